@@ -37,6 +37,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*admin.AdminDeleteWorkflowResponse, error)
 
+	DescribeAsyncWorkflowQueue(
+		ctx context.Context,
+		Request *admin.DescribeAsyncWorkflowQueueRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.DescribeAsyncWorkflowQueueResponse, error)
+
 	DescribeCluster(
 		ctx context.Context,
 		opts ...yarpc.CallOption,
@@ -138,12 +144,30 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*replicator.MergeDLQMessagesResponse, error)
 
+	MigrateTaskList(
+		ctx context.Context,
+		Request *admin.MigrateTaskListRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.MigrateTaskListResponse, error)
+
+	PurgeAsyncWorkflowDLQMessages(
+		ctx context.Context,
+		Request *admin.PurgeAsyncWorkflowDLQMessagesRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.PurgeAsyncWorkflowDLQMessagesResponse, error)
+
 	PurgeDLQMessages(
 		ctx context.Context,
 		Request *replicator.PurgeDLQMessagesRequest,
 		opts ...yarpc.CallOption,
 	) error
 
+	ReadAsyncWorkflowDLQMessages(
+		ctx context.Context,
+		Request *admin.ReadAsyncWorkflowDLQMessagesRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.ReadAsyncWorkflowDLQMessagesResponse, error)
+
 	ReadDLQMessages(
 		ctx context.Context,
 		Request *replicator.ReadDLQMessagesRequest,
@@ -168,6 +192,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ReplayAsyncWorkflowDLQMessages(
+		ctx context.Context,
+		Request *admin.ReplayAsyncWorkflowDLQMessagesRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.ReplayAsyncWorkflowDLQMessagesResponse, error)
+
 	ResendReplicationTasks(
 		ctx context.Context,
 		Request *admin.ResendReplicationTasksRequest,
@@ -215,6 +245,12 @@ type Interface interface {
 		Request *admin.UpdateGlobalIsolationGroupsRequest,
 		opts ...yarpc.CallOption,
 	) (*admin.UpdateGlobalIsolationGroupsResponse, error)
+
+	UpdateTaskListVersioningConfig(
+		ctx context.Context,
+		Request *admin.UpdateTaskListVersioningConfigRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.UpdateTaskListVersioningConfigResponse, error)
 }
 
 // New builds a new client for the AdminService service.
@@ -330,6 +366,34 @@ func (c client) DeleteWorkflow(
 	return
 }
 
+func (c client) DescribeAsyncWorkflowQueue(
+	ctx context.Context,
+	_Request *admin.DescribeAsyncWorkflowQueueRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeAsyncWorkflowQueueResponse, err error) {
+
+	var result admin.AdminService_DescribeAsyncWorkflowQueue_Result
+	args := admin.AdminService_DescribeAsyncWorkflowQueue_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_DescribeAsyncWorkflowQueue_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeCluster(
 	ctx context.Context,
 	opts ...yarpc.CallOption,
@@ -805,6 +869,62 @@ func (c client) MergeDLQMessages(
 	return
 }
 
+func (c client) MigrateTaskList(
+	ctx context.Context,
+	_Request *admin.MigrateTaskListRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.MigrateTaskListResponse, err error) {
+
+	var result admin.AdminService_MigrateTaskList_Result
+	args := admin.AdminService_MigrateTaskList_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_MigrateTaskList_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PurgeAsyncWorkflowDLQMessages(
+	ctx context.Context,
+	_Request *admin.PurgeAsyncWorkflowDLQMessagesRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.PurgeAsyncWorkflowDLQMessagesResponse, err error) {
+
+	var result admin.AdminService_PurgeAsyncWorkflowDLQMessages_Result
+	args := admin.AdminService_PurgeAsyncWorkflowDLQMessages_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_PurgeAsyncWorkflowDLQMessages_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PurgeDLQMessages(
 	ctx context.Context,
 	_Request *replicator.PurgeDLQMessagesRequest,
@@ -833,6 +953,34 @@ func (c client) PurgeDLQMessages(
 	return
 }
 
+func (c client) ReadAsyncWorkflowDLQMessages(
+	ctx context.Context,
+	_Request *admin.ReadAsyncWorkflowDLQMessagesRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ReadAsyncWorkflowDLQMessagesResponse, err error) {
+
+	var result admin.AdminService_ReadAsyncWorkflowDLQMessages_Result
+	args := admin.AdminService_ReadAsyncWorkflowDLQMessages_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_ReadAsyncWorkflowDLQMessages_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ReadDLQMessages(
 	ctx context.Context,
 	_Request *replicator.ReadDLQMessagesRequest,
@@ -945,6 +1093,34 @@ func (c client) RemoveTask(
 	return
 }
 
+func (c client) ReplayAsyncWorkflowDLQMessages(
+	ctx context.Context,
+	_Request *admin.ReplayAsyncWorkflowDLQMessagesRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ReplayAsyncWorkflowDLQMessagesResponse, err error) {
+
+	var result admin.AdminService_ReplayAsyncWorkflowDLQMessages_Result
+	args := admin.AdminService_ReplayAsyncWorkflowDLQMessages_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_ReplayAsyncWorkflowDLQMessages_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ResendReplicationTasks(
 	ctx context.Context,
 	_Request *admin.ResendReplicationTasksRequest,
@@ -1168,3 +1344,31 @@ func (c client) UpdateGlobalIsolationGroups(
 	success, err = admin.AdminService_UpdateGlobalIsolationGroups_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UpdateTaskListVersioningConfig(
+	ctx context.Context,
+	_Request *admin.UpdateTaskListVersioningConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.UpdateTaskListVersioningConfigResponse, err error) {
+
+	var result admin.AdminService_UpdateTaskListVersioningConfig_Result
+	args := admin.AdminService_UpdateTaskListVersioningConfig_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_UpdateTaskListVersioningConfig_Helper.UnwrapResponse(&result)
+	return
+}
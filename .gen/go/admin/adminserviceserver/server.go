@@ -34,6 +34,11 @@ type Interface interface {
 		Request *admin.AdminDeleteWorkflowRequest,
 	) (*admin.AdminDeleteWorkflowResponse, error)
 
+	DescribeAsyncWorkflowQueue(
+		ctx context.Context,
+		Request *admin.DescribeAsyncWorkflowQueueRequest,
+	) (*admin.DescribeAsyncWorkflowQueueResponse, error)
+
 	DescribeCluster(
 		ctx context.Context,
 	) (*admin.DescribeClusterResponse, error)
@@ -118,11 +123,26 @@ type Interface interface {
 		Request *replicator.MergeDLQMessagesRequest,
 	) (*replicator.MergeDLQMessagesResponse, error)
 
+	MigrateTaskList(
+		ctx context.Context,
+		Request *admin.MigrateTaskListRequest,
+	) (*admin.MigrateTaskListResponse, error)
+
+	PurgeAsyncWorkflowDLQMessages(
+		ctx context.Context,
+		Request *admin.PurgeAsyncWorkflowDLQMessagesRequest,
+	) (*admin.PurgeAsyncWorkflowDLQMessagesResponse, error)
+
 	PurgeDLQMessages(
 		ctx context.Context,
 		Request *replicator.PurgeDLQMessagesRequest,
 	) error
 
+	ReadAsyncWorkflowDLQMessages(
+		ctx context.Context,
+		Request *admin.ReadAsyncWorkflowDLQMessagesRequest,
+	) (*admin.ReadAsyncWorkflowDLQMessagesResponse, error)
+
 	ReadDLQMessages(
 		ctx context.Context,
 		Request *replicator.ReadDLQMessagesRequest,
@@ -143,6 +163,11 @@ type Interface interface {
 		Request *shared.RemoveTaskRequest,
 	) error
 
+	ReplayAsyncWorkflowDLQMessages(
+		ctx context.Context,
+		Request *admin.ReplayAsyncWorkflowDLQMessagesRequest,
+	) (*admin.ReplayAsyncWorkflowDLQMessagesResponse, error)
+
 	ResendReplicationTasks(
 		ctx context.Context,
 		Request *admin.ResendReplicationTasksRequest,
@@ -182,6 +207,11 @@ type Interface interface {
 		ctx context.Context,
 		Request *admin.UpdateGlobalIsolationGroupsRequest,
 	) (*admin.UpdateGlobalIsolationGroupsResponse, error)
+
+	UpdateTaskListVersioningConfig(
+		ctx context.Context,
+		Request *admin.UpdateTaskListVersioningConfigRequest,
+	) (*admin.UpdateTaskListVersioningConfigResponse, error)
 }
 
 // New prepares an implementation of the AdminService service for
@@ -231,6 +261,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeAsyncWorkflowQueue",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.DescribeAsyncWorkflowQueue),
+					NoWire: describeasyncworkflowqueue_NoWireHandler{impl},
+				},
+				Signature:    "DescribeAsyncWorkflowQueue(Request *admin.DescribeAsyncWorkflowQueueRequest) (*admin.DescribeAsyncWorkflowQueueResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeCluster",
 				HandlerSpec: thrift.HandlerSpec{
@@ -435,6 +477,30 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "MigrateTaskList",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.MigrateTaskList),
+					NoWire: migratetasklist_NoWireHandler{impl},
+				},
+				Signature:    "MigrateTaskList(Request *admin.MigrateTaskListRequest) (*admin.MigrateTaskListResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "PurgeAsyncWorkflowDLQMessages",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.PurgeAsyncWorkflowDLQMessages),
+					NoWire: purgeasyncworkflowdlqmessages_NoWireHandler{impl},
+				},
+				Signature:    "PurgeAsyncWorkflowDLQMessages(Request *admin.PurgeAsyncWorkflowDLQMessagesRequest) (*admin.PurgeAsyncWorkflowDLQMessagesResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "PurgeDLQMessages",
 				HandlerSpec: thrift.HandlerSpec{
@@ -447,6 +513,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ReadAsyncWorkflowDLQMessages",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ReadAsyncWorkflowDLQMessages),
+					NoWire: readasyncworkflowdlqmessages_NoWireHandler{impl},
+				},
+				Signature:    "ReadAsyncWorkflowDLQMessages(Request *admin.ReadAsyncWorkflowDLQMessagesRequest) (*admin.ReadAsyncWorkflowDLQMessagesResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ReadDLQMessages",
 				HandlerSpec: thrift.HandlerSpec{
@@ -495,6 +573,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ReplayAsyncWorkflowDLQMessages",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ReplayAsyncWorkflowDLQMessages),
+					NoWire: replayasyncworkflowdlqmessages_NoWireHandler{impl},
+				},
+				Signature:    "ReplayAsyncWorkflowDLQMessages(Request *admin.ReplayAsyncWorkflowDLQMessagesRequest) (*admin.ReplayAsyncWorkflowDLQMessagesResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ResendReplicationTasks",
 				HandlerSpec: thrift.HandlerSpec{
@@ -590,10 +680,22 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "UpdateGlobalIsolationGroups(Request *admin.UpdateGlobalIsolationGroupsRequest) (*admin.UpdateGlobalIsolationGroupsResponse)",
 				ThriftModule: admin.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "UpdateTaskListVersioningConfig",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.UpdateTaskListVersioningConfig),
+					NoWire: updatetasklistversioningconfig_NoWireHandler{impl},
+				},
+				Signature:    "UpdateTaskListVersioningConfig(Request *admin.UpdateTaskListVersioningConfigRequest) (*admin.UpdateTaskListVersioningConfigResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 33)
+	procedures := make([]transport.Procedure, 0, 39)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -694,6 +796,36 @@ func (h handler) DeleteWorkflow(ctx context.Context, body wire.Value) (thrift.Re
 	return response, err
 }
 
+func (h handler) DescribeAsyncWorkflowQueue(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeAsyncWorkflowQueue_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'DescribeAsyncWorkflowQueue': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeAsyncWorkflowQueue(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DescribeAsyncWorkflowQueue_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) DescribeCluster(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_DescribeCluster_Args
 	if err := args.FromWire(body); err != nil {
@@ -1204,6 +1336,66 @@ func (h handler) MergeDLQMessages(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
+func (h handler) MigrateTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_MigrateTaskList_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'MigrateTaskList': %w", err)
+	}
+
+	success, appErr := h.impl.MigrateTaskList(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_MigrateTaskList_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) PurgeAsyncWorkflowDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_PurgeAsyncWorkflowDLQMessages_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'PurgeAsyncWorkflowDLQMessages': %w", err)
+	}
+
+	success, appErr := h.impl.PurgeAsyncWorkflowDLQMessages(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_PurgeAsyncWorkflowDLQMessages_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) PurgeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_PurgeDLQMessages_Args
 	if err := args.FromWire(body); err != nil {
@@ -1234,6 +1426,36 @@ func (h handler) PurgeDLQMessages(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
+func (h handler) ReadAsyncWorkflowDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ReadAsyncWorkflowDLQMessages_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ReadAsyncWorkflowDLQMessages': %w", err)
+	}
+
+	success, appErr := h.impl.ReadAsyncWorkflowDLQMessages(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ReadAsyncWorkflowDLQMessages_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) ReadDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_ReadDLQMessages_Args
 	if err := args.FromWire(body); err != nil {
@@ -1354,6 +1576,36 @@ func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Respon
 	return response, err
 }
 
+func (h handler) ReplayAsyncWorkflowDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ReplayAsyncWorkflowDLQMessages_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ReplayAsyncWorkflowDLQMessages': %w", err)
+	}
+
+	success, appErr := h.impl.ReplayAsyncWorkflowDLQMessages(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ReplayAsyncWorkflowDLQMessages_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) ResendReplicationTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_ResendReplicationTasks_Args
 	if err := args.FromWire(body); err != nil {
@@ -1594,6 +1846,36 @@ func (h handler) UpdateGlobalIsolationGroups(ctx context.Context, body wire.Valu
 	return response, err
 }
 
+func (h handler) UpdateTaskListVersioningConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_UpdateTaskListVersioningConfig_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'UpdateTaskListVersioningConfig': %w", err)
+	}
+
+	success, appErr := h.impl.UpdateTaskListVersioningConfig(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_UpdateTaskListVersioningConfig_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 type addsearchattribute_NoWireHandler struct{ impl Interface }
 
 func (h addsearchattribute_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -1705,6 +1987,43 @@ func (h deleteworkflow_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thr
 
 }
 
+type describeasyncworkflowqueue_NoWireHandler struct{ impl Interface }
+
+func (h describeasyncworkflowqueue_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_DescribeAsyncWorkflowQueue_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeAsyncWorkflowQueue': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeAsyncWorkflowQueue(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DescribeAsyncWorkflowQueue_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type describecluster_NoWireHandler struct{ impl Interface }
 
 func (h describecluster_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2334,6 +2653,80 @@ func (h mergedlqmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *t
 
 }
 
+type migratetasklist_NoWireHandler struct{ impl Interface }
+
+func (h migratetasklist_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_MigrateTaskList_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'MigrateTaskList': %w", err)
+	}
+
+	success, appErr := h.impl.MigrateTaskList(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_MigrateTaskList_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type purgeasyncworkflowdlqmessages_NoWireHandler struct{ impl Interface }
+
+func (h purgeasyncworkflowdlqmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_PurgeAsyncWorkflowDLQMessages_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'PurgeAsyncWorkflowDLQMessages': %w", err)
+	}
+
+	success, appErr := h.impl.PurgeAsyncWorkflowDLQMessages(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_PurgeAsyncWorkflowDLQMessages_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type purgedlqmessages_NoWireHandler struct{ impl Interface }
 
 func (h purgedlqmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2371,6 +2764,43 @@ func (h purgedlqmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *t
 
 }
 
+type readasyncworkflowdlqmessages_NoWireHandler struct{ impl Interface }
+
+func (h readasyncworkflowdlqmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_ReadAsyncWorkflowDLQMessages_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'ReadAsyncWorkflowDLQMessages': %w", err)
+	}
+
+	success, appErr := h.impl.ReadAsyncWorkflowDLQMessages(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ReadAsyncWorkflowDLQMessages_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type readdlqmessages_NoWireHandler struct{ impl Interface }
 
 func (h readdlqmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2519,6 +2949,43 @@ func (h removetask_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.
 
 }
 
+type replayasyncworkflowdlqmessages_NoWireHandler struct{ impl Interface }
+
+func (h replayasyncworkflowdlqmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_ReplayAsyncWorkflowDLQMessages_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'ReplayAsyncWorkflowDLQMessages': %w", err)
+	}
+
+	success, appErr := h.impl.ReplayAsyncWorkflowDLQMessages(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ReplayAsyncWorkflowDLQMessages_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type resendreplicationtasks_NoWireHandler struct{ impl Interface }
 
 func (h resendreplicationtasks_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2814,3 +3281,40 @@ func (h updateglobalisolationgroups_NoWireHandler) HandleNoWire(ctx context.Cont
 	return response, err
 
 }
+
+type updatetasklistversioningconfig_NoWireHandler struct{ impl Interface }
+
+func (h updatetasklistversioningconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_UpdateTaskListVersioningConfig_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'UpdateTaskListVersioningConfig': %w", err)
+	}
+
+	success, appErr := h.impl.UpdateTaskListVersioningConfig(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_UpdateTaskListVersioningConfig_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
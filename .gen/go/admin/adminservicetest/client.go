@@ -141,6 +141,39 @@ func (mr *_MockClientRecorder) DeleteWorkflow(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DeleteWorkflow", args...)
 }
 
+// DescribeAsyncWorkflowQueue responds to a DescribeAsyncWorkflowQueue call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DescribeAsyncWorkflowQueue(gomock.Any(), ...).Return(...)
+//	... := client.DescribeAsyncWorkflowQueue(...)
+func (m *MockClient) DescribeAsyncWorkflowQueue(
+	ctx context.Context,
+	_Request *admin.DescribeAsyncWorkflowQueueRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeAsyncWorkflowQueueResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeAsyncWorkflowQueue", args...)
+	success, _ = ret[i].(*admin.DescribeAsyncWorkflowQueueResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeAsyncWorkflowQueue(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeAsyncWorkflowQueue", args...)
+}
+
 // DescribeCluster responds to a DescribeCluster call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -700,6 +733,72 @@ func (mr *_MockClientRecorder) MergeDLQMessages(
 	return mr.mock.ctrl.RecordCall(mr.mock, "MergeDLQMessages", args...)
 }
 
+// MigrateTaskList responds to a MigrateTaskList call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().MigrateTaskList(gomock.Any(), ...).Return(...)
+//	... := client.MigrateTaskList(...)
+func (m *MockClient) MigrateTaskList(
+	ctx context.Context,
+	_Request *admin.MigrateTaskListRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.MigrateTaskListResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "MigrateTaskList", args...)
+	success, _ = ret[i].(*admin.MigrateTaskListResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) MigrateTaskList(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "MigrateTaskList", args...)
+}
+
+// PurgeAsyncWorkflowDLQMessages responds to a PurgeAsyncWorkflowDLQMessages call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().PurgeAsyncWorkflowDLQMessages(gomock.Any(), ...).Return(...)
+//	... := client.PurgeAsyncWorkflowDLQMessages(...)
+func (m *MockClient) PurgeAsyncWorkflowDLQMessages(
+	ctx context.Context,
+	_Request *admin.PurgeAsyncWorkflowDLQMessagesRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.PurgeAsyncWorkflowDLQMessagesResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "PurgeAsyncWorkflowDLQMessages", args...)
+	success, _ = ret[i].(*admin.PurgeAsyncWorkflowDLQMessagesResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) PurgeAsyncWorkflowDLQMessages(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "PurgeAsyncWorkflowDLQMessages", args...)
+}
+
 // PurgeDLQMessages responds to a PurgeDLQMessages call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -731,6 +830,39 @@ func (mr *_MockClientRecorder) PurgeDLQMessages(
 	return mr.mock.ctrl.RecordCall(mr.mock, "PurgeDLQMessages", args...)
 }
 
+// ReadAsyncWorkflowDLQMessages responds to a ReadAsyncWorkflowDLQMessages call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ReadAsyncWorkflowDLQMessages(gomock.Any(), ...).Return(...)
+//	... := client.ReadAsyncWorkflowDLQMessages(...)
+func (m *MockClient) ReadAsyncWorkflowDLQMessages(
+	ctx context.Context,
+	_Request *admin.ReadAsyncWorkflowDLQMessagesRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ReadAsyncWorkflowDLQMessagesResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ReadAsyncWorkflowDLQMessages", args...)
+	success, _ = ret[i].(*admin.ReadAsyncWorkflowDLQMessagesResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ReadAsyncWorkflowDLQMessages(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ReadAsyncWorkflowDLQMessages", args...)
+}
+
 // ReadDLQMessages responds to a ReadDLQMessages call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -857,6 +989,39 @@ func (mr *_MockClientRecorder) RemoveTask(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RemoveTask", args...)
 }
 
+// ReplayAsyncWorkflowDLQMessages responds to a ReplayAsyncWorkflowDLQMessages call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ReplayAsyncWorkflowDLQMessages(gomock.Any(), ...).Return(...)
+//	... := client.ReplayAsyncWorkflowDLQMessages(...)
+func (m *MockClient) ReplayAsyncWorkflowDLQMessages(
+	ctx context.Context,
+	_Request *admin.ReplayAsyncWorkflowDLQMessagesRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ReplayAsyncWorkflowDLQMessagesResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ReplayAsyncWorkflowDLQMessages", args...)
+	success, _ = ret[i].(*admin.ReplayAsyncWorkflowDLQMessagesResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ReplayAsyncWorkflowDLQMessages(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ReplayAsyncWorkflowDLQMessages", args...)
+}
+
 // ResendReplicationTasks responds to a ResendReplicationTasks call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1112,3 +1277,36 @@ func (mr *_MockClientRecorder) UpdateGlobalIsolationGroups(
 	args := append([]interface{}{ctx, _Request}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateGlobalIsolationGroups", args...)
 }
+
+// UpdateTaskListVersioningConfig responds to a UpdateTaskListVersioningConfig call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().UpdateTaskListVersioningConfig(gomock.Any(), ...).Return(...)
+//	... := client.UpdateTaskListVersioningConfig(...)
+func (m *MockClient) UpdateTaskListVersioningConfig(
+	ctx context.Context,
+	_Request *admin.UpdateTaskListVersioningConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.UpdateTaskListVersioningConfigResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateTaskListVersioningConfig", args...)
+	success, _ = ret[i].(*admin.UpdateTaskListVersioningConfigResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateTaskListVersioningConfig(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateTaskListVersioningConfig", args...)
+}
@@ -36,6 +36,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	DescribeAsyncRequest(
+		ctx context.Context,
+		DescribeRequest *shared.DescribeAsyncRequestRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.DescribeAsyncRequestResponse, error)
+
 	DescribeDomain(
 		ctx context.Context,
 		DescribeRequest *shared.DescribeDomainRequest,
@@ -48,6 +54,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeTaskListResponse, error)
 
+	DescribeWorker(
+		ctx context.Context,
+		Request *shared.DescribeWorkerRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.DescribeWorkerResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		DescribeRequest *shared.DescribeWorkflowExecutionRequest,
@@ -70,6 +82,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.GetSearchAttributesResponse, error)
 
+	GetTaskListScalingRecommendation(
+		ctx context.Context,
+		Request *shared.GetTaskListScalingRecommendationRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetTaskListScalingRecommendationResponse, error)
+
 	GetTaskListsByDomain(
 		ctx context.Context,
 		Request *shared.GetTaskListsByDomainRequest,
@@ -112,6 +130,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.ListTaskListPartitionsResponse, error)
 
+	ListWorkers(
+		ctx context.Context,
+		Request *shared.ListWorkersRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListWorkersResponse, error)
+
 	ListWorkflowExecutions(
 		ctx context.Context,
 		ListRequest *shared.ListWorkflowExecutionsRequest,
@@ -166,6 +190,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	RequestCancelWorkflowExecutionAsync(
+		ctx context.Context,
+		CancelRequest *shared.RequestCancelWorkflowExecutionAsyncRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.RequestCancelWorkflowExecutionAsyncResponse, error)
+
 	ResetStickyTaskList(
 		ctx context.Context,
 		ResetRequest *shared.ResetStickyTaskListRequest,
@@ -262,6 +292,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	SignalWorkflowExecutionAsync(
+		ctx context.Context,
+		SignalRequest *shared.SignalWorkflowExecutionAsyncRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.SignalWorkflowExecutionAsyncResponse, error)
+
 	StartWorkflowExecution(
 		ctx context.Context,
 		StartRequest *shared.StartWorkflowExecutionRequest,
@@ -280,11 +316,23 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	TerminateWorkflowExecutionAsync(
+		ctx context.Context,
+		TerminateRequest *shared.TerminateWorkflowExecutionAsyncRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.TerminateWorkflowExecutionAsyncResponse, error)
+
 	UpdateDomain(
 		ctx context.Context,
 		UpdateRequest *shared.UpdateDomainRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.UpdateDomainResponse, error)
+
+	UpdateWorkflowMemo(
+		ctx context.Context,
+		UpdateRequest *shared.UpdateWorkflowMemoRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.UpdateWorkflowMemoResponse, error)
 }
 
 // New builds a new client for the WorkflowService service.
@@ -400,6 +448,34 @@ func (c client) DeprecateDomain(
 	return
 }
 
+func (c client) DescribeAsyncRequest(
+	ctx context.Context,
+	_DescribeRequest *shared.DescribeAsyncRequestRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeAsyncRequestResponse, err error) {
+
+	var result cadence.WorkflowService_DescribeAsyncRequest_Result
+	args := cadence.WorkflowService_DescribeAsyncRequest_Helper.Args(_DescribeRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_DescribeAsyncRequest_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeDomain(
 	ctx context.Context,
 	_DescribeRequest *shared.DescribeDomainRequest,
@@ -456,6 +532,34 @@ func (c client) DescribeTaskList(
 	return
 }
 
+func (c client) DescribeWorker(
+	ctx context.Context,
+	_Request *shared.DescribeWorkerRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeWorkerResponse, err error) {
+
+	var result cadence.WorkflowService_DescribeWorker_Result
+	args := cadence.WorkflowService_DescribeWorker_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_DescribeWorker_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeWorkflowExecution(
 	ctx context.Context,
 	_DescribeRequest *shared.DescribeWorkflowExecutionRequest,
@@ -566,6 +670,34 @@ func (c client) GetSearchAttributes(
 	return
 }
 
+func (c client) GetTaskListScalingRecommendation(
+	ctx context.Context,
+	_Request *shared.GetTaskListScalingRecommendationRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetTaskListScalingRecommendationResponse, err error) {
+
+	var result cadence.WorkflowService_GetTaskListScalingRecommendation_Result
+	args := cadence.WorkflowService_GetTaskListScalingRecommendation_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_GetTaskListScalingRecommendation_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetTaskListsByDomain(
 	ctx context.Context,
 	_Request *shared.GetTaskListsByDomainRequest,
@@ -762,6 +894,34 @@ func (c client) ListTaskListPartitions(
 	return
 }
 
+func (c client) ListWorkers(
+	ctx context.Context,
+	_Request *shared.ListWorkersRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListWorkersResponse, err error) {
+
+	var result cadence.WorkflowService_ListWorkers_Result
+	args := cadence.WorkflowService_ListWorkers_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_ListWorkers_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ListWorkflowExecutions(
 	ctx context.Context,
 	_ListRequest *shared.ListWorkflowExecutionsRequest,
@@ -1014,6 +1174,34 @@ func (c client) RequestCancelWorkflowExecution(
 	return
 }
 
+func (c client) RequestCancelWorkflowExecutionAsync(
+	ctx context.Context,
+	_CancelRequest *shared.RequestCancelWorkflowExecutionAsyncRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.RequestCancelWorkflowExecutionAsyncResponse, err error) {
+
+	var result cadence.WorkflowService_RequestCancelWorkflowExecutionAsync_Result
+	args := cadence.WorkflowService_RequestCancelWorkflowExecutionAsync_Helper.Args(_CancelRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_RequestCancelWorkflowExecutionAsync_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ResetStickyTaskList(
 	ctx context.Context,
 	_ResetRequest *shared.ResetStickyTaskListRequest,
@@ -1462,6 +1650,34 @@ func (c client) SignalWorkflowExecution(
 	return
 }
 
+func (c client) SignalWorkflowExecutionAsync(
+	ctx context.Context,
+	_SignalRequest *shared.SignalWorkflowExecutionAsyncRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.SignalWorkflowExecutionAsyncResponse, err error) {
+
+	var result cadence.WorkflowService_SignalWorkflowExecutionAsync_Result
+	args := cadence.WorkflowService_SignalWorkflowExecutionAsync_Helper.Args(_SignalRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_SignalWorkflowExecutionAsync_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) StartWorkflowExecution(
 	ctx context.Context,
 	_StartRequest *shared.StartWorkflowExecutionRequest,
@@ -1546,6 +1762,34 @@ func (c client) TerminateWorkflowExecution(
 	return
 }
 
+func (c client) TerminateWorkflowExecutionAsync(
+	ctx context.Context,
+	_TerminateRequest *shared.TerminateWorkflowExecutionAsyncRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.TerminateWorkflowExecutionAsyncResponse, err error) {
+
+	var result cadence.WorkflowService_TerminateWorkflowExecutionAsync_Result
+	args := cadence.WorkflowService_TerminateWorkflowExecutionAsync_Helper.Args(_TerminateRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_TerminateWorkflowExecutionAsync_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) UpdateDomain(
 	ctx context.Context,
 	_UpdateRequest *shared.UpdateDomainRequest,
@@ -1573,3 +1817,31 @@ func (c client) UpdateDomain(
 	success, err = cadence.WorkflowService_UpdateDomain_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UpdateWorkflowMemo(
+	ctx context.Context,
+	_UpdateRequest *shared.UpdateWorkflowMemoRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateWorkflowMemoResponse, err error) {
+
+	var result cadence.WorkflowService_UpdateWorkflowMemo_Result
+	args := cadence.WorkflowService_UpdateWorkflowMemo_Helper.Args(_UpdateRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = cadence.WorkflowService_UpdateWorkflowMemo_Helper.UnwrapResponse(&result)
+	return
+}
@@ -33,6 +33,11 @@ type Interface interface {
 		DeprecateRequest *shared.DeprecateDomainRequest,
 	) error
 
+	DescribeAsyncRequest(
+		ctx context.Context,
+		DescribeRequest *shared.DescribeAsyncRequestRequest,
+	) (*shared.DescribeAsyncRequestResponse, error)
+
 	DescribeDomain(
 		ctx context.Context,
 		DescribeRequest *shared.DescribeDomainRequest,
@@ -43,6 +48,11 @@ type Interface interface {
 		Request *shared.DescribeTaskListRequest,
 	) (*shared.DescribeTaskListResponse, error)
 
+	DescribeWorker(
+		ctx context.Context,
+		Request *shared.DescribeWorkerRequest,
+	) (*shared.DescribeWorkerResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		DescribeRequest *shared.DescribeWorkflowExecutionRequest,
@@ -61,6 +71,11 @@ type Interface interface {
 		ctx context.Context,
 	) (*shared.GetSearchAttributesResponse, error)
 
+	GetTaskListScalingRecommendation(
+		ctx context.Context,
+		Request *shared.GetTaskListScalingRecommendationRequest,
+	) (*shared.GetTaskListScalingRecommendationResponse, error)
+
 	GetTaskListsByDomain(
 		ctx context.Context,
 		Request *shared.GetTaskListsByDomainRequest,
@@ -96,6 +111,11 @@ type Interface interface {
 		Request *shared.ListTaskListPartitionsRequest,
 	) (*shared.ListTaskListPartitionsResponse, error)
 
+	ListWorkers(
+		ctx context.Context,
+		Request *shared.ListWorkersRequest,
+	) (*shared.ListWorkersResponse, error)
+
 	ListWorkflowExecutions(
 		ctx context.Context,
 		ListRequest *shared.ListWorkflowExecutionsRequest,
@@ -141,6 +161,11 @@ type Interface interface {
 		CancelRequest *shared.RequestCancelWorkflowExecutionRequest,
 	) error
 
+	RequestCancelWorkflowExecutionAsync(
+		ctx context.Context,
+		CancelRequest *shared.RequestCancelWorkflowExecutionAsyncRequest,
+	) (*shared.RequestCancelWorkflowExecutionAsyncResponse, error)
+
 	ResetStickyTaskList(
 		ctx context.Context,
 		ResetRequest *shared.ResetStickyTaskListRequest,
@@ -221,6 +246,11 @@ type Interface interface {
 		SignalRequest *shared.SignalWorkflowExecutionRequest,
 	) error
 
+	SignalWorkflowExecutionAsync(
+		ctx context.Context,
+		SignalRequest *shared.SignalWorkflowExecutionAsyncRequest,
+	) (*shared.SignalWorkflowExecutionAsyncResponse, error)
+
 	StartWorkflowExecution(
 		ctx context.Context,
 		StartRequest *shared.StartWorkflowExecutionRequest,
@@ -236,10 +266,20 @@ type Interface interface {
 		TerminateRequest *shared.TerminateWorkflowExecutionRequest,
 	) error
 
+	TerminateWorkflowExecutionAsync(
+		ctx context.Context,
+		TerminateRequest *shared.TerminateWorkflowExecutionAsyncRequest,
+	) (*shared.TerminateWorkflowExecutionAsyncResponse, error)
+
 	UpdateDomain(
 		ctx context.Context,
 		UpdateRequest *shared.UpdateDomainRequest,
 	) (*shared.UpdateDomainResponse, error)
+
+	UpdateWorkflowMemo(
+		ctx context.Context,
+		UpdateRequest *shared.UpdateWorkflowMemoRequest,
+	) (*shared.UpdateWorkflowMemoResponse, error)
 }
 
 // New prepares an implementation of the WorkflowService service for
@@ -289,6 +329,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeAsyncRequest",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.DescribeAsyncRequest),
+					NoWire: describeasyncrequest_NoWireHandler{impl},
+				},
+				Signature:    "DescribeAsyncRequest(DescribeRequest *shared.DescribeAsyncRequestRequest) (*shared.DescribeAsyncRequestResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeDomain",
 				HandlerSpec: thrift.HandlerSpec{
@@ -313,6 +365,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeWorker",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.DescribeWorker),
+					NoWire: describeworker_NoWireHandler{impl},
+				},
+				Signature:    "DescribeWorker(Request *shared.DescribeWorkerRequest) (*shared.DescribeWorkerResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeWorkflowExecution",
 				HandlerSpec: thrift.HandlerSpec{
@@ -361,6 +425,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "GetTaskListScalingRecommendation",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetTaskListScalingRecommendation),
+					NoWire: gettasklistscalingrecommendation_NoWireHandler{impl},
+				},
+				Signature:    "GetTaskListScalingRecommendation(Request *shared.GetTaskListScalingRecommendationRequest) (*shared.GetTaskListScalingRecommendationResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetTaskListsByDomain",
 				HandlerSpec: thrift.HandlerSpec{
@@ -445,6 +521,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ListWorkers",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ListWorkers),
+					NoWire: listworkers_NoWireHandler{impl},
+				},
+				Signature:    "ListWorkers(Request *shared.ListWorkersRequest) (*shared.ListWorkersResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ListWorkflowExecutions",
 				HandlerSpec: thrift.HandlerSpec{
@@ -553,6 +641,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RequestCancelWorkflowExecutionAsync",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.RequestCancelWorkflowExecutionAsync),
+					NoWire: requestcancelworkflowexecutionasync_NoWireHandler{impl},
+				},
+				Signature:    "RequestCancelWorkflowExecutionAsync(CancelRequest *shared.RequestCancelWorkflowExecutionAsyncRequest) (*shared.RequestCancelWorkflowExecutionAsyncResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ResetStickyTaskList",
 				HandlerSpec: thrift.HandlerSpec{
@@ -745,6 +845,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "SignalWorkflowExecutionAsync",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.SignalWorkflowExecutionAsync),
+					NoWire: signalworkflowexecutionasync_NoWireHandler{impl},
+				},
+				Signature:    "SignalWorkflowExecutionAsync(SignalRequest *shared.SignalWorkflowExecutionAsyncRequest) (*shared.SignalWorkflowExecutionAsyncResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "StartWorkflowExecution",
 				HandlerSpec: thrift.HandlerSpec{
@@ -781,6 +893,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "TerminateWorkflowExecutionAsync",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.TerminateWorkflowExecutionAsync),
+					NoWire: terminateworkflowexecutionasync_NoWireHandler{impl},
+				},
+				Signature:    "TerminateWorkflowExecutionAsync(TerminateRequest *shared.TerminateWorkflowExecutionAsyncRequest) (*shared.TerminateWorkflowExecutionAsyncResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "UpdateDomain",
 				HandlerSpec: thrift.HandlerSpec{
@@ -792,10 +916,22 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "UpdateDomain(UpdateRequest *shared.UpdateDomainRequest) (*shared.UpdateDomainResponse)",
 				ThriftModule: cadence.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "UpdateWorkflowMemo",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.UpdateWorkflowMemo),
+					NoWire: updateworkflowmemo_NoWireHandler{impl},
+				},
+				Signature:    "UpdateWorkflowMemo(UpdateRequest *shared.UpdateWorkflowMemoRequest) (*shared.UpdateWorkflowMemoResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 45)
+	procedures := make([]transport.Procedure, 0, 53)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -896,6 +1032,36 @@ func (h handler) DeprecateDomain(ctx context.Context, body wire.Value) (thrift.R
 	return response, err
 }
 
+func (h handler) DescribeAsyncRequest(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_DescribeAsyncRequest_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'DescribeAsyncRequest': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeAsyncRequest(ctx, args.DescribeRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_DescribeAsyncRequest_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) DescribeDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_DescribeDomain_Args
 	if err := args.FromWire(body); err != nil {
@@ -956,6 +1122,36 @@ func (h handler) DescribeTaskList(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
+func (h handler) DescribeWorker(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_DescribeWorker_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'DescribeWorker': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeWorker(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_DescribeWorker_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_DescribeWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
@@ -1076,6 +1272,36 @@ func (h handler) GetSearchAttributes(ctx context.Context, body wire.Value) (thri
 	return response, err
 }
 
+func (h handler) GetTaskListScalingRecommendation(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_GetTaskListScalingRecommendation_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'GetTaskListScalingRecommendation': %w", err)
+	}
+
+	success, appErr := h.impl.GetTaskListScalingRecommendation(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_GetTaskListScalingRecommendation_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) GetTaskListsByDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_GetTaskListsByDomain_Args
 	if err := args.FromWire(body); err != nil {
@@ -1286,6 +1512,36 @@ func (h handler) ListTaskListPartitions(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
+func (h handler) ListWorkers(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListWorkers_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'ListWorkers': %w", err)
+	}
+
+	success, appErr := h.impl.ListWorkers(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_ListWorkers_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) ListWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_ListWorkflowExecutions_Args
 	if err := args.FromWire(body); err != nil {
@@ -1556,6 +1812,36 @@ func (h handler) RequestCancelWorkflowExecution(ctx context.Context, body wire.V
 	return response, err
 }
 
+func (h handler) RequestCancelWorkflowExecutionAsync(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_RequestCancelWorkflowExecutionAsync_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'RequestCancelWorkflowExecutionAsync': %w", err)
+	}
+
+	success, appErr := h.impl.RequestCancelWorkflowExecutionAsync(ctx, args.CancelRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_RequestCancelWorkflowExecutionAsync_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) ResetStickyTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_ResetStickyTaskList_Args
 	if err := args.FromWire(body); err != nil {
@@ -2036,6 +2322,36 @@ func (h handler) SignalWorkflowExecution(ctx context.Context, body wire.Value) (
 	return response, err
 }
 
+func (h handler) SignalWorkflowExecutionAsync(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_SignalWorkflowExecutionAsync_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'SignalWorkflowExecutionAsync': %w", err)
+	}
+
+	success, appErr := h.impl.SignalWorkflowExecutionAsync(ctx, args.SignalRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_SignalWorkflowExecutionAsync_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) StartWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_StartWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
@@ -2126,6 +2442,36 @@ func (h handler) TerminateWorkflowExecution(ctx context.Context, body wire.Value
 	return response, err
 }
 
+func (h handler) TerminateWorkflowExecutionAsync(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_TerminateWorkflowExecutionAsync_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'TerminateWorkflowExecutionAsync': %w", err)
+	}
+
+	success, appErr := h.impl.TerminateWorkflowExecutionAsync(ctx, args.TerminateRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_TerminateWorkflowExecutionAsync_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) UpdateDomain(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_UpdateDomain_Args
 	if err := args.FromWire(body); err != nil {
@@ -2156,7 +2502,37 @@ func (h handler) UpdateDomain(ctx context.Context, body wire.Value) (thrift.Resp
 	return response, err
 }
 
-type countworkflowexecutions_NoWireHandler struct{ impl Interface }
+func (h handler) UpdateWorkflowMemo(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_UpdateWorkflowMemo_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'UpdateWorkflowMemo': %w", err)
+	}
+
+	success, appErr := h.impl.UpdateWorkflowMemo(ctx, args.UpdateRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UpdateWorkflowMemo_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+type countworkflowexecutions_NoWireHandler struct{ impl Interface }
 
 func (h countworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
@@ -2267,6 +2643,43 @@ func (h deprecatedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *th
 
 }
 
+type describeasyncrequest_NoWireHandler struct{ impl Interface }
+
+func (h describeasyncrequest_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_DescribeAsyncRequest_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'DescribeAsyncRequest': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeAsyncRequest(ctx, args.DescribeRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_DescribeAsyncRequest_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type describedomain_NoWireHandler struct{ impl Interface }
 
 func (h describedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2341,6 +2754,43 @@ func (h describetasklist_NoWireHandler) HandleNoWire(ctx context.Context, nwc *t
 
 }
 
+type describeworker_NoWireHandler struct{ impl Interface }
+
+func (h describeworker_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_DescribeWorker_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'DescribeWorker': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeWorker(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_DescribeWorker_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type describeworkflowexecution_NoWireHandler struct{ impl Interface }
 
 func (h describeworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2489,6 +2939,43 @@ func (h getsearchattributes_NoWireHandler) HandleNoWire(ctx context.Context, nwc
 
 }
 
+type gettasklistscalingrecommendation_NoWireHandler struct{ impl Interface }
+
+func (h gettasklistscalingrecommendation_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_GetTaskListScalingRecommendation_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'GetTaskListScalingRecommendation': %w", err)
+	}
+
+	success, appErr := h.impl.GetTaskListScalingRecommendation(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_GetTaskListScalingRecommendation_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type gettasklistsbydomain_NoWireHandler struct{ impl Interface }
 
 func (h gettasklistsbydomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2748,6 +3235,43 @@ func (h listtasklistpartitions_NoWireHandler) HandleNoWire(ctx context.Context,
 
 }
 
+type listworkers_NoWireHandler struct{ impl Interface }
+
+func (h listworkers_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_ListWorkers_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'ListWorkers': %w", err)
+	}
+
+	success, appErr := h.impl.ListWorkers(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_ListWorkers_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type listworkflowexecutions_NoWireHandler struct{ impl Interface }
 
 func (h listworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -3081,6 +3605,43 @@ func (h requestcancelworkflowexecution_NoWireHandler) HandleNoWire(ctx context.C
 
 }
 
+type requestcancelworkflowexecutionasync_NoWireHandler struct{ impl Interface }
+
+func (h requestcancelworkflowexecutionasync_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_RequestCancelWorkflowExecutionAsync_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'RequestCancelWorkflowExecutionAsync': %w", err)
+	}
+
+	success, appErr := h.impl.RequestCancelWorkflowExecutionAsync(ctx, args.CancelRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_RequestCancelWorkflowExecutionAsync_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type resetstickytasklist_NoWireHandler struct{ impl Interface }
 
 func (h resetstickytasklist_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -3673,6 +4234,43 @@ func (h signalworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context,
 
 }
 
+type signalworkflowexecutionasync_NoWireHandler struct{ impl Interface }
+
+func (h signalworkflowexecutionasync_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_SignalWorkflowExecutionAsync_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'SignalWorkflowExecutionAsync': %w", err)
+	}
+
+	success, appErr := h.impl.SignalWorkflowExecutionAsync(ctx, args.SignalRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_SignalWorkflowExecutionAsync_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type startworkflowexecution_NoWireHandler struct{ impl Interface }
 
 func (h startworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -3784,6 +4382,43 @@ func (h terminateworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Conte
 
 }
 
+type terminateworkflowexecutionasync_NoWireHandler struct{ impl Interface }
+
+func (h terminateworkflowexecutionasync_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_TerminateWorkflowExecutionAsync_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'TerminateWorkflowExecutionAsync': %w", err)
+	}
+
+	success, appErr := h.impl.TerminateWorkflowExecutionAsync(ctx, args.TerminateRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_TerminateWorkflowExecutionAsync_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type updatedomain_NoWireHandler struct{ impl Interface }
 
 func (h updatedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -3820,3 +4455,40 @@ func (h updatedomain_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrif
 	return response, err
 
 }
+
+type updateworkflowmemo_NoWireHandler struct{ impl Interface }
+
+func (h updateworkflowmemo_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_UpdateWorkflowMemo_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'UpdateWorkflowMemo': %w", err)
+	}
+
+	success, appErr := h.impl.UpdateWorkflowMemo(ctx, args.UpdateRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_UpdateWorkflowMemo_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
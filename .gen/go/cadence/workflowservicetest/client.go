@@ -139,6 +139,39 @@ func (mr *_MockClientRecorder) DeprecateDomain(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DeprecateDomain", args...)
 }
 
+// DescribeAsyncRequest responds to a DescribeAsyncRequest call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DescribeAsyncRequest(gomock.Any(), ...).Return(...)
+//	... := client.DescribeAsyncRequest(...)
+func (m *MockClient) DescribeAsyncRequest(
+	ctx context.Context,
+	_DescribeRequest *shared.DescribeAsyncRequestRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeAsyncRequestResponse, err error) {
+
+	args := []interface{}{ctx, _DescribeRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeAsyncRequest", args...)
+	success, _ = ret[i].(*shared.DescribeAsyncRequestResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeAsyncRequest(
+	ctx interface{},
+	_DescribeRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _DescribeRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeAsyncRequest", args...)
+}
+
 // DescribeDomain responds to a DescribeDomain call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -205,6 +238,39 @@ func (mr *_MockClientRecorder) DescribeTaskList(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeTaskList", args...)
 }
 
+// DescribeWorker responds to a DescribeWorker call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().DescribeWorker(gomock.Any(), ...).Return(...)
+//	... := client.DescribeWorker(...)
+func (m *MockClient) DescribeWorker(
+	ctx context.Context,
+	_Request *shared.DescribeWorkerRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeWorkerResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeWorker", args...)
+	success, _ = ret[i].(*shared.DescribeWorkerResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeWorker(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeWorker", args...)
+}
+
 // DescribeWorkflowExecution responds to a DescribeWorkflowExecution call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -333,6 +399,39 @@ func (mr *_MockClientRecorder) GetSearchAttributes(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetSearchAttributes", args...)
 }
 
+// GetTaskListScalingRecommendation responds to a GetTaskListScalingRecommendation call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetTaskListScalingRecommendation(gomock.Any(), ...).Return(...)
+//	... := client.GetTaskListScalingRecommendation(...)
+func (m *MockClient) GetTaskListScalingRecommendation(
+	ctx context.Context,
+	_Request *shared.GetTaskListScalingRecommendationRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetTaskListScalingRecommendationResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetTaskListScalingRecommendation", args...)
+	success, _ = ret[i].(*shared.GetTaskListScalingRecommendationResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetTaskListScalingRecommendation(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetTaskListScalingRecommendation", args...)
+}
+
 // GetTaskListsByDomain responds to a GetTaskListsByDomain call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -564,6 +663,39 @@ func (mr *_MockClientRecorder) ListTaskListPartitions(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ListTaskListPartitions", args...)
 }
 
+// ListWorkers responds to a ListWorkers call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ListWorkers(gomock.Any(), ...).Return(...)
+//	... := client.ListWorkers(...)
+func (m *MockClient) ListWorkers(
+	ctx context.Context,
+	_Request *shared.ListWorkersRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListWorkersResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListWorkers", args...)
+	success, _ = ret[i].(*shared.ListWorkersResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListWorkers(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListWorkers", args...)
+}
+
 // ListWorkflowExecutions responds to a ListWorkflowExecutions call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -855,6 +987,39 @@ func (mr *_MockClientRecorder) RequestCancelWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RequestCancelWorkflowExecution", args...)
 }
 
+// RequestCancelWorkflowExecutionAsync responds to a RequestCancelWorkflowExecutionAsync call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().RequestCancelWorkflowExecutionAsync(gomock.Any(), ...).Return(...)
+//	... := client.RequestCancelWorkflowExecutionAsync(...)
+func (m *MockClient) RequestCancelWorkflowExecutionAsync(
+	ctx context.Context,
+	_CancelRequest *shared.RequestCancelWorkflowExecutionAsyncRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.RequestCancelWorkflowExecutionAsyncResponse, err error) {
+
+	args := []interface{}{ctx, _CancelRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RequestCancelWorkflowExecutionAsync", args...)
+	success, _ = ret[i].(*shared.RequestCancelWorkflowExecutionAsyncResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RequestCancelWorkflowExecutionAsync(
+	ctx interface{},
+	_CancelRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _CancelRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RequestCancelWorkflowExecutionAsync", args...)
+}
+
 // ResetStickyTaskList responds to a ResetStickyTaskList call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1365,6 +1530,39 @@ func (mr *_MockClientRecorder) SignalWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "SignalWorkflowExecution", args...)
 }
 
+// SignalWorkflowExecutionAsync responds to a SignalWorkflowExecutionAsync call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().SignalWorkflowExecutionAsync(gomock.Any(), ...).Return(...)
+//	... := client.SignalWorkflowExecutionAsync(...)
+func (m *MockClient) SignalWorkflowExecutionAsync(
+	ctx context.Context,
+	_SignalRequest *shared.SignalWorkflowExecutionAsyncRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.SignalWorkflowExecutionAsyncResponse, err error) {
+
+	args := []interface{}{ctx, _SignalRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "SignalWorkflowExecutionAsync", args...)
+	success, _ = ret[i].(*shared.SignalWorkflowExecutionAsyncResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) SignalWorkflowExecutionAsync(
+	ctx interface{},
+	_SignalRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _SignalRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "SignalWorkflowExecutionAsync", args...)
+}
+
 // StartWorkflowExecution responds to a StartWorkflowExecution call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1462,6 +1660,39 @@ func (mr *_MockClientRecorder) TerminateWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "TerminateWorkflowExecution", args...)
 }
 
+// TerminateWorkflowExecutionAsync responds to a TerminateWorkflowExecutionAsync call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().TerminateWorkflowExecutionAsync(gomock.Any(), ...).Return(...)
+//	... := client.TerminateWorkflowExecutionAsync(...)
+func (m *MockClient) TerminateWorkflowExecutionAsync(
+	ctx context.Context,
+	_TerminateRequest *shared.TerminateWorkflowExecutionAsyncRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.TerminateWorkflowExecutionAsyncResponse, err error) {
+
+	args := []interface{}{ctx, _TerminateRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "TerminateWorkflowExecutionAsync", args...)
+	success, _ = ret[i].(*shared.TerminateWorkflowExecutionAsyncResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) TerminateWorkflowExecutionAsync(
+	ctx interface{},
+	_TerminateRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _TerminateRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "TerminateWorkflowExecutionAsync", args...)
+}
+
 // UpdateDomain responds to a UpdateDomain call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1494,3 +1725,36 @@ func (mr *_MockClientRecorder) UpdateDomain(
 	args := append([]interface{}{ctx, _UpdateRequest}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateDomain", args...)
 }
+
+// UpdateWorkflowMemo responds to a UpdateWorkflowMemo call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().UpdateWorkflowMemo(gomock.Any(), ...).Return(...)
+//	... := client.UpdateWorkflowMemo(...)
+func (m *MockClient) UpdateWorkflowMemo(
+	ctx context.Context,
+	_UpdateRequest *shared.UpdateWorkflowMemoRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateWorkflowMemoResponse, err error) {
+
+	args := []interface{}{ctx, _UpdateRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateWorkflowMemo", args...)
+	success, _ = ret[i].(*shared.UpdateWorkflowMemoResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateWorkflowMemo(
+	ctx interface{},
+	_UpdateRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _UpdateRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateWorkflowMemo", args...)
+}
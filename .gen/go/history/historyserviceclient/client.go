@@ -49,6 +49,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeWorkflowExecutionResponse, error)
 
+	GetCompletionCallbacks(
+		ctx context.Context,
+		GetRequest *history.GetCompletionCallbacksRequest,
+		opts ...yarpc.CallOption,
+	) (*history.GetCompletionCallbacksResponse, error)
+
 	GetCrossClusterTasks(
 		ctx context.Context,
 		Request *shared.GetCrossClusterTasksRequest,
@@ -145,6 +151,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	RecordCompletionCallbackState(
+		ctx context.Context,
+		RecordRequest *history.RecordCompletionCallbackStateRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RecordDecisionTaskStarted(
 		ctx context.Context,
 		AddRequest *history.RecordDecisionTaskStartedRequest,
@@ -276,6 +288,12 @@ type Interface interface {
 		TerminateRequest *history.TerminateWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	UpdateWorkflowMemo(
+		ctx context.Context,
+		UpdateRequest *history.UpdateWorkflowMemoRequest,
+		opts ...yarpc.CallOption,
+	) error
 }
 
 // New builds a new client for the HistoryService service.
@@ -447,6 +465,34 @@ func (c client) DescribeWorkflowExecution(
 	return
 }
 
+func (c client) GetCompletionCallbacks(
+	ctx context.Context,
+	_GetRequest *history.GetCompletionCallbacksRequest,
+	opts ...yarpc.CallOption,
+) (success *history.GetCompletionCallbacksResponse, err error) {
+
+	var result history.HistoryService_GetCompletionCallbacks_Result
+	args := history.HistoryService_GetCompletionCallbacks_Helper.Args(_GetRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = history.HistoryService_GetCompletionCallbacks_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetCrossClusterTasks(
 	ctx context.Context,
 	_Request *shared.GetCrossClusterTasksRequest,
@@ -895,6 +941,34 @@ func (c client) RecordChildExecutionCompleted(
 	return
 }
 
+func (c client) RecordCompletionCallbackState(
+	ctx context.Context,
+	_RecordRequest *history.RecordCompletionCallbackStateRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_RecordCompletionCallbackState_Result
+	args := history.HistoryService_RecordCompletionCallbackState_Helper.Args(_RecordRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_RecordCompletionCallbackState_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RecordDecisionTaskStarted(
 	ctx context.Context,
 	_AddRequest *history.RecordDecisionTaskStartedRequest,
@@ -1510,3 +1584,31 @@ func (c client) TerminateWorkflowExecution(
 	err = history.HistoryService_TerminateWorkflowExecution_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UpdateWorkflowMemo(
+	ctx context.Context,
+	_UpdateRequest *history.UpdateWorkflowMemoRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_UpdateWorkflowMemo_Result
+	args := history.HistoryService_UpdateWorkflowMemo_Helper.Args(_UpdateRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_UpdateWorkflowMemo_Helper.UnwrapResponse(&result)
+	return
+}
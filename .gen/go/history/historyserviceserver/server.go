@@ -44,6 +44,11 @@ type Interface interface {
 		DescribeRequest *history.DescribeWorkflowExecutionRequest,
 	) (*shared.DescribeWorkflowExecutionResponse, error)
 
+	GetCompletionCallbacks(
+		ctx context.Context,
+		GetRequest *history.GetCompletionCallbacksRequest,
+	) (*history.GetCompletionCallbacksResponse, error)
+
 	GetCrossClusterTasks(
 		ctx context.Context,
 		Request *shared.GetCrossClusterTasksRequest,
@@ -124,6 +129,11 @@ type Interface interface {
 		CompletionRequest *history.RecordChildExecutionCompletedRequest,
 	) error
 
+	RecordCompletionCallbackState(
+		ctx context.Context,
+		RecordRequest *history.RecordCompletionCallbackStateRequest,
+	) error
+
 	RecordDecisionTaskStarted(
 		ctx context.Context,
 		AddRequest *history.RecordDecisionTaskStartedRequest,
@@ -233,6 +243,11 @@ type Interface interface {
 		ctx context.Context,
 		TerminateRequest *history.TerminateWorkflowExecutionRequest,
 	) error
+
+	UpdateWorkflowMemo(
+		ctx context.Context,
+		UpdateRequest *history.UpdateWorkflowMemoRequest,
+	) error
 }
 
 // New prepares an implementation of the HistoryService service for
@@ -306,6 +321,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "GetCompletionCallbacks",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetCompletionCallbacks),
+					NoWire: getcompletioncallbacks_NoWireHandler{impl},
+				},
+				Signature:    "GetCompletionCallbacks(GetRequest *history.GetCompletionCallbacksRequest) (*history.GetCompletionCallbacksResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetCrossClusterTasks",
 				HandlerSpec: thrift.HandlerSpec{
@@ -498,6 +525,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RecordCompletionCallbackState",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.RecordCompletionCallbackState),
+					NoWire: recordcompletioncallbackstate_NoWireHandler{impl},
+				},
+				Signature:    "RecordCompletionCallbackState(RecordRequest *history.RecordCompletionCallbackStateRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RecordDecisionTaskStarted",
 				HandlerSpec: thrift.HandlerSpec{
@@ -761,10 +800,22 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "TerminateWorkflowExecution(TerminateRequest *history.TerminateWorkflowExecutionRequest)",
 				ThriftModule: history.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "UpdateWorkflowMemo",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.UpdateWorkflowMemo),
+					NoWire: updateworkflowmemo_NoWireHandler{impl},
+				},
+				Signature:    "UpdateWorkflowMemo(UpdateRequest *history.UpdateWorkflowMemoRequest)",
+				ThriftModule: history.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 43)
+	procedures := make([]transport.Procedure, 0, 46)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -925,6 +976,36 @@ func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value)
 	return response, err
 }
 
+func (h handler) GetCompletionCallbacks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_GetCompletionCallbacks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'GetCompletionCallbacks': %w", err)
+	}
+
+	success, appErr := h.impl.GetCompletionCallbacks(ctx, args.GetRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_GetCompletionCallbacks_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) GetCrossClusterTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_GetCrossClusterTasks_Args
 	if err := args.FromWire(body); err != nil {
@@ -1405,6 +1486,36 @@ func (h handler) RecordChildExecutionCompleted(ctx context.Context, body wire.Va
 	return response, err
 }
 
+func (h handler) RecordCompletionCallbackState(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RecordCompletionCallbackState_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'RecordCompletionCallbackState': %w", err)
+	}
+
+	appErr := h.impl.RecordCompletionCallbackState(ctx, args.RecordRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_RecordCompletionCallbackState_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) RecordDecisionTaskStarted(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_RecordDecisionTaskStarted_Args
 	if err := args.FromWire(body); err != nil {
@@ -2065,6 +2176,36 @@ func (h handler) TerminateWorkflowExecution(ctx context.Context, body wire.Value
 	return response, err
 }
 
+func (h handler) UpdateWorkflowMemo(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_UpdateWorkflowMemo_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'UpdateWorkflowMemo': %w", err)
+	}
+
+	appErr := h.impl.UpdateWorkflowMemo(ctx, args.UpdateRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_UpdateWorkflowMemo_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 type closeshard_NoWireHandler struct{ impl Interface }
 
 func (h closeshard_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2250,6 +2391,43 @@ func (h describeworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Contex
 
 }
 
+type getcompletioncallbacks_NoWireHandler struct{ impl Interface }
+
+func (h getcompletioncallbacks_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_GetCompletionCallbacks_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'GetCompletionCallbacks': %w", err)
+	}
+
+	success, appErr := h.impl.GetCompletionCallbacks(ctx, args.GetRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_GetCompletionCallbacks_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type getcrossclustertasks_NoWireHandler struct{ impl Interface }
 
 func (h getcrossclustertasks_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2842,6 +3020,43 @@ func (h recordchildexecutioncompleted_NoWireHandler) HandleNoWire(ctx context.Co
 
 }
 
+type recordcompletioncallbackstate_NoWireHandler struct{ impl Interface }
+
+func (h recordcompletioncallbackstate_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_RecordCompletionCallbackState_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'RecordCompletionCallbackState': %w", err)
+	}
+
+	appErr := h.impl.RecordCompletionCallbackState(ctx, args.RecordRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_RecordCompletionCallbackState_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type recorddecisiontaskstarted_NoWireHandler struct{ impl Interface }
 
 func (h recorddecisiontaskstarted_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -3655,3 +3870,40 @@ func (h terminateworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Conte
 	return response, err
 
 }
+
+type updateworkflowmemo_NoWireHandler struct{ impl Interface }
+
+func (h updateworkflowmemo_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_UpdateWorkflowMemo_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'UpdateWorkflowMemo': %w", err)
+	}
+
+	appErr := h.impl.UpdateWorkflowMemo(ctx, args.UpdateRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_UpdateWorkflowMemo_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
@@ -209,6 +209,39 @@ func (mr *_MockClientRecorder) DescribeWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeWorkflowExecution", args...)
 }
 
+// GetCompletionCallbacks responds to a GetCompletionCallbacks call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().GetCompletionCallbacks(gomock.Any(), ...).Return(...)
+//	... := client.GetCompletionCallbacks(...)
+func (m *MockClient) GetCompletionCallbacks(
+	ctx context.Context,
+	_GetRequest *history.GetCompletionCallbacksRequest,
+	opts ...yarpc.CallOption,
+) (success *history.GetCompletionCallbacksResponse, err error) {
+
+	args := []interface{}{ctx, _GetRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetCompletionCallbacks", args...)
+	success, _ = ret[i].(*history.GetCompletionCallbacksResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetCompletionCallbacks(
+	ctx interface{},
+	_GetRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _GetRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetCompletionCallbacks", args...)
+}
+
 // GetCrossClusterTasks responds to a GetCrossClusterTasks call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -729,6 +762,37 @@ func (mr *_MockClientRecorder) RecordChildExecutionCompleted(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RecordChildExecutionCompleted", args...)
 }
 
+// RecordCompletionCallbackState responds to a RecordCompletionCallbackState call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().RecordCompletionCallbackState(gomock.Any(), ...).Return(...)
+//	... := client.RecordCompletionCallbackState(...)
+func (m *MockClient) RecordCompletionCallbackState(
+	ctx context.Context,
+	_RecordRequest *history.RecordCompletionCallbackStateRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _RecordRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RecordCompletionCallbackState", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RecordCompletionCallbackState(
+	ctx interface{},
+	_RecordRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _RecordRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RecordCompletionCallbackState", args...)
+}
+
 // RecordDecisionTaskStarted responds to a RecordDecisionTaskStarted call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1424,3 +1488,34 @@ func (mr *_MockClientRecorder) TerminateWorkflowExecution(
 	args := append([]interface{}{ctx, _TerminateRequest}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "TerminateWorkflowExecution", args...)
 }
+
+// UpdateWorkflowMemo responds to a UpdateWorkflowMemo call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().UpdateWorkflowMemo(gomock.Any(), ...).Return(...)
+//	... := client.UpdateWorkflowMemo(...)
+func (m *MockClient) UpdateWorkflowMemo(
+	ctx context.Context,
+	_UpdateRequest *history.UpdateWorkflowMemoRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _UpdateRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateWorkflowMemo", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateWorkflowMemo(
+	ctx interface{},
+	_UpdateRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _UpdateRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateWorkflowMemo", args...)
+}
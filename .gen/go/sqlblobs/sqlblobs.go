@@ -2309,6 +2309,8 @@ type AsyncRequestMessage struct {
 	Header       *shared.Header    `json:"header,omitempty"`
 	Encoding     *string           `json:"encoding,omitempty"`
 	Payload      []byte            `json:"payload,omitempty"`
+	RequestID    *string           `json:"requestID,omitempty"`
+	DomainID     *string           `json:"domainID,omitempty"`
 }
 
 // ToWire translates a AsyncRequestMessage struct into a Thrift-level intermediate
@@ -2328,7 +2330,7 @@ type AsyncRequestMessage struct {
 //	}
 func (v *AsyncRequestMessage) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -2374,6 +2376,22 @@ func (v *AsyncRequestMessage) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 18, Value: w}
 		i++
 	}
+	if v.RequestID != nil {
+		w, err = wire.NewValueString(*(v.RequestID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.DomainID != nil {
+		w, err = wire.NewValueString(*(v.DomainID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 22, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -2457,6 +2475,26 @@ func (v *AsyncRequestMessage) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 22:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainID = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -2533,6 +2571,30 @@ func (v *AsyncRequestMessage) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.RequestID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.RequestID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DomainID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 22, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.DomainID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -2602,6 +2664,22 @@ func (v *AsyncRequestMessage) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.RequestID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 22 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.DomainID = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -2631,7 +2709,7 @@ func (v *AsyncRequestMessage) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [7]string
 	i := 0
 	if v.PartitionKey != nil {
 		fields[i] = fmt.Sprintf("PartitionKey: %v", *(v.PartitionKey))
@@ -2653,6 +2731,14 @@ func (v *AsyncRequestMessage) String() string {
 		fields[i] = fmt.Sprintf("Payload: %v", v.Payload)
 		i++
 	}
+	if v.RequestID != nil {
+		fields[i] = fmt.Sprintf("RequestID: %v", *(v.RequestID))
+		i++
+	}
+	if v.DomainID != nil {
+		fields[i] = fmt.Sprintf("DomainID: %v", *(v.DomainID))
+		i++
+	}
 
 	return fmt.Sprintf("AsyncRequestMessage{%v}", strings.Join(fields[:i], ", "))
 }
@@ -2692,6 +2778,12 @@ func (v *AsyncRequestMessage) Equals(rhs *AsyncRequestMessage) bool {
 	if !((v.Payload == nil && rhs.Payload == nil) || (v.Payload != nil && rhs.Payload != nil && bytes.Equal(v.Payload, rhs.Payload))) {
 		return false
 	}
+	if !_String_EqualsPtr(v.RequestID, rhs.RequestID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainID, rhs.DomainID) {
+		return false
+	}
 
 	return true
 }
@@ -2717,6 +2809,12 @@ func (v *AsyncRequestMessage) MarshalLogObject(enc zapcore.ObjectEncoder) (err e
 	if v.Payload != nil {
 		enc.AddString("payload", base64.StdEncoding.EncodeToString(v.Payload))
 	}
+	if v.RequestID != nil {
+		enc.AddString("requestID", *v.RequestID)
+	}
+	if v.DomainID != nil {
+		enc.AddString("domainID", *v.DomainID)
+	}
 	return err
 }
 
@@ -2795,11 +2893,44 @@ func (v *AsyncRequestMessage) IsSetPayload() bool {
 	return v != nil && v.Payload != nil
 }
 
+// GetRequestID returns the value of RequestID if it is set or its
+// zero value if it is unset.
+func (v *AsyncRequestMessage) GetRequestID() (o string) {
+	if v != nil && v.RequestID != nil {
+		return *v.RequestID
+	}
+
+	return
+}
+
+// IsSetRequestID returns true if RequestID is not nil.
+func (v *AsyncRequestMessage) IsSetRequestID() bool {
+	return v != nil && v.RequestID != nil
+}
+
+// GetDomainID returns the value of DomainID if it is set or its
+// zero value if it is unset.
+func (v *AsyncRequestMessage) GetDomainID() (o string) {
+	if v != nil && v.DomainID != nil {
+		return *v.DomainID
+	}
+
+	return
+}
+
+// IsSetDomainID returns true if DomainID is not nil.
+func (v *AsyncRequestMessage) IsSetDomainID() bool {
+	return v != nil && v.DomainID != nil
+}
+
 type AsyncRequestType int32
 
 const (
 	AsyncRequestTypeStartWorkflowExecutionAsyncRequest           AsyncRequestType = 0
 	AsyncRequestTypeSignalWithStartWorkflowExecutionAsyncRequest AsyncRequestType = 1
+	AsyncRequestTypeSignalWorkflowExecutionAsyncRequest          AsyncRequestType = 2
+	AsyncRequestTypeRequestCancelWorkflowExecutionAsyncRequest   AsyncRequestType = 3
+	AsyncRequestTypeTerminateWorkflowExecutionAsyncRequest       AsyncRequestType = 4
 )
 
 // AsyncRequestType_Values returns all recognized values of AsyncRequestType.
@@ -2807,6 +2938,9 @@ func AsyncRequestType_Values() []AsyncRequestType {
 	return []AsyncRequestType{
 		AsyncRequestTypeStartWorkflowExecutionAsyncRequest,
 		AsyncRequestTypeSignalWithStartWorkflowExecutionAsyncRequest,
+		AsyncRequestTypeSignalWorkflowExecutionAsyncRequest,
+		AsyncRequestTypeRequestCancelWorkflowExecutionAsyncRequest,
+		AsyncRequestTypeTerminateWorkflowExecutionAsyncRequest,
 	}
 }
 
@@ -2823,6 +2957,15 @@ func (v *AsyncRequestType) UnmarshalText(value []byte) error {
 	case "SignalWithStartWorkflowExecutionAsyncRequest":
 		*v = AsyncRequestTypeSignalWithStartWorkflowExecutionAsyncRequest
 		return nil
+	case "SignalWorkflowExecutionAsyncRequest":
+		*v = AsyncRequestTypeSignalWorkflowExecutionAsyncRequest
+		return nil
+	case "RequestCancelWorkflowExecutionAsyncRequest":
+		*v = AsyncRequestTypeRequestCancelWorkflowExecutionAsyncRequest
+		return nil
+	case "TerminateWorkflowExecutionAsyncRequest":
+		*v = AsyncRequestTypeTerminateWorkflowExecutionAsyncRequest
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -2845,6 +2988,12 @@ func (v AsyncRequestType) MarshalText() ([]byte, error) {
 		return []byte("StartWorkflowExecutionAsyncRequest"), nil
 	case 1:
 		return []byte("SignalWithStartWorkflowExecutionAsyncRequest"), nil
+	case 2:
+		return []byte("SignalWorkflowExecutionAsyncRequest"), nil
+	case 3:
+		return []byte("RequestCancelWorkflowExecutionAsyncRequest"), nil
+	case 4:
+		return []byte("TerminateWorkflowExecutionAsyncRequest"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -2860,6 +3009,12 @@ func (v AsyncRequestType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 		enc.AddString("name", "StartWorkflowExecutionAsyncRequest")
 	case 1:
 		enc.AddString("name", "SignalWithStartWorkflowExecutionAsyncRequest")
+	case 2:
+		enc.AddString("name", "SignalWorkflowExecutionAsyncRequest")
+	case 3:
+		enc.AddString("name", "RequestCancelWorkflowExecutionAsyncRequest")
+	case 4:
+		enc.AddString("name", "TerminateWorkflowExecutionAsyncRequest")
 	}
 	return nil
 }
@@ -2932,6 +3087,12 @@ func (v AsyncRequestType) String() string {
 		return "StartWorkflowExecutionAsyncRequest"
 	case 1:
 		return "SignalWithStartWorkflowExecutionAsyncRequest"
+	case 2:
+		return "SignalWorkflowExecutionAsyncRequest"
+	case 3:
+		return "RequestCancelWorkflowExecutionAsyncRequest"
+	case 4:
+		return "TerminateWorkflowExecutionAsyncRequest"
 	}
 	return fmt.Sprintf("AsyncRequestType(%d)", w)
 }
@@ -2954,6 +3115,12 @@ func (v AsyncRequestType) MarshalJSON() ([]byte, error) {
 		return ([]byte)("\"StartWorkflowExecutionAsyncRequest\""), nil
 	case 1:
 		return ([]byte)("\"SignalWithStartWorkflowExecutionAsyncRequest\""), nil
+	case 2:
+		return ([]byte)("\"SignalWorkflowExecutionAsyncRequest\""), nil
+	case 3:
+		return ([]byte)("\"RequestCancelWorkflowExecutionAsyncRequest\""), nil
+	case 4:
+		return ([]byte)("\"TerminateWorkflowExecutionAsyncRequest\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -10774,11 +10941,13 @@ func (v *TaskInfo) IsSetPartitionConfig() bool {
 }
 
 type TaskListInfo struct {
-	Kind                    *int16                   `json:"kind,omitempty"`
-	AckLevel                *int64                   `json:"ackLevel,omitempty"`
-	ExpiryTimeNanos         *int64                   `json:"expiryTimeNanos,omitempty"`
-	LastUpdatedNanos        *int64                   `json:"lastUpdatedNanos,omitempty"`
-	AdaptivePartitionConfig *TaskListPartitionConfig `json:"adaptivePartitionConfig,omitempty"`
+	Kind                    *int16                    `json:"kind,omitempty"`
+	AckLevel                *int64                    `json:"ackLevel,omitempty"`
+	ExpiryTimeNanos         *int64                    `json:"expiryTimeNanos,omitempty"`
+	LastUpdatedNanos        *int64                    `json:"lastUpdatedNanos,omitempty"`
+	AdaptivePartitionConfig *TaskListPartitionConfig  `json:"adaptivePartitionConfig,omitempty"`
+	VersioningConfig        *TaskListVersioningConfig `json:"versioningConfig,omitempty"`
+	MigrationConfig         *TaskListMigrationConfig  `json:"migrationConfig,omitempty"`
 }
 
 // ToWire translates a TaskListInfo struct into a Thrift-level intermediate
@@ -10798,7 +10967,7 @@ type TaskListInfo struct {
 //	}
 func (v *TaskListInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -10844,6 +11013,22 @@ func (v *TaskListInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 18, Value: w}
 		i++
 	}
+	if v.VersioningConfig != nil {
+		w, err = v.VersioningConfig.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.MigrationConfig != nil {
+		w, err = v.MigrationConfig.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 22, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -10854,6 +11039,18 @@ func _TaskListPartitionConfig_Read(w wire.Value) (*TaskListPartitionConfig, erro
 	return &v, err
 }
 
+func _TaskListVersioningConfig_Read(w wire.Value) (*TaskListVersioningConfig, error) {
+	var v TaskListVersioningConfig
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _TaskListMigrationConfig_Read(w wire.Value) (*TaskListMigrationConfig, error) {
+	var v TaskListMigrationConfig
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a TaskListInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -10923,6 +11120,22 @@ func (v *TaskListInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.VersioningConfig, err = _TaskListVersioningConfig_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 22:
+			if field.Value.Type() == wire.TStruct {
+				v.MigrationConfig, err = _TaskListMigrationConfig_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -10999,6 +11212,30 @@ func (v *TaskListInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.VersioningConfig != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.VersioningConfig.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.MigrationConfig != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 22, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.MigrationConfig.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -11008,6 +11245,18 @@ func _TaskListPartitionConfig_Decode(sr stream.Reader) (*TaskListPartitionConfig
 	return &v, err
 }
 
+func _TaskListVersioningConfig_Decode(sr stream.Reader) (*TaskListVersioningConfig, error) {
+	var v TaskListVersioningConfig
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _TaskListMigrationConfig_Decode(sr stream.Reader) (*TaskListMigrationConfig, error) {
+	var v TaskListMigrationConfig
+	err := v.Decode(sr)
+	return &v, err
+}
+
 // Decode deserializes a TaskListInfo struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -11064,6 +11313,18 @@ func (v *TaskListInfo) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.VersioningConfig, err = _TaskListVersioningConfig_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 22 && fh.Type == wire.TStruct:
+			v.MigrationConfig, err = _TaskListMigrationConfig_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -11093,7 +11354,7 @@ func (v *TaskListInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [7]string
 	i := 0
 	if v.Kind != nil {
 		fields[i] = fmt.Sprintf("Kind: %v", *(v.Kind))
@@ -11115,6 +11376,14 @@ func (v *TaskListInfo) String() string {
 		fields[i] = fmt.Sprintf("AdaptivePartitionConfig: %v", v.AdaptivePartitionConfig)
 		i++
 	}
+	if v.VersioningConfig != nil {
+		fields[i] = fmt.Sprintf("VersioningConfig: %v", v.VersioningConfig)
+		i++
+	}
+	if v.MigrationConfig != nil {
+		fields[i] = fmt.Sprintf("MigrationConfig: %v", v.MigrationConfig)
+		i++
+	}
 
 	return fmt.Sprintf("TaskListInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -11144,6 +11413,12 @@ func (v *TaskListInfo) Equals(rhs *TaskListInfo) bool {
 	if !((v.AdaptivePartitionConfig == nil && rhs.AdaptivePartitionConfig == nil) || (v.AdaptivePartitionConfig != nil && rhs.AdaptivePartitionConfig != nil && v.AdaptivePartitionConfig.Equals(rhs.AdaptivePartitionConfig))) {
 		return false
 	}
+	if !((v.VersioningConfig == nil && rhs.VersioningConfig == nil) || (v.VersioningConfig != nil && rhs.VersioningConfig != nil && v.VersioningConfig.Equals(rhs.VersioningConfig))) {
+		return false
+	}
+	if !((v.MigrationConfig == nil && rhs.MigrationConfig == nil) || (v.MigrationConfig != nil && rhs.MigrationConfig != nil && v.MigrationConfig.Equals(rhs.MigrationConfig))) {
+		return false
+	}
 
 	return true
 }
@@ -11169,6 +11444,12 @@ func (v *TaskListInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.AdaptivePartitionConfig != nil {
 		err = multierr.Append(err, enc.AddObject("adaptivePartitionConfig", v.AdaptivePartitionConfig))
 	}
+	if v.VersioningConfig != nil {
+		err = multierr.Append(err, enc.AddObject("versioningConfig", v.VersioningConfig))
+	}
+	if v.MigrationConfig != nil {
+		err = multierr.Append(err, enc.AddObject("migrationConfig", v.MigrationConfig))
+	}
 	return err
 }
 
@@ -11247,11 +11528,42 @@ func (v *TaskListInfo) IsSetAdaptivePartitionConfig() bool {
 	return v != nil && v.AdaptivePartitionConfig != nil
 }
 
-type TaskListPartition struct {
-	IsolationGroups []string `json:"isolationGroups,omitempty"`
+// GetVersioningConfig returns the value of VersioningConfig if it is set or its
+// zero value if it is unset.
+func (v *TaskListInfo) GetVersioningConfig() (o *TaskListVersioningConfig) {
+	if v != nil && v.VersioningConfig != nil {
+		return v.VersioningConfig
+	}
+
+	return
 }
 
-// ToWire translates a TaskListPartition struct into a Thrift-level intermediate
+// IsSetVersioningConfig returns true if VersioningConfig is not nil.
+func (v *TaskListInfo) IsSetVersioningConfig() bool {
+	return v != nil && v.VersioningConfig != nil
+}
+
+// GetMigrationConfig returns the value of MigrationConfig if it is set or its
+// zero value if it is unset.
+func (v *TaskListInfo) GetMigrationConfig() (o *TaskListMigrationConfig) {
+	if v != nil && v.MigrationConfig != nil {
+		return v.MigrationConfig
+	}
+
+	return
+}
+
+// IsSetMigrationConfig returns true if MigrationConfig is not nil.
+func (v *TaskListInfo) IsSetMigrationConfig() bool {
+	return v != nil && v.MigrationConfig != nil
+}
+
+type TaskListMigrationConfig struct {
+	TargetTaskList *string `json:"targetTaskList,omitempty"`
+	ActivityAlias  *bool   `json:"activityAlias,omitempty"`
+}
+
+// ToWire translates a TaskListMigrationConfig struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -11266,31 +11578,39 @@ type TaskListPartition struct {
 //	if err := binaryProtocol.Encode(x, writer); err != nil {
 //	  return err
 //	}
-func (v *TaskListPartition) ToWire() (wire.Value, error) {
+func (v *TaskListMigrationConfig) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.IsolationGroups != nil {
-		w, err = wire.NewValueList(_List_String_ValueList(v.IsolationGroups)), error(nil)
+	if v.TargetTaskList != nil {
+		w, err = wire.NewValueString(*(v.TargetTaskList)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.ActivityAlias != nil {
+		w, err = wire.NewValueBool(*(v.ActivityAlias)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 12, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a TaskListPartition struct from its Thrift-level
+// FromWire deserializes a TaskListMigrationConfig struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a TaskListPartition struct
+// An error is returned if we were unable to build a TaskListMigrationConfig struct
 // from the provided intermediate representation.
 //
 //	x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -11298,19 +11618,31 @@ func (v *TaskListPartition) ToWire() (wire.Value, error) {
 //	  return nil, err
 //	}
 //
-//	var v TaskListPartition
+//	var v TaskListMigrationConfig
 //	if err := v.FromWire(x); err != nil {
 //	  return nil, err
 //	}
 //	return &v, nil
-func (v *TaskListPartition) FromWire(w wire.Value) error {
+func (v *TaskListMigrationConfig) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TList {
-				v.IsolationGroups, err = _List_String_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.TargetTaskList = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 12:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.ActivityAlias = &x
 				if err != nil {
 					return err
 				}
@@ -11322,10 +11654,263 @@ func (v *TaskListPartition) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a TaskListPartition struct directly into bytes, without going
+// Encode serializes a TaskListMigrationConfig struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a TaskListPartition struct could not be encoded.
+// An error is returned if a TaskListMigrationConfig struct could not be encoded.
+func (v *TaskListMigrationConfig) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.TargetTaskList != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.TargetTaskList)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ActivityAlias != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 12, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.ActivityAlias)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a TaskListMigrationConfig struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a TaskListMigrationConfig struct could not be generated from the wire
+// representation.
+func (v *TaskListMigrationConfig) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.TargetTaskList = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 12 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.ActivityAlias = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a TaskListMigrationConfig
+// struct.
+func (v *TaskListMigrationConfig) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.TargetTaskList != nil {
+		fields[i] = fmt.Sprintf("TargetTaskList: %v", *(v.TargetTaskList))
+		i++
+	}
+	if v.ActivityAlias != nil {
+		fields[i] = fmt.Sprintf("ActivityAlias: %v", *(v.ActivityAlias))
+		i++
+	}
+
+	return fmt.Sprintf("TaskListMigrationConfig{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this TaskListMigrationConfig match the
+// provided TaskListMigrationConfig.
+//
+// This function performs a deep comparison.
+func (v *TaskListMigrationConfig) Equals(rhs *TaskListMigrationConfig) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.TargetTaskList, rhs.TargetTaskList) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.ActivityAlias, rhs.ActivityAlias) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TaskListMigrationConfig.
+func (v *TaskListMigrationConfig) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.TargetTaskList != nil {
+		enc.AddString("targetTaskList", *v.TargetTaskList)
+	}
+	if v.ActivityAlias != nil {
+		enc.AddBool("activityAlias", *v.ActivityAlias)
+	}
+	return err
+}
+
+// GetTargetTaskList returns the value of TargetTaskList if it is set or its
+// zero value if it is unset.
+func (v *TaskListMigrationConfig) GetTargetTaskList() (o string) {
+	if v != nil && v.TargetTaskList != nil {
+		return *v.TargetTaskList
+	}
+
+	return
+}
+
+// IsSetTargetTaskList returns true if TargetTaskList is not nil.
+func (v *TaskListMigrationConfig) IsSetTargetTaskList() bool {
+	return v != nil && v.TargetTaskList != nil
+}
+
+// GetActivityAlias returns the value of ActivityAlias if it is set or its
+// zero value if it is unset.
+func (v *TaskListMigrationConfig) GetActivityAlias() (o bool) {
+	if v != nil && v.ActivityAlias != nil {
+		return *v.ActivityAlias
+	}
+
+	return
+}
+
+// IsSetActivityAlias returns true if ActivityAlias is not nil.
+func (v *TaskListMigrationConfig) IsSetActivityAlias() bool {
+	return v != nil && v.ActivityAlias != nil
+}
+
+type TaskListPartition struct {
+	IsolationGroups []string `json:"isolationGroups,omitempty"`
+}
+
+// ToWire translates a TaskListPartition struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *TaskListPartition) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.IsolationGroups != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.IsolationGroups)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a TaskListPartition struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a TaskListPartition struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v TaskListPartition
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *TaskListPartition) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.IsolationGroups, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a TaskListPartition struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a TaskListPartition struct could not be encoded.
 func (v *TaskListPartition) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
@@ -12043,57 +12628,451 @@ func (v *TaskListPartitionConfig) GetNumReadPartitions() (o int32) {
 		return *v.NumReadPartitions
 	}
 
-	return
+	return
+}
+
+// IsSetNumReadPartitions returns true if NumReadPartitions is not nil.
+func (v *TaskListPartitionConfig) IsSetNumReadPartitions() bool {
+	return v != nil && v.NumReadPartitions != nil
+}
+
+// GetNumWritePartitions returns the value of NumWritePartitions if it is set or its
+// zero value if it is unset.
+func (v *TaskListPartitionConfig) GetNumWritePartitions() (o int32) {
+	if v != nil && v.NumWritePartitions != nil {
+		return *v.NumWritePartitions
+	}
+
+	return
+}
+
+// IsSetNumWritePartitions returns true if NumWritePartitions is not nil.
+func (v *TaskListPartitionConfig) IsSetNumWritePartitions() bool {
+	return v != nil && v.NumWritePartitions != nil
+}
+
+// GetReadPartitions returns the value of ReadPartitions if it is set or its
+// zero value if it is unset.
+func (v *TaskListPartitionConfig) GetReadPartitions() (o map[int32]*TaskListPartition) {
+	if v != nil && v.ReadPartitions != nil {
+		return v.ReadPartitions
+	}
+
+	return
+}
+
+// IsSetReadPartitions returns true if ReadPartitions is not nil.
+func (v *TaskListPartitionConfig) IsSetReadPartitions() bool {
+	return v != nil && v.ReadPartitions != nil
+}
+
+// GetWritePartitions returns the value of WritePartitions if it is set or its
+// zero value if it is unset.
+func (v *TaskListPartitionConfig) GetWritePartitions() (o map[int32]*TaskListPartition) {
+	if v != nil && v.WritePartitions != nil {
+		return v.WritePartitions
+	}
+
+	return
+}
+
+// IsSetWritePartitions returns true if WritePartitions is not nil.
+func (v *TaskListPartitionConfig) IsSetWritePartitions() bool {
+	return v != nil && v.WritePartitions != nil
+}
+
+type TaskListVersioningConfig struct {
+	Version        *int64     `json:"version,omitempty"`
+	CompatibleSets [][]string `json:"compatibleSets,omitempty"`
+}
+
+type _List_List_String_ValueList [][]string
+
+func (v _List_List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[][]string', index [%v]: value is nil", i)
+		}
+		w, err := wire.NewValueList(_List_String_ValueList(x)), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_List_String_ValueList) ValueType() wire.Type {
+	return wire.TList
+}
+
+func (_List_List_String_ValueList) Close() {}
+
+// ToWire translates a TaskListVersioningConfig struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//	x, err := v.ToWire()
+//	if err != nil {
+//	  return err
+//	}
+//
+//	if err := binaryProtocol.Encode(x, writer); err != nil {
+//	  return err
+//	}
+func (v *TaskListVersioningConfig) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Version != nil {
+		w, err = wire.NewValueI64(*(v.Version)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.CompatibleSets != nil {
+		w, err = wire.NewValueList(_List_List_String_ValueList(v.CompatibleSets)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 12, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_List_String_Read(l wire.ValueList) ([][]string, error) {
+	if l.ValueType() != wire.TList {
+		return nil, nil
+	}
+
+	o := make([][]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _List_String_Read(x.GetList())
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a TaskListVersioningConfig struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a TaskListVersioningConfig struct
+// from the provided intermediate representation.
+//
+//	x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//	if err != nil {
+//	  return nil, err
+//	}
+//
+//	var v TaskListVersioningConfig
+//	if err := v.FromWire(x); err != nil {
+//	  return nil, err
+//	}
+//	return &v, nil
+func (v *TaskListVersioningConfig) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Version = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 12:
+			if field.Value.Type() == wire.TList {
+				v.CompatibleSets, err = _List_List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_List_String_Encode(val [][]string, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TList,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[][]string', index [%v]: value is nil", i)
+		}
+		if err := _List_String_Encode(v, sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a TaskListVersioningConfig struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a TaskListVersioningConfig struct could not be encoded.
+func (v *TaskListVersioningConfig) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Version != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Version)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.CompatibleSets != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 12, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_List_String_Encode(v.CompatibleSets, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _List_List_String_Decode(sr stream.Reader) ([][]string, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TList {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([][]string, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _List_String_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a TaskListVersioningConfig struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a TaskListVersioningConfig struct could not be generated from the wire
+// representation.
+func (v *TaskListVersioningConfig) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Version = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 12 && fh.Type == wire.TList:
+			v.CompatibleSets, err = _List_List_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a TaskListVersioningConfig
+// struct.
+func (v *TaskListVersioningConfig) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Version != nil {
+		fields[i] = fmt.Sprintf("Version: %v", *(v.Version))
+		i++
+	}
+	if v.CompatibleSets != nil {
+		fields[i] = fmt.Sprintf("CompatibleSets: %v", v.CompatibleSets)
+		i++
+	}
+
+	return fmt.Sprintf("TaskListVersioningConfig{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_List_String_Equals(lhs, rhs [][]string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !_List_String_Equals(lv, rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this TaskListVersioningConfig match the
+// provided TaskListVersioningConfig.
+//
+// This function performs a deep comparison.
+func (v *TaskListVersioningConfig) Equals(rhs *TaskListVersioningConfig) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Version, rhs.Version) {
+		return false
+	}
+	if !((v.CompatibleSets == nil && rhs.CompatibleSets == nil) || (v.CompatibleSets != nil && rhs.CompatibleSets != nil && _List_List_String_Equals(v.CompatibleSets, rhs.CompatibleSets))) {
+		return false
+	}
+
+	return true
 }
 
-// IsSetNumReadPartitions returns true if NumReadPartitions is not nil.
-func (v *TaskListPartitionConfig) IsSetNumReadPartitions() bool {
-	return v != nil && v.NumReadPartitions != nil
-}
+type _List_List_String_Zapper [][]string
 
-// GetNumWritePartitions returns the value of NumWritePartitions if it is set or its
-// zero value if it is unset.
-func (v *TaskListPartitionConfig) GetNumWritePartitions() (o int32) {
-	if v != nil && v.NumWritePartitions != nil {
-		return *v.NumWritePartitions
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_List_String_Zapper.
+func (l _List_List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendArray((_List_String_Zapper)(v)))
 	}
-
-	return
+	return err
 }
 
-// IsSetNumWritePartitions returns true if NumWritePartitions is not nil.
-func (v *TaskListPartitionConfig) IsSetNumWritePartitions() bool {
-	return v != nil && v.NumWritePartitions != nil
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TaskListVersioningConfig.
+func (v *TaskListVersioningConfig) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Version != nil {
+		enc.AddInt64("version", *v.Version)
+	}
+	if v.CompatibleSets != nil {
+		err = multierr.Append(err, enc.AddArray("compatibleSets", (_List_List_String_Zapper)(v.CompatibleSets)))
+	}
+	return err
 }
 
-// GetReadPartitions returns the value of ReadPartitions if it is set or its
+// GetVersion returns the value of Version if it is set or its
 // zero value if it is unset.
-func (v *TaskListPartitionConfig) GetReadPartitions() (o map[int32]*TaskListPartition) {
-	if v != nil && v.ReadPartitions != nil {
-		return v.ReadPartitions
+func (v *TaskListVersioningConfig) GetVersion() (o int64) {
+	if v != nil && v.Version != nil {
+		return *v.Version
 	}
 
 	return
 }
 
-// IsSetReadPartitions returns true if ReadPartitions is not nil.
-func (v *TaskListPartitionConfig) IsSetReadPartitions() bool {
-	return v != nil && v.ReadPartitions != nil
+// IsSetVersion returns true if Version is not nil.
+func (v *TaskListVersioningConfig) IsSetVersion() bool {
+	return v != nil && v.Version != nil
 }
 
-// GetWritePartitions returns the value of WritePartitions if it is set or its
+// GetCompatibleSets returns the value of CompatibleSets if it is set or its
 // zero value if it is unset.
-func (v *TaskListPartitionConfig) GetWritePartitions() (o map[int32]*TaskListPartition) {
-	if v != nil && v.WritePartitions != nil {
-		return v.WritePartitions
+func (v *TaskListVersioningConfig) GetCompatibleSets() (o [][]string) {
+	if v != nil && v.CompatibleSets != nil {
+		return v.CompatibleSets
 	}
 
 	return
 }
 
-// IsSetWritePartitions returns true if WritePartitions is not nil.
-func (v *TaskListPartitionConfig) IsSetWritePartitions() bool {
-	return v != nil && v.WritePartitions != nil
+// IsSetCompatibleSets returns true if CompatibleSets is not nil.
+func (v *TaskListVersioningConfig) IsSetCompatibleSets() bool {
+	return v != nil && v.CompatibleSets != nil
 }
 
 type TimerInfo struct {
@@ -14307,6 +15286,11 @@ type WorkflowExecutionInfo struct {
 	CronOverlapPolicy                       *shared.CronOverlapPolicy `json:"cronOverlapPolicy,omitempty"`
 	ActiveClusterSelectionPolicy            []byte                    `json:"activeClusterSelectionPolicy,omitempty"`
 	ActiveClusterSelectionPolicyEncoding    *string                   `json:"activeClusterSelectionPolicyEncoding,omitempty"`
+	CompletionCallbacks                     []byte                    `json:"completionCallbacks,omitempty"`
+	CompletionCallbacksEncoding             *string                   `json:"completionCallbacksEncoding,omitempty"`
+	StickyWorkerIdentity                    *string                   `json:"stickyWorkerIdentity,omitempty"`
+	StickyHitCount                          *int64                    `json:"stickyHitCount,omitempty"`
+	StickyFallbackCount                     *int64                    `json:"stickyFallbackCount,omitempty"`
 }
 
 type _Map_String_Binary_MapItemList map[string][]byte
@@ -14364,7 +15348,7 @@ func (_Map_String_Binary_MapItemList) Close() {}
 //	}
 func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [66]wire.Field
+		fields [71]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -14898,6 +15882,46 @@ func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 138, Value: w}
 		i++
 	}
+	if v.CompletionCallbacks != nil {
+		w, err = wire.NewValueBinary(v.CompletionCallbacks), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 140, Value: w}
+		i++
+	}
+	if v.CompletionCallbacksEncoding != nil {
+		w, err = wire.NewValueString(*(v.CompletionCallbacksEncoding)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 142, Value: w}
+		i++
+	}
+	if v.StickyWorkerIdentity != nil {
+		w, err = wire.NewValueString(*(v.StickyWorkerIdentity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 144, Value: w}
+		i++
+	}
+	if v.StickyHitCount != nil {
+		w, err = wire.NewValueI64(*(v.StickyHitCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 146, Value: w}
+		i++
+	}
+	if v.StickyFallbackCount != nil {
+		w, err = wire.NewValueI64(*(v.StickyFallbackCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 148, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -15595,6 +16619,54 @@ func (v *WorkflowExecutionInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 140:
+			if field.Value.Type() == wire.TBinary {
+				v.CompletionCallbacks, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 142:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.CompletionCallbacksEncoding = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 144:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.StickyWorkerIdentity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 146:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StickyHitCount = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 148:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StickyFallbackCount = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -16429,6 +17501,66 @@ func (v *WorkflowExecutionInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.CompletionCallbacks != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 140, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.CompletionCallbacks); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.CompletionCallbacksEncoding != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 142, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.CompletionCallbacksEncoding)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StickyWorkerIdentity != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 144, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.StickyWorkerIdentity)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StickyHitCount != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 146, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.StickyHitCount)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StickyFallbackCount != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 148, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.StickyFallbackCount)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -17002,6 +18134,44 @@ func (v *WorkflowExecutionInfo) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 140 && fh.Type == wire.TBinary:
+			v.CompletionCallbacks, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 142 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.CompletionCallbacksEncoding = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 144 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.StickyWorkerIdentity = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 146 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.StickyHitCount = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 148 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.StickyFallbackCount = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -17031,7 +18201,7 @@ func (v *WorkflowExecutionInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [66]string
+	var fields [71]string
 	i := 0
 	if v.ParentDomainID != nil {
 		fields[i] = fmt.Sprintf("ParentDomainID: %v", v.ParentDomainID)
@@ -17297,6 +18467,26 @@ func (v *WorkflowExecutionInfo) String() string {
 		fields[i] = fmt.Sprintf("ActiveClusterSelectionPolicyEncoding: %v", *(v.ActiveClusterSelectionPolicyEncoding))
 		i++
 	}
+	if v.CompletionCallbacks != nil {
+		fields[i] = fmt.Sprintf("CompletionCallbacks: %v", v.CompletionCallbacks)
+		i++
+	}
+	if v.CompletionCallbacksEncoding != nil {
+		fields[i] = fmt.Sprintf("CompletionCallbacksEncoding: %v", *(v.CompletionCallbacksEncoding))
+		i++
+	}
+	if v.StickyWorkerIdentity != nil {
+		fields[i] = fmt.Sprintf("StickyWorkerIdentity: %v", *(v.StickyWorkerIdentity))
+		i++
+	}
+	if v.StickyHitCount != nil {
+		fields[i] = fmt.Sprintf("StickyHitCount: %v", *(v.StickyHitCount))
+		i++
+	}
+	if v.StickyFallbackCount != nil {
+		fields[i] = fmt.Sprintf("StickyFallbackCount: %v", *(v.StickyFallbackCount))
+		i++
+	}
 
 	return fmt.Sprintf("WorkflowExecutionInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -17546,6 +18736,21 @@ func (v *WorkflowExecutionInfo) Equals(rhs *WorkflowExecutionInfo) bool {
 	if !_String_EqualsPtr(v.ActiveClusterSelectionPolicyEncoding, rhs.ActiveClusterSelectionPolicyEncoding) {
 		return false
 	}
+	if !((v.CompletionCallbacks == nil && rhs.CompletionCallbacks == nil) || (v.CompletionCallbacks != nil && rhs.CompletionCallbacks != nil && bytes.Equal(v.CompletionCallbacks, rhs.CompletionCallbacks))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.CompletionCallbacksEncoding, rhs.CompletionCallbacksEncoding) {
+		return false
+	}
+	if !_String_EqualsPtr(v.StickyWorkerIdentity, rhs.StickyWorkerIdentity) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StickyHitCount, rhs.StickyHitCount) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StickyFallbackCount, rhs.StickyFallbackCount) {
+		return false
+	}
 
 	return true
 }
@@ -17765,6 +18970,21 @@ func (v *WorkflowExecutionInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err
 	if v.ActiveClusterSelectionPolicyEncoding != nil {
 		enc.AddString("activeClusterSelectionPolicyEncoding", *v.ActiveClusterSelectionPolicyEncoding)
 	}
+	if v.CompletionCallbacks != nil {
+		enc.AddString("completionCallbacks", base64.StdEncoding.EncodeToString(v.CompletionCallbacks))
+	}
+	if v.CompletionCallbacksEncoding != nil {
+		enc.AddString("completionCallbacksEncoding", *v.CompletionCallbacksEncoding)
+	}
+	if v.StickyWorkerIdentity != nil {
+		enc.AddString("stickyWorkerIdentity", *v.StickyWorkerIdentity)
+	}
+	if v.StickyHitCount != nil {
+		enc.AddInt64("stickyHitCount", *v.StickyHitCount)
+	}
+	if v.StickyFallbackCount != nil {
+		enc.AddInt64("stickyFallbackCount", *v.StickyFallbackCount)
+	}
 	return err
 }
 
@@ -18758,16 +19978,91 @@ func (v *WorkflowExecutionInfo) IsSetActiveClusterSelectionPolicyEncoding() bool
 	return v != nil && v.ActiveClusterSelectionPolicyEncoding != nil
 }
 
+// GetCompletionCallbacks returns the value of CompletionCallbacks if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetCompletionCallbacks() (o []byte) {
+	if v != nil && v.CompletionCallbacks != nil {
+		return v.CompletionCallbacks
+	}
+
+	return
+}
+
+// IsSetCompletionCallbacks returns true if CompletionCallbacks is not nil.
+func (v *WorkflowExecutionInfo) IsSetCompletionCallbacks() bool {
+	return v != nil && v.CompletionCallbacks != nil
+}
+
+// GetCompletionCallbacksEncoding returns the value of CompletionCallbacksEncoding if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetCompletionCallbacksEncoding() (o string) {
+	if v != nil && v.CompletionCallbacksEncoding != nil {
+		return *v.CompletionCallbacksEncoding
+	}
+
+	return
+}
+
+// IsSetCompletionCallbacksEncoding returns true if CompletionCallbacksEncoding is not nil.
+func (v *WorkflowExecutionInfo) IsSetCompletionCallbacksEncoding() bool {
+	return v != nil && v.CompletionCallbacksEncoding != nil
+}
+
+// GetStickyWorkerIdentity returns the value of StickyWorkerIdentity if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetStickyWorkerIdentity() (o string) {
+	if v != nil && v.StickyWorkerIdentity != nil {
+		return *v.StickyWorkerIdentity
+	}
+
+	return
+}
+
+// IsSetStickyWorkerIdentity returns true if StickyWorkerIdentity is not nil.
+func (v *WorkflowExecutionInfo) IsSetStickyWorkerIdentity() bool {
+	return v != nil && v.StickyWorkerIdentity != nil
+}
+
+// GetStickyHitCount returns the value of StickyHitCount if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetStickyHitCount() (o int64) {
+	if v != nil && v.StickyHitCount != nil {
+		return *v.StickyHitCount
+	}
+
+	return
+}
+
+// IsSetStickyHitCount returns true if StickyHitCount is not nil.
+func (v *WorkflowExecutionInfo) IsSetStickyHitCount() bool {
+	return v != nil && v.StickyHitCount != nil
+}
+
+// GetStickyFallbackCount returns the value of StickyFallbackCount if it is set or its
+// zero value if it is unset.
+func (v *WorkflowExecutionInfo) GetStickyFallbackCount() (o int64) {
+	if v != nil && v.StickyFallbackCount != nil {
+		return *v.StickyFallbackCount
+	}
+
+	return
+}
+
+// IsSetStickyFallbackCount returns true if StickyFallbackCount is not nil.
+func (v *WorkflowExecutionInfo) IsSetStickyFallbackCount() bool {
+	return v != nil && v.StickyFallbackCount != nil
+}
+
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "sqlblobs",
 	Package:  "github.com/uber/cadence/.gen/go/sqlblobs",
 	FilePath: "sqlblobs.thrift",
-	SHA1:     "048a63de874e011b73df9bd476f918c0aa1fcdaa",
+	SHA1:     "d509455530cf1150938b4c42c470bcf5fc90d6fa",
 	Includes: []*thriftreflect.ThriftModule{
 		shared.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.sqlblobs\n\ninclude \"shared.thrift\"\n\nstruct ShardInfo {\n  10: optional i32 stolenSinceRenew\n  12: optional i64 (js.type = \"Long\") updatedAtNanos\n  14: optional i64 (js.type = \"Long\") replicationAckLevel\n  16: optional i64 (js.type = \"Long\") transferAckLevel\n  18: optional i64 (js.type = \"Long\") timerAckLevelNanos\n  24: optional i64 (js.type = \"Long\") domainNotificationVersion\n  34: optional map<string, i64> clusterTransferAckLevel\n  36: optional map<string, i64> clusterTimerAckLevel\n  38: optional string owner\n  40: optional map<string, i64> clusterReplicationLevel\n  42: optional binary pendingFailoverMarkers\n  44: optional string pendingFailoverMarkersEncoding\n  46: optional map<string, i64> replicationDlqAckLevel\n  50: optional binary transferProcessingQueueStates\n  51: optional string transferProcessingQueueStatesEncoding\n  55: optional binary timerProcessingQueueStates\n  56: optional string timerProcessingQueueStatesEncoding\n  60: optional binary crossClusterProcessingQueueStates\n  61: optional string crossClusterProcessingQueueStatesEncoding\n  64: optional map<i32, shared.QueueState> queueStates\n}\n\nstruct DomainInfo {\n  10: optional string name\n  12: optional string description\n  14: optional string owner\n  16: optional i32 status\n  18: optional i16 retentionDays\n  20: optional bool emitMetric\n  22: optional string archivalBucket\n  24: optional i16 archivalStatus\n  26: optional i64 (js.type = \"Long\") configVersion\n  28: optional i64 (js.type = \"Long\") notificationVersion\n  30: optional i64 (js.type = \"Long\") failoverNotificationVersion\n  32: optional i64 (js.type = \"Long\") failoverVersion\n  34: optional string activeClusterName\n  36: optional list<string> clusters\n  38: optional map<string, string> data\n  39: optional binary badBinaries\n  40: optional string badBinariesEncoding\n  42: optional i16 historyArchivalStatus\n  44: optional string historyArchivalURI\n  46: optional i16 visibilityArchivalStatus\n  48: optional string visibilityArchivalURI\n  50: optional i64 (js.type = \"Long\") failoverEndTime\n  52: optional i64 (js.type = \"Long\") previousFailoverVersion\n  54: optional i64 (js.type = \"Long\") lastUpdatedTime\n  56: optional binary isolationGroupsConfiguration\n  58: optional string isolationGroupsConfigurationEncoding\n  60: optional binary asyncWorkflowConfiguration\n  62: optional string asyncWorkflowConfigurationEncoding\n  64: optional binary activeClustersConfiguration\n  66: optional string activeClustersConfigurationEncoding\n}\n\nstruct HistoryTreeInfo {\n  10: optional i64 (js.type = \"Long\") createdTimeNanos // For fork operation to prevent race condition of leaking event data when forking branches fail. Also can be used for clean up leaked data\n  12: optional list<shared.HistoryBranchRange> ancestors\n  14: optional string info // For lookup back to workflow during debugging, also background cleanup when fork operation cannot finish self cleanup due to crash.\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional binary parentDomainID\n  12: optional string parentWorkflowID\n  14: optional binary parentRunID\n  16: optional i64 (js.type = \"Long\") initiatedID\n  18: optional i64 (js.type = \"Long\") completionEventBatchID\n  20: optional binary completionEvent\n  22: optional string completionEventEncoding\n  24: optional string taskList\n  25: optional shared.TaskListKind taskListKind\n  26: optional string workflowTypeName\n  28: optional i32 workflowTimeoutSeconds\n  30: optional i32 decisionTaskTimeoutSeconds\n  32: optional binary executionContext\n  34: optional i32 state\n  36: optional i32 closeStatus\n  38: optional i64 (js.type = \"Long\") startVersion\n  44: optional i64 (js.type = \"Long\") lastWriteEventID\n  48: optional i64 (js.type = \"Long\") lastEventTaskID\n  50: optional i64 (js.type = \"Long\") lastFirstEventID\n  52: optional i64 (js.type = \"Long\") lastProcessedEvent\n  54: optional i64 (js.type = \"Long\") startTimeNanos\n  56: optional i64 (js.type = \"Long\") lastUpdatedTimeNanos\n  58: optional i64 (js.type = \"Long\") decisionVersion\n  60: optional i64 (js.type = \"Long\") decisionScheduleID\n  62: optional i64 (js.type = \"Long\") decisionStartedID\n  64: optional i32 decisionTimeout\n  66: optional i64 (js.type = \"Long\") decisionAttempt\n  68: optional i64 (js.type = \"Long\") decisionStartedTimestampNanos\n  69: optional i64 (js.type = \"Long\") decisionScheduledTimestampNanos\n  70: optional bool cancelRequested\n  71: optional i64 (js.type = \"Long\") decisionOriginalScheduledTimestampNanos\n  72: optional string createRequestID\n  74: optional string decisionRequestID\n  76: optional string cancelRequestID\n  78: optional string stickyTaskList\n  80: optional i64 (js.type = \"Long\") stickyScheduleToStartTimeout\n  82: optional i64 (js.type = \"Long\") retryAttempt\n  84: optional i32 retryInitialIntervalSeconds\n  86: optional i32 retryMaximumIntervalSeconds\n  88: optional i32 retryMaximumAttempts\n  90: optional i32 retryExpirationSeconds\n  92: optional double retryBackoffCoefficient\n  94: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  96: optional list<string> retryNonRetryableErrors\n  98: optional bool hasRetryPolicy\n  100: optional string cronSchedule\n  102: optional i32 eventStoreVersion\n  104: optional binary eventBranchToken\n  106: optional i64 (js.type = \"Long\") signalCount\n  108: optional i64 (js.type = \"Long\") historySize\n  110: optional string clientLibraryVersion\n  112: optional string clientFeatureVersion\n  114: optional string clientImpl\n  115: optional binary autoResetPoints\n  116: optional string autoResetPointsEncoding\n  118: optional map<string, binary> searchAttributes\n  120: optional map<string, binary> memo\n  122: optional binary versionHistories\n  124: optional string versionHistoriesEncoding\n  126: optional binary firstExecutionRunID\n  128: optional map<string, string> partitionConfig\n  130: optional binary checksum\n  132: optional string checksumEncoding\n  134: optional shared.CronOverlapPolicy cronOverlapPolicy\n  137: optional binary activeClusterSelectionPolicy\n  138: optional string activeClusterSelectionPolicyEncoding\n}\n\nstruct ActivityInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") scheduledEventBatchID\n  14: optional binary scheduledEvent\n  16: optional string scheduledEventEncoding\n  18: optional i64 (js.type = \"Long\") scheduledTimeNanos\n  20: optional i64 (js.type = \"Long\") startedID\n  22: optional binary startedEvent\n  24: optional string startedEventEncoding\n  26: optional i64 (js.type = \"Long\") startedTimeNanos\n  28: optional string activityID\n  30: optional string requestID\n  32: optional i32 scheduleToStartTimeoutSeconds\n  34: optional i32 scheduleToCloseTimeoutSeconds\n  36: optional i32 startToCloseTimeoutSeconds\n  38: optional i32 heartbeatTimeoutSeconds\n  40: optional bool cancelRequested\n  42: optional i64 (js.type = \"Long\") cancelRequestID\n  44: optional i32 timerTaskStatus\n  46: optional i32 attempt\n  48: optional string taskList\n  50: optional string startedIdentity\n  52: optional bool hasRetryPolicy\n  54: optional i32 retryInitialIntervalSeconds\n  56: optional i32 retryMaximumIntervalSeconds\n  58: optional i32 retryMaximumAttempts\n  60: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  62: optional double retryBackoffCoefficient\n  64: optional list<string> retryNonRetryableErrors\n  66: optional string retryLastFailureReason\n  68: optional string retryLastWorkerIdentity\n  70: optional binary retryLastFailureDetails\n}\n\nstruct ChildExecutionInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  14: optional i64 (js.type = \"Long\") startedID\n  16: optional binary initiatedEvent\n  18: optional string initiatedEventEncoding\n  20: optional string startedWorkflowID\n  22: optional binary startedRunID\n  24: optional binary startedEvent\n  26: optional string startedEventEncoding\n  28: optional string createRequestID\n  29: optional string domainID\n  30: optional string domainName // deprecated\n  32: optional string workflowTypeName\n  35: optional i32 parentClosePolicy\n}\n\nstruct SignalInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string requestID\n  14: optional string name\n  16: optional binary input\n  18: optional binary control\n}\n\nstruct RequestCancelInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string cancelRequestID\n}\n\nstruct TimerInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") startedID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  // TaskID is a misleading variable, it actually serves\n  // the purpose of indicating whether a timer task is\n  // generated for this timer info\n  16: optional i64 (js.type = \"Long\") taskID\n}\n\nstruct TaskInfo {\n  10: optional string workflowID\n  12: optional binary runID\n  13: optional i64 (js.type = \"Long\") scheduleID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  15: optional i64 (js.type = \"Long\") createdTimeNanos\n  17: optional map<string, string> partitionConfig\n}\n\nstruct TaskListPartition {\n    10: optional list<string> isolationGroups\n}\n\nstruct TaskListPartitionConfig {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i32 numReadPartitions\n  14: optional i32 numWritePartitions\n  16: optional map<i32, TaskListPartition> readPartitions\n  18: optional map<i32, TaskListPartition> writePartitions\n}\n\nstruct TaskListInfo {\n  10: optional i16 kind // {Normal, Sticky}\n  12: optional i64 (js.type = \"Long\") ackLevel\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  16: optional i64 (js.type = \"Long\") lastUpdatedNanos\n  18: optional TaskListPartitionConfig adaptivePartitionConfig\n}\n\nstruct TransferTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional binary targetDomainID\n  20: optional string targetWorkflowID\n  22: optional binary targetRunID\n  24: optional string taskList\n  26: optional bool targetChildWorkflowOnly\n  28: optional i64 (js.type = \"Long\") scheduleID\n  30: optional i64 (js.type = \"Long\") version\n  32: optional i64 (js.type = \"Long\") visibilityTimestampNanos\n  34: optional set<binary> targetDomainIDs\n}\n\nstruct TimerTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i16 timeoutType\n  20: optional i64 (js.type = \"Long\") version\n  22: optional i64 (js.type = \"Long\") scheduleAttempt\n  24: optional i64 (js.type = \"Long\") eventID\n}\n\nstruct ReplicationTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i64 (js.type = \"Long\") version\n  20: optional i64 (js.type = \"Long\") firstEventID\n  22: optional i64 (js.type = \"Long\") nextEventID\n  24: optional i64 (js.type = \"Long\") scheduledID\n  26: optional i32 eventStoreVersion\n  28: optional i32 newRunEventStoreVersion\n  30: optional binary branch_token\n  34: optional binary newRunBranchToken\n  38: optional i64 (js.type = \"Long\") creationTime\n}\n\nenum AsyncRequestType {\n  StartWorkflowExecutionAsyncRequest\n  SignalWithStartWorkflowExecutionAsyncRequest\n}\n\nstruct AsyncRequestMessage {\n  10: optional string partitionKey\n  12: optional AsyncRequestType type\n  14: optional shared.Header header\n  16: optional string encoding\n  18: optional binary payload\n}\n"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.sqlblobs\n\ninclude \"shared.thrift\"\n\nstruct ShardInfo {\n  10: optional i32 stolenSinceRenew\n  12: optional i64 (js.type = \"Long\") updatedAtNanos\n  14: optional i64 (js.type = \"Long\") replicationAckLevel\n  16: optional i64 (js.type = \"Long\") transferAckLevel\n  18: optional i64 (js.type = \"Long\") timerAckLevelNanos\n  24: optional i64 (js.type = \"Long\") domainNotificationVersion\n  34: optional map<string, i64> clusterTransferAckLevel\n  36: optional map<string, i64> clusterTimerAckLevel\n  38: optional string owner\n  40: optional map<string, i64> clusterReplicationLevel\n  42: optional binary pendingFailoverMarkers\n  44: optional string pendingFailoverMarkersEncoding\n  46: optional map<string, i64> replicationDlqAckLevel\n  50: optional binary transferProcessingQueueStates\n  51: optional string transferProcessingQueueStatesEncoding\n  55: optional binary timerProcessingQueueStates\n  56: optional string timerProcessingQueueStatesEncoding\n  60: optional binary crossClusterProcessingQueueStates\n  61: optional string crossClusterProcessingQueueStatesEncoding\n  64: optional map<i32, shared.QueueState> queueStates\n}\n\nstruct DomainInfo {\n  10: optional string name\n  12: optional string description\n  14: optional string owner\n  16: optional i32 status\n  18: optional i16 retentionDays\n  20: optional bool emitMetric\n  22: optional string archivalBucket\n  24: optional i16 archivalStatus\n  26: optional i64 (js.type = \"Long\") configVersion\n  28: optional i64 (js.type = \"Long\") notificationVersion\n  30: optional i64 (js.type = \"Long\") failoverNotificationVersion\n  32: optional i64 (js.type = \"Long\") failoverVersion\n  34: optional string activeClusterName\n  36: optional list<string> clusters\n  38: optional map<string, string> data\n  39: optional binary badBinaries\n  40: optional string badBinariesEncoding\n  42: optional i16 historyArchivalStatus\n  44: optional string historyArchivalURI\n  46: optional i16 visibilityArchivalStatus\n  48: optional string visibilityArchivalURI\n  50: optional i64 (js.type = \"Long\") failoverEndTime\n  52: optional i64 (js.type = \"Long\") previousFailoverVersion\n  54: optional i64 (js.type = \"Long\") lastUpdatedTime\n  56: optional binary isolationGroupsConfiguration\n  58: optional string isolationGroupsConfigurationEncoding\n  60: optional binary asyncWorkflowConfiguration\n  62: optional string asyncWorkflowConfigurationEncoding\n  64: optional binary activeClustersConfiguration\n  66: optional string activeClustersConfigurationEncoding\n}\n\nstruct HistoryTreeInfo {\n  10: optional i64 (js.type = \"Long\") createdTimeNanos // For fork operation to prevent race condition of leaking event data when forking branches fail. Also can be used for clean up leaked data\n  12: optional list<shared.HistoryBranchRange> ancestors\n  14: optional string info // For lookup back to workflow during debugging, also background cleanup when fork operation cannot finish self cleanup due to crash.\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional binary parentDomainID\n  12: optional string parentWorkflowID\n  14: optional binary parentRunID\n  16: optional i64 (js.type = \"Long\") initiatedID\n  18: optional i64 (js.type = \"Long\") completionEventBatchID\n  20: optional binary completionEvent\n  22: optional string completionEventEncoding\n  24: optional string taskList\n  25: optional shared.TaskListKind taskListKind\n  26: optional string workflowTypeName\n  28: optional i32 workflowTimeoutSeconds\n  30: optional i32 decisionTaskTimeoutSeconds\n  32: optional binary executionContext\n  34: optional i32 state\n  36: optional i32 closeStatus\n  38: optional i64 (js.type = \"Long\") startVersion\n  44: optional i64 (js.type = \"Long\") lastWriteEventID\n  48: optional i64 (js.type = \"Long\") lastEventTaskID\n  50: optional i64 (js.type = \"Long\") lastFirstEventID\n  52: optional i64 (js.type = \"Long\") lastProcessedEvent\n  54: optional i64 (js.type = \"Long\") startTimeNanos\n  56: optional i64 (js.type = \"Long\") lastUpdatedTimeNanos\n  58: optional i64 (js.type = \"Long\") decisionVersion\n  60: optional i64 (js.type = \"Long\") decisionScheduleID\n  62: optional i64 (js.type = \"Long\") decisionStartedID\n  64: optional i32 decisionTimeout\n  66: optional i64 (js.type = \"Long\") decisionAttempt\n  68: optional i64 (js.type = \"Long\") decisionStartedTimestampNanos\n  69: optional i64 (js.type = \"Long\") decisionScheduledTimestampNanos\n  70: optional bool cancelRequested\n  71: optional i64 (js.type = \"Long\") decisionOriginalScheduledTimestampNanos\n  72: optional string createRequestID\n  74: optional string decisionRequestID\n  76: optional string cancelRequestID\n  78: optional string stickyTaskList\n  80: optional i64 (js.type = \"Long\") stickyScheduleToStartTimeout\n  82: optional i64 (js.type = \"Long\") retryAttempt\n  84: optional i32 retryInitialIntervalSeconds\n  86: optional i32 retryMaximumIntervalSeconds\n  88: optional i32 retryMaximumAttempts\n  90: optional i32 retryExpirationSeconds\n  92: optional double retryBackoffCoefficient\n  94: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  96: optional list<string> retryNonRetryableErrors\n  98: optional bool hasRetryPolicy\n  100: optional string cronSchedule\n  102: optional i32 eventStoreVersion\n  104: optional binary eventBranchToken\n  106: optional i64 (js.type = \"Long\") signalCount\n  108: optional i64 (js.type = \"Long\") historySize\n  110: optional string clientLibraryVersion\n  112: optional string clientFeatureVersion\n  114: optional string clientImpl\n  115: optional binary autoResetPoints\n  116: optional string autoResetPointsEncoding\n  118: optional map<string, binary> searchAttributes\n  120: optional map<string, binary> memo\n  122: optional binary versionHistories\n  124: optional string versionHistoriesEncoding\n  126: optional binary firstExecutionRunID\n  128: optional map<string, string> partitionConfig\n  130: optional binary checksum\n  132: optional string checksumEncoding\n  134: optional shared.CronOverlapPolicy cronOverlapPolicy\n  137: optional binary activeClusterSelectionPolicy\n  138: optional string activeClusterSelectionPolicyEncoding\n  140: optional binary completionCallbacks\n  142: optional string completionCallbacksEncoding\n  144: optional string stickyWorkerIdentity\n  146: optional i64 (js.type = \"Long\") stickyHitCount\n  148: optional i64 (js.type = \"Long\") stickyFallbackCount\n}\n\nstruct ActivityInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") scheduledEventBatchID\n  14: optional binary scheduledEvent\n  16: optional string scheduledEventEncoding\n  18: optional i64 (js.type = \"Long\") scheduledTimeNanos\n  20: optional i64 (js.type = \"Long\") startedID\n  22: optional binary startedEvent\n  24: optional string startedEventEncoding\n  26: optional i64 (js.type = \"Long\") startedTimeNanos\n  28: optional string activityID\n  30: optional string requestID\n  32: optional i32 scheduleToStartTimeoutSeconds\n  34: optional i32 scheduleToCloseTimeoutSeconds\n  36: optional i32 startToCloseTimeoutSeconds\n  38: optional i32 heartbeatTimeoutSeconds\n  40: optional bool cancelRequested\n  42: optional i64 (js.type = \"Long\") cancelRequestID\n  44: optional i32 timerTaskStatus\n  46: optional i32 attempt\n  48: optional string taskList\n  50: optional string startedIdentity\n  52: optional bool hasRetryPolicy\n  54: optional i32 retryInitialIntervalSeconds\n  56: optional i32 retryMaximumIntervalSeconds\n  58: optional i32 retryMaximumAttempts\n  60: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  62: optional double retryBackoffCoefficient\n  64: optional list<string> retryNonRetryableErrors\n  66: optional string retryLastFailureReason\n  68: optional string retryLastWorkerIdentity\n  70: optional binary retryLastFailureDetails\n}\n\nstruct ChildExecutionInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  14: optional i64 (js.type = \"Long\") startedID\n  16: optional binary initiatedEvent\n  18: optional string initiatedEventEncoding\n  20: optional string startedWorkflowID\n  22: optional binary startedRunID\n  24: optional binary startedEvent\n  26: optional string startedEventEncoding\n  28: optional string createRequestID\n  29: optional string domainID\n  30: optional string domainName // deprecated\n  32: optional string workflowTypeName\n  35: optional i32 parentClosePolicy\n}\n\nstruct SignalInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string requestID\n  14: optional string name\n  16: optional binary input\n  18: optional binary control\n}\n\nstruct RequestCancelInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string cancelRequestID\n}\n\nstruct TimerInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") startedID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  // TaskID is a misleading variable, it actually serves\n  // the purpose of indicating whether a timer task is\n  // generated for this timer info\n  16: optional i64 (js.type = \"Long\") taskID\n}\n\nstruct TaskInfo {\n  10: optional string workflowID\n  12: optional binary runID\n  13: optional i64 (js.type = \"Long\") scheduleID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  15: optional i64 (js.type = \"Long\") createdTimeNanos\n  17: optional map<string, string> partitionConfig\n}\n\nstruct TaskListPartition {\n    10: optional list<string> isolationGroups\n}\n\nstruct TaskListPartitionConfig {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i32 numReadPartitions\n  14: optional i32 numWritePartitions\n  16: optional map<i32, TaskListPartition> readPartitions\n  18: optional map<i32, TaskListPartition> writePartitions\n}\n\nstruct TaskListVersioningConfig {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional list<list<string>> compatibleSets\n}\n\nstruct TaskListMigrationConfig {\n  10: optional string targetTaskList\n  12: optional bool activityAlias\n}\n\nstruct TaskListInfo {\n  10: optional i16 kind // {Normal, Sticky}\n  12: optional i64 (js.type = \"Long\") ackLevel\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  16: optional i64 (js.type = \"Long\") lastUpdatedNanos\n  18: optional TaskListPartitionConfig adaptivePartitionConfig\n  20: optional TaskListVersioningConfig versioningConfig\n  22: optional TaskListMigrationConfig migrationConfig\n}\n\nstruct TransferTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional binary targetDomainID\n  20: optional string targetWorkflowID\n  22: optional binary targetRunID\n  24: optional string taskList\n  26: optional bool targetChildWorkflowOnly\n  28: optional i64 (js.type = \"Long\") scheduleID\n  30: optional i64 (js.type = \"Long\") version\n  32: optional i64 (js.type = \"Long\") visibilityTimestampNanos\n  34: optional set<binary> targetDomainIDs\n}\n\nstruct TimerTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i16 timeoutType\n  20: optional i64 (js.type = \"Long\") version\n  22: optional i64 (js.type = \"Long\") scheduleAttempt\n  24: optional i64 (js.type = \"Long\") eventID\n}\n\nstruct ReplicationTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i64 (js.type = \"Long\") version\n  20: optional i64 (js.type = \"Long\") firstEventID\n  22: optional i64 (js.type = \"Long\") nextEventID\n  24: optional i64 (js.type = \"Long\") scheduledID\n  26: optional i32 eventStoreVersion\n  28: optional i32 newRunEventStoreVersion\n  30: optional binary branch_token\n  34: optional binary newRunBranchToken\n  38: optional i64 (js.type = \"Long\") creationTime\n}\n\nenum AsyncRequestType {\n  StartWorkflowExecutionAsyncRequest\n  SignalWithStartWorkflowExecutionAsyncRequest\n  SignalWorkflowExecutionAsyncRequest\n  RequestCancelWorkflowExecutionAsyncRequest\n  TerminateWorkflowExecutionAsyncRequest\n}\n\nstruct AsyncRequestMessage {\n  10: optional string partitionKey\n  12: optional AsyncRequestType type\n  14: optional shared.Header header\n  16: optional string encoding\n  18: optional binary payload\n  20: optional string requestID\n  22: optional string domainID\n}\n"
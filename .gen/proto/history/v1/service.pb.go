@@ -4,6 +4,7 @@
 package historyv1
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -28,6 +29,40 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type CompletionCallbackState int32
+
+const (
+	CompletionCallbackState_COMPLETION_CALLBACK_STATE_INVALID       CompletionCallbackState = 0
+	CompletionCallbackState_COMPLETION_CALLBACK_STATE_SCHEDULED     CompletionCallbackState = 1
+	CompletionCallbackState_COMPLETION_CALLBACK_STATE_BACKING_OFF   CompletionCallbackState = 2
+	CompletionCallbackState_COMPLETION_CALLBACK_STATE_SUCCEEDED     CompletionCallbackState = 3
+	CompletionCallbackState_COMPLETION_CALLBACK_STATE_DEAD_LETTERED CompletionCallbackState = 4
+)
+
+var CompletionCallbackState_name = map[int32]string{
+	0: "COMPLETION_CALLBACK_STATE_INVALID",
+	1: "COMPLETION_CALLBACK_STATE_SCHEDULED",
+	2: "COMPLETION_CALLBACK_STATE_BACKING_OFF",
+	3: "COMPLETION_CALLBACK_STATE_SUCCEEDED",
+	4: "COMPLETION_CALLBACK_STATE_DEAD_LETTERED",
+}
+
+var CompletionCallbackState_value = map[string]int32{
+	"COMPLETION_CALLBACK_STATE_INVALID":       0,
+	"COMPLETION_CALLBACK_STATE_SCHEDULED":     1,
+	"COMPLETION_CALLBACK_STATE_BACKING_OFF":   2,
+	"COMPLETION_CALLBACK_STATE_SUCCEEDED":     3,
+	"COMPLETION_CALLBACK_STATE_DEAD_LETTERED": 4,
+}
+
+func (x CompletionCallbackState) String() string {
+	return proto.EnumName(CompletionCallbackState_name, int32(x))
+}
+
+func (CompletionCallbackState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{0}
+}
+
 type StartWorkflowExecutionRequest struct {
 	Request                  *v1.StartWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	DomainId                 string                            `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -39,6 +74,7 @@ type StartWorkflowExecutionRequest struct {
 	LastCompletionResult     *v1.Payload                       `protobuf:"bytes,8,opt,name=last_completion_result,json=lastCompletionResult,proto3" json:"last_completion_result,omitempty"`
 	FirstDecisionTaskBackoff *types.Duration                   `protobuf:"bytes,9,opt,name=first_decision_task_backoff,json=firstDecisionTaskBackoff,proto3" json:"first_decision_task_backoff,omitempty"`
 	PartitionConfig          map[string]string                 `protobuf:"bytes,10,rep,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CompletionCallbacks      []*CompletionCallback             `protobuf:"bytes,11,rep,name=completion_callbacks,json=completionCallbacks,proto3" json:"completion_callbacks,omitempty"`
 	XXX_NoUnkeyedLiteral     struct{}                          `json:"-"`
 	XXX_unrecognized         []byte                            `json:"-"`
 	XXX_sizecache            int32                             `json:"-"`
@@ -147,6 +183,159 @@ func (m *StartWorkflowExecutionRequest) GetPartitionConfig() map[string]string {
 	return nil
 }
 
+func (m *StartWorkflowExecutionRequest) GetCompletionCallbacks() []*CompletionCallback {
+	if m != nil {
+		return m.CompletionCallbacks
+	}
+	return nil
+}
+
+// CompletionCallback is an HTTP endpoint notified once the started workflow execution closes.
+// It is carried next to the public request until the public API exposes it.
+type CompletionCallback struct {
+	Url                  string            `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Headers              map[string]string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CompletionCallback) Reset()         { *m = CompletionCallback{} }
+func (m *CompletionCallback) String() string { return proto.CompactTextString(m) }
+func (*CompletionCallback) ProtoMessage()    {}
+func (*CompletionCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{1}
+}
+func (m *CompletionCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompletionCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompletionCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompletionCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompletionCallback.Merge(m, src)
+}
+func (m *CompletionCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompletionCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompletionCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompletionCallback proto.InternalMessageInfo
+
+func (m *CompletionCallback) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *CompletionCallback) GetHeaders() map[string]string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+// CompletionCallbackInfo describes the delivery progress of a completion callback.
+// It is carried next to the public response until the public API exposes it.
+type CompletionCallbackInfo struct {
+	Url                  string                  `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	State                CompletionCallbackState `protobuf:"varint,2,opt,name=state,proto3,enum=uber.cadence.history.v1.CompletionCallbackState" json:"state,omitempty"`
+	Attempt              int32                   `protobuf:"varint,3,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	LastAttemptTime      *types.Timestamp        `protobuf:"bytes,4,opt,name=last_attempt_time,json=lastAttemptTime,proto3" json:"last_attempt_time,omitempty"`
+	NextAttemptTime      *types.Timestamp        `protobuf:"bytes,5,opt,name=next_attempt_time,json=nextAttemptTime,proto3" json:"next_attempt_time,omitempty"`
+	LastFailureReason    string                  `protobuf:"bytes,6,opt,name=last_failure_reason,json=lastFailureReason,proto3" json:"last_failure_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *CompletionCallbackInfo) Reset()         { *m = CompletionCallbackInfo{} }
+func (m *CompletionCallbackInfo) String() string { return proto.CompactTextString(m) }
+func (*CompletionCallbackInfo) ProtoMessage()    {}
+func (*CompletionCallbackInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{2}
+}
+func (m *CompletionCallbackInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompletionCallbackInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompletionCallbackInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompletionCallbackInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompletionCallbackInfo.Merge(m, src)
+}
+func (m *CompletionCallbackInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompletionCallbackInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompletionCallbackInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompletionCallbackInfo proto.InternalMessageInfo
+
+func (m *CompletionCallbackInfo) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *CompletionCallbackInfo) GetState() CompletionCallbackState {
+	if m != nil {
+		return m.State
+	}
+	return CompletionCallbackState_COMPLETION_CALLBACK_STATE_INVALID
+}
+
+func (m *CompletionCallbackInfo) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *CompletionCallbackInfo) GetLastAttemptTime() *types.Timestamp {
+	if m != nil {
+		return m.LastAttemptTime
+	}
+	return nil
+}
+
+func (m *CompletionCallbackInfo) GetNextAttemptTime() *types.Timestamp {
+	if m != nil {
+		return m.NextAttemptTime
+	}
+	return nil
+}
+
+func (m *CompletionCallbackInfo) GetLastFailureReason() string {
+	if m != nil {
+		return m.LastFailureReason
+	}
+	return ""
+}
+
 type StartWorkflowExecutionResponse struct {
 	RunId                string   `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -158,7 +347,7 @@ func (m *StartWorkflowExecutionResponse) Reset()         { *m = StartWorkflowExe
 func (m *StartWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
 func (*StartWorkflowExecutionResponse) ProtoMessage()    {}
 func (*StartWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{1}
+	return fileDescriptor_fee8ff76963a38ed, []int{3}
 }
 func (m *StartWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -211,7 +400,7 @@ func (m *SignalWorkflowExecutionRequest) Reset()         { *m = SignalWorkflowEx
 func (m *SignalWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
 func (*SignalWorkflowExecutionRequest) ProtoMessage()    {}
 func (*SignalWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{2}
+	return fileDescriptor_fee8ff76963a38ed, []int{4}
 }
 func (m *SignalWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -278,7 +467,7 @@ func (m *SignalWorkflowExecutionResponse) Reset()         { *m = SignalWorkflowE
 func (m *SignalWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
 func (*SignalWorkflowExecutionResponse) ProtoMessage()    {}
 func (*SignalWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{3}
+	return fileDescriptor_fee8ff76963a38ed, []int{5}
 }
 func (m *SignalWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -311,6 +500,7 @@ type SignalWithStartWorkflowExecutionRequest struct {
 	Request              *v1.SignalWithStartWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	DomainId             string                                      `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
 	PartitionConfig      map[string]string                           `protobuf:"bytes,3,rep,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CompletionCallbacks  []*CompletionCallback                       `protobuf:"bytes,4,rep,name=completion_callbacks,json=completionCallbacks,proto3" json:"completion_callbacks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
 	XXX_unrecognized     []byte                                      `json:"-"`
 	XXX_sizecache        int32                                       `json:"-"`
@@ -322,7 +512,7 @@ func (m *SignalWithStartWorkflowExecutionRequest) Reset() {
 func (m *SignalWithStartWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
 func (*SignalWithStartWorkflowExecutionRequest) ProtoMessage()    {}
 func (*SignalWithStartWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{4}
+	return fileDescriptor_fee8ff76963a38ed, []int{6}
 }
 func (m *SignalWithStartWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -372,6 +562,13 @@ func (m *SignalWithStartWorkflowExecutionRequest) GetPartitionConfig() map[strin
 	return nil
 }
 
+func (m *SignalWithStartWorkflowExecutionRequest) GetCompletionCallbacks() []*CompletionCallback {
+	if m != nil {
+		return m.CompletionCallbacks
+	}
+	return nil
+}
+
 type SignalWithStartWorkflowExecutionResponse struct {
 	RunId                string   `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -385,7 +582,7 @@ func (m *SignalWithStartWorkflowExecutionResponse) Reset() {
 func (m *SignalWithStartWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
 func (*SignalWithStartWorkflowExecutionResponse) ProtoMessage()    {}
 func (*SignalWithStartWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{5}
+	return fileDescriptor_fee8ff76963a38ed, []int{7}
 }
 func (m *SignalWithStartWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -433,7 +630,7 @@ func (m *ResetWorkflowExecutionRequest) Reset()         { *m = ResetWorkflowExec
 func (m *ResetWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
 func (*ResetWorkflowExecutionRequest) ProtoMessage()    {}
 func (*ResetWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{6}
+	return fileDescriptor_fee8ff76963a38ed, []int{8}
 }
 func (m *ResetWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +684,7 @@ func (m *ResetWorkflowExecutionResponse) Reset()         { *m = ResetWorkflowExe
 func (m *ResetWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
 func (*ResetWorkflowExecutionResponse) ProtoMessage()    {}
 func (*ResetWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{7}
+	return fileDescriptor_fee8ff76963a38ed, []int{9}
 }
 func (m *ResetWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -540,7 +737,7 @@ func (m *TerminateWorkflowExecutionRequest) Reset()         { *m = TerminateWork
 func (m *TerminateWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
 func (*TerminateWorkflowExecutionRequest) ProtoMessage()    {}
 func (*TerminateWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{8}
+	return fileDescriptor_fee8ff76963a38ed, []int{10}
 }
 func (m *TerminateWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -607,7 +804,7 @@ func (m *TerminateWorkflowExecutionResponse) Reset()         { *m = TerminateWor
 func (m *TerminateWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
 func (*TerminateWorkflowExecutionResponse) ProtoMessage()    {}
 func (*TerminateWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{9}
+	return fileDescriptor_fee8ff76963a38ed, []int{11}
 }
 func (m *TerminateWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -636,6 +833,133 @@ func (m *TerminateWorkflowExecutionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TerminateWorkflowExecutionResponse proto.InternalMessageInfo
 
+type UpdateWorkflowMemoRequest struct {
+	DomainId          string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	Domain            string                `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution *v1.WorkflowExecution `protobuf:"bytes,3,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	// Fields are merged into the existing memo, an empty value removes the key.
+	Memo                 *v1.Memo `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	Identity             string   `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId            string   `protobuf:"bytes,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateWorkflowMemoRequest) Reset()         { *m = UpdateWorkflowMemoRequest{} }
+func (m *UpdateWorkflowMemoRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateWorkflowMemoRequest) ProtoMessage()    {}
+func (*UpdateWorkflowMemoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{12}
+}
+func (m *UpdateWorkflowMemoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateWorkflowMemoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateWorkflowMemoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateWorkflowMemoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkflowMemoRequest.Merge(m, src)
+}
+func (m *UpdateWorkflowMemoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateWorkflowMemoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkflowMemoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateWorkflowMemoRequest proto.InternalMessageInfo
+
+func (m *UpdateWorkflowMemoRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *UpdateWorkflowMemoRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *UpdateWorkflowMemoRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *UpdateWorkflowMemoRequest) GetMemo() *v1.Memo {
+	if m != nil {
+		return m.Memo
+	}
+	return nil
+}
+
+func (m *UpdateWorkflowMemoRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *UpdateWorkflowMemoRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type UpdateWorkflowMemoResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateWorkflowMemoResponse) Reset()         { *m = UpdateWorkflowMemoResponse{} }
+func (m *UpdateWorkflowMemoResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateWorkflowMemoResponse) ProtoMessage()    {}
+func (*UpdateWorkflowMemoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{13}
+}
+func (m *UpdateWorkflowMemoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateWorkflowMemoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateWorkflowMemoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateWorkflowMemoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkflowMemoResponse.Merge(m, src)
+}
+func (m *UpdateWorkflowMemoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateWorkflowMemoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkflowMemoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateWorkflowMemoResponse proto.InternalMessageInfo
+
 type DescribeWorkflowExecutionRequest struct {
 	Request              *v1.DescribeWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	DomainId             string                               `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -648,7 +972,7 @@ func (m *DescribeWorkflowExecutionRequest) Reset()         { *m = DescribeWorkfl
 func (m *DescribeWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeWorkflowExecutionRequest) ProtoMessage()    {}
 func (*DescribeWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{10}
+	return fileDescriptor_fee8ff76963a38ed, []int{14}
 }
 func (m *DescribeWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -697,6 +1021,8 @@ type DescribeWorkflowExecutionResponse struct {
 	PendingActivities      []*v1.PendingActivityInfo          `protobuf:"bytes,3,rep,name=pending_activities,json=pendingActivities,proto3" json:"pending_activities,omitempty"`
 	PendingChildren        []*v1.PendingChildExecutionInfo    `protobuf:"bytes,4,rep,name=pending_children,json=pendingChildren,proto3" json:"pending_children,omitempty"`
 	PendingDecision        *v1.PendingDecisionInfo            `protobuf:"bytes,5,opt,name=pending_decision,json=pendingDecision,proto3" json:"pending_decision,omitempty"`
+	StickyExecutionInfo    *StickyExecutionInfo               `protobuf:"bytes,6,opt,name=sticky_execution_info,json=stickyExecutionInfo,proto3" json:"sticky_execution_info,omitempty"`
+	CompletionCallbacks    []*CompletionCallbackInfo          `protobuf:"bytes,7,rep,name=completion_callbacks,json=completionCallbacks,proto3" json:"completion_callbacks,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{}                           `json:"-"`
 	XXX_unrecognized       []byte                             `json:"-"`
 	XXX_sizecache          int32                              `json:"-"`
@@ -706,7 +1032,7 @@ func (m *DescribeWorkflowExecutionResponse) Reset()         { *m = DescribeWorkf
 func (m *DescribeWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeWorkflowExecutionResponse) ProtoMessage()    {}
 func (*DescribeWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{11}
+	return fileDescriptor_fee8ff76963a38ed, []int{15}
 }
 func (m *DescribeWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -770,26 +1096,46 @@ func (m *DescribeWorkflowExecutionResponse) GetPendingDecision() *v1.PendingDeci
 	return nil
 }
 
-type QueryWorkflowRequest struct {
-	Request              *v1.QueryWorkflowRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
-	DomainId             string                   `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *DescribeWorkflowExecutionResponse) GetStickyExecutionInfo() *StickyExecutionInfo {
+	if m != nil {
+		return m.StickyExecutionInfo
+	}
+	return nil
 }
 
-func (m *QueryWorkflowRequest) Reset()         { *m = QueryWorkflowRequest{} }
-func (m *QueryWorkflowRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryWorkflowRequest) ProtoMessage()    {}
-func (*QueryWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{12}
+func (m *DescribeWorkflowExecutionResponse) GetCompletionCallbacks() []*CompletionCallbackInfo {
+	if m != nil {
+		return m.CompletionCallbacks
+	}
+	return nil
 }
-func (m *QueryWorkflowRequest) XXX_Unmarshal(b []byte) error {
+
+// StickyExecutionInfo describes the sticky task list of a workflow execution and how often it was hit.
+// It is carried next to the public response until the public API exposes it.
+type StickyExecutionInfo struct {
+	StickyTaskList         *v1.TaskList    `protobuf:"bytes,1,opt,name=sticky_task_list,json=stickyTaskList,proto3" json:"sticky_task_list,omitempty"`
+	StickyHost             string          `protobuf:"bytes,2,opt,name=sticky_host,json=stickyHost,proto3" json:"sticky_host,omitempty"`
+	ScheduleToStartTimeout *types.Duration `protobuf:"bytes,3,opt,name=schedule_to_start_timeout,json=scheduleToStartTimeout,proto3" json:"schedule_to_start_timeout,omitempty"`
+	HitCount               int64           `protobuf:"varint,4,opt,name=hit_count,json=hitCount,proto3" json:"hit_count,omitempty"`
+	FallbackCount          int64           `protobuf:"varint,5,opt,name=fallback_count,json=fallbackCount,proto3" json:"fallback_count,omitempty"`
+	HitRate                float64         `protobuf:"fixed64,6,opt,name=hit_rate,json=hitRate,proto3" json:"hit_rate,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}        `json:"-"`
+	XXX_unrecognized       []byte          `json:"-"`
+	XXX_sizecache          int32           `json:"-"`
+}
+
+func (m *StickyExecutionInfo) Reset()         { *m = StickyExecutionInfo{} }
+func (m *StickyExecutionInfo) String() string { return proto.CompactTextString(m) }
+func (*StickyExecutionInfo) ProtoMessage()    {}
+func (*StickyExecutionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{16}
+}
+func (m *StickyExecutionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StickyExecutionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryWorkflowRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StickyExecutionInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -799,35 +1145,118 @@ func (m *QueryWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryWorkflowRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryWorkflowRequest.Merge(m, src)
+func (m *StickyExecutionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StickyExecutionInfo.Merge(m, src)
 }
-func (m *QueryWorkflowRequest) XXX_Size() int {
+func (m *StickyExecutionInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryWorkflowRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryWorkflowRequest.DiscardUnknown(m)
+func (m *StickyExecutionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_StickyExecutionInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryWorkflowRequest proto.InternalMessageInfo
+var xxx_messageInfo_StickyExecutionInfo proto.InternalMessageInfo
 
-func (m *QueryWorkflowRequest) GetRequest() *v1.QueryWorkflowRequest {
+func (m *StickyExecutionInfo) GetStickyTaskList() *v1.TaskList {
 	if m != nil {
-		return m.Request
+		return m.StickyTaskList
 	}
 	return nil
 }
 
-func (m *QueryWorkflowRequest) GetDomainId() string {
+func (m *StickyExecutionInfo) GetStickyHost() string {
 	if m != nil {
-		return m.DomainId
+		return m.StickyHost
 	}
 	return ""
 }
 
-type QueryWorkflowResponse struct {
-	QueryResult          *v1.Payload       `protobuf:"bytes,1,opt,name=query_result,json=queryResult,proto3" json:"query_result,omitempty"`
-	QueryRejected        *v1.QueryRejected `protobuf:"bytes,2,opt,name=query_rejected,json=queryRejected,proto3" json:"query_rejected,omitempty"`
+func (m *StickyExecutionInfo) GetScheduleToStartTimeout() *types.Duration {
+	if m != nil {
+		return m.ScheduleToStartTimeout
+	}
+	return nil
+}
+
+func (m *StickyExecutionInfo) GetHitCount() int64 {
+	if m != nil {
+		return m.HitCount
+	}
+	return 0
+}
+
+func (m *StickyExecutionInfo) GetFallbackCount() int64 {
+	if m != nil {
+		return m.FallbackCount
+	}
+	return 0
+}
+
+func (m *StickyExecutionInfo) GetHitRate() float64 {
+	if m != nil {
+		return m.HitRate
+	}
+	return 0
+}
+
+type QueryWorkflowRequest struct {
+	Request              *v1.QueryWorkflowRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	DomainId             string                   `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *QueryWorkflowRequest) Reset()         { *m = QueryWorkflowRequest{} }
+func (m *QueryWorkflowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWorkflowRequest) ProtoMessage()    {}
+func (*QueryWorkflowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{17}
+}
+func (m *QueryWorkflowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWorkflowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWorkflowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWorkflowRequest.Merge(m, src)
+}
+func (m *QueryWorkflowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWorkflowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWorkflowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWorkflowRequest proto.InternalMessageInfo
+
+func (m *QueryWorkflowRequest) GetRequest() *v1.QueryWorkflowRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *QueryWorkflowRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+type QueryWorkflowResponse struct {
+	QueryResult          *v1.Payload       `protobuf:"bytes,1,opt,name=query_result,json=queryResult,proto3" json:"query_result,omitempty"`
+	QueryRejected        *v1.QueryRejected `protobuf:"bytes,2,opt,name=query_rejected,json=queryRejected,proto3" json:"query_rejected,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -837,7 +1266,7 @@ func (m *QueryWorkflowResponse) Reset()         { *m = QueryWorkflowResponse{} }
 func (m *QueryWorkflowResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryWorkflowResponse) ProtoMessage()    {}
 func (*QueryWorkflowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{13}
+	return fileDescriptor_fee8ff76963a38ed, []int{18}
 }
 func (m *QueryWorkflowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -892,7 +1321,7 @@ func (m *ResetStickyTaskListRequest) Reset()         { *m = ResetStickyTaskListR
 func (m *ResetStickyTaskListRequest) String() string { return proto.CompactTextString(m) }
 func (*ResetStickyTaskListRequest) ProtoMessage()    {}
 func (*ResetStickyTaskListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{14}
+	return fileDescriptor_fee8ff76963a38ed, []int{19}
 }
 func (m *ResetStickyTaskListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -945,7 +1374,7 @@ func (m *ResetStickyTaskListResponse) Reset()         { *m = ResetStickyTaskList
 func (m *ResetStickyTaskListResponse) String() string { return proto.CompactTextString(m) }
 func (*ResetStickyTaskListResponse) ProtoMessage()    {}
 func (*ResetStickyTaskListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{15}
+	return fileDescriptor_fee8ff76963a38ed, []int{20}
 }
 func (m *ResetStickyTaskListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -989,7 +1418,7 @@ func (m *GetMutableStateRequest) Reset()         { *m = GetMutableStateRequest{}
 func (m *GetMutableStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMutableStateRequest) ProtoMessage()    {}
 func (*GetMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{16}
+	return fileDescriptor_fee8ff76963a38ed, []int{21}
 }
 func (m *GetMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1081,7 +1510,7 @@ func (m *GetMutableStateResponse) Reset()         { *m = GetMutableStateResponse
 func (m *GetMutableStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMutableStateResponse) ProtoMessage()    {}
 func (*GetMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{17}
+	return fileDescriptor_fee8ff76963a38ed, []int{22}
 }
 func (m *GetMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1250,7 +1679,7 @@ func (m *PollMutableStateRequest) Reset()         { *m = PollMutableStateRequest
 func (m *PollMutableStateRequest) String() string { return proto.CompactTextString(m) }
 func (*PollMutableStateRequest) ProtoMessage()    {}
 func (*PollMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{18}
+	return fileDescriptor_fee8ff76963a38ed, []int{23}
 }
 func (m *PollMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1332,7 +1761,7 @@ func (m *PollMutableStateResponse) Reset()         { *m = PollMutableStateRespon
 func (m *PollMutableStateResponse) String() string { return proto.CompactTextString(m) }
 func (*PollMutableStateResponse) ProtoMessage()    {}
 func (*PollMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{19}
+	return fileDescriptor_fee8ff76963a38ed, []int{24}
 }
 func (m *PollMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1483,7 +1912,7 @@ func (m *RecordDecisionTaskStartedRequest) Reset()         { *m = RecordDecision
 func (m *RecordDecisionTaskStartedRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordDecisionTaskStartedRequest) ProtoMessage()    {}
 func (*RecordDecisionTaskStartedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{20}
+	return fileDescriptor_fee8ff76963a38ed, []int{25}
 }
 func (m *RecordDecisionTaskStartedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1579,7 +2008,7 @@ func (m *RecordDecisionTaskStartedResponse) Reset()         { *m = RecordDecisio
 func (m *RecordDecisionTaskStartedResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordDecisionTaskStartedResponse) ProtoMessage()    {}
 func (*RecordDecisionTaskStartedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{21}
+	return fileDescriptor_fee8ff76963a38ed, []int{26}
 }
 func (m *RecordDecisionTaskStartedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1719,18 +2148,20 @@ type RecordActivityTaskStartedRequest struct {
 	ScheduleId        int64                 `protobuf:"varint,3,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
 	TaskId            int64                 `protobuf:"varint,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	// Unique id of each poll request. Used to ensure at most once delivery of tasks.
-	RequestId            string                         `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	PollRequest          *v1.PollForActivityTaskRequest `protobuf:"bytes,6,opt,name=poll_request,json=pollRequest,proto3" json:"poll_request,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
+	RequestId   string                         `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	PollRequest *v1.PollForActivityTaskRequest `protobuf:"bytes,6,opt,name=poll_request,json=pollRequest,proto3" json:"poll_request,omitempty"`
+	// Where the task spent its time in matching before being dispatched.
+	ScheduleToStartBreakdown *ScheduleToStartBreakdown `protobuf:"bytes,7,opt,name=schedule_to_start_breakdown,json=scheduleToStartBreakdown,proto3" json:"schedule_to_start_breakdown,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}                  `json:"-"`
+	XXX_unrecognized         []byte                    `json:"-"`
+	XXX_sizecache            int32                     `json:"-"`
 }
 
 func (m *RecordActivityTaskStartedRequest) Reset()         { *m = RecordActivityTaskStartedRequest{} }
 func (m *RecordActivityTaskStartedRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordActivityTaskStartedRequest) ProtoMessage()    {}
 func (*RecordActivityTaskStartedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{22}
+	return fileDescriptor_fee8ff76963a38ed, []int{27}
 }
 func (m *RecordActivityTaskStartedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1801,6 +2232,94 @@ func (m *RecordActivityTaskStartedRequest) GetPollRequest() *v1.PollForActivityT
 	return nil
 }
 
+func (m *RecordActivityTaskStartedRequest) GetScheduleToStartBreakdown() *ScheduleToStartBreakdown {
+	if m != nil {
+		return m.ScheduleToStartBreakdown
+	}
+	return nil
+}
+
+// ScheduleToStartBreakdown describes where a task spent its time between being scheduled and dispatched.
+// It is carried next to the public request until the public API exposes it.
+type ScheduleToStartBreakdown struct {
+	SyncMatched           bool     `protobuf:"varint,1,opt,name=sync_matched,json=syncMatched,proto3" json:"sync_matched,omitempty"`
+	Forwarded             bool     `protobuf:"varint,2,opt,name=forwarded,proto3" json:"forwarded,omitempty"`
+	BacklogWaitTimeInMs   int64    `protobuf:"varint,3,opt,name=backlog_wait_time_in_ms,json=backlogWaitTimeInMs,proto3" json:"backlog_wait_time_in_ms,omitempty"`
+	RatelimitWaitTimeInMs int64    `protobuf:"varint,4,opt,name=ratelimit_wait_time_in_ms,json=ratelimitWaitTimeInMs,proto3" json:"ratelimit_wait_time_in_ms,omitempty"`
+	IsolationWaitTimeInMs int64    `protobuf:"varint,5,opt,name=isolation_wait_time_in_ms,json=isolationWaitTimeInMs,proto3" json:"isolation_wait_time_in_ms,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *ScheduleToStartBreakdown) Reset()         { *m = ScheduleToStartBreakdown{} }
+func (m *ScheduleToStartBreakdown) String() string { return proto.CompactTextString(m) }
+func (*ScheduleToStartBreakdown) ProtoMessage()    {}
+func (*ScheduleToStartBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{28}
+}
+func (m *ScheduleToStartBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleToStartBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleToStartBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleToStartBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleToStartBreakdown.Merge(m, src)
+}
+func (m *ScheduleToStartBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleToStartBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleToStartBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleToStartBreakdown proto.InternalMessageInfo
+
+func (m *ScheduleToStartBreakdown) GetSyncMatched() bool {
+	if m != nil {
+		return m.SyncMatched
+	}
+	return false
+}
+
+func (m *ScheduleToStartBreakdown) GetForwarded() bool {
+	if m != nil {
+		return m.Forwarded
+	}
+	return false
+}
+
+func (m *ScheduleToStartBreakdown) GetBacklogWaitTimeInMs() int64 {
+	if m != nil {
+		return m.BacklogWaitTimeInMs
+	}
+	return 0
+}
+
+func (m *ScheduleToStartBreakdown) GetRatelimitWaitTimeInMs() int64 {
+	if m != nil {
+		return m.RatelimitWaitTimeInMs
+	}
+	return 0
+}
+
+func (m *ScheduleToStartBreakdown) GetIsolationWaitTimeInMs() int64 {
+	if m != nil {
+		return m.IsolationWaitTimeInMs
+	}
+	return 0
+}
+
 type RecordActivityTaskStartedResponse struct {
 	ScheduledEvent             *v1.HistoryEvent `protobuf:"bytes,1,opt,name=scheduled_event,json=scheduledEvent,proto3" json:"scheduled_event,omitempty"`
 	StartedTime                *types.Timestamp `protobuf:"bytes,2,opt,name=started_time,json=startedTime,proto3" json:"started_time,omitempty"`
@@ -1818,7 +2337,7 @@ func (m *RecordActivityTaskStartedResponse) Reset()         { *m = RecordActivit
 func (m *RecordActivityTaskStartedResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordActivityTaskStartedResponse) ProtoMessage()    {}
 func (*RecordActivityTaskStartedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{23}
+	return fileDescriptor_fee8ff76963a38ed, []int{29}
 }
 func (m *RecordActivityTaskStartedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1897,18 +2416,21 @@ func (m *RecordActivityTaskStartedResponse) GetWorkflowDomain() string {
 }
 
 type RespondDecisionTaskCompletedRequest struct {
-	Request              *v1.RespondDecisionTaskCompletedRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
-	DomainId             string                                  `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
-	XXX_unrecognized     []byte                                  `json:"-"`
-	XXX_sizecache        int32                                   `json:"-"`
+	Request  *v1.RespondDecisionTaskCompletedRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	DomainId string                                  `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	// Number of free activity slots of the worker for activities scheduled with request_local_dispatch.
+	// Carried here until the public RespondDecisionTaskCompletedRequest exposes it.
+	EagerActivitySlots   *types.Int32Value `protobuf:"bytes,3,opt,name=eager_activity_slots,json=eagerActivitySlots,proto3" json:"eager_activity_slots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *RespondDecisionTaskCompletedRequest) Reset()         { *m = RespondDecisionTaskCompletedRequest{} }
 func (m *RespondDecisionTaskCompletedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondDecisionTaskCompletedRequest) ProtoMessage()    {}
 func (*RespondDecisionTaskCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{24}
+	return fileDescriptor_fee8ff76963a38ed, []int{30}
 }
 func (m *RespondDecisionTaskCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1951,6 +2473,13 @@ func (m *RespondDecisionTaskCompletedRequest) GetDomainId() string {
 	return ""
 }
 
+func (m *RespondDecisionTaskCompletedRequest) GetEagerActivitySlots() *types.Int32Value {
+	if m != nil {
+		return m.EagerActivitySlots
+	}
+	return nil
+}
+
 type RespondDecisionTaskCompletedResponse struct {
 	StartedResponse             *RecordDecisionTaskStartedResponse       `protobuf:"bytes,1,opt,name=started_response,json=startedResponse,proto3" json:"started_response,omitempty"`
 	ActivitiesToDispatchLocally map[string]*v1.ActivityLocalDispatchInfo `protobuf:"bytes,2,rep,name=activities_to_dispatch_locally,json=activitiesToDispatchLocally,proto3" json:"activities_to_dispatch_locally,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -1963,7 +2492,7 @@ func (m *RespondDecisionTaskCompletedResponse) Reset()         { *m = RespondDec
 func (m *RespondDecisionTaskCompletedResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondDecisionTaskCompletedResponse) ProtoMessage()    {}
 func (*RespondDecisionTaskCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{25}
+	return fileDescriptor_fee8ff76963a38ed, []int{31}
 }
 func (m *RespondDecisionTaskCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2018,7 +2547,7 @@ func (m *RespondDecisionTaskFailedRequest) Reset()         { *m = RespondDecisio
 func (m *RespondDecisionTaskFailedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondDecisionTaskFailedRequest) ProtoMessage()    {}
 func (*RespondDecisionTaskFailedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{26}
+	return fileDescriptor_fee8ff76963a38ed, []int{32}
 }
 func (m *RespondDecisionTaskFailedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2071,7 +2600,7 @@ func (m *RespondDecisionTaskFailedResponse) Reset()         { *m = RespondDecisi
 func (m *RespondDecisionTaskFailedResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondDecisionTaskFailedResponse) ProtoMessage()    {}
 func (*RespondDecisionTaskFailedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{27}
+	return fileDescriptor_fee8ff76963a38ed, []int{33}
 }
 func (m *RespondDecisionTaskFailedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2112,7 +2641,7 @@ func (m *RecordActivityTaskHeartbeatRequest) Reset()         { *m = RecordActivi
 func (m *RecordActivityTaskHeartbeatRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordActivityTaskHeartbeatRequest) ProtoMessage()    {}
 func (*RecordActivityTaskHeartbeatRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{28}
+	return fileDescriptor_fee8ff76963a38ed, []int{34}
 }
 func (m *RecordActivityTaskHeartbeatRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2166,7 +2695,7 @@ func (m *RecordActivityTaskHeartbeatResponse) Reset()         { *m = RecordActiv
 func (m *RecordActivityTaskHeartbeatResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordActivityTaskHeartbeatResponse) ProtoMessage()    {}
 func (*RecordActivityTaskHeartbeatResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{29}
+	return fileDescriptor_fee8ff76963a38ed, []int{35}
 }
 func (m *RecordActivityTaskHeartbeatResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2214,7 +2743,7 @@ func (m *RespondActivityTaskCompletedRequest) Reset()         { *m = RespondActi
 func (m *RespondActivityTaskCompletedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskCompletedRequest) ProtoMessage()    {}
 func (*RespondActivityTaskCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{30}
+	return fileDescriptor_fee8ff76963a38ed, []int{36}
 }
 func (m *RespondActivityTaskCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2267,7 +2796,7 @@ func (m *RespondActivityTaskCompletedResponse) Reset()         { *m = RespondAct
 func (m *RespondActivityTaskCompletedResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskCompletedResponse) ProtoMessage()    {}
 func (*RespondActivityTaskCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{31}
+	return fileDescriptor_fee8ff76963a38ed, []int{37}
 }
 func (m *RespondActivityTaskCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2308,7 +2837,7 @@ func (m *RespondActivityTaskFailedRequest) Reset()         { *m = RespondActivit
 func (m *RespondActivityTaskFailedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskFailedRequest) ProtoMessage()    {}
 func (*RespondActivityTaskFailedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{32}
+	return fileDescriptor_fee8ff76963a38ed, []int{38}
 }
 func (m *RespondActivityTaskFailedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2361,7 +2890,7 @@ func (m *RespondActivityTaskFailedResponse) Reset()         { *m = RespondActivi
 func (m *RespondActivityTaskFailedResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskFailedResponse) ProtoMessage()    {}
 func (*RespondActivityTaskFailedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{33}
+	return fileDescriptor_fee8ff76963a38ed, []int{39}
 }
 func (m *RespondActivityTaskFailedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2402,7 +2931,7 @@ func (m *RespondActivityTaskCanceledRequest) Reset()         { *m = RespondActiv
 func (m *RespondActivityTaskCanceledRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskCanceledRequest) ProtoMessage()    {}
 func (*RespondActivityTaskCanceledRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{34}
+	return fileDescriptor_fee8ff76963a38ed, []int{40}
 }
 func (m *RespondActivityTaskCanceledRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2455,7 +2984,7 @@ func (m *RespondActivityTaskCanceledResponse) Reset()         { *m = RespondActi
 func (m *RespondActivityTaskCanceledResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskCanceledResponse) ProtoMessage()    {}
 func (*RespondActivityTaskCanceledResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{35}
+	return fileDescriptor_fee8ff76963a38ed, []int{41}
 }
 func (m *RespondActivityTaskCanceledResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2497,7 +3026,7 @@ func (m *RemoveSignalMutableStateRequest) Reset()         { *m = RemoveSignalMut
 func (m *RemoveSignalMutableStateRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveSignalMutableStateRequest) ProtoMessage()    {}
 func (*RemoveSignalMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{36}
+	return fileDescriptor_fee8ff76963a38ed, []int{42}
 }
 func (m *RemoveSignalMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2557,7 +3086,7 @@ func (m *RemoveSignalMutableStateResponse) Reset()         { *m = RemoveSignalMu
 func (m *RemoveSignalMutableStateResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveSignalMutableStateResponse) ProtoMessage()    {}
 func (*RemoveSignalMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{37}
+	return fileDescriptor_fee8ff76963a38ed, []int{43}
 }
 func (m *RemoveSignalMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2603,7 +3132,7 @@ func (m *RequestCancelWorkflowExecutionRequest) Reset()         { *m = RequestCa
 func (m *RequestCancelWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
 func (*RequestCancelWorkflowExecutionRequest) ProtoMessage()    {}
 func (*RequestCancelWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{38}
+	return fileDescriptor_fee8ff76963a38ed, []int{44}
 }
 func (m *RequestCancelWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2672,7 +3201,7 @@ func (m *RequestCancelWorkflowExecutionResponse) Reset() {
 func (m *RequestCancelWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
 func (*RequestCancelWorkflowExecutionResponse) ProtoMessage()    {}
 func (*RequestCancelWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{39}
+	return fileDescriptor_fee8ff76963a38ed, []int{45}
 }
 func (m *RequestCancelWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2714,7 +3243,7 @@ func (m *ScheduleDecisionTaskRequest) Reset()         { *m = ScheduleDecisionTas
 func (m *ScheduleDecisionTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*ScheduleDecisionTaskRequest) ProtoMessage()    {}
 func (*ScheduleDecisionTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{40}
+	return fileDescriptor_fee8ff76963a38ed, []int{46}
 }
 func (m *ScheduleDecisionTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2774,7 +3303,7 @@ func (m *ScheduleDecisionTaskResponse) Reset()         { *m = ScheduleDecisionTa
 func (m *ScheduleDecisionTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*ScheduleDecisionTaskResponse) ProtoMessage()    {}
 func (*ScheduleDecisionTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{41}
+	return fileDescriptor_fee8ff76963a38ed, []int{47}
 }
 func (m *ScheduleDecisionTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2824,7 +3353,7 @@ func (m *RecordChildExecutionCompletedRequest) Reset()         { *m = RecordChil
 func (m *RecordChildExecutionCompletedRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordChildExecutionCompletedRequest) ProtoMessage()    {}
 func (*RecordChildExecutionCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{42}
+	return fileDescriptor_fee8ff76963a38ed, []int{48}
 }
 func (m *RecordChildExecutionCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2905,7 +3434,7 @@ func (m *RecordChildExecutionCompletedResponse) Reset()         { *m = RecordChi
 func (m *RecordChildExecutionCompletedResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordChildExecutionCompletedResponse) ProtoMessage()    {}
 func (*RecordChildExecutionCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{43}
+	return fileDescriptor_fee8ff76963a38ed, []int{49}
 }
 func (m *RecordChildExecutionCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2934,30 +3463,26 @@ func (m *RecordChildExecutionCompletedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RecordChildExecutionCompletedResponse proto.InternalMessageInfo
 
-type ReplicateEventsV2Request struct {
-	DomainId            string                    `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	WorkflowExecution   *v1.WorkflowExecution     `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
-	VersionHistoryItems []*v11.VersionHistoryItem `protobuf:"bytes,3,rep,name=version_history_items,json=versionHistoryItems,proto3" json:"version_history_items,omitempty"`
-	Events              *v1.DataBlob              `protobuf:"bytes,4,opt,name=events,proto3" json:"events,omitempty"`
-	// New run events does not need version history since there is no prior events.
-	NewRunEvents         *v1.DataBlob `protobuf:"bytes,5,opt,name=new_run_events,json=newRunEvents,proto3" json:"new_run_events,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type GetCompletionCallbacksRequest struct {
+	DomainId             string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *ReplicateEventsV2Request) Reset()         { *m = ReplicateEventsV2Request{} }
-func (m *ReplicateEventsV2Request) String() string { return proto.CompactTextString(m) }
-func (*ReplicateEventsV2Request) ProtoMessage()    {}
-func (*ReplicateEventsV2Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{44}
+func (m *GetCompletionCallbacksRequest) Reset()         { *m = GetCompletionCallbacksRequest{} }
+func (m *GetCompletionCallbacksRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCompletionCallbacksRequest) ProtoMessage()    {}
+func (*GetCompletionCallbacksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{50}
 }
-func (m *ReplicateEventsV2Request) XXX_Unmarshal(b []byte) error {
+func (m *GetCompletionCallbacksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReplicateEventsV2Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetCompletionCallbacksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ReplicateEventsV2Request.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetCompletionCallbacksRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2967,44 +3492,293 @@ func (m *ReplicateEventsV2Request) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *ReplicateEventsV2Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplicateEventsV2Request.Merge(m, src)
+func (m *GetCompletionCallbacksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompletionCallbacksRequest.Merge(m, src)
 }
-func (m *ReplicateEventsV2Request) XXX_Size() int {
+func (m *GetCompletionCallbacksRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReplicateEventsV2Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReplicateEventsV2Request.DiscardUnknown(m)
+func (m *GetCompletionCallbacksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompletionCallbacksRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReplicateEventsV2Request proto.InternalMessageInfo
+var xxx_messageInfo_GetCompletionCallbacksRequest proto.InternalMessageInfo
 
-func (m *ReplicateEventsV2Request) GetDomainId() string {
+func (m *GetCompletionCallbacksRequest) GetDomainId() string {
 	if m != nil {
 		return m.DomainId
 	}
 	return ""
 }
 
-func (m *ReplicateEventsV2Request) GetWorkflowExecution() *v1.WorkflowExecution {
+func (m *GetCompletionCallbacksRequest) GetWorkflowExecution() *v1.WorkflowExecution {
 	if m != nil {
 		return m.WorkflowExecution
 	}
 	return nil
 }
 
-func (m *ReplicateEventsV2Request) GetVersionHistoryItems() []*v11.VersionHistoryItem {
-	if m != nil {
-		return m.VersionHistoryItems
-	}
-	return nil
+type GetCompletionCallbacksResponse struct {
+	Callbacks            []*CompletionCallback           `protobuf:"bytes,1,rep,name=callbacks,proto3" json:"callbacks,omitempty"`
+	WorkflowType         *v1.WorkflowType                `protobuf:"bytes,2,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	CloseStatus          v1.WorkflowExecutionCloseStatus `protobuf:"varint,3,opt,name=close_status,json=closeStatus,proto3,enum=uber.cadence.api.v1.WorkflowExecutionCloseStatus" json:"close_status,omitempty"`
+	CloseTime            *types.Timestamp                `protobuf:"bytes,4,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	HistoryLength        int64                           `protobuf:"varint,5,opt,name=history_length,json=historyLength,proto3" json:"history_length,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
 
-func (m *ReplicateEventsV2Request) GetEvents() *v1.DataBlob {
-	if m != nil {
-		return m.Events
-	}
-	return nil
+func (m *GetCompletionCallbacksResponse) Reset()         { *m = GetCompletionCallbacksResponse{} }
+func (m *GetCompletionCallbacksResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCompletionCallbacksResponse) ProtoMessage()    {}
+func (*GetCompletionCallbacksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{51}
+}
+func (m *GetCompletionCallbacksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCompletionCallbacksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCompletionCallbacksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetCompletionCallbacksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompletionCallbacksResponse.Merge(m, src)
+}
+func (m *GetCompletionCallbacksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCompletionCallbacksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompletionCallbacksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompletionCallbacksResponse proto.InternalMessageInfo
+
+func (m *GetCompletionCallbacksResponse) GetCallbacks() []*CompletionCallback {
+	if m != nil {
+		return m.Callbacks
+	}
+	return nil
+}
+
+func (m *GetCompletionCallbacksResponse) GetWorkflowType() *v1.WorkflowType {
+	if m != nil {
+		return m.WorkflowType
+	}
+	return nil
+}
+
+func (m *GetCompletionCallbacksResponse) GetCloseStatus() v1.WorkflowExecutionCloseStatus {
+	if m != nil {
+		return m.CloseStatus
+	}
+	return v1.WorkflowExecutionCloseStatus_WORKFLOW_EXECUTION_CLOSE_STATUS_INVALID
+}
+
+func (m *GetCompletionCallbacksResponse) GetCloseTime() *types.Timestamp {
+	if m != nil {
+		return m.CloseTime
+	}
+	return nil
+}
+
+func (m *GetCompletionCallbacksResponse) GetHistoryLength() int64 {
+	if m != nil {
+		return m.HistoryLength
+	}
+	return 0
+}
+
+type RecordCompletionCallbackStateRequest struct {
+	DomainId          string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowExecution *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	// Position of the callback in GetCompletionCallbacksResponse.callbacks.
+	Index                int32                   `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	State                *CompletionCallbackInfo `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *RecordCompletionCallbackStateRequest) Reset()         { *m = RecordCompletionCallbackStateRequest{} }
+func (m *RecordCompletionCallbackStateRequest) String() string { return proto.CompactTextString(m) }
+func (*RecordCompletionCallbackStateRequest) ProtoMessage()    {}
+func (*RecordCompletionCallbackStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{52}
+}
+func (m *RecordCompletionCallbackStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordCompletionCallbackStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordCompletionCallbackStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordCompletionCallbackStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordCompletionCallbackStateRequest.Merge(m, src)
+}
+func (m *RecordCompletionCallbackStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordCompletionCallbackStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordCompletionCallbackStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordCompletionCallbackStateRequest proto.InternalMessageInfo
+
+func (m *RecordCompletionCallbackStateRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *RecordCompletionCallbackStateRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *RecordCompletionCallbackStateRequest) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *RecordCompletionCallbackStateRequest) GetState() *CompletionCallbackInfo {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+type RecordCompletionCallbackStateResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecordCompletionCallbackStateResponse) Reset()         { *m = RecordCompletionCallbackStateResponse{} }
+func (m *RecordCompletionCallbackStateResponse) String() string { return proto.CompactTextString(m) }
+func (*RecordCompletionCallbackStateResponse) ProtoMessage()    {}
+func (*RecordCompletionCallbackStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{53}
+}
+func (m *RecordCompletionCallbackStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordCompletionCallbackStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordCompletionCallbackStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordCompletionCallbackStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordCompletionCallbackStateResponse.Merge(m, src)
+}
+func (m *RecordCompletionCallbackStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordCompletionCallbackStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordCompletionCallbackStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordCompletionCallbackStateResponse proto.InternalMessageInfo
+
+type ReplicateEventsV2Request struct {
+	DomainId            string                    `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowExecution   *v1.WorkflowExecution     `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	VersionHistoryItems []*v11.VersionHistoryItem `protobuf:"bytes,3,rep,name=version_history_items,json=versionHistoryItems,proto3" json:"version_history_items,omitempty"`
+	Events              *v1.DataBlob              `protobuf:"bytes,4,opt,name=events,proto3" json:"events,omitempty"`
+	// New run events does not need version history since there is no prior events.
+	NewRunEvents         *v1.DataBlob `protobuf:"bytes,5,opt,name=new_run_events,json=newRunEvents,proto3" json:"new_run_events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ReplicateEventsV2Request) Reset()         { *m = ReplicateEventsV2Request{} }
+func (m *ReplicateEventsV2Request) String() string { return proto.CompactTextString(m) }
+func (*ReplicateEventsV2Request) ProtoMessage()    {}
+func (*ReplicateEventsV2Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{54}
+}
+func (m *ReplicateEventsV2Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicateEventsV2Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicateEventsV2Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplicateEventsV2Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicateEventsV2Request.Merge(m, src)
+}
+func (m *ReplicateEventsV2Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicateEventsV2Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicateEventsV2Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicateEventsV2Request proto.InternalMessageInfo
+
+func (m *ReplicateEventsV2Request) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *ReplicateEventsV2Request) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *ReplicateEventsV2Request) GetVersionHistoryItems() []*v11.VersionHistoryItem {
+	if m != nil {
+		return m.VersionHistoryItems
+	}
+	return nil
+}
+
+func (m *ReplicateEventsV2Request) GetEvents() *v1.DataBlob {
+	if m != nil {
+		return m.Events
+	}
+	return nil
 }
 
 func (m *ReplicateEventsV2Request) GetNewRunEvents() *v1.DataBlob {
@@ -3024,7 +3798,7 @@ func (m *ReplicateEventsV2Response) Reset()         { *m = ReplicateEventsV2Resp
 func (m *ReplicateEventsV2Response) String() string { return proto.CompactTextString(m) }
 func (*ReplicateEventsV2Response) ProtoMessage()    {}
 func (*ReplicateEventsV2Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{45}
+	return fileDescriptor_fee8ff76963a38ed, []int{55}
 }
 func (m *ReplicateEventsV2Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3066,7 +3840,7 @@ func (m *SyncShardStatusRequest) Reset()         { *m = SyncShardStatusRequest{}
 func (m *SyncShardStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncShardStatusRequest) ProtoMessage()    {}
 func (*SyncShardStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{46}
+	return fileDescriptor_fee8ff76963a38ed, []int{56}
 }
 func (m *SyncShardStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3126,7 +3900,7 @@ func (m *SyncShardStatusResponse) Reset()         { *m = SyncShardStatusResponse
 func (m *SyncShardStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*SyncShardStatusResponse) ProtoMessage()    {}
 func (*SyncShardStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{47}
+	return fileDescriptor_fee8ff76963a38ed, []int{57}
 }
 func (m *SyncShardStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3178,7 +3952,7 @@ func (m *SyncActivityRequest) Reset()         { *m = SyncActivityRequest{} }
 func (m *SyncActivityRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncActivityRequest) ProtoMessage()    {}
 func (*SyncActivityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{48}
+	return fileDescriptor_fee8ff76963a38ed, []int{58}
 }
 func (m *SyncActivityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3308,7 +4082,7 @@ func (m *SyncActivityResponse) Reset()         { *m = SyncActivityResponse{} }
 func (m *SyncActivityResponse) String() string { return proto.CompactTextString(m) }
 func (*SyncActivityResponse) ProtoMessage()    {}
 func (*SyncActivityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{49}
+	return fileDescriptor_fee8ff76963a38ed, []int{59}
 }
 func (m *SyncActivityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3349,7 +4123,7 @@ func (m *DescribeMutableStateRequest) Reset()         { *m = DescribeMutableStat
 func (m *DescribeMutableStateRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeMutableStateRequest) ProtoMessage()    {}
 func (*DescribeMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{50}
+	return fileDescriptor_fee8ff76963a38ed, []int{60}
 }
 func (m *DescribeMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3404,7 +4178,7 @@ func (m *DescribeMutableStateResponse) Reset()         { *m = DescribeMutableSta
 func (m *DescribeMutableStateResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeMutableStateResponse) ProtoMessage()    {}
 func (*DescribeMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{51}
+	return fileDescriptor_fee8ff76963a38ed, []int{61}
 }
 func (m *DescribeMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3457,7 +4231,7 @@ func (m *DescribeHistoryHostRequest) Reset()         { *m = DescribeHistoryHostR
 func (m *DescribeHistoryHostRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeHistoryHostRequest) ProtoMessage()    {}
 func (*DescribeHistoryHostRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{52}
+	return fileDescriptor_fee8ff76963a38ed, []int{62}
 }
 func (m *DescribeHistoryHostRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3501,7 +4275,7 @@ func (m *DescribeHistoryHostResponse) Reset()         { *m = DescribeHistoryHost
 func (m *DescribeHistoryHostResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeHistoryHostResponse) ProtoMessage()    {}
 func (*DescribeHistoryHostResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{53}
+	return fileDescriptor_fee8ff76963a38ed, []int{63}
 }
 func (m *DescribeHistoryHostResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3576,7 +4350,7 @@ func (m *CloseShardRequest) Reset()         { *m = CloseShardRequest{} }
 func (m *CloseShardRequest) String() string { return proto.CompactTextString(m) }
 func (*CloseShardRequest) ProtoMessage()    {}
 func (*CloseShardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{54}
+	return fileDescriptor_fee8ff76963a38ed, []int{64}
 }
 func (m *CloseShardRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3622,7 +4396,7 @@ func (m *CloseShardResponse) Reset()         { *m = CloseShardResponse{} }
 func (m *CloseShardResponse) String() string { return proto.CompactTextString(m) }
 func (*CloseShardResponse) ProtoMessage()    {}
 func (*CloseShardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{55}
+	return fileDescriptor_fee8ff76963a38ed, []int{65}
 }
 func (m *CloseShardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3666,7 +4440,7 @@ func (m *RemoveTaskRequest) Reset()         { *m = RemoveTaskRequest{} }
 func (m *RemoveTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveTaskRequest) ProtoMessage()    {}
 func (*RemoveTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{56}
+	return fileDescriptor_fee8ff76963a38ed, []int{66}
 }
 func (m *RemoveTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3740,7 +4514,7 @@ func (m *RemoveTaskResponse) Reset()         { *m = RemoveTaskResponse{} }
 func (m *RemoveTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveTaskResponse) ProtoMessage()    {}
 func (*RemoveTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{57}
+	return fileDescriptor_fee8ff76963a38ed, []int{67}
 }
 func (m *RemoveTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3782,7 +4556,7 @@ func (m *ResetQueueRequest) Reset()         { *m = ResetQueueRequest{} }
 func (m *ResetQueueRequest) String() string { return proto.CompactTextString(m) }
 func (*ResetQueueRequest) ProtoMessage()    {}
 func (*ResetQueueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{58}
+	return fileDescriptor_fee8ff76963a38ed, []int{68}
 }
 func (m *ResetQueueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3842,7 +4616,7 @@ func (m *ResetQueueResponse) Reset()         { *m = ResetQueueResponse{} }
 func (m *ResetQueueResponse) String() string { return proto.CompactTextString(m) }
 func (*ResetQueueResponse) ProtoMessage()    {}
 func (*ResetQueueResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{59}
+	return fileDescriptor_fee8ff76963a38ed, []int{69}
 }
 func (m *ResetQueueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3884,7 +4658,7 @@ func (m *DescribeQueueRequest) Reset()         { *m = DescribeQueueRequest{} }
 func (m *DescribeQueueRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeQueueRequest) ProtoMessage()    {}
 func (*DescribeQueueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{60}
+	return fileDescriptor_fee8ff76963a38ed, []int{70}
 }
 func (m *DescribeQueueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3945,7 +4719,7 @@ func (m *DescribeQueueResponse) Reset()         { *m = DescribeQueueResponse{} }
 func (m *DescribeQueueResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeQueueResponse) ProtoMessage()    {}
 func (*DescribeQueueResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{61}
+	return fileDescriptor_fee8ff76963a38ed, []int{71}
 }
 func (m *DescribeQueueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3993,7 +4767,7 @@ func (m *GetReplicationMessagesRequest) Reset()         { *m = GetReplicationMes
 func (m *GetReplicationMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetReplicationMessagesRequest) ProtoMessage()    {}
 func (*GetReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{62}
+	return fileDescriptor_fee8ff76963a38ed, []int{72}
 }
 func (m *GetReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4047,7 +4821,7 @@ func (m *GetReplicationMessagesResponse) Reset()         { *m = GetReplicationMe
 func (m *GetReplicationMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetReplicationMessagesResponse) ProtoMessage()    {}
 func (*GetReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{63}
+	return fileDescriptor_fee8ff76963a38ed, []int{73}
 }
 func (m *GetReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4094,7 +4868,7 @@ func (m *GetDLQReplicationMessagesRequest) Reset()         { *m = GetDLQReplicat
 func (m *GetDLQReplicationMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDLQReplicationMessagesRequest) ProtoMessage()    {}
 func (*GetDLQReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{64}
+	return fileDescriptor_fee8ff76963a38ed, []int{74}
 }
 func (m *GetDLQReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4141,7 +4915,7 @@ func (m *GetDLQReplicationMessagesResponse) Reset()         { *m = GetDLQReplica
 func (m *GetDLQReplicationMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDLQReplicationMessagesResponse) ProtoMessage()    {}
 func (*GetDLQReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{65}
+	return fileDescriptor_fee8ff76963a38ed, []int{75}
 }
 func (m *GetDLQReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4191,7 +4965,7 @@ func (m *ReapplyEventsRequest) Reset()         { *m = ReapplyEventsRequest{} }
 func (m *ReapplyEventsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReapplyEventsRequest) ProtoMessage()    {}
 func (*ReapplyEventsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{66}
+	return fileDescriptor_fee8ff76963a38ed, []int{76}
 }
 func (m *ReapplyEventsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4258,7 +5032,7 @@ func (m *ReapplyEventsResponse) Reset()         { *m = ReapplyEventsResponse{} }
 func (m *ReapplyEventsResponse) String() string { return proto.CompactTextString(m) }
 func (*ReapplyEventsResponse) ProtoMessage()    {}
 func (*ReapplyEventsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{67}
+	return fileDescriptor_fee8ff76963a38ed, []int{77}
 }
 func (m *ReapplyEventsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4300,7 +5074,7 @@ func (m *RefreshWorkflowTasksRequest) Reset()         { *m = RefreshWorkflowTask
 func (m *RefreshWorkflowTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*RefreshWorkflowTasksRequest) ProtoMessage()    {}
 func (*RefreshWorkflowTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{68}
+	return fileDescriptor_fee8ff76963a38ed, []int{78}
 }
 func (m *RefreshWorkflowTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4360,7 +5134,7 @@ func (m *RefreshWorkflowTasksResponse) Reset()         { *m = RefreshWorkflowTas
 func (m *RefreshWorkflowTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*RefreshWorkflowTasksResponse) ProtoMessage()    {}
 func (*RefreshWorkflowTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{69}
+	return fileDescriptor_fee8ff76963a38ed, []int{79}
 }
 func (m *RefreshWorkflowTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4400,7 +5174,7 @@ func (m *CountDLQMessagesRequest) Reset()         { *m = CountDLQMessagesRequest
 func (m *CountDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*CountDLQMessagesRequest) ProtoMessage()    {}
 func (*CountDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{70}
+	return fileDescriptor_fee8ff76963a38ed, []int{80}
 }
 func (m *CountDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4447,7 +5221,7 @@ func (m *CountDLQMessagesResponse) Reset()         { *m = CountDLQMessagesRespon
 func (m *CountDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*CountDLQMessagesResponse) ProtoMessage()    {}
 func (*CountDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{71}
+	return fileDescriptor_fee8ff76963a38ed, []int{81}
 }
 func (m *CountDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4499,7 +5273,7 @@ func (m *ReadDLQMessagesRequest) Reset()         { *m = ReadDLQMessagesRequest{}
 func (m *ReadDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*ReadDLQMessagesRequest) ProtoMessage()    {}
 func (*ReadDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{72}
+	return fileDescriptor_fee8ff76963a38ed, []int{82}
 }
 func (m *ReadDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4584,7 +5358,7 @@ func (m *ReadDLQMessagesResponse) Reset()         { *m = ReadDLQMessagesResponse
 func (m *ReadDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*ReadDLQMessagesResponse) ProtoMessage()    {}
 func (*ReadDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{73}
+	return fileDescriptor_fee8ff76963a38ed, []int{83}
 }
 func (m *ReadDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4655,7 +5429,7 @@ func (m *PurgeDLQMessagesRequest) Reset()         { *m = PurgeDLQMessagesRequest
 func (m *PurgeDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*PurgeDLQMessagesRequest) ProtoMessage()    {}
 func (*PurgeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{74}
+	return fileDescriptor_fee8ff76963a38ed, []int{84}
 }
 func (m *PurgeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4722,7 +5496,7 @@ func (m *PurgeDLQMessagesResponse) Reset()         { *m = PurgeDLQMessagesRespon
 func (m *PurgeDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*PurgeDLQMessagesResponse) ProtoMessage()    {}
 func (*PurgeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{75}
+	return fileDescriptor_fee8ff76963a38ed, []int{85}
 }
 func (m *PurgeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4767,7 +5541,7 @@ func (m *MergeDLQMessagesRequest) Reset()         { *m = MergeDLQMessagesRequest
 func (m *MergeDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*MergeDLQMessagesRequest) ProtoMessage()    {}
 func (*MergeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{76}
+	return fileDescriptor_fee8ff76963a38ed, []int{86}
 }
 func (m *MergeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4849,7 +5623,7 @@ func (m *MergeDLQMessagesResponse) Reset()         { *m = MergeDLQMessagesRespon
 func (m *MergeDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*MergeDLQMessagesResponse) ProtoMessage()    {}
 func (*MergeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{77}
+	return fileDescriptor_fee8ff76963a38ed, []int{87}
 }
 func (m *MergeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4896,7 +5670,7 @@ func (m *NotifyFailoverMarkersRequest) Reset()         { *m = NotifyFailoverMark
 func (m *NotifyFailoverMarkersRequest) String() string { return proto.CompactTextString(m) }
 func (*NotifyFailoverMarkersRequest) ProtoMessage()    {}
 func (*NotifyFailoverMarkersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{78}
+	return fileDescriptor_fee8ff76963a38ed, []int{88}
 }
 func (m *NotifyFailoverMarkersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4942,7 +5716,7 @@ func (m *NotifyFailoverMarkersResponse) Reset()         { *m = NotifyFailoverMar
 func (m *NotifyFailoverMarkersResponse) String() string { return proto.CompactTextString(m) }
 func (*NotifyFailoverMarkersResponse) ProtoMessage()    {}
 func (*NotifyFailoverMarkersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{79}
+	return fileDescriptor_fee8ff76963a38ed, []int{89}
 }
 func (m *NotifyFailoverMarkersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4983,7 +5757,7 @@ func (m *GetCrossClusterTasksRequest) Reset()         { *m = GetCrossClusterTask
 func (m *GetCrossClusterTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCrossClusterTasksRequest) ProtoMessage()    {}
 func (*GetCrossClusterTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{80}
+	return fileDescriptor_fee8ff76963a38ed, []int{90}
 }
 func (m *GetCrossClusterTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5038,7 +5812,7 @@ func (m *GetCrossClusterTasksResponse) Reset()         { *m = GetCrossClusterTas
 func (m *GetCrossClusterTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCrossClusterTasksResponse) ProtoMessage()    {}
 func (*GetCrossClusterTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{81}
+	return fileDescriptor_fee8ff76963a38ed, []int{91}
 }
 func (m *GetCrossClusterTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5097,7 +5871,7 @@ func (m *RespondCrossClusterTasksCompletedRequest) Reset() {
 func (m *RespondCrossClusterTasksCompletedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondCrossClusterTasksCompletedRequest) ProtoMessage()    {}
 func (*RespondCrossClusterTasksCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{82}
+	return fileDescriptor_fee8ff76963a38ed, []int{92}
 }
 func (m *RespondCrossClusterTasksCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5169,7 +5943,7 @@ func (m *RespondCrossClusterTasksCompletedResponse) String() string {
 }
 func (*RespondCrossClusterTasksCompletedResponse) ProtoMessage() {}
 func (*RespondCrossClusterTasksCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{83}
+	return fileDescriptor_fee8ff76963a38ed, []int{93}
 }
 func (m *RespondCrossClusterTasksCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5216,7 +5990,7 @@ func (m *GetFailoverInfoRequest) Reset()         { *m = GetFailoverInfoRequest{}
 func (m *GetFailoverInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFailoverInfoRequest) ProtoMessage()    {}
 func (*GetFailoverInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{84}
+	return fileDescriptor_fee8ff76963a38ed, []int{94}
 }
 func (m *GetFailoverInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5264,7 +6038,7 @@ func (m *GetFailoverInfoResponse) Reset()         { *m = GetFailoverInfoResponse
 func (m *GetFailoverInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetFailoverInfoResponse) ProtoMessage()    {}
 func (*GetFailoverInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{85}
+	return fileDescriptor_fee8ff76963a38ed, []int{95}
 }
 func (m *GetFailoverInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5326,7 +6100,7 @@ func (m *RatelimitUpdateRequest) Reset()         { *m = RatelimitUpdateRequest{}
 func (m *RatelimitUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*RatelimitUpdateRequest) ProtoMessage()    {}
 func (*RatelimitUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{86}
+	return fileDescriptor_fee8ff76963a38ed, []int{96}
 }
 func (m *RatelimitUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5382,7 +6156,7 @@ func (m *RatelimitUpdateResponse) Reset()         { *m = RatelimitUpdateResponse
 func (m *RatelimitUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*RatelimitUpdateResponse) ProtoMessage()    {}
 func (*RatelimitUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{87}
+	return fileDescriptor_fee8ff76963a38ed, []int{97}
 }
 func (m *RatelimitUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5419,8 +6193,12 @@ func (m *RatelimitUpdateResponse) GetData() *v12.Any {
 }
 
 func init() {
+	proto.RegisterEnum("uber.cadence.history.v1.CompletionCallbackState", CompletionCallbackState_name, CompletionCallbackState_value)
 	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.StartWorkflowExecutionRequest")
 	proto.RegisterMapType((map[string]string)(nil), "uber.cadence.history.v1.StartWorkflowExecutionRequest.PartitionConfigEntry")
+	proto.RegisterType((*CompletionCallback)(nil), "uber.cadence.history.v1.CompletionCallback")
+	proto.RegisterMapType((map[string]string)(nil), "uber.cadence.history.v1.CompletionCallback.HeadersEntry")
+	proto.RegisterType((*CompletionCallbackInfo)(nil), "uber.cadence.history.v1.CompletionCallbackInfo")
 	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.StartWorkflowExecutionResponse")
 	proto.RegisterType((*SignalWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.SignalWorkflowExecutionRequest")
 	proto.RegisterType((*SignalWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.SignalWorkflowExecutionResponse")
@@ -5431,8 +6209,11 @@ func init() {
 	proto.RegisterType((*ResetWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.ResetWorkflowExecutionResponse")
 	proto.RegisterType((*TerminateWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.TerminateWorkflowExecutionRequest")
 	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.TerminateWorkflowExecutionResponse")
+	proto.RegisterType((*UpdateWorkflowMemoRequest)(nil), "uber.cadence.history.v1.UpdateWorkflowMemoRequest")
+	proto.RegisterType((*UpdateWorkflowMemoResponse)(nil), "uber.cadence.history.v1.UpdateWorkflowMemoResponse")
 	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.DescribeWorkflowExecutionRequest")
 	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.DescribeWorkflowExecutionResponse")
+	proto.RegisterType((*StickyExecutionInfo)(nil), "uber.cadence.history.v1.StickyExecutionInfo")
 	proto.RegisterType((*QueryWorkflowRequest)(nil), "uber.cadence.history.v1.QueryWorkflowRequest")
 	proto.RegisterType((*QueryWorkflowResponse)(nil), "uber.cadence.history.v1.QueryWorkflowResponse")
 	proto.RegisterType((*ResetStickyTaskListRequest)(nil), "uber.cadence.history.v1.ResetStickyTaskListRequest")
@@ -5445,6 +6226,7 @@ func init() {
 	proto.RegisterType((*RecordDecisionTaskStartedResponse)(nil), "uber.cadence.history.v1.RecordDecisionTaskStartedResponse")
 	proto.RegisterMapType((map[string]*v1.WorkflowQuery)(nil), "uber.cadence.history.v1.RecordDecisionTaskStartedResponse.QueriesEntry")
 	proto.RegisterType((*RecordActivityTaskStartedRequest)(nil), "uber.cadence.history.v1.RecordActivityTaskStartedRequest")
+	proto.RegisterType((*ScheduleToStartBreakdown)(nil), "uber.cadence.history.v1.ScheduleToStartBreakdown")
 	proto.RegisterType((*RecordActivityTaskStartedResponse)(nil), "uber.cadence.history.v1.RecordActivityTaskStartedResponse")
 	proto.RegisterType((*RespondDecisionTaskCompletedRequest)(nil), "uber.cadence.history.v1.RespondDecisionTaskCompletedRequest")
 	proto.RegisterType((*RespondDecisionTaskCompletedResponse)(nil), "uber.cadence.history.v1.RespondDecisionTaskCompletedResponse")
@@ -5467,6 +6249,10 @@ func init() {
 	proto.RegisterType((*ScheduleDecisionTaskResponse)(nil), "uber.cadence.history.v1.ScheduleDecisionTaskResponse")
 	proto.RegisterType((*RecordChildExecutionCompletedRequest)(nil), "uber.cadence.history.v1.RecordChildExecutionCompletedRequest")
 	proto.RegisterType((*RecordChildExecutionCompletedResponse)(nil), "uber.cadence.history.v1.RecordChildExecutionCompletedResponse")
+	proto.RegisterType((*GetCompletionCallbacksRequest)(nil), "uber.cadence.history.v1.GetCompletionCallbacksRequest")
+	proto.RegisterType((*GetCompletionCallbacksResponse)(nil), "uber.cadence.history.v1.GetCompletionCallbacksResponse")
+	proto.RegisterType((*RecordCompletionCallbackStateRequest)(nil), "uber.cadence.history.v1.RecordCompletionCallbackStateRequest")
+	proto.RegisterType((*RecordCompletionCallbackStateResponse)(nil), "uber.cadence.history.v1.RecordCompletionCallbackStateResponse")
 	proto.RegisterType((*ReplicateEventsV2Request)(nil), "uber.cadence.history.v1.ReplicateEventsV2Request")
 	proto.RegisterType((*ReplicateEventsV2Response)(nil), "uber.cadence.history.v1.ReplicateEventsV2Response")
 	proto.RegisterType((*SyncShardStatusRequest)(nil), "uber.cadence.history.v1.SyncShardStatusRequest")
@@ -5521,319 +6307,369 @@ func init() {
 }
 
 var fileDescriptor_fee8ff76963a38ed = []byte{
-	// 4981 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0x4d, 0x6c, 0x1c, 0x47,
-	0x76, 0x30, 0x7a, 0x46, 0xfc, 0x7b, 0x24, 0x87, 0x64, 0x89, 0x3f, 0xc3, 0xa1, 0x44, 0x91, 0x6d,
-	0xcb, 0xa2, 0xe5, 0xf5, 0xd0, 0xa2, 0x6c, 0x49, 0x96, 0xe5, 0xd5, 0x4a, 0xa4, 0x24, 0x8f, 0x3f,
-	0xfd, 0x36, 0x69, 0xf9, 0xcb, 0x9f, 0x7b, 0x9b, 0xd3, 0x35, 0x64, 0x47, 0x3d, 0xdd, 0xa3, 0xee,
-	0x1e, 0x52, 0xf4, 0x21, 0x70, 0xe2, 0x20, 0x40, 0x16, 0x41, 0x76, 0xb3, 0x48, 0x82, 0x00, 0x01,
-	0x02, 0x04, 0x1b, 0x60, 0xb1, 0x46, 0x6e, 0x09, 0x90, 0x43, 0x92, 0x53, 0x2e, 0x7b, 0xdc, 0x6b,
-	0x6e, 0x81, 0xb1, 0x7b, 0x48, 0x80, 0xdc, 0xf6, 0x1c, 0x04, 0xf5, 0xd3, 0x3d, 0xfd, 0x53, 0x5d,
-	0x3d, 0x43, 0x06, 0x91, 0xd7, 0xf1, 0x6d, 0xba, 0xaa, 0xde, 0xab, 0x57, 0xaf, 0xde, 0x7b, 0xfd,
-	0xfe, 0x7a, 0xe0, 0x7c, 0x77, 0x17, 0x7b, 0xeb, 0x4d, 0xc3, 0xc4, 0x4e, 0x13, 0xaf, 0xef, 0x5b,
-	0x7e, 0xe0, 0x7a, 0x47, 0xeb, 0x07, 0x97, 0xd6, 0x7d, 0xec, 0x1d, 0x58, 0x4d, 0x5c, 0xef, 0x78,
-	0x6e, 0xe0, 0xa2, 0x05, 0xb2, 0xac, 0xce, 0x97, 0xd5, 0xf9, 0xb2, 0xfa, 0xc1, 0xa5, 0xda, 0xf2,
-	0x9e, 0xeb, 0xee, 0xd9, 0x78, 0x9d, 0x2e, 0xdb, 0xed, 0xb6, 0xd6, 0xcd, 0xae, 0x67, 0x04, 0x96,
-	0xeb, 0x30, 0xc0, 0xda, 0xb9, 0xf4, 0x7c, 0x60, 0xb5, 0xb1, 0x1f, 0x18, 0xed, 0x0e, 0x5f, 0x90,
-	0x41, 0x70, 0xe8, 0x19, 0x9d, 0x0e, 0xf6, 0x7c, 0x3e, 0xbf, 0x92, 0x20, 0xd0, 0xe8, 0x58, 0x84,
-	0xb8, 0xa6, 0xdb, 0x6e, 0x47, 0x5b, 0xac, 0x8a, 0x56, 0x84, 0x24, 0x72, 0x2a, 0x44, 0x4b, 0x9e,
-	0x77, 0x71, 0xb4, 0x40, 0x15, 0x2d, 0x08, 0x0c, 0xff, 0x99, 0x6d, 0xf9, 0x81, 0x6c, 0xcd, 0xa1,
-	0xeb, 0x3d, 0x6b, 0xd9, 0xee, 0x21, 0x5f, 0x73, 0x51, 0xb4, 0x86, 0xb3, 0x52, 0x4f, 0xad, 0x5d,
-	0x2b, 0x5a, 0x8b, 0x3d, 0xbe, 0xf2, 0x95, 0xe4, 0x4a, 0xb3, 0x6d, 0x39, 0x94, 0x0b, 0x76, 0xd7,
-	0x0f, 0x8a, 0x16, 0x25, 0x19, 0xb1, 0x2a, 0x5e, 0xf4, 0xbc, 0x8b, 0xbb, 0xfc, 0xaa, 0x6b, 0x17,
-	0xc4, 0x4b, 0x3c, 0xdc, 0xb1, 0xad, 0x66, 0xfc, 0x6a, 0x93, 0x37, 0xe3, 0xef, 0x1b, 0x1e, 0x36,
-	0xc9, 0x4a, 0xc3, 0x09, 0x77, 0x7b, 0x35, 0x67, 0x45, 0x92, 0xa6, 0xf3, 0x39, 0xab, 0x92, 0xec,
-	0x52, 0x7f, 0x3e, 0x0c, 0x67, 0xb7, 0x03, 0xc3, 0x0b, 0x3e, 0xe6, 0xe3, 0x77, 0x5e, 0xe0, 0x66,
-	0x97, 0xd0, 0xa3, 0xe1, 0xe7, 0x5d, 0xec, 0x07, 0xe8, 0x3e, 0x8c, 0x78, 0xec, 0x67, 0x55, 0x59,
-	0x51, 0xd6, 0xc6, 0x37, 0x36, 0xea, 0x09, 0xb1, 0x35, 0x3a, 0x56, 0xfd, 0xe0, 0x52, 0x5d, 0x8a,
-	0x44, 0x0b, 0x51, 0xa0, 0x25, 0x18, 0x33, 0xdd, 0xb6, 0x61, 0x39, 0xba, 0x65, 0x56, 0x4b, 0x2b,
-	0xca, 0xda, 0x98, 0x36, 0xca, 0x06, 0x1a, 0x26, 0xfa, 0x4d, 0x98, 0xeb, 0x18, 0x1e, 0x76, 0x02,
-	0x1d, 0x87, 0x08, 0x74, 0xcb, 0x69, 0xb9, 0xd5, 0x32, 0xdd, 0x78, 0x4d, 0xb8, 0xf1, 0x63, 0x0a,
-	0x11, 0xed, 0xd8, 0x70, 0x5a, 0xae, 0x76, 0xba, 0x93, 0x1d, 0x44, 0x55, 0x18, 0x31, 0x82, 0x00,
-	0xb7, 0x3b, 0x41, 0xf5, 0xd4, 0x8a, 0xb2, 0x36, 0xa4, 0x85, 0x8f, 0x68, 0x13, 0xa6, 0xf0, 0x8b,
-	0x8e, 0xc5, 0x54, 0x4c, 0x27, 0xba, 0x54, 0x1d, 0xa2, 0x3b, 0xd6, 0xea, 0x4c, 0x8f, 0xea, 0xa1,
-	0x1e, 0xd5, 0x77, 0x42, 0x45, 0xd3, 0x2a, 0x3d, 0x10, 0x32, 0x88, 0x5a, 0xb0, 0xd8, 0x74, 0x9d,
-	0xc0, 0x72, 0xba, 0x58, 0x37, 0x7c, 0xdd, 0xc1, 0x87, 0xba, 0xe5, 0x58, 0x81, 0x65, 0x04, 0xae,
-	0x57, 0x1d, 0x5e, 0x51, 0xd6, 0x2a, 0x1b, 0x6f, 0x08, 0x0f, 0xb0, 0xc9, 0xa1, 0x6e, 0xf9, 0x0f,
-	0xf1, 0x61, 0x23, 0x04, 0xd1, 0xe6, 0x9b, 0xc2, 0x71, 0xd4, 0x80, 0x99, 0x70, 0xc6, 0xd4, 0x5b,
-	0x86, 0x65, 0x77, 0x3d, 0x5c, 0x1d, 0xa1, 0xe4, 0x9e, 0x11, 0xe2, 0xbf, 0xcb, 0xd6, 0x68, 0xd3,
-	0x11, 0x18, 0x1f, 0x41, 0x1a, 0xcc, 0xdb, 0x86, 0x1f, 0xe8, 0x4d, 0xb7, 0xdd, 0xb1, 0x31, 0x3d,
-	0xbc, 0x87, 0xfd, 0xae, 0x1d, 0x54, 0x47, 0x25, 0xf8, 0x1e, 0x1b, 0x47, 0xb6, 0x6b, 0x98, 0xda,
-	0x2c, 0x81, 0xdd, 0x8c, 0x40, 0x35, 0x0a, 0x89, 0xfe, 0x3f, 0x2c, 0xb5, 0x2c, 0xcf, 0x0f, 0x74,
-	0x13, 0x37, 0x2d, 0x9f, 0xf2, 0xd3, 0xf0, 0x9f, 0xe9, 0xbb, 0x46, 0xf3, 0x99, 0xdb, 0x6a, 0x55,
-	0xc7, 0x28, 0xe2, 0xc5, 0x0c, 0x5f, 0xb7, 0xb8, 0x81, 0xd3, 0xaa, 0x14, 0x7a, 0x8b, 0x03, 0xef,
-	0x18, 0xfe, 0xb3, 0xdb, 0x0c, 0x14, 0x1d, 0xc0, 0x74, 0xc7, 0xf0, 0x02, 0x8b, 0xd2, 0xd9, 0x74,
-	0x9d, 0x96, 0xb5, 0x57, 0x85, 0x95, 0xf2, 0xda, 0xf8, 0xc6, 0xff, 0xab, 0xe7, 0x18, 0x52, 0xb9,
-	0x54, 0x12, 0xd1, 0x61, 0xe8, 0x36, 0x29, 0xb6, 0x3b, 0x4e, 0xe0, 0x1d, 0x69, 0x53, 0x9d, 0xe4,
-	0x68, 0xed, 0x36, 0xcc, 0x8a, 0x16, 0xa2, 0x69, 0x28, 0x3f, 0xc3, 0x47, 0x54, 0x29, 0xc6, 0x34,
-	0xf2, 0x13, 0xcd, 0xc2, 0xd0, 0x81, 0x61, 0x77, 0x31, 0x17, 0x6c, 0xf6, 0x70, 0xbd, 0x74, 0x4d,
-	0x51, 0xaf, 0xc2, 0x72, 0x1e, 0x29, 0x7e, 0xc7, 0x75, 0x7c, 0x8c, 0xe6, 0x60, 0xd8, 0xeb, 0x52,
-	0xad, 0x60, 0x08, 0x87, 0xbc, 0xae, 0xd3, 0x30, 0xd5, 0xbf, 0x29, 0xc1, 0xf2, 0xb6, 0xb5, 0xe7,
-	0x18, 0x76, 0xae, 0x82, 0x3e, 0x48, 0x2b, 0xe8, 0x65, 0xb1, 0x82, 0x4a, 0xb1, 0xf4, 0xa9, 0xa1,
-	0x2d, 0x58, 0xc2, 0x2f, 0x02, 0xec, 0x39, 0x86, 0x1d, 0x19, 0xde, 0x9e, 0xb2, 0x72, 0x3d, 0x7d,
-	0x4d, 0xb8, 0x7f, 0x76, 0xe7, 0xc5, 0x10, 0x55, 0x66, 0x0a, 0xd5, 0xe1, 0x74, 0x73, 0xdf, 0xb2,
-	0xcd, 0xde, 0x26, 0xae, 0x63, 0x1f, 0x51, 0xbd, 0x1d, 0xd5, 0x66, 0xe8, 0x54, 0x08, 0xf4, 0xc8,
-	0xb1, 0x8f, 0xd4, 0x55, 0x38, 0x97, 0x7b, 0x3e, 0xc6, 0x60, 0xf5, 0x17, 0x25, 0xb8, 0xc0, 0xd7,
-	0x58, 0xc1, 0xbe, 0xdc, 0xe6, 0x3d, 0x4d, 0xb3, 0xf4, 0x86, 0x8c, 0xa5, 0x45, 0xe8, 0xfa, 0xe4,
-	0xed, 0x67, 0x8a, 0x40, 0xc0, 0xcb, 0x54, 0xc0, 0x3f, 0xca, 0x17, 0xf0, 0xfe, 0x48, 0xf8, 0x5f,
-	0x14, 0xf5, 0x5b, 0xb0, 0x56, 0x4c, 0x94, 0x5c, 0xe8, 0xbf, 0xa7, 0xc0, 0x59, 0x0d, 0xfb, 0xf8,
-	0xc4, 0x2f, 0x25, 0x29, 0x92, 0xfe, 0xae, 0x85, 0xa8, 0x6e, 0x1e, 0x1a, 0xf9, 0x29, 0xbe, 0x28,
-	0xc1, 0xea, 0x0e, 0xf6, 0xda, 0x96, 0x63, 0x04, 0x38, 0xf7, 0x24, 0x8f, 0xd3, 0x27, 0xb9, 0x22,
-	0x3c, 0x49, 0x21, 0xa2, 0x5f, 0x71, 0x05, 0x7e, 0x15, 0x54, 0xd9, 0x11, 0xb9, 0x0e, 0xff, 0x40,
-	0x81, 0x95, 0x2d, 0xec, 0x37, 0x3d, 0x6b, 0x37, 0x9f, 0xa3, 0x8f, 0xd2, 0x1c, 0x7d, 0x47, 0x78,
-	0x9c, 0x22, 0x3c, 0x7d, 0x8a, 0xc7, 0x7f, 0x95, 0x61, 0x55, 0x82, 0x8a, 0x8b, 0x88, 0x0d, 0x0b,
-	0x3d, 0x97, 0x86, 0xa9, 0x36, 0x7f, 0xe1, 0x49, 0x6d, 0x76, 0x06, 0xe1, 0x66, 0x1c, 0x54, 0x9b,
-	0xc7, 0xc2, 0x71, 0xb4, 0x0b, 0x0b, 0xd9, 0xbb, 0x65, 0x9e, 0x54, 0x89, 0xee, 0x76, 0xb1, 0xbf,
-	0xdd, 0xa8, 0x2f, 0x35, 0x77, 0x28, 0x1a, 0x46, 0x1f, 0x03, 0xea, 0x60, 0xc7, 0xb4, 0x9c, 0x3d,
-	0xdd, 0x68, 0x06, 0xd6, 0x81, 0x15, 0x58, 0xd8, 0xe7, 0xe6, 0x2a, 0xc7, 0x51, 0x63, 0xcb, 0x6f,
-	0xb1, 0xd5, 0x47, 0x14, 0xf9, 0x4c, 0x27, 0x31, 0x68, 0x61, 0x1f, 0xfd, 0x1a, 0x4c, 0x87, 0x88,
-	0xa9, 0x98, 0x78, 0xd8, 0xa9, 0x9e, 0xa2, 0x68, 0xeb, 0x32, 0xb4, 0x9b, 0x64, 0x6d, 0x92, 0xf2,
-	0xa9, 0x4e, 0x6c, 0xca, 0xc3, 0x0e, 0xda, 0xee, 0xa1, 0x0e, 0xbd, 0x13, 0xee, 0xe8, 0x49, 0x29,
-	0x0e, 0x9d, 0x91, 0x04, 0xd2, 0x70, 0x50, 0x7d, 0x01, 0xb3, 0x4f, 0x48, 0xcc, 0x13, 0x72, 0x2f,
-	0x14, 0xc3, 0xcd, 0xb4, 0x18, 0xbe, 0x2e, 0xdc, 0x43, 0x04, 0xdb, 0xa7, 0xe8, 0xfd, 0x48, 0x81,
-	0xb9, 0x14, 0x38, 0x17, 0xb7, 0x9b, 0x30, 0x41, 0xe3, 0xb0, 0xd0, 0x9d, 0x53, 0xfa, 0x70, 0xe7,
-	0xc6, 0x29, 0x04, 0xf7, 0xe2, 0x1a, 0x50, 0x09, 0x11, 0xfc, 0x36, 0x6e, 0x06, 0xd8, 0xe4, 0x82,
-	0xa3, 0xe6, 0x9f, 0x41, 0xe3, 0x2b, 0xb5, 0xc9, 0xe7, 0xf1, 0x47, 0xf5, 0xf7, 0x15, 0xa8, 0x51,
-	0x03, 0xba, 0x1d, 0x58, 0xcd, 0x67, 0x47, 0xc4, 0xa3, 0xbb, 0x6f, 0xf9, 0x41, 0xc8, 0xa6, 0x46,
-	0x9a, 0x4d, 0xeb, 0xf9, 0x96, 0x5c, 0x88, 0xa1, 0x4f, 0x66, 0x9d, 0x85, 0x25, 0x21, 0x0e, 0x6e,
-	0x59, 0x7e, 0x56, 0x82, 0xf9, 0x7b, 0x38, 0x78, 0xd0, 0x0d, 0x8c, 0x5d, 0x1b, 0x6f, 0x07, 0x46,
-	0x80, 0x35, 0x11, 0x5a, 0x25, 0x65, 0x4f, 0x3f, 0x02, 0x24, 0x30, 0xa3, 0xa5, 0x81, 0xcc, 0xe8,
-	0x4c, 0x46, 0xc3, 0xd0, 0x65, 0x98, 0xc7, 0x2f, 0x3a, 0x94, 0x81, 0xba, 0x83, 0x5f, 0x04, 0x3a,
-	0x3e, 0x20, 0x61, 0x91, 0x65, 0x52, 0x0b, 0x5d, 0xd6, 0x4e, 0x87, 0xb3, 0x0f, 0xf1, 0x8b, 0xe0,
-	0x0e, 0x99, 0x6b, 0x98, 0xe8, 0x2d, 0x98, 0x6d, 0x76, 0x3d, 0x1a, 0x3f, 0xed, 0x7a, 0x86, 0xd3,
-	0xdc, 0xd7, 0x03, 0xf7, 0x19, 0xd5, 0x1e, 0x65, 0x6d, 0x42, 0x43, 0x7c, 0xee, 0x36, 0x9d, 0xda,
-	0x21, 0x33, 0xe8, 0x37, 0x60, 0xf6, 0x00, 0x7b, 0xd4, 0x4b, 0xe7, 0x3e, 0x85, 0x6e, 0x05, 0xb8,
-	0xcd, 0x95, 0x22, 0x2d, 0xb0, 0x24, 0x68, 0x25, 0x27, 0x78, 0xca, 0x40, 0x3e, 0x60, 0x10, 0x8d,
-	0x00, 0xb7, 0x35, 0x74, 0x90, 0x19, 0x53, 0xff, 0x61, 0x0c, 0x16, 0x32, 0x2c, 0xe5, 0x02, 0x2a,
-	0x66, 0x9b, 0x72, 0x52, 0xb6, 0xdd, 0x85, 0xc9, 0x08, 0x6d, 0x70, 0xd4, 0xc1, 0xfc, 0x22, 0x56,
-	0xa5, 0x18, 0x77, 0x8e, 0x3a, 0x58, 0x9b, 0x38, 0x8c, 0x3d, 0x21, 0x15, 0x26, 0x45, 0x5c, 0x1f,
-	0x77, 0x62, 0xdc, 0x7e, 0x0a, 0x8b, 0x1d, 0x0f, 0x1f, 0x58, 0x6e, 0xd7, 0xd7, 0x7d, 0xe2, 0xe6,
-	0x60, 0xb3, 0xb7, 0xfe, 0x14, 0xdd, 0x77, 0x29, 0x13, 0xe6, 0x34, 0x9c, 0xe0, 0xca, 0xdb, 0x4f,
-	0x89, 0xaf, 0xa4, 0xcd, 0x87, 0xd0, 0xdb, 0x0c, 0x38, 0xc4, 0xfb, 0x26, 0x9c, 0xa6, 0x41, 0x19,
-	0x8b, 0xa2, 0x22, 0x8c, 0x43, 0x94, 0x82, 0x69, 0x32, 0x75, 0x97, 0xcc, 0x84, 0xcb, 0xaf, 0xc3,
-	0x18, 0x0d, 0xb0, 0x6c, 0xcb, 0x0f, 0x68, 0x98, 0x39, 0xbe, 0x71, 0x56, 0xec, 0x41, 0x84, 0x22,
-	0x3f, 0x1a, 0xf0, 0x5f, 0xe8, 0x1e, 0x4c, 0xfb, 0x54, 0x1d, 0xf4, 0x1e, 0x8a, 0x91, 0x7e, 0x50,
-	0x54, 0xfc, 0x84, 0x16, 0xa1, 0xb7, 0x61, 0xbe, 0x69, 0x5b, 0x84, 0x52, 0xdb, 0xda, 0xf5, 0x0c,
-	0xef, 0x48, 0xe7, 0xf2, 0x40, 0x03, 0xc9, 0x31, 0x6d, 0x96, 0xcd, 0xde, 0x67, 0x93, 0x5c, 0x7e,
-	0x62, 0x50, 0x2d, 0x6c, 0x04, 0x5d, 0x0f, 0x47, 0x50, 0x63, 0x71, 0xa8, 0xbb, 0x6c, 0x32, 0x84,
-	0x3a, 0x07, 0xe3, 0x1c, 0xca, 0x6a, 0x77, 0xec, 0x2a, 0xd0, 0xa5, 0xc0, 0x86, 0x1a, 0xed, 0x8e,
-	0x8d, 0x7c, 0xb8, 0x98, 0x3e, 0x95, 0xee, 0x37, 0xf7, 0xb1, 0xd9, 0xb5, 0xb1, 0x1e, 0xb8, 0xec,
-	0xb2, 0x68, 0x94, 0xef, 0x76, 0x83, 0xea, 0x78, 0x51, 0x40, 0xfa, 0x6a, 0xf2, 0xac, 0xdb, 0x1c,
-	0xd3, 0x8e, 0x4b, 0xef, 0x6d, 0x87, 0xa1, 0x21, 0xfe, 0x0e, 0xbb, 0x2a, 0x22, 0xff, 0xbd, 0x83,
-	0x4c, 0xd0, 0x44, 0xc3, 0x0c, 0x9d, 0xda, 0x26, 0x33, 0xe1, 0x29, 0xf2, 0x74, 0x75, 0x32, 0x57,
-	0x57, 0xef, 0x43, 0x25, 0x92, 0x6d, 0x9f, 0x28, 0x53, 0xb5, 0x42, 0x93, 0x0a, 0xe7, 0x93, 0x57,
-	0xc5, 0x32, 0x3d, 0x71, 0xf9, 0x66, 0x9a, 0x17, 0x29, 0x06, 0x7d, 0x44, 0x4d, 0x98, 0x8d, 0xb0,
-	0x35, 0x6d, 0xd7, 0xc7, 0x1c, 0xe7, 0x14, 0xc5, 0x79, 0xa9, 0x4f, 0x6f, 0x84, 0x00, 0x12, 0x7c,
-	0x5d, 0x5f, 0x8b, 0xf4, 0x39, 0x1a, 0x24, 0x5a, 0x3e, 0x93, 0x34, 0x2f, 0xc4, 0x45, 0x98, 0x16,
-	0xbd, 0x70, 0x7b, 0x54, 0x27, 0x8c, 0x8b, 0x85, 0x7d, 0x6d, 0xfa, 0x20, 0x35, 0x82, 0x6e, 0xc0,
-	0x92, 0x45, 0x74, 0x2e, 0x75, 0xc7, 0xd8, 0x21, 0x76, 0xc6, 0xac, 0xce, 0x50, 0x1f, 0x73, 0xc1,
-	0xf2, 0x93, 0xa6, 0xfe, 0x0e, 0x9b, 0x46, 0xab, 0x30, 0x11, 0xda, 0x3a, 0xdf, 0xfa, 0x14, 0x57,
-	0x11, 0x53, 0x6d, 0x3e, 0xb6, 0x6d, 0x7d, 0x8a, 0xd5, 0x5f, 0x2a, 0xb0, 0xf0, 0xd8, 0xb5, 0xed,
-	0xff, 0x5b, 0x6f, 0x03, 0xf5, 0xc7, 0xa3, 0x50, 0xcd, 0x1e, 0xfb, 0x1b, 0x8b, 0xfd, 0x8d, 0xc5,
-	0xfe, 0x3a, 0x5a, 0xec, 0x3c, 0xfd, 0x98, 0xc8, 0xb5, 0xc0, 0x42, 0x73, 0x36, 0x79, 0x62, 0x73,
-	0xf6, 0xab, 0x67, 0xd8, 0xd5, 0x7f, 0x29, 0xc1, 0x8a, 0x86, 0x9b, 0xae, 0x67, 0xc6, 0x13, 0xb5,
-	0x5c, 0x2d, 0x5e, 0xa6, 0xa5, 0x3c, 0x07, 0xe3, 0x91, 0xe0, 0x44, 0x46, 0x00, 0xc2, 0xa1, 0x86,
-	0x89, 0x16, 0x60, 0x84, 0xca, 0x18, 0xd7, 0xf8, 0xb2, 0x36, 0x4c, 0x1e, 0x1b, 0x26, 0x3a, 0x0b,
-	0xc0, 0xe3, 0x88, 0x50, 0x77, 0xc7, 0xb4, 0x31, 0x3e, 0xd2, 0x30, 0x91, 0x06, 0x13, 0x1d, 0xd7,
-	0xb6, 0xf5, 0x30, 0x56, 0x19, 0x96, 0xc4, 0x2a, 0xc4, 0x86, 0xde, 0x75, 0xbd, 0x38, 0x6b, 0xc2,
-	0x58, 0x65, 0x9c, 0x20, 0xe1, 0x0f, 0xea, 0xef, 0x8d, 0xc2, 0xaa, 0x84, 0x8b, 0xdc, 0xf0, 0x66,
-	0x2c, 0xa4, 0x72, 0x3c, 0x0b, 0x29, 0xb5, 0x7e, 0xa5, 0xe3, 0x5b, 0xbf, 0x6f, 0x01, 0x0a, 0xf9,
-	0x6b, 0xa6, 0xcd, 0xef, 0x74, 0x34, 0x13, 0xae, 0x5e, 0x23, 0x06, 0x4c, 0x60, 0x7a, 0xcb, 0xc4,
-	0x42, 0x25, 0xf0, 0x66, 0x2c, 0xfa, 0x50, 0xd6, 0xa2, 0xc7, 0x4a, 0x3a, 0xc3, 0xc9, 0x92, 0xce,
-	0x35, 0xa8, 0x72, 0x93, 0xd2, 0x4b, 0x80, 0x84, 0x0e, 0xc2, 0x08, 0x75, 0x10, 0xe6, 0xd9, 0x7c,
-	0x24, 0x3b, 0xa1, 0x7f, 0xa0, 0xc1, 0x64, 0x54, 0xba, 0xa0, 0x29, 0x13, 0x56, 0x0b, 0x79, 0x33,
-	0x4f, 0x1b, 0x77, 0x3c, 0xc3, 0xf1, 0x89, 0x29, 0x4b, 0xa4, 0x09, 0x26, 0xcc, 0xd8, 0x13, 0xfa,
-	0x04, 0xce, 0x08, 0x12, 0x32, 0x3d, 0x13, 0x3e, 0xd6, 0x8f, 0x09, 0x5f, 0xcc, 0x88, 0x7b, 0x64,
-	0xcd, 0x73, 0xbc, 0x4f, 0xc8, 0xf3, 0x3e, 0x57, 0x61, 0x22, 0x61, 0xf3, 0xc6, 0xa9, 0xcd, 0x1b,
-	0xdf, 0x8d, 0x19, 0xbb, 0x5b, 0x50, 0xe9, 0x5d, 0x2b, 0x2d, 0x89, 0x4d, 0x14, 0x96, 0xc4, 0x26,
-	0x23, 0x08, 0x5a, 0x11, 0x7b, 0x1f, 0x26, 0xc2, 0xbb, 0xa6, 0x08, 0x26, 0x0b, 0x11, 0x8c, 0xf3,
-	0xf5, 0x14, 0xdc, 0x80, 0x91, 0xe7, 0x5d, 0x4c, 0x8d, 0x6c, 0x85, 0xe6, 0x7f, 0xee, 0xe5, 0x66,
-	0xc1, 0x0b, 0xb5, 0x88, 0xa6, 0x28, 0x2c, 0xec, 0xb3, 0xbc, 0x77, 0x88, 0x37, 0xe3, 0x0b, 0x4e,
-	0x65, 0x7c, 0xc1, 0xda, 0x27, 0x30, 0x11, 0x87, 0x15, 0xa4, 0xc2, 0xaf, 0xc5, 0x53, 0xe1, 0x79,
-	0x29, 0x92, 0x50, 0x31, 0x59, 0xaa, 0x24, 0x96, 0x2e, 0xef, 0x99, 0xd2, 0x30, 0x31, 0xf6, 0x8d,
-	0x29, 0xcd, 0x98, 0xd2, 0x38, 0x6b, 0x84, 0xa6, 0xf4, 0xe7, 0xe5, 0xd0, 0x94, 0x0a, 0xb9, 0xc8,
-	0x4d, 0xe9, 0x87, 0x30, 0x95, 0x32, 0x55, 0x52, 0x63, 0xca, 0x93, 0x19, 0xd4, 0xd8, 0x68, 0x95,
-	0xa4, 0x29, 0xcb, 0x08, 0x77, 0x69, 0x30, 0xe1, 0x8e, 0x59, 0xae, 0x72, 0xd2, 0x72, 0x7d, 0x02,
-	0xcb, 0x49, 0xc5, 0xd3, 0xdd, 0x96, 0x1e, 0xec, 0x5b, 0xbe, 0x1e, 0xaf, 0x5e, 0xcb, 0xb7, 0xaa,
-	0x25, 0x14, 0xf1, 0x51, 0x6b, 0x67, 0xdf, 0xf2, 0x6f, 0x71, 0xfc, 0x0d, 0x98, 0xd9, 0xc7, 0x86,
-	0x17, 0xec, 0x62, 0x23, 0xd0, 0x4d, 0x1c, 0x18, 0x96, 0xed, 0xf3, 0x84, 0x8f, 0x3c, 0x41, 0x38,
-	0x1d, 0x81, 0x6d, 0x31, 0xa8, 0xec, 0xab, 0x69, 0xf8, 0x78, 0xaf, 0xa6, 0x0b, 0x30, 0x15, 0xe1,
-	0x61, 0x62, 0x4d, 0x6d, 0xf4, 0x98, 0x16, 0x39, 0x46, 0x5b, 0x74, 0x54, 0xfd, 0x73, 0x05, 0x5e,
-	0x61, 0xb7, 0x99, 0x50, 0x76, 0x5e, 0x84, 0xee, 0xe9, 0x8b, 0x96, 0x4e, 0x2a, 0x5e, 0xcb, 0x4b,
-	0x2a, 0x16, 0xa1, 0xea, 0x33, 0xbb, 0xf8, 0x77, 0x65, 0x78, 0x55, 0x8e, 0x8d, 0x8b, 0x20, 0xee,
-	0xbd, 0xff, 0x3c, 0x3e, 0xc6, 0x49, 0xbc, 0x7e, 0x7c, 0xeb, 0xa6, 0x4d, 0xf9, 0x29, 0x49, 0xff,
-	0x91, 0x02, 0xcb, 0xbd, 0xb4, 0x3c, 0xf1, 0xa1, 0x4d, 0xcb, 0xef, 0x18, 0x41, 0x73, 0x5f, 0xb7,
-	0xdd, 0xa6, 0x61, 0xdb, 0x47, 0xd5, 0x12, 0xb5, 0xa9, 0x9f, 0x48, 0x76, 0x2d, 0x3e, 0x4e, 0xbd,
-	0x97, 0xb7, 0xdf, 0x71, 0xb7, 0xf8, 0x0e, 0xf7, 0xd9, 0x06, 0xcc, 0xd4, 0x2e, 0x19, 0xf9, 0x2b,
-	0x6a, 0xbf, 0x03, 0x2b, 0x45, 0x08, 0x04, 0xf6, 0x76, 0x2b, 0x69, 0x6f, 0xc5, 0x55, 0x81, 0xd0,
-	0x0c, 0x50, 0x5c, 0x21, 0x62, 0xfa, 0x66, 0x8e, 0xd9, 0xde, 0x1f, 0x28, 0xc4, 0xf6, 0x66, 0x8e,
-	0x79, 0xd7, 0xb0, 0xec, 0x9e, 0x2c, 0xf5, 0x59, 0x4e, 0x2a, 0xc2, 0xd3, 0xa7, 0x20, 0xbd, 0x42,
-	0xec, 0x58, 0x2e, 0x26, 0x9e, 0xac, 0xfe, 0x53, 0x05, 0xd4, 0xac, 0xb5, 0xfb, 0x20, 0x54, 0xcf,
-	0x90, 0xf2, 0x27, 0x69, 0xca, 0xaf, 0xe6, 0x50, 0x5e, 0x84, 0xa9, 0x4f, 0xda, 0x1f, 0x13, 0xe5,
-	0x94, 0xe0, 0xe2, 0xb2, 0xf9, 0x3a, 0x4c, 0x37, 0x0d, 0xa7, 0x89, 0xa3, 0x37, 0x00, 0x66, 0xef,
-	0xb4, 0x51, 0x6d, 0x8a, 0x8d, 0x6b, 0xe1, 0x70, 0x5c, 0xdf, 0xe3, 0x38, 0x4f, 0xa8, 0xef, 0x32,
-	0x54, 0x7d, 0x1e, 0xf5, 0xb5, 0x48, 0xdd, 0x73, 0x90, 0xc5, 0x0a, 0x96, 0x82, 0x85, 0x27, 0x91,
-	0xb0, 0x5c, 0x3c, 0x03, 0x4b, 0x98, 0x08, 0x53, 0x42, 0xc2, 0xb2, 0x07, 0xa4, 0xf7, 0xd3, 0xa3,
-	0xbc, 0x6f, 0x09, 0x2b, 0xc2, 0xd4, 0x27, 0xed, 0xe7, 0xc5, 0xe2, 0x10, 0xe1, 0xe2, 0xd4, 0xff,
-	0xbd, 0x02, 0xe7, 0x34, 0xdc, 0x76, 0x0f, 0x30, 0xeb, 0x44, 0xf8, 0xaa, 0xe4, 0xf1, 0x92, 0x8e,
-	0x51, 0x39, 0xe5, 0x18, 0xa9, 0x2a, 0x91, 0x95, 0x3c, 0xaa, 0xf9, 0xd1, 0xfe, 0xb1, 0x04, 0xe7,
-	0xf9, 0x11, 0xd8, 0xb1, 0x73, 0xcb, 0xe0, 0xd2, 0x03, 0x1a, 0x50, 0x49, 0xea, 0x20, 0x3f, 0xdc,
-	0xf5, 0x9c, 0xfb, 0xeb, 0x63, 0x43, 0x6d, 0x32, 0xa1, 0xbd, 0x68, 0x17, 0x16, 0xa2, 0x4e, 0x03,
-	0x61, 0x3b, 0x9f, 0xb8, 0x08, 0x7d, 0x87, 0xc3, 0xa4, 0x8a, 0xd0, 0x58, 0x34, 0x3c, 0x70, 0x97,
-	0xc1, 0x1a, 0xbc, 0x56, 0x74, 0x16, 0xce, 0xe7, 0x7f, 0x56, 0x60, 0x29, 0x4c, 0x1c, 0x09, 0x02,
-	0xf9, 0x97, 0x22, 0x3e, 0x17, 0x61, 0xc6, 0xf2, 0xf5, 0x64, 0x77, 0x1d, 0xe5, 0xe5, 0xa8, 0x36,
-	0x65, 0xf9, 0x77, 0xe3, 0x7d, 0x73, 0xea, 0x32, 0x9c, 0x11, 0x93, 0xcf, 0xcf, 0xf7, 0x39, 0x75,
-	0x58, 0x88, 0xb1, 0x4e, 0x16, 0xce, 0x33, 0xa6, 0xf5, 0x65, 0x1c, 0x74, 0x15, 0x26, 0x78, 0xeb,
-	0x24, 0x36, 0x63, 0xb9, 0xdc, 0x68, 0xac, 0x61, 0xa2, 0x8f, 0xe1, 0x74, 0x33, 0x24, 0x35, 0xb6,
-	0xf5, 0xa9, 0x81, 0xb6, 0x46, 0x11, 0x8a, 0xde, 0xde, 0xf7, 0x61, 0x3a, 0xd6, 0x0e, 0xc9, 0x82,
-	0x84, 0xa1, 0x7e, 0x83, 0x84, 0xa9, 0x1e, 0x28, 0x8b, 0x12, 0xce, 0x02, 0x84, 0xee, 0x9e, 0x65,
-	0x52, 0xf7, 0xb8, 0xac, 0x8d, 0xf1, 0x91, 0x86, 0xa9, 0x5e, 0x20, 0xca, 0x2c, 0xbd, 0x04, 0x7e,
-	0x5d, 0xff, 0x5e, 0x82, 0xaa, 0xc6, 0x7b, 0x85, 0x31, 0x45, 0xed, 0x3f, 0xdd, 0x78, 0x99, 0x57,
-	0xf4, 0x5b, 0x30, 0x27, 0xaa, 0x1c, 0x87, 0x1d, 0x20, 0x03, 0x94, 0x8e, 0x4f, 0x67, 0x4b, 0xc7,
-	0x3e, 0x7a, 0x07, 0x86, 0x29, 0xeb, 0x7d, 0x7e, 0xa3, 0xe2, 0xd4, 0xc8, 0x96, 0x11, 0x18, 0xb7,
-	0x6d, 0x77, 0x57, 0xe3, 0x8b, 0xd1, 0x26, 0x54, 0x1c, 0x7c, 0xa8, 0x7b, 0x5d, 0x7e, 0x73, 0x61,
-	0x60, 0x53, 0x00, 0x3e, 0xe1, 0xe0, 0x43, 0xad, 0xcb, 0xae, 0xcc, 0x57, 0x97, 0x60, 0x51, 0xc0,
-	0x6a, 0x7e, 0x11, 0xdf, 0x53, 0x60, 0x7e, 0xfb, 0xc8, 0x69, 0x6e, 0xef, 0x1b, 0x9e, 0xc9, 0x33,
-	0xa4, 0xfc, 0x1a, 0xce, 0x43, 0xc5, 0x77, 0xbb, 0x5e, 0x13, 0xeb, 0xbc, 0x85, 0x9c, 0xdf, 0xc5,
-	0x24, 0x1b, 0xdd, 0x64, 0x83, 0x68, 0x11, 0x46, 0x7d, 0x02, 0x1c, 0xbe, 0xdf, 0x86, 0xb4, 0x11,
-	0xfa, 0xdc, 0x30, 0x51, 0x1d, 0x4e, 0xd1, 0x58, 0xb2, 0x5c, 0x18, 0xe0, 0xd1, 0x75, 0xea, 0x22,
-	0x2c, 0x64, 0x68, 0xe1, 0x74, 0xfe, 0x74, 0x08, 0x4e, 0x93, 0xb9, 0xf0, 0x3d, 0xf9, 0x32, 0x65,
-	0xa5, 0x0a, 0x23, 0x61, 0x46, 0x8a, 0x69, 0x72, 0xf8, 0x48, 0x14, 0xbd, 0x17, 0xeb, 0x46, 0x79,
-	0x84, 0x28, 0xef, 0x40, 0x78, 0x92, 0xcd, 0x43, 0x0d, 0x0d, 0x9a, 0x87, 0x92, 0x2b, 0x61, 0x26,
-	0x92, 0x1f, 0x19, 0x2c, 0x92, 0xff, 0x90, 0x57, 0x7f, 0x7a, 0x41, 0x35, 0xc5, 0x32, 0x5a, 0x88,
-	0x65, 0x86, 0x80, 0x45, 0xee, 0x31, 0xc5, 0x75, 0x05, 0x46, 0xc2, 0x88, 0x7c, 0xac, 0x8f, 0x88,
-	0x3c, 0x5c, 0x1c, 0xcf, 0x26, 0x40, 0x32, 0x9b, 0x70, 0x13, 0x26, 0x58, 0x6d, 0x8a, 0x37, 0x8a,
-	0x8f, 0xf7, 0xd1, 0x28, 0x3e, 0x4e, 0x4b, 0x56, 0xbc, 0x47, 0xfc, 0x2d, 0xa0, 0x7d, 0xde, 0xfc,
-	0xd3, 0x09, 0xdd, 0x32, 0xb1, 0x13, 0x58, 0xc1, 0x11, 0xcd, 0x06, 0x8e, 0x69, 0x88, 0xcc, 0x7d,
-	0x4c, 0xa7, 0x1a, 0x7c, 0x06, 0x3d, 0x84, 0xa9, 0x94, 0x69, 0xe0, 0x99, 0xbf, 0xf3, 0x7d, 0x19,
-	0x05, 0xad, 0x92, 0x34, 0x08, 0xea, 0x3c, 0xcc, 0x26, 0x25, 0x99, 0x8b, 0xf8, 0x9f, 0x28, 0xb0,
-	0x14, 0x76, 0xde, 0x7d, 0x45, 0x3c, 0x3c, 0xf5, 0x8f, 0x15, 0x38, 0x23, 0xa6, 0x89, 0x07, 0x3f,
-	0x97, 0x61, 0xbe, 0xcd, 0xc6, 0x59, 0x5d, 0x46, 0xb7, 0x1c, 0xbd, 0x69, 0x34, 0xf7, 0x31, 0xa7,
-	0xf0, 0x74, 0x3b, 0x06, 0xd5, 0x70, 0x36, 0xc9, 0x14, 0x7a, 0x17, 0x16, 0x33, 0x40, 0xa6, 0x11,
-	0x18, 0xbb, 0x86, 0x1f, 0x36, 0xe0, 0xce, 0x27, 0xe1, 0xb6, 0xf8, 0xac, 0x7a, 0x06, 0x6a, 0x21,
-	0x3d, 0x9c, 0x9f, 0x1f, 0xb8, 0x51, 0xeb, 0x94, 0xfa, 0xbb, 0xa5, 0x1e, 0x0b, 0x13, 0xd3, 0x9c,
-	0xda, 0x35, 0x98, 0x76, 0xba, 0xed, 0x5d, 0xec, 0xe9, 0x6e, 0x4b, 0xa7, 0x56, 0xca, 0xa7, 0x74,
-	0x0e, 0x69, 0x15, 0x36, 0xfe, 0xa8, 0x45, 0x8d, 0x8f, 0x4f, 0x98, 0x1d, 0x5a, 0x35, 0x9f, 0xa6,
-	0x16, 0x86, 0xb4, 0x51, 0x6e, 0xd6, 0x7c, 0xd4, 0x80, 0x09, 0x7e, 0x13, 0xec, 0xa8, 0xe2, 0x2e,
-	0xd3, 0x50, 0x1c, 0x58, 0xae, 0x87, 0x9e, 0x9c, 0xfa, 0x7e, 0xe3, 0x66, 0x6f, 0x00, 0x5d, 0x81,
-	0x05, 0xb6, 0x4f, 0xd3, 0x75, 0x02, 0xcf, 0xb5, 0x6d, 0xec, 0x51, 0x9e, 0x74, 0xd9, 0x9b, 0x62,
-	0x4c, 0x9b, 0xa3, 0xd3, 0x9b, 0xd1, 0x2c, 0xb3, 0x8b, 0x54, 0x43, 0x4c, 0xd3, 0xc3, 0xbe, 0xcf,
-	0x13, 0x92, 0xe1, 0xa3, 0x5a, 0x87, 0x19, 0x56, 0xd9, 0x22, 0x70, 0xa1, 0xec, 0xc4, 0x8d, 0xb4,
-	0x92, 0x30, 0xd2, 0xea, 0x2c, 0xa0, 0xf8, 0x7a, 0x2e, 0x8c, 0xff, 0xa9, 0xc0, 0x0c, 0x73, 0xde,
-	0xe3, 0x5e, 0x62, 0x3e, 0x1a, 0x74, 0x83, 0x57, 0x81, 0xa3, 0xa2, 0x77, 0x65, 0xe3, 0x5c, 0x0e,
-	0x43, 0x08, 0x46, 0x9a, 0x35, 0xa3, 0x75, 0x60, 0x9a, 0x31, 0x8b, 0xe5, 0x5e, 0xcb, 0x89, 0xdc,
-	0xeb, 0x26, 0x4c, 0x1d, 0x58, 0xbe, 0xb5, 0x6b, 0xd9, 0x56, 0x70, 0xc4, 0x2c, 0x51, 0x71, 0xba,
-	0xb0, 0xd2, 0x03, 0xa1, 0x66, 0x68, 0x15, 0x26, 0xf8, 0x2b, 0x4c, 0x77, 0x0c, 0x6e, 0x71, 0xc7,
-	0xb4, 0x71, 0x3e, 0xf6, 0xd0, 0x68, 0x63, 0xc2, 0x85, 0xf8, 0x71, 0x39, 0x17, 0xbe, 0x4f, 0xb9,
-	0xe0, 0xe3, 0xe0, 0x49, 0x17, 0x77, 0x71, 0x1f, 0x5c, 0x48, 0xef, 0x54, 0xca, 0xec, 0x94, 0x64,
-	0x54, 0x79, 0x40, 0x46, 0x31, 0x3a, 0x7b, 0x04, 0x71, 0x3a, 0x7f, 0xa8, 0xc0, 0x6c, 0x28, 0xf7,
-	0x5f, 0x19, 0x52, 0x1f, 0xc1, 0x5c, 0x8a, 0x26, 0xae, 0x85, 0x57, 0x60, 0xa1, 0xe3, 0xb9, 0x4d,
-	0xec, 0xfb, 0x96, 0xb3, 0xa7, 0xd3, 0xaf, 0xca, 0x98, 0x1d, 0x20, 0xca, 0x58, 0x26, 0x32, 0xdf,
-	0x9b, 0xa6, 0x90, 0xd4, 0x08, 0xf8, 0xea, 0xe7, 0x0a, 0x9c, 0xbd, 0x87, 0x03, 0xad, 0xf7, 0x8d,
-	0xd9, 0x03, 0xec, 0xfb, 0xc6, 0x1e, 0x8e, 0x5c, 0x96, 0x9b, 0x30, 0x4c, 0x0b, 0x40, 0x0c, 0xd1,
-	0xf8, 0xc6, 0x85, 0x1c, 0x6a, 0x63, 0x28, 0x68, 0x75, 0x48, 0xe3, 0x60, 0x7d, 0x30, 0x85, 0xd8,
-	0x98, 0xe5, 0x3c, 0x2a, 0xf8, 0x01, 0x9f, 0x43, 0x85, 0x71, 0xbd, 0xcd, 0x67, 0x38, 0x39, 0x1f,
-	0xe6, 0x26, 0x27, 0xe5, 0x08, 0xeb, 0x54, 0x37, 0xc3, 0x51, 0x96, 0x88, 0x9c, 0xf4, 0xe3, 0x63,
-	0x35, 0x1b, 0x50, 0x76, 0x51, 0x3c, 0xd9, 0x38, 0xc4, 0x92, 0x8d, 0xdf, 0x49, 0x26, 0x1b, 0x2f,
-	0x16, 0x33, 0x28, 0x22, 0x26, 0x96, 0x68, 0x6c, 0xc3, 0xca, 0x3d, 0x1c, 0x6c, 0xdd, 0x7f, 0x22,
-	0xb9, 0x8b, 0x06, 0x00, 0x53, 0x69, 0xa7, 0xe5, 0x86, 0x0c, 0xe8, 0x63, 0x3b, 0x22, 0x48, 0xd4,
-	0x4c, 0x52, 0xd1, 0x23, 0xbf, 0x7c, 0xf5, 0x05, 0xac, 0x4a, 0xb6, 0xe3, 0x4c, 0xdf, 0x86, 0x99,
-	0xd8, 0xd7, 0x87, 0xb4, 0x18, 0x19, 0x6e, 0xfb, 0x5a, 0x7f, 0xdb, 0x6a, 0xd3, 0x5e, 0x72, 0xc0,
-	0x57, 0xff, 0x55, 0x81, 0x59, 0x0d, 0x1b, 0x9d, 0x8e, 0xcd, 0x22, 0xa2, 0xe8, 0x74, 0xf3, 0x30,
-	0xcc, 0x33, 0xfb, 0xec, 0x3d, 0xc7, 0x9f, 0xe4, 0x1f, 0x2b, 0x88, 0x5f, 0xd2, 0xe5, 0x93, 0xfa,
-	0xa3, 0xc7, 0x0b, 0x2e, 0xd4, 0x05, 0x98, 0x4b, 0x1d, 0x8d, 0x5b, 0x93, 0x9f, 0x28, 0xb0, 0xa4,
-	0xe1, 0x96, 0x87, 0xfd, 0xfd, 0xa8, 0xc8, 0x41, 0xb8, 0xf1, 0x15, 0x3c, 0xbb, 0xba, 0x0c, 0x67,
-	0xc4, 0xa4, 0xf2, 0xb3, 0xbc, 0x0b, 0x0b, 0x9b, 0x6e, 0xd7, 0x21, 0xc2, 0x93, 0x16, 0xd0, 0x65,
-	0x80, 0x96, 0xeb, 0x35, 0xf1, 0x5d, 0x1c, 0x34, 0xf7, 0x79, 0xc6, 0x36, 0x36, 0xa2, 0x1a, 0x50,
-	0xcd, 0x82, 0x72, 0x61, 0xbb, 0x03, 0x23, 0xd8, 0x09, 0x68, 0x2d, 0x97, 0x89, 0xd8, 0x1b, 0x39,
-	0x22, 0xc6, 0xbd, 0x90, 0xad, 0xfb, 0x4f, 0x28, 0x2e, 0x5e, 0xaf, 0xe5, 0xb0, 0xea, 0x4f, 0x4a,
-	0x30, 0xaf, 0x61, 0xc3, 0x14, 0x50, 0xb7, 0x01, 0xa7, 0xa2, 0xee, 0x88, 0xca, 0xc6, 0x72, 0x9e,
-	0x6f, 0x71, 0xff, 0x09, 0xb5, 0xba, 0x74, 0xad, 0x2c, 0x14, 0xcb, 0x06, 0x73, 0x65, 0x51, 0x30,
-	0xb7, 0x03, 0x55, 0xcb, 0x21, 0x2b, 0xac, 0x03, 0xac, 0x63, 0x27, 0xb2, 0x60, 0x7d, 0x76, 0x94,
-	0xcd, 0x45, 0xc0, 0x77, 0x9c, 0xd0, 0x14, 0x35, 0x4c, 0x22, 0x18, 0x1d, 0x82, 0x84, 0xd6, 0xa4,
-	0x87, 0x28, 0x61, 0xa3, 0x64, 0x60, 0xdb, 0xfa, 0x14, 0xa3, 0xd7, 0x60, 0x8a, 0xf6, 0x45, 0xd0,
-	0x15, 0xac, 0x7c, 0x3f, 0x4c, 0xcb, 0xf7, 0xb4, 0x5d, 0xe2, 0xb1, 0xb1, 0x87, 0x59, 0x37, 0xdf,
-	0xdf, 0x96, 0x60, 0x21, 0xc3, 0x2b, 0x7e, 0x1d, 0xc7, 0x61, 0x96, 0xd0, 0x5e, 0x94, 0x4e, 0x66,
-	0x2f, 0xd0, 0x77, 0x61, 0x3e, 0x83, 0x34, 0xcc, 0x11, 0x0e, 0x6a, 0x00, 0x67, 0xd3, 0xd8, 0x69,
-	0x8a, 0x50, 0xc0, 0xae, 0x53, 0x22, 0x76, 0xfd, 0x42, 0x81, 0x85, 0xc7, 0x5d, 0x6f, 0x0f, 0x7f,
-	0xbd, 0x65, 0x4b, 0xad, 0x41, 0x35, 0x7b, 0x4c, 0xae, 0xfc, 0x5f, 0x94, 0x60, 0xe1, 0x01, 0xfe,
-	0xda, 0xf3, 0xe0, 0x7f, 0x46, 0xbf, 0x6e, 0x43, 0x35, 0xcb, 0x2b, 0xae, 0x5f, 0x02, 0x1c, 0x8a,
-	0x08, 0xc7, 0x67, 0x0a, 0x9c, 0x79, 0xe8, 0x06, 0x56, 0xeb, 0x88, 0x84, 0xdb, 0xee, 0x01, 0xf6,
-	0x1e, 0x18, 0x24, 0x96, 0x8e, 0xb8, 0xfe, 0x5d, 0x98, 0x6f, 0xf1, 0x19, 0xbd, 0x4d, 0xa7, 0xf4,
-	0x84, 0xc3, 0x96, 0xa7, 0x1f, 0x49, 0x74, 0xcc, 0x67, 0x9b, 0x6d, 0x65, 0x07, 0x7d, 0xf5, 0x1c,
-	0x9c, 0xcd, 0xa1, 0x80, 0x0b, 0x85, 0x01, 0x4b, 0xf7, 0x70, 0xb0, 0xe9, 0xb9, 0xbe, 0xcf, 0x6f,
-	0x25, 0xf1, 0x72, 0x4b, 0x04, 0x7e, 0x4a, 0x2a, 0xf0, 0x3b, 0x0f, 0x95, 0xc0, 0xf0, 0xf6, 0x70,
-	0x10, 0xdd, 0x32, 0x7b, 0xcd, 0x4d, 0xb2, 0x51, 0x8e, 0x4f, 0xfd, 0x65, 0x19, 0xce, 0x88, 0xf7,
-	0xe0, 0xfc, 0x6c, 0x13, 0x3c, 0xc4, 0x34, 0xec, 0x1e, 0xb1, 0x30, 0x94, 0x1f, 0xff, 0x9e, 0xcc,
-	0x41, 0xcc, 0x45, 0x47, 0x9d, 0x6f, 0xff, 0xf6, 0x11, 0x75, 0x00, 0xd9, 0x1b, 0x66, 0x22, 0x88,
-	0x0d, 0xa1, 0xcf, 0x14, 0x98, 0x6b, 0xd1, 0x82, 0x98, 0xde, 0x34, 0xba, 0x3e, 0xee, 0x6d, 0xcb,
-	0xec, 0xdd, 0x83, 0xe3, 0x6d, 0xcb, 0x6a, 0x6c, 0x9b, 0x04, 0x63, 0x62, 0x73, 0xd4, 0xca, 0x4c,
-	0xd4, 0x3a, 0x30, 0x93, 0xa1, 0x52, 0xe0, 0x9e, 0xde, 0x49, 0xba, 0xa7, 0xeb, 0x39, 0xe2, 0x90,
-	0xa6, 0x89, 0x5f, 0x5e, 0xdc, 0x47, 0xad, 0x75, 0x60, 0x21, 0x87, 0x40, 0xc1, 0xbe, 0x37, 0xe3,
-	0xfb, 0x56, 0x72, 0xd3, 0xbd, 0xf7, 0x70, 0xd0, 0x2b, 0x2e, 0x52, 0xbc, 0x71, 0xaf, 0xf8, 0x3f,
-	0x14, 0x58, 0xe3, 0xe5, 0xbc, 0x0c, 0xd3, 0x32, 0x75, 0x08, 0x49, 0x64, 0xd6, 0x9f, 0x94, 0xa1,
-	0xa7, 0x4c, 0x88, 0xa2, 0xbe, 0x8b, 0x30, 0x57, 0xdd, 0x3f, 0xd3, 0x78, 0xb7, 0xc5, 0x64, 0x10,
-	0x7b, 0xf2, 0xd1, 0xab, 0x30, 0xd9, 0x22, 0x0e, 0xd0, 0x43, 0xcc, 0x7c, 0x29, 0x5e, 0x7e, 0x4a,
-	0x0e, 0xaa, 0x1e, 0xbc, 0xde, 0xc7, 0x59, 0x23, 0x77, 0x69, 0x28, 0xf4, 0xc7, 0x8f, 0x77, 0xad,
-	0x14, 0x5a, 0x7d, 0x87, 0x7e, 0xd3, 0x16, 0x2a, 0x36, 0x7d, 0x49, 0xf6, 0x91, 0x1b, 0x53, 0x03,
-	0xfa, 0xdd, 0x56, 0x12, 0x2c, 0x72, 0x1c, 0xe6, 0x7a, 0x65, 0x97, 0x30, 0x11, 0xd3, 0xe5, 0x7d,
-	0x54, 0x43, 0x5a, 0xaf, 0x26, 0xb3, 0xcd, 0xb2, 0x30, 0x5d, 0x87, 0xe6, 0xc5, 0xc3, 0xaf, 0x2e,
-	0x79, 0x0a, 0x89, 0xe5, 0x87, 0x26, 0xf9, 0x28, 0xcb, 0x20, 0xa9, 0x0d, 0x98, 0xd7, 0x8c, 0x00,
-	0xdb, 0x56, 0xdb, 0x0a, 0x3e, 0xea, 0x98, 0xb1, 0x44, 0xde, 0x3a, 0x9c, 0x32, 0x8d, 0xc0, 0xe0,
-	0xcc, 0x58, 0xca, 0x6b, 0xc4, 0xbc, 0xe5, 0x1c, 0x69, 0x74, 0xa1, 0xfa, 0x21, 0x2c, 0x64, 0x50,
-	0xf1, 0x03, 0x0c, 0x8a, 0x6b, 0xe3, 0x9f, 0xea, 0x00, 0xdc, 0x29, 0xbd, 0xf5, 0xb8, 0x81, 0xfe,
-	0x50, 0x81, 0x79, 0xf1, 0x47, 0xed, 0xe8, 0xca, 0xf1, 0xfe, 0x85, 0xa2, 0x76, 0x75, 0x60, 0x38,
-	0x7e, 0x96, 0x3f, 0x52, 0x60, 0x21, 0xe7, 0x5f, 0x0f, 0xd0, 0xd5, 0xa2, 0x7f, 0x0c, 0xc8, 0xa3,
-	0xe6, 0xda, 0xe0, 0x80, 0x9c, 0x9c, 0x1f, 0x2b, 0xb0, 0x52, 0xf4, 0xe5, 0x3f, 0xfa, 0xce, 0x49,
-	0xff, 0xc9, 0xa0, 0x76, 0xeb, 0x04, 0x18, 0x38, 0xa5, 0xe4, 0x12, 0xc5, 0xdf, 0xf4, 0x4b, 0x2e,
-	0x51, 0xfa, 0x5f, 0x02, 0x92, 0x4b, 0x2c, 0xf8, 0xf3, 0x80, 0x3f, 0x53, 0xa0, 0x96, 0xff, 0xe5,
-	0x3b, 0xca, 0xef, 0x0a, 0x2b, 0xfc, 0x47, 0x80, 0xda, 0x7b, 0xc7, 0x82, 0xe5, 0x74, 0xfd, 0x50,
-	0x81, 0xc5, 0xdc, 0xef, 0xda, 0xd1, 0xbb, 0xb9, 0xa8, 0x8b, 0x3e, 0xab, 0xaf, 0x5d, 0x3f, 0x0e,
-	0x28, 0x27, 0xca, 0x81, 0xc9, 0xc4, 0x07, 0xcf, 0xe8, 0xcd, 0x5c, 0x64, 0xa2, 0xef, 0xaa, 0x6b,
-	0xf5, 0x7e, 0x97, 0xf3, 0xfd, 0x3e, 0x53, 0xe0, 0xb4, 0xe0, 0xab, 0x61, 0x74, 0x59, 0x7e, 0xdb,
-	0xc2, 0xef, 0x94, 0x6b, 0x6f, 0x0f, 0x06, 0xc4, 0x49, 0x08, 0x60, 0x2a, 0xf5, 0x11, 0x2d, 0x5a,
-	0x97, 0xb9, 0x1f, 0x82, 0x4a, 0x48, 0xed, 0xad, 0xfe, 0x01, 0xf8, 0xae, 0x87, 0x30, 0x9d, 0xfe,
-	0x12, 0x0c, 0xe5, 0x63, 0xc9, 0xf9, 0x56, 0xae, 0x76, 0x69, 0x00, 0x88, 0x98, 0xd8, 0xe5, 0xf6,
-	0x3b, 0x4a, 0xc4, 0xae, 0xe8, 0x6b, 0x94, 0xda, 0x09, 0xda, 0x2b, 0xd1, 0x5f, 0x2a, 0x70, 0x46,
-	0xd6, 0x0e, 0x89, 0x6e, 0x1c, 0xb3, 0x8b, 0x92, 0x91, 0xf6, 0xfe, 0x89, 0x7a, 0x30, 0x39, 0xcb,
-	0x72, 0x7a, 0x06, 0xa5, 0x2c, 0x93, 0x77, 0x2c, 0x4a, 0x59, 0x56, 0xd0, 0xa2, 0x18, 0xbb, 0x47,
-	0x41, 0x43, 0x76, 0xe1, 0x3d, 0xe6, 0xb7, 0xc2, 0x17, 0xde, 0xa3, 0xac, 0xff, 0x3b, 0x76, 0x8f,
-	0xc2, 0xb6, 0xbd, 0xe2, 0x7b, 0x94, 0xb5, 0x0e, 0x16, 0xdf, 0xa3, 0xb4, 0x57, 0x30, 0x7e, 0x8f,
-	0xd9, 0xce, 0xbc, 0xe2, 0x7b, 0xcc, 0xed, 0x0b, 0x2c, 0xbe, 0xc7, 0xfc, 0x46, 0x40, 0xf4, 0x17,
-	0x34, 0xb7, 0x99, 0xdb, 0x72, 0x87, 0xde, 0x1b, 0xe8, 0xcc, 0xc9, 0xa6, 0xbf, 0xda, 0x8d, 0xe3,
-	0x01, 0x27, 0x48, 0xcb, 0xed, 0x37, 0x95, 0x92, 0x56, 0xd4, 0xf1, 0x2a, 0x25, 0xad, 0xb8, 0xc5,
-	0xf5, 0xaf, 0x15, 0x58, 0x96, 0x37, 0x9a, 0xa1, 0x6f, 0x4b, 0x36, 0xe8, 0xa3, 0xdb, 0xae, 0x76,
-	0xf3, 0xd8, 0xf0, 0x9c, 0xc6, 0xef, 0x2b, 0x50, 0xcd, 0x6b, 0x37, 0x44, 0xd7, 0x24, 0xd8, 0xa5,
-	0x7d, 0x95, 0xb5, 0x77, 0x8f, 0x01, 0xc9, 0x29, 0xfa, 0x5c, 0x81, 0x59, 0x51, 0xd3, 0x1a, 0xca,
-	0x7f, 0x73, 0x4a, 0x5a, 0xf4, 0x6a, 0xef, 0x0c, 0x08, 0xc5, 0xa9, 0xf8, 0x2b, 0xfa, 0xe7, 0x53,
-	0x92, 0xa6, 0x2c, 0xf4, 0x7e, 0x81, 0x6c, 0xc8, 0x3b, 0xea, 0x6a, 0xdf, 0x3e, 0x2e, 0x38, 0x27,
-	0xf0, 0x53, 0x98, 0xc9, 0xf4, 0x27, 0xa1, 0x4b, 0x12, 0xa4, 0xe2, 0xb6, 0xb1, 0xda, 0xc6, 0x20,
-	0x20, 0x3d, 0x6f, 0x24, 0xd5, 0x71, 0x24, 0xf1, 0x46, 0xc4, 0x7d, 0x52, 0x12, 0x6f, 0x24, 0xa7,
-	0x99, 0x09, 0x3d, 0x83, 0x89, 0x78, 0x07, 0x08, 0xfa, 0x96, 0x14, 0x43, 0xaa, 0xe5, 0xa9, 0xf6,
-	0x66, 0x9f, 0xab, 0x63, 0x52, 0x28, 0x6a, 0xe1, 0x90, 0x48, 0xa1, 0xa4, 0x0b, 0x45, 0x22, 0x85,
-	0xd2, 0x3e, 0x11, 0xe2, 0x79, 0x0a, 0x3a, 0x33, 0x24, 0x9e, 0x67, 0x7e, 0x9b, 0x47, 0xed, 0xed,
-	0xc1, 0x80, 0xa2, 0x4f, 0x55, 0xa0, 0xd7, 0xe8, 0x80, 0x2e, 0xe6, 0xe2, 0xc8, 0x74, 0x4f, 0xd4,
-	0xde, 0xe8, 0x6b, 0x6d, 0x6f, 0x9b, 0x5e, 0x27, 0x81, 0x64, 0x9b, 0x4c, 0x77, 0x85, 0x64, 0x9b,
-	0x6c, 0x6b, 0x02, 0xdb, 0x26, 0x6c, 0x04, 0x90, 0x6e, 0x93, 0x6a, 0x5f, 0x90, 0x6e, 0x93, 0xee,
-	0x2c, 0x20, 0x11, 0x4a, 0xa2, 0x88, 0x2f, 0x89, 0x50, 0x44, 0x0d, 0x08, 0x92, 0x08, 0x45, 0xdc,
-	0x1b, 0x40, 0x42, 0x59, 0x71, 0x31, 0x5c, 0x12, 0xca, 0x4a, 0x9b, 0x02, 0x24, 0xa1, 0x6c, 0x41,
-	0x19, 0x9f, 0x38, 0x30, 0xb9, 0x75, 0x67, 0x89, 0x03, 0x53, 0x54, 0x1a, 0x97, 0x38, 0x30, 0xc5,
-	0x65, 0x6e, 0x07, 0x26, 0x13, 0x55, 0x5b, 0xc9, 0x85, 0x88, 0x0a, 0xd7, 0x92, 0x0b, 0x11, 0x16,
-	0x83, 0xa9, 0xf9, 0x10, 0x55, 0x58, 0x91, 0x2c, 0xfc, 0xcb, 0xad, 0x1d, 0x4b, 0xcc, 0x87, 0xac,
-	0x8c, 0x4b, 0xe2, 0xb7, 0x74, 0x2d, 0x56, 0x12, 0xbf, 0xe5, 0x54, 0x7c, 0x25, 0xf1, 0x5b, 0x6e,
-	0xa1, 0x37, 0x80, 0xa9, 0x54, 0xd1, 0x51, 0xf2, 0x82, 0x10, 0x97, 0x72, 0x25, 0x2f, 0x88, 0xbc,
-	0x7a, 0x26, 0x09, 0x57, 0x53, 0x45, 0x2d, 0x59, 0xb8, 0x2a, 0x2e, 0xf3, 0xc9, 0xc2, 0xd5, 0x9c,
-	0x8a, 0x19, 0xd9, 0x38, 0x5d, 0x04, 0x92, 0x6c, 0x9c, 0x53, 0x5b, 0x93, 0x6c, 0x9c, 0x5b, 0x61,
-	0xfa, 0x03, 0x05, 0xe6, 0x84, 0x75, 0x1b, 0x94, 0x2f, 0x31, 0xb2, 0x4a, 0x53, 0xed, 0xca, 0xa0,
-	0x60, 0x31, 0x79, 0x17, 0x55, 0x3d, 0x24, 0xf2, 0x2e, 0x29, 0x27, 0x49, 0xe4, 0x5d, 0x5a, 0x20,
-	0xfa, 0x42, 0x89, 0xbe, 0x6a, 0xca, 0x4f, 0xaf, 0xa3, 0x5b, 0x45, 0xf1, 0x46, 0x61, 0x19, 0xa2,
-	0x76, 0xfb, 0x24, 0x28, 0x12, 0x29, 0x9d, 0x78, 0x7e, 0x5d, 0x9e, 0xd2, 0x11, 0x24, 0xf0, 0xe5,
-	0x29, 0x1d, 0x61, 0xea, 0x9e, 0x68, 0x66, 0x32, 0x29, 0x2e, 0xd3, 0x4c, 0x61, 0x26, 0x5e, 0xa6,
-	0x99, 0xe2, 0x7c, 0xfb, 0xed, 0x3b, 0x3f, 0xfd, 0x72, 0x59, 0xf9, 0xd9, 0x97, 0xcb, 0xca, 0xbf,
-	0x7d, 0xb9, 0xac, 0xfc, 0xfa, 0xd5, 0x3d, 0x2b, 0xd8, 0xef, 0xee, 0xd6, 0x9b, 0x6e, 0x7b, 0x3d,
-	0xf1, 0xff, 0xe4, 0xf5, 0x3d, 0xec, 0xb0, 0x3f, 0xab, 0x8f, 0xfd, 0x5b, 0xfe, 0x7b, 0xfc, 0xe7,
-	0xc1, 0xa5, 0xdd, 0x61, 0x3a, 0x77, 0xf9, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x7a, 0xca,
-	0x50, 0x59, 0x5f, 0x00, 0x00,
+	// 5791 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x4b, 0x6c, 0x24, 0xc7,
+	0x79, 0xf0, 0xdf, 0xc3, 0xe5, 0xeb, 0xe3, 0xbb, 0x96, 0x8f, 0xe1, 0x70, 0x97, 0xbb, 0x6c, 0x69,
+	0xb5, 0xd4, 0xca, 0x22, 0xb5, 0x5c, 0x69, 0xb5, 0x92, 0x65, 0xcb, 0x5c, 0x3e, 0x56, 0x23, 0x73,
+	0x5f, 0x4d, 0x6a, 0xf5, 0xe7, 0xa5, 0x76, 0x73, 0xa6, 0x86, 0xec, 0xb0, 0xa7, 0x7b, 0xb6, 0xbb,
+	0x87, 0x5c, 0x0a, 0x48, 0xa0, 0xc4, 0x49, 0x80, 0x18, 0x41, 0xec, 0x38, 0x0f, 0x04, 0x08, 0x10,
+	0x20, 0x70, 0x00, 0xc3, 0x46, 0x6e, 0x71, 0x92, 0x43, 0x90, 0x53, 0x1c, 0xc0, 0x87, 0x1c, 0x7c,
+	0xcd, 0x2d, 0x30, 0x62, 0x20, 0x71, 0x90, 0x9b, 0x81, 0x5c, 0x83, 0x7a, 0xf5, 0xf4, 0xa3, 0xba,
+	0x7a, 0x86, 0x74, 0xb0, 0xb2, 0xe3, 0x1b, 0xa7, 0xaa, 0xbe, 0xaf, 0xbe, 0xfa, 0xaa, 0xbe, 0xaf,
+	0xbe, 0x57, 0x35, 0xe1, 0x5a, 0x7b, 0x1f, 0xfb, 0xab, 0x35, 0xab, 0x8e, 0xdd, 0x1a, 0x5e, 0x3d,
+	0xb4, 0x83, 0xd0, 0xf3, 0x4f, 0x57, 0x8f, 0x6f, 0xae, 0x06, 0xd8, 0x3f, 0xb6, 0x6b, 0x78, 0xa5,
+	0xe5, 0x7b, 0xa1, 0x87, 0xe6, 0xc8, 0xb0, 0x15, 0x3e, 0x6c, 0x85, 0x0f, 0x5b, 0x39, 0xbe, 0x59,
+	0x59, 0x3c, 0xf0, 0xbc, 0x03, 0x07, 0xaf, 0xd2, 0x61, 0xfb, 0xed, 0xc6, 0x6a, 0xbd, 0xed, 0x5b,
+	0xa1, 0xed, 0xb9, 0x0c, 0xb0, 0x72, 0x25, 0xdd, 0x1f, 0xda, 0x4d, 0x1c, 0x84, 0x56, 0xb3, 0xc5,
+	0x07, 0x64, 0x10, 0x9c, 0xf8, 0x56, 0xab, 0x85, 0xfd, 0x80, 0xf7, 0x5f, 0x4d, 0x10, 0x68, 0xb5,
+	0x6c, 0x42, 0x5c, 0xcd, 0x6b, 0x36, 0xa3, 0x29, 0x96, 0x64, 0x23, 0x04, 0x89, 0x9c, 0x0a, 0xd9,
+	0x90, 0xa7, 0x6d, 0x1c, 0x0d, 0xd0, 0x65, 0x03, 0x42, 0x2b, 0x38, 0x72, 0xec, 0x20, 0x54, 0x8d,
+	0x39, 0xf1, 0xfc, 0xa3, 0x86, 0xe3, 0x9d, 0xf0, 0x31, 0x37, 0x64, 0x63, 0x38, 0x2b, 0xcd, 0xd4,
+	0xd8, 0xe5, 0xa2, 0xb1, 0xd8, 0xe7, 0x23, 0x5f, 0x48, 0x8e, 0xac, 0x37, 0x6d, 0x97, 0x72, 0xc1,
+	0x69, 0x07, 0x61, 0xd1, 0xa0, 0x24, 0x23, 0x96, 0xe4, 0x83, 0x9e, 0xb6, 0x71, 0x9b, 0x6f, 0x75,
+	0xe5, 0xba, 0x7c, 0x88, 0x8f, 0x5b, 0x8e, 0x5d, 0x8b, 0x6f, 0x6d, 0x72, 0x67, 0x82, 0x43, 0xcb,
+	0xc7, 0x75, 0x32, 0xd2, 0x72, 0xc5, 0x6c, 0x2f, 0xe6, 0x8c, 0x48, 0xd2, 0x74, 0x2d, 0x67, 0x54,
+	0x92, 0x5d, 0xfa, 0x77, 0x07, 0xe1, 0xf2, 0x6e, 0x68, 0xf9, 0xe1, 0x87, 0xbc, 0x7d, 0xeb, 0x19,
+	0xae, 0xb5, 0x09, 0x3d, 0x06, 0x7e, 0xda, 0xc6, 0x41, 0x88, 0x76, 0x60, 0xd0, 0x67, 0x7f, 0x96,
+	0xb5, 0xab, 0xda, 0xf2, 0xc8, 0xda, 0xda, 0x4a, 0xe2, 0xd8, 0x5a, 0x2d, 0x7b, 0xe5, 0xf8, 0xe6,
+	0x8a, 0x12, 0x89, 0x21, 0x50, 0xa0, 0x05, 0x18, 0xae, 0x7b, 0x4d, 0xcb, 0x76, 0x4d, 0xbb, 0x5e,
+	0x2e, 0x5d, 0xd5, 0x96, 0x87, 0x8d, 0x21, 0xd6, 0x50, 0xad, 0xa3, 0x5f, 0x86, 0x99, 0x96, 0xe5,
+	0x63, 0x37, 0x34, 0xb1, 0x40, 0x60, 0xda, 0x6e, 0xc3, 0x2b, 0xf7, 0xd1, 0x89, 0x97, 0xa5, 0x13,
+	0x3f, 0xa2, 0x10, 0xd1, 0x8c, 0x55, 0xb7, 0xe1, 0x19, 0x17, 0x5b, 0xd9, 0x46, 0x54, 0x86, 0x41,
+	0x2b, 0x0c, 0x71, 0xb3, 0x15, 0x96, 0x2f, 0x5c, 0xd5, 0x96, 0xfb, 0x0d, 0xf1, 0x13, 0x6d, 0xc0,
+	0x04, 0x7e, 0xd6, 0xb2, 0x99, 0x88, 0x99, 0x44, 0x96, 0xca, 0xfd, 0x74, 0xc6, 0xca, 0x0a, 0x93,
+	0xa3, 0x15, 0x21, 0x47, 0x2b, 0x7b, 0x42, 0xd0, 0x8c, 0xf1, 0x0e, 0x08, 0x69, 0x44, 0x0d, 0x98,
+	0xaf, 0x79, 0x6e, 0x68, 0xbb, 0x6d, 0x6c, 0x5a, 0x81, 0xe9, 0xe2, 0x13, 0xd3, 0x76, 0xed, 0xd0,
+	0xb6, 0x42, 0xcf, 0x2f, 0x0f, 0x5c, 0xd5, 0x96, 0xc7, 0xd7, 0x5e, 0x91, 0x2e, 0x60, 0x83, 0x43,
+	0xad, 0x07, 0x0f, 0xf0, 0x49, 0x55, 0x80, 0x18, 0xb3, 0x35, 0x69, 0x3b, 0xaa, 0xc2, 0x94, 0xe8,
+	0xa9, 0x9b, 0x0d, 0xcb, 0x76, 0xda, 0x3e, 0x2e, 0x0f, 0x52, 0x72, 0x2f, 0x49, 0xf1, 0x6f, 0xb3,
+	0x31, 0xc6, 0x64, 0x04, 0xc6, 0x5b, 0x90, 0x01, 0xb3, 0x8e, 0x15, 0x84, 0x66, 0xcd, 0x6b, 0xb6,
+	0x1c, 0x4c, 0x17, 0xef, 0xe3, 0xa0, 0xed, 0x84, 0xe5, 0x21, 0x05, 0xbe, 0x47, 0xd6, 0xa9, 0xe3,
+	0x59, 0x75, 0x63, 0x9a, 0xc0, 0x6e, 0x44, 0xa0, 0x06, 0x85, 0x44, 0xff, 0x1f, 0x16, 0x1a, 0xb6,
+	0x1f, 0x84, 0x66, 0x1d, 0xd7, 0xec, 0x80, 0xf2, 0xd3, 0x0a, 0x8e, 0xcc, 0x7d, 0xab, 0x76, 0xe4,
+	0x35, 0x1a, 0xe5, 0x61, 0x8a, 0x78, 0x3e, 0xc3, 0xd7, 0x4d, 0xae, 0xe0, 0x8c, 0x32, 0x85, 0xde,
+	0xe4, 0xc0, 0x7b, 0x56, 0x70, 0x74, 0x97, 0x81, 0xa2, 0x63, 0x98, 0x6c, 0x59, 0x7e, 0x68, 0x53,
+	0x3a, 0x6b, 0x9e, 0xdb, 0xb0, 0x0f, 0xca, 0x70, 0xb5, 0x6f, 0x79, 0x64, 0xed, 0x8b, 0x2b, 0x39,
+	0x8a, 0x54, 0x7d, 0x2a, 0xc9, 0xd1, 0x61, 0xe8, 0x36, 0x28, 0xb6, 0x2d, 0x37, 0xf4, 0x4f, 0x8d,
+	0x89, 0x56, 0xb2, 0x15, 0x7d, 0x04, 0xd3, 0x31, 0x06, 0xd5, 0x2c, 0xc7, 0x21, 0x8b, 0x09, 0xca,
+	0x23, 0x74, 0xee, 0x57, 0x72, 0xe7, 0xee, 0xb0, 0x66, 0x83, 0xc3, 0x18, 0x17, 0x6b, 0x99, 0xb6,
+	0xa0, 0x72, 0x17, 0xa6, 0x65, 0x84, 0xa0, 0x49, 0xe8, 0x3b, 0xc2, 0xa7, 0x54, 0xe8, 0x86, 0x0d,
+	0xf2, 0x27, 0x9a, 0x86, 0xfe, 0x63, 0xcb, 0x69, 0x63, 0x2e, 0x38, 0xec, 0xc7, 0xdb, 0xa5, 0x3b,
+	0x9a, 0xfe, 0xb7, 0x1a, 0xa0, 0xec, 0x7c, 0x04, 0x45, 0xdb, 0x77, 0x04, 0x8a, 0xb6, 0xef, 0x20,
+	0x03, 0x06, 0x0f, 0xb1, 0x55, 0xc7, 0x7e, 0x50, 0x2e, 0x51, 0xfa, 0xef, 0xf4, 0x40, 0xff, 0xca,
+	0x7b, 0x0c, 0x94, 0x31, 0x4a, 0x20, 0xaa, 0xbc, 0x0d, 0xa3, 0xf1, 0x8e, 0x9e, 0x08, 0xff, 0xe7,
+	0x12, 0xcc, 0x66, 0x27, 0xa2, 0xf2, 0x9a, 0x25, 0x7e, 0x1b, 0xfa, 0x83, 0xd0, 0x0a, 0x19, 0x9a,
+	0xf1, 0xb5, 0xd7, 0x7a, 0x20, 0x7d, 0x97, 0xc0, 0x19, 0x0c, 0x3c, 0xae, 0x09, 0xfa, 0x92, 0x9a,
+	0x60, 0x1b, 0xa6, 0xa8, 0x44, 0xf0, 0xdf, 0x4c, 0x17, 0x5c, 0x28, 0xd4, 0x05, 0x13, 0x04, 0x68,
+	0x9d, 0xc1, 0x50, 0x65, 0xb0, 0x0d, 0x53, 0x2e, 0x7e, 0x96, 0xc2, 0x53, 0xac, 0x53, 0x26, 0x08,
+	0x50, 0x1c, 0xcf, 0x0a, 0x5c, 0xa4, 0xf4, 0x70, 0x39, 0x37, 0x7d, 0x6c, 0x05, 0x9e, 0x4b, 0xd5,
+	0xc9, 0xb0, 0x41, 0x49, 0x15, 0xd2, 0x4d, 0x3b, 0xf4, 0x37, 0x61, 0x31, 0xef, 0xc8, 0x07, 0x2d,
+	0xcf, 0x0d, 0x30, 0x9a, 0x81, 0x01, 0xbf, 0x4d, 0xb5, 0x2f, 0x63, 0x6c, 0xbf, 0xdf, 0x76, 0xab,
+	0x75, 0xfd, 0x2f, 0x4b, 0xb0, 0xb8, 0x6b, 0x1f, 0xb8, 0x96, 0x93, 0x7b, 0x11, 0xdc, 0x4f, 0x5f,
+	0x04, 0xb7, 0xe4, 0x17, 0x81, 0x12, 0x4b, 0x97, 0x37, 0x41, 0x03, 0x16, 0xf0, 0xb3, 0x10, 0xfb,
+	0xae, 0xe5, 0x44, 0x17, 0x7c, 0xe7, 0x52, 0xe0, 0xf7, 0xc1, 0x4b, 0xd2, 0xf9, 0xb3, 0x33, 0xcf,
+	0x0b, 0x54, 0x99, 0x2e, 0xc2, 0xdf, 0xda, 0xa1, 0xed, 0xd4, 0x3b, 0x93, 0x78, 0xae, 0x73, 0x4a,
+	0x77, 0x7c, 0xc8, 0x98, 0xa2, 0x5d, 0x02, 0xe8, 0xa1, 0xeb, 0x9c, 0xea, 0x4b, 0x70, 0x25, 0x77,
+	0x7d, 0x8c, 0xc1, 0xfa, 0x3f, 0xf5, 0xc1, 0x75, 0x3e, 0xc6, 0x0e, 0x0f, 0xd5, 0x77, 0xeb, 0x93,
+	0x34, 0x4b, 0xdf, 0x51, 0xb1, 0xb4, 0x08, 0x5d, 0x97, 0xbc, 0xfd, 0x44, 0x93, 0x28, 0xd2, 0x3e,
+	0xaa, 0x0c, 0x3e, 0xc8, 0x57, 0xa4, 0xdd, 0x91, 0x70, 0x4e, 0x95, 0x7a, 0xe1, 0x53, 0xa4, 0x52,
+	0xd7, 0x61, 0xb9, 0x78, 0xd1, 0x6a, 0xa1, 0xfa, 0x8a, 0x06, 0x97, 0x0d, 0x1c, 0xe0, 0x73, 0x1b,
+	0x57, 0x4a, 0x24, 0xdd, 0x6d, 0x3b, 0x51, 0x0d, 0x79, 0x68, 0xd4, 0xab, 0xf8, 0x76, 0x09, 0x96,
+	0xf6, 0xb0, 0xdf, 0xb4, 0x5d, 0x2b, 0xc4, 0xb9, 0x2b, 0x79, 0x94, 0x5e, 0xc9, 0x6d, 0xe9, 0x4a,
+	0x0a, 0x11, 0xfd, 0x94, 0x2b, 0x88, 0x17, 0x41, 0x57, 0x2d, 0x91, 0xeb, 0x88, 0x3f, 0x28, 0xc1,
+	0xfc, 0x07, 0xad, 0x7a, 0x6c, 0xcc, 0x7d, 0xdc, 0xf4, 0x0c, 0xd9, 0xc2, 0xb5, 0xd4, 0xc2, 0x67,
+	0x61, 0x80, 0xfd, 0xcd, 0x59, 0xc2, 0x7f, 0xa1, 0x0f, 0x00, 0x9d, 0x9b, 0x0f, 0x53, 0x27, 0x99,
+	0xf5, 0xbf, 0x0a, 0x17, 0x9a, 0xb8, 0xe9, 0xf1, 0x3b, 0x70, 0x5e, 0x8a, 0x88, 0xd2, 0x4e, 0x87,
+	0xa1, 0x0a, 0x0c, 0xd9, 0x75, 0xec, 0x86, 0x76, 0x78, 0x4a, 0xaf, 0xbb, 0x61, 0x23, 0xfa, 0x8d,
+	0x2e, 0x03, 0xf0, 0xad, 0x25, 0xeb, 0x62, 0x57, 0xd8, 0x30, 0x6f, 0xa9, 0xd6, 0xf5, 0x4b, 0x50,
+	0x91, 0xb1, 0x84, 0x73, 0xec, 0x6b, 0x1a, 0x5c, 0xdd, 0xc4, 0x41, 0xcd, 0xb7, 0xf7, 0xf3, 0xcf,
+	0xe0, 0xc3, 0xf4, 0x19, 0x7c, 0x43, 0x4a, 0x6f, 0x11, 0x9e, 0x2e, 0x05, 0xea, 0xbb, 0xfd, 0xb0,
+	0xa4, 0x40, 0xc5, 0x85, 0xca, 0x81, 0xb9, 0x8e, 0x33, 0xc3, 0x94, 0x2d, 0x37, 0x75, 0x95, 0xb7,
+	0x68, 0x06, 0xe1, 0x46, 0x1c, 0xd4, 0x98, 0xc5, 0xd2, 0x76, 0xb4, 0x0f, 0x73, 0xd9, 0x53, 0xc0,
+	0x7c, 0xa8, 0x12, 0x9d, 0xed, 0x46, 0x77, 0xb3, 0x51, 0x2f, 0x6a, 0xe6, 0x44, 0xd6, 0x8c, 0x3e,
+	0x04, 0xd4, 0xc2, 0x6e, 0xdd, 0x76, 0x0f, 0x4c, 0xab, 0x16, 0xda, 0xc7, 0x76, 0x68, 0xe3, 0x80,
+	0x5f, 0x20, 0x39, 0x2e, 0x1a, 0x1b, 0xbe, 0xce, 0x46, 0x9f, 0x52, 0xe4, 0x53, 0xad, 0x44, 0xa3,
+	0x8d, 0x03, 0xf4, 0x0b, 0x30, 0x29, 0x10, 0x53, 0xc1, 0xf2, 0xb1, 0xcb, 0x6f, 0x84, 0x15, 0x15,
+	0xda, 0x0d, 0x32, 0x36, 0x49, 0xf9, 0x44, 0x2b, 0xd6, 0xe5, 0x63, 0x17, 0xed, 0x76, 0x50, 0x0b,
+	0xbf, 0x84, 0x9b, 0x63, 0x4a, 0x8a, 0x85, 0x1b, 0x92, 0x40, 0x2a, 0x1a, 0xd1, 0x97, 0x60, 0x26,
+	0x08, 0xed, 0xda, 0xd1, 0x69, 0x9a, 0xd5, 0x03, 0x14, 0xf3, 0x67, 0x14, 0x5e, 0x09, 0x81, 0x4a,
+	0xb9, 0xac, 0x41, 0xb6, 0x11, 0xed, 0xe7, 0xdc, 0x93, 0x83, 0x94, 0x2b, 0xab, 0x3d, 0xdc, 0x93,
+	0x6c, 0x0e, 0xc9, 0x5d, 0xa9, 0x7f, 0xa7, 0x04, 0x17, 0x25, 0x04, 0xa1, 0x7b, 0x30, 0xc9, 0x57,
+	0x47, 0x1d, 0x38, 0xc7, 0x8e, 0xa4, 0xea, 0xb2, 0x5c, 0xb3, 0x5b, 0xc1, 0xd1, 0x8e, 0x1d, 0x84,
+	0xc6, 0x38, 0x03, 0x13, 0xbf, 0xd1, 0x15, 0x18, 0xe1, 0x88, 0x0e, 0xbd, 0x20, 0xe4, 0x62, 0x04,
+	0xac, 0xe9, 0x3d, 0x2f, 0x08, 0xd1, 0x1e, 0xcc, 0x07, 0xb5, 0x43, 0x5c, 0x6f, 0x3b, 0xd8, 0x0c,
+	0x3d, 0x33, 0x20, 0x77, 0x2d, 0xb5, 0x98, 0xbd, 0x76, 0xc8, 0x35, 0x98, 0xc2, 0x61, 0x9c, 0x15,
+	0xb0, 0x7b, 0x1e, 0xbd, 0xa5, 0xf7, 0x18, 0x20, 0x91, 0xdd, 0x43, 0x9b, 0xf8, 0xb6, 0x6d, 0x97,
+	0x39, 0xfc, 0x7d, 0xc6, 0xd0, 0xa1, 0x1d, 0x6e, 0x90, 0xdf, 0xe8, 0x1a, 0x8c, 0x37, 0x38, 0x07,
+	0xf8, 0x88, 0x7e, 0x3a, 0x62, 0x4c, 0xb4, 0xb2, 0x61, 0xf3, 0x40, 0x40, 0x4c, 0x9f, 0xf8, 0x1c,
+	0x64, 0x53, 0x35, 0x63, 0xf0, 0xd0, 0x0e, 0x0d, 0x2b, 0xc4, 0xfa, 0x33, 0x98, 0x7e, 0xdc, 0xc6,
+	0xfe, 0xa9, 0x10, 0x1d, 0xa1, 0x83, 0x36, 0xd2, 0x3a, 0xe8, 0x65, 0x29, 0xb7, 0x64, 0xb0, 0x5d,
+	0xea, 0x9d, 0x6f, 0x68, 0x30, 0x93, 0x02, 0xe7, 0xba, 0xe6, 0x5d, 0x18, 0xa5, 0xe1, 0x37, 0xe1,
+	0xc5, 0x6b, 0x5d, 0x78, 0xf1, 0x23, 0x14, 0x82, 0x3b, 0xef, 0x55, 0x18, 0x17, 0x08, 0x7e, 0x15,
+	0xd7, 0x42, 0x5c, 0xe7, 0x5a, 0x43, 0xcf, 0x5f, 0x83, 0xc1, 0x47, 0x1a, 0x63, 0x4f, 0xe3, 0x3f,
+	0xf5, 0xdf, 0xd2, 0xa0, 0x42, 0xed, 0x8d, 0xdd, 0xc4, 0x69, 0x10, 0x6c, 0xaa, 0xa6, 0xd9, 0xb4,
+	0x9a, 0x6f, 0xf8, 0x48, 0x31, 0x74, 0xc9, 0xac, 0xcb, 0xb0, 0x20, 0xc5, 0xc1, 0xaf, 0x95, 0xef,
+	0x97, 0x60, 0xf6, 0x1e, 0x0e, 0xef, 0xb7, 0x43, 0x6b, 0xdf, 0xc1, 0xcc, 0x49, 0xec, 0xe6, 0x16,
+	0x96, 0xdf, 0xb6, 0xa5, 0xf3, 0xde, 0xb6, 0xb7, 0x60, 0x16, 0x3f, 0x6b, 0x51, 0x06, 0x9a, 0xd4,
+	0x7f, 0xc4, 0xc7, 0xd8, 0xa5, 0xd7, 0x65, 0x1f, 0x3d, 0x9e, 0x17, 0x45, 0xef, 0x03, 0xfc, 0x2c,
+	0xdc, 0x22, 0x7d, 0xd5, 0x3a, 0x7a, 0x0d, 0xa6, 0x6b, 0x6d, 0x9f, 0x86, 0xcd, 0xf6, 0x7d, 0xcb,
+	0xad, 0x1d, 0x9a, 0xa1, 0x77, 0x44, 0x55, 0xa7, 0xb6, 0x3c, 0x6a, 0x20, 0xde, 0x77, 0x97, 0x76,
+	0xed, 0x91, 0x1e, 0xf4, 0x4b, 0x30, 0x7d, 0x8c, 0x7d, 0x1a, 0x9c, 0xe1, 0x4a, 0xc3, 0xb4, 0x43,
+	0xdc, 0xe4, 0x1a, 0x31, 0x7d, 0x60, 0xeb, 0x4d, 0xdb, 0x25, 0x2b, 0x78, 0xc2, 0x40, 0xde, 0x63,
+	0x10, 0xd5, 0x10, 0x37, 0x0d, 0x74, 0x9c, 0x69, 0xd3, 0xff, 0x6e, 0x18, 0xe6, 0x32, 0x2c, 0xe5,
+	0x07, 0x54, 0xce, 0x36, 0xed, 0xbc, 0x6c, 0xdb, 0x86, 0xb1, 0x08, 0x6d, 0x78, 0xda, 0xc2, 0x7c,
+	0x23, 0x96, 0x94, 0x18, 0xf7, 0x4e, 0x5b, 0xd8, 0x18, 0x3d, 0x89, 0xfd, 0x42, 0x3a, 0x8c, 0xc9,
+	0xb8, 0x3e, 0xe2, 0xc6, 0xb8, 0xfd, 0x04, 0xe6, 0x5b, 0x3e, 0x3e, 0xb6, 0xbd, 0x76, 0xc0, 0x34,
+	0x15, 0xae, 0x77, 0xc6, 0x33, 0x2b, 0x69, 0x21, 0xa3, 0xac, 0xaa, 0x6e, 0x78, 0xfb, 0xf5, 0x27,
+	0xc4, 0xb5, 0x30, 0x66, 0x05, 0xf4, 0x2e, 0x03, 0x16, 0x78, 0x5f, 0x15, 0x9e, 0x3e, 0x0d, 0x9e,
+	0x45, 0x18, 0x99, 0x5a, 0x9a, 0xa4, 0x9e, 0x3e, 0xe9, 0x11, 0xc3, 0xdf, 0x86, 0xe1, 0x8e, 0x5a,
+	0x1e, 0xe8, 0x46, 0x2d, 0x0f, 0x85, 0x42, 0x21, 0xcb, 0x34, 0xfb, 0xe0, 0x59, 0x34, 0xfb, 0xeb,
+	0x30, 0x5b, 0x73, 0x6c, 0x42, 0xa9, 0x63, 0xef, 0xfb, 0x96, 0x7f, 0x6a, 0xf2, 0xf3, 0x40, 0xe3,
+	0x87, 0xc3, 0xc6, 0x34, 0xeb, 0xdd, 0x61, 0x9d, 0xfc, 0xfc, 0xc4, 0xa0, 0x1a, 0xd8, 0x0a, 0xdb,
+	0x3e, 0x8e, 0xa0, 0x86, 0xe3, 0x50, 0xdb, 0xac, 0x53, 0x40, 0x5d, 0x81, 0x11, 0x0e, 0x65, 0x37,
+	0x5b, 0x4e, 0x19, 0xd8, 0x2d, 0xc2, 0x9a, 0xaa, 0xcd, 0x96, 0x83, 0x02, 0xb8, 0x91, 0x5e, 0x95,
+	0x99, 0x7f, 0xad, 0x8c, 0x14, 0x5d, 0x2b, 0x2f, 0x26, 0xd7, 0xba, 0x2b, 0xbf, 0x64, 0x56, 0xe0,
+	0x22, 0xdb, 0x2a, 0x72, 0xfe, 0x3b, 0x0b, 0x19, 0xa5, 0x51, 0xa5, 0x29, 0xda, 0xb5, 0x4b, 0x7a,
+	0xc4, 0x2a, 0xf2, 0x64, 0x75, 0x2c, 0x57, 0x56, 0x77, 0x60, 0x3c, 0x3a, 0xdb, 0x2c, 0xf8, 0x35,
+	0x4e, 0x83, 0x5f, 0xd7, 0x92, 0x5b, 0xc5, 0x02, 0xfc, 0xf1, 0xf3, 0xcd, 0x24, 0x2f, 0x12, 0x0c,
+	0xfa, 0x13, 0xd5, 0x60, 0x3a, 0xc2, 0x56, 0x73, 0xbc, 0x00, 0x73, 0x9c, 0x13, 0x14, 0xe7, 0xcd,
+	0x2e, 0x4d, 0x51, 0x02, 0x48, 0xf0, 0xb5, 0x03, 0x23, 0x92, 0xe7, 0xa8, 0x91, 0x48, 0xf9, 0x54,
+	0x52, 0xbd, 0x10, 0xfb, 0x70, 0x52, 0x66, 0x6d, 0x75, 0xa8, 0x4e, 0x28, 0x17, 0x1b, 0x07, 0xc6,
+	0xe4, 0x71, 0xaa, 0x05, 0xbd, 0x03, 0x0b, 0x36, 0x91, 0xb9, 0xd4, 0x1e, 0x63, 0x97, 0xe8, 0x99,
+	0x7a, 0x79, 0x8a, 0xba, 0x64, 0x73, 0x76, 0x90, 0x54, 0xf5, 0x5b, 0xac, 0x1b, 0x2d, 0xc1, 0xa8,
+	0xd0, 0x75, 0x81, 0xfd, 0x31, 0x2e, 0x23, 0x26, 0xda, 0xbc, 0x6d, 0xd7, 0xfe, 0x18, 0xeb, 0x3f,
+	0xd6, 0x60, 0xee, 0x91, 0xe7, 0x38, 0xff, 0xb7, 0x6e, 0x03, 0xfd, 0x9b, 0x43, 0x50, 0xce, 0x2e,
+	0xfb, 0xe7, 0x1a, 0xfb, 0xe7, 0x1a, 0xfb, 0x67, 0x51, 0x63, 0xe7, 0xc9, 0xc7, 0x68, 0xae, 0x06,
+	0x96, 0xaa, 0xb3, 0xb1, 0x73, 0xab, 0xb3, 0x9f, 0x3e, 0xc5, 0xae, 0xff, 0x63, 0x09, 0xae, 0x1a,
+	0xb8, 0xe6, 0xf9, 0xf5, 0x78, 0x7e, 0x8e, 0x8b, 0xc5, 0xf3, 0xd4, 0x94, 0xc4, 0xc5, 0x14, 0x07,
+	0x27, 0x52, 0x02, 0x20, 0x9a, 0xaa, 0x75, 0x34, 0x07, 0x83, 0xf4, 0x8c, 0x71, 0x89, 0xef, 0x33,
+	0x06, 0xc8, 0xcf, 0x6a, 0x3d, 0x15, 0x94, 0xea, 0x4f, 0x05, 0xa5, 0x90, 0x01, 0xa3, 0x2d, 0xcf,
+	0x71, 0x4c, 0xe1, 0xab, 0x0c, 0x28, 0x7c, 0x15, 0xa2, 0x43, 0xb7, 0x3d, 0x3f, 0xce, 0x1a, 0xe1,
+	0xab, 0x8c, 0x10, 0x24, 0xfc, 0x87, 0xfe, 0x9b, 0x43, 0xb0, 0xa4, 0xe0, 0x22, 0x57, 0xbc, 0x19,
+	0x0d, 0xa9, 0x9d, 0x4d, 0x43, 0x2a, 0xb5, 0x5f, 0xe9, 0xec, 0xda, 0xef, 0x33, 0x80, 0x04, 0x7f,
+	0xeb, 0x69, 0xf5, 0x3b, 0x19, 0xf5, 0x88, 0xd1, 0xcb, 0x44, 0x81, 0x49, 0x54, 0x6f, 0x1f, 0xd1,
+	0x50, 0x09, 0xbc, 0x19, 0x8d, 0xde, 0x9f, 0xd5, 0xe8, 0xb1, 0xfc, 0xdd, 0x40, 0x32, 0x7f, 0x77,
+	0x07, 0xca, 0x99, 0x90, 0x8c, 0x30, 0x10, 0x06, 0xa9, 0x81, 0x30, 0x9b, 0x8a, 0xb3, 0x08, 0xfb,
+	0xc0, 0x80, 0xb1, 0x28, 0x63, 0x4d, 0x83, 0x38, 0x2c, 0x05, 0xfe, 0x6a, 0x9e, 0x34, 0xee, 0xf9,
+	0x96, 0x1b, 0x10, 0x55, 0x96, 0x88, 0x11, 0x8d, 0xd6, 0x63, 0xbf, 0xd0, 0x47, 0x70, 0x49, 0x12,
+	0x8d, 0xeb, 0xa8, 0xf0, 0xe1, 0x6e, 0x54, 0xf8, 0x7c, 0xe6, 0xb8, 0x47, 0xda, 0x3c, 0xc7, 0xfa,
+	0x84, 0x3c, 0xeb, 0x73, 0x09, 0x46, 0x13, 0x3a, 0x6f, 0x84, 0xea, 0xbc, 0x91, 0xfd, 0x98, 0xb2,
+	0x5b, 0x87, 0xf1, 0xce, 0xb6, 0xd2, 0xac, 0xe5, 0x68, 0x61, 0xd6, 0x72, 0x2c, 0x82, 0xa0, 0x39,
+	0xcb, 0xcf, 0xc1, 0xa8, 0xd8, 0x6b, 0x8a, 0x60, 0xac, 0x10, 0xc1, 0x08, 0x1f, 0x4f, 0xc1, 0x2d,
+	0x18, 0x7c, 0xda, 0xc6, 0x54, 0xc9, 0x8e, 0xd3, 0x30, 0xd7, 0xbd, 0xdc, 0x30, 0x57, 0xa1, 0x14,
+	0xd1, 0x10, 0x85, 0x8d, 0x45, 0xc2, 0x9a, 0xe3, 0xcd, 0xd8, 0x82, 0x13, 0x19, 0x5b, 0xb0, 0xf2,
+	0x11, 0x8c, 0xc6, 0x61, 0x25, 0x99, 0xa3, 0x3b, 0xf1, 0xcc, 0x51, 0x5e, 0x88, 0x44, 0x08, 0x26,
+	0x0b, 0x95, 0xc4, 0xb2, 0x4b, 0x7f, 0xd3, 0x27, 0x54, 0xa9, 0x88, 0x8a, 0xfe, 0x5c, 0x95, 0x66,
+	0x54, 0x69, 0x9c, 0x35, 0x32, 0x55, 0x8a, 0x5a, 0xb0, 0x90, 0x35, 0x18, 0xf6, 0x7d, 0x6c, 0x1d,
+	0xd5, 0xbd, 0x13, 0x97, 0x9b, 0x48, 0x37, 0xf3, 0xe3, 0xb0, 0x49, 0x13, 0xe1, 0xae, 0x00, 0x34,
+	0xca, 0x41, 0x4e, 0x8f, 0xfe, 0xdb, 0x25, 0x28, 0xe7, 0x81, 0x91, 0x73, 0x15, 0x9c, 0xba, 0x35,
+	0xb3, 0x69, 0x85, 0x64, 0x08, 0xdd, 0xb2, 0x21, 0x63, 0x84, 0xb4, 0xdd, 0x67, 0x4d, 0xe8, 0x12,
+	0x0c, 0x37, 0x3c, 0xff, 0xc4, 0xf2, 0xeb, 0x3c, 0xb8, 0x36, 0x64, 0x74, 0x1a, 0xd0, 0xeb, 0x30,
+	0xb7, 0x6f, 0xd5, 0x8e, 0x1c, 0xef, 0xc0, 0x3c, 0xb1, 0x6c, 0x66, 0xfb, 0x98, 0xb6, 0x6b, 0x36,
+	0x03, 0x61, 0xf2, 0xf3, 0xee, 0x0f, 0x2d, 0x9b, 0x5a, 0x34, 0x55, 0xf7, 0x7e, 0x80, 0xee, 0xc0,
+	0xbc, 0x6f, 0x85, 0xd8, 0xb1, 0x9b, 0x76, 0x98, 0x81, 0x63, 0x7b, 0x34, 0x13, 0x0d, 0x48, 0x43,
+	0xda, 0x81, 0xe7, 0xb0, 0xba, 0xa7, 0x34, 0x24, 0x53, 0xbc, 0x33, 0xd1, 0x80, 0x38, 0xa4, 0xfe,
+	0x6f, 0x7d, 0xe2, 0x12, 0x93, 0x9e, 0x5f, 0x7e, 0x89, 0xbd, 0x0f, 0x13, 0xa9, 0x4b, 0x42, 0x79,
+	0x8d, 0xf1, 0x30, 0x12, 0x55, 0xf3, 0xc6, 0x78, 0xf2, 0x12, 0xc9, 0xa8, 0x95, 0x52, 0x6f, 0x6a,
+	0x25, 0xbf, 0xe6, 0xe3, 0x23, 0x58, 0x4c, 0xaa, 0x3c, 0xd3, 0x6b, 0x98, 0xe1, 0xa1, 0x1d, 0x98,
+	0xf1, 0x72, 0x31, 0xf5, 0x54, 0x95, 0x84, 0x0a, 0x7c, 0xd8, 0xd8, 0x3b, 0xb4, 0x03, 0x5e, 0xc7,
+	0x81, 0xaa, 0x30, 0x75, 0x88, 0x2d, 0x3f, 0xdc, 0xc7, 0x56, 0x68, 0xd6, 0x71, 0x68, 0xd9, 0x4e,
+	0xc0, 0x43, 0x6d, 0xea, 0xd0, 0xec, 0x64, 0x04, 0xb6, 0xc9, 0xa0, 0xb2, 0x46, 0xc1, 0xc0, 0xd9,
+	0x8c, 0x82, 0xeb, 0x30, 0x11, 0xe1, 0xe1, 0xd9, 0xc4, 0x41, 0x2a, 0xaf, 0x91, 0x49, 0xba, 0x49,
+	0x5b, 0xf5, 0x1f, 0x6a, 0xf0, 0x02, 0xdb, 0xcd, 0x84, 0x9a, 0xe5, 0xf9, 0x85, 0x8e, 0xa6, 0x32,
+	0xd2, 0xe1, 0xdc, 0x3b, 0x79, 0xe1, 0xdc, 0x22, 0x54, 0x5d, 0xe6, 0x7f, 0xef, 0xc3, 0x34, 0xb6,
+	0x0e, 0xb0, 0x2f, 0x52, 0x50, 0xa7, 0x66, 0xe0, 0x78, 0x61, 0xc0, 0xd3, 0x05, 0x52, 0x8b, 0xe6,
+	0xd6, 0x1a, 0xb3, 0x68, 0x10, 0x05, 0x14, 0xc7, 0x76, 0x97, 0x80, 0xe9, 0x7f, 0xdd, 0x07, 0x2f,
+	0xaa, 0x89, 0xe3, 0x27, 0x1a, 0x77, 0x0c, 0x19, 0x9f, 0xb7, 0xf1, 0x15, 0xbf, 0x7d, 0xf6, 0x6b,
+	0xca, 0x98, 0x08, 0x52, 0x82, 0xf3, 0x0d, 0x0d, 0x16, 0x3b, 0xc9, 0x35, 0xa2, 0xdb, 0xea, 0x76,
+	0xd0, 0x22, 0x3a, 0xc4, 0x74, 0xbc, 0x9a, 0xe5, 0x38, 0xa7, 0xbc, 0x7c, 0xeb, 0x23, 0xc5, 0xac,
+	0xc5, 0xcb, 0x59, 0xe9, 0x64, 0xdf, 0xf6, 0xbc, 0x4d, 0x3e, 0xc3, 0x0e, 0x9b, 0x80, 0xdd, 0x99,
+	0x0b, 0x56, 0xfe, 0x88, 0xca, 0xaf, 0xc3, 0xd5, 0x22, 0x04, 0x92, 0x8b, 0x73, 0x33, 0x79, 0x71,
+	0xca, 0x73, 0x7b, 0x62, 0x7b, 0x28, 0x2e, 0x81, 0x98, 0x9a, 0x58, 0xb1, 0x4b, 0xf4, 0x6b, 0x1a,
+	0xb9, 0x44, 0x33, 0xcb, 0xdc, 0xb6, 0x6c, 0xa7, 0x73, 0x34, 0xbb, 0x4c, 0x0a, 0x17, 0xe1, 0xe9,
+	0x32, 0xdf, 0xf0, 0x02, 0x51, 0x8b, 0xb9, 0x98, 0x78, 0xd6, 0xe1, 0x8f, 0x34, 0xd0, 0xb3, 0xca,
+	0xf3, 0x3d, 0x21, 0xed, 0x82, 0xf2, 0xc7, 0x69, 0xca, 0xdf, 0xcc, 0xa1, 0xbc, 0x08, 0x53, 0x97,
+	0xb4, 0x3f, 0x22, 0xb2, 0xae, 0xc0, 0xc5, 0xcf, 0xe6, 0xcb, 0x30, 0x59, 0xb3, 0xdc, 0x1a, 0x8e,
+	0xae, 0xf2, 0xe8, 0xa6, 0x9b, 0x60, 0xed, 0x86, 0x68, 0xd6, 0xff, 0xa4, 0xa3, 0x3e, 0xe2, 0x38,
+	0xcf, 0xa9, 0x3e, 0x54, 0xa8, 0xba, 0x5c, 0xea, 0x4b, 0x91, 0xb8, 0xe7, 0x20, 0x8b, 0x95, 0x1d,
+	0x48, 0x06, 0x9e, 0xe7, 0x84, 0xe5, 0xe2, 0xe9, 0xf9, 0x84, 0xc9, 0x30, 0x25, 0x4e, 0x58, 0x76,
+	0x81, 0x74, 0x7f, 0x3a, 0x94, 0x77, 0x7d, 0xc2, 0x8a, 0x30, 0x75, 0x49, 0xfb, 0x35, 0xf9, 0x71,
+	0x88, 0x70, 0x71, 0xea, 0xbf, 0xa3, 0xc1, 0x15, 0x03, 0x37, 0xbd, 0x63, 0xcc, 0x2a, 0xb0, 0x3e,
+	0x2d, 0x01, 0xd9, 0xa4, 0x85, 0xdb, 0x97, 0xae, 0x60, 0xd1, 0xc9, 0x59, 0xc9, 0xa3, 0x9a, 0x2f,
+	0xed, 0xef, 0x4b, 0x70, 0x8d, 0x2f, 0x81, 0x2d, 0x3b, 0xb7, 0x98, 0x45, 0xb9, 0x40, 0x0b, 0xc6,
+	0x93, 0x32, 0xc8, 0x17, 0xf7, 0x76, 0xce, 0xfe, 0x75, 0x31, 0xa1, 0x31, 0x96, 0x90, 0x5e, 0xb4,
+	0x0f, 0x73, 0x51, 0x85, 0x95, 0xb4, 0x1c, 0x5f, 0x5e, 0x4a, 0xb2, 0xc5, 0x61, 0x52, 0xa5, 0x24,
+	0x58, 0xd6, 0xdc, 0x73, 0x75, 0xd5, 0x32, 0xbc, 0x54, 0xb4, 0x16, 0xce, 0xe7, 0x7f, 0xd0, 0x60,
+	0x41, 0xd8, 0xe9, 0x92, 0x88, 0xcc, 0x73, 0x39, 0x3e, 0x37, 0x60, 0xca, 0x0e, 0xcc, 0x64, 0x75,
+	0x3c, 0xe5, 0xe5, 0x90, 0x31, 0x61, 0x07, 0xdb, 0xf1, 0xba, 0x77, 0x7d, 0x11, 0x2e, 0xc9, 0xc9,
+	0xe7, 0xeb, 0xfb, 0x32, 0x35, 0x58, 0x88, 0xb2, 0x4e, 0x96, 0xbf, 0x64, 0x54, 0xeb, 0xf3, 0x58,
+	0xe8, 0x12, 0x8c, 0xf2, 0xa7, 0x0f, 0xb8, 0x1e, 0x0b, 0xca, 0x47, 0x6d, 0xd5, 0x3a, 0xfa, 0x10,
+	0x44, 0x31, 0x0a, 0xf1, 0x0c, 0xa2, 0xa9, 0x2f, 0xf4, 0x34, 0x35, 0x8a, 0x50, 0x74, 0xe6, 0xde,
+	0x81, 0xc9, 0x58, 0xc9, 0x0c, 0xf3, 0x39, 0xfa, 0xbb, 0xf5, 0x39, 0x26, 0x3a, 0xa0, 0xcc, 0xe9,
+	0xb8, 0x0c, 0x20, 0xcc, 0x3d, 0x5e, 0xb3, 0xd6, 0x67, 0x0c, 0xf3, 0x96, 0x6a, 0x5d, 0xbf, 0x4e,
+	0x84, 0x59, 0xb9, 0x09, 0x7c, 0xbb, 0xfe, 0x50, 0x83, 0xcb, 0xf7, 0x70, 0x98, 0xad, 0xcb, 0x09,
+	0x9e, 0xe3, 0x3e, 0xe9, 0xff, 0x59, 0x82, 0xc5, 0x3c, 0xaa, 0xf8, 0x65, 0x5f, 0x85, 0xe1, 0x4e,
+	0xd9, 0x91, 0xd6, 0x7b, 0x79, 0x6e, 0x07, 0xfa, 0x27, 0x96, 0xf3, 0xd9, 0x83, 0xd1, 0x4e, 0x84,
+	0xbb, 0xcd, 0x4c, 0xfe, 0x33, 0x85, 0xb8, 0x47, 0x6a, 0x9d, 0x1f, 0xe8, 0x2d, 0x00, 0x86, 0xb5,
+	0xcb, 0x92, 0xff, 0x61, 0x3a, 0x9a, 0xba, 0x96, 0xd7, 0x60, 0x5c, 0x84, 0x93, 0x1c, 0xec, 0x1e,
+	0x84, 0x87, 0xa2, 0x98, 0x88, 0xb7, 0xee, 0xd0, 0x46, 0xfd, 0xbf, 0xb5, 0x48, 0x64, 0x73, 0x9e,
+	0x27, 0x3c, 0x47, 0x91, 0x9d, 0x86, 0x7e, 0xdb, 0xad, 0xe3, 0x67, 0xdc, 0x39, 0x66, 0x3f, 0xd0,
+	0x96, 0x78, 0x70, 0x71, 0x41, 0x16, 0xac, 0x29, 0x2e, 0x38, 0x63, 0xd0, 0x31, 0x31, 0xc9, 0x5b,
+	0x38, 0x17, 0x93, 0xff, 0x28, 0x41, 0xd9, 0xe0, 0x4f, 0xe2, 0x30, 0x95, 0xc0, 0xe0, 0xc9, 0xda,
+	0xf3, 0x64, 0xcb, 0xaf, 0xc0, 0x8c, 0xac, 0x52, 0x46, 0x94, 0x3b, 0xf6, 0x50, 0x2a, 0x73, 0x31,
+	0x5b, 0x2a, 0x13, 0xa0, 0x37, 0x60, 0x80, 0x6a, 0xa8, 0x80, 0x33, 0x58, 0x1e, 0x0a, 0xde, 0xb4,
+	0x42, 0xeb, 0xae, 0xe3, 0xed, 0x1b, 0x7c, 0x30, 0xda, 0x80, 0x71, 0x17, 0x9f, 0x98, 0x7e, 0x9b,
+	0x2b, 0x38, 0x11, 0x4e, 0x28, 0x00, 0x1f, 0x75, 0xf1, 0x89, 0xd1, 0x66, 0x9a, 0x2d, 0xd0, 0x17,
+	0x60, 0x5e, 0xc2, 0x6a, 0xbe, 0x11, 0x5f, 0xd1, 0x60, 0x76, 0xf7, 0xd4, 0xad, 0xed, 0x1e, 0x5a,
+	0x7e, 0x9d, 0x8b, 0x0b, 0xdf, 0x86, 0x6b, 0x30, 0x1e, 0x78, 0x6d, 0xbf, 0x86, 0x4d, 0xfe, 0x52,
+	0x92, 0xef, 0xc5, 0x18, 0x6b, 0xdd, 0x60, 0x8d, 0x68, 0x1e, 0x86, 0x02, 0x02, 0x2c, 0xcc, 0xc0,
+	0x7e, 0x63, 0x90, 0xfe, 0xae, 0xd6, 0xd1, 0x0a, 0x5c, 0xa0, 0x42, 0xd6, 0x57, 0x28, 0x64, 0x74,
+	0x9c, 0x3e, 0x0f, 0x73, 0x19, 0x5a, 0x38, 0x9d, 0xdf, 0xeb, 0x87, 0x8b, 0xa4, 0x4f, 0x98, 0x93,
+	0xcf, 0xf3, 0xac, 0x94, 0x61, 0x50, 0x44, 0xe0, 0xd9, 0x85, 0x27, 0x7e, 0xd2, 0xb8, 0x60, 0x14,
+	0x61, 0x8a, 0xe2, 0xa6, 0x51, 0x9c, 0x95, 0xf0, 0x24, 0x1b, 0x77, 0xef, 0xef, 0x35, 0xee, 0xae,
+	0xbe, 0xab, 0x32, 0xf1, 0xb3, 0xc1, 0xde, 0xe2, 0x67, 0xef, 0xf3, 0x6c, 0x77, 0x27, 0x94, 0x45,
+	0xb1, 0x0c, 0x15, 0x62, 0xa1, 0xaf, 0x94, 0x22, 0x2f, 0x92, 0xe2, 0xba, 0x0d, 0x83, 0x22, 0x0e,
+	0x36, 0xdc, 0x45, 0x1c, 0x4c, 0x0c, 0x8e, 0xc7, 0xf0, 0x20, 0x19, 0xc3, 0x7b, 0x17, 0x46, 0xe3,
+	0xef, 0xa4, 0x78, 0xb2, 0x58, 0xfd, 0x1e, 0x72, 0x24, 0xf6, 0x7c, 0x0a, 0xbd, 0x06, 0xf4, 0x39,
+	0x23, 0x7f, 0x21, 0x6c, 0x46, 0x45, 0xec, 0xa3, 0xf4, 0xec, 0x20, 0xd2, 0xf7, 0x21, 0xed, 0xaa,
+	0x8a, 0x72, 0xf6, 0x07, 0x30, 0x91, 0x52, 0x0d, 0x3c, 0xd3, 0x71, 0xad, 0x2b, 0xa5, 0x60, 0x8c,
+	0x27, 0x15, 0x82, 0x3e, 0x0b, 0xd3, 0xc9, 0x93, 0x2c, 0xde, 0x0a, 0x68, 0xb0, 0x20, 0xca, 0xcc,
+	0x3f, 0x25, 0x8e, 0x90, 0xfe, 0xfb, 0x1a, 0x5c, 0x92, 0xd3, 0xc4, 0xcd, 0x86, 0x5b, 0x30, 0xdb,
+	0x64, 0xed, 0x2c, 0x0f, 0x6d, 0xda, 0xae, 0x59, 0xb3, 0x6a, 0x87, 0x98, 0x53, 0x78, 0xb1, 0x19,
+	0x83, 0xaa, 0xba, 0x1b, 0xa4, 0x0b, 0xbd, 0x05, 0xf3, 0x19, 0xa0, 0xba, 0x15, 0x5a, 0xfb, 0x56,
+	0x20, 0xde, 0xe7, 0xcc, 0x26, 0xe1, 0x36, 0x79, 0xaf, 0x7e, 0x09, 0x2a, 0x82, 0x1e, 0xce, 0xcf,
+	0xf7, 0xbc, 0xa8, 0x54, 0x54, 0xff, 0x8d, 0x52, 0x87, 0x85, 0x89, 0x6e, 0x4e, 0xed, 0x32, 0x4c,
+	0xba, 0xed, 0xe6, 0x3e, 0xf6, 0x4d, 0xaf, 0x61, 0x52, 0x2d, 0x15, 0x50, 0x3a, 0xfb, 0x8d, 0x71,
+	0xd6, 0xfe, 0xb0, 0x41, 0x95, 0x4f, 0x40, 0x98, 0x2d, 0xb4, 0x1a, 0x7b, 0x40, 0xd9, 0x6f, 0x0c,
+	0x71, 0xb5, 0x16, 0xa0, 0x2a, 0x8c, 0xf2, 0x9d, 0x60, 0x4b, 0x95, 0x3f, 0xbe, 0x10, 0xc7, 0x81,
+	0x45, 0x58, 0xe9, 0xca, 0xe9, 0x5d, 0x39, 0x52, 0xef, 0x34, 0xa0, 0xdb, 0x30, 0xc7, 0xe6, 0xa9,
+	0x79, 0x6e, 0xe8, 0x7b, 0x8e, 0x83, 0x7d, 0x61, 0xee, 0x5c, 0xa0, 0x8c, 0x98, 0xa1, 0xdd, 0x1b,
+	0x51, 0x2f, 0xb7, 0x62, 0x88, 0x84, 0xd4, 0xeb, 0x3e, 0x0e, 0x02, 0x9e, 0x80, 0x11, 0x3f, 0xf5,
+	0x15, 0x98, 0x62, 0xb6, 0x0f, 0x81, 0x13, 0x67, 0x27, 0xae, 0xa4, 0xb5, 0x84, 0x92, 0xd6, 0xa7,
+	0x01, 0xc5, 0xc7, 0xf3, 0xc3, 0xf8, 0x5f, 0x1a, 0x4c, 0x31, 0x1f, 0x37, 0xee, 0x4c, 0xe5, 0xa3,
+	0x41, 0xef, 0xf0, 0xaa, 0x97, 0xc8, 0xe0, 0x1b, 0x5f, 0xbb, 0x92, 0xc3, 0x10, 0x82, 0x91, 0x9a,
+	0x7b, 0xb4, 0xee, 0x85, 0x9a, 0x7a, 0xb1, 0x5c, 0x53, 0x5f, 0x22, 0xd7, 0xb4, 0x01, 0x13, 0xc7,
+	0x76, 0x60, 0xef, 0xdb, 0x8e, 0x1d, 0x9e, 0x76, 0x6b, 0xb2, 0x8d, 0x77, 0x40, 0xa8, 0x1a, 0x5a,
+	0x22, 0x86, 0x24, 0xbd, 0xad, 0x4c, 0xd7, 0xe2, 0x1a, 0x77, 0x98, 0x58, 0x85, 0xb4, 0xed, 0x81,
+	0xd5, 0xc4, 0x84, 0x0b, 0xf1, 0xe5, 0x72, 0x2e, 0x7c, 0x95, 0x72, 0x21, 0xc0, 0xe1, 0xe3, 0x36,
+	0x6e, 0xe3, 0x2e, 0xb8, 0x90, 0x9e, 0xa9, 0x94, 0x99, 0x29, 0xc9, 0xa8, 0xbe, 0x1e, 0x19, 0xc5,
+	0xe8, 0xec, 0x10, 0xc4, 0xe9, 0xfc, 0xba, 0x06, 0xd3, 0xe2, 0xdc, 0x7f, 0x6a, 0x48, 0x7d, 0x08,
+	0x33, 0x29, 0x9a, 0xb8, 0x14, 0xde, 0x86, 0xb9, 0x96, 0xef, 0xd5, 0x70, 0x10, 0xd8, 0xee, 0x81,
+	0x49, 0x3f, 0x9e, 0xc0, 0xf4, 0x00, 0x73, 0x3c, 0x86, 0x8d, 0x99, 0x4e, 0x37, 0x85, 0xa4, 0x4a,
+	0x20, 0xd0, 0xbf, 0xcc, 0x7c, 0x2b, 0xa3, 0xf3, 0x29, 0x85, 0xfb, 0x38, 0x08, 0xac, 0x03, 0x1c,
+	0x99, 0x2c, 0xef, 0xc2, 0x00, 0x4d, 0x78, 0x0b, 0x0f, 0xe6, 0x7a, 0x0e, 0xb5, 0x31, 0x14, 0x34,
+	0x1b, 0x6e, 0x70, 0xb0, 0x2e, 0x98, 0x42, 0x74, 0xcc, 0x62, 0x1e, 0x15, 0x7c, 0x81, 0x4f, 0x61,
+	0x9c, 0x71, 0xbd, 0xc9, 0x7b, 0x38, 0x39, 0xef, 0xe7, 0x9a, 0xd5, 0x6a, 0x84, 0x2b, 0x54, 0x36,
+	0x45, 0x2b, 0x8b, 0xd7, 0x8f, 0x05, 0xf1, 0xb6, 0x8a, 0x03, 0x28, 0x3b, 0x28, 0x1e, 0x93, 0xef,
+	0x67, 0x31, 0xf9, 0x2f, 0x24, 0x63, 0xf2, 0x37, 0x8a, 0x19, 0x14, 0x11, 0x13, 0x8b, 0xc7, 0x37,
+	0xe1, 0xea, 0x3d, 0x1c, 0x6e, 0xee, 0x3c, 0x56, 0xec, 0x45, 0x15, 0x80, 0x89, 0xb4, 0xdb, 0xf0,
+	0x04, 0x03, 0xba, 0x98, 0x8e, 0x1c, 0x24, 0xaa, 0x26, 0xe9, 0xd1, 0x23, 0x7f, 0x05, 0xfa, 0x33,
+	0x58, 0x52, 0x4c, 0xc7, 0x99, 0xbe, 0x0b, 0x53, 0xb1, 0x8f, 0x6c, 0xd0, 0xe2, 0x0b, 0x31, 0xed,
+	0x4b, 0xdd, 0x4d, 0x6b, 0x4c, 0xfa, 0xc9, 0x86, 0x40, 0xff, 0x17, 0x0d, 0xa6, 0x0d, 0x6c, 0xb5,
+	0x5a, 0x0e, 0x0b, 0x1c, 0x44, 0xab, 0xeb, 0xbc, 0xce, 0xd3, 0x12, 0xaf, 0xf3, 0x94, 0xb9, 0xac,
+	0xff, 0xa5, 0xa7, 0x7b, 0x67, 0x73, 0x2e, 0xf4, 0x39, 0x98, 0x49, 0x2d, 0x8d, 0x6b, 0x93, 0x6f,
+	0x69, 0xb0, 0x60, 0xe0, 0x86, 0x8f, 0x83, 0xc3, 0xc8, 0x3b, 0x27, 0xdc, 0xf8, 0x14, 0xae, 0x5d,
+	0x5f, 0x84, 0x4b, 0x72, 0x52, 0xf9, 0x5a, 0xde, 0x82, 0x39, 0xfa, 0xc2, 0x67, 0x73, 0xe7, 0x71,
+	0xfa, 0x80, 0x2e, 0x02, 0x34, 0x3c, 0xbf, 0x86, 0xb7, 0x71, 0x58, 0x3b, 0xe4, 0x89, 0x8d, 0x58,
+	0x8b, 0x6e, 0x41, 0x39, 0x0b, 0xca, 0x0f, 0xdb, 0x16, 0x0c, 0x62, 0x37, 0xa4, 0xb5, 0x2b, 0xd2,
+	0x58, 0x49, 0x74, 0xc4, 0xb8, 0x15, 0xb2, 0xb9, 0xf3, 0x98, 0xe2, 0xe2, 0xf5, 0x29, 0x1c, 0x56,
+	0xff, 0x56, 0x09, 0x66, 0x0d, 0x6c, 0xd5, 0x25, 0xd4, 0xad, 0xc1, 0x85, 0xa8, 0x1a, 0x6c, 0x7c,
+	0x6d, 0x31, 0xcf, 0xb6, 0xd8, 0x79, 0x4c, 0xb5, 0x2e, 0x1d, 0xab, 0x72, 0xc5, 0xb2, 0xce, 0x5c,
+	0x9f, 0xcc, 0x99, 0xdb, 0x83, 0xb2, 0xed, 0x92, 0x11, 0xf6, 0x31, 0x36, 0xb1, 0x1b, 0x69, 0xb0,
+	0x2e, 0x2b, 0x68, 0x67, 0x22, 0xe0, 0x2d, 0x57, 0xa8, 0xa2, 0x6a, 0x9d, 0x1c, 0x8c, 0x16, 0x41,
+	0x42, 0x6b, 0x70, 0xfa, 0x29, 0x61, 0x43, 0xa4, 0x61, 0xd7, 0xfe, 0x18, 0xa3, 0x97, 0x80, 0x7e,
+	0x0c, 0xc1, 0xa4, 0x23, 0x58, 0xb9, 0xd2, 0x00, 0x2d, 0x57, 0xa2, 0xe5, 0x61, 0x8f, 0xac, 0x03,
+	0xcc, 0xaa, 0x97, 0xff, 0xaa, 0x04, 0x73, 0x19, 0x5e, 0xf1, 0xed, 0x38, 0x0b, 0xb3, 0xa4, 0xfa,
+	0xa2, 0x74, 0x3e, 0x7d, 0x81, 0xbe, 0x04, 0xb3, 0x19, 0xa4, 0x22, 0x94, 0xde, 0xab, 0x02, 0x9c,
+	0x4e, 0x63, 0xa7, 0x91, 0x74, 0x09, 0xbb, 0x2e, 0xc8, 0xd8, 0xf5, 0x43, 0x0d, 0xe6, 0x1e, 0xb5,
+	0xfd, 0x03, 0xfc, 0xb3, 0x7d, 0xb6, 0xf4, 0x0a, 0x94, 0xb3, 0xcb, 0xe4, 0xc2, 0xff, 0xed, 0x12,
+	0xcc, 0xdd, 0xc7, 0x3f, 0xf3, 0x3c, 0xf8, 0xc9, 0xc8, 0xd7, 0x5d, 0x28, 0x67, 0x79, 0xc5, 0xe5,
+	0x4b, 0x82, 0x43, 0x93, 0xe1, 0xf8, 0x44, 0x83, 0x4b, 0x0f, 0xbc, 0xd0, 0x6e, 0x9c, 0x12, 0x77,
+	0xdb, 0x3b, 0xc6, 0xfe, 0x7d, 0x8b, 0xf8, 0xd2, 0x11, 0xd7, 0xbf, 0x04, 0xb3, 0x0d, 0xde, 0x63,
+	0x36, 0x69, 0x97, 0x99, 0x30, 0xd8, 0xf2, 0xe4, 0x23, 0x89, 0x8e, 0xd9, 0x6c, 0xd3, 0x8d, 0x6c,
+	0x63, 0xa0, 0x5f, 0x81, 0xcb, 0x39, 0x14, 0xf0, 0x43, 0x61, 0xc1, 0xc2, 0x3d, 0x1c, 0x6e, 0xf8,
+	0x5e, 0x10, 0xf0, 0x5d, 0x49, 0x5c, 0x6e, 0x09, 0xc7, 0x4f, 0x4b, 0x39, 0x7e, 0xd7, 0x60, 0x3c,
+	0xb4, 0xfc, 0x03, 0x1c, 0x46, 0xbb, 0xcc, 0xae, 0xb9, 0x31, 0xd6, 0xca, 0xf1, 0xe9, 0x3f, 0xee,
+	0x83, 0x4b, 0xf2, 0x39, 0x38, 0x3f, 0x9b, 0x04, 0x0f, 0x51, 0x0d, 0xfb, 0xa7, 0xcc, 0x0d, 0xe5,
+	0xcb, 0xbf, 0xa7, 0x32, 0x10, 0x73, 0xd1, 0x51, 0xe3, 0x3b, 0xb8, 0x7b, 0x4a, 0x0d, 0x40, 0x76,
+	0xc3, 0x8c, 0x86, 0xb1, 0x26, 0xf4, 0x89, 0x06, 0x33, 0x0d, 0x9a, 0x37, 0x36, 0x6b, 0x56, 0x3b,
+	0xc0, 0x9d, 0x69, 0x99, 0xbe, 0xbb, 0x7f, 0xb6, 0x69, 0x59, 0x2a, 0x7a, 0x83, 0x60, 0x4c, 0x4c,
+	0x8e, 0x1a, 0x99, 0x8e, 0x4a, 0x0b, 0xa6, 0x32, 0x54, 0x4a, 0xcc, 0xd3, 0xad, 0xa4, 0x79, 0xba,
+	0x9a, 0x73, 0x1c, 0xd2, 0x34, 0xf1, 0xcd, 0x8b, 0xdb, 0xa8, 0x95, 0x16, 0xcc, 0xe5, 0x10, 0x28,
+	0x99, 0xf7, 0xdd, 0xf8, 0xbc, 0xe3, 0xb9, 0xe1, 0xde, 0x7b, 0x38, 0xec, 0xe4, 0xe0, 0x29, 0xde,
+	0xb8, 0x55, 0xfc, 0x23, 0x0d, 0x96, 0x79, 0xd6, 0x3b, 0xc3, 0xb4, 0x4c, 0xba, 0x4e, 0xe1, 0x99,
+	0x75, 0x77, 0xca, 0xd0, 0x13, 0x76, 0x88, 0xa2, 0xf2, 0x24, 0x11, 0xab, 0xee, 0x9e, 0x69, 0xbc,
+	0x28, 0x69, 0x2c, 0x8c, 0xfd, 0x0a, 0xd0, 0x8b, 0x30, 0xd6, 0x20, 0x06, 0xd0, 0x03, 0xcc, 0x6c,
+	0x29, 0x9e, 0xa5, 0x4d, 0x36, 0xea, 0x3e, 0xbc, 0xdc, 0xc5, 0x5a, 0x23, 0x73, 0xa9, 0x5f, 0xd8,
+	0xe3, 0x67, 0xdb, 0x56, 0x0a, 0xad, 0xbf, 0x41, 0xdf, 0xf0, 0x0a, 0xc1, 0xa6, 0x97, 0x64, 0x17,
+	0xb1, 0x31, 0x3d, 0xa4, 0xef, 0x54, 0x93, 0x60, 0x91, 0xe1, 0x30, 0xd3, 0xc9, 0x4e, 0x8a, 0x40,
+	0x4c, 0x9b, 0x57, 0x2f, 0xf6, 0x1b, 0x9d, 0xd4, 0xe5, 0x2e, 0x8b, 0xc2, 0xf0, 0x27, 0xe5, 0xe2,
+	0x13, 0x03, 0x3c, 0x84, 0xc4, 0xe2, 0x43, 0x63, 0xbc, 0x95, 0x45, 0x90, 0xf4, 0x2a, 0xcc, 0x1a,
+	0xa2, 0x16, 0x93, 0x7d, 0xef, 0x42, 0x10, 0xbb, 0x0a, 0x17, 0xea, 0x56, 0x68, 0x71, 0x66, 0x2c,
+	0xe4, 0x15, 0x9e, 0xaf, 0xbb, 0xa7, 0x06, 0x1d, 0xa8, 0xbf, 0x0f, 0x73, 0x19, 0x54, 0x7c, 0x01,
+	0xbd, 0xe2, 0xba, 0xf1, 0x23, 0x8d, 0x58, 0xc4, 0xd2, 0xec, 0x0c, 0xba, 0x06, 0x4b, 0x1b, 0x0f,
+	0xef, 0x3f, 0xda, 0xd9, 0xda, 0xab, 0x3e, 0x7c, 0x60, 0x6e, 0xac, 0xef, 0xec, 0xdc, 0x5d, 0xdf,
+	0xf8, 0xa2, 0xb9, 0xbb, 0xb7, 0xbe, 0xb7, 0x65, 0x56, 0x1f, 0x3c, 0x59, 0xdf, 0xa9, 0x6e, 0x4e,
+	0xfe, 0x3f, 0x74, 0x1d, 0x5e, 0xc8, 0x1f, 0xb6, 0xbb, 0xf1, 0xde, 0xd6, 0xe6, 0x07, 0x3b, 0x5b,
+	0x9b, 0x93, 0x1a, 0x7a, 0x19, 0xae, 0xe5, 0x0f, 0x24, 0x7f, 0x56, 0x1f, 0xdc, 0x33, 0x1f, 0x6e,
+	0x6f, 0x4f, 0x96, 0x0a, 0x70, 0x7e, 0xb0, 0xb1, 0xb1, 0xb5, 0xb5, 0xb9, 0xb5, 0x39, 0xd9, 0x87,
+	0x5e, 0x81, 0xeb, 0xf9, 0x03, 0x37, 0xb7, 0xd6, 0x37, 0xcd, 0x9d, 0xad, 0xbd, 0xbd, 0x2d, 0x63,
+	0x6b, 0x73, 0xf2, 0xc2, 0xda, 0xbf, 0xdf, 0x04, 0xe0, 0x16, 0xf8, 0xfa, 0xa3, 0x2a, 0xfa, 0x5d,
+	0x0d, 0x66, 0xe5, 0x1f, 0xf8, 0x41, 0xb7, 0xcf, 0xf6, 0x65, 0xb9, 0xca, 0x9b, 0x3d, 0xc3, 0xf1,
+	0x8d, 0xfb, 0x3d, 0x0d, 0xe6, 0x72, 0xbe, 0x30, 0x85, 0xde, 0x2c, 0xfa, 0x3a, 0x53, 0x1e, 0x35,
+	0x77, 0x7a, 0x07, 0xe4, 0xe4, 0x7c, 0x53, 0x83, 0xab, 0x45, 0x5f, 0x41, 0x42, 0x5f, 0x38, 0xef,
+	0x57, 0xa3, 0x2a, 0xeb, 0xe7, 0xc0, 0xc0, 0x29, 0x25, 0x9b, 0x28, 0xff, 0xbe, 0x91, 0x62, 0x13,
+	0x95, 0xdf, 0x55, 0x52, 0x6c, 0x62, 0xc1, 0x87, 0x94, 0xfe, 0x58, 0x83, 0x4a, 0xfe, 0x57, 0x80,
+	0x50, 0x7e, 0xa5, 0x68, 0xe1, 0xd7, 0x91, 0x2a, 0x9f, 0x3d, 0x13, 0x2c, 0xa7, 0xeb, 0xd7, 0x00,
+	0x65, 0x3f, 0xb1, 0x83, 0xd6, 0x72, 0x51, 0xe6, 0x7e, 0xa2, 0xa8, 0x72, 0xab, 0x27, 0x18, 0x3e,
+	0xfd, 0xd7, 0x35, 0x98, 0xcf, 0xfd, 0x60, 0x0e, 0x7a, 0x2b, 0x17, 0x65, 0xd1, 0xf7, 0x7a, 0x2a,
+	0x6f, 0x9f, 0x05, 0x94, 0x13, 0xe5, 0xc2, 0x58, 0xe2, 0x63, 0x1a, 0xe8, 0xd5, 0x5c, 0x64, 0xb2,
+	0x6f, 0x76, 0x54, 0x56, 0xba, 0x1d, 0xce, 0xe7, 0xfb, 0x44, 0x83, 0x8b, 0x92, 0x2f, 0x52, 0xa0,
+	0x5b, 0xea, 0xc3, 0x26, 0xfd, 0x06, 0x46, 0xe5, 0xf5, 0xde, 0x80, 0x38, 0x09, 0x21, 0x4c, 0xa4,
+	0x3e, 0xd0, 0x80, 0x56, 0x55, 0xa6, 0x9e, 0x24, 0xeb, 0x54, 0x79, 0xad, 0x7b, 0x00, 0x3e, 0xeb,
+	0x09, 0x4c, 0xa6, 0x5f, 0x19, 0xa3, 0x7c, 0x2c, 0x39, 0xef, 0xb0, 0x2b, 0x37, 0x7b, 0x80, 0x88,
+	0x1d, 0xbb, 0xdc, 0x12, 0x6c, 0xc5, 0xb1, 0x2b, 0x7a, 0xe9, 0x58, 0x39, 0x47, 0xc5, 0x37, 0xfa,
+	0x33, 0x0d, 0x2e, 0xa9, 0x2a, 0xb4, 0xd1, 0x3b, 0x67, 0x2c, 0xec, 0x66, 0xa4, 0x7d, 0xee, 0x5c,
+	0x65, 0xe1, 0x9c, 0x65, 0x39, 0x65, 0xcc, 0x4a, 0x96, 0xa9, 0x8b, 0xa8, 0x95, 0x2c, 0x2b, 0xa8,
+	0x9a, 0x8e, 0xed, 0xa3, 0xe4, 0xc9, 0x49, 0xe1, 0x3e, 0xe6, 0x3f, 0xb3, 0x2a, 0xdc, 0x47, 0xd5,
+	0x0b, 0x97, 0xd8, 0x3e, 0x4a, 0x2b, 0x89, 0x8b, 0xf7, 0x51, 0x55, 0xcd, 0x5c, 0xbc, 0x8f, 0xca,
+	0xf2, 0xe5, 0xf8, 0x3e, 0x66, 0x8b, 0x85, 0x8b, 0xf7, 0x31, 0xb7, 0x54, 0xb9, 0x78, 0x1f, 0xf3,
+	0x6b, 0x93, 0xd1, 0x9f, 0xd2, 0x38, 0x72, 0x6e, 0x15, 0x30, 0xfa, 0x6c, 0x4f, 0x6b, 0x4e, 0xd6,
+	0x21, 0x57, 0xde, 0x39, 0x1b, 0x70, 0x82, 0xb4, 0xdc, 0x12, 0x78, 0x25, 0x69, 0x45, 0x45, 0xf8,
+	0x4a, 0xd2, 0x8a, 0xab, 0xee, 0xff, 0x42, 0x83, 0x45, 0x75, 0xed, 0x2b, 0xfa, 0xbc, 0x62, 0x82,
+	0x2e, 0x0a, 0x80, 0x2b, 0xef, 0x9e, 0x19, 0x9e, 0xd3, 0xf8, 0x55, 0x0d, 0xca, 0x79, 0x15, 0xd0,
+	0xe8, 0x8e, 0x02, 0xbb, 0xb2, 0xd4, 0xbb, 0xf2, 0xd6, 0x19, 0x20, 0x39, 0x45, 0x5f, 0xd6, 0x60,
+	0x5a, 0x56, 0x47, 0x8b, 0x5e, 0x2f, 0x7c, 0x14, 0x28, 0xa9, 0x1a, 0xae, 0xbc, 0xd1, 0x23, 0x14,
+	0xa7, 0xe2, 0xcf, 0xe9, 0x77, 0x40, 0x15, 0x75, 0xa2, 0xe8, 0x73, 0x05, 0x67, 0x43, 0x5d, 0xe4,
+	0x5b, 0xf9, 0xfc, 0x59, 0xc1, 0x63, 0xc6, 0xb3, 0xbc, 0x10, 0x54, 0x61, 0x3c, 0x2b, 0xeb, 0x59,
+	0x15, 0xc6, 0x73, 0x41, 0xc5, 0x69, 0x8c, 0x59, 0x39, 0xfe, 0x68, 0x21, 0xb3, 0x94, 0xe5, 0x95,
+	0xc5, 0xcc, 0x52, 0x17, 0x29, 0xa2, 0x8f, 0x61, 0x2a, 0x53, 0x38, 0x87, 0x6e, 0x2a, 0x90, 0xca,
+	0xeb, 0x19, 0x2b, 0x6b, 0xbd, 0x80, 0x74, 0x4c, 0xb7, 0x54, 0x29, 0x9c, 0xc2, 0x74, 0x93, 0x17,
+	0xf0, 0x29, 0x4c, 0xb7, 0x9c, 0x2a, 0x3b, 0x74, 0x04, 0xa3, 0xf1, 0xd2, 0x24, 0xf4, 0x19, 0x25,
+	0x86, 0x54, 0x2d, 0x5e, 0xe5, 0xd5, 0x2e, 0x47, 0xc7, 0x44, 0x56, 0x56, 0x5b, 0xa4, 0x10, 0x59,
+	0x45, 0x79, 0x94, 0x42, 0x64, 0x95, 0x05, 0x4c, 0xc4, 0x4c, 0x97, 0x94, 0x0c, 0x29, 0xcc, 0xf4,
+	0xfc, 0xfa, 0xa3, 0xca, 0xeb, 0xbd, 0x01, 0x45, 0x4f, 0x0d, 0xa1, 0x53, 0x81, 0x83, 0x6e, 0xe4,
+	0xd7, 0xde, 0xa6, 0xcb, 0x7a, 0x2a, 0xaf, 0x74, 0x35, 0xb6, 0x33, 0x4d, 0xa7, 0xc4, 0x45, 0x31,
+	0x4d, 0xa6, 0xec, 0x47, 0x31, 0x4d, 0xb6, 0x66, 0x86, 0x4d, 0x23, 0x2a, 0x54, 0x94, 0xd3, 0xa4,
+	0xea, 0x6a, 0x94, 0xd3, 0xa4, 0x4b, 0x5e, 0x88, 0x3b, 0x97, 0xa8, 0x2e, 0x51, 0xb8, 0x73, 0xb2,
+	0xca, 0x18, 0x85, 0x3b, 0x27, 0x2f, 0x5a, 0xe1, 0x9a, 0x53, 0x52, 0x81, 0xa0, 0xd6, 0x9c, 0xf9,
+	0x15, 0x12, 0x6a, 0xcd, 0xa9, 0x2a, 0x75, 0x20, 0xd6, 0x5e, 0x6e, 0x41, 0x84, 0xc2, 0xda, 0x2b,
+	0xaa, 0xd9, 0x50, 0x58, 0x7b, 0xc5, 0xf5, 0x17, 0x2e, 0x8c, 0x25, 0xca, 0x09, 0x14, 0x1b, 0x22,
+	0xab, 0xa8, 0x50, 0x6c, 0x88, 0xb4, 0x4a, 0x81, 0xaa, 0x0f, 0x59, 0xea, 0x1f, 0xa9, 0x7c, 0xe5,
+	0xdc, 0xa2, 0x06, 0x85, 0xfa, 0x50, 0xd5, 0x17, 0x10, 0x67, 0x37, 0x5d, 0x24, 0x80, 0x54, 0xff,
+	0xae, 0x40, 0x5a, 0x8a, 0xa0, 0x70, 0x76, 0x73, 0x2b, 0x10, 0x42, 0x98, 0x48, 0x65, 0xc3, 0x15,
+	0x17, 0x84, 0xbc, 0xc6, 0x40, 0x71, 0x41, 0xe4, 0x25, 0xda, 0x89, 0x6f, 0x9f, 0xca, 0xb6, 0xaa,
+	0x7c, 0x7b, 0x79, 0xfe, 0x59, 0xe5, 0xdb, 0xe7, 0xa4, 0x72, 0xc9, 0xc4, 0xe9, 0xec, 0xa4, 0x62,
+	0xe2, 0x9c, 0xa4, 0xaf, 0x62, 0xe2, 0xdc, 0xd4, 0xe7, 0xef, 0x68, 0x30, 0x23, 0x4d, 0x28, 0xa2,
+	0xfc, 0x13, 0xa3, 0x4a, 0x81, 0x56, 0x6e, 0xf7, 0x0a, 0x16, 0x3b, 0xef, 0xb2, 0x74, 0x9c, 0xe2,
+	0xbc, 0x2b, 0xf2, 0x9c, 0x8a, 0xf3, 0xae, 0xcc, 0x5c, 0x7e, 0x5b, 0x8b, 0x5e, 0xa5, 0xe6, 0xe7,
+	0x7d, 0xd0, 0x7a, 0x91, 0x73, 0x56, 0x98, 0x1f, 0xab, 0xdc, 0x3d, 0x0f, 0x8a, 0x44, 0xfc, 0x2b,
+	0x9e, 0xf8, 0x51, 0xc7, 0xbf, 0x24, 0x99, 0x25, 0x75, 0xfc, 0x4b, 0x9a, 0x53, 0x22, 0x92, 0x99,
+	0xcc, 0xd6, 0xa8, 0x24, 0x53, 0x9a, 0x22, 0x52, 0x49, 0xa6, 0x3c, 0x11, 0x74, 0x77, 0xeb, 0x7b,
+	0x3f, 0x58, 0xd4, 0xbe, 0xff, 0x83, 0x45, 0xed, 0x5f, 0x7f, 0xb0, 0xa8, 0xfd, 0xe2, 0x9b, 0x07,
+	0x76, 0x78, 0xd8, 0xde, 0x5f, 0xa9, 0x79, 0xcd, 0xd5, 0xc4, 0xff, 0x87, 0x5a, 0x39, 0xc0, 0x2e,
+	0xfb, 0x67, 0x61, 0xb1, 0xff, 0x56, 0xf6, 0x59, 0xfe, 0xe7, 0xf1, 0xcd, 0xfd, 0x01, 0xda, 0x77,
+	0xeb, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xcd, 0x64, 0x09, 0xc3, 0xd9, 0x6c, 0x00, 0x00,
 }
 
 func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
@@ -5860,6 +6696,20 @@ func (m *StartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.CompletionCallbacks) > 0 {
+		for iNdEx := len(m.CompletionCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompletionCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.PartitionConfig) > 0 {
 		for k := range m.PartitionConfig {
 			v := m.PartitionConfig[k]
@@ -5971,6 +6821,134 @@ func (m *StartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *CompletionCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompletionCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompletionCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			v := m.Headers[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintService(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompletionCallbackInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompletionCallbackInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompletionCallbackInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.LastFailureReason) > 0 {
+		i -= len(m.LastFailureReason)
+		copy(dAtA[i:], m.LastFailureReason)
+		i = encodeVarintService(dAtA, i, uint64(len(m.LastFailureReason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.NextAttemptTime != nil {
+		{
+			size, err := m.NextAttemptTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LastAttemptTime != nil {
+		{
+			size, err := m.LastAttemptTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.State != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *StartWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6124,6 +7102,20 @@ func (m *SignalWithStartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []by
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.CompletionCallbacks) > 0 {
+		for iNdEx := len(m.CompletionCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompletionCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.PartitionConfig) > 0 {
 		for k := range m.PartitionConfig {
 			v := m.PartitionConfig[k]
@@ -6374,6 +7366,112 @@ func (m *TerminateWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *UpdateWorkflowMemoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateWorkflowMemoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkflowMemoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Memo != nil {
+		{
+			size, err := m.Memo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateWorkflowMemoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateWorkflowMemoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkflowMemoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DescribeWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6444,6 +7542,32 @@ func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.CompletionCallbacks) > 0 {
+		for iNdEx := len(m.CompletionCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompletionCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.StickyExecutionInfo != nil {
+		{
+			size, err := m.StickyExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.PendingDecision != nil {
 		{
 			size, err := m.PendingDecision.MarshalToSizedBuffer(dAtA[:i])
@@ -6511,7 +7635,7 @@ func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryWorkflowRequest) Marshal() (dAtA []byte, err error) {
+func (m *StickyExecutionInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6521,12 +7645,12 @@ func (m *QueryWorkflowRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *StickyExecutionInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StickyExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6535,14 +7659,88 @@ func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+	if m.HitRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.HitRate))))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x31
 	}
-	if m.Request != nil {
+	if m.FallbackCount != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.FallbackCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.HitCount != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.HitCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ScheduleToStartTimeout != nil {
+		{
+			size, err := m.ScheduleToStartTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StickyHost) > 0 {
+		i -= len(m.StickyHost)
+		copy(dAtA[i:], m.StickyHost)
+		i = encodeVarintService(dAtA, i, uint64(len(m.StickyHost)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StickyTaskList != nil {
+		{
+			size, err := m.StickyTaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
 		{
 			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
@@ -7424,6 +8622,18 @@ func (m *RecordActivityTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (in
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ScheduleToStartBreakdown != nil {
+		{
+			size, err := m.ScheduleToStartBreakdown.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.PollRequest != nil {
 		{
 			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
@@ -7475,6 +8685,68 @@ func (m *RecordActivityTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *ScheduleToStartBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleToStartBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleToStartBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IsolationWaitTimeInMs != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.IsolationWaitTimeInMs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RatelimitWaitTimeInMs != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.RatelimitWaitTimeInMs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BacklogWaitTimeInMs != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.BacklogWaitTimeInMs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Forwarded {
+		i--
+		if m.Forwarded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SyncMatched {
+		i--
+		if m.SyncMatched {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RecordActivityTaskStartedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7598,6 +8870,18 @@ func (m *RespondDecisionTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.EagerActivitySlots != nil {
+		{
+			size, err := m.EagerActivitySlots.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.DomainId) > 0 {
 		i -= len(m.DomainId)
 		copy(dAtA[i:], m.DomainId)
@@ -8425,7 +9709,7 @@ func (m *RecordChildExecutionCompletedResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *ReplicateEventsV2Request) Marshal() (dAtA []byte, err error) {
+func (m *GetCompletionCallbacksRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8435,12 +9719,12 @@ func (m *ReplicateEventsV2Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReplicateEventsV2Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetCompletionCallbacksRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReplicateEventsV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetCompletionCallbacksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8449,44 +9733,6 @@ func (m *ReplicateEventsV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.NewRunEvents != nil {
-		{
-			size, err := m.NewRunEvents.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Events != nil {
-		{
-			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.VersionHistoryItems) > 0 {
-		for iNdEx := len(m.VersionHistoryItems) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VersionHistoryItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
 	if m.WorkflowExecution != nil {
 		{
 			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
@@ -8509,7 +9755,7 @@ func (m *ReplicateEventsV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *ReplicateEventsV2Response) Marshal() (dAtA []byte, err error) {
+func (m *GetCompletionCallbacksResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8519,12 +9765,12 @@ func (m *ReplicateEventsV2Response) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReplicateEventsV2Response) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetCompletionCallbacksResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReplicateEventsV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetCompletionCallbacksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8533,10 +9779,58 @@ func (m *ReplicateEventsV2Response) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.HistoryLength != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.HistoryLength))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CloseTime != nil {
+		{
+			size, err := m.CloseTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CloseStatus != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.CloseStatus))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Callbacks) > 0 {
+		for iNdEx := len(m.Callbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Callbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SyncShardStatusRequest) Marshal() (dAtA []byte, err error) {
+func (m *RecordCompletionCallbackStateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8546,12 +9840,12 @@ func (m *SyncShardStatusRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncShardStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordCompletionCallbackStateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SyncShardStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordCompletionCallbackStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8560,9 +9854,9 @@ func (m *SyncShardStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Time != nil {
+	if m.State != nil {
 		{
-			size, err := m.Time.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8570,24 +9864,36 @@ func (m *SyncShardStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+	if m.Index != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Index))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x18
 	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SyncShardStatusResponse) Marshal() (dAtA []byte, err error) {
+func (m *RecordCompletionCallbackStateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8597,12 +9903,12 @@ func (m *SyncShardStatusResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncShardStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordCompletionCallbackStateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SyncShardStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordCompletionCallbackStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8614,7 +9920,7 @@ func (m *SyncShardStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *SyncActivityRequest) Marshal() (dAtA []byte, err error) {
+func (m *ReplicateEventsV2Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8624,7 +9930,196 @@ func (m *SyncActivityRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncActivityRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReplicateEventsV2Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicateEventsV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.NewRunEvents != nil {
+		{
+			size, err := m.NewRunEvents.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Events != nil {
+		{
+			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VersionHistoryItems) > 0 {
+		for iNdEx := len(m.VersionHistoryItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VersionHistoryItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicateEventsV2Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicateEventsV2Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicateEventsV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncShardStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncShardStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncShardStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Time != nil {
+		{
+			size, err := m.Time.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncShardStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncShardStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncShardStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncActivityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncActivityRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -8951,21 +10446,21 @@ func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		dAtA[i] = 0x1a
 	}
 	if len(m.ShardIds) > 0 {
-		dAtA83 := make([]byte, len(m.ShardIds)*10)
-		var j82 int
+		dAtA97 := make([]byte, len(m.ShardIds)*10)
+		var j96 int
 		for _, num1 := range m.ShardIds {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA83[j82] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA97[j96] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j82++
+				j96++
 			}
-			dAtA83[j82] = uint8(num)
-			j82++
+			dAtA97[j96] = uint8(num)
+			j96++
 		}
-		i -= j82
-		copy(dAtA[i:], dAtA83[:j82])
-		i = encodeVarintService(dAtA, i, uint64(j82))
+		i -= j96
+		copy(dAtA[i:], dAtA97[:j96])
+		i = encodeVarintService(dAtA, i, uint64(j96))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -10126,21 +11621,21 @@ func (m *GetCrossClusterTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 		dAtA[i] = 0x12
 	}
 	if len(m.ShardIds) > 0 {
-		dAtA93 := make([]byte, len(m.ShardIds)*10)
-		var j92 int
+		dAtA107 := make([]byte, len(m.ShardIds)*10)
+		var j106 int
 		for _, num1 := range m.ShardIds {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA93[j92] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA107[j106] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j92++
+				j106++
 			}
-			dAtA93[j92] = uint8(num)
-			j92++
+			dAtA107[j106] = uint8(num)
+			j106++
 		}
-		i -= j92
-		copy(dAtA[i:], dAtA93[:j92])
-		i = encodeVarintService(dAtA, i, uint64(j92))
+		i -= j106
+		copy(dAtA[i:], dAtA107[:j106])
+		i = encodeVarintService(dAtA, i, uint64(j106))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -10374,21 +11869,21 @@ func (m *GetFailoverInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.PendingShards) > 0 {
-		dAtA97 := make([]byte, len(m.PendingShards)*10)
-		var j96 int
+		dAtA111 := make([]byte, len(m.PendingShards)*10)
+		var j110 int
 		for _, num1 := range m.PendingShards {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA97[j96] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA111[j110] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j96++
+				j110++
 			}
-			dAtA97[j96] = uint8(num)
-			j96++
+			dAtA111[j110] = uint8(num)
+			j110++
 		}
-		i -= j96
-		copy(dAtA[i:], dAtA97[:j96])
-		i = encodeVarintService(dAtA, i, uint64(j96))
+		i -= j110
+		copy(dAtA[i:], dAtA111[:j110])
+		i = encodeVarintService(dAtA, i, uint64(j110))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -10537,6 +12032,70 @@ func (m *StartWorkflowExecutionRequest) Size() (n int) {
 			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
 		}
 	}
+	if len(m.CompletionCallbacks) > 0 {
+		for _, e := range m.CompletionCallbacks {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CompletionCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + len(v) + sovService(uint64(len(v)))
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CompletionCallbackInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovService(uint64(m.State))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovService(uint64(m.Attempt))
+	}
+	if m.LastAttemptTime != nil {
+		l = m.LastAttemptTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.NextAttemptTime != nil {
+		l = m.NextAttemptTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.LastFailureReason)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10620,8 +12179,14 @@ func (m *SignalWithStartWorkflowExecutionRequest) Size() (n int) {
 			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.CompletionCallbacks) > 0 {
+		for _, e := range m.CompletionCallbacks {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
@@ -10717,6 +12282,54 @@ func (m *TerminateWorkflowExecutionResponse) Size() (n int) {
 	return n
 }
 
+func (m *UpdateWorkflowMemoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Memo != nil {
+		l = m.Memo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateWorkflowMemoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10767,6 +12380,49 @@ func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
 		l = m.PendingDecision.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.StickyExecutionInfo != nil {
+		l = m.StickyExecutionInfo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.CompletionCallbacks) > 0 {
+		for _, e := range m.CompletionCallbacks {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StickyExecutionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StickyTaskList != nil {
+		l = m.StickyTaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.StickyHost)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ScheduleToStartTimeout != nil {
+		l = m.ScheduleToStartTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.HitCount != 0 {
+		n += 1 + sovService(uint64(m.HitCount))
+	}
+	if m.FallbackCount != 0 {
+		n += 1 + sovService(uint64(m.FallbackCount))
+	}
+	if m.HitRate != 0 {
+		n += 9
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11184,6 +12840,37 @@ func (m *RecordActivityTaskStartedRequest) Size() (n int) {
 		l = m.PollRequest.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.ScheduleToStartBreakdown != nil {
+		l = m.ScheduleToStartBreakdown.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleToStartBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SyncMatched {
+		n += 2
+	}
+	if m.Forwarded {
+		n += 2
+	}
+	if m.BacklogWaitTimeInMs != 0 {
+		n += 1 + sovService(uint64(m.BacklogWaitTimeInMs))
+	}
+	if m.RatelimitWaitTimeInMs != 0 {
+		n += 1 + sovService(uint64(m.RatelimitWaitTimeInMs))
+	}
+	if m.IsolationWaitTimeInMs != 0 {
+		n += 1 + sovService(uint64(m.IsolationWaitTimeInMs))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11243,6 +12930,10 @@ func (m *RespondDecisionTaskCompletedRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.EagerActivitySlots != nil {
+		l = m.EagerActivitySlots.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11597,6 +13288,97 @@ func (m *RecordChildExecutionCompletedResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetCompletionCallbacksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetCompletionCallbacksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Callbacks) > 0 {
+		for _, e := range m.Callbacks {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.CloseStatus != 0 {
+		n += 1 + sovService(uint64(m.CloseStatus))
+	}
+	if m.CloseTime != nil {
+		l = m.CloseTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.HistoryLength != 0 {
+		n += 1 + sovService(uint64(m.HistoryLength))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RecordCompletionCallbackStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovService(uint64(m.Index))
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RecordCompletionCallbackStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ReplicateEventsV2Request) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12969,62 +14751,11 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.PartitionConfig[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StartWorkflowExecutionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionCallbacks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -13034,23 +14765,25 @@ func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
+			m.CompletionCallbacks = append(m.CompletionCallbacks, &CompletionCallback{})
+			if err := m.CompletionCallbacks[len(m.CompletionCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13074,7 +14807,7 @@ func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *CompletionCallback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13097,17 +14830,17 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompletionCallback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompletionCallback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -13117,63 +14850,27 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.SignalWorkflowExecutionRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13200,84 +14897,104 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExternalWorkflowExecution == nil {
-				m.ExternalWorkflowExecution = &v1.WorkflowExecution{}
-			}
-			if err := m.ExternalWorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			m.ChildWorkflowOnly = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -13300,7 +15017,7 @@ func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *CompletionCallbackInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13323,17 +15040,17 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompletionCallbackInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompletionCallbackInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -13343,33 +15060,29 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.SignalWithStartWorkflowExecutionRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var stringLen uint64
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -13379,29 +15092,16 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.State |= CompletionCallbackState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
 			}
-			var msglen int
+			m.Attempt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -13411,175 +15111,16 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Attempt |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAttemptTime", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PartitionConfig == nil {
-				m.PartitionConfig = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthService
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthService
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.PartitionConfig[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
-			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -13589,78 +15130,31 @@ func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.LastAttemptTime == nil {
+				m.LastAttemptTime = &types.Timestamp{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.LastAttemptTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAttemptTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13687,16 +15181,16 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.ResetWorkflowExecutionRequest{}
+			if m.NextAttemptTime == nil {
+				m.NextAttemptTime = &types.Timestamp{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NextAttemptTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailureReason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13724,7 +15218,7 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			m.LastFailureReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13748,7 +15242,7 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13771,10 +15265,10 @@ func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13831,7 +15325,7 @@ func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13854,10 +15348,10 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13890,7 +15384,7 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.TerminateWorkflowExecutionRequest{}
+				m.Request = &v1.SignalWorkflowExecutionRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -14006,7 +15500,7 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14029,10 +15523,10 @@ func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -14057,7 +15551,7 @@ func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14080,10 +15574,10 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14116,7 +15610,7 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.DescribeWorkflowExecutionRequest{}
+				m.Request = &v1.SignalWithStartWorkflowExecutionRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -14154,7 +15648,168 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PartitionConfig[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionCallbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompletionCallbacks = append(m.CompletionCallbacks, &CompletionCallback{})
+			if err := m.CompletionCallbacks[len(m.CompletionCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
 			if err != nil {
@@ -14176,7 +15831,7 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14199,17 +15854,17 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionConfiguration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14219,31 +15874,78 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutionConfiguration == nil {
-				m.ExecutionConfiguration = &v1.WorkflowExecutionConfiguration{}
-			}
-			if err := m.ExecutionConfiguration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14270,18 +15972,18 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecutionInfo == nil {
-				m.WorkflowExecutionInfo = &v1.WorkflowExecutionInfo{}
+			if m.Request == nil {
+				m.Request = &v1.ResetWorkflowExecutionRequest{}
 			}
-			if err := m.WorkflowExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingActivities", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14291,95 +15993,23 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingActivities = append(m.PendingActivities, &v1.PendingActivityInfo{})
-			if err := m.PendingActivities[len(m.PendingActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingChildren", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PendingChildren = append(m.PendingChildren, &v1.PendingChildExecutionInfo{})
-			if err := m.PendingChildren[len(m.PendingChildren)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingDecision", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PendingDecision == nil {
-				m.PendingDecision = &v1.PendingDecisionInfo{}
-			}
-			if err := m.PendingDecision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14403,7 +16033,7 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
+func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14426,51 +16056,15 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Request == nil {
-				m.Request = &v1.QueryWorkflowRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14498,7 +16092,7 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			m.RunId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14522,7 +16116,7 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
+func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14545,15 +16139,15 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14580,18 +16174,18 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QueryResult == nil {
-				m.QueryResult = &v1.Payload{}
+			if m.Request == nil {
+				m.Request = &v1.TerminateWorkflowExecutionRequest{}
 			}
-			if err := m.QueryResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryRejected", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14601,82 +16195,27 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QueryRejected == nil {
-				m.QueryRejected = &v1.QueryRejected{}
-			}
-			if err := m.QueryRejected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResetStickyTaskListRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResetStickyTaskListRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetStickyTaskListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14703,18 +16242,18 @@ func (m *ResetStickyTaskListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.ResetStickyTaskListRequest{}
+			if m.ExternalWorkflowExecution == nil {
+				m.ExternalWorkflowExecution = &v1.WorkflowExecution{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExternalWorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14724,24 +16263,12 @@ func (m *ResetStickyTaskListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.ChildWorkflowOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -14764,7 +16291,7 @@ func (m *ResetStickyTaskListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetStickyTaskListResponse) Unmarshal(dAtA []byte) error {
+func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14787,10 +16314,10 @@ func (m *ResetStickyTaskListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetStickyTaskListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetStickyTaskListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -14815,7 +16342,7 @@ func (m *ResetStickyTaskListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateWorkflowMemoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14838,10 +16365,10 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMutableStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateWorkflowMemoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateWorkflowMemoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14878,9 +16405,9 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14890,15 +16417,47 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
@@ -14912,11 +16471,11 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedNextEventId", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
 			}
-			m.ExpectedNextEventId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14926,16 +16485,33 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpectedNextEventId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Memo == nil {
+				m.Memo = &v1.Memo{}
+			}
+			if err := m.Memo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14945,31 +16521,29 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.CurrentBranchToken == nil {
-				m.CurrentBranchToken = []byte{}
-			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistoryItem", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14979,28 +16553,75 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionHistoryItem == nil {
-				m.VersionHistoryItem = &v11.VersionHistoryItem{}
-			}
-			if err := m.VersionHistoryItem.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateWorkflowMemoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateWorkflowMemoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateWorkflowMemoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -15023,7 +16644,7 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
+func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15046,15 +16667,15 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMutableStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15081,18 +16702,18 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			if m.Request == nil {
+				m.Request = &v1.DescribeWorkflowExecutionRequest{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15102,50 +16723,78 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v1.WorkflowType{}
-			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
 			}
-			m.NextEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionConfiguration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15172,18 +16821,18 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PreviousStartedEventId == nil {
-				m.PreviousStartedEventId = &types.Int64Value{}
+			if m.ExecutionConfiguration == nil {
+				m.ExecutionConfiguration = &v1.WorkflowExecutionConfiguration{}
 			}
-			if err := m.PreviousStartedEventId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExecutionConfiguration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastFirstEventId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionInfo", wireType)
 			}
-			m.LastFirstEventId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15193,26 +16842,7 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastFirstEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15227,16 +16857,16 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskList == nil {
-				m.TaskList = &v1.TaskList{}
+			if m.WorkflowExecutionInfo == nil {
+				m.WorkflowExecutionInfo = &v1.WorkflowExecutionInfo{}
 			}
-			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingActivities", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15263,18 +16893,16 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StickyTaskList == nil {
-				m.StickyTaskList = &v1.TaskList{}
-			}
-			if err := m.StickyTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingActivities = append(m.PendingActivities, &v1.PendingActivityInfo{})
+			if err := m.PendingActivities[len(m.PendingActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientLibraryVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChildren", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15284,29 +16912,31 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientLibraryVersion = string(dAtA[iNdEx:postIndex])
+			m.PendingChildren = append(m.PendingChildren, &v1.PendingChildExecutionInfo{})
+			if err := m.PendingChildren[len(m.PendingChildren)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientFeatureVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDecision", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15316,29 +16946,33 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientFeatureVersion = string(dAtA[iNdEx:postIndex])
+			if m.PendingDecision == nil {
+				m.PendingDecision = &v1.PendingDecisionInfo{}
+			}
+			if err := m.PendingDecision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientImpl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyExecutionInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15348,27 +16982,31 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientImpl = string(dAtA[iNdEx:postIndex])
+			if m.StickyExecutionInfo == nil {
+				m.StickyExecutionInfo = &StickyExecutionInfo{}
+			}
+			if err := m.StickyExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskListScheduleToStartTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionCallbacks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15395,37 +17033,67 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StickyTaskListScheduleToStartTimeout == nil {
-				m.StickyTaskListScheduleToStartTimeout = &types.Duration{}
-			}
-			if err := m.StickyTaskListScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CompletionCallbacks = append(m.CompletionCallbacks, &CompletionCallbackInfo{})
+			if err := m.CompletionCallbacks[len(m.CompletionCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.EventStoreVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EventStoreVersion |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
 			}
-		case 13:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StickyExecutionInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StickyExecutionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StickyExecutionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskList", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15435,31 +17103,33 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.CurrentBranchToken == nil {
-				m.CurrentBranchToken = []byte{}
+			if m.StickyTaskList == nil {
+				m.StickyTaskList = &v1.TaskList{}
+			}
+			if err := m.StickyTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowState", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyHost", wireType)
 			}
-			m.WorkflowState = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15469,33 +17139,27 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkflowState |= v12.WorkflowState(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowCloseState", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
 			}
-			m.WorkflowCloseState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkflowCloseState |= v1.WorkflowExecutionCloseStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
 			}
-		case 16:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StickyHost = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15522,18 +17186,18 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionHistories == nil {
-				m.VersionHistories = &v12.VersionHistories{}
+			if m.ScheduleToStartTimeout == nil {
+				m.ScheduleToStartTimeout = &types.Duration{}
 			}
-			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsStickyTaskListEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HitCount", wireType)
 			}
-			var v int
+			m.HitCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15543,17 +17207,16 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.HitCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsStickyTaskListEnabled = bool(v != 0)
-		case 18:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistorySize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackCount", wireType)
 			}
-			m.HistorySize = 0
+			m.FallbackCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15563,11 +17226,22 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HistorySize |= int64(b&0x7F) << shift
+				m.FallbackCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HitRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.HitRate = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -15590,7 +17264,7 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15613,17 +17287,17 @@ func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollMutableStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15633,84 +17307,33 @@ func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			if m.Request == nil {
+				m.Request = &v1.QueryWorkflowRequest{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedNextEventId", wireType)
-			}
-			m.ExpectedNextEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpectedNextEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15720,25 +17343,23 @@ func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.CurrentBranchToken == nil {
-				m.CurrentBranchToken = []byte{}
-			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15762,7 +17383,7 @@ func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15785,15 +17406,15 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollMutableStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryResult", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15820,16 +17441,16 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			if m.QueryResult == nil {
+				m.QueryResult = &v1.Payload{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QueryResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryRejected", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15856,126 +17477,67 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v1.WorkflowType{}
+			if m.QueryRejected == nil {
+				m.QueryRejected = &v1.QueryRejected{}
 			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QueryRejected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
-			}
-			m.NextEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthService
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PreviousStartedEventId == nil {
-				m.PreviousStartedEventId = &types.Int64Value{}
-			}
-			if err := m.PreviousStartedEventId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastFirstEventId", wireType)
-			}
-			m.LastFirstEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastFirstEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetStickyTaskListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskList == nil {
-				m.TaskList = &v1.TaskList{}
-			}
-			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetStickyTaskListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetStickyTaskListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16002,16 +17564,16 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StickyTaskList == nil {
-				m.StickyTaskList = &v1.TaskList{}
+			if m.Request == nil {
+				m.Request = &v1.ResetStickyTaskListRequest{}
 			}
-			if err := m.StickyTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientLibraryVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16039,43 +17601,113 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientLibraryVersion = string(dAtA[iNdEx:postIndex])
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientFeatureVersion", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetStickyTaskListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetStickyTaskListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetStickyTaskListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthService
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientFeatureVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMutableStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientImpl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16103,11 +17735,11 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientImpl = string(dAtA[iNdEx:postIndex])
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskListScheduleToStartTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16134,14 +17766,33 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StickyTaskListScheduleToStartTimeout == nil {
-				m.StickyTaskListScheduleToStartTimeout = &types.Duration{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
 			}
-			if err := m.StickyTaskListScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedNextEventId", wireType)
+			}
+			m.ExpectedNextEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedNextEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
 			}
@@ -16175,9 +17826,9 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				m.CurrentBranchToken = []byte{}
 			}
 			iNdEx = postIndex
-		case 13:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistoryItem", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16204,51 +17855,13 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionHistories == nil {
-				m.VersionHistories = &v12.VersionHistories{}
+			if m.VersionHistoryItem == nil {
+				m.VersionHistoryItem = &v11.VersionHistoryItem{}
 			}
-			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VersionHistoryItem.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowState", wireType)
-			}
-			m.WorkflowState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkflowState |= v12.WorkflowState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowCloseState", wireType)
-			}
-			m.WorkflowCloseState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkflowCloseState |= v1.WorkflowExecutionCloseStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -16271,7 +17884,7 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
+func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16294,17 +17907,17 @@ func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordDecisionTaskStartedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetMutableStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordDecisionTaskStartedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16314,27 +17927,31 @@ func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16361,18 +17978,18 @@ func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v1.WorkflowType{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
 			}
-			m.ScheduleId = 0
+			m.NextEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16382,16 +17999,16 @@ func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScheduleId |= int64(b&0x7F) << shift
+				m.NextEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
 			}
-			m.TaskId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16401,48 +18018,33 @@ func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthService
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
+			if m.PreviousStartedEventId == nil {
+				m.PreviousStartedEventId = &types.Int64Value{}
+			}
+			if err := m.PreviousStartedEventId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFirstEventId", wireType)
 			}
-			var msglen int
+			m.LastFirstEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16452,82 +18054,14 @@ func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastFirstEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PollRequest == nil {
-				m.PollRequest = &v1.PollForDecisionTaskRequest{}
-			}
-			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RecordDecisionTaskStartedResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordDecisionTaskStartedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16554,16 +18088,16 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v1.WorkflowType{}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
 			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16590,18 +18124,18 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PreviousStartedEventId == nil {
-				m.PreviousStartedEventId = &types.Int64Value{}
+			if m.StickyTaskList == nil {
+				m.StickyTaskList = &v1.TaskList{}
 			}
-			if err := m.PreviousStartedEventId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StickyTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLibraryVersion", wireType)
 			}
-			m.ScheduledEventId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16611,54 +18145,29 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScheduledEventId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
-			}
-			m.StartedEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartedEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
 			}
-			m.NextEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			m.ClientLibraryVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientFeatureVersion", wireType)
 			}
-			m.Attempt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16668,36 +18177,29 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyExecutionEnabled", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
 			}
-			m.StickyExecutionEnabled = bool(v != 0)
-		case 8:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientFeatureVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecisionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientImpl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16707,31 +18209,27 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DecisionInfo == nil {
-				m.DecisionInfo = &v12.TransientDecisionInfo{}
-			}
-			if err := m.DecisionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientImpl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskListScheduleToStartTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16758,14 +18256,14 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecutionTaskList == nil {
-				m.WorkflowExecutionTaskList = &v1.TaskList{}
+			if m.StickyTaskListScheduleToStartTimeout == nil {
+				m.StickyTaskListScheduleToStartTimeout = &types.Duration{}
 			}
-			if err := m.WorkflowExecutionTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StickyTaskListScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 12:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
 			}
@@ -16784,9 +18282,9 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 11:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -16813,16 +18311,16 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.BranchToken == nil {
-				m.BranchToken = []byte{}
+			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentBranchToken == nil {
+				m.CurrentBranchToken = []byte{}
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowState", wireType)
 			}
-			var msglen int
+			m.WorkflowState = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16832,31 +18330,33 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.WorkflowState |= v12.WorkflowState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ScheduledTime == nil {
-				m.ScheduledTime = &types.Timestamp{}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowCloseState", wireType)
 			}
-			if err := m.ScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.WorkflowCloseState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowCloseState |= v1.WorkflowExecutionCloseStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 13:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16883,18 +18383,18 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = &types.Timestamp{}
+			if m.VersionHistories == nil {
+				m.VersionHistories = &v12.VersionHistories{}
 			}
-			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsStickyTaskListEnabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16904,122 +18404,13 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Queries == nil {
-				m.Queries = make(map[string]*v1.WorkflowQuery)
-			}
-			var mapkey string
-			var mapvalue *v1.WorkflowQuery
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthService
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthService
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v1.WorkflowQuery{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Queries[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 15:
+			m.IsStickyTaskListEnabled = bool(v != 0)
+		case 18:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field HistorySize", wireType)
 			}
@@ -17060,7 +18451,7 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
+func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17083,10 +18474,10 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordActivityTaskStartedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PollMutableStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordActivityTaskStartedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PollMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17159,9 +18550,9 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedNextEventId", wireType)
 			}
-			m.ScheduleId = 0
+			m.ExpectedNextEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -17171,67 +18562,16 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScheduleId |= int64(b&0x7F) << shift
+				m.ExpectedNextEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
-			}
-			m.TaskId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -17241,26 +18581,24 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PollRequest == nil {
-				m.PollRequest = &v1.PollForActivityTaskRequest{}
-			}
-			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentBranchToken == nil {
+				m.CurrentBranchToken = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -17285,7 +18623,7 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
+func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17308,15 +18646,15 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordActivityTaskStartedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PollMutableStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordActivityTaskStartedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PollMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEvent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17343,16 +18681,16 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledEvent == nil {
-				m.ScheduledEvent = &v1.HistoryEvent{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
 			}
-			if err := m.ScheduledEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17379,18 +18717,18 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = &types.Timestamp{}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v1.WorkflowType{}
 			}
-			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
 			}
-			m.Attempt = 0
+			m.NextEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -17400,14 +18738,14 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
+				m.NextEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTimeOfThisAttempt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17434,16 +18772,35 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTimeOfThisAttempt == nil {
-				m.ScheduledTimeOfThisAttempt = &types.Timestamp{}
+			if m.PreviousStartedEventId == nil {
+				m.PreviousStartedEventId = &types.Int64Value{}
 			}
-			if err := m.ScheduledTimeOfThisAttempt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PreviousStartedEventId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFirstEventId", wireType)
+			}
+			m.LastFirstEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFirstEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17470,16 +18827,16 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HeartbeatDetails == nil {
-				m.HeartbeatDetails = &v1.Payload{}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
 			}
-			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17506,16 +18863,80 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v1.WorkflowType{}
+			if m.StickyTaskList == nil {
+				m.StickyTaskList = &v1.TaskList{}
 			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StickyTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowDomain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLibraryVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientLibraryVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientFeatureVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientFeatureVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientImpl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17540,11 +18961,2176 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientImpl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskListScheduleToStartTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StickyTaskListScheduleToStartTimeout == nil {
+				m.StickyTaskListScheduleToStartTimeout = &types.Duration{}
+			}
+			if err := m.StickyTaskListScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentBranchToken == nil {
+				m.CurrentBranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionHistories == nil {
+				m.VersionHistories = &v12.VersionHistories{}
+			}
+			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowState", wireType)
+			}
+			m.WorkflowState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowState |= v12.WorkflowState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowCloseState", wireType)
+			}
+			m.WorkflowCloseState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowCloseState |= v1.WorkflowExecutionCloseStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordDecisionTaskStartedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordDecisionTaskStartedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PollRequest == nil {
+				m.PollRequest = &v1.PollForDecisionTaskRequest{}
+			}
+			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordDecisionTaskStartedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordDecisionTaskStartedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v1.WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousStartedEventId == nil {
+				m.PreviousStartedEventId = &types.Int64Value{}
+			}
+			if err := m.PreviousStartedEventId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+			}
+			m.ScheduledEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			}
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			}
+			m.NextEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyExecutionEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StickyExecutionEnabled = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionInfo == nil {
+				m.DecisionInfo = &v12.TransientDecisionInfo{}
+			}
+			if err := m.DecisionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecutionTaskList == nil {
+				m.WorkflowExecutionTaskList = &v1.TaskList{}
+			}
+			if err := m.WorkflowExecutionTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
+			}
+			m.EventStoreVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventStoreVersion |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.BranchToken == nil {
+				m.BranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = &types.Timestamp{}
+			}
+			if err := m.ScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedTime == nil {
+				m.StartedTime = &types.Timestamp{}
+			}
+			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Queries == nil {
+				m.Queries = make(map[string]*v1.WorkflowQuery)
+			}
+			var mapkey string
+			var mapvalue *v1.WorkflowQuery
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthService
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthService
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v1.WorkflowQuery{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Queries[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistorySize", wireType)
+			}
+			m.HistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistorySize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordActivityTaskStartedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordActivityTaskStartedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PollRequest == nil {
+				m.PollRequest = &v1.PollForActivityTaskRequest{}
+			}
+			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartBreakdown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleToStartBreakdown == nil {
+				m.ScheduleToStartBreakdown = &ScheduleToStartBreakdown{}
+			}
+			if err := m.ScheduleToStartBreakdown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleToStartBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleToStartBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleToStartBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncMatched", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SyncMatched = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forwarded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Forwarded = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogWaitTimeInMs", wireType)
+			}
+			m.BacklogWaitTimeInMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BacklogWaitTimeInMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatelimitWaitTimeInMs", wireType)
+			}
+			m.RatelimitWaitTimeInMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RatelimitWaitTimeInMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolationWaitTimeInMs", wireType)
+			}
+			m.IsolationWaitTimeInMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IsolationWaitTimeInMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordActivityTaskStartedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordActivityTaskStartedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEvent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledEvent == nil {
+				m.ScheduledEvent = &v1.HistoryEvent{}
+			}
+			if err := m.ScheduledEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedTime == nil {
+				m.StartedTime = &types.Timestamp{}
+			}
+			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTimeOfThisAttempt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTimeOfThisAttempt == nil {
+				m.ScheduledTimeOfThisAttempt = &types.Timestamp{}
+			}
+			if err := m.ScheduledTimeOfThisAttempt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeartbeatDetails == nil {
+				m.HeartbeatDetails = &v1.Payload{}
+			}
+			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v1.WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowDomain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowDomain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondDecisionTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondDecisionTaskCompletedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondDecisionTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v1.RespondDecisionTaskCompletedRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EagerActivitySlots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EagerActivitySlots == nil {
+				m.EagerActivitySlots = &types.Int32Value{}
+			}
+			if err := m.EagerActivitySlots.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondDecisionTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondDecisionTaskCompletedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondDecisionTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedResponse == nil {
+				m.StartedResponse = &RecordDecisionTaskStartedResponse{}
+			}
+			if err := m.StartedResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivitiesToDispatchLocally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivitiesToDispatchLocally == nil {
+				m.ActivitiesToDispatchLocally = make(map[string]*v1.ActivityLocalDispatchInfo)
+			}
+			var mapkey string
+			var mapvalue *v1.ActivityLocalDispatchInfo
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthService
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthService
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v1.ActivityLocalDispatchInfo{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ActivitiesToDispatchLocally[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondDecisionTaskFailedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondDecisionTaskFailedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondDecisionTaskFailedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v1.RespondDecisionTaskFailedRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondDecisionTaskFailedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowDomain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondDecisionTaskFailedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondDecisionTaskFailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -17567,7 +21153,7 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondDecisionTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17590,10 +21176,10 @@ func (m *RespondDecisionTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondDecisionTaskCompletedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordActivityTaskHeartbeatRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondDecisionTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordActivityTaskHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17626,7 +21212,7 @@ func (m *RespondDecisionTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.RespondDecisionTaskCompletedRequest{}
+				m.Request = &v1.RecordActivityTaskHeartbeatRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -17686,7 +21272,7 @@ func (m *RespondDecisionTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondDecisionTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordActivityTaskHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17709,53 +21295,17 @@ func (m *RespondDecisionTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondDecisionTaskCompletedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordActivityTaskHeartbeatResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondDecisionTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordActivityTaskHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedResponse", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StartedResponse == nil {
-				m.StartedResponse = &RecordDecisionTaskStartedResponse{}
-			}
-			if err := m.StartedResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivitiesToDispatchLocally", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequested", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -17765,121 +21315,12 @@ func (m *RespondDecisionTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ActivitiesToDispatchLocally == nil {
-				m.ActivitiesToDispatchLocally = make(map[string]*v1.ActivityLocalDispatchInfo)
-			}
-			var mapkey string
-			var mapvalue *v1.ActivityLocalDispatchInfo
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthService
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthService
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v1.ActivityLocalDispatchInfo{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.ActivitiesToDispatchLocally[mapkey] = mapvalue
-			iNdEx = postIndex
+			m.CancelRequested = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -17902,7 +21343,7 @@ func (m *RespondDecisionTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondDecisionTaskFailedRequest) Unmarshal(dAtA []byte) error {
+func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17925,10 +21366,10 @@ func (m *RespondDecisionTaskFailedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondDecisionTaskFailedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondActivityTaskCompletedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondDecisionTaskFailedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondActivityTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17961,7 +21402,7 @@ func (m *RespondDecisionTaskFailedRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.RespondDecisionTaskFailedRequest{}
+				m.Request = &v1.RespondActivityTaskCompletedRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -18021,7 +21462,7 @@ func (m *RespondDecisionTaskFailedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondDecisionTaskFailedResponse) Unmarshal(dAtA []byte) error {
+func (m *RespondActivityTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18044,10 +21485,10 @@ func (m *RespondDecisionTaskFailedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondDecisionTaskFailedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondActivityTaskCompletedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondDecisionTaskFailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondActivityTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18072,7 +21513,7 @@ func (m *RespondDecisionTaskFailedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
+func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18095,10 +21536,10 @@ func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordActivityTaskHeartbeatRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondActivityTaskFailedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordActivityTaskHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondActivityTaskFailedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18131,7 +21572,7 @@ func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.RecordActivityTaskHeartbeatRequest{}
+				m.Request = &v1.RespondActivityTaskFailedRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -18191,7 +21632,7 @@ func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordActivityTaskHeartbeatResponse) Unmarshal(dAtA []byte) error {
+func (m *RespondActivityTaskFailedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18214,32 +21655,12 @@ func (m *RecordActivityTaskHeartbeatResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordActivityTaskHeartbeatResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondActivityTaskFailedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordActivityTaskHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondActivityTaskFailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequested", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.CancelRequested = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -18262,7 +21683,7 @@ func (m *RecordActivityTaskHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18285,10 +21706,10 @@ func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskCompletedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondActivityTaskCanceledRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondActivityTaskCanceledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18321,7 +21742,7 @@ func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.RespondActivityTaskCompletedRequest{}
+				m.Request = &v1.RespondActivityTaskCanceledRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -18381,7 +21802,7 @@ func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondActivityTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+func (m *RespondActivityTaskCanceledResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18404,10 +21825,10 @@ func (m *RespondActivityTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskCompletedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondActivityTaskCanceledResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondActivityTaskCanceledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18432,7 +21853,7 @@ func (m *RespondActivityTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18455,15 +21876,47 @@ func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskFailedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveSignalMutableStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskFailedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveSignalMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18490,16 +21943,16 @@ func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.RespondActivityTaskFailedRequest{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18527,7 +21980,7 @@ func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			m.RequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18551,7 +22004,7 @@ func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondActivityTaskFailedResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveSignalMutableStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18574,10 +22027,10 @@ func (m *RespondActivityTaskFailedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskFailedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveSignalMutableStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskFailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveSignalMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18602,7 +22055,7 @@ func (m *RespondActivityTaskFailedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
+func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18625,15 +22078,47 @@ func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskCanceledRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskCanceledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18660,18 +22145,18 @@ func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.RespondActivityTaskCanceledRequest{}
+			if m.CancelRequest == nil {
+				m.CancelRequest = &v1.RequestCancelWorkflowExecutionRequest{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CancelRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalExecutionInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -18681,24 +22166,48 @@ func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			if m.ExternalExecutionInfo == nil {
+				m.ExternalExecutionInfo = &v1.ExternalExecutionInfo{}
+			}
+			if err := m.ExternalExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChildWorkflowOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -18721,7 +22230,7 @@ func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondActivityTaskCanceledResponse) Unmarshal(dAtA []byte) error {
+func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18744,10 +22253,10 @@ func (m *RespondActivityTaskCanceledResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskCanceledResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskCanceledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18772,7 +22281,7 @@ func (m *RespondActivityTaskCanceledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
+func (m *ScheduleDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18795,10 +22304,10 @@ func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveSignalMutableStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduleDecisionTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveSignalMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduleDecisionTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18870,10 +22379,10 @@ func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFirstDecision", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -18883,24 +22392,12 @@ func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.IsFirstDecision = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -18923,7 +22420,7 @@ func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveSignalMutableStateResponse) Unmarshal(dAtA []byte) error {
+func (m *ScheduleDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18946,10 +22443,10 @@ func (m *RemoveSignalMutableStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveSignalMutableStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduleDecisionTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveSignalMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduleDecisionTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18974,7 +22471,7 @@ func (m *RemoveSignalMutableStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18997,10 +22494,10 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordChildExecutionCompletedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordChildExecutionCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19037,7 +22534,62 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedId", wireType)
+			}
+			m.InitiatedId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitiatedId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19064,16 +22616,16 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CancelRequest == nil {
-				m.CancelRequest = &v1.RequestCancelWorkflowExecutionRequest{}
+			if m.CompletedExecution == nil {
+				m.CompletedExecution = &v1.WorkflowExecution{}
 			}
-			if err := m.CancelRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CompletedExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalExecutionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEvent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19100,18 +22652,18 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExternalExecutionInfo == nil {
-				m.ExternalExecutionInfo = &v1.ExternalExecutionInfo{}
+			if m.CompletionEvent == nil {
+				m.CompletionEvent = &v1.HistoryEvent{}
 			}
-			if err := m.ExternalExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CompletionEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedId", wireType)
 			}
-			var v int
+			m.StartedId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -19121,12 +22673,11 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.StartedId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ChildWorkflowOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -19149,7 +22700,7 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordChildExecutionCompletedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19172,10 +22723,10 @@ func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordChildExecutionCompletedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordChildExecutionCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -19200,7 +22751,7 @@ func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScheduleDecisionTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *GetCompletionCallbacksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19223,10 +22774,10 @@ func (m *ScheduleDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleDecisionTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetCompletionCallbacksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleDecisionTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetCompletionCallbacksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19297,26 +22848,6 @@ func (m *ScheduleDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFirstDecision", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsFirstDecision = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -19339,7 +22870,7 @@ func (m *ScheduleDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScheduleDecisionTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *GetCompletionCallbacksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19362,12 +22893,156 @@ func (m *ScheduleDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleDecisionTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetCompletionCallbacksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleDecisionTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetCompletionCallbacksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callbacks = append(m.Callbacks, &CompletionCallback{})
+			if err := m.Callbacks[len(m.Callbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v1.WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseStatus", wireType)
+			}
+			m.CloseStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CloseStatus |= v1.WorkflowExecutionCloseStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CloseTime == nil {
+				m.CloseTime = &types.Timestamp{}
+			}
+			if err := m.CloseTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryLength", wireType)
+			}
+			m.HistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -19390,7 +23065,7 @@ func (m *ScheduleDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordCompletionCallbackStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19413,10 +23088,10 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordChildExecutionCompletedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordCompletionCallbackStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordChildExecutionCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordCompletionCallbackStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19489,9 +23164,9 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.InitiatedId = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -19501,50 +23176,14 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InitiatedId |= int64(b&0x7F) << shift
+				m.Index |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletedExecution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CompletedExecution == nil {
-				m.CompletedExecution = &v1.WorkflowExecution{}
-			}
-			if err := m.CompletedExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEvent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19571,32 +23210,13 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompletionEvent == nil {
-				m.CompletionEvent = &v1.HistoryEvent{}
+			if m.State == nil {
+				m.State = &CompletionCallbackInfo{}
 			}
-			if err := m.CompletionEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedId", wireType)
-			}
-			m.StartedId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartedId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -19619,7 +23239,7 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordChildExecutionCompletedResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordCompletionCallbackStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19642,10 +23262,10 @@ func (m *RecordChildExecutionCompletedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordChildExecutionCompletedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordCompletionCallbackStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordChildExecutionCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordCompletionCallbackStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -27,6 +27,7 @@ type HistoryAPIYARPCClient interface {
 	SignalWithStartWorkflowExecution(context.Context, *SignalWithStartWorkflowExecutionRequest, ...yarpc.CallOption) (*SignalWithStartWorkflowExecutionResponse, error)
 	ResetWorkflowExecution(context.Context, *ResetWorkflowExecutionRequest, ...yarpc.CallOption) (*ResetWorkflowExecutionResponse, error)
 	TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest, ...yarpc.CallOption) (*TerminateWorkflowExecutionResponse, error)
+	UpdateWorkflowMemo(context.Context, *UpdateWorkflowMemoRequest, ...yarpc.CallOption) (*UpdateWorkflowMemoResponse, error)
 	DescribeWorkflowExecution(context.Context, *DescribeWorkflowExecutionRequest, ...yarpc.CallOption) (*DescribeWorkflowExecutionResponse, error)
 	QueryWorkflow(context.Context, *QueryWorkflowRequest, ...yarpc.CallOption) (*QueryWorkflowResponse, error)
 	ResetStickyTaskList(context.Context, *ResetStickyTaskListRequest, ...yarpc.CallOption) (*ResetStickyTaskListResponse, error)
@@ -44,6 +45,8 @@ type HistoryAPIYARPCClient interface {
 	RemoveSignalMutableState(context.Context, *RemoveSignalMutableStateRequest, ...yarpc.CallOption) (*RemoveSignalMutableStateResponse, error)
 	ScheduleDecisionTask(context.Context, *ScheduleDecisionTaskRequest, ...yarpc.CallOption) (*ScheduleDecisionTaskResponse, error)
 	RecordChildExecutionCompleted(context.Context, *RecordChildExecutionCompletedRequest, ...yarpc.CallOption) (*RecordChildExecutionCompletedResponse, error)
+	GetCompletionCallbacks(context.Context, *GetCompletionCallbacksRequest, ...yarpc.CallOption) (*GetCompletionCallbacksResponse, error)
+	RecordCompletionCallbackState(context.Context, *RecordCompletionCallbackStateRequest, ...yarpc.CallOption) (*RecordCompletionCallbackStateResponse, error)
 	ReplicateEventsV2(context.Context, *ReplicateEventsV2Request, ...yarpc.CallOption) (*ReplicateEventsV2Response, error)
 	SyncShardStatus(context.Context, *SyncShardStatusRequest, ...yarpc.CallOption) (*SyncShardStatusResponse, error)
 	SyncActivity(context.Context, *SyncActivityRequest, ...yarpc.CallOption) (*SyncActivityResponse, error)
@@ -91,6 +94,7 @@ type HistoryAPIYARPCServer interface {
 	SignalWithStartWorkflowExecution(context.Context, *SignalWithStartWorkflowExecutionRequest) (*SignalWithStartWorkflowExecutionResponse, error)
 	ResetWorkflowExecution(context.Context, *ResetWorkflowExecutionRequest) (*ResetWorkflowExecutionResponse, error)
 	TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest) (*TerminateWorkflowExecutionResponse, error)
+	UpdateWorkflowMemo(context.Context, *UpdateWorkflowMemoRequest) (*UpdateWorkflowMemoResponse, error)
 	DescribeWorkflowExecution(context.Context, *DescribeWorkflowExecutionRequest) (*DescribeWorkflowExecutionResponse, error)
 	QueryWorkflow(context.Context, *QueryWorkflowRequest) (*QueryWorkflowResponse, error)
 	ResetStickyTaskList(context.Context, *ResetStickyTaskListRequest) (*ResetStickyTaskListResponse, error)
@@ -108,6 +112,8 @@ type HistoryAPIYARPCServer interface {
 	RemoveSignalMutableState(context.Context, *RemoveSignalMutableStateRequest) (*RemoveSignalMutableStateResponse, error)
 	ScheduleDecisionTask(context.Context, *ScheduleDecisionTaskRequest) (*ScheduleDecisionTaskResponse, error)
 	RecordChildExecutionCompleted(context.Context, *RecordChildExecutionCompletedRequest) (*RecordChildExecutionCompletedResponse, error)
+	GetCompletionCallbacks(context.Context, *GetCompletionCallbacksRequest) (*GetCompletionCallbacksResponse, error)
+	RecordCompletionCallbackState(context.Context, *RecordCompletionCallbackStateRequest) (*RecordCompletionCallbackStateResponse, error)
 	ReplicateEventsV2(context.Context, *ReplicateEventsV2Request) (*ReplicateEventsV2Response, error)
 	SyncShardStatus(context.Context, *SyncShardStatusRequest) (*SyncShardStatusResponse, error)
 	SyncActivity(context.Context, *SyncActivityRequest) (*SyncActivityResponse, error)
@@ -193,6 +199,16 @@ func buildHistoryAPIYARPCProcedures(params buildHistoryAPIYARPCProceduresParams)
 						},
 					),
 				},
+				{
+					MethodName: "UpdateWorkflowMemo",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateWorkflowMemo,
+							NewRequest:  newHistoryAPIServiceUpdateWorkflowMemoYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 				{
 					MethodName: "DescribeWorkflowExecution",
 					Handler: protobuf.NewUnaryHandler(
@@ -363,6 +379,26 @@ func buildHistoryAPIYARPCProcedures(params buildHistoryAPIYARPCProceduresParams)
 						},
 					),
 				},
+				{
+					MethodName: "GetCompletionCallbacks",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetCompletionCallbacks,
+							NewRequest:  newHistoryAPIServiceGetCompletionCallbacksYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RecordCompletionCallbackState",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RecordCompletionCallbackState,
+							NewRequest:  newHistoryAPIServiceRecordCompletionCallbackStateYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 				{
 					MethodName: "ReplicateEventsV2",
 					Handler: protobuf.NewUnaryHandler(
@@ -761,6 +797,18 @@ func (c *_HistoryAPIYARPCCaller) TerminateWorkflowExecution(ctx context.Context,
 	return response, err
 }
 
+func (c *_HistoryAPIYARPCCaller) UpdateWorkflowMemo(ctx context.Context, request *UpdateWorkflowMemoRequest, options ...yarpc.CallOption) (*UpdateWorkflowMemoResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateWorkflowMemo", request, newHistoryAPIServiceUpdateWorkflowMemoYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateWorkflowMemoResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceUpdateWorkflowMemoYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 func (c *_HistoryAPIYARPCCaller) DescribeWorkflowExecution(ctx context.Context, request *DescribeWorkflowExecutionRequest, options ...yarpc.CallOption) (*DescribeWorkflowExecutionResponse, error) {
 	responseMessage, err := c.streamClient.Call(ctx, "DescribeWorkflowExecution", request, newHistoryAPIServiceDescribeWorkflowExecutionYARPCResponse, options...)
 	if responseMessage == nil {
@@ -965,6 +1013,30 @@ func (c *_HistoryAPIYARPCCaller) RecordChildExecutionCompleted(ctx context.Conte
 	return response, err
 }
 
+func (c *_HistoryAPIYARPCCaller) GetCompletionCallbacks(ctx context.Context, request *GetCompletionCallbacksRequest, options ...yarpc.CallOption) (*GetCompletionCallbacksResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetCompletionCallbacks", request, newHistoryAPIServiceGetCompletionCallbacksYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetCompletionCallbacksResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceGetCompletionCallbacksYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_HistoryAPIYARPCCaller) RecordCompletionCallbackState(ctx context.Context, request *RecordCompletionCallbackStateRequest, options ...yarpc.CallOption) (*RecordCompletionCallbackStateResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RecordCompletionCallbackState", request, newHistoryAPIServiceRecordCompletionCallbackStateYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RecordCompletionCallbackStateResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceRecordCompletionCallbackStateYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 func (c *_HistoryAPIYARPCCaller) ReplicateEventsV2(ctx context.Context, request *ReplicateEventsV2Request, options ...yarpc.CallOption) (*ReplicateEventsV2Response, error) {
 	responseMessage, err := c.streamClient.Call(ctx, "ReplicateEventsV2", request, newHistoryAPIServiceReplicateEventsV2YARPCResponse, options...)
 	if responseMessage == nil {
@@ -1313,6 +1385,22 @@ func (h *_HistoryAPIYARPCHandler) TerminateWorkflowExecution(ctx context.Context
 	return response, err
 }
 
+func (h *_HistoryAPIYARPCHandler) UpdateWorkflowMemo(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateWorkflowMemoRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateWorkflowMemoRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceUpdateWorkflowMemoYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateWorkflowMemo(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func (h *_HistoryAPIYARPCHandler) DescribeWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
 	var request *DescribeWorkflowExecutionRequest
 	var ok bool
@@ -1585,6 +1673,38 @@ func (h *_HistoryAPIYARPCHandler) RecordChildExecutionCompleted(ctx context.Cont
 	return response, err
 }
 
+func (h *_HistoryAPIYARPCHandler) GetCompletionCallbacks(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetCompletionCallbacksRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetCompletionCallbacksRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceGetCompletionCallbacksYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetCompletionCallbacks(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_HistoryAPIYARPCHandler) RecordCompletionCallbackState(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RecordCompletionCallbackStateRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RecordCompletionCallbackStateRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceRecordCompletionCallbackStateYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RecordCompletionCallbackState(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func (h *_HistoryAPIYARPCHandler) ReplicateEventsV2(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
 	var request *ReplicateEventsV2Request
 	var ok bool
@@ -1977,6 +2097,14 @@ func newHistoryAPIServiceTerminateWorkflowExecutionYARPCResponse() proto.Message
 	return &TerminateWorkflowExecutionResponse{}
 }
 
+func newHistoryAPIServiceUpdateWorkflowMemoYARPCRequest() proto.Message {
+	return &UpdateWorkflowMemoRequest{}
+}
+
+func newHistoryAPIServiceUpdateWorkflowMemoYARPCResponse() proto.Message {
+	return &UpdateWorkflowMemoResponse{}
+}
+
 func newHistoryAPIServiceDescribeWorkflowExecutionYARPCRequest() proto.Message {
 	return &DescribeWorkflowExecutionRequest{}
 }
@@ -2113,6 +2241,22 @@ func newHistoryAPIServiceRecordChildExecutionCompletedYARPCResponse() proto.Mess
 	return &RecordChildExecutionCompletedResponse{}
 }
 
+func newHistoryAPIServiceGetCompletionCallbacksYARPCRequest() proto.Message {
+	return &GetCompletionCallbacksRequest{}
+}
+
+func newHistoryAPIServiceGetCompletionCallbacksYARPCResponse() proto.Message {
+	return &GetCompletionCallbacksResponse{}
+}
+
+func newHistoryAPIServiceRecordCompletionCallbackStateYARPCRequest() proto.Message {
+	return &RecordCompletionCallbackStateRequest{}
+}
+
+func newHistoryAPIServiceRecordCompletionCallbackStateYARPCResponse() proto.Message {
+	return &RecordCompletionCallbackStateResponse{}
+}
+
 func newHistoryAPIServiceReplicateEventsV2YARPCRequest() proto.Message {
 	return &ReplicateEventsV2Request{}
 }
@@ -2300,6 +2444,8 @@ var (
 	emptyHistoryAPIServiceResetWorkflowExecutionYARPCResponse            = &ResetWorkflowExecutionResponse{}
 	emptyHistoryAPIServiceTerminateWorkflowExecutionYARPCRequest         = &TerminateWorkflowExecutionRequest{}
 	emptyHistoryAPIServiceTerminateWorkflowExecutionYARPCResponse        = &TerminateWorkflowExecutionResponse{}
+	emptyHistoryAPIServiceUpdateWorkflowMemoYARPCRequest                 = &UpdateWorkflowMemoRequest{}
+	emptyHistoryAPIServiceUpdateWorkflowMemoYARPCResponse                = &UpdateWorkflowMemoResponse{}
 	emptyHistoryAPIServiceDescribeWorkflowExecutionYARPCRequest          = &DescribeWorkflowExecutionRequest{}
 	emptyHistoryAPIServiceDescribeWorkflowExecutionYARPCResponse         = &DescribeWorkflowExecutionResponse{}
 	emptyHistoryAPIServiceQueryWorkflowYARPCRequest                      = &QueryWorkflowRequest{}
@@ -2334,6 +2480,10 @@ var (
 	emptyHistoryAPIServiceScheduleDecisionTaskYARPCResponse              = &ScheduleDecisionTaskResponse{}
 	emptyHistoryAPIServiceRecordChildExecutionCompletedYARPCRequest      = &RecordChildExecutionCompletedRequest{}
 	emptyHistoryAPIServiceRecordChildExecutionCompletedYARPCResponse     = &RecordChildExecutionCompletedResponse{}
+	emptyHistoryAPIServiceGetCompletionCallbacksYARPCRequest             = &GetCompletionCallbacksRequest{}
+	emptyHistoryAPIServiceGetCompletionCallbacksYARPCResponse            = &GetCompletionCallbacksResponse{}
+	emptyHistoryAPIServiceRecordCompletionCallbackStateYARPCRequest      = &RecordCompletionCallbackStateRequest{}
+	emptyHistoryAPIServiceRecordCompletionCallbackStateYARPCResponse     = &RecordCompletionCallbackStateResponse{}
 	emptyHistoryAPIServiceReplicateEventsV2YARPCRequest                  = &ReplicateEventsV2Request{}
 	emptyHistoryAPIServiceReplicateEventsV2YARPCResponse                 = &ReplicateEventsV2Response{}
 	emptyHistoryAPIServiceSyncShardStatusYARPCRequest                    = &SyncShardStatusRequest{}
@@ -2383,317 +2533,367 @@ var (
 var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 	// uber/cadence/history/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x6c, 0x1c, 0x47,
-		0x76, 0xe8, 0x19, 0xf1, 0xf7, 0x48, 0x0e, 0xc9, 0x12, 0x3f, 0xc3, 0xa1, 0x44, 0x91, 0xbd, 0x96,
-		0x44, 0xcb, 0xeb, 0xa1, 0x45, 0xd9, 0xfa, 0x59, 0x5e, 0xad, 0x44, 0x4a, 0xf2, 0x38, 0xfa, 0x36,
-		0x69, 0x39, 0x5f, 0xf7, 0x36, 0xa7, 0x6b, 0xc8, 0x8e, 0x7a, 0xba, 0x47, 0xdd, 0x3d, 0xa4, 0xe8,
-		0x43, 0xe0, 0xc4, 0x41, 0x80, 0x2c, 0x82, 0xec, 0x66, 0x91, 0x04, 0x01, 0x02, 0x04, 0x08, 0x36,
-		0xc0, 0x62, 0x8d, 0xdc, 0x12, 0x20, 0x87, 0x24, 0xa7, 0x5c, 0x72, 0xcc, 0x35, 0xf7, 0xdd, 0x43,
-		0x02, 0xe4, 0xb6, 0xe7, 0x20, 0xa8, 0x4f, 0xf7, 0xf4, 0xa7, 0xba, 0x7a, 0x86, 0x0c, 0x22, 0xaf,
-		0xe3, 0xdb, 0x74, 0x55, 0xbd, 0x57, 0xaf, 0x5e, 0xbd, 0xf7, 0xfa, 0xfd, 0x7a, 0xe0, 0x7c, 0x77,
-		0x17, 0x7b, 0xeb, 0x4d, 0xc3, 0xc4, 0x4e, 0x13, 0xaf, 0xef, 0x5b, 0x7e, 0xe0, 0x7a, 0x47, 0xeb,
-		0x07, 0x97, 0xd7, 0x7d, 0xec, 0x1d, 0x58, 0x4d, 0x5c, 0xef, 0x78, 0x6e, 0xe0, 0xa2, 0x05, 0xb2,
-		0xac, 0xce, 0x97, 0xd5, 0xf9, 0xb2, 0xfa, 0xc1, 0xe5, 0xda, 0xf2, 0x9e, 0xeb, 0xee, 0xd9, 0x78,
-		0x9d, 0x2e, 0xdb, 0xed, 0xb6, 0xd6, 0xcd, 0xae, 0x67, 0x04, 0x96, 0xeb, 0x30, 0xc0, 0xda, 0xb9,
-		0xf4, 0x7c, 0x60, 0xb5, 0xb1, 0x1f, 0x18, 0xed, 0x0e, 0x5f, 0x90, 0x41, 0x70, 0xe8, 0x19, 0x9d,
-		0x0e, 0xf6, 0x7c, 0x3e, 0xbf, 0x92, 0x20, 0xd0, 0xe8, 0x58, 0x84, 0xb8, 0xa6, 0xdb, 0x6e, 0x47,
-		0x5b, 0xac, 0x8a, 0x56, 0x84, 0x24, 0x72, 0x2a, 0x44, 0x4b, 0x5e, 0x76, 0x71, 0xb4, 0x40, 0x15,
-		0x2d, 0x08, 0x0c, 0xff, 0x85, 0x6d, 0xf9, 0x81, 0x6c, 0xcd, 0xa1, 0xeb, 0xbd, 0x68, 0xd9, 0xee,
-		0x21, 0x5f, 0x73, 0x49, 0xb4, 0x86, 0xb3, 0x52, 0x4f, 0xad, 0x5d, 0x2b, 0x5a, 0x8b, 0x3d, 0xbe,
-		0xf2, 0x5b, 0xc9, 0x95, 0x66, 0xdb, 0x72, 0x28, 0x17, 0xec, 0xae, 0x1f, 0x14, 0x2d, 0x4a, 0x32,
-		0x62, 0x55, 0xbc, 0xe8, 0x65, 0x17, 0x77, 0xf9, 0x55, 0xd7, 0x2e, 0x8a, 0x97, 0x78, 0xb8, 0x63,
-		0x5b, 0xcd, 0xf8, 0xd5, 0x26, 0x6f, 0xc6, 0xdf, 0x37, 0x3c, 0x6c, 0x92, 0x95, 0x86, 0x13, 0xee,
-		0xf6, 0x46, 0xce, 0x8a, 0x24, 0x4d, 0xe7, 0x73, 0x56, 0x25, 0xd9, 0xa5, 0xfe, 0x6c, 0x18, 0xce,
-		0x6e, 0x07, 0x86, 0x17, 0x7c, 0xc2, 0xc7, 0xef, 0xbd, 0xc2, 0xcd, 0x2e, 0xa1, 0x47, 0xc3, 0x2f,
-		0xbb, 0xd8, 0x0f, 0xd0, 0x43, 0x18, 0xf1, 0xd8, 0xcf, 0xaa, 0xb2, 0xa2, 0xac, 0x8d, 0x6f, 0x6c,
-		0xd4, 0x13, 0x62, 0x6b, 0x74, 0xac, 0xfa, 0xc1, 0xe5, 0xba, 0x14, 0x89, 0x16, 0xa2, 0x40, 0x4b,
-		0x30, 0x66, 0xba, 0x6d, 0xc3, 0x72, 0x74, 0xcb, 0xac, 0x96, 0x56, 0x94, 0xb5, 0x31, 0x6d, 0x94,
-		0x0d, 0x34, 0x4c, 0xf4, 0x9b, 0x30, 0xd7, 0x31, 0x3c, 0xec, 0x04, 0x3a, 0x0e, 0x11, 0xe8, 0x96,
-		0xd3, 0x72, 0xab, 0x65, 0xba, 0xf1, 0x9a, 0x70, 0xe3, 0xa7, 0x14, 0x22, 0xda, 0xb1, 0xe1, 0xb4,
-		0x5c, 0xed, 0x74, 0x27, 0x3b, 0x88, 0xaa, 0x30, 0x62, 0x04, 0x01, 0x6e, 0x77, 0x82, 0xea, 0xa9,
-		0x15, 0x65, 0x6d, 0x48, 0x0b, 0x1f, 0xd1, 0x26, 0x4c, 0xe1, 0x57, 0x1d, 0x8b, 0xa9, 0x98, 0x4e,
-		0x74, 0xa9, 0x3a, 0x44, 0x77, 0xac, 0xd5, 0x99, 0x1e, 0xd5, 0x43, 0x3d, 0xaa, 0xef, 0x84, 0x8a,
-		0xa6, 0x55, 0x7a, 0x20, 0x64, 0x10, 0xb5, 0x60, 0xb1, 0xe9, 0x3a, 0x81, 0xe5, 0x74, 0xb1, 0x6e,
-		0xf8, 0xba, 0x83, 0x0f, 0x75, 0xcb, 0xb1, 0x02, 0xcb, 0x08, 0x5c, 0xaf, 0x3a, 0xbc, 0xa2, 0xac,
-		0x55, 0x36, 0xde, 0x12, 0x1e, 0x60, 0x93, 0x43, 0xdd, 0xf1, 0x1f, 0xe3, 0xc3, 0x46, 0x08, 0xa2,
-		0xcd, 0x37, 0x85, 0xe3, 0xa8, 0x01, 0x33, 0xe1, 0x8c, 0xa9, 0xb7, 0x0c, 0xcb, 0xee, 0x7a, 0xb8,
-		0x3a, 0x42, 0xc9, 0x3d, 0x23, 0xc4, 0x7f, 0x9f, 0xad, 0xd1, 0xa6, 0x23, 0x30, 0x3e, 0x82, 0x34,
-		0x98, 0xb7, 0x0d, 0x3f, 0xd0, 0x9b, 0x6e, 0xbb, 0x63, 0x63, 0x7a, 0x78, 0x0f, 0xfb, 0x5d, 0x3b,
-		0xa8, 0x8e, 0x4a, 0xf0, 0x3d, 0x35, 0x8e, 0x6c, 0xd7, 0x30, 0xb5, 0x59, 0x02, 0xbb, 0x19, 0x81,
-		0x6a, 0x14, 0x12, 0xfd, 0x2a, 0x2c, 0xb5, 0x2c, 0xcf, 0x0f, 0x74, 0x13, 0x37, 0x2d, 0x9f, 0xf2,
-		0xd3, 0xf0, 0x5f, 0xe8, 0xbb, 0x46, 0xf3, 0x85, 0xdb, 0x6a, 0x55, 0xc7, 0x28, 0xe2, 0xc5, 0x0c,
-		0x5f, 0xb7, 0xb8, 0x81, 0xd3, 0xaa, 0x14, 0x7a, 0x8b, 0x03, 0xef, 0x18, 0xfe, 0x8b, 0xbb, 0x0c,
-		0x14, 0x1d, 0xc0, 0x74, 0xc7, 0xf0, 0x02, 0x8b, 0xd2, 0xd9, 0x74, 0x9d, 0x96, 0xb5, 0x57, 0x85,
-		0x95, 0xf2, 0xda, 0xf8, 0xc6, 0xaf, 0xd4, 0x73, 0x0c, 0xa9, 0x5c, 0x2a, 0x89, 0xe8, 0x30, 0x74,
-		0x9b, 0x14, 0xdb, 0x3d, 0x27, 0xf0, 0x8e, 0xb4, 0xa9, 0x4e, 0x72, 0xb4, 0x76, 0x17, 0x66, 0x45,
-		0x0b, 0xd1, 0x34, 0x94, 0x5f, 0xe0, 0x23, 0xaa, 0x14, 0x63, 0x1a, 0xf9, 0x89, 0x66, 0x61, 0xe8,
-		0xc0, 0xb0, 0xbb, 0x98, 0x0b, 0x36, 0x7b, 0xb8, 0x59, 0xba, 0xae, 0xa8, 0xd7, 0x60, 0x39, 0x8f,
-		0x14, 0xbf, 0xe3, 0x3a, 0x3e, 0x46, 0x73, 0x30, 0xec, 0x75, 0xa9, 0x56, 0x30, 0x84, 0x43, 0x5e,
-		0xd7, 0x69, 0x98, 0xea, 0xdf, 0x94, 0x60, 0x79, 0xdb, 0xda, 0x73, 0x0c, 0x3b, 0x57, 0x41, 0x1f,
-		0xa5, 0x15, 0xf4, 0x8a, 0x58, 0x41, 0xa5, 0x58, 0xfa, 0xd4, 0xd0, 0x16, 0x2c, 0xe1, 0x57, 0x01,
-		0xf6, 0x1c, 0xc3, 0x8e, 0x0c, 0x6f, 0x4f, 0x59, 0xb9, 0x9e, 0x5e, 0x10, 0xee, 0x9f, 0xdd, 0x79,
-		0x31, 0x44, 0x95, 0x99, 0x42, 0x75, 0x38, 0xdd, 0xdc, 0xb7, 0x6c, 0xb3, 0xb7, 0x89, 0xeb, 0xd8,
-		0x47, 0x54, 0x6f, 0x47, 0xb5, 0x19, 0x3a, 0x15, 0x02, 0x3d, 0x71, 0xec, 0x23, 0x75, 0x15, 0xce,
-		0xe5, 0x9e, 0x8f, 0x31, 0x58, 0xfd, 0x79, 0x09, 0x2e, 0xf2, 0x35, 0x56, 0xb0, 0x2f, 0xb7, 0x79,
-		0xcf, 0xd3, 0x2c, 0xbd, 0x25, 0x63, 0x69, 0x11, 0xba, 0x3e, 0x79, 0xfb, 0xb9, 0x22, 0x10, 0xf0,
-		0x32, 0x15, 0xf0, 0x8f, 0xf3, 0x05, 0xbc, 0x3f, 0x12, 0xfe, 0x0f, 0x45, 0xfd, 0x0e, 0xac, 0x15,
-		0x13, 0x25, 0x17, 0xfa, 0xef, 0x2b, 0x70, 0x56, 0xc3, 0x3e, 0x3e, 0xf1, 0x4b, 0x49, 0x8a, 0xa4,
-		0xbf, 0x6b, 0x21, 0xaa, 0x9b, 0x87, 0x46, 0x7e, 0x8a, 0x2f, 0x4b, 0xb0, 0xba, 0x83, 0xbd, 0xb6,
-		0xe5, 0x18, 0x01, 0xce, 0x3d, 0xc9, 0xd3, 0xf4, 0x49, 0xae, 0x0a, 0x4f, 0x52, 0x88, 0xe8, 0x97,
-		0x5c, 0x81, 0xdf, 0x00, 0x55, 0x76, 0x44, 0xae, 0xc3, 0x3f, 0x54, 0x60, 0x65, 0x0b, 0xfb, 0x4d,
-		0xcf, 0xda, 0xcd, 0xe7, 0xe8, 0x93, 0x34, 0x47, 0xdf, 0x13, 0x1e, 0xa7, 0x08, 0x4f, 0x9f, 0xe2,
-		0xf1, 0xdf, 0x65, 0x58, 0x95, 0xa0, 0xe2, 0x22, 0x62, 0xc3, 0x42, 0xcf, 0xa5, 0x61, 0xaa, 0xcd,
-		0x5f, 0x78, 0x52, 0x9b, 0x9d, 0x41, 0xb8, 0x19, 0x07, 0xd5, 0xe6, 0xb1, 0x70, 0x1c, 0xed, 0xc2,
-		0x42, 0xf6, 0x6e, 0x99, 0x27, 0x55, 0xa2, 0xbb, 0x5d, 0xea, 0x6f, 0x37, 0xea, 0x4b, 0xcd, 0x1d,
-		0x8a, 0x86, 0xd1, 0x27, 0x80, 0x3a, 0xd8, 0x31, 0x2d, 0x67, 0x4f, 0x37, 0x9a, 0x81, 0x75, 0x60,
-		0x05, 0x16, 0xf6, 0xb9, 0xb9, 0xca, 0x71, 0xd4, 0xd8, 0xf2, 0x3b, 0x6c, 0xf5, 0x11, 0x45, 0x3e,
-		0xd3, 0x49, 0x0c, 0x5a, 0xd8, 0x47, 0xbf, 0x06, 0xd3, 0x21, 0x62, 0x2a, 0x26, 0x1e, 0x76, 0xaa,
-		0xa7, 0x28, 0xda, 0xba, 0x0c, 0xed, 0x26, 0x59, 0x9b, 0xa4, 0x7c, 0xaa, 0x13, 0x9b, 0xf2, 0xb0,
-		0x83, 0xb6, 0x7b, 0xa8, 0x43, 0xef, 0x84, 0x3b, 0x7a, 0x52, 0x8a, 0x43, 0x67, 0x24, 0x81, 0x34,
-		0x1c, 0x54, 0x5f, 0xc1, 0xec, 0x33, 0x12, 0xf3, 0x84, 0xdc, 0x0b, 0xc5, 0x70, 0x33, 0x2d, 0x86,
-		0x6f, 0x0a, 0xf7, 0x10, 0xc1, 0xf6, 0x29, 0x7a, 0x3f, 0x56, 0x60, 0x2e, 0x05, 0xce, 0xc5, 0xed,
-		0x36, 0x4c, 0xd0, 0x38, 0x2c, 0x74, 0xe7, 0x94, 0x3e, 0xdc, 0xb9, 0x71, 0x0a, 0xc1, 0xbd, 0xb8,
-		0x06, 0x54, 0x42, 0x04, 0xbf, 0x8d, 0x9b, 0x01, 0x36, 0xb9, 0xe0, 0xa8, 0xf9, 0x67, 0xd0, 0xf8,
-		0x4a, 0x6d, 0xf2, 0x65, 0xfc, 0x51, 0xfd, 0x7d, 0x05, 0x6a, 0xd4, 0x80, 0x6e, 0x07, 0x56, 0xf3,
-		0xc5, 0x11, 0xf1, 0xe8, 0x1e, 0x5a, 0x7e, 0x10, 0xb2, 0xa9, 0x91, 0x66, 0xd3, 0x7a, 0xbe, 0x25,
-		0x17, 0x62, 0xe8, 0x93, 0x59, 0x67, 0x61, 0x49, 0x88, 0x83, 0x5b, 0x96, 0x7f, 0x2b, 0xc1, 0xfc,
-		0x03, 0x1c, 0x3c, 0xea, 0x06, 0xc6, 0xae, 0x8d, 0xb7, 0x03, 0x23, 0xc0, 0x9a, 0x08, 0xad, 0x92,
-		0xb2, 0xa7, 0x1f, 0x03, 0x12, 0x98, 0xd1, 0xd2, 0x40, 0x66, 0x74, 0x26, 0xa3, 0x61, 0xe8, 0x0a,
-		0xcc, 0xe3, 0x57, 0x1d, 0xca, 0x40, 0xdd, 0xc1, 0xaf, 0x02, 0x1d, 0x1f, 0x90, 0xb0, 0xc8, 0x32,
-		0xa9, 0x85, 0x2e, 0x6b, 0xa7, 0xc3, 0xd9, 0xc7, 0xf8, 0x55, 0x70, 0x8f, 0xcc, 0x35, 0x4c, 0xf4,
-		0x0e, 0xcc, 0x36, 0xbb, 0x1e, 0x8d, 0x9f, 0x76, 0x3d, 0xc3, 0x69, 0xee, 0xeb, 0x81, 0xfb, 0x82,
-		0x6a, 0x8f, 0xb2, 0x36, 0xa1, 0x21, 0x3e, 0x77, 0x97, 0x4e, 0xed, 0x90, 0x19, 0xf4, 0x1b, 0x30,
-		0x7b, 0x80, 0x3d, 0xea, 0xa5, 0x73, 0x9f, 0x42, 0xb7, 0x02, 0xdc, 0xe6, 0x4a, 0x91, 0x16, 0x58,
-		0x12, 0xb4, 0x92, 0x13, 0x3c, 0x67, 0x20, 0x1f, 0x32, 0x88, 0x46, 0x80, 0xdb, 0x1a, 0x3a, 0xc8,
-		0x8c, 0xa9, 0xff, 0x30, 0x06, 0x0b, 0x19, 0x96, 0x72, 0x01, 0x15, 0xb3, 0x4d, 0x39, 0x29, 0xdb,
-		0xee, 0xc3, 0x64, 0x84, 0x36, 0x38, 0xea, 0x60, 0x7e, 0x11, 0xab, 0x52, 0x8c, 0x3b, 0x47, 0x1d,
-		0xac, 0x4d, 0x1c, 0xc6, 0x9e, 0x90, 0x0a, 0x93, 0x22, 0xae, 0x8f, 0x3b, 0x31, 0x6e, 0x3f, 0x87,
-		0xc5, 0x8e, 0x87, 0x0f, 0x2c, 0xb7, 0xeb, 0xeb, 0x3e, 0x71, 0x73, 0xb0, 0xd9, 0x5b, 0x7f, 0x8a,
-		0xee, 0xbb, 0x94, 0x09, 0x73, 0x1a, 0x4e, 0x70, 0xf5, 0xdd, 0xe7, 0xc4, 0x57, 0xd2, 0xe6, 0x43,
-		0xe8, 0x6d, 0x06, 0x1c, 0xe2, 0x7d, 0x1b, 0x4e, 0xd3, 0xa0, 0x8c, 0x45, 0x51, 0x11, 0xc6, 0x21,
-		0x4a, 0xc1, 0x34, 0x99, 0xba, 0x4f, 0x66, 0xc2, 0xe5, 0x37, 0x61, 0x8c, 0x06, 0x58, 0xb6, 0xe5,
-		0x07, 0x34, 0xcc, 0x1c, 0xdf, 0x38, 0x2b, 0xf6, 0x20, 0x42, 0x91, 0x1f, 0x0d, 0xf8, 0x2f, 0xf4,
-		0x00, 0xa6, 0x7d, 0xaa, 0x0e, 0x7a, 0x0f, 0xc5, 0x48, 0x3f, 0x28, 0x2a, 0x7e, 0x42, 0x8b, 0xd0,
-		0xbb, 0x30, 0xdf, 0xb4, 0x2d, 0x42, 0xa9, 0x6d, 0xed, 0x7a, 0x86, 0x77, 0xa4, 0x73, 0x79, 0xa0,
-		0x81, 0xe4, 0x98, 0x36, 0xcb, 0x66, 0x1f, 0xb2, 0x49, 0x2e, 0x3f, 0x31, 0xa8, 0x16, 0x36, 0x82,
-		0xae, 0x87, 0x23, 0xa8, 0xb1, 0x38, 0xd4, 0x7d, 0x36, 0x19, 0x42, 0x9d, 0x83, 0x71, 0x0e, 0x65,
-		0xb5, 0x3b, 0x76, 0x15, 0xe8, 0x52, 0x60, 0x43, 0x8d, 0x76, 0xc7, 0x46, 0x3e, 0x5c, 0x4a, 0x9f,
-		0x4a, 0xf7, 0x9b, 0xfb, 0xd8, 0xec, 0xda, 0x58, 0x0f, 0x5c, 0x76, 0x59, 0x34, 0xca, 0x77, 0xbb,
-		0x41, 0x75, 0xbc, 0x28, 0x20, 0x7d, 0x23, 0x79, 0xd6, 0x6d, 0x8e, 0x69, 0xc7, 0xa5, 0xf7, 0xb6,
-		0xc3, 0xd0, 0x10, 0x7f, 0x87, 0x5d, 0x15, 0x91, 0xff, 0xde, 0x41, 0x26, 0x68, 0xa2, 0x61, 0x86,
-		0x4e, 0x6d, 0x93, 0x99, 0xf0, 0x14, 0x79, 0xba, 0x3a, 0x99, 0xab, 0xab, 0x0f, 0xa1, 0x12, 0xc9,
-		0xb6, 0x4f, 0x94, 0xa9, 0x5a, 0xa1, 0x49, 0x85, 0xf3, 0xc9, 0xab, 0x62, 0x99, 0x9e, 0xb8, 0x7c,
-		0x33, 0xcd, 0x8b, 0x14, 0x83, 0x3e, 0xa2, 0x26, 0xcc, 0x46, 0xd8, 0x9a, 0xb6, 0xeb, 0x63, 0x8e,
-		0x73, 0x8a, 0xe2, 0xbc, 0xdc, 0xa7, 0x37, 0x42, 0x00, 0x09, 0xbe, 0xae, 0xaf, 0x45, 0xfa, 0x1c,
-		0x0d, 0x12, 0x2d, 0x9f, 0x49, 0x9a, 0x17, 0xe2, 0x22, 0x4c, 0x8b, 0x5e, 0xb8, 0x3d, 0xaa, 0x13,
-		0xc6, 0xc5, 0xc2, 0xbe, 0x36, 0x7d, 0x90, 0x1a, 0x41, 0xb7, 0x60, 0xc9, 0x22, 0x3a, 0x97, 0xba,
-		0x63, 0xec, 0x10, 0x3b, 0x63, 0x56, 0x67, 0xa8, 0x8f, 0xb9, 0x60, 0xf9, 0x49, 0x53, 0x7f, 0x8f,
-		0x4d, 0xa3, 0x55, 0x98, 0x08, 0x6d, 0x9d, 0x6f, 0x7d, 0x86, 0xab, 0x88, 0xa9, 0x36, 0x1f, 0xdb,
-		0xb6, 0x3e, 0xc3, 0xea, 0x2f, 0x14, 0x58, 0x78, 0xea, 0xda, 0xf6, 0xff, 0xaf, 0xb7, 0x81, 0xfa,
-		0x93, 0x51, 0xa8, 0x66, 0x8f, 0xfd, 0x8d, 0xc5, 0xfe, 0xc6, 0x62, 0x7f, 0x1d, 0x2d, 0x76, 0x9e,
-		0x7e, 0x4c, 0xe4, 0x5a, 0x60, 0xa1, 0x39, 0x9b, 0x3c, 0xb1, 0x39, 0xfb, 0xe5, 0x33, 0xec, 0xea,
-		0xbf, 0x94, 0x60, 0x45, 0xc3, 0x4d, 0xd7, 0x33, 0xe3, 0x89, 0x5a, 0xae, 0x16, 0xaf, 0xd3, 0x52,
-		0x9e, 0x83, 0xf1, 0x48, 0x70, 0x22, 0x23, 0x00, 0xe1, 0x50, 0xc3, 0x44, 0x0b, 0x30, 0x42, 0x65,
-		0x8c, 0x6b, 0x7c, 0x59, 0x1b, 0x26, 0x8f, 0x0d, 0x13, 0x9d, 0x05, 0xe0, 0x71, 0x44, 0xa8, 0xbb,
-		0x63, 0xda, 0x18, 0x1f, 0x69, 0x98, 0x48, 0x83, 0x89, 0x8e, 0x6b, 0xdb, 0x7a, 0x18, 0xab, 0x0c,
-		0x4b, 0x62, 0x15, 0x62, 0x43, 0xef, 0xbb, 0x5e, 0x9c, 0x35, 0x61, 0xac, 0x32, 0x4e, 0x90, 0xf0,
-		0x07, 0xf5, 0xf7, 0x46, 0x61, 0x55, 0xc2, 0x45, 0x6e, 0x78, 0x33, 0x16, 0x52, 0x39, 0x9e, 0x85,
-		0x94, 0x5a, 0xbf, 0xd2, 0xf1, 0xad, 0xdf, 0xb7, 0x01, 0x85, 0xfc, 0x35, 0xd3, 0xe6, 0x77, 0x3a,
-		0x9a, 0x09, 0x57, 0xaf, 0x11, 0x03, 0x26, 0x30, 0xbd, 0x65, 0x62, 0xa1, 0x12, 0x78, 0x33, 0x16,
-		0x7d, 0x28, 0x6b, 0xd1, 0x63, 0x25, 0x9d, 0xe1, 0x64, 0x49, 0xe7, 0x3a, 0x54, 0xb9, 0x49, 0xe9,
-		0x25, 0x40, 0x42, 0x07, 0x61, 0x84, 0x3a, 0x08, 0xf3, 0x6c, 0x3e, 0x92, 0x9d, 0xd0, 0x3f, 0xd0,
-		0x60, 0x32, 0x2a, 0x5d, 0xd0, 0x94, 0x09, 0xab, 0x85, 0xbc, 0x9d, 0xa7, 0x8d, 0x3b, 0x9e, 0xe1,
-		0xf8, 0xc4, 0x94, 0x25, 0xd2, 0x04, 0x13, 0x66, 0xec, 0x09, 0x7d, 0x0a, 0x67, 0x04, 0x09, 0x99,
-		0x9e, 0x09, 0x1f, 0xeb, 0xc7, 0x84, 0x2f, 0x66, 0xc4, 0x3d, 0xb2, 0xe6, 0x39, 0xde, 0x27, 0xe4,
-		0x79, 0x9f, 0xab, 0x30, 0x91, 0xb0, 0x79, 0xe3, 0xd4, 0xe6, 0x8d, 0xef, 0xc6, 0x8c, 0xdd, 0x1d,
-		0xa8, 0xf4, 0xae, 0x95, 0x96, 0xc4, 0x26, 0x0a, 0x4b, 0x62, 0x93, 0x11, 0x04, 0xad, 0x88, 0x7d,
-		0x00, 0x13, 0xe1, 0x5d, 0x53, 0x04, 0x93, 0x85, 0x08, 0xc6, 0xf9, 0x7a, 0x0a, 0x6e, 0xc0, 0xc8,
-		0xcb, 0x2e, 0xa6, 0x46, 0xb6, 0x42, 0xf3, 0x3f, 0x0f, 0x72, 0xb3, 0xe0, 0x85, 0x5a, 0x44, 0x53,
-		0x14, 0x16, 0xf6, 0x59, 0xde, 0x3b, 0xc4, 0x9b, 0xf1, 0x05, 0xa7, 0x32, 0xbe, 0x60, 0xed, 0x53,
-		0x98, 0x88, 0xc3, 0x0a, 0x52, 0xe1, 0xd7, 0xe3, 0xa9, 0xf0, 0xbc, 0x14, 0x49, 0xa8, 0x98, 0x2c,
-		0x55, 0x12, 0x4b, 0x97, 0xf7, 0x4c, 0x69, 0x98, 0x18, 0xfb, 0xc6, 0x94, 0x66, 0x4c, 0x69, 0x9c,
-		0x35, 0x42, 0x53, 0xfa, 0xb3, 0x72, 0x68, 0x4a, 0x85, 0x5c, 0xe4, 0xa6, 0xf4, 0x23, 0x98, 0x4a,
-		0x99, 0x2a, 0xa9, 0x31, 0xe5, 0xc9, 0x0c, 0x6a, 0x6c, 0xb4, 0x4a, 0xd2, 0x94, 0x65, 0x84, 0xbb,
-		0x34, 0x98, 0x70, 0xc7, 0x2c, 0x57, 0x39, 0x69, 0xb9, 0x3e, 0x85, 0xe5, 0xa4, 0xe2, 0xe9, 0x6e,
-		0x4b, 0x0f, 0xf6, 0x2d, 0x5f, 0x8f, 0x57, 0xaf, 0xe5, 0x5b, 0xd5, 0x12, 0x8a, 0xf8, 0xa4, 0xb5,
-		0xb3, 0x6f, 0xf9, 0x77, 0x38, 0xfe, 0x06, 0xcc, 0xec, 0x63, 0xc3, 0x0b, 0x76, 0xb1, 0x11, 0xe8,
-		0x26, 0x0e, 0x0c, 0xcb, 0xf6, 0x79, 0xc2, 0x47, 0x9e, 0x20, 0x9c, 0x8e, 0xc0, 0xb6, 0x18, 0x54,
-		0xf6, 0xd5, 0x34, 0x7c, 0xbc, 0x57, 0xd3, 0x45, 0x98, 0x8a, 0xf0, 0x30, 0xb1, 0xa6, 0x36, 0x7a,
-		0x4c, 0x8b, 0x1c, 0xa3, 0x2d, 0x3a, 0xaa, 0xfe, 0xb9, 0x02, 0xdf, 0x62, 0xb7, 0x99, 0x50, 0x76,
-		0x5e, 0x84, 0xee, 0xe9, 0x8b, 0x96, 0x4e, 0x2a, 0x5e, 0xcf, 0x4b, 0x2a, 0x16, 0xa1, 0xea, 0x33,
-		0xbb, 0xf8, 0x77, 0x65, 0x78, 0x43, 0x8e, 0x8d, 0x8b, 0x20, 0xee, 0xbd, 0xff, 0x3c, 0x3e, 0xc6,
-		0x49, 0xbc, 0x79, 0x7c, 0xeb, 0xa6, 0x4d, 0xf9, 0x29, 0x49, 0xff, 0xb1, 0x02, 0xcb, 0xbd, 0xb4,
-		0x3c, 0xf1, 0xa1, 0x4d, 0xcb, 0xef, 0x18, 0x41, 0x73, 0x5f, 0xb7, 0xdd, 0xa6, 0x61, 0xdb, 0x47,
-		0xd5, 0x12, 0xb5, 0xa9, 0x9f, 0x4a, 0x76, 0x2d, 0x3e, 0x4e, 0xbd, 0x97, 0xb7, 0xdf, 0x71, 0xb7,
-		0xf8, 0x0e, 0x0f, 0xd9, 0x06, 0xcc, 0xd4, 0x2e, 0x19, 0xf9, 0x2b, 0x6a, 0xbf, 0x03, 0x2b, 0x45,
-		0x08, 0x04, 0xf6, 0x76, 0x2b, 0x69, 0x6f, 0xc5, 0x55, 0x81, 0xd0, 0x0c, 0x50, 0x5c, 0x21, 0x62,
-		0xfa, 0x66, 0x8e, 0xd9, 0xde, 0x1f, 0x2a, 0xc4, 0xf6, 0x66, 0x8e, 0x79, 0xdf, 0xb0, 0xec, 0x9e,
-		0x2c, 0xf5, 0x59, 0x4e, 0x2a, 0xc2, 0xd3, 0xa7, 0x20, 0x7d, 0x8b, 0xd8, 0xb1, 0x5c, 0x4c, 0x3c,
-		0x59, 0xfd, 0xa7, 0x0a, 0xa8, 0x59, 0x6b, 0xf7, 0x61, 0xa8, 0x9e, 0x21, 0xe5, 0xcf, 0xd2, 0x94,
-		0x5f, 0xcb, 0xa1, 0xbc, 0x08, 0x53, 0x9f, 0xb4, 0x3f, 0x25, 0xca, 0x29, 0xc1, 0xc5, 0x65, 0xf3,
-		0x4d, 0x98, 0x6e, 0x1a, 0x4e, 0x13, 0x47, 0x6f, 0x00, 0xcc, 0xde, 0x69, 0xa3, 0xda, 0x14, 0x1b,
-		0xd7, 0xc2, 0xe1, 0xb8, 0xbe, 0xc7, 0x71, 0x9e, 0x50, 0xdf, 0x65, 0xa8, 0xfa, 0x3c, 0xea, 0x85,
-		0x48, 0xdd, 0x73, 0x90, 0xc5, 0x0a, 0x96, 0x82, 0x85, 0x27, 0x91, 0xb0, 0x5c, 0x3c, 0x03, 0x4b,
-		0x98, 0x08, 0x53, 0x42, 0xc2, 0xb2, 0x07, 0xa4, 0xf7, 0xd3, 0xa3, 0xbc, 0x6f, 0x09, 0x2b, 0xc2,
-		0xd4, 0x27, 0xed, 0xe7, 0xc5, 0xe2, 0x10, 0xe1, 0xe2, 0xd4, 0xff, 0xbd, 0x02, 0xe7, 0x34, 0xdc,
-		0x76, 0x0f, 0x30, 0xeb, 0x44, 0xf8, 0xaa, 0xe4, 0xf1, 0x92, 0x8e, 0x51, 0x39, 0xe5, 0x18, 0xa9,
-		0x2a, 0x91, 0x95, 0x3c, 0xaa, 0xf9, 0xd1, 0xfe, 0xb1, 0x04, 0xe7, 0xf9, 0x11, 0xd8, 0xb1, 0x73,
-		0xcb, 0xe0, 0xd2, 0x03, 0x1a, 0x50, 0x49, 0xea, 0x20, 0x3f, 0xdc, 0xcd, 0x9c, 0xfb, 0xeb, 0x63,
-		0x43, 0x6d, 0x32, 0xa1, 0xbd, 0x68, 0x17, 0x16, 0xa2, 0x4e, 0x03, 0x61, 0x3b, 0x9f, 0xb8, 0x08,
-		0x7d, 0x8f, 0xc3, 0xa4, 0x8a, 0xd0, 0x58, 0x34, 0x3c, 0x70, 0x97, 0xc1, 0x1a, 0x5c, 0x28, 0x3a,
-		0x0b, 0xe7, 0xf3, 0x3f, 0x2b, 0xb0, 0x14, 0x26, 0x8e, 0x04, 0x81, 0xfc, 0x6b, 0x11, 0x9f, 0x4b,
-		0x30, 0x63, 0xf9, 0x7a, 0xb2, 0xbb, 0x8e, 0xf2, 0x72, 0x54, 0x9b, 0xb2, 0xfc, 0xfb, 0xf1, 0xbe,
-		0x39, 0x75, 0x19, 0xce, 0x88, 0xc9, 0xe7, 0xe7, 0xfb, 0x82, 0x3a, 0x2c, 0xc4, 0x58, 0x27, 0x0b,
-		0xe7, 0x19, 0xd3, 0xfa, 0x3a, 0x0e, 0xba, 0x0a, 0x13, 0xbc, 0x75, 0x12, 0x9b, 0xb1, 0x5c, 0x6e,
-		0x34, 0xd6, 0x30, 0xd1, 0x27, 0x70, 0xba, 0x19, 0x92, 0x1a, 0xdb, 0xfa, 0xd4, 0x40, 0x5b, 0xa3,
-		0x08, 0x45, 0x6f, 0xef, 0x87, 0x30, 0x1d, 0x6b, 0x87, 0x64, 0x41, 0xc2, 0x50, 0xbf, 0x41, 0xc2,
-		0x54, 0x0f, 0x94, 0x45, 0x09, 0x67, 0x01, 0x42, 0x77, 0xcf, 0x32, 0xa9, 0x7b, 0x5c, 0xd6, 0xc6,
-		0xf8, 0x48, 0xc3, 0x54, 0x2f, 0x12, 0x65, 0x96, 0x5e, 0x02, 0xbf, 0xae, 0xff, 0x28, 0x41, 0x55,
-		0xe3, 0xbd, 0xc2, 0x98, 0xa2, 0xf6, 0x9f, 0x6f, 0xbc, 0xce, 0x2b, 0xfa, 0x2d, 0x98, 0x13, 0x55,
-		0x8e, 0xc3, 0x0e, 0x90, 0x01, 0x4a, 0xc7, 0xa7, 0xb3, 0xa5, 0x63, 0x1f, 0xbd, 0x07, 0xc3, 0x94,
-		0xf5, 0x3e, 0xbf, 0x51, 0x71, 0x6a, 0x64, 0xcb, 0x08, 0x8c, 0xbb, 0xb6, 0xbb, 0xab, 0xf1, 0xc5,
-		0x68, 0x13, 0x2a, 0x0e, 0x3e, 0xd4, 0xbd, 0x2e, 0xbf, 0xb9, 0x30, 0xb0, 0x29, 0x00, 0x9f, 0x70,
-		0xf0, 0xa1, 0xd6, 0x65, 0x57, 0xe6, 0xab, 0x4b, 0xb0, 0x28, 0x60, 0x35, 0xbf, 0x88, 0xef, 0x2b,
-		0x30, 0xbf, 0x7d, 0xe4, 0x34, 0xb7, 0xf7, 0x0d, 0xcf, 0xe4, 0x19, 0x52, 0x7e, 0x0d, 0xe7, 0xa1,
-		0xe2, 0xbb, 0x5d, 0xaf, 0x89, 0x75, 0xde, 0x42, 0xce, 0xef, 0x62, 0x92, 0x8d, 0x6e, 0xb2, 0x41,
-		0xb4, 0x08, 0xa3, 0x3e, 0x01, 0x0e, 0xdf, 0x6f, 0x43, 0xda, 0x08, 0x7d, 0x6e, 0x98, 0xa8, 0x0e,
-		0xa7, 0x68, 0x2c, 0x59, 0x2e, 0x0c, 0xf0, 0xe8, 0x3a, 0x75, 0x11, 0x16, 0x32, 0xb4, 0x70, 0x3a,
-		0xff, 0x75, 0x08, 0x4e, 0x93, 0xb9, 0xf0, 0x3d, 0xf9, 0x3a, 0x65, 0xa5, 0x0a, 0x23, 0x61, 0x46,
-		0x8a, 0x69, 0x72, 0xf8, 0x48, 0x14, 0xbd, 0x17, 0xeb, 0x46, 0x79, 0x84, 0x28, 0xef, 0x40, 0x78,
-		0x92, 0xcd, 0x43, 0x0d, 0x0d, 0x9a, 0x87, 0x92, 0x2b, 0x61, 0x26, 0x92, 0x1f, 0x19, 0x2c, 0x92,
-		0xff, 0x88, 0x57, 0x7f, 0x7a, 0x41, 0x35, 0xc5, 0x32, 0x5a, 0x88, 0x65, 0x86, 0x80, 0x45, 0xee,
-		0x31, 0xc5, 0x75, 0x15, 0x46, 0xc2, 0x88, 0x7c, 0xac, 0x8f, 0x88, 0x3c, 0x5c, 0x1c, 0xcf, 0x26,
-		0x40, 0x32, 0x9b, 0x70, 0x1b, 0x26, 0x58, 0x6d, 0x8a, 0x37, 0x8a, 0x8f, 0xf7, 0xd1, 0x28, 0x3e,
-		0x4e, 0x4b, 0x56, 0xbc, 0x47, 0xfc, 0x1d, 0xa0, 0x7d, 0xde, 0xfc, 0xd3, 0x09, 0xdd, 0x32, 0xb1,
-		0x13, 0x58, 0xc1, 0x11, 0xcd, 0x06, 0x8e, 0x69, 0x88, 0xcc, 0x7d, 0x42, 0xa7, 0x1a, 0x7c, 0x06,
-		0x3d, 0x86, 0xa9, 0x94, 0x69, 0xe0, 0x99, 0xbf, 0xf3, 0x7d, 0x19, 0x05, 0xad, 0x92, 0x34, 0x08,
-		0xea, 0x3c, 0xcc, 0x26, 0x25, 0x99, 0x8b, 0xf8, 0x9f, 0x28, 0xb0, 0x14, 0x76, 0xde, 0x7d, 0x45,
-		0x3c, 0x3c, 0xf5, 0x8f, 0x15, 0x38, 0x23, 0xa6, 0x89, 0x07, 0x3f, 0x57, 0x60, 0xbe, 0xcd, 0xc6,
-		0x59, 0x5d, 0x46, 0xb7, 0x1c, 0xbd, 0x69, 0x34, 0xf7, 0x31, 0xa7, 0xf0, 0x74, 0x3b, 0x06, 0xd5,
-		0x70, 0x36, 0xc9, 0x14, 0xba, 0x01, 0x8b, 0x19, 0x20, 0xd3, 0x08, 0x8c, 0x5d, 0xc3, 0x0f, 0x1b,
-		0x70, 0xe7, 0x93, 0x70, 0x5b, 0x7c, 0x56, 0x3d, 0x03, 0xb5, 0x90, 0x1e, 0xce, 0xcf, 0x0f, 0xdd,
-		0xa8, 0x75, 0x4a, 0xfd, 0xdd, 0x52, 0x8f, 0x85, 0x89, 0x69, 0x4e, 0xed, 0x1a, 0x4c, 0x3b, 0xdd,
-		0xf6, 0x2e, 0xf6, 0x74, 0xb7, 0xa5, 0x53, 0x2b, 0xe5, 0x53, 0x3a, 0x87, 0xb4, 0x0a, 0x1b, 0x7f,
-		0xd2, 0xa2, 0xc6, 0xc7, 0x27, 0xcc, 0x0e, 0xad, 0x9a, 0x4f, 0x53, 0x0b, 0x43, 0xda, 0x28, 0x37,
-		0x6b, 0x3e, 0x6a, 0xc0, 0x04, 0xbf, 0x09, 0x76, 0x54, 0x71, 0x97, 0x69, 0x28, 0x0e, 0x2c, 0xd7,
-		0x43, 0x4f, 0x4e, 0x7d, 0xbf, 0x71, 0xb3, 0x37, 0x80, 0xae, 0xc2, 0x02, 0xdb, 0xa7, 0xe9, 0x3a,
-		0x81, 0xe7, 0xda, 0x36, 0xf6, 0x28, 0x4f, 0xba, 0xec, 0x4d, 0x31, 0xa6, 0xcd, 0xd1, 0xe9, 0xcd,
-		0x68, 0x96, 0xd9, 0x45, 0xaa, 0x21, 0xa6, 0xe9, 0x61, 0xdf, 0xe7, 0x09, 0xc9, 0xf0, 0x51, 0xad,
-		0xc3, 0x0c, 0xab, 0x6c, 0x11, 0xb8, 0x50, 0x76, 0xe2, 0x46, 0x5a, 0x49, 0x18, 0x69, 0x75, 0x16,
-		0x50, 0x7c, 0x3d, 0x17, 0xc6, 0xff, 0x52, 0x60, 0x86, 0x39, 0xef, 0x71, 0x2f, 0x31, 0x1f, 0x0d,
-		0xba, 0xc5, 0xab, 0xc0, 0x51, 0xd1, 0xbb, 0xb2, 0x71, 0x2e, 0x87, 0x21, 0x04, 0x23, 0xcd, 0x9a,
-		0xd1, 0x3a, 0x30, 0xcd, 0x98, 0xc5, 0x72, 0xaf, 0xe5, 0x44, 0xee, 0x75, 0x13, 0xa6, 0x0e, 0x2c,
-		0xdf, 0xda, 0xb5, 0x6c, 0x2b, 0x38, 0x62, 0x96, 0xa8, 0x38, 0x5d, 0x58, 0xe9, 0x81, 0x50, 0x33,
-		0xb4, 0x0a, 0x13, 0xfc, 0x15, 0xa6, 0x3b, 0x06, 0xb7, 0xb8, 0x63, 0xda, 0x38, 0x1f, 0x7b, 0x6c,
-		0xb4, 0x31, 0xe1, 0x42, 0xfc, 0xb8, 0x9c, 0x0b, 0x3f, 0xa0, 0x5c, 0xf0, 0x71, 0xf0, 0xac, 0x8b,
-		0xbb, 0xb8, 0x0f, 0x2e, 0xa4, 0x77, 0x2a, 0x65, 0x76, 0x4a, 0x32, 0xaa, 0x3c, 0x20, 0xa3, 0x18,
-		0x9d, 0x3d, 0x82, 0x38, 0x9d, 0x3f, 0x52, 0x60, 0x36, 0x94, 0xfb, 0xaf, 0x0c, 0xa9, 0x4f, 0x60,
-		0x2e, 0x45, 0x13, 0xd7, 0xc2, 0xab, 0xb0, 0xd0, 0xf1, 0xdc, 0x26, 0xf6, 0x7d, 0xcb, 0xd9, 0xd3,
-		0xe9, 0x57, 0x65, 0xcc, 0x0e, 0x10, 0x65, 0x2c, 0x13, 0x99, 0xef, 0x4d, 0x53, 0x48, 0x6a, 0x04,
-		0x7c, 0xf5, 0x0b, 0x05, 0xce, 0x3e, 0xc0, 0x81, 0xd6, 0xfb, 0xc6, 0xec, 0x11, 0xf6, 0x7d, 0x63,
-		0x0f, 0x47, 0x2e, 0xcb, 0x6d, 0x18, 0xa6, 0x05, 0x20, 0x86, 0x68, 0x7c, 0xe3, 0x62, 0x0e, 0xb5,
-		0x31, 0x14, 0xb4, 0x3a, 0xa4, 0x71, 0xb0, 0x3e, 0x98, 0x42, 0x6c, 0xcc, 0x72, 0x1e, 0x15, 0xfc,
-		0x80, 0x2f, 0xa1, 0xc2, 0xb8, 0xde, 0xe6, 0x33, 0x9c, 0x9c, 0x8f, 0x72, 0x93, 0x93, 0x72, 0x84,
-		0x75, 0xaa, 0x9b, 0xe1, 0x28, 0x4b, 0x44, 0x4e, 0xfa, 0xf1, 0xb1, 0x9a, 0x0d, 0x28, 0xbb, 0x28,
-		0x9e, 0x6c, 0x1c, 0x62, 0xc9, 0xc6, 0xef, 0x26, 0x93, 0x8d, 0x97, 0x8a, 0x19, 0x14, 0x11, 0x13,
-		0x4b, 0x34, 0xb6, 0x61, 0xe5, 0x01, 0x0e, 0xb6, 0x1e, 0x3e, 0x93, 0xdc, 0x45, 0x03, 0x80, 0xa9,
-		0xb4, 0xd3, 0x72, 0x43, 0x06, 0xf4, 0xb1, 0x1d, 0x11, 0x24, 0x6a, 0x26, 0xa9, 0xe8, 0x91, 0x5f,
-		0xbe, 0xfa, 0x0a, 0x56, 0x25, 0xdb, 0x71, 0xa6, 0x6f, 0xc3, 0x4c, 0xec, 0xeb, 0x43, 0x5a, 0x8c,
-		0x0c, 0xb7, 0xbd, 0xd0, 0xdf, 0xb6, 0xda, 0xb4, 0x97, 0x1c, 0xf0, 0xd5, 0x7f, 0x57, 0x60, 0x56,
-		0xc3, 0x46, 0xa7, 0x63, 0xb3, 0x88, 0x28, 0x3a, 0xdd, 0x3c, 0x0c, 0xf3, 0xcc, 0x3e, 0x7b, 0xcf,
-		0xf1, 0x27, 0xf9, 0xc7, 0x0a, 0xe2, 0x97, 0x74, 0xf9, 0xa4, 0xfe, 0xe8, 0xf1, 0x82, 0x0b, 0x75,
-		0x01, 0xe6, 0x52, 0x47, 0xe3, 0xd6, 0xe4, 0xa7, 0x0a, 0x2c, 0x69, 0xb8, 0xe5, 0x61, 0x7f, 0x3f,
-		0x2a, 0x72, 0x10, 0x6e, 0x7c, 0x05, 0xcf, 0xae, 0x2e, 0xc3, 0x19, 0x31, 0xa9, 0xfc, 0x2c, 0x37,
-		0x60, 0x61, 0xd3, 0xed, 0x3a, 0x44, 0x78, 0xd2, 0x02, 0xba, 0x0c, 0xd0, 0x72, 0xbd, 0x26, 0xbe,
-		0x8f, 0x83, 0xe6, 0x3e, 0xcf, 0xd8, 0xc6, 0x46, 0x54, 0x03, 0xaa, 0x59, 0x50, 0x2e, 0x6c, 0xf7,
-		0x60, 0x04, 0x3b, 0x01, 0xad, 0xe5, 0x32, 0x11, 0x7b, 0x2b, 0x47, 0xc4, 0xb8, 0x17, 0xb2, 0xf5,
-		0xf0, 0x19, 0xc5, 0xc5, 0xeb, 0xb5, 0x1c, 0x56, 0xfd, 0x69, 0x09, 0xe6, 0x35, 0x6c, 0x98, 0x02,
-		0xea, 0x36, 0xe0, 0x54, 0xd4, 0x1d, 0x51, 0xd9, 0x58, 0xce, 0xf3, 0x2d, 0x1e, 0x3e, 0xa3, 0x56,
-		0x97, 0xae, 0x95, 0x85, 0x62, 0xd9, 0x60, 0xae, 0x2c, 0x0a, 0xe6, 0x76, 0xa0, 0x6a, 0x39, 0x64,
-		0x85, 0x75, 0x80, 0x75, 0xec, 0x44, 0x16, 0xac, 0xcf, 0x8e, 0xb2, 0xb9, 0x08, 0xf8, 0x9e, 0x13,
-		0x9a, 0xa2, 0x86, 0x49, 0x04, 0xa3, 0x43, 0x90, 0xd0, 0x9a, 0xf4, 0x10, 0x25, 0x6c, 0x94, 0x0c,
-		0x6c, 0x5b, 0x9f, 0x61, 0x74, 0x01, 0xa6, 0x68, 0x5f, 0x04, 0x5d, 0xc1, 0xca, 0xf7, 0xc3, 0xb4,
-		0x7c, 0x4f, 0xdb, 0x25, 0x9e, 0x1a, 0x7b, 0x98, 0x75, 0xf3, 0xfd, 0x6d, 0x09, 0x16, 0x32, 0xbc,
-		0xe2, 0xd7, 0x71, 0x1c, 0x66, 0x09, 0xed, 0x45, 0xe9, 0x64, 0xf6, 0x02, 0x7d, 0x0f, 0xe6, 0x33,
-		0x48, 0xc3, 0x1c, 0xe1, 0xa0, 0x06, 0x70, 0x36, 0x8d, 0x9d, 0xa6, 0x08, 0x05, 0xec, 0x3a, 0x25,
-		0x62, 0xd7, 0xcf, 0x15, 0x58, 0x78, 0xda, 0xf5, 0xf6, 0xf0, 0xd7, 0x5b, 0xb6, 0xd4, 0x1a, 0x54,
-		0xb3, 0xc7, 0xe4, 0xca, 0xff, 0x65, 0x09, 0x16, 0x1e, 0xe1, 0xaf, 0x3d, 0x0f, 0xfe, 0x77, 0xf4,
-		0xeb, 0x2e, 0x54, 0xb3, 0xbc, 0xe2, 0xfa, 0x25, 0xc0, 0xa1, 0x88, 0x70, 0x7c, 0xae, 0xc0, 0x99,
-		0xc7, 0x6e, 0x60, 0xb5, 0x8e, 0x48, 0xb8, 0xed, 0x1e, 0x60, 0xef, 0x91, 0x41, 0x62, 0xe9, 0x88,
-		0xeb, 0xdf, 0x83, 0xf9, 0x16, 0x9f, 0xd1, 0xdb, 0x74, 0x4a, 0x4f, 0x38, 0x6c, 0x79, 0xfa, 0x91,
-		0x44, 0xc7, 0x7c, 0xb6, 0xd9, 0x56, 0x76, 0xd0, 0x57, 0xcf, 0xc1, 0xd9, 0x1c, 0x0a, 0xb8, 0x50,
-		0x18, 0xb0, 0xf4, 0x00, 0x07, 0x9b, 0x9e, 0xeb, 0xfb, 0xfc, 0x56, 0x12, 0x2f, 0xb7, 0x44, 0xe0,
-		0xa7, 0xa4, 0x02, 0xbf, 0xf3, 0x50, 0x09, 0x0c, 0x6f, 0x0f, 0x07, 0xd1, 0x2d, 0xb3, 0xd7, 0xdc,
-		0x24, 0x1b, 0xe5, 0xf8, 0xd4, 0x5f, 0x94, 0xe1, 0x8c, 0x78, 0x0f, 0xce, 0xcf, 0x36, 0xc1, 0x43,
-		0x4c, 0xc3, 0xee, 0x11, 0x0b, 0x43, 0xf9, 0xf1, 0x1f, 0xc8, 0x1c, 0xc4, 0x5c, 0x74, 0xd4, 0xf9,
-		0xf6, 0xef, 0x1e, 0x51, 0x07, 0x90, 0xbd, 0x61, 0x26, 0x82, 0xd8, 0x10, 0xfa, 0x5c, 0x81, 0xb9,
-		0x16, 0x2d, 0x88, 0xe9, 0x4d, 0xa3, 0xeb, 0xe3, 0xde, 0xb6, 0xcc, 0xde, 0x3d, 0x3a, 0xde, 0xb6,
-		0xac, 0xc6, 0xb6, 0x49, 0x30, 0x26, 0x36, 0x47, 0xad, 0xcc, 0x44, 0xad, 0x03, 0x33, 0x19, 0x2a,
-		0x05, 0xee, 0xe9, 0xbd, 0xa4, 0x7b, 0xba, 0x9e, 0x23, 0x0e, 0x69, 0x9a, 0xf8, 0xe5, 0xc5, 0x7d,
-		0xd4, 0x5a, 0x07, 0x16, 0x72, 0x08, 0x14, 0xec, 0x7b, 0x3b, 0xbe, 0x6f, 0x25, 0x37, 0xdd, 0xfb,
-		0x00, 0x07, 0xbd, 0xe2, 0x22, 0xc5, 0x1b, 0xf7, 0x8a, 0xff, 0x53, 0x81, 0x35, 0x5e, 0xce, 0xcb,
-		0x30, 0x2d, 0x53, 0x87, 0x90, 0x44, 0x66, 0xfd, 0x49, 0x19, 0x7a, 0xce, 0x84, 0x28, 0xea, 0xbb,
-		0x08, 0x73, 0xd5, 0xfd, 0x33, 0x8d, 0x77, 0x5b, 0x4c, 0x06, 0xb1, 0x27, 0x1f, 0xbd, 0x01, 0x93,
-		0x2d, 0xe2, 0x00, 0x3d, 0xc6, 0xcc, 0x97, 0xe2, 0xe5, 0xa7, 0xe4, 0xa0, 0xea, 0xc1, 0x9b, 0x7d,
-		0x9c, 0x35, 0x72, 0x97, 0x86, 0x42, 0x7f, 0xfc, 0x78, 0xd7, 0x4a, 0xa1, 0xd5, 0xf7, 0xe8, 0x37,
-		0x6d, 0xa1, 0x62, 0xd3, 0x97, 0x64, 0x1f, 0xb9, 0x31, 0x35, 0xa0, 0xdf, 0x6d, 0x25, 0xc1, 0x22,
-		0xc7, 0x61, 0xae, 0x57, 0x76, 0x09, 0x13, 0x31, 0x5d, 0xde, 0x47, 0x35, 0xa4, 0xf5, 0x6a, 0x32,
-		0xdb, 0x2c, 0x0b, 0xd3, 0x75, 0x68, 0x5e, 0x3c, 0xfc, 0xea, 0x92, 0xa7, 0x90, 0x58, 0x7e, 0x68,
-		0x92, 0x8f, 0xb2, 0x0c, 0x92, 0xda, 0x80, 0x79, 0xcd, 0x08, 0xb0, 0x6d, 0xb5, 0xad, 0xe0, 0xe3,
-		0x8e, 0x19, 0x4b, 0xe4, 0xad, 0xc3, 0x29, 0xd3, 0x08, 0x0c, 0xce, 0x8c, 0xa5, 0xbc, 0x46, 0xcc,
-		0x3b, 0xce, 0x91, 0x46, 0x17, 0xaa, 0x1f, 0xc1, 0x42, 0x06, 0x15, 0x3f, 0xc0, 0xa0, 0xb8, 0x36,
-		0xfe, 0xa9, 0x0e, 0xc0, 0x9d, 0xd2, 0x3b, 0x4f, 0x1b, 0xe8, 0x0f, 0x15, 0x98, 0x17, 0x7f, 0xd4,
-		0x8e, 0xae, 0x1e, 0xef, 0x5f, 0x28, 0x6a, 0xd7, 0x06, 0x86, 0xe3, 0x67, 0xf9, 0x23, 0x05, 0x16,
-		0x72, 0xfe, 0xf5, 0x00, 0x5d, 0x2b, 0xfa, 0xc7, 0x80, 0x3c, 0x6a, 0xae, 0x0f, 0x0e, 0xc8, 0xc9,
-		0xf9, 0x89, 0x02, 0x2b, 0x45, 0x5f, 0xfe, 0xa3, 0xef, 0x9e, 0xf4, 0x9f, 0x0c, 0x6a, 0x77, 0x4e,
-		0x80, 0x81, 0x53, 0x4a, 0x2e, 0x51, 0xfc, 0x4d, 0xbf, 0xe4, 0x12, 0xa5, 0xff, 0x25, 0x20, 0xb9,
-		0xc4, 0x82, 0x3f, 0x0f, 0xf8, 0x33, 0x05, 0x6a, 0xf9, 0x5f, 0xbe, 0xa3, 0xfc, 0xae, 0xb0, 0xc2,
-		0x7f, 0x04, 0xa8, 0xbd, 0x7f, 0x2c, 0x58, 0x4e, 0xd7, 0x8f, 0x14, 0x58, 0xcc, 0xfd, 0xae, 0x1d,
-		0xdd, 0xc8, 0x45, 0x5d, 0xf4, 0x59, 0x7d, 0xed, 0xe6, 0x71, 0x40, 0x39, 0x51, 0x0e, 0x4c, 0x26,
-		0x3e, 0x78, 0x46, 0x6f, 0xe7, 0x22, 0x13, 0x7d, 0x57, 0x5d, 0xab, 0xf7, 0xbb, 0x9c, 0xef, 0xf7,
-		0xb9, 0x02, 0xa7, 0x05, 0x5f, 0x0d, 0xa3, 0x2b, 0xf2, 0xdb, 0x16, 0x7e, 0xa7, 0x5c, 0x7b, 0x77,
-		0x30, 0x20, 0x4e, 0x42, 0x00, 0x53, 0xa9, 0x8f, 0x68, 0xd1, 0xba, 0xcc, 0xfd, 0x10, 0x54, 0x42,
-		0x6a, 0xef, 0xf4, 0x0f, 0xc0, 0x77, 0x3d, 0x84, 0xe9, 0xf4, 0x97, 0x60, 0x28, 0x1f, 0x4b, 0xce,
-		0xb7, 0x72, 0xb5, 0xcb, 0x03, 0x40, 0xc4, 0xc4, 0x2e, 0xb7, 0xdf, 0x51, 0x22, 0x76, 0x45, 0x5f,
-		0xa3, 0xd4, 0x4e, 0xd0, 0x5e, 0x89, 0xfe, 0x52, 0x81, 0x33, 0xb2, 0x76, 0x48, 0x74, 0xeb, 0x98,
-		0x5d, 0x94, 0x8c, 0xb4, 0x0f, 0x4e, 0xd4, 0x83, 0xc9, 0x59, 0x96, 0xd3, 0x33, 0x28, 0x65, 0x99,
-		0xbc, 0x63, 0x51, 0xca, 0xb2, 0x82, 0x16, 0xc5, 0xd8, 0x3d, 0x0a, 0x1a, 0xb2, 0x0b, 0xef, 0x31,
-		0xbf, 0x15, 0xbe, 0xf0, 0x1e, 0x65, 0xfd, 0xdf, 0xb1, 0x7b, 0x14, 0xb6, 0xed, 0x15, 0xdf, 0xa3,
-		0xac, 0x75, 0xb0, 0xf8, 0x1e, 0xa5, 0xbd, 0x82, 0xf1, 0x7b, 0xcc, 0x76, 0xe6, 0x15, 0xdf, 0x63,
-		0x6e, 0x5f, 0x60, 0xf1, 0x3d, 0xe6, 0x37, 0x02, 0xa2, 0xbf, 0xa0, 0xb9, 0xcd, 0xdc, 0x96, 0x3b,
-		0xf4, 0xfe, 0x40, 0x67, 0x4e, 0x36, 0xfd, 0xd5, 0x6e, 0x1d, 0x0f, 0x38, 0x41, 0x5a, 0x6e, 0xbf,
-		0xa9, 0x94, 0xb4, 0xa2, 0x8e, 0x57, 0x29, 0x69, 0xc5, 0x2d, 0xae, 0x7f, 0xad, 0xc0, 0xb2, 0xbc,
-		0xd1, 0x0c, 0x7d, 0x47, 0xb2, 0x41, 0x1f, 0xdd, 0x76, 0xb5, 0xdb, 0xc7, 0x86, 0xe7, 0x34, 0xfe,
-		0x40, 0x81, 0x6a, 0x5e, 0xbb, 0x21, 0xba, 0x2e, 0xc1, 0x2e, 0xed, 0xab, 0xac, 0xdd, 0x38, 0x06,
-		0x24, 0xa7, 0xe8, 0x0b, 0x05, 0x66, 0x45, 0x4d, 0x6b, 0x28, 0xff, 0xcd, 0x29, 0x69, 0xd1, 0xab,
-		0xbd, 0x37, 0x20, 0x14, 0xa7, 0xe2, 0xaf, 0xe8, 0x9f, 0x4f, 0x49, 0x9a, 0xb2, 0xd0, 0x07, 0x05,
-		0xb2, 0x21, 0xef, 0xa8, 0xab, 0x7d, 0xe7, 0xb8, 0xe0, 0x9c, 0xc0, 0xcf, 0x60, 0x26, 0xd3, 0x9f,
-		0x84, 0x2e, 0x4b, 0x90, 0x8a, 0xdb, 0xc6, 0x6a, 0x1b, 0x83, 0x80, 0xf4, 0xbc, 0x91, 0x54, 0xc7,
-		0x91, 0xc4, 0x1b, 0x11, 0xf7, 0x49, 0x49, 0xbc, 0x91, 0x9c, 0x66, 0x26, 0xf4, 0x02, 0x26, 0xe2,
-		0x1d, 0x20, 0xe8, 0xdb, 0x52, 0x0c, 0xa9, 0x96, 0xa7, 0xda, 0xdb, 0x7d, 0xae, 0x8e, 0x49, 0xa1,
-		0xa8, 0x85, 0x43, 0x22, 0x85, 0x92, 0x2e, 0x14, 0x89, 0x14, 0x4a, 0xfb, 0x44, 0x88, 0xe7, 0x29,
-		0xe8, 0xcc, 0x90, 0x78, 0x9e, 0xf9, 0x6d, 0x1e, 0xb5, 0x77, 0x07, 0x03, 0x8a, 0x3e, 0x55, 0x81,
-		0x5e, 0xa3, 0x03, 0xba, 0x94, 0x8b, 0x23, 0xd3, 0x3d, 0x51, 0x7b, 0xab, 0xaf, 0xb5, 0xbd, 0x6d,
-		0x7a, 0x9d, 0x04, 0x92, 0x6d, 0x32, 0xdd, 0x15, 0x92, 0x6d, 0xb2, 0xad, 0x09, 0x6c, 0x9b, 0xb0,
-		0x11, 0x40, 0xba, 0x4d, 0xaa, 0x7d, 0x41, 0xba, 0x4d, 0xba, 0xb3, 0x80, 0x44, 0x28, 0x89, 0x22,
-		0xbe, 0x24, 0x42, 0x11, 0x35, 0x20, 0x48, 0x22, 0x14, 0x71, 0x6f, 0x00, 0x09, 0x65, 0xc5, 0xc5,
-		0x70, 0x49, 0x28, 0x2b, 0x6d, 0x0a, 0x90, 0x84, 0xb2, 0x05, 0x65, 0x7c, 0xe2, 0xc0, 0xe4, 0xd6,
-		0x9d, 0x25, 0x0e, 0x4c, 0x51, 0x69, 0x5c, 0xe2, 0xc0, 0x14, 0x97, 0xb9, 0x1d, 0x98, 0x4c, 0x54,
-		0x6d, 0x25, 0x17, 0x22, 0x2a, 0x5c, 0x4b, 0x2e, 0x44, 0x58, 0x0c, 0xa6, 0xe6, 0x43, 0x54, 0x61,
-		0x45, 0xb2, 0xf0, 0x2f, 0xb7, 0x76, 0x2c, 0x31, 0x1f, 0xb2, 0x32, 0x2e, 0x89, 0xdf, 0xd2, 0xb5,
-		0x58, 0x49, 0xfc, 0x96, 0x53, 0xf1, 0x95, 0xc4, 0x6f, 0xb9, 0x85, 0xde, 0x00, 0xa6, 0x52, 0x45,
-		0x47, 0xc9, 0x0b, 0x42, 0x5c, 0xca, 0x95, 0xbc, 0x20, 0xf2, 0xea, 0x99, 0x24, 0x5c, 0x4d, 0x15,
-		0xb5, 0x64, 0xe1, 0xaa, 0xb8, 0xcc, 0x27, 0x0b, 0x57, 0x73, 0x2a, 0x66, 0x64, 0xe3, 0x74, 0x11,
-		0x48, 0xb2, 0x71, 0x4e, 0x6d, 0x4d, 0xb2, 0x71, 0x6e, 0x85, 0xe9, 0x0f, 0x14, 0x98, 0x13, 0xd6,
-		0x6d, 0x50, 0xbe, 0xc4, 0xc8, 0x2a, 0x4d, 0xb5, 0xab, 0x83, 0x82, 0xc5, 0xe4, 0x5d, 0x54, 0xf5,
-		0x90, 0xc8, 0xbb, 0xa4, 0x9c, 0x24, 0x91, 0x77, 0x69, 0x81, 0xe8, 0x4b, 0x25, 0xfa, 0xaa, 0x29,
-		0x3f, 0xbd, 0x8e, 0xee, 0x14, 0xc5, 0x1b, 0x85, 0x65, 0x88, 0xda, 0xdd, 0x93, 0xa0, 0x48, 0xa4,
-		0x74, 0xe2, 0xf9, 0x75, 0x79, 0x4a, 0x47, 0x90, 0xc0, 0x97, 0xa7, 0x74, 0x84, 0xa9, 0x7b, 0xa2,
-		0x99, 0xc9, 0xa4, 0xb8, 0x4c, 0x33, 0x85, 0x99, 0x78, 0x99, 0x66, 0x8a, 0xf3, 0xed, 0x77, 0x6f,
-		0xfc, 0xfa, 0xb5, 0x3d, 0x2b, 0xd8, 0xef, 0xee, 0xd6, 0x9b, 0x6e, 0x7b, 0x3d, 0xf1, 0x9f, 0xe4,
-		0xf5, 0x3d, 0xec, 0xb0, 0x3f, 0xa8, 0x8f, 0xfd, 0x43, 0xfe, 0xfb, 0xfc, 0xe7, 0xc1, 0xe5, 0xdd,
-		0x61, 0x3a, 0x77, 0xe5, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x55, 0xea, 0x8b, 0xf1, 0x4d, 0x5f,
-		0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3d, 0x4b, 0x6c, 0x24, 0xc7,
+		0x75, 0xe9, 0xe1, 0xf2, 0xf7, 0xf8, 0xaf, 0xe5, 0x67, 0x38, 0xdc, 0xe5, 0x2e, 0x5b, 0xa2, 0x96,
+		0x5a, 0x59, 0xa4, 0x96, 0x2b, 0xad, 0x56, 0xb2, 0x6c, 0x99, 0xcb, 0xcf, 0x6a, 0x64, 0xee, 0xaf,
+		0x49, 0xad, 0xf2, 0x55, 0xbb, 0x39, 0x53, 0x43, 0x76, 0xd8, 0xd3, 0x3d, 0xea, 0xee, 0x21, 0x97,
+		0x02, 0x12, 0x28, 0x71, 0x12, 0x20, 0x46, 0x10, 0x3b, 0xce, 0x07, 0x01, 0x02, 0x04, 0x08, 0x1c,
+		0xc0, 0xb0, 0x91, 0x5b, 0x9c, 0xe4, 0x10, 0xe4, 0x14, 0x07, 0xc8, 0x21, 0x87, 0x5c, 0x73, 0x8f,
+		0x81, 0xc4, 0x41, 0x6e, 0x06, 0x72, 0x0d, 0xea, 0xd7, 0xd3, 0x9f, 0xea, 0xea, 0x19, 0xd2, 0xc1,
+		0xca, 0x8e, 0x6f, 0x9c, 0xaa, 0x7a, 0xaf, 0x5e, 0xbd, 0xaa, 0xf7, 0xea, 0xfd, 0xaa, 0x09, 0xcb,
+		0xed, 0x03, 0xec, 0xaf, 0xd5, 0xac, 0x3a, 0x76, 0x6b, 0x78, 0xed, 0xc8, 0x0e, 0x42, 0xcf, 0x3f,
+		0x5b, 0x3b, 0xb9, 0xb5, 0x16, 0x60, 0xff, 0xc4, 0xae, 0xe1, 0xd5, 0x96, 0xef, 0x85, 0x1e, 0x9a,
+		0x23, 0xc3, 0x56, 0xf9, 0xb0, 0x55, 0x3e, 0x6c, 0xf5, 0xe4, 0x56, 0x65, 0xf1, 0xd0, 0xf3, 0x0e,
+		0x1d, 0xbc, 0x46, 0x87, 0x1d, 0xb4, 0x1b, 0x6b, 0xf5, 0xb6, 0x6f, 0x85, 0xb6, 0xe7, 0x32, 0xc0,
+		0xca, 0xb5, 0x74, 0x7f, 0x68, 0x37, 0x71, 0x10, 0x5a, 0xcd, 0x16, 0x1f, 0x90, 0x41, 0x70, 0xea,
+		0x5b, 0xad, 0x16, 0xf6, 0x03, 0xde, 0x7f, 0x3d, 0x41, 0xa0, 0xd5, 0xb2, 0x09, 0x71, 0x35, 0xaf,
+		0xd9, 0x8c, 0xa6, 0x58, 0x92, 0x8d, 0x10, 0x24, 0x72, 0x2a, 0x64, 0x43, 0x3e, 0x6e, 0xe3, 0x68,
+		0x80, 0x2e, 0x1b, 0x10, 0x5a, 0xc1, 0xb1, 0x63, 0x07, 0xa1, 0x6a, 0xcc, 0xa9, 0xe7, 0x1f, 0x37,
+		0x1c, 0xef, 0x94, 0x8f, 0xb9, 0x29, 0x1b, 0xc3, 0x59, 0x69, 0xa6, 0xc6, 0xae, 0x14, 0x8d, 0xc5,
+		0x3e, 0x1f, 0xf9, 0x42, 0x72, 0x64, 0xbd, 0x69, 0xbb, 0x94, 0x0b, 0x4e, 0x3b, 0x08, 0x8b, 0x06,
+		0x25, 0x19, 0xb1, 0x24, 0x1f, 0xf4, 0x71, 0x1b, 0xb7, 0xf9, 0x56, 0x57, 0x6e, 0xc8, 0x87, 0xf8,
+		0xb8, 0xe5, 0xd8, 0xb5, 0xf8, 0xd6, 0x26, 0x77, 0x26, 0x38, 0xb2, 0x7c, 0x5c, 0x27, 0x23, 0x2d,
+		0x57, 0xcc, 0xf6, 0x62, 0xce, 0x88, 0x24, 0x4d, 0xcb, 0x39, 0xa3, 0x92, 0xec, 0xd2, 0xbf, 0x3f,
+		0x08, 0x57, 0xf7, 0x42, 0xcb, 0x0f, 0x3f, 0xe4, 0xed, 0xdb, 0xcf, 0x70, 0xad, 0x4d, 0xe8, 0x31,
+		0xf0, 0xc7, 0x6d, 0x1c, 0x84, 0x68, 0x17, 0x06, 0x7d, 0xf6, 0x67, 0x59, 0xbb, 0xae, 0xad, 0x8c,
+		0xac, 0xaf, 0xaf, 0x26, 0x8e, 0xad, 0xd5, 0xb2, 0x57, 0x4f, 0x6e, 0xad, 0x2a, 0x91, 0x18, 0x02,
+		0x05, 0x5a, 0x80, 0xe1, 0xba, 0xd7, 0xb4, 0x6c, 0xd7, 0xb4, 0xeb, 0xe5, 0xd2, 0x75, 0x6d, 0x65,
+		0xd8, 0x18, 0x62, 0x0d, 0xd5, 0x3a, 0xfa, 0x65, 0x98, 0x69, 0x59, 0x3e, 0x76, 0x43, 0x13, 0x0b,
+		0x04, 0xa6, 0xed, 0x36, 0xbc, 0x72, 0x1f, 0x9d, 0x78, 0x45, 0x3a, 0xf1, 0x63, 0x0a, 0x11, 0xcd,
+		0x58, 0x75, 0x1b, 0x9e, 0x71, 0xb9, 0x95, 0x6d, 0x44, 0x65, 0x18, 0xb4, 0xc2, 0x10, 0x37, 0x5b,
+		0x61, 0xf9, 0xd2, 0x75, 0x6d, 0xa5, 0xdf, 0x10, 0x3f, 0xd1, 0x26, 0x4c, 0xe0, 0x67, 0x2d, 0x9b,
+		0x89, 0x98, 0x49, 0x64, 0xa9, 0xdc, 0x4f, 0x67, 0xac, 0xac, 0x32, 0x39, 0x5a, 0x15, 0x72, 0xb4,
+		0xba, 0x2f, 0x04, 0xcd, 0x18, 0xef, 0x80, 0x90, 0x46, 0xd4, 0x80, 0xf9, 0x9a, 0xe7, 0x86, 0xb6,
+		0xdb, 0xc6, 0xa6, 0x15, 0x98, 0x2e, 0x3e, 0x35, 0x6d, 0xd7, 0x0e, 0x6d, 0x2b, 0xf4, 0xfc, 0xf2,
+		0xc0, 0x75, 0x6d, 0x65, 0x7c, 0xfd, 0x15, 0xe9, 0x02, 0x36, 0x39, 0xd4, 0x46, 0xf0, 0x10, 0x9f,
+		0x56, 0x05, 0x88, 0x31, 0x5b, 0x93, 0xb6, 0xa3, 0x2a, 0x4c, 0x89, 0x9e, 0xba, 0xd9, 0xb0, 0x6c,
+		0xa7, 0xed, 0xe3, 0xf2, 0x20, 0x25, 0xf7, 0x8a, 0x14, 0xff, 0x0e, 0x1b, 0x63, 0x4c, 0x46, 0x60,
+		0xbc, 0x05, 0x19, 0x30, 0xeb, 0x58, 0x41, 0x68, 0xd6, 0xbc, 0x66, 0xcb, 0xc1, 0x74, 0xf1, 0x3e,
+		0x0e, 0xda, 0x4e, 0x58, 0x1e, 0x52, 0xe0, 0x7b, 0x6c, 0x9d, 0x39, 0x9e, 0x55, 0x37, 0xa6, 0x09,
+		0xec, 0x66, 0x04, 0x6a, 0x50, 0x48, 0xf4, 0xf3, 0xb0, 0xd0, 0xb0, 0xfd, 0x20, 0x34, 0xeb, 0xb8,
+		0x66, 0x07, 0x94, 0x9f, 0x56, 0x70, 0x6c, 0x1e, 0x58, 0xb5, 0x63, 0xaf, 0xd1, 0x28, 0x0f, 0x53,
+		0xc4, 0xf3, 0x19, 0xbe, 0x6e, 0x71, 0x05, 0x67, 0x94, 0x29, 0xf4, 0x16, 0x07, 0xde, 0xb7, 0x82,
+		0xe3, 0x7b, 0x0c, 0x14, 0x9d, 0xc0, 0x64, 0xcb, 0xf2, 0x43, 0x9b, 0xd2, 0x59, 0xf3, 0xdc, 0x86,
+		0x7d, 0x58, 0x86, 0xeb, 0x7d, 0x2b, 0x23, 0xeb, 0x5f, 0x5e, 0xcd, 0x51, 0xa4, 0xea, 0x53, 0x49,
+		0x8e, 0x0e, 0x43, 0xb7, 0x49, 0xb1, 0x6d, 0xbb, 0xa1, 0x7f, 0x66, 0x4c, 0xb4, 0x92, 0xad, 0xe8,
+		0x23, 0x98, 0x8e, 0x31, 0xa8, 0x66, 0x39, 0x0e, 0x59, 0x4c, 0x50, 0x1e, 0xa1, 0x73, 0xbf, 0x92,
+		0x3b, 0x77, 0x87, 0x35, 0x9b, 0x1c, 0xc6, 0xb8, 0x5c, 0xcb, 0xb4, 0x05, 0x95, 0x7b, 0x30, 0x2d,
+		0x23, 0x04, 0x4d, 0x42, 0xdf, 0x31, 0x3e, 0xa3, 0x42, 0x37, 0x6c, 0x90, 0x3f, 0xd1, 0x34, 0xf4,
+		0x9f, 0x58, 0x4e, 0x1b, 0x73, 0xc1, 0x61, 0x3f, 0xde, 0x2e, 0xdd, 0xd5, 0xf4, 0xbf, 0xd5, 0x00,
+		0x65, 0xe7, 0x23, 0x28, 0xda, 0xbe, 0x23, 0x50, 0xb4, 0x7d, 0x07, 0x19, 0x30, 0x78, 0x84, 0xad,
+		0x3a, 0xf6, 0x83, 0x72, 0x89, 0xd2, 0x7f, 0xb7, 0x07, 0xfa, 0x57, 0xdf, 0x63, 0xa0, 0x8c, 0x51,
+		0x02, 0x51, 0xe5, 0x6d, 0x18, 0x8d, 0x77, 0xf4, 0x44, 0xf8, 0xbf, 0x94, 0x60, 0x36, 0x3b, 0x11,
+		0x95, 0xd7, 0x2c, 0xf1, 0x3b, 0xd0, 0x1f, 0x84, 0x56, 0xc8, 0xd0, 0x8c, 0xaf, 0xbf, 0xd6, 0x03,
+		0xe9, 0x7b, 0x04, 0xce, 0x60, 0xe0, 0x71, 0x4d, 0xd0, 0x97, 0xd4, 0x04, 0x3b, 0x30, 0x45, 0x25,
+		0x82, 0xff, 0x66, 0xba, 0xe0, 0x52, 0xa1, 0x2e, 0x98, 0x20, 0x40, 0x1b, 0x0c, 0x86, 0x2a, 0x83,
+		0x1d, 0x98, 0x72, 0xf1, 0xb3, 0x14, 0x9e, 0x62, 0x9d, 0x32, 0x41, 0x80, 0xe2, 0x78, 0x56, 0xe1,
+		0x32, 0xa5, 0x87, 0xcb, 0xb9, 0xe9, 0x63, 0x2b, 0xf0, 0x5c, 0xaa, 0x4e, 0x86, 0x0d, 0x4a, 0xaa,
+		0x90, 0x6e, 0xda, 0xa1, 0xbf, 0x09, 0x8b, 0x79, 0x47, 0x3e, 0x68, 0x79, 0x6e, 0x80, 0xd1, 0x0c,
+		0x0c, 0xf8, 0x6d, 0xaa, 0x7d, 0x19, 0x63, 0xfb, 0xfd, 0xb6, 0x5b, 0xad, 0xeb, 0x7f, 0x59, 0x82,
+		0xc5, 0x3d, 0xfb, 0xd0, 0xb5, 0x9c, 0xdc, 0x8b, 0xe0, 0x41, 0xfa, 0x22, 0xb8, 0x2d, 0xbf, 0x08,
+		0x94, 0x58, 0xba, 0xbc, 0x09, 0x1a, 0xb0, 0x80, 0x9f, 0x85, 0xd8, 0x77, 0x2d, 0x27, 0xba, 0xe0,
+		0x3b, 0x97, 0x02, 0xbf, 0x0f, 0x5e, 0x92, 0xce, 0x9f, 0x9d, 0x79, 0x5e, 0xa0, 0xca, 0x74, 0x11,
+		0xfe, 0xd6, 0x8e, 0x6c, 0xa7, 0xde, 0x99, 0xc4, 0x73, 0x9d, 0x33, 0xba, 0xe3, 0x43, 0xc6, 0x14,
+		0xed, 0x12, 0x40, 0x8f, 0x5c, 0xe7, 0x4c, 0x5f, 0x82, 0x6b, 0xb9, 0xeb, 0x63, 0x0c, 0xd6, 0xff,
+		0xa9, 0x0f, 0x6e, 0xf0, 0x31, 0x76, 0x78, 0xa4, 0xbe, 0x5b, 0x9f, 0xa6, 0x59, 0xfa, 0x8e, 0x8a,
+		0xa5, 0x45, 0xe8, 0xba, 0xe4, 0xed, 0xa7, 0x9a, 0x44, 0x91, 0xf6, 0x51, 0x65, 0xf0, 0x41, 0xbe,
+		0x22, 0xed, 0x8e, 0x84, 0x0b, 0xaa, 0xd4, 0x4b, 0x9f, 0x21, 0x95, 0xba, 0x01, 0x2b, 0xc5, 0x8b,
+		0x56, 0x0b, 0xd5, 0xd7, 0x34, 0xb8, 0x6a, 0xe0, 0x00, 0x5f, 0xd8, 0xb8, 0x52, 0x22, 0xe9, 0x6e,
+		0xdb, 0x89, 0x6a, 0xc8, 0x43, 0xa3, 0x5e, 0xc5, 0x77, 0x4b, 0xb0, 0xb4, 0x8f, 0xfd, 0xa6, 0xed,
+		0x5a, 0x21, 0xce, 0x5d, 0xc9, 0xe3, 0xf4, 0x4a, 0xee, 0x48, 0x57, 0x52, 0x88, 0xe8, 0x27, 0x5c,
+		0x41, 0xbc, 0x08, 0xba, 0x6a, 0x89, 0x5c, 0x47, 0xfc, 0x41, 0x09, 0xe6, 0x3f, 0x68, 0xd5, 0x63,
+		0x63, 0x1e, 0xe0, 0xa6, 0x67, 0xc8, 0x16, 0xae, 0xa5, 0x16, 0x3e, 0x0b, 0x03, 0xec, 0x6f, 0xce,
+		0x12, 0xfe, 0x0b, 0x7d, 0x00, 0xe8, 0xc2, 0x7c, 0x98, 0x3a, 0xcd, 0xac, 0xff, 0x55, 0xb8, 0xd4,
+		0xc4, 0x4d, 0x8f, 0xdf, 0x81, 0xf3, 0x52, 0x44, 0x94, 0x76, 0x3a, 0x0c, 0x55, 0x60, 0xc8, 0xae,
+		0x63, 0x37, 0xb4, 0xc3, 0x33, 0x7a, 0xdd, 0x0d, 0x1b, 0xd1, 0x6f, 0x74, 0x15, 0x80, 0x6f, 0x2d,
+		0x59, 0x17, 0xbb, 0xc2, 0x86, 0x79, 0x4b, 0xb5, 0xae, 0x5f, 0x81, 0x8a, 0x8c, 0x25, 0x9c, 0x63,
+		0xdf, 0xd0, 0xe0, 0xfa, 0x16, 0x0e, 0x6a, 0xbe, 0x7d, 0x90, 0x7f, 0x06, 0x1f, 0xa5, 0xcf, 0xe0,
+		0x1b, 0x52, 0x7a, 0x8b, 0xf0, 0x74, 0x29, 0x50, 0xdf, 0xef, 0x87, 0x25, 0x05, 0x2a, 0x2e, 0x54,
+		0x0e, 0xcc, 0x75, 0x9c, 0x19, 0xa6, 0x6c, 0xb9, 0xa9, 0xab, 0xbc, 0x45, 0x33, 0x08, 0x37, 0xe3,
+		0xa0, 0xc6, 0x2c, 0x96, 0xb6, 0xa3, 0x03, 0x98, 0xcb, 0x9e, 0x02, 0xe6, 0x43, 0x95, 0xe8, 0x6c,
+		0x37, 0xbb, 0x9b, 0x8d, 0x7a, 0x51, 0x33, 0xa7, 0xb2, 0x66, 0xf4, 0x21, 0xa0, 0x16, 0x76, 0xeb,
+		0xb6, 0x7b, 0x68, 0x5a, 0xb5, 0xd0, 0x3e, 0xb1, 0x43, 0x1b, 0x07, 0xfc, 0x02, 0xc9, 0x71, 0xd1,
+		0xd8, 0xf0, 0x0d, 0x36, 0xfa, 0x8c, 0x22, 0x9f, 0x6a, 0x25, 0x1a, 0x6d, 0x1c, 0xa0, 0x5f, 0x80,
+		0x49, 0x81, 0x98, 0x0a, 0x96, 0x8f, 0x5d, 0x7e, 0x23, 0xac, 0xaa, 0xd0, 0x6e, 0x92, 0xb1, 0x49,
+		0xca, 0x27, 0x5a, 0xb1, 0x2e, 0x1f, 0xbb, 0x68, 0xaf, 0x83, 0x5a, 0xf8, 0x25, 0xdc, 0x1c, 0x53,
+		0x52, 0x2c, 0xdc, 0x90, 0x04, 0x52, 0xd1, 0x88, 0xbe, 0x02, 0x33, 0x41, 0x68, 0xd7, 0x8e, 0xcf,
+		0xd2, 0xac, 0x1e, 0xa0, 0x98, 0x3f, 0xa7, 0xf0, 0x4a, 0x08, 0x54, 0xca, 0x65, 0x0d, 0xb2, 0x8d,
+		0xe8, 0x20, 0xe7, 0x9e, 0x1c, 0xa4, 0x5c, 0x59, 0xeb, 0xe1, 0x9e, 0x64, 0x73, 0x48, 0xee, 0x4a,
+		0xfd, 0x7b, 0x25, 0xb8, 0x2c, 0x21, 0x08, 0xdd, 0x87, 0x49, 0xbe, 0x3a, 0xea, 0xc0, 0x39, 0x76,
+		0x24, 0x55, 0x57, 0xe5, 0x9a, 0xdd, 0x0a, 0x8e, 0x77, 0xed, 0x20, 0x34, 0xc6, 0x19, 0x98, 0xf8,
+		0x8d, 0xae, 0xc1, 0x08, 0x47, 0x74, 0xe4, 0x05, 0x21, 0x17, 0x23, 0x60, 0x4d, 0xef, 0x79, 0x41,
+		0x88, 0xf6, 0x61, 0x3e, 0xa8, 0x1d, 0xe1, 0x7a, 0xdb, 0xc1, 0x66, 0xe8, 0x99, 0x01, 0xb9, 0x6b,
+		0xa9, 0xc5, 0xec, 0xb5, 0x43, 0xae, 0xc1, 0x14, 0x0e, 0xe3, 0xac, 0x80, 0xdd, 0xf7, 0xe8, 0x2d,
+		0xbd, 0xcf, 0x00, 0x89, 0xec, 0x1e, 0xd9, 0xc4, 0xb7, 0x6d, 0xbb, 0xcc, 0xe1, 0xef, 0x33, 0x86,
+		0x8e, 0xec, 0x70, 0x93, 0xfc, 0x46, 0xcb, 0x30, 0xde, 0xe0, 0x1c, 0xe0, 0x23, 0xfa, 0xe9, 0x88,
+		0x31, 0xd1, 0xca, 0x86, 0xcd, 0x03, 0x01, 0x31, 0x7d, 0xe2, 0x73, 0x90, 0x4d, 0xd5, 0x8c, 0xc1,
+		0x23, 0x3b, 0x34, 0xac, 0x10, 0xeb, 0xcf, 0x60, 0xfa, 0x49, 0x1b, 0xfb, 0x67, 0x42, 0x74, 0x84,
+		0x0e, 0xda, 0x4c, 0xeb, 0xa0, 0x97, 0xa5, 0xdc, 0x92, 0xc1, 0x76, 0xa9, 0x77, 0xbe, 0xa5, 0xc1,
+		0x4c, 0x0a, 0x9c, 0xeb, 0x9a, 0x77, 0x61, 0x94, 0x86, 0xdf, 0x84, 0x17, 0xaf, 0x75, 0xe1, 0xc5,
+		0x8f, 0x50, 0x08, 0xee, 0xbc, 0x57, 0x61, 0x5c, 0x20, 0xf8, 0x55, 0x5c, 0x0b, 0x71, 0x9d, 0x6b,
+		0x0d, 0x3d, 0x7f, 0x0d, 0x06, 0x1f, 0x69, 0x8c, 0x7d, 0x1c, 0xff, 0xa9, 0xff, 0x96, 0x06, 0x15,
+		0x6a, 0x6f, 0xec, 0x25, 0x4e, 0x83, 0x60, 0x53, 0x35, 0xcd, 0xa6, 0xb5, 0x7c, 0xc3, 0x47, 0x8a,
+		0xa1, 0x4b, 0x66, 0x5d, 0x85, 0x05, 0x29, 0x0e, 0x7e, 0xad, 0xfc, 0x6b, 0x09, 0x66, 0xef, 0xe3,
+		0xf0, 0x41, 0x3b, 0xb4, 0x0e, 0x1c, 0xcc, 0x9c, 0xc4, 0x6e, 0x6e, 0x61, 0xf9, 0x6d, 0x5b, 0xba,
+		0xe8, 0x6d, 0x7b, 0x1b, 0x66, 0xf1, 0xb3, 0x16, 0x65, 0xa0, 0x49, 0xfd, 0x47, 0x7c, 0x82, 0x5d,
+		0x7a, 0x5d, 0xf6, 0xd1, 0xe3, 0x79, 0x59, 0xf4, 0x3e, 0xc4, 0xcf, 0xc2, 0x6d, 0xd2, 0x57, 0xad,
+		0xa3, 0xd7, 0x60, 0xba, 0xd6, 0xf6, 0x69, 0xd8, 0xec, 0xc0, 0xb7, 0xdc, 0xda, 0x91, 0x19, 0x7a,
+		0xc7, 0x54, 0x75, 0x6a, 0x2b, 0xa3, 0x06, 0xe2, 0x7d, 0xf7, 0x68, 0xd7, 0x3e, 0xe9, 0x41, 0xbf,
+		0x04, 0xd3, 0x27, 0xd8, 0xa7, 0xc1, 0x19, 0xae, 0x34, 0x4c, 0x3b, 0xc4, 0x4d, 0xae, 0x11, 0xd3,
+		0x07, 0xb6, 0xde, 0xb4, 0x5d, 0xb2, 0x82, 0xa7, 0x0c, 0xe4, 0x3d, 0x06, 0x51, 0x0d, 0x71, 0xd3,
+		0x40, 0x27, 0x99, 0x36, 0xfd, 0xef, 0x86, 0x61, 0x2e, 0xc3, 0x52, 0x7e, 0x40, 0xe5, 0x6c, 0xd3,
+		0x2e, 0xca, 0xb6, 0x1d, 0x18, 0x8b, 0xd0, 0x86, 0x67, 0x2d, 0xcc, 0x37, 0x62, 0x49, 0x89, 0x71,
+		0xff, 0xac, 0x85, 0x8d, 0xd1, 0xd3, 0xd8, 0x2f, 0xa4, 0xc3, 0x98, 0x8c, 0xeb, 0x23, 0x6e, 0x8c,
+		0xdb, 0x4f, 0x61, 0xbe, 0xe5, 0xe3, 0x13, 0xdb, 0x6b, 0x07, 0x4c, 0x53, 0xe1, 0x7a, 0x67, 0x3c,
+		0xb3, 0x92, 0x16, 0x32, 0xca, 0xaa, 0xea, 0x86, 0x77, 0x5e, 0x7f, 0x4a, 0x5c, 0x0b, 0x63, 0x56,
+		0x40, 0xef, 0x31, 0x60, 0x81, 0xf7, 0x55, 0xe1, 0xe9, 0xd3, 0xe0, 0x59, 0x84, 0x91, 0xa9, 0xa5,
+		0x49, 0xea, 0xe9, 0x93, 0x1e, 0x31, 0xfc, 0x6d, 0x18, 0xee, 0xa8, 0xe5, 0x81, 0x6e, 0xd4, 0xf2,
+		0x50, 0x28, 0x14, 0xb2, 0x4c, 0xb3, 0x0f, 0x9e, 0x47, 0xb3, 0xbf, 0x0e, 0xb3, 0x35, 0xc7, 0x26,
+		0x94, 0x3a, 0xf6, 0x81, 0x6f, 0xf9, 0x67, 0x26, 0x3f, 0x0f, 0x34, 0x7e, 0x38, 0x6c, 0x4c, 0xb3,
+		0xde, 0x5d, 0xd6, 0xc9, 0xcf, 0x4f, 0x0c, 0xaa, 0x81, 0xad, 0xb0, 0xed, 0xe3, 0x08, 0x6a, 0x38,
+		0x0e, 0xb5, 0xc3, 0x3a, 0x05, 0xd4, 0x35, 0x18, 0xe1, 0x50, 0x76, 0xb3, 0xe5, 0x94, 0x81, 0xdd,
+		0x22, 0xac, 0xa9, 0xda, 0x6c, 0x39, 0x28, 0x80, 0x9b, 0xe9, 0x55, 0x99, 0xf9, 0xd7, 0xca, 0x48,
+		0xd1, 0xb5, 0xf2, 0x62, 0x72, 0xad, 0x7b, 0xf2, 0x4b, 0x66, 0x15, 0x2e, 0xb3, 0xad, 0x22, 0xe7,
+		0xbf, 0xb3, 0x90, 0x51, 0x1a, 0x55, 0x9a, 0xa2, 0x5d, 0x7b, 0xa4, 0x47, 0xac, 0x22, 0x4f, 0x56,
+		0xc7, 0x72, 0x65, 0x75, 0x17, 0xc6, 0xa3, 0xb3, 0xcd, 0x82, 0x5f, 0xe3, 0x34, 0xf8, 0xb5, 0x9c,
+		0xdc, 0x2a, 0x16, 0xe0, 0x8f, 0x9f, 0x6f, 0x26, 0x79, 0x91, 0x60, 0xd0, 0x9f, 0xa8, 0x06, 0xd3,
+		0x11, 0xb6, 0x9a, 0xe3, 0x05, 0x98, 0xe3, 0x9c, 0xa0, 0x38, 0x6f, 0x75, 0x69, 0x8a, 0x12, 0x40,
+		0x82, 0xaf, 0x1d, 0x18, 0x91, 0x3c, 0x47, 0x8d, 0x44, 0xca, 0xa7, 0x92, 0xea, 0x85, 0xd8, 0x87,
+		0x93, 0x32, 0x6b, 0xab, 0x43, 0x75, 0x42, 0xb9, 0xd8, 0x38, 0x30, 0x26, 0x4f, 0x52, 0x2d, 0xe8,
+		0x1d, 0x58, 0xb0, 0x89, 0xcc, 0xa5, 0xf6, 0x18, 0xbb, 0x44, 0xcf, 0xd4, 0xcb, 0x53, 0xd4, 0x25,
+		0x9b, 0xb3, 0x83, 0xa4, 0xaa, 0xdf, 0x66, 0xdd, 0x68, 0x09, 0x46, 0x85, 0xae, 0x0b, 0xec, 0x4f,
+		0x70, 0x19, 0x31, 0xd1, 0xe6, 0x6d, 0x7b, 0xf6, 0x27, 0x58, 0xff, 0x91, 0x06, 0x73, 0x8f, 0x3d,
+		0xc7, 0xf9, 0xff, 0x75, 0x1b, 0xe8, 0xdf, 0x1e, 0x82, 0x72, 0x76, 0xd9, 0x3f, 0xd3, 0xd8, 0x3f,
+		0xd3, 0xd8, 0x3f, 0x8d, 0x1a, 0x3b, 0x4f, 0x3e, 0x46, 0x73, 0x35, 0xb0, 0x54, 0x9d, 0x8d, 0x5d,
+		0x58, 0x9d, 0xfd, 0xe4, 0x29, 0x76, 0xfd, 0x1f, 0x4b, 0x70, 0xdd, 0xc0, 0x35, 0xcf, 0xaf, 0xc7,
+		0xf3, 0x73, 0x5c, 0x2c, 0x9e, 0xa7, 0xa6, 0x24, 0x2e, 0xa6, 0x38, 0x38, 0x91, 0x12, 0x00, 0xd1,
+		0x54, 0xad, 0xa3, 0x39, 0x18, 0xa4, 0x67, 0x8c, 0x4b, 0x7c, 0x9f, 0x31, 0x40, 0x7e, 0x56, 0xeb,
+		0xa9, 0xa0, 0x54, 0x7f, 0x2a, 0x28, 0x85, 0x0c, 0x18, 0x6d, 0x79, 0x8e, 0x63, 0x0a, 0x5f, 0x65,
+		0x40, 0xe1, 0xab, 0x10, 0x1d, 0xba, 0xe3, 0xf9, 0x71, 0xd6, 0x08, 0x5f, 0x65, 0x84, 0x20, 0xe1,
+		0x3f, 0xf4, 0xdf, 0x1c, 0x82, 0x25, 0x05, 0x17, 0xb9, 0xe2, 0xcd, 0x68, 0x48, 0xed, 0x7c, 0x1a,
+		0x52, 0xa9, 0xfd, 0x4a, 0xe7, 0xd7, 0x7e, 0x9f, 0x03, 0x24, 0xf8, 0x5b, 0x4f, 0xab, 0xdf, 0xc9,
+		0xa8, 0x47, 0x8c, 0x5e, 0x21, 0x0a, 0x4c, 0xa2, 0x7a, 0xfb, 0x88, 0x86, 0x4a, 0xe0, 0xcd, 0x68,
+		0xf4, 0xfe, 0xac, 0x46, 0x8f, 0xe5, 0xef, 0x06, 0x92, 0xf9, 0xbb, 0xbb, 0x50, 0xce, 0x84, 0x64,
+		0x84, 0x81, 0x30, 0x48, 0x0d, 0x84, 0xd9, 0x54, 0x9c, 0x45, 0xd8, 0x07, 0x06, 0x8c, 0x45, 0x19,
+		0x6b, 0x1a, 0xc4, 0x61, 0x29, 0xf0, 0x57, 0xf3, 0xa4, 0x71, 0xdf, 0xb7, 0xdc, 0x80, 0xa8, 0xb2,
+		0x44, 0x8c, 0x68, 0xb4, 0x1e, 0xfb, 0x85, 0x3e, 0x82, 0x2b, 0x92, 0x68, 0x5c, 0x47, 0x85, 0x0f,
+		0x77, 0xa3, 0xc2, 0xe7, 0x33, 0xc7, 0x3d, 0xd2, 0xe6, 0x39, 0xd6, 0x27, 0xe4, 0x59, 0x9f, 0x4b,
+		0x30, 0x9a, 0xd0, 0x79, 0x23, 0x54, 0xe7, 0x8d, 0x1c, 0xc4, 0x94, 0xdd, 0x06, 0x8c, 0x77, 0xb6,
+		0x95, 0x66, 0x2d, 0x47, 0x0b, 0xb3, 0x96, 0x63, 0x11, 0x04, 0xcd, 0x59, 0x7e, 0x01, 0x46, 0xc5,
+		0x5e, 0x53, 0x04, 0x63, 0x85, 0x08, 0x46, 0xf8, 0x78, 0x0a, 0x6e, 0xc1, 0xe0, 0xc7, 0x6d, 0x4c,
+		0x95, 0xec, 0x38, 0x0d, 0x73, 0xdd, 0xcf, 0x0d, 0x73, 0x15, 0x4a, 0x11, 0x0d, 0x51, 0xd8, 0x58,
+		0x24, 0xac, 0x39, 0xde, 0x8c, 0x2d, 0x38, 0x91, 0xb1, 0x05, 0x2b, 0x1f, 0xc1, 0x68, 0x1c, 0x56,
+		0x92, 0x39, 0xba, 0x1b, 0xcf, 0x1c, 0xe5, 0x85, 0x48, 0x84, 0x60, 0xb2, 0x50, 0x49, 0x2c, 0xbb,
+		0xf4, 0x37, 0x7d, 0x42, 0x95, 0x8a, 0xa8, 0xe8, 0xcf, 0x54, 0x69, 0x46, 0x95, 0xc6, 0x59, 0x23,
+		0x53, 0xa5, 0xa8, 0x05, 0x0b, 0x59, 0x83, 0xe1, 0xc0, 0xc7, 0xd6, 0x71, 0xdd, 0x3b, 0x75, 0xb9,
+		0x89, 0x74, 0x2b, 0x3f, 0x0e, 0x9b, 0x34, 0x11, 0xee, 0x09, 0x40, 0xa3, 0x1c, 0xe4, 0xf4, 0xe8,
+		0xbf, 0x5d, 0x82, 0x72, 0x1e, 0x18, 0x39, 0x57, 0xc1, 0x99, 0x5b, 0x33, 0x9b, 0x56, 0x48, 0x86,
+		0xd0, 0x2d, 0x1b, 0x32, 0x46, 0x48, 0xdb, 0x03, 0xd6, 0x84, 0xae, 0xc0, 0x70, 0xc3, 0xf3, 0x4f,
+		0x2d, 0xbf, 0xce, 0x83, 0x6b, 0x43, 0x46, 0xa7, 0x01, 0xbd, 0x0e, 0x73, 0x07, 0x56, 0xed, 0xd8,
+		0xf1, 0x0e, 0xcd, 0x53, 0xcb, 0x66, 0xb6, 0x8f, 0x69, 0xbb, 0x66, 0x33, 0x10, 0x26, 0x3f, 0xef,
+		0xfe, 0xd0, 0xb2, 0xa9, 0x45, 0x53, 0x75, 0x1f, 0x04, 0xe8, 0x2e, 0xcc, 0xfb, 0x56, 0x88, 0x1d,
+		0xbb, 0x69, 0x87, 0x19, 0x38, 0xb6, 0x47, 0x33, 0xd1, 0x80, 0x34, 0xa4, 0x1d, 0x78, 0x0e, 0xab,
+		0x7b, 0x4a, 0x43, 0x32, 0xc5, 0x3b, 0x13, 0x0d, 0x88, 0x43, 0xea, 0xff, 0xde, 0x27, 0x2e, 0x31,
+		0xe9, 0xf9, 0xe5, 0x97, 0xd8, 0xfb, 0x30, 0x91, 0xba, 0x24, 0x94, 0xd7, 0x18, 0x0f, 0x23, 0x51,
+		0x35, 0x6f, 0x8c, 0x27, 0x2f, 0x91, 0x8c, 0x5a, 0x29, 0xf5, 0xa6, 0x56, 0xf2, 0x6b, 0x3e, 0x3e,
+		0x82, 0xc5, 0xa4, 0xca, 0x33, 0xbd, 0x86, 0x19, 0x1e, 0xd9, 0x81, 0x19, 0x2f, 0x17, 0x53, 0x4f,
+		0x55, 0x49, 0xa8, 0xc0, 0x47, 0x8d, 0xfd, 0x23, 0x3b, 0xe0, 0x75, 0x1c, 0xa8, 0x0a, 0x53, 0x47,
+		0xd8, 0xf2, 0xc3, 0x03, 0x6c, 0x85, 0x66, 0x1d, 0x87, 0x96, 0xed, 0x04, 0x3c, 0xd4, 0xa6, 0x0e,
+		0xcd, 0x4e, 0x46, 0x60, 0x5b, 0x0c, 0x2a, 0x6b, 0x14, 0x0c, 0x9c, 0xcf, 0x28, 0xb8, 0x01, 0x13,
+		0x11, 0x1e, 0x9e, 0x4d, 0x1c, 0xa4, 0xf2, 0x1a, 0x99, 0xa4, 0x5b, 0xb4, 0x55, 0xff, 0x81, 0x06,
+		0x2f, 0xb0, 0xdd, 0x4c, 0xa8, 0x59, 0x9e, 0x5f, 0xe8, 0x68, 0x2a, 0x23, 0x1d, 0xce, 0xbd, 0x9b,
+		0x17, 0xce, 0x2d, 0x42, 0xd5, 0x65, 0xfe, 0xf7, 0x01, 0x4c, 0x63, 0xeb, 0x10, 0xfb, 0x22, 0x05,
+		0x75, 0x66, 0x06, 0x8e, 0x17, 0x06, 0x3c, 0x5d, 0x20, 0xb5, 0x68, 0x6e, 0xaf, 0x33, 0x8b, 0x06,
+		0x51, 0x40, 0x71, 0x6c, 0xf7, 0x08, 0x98, 0xfe, 0xd7, 0x7d, 0xf0, 0xa2, 0x9a, 0x38, 0x7e, 0xa2,
+		0x71, 0xc7, 0x90, 0xf1, 0x79, 0x1b, 0x5f, 0xf1, 0xdb, 0xe7, 0xbf, 0xa6, 0x8c, 0x89, 0x20, 0x25,
+		0x38, 0xdf, 0xd2, 0x60, 0xb1, 0x93, 0x5c, 0x23, 0xba, 0xad, 0x6e, 0x07, 0x2d, 0xa2, 0x43, 0x4c,
+		0xc7, 0xab, 0x59, 0x8e, 0x73, 0xc6, 0xcb, 0xb7, 0x3e, 0x52, 0xcc, 0x5a, 0xbc, 0x9c, 0xd5, 0x4e,
+		0xf6, 0x6d, 0xdf, 0xdb, 0xe2, 0x33, 0xec, 0xb2, 0x09, 0xd8, 0x9d, 0xb9, 0x60, 0xe5, 0x8f, 0xa8,
+		0xfc, 0x3a, 0x5c, 0x2f, 0x42, 0x20, 0xb9, 0x38, 0xb7, 0x92, 0x17, 0xa7, 0x3c, 0xb7, 0x27, 0xb6,
+		0x87, 0xe2, 0x12, 0x88, 0xa9, 0x89, 0x15, 0xbb, 0x44, 0xbf, 0xa1, 0x91, 0x4b, 0x34, 0xb3, 0xcc,
+		0x1d, 0xcb, 0x76, 0x3a, 0x47, 0xb3, 0xcb, 0xa4, 0x70, 0x11, 0x9e, 0x2e, 0xf3, 0x0d, 0x2f, 0x10,
+		0xb5, 0x98, 0x8b, 0x89, 0x67, 0x1d, 0xfe, 0x48, 0x03, 0x3d, 0xab, 0x3c, 0xdf, 0x13, 0xd2, 0x2e,
+		0x28, 0x7f, 0x92, 0xa6, 0xfc, 0xcd, 0x1c, 0xca, 0x8b, 0x30, 0x75, 0x49, 0xfb, 0x63, 0x22, 0xeb,
+		0x0a, 0x5c, 0xfc, 0x6c, 0xbe, 0x0c, 0x93, 0x35, 0xcb, 0xad, 0xe1, 0xe8, 0x2a, 0x8f, 0x6e, 0xba,
+		0x09, 0xd6, 0x6e, 0x88, 0x66, 0xfd, 0x4f, 0x3a, 0xea, 0x23, 0x8e, 0xf3, 0x82, 0xea, 0x43, 0x85,
+		0xaa, 0xcb, 0xa5, 0xbe, 0x14, 0x89, 0x7b, 0x0e, 0xb2, 0x58, 0xd9, 0x81, 0x64, 0xe0, 0x45, 0x4e,
+		0x58, 0x2e, 0x9e, 0x9e, 0x4f, 0x98, 0x0c, 0x53, 0xe2, 0x84, 0x65, 0x17, 0x48, 0xf7, 0xa7, 0x43,
+		0x79, 0xd7, 0x27, 0xac, 0x08, 0x53, 0x97, 0xb4, 0x2f, 0xcb, 0x8f, 0x43, 0x84, 0x8b, 0x53, 0xff,
+		0x3d, 0x0d, 0xae, 0x19, 0xb8, 0xe9, 0x9d, 0x60, 0x56, 0x81, 0xf5, 0x59, 0x09, 0xc8, 0x26, 0x2d,
+		0xdc, 0xbe, 0x74, 0x05, 0x8b, 0x4e, 0xce, 0x4a, 0x1e, 0xd5, 0x7c, 0x69, 0x7f, 0x5f, 0x82, 0x65,
+		0xbe, 0x04, 0xb6, 0xec, 0xdc, 0x62, 0x16, 0xe5, 0x02, 0x2d, 0x18, 0x4f, 0xca, 0x20, 0x5f, 0xdc,
+		0xdb, 0x39, 0xfb, 0xd7, 0xc5, 0x84, 0xc6, 0x58, 0x42, 0x7a, 0xd1, 0x01, 0xcc, 0x45, 0x15, 0x56,
+		0xd2, 0x72, 0x7c, 0x79, 0x29, 0xc9, 0x36, 0x87, 0x49, 0x95, 0x92, 0x60, 0x59, 0x73, 0xcf, 0xd5,
+		0x55, 0x2b, 0xf0, 0x52, 0xd1, 0x5a, 0x38, 0x9f, 0xff, 0x41, 0x83, 0x05, 0x61, 0xa7, 0x4b, 0x22,
+		0x32, 0xcf, 0xe5, 0xf8, 0xdc, 0x84, 0x29, 0x3b, 0x30, 0x93, 0xd5, 0xf1, 0x94, 0x97, 0x43, 0xc6,
+		0x84, 0x1d, 0xec, 0xc4, 0xeb, 0xde, 0xf5, 0x45, 0xb8, 0x22, 0x27, 0x9f, 0xaf, 0xef, 0xab, 0xd4,
+		0x60, 0x21, 0xca, 0x3a, 0x59, 0xfe, 0x92, 0x51, 0xad, 0xcf, 0x63, 0xa1, 0x4b, 0x30, 0xca, 0x9f,
+		0x3e, 0xe0, 0x7a, 0x2c, 0x28, 0x1f, 0xb5, 0x55, 0xeb, 0xe8, 0x43, 0x10, 0xc5, 0x28, 0xc4, 0x33,
+		0x88, 0xa6, 0xbe, 0xd4, 0xd3, 0xd4, 0x28, 0x42, 0xd1, 0x99, 0x7b, 0x17, 0x26, 0x63, 0x25, 0x33,
+		0xcc, 0xe7, 0xe8, 0xef, 0xd6, 0xe7, 0x98, 0xe8, 0x80, 0x32, 0xa7, 0xe3, 0x2a, 0x80, 0x30, 0xf7,
+		0x78, 0xcd, 0x5a, 0x9f, 0x31, 0xcc, 0x5b, 0xaa, 0x75, 0xfd, 0x06, 0x11, 0x66, 0xe5, 0x26, 0xf0,
+		0xed, 0xfa, 0x43, 0x0d, 0xae, 0xde, 0xc7, 0x61, 0xb6, 0x2e, 0x27, 0x78, 0x8e, 0xfb, 0xa4, 0xff,
+		0x57, 0x09, 0x16, 0xf3, 0xa8, 0xe2, 0x97, 0x7d, 0x15, 0x86, 0x3b, 0x65, 0x47, 0x5a, 0xef, 0xe5,
+		0xb9, 0x1d, 0xe8, 0x1f, 0x5b, 0xce, 0x67, 0x1f, 0x46, 0x3b, 0x11, 0xee, 0x36, 0x33, 0xf9, 0xcf,
+		0x15, 0xe2, 0x1e, 0xa9, 0x75, 0x7e, 0xa0, 0xb7, 0x00, 0x18, 0xd6, 0x2e, 0x4b, 0xfe, 0x87, 0xe9,
+		0x68, 0xea, 0x5a, 0x2e, 0xc3, 0xb8, 0x08, 0x27, 0x39, 0xd8, 0x3d, 0x0c, 0x8f, 0x44, 0x31, 0x11,
+		0x6f, 0xdd, 0xa5, 0x8d, 0xfa, 0xff, 0x68, 0x91, 0xc8, 0xe6, 0x3c, 0x4f, 0x78, 0x8e, 0x22, 0x3b,
+		0x0d, 0xfd, 0xb6, 0x5b, 0xc7, 0xcf, 0xb8, 0x73, 0xcc, 0x7e, 0xa0, 0x6d, 0xf1, 0xe0, 0xe2, 0x92,
+		0x2c, 0x58, 0x53, 0x5c, 0x70, 0xc6, 0xa0, 0x63, 0x62, 0x92, 0xb7, 0x70, 0x2e, 0x26, 0xff, 0x59,
+		0x82, 0xb2, 0xc1, 0x9f, 0xc4, 0x61, 0x2a, 0x81, 0xc1, 0xd3, 0xf5, 0xe7, 0xc9, 0x96, 0x5f, 0x81,
+		0x19, 0x59, 0xa5, 0x8c, 0x28, 0x77, 0xec, 0xa1, 0x54, 0xe6, 0x72, 0xb6, 0x54, 0x26, 0x40, 0x6f,
+		0xc0, 0x00, 0xd5, 0x50, 0x01, 0x67, 0xb0, 0x3c, 0x14, 0xbc, 0x65, 0x85, 0xd6, 0x3d, 0xc7, 0x3b,
+		0x30, 0xf8, 0x60, 0xb4, 0x09, 0xe3, 0x2e, 0x3e, 0x35, 0xfd, 0x36, 0x57, 0x70, 0x22, 0x9c, 0x50,
+		0x00, 0x3e, 0xea, 0xe2, 0x53, 0xa3, 0xcd, 0x34, 0x5b, 0xa0, 0x2f, 0xc0, 0xbc, 0x84, 0xd5, 0x7c,
+		0x23, 0xbe, 0xa6, 0xc1, 0xec, 0xde, 0x99, 0x5b, 0xdb, 0x3b, 0xb2, 0xfc, 0x3a, 0x17, 0x17, 0xbe,
+		0x0d, 0xcb, 0x30, 0x1e, 0x78, 0x6d, 0xbf, 0x86, 0x4d, 0xfe, 0x52, 0x92, 0xef, 0xc5, 0x18, 0x6b,
+		0xdd, 0x64, 0x8d, 0x68, 0x1e, 0x86, 0x02, 0x02, 0x2c, 0xcc, 0xc0, 0x7e, 0x63, 0x90, 0xfe, 0xae,
+		0xd6, 0xd1, 0x2a, 0x5c, 0xa2, 0x42, 0xd6, 0x57, 0x28, 0x64, 0x74, 0x9c, 0x3e, 0x0f, 0x73, 0x19,
+		0x5a, 0x38, 0x9d, 0xff, 0xdc, 0x0f, 0x97, 0x49, 0x9f, 0x30, 0x27, 0x9f, 0xe7, 0x59, 0x29, 0xc3,
+		0xa0, 0x88, 0xc0, 0xb3, 0x0b, 0x4f, 0xfc, 0xa4, 0x71, 0xc1, 0x28, 0xc2, 0x14, 0xc5, 0x4d, 0xa3,
+		0x38, 0x2b, 0xe1, 0x49, 0x36, 0xee, 0xde, 0xdf, 0x6b, 0xdc, 0x5d, 0x7d, 0x57, 0x65, 0xe2, 0x67,
+		0x83, 0xbd, 0xc5, 0xcf, 0xde, 0xe7, 0xd9, 0xee, 0x4e, 0x28, 0x8b, 0x62, 0x19, 0x2a, 0xc4, 0x42,
+		0x5f, 0x29, 0x45, 0x5e, 0x24, 0xc5, 0x75, 0x07, 0x06, 0x45, 0x1c, 0x6c, 0xb8, 0x8b, 0x38, 0x98,
+		0x18, 0x1c, 0x8f, 0xe1, 0x41, 0x32, 0x86, 0xf7, 0x2e, 0x8c, 0xc6, 0xdf, 0x49, 0xf1, 0x64, 0xb1,
+		0xfa, 0x3d, 0xe4, 0x48, 0xec, 0xf9, 0x14, 0x7a, 0x0d, 0xe8, 0x73, 0x46, 0xfe, 0x42, 0xd8, 0x8c,
+		0x8a, 0xd8, 0x47, 0xe9, 0xd9, 0x41, 0xa4, 0xef, 0x43, 0xda, 0x55, 0x15, 0xe5, 0xec, 0x0f, 0x61,
+		0x22, 0xa5, 0x1a, 0x78, 0xa6, 0x63, 0xb9, 0x2b, 0xa5, 0x60, 0x8c, 0x27, 0x15, 0x82, 0x3e, 0x0b,
+		0xd3, 0xc9, 0x93, 0x2c, 0xde, 0x0a, 0x68, 0xb0, 0x20, 0xca, 0xcc, 0x3f, 0x23, 0x8e, 0x90, 0xfe,
+		0xfb, 0x1a, 0x5c, 0x91, 0xd3, 0xc4, 0xcd, 0x86, 0xdb, 0x30, 0xdb, 0x64, 0xed, 0x2c, 0x0f, 0x6d,
+		0xda, 0xae, 0x59, 0xb3, 0x6a, 0x47, 0x98, 0x53, 0x78, 0xb9, 0x19, 0x83, 0xaa, 0xba, 0x9b, 0xa4,
+		0x0b, 0xbd, 0x05, 0xf3, 0x19, 0xa0, 0xba, 0x15, 0x5a, 0x07, 0x56, 0x20, 0xde, 0xe7, 0xcc, 0x26,
+		0xe1, 0xb6, 0x78, 0xaf, 0x7e, 0x05, 0x2a, 0x82, 0x1e, 0xce, 0xcf, 0xf7, 0xbc, 0xa8, 0x54, 0x54,
+		0xff, 0x8d, 0x52, 0x87, 0x85, 0x89, 0x6e, 0x4e, 0xed, 0x0a, 0x4c, 0xba, 0xed, 0xe6, 0x01, 0xf6,
+		0x4d, 0xaf, 0x61, 0x52, 0x2d, 0x15, 0x50, 0x3a, 0xfb, 0x8d, 0x71, 0xd6, 0xfe, 0xa8, 0x41, 0x95,
+		0x4f, 0x40, 0x98, 0x2d, 0xb4, 0x1a, 0x7b, 0x40, 0xd9, 0x6f, 0x0c, 0x71, 0xb5, 0x16, 0xa0, 0x2a,
+		0x8c, 0xf2, 0x9d, 0x60, 0x4b, 0x95, 0x3f, 0xbe, 0x10, 0xc7, 0x81, 0x45, 0x58, 0xe9, 0xca, 0xe9,
+		0x5d, 0x39, 0x52, 0xef, 0x34, 0xa0, 0x3b, 0x30, 0xc7, 0xe6, 0xa9, 0x79, 0x6e, 0xe8, 0x7b, 0x8e,
+		0x83, 0x7d, 0x61, 0xee, 0x5c, 0xa2, 0x8c, 0x98, 0xa1, 0xdd, 0x9b, 0x51, 0x2f, 0xb7, 0x62, 0x88,
+		0x84, 0xd4, 0xeb, 0x3e, 0x0e, 0x02, 0x9e, 0x80, 0x11, 0x3f, 0xf5, 0x55, 0x98, 0x62, 0xb6, 0x0f,
+		0x81, 0x13, 0x67, 0x27, 0xae, 0xa4, 0xb5, 0x84, 0x92, 0xd6, 0xa7, 0x01, 0xc5, 0xc7, 0xf3, 0xc3,
+		0xf8, 0xdf, 0x1a, 0x4c, 0x31, 0x1f, 0x37, 0xee, 0x4c, 0xe5, 0xa3, 0x41, 0xef, 0xf0, 0xaa, 0x97,
+		0xc8, 0xe0, 0x1b, 0x5f, 0xbf, 0x96, 0xc3, 0x10, 0x82, 0x91, 0x9a, 0x7b, 0xb4, 0xee, 0x85, 0x9a,
+		0x7a, 0xb1, 0x5c, 0x53, 0x5f, 0x22, 0xd7, 0xb4, 0x09, 0x13, 0x27, 0x76, 0x60, 0x1f, 0xd8, 0x8e,
+		0x1d, 0x9e, 0x75, 0x6b, 0xb2, 0x8d, 0x77, 0x40, 0xa8, 0x1a, 0x5a, 0x22, 0x86, 0x24, 0xbd, 0xad,
+		0x4c, 0xd7, 0xe2, 0x1a, 0x77, 0x98, 0x58, 0x85, 0xb4, 0xed, 0xa1, 0xd5, 0xc4, 0x84, 0x0b, 0xf1,
+		0xe5, 0x72, 0x2e, 0x7c, 0x9d, 0x72, 0x21, 0xc0, 0xe1, 0x93, 0x36, 0x6e, 0xe3, 0x2e, 0xb8, 0x90,
+		0x9e, 0xa9, 0x94, 0x99, 0x29, 0xc9, 0xa8, 0xbe, 0x1e, 0x19, 0xc5, 0xe8, 0xec, 0x10, 0xc4, 0xe9,
+		0xfc, 0xa6, 0x06, 0xd3, 0xe2, 0xdc, 0x7f, 0x66, 0x48, 0x7d, 0x04, 0x33, 0x29, 0x9a, 0xb8, 0x14,
+		0xde, 0x81, 0xb9, 0x96, 0xef, 0xd5, 0x70, 0x10, 0xd8, 0xee, 0xa1, 0x49, 0x3f, 0x9e, 0xc0, 0xf4,
+		0x00, 0x73, 0x3c, 0x86, 0x8d, 0x99, 0x4e, 0x37, 0x85, 0xa4, 0x4a, 0x20, 0xd0, 0xbf, 0xca, 0x7c,
+		0x2b, 0xa3, 0xf3, 0x29, 0x85, 0x07, 0x38, 0x08, 0xac, 0x43, 0x1c, 0x99, 0x2c, 0xef, 0xc2, 0x00,
+		0x4d, 0x78, 0x0b, 0x0f, 0xe6, 0x46, 0x0e, 0xb5, 0x31, 0x14, 0x34, 0x1b, 0x6e, 0x70, 0xb0, 0x2e,
+		0x98, 0x42, 0x74, 0xcc, 0x62, 0x1e, 0x15, 0x7c, 0x81, 0x1f, 0xc3, 0x38, 0xe3, 0x7a, 0x93, 0xf7,
+		0x70, 0x72, 0xde, 0xcf, 0x35, 0xab, 0xd5, 0x08, 0x57, 0xa9, 0x6c, 0x8a, 0x56, 0x16, 0xaf, 0x1f,
+		0x0b, 0xe2, 0x6d, 0x15, 0x07, 0x50, 0x76, 0x50, 0x3c, 0x26, 0xdf, 0xcf, 0x62, 0xf2, 0x5f, 0x4a,
+		0xc6, 0xe4, 0x6f, 0x16, 0x33, 0x28, 0x22, 0x26, 0x16, 0x8f, 0x6f, 0xc2, 0xf5, 0xfb, 0x38, 0xdc,
+		0xda, 0x7d, 0xa2, 0xd8, 0x8b, 0x2a, 0x00, 0x13, 0x69, 0xb7, 0xe1, 0x09, 0x06, 0x74, 0x31, 0x1d,
+		0x39, 0x48, 0x54, 0x4d, 0xd2, 0xa3, 0x47, 0xfe, 0x0a, 0xf4, 0x67, 0xb0, 0xa4, 0x98, 0x8e, 0x33,
+		0x7d, 0x0f, 0xa6, 0x62, 0x1f, 0xd9, 0xa0, 0xc5, 0x17, 0x62, 0xda, 0x97, 0xba, 0x9b, 0xd6, 0x98,
+		0xf4, 0x93, 0x0d, 0x81, 0xfe, 0x6f, 0x1a, 0x4c, 0x1b, 0xd8, 0x6a, 0xb5, 0x1c, 0x16, 0x38, 0x88,
+		0x56, 0xd7, 0x79, 0x9d, 0xa7, 0x25, 0x5e, 0xe7, 0x29, 0x73, 0x59, 0xff, 0x47, 0x4f, 0xf7, 0xce,
+		0xe7, 0x5c, 0xe8, 0x73, 0x30, 0x93, 0x5a, 0x1a, 0xd7, 0x26, 0xdf, 0xd1, 0x60, 0xc1, 0xc0, 0x0d,
+		0x1f, 0x07, 0x47, 0x91, 0x77, 0x4e, 0xb8, 0xf1, 0x19, 0x5c, 0xbb, 0xbe, 0x08, 0x57, 0xe4, 0xa4,
+		0xf2, 0xb5, 0xbc, 0x05, 0x73, 0xf4, 0x85, 0xcf, 0xd6, 0xee, 0x93, 0xf4, 0x01, 0x5d, 0x04, 0x68,
+		0x78, 0x7e, 0x0d, 0xef, 0xe0, 0xb0, 0x76, 0xc4, 0x13, 0x1b, 0xb1, 0x16, 0xdd, 0x82, 0x72, 0x16,
+		0x94, 0x1f, 0xb6, 0x6d, 0x18, 0xc4, 0x6e, 0x48, 0x6b, 0x57, 0xa4, 0xb1, 0x92, 0xe8, 0x88, 0x71,
+		0x2b, 0x64, 0x6b, 0xf7, 0x09, 0xc5, 0xc5, 0xeb, 0x53, 0x38, 0xac, 0xfe, 0x9d, 0x12, 0xcc, 0x1a,
+		0xd8, 0xaa, 0x4b, 0xa8, 0x5b, 0x87, 0x4b, 0x51, 0x35, 0xd8, 0xf8, 0xfa, 0x62, 0x9e, 0x6d, 0xb1,
+		0xfb, 0x84, 0x6a, 0x5d, 0x3a, 0x56, 0xe5, 0x8a, 0x65, 0x9d, 0xb9, 0x3e, 0x99, 0x33, 0xb7, 0x0f,
+		0x65, 0xdb, 0x25, 0x23, 0xec, 0x13, 0x6c, 0x62, 0x37, 0xd2, 0x60, 0x5d, 0x56, 0xd0, 0xce, 0x44,
+		0xc0, 0xdb, 0xae, 0x50, 0x45, 0xd5, 0x3a, 0x39, 0x18, 0x2d, 0x82, 0x84, 0xd6, 0xe0, 0xf4, 0x53,
+		0xc2, 0x86, 0x48, 0xc3, 0x9e, 0xfd, 0x09, 0x46, 0x2f, 0x01, 0xfd, 0x18, 0x82, 0x49, 0x47, 0xb0,
+		0x72, 0xa5, 0x01, 0x5a, 0xae, 0x44, 0xcb, 0xc3, 0x1e, 0x5b, 0x87, 0x98, 0x55, 0x2f, 0xff, 0x55,
+		0x09, 0xe6, 0x32, 0xbc, 0xe2, 0xdb, 0x71, 0x1e, 0x66, 0x49, 0xf5, 0x45, 0xe9, 0x62, 0xfa, 0x02,
+		0x7d, 0x05, 0x66, 0x33, 0x48, 0x45, 0x28, 0xbd, 0x57, 0x05, 0x38, 0x9d, 0xc6, 0x4e, 0x23, 0xe9,
+		0x12, 0x76, 0x5d, 0x92, 0xb1, 0xeb, 0x07, 0x1a, 0xcc, 0x3d, 0x6e, 0xfb, 0x87, 0xf8, 0xa7, 0xfb,
+		0x6c, 0xe9, 0x15, 0x28, 0x67, 0x97, 0xc9, 0x85, 0xff, 0xbb, 0x25, 0x98, 0x7b, 0x80, 0x7f, 0xea,
+		0x79, 0xf0, 0xe3, 0x91, 0xaf, 0x7b, 0x50, 0xce, 0xf2, 0x8a, 0xcb, 0x97, 0x04, 0x87, 0x26, 0xc3,
+		0xf1, 0xa9, 0x06, 0x57, 0x1e, 0x7a, 0xa1, 0xdd, 0x38, 0x23, 0xee, 0xb6, 0x77, 0x82, 0xfd, 0x07,
+		0x16, 0xf1, 0xa5, 0x23, 0xae, 0x7f, 0x05, 0x66, 0x1b, 0xbc, 0xc7, 0x6c, 0xd2, 0x2e, 0x33, 0x61,
+		0xb0, 0xe5, 0xc9, 0x47, 0x12, 0x1d, 0xb3, 0xd9, 0xa6, 0x1b, 0xd9, 0xc6, 0x40, 0xbf, 0x06, 0x57,
+		0x73, 0x28, 0xe0, 0x87, 0xc2, 0x82, 0x85, 0xfb, 0x38, 0xdc, 0xf4, 0xbd, 0x20, 0xe0, 0xbb, 0x92,
+		0xb8, 0xdc, 0x12, 0x8e, 0x9f, 0x96, 0x72, 0xfc, 0x96, 0x61, 0x3c, 0xb4, 0xfc, 0x43, 0x1c, 0x46,
+		0xbb, 0xcc, 0xae, 0xb9, 0x31, 0xd6, 0xca, 0xf1, 0xe9, 0x3f, 0xea, 0x83, 0x2b, 0xf2, 0x39, 0x38,
+		0x3f, 0x9b, 0x04, 0x0f, 0x51, 0x0d, 0x07, 0x67, 0xcc, 0x0d, 0xe5, 0xcb, 0xbf, 0xaf, 0x32, 0x10,
+		0x73, 0xd1, 0x51, 0xe3, 0x3b, 0xb8, 0x77, 0x46, 0x0d, 0x40, 0x76, 0xc3, 0x8c, 0x86, 0xb1, 0x26,
+		0xf4, 0xa9, 0x06, 0x33, 0x0d, 0x9a, 0x37, 0x36, 0x6b, 0x56, 0x3b, 0xc0, 0x9d, 0x69, 0x99, 0xbe,
+		0x7b, 0x70, 0xbe, 0x69, 0x59, 0x2a, 0x7a, 0x93, 0x60, 0x4c, 0x4c, 0x8e, 0x1a, 0x99, 0x8e, 0x4a,
+		0x0b, 0xa6, 0x32, 0x54, 0x4a, 0xcc, 0xd3, 0xed, 0xa4, 0x79, 0xba, 0x96, 0x73, 0x1c, 0xd2, 0x34,
+		0xf1, 0xcd, 0x8b, 0xdb, 0xa8, 0x95, 0x16, 0xcc, 0xe5, 0x10, 0x28, 0x99, 0xf7, 0xdd, 0xf8, 0xbc,
+		0xe3, 0xb9, 0xe1, 0xde, 0xfb, 0x38, 0xec, 0xe4, 0xe0, 0x29, 0xde, 0xb8, 0x55, 0xfc, 0x43, 0x0d,
+		0x56, 0x78, 0xd6, 0x3b, 0xc3, 0xb4, 0x4c, 0xba, 0x4e, 0xe1, 0x99, 0x75, 0x77, 0xca, 0xd0, 0x53,
+		0x76, 0x88, 0xa2, 0xf2, 0x24, 0x11, 0xab, 0xee, 0x9e, 0x69, 0xbc, 0x28, 0x69, 0x2c, 0x8c, 0xfd,
+		0x0a, 0xd0, 0x8b, 0x30, 0xd6, 0x20, 0x06, 0xd0, 0x43, 0xcc, 0x6c, 0x29, 0x9e, 0xa5, 0x4d, 0x36,
+		0xea, 0x3e, 0xbc, 0xdc, 0xc5, 0x5a, 0x23, 0x73, 0xa9, 0x5f, 0xd8, 0xe3, 0xe7, 0xdb, 0x56, 0x0a,
+		0xad, 0xbf, 0x41, 0xdf, 0xf0, 0x0a, 0xc1, 0xa6, 0x97, 0x64, 0x17, 0xb1, 0x31, 0x3d, 0xa4, 0xef,
+		0x54, 0x93, 0x60, 0x91, 0xe1, 0x30, 0xd3, 0xc9, 0x4e, 0x8a, 0x40, 0x4c, 0x9b, 0x57, 0x2f, 0xf6,
+		0x1b, 0x9d, 0xd4, 0xe5, 0x1e, 0x8b, 0xc2, 0xf0, 0x27, 0xe5, 0xe2, 0x13, 0x03, 0x3c, 0x84, 0xc4,
+		0xe2, 0x43, 0x63, 0xbc, 0x95, 0x45, 0x90, 0xf4, 0x2a, 0xcc, 0x1a, 0xa2, 0x16, 0x93, 0x7d, 0xef,
+		0x42, 0x10, 0xbb, 0x06, 0x97, 0xea, 0x56, 0x68, 0x71, 0x66, 0x2c, 0xe4, 0x15, 0x9e, 0x6f, 0xb8,
+		0x67, 0x06, 0x1d, 0xa8, 0xbf, 0x0f, 0x73, 0x19, 0x54, 0x7c, 0x01, 0xbd, 0xe2, 0xba, 0xf9, 0x43,
+		0x8d, 0x58, 0xc4, 0xd2, 0xec, 0x0c, 0x5a, 0x86, 0xa5, 0xcd, 0x47, 0x0f, 0x1e, 0xef, 0x6e, 0xef,
+		0x57, 0x1f, 0x3d, 0x34, 0x37, 0x37, 0x76, 0x77, 0xef, 0x6d, 0x6c, 0x7e, 0xd9, 0xdc, 0xdb, 0xdf,
+		0xd8, 0xdf, 0x36, 0xab, 0x0f, 0x9f, 0x6e, 0xec, 0x56, 0xb7, 0x26, 0x7f, 0x0e, 0xdd, 0x80, 0x17,
+		0xf2, 0x87, 0xed, 0x6d, 0xbe, 0xb7, 0xbd, 0xf5, 0xc1, 0xee, 0xf6, 0xd6, 0xa4, 0x86, 0x5e, 0x86,
+		0xe5, 0xfc, 0x81, 0xe4, 0xcf, 0xea, 0xc3, 0xfb, 0xe6, 0xa3, 0x9d, 0x9d, 0xc9, 0x52, 0x01, 0xce,
+		0x0f, 0x36, 0x37, 0xb7, 0xb7, 0xb7, 0xb6, 0xb7, 0x26, 0xfb, 0xd0, 0x2b, 0x70, 0x23, 0x7f, 0xe0,
+		0xd6, 0xf6, 0xc6, 0x96, 0xb9, 0xbb, 0xbd, 0xbf, 0xbf, 0x6d, 0x6c, 0x6f, 0x4d, 0x5e, 0x5a, 0xff,
+		0x8f, 0x5b, 0x00, 0xdc, 0x02, 0xdf, 0x78, 0x5c, 0x45, 0xbf, 0xab, 0xc1, 0xac, 0xfc, 0x03, 0x3f,
+		0xe8, 0xce, 0xf9, 0xbe, 0x2c, 0x57, 0x79, 0xb3, 0x67, 0x38, 0xbe, 0x71, 0xbf, 0xa7, 0xc1, 0x5c,
+		0xce, 0x17, 0xa6, 0xd0, 0x9b, 0x45, 0x5f, 0x67, 0xca, 0xa3, 0xe6, 0x6e, 0xef, 0x80, 0x9c, 0x9c,
+		0x6f, 0x6b, 0x70, 0xbd, 0xe8, 0x2b, 0x48, 0xe8, 0x4b, 0x17, 0xfd, 0x6a, 0x54, 0x65, 0xe3, 0x02,
+		0x18, 0x38, 0xa5, 0x64, 0x13, 0xe5, 0xdf, 0x37, 0x52, 0x6c, 0xa2, 0xf2, 0xbb, 0x4a, 0x8a, 0x4d,
+		0x2c, 0xf8, 0x90, 0xd2, 0x1f, 0x6b, 0x50, 0xc9, 0xff, 0x0a, 0x10, 0xca, 0xaf, 0x14, 0x2d, 0xfc,
+		0x3a, 0x52, 0xe5, 0xf3, 0xe7, 0x82, 0xe5, 0x74, 0xfd, 0x1a, 0xa0, 0xec, 0x27, 0x76, 0xd0, 0x7a,
+		0x2e, 0xca, 0xdc, 0x4f, 0x14, 0x55, 0x6e, 0xf7, 0x04, 0xc3, 0xa7, 0xff, 0xa6, 0x06, 0xf3, 0xb9,
+		0x1f, 0xcc, 0x41, 0x6f, 0xe5, 0xa2, 0x2c, 0xfa, 0x5e, 0x4f, 0xe5, 0xed, 0xf3, 0x80, 0x72, 0xa2,
+		0x5c, 0x18, 0x4b, 0x7c, 0x4c, 0x03, 0xbd, 0x9a, 0x8b, 0x4c, 0xf6, 0xcd, 0x8e, 0xca, 0x6a, 0xb7,
+		0xc3, 0xf9, 0x7c, 0x9f, 0x6a, 0x70, 0x59, 0xf2, 0x45, 0x0a, 0x74, 0x5b, 0x7d, 0xd8, 0xa4, 0xdf,
+		0xc0, 0xa8, 0xbc, 0xde, 0x1b, 0x10, 0x27, 0x21, 0x84, 0x89, 0xd4, 0x07, 0x1a, 0xd0, 0x9a, 0xca,
+		0xd4, 0x93, 0x64, 0x9d, 0x2a, 0xaf, 0x75, 0x0f, 0xc0, 0x67, 0x3d, 0x85, 0xc9, 0xf4, 0x2b, 0x63,
+		0x94, 0x8f, 0x25, 0xe7, 0x1d, 0x76, 0xe5, 0x56, 0x0f, 0x10, 0xb1, 0x63, 0x97, 0x5b, 0x82, 0xad,
+		0x38, 0x76, 0x45, 0x2f, 0x1d, 0x2b, 0x17, 0xa8, 0xf8, 0x46, 0x7f, 0xa6, 0xc1, 0x15, 0x55, 0x85,
+		0x36, 0x7a, 0xe7, 0x9c, 0x85, 0xdd, 0x8c, 0xb4, 0x2f, 0x5c, 0xa8, 0x2c, 0x9c, 0xb3, 0x2c, 0xa7,
+		0x8c, 0x59, 0xc9, 0x32, 0x75, 0x11, 0xb5, 0x92, 0x65, 0x05, 0x55, 0xd3, 0xb1, 0x7d, 0x94, 0x3c,
+		0x39, 0x29, 0xdc, 0xc7, 0xfc, 0x67, 0x56, 0x85, 0xfb, 0xa8, 0x7a, 0xe1, 0x12, 0xdb, 0x47, 0x69,
+		0x25, 0x71, 0xf1, 0x3e, 0xaa, 0xaa, 0x99, 0x8b, 0xf7, 0x51, 0x59, 0xbe, 0x1c, 0xdf, 0xc7, 0x6c,
+		0xb1, 0x70, 0xf1, 0x3e, 0xe6, 0x96, 0x2a, 0x17, 0xef, 0x63, 0x7e, 0x6d, 0x32, 0xfa, 0x53, 0x1a,
+		0x47, 0xce, 0xad, 0x02, 0x46, 0x9f, 0xef, 0x69, 0xcd, 0xc9, 0x3a, 0xe4, 0xca, 0x3b, 0xe7, 0x03,
+		0x4e, 0x90, 0x96, 0x5b, 0x02, 0xaf, 0x24, 0xad, 0xa8, 0x08, 0x5f, 0x49, 0x5a, 0x71, 0xd5, 0xfd,
+		0x5f, 0x68, 0xb0, 0xa8, 0xae, 0x7d, 0x45, 0x5f, 0x54, 0x4c, 0xd0, 0x45, 0x01, 0x70, 0xe5, 0xdd,
+		0x73, 0xc3, 0x73, 0x1a, 0xbf, 0xae, 0x41, 0x39, 0xaf, 0x02, 0x1a, 0xdd, 0x55, 0x60, 0x57, 0x96,
+		0x7a, 0x57, 0xde, 0x3a, 0x07, 0x24, 0xa7, 0xe8, 0xab, 0x1a, 0x4c, 0xcb, 0xea, 0x68, 0xd1, 0xeb,
+		0x85, 0x8f, 0x02, 0x25, 0x55, 0xc3, 0x95, 0x37, 0x7a, 0x84, 0xe2, 0x54, 0xfc, 0x39, 0xfd, 0x0e,
+		0xa8, 0xa2, 0x4e, 0x14, 0x7d, 0xa1, 0xe0, 0x6c, 0xa8, 0x8b, 0x7c, 0x2b, 0x5f, 0x3c, 0x2f, 0x78,
+		0xcc, 0x78, 0x96, 0x17, 0x82, 0x2a, 0x8c, 0x67, 0x65, 0x3d, 0xab, 0xc2, 0x78, 0x2e, 0xa8, 0x38,
+		0x8d, 0x31, 0x2b, 0xc7, 0x1f, 0x2d, 0x64, 0x96, 0xb2, 0xbc, 0xb2, 0x98, 0x59, 0xea, 0x22, 0x45,
+		0xf4, 0x09, 0x4c, 0x65, 0x0a, 0xe7, 0xd0, 0x2d, 0x05, 0x52, 0x79, 0x3d, 0x63, 0x65, 0xbd, 0x17,
+		0x90, 0x8e, 0xe9, 0x96, 0x2a, 0x85, 0x53, 0x98, 0x6e, 0xf2, 0x02, 0x3e, 0x85, 0xe9, 0x96, 0x53,
+		0x65, 0x87, 0x8e, 0x61, 0x34, 0x5e, 0x9a, 0x84, 0x3e, 0xa7, 0xc4, 0x90, 0xaa, 0xc5, 0xab, 0xbc,
+		0xda, 0xe5, 0xe8, 0x98, 0xc8, 0xca, 0x6a, 0x8b, 0x14, 0x22, 0xab, 0x28, 0x8f, 0x52, 0x88, 0xac,
+		0xb2, 0x80, 0x89, 0x98, 0xe9, 0x92, 0x92, 0x21, 0x85, 0x99, 0x9e, 0x5f, 0x7f, 0x54, 0x79, 0xbd,
+		0x37, 0xa0, 0xe8, 0xa9, 0x21, 0x74, 0x2a, 0x70, 0xd0, 0xcd, 0xfc, 0xda, 0xdb, 0x74, 0x59, 0x4f,
+		0xe5, 0x95, 0xae, 0xc6, 0x76, 0xa6, 0xe9, 0x94, 0xb8, 0x28, 0xa6, 0xc9, 0x94, 0xfd, 0x28, 0xa6,
+		0xc9, 0xd6, 0xcc, 0xb0, 0x69, 0x44, 0x85, 0x8a, 0x72, 0x9a, 0x54, 0x5d, 0x8d, 0x72, 0x9a, 0x74,
+		0xc9, 0x0b, 0x71, 0xe7, 0x12, 0xd5, 0x25, 0x0a, 0x77, 0x4e, 0x56, 0x19, 0xa3, 0x70, 0xe7, 0xe4,
+		0x45, 0x2b, 0x5c, 0x73, 0x4a, 0x2a, 0x10, 0xd4, 0x9a, 0x33, 0xbf, 0x42, 0x42, 0xad, 0x39, 0x55,
+		0xa5, 0x0e, 0xc4, 0xda, 0xcb, 0x2d, 0x88, 0x50, 0x58, 0x7b, 0x45, 0x35, 0x1b, 0x0a, 0x6b, 0xaf,
+		0xb8, 0xfe, 0xc2, 0x85, 0xb1, 0x44, 0x39, 0x81, 0x62, 0x43, 0x64, 0x15, 0x15, 0x8a, 0x0d, 0x91,
+		0x56, 0x29, 0x50, 0xf5, 0x21, 0x4b, 0xfd, 0x23, 0x95, 0xaf, 0x9c, 0x5b, 0xd4, 0xa0, 0x50, 0x1f,
+		0xaa, 0xfa, 0x02, 0xe2, 0xec, 0xa6, 0x8b, 0x04, 0x90, 0xea, 0xdf, 0x15, 0x48, 0x4b, 0x11, 0x14,
+		0xce, 0x6e, 0x6e, 0x05, 0x42, 0x08, 0x13, 0xa9, 0x6c, 0xb8, 0xe2, 0x82, 0x90, 0xd7, 0x18, 0x28,
+		0x2e, 0x88, 0xbc, 0x44, 0x3b, 0xf1, 0xed, 0x53, 0xd9, 0x56, 0x95, 0x6f, 0x2f, 0xcf, 0x3f, 0xab,
+		0x7c, 0xfb, 0x9c, 0x54, 0x2e, 0x99, 0x38, 0x9d, 0x9d, 0x54, 0x4c, 0x9c, 0x93, 0xf4, 0x55, 0x4c,
+		0x9c, 0x9b, 0xfa, 0xfc, 0x1d, 0x0d, 0x66, 0xa4, 0x09, 0x45, 0x94, 0x7f, 0x62, 0x54, 0x29, 0xd0,
+		0xca, 0x9d, 0x5e, 0xc1, 0x62, 0xe7, 0x5d, 0x96, 0x8e, 0x53, 0x9c, 0x77, 0x45, 0x9e, 0x53, 0x71,
+		0xde, 0x95, 0x99, 0xcb, 0xef, 0x6a, 0xd1, 0xab, 0xd4, 0xfc, 0xbc, 0x0f, 0xda, 0x28, 0x72, 0xce,
+		0x0a, 0xf3, 0x63, 0x95, 0x7b, 0x17, 0x41, 0x91, 0x88, 0x7f, 0xc5, 0x13, 0x3f, 0xea, 0xf8, 0x97,
+		0x24, 0xb3, 0xa4, 0x8e, 0x7f, 0x49, 0x73, 0x4a, 0x44, 0x32, 0x93, 0xd9, 0x1a, 0x95, 0x64, 0x4a,
+		0x53, 0x44, 0x2a, 0xc9, 0x94, 0x27, 0x82, 0xee, 0xbd, 0xf5, 0x8b, 0x6f, 0x1e, 0xda, 0xe1, 0x51,
+		0xfb, 0x60, 0xb5, 0xe6, 0x35, 0xd7, 0x12, 0xff, 0x13, 0x6a, 0xf5, 0x10, 0xbb, 0xec, 0x1f, 0x84,
+		0xc5, 0xfe, 0x43, 0xd9, 0xe7, 0xf9, 0x9f, 0x27, 0xb7, 0x0e, 0x06, 0x68, 0xdf, 0xed, 0xff, 0x0d,
+		0x00, 0x00, 0xff, 0xff, 0xa4, 0x72, 0x29, 0xc8, 0xcd, 0x6c, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -28,6 +28,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type TaskListScalingConfidence int32
+
+const (
+	TaskListScalingConfidence_TASK_LIST_SCALING_CONFIDENCE_INVALID TaskListScalingConfidence = 0
+	TaskListScalingConfidence_TASK_LIST_SCALING_CONFIDENCE_LOW     TaskListScalingConfidence = 1
+	TaskListScalingConfidence_TASK_LIST_SCALING_CONFIDENCE_MEDIUM  TaskListScalingConfidence = 2
+	TaskListScalingConfidence_TASK_LIST_SCALING_CONFIDENCE_HIGH    TaskListScalingConfidence = 3
+)
+
+var TaskListScalingConfidence_name = map[int32]string{
+	0: "TASK_LIST_SCALING_CONFIDENCE_INVALID",
+	1: "TASK_LIST_SCALING_CONFIDENCE_LOW",
+	2: "TASK_LIST_SCALING_CONFIDENCE_MEDIUM",
+	3: "TASK_LIST_SCALING_CONFIDENCE_HIGH",
+}
+
+var TaskListScalingConfidence_value = map[string]int32{
+	"TASK_LIST_SCALING_CONFIDENCE_INVALID": 0,
+	"TASK_LIST_SCALING_CONFIDENCE_LOW":     1,
+	"TASK_LIST_SCALING_CONFIDENCE_MEDIUM":  2,
+	"TASK_LIST_SCALING_CONFIDENCE_HIGH":    3,
+}
+
+func (x TaskListScalingConfidence) String() string {
+	return proto.EnumName(TaskListScalingConfidence_name, int32(x))
+}
+
+func (TaskListScalingConfidence) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{0}
+}
+
 type TaskListPartition struct {
 	IsolationGroups      []string `protobuf:"bytes,1,rep,name=isolation_groups,json=isolationGroups,proto3" json:"isolation_groups,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -211,6 +242,164 @@ func (m *LoadBalancerHints) GetRatePerSecond() float64 {
 	return 0
 }
 
+type TaskListVersioningConfig struct {
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The last set is the default set of the task list.
+	CompatibleSets       []*CompatibleBuildIdSet `protobuf:"bytes,2,rep,name=compatible_sets,json=compatibleSets,proto3" json:"compatible_sets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *TaskListVersioningConfig) Reset()         { *m = TaskListVersioningConfig{} }
+func (m *TaskListVersioningConfig) String() string { return proto.CompactTextString(m) }
+func (*TaskListVersioningConfig) ProtoMessage()    {}
+func (*TaskListVersioningConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{3}
+}
+func (m *TaskListVersioningConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskListVersioningConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskListVersioningConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskListVersioningConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskListVersioningConfig.Merge(m, src)
+}
+func (m *TaskListVersioningConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskListVersioningConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskListVersioningConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskListVersioningConfig proto.InternalMessageInfo
+
+func (m *TaskListVersioningConfig) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *TaskListVersioningConfig) GetCompatibleSets() []*CompatibleBuildIdSet {
+	if m != nil {
+		return m.CompatibleSets
+	}
+	return nil
+}
+
+type CompatibleBuildIdSet struct {
+	BuildIds             []string `protobuf:"bytes,1,rep,name=build_ids,json=buildIds,proto3" json:"build_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompatibleBuildIdSet) Reset()         { *m = CompatibleBuildIdSet{} }
+func (m *CompatibleBuildIdSet) String() string { return proto.CompactTextString(m) }
+func (*CompatibleBuildIdSet) ProtoMessage()    {}
+func (*CompatibleBuildIdSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{4}
+}
+func (m *CompatibleBuildIdSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompatibleBuildIdSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompatibleBuildIdSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompatibleBuildIdSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompatibleBuildIdSet.Merge(m, src)
+}
+func (m *CompatibleBuildIdSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompatibleBuildIdSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompatibleBuildIdSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompatibleBuildIdSet proto.InternalMessageInfo
+
+func (m *CompatibleBuildIdSet) GetBuildIds() []string {
+	if m != nil {
+		return m.BuildIds
+	}
+	return nil
+}
+
+type BuildIdMetrics struct {
+	BacklogCountHint     int64    `protobuf:"varint,1,opt,name=backlog_count_hint,json=backlogCountHint,proto3" json:"backlog_count_hint,omitempty"`
+	PollerCount          int64    `protobuf:"varint,2,opt,name=poller_count,json=pollerCount,proto3" json:"poller_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BuildIdMetrics) Reset()         { *m = BuildIdMetrics{} }
+func (m *BuildIdMetrics) String() string { return proto.CompactTextString(m) }
+func (*BuildIdMetrics) ProtoMessage()    {}
+func (*BuildIdMetrics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{5}
+}
+func (m *BuildIdMetrics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BuildIdMetrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BuildIdMetrics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BuildIdMetrics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildIdMetrics.Merge(m, src)
+}
+func (m *BuildIdMetrics) XXX_Size() int {
+	return m.Size()
+}
+func (m *BuildIdMetrics) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildIdMetrics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildIdMetrics proto.InternalMessageInfo
+
+func (m *BuildIdMetrics) GetBacklogCountHint() int64 {
+	if m != nil {
+		return m.BacklogCountHint
+	}
+	return 0
+}
+
+func (m *BuildIdMetrics) GetPollerCount() int64 {
+	if m != nil {
+		return m.PollerCount
+	}
+	return 0
+}
+
 type PollForDecisionTaskRequest struct {
 	Request              *v1.PollForDecisionTaskRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	DomainId             string                         `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -226,7 +415,7 @@ func (m *PollForDecisionTaskRequest) Reset()         { *m = PollForDecisionTaskR
 func (m *PollForDecisionTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*PollForDecisionTaskRequest) ProtoMessage()    {}
 func (*PollForDecisionTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{3}
+	return fileDescriptor_826e827d3aabf7fc, []int{6}
 }
 func (m *PollForDecisionTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -321,7 +510,7 @@ func (m *PollForDecisionTaskResponse) Reset()         { *m = PollForDecisionTask
 func (m *PollForDecisionTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*PollForDecisionTaskResponse) ProtoMessage()    {}
 func (*PollForDecisionTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{4}
+	return fileDescriptor_826e827d3aabf7fc, []int{7}
 }
 func (m *PollForDecisionTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -512,7 +701,7 @@ func (m *PollForActivityTaskRequest) Reset()         { *m = PollForActivityTaskR
 func (m *PollForActivityTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*PollForActivityTaskRequest) ProtoMessage()    {}
 func (*PollForActivityTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{5}
+	return fileDescriptor_826e827d3aabf7fc, []int{8}
 }
 func (m *PollForActivityTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -605,7 +794,7 @@ func (m *PollForActivityTaskResponse) Reset()         { *m = PollForActivityTask
 func (m *PollForActivityTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*PollForActivityTaskResponse) ProtoMessage()    {}
 func (*PollForActivityTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{6}
+	return fileDescriptor_826e827d3aabf7fc, []int{9}
 }
 func (m *PollForActivityTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -776,6 +965,7 @@ type AddDecisionTaskRequest struct {
 	Source                 v11.TaskSource        `protobuf:"varint,6,opt,name=source,proto3,enum=uber.cadence.shared.v1.TaskSource" json:"source,omitempty"`
 	ForwardedFrom          string                `protobuf:"bytes,7,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
 	PartitionConfig        map[string]string     `protobuf:"bytes,8,rep,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	BuildId                string                `protobuf:"bytes,9,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{}              `json:"-"`
 	XXX_unrecognized       []byte                `json:"-"`
 	XXX_sizecache          int32                 `json:"-"`
@@ -785,7 +975,7 @@ func (m *AddDecisionTaskRequest) Reset()         { *m = AddDecisionTaskRequest{}
 func (m *AddDecisionTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*AddDecisionTaskRequest) ProtoMessage()    {}
 func (*AddDecisionTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{7}
+	return fileDescriptor_826e827d3aabf7fc, []int{10}
 }
 func (m *AddDecisionTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -870,6 +1060,13 @@ func (m *AddDecisionTaskRequest) GetPartitionConfig() map[string]string {
 	return nil
 }
 
+func (m *AddDecisionTaskRequest) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
+	}
+	return ""
+}
+
 type AddDecisionTaskResponse struct {
 	PartitionConfig      *TaskListPartitionConfig `protobuf:"bytes,1,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
@@ -881,7 +1078,7 @@ func (m *AddDecisionTaskResponse) Reset()         { *m = AddDecisionTaskResponse
 func (m *AddDecisionTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*AddDecisionTaskResponse) ProtoMessage()    {}
 func (*AddDecisionTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{8}
+	return fileDescriptor_826e827d3aabf7fc, []int{11}
 }
 func (m *AddDecisionTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -937,7 +1134,7 @@ func (m *AddActivityTaskRequest) Reset()         { *m = AddActivityTaskRequest{}
 func (m *AddActivityTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*AddActivityTaskRequest) ProtoMessage()    {}
 func (*AddActivityTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{9}
+	return fileDescriptor_826e827d3aabf7fc, []int{12}
 }
 func (m *AddActivityTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1053,7 +1250,7 @@ func (m *ActivityTaskDispatchInfo) Reset()         { *m = ActivityTaskDispatchIn
 func (m *ActivityTaskDispatchInfo) String() string { return proto.CompactTextString(m) }
 func (*ActivityTaskDispatchInfo) ProtoMessage()    {}
 func (*ActivityTaskDispatchInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{10}
+	return fileDescriptor_826e827d3aabf7fc, []int{13}
 }
 func (m *ActivityTaskDispatchInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1142,7 +1339,7 @@ func (m *AddActivityTaskResponse) Reset()         { *m = AddActivityTaskResponse
 func (m *AddActivityTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*AddActivityTaskResponse) ProtoMessage()    {}
 func (*AddActivityTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{11}
+	return fileDescriptor_826e827d3aabf7fc, []int{14}
 }
 func (m *AddActivityTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1192,7 +1389,7 @@ func (m *QueryWorkflowRequest) Reset()         { *m = QueryWorkflowRequest{} }
 func (m *QueryWorkflowRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryWorkflowRequest) ProtoMessage()    {}
 func (*QueryWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{12}
+	return fileDescriptor_826e827d3aabf7fc, []int{15}
 }
 func (m *QueryWorkflowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1262,7 +1459,7 @@ func (m *QueryWorkflowResponse) Reset()         { *m = QueryWorkflowResponse{} }
 func (m *QueryWorkflowResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryWorkflowResponse) ProtoMessage()    {}
 func (*QueryWorkflowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{13}
+	return fileDescriptor_826e827d3aabf7fc, []int{16}
 }
 func (m *QueryWorkflowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1326,7 +1523,7 @@ func (m *RespondQueryTaskCompletedRequest) Reset()         { *m = RespondQueryTa
 func (m *RespondQueryTaskCompletedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondQueryTaskCompletedRequest) ProtoMessage()    {}
 func (*RespondQueryTaskCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{14}
+	return fileDescriptor_826e827d3aabf7fc, []int{17}
 }
 func (m *RespondQueryTaskCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1393,7 +1590,7 @@ func (m *RespondQueryTaskCompletedResponse) Reset()         { *m = RespondQueryT
 func (m *RespondQueryTaskCompletedResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondQueryTaskCompletedResponse) ProtoMessage()    {}
 func (*RespondQueryTaskCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{15}
+	return fileDescriptor_826e827d3aabf7fc, []int{18}
 }
 func (m *RespondQueryTaskCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1436,7 +1633,7 @@ func (m *CancelOutstandingPollRequest) Reset()         { *m = CancelOutstandingP
 func (m *CancelOutstandingPollRequest) String() string { return proto.CompactTextString(m) }
 func (*CancelOutstandingPollRequest) ProtoMessage()    {}
 func (*CancelOutstandingPollRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{16}
+	return fileDescriptor_826e827d3aabf7fc, []int{19}
 }
 func (m *CancelOutstandingPollRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1503,7 +1700,7 @@ func (m *CancelOutstandingPollResponse) Reset()         { *m = CancelOutstanding
 func (m *CancelOutstandingPollResponse) String() string { return proto.CompactTextString(m) }
 func (*CancelOutstandingPollResponse) ProtoMessage()    {}
 func (*CancelOutstandingPollResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{17}
+	return fileDescriptor_826e827d3aabf7fc, []int{20}
 }
 func (m *CancelOutstandingPollResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1544,7 +1741,7 @@ func (m *DescribeTaskListRequest) Reset()         { *m = DescribeTaskListRequest
 func (m *DescribeTaskListRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeTaskListRequest) ProtoMessage()    {}
 func (*DescribeTaskListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{18}
+	return fileDescriptor_826e827d3aabf7fc, []int{21}
 }
 func (m *DescribeTaskListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1588,20 +1785,26 @@ func (m *DescribeTaskListRequest) GetDomainId() string {
 }
 
 type DescribeTaskListResponse struct {
-	Pollers              []*v1.PollerInfo            `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
-	TaskListStatus       *v1.TaskListStatus          `protobuf:"bytes,2,opt,name=task_list_status,json=taskListStatus,proto3" json:"task_list_status,omitempty"`
-	PartitionConfig      *v1.TaskListPartitionConfig `protobuf:"bytes,3,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
-	TaskList             *v1.TaskList                `protobuf:"bytes,4,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	Pollers          []*v1.PollerInfo            `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
+	TaskListStatus   *v1.TaskListStatus          `protobuf:"bytes,2,opt,name=task_list_status,json=taskListStatus,proto3" json:"task_list_status,omitempty"`
+	PartitionConfig  *v1.TaskListPartitionConfig `protobuf:"bytes,3,opt,name=partition_config,json=partitionConfig,proto3" json:"partition_config,omitempty"`
+	TaskList         *v1.TaskList                `protobuf:"bytes,4,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	VersioningConfig *TaskListVersioningConfig   `protobuf:"bytes,5,opt,name=versioning_config,json=versioningConfig,proto3" json:"versioning_config,omitempty"`
+	BuildIdMetrics   map[string]*BuildIdMetrics  `protobuf:"bytes,6,rep,name=build_id_metrics,json=buildIdMetrics,proto3" json:"build_id_metrics,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The dispatch metrics below are not part of the public TaskListStatus yet.
+	DispatchedTasksPerSecond   float64  `protobuf:"fixed64,7,opt,name=dispatched_tasks_per_second,json=dispatchedTasksPerSecond,proto3" json:"dispatched_tasks_per_second,omitempty"`
+	SyncMatchRatio             float64  `protobuf:"fixed64,8,opt,name=sync_match_ratio,json=syncMatchRatio,proto3" json:"sync_match_ratio,omitempty"`
+	ScheduleToStartLatencyInMs int64    `protobuf:"varint,9,opt,name=schedule_to_start_latency_in_ms,json=scheduleToStartLatencyInMs,proto3" json:"schedule_to_start_latency_in_ms,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
+	XXX_unrecognized           []byte   `json:"-"`
+	XXX_sizecache              int32    `json:"-"`
 }
 
 func (m *DescribeTaskListResponse) Reset()         { *m = DescribeTaskListResponse{} }
 func (m *DescribeTaskListResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeTaskListResponse) ProtoMessage()    {}
 func (*DescribeTaskListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{19}
+	return fileDescriptor_826e827d3aabf7fc, []int{22}
 }
 func (m *DescribeTaskListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1658,6 +1861,41 @@ func (m *DescribeTaskListResponse) GetTaskList() *v1.TaskList {
 	return nil
 }
 
+func (m *DescribeTaskListResponse) GetVersioningConfig() *TaskListVersioningConfig {
+	if m != nil {
+		return m.VersioningConfig
+	}
+	return nil
+}
+
+func (m *DescribeTaskListResponse) GetBuildIdMetrics() map[string]*BuildIdMetrics {
+	if m != nil {
+		return m.BuildIdMetrics
+	}
+	return nil
+}
+
+func (m *DescribeTaskListResponse) GetDispatchedTasksPerSecond() float64 {
+	if m != nil {
+		return m.DispatchedTasksPerSecond
+	}
+	return 0
+}
+
+func (m *DescribeTaskListResponse) GetSyncMatchRatio() float64 {
+	if m != nil {
+		return m.SyncMatchRatio
+	}
+	return 0
+}
+
+func (m *DescribeTaskListResponse) GetScheduleToStartLatencyInMs() int64 {
+	if m != nil {
+		return m.ScheduleToStartLatencyInMs
+	}
+	return 0
+}
+
 type ListTaskListPartitionsRequest struct {
 	Domain               string       `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	TaskList             *v1.TaskList `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
@@ -1670,7 +1908,7 @@ func (m *ListTaskListPartitionsRequest) Reset()         { *m = ListTaskListParti
 func (m *ListTaskListPartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListTaskListPartitionsRequest) ProtoMessage()    {}
 func (*ListTaskListPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{20}
+	return fileDescriptor_826e827d3aabf7fc, []int{23}
 }
 func (m *ListTaskListPartitionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1725,7 +1963,7 @@ func (m *ListTaskListPartitionsResponse) Reset()         { *m = ListTaskListPart
 func (m *ListTaskListPartitionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListTaskListPartitionsResponse) ProtoMessage()    {}
 func (*ListTaskListPartitionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{21}
+	return fileDescriptor_826e827d3aabf7fc, []int{24}
 }
 func (m *ListTaskListPartitionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1779,7 +2017,7 @@ func (m *GetTaskListsByDomainRequest) Reset()         { *m = GetTaskListsByDomai
 func (m *GetTaskListsByDomainRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTaskListsByDomainRequest) ProtoMessage()    {}
 func (*GetTaskListsByDomainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{22}
+	return fileDescriptor_826e827d3aabf7fc, []int{25}
 }
 func (m *GetTaskListsByDomainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1827,7 +2065,7 @@ func (m *GetTaskListsByDomainResponse) Reset()         { *m = GetTaskListsByDoma
 func (m *GetTaskListsByDomainResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTaskListsByDomainResponse) ProtoMessage()    {}
 func (*GetTaskListsByDomainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{23}
+	return fileDescriptor_826e827d3aabf7fc, []int{26}
 }
 func (m *GetTaskListsByDomainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1884,7 +2122,7 @@ func (m *UpdateTaskListPartitionConfigRequest) Reset()         { *m = UpdateTask
 func (m *UpdateTaskListPartitionConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateTaskListPartitionConfigRequest) ProtoMessage()    {}
 func (*UpdateTaskListPartitionConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{24}
+	return fileDescriptor_826e827d3aabf7fc, []int{27}
 }
 func (m *UpdateTaskListPartitionConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1951,7 +2189,7 @@ func (m *UpdateTaskListPartitionConfigResponse) Reset()         { *m = UpdateTas
 func (m *UpdateTaskListPartitionConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateTaskListPartitionConfigResponse) ProtoMessage()    {}
 func (*UpdateTaskListPartitionConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{25}
+	return fileDescriptor_826e827d3aabf7fc, []int{28}
 }
 func (m *UpdateTaskListPartitionConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1994,7 +2232,7 @@ func (m *RefreshTaskListPartitionConfigRequest) Reset()         { *m = RefreshTa
 func (m *RefreshTaskListPartitionConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*RefreshTaskListPartitionConfigRequest) ProtoMessage()    {}
 func (*RefreshTaskListPartitionConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{26}
+	return fileDescriptor_826e827d3aabf7fc, []int{29}
 }
 func (m *RefreshTaskListPartitionConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2063,7 +2301,7 @@ func (m *RefreshTaskListPartitionConfigResponse) Reset() {
 func (m *RefreshTaskListPartitionConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*RefreshTaskListPartitionConfigResponse) ProtoMessage()    {}
 func (*RefreshTaskListPartitionConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{27}
+	return fileDescriptor_826e827d3aabf7fc, []int{30}
 }
 func (m *RefreshTaskListPartitionConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2092,1107 +2330,1547 @@ func (m *RefreshTaskListPartitionConfigResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RefreshTaskListPartitionConfigResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*TaskListPartition)(nil), "uber.cadence.matching.v1.TaskListPartition")
-	proto.RegisterType((*TaskListPartitionConfig)(nil), "uber.cadence.matching.v1.TaskListPartitionConfig")
-	proto.RegisterMapType((map[int32]*TaskListPartition)(nil), "uber.cadence.matching.v1.TaskListPartitionConfig.ReadPartitionsEntry")
-	proto.RegisterMapType((map[int32]*TaskListPartition)(nil), "uber.cadence.matching.v1.TaskListPartitionConfig.WritePartitionsEntry")
-	proto.RegisterType((*LoadBalancerHints)(nil), "uber.cadence.matching.v1.LoadBalancerHints")
-	proto.RegisterType((*PollForDecisionTaskRequest)(nil), "uber.cadence.matching.v1.PollForDecisionTaskRequest")
-	proto.RegisterType((*PollForDecisionTaskResponse)(nil), "uber.cadence.matching.v1.PollForDecisionTaskResponse")
-	proto.RegisterMapType((map[string]*v1.WorkflowQuery)(nil), "uber.cadence.matching.v1.PollForDecisionTaskResponse.QueriesEntry")
-	proto.RegisterType((*PollForActivityTaskRequest)(nil), "uber.cadence.matching.v1.PollForActivityTaskRequest")
-	proto.RegisterType((*PollForActivityTaskResponse)(nil), "uber.cadence.matching.v1.PollForActivityTaskResponse")
-	proto.RegisterType((*AddDecisionTaskRequest)(nil), "uber.cadence.matching.v1.AddDecisionTaskRequest")
-	proto.RegisterMapType((map[string]string)(nil), "uber.cadence.matching.v1.AddDecisionTaskRequest.PartitionConfigEntry")
-	proto.RegisterType((*AddDecisionTaskResponse)(nil), "uber.cadence.matching.v1.AddDecisionTaskResponse")
-	proto.RegisterType((*AddActivityTaskRequest)(nil), "uber.cadence.matching.v1.AddActivityTaskRequest")
-	proto.RegisterMapType((map[string]string)(nil), "uber.cadence.matching.v1.AddActivityTaskRequest.PartitionConfigEntry")
-	proto.RegisterType((*ActivityTaskDispatchInfo)(nil), "uber.cadence.matching.v1.ActivityTaskDispatchInfo")
-	proto.RegisterType((*AddActivityTaskResponse)(nil), "uber.cadence.matching.v1.AddActivityTaskResponse")
-	proto.RegisterType((*QueryWorkflowRequest)(nil), "uber.cadence.matching.v1.QueryWorkflowRequest")
-	proto.RegisterType((*QueryWorkflowResponse)(nil), "uber.cadence.matching.v1.QueryWorkflowResponse")
-	proto.RegisterType((*RespondQueryTaskCompletedRequest)(nil), "uber.cadence.matching.v1.RespondQueryTaskCompletedRequest")
-	proto.RegisterType((*RespondQueryTaskCompletedResponse)(nil), "uber.cadence.matching.v1.RespondQueryTaskCompletedResponse")
-	proto.RegisterType((*CancelOutstandingPollRequest)(nil), "uber.cadence.matching.v1.CancelOutstandingPollRequest")
-	proto.RegisterType((*CancelOutstandingPollResponse)(nil), "uber.cadence.matching.v1.CancelOutstandingPollResponse")
-	proto.RegisterType((*DescribeTaskListRequest)(nil), "uber.cadence.matching.v1.DescribeTaskListRequest")
-	proto.RegisterType((*DescribeTaskListResponse)(nil), "uber.cadence.matching.v1.DescribeTaskListResponse")
-	proto.RegisterType((*ListTaskListPartitionsRequest)(nil), "uber.cadence.matching.v1.ListTaskListPartitionsRequest")
-	proto.RegisterType((*ListTaskListPartitionsResponse)(nil), "uber.cadence.matching.v1.ListTaskListPartitionsResponse")
-	proto.RegisterType((*GetTaskListsByDomainRequest)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainRequest")
-	proto.RegisterType((*GetTaskListsByDomainResponse)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainResponse")
-	proto.RegisterMapType((map[string]*DescribeTaskListResponse)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainResponse.ActivityTaskListMapEntry")
-	proto.RegisterMapType((map[string]*DescribeTaskListResponse)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainResponse.DecisionTaskListMapEntry")
-	proto.RegisterType((*UpdateTaskListPartitionConfigRequest)(nil), "uber.cadence.matching.v1.UpdateTaskListPartitionConfigRequest")
-	proto.RegisterType((*UpdateTaskListPartitionConfigResponse)(nil), "uber.cadence.matching.v1.UpdateTaskListPartitionConfigResponse")
-	proto.RegisterType((*RefreshTaskListPartitionConfigRequest)(nil), "uber.cadence.matching.v1.RefreshTaskListPartitionConfigRequest")
-	proto.RegisterType((*RefreshTaskListPartitionConfigResponse)(nil), "uber.cadence.matching.v1.RefreshTaskListPartitionConfigResponse")
+type UpdateTaskListVersioningConfigRequest struct {
+	DomainId string       `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	Domain   string       `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	TaskList *v1.TaskList `protobuf:"bytes,3,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	// Exactly one of the operations below must be set.
+	AddNewDefaultBuildId string                `protobuf:"bytes,4,opt,name=add_new_default_build_id,json=addNewDefaultBuildId,proto3" json:"add_new_default_build_id,omitempty"`
+	AddCompatibleBuildId *AddCompatibleBuildId `protobuf:"bytes,5,opt,name=add_compatible_build_id,json=addCompatibleBuildId,proto3" json:"add_compatible_build_id,omitempty"`
+	PromoteBuildId       string                `protobuf:"bytes,6,opt,name=promote_build_id,json=promoteBuildId,proto3" json:"promote_build_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UpdateTaskListVersioningConfigRequest) Reset()         { *m = UpdateTaskListVersioningConfigRequest{} }
+func (m *UpdateTaskListVersioningConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateTaskListVersioningConfigRequest) ProtoMessage()    {}
+func (*UpdateTaskListVersioningConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{31}
+}
+func (m *UpdateTaskListVersioningConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateTaskListVersioningConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateTaskListVersioningConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateTaskListVersioningConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTaskListVersioningConfigRequest.Merge(m, src)
+}
+func (m *UpdateTaskListVersioningConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateTaskListVersioningConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTaskListVersioningConfigRequest.DiscardUnknown(m)
 }
 
-func init() {
-	proto.RegisterFile("uber/cadence/matching/v1/service.proto", fileDescriptor_826e827d3aabf7fc)
+var xxx_messageInfo_UpdateTaskListVersioningConfigRequest proto.InternalMessageInfo
+
+func (m *UpdateTaskListVersioningConfigRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
 }
 
-var fileDescriptor_826e827d3aabf7fc = []byte{
-	// 2528 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcb, 0x6f, 0x1c, 0x49,
-	0x19, 0x57, 0x8f, 0x3d, 0x7e, 0x7c, 0x63, 0x8f, 0xed, 0xb2, 0xd7, 0xe9, 0x4c, 0x62, 0xc7, 0x99,
-	0x6c, 0xb2, 0x5e, 0x58, 0xc6, 0x6b, 0xef, 0x26, 0x64, 0xb3, 0x62, 0x83, 0x1f, 0x71, 0x32, 0x68,
-	0x43, 0xb2, 0x1d, 0x6f, 0x22, 0xc1, 0x2a, 0x4d, 0x79, 0xba, 0xec, 0x69, 0xdc, 0xd3, 0xdd, 0xe9,
-	0xae, 0xb6, 0x77, 0xf6, 0xc0, 0x01, 0x01, 0x42, 0xe2, 0x0a, 0x77, 0x5e, 0xff, 0x04, 0x17, 0xce,
-	0x1c, 0x39, 0x22, 0xad, 0x90, 0x20, 0x12, 0x7f, 0x00, 0x48, 0xdc, 0x38, 0xa0, 0x7a, 0xf4, 0x4c,
-	0xf7, 0x4c, 0xf5, 0x3c, 0x6c, 0x27, 0xcb, 0x81, 0x9b, 0xbb, 0xea, 0x7b, 0xd5, 0xf7, 0xfa, 0x7d,
-	0x55, 0x63, 0xb8, 0x11, 0xed, 0x93, 0x60, 0xad, 0x86, 0x2d, 0xe2, 0xd6, 0xc8, 0x5a, 0x03, 0xd3,
-	0x5a, 0xdd, 0x76, 0x0f, 0xd7, 0x8e, 0xd7, 0xd7, 0x42, 0x12, 0x1c, 0xdb, 0x35, 0x52, 0xf1, 0x03,
-	0x8f, 0x7a, 0x48, 0x67, 0x74, 0x15, 0x49, 0x57, 0x89, 0xe9, 0x2a, 0xc7, 0xeb, 0xa5, 0xe5, 0x43,
-	0xcf, 0x3b, 0x74, 0xc8, 0x1a, 0xa7, 0xdb, 0x8f, 0x0e, 0xd6, 0xac, 0x28, 0xc0, 0xd4, 0xf6, 0x5c,
-	0xc1, 0x59, 0xba, 0xd2, 0xb9, 0x4f, 0xed, 0x06, 0x09, 0x29, 0x6e, 0xf8, 0x92, 0xa0, 0x4b, 0xc0,
-	0x49, 0x80, 0x7d, 0x9f, 0x04, 0xa1, 0xdc, 0x5f, 0x49, 0x99, 0x88, 0x7d, 0x9b, 0x59, 0x57, 0xf3,
-	0x1a, 0x8d, 0xb6, 0x0a, 0x15, 0xc5, 0x8b, 0x88, 0x04, 0x4d, 0x49, 0x50, 0x56, 0x11, 0x50, 0x1c,
-	0x1e, 0x39, 0x76, 0x48, 0x25, 0xcd, 0xaa, 0x8a, 0x46, 0x3a, 0xc1, 0x3c, 0xf1, 0x82, 0x23, 0x12,
-	0x48, 0xca, 0xaf, 0xf5, 0xa3, 0x3c, 0x70, 0xbc, 0x13, 0x49, 0x7b, 0x55, 0x45, 0x5b, 0xb7, 0x43,
-	0xea, 0xb5, 0x8c, 0x7b, 0x33, 0x45, 0x12, 0xd6, 0x71, 0x40, 0xac, 0x6e, 0xaa, 0xeb, 0x19, 0x54,
-	0xe9, 0x53, 0x94, 0x3f, 0x82, 0xb9, 0x3d, 0x1c, 0x1e, 0x7d, 0x6c, 0x87, 0xf4, 0x31, 0x0e, 0xa8,
-	0xcd, 0x02, 0x81, 0xde, 0x86, 0x59, 0x3b, 0xf4, 0x1c, 0x1e, 0x15, 0xf3, 0x30, 0xf0, 0x22, 0x3f,
-	0xd4, 0xb5, 0x95, 0x91, 0xd5, 0x49, 0x63, 0xa6, 0xb5, 0x7e, 0x9f, 0x2f, 0x97, 0xff, 0x3e, 0x0a,
-	0x17, 0xba, 0x04, 0x6c, 0x7b, 0xee, 0x81, 0x7d, 0x88, 0x74, 0x18, 0x3f, 0x26, 0x41, 0x68, 0x7b,
-	0xae, 0xae, 0xad, 0x68, 0xab, 0x23, 0x46, 0xfc, 0x89, 0x36, 0x60, 0xde, 0x8d, 0x1a, 0x66, 0x40,
-	0xb0, 0x65, 0xfa, 0x31, 0x57, 0xa8, 0xe7, 0x56, 0xb4, 0xd5, 0xfc, 0x56, 0x4e, 0xd7, 0x8c, 0x39,
-	0x37, 0x6a, 0x18, 0x04, 0x5b, 0x2d, 0x91, 0x21, 0x7a, 0x1f, 0x16, 0x18, 0xcf, 0x49, 0x60, 0x53,
-	0x92, 0x64, 0x1a, 0x69, 0x31, 0x21, 0x37, 0x6a, 0x3c, 0x63, 0xdb, 0x09, 0x2e, 0x17, 0x66, 0x3a,
-	0xb5, 0x8c, 0xae, 0x8c, 0xac, 0x16, 0x36, 0xee, 0x55, 0xb2, 0x32, 0xb4, 0x92, 0x71, 0x9e, 0x4a,
-	0xda, 0xa0, 0x7b, 0x2e, 0x0d, 0x9a, 0x46, 0x31, 0x48, 0x5b, 0xf9, 0x02, 0x66, 0xbb, 0x2c, 0xcc,
-	0x73, 0x85, 0xbb, 0xc3, 0x2b, 0xec, 0x38, 0x8c, 0xd0, 0x38, 0x73, 0x92, 0x5e, 0x2d, 0xb9, 0x30,
-	0xaf, 0xb0, 0x0c, 0xcd, 0xc2, 0xc8, 0x11, 0x69, 0x72, 0xcf, 0xe7, 0x0d, 0xf6, 0x27, 0xda, 0x84,
-	0xfc, 0x31, 0x76, 0x22, 0xc2, 0xfd, 0x5c, 0xd8, 0xf8, 0xfa, 0x10, 0x06, 0x19, 0x82, 0xf3, 0x4e,
-	0xee, 0xb6, 0x56, 0xf2, 0x60, 0x41, 0x65, 0xd8, 0x2b, 0x53, 0x58, 0xfe, 0x01, 0xcc, 0x7d, 0xec,
-	0x61, 0x6b, 0x0b, 0x3b, 0xd8, 0xad, 0x91, 0xe0, 0x81, 0xed, 0xd2, 0x10, 0x5d, 0x83, 0xe9, 0x7d,
-	0x5c, 0x3b, 0x72, 0xbc, 0x43, 0xb3, 0xe6, 0x45, 0x2e, 0x95, 0x29, 0x36, 0x25, 0x17, 0xb7, 0xd9,
-	0x1a, 0xba, 0x01, 0x33, 0x01, 0x66, 0xc1, 0x20, 0x81, 0x19, 0x92, 0x9a, 0xe7, 0x5a, 0xdc, 0x14,
-	0xcd, 0x98, 0x66, 0xcb, 0x8f, 0x49, 0xf0, 0x84, 0x2f, 0x96, 0xff, 0xa9, 0x41, 0xe9, 0xb1, 0xe7,
-	0x38, 0xbb, 0x5e, 0xb0, 0x43, 0x6a, 0x36, 0xcb, 0x51, 0x66, 0x91, 0x41, 0x5e, 0x44, 0x24, 0xa4,
-	0xa8, 0x0a, 0xe3, 0x81, 0xf8, 0x93, 0x6b, 0x29, 0x6c, 0xac, 0xa5, 0x4f, 0x82, 0x7d, 0x9b, 0x1d,
-	0x22, 0x5b, 0x82, 0x11, 0xf3, 0xa3, 0x4b, 0x30, 0x69, 0x79, 0x0d, 0x6c, 0xbb, 0xa6, 0x2d, 0x6c,
-	0x99, 0x34, 0x26, 0xc4, 0x42, 0xd5, 0x62, 0x9b, 0xbe, 0xe7, 0x38, 0x24, 0x60, 0x9b, 0x23, 0x62,
-	0x53, 0x2c, 0x54, 0x2d, 0x74, 0x1d, 0x8a, 0x07, 0x5e, 0x70, 0x82, 0x03, 0x8b, 0x58, 0xe6, 0x41,
-	0xe0, 0x35, 0xf4, 0x51, 0x4e, 0x31, 0xdd, 0x5a, 0xdd, 0x0d, 0xbc, 0x06, 0x7a, 0x0b, 0x66, 0x3a,
-	0x6a, 0x57, 0xcf, 0x73, 0xba, 0x62, 0xba, 0x74, 0xcb, 0x7f, 0x2c, 0xc0, 0x25, 0xa5, 0xc5, 0xa1,
-	0xef, 0xb9, 0x21, 0x41, 0x4b, 0x00, 0xac, 0x57, 0x98, 0xd4, 0x3b, 0x22, 0xa2, 0x80, 0xa7, 0x8c,
-	0x49, 0xb6, 0xb2, 0xc7, 0x16, 0xd0, 0xa7, 0x80, 0xe2, 0xd6, 0x65, 0x92, 0xcf, 0x49, 0x2d, 0x62,
-	0x92, 0x65, 0xa0, 0x6f, 0x28, 0xdd, 0xf3, 0x4c, 0x92, 0xdf, 0x8b, 0xa9, 0x8d, 0xb9, 0x93, 0xce,
-	0x25, 0xb4, 0x0b, 0xd3, 0x2d, 0xb1, 0xb4, 0xe9, 0x13, 0xee, 0x86, 0xc2, 0xc6, 0xd5, 0x9e, 0x12,
-	0xf7, 0x9a, 0x3e, 0x31, 0xa6, 0x4e, 0x12, 0x5f, 0xe8, 0x29, 0x5c, 0xf4, 0x03, 0x72, 0x6c, 0x7b,
-	0x51, 0x68, 0x86, 0x14, 0x07, 0x94, 0x58, 0x26, 0x39, 0x26, 0x2e, 0x65, 0xae, 0x1d, 0xe5, 0x32,
-	0x2f, 0x55, 0x04, 0x90, 0x54, 0x62, 0x20, 0xa9, 0x54, 0x5d, 0x7a, 0xeb, 0xfd, 0xa7, 0x2c, 0xef,
-	0x8c, 0xc5, 0x98, 0xfb, 0x89, 0x60, 0xbe, 0xc7, 0x78, 0xab, 0x16, 0x5a, 0x85, 0xd9, 0x2e, 0x71,
-	0x79, 0x9e, 0x79, 0xc5, 0x30, 0x4d, 0xa9, 0xc3, 0x38, 0xa6, 0x94, 0x34, 0x7c, 0xaa, 0x8f, 0xf1,
-	0x92, 0x88, 0x3f, 0x51, 0x19, 0xa6, 0x5d, 0xf2, 0x39, 0x6d, 0x0b, 0x18, 0xe7, 0x02, 0x0a, 0x6c,
-	0x31, 0xe6, 0x7e, 0x07, 0x50, 0x2a, 0xbd, 0xcd, 0xba, 0xed, 0x52, 0x7d, 0x82, 0x13, 0xce, 0x26,
-	0x73, 0x9c, 0x55, 0x03, 0xba, 0x0d, 0x7a, 0x48, 0xed, 0xda, 0x51, 0xb3, 0x1d, 0x0a, 0x93, 0xb8,
-	0x78, 0xdf, 0x21, 0x96, 0x3e, 0xb9, 0xa2, 0xad, 0x4e, 0x18, 0x8b, 0x62, 0xbf, 0xe5, 0xe8, 0x7b,
-	0x62, 0x17, 0xdd, 0x86, 0x3c, 0x07, 0x3e, 0x1d, 0xb8, 0x4f, 0xca, 0x3d, 0xfd, 0xfc, 0x09, 0xa3,
-	0x34, 0x04, 0x03, 0x32, 0x60, 0xda, 0x92, 0x79, 0x63, 0xda, 0xee, 0x81, 0xa7, 0x17, 0xb8, 0x84,
-	0x6f, 0xa4, 0x25, 0x08, 0xe0, 0xe1, 0x25, 0x1e, 0x60, 0x37, 0xb4, 0x89, 0x4b, 0xe3, 0x6c, 0xab,
-	0xba, 0x07, 0x9e, 0x31, 0x65, 0x25, 0xbe, 0xd0, 0x73, 0xb8, 0xdc, 0x9d, 0x54, 0x26, 0x4f, 0x43,
-	0x86, 0x59, 0xfa, 0x14, 0x57, 0xb1, 0xa4, 0x34, 0x32, 0x6e, 0x21, 0xc6, 0xc5, 0xae, 0xac, 0x8a,
-	0xb7, 0x50, 0x05, 0xe6, 0x85, 0xd3, 0x19, 0x52, 0x12, 0x33, 0x46, 0xa7, 0x69, 0x1e, 0x9f, 0x39,
-	0xbe, 0xf5, 0x84, 0xed, 0x3c, 0x95, 0x38, 0x75, 0x15, 0xa6, 0xf6, 0x03, 0xec, 0xd6, 0xea, 0xb2,
-	0x0a, 0x8a, 0xbc, 0x0a, 0x0a, 0x62, 0x4d, 0xd4, 0xc1, 0x26, 0x14, 0xc3, 0x5a, 0x9d, 0x58, 0x91,
-	0x43, 0x2c, 0x93, 0x8d, 0x2a, 0xfa, 0x0c, 0x37, 0xb2, 0xd4, 0x95, 0x5d, 0x7b, 0xf1, 0x1c, 0x63,
-	0x4c, 0xb7, 0x38, 0xd8, 0x1a, 0xfa, 0x16, 0x4c, 0xc5, 0x39, 0xc5, 0x05, 0xcc, 0xf6, 0x15, 0x50,
-	0x90, 0xf4, 0x9c, 0xfd, 0x33, 0x18, 0x67, 0x11, 0xb1, 0x49, 0xa8, 0xcf, 0x71, 0xa4, 0xd9, 0xca,
-	0xee, 0xb3, 0x3d, 0x0a, 0xbe, 0xf2, 0x89, 0x10, 0x22, 0x50, 0x26, 0x16, 0xc9, 0x5c, 0x46, 0x3d,
-	0x8a, 0x1d, 0x53, 0x8e, 0x17, 0xe6, 0x7e, 0x93, 0x92, 0x50, 0x47, 0x3c, 0x13, 0xe7, 0xf8, 0xd6,
-	0x03, 0xb1, 0xb3, 0xc5, 0x36, 0xd0, 0x67, 0x30, 0xdb, 0x82, 0x3e, 0xb3, 0xc6, 0x71, 0x4c, 0x9f,
-	0xe7, 0x07, 0x5a, 0x1f, 0x1a, 0x00, 0x8d, 0x19, 0xbf, 0x63, 0xa4, 0xf8, 0x3e, 0xcc, 0x3b, 0x1e,
-	0xb6, 0xcc, 0x7d, 0x89, 0x05, 0xbc, 0x2c, 0x42, 0x7d, 0xa1, 0x1f, 0xbe, 0x74, 0xe1, 0x87, 0x31,
-	0xe7, 0x74, 0x41, 0xca, 0x43, 0x98, 0xc5, 0x11, 0xf5, 0xa4, 0xd5, 0xa2, 0xe2, 0xde, 0xe0, 0x92,
-	0xaf, 0x29, 0x33, 0x6e, 0x33, 0xa2, 0x9e, 0xb0, 0x8b, 0xf1, 0x1b, 0x45, 0x9c, 0xfa, 0x2e, 0x3d,
-	0x87, 0xa9, 0xa4, 0x4b, 0x93, 0xf8, 0x38, 0x29, 0xf0, 0xf1, 0x76, 0x1a, 0x1f, 0x07, 0x2a, 0xbe,
-	0x36, 0x2c, 0x26, 0x40, 0x6b, 0xb3, 0x46, 0xed, 0x63, 0x9b, 0x36, 0x4f, 0x0f, 0x5a, 0x0a, 0x09,
-	0xff, 0x8b, 0xa0, 0xf5, 0x2b, 0x68, 0x81, 0x56, 0xda, 0xe2, 0xaf, 0x14, 0xb4, 0xae, 0x40, 0x01,
-	0x4b, 0x6b, 0xda, 0x4e, 0x80, 0x78, 0xa9, 0x6a, 0x31, 0x54, 0x6b, 0x11, 0x70, 0x54, 0x1b, 0xed,
-	0x81, 0x6a, 0xad, 0x83, 0x71, 0x54, 0xc3, 0x89, 0x2f, 0xb4, 0x01, 0x79, 0xdb, 0xf5, 0x23, 0xca,
-	0xbd, 0x53, 0xd8, 0xb8, 0xac, 0x8e, 0x28, 0x6e, 0xb2, 0xdc, 0x36, 0x04, 0xa9, 0xa2, 0x41, 0x8d,
-	0x9d, 0xb5, 0x41, 0x8d, 0x0f, 0xd7, 0xa0, 0xf6, 0xe0, 0x62, 0x2c, 0xcf, 0x64, 0xe5, 0xe5, 0x78,
-	0x21, 0xe1, 0x82, 0xbc, 0x48, 0x40, 0x5a, 0x61, 0xe3, 0x62, 0x97, 0xac, 0x1d, 0x79, 0x2b, 0x34,
-	0x16, 0x63, 0xde, 0x3d, 0x6f, 0x9b, 0x71, 0xee, 0x09, 0x46, 0xf4, 0x5d, 0x58, 0xe4, 0x4a, 0xba,
-	0x45, 0x4e, 0xf6, 0x13, 0x39, 0xcf, 0x19, 0x3b, 0xe4, 0xed, 0xc2, 0x5c, 0x9d, 0xe0, 0x80, 0xee,
-	0x13, 0x4c, 0x5b, 0xa2, 0xa0, 0x9f, 0xa8, 0xd9, 0x16, 0x4f, 0x2c, 0x27, 0x81, 0xfb, 0x85, 0x34,
-	0xee, 0x3f, 0x87, 0xe5, 0x74, 0x24, 0x4c, 0xef, 0xc0, 0xa4, 0x75, 0x3b, 0x34, 0x63, 0x86, 0xa9,
-	0xbe, 0x8e, 0x2d, 0xa5, 0x22, 0xf3, 0xe8, 0x60, 0xaf, 0x6e, 0x87, 0x9b, 0x52, 0x7e, 0x35, 0x79,
-	0x02, 0x8b, 0x50, 0x6c, 0x3b, 0x21, 0xc7, 0xb6, 0x7e, 0x99, 0xd2, 0x3e, 0xc4, 0x8e, 0xe0, 0xea,
-	0x1e, 0xc3, 0x8a, 0xa7, 0x1b, 0xc3, 0xde, 0x82, 0x99, 0x96, 0x1c, 0xd1, 0x31, 0x38, 0x3c, 0x4e,
-	0x1a, 0xc5, 0x78, 0x79, 0x87, 0xaf, 0xa2, 0xf7, 0x60, 0xac, 0x4e, 0xb0, 0x45, 0x02, 0x89, 0x7e,
-	0x97, 0x94, 0x9a, 0x1e, 0x70, 0x12, 0x43, 0x92, 0x66, 0xa1, 0xc1, 0xdc, 0xb9, 0xa0, 0xc1, 0xab,
-	0x05, 0x32, 0x15, 0xd6, 0x2c, 0x9c, 0x1a, 0x6b, 0xca, 0x7f, 0x19, 0x85, 0xc5, 0x4d, 0xcb, 0x52,
-	0x5d, 0x5e, 0x52, 0xcd, 0x5b, 0xeb, 0x68, 0xde, 0xaf, 0xa8, 0x21, 0xde, 0x81, 0xc9, 0xf6, 0xd0,
-	0x36, 0x32, 0xc8, 0xd0, 0x36, 0x41, 0xe3, 0x19, 0xed, 0x0a, 0x14, 0x5a, 0xdd, 0x42, 0xce, 0xea,
-	0x23, 0x06, 0xc4, 0x4b, 0x55, 0xab, 0xb3, 0x9d, 0xc8, 0x26, 0x20, 0x0b, 0x36, 0x3f, 0x44, 0x3b,
-	0xe1, 0xa3, 0x7d, 0x5c, 0xb6, 0x77, 0x60, 0x2c, 0xf4, 0xa2, 0xa0, 0x26, 0xda, 0x63, 0xb1, 0x13,
-	0x8c, 0x13, 0x73, 0x2c, 0x0e, 0x8f, 0x9e, 0x70, 0x4a, 0x43, 0x72, 0x28, 0x50, 0x6e, 0x5c, 0x85,
-	0x72, 0xbe, 0x22, 0xa3, 0x26, 0xfa, 0x3d, 0x46, 0xa8, 0xa3, 0x5a, 0xe9, 0x48, 0x30, 0xf9, 0x34,
-	0xd0, 0x91, 0x65, 0xa5, 0x2d, 0x58, 0x50, 0x11, 0x2a, 0x46, 0x91, 0x85, 0xe4, 0x28, 0x32, 0x99,
-	0x1c, 0x33, 0x4e, 0xe0, 0x42, 0x97, 0x0d, 0x12, 0x6d, 0x55, 0x25, 0xa2, 0x9d, 0x57, 0x89, 0x94,
-	0xff, 0x95, 0xe7, 0x39, 0xad, 0x9a, 0x6d, 0xbe, 0x8a, 0x9c, 0x66, 0x37, 0x3f, 0x1e, 0x6e, 0xb3,
-	0xad, 0x5a, 0x20, 0x7d, 0x51, 0xac, 0xef, 0xc4, 0x06, 0xa4, 0xb2, 0x7f, 0xf4, 0x4c, 0xd9, 0x9f,
-	0x1f, 0x2e, 0xfb, 0xc7, 0xce, 0x9e, 0xfd, 0xe3, 0xe7, 0x90, 0xfd, 0x13, 0xaa, 0xec, 0x77, 0x41,
-	0xc7, 0x89, 0x50, 0xee, 0xd8, 0xa1, 0xcf, 0xb2, 0x82, 0xdd, 0xfb, 0x24, 0x62, 0x6f, 0xf4, 0xa8,
-	0x82, 0x0c, 0x4e, 0x23, 0x53, 0xa6, 0xb2, 0xda, 0x60, 0x80, 0x6a, 0x53, 0xe4, 0xdb, 0x6b, 0xac,
-	0xb6, 0x2f, 0x47, 0x40, 0xcf, 0x3a, 0x2c, 0xfa, 0x0e, 0xcc, 0xb4, 0x07, 0x08, 0x7e, 0x5b, 0x95,
-	0xe5, 0xa6, 0xc6, 0x65, 0x79, 0x2f, 0xe3, 0x4f, 0x0a, 0x46, 0x7b, 0x08, 0xe4, 0xdf, 0x5d, 0x33,
-	0x5d, 0x6e, 0xb8, 0x99, 0x2e, 0x31, 0xe5, 0x8c, 0x0c, 0x3b, 0xe5, 0x8c, 0x9e, 0xff, 0x94, 0x93,
-	0x3f, 0x9f, 0x29, 0x67, 0xec, 0xdc, 0xa6, 0x9c, 0x71, 0xd5, 0x94, 0x23, 0x7b, 0xa9, 0xf2, 0xe6,
-	0xf2, 0x6a, 0x7b, 0xe9, 0x97, 0x1a, 0x2c, 0xf0, 0x0b, 0x64, 0x7c, 0x8a, 0xb8, 0x93, 0x6e, 0x77,
-	0xde, 0x12, 0xdf, 0x56, 0x1e, 0x5e, 0xc5, 0x3b, 0xe0, 0xfd, 0xf0, 0x2c, 0xb3, 0xc0, 0x60, 0xd7,
-	0xc7, 0xf2, 0x7f, 0x34, 0x78, 0xa3, 0xc3, 0x42, 0xe9, 0xd5, 0xbb, 0x30, 0xc5, 0x5f, 0xab, 0xcc,
-	0x80, 0x84, 0x91, 0x13, 0x9f, 0xb1, 0x77, 0x9e, 0x14, 0x38, 0x87, 0xc1, 0x19, 0x50, 0x15, 0x8a,
-	0xb1, 0x80, 0x1f, 0x92, 0x1a, 0x25, 0x56, 0xcf, 0xbb, 0xba, 0xb8, 0xa3, 0x4b, 0x4a, 0x63, 0xfa,
-	0x45, 0xf2, 0x13, 0x3d, 0x53, 0x44, 0x58, 0xf8, 0xe3, 0x9d, 0x9e, 0xfe, 0xe8, 0x1b, 0xdc, 0x7f,
-	0x68, 0xb0, 0x22, 0x4e, 0x6c, 0x71, 0x03, 0x18, 0xe3, 0xb6, 0xd7, 0xf0, 0x1d, 0xc2, 0xac, 0x90,
-	0x31, 0x7a, 0xd4, 0x19, 0xe8, 0x9b, 0x4a, 0xa5, 0xfd, 0xe4, 0xbc, 0x86, 0xa0, 0x5f, 0x80, 0x71,
-	0xce, 0x2b, 0x87, 0xbf, 0x49, 0x63, 0x8c, 0x7d, 0x56, 0xad, 0xf2, 0x35, 0xb8, 0xda, 0xc3, 0x3c,
-	0x11, 0xf1, 0xf2, 0x5f, 0x35, 0xb8, 0xbc, 0xcd, 0xc6, 0x78, 0xe7, 0x51, 0x44, 0x43, 0x8a, 0x5d,
-	0xcb, 0x76, 0x0f, 0x1f, 0x7b, 0x8e, 0x33, 0xd0, 0xec, 0x90, 0x7a, 0xcc, 0xc8, 0x75, 0x3c, 0x66,
-	0xdc, 0x87, 0x62, 0xeb, 0x50, 0xed, 0xc7, 0xe9, 0x62, 0x46, 0xbf, 0x88, 0x4f, 0x26, 0xfa, 0x05,
-	0x4d, 0x7c, 0x9d, 0x65, 0x40, 0x28, 0x5f, 0x81, 0xa5, 0x8c, 0xe3, 0x49, 0x07, 0xfc, 0x08, 0x2e,
-	0xec, 0x90, 0xb0, 0x16, 0xd8, 0xfb, 0xa4, 0xc5, 0x2e, 0x8f, 0xbe, 0xdb, 0x99, 0x03, 0xea, 0xc4,
-	0xcb, 0x60, 0x1f, 0x2c, 0xf4, 0xe5, 0x3f, 0xe4, 0x40, 0xef, 0x96, 0x20, 0xeb, 0xf1, 0x03, 0x18,
-	0x17, 0xee, 0x14, 0x3f, 0x28, 0x16, 0x36, 0xae, 0x64, 0x3e, 0x4a, 0x91, 0x80, 0x03, 0x7c, 0x4c,
-	0xcf, 0x6e, 0x4c, 0x6d, 0xef, 0x87, 0x14, 0xd3, 0x28, 0x94, 0xb5, 0x78, 0xad, 0xa7, 0xef, 0x9e,
-	0x70, 0x52, 0xa3, 0x48, 0x53, 0xdf, 0xaf, 0xac, 0x1a, 0xcf, 0x14, 0xdc, 0x10, 0x96, 0x78, 0x92,
-	0x74, 0xea, 0x0a, 0xe3, 0x08, 0x2e, 0xc2, 0x98, 0x04, 0x18, 0x91, 0xb9, 0xf2, 0x2b, 0xad, 0x34,
-	0x37, 0x9c, 0xd2, 0x9f, 0xe5, 0x60, 0x39, 0x4b, 0xab, 0x0c, 0xdb, 0x0b, 0x58, 0x6a, 0xbf, 0x5f,
-	0xb5, 0x82, 0x90, 0xf8, 0x89, 0x53, 0x04, 0xb3, 0x32, 0x98, 0xe7, 0x1e, 0x12, 0x8a, 0x2d, 0x4c,
-	0xb1, 0x51, 0x4a, 0x0e, 0x6f, 0x69, 0xd5, 0x4c, 0x65, 0xeb, 0xe7, 0x05, 0xa5, 0xca, 0xdc, 0xe9,
-	0x54, 0x5a, 0x89, 0x8b, 0x4c, 0x5a, 0x65, 0xf9, 0x26, 0x5c, 0xba, 0x4f, 0x5a, 0x6e, 0x08, 0xb7,
-	0x9a, 0x02, 0xb5, 0xfb, 0xf8, 0xbe, 0xfc, 0xfb, 0x51, 0xb8, 0xac, 0xe6, 0x93, 0xde, 0xfb, 0x89,
-	0x06, 0x8b, 0x8a, 0xb3, 0x34, 0xb0, 0x2f, 0xfd, 0xf6, 0x28, 0x1b, 0xe1, 0x7b, 0x09, 0xae, 0xec,
-	0x74, 0x9c, 0xe5, 0x21, 0xf6, 0xc5, 0x68, 0x3a, 0x6f, 0x75, 0xef, 0x70, 0x33, 0x14, 0x51, 0x64,
-	0x66, 0xe4, 0xce, 0x64, 0xc6, 0x66, 0x47, 0x14, 0xdb, 0x66, 0xe0, 0xee, 0x9d, 0xd2, 0x17, 0xac,
-	0x3d, 0xa8, 0xed, 0x56, 0x4c, 0xca, 0x0f, 0xd2, 0x4f, 0xe4, 0x3d, 0xae, 0x08, 0x59, 0x3d, 0x27,
-	0xf9, 0xd3, 0xf5, 0x17, 0xe9, 0xe1, 0xfa, 0x75, 0xea, 0x2e, 0xff, 0x26, 0x07, 0x6f, 0x7e, 0xea,
-	0x5b, 0x98, 0x92, 0xac, 0x56, 0x32, 0x08, 0x40, 0x9d, 0xa1, 0xd0, 0xcf, 0x0f, 0xbf, 0x54, 0xbd,
-	0x73, 0xf4, 0x3c, 0x26, 0x99, 0xb7, 0xe0, 0x7a, 0x1f, 0x17, 0x49, 0x90, 0xfb, 0x6d, 0x0e, 0xae,
-	0x1b, 0xe4, 0x20, 0x20, 0x61, 0xfd, 0xff, 0xde, 0xcc, 0xf2, 0xe6, 0x2a, 0xdc, 0xe8, 0xe7, 0x23,
-	0xe1, 0xce, 0x8d, 0x7f, 0x4f, 0x41, 0xe1, 0xa1, 0xcc, 0xe7, 0xcd, 0xc7, 0x55, 0xf4, 0x63, 0x0d,
-	0xe6, 0x15, 0x3f, 0x15, 0xa2, 0xf7, 0x87, 0xfc, 0x65, 0x91, 0x87, 0xa0, 0x74, 0xf3, 0x54, 0xbf,
-	0x47, 0x26, 0x8d, 0x48, 0x16, 0xed, 0x00, 0x46, 0x28, 0xae, 0xf0, 0x03, 0x18, 0xa1, 0xbc, 0x96,
-	0x1d, 0xc3, 0x4c, 0xc7, 0xeb, 0x17, 0x7a, 0x77, 0xd8, 0xc7, 0xba, 0xd2, 0xfa, 0x10, 0x1c, 0x29,
-	0xbd, 0xa9, 0x73, 0xbf, 0x3b, 0xec, 0xb3, 0x45, 0x1f, 0xbd, 0xca, 0xf3, 0xfa, 0x30, 0x9d, 0xba,
-	0x49, 0xa1, 0x4a, 0xb6, 0x0c, 0xd5, 0xa5, 0xb0, 0xb4, 0x36, 0x30, 0xbd, 0xd4, 0xf8, 0x4b, 0x0d,
-	0x2e, 0x66, 0x8e, 0xf5, 0xe8, 0x4e, 0xb6, 0xb8, 0x7e, 0x57, 0x95, 0xd2, 0x87, 0xa7, 0xe2, 0x95,
-	0x66, 0xfd, 0x5c, 0x83, 0x37, 0x94, 0x83, 0x36, 0xba, 0x95, 0x2d, 0xb6, 0xd7, 0xc5, 0xa3, 0xf4,
-	0xcd, 0xa1, 0xf9, 0xa4, 0x29, 0x4d, 0x98, 0xed, 0x04, 0x18, 0xb4, 0x3e, 0x0c, 0x18, 0x09, 0xfd,
-	0xa7, 0xc0, 0x2f, 0xf4, 0x0b, 0x0d, 0x16, 0xd5, 0xb3, 0x21, 0xea, 0x71, 0x9c, 0x9e, 0x33, 0x6c,
-	0xe9, 0xf6, 0xf0, 0x8c, 0xd2, 0x9a, 0x9f, 0x6a, 0xb0, 0xa0, 0x9a, 0x44, 0xd0, 0xcd, 0x61, 0x27,
-	0x17, 0x61, 0xc9, 0xad, 0xd3, 0x0d, 0x3c, 0xe8, 0xd7, 0x1a, 0x2c, 0xf5, 0xc4, 0x29, 0xf4, 0x51,
-	0xb6, 0xe4, 0x41, 0x66, 0x80, 0xd2, 0xdd, 0x53, 0xf3, 0x4b, 0x13, 0x7f, 0xa7, 0xc1, 0x72, 0xef,
-	0xe6, 0x8f, 0xee, 0xf6, 0x2a, 0x8f, 0x01, 0xa0, 0xb5, 0xf4, 0xed, 0xd3, 0x0b, 0x10, 0x56, 0x6e,
-	0xdd, 0xff, 0xd3, 0xcb, 0x65, 0xed, 0xcf, 0x2f, 0x97, 0xb5, 0xbf, 0xbd, 0x5c, 0xd6, 0xbe, 0xf7,
-	0xc1, 0xa1, 0x4d, 0xeb, 0xd1, 0x7e, 0xa5, 0xe6, 0x35, 0xd6, 0x52, 0xff, 0xbd, 0x5a, 0x39, 0x24,
-	0xae, 0xf8, 0x77, 0xdf, 0xe4, 0x7f, 0x1c, 0x7f, 0x18, 0xff, 0x7d, 0xbc, 0xbe, 0x3f, 0xc6, 0x77,
-	0xdf, 0xfb, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x48, 0x50, 0xe3, 0xd9, 0x9f, 0x2c, 0x00, 0x00,
+func (m *UpdateTaskListVersioningConfigRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
 }
 
-func (m *TaskListPartition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UpdateTaskListVersioningConfigRequest) GetTaskList() *v1.TaskList {
+	if m != nil {
+		return m.TaskList
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TaskListPartition) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UpdateTaskListVersioningConfigRequest) GetAddNewDefaultBuildId() string {
+	if m != nil {
+		return m.AddNewDefaultBuildId
+	}
+	return ""
 }
 
-func (m *TaskListPartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.IsolationGroups) > 0 {
-		for iNdEx := len(m.IsolationGroups) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.IsolationGroups[iNdEx])
-			copy(dAtA[i:], m.IsolationGroups[iNdEx])
-			i = encodeVarintService(dAtA, i, uint64(len(m.IsolationGroups[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *UpdateTaskListVersioningConfigRequest) GetAddCompatibleBuildId() *AddCompatibleBuildId {
+	if m != nil {
+		return m.AddCompatibleBuildId
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *TaskListPartitionConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UpdateTaskListVersioningConfigRequest) GetPromoteBuildId() string {
+	if m != nil {
+		return m.PromoteBuildId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TaskListPartitionConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type AddCompatibleBuildId struct {
+	BuildId                   string   `protobuf:"bytes,1,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	ExistingCompatibleBuildId string   `protobuf:"bytes,2,opt,name=existing_compatible_build_id,json=existingCompatibleBuildId,proto3" json:"existing_compatible_build_id,omitempty"`
+	MakeDefault               bool     `protobuf:"varint,3,opt,name=make_default,json=makeDefault,proto3" json:"make_default,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
 }
 
-func (m *TaskListPartitionConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.WritePartitions) > 0 {
-		for k := range m.WritePartitions {
-			v := m.WritePartitions[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintService(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintService(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintService(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.ReadPartitions) > 0 {
-		for k := range m.ReadPartitions {
-			v := m.ReadPartitions[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintService(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintService(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintService(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
+func (m *AddCompatibleBuildId) Reset()         { *m = AddCompatibleBuildId{} }
+func (m *AddCompatibleBuildId) String() string { return proto.CompactTextString(m) }
+func (*AddCompatibleBuildId) ProtoMessage()    {}
+func (*AddCompatibleBuildId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{32}
+}
+func (m *AddCompatibleBuildId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddCompatibleBuildId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddCompatibleBuildId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.NumWritePartitions != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.NumWritePartitions))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.NumReadPartitions != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.NumReadPartitions))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *AddCompatibleBuildId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddCompatibleBuildId.Merge(m, src)
+}
+func (m *AddCompatibleBuildId) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddCompatibleBuildId) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddCompatibleBuildId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddCompatibleBuildId proto.InternalMessageInfo
+
+func (m *AddCompatibleBuildId) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
 	}
-	if m.Version != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *AddCompatibleBuildId) GetExistingCompatibleBuildId() string {
+	if m != nil {
+		return m.ExistingCompatibleBuildId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *LoadBalancerHints) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AddCompatibleBuildId) GetMakeDefault() bool {
+	if m != nil {
+		return m.MakeDefault
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *LoadBalancerHints) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type UpdateTaskListVersioningConfigResponse struct {
+	VersioningConfig     *TaskListVersioningConfig `protobuf:"bytes,1,opt,name=versioning_config,json=versioningConfig,proto3" json:"versioning_config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
-func (m *LoadBalancerHints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *UpdateTaskListVersioningConfigResponse) Reset() {
+	*m = UpdateTaskListVersioningConfigResponse{}
+}
+func (m *UpdateTaskListVersioningConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateTaskListVersioningConfigResponse) ProtoMessage()    {}
+func (*UpdateTaskListVersioningConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{33}
+}
+func (m *UpdateTaskListVersioningConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateTaskListVersioningConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateTaskListVersioningConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.RatePerSecond != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RatePerSecond))))
-		i--
-		dAtA[i] = 0x11
+}
+func (m *UpdateTaskListVersioningConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTaskListVersioningConfigResponse.Merge(m, src)
+}
+func (m *UpdateTaskListVersioningConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateTaskListVersioningConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTaskListVersioningConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateTaskListVersioningConfigResponse proto.InternalMessageInfo
+
+func (m *UpdateTaskListVersioningConfigResponse) GetVersioningConfig() *TaskListVersioningConfig {
+	if m != nil {
+		return m.VersioningConfig
 	}
-	if m.BacklogCount != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.BacklogCount))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+type GetTaskListScalingRecommendationRequest struct {
+	DomainId             string          `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	Domain               string          `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	TaskList             *v1.TaskList    `protobuf:"bytes,3,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	TaskListType         v1.TaskListType `protobuf:"varint,4,opt,name=task_list_type,json=taskListType,proto3,enum=uber.cadence.api.v1.TaskListType" json:"task_list_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetTaskListScalingRecommendationRequest) Reset() {
+	*m = GetTaskListScalingRecommendationRequest{}
+}
+func (m *GetTaskListScalingRecommendationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTaskListScalingRecommendationRequest) ProtoMessage()    {}
+func (*GetTaskListScalingRecommendationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{34}
+}
+func (m *GetTaskListScalingRecommendationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTaskListScalingRecommendationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTaskListScalingRecommendationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetTaskListScalingRecommendationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaskListScalingRecommendationRequest.Merge(m, src)
+}
+func (m *GetTaskListScalingRecommendationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTaskListScalingRecommendationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaskListScalingRecommendationRequest.DiscardUnknown(m)
 }
 
-func (m *PollForDecisionTaskRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetTaskListScalingRecommendationRequest proto.InternalMessageInfo
+
+func (m *GetTaskListScalingRecommendationRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PollForDecisionTaskRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetTaskListScalingRecommendationRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
 }
 
-func (m *PollForDecisionTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *GetTaskListScalingRecommendationRequest) GetTaskList() *v1.TaskList {
+	if m != nil {
+		return m.TaskList
 	}
-	if len(m.IsolationGroup) > 0 {
-		i -= len(m.IsolationGroup)
-		copy(dAtA[i:], m.IsolationGroup)
-		i = encodeVarintService(dAtA, i, uint64(len(m.IsolationGroup)))
-		i--
-		dAtA[i] = 0x2a
+	return nil
+}
+
+func (m *GetTaskListScalingRecommendationRequest) GetTaskListType() v1.TaskListType {
+	if m != nil {
+		return m.TaskListType
 	}
-	if len(m.ForwardedFrom) > 0 {
-		i -= len(m.ForwardedFrom)
-		copy(dAtA[i:], m.ForwardedFrom)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ForwardedFrom)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.PollerId) > 0 {
-		i -= len(m.PollerId)
-		copy(dAtA[i:], m.PollerId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.PollerId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+	return v1.TaskListType_TASK_LIST_TYPE_INVALID
+}
+
+type GetTaskListScalingRecommendationResponse struct {
+	RecommendedPollerCount int64                     `protobuf:"varint,1,opt,name=recommended_poller_count,json=recommendedPollerCount,proto3" json:"recommended_poller_count,omitempty"`
+	Confidence             TaskListScalingConfidence `protobuf:"varint,2,opt,name=confidence,proto3,enum=uber.cadence.matching.v1.TaskListScalingConfidence" json:"confidence,omitempty"`
+	Signals                *TaskListScalingSignals   `protobuf:"bytes,3,opt,name=signals,proto3" json:"signals,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                  `json:"-"`
+	XXX_unrecognized       []byte                    `json:"-"`
+	XXX_sizecache          int32                     `json:"-"`
+}
+
+func (m *GetTaskListScalingRecommendationResponse) Reset() {
+	*m = GetTaskListScalingRecommendationResponse{}
+}
+func (m *GetTaskListScalingRecommendationResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTaskListScalingRecommendationResponse) ProtoMessage()    {}
+func (*GetTaskListScalingRecommendationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{35}
+}
+func (m *GetTaskListScalingRecommendationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTaskListScalingRecommendationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTaskListScalingRecommendationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetTaskListScalingRecommendationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaskListScalingRecommendationResponse.Merge(m, src)
+}
+func (m *GetTaskListScalingRecommendationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTaskListScalingRecommendationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaskListScalingRecommendationResponse.DiscardUnknown(m)
 }
 
-func (m *PollForDecisionTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetTaskListScalingRecommendationResponse proto.InternalMessageInfo
+
+func (m *GetTaskListScalingRecommendationResponse) GetRecommendedPollerCount() int64 {
+	if m != nil {
+		return m.RecommendedPollerCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PollForDecisionTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetTaskListScalingRecommendationResponse) GetConfidence() TaskListScalingConfidence {
+	if m != nil {
+		return m.Confidence
+	}
+	return TaskListScalingConfidence_TASK_LIST_SCALING_CONFIDENCE_INVALID
 }
 
-func (m *PollForDecisionTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *GetTaskListScalingRecommendationResponse) GetSignals() *TaskListScalingSignals {
+	if m != nil {
+		return m.Signals
 	}
-	if m.AutoConfigHint != nil {
-		{
-			size, err := m.AutoConfigHint.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+	return nil
+}
+
+// TaskListScalingSignals are the task list metrics, aggregated over all partitions, a recommendation is based on.
+type TaskListScalingSignals struct {
+	BacklogCountHint           int64    `protobuf:"varint,1,opt,name=backlog_count_hint,json=backlogCountHint,proto3" json:"backlog_count_hint,omitempty"`
+	AddTasksPerSecond          float64  `protobuf:"fixed64,2,opt,name=add_tasks_per_second,json=addTasksPerSecond,proto3" json:"add_tasks_per_second,omitempty"`
+	DispatchedTasksPerSecond   float64  `protobuf:"fixed64,3,opt,name=dispatched_tasks_per_second,json=dispatchedTasksPerSecond,proto3" json:"dispatched_tasks_per_second,omitempty"`
+	SyncMatchRatio             float64  `protobuf:"fixed64,4,opt,name=sync_match_ratio,json=syncMatchRatio,proto3" json:"sync_match_ratio,omitempty"`
+	ScheduleToStartLatencyInMs int64    `protobuf:"varint,5,opt,name=schedule_to_start_latency_in_ms,json=scheduleToStartLatencyInMs,proto3" json:"schedule_to_start_latency_in_ms,omitempty"`
+	PollerCount                int64    `protobuf:"varint,6,opt,name=poller_count,json=pollerCount,proto3" json:"poller_count,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
+	XXX_unrecognized           []byte   `json:"-"`
+	XXX_sizecache              int32    `json:"-"`
+}
+
+func (m *TaskListScalingSignals) Reset()         { *m = TaskListScalingSignals{} }
+func (m *TaskListScalingSignals) String() string { return proto.CompactTextString(m) }
+func (*TaskListScalingSignals) ProtoMessage()    {}
+func (*TaskListScalingSignals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{36}
+}
+func (m *TaskListScalingSignals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskListScalingSignals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskListScalingSignals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xaa
+		return b[:n], nil
 	}
-	if m.LoadBalancerHints != nil {
-		{
-			size, err := m.LoadBalancerHints.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+}
+func (m *TaskListScalingSignals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskListScalingSignals.Merge(m, src)
+}
+func (m *TaskListScalingSignals) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskListScalingSignals) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskListScalingSignals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskListScalingSignals proto.InternalMessageInfo
+
+func (m *TaskListScalingSignals) GetBacklogCountHint() int64 {
+	if m != nil {
+		return m.BacklogCountHint
 	}
-	if m.PartitionConfig != nil {
-		{
-			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x9a
+	return 0
+}
+
+func (m *TaskListScalingSignals) GetAddTasksPerSecond() float64 {
+	if m != nil {
+		return m.AddTasksPerSecond
 	}
-	if m.TotalHistoryBytes != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.TotalHistoryBytes))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x90
+	return 0
+}
+
+func (m *TaskListScalingSignals) GetDispatchedTasksPerSecond() float64 {
+	if m != nil {
+		return m.DispatchedTasksPerSecond
 	}
-	if len(m.Queries) > 0 {
-		for k := range m.Queries {
-			v := m.Queries[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintService(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintService(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintService(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
-		}
+	return 0
+}
+
+func (m *TaskListScalingSignals) GetSyncMatchRatio() float64 {
+	if m != nil {
+		return m.SyncMatchRatio
 	}
-	if m.StartedTime != nil {
-		{
-			size, err := m.StartedTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+	return 0
+}
+
+func (m *TaskListScalingSignals) GetScheduleToStartLatencyInMs() int64 {
+	if m != nil {
+		return m.ScheduleToStartLatencyInMs
 	}
-	if m.ScheduledTime != nil {
-		{
-			size, err := m.ScheduledTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *TaskListScalingSignals) GetPollerCount() int64 {
+	if m != nil {
+		return m.PollerCount
+	}
+	return 0
+}
+
+type ListWorkersRequest struct {
+	DomainId             string   `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	Domain               string   `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListWorkersRequest) Reset()         { *m = ListWorkersRequest{} }
+func (m *ListWorkersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListWorkersRequest) ProtoMessage()    {}
+func (*ListWorkersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{37}
+}
+func (m *ListWorkersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListWorkersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListWorkersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x7a
+		return b[:n], nil
 	}
-	if len(m.BranchToken) > 0 {
-		i -= len(m.BranchToken)
-		copy(dAtA[i:], m.BranchToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.BranchToken)))
-		i--
-		dAtA[i] = 0x72
+}
+func (m *ListWorkersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWorkersRequest.Merge(m, src)
+}
+func (m *ListWorkersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListWorkersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWorkersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWorkersRequest proto.InternalMessageInfo
+
+func (m *ListWorkersRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
 	}
-	if m.EventStoreVersion != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.EventStoreVersion))
-		i--
-		dAtA[i] = 0x68
+	return ""
+}
+
+func (m *ListWorkersRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	if m.WorkflowExecutionTaskList != nil {
-		{
-			size, err := m.WorkflowExecutionTaskList.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.DecisionInfo != nil {
-		{
-			size, err := m.DecisionInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+	return ""
+}
+
+type ListWorkersResponse struct {
+	Workers              []*WorkerInfo `protobuf:"bytes,1,rep,name=workers,proto3" json:"workers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListWorkersResponse) Reset()         { *m = ListWorkersResponse{} }
+func (m *ListWorkersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListWorkersResponse) ProtoMessage()    {}
+func (*ListWorkersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{38}
+}
+func (m *ListWorkersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListWorkersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListWorkersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x5a
+		return b[:n], nil
 	}
-	if m.Query != nil {
-		{
-			size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
+}
+func (m *ListWorkersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWorkersResponse.Merge(m, src)
+}
+func (m *ListWorkersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListWorkersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWorkersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWorkersResponse proto.InternalMessageInfo
+
+func (m *ListWorkersResponse) GetWorkers() []*WorkerInfo {
+	if m != nil {
+		return m.Workers
 	}
-	if m.StickyExecutionEnabled {
-		i--
-		if m.StickyExecutionEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return nil
+}
+
+type DescribeWorkerRequest struct {
+	DomainId             string   `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	Domain               string   `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	Identity             string   `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DescribeWorkerRequest) Reset()         { *m = DescribeWorkerRequest{} }
+func (m *DescribeWorkerRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeWorkerRequest) ProtoMessage()    {}
+func (*DescribeWorkerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{39}
+}
+func (m *DescribeWorkerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeWorkerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeWorkerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.BacklogCountHint != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.BacklogCountHint))
-		i--
-		dAtA[i] = 0x40
+		return b[:n], nil
 	}
-	if m.NextEventId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.NextEventId))
-		i--
-		dAtA[i] = 0x38
+}
+func (m *DescribeWorkerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeWorkerRequest.Merge(m, src)
+}
+func (m *DescribeWorkerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeWorkerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeWorkerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeWorkerRequest proto.InternalMessageInfo
+
+func (m *DescribeWorkerRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
 	}
-	if m.Attempt != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Attempt))
-		i--
-		dAtA[i] = 0x30
+	return ""
+}
+
+func (m *DescribeWorkerRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	if m.StartedEventId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.StartedEventId))
-		i--
-		dAtA[i] = 0x28
+	return ""
+}
+
+func (m *DescribeWorkerRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	if m.PreviousStartedEventId != nil {
-		{
-			size, err := m.PreviousStartedEventId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+	return ""
+}
+
+type DescribeWorkerResponse struct {
+	Worker               *WorkerInfo `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *DescribeWorkerResponse) Reset()         { *m = DescribeWorkerResponse{} }
+func (m *DescribeWorkerResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeWorkerResponse) ProtoMessage()    {}
+func (*DescribeWorkerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{40}
+}
+func (m *DescribeWorkerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeWorkerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeWorkerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if m.WorkflowType != nil {
-		{
-			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *DescribeWorkerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeWorkerResponse.Merge(m, src)
+}
+func (m *DescribeWorkerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeWorkerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeWorkerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeWorkerResponse proto.InternalMessageInfo
+
+func (m *DescribeWorkerResponse) GetWorker() *WorkerInfo {
+	if m != nil {
+		return m.Worker
 	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+	return nil
+}
+
+type WorkerInfo struct {
+	Identity             string                `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	VersionInfo          *v1.WorkerVersionInfo `protobuf:"bytes,2,opt,name=version_info,json=versionInfo,proto3" json:"version_info,omitempty"`
+	TaskLists            []*WorkerTaskListInfo `protobuf:"bytes,3,rep,name=task_lists,json=taskLists,proto3" json:"task_lists,omitempty"`
+	LastPollTime         *types.Timestamp      `protobuf:"bytes,4,opt,name=last_poll_time,json=lastPollTime,proto3" json:"last_poll_time,omitempty"`
+	PollsPerSecond       float64               `protobuf:"fixed64,5,opt,name=polls_per_second,json=pollsPerSecond,proto3" json:"polls_per_second,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *WorkerInfo) Reset()         { *m = WorkerInfo{} }
+func (m *WorkerInfo) String() string { return proto.CompactTextString(m) }
+func (*WorkerInfo) ProtoMessage()    {}
+func (*WorkerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{41}
+}
+func (m *WorkerInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkerInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.TaskToken) > 0 {
-		i -= len(m.TaskToken)
-		copy(dAtA[i:], m.TaskToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.TaskToken)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *WorkerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkerInfo.Merge(m, src)
+}
+func (m *WorkerInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkerInfo proto.InternalMessageInfo
+
+func (m *WorkerInfo) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *PollForActivityTaskRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *WorkerInfo) GetVersionInfo() *v1.WorkerVersionInfo {
+	if m != nil {
+		return m.VersionInfo
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PollForActivityTaskRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WorkerInfo) GetTaskLists() []*WorkerTaskListInfo {
+	if m != nil {
+		return m.TaskLists
+	}
+	return nil
 }
 
-func (m *PollForActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *WorkerInfo) GetLastPollTime() *types.Timestamp {
+	if m != nil {
+		return m.LastPollTime
 	}
-	if len(m.IsolationGroup) > 0 {
-		i -= len(m.IsolationGroup)
-		copy(dAtA[i:], m.IsolationGroup)
-		i = encodeVarintService(dAtA, i, uint64(len(m.IsolationGroup)))
-		i--
-		dAtA[i] = 0x2a
+	return nil
+}
+
+func (m *WorkerInfo) GetPollsPerSecond() float64 {
+	if m != nil {
+		return m.PollsPerSecond
 	}
-	if len(m.ForwardedFrom) > 0 {
-		i -= len(m.ForwardedFrom)
-		copy(dAtA[i:], m.ForwardedFrom)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ForwardedFrom)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+type WorkerTaskListInfo struct {
+	TaskList             *v1.TaskList     `protobuf:"bytes,1,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	TaskListType         v1.TaskListType  `protobuf:"varint,2,opt,name=task_list_type,json=taskListType,proto3,enum=uber.cadence.api.v1.TaskListType" json:"task_list_type,omitempty"`
+	LastPollTime         *types.Timestamp `protobuf:"bytes,3,opt,name=last_poll_time,json=lastPollTime,proto3" json:"last_poll_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *WorkerTaskListInfo) Reset()         { *m = WorkerTaskListInfo{} }
+func (m *WorkerTaskListInfo) String() string { return proto.CompactTextString(m) }
+func (*WorkerTaskListInfo) ProtoMessage()    {}
+func (*WorkerTaskListInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{42}
+}
+func (m *WorkerTaskListInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkerTaskListInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkerTaskListInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.PollerId) > 0 {
-		i -= len(m.PollerId)
-		copy(dAtA[i:], m.PollerId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.PollerId)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *WorkerTaskListInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkerTaskListInfo.Merge(m, src)
+}
+func (m *WorkerTaskListInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkerTaskListInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkerTaskListInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkerTaskListInfo proto.InternalMessageInfo
+
+func (m *WorkerTaskListInfo) GetTaskList() *v1.TaskList {
+	if m != nil {
+		return m.TaskList
 	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *WorkerTaskListInfo) GetTaskListType() v1.TaskListType {
+	if m != nil {
+		return m.TaskListType
 	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+	return v1.TaskListType_TASK_LIST_TYPE_INVALID
+}
+
+func (m *WorkerTaskListInfo) GetLastPollTime() *types.Timestamp {
+	if m != nil {
+		return m.LastPollTime
+	}
+	return nil
+}
+
+type MigrateTaskListRequest struct {
+	DomainId       string          `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	Domain         string          `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	TaskList       *v1.TaskList    `protobuf:"bytes,3,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	TaskListType   v1.TaskListType `protobuf:"varint,4,opt,name=task_list_type,json=taskListType,proto3,enum=uber.cadence.api.v1.TaskListType" json:"task_list_type,omitempty"`
+	TargetTaskList *v1.TaskList    `protobuf:"bytes,5,opt,name=target_task_list,json=targetTaskList,proto3" json:"target_task_list,omitempty"`
+	// Alias redirects activity tasks added to the task list to the target task list.
+	Alias                bool     `protobuf:"varint,6,opt,name=alias,proto3" json:"alias,omitempty"`
+	Stop                 bool     `protobuf:"varint,7,opt,name=stop,proto3" json:"stop,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MigrateTaskListRequest) Reset()         { *m = MigrateTaskListRequest{} }
+func (m *MigrateTaskListRequest) String() string { return proto.CompactTextString(m) }
+func (*MigrateTaskListRequest) ProtoMessage()    {}
+func (*MigrateTaskListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{43}
+}
+func (m *MigrateTaskListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrateTaskListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrateTaskListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MigrateTaskListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateTaskListRequest.Merge(m, src)
+}
+func (m *MigrateTaskListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrateTaskListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateTaskListRequest.DiscardUnknown(m)
 }
 
-func (m *PollForActivityTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MigrateTaskListRequest proto.InternalMessageInfo
+
+func (m *MigrateTaskListRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PollForActivityTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MigrateTaskListRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
 }
 
-func (m *PollForActivityTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *MigrateTaskListRequest) GetTaskList() *v1.TaskList {
+	if m != nil {
+		return m.TaskList
 	}
-	if m.AutoConfigHint != nil {
-		{
-			size, err := m.AutoConfigHint.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+	return nil
+}
+
+func (m *MigrateTaskListRequest) GetTaskListType() v1.TaskListType {
+	if m != nil {
+		return m.TaskListType
 	}
-	if m.PartitionConfig != nil {
-		{
-			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x9a
+	return v1.TaskListType_TASK_LIST_TYPE_INVALID
+}
+
+func (m *MigrateTaskListRequest) GetTargetTaskList() *v1.TaskList {
+	if m != nil {
+		return m.TargetTaskList
 	}
-	if m.LoadBalancerHints != nil {
-		{
-			size, err := m.LoadBalancerHints.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+	return nil
+}
+
+func (m *MigrateTaskListRequest) GetAlias() bool {
+	if m != nil {
+		return m.Alias
 	}
-	if m.Header != nil {
-		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+	return false
+}
+
+func (m *MigrateTaskListRequest) GetStop() bool {
+	if m != nil {
+		return m.Stop
+	}
+	return false
+}
+
+type MigrateTaskListResponse struct {
+	// Not set if the task list is not being migrated.
+	MigrationConfig      *TaskListMigrationConfig            `protobuf:"bytes,1,opt,name=migration_config,json=migrationConfig,proto3" json:"migration_config,omitempty"`
+	Partitions           []*TaskListMigrationPartitionStatus `protobuf:"bytes,2,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *MigrateTaskListResponse) Reset()         { *m = MigrateTaskListResponse{} }
+func (m *MigrateTaskListResponse) String() string { return proto.CompactTextString(m) }
+func (*MigrateTaskListResponse) ProtoMessage()    {}
+func (*MigrateTaskListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{44}
+}
+func (m *MigrateTaskListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrateTaskListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrateTaskListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+		return b[:n], nil
 	}
-	if len(m.WorkflowDomain) > 0 {
-		i -= len(m.WorkflowDomain)
-		copy(dAtA[i:], m.WorkflowDomain)
-		i = encodeVarintService(dAtA, i, uint64(len(m.WorkflowDomain)))
-		i--
-		dAtA[i] = 0x7a
+}
+func (m *MigrateTaskListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateTaskListResponse.Merge(m, src)
+}
+func (m *MigrateTaskListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrateTaskListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateTaskListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateTaskListResponse proto.InternalMessageInfo
+
+func (m *MigrateTaskListResponse) GetMigrationConfig() *TaskListMigrationConfig {
+	if m != nil {
+		return m.MigrationConfig
 	}
-	if m.WorkflowType != nil {
-		{
-			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
+	return nil
+}
+
+func (m *MigrateTaskListResponse) GetPartitions() []*TaskListMigrationPartitionStatus {
+	if m != nil {
+		return m.Partitions
 	}
-	if m.HeartbeatDetails != nil {
-		{
-			size, err := m.HeartbeatDetails.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+	return nil
+}
+
+type TaskListMigrationConfig struct {
+	TargetTaskList       string   `protobuf:"bytes,1,opt,name=target_task_list,json=targetTaskList,proto3" json:"target_task_list,omitempty"`
+	Alias                bool     `protobuf:"varint,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TaskListMigrationConfig) Reset()         { *m = TaskListMigrationConfig{} }
+func (m *TaskListMigrationConfig) String() string { return proto.CompactTextString(m) }
+func (*TaskListMigrationConfig) ProtoMessage()    {}
+func (*TaskListMigrationConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{45}
+}
+func (m *TaskListMigrationConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskListMigrationConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskListMigrationConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x6a
+		return b[:n], nil
 	}
-	if m.ScheduledTimeOfThisAttempt != nil {
-		{
-			size, err := m.ScheduledTimeOfThisAttempt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
+}
+func (m *TaskListMigrationConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskListMigrationConfig.Merge(m, src)
+}
+func (m *TaskListMigrationConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskListMigrationConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskListMigrationConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskListMigrationConfig proto.InternalMessageInfo
+
+func (m *TaskListMigrationConfig) GetTargetTaskList() string {
+	if m != nil {
+		return m.TargetTaskList
 	}
-	if m.Attempt != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Attempt))
-		i--
-		dAtA[i] = 0x58
+	return ""
+}
+
+func (m *TaskListMigrationConfig) GetAlias() bool {
+	if m != nil {
+		return m.Alias
 	}
-	if m.HeartbeatTimeout != nil {
-		{
-			size, err := m.HeartbeatTimeout.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+	return false
+}
+
+type TaskListMigrationPartitionStatus struct {
+	Partition            string   `protobuf:"bytes,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	OwnerHostName        string   `protobuf:"bytes,2,opt,name=owner_host_name,json=ownerHostName,proto3" json:"owner_host_name,omitempty"`
+	BacklogCountHint     int64    `protobuf:"varint,3,opt,name=backlog_count_hint,json=backlogCountHint,proto3" json:"backlog_count_hint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TaskListMigrationPartitionStatus) Reset()         { *m = TaskListMigrationPartitionStatus{} }
+func (m *TaskListMigrationPartitionStatus) String() string { return proto.CompactTextString(m) }
+func (*TaskListMigrationPartitionStatus) ProtoMessage()    {}
+func (*TaskListMigrationPartitionStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{46}
+}
+func (m *TaskListMigrationPartitionStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskListMigrationPartitionStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskListMigrationPartitionStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x52
+		return b[:n], nil
 	}
-	if m.StartToCloseTimeout != nil {
-		{
-			size, err := m.StartToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+}
+func (m *TaskListMigrationPartitionStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskListMigrationPartitionStatus.Merge(m, src)
+}
+func (m *TaskListMigrationPartitionStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskListMigrationPartitionStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskListMigrationPartitionStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskListMigrationPartitionStatus proto.InternalMessageInfo
+
+func (m *TaskListMigrationPartitionStatus) GetPartition() string {
+	if m != nil {
+		return m.Partition
 	}
-	if m.ScheduleToCloseTimeout != nil {
-		{
-			size, err := m.ScheduleToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+	return ""
+}
+
+func (m *TaskListMigrationPartitionStatus) GetOwnerHostName() string {
+	if m != nil {
+		return m.OwnerHostName
 	}
-	if m.StartedTime != nil {
-		{
-			size, err := m.StartedTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+func (m *TaskListMigrationPartitionStatus) GetBacklogCountHint() int64 {
+	if m != nil {
+		return m.BacklogCountHint
 	}
-	if m.ScheduledTime != nil {
-		{
-			size, err := m.ScheduledTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+	return 0
+}
+
+type DescribeDomainTaskQuotaRequest struct {
+	DomainId             string   `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DescribeDomainTaskQuotaRequest) Reset()         { *m = DescribeDomainTaskQuotaRequest{} }
+func (m *DescribeDomainTaskQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeDomainTaskQuotaRequest) ProtoMessage()    {}
+func (*DescribeDomainTaskQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{47}
+}
+func (m *DescribeDomainTaskQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeDomainTaskQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeDomainTaskQuotaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x32
+		return b[:n], nil
 	}
-	if m.Input != nil {
-		{
-			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *DescribeDomainTaskQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeDomainTaskQuotaRequest.Merge(m, src)
+}
+func (m *DescribeDomainTaskQuotaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeDomainTaskQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeDomainTaskQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeDomainTaskQuotaRequest proto.InternalMessageInfo
+
+func (m *DescribeDomainTaskQuotaRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
 	}
-	if m.ActivityType != nil {
-		{
-			size, err := m.ActivityType.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+	return ""
+}
+
+type DescribeDomainTaskQuotaResponse struct {
+	TaskQuota            *DomainTaskQuota `protobuf:"bytes,1,opt,name=task_quota,json=taskQuota,proto3" json:"task_quota,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DescribeDomainTaskQuotaResponse) Reset()         { *m = DescribeDomainTaskQuotaResponse{} }
+func (m *DescribeDomainTaskQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeDomainTaskQuotaResponse) ProtoMessage()    {}
+func (*DescribeDomainTaskQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{48}
+}
+func (m *DescribeDomainTaskQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeDomainTaskQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeDomainTaskQuotaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if len(m.ActivityId) > 0 {
-		i -= len(m.ActivityId)
-		copy(dAtA[i:], m.ActivityId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ActivityId)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *DescribeDomainTaskQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeDomainTaskQuotaResponse.Merge(m, src)
+}
+func (m *DescribeDomainTaskQuotaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeDomainTaskQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeDomainTaskQuotaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeDomainTaskQuotaResponse proto.InternalMessageInfo
+
+func (m *DescribeDomainTaskQuotaResponse) GetTaskQuota() *DomainTaskQuota {
+	if m != nil {
+		return m.TaskQuota
 	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+	return nil
+}
+
+// DomainTaskQuota is the domain-wide task quotas of a domain and their usage on a matching host.
+type DomainTaskQuota struct {
+	AddTaskRpsLimit      float64  `protobuf:"fixed64,1,opt,name=add_task_rps_limit,json=addTaskRpsLimit,proto3" json:"add_task_rps_limit,omitempty"`
+	AddTaskRps           float64  `protobuf:"fixed64,2,opt,name=add_task_rps,json=addTaskRps,proto3" json:"add_task_rps,omitempty"`
+	AddTaskRejectedRps   float64  `protobuf:"fixed64,3,opt,name=add_task_rejected_rps,json=addTaskRejectedRps,proto3" json:"add_task_rejected_rps,omitempty"`
+	DispatchRpsLimit     float64  `protobuf:"fixed64,4,opt,name=dispatch_rps_limit,json=dispatchRpsLimit,proto3" json:"dispatch_rps_limit,omitempty"`
+	DispatchRps          float64  `protobuf:"fixed64,5,opt,name=dispatch_rps,json=dispatchRps,proto3" json:"dispatch_rps,omitempty"`
+	DispatchRejectedRps  float64  `protobuf:"fixed64,6,opt,name=dispatch_rejected_rps,json=dispatchRejectedRps,proto3" json:"dispatch_rejected_rps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DomainTaskQuota) Reset()         { *m = DomainTaskQuota{} }
+func (m *DomainTaskQuota) String() string { return proto.CompactTextString(m) }
+func (*DomainTaskQuota) ProtoMessage()    {}
+func (*DomainTaskQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{49}
+}
+func (m *DomainTaskQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainTaskQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainTaskQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.TaskToken) > 0 {
-		i -= len(m.TaskToken)
-		copy(dAtA[i:], m.TaskToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.TaskToken)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DomainTaskQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainTaskQuota.Merge(m, src)
+}
+func (m *DomainTaskQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainTaskQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainTaskQuota.DiscardUnknown(m)
 }
 
-func (m *AddDecisionTaskRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DomainTaskQuota proto.InternalMessageInfo
+
+func (m *DomainTaskQuota) GetAddTaskRpsLimit() float64 {
+	if m != nil {
+		return m.AddTaskRpsLimit
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AddDecisionTaskRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DomainTaskQuota) GetAddTaskRps() float64 {
+	if m != nil {
+		return m.AddTaskRps
+	}
+	return 0
 }
 
-func (m *AddDecisionTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *DomainTaskQuota) GetAddTaskRejectedRps() float64 {
+	if m != nil {
+		return m.AddTaskRejectedRps
 	}
-	if len(m.PartitionConfig) > 0 {
-		for k := range m.PartitionConfig {
-			v := m.PartitionConfig[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintService(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintService(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintService(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x42
-		}
+	return 0
+}
+
+func (m *DomainTaskQuota) GetDispatchRpsLimit() float64 {
+	if m != nil {
+		return m.DispatchRpsLimit
 	}
-	if len(m.ForwardedFrom) > 0 {
-		i -= len(m.ForwardedFrom)
-		copy(dAtA[i:], m.ForwardedFrom)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ForwardedFrom)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Source != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Source))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.ScheduleToStartTimeout != nil {
-		{
-			size, err := m.ScheduleToStartTimeout.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.ScheduleId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ScheduleId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.TaskList != nil {
-		{
-			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *DomainTaskQuota) GetDispatchRps() float64 {
+	if m != nil {
+		return m.DispatchRps
 	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *DomainTaskQuota) GetDispatchRejectedRps() float64 {
+	if m != nil {
+		return m.DispatchRejectedRps
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *AddDecisionTaskResponse) Marshal() (dAtA []byte, err error) {
+func init() {
+	proto.RegisterEnum("uber.cadence.matching.v1.TaskListScalingConfidence", TaskListScalingConfidence_name, TaskListScalingConfidence_value)
+	proto.RegisterType((*TaskListPartition)(nil), "uber.cadence.matching.v1.TaskListPartition")
+	proto.RegisterType((*TaskListPartitionConfig)(nil), "uber.cadence.matching.v1.TaskListPartitionConfig")
+	proto.RegisterMapType((map[int32]*TaskListPartition)(nil), "uber.cadence.matching.v1.TaskListPartitionConfig.ReadPartitionsEntry")
+	proto.RegisterMapType((map[int32]*TaskListPartition)(nil), "uber.cadence.matching.v1.TaskListPartitionConfig.WritePartitionsEntry")
+	proto.RegisterType((*LoadBalancerHints)(nil), "uber.cadence.matching.v1.LoadBalancerHints")
+	proto.RegisterType((*TaskListVersioningConfig)(nil), "uber.cadence.matching.v1.TaskListVersioningConfig")
+	proto.RegisterType((*CompatibleBuildIdSet)(nil), "uber.cadence.matching.v1.CompatibleBuildIdSet")
+	proto.RegisterType((*BuildIdMetrics)(nil), "uber.cadence.matching.v1.BuildIdMetrics")
+	proto.RegisterType((*PollForDecisionTaskRequest)(nil), "uber.cadence.matching.v1.PollForDecisionTaskRequest")
+	proto.RegisterType((*PollForDecisionTaskResponse)(nil), "uber.cadence.matching.v1.PollForDecisionTaskResponse")
+	proto.RegisterMapType((map[string]*v1.WorkflowQuery)(nil), "uber.cadence.matching.v1.PollForDecisionTaskResponse.QueriesEntry")
+	proto.RegisterType((*PollForActivityTaskRequest)(nil), "uber.cadence.matching.v1.PollForActivityTaskRequest")
+	proto.RegisterType((*PollForActivityTaskResponse)(nil), "uber.cadence.matching.v1.PollForActivityTaskResponse")
+	proto.RegisterType((*AddDecisionTaskRequest)(nil), "uber.cadence.matching.v1.AddDecisionTaskRequest")
+	proto.RegisterMapType((map[string]string)(nil), "uber.cadence.matching.v1.AddDecisionTaskRequest.PartitionConfigEntry")
+	proto.RegisterType((*AddDecisionTaskResponse)(nil), "uber.cadence.matching.v1.AddDecisionTaskResponse")
+	proto.RegisterType((*AddActivityTaskRequest)(nil), "uber.cadence.matching.v1.AddActivityTaskRequest")
+	proto.RegisterMapType((map[string]string)(nil), "uber.cadence.matching.v1.AddActivityTaskRequest.PartitionConfigEntry")
+	proto.RegisterType((*ActivityTaskDispatchInfo)(nil), "uber.cadence.matching.v1.ActivityTaskDispatchInfo")
+	proto.RegisterType((*AddActivityTaskResponse)(nil), "uber.cadence.matching.v1.AddActivityTaskResponse")
+	proto.RegisterType((*QueryWorkflowRequest)(nil), "uber.cadence.matching.v1.QueryWorkflowRequest")
+	proto.RegisterType((*QueryWorkflowResponse)(nil), "uber.cadence.matching.v1.QueryWorkflowResponse")
+	proto.RegisterType((*RespondQueryTaskCompletedRequest)(nil), "uber.cadence.matching.v1.RespondQueryTaskCompletedRequest")
+	proto.RegisterType((*RespondQueryTaskCompletedResponse)(nil), "uber.cadence.matching.v1.RespondQueryTaskCompletedResponse")
+	proto.RegisterType((*CancelOutstandingPollRequest)(nil), "uber.cadence.matching.v1.CancelOutstandingPollRequest")
+	proto.RegisterType((*CancelOutstandingPollResponse)(nil), "uber.cadence.matching.v1.CancelOutstandingPollResponse")
+	proto.RegisterType((*DescribeTaskListRequest)(nil), "uber.cadence.matching.v1.DescribeTaskListRequest")
+	proto.RegisterType((*DescribeTaskListResponse)(nil), "uber.cadence.matching.v1.DescribeTaskListResponse")
+	proto.RegisterMapType((map[string]*BuildIdMetrics)(nil), "uber.cadence.matching.v1.DescribeTaskListResponse.BuildIdMetricsEntry")
+	proto.RegisterType((*ListTaskListPartitionsRequest)(nil), "uber.cadence.matching.v1.ListTaskListPartitionsRequest")
+	proto.RegisterType((*ListTaskListPartitionsResponse)(nil), "uber.cadence.matching.v1.ListTaskListPartitionsResponse")
+	proto.RegisterType((*GetTaskListsByDomainRequest)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainRequest")
+	proto.RegisterType((*GetTaskListsByDomainResponse)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainResponse")
+	proto.RegisterMapType((map[string]*DescribeTaskListResponse)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainResponse.ActivityTaskListMapEntry")
+	proto.RegisterMapType((map[string]*DescribeTaskListResponse)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainResponse.DecisionTaskListMapEntry")
+	proto.RegisterType((*UpdateTaskListPartitionConfigRequest)(nil), "uber.cadence.matching.v1.UpdateTaskListPartitionConfigRequest")
+	proto.RegisterType((*UpdateTaskListPartitionConfigResponse)(nil), "uber.cadence.matching.v1.UpdateTaskListPartitionConfigResponse")
+	proto.RegisterType((*RefreshTaskListPartitionConfigRequest)(nil), "uber.cadence.matching.v1.RefreshTaskListPartitionConfigRequest")
+	proto.RegisterType((*RefreshTaskListPartitionConfigResponse)(nil), "uber.cadence.matching.v1.RefreshTaskListPartitionConfigResponse")
+	proto.RegisterType((*UpdateTaskListVersioningConfigRequest)(nil), "uber.cadence.matching.v1.UpdateTaskListVersioningConfigRequest")
+	proto.RegisterType((*AddCompatibleBuildId)(nil), "uber.cadence.matching.v1.AddCompatibleBuildId")
+	proto.RegisterType((*UpdateTaskListVersioningConfigResponse)(nil), "uber.cadence.matching.v1.UpdateTaskListVersioningConfigResponse")
+	proto.RegisterType((*GetTaskListScalingRecommendationRequest)(nil), "uber.cadence.matching.v1.GetTaskListScalingRecommendationRequest")
+	proto.RegisterType((*GetTaskListScalingRecommendationResponse)(nil), "uber.cadence.matching.v1.GetTaskListScalingRecommendationResponse")
+	proto.RegisterType((*TaskListScalingSignals)(nil), "uber.cadence.matching.v1.TaskListScalingSignals")
+	proto.RegisterType((*ListWorkersRequest)(nil), "uber.cadence.matching.v1.ListWorkersRequest")
+	proto.RegisterType((*ListWorkersResponse)(nil), "uber.cadence.matching.v1.ListWorkersResponse")
+	proto.RegisterType((*DescribeWorkerRequest)(nil), "uber.cadence.matching.v1.DescribeWorkerRequest")
+	proto.RegisterType((*DescribeWorkerResponse)(nil), "uber.cadence.matching.v1.DescribeWorkerResponse")
+	proto.RegisterType((*WorkerInfo)(nil), "uber.cadence.matching.v1.WorkerInfo")
+	proto.RegisterType((*WorkerTaskListInfo)(nil), "uber.cadence.matching.v1.WorkerTaskListInfo")
+	proto.RegisterType((*MigrateTaskListRequest)(nil), "uber.cadence.matching.v1.MigrateTaskListRequest")
+	proto.RegisterType((*MigrateTaskListResponse)(nil), "uber.cadence.matching.v1.MigrateTaskListResponse")
+	proto.RegisterType((*TaskListMigrationConfig)(nil), "uber.cadence.matching.v1.TaskListMigrationConfig")
+	proto.RegisterType((*TaskListMigrationPartitionStatus)(nil), "uber.cadence.matching.v1.TaskListMigrationPartitionStatus")
+	proto.RegisterType((*DescribeDomainTaskQuotaRequest)(nil), "uber.cadence.matching.v1.DescribeDomainTaskQuotaRequest")
+	proto.RegisterType((*DescribeDomainTaskQuotaResponse)(nil), "uber.cadence.matching.v1.DescribeDomainTaskQuotaResponse")
+	proto.RegisterType((*DomainTaskQuota)(nil), "uber.cadence.matching.v1.DomainTaskQuota")
+}
+
+func init() {
+	proto.RegisterFile("uber/cadence/matching/v1/service.proto", fileDescriptor_826e827d3aabf7fc)
+}
+
+var fileDescriptor_826e827d3aabf7fc = []byte{
+	// 3803 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0xcb, 0x6f, 0x24, 0x49,
+	0x5a, 0x27, 0xcb, 0x2e, 0x3f, 0xbe, 0xb2, 0xcb, 0xe5, 0xb0, 0xdb, 0x5d, 0xae, 0xee, 0x76, 0xbb,
+	0xb3, 0x5f, 0xde, 0xdd, 0xd9, 0xf2, 0xb4, 0xe7, 0x41, 0xcf, 0x2c, 0x33, 0xb3, 0x7e, 0x75, 0xbb,
+	0x76, 0xed, 0xee, 0x9e, 0xb4, 0xa7, 0x5b, 0x2c, 0xab, 0x49, 0xc2, 0x95, 0x61, 0x3b, 0xd7, 0x59,
+	0x99, 0xd5, 0x19, 0x51, 0xf6, 0x78, 0x0f, 0x1c, 0x10, 0x8b, 0x40, 0x08, 0x4e, 0x2c, 0xe2, 0xc8,
+	0x4b, 0x42, 0x48, 0x5c, 0x38, 0x23, 0x71, 0x43, 0xda, 0x23, 0x12, 0xc7, 0x11, 0x12, 0x3b, 0x88,
+	0x3f, 0x00, 0xb8, 0x72, 0x40, 0xf1, 0xc8, 0xac, 0xcc, 0xca, 0xcc, 0x7a, 0xd9, 0x3d, 0x03, 0x12,
+	0xb7, 0xca, 0x88, 0xef, 0xfb, 0xe2, 0x8b, 0x2f, 0xbe, 0xc7, 0x2f, 0x1e, 0x36, 0x3c, 0x68, 0x1d,
+	0x12, 0x7f, 0xb5, 0x8e, 0x2d, 0xe2, 0xd6, 0xc9, 0x6a, 0x03, 0xb3, 0xfa, 0x89, 0xed, 0x1e, 0xaf,
+	0x9e, 0x3d, 0x5a, 0xa5, 0xc4, 0x3f, 0xb3, 0xeb, 0xa4, 0xda, 0xf4, 0x3d, 0xe6, 0xa1, 0x32, 0xa7,
+	0xab, 0x2a, 0xba, 0x6a, 0x40, 0x57, 0x3d, 0x7b, 0x54, 0x59, 0x3a, 0xf6, 0xbc, 0x63, 0x87, 0xac,
+	0x0a, 0xba, 0xc3, 0xd6, 0xd1, 0xaa, 0xd5, 0xf2, 0x31, 0xb3, 0x3d, 0x57, 0x72, 0x56, 0x6e, 0x77,
+	0xf6, 0x33, 0xbb, 0x41, 0x28, 0xc3, 0x8d, 0xa6, 0x22, 0x48, 0x08, 0x38, 0xf7, 0x71, 0xb3, 0x49,
+	0x7c, 0xaa, 0xfa, 0x97, 0x63, 0x2a, 0xe2, 0xa6, 0xcd, 0xb5, 0xab, 0x7b, 0x8d, 0x46, 0x7b, 0x88,
+	0x34, 0x8a, 0xd7, 0x2d, 0xe2, 0x5f, 0x28, 0x02, 0x3d, 0x8d, 0x80, 0x61, 0x7a, 0xea, 0xd8, 0x94,
+	0x29, 0x9a, 0x95, 0x34, 0x1a, 0x65, 0x04, 0xf3, 0xdc, 0xf3, 0x4f, 0x89, 0xaf, 0x28, 0xbf, 0xdd,
+	0x8b, 0xf2, 0xc8, 0xf1, 0xce, 0x15, 0xed, 0x9d, 0x34, 0xda, 0x13, 0x9b, 0x32, 0x2f, 0x54, 0xee,
+	0x5e, 0x8c, 0x84, 0x9e, 0x60, 0x9f, 0x58, 0x49, 0xaa, 0xfb, 0x19, 0x54, 0xf1, 0x59, 0xe8, 0x1f,
+	0xc3, 0xec, 0x01, 0xa6, 0xa7, 0xbb, 0x36, 0x65, 0x2f, 0xb0, 0xcf, 0x6c, 0xbe, 0x10, 0xe8, 0x5b,
+	0x50, 0xb2, 0xa9, 0xe7, 0x88, 0x55, 0x31, 0x8f, 0x7d, 0xaf, 0xd5, 0xa4, 0x65, 0x6d, 0x79, 0x64,
+	0x65, 0xd2, 0x98, 0x09, 0xdb, 0x9f, 0x8a, 0x66, 0xfd, 0x97, 0xa3, 0x70, 0x3d, 0x21, 0x60, 0xd3,
+	0x73, 0x8f, 0xec, 0x63, 0x54, 0x86, 0xf1, 0x33, 0xe2, 0x53, 0xdb, 0x73, 0xcb, 0xda, 0xb2, 0xb6,
+	0x32, 0x62, 0x04, 0x9f, 0x68, 0x0d, 0xe6, 0xdc, 0x56, 0xc3, 0xf4, 0x09, 0xb6, 0xcc, 0x66, 0xc0,
+	0x45, 0xcb, 0xb9, 0x65, 0x6d, 0x25, 0xbf, 0x91, 0x2b, 0x6b, 0xc6, 0xac, 0xdb, 0x6a, 0x18, 0x04,
+	0x5b, 0xa1, 0x48, 0x8a, 0xde, 0x85, 0x79, 0xce, 0x73, 0xee, 0xdb, 0x8c, 0x44, 0x99, 0x46, 0x42,
+	0x26, 0xe4, 0xb6, 0x1a, 0xaf, 0x78, 0x77, 0x84, 0xcb, 0x85, 0x99, 0xce, 0x51, 0x46, 0x97, 0x47,
+	0x56, 0x0a, 0x6b, 0xdb, 0xd5, 0x2c, 0x0f, 0xad, 0x66, 0xcc, 0xa7, 0x1a, 0x57, 0x68, 0xdb, 0x65,
+	0xfe, 0x85, 0x51, 0xf4, 0xe3, 0x5a, 0xbe, 0x86, 0x52, 0x42, 0xc3, 0xbc, 0x18, 0xf0, 0xc9, 0xe0,
+	0x03, 0x76, 0x4c, 0x46, 0x8e, 0x38, 0x73, 0x1e, 0x6f, 0xad, 0xb8, 0x30, 0x97, 0xa2, 0x19, 0x2a,
+	0xc1, 0xc8, 0x29, 0xb9, 0x10, 0x96, 0xcf, 0x1b, 0xfc, 0x27, 0x5a, 0x87, 0xfc, 0x19, 0x76, 0x5a,
+	0x44, 0xd8, 0xb9, 0xb0, 0xf6, 0x9d, 0x01, 0x14, 0x32, 0x24, 0xe7, 0x87, 0xb9, 0xc7, 0x5a, 0xc5,
+	0x83, 0xf9, 0x34, 0xc5, 0xde, 0xd8, 0x80, 0xfa, 0x6f, 0xc2, 0xec, 0xae, 0x87, 0xad, 0x0d, 0xec,
+	0x60, 0xb7, 0x4e, 0xfc, 0x1d, 0xdb, 0x65, 0x14, 0xdd, 0x85, 0xe9, 0x43, 0x5c, 0x3f, 0x75, 0xbc,
+	0x63, 0xb3, 0xee, 0xb5, 0x5c, 0xa6, 0x5c, 0x6c, 0x4a, 0x35, 0x6e, 0xf2, 0x36, 0xf4, 0x00, 0x66,
+	0x7c, 0xcc, 0x17, 0x83, 0xf8, 0x26, 0x25, 0x75, 0xcf, 0xb5, 0x84, 0x2a, 0x9a, 0x31, 0xcd, 0x9b,
+	0x5f, 0x10, 0x7f, 0x5f, 0x34, 0xea, 0x7f, 0xa8, 0x41, 0x39, 0x50, 0xe1, 0xa5, 0xf4, 0x51, 0xdb,
+	0x3d, 0xee, 0xe9, 0xc6, 0xaf, 0x60, 0xa6, 0xee, 0x35, 0x9a, 0x98, 0xd9, 0x87, 0x0e, 0x31, 0x29,
+	0x61, 0xdc, 0x85, 0xf9, 0x5a, 0x57, 0xb3, 0x67, 0xba, 0x19, 0x32, 0x6c, 0xb4, 0x6c, 0xc7, 0xaa,
+	0x59, 0xfb, 0x84, 0x19, 0xc5, 0xb6, 0x98, 0x7d, 0xc2, 0xa8, 0xfe, 0x0e, 0xcc, 0xa7, 0xd1, 0xa1,
+	0x1b, 0x30, 0x79, 0xc8, 0xbf, 0x4c, 0xdb, 0x0a, 0x22, 0x72, 0xe2, 0x50, 0x76, 0x53, 0x1d, 0x43,
+	0x51, 0x91, 0xee, 0x11, 0xe6, 0xdb, 0x75, 0x8a, 0xde, 0x02, 0x14, 0xb3, 0x91, 0x79, 0x62, 0x87,
+	0x86, 0x2a, 0x45, 0x0d, 0xc5, 0x4d, 0x8a, 0xee, 0xc0, 0x54, 0xd3, 0x73, 0x1c, 0xe2, 0x2b, 0x83,
+	0xe6, 0x04, 0x5d, 0x41, 0xb6, 0x09, 0x32, 0xfd, 0x3f, 0x34, 0xa8, 0xbc, 0xf0, 0x1c, 0xe7, 0x89,
+	0xe7, 0x6f, 0x91, 0xba, 0xcd, 0x8d, 0xc0, 0xcd, 0x66, 0x90, 0xd7, 0x2d, 0x42, 0x19, 0xaa, 0xc1,
+	0xb8, 0x2f, 0x7f, 0x8a, 0x41, 0x0a, 0x6b, 0xab, 0x71, 0x3b, 0xe0, 0xa6, 0xcd, 0x4d, 0x90, 0x2d,
+	0xc1, 0x08, 0xf8, 0xf9, 0x4c, 0x2d, 0xaf, 0x81, 0x6d, 0xd7, 0xb4, 0xe5, 0x9a, 0x4d, 0x1a, 0x13,
+	0xb2, 0xa1, 0x66, 0xf1, 0x4e, 0xa5, 0xa9, 0x6d, 0x89, 0xf8, 0x9f, 0x34, 0x26, 0x64, 0x43, 0xcd,
+	0x42, 0xf7, 0xa1, 0x78, 0xe4, 0xf9, 0xe7, 0xd8, 0xb7, 0x88, 0x65, 0x1e, 0xf9, 0x5e, 0xa3, 0x3c,
+	0x2a, 0x28, 0xa6, 0xc3, 0xd6, 0x27, 0xbe, 0xd7, 0x40, 0x0f, 0x61, 0xa6, 0x23, 0xc7, 0x95, 0xf3,
+	0x82, 0xae, 0x18, 0x4f, 0x71, 0xfa, 0x3f, 0x14, 0xe0, 0x46, 0xaa, 0xc6, 0xb4, 0xe9, 0xb9, 0x94,
+	0xa0, 0x5b, 0x00, 0x3c, 0xa7, 0x9a, 0xcc, 0x3b, 0x25, 0xd2, 0x43, 0xa6, 0x8c, 0x49, 0xde, 0x72,
+	0xc0, 0x1b, 0xd0, 0x67, 0x80, 0x82, 0x14, 0x6f, 0x92, 0x2f, 0x48, 0xbd, 0xc5, 0x25, 0xab, 0x80,
+	0x78, 0x90, 0x6a, 0x9e, 0x57, 0x8a, 0x7c, 0x3b, 0xa0, 0x36, 0x66, 0xcf, 0x3b, 0x9b, 0xd0, 0x13,
+	0x98, 0x0e, 0xc5, 0xb2, 0x8b, 0x26, 0x11, 0x66, 0x28, 0xac, 0xdd, 0xe9, 0x2a, 0xf1, 0xe0, 0xa2,
+	0x49, 0x8c, 0xa9, 0xf3, 0xc8, 0x17, 0x7a, 0x09, 0x8b, 0x4d, 0x9f, 0x9c, 0xd9, 0x5e, 0x8b, 0x9a,
+	0x94, 0x61, 0x9f, 0x11, 0xcb, 0x24, 0x67, 0xc4, 0x65, 0xdc, 0xb4, 0xa3, 0x42, 0xe6, 0x8d, 0xaa,
+	0x2c, 0xb8, 0xd5, 0xa0, 0xe0, 0x56, 0x6b, 0x2e, 0x7b, 0xff, 0xdd, 0x97, 0x3c, 0x3e, 0x8d, 0x85,
+	0x80, 0x7b, 0x5f, 0x32, 0x6f, 0x73, 0xde, 0x9a, 0x85, 0x56, 0xa0, 0x94, 0x10, 0x97, 0x17, 0x0e,
+	0x55, 0xa4, 0x71, 0xca, 0x32, 0x8c, 0x63, 0xc6, 0x48, 0xa3, 0xc9, 0xca, 0x63, 0x22, 0x75, 0x04,
+	0x9f, 0x48, 0x87, 0x69, 0x97, 0x7c, 0xc1, 0xda, 0x02, 0xc6, 0xa5, 0x47, 0xf2, 0xc6, 0x80, 0x3b,
+	0xdd, 0xc5, 0x27, 0x32, 0x5c, 0xfc, 0x31, 0x94, 0x29, 0xb3, 0xeb, 0xa7, 0x17, 0xed, 0xa5, 0x30,
+	0x89, 0x8b, 0x0f, 0x1d, 0x62, 0x95, 0x27, 0x97, 0xb5, 0x95, 0x09, 0x63, 0x41, 0xf6, 0x87, 0x86,
+	0xde, 0x96, 0xbd, 0xe8, 0x31, 0xe4, 0x05, 0x40, 0x28, 0x83, 0xb0, 0x89, 0xde, 0xd5, 0xce, 0x9f,
+	0x72, 0x4a, 0x43, 0x32, 0x20, 0x03, 0xa6, 0x2d, 0xe5, 0x37, 0xa6, 0xed, 0x1e, 0x79, 0xe5, 0x82,
+	0x90, 0xf0, 0xdd, 0xb8, 0x04, 0x59, 0xa0, 0x45, 0x2a, 0xf4, 0xb1, 0x4b, 0x6d, 0xe2, 0xb2, 0xc0,
+	0xdb, 0x6a, 0xee, 0x91, 0x67, 0x4c, 0x59, 0x91, 0x2f, 0xf4, 0x39, 0xdc, 0x4c, 0x3a, 0x95, 0x29,
+	0xdc, 0x90, 0xd7, 0xf6, 0xf2, 0x94, 0x18, 0xe2, 0x56, 0xaa, 0x92, 0x41, 0x9e, 0x33, 0x16, 0x13,
+	0x5e, 0x15, 0x74, 0xa1, 0x2a, 0xcc, 0x49, 0xa3, 0x73, 0x44, 0x41, 0xcc, 0x20, 0xfd, 0x4d, 0x8b,
+	0xf5, 0x99, 0x15, 0x5d, 0xfb, 0xbc, 0x47, 0xe5, 0x4a, 0x9e, 0x3a, 0x0e, 0x7d, 0xec, 0xd6, 0x4f,
+	0x54, 0x14, 0x14, 0x45, 0x14, 0x14, 0x64, 0x9b, 0x8c, 0x83, 0x75, 0x28, 0xd2, 0xfa, 0x09, 0xb1,
+	0x5a, 0x0e, 0xb1, 0x4c, 0x0e, 0xe9, 0xca, 0x33, 0x42, 0xc9, 0x4a, 0xc2, 0xbb, 0x0e, 0x02, 0xbc,
+	0x67, 0x4c, 0x87, 0x1c, 0xbc, 0x0d, 0x7d, 0x04, 0x53, 0x81, 0x4f, 0x09, 0x01, 0xa5, 0x9e, 0x02,
+	0x0a, 0x8a, 0x5e, 0xb0, 0xff, 0x18, 0xc6, 0xf9, 0x8a, 0xd8, 0x84, 0x96, 0x67, 0x45, 0x96, 0xde,
+	0xc8, 0xce, 0xd2, 0x5d, 0x02, 0xbe, 0xfa, 0xa9, 0x14, 0x22, 0xab, 0x71, 0x20, 0x92, 0x9b, 0x8c,
+	0x79, 0x0c, 0x3b, 0xa6, 0x82, 0x61, 0xe6, 0xe1, 0x05, 0x23, 0xb4, 0x8c, 0x84, 0x27, 0xce, 0x8a,
+	0xae, 0x1d, 0xd9, 0xb3, 0xc1, 0x3b, 0xd0, 0x8f, 0xa1, 0x14, 0x42, 0x04, 0xb3, 0x2e, 0x2a, 0x4d,
+	0x79, 0x4e, 0x4c, 0xe8, 0xd1, 0xc0, 0x40, 0xc1, 0x98, 0x69, 0x76, 0x40, 0xaf, 0xdf, 0x80, 0x39,
+	0xc7, 0xc3, 0x96, 0x79, 0xa8, 0x6a, 0xa6, 0x08, 0x0b, 0x5a, 0x9e, 0xef, 0x55, 0x87, 0x13, 0x75,
+	0xd6, 0x98, 0x75, 0x12, 0xa5, 0x77, 0x0f, 0x4a, 0xb8, 0xc5, 0x3c, 0xa5, 0xb5, 0x8c, 0xb8, 0x6b,
+	0x42, 0xf2, 0xdd, 0x54, 0x8f, 0x5b, 0x6f, 0x31, 0x4f, 0xea, 0xc5, 0xf9, 0x8d, 0x22, 0x8e, 0x7d,
+	0x57, 0x3e, 0x87, 0xa9, 0xa8, 0x49, 0xa3, 0x38, 0x62, 0x52, 0xe2, 0x88, 0xc7, 0x71, 0x1c, 0xd1,
+	0x57, 0xf0, 0xb5, 0xe1, 0x43, 0xa4, 0x68, 0xad, 0xd7, 0x99, 0x7d, 0x66, 0xb3, 0x8b, 0xe1, 0x8b,
+	0x56, 0x8a, 0x84, 0xff, 0x8d, 0x45, 0xeb, 0xe7, 0x10, 0x16, 0xad, 0xb8, 0xc6, 0xdf, 0x68, 0xd1,
+	0xba, 0x0d, 0x05, 0xac, 0xb4, 0x69, 0x1b, 0x01, 0x82, 0xa6, 0x9a, 0xc5, 0xab, 0x5a, 0x48, 0x20,
+	0xaa, 0xda, 0x68, 0x97, 0xaa, 0x16, 0x4e, 0x4c, 0x54, 0x35, 0x1c, 0xf9, 0x42, 0x6b, 0x90, 0xb7,
+	0xdd, 0x66, 0x8b, 0x09, 0xeb, 0x14, 0xd6, 0x6e, 0xa6, 0xaf, 0x28, 0xbe, 0xe0, 0xbe, 0x6d, 0x48,
+	0xd2, 0x94, 0x04, 0x35, 0x76, 0xd9, 0x04, 0x35, 0x3e, 0x58, 0x82, 0x3a, 0x80, 0xc5, 0x40, 0x9e,
+	0xc9, 0xc3, 0xcb, 0xf1, 0x28, 0x11, 0x82, 0xbc, 0x96, 0x2c, 0x69, 0x85, 0xb5, 0xc5, 0x84, 0xac,
+	0x2d, 0xb5, 0x7b, 0x36, 0x16, 0x02, 0xde, 0x03, 0x6f, 0x93, 0x73, 0x1e, 0x48, 0x46, 0xf4, 0x0c,
+	0x16, 0xc4, 0x20, 0x49, 0x91, 0x93, 0xbd, 0x44, 0xce, 0x09, 0xc6, 0x0e, 0x79, 0x4f, 0x60, 0xf6,
+	0x84, 0x60, 0x9f, 0x1d, 0x12, 0xcc, 0x42, 0x51, 0xd0, 0x4b, 0x54, 0x29, 0xe4, 0x09, 0xe4, 0x44,
+	0xea, 0x7e, 0x21, 0x5e, 0xf7, 0x3f, 0x87, 0xa5, 0xf8, 0x4a, 0x98, 0xde, 0x91, 0xc9, 0x4e, 0x6c,
+	0x6a, 0x06, 0x0c, 0x53, 0x3d, 0x0d, 0x5b, 0x89, 0xad, 0xcc, 0xf3, 0xa3, 0x83, 0x13, 0x9b, 0xae,
+	0x2b, 0xf9, 0xb5, 0xe8, 0x0c, 0x2c, 0xc2, 0xb0, 0xed, 0x50, 0x51, 0xdb, 0x7a, 0x79, 0x4a, 0x7b,
+	0x12, 0x5b, 0x92, 0x2b, 0x09, 0xc3, 0x8a, 0xc3, 0xc1, 0xb0, 0x87, 0x30, 0x13, 0xca, 0x91, 0x19,
+	0x43, 0x94, 0xc7, 0x49, 0xa3, 0x18, 0x34, 0x6f, 0x89, 0x56, 0xf4, 0x0e, 0x8c, 0x9d, 0x10, 0x6c,
+	0x11, 0x5f, 0x55, 0xbf, 0x1b, 0xa9, 0x23, 0xed, 0x08, 0x12, 0x43, 0x91, 0x66, 0x55, 0x83, 0xd9,
+	0x2b, 0xa9, 0x06, 0x6f, 0xb6, 0x90, 0xa5, 0xd5, 0x9a, 0xf9, 0xa1, 0x6b, 0x8d, 0xfe, 0x5f, 0xa3,
+	0xb0, 0xb0, 0x6e, 0x59, 0x69, 0x9b, 0x97, 0x58, 0xf2, 0xd6, 0x3a, 0x92, 0xf7, 0x1b, 0x4a, 0x88,
+	0x1f, 0xc2, 0x64, 0x1b, 0xb4, 0x8d, 0xf4, 0x03, 0xda, 0x26, 0x58, 0x80, 0xd1, 0x6e, 0x43, 0x21,
+	0xcc, 0x16, 0x0a, 0xab, 0x8f, 0x18, 0x10, 0x34, 0xd5, 0xac, 0xce, 0x74, 0xa2, 0x92, 0x80, 0x0a,
+	0xd8, 0xfc, 0x00, 0xe9, 0x44, 0x40, 0xfb, 0x20, 0x6c, 0x3f, 0x84, 0x31, 0xea, 0xb5, 0xfc, 0xba,
+	0x4c, 0x8f, 0xc5, 0xce, 0x62, 0x1c, 0xc1, 0xb1, 0x98, 0x9e, 0xee, 0x0b, 0x4a, 0x43, 0x71, 0xa4,
+	0x54, 0xb9, 0xf1, 0xb4, 0x2a, 0xd7, 0x4c, 0xf1, 0xa8, 0x89, 0x5e, 0x87, 0x36, 0xe9, 0xab, 0x5a,
+	0xed, 0x70, 0x30, 0x75, 0x84, 0xd2, 0xe9, 0x65, 0x8b, 0x30, 0x11, 0xec, 0xab, 0x45, 0x56, 0x9c,
+	0x34, 0xc6, 0xd5, 0xb6, 0xba, 0xb2, 0x01, 0xf3, 0x69, 0x32, 0x52, 0x50, 0xca, 0x7c, 0x14, 0xa5,
+	0x4c, 0x46, 0x11, 0xc8, 0x39, 0x5c, 0x4f, 0xa8, 0xa7, 0x0a, 0x71, 0x5a, 0xf4, 0x68, 0x57, 0x15,
+	0x3d, 0xfa, 0x7f, 0xe6, 0x85, 0xbb, 0xa7, 0xc1, 0x9e, 0x6f, 0xc2, 0xdd, 0xf9, 0xa6, 0x50, 0x78,
+	0x82, 0xd9, 0x1e, 0x5a, 0x82, 0x80, 0xa2, 0x6c, 0xdf, 0x0a, 0x14, 0x88, 0x05, 0xc6, 0xe8, 0xa5,
+	0x02, 0x23, 0x3f, 0x58, 0x60, 0x8c, 0x5d, 0x3e, 0x30, 0xc6, 0xaf, 0x20, 0x30, 0x26, 0xd2, 0x02,
+	0xc3, 0x85, 0x32, 0x8e, 0x2c, 0xe5, 0x96, 0x4d, 0x9b, 0xdc, 0x2b, 0xf8, 0x96, 0x50, 0x15, 0xf3,
+	0xb5, 0x2e, 0x01, 0x92, 0xc1, 0x69, 0x64, 0xca, 0x4c, 0x0d, 0x44, 0xe8, 0x23, 0x10, 0x53, 0xfc,
+	0xad, 0xbf, 0x40, 0xbc, 0x92, 0x68, 0xfb, 0x72, 0x04, 0xca, 0x59, 0x93, 0x45, 0x3f, 0x80, 0x99,
+	0x36, 0xb6, 0x10, 0x1b, 0x59, 0x15, 0x6e, 0xe9, 0x25, 0x5b, 0x6d, 0xd9, 0xc4, 0x69, 0x83, 0xd1,
+	0xc6, 0x87, 0xe2, 0x3b, 0x01, 0xf7, 0x72, 0x83, 0xc1, 0xbd, 0x08, 0x00, 0x1a, 0x19, 0x14, 0x00,
+	0x8d, 0x5e, 0x3d, 0x00, 0xca, 0x5f, 0x0d, 0x00, 0x1a, 0xbb, 0x32, 0x00, 0x34, 0x9e, 0x06, 0x80,
+	0x54, 0x2e, 0x4d, 0xdd, 0xd4, 0xbc, 0xd9, 0x5c, 0xfa, 0xa5, 0x06, 0xf3, 0x62, 0x6f, 0x19, 0xcc,
+	0x22, 0xc8, 0xa4, 0x9b, 0x9d, 0x1b, 0xc8, 0x6f, 0xa5, 0x4e, 0x3e, 0x8d, 0xb7, 0xcf, 0xad, 0xe3,
+	0x65, 0x60, 0x42, 0x7f, 0x3b, 0x4b, 0xfd, 0xbf, 0x35, 0xb8, 0xd6, 0xa1, 0xa1, 0xb2, 0xea, 0x27,
+	0x30, 0x25, 0x0e, 0xb2, 0x4c, 0x9f, 0xd0, 0x96, 0x13, 0xcc, 0xb1, 0xbb, 0x9f, 0x14, 0x04, 0x87,
+	0x21, 0x18, 0x50, 0x0d, 0x8a, 0x81, 0x80, 0x9f, 0x90, 0x3a, 0x23, 0x56, 0xd7, 0x6d, 0xbc, 0xdc,
+	0xbe, 0x2b, 0x4a, 0x63, 0xfa, 0x75, 0xf4, 0x13, 0xbd, 0x4a, 0x59, 0x61, 0x69, 0x8f, 0xb7, 0xba,
+	0xda, 0xa3, 0xe7, 0xe2, 0xfe, 0xbb, 0x06, 0xcb, 0x72, 0xc6, 0x96, 0x50, 0x80, 0x33, 0x6e, 0x7a,
+	0x8d, 0xa6, 0x43, 0xb8, 0x16, 0x6a, 0x8d, 0x9e, 0x77, 0x2e, 0xf4, 0x7b, 0xa9, 0x83, 0xf6, 0x92,
+	0xf3, 0x35, 0x2c, 0xfa, 0x75, 0x18, 0x17, 0xbc, 0x0a, 0x17, 0x4e, 0x1a, 0x63, 0xfc, 0xb3, 0x66,
+	0xe9, 0x77, 0xe1, 0x4e, 0x17, 0xf5, 0xe4, 0x8a, 0xeb, 0xff, 0xa2, 0xc1, 0xcd, 0x4d, 0x8e, 0xf0,
+	0x9d, 0xe7, 0x2d, 0x46, 0x19, 0x76, 0x2d, 0xdb, 0x3d, 0x7e, 0xe1, 0x39, 0x4e, 0x5f, 0xd8, 0x21,
+	0x76, 0xce, 0x91, 0xeb, 0x38, 0xe7, 0x78, 0x0a, 0xc5, 0x70, 0x52, 0xed, 0x73, 0xeb, 0x62, 0x46,
+	0xbe, 0x08, 0x66, 0x26, 0xf3, 0x05, 0x8b, 0x7c, 0x5d, 0x06, 0x20, 0xe8, 0xb7, 0xe1, 0x56, 0xc6,
+	0xf4, 0x94, 0x01, 0x7e, 0x0b, 0xae, 0x6f, 0x11, 0x5a, 0xf7, 0xed, 0x43, 0x12, 0xb2, 0xab, 0xa9,
+	0x3f, 0xe9, 0xf4, 0x81, 0x74, 0xc7, 0xcb, 0x60, 0xef, 0x6f, 0xe9, 0xf5, 0xbf, 0x1e, 0x83, 0x72,
+	0x52, 0x82, 0x8a, 0xc7, 0x0f, 0x60, 0x5c, 0x9a, 0x53, 0xde, 0x00, 0x15, 0xd6, 0x6e, 0x67, 0x9e,
+	0x57, 0x11, 0x5f, 0x14, 0xf8, 0x80, 0x9e, 0x6f, 0xa6, 0xda, 0xd6, 0xa7, 0x0c, 0xb3, 0x16, 0x55,
+	0xb1, 0x78, 0xb7, 0xab, 0xed, 0xf6, 0x05, 0xa9, 0x51, 0x64, 0xb1, 0xef, 0x37, 0x16, 0x8d, 0x97,
+	0x42, 0x7f, 0x26, 0xcc, 0x9e, 0x85, 0x37, 0x78, 0x81, 0x56, 0xf9, 0x5e, 0xe0, 0x28, 0xeb, 0xf2,
+	0xcf, 0x28, 0x9d, 0x75, 0x5e, 0x07, 0x36, 0xa1, 0x14, 0xec, 0x15, 0xcc, 0x86, 0xbc, 0x68, 0x2b,
+	0x8f, 0xf5, 0xba, 0xe1, 0xcd, 0x5a, 0xcd, 0x6a, 0xfc, 0xc6, 0x4e, 0xdd, 0x29, 0x1f, 0xc6, 0xaf,
+	0xf1, 0x3e, 0x82, 0x1b, 0x96, 0xc2, 0x30, 0x1c, 0x0f, 0x60, 0x7a, 0x4a, 0xa3, 0x37, 0x9a, 0xe3,
+	0xe2, 0x46, 0xb3, 0xdc, 0x26, 0xe1, 0x83, 0xd0, 0xf0, 0x72, 0x53, 0xa0, 0xee, 0x0b, 0xb7, 0x6e,
+	0x0a, 0x7d, 0x4c, 0x81, 0x62, 0x05, 0xbc, 0xd4, 0x8c, 0x22, 0x6f, 0xdf, 0xe3, 0xcd, 0x06, 0x6f,
+	0x45, 0x9b, 0x70, 0x3b, 0x09, 0x8c, 0x1d, 0xcc, 0x88, 0x5b, 0xbf, 0x30, 0x6d, 0xd7, 0x6c, 0x50,
+	0x01, 0x33, 0x47, 0xda, 0xe0, 0x42, 0x61, 0xe0, 0x5d, 0x49, 0x53, 0x73, 0xf7, 0x68, 0xe5, 0x14,
+	0xe6, 0x52, 0x26, 0x95, 0x82, 0xe0, 0x3e, 0x8e, 0x9f, 0xea, 0xae, 0x64, 0x5b, 0x2f, 0x2e, 0x2f,
+	0x8a, 0xf5, 0x28, 0xdc, 0x12, 0x29, 0xa1, 0xd3, 0xb3, 0x68, 0x10, 0xaf, 0x0b, 0x30, 0xa6, 0xe0,
+	0x84, 0x1c, 0x59, 0x7d, 0xc5, 0x5d, 0x2c, 0x37, 0x58, 0xfe, 0xf8, 0xdd, 0x1c, 0x2c, 0x65, 0x8d,
+	0xaa, 0x82, 0xf4, 0x35, 0xdc, 0x6a, 0x1f, 0x64, 0x86, 0x21, 0x17, 0x79, 0x13, 0xa0, 0xa5, 0xdd,
+	0x13, 0x67, 0xc5, 0xc9, 0x1e, 0x61, 0xd8, 0xc2, 0x0c, 0x1b, 0x95, 0x28, 0x54, 0x8f, 0x0f, 0xcd,
+	0x87, 0x0c, 0xef, 0x99, 0x52, 0x87, 0xcc, 0x0d, 0x37, 0xa4, 0x15, 0xd9, 0xb6, 0xc6, 0x87, 0xd4,
+	0xdf, 0x83, 0x1b, 0x4f, 0x49, 0x68, 0x06, 0xba, 0x71, 0x21, 0x31, 0x5a, 0x0f, 0xdb, 0xeb, 0x7f,
+	0x35, 0x0a, 0x37, 0xd3, 0xf9, 0x94, 0xf5, 0x7e, 0x47, 0x83, 0x85, 0x94, 0xb9, 0x34, 0x70, 0x53,
+	0xd9, 0xed, 0x79, 0xb6, 0xaf, 0x74, 0x13, 0x5c, 0xdd, 0xea, 0x98, 0xcb, 0x1e, 0x6e, 0xca, 0x90,
+	0x9b, 0xb3, 0x92, 0x3d, 0x42, 0x8d, 0x94, 0x55, 0xe4, 0x6a, 0xe4, 0x2e, 0xa5, 0xc6, 0x7a, 0xc7,
+	0x2a, 0xb6, 0xd5, 0xc0, 0xc9, 0x9e, 0xca, 0x4f, 0x79, 0x31, 0x48, 0xd7, 0x3b, 0x25, 0xaa, 0x76,
+	0xe2, 0x51, 0xb5, 0x36, 0x78, 0x4e, 0x8a, 0xbe, 0xf5, 0xf8, 0x69, 0x7c, 0x2b, 0xf5, 0x75, 0x8e,
+	0xad, 0xff, 0x79, 0x0e, 0xee, 0x7d, 0xd6, 0xb4, 0x30, 0x23, 0x59, 0x85, 0xa3, 0x1f, 0x38, 0x72,
+	0x89, 0x40, 0xbf, 0x3a, 0xb4, 0x92, 0x56, 0x29, 0x47, 0xaf, 0x02, 0xb7, 0x3e, 0x84, 0xfb, 0x3d,
+	0x4c, 0xa4, 0x20, 0xcd, 0x5f, 0xe4, 0xe0, 0xbe, 0x41, 0x8e, 0x7c, 0x42, 0x4f, 0xfe, 0xdf, 0x9a,
+	0x59, 0xd6, 0x5c, 0x81, 0x07, 0xbd, 0x6c, 0xa4, 0xcc, 0xf9, 0xcb, 0x5c, 0xa7, 0xe1, 0x13, 0xc8,
+	0xa1, 0x1f, 0x73, 0xb6, 0x33, 0x64, 0x2e, 0xbb, 0x3a, 0x0d, 0x88, 0xfd, 0xdf, 0x87, 0x32, 0xb6,
+	0x2c, 0xd3, 0x25, 0xe7, 0xa6, 0x45, 0x8e, 0x70, 0xcb, 0x61, 0x66, 0x78, 0xb6, 0x29, 0x37, 0x03,
+	0xf3, 0xd8, 0xb2, 0x9e, 0x91, 0xf3, 0x2d, 0xd9, 0xab, 0x8a, 0x2b, 0x22, 0x70, 0x9d, 0xf3, 0x45,
+	0x1e, 0x34, 0x85, 0x6c, 0x12, 0x3e, 0x55, 0xbb, 0x9e, 0xf9, 0x24, 0xde, 0x2b, 0x89, 0x61, 0x12,
+	0xad, 0x1c, 0x8d, 0x34, 0x7d, 0xaf, 0xe1, 0xb1, 0x88, 0xfc, 0x31, 0xb9, 0xd3, 0x57, 0xed, 0x8a,
+	0x52, 0xff, 0xb9, 0x06, 0xf3, 0x69, 0x82, 0x63, 0xa7, 0xb5, 0x5a, 0xec, 0xb4, 0x16, 0x7d, 0x02,
+	0x37, 0xc9, 0x17, 0x36, 0x65, 0x12, 0xfb, 0x25, 0x67, 0x22, 0xcd, 0xbc, 0x18, 0xd0, 0x24, 0x65,
+	0xdf, 0x81, 0xa9, 0x06, 0x3e, 0x25, 0x81, 0xe9, 0x84, 0xf1, 0x27, 0x8c, 0x02, 0x6f, 0x53, 0xf6,
+	0xd2, 0x7f, 0x5f, 0x83, 0x07, 0xbd, 0xd6, 0x5e, 0x15, 0xb2, 0x54, 0x30, 0xaa, 0x5d, 0x1d, 0x18,
+	0xd5, 0xff, 0x4d, 0x83, 0x87, 0x91, 0x52, 0xb3, 0x5f, 0xc7, 0x8e, 0xed, 0x1e, 0x1b, 0xa4, 0xee,
+	0x35, 0x1a, 0xc4, 0xb5, 0xe4, 0x89, 0xe5, 0x37, 0xe5, 0x89, 0xc9, 0x80, 0x1f, 0x1d, 0x2a, 0xe0,
+	0xf5, 0x9f, 0xe5, 0x60, 0xa5, 0xf7, 0x2c, 0x95, 0xcd, 0x1f, 0x43, 0xd9, 0x0f, 0x7a, 0x88, 0x65,
+	0xc6, 0x9e, 0xb4, 0xc9, 0xa7, 0x6f, 0x0b, 0x91, 0xfe, 0x17, 0xed, 0xd7, 0x6d, 0x68, 0x1f, 0x40,
+	0x2c, 0x91, 0x50, 0x4b, 0xd8, 0xa1, 0xb8, 0xf6, 0x4e, 0xef, 0x65, 0x52, 0xea, 0x6c, 0x86, 0xac,
+	0x46, 0x44, 0x0c, 0xfa, 0x01, 0x8c, 0x53, 0xfb, 0xd8, 0xc5, 0x0e, 0x55, 0xe6, 0x7b, 0xbb, 0x6f,
+	0x89, 0xfb, 0x92, 0xcf, 0x08, 0x04, 0xe8, 0xbf, 0xc8, 0xc1, 0x42, 0x3a, 0xcd, 0x80, 0x4f, 0xfd,
+	0x56, 0x81, 0x07, 0x67, 0x72, 0x2b, 0x21, 0x1f, 0x47, 0xce, 0x62, 0xab, 0x73, 0x0f, 0xd1, 0x63,
+	0x0b, 0x32, 0x32, 0xc4, 0x16, 0x64, 0x74, 0xd8, 0x2d, 0x48, 0xbe, 0xd7, 0x16, 0x24, 0xf1, 0x92,
+	0x71, 0x2c, 0xf9, 0x92, 0xb1, 0x06, 0x88, 0x5b, 0xf1, 0x95, 0x78, 0xa7, 0x4d, 0x2f, 0x13, 0x22,
+	0xfa, 0x67, 0x30, 0x17, 0x13, 0xa5, 0xfc, 0xf0, 0x63, 0x18, 0x97, 0xaf, 0xc0, 0x03, 0xb0, 0x7f,
+	0x2f, 0x7b, 0xe1, 0x25, 0xaf, 0xdc, 0xac, 0x2b, 0x26, 0xfd, 0x04, 0xae, 0x05, 0x28, 0x49, 0x76,
+	0x5f, 0x2a, 0x8e, 0x2b, 0x30, 0xc1, 0x1d, 0x92, 0xd9, 0xec, 0x22, 0x78, 0x7c, 0x12, 0x7c, 0xeb,
+	0x2f, 0x61, 0xa1, 0x73, 0x24, 0x35, 0x87, 0x5f, 0x83, 0x31, 0xa9, 0x8e, 0x4a, 0x5a, 0xfd, 0x4d,
+	0x41, 0xf1, 0xe8, 0x7f, 0x9b, 0x03, 0x68, 0x37, 0xc7, 0x54, 0xd0, 0xe2, 0x2a, 0xa0, 0x1a, 0x4c,
+	0xa9, 0xdc, 0x26, 0xdf, 0xc8, 0xf5, 0xba, 0x6a, 0x22, 0xbe, 0x4a, 0x8e, 0x62, 0xc0, 0xc2, 0x59,
+	0xfb, 0x03, 0xfd, 0x50, 0x3d, 0x6d, 0xe1, 0x59, 0x87, 0xc7, 0xdc, 0x48, 0x12, 0x17, 0x24, 0xf5,
+	0x0e, 0xa2, 0x4a, 0x88, 0x9b, 0x0c, 0x92, 0x0f, 0x45, 0xdf, 0x87, 0xa2, 0x83, 0xf9, 0x36, 0xca,
+	0x73, 0x1c, 0x79, 0xc8, 0xdf, 0xfb, 0xe4, 0x7d, 0x8a, 0x73, 0xf0, 0xbc, 0x22, 0x4e, 0xf9, 0x79,
+	0xbd, 0xf3, 0x1c, 0x27, 0x16, 0x2e, 0x79, 0xe9, 0xfa, 0xa2, 0xbd, 0xfd, 0x08, 0xf9, 0x4b, 0x0d,
+	0x50, 0x52, 0x9b, 0x78, 0x06, 0xd6, 0x2e, 0x9b, 0x81, 0x73, 0xc3, 0x41, 0xae, 0xa4, 0x1d, 0x46,
+	0x06, 0xb3, 0x83, 0xfe, 0x8f, 0x39, 0x58, 0xd8, 0xb3, 0x8f, 0xfd, 0x48, 0xd9, 0xfc, 0x3f, 0x5f,
+	0x98, 0xd0, 0x53, 0x28, 0x31, 0xec, 0x1f, 0x13, 0x16, 0x79, 0x7b, 0x99, 0xef, 0x47, 0x97, 0xa2,
+	0x64, 0x0b, 0x1f, 0x5c, 0xce, 0x43, 0x1e, 0x3b, 0x36, 0xa6, 0x22, 0x55, 0x4d, 0x18, 0xf2, 0x03,
+	0x21, 0x18, 0xa5, 0xcc, 0x6b, 0x8a, 0x13, 0x9e, 0x09, 0x43, 0xfc, 0xd6, 0xff, 0x59, 0x83, 0xeb,
+	0x09, 0x3b, 0xb6, 0x2f, 0x40, 0x1a, 0xa2, 0x6b, 0x98, 0x0b, 0x90, 0xbd, 0x80, 0x33, 0x40, 0xc7,
+	0x8d, 0x78, 0x03, 0xfa, 0x11, 0x40, 0xe2, 0x34, 0xe1, 0xc3, 0x01, 0xe4, 0x86, 0x58, 0x5a, 0x1d,
+	0x27, 0x46, 0xa4, 0xe9, 0xbf, 0xde, 0xfe, 0x2b, 0x92, 0x0e, 0x3d, 0x78, 0x00, 0x25, 0x6c, 0x2c,
+	0x9d, 0x24, 0xd3, 0x88, 0xb9, 0x88, 0x11, 0xf5, 0x3f, 0xd1, 0x60, 0xb9, 0x97, 0x2e, 0xe8, 0x26,
+	0x4c, 0x86, 0xda, 0x28, 0xe9, 0xed, 0x06, 0xf4, 0x00, 0x66, 0xbc, 0x73, 0x97, 0xf8, 0xe6, 0x89,
+	0x47, 0x99, 0xe9, 0xe2, 0x46, 0x70, 0xeb, 0x38, 0x2d, 0x9a, 0x77, 0x3c, 0xca, 0x9e, 0xe1, 0x06,
+	0xc9, 0x28, 0xc2, 0x23, 0xe9, 0x45, 0x58, 0xff, 0x08, 0x96, 0x82, 0xb4, 0x2b, 0xcf, 0x07, 0xb8,
+	0x96, 0x9f, 0xb6, 0x3c, 0x86, 0xfb, 0x09, 0x0c, 0xfd, 0x14, 0x6e, 0x67, 0xb2, 0x2b, 0x7f, 0xd8,
+	0x51, 0xa9, 0xf0, 0x35, 0x6f, 0x4d, 0xbf, 0x9c, 0x8a, 0x6d, 0xca, 0x3b, 0xc4, 0x88, 0x00, 0x13,
+	0x3f, 0xf5, 0x3f, 0xcd, 0xc1, 0x4c, 0x47, 0x37, 0xfa, 0x0e, 0xa0, 0x00, 0x44, 0x98, 0x7e, 0x93,
+	0x9a, 0x8e, 0xdd, 0xb0, 0xe5, 0xd2, 0x68, 0xc6, 0x8c, 0x82, 0x10, 0x46, 0x93, 0xee, 0xf2, 0x66,
+	0xb4, 0x0c, 0x53, 0x51, 0x62, 0x85, 0x34, 0xa0, 0x4d, 0x86, 0x1e, 0xc1, 0xb5, 0x36, 0x85, 0xba,
+	0xf0, 0x11, 0xa4, 0x12, 0x5c, 0xa0, 0x80, 0x34, 0xb8, 0x1a, 0x6a, 0x0a, 0xd0, 0x13, 0x40, 0x8e,
+	0x88, 0x06, 0x12, 0x58, 0x94, 0x82, 0x9e, 0x50, 0x85, 0x3b, 0x30, 0x15, 0xa5, 0x56, 0x59, 0xb8,
+	0x10, 0xa1, 0x43, 0x6b, 0x70, 0xad, 0x4d, 0x12, 0xd5, 0x61, 0x4c, 0xd0, 0xce, 0x85, 0xb4, 0x6d,
+	0x25, 0xbe, 0xfd, 0xf7, 0x1a, 0x2c, 0x66, 0x42, 0x41, 0xb4, 0x02, 0xf7, 0x0e, 0xd6, 0xf7, 0x7f,
+	0x68, 0xee, 0xd6, 0xf6, 0x0f, 0xcc, 0xfd, 0xcd, 0xf5, 0xdd, 0xda, 0xb3, 0xa7, 0xe6, 0xe6, 0xf3,
+	0x67, 0x4f, 0x6a, 0x5b, 0xdb, 0xcf, 0x36, 0xb7, 0xcd, 0xda, 0xb3, 0x97, 0xeb, 0xbb, 0xb5, 0xad,
+	0xd2, 0xaf, 0xa0, 0x7b, 0xb0, 0xdc, 0x95, 0x72, 0xf7, 0xf9, 0xab, 0x92, 0x86, 0x1e, 0xc2, 0xdd,
+	0xae, 0x54, 0x7b, 0xdb, 0x5b, 0xb5, 0xcf, 0xf6, 0x4a, 0x39, 0x74, 0x1f, 0xee, 0x74, 0x25, 0xdc,
+	0xa9, 0x3d, 0xdd, 0x29, 0x8d, 0xac, 0xfd, 0xdd, 0x1c, 0x14, 0xf6, 0x94, 0x0f, 0xac, 0xbf, 0xa8,
+	0xa1, 0xdf, 0xd6, 0x60, 0x2e, 0xe5, 0xf1, 0x33, 0x7a, 0x77, 0xc0, 0xb7, 0xd2, 0xc2, 0x81, 0x2b,
+	0xef, 0x0d, 0xf5, 0xc2, 0x3a, 0xaa, 0x44, 0xf4, 0xf4, 0xa9, 0x0f, 0x25, 0x52, 0x5e, 0x1e, 0xf4,
+	0xa1, 0x44, 0xea, 0x6d, 0xf2, 0x19, 0xcc, 0x74, 0x3c, 0xda, 0x41, 0x6f, 0x0f, 0xfa, 0xfc, 0xa8,
+	0xf2, 0x68, 0x00, 0x8e, 0xd8, 0xb8, 0xb1, 0x79, 0xbf, 0x3d, 0xe8, 0x6b, 0x8b, 0x1e, 0xe3, 0xa6,
+	0xce, 0xb7, 0x09, 0xd3, 0xb1, 0x0b, 0x60, 0xd4, 0x65, 0xbf, 0x9f, 0x76, 0x97, 0x5d, 0x59, 0xed,
+	0x9b, 0x5e, 0x8d, 0xf8, 0xc7, 0x1a, 0x2c, 0x66, 0xde, 0x46, 0xa2, 0x2e, 0xa5, 0xa5, 0xd7, 0x0d,
+	0x6b, 0xe5, 0x7b, 0x43, 0xf1, 0x2a, 0xb5, 0x7e, 0x4f, 0x83, 0x6b, 0xa9, 0xf7, 0x83, 0xe8, 0xfd,
+	0x2e, 0x7f, 0xd6, 0xd5, 0xe5, 0xbe, 0xb4, 0xf2, 0xab, 0x03, 0xf3, 0x29, 0x55, 0x2e, 0xa0, 0xd4,
+	0x79, 0x52, 0x8a, 0x1e, 0x0d, 0x72, 0xaa, 0x2a, 0xc7, 0x1f, 0xe2, 0x20, 0x16, 0xfd, 0x81, 0x06,
+	0x0b, 0xe9, 0x97, 0x1c, 0xa8, 0xcb, 0x74, 0xba, 0x5e, 0xc6, 0x54, 0x1e, 0x0f, 0xce, 0xa8, 0xb4,
+	0xf9, 0x99, 0x06, 0xf3, 0x69, 0x47, 0xea, 0xe8, 0xbd, 0x41, 0x8f, 0xe0, 0xa5, 0x26, 0xef, 0x0f,
+	0x77, 0x72, 0x8f, 0xfe, 0x4c, 0x83, 0x5b, 0x5d, 0x0f, 0x5c, 0xd1, 0xc7, 0xd9, 0x92, 0xfb, 0x39,
+	0xcc, 0xae, 0x7c, 0x32, 0x34, 0xbf, 0x52, 0xf1, 0x2f, 0x35, 0x58, 0xea, 0x7e, 0x8a, 0x89, 0x3e,
+	0xe9, 0x16, 0x1e, 0x7d, 0x9c, 0x11, 0x57, 0xbe, 0x3f, 0xbc, 0x80, 0x88, 0x96, 0xdd, 0x0f, 0xd1,
+	0x50, 0xdf, 0x96, 0xc8, 0x38, 0x7a, 0xed, 0xa6, 0x65, 0x9f, 0xe7, 0x77, 0x7f, 0xa3, 0xc1, 0x72,
+	0xaf, 0x83, 0x27, 0xb4, 0xde, 0x97, 0x2f, 0x75, 0x3b, 0x9a, 0xab, 0x6c, 0x5c, 0x46, 0x84, 0xd2,
+	0xf5, 0x27, 0x50, 0x88, 0x1c, 0x43, 0xa0, 0xb7, 0xba, 0xc7, 0x5a, 0xfc, 0xe0, 0xa3, 0xf2, 0xdd,
+	0x3e, 0xa9, 0xd5, 0x58, 0x14, 0x8a, 0xf1, 0x13, 0x03, 0xb4, 0xda, 0x3b, 0xc5, 0xc4, 0x4e, 0x31,
+	0x2a, 0x6f, 0xf7, 0xcf, 0xd0, 0x2e, 0x8c, 0x1d, 0x1b, 0x9f, 0x6e, 0x85, 0x31, 0x7d, 0xaf, 0xd9,
+	0xad, 0x30, 0x66, 0xed, 0xaa, 0xfe, 0x48, 0x6b, 0x3f, 0x07, 0xe9, 0xc4, 0xc0, 0x8f, 0x7b, 0xcf,
+	0x22, 0x1d, 0xdb, 0x57, 0x3e, 0x18, 0x82, 0x53, 0x2a, 0xb4, 0xf1, 0xf4, 0x17, 0x5f, 0x2d, 0x69,
+	0xff, 0xf4, 0xd5, 0x92, 0xf6, 0xaf, 0x5f, 0x2d, 0x69, 0x3f, 0xfa, 0xe0, 0xd8, 0x66, 0x27, 0xad,
+	0xc3, 0x6a, 0xdd, 0x6b, 0xac, 0xc6, 0xfe, 0xe6, 0xbf, 0x7a, 0x4c, 0x5c, 0xf9, 0x4f, 0x12, 0xa2,
+	0xff, 0xa7, 0xe1, 0x7b, 0xc1, 0xef, 0xb3, 0x47, 0x87, 0x63, 0xa2, 0xf7, 0x9d, 0xff, 0x09, 0x00,
+	0x00, 0xff, 0xff, 0x70, 0x32, 0x9e, 0x08, 0xd5, 0x41, 0x00, 0x00,
+}
+
+func (m *TaskListPartition) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3202,12 +3880,12 @@ func (m *AddDecisionTaskResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddDecisionTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TaskListPartition) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddDecisionTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TaskListPartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3216,22 +3894,19 @@ func (m *AddDecisionTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PartitionConfig != nil {
-		{
-			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+	if len(m.IsolationGroups) > 0 {
+		for iNdEx := len(m.IsolationGroups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IsolationGroups[iNdEx])
+			copy(dAtA[i:], m.IsolationGroups[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.IsolationGroups[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AddActivityTaskRequest) Marshal() (dAtA []byte, err error) {
+func (m *TaskListPartitionConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3241,12 +3916,12 @@ func (m *AddActivityTaskRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddActivityTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TaskListPartitionConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TaskListPartitionConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3255,108 +3930,111 @@ func (m *AddActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PartitionConfig) > 0 {
-		for k := range m.PartitionConfig {
-			v := m.PartitionConfig[k]
+	if len(m.WritePartitions) > 0 {
+		for k := range m.WritePartitions {
+			v := m.WritePartitions[k]
 			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintService(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintService(dAtA, i, uint64(len(k)))
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintService(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintService(dAtA, i, uint64(k))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 			i = encodeVarintService(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0x2a
 		}
 	}
-	if m.ActivityTaskDispatchInfo != nil {
-		{
-			size, err := m.ActivityTaskDispatchInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.ReadPartitions) > 0 {
+		for k := range m.ReadPartitions {
+			v := m.ReadPartitions[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintService(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
 			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+			i = encodeVarintService(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
 		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.ForwardedFrom) > 0 {
-		i -= len(m.ForwardedFrom)
-		copy(dAtA[i:], m.ForwardedFrom)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ForwardedFrom)))
-		i--
-		dAtA[i] = 0x42
 	}
-	if m.Source != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Source))
+	if m.NumWritePartitions != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.NumWritePartitions))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x18
 	}
-	if m.ScheduleToStartTimeout != nil {
-		{
-			size, err := m.ScheduleToStartTimeout.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if m.NumReadPartitions != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.NumReadPartitions))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x10
 	}
-	if m.ScheduleId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ScheduleId))
+	if m.Version != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Version))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x8
 	}
-	if m.TaskList != nil {
-		{
-			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+
+func (m *LoadBalancerHints) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.SourceDomainId) > 0 {
-		i -= len(m.SourceDomainId)
-		copy(dAtA[i:], m.SourceDomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.SourceDomainId)))
-		i--
-		dAtA[i] = 0x1a
+	return dAtA[:n], nil
+}
+
+func (m *LoadBalancerHints) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LoadBalancerHints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if m.RatePerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RatePerSecond))))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x11
 	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+	if m.BacklogCount != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.BacklogCount))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ActivityTaskDispatchInfo) Marshal() (dAtA []byte, err error) {
+func (m *TaskListVersioningConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3366,12 +4044,12 @@ func (m *ActivityTaskDispatchInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ActivityTaskDispatchInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *TaskListVersioningConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ActivityTaskDispatchInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TaskListVersioningConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3380,82 +4058,65 @@ func (m *ActivityTaskDispatchInfo) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.WorkflowDomain) > 0 {
-		i -= len(m.WorkflowDomain)
-		copy(dAtA[i:], m.WorkflowDomain)
-		i = encodeVarintService(dAtA, i, uint64(len(m.WorkflowDomain)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.WorkflowType != nil {
-		{
-			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.HeartbeatDetails != nil {
-		{
-			size, err := m.HeartbeatDetails.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.CompatibleSets) > 0 {
+		for iNdEx := len(m.CompatibleSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompatibleSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x2a
 	}
-	if m.ScheduledTimeOfThisAttempt != nil {
-		{
-			size, err := m.ScheduledTimeOfThisAttempt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if m.Version != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Version))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x8
 	}
-	if m.Attempt != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Attempt))
-		i--
-		dAtA[i] = 0x18
+	return len(dAtA) - i, nil
+}
+
+func (m *CompatibleBuildIdSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.StartedTime != nil {
-		{
-			size, err := m.StartedTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return dAtA[:n], nil
+}
+
+func (m *CompatibleBuildIdSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompatibleBuildIdSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ScheduledEvent != nil {
-		{
-			size, err := m.ScheduledEvent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+	if len(m.BuildIds) > 0 {
+		for iNdEx := len(m.BuildIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BuildIds[iNdEx])
+			copy(dAtA[i:], m.BuildIds[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.BuildIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AddActivityTaskResponse) Marshal() (dAtA []byte, err error) {
+func (m *BuildIdMetrics) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3465,12 +4126,12 @@ func (m *AddActivityTaskResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddActivityTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *BuildIdMetrics) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddActivityTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BuildIdMetrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3479,22 +4140,20 @@ func (m *AddActivityTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PartitionConfig != nil {
-		{
-			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if m.PollerCount != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.PollerCount))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	if m.BacklogCountHint != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.BacklogCountHint))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryWorkflowRequest) Marshal() (dAtA []byte, err error) {
+func (m *PollForDecisionTaskRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3504,12 +4163,12 @@ func (m *QueryWorkflowRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PollForDecisionTaskRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PollForDecisionTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3518,6 +4177,13 @@ func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.IsolationGroup) > 0 {
+		i -= len(m.IsolationGroup)
+		copy(dAtA[i:], m.IsolationGroup)
+		i = encodeVarintService(dAtA, i, uint64(len(m.IsolationGroup)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.ForwardedFrom) > 0 {
 		i -= len(m.ForwardedFrom)
 		copy(dAtA[i:], m.ForwardedFrom)
@@ -3525,15 +4191,10 @@ func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.TaskList != nil {
-		{
-			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if len(m.PollerId) > 0 {
+		i -= len(m.PollerId)
+		copy(dAtA[i:], m.PollerId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.PollerId)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -3559,7 +4220,7 @@ func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryWorkflowResponse) Marshal() (dAtA []byte, err error) {
+func (m *PollForDecisionTaskResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3569,12 +4230,12 @@ func (m *QueryWorkflowResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryWorkflowResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PollForDecisionTaskResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PollForDecisionTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3583,9 +4244,9 @@ func (m *QueryWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PartitionConfig != nil {
+	if m.AutoConfigHint != nil {
 		{
-			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.AutoConfigHint.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3593,11 +4254,13 @@ func (m *QueryWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	if m.QueryRejected != nil {
+	if m.LoadBalancerHints != nil {
 		{
-			size, err := m.QueryRejected.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.LoadBalancerHints.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3605,11 +4268,13 @@ func (m *QueryWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	if m.QueryResult != nil {
+	if m.PartitionConfig != nil {
 		{
-			size, err := m.QueryResult.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3617,45 +4282,48 @@ func (m *QueryWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RespondQueryTaskCompletedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *RespondQueryTaskCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RespondQueryTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
 	}
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.TaskId)))
+	if m.TotalHistoryBytes != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TotalHistoryBytes))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.TaskList != nil {
+	if len(m.Queries) > 0 {
+		for k := range m.Queries {
+			v := m.Queries[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintService(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.StartedTime != nil {
 		{
-			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.StartedTime.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3663,18 +4331,37 @@ func (m *RespondQueryTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (in
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+	if m.ScheduledTime != nil {
+		{
+			size, err := m.ScheduledTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x7a
 	}
-	if m.Request != nil {
+	if len(m.BranchToken) > 0 {
+		i -= len(m.BranchToken)
+		copy(dAtA[i:], m.BranchToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.BranchToken)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.EventStoreVersion != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.EventStoreVersion))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.WorkflowExecutionTaskList != nil {
 		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WorkflowExecutionTaskList.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3682,39 +4369,109 @@ func (m *RespondQueryTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (in
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x62
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RespondQueryTaskCompletedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.DecisionInfo != nil {
+		{
+			size, err := m.DecisionInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RespondQueryTaskCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RespondQueryTaskCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.Query != nil {
+		{
+			size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.StickyExecutionEnabled {
+		i--
+		if m.StickyExecutionEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.BacklogCountHint != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.BacklogCountHint))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.NextEventId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.NextEventId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartedEventId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.StartedEventId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PreviousStartedEventId != nil {
+		{
+			size, err := m.PreviousStartedEventId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskToken) > 0 {
+		i -= len(m.TaskToken)
+		copy(dAtA[i:], m.TaskToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TaskToken)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CancelOutstandingPollRequest) Marshal() (dAtA []byte, err error) {
+func (m *PollForActivityTaskRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3724,12 +4481,12 @@ func (m *CancelOutstandingPollRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CancelOutstandingPollRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PollForActivityTaskRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CancelOutstandingPollRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PollForActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3738,41 +4495,50 @@ func (m *CancelOutstandingPollRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TaskList != nil {
-		{
-			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if len(m.IsolationGroup) > 0 {
+		i -= len(m.IsolationGroup)
+		copy(dAtA[i:], m.IsolationGroup)
+		i = encodeVarintService(dAtA, i, uint64(len(m.IsolationGroup)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x2a
 	}
-	if m.TaskListType != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.TaskListType))
+	if len(m.ForwardedFrom) > 0 {
+		i -= len(m.ForwardedFrom)
+		copy(dAtA[i:], m.ForwardedFrom)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ForwardedFrom)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x22
 	}
 	if len(m.PollerId) > 0 {
 		i -= len(m.PollerId)
 		copy(dAtA[i:], m.PollerId)
 		i = encodeVarintService(dAtA, i, uint64(len(m.PollerId)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
 	if len(m.DomainId) > 0 {
 		i -= len(m.DomainId)
 		copy(dAtA[i:], m.DomainId)
 		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CancelOutstandingPollResponse) Marshal() (dAtA []byte, err error) {
+func (m *PollForActivityTaskResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3782,12 +4548,12 @@ func (m *CancelOutstandingPollResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CancelOutstandingPollResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PollForActivityTaskResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CancelOutstandingPollResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PollForActivityTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3796,43 +4562,37 @@ func (m *CancelOutstandingPollResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DescribeTaskListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *DescribeTaskListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DescribeTaskListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.AutoConfigHint != nil {
+		{
+			size, err := m.AutoConfigHint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+	if m.PartitionConfig != nil {
+		{
+			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
 	}
-	if m.Request != nil {
+	if m.LoadBalancerHints != nil {
 		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.LoadBalancerHints.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3840,38 +4600,34 @@ func (m *DescribeTaskListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DescribeTaskListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DescribeTaskListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DescribeTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.WorkflowDomain) > 0 {
+		i -= len(m.WorkflowDomain)
+		copy(dAtA[i:], m.WorkflowDomain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.WorkflowDomain)))
+		i--
+		dAtA[i] = 0x7a
 	}
-	if m.TaskList != nil {
+	if m.WorkflowType != nil {
 		{
-			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3879,11 +4635,11 @@ func (m *DescribeTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x72
 	}
-	if m.PartitionConfig != nil {
+	if m.HeartbeatDetails != nil {
 		{
-			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.HeartbeatDetails.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3891,11 +4647,11 @@ func (m *DescribeTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x6a
 	}
-	if m.TaskListStatus != nil {
+	if m.ScheduledTimeOfThisAttempt != nil {
 		{
-			size, err := m.TaskListStatus.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ScheduledTimeOfThisAttempt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3903,52 +4659,107 @@ func (m *DescribeTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x62
 	}
-	if len(m.Pollers) > 0 {
-		for iNdEx := len(m.Pollers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pollers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
+	if m.Attempt != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.HeartbeatTimeout != nil {
+		{
+			size, err := m.HeartbeatTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x52
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ListTaskListPartitionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.StartToCloseTimeout != nil {
+		{
+			size, err := m.StartToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListTaskListPartitionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ListTaskListPartitionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.ScheduleToCloseTimeout != nil {
+		{
+			size, err := m.ScheduleToCloseTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.TaskList != nil {
+	if m.StartedTime != nil {
 		{
-			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.StartedTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ScheduledTime != nil {
+		{
+			size, err := m.ScheduledTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ActivityType != nil {
+		{
+			size, err := m.ActivityType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ActivityId) > 0 {
+		i -= len(m.ActivityId)
+		copy(dAtA[i:], m.ActivityId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ActivityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3958,17 +4769,17 @@ func (m *ListTaskListPartitionsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+	if len(m.TaskToken) > 0 {
+		i -= len(m.TaskToken)
+		copy(dAtA[i:], m.TaskToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TaskToken)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ListTaskListPartitionsResponse) Marshal() (dAtA []byte, err error) {
+func (m *AddDecisionTaskRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3978,12 +4789,12 @@ func (m *ListTaskListPartitionsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListTaskListPartitionsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddDecisionTaskRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListTaskListPartitionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddDecisionTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3992,38 +4803,96 @@ func (m *ListTaskListPartitionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DecisionTaskListPartitions) > 0 {
-		for iNdEx := len(m.DecisionTaskListPartitions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DecisionTaskListPartitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.PartitionConfig) > 0 {
+		for k := range m.PartitionConfig {
+			v := m.PartitionConfig[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintService(dAtA, i, uint64(len(v)))
 			i--
 			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
 		}
 	}
-	if len(m.ActivityTaskListPartitions) > 0 {
-		for iNdEx := len(m.ActivityTaskListPartitions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ActivityTaskListPartitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
+	if len(m.ForwardedFrom) > 0 {
+		i -= len(m.ForwardedFrom)
+		copy(dAtA[i:], m.ForwardedFrom)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ForwardedFrom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Source != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ScheduleToStartTimeout != nil {
+		{
+			size, err := m.ScheduleToStartTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ScheduleId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTaskListsByDomainRequest) Marshal() (dAtA []byte, err error) {
+func (m *AddDecisionTaskResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4033,12 +4902,12 @@ func (m *GetTaskListsByDomainRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTaskListsByDomainRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddDecisionTaskResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTaskListsByDomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddDecisionTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4047,17 +4916,22 @@ func (m *GetTaskListsByDomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+	if m.PartitionConfig != nil {
+		{
+			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTaskListsByDomainResponse) Marshal() (dAtA []byte, err error) {
+func (m *AddActivityTaskRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4067,12 +4941,12 @@ func (m *GetTaskListsByDomainResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTaskListsByDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddActivityTaskRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTaskListsByDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4081,22 +4955,15 @@ func (m *GetTaskListsByDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ActivityTaskListMap) > 0 {
-		for k := range m.ActivityTaskListMap {
-			v := m.ActivityTaskListMap[k]
+	if len(m.PartitionConfig) > 0 {
+		for k := range m.PartitionConfig {
+			v := m.PartitionConfig[k]
 			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintService(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintService(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
 			i -= len(k)
 			copy(dAtA[i:], k)
 			i = encodeVarintService(dAtA, i, uint64(len(k)))
@@ -4104,65 +4971,36 @@ func (m *GetTaskListsByDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 			dAtA[i] = 0xa
 			i = encodeVarintService(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x52
 		}
 	}
-	if len(m.DecisionTaskListMap) > 0 {
-		for k := range m.DecisionTaskListMap {
-			v := m.DecisionTaskListMap[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintService(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
+	if m.ActivityTaskDispatchInfo != nil {
+		{
+			size, err := m.ActivityTaskDispatchInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintService(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintService(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *UpdateTaskListPartitionConfigRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.ForwardedFrom) > 0 {
+		i -= len(m.ForwardedFrom)
+		copy(dAtA[i:], m.ForwardedFrom)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ForwardedFrom)))
+		i--
+		dAtA[i] = 0x42
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateTaskListPartitionConfigRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UpdateTaskListPartitionConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.Source != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.PartitionConfig != nil {
+	if m.ScheduleToStartTimeout != nil {
 		{
-			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ScheduleToStartTimeout.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4170,12 +5008,12 @@ func (m *UpdateTaskListPartitionConfigRequest) MarshalToSizedBuffer(dAtA []byte)
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x32
 	}
-	if m.TaskListType != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.TaskListType))
+	if m.ScheduleId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ScheduleId))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x28
 	}
 	if m.TaskList != nil {
 		{
@@ -4187,6 +5025,25 @@ func (m *UpdateTaskListPartitionConfigRequest) MarshalToSizedBuffer(dAtA []byte)
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceDomainId) > 0 {
+		i -= len(m.SourceDomainId)
+		copy(dAtA[i:], m.SourceDomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.SourceDomainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.DomainId) > 0 {
@@ -4199,7 +5056,7 @@ func (m *UpdateTaskListPartitionConfigRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdateTaskListPartitionConfigResponse) Marshal() (dAtA []byte, err error) {
+func (m *ActivityTaskDispatchInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4209,12 +5066,12 @@ func (m *UpdateTaskListPartitionConfigResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *UpdateTaskListPartitionConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ActivityTaskDispatchInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateTaskListPartitionConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ActivityTaskDispatchInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4223,25 +5080,97 @@ func (m *UpdateTaskListPartitionConfigResponse) MarshalToSizedBuffer(dAtA []byte
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RefreshTaskListPartitionConfigRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.WorkflowDomain) > 0 {
+		i -= len(m.WorkflowDomain)
+		copy(dAtA[i:], m.WorkflowDomain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.WorkflowDomain)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.HeartbeatDetails != nil {
+		{
+			size, err := m.HeartbeatDetails.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ScheduledTimeOfThisAttempt != nil {
+		{
+			size, err := m.ScheduledTimeOfThisAttempt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartedTime != nil {
+		{
+			size, err := m.StartedTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ScheduledEvent != nil {
+		{
+			size, err := m.ScheduledEvent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddActivityTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *RefreshTaskListPartitionConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddActivityTaskResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RefreshTaskListPartitionConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddActivityTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4260,12 +5189,41 @@ func (m *RefreshTaskListPartitionConfigRequest) MarshalToSizedBuffer(dAtA []byte
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xa
 	}
-	if m.TaskListType != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.TaskListType))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ForwardedFrom) > 0 {
+		i -= len(m.ForwardedFrom)
+		copy(dAtA[i:], m.ForwardedFrom)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ForwardedFrom)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x22
 	}
 	if m.TaskList != nil {
 		{
@@ -4277,19 +5235,31 @@ func (m *RefreshTaskListPartitionConfigRequest) MarshalToSizedBuffer(dAtA []byte
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
 	if len(m.DomainId) > 0 {
 		i -= len(m.DomainId)
 		copy(dAtA[i:], m.DomainId)
 		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RefreshTaskListPartitionConfigResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryWorkflowResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4299,12 +5269,12 @@ func (m *RefreshTaskListPartitionConfigResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *RefreshTaskListPartitionConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryWorkflowResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RefreshTaskListPartitionConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4313,920 +5283,5555 @@ func (m *RefreshTaskListPartitionConfigResponse) MarshalToSizedBuffer(dAtA []byt
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintService(dAtA []byte, offset int, v uint64) int {
-	offset -= sovService(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *TaskListPartition) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.PartitionConfig != nil {
+		{
+			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if len(m.IsolationGroups) > 0 {
-		for _, s := range m.IsolationGroups {
-			l = len(s)
-			n += 1 + l + sovService(uint64(l))
+	if m.QueryRejected != nil {
+		{
+			size, err := m.QueryRejected.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.QueryResult != nil {
+		{
+			size, err := m.QueryResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TaskListPartitionConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RespondQueryTaskCompletedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondQueryTaskCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RespondQueryTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Version != 0 {
-		n += 1 + sovService(uint64(m.Version))
-	}
-	if m.NumReadPartitions != 0 {
-		n += 1 + sovService(uint64(m.NumReadPartitions))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.NumWritePartitions != 0 {
-		n += 1 + sovService(uint64(m.NumWritePartitions))
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.ReadPartitions) > 0 {
-		for k, v := range m.ReadPartitions {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovService(uint64(l))
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			mapEntrySize := 1 + sovService(uint64(k)) + l
-			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.WritePartitions) > 0 {
-		for k, v := range m.WritePartitions {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovService(uint64(l))
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			mapEntrySize := 1 + sovService(uint64(k)) + l
-			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LoadBalancerHints) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RespondQueryTaskCompletedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondQueryTaskCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RespondQueryTaskCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BacklogCount != 0 {
-		n += 1 + sovService(uint64(m.BacklogCount))
-	}
-	if m.RatePerSecond != 0 {
-		n += 9
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PollForDecisionTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CancelOutstandingPollRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelOutstandingPollRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelOutstandingPollRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.PollerId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ForwardedFrom)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.TaskListType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskListType))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.IsolationGroup)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if len(m.PollerId) > 0 {
+		i -= len(m.PollerId)
+		copy(dAtA[i:], m.PollerId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.PollerId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PollForDecisionTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CancelOutstandingPollResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelOutstandingPollResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelOutstandingPollResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TaskToken)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeTaskListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
-		n += 1 + l + sovService(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *DescribeTaskListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeTaskListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PreviousStartedEventId != nil {
-		l = m.PreviousStartedEventId.Size()
-		n += 1 + l + sovService(uint64(l))
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.StartedEventId != 0 {
-		n += 1 + sovService(uint64(m.StartedEventId))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Attempt != 0 {
-		n += 1 + sovService(uint64(m.Attempt))
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeTaskListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.NextEventId != 0 {
-		n += 1 + sovService(uint64(m.NextEventId))
+	return dAtA[:n], nil
+}
+
+func (m *DescribeTaskListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.BacklogCountHint != 0 {
-		n += 1 + sovService(uint64(m.BacklogCountHint))
+	if m.ScheduleToStartLatencyInMs != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ScheduleToStartLatencyInMs))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.StickyExecutionEnabled {
-		n += 2
+	if m.SyncMatchRatio != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SyncMatchRatio))))
+		i--
+		dAtA[i] = 0x41
 	}
-	if m.Query != nil {
-		l = m.Query.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.DispatchedTasksPerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DispatchedTasksPerSecond))))
+		i--
+		dAtA[i] = 0x39
 	}
-	if m.DecisionInfo != nil {
-		l = m.DecisionInfo.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.WorkflowExecutionTaskList != nil {
-		l = m.WorkflowExecutionTaskList.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.EventStoreVersion != 0 {
-		n += 1 + sovService(uint64(m.EventStoreVersion))
-	}
-	l = len(m.BranchToken)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.ScheduledTime != nil {
-		l = m.ScheduledTime.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.StartedTime != nil {
-		l = m.StartedTime.Size()
-		n += 2 + l + sovService(uint64(l))
-	}
-	if len(m.Queries) > 0 {
-		for k, v := range m.Queries {
-			_ = k
-			_ = v
-			l = 0
+	if len(m.BuildIdMetrics) > 0 {
+		for k := range m.BuildIdMetrics {
+			v := m.BuildIdMetrics[k]
+			baseI := i
 			if v != nil {
-				l = v.Size()
-				l += 1 + sovService(uint64(l))
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintService(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
 			}
-			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + l
-			n += mapEntrySize + 2 + sovService(uint64(mapEntrySize))
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	if m.TotalHistoryBytes != 0 {
-		n += 2 + sovService(uint64(m.TotalHistoryBytes))
+	if m.VersioningConfig != nil {
+		{
+			size, err := m.VersioningConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.PartitionConfig != nil {
-		l = m.PartitionConfig.Size()
-		n += 2 + l + sovService(uint64(l))
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.LoadBalancerHints != nil {
-		l = m.LoadBalancerHints.Size()
-		n += 2 + l + sovService(uint64(l))
+	if m.PartitionConfig != nil {
+		{
+			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.AutoConfigHint != nil {
-		l = m.AutoConfigHint.Size()
-		n += 2 + l + sovService(uint64(l))
+	if m.TaskListStatus != nil {
+		{
+			size, err := m.TaskListStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Pollers) > 0 {
+		for iNdEx := len(m.Pollers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pollers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PollForActivityTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListTaskListPartitionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTaskListPartitionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListTaskListPartitionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.PollerId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.ForwardedFrom)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.IsolationGroup)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PollForActivityTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListTaskListPartitionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTaskListPartitionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListTaskListPartitionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TaskToken)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.ActivityId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if len(m.DecisionTaskListPartitions) > 0 {
+		for iNdEx := len(m.DecisionTaskListPartitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DecisionTaskListPartitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.ActivityType != nil {
-		l = m.ActivityType.Size()
-		n += 1 + l + sovService(uint64(l))
+	if len(m.ActivityTaskListPartitions) > 0 {
+		for iNdEx := len(m.ActivityTaskListPartitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActivityTaskListPartitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 1 + l + sovService(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTaskListsByDomainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.ScheduledTime != nil {
-		l = m.ScheduledTime.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.StartedTime != nil {
-		l = m.StartedTime.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.ScheduleToCloseTimeout != nil {
-		l = m.ScheduleToCloseTimeout.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.StartToCloseTimeout != nil {
-		l = m.StartToCloseTimeout.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.HeartbeatTimeout != nil {
-		l = m.HeartbeatTimeout.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.Attempt != 0 {
-		n += 1 + sovService(uint64(m.Attempt))
-	}
-	if m.ScheduledTimeOfThisAttempt != nil {
-		l = m.ScheduledTimeOfThisAttempt.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.HeartbeatDetails != nil {
-		l = m.HeartbeatDetails.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.WorkflowDomain)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 2 + l + sovService(uint64(l))
-	}
-	if m.LoadBalancerHints != nil {
-		l = m.LoadBalancerHints.Size()
-		n += 2 + l + sovService(uint64(l))
-	}
-	if m.PartitionConfig != nil {
-		l = m.PartitionConfig.Size()
-		n += 2 + l + sovService(uint64(l))
-	}
-	if m.AutoConfigHint != nil {
-		l = m.AutoConfigHint.Size()
-		n += 2 + l + sovService(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AddDecisionTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetTaskListsByDomainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskListsByDomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.TaskList != nil {
-		l = m.TaskList.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.ScheduleId != 0 {
-		n += 1 + sovService(uint64(m.ScheduleId))
-	}
-	if m.ScheduleToStartTimeout != nil {
-		l = m.ScheduleToStartTimeout.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.Source != 0 {
-		n += 1 + sovService(uint64(m.Source))
-	}
-	l = len(m.ForwardedFrom)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if len(m.PartitionConfig) > 0 {
-		for k, v := range m.PartitionConfig {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + len(v) + sovService(uint64(len(v)))
-			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AddDecisionTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetTaskListsByDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskListsByDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskListsByDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PartitionConfig != nil {
-		l = m.PartitionConfig.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.ActivityTaskListMap) > 0 {
+		for k := range m.ActivityTaskListMap {
+			v := m.ActivityTaskListMap[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintService(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DecisionTaskListMap) > 0 {
+		for k := range m.DecisionTaskListMap {
+			v := m.DecisionTaskListMap[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintService(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AddActivityTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateTaskListPartitionConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTaskListPartitionConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTaskListPartitionConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.PartitionConfig != nil {
+		{
+			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.SourceDomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.TaskListType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskListType))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.TaskList != nil {
-		l = m.TaskList.Size()
-		n += 1 + l + sovService(uint64(l))
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ScheduleId != 0 {
-		n += 1 + sovService(uint64(m.ScheduleId))
-	}
-	if m.ScheduleToStartTimeout != nil {
-		l = m.ScheduleToStartTimeout.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.Source != 0 {
-		n += 1 + sovService(uint64(m.Source))
-	}
-	l = len(m.ForwardedFrom)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.ActivityTaskDispatchInfo != nil {
-		l = m.ActivityTaskDispatchInfo.Size()
-		n += 1 + l + sovService(uint64(l))
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.PartitionConfig) > 0 {
-		for k, v := range m.PartitionConfig {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + len(v) + sovService(uint64(len(v)))
-			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateTaskListPartitionConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTaskListPartitionConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTaskListPartitionConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ActivityTaskDispatchInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RefreshTaskListPartitionConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshTaskListPartitionConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshTaskListPartitionConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ScheduledEvent != nil {
-		l = m.ScheduledEvent.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.StartedTime != nil {
-		l = m.StartedTime.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.Attempt != 0 {
-		n += 1 + sovService(uint64(m.Attempt))
-	}
-	if m.ScheduledTimeOfThisAttempt != nil {
-		l = m.ScheduledTimeOfThisAttempt.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.HeartbeatDetails != nil {
-		l = m.HeartbeatDetails.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.PartitionConfig != nil {
+		{
+			size, err := m.PartitionConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.TaskListType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskListType))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.WorkflowDomain)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddActivityTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RefreshTaskListPartitionConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshTaskListPartitionConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshTaskListPartitionConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PartitionConfig != nil {
-		l = m.PartitionConfig.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryWorkflowRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateTaskListVersioningConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTaskListVersioningConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTaskListVersioningConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if len(m.PromoteBuildId) > 0 {
+		i -= len(m.PromoteBuildId)
+		copy(dAtA[i:], m.PromoteBuildId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.PromoteBuildId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AddCompatibleBuildId != nil {
+		{
+			size, err := m.AddCompatibleBuildId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AddNewDefaultBuildId) > 0 {
+		i -= len(m.AddNewDefaultBuildId)
+		copy(dAtA[i:], m.AddNewDefaultBuildId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.AddNewDefaultBuildId)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.TaskList != nil {
-		l = m.TaskList.Size()
-		n += 1 + l + sovService(uint64(l))
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ForwardedFrom)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryWorkflowResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AddCompatibleBuildId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AddCompatibleBuildId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddCompatibleBuildId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.QueryResult != nil {
-		l = m.QueryResult.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.QueryRejected != nil {
-		l = m.QueryRejected.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.MakeDefault {
+		i--
+		if m.MakeDefault {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.PartitionConfig != nil {
-		l = m.PartitionConfig.Size()
-		n += 1 + l + sovService(uint64(l))
+	if len(m.ExistingCompatibleBuildId) > 0 {
+		i -= len(m.ExistingCompatibleBuildId)
+		copy(dAtA[i:], m.ExistingCompatibleBuildId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ExistingCompatibleBuildId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RespondQueryTaskCompletedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.TaskList != nil {
-		l = m.TaskList.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *UpdateTaskListVersioningConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RespondQueryTaskCompletedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UpdateTaskListVersioningConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTaskListVersioningConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.VersioningConfig != nil {
+		{
+			size, err := m.VersioningConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CancelOutstandingPollRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetTaskListScalingRecommendationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskListScalingRecommendationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskListScalingRecommendationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.PollerId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.TaskListType != 0 {
-		n += 1 + sovService(uint64(m.TaskListType))
+		i = encodeVarintService(dAtA, i, uint64(m.TaskListType))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.TaskList != nil {
-		l = m.TaskList.Size()
-		n += 1 + l + sovService(uint64(l))
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CancelOutstandingPollResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetTaskListScalingRecommendationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DescribeTaskListRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetTaskListScalingRecommendationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskListScalingRecommendationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.Signals != nil {
+		{
+			size, err := m.Signals.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Confidence != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Confidence))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.RecommendedPollerCount != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.RecommendedPollerCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DescribeTaskListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TaskListScalingSignals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskListScalingSignals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskListScalingSignals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pollers) > 0 {
-		for _, e := range m.Pollers {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TaskListStatus != nil {
-		l = m.TaskListStatus.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.PollerCount != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.PollerCount))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.PartitionConfig != nil {
-		l = m.PartitionConfig.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.ScheduleToStartLatencyInMs != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ScheduleToStartLatencyInMs))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.TaskList != nil {
-		l = m.TaskList.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.SyncMatchRatio != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SyncMatchRatio))))
+		i--
+		dAtA[i] = 0x21
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.DispatchedTasksPerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DispatchedTasksPerSecond))))
+		i--
+		dAtA[i] = 0x19
 	}
-	return n
+	if m.AddTasksPerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AddTasksPerSecond))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.BacklogCountHint != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.BacklogCountHint))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ListTaskListPartitionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.TaskList != nil {
-		l = m.TaskList.Size()
-		n += 1 + l + sovService(uint64(l))
+func (m *ListWorkersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListWorkersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListWorkersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ListTaskListPartitionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListWorkersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListWorkersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListWorkersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ActivityTaskListPartitions) > 0 {
-		for _, e := range m.ActivityTaskListPartitions {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DecisionTaskListPartitions) > 0 {
-		for _, e := range m.DecisionTaskListPartitions {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
+	if len(m.Workers) > 0 {
+		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Workers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeWorkerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeWorkerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeWorkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetTaskListsByDomainRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DescribeWorkerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeWorkerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeWorkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Worker != nil {
+		{
+			size, err := m.Worker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkerInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.PollsPerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.PollsPerSecond))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.LastPollTime != nil {
+		{
+			size, err := m.LastPollTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TaskLists) > 0 {
+		for iNdEx := len(m.TaskLists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskLists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.VersionInfo != nil {
+		{
+			size, err := m.VersionInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetTaskListsByDomainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WorkerTaskListInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerTaskListInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerTaskListInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.DecisionTaskListMap) > 0 {
-		for k, v := range m.DecisionTaskListMap {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LastPollTime != nil {
+		{
+			size, err := m.LastPollTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TaskListType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskListType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MigrateTaskListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrateTaskListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrateTaskListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Stop {
+		i--
+		if m.Stop {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Alias {
+		i--
+		if m.Alias {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TargetTaskList != nil {
+		{
+			size, err := m.TargetTaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TaskListType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskListType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MigrateTaskListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrateTaskListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrateTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Partitions) > 0 {
+		for iNdEx := len(m.Partitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Partitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MigrationConfig != nil {
+		{
+			size, err := m.MigrationConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskListMigrationConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskListMigrationConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskListMigrationConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Alias {
+		i--
+		if m.Alias {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TargetTaskList) > 0 {
+		i -= len(m.TargetTaskList)
+		copy(dAtA[i:], m.TargetTaskList)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TargetTaskList)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskListMigrationPartitionStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskListMigrationPartitionStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskListMigrationPartitionStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BacklogCountHint != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.BacklogCountHint))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OwnerHostName) > 0 {
+		i -= len(m.OwnerHostName)
+		copy(dAtA[i:], m.OwnerHostName)
+		i = encodeVarintService(dAtA, i, uint64(len(m.OwnerHostName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Partition) > 0 {
+		i -= len(m.Partition)
+		copy(dAtA[i:], m.Partition)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Partition)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeDomainTaskQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeDomainTaskQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeDomainTaskQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeDomainTaskQuotaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeDomainTaskQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeDomainTaskQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TaskQuota != nil {
+		{
+			size, err := m.TaskQuota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DomainTaskQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainTaskQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainTaskQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DispatchRejectedRps != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DispatchRejectedRps))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.DispatchRps != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DispatchRps))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.DispatchRpsLimit != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DispatchRpsLimit))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.AddTaskRejectedRps != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AddTaskRejectedRps))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.AddTaskRps != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AddTaskRps))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.AddTaskRpsLimit != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AddTaskRpsLimit))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TaskListPartition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IsolationGroups) > 0 {
+		for _, s := range m.IsolationGroups {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskListPartitionConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovService(uint64(m.Version))
+	}
+	if m.NumReadPartitions != 0 {
+		n += 1 + sovService(uint64(m.NumReadPartitions))
+	}
+	if m.NumWritePartitions != 0 {
+		n += 1 + sovService(uint64(m.NumWritePartitions))
+	}
+	if len(m.ReadPartitions) > 0 {
+		for k, v := range m.ReadPartitions {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovService(uint64(l))
+			}
+			mapEntrySize := 1 + sovService(uint64(k)) + l
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if len(m.WritePartitions) > 0 {
+		for k, v := range m.WritePartitions {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovService(uint64(l))
+			}
+			mapEntrySize := 1 + sovService(uint64(k)) + l
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LoadBalancerHints) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BacklogCount != 0 {
+		n += 1 + sovService(uint64(m.BacklogCount))
+	}
+	if m.RatePerSecond != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskListVersioningConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovService(uint64(m.Version))
+	}
+	if len(m.CompatibleSets) > 0 {
+		for _, e := range m.CompatibleSets {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CompatibleBuildIdSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BuildIds) > 0 {
+		for _, s := range m.BuildIds {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BuildIdMetrics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BacklogCountHint != 0 {
+		n += 1 + sovService(uint64(m.BacklogCountHint))
+	}
+	if m.PollerCount != 0 {
+		n += 1 + sovService(uint64(m.PollerCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PollForDecisionTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.PollerId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.ForwardedFrom)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.IsolationGroup)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PollForDecisionTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.PreviousStartedEventId != nil {
+		l = m.PreviousStartedEventId.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.StartedEventId != 0 {
+		n += 1 + sovService(uint64(m.StartedEventId))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovService(uint64(m.Attempt))
+	}
+	if m.NextEventId != 0 {
+		n += 1 + sovService(uint64(m.NextEventId))
+	}
+	if m.BacklogCountHint != 0 {
+		n += 1 + sovService(uint64(m.BacklogCountHint))
+	}
+	if m.StickyExecutionEnabled {
+		n += 2
+	}
+	if m.Query != nil {
+		l = m.Query.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.DecisionInfo != nil {
+		l = m.DecisionInfo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecutionTaskList != nil {
+		l = m.WorkflowExecutionTaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.EventStoreVersion != 0 {
+		n += 1 + sovService(uint64(m.EventStoreVersion))
+	}
+	l = len(m.BranchToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ScheduledTime != nil {
+		l = m.ScheduledTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.StartedTime != nil {
+		l = m.StartedTime.Size()
+		n += 2 + l + sovService(uint64(l))
+	}
+	if len(m.Queries) > 0 {
+		for k, v := range m.Queries {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovService(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if m.TotalHistoryBytes != 0 {
+		n += 2 + sovService(uint64(m.TotalHistoryBytes))
+	}
+	if m.PartitionConfig != nil {
+		l = m.PartitionConfig.Size()
+		n += 2 + l + sovService(uint64(l))
+	}
+	if m.LoadBalancerHints != nil {
+		l = m.LoadBalancerHints.Size()
+		n += 2 + l + sovService(uint64(l))
+	}
+	if m.AutoConfigHint != nil {
+		l = m.AutoConfigHint.Size()
+		n += 2 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PollForActivityTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.PollerId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.ForwardedFrom)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.IsolationGroup)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PollForActivityTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.ActivityId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ActivityType != nil {
+		l = m.ActivityType.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ScheduledTime != nil {
+		l = m.ScheduledTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.StartedTime != nil {
+		l = m.StartedTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ScheduleToCloseTimeout != nil {
+		l = m.ScheduleToCloseTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.StartToCloseTimeout != nil {
+		l = m.StartToCloseTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.HeartbeatTimeout != nil {
+		l = m.HeartbeatTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovService(uint64(m.Attempt))
+	}
+	if m.ScheduledTimeOfThisAttempt != nil {
+		l = m.ScheduledTimeOfThisAttempt.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.HeartbeatDetails != nil {
+		l = m.HeartbeatDetails.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.WorkflowDomain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 2 + l + sovService(uint64(l))
+	}
+	if m.LoadBalancerHints != nil {
+		l = m.LoadBalancerHints.Size()
+		n += 2 + l + sovService(uint64(l))
+	}
+	if m.PartitionConfig != nil {
+		l = m.PartitionConfig.Size()
+		n += 2 + l + sovService(uint64(l))
+	}
+	if m.AutoConfigHint != nil {
+		l = m.AutoConfigHint.Size()
+		n += 2 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddDecisionTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ScheduleId != 0 {
+		n += 1 + sovService(uint64(m.ScheduleId))
+	}
+	if m.ScheduleToStartTimeout != nil {
+		l = m.ScheduleToStartTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Source != 0 {
+		n += 1 + sovService(uint64(m.Source))
+	}
+	l = len(m.ForwardedFrom)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.PartitionConfig) > 0 {
+		for k, v := range m.PartitionConfig {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + len(v) + sovService(uint64(len(v)))
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddDecisionTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartitionConfig != nil {
+		l = m.PartitionConfig.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddActivityTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.SourceDomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ScheduleId != 0 {
+		n += 1 + sovService(uint64(m.ScheduleId))
+	}
+	if m.ScheduleToStartTimeout != nil {
+		l = m.ScheduleToStartTimeout.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Source != 0 {
+		n += 1 + sovService(uint64(m.Source))
+	}
+	l = len(m.ForwardedFrom)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ActivityTaskDispatchInfo != nil {
+		l = m.ActivityTaskDispatchInfo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.PartitionConfig) > 0 {
+		for k, v := range m.PartitionConfig {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + len(v) + sovService(uint64(len(v)))
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivityTaskDispatchInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduledEvent != nil {
+		l = m.ScheduledEvent.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.StartedTime != nil {
+		l = m.StartedTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovService(uint64(m.Attempt))
+	}
+	if m.ScheduledTimeOfThisAttempt != nil {
+		l = m.ScheduledTimeOfThisAttempt.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.HeartbeatDetails != nil {
+		l = m.HeartbeatDetails.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.WorkflowDomain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddActivityTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartitionConfig != nil {
+		l = m.PartitionConfig.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *QueryWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.ForwardedFrom)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *QueryWorkflowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryResult != nil {
+		l = m.QueryResult.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.QueryRejected != nil {
+		l = m.QueryRejected.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.PartitionConfig != nil {
+		l = m.PartitionConfig.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RespondQueryTaskCompletedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RespondQueryTaskCompletedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CancelOutstandingPollRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.PollerId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskListType != 0 {
+		n += 1 + sovService(uint64(m.TaskListType))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CancelOutstandingPollResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeTaskListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeTaskListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pollers) > 0 {
+		for _, e := range m.Pollers {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.TaskListStatus != nil {
+		l = m.TaskListStatus.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.PartitionConfig != nil {
+		l = m.PartitionConfig.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.VersioningConfig != nil {
+		l = m.VersioningConfig.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.BuildIdMetrics) > 0 {
+		for k, v := range m.BuildIdMetrics {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovService(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if m.DispatchedTasksPerSecond != 0 {
+		n += 9
+	}
+	if m.SyncMatchRatio != 0 {
+		n += 9
+	}
+	if m.ScheduleToStartLatencyInMs != 0 {
+		n += 1 + sovService(uint64(m.ScheduleToStartLatencyInMs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListTaskListPartitionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListTaskListPartitionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActivityTaskListPartitions) > 0 {
+		for _, e := range m.ActivityTaskListPartitions {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.DecisionTaskListPartitions) > 0 {
+		for _, e := range m.DecisionTaskListPartitions {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTaskListsByDomainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTaskListsByDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DecisionTaskListMap) > 0 {
+		for k, v := range m.DecisionTaskListMap {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovService(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ActivityTaskListMap) > 0 {
+		for k, v := range m.ActivityTaskListMap {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovService(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateTaskListPartitionConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskListType != 0 {
+		n += 1 + sovService(uint64(m.TaskListType))
+	}
+	if m.PartitionConfig != nil {
+		l = m.PartitionConfig.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateTaskListPartitionConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RefreshTaskListPartitionConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskListType != 0 {
+		n += 1 + sovService(uint64(m.TaskListType))
+	}
+	if m.PartitionConfig != nil {
+		l = m.PartitionConfig.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RefreshTaskListPartitionConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateTaskListVersioningConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.AddNewDefaultBuildId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.AddCompatibleBuildId != nil {
+		l = m.AddCompatibleBuildId.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.PromoteBuildId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddCompatibleBuildId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.ExistingCompatibleBuildId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.MakeDefault {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateTaskListVersioningConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VersioningConfig != nil {
+		l = m.VersioningConfig.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTaskListScalingRecommendationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskListType != 0 {
+		n += 1 + sovService(uint64(m.TaskListType))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTaskListScalingRecommendationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecommendedPollerCount != 0 {
+		n += 1 + sovService(uint64(m.RecommendedPollerCount))
+	}
+	if m.Confidence != 0 {
+		n += 1 + sovService(uint64(m.Confidence))
+	}
+	if m.Signals != nil {
+		l = m.Signals.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskListScalingSignals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BacklogCountHint != 0 {
+		n += 1 + sovService(uint64(m.BacklogCountHint))
+	}
+	if m.AddTasksPerSecond != 0 {
+		n += 9
+	}
+	if m.DispatchedTasksPerSecond != 0 {
+		n += 9
+	}
+	if m.SyncMatchRatio != 0 {
+		n += 9
+	}
+	if m.ScheduleToStartLatencyInMs != 0 {
+		n += 1 + sovService(uint64(m.ScheduleToStartLatencyInMs))
+	}
+	if m.PollerCount != 0 {
+		n += 1 + sovService(uint64(m.PollerCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListWorkersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListWorkersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Workers) > 0 {
+		for _, e := range m.Workers {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeWorkerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeWorkerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Worker != nil {
+		l = m.Worker.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.VersionInfo != nil {
+		l = m.VersionInfo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.TaskLists) > 0 {
+		for _, e := range m.TaskLists {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.LastPollTime != nil {
+		l = m.LastPollTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.PollsPerSecond != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkerTaskListInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskListType != 0 {
+		n += 1 + sovService(uint64(m.TaskListType))
+	}
+	if m.LastPollTime != nil {
+		l = m.LastPollTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MigrateTaskListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskListType != 0 {
+		n += 1 + sovService(uint64(m.TaskListType))
+	}
+	if m.TargetTaskList != nil {
+		l = m.TargetTaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Alias {
+		n += 2
+	}
+	if m.Stop {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MigrateTaskListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MigrationConfig != nil {
+		l = m.MigrationConfig.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.Partitions) > 0 {
+		for _, e := range m.Partitions {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskListMigrationConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TargetTaskList)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Alias {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskListMigrationPartitionStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Partition)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.OwnerHostName)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.BacklogCountHint != 0 {
+		n += 1 + sovService(uint64(m.BacklogCountHint))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeDomainTaskQuotaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeDomainTaskQuotaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskQuota != nil {
+		l = m.TaskQuota.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DomainTaskQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AddTaskRpsLimit != 0 {
+		n += 9
+	}
+	if m.AddTaskRps != 0 {
+		n += 9
+	}
+	if m.AddTaskRejectedRps != 0 {
+		n += 9
+	}
+	if m.DispatchRpsLimit != 0 {
+		n += 9
+	}
+	if m.DispatchRps != 0 {
+		n += 9
+	}
+	if m.DispatchRejectedRps != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovService(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozService(x uint64) (n int) {
+	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TaskListPartition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskListPartition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskListPartition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolationGroups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsolationGroups = append(m.IsolationGroups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskListPartitionConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskListPartitionConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskListPartitionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumReadPartitions", wireType)
+			}
+			m.NumReadPartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumReadPartitions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumWritePartitions", wireType)
+			}
+			m.NumWritePartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumWritePartitions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadPartitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReadPartitions == nil {
+				m.ReadPartitions = make(map[int32]*TaskListPartition)
+			}
+			var mapkey int32
+			var mapvalue *TaskListPartition
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthService
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthService
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &TaskListPartition{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ReadPartitions[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WritePartitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WritePartitions == nil {
+				m.WritePartitions = make(map[int32]*TaskListPartition)
+			}
+			var mapkey int32
+			var mapvalue *TaskListPartition
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthService
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthService
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &TaskListPartition{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.WritePartitions[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LoadBalancerHints) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoadBalancerHints: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoadBalancerHints: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogCount", wireType)
+			}
+			m.BacklogCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BacklogCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatePerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RatePerSecond = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskListVersioningConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskListVersioningConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskListVersioningConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompatibleSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompatibleSets = append(m.CompatibleSets, &CompatibleBuildIdSet{})
+			if err := m.CompatibleSets[len(m.CompatibleSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompatibleBuildIdSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompatibleBuildIdSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompatibleBuildIdSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildIds = append(m.BuildIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuildIdMetrics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuildIdMetrics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuildIdMetrics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogCountHint", wireType)
+			}
+			m.BacklogCountHint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BacklogCountHint |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollerCount", wireType)
+			}
+			m.PollerCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PollerCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PollForDecisionTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollForDecisionTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollForDecisionTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v1.PollForDecisionTaskRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PollerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolationGroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsolationGroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollForDecisionTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollForDecisionTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskToken = append(m.TaskToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskToken == nil {
+				m.TaskToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v1.WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousStartedEventId == nil {
+				m.PreviousStartedEventId = &types.Int64Value{}
+			}
+			if err := m.PreviousStartedEventId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			}
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			}
+			m.NextEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogCountHint", wireType)
+			}
+			m.BacklogCountHint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BacklogCountHint |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyExecutionEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StickyExecutionEnabled = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Query == nil {
+				m.Query = &v1.WorkflowQuery{}
+			}
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionInfo == nil {
+				m.DecisionInfo = &v11.TransientDecisionInfo{}
+			}
+			if err := m.DecisionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecutionTaskList == nil {
+				m.WorkflowExecutionTaskList = &v1.TaskList{}
+			}
+			if err := m.WorkflowExecutionTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
+			}
+			m.EventStoreVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventStoreVersion |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.BranchToken == nil {
+				m.BranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = &types.Timestamp{}
+			}
+			if err := m.ScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedTime == nil {
+				m.StartedTime = &types.Timestamp{}
+			}
+			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Queries == nil {
+				m.Queries = make(map[string]*v1.WorkflowQuery)
+			}
+			var mapkey string
+			var mapvalue *v1.WorkflowQuery
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthService
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthService
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v1.WorkflowQuery{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Queries[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalHistoryBytes", wireType)
+			}
+			m.TotalHistoryBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalHistoryBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = &TaskListPartitionConfig{}
+			}
+			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoadBalancerHints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LoadBalancerHints == nil {
+				m.LoadBalancerHints = &LoadBalancerHints{}
+			}
+			if err := m.LoadBalancerHints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoConfigHint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoConfigHint == nil {
+				m.AutoConfigHint = &v1.AutoConfigHint{}
+			}
+			if err := m.AutoConfigHint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PollForActivityTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollForActivityTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollForActivityTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v1.PollForActivityTaskRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PollerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolationGroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsolationGroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollForActivityTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollForActivityTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskToken = append(m.TaskToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskToken == nil {
+				m.TaskToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivityType == nil {
+				m.ActivityType = &v1.ActivityType{}
+			}
+			if err := m.ActivityType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &v1.Payload{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = &types.Timestamp{}
+			}
+			if err := m.ScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedTime == nil {
+				m.StartedTime = &types.Timestamp{}
+			}
+			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleToCloseTimeout == nil {
+				m.ScheduleToCloseTimeout = &types.Duration{}
+			}
+			if err := m.ScheduleToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartToCloseTimeout", wireType)
 			}
-			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
-		}
-	}
-	if len(m.ActivityTaskListMap) > 0 {
-		for k, v := range m.ActivityTaskListMap {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovService(uint64(l))
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *UpdateTaskListPartitionConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.TaskList != nil {
-		l = m.TaskList.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.TaskListType != 0 {
-		n += 1 + sovService(uint64(m.TaskListType))
-	}
-	if m.PartitionConfig != nil {
-		l = m.PartitionConfig.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *UpdateTaskListPartitionConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *RefreshTaskListPartitionConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.TaskList != nil {
-		l = m.TaskList.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.TaskListType != 0 {
-		n += 1 + sovService(uint64(m.TaskListType))
-	}
-	if m.PartitionConfig != nil {
-		l = m.PartitionConfig.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *RefreshTaskListPartitionConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func sovService(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozService(x uint64) (n int) {
-	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *TaskListPartition) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
+			if msglen < 0 {
+				return ErrInvalidLengthService
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.StartToCloseTimeout == nil {
+				m.StartToCloseTimeout = &types.Duration{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TaskListPartition: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskListPartition: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.StartToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsolationGroups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTimeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -5236,80 +10841,124 @@ func (m *TaskListPartition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IsolationGroups = append(m.IsolationGroups, string(dAtA[iNdEx:postIndex]))
+			if m.HeartbeatTimeout == nil {
+				m.HeartbeatTimeout = &types.Duration{}
+			}
+			if err := m.HeartbeatTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTimeOfThisAttempt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTimeOfThisAttempt == nil {
+				m.ScheduledTimeOfThisAttempt = &types.Timestamp{}
+			}
+			if err := m.ScheduledTimeOfThisAttempt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TaskListPartitionConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.HeartbeatDetails == nil {
+				m.HeartbeatDetails = &v1.Payload{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TaskListPartitionConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskListPartitionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
 			}
-			m.Version = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -5319,16 +10968,33 @@ func (m *TaskListPartitionConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumReadPartitions", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthService
 			}
-			m.NumReadPartitions = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v1.WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowDomain", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -5338,16 +11004,29 @@ func (m *TaskListPartitionConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumReadPartitions |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumWritePartitions", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
 			}
-			m.NumWritePartitions = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowDomain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -5357,14 +11036,31 @@ func (m *TaskListPartitionConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumWritePartitions |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &v1.Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadPartitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LoadBalancerHints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5391,95 +11087,16 @@ func (m *TaskListPartitionConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReadPartitions == nil {
-				m.ReadPartitions = make(map[int32]*TaskListPartition)
+			if m.LoadBalancerHints == nil {
+				m.LoadBalancerHints = &LoadBalancerHints{}
 			}
-			var mapkey int32
-			var mapvalue *TaskListPartition
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthService
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthService
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &TaskListPartition{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.LoadBalancerHints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ReadPartitions[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WritePartitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5506,91 +11123,48 @@ func (m *TaskListPartitionConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WritePartitions == nil {
-				m.WritePartitions = make(map[int32]*TaskListPartition)
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = &TaskListPartitionConfig{}
 			}
-			var mapkey int32
-			var mapvalue *TaskListPartition
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoConfigHint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthService
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthService
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &TaskListPartition{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.WritePartitions[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoConfigHint == nil {
+				m.AutoConfigHint = &v1.AutoConfigHint{}
+			}
+			if err := m.AutoConfigHint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5614,7 +11188,7 @@ func (m *TaskListPartitionConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LoadBalancerHints) Unmarshal(dAtA []byte) error {
+func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5637,17 +11211,17 @@ func (m *LoadBalancerHints) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LoadBalancerHints: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddDecisionTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LoadBalancerHints: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddDecisionTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BacklogCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			m.BacklogCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -5657,76 +11231,118 @@ func (m *LoadBalancerHints) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BacklogCount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RatePerSecond", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.RatePerSecond = float64(math.Float64frombits(v))
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PollForDecisionTaskRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PollForDecisionTaskRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollForDecisionTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5753,18 +11369,18 @@ func (m *PollForDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.PollForDecisionTaskRequest{}
+			if m.ScheduleToStartTimeout == nil {
+				m.ScheduleToStartTimeout = &types.Duration{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			m.Source = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -5774,27 +11390,14 @@ func (m *PollForDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Source |= v11.TaskSource(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedFrom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5822,13 +11425,13 @@ func (m *PollForDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PollerId = string(dAtA[iNdEx:postIndex])
+			m.ForwardedFrom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -5838,27 +11441,122 @@ func (m *PollForDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForwardedFrom = string(dAtA[iNdEx:postIndex])
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PartitionConfig[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsolationGroup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5886,7 +11584,7 @@ func (m *PollForDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IsolationGroup = string(dAtA[iNdEx:postIndex])
+			m.BuildId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5910,7 +11608,7 @@ func (m *PollForDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *AddDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5925,129 +11623,23 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PollForDecisionTaskResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollForDecisionTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskToken", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TaskToken = append(m.TaskToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.TaskToken == nil {
-				m.TaskToken = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v1.WorkflowType{}
-			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddDecisionTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddDecisionTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6074,75 +11666,69 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PreviousStartedEventId == nil {
-				m.PreviousStartedEventId = &types.Int64Value{}
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = &TaskListPartitionConfig{}
 			}
-			if err := m.PreviousStartedEventId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.StartedEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartedEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Attempt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.NextEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BacklogCountHint", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddActivityTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddActivityTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			m.BacklogCountHint = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -6152,34 +11738,27 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BacklogCountHint |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyExecutionEnabled", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
 			}
-			m.StickyExecutionEnabled = bool(v != 0)
-		case 10:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6206,18 +11785,18 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Query == nil {
-				m.Query = &v1.WorkflowQuery{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
 			}
-			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecisionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -6227,31 +11806,27 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DecisionInfo == nil {
-				m.DecisionInfo = &v11.TransientDecisionInfo{}
-			}
-			if err := m.DecisionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceDomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6278,18 +11853,18 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecutionTaskList == nil {
-				m.WorkflowExecutionTaskList = &v1.TaskList{}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
 			}
-			if err := m.WorkflowExecutionTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
 			}
-			m.EventStoreVersion = 0
+			m.ScheduleId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -6299,16 +11874,16 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventStoreVersion |= int32(b&0x7F) << shift
+				m.ScheduleId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -6318,31 +11893,52 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.BranchToken == nil {
-				m.BranchToken = []byte{}
+			if m.ScheduleToStartTimeout == nil {
+				m.ScheduleToStartTimeout = &types.Duration{}
+			}
+			if err := m.ScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= v11.TaskSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedFrom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -6352,31 +11948,27 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTime == nil {
-				m.ScheduledTime = &types.Timestamp{}
-			}
-			if err := m.ScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ForwardedFrom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskDispatchInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6403,16 +11995,16 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = &types.Timestamp{}
+			if m.ActivityTaskDispatchInfo == nil {
+				m.ActivityTaskDispatchInfo = &ActivityTaskDispatchInfo{}
 			}
-			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ActivityTaskDispatchInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6439,11 +12031,11 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Queries == nil {
-				m.Queries = make(map[string]*v1.WorkflowQuery)
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = make(map[string]string)
 			}
 			var mapkey string
-			var mapvalue *v1.WorkflowQuery
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -6492,7 +12084,7 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowService
@@ -6502,26 +12094,24 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
 						return ErrInvalidLengthService
 					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
 						return ErrInvalidLengthService
 					}
-					if postmsgIndex > l {
+					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &v1.WorkflowQuery{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipService(dAtA[iNdEx:])
@@ -6537,13 +12127,136 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Queries[mapkey] = mapvalue
+			m.PartitionConfig[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 18:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivityTaskDispatchInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivityTaskDispatchInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivityTaskDispatchInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEvent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledEvent == nil {
+				m.ScheduledEvent = &v1.HistoryEvent{}
+			}
+			if err := m.ScheduledEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedTime == nil {
+				m.StartedTime = &types.Timestamp{}
+			}
+			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalHistoryBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
 			}
-			m.TotalHistoryBytes = 0
+			m.Attempt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -6553,14 +12266,86 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalHistoryBytes |= int64(b&0x7F) << shift
+				m.Attempt |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 19:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTimeOfThisAttempt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTimeOfThisAttempt == nil {
+				m.ScheduledTimeOfThisAttempt = &types.Timestamp{}
+			}
+			if err := m.ScheduledTimeOfThisAttempt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeartbeatDetails == nil {
+				m.HeartbeatDetails = &v1.Payload{}
+			}
+			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6587,18 +12372,18 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PartitionConfig == nil {
-				m.PartitionConfig = &TaskListPartitionConfig{}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v1.WorkflowType{}
 			}
-			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoadBalancerHints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowDomain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -6608,31 +12393,78 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LoadBalancerHints == nil {
-				m.LoadBalancerHints = &LoadBalancerHints{}
-			}
-			if err := m.LoadBalancerHints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WorkflowDomain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 21:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddActivityTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddActivityTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoConfigHint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6659,10 +12491,10 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AutoConfigHint == nil {
-				m.AutoConfigHint = &v1.AutoConfigHint{}
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = &TaskListPartitionConfig{}
 			}
-			if err := m.AutoConfigHint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6688,7 +12520,7 @@ func (m *PollForDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollForActivityTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6711,10 +12543,10 @@ func (m *PollForActivityTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollForActivityTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollForActivityTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6747,7 +12579,7 @@ func (m *PollForActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.PollForActivityTaskRequest{}
+				m.Request = &v1.QueryWorkflowRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -6787,9 +12619,9 @@ func (m *PollForActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -6799,23 +12631,27 @@ func (m *PollForActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PollerId = string(dAtA[iNdEx:postIndex])
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -6849,11 +12685,62 @@ func (m *PollForActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ForwardedFrom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsolationGroup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryResult", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -6863,23 +12750,99 @@ func (m *PollForActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QueryResult == nil {
+				m.QueryResult = &v1.Payload{}
+			}
+			if err := m.QueryResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryRejected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QueryRejected == nil {
+				m.QueryRejected = &v1.QueryRejected{}
+			}
+			if err := m.QueryRejected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = &v1.TaskListPartitionConfig{}
+			}
+			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.IsolationGroup = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6903,7 +12866,7 @@ func (m *PollForActivityTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6926,49 +12889,15 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollForActivityTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondQueryTaskCompletedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollForActivityTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondQueryTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskToken", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TaskToken = append(m.TaskToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.TaskToken == nil {
-				m.TaskToken = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6995,16 +12924,16 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			if m.Request == nil {
+				m.Request = &v1.RespondQueryTaskCompletedRequest{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7032,11 +12961,11 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActivityId = string(dAtA[iNdEx:postIndex])
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivityType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7063,18 +12992,18 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ActivityType == nil {
-				m.ActivityType = &v1.ActivityType{}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
 			}
-			if err := m.ActivityType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -7084,33 +13013,131 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &v1.Payload{}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondQueryTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondQueryTaskCompletedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondQueryTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelOutstandingPollRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelOutstandingPollRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -7120,33 +13147,29 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTime == nil {
-				m.ScheduledTime = &types.Timestamp{}
-			}
-			if err := m.ScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -7156,33 +13179,29 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = &types.Timestamp{}
-			}
-			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PollerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToCloseTimeout", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
 			}
-			var msglen int
+			m.TaskListType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -7192,31 +13211,14 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ScheduleToCloseTimeout == nil {
-				m.ScheduleToCloseTimeout = &types.Duration{}
-			}
-			if err := m.ScheduleToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartToCloseTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7243,71 +13245,118 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartToCloseTimeout == nil {
-				m.StartToCloseTimeout = &types.Duration{}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
 			}
-			if err := m.StartToCloseTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTimeout", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelOutstandingPollResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HeartbeatTimeout == nil {
-				m.HeartbeatTimeout = &types.Duration{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if err := m.HeartbeatTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelOutstandingPollResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelOutstandingPollResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
 			}
-			m.Attempt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 12:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeTaskListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeTaskListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTimeOfThisAttempt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7334,18 +13383,18 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTimeOfThisAttempt == nil {
-				m.ScheduledTimeOfThisAttempt = &types.Timestamp{}
+			if m.Request == nil {
+				m.Request = &v1.DescribeTaskListRequest{}
 			}
-			if err := m.ScheduledTimeOfThisAttempt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -7355,31 +13404,78 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HeartbeatDetails == nil {
-				m.HeartbeatDetails = &v1.Payload{}
-			}
-			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 14:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeTaskListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeTaskListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pollers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7406,18 +13502,16 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v1.WorkflowType{}
-			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pollers = append(m.Pollers, &v1.PollerInfo{})
+			if err := m.Pollers[len(m.Pollers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowDomain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListStatus", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -7427,27 +13521,31 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowDomain = string(dAtA[iNdEx:postIndex])
+			if m.TaskListStatus == nil {
+				m.TaskListStatus = &v1.TaskListStatus{}
+			}
+			if err := m.TaskListStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 16:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7474,16 +13572,16 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &v1.Header{}
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = &v1.TaskListPartitionConfig{}
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoadBalancerHints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7510,16 +13608,16 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LoadBalancerHints == nil {
-				m.LoadBalancerHints = &LoadBalancerHints{}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
 			}
-			if err := m.LoadBalancerHints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersioningConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7546,16 +13644,16 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PartitionConfig == nil {
-				m.PartitionConfig = &TaskListPartitionConfig{}
+			if m.VersioningConfig == nil {
+				m.VersioningConfig = &TaskListVersioningConfig{}
 			}
-			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VersioningConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoConfigHint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildIdMetrics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7582,13 +13680,147 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AutoConfigHint == nil {
-				m.AutoConfigHint = &v1.AutoConfigHint{}
+			if m.BuildIdMetrics == nil {
+				m.BuildIdMetrics = make(map[string]*BuildIdMetrics)
 			}
-			if err := m.AutoConfigHint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue *BuildIdMetrics
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthService
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthService
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &BuildIdMetrics{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.BuildIdMetrics[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DispatchedTasksPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DispatchedTasksPerSecond = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncMatchRatio", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.SyncMatchRatio = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartLatencyInMs", wireType)
+			}
+			m.ScheduleToStartLatencyInMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleToStartLatencyInMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -7611,7 +13843,7 @@ func (m *PollForActivityTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *ListTaskListPartitionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7634,15 +13866,15 @@ func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddDecisionTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListTaskListPartitionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddDecisionTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListTaskListPartitionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7670,11 +13902,11 @@ func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7701,54 +13933,69 @@ func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthService
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskList == nil {
-				m.TaskList = &v1.TaskList{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListTaskListPartitionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
 			}
-			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.ScheduleId = 0
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTaskListPartitionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTaskListPartitionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskListPartitions", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -7758,14 +14005,29 @@ func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScheduleId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivityTaskListPartitions = append(m.ActivityTaskListPartitions, &v1.TaskListPartitionMetadata{})
+			if err := m.ActivityTaskListPartitions[len(m.ActivityTaskListPartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskListPartitions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7792,18 +14054,67 @@ func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduleToStartTimeout == nil {
-				m.ScheduleToStartTimeout = &types.Duration{}
-			}
-			if err := m.ScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DecisionTaskListPartitions = append(m.DecisionTaskListPartitions, &v1.TaskListPartitionMetadata{})
+			if err := m.DecisionTaskListPartitions[len(m.DecisionTaskListPartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Source = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTaskListsByDomainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTaskListsByDomainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTaskListsByDomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -7813,16 +14124,80 @@ func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Source |= v11.TaskSource(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTaskListsByDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTaskListsByDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTaskListsByDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskListMap", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -7832,27 +14207,124 @@ func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForwardedFrom = string(dAtA[iNdEx:postIndex])
+			if m.DecisionTaskListMap == nil {
+				m.DecisionTaskListMap = make(map[string]*DescribeTaskListResponse)
+			}
+			var mapkey string
+			var mapvalue *DescribeTaskListResponse
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthService
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthService
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &DescribeTaskListResponse{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.DecisionTaskListMap[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskListMap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7879,11 +14351,11 @@ func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PartitionConfig == nil {
-				m.PartitionConfig = make(map[string]string)
+			if m.ActivityTaskListMap == nil {
+				m.ActivityTaskListMap = make(map[string]*DescribeTaskListResponse)
 			}
 			var mapkey string
-			var mapvalue string
+			var mapvalue *DescribeTaskListResponse
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -7932,7 +14404,7 @@ func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowService
@@ -7942,24 +14414,26 @@ func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthService
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthService
 					}
-					if postStringIndexmapvalue > l {
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = &DescribeTaskListResponse{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipService(dAtA[iNdEx:])
@@ -7975,94 +14449,7 @@ func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.PartitionConfig[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AddDecisionTaskResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AddDecisionTaskResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddDecisionTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PartitionConfig == nil {
-				m.PartitionConfig = &TaskListPartitionConfig{}
-			}
-			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ActivityTaskListMap[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8086,7 +14473,7 @@ func (m *AddDecisionTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateTaskListPartitionConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8109,10 +14496,10 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddActivityTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateTaskListPartitionConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddActivityTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateTaskListPartitionConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8149,130 +14536,7 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SourceDomainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TaskList == nil {
-				m.TaskList = &v1.TaskList{}
-			}
-			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
-			}
-			m.ScheduleId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduleId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8299,18 +14563,18 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduleToStartTimeout == nil {
-				m.ScheduleToStartTimeout = &types.Duration{}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
 			}
-			if err := m.ScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
 			}
-			m.Source = 0
+			m.TaskListType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -8320,16 +14584,16 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Source |= v11.TaskSource(b&0x7F) << shift
+				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -8339,29 +14603,135 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForwardedFrom = string(dAtA[iNdEx:postIndex])
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = &v1.TaskListPartitionConfig{}
+			}
+			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateTaskListPartitionConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateTaskListPartitionConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateTaskListPartitionConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshTaskListPartitionConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshTaskListPartitionConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshTaskListPartitionConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskDispatchInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -8371,31 +14741,27 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ActivityTaskDispatchInfo == nil {
-				m.ActivityTaskDispatchInfo = &ActivityTaskDispatchInfo{}
-			}
-			if err := m.ActivityTaskDispatchInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8422,103 +14788,67 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PartitionConfig == nil {
-				m.PartitionConfig = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthService
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthService
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
+			}
+			m.TaskListType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.PartitionConfig[mapkey] = mapvalue
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionConfig == nil {
+				m.PartitionConfig = &v1.TaskListPartitionConfig{}
+			}
+			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8542,7 +14872,7 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActivityTaskDispatchInfo) Unmarshal(dAtA []byte) error {
+func (m *RefreshTaskListPartitionConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8565,17 +14895,68 @@ func (m *ActivityTaskDispatchInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActivityTaskDispatchInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshTaskListPartitionConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActivityTaskDispatchInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshTaskListPartitionConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateTaskListVersioningConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateTaskListVersioningConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateTaskListVersioningConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEvent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -8585,31 +14966,59 @@ func (m *ActivityTaskDispatchInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledEvent == nil {
-				m.ScheduledEvent = &v1.HistoryEvent{}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
-			if err := m.ScheduledEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8636,18 +15045,18 @@ func (m *ActivityTaskDispatchInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = &types.Timestamp{}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
 			}
-			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddNewDefaultBuildId", wireType)
 			}
-			m.Attempt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -8657,14 +15066,27 @@ func (m *ActivityTaskDispatchInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddNewDefaultBuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTimeOfThisAttempt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddCompatibleBuildId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8691,18 +15113,18 @@ func (m *ActivityTaskDispatchInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTimeOfThisAttempt == nil {
-				m.ScheduledTimeOfThisAttempt = &types.Timestamp{}
+			if m.AddCompatibleBuildId == nil {
+				m.AddCompatibleBuildId = &AddCompatibleBuildId{}
 			}
-			if err := m.ScheduledTimeOfThisAttempt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AddCompatibleBuildId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PromoteBuildId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -8712,33 +15134,80 @@ func (m *ActivityTaskDispatchInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HeartbeatDetails == nil {
-				m.HeartbeatDetails = &v1.Payload{}
-			}
-			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PromoteBuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddCompatibleBuildId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddCompatibleBuildId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddCompatibleBuildId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -8748,31 +15217,27 @@ func (m *ActivityTaskDispatchInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v1.WorkflowType{}
-			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BuildId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowDomain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExistingCompatibleBuildId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8800,8 +15265,28 @@ func (m *ActivityTaskDispatchInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowDomain = string(dAtA[iNdEx:postIndex])
+			m.ExistingCompatibleBuildId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakeDefault", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MakeDefault = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -8824,7 +15309,7 @@ func (m *ActivityTaskDispatchInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateTaskListVersioningConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8847,15 +15332,15 @@ func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddActivityTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateTaskListVersioningConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddActivityTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateTaskListVersioningConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersioningConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8882,10 +15367,10 @@ func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PartitionConfig == nil {
-				m.PartitionConfig = &TaskListPartitionConfig{}
+			if m.VersioningConfig == nil {
+				m.VersioningConfig = &TaskListVersioningConfig{}
 			}
-			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VersioningConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8911,7 +15396,7 @@ func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
+func (m *GetTaskListScalingRecommendationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8934,17 +15419,17 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTaskListScalingRecommendationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTaskListScalingRecommendationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -8954,31 +15439,27 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.QueryWorkflowRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9006,7 +15487,7 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -9045,10 +15526,10 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedFrom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
 			}
-			var stringLen uint64
+			m.TaskListType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -9058,24 +15539,11 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ForwardedFrom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -9098,7 +15566,7 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
+func (m *GetTaskListScalingRecommendationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9121,17 +15589,17 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTaskListScalingRecommendationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTaskListScalingRecommendationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryResult", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommendedPollerCount", wireType)
 			}
-			var msglen int
+			m.RecommendedPollerCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -9141,31 +15609,33 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RecommendedPollerCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.QueryResult == nil {
-				m.QueryResult = &v1.Payload{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confidence", wireType)
 			}
-			if err := m.QueryResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Confidence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Confidence |= TaskListScalingConfidence(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryRejected", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9192,18 +15662,69 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QueryRejected == nil {
-				m.QueryRejected = &v1.QueryRejected{}
+			if m.Signals == nil {
+				m.Signals = &TaskListScalingSignals{}
 			}
-			if err := m.QueryRejected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Signals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskListScalingSignals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskListScalingSignals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskListScalingSignals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogCountHint", wireType)
+			}
+			m.BacklogCountHint = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -9213,28 +15734,82 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BacklogCountHint |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddTasksPerSecond", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.AddTasksPerSecond = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DispatchedTasksPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PartitionConfig == nil {
-				m.PartitionConfig = &v1.TaskListPartitionConfig{}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DispatchedTasksPerSecond = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncMatchRatio", wireType)
 			}
-			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.SyncMatchRatio = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartLatencyInMs", wireType)
+			}
+			m.ScheduleToStartLatencyInMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleToStartLatencyInMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollerCount", wireType)
+			}
+			m.PollerCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PollerCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -9257,7 +15832,7 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+func (m *ListWorkersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9280,49 +15855,13 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondQueryTaskCompletedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListWorkersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondQueryTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListWorkersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Request == nil {
-				m.Request = &v1.RespondQueryTaskCompletedRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
@@ -9354,45 +15893,9 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TaskList == nil {
-				m.TaskList = &v1.TaskList{}
-			}
-			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9420,7 +15923,7 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskId = string(dAtA[iNdEx:postIndex])
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9444,7 +15947,7 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondQueryTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+func (m *ListWorkersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9467,12 +15970,46 @@ func (m *RespondQueryTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondQueryTaskCompletedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListWorkersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondQueryTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListWorkersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Workers = append(m.Workers, &WorkerInfo{})
+			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -9495,7 +16032,7 @@ func (m *RespondQueryTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeWorkerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9518,10 +16055,10 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelOutstandingPollRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeWorkerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelOutstandingPollRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeWorkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9558,7 +16095,7 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9586,32 +16123,13 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PollerId = string(dAtA[iNdEx:postIndex])
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
-			}
-			m.TaskListType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -9621,27 +16139,23 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskList == nil {
-				m.TaskList = &v1.TaskList{}
-			}
-			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9665,58 +16179,7 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelOutstandingPollResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CancelOutstandingPollResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelOutstandingPollResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeWorkerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9739,15 +16202,15 @@ func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeTaskListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeWorkerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeTaskListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeWorkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9774,45 +16237,13 @@ func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.DescribeTaskListRequest{}
+			if m.Worker == nil {
+				m.Worker = &WorkerInfo{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Worker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -9835,7 +16266,7 @@ func (m *DescribeTaskListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
+func (m *WorkerInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9858,17 +16289,17 @@ func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeTaskListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkerInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeTaskListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pollers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -9878,29 +16309,27 @@ func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pollers = append(m.Pollers, &v1.PollerInfo{})
-			if err := m.Pollers[len(m.Pollers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskListStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9927,16 +16356,16 @@ func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskListStatus == nil {
-				m.TaskListStatus = &v1.TaskListStatus{}
+			if m.VersionInfo == nil {
+				m.VersionInfo = &v1.WorkerVersionInfo{}
 			}
-			if err := m.TaskListStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VersionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskLists", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9963,16 +16392,14 @@ func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PartitionConfig == nil {
-				m.PartitionConfig = &v1.TaskListPartitionConfig{}
-			}
-			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TaskLists = append(m.TaskLists, &WorkerTaskListInfo{})
+			if err := m.TaskLists[len(m.TaskLists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPollTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9999,13 +16426,24 @@ func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskList == nil {
-				m.TaskList = &v1.TaskList{}
+			if m.LastPollTime == nil {
+				m.LastPollTime = &types.Timestamp{}
 			}
-			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastPollTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollsPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.PollsPerSecond = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -10028,7 +16466,7 @@ func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTaskListPartitionsRequest) Unmarshal(dAtA []byte) error {
+func (m *WorkerTaskListInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10051,17 +16489,17 @@ func (m *ListTaskListPartitionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTaskListPartitionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkerTaskListInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTaskListPartitionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkerTaskListInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -10071,27 +16509,50 @@ func (m *ListTaskListPartitionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
+			}
+			m.TaskListType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPollTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10118,10 +16579,10 @@ func (m *ListTaskListPartitionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskList == nil {
-				m.TaskList = &v1.TaskList{}
+			if m.LastPollTime == nil {
+				m.LastPollTime = &types.Timestamp{}
 			}
-			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastPollTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10147,7 +16608,7 @@ func (m *ListTaskListPartitionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTaskListPartitionsResponse) Unmarshal(dAtA []byte) error {
+func (m *MigrateTaskListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10170,15 +16631,79 @@ func (m *ListTaskListPartitionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTaskListPartitionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MigrateTaskListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTaskListPartitionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MigrateTaskListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskListPartitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10205,14 +16730,35 @@ func (m *ListTaskListPartitionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActivityTaskListPartitions = append(m.ActivityTaskListPartitions, &v1.TaskListPartitionMetadata{})
-			if err := m.ActivityTaskListPartitions[len(m.ActivityTaskListPartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
+			}
+			m.TaskListType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskListPartitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetTaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10239,11 +16785,53 @@ func (m *ListTaskListPartitionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DecisionTaskListPartitions = append(m.DecisionTaskListPartitions, &v1.TaskListPartitionMetadata{})
-			if err := m.DecisionTaskListPartitions[len(m.DecisionTaskListPartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TargetTaskList == nil {
+				m.TargetTaskList = &v1.TaskList{}
+			}
+			if err := m.TargetTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Alias = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stop", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stop = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -10266,7 +16854,7 @@ func (m *ListTaskListPartitionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTaskListsByDomainRequest) Unmarshal(dAtA []byte) error {
+func (m *MigrateTaskListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10285,21 +16873,57 @@ func (m *GetTaskListsByDomainRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetTaskListsByDomainRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTaskListsByDomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MigrateTaskListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MigrateTaskListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MigrationConfig == nil {
+				m.MigrationConfig = &TaskListMigrationConfig{}
+			}
+			if err := m.MigrationConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -10309,23 +16933,25 @@ func (m *GetTaskListsByDomainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
+			m.Partitions = append(m.Partitions, &TaskListMigrationPartitionStatus{})
+			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10349,7 +16975,7 @@ func (m *GetTaskListsByDomainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTaskListsByDomainResponse) Unmarshal(dAtA []byte) error {
+func (m *TaskListMigrationConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10372,17 +16998,17 @@ func (m *GetTaskListsByDomainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTaskListsByDomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskListMigrationConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTaskListsByDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskListMigrationConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskListMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetTaskList", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -10392,126 +17018,29 @@ func (m *GetTaskListsByDomainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DecisionTaskListMap == nil {
-				m.DecisionTaskListMap = make(map[string]*DescribeTaskListResponse)
-			}
-			var mapkey string
-			var mapvalue *DescribeTaskListResponse
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthService
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthService
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &DescribeTaskListResponse{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.DecisionTaskListMap[mapkey] = mapvalue
+			m.TargetTaskList = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskListMap", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -10519,123 +17048,14 @@ func (m *GetTaskListsByDomainResponse) Unmarshal(dAtA []byte) error {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ActivityTaskListMap == nil {
-				m.ActivityTaskListMap = make(map[string]*DescribeTaskListResponse)
-			}
-			var mapkey string
-			var mapvalue *DescribeTaskListResponse
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthService
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthService
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &DescribeTaskListResponse{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.ActivityTaskListMap[mapkey] = mapvalue
-			iNdEx = postIndex
+			m.Alias = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -10658,7 +17078,7 @@ func (m *GetTaskListsByDomainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateTaskListPartitionConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *TaskListMigrationPartitionStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10681,15 +17101,15 @@ func (m *UpdateTaskListPartitionConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateTaskListPartitionConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskListMigrationPartitionStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateTaskListPartitionConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskListMigrationPartitionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10717,13 +17137,13 @@ func (m *UpdateTaskListPartitionConfigRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			m.Partition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerHostName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -10733,52 +17153,29 @@ func (m *UpdateTaskListPartitionConfigRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskList == nil {
-				m.TaskList = &v1.TaskList{}
-			}
-			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OwnerHostName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
-			}
-			m.TaskListType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogCountHint", wireType)
 			}
-			var msglen int
+			m.BacklogCountHint = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -10788,28 +17185,11 @@ func (m *UpdateTaskListPartitionConfigRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BacklogCountHint |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PartitionConfig == nil {
-				m.PartitionConfig = &v1.TaskListPartitionConfig{}
-			}
-			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -10832,7 +17212,7 @@ func (m *UpdateTaskListPartitionConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateTaskListPartitionConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *DescribeDomainTaskQuotaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10855,12 +17235,44 @@ func (m *UpdateTaskListPartitionConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateTaskListPartitionConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeDomainTaskQuotaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateTaskListPartitionConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeDomainTaskQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -10883,7 +17295,7 @@ func (m *UpdateTaskListPartitionConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshTaskListPartitionConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeDomainTaskQuotaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10906,102 +17318,15 @@ func (m *RefreshTaskListPartitionConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshTaskListPartitionConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeDomainTaskQuotaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshTaskListPartitionConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeDomainTaskQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TaskList == nil {
-				m.TaskList = &v1.TaskList{}
-			}
-			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
-			}
-			m.TaskListType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQuota", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11028,10 +17353,10 @@ func (m *RefreshTaskListPartitionConfigRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PartitionConfig == nil {
-				m.PartitionConfig = &v1.TaskListPartitionConfig{}
+			if m.TaskQuota == nil {
+				m.TaskQuota = &DomainTaskQuota{}
 			}
-			if err := m.PartitionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11057,7 +17382,7 @@ func (m *RefreshTaskListPartitionConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshTaskListPartitionConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *DomainTaskQuota) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11080,12 +17405,78 @@ func (m *RefreshTaskListPartitionConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshTaskListPartitionConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DomainTaskQuota: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshTaskListPartitionConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DomainTaskQuota: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddTaskRpsLimit", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.AddTaskRpsLimit = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddTaskRps", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.AddTaskRps = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddTaskRejectedRps", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.AddTaskRejectedRps = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DispatchRpsLimit", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DispatchRpsLimit = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DispatchRps", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DispatchRps = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DispatchRejectedRps", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DispatchRejectedRps = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -34,6 +34,12 @@ type MatchingAPIYARPCClient interface {
 	GetTaskListsByDomain(context.Context, *GetTaskListsByDomainRequest, ...yarpc.CallOption) (*GetTaskListsByDomainResponse, error)
 	UpdateTaskListPartitionConfig(context.Context, *UpdateTaskListPartitionConfigRequest, ...yarpc.CallOption) (*UpdateTaskListPartitionConfigResponse, error)
 	RefreshTaskListPartitionConfig(context.Context, *RefreshTaskListPartitionConfigRequest, ...yarpc.CallOption) (*RefreshTaskListPartitionConfigResponse, error)
+	UpdateTaskListVersioningConfig(context.Context, *UpdateTaskListVersioningConfigRequest, ...yarpc.CallOption) (*UpdateTaskListVersioningConfigResponse, error)
+	GetTaskListScalingRecommendation(context.Context, *GetTaskListScalingRecommendationRequest, ...yarpc.CallOption) (*GetTaskListScalingRecommendationResponse, error)
+	ListWorkers(context.Context, *ListWorkersRequest, ...yarpc.CallOption) (*ListWorkersResponse, error)
+	DescribeWorker(context.Context, *DescribeWorkerRequest, ...yarpc.CallOption) (*DescribeWorkerResponse, error)
+	MigrateTaskList(context.Context, *MigrateTaskListRequest, ...yarpc.CallOption) (*MigrateTaskListResponse, error)
+	DescribeDomainTaskQuota(context.Context, *DescribeDomainTaskQuotaRequest, ...yarpc.CallOption) (*DescribeDomainTaskQuotaResponse, error)
 }
 
 func newMatchingAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) MatchingAPIYARPCClient {
@@ -66,6 +72,12 @@ type MatchingAPIYARPCServer interface {
 	GetTaskListsByDomain(context.Context, *GetTaskListsByDomainRequest) (*GetTaskListsByDomainResponse, error)
 	UpdateTaskListPartitionConfig(context.Context, *UpdateTaskListPartitionConfigRequest) (*UpdateTaskListPartitionConfigResponse, error)
 	RefreshTaskListPartitionConfig(context.Context, *RefreshTaskListPartitionConfigRequest) (*RefreshTaskListPartitionConfigResponse, error)
+	UpdateTaskListVersioningConfig(context.Context, *UpdateTaskListVersioningConfigRequest) (*UpdateTaskListVersioningConfigResponse, error)
+	GetTaskListScalingRecommendation(context.Context, *GetTaskListScalingRecommendationRequest) (*GetTaskListScalingRecommendationResponse, error)
+	ListWorkers(context.Context, *ListWorkersRequest) (*ListWorkersResponse, error)
+	DescribeWorker(context.Context, *DescribeWorkerRequest) (*DescribeWorkerResponse, error)
+	MigrateTaskList(context.Context, *MigrateTaskListRequest) (*MigrateTaskListResponse, error)
+	DescribeDomainTaskQuota(context.Context, *DescribeDomainTaskQuotaRequest) (*DescribeDomainTaskQuotaResponse, error)
 }
 
 type buildMatchingAPIYARPCProceduresParams struct {
@@ -199,6 +211,66 @@ func buildMatchingAPIYARPCProcedures(params buildMatchingAPIYARPCProceduresParam
 						},
 					),
 				},
+				{
+					MethodName: "UpdateTaskListVersioningConfig",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateTaskListVersioningConfig,
+							NewRequest:  newMatchingAPIServiceUpdateTaskListVersioningConfigYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetTaskListScalingRecommendation",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetTaskListScalingRecommendation,
+							NewRequest:  newMatchingAPIServiceGetTaskListScalingRecommendationYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ListWorkers",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ListWorkers,
+							NewRequest:  newMatchingAPIServiceListWorkersYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeWorker",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeWorker,
+							NewRequest:  newMatchingAPIServiceDescribeWorkerYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "MigrateTaskList",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.MigrateTaskList,
+							NewRequest:  newMatchingAPIServiceMigrateTaskListYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeDomainTaskQuota",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeDomainTaskQuota,
+							NewRequest:  newMatchingAPIServiceDescribeDomainTaskQuotaYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 			},
 			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
 			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
@@ -461,6 +533,78 @@ func (c *_MatchingAPIYARPCCaller) RefreshTaskListPartitionConfig(ctx context.Con
 	return response, err
 }
 
+func (c *_MatchingAPIYARPCCaller) UpdateTaskListVersioningConfig(ctx context.Context, request *UpdateTaskListVersioningConfigRequest, options ...yarpc.CallOption) (*UpdateTaskListVersioningConfigResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateTaskListVersioningConfig", request, newMatchingAPIServiceUpdateTaskListVersioningConfigYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateTaskListVersioningConfigResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceUpdateTaskListVersioningConfigYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_MatchingAPIYARPCCaller) GetTaskListScalingRecommendation(ctx context.Context, request *GetTaskListScalingRecommendationRequest, options ...yarpc.CallOption) (*GetTaskListScalingRecommendationResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetTaskListScalingRecommendation", request, newMatchingAPIServiceGetTaskListScalingRecommendationYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetTaskListScalingRecommendationResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceGetTaskListScalingRecommendationYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_MatchingAPIYARPCCaller) ListWorkers(ctx context.Context, request *ListWorkersRequest, options ...yarpc.CallOption) (*ListWorkersResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListWorkers", request, newMatchingAPIServiceListWorkersYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListWorkersResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceListWorkersYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_MatchingAPIYARPCCaller) DescribeWorker(ctx context.Context, request *DescribeWorkerRequest, options ...yarpc.CallOption) (*DescribeWorkerResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeWorker", request, newMatchingAPIServiceDescribeWorkerYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeWorkerResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceDescribeWorkerYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_MatchingAPIYARPCCaller) MigrateTaskList(ctx context.Context, request *MigrateTaskListRequest, options ...yarpc.CallOption) (*MigrateTaskListResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "MigrateTaskList", request, newMatchingAPIServiceMigrateTaskListYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*MigrateTaskListResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceMigrateTaskListYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_MatchingAPIYARPCCaller) DescribeDomainTaskQuota(ctx context.Context, request *DescribeDomainTaskQuotaRequest, options ...yarpc.CallOption) (*DescribeDomainTaskQuotaResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeDomainTaskQuota", request, newMatchingAPIServiceDescribeDomainTaskQuotaYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeDomainTaskQuotaResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceDescribeDomainTaskQuotaYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 type _MatchingAPIYARPCHandler struct {
 	server MatchingAPIYARPCServer
 }
@@ -657,6 +801,102 @@ func (h *_MatchingAPIYARPCHandler) RefreshTaskListPartitionConfig(ctx context.Co
 	return response, err
 }
 
+func (h *_MatchingAPIYARPCHandler) UpdateTaskListVersioningConfig(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateTaskListVersioningConfigRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateTaskListVersioningConfigRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceUpdateTaskListVersioningConfigYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateTaskListVersioningConfig(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_MatchingAPIYARPCHandler) GetTaskListScalingRecommendation(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetTaskListScalingRecommendationRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetTaskListScalingRecommendationRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceGetTaskListScalingRecommendationYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetTaskListScalingRecommendation(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_MatchingAPIYARPCHandler) ListWorkers(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListWorkersRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListWorkersRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceListWorkersYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListWorkers(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_MatchingAPIYARPCHandler) DescribeWorker(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeWorkerRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeWorkerRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceDescribeWorkerYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeWorker(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_MatchingAPIYARPCHandler) MigrateTaskList(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *MigrateTaskListRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*MigrateTaskListRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceMigrateTaskListYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.MigrateTaskList(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_MatchingAPIYARPCHandler) DescribeDomainTaskQuota(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeDomainTaskQuotaRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeDomainTaskQuotaRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceDescribeDomainTaskQuotaYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeDomainTaskQuota(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func newMatchingAPIServicePollForDecisionTaskYARPCRequest() proto.Message {
 	return &PollForDecisionTaskRequest{}
 }
@@ -753,194 +993,333 @@ func newMatchingAPIServiceRefreshTaskListPartitionConfigYARPCResponse() proto.Me
 	return &RefreshTaskListPartitionConfigResponse{}
 }
 
+func newMatchingAPIServiceUpdateTaskListVersioningConfigYARPCRequest() proto.Message {
+	return &UpdateTaskListVersioningConfigRequest{}
+}
+
+func newMatchingAPIServiceUpdateTaskListVersioningConfigYARPCResponse() proto.Message {
+	return &UpdateTaskListVersioningConfigResponse{}
+}
+
+func newMatchingAPIServiceGetTaskListScalingRecommendationYARPCRequest() proto.Message {
+	return &GetTaskListScalingRecommendationRequest{}
+}
+
+func newMatchingAPIServiceGetTaskListScalingRecommendationYARPCResponse() proto.Message {
+	return &GetTaskListScalingRecommendationResponse{}
+}
+
+func newMatchingAPIServiceListWorkersYARPCRequest() proto.Message {
+	return &ListWorkersRequest{}
+}
+
+func newMatchingAPIServiceListWorkersYARPCResponse() proto.Message {
+	return &ListWorkersResponse{}
+}
+
+func newMatchingAPIServiceDescribeWorkerYARPCRequest() proto.Message {
+	return &DescribeWorkerRequest{}
+}
+
+func newMatchingAPIServiceDescribeWorkerYARPCResponse() proto.Message {
+	return &DescribeWorkerResponse{}
+}
+
+func newMatchingAPIServiceMigrateTaskListYARPCRequest() proto.Message {
+	return &MigrateTaskListRequest{}
+}
+
+func newMatchingAPIServiceMigrateTaskListYARPCResponse() proto.Message {
+	return &MigrateTaskListResponse{}
+}
+
+func newMatchingAPIServiceDescribeDomainTaskQuotaYARPCRequest() proto.Message {
+	return &DescribeDomainTaskQuotaRequest{}
+}
+
+func newMatchingAPIServiceDescribeDomainTaskQuotaYARPCResponse() proto.Message {
+	return &DescribeDomainTaskQuotaResponse{}
+}
+
 var (
-	emptyMatchingAPIServicePollForDecisionTaskYARPCRequest             = &PollForDecisionTaskRequest{}
-	emptyMatchingAPIServicePollForDecisionTaskYARPCResponse            = &PollForDecisionTaskResponse{}
-	emptyMatchingAPIServicePollForActivityTaskYARPCRequest             = &PollForActivityTaskRequest{}
-	emptyMatchingAPIServicePollForActivityTaskYARPCResponse            = &PollForActivityTaskResponse{}
-	emptyMatchingAPIServiceAddDecisionTaskYARPCRequest                 = &AddDecisionTaskRequest{}
-	emptyMatchingAPIServiceAddDecisionTaskYARPCResponse                = &AddDecisionTaskResponse{}
-	emptyMatchingAPIServiceAddActivityTaskYARPCRequest                 = &AddActivityTaskRequest{}
-	emptyMatchingAPIServiceAddActivityTaskYARPCResponse                = &AddActivityTaskResponse{}
-	emptyMatchingAPIServiceQueryWorkflowYARPCRequest                   = &QueryWorkflowRequest{}
-	emptyMatchingAPIServiceQueryWorkflowYARPCResponse                  = &QueryWorkflowResponse{}
-	emptyMatchingAPIServiceRespondQueryTaskCompletedYARPCRequest       = &RespondQueryTaskCompletedRequest{}
-	emptyMatchingAPIServiceRespondQueryTaskCompletedYARPCResponse      = &RespondQueryTaskCompletedResponse{}
-	emptyMatchingAPIServiceCancelOutstandingPollYARPCRequest           = &CancelOutstandingPollRequest{}
-	emptyMatchingAPIServiceCancelOutstandingPollYARPCResponse          = &CancelOutstandingPollResponse{}
-	emptyMatchingAPIServiceDescribeTaskListYARPCRequest                = &DescribeTaskListRequest{}
-	emptyMatchingAPIServiceDescribeTaskListYARPCResponse               = &DescribeTaskListResponse{}
-	emptyMatchingAPIServiceListTaskListPartitionsYARPCRequest          = &ListTaskListPartitionsRequest{}
-	emptyMatchingAPIServiceListTaskListPartitionsYARPCResponse         = &ListTaskListPartitionsResponse{}
-	emptyMatchingAPIServiceGetTaskListsByDomainYARPCRequest            = &GetTaskListsByDomainRequest{}
-	emptyMatchingAPIServiceGetTaskListsByDomainYARPCResponse           = &GetTaskListsByDomainResponse{}
-	emptyMatchingAPIServiceUpdateTaskListPartitionConfigYARPCRequest   = &UpdateTaskListPartitionConfigRequest{}
-	emptyMatchingAPIServiceUpdateTaskListPartitionConfigYARPCResponse  = &UpdateTaskListPartitionConfigResponse{}
-	emptyMatchingAPIServiceRefreshTaskListPartitionConfigYARPCRequest  = &RefreshTaskListPartitionConfigRequest{}
-	emptyMatchingAPIServiceRefreshTaskListPartitionConfigYARPCResponse = &RefreshTaskListPartitionConfigResponse{}
+	emptyMatchingAPIServicePollForDecisionTaskYARPCRequest               = &PollForDecisionTaskRequest{}
+	emptyMatchingAPIServicePollForDecisionTaskYARPCResponse              = &PollForDecisionTaskResponse{}
+	emptyMatchingAPIServicePollForActivityTaskYARPCRequest               = &PollForActivityTaskRequest{}
+	emptyMatchingAPIServicePollForActivityTaskYARPCResponse              = &PollForActivityTaskResponse{}
+	emptyMatchingAPIServiceAddDecisionTaskYARPCRequest                   = &AddDecisionTaskRequest{}
+	emptyMatchingAPIServiceAddDecisionTaskYARPCResponse                  = &AddDecisionTaskResponse{}
+	emptyMatchingAPIServiceAddActivityTaskYARPCRequest                   = &AddActivityTaskRequest{}
+	emptyMatchingAPIServiceAddActivityTaskYARPCResponse                  = &AddActivityTaskResponse{}
+	emptyMatchingAPIServiceQueryWorkflowYARPCRequest                     = &QueryWorkflowRequest{}
+	emptyMatchingAPIServiceQueryWorkflowYARPCResponse                    = &QueryWorkflowResponse{}
+	emptyMatchingAPIServiceRespondQueryTaskCompletedYARPCRequest         = &RespondQueryTaskCompletedRequest{}
+	emptyMatchingAPIServiceRespondQueryTaskCompletedYARPCResponse        = &RespondQueryTaskCompletedResponse{}
+	emptyMatchingAPIServiceCancelOutstandingPollYARPCRequest             = &CancelOutstandingPollRequest{}
+	emptyMatchingAPIServiceCancelOutstandingPollYARPCResponse            = &CancelOutstandingPollResponse{}
+	emptyMatchingAPIServiceDescribeTaskListYARPCRequest                  = &DescribeTaskListRequest{}
+	emptyMatchingAPIServiceDescribeTaskListYARPCResponse                 = &DescribeTaskListResponse{}
+	emptyMatchingAPIServiceListTaskListPartitionsYARPCRequest            = &ListTaskListPartitionsRequest{}
+	emptyMatchingAPIServiceListTaskListPartitionsYARPCResponse           = &ListTaskListPartitionsResponse{}
+	emptyMatchingAPIServiceGetTaskListsByDomainYARPCRequest              = &GetTaskListsByDomainRequest{}
+	emptyMatchingAPIServiceGetTaskListsByDomainYARPCResponse             = &GetTaskListsByDomainResponse{}
+	emptyMatchingAPIServiceUpdateTaskListPartitionConfigYARPCRequest     = &UpdateTaskListPartitionConfigRequest{}
+	emptyMatchingAPIServiceUpdateTaskListPartitionConfigYARPCResponse    = &UpdateTaskListPartitionConfigResponse{}
+	emptyMatchingAPIServiceRefreshTaskListPartitionConfigYARPCRequest    = &RefreshTaskListPartitionConfigRequest{}
+	emptyMatchingAPIServiceRefreshTaskListPartitionConfigYARPCResponse   = &RefreshTaskListPartitionConfigResponse{}
+	emptyMatchingAPIServiceUpdateTaskListVersioningConfigYARPCRequest    = &UpdateTaskListVersioningConfigRequest{}
+	emptyMatchingAPIServiceUpdateTaskListVersioningConfigYARPCResponse   = &UpdateTaskListVersioningConfigResponse{}
+	emptyMatchingAPIServiceGetTaskListScalingRecommendationYARPCRequest  = &GetTaskListScalingRecommendationRequest{}
+	emptyMatchingAPIServiceGetTaskListScalingRecommendationYARPCResponse = &GetTaskListScalingRecommendationResponse{}
+	emptyMatchingAPIServiceListWorkersYARPCRequest                       = &ListWorkersRequest{}
+	emptyMatchingAPIServiceListWorkersYARPCResponse                      = &ListWorkersResponse{}
+	emptyMatchingAPIServiceDescribeWorkerYARPCRequest                    = &DescribeWorkerRequest{}
+	emptyMatchingAPIServiceDescribeWorkerYARPCResponse                   = &DescribeWorkerResponse{}
+	emptyMatchingAPIServiceMigrateTaskListYARPCRequest                   = &MigrateTaskListRequest{}
+	emptyMatchingAPIServiceMigrateTaskListYARPCResponse                  = &MigrateTaskListResponse{}
+	emptyMatchingAPIServiceDescribeDomainTaskQuotaYARPCRequest           = &DescribeDomainTaskQuotaRequest{}
+	emptyMatchingAPIServiceDescribeDomainTaskQuotaYARPCResponse          = &DescribeDomainTaskQuotaResponse{}
 )
 
 var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 	// uber/cadence/matching/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x49, 0x73, 0xdc, 0xc6,
-		0xf5, 0x2f, 0x0c, 0x39, 0x5c, 0xde, 0x90, 0x43, 0xb2, 0x49, 0x53, 0xd0, 0x48, 0x94, 0xa8, 0x91,
-		0x25, 0xd3, 0xff, 0xbf, 0x33, 0x34, 0x69, 0x49, 0x91, 0xa5, 0x8a, 0x15, 0x2e, 0xa2, 0x34, 0x29,
-		0x2b, 0x92, 0x21, 0x5a, 0xaa, 0x4a, 0x5c, 0x42, 0x9a, 0x83, 0x26, 0x07, 0x21, 0x06, 0x80, 0x80,
-		0x06, 0x69, 0xfa, 0x90, 0x43, 0x2a, 0x49, 0xa5, 0x2a, 0xd7, 0xe4, 0x9e, 0xed, 0x4b, 0xe4, 0x92,
-		0xcf, 0x91, 0x2a, 0x57, 0x0e, 0x39, 0xe4, 0x03, 0x24, 0x55, 0xb9, 0xe5, 0x90, 0xea, 0x05, 0x33,
-		0x00, 0xa6, 0x31, 0x0b, 0x49, 0xc9, 0x39, 0xe4, 0x46, 0x74, 0xbf, 0xad, 0xdf, 0xf6, 0x7b, 0xdd,
-		0x43, 0xb8, 0x19, 0xed, 0x91, 0x60, 0xb5, 0x81, 0x2d, 0xe2, 0x36, 0xc8, 0x6a, 0x0b, 0xd3, 0x46,
-		0xd3, 0x76, 0x0f, 0x56, 0x8f, 0xd6, 0x56, 0x43, 0x12, 0x1c, 0xd9, 0x0d, 0x52, 0xf3, 0x03, 0x8f,
-		0x7a, 0x48, 0x67, 0x74, 0x35, 0x49, 0x57, 0x8b, 0xe9, 0x6a, 0x47, 0x6b, 0x95, 0x2b, 0x07, 0x9e,
-		0x77, 0xe0, 0x90, 0x55, 0x4e, 0xb7, 0x17, 0xed, 0xaf, 0x5a, 0x51, 0x80, 0xa9, 0xed, 0xb9, 0x82,
-		0xb3, 0x72, 0x35, 0xbb, 0x4f, 0xed, 0x16, 0x09, 0x29, 0x6e, 0xf9, 0x92, 0xa0, 0x4b, 0xc0, 0x71,
-		0x80, 0x7d, 0x9f, 0x04, 0xa1, 0xdc, 0x5f, 0x4e, 0x99, 0x88, 0x7d, 0x9b, 0x59, 0xd7, 0xf0, 0x5a,
-		0xad, 0x8e, 0x0a, 0x15, 0xc5, 0xeb, 0x88, 0x04, 0x27, 0x92, 0xa0, 0xaa, 0x22, 0xa0, 0x38, 0x3c,
-		0x74, 0xec, 0x90, 0x4a, 0x9a, 0x15, 0x15, 0x8d, 0x74, 0x82, 0x79, 0xec, 0x05, 0x87, 0x24, 0x90,
-		0x94, 0xff, 0xd7, 0x8f, 0x72, 0xdf, 0xf1, 0x8e, 0x25, 0xed, 0x35, 0x15, 0x6d, 0xd3, 0x0e, 0xa9,
-		0xd7, 0x36, 0xee, 0xdd, 0x14, 0x49, 0xd8, 0xc4, 0x01, 0xb1, 0xba, 0xa9, 0x6e, 0xe4, 0x50, 0xa5,
-		0x4f, 0x51, 0xfd, 0x04, 0xe6, 0x76, 0x71, 0x78, 0xf8, 0xa9, 0x1d, 0xd2, 0x67, 0x38, 0xa0, 0x36,
-		0x0b, 0x04, 0x7a, 0x1f, 0x66, 0xed, 0xd0, 0x73, 0x78, 0x54, 0xcc, 0x83, 0xc0, 0x8b, 0xfc, 0x50,
-		0xd7, 0x96, 0x47, 0x56, 0x26, 0x8d, 0x99, 0xf6, 0xfa, 0x23, 0xbe, 0x5c, 0xfd, 0xdb, 0x28, 0x5c,
-		0xe8, 0x12, 0xb0, 0xe5, 0xb9, 0xfb, 0xf6, 0x01, 0xd2, 0x61, 0xfc, 0x88, 0x04, 0xa1, 0xed, 0xb9,
-		0xba, 0xb6, 0xac, 0xad, 0x8c, 0x18, 0xf1, 0x27, 0x5a, 0x87, 0x79, 0x37, 0x6a, 0x99, 0x01, 0xc1,
-		0x96, 0xe9, 0xc7, 0x5c, 0xa1, 0x5e, 0x58, 0xd6, 0x56, 0x8a, 0x9b, 0x05, 0x5d, 0x33, 0xe6, 0xdc,
-		0xa8, 0x65, 0x10, 0x6c, 0xb5, 0x45, 0x86, 0xe8, 0x16, 0x2c, 0x30, 0x9e, 0xe3, 0xc0, 0xa6, 0x24,
-		0xc9, 0x34, 0xd2, 0x66, 0x42, 0x6e, 0xd4, 0x7a, 0xc9, 0xb6, 0x13, 0x5c, 0x2e, 0xcc, 0x64, 0xb5,
-		0x8c, 0x2e, 0x8f, 0xac, 0x94, 0xd6, 0x1f, 0xd6, 0xf2, 0x32, 0xb4, 0x96, 0x73, 0x9e, 0x5a, 0xda,
-		0xa0, 0x87, 0x2e, 0x0d, 0x4e, 0x8c, 0x72, 0x90, 0xb6, 0xf2, 0x35, 0xcc, 0x76, 0x59, 0x58, 0xe4,
-		0x0a, 0x77, 0x86, 0x57, 0x98, 0x39, 0x8c, 0xd0, 0x38, 0x73, 0x9c, 0x5e, 0xad, 0xb8, 0x30, 0xaf,
-		0xb0, 0x0c, 0xcd, 0xc2, 0xc8, 0x21, 0x39, 0xe1, 0x9e, 0x2f, 0x1a, 0xec, 0x4f, 0xb4, 0x01, 0xc5,
-		0x23, 0xec, 0x44, 0x84, 0xfb, 0xb9, 0xb4, 0xfe, 0xff, 0x43, 0x18, 0x64, 0x08, 0xce, 0x7b, 0x85,
-		0xbb, 0x5a, 0xc5, 0x83, 0x05, 0x95, 0x61, 0x6f, 0x4c, 0x61, 0xf5, 0x47, 0x30, 0xf7, 0xa9, 0x87,
-		0xad, 0x4d, 0xec, 0x60, 0xb7, 0x41, 0x82, 0xc7, 0xb6, 0x4b, 0x43, 0x74, 0x1d, 0xa6, 0xf7, 0x70,
-		0xe3, 0xd0, 0xf1, 0x0e, 0xcc, 0x86, 0x17, 0xb9, 0x54, 0xa6, 0xd8, 0x94, 0x5c, 0xdc, 0x62, 0x6b,
-		0xe8, 0x26, 0xcc, 0x04, 0x98, 0x05, 0x83, 0x04, 0x66, 0x48, 0x1a, 0x9e, 0x6b, 0x71, 0x53, 0x34,
-		0x63, 0x9a, 0x2d, 0x3f, 0x23, 0xc1, 0x73, 0xbe, 0x58, 0xfd, 0x87, 0x06, 0x95, 0x67, 0x9e, 0xe3,
-		0xec, 0x78, 0xc1, 0x36, 0x69, 0xd8, 0x2c, 0x47, 0x99, 0x45, 0x06, 0x79, 0x1d, 0x91, 0x90, 0xa2,
-		0x3a, 0x8c, 0x07, 0xe2, 0x4f, 0xae, 0xa5, 0xb4, 0xbe, 0x9a, 0x3e, 0x09, 0xf6, 0x6d, 0x76, 0x88,
-		0x7c, 0x09, 0x46, 0xcc, 0x8f, 0x2e, 0xc1, 0xa4, 0xe5, 0xb5, 0xb0, 0xed, 0x9a, 0xb6, 0xb0, 0x65,
-		0xd2, 0x98, 0x10, 0x0b, 0x75, 0x8b, 0x6d, 0xfa, 0x9e, 0xe3, 0x90, 0x80, 0x6d, 0x8e, 0x88, 0x4d,
-		0xb1, 0x50, 0xb7, 0xd0, 0x0d, 0x28, 0xef, 0x7b, 0xc1, 0x31, 0x0e, 0x2c, 0x62, 0x99, 0xfb, 0x81,
-		0xd7, 0xd2, 0x47, 0x39, 0xc5, 0x74, 0x7b, 0x75, 0x27, 0xf0, 0x5a, 0xe8, 0x3d, 0x98, 0xc9, 0xd4,
-		0xae, 0x5e, 0xe4, 0x74, 0xe5, 0x74, 0xe9, 0x56, 0xff, 0x5c, 0x82, 0x4b, 0x4a, 0x8b, 0x43, 0xdf,
-		0x73, 0x43, 0x82, 0x96, 0x00, 0x58, 0xaf, 0x30, 0xa9, 0x77, 0x48, 0x44, 0x01, 0x4f, 0x19, 0x93,
-		0x6c, 0x65, 0x97, 0x2d, 0xa0, 0xcf, 0x01, 0xc5, 0xad, 0xcb, 0x24, 0x5f, 0x92, 0x46, 0xc4, 0x24,
-		0xcb, 0x40, 0xdf, 0x54, 0xba, 0xe7, 0xa5, 0x24, 0x7f, 0x18, 0x53, 0x1b, 0x73, 0xc7, 0xd9, 0x25,
-		0xb4, 0x03, 0xd3, 0x6d, 0xb1, 0xf4, 0xc4, 0x27, 0xdc, 0x0d, 0xa5, 0xf5, 0x6b, 0x3d, 0x25, 0xee,
-		0x9e, 0xf8, 0xc4, 0x98, 0x3a, 0x4e, 0x7c, 0xa1, 0x17, 0x70, 0xd1, 0x0f, 0xc8, 0x91, 0xed, 0x45,
-		0xa1, 0x19, 0x52, 0x1c, 0x50, 0x62, 0x99, 0xe4, 0x88, 0xb8, 0x94, 0xb9, 0x76, 0x94, 0xcb, 0xbc,
-		0x54, 0x13, 0x40, 0x52, 0x8b, 0x81, 0xa4, 0x56, 0x77, 0xe9, 0x9d, 0x5b, 0x2f, 0x58, 0xde, 0x19,
-		0x8b, 0x31, 0xf7, 0x73, 0xc1, 0xfc, 0x90, 0xf1, 0xd6, 0x2d, 0xb4, 0x02, 0xb3, 0x5d, 0xe2, 0x8a,
-		0x3c, 0xf3, 0xca, 0x61, 0x9a, 0x52, 0x87, 0x71, 0x4c, 0x29, 0x69, 0xf9, 0x54, 0x1f, 0xe3, 0x25,
-		0x11, 0x7f, 0xa2, 0x2a, 0x4c, 0xbb, 0xe4, 0x4b, 0xda, 0x11, 0x30, 0xce, 0x05, 0x94, 0xd8, 0x62,
-		0xcc, 0xfd, 0x01, 0xa0, 0x54, 0x7a, 0x9b, 0x4d, 0xdb, 0xa5, 0xfa, 0x04, 0x27, 0x9c, 0x4d, 0xe6,
-		0x38, 0xab, 0x06, 0x74, 0x17, 0xf4, 0x90, 0xda, 0x8d, 0xc3, 0x93, 0x4e, 0x28, 0x4c, 0xe2, 0xe2,
-		0x3d, 0x87, 0x58, 0xfa, 0xe4, 0xb2, 0xb6, 0x32, 0x61, 0x2c, 0x8a, 0xfd, 0xb6, 0xa3, 0x1f, 0x8a,
-		0x5d, 0x74, 0x17, 0x8a, 0x1c, 0xf8, 0x74, 0xe0, 0x3e, 0xa9, 0xf6, 0xf4, 0xf3, 0x67, 0x8c, 0xd2,
-		0x10, 0x0c, 0xc8, 0x80, 0x69, 0x4b, 0xe6, 0x8d, 0x69, 0xbb, 0xfb, 0x9e, 0x5e, 0xe2, 0x12, 0xbe,
-		0x95, 0x96, 0x20, 0x80, 0x87, 0x97, 0x78, 0x80, 0xdd, 0xd0, 0x26, 0x2e, 0x8d, 0xb3, 0xad, 0xee,
-		0xee, 0x7b, 0xc6, 0x94, 0x95, 0xf8, 0x42, 0xaf, 0xe0, 0x72, 0x77, 0x52, 0x99, 0x3c, 0x0d, 0x19,
-		0x66, 0xe9, 0x53, 0x5c, 0xc5, 0x92, 0xd2, 0xc8, 0xb8, 0x85, 0x18, 0x17, 0xbb, 0xb2, 0x2a, 0xde,
-		0x42, 0x35, 0x98, 0x17, 0x4e, 0x67, 0x48, 0x49, 0xcc, 0x18, 0x9d, 0xa6, 0x79, 0x7c, 0xe6, 0xf8,
-		0xd6, 0x73, 0xb6, 0xf3, 0x42, 0xe2, 0xd4, 0x35, 0x98, 0xda, 0x0b, 0xb0, 0xdb, 0x68, 0xca, 0x2a,
-		0x28, 0xf3, 0x2a, 0x28, 0x89, 0x35, 0x51, 0x07, 0x1b, 0x50, 0x0e, 0x1b, 0x4d, 0x62, 0x45, 0x0e,
-		0xb1, 0x4c, 0x36, 0xaa, 0xe8, 0x33, 0xdc, 0xc8, 0x4a, 0x57, 0x76, 0xed, 0xc6, 0x73, 0x8c, 0x31,
-		0xdd, 0xe6, 0x60, 0x6b, 0xe8, 0x3b, 0x30, 0x15, 0xe7, 0x14, 0x17, 0x30, 0xdb, 0x57, 0x40, 0x49,
-		0xd2, 0x73, 0xf6, 0x2f, 0x60, 0x9c, 0x45, 0xc4, 0x26, 0xa1, 0x3e, 0xc7, 0x91, 0x66, 0x33, 0xbf,
-		0xcf, 0xf6, 0x28, 0xf8, 0xda, 0x67, 0x42, 0x88, 0x40, 0x99, 0x58, 0x24, 0x73, 0x19, 0xf5, 0x28,
-		0x76, 0x4c, 0x39, 0x5e, 0x98, 0x7b, 0x27, 0x94, 0x84, 0x3a, 0xe2, 0x99, 0x38, 0xc7, 0xb7, 0x1e,
-		0x8b, 0x9d, 0x4d, 0xb6, 0x81, 0xbe, 0x80, 0xd9, 0x36, 0xf4, 0x99, 0x0d, 0x8e, 0x63, 0xfa, 0x3c,
-		0x3f, 0xd0, 0xda, 0xd0, 0x00, 0x68, 0xcc, 0xf8, 0x99, 0x91, 0xe2, 0x87, 0x30, 0xef, 0x78, 0xd8,
-		0x32, 0xf7, 0x24, 0x16, 0xf0, 0xb2, 0x08, 0xf5, 0x85, 0x7e, 0xf8, 0xd2, 0x85, 0x1f, 0xc6, 0x9c,
-		0xd3, 0x05, 0x29, 0x4f, 0x60, 0x16, 0x47, 0xd4, 0x93, 0x56, 0x8b, 0x8a, 0x7b, 0x87, 0x4b, 0xbe,
-		0xae, 0xcc, 0xb8, 0x8d, 0x88, 0x7a, 0xc2, 0x2e, 0xc6, 0x6f, 0x94, 0x71, 0xea, 0xbb, 0xf2, 0x0a,
-		0xa6, 0x92, 0x2e, 0x4d, 0xe2, 0xe3, 0xa4, 0xc0, 0xc7, 0xbb, 0x69, 0x7c, 0x1c, 0xa8, 0xf8, 0x3a,
-		0xb0, 0x98, 0x00, 0xad, 0x8d, 0x06, 0xb5, 0x8f, 0x6c, 0x7a, 0x72, 0x7a, 0xd0, 0x52, 0x48, 0xf8,
-		0x6f, 0x04, 0xad, 0xdf, 0x40, 0x1b, 0xb4, 0xd2, 0x16, 0x7f, 0xa3, 0xa0, 0x75, 0x15, 0x4a, 0x58,
-		0x5a, 0xd3, 0x71, 0x02, 0xc4, 0x4b, 0x75, 0x8b, 0xa1, 0x5a, 0x9b, 0x80, 0xa3, 0xda, 0x68, 0x0f,
-		0x54, 0x6b, 0x1f, 0x8c, 0xa3, 0x1a, 0x4e, 0x7c, 0xa1, 0x75, 0x28, 0xda, 0xae, 0x1f, 0x51, 0xee,
-		0x9d, 0xd2, 0xfa, 0x65, 0x75, 0x44, 0xf1, 0x09, 0xcb, 0x6d, 0x43, 0x90, 0x2a, 0x1a, 0xd4, 0xd8,
-		0x59, 0x1b, 0xd4, 0xf8, 0x70, 0x0d, 0x6a, 0x17, 0x2e, 0xc6, 0xf2, 0x4c, 0x56, 0x5e, 0x8e, 0x17,
-		0x12, 0x2e, 0xc8, 0x8b, 0x04, 0xa4, 0x95, 0xd6, 0x2f, 0x76, 0xc9, 0xda, 0x96, 0xb7, 0x42, 0x63,
-		0x31, 0xe6, 0xdd, 0xf5, 0xb6, 0x18, 0xe7, 0xae, 0x60, 0x44, 0xdf, 0x87, 0x45, 0xae, 0xa4, 0x5b,
-		0xe4, 0x64, 0x3f, 0x91, 0xf3, 0x9c, 0x31, 0x23, 0x6f, 0x07, 0xe6, 0x9a, 0x04, 0x07, 0x74, 0x8f,
-		0x60, 0xda, 0x16, 0x05, 0xfd, 0x44, 0xcd, 0xb6, 0x79, 0x62, 0x39, 0x09, 0xdc, 0x2f, 0xa5, 0x71,
-		0xff, 0x15, 0x5c, 0x49, 0x47, 0xc2, 0xf4, 0xf6, 0x4d, 0xda, 0xb4, 0x43, 0x33, 0x66, 0x98, 0xea,
-		0xeb, 0xd8, 0x4a, 0x2a, 0x32, 0x4f, 0xf7, 0x77, 0x9b, 0x76, 0xb8, 0x21, 0xe5, 0xd7, 0x93, 0x27,
-		0xb0, 0x08, 0xc5, 0xb6, 0x13, 0x72, 0x6c, 0xeb, 0x97, 0x29, 0x9d, 0x43, 0x6c, 0x0b, 0xae, 0xee,
-		0x31, 0xac, 0x7c, 0xba, 0x31, 0xec, 0x3d, 0x98, 0x69, 0xcb, 0x11, 0x1d, 0x83, 0xc3, 0xe3, 0xa4,
-		0x51, 0x8e, 0x97, 0xb7, 0xf9, 0x2a, 0xfa, 0x08, 0xc6, 0x9a, 0x04, 0x5b, 0x24, 0x90, 0xe8, 0x77,
-		0x49, 0xa9, 0xe9, 0x31, 0x27, 0x31, 0x24, 0x69, 0x1e, 0x1a, 0xcc, 0x9d, 0x0b, 0x1a, 0xbc, 0x59,
-		0x20, 0x53, 0x61, 0xcd, 0xc2, 0xa9, 0xb1, 0xa6, 0xfa, 0x97, 0x51, 0x58, 0xdc, 0xb0, 0x2c, 0xd5,
-		0xe5, 0x25, 0xd5, 0xbc, 0xb5, 0x4c, 0xf3, 0x7e, 0x43, 0x0d, 0xf1, 0x1e, 0x4c, 0x76, 0x86, 0xb6,
-		0x91, 0x41, 0x86, 0xb6, 0x09, 0x1a, 0xcf, 0x68, 0x57, 0xa1, 0xd4, 0xee, 0x16, 0x72, 0x56, 0x1f,
-		0x31, 0x20, 0x5e, 0xaa, 0x5b, 0xd9, 0x76, 0x22, 0x9b, 0x80, 0x2c, 0xd8, 0xe2, 0x10, 0xed, 0x84,
-		0x8f, 0xf6, 0x71, 0xd9, 0xde, 0x83, 0xb1, 0xd0, 0x8b, 0x82, 0x86, 0x68, 0x8f, 0xe5, 0x2c, 0x18,
-		0x27, 0xe6, 0x58, 0x1c, 0x1e, 0x3e, 0xe7, 0x94, 0x86, 0xe4, 0x50, 0xa0, 0xdc, 0xb8, 0x0a, 0xe5,
-		0x7c, 0x45, 0x46, 0x4d, 0xf4, 0x7b, 0x8c, 0x50, 0x47, 0xb5, 0x96, 0x49, 0x30, 0xf9, 0x34, 0x90,
-		0xc9, 0xb2, 0xca, 0x26, 0x2c, 0xa8, 0x08, 0x15, 0xa3, 0xc8, 0x42, 0x72, 0x14, 0x99, 0x4c, 0x8e,
-		0x19, 0xc7, 0x70, 0xa1, 0xcb, 0x06, 0x89, 0xb6, 0xaa, 0x12, 0xd1, 0xce, 0xab, 0x44, 0xaa, 0xff,
-		0x2c, 0xf2, 0x9c, 0x56, 0xcd, 0x36, 0xdf, 0x44, 0x4e, 0xb3, 0x9b, 0x1f, 0x0f, 0xb7, 0xd9, 0x51,
-		0x2d, 0x90, 0xbe, 0x2c, 0xd6, 0xb7, 0x63, 0x03, 0x52, 0xd9, 0x3f, 0x7a, 0xa6, 0xec, 0x2f, 0x0e,
-		0x97, 0xfd, 0x63, 0x67, 0xcf, 0xfe, 0xf1, 0x73, 0xc8, 0xfe, 0x09, 0x55, 0xf6, 0xbb, 0xa0, 0xe3,
-		0x44, 0x28, 0xb7, 0xed, 0xd0, 0x67, 0x59, 0xc1, 0xee, 0x7d, 0x12, 0xb1, 0xd7, 0x7b, 0x54, 0x41,
-		0x0e, 0xa7, 0x91, 0x2b, 0x53, 0x59, 0x6d, 0x30, 0x40, 0xb5, 0x29, 0xf2, 0xed, 0x2d, 0x56, 0xdb,
-		0xd7, 0x23, 0xa0, 0xe7, 0x1d, 0x16, 0x7d, 0x0f, 0x66, 0x3a, 0x03, 0x04, 0xbf, 0xad, 0xca, 0x72,
-		0x53, 0xe3, 0xb2, 0xbc, 0x97, 0xf1, 0x27, 0x05, 0xa3, 0x33, 0x04, 0xf2, 0xef, 0xae, 0x99, 0xae,
-		0x30, 0xdc, 0x4c, 0x97, 0x98, 0x72, 0x46, 0x86, 0x9d, 0x72, 0x46, 0xcf, 0x7f, 0xca, 0x29, 0x9e,
-		0xcf, 0x94, 0x33, 0x76, 0x6e, 0x53, 0xce, 0xb8, 0x6a, 0xca, 0x91, 0xbd, 0x54, 0x79, 0x73, 0x79,
-		0xb3, 0xbd, 0xf4, 0x6b, 0x0d, 0x16, 0xf8, 0x05, 0x32, 0x3e, 0x45, 0xdc, 0x49, 0xb7, 0xb2, 0xb7,
-		0xc4, 0xf7, 0x95, 0x87, 0x57, 0xf1, 0x0e, 0x78, 0x3f, 0x3c, 0xcb, 0x2c, 0x30, 0xd8, 0xf5, 0xb1,
-		0xfa, 0x6f, 0x0d, 0xde, 0xc9, 0x58, 0x28, 0xbd, 0xfa, 0x00, 0xa6, 0xf8, 0x6b, 0x95, 0x19, 0x90,
-		0x30, 0x72, 0xe2, 0x33, 0xf6, 0xce, 0x93, 0x12, 0xe7, 0x30, 0x38, 0x03, 0xaa, 0x43, 0x39, 0x16,
-		0xf0, 0x63, 0xd2, 0xa0, 0xc4, 0xea, 0x79, 0x57, 0x17, 0x77, 0x74, 0x49, 0x69, 0x4c, 0xbf, 0x4e,
-		0x7e, 0xa2, 0x97, 0x8a, 0x08, 0x0b, 0x7f, 0x7c, 0xd0, 0xd3, 0x1f, 0x7d, 0x83, 0xfb, 0x77, 0x0d,
-		0x96, 0xc5, 0x89, 0x2d, 0x6e, 0x00, 0x63, 0xdc, 0xf2, 0x5a, 0xbe, 0x43, 0x98, 0x15, 0x32, 0x46,
-		0x4f, 0xb3, 0x81, 0xbe, 0xad, 0x54, 0xda, 0x4f, 0xce, 0x5b, 0x08, 0xfa, 0x05, 0x18, 0xe7, 0xbc,
-		0x72, 0xf8, 0x9b, 0x34, 0xc6, 0xd8, 0x67, 0xdd, 0xaa, 0x5e, 0x87, 0x6b, 0x3d, 0xcc, 0x13, 0x11,
-		0xaf, 0xfe, 0x55, 0x83, 0xcb, 0x5b, 0x6c, 0x8c, 0x77, 0x9e, 0x46, 0x34, 0xa4, 0xd8, 0xb5, 0x6c,
-		0xf7, 0xe0, 0x99, 0xe7, 0x38, 0x03, 0xcd, 0x0e, 0xa9, 0xc7, 0x8c, 0x42, 0xe6, 0x31, 0xe3, 0x11,
-		0x94, 0xdb, 0x87, 0xea, 0x3c, 0x4e, 0x97, 0x73, 0xfa, 0x45, 0x7c, 0x32, 0xd1, 0x2f, 0x68, 0xe2,
-		0xeb, 0x2c, 0x03, 0x42, 0xf5, 0x2a, 0x2c, 0xe5, 0x1c, 0x4f, 0x3a, 0xe0, 0x27, 0x70, 0x61, 0x9b,
-		0x84, 0x8d, 0xc0, 0xde, 0x23, 0x6d, 0x76, 0x79, 0xf4, 0x9d, 0x6c, 0x0e, 0xa8, 0x13, 0x2f, 0x87,
-		0x7d, 0xb0, 0xd0, 0x57, 0xff, 0x54, 0x00, 0xbd, 0x5b, 0x82, 0xac, 0xc7, 0x8f, 0x61, 0x5c, 0xb8,
-		0x53, 0xfc, 0xa0, 0x58, 0x5a, 0xbf, 0x9a, 0xfb, 0x28, 0x45, 0x02, 0x0e, 0xf0, 0x31, 0x3d, 0xbb,
-		0x31, 0x75, 0xbc, 0x1f, 0x52, 0x4c, 0xa3, 0x50, 0xd6, 0xe2, 0xf5, 0x9e, 0xbe, 0x7b, 0xce, 0x49,
-		0x8d, 0x32, 0x4d, 0x7d, 0xbf, 0xb1, 0x6a, 0x3c, 0x53, 0x70, 0x43, 0x58, 0xe2, 0x49, 0x92, 0xd5,
-		0x15, 0xc6, 0x11, 0x5c, 0x84, 0x31, 0x09, 0x30, 0x22, 0x73, 0xe5, 0x57, 0x5a, 0x69, 0x61, 0x38,
-		0xa5, 0xbf, 0x28, 0xc0, 0x95, 0x3c, 0xad, 0x32, 0x6c, 0xaf, 0x61, 0xa9, 0xf3, 0x7e, 0xd5, 0x0e,
-		0x42, 0xe2, 0x27, 0x4e, 0x11, 0xcc, 0xda, 0x60, 0x9e, 0x7b, 0x42, 0x28, 0xb6, 0x30, 0xc5, 0x46,
-		0x25, 0x39, 0xbc, 0xa5, 0x55, 0x33, 0x95, 0xed, 0x9f, 0x17, 0x94, 0x2a, 0x0b, 0xa7, 0x53, 0x69,
-		0x25, 0x2e, 0x32, 0x69, 0x95, 0xd5, 0xdb, 0x70, 0xe9, 0x11, 0x69, 0xbb, 0x21, 0xdc, 0x3c, 0x11,
-		0xa8, 0xdd, 0xc7, 0xf7, 0xd5, 0x3f, 0x8e, 0xc2, 0x65, 0x35, 0x9f, 0xf4, 0xde, 0xcf, 0x34, 0x58,
-		0x54, 0x9c, 0xa5, 0x85, 0x7d, 0xe9, 0xb7, 0xa7, 0xf9, 0x08, 0xdf, 0x4b, 0x70, 0x6d, 0x3b, 0x73,
-		0x96, 0x27, 0xd8, 0x17, 0xa3, 0xe9, 0xbc, 0xd5, 0xbd, 0xc3, 0xcd, 0x50, 0x44, 0x91, 0x99, 0x51,
-		0x38, 0x93, 0x19, 0x1b, 0x99, 0x28, 0x76, 0xcc, 0xc0, 0xdd, 0x3b, 0x95, 0xaf, 0x58, 0x7b, 0x50,
-		0xdb, 0xad, 0x98, 0x94, 0x1f, 0xa7, 0x9f, 0xc8, 0x7b, 0x5c, 0x11, 0xf2, 0x7a, 0x4e, 0xf2, 0xa7,
-		0xeb, 0xaf, 0xd2, 0xc3, 0xf5, 0xdb, 0xd4, 0x5d, 0xfd, 0x5d, 0x01, 0xde, 0xfd, 0xdc, 0xb7, 0x30,
-		0x25, 0x79, 0xad, 0x64, 0x10, 0x80, 0x3a, 0x43, 0xa1, 0x9f, 0x1f, 0x7e, 0xa9, 0x7a, 0xe7, 0xe8,
-		0x79, 0x4c, 0x32, 0xef, 0xc1, 0x8d, 0x3e, 0x2e, 0x92, 0x20, 0xf7, 0xfb, 0x02, 0xdc, 0x30, 0xc8,
-		0x7e, 0x40, 0xc2, 0xe6, 0xff, 0xbc, 0x99, 0xe7, 0xcd, 0x15, 0xb8, 0xd9, 0xcf, 0x47, 0xc2, 0x9d,
-		0xeb, 0xff, 0x9a, 0x82, 0xd2, 0x13, 0x99, 0xcf, 0x1b, 0xcf, 0xea, 0xe8, 0xa7, 0x1a, 0xcc, 0x2b,
-		0x7e, 0x2a, 0x44, 0xb7, 0x86, 0xfc, 0x65, 0x91, 0x87, 0xa0, 0x72, 0xfb, 0x54, 0xbf, 0x47, 0x26,
-		0x8d, 0x48, 0x16, 0xed, 0x00, 0x46, 0x28, 0xae, 0xf0, 0x03, 0x18, 0xa1, 0xbc, 0x96, 0x1d, 0xc1,
-		0x4c, 0xe6, 0xf5, 0x0b, 0x7d, 0x38, 0xec, 0x63, 0x5d, 0x65, 0x6d, 0x08, 0x8e, 0x94, 0xde, 0xd4,
-		0xb9, 0x3f, 0x1c, 0xf6, 0xd9, 0xa2, 0x8f, 0x5e, 0xe5, 0x79, 0x7d, 0x98, 0x4e, 0xdd, 0xa4, 0x50,
-		0x2d, 0x5f, 0x86, 0xea, 0x52, 0x58, 0x59, 0x1d, 0x98, 0x5e, 0x6a, 0xfc, 0xb5, 0x06, 0x17, 0x73,
-		0xc7, 0x7a, 0x74, 0x2f, 0x5f, 0x5c, 0xbf, 0xab, 0x4a, 0xe5, 0xfe, 0xa9, 0x78, 0xa5, 0x59, 0xbf,
-		0xd4, 0xe0, 0x1d, 0xe5, 0xa0, 0x8d, 0xee, 0xe4, 0x8b, 0xed, 0x75, 0xf1, 0xa8, 0x7c, 0x7b, 0x68,
-		0x3e, 0x69, 0xca, 0x09, 0xcc, 0x66, 0x01, 0x06, 0xad, 0x0d, 0x03, 0x46, 0x42, 0xff, 0x29, 0xf0,
-		0x0b, 0xfd, 0x4a, 0x83, 0x45, 0xf5, 0x6c, 0x88, 0x7a, 0x1c, 0xa7, 0xe7, 0x0c, 0x5b, 0xb9, 0x3b,
-		0x3c, 0xa3, 0xb4, 0xe6, 0xe7, 0x1a, 0x2c, 0xa8, 0x26, 0x11, 0x74, 0x7b, 0xd8, 0xc9, 0x45, 0x58,
-		0x72, 0xe7, 0x74, 0x03, 0x0f, 0xfa, 0xad, 0x06, 0x4b, 0x3d, 0x71, 0x0a, 0x7d, 0x92, 0x2f, 0x79,
-		0x90, 0x19, 0xa0, 0xf2, 0xe0, 0xd4, 0xfc, 0xd2, 0xc4, 0x3f, 0x68, 0x70, 0xa5, 0x77, 0xf3, 0x47,
-		0x0f, 0x7a, 0x95, 0xc7, 0x00, 0xd0, 0x5a, 0xf9, 0xee, 0xe9, 0x05, 0x08, 0x2b, 0x37, 0xef, 0xff,
-		0xe0, 0xe3, 0x03, 0x9b, 0x36, 0xa3, 0xbd, 0x5a, 0xc3, 0x6b, 0xad, 0xa6, 0xfe, 0x63, 0xb5, 0x76,
-		0x40, 0x5c, 0xf1, 0x2f, 0xbe, 0xc9, 0xff, 0x32, 0xbe, 0x1f, 0xff, 0x7d, 0xb4, 0xb6, 0x37, 0xc6,
-		0x77, 0x3f, 0xfa, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7a, 0xe2, 0xa5, 0xb6, 0x93, 0x2c, 0x00,
-		0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0xcb, 0x6f, 0x24, 0x49,
+		0x5a, 0x27, 0xcb, 0x2e, 0x3f, 0xbe, 0xb2, 0xcb, 0xe5, 0xb0, 0xdb, 0x5d, 0xae, 0x7e, 0xb9, 0xb3,
+		0x5f, 0xde, 0xdd, 0xd9, 0xf2, 0xb4, 0xe7, 0x41, 0xcf, 0x0c, 0xd3, 0xbd, 0x7e, 0x75, 0xbb, 0x76,
+		0xed, 0xee, 0x9e, 0xb4, 0xbb, 0x5b, 0x2c, 0xab, 0x49, 0xc2, 0x95, 0x61, 0x3b, 0xd7, 0x59, 0x99,
+		0xd5, 0x19, 0x51, 0xf6, 0x78, 0x0f, 0x1c, 0x10, 0x8b, 0x40, 0x08, 0x4e, 0x2c, 0xe2, 0xc8, 0x4b,
+		0x42, 0x48, 0x5c, 0x38, 0x23, 0x71, 0x43, 0xe2, 0xce, 0x71, 0xc4, 0x61, 0x85, 0xf8, 0x03, 0x80,
+		0x2b, 0x07, 0x14, 0x8f, 0xcc, 0xca, 0xac, 0xcc, 0xac, 0x97, 0xdd, 0x33, 0x20, 0xed, 0xad, 0x32,
+		0xe2, 0xfb, 0xbe, 0xf8, 0xe2, 0x8b, 0xef, 0xf1, 0x8b, 0x87, 0x0d, 0xf7, 0x5b, 0x07, 0xc4, 0x5f,
+		0xa9, 0x63, 0x8b, 0xb8, 0x75, 0xb2, 0xd2, 0xc0, 0xac, 0x7e, 0x6c, 0xbb, 0x47, 0x2b, 0xa7, 0x0f,
+		0x57, 0x28, 0xf1, 0x4f, 0xed, 0x3a, 0xa9, 0x36, 0x7d, 0x8f, 0x79, 0xa8, 0xcc, 0xe9, 0xaa, 0x8a,
+		0xae, 0x1a, 0xd0, 0x55, 0x4f, 0x1f, 0x56, 0x6e, 0x1e, 0x79, 0xde, 0x91, 0x43, 0x56, 0x04, 0xdd,
+		0x41, 0xeb, 0x70, 0xc5, 0x6a, 0xf9, 0x98, 0xd9, 0x9e, 0x2b, 0x39, 0x2b, 0xb7, 0x3a, 0xfb, 0x99,
+		0xdd, 0x20, 0x94, 0xe1, 0x46, 0x53, 0x11, 0x24, 0x04, 0x9c, 0xf9, 0xb8, 0xd9, 0x24, 0x3e, 0x55,
+		0xfd, 0x4b, 0x31, 0x15, 0x71, 0xd3, 0xe6, 0xda, 0xd5, 0xbd, 0x46, 0xa3, 0x3d, 0x44, 0x1a, 0xc5,
+		0xdb, 0x16, 0xf1, 0xcf, 0x15, 0x81, 0x9e, 0x46, 0xc0, 0x30, 0x3d, 0x71, 0x6c, 0xca, 0x14, 0xcd,
+		0x72, 0x1a, 0x8d, 0x32, 0x82, 0x79, 0xe6, 0xf9, 0x27, 0xc4, 0x57, 0x94, 0xdf, 0xed, 0x45, 0x79,
+		0xe8, 0x78, 0x67, 0x8a, 0xf6, 0x76, 0x1a, 0xed, 0xb1, 0x4d, 0x99, 0x17, 0x2a, 0x77, 0x37, 0x46,
+		0x42, 0x8f, 0xb1, 0x4f, 0xac, 0x24, 0xd5, 0xbd, 0x0c, 0xaa, 0xf8, 0x2c, 0xf4, 0xc7, 0x30, 0xbb,
+		0x8f, 0xe9, 0xc9, 0x8e, 0x4d, 0xd9, 0x4b, 0xec, 0x33, 0x9b, 0x2f, 0x04, 0xfa, 0x0e, 0x94, 0x6c,
+		0xea, 0x39, 0x62, 0x55, 0xcc, 0x23, 0xdf, 0x6b, 0x35, 0x69, 0x59, 0x5b, 0x1a, 0x59, 0x9e, 0x34,
+		0x66, 0xc2, 0xf6, 0x67, 0xa2, 0x59, 0xff, 0xe5, 0x28, 0x5c, 0x4d, 0x08, 0xd8, 0xf0, 0xdc, 0x43,
+		0xfb, 0x08, 0x95, 0x61, 0xfc, 0x94, 0xf8, 0xd4, 0xf6, 0xdc, 0xb2, 0xb6, 0xa4, 0x2d, 0x8f, 0x18,
+		0xc1, 0x27, 0x5a, 0x85, 0x39, 0xb7, 0xd5, 0x30, 0x7d, 0x82, 0x2d, 0xb3, 0x19, 0x70, 0xd1, 0x72,
+		0x6e, 0x49, 0x5b, 0xce, 0xaf, 0xe7, 0xca, 0x9a, 0x31, 0xeb, 0xb6, 0x1a, 0x06, 0xc1, 0x56, 0x28,
+		0x92, 0xa2, 0x0f, 0x61, 0x9e, 0xf3, 0x9c, 0xf9, 0x36, 0x23, 0x51, 0xa6, 0x91, 0x90, 0x09, 0xb9,
+		0xad, 0xc6, 0x1b, 0xde, 0x1d, 0xe1, 0x72, 0x61, 0xa6, 0x73, 0x94, 0xd1, 0xa5, 0x91, 0xe5, 0xc2,
+		0xea, 0x56, 0x35, 0xcb, 0x43, 0xab, 0x19, 0xf3, 0xa9, 0xc6, 0x15, 0xda, 0x72, 0x99, 0x7f, 0x6e,
+		0x14, 0xfd, 0xb8, 0x96, 0x6f, 0xa1, 0x94, 0xd0, 0x30, 0x2f, 0x06, 0x7c, 0x3a, 0xf8, 0x80, 0x1d,
+		0x93, 0x91, 0x23, 0xce, 0x9c, 0xc5, 0x5b, 0x2b, 0x2e, 0xcc, 0xa5, 0x68, 0x86, 0x4a, 0x30, 0x72,
+		0x42, 0xce, 0x85, 0xe5, 0xf3, 0x06, 0xff, 0x89, 0xd6, 0x20, 0x7f, 0x8a, 0x9d, 0x16, 0x11, 0x76,
+		0x2e, 0xac, 0x7e, 0x6f, 0x00, 0x85, 0x0c, 0xc9, 0xf9, 0x69, 0xee, 0x91, 0x56, 0xf1, 0x60, 0x3e,
+		0x4d, 0xb1, 0x77, 0x36, 0xa0, 0xfe, 0xdb, 0x30, 0xbb, 0xe3, 0x61, 0x6b, 0x1d, 0x3b, 0xd8, 0xad,
+		0x13, 0x7f, 0xdb, 0x76, 0x19, 0x45, 0x77, 0x60, 0xfa, 0x00, 0xd7, 0x4f, 0x1c, 0xef, 0xc8, 0xac,
+		0x7b, 0x2d, 0x97, 0x29, 0x17, 0x9b, 0x52, 0x8d, 0x1b, 0xbc, 0x0d, 0xdd, 0x87, 0x19, 0x1f, 0xf3,
+		0xc5, 0x20, 0xbe, 0x49, 0x49, 0xdd, 0x73, 0x2d, 0xa1, 0x8a, 0x66, 0x4c, 0xf3, 0xe6, 0x97, 0xc4,
+		0xdf, 0x13, 0x8d, 0xfa, 0x1f, 0x6b, 0x50, 0x0e, 0x54, 0x78, 0x2d, 0x7d, 0xd4, 0x76, 0x8f, 0x7a,
+		0xba, 0xf1, 0x1b, 0x98, 0xa9, 0x7b, 0x8d, 0x26, 0x66, 0xf6, 0x81, 0x43, 0x4c, 0x4a, 0x18, 0x77,
+		0x61, 0xbe, 0xd6, 0xd5, 0xec, 0x99, 0x6e, 0x84, 0x0c, 0xeb, 0x2d, 0xdb, 0xb1, 0x6a, 0xd6, 0x1e,
+		0x61, 0x46, 0xb1, 0x2d, 0x66, 0x8f, 0x30, 0xaa, 0x7f, 0x00, 0xf3, 0x69, 0x74, 0xe8, 0x1a, 0x4c,
+		0x1e, 0xf0, 0x2f, 0xd3, 0xb6, 0x82, 0x88, 0x9c, 0x38, 0x90, 0xdd, 0x54, 0xc7, 0x50, 0x54, 0xa4,
+		0xbb, 0x84, 0xf9, 0x76, 0x9d, 0xa2, 0xf7, 0x00, 0xc5, 0x6c, 0x64, 0x1e, 0xdb, 0xa1, 0xa1, 0x4a,
+		0x51, 0x43, 0x71, 0x93, 0xa2, 0xdb, 0x30, 0xd5, 0xf4, 0x1c, 0x87, 0xf8, 0xca, 0xa0, 0x39, 0x41,
+		0x57, 0x90, 0x6d, 0x82, 0x4c, 0xff, 0x4f, 0x0d, 0x2a, 0x2f, 0x3d, 0xc7, 0x79, 0xea, 0xf9, 0x9b,
+		0xa4, 0x6e, 0x73, 0x23, 0x70, 0xb3, 0x19, 0xe4, 0x6d, 0x8b, 0x50, 0x86, 0x6a, 0x30, 0xee, 0xcb,
+		0x9f, 0x62, 0x90, 0xc2, 0xea, 0x4a, 0xdc, 0x0e, 0xb8, 0x69, 0x73, 0x13, 0x64, 0x4b, 0x30, 0x02,
+		0x7e, 0x3e, 0x53, 0xcb, 0x6b, 0x60, 0xdb, 0x35, 0x6d, 0xb9, 0x66, 0x93, 0xc6, 0x84, 0x6c, 0xa8,
+		0x59, 0xbc, 0x53, 0x69, 0x6a, 0x5b, 0x22, 0xfe, 0x27, 0x8d, 0x09, 0xd9, 0x50, 0xb3, 0xd0, 0x3d,
+		0x28, 0x1e, 0x7a, 0xfe, 0x19, 0xf6, 0x2d, 0x62, 0x99, 0x87, 0xbe, 0xd7, 0x28, 0x8f, 0x0a, 0x8a,
+		0xe9, 0xb0, 0xf5, 0xa9, 0xef, 0x35, 0xd0, 0x03, 0x98, 0xe9, 0xc8, 0x71, 0xe5, 0xbc, 0xa0, 0x2b,
+		0xc6, 0x53, 0x9c, 0xfe, 0x4f, 0x05, 0xb8, 0x96, 0xaa, 0x31, 0x6d, 0x7a, 0x2e, 0x25, 0xe8, 0x06,
+		0x00, 0xcf, 0xa9, 0x26, 0xf3, 0x4e, 0x88, 0xf4, 0x90, 0x29, 0x63, 0x92, 0xb7, 0xec, 0xf3, 0x06,
+		0xf4, 0x0a, 0x50, 0x90, 0xe2, 0x4d, 0xf2, 0x15, 0xa9, 0xb7, 0xb8, 0x64, 0x15, 0x10, 0xf7, 0x53,
+		0xcd, 0xf3, 0x46, 0x91, 0x6f, 0x05, 0xd4, 0xc6, 0xec, 0x59, 0x67, 0x13, 0x7a, 0x0a, 0xd3, 0xa1,
+		0x58, 0x76, 0xde, 0x24, 0xc2, 0x0c, 0x85, 0xd5, 0xdb, 0x5d, 0x25, 0xee, 0x9f, 0x37, 0x89, 0x31,
+		0x75, 0x16, 0xf9, 0x42, 0xaf, 0x61, 0xb1, 0xe9, 0x93, 0x53, 0xdb, 0x6b, 0x51, 0x93, 0x32, 0xec,
+		0x33, 0x62, 0x99, 0xe4, 0x94, 0xb8, 0x8c, 0x9b, 0x76, 0x54, 0xc8, 0xbc, 0x56, 0x95, 0x05, 0xb7,
+		0x1a, 0x14, 0xdc, 0x6a, 0xcd, 0x65, 0x1f, 0x7f, 0xf8, 0x9a, 0xc7, 0xa7, 0xb1, 0x10, 0x70, 0xef,
+		0x49, 0xe6, 0x2d, 0xce, 0x5b, 0xb3, 0xd0, 0x32, 0x94, 0x12, 0xe2, 0xf2, 0xc2, 0xa1, 0x8a, 0x34,
+		0x4e, 0x59, 0x86, 0x71, 0xcc, 0x18, 0x69, 0x34, 0x59, 0x79, 0x4c, 0xa4, 0x8e, 0xe0, 0x13, 0xe9,
+		0x30, 0xed, 0x92, 0xaf, 0x58, 0x5b, 0xc0, 0xb8, 0xf4, 0x48, 0xde, 0x18, 0x70, 0xa7, 0xbb, 0xf8,
+		0x44, 0x86, 0x8b, 0x3f, 0x82, 0x32, 0x65, 0x76, 0xfd, 0xe4, 0xbc, 0xbd, 0x14, 0x26, 0x71, 0xf1,
+		0x81, 0x43, 0xac, 0xf2, 0xe4, 0x92, 0xb6, 0x3c, 0x61, 0x2c, 0xc8, 0xfe, 0xd0, 0xd0, 0x5b, 0xb2,
+		0x17, 0x3d, 0x82, 0xbc, 0x00, 0x08, 0x65, 0x10, 0x36, 0xd1, 0xbb, 0xda, 0xf9, 0x0b, 0x4e, 0x69,
+		0x48, 0x06, 0x64, 0xc0, 0xb4, 0xa5, 0xfc, 0xc6, 0xb4, 0xdd, 0x43, 0xaf, 0x5c, 0x10, 0x12, 0xbe,
+		0x1f, 0x97, 0x20, 0x0b, 0xb4, 0x48, 0x85, 0x3e, 0x76, 0xa9, 0x4d, 0x5c, 0x16, 0x78, 0x5b, 0xcd,
+		0x3d, 0xf4, 0x8c, 0x29, 0x2b, 0xf2, 0x85, 0xbe, 0x84, 0xeb, 0x49, 0xa7, 0x32, 0x85, 0x1b, 0xf2,
+		0xda, 0x5e, 0x9e, 0x12, 0x43, 0xdc, 0x48, 0x55, 0x32, 0xc8, 0x73, 0xc6, 0x62, 0xc2, 0xab, 0x82,
+		0x2e, 0x54, 0x85, 0x39, 0x69, 0x74, 0x8e, 0x28, 0x88, 0x19, 0xa4, 0xbf, 0x69, 0xb1, 0x3e, 0xb3,
+		0xa2, 0x6b, 0x8f, 0xf7, 0xa8, 0x5c, 0xc9, 0x53, 0xc7, 0x81, 0x8f, 0xdd, 0xfa, 0xb1, 0x8a, 0x82,
+		0xa2, 0x88, 0x82, 0x82, 0x6c, 0x93, 0x71, 0xb0, 0x06, 0x45, 0x5a, 0x3f, 0x26, 0x56, 0xcb, 0x21,
+		0x96, 0xc9, 0x21, 0x5d, 0x79, 0x46, 0x28, 0x59, 0x49, 0x78, 0xd7, 0x7e, 0x80, 0xf7, 0x8c, 0xe9,
+		0x90, 0x83, 0xb7, 0xa1, 0xcf, 0x61, 0x2a, 0xf0, 0x29, 0x21, 0xa0, 0xd4, 0x53, 0x40, 0x41, 0xd1,
+		0x0b, 0xf6, 0x9f, 0xc0, 0x38, 0x5f, 0x11, 0x9b, 0xd0, 0xf2, 0xac, 0xc8, 0xd2, 0xeb, 0xd9, 0x59,
+		0xba, 0x4b, 0xc0, 0x57, 0xbf, 0x90, 0x42, 0x64, 0x35, 0x0e, 0x44, 0x72, 0x93, 0x31, 0x8f, 0x61,
+		0xc7, 0x54, 0x30, 0xcc, 0x3c, 0x38, 0x67, 0x84, 0x96, 0x91, 0xf0, 0xc4, 0x59, 0xd1, 0xb5, 0x2d,
+		0x7b, 0xd6, 0x79, 0x07, 0xfa, 0x09, 0x94, 0x42, 0x88, 0x60, 0xd6, 0x45, 0xa5, 0x29, 0xcf, 0x89,
+		0x09, 0x3d, 0x1c, 0x18, 0x28, 0x18, 0x33, 0xcd, 0x0e, 0xe8, 0xf5, 0x5b, 0x30, 0xe7, 0x78, 0xd8,
+		0x32, 0x0f, 0x54, 0xcd, 0x14, 0x61, 0x41, 0xcb, 0xf3, 0xbd, 0xea, 0x70, 0xa2, 0xce, 0x1a, 0xb3,
+		0x4e, 0xa2, 0xf4, 0xee, 0x42, 0x09, 0xb7, 0x98, 0xa7, 0xb4, 0x96, 0x11, 0x77, 0x45, 0x48, 0xbe,
+		0x93, 0xea, 0x71, 0x6b, 0x2d, 0xe6, 0x49, 0xbd, 0x38, 0xbf, 0x51, 0xc4, 0xb1, 0xef, 0xca, 0x97,
+		0x30, 0x15, 0x35, 0x69, 0x14, 0x47, 0x4c, 0x4a, 0x1c, 0xf1, 0x28, 0x8e, 0x23, 0xfa, 0x0a, 0xbe,
+		0x36, 0x7c, 0x88, 0x14, 0xad, 0xb5, 0x3a, 0xb3, 0x4f, 0x6d, 0x76, 0x3e, 0x7c, 0xd1, 0x4a, 0x91,
+		0xf0, 0x7f, 0xb1, 0x68, 0xfd, 0x02, 0xc2, 0xa2, 0x15, 0xd7, 0xf8, 0x5b, 0x2d, 0x5a, 0xb7, 0xa0,
+		0x80, 0x95, 0x36, 0x6d, 0x23, 0x40, 0xd0, 0x54, 0xb3, 0x78, 0x55, 0x0b, 0x09, 0x44, 0x55, 0x1b,
+		0xed, 0x52, 0xd5, 0xc2, 0x89, 0x89, 0xaa, 0x86, 0x23, 0x5f, 0x68, 0x15, 0xf2, 0xb6, 0xdb, 0x6c,
+		0x31, 0x61, 0x9d, 0xc2, 0xea, 0xf5, 0xf4, 0x15, 0xc5, 0xe7, 0xdc, 0xb7, 0x0d, 0x49, 0x9a, 0x92,
+		0xa0, 0xc6, 0x2e, 0x9a, 0xa0, 0xc6, 0x07, 0x4b, 0x50, 0xfb, 0xb0, 0x18, 0xc8, 0x33, 0x79, 0x78,
+		0x39, 0x1e, 0x25, 0x42, 0x90, 0xd7, 0x92, 0x25, 0xad, 0xb0, 0xba, 0x98, 0x90, 0xb5, 0xa9, 0x76,
+		0xcf, 0xc6, 0x42, 0xc0, 0xbb, 0xef, 0x6d, 0x70, 0xce, 0x7d, 0xc9, 0x88, 0x9e, 0xc3, 0x82, 0x18,
+		0x24, 0x29, 0x72, 0xb2, 0x97, 0xc8, 0x39, 0xc1, 0xd8, 0x21, 0xef, 0x29, 0xcc, 0x1e, 0x13, 0xec,
+		0xb3, 0x03, 0x82, 0x59, 0x28, 0x0a, 0x7a, 0x89, 0x2a, 0x85, 0x3c, 0x81, 0x9c, 0x48, 0xdd, 0x2f,
+		0xc4, 0xeb, 0xfe, 0x97, 0x70, 0x33, 0xbe, 0x12, 0xa6, 0x77, 0x68, 0xb2, 0x63, 0x9b, 0x9a, 0x01,
+		0xc3, 0x54, 0x4f, 0xc3, 0x56, 0x62, 0x2b, 0xf3, 0xe2, 0x70, 0xff, 0xd8, 0xa6, 0x6b, 0x4a, 0x7e,
+		0x2d, 0x3a, 0x03, 0x8b, 0x30, 0x6c, 0x3b, 0x54, 0xd4, 0xb6, 0x5e, 0x9e, 0xd2, 0x9e, 0xc4, 0xa6,
+		0xe4, 0x4a, 0xc2, 0xb0, 0xe2, 0x70, 0x30, 0xec, 0x01, 0xcc, 0x84, 0x72, 0x64, 0xc6, 0x10, 0xe5,
+		0x71, 0xd2, 0x28, 0x06, 0xcd, 0x9b, 0xa2, 0x15, 0x7d, 0x00, 0x63, 0xc7, 0x04, 0x5b, 0xc4, 0x57,
+		0xd5, 0xef, 0x5a, 0xea, 0x48, 0xdb, 0x82, 0xc4, 0x50, 0xa4, 0x59, 0xd5, 0x60, 0xf6, 0x52, 0xaa,
+		0xc1, 0xbb, 0x2d, 0x64, 0x69, 0xb5, 0x66, 0x7e, 0xe8, 0x5a, 0xa3, 0xff, 0xf7, 0x28, 0x2c, 0xac,
+		0x59, 0x56, 0xda, 0xe6, 0x25, 0x96, 0xbc, 0xb5, 0x8e, 0xe4, 0xfd, 0x8e, 0x12, 0xe2, 0xa7, 0x30,
+		0xd9, 0x06, 0x6d, 0x23, 0xfd, 0x80, 0xb6, 0x09, 0x16, 0x60, 0xb4, 0x5b, 0x50, 0x08, 0xb3, 0x85,
+		0xc2, 0xea, 0x23, 0x06, 0x04, 0x4d, 0x35, 0xab, 0x33, 0x9d, 0xa8, 0x24, 0xa0, 0x02, 0x36, 0x3f,
+		0x40, 0x3a, 0x11, 0xd0, 0x3e, 0x08, 0xdb, 0x4f, 0x61, 0x8c, 0x7a, 0x2d, 0xbf, 0x2e, 0xd3, 0x63,
+		0xb1, 0xb3, 0x18, 0x47, 0x70, 0x2c, 0xa6, 0x27, 0x7b, 0x82, 0xd2, 0x50, 0x1c, 0x29, 0x55, 0x6e,
+		0x3c, 0xad, 0xca, 0x35, 0x53, 0x3c, 0x6a, 0xa2, 0xd7, 0xa1, 0x4d, 0xfa, 0xaa, 0x56, 0x3b, 0x1c,
+		0x4c, 0x1d, 0xa1, 0x74, 0x7a, 0xd9, 0x22, 0x4c, 0x04, 0xfb, 0x6a, 0x91, 0x15, 0x27, 0x8d, 0x71,
+		0xb5, 0xad, 0xae, 0xac, 0xc3, 0x7c, 0x9a, 0x8c, 0x14, 0x94, 0x32, 0x1f, 0x45, 0x29, 0x93, 0x51,
+		0x04, 0x72, 0x06, 0x57, 0x13, 0xea, 0xa9, 0x42, 0x9c, 0x16, 0x3d, 0xda, 0x65, 0x45, 0x8f, 0xfe,
+		0x5f, 0x79, 0xe1, 0xee, 0x69, 0xb0, 0xe7, 0xdb, 0x70, 0x77, 0xbe, 0x29, 0x14, 0x9e, 0x60, 0xb6,
+		0x87, 0x96, 0x20, 0xa0, 0x28, 0xdb, 0x37, 0x03, 0x05, 0x62, 0x81, 0x31, 0x7a, 0xa1, 0xc0, 0xc8,
+		0x0f, 0x16, 0x18, 0x63, 0x17, 0x0f, 0x8c, 0xf1, 0x4b, 0x08, 0x8c, 0x89, 0xb4, 0xc0, 0x70, 0xa1,
+		0x8c, 0x23, 0x4b, 0xb9, 0x69, 0xd3, 0x26, 0xf7, 0x0a, 0xbe, 0x25, 0x54, 0xc5, 0x7c, 0xb5, 0x4b,
+		0x80, 0x64, 0x70, 0x1a, 0x99, 0x32, 0x53, 0x03, 0x11, 0xfa, 0x08, 0xc4, 0x14, 0x7f, 0xeb, 0x2f,
+		0x10, 0x2f, 0x25, 0xda, 0xbe, 0x1e, 0x81, 0x72, 0xd6, 0x64, 0xd1, 0x0f, 0x61, 0xa6, 0x8d, 0x2d,
+		0xc4, 0x46, 0x56, 0x85, 0x5b, 0x7a, 0xc9, 0x56, 0x5b, 0x36, 0x71, 0xda, 0x60, 0xb4, 0xf1, 0xa1,
+		0xf8, 0x4e, 0xc0, 0xbd, 0xdc, 0x60, 0x70, 0x2f, 0x02, 0x80, 0x46, 0x06, 0x05, 0x40, 0xa3, 0x97,
+		0x0f, 0x80, 0xf2, 0x97, 0x03, 0x80, 0xc6, 0x2e, 0x0d, 0x00, 0x8d, 0xa7, 0x01, 0x20, 0x95, 0x4b,
+		0x53, 0x37, 0x35, 0xef, 0x36, 0x97, 0x7e, 0xad, 0xc1, 0xbc, 0xd8, 0x5b, 0x06, 0xb3, 0x08, 0x32,
+		0xe9, 0x46, 0xe7, 0x06, 0xf2, 0x3b, 0xa9, 0x93, 0x4f, 0xe3, 0xed, 0x73, 0xeb, 0x78, 0x11, 0x98,
+		0xd0, 0xdf, 0xce, 0x52, 0xff, 0x1f, 0x0d, 0xae, 0x74, 0x68, 0xa8, 0xac, 0xfa, 0x04, 0xa6, 0xc4,
+		0x41, 0x96, 0xe9, 0x13, 0xda, 0x72, 0x82, 0x39, 0x76, 0xf7, 0x93, 0x82, 0xe0, 0x30, 0x04, 0x03,
+		0xaa, 0x41, 0x31, 0x10, 0xf0, 0x53, 0x52, 0x67, 0xc4, 0xea, 0xba, 0x8d, 0x97, 0xdb, 0x77, 0x45,
+		0x69, 0x4c, 0xbf, 0x8d, 0x7e, 0xa2, 0x37, 0x29, 0x2b, 0x2c, 0xed, 0xf1, 0x5e, 0x57, 0x7b, 0xf4,
+		0x5c, 0xdc, 0xff, 0xd0, 0x60, 0x49, 0xce, 0xd8, 0x12, 0x0a, 0x70, 0xc6, 0x0d, 0xaf, 0xd1, 0x74,
+		0x08, 0xd7, 0x42, 0xad, 0xd1, 0x8b, 0xce, 0x85, 0xfe, 0x28, 0x75, 0xd0, 0x5e, 0x72, 0xbe, 0x81,
+		0x45, 0xbf, 0x0a, 0xe3, 0x82, 0x57, 0xe1, 0xc2, 0x49, 0x63, 0x8c, 0x7f, 0xd6, 0x2c, 0xfd, 0x0e,
+		0xdc, 0xee, 0xa2, 0x9e, 0x5c, 0x71, 0xfd, 0xdf, 0x34, 0xb8, 0xbe, 0xc1, 0x11, 0xbe, 0xf3, 0xa2,
+		0xc5, 0x28, 0xc3, 0xae, 0x65, 0xbb, 0x47, 0x2f, 0x3d, 0xc7, 0xe9, 0x0b, 0x3b, 0xc4, 0xce, 0x39,
+		0x72, 0x1d, 0xe7, 0x1c, 0xcf, 0xa0, 0x18, 0x4e, 0xaa, 0x7d, 0x6e, 0x5d, 0xcc, 0xc8, 0x17, 0xc1,
+		0xcc, 0x64, 0xbe, 0x60, 0x91, 0xaf, 0x8b, 0x00, 0x04, 0xfd, 0x16, 0xdc, 0xc8, 0x98, 0x9e, 0x32,
+		0xc0, 0xef, 0xc0, 0xd5, 0x4d, 0x42, 0xeb, 0xbe, 0x7d, 0x40, 0x42, 0x76, 0x35, 0xf5, 0xa7, 0x9d,
+		0x3e, 0x90, 0xee, 0x78, 0x19, 0xec, 0xfd, 0x2d, 0xbd, 0xfe, 0xb7, 0x63, 0x50, 0x4e, 0x4a, 0x50,
+		0xf1, 0xf8, 0x09, 0x8c, 0x4b, 0x73, 0xca, 0x1b, 0xa0, 0xc2, 0xea, 0xad, 0xcc, 0xf3, 0x2a, 0xe2,
+		0x8b, 0x02, 0x1f, 0xd0, 0xf3, 0xcd, 0x54, 0xdb, 0xfa, 0x94, 0x61, 0xd6, 0xa2, 0x2a, 0x16, 0xef,
+		0x74, 0xb5, 0xdd, 0x9e, 0x20, 0x35, 0x8a, 0x2c, 0xf6, 0xfd, 0xce, 0xa2, 0xf1, 0x42, 0xe8, 0xcf,
+		0x84, 0xd9, 0xd3, 0xf0, 0x06, 0x2f, 0xd0, 0x2a, 0xdf, 0x0b, 0x1c, 0x65, 0x5d, 0xfe, 0x19, 0xa5,
+		0xd3, 0xce, 0xeb, 0xc0, 0x26, 0x94, 0x82, 0xbd, 0x82, 0xd9, 0x90, 0x17, 0x6d, 0xe5, 0xb1, 0x5e,
+		0x37, 0xbc, 0x59, 0xab, 0x59, 0x8d, 0xdf, 0xd8, 0xa9, 0x3b, 0xe5, 0x83, 0xf8, 0x35, 0xde, 0xe7,
+		0x70, 0xcd, 0x52, 0x18, 0x86, 0xe3, 0x01, 0x4c, 0x4f, 0x68, 0xf4, 0x46, 0x73, 0x5c, 0xdc, 0x68,
+		0x96, 0xdb, 0x24, 0x7c, 0x10, 0x1a, 0x5e, 0x6e, 0x0a, 0xd4, 0x7d, 0xee, 0xd6, 0x4d, 0xa1, 0x8f,
+		0x29, 0x50, 0xac, 0x80, 0x97, 0x9a, 0x51, 0xe4, 0xed, 0xbb, 0xbc, 0xd9, 0xe0, 0xad, 0x68, 0x03,
+		0x6e, 0x25, 0x81, 0xb1, 0x83, 0x19, 0x71, 0xeb, 0xe7, 0xa6, 0xed, 0x9a, 0x0d, 0x2a, 0x60, 0xe6,
+		0x48, 0x1b, 0x5c, 0x28, 0x0c, 0xbc, 0x23, 0x69, 0x6a, 0xee, 0x2e, 0xad, 0x9c, 0xc0, 0x5c, 0xca,
+		0xa4, 0x52, 0x10, 0xdc, 0xe3, 0xf8, 0xa9, 0xee, 0x72, 0xb6, 0xf5, 0xe2, 0xf2, 0xa2, 0x58, 0x8f,
+		0xc2, 0x0d, 0x91, 0x12, 0x3a, 0x3d, 0x8b, 0x06, 0xf1, 0xba, 0x00, 0x63, 0x0a, 0x4e, 0xc8, 0x91,
+		0xd5, 0x57, 0xdc, 0xc5, 0x72, 0x83, 0xe5, 0x8f, 0xdf, 0xcf, 0xc1, 0xcd, 0xac, 0x51, 0x55, 0x90,
+		0xbe, 0x85, 0x1b, 0xed, 0x83, 0xcc, 0x30, 0xe4, 0x22, 0x6f, 0x02, 0xb4, 0xb4, 0x7b, 0xe2, 0xac,
+		0x38, 0xd9, 0x25, 0x0c, 0x5b, 0x98, 0x61, 0xa3, 0x12, 0x85, 0xea, 0xf1, 0xa1, 0xf9, 0x90, 0xe1,
+		0x3d, 0x53, 0xea, 0x90, 0xb9, 0xe1, 0x86, 0xb4, 0x22, 0xdb, 0xd6, 0xf8, 0x90, 0xfa, 0x47, 0x70,
+		0xed, 0x19, 0x09, 0xcd, 0x40, 0xd7, 0xcf, 0x25, 0x46, 0xeb, 0x61, 0x7b, 0xfd, 0x6f, 0x46, 0xe1,
+		0x7a, 0x3a, 0x9f, 0xb2, 0xde, 0xef, 0x69, 0xb0, 0x90, 0x32, 0x97, 0x06, 0x6e, 0x2a, 0xbb, 0xbd,
+		0xc8, 0xf6, 0x95, 0x6e, 0x82, 0xab, 0x9b, 0x1d, 0x73, 0xd9, 0xc5, 0x4d, 0x19, 0x72, 0x73, 0x56,
+		0xb2, 0x47, 0xa8, 0x91, 0xb2, 0x8a, 0x5c, 0x8d, 0xdc, 0x85, 0xd4, 0x58, 0xeb, 0x58, 0xc5, 0xb6,
+		0x1a, 0x38, 0xd9, 0x53, 0xf9, 0x19, 0x2f, 0x06, 0xe9, 0x7a, 0xa7, 0x44, 0xd5, 0x76, 0x3c, 0xaa,
+		0x56, 0x07, 0xcf, 0x49, 0xd1, 0xb7, 0x1e, 0x3f, 0x8b, 0x6f, 0xa5, 0xbe, 0xc9, 0xb1, 0xf5, 0xbf,
+		0xcc, 0xc1, 0xdd, 0x57, 0x4d, 0x0b, 0x33, 0x92, 0x55, 0x38, 0xfa, 0x81, 0x23, 0x17, 0x08, 0xf4,
+		0xcb, 0x43, 0x2b, 0x69, 0x95, 0x72, 0xf4, 0x32, 0x70, 0xeb, 0x03, 0xb8, 0xd7, 0xc3, 0x44, 0x0a,
+		0xd2, 0xfc, 0x55, 0x0e, 0xee, 0x19, 0xe4, 0xd0, 0x27, 0xf4, 0xf8, 0x57, 0xd6, 0xcc, 0xb2, 0xe6,
+		0x32, 0xdc, 0xef, 0x65, 0x23, 0x65, 0xce, 0x5f, 0xe6, 0x3a, 0x0d, 0x9f, 0x40, 0x0e, 0xfd, 0x98,
+		0xb3, 0x9d, 0x21, 0x73, 0xd9, 0xd5, 0x69, 0x40, 0xec, 0xff, 0x31, 0x94, 0xb1, 0x65, 0x99, 0x2e,
+		0x39, 0x33, 0x2d, 0x72, 0x88, 0x5b, 0x0e, 0x33, 0xc3, 0xb3, 0x4d, 0xb9, 0x19, 0x98, 0xc7, 0x96,
+		0xf5, 0x9c, 0x9c, 0x6d, 0xca, 0x5e, 0x55, 0x5c, 0x11, 0x81, 0xab, 0x9c, 0x2f, 0xf2, 0xa0, 0x29,
+		0x64, 0x93, 0xf0, 0xa9, 0xda, 0xf5, 0xcc, 0x27, 0xf1, 0x5e, 0x49, 0x0c, 0x93, 0x68, 0xe5, 0x68,
+		0xa4, 0xe9, 0x7b, 0x0d, 0x8f, 0x45, 0xe4, 0x8f, 0xc9, 0x9d, 0xbe, 0x6a, 0x57, 0x94, 0xfa, 0x2f,
+		0x34, 0x98, 0x4f, 0x13, 0x1c, 0x3b, 0xad, 0xd5, 0x62, 0xa7, 0xb5, 0xe8, 0x09, 0x5c, 0x27, 0x5f,
+		0xd9, 0x94, 0x49, 0xec, 0x97, 0x9c, 0x89, 0x34, 0xf3, 0x62, 0x40, 0x93, 0x94, 0x7d, 0x1b, 0xa6,
+		0x1a, 0xf8, 0x84, 0x04, 0xa6, 0x13, 0xc6, 0x9f, 0x30, 0x0a, 0xbc, 0x4d, 0xd9, 0x4b, 0xff, 0x43,
+		0x0d, 0xee, 0xf7, 0x5a, 0x7b, 0x55, 0xc8, 0x52, 0xc1, 0xa8, 0x76, 0x79, 0x60, 0x54, 0xff, 0x77,
+		0x0d, 0x1e, 0x44, 0x4a, 0xcd, 0x5e, 0x1d, 0x3b, 0xb6, 0x7b, 0x64, 0x90, 0xba, 0xd7, 0x68, 0x10,
+		0xd7, 0x92, 0x27, 0x96, 0xdf, 0x96, 0x27, 0x26, 0x03, 0x7e, 0x74, 0xa8, 0x80, 0xd7, 0x7f, 0x9e,
+		0x83, 0xe5, 0xde, 0xb3, 0x54, 0x36, 0x7f, 0x04, 0x65, 0x3f, 0xe8, 0x21, 0x96, 0x19, 0x7b, 0xd2,
+		0x26, 0x9f, 0xbe, 0x2d, 0x44, 0xfa, 0x5f, 0xb6, 0x5f, 0xb7, 0xa1, 0x3d, 0x00, 0xb1, 0x44, 0x42,
+		0x2d, 0x61, 0x87, 0xe2, 0xea, 0x07, 0xbd, 0x97, 0x49, 0xa9, 0xb3, 0x11, 0xb2, 0x1a, 0x11, 0x31,
+		0xe8, 0x87, 0x30, 0x4e, 0xed, 0x23, 0x17, 0x3b, 0x54, 0x99, 0xef, 0xfd, 0xbe, 0x25, 0xee, 0x49,
+		0x3e, 0x23, 0x10, 0xa0, 0xff, 0x4b, 0x0e, 0x16, 0xd2, 0x69, 0x06, 0x7c, 0xea, 0xb7, 0x02, 0x3c,
+		0x38, 0x93, 0x5b, 0x09, 0xf9, 0x38, 0x72, 0x16, 0x5b, 0x9d, 0x7b, 0x88, 0x1e, 0x5b, 0x90, 0x91,
+		0x21, 0xb6, 0x20, 0xa3, 0xc3, 0x6e, 0x41, 0xf2, 0xbd, 0xb6, 0x20, 0x89, 0x97, 0x8c, 0x63, 0xc9,
+		0x97, 0x8c, 0x35, 0x40, 0xdc, 0x8a, 0x6f, 0xc4, 0x3b, 0x6d, 0x7a, 0x91, 0x10, 0xd1, 0x5f, 0xc1,
+		0x5c, 0x4c, 0x94, 0xf2, 0xc3, 0xc7, 0x30, 0x2e, 0x5f, 0x81, 0x07, 0x60, 0xff, 0x6e, 0xf6, 0xc2,
+		0x4b, 0x5e, 0xb9, 0x59, 0x57, 0x4c, 0xfa, 0x31, 0x5c, 0x09, 0x50, 0x92, 0xec, 0xbe, 0x50, 0x1c,
+		0x57, 0x60, 0x82, 0x3b, 0x24, 0xb3, 0xd9, 0x79, 0xf0, 0xf8, 0x24, 0xf8, 0xd6, 0x5f, 0xc3, 0x42,
+		0xe7, 0x48, 0x6a, 0x0e, 0xbf, 0x01, 0x63, 0x52, 0x1d, 0x95, 0xb4, 0xfa, 0x9b, 0x82, 0xe2, 0xd1,
+		0xff, 0x3e, 0x07, 0xd0, 0x6e, 0x8e, 0xa9, 0xa0, 0xc5, 0x55, 0x40, 0x35, 0x98, 0x52, 0xb9, 0x4d,
+		0xbe, 0x91, 0xeb, 0x75, 0xd5, 0x44, 0x7c, 0x95, 0x1c, 0xc5, 0x80, 0x85, 0xd3, 0xf6, 0x07, 0xfa,
+		0x91, 0x7a, 0xda, 0xc2, 0xb3, 0x0e, 0x8f, 0xb9, 0x91, 0x24, 0x2e, 0x48, 0xea, 0x1d, 0x44, 0x95,
+		0x10, 0x37, 0x19, 0x24, 0x1f, 0x8a, 0x7e, 0x00, 0x45, 0x07, 0xf3, 0x6d, 0x94, 0xe7, 0x38, 0xf2,
+		0x90, 0xbf, 0xf7, 0xc9, 0xfb, 0x14, 0xe7, 0xe0, 0x79, 0x45, 0x9c, 0xf2, 0xf3, 0x7a, 0xe7, 0x39,
+		0x4e, 0x2c, 0x5c, 0xf2, 0xd2, 0xf5, 0x45, 0x7b, 0xfb, 0x11, 0xf2, 0xd7, 0x1a, 0xa0, 0xa4, 0x36,
+		0xf1, 0x0c, 0xac, 0x5d, 0x34, 0x03, 0xe7, 0x86, 0x83, 0x5c, 0x49, 0x3b, 0x8c, 0x0c, 0x66, 0x07,
+		0xfd, 0x9f, 0x73, 0xb0, 0xb0, 0x6b, 0x1f, 0xf9, 0x91, 0xb2, 0xf9, 0xff, 0xbe, 0x30, 0xa1, 0x67,
+		0x50, 0x62, 0xd8, 0x3f, 0x22, 0x2c, 0xf2, 0xf6, 0x32, 0xdf, 0x8f, 0x2e, 0x45, 0xc9, 0x16, 0x3e,
+		0xb8, 0x9c, 0x87, 0x3c, 0x76, 0x6c, 0x4c, 0x45, 0xaa, 0x9a, 0x30, 0xe4, 0x07, 0x42, 0x30, 0x4a,
+		0x99, 0xd7, 0x14, 0x27, 0x3c, 0x13, 0x86, 0xf8, 0xad, 0xff, 0xab, 0x06, 0x57, 0x13, 0x76, 0x6c,
+		0x5f, 0x80, 0x34, 0x44, 0xd7, 0x30, 0x17, 0x20, 0xbb, 0x01, 0x67, 0x80, 0x8e, 0x1b, 0xf1, 0x06,
+		0xf4, 0x63, 0x80, 0xc4, 0x69, 0xc2, 0xa7, 0x03, 0xc8, 0x0d, 0xb1, 0xb4, 0x3a, 0x4e, 0x8c, 0x48,
+		0xd3, 0x7f, 0xb3, 0xfd, 0x57, 0x24, 0x1d, 0x7a, 0xf0, 0x00, 0x4a, 0xd8, 0x58, 0x3a, 0x49, 0xa6,
+		0x11, 0x73, 0x11, 0x23, 0xea, 0x7f, 0xa6, 0xc1, 0x52, 0x2f, 0x5d, 0xd0, 0x75, 0x98, 0x0c, 0xb5,
+		0x51, 0xd2, 0xdb, 0x0d, 0xe8, 0x3e, 0xcc, 0x78, 0x67, 0x2e, 0xf1, 0xcd, 0x63, 0x8f, 0x32, 0xd3,
+		0xc5, 0x8d, 0xe0, 0xd6, 0x71, 0x5a, 0x34, 0x6f, 0x7b, 0x94, 0x3d, 0xc7, 0x0d, 0x92, 0x51, 0x84,
+		0x47, 0xd2, 0x8b, 0xb0, 0xfe, 0x39, 0xdc, 0x0c, 0xd2, 0xae, 0x3c, 0x1f, 0xe0, 0x5a, 0x7e, 0xd1,
+		0xf2, 0x18, 0xee, 0x27, 0x30, 0xf4, 0x13, 0xb8, 0x95, 0xc9, 0xae, 0xfc, 0x61, 0x5b, 0xa5, 0xc2,
+		0xb7, 0xbc, 0x35, 0xfd, 0x72, 0x2a, 0xb6, 0x29, 0xef, 0x10, 0x23, 0x02, 0x4c, 0xfc, 0xd4, 0xff,
+		0x3c, 0x07, 0x33, 0x1d, 0xdd, 0xe8, 0x7b, 0x80, 0x02, 0x10, 0x61, 0xfa, 0x4d, 0x6a, 0x3a, 0x76,
+		0xc3, 0x96, 0x4b, 0xa3, 0x19, 0x33, 0x0a, 0x42, 0x18, 0x4d, 0xba, 0xc3, 0x9b, 0xd1, 0x12, 0x4c,
+		0x45, 0x89, 0x15, 0xd2, 0x80, 0x36, 0x19, 0x7a, 0x08, 0x57, 0xda, 0x14, 0xea, 0xc2, 0x47, 0x90,
+		0x4a, 0x70, 0x81, 0x02, 0xd2, 0xe0, 0x6a, 0xa8, 0x29, 0x40, 0x4f, 0x00, 0x39, 0x22, 0x1a, 0x48,
+		0x60, 0x51, 0x0a, 0x7a, 0x42, 0x15, 0x6e, 0xc3, 0x54, 0x94, 0x5a, 0x65, 0xe1, 0x42, 0x84, 0x0e,
+		0xad, 0xc2, 0x95, 0x36, 0x49, 0x54, 0x87, 0x31, 0x41, 0x3b, 0x17, 0xd2, 0xb6, 0x95, 0xf8, 0xee,
+		0x3f, 0x6a, 0xb0, 0x98, 0x09, 0x05, 0xd1, 0x32, 0xdc, 0xdd, 0x5f, 0xdb, 0xfb, 0x91, 0xb9, 0x53,
+		0xdb, 0xdb, 0x37, 0xf7, 0x36, 0xd6, 0x76, 0x6a, 0xcf, 0x9f, 0x99, 0x1b, 0x2f, 0x9e, 0x3f, 0xad,
+		0x6d, 0x6e, 0x3d, 0xdf, 0xd8, 0x32, 0x6b, 0xcf, 0x5f, 0xaf, 0xed, 0xd4, 0x36, 0x4b, 0xbf, 0x86,
+		0xee, 0xc2, 0x52, 0x57, 0xca, 0x9d, 0x17, 0x6f, 0x4a, 0x1a, 0x7a, 0x00, 0x77, 0xba, 0x52, 0xed,
+		0x6e, 0x6d, 0xd6, 0x5e, 0xed, 0x96, 0x72, 0xe8, 0x1e, 0xdc, 0xee, 0x4a, 0xb8, 0x5d, 0x7b, 0xb6,
+		0x5d, 0x1a, 0x59, 0xfd, 0x87, 0x39, 0x28, 0xec, 0x2a, 0x1f, 0x58, 0x7b, 0x59, 0x43, 0xbf, 0xab,
+		0xc1, 0x5c, 0xca, 0xe3, 0x67, 0xf4, 0xe1, 0x80, 0x6f, 0xa5, 0x85, 0x03, 0x57, 0x3e, 0x1a, 0xea,
+		0x85, 0x75, 0x54, 0x89, 0xe8, 0xe9, 0x53, 0x1f, 0x4a, 0xa4, 0xbc, 0x3c, 0xe8, 0x43, 0x89, 0xd4,
+		0xdb, 0xe4, 0x53, 0x98, 0xe9, 0x78, 0xb4, 0x83, 0xde, 0x1f, 0xf4, 0xf9, 0x51, 0xe5, 0xe1, 0x00,
+		0x1c, 0xb1, 0x71, 0x63, 0xf3, 0x7e, 0x7f, 0xd0, 0xd7, 0x16, 0x3d, 0xc6, 0x4d, 0x9d, 0x6f, 0x13,
+		0xa6, 0x63, 0x17, 0xc0, 0xa8, 0xcb, 0x7e, 0x3f, 0xed, 0x2e, 0xbb, 0xb2, 0xd2, 0x37, 0xbd, 0x1a,
+		0xf1, 0x4f, 0x35, 0x58, 0xcc, 0xbc, 0x8d, 0x44, 0x5d, 0x4a, 0x4b, 0xaf, 0x1b, 0xd6, 0xca, 0x67,
+		0x43, 0xf1, 0x2a, 0xb5, 0xfe, 0x40, 0x83, 0x2b, 0xa9, 0xf7, 0x83, 0xe8, 0xe3, 0x2e, 0x7f, 0xd6,
+		0xd5, 0xe5, 0xbe, 0xb4, 0xf2, 0xeb, 0x03, 0xf3, 0x29, 0x55, 0xce, 0xa1, 0xd4, 0x79, 0x52, 0x8a,
+		0x1e, 0x0e, 0x72, 0xaa, 0x2a, 0xc7, 0x1f, 0xe2, 0x20, 0x16, 0xfd, 0x91, 0x06, 0x0b, 0xe9, 0x97,
+		0x1c, 0xa8, 0xcb, 0x74, 0xba, 0x5e, 0xc6, 0x54, 0x1e, 0x0d, 0xce, 0xa8, 0xb4, 0xf9, 0xb9, 0x06,
+		0xf3, 0x69, 0x47, 0xea, 0xe8, 0xa3, 0x41, 0x8f, 0xe0, 0xa5, 0x26, 0x1f, 0x0f, 0x77, 0x72, 0x8f,
+		0xfe, 0x42, 0x83, 0x1b, 0x5d, 0x0f, 0x5c, 0xd1, 0xe3, 0x6c, 0xc9, 0xfd, 0x1c, 0x66, 0x57, 0x9e,
+		0x0c, 0xcd, 0xaf, 0x54, 0xfc, 0x6b, 0x0d, 0x6e, 0x76, 0x3f, 0xc5, 0x44, 0x4f, 0xba, 0x85, 0x47,
+		0x1f, 0x67, 0xc4, 0x95, 0x1f, 0x0c, 0x2f, 0x20, 0xa2, 0x65, 0xf7, 0x43, 0x34, 0xd4, 0xb7, 0x25,
+		0x32, 0x8e, 0x5e, 0xbb, 0x69, 0xd9, 0xe7, 0xf9, 0xdd, 0xdf, 0x69, 0xb0, 0xd4, 0xeb, 0xe0, 0x09,
+		0xad, 0xf5, 0xe5, 0x4b, 0xdd, 0x8e, 0xe6, 0x2a, 0xeb, 0x17, 0x11, 0xa1, 0x74, 0xfd, 0x29, 0x14,
+		0x22, 0xc7, 0x10, 0xe8, 0xbd, 0xee, 0xb1, 0x16, 0x3f, 0xf8, 0xa8, 0x7c, 0xbf, 0x4f, 0x6a, 0x35,
+		0x16, 0x85, 0x62, 0xfc, 0xc4, 0x00, 0xad, 0xf4, 0x4e, 0x31, 0xb1, 0x53, 0x8c, 0xca, 0xfb, 0xfd,
+		0x33, 0xb4, 0x0b, 0x63, 0xc7, 0xc6, 0xa7, 0x5b, 0x61, 0x4c, 0xdf, 0x6b, 0x76, 0x2b, 0x8c, 0x59,
+		0xbb, 0xaa, 0x3f, 0xd1, 0xda, 0xcf, 0x41, 0x3a, 0x31, 0xf0, 0xa3, 0xde, 0xb3, 0x48, 0xc7, 0xf6,
+		0x95, 0x4f, 0x86, 0xe0, 0x94, 0x0a, 0xad, 0x7f, 0xf6, 0xe3, 0x4f, 0x8e, 0x6c, 0x76, 0xdc, 0x3a,
+		0xa8, 0xd6, 0xbd, 0xc6, 0x4a, 0xec, 0xef, 0xfc, 0xab, 0x47, 0xc4, 0x95, 0xff, 0x18, 0x21, 0xfa,
+		0xbf, 0x19, 0x3e, 0x0b, 0x7e, 0x9f, 0x3e, 0x3c, 0x18, 0x13, 0xbd, 0x1f, 0xfc, 0x6f, 0x00, 0x00,
+		0x00, 0xff, 0xff, 0xd7, 0xc6, 0x7a, 0x45, 0xc9, 0x41, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -23,6 +23,12 @@ var _ = ioutil.NopCloser
 // ShardDistributorAPIYARPCClient is the YARPC client-side interface for the ShardDistributorAPI service.
 type ShardDistributorAPIYARPCClient interface {
 	GetShardOwner(context.Context, *GetShardOwnerRequest, ...yarpc.CallOption) (*GetShardOwnerResponse, error)
+	WatchNamespaceState(context.Context, *WatchNamespaceStateRequest, ...yarpc.CallOption) (*WatchNamespaceStateResponse, error)
+	ListNamespaces(context.Context, *ListNamespacesRequest, ...yarpc.CallOption) (*ListNamespacesResponse, error)
+	DescribeNamespace(context.Context, *DescribeNamespaceRequest, ...yarpc.CallOption) (*DescribeNamespaceResponse, error)
+	MoveShard(context.Context, *MoveShardRequest, ...yarpc.CallOption) (*MoveShardResponse, error)
+	SetExecutorDrained(context.Context, *SetExecutorDrainedRequest, ...yarpc.CallOption) (*SetExecutorDrainedResponse, error)
+	SetRebalancingFrozen(context.Context, *SetRebalancingFrozenRequest, ...yarpc.CallOption) (*SetRebalancingFrozenResponse, error)
 }
 
 func newShardDistributorAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) ShardDistributorAPIYARPCClient {
@@ -44,6 +50,12 @@ func NewShardDistributorAPIYARPCClient(clientConfig transport.ClientConfig, opti
 // ShardDistributorAPIYARPCServer is the YARPC server-side interface for the ShardDistributorAPI service.
 type ShardDistributorAPIYARPCServer interface {
 	GetShardOwner(context.Context, *GetShardOwnerRequest) (*GetShardOwnerResponse, error)
+	WatchNamespaceState(context.Context, *WatchNamespaceStateRequest) (*WatchNamespaceStateResponse, error)
+	ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error)
+	DescribeNamespace(context.Context, *DescribeNamespaceRequest) (*DescribeNamespaceResponse, error)
+	MoveShard(context.Context, *MoveShardRequest) (*MoveShardResponse, error)
+	SetExecutorDrained(context.Context, *SetExecutorDrainedRequest) (*SetExecutorDrainedResponse, error)
+	SetRebalancingFrozen(context.Context, *SetRebalancingFrozenRequest) (*SetRebalancingFrozenResponse, error)
 }
 
 type buildShardDistributorAPIYARPCProceduresParams struct {
@@ -67,6 +79,66 @@ func buildShardDistributorAPIYARPCProcedures(params buildShardDistributorAPIYARP
 						},
 					),
 				},
+				{
+					MethodName: "WatchNamespaceState",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.WatchNamespaceState,
+							NewRequest:  newShardDistributorAPIServiceWatchNamespaceStateYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ListNamespaces",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ListNamespaces,
+							NewRequest:  newShardDistributorAPIServiceListNamespacesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeNamespace",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeNamespace,
+							NewRequest:  newShardDistributorAPIServiceDescribeNamespaceYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "MoveShard",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.MoveShard,
+							NewRequest:  newShardDistributorAPIServiceMoveShardYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "SetExecutorDrained",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.SetExecutorDrained,
+							NewRequest:  newShardDistributorAPIServiceSetExecutorDrainedYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "SetRebalancingFrozen",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.SetRebalancingFrozen,
+							NewRequest:  newShardDistributorAPIServiceSetRebalancingFrozenYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 			},
 			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
 			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
@@ -197,6 +269,78 @@ func (c *_ShardDistributorAPIYARPCCaller) GetShardOwner(ctx context.Context, req
 	return response, err
 }
 
+func (c *_ShardDistributorAPIYARPCCaller) WatchNamespaceState(ctx context.Context, request *WatchNamespaceStateRequest, options ...yarpc.CallOption) (*WatchNamespaceStateResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "WatchNamespaceState", request, newShardDistributorAPIServiceWatchNamespaceStateYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*WatchNamespaceStateResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyShardDistributorAPIServiceWatchNamespaceStateYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_ShardDistributorAPIYARPCCaller) ListNamespaces(ctx context.Context, request *ListNamespacesRequest, options ...yarpc.CallOption) (*ListNamespacesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListNamespaces", request, newShardDistributorAPIServiceListNamespacesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListNamespacesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyShardDistributorAPIServiceListNamespacesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_ShardDistributorAPIYARPCCaller) DescribeNamespace(ctx context.Context, request *DescribeNamespaceRequest, options ...yarpc.CallOption) (*DescribeNamespaceResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeNamespace", request, newShardDistributorAPIServiceDescribeNamespaceYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeNamespaceResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyShardDistributorAPIServiceDescribeNamespaceYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_ShardDistributorAPIYARPCCaller) MoveShard(ctx context.Context, request *MoveShardRequest, options ...yarpc.CallOption) (*MoveShardResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "MoveShard", request, newShardDistributorAPIServiceMoveShardYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*MoveShardResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyShardDistributorAPIServiceMoveShardYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_ShardDistributorAPIYARPCCaller) SetExecutorDrained(ctx context.Context, request *SetExecutorDrainedRequest, options ...yarpc.CallOption) (*SetExecutorDrainedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "SetExecutorDrained", request, newShardDistributorAPIServiceSetExecutorDrainedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*SetExecutorDrainedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyShardDistributorAPIServiceSetExecutorDrainedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_ShardDistributorAPIYARPCCaller) SetRebalancingFrozen(ctx context.Context, request *SetRebalancingFrozenRequest, options ...yarpc.CallOption) (*SetRebalancingFrozenResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "SetRebalancingFrozen", request, newShardDistributorAPIServiceSetRebalancingFrozenYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*SetRebalancingFrozenResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyShardDistributorAPIServiceSetRebalancingFrozenYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 type _ShardDistributorAPIYARPCHandler struct {
 	server ShardDistributorAPIYARPCServer
 }
@@ -217,6 +361,102 @@ func (h *_ShardDistributorAPIYARPCHandler) GetShardOwner(ctx context.Context, re
 	return response, err
 }
 
+func (h *_ShardDistributorAPIYARPCHandler) WatchNamespaceState(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *WatchNamespaceStateRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*WatchNamespaceStateRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyShardDistributorAPIServiceWatchNamespaceStateYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.WatchNamespaceState(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_ShardDistributorAPIYARPCHandler) ListNamespaces(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListNamespacesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListNamespacesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyShardDistributorAPIServiceListNamespacesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListNamespaces(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_ShardDistributorAPIYARPCHandler) DescribeNamespace(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeNamespaceRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeNamespaceRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyShardDistributorAPIServiceDescribeNamespaceYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeNamespace(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_ShardDistributorAPIYARPCHandler) MoveShard(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *MoveShardRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*MoveShardRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyShardDistributorAPIServiceMoveShardYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.MoveShard(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_ShardDistributorAPIYARPCHandler) SetExecutorDrained(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *SetExecutorDrainedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*SetExecutorDrainedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyShardDistributorAPIServiceSetExecutorDrainedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.SetExecutorDrained(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_ShardDistributorAPIYARPCHandler) SetRebalancingFrozen(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *SetRebalancingFrozenRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*SetRebalancingFrozenRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyShardDistributorAPIServiceSetRebalancingFrozenYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.SetRebalancingFrozen(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func newShardDistributorAPIServiceGetShardOwnerYARPCRequest() proto.Message {
 	return &GetShardOwnerRequest{}
 }
@@ -225,31 +465,154 @@ func newShardDistributorAPIServiceGetShardOwnerYARPCResponse() proto.Message {
 	return &GetShardOwnerResponse{}
 }
 
+func newShardDistributorAPIServiceWatchNamespaceStateYARPCRequest() proto.Message {
+	return &WatchNamespaceStateRequest{}
+}
+
+func newShardDistributorAPIServiceWatchNamespaceStateYARPCResponse() proto.Message {
+	return &WatchNamespaceStateResponse{}
+}
+
+func newShardDistributorAPIServiceListNamespacesYARPCRequest() proto.Message {
+	return &ListNamespacesRequest{}
+}
+
+func newShardDistributorAPIServiceListNamespacesYARPCResponse() proto.Message {
+	return &ListNamespacesResponse{}
+}
+
+func newShardDistributorAPIServiceDescribeNamespaceYARPCRequest() proto.Message {
+	return &DescribeNamespaceRequest{}
+}
+
+func newShardDistributorAPIServiceDescribeNamespaceYARPCResponse() proto.Message {
+	return &DescribeNamespaceResponse{}
+}
+
+func newShardDistributorAPIServiceMoveShardYARPCRequest() proto.Message {
+	return &MoveShardRequest{}
+}
+
+func newShardDistributorAPIServiceMoveShardYARPCResponse() proto.Message {
+	return &MoveShardResponse{}
+}
+
+func newShardDistributorAPIServiceSetExecutorDrainedYARPCRequest() proto.Message {
+	return &SetExecutorDrainedRequest{}
+}
+
+func newShardDistributorAPIServiceSetExecutorDrainedYARPCResponse() proto.Message {
+	return &SetExecutorDrainedResponse{}
+}
+
+func newShardDistributorAPIServiceSetRebalancingFrozenYARPCRequest() proto.Message {
+	return &SetRebalancingFrozenRequest{}
+}
+
+func newShardDistributorAPIServiceSetRebalancingFrozenYARPCResponse() proto.Message {
+	return &SetRebalancingFrozenResponse{}
+}
+
 var (
-	emptyShardDistributorAPIServiceGetShardOwnerYARPCRequest  = &GetShardOwnerRequest{}
-	emptyShardDistributorAPIServiceGetShardOwnerYARPCResponse = &GetShardOwnerResponse{}
+	emptyShardDistributorAPIServiceGetShardOwnerYARPCRequest         = &GetShardOwnerRequest{}
+	emptyShardDistributorAPIServiceGetShardOwnerYARPCResponse        = &GetShardOwnerResponse{}
+	emptyShardDistributorAPIServiceWatchNamespaceStateYARPCRequest   = &WatchNamespaceStateRequest{}
+	emptyShardDistributorAPIServiceWatchNamespaceStateYARPCResponse  = &WatchNamespaceStateResponse{}
+	emptyShardDistributorAPIServiceListNamespacesYARPCRequest        = &ListNamespacesRequest{}
+	emptyShardDistributorAPIServiceListNamespacesYARPCResponse       = &ListNamespacesResponse{}
+	emptyShardDistributorAPIServiceDescribeNamespaceYARPCRequest     = &DescribeNamespaceRequest{}
+	emptyShardDistributorAPIServiceDescribeNamespaceYARPCResponse    = &DescribeNamespaceResponse{}
+	emptyShardDistributorAPIServiceMoveShardYARPCRequest             = &MoveShardRequest{}
+	emptyShardDistributorAPIServiceMoveShardYARPCResponse            = &MoveShardResponse{}
+	emptyShardDistributorAPIServiceSetExecutorDrainedYARPCRequest    = &SetExecutorDrainedRequest{}
+	emptyShardDistributorAPIServiceSetExecutorDrainedYARPCResponse   = &SetExecutorDrainedResponse{}
+	emptyShardDistributorAPIServiceSetRebalancingFrozenYARPCRequest  = &SetRebalancingFrozenRequest{}
+	emptyShardDistributorAPIServiceSetRebalancingFrozenYARPCResponse = &SetRebalancingFrozenResponse{}
 )
 
 var yarpcFileDescriptorClosure0055bfd59dff1f95 = [][]byte{
 	// uber/cadence/sharddistributor/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x51, 0x4d, 0x4b, 0x03, 0x31,
-		0x10, 0x25, 0x82, 0xe2, 0x06, 0xbc, 0xc4, 0x2a, 0x45, 0x3d, 0x94, 0x9e, 0x3c, 0x25, 0xac, 0x42,
-		0x3d, 0x78, 0x52, 0xfc, 0xa0, 0x2c, 0x54, 0xad, 0x37, 0x2f, 0xb2, 0x9b, 0x1d, 0xda, 0x45, 0x9a,
-		0x59, 0x27, 0xc9, 0x4a, 0x6f, 0xfe, 0x0c, 0x7f, 0xae, 0x6c, 0x5a, 0x29, 0x0d, 0x85, 0xe2, 0x71,
-		0xde, 0xbc, 0xf7, 0x98, 0x79, 0x8f, 0x4b, 0x5f, 0x00, 0x29, 0x9d, 0x97, 0x60, 0x34, 0x28, 0x3b,
-		0xcd, 0xa9, 0x2c, 0x2b, 0xeb, 0xa8, 0x2a, 0xbc, 0x43, 0x52, 0x4d, 0xaa, 0x2c, 0x50, 0x53, 0x69,
-		0x90, 0x35, 0xa1, 0x43, 0xd1, 0x6b, 0xf9, 0x72, 0xc9, 0x97, 0x31, 0x5f, 0x36, 0x69, 0xff, 0x85,
-		0x77, 0x1e, 0xc1, 0xbd, 0xb6, 0x9b, 0xa7, 0x2f, 0x03, 0x34, 0x86, 0x4f, 0x0f, 0xd6, 0x89, 0x53,
-		0x9e, 0x04, 0xfa, 0xfb, 0x07, 0xcc, 0xbb, 0xac, 0xc7, 0xce, 0x93, 0xf1, 0x7e, 0x00, 0x32, 0x98,
-		0x8b, 0x33, 0x9e, 0x98, 0x7c, 0x06, 0xb6, 0xce, 0x35, 0x74, 0x77, 0xc2, 0x72, 0x05, 0xf4, 0x33,
-		0x7e, 0x14, 0x59, 0xda, 0x1a, 0x8d, 0x05, 0xd1, 0xe1, 0xbb, 0xd8, 0x02, 0x4b, 0xbf, 0xc5, 0xb0,
-		0xc5, 0x6c, 0xc0, 0x8f, 0x47, 0x7f, 0xc3, 0x08, 0xdd, 0x03, 0x7a, 0x53, 0xde, 0x13, 0x61, 0xa4,
-		0x63, 0x91, 0xee, 0xe2, 0x87, 0xf1, 0xc3, 0x70, 0xc2, 0xdd, 0xea, 0xdf, 0x9b, 0xe7, 0xa1, 0xf8,
-		0x66, 0xfc, 0x60, 0xed, 0x3a, 0x31, 0x90, 0xdb, 0x42, 0x92, 0x9b, 0x12, 0x3a, 0xb9, 0xfa, 0xb7,
-		0x6e, 0x11, 0xc3, 0x6d, 0xf6, 0x36, 0x9c, 0x54, 0x6e, 0xea, 0x0b, 0xa9, 0x71, 0xa6, 0xd6, 0x1a,
-		0x95, 0x13, 0x30, 0x2a, 0x54, 0xb7, 0xa9, 0xdc, 0xeb, 0x18, 0x6b, 0xd2, 0x62, 0x2f, 0xb0, 0x2f,
-		0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xd5, 0x61, 0x9a, 0x8e, 0x1a, 0x02, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x41, 0x6f, 0xe3, 0x44,
+		0x14, 0x96, 0x93, 0x6c, 0xb7, 0x79, 0xd9, 0x16, 0x3a, 0xed, 0x16, 0xaf, 0x5b, 0xd8, 0xca, 0xa7,
+		0x5e, 0x70, 0xd4, 0xae, 0x58, 0x56, 0x74, 0x8b, 0x14, 0xd4, 0x2e, 0x54, 0x2d, 0xdd, 0xc5, 0x5d,
+		0x81, 0xc4, 0x81, 0x68, 0x12, 0xbf, 0xa6, 0x16, 0x89, 0x27, 0xcc, 0x8c, 0x03, 0xe1, 0x84, 0xe0,
+		0xc0, 0x65, 0x25, 0x24, 0x24, 0xc4, 0x9d, 0x03, 0xe2, 0x47, 0x71, 0xe2, 0x97, 0x20, 0x8f, 0xc7,
+		0x4e, 0x9c, 0x3a, 0xb1, 0x9b, 0x5b, 0xde, 0xcc, 0xbc, 0x2f, 0xdf, 0xf7, 0x3d, 0xbf, 0xe7, 0x31,
+		0x38, 0x61, 0x07, 0x79, 0xb3, 0x4b, 0x3d, 0x0c, 0xba, 0xd8, 0x14, 0x37, 0x94, 0x7b, 0x9e, 0x2f,
+		0x24, 0xf7, 0x3b, 0xa1, 0x64, 0xbc, 0x39, 0x3a, 0x68, 0x0a, 0xe4, 0x23, 0xbf, 0x8b, 0xce, 0x90,
+		0x33, 0xc9, 0xc8, 0x5e, 0x74, 0xde, 0xd1, 0xe7, 0x9d, 0xd9, 0xf3, 0xce, 0xe8, 0xc0, 0x7a, 0xdc,
+		0x63, 0xac, 0xd7, 0xc7, 0xa6, 0x3a, 0xdf, 0x09, 0xaf, 0x9b, 0xd2, 0x1f, 0xa0, 0x90, 0x74, 0x30,
+		0x8c, 0x21, 0xec, 0x2f, 0x60, 0xeb, 0x53, 0x94, 0x57, 0x51, 0xea, 0xcb, 0xef, 0x03, 0xe4, 0x2e,
+		0x7e, 0x17, 0xa2, 0x90, 0x64, 0x07, 0xea, 0x0a, 0xaf, 0xfd, 0x2d, 0x8e, 0x4d, 0x63, 0xcf, 0xd8,
+		0xaf, 0xbb, 0xab, 0x6a, 0xe1, 0x1c, 0xc7, 0x64, 0x17, 0xea, 0x01, 0x1d, 0xa0, 0x18, 0xd2, 0x2e,
+		0x9a, 0x15, 0xb5, 0x39, 0x59, 0xb0, 0xcf, 0xe1, 0xe1, 0x0c, 0xa4, 0x18, 0xb2, 0x40, 0x20, 0xd9,
+		0x82, 0x7b, 0x2c, 0x5a, 0xd0, 0x78, 0x71, 0x50, 0x00, 0xf6, 0x25, 0x58, 0x5f, 0x51, 0xd9, 0xbd,
+		0xb9, 0x4c, 0x56, 0xae, 0x24, 0x95, 0x98, 0xb0, 0xcc, 0xe4, 0x1a, 0x33, 0xb9, 0xc4, 0x82, 0x55,
+		0x8e, 0x23, 0x5f, 0xf8, 0x2c, 0x50, 0xc0, 0x55, 0x37, 0x8d, 0x6d, 0x06, 0x3b, 0xb9, 0xb8, 0x9a,
+		0xea, 0xd2, 0xc0, 0x64, 0x1b, 0x56, 0x94, 0x2e, 0x61, 0x56, 0xf7, 0xaa, 0xfb, 0x75, 0x57, 0x47,
+		0xf6, 0x3b, 0xf0, 0xf0, 0xc2, 0x17, 0x32, 0xfd, 0x3f, 0xa1, 0x35, 0xd8, 0x3e, 0x6c, 0xcf, 0x6e,
+		0x68, 0x12, 0x2f, 0x01, 0xd2, 0xff, 0x14, 0xa6, 0xb1, 0x57, 0xdd, 0x6f, 0x1c, 0x36, 0x9d, 0xa2,
+		0x9a, 0x3b, 0x29, 0xd2, 0x59, 0x70, 0xcd, 0xdc, 0x29, 0x08, 0xfb, 0x35, 0xac, 0x65, 0x36, 0x09,
+		0x81, 0x5a, 0xb4, 0xad, 0x15, 0xaa, 0xdf, 0xd1, 0x9a, 0x1c, 0x0f, 0x93, 0x52, 0xa8, 0xdf, 0x93,
+		0xa7, 0x21, 0x08, 0x07, 0x66, 0x35, 0x56, 0xac, 0x16, 0x2e, 0xc3, 0x81, 0xfd, 0x0c, 0xcc, 0x13,
+		0x14, 0x5d, 0xee, 0x77, 0x30, 0x45, 0x2f, 0x55, 0x20, 0xfb, 0xdf, 0x0a, 0x3c, 0xca, 0x49, 0x2d,
+		0x55, 0x83, 0x0b, 0xa8, 0xe3, 0x0f, 0xd8, 0x8d, 0x44, 0x0b, 0xb3, 0xa2, 0xbc, 0x71, 0x8a, 0xbd,
+		0x39, 0xd5, 0x29, 0xca, 0x9a, 0x09, 0x00, 0x79, 0x1f, 0x08, 0xc7, 0x0e, 0xed, 0xd3, 0xa0, 0xeb,
+		0x07, 0xbd, 0xf6, 0x35, 0x67, 0x3f, 0x62, 0xa0, 0x94, 0xae, 0xba, 0x1b, 0x53, 0x3b, 0x2f, 0xd4,
+		0x06, 0x79, 0x05, 0x8d, 0xd8, 0x8f, 0x01, 0x1b, 0xa1, 0x30, 0x6b, 0x65, 0x4b, 0xa3, 0x9a, 0xe2,
+		0x73, 0x36, 0xd2, 0xa5, 0x11, 0x49, 0x28, 0x88, 0x0b, 0x75, 0x1a, 0x7a, 0xbe, 0x6c, 0xf7, 0x59,
+		0xcf, 0xbc, 0xa7, 0xf0, 0x3e, 0xb8, 0x43, 0xa9, 0x5b, 0x51, 0xee, 0x69, 0x20, 0xf9, 0xd8, 0x5d,
+		0x55, 0x38, 0x17, 0xac, 0x67, 0xff, 0x5c, 0x81, 0x07, 0xd3, 0x82, 0xc9, 0x63, 0x68, 0x24, 0x92,
+		0xdb, 0xbe, 0xa7, 0x3d, 0x85, 0x64, 0xe9, 0xcc, 0x8b, 0x3a, 0x54, 0x44, 0x7d, 0xa0, 0x8b, 0x1f,
+		0x07, 0xa4, 0x05, 0xeb, 0x7d, 0x2a, 0x64, 0xfb, 0x06, 0x29, 0x97, 0x1d, 0xa4, 0x52, 0x19, 0xd3,
+		0x38, 0xb4, 0x9c, 0x78, 0xba, 0x38, 0xc9, 0x74, 0x71, 0x5e, 0x27, 0xd3, 0xc5, 0x5d, 0x8b, 0x32,
+		0x3e, 0x4b, 0x12, 0x88, 0x09, 0xf7, 0x3d, 0x4e, 0xfd, 0x00, 0x3d, 0xb3, 0xa6, 0x4c, 0x4d, 0x42,
+		0xf2, 0x0d, 0xbc, 0x45, 0x85, 0xf0, 0x7b, 0x01, 0x7a, 0x6d, 0x25, 0x51, 0x94, 0x97, 0xaf, 0xec,
+		0x6c, 0xa9, 0xec, 0x01, 0x06, 0x52, 0x99, 0xba, 0x9e, 0xa0, 0xa9, 0x4d, 0x61, 0xff, 0x6d, 0xc0,
+		0x66, 0xce, 0xb9, 0xc5, 0x03, 0xee, 0x08, 0x1a, 0x29, 0x29, 0x2a, 0x95, 0x1b, 0x8b, 0xe5, 0x42,
+		0x72, 0xbc, 0x25, 0xa3, 0x09, 0x10, 0xf9, 0x16, 0x0a, 0x65, 0x53, 0xdd, 0xd5, 0x11, 0x79, 0x17,
+		0xe2, 0x82, 0xb7, 0xfb, 0x8c, 0xc6, 0x36, 0x18, 0x6e, 0xcc, 0xe1, 0x82, 0x51, 0xcf, 0x7e, 0x63,
+		0xc0, 0x5a, 0xe6, 0xf9, 0x58, 0x4c, 0x71, 0xa6, 0x96, 0x95, 0x5b, 0xb5, 0x3c, 0x86, 0x07, 0x3c,
+		0xee, 0xc2, 0x58, 0x44, 0x71, 0xcd, 0x1a, 0xe9, 0xf9, 0x96, 0xb4, 0xff, 0x34, 0x60, 0x33, 0xe7,
+		0xf1, 0x22, 0x0e, 0xd4, 0xa2, 0x77, 0x88, 0xe2, 0xb3, 0x18, 0x4e, 0x9d, 0x8b, 0x66, 0xa5, 0xef,
+		0x61, 0x20, 0x7d, 0x39, 0xd6, 0x24, 0xd3, 0x38, 0xea, 0x70, 0x36, 0x44, 0x4e, 0x65, 0x34, 0x48,
+		0x63, 0xb3, 0x26, 0x0b, 0x91, 0x8f, 0x1c, 0xa9, 0x60, 0x81, 0xf2, 0xaa, 0xee, 0xea, 0xc8, 0xfe,
+		0xcb, 0x80, 0xb7, 0x23, 0x8f, 0x94, 0x59, 0xe5, 0xde, 0x04, 0x19, 0x27, 0x2b, 0x8b, 0x9d, 0xac,
+		0xde, 0x72, 0x72, 0x5a, 0x42, 0x6d, 0x46, 0xc2, 0x84, 0xe4, 0xbd, 0x0c, 0xc9, 0x4d, 0xd8, 0x98,
+		0xe2, 0x18, 0x4f, 0x34, 0xfb, 0x1f, 0x03, 0x1e, 0x5d, 0xa1, 0x4c, 0x7a, 0xf2, 0x24, 0x6e, 0x81,
+		0x72, 0x12, 0x0a, 0xeb, 0x3d, 0xd5, 0x62, 0xd5, 0x6c, 0x8b, 0x2d, 0xc3, 0x7f, 0x17, 0xac, 0x3c,
+		0xa6, 0x5a, 0xc8, 0xaf, 0x06, 0xec, 0x5c, 0xa1, 0x74, 0x67, 0x07, 0x63, 0x39, 0x29, 0xdb, 0xb0,
+		0xa2, 0x07, 0x6c, 0x45, 0x11, 0xd5, 0x51, 0x86, 0x67, 0x75, 0x2e, 0xcf, 0xec, 0xc3, 0xf0, 0x1e,
+		0xec, 0xe6, 0x13, 0xd1, 0x4c, 0x9f, 0xc2, 0x76, 0xfa, 0x14, 0x5f, 0x32, 0xf9, 0x82, 0x85, 0x81,
+		0x77, 0xca, 0x39, 0xe3, 0x8b, 0x39, 0x1e, 0xfe, 0x77, 0x5f, 0x8f, 0x8d, 0x93, 0xc9, 0xc8, 0x69,
+		0xbd, 0x3a, 0x23, 0x3f, 0x19, 0xb0, 0x96, 0xb9, 0xdd, 0x90, 0xa7, 0xc5, 0x73, 0x2a, 0xef, 0x86,
+		0x65, 0x7d, 0x78, 0xe7, 0x3c, 0xfd, 0x5e, 0xfc, 0xdd, 0x80, 0xcd, 0x9c, 0xbb, 0x0b, 0x79, 0x5e,
+		0x0c, 0x38, 0xff, 0x2a, 0x65, 0x1d, 0x2f, 0x99, 0xad, 0x49, 0xfd, 0x62, 0xc0, 0x7a, 0xf6, 0x1a,
+		0x43, 0x4a, 0x08, 0xcc, 0xbd, 0x11, 0x59, 0xcf, 0xee, 0x9e, 0xa8, 0x59, 0xbc, 0x31, 0x60, 0xe3,
+		0xd6, 0x85, 0x82, 0x7c, 0x54, 0x8c, 0x37, 0xef, 0x02, 0x63, 0x1d, 0x2d, 0x95, 0xab, 0xe9, 0x48,
+		0xa8, 0xa7, 0x43, 0x80, 0x1c, 0x16, 0x23, 0xcd, 0x4e, 0x35, 0xeb, 0xc9, 0x9d, 0x72, 0xf4, 0xbf,
+		0xfe, 0x66, 0x00, 0xb9, 0xdd, 0xbb, 0xa4, 0x84, 0x92, 0xb9, 0xb3, 0xc9, 0x7a, 0xbe, 0x5c, 0xb2,
+		0x66, 0xf4, 0x87, 0x01, 0x5b, 0x79, 0x5d, 0x4a, 0x8e, 0x4b, 0xc1, 0xce, 0x1b, 0x33, 0xd6, 0xc7,
+		0xcb, 0xa6, 0xc7, 0xbc, 0x3e, 0x39, 0xff, 0xfa, 0xac, 0xe7, 0xcb, 0x9b, 0xb0, 0xe3, 0x74, 0xd9,
+		0xa0, 0x99, 0xf9, 0xf8, 0x72, 0x7a, 0x18, 0xc4, 0x5f, 0x4d, 0x79, 0xdf, 0x61, 0x47, 0xb3, 0x6b,
+		0xa3, 0x83, 0xce, 0x8a, 0x3a, 0xfd, 0xe4, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x1d, 0xb9,
+		0x69, 0xc5, 0x0d, 0x00, 0x00,
+	},
+	// google/protobuf/timestamp.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d,
+		0x2d, 0x2e, 0x49, 0xcc, 0x2d, 0xd0, 0x03, 0x0b, 0x09, 0xf1, 0x43, 0x14, 0xe8, 0xc1, 0x14, 0x28,
+		0x59, 0x73, 0x71, 0x86, 0xc0, 0xd4, 0x08, 0x49, 0x70, 0xb1, 0x17, 0xa7, 0x26, 0xe7, 0xe7, 0xa5,
+		0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x79, 0x89,
+		0x79, 0xf9, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xac, 0x41, 0x10, 0x8e, 0x53, 0x2b, 0x23, 0x97,
+		0x70, 0x72, 0x7e, 0xae, 0x1e, 0x9a, 0xa1, 0x4e, 0x7c, 0x70, 0x23, 0x03, 0x40, 0x42, 0x01, 0x8c,
+		0x51, 0x46, 0x50, 0x25, 0xe9, 0xf9, 0x39, 0x89, 0x79, 0xe9, 0x7a, 0xf9, 0x45, 0xe9, 0x48, 0x6e,
+		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
+		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
+		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
+		0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
 	},
 }
 
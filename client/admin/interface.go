@@ -71,4 +71,10 @@ type Client interface {
 	GetDomainAsyncWorkflowConfiguraton(ctx context.Context, request *types.GetDomainAsyncWorkflowConfiguratonRequest, opts ...yarpc.CallOption) (*types.GetDomainAsyncWorkflowConfiguratonResponse, error)
 	UpdateDomainAsyncWorkflowConfiguraton(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfiguratonRequest, opts ...yarpc.CallOption) (*types.UpdateDomainAsyncWorkflowConfiguratonResponse, error)
 	UpdateTaskListPartitionConfig(ctx context.Context, request *types.UpdateTaskListPartitionConfigRequest, opts ...yarpc.CallOption) (*types.UpdateTaskListPartitionConfigResponse, error)
+	UpdateTaskListVersioningConfig(ctx context.Context, request *types.UpdateTaskListVersioningConfigRequest, opts ...yarpc.CallOption) (*types.UpdateTaskListVersioningConfigResponse, error)
+	MigrateTaskList(ctx context.Context, request *types.MigrateTaskListRequest, opts ...yarpc.CallOption) (*types.MigrateTaskListResponse, error)
+	ReadAsyncWorkflowDLQMessages(ctx context.Context, request *types.ReadAsyncWorkflowDLQMessagesRequest, opts ...yarpc.CallOption) (*types.ReadAsyncWorkflowDLQMessagesResponse, error)
+	PurgeAsyncWorkflowDLQMessages(ctx context.Context, request *types.PurgeAsyncWorkflowDLQMessagesRequest, opts ...yarpc.CallOption) (*types.PurgeAsyncWorkflowDLQMessagesResponse, error)
+	ReplayAsyncWorkflowDLQMessages(ctx context.Context, request *types.ReplayAsyncWorkflowDLQMessagesRequest, opts ...yarpc.CallOption) (*types.ReplayAsyncWorkflowDLQMessagesResponse, error)
+	DescribeAsyncWorkflowQueue(ctx context.Context, request *types.DescribeAsyncWorkflowQueueRequest, opts ...yarpc.CallOption) (*types.DescribeAsyncWorkflowQueueResponse, error)
 }
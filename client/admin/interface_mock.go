@@ -121,6 +121,26 @@ func (mr *MockClientMockRecorder) DeleteWorkflow(arg0, arg1 any, arg2 ...any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflow", reflect.TypeOf((*MockClient)(nil).DeleteWorkflow), varargs...)
 }
 
+// DescribeAsyncWorkflowQueue mocks base method.
+func (m *MockClient) DescribeAsyncWorkflowQueue(ctx context.Context, request *types.DescribeAsyncWorkflowQueueRequest, opts ...yarpc.CallOption) (*types.DescribeAsyncWorkflowQueueResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, request}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeAsyncWorkflowQueue", varargs...)
+	ret0, _ := ret[0].(*types.DescribeAsyncWorkflowQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAsyncWorkflowQueue indicates an expected call of DescribeAsyncWorkflowQueue.
+func (mr *MockClientMockRecorder) DescribeAsyncWorkflowQueue(ctx, request any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, request}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAsyncWorkflowQueue", reflect.TypeOf((*MockClient)(nil).DescribeAsyncWorkflowQueue), varargs...)
+}
+
 // DescribeCluster mocks base method.
 func (m *MockClient) DescribeCluster(arg0 context.Context, arg1 ...yarpc.CallOption) (*types.DescribeClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -441,6 +461,46 @@ func (mr *MockClientMockRecorder) MergeDLQMessages(arg0, arg1 any, arg2 ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockClient)(nil).MergeDLQMessages), varargs...)
 }
 
+// MigrateTaskList mocks base method.
+func (m *MockClient) MigrateTaskList(ctx context.Context, request *types.MigrateTaskListRequest, opts ...yarpc.CallOption) (*types.MigrateTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, request}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MigrateTaskList", varargs...)
+	ret0, _ := ret[0].(*types.MigrateTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateTaskList indicates an expected call of MigrateTaskList.
+func (mr *MockClientMockRecorder) MigrateTaskList(ctx, request any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, request}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateTaskList", reflect.TypeOf((*MockClient)(nil).MigrateTaskList), varargs...)
+}
+
+// PurgeAsyncWorkflowDLQMessages mocks base method.
+func (m *MockClient) PurgeAsyncWorkflowDLQMessages(ctx context.Context, request *types.PurgeAsyncWorkflowDLQMessagesRequest, opts ...yarpc.CallOption) (*types.PurgeAsyncWorkflowDLQMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, request}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeAsyncWorkflowDLQMessages", varargs...)
+	ret0, _ := ret[0].(*types.PurgeAsyncWorkflowDLQMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeAsyncWorkflowDLQMessages indicates an expected call of PurgeAsyncWorkflowDLQMessages.
+func (mr *MockClientMockRecorder) PurgeAsyncWorkflowDLQMessages(ctx, request any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, request}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeAsyncWorkflowDLQMessages", reflect.TypeOf((*MockClient)(nil).PurgeAsyncWorkflowDLQMessages), varargs...)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockClient) PurgeDLQMessages(arg0 context.Context, arg1 *types.PurgeDLQMessagesRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -460,6 +520,26 @@ func (mr *MockClientMockRecorder) PurgeDLQMessages(arg0, arg1 any, arg2 ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQMessages", reflect.TypeOf((*MockClient)(nil).PurgeDLQMessages), varargs...)
 }
 
+// ReadAsyncWorkflowDLQMessages mocks base method.
+func (m *MockClient) ReadAsyncWorkflowDLQMessages(ctx context.Context, request *types.ReadAsyncWorkflowDLQMessagesRequest, opts ...yarpc.CallOption) (*types.ReadAsyncWorkflowDLQMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, request}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReadAsyncWorkflowDLQMessages", varargs...)
+	ret0, _ := ret[0].(*types.ReadAsyncWorkflowDLQMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAsyncWorkflowDLQMessages indicates an expected call of ReadAsyncWorkflowDLQMessages.
+func (mr *MockClientMockRecorder) ReadAsyncWorkflowDLQMessages(ctx, request any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, request}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAsyncWorkflowDLQMessages", reflect.TypeOf((*MockClient)(nil).ReadAsyncWorkflowDLQMessages), varargs...)
+}
+
 // ReadDLQMessages mocks base method.
 func (m *MockClient) ReadDLQMessages(arg0 context.Context, arg1 *types.ReadDLQMessagesRequest, arg2 ...yarpc.CallOption) (*types.ReadDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -537,6 +617,26 @@ func (mr *MockClientMockRecorder) RemoveTask(arg0, arg1 any, arg2 ...any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockClient)(nil).RemoveTask), varargs...)
 }
 
+// ReplayAsyncWorkflowDLQMessages mocks base method.
+func (m *MockClient) ReplayAsyncWorkflowDLQMessages(ctx context.Context, request *types.ReplayAsyncWorkflowDLQMessagesRequest, opts ...yarpc.CallOption) (*types.ReplayAsyncWorkflowDLQMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, request}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReplayAsyncWorkflowDLQMessages", varargs...)
+	ret0, _ := ret[0].(*types.ReplayAsyncWorkflowDLQMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayAsyncWorkflowDLQMessages indicates an expected call of ReplayAsyncWorkflowDLQMessages.
+func (mr *MockClientMockRecorder) ReplayAsyncWorkflowDLQMessages(ctx, request any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, request}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayAsyncWorkflowDLQMessages", reflect.TypeOf((*MockClient)(nil).ReplayAsyncWorkflowDLQMessages), varargs...)
+}
+
 // ResendReplicationTasks mocks base method.
 func (m *MockClient) ResendReplicationTasks(arg0 context.Context, arg1 *types.ResendReplicationTasksRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -692,3 +792,23 @@ func (mr *MockClientMockRecorder) UpdateTaskListPartitionConfig(ctx, request any
 	varargs := append([]any{ctx, request}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListPartitionConfig", reflect.TypeOf((*MockClient)(nil).UpdateTaskListPartitionConfig), varargs...)
 }
+
+// UpdateTaskListVersioningConfig mocks base method.
+func (m *MockClient) UpdateTaskListVersioningConfig(ctx context.Context, request *types.UpdateTaskListVersioningConfigRequest, opts ...yarpc.CallOption) (*types.UpdateTaskListVersioningConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, request}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTaskListVersioningConfig", varargs...)
+	ret0, _ := ret[0].(*types.UpdateTaskListVersioningConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskListVersioningConfig indicates an expected call of UpdateTaskListVersioningConfig.
+func (mr *MockClientMockRecorder) UpdateTaskListVersioningConfig(ctx, request any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, request}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListVersioningConfig", reflect.TypeOf((*MockClient)(nil).UpdateTaskListVersioningConfig), varargs...)
+}
@@ -54,6 +54,10 @@ type Client interface {
 	ListOpenWorkflowExecutions(context.Context, *types.ListOpenWorkflowExecutionsRequest, ...yarpc.CallOption) (*types.ListOpenWorkflowExecutionsResponse, error)
 	ListTaskListPartitions(context.Context, *types.ListTaskListPartitionsRequest, ...yarpc.CallOption) (*types.ListTaskListPartitionsResponse, error)
 	GetTaskListsByDomain(context.Context, *types.GetTaskListsByDomainRequest, ...yarpc.CallOption) (*types.GetTaskListsByDomainResponse, error)
+	GetTaskListScalingRecommendation(context.Context, *types.GetTaskListScalingRecommendationRequest, ...yarpc.CallOption) (*types.GetTaskListScalingRecommendationResponse, error)
+	ListWorkers(context.Context, *types.ListWorkersRequest, ...yarpc.CallOption) (*types.ListWorkersResponse, error)
+	DescribeAsyncRequest(context.Context, *types.DescribeAsyncRequestRequest, ...yarpc.CallOption) (*types.DescribeAsyncRequestResponse, error)
+	DescribeWorker(context.Context, *types.DescribeWorkerRequest, ...yarpc.CallOption) (*types.DescribeWorkerResponse, error)
 	RefreshWorkflowTasks(context.Context, *types.RefreshWorkflowTasksRequest, ...yarpc.CallOption) error
 	ListWorkflowExecutions(context.Context, *types.ListWorkflowExecutionsRequest, ...yarpc.CallOption) (*types.ListWorkflowExecutionsResponse, error)
 	PollForActivityTask(context.Context, *types.PollForActivityTaskRequest, ...yarpc.CallOption) (*types.PollForActivityTaskResponse, error)
@@ -63,6 +67,7 @@ type Client interface {
 	RecordActivityTaskHeartbeatByID(context.Context, *types.RecordActivityTaskHeartbeatByIDRequest, ...yarpc.CallOption) (*types.RecordActivityTaskHeartbeatResponse, error)
 	RegisterDomain(context.Context, *types.RegisterDomainRequest, ...yarpc.CallOption) error
 	RequestCancelWorkflowExecution(context.Context, *types.RequestCancelWorkflowExecutionRequest, ...yarpc.CallOption) error
+	RequestCancelWorkflowExecutionAsync(context.Context, *types.RequestCancelWorkflowExecutionAsyncRequest, ...yarpc.CallOption) (*types.RequestCancelWorkflowExecutionAsyncResponse, error)
 	ResetStickyTaskList(context.Context, *types.ResetStickyTaskListRequest, ...yarpc.CallOption) (*types.ResetStickyTaskListResponse, error)
 	ResetWorkflowExecution(context.Context, *types.ResetWorkflowExecutionRequest, ...yarpc.CallOption) (*types.ResetWorkflowExecutionResponse, error)
 	RespondActivityTaskCanceled(context.Context, *types.RespondActivityTaskCanceledRequest, ...yarpc.CallOption) error
@@ -79,8 +84,11 @@ type Client interface {
 	SignalWithStartWorkflowExecution(context.Context, *types.SignalWithStartWorkflowExecutionRequest, ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error)
 	SignalWithStartWorkflowExecutionAsync(context.Context, *types.SignalWithStartWorkflowExecutionAsyncRequest, ...yarpc.CallOption) (*types.SignalWithStartWorkflowExecutionAsyncResponse, error)
 	SignalWorkflowExecution(context.Context, *types.SignalWorkflowExecutionRequest, ...yarpc.CallOption) error
+	SignalWorkflowExecutionAsync(context.Context, *types.SignalWorkflowExecutionAsyncRequest, ...yarpc.CallOption) (*types.SignalWorkflowExecutionAsyncResponse, error)
 	StartWorkflowExecution(context.Context, *types.StartWorkflowExecutionRequest, ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error)
 	StartWorkflowExecutionAsync(context.Context, *types.StartWorkflowExecutionAsyncRequest, ...yarpc.CallOption) (*types.StartWorkflowExecutionAsyncResponse, error)
 	TerminateWorkflowExecution(context.Context, *types.TerminateWorkflowExecutionRequest, ...yarpc.CallOption) error
+	TerminateWorkflowExecutionAsync(context.Context, *types.TerminateWorkflowExecutionAsyncRequest, ...yarpc.CallOption) (*types.TerminateWorkflowExecutionAsyncResponse, error)
 	UpdateDomain(context.Context, *types.UpdateDomainRequest, ...yarpc.CallOption) (*types.UpdateDomainResponse, error)
+	UpdateWorkflowMemo(context.Context, *types.UpdateWorkflowMemoRequest, ...yarpc.CallOption) (*types.UpdateWorkflowMemoResponse, error)
 }
@@ -101,6 +101,26 @@ func (mr *MockClientMockRecorder) DeprecateDomain(arg0, arg1 any, arg2 ...any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeprecateDomain", reflect.TypeOf((*MockClient)(nil).DeprecateDomain), varargs...)
 }
 
+// DescribeAsyncRequest mocks base method.
+func (m *MockClient) DescribeAsyncRequest(arg0 context.Context, arg1 *types.DescribeAsyncRequestRequest, arg2 ...yarpc.CallOption) (*types.DescribeAsyncRequestResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeAsyncRequest", varargs...)
+	ret0, _ := ret[0].(*types.DescribeAsyncRequestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAsyncRequest indicates an expected call of DescribeAsyncRequest.
+func (mr *MockClientMockRecorder) DescribeAsyncRequest(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAsyncRequest", reflect.TypeOf((*MockClient)(nil).DescribeAsyncRequest), varargs...)
+}
+
 // DescribeDomain mocks base method.
 func (m *MockClient) DescribeDomain(arg0 context.Context, arg1 *types.DescribeDomainRequest, arg2 ...yarpc.CallOption) (*types.DescribeDomainResponse, error) {
 	m.ctrl.T.Helper()
@@ -141,6 +161,26 @@ func (mr *MockClientMockRecorder) DescribeTaskList(arg0, arg1 any, arg2 ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskList", reflect.TypeOf((*MockClient)(nil).DescribeTaskList), varargs...)
 }
 
+// DescribeWorker mocks base method.
+func (m *MockClient) DescribeWorker(arg0 context.Context, arg1 *types.DescribeWorkerRequest, arg2 ...yarpc.CallOption) (*types.DescribeWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeWorker", varargs...)
+	ret0, _ := ret[0].(*types.DescribeWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorker indicates an expected call of DescribeWorker.
+func (mr *MockClientMockRecorder) DescribeWorker(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorker", reflect.TypeOf((*MockClient)(nil).DescribeWorker), varargs...)
+}
+
 // DescribeWorkflowExecution mocks base method.
 func (m *MockClient) DescribeWorkflowExecution(arg0 context.Context, arg1 *types.DescribeWorkflowExecutionRequest, arg2 ...yarpc.CallOption) (*types.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -221,6 +261,26 @@ func (mr *MockClientMockRecorder) GetSearchAttributes(arg0 any, arg1 ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchAttributes", reflect.TypeOf((*MockClient)(nil).GetSearchAttributes), varargs...)
 }
 
+// GetTaskListScalingRecommendation mocks base method.
+func (m *MockClient) GetTaskListScalingRecommendation(arg0 context.Context, arg1 *types.GetTaskListScalingRecommendationRequest, arg2 ...yarpc.CallOption) (*types.GetTaskListScalingRecommendationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTaskListScalingRecommendation", varargs...)
+	ret0, _ := ret[0].(*types.GetTaskListScalingRecommendationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskListScalingRecommendation indicates an expected call of GetTaskListScalingRecommendation.
+func (mr *MockClientMockRecorder) GetTaskListScalingRecommendation(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListScalingRecommendation", reflect.TypeOf((*MockClient)(nil).GetTaskListScalingRecommendation), varargs...)
+}
+
 // GetTaskListsByDomain mocks base method.
 func (m *MockClient) GetTaskListsByDomain(arg0 context.Context, arg1 *types.GetTaskListsByDomainRequest, arg2 ...yarpc.CallOption) (*types.GetTaskListsByDomainResponse, error) {
 	m.ctrl.T.Helper()
@@ -361,6 +421,26 @@ func (mr *MockClientMockRecorder) ListTaskListPartitions(arg0, arg1 any, arg2 ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskListPartitions", reflect.TypeOf((*MockClient)(nil).ListTaskListPartitions), varargs...)
 }
 
+// ListWorkers mocks base method.
+func (m *MockClient) ListWorkers(arg0 context.Context, arg1 *types.ListWorkersRequest, arg2 ...yarpc.CallOption) (*types.ListWorkersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWorkers", varargs...)
+	ret0, _ := ret[0].(*types.ListWorkersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkers indicates an expected call of ListWorkers.
+func (mr *MockClientMockRecorder) ListWorkers(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkers", reflect.TypeOf((*MockClient)(nil).ListWorkers), varargs...)
+}
+
 // ListWorkflowExecutions mocks base method.
 func (m *MockClient) ListWorkflowExecutions(arg0 context.Context, arg1 *types.ListWorkflowExecutionsRequest, arg2 ...yarpc.CallOption) (*types.ListWorkflowExecutionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -538,6 +618,26 @@ func (mr *MockClientMockRecorder) RequestCancelWorkflowExecution(arg0, arg1 any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancelWorkflowExecution", reflect.TypeOf((*MockClient)(nil).RequestCancelWorkflowExecution), varargs...)
 }
 
+// RequestCancelWorkflowExecutionAsync mocks base method.
+func (m *MockClient) RequestCancelWorkflowExecutionAsync(arg0 context.Context, arg1 *types.RequestCancelWorkflowExecutionAsyncRequest, arg2 ...yarpc.CallOption) (*types.RequestCancelWorkflowExecutionAsyncResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RequestCancelWorkflowExecutionAsync", varargs...)
+	ret0, _ := ret[0].(*types.RequestCancelWorkflowExecutionAsyncResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestCancelWorkflowExecutionAsync indicates an expected call of RequestCancelWorkflowExecutionAsync.
+func (mr *MockClientMockRecorder) RequestCancelWorkflowExecutionAsync(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancelWorkflowExecutionAsync", reflect.TypeOf((*MockClient)(nil).RequestCancelWorkflowExecutionAsync), varargs...)
+}
+
 // ResetStickyTaskList mocks base method.
 func (m *MockClient) ResetStickyTaskList(arg0 context.Context, arg1 *types.ResetStickyTaskListRequest, arg2 ...yarpc.CallOption) (*types.ResetStickyTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -849,6 +949,26 @@ func (mr *MockClientMockRecorder) SignalWorkflowExecution(arg0, arg1 any, arg2 .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalWorkflowExecution", reflect.TypeOf((*MockClient)(nil).SignalWorkflowExecution), varargs...)
 }
 
+// SignalWorkflowExecutionAsync mocks base method.
+func (m *MockClient) SignalWorkflowExecutionAsync(arg0 context.Context, arg1 *types.SignalWorkflowExecutionAsyncRequest, arg2 ...yarpc.CallOption) (*types.SignalWorkflowExecutionAsyncResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SignalWorkflowExecutionAsync", varargs...)
+	ret0, _ := ret[0].(*types.SignalWorkflowExecutionAsyncResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignalWorkflowExecutionAsync indicates an expected call of SignalWorkflowExecutionAsync.
+func (mr *MockClientMockRecorder) SignalWorkflowExecutionAsync(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalWorkflowExecutionAsync", reflect.TypeOf((*MockClient)(nil).SignalWorkflowExecutionAsync), varargs...)
+}
+
 // StartWorkflowExecution mocks base method.
 func (m *MockClient) StartWorkflowExecution(arg0 context.Context, arg1 *types.StartWorkflowExecutionRequest, arg2 ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -908,6 +1028,26 @@ func (mr *MockClientMockRecorder) TerminateWorkflowExecution(arg0, arg1 any, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockClient)(nil).TerminateWorkflowExecution), varargs...)
 }
 
+// TerminateWorkflowExecutionAsync mocks base method.
+func (m *MockClient) TerminateWorkflowExecutionAsync(arg0 context.Context, arg1 *types.TerminateWorkflowExecutionAsyncRequest, arg2 ...yarpc.CallOption) (*types.TerminateWorkflowExecutionAsyncResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TerminateWorkflowExecutionAsync", varargs...)
+	ret0, _ := ret[0].(*types.TerminateWorkflowExecutionAsyncResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TerminateWorkflowExecutionAsync indicates an expected call of TerminateWorkflowExecutionAsync.
+func (mr *MockClientMockRecorder) TerminateWorkflowExecutionAsync(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecutionAsync", reflect.TypeOf((*MockClient)(nil).TerminateWorkflowExecutionAsync), varargs...)
+}
+
 // UpdateDomain mocks base method.
 func (m *MockClient) UpdateDomain(arg0 context.Context, arg1 *types.UpdateDomainRequest, arg2 ...yarpc.CallOption) (*types.UpdateDomainResponse, error) {
 	m.ctrl.T.Helper()
@@ -927,3 +1067,23 @@ func (mr *MockClientMockRecorder) UpdateDomain(arg0, arg1 any, arg2 ...any) *gom
 	varargs := append([]any{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomain", reflect.TypeOf((*MockClient)(nil).UpdateDomain), varargs...)
 }
+
+// UpdateWorkflowMemo mocks base method.
+func (m *MockClient) UpdateWorkflowMemo(arg0 context.Context, arg1 *types.UpdateWorkflowMemoRequest, arg2 ...yarpc.CallOption) (*types.UpdateWorkflowMemoResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkflowMemo", varargs...)
+	ret0, _ := ret[0].(*types.UpdateWorkflowMemoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowMemo indicates an expected call of UpdateWorkflowMemo.
+func (mr *MockClientMockRecorder) UpdateWorkflowMemo(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowMemo", reflect.TypeOf((*MockClient)(nil).UpdateWorkflowMemo), varargs...)
+}
@@ -599,6 +599,22 @@ func (c *clientImpl) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *clientImpl) UpdateWorkflowMemo(
+	ctx context.Context,
+	request *types.HistoryUpdateWorkflowMemoRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.UpdateRequest.WorkflowExecution.WorkflowID)
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.UpdateWorkflowMemo(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
 func (c *clientImpl) ResetWorkflowExecution(
 	ctx context.Context,
 	request *types.HistoryResetWorkflowExecutionRequest,
@@ -652,6 +668,43 @@ func (c *clientImpl) RecordChildExecutionCompleted(
 	return err
 }
 
+func (c *clientImpl) GetCompletionCallbacks(
+	ctx context.Context,
+	request *types.GetCompletionCallbacksRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetCompletionCallbacksResponse, error) {
+	peer, err := c.peerResolver.FromWorkflowID(request.WorkflowExecution.WorkflowID)
+	if err != nil {
+		return nil, err
+	}
+	var response *types.GetCompletionCallbacksResponse
+	op := func(ctx context.Context, peer string) error {
+		response, err = c.client.GetCompletionCallbacks(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) RecordCompletionCallbackState(
+	ctx context.Context,
+	request *types.RecordCompletionCallbackStateRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.WorkflowExecution.WorkflowID)
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		return c.client.RecordCompletionCallbackState(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
+
 func (c *clientImpl) ReplicateEventsV2(
 	ctx context.Context,
 	request *types.ReplicateEventsV2Request,
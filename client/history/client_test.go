@@ -392,6 +392,20 @@ func TestClient_withResponse(t *testing.T) {
 			},
 			want: &types.HistoryQueryWorkflowResponse{},
 		},
+		{
+			name: "GetCompletionCallbacks",
+			op: func(c Client) (any, error) {
+				return c.GetCompletionCallbacks(context.Background(), &types.GetCompletionCallbacksRequest{
+					WorkflowExecution: &types.WorkflowExecution{WorkflowID: "test-workflow"},
+				})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().FromWorkflowID("test-workflow").Return("test-peer", nil).Times(1)
+				c.EXPECT().GetCompletionCallbacks(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer")}).
+					Return(&types.GetCompletionCallbacksResponse{}, nil).Times(1)
+			},
+			want: &types.GetCompletionCallbacksResponse{},
+		},
 		{
 			name: "ResetStickyTaskList",
 			op: func(c Client) (any, error) {
@@ -1027,6 +1041,34 @@ func TestClient_withNoResponse(t *testing.T) {
 					Return(nil).Times(1)
 			},
 		},
+		{
+			name: "RecordCompletionCallbackState",
+			op: func(c Client) error {
+				return c.RecordCompletionCallbackState(context.Background(), &types.RecordCompletionCallbackStateRequest{
+					WorkflowExecution: &types.WorkflowExecution{WorkflowID: "test-workflow"},
+				})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().FromWorkflowID("test-workflow").Return("test-peer", nil).Times(1)
+				c.EXPECT().RecordCompletionCallbackState(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer")}).
+					Return(nil).Times(1)
+			},
+		},
+		{
+			name: "UpdateWorkflowMemo",
+			op: func(c Client) error {
+				return c.UpdateWorkflowMemo(context.Background(), &types.HistoryUpdateWorkflowMemoRequest{
+					UpdateRequest: &types.UpdateWorkflowMemoRequest{
+						WorkflowExecution: &types.WorkflowExecution{WorkflowID: "test-workflow"},
+					},
+				})
+			},
+			mock: func(p *MockPeerResolver, c *MockClient) {
+				p.EXPECT().FromWorkflowID("test-workflow").Return("test-peer", nil).Times(1)
+				c.EXPECT().UpdateWorkflowMemo(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("test-peer")}).
+					Return(nil).Times(1)
+			},
+		},
 		{
 			name: "NotifyFailoverMarkers",
 			op: func(c Client) error {
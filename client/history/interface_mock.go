@@ -162,6 +162,26 @@ func (mr *MockClientMockRecorder) DescribeWorkflowExecution(arg0, arg1 any, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockClient)(nil).DescribeWorkflowExecution), varargs...)
 }
 
+// GetCompletionCallbacks mocks base method.
+func (m *MockClient) GetCompletionCallbacks(arg0 context.Context, arg1 *types.GetCompletionCallbacksRequest, arg2 ...yarpc.CallOption) (*types.GetCompletionCallbacksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCompletionCallbacks", varargs...)
+	ret0, _ := ret[0].(*types.GetCompletionCallbacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompletionCallbacks indicates an expected call of GetCompletionCallbacks.
+func (mr *MockClientMockRecorder) GetCompletionCallbacks(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompletionCallbacks", reflect.TypeOf((*MockClient)(nil).GetCompletionCallbacks), varargs...)
+}
+
 // GetCrossClusterTasks mocks base method.
 func (m *MockClient) GetCrossClusterTasks(arg0 context.Context, arg1 *types.GetCrossClusterTasksRequest, arg2 ...yarpc.CallOption) (*types.GetCrossClusterTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -478,6 +498,25 @@ func (mr *MockClientMockRecorder) RecordChildExecutionCompleted(arg0, arg1 any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordChildExecutionCompleted", reflect.TypeOf((*MockClient)(nil).RecordChildExecutionCompleted), varargs...)
 }
 
+// RecordCompletionCallbackState mocks base method.
+func (m *MockClient) RecordCompletionCallbackState(arg0 context.Context, arg1 *types.RecordCompletionCallbackStateRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RecordCompletionCallbackState", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordCompletionCallbackState indicates an expected call of RecordCompletionCallbackState.
+func (mr *MockClientMockRecorder) RecordCompletionCallbackState(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordCompletionCallbackState", reflect.TypeOf((*MockClient)(nil).RecordCompletionCallbackState), varargs...)
+}
+
 // RecordDecisionTaskStarted mocks base method.
 func (m *MockClient) RecordDecisionTaskStarted(arg0 context.Context, arg1 *types.RecordDecisionTaskStartedRequest, arg2 ...yarpc.CallOption) (*types.RecordDecisionTaskStartedResponse, error) {
 	m.ctrl.T.Helper()
@@ -902,3 +941,22 @@ func (mr *MockClientMockRecorder) TerminateWorkflowExecution(arg0, arg1 any, arg
 	varargs := append([]any{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockClient)(nil).TerminateWorkflowExecution), varargs...)
 }
+
+// UpdateWorkflowMemo mocks base method.
+func (m *MockClient) UpdateWorkflowMemo(arg0 context.Context, arg1 *types.HistoryUpdateWorkflowMemoRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkflowMemo", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkflowMemo indicates an expected call of UpdateWorkflowMemo.
+func (mr *MockClientMockRecorder) UpdateWorkflowMemo(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowMemo", reflect.TypeOf((*MockClient)(nil).UpdateWorkflowMemo), varargs...)
+}
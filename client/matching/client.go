@@ -22,6 +22,8 @@ package matching
 
 import (
 	"context"
+	"math"
+	"sort"
 
 	"go.uber.org/yarpc"
 
@@ -376,3 +378,209 @@ func (c *clientImpl) RefreshTaskListPartitionConfig(
 	}
 	return resp, nil
 }
+
+func (c *clientImpl) UpdateTaskListVersioningConfig(
+	ctx context.Context,
+	request *types.MatchingUpdateTaskListVersioningConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.MatchingUpdateTaskListVersioningConfigResponse, error) {
+	peer, err := c.peerResolver.FromTaskList(request.GetUpdateRequest().GetTaskList().GetName())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.UpdateTaskListVersioningConfig(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *clientImpl) MigrateTaskList(
+	ctx context.Context,
+	request *types.MatchingMigrateTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*types.MigrateTaskListResponse, error) {
+	peer, err := c.peerResolver.FromTaskList(request.GetMigrateRequest().GetTaskList().GetName())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.MigrateTaskList(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *clientImpl) GetTaskListScalingRecommendation(
+	ctx context.Context,
+	request *types.MatchingGetTaskListScalingRecommendationRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetTaskListScalingRecommendationResponse, error) {
+	peer, err := c.peerResolver.FromTaskList(request.GetRequest().GetTaskList().GetName())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetTaskListScalingRecommendation(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *clientImpl) ListWorkers(
+	ctx context.Context,
+	request *types.MatchingListWorkersRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListWorkersResponse, error) {
+	workers, err := c.collectWorkers(ctx, opts, func(opts []yarpc.CallOption) ([]*types.WorkerInfo, error) {
+		resp, err := c.client.ListWorkers(ctx, request, opts...)
+		return resp.GetWorkers(), err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.ListWorkersResponse{Workers: workers}, nil
+}
+
+func (c *clientImpl) DescribeWorker(
+	ctx context.Context,
+	request *types.MatchingDescribeWorkerRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeWorkerResponse, error) {
+	workers, err := c.collectWorkers(ctx, opts, func(opts []yarpc.CallOption) ([]*types.WorkerInfo, error) {
+		resp, err := c.client.DescribeWorker(ctx, request, opts...)
+		if resp.GetWorker() == nil {
+			return nil, err
+		}
+		return []*types.WorkerInfo{resp.GetWorker()}, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(workers) == 0 {
+		return nil, &types.EntityNotExistsError{
+			Message: "Worker " + request.GetRequest().GetIdentity() + " has not polled any task list of the domain recently.",
+		}
+	}
+	return &types.DescribeWorkerResponse{Worker: workers[0]}, nil
+}
+
+// DescribeDomainTaskQuota calls all matching hosts, since the task lists of a domain can be owned by any of them.
+// Limits are the same on all hosts, while the usage each host reports is summed up.
+func (c *clientImpl) DescribeDomainTaskQuota(
+	ctx context.Context,
+	request *types.MatchingDescribeDomainTaskQuotaRequest,
+	opts ...yarpc.CallOption,
+) (*types.MatchingDescribeDomainTaskQuotaResponse, error) {
+	peers, err := c.peerResolver.GetAllPeers()
+	if err != nil {
+		return nil, err
+	}
+
+	var futures []future.Future
+	for _, peer := range peers {
+		future, settable := future.NewFuture()
+		settable.Set(c.client.DescribeDomainTaskQuota(ctx, request, append(opts, yarpc.WithShardKey(peer))...))
+		futures = append(futures, future)
+	}
+
+	quota := &types.DomainTaskQuota{}
+	for i, future := range futures {
+		var resp *types.MatchingDescribeDomainTaskQuotaResponse
+		if err = future.Get(ctx, &resp); err != nil {
+			return nil, errors.NewPeerHostnameError(err, peers[i])
+		}
+		hostQuota := resp.GetTaskQuota()
+		quota.AddTaskRPSLimit = math.Max(quota.AddTaskRPSLimit, hostQuota.GetAddTaskRPSLimit())
+		quota.AddTaskRPS += hostQuota.GetAddTaskRPS()
+		quota.AddTaskRejectedRPS += hostQuota.GetAddTaskRejectedRPS()
+		quota.DispatchRPSLimit = math.Max(quota.DispatchRPSLimit, hostQuota.GetDispatchRPSLimit())
+		quota.DispatchRPS += hostQuota.GetDispatchRPS()
+		quota.DispatchRejectedRPS += hostQuota.GetDispatchRejectedRPS()
+	}
+	return &types.MatchingDescribeDomainTaskQuotaResponse{TaskQuota: quota}, nil
+}
+
+// collectWorkers calls all matching hosts, since the task lists polled by a worker can be owned by any of them,
+// and merges the workers they report by identity
+func (c *clientImpl) collectWorkers(
+	ctx context.Context,
+	opts []yarpc.CallOption,
+	call func([]yarpc.CallOption) ([]*types.WorkerInfo, error),
+) ([]*types.WorkerInfo, error) {
+	peers, err := c.peerResolver.GetAllPeers()
+	if err != nil {
+		return nil, err
+	}
+
+	var futures []future.Future
+	for _, peer := range peers {
+		future, settable := future.NewFuture()
+		settable.Set(call(append(opts, yarpc.WithShardKey(peer))))
+		futures = append(futures, future)
+	}
+
+	workerMap := make(map[string]*types.WorkerInfo)
+	for i, future := range futures {
+		var workers []*types.WorkerInfo
+		if err = future.Get(ctx, &workers); err != nil {
+			return nil, errors.NewPeerHostnameError(err, peers[i])
+		}
+		for _, worker := range workers {
+			workerMap[worker.GetIdentity()] = mergeWorkerInfo(workerMap[worker.GetIdentity()], worker)
+		}
+	}
+
+	result := make([]*types.WorkerInfo, 0, len(workerMap))
+	for _, worker := range workerMap {
+		result = append(result, worker)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].GetIdentity() < result[j].GetIdentity()
+	})
+	return result, nil
+}
+
+// mergeWorkerInfo combines the views of the same worker from two matching hosts:
+// poll rates add up, task lists are unioned and the version info of the latest poll wins
+func mergeWorkerInfo(existing, other *types.WorkerInfo) *types.WorkerInfo {
+	if existing == nil {
+		return other
+	}
+	merged := &types.WorkerInfo{
+		Identity:       existing.Identity,
+		VersionInfo:    existing.VersionInfo,
+		LastPollTime:   existing.LastPollTime,
+		PollsPerSecond: existing.PollsPerSecond + other.PollsPerSecond,
+	}
+	if other.GetLastPollTime() > existing.GetLastPollTime() {
+		merged.LastPollTime = other.LastPollTime
+		if other.VersionInfo != nil {
+			merged.VersionInfo = other.VersionInfo
+		}
+	}
+
+	type taskListKey struct {
+		name     string
+		taskType types.TaskListType
+	}
+	taskLists := make(map[taskListKey]*types.WorkerTaskListInfo)
+	for _, tls := range [][]*types.WorkerTaskListInfo{existing.TaskLists, other.TaskLists} {
+		for _, tl := range tls {
+			key := taskListKey{name: tl.GetTaskList().GetName(), taskType: tl.GetTaskListType()}
+			if current, ok := taskLists[key]; !ok || tl.GetLastPollTime() > current.GetLastPollTime() {
+				taskLists[key] = tl
+			}
+		}
+	}
+	for _, tl := range taskLists {
+		merged.TaskLists = append(merged.TaskLists, tl)
+	}
+	sort.Slice(merged.TaskLists, func(i, j int) bool {
+		if merged.TaskLists[i].GetTaskList().GetName() != merged.TaskLists[j].GetTaskList().GetName() {
+			return merged.TaskLists[i].GetTaskList().GetName() < merged.TaskLists[j].GetTaskList().GetName()
+		}
+		return merged.TaskLists[i].GetTaskListType() < merged.TaskLists[j].GetTaskListType()
+	})
+	return merged
+}
@@ -31,6 +31,7 @@ import (
 	"go.uber.org/mock/gomock"
 	"go.uber.org/yarpc"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/errors"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
@@ -532,6 +533,305 @@ func TestClient_withResponse(t *testing.T) {
 			want:      nil,
 			wantError: true,
 		},
+		{
+			name: "UpdateTaskListVersioningConfig",
+			op: func(c Client) (any, error) {
+				return c.UpdateTaskListVersioningConfig(context.Background(), testMatchingUpdateTaskListVersioningConfigRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", nil)
+				c.EXPECT().UpdateTaskListVersioningConfig(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("peer0")}).Return(&types.MatchingUpdateTaskListVersioningConfigResponse{}, nil)
+			},
+			want: &types.MatchingUpdateTaskListVersioningConfigResponse{},
+		},
+		{
+			name: "UpdateTaskListVersioningConfig - Error in resolving peer",
+			op: func(c Client) (any, error) {
+				return c.UpdateTaskListVersioningConfig(context.Background(), testMatchingUpdateTaskListVersioningConfigRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", assert.AnError)
+			},
+			want:      nil,
+			wantError: true,
+		},
+		{
+			name: "UpdateTaskListVersioningConfig - Error while updating versioning config",
+			op: func(c Client) (any, error) {
+				return c.UpdateTaskListVersioningConfig(context.Background(), testMatchingUpdateTaskListVersioningConfigRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", nil)
+				c.EXPECT().UpdateTaskListVersioningConfig(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("peer0")}).Return(nil, assert.AnError)
+			},
+			want:      nil,
+			wantError: true,
+		},
+		{
+			name: "MigrateTaskList",
+			op: func(c Client) (any, error) {
+				return c.MigrateTaskList(context.Background(), testMatchingMigrateTaskListRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", nil)
+				c.EXPECT().MigrateTaskList(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("peer0")}).Return(&types.MigrateTaskListResponse{}, nil)
+			},
+			want: &types.MigrateTaskListResponse{},
+		},
+		{
+			name: "MigrateTaskList - Error in resolving peer",
+			op: func(c Client) (any, error) {
+				return c.MigrateTaskList(context.Background(), testMatchingMigrateTaskListRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", assert.AnError)
+			},
+			want:      nil,
+			wantError: true,
+		},
+		{
+			name: "MigrateTaskList - Error while migrating task list",
+			op: func(c Client) (any, error) {
+				return c.MigrateTaskList(context.Background(), testMatchingMigrateTaskListRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", nil)
+				c.EXPECT().MigrateTaskList(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("peer0")}).Return(nil, assert.AnError)
+			},
+			want:      nil,
+			wantError: true,
+		},
+		{
+			name: "GetTaskListScalingRecommendation",
+			op: func(c Client) (any, error) {
+				return c.GetTaskListScalingRecommendation(context.Background(), testMatchingGetTaskListScalingRecommendationRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", nil)
+				c.EXPECT().GetTaskListScalingRecommendation(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("peer0")}).Return(&types.GetTaskListScalingRecommendationResponse{}, nil)
+			},
+			want: &types.GetTaskListScalingRecommendationResponse{},
+		},
+		{
+			name: "GetTaskListScalingRecommendation - Error in resolving peer",
+			op: func(c Client) (any, error) {
+				return c.GetTaskListScalingRecommendation(context.Background(), testMatchingGetTaskListScalingRecommendationRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", assert.AnError)
+			},
+			want:      nil,
+			wantError: true,
+		},
+		{
+			name: "GetTaskListScalingRecommendation - Error while getting recommendation",
+			op: func(c Client) (any, error) {
+				return c.GetTaskListScalingRecommendation(context.Background(), testMatchingGetTaskListScalingRecommendationRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().FromTaskList(_testTaskList).Return("peer0", nil)
+				c.EXPECT().GetTaskListScalingRecommendation(gomock.Any(), gomock.Any(), []yarpc.CallOption{yarpc.WithShardKey("peer0")}).Return(nil, assert.AnError)
+			},
+			want:      nil,
+			wantError: true,
+		},
+		{
+			name: "ListWorkers",
+			op: func(c Client) (any, error) {
+				return c.ListWorkers(context.Background(), testMatchingListWorkersRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().GetAllPeers().Return([]string{"peer0", "peer1"}, nil)
+				c.EXPECT().ListWorkers(gomock.Any(), testMatchingListWorkersRequest(), []yarpc.CallOption{yarpc.WithShardKey("peer0")}).Return(&types.ListWorkersResponse{
+					Workers: []*types.WorkerInfo{
+						{
+							Identity:       "worker-b",
+							VersionInfo:    &types.WorkerVersionInfo{Impl: "uber-go", FeatureVersion: "1.0.0"},
+							TaskLists:      []*types.WorkerTaskListInfo{testWorkerTaskListInfo("tl-b", types.TaskListTypeDecision, 10)},
+							LastPollTime:   common.Int64Ptr(10),
+							PollsPerSecond: 1,
+						},
+						{
+							Identity:       "worker-a",
+							TaskLists:      []*types.WorkerTaskListInfo{testWorkerTaskListInfo("tl-a", types.TaskListTypeDecision, 5)},
+							LastPollTime:   common.Int64Ptr(5),
+							PollsPerSecond: 2,
+						},
+					},
+				}, nil)
+				c.EXPECT().ListWorkers(gomock.Any(), testMatchingListWorkersRequest(), []yarpc.CallOption{yarpc.WithShardKey("peer1")}).Return(&types.ListWorkersResponse{
+					Workers: []*types.WorkerInfo{
+						{
+							Identity:    "worker-b",
+							VersionInfo: &types.WorkerVersionInfo{Impl: "uber-go", FeatureVersion: "1.1.0"},
+							TaskLists: []*types.WorkerTaskListInfo{
+								testWorkerTaskListInfo("tl-b", types.TaskListTypeDecision, 20),
+								testWorkerTaskListInfo("tl-a", types.TaskListTypeActivity, 15),
+							},
+							LastPollTime:   common.Int64Ptr(20),
+							PollsPerSecond: 0.5,
+						},
+					},
+				}, nil)
+			},
+			want: &types.ListWorkersResponse{
+				Workers: []*types.WorkerInfo{
+					{
+						Identity:       "worker-a",
+						TaskLists:      []*types.WorkerTaskListInfo{testWorkerTaskListInfo("tl-a", types.TaskListTypeDecision, 5)},
+						LastPollTime:   common.Int64Ptr(5),
+						PollsPerSecond: 2,
+					},
+					{
+						Identity:    "worker-b",
+						VersionInfo: &types.WorkerVersionInfo{Impl: "uber-go", FeatureVersion: "1.1.0"},
+						TaskLists: []*types.WorkerTaskListInfo{
+							testWorkerTaskListInfo("tl-a", types.TaskListTypeActivity, 15),
+							testWorkerTaskListInfo("tl-b", types.TaskListTypeDecision, 20),
+						},
+						LastPollTime:   common.Int64Ptr(20),
+						PollsPerSecond: 1.5,
+					},
+				},
+			},
+		},
+		{
+			name: "ListWorkers - Error in resolving peer",
+			op: func(c Client) (any, error) {
+				return c.ListWorkers(context.Background(), testMatchingListWorkersRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().GetAllPeers().Return(nil, assert.AnError)
+			},
+			want:      nil,
+			wantError: true,
+		},
+		{
+			name: "ListWorkers - Error from a peer",
+			op: func(c Client) (any, error) {
+				return c.ListWorkers(context.Background(), testMatchingListWorkersRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().GetAllPeers().Return([]string{"peer0", "peer1"}, nil)
+				c.EXPECT().ListWorkers(gomock.Any(), testMatchingListWorkersRequest(), []yarpc.CallOption{yarpc.WithShardKey("peer0")}).Return(&types.ListWorkersResponse{}, nil)
+				c.EXPECT().ListWorkers(gomock.Any(), testMatchingListWorkersRequest(), []yarpc.CallOption{yarpc.WithShardKey("peer1")}).Return(nil, assert.AnError)
+			},
+			want:      nil,
+			wantError: true,
+			validateError: func(t *testing.T, err error) {
+				var peerErr *errors.PeerHostnameError
+				assert.True(t, stdErrors.As(err, &peerErr))
+				assert.Equal(t, "peer1", peerErr.PeerHostname)
+			},
+		},
+		{
+			name: "DescribeWorker",
+			op: func(c Client) (any, error) {
+				return c.DescribeWorker(context.Background(), testMatchingDescribeWorkerRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().GetAllPeers().Return([]string{"peer0", "peer1"}, nil)
+				c.EXPECT().DescribeWorker(gomock.Any(), testMatchingDescribeWorkerRequest(), []yarpc.CallOption{yarpc.WithShardKey("peer0")}).Return(&types.DescribeWorkerResponse{
+					Worker: &types.WorkerInfo{
+						Identity:       "worker-a",
+						TaskLists:      []*types.WorkerTaskListInfo{testWorkerTaskListInfo("tl-a", types.TaskListTypeDecision, 5)},
+						LastPollTime:   common.Int64Ptr(5),
+						PollsPerSecond: 2,
+					},
+				}, nil)
+				c.EXPECT().DescribeWorker(gomock.Any(), testMatchingDescribeWorkerRequest(), []yarpc.CallOption{yarpc.WithShardKey("peer1")}).Return(&types.DescribeWorkerResponse{}, nil)
+			},
+			want: &types.DescribeWorkerResponse{
+				Worker: &types.WorkerInfo{
+					Identity:       "worker-a",
+					TaskLists:      []*types.WorkerTaskListInfo{testWorkerTaskListInfo("tl-a", types.TaskListTypeDecision, 5)},
+					LastPollTime:   common.Int64Ptr(5),
+					PollsPerSecond: 2,
+				},
+			},
+		},
+		{
+			name: "DescribeWorker - Worker not found",
+			op: func(c Client) (any, error) {
+				return c.DescribeWorker(context.Background(), testMatchingDescribeWorkerRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().GetAllPeers().Return([]string{"peer0"}, nil)
+				c.EXPECT().DescribeWorker(gomock.Any(), testMatchingDescribeWorkerRequest(), []yarpc.CallOption{yarpc.WithShardKey("peer0")}).Return(&types.DescribeWorkerResponse{}, nil)
+			},
+			want:      nil,
+			wantError: true,
+			validateError: func(t *testing.T, err error) {
+				var notExistsErr *types.EntityNotExistsError
+				assert.True(t, stdErrors.As(err, &notExistsErr))
+			},
+		},
+		{
+			name: "DescribeDomainTaskQuota",
+			op: func(c Client) (any, error) {
+				return c.DescribeDomainTaskQuota(context.Background(), testMatchingDescribeDomainTaskQuotaRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().GetAllPeers().Return([]string{"peer0", "peer1"}, nil)
+				c.EXPECT().DescribeDomainTaskQuota(gomock.Any(), testMatchingDescribeDomainTaskQuotaRequest(), []yarpc.CallOption{yarpc.WithShardKey("peer0")}).Return(&types.MatchingDescribeDomainTaskQuotaResponse{
+					TaskQuota: &types.DomainTaskQuota{
+						AddTaskRPSLimit:     100,
+						AddTaskRPS:          30,
+						AddTaskRejectedRPS:  1,
+						DispatchRPSLimit:    50,
+						DispatchRPS:         20,
+						DispatchRejectedRPS: 2,
+					},
+				}, nil)
+				c.EXPECT().DescribeDomainTaskQuota(gomock.Any(), testMatchingDescribeDomainTaskQuotaRequest(), []yarpc.CallOption{yarpc.WithShardKey("peer1")}).Return(&types.MatchingDescribeDomainTaskQuotaResponse{
+					TaskQuota: &types.DomainTaskQuota{
+						AddTaskRPSLimit:  100,
+						AddTaskRPS:       10,
+						DispatchRPSLimit: 50,
+						DispatchRPS:      25,
+					},
+				}, nil)
+			},
+			want: &types.MatchingDescribeDomainTaskQuotaResponse{
+				TaskQuota: &types.DomainTaskQuota{
+					AddTaskRPSLimit:     100,
+					AddTaskRPS:          40,
+					AddTaskRejectedRPS:  1,
+					DispatchRPSLimit:    50,
+					DispatchRPS:         45,
+					DispatchRejectedRPS: 2,
+				},
+			},
+		},
+		{
+			name: "DescribeDomainTaskQuota - Error in resolving peer",
+			op: func(c Client) (any, error) {
+				return c.DescribeDomainTaskQuota(context.Background(), testMatchingDescribeDomainTaskQuotaRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().GetAllPeers().Return(nil, assert.AnError)
+			},
+			want:      nil,
+			wantError: true,
+		},
+		{
+			name: "DescribeDomainTaskQuota - Error from a peer",
+			op: func(c Client) (any, error) {
+				return c.DescribeDomainTaskQuota(context.Background(), testMatchingDescribeDomainTaskQuotaRequest())
+			},
+			mock: func(p *MockPeerResolver, balancer *MockLoadBalancer, c *MockClient, mp *MockPartitionConfigProvider) {
+				p.EXPECT().GetAllPeers().Return([]string{"peer0", "peer1"}, nil)
+				c.EXPECT().DescribeDomainTaskQuota(gomock.Any(), testMatchingDescribeDomainTaskQuotaRequest(), []yarpc.CallOption{yarpc.WithShardKey("peer0")}).Return(nil, assert.AnError)
+				c.EXPECT().DescribeDomainTaskQuota(gomock.Any(), testMatchingDescribeDomainTaskQuotaRequest(), []yarpc.CallOption{yarpc.WithShardKey("peer1")}).Return(&types.MatchingDescribeDomainTaskQuotaResponse{}, nil)
+			},
+			want:      nil,
+			wantError: true,
+			validateError: func(t *testing.T, err error) {
+				var peerErr *errors.PeerHostnameError
+				assert.True(t, stdErrors.As(err, &peerErr))
+				assert.Equal(t, "peer0", peerErr.PeerHostname)
+			},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -676,3 +976,62 @@ func testMatchingRefreshTaskListPartitionConfigRequest() *types.MatchingRefreshT
 		},
 	}
 }
+
+func testMatchingUpdateTaskListVersioningConfigRequest() *types.MatchingUpdateTaskListVersioningConfigRequest {
+	return &types.MatchingUpdateTaskListVersioningConfigRequest{
+		DomainUUID: _testDomainUUID,
+		UpdateRequest: &types.UpdateTaskListVersioningConfigRequest{
+			TaskList:             &types.TaskList{Name: _testTaskList},
+			AddNewDefaultBuildID: "build-1",
+		},
+	}
+}
+
+func testMatchingMigrateTaskListRequest() *types.MatchingMigrateTaskListRequest {
+	return &types.MatchingMigrateTaskListRequest{
+		DomainUUID: _testDomainUUID,
+		MigrateRequest: &types.MigrateTaskListRequest{
+			TaskList:       &types.TaskList{Name: _testTaskList},
+			TaskListType:   types.TaskListTypeActivity.Ptr(),
+			TargetTaskList: &types.TaskList{Name: "target-tl"},
+		},
+	}
+}
+
+func testMatchingGetTaskListScalingRecommendationRequest() *types.MatchingGetTaskListScalingRecommendationRequest {
+	return &types.MatchingGetTaskListScalingRecommendationRequest{
+		DomainUUID: _testDomainUUID,
+		Request: &types.GetTaskListScalingRecommendationRequest{
+			TaskList:     &types.TaskList{Name: _testTaskList},
+			TaskListType: types.TaskListTypeDecision.Ptr(),
+		},
+	}
+}
+
+func testMatchingListWorkersRequest() *types.MatchingListWorkersRequest {
+	return &types.MatchingListWorkersRequest{
+		DomainUUID: _testDomainUUID,
+		Request:    &types.ListWorkersRequest{Domain: _testDomain},
+	}
+}
+
+func testMatchingDescribeWorkerRequest() *types.MatchingDescribeWorkerRequest {
+	return &types.MatchingDescribeWorkerRequest{
+		DomainUUID: _testDomainUUID,
+		Request:    &types.DescribeWorkerRequest{Domain: _testDomain, Identity: "worker-a"},
+	}
+}
+
+func testMatchingDescribeDomainTaskQuotaRequest() *types.MatchingDescribeDomainTaskQuotaRequest {
+	return &types.MatchingDescribeDomainTaskQuotaRequest{
+		DomainUUID: _testDomainUUID,
+	}
+}
+
+func testWorkerTaskListInfo(name string, taskListType types.TaskListType, lastPollTime int64) *types.WorkerTaskListInfo {
+	return &types.WorkerTaskListInfo{
+		TaskList:     &types.TaskList{Name: name},
+		TaskListType: taskListType.Ptr(),
+		LastPollTime: common.Int64Ptr(lastPollTime),
+	}
+}
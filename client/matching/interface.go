@@ -50,4 +50,10 @@ type Client interface {
 	RespondQueryTaskCompleted(context.Context, *types.MatchingRespondQueryTaskCompletedRequest, ...yarpc.CallOption) error
 	UpdateTaskListPartitionConfig(context.Context, *types.MatchingUpdateTaskListPartitionConfigRequest, ...yarpc.CallOption) (*types.MatchingUpdateTaskListPartitionConfigResponse, error)
 	RefreshTaskListPartitionConfig(context.Context, *types.MatchingRefreshTaskListPartitionConfigRequest, ...yarpc.CallOption) (*types.MatchingRefreshTaskListPartitionConfigResponse, error)
+	UpdateTaskListVersioningConfig(context.Context, *types.MatchingUpdateTaskListVersioningConfigRequest, ...yarpc.CallOption) (*types.MatchingUpdateTaskListVersioningConfigResponse, error)
+	GetTaskListScalingRecommendation(context.Context, *types.MatchingGetTaskListScalingRecommendationRequest, ...yarpc.CallOption) (*types.GetTaskListScalingRecommendationResponse, error)
+	ListWorkers(context.Context, *types.MatchingListWorkersRequest, ...yarpc.CallOption) (*types.ListWorkersResponse, error)
+	DescribeWorker(context.Context, *types.MatchingDescribeWorkerRequest, ...yarpc.CallOption) (*types.DescribeWorkerResponse, error)
+	MigrateTaskList(context.Context, *types.MatchingMigrateTaskListRequest, ...yarpc.CallOption) (*types.MigrateTaskListResponse, error)
+	DescribeDomainTaskQuota(context.Context, *types.MatchingDescribeDomainTaskQuotaRequest, ...yarpc.CallOption) (*types.MatchingDescribeDomainTaskQuotaResponse, error)
 }
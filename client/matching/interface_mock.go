@@ -102,6 +102,26 @@ func (mr *MockClientMockRecorder) CancelOutstandingPoll(arg0, arg1 any, arg2 ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOutstandingPoll", reflect.TypeOf((*MockClient)(nil).CancelOutstandingPoll), varargs...)
 }
 
+// DescribeDomainTaskQuota mocks base method.
+func (m *MockClient) DescribeDomainTaskQuota(arg0 context.Context, arg1 *types.MatchingDescribeDomainTaskQuotaRequest, arg2 ...yarpc.CallOption) (*types.MatchingDescribeDomainTaskQuotaResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeDomainTaskQuota", varargs...)
+	ret0, _ := ret[0].(*types.MatchingDescribeDomainTaskQuotaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDomainTaskQuota indicates an expected call of DescribeDomainTaskQuota.
+func (mr *MockClientMockRecorder) DescribeDomainTaskQuota(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDomainTaskQuota", reflect.TypeOf((*MockClient)(nil).DescribeDomainTaskQuota), varargs...)
+}
+
 // DescribeTaskList mocks base method.
 func (m *MockClient) DescribeTaskList(arg0 context.Context, arg1 *types.MatchingDescribeTaskListRequest, arg2 ...yarpc.CallOption) (*types.DescribeTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -122,6 +142,46 @@ func (mr *MockClientMockRecorder) DescribeTaskList(arg0, arg1 any, arg2 ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskList", reflect.TypeOf((*MockClient)(nil).DescribeTaskList), varargs...)
 }
 
+// DescribeWorker mocks base method.
+func (m *MockClient) DescribeWorker(arg0 context.Context, arg1 *types.MatchingDescribeWorkerRequest, arg2 ...yarpc.CallOption) (*types.DescribeWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeWorker", varargs...)
+	ret0, _ := ret[0].(*types.DescribeWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorker indicates an expected call of DescribeWorker.
+func (mr *MockClientMockRecorder) DescribeWorker(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorker", reflect.TypeOf((*MockClient)(nil).DescribeWorker), varargs...)
+}
+
+// GetTaskListScalingRecommendation mocks base method.
+func (m *MockClient) GetTaskListScalingRecommendation(arg0 context.Context, arg1 *types.MatchingGetTaskListScalingRecommendationRequest, arg2 ...yarpc.CallOption) (*types.GetTaskListScalingRecommendationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTaskListScalingRecommendation", varargs...)
+	ret0, _ := ret[0].(*types.GetTaskListScalingRecommendationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskListScalingRecommendation indicates an expected call of GetTaskListScalingRecommendation.
+func (mr *MockClientMockRecorder) GetTaskListScalingRecommendation(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListScalingRecommendation", reflect.TypeOf((*MockClient)(nil).GetTaskListScalingRecommendation), varargs...)
+}
+
 // GetTaskListsByDomain mocks base method.
 func (m *MockClient) GetTaskListsByDomain(arg0 context.Context, arg1 *types.GetTaskListsByDomainRequest, arg2 ...yarpc.CallOption) (*types.GetTaskListsByDomainResponse, error) {
 	m.ctrl.T.Helper()
@@ -162,6 +222,46 @@ func (mr *MockClientMockRecorder) ListTaskListPartitions(arg0, arg1 any, arg2 ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskListPartitions", reflect.TypeOf((*MockClient)(nil).ListTaskListPartitions), varargs...)
 }
 
+// ListWorkers mocks base method.
+func (m *MockClient) ListWorkers(arg0 context.Context, arg1 *types.MatchingListWorkersRequest, arg2 ...yarpc.CallOption) (*types.ListWorkersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWorkers", varargs...)
+	ret0, _ := ret[0].(*types.ListWorkersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkers indicates an expected call of ListWorkers.
+func (mr *MockClientMockRecorder) ListWorkers(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkers", reflect.TypeOf((*MockClient)(nil).ListWorkers), varargs...)
+}
+
+// MigrateTaskList mocks base method.
+func (m *MockClient) MigrateTaskList(arg0 context.Context, arg1 *types.MatchingMigrateTaskListRequest, arg2 ...yarpc.CallOption) (*types.MigrateTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MigrateTaskList", varargs...)
+	ret0, _ := ret[0].(*types.MigrateTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateTaskList indicates an expected call of MigrateTaskList.
+func (mr *MockClientMockRecorder) MigrateTaskList(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateTaskList", reflect.TypeOf((*MockClient)(nil).MigrateTaskList), varargs...)
+}
+
 // PollForActivityTask mocks base method.
 func (m *MockClient) PollForActivityTask(arg0 context.Context, arg1 *types.MatchingPollForActivityTaskRequest, arg2 ...yarpc.CallOption) (*types.MatchingPollForActivityTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -280,3 +380,23 @@ func (mr *MockClientMockRecorder) UpdateTaskListPartitionConfig(arg0, arg1 any,
 	varargs := append([]any{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListPartitionConfig", reflect.TypeOf((*MockClient)(nil).UpdateTaskListPartitionConfig), varargs...)
 }
+
+// UpdateTaskListVersioningConfig mocks base method.
+func (m *MockClient) UpdateTaskListVersioningConfig(arg0 context.Context, arg1 *types.MatchingUpdateTaskListVersioningConfigRequest, arg2 ...yarpc.CallOption) (*types.MatchingUpdateTaskListVersioningConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTaskListVersioningConfig", varargs...)
+	ret0, _ := ret[0].(*types.MatchingUpdateTaskListVersioningConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskListVersioningConfig indicates an expected call of UpdateTaskListVersioningConfig.
+func (mr *MockClientMockRecorder) UpdateTaskListVersioningConfig(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListVersioningConfig", reflect.TypeOf((*MockClient)(nil).UpdateTaskListVersioningConfig), varargs...)
+}
@@ -39,4 +39,11 @@ import (
 
 type Client interface {
 	GetShardOwner(context.Context, *types.GetShardOwnerRequest, ...yarpc.CallOption) (*types.GetShardOwnerResponse, error)
+	WatchNamespaceState(context.Context, *types.WatchNamespaceStateRequest, ...yarpc.CallOption) (*types.WatchNamespaceStateResponse, error)
+
+	ListNamespaces(context.Context, *types.ListNamespacesRequest, ...yarpc.CallOption) (*types.ListNamespacesResponse, error)
+	DescribeNamespace(context.Context, *types.DescribeNamespaceRequest, ...yarpc.CallOption) (*types.DescribeNamespaceResponse, error)
+	MoveShard(context.Context, *types.MoveShardRequest, ...yarpc.CallOption) (*types.MoveShardResponse, error)
+	SetExecutorDrained(context.Context, *types.SetExecutorDrainedRequest, ...yarpc.CallOption) (*types.SetExecutorDrainedResponse, error)
+	SetRebalancingFrozen(context.Context, *types.SetRebalancingFrozenRequest, ...yarpc.CallOption) (*types.SetRebalancingFrozenResponse, error)
 }
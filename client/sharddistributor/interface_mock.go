@@ -43,6 +43,26 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// DescribeNamespace mocks base method.
+func (m *MockClient) DescribeNamespace(arg0 context.Context, arg1 *types.DescribeNamespaceRequest, arg2 ...yarpc.CallOption) (*types.DescribeNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeNamespace", varargs...)
+	ret0, _ := ret[0].(*types.DescribeNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNamespace indicates an expected call of DescribeNamespace.
+func (mr *MockClientMockRecorder) DescribeNamespace(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNamespace", reflect.TypeOf((*MockClient)(nil).DescribeNamespace), varargs...)
+}
+
 // GetShardOwner mocks base method.
 func (m *MockClient) GetShardOwner(arg0 context.Context, arg1 *types.GetShardOwnerRequest, arg2 ...yarpc.CallOption) (*types.GetShardOwnerResponse, error) {
 	m.ctrl.T.Helper()
@@ -62,3 +82,103 @@ func (mr *MockClientMockRecorder) GetShardOwner(arg0, arg1 any, arg2 ...any) *go
 	varargs := append([]any{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShardOwner", reflect.TypeOf((*MockClient)(nil).GetShardOwner), varargs...)
 }
+
+// ListNamespaces mocks base method.
+func (m *MockClient) ListNamespaces(arg0 context.Context, arg1 *types.ListNamespacesRequest, arg2 ...yarpc.CallOption) (*types.ListNamespacesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNamespaces", varargs...)
+	ret0, _ := ret[0].(*types.ListNamespacesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNamespaces indicates an expected call of ListNamespaces.
+func (mr *MockClientMockRecorder) ListNamespaces(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespaces", reflect.TypeOf((*MockClient)(nil).ListNamespaces), varargs...)
+}
+
+// MoveShard mocks base method.
+func (m *MockClient) MoveShard(arg0 context.Context, arg1 *types.MoveShardRequest, arg2 ...yarpc.CallOption) (*types.MoveShardResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveShard", varargs...)
+	ret0, _ := ret[0].(*types.MoveShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveShard indicates an expected call of MoveShard.
+func (mr *MockClientMockRecorder) MoveShard(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveShard", reflect.TypeOf((*MockClient)(nil).MoveShard), varargs...)
+}
+
+// SetExecutorDrained mocks base method.
+func (m *MockClient) SetExecutorDrained(arg0 context.Context, arg1 *types.SetExecutorDrainedRequest, arg2 ...yarpc.CallOption) (*types.SetExecutorDrainedResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetExecutorDrained", varargs...)
+	ret0, _ := ret[0].(*types.SetExecutorDrainedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetExecutorDrained indicates an expected call of SetExecutorDrained.
+func (mr *MockClientMockRecorder) SetExecutorDrained(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExecutorDrained", reflect.TypeOf((*MockClient)(nil).SetExecutorDrained), varargs...)
+}
+
+// SetRebalancingFrozen mocks base method.
+func (m *MockClient) SetRebalancingFrozen(arg0 context.Context, arg1 *types.SetRebalancingFrozenRequest, arg2 ...yarpc.CallOption) (*types.SetRebalancingFrozenResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetRebalancingFrozen", varargs...)
+	ret0, _ := ret[0].(*types.SetRebalancingFrozenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRebalancingFrozen indicates an expected call of SetRebalancingFrozen.
+func (mr *MockClientMockRecorder) SetRebalancingFrozen(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRebalancingFrozen", reflect.TypeOf((*MockClient)(nil).SetRebalancingFrozen), varargs...)
+}
+
+// WatchNamespaceState mocks base method.
+func (m *MockClient) WatchNamespaceState(arg0 context.Context, arg1 *types.WatchNamespaceStateRequest, arg2 ...yarpc.CallOption) (*types.WatchNamespaceStateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WatchNamespaceState", varargs...)
+	ret0, _ := ret[0].(*types.WatchNamespaceStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchNamespaceState indicates an expected call of WatchNamespaceState.
+func (mr *MockClientMockRecorder) WatchNamespaceState(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchNamespaceState", reflect.TypeOf((*MockClient)(nil).WatchNamespaceState), varargs...)
+}
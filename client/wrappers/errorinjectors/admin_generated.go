@@ -120,6 +120,26 @@ func (c *adminClient) DeleteWorkflow(ctx context.Context, ap1 *types.AdminDelete
 	return
 }
 
+func (c *adminClient) DescribeAsyncWorkflowQueue(ctx context.Context, dp1 *types.DescribeAsyncWorkflowQueueRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeAsyncWorkflowQueueResponse, err error) {
+	fakeErr := c.fakeErrFn(c.errorRate)
+	var forwardCall bool
+	if forwardCall = c.forwardCallFn(fakeErr); forwardCall {
+		dp2, err = c.client.DescribeAsyncWorkflowQueue(ctx, dp1, p1...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgAdminInjectedFakeErr,
+			tag.AdminClientOperationDescribeAsyncWorkflowQueue,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(err),
+		)
+		err = fakeErr
+		return
+	}
+	return
+}
+
 func (c *adminClient) DescribeCluster(ctx context.Context, p1 ...yarpc.CallOption) (dp1 *types.DescribeClusterResponse, err error) {
 	fakeErr := c.fakeErrFn(c.errorRate)
 	var forwardCall bool
@@ -440,6 +460,46 @@ func (c *adminClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQM
 	return
 }
 
+func (c *adminClient) MigrateTaskList(ctx context.Context, request *types.MigrateTaskListRequest, opts ...yarpc.CallOption) (mp1 *types.MigrateTaskListResponse, err error) {
+	fakeErr := c.fakeErrFn(c.errorRate)
+	var forwardCall bool
+	if forwardCall = c.forwardCallFn(fakeErr); forwardCall {
+		mp1, err = c.client.MigrateTaskList(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgAdminInjectedFakeErr,
+			tag.AdminClientOperationMigrateTaskList,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(err),
+		)
+		err = fakeErr
+		return
+	}
+	return
+}
+
+func (c *adminClient) PurgeAsyncWorkflowDLQMessages(ctx context.Context, request *types.PurgeAsyncWorkflowDLQMessagesRequest, opts ...yarpc.CallOption) (pp1 *types.PurgeAsyncWorkflowDLQMessagesResponse, err error) {
+	fakeErr := c.fakeErrFn(c.errorRate)
+	var forwardCall bool
+	if forwardCall = c.forwardCallFn(fakeErr); forwardCall {
+		pp1, err = c.client.PurgeAsyncWorkflowDLQMessages(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgAdminInjectedFakeErr,
+			tag.AdminClientOperationPurgeAsyncWorkflowDLQMessages,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(err),
+		)
+		err = fakeErr
+		return
+	}
+	return
+}
+
 func (c *adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest, p1 ...yarpc.CallOption) (err error) {
 	fakeErr := c.fakeErrFn(c.errorRate)
 	var forwardCall bool
@@ -460,6 +520,26 @@ func (c *adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQM
 	return
 }
 
+func (c *adminClient) ReadAsyncWorkflowDLQMessages(ctx context.Context, request *types.ReadAsyncWorkflowDLQMessagesRequest, opts ...yarpc.CallOption) (rp1 *types.ReadAsyncWorkflowDLQMessagesResponse, err error) {
+	fakeErr := c.fakeErrFn(c.errorRate)
+	var forwardCall bool
+	if forwardCall = c.forwardCallFn(fakeErr); forwardCall {
+		rp1, err = c.client.ReadAsyncWorkflowDLQMessages(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgAdminInjectedFakeErr,
+			tag.AdminClientOperationReadAsyncWorkflowDLQMessages,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(err),
+		)
+		err = fakeErr
+		return
+	}
+	return
+}
+
 func (c *adminClient) ReadDLQMessages(ctx context.Context, rp1 *types.ReadDLQMessagesRequest, p1 ...yarpc.CallOption) (rp2 *types.ReadDLQMessagesResponse, err error) {
 	fakeErr := c.fakeErrFn(c.errorRate)
 	var forwardCall bool
@@ -540,6 +620,26 @@ func (c *adminClient) RemoveTask(ctx context.Context, rp1 *types.RemoveTaskReque
 	return
 }
 
+func (c *adminClient) ReplayAsyncWorkflowDLQMessages(ctx context.Context, request *types.ReplayAsyncWorkflowDLQMessagesRequest, opts ...yarpc.CallOption) (rp1 *types.ReplayAsyncWorkflowDLQMessagesResponse, err error) {
+	fakeErr := c.fakeErrFn(c.errorRate)
+	var forwardCall bool
+	if forwardCall = c.forwardCallFn(fakeErr); forwardCall {
+		rp1, err = c.client.ReplayAsyncWorkflowDLQMessages(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgAdminInjectedFakeErr,
+			tag.AdminClientOperationReplayAsyncWorkflowDLQMessages,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(err),
+		)
+		err = fakeErr
+		return
+	}
+	return
+}
+
 func (c *adminClient) ResendReplicationTasks(ctx context.Context, rp1 *types.ResendReplicationTasksRequest, p1 ...yarpc.CallOption) (err error) {
 	fakeErr := c.fakeErrFn(c.errorRate)
 	var forwardCall bool
@@ -699,3 +799,23 @@ func (c *adminClient) UpdateTaskListPartitionConfig(ctx context.Context, request
 	}
 	return
 }
+
+func (c *adminClient) UpdateTaskListVersioningConfig(ctx context.Context, request *types.UpdateTaskListVersioningConfigRequest, opts ...yarpc.CallOption) (up1 *types.UpdateTaskListVersioningConfigResponse, err error) {
+	fakeErr := c.fakeErrFn(c.errorRate)
+	var forwardCall bool
+	if forwardCall = c.forwardCallFn(fakeErr); forwardCall {
+		up1, err = c.client.UpdateTaskListVersioningConfig(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgAdminInjectedFakeErr,
+			tag.AdminClientOperationUpdateTaskListVersioningConfig,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(err),
+		)
+		err = fakeErr
+		return
+	}
+	return
+}
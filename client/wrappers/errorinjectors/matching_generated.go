@@ -100,6 +100,26 @@ func (c *matchingClient) CancelOutstandingPoll(ctx context.Context, cp1 *types.C
 	return
 }
 
+func (c *matchingClient) DescribeDomainTaskQuota(ctx context.Context, mp1 *types.MatchingDescribeDomainTaskQuotaRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingDescribeDomainTaskQuotaResponse, err error) {
+	fakeErr := c.fakeErrFn(c.errorRate)
+	var forwardCall bool
+	if forwardCall = c.forwardCallFn(fakeErr); forwardCall {
+		mp2, err = c.client.DescribeDomainTaskQuota(ctx, mp1, p1...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgMatchingInjectedFakeErr,
+			tag.MatchingClientOperationDescribeDomainTaskQuota,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(err),
+		)
+		err = fakeErr
+		return
+	}
+	return
+}
+
 func (c *matchingClient) DescribeTaskList(ctx context.Context, mp1 *types.MatchingDescribeTaskListRequest, p1 ...yarpc.CallOption) (dp1 *types.DescribeTaskListResponse, err error) {
 	fakeErr := c.fakeErrFn(c.errorRate)
 	var forwardCall bool
@@ -120,6 +140,46 @@ func (c *matchingClient) DescribeTaskList(ctx context.Context, mp1 *types.Matchi
 	return
 }
 
+func (c *matchingClient) DescribeWorker(ctx context.Context, mp1 *types.MatchingDescribeWorkerRequest, p1 ...yarpc.CallOption) (dp1 *types.DescribeWorkerResponse, err error) {
+	fakeErr := c.fakeErrFn(c.errorRate)
+	var forwardCall bool
+	if forwardCall = c.forwardCallFn(fakeErr); forwardCall {
+		dp1, err = c.client.DescribeWorker(ctx, mp1, p1...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgMatchingInjectedFakeErr,
+			tag.MatchingClientOperationDescribeWorker,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(err),
+		)
+		err = fakeErr
+		return
+	}
+	return
+}
+
+func (c *matchingClient) GetTaskListScalingRecommendation(ctx context.Context, mp1 *types.MatchingGetTaskListScalingRecommendationRequest, p1 ...yarpc.CallOption) (gp1 *types.GetTaskListScalingRecommendationResponse, err error) {
+	fakeErr := c.fakeErrFn(c.errorRate)
+	var forwardCall bool
+	if forwardCall = c.forwardCallFn(fakeErr); forwardCall {
+		gp1, err = c.client.GetTaskListScalingRecommendation(ctx, mp1, p1...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgMatchingInjectedFakeErr,
+			tag.MatchingClientOperationGetTaskListScalingRecommendation,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(err),
+		)
+		err = fakeErr
+		return
+	}
+	return
+}
+
 func (c *matchingClient) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest, p1 ...yarpc.CallOption) (gp2 *types.GetTaskListsByDomainResponse, err error) {
 	fakeErr := c.fakeErrFn(c.errorRate)
 	var forwardCall bool
@@ -160,6 +220,46 @@ func (c *matchingClient) ListTaskListPartitions(ctx context.Context, mp1 *types.
 	return
 }
 
+func (c *matchingClient) ListWorkers(ctx context.Context, mp1 *types.MatchingListWorkersRequest, p1 ...yarpc.CallOption) (lp1 *types.ListWorkersResponse, err error) {
+	fakeErr := c.fakeErrFn(c.errorRate)
+	var forwardCall bool
+	if forwardCall = c.forwardCallFn(fakeErr); forwardCall {
+		lp1, err = c.client.ListWorkers(ctx, mp1, p1...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgMatchingInjectedFakeErr,
+			tag.MatchingClientOperationListWorkers,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(err),
+		)
+		err = fakeErr
+		return
+	}
+	return
+}
+
+func (c *matchingClient) MigrateTaskList(ctx context.Context, mp1 *types.MatchingMigrateTaskListRequest, p1 ...yarpc.CallOption) (mp2 *types.MigrateTaskListResponse, err error) {
+	fakeErr := c.fakeErrFn(c.errorRate)
+	var forwardCall bool
+	if forwardCall = c.forwardCallFn(fakeErr); forwardCall {
+		mp2, err = c.client.MigrateTaskList(ctx, mp1, p1...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgMatchingInjectedFakeErr,
+			tag.MatchingClientOperationMigrateTaskList,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(err),
+		)
+		err = fakeErr
+		return
+	}
+	return
+}
+
 func (c *matchingClient) PollForActivityTask(ctx context.Context, mp1 *types.MatchingPollForActivityTaskRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingPollForActivityTaskResponse, err error) {
 	fakeErr := c.fakeErrFn(c.errorRate)
 	var forwardCall bool
@@ -279,3 +379,23 @@ func (c *matchingClient) UpdateTaskListPartitionConfig(ctx context.Context, mp1
 	}
 	return
 }
+
+func (c *matchingClient) UpdateTaskListVersioningConfig(ctx context.Context, mp1 *types.MatchingUpdateTaskListVersioningConfigRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingUpdateTaskListVersioningConfigResponse, err error) {
+	fakeErr := c.fakeErrFn(c.errorRate)
+	var forwardCall bool
+	if forwardCall = c.forwardCallFn(fakeErr); forwardCall {
+		mp2, err = c.client.UpdateTaskListVersioningConfig(ctx, mp1, p1...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgMatchingInjectedFakeErr,
+			tag.MatchingClientOperationUpdateTaskListVersioningConfig,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(err),
+		)
+		err = fakeErr
+		return
+	}
+	return
+}
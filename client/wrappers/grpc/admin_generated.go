@@ -34,6 +34,10 @@ func (g adminClient) DeleteWorkflow(ctx context.Context, ap1 *types.AdminDeleteW
 	return proto.ToAdminDeleteWorkflowResponse(response), proto.ToError(err)
 }
 
+func (g adminClient) DescribeAsyncWorkflowQueue(ctx context.Context, request *types.DescribeAsyncWorkflowQueueRequest, opts ...yarpc.CallOption) (dp1 *types.DescribeAsyncWorkflowQueueResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g adminClient) DescribeCluster(ctx context.Context, p1 ...yarpc.CallOption) (dp1 *types.DescribeClusterResponse, err error) {
 	response, err := g.c.DescribeCluster(ctx, &adminv1.DescribeClusterRequest{}, p1...)
 	return proto.ToAdminDescribeClusterResponse(response), proto.ToError(err)
@@ -114,11 +118,23 @@ func (g adminClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQMe
 	return proto.ToAdminMergeDLQMessagesResponse(response), proto.ToError(err)
 }
 
+func (g adminClient) MigrateTaskList(ctx context.Context, request *types.MigrateTaskListRequest, opts ...yarpc.CallOption) (mp1 *types.MigrateTaskListResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
+func (g adminClient) PurgeAsyncWorkflowDLQMessages(ctx context.Context, request *types.PurgeAsyncWorkflowDLQMessagesRequest, opts ...yarpc.CallOption) (pp1 *types.PurgeAsyncWorkflowDLQMessagesResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.PurgeDLQMessages(ctx, proto.FromAdminPurgeDLQMessagesRequest(pp1), p1...)
 	return proto.ToError(err)
 }
 
+func (g adminClient) ReadAsyncWorkflowDLQMessages(ctx context.Context, request *types.ReadAsyncWorkflowDLQMessagesRequest, opts ...yarpc.CallOption) (rp1 *types.ReadAsyncWorkflowDLQMessagesResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g adminClient) ReadDLQMessages(ctx context.Context, rp1 *types.ReadDLQMessagesRequest, p1 ...yarpc.CallOption) (rp2 *types.ReadDLQMessagesResponse, err error) {
 	response, err := g.c.ReadDLQMessages(ctx, proto.FromAdminReadDLQMessagesRequest(rp1), p1...)
 	return proto.ToAdminReadDLQMessagesResponse(response), proto.ToError(err)
@@ -139,6 +155,10 @@ func (g adminClient) RemoveTask(ctx context.Context, rp1 *types.RemoveTaskReques
 	return proto.ToError(err)
 }
 
+func (g adminClient) ReplayAsyncWorkflowDLQMessages(ctx context.Context, request *types.ReplayAsyncWorkflowDLQMessagesRequest, opts ...yarpc.CallOption) (rp1 *types.ReplayAsyncWorkflowDLQMessagesResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g adminClient) ResendReplicationTasks(ctx context.Context, rp1 *types.ResendReplicationTasksRequest, p1 ...yarpc.CallOption) (err error) {
 	_, err = g.c.ResendReplicationTasks(ctx, proto.FromAdminResendReplicationTasksRequest(rp1), p1...)
 	return proto.ToError(err)
@@ -178,3 +198,7 @@ func (g adminClient) UpdateTaskListPartitionConfig(ctx context.Context, request
 	response, err := g.c.UpdateTaskListPartitionConfig(ctx, proto.FromAdminUpdateTaskListPartitionConfigRequest(request), opts...)
 	return proto.ToAdminUpdateTaskListPartitionConfigResponse(response), proto.ToError(err)
 }
+
+func (g adminClient) UpdateTaskListVersioningConfig(ctx context.Context, request *types.UpdateTaskListVersioningConfigRequest, opts ...yarpc.CallOption) (up1 *types.UpdateTaskListVersioningConfigResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
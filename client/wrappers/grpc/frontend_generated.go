@@ -29,6 +29,10 @@ func (g frontendClient) DeprecateDomain(ctx context.Context, dp1 *types.Deprecat
 	return proto.ToError(err)
 }
 
+func (g frontendClient) DescribeAsyncRequest(ctx context.Context, dp1 *types.DescribeAsyncRequestRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeAsyncRequestResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) DescribeDomain(ctx context.Context, dp1 *types.DescribeDomainRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeDomainResponse, err error) {
 	response, err := g.c.DescribeDomain(ctx, proto.FromDescribeDomainRequest(dp1), p1...)
 	return proto.ToDescribeDomainResponse(response), proto.ToError(err)
@@ -39,6 +43,10 @@ func (g frontendClient) DescribeTaskList(ctx context.Context, dp1 *types.Describ
 	return proto.ToDescribeTaskListResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) DescribeWorker(ctx context.Context, dp1 *types.DescribeWorkerRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeWorkerResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) DescribeWorkflowExecution(ctx context.Context, dp1 *types.DescribeWorkflowExecutionRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeWorkflowExecutionResponse, err error) {
 	response, err := g.c.DescribeWorkflowExecution(ctx, proto.FromDescribeWorkflowExecutionRequest(dp1), p1...)
 	return proto.ToDescribeWorkflowExecutionResponse(response), proto.ToError(err)
@@ -59,6 +67,10 @@ func (g frontendClient) GetSearchAttributes(ctx context.Context, p1 ...yarpc.Cal
 	return proto.ToGetSearchAttributesResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) GetTaskListScalingRecommendation(ctx context.Context, gp1 *types.GetTaskListScalingRecommendationRequest, p1 ...yarpc.CallOption) (gp2 *types.GetTaskListScalingRecommendationResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest, p1 ...yarpc.CallOption) (gp2 *types.GetTaskListsByDomainResponse, err error) {
 	response, err := g.c.GetTaskListsByDomain(ctx, proto.FromGetTaskListsByDomainRequest(gp1), p1...)
 	return proto.ToGetTaskListsByDomainResponse(response), proto.ToError(err)
@@ -94,6 +106,10 @@ func (g frontendClient) ListTaskListPartitions(ctx context.Context, lp1 *types.L
 	return proto.ToListTaskListPartitionsResponse(response), proto.ToError(err)
 }
 
+func (g frontendClient) ListWorkers(ctx context.Context, lp1 *types.ListWorkersRequest, p1 ...yarpc.CallOption) (lp2 *types.ListWorkersResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) ListWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
 	response, err := g.c.ListWorkflowExecutions(ctx, proto.FromListWorkflowExecutionsRequest(lp1), p1...)
 	return proto.ToListWorkflowExecutionsResponse(response), proto.ToError(err)
@@ -139,6 +155,10 @@ func (g frontendClient) RequestCancelWorkflowExecution(ctx context.Context, rp1
 	return proto.ToError(err)
 }
 
+func (g frontendClient) RequestCancelWorkflowExecutionAsync(ctx context.Context, rp1 *types.RequestCancelWorkflowExecutionAsyncRequest, p1 ...yarpc.CallOption) (rp2 *types.RequestCancelWorkflowExecutionAsyncResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest, p1 ...yarpc.CallOption) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	response, err := g.c.ResetStickyTaskList(ctx, proto.FromResetStickyTaskListRequest(rp1), p1...)
 	return proto.ToResetStickyTaskListResponse(response), proto.ToError(err)
@@ -219,6 +239,10 @@ func (g frontendClient) SignalWorkflowExecution(ctx context.Context, sp1 *types.
 	return proto.ToError(err)
 }
 
+func (g frontendClient) SignalWorkflowExecutionAsync(ctx context.Context, sp1 *types.SignalWorkflowExecutionAsyncRequest, p1 ...yarpc.CallOption) (sp2 *types.SignalWorkflowExecutionAsyncResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) StartWorkflowExecution(ctx context.Context, sp1 *types.StartWorkflowExecutionRequest, p1 ...yarpc.CallOption) (sp2 *types.StartWorkflowExecutionResponse, err error) {
 	response, err := g.c.StartWorkflowExecution(ctx, proto.FromStartWorkflowExecutionRequest(sp1), p1...)
 	return proto.ToStartWorkflowExecutionResponse(response), proto.ToError(err)
@@ -234,7 +258,15 @@ func (g frontendClient) TerminateWorkflowExecution(ctx context.Context, tp1 *typ
 	return proto.ToError(err)
 }
 
+func (g frontendClient) TerminateWorkflowExecutionAsync(ctx context.Context, tp1 *types.TerminateWorkflowExecutionAsyncRequest, p1 ...yarpc.CallOption) (tp2 *types.TerminateWorkflowExecutionAsyncResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
+
 func (g frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateDomainResponse, err error) {
 	response, err := g.c.UpdateDomain(ctx, proto.FromUpdateDomainRequest(up1), p1...)
 	return proto.ToUpdateDomainResponse(response), proto.ToError(err)
 }
+
+func (g frontendClient) UpdateWorkflowMemo(ctx context.Context, up1 *types.UpdateWorkflowMemoRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateWorkflowMemoResponse, err error) {
+	return nil, &types.BadRequestError{Message: "Feature not supported on gRPC"}
+}
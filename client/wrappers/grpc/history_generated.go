@@ -43,6 +43,11 @@ func (g historyClient) DescribeWorkflowExecution(ctx context.Context, hp1 *types
 	return proto.ToHistoryDescribeWorkflowExecutionResponse(response), proto.ToError(err)
 }
 
+func (g historyClient) GetCompletionCallbacks(ctx context.Context, gp1 *types.GetCompletionCallbacksRequest, p1 ...yarpc.CallOption) (gp2 *types.GetCompletionCallbacksResponse, err error) {
+	response, err := g.c.GetCompletionCallbacks(ctx, proto.FromHistoryGetCompletionCallbacksRequest(gp1), p1...)
+	return proto.ToHistoryGetCompletionCallbacksResponse(response), proto.ToError(err)
+}
+
 func (g historyClient) GetCrossClusterTasks(ctx context.Context, gp1 *types.GetCrossClusterTasksRequest, p1 ...yarpc.CallOption) (gp2 *types.GetCrossClusterTasksResponse, err error) {
 	response, err := g.c.GetCrossClusterTasks(ctx, proto.FromHistoryGetCrossClusterTasksRequest(gp1), p1...)
 	return proto.ToHistoryGetCrossClusterTasksResponse(response), proto.ToError(err)
@@ -123,6 +128,11 @@ func (g historyClient) RecordChildExecutionCompleted(ctx context.Context, rp1 *t
 	return proto.ToError(err)
 }
 
+func (g historyClient) RecordCompletionCallbackState(ctx context.Context, rp1 *types.RecordCompletionCallbackStateRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.RecordCompletionCallbackState(ctx, proto.FromHistoryRecordCompletionCallbackStateRequest(rp1), p1...)
+	return proto.ToError(err)
+}
+
 func (g historyClient) RecordDecisionTaskStarted(ctx context.Context, rp1 *types.RecordDecisionTaskStartedRequest, p1 ...yarpc.CallOption) (rp2 *types.RecordDecisionTaskStartedResponse, err error) {
 	response, err := g.c.RecordDecisionTaskStarted(ctx, proto.FromHistoryRecordDecisionTaskStartedRequest(rp1), p1...)
 	return proto.ToHistoryRecordDecisionTaskStartedResponse(response), proto.ToError(err)
@@ -232,3 +242,8 @@ func (g historyClient) TerminateWorkflowExecution(ctx context.Context, hp1 *type
 	_, err = g.c.TerminateWorkflowExecution(ctx, proto.FromHistoryTerminateWorkflowExecutionRequest(hp1), p1...)
 	return proto.ToError(err)
 }
+
+func (g historyClient) UpdateWorkflowMemo(ctx context.Context, hp1 *types.HistoryUpdateWorkflowMemoRequest, p1 ...yarpc.CallOption) (err error) {
+	_, err = g.c.UpdateWorkflowMemo(ctx, proto.FromHistoryUpdateWorkflowMemoRequest(hp1), p1...)
+	return proto.ToError(err)
+}
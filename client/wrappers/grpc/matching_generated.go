@@ -28,11 +28,26 @@ func (g matchingClient) CancelOutstandingPoll(ctx context.Context, cp1 *types.Ca
 	return proto.ToError(err)
 }
 
+func (g matchingClient) DescribeDomainTaskQuota(ctx context.Context, mp1 *types.MatchingDescribeDomainTaskQuotaRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingDescribeDomainTaskQuotaResponse, err error) {
+	response, err := g.c.DescribeDomainTaskQuota(ctx, proto.FromMatchingDescribeDomainTaskQuotaRequest(mp1), p1...)
+	return proto.ToMatchingDescribeDomainTaskQuotaResponse(response), proto.ToError(err)
+}
+
 func (g matchingClient) DescribeTaskList(ctx context.Context, mp1 *types.MatchingDescribeTaskListRequest, p1 ...yarpc.CallOption) (dp1 *types.DescribeTaskListResponse, err error) {
 	response, err := g.c.DescribeTaskList(ctx, proto.FromMatchingDescribeTaskListRequest(mp1), p1...)
 	return proto.ToMatchingDescribeTaskListResponse(response), proto.ToError(err)
 }
 
+func (g matchingClient) DescribeWorker(ctx context.Context, mp1 *types.MatchingDescribeWorkerRequest, p1 ...yarpc.CallOption) (dp1 *types.DescribeWorkerResponse, err error) {
+	response, err := g.c.DescribeWorker(ctx, proto.FromMatchingDescribeWorkerRequest(mp1), p1...)
+	return proto.ToMatchingDescribeWorkerResponse(response), proto.ToError(err)
+}
+
+func (g matchingClient) GetTaskListScalingRecommendation(ctx context.Context, mp1 *types.MatchingGetTaskListScalingRecommendationRequest, p1 ...yarpc.CallOption) (gp1 *types.GetTaskListScalingRecommendationResponse, err error) {
+	response, err := g.c.GetTaskListScalingRecommendation(ctx, proto.FromMatchingGetTaskListScalingRecommendationRequest(mp1), p1...)
+	return proto.ToMatchingGetTaskListScalingRecommendationResponse(response), proto.ToError(err)
+}
+
 func (g matchingClient) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest, p1 ...yarpc.CallOption) (gp2 *types.GetTaskListsByDomainResponse, err error) {
 	response, err := g.c.GetTaskListsByDomain(ctx, proto.FromMatchingGetTaskListsByDomainRequest(gp1), p1...)
 	return proto.ToMatchingGetTaskListsByDomainResponse(response), proto.ToError(err)
@@ -43,6 +58,16 @@ func (g matchingClient) ListTaskListPartitions(ctx context.Context, mp1 *types.M
 	return proto.ToMatchingListTaskListPartitionsResponse(response), proto.ToError(err)
 }
 
+func (g matchingClient) ListWorkers(ctx context.Context, mp1 *types.MatchingListWorkersRequest, p1 ...yarpc.CallOption) (lp1 *types.ListWorkersResponse, err error) {
+	response, err := g.c.ListWorkers(ctx, proto.FromMatchingListWorkersRequest(mp1), p1...)
+	return proto.ToMatchingListWorkersResponse(response), proto.ToError(err)
+}
+
+func (g matchingClient) MigrateTaskList(ctx context.Context, mp1 *types.MatchingMigrateTaskListRequest, p1 ...yarpc.CallOption) (mp2 *types.MigrateTaskListResponse, err error) {
+	response, err := g.c.MigrateTaskList(ctx, proto.FromMatchingMigrateTaskListRequest(mp1), p1...)
+	return proto.ToMatchingMigrateTaskListResponse(response), proto.ToError(err)
+}
+
 func (g matchingClient) PollForActivityTask(ctx context.Context, mp1 *types.MatchingPollForActivityTaskRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingPollForActivityTaskResponse, err error) {
 	response, err := g.c.PollForActivityTask(ctx, proto.FromMatchingPollForActivityTaskRequest(mp1), p1...)
 	return proto.ToMatchingPollForActivityTaskResponse(response), proto.ToError(err)
@@ -72,3 +97,8 @@ func (g matchingClient) UpdateTaskListPartitionConfig(ctx context.Context, mp1 *
 	response, err := g.c.UpdateTaskListPartitionConfig(ctx, proto.FromMatchingUpdateTaskListPartitionConfigRequest(mp1), p1...)
 	return proto.ToMatchingUpdateTaskListPartitionConfigResponse(response), proto.ToError(err)
 }
+
+func (g matchingClient) UpdateTaskListVersioningConfig(ctx context.Context, mp1 *types.MatchingUpdateTaskListVersioningConfigRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingUpdateTaskListVersioningConfigResponse, err error) {
+	response, err := g.c.UpdateTaskListVersioningConfig(ctx, proto.FromMatchingUpdateTaskListVersioningConfigRequest(mp1), p1...)
+	return proto.ToMatchingUpdateTaskListVersioningConfigResponse(response), proto.ToError(err)
+}
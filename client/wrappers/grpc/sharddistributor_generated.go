@@ -13,7 +13,37 @@ import (
 	"github.com/uber/cadence/common/types/mapper/proto"
 )
 
+func (g sharddistributorClient) DescribeNamespace(ctx context.Context, dp1 *types.DescribeNamespaceRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeNamespaceResponse, err error) {
+	response, err := g.c.DescribeNamespace(ctx, proto.FromShardDistributorDescribeNamespaceRequest(dp1), p1...)
+	return proto.ToShardDistributorDescribeNamespaceResponse(response), proto.ToError(err)
+}
+
 func (g sharddistributorClient) GetShardOwner(ctx context.Context, gp1 *types.GetShardOwnerRequest, p1 ...yarpc.CallOption) (gp2 *types.GetShardOwnerResponse, err error) {
 	response, err := g.c.GetShardOwner(ctx, proto.FromShardDistributorGetShardOwnerRequest(gp1), p1...)
 	return proto.ToShardDistributorGetShardOwnerResponse(response), proto.ToError(err)
 }
+
+func (g sharddistributorClient) ListNamespaces(ctx context.Context, lp1 *types.ListNamespacesRequest, p1 ...yarpc.CallOption) (lp2 *types.ListNamespacesResponse, err error) {
+	response, err := g.c.ListNamespaces(ctx, proto.FromShardDistributorListNamespacesRequest(lp1), p1...)
+	return proto.ToShardDistributorListNamespacesResponse(response), proto.ToError(err)
+}
+
+func (g sharddistributorClient) MoveShard(ctx context.Context, mp1 *types.MoveShardRequest, p1 ...yarpc.CallOption) (mp2 *types.MoveShardResponse, err error) {
+	response, err := g.c.MoveShard(ctx, proto.FromShardDistributorMoveShardRequest(mp1), p1...)
+	return proto.ToShardDistributorMoveShardResponse(response), proto.ToError(err)
+}
+
+func (g sharddistributorClient) SetExecutorDrained(ctx context.Context, sp1 *types.SetExecutorDrainedRequest, p1 ...yarpc.CallOption) (sp2 *types.SetExecutorDrainedResponse, err error) {
+	response, err := g.c.SetExecutorDrained(ctx, proto.FromShardDistributorSetExecutorDrainedRequest(sp1), p1...)
+	return proto.ToShardDistributorSetExecutorDrainedResponse(response), proto.ToError(err)
+}
+
+func (g sharddistributorClient) SetRebalancingFrozen(ctx context.Context, sp1 *types.SetRebalancingFrozenRequest, p1 ...yarpc.CallOption) (sp2 *types.SetRebalancingFrozenResponse, err error) {
+	response, err := g.c.SetRebalancingFrozen(ctx, proto.FromShardDistributorSetRebalancingFrozenRequest(sp1), p1...)
+	return proto.ToShardDistributorSetRebalancingFrozenResponse(response), proto.ToError(err)
+}
+
+func (g sharddistributorClient) WatchNamespaceState(ctx context.Context, wp1 *types.WatchNamespaceStateRequest, p1 ...yarpc.CallOption) (wp2 *types.WatchNamespaceStateResponse, err error) {
+	response, err := g.c.WatchNamespaceState(ctx, proto.FromShardDistributorWatchNamespaceStateRequest(wp1), p1...)
+	return proto.ToShardDistributorWatchNamespaceStateResponse(response), proto.ToError(err)
+}
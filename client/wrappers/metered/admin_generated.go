@@ -116,6 +116,28 @@ func (c *adminClient) DeleteWorkflow(ctx context.Context, ap1 *types.AdminDelete
 	return ap2, err
 }
 
+func (c *adminClient) DescribeAsyncWorkflowQueue(ctx context.Context, dp1 *types.DescribeAsyncWorkflowQueueRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeAsyncWorkflowQueueResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.AdminClientDescribeAsyncWorkflowQueueScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.AdminClientDescribeAsyncWorkflowQueueScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	dp2, err = c.client.DescribeAsyncWorkflowQueue(ctx, dp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return dp2, err
+}
+
 func (c *adminClient) DescribeCluster(ctx context.Context, p1 ...yarpc.CallOption) (dp1 *types.DescribeClusterResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -468,6 +490,50 @@ func (c *adminClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQM
 	return mp2, err
 }
 
+func (c *adminClient) MigrateTaskList(ctx context.Context, request *types.MigrateTaskListRequest, opts ...yarpc.CallOption) (mp1 *types.MigrateTaskListResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.AdminClientMigrateTaskListScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.AdminClientMigrateTaskListScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	mp1, err = c.client.MigrateTaskList(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return mp1, err
+}
+
+func (c *adminClient) PurgeAsyncWorkflowDLQMessages(ctx context.Context, request *types.PurgeAsyncWorkflowDLQMessagesRequest, opts ...yarpc.CallOption) (pp1 *types.PurgeAsyncWorkflowDLQMessagesResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.AdminClientPurgeAsyncWorkflowDLQMessagesScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.AdminClientPurgeAsyncWorkflowDLQMessagesScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	pp1, err = c.client.PurgeAsyncWorkflowDLQMessages(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return pp1, err
+}
+
 func (c *adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest, p1 ...yarpc.CallOption) (err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -490,6 +556,28 @@ func (c *adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQM
 	return err
 }
 
+func (c *adminClient) ReadAsyncWorkflowDLQMessages(ctx context.Context, request *types.ReadAsyncWorkflowDLQMessagesRequest, opts ...yarpc.CallOption) (rp1 *types.ReadAsyncWorkflowDLQMessagesResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.AdminClientReadAsyncWorkflowDLQMessagesScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.AdminClientReadAsyncWorkflowDLQMessagesScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	rp1, err = c.client.ReadAsyncWorkflowDLQMessages(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return rp1, err
+}
+
 func (c *adminClient) ReadDLQMessages(ctx context.Context, rp1 *types.ReadDLQMessagesRequest, p1 ...yarpc.CallOption) (rp2 *types.ReadDLQMessagesResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -578,6 +666,28 @@ func (c *adminClient) RemoveTask(ctx context.Context, rp1 *types.RemoveTaskReque
 	return err
 }
 
+func (c *adminClient) ReplayAsyncWorkflowDLQMessages(ctx context.Context, request *types.ReplayAsyncWorkflowDLQMessagesRequest, opts ...yarpc.CallOption) (rp1 *types.ReplayAsyncWorkflowDLQMessagesResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.AdminClientReplayAsyncWorkflowDLQMessagesScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.AdminClientReplayAsyncWorkflowDLQMessagesScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	rp1, err = c.client.ReplayAsyncWorkflowDLQMessages(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return rp1, err
+}
+
 func (c *adminClient) ResendReplicationTasks(ctx context.Context, rp1 *types.ResendReplicationTasksRequest, p1 ...yarpc.CallOption) (err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -753,3 +863,25 @@ func (c *adminClient) UpdateTaskListPartitionConfig(ctx context.Context, request
 	}
 	return up1, err
 }
+
+func (c *adminClient) UpdateTaskListVersioningConfig(ctx context.Context, request *types.UpdateTaskListVersioningConfigRequest, opts ...yarpc.CallOption) (up1 *types.UpdateTaskListVersioningConfigResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.AdminClientUpdateTaskListVersioningConfigScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.AdminClientUpdateTaskListVersioningConfigScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	up1, err = c.client.UpdateTaskListVersioningConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return up1, err
+}
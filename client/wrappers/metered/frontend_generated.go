@@ -94,6 +94,28 @@ func (c *frontendClient) DeprecateDomain(ctx context.Context, dp1 *types.Depreca
 	return err
 }
 
+func (c *frontendClient) DescribeAsyncRequest(ctx context.Context, dp1 *types.DescribeAsyncRequestRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeAsyncRequestResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.FrontendClientDescribeAsyncRequestScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.FrontendClientDescribeAsyncRequestScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	dp2, err = c.client.DescribeAsyncRequest(ctx, dp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return dp2, err
+}
+
 func (c *frontendClient) DescribeDomain(ctx context.Context, dp1 *types.DescribeDomainRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeDomainResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -138,6 +160,28 @@ func (c *frontendClient) DescribeTaskList(ctx context.Context, dp1 *types.Descri
 	return dp2, err
 }
 
+func (c *frontendClient) DescribeWorker(ctx context.Context, dp1 *types.DescribeWorkerRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeWorkerResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.FrontendClientDescribeWorkerScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.FrontendClientDescribeWorkerScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	dp2, err = c.client.DescribeWorker(ctx, dp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return dp2, err
+}
+
 func (c *frontendClient) DescribeWorkflowExecution(ctx context.Context, dp1 *types.DescribeWorkflowExecutionRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeWorkflowExecutionResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -226,6 +270,28 @@ func (c *frontendClient) GetSearchAttributes(ctx context.Context, p1 ...yarpc.Ca
 	return gp1, err
 }
 
+func (c *frontendClient) GetTaskListScalingRecommendation(ctx context.Context, gp1 *types.GetTaskListScalingRecommendationRequest, p1 ...yarpc.CallOption) (gp2 *types.GetTaskListScalingRecommendationResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.FrontendClientGetTaskListScalingRecommendationScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.FrontendClientGetTaskListScalingRecommendationScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	gp2, err = c.client.GetTaskListScalingRecommendation(ctx, gp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return gp2, err
+}
+
 func (c *frontendClient) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest, p1 ...yarpc.CallOption) (gp2 *types.GetTaskListsByDomainResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -380,6 +446,28 @@ func (c *frontendClient) ListTaskListPartitions(ctx context.Context, lp1 *types.
 	return lp2, err
 }
 
+func (c *frontendClient) ListWorkers(ctx context.Context, lp1 *types.ListWorkersRequest, p1 ...yarpc.CallOption) (lp2 *types.ListWorkersResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.FrontendClientListWorkersScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.FrontendClientListWorkersScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	lp2, err = c.client.ListWorkers(ctx, lp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return lp2, err
+}
+
 func (c *frontendClient) ListWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -578,6 +666,28 @@ func (c *frontendClient) RequestCancelWorkflowExecution(ctx context.Context, rp1
 	return err
 }
 
+func (c *frontendClient) RequestCancelWorkflowExecutionAsync(ctx context.Context, rp1 *types.RequestCancelWorkflowExecutionAsyncRequest, p1 ...yarpc.CallOption) (rp2 *types.RequestCancelWorkflowExecutionAsyncResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.FrontendClientRequestCancelWorkflowExecutionAsyncScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.FrontendClientRequestCancelWorkflowExecutionAsyncScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	rp2, err = c.client.RequestCancelWorkflowExecutionAsync(ctx, rp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return rp2, err
+}
+
 func (c *frontendClient) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest, p1 ...yarpc.CallOption) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -930,6 +1040,28 @@ func (c *frontendClient) SignalWorkflowExecution(ctx context.Context, sp1 *types
 	return err
 }
 
+func (c *frontendClient) SignalWorkflowExecutionAsync(ctx context.Context, sp1 *types.SignalWorkflowExecutionAsyncRequest, p1 ...yarpc.CallOption) (sp2 *types.SignalWorkflowExecutionAsyncResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.FrontendClientSignalWorkflowExecutionAsyncScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.FrontendClientSignalWorkflowExecutionAsyncScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	sp2, err = c.client.SignalWorkflowExecutionAsync(ctx, sp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return sp2, err
+}
+
 func (c *frontendClient) StartWorkflowExecution(ctx context.Context, sp1 *types.StartWorkflowExecutionRequest, p1 ...yarpc.CallOption) (sp2 *types.StartWorkflowExecutionResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -996,6 +1128,28 @@ func (c *frontendClient) TerminateWorkflowExecution(ctx context.Context, tp1 *ty
 	return err
 }
 
+func (c *frontendClient) TerminateWorkflowExecutionAsync(ctx context.Context, tp1 *types.TerminateWorkflowExecutionAsyncRequest, p1 ...yarpc.CallOption) (tp2 *types.TerminateWorkflowExecutionAsyncResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.FrontendClientTerminateWorkflowExecutionAsyncScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.FrontendClientTerminateWorkflowExecutionAsyncScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	tp2, err = c.client.TerminateWorkflowExecutionAsync(ctx, tp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return tp2, err
+}
+
 func (c *frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateDomainResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -1017,3 +1171,25 @@ func (c *frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDoma
 	}
 	return up2, err
 }
+
+func (c *frontendClient) UpdateWorkflowMemo(ctx context.Context, up1 *types.UpdateWorkflowMemoRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateWorkflowMemoResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.FrontendClientUpdateWorkflowMemoScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.FrontendClientUpdateWorkflowMemoScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	up2, err = c.client.UpdateWorkflowMemo(ctx, up1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return up2, err
+}
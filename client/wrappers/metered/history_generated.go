@@ -160,6 +160,28 @@ func (c *historyClient) DescribeWorkflowExecution(ctx context.Context, hp1 *type
 	return dp1, err
 }
 
+func (c *historyClient) GetCompletionCallbacks(ctx context.Context, gp1 *types.GetCompletionCallbacksRequest, p1 ...yarpc.CallOption) (gp2 *types.GetCompletionCallbacksResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.HistoryClientGetCompletionCallbacksScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.HistoryClientGetCompletionCallbacksScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	gp2, err = c.client.GetCompletionCallbacks(ctx, gp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return gp2, err
+}
+
 func (c *historyClient) GetCrossClusterTasks(ctx context.Context, gp1 *types.GetCrossClusterTasksRequest, p1 ...yarpc.CallOption) (gp2 *types.GetCrossClusterTasksResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -512,6 +534,28 @@ func (c *historyClient) RecordChildExecutionCompleted(ctx context.Context, rp1 *
 	return err
 }
 
+func (c *historyClient) RecordCompletionCallbackState(ctx context.Context, rp1 *types.RecordCompletionCallbackStateRequest, p1 ...yarpc.CallOption) (err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.HistoryClientRecordCompletionCallbackStateScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.HistoryClientRecordCompletionCallbackStateScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	err = c.client.RecordCompletionCallbackState(ctx, rp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *historyClient) RecordDecisionTaskStarted(ctx context.Context, rp1 *types.RecordDecisionTaskStartedRequest, p1 ...yarpc.CallOption) (rp2 *types.RecordDecisionTaskStartedResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -995,3 +1039,25 @@ func (c *historyClient) TerminateWorkflowExecution(ctx context.Context, hp1 *typ
 	}
 	return err
 }
+
+func (c *historyClient) UpdateWorkflowMemo(ctx context.Context, hp1 *types.HistoryUpdateWorkflowMemoRequest, p1 ...yarpc.CallOption) (err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.HistoryClientUpdateWorkflowMemoScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.HistoryClientUpdateWorkflowMemoScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	err = c.client.UpdateWorkflowMemo(ctx, hp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return err
+}
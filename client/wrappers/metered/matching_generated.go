@@ -99,6 +99,29 @@ func (c *matchingClient) CancelOutstandingPoll(ctx context.Context, cp1 *types.C
 	return err
 }
 
+func (c *matchingClient) DescribeDomainTaskQuota(ctx context.Context, mp1 *types.MatchingDescribeDomainTaskQuotaRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingDescribeDomainTaskQuotaResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.MatchingClientDescribeDomainTaskQuotaScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.MatchingClientDescribeDomainTaskQuotaScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+	c.emitForwardedFromStats(scope, mp1)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	mp2, err = c.client.DescribeDomainTaskQuota(ctx, mp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return mp2, err
+}
+
 func (c *matchingClient) DescribeTaskList(ctx context.Context, mp1 *types.MatchingDescribeTaskListRequest, p1 ...yarpc.CallOption) (dp1 *types.DescribeTaskListResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -122,6 +145,52 @@ func (c *matchingClient) DescribeTaskList(ctx context.Context, mp1 *types.Matchi
 	return dp1, err
 }
 
+func (c *matchingClient) DescribeWorker(ctx context.Context, mp1 *types.MatchingDescribeWorkerRequest, p1 ...yarpc.CallOption) (dp1 *types.DescribeWorkerResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.MatchingClientDescribeWorkerScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.MatchingClientDescribeWorkerScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+	c.emitForwardedFromStats(scope, mp1)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	dp1, err = c.client.DescribeWorker(ctx, mp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return dp1, err
+}
+
+func (c *matchingClient) GetTaskListScalingRecommendation(ctx context.Context, mp1 *types.MatchingGetTaskListScalingRecommendationRequest, p1 ...yarpc.CallOption) (gp1 *types.GetTaskListScalingRecommendationResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.MatchingClientGetTaskListScalingRecommendationScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.MatchingClientGetTaskListScalingRecommendationScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+	c.emitForwardedFromStats(scope, mp1)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	gp1, err = c.client.GetTaskListScalingRecommendation(ctx, mp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return gp1, err
+}
+
 func (c *matchingClient) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest, p1 ...yarpc.CallOption) (gp2 *types.GetTaskListsByDomainResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -168,6 +237,52 @@ func (c *matchingClient) ListTaskListPartitions(ctx context.Context, mp1 *types.
 	return lp1, err
 }
 
+func (c *matchingClient) ListWorkers(ctx context.Context, mp1 *types.MatchingListWorkersRequest, p1 ...yarpc.CallOption) (lp1 *types.ListWorkersResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.MatchingClientListWorkersScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.MatchingClientListWorkersScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+	c.emitForwardedFromStats(scope, mp1)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	lp1, err = c.client.ListWorkers(ctx, mp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return lp1, err
+}
+
+func (c *matchingClient) MigrateTaskList(ctx context.Context, mp1 *types.MatchingMigrateTaskListRequest, p1 ...yarpc.CallOption) (mp2 *types.MigrateTaskListResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.MatchingClientMigrateTaskListScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.MatchingClientMigrateTaskListScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+	c.emitForwardedFromStats(scope, mp1)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	mp2, err = c.client.MigrateTaskList(ctx, mp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return mp2, err
+}
+
 func (c *matchingClient) PollForActivityTask(ctx context.Context, mp1 *types.MatchingPollForActivityTaskRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingPollForActivityTaskResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -311,6 +426,29 @@ type forwardedRequest interface {
 	GetTaskList() *types.TaskList
 }
 
+func (c *matchingClient) UpdateTaskListVersioningConfig(ctx context.Context, mp1 *types.MatchingUpdateTaskListVersioningConfigRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingUpdateTaskListVersioningConfigResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.MatchingClientUpdateTaskListVersioningConfigScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.MatchingClientUpdateTaskListVersioningConfigScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+	c.emitForwardedFromStats(scope, mp1)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	mp2, err = c.client.UpdateTaskListVersioningConfig(ctx, mp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return mp2, err
+}
+
 func (c *matchingClient) emitForwardedFromStats(scope metrics.Scope, req any) {
 	p, ok := req.(forwardedRequest)
 	if !ok || p.GetTaskList() == nil {
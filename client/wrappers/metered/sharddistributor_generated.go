@@ -28,6 +28,28 @@ func NewShardDistributorClient(client sharddistributor.Client, metricsClient met
 	}
 }
 
+func (c *sharddistributorClient) DescribeNamespace(ctx context.Context, dp1 *types.DescribeNamespaceRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeNamespaceResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.ShardDistributorClientDescribeNamespaceScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.ShardDistributorClientDescribeNamespaceScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	dp2, err = c.client.DescribeNamespace(ctx, dp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return dp2, err
+}
+
 func (c *sharddistributorClient) GetShardOwner(ctx context.Context, gp1 *types.GetShardOwnerRequest, p1 ...yarpc.CallOption) (gp2 *types.GetShardOwnerResponse, err error) {
 	retryCount := getRetryCountFromContext(ctx)
 
@@ -49,3 +71,113 @@ func (c *sharddistributorClient) GetShardOwner(ctx context.Context, gp1 *types.G
 	}
 	return gp2, err
 }
+
+func (c *sharddistributorClient) ListNamespaces(ctx context.Context, lp1 *types.ListNamespacesRequest, p1 ...yarpc.CallOption) (lp2 *types.ListNamespacesResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.ShardDistributorClientListNamespacesScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.ShardDistributorClientListNamespacesScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	lp2, err = c.client.ListNamespaces(ctx, lp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return lp2, err
+}
+
+func (c *sharddistributorClient) MoveShard(ctx context.Context, mp1 *types.MoveShardRequest, p1 ...yarpc.CallOption) (mp2 *types.MoveShardResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.ShardDistributorClientMoveShardScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.ShardDistributorClientMoveShardScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	mp2, err = c.client.MoveShard(ctx, mp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return mp2, err
+}
+
+func (c *sharddistributorClient) SetExecutorDrained(ctx context.Context, sp1 *types.SetExecutorDrainedRequest, p1 ...yarpc.CallOption) (sp2 *types.SetExecutorDrainedResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.ShardDistributorClientSetExecutorDrainedScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.ShardDistributorClientSetExecutorDrainedScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	sp2, err = c.client.SetExecutorDrained(ctx, sp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return sp2, err
+}
+
+func (c *sharddistributorClient) SetRebalancingFrozen(ctx context.Context, sp1 *types.SetRebalancingFrozenRequest, p1 ...yarpc.CallOption) (sp2 *types.SetRebalancingFrozenResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.ShardDistributorClientSetRebalancingFrozenScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.ShardDistributorClientSetRebalancingFrozenScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	sp2, err = c.client.SetRebalancingFrozen(ctx, sp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return sp2, err
+}
+
+func (c *sharddistributorClient) WatchNamespaceState(ctx context.Context, wp1 *types.WatchNamespaceStateRequest, p1 ...yarpc.CallOption) (wp2 *types.WatchNamespaceStateResponse, err error) {
+	retryCount := getRetryCountFromContext(ctx)
+
+	var scope metrics.Scope
+	if retryCount == -1 {
+		scope = c.metricsClient.Scope(metrics.ShardDistributorClientWatchNamespaceStateScope)
+	} else {
+		scope = c.metricsClient.Scope(metrics.ShardDistributorClientWatchNamespaceStateScope, metrics.IsRetryTag(retryCount > 0))
+	}
+
+	scope.IncCounter(metrics.CadenceClientRequests)
+
+	sw := scope.StartTimer(metrics.CadenceClientLatency)
+	wp2, err = c.client.WatchNamespaceState(ctx, wp1, p1...)
+	sw.Stop()
+
+	if err != nil {
+		scope.IncCounter(metrics.CadenceClientFailures)
+	}
+	return wp2, err
+}
@@ -67,6 +67,17 @@ func (c *adminClient) DeleteWorkflow(ctx context.Context, ap1 *types.AdminDelete
 	return resp, err
 }
 
+func (c *adminClient) DescribeAsyncWorkflowQueue(ctx context.Context, dp1 *types.DescribeAsyncWorkflowQueueRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeAsyncWorkflowQueueResponse, err error) {
+	var resp *types.DescribeAsyncWorkflowQueueResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeAsyncWorkflowQueue(ctx, dp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) DescribeCluster(ctx context.Context, p1 ...yarpc.CallOption) (dp1 *types.DescribeClusterResponse, err error) {
 	var resp *types.DescribeClusterResponse
 	op := func(ctx context.Context) error {
@@ -243,6 +254,28 @@ func (c *adminClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQM
 	return resp, err
 }
 
+func (c *adminClient) MigrateTaskList(ctx context.Context, request *types.MigrateTaskListRequest, opts ...yarpc.CallOption) (mp1 *types.MigrateTaskListResponse, err error) {
+	var resp *types.MigrateTaskListResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MigrateTaskList(ctx, request, opts...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *adminClient) PurgeAsyncWorkflowDLQMessages(ctx context.Context, request *types.PurgeAsyncWorkflowDLQMessagesRequest, opts ...yarpc.CallOption) (pp1 *types.PurgeAsyncWorkflowDLQMessagesResponse, err error) {
+	var resp *types.PurgeAsyncWorkflowDLQMessagesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PurgeAsyncWorkflowDLQMessages(ctx, request, opts...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest, p1 ...yarpc.CallOption) (err error) {
 	op := func(ctx context.Context) error {
 		return c.client.PurgeDLQMessages(ctx, pp1, p1...)
@@ -250,6 +283,17 @@ func (c *adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQM
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *adminClient) ReadAsyncWorkflowDLQMessages(ctx context.Context, request *types.ReadAsyncWorkflowDLQMessagesRequest, opts ...yarpc.CallOption) (rp1 *types.ReadAsyncWorkflowDLQMessagesResponse, err error) {
+	var resp *types.ReadAsyncWorkflowDLQMessagesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ReadAsyncWorkflowDLQMessages(ctx, request, opts...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) ReadDLQMessages(ctx context.Context, rp1 *types.ReadDLQMessagesRequest, p1 ...yarpc.CallOption) (rp2 *types.ReadDLQMessagesResponse, err error) {
 	var resp *types.ReadDLQMessagesResponse
 	op := func(ctx context.Context) error {
@@ -282,6 +326,17 @@ func (c *adminClient) RemoveTask(ctx context.Context, rp1 *types.RemoveTaskReque
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *adminClient) ReplayAsyncWorkflowDLQMessages(ctx context.Context, request *types.ReplayAsyncWorkflowDLQMessagesRequest, opts ...yarpc.CallOption) (rp1 *types.ReplayAsyncWorkflowDLQMessagesResponse, err error) {
+	var resp *types.ReplayAsyncWorkflowDLQMessagesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ReplayAsyncWorkflowDLQMessages(ctx, request, opts...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *adminClient) ResendReplicationTasks(ctx context.Context, rp1 *types.ResendReplicationTasksRequest, p1 ...yarpc.CallOption) (err error) {
 	op := func(ctx context.Context) error {
 		return c.client.ResendReplicationTasks(ctx, rp1, p1...)
@@ -353,3 +408,14 @@ func (c *adminClient) UpdateTaskListPartitionConfig(ctx context.Context, request
 	err = c.throttleRetry.Do(ctx, op)
 	return resp, err
 }
+
+func (c *adminClient) UpdateTaskListVersioningConfig(ctx context.Context, request *types.UpdateTaskListVersioningConfigRequest, opts ...yarpc.CallOption) (up1 *types.UpdateTaskListVersioningConfigResponse, err error) {
+	var resp *types.UpdateTaskListVersioningConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateTaskListVersioningConfig(ctx, request, opts...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
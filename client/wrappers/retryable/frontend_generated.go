@@ -56,6 +56,17 @@ func (c *frontendClient) DeprecateDomain(ctx context.Context, dp1 *types.Depreca
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *frontendClient) DescribeAsyncRequest(ctx context.Context, dp1 *types.DescribeAsyncRequestRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeAsyncRequestResponse, err error) {
+	var resp *types.DescribeAsyncRequestResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeAsyncRequest(ctx, dp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) DescribeDomain(ctx context.Context, dp1 *types.DescribeDomainRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeDomainResponse, err error) {
 	var resp *types.DescribeDomainResponse
 	op := func(ctx context.Context) error {
@@ -78,6 +89,17 @@ func (c *frontendClient) DescribeTaskList(ctx context.Context, dp1 *types.Descri
 	return resp, err
 }
 
+func (c *frontendClient) DescribeWorker(ctx context.Context, dp1 *types.DescribeWorkerRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeWorkerResponse, err error) {
+	var resp *types.DescribeWorkerResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeWorker(ctx, dp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) DescribeWorkflowExecution(ctx context.Context, dp1 *types.DescribeWorkflowExecutionRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeWorkflowExecutionResponse, err error) {
 	var resp *types.DescribeWorkflowExecutionResponse
 	op := func(ctx context.Context) error {
@@ -122,6 +144,17 @@ func (c *frontendClient) GetSearchAttributes(ctx context.Context, p1 ...yarpc.Ca
 	return resp, err
 }
 
+func (c *frontendClient) GetTaskListScalingRecommendation(ctx context.Context, gp1 *types.GetTaskListScalingRecommendationRequest, p1 ...yarpc.CallOption) (gp2 *types.GetTaskListScalingRecommendationResponse, err error) {
+	var resp *types.GetTaskListScalingRecommendationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetTaskListScalingRecommendation(ctx, gp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest, p1 ...yarpc.CallOption) (gp2 *types.GetTaskListsByDomainResponse, err error) {
 	var resp *types.GetTaskListsByDomainResponse
 	op := func(ctx context.Context) error {
@@ -199,6 +232,17 @@ func (c *frontendClient) ListTaskListPartitions(ctx context.Context, lp1 *types.
 	return resp, err
 }
 
+func (c *frontendClient) ListWorkers(ctx context.Context, lp1 *types.ListWorkersRequest, p1 ...yarpc.CallOption) (lp2 *types.ListWorkersResponse, err error) {
+	var resp *types.ListWorkersResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListWorkers(ctx, lp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) ListWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
 	var resp *types.ListWorkflowExecutionsResponse
 	op := func(ctx context.Context) error {
@@ -286,6 +330,17 @@ func (c *frontendClient) RequestCancelWorkflowExecution(ctx context.Context, rp1
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *frontendClient) RequestCancelWorkflowExecutionAsync(ctx context.Context, rp1 *types.RequestCancelWorkflowExecutionAsyncRequest, p1 ...yarpc.CallOption) (rp2 *types.RequestCancelWorkflowExecutionAsyncResponse, err error) {
+	var resp *types.RequestCancelWorkflowExecutionAsyncResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RequestCancelWorkflowExecutionAsync(ctx, rp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest, p1 ...yarpc.CallOption) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	var resp *types.ResetStickyTaskListResponse
 	op := func(ctx context.Context) error {
@@ -426,6 +481,17 @@ func (c *frontendClient) SignalWorkflowExecution(ctx context.Context, sp1 *types
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *frontendClient) SignalWorkflowExecutionAsync(ctx context.Context, sp1 *types.SignalWorkflowExecutionAsyncRequest, p1 ...yarpc.CallOption) (sp2 *types.SignalWorkflowExecutionAsyncResponse, err error) {
+	var resp *types.SignalWorkflowExecutionAsyncResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SignalWorkflowExecutionAsync(ctx, sp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) StartWorkflowExecution(ctx context.Context, sp1 *types.StartWorkflowExecutionRequest, p1 ...yarpc.CallOption) (sp2 *types.StartWorkflowExecutionResponse, err error) {
 	var resp *types.StartWorkflowExecutionResponse
 	op := func(ctx context.Context) error {
@@ -455,6 +521,17 @@ func (c *frontendClient) TerminateWorkflowExecution(ctx context.Context, tp1 *ty
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *frontendClient) TerminateWorkflowExecutionAsync(ctx context.Context, tp1 *types.TerminateWorkflowExecutionAsyncRequest, p1 ...yarpc.CallOption) (tp2 *types.TerminateWorkflowExecutionAsyncResponse, err error) {
+	var resp *types.TerminateWorkflowExecutionAsyncResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.TerminateWorkflowExecutionAsync(ctx, tp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateDomainResponse, err error) {
 	var resp *types.UpdateDomainResponse
 	op := func(ctx context.Context) error {
@@ -465,3 +542,14 @@ func (c *frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDoma
 	err = c.throttleRetry.Do(ctx, op)
 	return resp, err
 }
+
+func (c *frontendClient) UpdateWorkflowMemo(ctx context.Context, up1 *types.UpdateWorkflowMemoRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateWorkflowMemoResponse, err error) {
+	var resp *types.UpdateWorkflowMemoResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateWorkflowMemo(ctx, up1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
@@ -93,6 +93,17 @@ func (c *historyClient) DescribeWorkflowExecution(ctx context.Context, hp1 *type
 	return resp, err
 }
 
+func (c *historyClient) GetCompletionCallbacks(ctx context.Context, gp1 *types.GetCompletionCallbacksRequest, p1 ...yarpc.CallOption) (gp2 *types.GetCompletionCallbacksResponse, err error) {
+	var resp *types.GetCompletionCallbacksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetCompletionCallbacks(ctx, gp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *historyClient) GetCrossClusterTasks(ctx context.Context, gp1 *types.GetCrossClusterTasksRequest, p1 ...yarpc.CallOption) (gp2 *types.GetCrossClusterTasksResponse, err error) {
 	var resp *types.GetCrossClusterTasksResponse
 	op := func(ctx context.Context) error {
@@ -253,6 +264,13 @@ func (c *historyClient) RecordChildExecutionCompleted(ctx context.Context, rp1 *
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *historyClient) RecordCompletionCallbackState(ctx context.Context, rp1 *types.RecordCompletionCallbackStateRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.RecordCompletionCallbackState(ctx, rp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *historyClient) RecordDecisionTaskStarted(ctx context.Context, rp1 *types.RecordDecisionTaskStartedRequest, p1 ...yarpc.CallOption) (rp2 *types.RecordDecisionTaskStartedResponse, err error) {
 	var resp *types.RecordDecisionTaskStartedResponse
 	op := func(ctx context.Context) error {
@@ -434,3 +452,10 @@ func (c *historyClient) TerminateWorkflowExecution(ctx context.Context, hp1 *typ
 	}
 	return c.throttleRetry.Do(ctx, op)
 }
+
+func (c *historyClient) UpdateWorkflowMemo(ctx context.Context, hp1 *types.HistoryUpdateWorkflowMemoRequest, p1 ...yarpc.CallOption) (err error) {
+	op := func(ctx context.Context) error {
+		return c.client.UpdateWorkflowMemo(ctx, hp1, p1...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
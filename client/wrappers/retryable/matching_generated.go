@@ -60,6 +60,17 @@ func (c *matchingClient) CancelOutstandingPoll(ctx context.Context, cp1 *types.C
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *matchingClient) DescribeDomainTaskQuota(ctx context.Context, mp1 *types.MatchingDescribeDomainTaskQuotaRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingDescribeDomainTaskQuotaResponse, err error) {
+	var resp *types.MatchingDescribeDomainTaskQuotaResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeDomainTaskQuota(ctx, mp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *matchingClient) DescribeTaskList(ctx context.Context, mp1 *types.MatchingDescribeTaskListRequest, p1 ...yarpc.CallOption) (dp1 *types.DescribeTaskListResponse, err error) {
 	var resp *types.DescribeTaskListResponse
 	op := func(ctx context.Context) error {
@@ -71,6 +82,28 @@ func (c *matchingClient) DescribeTaskList(ctx context.Context, mp1 *types.Matchi
 	return resp, err
 }
 
+func (c *matchingClient) DescribeWorker(ctx context.Context, mp1 *types.MatchingDescribeWorkerRequest, p1 ...yarpc.CallOption) (dp1 *types.DescribeWorkerResponse, err error) {
+	var resp *types.DescribeWorkerResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeWorker(ctx, mp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *matchingClient) GetTaskListScalingRecommendation(ctx context.Context, mp1 *types.MatchingGetTaskListScalingRecommendationRequest, p1 ...yarpc.CallOption) (gp1 *types.GetTaskListScalingRecommendationResponse, err error) {
+	var resp *types.GetTaskListScalingRecommendationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetTaskListScalingRecommendation(ctx, mp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *matchingClient) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest, p1 ...yarpc.CallOption) (gp2 *types.GetTaskListsByDomainResponse, err error) {
 	var resp *types.GetTaskListsByDomainResponse
 	op := func(ctx context.Context) error {
@@ -93,6 +126,28 @@ func (c *matchingClient) ListTaskListPartitions(ctx context.Context, mp1 *types.
 	return resp, err
 }
 
+func (c *matchingClient) ListWorkers(ctx context.Context, mp1 *types.MatchingListWorkersRequest, p1 ...yarpc.CallOption) (lp1 *types.ListWorkersResponse, err error) {
+	var resp *types.ListWorkersResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListWorkers(ctx, mp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *matchingClient) MigrateTaskList(ctx context.Context, mp1 *types.MatchingMigrateTaskListRequest, p1 ...yarpc.CallOption) (mp2 *types.MigrateTaskListResponse, err error) {
+	var resp *types.MigrateTaskListResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MigrateTaskList(ctx, mp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *matchingClient) PollForActivityTask(ctx context.Context, mp1 *types.MatchingPollForActivityTaskRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingPollForActivityTaskResponse, err error) {
 	var resp *types.MatchingPollForActivityTaskResponse
 	op := func(ctx context.Context) error {
@@ -154,3 +209,14 @@ func (c *matchingClient) UpdateTaskListPartitionConfig(ctx context.Context, mp1
 	err = c.throttleRetry.Do(ctx, op)
 	return resp, err
 }
+
+func (c *matchingClient) UpdateTaskListVersioningConfig(ctx context.Context, mp1 *types.MatchingUpdateTaskListVersioningConfigRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingUpdateTaskListVersioningConfigResponse, err error) {
+	var resp *types.MatchingUpdateTaskListVersioningConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateTaskListVersioningConfig(ctx, mp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
@@ -31,6 +31,17 @@ func NewShardDistributorClient(client sharddistributor.Client, policy backoff.Re
 	}
 }
 
+func (c *sharddistributorClient) DescribeNamespace(ctx context.Context, dp1 *types.DescribeNamespaceRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeNamespaceResponse, err error) {
+	var resp *types.DescribeNamespaceResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeNamespace(ctx, dp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *sharddistributorClient) GetShardOwner(ctx context.Context, gp1 *types.GetShardOwnerRequest, p1 ...yarpc.CallOption) (gp2 *types.GetShardOwnerResponse, err error) {
 	var resp *types.GetShardOwnerResponse
 	op := func(ctx context.Context) error {
@@ -41,3 +52,58 @@ func (c *sharddistributorClient) GetShardOwner(ctx context.Context, gp1 *types.G
 	err = c.throttleRetry.Do(ctx, op)
 	return resp, err
 }
+
+func (c *sharddistributorClient) ListNamespaces(ctx context.Context, lp1 *types.ListNamespacesRequest, p1 ...yarpc.CallOption) (lp2 *types.ListNamespacesResponse, err error) {
+	var resp *types.ListNamespacesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListNamespaces(ctx, lp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *sharddistributorClient) MoveShard(ctx context.Context, mp1 *types.MoveShardRequest, p1 ...yarpc.CallOption) (mp2 *types.MoveShardResponse, err error) {
+	var resp *types.MoveShardResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MoveShard(ctx, mp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *sharddistributorClient) SetExecutorDrained(ctx context.Context, sp1 *types.SetExecutorDrainedRequest, p1 ...yarpc.CallOption) (sp2 *types.SetExecutorDrainedResponse, err error) {
+	var resp *types.SetExecutorDrainedResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SetExecutorDrained(ctx, sp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *sharddistributorClient) SetRebalancingFrozen(ctx context.Context, sp1 *types.SetRebalancingFrozenRequest, p1 ...yarpc.CallOption) (sp2 *types.SetRebalancingFrozenResponse, err error) {
+	var resp *types.SetRebalancingFrozenResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SetRebalancingFrozen(ctx, sp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *sharddistributorClient) WatchNamespaceState(ctx context.Context, wp1 *types.WatchNamespaceStateRequest, p1 ...yarpc.CallOption) (wp2 *types.WatchNamespaceStateResponse, err error) {
+	var resp *types.WatchNamespaceStateResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.WatchNamespaceState(ctx, wp1, p1...)
+		return err
+	}
+	err = c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
@@ -32,6 +32,11 @@ func (g adminClient) DeleteWorkflow(ctx context.Context, ap1 *types.AdminDeleteW
 	return thrift.ToAdminDeleteWorkflowResponse(response), thrift.ToError(err)
 }
 
+func (g adminClient) DescribeAsyncWorkflowQueue(ctx context.Context, request *types.DescribeAsyncWorkflowQueueRequest, opts ...yarpc.CallOption) (dp1 *types.DescribeAsyncWorkflowQueueResponse, err error) {
+	response, err := g.c.DescribeAsyncWorkflowQueue(ctx, thrift.FromAdminDescribeAsyncWorkflowQueueRequest(request), opts...)
+	return thrift.ToAdminDescribeAsyncWorkflowQueueResponse(response), thrift.ToError(err)
+}
+
 func (g adminClient) DescribeCluster(ctx context.Context, p1 ...yarpc.CallOption) (dp1 *types.DescribeClusterResponse, err error) {
 	response, err := g.c.DescribeCluster(ctx, p1...)
 	return thrift.ToAdminDescribeClusterResponse(response), thrift.ToError(err)
@@ -112,11 +117,26 @@ func (g adminClient) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQMe
 	return thrift.ToAdminMergeDLQMessagesResponse(response), thrift.ToError(err)
 }
 
+func (g adminClient) MigrateTaskList(ctx context.Context, request *types.MigrateTaskListRequest, opts ...yarpc.CallOption) (mp1 *types.MigrateTaskListResponse, err error) {
+	response, err := g.c.MigrateTaskList(ctx, thrift.FromAdminMigrateTaskListRequest(request), opts...)
+	return thrift.ToAdminMigrateTaskListResponse(response), thrift.ToError(err)
+}
+
+func (g adminClient) PurgeAsyncWorkflowDLQMessages(ctx context.Context, request *types.PurgeAsyncWorkflowDLQMessagesRequest, opts ...yarpc.CallOption) (pp1 *types.PurgeAsyncWorkflowDLQMessagesResponse, err error) {
+	response, err := g.c.PurgeAsyncWorkflowDLQMessages(ctx, thrift.FromAdminPurgeAsyncWorkflowDLQMessagesRequest(request), opts...)
+	return thrift.ToAdminPurgeAsyncWorkflowDLQMessagesResponse(response), thrift.ToError(err)
+}
+
 func (g adminClient) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.PurgeDLQMessages(ctx, thrift.FromAdminPurgeDLQMessagesRequest(pp1), p1...)
 	return thrift.ToError(err)
 }
 
+func (g adminClient) ReadAsyncWorkflowDLQMessages(ctx context.Context, request *types.ReadAsyncWorkflowDLQMessagesRequest, opts ...yarpc.CallOption) (rp1 *types.ReadAsyncWorkflowDLQMessagesResponse, err error) {
+	response, err := g.c.ReadAsyncWorkflowDLQMessages(ctx, thrift.FromAdminReadAsyncWorkflowDLQMessagesRequest(request), opts...)
+	return thrift.ToAdminReadAsyncWorkflowDLQMessagesResponse(response), thrift.ToError(err)
+}
+
 func (g adminClient) ReadDLQMessages(ctx context.Context, rp1 *types.ReadDLQMessagesRequest, p1 ...yarpc.CallOption) (rp2 *types.ReadDLQMessagesResponse, err error) {
 	response, err := g.c.ReadDLQMessages(ctx, thrift.FromAdminReadDLQMessagesRequest(rp1), p1...)
 	return thrift.ToAdminReadDLQMessagesResponse(response), thrift.ToError(err)
@@ -137,6 +157,11 @@ func (g adminClient) RemoveTask(ctx context.Context, rp1 *types.RemoveTaskReques
 	return thrift.ToError(err)
 }
 
+func (g adminClient) ReplayAsyncWorkflowDLQMessages(ctx context.Context, request *types.ReplayAsyncWorkflowDLQMessagesRequest, opts ...yarpc.CallOption) (rp1 *types.ReplayAsyncWorkflowDLQMessagesResponse, err error) {
+	response, err := g.c.ReplayAsyncWorkflowDLQMessages(ctx, thrift.FromAdminReplayAsyncWorkflowDLQMessagesRequest(request), opts...)
+	return thrift.ToAdminReplayAsyncWorkflowDLQMessagesResponse(response), thrift.ToError(err)
+}
+
 func (g adminClient) ResendReplicationTasks(ctx context.Context, rp1 *types.ResendReplicationTasksRequest, p1 ...yarpc.CallOption) (err error) {
 	err = g.c.ResendReplicationTasks(ctx, thrift.FromAdminResendReplicationTasksRequest(rp1), p1...)
 	return thrift.ToError(err)
@@ -175,3 +200,8 @@ func (g adminClient) UpdateGlobalIsolationGroups(ctx context.Context, request *t
 func (g adminClient) UpdateTaskListPartitionConfig(ctx context.Context, request *types.UpdateTaskListPartitionConfigRequest, opts ...yarpc.CallOption) (up1 *types.UpdateTaskListPartitionConfigResponse, err error) {
 	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
 }
+
+func (g adminClient) UpdateTaskListVersioningConfig(ctx context.Context, request *types.UpdateTaskListVersioningConfigRequest, opts ...yarpc.CallOption) (up1 *types.UpdateTaskListVersioningConfigResponse, err error) {
+	response, err := g.c.UpdateTaskListVersioningConfig(ctx, thrift.FromAdminUpdateTaskListVersioningConfigRequest(request), opts...)
+	return thrift.ToAdminUpdateTaskListVersioningConfigResponse(response), thrift.ToError(err)
+}
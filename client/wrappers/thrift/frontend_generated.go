@@ -28,6 +28,11 @@ func (g frontendClient) DeprecateDomain(ctx context.Context, dp1 *types.Deprecat
 	return thrift.ToError(err)
 }
 
+func (g frontendClient) DescribeAsyncRequest(ctx context.Context, dp1 *types.DescribeAsyncRequestRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeAsyncRequestResponse, err error) {
+	response, err := g.c.DescribeAsyncRequest(ctx, thrift.FromDescribeAsyncRequestRequest(dp1), p1...)
+	return thrift.ToDescribeAsyncRequestResponse(response), thrift.ToError(err)
+}
+
 func (g frontendClient) DescribeDomain(ctx context.Context, dp1 *types.DescribeDomainRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeDomainResponse, err error) {
 	response, err := g.c.DescribeDomain(ctx, thrift.FromDescribeDomainRequest(dp1), p1...)
 	return thrift.ToDescribeDomainResponse(response), thrift.ToError(err)
@@ -38,6 +43,11 @@ func (g frontendClient) DescribeTaskList(ctx context.Context, dp1 *types.Describ
 	return thrift.ToDescribeTaskListResponse(response), thrift.ToError(err)
 }
 
+func (g frontendClient) DescribeWorker(ctx context.Context, dp1 *types.DescribeWorkerRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeWorkerResponse, err error) {
+	response, err := g.c.DescribeWorker(ctx, thrift.FromDescribeWorkerRequest(dp1), p1...)
+	return thrift.ToDescribeWorkerResponse(response), thrift.ToError(err)
+}
+
 func (g frontendClient) DescribeWorkflowExecution(ctx context.Context, dp1 *types.DescribeWorkflowExecutionRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeWorkflowExecutionResponse, err error) {
 	response, err := g.c.DescribeWorkflowExecution(ctx, thrift.FromDescribeWorkflowExecutionRequest(dp1), p1...)
 	return thrift.ToDescribeWorkflowExecutionResponse(response), thrift.ToError(err)
@@ -58,6 +68,11 @@ func (g frontendClient) GetSearchAttributes(ctx context.Context, p1 ...yarpc.Cal
 	return thrift.ToGetSearchAttributesResponse(response), thrift.ToError(err)
 }
 
+func (g frontendClient) GetTaskListScalingRecommendation(ctx context.Context, gp1 *types.GetTaskListScalingRecommendationRequest, p1 ...yarpc.CallOption) (gp2 *types.GetTaskListScalingRecommendationResponse, err error) {
+	response, err := g.c.GetTaskListScalingRecommendation(ctx, thrift.FromGetTaskListScalingRecommendationRequest(gp1), p1...)
+	return thrift.ToGetTaskListScalingRecommendationResponse(response), thrift.ToError(err)
+}
+
 func (g frontendClient) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest, p1 ...yarpc.CallOption) (gp2 *types.GetTaskListsByDomainResponse, err error) {
 	response, err := g.c.GetTaskListsByDomain(ctx, thrift.FromGetTaskListsByDomainRequest(gp1), p1...)
 	return thrift.ToGetTaskListsByDomainResponse(response), thrift.ToError(err)
@@ -93,6 +108,11 @@ func (g frontendClient) ListTaskListPartitions(ctx context.Context, lp1 *types.L
 	return thrift.ToListTaskListPartitionsResponse(response), thrift.ToError(err)
 }
 
+func (g frontendClient) ListWorkers(ctx context.Context, lp1 *types.ListWorkersRequest, p1 ...yarpc.CallOption) (lp2 *types.ListWorkersResponse, err error) {
+	response, err := g.c.ListWorkers(ctx, thrift.FromListWorkersRequest(lp1), p1...)
+	return thrift.ToListWorkersResponse(response), thrift.ToError(err)
+}
+
 func (g frontendClient) ListWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest, p1 ...yarpc.CallOption) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
 	response, err := g.c.ListWorkflowExecutions(ctx, thrift.FromListWorkflowExecutionsRequest(lp1), p1...)
 	return thrift.ToListWorkflowExecutionsResponse(response), thrift.ToError(err)
@@ -138,6 +158,11 @@ func (g frontendClient) RequestCancelWorkflowExecution(ctx context.Context, rp1
 	return thrift.ToError(err)
 }
 
+func (g frontendClient) RequestCancelWorkflowExecutionAsync(ctx context.Context, rp1 *types.RequestCancelWorkflowExecutionAsyncRequest, p1 ...yarpc.CallOption) (rp2 *types.RequestCancelWorkflowExecutionAsyncResponse, err error) {
+	response, err := g.c.RequestCancelWorkflowExecutionAsync(ctx, thrift.FromRequestCancelWorkflowExecutionAsyncRequest(rp1), p1...)
+	return thrift.ToRequestCancelWorkflowExecutionAsyncResponse(response), thrift.ToError(err)
+}
+
 func (g frontendClient) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest, p1 ...yarpc.CallOption) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	response, err := g.c.ResetStickyTaskList(ctx, thrift.FromResetStickyTaskListRequest(rp1), p1...)
 	return thrift.ToResetStickyTaskListResponse(response), thrift.ToError(err)
@@ -218,6 +243,11 @@ func (g frontendClient) SignalWorkflowExecution(ctx context.Context, sp1 *types.
 	return thrift.ToError(err)
 }
 
+func (g frontendClient) SignalWorkflowExecutionAsync(ctx context.Context, sp1 *types.SignalWorkflowExecutionAsyncRequest, p1 ...yarpc.CallOption) (sp2 *types.SignalWorkflowExecutionAsyncResponse, err error) {
+	response, err := g.c.SignalWorkflowExecutionAsync(ctx, thrift.FromSignalWorkflowExecutionAsyncRequest(sp1), p1...)
+	return thrift.ToSignalWorkflowExecutionAsyncResponse(response), thrift.ToError(err)
+}
+
 func (g frontendClient) StartWorkflowExecution(ctx context.Context, sp1 *types.StartWorkflowExecutionRequest, p1 ...yarpc.CallOption) (sp2 *types.StartWorkflowExecutionResponse, err error) {
 	response, err := g.c.StartWorkflowExecution(ctx, thrift.FromStartWorkflowExecutionRequest(sp1), p1...)
 	return thrift.ToStartWorkflowExecutionResponse(response), thrift.ToError(err)
@@ -233,7 +263,17 @@ func (g frontendClient) TerminateWorkflowExecution(ctx context.Context, tp1 *typ
 	return thrift.ToError(err)
 }
 
+func (g frontendClient) TerminateWorkflowExecutionAsync(ctx context.Context, tp1 *types.TerminateWorkflowExecutionAsyncRequest, p1 ...yarpc.CallOption) (tp2 *types.TerminateWorkflowExecutionAsyncResponse, err error) {
+	response, err := g.c.TerminateWorkflowExecutionAsync(ctx, thrift.FromTerminateWorkflowExecutionAsyncRequest(tp1), p1...)
+	return thrift.ToTerminateWorkflowExecutionAsyncResponse(response), thrift.ToError(err)
+}
+
 func (g frontendClient) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateDomainResponse, err error) {
 	response, err := g.c.UpdateDomain(ctx, thrift.FromUpdateDomainRequest(up1), p1...)
 	return thrift.ToUpdateDomainResponse(response), thrift.ToError(err)
 }
+
+func (g frontendClient) UpdateWorkflowMemo(ctx context.Context, up1 *types.UpdateWorkflowMemoRequest, p1 ...yarpc.CallOption) (up2 *types.UpdateWorkflowMemoResponse, err error) {
+	response, err := g.c.UpdateWorkflowMemo(ctx, thrift.FromUpdateWorkflowMemoRequest(up1), p1...)
+	return thrift.ToUpdateWorkflowMemoResponse(response), thrift.ToError(err)
+}
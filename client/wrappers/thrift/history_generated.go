@@ -42,6 +42,11 @@ func (g historyClient) DescribeWorkflowExecution(ctx context.Context, hp1 *types
 	return thrift.ToHistoryDescribeWorkflowExecutionResponse(response), thrift.ToError(err)
 }
 
+func (g historyClient) GetCompletionCallbacks(ctx context.Context, gp1 *types.GetCompletionCallbacksRequest, p1 ...yarpc.CallOption) (gp2 *types.GetCompletionCallbacksResponse, err error) {
+	response, err := g.c.GetCompletionCallbacks(ctx, thrift.FromHistoryGetCompletionCallbacksRequest(gp1), p1...)
+	return thrift.ToHistoryGetCompletionCallbacksResponse(response), thrift.ToError(err)
+}
+
 func (g historyClient) GetCrossClusterTasks(ctx context.Context, gp1 *types.GetCrossClusterTasksRequest, p1 ...yarpc.CallOption) (gp2 *types.GetCrossClusterTasksResponse, err error) {
 	response, err := g.c.GetCrossClusterTasks(ctx, thrift.FromHistoryGetCrossClusterTasksRequest(gp1), p1...)
 	return thrift.ToHistoryGetCrossClusterTasksResponse(response), thrift.ToError(err)
@@ -122,6 +127,11 @@ func (g historyClient) RecordChildExecutionCompleted(ctx context.Context, rp1 *t
 	return thrift.ToError(err)
 }
 
+func (g historyClient) RecordCompletionCallbackState(ctx context.Context, rp1 *types.RecordCompletionCallbackStateRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.RecordCompletionCallbackState(ctx, thrift.FromHistoryRecordCompletionCallbackStateRequest(rp1), p1...)
+	return thrift.ToError(err)
+}
+
 func (g historyClient) RecordDecisionTaskStarted(ctx context.Context, rp1 *types.RecordDecisionTaskStartedRequest, p1 ...yarpc.CallOption) (rp2 *types.RecordDecisionTaskStartedResponse, err error) {
 	response, err := g.c.RecordDecisionTaskStarted(ctx, thrift.FromHistoryRecordDecisionTaskStartedRequest(rp1), p1...)
 	return thrift.ToHistoryRecordDecisionTaskStartedResponse(response), thrift.ToError(err)
@@ -231,3 +241,8 @@ func (g historyClient) TerminateWorkflowExecution(ctx context.Context, hp1 *type
 	err = g.c.TerminateWorkflowExecution(ctx, thrift.FromHistoryTerminateWorkflowExecutionRequest(hp1), p1...)
 	return thrift.ToError(err)
 }
+
+func (g historyClient) UpdateWorkflowMemo(ctx context.Context, hp1 *types.HistoryUpdateWorkflowMemoRequest, p1 ...yarpc.CallOption) (err error) {
+	err = g.c.UpdateWorkflowMemo(ctx, thrift.FromHistoryUpdateWorkflowMemoRequest(hp1), p1...)
+	return thrift.ToError(err)
+}
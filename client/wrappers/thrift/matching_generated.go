@@ -34,11 +34,23 @@ func (g matchingClient) CancelOutstandingPoll(ctx context.Context, cp1 *types.Ca
 	return thrift.ToError(err)
 }
 
+func (g matchingClient) DescribeDomainTaskQuota(ctx context.Context, mp1 *types.MatchingDescribeDomainTaskQuotaRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingDescribeDomainTaskQuotaResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
 func (g matchingClient) DescribeTaskList(ctx context.Context, mp1 *types.MatchingDescribeTaskListRequest, p1 ...yarpc.CallOption) (dp1 *types.DescribeTaskListResponse, err error) {
 	response, err := g.c.DescribeTaskList(ctx, thrift.FromMatchingDescribeTaskListRequest(mp1), p1...)
 	return thrift.ToMatchingDescribeTaskListResponse(response), thrift.ToError(err)
 }
 
+func (g matchingClient) DescribeWorker(ctx context.Context, mp1 *types.MatchingDescribeWorkerRequest, p1 ...yarpc.CallOption) (dp1 *types.DescribeWorkerResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
+func (g matchingClient) GetTaskListScalingRecommendation(ctx context.Context, mp1 *types.MatchingGetTaskListScalingRecommendationRequest, p1 ...yarpc.CallOption) (gp1 *types.GetTaskListScalingRecommendationResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
 func (g matchingClient) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest, p1 ...yarpc.CallOption) (gp2 *types.GetTaskListsByDomainResponse, err error) {
 	response, err := g.c.GetTaskListsByDomain(ctx, thrift.FromMatchingGetTaskListsByDomainRequest(gp1), p1...)
 	return thrift.ToMatchingGetTaskListsByDomainResponse(response), thrift.ToError(err)
@@ -49,6 +61,14 @@ func (g matchingClient) ListTaskListPartitions(ctx context.Context, mp1 *types.M
 	return thrift.ToMatchingListTaskListPartitionsResponse(response), thrift.ToError(err)
 }
 
+func (g matchingClient) ListWorkers(ctx context.Context, mp1 *types.MatchingListWorkersRequest, p1 ...yarpc.CallOption) (lp1 *types.ListWorkersResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
+func (g matchingClient) MigrateTaskList(ctx context.Context, mp1 *types.MatchingMigrateTaskListRequest, p1 ...yarpc.CallOption) (mp2 *types.MigrateTaskListResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
 func (g matchingClient) PollForActivityTask(ctx context.Context, mp1 *types.MatchingPollForActivityTaskRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingPollForActivityTaskResponse, err error) {
 	response, err := g.c.PollForActivityTask(ctx, thrift.FromMatchingPollForActivityTaskRequest(mp1), p1...)
 	return thrift.ToMatchingPollForActivityTaskResponse(response), thrift.ToError(err)
@@ -76,3 +96,7 @@ func (g matchingClient) RespondQueryTaskCompleted(ctx context.Context, mp1 *type
 func (g matchingClient) UpdateTaskListPartitionConfig(ctx context.Context, mp1 *types.MatchingUpdateTaskListPartitionConfigRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingUpdateTaskListPartitionConfigResponse, err error) {
 	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
 }
+
+func (g matchingClient) UpdateTaskListVersioningConfig(ctx context.Context, mp1 *types.MatchingUpdateTaskListVersioningConfigRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingUpdateTaskListVersioningConfigResponse, err error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
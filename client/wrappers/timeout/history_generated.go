@@ -67,6 +67,12 @@ func (c *historyClient) DescribeWorkflowExecution(ctx context.Context, hp1 *type
 	return c.client.DescribeWorkflowExecution(ctx, hp1, p1...)
 }
 
+func (c *historyClient) GetCompletionCallbacks(ctx context.Context, gp1 *types.GetCompletionCallbacksRequest, p1 ...yarpc.CallOption) (gp2 *types.GetCompletionCallbacksResponse, err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.GetCompletionCallbacks(ctx, gp1, p1...)
+}
+
 func (c *historyClient) GetCrossClusterTasks(ctx context.Context, gp1 *types.GetCrossClusterTasksRequest, p1 ...yarpc.CallOption) (gp2 *types.GetCrossClusterTasksResponse, err error) {
 	return c.client.GetCrossClusterTasks(ctx, gp1, p1...)
 }
@@ -149,6 +155,12 @@ func (c *historyClient) RecordChildExecutionCompleted(ctx context.Context, rp1 *
 	return c.client.RecordChildExecutionCompleted(ctx, rp1, p1...)
 }
 
+func (c *historyClient) RecordCompletionCallbackState(ctx context.Context, rp1 *types.RecordCompletionCallbackStateRequest, p1 ...yarpc.CallOption) (err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.RecordCompletionCallbackState(ctx, rp1, p1...)
+}
+
 func (c *historyClient) RecordDecisionTaskStarted(ctx context.Context, rp1 *types.RecordDecisionTaskStartedRequest, p1 ...yarpc.CallOption) (rp2 *types.RecordDecisionTaskStartedResponse, err error) {
 	ctx, cancel := createContext(ctx, c.timeout)
 	defer cancel()
@@ -280,3 +292,9 @@ func (c *historyClient) TerminateWorkflowExecution(ctx context.Context, hp1 *typ
 	defer cancel()
 	return c.client.TerminateWorkflowExecution(ctx, hp1, p1...)
 }
+
+func (c *historyClient) UpdateWorkflowMemo(ctx context.Context, hp1 *types.HistoryUpdateWorkflowMemoRequest, p1 ...yarpc.CallOption) (err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.UpdateWorkflowMemo(ctx, hp1, p1...)
+}
@@ -54,12 +54,26 @@ func (c *matchingClient) CancelOutstandingPoll(ctx context.Context, cp1 *types.C
 	return c.client.CancelOutstandingPoll(ctx, cp1, p1...)
 }
 
+func (c *matchingClient) DescribeDomainTaskQuota(ctx context.Context, mp1 *types.MatchingDescribeDomainTaskQuotaRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingDescribeDomainTaskQuotaResponse, err error) {
+	return c.client.DescribeDomainTaskQuota(ctx, mp1, p1...)
+}
+
 func (c *matchingClient) DescribeTaskList(ctx context.Context, mp1 *types.MatchingDescribeTaskListRequest, p1 ...yarpc.CallOption) (dp1 *types.DescribeTaskListResponse, err error) {
 	ctx, cancel := createContext(ctx, c.timeout)
 	defer cancel()
 	return c.client.DescribeTaskList(ctx, mp1, p1...)
 }
 
+func (c *matchingClient) DescribeWorker(ctx context.Context, mp1 *types.MatchingDescribeWorkerRequest, p1 ...yarpc.CallOption) (dp1 *types.DescribeWorkerResponse, err error) {
+	return c.client.DescribeWorker(ctx, mp1, p1...)
+}
+
+func (c *matchingClient) GetTaskListScalingRecommendation(ctx context.Context, mp1 *types.MatchingGetTaskListScalingRecommendationRequest, p1 ...yarpc.CallOption) (gp1 *types.GetTaskListScalingRecommendationResponse, err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.GetTaskListScalingRecommendation(ctx, mp1, p1...)
+}
+
 func (c *matchingClient) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest, p1 ...yarpc.CallOption) (gp2 *types.GetTaskListsByDomainResponse, err error) {
 	return c.client.GetTaskListsByDomain(ctx, gp1, p1...)
 }
@@ -70,6 +84,16 @@ func (c *matchingClient) ListTaskListPartitions(ctx context.Context, mp1 *types.
 	return c.client.ListTaskListPartitions(ctx, mp1, p1...)
 }
 
+func (c *matchingClient) ListWorkers(ctx context.Context, mp1 *types.MatchingListWorkersRequest, p1 ...yarpc.CallOption) (lp1 *types.ListWorkersResponse, err error) {
+	return c.client.ListWorkers(ctx, mp1, p1...)
+}
+
+func (c *matchingClient) MigrateTaskList(ctx context.Context, mp1 *types.MatchingMigrateTaskListRequest, p1 ...yarpc.CallOption) (mp2 *types.MigrateTaskListResponse, err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.MigrateTaskList(ctx, mp1, p1...)
+}
+
 func (c *matchingClient) PollForActivityTask(ctx context.Context, mp1 *types.MatchingPollForActivityTaskRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingPollForActivityTaskResponse, err error) {
 	ctx, cancel := createContext(ctx, c.longPollTimeout)
 	defer cancel()
@@ -105,3 +129,9 @@ func (c *matchingClient) UpdateTaskListPartitionConfig(ctx context.Context, mp1
 	defer cancel()
 	return c.client.UpdateTaskListPartitionConfig(ctx, mp1, p1...)
 }
+
+func (c *matchingClient) UpdateTaskListVersioningConfig(ctx context.Context, mp1 *types.MatchingUpdateTaskListVersioningConfigRequest, p1 ...yarpc.CallOption) (mp2 *types.MatchingUpdateTaskListVersioningConfigResponse, err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.UpdateTaskListVersioningConfig(ctx, mp1, p1...)
+}
@@ -33,8 +33,42 @@ func NewShardDistributorClient(
 	}
 }
 
+func (c *sharddistributorClient) DescribeNamespace(ctx context.Context, dp1 *types.DescribeNamespaceRequest, p1 ...yarpc.CallOption) (dp2 *types.DescribeNamespaceResponse, err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.DescribeNamespace(ctx, dp1, p1...)
+}
+
 func (c *sharddistributorClient) GetShardOwner(ctx context.Context, gp1 *types.GetShardOwnerRequest, p1 ...yarpc.CallOption) (gp2 *types.GetShardOwnerResponse, err error) {
 	ctx, cancel := createContext(ctx, c.timeout)
 	defer cancel()
 	return c.client.GetShardOwner(ctx, gp1, p1...)
 }
+
+func (c *sharddistributorClient) ListNamespaces(ctx context.Context, lp1 *types.ListNamespacesRequest, p1 ...yarpc.CallOption) (lp2 *types.ListNamespacesResponse, err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.ListNamespaces(ctx, lp1, p1...)
+}
+
+func (c *sharddistributorClient) MoveShard(ctx context.Context, mp1 *types.MoveShardRequest, p1 ...yarpc.CallOption) (mp2 *types.MoveShardResponse, err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.MoveShard(ctx, mp1, p1...)
+}
+
+func (c *sharddistributorClient) SetExecutorDrained(ctx context.Context, sp1 *types.SetExecutorDrainedRequest, p1 ...yarpc.CallOption) (sp2 *types.SetExecutorDrainedResponse, err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.SetExecutorDrained(ctx, sp1, p1...)
+}
+
+func (c *sharddistributorClient) SetRebalancingFrozen(ctx context.Context, sp1 *types.SetRebalancingFrozenRequest, p1 ...yarpc.CallOption) (sp2 *types.SetRebalancingFrozenResponse, err error) {
+	ctx, cancel := createContext(ctx, c.timeout)
+	defer cancel()
+	return c.client.SetRebalancingFrozen(ctx, sp1, p1...)
+}
+
+func (c *sharddistributorClient) WatchNamespaceState(ctx context.Context, wp1 *types.WatchNamespaceStateRequest, p1 ...yarpc.CallOption) (wp2 *types.WatchNamespaceStateResponse, err error) {
+	return c.client.WatchNamespaceState(ctx, wp1, p1...)
+}
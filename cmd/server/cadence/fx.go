@@ -75,7 +75,7 @@ func Module(serviceName string) fx.Option {
 			fx.Decorate(func(z *zap.Logger, l log.Logger) (*zap.Logger, log.Logger) {
 				return z.With(zap.String("service", service.ShardDistributor)), l.WithTags(tag.Service(service.ShardDistributor))
 			}),
-			store.Module("etcd"),
+			store.Module(),
 
 			rpcfx.Module,
 			// PeerProvider could be overriden e.g. with a DNS based internal solution.
@@ -22,6 +22,7 @@ package cadence
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/startreedata/pinot-client-go/pinot"
@@ -29,6 +30,7 @@ import (
 	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
 	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	"go.uber.org/cadence/compatibility"
+	"go.uber.org/yarpc/transport/tchannel"
 
 	sharddistributorv1 "github.com/uber/cadence/.gen/proto/sharddistributor/v1"
 	sharddistributorClient "github.com/uber/cadence/client/sharddistributor"
@@ -55,6 +57,9 @@ import (
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging/kafka"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/peerprovider"
+	"github.com/uber/cadence/common/peerprovider/dnsprovider"
+	"github.com/uber/cadence/common/peerprovider/fileprovider"
 	"github.com/uber/cadence/common/peerprovider/ringpopprovider"
 	pnt "github.com/uber/cadence/common/pinot"
 	"github.com/uber/cadence/common/resource"
@@ -84,6 +89,8 @@ type (
 	}
 )
 
+var registerPeerProviderOnce sync.Once
+
 // newServer returns a new instance of a daemon
 // that represents a cadence service
 func newServer(service string, cfg config.Config, logger log.Logger, dynamicCfgClient dynamicconfig.Client, scope tally.Scope, metricsClient metrics.Client) common.Daemon {
@@ -156,9 +163,8 @@ func (s *server) startService() common.Daemon {
 	rpcFactory := rpc.NewFactory(params.Logger, rpcParams)
 	params.RPCFactory = rpcFactory
 
-	peerProvider, err := ringpopprovider.New(
+	peerProvider, err := s.newPeerProvider(
 		params.Name,
-		&s.cfg.Ringpop,
 		rpcFactory.GetTChannel(),
 		membership.PortMap{
 			membership.PortGRPC:     svcCfg.RPC.GRPCPort,
@@ -168,7 +174,7 @@ func (s *server) startService() common.Daemon {
 	)
 
 	if err != nil {
-		s.logger.Fatal("ringpop provider failed", tag.Error(err))
+		s.logger.Fatal("peer provider failed", tag.Error(err))
 	}
 
 	shardDistributorClient := s.createShardDistributorClient(params, dc)
@@ -207,7 +213,9 @@ func (s *server) startService() common.Daemon {
 		dynamicproperties.WriteVisibilityStoreName,
 	)()
 	isAdvancedVisEnabled := common.IsAdvancedVisibilityWritingEnabled(advancedVisMode, params.PersistenceConfig.IsAdvancedVisibilityConfigExist())
-	if isAdvancedVisEnabled {
+	// with the persistence transport, visibility messages go through a queue in the default store and the
+	// messaging client is created along with the persistence layer of each service
+	if isAdvancedVisEnabled && !params.PersistenceConfig.IsPersistenceVisibilityTransport() {
 		params.MessagingClient = kafka.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, params.Logger, params.MetricScope, isAdvancedVisEnabled)
 	} else {
 		params.MessagingClient = nil
@@ -281,6 +289,44 @@ func (s *server) startService() common.Daemon {
 	return daemon
 }
 
+// newPeerProvider uses ringpop unless a peer provider plugin is configured under membership.provider
+func (s *server) newPeerProvider(
+	serviceName string,
+	channel tchannel.Channel,
+	portMap membership.PortMap,
+	logger log.Logger,
+) (membership.PeerProvider, error) {
+	if len(s.cfg.Membership.Provider) == 0 {
+		return ringpopprovider.New(serviceName, &s.cfg.Ringpop, channel, portMap, logger)
+	}
+
+	// only one plugin can be registered per process, while several services may run in it
+	registerPeerProviderOnce.Do(func() {
+		registerBuiltinPeerProvider(s.cfg.Membership.Provider, logger)
+	})
+
+	return peerprovider.New(s.cfg.Membership.Provider, peerprovider.Container{
+		Service: serviceName,
+		Channel: channel,
+		Logger:  logger,
+		Portmap: portMap,
+	}).Provider()
+}
+
+func registerBuiltinPeerProvider(cfg config.PeerProvider, logger log.Logger) {
+	var err error
+	if _, ok := cfg[fileprovider.ConfigKey]; ok {
+		err = fileprovider.Register()
+	} else if _, ok := cfg[dnsprovider.ConfigKey]; ok {
+		err = dnsprovider.Register()
+	}
+
+	if err != nil {
+		// a custom plugin registered by the binary takes precedence over the built-in ones
+		logger.Warn("built-in peer provider was not registered", tag.Error(err))
+	}
+}
+
 func (*server) newMethod(
 	hashRings map[string]membership.SingleProvider,
 	shardDistributorClient sharddistributorClient.Client,
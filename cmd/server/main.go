@@ -28,12 +28,15 @@ import (
 	"github.com/uber/cadence/tools/common/commoncli"
 
 	_ "github.com/uber/cadence/common/archiver/gcloud"                                      // needed to load the optional gcloud archiver plugin
+	_ "github.com/uber/cadence/common/asyncworkflow/queue/database"                         // needed to load database asyncworkflow queue
 	_ "github.com/uber/cadence/common/asyncworkflow/queue/kafka"                            // needed to load kafka asyncworkflow queue
+	_ "github.com/uber/cadence/common/asyncworkflow/queue/mapq"                             // needed to load mapq asyncworkflow queue
 	_ "github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra"              // needed to load cassandra plugin
 	_ "github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql/public" // needed to load the default gocql client
 	_ "github.com/uber/cadence/common/persistence/sql/sqlplugin/mysql"                      // needed to load mysql plugin
 	_ "github.com/uber/cadence/common/persistence/sql/sqlplugin/postgres"                   // needed to load postgres plugin
 	_ "github.com/uber/cadence/common/persistence/sql/sqlplugin/sqlite"                     // needed to load sqlite plugin
+	_ "github.com/uber/cadence/service/sharddistributor/leader/store/database"              // needed for shard distributor leader election on the database
 	_ "github.com/uber/cadence/service/sharddistributor/leader/store/etcd"                  // needed for shard distributor leader election
 )
 
@@ -30,6 +30,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/.gen/go/shared"
@@ -43,14 +44,17 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
 )
 
 const (
 	defaultShutdownTimeout = 5 * time.Second
-	defaultStartWFTimeout  = 3 * time.Second
+	defaultFrontendTimeout = 3 * time.Second
 	defaultConcurrency     = 100
+
+	defaultPersistenceTimeout = 3 * time.Second
 )
 
 type DefaultConsumer struct {
@@ -63,9 +67,12 @@ type DefaultConsumer struct {
 	cancelFn        context.CancelFunc
 	wg              sync.WaitGroup
 	shutdownTimeout time.Duration
-	startWFTimeout  time.Duration
+	frontendTimeout time.Duration
 	msgDecoder      codec.BinaryEncoder
 	concurrency     int
+	// asyncRequestManager is used to record the status of tracked requests and to keep the requests that
+	// failed permanently in the DLQ. If it's not set, failed requests are nacked to the underlying queue.
+	asyncRequestManager persistence.AsyncRequestManager
 }
 
 type Option func(*DefaultConsumer)
@@ -76,6 +83,13 @@ func WithConcurrency(concurrency int) Option {
 	}
 }
 
+// WithAsyncRequestManager enables request status tracking and the persistence backed DLQ
+func WithAsyncRequestManager(asyncRequestManager persistence.AsyncRequestManager) Option {
+	return func(c *DefaultConsumer) {
+		c.asyncRequestManager = asyncRequestManager
+	}
+}
+
 func New(
 	queueID string,
 	innerConsumer messaging.Consumer,
@@ -94,7 +108,7 @@ func New(
 		ctx:             ctx,
 		cancelFn:        cancelFn,
 		shutdownTimeout: defaultShutdownTimeout,
-		startWFTimeout:  defaultStartWFTimeout,
+		frontendTimeout: defaultFrontendTimeout,
 		msgDecoder:      codec.NewThriftRWEncoder(),
 		concurrency:     defaultConcurrency,
 	}
@@ -169,15 +183,28 @@ func (c *DefaultConsumer) processMessage(msg messaging.Message) {
 		return
 	}
 
-	logTags, err := c.processRequest(logger, &request)
+	if request.GetRequestID() != "" {
+		logger = logger.WithTags(tag.WorkflowRequestID(request.GetRequestID()))
+	}
+	c.updateRequestState(logger, &request, types.AsyncRequestStateProcessing, "", "")
+
+	logTags, runID, err := c.processRequest(logger, &request)
 	if err != nil {
 		logger.Error("Failed to process message", append(logTags, tag.Error(err))...)
+		if c.enqueueToDLQ(logger, msg, &request, err) {
+			c.updateRequestState(logger, &request, types.AsyncRequestStateFailed, "", err.Error())
+			if ackErr := msg.Ack(); ackErr != nil {
+				logger.Error("Failed to ack message", append(logTags, tag.Error(ackErr))...)
+			}
+			return
+		}
 		if nackErr := msg.Nack(); nackErr != nil {
 			logger.Error("Failed to nack message", append(logTags, tag.Dynamic("original-error", err.Error()), tag.Error(nackErr))...)
 		}
 		return
 	}
 
+	c.updateRequestState(logger, &request, types.AsyncRequestStateSucceeded, runID, "")
 	logger = logger.WithTags(logTags...)
 	if err := msg.Ack(); err != nil {
 		logger.Error("Failed to ack message", tag.Error(err))
@@ -185,16 +212,17 @@ func (c *DefaultConsumer) processMessage(msg messaging.Message) {
 	logger.Info("Processed message successfully")
 }
 
-func (c *DefaultConsumer) processRequest(logger log.Logger, request *sqlblobs.AsyncRequestMessage) ([]tag.Tag, error) {
+func (c *DefaultConsumer) processRequest(logger log.Logger, request *sqlblobs.AsyncRequestMessage) ([]tag.Tag, string, error) {
 	requestType := request.GetType().String()
 	scope := c.scope.Tagged(metrics.AsyncWFRequestTypeTag(requestType))
 	logTags := []tag.Tag{tag.AsyncWFRequestType(requestType)}
+	var runID string
 	switch request.GetType() {
 	case sqlblobs.AsyncRequestTypeStartWorkflowExecutionAsyncRequest:
 		startWFReq, err := c.decodeStartWorkflowRequest(request.GetPayload(), request.GetEncoding())
 		if err != nil {
 			scope.IncCounter(metrics.AsyncWorkflowFailureCorruptMsgCount)
-			return logTags, err
+			return logTags, "", err
 		}
 
 		yarpcCallOpts := getYARPCOptions(request.GetHeader())
@@ -203,7 +231,7 @@ func (c *DefaultConsumer) processRequest(logger log.Logger, request *sqlblobs.As
 
 		var resp *types.StartWorkflowExecutionResponse
 		op := func(ctx1 context.Context) error {
-			ctx, cancel := context.WithTimeout(ctx1, c.startWFTimeout)
+			ctx, cancel := context.WithTimeout(ctx1, c.frontendTimeout)
 			defer cancel()
 			resp, err = c.frontendClient.StartWorkflowExecution(ctx, startWFReq, yarpcCallOpts...)
 
@@ -217,16 +245,17 @@ func (c *DefaultConsumer) processRequest(logger log.Logger, request *sqlblobs.As
 
 		if err := callFrontendWithRetries(c.ctx, op); err != nil {
 			scope.IncCounter(metrics.AsyncWorkflowFailureByFrontendCount)
-			return logTags, fmt.Errorf("start workflow execution failed after all attempts: %w", err)
+			return logTags, "", fmt.Errorf("start workflow execution failed after all attempts: %w", err)
 		}
 
-		logTags = append(logTags, tag.WorkflowRunID(resp.GetRunID()))
+		runID = resp.GetRunID()
+		logTags = append(logTags, tag.WorkflowRunID(runID))
 		scope.IncCounter(metrics.AsyncWorkflowSuccessCount)
 	case sqlblobs.AsyncRequestTypeSignalWithStartWorkflowExecutionAsyncRequest:
 		startWFReq, err := c.decodeSignalWithStartWorkflowRequest(request.GetPayload(), request.GetEncoding())
 		if err != nil {
 			c.scope.IncCounter(metrics.AsyncWorkflowFailureCorruptMsgCount)
-			return logTags, err
+			return logTags, "", err
 		}
 
 		yarpcCallOpts := getYARPCOptions(request.GetHeader())
@@ -234,7 +263,7 @@ func (c *DefaultConsumer) processRequest(logger log.Logger, request *sqlblobs.As
 		logTags = append(logTags, tag.WorkflowDomainName(startWFReq.GetDomain()), tag.WorkflowID(startWFReq.GetWorkflowID()))
 		var resp *types.StartWorkflowExecutionResponse
 		op := func(ctx1 context.Context) error {
-			ctx, cancel := context.WithTimeout(ctx1, c.startWFTimeout)
+			ctx, cancel := context.WithTimeout(ctx1, c.frontendTimeout)
 			defer cancel()
 			resp, err = c.frontendClient.SignalWithStartWorkflowExecution(ctx, startWFReq, yarpcCallOpts...)
 
@@ -248,17 +277,173 @@ func (c *DefaultConsumer) processRequest(logger log.Logger, request *sqlblobs.As
 
 		if err := callFrontendWithRetries(c.ctx, op); err != nil {
 			scope.IncCounter(metrics.AsyncWorkflowFailureByFrontendCount)
-			return logTags, fmt.Errorf("signal with start workflow execution failed after all attempts: %w", err)
+			return logTags, "", fmt.Errorf("signal with start workflow execution failed after all attempts: %w", err)
+		}
+
+		scope.IncCounter(metrics.AsyncWorkflowSuccessCount)
+		runID = resp.GetRunID()
+		logTags = append(logTags, tag.WorkflowRunID(runID))
+	case sqlblobs.AsyncRequestTypeSignalWorkflowExecutionAsyncRequest:
+		signalReq, err := c.decodeSignalWorkflowRequest(request.GetPayload(), request.GetEncoding())
+		if err != nil {
+			scope.IncCounter(metrics.AsyncWorkflowFailureCorruptMsgCount)
+			return logTags, "", err
+		}
+
+		yarpcCallOpts := getYARPCOptions(request.GetHeader())
+		scope := scope.Tagged(metrics.DomainTag(signalReq.GetDomain()))
+		logTags = append(logTags, tag.WorkflowDomainName(signalReq.GetDomain()), tag.WorkflowID(signalReq.GetWorkflowExecution().GetWorkflowID()), tag.WorkflowSignalName(signalReq.GetSignalName()))
+		op := func(ctx1 context.Context) error {
+			ctx, cancel := context.WithTimeout(ctx1, c.frontendTimeout)
+			defer cancel()
+			return c.frontendClient.SignalWorkflowExecution(ctx, signalReq, yarpcCallOpts...)
+		}
+
+		if err := callFrontendWithRetries(c.ctx, op); err != nil {
+			scope.IncCounter(metrics.AsyncWorkflowFailureByFrontendCount)
+			return logTags, "", fmt.Errorf("signal workflow execution failed after all attempts: %w", err)
+		}
+
+		scope.IncCounter(metrics.AsyncWorkflowSuccessCount)
+	case sqlblobs.AsyncRequestTypeRequestCancelWorkflowExecutionAsyncRequest:
+		cancelReq, err := c.decodeRequestCancelWorkflowRequest(request.GetPayload(), request.GetEncoding())
+		if err != nil {
+			scope.IncCounter(metrics.AsyncWorkflowFailureCorruptMsgCount)
+			return logTags, "", err
+		}
+
+		yarpcCallOpts := getYARPCOptions(request.GetHeader())
+		scope := scope.Tagged(metrics.DomainTag(cancelReq.GetDomain()))
+		logTags = append(logTags, tag.WorkflowDomainName(cancelReq.GetDomain()), tag.WorkflowID(cancelReq.GetWorkflowExecution().GetWorkflowID()))
+		op := func(ctx1 context.Context) error {
+			ctx, cancel := context.WithTimeout(ctx1, c.frontendTimeout)
+			defer cancel()
+			err := c.frontendClient.RequestCancelWorkflowExecution(ctx, cancelReq, yarpcCallOpts...)
+
+			var alreadyRequestedError *types.CancellationAlreadyRequestedError
+			if errors.As(err, &alreadyRequestedError) {
+				logger.Info("Received CancellationAlreadyRequestedError, treating it as a success", tag.WorkflowID(cancelReq.GetWorkflowExecution().GetWorkflowID()))
+				return nil
+			}
+			return err
+		}
+
+		if err := callFrontendWithRetries(c.ctx, op); err != nil {
+			scope.IncCounter(metrics.AsyncWorkflowFailureByFrontendCount)
+			return logTags, "", fmt.Errorf("request cancel workflow execution failed after all attempts: %w", err)
+		}
+
+		scope.IncCounter(metrics.AsyncWorkflowSuccessCount)
+	case sqlblobs.AsyncRequestTypeTerminateWorkflowExecutionAsyncRequest:
+		terminateReq, err := c.decodeTerminateWorkflowRequest(request.GetPayload(), request.GetEncoding())
+		if err != nil {
+			scope.IncCounter(metrics.AsyncWorkflowFailureCorruptMsgCount)
+			return logTags, "", err
+		}
+
+		yarpcCallOpts := getYARPCOptions(request.GetHeader())
+		scope := scope.Tagged(metrics.DomainTag(terminateReq.GetDomain()))
+		logTags = append(logTags, tag.WorkflowDomainName(terminateReq.GetDomain()), tag.WorkflowID(terminateReq.GetWorkflowExecution().GetWorkflowID()))
+		op := func(ctx1 context.Context) error {
+			ctx, cancel := context.WithTimeout(ctx1, c.frontendTimeout)
+			defer cancel()
+			return c.frontendClient.TerminateWorkflowExecution(ctx, terminateReq, yarpcCallOpts...)
+		}
+
+		if err := callFrontendWithRetries(c.ctx, op); err != nil {
+			scope.IncCounter(metrics.AsyncWorkflowFailureByFrontendCount)
+			return logTags, "", fmt.Errorf("terminate workflow execution failed after all attempts: %w", err)
 		}
 
 		scope.IncCounter(metrics.AsyncWorkflowSuccessCount)
-		logTags = append(logTags, tag.WorkflowRunID(resp.GetRunID()))
 	default:
 		c.scope.IncCounter(metrics.AsyncWorkflowFailureCorruptMsgCount)
-		return logTags, &UnsupportedRequestType{Type: request.GetType()}
+		return logTags, "", &UnsupportedRequestType{Type: request.GetType()}
 	}
 
-	return logTags, nil
+	return logTags, runID, nil
+}
+
+// updateRequestState records the state of a tracked request. Requests are tracked if the frontend recorded
+// them before publishing, which is signaled by the domain ID being set on the message. Failing to update the
+// state doesn't fail the request.
+func (c *DefaultConsumer) updateRequestState(
+	logger log.Logger,
+	request *sqlblobs.AsyncRequestMessage,
+	state types.AsyncRequestState,
+	runID string,
+	failure string,
+) {
+	if c.asyncRequestManager == nil || request.GetDomainID() == "" || request.GetRequestID() == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.ctx, defaultPersistenceTimeout)
+	defer cancel()
+	resp, err := c.asyncRequestManager.GetAsyncRequest(ctx, &persistence.GetAsyncRequestRequest{
+		DomainID:  request.GetDomainID(),
+		RequestID: request.GetRequestID(),
+	})
+	if err != nil {
+		var notExistsErr *types.EntityNotExistsError
+		if !errors.As(err, &notExistsErr) {
+			logger.Warn("Failed to get async request status", tag.Error(err))
+		}
+		return
+	}
+
+	info := resp.Request
+	info.State = state
+	if runID != "" {
+		info.RunID = runID
+	}
+	info.Failure = failure
+	if err := c.asyncRequestManager.UpsertAsyncRequest(ctx, &persistence.UpsertAsyncRequestRequest{Request: info}); err != nil {
+		logger.Warn("Failed to update async request status", tag.Error(err), tag.Dynamic("state", state.String()))
+	}
+}
+
+// enqueueToDLQ keeps a request that failed permanently in the DLQ of its domain, from where it can be
+// inspected and replayed with the admin async-wf-queue dlq commands. It returns false if the request couldn't
+// be put into the DLQ, in which case the message should be nacked to the underlying queue instead.
+func (c *DefaultConsumer) enqueueToDLQ(
+	logger log.Logger,
+	msg messaging.Message,
+	request *sqlblobs.AsyncRequestMessage,
+	processErr error,
+) bool {
+	if c.asyncRequestManager == nil || request.GetDomainID() == "" {
+		return false
+	}
+	if c.ctx.Err() != nil {
+		// the consumer is shutting down so the request didn't really fail, let it be redelivered
+		return false
+	}
+
+	requestID := request.GetRequestID()
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+	ctx, cancel := context.WithTimeout(c.ctx, defaultPersistenceTimeout)
+	defer cancel()
+	err := c.asyncRequestManager.EnqueueAsyncRequestToDLQ(ctx, &persistence.EnqueueAsyncRequestToDLQRequest{
+		Entry: &persistence.AsyncRequestDLQEntry{
+			DomainID:    request.GetDomainID(),
+			RequestID:   requestID,
+			RequestType: int32(request.GetType()),
+			WorkflowID:  request.GetPartitionKey(),
+			Failure:     processErr.Error(),
+			Payload:     msg.Value(),
+		},
+	})
+	if err != nil {
+		c.scope.IncCounter(metrics.AsyncWorkflowDLQEnqueueFailureCount)
+		logger.Error("Failed to enqueue message to DLQ", tag.Error(err))
+		return false
+	}
+	c.scope.IncCounter(metrics.AsyncWorkflowDLQEnqueueCount)
+	logger.Warn("Enqueued message to DLQ")
+	return true
 }
 
 func callFrontendWithRetries(ctx context.Context, op func(ctx context.Context) error) error {
@@ -317,3 +502,45 @@ func (c *DefaultConsumer) decodeSignalWithStartWorkflowRequest(payload []byte, e
 	signalWithStartRequest := thrift.ToSignalWithStartWorkflowExecutionAsyncRequest(&thriftObj)
 	return signalWithStartRequest.SignalWithStartWorkflowExecutionRequest, nil
 }
+
+func (c *DefaultConsumer) decodeSignalWorkflowRequest(payload []byte, encoding string) (*types.SignalWorkflowExecutionRequest, error) {
+	if encoding != string(constants.EncodingTypeThriftRW) {
+		return nil, &UnsupportedEncoding{EncodingType: encoding}
+	}
+
+	var thriftObj shared.SignalWorkflowExecutionAsyncRequest
+	if err := c.msgDecoder.Decode(payload, &thriftObj); err != nil {
+		return nil, err
+	}
+
+	signalRequest := thrift.ToSignalWorkflowExecutionAsyncRequest(&thriftObj)
+	return signalRequest.SignalWorkflowExecutionRequest, nil
+}
+
+func (c *DefaultConsumer) decodeRequestCancelWorkflowRequest(payload []byte, encoding string) (*types.RequestCancelWorkflowExecutionRequest, error) {
+	if encoding != string(constants.EncodingTypeThriftRW) {
+		return nil, &UnsupportedEncoding{EncodingType: encoding}
+	}
+
+	var thriftObj shared.RequestCancelWorkflowExecutionAsyncRequest
+	if err := c.msgDecoder.Decode(payload, &thriftObj); err != nil {
+		return nil, err
+	}
+
+	cancelRequest := thrift.ToRequestCancelWorkflowExecutionAsyncRequest(&thriftObj)
+	return cancelRequest.RequestCancelWorkflowExecutionRequest, nil
+}
+
+func (c *DefaultConsumer) decodeTerminateWorkflowRequest(payload []byte, encoding string) (*types.TerminateWorkflowExecutionRequest, error) {
+	if encoding != string(constants.EncodingTypeThriftRW) {
+		return nil, &UnsupportedEncoding{EncodingType: encoding}
+	}
+
+	var thriftObj shared.TerminateWorkflowExecutionAsyncRequest
+	if err := c.msgDecoder.Decode(payload, &thriftObj); err != nil {
+		return nil, err
+	}
+
+	terminateRequest := thrift.ToTerminateWorkflowExecutionAsyncRequest(&thriftObj)
+	return terminateRequest.TerminateWorkflowExecutionRequest, nil
+}
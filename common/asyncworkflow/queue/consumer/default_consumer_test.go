@@ -23,10 +23,12 @@
 package consumer
 
 import (
+	"context"
 	"errors"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/yarpc"
 
@@ -39,6 +41,7 @@ import (
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
 )
@@ -62,6 +65,31 @@ var (
 			Input:        []byte("test-input"),
 		},
 	}
+
+	testSignalReq = &types.SignalWorkflowExecutionAsyncRequest{
+		SignalWorkflowExecutionRequest: &types.SignalWorkflowExecutionRequest{
+			Domain:            "test-domain",
+			WorkflowExecution: &types.WorkflowExecution{WorkflowID: "test-workflow-id"},
+			SignalName:        "test-signal-name",
+			Input:             []byte("test-input"),
+		},
+	}
+
+	testCancelReq = &types.RequestCancelWorkflowExecutionAsyncRequest{
+		RequestCancelWorkflowExecutionRequest: &types.RequestCancelWorkflowExecutionRequest{
+			Domain:            "test-domain",
+			WorkflowExecution: &types.WorkflowExecution{WorkflowID: "test-workflow-id"},
+			Cause:             "test-cause",
+		},
+	}
+
+	testTerminateReq = &types.TerminateWorkflowExecutionAsyncRequest{
+		TerminateWorkflowExecutionRequest: &types.TerminateWorkflowExecutionRequest{
+			Domain:            "test-domain",
+			WorkflowExecution: &types.WorkflowExecution{WorkflowID: "test-workflow-id"},
+			Reason:            "test-reason",
+		},
+	}
 )
 
 type fakeMessageConsumer struct {
@@ -129,6 +157,9 @@ func TestDefaultConsumer(t *testing.T) {
 		frontendErr                  error
 		expectStartRequest           bool
 		expectSignalWithStartRequest bool
+		expectSignalRequest          bool
+		expectCancelRequest          bool
+		expectTerminateRequest       bool
 		msgs                         []*fakeMessage
 	}{
 		{
@@ -227,6 +258,78 @@ func TestDefaultConsumer(t *testing.T) {
 			},
 			expectSignalWithStartRequest: true,
 		},
+		// signal, cancel and terminate test cases
+		{
+			name: "signalworkflow request with invalid payload content",
+			msgs: []*fakeMessage{
+				{val: mustGenerateAsyncRequestMsg(t, sqlblobs.AsyncRequestTypeSignalWorkflowExecutionAsyncRequest, thrift.FromSignalWorkflowExecutionAsyncRequest(testSignalReq), constants.EncodingTypeThriftRW, false), wantAck: false},
+			},
+		},
+		{
+			name:        "signalworkflow frontend error",
+			frontendErr: &types.InternalServiceError{Message: "oh no"},
+			msgs: []*fakeMessage{
+				{val: mustGenerateAsyncRequestMsg(t, sqlblobs.AsyncRequestTypeSignalWorkflowExecutionAsyncRequest, thrift.FromSignalWorkflowExecutionAsyncRequest(testSignalReq), constants.EncodingTypeThriftRW, true), wantAck: false},
+			},
+			expectSignalRequest: true,
+		},
+		{
+			name: "signalworkflow unsupported encoding type",
+			msgs: []*fakeMessage{
+				{val: mustGenerateAsyncRequestMsg(t, sqlblobs.AsyncRequestTypeSignalWorkflowExecutionAsyncRequest, thrift.FromSignalWorkflowExecutionAsyncRequest(testSignalReq), constants.EncodingTypeJSON, true), wantAck: false},
+			},
+		},
+		{
+			name: "signalworkflow ok",
+			msgs: []*fakeMessage{
+				{val: mustGenerateAsyncRequestMsg(t, sqlblobs.AsyncRequestTypeSignalWorkflowExecutionAsyncRequest, thrift.FromSignalWorkflowExecutionAsyncRequest(testSignalReq), constants.EncodingTypeThriftRW, true), wantAck: true},
+			},
+			expectSignalRequest: true,
+		},
+		{
+			name:        "requestcancelworkflow CancellationAlreadyRequestedError",
+			frontendErr: &types.CancellationAlreadyRequestedError{Message: "all good, already requested"},
+			msgs: []*fakeMessage{
+				{val: mustGenerateAsyncRequestMsg(t, sqlblobs.AsyncRequestTypeRequestCancelWorkflowExecutionAsyncRequest, thrift.FromRequestCancelWorkflowExecutionAsyncRequest(testCancelReq), constants.EncodingTypeThriftRW, true), wantAck: true},
+			},
+			expectCancelRequest: true,
+		},
+		{
+			name:        "requestcancelworkflow frontend error",
+			frontendErr: &types.EntityNotExistsError{Message: "not found"},
+			msgs: []*fakeMessage{
+				{val: mustGenerateAsyncRequestMsg(t, sqlblobs.AsyncRequestTypeRequestCancelWorkflowExecutionAsyncRequest, thrift.FromRequestCancelWorkflowExecutionAsyncRequest(testCancelReq), constants.EncodingTypeThriftRW, true), wantAck: false},
+			},
+			expectCancelRequest: true,
+		},
+		{
+			name: "requestcancelworkflow ok",
+			msgs: []*fakeMessage{
+				{val: mustGenerateAsyncRequestMsg(t, sqlblobs.AsyncRequestTypeRequestCancelWorkflowExecutionAsyncRequest, thrift.FromRequestCancelWorkflowExecutionAsyncRequest(testCancelReq), constants.EncodingTypeThriftRW, true), wantAck: true},
+			},
+			expectCancelRequest: true,
+		},
+		{
+			name: "terminateworkflow request with invalid payload content",
+			msgs: []*fakeMessage{
+				{val: mustGenerateAsyncRequestMsg(t, sqlblobs.AsyncRequestTypeTerminateWorkflowExecutionAsyncRequest, thrift.FromTerminateWorkflowExecutionAsyncRequest(testTerminateReq), constants.EncodingTypeThriftRW, false), wantAck: false},
+			},
+		},
+		{
+			name:        "terminateworkflow frontend error",
+			frontendErr: &types.InternalServiceError{Message: "oh no"},
+			msgs: []*fakeMessage{
+				{val: mustGenerateAsyncRequestMsg(t, sqlblobs.AsyncRequestTypeTerminateWorkflowExecutionAsyncRequest, thrift.FromTerminateWorkflowExecutionAsyncRequest(testTerminateReq), constants.EncodingTypeThriftRW, true), wantAck: false},
+			},
+			expectTerminateRequest: true,
+		},
+		{
+			name: "terminateworkflow ok",
+			msgs: []*fakeMessage{
+				{val: mustGenerateAsyncRequestMsg(t, sqlblobs.AsyncRequestTypeTerminateWorkflowExecutionAsyncRequest, thrift.FromTerminateWorkflowExecutionAsyncRequest(testTerminateReq), constants.EncodingTypeThriftRW, true), wantAck: true},
+			},
+			expectTerminateRequest: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -266,6 +369,37 @@ func TestDefaultConsumer(t *testing.T) {
 					}).MinTimes(1)
 			}
 
+			if tc.expectSignalRequest {
+				mockFrontend.EXPECT().
+					SignalWorkflowExecution(gomock.Any(), gomock.Any(), opts[0], opts[1]).
+					DoAndReturn(func(ctx interface{}, req *types.SignalWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+						if diff := cmp.Diff(testSignalReq.SignalWorkflowExecutionRequest, req); diff != "" {
+							t.Fatalf("Request mismatch (-want +got):\n%s", diff)
+						}
+						return tc.frontendErr
+					}).MinTimes(1)
+			}
+			if tc.expectCancelRequest {
+				mockFrontend.EXPECT().
+					RequestCancelWorkflowExecution(gomock.Any(), gomock.Any(), opts[0], opts[1]).
+					DoAndReturn(func(ctx interface{}, req *types.RequestCancelWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+						if diff := cmp.Diff(testCancelReq.RequestCancelWorkflowExecutionRequest, req); diff != "" {
+							t.Fatalf("Request mismatch (-want +got):\n%s", diff)
+						}
+						return tc.frontendErr
+					}).MinTimes(1)
+			}
+			if tc.expectTerminateRequest {
+				mockFrontend.EXPECT().
+					TerminateWorkflowExecution(gomock.Any(), gomock.Any(), opts[0], opts[1]).
+					DoAndReturn(func(ctx interface{}, req *types.TerminateWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+						if diff := cmp.Diff(testTerminateReq.TerminateWorkflowExecutionRequest, req); diff != "" {
+							t.Fatalf("Request mismatch (-want +got):\n%s", diff)
+						}
+						return tc.frontendErr
+					}).MinTimes(1)
+			}
+
 			c := New("queueid1", fakeConsumer, testlogger.New(t), metrics.NewNoopMetricsClient(), mockFrontend, WithConcurrency(2))
 			err := c.Start()
 			if tc.innerConsumerFailToStart != (err != nil) {
@@ -352,6 +486,31 @@ func mustGenerateSignalWithStartWorkflowExecutionRequestMsg(t *testing.T, encodi
 	return res
 }
 
+func mustGenerateAsyncRequestMsg(t *testing.T, requestType sqlblobs.AsyncRequestType, request codec.ThriftObject, encodingType constants.EncodingType, validPayload bool) []byte {
+	payload, err := codec.NewThriftRWEncoder().Encode(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !validPayload {
+		payload = []byte("invalid payload")
+	}
+
+	msg := &sqlblobs.AsyncRequestMessage{
+		Type:     requestType.Ptr(),
+		Header:   fakeHeaders(),
+		Encoding: common.StringPtr(string(encodingType)),
+		Payload:  payload,
+	}
+
+	res, err := codec.NewThriftRWEncoder().Encode(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return res
+}
+
 func mustGenerateUnsupportedRequestMsg(t *testing.T) []byte {
 	encoder := codec.NewThriftRWEncoder()
 	payload, err := encoder.Encode(thrift.FromStartWorkflowExecutionAsyncRequest(testStartReq))
@@ -383,3 +542,152 @@ func fakeHeaders() *shared.Header {
 		},
 	}
 }
+
+func TestDefaultConsumerRequestTracking(t *testing.T) {
+	trackedInfo := func() *persistence.AsyncRequestInfo {
+		return &persistence.AsyncRequestInfo{
+			DomainID:   "test-domain-id",
+			RequestID:  "test-request-id",
+			WorkflowID: "test-workflow-id",
+			State:      types.AsyncRequestStateQueued,
+		}
+	}
+	expectState := func(mgr *persistence.MockAsyncRequestManager, state types.AsyncRequestState, runID, failure string) *gomock.Call {
+		getReq := &persistence.GetAsyncRequestRequest{DomainID: "test-domain-id", RequestID: "test-request-id"}
+		mgr.EXPECT().GetAsyncRequest(gomock.Any(), getReq).Return(&persistence.GetAsyncRequestResponse{Request: trackedInfo()}, nil)
+		want := trackedInfo()
+		want.State = state
+		want.RunID = runID
+		want.Failure = failure
+		return mgr.EXPECT().UpsertAsyncRequest(gomock.Any(), &persistence.UpsertAsyncRequestRequest{Request: want}).Return(nil)
+	}
+
+	tests := []struct {
+		name        string
+		domainID    string
+		requestID   string
+		frontendErr error
+		setupMocks  func(*persistence.MockAsyncRequestManager)
+		wantAck     bool
+	}{
+		{
+			name:      "tracked request succeeded",
+			domainID:  "test-domain-id",
+			requestID: "test-request-id",
+			setupMocks: func(mgr *persistence.MockAsyncRequestManager) {
+				gomock.InOrder(
+					expectState(mgr, types.AsyncRequestStateProcessing, "", ""),
+					expectState(mgr, types.AsyncRequestStateSucceeded, "test-run-id", ""),
+				)
+			},
+			wantAck: true,
+		},
+		{
+			name:      "tracked request expired",
+			domainID:  "test-domain-id",
+			requestID: "test-request-id",
+			setupMocks: func(mgr *persistence.MockAsyncRequestManager) {
+				mgr.EXPECT().GetAsyncRequest(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{}).Times(2)
+			},
+			wantAck: true,
+		},
+		{
+			name:        "tracked request failed and moved to DLQ",
+			domainID:    "test-domain-id",
+			requestID:   "test-request-id",
+			frontendErr: &types.BadRequestError{Message: "bad request"},
+			setupMocks: func(mgr *persistence.MockAsyncRequestManager) {
+				expectState(mgr, types.AsyncRequestStateProcessing, "", "")
+				mgr.EXPECT().EnqueueAsyncRequestToDLQ(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, req *persistence.EnqueueAsyncRequestToDLQRequest) error {
+						assert.Equal(t, "test-domain-id", req.Entry.DomainID)
+						assert.Equal(t, "test-request-id", req.Entry.RequestID)
+						assert.Equal(t, int32(sqlblobs.AsyncRequestTypeStartWorkflowExecutionAsyncRequest), req.Entry.RequestType)
+						assert.Equal(t, "test-workflow-id", req.Entry.WorkflowID)
+						assert.Contains(t, req.Entry.Failure, "bad request")
+						assert.NotEmpty(t, req.Entry.Payload)
+						return nil
+					})
+				expectState(mgr, types.AsyncRequestStateFailed, "", "start workflow execution failed after all attempts: bad request")
+			},
+			wantAck: true,
+		},
+		{
+			name:        "request without request ID moved to DLQ",
+			domainID:    "test-domain-id",
+			frontendErr: &types.BadRequestError{Message: "bad request"},
+			setupMocks: func(mgr *persistence.MockAsyncRequestManager) {
+				mgr.EXPECT().EnqueueAsyncRequestToDLQ(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, req *persistence.EnqueueAsyncRequestToDLQRequest) error {
+						assert.NotEmpty(t, req.Entry.RequestID)
+						return nil
+					})
+			},
+			wantAck: true,
+		},
+		{
+			name:        "failed to move request to DLQ",
+			domainID:    "test-domain-id",
+			requestID:   "test-request-id",
+			frontendErr: &types.BadRequestError{Message: "bad request"},
+			setupMocks: func(mgr *persistence.MockAsyncRequestManager) {
+				expectState(mgr, types.AsyncRequestStateProcessing, "", "")
+				mgr.EXPECT().EnqueueAsyncRequestToDLQ(gomock.Any(), gomock.Any()).Return(errors.New("persistence error"))
+			},
+			wantAck: false,
+		},
+		{
+			name:        "message without domain ID is nacked",
+			frontendErr: &types.BadRequestError{Message: "bad request"},
+			setupMocks:  func(*persistence.MockAsyncRequestManager) {},
+			wantAck:     false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockFrontend := frontend.NewMockClient(ctrl)
+			mockMgr := persistence.NewMockAsyncRequestManager(ctrl)
+			tc.setupMocks(mockMgr)
+
+			mockFrontend.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&types.StartWorkflowExecutionResponse{RunID: "test-run-id"}, tc.frontendErr).MinTimes(1)
+
+			c := New("queueid1", &fakeMessageConsumer{}, testlogger.New(t), metrics.NewNoopMetricsClient(), mockFrontend, WithAsyncRequestManager(mockMgr))
+			msg := &fakeMessage{val: mustGenerateTrackedStartWorkflowExecutionRequestMsg(t, tc.domainID, tc.requestID)}
+			c.processMessage(msg)
+
+			assert.Equal(t, tc.wantAck, msg.acked)
+			assert.Equal(t, !tc.wantAck, msg.nacked)
+		})
+	}
+}
+
+func mustGenerateTrackedStartWorkflowExecutionRequestMsg(t *testing.T, domainID, requestID string) []byte {
+	payload, err := codec.NewThriftRWEncoder().Encode(thrift.FromStartWorkflowExecutionAsyncRequest(testStartReq))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg := &sqlblobs.AsyncRequestMessage{
+		PartitionKey: common.StringPtr("test-workflow-id"),
+		Type:         sqlblobs.AsyncRequestTypeStartWorkflowExecutionAsyncRequest.Ptr(),
+		Header:       fakeHeaders(),
+		Encoding:     common.StringPtr(string(constants.EncodingTypeThriftRW)),
+		Payload:      payload,
+	}
+	if domainID != "" {
+		msg.DomainID = common.StringPtr(domainID)
+	}
+	if requestID != "" {
+		msg.RequestID = common.StringPtr(requestID)
+	}
+
+	res, err := codec.NewThriftRWEncoder().Encode(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return res
+}
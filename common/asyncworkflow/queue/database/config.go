@@ -0,0 +1,81 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package database
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	defaultBatchSize            = 100
+	defaultPollIntervalMs       = 1000
+	defaultLeaseDurationSeconds = 30
+)
+
+type (
+	// queueConfig is the config of a queue stored in Cadence's own database. Consumers of the same queue on different
+	// hosts take a lease on the queue so that only one of them reads messages at a time.
+	queueConfig struct {
+		QueueName            string `yaml:"queueName"`
+		BatchSize            int    `yaml:"batchSize"`
+		PollIntervalMs       int    `yaml:"pollIntervalMs"`
+		LeaseDurationSeconds int    `yaml:"leaseDurationSeconds"`
+	}
+)
+
+func (c *queueConfig) ID() string {
+	return fmt.Sprintf("database::%s", c.QueueName)
+}
+
+func (c *queueConfig) validate() error {
+	if c.QueueName == "" {
+		return errors.New("queueName is required")
+	}
+	if c.BatchSize < 0 || c.PollIntervalMs < 0 || c.LeaseDurationSeconds < 0 {
+		return errors.New("batchSize, pollIntervalMs and leaseDurationSeconds must not be negative")
+	}
+	return nil
+}
+
+func (c *queueConfig) batchSize() int {
+	if c.BatchSize == 0 {
+		return defaultBatchSize
+	}
+	return c.BatchSize
+}
+
+func (c *queueConfig) pollInterval() time.Duration {
+	if c.PollIntervalMs == 0 {
+		return defaultPollIntervalMs * time.Millisecond
+	}
+	return time.Duration(c.PollIntervalMs) * time.Millisecond
+}
+
+func (c *queueConfig) leaseDuration() time.Duration {
+	if c.LeaseDurationSeconds == 0 {
+		return defaultLeaseDurationSeconds * time.Second
+	}
+	return time.Duration(c.LeaseDurationSeconds) * time.Second
+}
@@ -0,0 +1,84 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueueConfig(t *testing.T) {
+	tests := []struct {
+		name              string
+		config            queueConfig
+		wantErr           bool
+		wantID            string
+		wantBatchSize     int
+		wantPollInterval  time.Duration
+		wantLeaseDuration time.Duration
+	}{
+		{
+			name:              "defaults",
+			config:            queueConfig{QueueName: "queue1"},
+			wantID:            "database::queue1",
+			wantBatchSize:     defaultBatchSize,
+			wantPollInterval:  time.Second,
+			wantLeaseDuration: 30 * time.Second,
+		},
+		{
+			name:              "overrides",
+			config:            queueConfig{QueueName: "queue2", BatchSize: 10, PollIntervalMs: 200, LeaseDurationSeconds: 5},
+			wantID:            "database::queue2",
+			wantBatchSize:     10,
+			wantPollInterval:  200 * time.Millisecond,
+			wantLeaseDuration: 5 * time.Second,
+		},
+		{
+			name:    "missing queue name",
+			config:  queueConfig{},
+			wantErr: true,
+		},
+		{
+			name:    "negative batch size",
+			config:  queueConfig{QueueName: "queue3", BatchSize: -1},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantID, tt.config.ID())
+			assert.Equal(t, tt.wantBatchSize, tt.config.batchSize())
+			assert.Equal(t, tt.wantPollInterval, tt.config.pollInterval())
+			assert.Equal(t, tt.wantLeaseDuration, tt.config.leaseDuration())
+		})
+	}
+}
@@ -0,0 +1,433 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// maxDeliveryAttempts is the number of times a nacked message is handed out again before it is dropped
+	maxDeliveryAttempts = 10
+
+	persistenceTimeout = 10 * time.Second
+)
+
+type (
+	// consumerImpl reads messages of a database queue. Consumers of the same queue compete for a lease stored in the
+	// queue metadata and only the lease owner dispatches messages. The owner periodically persists the ack level
+	// together with the lease renewal and deletes the acked messages. Messages that were handed out but not acked
+	// before the lease moved to another host are delivered again by the new owner.
+	consumerImpl struct {
+		queueName string
+		owner     string
+		config    *queueConfig
+		manager   persistence.AsyncRequestManager
+		logger    log.Logger
+		timeSrc   clock.TimeSource
+
+		msgC       chan messaging.Message
+		shutdownCh chan struct{}
+		wg         sync.WaitGroup
+		status     int32
+
+		sync.Mutex
+		lease *lease
+	}
+
+	// lease holds the state of a single ownership period of the queue
+	lease struct {
+		version          int64
+		expiry           time.Time
+		ackMgr           messaging.AckManager
+		persistedAck     int64
+		readLevel        int64
+		redeliveries     []*message
+		deliveryAttempts map[int64]int
+	}
+
+	message struct {
+		consumer *consumerImpl
+		lease    *lease
+		id       int64
+		payload  []byte
+	}
+)
+
+var _ messaging.Consumer = (*consumerImpl)(nil)
+
+func newConsumer(
+	config *queueConfig,
+	manager persistence.AsyncRequestManager,
+	logger log.Logger,
+	metricsClient metrics.Client,
+) *consumerImpl {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return &consumerImpl{
+		queueName:  config.QueueName,
+		owner:      fmt.Sprintf("%s/%s", hostname, uuid.New().String()),
+		config:     config,
+		manager:    manager,
+		logger:     logger.WithTags(tag.AsyncWFQueueID(config.ID())),
+		timeSrc:    clock.NewRealTimeSource(),
+		msgC:       make(chan messaging.Message, config.batchSize()),
+		shutdownCh: make(chan struct{}),
+	}
+}
+
+func (c *consumerImpl) Start() error {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return nil
+	}
+	c.wg.Add(2)
+	go c.leaseLoop()
+	go c.pollLoop()
+	c.logger.Info("Database queue consumer started", tag.Value(c.owner))
+	return nil
+}
+
+func (c *consumerImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(c.shutdownCh)
+	c.wg.Wait()
+
+	// hand the lease over right away so that another host does not have to wait for it to expire
+	ctx, cancel := context.WithTimeout(context.Background(), persistenceTimeout)
+	defer cancel()
+	c.releaseLease(ctx)
+	close(c.msgC)
+	c.logger.Info("Database queue consumer stopped")
+}
+
+func (c *consumerImpl) Messages() <-chan messaging.Message {
+	return c.msgC
+}
+
+func (c *consumerImpl) leaseLoop() {
+	defer c.wg.Done()
+
+	ticker := c.timeSrc.NewTicker(c.config.leaseDuration() / 3)
+	defer ticker.Stop()
+	for {
+		c.refreshLease()
+		select {
+		case <-ticker.Chan():
+		case <-c.shutdownCh:
+			return
+		}
+	}
+}
+
+func (c *consumerImpl) pollLoop() {
+	defer c.wg.Done()
+
+	ticker := c.timeSrc.NewTicker(c.config.pollInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.Chan():
+			c.dispatch()
+		case <-c.shutdownCh:
+			return
+		}
+	}
+}
+
+// refreshLease acquires the lease if it is free or expired and renews it if this consumer owns it. The ack level
+// of the current lease is persisted on every renewal and messages up to it are deleted.
+func (c *consumerImpl) refreshLease() {
+	ctx, cancel := context.WithTimeout(context.Background(), persistenceTimeout)
+	defer cancel()
+
+	resp, err := c.manager.GetAsyncRequestQueueMetadata(ctx, &persistence.GetAsyncRequestQueueMetadataRequest{QueueName: c.queueName})
+	var notExistsErr *types.EntityNotExistsError
+	if err != nil && !errors.As(err, &notExistsErr) {
+		c.logger.Warn("Failed to read queue metadata", tag.Error(err))
+		c.expireLease()
+		return
+	}
+
+	now := c.timeSrc.Now()
+	current := c.currentLease()
+	metadata := &persistence.AsyncRequestQueueMetadata{
+		QueueName:   c.queueName,
+		AckLevel:    -1,
+		LeaseOwner:  c.owner,
+		LeaseExpiry: now.Add(c.config.leaseDuration()),
+		Version:     1,
+	}
+	var previousVersion int64
+	if resp != nil {
+		existing := resp.Metadata
+		if existing.LeaseOwner != c.owner && existing.LeaseExpiry.After(now) {
+			// someone else owns the queue
+			c.dropLease()
+			return
+		}
+		if current != nil && current.version != existing.Version {
+			// the lease was taken over and released in between, start over from the persisted state
+			c.dropLease()
+			current = nil
+		}
+		previousVersion = existing.Version
+		metadata.Version = existing.Version + 1
+		metadata.AckLevel = existing.AckLevel
+		if current != nil && current.ackMgr.GetAckLevel() > metadata.AckLevel {
+			metadata.AckLevel = current.ackMgr.GetAckLevel()
+		}
+	}
+
+	err = c.manager.UpdateAsyncRequestQueueMetadata(ctx, &persistence.UpdateAsyncRequestQueueMetadataRequest{
+		Metadata:        metadata,
+		PreviousVersion: previousVersion,
+	})
+	if err != nil {
+		var conditionFailedErr *persistence.ConditionFailedError
+		if errors.As(err, &conditionFailedErr) {
+			c.logger.Info("Lost the race for the queue lease")
+			c.dropLease()
+			return
+		}
+		c.logger.Warn("Failed to update queue metadata", tag.Error(err))
+		c.expireLease()
+		return
+	}
+
+	if current == nil {
+		c.logger.Info("Acquired the queue lease", tag.ReadLevel(metadata.AckLevel))
+		current = c.newLease(metadata)
+	} else {
+		c.Lock()
+		current.version = metadata.Version
+		current.expiry = metadata.LeaseExpiry
+		c.Unlock()
+	}
+
+	if metadata.AckLevel > current.persistedAck {
+		if err := c.manager.DeleteAsyncRequestQueueMessages(ctx, &persistence.DeleteAsyncRequestQueueMessagesRequest{
+			QueueName:             c.queueName,
+			InclusiveEndMessageID: metadata.AckLevel,
+		}); err != nil {
+			// acked messages are deleted again on the next renewal
+			c.logger.Warn("Failed to delete acked messages", tag.Error(err))
+			return
+		}
+		c.Lock()
+		current.persistedAck = metadata.AckLevel
+		c.Unlock()
+	}
+}
+
+// releaseLease persists the final ack level and lets the lease expire immediately
+func (c *consumerImpl) releaseLease(ctx context.Context) {
+	current := c.currentLease()
+	if current == nil {
+		return
+	}
+	c.dropLease()
+
+	ackLevel := current.ackMgr.GetAckLevel()
+	if ackLevel < current.persistedAck {
+		ackLevel = current.persistedAck
+	}
+	err := c.manager.UpdateAsyncRequestQueueMetadata(ctx, &persistence.UpdateAsyncRequestQueueMetadataRequest{
+		Metadata: &persistence.AsyncRequestQueueMetadata{
+			QueueName: c.queueName,
+			AckLevel:  ackLevel,
+			Version:   current.version + 1,
+		},
+		PreviousVersion: current.version,
+	})
+	if err != nil {
+		c.logger.Warn("Failed to release the queue lease", tag.Error(err))
+	}
+}
+
+func (c *consumerImpl) newLease(metadata *persistence.AsyncRequestQueueMetadata) *lease {
+	ackMgr := messaging.NewAckManager(c.logger)
+	ackMgr.SetAckLevel(metadata.AckLevel)
+	l := &lease{
+		version:          metadata.Version,
+		expiry:           metadata.LeaseExpiry,
+		ackMgr:           ackMgr,
+		persistedAck:     metadata.AckLevel,
+		readLevel:        metadata.AckLevel,
+		deliveryAttempts: make(map[int64]int),
+	}
+	c.Lock()
+	c.lease = l
+	c.Unlock()
+	return l
+}
+
+func (c *consumerImpl) currentLease() *lease {
+	c.Lock()
+	defer c.Unlock()
+	return c.lease
+}
+
+func (c *consumerImpl) dropLease() {
+	c.Lock()
+	defer c.Unlock()
+	if c.lease != nil {
+		c.logger.Info("Giving up the queue lease")
+	}
+	c.lease = nil
+}
+
+// expireLease drops the lease if it could not be renewed in time
+func (c *consumerImpl) expireLease() {
+	c.Lock()
+	defer c.Unlock()
+	if c.lease != nil && !c.lease.expiry.After(c.timeSrc.Now()) {
+		c.logger.Warn("Queue lease expired before it could be renewed")
+		c.lease = nil
+	}
+}
+
+// dispatch hands out nacked messages and the next batch of messages if this consumer owns the lease and the number
+// of in-flight messages is below the batch size
+func (c *consumerImpl) dispatch() {
+	current := c.currentLease()
+	if current == nil {
+		return
+	}
+
+	c.Lock()
+	redeliveries := current.redeliveries
+	current.redeliveries = nil
+	c.Unlock()
+	for _, msg := range redeliveries {
+		if !c.send(current, msg) {
+			return
+		}
+	}
+
+	pageSize := c.config.batchSize() - int(current.ackMgr.GetBacklogCount())
+	if pageSize <= 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), persistenceTimeout)
+	defer cancel()
+	resp, err := c.manager.ReadAsyncRequestQueueMessages(ctx, &persistence.ReadAsyncRequestQueueMessagesRequest{
+		QueueName:               c.queueName,
+		ExclusiveBeginMessageID: current.readLevel,
+		PageSize:                pageSize,
+	})
+	if err != nil {
+		c.logger.Warn("Failed to read queue messages", tag.Error(err))
+		return
+	}
+	for _, m := range resp.Messages {
+		if err := current.ackMgr.ReadItem(m.MessageID); err != nil {
+			c.logger.Warn("Skipping queue message", tag.TaskID(m.MessageID), tag.Error(err))
+			continue
+		}
+		current.readLevel = m.MessageID
+		if !c.send(current, &message{consumer: c, lease: current, id: m.MessageID, payload: m.Payload}) {
+			return
+		}
+	}
+}
+
+func (c *consumerImpl) send(current *lease, msg *message) bool {
+	if c.currentLease() != current {
+		return false
+	}
+	c.Lock()
+	current.deliveryAttempts[msg.id]++
+	c.Unlock()
+	select {
+	case c.msgC <- msg:
+		return true
+	case <-c.shutdownCh:
+		return false
+	}
+}
+
+func (c *consumerImpl) ack(msg *message) {
+	msg.lease.ackMgr.AckItem(msg.id)
+	c.Lock()
+	delete(msg.lease.deliveryAttempts, msg.id)
+	c.Unlock()
+}
+
+// nack schedules the message to be delivered again. Messages that keep failing are dropped so that they do not
+// hold back the ack level of the queue forever.
+func (c *consumerImpl) nack(msg *message) {
+	c.Lock()
+	attempts := msg.lease.deliveryAttempts[msg.id]
+	if attempts < maxDeliveryAttempts {
+		msg.lease.redeliveries = append(msg.lease.redeliveries, msg)
+		c.Unlock()
+		return
+	}
+	c.Unlock()
+
+	c.logger.Error("Dropping queue message after too many delivery attempts", tag.TaskID(msg.id), tag.Attempt(int32(attempts)))
+	c.ack(msg)
+}
+
+func (m *message) Value() []byte {
+	return m.payload
+}
+
+func (m *message) Partition() int32 {
+	return 0
+}
+
+func (m *message) Offset() int64 {
+	return m.id
+}
+
+func (m *message) Ack() error {
+	m.consumer.ack(m)
+	return nil
+}
+
+func (m *message) Nack() error {
+	m.consumer.nack(m)
+	return nil
+}
@@ -0,0 +1,239 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package database
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func newTestConsumer(t *testing.T) (*consumerImpl, *persistence.MockAsyncRequestManager, clock.MockedTimeSource) {
+	ctrl := gomock.NewController(t)
+	manager := persistence.NewMockAsyncRequestManager(ctrl)
+	c := newConsumer(&queueConfig{QueueName: "queue", BatchSize: 2}, manager, testlogger.New(t), metrics.NewNoopMetricsClient())
+	timeSrc := clock.NewMockedTimeSourceAt(time.Unix(1700000000, 0))
+	c.timeSrc = timeSrc
+	return c, manager, timeSrc
+}
+
+func TestRefreshLease(t *testing.T) {
+	getReq := &persistence.GetAsyncRequestQueueMetadataRequest{QueueName: "queue"}
+
+	t.Run("create lease for new queue", func(t *testing.T) {
+		c, manager, timeSrc := newTestConsumer(t)
+		manager.EXPECT().GetAsyncRequestQueueMetadata(gomock.Any(), getReq).Return(nil, &types.EntityNotExistsError{}).Times(1)
+		manager.EXPECT().UpdateAsyncRequestQueueMetadata(gomock.Any(), &persistence.UpdateAsyncRequestQueueMetadataRequest{
+			Metadata: &persistence.AsyncRequestQueueMetadata{
+				QueueName:   "queue",
+				AckLevel:    -1,
+				LeaseOwner:  c.owner,
+				LeaseExpiry: timeSrc.Now().Add(30 * time.Second),
+				Version:     1,
+			},
+		}).Return(nil).Times(1)
+
+		c.refreshLease()
+		require.NotNil(t, c.currentLease())
+		assert.Equal(t, int64(1), c.currentLease().version)
+		assert.Equal(t, int64(-1), c.currentLease().readLevel)
+	})
+
+	t.Run("lease owned by another host", func(t *testing.T) {
+		c, manager, timeSrc := newTestConsumer(t)
+		manager.EXPECT().GetAsyncRequestQueueMetadata(gomock.Any(), getReq).Return(&persistence.GetAsyncRequestQueueMetadataResponse{
+			Metadata: &persistence.AsyncRequestQueueMetadata{QueueName: "queue", LeaseOwner: "other", LeaseExpiry: timeSrc.Now().Add(time.Second), Version: 3},
+		}, nil).Times(1)
+
+		c.refreshLease()
+		assert.Nil(t, c.currentLease())
+	})
+
+	t.Run("take over expired lease", func(t *testing.T) {
+		c, manager, timeSrc := newTestConsumer(t)
+		manager.EXPECT().GetAsyncRequestQueueMetadata(gomock.Any(), getReq).Return(&persistence.GetAsyncRequestQueueMetadataResponse{
+			Metadata: &persistence.AsyncRequestQueueMetadata{QueueName: "queue", AckLevel: 5, LeaseOwner: "other", LeaseExpiry: timeSrc.Now(), Version: 3},
+		}, nil).Times(1)
+		manager.EXPECT().UpdateAsyncRequestQueueMetadata(gomock.Any(), &persistence.UpdateAsyncRequestQueueMetadataRequest{
+			Metadata: &persistence.AsyncRequestQueueMetadata{
+				QueueName:   "queue",
+				AckLevel:    5,
+				LeaseOwner:  c.owner,
+				LeaseExpiry: timeSrc.Now().Add(30 * time.Second),
+				Version:     4,
+			},
+			PreviousVersion: 3,
+		}).Return(nil).Times(1)
+
+		c.refreshLease()
+		require.NotNil(t, c.currentLease())
+		assert.Equal(t, int64(5), c.currentLease().readLevel)
+	})
+
+	t.Run("renewal persists ack level and deletes acked messages", func(t *testing.T) {
+		c, manager, timeSrc := newTestConsumer(t)
+		current := c.newLease(&persistence.AsyncRequestQueueMetadata{QueueName: "queue", AckLevel: 5, LeaseOwner: c.owner, LeaseExpiry: timeSrc.Now(), Version: 4})
+		require.NoError(t, current.ackMgr.ReadItem(6))
+		require.NoError(t, current.ackMgr.ReadItem(7))
+		current.ackMgr.AckItem(6)
+
+		manager.EXPECT().GetAsyncRequestQueueMetadata(gomock.Any(), getReq).Return(&persistence.GetAsyncRequestQueueMetadataResponse{
+			Metadata: &persistence.AsyncRequestQueueMetadata{QueueName: "queue", AckLevel: 5, LeaseOwner: c.owner, LeaseExpiry: timeSrc.Now(), Version: 4},
+		}, nil).Times(1)
+		manager.EXPECT().UpdateAsyncRequestQueueMetadata(gomock.Any(), &persistence.UpdateAsyncRequestQueueMetadataRequest{
+			Metadata: &persistence.AsyncRequestQueueMetadata{
+				QueueName:   "queue",
+				AckLevel:    6,
+				LeaseOwner:  c.owner,
+				LeaseExpiry: timeSrc.Now().Add(30 * time.Second),
+				Version:     5,
+			},
+			PreviousVersion: 4,
+		}).Return(nil).Times(1)
+		manager.EXPECT().DeleteAsyncRequestQueueMessages(gomock.Any(), &persistence.DeleteAsyncRequestQueueMessagesRequest{
+			QueueName:             "queue",
+			InclusiveEndMessageID: 6,
+		}).Return(nil).Times(1)
+
+		c.refreshLease()
+		assert.Equal(t, current, c.currentLease())
+		assert.Equal(t, int64(5), current.version)
+		assert.Equal(t, int64(6), current.persistedAck)
+	})
+
+	t.Run("lost the race", func(t *testing.T) {
+		c, manager, _ := newTestConsumer(t)
+		manager.EXPECT().GetAsyncRequestQueueMetadata(gomock.Any(), getReq).Return(nil, &types.EntityNotExistsError{}).Times(1)
+		manager.EXPECT().UpdateAsyncRequestQueueMetadata(gomock.Any(), gomock.Any()).Return(&persistence.ConditionFailedError{}).Times(1)
+
+		c.refreshLease()
+		assert.Nil(t, c.currentLease())
+	})
+
+	t.Run("lease expires when metadata is unavailable", func(t *testing.T) {
+		c, manager, timeSrc := newTestConsumer(t)
+		c.newLease(&persistence.AsyncRequestQueueMetadata{QueueName: "queue", LeaseExpiry: timeSrc.Now().Add(time.Second), Version: 1})
+		manager.EXPECT().GetAsyncRequestQueueMetadata(gomock.Any(), getReq).Return(nil, errors.New("db unavailable")).Times(2)
+
+		c.refreshLease()
+		assert.NotNil(t, c.currentLease())
+
+		timeSrc.Advance(time.Second)
+		c.refreshLease()
+		assert.Nil(t, c.currentLease())
+	})
+}
+
+func TestDispatch(t *testing.T) {
+	c, manager, timeSrc := newTestConsumer(t)
+	c.dispatch() // no lease, nothing to do
+
+	current := c.newLease(&persistence.AsyncRequestQueueMetadata{QueueName: "queue", AckLevel: 5, LeaseOwner: c.owner, LeaseExpiry: timeSrc.Now().Add(time.Minute), Version: 1})
+	manager.EXPECT().ReadAsyncRequestQueueMessages(gomock.Any(), &persistence.ReadAsyncRequestQueueMessagesRequest{
+		QueueName:               "queue",
+		ExclusiveBeginMessageID: 5,
+		PageSize:                2,
+	}).Return(&persistence.ReadAsyncRequestQueueMessagesResponse{
+		Messages: []*persistence.AsyncRequestQueueMessage{
+			{MessageID: 6, Payload: []byte("six")},
+			{MessageID: 7, Payload: []byte("seven")},
+		},
+	}, nil).Times(1)
+	c.dispatch()
+
+	first := receive(t, c)
+	second := receive(t, c)
+	assert.Equal(t, int64(6), first.Offset())
+	assert.Equal(t, []byte("six"), first.Value())
+	assert.Equal(t, int64(7), second.Offset())
+	assert.Equal(t, int64(7), current.readLevel)
+
+	// the backlog is full, only the nacked message is delivered again
+	assert.NoError(t, first.Nack())
+	c.dispatch()
+	redelivered := receive(t, c)
+	assert.Equal(t, int64(6), redelivered.Offset())
+
+	assert.NoError(t, redelivered.Ack())
+	assert.NoError(t, second.Ack())
+	assert.Equal(t, int64(7), current.ackMgr.GetAckLevel())
+	assert.Empty(t, current.deliveryAttempts)
+}
+
+func TestNackDropsMessageAfterMaxAttempts(t *testing.T) {
+	c, _, timeSrc := newTestConsumer(t)
+	current := c.newLease(&persistence.AsyncRequestQueueMetadata{QueueName: "queue", AckLevel: 5, LeaseExpiry: timeSrc.Now().Add(time.Minute), Version: 1})
+	require.NoError(t, current.ackMgr.ReadItem(6))
+	msg := &message{consumer: c, lease: current, id: 6}
+	current.deliveryAttempts[6] = maxDeliveryAttempts
+
+	assert.NoError(t, msg.Nack())
+	assert.Empty(t, current.redeliveries)
+	assert.Equal(t, int64(6), current.ackMgr.GetAckLevel())
+}
+
+func TestConsumerStartStop(t *testing.T) {
+	c, manager, _ := newTestConsumer(t)
+	manager.EXPECT().GetAsyncRequestQueueMetadata(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{}).Times(1)
+	manager.EXPECT().UpdateAsyncRequestQueueMetadata(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	released := make(chan *persistence.UpdateAsyncRequestQueueMetadataRequest, 1)
+
+	require.NoError(t, c.Start())
+	assert.Eventually(t, func() bool { return c.currentLease() != nil }, time.Second, 10*time.Millisecond)
+
+	manager.EXPECT().UpdateAsyncRequestQueueMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, request *persistence.UpdateAsyncRequestQueueMetadataRequest) error {
+			released <- request
+			return nil
+		},
+	).Times(1)
+	c.Stop()
+
+	request := <-released
+	assert.Equal(t, int64(1), request.PreviousVersion)
+	assert.Empty(t, request.Metadata.LeaseOwner)
+	assert.Nil(t, c.currentLease())
+	_, ok := <-c.Messages()
+	assert.False(t, ok)
+}
+
+func receive(t *testing.T, c *consumerImpl) messaging.Message {
+	select {
+	case msg := <-c.Messages():
+		return msg
+	default:
+		t.Fatal("expected a message")
+		return nil
+	}
+}
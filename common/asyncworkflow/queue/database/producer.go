@@ -0,0 +1,66 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package database
+
+import (
+	"context"
+	"errors"
+
+	"github.com/uber/cadence/.gen/go/sqlblobs"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	producerImpl struct {
+		queueName  string
+		manager    persistence.AsyncRequestManager
+		msgEncoder codec.BinaryEncoder
+	}
+)
+
+func newProducer(queueName string, manager persistence.AsyncRequestManager) messaging.Producer {
+	return &producerImpl{
+		queueName:  queueName,
+		manager:    manager,
+		msgEncoder: codec.NewThriftRWEncoder(),
+	}
+}
+
+// Publish appends an async request message to the queue
+func (p *producerImpl) Publish(ctx context.Context, msg interface{}) error {
+	message, ok := msg.(*sqlblobs.AsyncRequestMessage)
+	if !ok {
+		return errors.New("unknown producer message type")
+	}
+	payload, err := p.msgEncoder.Encode(message)
+	if err != nil {
+		return err
+	}
+	_, err = p.manager.EnqueueAsyncRequestQueueMessage(ctx, &persistence.EnqueueAsyncRequestQueueMessageRequest{
+		QueueName: p.queueName,
+		Payload:   payload,
+	})
+	return err
+}
@@ -0,0 +1,133 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/.gen/go/sqlblobs"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/asyncworkflow/queue/consumer"
+	"github.com/uber/cadence/common/asyncworkflow/queue/provider"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type mockDecoder struct {
+	decodeFunc func(v any) error
+}
+
+func (m *mockDecoder) Decode(v any) error {
+	return m.decodeFunc(v)
+}
+
+func TestNewQueue(t *testing.T) {
+	tests := []struct {
+		name    string
+		decoder *mockDecoder
+		want    *queueImpl
+		wantErr bool
+	}{
+		{
+			name: "success",
+			decoder: &mockDecoder{decodeFunc: func(v any) error {
+				v.(*queueConfig).QueueName = "queue"
+				return nil
+			}},
+			want: &queueImpl{config: &queueConfig{QueueName: "queue"}},
+		},
+		{
+			name: "decoding failure",
+			decoder: &mockDecoder{decodeFunc: func(v any) error {
+				return errors.New("decoding error")
+			}},
+			wantErr: true,
+		},
+		{
+			name: "invalid config",
+			decoder: &mockDecoder{decodeFunc: func(v any) error {
+				return nil
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newQueue(tt.decoder)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, "database::queue", got.ID())
+		})
+	}
+}
+
+func TestCreateConsumerAndProducer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	q := &queueImpl{config: &queueConfig{QueueName: "queue"}}
+
+	params := &provider.Params{Logger: testlogger.New(t), MetricsClient: metrics.NewNoopMetricsClient()}
+	_, err := q.CreateConsumer(params)
+	assert.ErrorIs(t, err, errAsyncRequestManagerRequired)
+	_, err = q.CreateProducer(params)
+	assert.ErrorIs(t, err, errAsyncRequestManagerRequired)
+
+	params.AsyncRequestManager = persistence.NewMockAsyncRequestManager(ctrl)
+	c, err := q.CreateConsumer(params)
+	assert.NoError(t, err)
+	assert.IsType(t, &consumer.DefaultConsumer{}, c)
+	p, err := q.CreateProducer(params)
+	assert.NoError(t, err)
+	assert.NotNil(t, p)
+}
+
+func TestProducerPublish(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	manager := persistence.NewMockAsyncRequestManager(ctrl)
+	p := newProducer("queue", manager)
+
+	msg := &sqlblobs.AsyncRequestMessage{PartitionKey: common.StringPtr("wid")}
+	payload, err := codec.NewThriftRWEncoder().Encode(msg)
+	assert.NoError(t, err)
+	manager.EXPECT().EnqueueAsyncRequestQueueMessage(gomock.Any(), &persistence.EnqueueAsyncRequestQueueMessageRequest{
+		QueueName: "queue",
+		Payload:   payload,
+	}).Return(&persistence.EnqueueAsyncRequestQueueMessageResponse{MessageID: 1}, nil).Times(1)
+	assert.NoError(t, p.Publish(context.Background(), msg))
+
+	manager.EXPECT().EnqueueAsyncRequestQueueMessage(gomock.Any(), gomock.Any()).Return(nil, errors.New("enqueue failed")).Times(1)
+	assert.Error(t, p.Publish(context.Background(), msg))
+
+	assert.Error(t, p.Publish(context.Background(), "unknown message"))
+}
@@ -87,7 +87,11 @@ func (q *queueImpl) CreateConsumer(p *provider.Params) (provider.Consumer, error
 		return nil, fmt.Errorf("failed to create kafka consumer: %w", err)
 	}
 	p.Logger.Info("Creating async wf consumer", tag.KafkaTopicName(q.config.Topic))
-	return consumer.New(q.ID(), kafkaConsumer, p.Logger, p.MetricsClient, p.FrontendClient), nil
+	var opts []consumer.Option
+	if p.AsyncRequestManager != nil {
+		opts = append(opts, consumer.WithAsyncRequestManager(p.AsyncRequestManager))
+	}
+	return consumer.New(q.ID(), kafkaConsumer, p.Logger, p.MetricsClient, p.FrontendClient, opts...), nil
 }
 
 func (q *queueImpl) CreateProducer(p *provider.Params) (messaging.Producer, error) {
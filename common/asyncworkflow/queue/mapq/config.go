@@ -0,0 +1,222 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package mapq
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	mapqtypes "github.com/uber/cadence/common/mapq/types"
+)
+
+const (
+	defaultBatchSize                       = 100
+	defaultPollIntervalMs                  = 1000
+	defaultLeaseDurationSeconds            = 30
+	defaultPolicyEvaluationIntervalSeconds = 10
+	defaultOffsetCommitIntervalSeconds     = 10
+
+	domainPartitionKey       = "domain"
+	workflowTypePartitionKey = "workflow-type"
+)
+
+type (
+	// queueConfig is the config of a MAPQ backed queue. Requests are partitioned by domain and then by workflow type.
+	// Predefined partitions are created for the domains in DomainPolicies. Other domains and workflow types get their own
+	// partition once their enqueue rate exceeds SplitRPS or SkewRatio of the catch-all partition they are in.
+	queueConfig struct {
+		QueueName            string `yaml:"queueName"`
+		BatchSize            int    `yaml:"batchSize"`
+		PollIntervalMs       int    `yaml:"pollIntervalMs"`
+		LeaseDurationSeconds int    `yaml:"leaseDurationSeconds"`
+
+		// DispatchRPS and Concurrency are applied to each partition unless overridden by DomainPolicies. 0 means unlimited.
+		DispatchRPS int64 `yaml:"dispatchRPS"`
+		Concurrency int   `yaml:"concurrency"`
+
+		// SplitRPS is the enqueue rate of a domain or workflow type above which it gets its own partition. 0 disables it.
+		SplitRPS float64 `yaml:"splitRPS"`
+		// SkewRatio is the share of the catch-all partition's enqueue rate above which a domain or workflow type gets
+		// its own partition. 0 disables it.
+		SkewRatio float64 `yaml:"skewRatio"`
+		// MinSkewRPS is the enqueue rate of the catch-all partition below which skew is ignored
+		MinSkewRPS float64 `yaml:"minSkewRPS"`
+		// MergeRPS is the enqueue rate below which a dynamically created partition is merged back. 0 disables merges.
+		MergeRPS float64 `yaml:"mergeRPS"`
+
+		DomainPolicies map[string]domainPolicy `yaml:"domainPolicies"`
+
+		PolicyEvaluationIntervalSeconds int `yaml:"policyEvaluationIntervalSeconds"`
+		OffsetCommitIntervalSeconds     int `yaml:"offsetCommitIntervalSeconds"`
+	}
+
+	// domainPolicy overrides the dispatch policy of a domain. WorkflowTypes get their own partitions from the start.
+	domainPolicy struct {
+		DispatchRPS   int64    `yaml:"dispatchRPS"`
+		Concurrency   int      `yaml:"concurrency"`
+		WorkflowTypes []string `yaml:"workflowTypes"`
+	}
+)
+
+func (c *queueConfig) ID() string {
+	return fmt.Sprintf("mapq::%s", c.QueueName)
+}
+
+func (c *queueConfig) validate() error {
+	if c.QueueName == "" {
+		return errors.New("queueName is required")
+	}
+	if c.BatchSize < 0 || c.PollIntervalMs < 0 || c.LeaseDurationSeconds < 0 || c.PolicyEvaluationIntervalSeconds < 0 || c.OffsetCommitIntervalSeconds < 0 {
+		return errors.New("batchSize, pollIntervalMs, leaseDurationSeconds and intervals must not be negative")
+	}
+	if c.DispatchRPS < 0 || c.Concurrency < 0 || c.SplitRPS < 0 || c.MinSkewRPS < 0 || c.MergeRPS < 0 {
+		return errors.New("dispatchRPS, concurrency, splitRPS, minSkewRPS and mergeRPS must not be negative")
+	}
+	if c.SkewRatio < 0 || c.SkewRatio > 1 {
+		return errors.New("skewRatio must be between 0 and 1")
+	}
+	for domain, policy := range c.DomainPolicies {
+		if policy.DispatchRPS < 0 || policy.Concurrency < 0 {
+			return fmt.Errorf("dispatchRPS and concurrency of domain %v must not be negative", domain)
+		}
+	}
+	return nil
+}
+
+func (c *queueConfig) batchSize() int {
+	if c.BatchSize == 0 {
+		return defaultBatchSize
+	}
+	return c.BatchSize
+}
+
+func (c *queueConfig) pollInterval() time.Duration {
+	if c.PollIntervalMs == 0 {
+		return defaultPollIntervalMs * time.Millisecond
+	}
+	return time.Duration(c.PollIntervalMs) * time.Millisecond
+}
+
+func (c *queueConfig) leaseDuration() time.Duration {
+	if c.LeaseDurationSeconds == 0 {
+		return defaultLeaseDurationSeconds * time.Second
+	}
+	return time.Duration(c.LeaseDurationSeconds) * time.Second
+}
+
+func (c *queueConfig) policyEvaluationInterval() time.Duration {
+	if c.PolicyEvaluationIntervalSeconds == 0 {
+		return defaultPolicyEvaluationIntervalSeconds * time.Second
+	}
+	return time.Duration(c.PolicyEvaluationIntervalSeconds) * time.Second
+}
+
+func (c *queueConfig) offsetCommitInterval() time.Duration {
+	if c.OffsetCommitIntervalSeconds == 0 {
+		return defaultOffsetCommitIntervalSeconds * time.Second
+	}
+	return time.Duration(c.OffsetCommitIntervalSeconds) * time.Second
+}
+
+func (c *queueConfig) partitions() []string {
+	return []string{domainPartitionKey, workflowTypePartitionKey}
+}
+
+// policies returns the MAPQ policies of the queue:
+//   - "*" splits by domain with a predefined partition per domain in DomainPolicies
+//   - "*/." splits by workflow type
+//   - "*/./." are the leaf partitions which are rate limited
+func (c *queueConfig) policies() []mapqtypes.NodePolicy {
+	domains := make([]string, 0, len(c.DomainPolicies))
+	for domain := range c.DomainPolicies {
+		domains = append(domains, domain)
+	}
+	sort.Strings(domains)
+
+	var predefinedDomains []any
+	for _, domain := range domains {
+		predefinedDomains = append(predefinedDomains, domain)
+	}
+
+	policies := []mapqtypes.NodePolicy{
+		{
+			Path:        "*",
+			SplitPolicy: c.splitPolicy(predefinedDomains),
+		},
+		{
+			Path:        "*/.",
+			SplitPolicy: c.splitPolicy(nil),
+		},
+		{
+			Path:           "*/./.",
+			SplitPolicy:    &mapqtypes.SplitPolicy{Disabled: true},
+			DispatchPolicy: &mapqtypes.DispatchPolicy{DispatchRPS: c.DispatchRPS, Concurrency: c.Concurrency},
+		},
+	}
+
+	for _, domain := range domains {
+		policy := c.DomainPolicies[domain]
+		var workflowTypes []any
+		for _, workflowType := range policy.WorkflowTypes {
+			workflowTypes = append(workflowTypes, workflowType)
+		}
+
+		dispatchPolicy := &mapqtypes.DispatchPolicy{DispatchRPS: c.DispatchRPS, Concurrency: c.Concurrency}
+		if policy.DispatchRPS > 0 {
+			dispatchPolicy.DispatchRPS = policy.DispatchRPS
+		}
+		if policy.Concurrency > 0 {
+			dispatchPolicy.Concurrency = policy.Concurrency
+		}
+
+		policies = append(policies,
+			mapqtypes.NodePolicy{
+				Path:        fmt.Sprintf("*/%s", domain),
+				SplitPolicy: c.splitPolicy(workflowTypes),
+			},
+			mapqtypes.NodePolicy{
+				Path:           fmt.Sprintf("*/%s/.", domain),
+				SplitPolicy:    &mapqtypes.SplitPolicy{Disabled: true},
+				DispatchPolicy: dispatchPolicy,
+			},
+		)
+	}
+
+	return policies
+}
+
+func (c *queueConfig) splitPolicy(predefinedSplits []any) *mapqtypes.SplitPolicy {
+	sp := &mapqtypes.SplitPolicy{PredefinedSplits: predefinedSplits}
+	if c.SplitRPS > 0 {
+		sp.Burst = &mapqtypes.BurstPolicy{RPSThreshold: c.SplitRPS}
+	}
+	if c.SkewRatio > 0 {
+		sp.Skew = &mapqtypes.SkewPolicy{Ratio: c.SkewRatio, MinRPS: c.MinSkewRPS}
+	}
+	if c.MergeRPS > 0 {
+		sp.Merge = &mapqtypes.MergePolicy{BelowRPS: c.MergeRPS}
+	}
+	return sp
+}
@@ -0,0 +1,178 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package mapq
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	mapqtypes "github.com/uber/cadence/common/mapq/types"
+)
+
+func TestQueueConfig(t *testing.T) {
+	tests := []struct {
+		name                         string
+		config                       queueConfig
+		wantErr                      bool
+		wantID                       string
+		wantBatchSize                int
+		wantPollInterval             time.Duration
+		wantLeaseDuration            time.Duration
+		wantPolicyEvaluationInterval time.Duration
+		wantOffsetCommitInterval     time.Duration
+	}{
+		{
+			name:                         "defaults",
+			config:                       queueConfig{QueueName: "queue1"},
+			wantID:                       "mapq::queue1",
+			wantBatchSize:                defaultBatchSize,
+			wantPollInterval:             time.Second,
+			wantLeaseDuration:            30 * time.Second,
+			wantPolicyEvaluationInterval: 10 * time.Second,
+			wantOffsetCommitInterval:     10 * time.Second,
+		},
+		{
+			name: "overrides",
+			config: queueConfig{
+				QueueName:                       "queue2",
+				BatchSize:                       10,
+				PollIntervalMs:                  200,
+				LeaseDurationSeconds:            5,
+				PolicyEvaluationIntervalSeconds: 1,
+				OffsetCommitIntervalSeconds:     2,
+			},
+			wantID:                       "mapq::queue2",
+			wantBatchSize:                10,
+			wantPollInterval:             200 * time.Millisecond,
+			wantLeaseDuration:            5 * time.Second,
+			wantPolicyEvaluationInterval: time.Second,
+			wantOffsetCommitInterval:     2 * time.Second,
+		},
+		{
+			name:    "missing queue name",
+			config:  queueConfig{},
+			wantErr: true,
+		},
+		{
+			name:    "negative batch size",
+			config:  queueConfig{QueueName: "queue3", BatchSize: -1},
+			wantErr: true,
+		},
+		{
+			name:    "negative split rps",
+			config:  queueConfig{QueueName: "queue4", SplitRPS: -1},
+			wantErr: true,
+		},
+		{
+			name:    "skew ratio above 1",
+			config:  queueConfig{QueueName: "queue5", SkewRatio: 1.5},
+			wantErr: true,
+		},
+		{
+			name:    "negative domain dispatch rps",
+			config:  queueConfig{QueueName: "queue6", DomainPolicies: map[string]domainPolicy{"d1": {DispatchRPS: -1}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantID, tt.config.ID())
+			assert.Equal(t, tt.wantBatchSize, tt.config.batchSize())
+			assert.Equal(t, tt.wantPollInterval, tt.config.pollInterval())
+			assert.Equal(t, tt.wantLeaseDuration, tt.config.leaseDuration())
+			assert.Equal(t, tt.wantPolicyEvaluationInterval, tt.config.policyEvaluationInterval())
+			assert.Equal(t, tt.wantOffsetCommitInterval, tt.config.offsetCommitInterval())
+		})
+	}
+}
+
+func TestQueueConfigPolicies(t *testing.T) {
+	config := queueConfig{
+		QueueName:   "queue",
+		DispatchRPS: 100,
+		Concurrency: 10,
+		SplitRPS:    50,
+		SkewRatio:   0.5,
+		MinSkewRPS:  20,
+		MergeRPS:    5,
+		DomainPolicies: map[string]domainPolicy{
+			"d2": {DispatchRPS: 500},
+			"d1": {Concurrency: 20, WorkflowTypes: []string{"wt1", "wt2"}},
+		},
+	}
+	splitPolicy := func(predefinedSplits ...any) *mapqtypes.SplitPolicy {
+		return &mapqtypes.SplitPolicy{
+			PredefinedSplits: predefinedSplits,
+			Burst:            &mapqtypes.BurstPolicy{RPSThreshold: 50},
+			Skew:             &mapqtypes.SkewPolicy{Ratio: 0.5, MinRPS: 20},
+			Merge:            &mapqtypes.MergePolicy{BelowRPS: 5},
+		}
+	}
+
+	want := []mapqtypes.NodePolicy{
+		{Path: "*", SplitPolicy: splitPolicy("d1", "d2")},
+		{Path: "*/.", SplitPolicy: &mapqtypes.SplitPolicy{
+			Burst: &mapqtypes.BurstPolicy{RPSThreshold: 50},
+			Skew:  &mapqtypes.SkewPolicy{Ratio: 0.5, MinRPS: 20},
+			Merge: &mapqtypes.MergePolicy{BelowRPS: 5},
+		}},
+		{
+			Path:           "*/./.",
+			SplitPolicy:    &mapqtypes.SplitPolicy{Disabled: true},
+			DispatchPolicy: &mapqtypes.DispatchPolicy{DispatchRPS: 100, Concurrency: 10},
+		},
+		{Path: "*/d1", SplitPolicy: splitPolicy("wt1", "wt2")},
+		{
+			Path:           "*/d1/.",
+			SplitPolicy:    &mapqtypes.SplitPolicy{Disabled: true},
+			DispatchPolicy: &mapqtypes.DispatchPolicy{DispatchRPS: 100, Concurrency: 20},
+		},
+		{Path: "*/d2", SplitPolicy: splitPolicy()},
+		{
+			Path:           "*/d2/.",
+			SplitPolicy:    &mapqtypes.SplitPolicy{Disabled: true},
+			DispatchPolicy: &mapqtypes.DispatchPolicy{DispatchRPS: 500, Concurrency: 10},
+		},
+	}
+
+	assert.Equal(t, []string{domainPartitionKey, workflowTypePartitionKey}, config.partitions())
+	assert.Equal(t, want, config.policies())
+}
+
+func TestQueueConfigPoliciesWithoutSplits(t *testing.T) {
+	config := queueConfig{QueueName: "queue"}
+
+	policies := config.policies()
+	assert.Len(t, policies, 3)
+	assert.Equal(t, &mapqtypes.SplitPolicy{}, policies[0].SplitPolicy)
+	assert.Equal(t, &mapqtypes.SplitPolicy{}, policies[1].SplitPolicy)
+}
@@ -0,0 +1,538 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package mapq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	mapqclient "github.com/uber/cadence/common/mapq"
+	mapqpersister "github.com/uber/cadence/common/mapq/persister"
+	mapqtypes "github.com/uber/cadence/common/mapq/types"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// maxDeliveryAttempts is the number of times a nacked message is handed out again before it is dropped
+	maxDeliveryAttempts = 10
+
+	persistenceTimeout = 10 * time.Second
+)
+
+var errMessageNacked = errors.New("message is nacked")
+
+type (
+	// consumerImpl moves async requests from the ingress queue into MAPQ and hands out the requests dispatched by MAPQ.
+	// Consumers of the same queue compete for a lease stored in the ingress queue metadata and only the lease owner
+	// runs the MAPQ tree. The ack level in the metadata is the ID of the last ingress message moved into MAPQ, so
+	// ingress messages are deleted as soon as they are persisted in MAPQ and MAPQ offsets take over from there.
+	consumerImpl struct {
+		queueID             string
+		owner               string
+		config              *queueConfig
+		asyncRequestManager persistence.AsyncRequestManager
+		mapQManager         persistence.MapQManager
+		logger              log.Logger
+		metricsClient       metrics.Client
+		timeSrc             clock.TimeSource
+		msgDecoder          codec.BinaryEncoder
+		newClient           func() (mapqtypes.Client, error)
+
+		msgC       chan messaging.Message
+		shutdownCh chan struct{}
+		wg         sync.WaitGroup
+		status     int32
+
+		sync.Mutex
+		lease            *lease
+		deliveryAttempts map[int64]int
+	}
+
+	// lease holds the state of a single ownership period of the queue
+	lease struct {
+		version      int64
+		expiry       time.Time
+		persistedAck int64
+		readLevel    int64
+		client       mapqtypes.Client
+	}
+
+	message struct {
+		id      int64
+		payload []byte
+		result  chan bool
+	}
+
+	// mapqConsumer pushes the items dispatched by a MAPQ partition to the messages channel and waits until they are
+	// acked or nacked. Nacked items are retried by the MAPQ dispatcher.
+	mapqConsumer struct {
+		c *consumerImpl
+	}
+
+	mapqConsumerFactory struct {
+		c *consumerImpl
+	}
+)
+
+var (
+	_ messaging.Consumer = (*consumerImpl)(nil)
+	_ messaging.Message  = (*message)(nil)
+)
+
+func newConsumer(
+	config *queueConfig,
+	asyncRequestManager persistence.AsyncRequestManager,
+	mapQManager persistence.MapQManager,
+	logger log.Logger,
+	metricsClient metrics.Client,
+) *consumerImpl {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	c := &consumerImpl{
+		queueID:             config.ID(),
+		owner:               fmt.Sprintf("%s/%s", hostname, uuid.New().String()),
+		config:              config,
+		asyncRequestManager: asyncRequestManager,
+		mapQManager:         mapQManager,
+		logger:              logger.WithTags(tag.AsyncWFQueueID(config.ID())),
+		metricsClient:       metricsClient,
+		timeSrc:             clock.NewRealTimeSource(),
+		msgDecoder:          codec.NewThriftRWEncoder(),
+		msgC:                make(chan messaging.Message, config.batchSize()),
+		shutdownCh:          make(chan struct{}),
+		deliveryAttempts:    make(map[int64]int),
+	}
+	c.newClient = c.newMapQClient
+	return c
+}
+
+func (c *consumerImpl) Start() error {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return nil
+	}
+	c.wg.Add(2)
+	go c.leaseLoop()
+	go c.pollLoop()
+	c.logger.Info("MAPQ queue consumer started", tag.Value(c.owner))
+	return nil
+}
+
+func (c *consumerImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(c.shutdownCh)
+	c.wg.Wait()
+
+	// hand the lease over right away so that another host does not have to wait for it to expire
+	ctx, cancel := context.WithTimeout(context.Background(), persistenceTimeout)
+	defer cancel()
+	c.releaseLease(ctx)
+	close(c.msgC)
+	c.logger.Info("MAPQ queue consumer stopped")
+}
+
+func (c *consumerImpl) Messages() <-chan messaging.Message {
+	return c.msgC
+}
+
+func (c *consumerImpl) leaseLoop() {
+	defer c.wg.Done()
+
+	ticker := c.timeSrc.NewTicker(c.config.leaseDuration() / 3)
+	defer ticker.Stop()
+	for {
+		c.refreshLease()
+		select {
+		case <-ticker.Chan():
+		case <-c.shutdownCh:
+			return
+		}
+	}
+}
+
+func (c *consumerImpl) pollLoop() {
+	defer c.wg.Done()
+
+	ticker := c.timeSrc.NewTicker(c.config.pollInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.Chan():
+			// drain the ingress queue before waiting for the next tick
+			for c.moveToMapQ() == c.config.batchSize() {
+				select {
+				case <-c.shutdownCh:
+					return
+				default:
+				}
+			}
+		case <-c.shutdownCh:
+			return
+		}
+	}
+}
+
+// refreshLease acquires the lease if it is free or expired and renews it if this consumer owns it. MAPQ is started
+// when the lease is acquired and stopped when it is lost. Ingress messages moved into MAPQ are deleted on renewals.
+func (c *consumerImpl) refreshLease() {
+	ctx, cancel := context.WithTimeout(context.Background(), persistenceTimeout)
+	defer cancel()
+
+	resp, err := c.asyncRequestManager.GetAsyncRequestQueueMetadata(ctx, &persistence.GetAsyncRequestQueueMetadataRequest{QueueName: c.queueID})
+	var notExistsErr *types.EntityNotExistsError
+	if err != nil && !errors.As(err, &notExistsErr) {
+		c.logger.Warn("Failed to read queue metadata", tag.Error(err))
+		c.expireLease()
+		return
+	}
+
+	now := c.timeSrc.Now()
+	current := c.currentLease()
+	metadata := &persistence.AsyncRequestQueueMetadata{
+		QueueName:   c.queueID,
+		AckLevel:    -1,
+		LeaseOwner:  c.owner,
+		LeaseExpiry: now.Add(c.config.leaseDuration()),
+		Version:     1,
+	}
+	var previousVersion int64
+	if resp != nil {
+		existing := resp.Metadata
+		if existing.LeaseOwner != c.owner && existing.LeaseExpiry.After(now) {
+			// someone else owns the queue
+			c.dropLease()
+			return
+		}
+		if current != nil && current.version != existing.Version {
+			// the lease was taken over and released in between, start over from the persisted state
+			c.dropLease()
+			current = nil
+		}
+		previousVersion = existing.Version
+		metadata.Version = existing.Version + 1
+		metadata.AckLevel = existing.AckLevel
+		if current != nil {
+			c.Lock()
+			if current.readLevel > metadata.AckLevel {
+				metadata.AckLevel = current.readLevel
+			}
+			c.Unlock()
+		}
+	}
+
+	err = c.asyncRequestManager.UpdateAsyncRequestQueueMetadata(ctx, &persistence.UpdateAsyncRequestQueueMetadataRequest{
+		Metadata:        metadata,
+		PreviousVersion: previousVersion,
+	})
+	if err != nil {
+		var conditionFailedErr *persistence.ConditionFailedError
+		if errors.As(err, &conditionFailedErr) {
+			c.logger.Info("Lost the race for the queue lease")
+			c.dropLease()
+			return
+		}
+		c.logger.Warn("Failed to update queue metadata", tag.Error(err))
+		c.expireLease()
+		return
+	}
+
+	if current == nil {
+		current, err = c.newLease(ctx, metadata)
+		if err != nil {
+			// the lease is kept so MAPQ is started again on the next renewal
+			c.logger.Error("Failed to start MAPQ", tag.Error(err))
+			return
+		}
+		c.logger.Info("Acquired the queue lease", tag.ReadLevel(metadata.AckLevel))
+	} else {
+		c.Lock()
+		current.version = metadata.Version
+		current.expiry = metadata.LeaseExpiry
+		c.Unlock()
+	}
+
+	if metadata.AckLevel > current.persistedAck {
+		if err := c.asyncRequestManager.DeleteAsyncRequestQueueMessages(ctx, &persistence.DeleteAsyncRequestQueueMessagesRequest{
+			QueueName:             c.queueID,
+			InclusiveEndMessageID: metadata.AckLevel,
+		}); err != nil {
+			// moved messages are deleted again on the next renewal
+			c.logger.Warn("Failed to delete moved ingress messages", tag.Error(err))
+			return
+		}
+		c.Lock()
+		current.persistedAck = metadata.AckLevel
+		c.Unlock()
+	}
+}
+
+// releaseLease stops MAPQ, persists the final ingress ack level and lets the lease expire immediately
+func (c *consumerImpl) releaseLease(ctx context.Context) {
+	current := c.currentLease()
+	if current == nil {
+		return
+	}
+	c.dropLease()
+
+	ackLevel := current.readLevel
+	if ackLevel < current.persistedAck {
+		ackLevel = current.persistedAck
+	}
+	err := c.asyncRequestManager.UpdateAsyncRequestQueueMetadata(ctx, &persistence.UpdateAsyncRequestQueueMetadataRequest{
+		Metadata: &persistence.AsyncRequestQueueMetadata{
+			QueueName: c.queueID,
+			AckLevel:  ackLevel,
+			Version:   current.version + 1,
+		},
+		PreviousVersion: current.version,
+	})
+	if err != nil {
+		c.logger.Warn("Failed to release the queue lease", tag.Error(err))
+	}
+}
+
+// newLease starts MAPQ from its committed offsets
+func (c *consumerImpl) newLease(ctx context.Context, metadata *persistence.AsyncRequestQueueMetadata) (*lease, error) {
+	client, err := c.newClient()
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Start(ctx); err != nil {
+		return nil, err
+	}
+
+	l := &lease{
+		version:      metadata.Version,
+		expiry:       metadata.LeaseExpiry,
+		persistedAck: metadata.AckLevel,
+		readLevel:    metadata.AckLevel,
+		client:       client,
+	}
+	c.Lock()
+	c.lease = l
+	c.deliveryAttempts = make(map[int64]int)
+	c.Unlock()
+	return l, nil
+}
+
+func (c *consumerImpl) newMapQClient() (mapqtypes.Client, error) {
+	return mapqclient.New(
+		c.logger,
+		c.metricsClient.Scope(metrics.AsyncWorkflowConsumerScope),
+		mapqclient.WithPersister(mapqpersister.New(c.queueID, c.mapQManager, itemCodec{})),
+		mapqclient.WithConsumerFactory(&mapqConsumerFactory{c: c}),
+		mapqclient.WithPartitions(c.config.partitions()),
+		mapqclient.WithPolicies(c.config.policies()),
+		mapqclient.WithPolicyEvaluationInterval(c.config.policyEvaluationInterval()),
+		mapqclient.WithOffsetCommitInterval(c.config.offsetCommitInterval()),
+		mapqclient.WithTimeSource(c.timeSrc),
+	)
+}
+
+func (c *consumerImpl) currentLease() *lease {
+	c.Lock()
+	defer c.Unlock()
+	return c.lease
+}
+
+func (c *consumerImpl) dropLease() {
+	c.Lock()
+	current := c.lease
+	c.lease = nil
+	c.Unlock()
+
+	if current != nil {
+		c.logger.Info("Giving up the queue lease")
+		c.stopMapQ(current)
+	}
+}
+
+// expireLease drops the lease if it could not be renewed in time
+func (c *consumerImpl) expireLease() {
+	c.Lock()
+	current := c.lease
+	if current == nil || current.expiry.After(c.timeSrc.Now()) {
+		c.Unlock()
+		return
+	}
+	c.lease = nil
+	c.Unlock()
+
+	c.logger.Warn("Queue lease expired before it could be renewed")
+	c.stopMapQ(current)
+}
+
+func (c *consumerImpl) stopMapQ(l *lease) {
+	ctx, cancel := context.WithTimeout(context.Background(), persistenceTimeout)
+	defer cancel()
+	if err := l.client.Stop(ctx); err != nil {
+		c.logger.Warn("Failed to stop MAPQ", tag.Error(err))
+	}
+}
+
+// moveToMapQ moves a page of ingress messages into MAPQ if this consumer owns the lease. It returns the number of
+// messages moved.
+func (c *consumerImpl) moveToMapQ() int {
+	current := c.currentLease()
+	if current == nil {
+		return 0
+	}
+
+	c.Lock()
+	readLevel := current.readLevel
+	c.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), persistenceTimeout)
+	defer cancel()
+	resp, err := c.asyncRequestManager.ReadAsyncRequestQueueMessages(ctx, &persistence.ReadAsyncRequestQueueMessagesRequest{
+		QueueName:               c.queueID,
+		ExclusiveBeginMessageID: readLevel,
+		PageSize:                c.config.batchSize(),
+	})
+	if err != nil {
+		c.logger.Warn("Failed to read ingress messages", tag.Error(err))
+		return 0
+	}
+	if len(resp.Messages) == 0 {
+		return 0
+	}
+
+	items := make([]mapqtypes.Item, 0, len(resp.Messages))
+	for _, m := range resp.Messages {
+		it, err := newItem(c.msgDecoder, m.MessageID, m.Payload)
+		if err != nil {
+			c.logger.Warn("Failed to get partition attributes of message, it will be moved to the catch-all partition", tag.TaskID(m.MessageID), tag.Error(err))
+		}
+		items = append(items, it)
+	}
+
+	if _, err := current.client.Enqueue(ctx, items); err != nil {
+		c.logger.Warn("Failed to move ingress messages into MAPQ", tag.Error(err))
+		return 0
+	}
+
+	c.Lock()
+	current.readLevel = resp.Messages[len(resp.Messages)-1].MessageID
+	c.Unlock()
+	return len(resp.Messages)
+}
+
+func (f *mapqConsumerFactory) New(mapqtypes.ItemPartitions) (mapqtypes.Consumer, error) {
+	return &mapqConsumer{c: f.c}, nil
+}
+
+func (f *mapqConsumerFactory) Stop(context.Context) error {
+	return nil
+}
+
+func (m *mapqConsumer) Start(context.Context) error {
+	return nil
+}
+
+func (m *mapqConsumer) Stop(context.Context) error {
+	return nil
+}
+
+func (m *mapqConsumer) Process(ctx context.Context, mapqItem mapqtypes.Item) error {
+	it, ok := mapqItem.(*item)
+	if !ok {
+		return fmt.Errorf("unexpected item type %T", mapqItem)
+	}
+
+	c := m.c
+	c.Lock()
+	c.deliveryAttempts[it.ID]++
+	attempts := c.deliveryAttempts[it.ID]
+	c.Unlock()
+
+	msg := &message{id: it.ID, payload: it.Payload, result: make(chan bool, 1)}
+	select {
+	case c.msgC <- msg:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case acked := <-msg.result:
+		if !acked && attempts < maxDeliveryAttempts {
+			return errMessageNacked
+		}
+		if !acked {
+			c.logger.Error("Dropping queue message after too many delivery attempts", tag.TaskID(it.ID), tag.Attempt(int32(attempts)))
+		}
+		c.Lock()
+		delete(c.deliveryAttempts, it.ID)
+		c.Unlock()
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (m *message) Value() []byte {
+	return m.payload
+}
+
+func (m *message) Partition() int32 {
+	return 0
+}
+
+func (m *message) Offset() int64 {
+	return m.id
+}
+
+func (m *message) Ack() error {
+	m.complete(true)
+	return nil
+}
+
+func (m *message) Nack() error {
+	m.complete(false)
+	return nil
+}
+
+func (m *message) complete(acked bool) {
+	select {
+	case m.result <- acked:
+	default:
+		// already acked or nacked
+	}
+}
@@ -0,0 +1,295 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package mapq
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/testlogger"
+	mapqtypes "github.com/uber/cadence/common/mapq/types"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func newTestConsumer(t *testing.T) (*consumerImpl, *persistence.MockAsyncRequestManager, *mapqtypes.MockClient, clock.MockedTimeSource) {
+	ctrl := gomock.NewController(t)
+	manager := persistence.NewMockAsyncRequestManager(ctrl)
+	client := mapqtypes.NewMockClient(ctrl)
+	c := newConsumer(&queueConfig{QueueName: "queue", BatchSize: 2}, manager, persistence.NewMockMapQManager(ctrl), testlogger.New(t), metrics.NewNoopMetricsClient())
+	timeSrc := clock.NewMockedTimeSourceAt(time.Unix(1700000000, 0))
+	c.timeSrc = timeSrc
+	c.newClient = func() (mapqtypes.Client, error) {
+		return client, nil
+	}
+	return c, manager, client, timeSrc
+}
+
+func TestRefreshLease(t *testing.T) {
+	getReq := &persistence.GetAsyncRequestQueueMetadataRequest{QueueName: "mapq::queue"}
+
+	t.Run("create lease for new queue and start mapq", func(t *testing.T) {
+		c, manager, client, timeSrc := newTestConsumer(t)
+		manager.EXPECT().GetAsyncRequestQueueMetadata(gomock.Any(), getReq).Return(nil, &types.EntityNotExistsError{}).Times(1)
+		manager.EXPECT().UpdateAsyncRequestQueueMetadata(gomock.Any(), &persistence.UpdateAsyncRequestQueueMetadataRequest{
+			Metadata: &persistence.AsyncRequestQueueMetadata{
+				QueueName:   "mapq::queue",
+				AckLevel:    -1,
+				LeaseOwner:  c.owner,
+				LeaseExpiry: timeSrc.Now().Add(30 * time.Second),
+				Version:     1,
+			},
+		}).Return(nil).Times(1)
+		client.EXPECT().Start(gomock.Any()).Return(nil).Times(1)
+
+		c.refreshLease()
+		require.NotNil(t, c.currentLease())
+		assert.Equal(t, int64(1), c.currentLease().version)
+		assert.Equal(t, int64(-1), c.currentLease().readLevel)
+	})
+
+	t.Run("mapq is started again on the next renewal if it fails to start", func(t *testing.T) {
+		c, manager, client, timeSrc := newTestConsumer(t)
+		manager.EXPECT().GetAsyncRequestQueueMetadata(gomock.Any(), getReq).Return(nil, &types.EntityNotExistsError{}).Times(1)
+		manager.EXPECT().UpdateAsyncRequestQueueMetadata(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		client.EXPECT().Start(gomock.Any()).Return(errors.New("failed to read offsets")).Times(1)
+
+		c.refreshLease()
+		assert.Nil(t, c.currentLease())
+
+		manager.EXPECT().GetAsyncRequestQueueMetadata(gomock.Any(), getReq).Return(&persistence.GetAsyncRequestQueueMetadataResponse{
+			Metadata: &persistence.AsyncRequestQueueMetadata{QueueName: "mapq::queue", AckLevel: -1, LeaseOwner: c.owner, LeaseExpiry: timeSrc.Now().Add(time.Second), Version: 1},
+		}, nil).Times(1)
+		client.EXPECT().Start(gomock.Any()).Return(nil).Times(1)
+
+		c.refreshLease()
+		require.NotNil(t, c.currentLease())
+		assert.Equal(t, int64(2), c.currentLease().version)
+	})
+
+	t.Run("lease owned by another host", func(t *testing.T) {
+		c, manager, _, timeSrc := newTestConsumer(t)
+		manager.EXPECT().GetAsyncRequestQueueMetadata(gomock.Any(), getReq).Return(&persistence.GetAsyncRequestQueueMetadataResponse{
+			Metadata: &persistence.AsyncRequestQueueMetadata{QueueName: "mapq::queue", LeaseOwner: "other", LeaseExpiry: timeSrc.Now().Add(time.Second), Version: 3},
+		}, nil).Times(1)
+
+		c.refreshLease()
+		assert.Nil(t, c.currentLease())
+	})
+
+	t.Run("renewal persists read level and deletes moved messages", func(t *testing.T) {
+		c, manager, client, timeSrc := newTestConsumer(t)
+		client.EXPECT().Start(gomock.Any()).Return(nil).Times(1)
+		current, err := c.newLease(context.Background(), &persistence.AsyncRequestQueueMetadata{QueueName: "mapq::queue", AckLevel: 5, LeaseOwner: c.owner, LeaseExpiry: timeSrc.Now(), Version: 4})
+		require.NoError(t, err)
+		current.readLevel = 7
+
+		manager.EXPECT().GetAsyncRequestQueueMetadata(gomock.Any(), getReq).Return(&persistence.GetAsyncRequestQueueMetadataResponse{
+			Metadata: &persistence.AsyncRequestQueueMetadata{QueueName: "mapq::queue", AckLevel: 5, LeaseOwner: c.owner, LeaseExpiry: timeSrc.Now(), Version: 4},
+		}, nil).Times(1)
+		manager.EXPECT().UpdateAsyncRequestQueueMetadata(gomock.Any(), &persistence.UpdateAsyncRequestQueueMetadataRequest{
+			Metadata: &persistence.AsyncRequestQueueMetadata{
+				QueueName:   "mapq::queue",
+				AckLevel:    7,
+				LeaseOwner:  c.owner,
+				LeaseExpiry: timeSrc.Now().Add(30 * time.Second),
+				Version:     5,
+			},
+			PreviousVersion: 4,
+		}).Return(nil).Times(1)
+		manager.EXPECT().DeleteAsyncRequestQueueMessages(gomock.Any(), &persistence.DeleteAsyncRequestQueueMessagesRequest{
+			QueueName:             "mapq::queue",
+			InclusiveEndMessageID: 7,
+		}).Return(nil).Times(1)
+
+		c.refreshLease()
+		assert.Equal(t, current, c.currentLease())
+		assert.Equal(t, int64(5), current.version)
+		assert.Equal(t, int64(7), current.persistedAck)
+	})
+
+	t.Run("lost the race stops mapq", func(t *testing.T) {
+		c, manager, client, timeSrc := newTestConsumer(t)
+		client.EXPECT().Start(gomock.Any()).Return(nil).Times(1)
+		_, err := c.newLease(context.Background(), &persistence.AsyncRequestQueueMetadata{QueueName: "mapq::queue", LeaseOwner: c.owner, LeaseExpiry: timeSrc.Now(), Version: 1})
+		require.NoError(t, err)
+
+		manager.EXPECT().GetAsyncRequestQueueMetadata(gomock.Any(), getReq).Return(&persistence.GetAsyncRequestQueueMetadataResponse{
+			Metadata: &persistence.AsyncRequestQueueMetadata{QueueName: "mapq::queue", LeaseOwner: c.owner, LeaseExpiry: timeSrc.Now(), Version: 1},
+		}, nil).Times(1)
+		manager.EXPECT().UpdateAsyncRequestQueueMetadata(gomock.Any(), gomock.Any()).Return(&persistence.ConditionFailedError{}).Times(1)
+		client.EXPECT().Stop(gomock.Any()).Return(nil).Times(1)
+
+		c.refreshLease()
+		assert.Nil(t, c.currentLease())
+	})
+
+	t.Run("lease expires when metadata is unavailable", func(t *testing.T) {
+		c, manager, client, timeSrc := newTestConsumer(t)
+		client.EXPECT().Start(gomock.Any()).Return(nil).Times(1)
+		_, err := c.newLease(context.Background(), &persistence.AsyncRequestQueueMetadata{QueueName: "mapq::queue", LeaseExpiry: timeSrc.Now().Add(time.Second), Version: 1})
+		require.NoError(t, err)
+		manager.EXPECT().GetAsyncRequestQueueMetadata(gomock.Any(), getReq).Return(nil, errors.New("db unavailable")).Times(2)
+
+		c.refreshLease()
+		assert.NotNil(t, c.currentLease())
+
+		client.EXPECT().Stop(gomock.Any()).Return(nil).Times(1)
+		timeSrc.Advance(time.Second)
+		c.refreshLease()
+		assert.Nil(t, c.currentLease())
+	})
+}
+
+func TestMoveToMapQ(t *testing.T) {
+	c, manager, client, timeSrc := newTestConsumer(t)
+	assert.Equal(t, 0, c.moveToMapQ()) // no lease, nothing to do
+
+	client.EXPECT().Start(gomock.Any()).Return(nil).Times(1)
+	current, err := c.newLease(context.Background(), &persistence.AsyncRequestQueueMetadata{QueueName: "mapq::queue", AckLevel: 5, LeaseOwner: c.owner, LeaseExpiry: timeSrc.Now().Add(time.Minute), Version: 1})
+	require.NoError(t, err)
+
+	readReq := &persistence.ReadAsyncRequestQueueMessagesRequest{
+		QueueName:               "mapq::queue",
+		ExclusiveBeginMessageID: 5,
+		PageSize:                2,
+	}
+	manager.EXPECT().ReadAsyncRequestQueueMessages(gomock.Any(), readReq).Return(&persistence.ReadAsyncRequestQueueMessagesResponse{
+		Messages: []*persistence.AsyncRequestQueueMessage{
+			{MessageID: 6, Payload: []byte("six")},
+			{MessageID: 7, Payload: []byte("seven")},
+		},
+	}, nil).Times(2)
+
+	// messages stay in the ingress queue if they can't be enqueued
+	client.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(nil, errors.New("enqueue failed")).Times(1)
+	assert.Equal(t, 0, c.moveToMapQ())
+	assert.Equal(t, int64(5), current.readLevel)
+
+	// messages that can't be decoded are moved to the catch-all partition
+	client.EXPECT().Enqueue(gomock.Any(), []mapqtypes.Item{
+		&item{ID: 6, Domain: catchAllValue, WorkflowType: catchAllValue, Payload: []byte("six")},
+		&item{ID: 7, Domain: catchAllValue, WorkflowType: catchAllValue, Payload: []byte("seven")},
+	}).Return(nil, nil).Times(1)
+	assert.Equal(t, 2, c.moveToMapQ())
+	assert.Equal(t, int64(7), current.readLevel)
+
+	manager.EXPECT().ReadAsyncRequestQueueMessages(gomock.Any(), gomock.Any()).Return(&persistence.ReadAsyncRequestQueueMessagesResponse{}, nil).Times(1)
+	assert.Equal(t, 0, c.moveToMapQ())
+}
+
+func TestProcess(t *testing.T) {
+	c, _, _, _ := newTestConsumer(t)
+	consumer, err := (&mapqConsumerFactory{c: c}).New(nil)
+	require.NoError(t, err)
+	it := &item{ID: 6, Domain: "d1", WorkflowType: "wt1", Payload: []byte("six")}
+
+	process := func() chan error {
+		result := make(chan error, 1)
+		go func() {
+			result <- consumer.Process(context.Background(), it)
+		}()
+		return result
+	}
+
+	// nacked messages are retried by the mapq dispatcher
+	result := process()
+	msg := <-c.Messages()
+	assert.Equal(t, int64(6), msg.Offset())
+	assert.Equal(t, []byte("six"), msg.Value())
+	assert.NoError(t, msg.Nack())
+	assert.ErrorIs(t, <-result, errMessageNacked)
+
+	result = process()
+	msg = <-c.Messages()
+	assert.NoError(t, msg.Ack())
+	assert.NoError(t, <-result)
+	assert.Empty(t, c.deliveryAttempts)
+
+	assert.Error(t, consumer.Process(context.Background(), mapqtypes.NewMockItem(gomock.NewController(t))))
+}
+
+func TestProcessDropsMessageAfterMaxAttempts(t *testing.T) {
+	c, _, _, _ := newTestConsumer(t)
+	consumer := &mapqConsumer{c: c}
+	c.deliveryAttempts[6] = maxDeliveryAttempts - 1
+
+	result := make(chan error, 1)
+	go func() {
+		result <- consumer.Process(context.Background(), &item{ID: 6})
+	}()
+	msg := <-c.Messages()
+	assert.NoError(t, msg.Nack())
+	assert.NoError(t, <-result)
+	assert.Empty(t, c.deliveryAttempts)
+}
+
+func TestProcessCanceled(t *testing.T) {
+	c, _, _, _ := newTestConsumer(t)
+	consumer := &mapqConsumer{c: c}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	result := make(chan error, 1)
+	go func() {
+		result <- consumer.Process(ctx, &item{ID: 6})
+	}()
+	<-c.Messages()
+	cancel()
+	assert.ErrorIs(t, <-result, context.Canceled)
+}
+
+func TestConsumerStartStop(t *testing.T) {
+	c, manager, client, _ := newTestConsumer(t)
+	manager.EXPECT().GetAsyncRequestQueueMetadata(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{}).Times(1)
+	manager.EXPECT().UpdateAsyncRequestQueueMetadata(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	client.EXPECT().Start(gomock.Any()).Return(nil).Times(1)
+	released := make(chan *persistence.UpdateAsyncRequestQueueMetadataRequest, 1)
+
+	require.NoError(t, c.Start())
+	assert.Eventually(t, func() bool { return c.currentLease() != nil }, time.Second, 10*time.Millisecond)
+
+	client.EXPECT().Stop(gomock.Any()).Return(nil).Times(1)
+	manager.EXPECT().UpdateAsyncRequestQueueMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, request *persistence.UpdateAsyncRequestQueueMetadataRequest) error {
+			released <- request
+			return nil
+		},
+	).Times(1)
+	c.Stop()
+
+	request := <-released
+	assert.Equal(t, int64(1), request.PreviousVersion)
+	assert.Equal(t, int64(-1), request.Metadata.AckLevel)
+	assert.Empty(t, request.Metadata.LeaseOwner)
+	assert.Nil(t, c.currentLease())
+	_, ok := <-c.Messages()
+	assert.False(t, ok)
+}
@@ -0,0 +1,158 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package mapq
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/.gen/go/sqlblobs"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/constants"
+	mapqtypes "github.com/uber/cadence/common/mapq/types"
+	"github.com/uber/cadence/common/types/mapper/thrift"
+)
+
+// catchAllValue is used for attributes that can't be determined so that such requests always stay in catch-all partitions
+const catchAllValue = "*"
+
+type (
+	// item is an async request moved from the ingress queue into MAPQ. Its offset is the ID of the ingress message
+	// which is unique and increasing within the queue.
+	item struct {
+		ID           int64  `json:"id"`
+		Domain       string `json:"domain"`
+		WorkflowType string `json:"workflowType"`
+		Payload      []byte `json:"payload"`
+	}
+
+	itemCodec struct{}
+)
+
+var _ mapqtypes.Item = (*item)(nil)
+
+// newItem extracts the partition attributes of the serialized AsyncRequestMessage. Requests that can't be decoded are
+// routed to the catch-all partition and fail when they are processed.
+func newItem(decoder codec.BinaryEncoder, id int64, payload []byte) (*item, error) {
+	it := &item{
+		ID:           id,
+		Domain:       catchAllValue,
+		WorkflowType: catchAllValue,
+		Payload:      payload,
+	}
+
+	var message sqlblobs.AsyncRequestMessage
+	if err := decoder.Decode(payload, &message); err != nil {
+		return it, fmt.Errorf("failed to decode message %v: %w", id, err)
+	}
+	if message.GetEncoding() != string(constants.EncodingTypeThriftRW) {
+		return it, fmt.Errorf("unsupported encoding %v of message %v", message.GetEncoding(), id)
+	}
+
+	var domain, workflowType string
+	switch message.GetType() {
+	case sqlblobs.AsyncRequestTypeStartWorkflowExecutionAsyncRequest:
+		var req shared.StartWorkflowExecutionAsyncRequest
+		if err := decoder.Decode(message.Payload, &req); err != nil {
+			return it, fmt.Errorf("failed to decode request of message %v: %w", id, err)
+		}
+		startRequest := thrift.ToStartWorkflowExecutionAsyncRequest(&req)
+		domain = startRequest.GetDomain()
+		if startRequest.StartWorkflowExecutionRequest != nil {
+			workflowType = startRequest.WorkflowType.GetName()
+		}
+	case sqlblobs.AsyncRequestTypeSignalWithStartWorkflowExecutionAsyncRequest:
+		var req shared.SignalWithStartWorkflowExecutionAsyncRequest
+		if err := decoder.Decode(message.Payload, &req); err != nil {
+			return it, fmt.Errorf("failed to decode request of message %v: %w", id, err)
+		}
+		signalWithStartRequest := thrift.ToSignalWithStartWorkflowExecutionAsyncRequest(&req)
+		domain = signalWithStartRequest.GetDomain()
+		workflowType = signalWithStartRequest.GetWorkflowType().GetName()
+	case sqlblobs.AsyncRequestTypeSignalWorkflowExecutionAsyncRequest:
+		var req shared.SignalWorkflowExecutionAsyncRequest
+		if err := decoder.Decode(message.Payload, &req); err != nil {
+			return it, fmt.Errorf("failed to decode request of message %v: %w", id, err)
+		}
+		domain = thrift.ToSignalWorkflowExecutionAsyncRequest(&req).GetDomain()
+	case sqlblobs.AsyncRequestTypeRequestCancelWorkflowExecutionAsyncRequest:
+		var req shared.RequestCancelWorkflowExecutionAsyncRequest
+		if err := decoder.Decode(message.Payload, &req); err != nil {
+			return it, fmt.Errorf("failed to decode request of message %v: %w", id, err)
+		}
+		domain = thrift.ToRequestCancelWorkflowExecutionAsyncRequest(&req).GetDomain()
+	case sqlblobs.AsyncRequestTypeTerminateWorkflowExecutionAsyncRequest:
+		var req shared.TerminateWorkflowExecutionAsyncRequest
+		if err := decoder.Decode(message.Payload, &req); err != nil {
+			return it, fmt.Errorf("failed to decode request of message %v: %w", id, err)
+		}
+		domain = thrift.ToTerminateWorkflowExecutionAsyncRequest(&req).GetDomain()
+	default:
+		return it, fmt.Errorf("unsupported request type %v of message %v", message.GetType(), id)
+	}
+
+	if domain != "" {
+		it.Domain = domain
+	}
+	// requests without a workflow type (e.g. signals) share the catch-all partition of their domain
+	if workflowType != "" {
+		it.WorkflowType = workflowType
+	}
+	return it, nil
+}
+
+func (i *item) GetAttribute(key string) any {
+	switch key {
+	case domainPartitionKey:
+		return i.Domain
+	case workflowTypePartitionKey:
+		return i.WorkflowType
+	default:
+		return nil
+	}
+}
+
+func (i *item) Offset() int64 {
+	return i.ID
+}
+
+func (i *item) String() string {
+	return fmt.Sprintf("item{id: %v, domain: %v, workflowType: %v}", i.ID, i.Domain, i.WorkflowType)
+}
+
+func (itemCodec) Encode(it mapqtypes.Item) ([]byte, error) {
+	i, ok := it.(*item)
+	if !ok {
+		return nil, fmt.Errorf("unexpected item type %T", it)
+	}
+	return json.Marshal(i)
+}
+
+func (itemCodec) Decode(payload []byte) (mapqtypes.Item, error) {
+	var i item
+	if err := json.Unmarshal(payload, &i); err != nil {
+		return nil, err
+	}
+	return &i, nil
+}
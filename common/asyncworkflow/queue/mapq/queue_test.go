@@ -0,0 +1,281 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package mapq
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/.gen/go/sqlblobs"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/asyncworkflow/queue/consumer"
+	"github.com/uber/cadence/common/asyncworkflow/queue/provider"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log/testlogger"
+	mapqtypes "github.com/uber/cadence/common/mapq/types"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type mockDecoder struct {
+	decodeFunc func(v any) error
+}
+
+func (m *mockDecoder) Decode(v any) error {
+	return m.decodeFunc(v)
+}
+
+func TestNewQueue(t *testing.T) {
+	tests := []struct {
+		name    string
+		decoder *mockDecoder
+		want    *queueImpl
+		wantErr bool
+	}{
+		{
+			name: "success",
+			decoder: &mockDecoder{decodeFunc: func(v any) error {
+				v.(*queueConfig).QueueName = "queue"
+				return nil
+			}},
+			want: &queueImpl{config: &queueConfig{QueueName: "queue"}},
+		},
+		{
+			name: "decoding failure",
+			decoder: &mockDecoder{decodeFunc: func(v any) error {
+				return errors.New("decoding error")
+			}},
+			wantErr: true,
+		},
+		{
+			name: "invalid config",
+			decoder: &mockDecoder{decodeFunc: func(v any) error {
+				return nil
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newQueue(tt.decoder)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, "mapq::queue", got.ID())
+		})
+	}
+}
+
+func TestCreateConsumerAndProducer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	q := &queueImpl{config: &queueConfig{QueueName: "queue"}}
+
+	params := &provider.Params{Logger: testlogger.New(t), MetricsClient: metrics.NewNoopMetricsClient()}
+	_, err := q.CreateConsumer(params)
+	assert.ErrorIs(t, err, errAsyncRequestManagerRequired)
+	_, err = q.CreateProducer(params)
+	assert.ErrorIs(t, err, errAsyncRequestManagerRequired)
+
+	params.AsyncRequestManager = persistence.NewMockAsyncRequestManager(ctrl)
+	_, err = q.CreateConsumer(params)
+	assert.ErrorIs(t, err, errMapQManagerRequired)
+	p, err := q.CreateProducer(params)
+	assert.NoError(t, err)
+	assert.NotNil(t, p)
+
+	params.MapQManager = persistence.NewMockMapQManager(ctrl)
+	c, err := q.CreateConsumer(params)
+	assert.NoError(t, err)
+	assert.IsType(t, &consumer.DefaultConsumer{}, c)
+}
+
+func TestDescribe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	q := &queueImpl{config: &queueConfig{QueueName: "queue"}}
+
+	_, err := q.Describe(context.Background(), &provider.Params{})
+	assert.ErrorIs(t, err, errMapQManagerRequired)
+
+	manager := persistence.NewMockMapQManager(ctrl)
+	manager.EXPECT().GetMapQState(gomock.Any(), &persistence.GetMapQStateRequest{QueueID: "mapq::queue"}).Return(&persistence.GetMapQStateResponse{
+		State: &persistence.MapQState{
+			QueueID: "mapq::queue",
+			State:   persistence.NewDataBlob([]byte(`{"partitions":{"*/d1/.":7,"*/./.":3}}`), constants.EncodingTypeJSON),
+			Version: 2,
+		},
+	}, nil).Times(1)
+
+	partitions, err := q.Describe(context.Background(), &provider.Params{MapQManager: manager})
+	require.NoError(t, err)
+	assert.Equal(t, []*types.AsyncWorkflowQueuePartition{
+		{Path: "*/./.", AckLevel: 3},
+		{Path: "*/d1/.", AckLevel: 7},
+	}, partitions)
+
+	manager.EXPECT().GetMapQState(gomock.Any(), gomock.Any()).Return(nil, errors.New("db unavailable")).Times(1)
+	_, err = q.Describe(context.Background(), &provider.Params{MapQManager: manager})
+	assert.Error(t, err)
+}
+
+func TestProducerPublish(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	manager := persistence.NewMockAsyncRequestManager(ctrl)
+	p := newProducer("mapq::queue", manager)
+
+	msg := &sqlblobs.AsyncRequestMessage{PartitionKey: common.StringPtr("wid")}
+	payload, err := codec.NewThriftRWEncoder().Encode(msg)
+	assert.NoError(t, err)
+	manager.EXPECT().EnqueueAsyncRequestQueueMessage(gomock.Any(), &persistence.EnqueueAsyncRequestQueueMessageRequest{
+		QueueName: "mapq::queue",
+		Payload:   payload,
+	}).Return(&persistence.EnqueueAsyncRequestQueueMessageResponse{MessageID: 1}, nil).Times(1)
+	assert.NoError(t, p.Publish(context.Background(), msg))
+
+	manager.EXPECT().EnqueueAsyncRequestQueueMessage(gomock.Any(), gomock.Any()).Return(nil, errors.New("enqueue failed")).Times(1)
+	assert.Error(t, p.Publish(context.Background(), msg))
+
+	assert.Error(t, p.Publish(context.Background(), "unknown message"))
+}
+
+func TestNewItem(t *testing.T) {
+	encoder := codec.NewThriftRWEncoder()
+	encode := func(t *testing.T, requestType sqlblobs.AsyncRequestType, request codec.ThriftObject) []byte {
+		payload, err := encoder.Encode(request)
+		require.NoError(t, err)
+		message, err := encoder.Encode(&sqlblobs.AsyncRequestMessage{
+			Type:     requestType.Ptr(),
+			Encoding: common.StringPtr(string(constants.EncodingTypeThriftRW)),
+			Payload:  payload,
+		})
+		require.NoError(t, err)
+		return message
+	}
+
+	tests := []struct {
+		name             string
+		payload          func(t *testing.T) []byte
+		wantDomain       string
+		wantWorkflowType string
+		wantErr          bool
+	}{
+		{
+			name: "start workflow",
+			payload: func(t *testing.T) []byte {
+				return encode(t, sqlblobs.AsyncRequestTypeStartWorkflowExecutionAsyncRequest, &shared.StartWorkflowExecutionAsyncRequest{
+					Request: &shared.StartWorkflowExecutionRequest{
+						Domain:       common.StringPtr("d1"),
+						WorkflowType: &shared.WorkflowType{Name: common.StringPtr("wt1")},
+					},
+				})
+			},
+			wantDomain:       "d1",
+			wantWorkflowType: "wt1",
+		},
+		{
+			name: "signal with start workflow",
+			payload: func(t *testing.T) []byte {
+				return encode(t, sqlblobs.AsyncRequestTypeSignalWithStartWorkflowExecutionAsyncRequest, &shared.SignalWithStartWorkflowExecutionAsyncRequest{
+					Request: &shared.SignalWithStartWorkflowExecutionRequest{
+						Domain:       common.StringPtr("d2"),
+						WorkflowType: &shared.WorkflowType{Name: common.StringPtr("wt2")},
+					},
+				})
+			},
+			wantDomain:       "d2",
+			wantWorkflowType: "wt2",
+		},
+		{
+			name: "signal workflow",
+			payload: func(t *testing.T) []byte {
+				return encode(t, sqlblobs.AsyncRequestTypeSignalWorkflowExecutionAsyncRequest, &shared.SignalWorkflowExecutionAsyncRequest{
+					Request: &shared.SignalWorkflowExecutionRequest{Domain: common.StringPtr("d3")},
+				})
+			},
+			wantDomain:       "d3",
+			wantWorkflowType: catchAllValue,
+		},
+		{
+			name: "unsupported request type",
+			payload: func(t *testing.T) []byte {
+				return encode(t, sqlblobs.AsyncRequestType(-1), &shared.SignalWorkflowExecutionAsyncRequest{})
+			},
+			wantDomain:       catchAllValue,
+			wantWorkflowType: catchAllValue,
+			wantErr:          true,
+		},
+		{
+			name: "corrupted payload",
+			payload: func(t *testing.T) []byte {
+				return []byte("corrupted")
+			},
+			wantDomain:       catchAllValue,
+			wantWorkflowType: catchAllValue,
+			wantErr:          true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := tt.payload(t)
+			it, err := newItem(encoder, 5, payload)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			require.NotNil(t, it)
+			assert.Equal(t, int64(5), it.Offset())
+			assert.Equal(t, payload, it.Payload)
+			assert.Equal(t, tt.wantDomain, it.GetAttribute(domainPartitionKey))
+			assert.Equal(t, tt.wantWorkflowType, it.GetAttribute(workflowTypePartitionKey))
+			assert.Nil(t, it.GetAttribute("unknown"))
+		})
+	}
+}
+
+func TestItemCodec(t *testing.T) {
+	it := &item{ID: 3, Domain: "d1", WorkflowType: "wt1", Payload: []byte("payload")}
+
+	encoded, err := itemCodec{}.Encode(it)
+	require.NoError(t, err)
+	decoded, err := itemCodec{}.Decode(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, it, decoded)
+
+	_, err = itemCodec{}.Encode(mapqtypes.NewMockItem(gomock.NewController(t)))
+	assert.Error(t, err)
+	_, err = itemCodec{}.Decode([]byte("corrupted"))
+	assert.Error(t, err)
+}
@@ -10,11 +10,13 @@
 package provider
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
 
 	messaging "github.com/uber/cadence/common/messaging"
+	types "github.com/uber/cadence/common/types"
 )
 
 // MockDecoder is a mock of Decoder interface.
@@ -172,3 +174,42 @@ func (mr *MockQueueMockRecorder) ID() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ID", reflect.TypeOf((*MockQueue)(nil).ID))
 }
+
+// MockDescriber is a mock of Describer interface.
+type MockDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockDescriberMockRecorder
+	isgomock struct{}
+}
+
+// MockDescriberMockRecorder is the mock recorder for MockDescriber.
+type MockDescriberMockRecorder struct {
+	mock *MockDescriber
+}
+
+// NewMockDescriber creates a new mock instance.
+func NewMockDescriber(ctrl *gomock.Controller) *MockDescriber {
+	mock := &MockDescriber{ctrl: ctrl}
+	mock.recorder = &MockDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDescriber) EXPECT() *MockDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockDescriber) Describe(arg0 context.Context, arg1 *Params) ([]*types.AsyncWorkflowQueuePartition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe", arg0, arg1)
+	ret0, _ := ret[0].([]*types.AsyncWorkflowQueuePartition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockDescriberMockRecorder) Describe(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockDescriber)(nil).Describe), arg0, arg1)
+}
@@ -25,12 +25,14 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/syncmap"
 	"github.com/uber/cadence/common/types"
 )
@@ -40,6 +42,11 @@ type (
 		Logger         log.Logger
 		MetricsClient  metrics.Client
 		FrontendClient frontend.Client
+		// AsyncRequestManager is optional for most queues. If set, consumers record the status of tracked requests
+		// and keep the requests that failed permanently in its DLQ. The database queue requires it to store messages.
+		AsyncRequestManager persistence.AsyncRequestManager
+		// MapQManager stores the items and offsets of MAPQ backed queues.
+		MapQManager persistence.MapQManager
 	}
 
 	Decoder interface {
@@ -58,6 +65,11 @@ type (
 		CreateProducer(*Params) (messaging.Producer, error)
 	}
 
+	// Describer is implemented by queues that can report their partitions and consumption progress
+	Describer interface {
+		Describe(context.Context, *Params) ([]*types.AsyncWorkflowQueuePartition, error)
+	}
+
 	QueueConstructor func(Decoder) (Queue, error)
 
 	DecoderConstructor func(*types.DataBlob) Decoder
@@ -0,0 +1,89 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package completioncallback contains the helpers shared by frontend, history and worker
+// for HTTP callbacks fired when a workflow execution closes.
+package completioncallback
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/uber/cadence/common/types"
+)
+
+const maxURLLength = 2048
+
+// Validate checks callbacks against the per-domain limits. allowedHosts is the domain's
+// allow-list as returned by dynamic config; an empty allow-list rejects every destination.
+func Validate(callbacks []*types.CompletionCallback, maxCallbacks int, allowedHosts map[string]interface{}) error {
+	if len(callbacks) > maxCallbacks {
+		return &types.BadRequestError{Message: fmt.Sprintf("Too many completion callbacks: %d, limit is %d.", len(callbacks), maxCallbacks)}
+	}
+	for _, callback := range callbacks {
+		if err := ValidateCallback(callback, allowedHosts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateCallback checks that callback is a well-formed http(s) URL whose host is in allowedHosts
+func ValidateCallback(callback *types.CompletionCallback, allowedHosts map[string]interface{}) error {
+	if callback.GetURL() == "" {
+		return &types.BadRequestError{Message: "Completion callback URL is not set."}
+	}
+	if len(callback.URL) > maxURLLength {
+		return &types.BadRequestError{Message: fmt.Sprintf("Completion callback URL exceeds length limit of %d.", maxURLLength)}
+	}
+	u, err := url.Parse(callback.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return &types.BadRequestError{Message: fmt.Sprintf("Invalid completion callback URL: %q.", callback.URL)}
+	}
+	if !IsHostAllowed(u.Hostname(), allowedHosts) {
+		return &types.BadRequestError{Message: fmt.Sprintf("Completion callback host %q is not in the domain allow-list.", u.Hostname())}
+	}
+	return nil
+}
+
+// IsHostAllowed reports whether host matches an entry of the allow-list. Entries are either
+// exact host names or "*.<suffix>" wildcards matching any subdomain of suffix.
+func IsHostAllowed(host string, allowedHosts map[string]interface{}) bool {
+	host = strings.ToLower(host)
+	for pattern, enabled := range allowedHosts {
+		if allowed, ok := enabled.(bool); !ok || !allowed {
+			continue
+		}
+		pattern = strings.ToLower(pattern)
+		if suffix, ok := strings.CutPrefix(pattern, "*."); ok {
+			if strings.HasSuffix(host, "."+suffix) {
+				return true
+			}
+			continue
+		}
+		if host == pattern {
+			return true
+		}
+	}
+	return false
+}
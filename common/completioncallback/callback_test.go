@@ -0,0 +1,89 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package completioncallback
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestValidate(t *testing.T) {
+	allowed := map[string]interface{}{
+		"hooks.example.com":  true,
+		"*.internal.example": true,
+		"disabled.example":   false,
+	}
+	tests := map[string]struct {
+		callbacks []*types.CompletionCallback
+		wantErr   bool
+	}{
+		"no callbacks": {},
+		"exact host": {
+			callbacks: []*types.CompletionCallback{{URL: "https://hooks.example.com/done"}},
+		},
+		"wildcard host": {
+			callbacks: []*types.CompletionCallback{{URL: "http://svc.internal.example:8080/done"}},
+		},
+		"wildcard does not match bare suffix": {
+			callbacks: []*types.CompletionCallback{{URL: "http://internal.example/done"}},
+			wantErr:   true,
+		},
+		"disabled host": {
+			callbacks: []*types.CompletionCallback{{URL: "https://disabled.example/done"}},
+			wantErr:   true,
+		},
+		"host not allowed": {
+			callbacks: []*types.CompletionCallback{{URL: "https://evil.example/done"}},
+			wantErr:   true,
+		},
+		"unsupported scheme": {
+			callbacks: []*types.CompletionCallback{{URL: "ftp://hooks.example.com/done"}},
+			wantErr:   true,
+		},
+		"empty url": {
+			callbacks: []*types.CompletionCallback{{}},
+			wantErr:   true,
+		},
+		"too many": {
+			callbacks: []*types.CompletionCallback{
+				{URL: "https://hooks.example.com/1"},
+				{URL: "https://hooks.example.com/2"},
+				{URL: "https://hooks.example.com/3"},
+			},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := Validate(tt.callbacks, 2, allowed)
+			if tt.wantErr {
+				assert.IsType(t, &types.BadRequestError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package completioncallback
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// ContentType is the content type of the notification body
+	ContentType = "application/json"
+
+	maxErrorBodyLength = 256
+)
+
+type (
+	// Notification is the JSON body posted to a completion callback
+	Notification struct {
+		Domain        string `json:"domain"`
+		WorkflowID    string `json:"workflowId"`
+		RunID         string `json:"runId"`
+		WorkflowType  string `json:"workflowType"`
+		CloseStatus   string `json:"closeStatus"`
+		CloseTime     int64  `json:"closeTime"`
+		HistoryLength int64  `json:"historyLength"`
+	}
+
+	// DeliveryError is returned when a callback endpoint could not be notified
+	DeliveryError struct {
+		StatusCode int
+		Message    string
+		Retryable  bool
+	}
+)
+
+func (e *DeliveryError) Error() string {
+	if e.StatusCode == 0 {
+		return e.Message
+	}
+	return fmt.Sprintf("callback returned status %d: %s", e.StatusCode, e.Message)
+}
+
+// Deliver posts notification to the callback. Any 2xx response is a success. Transport
+// errors, 408, 429 and 5xx are retryable; other responses mean the request itself is
+// rejected and retrying will not help.
+func Deliver(
+	ctx context.Context,
+	client *http.Client,
+	callback *types.CompletionCallback,
+	notification Notification,
+) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return &DeliveryError{Message: err.Error()}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, callback.URL, bytes.NewReader(body))
+	if err != nil {
+		return &DeliveryError{Message: err.Error()}
+	}
+	for k, v := range callback.Headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", ContentType)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return &DeliveryError{Message: err.Error(), Retryable: true}
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyLength))
+	return &DeliveryError{
+		StatusCode: resp.StatusCode,
+		Message:    string(respBody),
+		Retryable:  isRetryableStatus(resp.StatusCode),
+	}
+}
+
+func isRetryableStatus(code int) bool {
+	return code >= http.StatusInternalServerError ||
+		code == http.StatusRequestTimeout ||
+		code == http.StatusTooManyRequests
+}
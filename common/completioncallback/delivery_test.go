@@ -0,0 +1,96 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package completioncallback
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestDeliver(t *testing.T) {
+	notification := Notification{
+		Domain:       "test-domain",
+		WorkflowID:   "wid",
+		RunID:        "rid",
+		WorkflowType: "wtype",
+		CloseStatus:  types.WorkflowExecutionCloseStatusCompleted.String(),
+		CloseTime:    123,
+	}
+	tests := map[string]struct {
+		status        int
+		wantErr       bool
+		wantRetryable bool
+	}{
+		"ok":                {status: http.StatusOK},
+		"accepted":          {status: http.StatusAccepted},
+		"bad request":       {status: http.StatusBadRequest, wantErr: true},
+		"too many requests": {status: http.StatusTooManyRequests, wantErr: true, wantRetryable: true},
+		"server error":      {status: http.StatusServiceUnavailable, wantErr: true, wantRetryable: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var received Notification
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, ContentType, r.Header.Get("Content-Type"))
+				assert.Equal(t, "secret", r.Header.Get("X-Token"))
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			err := Deliver(context.Background(), server.Client(), &types.CompletionCallback{
+				URL:     server.URL,
+				Headers: map[string]string{"X-Token": "secret"},
+			}, notification)
+			assert.Equal(t, notification, received)
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			var deliveryErr *DeliveryError
+			require.ErrorAs(t, err, &deliveryErr)
+			assert.Equal(t, tt.status, deliveryErr.StatusCode)
+			assert.Equal(t, tt.wantRetryable, deliveryErr.Retryable)
+		})
+	}
+}
+
+func TestDeliverUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	err := Deliver(context.Background(), http.DefaultClient, &types.CompletionCallback{URL: url}, Notification{})
+	var deliveryErr *DeliveryError
+	require.ErrorAs(t, err, &deliveryErr)
+	assert.True(t, deliveryErr.Retryable)
+}
@@ -99,7 +99,12 @@ type (
 		Provider PeerProvider `yaml:"provider"`
 	}
 
-	// PeerProvider is provider config. Contents depends on plugin in use
+	// PeerProvider is provider config. Contents depends on plugin in use.
+	// When empty, ringpop is used as the peer provider.
+	//
+	// Config keys and structures expected in the main default binary include:
+	//  - "file" via [github.com/uber/cadence/common/peerprovider/fileprovider.ConfigKey]: [github.com/uber/cadence/common/peerprovider/fileprovider.Config]
+	//  - "dns" via [github.com/uber/cadence/common/peerprovider/dnsprovider.ConfigKey]: [github.com/uber/cadence/common/peerprovider/dnsprovider.Config]
 	PeerProvider map[string]*YamlNode
 
 	HeaderRule struct {
@@ -188,6 +193,9 @@ type (
 		// AdvancedVisibilityStore is the name of the datastore to be used for visibility records
 		// Must provide one of VisibilityStore and AdvancedVisibilityStore
 		AdvancedVisibilityStore string `yaml:"advancedVisibilityStore"`
+		// AdvancedVisibilityTransport is the transport used to deliver visibility messages to the indexer
+		// Supported values are "kafka" (default) and "persistence", the latter uses a queue in the default store
+		AdvancedVisibilityTransport string `yaml:"advancedVisibilityTransport"`
 		// HistoryMaxConns is the desired number of conns to history store. Value specified
 		// here overrides the MaxConns config specified as part of datastore
 		// Deprecated: This value is not used
@@ -634,6 +642,8 @@ type (
 
 	// LeaderStore provides a config for leader election.
 	LeaderStore struct {
+		// Type is the name of the registered leader store implementation, etcd if not set
+		Type          string    `yaml:"type"`
 		StorageParams *YamlNode `yaml:"storageParams"`
 	}
 
@@ -654,6 +664,14 @@ type (
 	LeaderProcess struct {
 		Period       time.Duration `yaml:"period"`
 		HeartbeatTTL time.Duration `yaml:"heartbeatTTL"`
+		// LoadBalancing moves shards from the most to the least loaded executors based on the shard load reported in heartbeats.
+		LoadBalancing bool `yaml:"loadBalancing"`
+		// LoadImbalanceThreshold is the ratio of the most loaded executor to the mean executor load above which shards are moved.
+		LoadImbalanceThreshold float64 `yaml:"loadImbalanceThreshold"`
+		// MaxShardMovesPerRebalance bounds how many shards are moved for load balancing in a single rebalance.
+		MaxShardMovesPerRebalance int `yaml:"maxShardMovesPerRebalance"`
+		// ShardMoveCooldown is the minimum time a shard stays on an executor before it can be moved for load balancing.
+		ShardMoveCooldown time.Duration `yaml:"shardMoveCooldown"`
 	}
 )
 
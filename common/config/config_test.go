@@ -159,6 +159,32 @@ func TestInvalidMultipleDatabaseConfig_nonEmptySQLConnAddr(t *testing.T) {
 	require.EqualError(t, err, "sql persistence config: connectAddr can only be configured in multipleDatabasesConfig when UseMultipleDatabases is true")
 }
 
+func TestAdvancedVisibilityTransport(t *testing.T) {
+	cfg := getValidMultipleDatabasseConfig()
+	require.False(t, cfg.Persistence.IsPersistenceVisibilityTransport())
+
+	cfg.Persistence.AdvancedVisibilityTransport = AdvancedVisibilityTransportPersistence
+	require.NoError(t, cfg.ValidateAndFillDefaults())
+	require.True(t, cfg.Persistence.IsPersistenceVisibilityTransport())
+
+	cfg.Persistence.AdvancedVisibilityStore = ""
+	require.False(t, cfg.Persistence.IsPersistenceVisibilityTransport())
+}
+
+func TestInvalidAdvancedVisibilityTransport(t *testing.T) {
+	cfg := getValidMultipleDatabasseConfig()
+	cfg.Persistence.AdvancedVisibilityTransport = "rabbitmq"
+	err := cfg.ValidateAndFillDefaults()
+	require.EqualError(t, err, "persistence config: unknown advancedVisibilityTransport rabbitmq")
+
+	cfg = getValidMultipleDatabasseConfig()
+	cfg.Persistence.AdvancedVisibilityTransport = AdvancedVisibilityTransportPersistence
+	cfg.Persistence.AdvancedVisibilityStore = constants.PinotVisibilityStoreName
+	cfg.Persistence.DataStores[constants.PinotVisibilityStoreName] = cfg.Persistence.DataStores["esv7"]
+	err = cfg.ValidateAndFillDefaults()
+	require.EqualError(t, err, "persistence config: advancedVisibilityTransport persistence is not supported by pinot")
+}
+
 func TestConfigFallbacks(t *testing.T) {
 	metadata := validClusterGroupMetadata()
 	cfg := &Config{
@@ -33,6 +33,13 @@ const (
 	StoreTypeCassandra = "cassandra"
 )
 
+const (
+	// AdvancedVisibilityTransportKafka delivers visibility messages to the indexer through Kafka
+	AdvancedVisibilityTransportKafka = "kafka"
+	// AdvancedVisibilityTransportPersistence delivers visibility messages to the indexer through a queue in the default store
+	AdvancedVisibilityTransportPersistence = "persistence"
+)
+
 // DefaultStoreType returns the storeType for the default persistence store
 func (c *Persistence) DefaultStoreType() string {
 	if c.DataStores[c.DefaultStore].SQL != nil {
@@ -85,6 +92,16 @@ func (c *Persistence) Validate() error {
 		useAdvancedVisibilityOnly = true
 	}
 
+	switch c.AdvancedVisibilityTransport {
+	case "", AdvancedVisibilityTransportKafka:
+	case AdvancedVisibilityTransportPersistence:
+		if c.AdvancedVisibilityStore == constants.PinotVisibilityStoreName {
+			return fmt.Errorf("persistence config: advancedVisibilityTransport %v is not supported by pinot", c.AdvancedVisibilityTransport)
+		}
+	default:
+		return fmt.Errorf("persistence config: unknown advancedVisibilityTransport %v", c.AdvancedVisibilityTransport)
+	}
+
 	for _, st := range dbStoreKeys {
 		ds, ok := c.DataStores[st]
 		if !ok {
@@ -199,3 +216,8 @@ func (c *Persistence) Validate() error {
 func (c *Persistence) IsAdvancedVisibilityConfigExist() bool {
 	return len(c.AdvancedVisibilityStore) != 0
 }
+
+// IsPersistenceVisibilityTransport returns whether visibility messages are delivered through a persistence backed queue instead of Kafka
+func (c *Persistence) IsPersistenceVisibilityTransport() bool {
+	return c.IsAdvancedVisibilityConfigExist() && c.AdvancedVisibilityTransport == AdvancedVisibilityTransportPersistence
+}
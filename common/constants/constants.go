@@ -240,6 +240,10 @@ const MemoKeyForOperator = "operator"
 // ReservedTaskListPrefix is the required naming prefix for any task list partition other than partition 0
 const ReservedTaskListPrefix = "/__cadence_sys/"
 
+// ReservedVersionedTaskListPrefix is the naming prefix for task lists that hold the decision tasks
+// routed to a compatible set of worker build IDs
+const ReservedVersionedTaskListPrefix = ReservedTaskListPrefix + "__build/"
+
 type (
 	// VisibilityOperation is an enum that represents visibility message types
 	VisibilityOperation string
@@ -212,6 +212,25 @@ func (c *Collection) GetBoolPropertyFilteredByShardID(key dynamicproperties.Bool
 	}
 }
 
+// GetBoolPropertyFilteredByWorkflowType gets property with domain and workflow type filters and asserts that it's a bool
+func (c *Collection) GetBoolPropertyFilteredByWorkflowType(key dynamicproperties.BoolKey) dynamicproperties.BoolPropertyFnWithWorkflowTypeFilter {
+	return func(domainName string, workflowType string) bool {
+		filters := c.toFilterMap(
+			dynamicproperties.DomainFilter(domainName),
+			dynamicproperties.WorkflowTypeFilter(workflowType),
+		)
+		val, err := c.client.GetBoolValue(
+			key,
+			filters,
+		)
+		if err != nil {
+			c.logError(key, filters, err)
+			return key.DefaultBool()
+		}
+		return val
+	}
+}
+
 // GetFloat64Property gets property and asserts that it's a float64
 func (c *Collection) GetFloat64Property(key dynamicproperties.FloatKey) dynamicproperties.FloatPropertyFn {
 	return func(opts ...dynamicproperties.FilterOption) float64 {
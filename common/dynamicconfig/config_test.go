@@ -204,6 +204,16 @@ func (s *configSuite) TestGetBoolPropertyFilteredByTaskListInfo() {
 	s.Equal(true, value(domain, taskList, taskType))
 }
 
+func (s *configSuite) TestGetBoolPropertyFilteredByWorkflowType() {
+	key := dynamicproperties.TestGetBoolPropertyFilteredByWorkflowTypeKey
+	domain := "testDomain"
+	workflowType := "testWorkflowType"
+	value := s.cln.GetBoolPropertyFilteredByWorkflowType(key)
+	s.Equal(key.DefaultBool(), value(domain, workflowType))
+	s.client.SetValue(key, true)
+	s.Equal(true, value(domain, workflowType))
+}
+
 func (s *configSuite) TestGetBoolPropertyFilteredByShardID() {
 	key := dynamicproperties.TestGetBoolPropertyFilteredByShardIDKey
 	shardID := 1
@@ -56,6 +56,11 @@ func GetDurationPropertyFilteredByWorkflowType(value time.Duration) func(domainN
 	return func(domainName string, workflowType string) time.Duration { return value }
 }
 
+// GetBoolPropertyFilteredByWorkflowType returns values as BoolPropertyFnWithWorkflowTypeFilter
+func GetBoolPropertyFilteredByWorkflowType(value bool) func(domainName string, workflowType string) bool {
+	return func(domainName string, workflowType string) bool { return value }
+}
+
 // GetFloatPropertyFn returns value as FloatPropertyFn
 func GetFloatPropertyFn(value float64) func(opts ...FilterOption) float64 {
 	return func(...FilterOption) float64 { return value }
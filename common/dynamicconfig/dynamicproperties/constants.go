@@ -728,6 +728,34 @@ const (
 	// Default value: UnlimitedRPS
 	// Allowed filters: N/A
 	MatchingDomainWorkerRPS
+	// MatchingGlobalDomainAddTaskRPS is used to limit the rate of tasks added to matching per domain,
+	// across all of the domain's task lists and partitions, to a target RPS that is shared across the entire cluster.
+	// Tasks added over the quota are rejected with a ServiceBusyError and retried by history.
+	//
+	// Like the frontend global limits, this can be distributed in two ways, selected by MatchingGlobalRatelimiterMode
+	// with an "add:" key prefix:
+	//   1. "local", where the configured RPS is split evenly across all matching hosts in the cluster.
+	//   2. "global", where matching hosts share load information with each other, to adjust to imbalanced load.
+	//
+	// KeyName: matching.globalDomainAddTaskRPS
+	// Value type: Int
+	// Default value: UnlimitedRPS
+	// Allowed filters: DomainName
+	MatchingGlobalDomainAddTaskRPS
+	// MatchingGlobalDomainDispatchRPS is used to limit the rate of tasks dispatched to pollers per domain,
+	// across all of the domain's task lists and partitions, to a target RPS that is shared across the entire cluster.
+	// It applies in addition to the per-tasklist dispatch rate.
+	//
+	// Like the frontend global limits, this can be distributed in two ways, selected by MatchingGlobalRatelimiterMode
+	// with a "dispatch:" key prefix:
+	//   1. "local", where the configured RPS is split evenly across all matching hosts in the cluster.
+	//   2. "global", where matching hosts share load information with each other, to adjust to imbalanced load.
+	//
+	// KeyName: matching.globalDomainDispatchRPS
+	// Value type: Int
+	// Default value: UnlimitedRPS
+	// Allowed filters: DomainName
+	MatchingGlobalDomainDispatchRPS
 	// MatchingPersistenceMaxQPS is the max qps matching host can query DB
 	// KeyName: matching.persistenceMaxQPS
 	// Value type: Int
@@ -1225,6 +1253,12 @@ const (
 	// Default value: 0
 	// Allowed filters: DomainName
 	MaxActivityCountDispatchByDomain
+	// MaxEagerActivityCountByDomain max # of activities started eagerly from a single RespondDecisionTaskCompleted and returned to the worker. Activities above the limit fall back to matching.
+	// KeyName: history.maxEagerActivityCountByDomain
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: DomainName
+	MaxEagerActivityCountByDomain
 
 	// key for history replication
 
@@ -1513,6 +1547,19 @@ const (
 	// Default value: 30
 	DeleteHistoryEventContextTimeout
 
+	// MaxCompletionCallbacksPerWorkflow is the max number of completion callbacks a workflow can register on start
+	// KeyName: frontend.maxCompletionCallbacksPerWorkflow
+	// Value type: Int
+	// Default value: 5
+	// Allowed filters: DomainName
+	MaxCompletionCallbacksPerWorkflow
+	// CompletionCallbackMaxAttempts is the max number of delivery attempts for a completion callback before it is dead-lettered
+	// KeyName: worker.completionCallbackMaxAttempts
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: DomainName
+	CompletionCallbackMaxAttempts
+
 	// LastIntKey must be the last one in this const group
 	LastIntKey
 )
@@ -1527,6 +1574,7 @@ const (
 	TestGetBoolPropertyFilteredByDomainKey
 	TestGetBoolPropertyFilteredByDomainIDAndWorkflowIDKey
 	TestGetBoolPropertyFilteredByShardIDKey
+	TestGetBoolPropertyFilteredByWorkflowTypeKey
 
 	// key for common & admin
 
@@ -1636,6 +1684,20 @@ const (
 	// Default value: false
 	// Allowed filters: DomainName
 	FrontendEmitSignalNameMetricsTag
+	// FrontendEnableDescribeDomainTaskQuota enables reporting the domain-wide matching task quotas and their usage
+	// in DescribeDomain responses. This fans out to all matching hosts, so it's disabled by default
+	// KeyName: frontend.enableDescribeDomainTaskQuota
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	FrontendEnableDescribeDomainTaskQuota
+	// FrontendEnableAsyncRequestTracking enables recording the status of requests published to the async workflow
+	// queue so that they can be looked up with DescribeAsyncRequest
+	// KeyName: frontend.enableAsyncRequestTracking
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	FrontendEnableAsyncRequestTracking
 	// EnableQueryAttributeValidation enables validation of queries' search attributes against the dynamic config whitelist
 	// Keyname: frontend.enableQueryAttributeValidation
 	// Value type: Bool
@@ -1725,6 +1787,12 @@ const (
 	// Default value: false
 	// Allowed filters: N/A
 	QueueProcessorEnableGracefulSyncShutdown
+	// EnableGracefulShardHandoff indicates whether history hosts hand off shards to their new owners gracefully
+	// KeyName: history.enableGracefulShardHandoff
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableGracefulShardHandoff
 	// TransferProcessorEnableValidator is whether validator should be enabled for transferQueueProcessor
 	// KeyName: history.transferProcessorEnableValidator
 	// Value type: Bool
@@ -1788,6 +1856,19 @@ const (
 	// Default value: true
 	// Allowed filters: DomainName
 	EnableActivityLocalDispatchByDomain
+	// EnableStickyExecution is whether workers are allowed to bind a workflow to their sticky task list
+	// KeyName: history.enableStickyExecution
+	// Value type: Bool
+	// Default value: true
+	// Allowed filters: DomainName, WorkflowType
+	EnableStickyExecution
+	// StickyPreferredHostAffinity is whether a workflow stays on its sticky worker after a failed or timed out decision.
+	// Sticky schedule-to-start timeouts still fall back to the normal task list.
+	// KeyName: history.stickyPreferredHostAffinity
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName, WorkflowType
+	StickyPreferredHostAffinity
 	// HistoryEnableTaskInfoLogByDomainID is enables info level logs for decision/activity task based on the request domainID
 	// KeyName: history.enableTaskInfoLogByDomainID
 	// Value type: Bool
@@ -2102,6 +2183,43 @@ const (
 	EnableTransferQueueV2
 	EnableTimerQueueV2
 
+	// EnableCompletionCallbacks decides whether workflows in a domain can register completion callbacks and have them delivered on close
+	// KeyName: system.enableCompletionCallbacks
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableCompletionCallbacks
+	// EnableCompletionCallbackWorker decides whether or not enable system workers for delivering completion callbacks
+	// KeyName: system.enableCompletionCallbackWorker
+	// Value type: Bool
+	// Default value: true
+	// Allowed filters: N/A
+	EnableCompletionCallbackWorker
+	// EnableWorkflowCDC decides whether workflow lifecycle changes of a domain are published to the CDC stream
+	// KeyName: history.enableWorkflowCDC
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	EnableWorkflowCDC
+	// VisibilityReconcilerEnabled indicates if visibility reconciler should be started as part of worker.Scanner
+	// KeyName: worker.visibilityReconcilerEnabled
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	VisibilityReconcilerEnabled
+	// VisibilityReconcilerDomainAllow is which domains are reconciled by visibility reconciler workflow
+	// KeyName: worker.visibilityReconcilerDomainAllow
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	VisibilityReconcilerDomainAllow
+	// VisibilityReconcilerRepairDomainAllow is which domains get divergent visibility records repaired by visibility reconciler workflow
+	// KeyName: worker.visibilityReconcilerRepairDomainAllow
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	VisibilityReconcilerRepairDomainAllow
+
 	// LastBoolKey must be the last one in this const group
 	LastBoolKey
 )
@@ -2285,6 +2403,13 @@ const (
 	// Allowed filters: N/A
 	ShardDistributorErrorInjectionRate
 
+	// VisibilityReconcilerSampleRate is the ratio of executions checked by visibility reconciler, 1 means every execution is checked
+	// KeyName: worker.visibilityReconcilerSampleRate
+	// Value type: Float64
+	// Default value: 1
+	// Allowed filters: N/A
+	VisibilityReconcilerSampleRate
+
 	// LastFloatKey must be the last one in this const group
 	LastFloatKey
 )
@@ -2375,6 +2500,14 @@ const (
 	// Default value: "disabled"
 	// Allowed filters: RatelimitKey (on global key, e.g. prefixed by collection name)
 	FrontendGlobalRatelimiterMode
+	// MatchingGlobalRatelimiterMode is the matching equivalent of FrontendGlobalRatelimiterMode,
+	// and controls the mode of the domain-wide "add:" and "dispatch:" task quotas.
+	//
+	// KeyName: matching.globalRatelimiterMode
+	// Value type: string enum: "disabled", "local", "global", "local-shadow-global", or "global-shadow-local"
+	// Default value: "disabled"
+	// Allowed filters: RatelimitKey (on global key, e.g. prefixed by collection name)
+	MatchingGlobalRatelimiterMode
 
 	TasklistLoadBalancerStrategy
 
@@ -2424,6 +2557,12 @@ const (
 	// Default value: 1m (one minute, see domain.FailoverCoolDown)
 	// Allowed filters: DomainName
 	FrontendFailoverCoolDown
+	// FrontendAsyncRequestRetention is how long the status of a tracked async request is kept after it was queued
+	// KeyName: frontend.asyncRequestRetention
+	// Value type: Duration
+	// Default value: 7 days
+	// Allowed filters: DomainName
+	FrontendAsyncRequestRetention
 	// DomainFailoverRefreshInterval is the domain failover refresh timer
 	// KeyName: frontend.domainFailoverRefreshInterval
 	// Value type: Duration
@@ -2478,6 +2617,18 @@ const (
 	MatchingPartitionDownscaleSustainedDuration
 	MatchingAdaptiveScalerUpdateInterval
 	MatchingQPSTrackerInterval
+	// MatchingScalingRecommendationScheduleToStartTarget is the schedule to start latency under which a task list is considered to keep up with its tasks
+	// KeyName: matching.scalingRecommendationScheduleToStartTarget
+	// Value type: Duration
+	// Default value: 1s
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingScalingRecommendationScheduleToStartTarget
+	// MatchingScalingRecommendationBacklogDrainDuration is the time in which a recommended number of pollers should drain the task list backlog
+	// KeyName: matching.scalingRecommendationBacklogDrainDuration
+	// Value type: Duration
+	// Default value: 1m
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingScalingRecommendationBacklogDrainDuration
 
 	// MatchingIsolationGroupUpscaleSustainedDuration is the sustained period to wait before upscaling the number of partitions an isolation group is assigned to
 	// KeyName: matching.isolationGroupUpscaleSustainedDuration
@@ -2534,6 +2685,18 @@ const (
 	// Default value: 1m (time.Minute)
 	// Allowed filters: N/A
 	AcquireShardInterval
+	// ShardHandoffDrainTimeout is the max time a history host waits for in-flight writes before handing off a shard
+	// KeyName: history.shardHandoffDrainTimeout
+	// Value type: Duration
+	// Default value: 5s (5*time.Second)
+	// Allowed filters: N/A
+	ShardHandoffDrainTimeout
+	// ShardHandoffWaitTimeout is the max time a history host waits for the previous owner to hand off a shard before stealing it
+	// KeyName: history.shardHandoffWaitTimeout
+	// Value type: Duration
+	// Default value: 5s (5*time.Second)
+	// Allowed filters: N/A
+	ShardHandoffWaitTimeout
 	// StandbyClusterDelay is the artificial delay added to standby cluster's view of active cluster's time
 	// KeyName: history.standbyClusterDelay
 	// Value type: Duration
@@ -2703,6 +2866,12 @@ const (
 	// Default value: time.Hour*24*365
 	// Allowed filters: DomainName
 	StickyTTL
+	// StickyMaxScheduleToStartTimeout caps the sticky schedule-to-start timeout requested by workers. Once it elapses the decision falls back to the normal task list. 0 means no cap
+	// KeyName: history.stickyMaxScheduleToStartTimeout
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName, WorkflowType
+	StickyMaxScheduleToStartTimeout
 	// DecisionHeartbeatTimeout is for decision heartbeat
 	// KeyName: history.decisionHeartbeatTimeout
 	// Value type: Duration
@@ -2877,6 +3046,13 @@ const (
 	// Allowed filters: domainName, taskListName, taskListType
 	TaskIsolationPollerWindow
 
+	// VisibilityReconcilerClosedLookback is how far back closed executions are checked by visibility reconciler
+	// KeyName: worker.visibilityReconcilerClosedLookback
+	// Value type: Duration
+	// Default value: 24h
+	// Allowed filters: N/A
+	VisibilityReconcilerClosedLookback
+
 	// LastDurationKey must be the last one in this const group
 	LastDurationKey
 )
@@ -2941,6 +3117,22 @@ const (
 	// Allowed filters: N/A
 	SearchAttributesHiddenValueKeys
 
+	// CompletionCallbackAllowedHosts is the allow-list of hosts completion callbacks may target. Keys are host names,
+	// optionally with a leading "*." to match any subdomain; values must be true.
+	// KeyName: system.completionCallbackAllowedHosts
+	// Value type: Map
+	// Default value: empty map (no destination is allowed)
+	// Allowed filters: DomainName
+	CompletionCallbackAllowedHosts
+	// WorkflowCDCEventTypes selects what is published to the CDC stream for a domain. Keys are CDC message types
+	// (WorkflowStarted, WorkflowClosed, SearchAttributesUpserted) or history event types (e.g. ActivityTaskFailed);
+	// values must be true. When empty, all workflow lifecycle messages and no history events are published.
+	// KeyName: history.workflowCDCEventTypes
+	// Value type: Map
+	// Default value: empty map
+	// Allowed filters: DomainName
+	WorkflowCDCEventTypes
+
 	// LastMapKey must be the last one in this const group
 	LastMapKey
 )
@@ -3316,6 +3508,18 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "MatchingDomainWorkerRPS is background-processing request rate per domain per second for each matching host",
 		DefaultValue: UnlimitedRPS,
 	},
+	MatchingGlobalDomainAddTaskRPS: {
+		KeyName:      "matching.globalDomainAddTaskRPS",
+		Filters:      []Filter{DomainName},
+		Description:  "MatchingGlobalDomainAddTaskRPS is the rate limit of tasks added per domain for the whole Cadence cluster",
+		DefaultValue: UnlimitedRPS,
+	},
+	MatchingGlobalDomainDispatchRPS: {
+		KeyName:      "matching.globalDomainDispatchRPS",
+		Filters:      []Filter{DomainName},
+		Description:  "MatchingGlobalDomainDispatchRPS is the rate limit of tasks dispatched per domain for the whole Cadence cluster",
+		DefaultValue: UnlimitedRPS,
+	},
 	MatchingPersistenceMaxQPS: {
 		KeyName:      "matching.persistenceMaxQPS",
 		Description:  "MatchingPersistenceMaxQPS is the max qps matching host can query DB",
@@ -3773,6 +3977,12 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "MaxActivityCountDispatchByDomain max # of activity tasks to dispatch to matching before creating transfer tasks. This is an performance optimization to skip activity scheduling efforts.",
 		DefaultValue: 0,
 	},
+	MaxEagerActivityCountByDomain: {
+		KeyName:      "history.maxEagerActivityCountByDomain",
+		Filters:      []Filter{DomainName},
+		Description:  "MaxEagerActivityCountByDomain max # of activities started eagerly from a single RespondDecisionTaskCompleted and returned to the worker. Activities above the limit fall back to matching.",
+		DefaultValue: 100,
+	},
 	ReplicationTaskFetcherParallelism: {
 		KeyName:      "history.ReplicationTaskFetcherParallelism",
 		Description:  "ReplicationTaskFetcherParallelism determines how many go routines we spin up for fetching tasks",
@@ -4011,6 +4221,18 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "This is the number of seconds allowed for a deleteHistoryEvent task to the database",
 		DefaultValue: 30,
 	},
+	MaxCompletionCallbacksPerWorkflow: {
+		KeyName:      "frontend.maxCompletionCallbacksPerWorkflow",
+		Filters:      []Filter{DomainName},
+		Description:  "MaxCompletionCallbacksPerWorkflow is the max number of completion callbacks a workflow can register on start",
+		DefaultValue: 5,
+	},
+	CompletionCallbackMaxAttempts: {
+		KeyName:      "worker.completionCallbackMaxAttempts",
+		Filters:      []Filter{DomainName},
+		Description:  "CompletionCallbackMaxAttempts is the max number of delivery attempts for a completion callback before it is dead-lettered",
+		DefaultValue: 10,
+	},
 }
 
 var BoolKeys = map[BoolKey]DynamicBool{
@@ -4047,6 +4269,12 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		DefaultValue: false,
 		Filters:      []Filter{ShardID},
 	},
+	TestGetBoolPropertyFilteredByWorkflowTypeKey: {
+		KeyName:      "testGetBoolPropertyFilteredByWorkflowTypeKey",
+		Description:  "",
+		DefaultValue: false,
+		Filters:      nil,
+	},
 	EnableVisibilitySampling: {
 		KeyName:      "system.enableVisibilitySampling",
 		Description:  "EnableVisibilitySampling is key for enable visibility sampling for basic(DB based) visibility",
@@ -4144,6 +4372,18 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "FrontendEmitSignalNameMetricsTag enables emitting signal name tag in metrics in frontend client",
 		DefaultValue: false,
 	},
+	FrontendEnableDescribeDomainTaskQuota: {
+		KeyName:      "frontend.enableDescribeDomainTaskQuota",
+		Filters:      []Filter{DomainName},
+		Description:  "FrontendEnableDescribeDomainTaskQuota enables reporting the domain-wide matching task quotas and their usage in DescribeDomain responses",
+		DefaultValue: false,
+	},
+	FrontendEnableAsyncRequestTracking: {
+		KeyName:      "frontend.enableAsyncRequestTracking",
+		Filters:      []Filter{DomainName},
+		Description:  "FrontendEnableAsyncRequestTracking enables recording the status of requests published to the async workflow queue",
+		DefaultValue: false,
+	},
 	EnableQueryAttributeValidation: {
 		KeyName:      "frontend.enableQueryAttributeValidation",
 		Description:  "EnableQueryAttributeValidation enables validation of queries' search attributes against the dynamic config whitelist",
@@ -4233,6 +4473,11 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "QueueProcessorEnableGracefulSyncShutdown indicates whether processing queue should be shutdown gracefully & synchronously",
 		DefaultValue: false,
 	},
+	EnableGracefulShardHandoff: {
+		KeyName:      "history.enableGracefulShardHandoff",
+		Description:  "EnableGracefulShardHandoff indicates whether history hosts hand off shards to their new owners gracefully",
+		DefaultValue: false,
+	},
 	TransferProcessorEnableValidator: {
 		KeyName:      "history.transferProcessorEnableValidator",
 		Description:  "TransferProcessorEnableValidator is whether validator should be enabled for transferQueueProcessor",
@@ -4305,6 +4550,18 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "EnableActivityLocalDispatchByDomain is allows worker to dispatch activity tasks through local tunnel after decisions are made. This is an performance optimization to skip activity scheduling efforts",
 		DefaultValue: true,
 	},
+	EnableStickyExecution: {
+		KeyName:      "history.enableStickyExecution",
+		Filters:      []Filter{DomainName, WorkflowType},
+		Description:  "EnableStickyExecution is whether workers are allowed to bind a workflow to their sticky task list",
+		DefaultValue: true,
+	},
+	StickyPreferredHostAffinity: {
+		KeyName:      "history.stickyPreferredHostAffinity",
+		Filters:      []Filter{DomainName, WorkflowType},
+		Description:  "StickyPreferredHostAffinity is whether a workflow stays on its sticky worker after a failed or timed out decision. Sticky schedule-to-start timeouts still fall back to the normal task list.",
+		DefaultValue: false,
+	},
 	HistoryEnableTaskInfoLogByDomainID: {
 		KeyName:      "history.enableTaskInfoLogByDomainID",
 		Filters:      []Filter{DomainID},
@@ -4604,6 +4861,40 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Filters:      []Filter{ShardID},
 		DefaultValue: false,
 	},
+	EnableCompletionCallbacks: {
+		KeyName:      "system.enableCompletionCallbacks",
+		Filters:      []Filter{DomainName},
+		Description:  "EnableCompletionCallbacks decides whether workflows in a domain can register completion callbacks and have them delivered on close",
+		DefaultValue: false,
+	},
+	EnableCompletionCallbackWorker: {
+		KeyName:      "system.enableCompletionCallbackWorker",
+		Description:  "EnableCompletionCallbackWorker decides whether or not enable system workers for delivering completion callbacks",
+		DefaultValue: true,
+	},
+	EnableWorkflowCDC: {
+		KeyName:      "history.enableWorkflowCDC",
+		Filters:      []Filter{DomainName},
+		Description:  "EnableWorkflowCDC decides whether workflow lifecycle changes of a domain are published to the CDC stream",
+		DefaultValue: false,
+	},
+	VisibilityReconcilerEnabled: {
+		KeyName:      "worker.visibilityReconcilerEnabled",
+		Description:  "VisibilityReconcilerEnabled indicates if visibility reconciler should be started as part of worker.Scanner",
+		DefaultValue: false,
+	},
+	VisibilityReconcilerDomainAllow: {
+		KeyName:      "worker.visibilityReconcilerDomainAllow",
+		Filters:      []Filter{DomainName},
+		Description:  "VisibilityReconcilerDomainAllow is which domains are reconciled by visibility reconciler workflow",
+		DefaultValue: false,
+	},
+	VisibilityReconcilerRepairDomainAllow: {
+		KeyName:      "worker.visibilityReconcilerRepairDomainAllow",
+		Filters:      []Filter{DomainName},
+		Description:  "VisibilityReconcilerRepairDomainAllow is which domains get divergent visibility records repaired by visibility reconciler workflow",
+		DefaultValue: false,
+	},
 }
 
 var FloatKeys = map[FloatKey]DynamicFloat{
@@ -4761,6 +5052,11 @@ var FloatKeys = map[FloatKey]DynamicFloat{
 		Description:  "ShardDistributorInjectionRate is rate for injecting random error in shard distributor client",
 		DefaultValue: 0,
 	},
+	VisibilityReconcilerSampleRate: {
+		KeyName:      "worker.visibilityReconcilerSampleRate",
+		Description:  "VisibilityReconcilerSampleRate is the ratio of executions checked by visibility reconciler, 1 means every execution is checked",
+		DefaultValue: 1.0,
+	},
 }
 
 var StringKeys = map[StringKey]DynamicString{
@@ -4826,6 +5122,12 @@ var StringKeys = map[StringKey]DynamicString{
 		DefaultValue: "disabled",
 		Filters:      []Filter{RatelimitKey},
 	},
+	MatchingGlobalRatelimiterMode: {
+		KeyName:      "matching.globalRatelimiterMode",
+		Description:  "MatchingGlobalRatelimiterMode defines which mode a matching domain task quota key should be in, per key, to make gradual changes to ratelimiter algorithms",
+		DefaultValue: "disabled",
+		Filters:      []Filter{RatelimitKey},
+	},
 	TasklistLoadBalancerStrategy: {
 		KeyName:      "system.tasklistLoadBalancerStrategy",
 		Description:  "TasklistLoadBalancerStrategy is the key for tasklist load balancer strategy",
@@ -4895,6 +5197,12 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "FrontendFailoverCoolDown is duration between two domain failvoers",
 		DefaultValue: time.Minute,
 	},
+	FrontendAsyncRequestRetention: {
+		KeyName:      "frontend.asyncRequestRetention",
+		Filters:      []Filter{DomainName},
+		Description:  "FrontendAsyncRequestRetention is how long the status of a tracked async request is kept after it was queued",
+		DefaultValue: time.Hour * 24 * 7,
+	},
 	DomainFailoverRefreshInterval: {
 		KeyName:      "frontend.domainFailoverRefreshInterval",
 		Description:  "DomainFailoverRefreshInterval is the domain failover refresh timer",
@@ -4988,6 +5296,18 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "MatchingQPSTrackerInterval is the interval for qps tracker's loop. Changes are not reflected until service restart",
 		DefaultValue: time.Second * 10,
 	},
+	MatchingScalingRecommendationScheduleToStartTarget: {
+		KeyName:      "matching.scalingRecommendationScheduleToStartTarget",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingScalingRecommendationScheduleToStartTarget is the schedule to start latency under which a task list is considered to keep up with its tasks",
+		DefaultValue: time.Second,
+	},
+	MatchingScalingRecommendationBacklogDrainDuration: {
+		KeyName:      "matching.scalingRecommendationBacklogDrainDuration",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingScalingRecommendationBacklogDrainDuration is the time in which a recommended number of pollers should drain the task list backlog",
+		DefaultValue: time.Minute,
+	},
 	HistoryLongPollExpirationInterval: {
 		KeyName:      "history.longPollExpirationInterval",
 		Filters:      []Filter{DomainName},
@@ -5014,6 +5334,16 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "AcquireShardInterval is interval that timer used to acquire shard",
 		DefaultValue: time.Minute,
 	},
+	ShardHandoffDrainTimeout: {
+		KeyName:      "history.shardHandoffDrainTimeout",
+		Description:  "ShardHandoffDrainTimeout is the max time a history host waits for in-flight writes before handing off a shard",
+		DefaultValue: time.Second * 5,
+	},
+	ShardHandoffWaitTimeout: {
+		KeyName:      "history.shardHandoffWaitTimeout",
+		Description:  "ShardHandoffWaitTimeout is the max time a history host waits for the previous owner to hand off a shard before stealing it",
+		DefaultValue: time.Second * 5,
+	},
 	StandbyClusterDelay: {
 		KeyName:      "history.standbyClusterDelay",
 		Description:  "StandbyClusterDelay is the artificial delay added to standby cluster's view of active cluster's time",
@@ -5153,6 +5483,12 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "StickyTTL is to expire a sticky tasklist if no update more than this duration",
 		DefaultValue: time.Hour * 24 * 365,
 	},
+	StickyMaxScheduleToStartTimeout: {
+		KeyName:      "history.stickyMaxScheduleToStartTimeout",
+		Filters:      []Filter{DomainName, WorkflowType},
+		Description:  "StickyMaxScheduleToStartTimeout caps the sticky schedule-to-start timeout requested by workers. Once it elapses the decision falls back to the normal task list. 0 means no cap",
+		DefaultValue: 0,
+	},
 	DecisionHeartbeatTimeout: {
 		KeyName:      "history.decisionHeartbeatTimeout",
 		Filters:      []Filter{DomainName},
@@ -5313,6 +5649,11 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "TaskIsolationDuration is the time period for which we attempt to respect tasklist isolation before allowing any poller to process the task",
 		DefaultValue: time.Second * 2,
 	},
+	VisibilityReconcilerClosedLookback: {
+		KeyName:      "worker.visibilityReconcilerClosedLookback",
+		Description:  "VisibilityReconcilerClosedLookback is how far back closed executions are checked by visibility reconciler",
+		DefaultValue: time.Hour * 24,
+	},
 }
 
 var MapKeys = map[MapKey]DynamicMap{
@@ -5362,6 +5703,18 @@ var MapKeys = map[MapKey]DynamicMap{
 		Description:  "SearchAttributesHiddenValueKeys is the list of search attributes that values should be hidden",
 		DefaultValue: map[string]interface{}{},
 	},
+	CompletionCallbackAllowedHosts: {
+		KeyName:      "system.completionCallbackAllowedHosts",
+		Filters:      []Filter{DomainName},
+		Description:  "CompletionCallbackAllowedHosts is the allow-list of hosts completion callbacks may target. Keys are host names, optionally with a leading \"*.\" to match any subdomain",
+		DefaultValue: map[string]interface{}{},
+	},
+	WorkflowCDCEventTypes: {
+		KeyName:      "history.workflowCDCEventTypes",
+		Filters:      []Filter{DomainName},
+		Description:  "WorkflowCDCEventTypes selects the CDC message types and history event types published to the CDC stream for a domain. When empty, all workflow lifecycle messages and no history events are published",
+		DefaultValue: map[string]interface{}{},
+	},
 }
 
 var ListKeys = map[ListKey]DynamicList{
@@ -107,6 +107,9 @@ type IntPropertyFnWithWorkflowTypeFilter func(domainName string, workflowType st
 // DurationPropertyFnWithWorkflowTypeFilter is a wrapper to get duration property from dynamic config with domain as filter
 type DurationPropertyFnWithWorkflowTypeFilter func(domainName string, workflowType string) time.Duration
 
+// BoolPropertyFnWithWorkflowTypeFilter is a wrapper to get bool property from dynamic config with domain and workflow type as filters
+type BoolPropertyFnWithWorkflowTypeFilter func(domainName string, workflowType string) bool
+
 // ListPropertyFn is a wrapper to get a list property from dynamic config
 type ListPropertyFn func(opts ...FilterOption) []interface{}
 
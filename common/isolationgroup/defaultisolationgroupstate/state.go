@@ -26,9 +26,11 @@ import (
 	"context"
 	"fmt"
 	"sync/atomic"
+	"time"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/isolationgroup"
@@ -46,6 +48,7 @@ type defaultIsolationGroupStateHandler struct {
 	globalIsolationGroupDrains dynamicconfig.Client
 	config                     defaultConfig
 	metricsClient              metrics.Client
+	timeSource                 clock.TimeSource
 }
 
 // NewDefaultIsolationGroupStateWatcherWithConfigStoreClient Is a constructor which allows passing in the dynamic config client
@@ -72,6 +75,7 @@ func NewDefaultIsolationGroupStateWatcherWithConfigStoreClient(
 		log:                        logger,
 		config:                     config,
 		metricsClient:              metricsClient,
+		timeSource:                 clock.NewRealTimeSource(),
 	}, nil
 }
 
@@ -80,7 +84,7 @@ func (z *defaultIsolationGroupStateHandler) IsDrained(ctx context.Context, domai
 	if err != nil {
 		return false, fmt.Errorf("could not determine if drained: %w", err)
 	}
-	return isDrained(isolationGroup, state.Global, state.Domain), nil
+	return isDrained(isolationGroup, state.Global, state.Domain, z.timeSource.Now()), nil
 }
 
 func (z *defaultIsolationGroupStateHandler) IsDrainedByDomainID(ctx context.Context, domainID string, isolationGroup string) (bool, error) {
@@ -91,6 +95,22 @@ func (z *defaultIsolationGroupStateHandler) IsDrainedByDomainID(ctx context.Cont
 	return z.IsDrained(ctx, domain.GetInfo().Name, isolationGroup)
 }
 
+func (z *defaultIsolationGroupStateHandler) TrafficWeight(ctx context.Context, domain string, isolationGroup string) (int32, error) {
+	state, err := z.get(ctx, domain)
+	if err != nil {
+		return 0, fmt.Errorf("could not determine traffic weight: %w", err)
+	}
+	return trafficWeight(isolationGroup, state.Global, state.Domain, z.timeSource.Now()), nil
+}
+
+func (z *defaultIsolationGroupStateHandler) TrafficWeightByDomainID(ctx context.Context, domainID string, isolationGroup string) (int32, error) {
+	domain, err := z.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return 0, fmt.Errorf("could not determine traffic weight: %w", err)
+	}
+	return z.TrafficWeight(ctx, domain.GetInfo().Name, isolationGroup)
+}
+
 // Start the state handler
 func (z *defaultIsolationGroupStateHandler) Start() {
 	if !atomic.CompareAndSwapInt32(&z.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
@@ -145,18 +165,19 @@ func (z *defaultIsolationGroupStateHandler) get(ctx context.Context, domain stri
 	return ig, nil
 }
 
-func isDrained(isolationGroup string, global types.IsolationGroupConfiguration, domain types.IsolationGroupConfiguration) bool {
-	globalCfg, hasGlobalConfig := global[isolationGroup]
-	domainCfg, hasDomainConfig := domain[isolationGroup]
-	if hasGlobalConfig {
-		if globalCfg.State == types.IsolationGroupStateDrained {
-			return true
-		}
+func isDrained(isolationGroup string, global types.IsolationGroupConfiguration, domain types.IsolationGroupConfiguration, now time.Time) bool {
+	return trafficWeight(isolationGroup, global, domain, now) == 0
+}
+
+// trafficWeight takes the lower of the global and domain weights at the given time, so that
+// either config is able to shift traffic away from an isolationGroup, including through a ramp
+func trafficWeight(isolationGroup string, global types.IsolationGroupConfiguration, domain types.IsolationGroupConfiguration, now time.Time) int32 {
+	weight := types.IsolationGroupMaxWeight
+	if globalCfg, hasGlobalConfig := global[isolationGroup]; hasGlobalConfig {
+		weight = min(weight, globalCfg.TrafficWeight(now))
 	}
-	if hasDomainConfig {
-		if domainCfg.State == types.IsolationGroupStateDrained {
-			return true
-		}
+	if domainCfg, hasDomainConfig := domain[isolationGroup]; hasDomainConfig {
+		weight = min(weight, domainCfg.TrafficWeight(now))
 	}
-	return false
+	return weight
 }
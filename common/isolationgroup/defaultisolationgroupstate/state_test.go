@@ -27,11 +27,13 @@ import (
 	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/isolationgroup/isolationgroupapi"
@@ -133,6 +135,7 @@ func TestIsDrainedHandler(t *testing.T) {
 				log:                        testlogger.New(t),
 				globalIsolationGroupDrains: dcMock,
 				domainCache:                domaincacheMock,
+				timeSource:                 clock.NewMockedTimeSource(),
 				config:                     td.cfg,
 			}
 			res, err := handler.IsDrainedByDomainID(context.Background(), "domain-id", td.requestIsolationgroup)
@@ -205,7 +208,144 @@ func TestIsDrained(t *testing.T) {
 
 	for name, td := range tests {
 		t.Run(name, func(t *testing.T) {
-			assert.Equal(t, td.expected, isDrained(td.isolationGroup, td.globalIGCfg, td.domainIGCfg))
+			assert.Equal(t, td.expected, isDrained(td.isolationGroup, td.globalIGCfg, td.domainIGCfg, time.Now()))
+		})
+	}
+}
+
+func TestTrafficWeightHandler(t *testing.T) {
+
+	globalInput := types.IsolationGroupConfiguration{
+		"zone-1": types.IsolationGroupPartition{
+			Name:   "zone-1",
+			State:  types.IsolationGroupStateWeighted,
+			Weight: 60,
+		},
+	}
+
+	globalCfg, _ := isolationgroupapi.MapUpdateGlobalIsolationGroupsRequest(globalInput)
+	dynamicConfigResponse := []interface{}{}
+	json.Unmarshal(globalCfg[0].Value.GetData(), &dynamicConfigResponse)
+
+	tests := map[string]struct {
+		requestIsolationgroup string
+		domainIGCfg           types.IsolationGroupConfiguration
+		expected              int32
+	}{
+		"global weight applies": {
+			requestIsolationgroup: "zone-1",
+			expected:              60,
+		},
+		"lower domain weight takes precedence": {
+			requestIsolationgroup: "zone-1",
+			domainIGCfg: types.IsolationGroupConfiguration{
+				"zone-1": {Name: "zone-1", State: types.IsolationGroupStateWeighted, Weight: 20},
+			},
+			expected: 20,
+		},
+		"unconfigured isolation group keeps all traffic": {
+			requestIsolationgroup: "zone-2",
+			expected:              100,
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockCtl := gomock.NewController(t)
+			dcMock := dynamicconfig.NewMockClient(mockCtl)
+			domaincacheMock := cache.NewMockDomainCache(mockCtl)
+			dcMock.EXPECT().GetListValue(dynamicproperties.DefaultIsolationGroupConfigStoreManagerGlobalMapping, gomock.Any()).Return(dynamicConfigResponse, nil)
+			domainResponse := cache.NewDomainCacheEntryForTest(&persistence.DomainInfo{ID: "domain-id", Name: "domain"}, &persistence.DomainConfig{IsolationGroups: td.domainIGCfg}, true, nil, 0, nil, 0, 0, 0)
+			domaincacheMock.EXPECT().GetDomainByID("domain-id").Return(domainResponse, nil)
+			domaincacheMock.EXPECT().GetDomain("domain").Return(domainResponse, nil)
+			handler := defaultIsolationGroupStateHandler{
+				log:                        testlogger.New(t),
+				globalIsolationGroupDrains: dcMock,
+				domainCache:                domaincacheMock,
+				timeSource:                 clock.NewMockedTimeSource(),
+				config: defaultConfig{
+					IsolationGroupEnabled: func(string) bool { return true },
+					AllIsolationGroups:    func() []string { return []string{"zone-1", "zone-2"} },
+				},
+			}
+			res, err := handler.TrafficWeightByDomainID(context.Background(), "domain-id", td.requestIsolationgroup)
+			assert.NoError(t, err)
+			assert.Equal(t, td.expected, res)
+		})
+	}
+}
+
+func TestTrafficWeight(t *testing.T) {
+	igA := "isolationGroupA"
+
+	weighted := func(w int32) types.IsolationGroupConfiguration {
+		return types.IsolationGroupConfiguration{
+			igA: {Name: igA, State: types.IsolationGroupStateWeighted, Weight: w},
+		}
+	}
+	drained := types.IsolationGroupConfiguration{
+		igA: {Name: igA, State: types.IsolationGroupStateDrained},
+	}
+	now := time.Unix(1000, 0)
+	ramping := func(start time.Time) types.IsolationGroupConfiguration {
+		return types.IsolationGroupConfiguration{
+			igA: {Name: igA, State: types.IsolationGroupStateWeighted, Ramp: &types.IsolationGroupWeightRamp{
+				Weights:         []int32{50, 0},
+				StartTimestamp:  start.UnixNano(),
+				IntervalSeconds: 60,
+			}},
+		}
+	}
+
+	tests := map[string]struct {
+		globalIGCfg types.IsolationGroupConfiguration
+		domainIGCfg types.IsolationGroupConfiguration
+		expected    int32
+		drained     bool
+	}{
+		"no config": {
+			expected: 100,
+		},
+		"global weight only": {
+			globalIGCfg: weighted(70),
+			expected:    70,
+		},
+		"domain weight only": {
+			domainIGCfg: weighted(30),
+			expected:    30,
+		},
+		"lowest weight wins": {
+			globalIGCfg: weighted(30),
+			domainIGCfg: weighted(70),
+			expected:    30,
+		},
+		"drain overrides weight": {
+			globalIGCfg: weighted(70),
+			domainIGCfg: drained,
+			expected:    0,
+			drained:     true,
+		},
+		"a zero weight is a drain": {
+			globalIGCfg: weighted(0),
+			expected:    0,
+			drained:     true,
+		},
+		"ramp in progress": {
+			globalIGCfg: ramping(now.Add(-30 * time.Second)),
+			domainIGCfg: weighted(70),
+			expected:    50,
+		},
+		"completed ramp to zero is a drain": {
+			domainIGCfg: ramping(now.Add(-time.Hour)),
+			expected:    0,
+			drained:     true,
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, td.expected, trafficWeight(igA, td.globalIGCfg, td.domainIGCfg, now))
+			assert.Equal(t, td.drained, isDrained(igA, td.globalIGCfg, td.domainIGCfg, now))
 		})
 	}
 }
@@ -222,10 +362,16 @@ func TestIsolationGroupStateMapping(t *testing.T) {
 		State: types.IsolationGroupStateDrained,
 	}
 
+	z3 := types.IsolationGroupPartition{
+		Name:   "zone-3",
+		State:  types.IsolationGroupStateWeighted,
+		Weight: 20,
+	}
+
 	// JSON serialization inside the dynamic config library makes a mess of things because
 	// it doesn't have any type information. So mimicing this type information loss to ensure
 	// any field name types or similar serialization quirks are picked up by the test
-	zMarshalled, _ := json.Marshal([]types.IsolationGroupPartition{z1, z2})
+	zMarshalled, _ := json.Marshal([]types.IsolationGroupPartition{z1, z2, z3})
 	var rawIsolationGroupDataMarshalled []interface{}
 	json.Unmarshal(zMarshalled, &rawIsolationGroupDataMarshalled)
 
@@ -245,6 +391,11 @@ func TestIsolationGroupStateMapping(t *testing.T) {
 					Name:  "zone-2",
 					State: types.IsolationGroupStateDrained,
 				},
+				"zone-3": {
+					Name:   "zone-3",
+					State:  types.IsolationGroupStateWeighted,
+					Weight: 20,
+				},
 			},
 		},
 		"empty mapping": {
@@ -259,6 +410,10 @@ func TestIsolationGroupStateMapping(t *testing.T) {
 			in:          []interface{}{`{"Name": "some zone", "State": "not the right type"}`},
 			expectedErr: errors.New(`failed parse a dynamic config entry, map[], (got {"Name": "some zone", "State": "not the right type"})`),
 		},
+		"invalid weight": {
+			in:          []interface{}{map[string]interface{}{"Name": "some zone", "State": float64(3), "Weight": "50"}},
+			expectedErr: errors.New(`failed parse a dynamic config entry, map[Name:some zone State:3 Weight:50], (got map[Name:some zone State:3 Weight:50])`),
+		},
 	}
 
 	for name, td := range tests {
@@ -287,12 +442,17 @@ func TestUpdateRequest(t *testing.T) {
 					Name:  "zone-2",
 					State: types.IsolationGroupStateDrained,
 				},
+				"zone-3": {
+					Name:   "zone-3",
+					State:  types.IsolationGroupStateWeighted,
+					Weight: 40,
+				},
 			},
 			expected: []*types.DynamicConfigValue{
 				{
 					Value: &types.DataBlob{
 						EncodingType: types.EncodingTypeJSON.Ptr(),
-						Data:         []byte(`[{"Name":"zone-1","State":1},{"Name":"zone-2","State":2}]`),
+						Data:         []byte(`[{"Name":"zone-1","State":1},{"Name":"zone-2","State":2},{"Name":"zone-3","State":3,"Weight":40}]`),
 					},
 					Filters: nil,
 				},
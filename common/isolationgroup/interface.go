@@ -35,8 +35,14 @@ import (
 // depending on the implementation.
 type State interface {
 	common.Daemon
-	// IsDrained answers the question - "is this particular isolationGroup drained?". Used by startWorkflow calls
-	// and similar sync frontend calls to make routing decisions
+	// IsDrained answers the question - "is this particular isolationGroup drained?". Used by poll calls
+	// to hold pollers of drained isolationGroups
 	IsDrained(ctx context.Context, Domain string, IsolationGroup string) (bool, error)
 	IsDrainedByDomainID(ctx context.Context, DomainID string, IsolationGroup string) (bool, error)
+	// TrafficWeight returns the percentage (0-100) of traffic which should be kept in the isolationGroup,
+	// taking the lower of the global and domain weights. Used to gradually shift traffic out of (or back into)
+	// an isolationGroup, where a weight of 0 is equivalent to a drain. Used by startWorkflow calls and similar
+	// sync frontend calls, and by matching, to make routing decisions
+	TrafficWeight(ctx context.Context, Domain string, IsolationGroup string) (int32, error)
+	TrafficWeightByDomainID(ctx context.Context, DomainID string, IsolationGroup string) (int32, error)
 }
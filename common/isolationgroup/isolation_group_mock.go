@@ -93,3 +93,33 @@ func (mr *MockStateMockRecorder) Stop() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockState)(nil).Stop))
 }
+
+// TrafficWeight mocks base method.
+func (m *MockState) TrafficWeight(ctx context.Context, Domain, IsolationGroup string) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrafficWeight", ctx, Domain, IsolationGroup)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TrafficWeight indicates an expected call of TrafficWeight.
+func (mr *MockStateMockRecorder) TrafficWeight(ctx, Domain, IsolationGroup any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrafficWeight", reflect.TypeOf((*MockState)(nil).TrafficWeight), ctx, Domain, IsolationGroup)
+}
+
+// TrafficWeightByDomainID mocks base method.
+func (m *MockState) TrafficWeightByDomainID(ctx context.Context, DomainID, IsolationGroup string) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrafficWeightByDomainID", ctx, DomainID, IsolationGroup)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TrafficWeightByDomainID indicates an expected call of TrafficWeightByDomainID.
+func (mr *MockStateMockRecorder) TrafficWeightByDomainID(ctx, DomainID, IsolationGroup any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrafficWeightByDomainID", reflect.TypeOf((*MockState)(nil).TrafficWeightByDomainID), ctx, DomainID, IsolationGroup)
+}
@@ -46,6 +46,9 @@ func (z *handlerImpl) GetDomainState(ctx context.Context, request types.GetDomai
 // UpdateDomainState is the read operation for updating a domain's isolation-groups
 // todo (david.porter) delete this handler and use domain-handler directly
 func (z *handlerImpl) UpdateDomainState(ctx context.Context, request types.UpdateDomainIsolationGroupsRequest) error {
+	if err := validateIsolationGroups(request.IsolationGroups); err != nil {
+		return err
+	}
 	err := z.domainHandler.UpdateIsolationGroups(ctx, request)
 	return err
 }
@@ -81,6 +81,17 @@ func TestUpdateDomainState(t *testing.T) {
 				}).Return(nil)
 			},
 		},
+		"invalid state": {
+			in: types.UpdateDomainIsolationGroupsRequest{
+				Domain: "domain",
+				IsolationGroups: types.IsolationGroupConfiguration{
+					"zone-1": {Name: "zone-1", State: types.IsolationGroupStateInvalid},
+				}},
+			domainHandlerAffordance: func(h *domain.MockHandler) {},
+			expectedErr: &types.BadRequestError{
+				Message: `invalid state for isolation group "zone-1", weighted isolation groups are only supported over thrift`,
+			},
+		},
 		"empty value - ie removing isolation groups": {
 			in: types.UpdateDomainIsolationGroupsRequest{
 				Domain:          "domain",
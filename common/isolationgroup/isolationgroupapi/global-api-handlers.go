@@ -35,6 +35,9 @@ func (z *handlerImpl) UpdateGlobalState(ctx context.Context, in types.UpdateGlob
 	if z.globalIsolationGroupDrains == nil {
 		return &types.BadRequestError{"global isolation group drain is not supported in this cluster"}
 	}
+	if err := validateIsolationGroups(in.IsolationGroups); err != nil {
+		return err
+	}
 	mappedInput, err := MapUpdateGlobalIsolationGroupsRequest(in.IsolationGroups)
 	if err != nil {
 		return err
@@ -56,6 +56,16 @@ func TestUpdateGlobalState(t *testing.T) {
 				)
 			},
 		},
+		"invalid state": {
+			in: types.UpdateGlobalIsolationGroupsRequest{IsolationGroups: types.IsolationGroupConfiguration{
+				"zone-1": {Name: "zone-1", State: types.IsolationGroupStateHealthy},
+				"zone-2": {Name: "zone-2", State: types.IsolationGroupStateInvalid},
+			}},
+			dcAffordance: func(client *dynamicconfig.MockClient) {},
+			expectedErr: &types.BadRequestError{
+				Message: `invalid state for isolation group "zone-2", weighted isolation groups are only supported over thrift`,
+			},
+		},
 	}
 
 	for name, td := range tests {
@@ -23,9 +23,12 @@
 package isolationgroupapi
 
 import (
+	"fmt"
+
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/types"
 )
 
 type handlerImpl struct {
@@ -41,3 +44,17 @@ func New(log log.Logger, igConfigStore dynamicconfig.Client, dh domain.Handler)
 		domainHandler:              dh,
 	}
 }
+
+// validateIsolationGroups rejects isolation groups without a known state. Weighted isolation
+// groups sent over gRPC end up here as the public API has no representation for them.
+func validateIsolationGroups(in types.IsolationGroupConfiguration) error {
+	for _, partition := range in.ToPartitionList() {
+		switch partition.State {
+		case types.IsolationGroupStateHealthy, types.IsolationGroupStateDrained, types.IsolationGroupStateWeighted:
+		default:
+			return &types.BadRequestError{Message: fmt.Sprintf(
+				"invalid state for isolation group %q, weighted isolation groups are only supported over thrift", partition.Name)}
+		}
+	}
+	return nil
+}
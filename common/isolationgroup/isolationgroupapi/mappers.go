@@ -50,14 +50,41 @@ func MapDynamicConfigResponse(in []interface{}) (out types.IsolationGroupConfigu
 		if !okStr || !okI {
 			return nil, fmt.Errorf("failed parse a dynamic config entry, %v, (got %v)", v1, v)
 		}
-		out[nS] = types.IsolationGroupPartition{
+		partition := types.IsolationGroupPartition{
 			Name:  nS,
 			State: types.IsolationGroupState(sI),
 		}
+		if w, okWeight := v1["Weight"]; okWeight {
+			wI, okF := w.(float64)
+			if !okF {
+				return nil, fmt.Errorf("failed parse a dynamic config entry, %v, (got %v)", v1, v)
+			}
+			partition.Weight = int32(wI)
+		}
+		if r, okRamp := v1["Ramp"]; okRamp && r != nil {
+			ramp, err := mapDynamicConfigRamp(r)
+			if err != nil {
+				return nil, fmt.Errorf("failed parse a dynamic config entry, %v: %w", v1, err)
+			}
+			partition.Ramp = ramp
+		}
+		out[nS] = partition
 	}
 	return out, nil
 }
 
+func mapDynamicConfigRamp(in interface{}) (*types.IsolationGroupWeightRamp, error) {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+	var ramp types.IsolationGroupWeightRamp
+	if err := json.Unmarshal(data, &ramp); err != nil {
+		return nil, err
+	}
+	return &ramp, nil
+}
+
 func MapUpdateGlobalIsolationGroupsRequest(in types.IsolationGroupConfiguration) ([]*types.DynamicConfigValue, error) {
 	jsonData, err := json.Marshal(in.ToPartitionList())
 	if err != nil {
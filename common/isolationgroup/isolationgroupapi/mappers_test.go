@@ -23,10 +23,13 @@
 package isolationgroupapi
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/types"
 )
 
 func TestMapAllIsolationGroupStates(t *testing.T) {
@@ -54,3 +57,24 @@ func TestMapAllIsolationGroupStates(t *testing.T) {
 		})
 	}
 }
+
+func TestMapDynamicConfigResponseRoundTrip(t *testing.T) {
+	in := types.IsolationGroupConfiguration{
+		"zone-1": {Name: "zone-1", State: types.IsolationGroupStateDrained},
+		"zone-2": {Name: "zone-2", State: types.IsolationGroupStateWeighted, Weight: 40},
+		"zone-3": {Name: "zone-3", State: types.IsolationGroupStateWeighted, Ramp: &types.IsolationGroupWeightRamp{
+			Weights:         []int32{75, 50, 25, 0},
+			StartTimestamp:  1700000000000000000,
+			IntervalSeconds: 300,
+		}},
+	}
+
+	values, err := MapUpdateGlobalIsolationGroupsRequest(in)
+	assert.NoError(t, err)
+	var raw []interface{}
+	assert.NoError(t, json.Unmarshal(values[0].Value.Data, &raw))
+
+	out, err := MapDynamicConfigResponse(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, in, out)
+}
@@ -0,0 +1,43 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package isolationgroup
+
+import (
+	"github.com/dgryski/go-farm"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// IsWithinTrafficWeight answers whether the given key (typically a workflowID) falls into
+// the share of traffic that should be kept in an isolation group with the given weight.
+// The decision is deterministic so that all tasks of a workflow are placed consistently,
+// and raising the weight only ever adds workflows to the isolation group.
+func IsWithinTrafficWeight(key string, weight int32) bool {
+	if weight >= types.IsolationGroupMaxWeight {
+		return true
+	}
+	if weight <= 0 {
+		return false
+	}
+	return int32(farm.Fingerprint32([]byte(key))%uint32(types.IsolationGroupMaxWeight)) < weight
+}
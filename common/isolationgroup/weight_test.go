@@ -0,0 +1,49 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+
+package isolationgroup
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsWithinTrafficWeight(t *testing.T) {
+	assert.True(t, IsWithinTrafficWeight("wf-id", 100))
+	assert.True(t, IsWithinTrafficWeight("wf-id", 150))
+	assert.False(t, IsWithinTrafficWeight("wf-id", 0))
+	assert.False(t, IsWithinTrafficWeight("wf-id", -1))
+
+	const total = 10000
+	kept := 0
+	for i := 0; i < total; i++ {
+		key := fmt.Sprintf("workflow-%d", i)
+		within := IsWithinTrafficWeight(key, 30)
+		assert.Equal(t, within, IsWithinTrafficWeight(key, 30), "the decision should be deterministic")
+		if within {
+			kept++
+			assert.True(t, IsWithinTrafficWeight(key, 60), "raising the weight should keep existing workflows")
+		}
+	}
+	assert.InDelta(t, 0.3, float64(kept)/total, 0.03)
+}
@@ -629,6 +629,11 @@ func ReadLevel(lv int64) Tag {
 	return newInt64("read-level", lv)
 }
 
+// AckLevel returns tag for AckLevel
+func AckLevel(lv int64) Tag {
+	return newInt64("ack-level", lv)
+}
+
 // MinLevel returns tag for MinLevel
 func MinLevel(lv int64) Tag {
 	return newInt64("min-level", lv)
@@ -1176,6 +1181,22 @@ func ShardExecutors(executorIDs []string) Tag {
 	return newStringsTag("shard-executors", executorIDs)
 }
 
+func ShardKey(key string) Tag {
+	return newStringTag("shard-key", key)
+}
+
+func ShardTargetExecutor(ID string) Tag {
+	return newStringTag("shard-target-executor", ID)
+}
+
+func ShardLoad(load float64) Tag {
+	return newFloat64Tag("shard-load", load)
+}
+
+func ShardOperatorIdentity(identity string) Tag {
+	return newStringTag("shard-operator-identity", identity)
+}
+
 func ElectionDelay(t time.Duration) Tag {
 	return newDurationTag("election-delay", t)
 }
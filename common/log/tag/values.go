@@ -36,6 +36,8 @@ var (
 	WorkflowActionWorkflowSignaled               = workflowAction("add-workflow-signaled-event")
 	WorkflowActionWorkflowRecordMarker           = workflowAction("add-workflow-marker-record-event")
 	WorkflowActionUpsertWorkflowSearchAttributes = workflowAction("add-workflow-upsert-search-attributes-event")
+	WorkflowActionUpsertWorkflowMemo             = workflowAction("add-workflow-upsert-memo-event")
+	WorkflowActionWorkflowMemoUpdated            = workflowAction("add-workflow-memo-updated-event")
 
 	// decision
 	WorkflowActionDecisionTaskScheduled = workflowAction("add-decisiontask-scheduled-event")
@@ -127,6 +129,8 @@ var (
 	ComponentESVisibilityManager              = component("es-visibility-manager")
 	ComponentArchiver                         = component("archiver")
 	ComponentBatcher                          = component("batcher")
+	ComponentCompletionCallback               = component("completion-callback")
+	ComponentCDCPublisher                     = component("cdc-publisher")
 	ComponentWorker                           = component("worker")
 	ComponentServiceResolver                  = component("service-resolver")
 	ComponentFailoverCoordinator              = component("failover-coordinator")
@@ -142,6 +146,7 @@ var (
 	ComponentMapQ                             = component("mapq")
 	ComponentMapQTree                         = component("mapq-tree")
 	ComponentMapQTreeNode                     = component("mapq-tree-node")
+	ComponentMapQDispatcher                   = component("mapq-dispatcher")
 	ComponentRPCFactory                       = component("rpc-factory")
 	ComponentTaskListAdaptiveScaler           = component("task-list-adaptive-scaler")
 	ComponentActiveClusterManager             = component("active-cluster-manager")
@@ -301,6 +306,30 @@ var (
 
 	StoreOperationFetchDynamicConfig  = storeOperation("fetch-dynamic-config")
 	StoreOperationUpdateDynamicConfig = storeOperation("update-dynamic-config")
+
+	StoreOperationUpsertAsyncRequest              = storeOperation("upsert-async-request")
+	StoreOperationGetAsyncRequest                 = storeOperation("get-async-request")
+	StoreOperationEnqueueAsyncRequestToDLQ        = storeOperation("enqueue-async-request-to-dlq")
+	StoreOperationReadAsyncRequestDLQ             = storeOperation("read-async-request-dlq")
+	StoreOperationGetAsyncRequestDLQEntry         = storeOperation("get-async-request-dlq-entry")
+	StoreOperationDeleteAsyncRequestDLQEntry      = storeOperation("delete-async-request-dlq-entry")
+	StoreOperationEnqueueAsyncRequestQueueMessage = storeOperation("enqueue-async-request-queue-message")
+	StoreOperationReadAsyncRequestQueueMessages   = storeOperation("read-async-request-queue-messages")
+	StoreOperationDeleteAsyncRequestQueueMessages = storeOperation("delete-async-request-queue-messages")
+	StoreOperationGetAsyncRequestQueueMetadata    = storeOperation("get-async-request-queue-metadata")
+	StoreOperationUpdateAsyncRequestQueueMetadata = storeOperation("update-async-request-queue-metadata")
+
+	StoreOperationEnqueueMapQItems = storeOperation("enqueue-mapq-items")
+	StoreOperationReadMapQItems    = storeOperation("read-mapq-items")
+	StoreOperationDeleteMapQItems  = storeOperation("delete-mapq-items")
+	StoreOperationGetMapQState     = storeOperation("get-mapq-state")
+	StoreOperationUpdateMapQState  = storeOperation("update-mapq-state")
+
+	StoreOperationGetShardDistributorState        = storeOperation("get-shard-distributor-state")
+	StoreOperationUpdateShardDistributorLeader    = storeOperation("update-shard-distributor-leader")
+	StoreOperationUpsertShardDistributorExecutor  = storeOperation("upsert-shard-distributor-executor")
+	StoreOperationAssignShardDistributorShards    = storeOperation("assign-shard-distributor-shards")
+	StoreOperationDeleteShardDistributorExecutors = storeOperation("delete-shard-distributor-executors")
 )
 
 // Pre-defined values for TagSysClientOperation
@@ -342,6 +371,12 @@ var (
 	AdminDeleteWorkflow                                       = clientOperation("admin-delete-workflow")
 	MaintainCorruptWorkflow                                   = clientOperation("maintain-corrupt-workflow")
 	AdminClientOperationUpdateTaskListPartitionConfig         = clientOperation("admin-update-task-list-partition-config")
+	AdminClientOperationUpdateTaskListVersioningConfig        = clientOperation("admin-update-task-list-versioning-config")
+	AdminClientOperationMigrateTaskList                       = clientOperation("admin-migrate-task-list")
+	AdminClientOperationReadAsyncWorkflowDLQMessages          = clientOperation("admin-read-async-workflow-dlq-messages")
+	AdminClientOperationPurgeAsyncWorkflowDLQMessages         = clientOperation("admin-purge-async-workflow-dlq-messages")
+	AdminClientOperationReplayAsyncWorkflowDLQMessages        = clientOperation("admin-replay-async-workflow-dlq-messages")
+	AdminClientOperationDescribeAsyncWorkflowQueue            = clientOperation("admin-describe-async-workflow-queue")
 
 	FrontendClientOperationDeleteDomain                          = clientOperation("frontend-delete-domain")
 	FrontendClientOperationDeprecateDomain                       = clientOperation("frontend-deprecate-domain")
@@ -365,6 +400,7 @@ var (
 	FrontendClientOperationRecordActivityTaskHeartbeatByID       = clientOperation("frontend-record-activity-heartbeat-by-id")
 	FrontendClientOperationRegisterDomain                        = clientOperation("frontend-register-domain")
 	FrontendClientOperationRequestCancelWorkflowExecution        = clientOperation("frontend-request-cancel-wf-execution")
+	FrontendClientOperationRequestCancelWorkflowExecutionAsync   = clientOperation("frontend-request-cancel-wf-execution-async")
 	FrontendClientOperationResetStickyTaskList                   = clientOperation("frontend-reset-sticky-task-list")
 	FrontendClientOperationResetWorkflowExecution                = clientOperation("frontend-reset-wf-execution")
 	FrontendClientOperationRefreshWorkflowTasks                  = clientOperation("frontend-refresh-wf-tasks")
@@ -381,13 +417,20 @@ var (
 	FrontendClientOperationSignalWithStartWorkflowExecution      = clientOperation("frontend-signal-with-start-wf-execution")
 	FrontendClientOperationSignalWithStartWorkflowExecutionAsync = clientOperation("frontend-signal-with-start-wf-execution-async")
 	FrontendClientOperationSignalWorkflowExecution               = clientOperation("frontend-signal-wf-execution")
+	FrontendClientOperationSignalWorkflowExecutionAsync          = clientOperation("frontend-signal-wf-execution-async")
 	FrontendClientOperationStartWorkflowExecution                = clientOperation("frontend-start-wf-execution")
 	FrontendClientOperationStartWorkflowExecutionAsync           = clientOperation("frontend-start-wf-execution-async")
 	FrontendClientOperationTerminateWorkflowExecution            = clientOperation("frontend-terminate-wf-execution")
+	FrontendClientOperationTerminateWorkflowExecutionAsync       = clientOperation("frontend-terminate-wf-execution-async")
+	FrontendClientOperationUpdateWorkflowMemo                    = clientOperation("frontend-update-wf-memo")
 	FrontendClientOperationUpdateDomain                          = clientOperation("frontend-update-domain")
 	FrontendClientOperationGetClusterInfo                        = clientOperation("frontend-get-cluster-info")
 	FrontendClientOperationListTaskListPartitions                = clientOperation("frontend-list-task-list-partitions")
 	FrontendClientOperationGetTaskListsByDomain                  = clientOperation("frontend-get-task-list-for-domain")
+	FrontendClientOperationGetTaskListScalingRecommendation      = clientOperation("frontend-get-task-list-scaling-recommendation")
+	FrontendClientOperationListWorkers                           = clientOperation("frontend-list-workers")
+	FrontendClientOperationDescribeAsyncRequest                  = clientOperation("frontend-describe-async-request")
+	FrontendClientOperationDescribeWorker                        = clientOperation("frontend-describe-worker")
 
 	HistoryClientOperationStartWorkflowExecution            = clientOperation("history-start-wf-execution")
 	HistoryClientOperationDescribeHistoryHost               = clientOperation("history-describe-history-host")
@@ -413,9 +456,12 @@ var (
 	HistoryClientOperationSignalWithStartWorkflowExecution  = clientOperation("history-signal-with-start-wf-execution")
 	HistoryClientOperationRemoveSignalMutableState          = clientOperation("history-remove-signal-mutable-state")
 	HistoryClientOperationTerminateWorkflowExecution        = clientOperation("history-terminate-wf-execution")
+	HistoryClientOperationUpdateWorkflowMemo                = clientOperation("history-update-wf-memo")
 	HistoryClientOperationResetWorkflowExecution            = clientOperation("history-reset-wf-execution")
 	HistoryClientOperationScheduleDecisionTask              = clientOperation("history-schedule-decision-task")
 	HistoryClientOperationRecordChildExecutionCompleted     = clientOperation("history-record-child-execution-completed")
+	HistoryClientOperationGetCompletionCallbacks            = clientOperation("history-get-completion-callbacks")
+	HistoryClientOperationRecordCompletionCallbackState     = clientOperation("history-record-completion-callback-state")
 	HistoryClientOperationReplicateEventsV2                 = clientOperation("history-replicate-events-v2")
 	HistoryClientOperationSyncShardStatus                   = clientOperation("history-sync-shard-status")
 	HistoryClientOperationSyncActivity                      = clientOperation("history-sync-activity")
@@ -439,22 +485,34 @@ var (
 	HistoryClientOperationRespondDecisionTaskFailed         = clientOperation("history-respond-decision-task-failed")
 	HistoryClientOperationRatelimitUpdate                   = clientOperation("history-ratelimit-update")
 
-	MatchingClientOperationAddActivityTask                = clientOperation("matching-add-activity-task")
-	MatchingClientOperationAddDecisionTask                = clientOperation("matching-add-decision-task")
-	MatchingClientOperationPollForActivityTask            = clientOperation("matching-poll-for-activity-task")
-	MatchingClientOperationPollForDecisionTask            = clientOperation("matching-poll-for-decision-task")
-	MatchingClientOperationQueryWorkflow                  = clientOperation("matching-query-wf")
-	MatchingClientOperationQueryTaskCompleted             = clientOperation("matching-query-task-completed")
-	MatchingClientOperationCancelOutstandingPoll          = clientOperation("matching-cancel-outstanding-poll")
-	MatchingClientOperationDescribeTaskList               = clientOperation("matching-describe-task-list")
-	MatchingClientOperationListTaskListPartitions         = clientOperation("matching-list-task-list-partitions")
-	MatchingClientOperationGetTaskListsByDomain           = clientOperation("matching-get-task-list-for-domain")
-	MatchingClientOperationRespondQueryTaskCompleted      = clientOperation("matching-respond-query-task-completed")
-	MatchingClientOperationUpdateTaskListPartitionConfig  = clientOperation("matching-update-task-list-partition-config")
-	MatchingClientOperationRefreshTaskListPartitionConfig = clientOperation("matching-refresh-task-list-partition-config")
-
-	ShardDistributorClientOperationGetShardOwner     = clientOperation("shard-distributor-get-shard-owner")
-	ShardDistributorExecutorClientOperationHeartbeat = clientOperation("shard-distributor-executor-heartbeat")
+	MatchingClientOperationAddActivityTask                  = clientOperation("matching-add-activity-task")
+	MatchingClientOperationAddDecisionTask                  = clientOperation("matching-add-decision-task")
+	MatchingClientOperationPollForActivityTask              = clientOperation("matching-poll-for-activity-task")
+	MatchingClientOperationPollForDecisionTask              = clientOperation("matching-poll-for-decision-task")
+	MatchingClientOperationQueryWorkflow                    = clientOperation("matching-query-wf")
+	MatchingClientOperationQueryTaskCompleted               = clientOperation("matching-query-task-completed")
+	MatchingClientOperationCancelOutstandingPoll            = clientOperation("matching-cancel-outstanding-poll")
+	MatchingClientOperationDescribeTaskList                 = clientOperation("matching-describe-task-list")
+	MatchingClientOperationListTaskListPartitions           = clientOperation("matching-list-task-list-partitions")
+	MatchingClientOperationGetTaskListsByDomain             = clientOperation("matching-get-task-list-for-domain")
+	MatchingClientOperationRespondQueryTaskCompleted        = clientOperation("matching-respond-query-task-completed")
+	MatchingClientOperationUpdateTaskListPartitionConfig    = clientOperation("matching-update-task-list-partition-config")
+	MatchingClientOperationRefreshTaskListPartitionConfig   = clientOperation("matching-refresh-task-list-partition-config")
+	MatchingClientOperationUpdateTaskListVersioningConfig   = clientOperation("matching-update-task-list-versioning-config")
+	MatchingClientOperationGetTaskListScalingRecommendation = clientOperation("matching-get-task-list-scaling-recommendation")
+	MatchingClientOperationListWorkers                      = clientOperation("matching-list-workers")
+	MatchingClientOperationDescribeWorker                   = clientOperation("matching-describe-worker")
+	MatchingClientOperationMigrateTaskList                  = clientOperation("matching-migrate-task-list")
+	MatchingClientOperationDescribeDomainTaskQuota          = clientOperation("matching-describe-domain-task-quota")
+
+	ShardDistributorClientOperationGetShardOwner        = clientOperation("shard-distributor-get-shard-owner")
+	ShardDistributorClientOperationWatchNamespaceState  = clientOperation("shard-distributor-watch-namespace-state")
+	ShardDistributorClientOperationListNamespaces       = clientOperation("shard-distributor-list-namespaces")
+	ShardDistributorClientOperationDescribeNamespace    = clientOperation("shard-distributor-describe-namespace")
+	ShardDistributorClientOperationMoveShard            = clientOperation("shard-distributor-move-shard")
+	ShardDistributorClientOperationSetExecutorDrained   = clientOperation("shard-distributor-set-executor-drained")
+	ShardDistributorClientOperationSetRebalancingFrozen = clientOperation("shard-distributor-set-rebalancing-frozen")
+	ShardDistributorExecutorClientOperationHeartbeat    = clientOperation("shard-distributor-executor-heartbeat")
 )
 
 // Pre-defined values for TagIDType
@@ -41,6 +41,7 @@ type clientImpl struct {
 	tree            *tree.QueueTree
 	partitions      []string
 	policies        []types.NodePolicy
+	treeOptions     []tree.Options
 }
 
 func (c *clientImpl) Start(ctx context.Context) error {
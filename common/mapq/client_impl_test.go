@@ -93,8 +93,12 @@ func TestStartStop(t *testing.T) {
 	consumer := types.NewMockConsumer(ctrl)
 	consumerFactory.EXPECT().Stop(gomock.Any()).Return(nil).Times(1)
 	consumerFactory.EXPECT().New(gomock.Any()).Return(consumer, nil).Times(1)
+	persister := types.NewMockPersister(ctrl)
+	persister.EXPECT().GetOffsets(gomock.Any()).Return(types.NewOffsets(), nil)
+	persister.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	persister.EXPECT().CommitOffsets(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	opts := []Options{
-		WithPersister(types.NewMockPersister(ctrl)),
+		WithPersister(persister),
 		WithConsumerFactory(consumerFactory),
 	}
 	logger := testlogger.New(t)
@@ -114,8 +118,12 @@ func TestAck(t *testing.T) {
 	consumer := types.NewMockConsumer(ctrl)
 	consumerFactory.EXPECT().Stop(gomock.Any()).Return(nil).Times(1)
 	consumerFactory.EXPECT().New(gomock.Any()).Return(consumer, nil).Times(1)
+	persister := types.NewMockPersister(ctrl)
+	persister.EXPECT().GetOffsets(gomock.Any()).Return(types.NewOffsets(), nil)
+	persister.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	persister.EXPECT().CommitOffsets(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	opts := []Options{
-		WithPersister(types.NewMockPersister(ctrl)),
+		WithPersister(persister),
 		WithConsumerFactory(consumerFactory),
 	}
 	logger := testlogger.New(t)
@@ -140,8 +148,12 @@ func TestNack(t *testing.T) {
 	consumer := types.NewMockConsumer(ctrl)
 	consumerFactory.EXPECT().Stop(gomock.Any()).Return(nil).Times(1)
 	consumerFactory.EXPECT().New(gomock.Any()).Return(consumer, nil).Times(1)
+	persister := types.NewMockPersister(ctrl)
+	persister.EXPECT().GetOffsets(gomock.Any()).Return(types.NewOffsets(), nil)
+	persister.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	persister.EXPECT().CommitOffsets(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	opts := []Options{
-		WithPersister(types.NewMockPersister(ctrl)),
+		WithPersister(persister),
 		WithConsumerFactory(consumerFactory),
 	}
 	logger := testlogger.New(t)
@@ -25,26 +25,102 @@ package dispatcher
 import (
 	"context"
 	"fmt"
+	"math"
+	"sort"
 	"sync"
 	"time"
 
+	"golang.org/x/time/rate"
+
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/mapq/types"
+	"github.com/uber/cadence/common/metrics"
+)
+
+const (
+	defaultPageSize      = 100
+	defaultConcurrency   = 10
+	defaultPollInterval  = time.Second
+	defaultRetryInterval = time.Second
 )
 
+// Dispatcher reads items of a leaf node from the persister and pushes them to the consumer.
+// It enforces the dispatch policy of the leaf node and keeps track of the ack level,
+// i.e. the offset below which all items of the leaf node are processed.
 type Dispatcher struct {
-	consumer  types.Consumer
+	logger     log.Logger
+	scope      metrics.Scope
+	consumer   types.Consumer
+	persister  types.Persister
+	partitions types.ItemPartitions
+	timeSource clock.TimeSource
+	limiter    clock.Ratelimiter
+	semaphore  chan struct{}
+	notifyCh   chan struct{}
+
+	pageSize      int
+	pollInterval  time.Duration
+	retryInterval time.Duration
+
 	ctx       context.Context
 	cancelCtx context.CancelFunc
 	wg        sync.WaitGroup
+
+	sync.Mutex
+	readLevel int64
+	ackLevel  int64
+	// inflight contains the offsets of dispatched items above the ack level in ascending order
+	inflight []int64
+	// acked tracks whether the items in inflight are processed
+	acked map[int64]bool
+	// rewinds is incremented whenever the read level is moved back so that results of an ongoing fetch are discarded
+	rewinds  int64
+	caughtUp bool
 }
 
-func New(c types.Consumer) *Dispatcher {
+func New(
+	logger log.Logger,
+	scope metrics.Scope,
+	c types.Consumer,
+	persister types.Persister,
+	partitions types.ItemPartitions,
+	policy types.DispatchPolicy,
+	ackLevel int64,
+	timeSource clock.TimeSource,
+) *Dispatcher {
 	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	limit, burst := rate.Inf, 1
+	if policy.DispatchRPS > 0 {
+		limit, burst = rate.Limit(policy.DispatchRPS), int(policy.DispatchRPS)
+	}
+
+	concurrency := policy.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+
 	return &Dispatcher{
-		consumer:  c,
-		ctx:       ctx,
-		cancelCtx: cancelCtx,
+		logger:        logger.WithTags(tag.ComponentMapQDispatcher, tag.Dynamic("partitions", partitions.String())),
+		scope:         scope,
+		consumer:      c,
+		persister:     persister,
+		partitions:    partitions,
+		timeSource:    timeSource,
+		limiter:       clock.NewRateLimiterWithTimeSource(timeSource, limit, burst),
+		semaphore:     make(chan struct{}, concurrency),
+		notifyCh:      make(chan struct{}, 1),
+		pageSize:      defaultPageSize,
+		pollInterval:  defaultPollInterval,
+		retryInterval: defaultRetryInterval,
+		ctx:           ctx,
+		cancelCtx:     cancelCtx,
+		readLevel:     ackLevel,
+		ackLevel:      ackLevel,
+		acked:         map[int64]bool{},
 	}
 }
 
@@ -66,7 +142,192 @@ func (d *Dispatcher) Stop(ctx context.Context) error {
 	return nil
 }
 
+// AckLevel returns the offset below which all items of the leaf node are processed
+func (d *Dispatcher) AckLevel() int64 {
+	d.Lock()
+	defer d.Unlock()
+
+	return d.ackLevel
+}
+
+// Idle returns true if all persisted items of the leaf node are read and processed
+func (d *Dispatcher) Idle() bool {
+	d.Lock()
+	defer d.Unlock()
+
+	return d.caughtUp && len(d.inflight) == 0
+}
+
+// Notify informs the dispatcher that an item with the given offset is persisted.
+// If the offset is not above the read level (e.g. timer items), the read level and the ack level are moved back
+// so the item is not skipped. Items between the new and old levels might be delivered again.
+func (d *Dispatcher) Notify(offset int64) {
+	d.Lock()
+	if offset <= d.readLevel {
+		d.readLevel = offset - 1
+		d.rewinds++
+	}
+	if offset <= d.ackLevel {
+		d.ackLevel = offset - 1
+	}
+	d.caughtUp = false
+	d.Unlock()
+
+	select {
+	case d.notifyCh <- struct{}{}:
+	default:
+	}
+}
+
 func (d *Dispatcher) run() {
 	defer d.wg.Done()
-	// TODO: implement
+
+	for {
+		select {
+		case <-d.ctx.Done():
+			return
+		default:
+		}
+
+		items, err := d.fetch()
+		if err != nil {
+			if d.ctx.Err() != nil {
+				return
+			}
+			d.logger.Warn("Failed to fetch items", tag.Error(err))
+			d.wait(d.retryInterval)
+			continue
+		}
+
+		if len(items) == 0 {
+			d.wait(d.pollInterval)
+			continue
+		}
+
+		for _, item := range items {
+			if err := d.dispatch(item); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (d *Dispatcher) fetch() ([]types.Item, error) {
+	d.Lock()
+	readLevel := d.readLevel
+	rewinds := d.rewinds
+	d.Unlock()
+
+	items, err := d.persister.Fetch(d.ctx, d.partitions, types.PageInfo{
+		ExclusiveMinOffset: readLevel,
+		InclusiveMaxOffset: math.MaxInt64,
+		PageSize:           d.pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	if rewinds != d.rewinds {
+		// read level is moved back during the fetch so the page might be missing newly persisted items.
+		// Notify already signaled the dispatcher so the page will be fetched again right away.
+		return nil, nil
+	}
+
+	var result []types.Item
+	for _, item := range items {
+		offset := item.Offset()
+		if offset > d.readLevel {
+			d.readLevel = offset
+		}
+		if _, ok := d.acked[offset]; ok {
+			// already dispatched and not processed yet
+			continue
+		}
+
+		d.acked[offset] = false
+		idx := sort.Search(len(d.inflight), func(i int) bool { return d.inflight[i] >= offset })
+		d.inflight = append(d.inflight, 0)
+		copy(d.inflight[idx+1:], d.inflight[idx:])
+		d.inflight[idx] = offset
+		result = append(result, item)
+	}
+
+	d.advanceAckLevel()
+	d.caughtUp = len(items) < d.pageSize
+	return result, nil
+}
+
+func (d *Dispatcher) dispatch(item types.Item) error {
+	if err := d.limiter.Wait(d.ctx); err != nil {
+		return err
+	}
+
+	select {
+	case d.semaphore <- struct{}{}:
+	case <-d.ctx.Done():
+		return d.ctx.Err()
+	}
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		defer func() { <-d.semaphore }()
+
+		d.process(item)
+	}()
+	return nil
+}
+
+// process pushes the item to the consumer until it succeeds to guarantee at least once delivery
+func (d *Dispatcher) process(item types.Item) {
+	for {
+		err := d.consumer.Process(d.ctx, item)
+		if err == nil {
+			d.ack(item.Offset())
+			return
+		}
+
+		d.logger.Warn("Failed to process item, will retry", tag.Error(err), tag.Dynamic("item", item.String()))
+		if err := d.timeSource.SleepWithContext(d.ctx, d.retryInterval); err != nil {
+			return
+		}
+	}
+}
+
+func (d *Dispatcher) ack(offset int64) {
+	d.Lock()
+	defer d.Unlock()
+
+	if _, ok := d.acked[offset]; !ok {
+		return
+	}
+
+	d.acked[offset] = true
+	d.advanceAckLevel()
+}
+
+// advanceAckLevel moves the ack level up to the first unprocessed item. It must be called with the lock held.
+func (d *Dispatcher) advanceAckLevel() {
+	// ack level can't move beyond the read level. Otherwise items persisted after a rewind might be skipped.
+	for len(d.inflight) > 0 && d.acked[d.inflight[0]] && d.inflight[0] <= d.readLevel {
+		if d.inflight[0] > d.ackLevel {
+			d.ackLevel = d.inflight[0]
+		}
+		delete(d.acked, d.inflight[0])
+		d.inflight = d.inflight[1:]
+	}
+}
+
+func (d *Dispatcher) wait(duration time.Duration) {
+	timer := d.timeSource.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-d.ctx.Done():
+	case <-d.notifyCh:
+	case <-timer.Chan():
+	}
 }
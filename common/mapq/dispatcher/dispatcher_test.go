@@ -24,16 +24,28 @@ package dispatcher
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"testing"
 	"time"
 
 	"go.uber.org/goleak"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/mapq/types"
+	"github.com/uber/cadence/common/metrics"
 )
 
 func TestStartStop(t *testing.T) {
 	defer goleak.VerifyNone(t)
 
-	d := New(nil)
+	ctrl := gomock.NewController(t)
+	persister := types.NewMockPersister(ctrl)
+	persister.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	d := newTestDispatcher(t, types.NewMockConsumer(ctrl), persister, types.DispatchPolicy{})
 	err := d.Start(context.Background())
 	if err != nil {
 		t.Fatalf("Start() failed: %v", err)
@@ -46,3 +58,160 @@ func TestStartStop(t *testing.T) {
 		t.Fatalf("Stop() failed: %v", err)
 	}
 }
+
+func TestDispatch(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	ctrl := gomock.NewController(t)
+	persister := newFakePersister(ctrl, 1, 2, 3, 4, 5)
+	consumer := types.NewMockConsumer(ctrl)
+	var mu sync.Mutex
+	var processed []int64
+	failures := 0
+	consumer.EXPECT().Process(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, item types.Item) error {
+		mu.Lock()
+		defer mu.Unlock()
+		// fail the first attempt of item 3 to verify that it's redelivered
+		if item.Offset() == 3 && failures == 0 {
+			failures++
+			return errors.New("transient error")
+		}
+		processed = append(processed, item.Offset())
+		return nil
+	}).MinTimes(6)
+
+	d := newTestDispatcher(t, consumer, persister, types.DispatchPolicy{Concurrency: 1})
+	if err := d.Start(context.Background()); err != nil {
+		t.Fatalf("Start() failed: %v", err)
+	}
+	defer d.Stop(context.Background())
+
+	waitFor(t, func() bool { return d.AckLevel() == 5 && d.Idle() })
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(processed) != 5 {
+		t.Errorf("processed items = %v, want 5 items", processed)
+	}
+}
+
+func TestNotifyRewindsReadLevel(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	ctrl := gomock.NewController(t)
+	persister := newFakePersister(ctrl, 10, 11)
+	consumer := types.NewMockConsumer(ctrl)
+	consumer.EXPECT().Process(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	d := newTestDispatcher(t, consumer, persister, types.DispatchPolicy{})
+	if err := d.Start(context.Background()); err != nil {
+		t.Fatalf("Start() failed: %v", err)
+	}
+	defer d.Stop(context.Background())
+
+	waitFor(t, func() bool { return d.AckLevel() == 11 && d.Idle() })
+
+	// an item with a lower offset is persisted after the dispatcher moved past it
+	persister.add(5)
+	d.Notify(5)
+	if got := d.AckLevel(); got != 4 {
+		t.Errorf("AckLevel() after Notify = %v, want 4", got)
+	}
+
+	waitFor(t, func() bool { return d.AckLevel() == 11 && d.Idle() })
+}
+
+func TestDispatchRateLimit(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	ctrl := gomock.NewController(t)
+	persister := newFakePersister(ctrl, 1, 2, 3, 4, 5, 6)
+	consumer := types.NewMockConsumer(ctrl)
+	consumer.EXPECT().Process(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	// burst is equal to DispatchRPS so the first 2 items are dispatched right away and the rest with 500ms interval
+	d := newTestDispatcher(t, consumer, persister, types.DispatchPolicy{DispatchRPS: 2})
+	start := time.Now()
+	if err := d.Start(context.Background()); err != nil {
+		t.Fatalf("Start() failed: %v", err)
+	}
+	defer d.Stop(context.Background())
+
+	waitFor(t, func() bool { return d.AckLevel() == 6 })
+	if elapsed := time.Since(start); elapsed < 1500*time.Millisecond {
+		t.Errorf("dispatched 6 items in %v with 2 RPS limit, want at least 1.5s", elapsed)
+	}
+}
+
+func newTestDispatcher(t *testing.T, consumer types.Consumer, persister types.Persister, policy types.DispatchPolicy) *Dispatcher {
+	d := New(
+		testlogger.New(t),
+		metrics.NoopScope,
+		consumer,
+		persister,
+		types.NewItemPartitions([]string{"domain"}, map[string]any{"domain": "*"}),
+		policy,
+		types.DefaultAckLevel,
+		clock.NewRealTimeSource(),
+	)
+	d.pollInterval = 10 * time.Millisecond
+	d.retryInterval = 10 * time.Millisecond
+	return d
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met in time")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+type fakePersister struct {
+	*types.MockPersister
+
+	sync.Mutex
+	offsets []int64
+	ctrl    *gomock.Controller
+}
+
+// newFakePersister returns a persister which serves items with given offsets in ascending order
+func newFakePersister(ctrl *gomock.Controller, offsets ...int64) *fakePersister {
+	p := &fakePersister{
+		MockPersister: types.NewMockPersister(ctrl),
+		offsets:       offsets,
+		ctrl:          ctrl,
+	}
+	p.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(p.fetch).AnyTimes()
+	return p
+}
+
+func (p *fakePersister) add(offset int64) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.offsets = append([]int64{offset}, p.offsets...)
+}
+
+func (p *fakePersister) fetch(ctx context.Context, partitions types.ItemPartitions, pageInfo types.PageInfo) ([]types.Item, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	var items []types.Item
+	for _, offset := range p.offsets {
+		if offset <= pageInfo.ExclusiveMinOffset || offset > pageInfo.InclusiveMaxOffset {
+			continue
+		}
+		if len(items) == pageInfo.PageSize {
+			break
+		}
+		item := types.NewMockItem(p.ctrl)
+		item.EXPECT().Offset().Return(offset).AnyTimes()
+		item.EXPECT().String().Return("item").AnyTimes()
+		items = append(items, item)
+	}
+	return items, nil
+}
@@ -24,7 +24,9 @@ package mapq
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/mapq/tree"
@@ -72,6 +74,28 @@ func WithPolicies(policies []types.NodePolicy) Options {
 	}
 }
 
+// WithTimeSource sets the time source used for rate limiting and periodic tasks. Intended for tests.
+func WithTimeSource(ts clock.TimeSource) Options {
+	return func(c *clientImpl) {
+		c.treeOptions = append(c.treeOptions, tree.WithTimeSource(ts))
+	}
+}
+
+// WithPolicyEvaluationInterval sets how often the enqueue rates are evaluated against split policies
+// to split hot partitions out of catch-all nodes or to merge them back.
+func WithPolicyEvaluationInterval(d time.Duration) Options {
+	return func(c *clientImpl) {
+		c.treeOptions = append(c.treeOptions, tree.WithPolicyEvaluationInterval(d))
+	}
+}
+
+// WithOffsetCommitInterval sets how often the ack levels of the queues are committed to the persister
+func WithOffsetCommitInterval(d time.Duration) Options {
+	return func(c *clientImpl) {
+		c.treeOptions = append(c.treeOptions, tree.WithOffsetCommitInterval(d))
+	}
+}
+
 func New(logger log.Logger, scope metrics.Scope, opts ...Options) (types.Client, error) {
 	c := &clientImpl{
 		logger: logger.WithTags(tag.ComponentMapQ),
@@ -90,7 +114,7 @@ func New(logger log.Logger, scope metrics.Scope, opts ...Options) (types.Client,
 		return nil, fmt.Errorf("consumer factory is required. Use WithConsumerFactory option to set it")
 	}
 
-	tree, err := tree.New(logger, scope, c.partitions, c.policies, c.persister, c.consumerFactory)
+	tree, err := tree.New(logger, scope, c.partitions, c.policies, c.persister, c.consumerFactory, c.treeOptions...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,262 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package persister
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/mapq/types"
+	"github.com/uber/cadence/common/persistence"
+	cadencetypes "github.com/uber/cadence/common/types"
+)
+
+// ItemCodec converts items to payloads stored in the database and vice versa.
+// Encode is called with the items passed to Enqueue, so it can rely on their concrete types.
+type ItemCodec interface {
+	Encode(types.Item) ([]byte, error)
+	Decode([]byte) (types.Item, error)
+}
+
+const defaultMaxBatchSize = 100
+
+type Options func(*persisterImpl)
+
+// WithMaxBatchSize sets the maximum number of items written in a single request. Larger enqueues are split.
+func WithMaxBatchSize(n int) Options {
+	return func(p *persisterImpl) {
+		p.maxBatchSize = n
+	}
+}
+
+type persisterImpl struct {
+	queueID      string
+	manager      persistence.MapQManager
+	codec        ItemCodec
+	maxBatchSize int
+
+	sync.Mutex
+	// version of the offsets state read or written last. 0 means state doesn't exist yet.
+	version int64
+	// stale is set when the state was updated by someone else so the version must be read again before the next commit
+	stale bool
+	// deleted keeps the offsets up to which items of each partition are deleted so unchanged partitions are skipped
+	deleted map[string]int64
+}
+
+// New returns a persister which stores the items and offsets of the MAPQ queue with given ID in the database.
+// Leaf node paths are used as partitions of the queue.
+func New(queueID string, manager persistence.MapQManager, codec ItemCodec, opts ...Options) types.Persister {
+	p := &persisterImpl{
+		queueID:      queueID,
+		manager:      manager,
+		codec:        codec,
+		maxBatchSize: defaultMaxBatchSize,
+		deleted:      map[string]int64{},
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+func (p *persisterImpl) Persist(ctx context.Context, items []types.ItemToPersist) error {
+	mapQItems := make([]*persistence.MapQItem, 0, len(items))
+	for _, item := range items {
+		payload, err := p.codec.Encode(types.UnwrapItem(item))
+		if err != nil {
+			return fmt.Errorf("failed to encode item %v: %w", item, err)
+		}
+		mapQItems = append(mapQItems, &persistence.MapQItem{
+			Partition: types.PartitionPath(item),
+			Offset:    item.Offset(),
+			Payload:   payload,
+		})
+	}
+
+	for start := 0; start < len(mapQItems); start += p.maxBatchSize {
+		end := start + p.maxBatchSize
+		if end > len(mapQItems) {
+			end = len(mapQItems)
+		}
+		err := p.manager.EnqueueMapQItems(ctx, &persistence.EnqueueMapQItemsRequest{
+			QueueID: p.queueID,
+			Items:   mapQItems[start:end],
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *persisterImpl) GetOffsets(ctx context.Context) (*types.Offsets, error) {
+	resp, err := p.manager.GetMapQState(ctx, &persistence.GetMapQStateRequest{QueueID: p.queueID})
+	if err != nil {
+		var notExistsErr *cadencetypes.EntityNotExistsError
+		if !errors.As(err, &notExistsErr) {
+			return nil, err
+		}
+
+		p.Lock()
+		p.version = 0
+		p.stale = false
+		p.Unlock()
+		return types.NewOffsets(), nil
+	}
+
+	offsets := types.NewOffsets()
+	if resp.State.State != nil && len(resp.State.State.Data) > 0 {
+		if err := json.Unmarshal(resp.State.State.Data, offsets); err != nil {
+			return nil, fmt.Errorf("failed to decode offsets of queue %v: %w", p.queueID, err)
+		}
+	}
+
+	p.Lock()
+	p.version = resp.State.Version
+	p.stale = false
+	p.Unlock()
+	return offsets, nil
+}
+
+// CommitOffsets stores the offsets and deletes the items at or below the committed offsets.
+// Offsets are updated with optimistic concurrency control. If the state was updated by someone else in the meantime,
+// ConditionFailedError is returned and the latest version is read on the next commit. It's up to the caller to make
+// sure that there is a single owner of the queue.
+func (p *persisterImpl) CommitOffsets(ctx context.Context, offsets *types.Offsets) error {
+	data, err := json.Marshal(offsets)
+	if err != nil {
+		return fmt.Errorf("failed to encode offsets of queue %v: %w", p.queueID, err)
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	if p.stale {
+		if err := p.refreshVersion(ctx); err != nil {
+			return err
+		}
+	}
+
+	err = p.manager.UpdateMapQState(ctx, &persistence.UpdateMapQStateRequest{
+		State: &persistence.MapQState{
+			QueueID: p.queueID,
+			State:   persistence.NewDataBlob(data, constants.EncodingTypeJSON),
+			Version: p.version + 1,
+		},
+		PreviousVersion: p.version,
+	})
+	if err != nil {
+		var conditionFailedErr *persistence.ConditionFailedError
+		if errors.As(err, &conditionFailedErr) {
+			p.stale = true
+		}
+		return err
+	}
+	p.version++
+
+	for partition, ackLevel := range offsets.Partitions {
+		if err := p.deleteItems(ctx, partition, ackLevel); err != nil {
+			return err
+		}
+	}
+	for partition, ackLevel := range offsets.Retired {
+		if err := p.deleteItems(ctx, partition, ackLevel); err != nil {
+			return err
+		}
+		// retired partitions are committed only once
+		delete(p.deleted, partition)
+	}
+
+	return nil
+}
+
+// deleteItems deletes the items of the partition up to the ack level. It must be called with the lock held.
+func (p *persisterImpl) deleteItems(ctx context.Context, partition string, ackLevel int64) error {
+	if ackLevel == types.DefaultAckLevel {
+		return nil
+	}
+	if deleted, ok := p.deleted[partition]; ok && deleted >= ackLevel {
+		return nil
+	}
+
+	err := p.manager.DeleteMapQItems(ctx, &persistence.DeleteMapQItemsRequest{
+		QueueID:            p.queueID,
+		Partition:          partition,
+		InclusiveMaxOffset: ackLevel,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete processed items of partition %v: %w", partition, err)
+	}
+
+	p.deleted[partition] = ackLevel
+	return nil
+}
+
+// refreshVersion reads the current version of the state. It must be called with the lock held.
+func (p *persisterImpl) refreshVersion(ctx context.Context) error {
+	resp, err := p.manager.GetMapQState(ctx, &persistence.GetMapQStateRequest{QueueID: p.queueID})
+	if err != nil {
+		var notExistsErr *cadencetypes.EntityNotExistsError
+		if !errors.As(err, &notExistsErr) {
+			return err
+		}
+		p.version = 0
+	} else {
+		p.version = resp.State.Version
+	}
+
+	p.stale = false
+	return nil
+}
+
+func (p *persisterImpl) Fetch(ctx context.Context, partitions types.ItemPartitions, pageInfo types.PageInfo) ([]types.Item, error) {
+	resp, err := p.manager.ReadMapQItems(ctx, &persistence.ReadMapQItemsRequest{
+		QueueID:            p.queueID,
+		Partition:          types.PartitionPath(partitions),
+		ExclusiveMinOffset: pageInfo.ExclusiveMinOffset,
+		InclusiveMaxOffset: pageInfo.InclusiveMaxOffset,
+		PageSize:           pageInfo.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]types.Item, 0, len(resp.Items))
+	for _, mapQItem := range resp.Items {
+		item, err := p.codec.Decode(mapQItem.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode item at offset %v of partition %v: %w", mapQItem.Offset, mapQItem.Partition, err)
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
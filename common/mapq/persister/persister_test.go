@@ -0,0 +1,229 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package persister
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/mapq/types"
+	"github.com/uber/cadence/common/persistence"
+	cadencetypes "github.com/uber/cadence/common/types"
+)
+
+func TestPersist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	manager := persistence.NewMockMapQManager(ctrl)
+	p := New("queue", manager, testCodec{})
+
+	item := types.NewItemToPersist(
+		testItem{Domain: "d1", ItemOffset: 5},
+		types.NewItemPartitions([]string{"domain"}, map[string]any{"domain": "*"}),
+	)
+	manager.EXPECT().EnqueueMapQItems(gomock.Any(), &persistence.EnqueueMapQItemsRequest{
+		QueueID: "queue",
+		Items:   []*persistence.MapQItem{{Partition: "*/*", Offset: 5, Payload: []byte(`{"domain":"d1","offset":5}`)}},
+	}).Return(nil)
+
+	assert.NoError(t, p.Persist(context.Background(), []types.ItemToPersist{item}))
+}
+
+func TestPersistInBatches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	manager := persistence.NewMockMapQManager(ctrl)
+	p := New("queue", manager, testCodec{}, WithMaxBatchSize(2))
+
+	var items []types.ItemToPersist
+	for i := 0; i < 5; i++ {
+		items = append(items, types.NewItemToPersist(
+			testItem{Domain: "d1", ItemOffset: int64(i)},
+			types.NewItemPartitions([]string{"domain"}, map[string]any{"domain": "*"}),
+		))
+	}
+
+	var batchSizes []int
+	manager.EXPECT().EnqueueMapQItems(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, req *persistence.EnqueueMapQItemsRequest) error {
+		batchSizes = append(batchSizes, len(req.Items))
+		return nil
+	}).Times(3)
+
+	assert.NoError(t, p.Persist(context.Background(), items))
+	assert.Equal(t, []int{2, 2, 1}, batchSizes)
+}
+
+func TestFetch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	manager := persistence.NewMockMapQManager(ctrl)
+	p := New("queue", manager, testCodec{})
+
+	manager.EXPECT().ReadMapQItems(gomock.Any(), &persistence.ReadMapQItemsRequest{
+		QueueID:            "queue",
+		Partition:          "*/d1",
+		ExclusiveMinOffset: 4,
+		InclusiveMaxOffset: 10,
+		PageSize:           100,
+	}).Return(&persistence.ReadMapQItemsResponse{
+		Items: []*persistence.MapQItem{{Partition: "*/d1", Offset: 5, Payload: []byte(`{"domain":"d1","offset":5}`)}},
+	}, nil)
+
+	items, err := p.Fetch(
+		context.Background(),
+		types.NewItemPartitions([]string{"domain"}, map[string]any{"domain": "d1"}),
+		types.PageInfo{ExclusiveMinOffset: 4, InclusiveMaxOffset: 10, PageSize: 100},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []types.Item{testItem{Domain: "d1", ItemOffset: 5}}, items)
+}
+
+func TestOffsets(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no committed offsets", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		manager := persistence.NewMockMapQManager(ctrl)
+		p := New("queue", manager, testCodec{})
+
+		manager.EXPECT().GetMapQState(gomock.Any(), &persistence.GetMapQStateRequest{QueueID: "queue"}).Return(nil, &cadencetypes.EntityNotExistsError{})
+		offsets, err := p.GetOffsets(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, types.NewOffsets(), offsets)
+
+		// first commit creates the state
+		offsets.Partitions["*/*"] = 7
+		manager.EXPECT().UpdateMapQState(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, req *persistence.UpdateMapQStateRequest) error {
+			assert.Equal(t, int64(0), req.PreviousVersion)
+			assert.Equal(t, int64(1), req.State.Version)
+			return nil
+		})
+		manager.EXPECT().DeleteMapQItems(gomock.Any(), &persistence.DeleteMapQItemsRequest{QueueID: "queue", Partition: "*/*", InclusiveMaxOffset: 7}).Return(nil)
+		assert.NoError(t, p.CommitOffsets(ctx, offsets))
+	})
+
+	t.Run("commit on top of existing offsets", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		manager := persistence.NewMockMapQManager(ctrl)
+		p := New("queue", manager, testCodec{})
+
+		stored := &types.Offsets{Partitions: map[string]int64{"*/*": 3, "*/d1": types.DefaultAckLevel}}
+		data, err := json.Marshal(stored)
+		require.NoError(t, err)
+		manager.EXPECT().GetMapQState(gomock.Any(), gomock.Any()).Return(&persistence.GetMapQStateResponse{
+			State: &persistence.MapQState{QueueID: "queue", State: persistence.NewDataBlob(data, constants.EncodingTypeJSON), Version: 4},
+		}, nil)
+		offsets, err := p.GetOffsets(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, stored.Partitions, offsets.Partitions)
+
+		offsets.Partitions["*/*"] = 8
+		offsets.Retired["*/d2"] = 12
+		manager.EXPECT().UpdateMapQState(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, req *persistence.UpdateMapQStateRequest) error {
+			assert.Equal(t, int64(4), req.PreviousVersion)
+			assert.Equal(t, int64(5), req.State.Version)
+			return nil
+		})
+		// partitions without a committed offset don't have anything to delete
+		manager.EXPECT().DeleteMapQItems(gomock.Any(), &persistence.DeleteMapQItemsRequest{QueueID: "queue", Partition: "*/*", InclusiveMaxOffset: 8}).Return(nil)
+		manager.EXPECT().DeleteMapQItems(gomock.Any(), &persistence.DeleteMapQItemsRequest{QueueID: "queue", Partition: "*/d2", InclusiveMaxOffset: 12}).Return(nil)
+		assert.NoError(t, p.CommitOffsets(ctx, offsets))
+	})
+
+	t.Run("concurrent update", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		manager := persistence.NewMockMapQManager(ctrl)
+		p := New("queue", manager, testCodec{})
+
+		manager.EXPECT().UpdateMapQState(gomock.Any(), gomock.Any()).Return(&persistence.ConditionFailedError{})
+		err := p.CommitOffsets(ctx, types.NewOffsets())
+		assert.IsType(t, &persistence.ConditionFailedError{}, err)
+
+		// the version is read again before the next commit
+		manager.EXPECT().GetMapQState(gomock.Any(), gomock.Any()).Return(&persistence.GetMapQStateResponse{
+			State: &persistence.MapQState{QueueID: "queue", Version: 3},
+		}, nil)
+		manager.EXPECT().UpdateMapQState(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, req *persistence.UpdateMapQStateRequest) error {
+			assert.Equal(t, int64(3), req.PreviousVersion)
+			return nil
+		})
+		assert.NoError(t, p.CommitOffsets(ctx, types.NewOffsets()))
+	})
+
+	t.Run("unchanged partitions are not deleted again", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		manager := persistence.NewMockMapQManager(ctrl)
+		p := New("queue", manager, testCodec{})
+
+		offsets := types.NewOffsets()
+		offsets.Partitions["*/*"] = 7
+		manager.EXPECT().UpdateMapQState(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		manager.EXPECT().DeleteMapQItems(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		assert.NoError(t, p.CommitOffsets(ctx, offsets))
+		assert.NoError(t, p.CommitOffsets(ctx, offsets))
+	})
+
+	t.Run("get offsets error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		manager := persistence.NewMockMapQManager(ctrl)
+		p := New("queue", manager, testCodec{})
+
+		manager.EXPECT().GetMapQState(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+		_, err := p.GetOffsets(ctx)
+		assert.Error(t, err)
+	})
+}
+
+type testItem struct {
+	Domain     string `json:"domain"`
+	ItemOffset int64  `json:"offset"`
+}
+
+func (i testItem) GetAttribute(key string) any {
+	return i.Domain
+}
+
+func (i testItem) Offset() int64 {
+	return i.ItemOffset
+}
+
+func (i testItem) String() string {
+	return fmt.Sprintf("testItem{domain:%v, offset:%v}", i.Domain, i.ItemOffset)
+}
+
+type testCodec struct{}
+
+func (testCodec) Encode(item types.Item) ([]byte, error) {
+	return json.Marshal(testItem{Domain: item.GetAttribute("domain").(string), ItemOffset: item.Offset()})
+}
+
+func (testCodec) Decode(payload []byte) (types.Item, error) {
+	var item testItem
+	err := json.Unmarshal(payload, &item)
+	return item, err
+}
@@ -0,0 +1,251 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package mapq
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/goleak"
+
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/mapq/types"
+	"github.com/uber/cadence/common/metrics"
+)
+
+// TestSoak enqueues a skewed load and verifies that the hot domain is split into its own queue
+// and every item is delivered at least once.
+func TestSoak(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping soak test in short mode")
+	}
+	defer goleak.VerifyNone(t)
+
+	persister := newSoakPersister()
+	consumerFactory := &soakConsumerFactory{processed: map[int64]bool{}}
+	cl, err := New(
+		testlogger.New(t),
+		metrics.NoopScope,
+		WithPersister(persister),
+		WithConsumerFactory(consumerFactory),
+		WithPartitions([]string{"domain"}),
+		WithPolicies([]types.NodePolicy{
+			{
+				Path: "*",
+				SplitPolicy: &types.SplitPolicy{
+					Skew:  &types.SkewPolicy{Ratio: 0.5, MinRPS: 10},
+					Merge: &types.MergePolicy{BelowRPS: 1},
+				},
+			},
+			{
+				Path:           "*/.",
+				DispatchPolicy: &types.DispatchPolicy{Concurrency: 20},
+			},
+		}),
+		WithPolicyEvaluationInterval(100*time.Millisecond),
+		WithOffsetCommitInterval(100*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+
+	ctx := context.Background()
+	if err := cl.Start(ctx); err != nil {
+		t.Fatalf("Start() error: %v", err)
+	}
+
+	var offset int64
+	total := 0
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		var items []types.Item
+		for i := 0; i < 10; i++ {
+			domain := fmt.Sprintf("cold-%d", rand.Intn(5))
+			if rand.Intn(10) < 8 {
+				domain = "hot"
+			}
+			items = append(items, &soakItem{domain: domain, offset: atomic.AddInt64(&offset, 1)})
+		}
+		if _, err := cl.Enqueue(ctx, items); err != nil {
+			t.Fatalf("Enqueue() error: %v", err)
+		}
+		total += len(items)
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	processedAll := func() bool { return consumerFactory.processedCount() == total }
+	waitUntil := time.Now().Add(10 * time.Second)
+	for !processedAll() && time.Now().Before(waitUntil) {
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	if err := cl.Stop(ctx); err != nil {
+		t.Fatalf("Stop() error: %v", err)
+	}
+
+	if got := consumerFactory.processedCount(); got != total {
+		t.Errorf("processed %d unique items, want %d", got, total)
+	}
+	if !persister.sawPartition("*/hot") {
+		t.Errorf("hot domain was never split into its own partition")
+	}
+}
+
+type soakItem struct {
+	domain string
+	offset int64
+}
+
+func (i *soakItem) GetAttribute(key string) any {
+	if key == "domain" {
+		return i.domain
+	}
+	return nil
+}
+
+func (i *soakItem) Offset() int64 {
+	return i.offset
+}
+
+func (i *soakItem) String() string {
+	return fmt.Sprintf("soakItem{domain: %s, offset: %d}", i.domain, i.offset)
+}
+
+type soakPersister struct {
+	sync.Mutex
+	items   map[string][]types.Item
+	offsets *types.Offsets
+}
+
+func newSoakPersister() *soakPersister {
+	return &soakPersister{items: map[string][]types.Item{}}
+}
+
+func (p *soakPersister) Persist(ctx context.Context, items []types.ItemToPersist) error {
+	p.Lock()
+	defer p.Unlock()
+
+	for _, item := range items {
+		path := types.PartitionPath(item)
+		p.items[path] = append(p.items[path], item)
+		sort.Slice(p.items[path], func(i, j int) bool { return p.items[path][i].Offset() < p.items[path][j].Offset() })
+	}
+	return nil
+}
+
+func (p *soakPersister) GetOffsets(context.Context) (*types.Offsets, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	return p.offsets, nil
+}
+
+func (p *soakPersister) CommitOffsets(ctx context.Context, offsets *types.Offsets) error {
+	p.Lock()
+	defer p.Unlock()
+
+	p.offsets = offsets
+	deleteUpTo := func(path string, ackLevel int64) {
+		items := p.items[path]
+		idx := sort.Search(len(items), func(i int) bool { return items[i].Offset() > ackLevel })
+		p.items[path] = items[idx:]
+	}
+	for path, ackLevel := range offsets.Partitions {
+		deleteUpTo(path, ackLevel)
+	}
+	for path, ackLevel := range offsets.Retired {
+		deleteUpTo(path, ackLevel)
+	}
+	return nil
+}
+
+func (p *soakPersister) Fetch(ctx context.Context, partitions types.ItemPartitions, pageInfo types.PageInfo) ([]types.Item, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	var result []types.Item
+	for _, item := range p.items[types.PartitionPath(partitions)] {
+		if item.Offset() <= pageInfo.ExclusiveMinOffset || item.Offset() > pageInfo.InclusiveMaxOffset {
+			continue
+		}
+		result = append(result, item)
+		if len(result) == pageInfo.PageSize {
+			break
+		}
+	}
+	return result, nil
+}
+
+func (p *soakPersister) sawPartition(path string) bool {
+	p.Lock()
+	defer p.Unlock()
+
+	_, ok := p.items[path]
+	return ok
+}
+
+type soakConsumerFactory struct {
+	sync.Mutex
+	processed map[int64]bool
+}
+
+func (f *soakConsumerFactory) New(types.ItemPartitions) (types.Consumer, error) {
+	return &soakConsumer{factory: f}, nil
+}
+
+func (f *soakConsumerFactory) Stop(context.Context) error {
+	return nil
+}
+
+func (f *soakConsumerFactory) processedCount() int {
+	f.Lock()
+	defer f.Unlock()
+
+	return len(f.processed)
+}
+
+type soakConsumer struct {
+	factory *soakConsumerFactory
+}
+
+func (c *soakConsumer) Start(context.Context) error {
+	return nil
+}
+
+func (c *soakConsumer) Stop(context.Context) error {
+	return nil
+}
+
+func (c *soakConsumer) Process(ctx context.Context, item types.Item) error {
+	c.factory.Lock()
+	defer c.factory.Unlock()
+
+	c.factory.processed[item.Offset()] = true
+	return nil
+}
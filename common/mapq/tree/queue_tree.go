@@ -26,23 +26,69 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/mapq/types"
 	"github.com/uber/cadence/common/metrics"
 )
 
+const (
+	defaultPolicyEvaluationInterval = 10 * time.Second
+	defaultOffsetCommitInterval     = 10 * time.Second
+)
+
+type Options func(*QueueTree)
+
+// WithTimeSource sets the time source used by the tree and the dispatchers
+func WithTimeSource(ts clock.TimeSource) Options {
+	return func(t *QueueTree) {
+		t.timeSource = ts
+	}
+}
+
+// WithPolicyEvaluationInterval sets how often enqueue rates are evaluated against split policies
+func WithPolicyEvaluationInterval(d time.Duration) Options {
+	return func(t *QueueTree) {
+		t.policyEvaluationInterval = d
+	}
+}
+
+// WithOffsetCommitInterval sets how often the ack levels of leaf nodes are committed to the persister
+func WithOffsetCommitInterval(d time.Duration) Options {
+	return func(t *QueueTree) {
+		t.offsetCommitInterval = d
+	}
+}
+
 // QueueTree is a tree structure that represents the queue structure for MAPQ
 type QueueTree struct {
-	originalLogger  log.Logger
-	logger          log.Logger
-	scope           metrics.Scope
-	partitions      []string
-	policyCol       types.NodePolicyCollection
-	persister       types.Persister
-	consumerFactory types.ConsumerFactory
-	root            *QueueTreeNode
+	originalLogger           log.Logger
+	logger                   log.Logger
+	scope                    metrics.Scope
+	partitions               []string
+	policyCol                types.NodePolicyCollection
+	persister                types.Persister
+	consumerFactory          types.ConsumerFactory
+	timeSource               clock.TimeSource
+	policyEvaluationInterval time.Duration
+	offsetCommitInterval     time.Duration
+
+	ctx       context.Context
+	cancelCtx context.CancelFunc
+	wg        sync.WaitGroup
+
+	// RWMutex guards the tree structure. Enqueue holds the read lock while routing and persisting items
+	// so leaf nodes can't be merged and offsets can't be committed before the dispatchers are notified.
+	sync.RWMutex
+	root           *QueueTreeNode
+	started        bool
+	lastEvaluation time.Time
+	// retired holds the ack levels of leaf nodes removed by merges since the last commit
+	retired map[string]int64
 }
 
 func New(
@@ -52,46 +98,96 @@ func New(
 	policies []types.NodePolicy,
 	persister types.Persister,
 	consumerFactory types.ConsumerFactory,
+	opts ...Options,
 ) (*QueueTree, error) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
 	t := &QueueTree{
-		originalLogger:  logger,
-		logger:          logger.WithTags(tag.ComponentMapQTree),
-		scope:           scope,
-		partitions:      partitions,
-		policyCol:       types.NewNodePolicyCollection(policies),
-		persister:       persister,
-		consumerFactory: consumerFactory,
+		originalLogger:           logger,
+		logger:                   logger.WithTags(tag.ComponentMapQTree),
+		scope:                    scope,
+		partitions:               partitions,
+		policyCol:                types.NewNodePolicyCollection(policies),
+		persister:                persister,
+		consumerFactory:          consumerFactory,
+		timeSource:               clock.NewRealTimeSource(),
+		policyEvaluationInterval: defaultPolicyEvaluationInterval,
+		offsetCommitInterval:     defaultOffsetCommitInterval,
+		ctx:                      ctx,
+		cancelCtx:                cancelCtx,
+		retired:                  map[string]int64{},
+	}
+
+	for _, opt := range opts {
+		opt(t)
 	}
 
 	return t, t.init()
 }
 
-// Start the dispatchers for all leaf nodes
+// Start the dispatchers for all leaf nodes starting from the last committed offsets.
+// Leaf nodes created by dynamic splits before the restart are recreated.
 func (t *QueueTree) Start(ctx context.Context) error {
 	t.logger.Info("Starting MAPQ tree", tag.Dynamic("tree", t.String()))
-	err := t.root.Start(ctx, t.consumerFactory, nil, map[string]any{})
+
+	offsets, err := t.persister.GetOffsets(ctx)
 	if err != nil {
+		return fmt.Errorf("failed to get offsets: %w", err)
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	if offsets != nil {
+		for path := range offsets.Partitions {
+			if err := t.restoreLeaf(path); err != nil {
+				return fmt.Errorf("failed to restore leaf node %s: %w", path, err)
+			}
+		}
+	}
+
+	if err := t.root.Start(ctx, t, offsets); err != nil {
 		return fmt.Errorf("failed to start root node: %w", err)
 	}
 
+	t.started = true
+	t.lastEvaluation = t.timeSource.Now()
+	t.wg.Add(1)
+	go t.loop()
+
 	t.logger.Info("Started MAPQ tree")
 	return nil
 }
 
-// Stop the dispatchers for all leaf nodes
+// Stop the dispatchers for all leaf nodes and commit their offsets
 func (t *QueueTree) Stop(ctx context.Context) error {
 	t.logger.Info("Stopping MAPQ tree", tag.Dynamic("tree", t.String()))
 
+	t.cancelCtx()
+	t.wg.Wait()
+
+	t.Lock()
+	defer t.Unlock()
+
 	err := t.root.Stop(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to stop nodes: %w", err)
 	}
 
+	if t.started {
+		if err := t.commitOffsetsLocked(ctx); err != nil {
+			return fmt.Errorf("failed to commit offsets: %w", err)
+		}
+		t.started = false
+	}
+
 	t.logger.Info("Stopped MAPQ tree")
 	return nil
 }
 
 func (t *QueueTree) String() string {
+	t.RLock()
+	defer t.RUnlock()
+
 	var sb strings.Builder
 	var nodes []*QueueTreeNode
 	nodes = append(nodes, t.root)
@@ -109,26 +205,65 @@ func (t *QueueTree) String() string {
 }
 
 func (t *QueueTree) Enqueue(ctx context.Context, items []types.Item) ([]types.ItemToPersist, error) {
+	t.RLock()
+	defer t.RUnlock()
+
 	if t.root == nil {
 		return nil, fmt.Errorf("root node is nil")
 	}
 
 	var itemsToPersist []types.ItemToPersist
+	var leaves []*QueueTreeNode
 	for _, item := range items {
-		itemToPersist, err := t.root.Enqueue(ctx, item, nil, map[string]any{})
+		itemToPersist, leaf, err := t.root.Enqueue(ctx, item, nil, map[string]any{})
 		if err != nil {
 			return nil, err
 		}
 		itemsToPersist = append(itemsToPersist, itemToPersist)
+		leaves = append(leaves, leaf)
+	}
+
+	if err := t.persister.Persist(ctx, itemsToPersist); err != nil {
+		return itemsToPersist, err
+	}
+
+	for i, leaf := range leaves {
+		if leaf.Dispatcher != nil {
+			leaf.Dispatcher.Notify(itemsToPersist[i].Offset())
+		}
+	}
+
+	return itemsToPersist, nil
+}
+
+// CommitOffsets commits the ack levels of all leaf nodes to the persister
+func (t *QueueTree) CommitOffsets(ctx context.Context) error {
+	t.Lock()
+	defer t.Unlock()
+
+	return t.commitOffsetsLocked(ctx)
+}
+
+// EvaluatePolicies splits and merges nodes based on the enqueue rates since the last evaluation
+func (t *QueueTree) EvaluatePolicies() {
+	t.Lock()
+	defer t.Unlock()
+
+	now := t.timeSource.Now()
+	elapsed := now.Sub(t.lastEvaluation).Seconds()
+	t.lastEvaluation = now
+	if elapsed <= 0 {
+		return
 	}
 
-	return itemsToPersist, t.persister.Persist(ctx, itemsToPersist)
+	t.evaluateNode(t.root, elapsed)
 }
 
 func (t *QueueTree) init() error {
 	t.root = &QueueTreeNode{
-		Path:     "*", // Root node
-		Children: map[any]*QueueTreeNode{},
+		Path:            "*", // Root node
+		Children:        map[any]*QueueTreeNode{},
+		partitionValues: map[string]any{},
 	}
 
 	if err := t.root.Init(t.originalLogger, t.scope, t.policyCol, t.partitions); err != nil {
@@ -169,6 +304,151 @@ func (t *QueueTree) constructInitialNodes(n *QueueTreeNode) error {
 	return nil
 }
 
+func (t *QueueTree) loop() {
+	defer t.wg.Done()
+
+	evaluationTicker := t.timeSource.NewTicker(t.policyEvaluationInterval)
+	defer evaluationTicker.Stop()
+	commitTicker := t.timeSource.NewTicker(t.offsetCommitInterval)
+	defer commitTicker.Stop()
+
+	for {
+		select {
+		case <-t.ctx.Done():
+			return
+		case <-evaluationTicker.Chan():
+			t.EvaluatePolicies()
+		case <-commitTicker.Chan():
+			if err := t.CommitOffsets(t.ctx); err != nil {
+				t.logger.Warn("Failed to commit offsets", tag.Error(err))
+			}
+		}
+	}
+}
+
+// commitOffsetsLocked commits the offsets while holding the write lock.
+// Persister deletes the items at or below the committed offsets so an enqueue must not move an ack level back in the meantime.
+func (t *QueueTree) commitOffsetsLocked(ctx context.Context) error {
+	offsets := types.NewOffsets()
+	t.root.collectOffsets(offsets.Partitions)
+	for path, ackLevel := range t.retired {
+		offsets.Retired[path] = ackLevel
+	}
+
+	if err := t.persister.CommitOffsets(ctx, offsets); err != nil {
+		return err
+	}
+
+	t.retired = map[string]int64{}
+	return nil
+}
+
+func (t *QueueTree) evaluateNode(n *QueueTreeNode, elapsed float64) {
+	catchAllCounts := n.resetStats()
+	if len(n.Children) == 0 {
+		return
+	}
+
+	sp := n.NodePolicy.SplitPolicy
+	if sp != nil && !sp.Disabled {
+		var catchAllTotal int64
+		for _, cnt := range catchAllCounts {
+			catchAllTotal += cnt
+		}
+		catchAllRPS := float64(catchAllTotal) / elapsed
+
+		splitNow := map[string]bool{}
+		for val, cnt := range catchAllCounts {
+			if n.findChild(val) != nil || !sp.ShouldSplit(float64(cnt)/elapsed, catchAllRPS) {
+				continue
+			}
+			if err := t.split(n, val); err != nil {
+				t.logger.Error("Failed to split node", tag.Dynamic("path", n.Path), tag.Dynamic("value", val), tag.Error(err))
+				continue
+			}
+			splitNow[val] = true
+		}
+
+		for key, child := range n.Children {
+			if !child.dynamic || splitNow[fmt.Sprint(key)] {
+				continue
+			}
+			if sp.ShouldMerge(float64(child.enqueuedCount())/elapsed) && child.idle() {
+				t.merge(n, key, child)
+			}
+		}
+	}
+
+	for _, child := range n.Children {
+		t.evaluateNode(child, elapsed)
+	}
+}
+
+func (t *QueueTree) split(n *QueueTreeNode, val string) error {
+	child, err := n.addChild(val, t.policyCol, t.partitions)
+	if err != nil {
+		return err
+	}
+	child.dynamic = true
+
+	if err := t.constructInitialNodes(child); err != nil {
+		delete(n.Children, val)
+		return err
+	}
+
+	if err := child.Start(t.ctx, t, nil); err != nil {
+		child.Stop(context.Background())
+		delete(n.Children, val)
+		return err
+	}
+
+	t.logger.Info("Split node", tag.Dynamic("path", child.Path))
+	return nil
+}
+
+func (t *QueueTree) merge(n *QueueTreeNode, key any, child *QueueTreeNode) {
+	ackLevels := map[string]int64{}
+	child.collectOffsets(ackLevels)
+	if err := child.Stop(context.Background()); err != nil {
+		t.logger.Error("Failed to stop merged node", tag.Dynamic("path", child.Path), tag.Error(err))
+		return
+	}
+
+	for path, ackLevel := range ackLevels {
+		t.retired[path] = ackLevel
+	}
+	delete(n.Children, key)
+	t.logger.Info("Merged node", tag.Dynamic("path", child.Path))
+}
+
+// restoreLeaf creates the nodes on the path of a leaf node if they don't exist, i.e. they were created by dynamic splits
+func (t *QueueTree) restoreLeaf(path string) error {
+	parts := strings.Split(path, "/")
+	if len(parts)-1 != len(t.partitions) {
+		t.logger.Warn("Skipping offsets of unknown leaf node", tag.Dynamic("path", path))
+		return nil
+	}
+
+	n := t.root
+	for _, part := range parts[1:] {
+		child := n.findChild(part)
+		if child == nil {
+			var err error
+			child, err = n.addChild(part, t.policyCol, t.partitions)
+			if err != nil {
+				return err
+			}
+			child.dynamic = true
+			if err := t.constructInitialNodes(child); err != nil {
+				return err
+			}
+		}
+		n = child
+	}
+
+	return nil
+}
+
 func nodeLevel(path string) int {
 	return len(strings.Split(path, "/")) - 1
 }
@@ -25,6 +25,7 @@ package tree
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -65,24 +66,48 @@ type QueueTreeNode struct {
 
 	// The dispatcher for this node. Only leaf nodes have dispatcher
 	Dispatcher *dispatcher.Dispatcher
+
+	// dynamic is true if the node was created by a split policy at runtime. Only dynamic nodes are merged back.
+	dynamic bool
+
+	// partitionValues are the attribute values on the path to this node keyed by partition key
+	partitionValues map[string]any
+
+	stats nodeStats
 }
 
+// nodeStats tracks enqueues since the last policy evaluation
+type nodeStats struct {
+	sync.Mutex
+	enqueued int64
+	// catchAll counts the items routed to the catch-all child by their partition value
+	catchAll map[string]int64
+}
+
+// Start creates a dispatcher for each leaf node in the subtree starting from its committed ack level.
+// offsets can be nil in which case dispatchers start from the beginning of their partition.
 func (n *QueueTreeNode) Start(
 	ctx context.Context,
-	consumerFactory types.ConsumerFactory,
-	partitions []string,
-	partitionMap map[string]any,
+	t *QueueTree,
+	offsets *types.Offsets,
 ) error {
 	n.logger.Info("Starting node", tag.Dynamic("node", n.String()))
 
 	// If there are no children then this is a leaf node
 	if len(n.Children) == 0 {
 		n.logger.Info("Creating consumer and starting a new dispatcher for leaf node")
-		c, err := consumerFactory.New(types.NewItemPartitions(partitions, partitionMap))
+		partitions := types.NewItemPartitions(t.partitions[:nodeLevel(n.Path)], n.partitionValues)
+		c, err := t.consumerFactory.New(partitions)
 		if err != nil {
 			return err
 		}
-		d := dispatcher.New(c)
+
+		var policy types.DispatchPolicy
+		if n.NodePolicy.DispatchPolicy != nil {
+			policy = *n.NodePolicy.DispatchPolicy
+		}
+
+		d := dispatcher.New(n.originalLogger, n.scope, c, t.persister, partitions, policy, offsets.AckLevel(n.Path), t.timeSource)
 		if err := d.Start(ctx); err != nil {
 			return err
 		}
@@ -91,8 +116,7 @@ func (n *QueueTreeNode) Start(
 	}
 
 	for _, child := range n.Children {
-		partitionMap[n.PartitionKey] = child.AttributeVal
-		err := child.Start(ctx, consumerFactory, partitions, partitionMap)
+		err := child.Start(ctx, t, offsets)
 		if err != nil {
 			return fmt.Errorf("failed to start child %s: %w", child.Path, err)
 		}
@@ -124,10 +148,14 @@ func (n *QueueTreeNode) Enqueue(
 	item types.Item,
 	partitions []string,
 	partitionMap map[string]any,
-) (types.ItemToPersist, error) {
+) (types.ItemToPersist, *QueueTreeNode, error) {
+	n.stats.Lock()
+	n.stats.enqueued++
+	n.stats.Unlock()
+
 	// If there are no children then this is a leaf node
 	if len(n.Children) == 0 {
-		return types.NewItemToPersist(item, types.NewItemPartitions(partitions, partitionMap)), nil
+		return types.NewItemToPersist(item, types.NewItemPartitions(partitions, partitionMap)), n, nil
 	}
 
 	// Add the attribute value to queueNodePathParts
@@ -137,18 +165,91 @@ func (n *QueueTreeNode) Enqueue(
 
 	child, ok := n.Children[partitionVal]
 	if !ok {
-		// TODO: thread safety missing
+		// children created by dynamic splits are keyed by the string form of the value
+		child, ok = n.Children[fmt.Sprint(partitionVal)]
+	}
+	if !ok {
 		child, ok = n.Children["*"]
 		partitionMap[n.PartitionKey] = "*"
 		if !ok {
 			// catch-all nodes are created during initalization so this should never happen
-			return nil, fmt.Errorf("no child found for attribute %v in node %v", partitionVal, n.Path)
+			return nil, nil, fmt.Errorf("no child found for attribute %v in node %v", partitionVal, n.Path)
 		}
+		n.recordCatchAll(partitionVal)
 	}
 
 	return child.Enqueue(ctx, item, partitions, partitionMap)
 }
 
+// findChild returns the child whose attribute value has the given string form
+func (n *QueueTreeNode) findChild(val string) *QueueTreeNode {
+	if ch, ok := n.Children[val]; ok {
+		return ch
+	}
+	for key, ch := range n.Children {
+		if fmt.Sprint(key) == val {
+			return ch
+		}
+	}
+	return nil
+}
+
+func (n *QueueTreeNode) recordCatchAll(partitionVal any) {
+	n.stats.Lock()
+	defer n.stats.Unlock()
+
+	if n.stats.catchAll == nil {
+		n.stats.catchAll = map[string]int64{}
+	}
+	n.stats.catchAll[fmt.Sprint(partitionVal)]++
+}
+
+func (n *QueueTreeNode) enqueuedCount() int64 {
+	n.stats.Lock()
+	defer n.stats.Unlock()
+
+	return n.stats.enqueued
+}
+
+// resetStats resets the enqueue stats and returns the catch-all counts collected since the last reset
+func (n *QueueTreeNode) resetStats() map[string]int64 {
+	n.stats.Lock()
+	defer n.stats.Unlock()
+
+	catchAll := n.stats.catchAll
+	n.stats.enqueued = 0
+	n.stats.catchAll = nil
+	return catchAll
+}
+
+// collectOffsets adds the ack levels of leaf dispatchers in the subtree to ackLevels keyed by node path
+func (n *QueueTreeNode) collectOffsets(ackLevels map[string]int64) {
+	if len(n.Children) == 0 {
+		if n.Dispatcher != nil {
+			ackLevels[n.Path] = n.Dispatcher.AckLevel()
+		}
+		return
+	}
+
+	for _, child := range n.Children {
+		child.collectOffsets(ackLevels)
+	}
+}
+
+// idle returns true if all leaf dispatchers in the subtree have processed every item they have read
+func (n *QueueTreeNode) idle() bool {
+	if len(n.Children) == 0 {
+		return n.Dispatcher != nil && n.Dispatcher.Idle()
+	}
+
+	for _, child := range n.Children {
+		if !child.idle() {
+			return false
+		}
+	}
+	return true
+}
+
 func (n *QueueTreeNode) String() string {
 	return fmt.Sprintf("QueueTreeNode{Path: %q, AttributeKey: %v, AttributeVal: %v, NodePolicy: %s, Num Children: %d}", n.Path, n.AttributeKey, n.AttributeVal, n.NodePolicy, len(n.Children))
 }
@@ -177,11 +278,18 @@ func (n *QueueTreeNode) Init(logger log.Logger, scope metrics.Scope, policyCol t
 
 func (n *QueueTreeNode) addChild(attrVal any, policyCol types.NodePolicyCollection, partitions []string) (*QueueTreeNode, error) {
 	path := fmt.Sprintf("%s/%v", n.Path, attrVal)
+	partitionValues := make(map[string]any, len(n.partitionValues)+1)
+	for k, v := range n.partitionValues {
+		partitionValues[k] = v
+	}
+	partitionValues[n.PartitionKey] = attrVal
+
 	ch := &QueueTreeNode{
-		Path:         path,
-		AttributeKey: n.PartitionKey,
-		AttributeVal: attrVal,
-		Children:     map[any]*QueueTreeNode{},
+		Path:            path,
+		AttributeKey:    n.PartitionKey,
+		AttributeVal:    attrVal,
+		Children:        map[any]*QueueTreeNode{},
+		partitionValues: partitionValues,
 	}
 
 	if err := ch.Init(n.originalLogger, n.scope, policyCol, partitions); err != nil {
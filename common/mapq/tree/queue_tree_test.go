@@ -25,11 +25,13 @@ package tree
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"go.uber.org/goleak"
 	"go.uber.org/mock/gomock"
 
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/mapq/types"
 	"github.com/uber/cadence/common/metrics"
@@ -50,12 +52,15 @@ func TestStartStop(t *testing.T) {
 	// - */*/*/*
 	consumerFactory.EXPECT().New(gomock.Any()).Return(consumer, nil).Times(7)
 
+	persister := types.NewMockPersister(ctrl)
+	expectPersisterLifecycle(persister)
+
 	tree, err := New(
 		testlogger.New(t),
 		metrics.NoopScope,
 		[]string{"type", "sub-type", "domain"},
 		getTestPolicies(),
-		types.NewMockPersister(ctrl),
+		persister,
 		consumerFactory,
 	)
 	if err != nil {
@@ -163,6 +168,7 @@ func TestEnqueue(t *testing.T) {
 				gotItemsToPersistByPersister = itemsToPersist
 				return tc.persistErr
 			})
+			expectPersisterLifecycle(persister)
 
 			tree, err := New(
 				testlogger.New(t),
@@ -211,15 +217,122 @@ func TestEnqueue(t *testing.T) {
 	}
 }
 
+func TestSplitAndMerge(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctrl := gomock.NewController(t)
+	consumerFactory := types.NewMockConsumerFactory(ctrl)
+	consumerFactory.EXPECT().New(gomock.Any()).Return(types.NewMockConsumer(ctrl), nil).AnyTimes()
+
+	var committed []*types.Offsets
+	persister := types.NewMockPersister(ctrl)
+	persister.EXPECT().GetOffsets(gomock.Any()).Return(types.NewOffsets(), nil)
+	persister.EXPECT().Persist(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	persister.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	persister.EXPECT().CommitOffsets(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, offsets *types.Offsets) error {
+		committed = append(committed, offsets)
+		return nil
+	}).AnyTimes()
+
+	timeSource := clock.NewMockedTimeSource()
+	tree, err := New(
+		testlogger.New(t),
+		metrics.NoopScope,
+		[]string{"domain"},
+		[]types.NodePolicy{
+			{
+				Path: "*",
+				SplitPolicy: &types.SplitPolicy{
+					Skew:  &types.SkewPolicy{Ratio: 0.5, MinRPS: 5},
+					Merge: &types.MergePolicy{BelowRPS: 2},
+				},
+			},
+		},
+		persister,
+		consumerFactory,
+		WithTimeSource(timeSource),
+		WithPolicyEvaluationInterval(time.Hour),
+		WithOffsetCommitInterval(time.Hour),
+	)
+	if err != nil {
+		t.Fatalf("failed to create queue tree: %v", err)
+	}
+
+	if err := tree.Start(context.Background()); err != nil {
+		t.Fatalf("failed to start queue tree: %v", err)
+	}
+	defer tree.Stop(context.Background())
+
+	var items []types.Item
+	for i := 0; i < 10; i++ {
+		items = append(items, mockItem(t, map[string]any{"domain": "hot"}))
+	}
+	items = append(items, mockItem(t, map[string]any{"domain": "cold"}))
+	if _, err := tree.Enqueue(context.Background(), items); err != nil {
+		t.Fatalf("Enqueue() failed: %v", err)
+	}
+
+	timeSource.Advance(time.Second)
+	tree.EvaluatePolicies()
+
+	if tree.root.findChild("hot") == nil {
+		t.Fatalf("hot domain is not split out: %s", tree)
+	}
+	if tree.root.findChild("cold") != nil {
+		t.Fatalf("cold domain should not be split out: %s", tree)
+	}
+
+	got, err := tree.Enqueue(context.Background(), []types.Item{mockItem(t, map[string]any{"domain": "hot"})})
+	if err != nil {
+		t.Fatalf("Enqueue() failed: %v", err)
+	}
+	if diff := cmp.Diff("*/hot", types.PartitionPath(got[0])); diff != "" {
+		t.Errorf("partition path mismatch (-want +got):\n%s", diff)
+	}
+
+	// wait for the dispatcher of the new node to catch up so it can be merged
+	deadline := time.Now().Add(5 * time.Second)
+	for !tree.root.findChild("hot").idle() {
+		if time.Now().After(deadline) {
+			t.Fatal("dispatcher of the split node did not become idle")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	timeSource.Advance(time.Second)
+	tree.EvaluatePolicies()
+
+	if tree.root.findChild("hot") != nil {
+		t.Fatalf("hot domain is not merged back: %s", tree)
+	}
+
+	if err := tree.CommitOffsets(context.Background()); err != nil {
+		t.Fatalf("CommitOffsets() failed: %v", err)
+	}
+	last := committed[len(committed)-1]
+	if _, ok := last.Retired["*/hot"]; !ok {
+		t.Errorf("merged node is not retired: %s", last)
+	}
+	if _, ok := last.Partitions["*/hot"]; ok {
+		t.Errorf("merged node is still committed as a partition: %s", last)
+	}
+}
+
 func mockItem(t *testing.T, attributes map[string]any) types.Item {
 	item := types.NewMockItem(gomock.NewController(t))
 	item.EXPECT().GetAttribute(gomock.Any()).DoAndReturn(func(key string) any {
 		return attributes[key]
 	}).AnyTimes()
 	item.EXPECT().String().Return("mockitem").AnyTimes()
+	item.EXPECT().Offset().Return(int64(0)).AnyTimes()
 	return item
 }
 
+func expectPersisterLifecycle(persister *types.MockPersister) {
+	persister.EXPECT().GetOffsets(gomock.Any()).Return(types.NewOffsets(), nil)
+	persister.EXPECT().Fetch(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	persister.EXPECT().CommitOffsets(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+}
+
 func getTestPolicies() []types.NodePolicy {
 	return []types.NodePolicy{
 		{
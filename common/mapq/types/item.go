@@ -24,7 +24,10 @@ package types
 
 //go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination item_mock.go -package types github.com/uber/cadence/common/mapq/types Item
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type Item interface {
 	// GetAttribute returns the value of the attribute key.
@@ -68,6 +71,26 @@ type ItemPartitions interface {
 	String() string
 }
 
+// PartitionPath returns the path of the leaf node that owns the given partitions. e.g. "*/timer/*/domain1"
+// It's used by persisters as the physical partition of the queue.
+func PartitionPath(p ItemPartitions) string {
+	var sb strings.Builder
+	sb.WriteString("*")
+	for _, key := range p.GetPartitionKeys() {
+		sb.WriteString("/")
+		sb.WriteString(fmt.Sprintf("%v", p.GetPartitionValue(key)))
+	}
+	return sb.String()
+}
+
+// UnwrapItem returns the item passed to NewItemToPersist. Other items are returned as is.
+func UnwrapItem(item Item) Item {
+	if i, ok := item.(*defaultItemToPersist); ok {
+		return i.item
+	}
+	return item
+}
+
 func NewItemPartitions(partitionKeys []string, partitionMap map[string]any) ItemPartitions {
 	return &defaultItemPartitions{
 		partitionKeys: partitionKeys,
@@ -79,3 +79,46 @@ func TestNewItemToPersist(t *testing.T) {
 		t.Errorf("itemToPersist.String() = %v, want to contain %v", itemToPersistStr, itemStr)
 	}
 }
+
+func TestPartitionPath(t *testing.T) {
+	tests := []struct {
+		name       string
+		partitions ItemPartitions
+		want       string
+	}{
+		{
+			name:       "root",
+			partitions: NewItemPartitions(nil, nil),
+			want:       "*",
+		},
+		{
+			name: "leaf",
+			partitions: NewItemPartitions(
+				[]string{"type", "sub-type", "domain"},
+				map[string]any{"type": "timer", "sub-type": 4, "domain": "*"},
+			),
+			want: "*/timer/4/*",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := PartitionPath(tc.partitions); got != tc.want {
+				t.Errorf("PartitionPath() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestUnwrapItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	item := NewMockItem(ctrl)
+	itemToPersist := NewItemToPersist(item, NewItemPartitions(nil, nil))
+
+	if got := UnwrapItem(itemToPersist); got != item {
+		t.Errorf("UnwrapItem() = %v, want the wrapped item", got)
+	}
+	if got := UnwrapItem(item); got != item {
+		t.Errorf("UnwrapItem() = %v, want the item itself", got)
+	}
+}
@@ -22,7 +22,46 @@
 
 package types
 
+import "fmt"
+
+// DefaultAckLevel is the ack level of a leaf node that has no committed offset yet.
+// Item offsets are expected to be non-negative.
+const DefaultAckLevel int64 = -1
+
 // Offsets encapsulates the whole queue tree state including the offsets of each leaf node
 type Offsets struct {
-	// TODO: complete
+	// Partitions maps leaf node paths to their committed offsets (ack levels).
+	// All items of a leaf node with offset <= committed offset are processed and can be deleted.
+	// Leaf nodes created by dynamic splits are recreated from these paths when the queue is restarted.
+	Partitions map[string]int64 `json:"partitions,omitempty"`
+
+	// Retired holds the ack levels of leaf nodes removed by a merge since the last commit.
+	// Their processed items are cleaned up on commit but they are not recreated on restart.
+	Retired map[string]int64 `json:"retired,omitempty"`
+}
+
+// NewOffsets returns empty offsets
+func NewOffsets() *Offsets {
+	return &Offsets{
+		Partitions: map[string]int64{},
+		Retired:    map[string]int64{},
+	}
+}
+
+// AckLevel returns the committed offset of the given leaf node path
+func (o *Offsets) AckLevel(path string) int64 {
+	if o == nil {
+		return DefaultAckLevel
+	}
+	if ackLevel, ok := o.Partitions[path]; ok {
+		return ackLevel
+	}
+	return DefaultAckLevel
+}
+
+func (o *Offsets) String() string {
+	if o == nil {
+		return "Offsets{}"
+	}
+	return fmt.Sprintf("Offsets{Partitions:%v, Retired:%v}", o.Partitions, o.Retired)
 }
@@ -33,6 +33,14 @@ type Persister interface {
 	Fetch(ctx context.Context, partitions ItemPartitions, pageInfo PageInfo) ([]Item, error)
 }
 
+// PageInfo defines the range of items to be fetched from a leaf node
 type PageInfo struct {
-	// TODO: define ack levels, page size, etc.
+	// ExclusiveMinOffset is the offset of the last item already read from the leaf node (i.e. read level).
+	ExclusiveMinOffset int64
+
+	// InclusiveMaxOffset is the maximum offset of the items to be fetched.
+	InclusiveMaxOffset int64
+
+	// PageSize is the maximum number of items to be fetched. Items are returned in ascending offset order.
+	PageSize int
 }
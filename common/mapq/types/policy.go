@@ -50,10 +50,67 @@ type SplitPolicy struct {
 	// PredefinedSplits is a list of predefined splits for the attribute key
 	// Child nodes for these attributes will be created during initialization
 	PredefinedSplits []any `json:"predefinedSplits,omitempty"`
+
+	// Burst creates a child node for an attribute value whose enqueue rate exceeds the threshold.
+	// Only values routed to the catch-all child are considered.
+	Burst *BurstPolicy `json:"burst,omitempty"`
+
+	// Skew creates a child node for an attribute value that dominates the enqueue rate of the catch-all child.
+	Skew *SkewPolicy `json:"skew,omitempty"`
+
+	// Merge removes dynamically created child nodes once their enqueue rate drops and they are drained.
+	// Child nodes created via PredefinedSplits are never merged.
+	Merge *MergePolicy `json:"merge,omitempty"`
+}
+
+type BurstPolicy struct {
+	// RPSThreshold is the enqueue rate of a single attribute value above which the value gets its own child node.
+	RPSThreshold float64 `json:"rpsThreshold,omitempty"`
+}
+
+type SkewPolicy struct {
+	// Ratio is the share of the catch-all child's enqueue rate taken by a single attribute value
+	// above which the value gets its own child node. e.g. 0.5
+	Ratio float64 `json:"ratio,omitempty"`
+
+	// MinRPS is the minimum enqueue rate of the catch-all child for skew to be considered.
+	MinRPS float64 `json:"minRPS,omitempty"`
+}
+
+type MergePolicy struct {
+	// BelowRPS is the enqueue rate of a dynamically created child below which it's merged back to the catch-all child.
+	BelowRPS float64 `json:"belowRPS,omitempty"`
+}
+
+// ShouldSplit returns true if an attribute value routed to the catch-all child should get its own child node
+// given the enqueue rate of the value and the total enqueue rate of the catch-all child.
+func (sp SplitPolicy) ShouldSplit(valueRPS, catchAllRPS float64) bool {
+	if sp.Disabled {
+		return false
+	}
+
+	if sp.Burst != nil && sp.Burst.RPSThreshold > 0 && valueRPS >= sp.Burst.RPSThreshold {
+		return true
+	}
+
+	if sp.Skew != nil && sp.Skew.Ratio > 0 && catchAllRPS > 0 && catchAllRPS >= sp.Skew.MinRPS {
+		return valueRPS/catchAllRPS >= sp.Skew.Ratio
+	}
+
+	return false
+}
+
+// ShouldMerge returns true if a dynamically created child with the given enqueue rate should be merged back.
+func (sp SplitPolicy) ShouldMerge(childRPS float64) bool {
+	if sp.Merge == nil {
+		return false
+	}
+
+	return childRPS < sp.Merge.BelowRPS
 }
 
 func (sp SplitPolicy) String() string {
-	return fmt.Sprintf("SplitPolicy{Disabled:%v, PredefinedSplits:%v}", sp.Disabled, sp.PredefinedSplits)
+	return fmt.Sprintf("SplitPolicy{Disabled:%v, PredefinedSplits:%v, Burst:%v, Skew:%v, Merge:%v}", sp.Disabled, sp.PredefinedSplits, sp.Burst, sp.Skew, sp.Merge)
 }
 
 type NodePolicy struct {
@@ -0,0 +1,107 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package types
+
+import "testing"
+
+func TestSplitPolicyShouldSplit(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      SplitPolicy
+		valueRPS    float64
+		catchAllRPS float64
+		want        bool
+	}{
+		{
+			name:        "no burst or skew policy",
+			policy:      SplitPolicy{},
+			valueRPS:    1000,
+			catchAllRPS: 1000,
+			want:        false,
+		},
+		{
+			name:        "disabled",
+			policy:      SplitPolicy{Disabled: true, Burst: &BurstPolicy{RPSThreshold: 10}},
+			valueRPS:    1000,
+			catchAllRPS: 1000,
+			want:        false,
+		},
+		{
+			name:        "burst above threshold",
+			policy:      SplitPolicy{Burst: &BurstPolicy{RPSThreshold: 100}},
+			valueRPS:    150,
+			catchAllRPS: 1000,
+			want:        true,
+		},
+		{
+			name:        "burst below threshold",
+			policy:      SplitPolicy{Burst: &BurstPolicy{RPSThreshold: 100}},
+			valueRPS:    50,
+			catchAllRPS: 1000,
+			want:        false,
+		},
+		{
+			name:        "skewed",
+			policy:      SplitPolicy{Skew: &SkewPolicy{Ratio: 0.5, MinRPS: 10}},
+			valueRPS:    80,
+			catchAllRPS: 100,
+			want:        true,
+		},
+		{
+			name:        "not skewed",
+			policy:      SplitPolicy{Skew: &SkewPolicy{Ratio: 0.5, MinRPS: 10}},
+			valueRPS:    30,
+			catchAllRPS: 100,
+			want:        false,
+		},
+		{
+			name:        "skewed but catch-all rate is too low",
+			policy:      SplitPolicy{Skew: &SkewPolicy{Ratio: 0.5, MinRPS: 10}},
+			valueRPS:    5,
+			catchAllRPS: 5,
+			want:        false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.policy.ShouldSplit(tc.valueRPS, tc.catchAllRPS); got != tc.want {
+				t.Errorf("ShouldSplit() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSplitPolicyShouldMerge(t *testing.T) {
+	if (SplitPolicy{}).ShouldMerge(0) {
+		t.Error("ShouldMerge() = true without merge policy, want false")
+	}
+
+	policy := SplitPolicy{Merge: &MergePolicy{BelowRPS: 10}}
+	if !policy.ShouldMerge(5) {
+		t.Error("ShouldMerge(5) = false, want true")
+	}
+	if policy.ShouldMerge(10) {
+		t.Error("ShouldMerge(10) = true, want false")
+	}
+}
@@ -24,6 +24,8 @@ package membership
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/uber/cadence/client/sharddistributor"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
@@ -41,15 +43,36 @@ var (
 	modeKeyShardDistributorShadowHashRing modeKey = "shard_distributor-shadow-hash_ring"
 )
 
+const (
+	// _watchNamespaceStateTimeout is longer than the shard distributor poll timeout,
+	// so a watch call normally returns because the state changed or the poll timed out on the server
+	_watchNamespaceStateTimeout = 90 * time.Second
+	// _watchNamespaceStateRetryInterval is how long to wait before watching again after a failure
+	_watchNamespaceStateRetryInterval = time.Second
+)
+
 type shardDistributorResolver struct {
 	namespace             string
 	shardDistributionMode dynamicproperties.StringPropertyFn
 	client                sharddistributor.Client
 	ring                  SingleProvider
 	logger                log.Logger
+
+	watchRetryInterval time.Duration
+	ctx                context.Context
+	cancel             context.CancelFunc
+	wg                 sync.WaitGroup
+
+	sync.RWMutex
+	// owners caches the shard owners from the shard distributor, it is only used while the
+	// namespace state is watched, and cleared whenever the shard distributor reports a change
+	owners      map[string]string
+	generation  int64
+	watching    bool
+	subscribers map[string]chan<- *ChangedEvent
 }
 
-func (s shardDistributorResolver) AddressToHost(owner string) (HostInfo, error) {
+func (s *shardDistributorResolver) AddressToHost(owner string) (HostInfo, error) {
 	return s.ring.AddressToHost(owner)
 }
 
@@ -60,26 +83,38 @@ func NewShardDistributorResolver(
 	ring SingleProvider,
 	logger log.Logger,
 ) SingleProvider {
+	ctx, cancel := context.WithCancel(context.Background())
 	return &shardDistributorResolver{
 		namespace:             namespace,
 		client:                client,
 		shardDistributionMode: shardDistributionMode,
 		ring:                  ring,
 		logger:                logger,
+		watchRetryInterval:    _watchNamespaceStateRetryInterval,
+		ctx:                   ctx,
+		cancel:                cancel,
+		owners:                make(map[string]string),
+		subscribers:           make(map[string]chan<- *ChangedEvent),
 	}
 }
 
-func (s shardDistributorResolver) Start() {
-	// We do not need to start anything in the shard distributor, so just start the ring
+func (s *shardDistributorResolver) Start() {
 	s.ring.Start()
+
+	if s.client != nil {
+		s.wg.Add(1)
+		go s.watchNamespaceState()
+	}
 }
 
-func (s shardDistributorResolver) Stop() {
-	// We do not need to stop anything in the shard distributor, so just stop the ring
+func (s *shardDistributorResolver) Stop() {
+	s.cancel()
+	s.wg.Wait()
+
 	s.ring.Stop()
 }
 
-func (s shardDistributorResolver) LookupRaw(key string) (string, error) {
+func (s *shardDistributorResolver) LookupRaw(key string) (string, error) {
 	if s.shardDistributionMode() != "hash_ring" && s.client == nil {
 		// This will avoid panics when the shard distributor is not configured
 		s.logger.Warn("No shard distributor client, defaulting to hash ring", tag.Value(s.shardDistributionMode()))
@@ -126,7 +161,7 @@ func (s shardDistributorResolver) LookupRaw(key string) (string, error) {
 	return s.ring.LookupRaw(key)
 }
 
-func (s shardDistributorResolver) Lookup(key string) (HostInfo, error) {
+func (s *shardDistributorResolver) Lookup(key string) (HostInfo, error) {
 	owner, err := s.LookupRaw(key)
 	if err != nil {
 		return HostInfo{}, err
@@ -135,32 +170,50 @@ func (s shardDistributorResolver) Lookup(key string) (HostInfo, error) {
 	return s.ring.AddressToHost(owner)
 }
 
-func (s shardDistributorResolver) Subscribe(name string, channel chan<- *ChangedEvent) error {
-	// Shard distributor does not support subscription yet, so use the ring
-	return s.ring.Subscribe(name, channel)
+// Subscribe registers the channel for both hash ring membership changes and shard distributor ownership changes
+func (s *shardDistributorResolver) Subscribe(name string, channel chan<- *ChangedEvent) error {
+	if err := s.ring.Subscribe(name, channel); err != nil {
+		return err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	s.subscribers[name] = channel
+	return nil
 }
 
-func (s shardDistributorResolver) Unsubscribe(name string) error {
-	// Shard distributor does not support subscription yet, so use the ring
+func (s *shardDistributorResolver) Unsubscribe(name string) error {
+	s.Lock()
+	delete(s.subscribers, name)
+	s.Unlock()
+
 	return s.ring.Unsubscribe(name)
 }
 
-func (s shardDistributorResolver) Members() []HostInfo {
+func (s *shardDistributorResolver) Members() []HostInfo {
 	// Shard distributor does not member tracking yet, so use the ring
 	return s.ring.Members()
 }
 
-func (s shardDistributorResolver) MemberCount() int {
+func (s *shardDistributorResolver) MemberCount() int {
 	// Shard distributor does not member tracking yet, so use the ring
 	return s.ring.MemberCount()
 }
 
-func (s shardDistributorResolver) Refresh() error {
+func (s *shardDistributorResolver) Refresh() error {
 	// Shard distributor does not need refresh, so propagate to the ring
 	return s.ring.Refresh()
 }
 
-func (s shardDistributorResolver) lookUpInShardDistributor(key string) (string, error) {
+func (s *shardDistributorResolver) lookUpInShardDistributor(key string) (string, error) {
+	s.RLock()
+	owner, ok := s.owners[key]
+	generation := s.generation
+	s.RUnlock()
+	if ok {
+		return owner, nil
+	}
+
 	request := &types.GetShardOwnerRequest{
 		ShardKey:  key,
 		Namespace: s.namespace,
@@ -170,5 +223,119 @@ func (s shardDistributorResolver) lookUpInShardDistributor(key string) (string,
 		return "", err
 	}
 
+	s.Lock()
+	defer s.Unlock()
+	// Without a watch we would not know when the owner changes, so only cache while watching,
+	// and not if the owners were reset while the request was in flight, as the response may be stale
+	if s.watching && s.generation == generation {
+		s.owners[key] = response.Owner
+	}
+
 	return response.Owner, nil
 }
+
+// watchNamespaceState long polls the shard distributor for ownership changes of the namespace,
+// so cached owners are dropped and subscribers are notified as soon as shards move.
+func (s *shardDistributorResolver) watchNamespaceState() {
+	defer s.wg.Done()
+	defer func() { log.CapturePanic(recover(), s.logger, nil) }()
+
+	var revision int64
+	var owners []string
+	for s.ctx.Err() == nil {
+		if modeKey(s.shardDistributionMode()) == modeKeyHashRing {
+			s.stopWatching()
+			revision, owners = 0, nil
+			s.waitForRetry()
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(s.ctx, _watchNamespaceStateTimeout)
+		response, err := s.client.WatchNamespaceState(ctx, &types.WatchNamespaceStateRequest{
+			Namespace: s.namespace,
+			Revision:  revision,
+		})
+		cancel()
+		if s.ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			s.logger.Warn("Failed to watch shard distributor namespace state", tag.ShardNamespace(s.namespace), tag.Error(err))
+			s.stopWatching()
+			revision, owners = 0, nil
+			s.waitForRetry()
+			continue
+		}
+
+		if response.GetRevision() == revision {
+			continue
+		}
+		// The first response is the initial state, there is no change to report yet
+		notify := revision != 0
+		revision = response.GetRevision()
+		event := ownersChangedEvent(owners, response.GetOwners())
+		owners = response.GetOwners()
+		s.resetOwners(notify, event)
+	}
+}
+
+func (s *shardDistributorResolver) waitForRetry() {
+	timer := time.NewTimer(s.watchRetryInterval)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-s.ctx.Done():
+	}
+}
+
+func (s *shardDistributorResolver) stopWatching() {
+	s.Lock()
+	defer s.Unlock()
+	s.watching = false
+	s.owners = make(map[string]string)
+	s.generation++
+}
+
+func (s *shardDistributorResolver) resetOwners(notify bool, event *ChangedEvent) {
+	s.Lock()
+	defer s.Unlock()
+	s.watching = true
+	s.owners = make(map[string]string)
+	s.generation++
+
+	if !notify {
+		return
+	}
+	for name, ch := range s.subscribers {
+		select {
+		case ch <- event:
+		default:
+			s.logger.Debug("Subscriber channel is full, skipping shard ownership change", tag.Name(name))
+		}
+	}
+}
+
+func ownersChangedEvent(previous, current []string) *ChangedEvent {
+	previousSet := make(map[string]struct{}, len(previous))
+	for _, owner := range previous {
+		previousSet[owner] = struct{}{}
+	}
+	currentSet := make(map[string]struct{}, len(current))
+	for _, owner := range current {
+		currentSet[owner] = struct{}{}
+	}
+
+	event := &ChangedEvent{}
+	for _, owner := range current {
+		if _, ok := previousSet[owner]; !ok {
+			event.HostsAdded = append(event.HostsAdded, owner)
+		}
+	}
+	for _, owner := range previous {
+		if _, ok := currentSet[owner]; !ok {
+			event.HostsRemoved = append(event.HostsRemoved, owner)
+		}
+	}
+	return event
+}
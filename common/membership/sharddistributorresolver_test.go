@@ -23,10 +23,13 @@
 package membership
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/sharddistributor"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
@@ -217,12 +220,129 @@ func TestShardDistributorResolver_Lookup_UnknownMode(t *testing.T) {
 	assert.Equal(t, "test-addr", host.addr)
 }
 
+func TestShardDistributorResolver_WatchNamespaceState(t *testing.T) {
+	resolver, ring, shardDistributorMock := newShardDistributorResolver(t)
+	resolver.shardDistributionMode = func(...dynamicproperties.FilterOption) string {
+		return string(modeKeyShardDistributor)
+	}
+
+	responses := make(chan *types.WatchNamespaceStateResponse)
+	shardDistributorMock.EXPECT().WatchNamespaceState(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ *types.WatchNamespaceStateRequest, _ ...yarpc.CallOption) (*types.WatchNamespaceStateResponse, error) {
+			select {
+			case response := <-responses:
+				return response, nil
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}).AnyTimes()
+
+	changes := make(chan *ChangedEvent, 1)
+	ring.EXPECT().Subscribe("test-name", gomock.Any()).Return(nil)
+	assert.NoError(t, resolver.Subscribe("test-name", changes))
+
+	ring.EXPECT().Start()
+	ring.EXPECT().Stop()
+	resolver.Start()
+	defer resolver.Stop()
+
+	responses <- &types.WatchNamespaceStateResponse{Namespace: "test-namespace", Revision: 1, Owners: []string{"owner-1"}}
+	assert.Eventually(t, func() bool {
+		resolver.RLock()
+		defer resolver.RUnlock()
+		return resolver.watching
+	}, time.Second, time.Millisecond)
+
+	// The owner is cached while the namespace is watched
+	shardDistributorMock.EXPECT().GetShardOwner(gomock.Any(),
+		&types.GetShardOwnerRequest{ShardKey: "test-key", Namespace: "test-namespace"}).
+		Return(&types.GetShardOwnerResponse{Owner: "owner-1"}, nil).Times(1)
+	for i := 0; i < 2; i++ {
+		owner, err := resolver.LookupRaw("test-key")
+		assert.NoError(t, err)
+		assert.Equal(t, "owner-1", owner)
+	}
+
+	// A change drops the cache and notifies the subscribers
+	responses <- &types.WatchNamespaceStateResponse{Namespace: "test-namespace", Revision: 2, Owners: []string{"owner-2"}}
+	select {
+	case event := <-changes:
+		assert.Equal(t, &ChangedEvent{HostsAdded: []string{"owner-2"}, HostsRemoved: []string{"owner-1"}}, event)
+	case <-time.After(time.Second):
+		t.Fatal("subscriber was not notified about the ownership change")
+	}
+
+	shardDistributorMock.EXPECT().GetShardOwner(gomock.Any(),
+		&types.GetShardOwnerRequest{ShardKey: "test-key", Namespace: "test-namespace"}).
+		Return(&types.GetShardOwnerResponse{Owner: "owner-2"}, nil).Times(1)
+	owner, err := resolver.LookupRaw("test-key")
+	assert.NoError(t, err)
+	assert.Equal(t, "owner-2", owner)
+}
+
+func TestShardDistributorResolver_WatchNamespaceState_Error(t *testing.T) {
+	resolver, ring, shardDistributorMock := newShardDistributorResolver(t)
+	resolver.shardDistributionMode = func(...dynamicproperties.FilterOption) string {
+		return string(modeKeyShardDistributor)
+	}
+	resolver.watchRetryInterval = time.Millisecond
+
+	retried := make(chan struct{})
+	gomock.InOrder(
+		shardDistributorMock.EXPECT().WatchNamespaceState(gomock.Any(), gomock.Any()).
+			Return(nil, assert.AnError),
+		shardDistributorMock.EXPECT().WatchNamespaceState(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, _ *types.WatchNamespaceStateRequest, _ ...yarpc.CallOption) (*types.WatchNamespaceStateResponse, error) {
+				close(retried)
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}),
+	)
+
+	ring.EXPECT().Start()
+	ring.EXPECT().Stop()
+	resolver.Start()
+	defer resolver.Stop()
+
+	select {
+	case <-retried:
+	case <-time.After(time.Second):
+		t.Fatal("watch was not retried after an error")
+	}
+
+	// Nothing is cached without a successful watch
+	shardDistributorMock.EXPECT().GetShardOwner(gomock.Any(),
+		&types.GetShardOwnerRequest{ShardKey: "test-key", Namespace: "test-namespace"}).
+		Return(&types.GetShardOwnerResponse{Owner: "test-owner"}, nil).Times(2)
+	for i := 0; i < 2; i++ {
+		owner, err := resolver.LookupRaw("test-key")
+		assert.NoError(t, err)
+		assert.Equal(t, "test-owner", owner)
+	}
+}
+
+func TestOwnersChangedEvent(t *testing.T) {
+	assert.Equal(t, &ChangedEvent{}, ownersChangedEvent([]string{"a", "b"}, []string{"a", "b"}))
+	assert.Equal(t, &ChangedEvent{HostsAdded: []string{"a"}}, ownersChangedEvent(nil, []string{"a"}))
+	assert.Equal(t,
+		&ChangedEvent{HostsAdded: []string{"c"}, HostsRemoved: []string{"a"}},
+		ownersChangedEvent([]string{"a", "b"}, []string{"b", "c"}),
+	)
+}
+
 /* Test all the simple proxies
  */
 func TestShardDistributorResolver_Start(t *testing.T) {
-	resolver, ring, _ := newShardDistributorResolver(t)
+	resolver, ring, shardDistributorMock := newShardDistributorResolver(t)
 	ring.EXPECT().Start().Times(1)
+	ring.EXPECT().Stop().Times(1)
+	shardDistributorMock.EXPECT().WatchNamespaceState(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ *types.WatchNamespaceStateRequest, _ ...yarpc.CallOption) (*types.WatchNamespaceStateResponse, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}).AnyTimes()
 	resolver.Start()
+	resolver.Stop()
 }
 
 func TestShardDistributorResolver_Stop(t *testing.T) {
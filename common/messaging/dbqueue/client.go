@@ -0,0 +1,79 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package dbqueue implements the messaging client on top of a persistence backed queue.
+// It allows the visibility indexing pipeline to run without Kafka.
+package dbqueue
+
+import (
+	"fmt"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type clientImpl struct {
+	queue              persistence.QueueManager
+	membershipResolver membership.Resolver
+	logger             log.Logger
+}
+
+var _ messaging.Client = (*clientImpl)(nil)
+
+// NewClient returns a messaging client which delivers visibility messages through the given queue
+func NewClient(
+	queue persistence.QueueManager,
+	membershipResolver membership.Resolver,
+	logger log.Logger,
+) messaging.Client {
+	return &clientImpl{
+		queue:              queue,
+		membershipResolver: membershipResolver,
+		logger:             logger,
+	}
+}
+
+// NewConsumer is used to create a consumer of the visibility queue
+func (c *clientImpl) NewConsumer(appName, consumerName string) (messaging.Consumer, error) {
+	if err := validateAppName(appName); err != nil {
+		return nil, err
+	}
+	return NewConsumer(c.queue, consumerName, c.membershipResolver, c.logger), nil
+}
+
+// NewProducer is used to create a producer of the visibility queue
+func (c *clientImpl) NewProducer(appName string) (messaging.Producer, error) {
+	if err := validateAppName(appName); err != nil {
+		return nil, err
+	}
+	return NewProducer(c.queue, c.logger), nil
+}
+
+func validateAppName(appName string) error {
+	if appName != constants.VisibilityAppName {
+		return fmt.Errorf("app %q is not supported by the persistence visibility transport, only %q messages can be delivered without kafka", appName, constants.VisibilityAppName)
+	}
+	return nil
+}
@@ -0,0 +1,357 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package dbqueue
+
+import (
+	"context"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+)
+
+const (
+	rcvBufferSize          = 1024
+	emptyMessageID         = -1
+	readPageSize           = 100
+	maxBacklogCount        = 2 * rcvBufferSize
+	pollInterval           = time.Second
+	ackLevelUpdateInterval = 10 * time.Second
+	persistenceTimeout     = 10 * time.Second
+)
+
+type (
+	// consumerImpl reads messages from a persistence backed queue.
+	// Only the worker host owning the consumer name reads from the queue, the ownership is decided by
+	// the membership ring and is best effort. During ownership changes the same messages could be delivered
+	// by multiple hosts, which is fine for visibility as documents are written with external versioning.
+	consumerImpl struct {
+		status             int32
+		queue              persistence.QueueManager
+		consumerName       string
+		membershipResolver membership.Resolver
+		msgChan            chan messaging.Message
+
+		sync.RWMutex
+		session *session
+
+		ctx        context.Context
+		cancelFunc context.CancelFunc
+		wg         sync.WaitGroup
+		logger     log.Logger
+	}
+
+	// session tracks the messages read while the host owns the consumer.
+	// Acks of messages from a previous session are ignored.
+	session struct {
+		ackMgr            messaging.AckManager
+		persistedAckLevel int64
+	}
+
+	messageImpl struct {
+		msg      *persistence.QueueMessage
+		session  *session
+		consumer *consumerImpl
+	}
+)
+
+var _ messaging.Consumer = (*consumerImpl)(nil)
+var _ messaging.Message = (*messageImpl)(nil)
+
+// NewConsumer is used to create the persistence queue based consumer implementation
+func NewConsumer(
+	queue persistence.QueueManager,
+	consumerName string,
+	membershipResolver membership.Resolver,
+	logger log.Logger,
+) messaging.Consumer {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &consumerImpl{
+		status:             common.DaemonStatusInitialized,
+		queue:              queue,
+		consumerName:       consumerName,
+		membershipResolver: membershipResolver,
+		msgChan:            make(chan messaging.Message, rcvBufferSize),
+		ctx:                ctx,
+		cancelFunc:         cancel,
+		logger:             logger.WithTags(tag.Name(consumerName)),
+	}
+}
+
+// Start starts the consumer
+func (c *consumerImpl) Start() error {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return nil
+	}
+
+	c.wg.Add(2)
+	go c.readLoop()
+	go c.ackLoop()
+	c.logger.Info("Persistence queue consumer started")
+	return nil
+}
+
+// Stop stops the consumer
+func (c *consumerImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	c.cancelFunc()
+	c.wg.Wait()
+	if s := c.getSession(); s != nil {
+		c.updateAckLevel(s)
+	}
+	close(c.msgChan)
+	c.logger.Info("Persistence queue consumer stopped")
+}
+
+// Messages return the message channel for this consumer
+func (c *consumerImpl) Messages() <-chan messaging.Message {
+	return c.msgChan
+}
+
+func (c *consumerImpl) readLoop() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			s := c.refreshSession()
+			if s == nil {
+				continue
+			}
+			for c.readPage(s) {
+			}
+		}
+	}
+}
+
+func (c *consumerImpl) ackLoop() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(ackLevelUpdateInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			if s := c.getSession(); s != nil {
+				c.updateAckLevel(s)
+				c.purgeAckedMessages()
+			}
+		}
+	}
+}
+
+// refreshSession returns the current session if the host owns the consumer, a new one is created
+// when the host just took over the ownership. It returns nil if the host doesn't own the consumer.
+func (c *consumerImpl) refreshSession() *session {
+	owner, err := c.isOwner()
+	if err != nil {
+		c.logger.Warn("Failed to lookup owner of persistence queue consumer", tag.Error(err))
+		return c.getSession()
+	}
+
+	if !owner {
+		if c.getSession() != nil {
+			c.logger.Info("Host is no longer responsible for persistence queue consumer")
+			c.setSession(nil)
+		}
+		return nil
+	}
+
+	if s := c.getSession(); s != nil {
+		return s
+	}
+
+	ackLevel, err := c.loadAckLevel()
+	if err != nil {
+		c.logger.Warn("Failed to load ack level of persistence queue consumer", tag.Error(err))
+		return nil
+	}
+
+	s := &session{
+		ackMgr:            messaging.NewAckManager(c.logger),
+		persistedAckLevel: ackLevel,
+	}
+	s.ackMgr.SetAckLevel(ackLevel)
+	c.setSession(s)
+	c.logger.Info("Host is responsible for persistence queue consumer", tag.AckLevel(ackLevel))
+	return s
+}
+
+func (c *consumerImpl) isOwner() (bool, error) {
+	owner, err := c.membershipResolver.Lookup(service.Worker, c.consumerName)
+	if err != nil {
+		return false, err
+	}
+	self, err := c.membershipResolver.WhoAmI()
+	if err != nil {
+		return false, err
+	}
+	return owner.Identity() == self.Identity(), nil
+}
+
+func (c *consumerImpl) loadAckLevel() (int64, error) {
+	ctx, cancel := context.WithTimeout(c.ctx, persistenceTimeout)
+	defer cancel()
+
+	ackLevels, err := c.queue.GetAckLevels(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if ackLevel, ok := ackLevels[c.consumerName]; ok {
+		return ackLevel, nil
+	}
+	return emptyMessageID, nil
+}
+
+// readPage reads the next page of messages into the message channel and returns whether there could be more to read
+func (c *consumerImpl) readPage(s *session) bool {
+	if s.ackMgr.GetBacklogCount() >= maxBacklogCount {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(c.ctx, persistenceTimeout)
+	messages, err := c.queue.ReadMessages(ctx, s.ackMgr.GetReadLevel(), readPageSize)
+	cancel()
+	if err != nil {
+		c.logger.Warn("Failed to read messages from persistence queue", tag.Error(err))
+		return false
+	}
+
+	for _, msg := range messages {
+		if err := s.ackMgr.ReadItem(msg.ID); err != nil {
+			c.logger.Error("Failed to add message to ack manager", tag.TaskID(msg.ID), tag.Error(err))
+			continue
+		}
+		select {
+		case c.msgChan <- &messageImpl{msg: msg, session: s, consumer: c}:
+		case <-c.ctx.Done():
+			return false
+		}
+	}
+	return len(messages) == readPageSize
+}
+
+func (c *consumerImpl) updateAckLevel(s *session) {
+	ackLevel := s.ackMgr.GetAckLevel()
+	if ackLevel <= s.persistedAckLevel {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), persistenceTimeout)
+	defer cancel()
+	if err := c.queue.UpdateAckLevel(ctx, ackLevel, c.consumerName); err != nil {
+		c.logger.Warn("Failed to update ack level of persistence queue consumer", tag.AckLevel(ackLevel), tag.Error(err))
+		return
+	}
+	s.persistedAckLevel = ackLevel
+}
+
+// purgeAckedMessages deletes the messages acked by all consumers of the queue
+func (c *consumerImpl) purgeAckedMessages() {
+	ctx, cancel := context.WithTimeout(c.ctx, persistenceTimeout)
+	defer cancel()
+
+	ackLevels, err := c.queue.GetAckLevels(ctx)
+	if err != nil {
+		c.logger.Warn("Failed to purge acked messages of persistence queue", tag.Error(err))
+		return
+	}
+
+	minAckLevel := int64(math.MaxInt64)
+	for _, ackLevel := range ackLevels {
+		if ackLevel < minAckLevel {
+			minAckLevel = ackLevel
+		}
+	}
+	if minAckLevel == int64(math.MaxInt64) {
+		return
+	}
+
+	if err := c.queue.DeleteMessagesBefore(ctx, minAckLevel); err != nil {
+		c.logger.Warn("Failed to purge acked messages of persistence queue", tag.Error(err))
+	}
+}
+
+func (c *consumerImpl) getSession() *session {
+	c.RLock()
+	defer c.RUnlock()
+	return c.session
+}
+
+func (c *consumerImpl) setSession(s *session) {
+	c.Lock()
+	defer c.Unlock()
+	c.session = s
+}
+
+func (m *messageImpl) Value() []byte {
+	return m.msg.Payload
+}
+
+func (m *messageImpl) Partition() int32 {
+	return 0
+}
+
+func (m *messageImpl) Offset() int64 {
+	return m.msg.ID
+}
+
+func (m *messageImpl) Ack() error {
+	m.session.ackMgr.AckItem(m.msg.ID)
+	return nil
+}
+
+// Nack moves the message to the DLQ of the queue and acks it, so the consumer can make progress
+func (m *messageImpl) Nack() error {
+	ctx, cancel := context.WithTimeout(context.Background(), persistenceTimeout)
+	defer cancel()
+
+	if err := m.consumer.queue.EnqueueMessageToDLQ(ctx, m.msg.Payload); err != nil {
+		m.consumer.logger.Error("Fail to publish message to DLQ when nacking message, please take action!!",
+			tag.TaskID(m.msg.ID),
+			tag.Error(err))
+	} else {
+		m.consumer.logger.Warn("nack message and publish to DLQ", tag.TaskID(m.msg.ID))
+	}
+	m.session.ackMgr.AckItem(m.msg.ID)
+	return nil
+}
@@ -0,0 +1,178 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package dbqueue
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+)
+
+const testConsumerName = "test-consumer"
+
+var (
+	selfHost  = membership.NewDetailedHostInfo("127.0.0.1:7939", "self", nil)
+	otherHost = membership.NewDetailedHostInfo("127.0.0.2:7939", "other", nil)
+)
+
+func setupConsumer(t *testing.T) (*consumerImpl, *persistence.MockQueueManager, *membership.MockResolver) {
+	ctrl := gomock.NewController(t)
+	queue := persistence.NewMockQueueManager(ctrl)
+	resolver := membership.NewMockResolver(ctrl)
+	c := NewConsumer(queue, testConsumerName, resolver, testlogger.New(t)).(*consumerImpl)
+	return c, queue, resolver
+}
+
+func expectOwner(resolver *membership.MockResolver, owner membership.HostInfo) {
+	resolver.EXPECT().Lookup(service.Worker, testConsumerName).Return(owner, nil)
+	resolver.EXPECT().WhoAmI().Return(selfHost, nil)
+}
+
+func TestConsumer_RefreshSession(t *testing.T) {
+	t.Run("not owner", func(t *testing.T) {
+		c, _, resolver := setupConsumer(t)
+		expectOwner(resolver, otherHost)
+
+		assert.Nil(t, c.refreshSession())
+	})
+
+	t.Run("lookup error keeps current session", func(t *testing.T) {
+		c, _, resolver := setupConsumer(t)
+		resolver.EXPECT().Lookup(service.Worker, testConsumerName).Return(membership.HostInfo{}, errors.New("ring not ready"))
+
+		assert.Nil(t, c.refreshSession())
+	})
+
+	t.Run("takes over from persisted ack level", func(t *testing.T) {
+		c, queue, resolver := setupConsumer(t)
+		expectOwner(resolver, selfHost)
+		queue.EXPECT().GetAckLevels(gomock.Any()).Return(map[string]int64{testConsumerName: 10, "other-consumer": 5}, nil)
+
+		s := c.refreshSession()
+		require.NotNil(t, s)
+		assert.Equal(t, int64(10), s.ackMgr.GetAckLevel())
+		assert.Equal(t, int64(10), s.ackMgr.GetReadLevel())
+
+		// the session is kept while the host owns the consumer
+		expectOwner(resolver, selfHost)
+		assert.Equal(t, s, c.refreshSession())
+
+		// and dropped when the ownership moves to another host
+		expectOwner(resolver, otherHost)
+		assert.Nil(t, c.refreshSession())
+		assert.Nil(t, c.getSession())
+	})
+
+	t.Run("new consumer starts from the beginning of the queue", func(t *testing.T) {
+		c, queue, resolver := setupConsumer(t)
+		expectOwner(resolver, selfHost)
+		queue.EXPECT().GetAckLevels(gomock.Any()).Return(map[string]int64{}, nil)
+
+		s := c.refreshSession()
+		require.NotNil(t, s)
+		assert.Equal(t, int64(emptyMessageID), s.ackMgr.GetReadLevel())
+	})
+
+	t.Run("ack level load error", func(t *testing.T) {
+		c, queue, resolver := setupConsumer(t)
+		expectOwner(resolver, selfHost)
+		queue.EXPECT().GetAckLevels(gomock.Any()).Return(nil, errors.New("db down"))
+
+		assert.Nil(t, c.refreshSession())
+	})
+}
+
+func TestConsumer_ReadAndAck(t *testing.T) {
+	c, queue, resolver := setupConsumer(t)
+	expectOwner(resolver, selfHost)
+	queue.EXPECT().GetAckLevels(gomock.Any()).Return(map[string]int64{testConsumerName: 10}, nil)
+	s := c.refreshSession()
+	require.NotNil(t, s)
+
+	queue.EXPECT().ReadMessages(gomock.Any(), int64(10), readPageSize).Return(persistence.QueueMessageList{
+		{ID: 11, Payload: []byte("m11")},
+		{ID: 12, Payload: []byte("m12")},
+		{ID: 13, Payload: []byte("m13")},
+	}, nil)
+	assert.False(t, c.readPage(s))
+
+	msgs := make([]*messageImpl, 0, 3)
+	for i := 0; i < 3; i++ {
+		msgs = append(msgs, (<-c.Messages()).(*messageImpl))
+	}
+	assert.Equal(t, []byte("m11"), msgs[0].Value())
+	assert.Equal(t, int64(11), msgs[0].Offset())
+	assert.Equal(t, int64(3), s.ackMgr.GetBacklogCount())
+
+	// out of order acks only move the ack level once all preceding messages are done
+	require.NoError(t, msgs[1].Ack())
+	c.updateAckLevel(s)
+
+	require.NoError(t, msgs[0].Ack())
+	queue.EXPECT().UpdateAckLevel(gomock.Any(), int64(12), testConsumerName).Return(nil)
+	c.updateAckLevel(s)
+	assert.Equal(t, int64(12), s.persistedAckLevel)
+
+	queue.EXPECT().EnqueueMessageToDLQ(gomock.Any(), []byte("m13")).Return(nil)
+	require.NoError(t, msgs[2].Nack())
+	queue.EXPECT().UpdateAckLevel(gomock.Any(), int64(13), testConsumerName).Return(errors.New("db down"))
+	c.updateAckLevel(s)
+	assert.Equal(t, int64(12), s.persistedAckLevel)
+
+	queue.EXPECT().ReadMessages(gomock.Any(), int64(13), readPageSize).Return(nil, errors.New("db down"))
+	assert.False(t, c.readPage(s))
+}
+
+func TestConsumer_PurgeAckedMessages(t *testing.T) {
+	c, queue, _ := setupConsumer(t)
+
+	queue.EXPECT().GetAckLevels(gomock.Any()).Return(map[string]int64{testConsumerName: 20, "other-consumer": 15}, nil)
+	queue.EXPECT().DeleteMessagesBefore(gomock.Any(), int64(15)).Return(nil)
+	c.purgeAckedMessages()
+
+	queue.EXPECT().GetAckLevels(gomock.Any()).Return(map[string]int64{}, nil)
+	c.purgeAckedMessages()
+}
+
+func TestConsumer_StartStop(t *testing.T) {
+	c, queue, resolver := setupConsumer(t)
+	resolver.EXPECT().Lookup(service.Worker, testConsumerName).Return(otherHost, nil).AnyTimes()
+	resolver.EXPECT().WhoAmI().Return(selfHost, nil).AnyTimes()
+	queue.EXPECT().GetAckLevels(gomock.Any()).Times(0)
+
+	require.NoError(t, c.Start())
+	time.Sleep(pollInterval + 100*time.Millisecond)
+	c.Stop()
+
+	_, ok := <-c.Messages()
+	assert.False(t, ok, "message channel should be closed after stop")
+}
@@ -0,0 +1,101 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package dbqueue
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	enqueueRetryInitialInterval = 5 * time.Millisecond
+	enqueueRetryMaxInterval     = 200 * time.Millisecond
+	enqueueRetryExpiration      = 5 * time.Second
+)
+
+type producerImpl struct {
+	queue         persistence.QueueManager
+	msgEncoder    codec.BinaryEncoder
+	throttleRetry *backoff.ThrottleRetry
+	logger        log.Logger
+}
+
+var _ messaging.Producer = (*producerImpl)(nil)
+
+// NewProducer is used to create the persistence queue based producer implementation
+func NewProducer(queue persistence.QueueManager, logger log.Logger) messaging.Producer {
+	retryPolicy := backoff.NewExponentialRetryPolicy(enqueueRetryInitialInterval)
+	retryPolicy.SetMaximumInterval(enqueueRetryMaxInterval)
+	retryPolicy.SetExpirationInterval(enqueueRetryExpiration)
+
+	return &producerImpl{
+		queue:      queue,
+		msgEncoder: codec.NewThriftRWEncoder(),
+		// every history host appends to the same queue, and a store like Cassandra assigns the next message ID
+		// with a conditional insert, so concurrent producers racing for the same ID are retried with a jittered backoff
+		throttleRetry: backoff.NewThrottleRetry(
+			backoff.WithRetryPolicy(retryPolicy),
+			backoff.WithRetryableError(isEnqueueConflict),
+		),
+		logger: logger,
+	}
+}
+
+// Publish serializes the visibility message and appends it to the queue
+func (p *producerImpl) Publish(ctx context.Context, msg interface{}) error {
+	message, ok := msg.(*indexer.Message)
+	if !ok {
+		return errors.New("unknown producer message type")
+	}
+
+	payload, err := p.msgEncoder.Encode(message)
+	if err != nil {
+		p.logger.Error("Failed to serialize thrift object", tag.Error(err))
+		return err
+	}
+
+	err = p.throttleRetry.Do(ctx, func(ctx context.Context) error {
+		return p.queue.EnqueueMessage(ctx, payload)
+	})
+	if err != nil {
+		p.logger.Warn("Failed to publish message to persistence queue",
+			tag.WorkflowID(message.GetWorkflowID()),
+			tag.Error(err))
+		return err
+	}
+	return nil
+}
+
+func isEnqueueConflict(err error) bool {
+	var conditionFailedErr *persistence.ConditionFailedError
+	return errors.As(err, &conditionFailedErr)
+}
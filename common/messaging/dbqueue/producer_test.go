@@ -0,0 +1,175 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package dbqueue
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := NewClient(persistence.NewMockQueueManager(ctrl), membership.NewMockResolver(ctrl), testlogger.New(t))
+
+	producer, err := client.NewProducer(constants.VisibilityAppName)
+	assert.NoError(t, err)
+	assert.NotNil(t, producer)
+	consumer, err := client.NewConsumer(constants.VisibilityAppName, "consumer")
+	assert.NoError(t, err)
+	assert.NotNil(t, consumer)
+
+	_, err = client.NewProducer(constants.PinotVisibilityAppName)
+	assert.ErrorContains(t, err, "only \"visibility\" messages can be delivered without kafka")
+	_, err = client.NewConsumer(constants.PinotVisibilityAppName, "consumer")
+	assert.Error(t, err)
+}
+
+func TestProducer_Publish(t *testing.T) {
+	msg := &indexer.Message{
+		WorkflowID: common.StringPtr("wid"),
+		RunID:      common.StringPtr("rid"),
+		Version:    common.Int64Ptr(1),
+	}
+	payload, err := codec.NewThriftRWEncoder().Encode(msg)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		msg        interface{}
+		setupMocks func(queue *persistence.MockQueueManager)
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			msg:  msg,
+			setupMocks: func(queue *persistence.MockQueueManager) {
+				queue.EXPECT().EnqueueMessage(gomock.Any(), payload).Return(nil)
+			},
+		},
+		{
+			name: "conflicting enqueue is retried",
+			msg:  msg,
+			setupMocks: func(queue *persistence.MockQueueManager) {
+				queue.EXPECT().EnqueueMessage(gomock.Any(), payload).Return(&persistence.ConditionFailedError{}).Times(2)
+				queue.EXPECT().EnqueueMessage(gomock.Any(), payload).Return(nil)
+			},
+		},
+		{
+			name: "enqueue error",
+			msg:  msg,
+			setupMocks: func(queue *persistence.MockQueueManager) {
+				queue.EXPECT().EnqueueMessage(gomock.Any(), payload).Return(errors.New("enqueue failed"))
+			},
+			wantErr: true,
+		},
+		{
+			name:       "unknown message type",
+			msg:        &indexer.PinotMessage{},
+			setupMocks: func(queue *persistence.MockQueueManager) {},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queue := persistence.NewMockQueueManager(ctrl)
+			tt.setupMocks(queue)
+
+			err := NewProducer(queue, testlogger.New(t)).Publish(context.Background(), tt.msg)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// fakeQueue assigns message IDs like the Cassandra queue store: it reads the last message ID and inserts
+// the next one only if it is still free, so concurrent enqueues of the same ID fail with a condition failure.
+type fakeQueue struct {
+	persistence.QueueManager
+
+	sync.Mutex
+	messages [][]byte
+}
+
+func (q *fakeQueue) EnqueueMessage(ctx context.Context, payload []byte) error {
+	q.Lock()
+	nextID := len(q.messages)
+	q.Unlock()
+
+	// let other producers race for the same message ID
+	runtime.Gosched()
+
+	q.Lock()
+	defer q.Unlock()
+	if len(q.messages) != nextID {
+		return &persistence.ConditionFailedError{Msg: "message ID exists in queue"}
+	}
+	q.messages = append(q.messages, payload)
+	return nil
+}
+
+func TestProducer_ConcurrentPublish(t *testing.T) {
+	const (
+		numProducers        = 10
+		messagesPerProducer = 20
+	)
+
+	queue := &fakeQueue{}
+	g := errgroup.Group{}
+	for i := 0; i < numProducers; i++ {
+		producer := NewProducer(queue, testlogger.New(t))
+		g.Go(func() error {
+			for j := 0; j < messagesPerProducer; j++ {
+				if err := producer.Publish(context.Background(), &indexer.Message{
+					WorkflowID: common.StringPtr("wid"),
+					Version:    common.Int64Ptr(int64(j)),
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+	require.NoError(t, g.Wait())
+	assert.Len(t, queue.messages, numProducers*messagesPerProducer)
+}
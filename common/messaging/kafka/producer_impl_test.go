@@ -70,6 +70,14 @@ func TestPublish(t *testing.T) {
 			},
 			hasErr: false,
 		},
+		{
+			name: "Publish CDC message succeeded",
+			message: &indexer.CDCMessage{
+				WorkflowID: common.StringPtr("test-workflow-id"),
+				Payload:    []byte(`{"schemaVersion":1}`),
+			},
+			hasErr: false,
+		},
 		{
 			name:    "Unrecognized message type",
 			message: "This is not a recognized message type",
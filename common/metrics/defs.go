@@ -303,6 +303,48 @@ const (
 	PersistenceFetchDynamicConfigScope
 	// PersistenceUpdateDynamicConfigScope tracks UpdateDynamicConfig calls made by service to persistence layer
 	PersistenceUpdateDynamicConfigScope
+	// PersistenceUpsertAsyncRequestScope tracks UpsertAsyncRequest calls made by service to persistence layer
+	PersistenceUpsertAsyncRequestScope
+	// PersistenceGetAsyncRequestScope tracks GetAsyncRequest calls made by service to persistence layer
+	PersistenceGetAsyncRequestScope
+	// PersistenceEnqueueAsyncRequestToDLQScope tracks EnqueueAsyncRequestToDLQ calls made by service to persistence layer
+	PersistenceEnqueueAsyncRequestToDLQScope
+	// PersistenceReadAsyncRequestDLQScope tracks ReadAsyncRequestDLQ calls made by service to persistence layer
+	PersistenceReadAsyncRequestDLQScope
+	// PersistenceGetAsyncRequestDLQEntryScope tracks GetAsyncRequestDLQEntry calls made by service to persistence layer
+	PersistenceGetAsyncRequestDLQEntryScope
+	// PersistenceDeleteAsyncRequestDLQEntryScope tracks DeleteAsyncRequestDLQEntry calls made by service to persistence layer
+	PersistenceDeleteAsyncRequestDLQEntryScope
+	// PersistenceEnqueueAsyncRequestQueueMessageScope tracks EnqueueAsyncRequestQueueMessage calls made by service to persistence layer
+	PersistenceEnqueueAsyncRequestQueueMessageScope
+	// PersistenceReadAsyncRequestQueueMessagesScope tracks ReadAsyncRequestQueueMessages calls made by service to persistence layer
+	PersistenceReadAsyncRequestQueueMessagesScope
+	// PersistenceDeleteAsyncRequestQueueMessagesScope tracks DeleteAsyncRequestQueueMessages calls made by service to persistence layer
+	PersistenceDeleteAsyncRequestQueueMessagesScope
+	// PersistenceGetAsyncRequestQueueMetadataScope tracks GetAsyncRequestQueueMetadata calls made by service to persistence layer
+	PersistenceGetAsyncRequestQueueMetadataScope
+	// PersistenceUpdateAsyncRequestQueueMetadataScope tracks UpdateAsyncRequestQueueMetadata calls made by service to persistence layer
+	PersistenceUpdateAsyncRequestQueueMetadataScope
+	// PersistenceEnqueueMapQItemsScope tracks EnqueueMapQItems calls made by service to persistence layer
+	PersistenceEnqueueMapQItemsScope
+	// PersistenceReadMapQItemsScope tracks ReadMapQItems calls made by service to persistence layer
+	PersistenceReadMapQItemsScope
+	// PersistenceDeleteMapQItemsScope tracks DeleteMapQItems calls made by service to persistence layer
+	PersistenceDeleteMapQItemsScope
+	// PersistenceGetMapQStateScope tracks GetMapQState calls made by service to persistence layer
+	PersistenceGetMapQStateScope
+	// PersistenceUpdateMapQStateScope tracks UpdateMapQState calls made by service to persistence layer
+	PersistenceUpdateMapQStateScope
+	// PersistenceGetShardDistributorStateScope tracks GetShardDistributorState calls made by service to persistence layer
+	PersistenceGetShardDistributorStateScope
+	// PersistenceUpdateShardDistributorLeaderScope tracks UpdateShardDistributorLeader calls made by service to persistence layer
+	PersistenceUpdateShardDistributorLeaderScope
+	// PersistenceUpsertShardDistributorExecutorScope tracks UpsertShardDistributorExecutor calls made by service to persistence layer
+	PersistenceUpsertShardDistributorExecutorScope
+	// PersistenceAssignShardDistributorShardsScope tracks AssignShardDistributorShards calls made by service to persistence layer
+	PersistenceAssignShardDistributorShardsScope
+	// PersistenceDeleteShardDistributorExecutorsScope tracks DeleteShardDistributorExecutors calls made by service to persistence layer
+	PersistenceDeleteShardDistributorExecutorsScope
 	// PersistenceShardRequestCountScope tracks number of persistence calls made to each shard
 	PersistenceShardRequestCountScope
 	// PersistenceGetActiveClusterSelectionPolicyScope tracks GetActiveClusterSelectionPolicy calls made by service to persistence layer
@@ -362,12 +404,18 @@ const (
 	HistoryClientRemoveSignalMutableStateScope
 	// HistoryClientTerminateWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientTerminateWorkflowExecutionScope
+	// HistoryClientUpdateWorkflowMemoScope tracks RPC calls to history service
+	HistoryClientUpdateWorkflowMemoScope
 	// HistoryClientResetWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientResetWorkflowExecutionScope
 	// HistoryClientScheduleDecisionTaskScope tracks RPC calls to history service
 	HistoryClientScheduleDecisionTaskScope
 	// HistoryClientRecordChildExecutionCompletedScope tracks RPC calls to history service
 	HistoryClientRecordChildExecutionCompletedScope
+	// HistoryClientGetCompletionCallbacksScope tracks RPC calls to history service
+	HistoryClientGetCompletionCallbacksScope
+	// HistoryClientRecordCompletionCallbackStateScope tracks RPC calls to history service
+	HistoryClientRecordCompletionCallbackStateScope
 	// HistoryClientSyncShardStatusScope tracks RPC calls to history service
 	HistoryClientReplicateEventsV2Scope
 	// HistoryClientReplicateRawEventsV2Scope tracks RPC calls to history service
@@ -433,6 +481,18 @@ const (
 	MatchingClientUpdateTaskListPartitionConfigScope
 	// MatchingClientRefreshTaskListPartitionConfigScope tracks RPC calls to matching service
 	MatchingClientRefreshTaskListPartitionConfigScope
+	// MatchingClientUpdateTaskListVersioningConfigScope tracks RPC calls to matching service
+	MatchingClientUpdateTaskListVersioningConfigScope
+	// MatchingClientGetTaskListScalingRecommendationScope tracks RPC calls to matching service
+	MatchingClientGetTaskListScalingRecommendationScope
+	// MatchingClientListWorkersScope tracks RPC calls to matching service
+	MatchingClientListWorkersScope
+	// MatchingClientDescribeWorkerScope tracks RPC calls to matching service
+	MatchingClientDescribeWorkerScope
+	// MatchingClientMigrateTaskListScope tracks RPC calls to matching service
+	MatchingClientMigrateTaskListScope
+	// MatchingClientDescribeDomainTaskQuotaScope tracks RPC calls to matching service
+	MatchingClientDescribeDomainTaskQuotaScope
 
 	// FrontendClientDeleteDomainScope tracks RPC calls to frontend service
 	FrontendClientDeleteDomainScope
@@ -474,6 +534,8 @@ const (
 	FrontendClientRegisterDomainScope
 	// FrontendClientRequestCancelWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientRequestCancelWorkflowExecutionScope
+	// FrontendClientRequestCancelWorkflowExecutionAsyncScope tracks RPC calls to frontend service
+	FrontendClientRequestCancelWorkflowExecutionAsyncScope
 	// FrontendClientResetStickyTaskListScope tracks RPC calls to frontend service
 	FrontendClientResetStickyTaskListScope
 	// FrontendClientRefreshWorkflowTasksScope tracks RPC calls to frontend service
@@ -504,6 +566,8 @@ const (
 	FrontendClientSignalWithStartWorkflowExecutionAsyncScope
 	// FrontendClientSignalWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientSignalWorkflowExecutionScope
+	// FrontendClientSignalWorkflowExecutionAsyncScope tracks RPC calls to frontend service
+	FrontendClientSignalWorkflowExecutionAsyncScope
 	// FrontendClientStartWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientStartWorkflowExecutionScope
 	// FrontendClientStartWorkflowExecutionAsyncScope tracks RPC calls to frontend service
@@ -512,6 +576,18 @@ const (
 	FrontendClientRestartWorkflowExecutionScope
 	// FrontendClientTerminateWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientTerminateWorkflowExecutionScope
+	// FrontendClientTerminateWorkflowExecutionAsyncScope tracks RPC calls to frontend service
+	FrontendClientTerminateWorkflowExecutionAsyncScope
+	// FrontendClientUpdateWorkflowMemoScope tracks RPC calls to frontend service
+	FrontendClientUpdateWorkflowMemoScope
+	// FrontendClientGetTaskListScalingRecommendationScope tracks RPC calls to frontend service
+	FrontendClientGetTaskListScalingRecommendationScope
+	// FrontendClientListWorkersScope tracks RPC calls to frontend service
+	FrontendClientListWorkersScope
+	// FrontendClientDescribeAsyncRequestScope tracks RPC calls to frontend service
+	FrontendClientDescribeAsyncRequestScope
+	// FrontendClientDescribeWorkerScope tracks RPC calls to frontend service
+	FrontendClientDescribeWorkerScope
 	// FrontendClientUpdateDomainScope tracks RPC calls to frontend service
 	FrontendClientUpdateDomainScope
 	// FrontendClientListWorkflowExecutionsScope tracks RPC calls to frontend service
@@ -609,6 +685,18 @@ const (
 	AdminClientUpdateDomainAsyncWorkflowConfiguratonScope
 	// AdminClientUpdateTaskListPartitionConfigScope is the metrics scope for admin.UpdateTaskListPartitionConfig
 	AdminClientUpdateTaskListPartitionConfigScope
+	// AdminClientUpdateTaskListVersioningConfigScope is the metrics scope for admin.UpdateTaskListVersioningConfig
+	AdminClientUpdateTaskListVersioningConfigScope
+	// AdminClientMigrateTaskListScope is the metrics scope for admin.MigrateTaskList
+	AdminClientMigrateTaskListScope
+	// AdminClientReadAsyncWorkflowDLQMessagesScope is the metrics scope for admin.ReadAsyncWorkflowDLQMessages
+	AdminClientReadAsyncWorkflowDLQMessagesScope
+	// AdminClientPurgeAsyncWorkflowDLQMessagesScope is the metrics scope for admin.PurgeAsyncWorkflowDLQMessages
+	AdminClientPurgeAsyncWorkflowDLQMessagesScope
+	// AdminClientReplayAsyncWorkflowDLQMessagesScope is the metrics scope for admin.ReplayAsyncWorkflowDLQMessages
+	AdminClientReplayAsyncWorkflowDLQMessagesScope
+	// AdminClientDescribeAsyncWorkflowQueueScope is the metrics scope for admin.DescribeAsyncWorkflowQueue
+	AdminClientDescribeAsyncWorkflowQueueScope
 
 	// DCRedirectionDeleteDomainScope tracks RPC calls for dc redirection
 	DCRedirectionDeleteDomainScope
@@ -658,6 +746,8 @@ const (
 	DCRedirectionRegisterDomainScope
 	// DCRedirectionRequestCancelWorkflowExecutionScope tracks RPC calls for dc redirection
 	DCRedirectionRequestCancelWorkflowExecutionScope
+	// DCRedirectionRequestCancelWorkflowExecutionAsyncScope tracks RPC calls for dc redirection
+	DCRedirectionRequestCancelWorkflowExecutionAsyncScope
 	// DCRedirectionResetStickyTaskListScope tracks RPC calls for dc redirection
 	DCRedirectionResetStickyTaskListScope
 	// DCRedirectionResetWorkflowExecutionScope tracks RPC calls for dc redirection
@@ -686,12 +776,26 @@ const (
 	DCRedirectionSignalWithStartWorkflowExecutionAsyncScope
 	// DCRedirectionSignalWorkflowExecutionScope tracks RPC calls for dc redirection
 	DCRedirectionSignalWorkflowExecutionScope
+	// DCRedirectionSignalWorkflowExecutionAsyncScope tracks RPC calls for dc redirection
+	DCRedirectionSignalWorkflowExecutionAsyncScope
 	// DCRedirectionStartWorkflowExecutionScope tracks RPC calls for dc redirection
 	DCRedirectionStartWorkflowExecutionScope
 	// DCRedirectionStartWorkflowExecutionAsyncScope tracks RPC calls for dc redirection
 	DCRedirectionStartWorkflowExecutionAsyncScope
 	// DCRedirectionTerminateWorkflowExecutionScope tracks RPC calls for dc redirection
 	DCRedirectionTerminateWorkflowExecutionScope
+	// DCRedirectionTerminateWorkflowExecutionAsyncScope tracks RPC calls for dc redirection
+	DCRedirectionTerminateWorkflowExecutionAsyncScope
+	// DCRedirectionUpdateWorkflowMemoScope tracks RPC calls for dc redirection
+	DCRedirectionUpdateWorkflowMemoScope
+	// DCRedirectionGetTaskListScalingRecommendationScope tracks RPC calls for dc redirection
+	DCRedirectionGetTaskListScalingRecommendationScope
+	// DCRedirectionListWorkersScope tracks RPC calls for dc redirection
+	DCRedirectionListWorkersScope
+	// DCRedirectionDescribeAsyncRequestScope tracks RPC calls for dc redirection
+	DCRedirectionDescribeAsyncRequestScope
+	// DCRedirectionDescribeWorkerScope tracks RPC calls for dc redirection
+	DCRedirectionDescribeWorkerScope
 	// DCRedirectionUpdateDomainScope tracks RPC calls for dc redirection
 	DCRedirectionUpdateDomainScope
 	// DCRedirectionListTaskListPartitionsScope tracks RPC calls for dc redirection
@@ -870,6 +974,19 @@ const (
 	// ShardDistributorClientGetShardOwnerScope tracks GetShardOwner calls made by service to shard distributor
 	ShardDistributorClientGetShardOwnerScope
 
+	// ShardDistributorClientWatchNamespaceStateScope tracks WatchNamespaceState calls made by service to shard distributor
+	ShardDistributorClientWatchNamespaceStateScope
+	// ShardDistributorClientListNamespacesScope tracks ListNamespaces calls made by service to shard distributor
+	ShardDistributorClientListNamespacesScope
+	// ShardDistributorClientDescribeNamespaceScope tracks DescribeNamespace calls made by service to shard distributor
+	ShardDistributorClientDescribeNamespaceScope
+	// ShardDistributorClientMoveShardScope tracks MoveShard calls made by service to shard distributor
+	ShardDistributorClientMoveShardScope
+	// ShardDistributorClientSetExecutorDrainedScope tracks SetExecutorDrained calls made by service to shard distributor
+	ShardDistributorClientSetExecutorDrainedScope
+	// ShardDistributorClientSetRebalancingFrozenScope tracks SetRebalancingFrozen calls made by service to shard distributor
+	ShardDistributorClientSetRebalancingFrozenScope
+
 	// ShardDistributorExecutorClientHeartbeatScope tracks Heartbeat calls made by executor to shard distributor
 	ShardDistributorExecutorClientHeartbeatScope
 
@@ -951,6 +1068,18 @@ const (
 	UpdateDomainAsyncWorkflowConfiguraton
 	// UpdateTaskListPartitionConfig is the scope for update task list partition config
 	UpdateTaskListPartitionConfig
+	// UpdateTaskListVersioningConfig is the scope for update task list versioning config
+	UpdateTaskListVersioningConfig
+	// MigrateTaskList is the scope for migrate task list
+	MigrateTaskList
+	// ReadAsyncWorkflowDLQMessages is the scope for read async workflow DLQ messages
+	ReadAsyncWorkflowDLQMessages
+	// PurgeAsyncWorkflowDLQMessages is the scope for purge async workflow DLQ messages
+	PurgeAsyncWorkflowDLQMessages
+	// ReplayAsyncWorkflowDLQMessages is the scope for replay async workflow DLQ messages
+	ReplayAsyncWorkflowDLQMessages
+	// DescribeAsyncWorkflowQueue is the scope for describe async workflow queue
+	DescribeAsyncWorkflowQueue
 
 	NumAdminScopes
 )
@@ -997,14 +1126,30 @@ const (
 	FrontendPollForWorklfowExecutionRawHistoryScope
 	// FrontendSignalWorkflowExecutionScope is the metric scope for frontend.SignalWorkflowExecution
 	FrontendSignalWorkflowExecutionScope
+	// FrontendSignalWorkflowExecutionAsyncScope is the metric scope for frontend.SignalWorkflowExecutionAsync
+	FrontendSignalWorkflowExecutionAsyncScope
 	// FrontendSignalWithStartWorkflowExecutionScope is the metric scope for frontend.SignalWithStartWorkflowExecution
 	FrontendSignalWithStartWorkflowExecutionScope
 	// FrontendSignalWithStartWorkflowExecutionAsyncScope is the metric scope for frontend.SignalWithStartWorkflowExecutionAsync
 	FrontendSignalWithStartWorkflowExecutionAsyncScope
 	// FrontendTerminateWorkflowExecutionScope is the metric scope for frontend.TerminateWorkflowExecution
 	FrontendTerminateWorkflowExecutionScope
+	// FrontendTerminateWorkflowExecutionAsyncScope is the metric scope for frontend.TerminateWorkflowExecutionAsync
+	FrontendTerminateWorkflowExecutionAsyncScope
+	// FrontendUpdateWorkflowMemoScope is the metric scope for frontend.UpdateWorkflowMemo
+	FrontendUpdateWorkflowMemoScope
+	// FrontendGetTaskListScalingRecommendationScope is the metric scope for frontend.GetTaskListScalingRecommendation
+	FrontendGetTaskListScalingRecommendationScope
+	// FrontendListWorkersScope is the metric scope for frontend.ListWorkers
+	FrontendListWorkersScope
+	// FrontendDescribeAsyncRequestScope is the metric scope for frontend.DescribeAsyncRequest
+	FrontendDescribeAsyncRequestScope
+	// FrontendDescribeWorkerScope is the metric scope for frontend.DescribeWorker
+	FrontendDescribeWorkerScope
 	// FrontendRequestCancelWorkflowExecutionScope is the metric scope for frontend.RequestCancelWorkflowExecution
 	FrontendRequestCancelWorkflowExecutionScope
+	// FrontendRequestCancelWorkflowExecutionAsyncScope is the metric scope for frontend.RequestCancelWorkflowExecutionAsync
+	FrontendRequestCancelWorkflowExecutionAsyncScope
 	// FrontendListArchivedWorkflowExecutionsScope is the metric scope for frontend.ListArchivedWorkflowExecutions
 	FrontendListArchivedWorkflowExecutionsScope
 	// FrontendListOpenWorkflowExecutionsScope is the metric scope for frontend.ListOpenWorkflowExecutions
@@ -1100,10 +1245,16 @@ const (
 	HistoryRemoveSignalMutableStateScope
 	// HistoryTerminateWorkflowExecutionScope tracks TerminateWorkflowExecution API calls received by service
 	HistoryTerminateWorkflowExecutionScope
+	// HistoryUpdateWorkflowMemoScope tracks UpdateWorkflowMemo API calls received by service
+	HistoryUpdateWorkflowMemoScope
 	// HistoryScheduleDecisionTaskScope tracks ScheduleDecisionTask API calls received by service
 	HistoryScheduleDecisionTaskScope
 	// HistoryRecordChildExecutionCompletedScope tracks CompleteChildExecution API calls received by service
 	HistoryRecordChildExecutionCompletedScope
+	// HistoryGetCompletionCallbacksScope tracks GetCompletionCallbacks API calls received by service
+	HistoryGetCompletionCallbacksScope
+	// HistoryRecordCompletionCallbackStateScope tracks RecordCompletionCallbackState API calls received by service
+	HistoryRecordCompletionCallbackStateScope
 	// HistoryRequestCancelWorkflowExecutionScope tracks RequestCancelWorkflowExecution API calls received by service
 	HistoryRequestCancelWorkflowExecutionScope
 	// HistoryReplicateEventsScope tracks ReplicateEvents API calls received by service
@@ -1180,6 +1331,8 @@ const (
 	TransferActiveTaskRecordChildExecutionCompletedScope
 	// TransferActiveTaskApplyParentClosePolicyScope is the scope used for apply parent close policy task processing by transfer queue processor
 	TransferActiveTaskApplyParentClosePolicyScope
+	// TransferActiveTaskCompletionCallbackScope is the scope used for completion callback task processing by transfer queue processor
+	TransferActiveTaskCompletionCallbackScope
 	// TransferStandbyTaskResetWorkflowScope is the scope used for record workflow started task processing by transfer queue processor
 	TransferStandbyTaskResetWorkflowScope
 	// TransferStandbyTaskActivityScope is the scope used for activity task processing by transfer queue processor
@@ -1204,6 +1357,8 @@ const (
 	TransferStandbyTaskRecordChildExecutionCompletedScope
 	// TransferActiveTaskApplyParentClosePolicyScope is the scope used for apply parent close policy task processing by transfer queue processor
 	TransferStandbyTaskApplyParentClosePolicyScope
+	// TransferStandbyTaskCompletionCallbackScope is the scope used for completion callback task processing by transfer queue processor
+	TransferStandbyTaskCompletionCallbackScope
 	// TimerQueueProcessorScope is the scope used by all metric emitted by timer queue processor
 	TimerQueueProcessorScope
 	// TimerQueueProcessorV2Scope is the scope used by all metric emitted by timer queue processor
@@ -1342,6 +1497,8 @@ const (
 	HistoryFlushBufferedEventsScope
 	// HistoryTaskSchedulerMigrationScope is the scope used by history task scheduler migration
 	HistoryTaskSchedulerMigrationScope
+	// HistoryCDCPublisherScope is the scope used by the workflow CDC publisher
+	HistoryCDCPublisherScope
 
 	NumHistoryScopes
 )
@@ -1378,6 +1535,18 @@ const (
 	MatchingUpdateTaskListPartitionConfigScope
 	// MatchingRefreshTaskListPartitionConfigScope tracks RefreshTaskListPartitionConfig API calls received by service
 	MatchingRefreshTaskListPartitionConfigScope
+	// MatchingUpdateTaskListVersioningConfigScope tracks UpdateTaskListVersioningConfig API calls received by service
+	MatchingUpdateTaskListVersioningConfigScope
+	// MatchingGetTaskListScalingRecommendationScope tracks GetTaskListScalingRecommendation API calls received by service
+	MatchingGetTaskListScalingRecommendationScope
+	// MatchingListWorkersScope tracks ListWorkers API calls received by service
+	MatchingListWorkersScope
+	// MatchingDescribeWorkerScope tracks DescribeWorker API calls received by service
+	MatchingDescribeWorkerScope
+	// MatchingMigrateTaskListScope tracks MigrateTaskList API calls received by service
+	MatchingMigrateTaskListScope
+	// MatchingDescribeDomainTaskQuotaScope tracks DescribeDomainTaskQuota API calls received by service
+	MatchingDescribeDomainTaskQuotaScope
 
 	NumMatchingScopes
 )
@@ -1416,6 +1585,8 @@ const (
 	HistoryScavengerScope
 	// ParentClosePolicyProcessorScope is scope used by all metrics emitted by worker.ParentClosePolicyProcessor
 	ParentClosePolicyProcessorScope
+	// CompletionCallbackProcessorScope is scope used by all metrics emitted by worker.CompletionCallbackProcessor
+	CompletionCallbackProcessorScope
 	// ShardScannerScope is scope used by all metrics emitted by worker.shardscanner module
 	ShardScannerScope
 	// CheckDataCorruptionWorkflowScope is scope used by the data corruption workflow
@@ -1426,6 +1597,8 @@ const (
 	AsyncWorkflowConsumerScope
 	// DiagnosticsWorkflowScope is scope used by diagnostics workflow
 	DiagnosticsWorkflowScope
+	// VisibilityReconcilerScope is scope used by all metrics emitted by worker.visibility.Reconciler module
+	VisibilityReconcilerScope
 
 	NumWorkerScopes
 )
@@ -1434,6 +1607,18 @@ const (
 const (
 	// ShardDistributorGetShardOwnerScope tracks GetShardOwner API calls received by service
 	ShardDistributorGetShardOwnerScope = iota + NumCommonScopes
+	// ShardDistributorWatchNamespaceStateScope tracks WatchNamespaceState API calls received by service
+	ShardDistributorWatchNamespaceStateScope
+	// ShardDistributorListNamespacesScope tracks ListNamespaces API calls received by service
+	ShardDistributorListNamespacesScope
+	// ShardDistributorDescribeNamespaceScope tracks DescribeNamespace API calls received by service
+	ShardDistributorDescribeNamespaceScope
+	// ShardDistributorMoveShardScope tracks MoveShard API calls received by service
+	ShardDistributorMoveShardScope
+	// ShardDistributorSetExecutorDrainedScope tracks SetExecutorDrained API calls received by service
+	ShardDistributorSetExecutorDrainedScope
+	// ShardDistributorSetRebalancingFrozenScope tracks SetRebalancingFrozen API calls received by service
+	ShardDistributorSetRebalancingFrozenScope
 	ShardDistributorAssignLoopScope
 
 	NumShardDistributorScopes
@@ -1535,6 +1720,27 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceGetDLQSizeScope:                               {operation: "GetDLQSize"},
 		PersistenceFetchDynamicConfigScope:                       {operation: "FetchDynamicConfig"},
 		PersistenceUpdateDynamicConfigScope:                      {operation: "UpdateDynamicConfig"},
+		PersistenceUpsertAsyncRequestScope:                       {operation: "UpsertAsyncRequest"},
+		PersistenceGetAsyncRequestScope:                          {operation: "GetAsyncRequest"},
+		PersistenceEnqueueAsyncRequestToDLQScope:                 {operation: "EnqueueAsyncRequestToDLQ"},
+		PersistenceReadAsyncRequestDLQScope:                      {operation: "ReadAsyncRequestDLQ"},
+		PersistenceGetAsyncRequestDLQEntryScope:                  {operation: "GetAsyncRequestDLQEntry"},
+		PersistenceDeleteAsyncRequestDLQEntryScope:               {operation: "DeleteAsyncRequestDLQEntry"},
+		PersistenceEnqueueAsyncRequestQueueMessageScope:          {operation: "EnqueueAsyncRequestQueueMessage"},
+		PersistenceReadAsyncRequestQueueMessagesScope:            {operation: "ReadAsyncRequestQueueMessages"},
+		PersistenceDeleteAsyncRequestQueueMessagesScope:          {operation: "DeleteAsyncRequestQueueMessages"},
+		PersistenceGetAsyncRequestQueueMetadataScope:             {operation: "GetAsyncRequestQueueMetadata"},
+		PersistenceUpdateAsyncRequestQueueMetadataScope:          {operation: "UpdateAsyncRequestQueueMetadata"},
+		PersistenceEnqueueMapQItemsScope:                         {operation: "EnqueueMapQItems"},
+		PersistenceReadMapQItemsScope:                            {operation: "ReadMapQItems"},
+		PersistenceDeleteMapQItemsScope:                          {operation: "DeleteMapQItems"},
+		PersistenceGetMapQStateScope:                             {operation: "GetMapQState"},
+		PersistenceUpdateMapQStateScope:                          {operation: "UpdateMapQState"},
+		PersistenceGetShardDistributorStateScope:                 {operation: "GetShardDistributorState"},
+		PersistenceUpdateShardDistributorLeaderScope:             {operation: "UpdateShardDistributorLeader"},
+		PersistenceUpsertShardDistributorExecutorScope:           {operation: "UpsertShardDistributorExecutor"},
+		PersistenceAssignShardDistributorShardsScope:             {operation: "AssignShardDistributorShards"},
+		PersistenceDeleteShardDistributorExecutorsScope:          {operation: "DeleteShardDistributorExecutors"},
 		PersistenceShardRequestCountScope:                        {operation: "ShardIdPersistenceRequest"},
 		PersistenceGetActiveClusterSelectionPolicyScope:          {operation: "GetActiveClusterSelectionPolicy"},
 		PersistenceDeleteActiveClusterSelectionPolicyScope:       {operation: "DeleteActiveClusterSelectionPolicy"},
@@ -1566,9 +1772,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientSignalWithStartWorkflowExecutionScope:  {operation: "HistoryClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRemoveSignalMutableStateScope:          {operation: "HistoryClientRemoveSignalMutableState", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientTerminateWorkflowExecutionScope:        {operation: "HistoryClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientUpdateWorkflowMemoScope:                {operation: "HistoryClientUpdateWorkflowMemo", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientResetWorkflowExecutionScope:            {operation: "HistoryClientResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientScheduleDecisionTaskScope:              {operation: "HistoryClientScheduleDecisionTask", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRecordChildExecutionCompletedScope:     {operation: "HistoryClientRecordChildExecutionCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetCompletionCallbacksScope:            {operation: "HistoryClientGetCompletionCallbacks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRecordCompletionCallbackStateScope:     {operation: "HistoryClientRecordCompletionCallbackState", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientReplicateEventsV2Scope:                 {operation: "HistoryClientReplicateEventsV2", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientSyncShardStatusScope:                   {operation: "HistoryClientSyncShardStatus", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientSyncActivityScope:                      {operation: "HistoryClientSyncActivity", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
@@ -1590,18 +1799,24 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientWfIDCacheScope:                         {operation: "HistoryClientWfIDCache", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRatelimitUpdateScope:                   {operation: "HistoryClientRatelimitUpdate", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 
-		MatchingClientPollForDecisionTaskScope:            {operation: "MatchingClientPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
-		MatchingClientPollForActivityTaskScope:            {operation: "MatchingClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
-		MatchingClientAddActivityTaskScope:                {operation: "MatchingClientAddActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
-		MatchingClientAddDecisionTaskScope:                {operation: "MatchingClientAddDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
-		MatchingClientQueryWorkflowScope:                  {operation: "MatchingClientQueryWorkflow", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
-		MatchingClientRespondQueryTaskCompletedScope:      {operation: "MatchingClientRespondQueryTaskCompleted", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
-		MatchingClientCancelOutstandingPollScope:          {operation: "MatchingClientCancelOutstandingPoll", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
-		MatchingClientDescribeTaskListScope:               {operation: "MatchingClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
-		MatchingClientListTaskListPartitionsScope:         {operation: "MatchingClientListTaskListPartitions", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
-		MatchingClientGetTaskListsByDomainScope:           {operation: "MatchingClientGetTaskListsByDomain", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
-		MatchingClientUpdateTaskListPartitionConfigScope:  {operation: "MatchingClientUpdateTaskListPartitionConfig", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
-		MatchingClientRefreshTaskListPartitionConfigScope: {operation: "MatchingClientRefreshTaskListPartitionConfig", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientPollForDecisionTaskScope:              {operation: "MatchingClientPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientPollForActivityTaskScope:              {operation: "MatchingClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientAddActivityTaskScope:                  {operation: "MatchingClientAddActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientAddDecisionTaskScope:                  {operation: "MatchingClientAddDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientQueryWorkflowScope:                    {operation: "MatchingClientQueryWorkflow", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientRespondQueryTaskCompletedScope:        {operation: "MatchingClientRespondQueryTaskCompleted", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientCancelOutstandingPollScope:            {operation: "MatchingClientCancelOutstandingPoll", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientDescribeTaskListScope:                 {operation: "MatchingClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientListTaskListPartitionsScope:           {operation: "MatchingClientListTaskListPartitions", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientGetTaskListsByDomainScope:             {operation: "MatchingClientGetTaskListsByDomain", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientUpdateTaskListPartitionConfigScope:    {operation: "MatchingClientUpdateTaskListPartitionConfig", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientRefreshTaskListPartitionConfigScope:   {operation: "MatchingClientRefreshTaskListPartitionConfig", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientUpdateTaskListVersioningConfigScope:   {operation: "MatchingClientUpdateTaskListVersioningConfig", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientGetTaskListScalingRecommendationScope: {operation: "MatchingClientGetTaskListScalingRecommendation", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientListWorkersScope:                      {operation: "MatchingClientListWorkers", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientDescribeWorkerScope:                   {operation: "MatchingClientDescribeWorker", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientMigrateTaskListScope:                  {operation: "MatchingClientMigrateTaskList", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientDescribeDomainTaskQuotaScope:          {operation: "MatchingClientDescribeDomainTaskQuota", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 
 		FrontendClientDeleteDomainScope:                          {operation: "FrontendClientDeleteDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientDeprecateDomainScope:                       {operation: "FrontendClientDeprecateDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
@@ -1623,6 +1838,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendClientRecordActivityTaskHeartbeatByIDScope:       {operation: "FrontendClientRecordActivityTaskHeartbeatByID", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientRegisterDomainScope:                        {operation: "FrontendClientRegisterDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientRequestCancelWorkflowExecutionScope:        {operation: "FrontendClientRequestCancelWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientRequestCancelWorkflowExecutionAsyncScope:   {operation: "FrontendClientRequestCancelWorkflowExecutionAsync", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientResetStickyTaskListScope:                   {operation: "FrontendClientResetStickyTaskList", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientRefreshWorkflowTasksScope:                  {operation: "FrontendClientRefreshWorkflowTasks", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientResetWorkflowExecutionScope:                {operation: "FrontendClientResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
@@ -1638,9 +1854,16 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendClientSignalWithStartWorkflowExecutionScope:      {operation: "FrontendClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientSignalWithStartWorkflowExecutionAsyncScope: {operation: "FrontendClientSignalWithStartWorkflowExecutionAsync", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientSignalWorkflowExecutionScope:               {operation: "FrontendClientSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientSignalWorkflowExecutionAsyncScope:          {operation: "FrontendClientSignalWorkflowExecutionAsync", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientStartWorkflowExecutionScope:                {operation: "FrontendClientStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientStartWorkflowExecutionAsyncScope:           {operation: "FrontendClientStartWorkflowExecutionAsync", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientTerminateWorkflowExecutionScope:            {operation: "FrontendClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientTerminateWorkflowExecutionAsyncScope:       {operation: "FrontendClientTerminateWorkflowExecutionAsync", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientUpdateWorkflowMemoScope:                    {operation: "FrontendClientUpdateWorkflowMemo", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientGetTaskListScalingRecommendationScope:      {operation: "FrontendClientGetTaskListScalingRecommendation", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientListWorkersScope:                           {operation: "FrontendClientListWorkers", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientDescribeAsyncRequestScope:                  {operation: "FrontendClientDescribeAsyncRequest", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientDescribeWorkerScope:                        {operation: "FrontendClientDescribeWorker", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientUpdateDomainScope:                          {operation: "FrontendClientUpdateDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientListWorkflowExecutionsScope:                {operation: "FrontendClientListWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientScanWorkflowExecutionsScope:                {operation: "FrontendClientScanWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
@@ -1691,6 +1914,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientGetDomainAsyncWorkflowConfiguratonScope:    {operation: "AdminClientGetDomainAsyncWorkflowConfiguraton", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientUpdateDomainAsyncWorkflowConfiguratonScope: {operation: "AdminClientUpdateDomainAsyncWorkflowConfiguraton", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientUpdateTaskListPartitionConfigScope:         {operation: "AdminClientUpdateTaskListPartitionConfig", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientUpdateTaskListVersioningConfigScope:        {operation: "AdminClientUpdateTaskListVersioningConfig", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientMigrateTaskListScope:                       {operation: "AdminClientMigrateTaskList", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientReadAsyncWorkflowDLQMessagesScope:          {operation: "AdminClientReadAsyncWorkflowDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientPurgeAsyncWorkflowDLQMessagesScope:         {operation: "AdminClientPurgeAsyncWorkflowDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientReplayAsyncWorkflowDLQMessagesScope:        {operation: "AdminClientReplayAsyncWorkflowDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientDescribeAsyncWorkflowQueueScope:            {operation: "AdminClientDescribeAsyncWorkflowQueue", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 
 		DCRedirectionDeleteDomainScope:                          {operation: "DCRedirectionDeleteDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDeprecateDomainScope:                       {operation: "DCRedirectionDeprecateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -1716,6 +1945,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		DCRedirectionRecordActivityTaskHeartbeatByIDScope:       {operation: "DCRedirectionRecordActivityTaskHeartbeatByID", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionRegisterDomainScope:                        {operation: "DCRedirectionRegisterDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionRequestCancelWorkflowExecutionScope:        {operation: "DCRedirectionRequestCancelWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionRequestCancelWorkflowExecutionAsyncScope:   {operation: "DCRedirectionRequestCancelWorkflowExecutionAsync", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionResetStickyTaskListScope:                   {operation: "DCRedirectionResetStickyTaskList", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionResetWorkflowExecutionScope:                {operation: "DCRedirectionResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionRespondActivityTaskCanceledScope:           {operation: "DCRedirectionRespondActivityTaskCanceled", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -1730,9 +1960,16 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		DCRedirectionSignalWithStartWorkflowExecutionScope:      {operation: "DCRedirectionSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionSignalWithStartWorkflowExecutionAsyncScope: {operation: "DCRedirectionSignalWithStartWorkflowExecutionAsync", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionSignalWorkflowExecutionScope:               {operation: "DCRedirectionSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionSignalWorkflowExecutionAsyncScope:          {operation: "DCRedirectionSignalWorkflowExecutionAsync", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionStartWorkflowExecutionScope:                {operation: "DCRedirectionStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionStartWorkflowExecutionAsyncScope:           {operation: "DCRedirectionStartWorkflowExecutionAsync", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionTerminateWorkflowExecutionScope:            {operation: "DCRedirectionTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionTerminateWorkflowExecutionAsyncScope:       {operation: "DCRedirectionTerminateWorkflowExecutionAsync", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUpdateWorkflowMemoScope:                    {operation: "DCRedirectionUpdateWorkflowMemo", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionGetTaskListScalingRecommendationScope:      {operation: "DCRedirectionGetTaskListScalingRecommendation", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionListWorkersScope:                           {operation: "DCRedirectionListWorkers", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDescribeAsyncRequestScope:                  {operation: "DCRedirectionDescribeAsyncRequest", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDescribeWorkerScope:                        {operation: "DCRedirectionDescribeWorker", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionUpdateDomainScope:                          {operation: "DCRedirectionUpdateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionListTaskListPartitionsScope:                {operation: "DCRedirectionListTaskListPartitions", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionGetTaskListsByDomainScope:                  {operation: "DCRedirectionGetTaskListsByDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -1814,8 +2051,14 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		P2PRPCPeerChooserScope:       {operation: "P2PRPCPeerChooser"},
 		PartitionConfigProviderScope: {operation: "PartitionConfigProvider"},
 
-		ShardDistributorClientGetShardOwnerScope:     {operation: "ShardDistributorClientGetShardOwner"},
-		ShardDistributorExecutorClientHeartbeatScope: {operation: "ShardDistributorExecutorHeartbeat"},
+		ShardDistributorClientGetShardOwnerScope:        {operation: "ShardDistributorClientGetShardOwner"},
+		ShardDistributorClientWatchNamespaceStateScope:  {operation: "ShardDistributorClientWatchNamespaceState"},
+		ShardDistributorClientListNamespacesScope:       {operation: "ShardDistributorClientListNamespaces"},
+		ShardDistributorClientDescribeNamespaceScope:    {operation: "ShardDistributorClientDescribeNamespace"},
+		ShardDistributorClientMoveShardScope:            {operation: "ShardDistributorClientMoveShard"},
+		ShardDistributorClientSetExecutorDrainedScope:   {operation: "ShardDistributorClientSetExecutorDrained"},
+		ShardDistributorClientSetRebalancingFrozenScope: {operation: "ShardDistributorClientSetRebalancingFrozen"},
+		ShardDistributorExecutorClientHeartbeatScope:    {operation: "ShardDistributorExecutorHeartbeat"},
 
 		LoadBalancerScope: {operation: "RRLoadBalancer"},
 	},
@@ -1857,6 +2100,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		GetDomainAsyncWorkflowConfiguraton:          {operation: "GetDomainAsyncWorkflowConfiguraton"},
 		UpdateDomainAsyncWorkflowConfiguraton:       {operation: "UpdateDomainAsyncWorkflowConfiguraton"},
 		UpdateTaskListPartitionConfig:               {operation: "UpdateTaskListPartitionConfig"},
+		UpdateTaskListVersioningConfig:              {operation: "UpdateTaskListVersioningConfig"},
+		MigrateTaskList:                             {operation: "MigrateTaskList"},
+		ReadAsyncWorkflowDLQMessages:                {operation: "ReadAsyncWorkflowDLQMessages"},
+		PurgeAsyncWorkflowDLQMessages:               {operation: "PurgeAsyncWorkflowDLQMessages"},
+		ReplayAsyncWorkflowDLQMessages:              {operation: "ReplayAsyncWorkflowDLQMessages"},
+		DescribeAsyncWorkflowQueue:                  {operation: "DescribeAsyncWorkflowQueue"},
 
 		FrontendRestartWorkflowExecutionScope:              {operation: "RestartWorkflowExecution"},
 		FrontendStartWorkflowExecutionScope:                {operation: "StartWorkflowExecution"},
@@ -1878,11 +2127,19 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendGetWorkflowExecutionRawHistoryScope:        {operation: "GetWorkflowExecutionRawHistory"},
 		FrontendPollForWorklfowExecutionRawHistoryScope:    {operation: "PollForWorklfowExecutionRawHistory"},
 		FrontendSignalWorkflowExecutionScope:               {operation: "SignalWorkflowExecution"},
+		FrontendSignalWorkflowExecutionAsyncScope:          {operation: "SignalWorkflowExecutionAsync"},
 		FrontendSignalWithStartWorkflowExecutionScope:      {operation: "SignalWithStartWorkflowExecution"},
 		FrontendSignalWithStartWorkflowExecutionAsyncScope: {operation: "SignalWithStartWorkflowExecutionAsync"},
 		FrontendTerminateWorkflowExecutionScope:            {operation: "TerminateWorkflowExecution"},
+		FrontendTerminateWorkflowExecutionAsyncScope:       {operation: "TerminateWorkflowExecutionAsync"},
+		FrontendUpdateWorkflowMemoScope:                    {operation: "UpdateWorkflowMemo"},
+		FrontendGetTaskListScalingRecommendationScope:      {operation: "GetTaskListScalingRecommendation"},
+		FrontendListWorkersScope:                           {operation: "ListWorkers"},
+		FrontendDescribeAsyncRequestScope:                  {operation: "DescribeAsyncRequest"},
+		FrontendDescribeWorkerScope:                        {operation: "DescribeWorker"},
 		FrontendResetWorkflowExecutionScope:                {operation: "ResetWorkflowExecution"},
 		FrontendRequestCancelWorkflowExecutionScope:        {operation: "RequestCancelWorkflowExecution"},
+		FrontendRequestCancelWorkflowExecutionAsyncScope:   {operation: "RequestCancelWorkflowExecutionAsync"},
 		FrontendListArchivedWorkflowExecutionsScope:        {operation: "ListArchivedWorkflowExecutions"},
 		FrontendListOpenWorkflowExecutionsScope:            {operation: "ListOpenWorkflowExecutions"},
 		FrontendListClosedWorkflowExecutionsScope:          {operation: "ListClosedWorkflowExecutions"},
@@ -1929,11 +2186,14 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistorySignalWithStartWorkflowExecutionScope:                    {operation: "SignalWithStartWorkflowExecution"},
 		HistoryRemoveSignalMutableStateScope:                            {operation: "RemoveSignalMutableState"},
 		HistoryTerminateWorkflowExecutionScope:                          {operation: "TerminateWorkflowExecution"},
+		HistoryUpdateWorkflowMemoScope:                                  {operation: "UpdateWorkflowMemo"},
 		HistoryResetWorkflowExecutionScope:                              {operation: "ResetWorkflowExecution"},
 		HistoryQueryWorkflowScope:                                       {operation: "QueryWorkflow"},
 		HistoryProcessDeleteHistoryEventScope:                           {operation: "ProcessDeleteHistoryEvent"},
 		HistoryScheduleDecisionTaskScope:                                {operation: "ScheduleDecisionTask"},
 		HistoryRecordChildExecutionCompletedScope:                       {operation: "RecordChildExecutionCompleted"},
+		HistoryGetCompletionCallbacksScope:                              {operation: "GetCompletionCallbacks"},
+		HistoryRecordCompletionCallbackStateScope:                       {operation: "RecordCompletionCallbackState"},
 		HistoryRequestCancelWorkflowExecutionScope:                      {operation: "RequestCancelWorkflowExecution"},
 		HistoryReplicateEventsScope:                                     {operation: "ReplicateEvents"},
 		HistoryReplicateRawEventsScope:                                  {operation: "ReplicateRawEvents"},
@@ -1972,6 +2232,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TransferActiveTaskRecordWorkflowClosedScope:                     {operation: "TransferActiveTaskRecordWorkflowClosed"},
 		TransferActiveTaskRecordChildExecutionCompletedScope:            {operation: "TransferActiveTaskRecordChildExecutionCompleted"},
 		TransferActiveTaskApplyParentClosePolicyScope:                   {operation: "TransferActiveTaskApplyParentClosePolicy"},
+		TransferActiveTaskCompletionCallbackScope:                       {operation: "TransferActiveTaskCompletionCallback"},
 		TransferStandbyTaskActivityScope:                                {operation: "TransferStandbyTaskActivity"},
 		TransferStandbyTaskDecisionScope:                                {operation: "TransferStandbyTaskDecision"},
 		TransferStandbyTaskCloseExecutionScope:                          {operation: "TransferStandbyTaskCloseExecution"},
@@ -1984,6 +2245,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TransferStandbyTaskRecordWorkflowClosedScope:                    {operation: "TransferStandbyTaskRecordWorkflowClosed"},
 		TransferStandbyTaskRecordChildExecutionCompletedScope:           {operation: "TransferStandbyTaskRecordChildExecutionCompleted"},
 		TransferStandbyTaskApplyParentClosePolicyScope:                  {operation: "TransferStandbyTaskApplyParentClosePolicy"},
+		TransferStandbyTaskCompletionCallbackScope:                      {operation: "TransferStandbyTaskCompletionCallback"},
 		TimerQueueProcessorScope:                                        {operation: "TimerQueueProcessor"},
 		TimerQueueProcessorV2Scope:                                      {operation: "TimerQueueProcessorV2"},
 		TimerActiveQueueProcessorScope:                                  {operation: "TimerActiveQueueProcessor"},
@@ -2050,24 +2312,31 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryWorkflowCacheScope:                                       {operation: "HistoryWorkflowCache"},
 		HistoryFlushBufferedEventsScope:                                 {operation: "HistoryFlushBufferedEvents"},
 		HistoryTaskSchedulerMigrationScope:                              {operation: "HistoryTaskSchedulerMigration"},
+		HistoryCDCPublisherScope:                                        {operation: "HistoryCDCPublisher"},
 	},
 	// Matching Scope Names
 	Matching: {
-		MatchingPollForDecisionTaskScope:            {operation: "PollForDecisionTask"},
-		MatchingPollForActivityTaskScope:            {operation: "PollForActivityTask"},
-		MatchingAddActivityTaskScope:                {operation: "AddActivityTask"},
-		MatchingAddDecisionTaskScope:                {operation: "AddDecisionTask"},
-		MatchingAddTaskScope:                        {operation: "AddTask"},
-		MatchingTaskListMgrScope:                    {operation: "TaskListMgr"},
-		MatchingAdaptiveScalerScope:                 {operation: "adaptivescaler"},
-		MatchingQueryWorkflowScope:                  {operation: "QueryWorkflow"},
-		MatchingRespondQueryTaskCompletedScope:      {operation: "RespondQueryTaskCompleted"},
-		MatchingCancelOutstandingPollScope:          {operation: "CancelOutstandingPoll"},
-		MatchingDescribeTaskListScope:               {operation: "DescribeTaskList"},
-		MatchingListTaskListPartitionsScope:         {operation: "ListTaskListPartitions"},
-		MatchingGetTaskListsByDomainScope:           {operation: "GetTaskListsByDomain"},
-		MatchingUpdateTaskListPartitionConfigScope:  {operation: "UpdateTaskListPartitionConfig"},
-		MatchingRefreshTaskListPartitionConfigScope: {operation: "RefreshTaskListPartitionConfig"},
+		MatchingPollForDecisionTaskScope:              {operation: "PollForDecisionTask"},
+		MatchingPollForActivityTaskScope:              {operation: "PollForActivityTask"},
+		MatchingAddActivityTaskScope:                  {operation: "AddActivityTask"},
+		MatchingAddDecisionTaskScope:                  {operation: "AddDecisionTask"},
+		MatchingAddTaskScope:                          {operation: "AddTask"},
+		MatchingTaskListMgrScope:                      {operation: "TaskListMgr"},
+		MatchingAdaptiveScalerScope:                   {operation: "adaptivescaler"},
+		MatchingQueryWorkflowScope:                    {operation: "QueryWorkflow"},
+		MatchingRespondQueryTaskCompletedScope:        {operation: "RespondQueryTaskCompleted"},
+		MatchingCancelOutstandingPollScope:            {operation: "CancelOutstandingPoll"},
+		MatchingDescribeTaskListScope:                 {operation: "DescribeTaskList"},
+		MatchingListTaskListPartitionsScope:           {operation: "ListTaskListPartitions"},
+		MatchingGetTaskListsByDomainScope:             {operation: "GetTaskListsByDomain"},
+		MatchingUpdateTaskListPartitionConfigScope:    {operation: "UpdateTaskListPartitionConfig"},
+		MatchingRefreshTaskListPartitionConfigScope:   {operation: "RefreshTaskListPartitionConfig"},
+		MatchingUpdateTaskListVersioningConfigScope:   {operation: "UpdateTaskListVersioningConfig"},
+		MatchingGetTaskListScalingRecommendationScope: {operation: "GetTaskListScalingRecommendation"},
+		MatchingListWorkersScope:                      {operation: "ListWorkers"},
+		MatchingDescribeWorkerScope:                   {operation: "DescribeWorker"},
+		MatchingMigrateTaskListScope:                  {operation: "MigrateTaskList"},
+		MatchingDescribeDomainTaskQuotaScope:          {operation: "DescribeDomainTaskQuota"},
 	},
 	// Worker Scope Names
 	Worker: {
@@ -2089,13 +2358,21 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryScavengerScope:                  {operation: "historyscavenger"},
 		BatcherScope:                           {operation: "batcher"},
 		ParentClosePolicyProcessorScope:        {operation: "ParentClosePolicyProcessor"},
+		CompletionCallbackProcessorScope:       {operation: "CompletionCallbackProcessor"},
 		ESAnalyzerScope:                        {operation: "ESAnalyzer"},
 		AsyncWorkflowConsumerScope:             {operation: "AsyncWorkflowConsumer"},
 		DiagnosticsWorkflowScope:               {operation: "DiagnosticsWorkflow"},
+		VisibilityReconcilerScope:              {operation: "VisibilityReconciler"},
 	},
 	ShardDistributor: {
-		ShardDistributorGetShardOwnerScope: {operation: "GetShardOwner"},
-		ShardDistributorAssignLoopScope:    {operation: "ShardAssignLoop"},
+		ShardDistributorGetShardOwnerScope:        {operation: "GetShardOwner"},
+		ShardDistributorWatchNamespaceStateScope:  {operation: "WatchNamespaceState"},
+		ShardDistributorListNamespacesScope:       {operation: "ListNamespaces"},
+		ShardDistributorDescribeNamespaceScope:    {operation: "DescribeNamespace"},
+		ShardDistributorMoveShardScope:            {operation: "MoveShard"},
+		ShardDistributorSetExecutorDrainedScope:   {operation: "SetExecutorDrained"},
+		ShardDistributorSetRebalancingFrozenScope: {operation: "SetRebalancingFrozen"},
+		ShardDistributorAssignLoopScope:           {operation: "ShardAssignLoop"},
 	},
 }
 
@@ -2315,6 +2592,10 @@ const (
 	ParentClosePolicyProcessorSuccess
 	ParentClosePolicyProcessorFailures
 
+	CompletionCallbackDeliverySuccess
+	CompletionCallbackDeliveryFailures
+	CompletionCallbackDeadLettered
+
 	ValidatedWorkflowCount
 
 	HashringViewIdentifier
@@ -2450,6 +2731,8 @@ const (
 	DecisionTypeScheduleActivityCounter
 	DecisionTypeScheduleActivityDispatchSucceedCounter
 	DecisionTypeScheduleActivityDispatchCounter
+	DecisionTypeScheduleActivityEagerDispatchCounter
+	DecisionTypeScheduleActivityEagerDispatchFallbackCounter
 	DecisionTypeCompleteWorkflowCounter
 	DecisionTypeFailWorkflowCounter
 	DecisionTypeCancelWorkflowCounter
@@ -2462,6 +2745,7 @@ const (
 	DecisionTypeContinueAsNewCounter
 	DecisionTypeSignalExternalWorkflowCounter
 	DecisionTypeUpsertWorkflowSearchAttributesCounter
+	DecisionTypeUpsertWorkflowMemoCounter
 	EmptyCompletionDecisionsCounter
 	MultipleCompletionDecisionsCounter
 	FailedDecisionsCounter
@@ -2491,6 +2775,11 @@ const (
 	ShardItemCreatedCounter
 	ShardItemRemovedCounter
 	ShardItemAcquisitionLatency
+	ShardHandoffCounter
+	ShardHandoffFailedCounter
+	ShardHandoffLatency
+	ShardHandoffWaitLatency
+	ShardHandoffWaitTimeoutCounter
 	ShardInfoReplicationPendingTasksTimer
 	ShardInfoTransferActivePendingTasksTimer
 	ShardInfoTransferStandbyPendingTasksTimer
@@ -2516,6 +2805,7 @@ const (
 	RemoveEngineForShardLatency
 	CompleteDecisionWithStickyEnabledCounter
 	CompleteDecisionWithStickyDisabledCounter
+	CompleteDecisionWithStickyDisabledByPolicyCounter
 	DecisionHeartbeatTimeoutCounter
 	HistoryEventNotificationQueueingLatency
 	HistoryEventNotificationFanoutLatency
@@ -2658,6 +2948,9 @@ const (
 	WorkflowIDCacheRequestsExternalMaxRequestsPerSecondsTimer
 	WorkflowIDCacheRequestsInternalMaxRequestsPerSecondsTimer
 	WorkflowIDCacheRequestsInternalRatelimitedCounter
+	CDCMessagesPublishedCounter
+	CDCMessagesFilteredCounter
+	CDCPublishFailuresCounter
 	NumHistoryMetrics
 )
 
@@ -2702,6 +2995,12 @@ const (
 	PollerPerTaskListCounter
 	PollerInvalidIsolationGroupCounter
 	TaskListPartitionUpdateFailedCounter
+	TaskListVersioningUpdateFailedCounter
+	TaskListMigrationUpdateFailedCounter
+	MigratedTasksPerTaskListCounter
+	MigrateTaskFailedPerTaskListCounter
+	TaskListRecommendedPollerCountGauge
+	TaskListScalingConfidenceGauge
 	TaskListManagersGauge
 	TaskLagPerTaskListGauge
 	TaskBacklogPerTaskListGauge
@@ -2740,6 +3039,9 @@ const (
 	IsolationGroupUpscale
 	IsolationGroupDownscale
 	PartitionDrained
+	ScheduleToStartBacklogLatencyPerTaskList
+	ScheduleToStartRatelimitLatencyPerTaskList
+	ScheduleToStartIsolationLatencyPerTaskList
 	NumMatchingMetrics
 )
 
@@ -2826,9 +3128,16 @@ const (
 	AsyncWorkflowFailureCorruptMsgCount
 	AsyncWorkflowFailureByFrontendCount
 	AsyncWorkflowSuccessCount
+	AsyncWorkflowDLQEnqueueCount
+	AsyncWorkflowDLQEnqueueFailureCount
 	DiagnosticsWorkflowStartedCount
 	DiagnosticsWorkflowSuccess
 	DiagnosticsWorkflowExecutionLatency
+	VisibilityReconcilerCheckedCount
+	VisibilityReconcilerSkippedCount
+	VisibilityReconcilerDivergenceCount
+	VisibilityReconcilerRepairedCount
+	VisibilityReconcilerErrorCount
 	NumWorkerMetrics
 )
 
@@ -2845,6 +3154,8 @@ const (
 	ShardDistributorAssignLoopAttempts
 	ShardDistributorAssignLoopSuccess
 	ShardDistributorAssignLoopFail
+	ShardDistributorAssignLoopLoadImbalance
+	ShardDistributorAssignLoopLoadMovedShards
 
 	NumShardDistributorMetrics
 )
@@ -3084,6 +3395,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DomainCacheUpdateLatency:             {metricName: "domain_cache_update_latency", metricType: Histogram, buckets: DomainCacheUpdateBuckets},
 		ParentClosePolicyProcessorSuccess:    {metricName: "parent_close_policy_processor_requests", metricType: Counter},
 		ParentClosePolicyProcessorFailures:   {metricName: "parent_close_policy_processor_errors", metricType: Counter},
+		CompletionCallbackDeliverySuccess:    {metricName: "completion_callback_delivery_success", metricType: Counter},
+		CompletionCallbackDeliveryFailures:   {metricName: "completion_callback_delivery_failures", metricType: Counter},
+		CompletionCallbackDeadLettered:       {metricName: "completion_callback_dead_lettered", metricType: Counter},
 
 		ValidatedWorkflowCount:      {metricName: "task_validator_count", metricType: Counter},
 		HashringViewIdentifier:      {metricName: "hashring_view_identifier", metricType: Counter},
@@ -3207,6 +3521,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DecisionTypeScheduleActivityCounter:                          {metricName: "schedule_activity_decision", metricType: Counter},
 		DecisionTypeScheduleActivityDispatchSucceedCounter:           {metricName: "schedule_activity_decision_sync_match_succeed", metricType: Counter},
 		DecisionTypeScheduleActivityDispatchCounter:                  {metricName: "schedule_activity_decision_try_sync_match", metricType: Counter},
+		DecisionTypeScheduleActivityEagerDispatchCounter:             {metricName: "schedule_activity_decision_eager_dispatch", metricType: Counter},
+		DecisionTypeScheduleActivityEagerDispatchFallbackCounter:     {metricName: "schedule_activity_decision_eager_dispatch_fallback", metricType: Counter},
 		DecisionTypeCompleteWorkflowCounter:                          {metricName: "complete_workflow_decision", metricType: Counter},
 		DecisionTypeFailWorkflowCounter:                              {metricName: "fail_workflow_decision", metricType: Counter},
 		DecisionTypeCancelWorkflowCounter:                            {metricName: "cancel_workflow_decision", metricType: Counter},
@@ -3218,6 +3534,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DecisionTypeContinueAsNewCounter:                             {metricName: "continue_as_new_decision", metricType: Counter},
 		DecisionTypeSignalExternalWorkflowCounter:                    {metricName: "signal_external_workflow_decision", metricType: Counter},
 		DecisionTypeUpsertWorkflowSearchAttributesCounter:            {metricName: "upsert_workflow_search_attributes_decision", metricType: Counter},
+		DecisionTypeUpsertWorkflowMemoCounter:                        {metricName: "upsert_workflow_memo_decision", metricType: Counter},
 		DecisionTypeChildWorkflowCounter:                             {metricName: "child_workflow_decision", metricType: Counter},
 		EmptyCompletionDecisionsCounter:                              {metricName: "empty_completion_decisions", metricType: Counter},
 		MultipleCompletionDecisionsCounter:                           {metricName: "multiple_completion_decisions", metricType: Counter},
@@ -3248,6 +3565,11 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ShardItemCreatedCounter:                                      {metricName: "sharditem_created_count", metricType: Counter},
 		ShardItemRemovedCounter:                                      {metricName: "sharditem_removed_count", metricType: Counter},
 		ShardItemAcquisitionLatency:                                  {metricName: "sharditem_acquisition_latency", metricType: Timer},
+		ShardHandoffCounter:                                          {metricName: "shard_handoff_count", metricType: Counter},
+		ShardHandoffFailedCounter:                                    {metricName: "shard_handoff_failed_count", metricType: Counter},
+		ShardHandoffLatency:                                          {metricName: "shard_handoff_latency", metricType: Timer},
+		ShardHandoffWaitLatency:                                      {metricName: "shard_handoff_wait_latency", metricType: Timer},
+		ShardHandoffWaitTimeoutCounter:                               {metricName: "shard_handoff_wait_timeout_count", metricType: Counter},
 		ShardInfoReplicationPendingTasksTimer:                        {metricName: "shardinfo_replication_pending_task", metricType: Timer},
 		ShardInfoTransferActivePendingTasksTimer:                     {metricName: "shardinfo_transfer_active_pending_task", metricType: Timer},
 		ShardInfoTransferStandbyPendingTasksTimer:                    {metricName: "shardinfo_transfer_standby_pending_task", metricType: Timer},
@@ -3273,6 +3595,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		RemoveEngineForShardLatency:                                  {metricName: "remove_engine_for_shard_latency", metricType: Timer},
 		CompleteDecisionWithStickyEnabledCounter:                     {metricName: "complete_decision_sticky_enabled_count", metricType: Counter},
 		CompleteDecisionWithStickyDisabledCounter:                    {metricName: "complete_decision_sticky_disabled_count", metricType: Counter},
+		CompleteDecisionWithStickyDisabledByPolicyCounter:            {metricName: "complete_decision_sticky_disabled_by_policy_count", metricType: Counter},
 		DecisionHeartbeatTimeoutCounter:                              {metricName: "decision_heartbeat_timeout_count", metricType: Counter},
 		HistoryEventNotificationQueueingLatency:                      {metricName: "history_event_notification_queueing_latency", metricType: Timer},
 		HistoryEventNotificationFanoutLatency:                        {metricName: "history_event_notification_fanout_latency", metricType: Timer},
@@ -3415,6 +3738,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		WorkflowIDCacheRequestsExternalMaxRequestsPerSecondsTimer:    {metricName: "workflow_id_external_requests_max_requests_per_seconds", metricType: Timer},
 		WorkflowIDCacheRequestsInternalMaxRequestsPerSecondsTimer:    {metricName: "workflow_id_internal_requests_max_requests_per_seconds", metricType: Timer},
 		WorkflowIDCacheRequestsInternalRatelimitedCounter:            {metricName: "workflow_id_internal_requests_ratelimited", metricType: Counter},
+		CDCMessagesPublishedCounter:                                  {metricName: "cdc_messages_published", metricType: Counter},
+		CDCMessagesFilteredCounter:                                   {metricName: "cdc_messages_filtered", metricType: Counter},
+		CDCPublishFailuresCounter:                                    {metricName: "cdc_publish_failures", metricType: Counter},
 	},
 	Matching: {
 		PollSuccessPerTaskListCounter:                           {metricName: "poll_success_per_tl", metricRollupName: "poll_success"},
@@ -3456,6 +3782,12 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		PollerPerTaskListCounter:                                {metricName: "poller_count_per_tl", metricRollupName: "poller_count"},
 		PollerInvalidIsolationGroupCounter:                      {metricName: "poller_invalid_isolation_group_per_tl", metricType: Counter},
 		TaskListPartitionUpdateFailedCounter:                    {metricName: "tasklist_partition_update_failed_per_tl", metricType: Counter},
+		TaskListVersioningUpdateFailedCounter:                   {metricName: "tasklist_versioning_update_failed_per_tl", metricType: Counter},
+		TaskListMigrationUpdateFailedCounter:                    {metricName: "tasklist_migration_update_failed_per_tl", metricType: Counter},
+		MigratedTasksPerTaskListCounter:                         {metricName: "migrated_tasks_per_tl", metricType: Counter},
+		MigrateTaskFailedPerTaskListCounter:                     {metricName: "migrate_task_failed_per_tl", metricType: Counter},
+		TaskListRecommendedPollerCountGauge:                     {metricName: "recommended_poller_count_per_tl", metricType: Gauge},
+		TaskListScalingConfidenceGauge:                          {metricName: "scaling_confidence_per_tl", metricType: Gauge},
 		TaskListManagersGauge:                                   {metricName: "tasklist_managers", metricType: Gauge},
 		TaskLagPerTaskListGauge:                                 {metricName: "task_lag_per_tl", metricType: Gauge},
 		TaskBacklogPerTaskListGauge:                             {metricName: "task_backlog_per_tl", metricType: Gauge},
@@ -3494,6 +3826,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		IsolationGroupUpscale:                                   {metricName: "ig_upscale_per_tl", metricRollupName: "ig_upscale"},
 		IsolationGroupDownscale:                                 {metricName: "ig_downscale_per_tl", metricRollupName: "ig_downscale"},
 		IsolationGroupPartitionsGauge:                           {metricName: "ig_partitions_per_tl", metricType: Gauge},
+		ScheduleToStartBacklogLatencyPerTaskList:                {metricName: "schedule_to_start_backlog_latency_per_tl", metricRollupName: "schedule_to_start_backlog_latency", metricType: Histogram, buckets: HistoryTaskLatencyBuckets},
+		ScheduleToStartRatelimitLatencyPerTaskList:              {metricName: "schedule_to_start_ratelimit_latency_per_tl", metricRollupName: "schedule_to_start_ratelimit_latency", metricType: Histogram, buckets: HistoryTaskLatencyBuckets},
+		ScheduleToStartIsolationLatencyPerTaskList:              {metricName: "schedule_to_start_isolation_latency_per_tl", metricRollupName: "schedule_to_start_isolation_latency", metricType: Histogram, buckets: HistoryTaskLatencyBuckets},
 	},
 	Worker: {
 		ReplicatorMessages:                            {metricName: "replicator_messages"},
@@ -3577,9 +3912,16 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		AsyncWorkflowFailureCorruptMsgCount:           {metricName: "async_workflow_failure_corrupt_msg", metricType: Counter},
 		AsyncWorkflowFailureByFrontendCount:           {metricName: "async_workflow_failure_by_frontend", metricType: Counter},
 		AsyncWorkflowSuccessCount:                     {metricName: "async_workflow_success", metricType: Counter},
+		AsyncWorkflowDLQEnqueueCount:                  {metricName: "async_workflow_dlq_enqueue", metricType: Counter},
+		AsyncWorkflowDLQEnqueueFailureCount:           {metricName: "async_workflow_dlq_enqueue_failure", metricType: Counter},
 		DiagnosticsWorkflowStartedCount:               {metricName: "diagnostics_workflow_count", metricType: Counter},
 		DiagnosticsWorkflowSuccess:                    {metricName: "diagnostics_workflow_success", metricType: Counter},
 		DiagnosticsWorkflowExecutionLatency:           {metricName: "diagnostics_workflow_execution_latency", metricType: Timer},
+		VisibilityReconcilerCheckedCount:              {metricName: "visibility_reconciler_checked", metricType: Counter},
+		VisibilityReconcilerSkippedCount:              {metricName: "visibility_reconciler_skipped", metricType: Counter},
+		VisibilityReconcilerDivergenceCount:           {metricName: "visibility_reconciler_divergence", metricType: Counter},
+		VisibilityReconcilerRepairedCount:             {metricName: "visibility_reconciler_repaired", metricType: Counter},
+		VisibilityReconcilerErrorCount:                {metricName: "visibility_reconciler_errors", metricType: Counter},
 	},
 	ShardDistributor: {
 		ShardDistributorRequests:                        {metricName: "shard_distributor_requests", metricType: Counter},
@@ -3592,6 +3934,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ShardDistributorAssignLoopAttempts:              {metricName: "shard_distrubutor_shard_assign_attempt", metricType: Counter},
 		ShardDistributorAssignLoopSuccess:               {metricName: "shard_distrubutor_shard_assign_success", metricType: Counter},
 		ShardDistributorAssignLoopFail:                  {metricName: "shard_distrubutor_shard_assign_fail", metricType: Counter},
+		ShardDistributorAssignLoopLoadImbalance:         {metricName: "shard_distributor_shard_assign_load_imbalance", metricType: Gauge},
+		ShardDistributorAssignLoopLoadMovedShards:       {metricName: "shard_distributor_shard_assign_load_moved_shards", metricType: Counter},
 	},
 }
 
@@ -0,0 +1,95 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package dnsprovider
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/uber/cadence/common/membership"
+)
+
+const (
+	// ConfigKey is the key of the DNS peer provider in the membership provider config
+	ConfigKey = "dns"
+
+	defaultRefreshInterval = 10 * time.Second
+)
+
+type (
+	// Config contains the DNS peer provider config items
+	Config struct {
+		// BroadcastAddress is the IP this host is resolved to by the DNS records of its service.
+		BroadcastAddress string `yaml:"broadcastAddress"`
+		// RefreshInterval is how often the DNS records are resolved, defaults to 10s
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
+		// Services maps service names to the DNS records resolving their hosts
+		Services map[string]Record `yaml:"services"`
+	}
+
+	// Record describes how to resolve the hosts of a service, either SRV or Host and Port must be set
+	Record struct {
+		// SRV is the name of an SRV record, the record ports are used as tchannel ports
+		SRV string `yaml:"srv"`
+		// Host is the name of an A or AAAA record
+		Host string `yaml:"host"`
+		// Port is the tchannel port of the hosts resolved from Host
+		Port uint16 `yaml:"port"`
+		// Ports are the additional named ports every host of the service is listening on
+		Ports membership.PortMap `yaml:"ports"`
+	}
+)
+
+func (c *Config) validate() error {
+	if net.ParseIP(c.BroadcastAddress) == nil {
+		return fmt.Errorf("dns peer provider config has invalid `broadcastAddress` %q", c.BroadcastAddress)
+	}
+	if len(c.Services) == 0 {
+		return errors.New("dns peer provider config missing `services` param")
+	}
+	for service, record := range c.Services {
+		if err := record.validate(); err != nil {
+			return fmt.Errorf("dns peer provider config for service %q: %w", service, err)
+		}
+	}
+	if c.RefreshInterval == 0 {
+		c.RefreshInterval = defaultRefreshInterval
+	}
+	return nil
+}
+
+func (r Record) validate() error {
+	switch {
+	case r.SRV != "" && r.Host != "":
+		return errors.New("only one of `srv` and `host` can be set")
+	case r.SRV != "":
+		return nil
+	case r.Host == "":
+		return errors.New("one of `srv` and `host` must be set")
+	case r.Port == 0:
+		return errors.New("`port` must be set together with `host`")
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package dnsprovider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/peerprovider"
+	"github.com/uber/cadence/common/peerprovider/pollingprovider"
+)
+
+type (
+	// Resolver is the subset of net.Resolver used to resolve service hosts
+	Resolver interface {
+		LookupHost(ctx context.Context, host string) (addrs []string, err error)
+		LookupSRV(ctx context.Context, service, proto, name string) (cname string, addrs []*net.SRV, err error)
+	}
+
+	dnsSource struct {
+		services map[string]Record
+		resolver Resolver
+	}
+)
+
+// Register registers the DNS peer provider as the peer provider plugin
+func Register() error {
+	return peerprovider.Register(ConfigKey, func(cfg *config.YamlNode, container peerprovider.Container) (membership.PeerProvider, error) {
+		var providerConfig Config
+		if err := cfg.Decode(&providerConfig); err != nil {
+			return nil, fmt.Errorf("decoding dns peer provider config: %w", err)
+		}
+		return New(container.Service, &providerConfig, container.Portmap, net.DefaultResolver, clock.NewRealTimeSource(), container.Logger)
+	})
+}
+
+// New creates a peer provider that periodically resolves members of every service from DNS
+func New(
+	service string,
+	config *Config,
+	portMap membership.PortMap,
+	resolver Resolver,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) (*pollingprovider.Provider, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	address := net.JoinHostPort(config.BroadcastAddress, strconv.Itoa(int(portMap[membership.PortTchannel])))
+	self := membership.NewDetailedHostInfo(address, address, portMap)
+
+	return pollingprovider.New(
+		ConfigKey,
+		service,
+		self,
+		&dnsSource{services: config.Services, resolver: resolver},
+		config.RefreshInterval,
+		timeSource,
+		logger,
+	), nil
+}
+
+// Members resolves all services, failing as a whole so a partial DNS outage doesn't remove hosts
+func (s *dnsSource) Members(ctx context.Context) (map[string][]membership.HostInfo, error) {
+	result := make(map[string][]membership.HostInfo, len(s.services))
+	for service, record := range s.services {
+		addresses, err := s.resolve(ctx, record)
+		if err != nil {
+			return nil, fmt.Errorf("resolving hosts of service %q: %w", service, err)
+		}
+
+		slices.Sort(addresses)
+		addresses = slices.Compact(addresses)
+
+		hosts := make([]membership.HostInfo, 0, len(addresses))
+		for _, addr := range addresses {
+			hosts = append(hosts, toHostInfo(addr, record.Ports))
+		}
+		result[service] = hosts
+	}
+	return result, nil
+}
+
+func (s *dnsSource) resolve(ctx context.Context, record Record) ([]string, error) {
+	if record.SRV == "" {
+		return s.lookupHost(ctx, record.Host, record.Port)
+	}
+
+	// empty service and proto make the resolver look up the record name as is
+	_, srvs, err := s.resolver.LookupSRV(ctx, "", "", record.SRV)
+	if err != nil {
+		return nil, fmt.Errorf("looking up srv record %q: %w", record.SRV, err)
+	}
+
+	var addresses []string
+	for _, srv := range srvs {
+		resolved, err := s.lookupHost(ctx, srv.Target, srv.Port)
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, resolved...)
+	}
+	return addresses, nil
+}
+
+func (s *dnsSource) lookupHost(ctx context.Context, host string, port uint16) ([]string, error) {
+	ips, err := s.resolver.LookupHost(ctx, host)
+	if err != nil {
+		return nil, fmt.Errorf("looking up host %q: %w", host, err)
+	}
+
+	addresses := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		addresses = append(addresses, net.JoinHostPort(ip, strconv.Itoa(int(port))))
+	}
+	return addresses, nil
+}
+
+func toHostInfo(address string, ports membership.PortMap) membership.HostInfo {
+	_, port, _ := net.SplitHostPort(address)
+	tchannelPort, _ := strconv.ParseUint(port, 10, 16)
+
+	portMap := membership.PortMap{membership.PortTchannel: uint16(tchannelPort)}
+	for name, p := range ports {
+		portMap[name] = p
+	}
+	return membership.NewDetailedHostInfo(address, address, portMap)
+}
@@ -0,0 +1,235 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package dnsprovider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/peerprovider"
+)
+
+const testService = "cadence-history"
+
+var testPortMap = membership.PortMap{
+	membership.PortTchannel: 7934,
+	membership.PortGRPC:     7834,
+}
+
+type fakeResolver struct {
+	mu    sync.Mutex
+	hosts map[string][]string
+	srvs  map[string][]*net.SRV
+}
+
+func (r *fakeResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	addrs, ok := r.hosts[host]
+	if !ok {
+		return nil, fmt.Errorf("no such host %q", host)
+	}
+	return addrs, nil
+}
+
+func (r *fakeResolver) LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	srvs, ok := r.srvs[name]
+	if !ok {
+		return "", nil, fmt.Errorf("no such srv record %q", name)
+	}
+	return name, srvs, nil
+}
+
+func (r *fakeResolver) setHost(host string, addrs ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.hosts[host] = addrs
+}
+
+func newFakeResolver() *fakeResolver {
+	return &fakeResolver{
+		hosts: map[string][]string{
+			"history-0.cadence.svc": {"10.0.0.1"},
+			"history-1.cadence.svc": {"10.0.0.2"},
+			"frontend.cadence.svc":  {"10.0.1.2", "10.0.1.1", "10.0.1.1"},
+		},
+		srvs: map[string][]*net.SRV{
+			"_tchannel._tcp.history.cadence.svc": {
+				{Target: "history-0.cadence.svc", Port: 7934},
+				{Target: "history-1.cadence.svc", Port: 7934},
+			},
+		},
+	}
+}
+
+func testConfig() *Config {
+	return &Config{
+		BroadcastAddress: "10.0.0.1",
+		RefreshInterval:  time.Second,
+		Services: map[string]Record{
+			"cadence-history": {
+				SRV:   "_tchannel._tcp.history.cadence.svc",
+				Ports: membership.PortMap{membership.PortGRPC: 7834},
+			},
+			"cadence-frontend": {
+				Host: "frontend.cadence.svc",
+				Port: 7933,
+			},
+		},
+	}
+}
+
+func TestProvider(t *testing.T) {
+	t.Cleanup(func() { goleak.VerifyNone(t) })
+
+	resolver := newFakeResolver()
+	timeSource := clock.NewMockedTimeSource()
+	p, err := New(testService, testConfig(), testPortMap, resolver, timeSource, testlogger.New(t))
+	require.NoError(t, err)
+
+	events := make(chan membership.ChangedEvent, 10)
+	require.NoError(t, p.Subscribe("test", func(event membership.ChangedEvent) {
+		events <- event
+	}))
+
+	p.Start()
+	defer p.Stop()
+
+	self, err := p.WhoAmI()
+	require.NoError(t, err)
+	assert.Equal(t, membership.NewDetailedHostInfo("10.0.0.1:7934", "10.0.0.1:7934", testPortMap), self)
+
+	members, err := p.GetMembers(testService)
+	require.NoError(t, err)
+	assert.Equal(t, []membership.HostInfo{
+		membership.NewDetailedHostInfo("10.0.0.1:7934", "10.0.0.1:7934", testPortMap),
+		membership.NewDetailedHostInfo("10.0.0.2:7934", "10.0.0.2:7934", testPortMap),
+	}, members)
+
+	members, err = p.GetMembers("cadence-frontend")
+	require.NoError(t, err)
+	assert.Equal(t, []membership.HostInfo{
+		membership.NewDetailedHostInfo("10.0.1.1:7933", "10.0.1.1:7933", membership.PortMap{membership.PortTchannel: 7933}),
+		membership.NewDetailedHostInfo("10.0.1.2:7933", "10.0.1.2:7933", membership.PortMap{membership.PortTchannel: 7933}),
+	}, members)
+	assert.Equal(t, membership.ChangedEvent{
+		HostsAdded: []string{"10.0.0.1:7934", "10.0.0.2:7934", "10.0.1.1:7933", "10.0.1.2:7933"},
+	}, <-events)
+
+	// the records are resolved again on the next refresh
+	resolver.setHost("history-1.cadence.svc", "10.0.0.3")
+	timeSource.BlockUntil(1)
+	timeSource.Advance(time.Second)
+
+	select {
+	case event := <-events:
+		assert.Equal(t, membership.ChangedEvent{
+			HostsAdded:   []string{"10.0.0.3:7934"},
+			HostsRemoved: []string{"10.0.0.2:7934"},
+		}, event)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for dns refresh")
+	}
+}
+
+func TestSourceFailsOnLookupFailure(t *testing.T) {
+	resolver := newFakeResolver()
+	source := &dnsSource{services: testConfig().Services, resolver: resolver}
+
+	delete(resolver.hosts, "history-1.cadence.svc")
+
+	_, err := source.Members(context.Background())
+	assert.EqualError(t, err, `resolving hosts of service "cadence-history": looking up host "history-1.cadence.svc": no such host "history-1.cadence.svc"`)
+}
+
+func TestProviderInvalidConfig(t *testing.T) {
+	tests := map[string]struct {
+		config Config
+		err    string
+	}{
+		"invalid broadcast address": {
+			config: Config{BroadcastAddress: "", Services: testConfig().Services},
+			err:    "dns peer provider config has invalid `broadcastAddress` \"\"",
+		},
+		"missing services": {
+			config: Config{BroadcastAddress: "10.0.0.1"},
+			err:    "dns peer provider config missing `services` param",
+		},
+		"srv and host": {
+			config: Config{BroadcastAddress: "10.0.0.1", Services: map[string]Record{testService: {SRV: "srv", Host: "host", Port: 7934}}},
+			err:    "dns peer provider config for service \"cadence-history\": only one of `srv` and `host` can be set",
+		},
+		"no record": {
+			config: Config{BroadcastAddress: "10.0.0.1", Services: map[string]Record{testService: {}}},
+			err:    "dns peer provider config for service \"cadence-history\": one of `srv` and `host` must be set",
+		},
+		"host without port": {
+			config: Config{BroadcastAddress: "10.0.0.1", Services: map[string]Record{testService: {Host: "host"}}},
+			err:    "dns peer provider config for service \"cadence-history\": `port` must be set together with `host`",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(testService, &tc.config, testPortMap, newFakeResolver(), clock.NewMockedTimeSource(), testlogger.New(t))
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	require.NoError(t, Register())
+
+	cfg, err := config.ToYamlNode(map[string]any{
+		"broadcastAddress": "10.0.0.1",
+		"refreshInterval":  "5s",
+		"services": map[string]any{
+			testService: map[string]any{"srv": "_tchannel._tcp.history.cadence.svc"},
+		},
+	})
+	require.NoError(t, err)
+
+	p, err := peerprovider.New(config.PeerProvider{ConfigKey: cfg}, peerprovider.Container{
+		Service: testService,
+		Logger:  testlogger.New(t),
+		Portmap: testPortMap,
+	}).Provider()
+	require.NoError(t, err)
+	assert.NotNil(t, p)
+}
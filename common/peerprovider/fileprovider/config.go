@@ -0,0 +1,125 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package fileprovider
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/uber/cadence/common/membership"
+)
+
+const (
+	// ConfigKey is the key of the file peer provider in the membership provider config
+	ConfigKey = "file"
+
+	defaultRefreshInterval = 10 * time.Second
+)
+
+type (
+	// Config contains the file peer provider config items
+	Config struct {
+		// Path is the YAML or JSON file listing the hosts of every service, see Member.
+		// The file is re-read every RefreshInterval, so it can be updated in place.
+		Path string `yaml:"path"`
+		// BroadcastAddress is the IP this host is listed with in the file.
+		BroadcastAddress string `yaml:"broadcastAddress"`
+		// RefreshInterval is how often the file is re-read, defaults to 10s
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
+	}
+
+	// Member is a single host in the hosts file, which maps service names to their members:
+	//
+	//	cadence-frontend:
+	//	  - address: 10.0.0.1:7933
+	//	    ports:
+	//	      grpc: 7833
+	Member struct {
+		// Address is the ip:port of the host, the port is its tchannel port
+		Address string `yaml:"address"`
+		// Ports are the additional named ports the host is listening on
+		Ports membership.PortMap `yaml:"ports"`
+	}
+)
+
+func (c *Config) validate() error {
+	if c.Path == "" {
+		return errors.New("file peer provider config missing `path` param")
+	}
+	if net.ParseIP(c.BroadcastAddress) == nil {
+		return fmt.Errorf("file peer provider config has invalid `broadcastAddress` %q", c.BroadcastAddress)
+	}
+	if c.RefreshInterval == 0 {
+		c.RefreshInterval = defaultRefreshInterval
+	}
+	return nil
+}
+
+func readHostsFile(path string) (map[string][]membership.HostInfo, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading hosts file: %w", err)
+	}
+
+	var services map[string][]Member
+	if err := yaml.Unmarshal(content, &services); err != nil {
+		return nil, fmt.Errorf("parsing hosts file %q: %w", path, err)
+	}
+
+	result := make(map[string][]membership.HostInfo, len(services))
+	for service, members := range services {
+		hosts := make([]membership.HostInfo, 0, len(members))
+		for _, m := range members {
+			host, err := m.toHostInfo()
+			if err != nil {
+				return nil, fmt.Errorf("service %q in hosts file %q: %w", service, path, err)
+			}
+			hosts = append(hosts, host)
+		}
+		result[service] = hosts
+	}
+	return result, nil
+}
+
+func (m Member) toHostInfo() (membership.HostInfo, error) {
+	_, port, err := net.SplitHostPort(m.Address)
+	if err != nil {
+		return membership.HostInfo{}, fmt.Errorf("invalid member address %q: %w", m.Address, err)
+	}
+	tchannelPort, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return membership.HostInfo{}, fmt.Errorf("invalid member port %q: %w", m.Address, err)
+	}
+
+	portMap := membership.PortMap{membership.PortTchannel: uint16(tchannelPort)}
+	for name, p := range m.Ports {
+		portMap[name] = p
+	}
+	return membership.NewDetailedHostInfo(m.Address, m.Address, portMap), nil
+}
@@ -0,0 +1,81 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package fileprovider
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/membership"
+)
+
+func TestReadHostsFile(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("yaml", func(t *testing.T) {
+		path := filepath.Join(dir, "hosts.yaml")
+		writeHostsFile(t, path, testHosts)
+
+		members, err := readHostsFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]membership.HostInfo{
+			"cadence-matching": {
+				membership.NewDetailedHostInfo("10.0.0.1:7935", "10.0.0.1:7935", membership.PortMap{membership.PortTchannel: 7935, membership.PortGRPC: 7835}),
+				membership.NewDetailedHostInfo("10.0.0.2:7935", "10.0.0.2:7935", membership.PortMap{membership.PortTchannel: 7935}),
+			},
+			"cadence-history": {
+				membership.NewDetailedHostInfo("10.0.0.3:7934", "10.0.0.3:7934", membership.PortMap{membership.PortTchannel: 7934}),
+			},
+		}, members)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		path := filepath.Join(dir, "hosts.json")
+		writeHostsFile(t, path, `{"cadence-frontend": [{"address": "10.0.0.5:7933", "ports": {"grpc": 7833}}]}`)
+
+		members, err := readHostsFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]membership.HostInfo{
+			"cadence-frontend": {
+				membership.NewDetailedHostInfo("10.0.0.5:7933", "10.0.0.5:7933", membership.PortMap{membership.PortTchannel: 7933, membership.PortGRPC: 7833}),
+			},
+		}, members)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := readHostsFile(filepath.Join(dir, "missing.yaml"))
+		assert.ErrorContains(t, err, "reading hosts file")
+	})
+
+	t.Run("address without port", func(t *testing.T) {
+		path := filepath.Join(dir, "no-port.yaml")
+		writeHostsFile(t, path, "cadence-history:\n  - address: 10.0.0.3\n")
+
+		_, err := readHostsFile(path)
+		assert.ErrorContains(t, err, `service "cadence-history"`)
+		assert.ErrorContains(t, err, `invalid member address "10.0.0.3"`)
+	})
+}
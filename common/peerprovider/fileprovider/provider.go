@@ -0,0 +1,82 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package fileprovider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/peerprovider"
+	"github.com/uber/cadence/common/peerprovider/pollingprovider"
+)
+
+type fileSource struct {
+	path string
+}
+
+// Register registers the file peer provider as the peer provider plugin
+func Register() error {
+	return peerprovider.Register(ConfigKey, func(cfg *config.YamlNode, container peerprovider.Container) (membership.PeerProvider, error) {
+		var providerConfig Config
+		if err := cfg.Decode(&providerConfig); err != nil {
+			return nil, fmt.Errorf("decoding file peer provider config: %w", err)
+		}
+		return New(container.Service, &providerConfig, container.Portmap, clock.NewRealTimeSource(), container.Logger)
+	})
+}
+
+// New creates a peer provider that reads members of every service from a hosts file
+func New(
+	service string,
+	config *Config,
+	portMap membership.PortMap,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) (*pollingprovider.Provider, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	address := net.JoinHostPort(config.BroadcastAddress, strconv.Itoa(int(portMap[membership.PortTchannel])))
+	self := membership.NewDetailedHostInfo(address, address, portMap)
+
+	return pollingprovider.New(
+		ConfigKey,
+		service,
+		self,
+		&fileSource{path: config.Path},
+		config.RefreshInterval,
+		timeSource,
+		logger,
+	), nil
+}
+
+func (s *fileSource) Members(ctx context.Context) (map[string][]membership.HostInfo, error) {
+	return readHostsFile(s.path)
+}
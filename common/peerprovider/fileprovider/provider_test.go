@@ -0,0 +1,164 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package fileprovider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/peerprovider"
+)
+
+const (
+	testService = "cadence-matching"
+
+	testHosts = `
+cadence-matching:
+  - address: 10.0.0.1:7935
+    ports:
+      grpc: 7835
+  - address: 10.0.0.2:7935
+cadence-history:
+  - address: 10.0.0.3:7934
+`
+)
+
+var testPortMap = membership.PortMap{
+	membership.PortTchannel: 7935,
+	membership.PortGRPC:     7835,
+}
+
+func writeHostsFile(t *testing.T, path string, content string) {
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
+
+func TestProvider(t *testing.T) {
+	t.Cleanup(func() { goleak.VerifyNone(t) })
+
+	path := filepath.Join(t.TempDir(), "hosts.yaml")
+	writeHostsFile(t, path, testHosts)
+
+	timeSource := clock.NewMockedTimeSource()
+	p, err := New(testService, &Config{
+		Path:             path,
+		BroadcastAddress: "10.0.0.1",
+		RefreshInterval:  time.Second,
+	}, testPortMap, timeSource, testlogger.New(t))
+	require.NoError(t, err)
+
+	events := make(chan membership.ChangedEvent, 10)
+	require.NoError(t, p.Subscribe("test", func(event membership.ChangedEvent) {
+		events <- event
+	}))
+
+	p.Start()
+	defer p.Stop()
+
+	self, err := p.WhoAmI()
+	require.NoError(t, err)
+	assert.Equal(t, membership.NewDetailedHostInfo("10.0.0.1:7935", "10.0.0.1:7935", testPortMap), self)
+
+	members, err := p.GetMembers(testService)
+	require.NoError(t, err)
+	assert.Equal(t, []membership.HostInfo{
+		membership.NewDetailedHostInfo("10.0.0.1:7935", "10.0.0.1:7935", testPortMap),
+		membership.NewDetailedHostInfo("10.0.0.2:7935", "10.0.0.2:7935", membership.PortMap{membership.PortTchannel: 7935}),
+	}, members)
+	assert.Equal(t, membership.ChangedEvent{
+		HostsAdded: []string{"10.0.0.1:7935", "10.0.0.2:7935", "10.0.0.3:7934"},
+	}, <-events)
+
+	// the hosts file is reloaded on the next refresh
+	writeHostsFile(t, path, `
+cadence-matching:
+  - address: 10.0.0.1:7935
+    ports:
+      grpc: 7835
+  - address: 10.0.0.4:7935
+`)
+	timeSource.BlockUntil(1)
+	timeSource.Advance(time.Second)
+
+	select {
+	case event := <-events:
+		assert.Equal(t, membership.ChangedEvent{
+			HostsAdded:   []string{"10.0.0.4:7935"},
+			HostsRemoved: []string{"10.0.0.2:7935", "10.0.0.3:7934"},
+		}, event)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for hosts file reload")
+	}
+}
+
+func TestProviderInvalidConfig(t *testing.T) {
+	tests := map[string]struct {
+		config Config
+		err    string
+	}{
+		"missing path": {
+			config: Config{BroadcastAddress: "10.0.0.1"},
+			err:    "file peer provider config missing `path` param",
+		},
+		"invalid broadcast address": {
+			config: Config{Path: "hosts.yaml", BroadcastAddress: "not-an-ip"},
+			err:    "file peer provider config has invalid `broadcastAddress` \"not-an-ip\"",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(testService, &tc.config, testPortMap, clock.NewMockedTimeSource(), testlogger.New(t))
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hosts.yaml")
+	writeHostsFile(t, path, testHosts)
+
+	require.NoError(t, Register())
+
+	cfg, err := config.ToYamlNode(map[string]any{
+		"path":             path,
+		"broadcastAddress": "10.0.0.1",
+	})
+	require.NoError(t, err)
+
+	p, err := peerprovider.New(config.PeerProvider{ConfigKey: cfg}, peerprovider.Container{
+		Service: testService,
+		Logger:  testlogger.New(t),
+		Portmap: testPortMap,
+	}).Provider()
+	require.NoError(t, err)
+	assert.NotNil(t, p)
+}
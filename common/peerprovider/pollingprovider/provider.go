@@ -0,0 +1,287 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package pollingprovider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/membership"
+)
+
+type (
+	// Source returns the current members of every service, keyed by service name.
+	// Member addresses are expected to be unique across services.
+	Source interface {
+		Members(ctx context.Context) (map[string][]membership.HostInfo, error)
+	}
+
+	// Provider is a peer provider that periodically polls a Source and
+	// announces membership changes the same way ringpop does.
+	// It does not gossip: SelfEvict only removes this host from the local view,
+	// other hosts stop seeing it once the Source no longer returns it.
+	Provider struct {
+		status          int32
+		name            string
+		service         string
+		self            membership.HostInfo
+		source          Source
+		refreshInterval time.Duration
+		timeSource      clock.TimeSource
+		logger          log.Logger
+
+		mu          sync.RWMutex
+		members     map[string][]membership.HostInfo
+		evicted     bool
+		subscribers map[string]func(membership.ChangedEvent)
+
+		ctx        context.Context
+		cancel     context.CancelFunc
+		shutdownWG sync.WaitGroup
+	}
+)
+
+var _ membership.PeerProvider = (*Provider)(nil)
+
+// New creates a polling peer provider. Name is used to identify the provider in logs and errors.
+func New(
+	name string,
+	service string,
+	self membership.HostInfo,
+	source Source,
+	refreshInterval time.Duration,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) *Provider {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Provider{
+		status:          common.DaemonStatusInitialized,
+		name:            name,
+		service:         service,
+		self:            self,
+		source:          source,
+		refreshInterval: refreshInterval,
+		timeSource:      timeSource,
+		logger:          logger.WithTags(tag.Name(name)),
+		members:         map[string][]membership.HostInfo{},
+		subscribers:     map[string]func(membership.ChangedEvent){},
+		ctx:             ctx,
+		cancel:          cancel,
+	}
+}
+
+// Start loads the initial membership and starts polling for changes
+func (p *Provider) Start() {
+	if !atomic.CompareAndSwapInt32(
+		&p.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return
+	}
+
+	// the ticker is created before the initial load, so the next refresh is due one interval after Start
+	ticker := p.timeSource.NewTicker(p.refreshInterval)
+
+	// a failed initial load is not fatal: rings stay empty until the source recovers
+	p.refresh()
+
+	p.shutdownWG.Add(1)
+	go p.refreshLoop(ticker)
+}
+
+// Stop stops polling for changes
+func (p *Provider) Stop() {
+	if !atomic.CompareAndSwapInt32(
+		&p.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+
+	p.cancel()
+	p.shutdownWG.Wait()
+}
+
+// GetMembers returns all hosts of a specified service
+func (p *Provider) GetMembers(service string) ([]membership.HostInfo, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return slices.Clone(p.members[service]), nil
+}
+
+// WhoAmI returns address of this instance
+func (p *Provider) WhoAmI() (membership.HostInfo, error) {
+	return p.self, nil
+}
+
+// SelfEvict removes this instance from the local view of the membership
+func (p *Provider) SelfEvict() error {
+	p.mu.Lock()
+	if p.evicted {
+		p.mu.Unlock()
+		return nil
+	}
+	p.evicted = true
+	members := p.members
+	p.mu.Unlock()
+
+	p.update(members)
+	return nil
+}
+
+// Subscribe allows to be subscribed for ring changes
+func (p *Provider) Subscribe(name string, handler func(membership.ChangedEvent)) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, ok := p.subscribers[name]
+	if ok {
+		return fmt.Errorf("%q already subscribed to %s provider", name, p.name)
+	}
+
+	p.subscribers[name] = handler
+	return nil
+}
+
+func (p *Provider) refreshLoop(ticker clock.Ticker) {
+	defer p.shutdownWG.Done()
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-ticker.Chan():
+			p.refresh()
+		}
+	}
+}
+
+func (p *Provider) refresh() {
+	ctx, cancel := p.timeSource.ContextWithTimeout(p.ctx, p.refreshInterval)
+	defer cancel()
+
+	members, err := p.source.Members(ctx)
+	if err != nil {
+		// keep the last known membership, a transient source failure should not empty the rings
+		p.logger.Warn("failed to refresh peer provider members", tag.Error(err))
+		return
+	}
+
+	p.update(members)
+}
+
+func (p *Provider) update(members map[string][]membership.HostInfo) {
+	p.mu.Lock()
+	if p.evicted {
+		members = withoutHost(members, p.service, p.self.GetAddress())
+	}
+	change := diffMembers(p.members, members)
+	p.members = members
+	p.mu.Unlock()
+
+	if change.Empty() {
+		return
+	}
+
+	p.logger.Info("Received a peer provider membership changed event", tag.MembershipChangeEvent(change))
+	p.notifySubscribers(change)
+}
+
+func (p *Provider) notifySubscribers(event membership.ChangedEvent) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	for _, handler := range p.subscribers {
+		handler(event)
+	}
+}
+
+func withoutHost(members map[string][]membership.HostInfo, service string, address string) map[string][]membership.HostInfo {
+	result := make(map[string][]membership.HostInfo, len(members))
+	for s, hosts := range members {
+		if s != service {
+			result[s] = hosts
+			continue
+		}
+		result[s] = slices.DeleteFunc(slices.Clone(hosts), func(h membership.HostInfo) bool {
+			return h.GetAddress() == address
+		})
+	}
+	return result
+}
+
+type serviceHost struct {
+	service string
+	host    membership.HostInfo
+}
+
+func indexByAddress(members map[string][]membership.HostInfo) map[string]serviceHost {
+	result := make(map[string]serviceHost)
+	for service, hosts := range members {
+		for _, h := range hosts {
+			result[h.GetAddress()] = serviceHost{service: service, host: h}
+		}
+	}
+	return result
+}
+
+// diffMembers reports hosts by address, the same way ringpop reports ring changes
+func diffMembers(previous, current map[string][]membership.HostInfo) membership.ChangedEvent {
+	before := indexByAddress(previous)
+	after := indexByAddress(current)
+
+	var change membership.ChangedEvent
+	for addr, host := range after {
+		old, found := before[addr]
+		switch {
+		case !found:
+			change.HostsAdded = append(change.HostsAdded, addr)
+		case !reflect.DeepEqual(old, host):
+			change.HostsUpdated = append(change.HostsUpdated, addr)
+		}
+	}
+	for addr := range before {
+		if _, found := after[addr]; !found {
+			change.HostsRemoved = append(change.HostsRemoved, addr)
+		}
+	}
+
+	// order since it will most probably used in logs
+	slices.Sort(change.HostsAdded)
+	slices.Sort(change.HostsUpdated)
+	slices.Sort(change.HostsRemoved)
+	return change
+}
@@ -0,0 +1,215 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package pollingprovider
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/membership"
+)
+
+const (
+	testService         = "cadence-matching"
+	testRefreshInterval = 10 * time.Second
+)
+
+type fakeSource struct {
+	mu      sync.Mutex
+	members map[string][]membership.HostInfo
+	err     error
+	calls   chan struct{}
+}
+
+func newFakeSource(members map[string][]membership.HostInfo) *fakeSource {
+	return &fakeSource{
+		members: members,
+		calls:   make(chan struct{}, 10),
+	}
+}
+
+func (s *fakeSource) Members(ctx context.Context) (map[string][]membership.HostInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	defer func() { s.calls <- struct{}{} }()
+
+	return s.members, s.err
+}
+
+func (s *fakeSource) set(members map[string][]membership.HostInfo, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.members = members
+	s.err = err
+}
+
+func host(addr string) membership.HostInfo {
+	return membership.NewDetailedHostInfo(addr, addr, membership.PortMap{membership.PortTchannel: 7935})
+}
+
+func setupProvider(t *testing.T, source *fakeSource) (*Provider, clock.MockedTimeSource, chan membership.ChangedEvent) {
+	t.Cleanup(func() { goleak.VerifyNone(t) })
+
+	timeSource := clock.NewMockedTimeSource()
+	p := New("test", testService, host("10.0.0.1:7935"), source, testRefreshInterval, timeSource, testlogger.New(t))
+
+	events := make(chan membership.ChangedEvent, 10)
+	require.NoError(t, p.Subscribe("test-subscriber", func(event membership.ChangedEvent) {
+		events <- event
+	}))
+
+	p.Start()
+	t.Cleanup(p.Stop)
+	<-source.calls
+
+	return p, timeSource, events
+}
+
+func awaitEvent(t *testing.T, events chan membership.ChangedEvent) membership.ChangedEvent {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for membership changed event")
+	}
+	return membership.ChangedEvent{}
+}
+
+func TestProviderLoadsMembersOnStart(t *testing.T) {
+	source := newFakeSource(map[string][]membership.HostInfo{
+		testService:        {host("10.0.0.1:7935"), host("10.0.0.2:7935")},
+		"cadence-frontend": {host("10.0.0.3:7933")},
+	})
+	p, _, events := setupProvider(t, source)
+
+	assert.Equal(t, membership.ChangedEvent{
+		HostsAdded: []string{"10.0.0.1:7935", "10.0.0.2:7935", "10.0.0.3:7933"},
+	}, awaitEvent(t, events))
+
+	members, err := p.GetMembers(testService)
+	require.NoError(t, err)
+	assert.Equal(t, []membership.HostInfo{host("10.0.0.1:7935"), host("10.0.0.2:7935")}, members)
+
+	self, err := p.WhoAmI()
+	require.NoError(t, err)
+	assert.Equal(t, host("10.0.0.1:7935"), self)
+}
+
+func TestProviderNotifiesChangesOnRefresh(t *testing.T) {
+	source := newFakeSource(map[string][]membership.HostInfo{
+		testService: {host("10.0.0.1:7935"), host("10.0.0.2:7935")},
+	})
+	p, timeSource, events := setupProvider(t, source)
+	awaitEvent(t, events)
+
+	source.set(map[string][]membership.HostInfo{
+		testService: {
+			host("10.0.0.1:7935"),
+			membership.NewDetailedHostInfo("10.0.0.3:7935", "10.0.0.3:7935", membership.PortMap{membership.PortGRPC: 7835}),
+		},
+		"cadence-history": {host("10.0.0.4:7934")},
+	}, nil)
+	timeSource.BlockUntil(1)
+	timeSource.Advance(testRefreshInterval)
+
+	assert.Equal(t, membership.ChangedEvent{
+		HostsAdded:   []string{"10.0.0.3:7935", "10.0.0.4:7934"},
+		HostsRemoved: []string{"10.0.0.2:7935"},
+	}, awaitEvent(t, events))
+
+	source.set(map[string][]membership.HostInfo{
+		testService: {
+			host("10.0.0.1:7935"),
+			host("10.0.0.3:7935"),
+		},
+		"cadence-history": {host("10.0.0.4:7934")},
+	}, nil)
+	timeSource.BlockUntil(1)
+	timeSource.Advance(testRefreshInterval)
+
+	assert.Equal(t, membership.ChangedEvent{
+		HostsUpdated: []string{"10.0.0.3:7935"},
+	}, awaitEvent(t, events))
+
+	members, err := p.GetMembers("cadence-history")
+	require.NoError(t, err)
+	assert.Equal(t, []membership.HostInfo{host("10.0.0.4:7934")}, members)
+}
+
+func TestProviderKeepsMembersOnSourceError(t *testing.T) {
+	source := newFakeSource(map[string][]membership.HostInfo{
+		testService: {host("10.0.0.1:7935")},
+	})
+	p, timeSource, events := setupProvider(t, source)
+	awaitEvent(t, events)
+
+	source.set(nil, errors.New("source is unavailable"))
+	timeSource.BlockUntil(1)
+	timeSource.Advance(testRefreshInterval)
+	<-source.calls
+
+	members, err := p.GetMembers(testService)
+	require.NoError(t, err)
+	assert.Equal(t, []membership.HostInfo{host("10.0.0.1:7935")}, members)
+	assert.Empty(t, events)
+}
+
+func TestProviderSelfEvict(t *testing.T) {
+	source := newFakeSource(map[string][]membership.HostInfo{
+		testService: {host("10.0.0.1:7935"), host("10.0.0.2:7935")},
+	})
+	p, timeSource, events := setupProvider(t, source)
+	awaitEvent(t, events)
+
+	require.NoError(t, p.SelfEvict())
+	assert.Equal(t, membership.ChangedEvent{
+		HostsRemoved: []string{"10.0.0.1:7935"},
+	}, awaitEvent(t, events))
+
+	// the source still returns this host until it is deregistered, it must stay evicted
+	timeSource.BlockUntil(1)
+	timeSource.Advance(testRefreshInterval)
+	<-source.calls
+
+	members, err := p.GetMembers(testService)
+	require.NoError(t, err)
+	assert.Equal(t, []membership.HostInfo{host("10.0.0.2:7935")}, members)
+	assert.Empty(t, events)
+}
+
+func TestProviderSubscribeTwice(t *testing.T) {
+	p := New("test", testService, host("10.0.0.1:7935"), newFakeSource(nil), testRefreshInterval, clock.NewMockedTimeSource(), testlogger.New(t))
+
+	require.NoError(t, p.Subscribe("subscriber", func(membership.ChangedEvent) {}))
+	assert.EqualError(t, p.Subscribe("subscriber", func(membership.ChangedEvent) {}), `"subscriber" already subscribed to test provider`)
+}
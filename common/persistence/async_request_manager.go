@@ -0,0 +1,164 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	maxAsyncRequestQueueEnqueueAttempts = 5
+)
+
+type (
+	asyncRequestManager struct {
+		persistence AsyncRequestStore
+		timeSrc     clock.TimeSource
+	}
+)
+
+var _ AsyncRequestManager = (*asyncRequestManager)(nil)
+
+// NewAsyncRequestManager returns a new AsyncRequestManager
+func NewAsyncRequestManager(
+	persistence AsyncRequestStore,
+) AsyncRequestManager {
+	return &asyncRequestManager{
+		persistence: persistence,
+		timeSrc:     clock.NewRealTimeSource(),
+	}
+}
+
+func (m *asyncRequestManager) Close() {
+	m.persistence.Close()
+}
+
+func (m *asyncRequestManager) UpsertAsyncRequest(ctx context.Context, request *UpsertAsyncRequestRequest) error {
+	info := *request.Request
+	now := m.timeSrc.Now()
+	if info.CreatedTime.IsZero() {
+		info.CreatedTime = now
+	}
+	info.LastUpdatedTime = now
+	return m.persistence.UpsertAsyncRequest(ctx, &UpsertAsyncRequestRequest{Request: &info})
+}
+
+// GetAsyncRequest returns the status of an async request. Stores without TTL support may still return
+// expired records, these are treated as if they were already removed.
+func (m *asyncRequestManager) GetAsyncRequest(ctx context.Context, request *GetAsyncRequestRequest) (*GetAsyncRequestResponse, error) {
+	resp, err := m.persistence.GetAsyncRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if expiry := resp.Request.ExpiryTime; !expiry.IsZero() && !expiry.After(m.timeSrc.Now()) {
+		return nil, &types.EntityNotExistsError{Message: "async request " + request.RequestID + " not found"}
+	}
+	return resp, nil
+}
+
+func (m *asyncRequestManager) EnqueueAsyncRequestToDLQ(ctx context.Context, request *EnqueueAsyncRequestToDLQRequest) error {
+	entry := *request.Entry
+	if entry.CreatedTime.IsZero() {
+		entry.CreatedTime = m.timeSrc.Now()
+	}
+	return m.persistence.EnqueueAsyncRequestToDLQ(ctx, &EnqueueAsyncRequestToDLQRequest{Entry: &entry})
+}
+
+func (m *asyncRequestManager) ReadAsyncRequestDLQ(ctx context.Context, request *ReadAsyncRequestDLQRequest) (*ReadAsyncRequestDLQResponse, error) {
+	return m.persistence.ReadAsyncRequestDLQ(ctx, request)
+}
+
+func (m *asyncRequestManager) GetAsyncRequestDLQEntry(ctx context.Context, request *GetAsyncRequestDLQEntryRequest) (*GetAsyncRequestDLQEntryResponse, error) {
+	return m.persistence.GetAsyncRequestDLQEntry(ctx, request)
+}
+
+func (m *asyncRequestManager) DeleteAsyncRequestDLQEntry(ctx context.Context, request *DeleteAsyncRequestDLQEntryRequest) error {
+	return m.persistence.DeleteAsyncRequestDLQEntry(ctx, request)
+}
+
+// EnqueueAsyncRequestQueueMessage appends a message to a queue. Message IDs are assigned in order and never go below
+// the ack level of the queue, so that messages enqueued after the queue was drained are not skipped by the consumer.
+func (m *asyncRequestManager) EnqueueAsyncRequestQueueMessage(ctx context.Context, request *EnqueueAsyncRequestQueueMessageRequest) (*EnqueueAsyncRequestQueueMessageResponse, error) {
+	var err error
+	for attempt := 0; attempt < maxAsyncRequestQueueEnqueueAttempts; attempt++ {
+		var messageID int64
+		messageID, err = m.nextAsyncRequestQueueMessageID(ctx, request.QueueName)
+		if err != nil {
+			return nil, err
+		}
+		err = m.persistence.InsertAsyncRequestQueueMessage(ctx, &InternalInsertAsyncRequestQueueMessageRequest{
+			QueueName: request.QueueName,
+			Message: &AsyncRequestQueueMessage{
+				MessageID:   messageID,
+				Payload:     request.Payload,
+				CreatedTime: m.timeSrc.Now(),
+			},
+		})
+		if err == nil {
+			return &EnqueueAsyncRequestQueueMessageResponse{MessageID: messageID}, nil
+		}
+		var conditionFailedErr *ConditionFailedError
+		if !errors.As(err, &conditionFailedErr) {
+			return nil, err
+		}
+		// another producer took the message ID, try again with the next one
+	}
+	return nil, err
+}
+
+func (m *asyncRequestManager) nextAsyncRequestQueueMessageID(ctx context.Context, queueName string) (int64, error) {
+	lastMessageID, err := m.persistence.GetLastAsyncRequestQueueMessageID(ctx, queueName)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := m.persistence.GetAsyncRequestQueueMetadata(ctx, &GetAsyncRequestQueueMetadataRequest{QueueName: queueName})
+	if err != nil {
+		var notExistsErr *types.EntityNotExistsError
+		if !errors.As(err, &notExistsErr) {
+			return 0, err
+		}
+	} else if resp.Metadata.AckLevel > lastMessageID {
+		lastMessageID = resp.Metadata.AckLevel
+	}
+	return lastMessageID + 1, nil
+}
+
+func (m *asyncRequestManager) ReadAsyncRequestQueueMessages(ctx context.Context, request *ReadAsyncRequestQueueMessagesRequest) (*ReadAsyncRequestQueueMessagesResponse, error) {
+	return m.persistence.ReadAsyncRequestQueueMessages(ctx, request)
+}
+
+func (m *asyncRequestManager) DeleteAsyncRequestQueueMessages(ctx context.Context, request *DeleteAsyncRequestQueueMessagesRequest) error {
+	return m.persistence.DeleteAsyncRequestQueueMessages(ctx, request)
+}
+
+func (m *asyncRequestManager) GetAsyncRequestQueueMetadata(ctx context.Context, request *GetAsyncRequestQueueMetadataRequest) (*GetAsyncRequestQueueMetadataResponse, error) {
+	return m.persistence.GetAsyncRequestQueueMetadata(ctx, request)
+}
+
+func (m *asyncRequestManager) UpdateAsyncRequestQueueMetadata(ctx context.Context, request *UpdateAsyncRequestQueueMetadataRequest) error {
+	return m.persistence.UpdateAsyncRequestQueueMetadata(ctx, request)
+}
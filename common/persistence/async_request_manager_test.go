@@ -0,0 +1,362 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/types"
+)
+
+func setUpMocksForAsyncRequestManager(t *testing.T) (*asyncRequestManager, *MockAsyncRequestStore, clock.MockedTimeSource) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockAsyncRequestStore(ctrl)
+	timeSrc := clock.NewMockedTimeSourceAt(time.Unix(1700000000, 0))
+	return &asyncRequestManager{
+		persistence: mockStore,
+		timeSrc:     timeSrc,
+	}, mockStore, timeSrc
+}
+
+func TestNewAsyncRequestManager(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockAsyncRequestStore(ctrl)
+	m := NewAsyncRequestManager(mockStore)
+	am, ok := m.(*asyncRequestManager)
+	assert.True(t, ok)
+	assert.Equal(t, mockStore, am.persistence)
+
+	mockStore.EXPECT().Close().Times(1)
+	m.Close()
+}
+
+func TestUpsertAsyncRequest(t *testing.T) {
+	createdTime := time.Unix(1600000000, 0)
+	testCases := []struct {
+		name        string
+		request     *AsyncRequestInfo
+		expected    func(now time.Time) *AsyncRequestInfo
+		storeErr    error
+		expectError bool
+	}{
+		{
+			name: "new request gets created and last updated time",
+			request: &AsyncRequestInfo{
+				DomainID:  "domain-id",
+				RequestID: "request-id",
+				State:     types.AsyncRequestStateQueued,
+			},
+			expected: func(now time.Time) *AsyncRequestInfo {
+				return &AsyncRequestInfo{
+					DomainID:        "domain-id",
+					RequestID:       "request-id",
+					State:           types.AsyncRequestStateQueued,
+					CreatedTime:     now,
+					LastUpdatedTime: now,
+				}
+			},
+		},
+		{
+			name: "existing created time is kept",
+			request: &AsyncRequestInfo{
+				DomainID:    "domain-id",
+				RequestID:   "request-id",
+				RunID:       "run-id",
+				State:       types.AsyncRequestStateSucceeded,
+				CreatedTime: createdTime,
+			},
+			expected: func(now time.Time) *AsyncRequestInfo {
+				return &AsyncRequestInfo{
+					DomainID:        "domain-id",
+					RequestID:       "request-id",
+					RunID:           "run-id",
+					State:           types.AsyncRequestStateSucceeded,
+					CreatedTime:     createdTime,
+					LastUpdatedTime: now,
+				}
+			},
+		},
+		{
+			name: "store error",
+			request: &AsyncRequestInfo{
+				DomainID:  "domain-id",
+				RequestID: "request-id",
+			},
+			expected: func(now time.Time) *AsyncRequestInfo {
+				return &AsyncRequestInfo{
+					DomainID:        "domain-id",
+					RequestID:       "request-id",
+					CreatedTime:     now,
+					LastUpdatedTime: now,
+				}
+			},
+			storeErr:    errors.New("store error"),
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, mockStore, timeSrc := setUpMocksForAsyncRequestManager(t)
+			mockStore.EXPECT().UpsertAsyncRequest(gomock.Any(), &UpsertAsyncRequestRequest{Request: tc.expected(timeSrc.Now())}).Return(tc.storeErr).Times(1)
+
+			err := m.UpsertAsyncRequest(context.Background(), &UpsertAsyncRequestRequest{Request: tc.request})
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEnqueueAsyncRequestToDLQ(t *testing.T) {
+	m, mockStore, timeSrc := setUpMocksForAsyncRequestManager(t)
+	mockStore.EXPECT().EnqueueAsyncRequestToDLQ(gomock.Any(), &EnqueueAsyncRequestToDLQRequest{
+		Entry: &AsyncRequestDLQEntry{
+			DomainID:    "domain-id",
+			RequestID:   "request-id",
+			Payload:     []byte("payload"),
+			CreatedTime: timeSrc.Now(),
+		},
+	}).Return(nil).Times(1)
+
+	err := m.EnqueueAsyncRequestToDLQ(context.Background(), &EnqueueAsyncRequestToDLQRequest{
+		Entry: &AsyncRequestDLQEntry{
+			DomainID:  "domain-id",
+			RequestID: "request-id",
+			Payload:   []byte("payload"),
+		},
+	})
+	assert.NoError(t, err)
+}
+
+func TestGetAsyncRequest(t *testing.T) {
+	getReq := &GetAsyncRequestRequest{DomainID: "domain-id", RequestID: "request-id"}
+	testCases := []struct {
+		name     string
+		expiry   time.Duration
+		storeErr error
+		wantErr  error
+	}{
+		{
+			name: "no expiry",
+		},
+		{
+			name:   "not expired",
+			expiry: time.Hour,
+		},
+		{
+			name:    "expired",
+			expiry:  -time.Second,
+			wantErr: &types.EntityNotExistsError{},
+		},
+		{
+			name:     "store error",
+			storeErr: errors.New("store failed"),
+			wantErr:  errors.New("store failed"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, mockStore, timeSrc := setUpMocksForAsyncRequestManager(t)
+			info := &AsyncRequestInfo{DomainID: "domain-id", RequestID: "request-id"}
+			if tc.expiry != 0 {
+				info.ExpiryTime = timeSrc.Now().Add(tc.expiry)
+			}
+			if tc.storeErr != nil {
+				mockStore.EXPECT().GetAsyncRequest(gomock.Any(), getReq).Return(nil, tc.storeErr).Times(1)
+			} else {
+				mockStore.EXPECT().GetAsyncRequest(gomock.Any(), getReq).Return(&GetAsyncRequestResponse{Request: info}, nil).Times(1)
+			}
+
+			resp, err := m.GetAsyncRequest(context.Background(), getReq)
+			if tc.wantErr != nil {
+				assert.IsType(t, tc.wantErr, err)
+				assert.Nil(t, resp)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, info, resp.Request)
+		})
+	}
+}
+
+func TestAsyncRequestManagerPassthrough(t *testing.T) {
+	ctx := context.Background()
+	m, mockStore, _ := setUpMocksForAsyncRequestManager(t)
+
+	readReq := &ReadAsyncRequestDLQRequest{DomainID: "domain-id", PageSize: 10}
+	readResp := &ReadAsyncRequestDLQResponse{Entries: []*AsyncRequestDLQEntry{{RequestID: "request-id"}}, NextPageToken: []byte("token")}
+	mockStore.EXPECT().ReadAsyncRequestDLQ(ctx, readReq).Return(readResp, nil).Times(1)
+	dlqResp, err := m.ReadAsyncRequestDLQ(ctx, readReq)
+	assert.NoError(t, err)
+	assert.Equal(t, readResp, dlqResp)
+
+	getEntryReq := &GetAsyncRequestDLQEntryRequest{DomainID: "domain-id", RequestID: "request-id"}
+	mockStore.EXPECT().GetAsyncRequestDLQEntry(ctx, getEntryReq).Return(nil, &types.EntityNotExistsError{}).Times(1)
+	_, err = m.GetAsyncRequestDLQEntry(ctx, getEntryReq)
+	assert.IsType(t, &types.EntityNotExistsError{}, err)
+
+	deleteReq := &DeleteAsyncRequestDLQEntryRequest{DomainID: "domain-id", RequestID: "request-id"}
+	mockStore.EXPECT().DeleteAsyncRequestDLQEntry(ctx, deleteReq).Return(nil).Times(1)
+	assert.NoError(t, m.DeleteAsyncRequestDLQEntry(ctx, deleteReq))
+}
+
+func TestEnqueueAsyncRequestQueueMessage(t *testing.T) {
+	metadataReq := &GetAsyncRequestQueueMetadataRequest{QueueName: "queue"}
+	testCases := []struct {
+		name          string
+		setupMock     func(*MockAsyncRequestStore, time.Time)
+		wantMessageID int64
+		wantErr       bool
+	}{
+		{
+			name: "empty queue without metadata",
+			setupMock: func(mockStore *MockAsyncRequestStore, now time.Time) {
+				mockStore.EXPECT().GetLastAsyncRequestQueueMessageID(gomock.Any(), "queue").Return(int64(-1), nil).Times(1)
+				mockStore.EXPECT().GetAsyncRequestQueueMetadata(gomock.Any(), metadataReq).Return(nil, &types.EntityNotExistsError{}).Times(1)
+				mockStore.EXPECT().InsertAsyncRequestQueueMessage(gomock.Any(), &InternalInsertAsyncRequestQueueMessageRequest{
+					QueueName: "queue",
+					Message:   &AsyncRequestQueueMessage{MessageID: 0, Payload: []byte("payload"), CreatedTime: now},
+				}).Return(nil).Times(1)
+			},
+			wantMessageID: 0,
+		},
+		{
+			name: "drained queue continues after ack level",
+			setupMock: func(mockStore *MockAsyncRequestStore, now time.Time) {
+				mockStore.EXPECT().GetLastAsyncRequestQueueMessageID(gomock.Any(), "queue").Return(int64(-1), nil).Times(1)
+				mockStore.EXPECT().GetAsyncRequestQueueMetadata(gomock.Any(), metadataReq).Return(&GetAsyncRequestQueueMetadataResponse{
+					Metadata: &AsyncRequestQueueMetadata{QueueName: "queue", AckLevel: 10},
+				}, nil).Times(1)
+				mockStore.EXPECT().InsertAsyncRequestQueueMessage(gomock.Any(), &InternalInsertAsyncRequestQueueMessageRequest{
+					QueueName: "queue",
+					Message:   &AsyncRequestQueueMessage{MessageID: 11, Payload: []byte("payload"), CreatedTime: now},
+				}).Return(nil).Times(1)
+			},
+			wantMessageID: 11,
+		},
+		{
+			name: "retry on conflicting message ID",
+			setupMock: func(mockStore *MockAsyncRequestStore, now time.Time) {
+				gomock.InOrder(
+					mockStore.EXPECT().GetLastAsyncRequestQueueMessageID(gomock.Any(), "queue").Return(int64(4), nil),
+					mockStore.EXPECT().GetLastAsyncRequestQueueMessageID(gomock.Any(), "queue").Return(int64(5), nil),
+				)
+				mockStore.EXPECT().GetAsyncRequestQueueMetadata(gomock.Any(), metadataReq).Return(&GetAsyncRequestQueueMetadataResponse{
+					Metadata: &AsyncRequestQueueMetadata{QueueName: "queue", AckLevel: 2},
+				}, nil).Times(2)
+				gomock.InOrder(
+					mockStore.EXPECT().InsertAsyncRequestQueueMessage(gomock.Any(), &InternalInsertAsyncRequestQueueMessageRequest{
+						QueueName: "queue",
+						Message:   &AsyncRequestQueueMessage{MessageID: 5, Payload: []byte("payload"), CreatedTime: now},
+					}).Return(&ConditionFailedError{}),
+					mockStore.EXPECT().InsertAsyncRequestQueueMessage(gomock.Any(), &InternalInsertAsyncRequestQueueMessageRequest{
+						QueueName: "queue",
+						Message:   &AsyncRequestQueueMessage{MessageID: 6, Payload: []byte("payload"), CreatedTime: now},
+					}).Return(nil),
+				)
+			},
+			wantMessageID: 6,
+		},
+		{
+			name: "too many conflicts",
+			setupMock: func(mockStore *MockAsyncRequestStore, now time.Time) {
+				mockStore.EXPECT().GetLastAsyncRequestQueueMessageID(gomock.Any(), "queue").Return(int64(4), nil).Times(maxAsyncRequestQueueEnqueueAttempts)
+				mockStore.EXPECT().GetAsyncRequestQueueMetadata(gomock.Any(), metadataReq).Return(nil, &types.EntityNotExistsError{}).Times(maxAsyncRequestQueueEnqueueAttempts)
+				mockStore.EXPECT().InsertAsyncRequestQueueMessage(gomock.Any(), gomock.Any()).Return(&ConditionFailedError{}).Times(maxAsyncRequestQueueEnqueueAttempts)
+			},
+			wantErr: true,
+		},
+		{
+			name: "metadata error",
+			setupMock: func(mockStore *MockAsyncRequestStore, now time.Time) {
+				mockStore.EXPECT().GetLastAsyncRequestQueueMessageID(gomock.Any(), "queue").Return(int64(4), nil).Times(1)
+				mockStore.EXPECT().GetAsyncRequestQueueMetadata(gomock.Any(), metadataReq).Return(nil, errors.New("store failed")).Times(1)
+			},
+			wantErr: true,
+		},
+		{
+			name: "insert error",
+			setupMock: func(mockStore *MockAsyncRequestStore, now time.Time) {
+				mockStore.EXPECT().GetLastAsyncRequestQueueMessageID(gomock.Any(), "queue").Return(int64(4), nil).Times(1)
+				mockStore.EXPECT().GetAsyncRequestQueueMetadata(gomock.Any(), metadataReq).Return(nil, &types.EntityNotExistsError{}).Times(1)
+				mockStore.EXPECT().InsertAsyncRequestQueueMessage(gomock.Any(), gomock.Any()).Return(errors.New("store failed")).Times(1)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, mockStore, timeSrc := setUpMocksForAsyncRequestManager(t)
+			tc.setupMock(mockStore, timeSrc.Now())
+
+			resp, err := m.EnqueueAsyncRequestQueueMessage(context.Background(), &EnqueueAsyncRequestQueueMessageRequest{
+				QueueName: "queue",
+				Payload:   []byte("payload"),
+			})
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, resp)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantMessageID, resp.MessageID)
+		})
+	}
+}
+
+func TestAsyncRequestQueuePassthrough(t *testing.T) {
+	ctx := context.Background()
+	m, mockStore, _ := setUpMocksForAsyncRequestManager(t)
+
+	readReq := &ReadAsyncRequestQueueMessagesRequest{QueueName: "queue", ExclusiveBeginMessageID: 3, PageSize: 10}
+	readResp := &ReadAsyncRequestQueueMessagesResponse{Messages: []*AsyncRequestQueueMessage{{MessageID: 4}}}
+	mockStore.EXPECT().ReadAsyncRequestQueueMessages(ctx, readReq).Return(readResp, nil).Times(1)
+	resp, err := m.ReadAsyncRequestQueueMessages(ctx, readReq)
+	assert.NoError(t, err)
+	assert.Equal(t, readResp, resp)
+
+	deleteReq := &DeleteAsyncRequestQueueMessagesRequest{QueueName: "queue", InclusiveEndMessageID: 4}
+	mockStore.EXPECT().DeleteAsyncRequestQueueMessages(ctx, deleteReq).Return(nil).Times(1)
+	assert.NoError(t, m.DeleteAsyncRequestQueueMessages(ctx, deleteReq))
+
+	getReq := &GetAsyncRequestQueueMetadataRequest{QueueName: "queue"}
+	getResp := &GetAsyncRequestQueueMetadataResponse{Metadata: &AsyncRequestQueueMetadata{QueueName: "queue", AckLevel: 4, Version: 2}}
+	mockStore.EXPECT().GetAsyncRequestQueueMetadata(ctx, getReq).Return(getResp, nil).Times(1)
+	metadata, err := m.GetAsyncRequestQueueMetadata(ctx, getReq)
+	assert.NoError(t, err)
+	assert.Equal(t, getResp, metadata)
+
+	updateReq := &UpdateAsyncRequestQueueMetadataRequest{Metadata: &AsyncRequestQueueMetadata{QueueName: "queue", AckLevel: 5, Version: 3}, PreviousVersion: 2}
+	mockStore.EXPECT().UpdateAsyncRequestQueueMetadata(ctx, updateReq).Return(&ConditionFailedError{}).Times(1)
+	assert.IsType(t, &ConditionFailedError{}, m.UpdateAsyncRequestQueueMetadata(ctx, updateReq))
+}
@@ -62,6 +62,12 @@ type (
 
 		GetConfigStoreManager() persistence.ConfigStoreManager
 		SetConfigStoreManager(persistence.ConfigStoreManager)
+
+		GetAsyncRequestManager() persistence.AsyncRequestManager
+		SetAsyncRequestManager(persistence.AsyncRequestManager)
+
+		GetMapQManager() persistence.MapQManager
+		SetMapQManager(persistence.MapQManager)
 	}
 
 	// BeanImpl stores persistence managers
@@ -73,6 +79,8 @@ type (
 		shardManager                  persistence.ShardManager
 		historyManager                persistence.HistoryManager
 		configStoreManager            persistence.ConfigStoreManager
+		asyncRequestManager           persistence.AsyncRequestManager
+		mapQManager                   persistence.MapQManager
 		executionManagerFactory       persistence.ExecutionManagerFactory
 
 		sync.RWMutex
@@ -135,6 +143,16 @@ func NewBeanFromFactory(
 		return nil, err
 	}
 
+	asyncRequestMgr, err := factory.NewAsyncRequestManager()
+	if err != nil {
+		return nil, err
+	}
+
+	mapQMgr, err := factory.NewMapQManager()
+	if err != nil {
+		return nil, err
+	}
+
 	return NewBean(
 		metadataMgr,
 		taskMgr,
@@ -143,6 +161,8 @@ func NewBeanFromFactory(
 		shardMgr,
 		historyMgr,
 		configStoreMgr,
+		asyncRequestMgr,
+		mapQMgr,
 		factory,
 	), nil
 }
@@ -156,6 +176,8 @@ func NewBean(
 	shardManager persistence.ShardManager,
 	historyManager persistence.HistoryManager,
 	configStoreManager persistence.ConfigStoreManager,
+	asyncRequestManager persistence.AsyncRequestManager,
+	mapQManager persistence.MapQManager,
 	executionManagerFactory persistence.ExecutionManagerFactory,
 ) *BeanImpl {
 	return &BeanImpl{
@@ -166,6 +188,8 @@ func NewBean(
 		shardManager:                  shardManager,
 		historyManager:                historyManager,
 		configStoreManager:            configStoreManager,
+		asyncRequestManager:           asyncRequestManager,
+		mapQManager:                   mapQManager,
 		executionManagerFactory:       executionManagerFactory,
 
 		shardIDToExecutionManager: make(map[int]persistence.ExecutionManager),
@@ -354,6 +378,46 @@ func (s *BeanImpl) SetConfigStoreManager(
 	s.configStoreManager = configStoreManager
 }
 
+// GetAsyncRequestManager gets AsyncRequestManager
+func (s *BeanImpl) GetAsyncRequestManager() persistence.AsyncRequestManager {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.asyncRequestManager
+}
+
+// SetAsyncRequestManager sets AsyncRequestManager
+func (s *BeanImpl) SetAsyncRequestManager(
+	asyncRequestManager persistence.AsyncRequestManager,
+) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.asyncRequestManager = asyncRequestManager
+}
+
+// GetMapQManager gets MapQManager
+func (s *BeanImpl) GetMapQManager() persistence.MapQManager {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.mapQManager
+}
+
+// SetMapQManager sets MapQManager
+func (s *BeanImpl) SetMapQManager(
+	mapQManager persistence.MapQManager,
+) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.mapQManager = mapQManager
+}
+
 // Close cleanup connections
 func (s *BeanImpl) Close() {
 
@@ -371,6 +435,8 @@ func (s *BeanImpl) Close() {
 	s.historyManager.Close()
 	s.executionManagerFactory.Close()
 	s.configStoreManager.Close()
+	s.asyncRequestManager.Close()
+	s.mapQManager.Close()
 	for _, executionMgr := range s.shardIDToExecutionManager {
 		executionMgr.Close()
 	}
@@ -53,6 +53,20 @@ func (mr *MockBeanMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockBean)(nil).Close))
 }
 
+// GetAsyncRequestManager mocks base method.
+func (m *MockBean) GetAsyncRequestManager() persistence.AsyncRequestManager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAsyncRequestManager")
+	ret0, _ := ret[0].(persistence.AsyncRequestManager)
+	return ret0
+}
+
+// GetAsyncRequestManager indicates an expected call of GetAsyncRequestManager.
+func (mr *MockBeanMockRecorder) GetAsyncRequestManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAsyncRequestManager", reflect.TypeOf((*MockBean)(nil).GetAsyncRequestManager))
+}
+
 // GetConfigStoreManager mocks base method.
 func (m *MockBean) GetConfigStoreManager() persistence.ConfigStoreManager {
 	m.ctrl.T.Helper()
@@ -124,6 +138,20 @@ func (mr *MockBeanMockRecorder) GetHistoryManager() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryManager", reflect.TypeOf((*MockBean)(nil).GetHistoryManager))
 }
 
+// GetMapQManager mocks base method.
+func (m *MockBean) GetMapQManager() persistence.MapQManager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMapQManager")
+	ret0, _ := ret[0].(persistence.MapQManager)
+	return ret0
+}
+
+// GetMapQManager indicates an expected call of GetMapQManager.
+func (mr *MockBeanMockRecorder) GetMapQManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMapQManager", reflect.TypeOf((*MockBean)(nil).GetMapQManager))
+}
+
 // GetShardManager mocks base method.
 func (m *MockBean) GetShardManager() persistence.ShardManager {
 	m.ctrl.T.Helper()
@@ -166,6 +194,18 @@ func (mr *MockBeanMockRecorder) GetVisibilityManager() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVisibilityManager", reflect.TypeOf((*MockBean)(nil).GetVisibilityManager))
 }
 
+// SetAsyncRequestManager mocks base method.
+func (m *MockBean) SetAsyncRequestManager(arg0 persistence.AsyncRequestManager) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAsyncRequestManager", arg0)
+}
+
+// SetAsyncRequestManager indicates an expected call of SetAsyncRequestManager.
+func (mr *MockBeanMockRecorder) SetAsyncRequestManager(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAsyncRequestManager", reflect.TypeOf((*MockBean)(nil).SetAsyncRequestManager), arg0)
+}
+
 // SetConfigStoreManager mocks base method.
 func (m *MockBean) SetConfigStoreManager(arg0 persistence.ConfigStoreManager) {
 	m.ctrl.T.Helper()
@@ -226,6 +266,18 @@ func (mr *MockBeanMockRecorder) SetHistoryManager(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHistoryManager", reflect.TypeOf((*MockBean)(nil).SetHistoryManager), arg0)
 }
 
+// SetMapQManager mocks base method.
+func (m *MockBean) SetMapQManager(arg0 persistence.MapQManager) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMapQManager", arg0)
+}
+
+// SetMapQManager indicates an expected call of SetMapQManager.
+func (mr *MockBeanMockRecorder) SetMapQManager(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMapQManager", reflect.TypeOf((*MockBean)(nil).SetMapQManager), arg0)
+}
+
 // SetShardManager mocks base method.
 func (m *MockBean) SetShardManager(arg0 persistence.ShardManager) {
 	m.ctrl.T.Helper()
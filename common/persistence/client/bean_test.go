@@ -36,27 +36,31 @@ import (
 )
 
 type beanmocks struct {
-	mockCtrl           *gomock.Controller
-	domainManager      *persistence.MockDomainManager
-	taskManager        *persistence.MockTaskManager
-	visibilityManager  *persistence.MockVisibilityManager
-	replicationManager *persistence.MockQueueManager
-	shardManager       *persistence.MockShardManager
-	historyManager     *persistence.MockHistoryManager
-	configManager      *persistence.MockConfigStoreManager
+	mockCtrl            *gomock.Controller
+	domainManager       *persistence.MockDomainManager
+	taskManager         *persistence.MockTaskManager
+	visibilityManager   *persistence.MockVisibilityManager
+	replicationManager  *persistence.MockQueueManager
+	shardManager        *persistence.MockShardManager
+	historyManager      *persistence.MockHistoryManager
+	configManager       *persistence.MockConfigStoreManager
+	asyncRequestManager *persistence.MockAsyncRequestManager
+	mapQManager         *persistence.MockMapQManager
 }
 
 func beanSetup(t *testing.T) (f *MockFactory, m beanmocks, defaultMocks func()) {
 	ctrl := gomock.NewController(t)
 	m = beanmocks{
-		mockCtrl:           ctrl,
-		domainManager:      persistence.NewMockDomainManager(ctrl),
-		taskManager:        persistence.NewMockTaskManager(ctrl),
-		visibilityManager:  persistence.NewMockVisibilityManager(ctrl),
-		replicationManager: persistence.NewMockQueueManager(ctrl),
-		shardManager:       persistence.NewMockShardManager(ctrl),
-		historyManager:     persistence.NewMockHistoryManager(ctrl),
-		configManager:      persistence.NewMockConfigStoreManager(ctrl),
+		mockCtrl:            ctrl,
+		domainManager:       persistence.NewMockDomainManager(ctrl),
+		taskManager:         persistence.NewMockTaskManager(ctrl),
+		visibilityManager:   persistence.NewMockVisibilityManager(ctrl),
+		replicationManager:  persistence.NewMockQueueManager(ctrl),
+		shardManager:        persistence.NewMockShardManager(ctrl),
+		historyManager:      persistence.NewMockHistoryManager(ctrl),
+		configManager:       persistence.NewMockConfigStoreManager(ctrl),
+		asyncRequestManager: persistence.NewMockAsyncRequestManager(ctrl),
+		mapQManager:         persistence.NewMockMapQManager(ctrl),
 	}
 	f = NewMockFactory(ctrl)
 	defaultMocks = func() {
@@ -68,6 +72,8 @@ func beanSetup(t *testing.T) (f *MockFactory, m beanmocks, defaultMocks func())
 		f.EXPECT().NewShardManager().Return(m.shardManager, nil).MaxTimes(1)
 		f.EXPECT().NewHistoryManager().Return(m.historyManager, nil).MaxTimes(1)
 		f.EXPECT().NewConfigStoreManager().Return(m.configManager, nil).MaxTimes(1)
+		f.EXPECT().NewAsyncRequestManager().Return(m.asyncRequestManager, nil).MaxTimes(1)
+		f.EXPECT().NewMapQManager().Return(m.mapQManager, nil).MaxTimes(1)
 	}
 	return f, m, defaultMocks
 }
@@ -129,6 +135,18 @@ func TestBeanCoverage(t *testing.T) {
 				},
 				err: "no config manager",
 			},
+			"async request manager error": {
+				mockSetup: func(t *testing.T, f *MockFactory) {
+					f.EXPECT().NewAsyncRequestManager().Return(nil, fmt.Errorf("no async request manager"))
+				},
+				err: "no async request manager",
+			},
+			"mapq manager error": {
+				mockSetup: func(t *testing.T, f *MockFactory) {
+					f.EXPECT().NewMapQManager().Return(nil, fmt.Errorf("no mapq manager"))
+				},
+				err: "no mapq manager",
+			},
 		}
 		for name, test := range tests {
 			name, test := name, test
@@ -163,6 +181,8 @@ func TestBeanCoverage(t *testing.T) {
 		g.Go(errgroupAssertEqual(t, m.shardManager, impl.GetShardManager))
 		g.Go(errgroupAssertEqual(t, m.historyManager, impl.GetHistoryManager))
 		g.Go(errgroupAssertEqual(t, m.configManager, impl.GetConfigStoreManager))
+		g.Go(errgroupAssertEqual(t, m.asyncRequestManager, impl.GetAsyncRequestManager))
+		g.Go(errgroupAssertEqual(t, m.mapQManager, impl.GetMapQManager))
 		require.NoError(t, g.Wait())
 		// execution managers are per shard, checked separately
 	})
@@ -184,6 +204,8 @@ func TestBeanCoverage(t *testing.T) {
 		g.Go(errgroupAssertSets(t, m2.shardManager, impl.SetShardManager, impl.GetShardManager))
 		g.Go(errgroupAssertSets(t, m2.historyManager, impl.SetHistoryManager, impl.GetHistoryManager))
 		g.Go(errgroupAssertSets(t, m2.configManager, impl.SetConfigStoreManager, impl.GetConfigStoreManager))
+		g.Go(errgroupAssertSets(t, m2.asyncRequestManager, impl.SetAsyncRequestManager, impl.GetAsyncRequestManager))
+		g.Go(errgroupAssertSets(t, m2.mapQManager, impl.SetMapQManager, impl.GetMapQManager))
 		require.NoError(t, g.Wait())
 		// execution managers are per shard, checked separately
 	})
@@ -254,6 +276,8 @@ func TestBeanCoverage(t *testing.T) {
 		m.shardManager.EXPECT().Close().Return().Times(1)
 		m.historyManager.EXPECT().Close().Return().Times(1)
 		m.configManager.EXPECT().Close().Return().Times(1)
+		m.asyncRequestManager.EXPECT().Close().Return().Times(1)
+		m.mapQManager.EXPECT().Close().Return().Times(1)
 		ex1.EXPECT().Close().Return().Times(1)
 		ex2.EXPECT().Close().Return().Times(1)
 		// which includes the execution-manager-factory itself
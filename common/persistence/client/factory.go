@@ -70,8 +70,16 @@ type (
 		NewVisibilityManager(params *Params, serviceConfig *service.Config) (p.VisibilityManager, error)
 		// NewDomainReplicationQueueManager returns a new queue for domain replication
 		NewDomainReplicationQueueManager() (p.QueueManager, error)
+		// NewVisibilityQueueManager returns a new queue for visibility messages
+		NewVisibilityQueueManager() (p.QueueManager, error)
 		// NewConfigStoreManager returns a new config store manager
 		NewConfigStoreManager() (p.ConfigStoreManager, error)
+		// NewAsyncRequestManager returns a new async request manager
+		NewAsyncRequestManager() (p.AsyncRequestManager, error)
+		// NewMapQManager returns a new MAPQ manager
+		NewMapQManager() (p.MapQManager, error)
+		// NewShardDistributorManager returns a new shard distributor manager
+		NewShardDistributorManager() (p.ShardDistributorManager, error)
 	}
 	// DataStoreFactory is a low level interface to be implemented by a datastore
 	// Examples of datastores are cassandra, mysql etc
@@ -95,6 +103,12 @@ type (
 		NewQueue(queueType p.QueueType) (p.Queue, error)
 		// NewConfigStore returns a new config store
 		NewConfigStore() (p.ConfigStore, error)
+		// NewAsyncRequestStore returns a new async request store
+		NewAsyncRequestStore() (p.AsyncRequestStore, error)
+		// NewMapQStore returns a new MAPQ store
+		NewMapQStore() (p.MapQStore, error)
+		// NewShardDistributorStore returns a new shard distributor store
+		NewShardDistributorStore() (p.ShardDistributorStore, error)
 	}
 
 	// Datastore represents a datastore
@@ -124,6 +138,9 @@ const (
 	storeTypeVisibility
 	storeTypeQueue
 	storeTypeConfigStore
+	storeTypeAsyncRequest
+	storeTypeMapQ
+	storeTypeShardDistributor
 )
 
 var storeTypes = []storeType{
@@ -135,6 +152,9 @@ var storeTypes = []storeType{
 	storeTypeVisibility,
 	storeTypeQueue,
 	storeTypeConfigStore,
+	storeTypeAsyncRequest,
+	storeTypeMapQ,
+	storeTypeShardDistributor,
 }
 
 // NewFactory returns an implementation of factory that vends persistence objects based on
@@ -472,8 +492,16 @@ func (f *factoryImpl) newDBVisibilityManager(
 }
 
 func (f *factoryImpl) NewDomainReplicationQueueManager() (p.QueueManager, error) {
+	return f.newQueueManager(p.DomainReplicationQueueType)
+}
+
+func (f *factoryImpl) NewVisibilityQueueManager() (p.QueueManager, error) {
+	return f.newQueueManager(p.VisibilityQueueType)
+}
+
+func (f *factoryImpl) newQueueManager(queueType p.QueueType) (p.QueueManager, error) {
 	ds := f.datastores[storeTypeQueue]
-	store, err := ds.factory.NewQueue(p.DomainReplicationQueueType)
+	store, err := ds.factory.NewQueue(queueType)
 	if err != nil {
 		return nil, err
 	}
@@ -511,6 +539,66 @@ func (f *factoryImpl) NewConfigStoreManager() (p.ConfigStoreManager, error) {
 	return result, nil
 }
 
+func (f *factoryImpl) NewAsyncRequestManager() (p.AsyncRequestManager, error) {
+	ds := f.datastores[storeTypeAsyncRequest]
+	store, err := ds.factory.NewAsyncRequestStore()
+	if err != nil {
+		return nil, err
+	}
+	result := p.NewAsyncRequestManager(store)
+	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
+		result = errorinjectors.NewAsyncRequestManager(result, errorRate, f.logger)
+	}
+	if ds.ratelimit != nil {
+		result = ratelimited.NewAsyncRequestManager(result, ds.ratelimit)
+	}
+	if f.metricsClient != nil {
+		result = metered.NewAsyncRequestManager(result, f.metricsClient, f.logger, f.config)
+	}
+
+	return result, nil
+}
+
+func (f *factoryImpl) NewMapQManager() (p.MapQManager, error) {
+	ds := f.datastores[storeTypeMapQ]
+	store, err := ds.factory.NewMapQStore()
+	if err != nil {
+		return nil, err
+	}
+	result := p.NewMapQManager(store)
+	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
+		result = errorinjectors.NewMapQManager(result, errorRate, f.logger)
+	}
+	if ds.ratelimit != nil {
+		result = ratelimited.NewMapQManager(result, ds.ratelimit)
+	}
+	if f.metricsClient != nil {
+		result = metered.NewMapQManager(result, f.metricsClient, f.logger, f.config)
+	}
+
+	return result, nil
+}
+
+func (f *factoryImpl) NewShardDistributorManager() (p.ShardDistributorManager, error) {
+	ds := f.datastores[storeTypeShardDistributor]
+	store, err := ds.factory.NewShardDistributorStore()
+	if err != nil {
+		return nil, err
+	}
+	result := p.NewShardDistributorManager(store)
+	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
+		result = errorinjectors.NewShardDistributorManager(result, errorRate, f.logger)
+	}
+	if ds.ratelimit != nil {
+		result = ratelimited.NewShardDistributorManager(result, ds.ratelimit)
+	}
+	if f.metricsClient != nil {
+		result = metered.NewShardDistributorManager(result, f.metricsClient, f.logger, f.config)
+	}
+
+	return result, nil
+}
+
 // Close closes this factory
 func (f *factoryImpl) Close() {
 	ds := f.datastores[storeTypeExecution]
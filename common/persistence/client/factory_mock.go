@@ -54,6 +54,21 @@ func (mr *MockFactoryMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockFactory)(nil).Close))
 }
 
+// NewAsyncRequestManager mocks base method.
+func (m *MockFactory) NewAsyncRequestManager() (persistence.AsyncRequestManager, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAsyncRequestManager")
+	ret0, _ := ret[0].(persistence.AsyncRequestManager)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewAsyncRequestManager indicates an expected call of NewAsyncRequestManager.
+func (mr *MockFactoryMockRecorder) NewAsyncRequestManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAsyncRequestManager", reflect.TypeOf((*MockFactory)(nil).NewAsyncRequestManager))
+}
+
 // NewConfigStoreManager mocks base method.
 func (m *MockFactory) NewConfigStoreManager() (persistence.ConfigStoreManager, error) {
 	m.ctrl.T.Helper()
@@ -129,6 +144,36 @@ func (mr *MockFactoryMockRecorder) NewHistoryManager() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewHistoryManager", reflect.TypeOf((*MockFactory)(nil).NewHistoryManager))
 }
 
+// NewMapQManager mocks base method.
+func (m *MockFactory) NewMapQManager() (persistence.MapQManager, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMapQManager")
+	ret0, _ := ret[0].(persistence.MapQManager)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewMapQManager indicates an expected call of NewMapQManager.
+func (mr *MockFactoryMockRecorder) NewMapQManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMapQManager", reflect.TypeOf((*MockFactory)(nil).NewMapQManager))
+}
+
+// NewShardDistributorManager mocks base method.
+func (m *MockFactory) NewShardDistributorManager() (persistence.ShardDistributorManager, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewShardDistributorManager")
+	ret0, _ := ret[0].(persistence.ShardDistributorManager)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewShardDistributorManager indicates an expected call of NewShardDistributorManager.
+func (mr *MockFactoryMockRecorder) NewShardDistributorManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewShardDistributorManager", reflect.TypeOf((*MockFactory)(nil).NewShardDistributorManager))
+}
+
 // NewShardManager mocks base method.
 func (m *MockFactory) NewShardManager() (persistence.ShardManager, error) {
 	m.ctrl.T.Helper()
@@ -174,6 +219,21 @@ func (mr *MockFactoryMockRecorder) NewVisibilityManager(params, serviceConfig an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewVisibilityManager", reflect.TypeOf((*MockFactory)(nil).NewVisibilityManager), params, serviceConfig)
 }
 
+// NewVisibilityQueueManager mocks base method.
+func (m *MockFactory) NewVisibilityQueueManager() (persistence.QueueManager, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewVisibilityQueueManager")
+	ret0, _ := ret[0].(persistence.QueueManager)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewVisibilityQueueManager indicates an expected call of NewVisibilityQueueManager.
+func (mr *MockFactoryMockRecorder) NewVisibilityQueueManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewVisibilityQueueManager", reflect.TypeOf((*MockFactory)(nil).NewVisibilityQueueManager))
+}
+
 // MockDataStoreFactory is a mock of DataStoreFactory interface.
 type MockDataStoreFactory struct {
 	ctrl     *gomock.Controller
@@ -210,6 +270,21 @@ func (mr *MockDataStoreFactoryMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockDataStoreFactory)(nil).Close))
 }
 
+// NewAsyncRequestStore mocks base method.
+func (m *MockDataStoreFactory) NewAsyncRequestStore() (persistence.AsyncRequestStore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAsyncRequestStore")
+	ret0, _ := ret[0].(persistence.AsyncRequestStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewAsyncRequestStore indicates an expected call of NewAsyncRequestStore.
+func (mr *MockDataStoreFactoryMockRecorder) NewAsyncRequestStore() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAsyncRequestStore", reflect.TypeOf((*MockDataStoreFactory)(nil).NewAsyncRequestStore))
+}
+
 // NewConfigStore mocks base method.
 func (m *MockDataStoreFactory) NewConfigStore() (persistence.ConfigStore, error) {
 	m.ctrl.T.Helper()
@@ -270,6 +345,21 @@ func (mr *MockDataStoreFactoryMockRecorder) NewHistoryStore() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewHistoryStore", reflect.TypeOf((*MockDataStoreFactory)(nil).NewHistoryStore))
 }
 
+// NewMapQStore mocks base method.
+func (m *MockDataStoreFactory) NewMapQStore() (persistence.MapQStore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMapQStore")
+	ret0, _ := ret[0].(persistence.MapQStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewMapQStore indicates an expected call of NewMapQStore.
+func (mr *MockDataStoreFactoryMockRecorder) NewMapQStore() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMapQStore", reflect.TypeOf((*MockDataStoreFactory)(nil).NewMapQStore))
+}
+
 // NewQueue mocks base method.
 func (m *MockDataStoreFactory) NewQueue(queueType persistence.QueueType) (persistence.Queue, error) {
 	m.ctrl.T.Helper()
@@ -285,6 +375,21 @@ func (mr *MockDataStoreFactoryMockRecorder) NewQueue(queueType any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewQueue", reflect.TypeOf((*MockDataStoreFactory)(nil).NewQueue), queueType)
 }
 
+// NewShardDistributorStore mocks base method.
+func (m *MockDataStoreFactory) NewShardDistributorStore() (persistence.ShardDistributorStore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewShardDistributorStore")
+	ret0, _ := ret[0].(persistence.ShardDistributorStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewShardDistributorStore indicates an expected call of NewShardDistributorStore.
+func (mr *MockDataStoreFactoryMockRecorder) NewShardDistributorStore() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewShardDistributorStore", reflect.TypeOf((*MockDataStoreFactory)(nil).NewShardDistributorStore))
+}
+
 // NewShardStore mocks base method.
 func (m *MockDataStoreFactory) NewShardStore() (persistence.ShardStore, error) {
 	m.ctrl.T.Helper()
@@ -131,6 +131,13 @@ func TestFactoryMethods(t *testing.T) {
 		ds.EXPECT().NewQueue(persistence.DomainReplicationQueueType).Return(nil, nil).MinTimes(1)
 		check(t, fact.NewDomainReplicationQueueManager)
 	})
+	t.Run("NewVisibilityQueueManager", func(t *testing.T) {
+		fact := makeFactory(t)
+		ds := mockDatastore(t, fact, storeTypeQueue)
+
+		ds.EXPECT().NewQueue(persistence.VisibilityQueueType).Return(nil, nil).MinTimes(1)
+		check(t, fact.NewVisibilityQueueManager)
+	})
 	t.Run("NewConfigStoreManager", func(t *testing.T) {
 		fact := makeFactory(t)
 		ds := mockDatastore(t, fact, storeTypeConfigStore)
@@ -138,6 +145,27 @@ func TestFactoryMethods(t *testing.T) {
 		ds.EXPECT().NewConfigStore().Return(nil, nil).MinTimes(1)
 		check(t, fact.NewConfigStoreManager)
 	})
+	t.Run("NewAsyncRequestManager", func(t *testing.T) {
+		fact := makeFactory(t)
+		ds := mockDatastore(t, fact, storeTypeAsyncRequest)
+
+		ds.EXPECT().NewAsyncRequestStore().Return(nil, nil).MinTimes(1)
+		check(t, fact.NewAsyncRequestManager)
+	})
+	t.Run("NewMapQManager", func(t *testing.T) {
+		fact := makeFactory(t)
+		ds := mockDatastore(t, fact, storeTypeMapQ)
+
+		ds.EXPECT().NewMapQStore().Return(nil, nil).MinTimes(1)
+		check(t, fact.NewMapQManager)
+	})
+	t.Run("NewShardDistributorManager", func(t *testing.T) {
+		fact := makeFactory(t)
+		ds := mockDatastore(t, fact, storeTypeShardDistributor)
+
+		ds.EXPECT().NewShardDistributorStore().Return(nil, nil).MinTimes(1)
+		check(t, fact.NewShardDistributorManager)
+	})
 	t.Run("NewVisibilityManager_TripleVisibilityManager_Pinot", func(t *testing.T) {
 		fact := makeFactory(t)
 		ds := mockDatastore(t, fact, storeTypeVisibility)
@@ -20,7 +20,10 @@
 // THE SOFTWARE.
 
 // Geneate rate limiter wrappers.
-//go:generate mockgen -package $GOPACKAGE -destination data_manager_interfaces_mock.go github.com/uber/cadence/common/persistence Task,ShardManager,ExecutionManager,ExecutionManagerFactory,TaskManager,HistoryManager,DomainManager,QueueManager,ConfigStoreManager
+//go:generate mockgen -package $GOPACKAGE -destination data_manager_interfaces_mock.go github.com/uber/cadence/common/persistence Task,ShardManager,ExecutionManager,ExecutionManagerFactory,TaskManager,HistoryManager,DomainManager,QueueManager,ConfigStoreManager,AsyncRequestManager,MapQManager,ShardDistributorManager
+//go:generate gowrap gen -g -p . -i AsyncRequestManager -t ./wrappers/templates/ratelimited.tmpl -o wrappers/ratelimited/asyncrequest_generated.go
+//go:generate gowrap gen -g -p . -i MapQManager -t ./wrappers/templates/ratelimited.tmpl -o wrappers/ratelimited/mapq_generated.go
+//go:generate gowrap gen -g -p . -i ShardDistributorManager -t ./wrappers/templates/ratelimited.tmpl -o wrappers/ratelimited/sharddistributor_generated.go
 //go:generate gowrap gen -g -p . -i ConfigStoreManager -t ./wrappers/templates/ratelimited.tmpl -o wrappers/ratelimited/configstore_generated.go
 //go:generate gowrap gen -g -p . -i DomainManager -t ./wrappers/templates/ratelimited.tmpl -o wrappers/ratelimited/domain_generated.go
 //go:generate gowrap gen -g -p . -i HistoryManager -t ./wrappers/templates/ratelimited.tmpl -o wrappers/ratelimited/history_generated.go
@@ -30,6 +33,9 @@
 //go:generate gowrap gen -g -p . -i ShardManager -t ./wrappers/templates/ratelimited.tmpl -o wrappers/ratelimited/shard_generated.go
 
 // Geneate error injector wrappers.
+//go:generate gowrap gen -g -p . -i AsyncRequestManager -t ./wrappers/templates/errorinjector.tmpl -o wrappers/errorinjectors/asyncrequest_generated.go
+//go:generate gowrap gen -g -p . -i MapQManager -t ./wrappers/templates/errorinjector.tmpl -o wrappers/errorinjectors/mapq_generated.go
+//go:generate gowrap gen -g -p . -i ShardDistributorManager -t ./wrappers/templates/errorinjector.tmpl -o wrappers/errorinjectors/sharddistributor_generated.go
 //go:generate gowrap gen -g -p . -i ConfigStoreManager -t ./wrappers/templates/errorinjector.tmpl -o wrappers/errorinjectors/configstore_generated.go
 //go:generate gowrap gen -g -p . -i ShardManager -t ./wrappers/templates/errorinjector.tmpl -o wrappers/errorinjectors/shard_generated.go
 //go:generate gowrap gen -g -p . -i ExecutionManager -t ./wrappers/templates/errorinjector.tmpl -o wrappers/errorinjectors/execution_generated.go
@@ -39,6 +45,9 @@
 //go:generate gowrap gen -g -p . -i QueueManager -t ./wrappers/templates/errorinjector.tmpl -o wrappers/errorinjectors/queue_generated.go
 
 // Generate metered wrappers.
+//go:generate gowrap gen -g -p . -i AsyncRequestManager -t ./wrappers/templates/metered.tmpl -o wrappers/metered/asyncrequest_generated.go
+//go:generate gowrap gen -g -p . -i MapQManager -t ./wrappers/templates/metered.tmpl -o wrappers/metered/mapq_generated.go
+//go:generate gowrap gen -g -p . -i ShardDistributorManager -t ./wrappers/templates/metered.tmpl -o wrappers/metered/sharddistributor_generated.go
 //go:generate gowrap gen -g -p . -i ConfigStoreManager -t ./wrappers/templates/metered.tmpl -o wrappers/metered/configstore_generated.go
 //go:generate gowrap gen -g -p . -i ShardManager -t ./wrappers/templates/metered.tmpl -o wrappers/metered/shard_generated.go
 //go:generate gowrap gen -g -p . -i TaskManager -t ./wrappers/templates/metered.tmpl -o wrappers/metered/task_generated.go
@@ -94,6 +103,7 @@ type QueueType int
 // Negative numbers are reserved for DLQ
 const (
 	DomainReplicationQueueType QueueType = iota + 1
+	VisibilityQueueType
 )
 
 // Create Workflow Execution Mode
@@ -239,6 +249,7 @@ const (
 	TransferTaskTypeRecordWorkflowClosed
 	TransferTaskTypeRecordChildExecutionCompleted
 	TransferTaskTypeApplyParentClosePolicy // Deprecated: this is related to cross-cluster tasks
+	TransferTaskTypeCompletionCallback
 )
 
 // Deprecated: Types of cross-cluster tasks. These are deprecated as of
@@ -395,6 +406,9 @@ type (
 		CancelRequestID                    string
 		StickyTaskList                     string
 		StickyScheduleToStartTimeout       int32
+		StickyWorkerIdentity               string
+		StickyHitCount                     int64
+		StickyFallbackCount                int64
 		ClientLibraryVersion               string
 		ClientFeatureVersion               string
 		ClientImpl                         string
@@ -419,6 +433,12 @@ type (
 		ExpirationSeconds int32 // TODO: is this field useful?
 
 		ActiveClusterSelectionPolicy *types.ActiveClusterSelectionPolicy
+		// CompletionCallbacks are notified once the workflow execution closes. They are kept
+		// in mutable state rather than history as their headers may carry credentials.
+		CompletionCallbacks []*types.CompletionCallback
+		// CompletionCallbackStates is the delivery state of each of the CompletionCallbacks,
+		// recorded by the system workflow delivering them after the workflow execution closes.
+		CompletionCallbackStates []*types.CompletionCallbackInfo
 	}
 
 	// ExecutionStats is the statistics about workflow execution
@@ -522,6 +542,8 @@ type (
 		Expiry                  time.Time
 		LastUpdated             time.Time
 		AdaptivePartitionConfig *TaskListPartitionConfig
+		VersioningConfig        *TaskListVersioningConfig
+		MigrationConfig         *TaskListMigrationConfig
 	}
 
 	TaskListPartition struct {
@@ -535,6 +557,19 @@ type (
 		WritePartitions map[int]*TaskListPartition
 	}
 
+	// TaskListVersioningConfig represents the worker build ID versioning configuration of a task list.
+	// Each compatible set is ordered from oldest to newest build ID and the last set is the default one.
+	TaskListVersioningConfig struct {
+		Version        int64
+		CompatibleSets [][]string
+	}
+
+	// TaskListMigrationConfig represents the migration of the backlog of a task list to another task list.
+	TaskListMigrationConfig struct {
+		TargetTaskList string
+		Alias          bool
+	}
+
 	// TaskInfo describes either activity or decision task
 	TaskInfo struct {
 		DomainID                      string
@@ -1506,6 +1541,277 @@ type (
 		Values  *types.DynamicConfigBlob
 	}
 
+	// AsyncRequestInfo describes the processing status of an async workflow request
+	AsyncRequestInfo struct {
+		DomainID        string
+		RequestID       string
+		WorkflowID      string
+		RunID           string
+		State           types.AsyncRequestState
+		Failure         string
+		CreatedTime     time.Time
+		LastUpdatedTime time.Time
+		// ExpiryTime is the time after which the status record can be removed
+		ExpiryTime time.Time
+	}
+
+	// AsyncRequestDLQEntry is an async workflow request that failed processing
+	AsyncRequestDLQEntry struct {
+		DomainID    string
+		RequestID   string
+		RequestType int32
+		WorkflowID  string
+		Failure     string
+		// Payload is the encoded async request message as it was read from the queue
+		Payload     []byte
+		CreatedTime time.Time
+	}
+
+	// UpsertAsyncRequestRequest is used to create or update the status of an async request
+	UpsertAsyncRequestRequest struct {
+		Request *AsyncRequestInfo
+	}
+
+	// GetAsyncRequestRequest is used to read the status of an async request
+	GetAsyncRequestRequest struct {
+		DomainID  string
+		RequestID string
+	}
+
+	// GetAsyncRequestResponse is the response to GetAsyncRequest
+	GetAsyncRequestResponse struct {
+		Request *AsyncRequestInfo
+	}
+
+	// EnqueueAsyncRequestToDLQRequest is used to put a failed async request into the DLQ of its domain
+	EnqueueAsyncRequestToDLQRequest struct {
+		Entry *AsyncRequestDLQEntry
+	}
+
+	// ReadAsyncRequestDLQRequest is used to page through the DLQ of a domain
+	ReadAsyncRequestDLQRequest struct {
+		DomainID      string
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	// ReadAsyncRequestDLQResponse is the response to ReadAsyncRequestDLQ
+	ReadAsyncRequestDLQResponse struct {
+		Entries       []*AsyncRequestDLQEntry
+		NextPageToken []byte
+	}
+
+	// GetAsyncRequestDLQEntryRequest is used to read a single DLQ entry
+	GetAsyncRequestDLQEntryRequest struct {
+		DomainID  string
+		RequestID string
+	}
+
+	// GetAsyncRequestDLQEntryResponse is the response to GetAsyncRequestDLQEntry
+	GetAsyncRequestDLQEntryResponse struct {
+		Entry *AsyncRequestDLQEntry
+	}
+
+	// DeleteAsyncRequestDLQEntryRequest is used to remove a single DLQ entry
+	DeleteAsyncRequestDLQEntryRequest struct {
+		DomainID  string
+		RequestID string
+	}
+
+	// AsyncRequestQueueMessage is a message of a persistence backed async workflow queue
+	AsyncRequestQueueMessage struct {
+		MessageID   int64
+		Payload     []byte
+		CreatedTime time.Time
+	}
+
+	// AsyncRequestQueueMetadata holds the consumer state of a persistence backed async workflow queue
+	AsyncRequestQueueMetadata struct {
+		QueueName string
+		// AckLevel is the ID of the last message that was processed
+		AckLevel int64
+		// LeaseOwner is the identity of the worker host consuming the queue until LeaseExpiry
+		LeaseOwner  string
+		LeaseExpiry time.Time
+		Version     int64
+	}
+
+	// EnqueueAsyncRequestQueueMessageRequest is used to append a message to a queue
+	EnqueueAsyncRequestQueueMessageRequest struct {
+		QueueName string
+		Payload   []byte
+	}
+
+	// EnqueueAsyncRequestQueueMessageResponse is the response to EnqueueAsyncRequestQueueMessage
+	EnqueueAsyncRequestQueueMessageResponse struct {
+		MessageID int64
+	}
+
+	// ReadAsyncRequestQueueMessagesRequest is used to read the messages of a queue in order
+	ReadAsyncRequestQueueMessagesRequest struct {
+		QueueName               string
+		ExclusiveBeginMessageID int64
+		PageSize                int
+	}
+
+	// ReadAsyncRequestQueueMessagesResponse is the response to ReadAsyncRequestQueueMessages
+	ReadAsyncRequestQueueMessagesResponse struct {
+		Messages []*AsyncRequestQueueMessage
+	}
+
+	// DeleteAsyncRequestQueueMessagesRequest is used to remove the messages of a queue up to and including InclusiveEndMessageID
+	DeleteAsyncRequestQueueMessagesRequest struct {
+		QueueName             string
+		InclusiveEndMessageID int64
+	}
+
+	// GetAsyncRequestQueueMetadataRequest is used to read the consumer state of a queue
+	GetAsyncRequestQueueMetadataRequest struct {
+		QueueName string
+	}
+
+	// GetAsyncRequestQueueMetadataResponse is the response to GetAsyncRequestQueueMetadata
+	GetAsyncRequestQueueMetadataResponse struct {
+		Metadata *AsyncRequestQueueMetadata
+	}
+
+	// UpdateAsyncRequestQueueMetadataRequest is used to write the consumer state of a queue.
+	// The metadata is created if PreviousVersion is 0, otherwise it is only updated if the stored version matches.
+	UpdateAsyncRequestQueueMetadataRequest struct {
+		Metadata        *AsyncRequestQueueMetadata
+		PreviousVersion int64
+	}
+
+	// MapQItem is an item stored in a partition of a MAPQ queue
+	MapQItem struct {
+		// Partition is the path of the leaf node of the queue tree the item belongs to
+		Partition string
+		Offset    int64
+		Payload   []byte
+	}
+
+	// MapQState holds the committed offsets and the partitioning of a MAPQ queue
+	MapQState struct {
+		QueueID string
+		State   *DataBlob
+		Version int64
+	}
+
+	// EnqueueMapQItemsRequest is used to write items to the partitions of a MAPQ queue.
+	// Writing an item with an existing offset overwrites it.
+	EnqueueMapQItemsRequest struct {
+		QueueID string
+		Items   []*MapQItem
+	}
+
+	// ReadMapQItemsRequest is used to read the items of a partition in offset order
+	ReadMapQItemsRequest struct {
+		QueueID            string
+		Partition          string
+		ExclusiveMinOffset int64
+		InclusiveMaxOffset int64
+		PageSize           int
+	}
+
+	// ReadMapQItemsResponse is the response to ReadMapQItems
+	ReadMapQItemsResponse struct {
+		Items []*MapQItem
+	}
+
+	// DeleteMapQItemsRequest is used to remove the items of a partition up to and including InclusiveMaxOffset
+	DeleteMapQItemsRequest struct {
+		QueueID            string
+		Partition          string
+		InclusiveMaxOffset int64
+	}
+
+	// GetMapQStateRequest is used to read the state of a MAPQ queue
+	GetMapQStateRequest struct {
+		QueueID string
+	}
+
+	// GetMapQStateResponse is the response to GetMapQState
+	GetMapQStateResponse struct {
+		State *MapQState
+	}
+
+	// UpdateMapQStateRequest is used to write the state of a MAPQ queue.
+	// The state is created if PreviousVersion is 0, otherwise it is only updated if the stored version matches.
+	UpdateMapQStateRequest struct {
+		State           *MapQState
+		PreviousVersion int64
+	}
+
+	// ShardDistributorLeader is the leader of a shard distributor namespace
+	ShardDistributorLeader struct {
+		LeaderID string
+		// LeaseExpiry is when the leadership ends unless the leader renews it
+		LeaseExpiry time.Time
+		// Version is the fencing token of the leadership, a new leader must increment it
+		Version int64
+	}
+
+	// ShardDistributorExecutor is an executor of a shard distributor namespace.
+	// The heartbeat, state and reported shards are written by the executor, the assigned shards by the leader.
+	ShardDistributorExecutor struct {
+		ExecutorID     string
+		LastHeartbeat  time.Time
+		State          string
+		ReportedShards *DataBlob
+		AssignedShards *DataBlob
+		// AssignedRevision is the namespace revision the assigned shards were written at
+		AssignedRevision int64
+	}
+
+	// GetShardDistributorStateRequest is used to read the leader and executors of a shard distributor namespace
+	GetShardDistributorStateRequest struct {
+		Namespace string
+	}
+
+	// GetShardDistributorStateResponse is the response to GetShardDistributorState
+	GetShardDistributorStateResponse struct {
+		// Leader is nil if the namespace never had a leader
+		Leader    *ShardDistributorLeader
+		Executors map[string]*ShardDistributorExecutor
+		// Revision is incremented on every change of the executors of the namespace
+		Revision int64
+	}
+
+	// UpdateShardDistributorLeaderRequest is used to acquire, renew or release the leadership of a namespace.
+	// The leader is only updated if the stored leader version matches PreviousVersion, 0 if there was no leader.
+	UpdateShardDistributorLeaderRequest struct {
+		Namespace       string
+		Leader          *ShardDistributorLeader
+		PreviousVersion int64
+	}
+
+	// UpsertShardDistributorExecutorRequest is used by an executor to write its heartbeat, state and reported shards.
+	// It is only applied if the namespace revision matches PreviousRevision.
+	UpsertShardDistributorExecutorRequest struct {
+		Namespace        string
+		Executor         *ShardDistributorExecutor
+		PreviousRevision int64
+	}
+
+	// AssignShardDistributorShardsRequest is used by the leader to write the assigned shards of executors.
+	// It is only applied if the namespace revision matches PreviousRevision and the leader version matches LeaderVersion.
+	AssignShardDistributorShardsRequest struct {
+		Namespace string
+		// AssignedShards is keyed by executor ID
+		AssignedShards   map[string]*DataBlob
+		LeaderVersion    int64
+		PreviousRevision int64
+	}
+
+	// DeleteShardDistributorExecutorsRequest is used by the leader to remove executors.
+	// It is only applied if the namespace revision matches PreviousRevision and the leader version matches LeaderVersion.
+	DeleteShardDistributorExecutorsRequest struct {
+		Namespace        string
+		ExecutorIDs      []string
+		LeaderVersion    int64
+		PreviousRevision int64
+	}
+
 	// Closeable is an interface for any entity that supports a close operation to release resources
 	Closeable interface {
 		Close()
@@ -1652,6 +1958,43 @@ type (
 		UpdateDynamicConfig(ctx context.Context, request *UpdateDynamicConfigRequest, cfgType ConfigType) error
 		// can add functions for config types other than dynamic config
 	}
+
+	// AsyncRequestManager is used to track async workflow requests and the requests that failed processing.
+	// It also stores the messages of persistence backed async workflow queues.
+	AsyncRequestManager interface {
+		Closeable
+		UpsertAsyncRequest(ctx context.Context, request *UpsertAsyncRequestRequest) error
+		GetAsyncRequest(ctx context.Context, request *GetAsyncRequestRequest) (*GetAsyncRequestResponse, error)
+		EnqueueAsyncRequestToDLQ(ctx context.Context, request *EnqueueAsyncRequestToDLQRequest) error
+		ReadAsyncRequestDLQ(ctx context.Context, request *ReadAsyncRequestDLQRequest) (*ReadAsyncRequestDLQResponse, error)
+		GetAsyncRequestDLQEntry(ctx context.Context, request *GetAsyncRequestDLQEntryRequest) (*GetAsyncRequestDLQEntryResponse, error)
+		DeleteAsyncRequestDLQEntry(ctx context.Context, request *DeleteAsyncRequestDLQEntryRequest) error
+		EnqueueAsyncRequestQueueMessage(ctx context.Context, request *EnqueueAsyncRequestQueueMessageRequest) (*EnqueueAsyncRequestQueueMessageResponse, error)
+		ReadAsyncRequestQueueMessages(ctx context.Context, request *ReadAsyncRequestQueueMessagesRequest) (*ReadAsyncRequestQueueMessagesResponse, error)
+		DeleteAsyncRequestQueueMessages(ctx context.Context, request *DeleteAsyncRequestQueueMessagesRequest) error
+		GetAsyncRequestQueueMetadata(ctx context.Context, request *GetAsyncRequestQueueMetadataRequest) (*GetAsyncRequestQueueMetadataResponse, error)
+		UpdateAsyncRequestQueueMetadata(ctx context.Context, request *UpdateAsyncRequestQueueMetadataRequest) error
+	}
+
+	// MapQManager is used to store the items and the state of MAPQ queues
+	MapQManager interface {
+		Closeable
+		EnqueueMapQItems(ctx context.Context, request *EnqueueMapQItemsRequest) error
+		ReadMapQItems(ctx context.Context, request *ReadMapQItemsRequest) (*ReadMapQItemsResponse, error)
+		DeleteMapQItems(ctx context.Context, request *DeleteMapQItemsRequest) error
+		GetMapQState(ctx context.Context, request *GetMapQStateRequest) (*GetMapQStateResponse, error)
+		UpdateMapQState(ctx context.Context, request *UpdateMapQStateRequest) error
+	}
+
+	// ShardDistributorManager is used to store the leader and executors of shard distributor namespaces
+	ShardDistributorManager interface {
+		Closeable
+		GetShardDistributorState(ctx context.Context, request *GetShardDistributorStateRequest) (*GetShardDistributorStateResponse, error)
+		UpdateShardDistributorLeader(ctx context.Context, request *UpdateShardDistributorLeaderRequest) error
+		UpsertShardDistributorExecutor(ctx context.Context, request *UpsertShardDistributorExecutorRequest) error
+		AssignShardDistributorShards(ctx context.Context, request *AssignShardDistributorShardsRequest) error
+		DeleteShardDistributorExecutors(ctx context.Context, request *DeleteShardDistributorExecutorsRequest) error
+	}
 )
 
 // IsTimeoutError check whether error is TimeoutError
@@ -1753,6 +2096,11 @@ func (t *TransferTaskInfo) ToTask() (Task, error) {
 			WorkflowIdentifier: workflowIdentifier,
 			TaskData:           taskData,
 		}, nil
+	case TransferTaskTypeCompletionCallback:
+		return &CompletionCallbackTask{
+			WorkflowIdentifier: workflowIdentifier,
+			TaskData:           taskData,
+		}, nil
 	case TransferTaskTypeRecordChildExecutionCompleted:
 		return &RecordChildExecutionCompletedTask{
 			WorkflowIdentifier: workflowIdentifier,
@@ -2285,6 +2633,33 @@ func (p *TaskListPartition) ToInternalType() *types.TaskListPartition {
 	return &types.TaskListPartition{IsolationGroups: p.IsolationGroups}
 }
 
+func (p *TaskListVersioningConfig) ToInternalType() *types.TaskListVersioningConfig {
+	if p == nil {
+		return nil
+	}
+	var compatibleSets []*types.CompatibleBuildIDSet
+	if p.CompatibleSets != nil {
+		compatibleSets = make([]*types.CompatibleBuildIDSet, len(p.CompatibleSets))
+		for i, buildIDs := range p.CompatibleSets {
+			compatibleSets[i] = &types.CompatibleBuildIDSet{BuildIDs: buildIDs}
+		}
+	}
+	return &types.TaskListVersioningConfig{
+		Version:        p.Version,
+		CompatibleSets: compatibleSets,
+	}
+}
+
+func (p *TaskListMigrationConfig) ToInternalType() *types.TaskListMigrationConfig {
+	if p == nil {
+		return nil
+	}
+	return &types.TaskListMigrationConfig{
+		TargetTaskList: p.TargetTaskList,
+		Alias:          p.Alias,
+	}
+}
+
 // TODO(active-active): Update unit tests of all components that use this function to cover active-active case
 func (d *DomainReplicationConfig) IsActiveActive() bool {
 	return d != nil && d.ActiveClusters != nil && len(d.ActiveClusters.ActiveClustersByRegion) > 0
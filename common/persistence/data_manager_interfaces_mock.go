@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/uber/cadence/common/persistence (interfaces: Task,ShardManager,ExecutionManager,ExecutionManagerFactory,TaskManager,HistoryManager,DomainManager,QueueManager,ConfigStoreManager)
+// Source: github.com/uber/cadence/common/persistence (interfaces: Task,ShardManager,ExecutionManager,ExecutionManagerFactory,TaskManager,HistoryManager,DomainManager,QueueManager,ConfigStoreManager,AsyncRequestManager,MapQManager,ShardDistributorManager)
 //
 // Generated by this command:
 //
-//	mockgen -package persistence -destination data_manager_interfaces_mock.go github.com/uber/cadence/common/persistence Task,ShardManager,ExecutionManager,ExecutionManagerFactory,TaskManager,HistoryManager,DomainManager,QueueManager,ConfigStoreManager
+//	mockgen -package persistence -destination data_manager_interfaces_mock.go github.com/uber/cadence/common/persistence Task,ShardManager,ExecutionManager,ExecutionManagerFactory,TaskManager,HistoryManager,DomainManager,QueueManager,ConfigStoreManager,AsyncRequestManager,MapQManager,ShardDistributorManager
 //
 
 // Package persistence is a generated GoMock package.
@@ -1588,3 +1588,414 @@ func (mr *MockConfigStoreManagerMockRecorder) UpdateDynamicConfig(ctx, request,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDynamicConfig", reflect.TypeOf((*MockConfigStoreManager)(nil).UpdateDynamicConfig), ctx, request, cfgType)
 }
+
+// MockAsyncRequestManager is a mock of AsyncRequestManager interface.
+type MockAsyncRequestManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockAsyncRequestManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockAsyncRequestManagerMockRecorder is the mock recorder for MockAsyncRequestManager.
+type MockAsyncRequestManagerMockRecorder struct {
+	mock *MockAsyncRequestManager
+}
+
+// NewMockAsyncRequestManager creates a new mock instance.
+func NewMockAsyncRequestManager(ctrl *gomock.Controller) *MockAsyncRequestManager {
+	mock := &MockAsyncRequestManager{ctrl: ctrl}
+	mock.recorder = &MockAsyncRequestManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAsyncRequestManager) EXPECT() *MockAsyncRequestManagerMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockAsyncRequestManager) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockAsyncRequestManagerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockAsyncRequestManager)(nil).Close))
+}
+
+// DeleteAsyncRequestDLQEntry mocks base method.
+func (m *MockAsyncRequestManager) DeleteAsyncRequestDLQEntry(ctx context.Context, request *DeleteAsyncRequestDLQEntryRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAsyncRequestDLQEntry", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAsyncRequestDLQEntry indicates an expected call of DeleteAsyncRequestDLQEntry.
+func (mr *MockAsyncRequestManagerMockRecorder) DeleteAsyncRequestDLQEntry(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAsyncRequestDLQEntry", reflect.TypeOf((*MockAsyncRequestManager)(nil).DeleteAsyncRequestDLQEntry), ctx, request)
+}
+
+// DeleteAsyncRequestQueueMessages mocks base method.
+func (m *MockAsyncRequestManager) DeleteAsyncRequestQueueMessages(ctx context.Context, request *DeleteAsyncRequestQueueMessagesRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAsyncRequestQueueMessages", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAsyncRequestQueueMessages indicates an expected call of DeleteAsyncRequestQueueMessages.
+func (mr *MockAsyncRequestManagerMockRecorder) DeleteAsyncRequestQueueMessages(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAsyncRequestQueueMessages", reflect.TypeOf((*MockAsyncRequestManager)(nil).DeleteAsyncRequestQueueMessages), ctx, request)
+}
+
+// EnqueueAsyncRequestQueueMessage mocks base method.
+func (m *MockAsyncRequestManager) EnqueueAsyncRequestQueueMessage(ctx context.Context, request *EnqueueAsyncRequestQueueMessageRequest) (*EnqueueAsyncRequestQueueMessageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueAsyncRequestQueueMessage", ctx, request)
+	ret0, _ := ret[0].(*EnqueueAsyncRequestQueueMessageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnqueueAsyncRequestQueueMessage indicates an expected call of EnqueueAsyncRequestQueueMessage.
+func (mr *MockAsyncRequestManagerMockRecorder) EnqueueAsyncRequestQueueMessage(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueAsyncRequestQueueMessage", reflect.TypeOf((*MockAsyncRequestManager)(nil).EnqueueAsyncRequestQueueMessage), ctx, request)
+}
+
+// EnqueueAsyncRequestToDLQ mocks base method.
+func (m *MockAsyncRequestManager) EnqueueAsyncRequestToDLQ(ctx context.Context, request *EnqueueAsyncRequestToDLQRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueAsyncRequestToDLQ", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueAsyncRequestToDLQ indicates an expected call of EnqueueAsyncRequestToDLQ.
+func (mr *MockAsyncRequestManagerMockRecorder) EnqueueAsyncRequestToDLQ(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueAsyncRequestToDLQ", reflect.TypeOf((*MockAsyncRequestManager)(nil).EnqueueAsyncRequestToDLQ), ctx, request)
+}
+
+// GetAsyncRequest mocks base method.
+func (m *MockAsyncRequestManager) GetAsyncRequest(ctx context.Context, request *GetAsyncRequestRequest) (*GetAsyncRequestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAsyncRequest", ctx, request)
+	ret0, _ := ret[0].(*GetAsyncRequestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAsyncRequest indicates an expected call of GetAsyncRequest.
+func (mr *MockAsyncRequestManagerMockRecorder) GetAsyncRequest(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAsyncRequest", reflect.TypeOf((*MockAsyncRequestManager)(nil).GetAsyncRequest), ctx, request)
+}
+
+// GetAsyncRequestDLQEntry mocks base method.
+func (m *MockAsyncRequestManager) GetAsyncRequestDLQEntry(ctx context.Context, request *GetAsyncRequestDLQEntryRequest) (*GetAsyncRequestDLQEntryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAsyncRequestDLQEntry", ctx, request)
+	ret0, _ := ret[0].(*GetAsyncRequestDLQEntryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAsyncRequestDLQEntry indicates an expected call of GetAsyncRequestDLQEntry.
+func (mr *MockAsyncRequestManagerMockRecorder) GetAsyncRequestDLQEntry(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAsyncRequestDLQEntry", reflect.TypeOf((*MockAsyncRequestManager)(nil).GetAsyncRequestDLQEntry), ctx, request)
+}
+
+// GetAsyncRequestQueueMetadata mocks base method.
+func (m *MockAsyncRequestManager) GetAsyncRequestQueueMetadata(ctx context.Context, request *GetAsyncRequestQueueMetadataRequest) (*GetAsyncRequestQueueMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAsyncRequestQueueMetadata", ctx, request)
+	ret0, _ := ret[0].(*GetAsyncRequestQueueMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAsyncRequestQueueMetadata indicates an expected call of GetAsyncRequestQueueMetadata.
+func (mr *MockAsyncRequestManagerMockRecorder) GetAsyncRequestQueueMetadata(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAsyncRequestQueueMetadata", reflect.TypeOf((*MockAsyncRequestManager)(nil).GetAsyncRequestQueueMetadata), ctx, request)
+}
+
+// ReadAsyncRequestDLQ mocks base method.
+func (m *MockAsyncRequestManager) ReadAsyncRequestDLQ(ctx context.Context, request *ReadAsyncRequestDLQRequest) (*ReadAsyncRequestDLQResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAsyncRequestDLQ", ctx, request)
+	ret0, _ := ret[0].(*ReadAsyncRequestDLQResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAsyncRequestDLQ indicates an expected call of ReadAsyncRequestDLQ.
+func (mr *MockAsyncRequestManagerMockRecorder) ReadAsyncRequestDLQ(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAsyncRequestDLQ", reflect.TypeOf((*MockAsyncRequestManager)(nil).ReadAsyncRequestDLQ), ctx, request)
+}
+
+// ReadAsyncRequestQueueMessages mocks base method.
+func (m *MockAsyncRequestManager) ReadAsyncRequestQueueMessages(ctx context.Context, request *ReadAsyncRequestQueueMessagesRequest) (*ReadAsyncRequestQueueMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAsyncRequestQueueMessages", ctx, request)
+	ret0, _ := ret[0].(*ReadAsyncRequestQueueMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAsyncRequestQueueMessages indicates an expected call of ReadAsyncRequestQueueMessages.
+func (mr *MockAsyncRequestManagerMockRecorder) ReadAsyncRequestQueueMessages(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAsyncRequestQueueMessages", reflect.TypeOf((*MockAsyncRequestManager)(nil).ReadAsyncRequestQueueMessages), ctx, request)
+}
+
+// UpdateAsyncRequestQueueMetadata mocks base method.
+func (m *MockAsyncRequestManager) UpdateAsyncRequestQueueMetadata(ctx context.Context, request *UpdateAsyncRequestQueueMetadataRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAsyncRequestQueueMetadata", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAsyncRequestQueueMetadata indicates an expected call of UpdateAsyncRequestQueueMetadata.
+func (mr *MockAsyncRequestManagerMockRecorder) UpdateAsyncRequestQueueMetadata(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAsyncRequestQueueMetadata", reflect.TypeOf((*MockAsyncRequestManager)(nil).UpdateAsyncRequestQueueMetadata), ctx, request)
+}
+
+// UpsertAsyncRequest mocks base method.
+func (m *MockAsyncRequestManager) UpsertAsyncRequest(ctx context.Context, request *UpsertAsyncRequestRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertAsyncRequest", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertAsyncRequest indicates an expected call of UpsertAsyncRequest.
+func (mr *MockAsyncRequestManagerMockRecorder) UpsertAsyncRequest(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAsyncRequest", reflect.TypeOf((*MockAsyncRequestManager)(nil).UpsertAsyncRequest), ctx, request)
+}
+
+// MockMapQManager is a mock of MapQManager interface.
+type MockMapQManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockMapQManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockMapQManagerMockRecorder is the mock recorder for MockMapQManager.
+type MockMapQManagerMockRecorder struct {
+	mock *MockMapQManager
+}
+
+// NewMockMapQManager creates a new mock instance.
+func NewMockMapQManager(ctrl *gomock.Controller) *MockMapQManager {
+	mock := &MockMapQManager{ctrl: ctrl}
+	mock.recorder = &MockMapQManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMapQManager) EXPECT() *MockMapQManagerMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockMapQManager) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockMapQManagerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockMapQManager)(nil).Close))
+}
+
+// DeleteMapQItems mocks base method.
+func (m *MockMapQManager) DeleteMapQItems(ctx context.Context, request *DeleteMapQItemsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMapQItems", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMapQItems indicates an expected call of DeleteMapQItems.
+func (mr *MockMapQManagerMockRecorder) DeleteMapQItems(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMapQItems", reflect.TypeOf((*MockMapQManager)(nil).DeleteMapQItems), ctx, request)
+}
+
+// EnqueueMapQItems mocks base method.
+func (m *MockMapQManager) EnqueueMapQItems(ctx context.Context, request *EnqueueMapQItemsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueMapQItems", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueMapQItems indicates an expected call of EnqueueMapQItems.
+func (mr *MockMapQManagerMockRecorder) EnqueueMapQItems(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueMapQItems", reflect.TypeOf((*MockMapQManager)(nil).EnqueueMapQItems), ctx, request)
+}
+
+// GetMapQState mocks base method.
+func (m *MockMapQManager) GetMapQState(ctx context.Context, request *GetMapQStateRequest) (*GetMapQStateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMapQState", ctx, request)
+	ret0, _ := ret[0].(*GetMapQStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMapQState indicates an expected call of GetMapQState.
+func (mr *MockMapQManagerMockRecorder) GetMapQState(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMapQState", reflect.TypeOf((*MockMapQManager)(nil).GetMapQState), ctx, request)
+}
+
+// ReadMapQItems mocks base method.
+func (m *MockMapQManager) ReadMapQItems(ctx context.Context, request *ReadMapQItemsRequest) (*ReadMapQItemsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadMapQItems", ctx, request)
+	ret0, _ := ret[0].(*ReadMapQItemsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadMapQItems indicates an expected call of ReadMapQItems.
+func (mr *MockMapQManagerMockRecorder) ReadMapQItems(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMapQItems", reflect.TypeOf((*MockMapQManager)(nil).ReadMapQItems), ctx, request)
+}
+
+// UpdateMapQState mocks base method.
+func (m *MockMapQManager) UpdateMapQState(ctx context.Context, request *UpdateMapQStateRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMapQState", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMapQState indicates an expected call of UpdateMapQState.
+func (mr *MockMapQManagerMockRecorder) UpdateMapQState(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMapQState", reflect.TypeOf((*MockMapQManager)(nil).UpdateMapQState), ctx, request)
+}
+
+// MockShardDistributorManager is a mock of ShardDistributorManager interface.
+type MockShardDistributorManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockShardDistributorManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockShardDistributorManagerMockRecorder is the mock recorder for MockShardDistributorManager.
+type MockShardDistributorManagerMockRecorder struct {
+	mock *MockShardDistributorManager
+}
+
+// NewMockShardDistributorManager creates a new mock instance.
+func NewMockShardDistributorManager(ctrl *gomock.Controller) *MockShardDistributorManager {
+	mock := &MockShardDistributorManager{ctrl: ctrl}
+	mock.recorder = &MockShardDistributorManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShardDistributorManager) EXPECT() *MockShardDistributorManagerMockRecorder {
+	return m.recorder
+}
+
+// AssignShardDistributorShards mocks base method.
+func (m *MockShardDistributorManager) AssignShardDistributorShards(ctx context.Context, request *AssignShardDistributorShardsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignShardDistributorShards", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignShardDistributorShards indicates an expected call of AssignShardDistributorShards.
+func (mr *MockShardDistributorManagerMockRecorder) AssignShardDistributorShards(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignShardDistributorShards", reflect.TypeOf((*MockShardDistributorManager)(nil).AssignShardDistributorShards), ctx, request)
+}
+
+// Close mocks base method.
+func (m *MockShardDistributorManager) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockShardDistributorManagerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockShardDistributorManager)(nil).Close))
+}
+
+// DeleteShardDistributorExecutors mocks base method.
+func (m *MockShardDistributorManager) DeleteShardDistributorExecutors(ctx context.Context, request *DeleteShardDistributorExecutorsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteShardDistributorExecutors", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteShardDistributorExecutors indicates an expected call of DeleteShardDistributorExecutors.
+func (mr *MockShardDistributorManagerMockRecorder) DeleteShardDistributorExecutors(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteShardDistributorExecutors", reflect.TypeOf((*MockShardDistributorManager)(nil).DeleteShardDistributorExecutors), ctx, request)
+}
+
+// GetShardDistributorState mocks base method.
+func (m *MockShardDistributorManager) GetShardDistributorState(ctx context.Context, request *GetShardDistributorStateRequest) (*GetShardDistributorStateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShardDistributorState", ctx, request)
+	ret0, _ := ret[0].(*GetShardDistributorStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShardDistributorState indicates an expected call of GetShardDistributorState.
+func (mr *MockShardDistributorManagerMockRecorder) GetShardDistributorState(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShardDistributorState", reflect.TypeOf((*MockShardDistributorManager)(nil).GetShardDistributorState), ctx, request)
+}
+
+// UpdateShardDistributorLeader mocks base method.
+func (m *MockShardDistributorManager) UpdateShardDistributorLeader(ctx context.Context, request *UpdateShardDistributorLeaderRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorLeader", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateShardDistributorLeader indicates an expected call of UpdateShardDistributorLeader.
+func (mr *MockShardDistributorManagerMockRecorder) UpdateShardDistributorLeader(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorLeader", reflect.TypeOf((*MockShardDistributorManager)(nil).UpdateShardDistributorLeader), ctx, request)
+}
+
+// UpsertShardDistributorExecutor mocks base method.
+func (m *MockShardDistributorManager) UpsertShardDistributorExecutor(ctx context.Context, request *UpsertShardDistributorExecutorRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertShardDistributorExecutor", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertShardDistributorExecutor indicates an expected call of UpsertShardDistributorExecutor.
+func (mr *MockShardDistributorManagerMockRecorder) UpsertShardDistributorExecutor(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertShardDistributorExecutor", reflect.TypeOf((*MockShardDistributorManager)(nil).UpsertShardDistributorExecutor), ctx, request)
+}
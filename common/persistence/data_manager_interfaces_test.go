@@ -583,6 +583,76 @@ func TestTaskListPartitionConfigToInternalType(t *testing.T) {
 	}
 }
 
+func TestTaskListVersioningConfigToInternalType(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  *TaskListVersioningConfig
+		expect *types.TaskListVersioningConfig
+	}{
+		{
+			name:   "nil case",
+			input:  nil,
+			expect: nil,
+		},
+		{
+			name:   "empty case",
+			input:  &TaskListVersioningConfig{},
+			expect: &types.TaskListVersioningConfig{},
+		},
+		{
+			name: "normal case",
+			input: &TaskListVersioningConfig{
+				Version:        3,
+				CompatibleSets: [][]string{{"1.0", "1.1"}, {"2.0"}},
+			},
+			expect: &types.TaskListVersioningConfig{
+				Version: 3,
+				CompatibleSets: []*types.CompatibleBuildIDSet{
+					{BuildIDs: []string{"1.0", "1.1"}},
+					{BuildIDs: []string{"2.0"}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, tc.input.ToInternalType())
+		})
+	}
+}
+
+func TestTaskListMigrationConfigToInternalType(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  *TaskListMigrationConfig
+		expect *types.TaskListMigrationConfig
+	}{
+		{
+			name:   "nil case",
+			input:  nil,
+			expect: nil,
+		},
+		{
+			name: "normal case",
+			input: &TaskListMigrationConfig{
+				TargetTaskList: "target",
+				Alias:          true,
+			},
+			expect: &types.TaskListMigrationConfig{
+				TargetTaskList: "target",
+				Alias:          true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, tc.input.ToInternalType())
+		})
+	}
+}
+
 func TestVersionHistoryCopy(t *testing.T) {
 	a := VersionHistories{
 		CurrentVersionHistoryIndex: 1,
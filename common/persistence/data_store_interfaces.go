@@ -32,7 +32,7 @@ import (
 	"github.com/uber/cadence/common/types"
 )
 
-//go:generate mockgen -package $GOPACKAGE -destination data_store_interfaces_mock.go -self_package github.com/uber/cadence/common/persistence github.com/uber/cadence/common/persistence ExecutionStore,ShardStore,DomainStore,TaskStore,HistoryStore,ConfigStore
+//go:generate mockgen -package $GOPACKAGE -destination data_store_interfaces_mock.go -self_package github.com/uber/cadence/common/persistence github.com/uber/cadence/common/persistence ExecutionStore,ShardStore,DomainStore,TaskStore,HistoryStore,ConfigStore,AsyncRequestStore,MapQStore,ShardDistributorStore
 //go:generate mockgen -package $GOPACKAGE -destination visibility_store_mock.go -self_package github.com/uber/cadence/common/persistence github.com/uber/cadence/common/persistence VisibilityStore
 
 type (
@@ -190,6 +190,56 @@ type (
 		Values    *DataBlob
 	}
 
+	// InternalInsertAsyncRequestQueueMessageRequest is used to write a message with an already assigned ID
+	InternalInsertAsyncRequestQueueMessageRequest struct {
+		QueueName string
+		Message   *AsyncRequestQueueMessage
+	}
+
+	// AsyncRequestStore is the lower persistence interface for AsyncRequestManager
+	AsyncRequestStore interface {
+		Closeable
+		UpsertAsyncRequest(ctx context.Context, request *UpsertAsyncRequestRequest) error
+		GetAsyncRequest(ctx context.Context, request *GetAsyncRequestRequest) (*GetAsyncRequestResponse, error)
+		EnqueueAsyncRequestToDLQ(ctx context.Context, request *EnqueueAsyncRequestToDLQRequest) error
+		ReadAsyncRequestDLQ(ctx context.Context, request *ReadAsyncRequestDLQRequest) (*ReadAsyncRequestDLQResponse, error)
+		GetAsyncRequestDLQEntry(ctx context.Context, request *GetAsyncRequestDLQEntryRequest) (*GetAsyncRequestDLQEntryResponse, error)
+		DeleteAsyncRequestDLQEntry(ctx context.Context, request *DeleteAsyncRequestDLQEntryRequest) error
+		// InsertAsyncRequestQueueMessage must return ConditionFailedError if the message ID is already taken
+		InsertAsyncRequestQueueMessage(ctx context.Context, request *InternalInsertAsyncRequestQueueMessageRequest) error
+		// GetLastAsyncRequestQueueMessageID returns -1 if the queue is empty
+		GetLastAsyncRequestQueueMessageID(ctx context.Context, queueName string) (int64, error)
+		ReadAsyncRequestQueueMessages(ctx context.Context, request *ReadAsyncRequestQueueMessagesRequest) (*ReadAsyncRequestQueueMessagesResponse, error)
+		DeleteAsyncRequestQueueMessages(ctx context.Context, request *DeleteAsyncRequestQueueMessagesRequest) error
+		GetAsyncRequestQueueMetadata(ctx context.Context, request *GetAsyncRequestQueueMetadataRequest) (*GetAsyncRequestQueueMetadataResponse, error)
+		// UpdateAsyncRequestQueueMetadata must return ConditionFailedError if the metadata was changed concurrently
+		UpdateAsyncRequestQueueMetadata(ctx context.Context, request *UpdateAsyncRequestQueueMetadataRequest) error
+	}
+
+	// MapQStore is the lower persistence interface for MapQManager
+	MapQStore interface {
+		Closeable
+		EnqueueMapQItems(ctx context.Context, request *EnqueueMapQItemsRequest) error
+		ReadMapQItems(ctx context.Context, request *ReadMapQItemsRequest) (*ReadMapQItemsResponse, error)
+		DeleteMapQItems(ctx context.Context, request *DeleteMapQItemsRequest) error
+		GetMapQState(ctx context.Context, request *GetMapQStateRequest) (*GetMapQStateResponse, error)
+		// UpdateMapQState must return ConditionFailedError if the state was changed concurrently
+		UpdateMapQState(ctx context.Context, request *UpdateMapQStateRequest) error
+	}
+
+	// ShardDistributorStore is the lower persistence interface for ShardDistributorManager
+	ShardDistributorStore interface {
+		Closeable
+		GetShardDistributorState(ctx context.Context, request *GetShardDistributorStateRequest) (*GetShardDistributorStateResponse, error)
+		// UpdateShardDistributorLeader must return ConditionFailedError if the leader version doesn't match
+		UpdateShardDistributorLeader(ctx context.Context, request *UpdateShardDistributorLeaderRequest) error
+		// UpsertShardDistributorExecutor, AssignShardDistributorShards and DeleteShardDistributorExecutors
+		// must increment the namespace revision and return ConditionFailedError if the conditions are not met
+		UpsertShardDistributorExecutor(ctx context.Context, request *UpsertShardDistributorExecutorRequest) error
+		AssignShardDistributorShards(ctx context.Context, request *AssignShardDistributorShardsRequest) error
+		DeleteShardDistributorExecutors(ctx context.Context, request *DeleteShardDistributorExecutorsRequest) error
+	}
+
 	// Queue is a store to enqueue and get messages
 	Queue interface {
 		Closeable
@@ -302,6 +352,9 @@ type (
 		CancelRequestID                    string
 		StickyTaskList                     string
 		StickyScheduleToStartTimeout       time.Duration
+		StickyWorkerIdentity               string
+		StickyHitCount                     int64
+		StickyFallbackCount                int64
 		ClientLibraryVersion               string
 		ClientFeatureVersion               string
 		ClientImpl                         string
@@ -324,6 +377,7 @@ type (
 		PartitionConfig    map[string]string
 
 		ActiveClusterSelectionPolicy *DataBlob
+		CompletionCallbacks          *DataBlob
 
 		// attributes which are not related to mutable state at all
 		HistorySize int64
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/uber/cadence/common/persistence (interfaces: ExecutionStore,ShardStore,DomainStore,TaskStore,HistoryStore,ConfigStore)
+// Source: github.com/uber/cadence/common/persistence (interfaces: ExecutionStore,ShardStore,DomainStore,TaskStore,HistoryStore,ConfigStore,AsyncRequestStore,MapQStore,ShardDistributorStore)
 //
 // Generated by this command:
 //
-//	mockgen -package persistence -destination data_store_interfaces_mock.go -self_package github.com/uber/cadence/common/persistence github.com/uber/cadence/common/persistence ExecutionStore,ShardStore,DomainStore,TaskStore,HistoryStore,ConfigStore
+//	mockgen -package persistence -destination data_store_interfaces_mock.go -self_package github.com/uber/cadence/common/persistence github.com/uber/cadence/common/persistence ExecutionStore,ShardStore,DomainStore,TaskStore,HistoryStore,ConfigStore,AsyncRequestStore,MapQStore,ShardDistributorStore
 //
 
 // Package persistence is a generated GoMock package.
@@ -1046,3 +1046,428 @@ func (mr *MockConfigStoreMockRecorder) UpdateConfig(ctx, value any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConfig", reflect.TypeOf((*MockConfigStore)(nil).UpdateConfig), ctx, value)
 }
+
+// MockAsyncRequestStore is a mock of AsyncRequestStore interface.
+type MockAsyncRequestStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockAsyncRequestStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockAsyncRequestStoreMockRecorder is the mock recorder for MockAsyncRequestStore.
+type MockAsyncRequestStoreMockRecorder struct {
+	mock *MockAsyncRequestStore
+}
+
+// NewMockAsyncRequestStore creates a new mock instance.
+func NewMockAsyncRequestStore(ctrl *gomock.Controller) *MockAsyncRequestStore {
+	mock := &MockAsyncRequestStore{ctrl: ctrl}
+	mock.recorder = &MockAsyncRequestStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAsyncRequestStore) EXPECT() *MockAsyncRequestStoreMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockAsyncRequestStore) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockAsyncRequestStoreMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockAsyncRequestStore)(nil).Close))
+}
+
+// DeleteAsyncRequestDLQEntry mocks base method.
+func (m *MockAsyncRequestStore) DeleteAsyncRequestDLQEntry(ctx context.Context, request *DeleteAsyncRequestDLQEntryRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAsyncRequestDLQEntry", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAsyncRequestDLQEntry indicates an expected call of DeleteAsyncRequestDLQEntry.
+func (mr *MockAsyncRequestStoreMockRecorder) DeleteAsyncRequestDLQEntry(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAsyncRequestDLQEntry", reflect.TypeOf((*MockAsyncRequestStore)(nil).DeleteAsyncRequestDLQEntry), ctx, request)
+}
+
+// DeleteAsyncRequestQueueMessages mocks base method.
+func (m *MockAsyncRequestStore) DeleteAsyncRequestQueueMessages(ctx context.Context, request *DeleteAsyncRequestQueueMessagesRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAsyncRequestQueueMessages", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAsyncRequestQueueMessages indicates an expected call of DeleteAsyncRequestQueueMessages.
+func (mr *MockAsyncRequestStoreMockRecorder) DeleteAsyncRequestQueueMessages(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAsyncRequestQueueMessages", reflect.TypeOf((*MockAsyncRequestStore)(nil).DeleteAsyncRequestQueueMessages), ctx, request)
+}
+
+// EnqueueAsyncRequestToDLQ mocks base method.
+func (m *MockAsyncRequestStore) EnqueueAsyncRequestToDLQ(ctx context.Context, request *EnqueueAsyncRequestToDLQRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueAsyncRequestToDLQ", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueAsyncRequestToDLQ indicates an expected call of EnqueueAsyncRequestToDLQ.
+func (mr *MockAsyncRequestStoreMockRecorder) EnqueueAsyncRequestToDLQ(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueAsyncRequestToDLQ", reflect.TypeOf((*MockAsyncRequestStore)(nil).EnqueueAsyncRequestToDLQ), ctx, request)
+}
+
+// GetAsyncRequest mocks base method.
+func (m *MockAsyncRequestStore) GetAsyncRequest(ctx context.Context, request *GetAsyncRequestRequest) (*GetAsyncRequestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAsyncRequest", ctx, request)
+	ret0, _ := ret[0].(*GetAsyncRequestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAsyncRequest indicates an expected call of GetAsyncRequest.
+func (mr *MockAsyncRequestStoreMockRecorder) GetAsyncRequest(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAsyncRequest", reflect.TypeOf((*MockAsyncRequestStore)(nil).GetAsyncRequest), ctx, request)
+}
+
+// GetAsyncRequestDLQEntry mocks base method.
+func (m *MockAsyncRequestStore) GetAsyncRequestDLQEntry(ctx context.Context, request *GetAsyncRequestDLQEntryRequest) (*GetAsyncRequestDLQEntryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAsyncRequestDLQEntry", ctx, request)
+	ret0, _ := ret[0].(*GetAsyncRequestDLQEntryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAsyncRequestDLQEntry indicates an expected call of GetAsyncRequestDLQEntry.
+func (mr *MockAsyncRequestStoreMockRecorder) GetAsyncRequestDLQEntry(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAsyncRequestDLQEntry", reflect.TypeOf((*MockAsyncRequestStore)(nil).GetAsyncRequestDLQEntry), ctx, request)
+}
+
+// GetAsyncRequestQueueMetadata mocks base method.
+func (m *MockAsyncRequestStore) GetAsyncRequestQueueMetadata(ctx context.Context, request *GetAsyncRequestQueueMetadataRequest) (*GetAsyncRequestQueueMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAsyncRequestQueueMetadata", ctx, request)
+	ret0, _ := ret[0].(*GetAsyncRequestQueueMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAsyncRequestQueueMetadata indicates an expected call of GetAsyncRequestQueueMetadata.
+func (mr *MockAsyncRequestStoreMockRecorder) GetAsyncRequestQueueMetadata(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAsyncRequestQueueMetadata", reflect.TypeOf((*MockAsyncRequestStore)(nil).GetAsyncRequestQueueMetadata), ctx, request)
+}
+
+// GetLastAsyncRequestQueueMessageID mocks base method.
+func (m *MockAsyncRequestStore) GetLastAsyncRequestQueueMessageID(ctx context.Context, queueName string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastAsyncRequestQueueMessageID", ctx, queueName)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastAsyncRequestQueueMessageID indicates an expected call of GetLastAsyncRequestQueueMessageID.
+func (mr *MockAsyncRequestStoreMockRecorder) GetLastAsyncRequestQueueMessageID(ctx, queueName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastAsyncRequestQueueMessageID", reflect.TypeOf((*MockAsyncRequestStore)(nil).GetLastAsyncRequestQueueMessageID), ctx, queueName)
+}
+
+// InsertAsyncRequestQueueMessage mocks base method.
+func (m *MockAsyncRequestStore) InsertAsyncRequestQueueMessage(ctx context.Context, request *InternalInsertAsyncRequestQueueMessageRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAsyncRequestQueueMessage", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAsyncRequestQueueMessage indicates an expected call of InsertAsyncRequestQueueMessage.
+func (mr *MockAsyncRequestStoreMockRecorder) InsertAsyncRequestQueueMessage(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAsyncRequestQueueMessage", reflect.TypeOf((*MockAsyncRequestStore)(nil).InsertAsyncRequestQueueMessage), ctx, request)
+}
+
+// ReadAsyncRequestDLQ mocks base method.
+func (m *MockAsyncRequestStore) ReadAsyncRequestDLQ(ctx context.Context, request *ReadAsyncRequestDLQRequest) (*ReadAsyncRequestDLQResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAsyncRequestDLQ", ctx, request)
+	ret0, _ := ret[0].(*ReadAsyncRequestDLQResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAsyncRequestDLQ indicates an expected call of ReadAsyncRequestDLQ.
+func (mr *MockAsyncRequestStoreMockRecorder) ReadAsyncRequestDLQ(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAsyncRequestDLQ", reflect.TypeOf((*MockAsyncRequestStore)(nil).ReadAsyncRequestDLQ), ctx, request)
+}
+
+// ReadAsyncRequestQueueMessages mocks base method.
+func (m *MockAsyncRequestStore) ReadAsyncRequestQueueMessages(ctx context.Context, request *ReadAsyncRequestQueueMessagesRequest) (*ReadAsyncRequestQueueMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAsyncRequestQueueMessages", ctx, request)
+	ret0, _ := ret[0].(*ReadAsyncRequestQueueMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAsyncRequestQueueMessages indicates an expected call of ReadAsyncRequestQueueMessages.
+func (mr *MockAsyncRequestStoreMockRecorder) ReadAsyncRequestQueueMessages(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAsyncRequestQueueMessages", reflect.TypeOf((*MockAsyncRequestStore)(nil).ReadAsyncRequestQueueMessages), ctx, request)
+}
+
+// UpdateAsyncRequestQueueMetadata mocks base method.
+func (m *MockAsyncRequestStore) UpdateAsyncRequestQueueMetadata(ctx context.Context, request *UpdateAsyncRequestQueueMetadataRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAsyncRequestQueueMetadata", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAsyncRequestQueueMetadata indicates an expected call of UpdateAsyncRequestQueueMetadata.
+func (mr *MockAsyncRequestStoreMockRecorder) UpdateAsyncRequestQueueMetadata(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAsyncRequestQueueMetadata", reflect.TypeOf((*MockAsyncRequestStore)(nil).UpdateAsyncRequestQueueMetadata), ctx, request)
+}
+
+// UpsertAsyncRequest mocks base method.
+func (m *MockAsyncRequestStore) UpsertAsyncRequest(ctx context.Context, request *UpsertAsyncRequestRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertAsyncRequest", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertAsyncRequest indicates an expected call of UpsertAsyncRequest.
+func (mr *MockAsyncRequestStoreMockRecorder) UpsertAsyncRequest(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAsyncRequest", reflect.TypeOf((*MockAsyncRequestStore)(nil).UpsertAsyncRequest), ctx, request)
+}
+
+// MockMapQStore is a mock of MapQStore interface.
+type MockMapQStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockMapQStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockMapQStoreMockRecorder is the mock recorder for MockMapQStore.
+type MockMapQStoreMockRecorder struct {
+	mock *MockMapQStore
+}
+
+// NewMockMapQStore creates a new mock instance.
+func NewMockMapQStore(ctrl *gomock.Controller) *MockMapQStore {
+	mock := &MockMapQStore{ctrl: ctrl}
+	mock.recorder = &MockMapQStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMapQStore) EXPECT() *MockMapQStoreMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockMapQStore) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockMapQStoreMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockMapQStore)(nil).Close))
+}
+
+// DeleteMapQItems mocks base method.
+func (m *MockMapQStore) DeleteMapQItems(ctx context.Context, request *DeleteMapQItemsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMapQItems", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMapQItems indicates an expected call of DeleteMapQItems.
+func (mr *MockMapQStoreMockRecorder) DeleteMapQItems(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMapQItems", reflect.TypeOf((*MockMapQStore)(nil).DeleteMapQItems), ctx, request)
+}
+
+// EnqueueMapQItems mocks base method.
+func (m *MockMapQStore) EnqueueMapQItems(ctx context.Context, request *EnqueueMapQItemsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueMapQItems", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueMapQItems indicates an expected call of EnqueueMapQItems.
+func (mr *MockMapQStoreMockRecorder) EnqueueMapQItems(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueMapQItems", reflect.TypeOf((*MockMapQStore)(nil).EnqueueMapQItems), ctx, request)
+}
+
+// GetMapQState mocks base method.
+func (m *MockMapQStore) GetMapQState(ctx context.Context, request *GetMapQStateRequest) (*GetMapQStateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMapQState", ctx, request)
+	ret0, _ := ret[0].(*GetMapQStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMapQState indicates an expected call of GetMapQState.
+func (mr *MockMapQStoreMockRecorder) GetMapQState(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMapQState", reflect.TypeOf((*MockMapQStore)(nil).GetMapQState), ctx, request)
+}
+
+// ReadMapQItems mocks base method.
+func (m *MockMapQStore) ReadMapQItems(ctx context.Context, request *ReadMapQItemsRequest) (*ReadMapQItemsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadMapQItems", ctx, request)
+	ret0, _ := ret[0].(*ReadMapQItemsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadMapQItems indicates an expected call of ReadMapQItems.
+func (mr *MockMapQStoreMockRecorder) ReadMapQItems(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMapQItems", reflect.TypeOf((*MockMapQStore)(nil).ReadMapQItems), ctx, request)
+}
+
+// UpdateMapQState mocks base method.
+func (m *MockMapQStore) UpdateMapQState(ctx context.Context, request *UpdateMapQStateRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMapQState", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMapQState indicates an expected call of UpdateMapQState.
+func (mr *MockMapQStoreMockRecorder) UpdateMapQState(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMapQState", reflect.TypeOf((*MockMapQStore)(nil).UpdateMapQState), ctx, request)
+}
+
+// MockShardDistributorStore is a mock of ShardDistributorStore interface.
+type MockShardDistributorStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockShardDistributorStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockShardDistributorStoreMockRecorder is the mock recorder for MockShardDistributorStore.
+type MockShardDistributorStoreMockRecorder struct {
+	mock *MockShardDistributorStore
+}
+
+// NewMockShardDistributorStore creates a new mock instance.
+func NewMockShardDistributorStore(ctrl *gomock.Controller) *MockShardDistributorStore {
+	mock := &MockShardDistributorStore{ctrl: ctrl}
+	mock.recorder = &MockShardDistributorStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShardDistributorStore) EXPECT() *MockShardDistributorStoreMockRecorder {
+	return m.recorder
+}
+
+// AssignShardDistributorShards mocks base method.
+func (m *MockShardDistributorStore) AssignShardDistributorShards(ctx context.Context, request *AssignShardDistributorShardsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignShardDistributorShards", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignShardDistributorShards indicates an expected call of AssignShardDistributorShards.
+func (mr *MockShardDistributorStoreMockRecorder) AssignShardDistributorShards(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignShardDistributorShards", reflect.TypeOf((*MockShardDistributorStore)(nil).AssignShardDistributorShards), ctx, request)
+}
+
+// Close mocks base method.
+func (m *MockShardDistributorStore) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockShardDistributorStoreMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockShardDistributorStore)(nil).Close))
+}
+
+// DeleteShardDistributorExecutors mocks base method.
+func (m *MockShardDistributorStore) DeleteShardDistributorExecutors(ctx context.Context, request *DeleteShardDistributorExecutorsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteShardDistributorExecutors", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteShardDistributorExecutors indicates an expected call of DeleteShardDistributorExecutors.
+func (mr *MockShardDistributorStoreMockRecorder) DeleteShardDistributorExecutors(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteShardDistributorExecutors", reflect.TypeOf((*MockShardDistributorStore)(nil).DeleteShardDistributorExecutors), ctx, request)
+}
+
+// GetShardDistributorState mocks base method.
+func (m *MockShardDistributorStore) GetShardDistributorState(ctx context.Context, request *GetShardDistributorStateRequest) (*GetShardDistributorStateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShardDistributorState", ctx, request)
+	ret0, _ := ret[0].(*GetShardDistributorStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShardDistributorState indicates an expected call of GetShardDistributorState.
+func (mr *MockShardDistributorStoreMockRecorder) GetShardDistributorState(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShardDistributorState", reflect.TypeOf((*MockShardDistributorStore)(nil).GetShardDistributorState), ctx, request)
+}
+
+// UpdateShardDistributorLeader mocks base method.
+func (m *MockShardDistributorStore) UpdateShardDistributorLeader(ctx context.Context, request *UpdateShardDistributorLeaderRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorLeader", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateShardDistributorLeader indicates an expected call of UpdateShardDistributorLeader.
+func (mr *MockShardDistributorStoreMockRecorder) UpdateShardDistributorLeader(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorLeader", reflect.TypeOf((*MockShardDistributorStore)(nil).UpdateShardDistributorLeader), ctx, request)
+}
+
+// UpsertShardDistributorExecutor mocks base method.
+func (m *MockShardDistributorStore) UpsertShardDistributorExecutor(ctx context.Context, request *UpsertShardDistributorExecutorRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertShardDistributorExecutor", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertShardDistributorExecutor indicates an expected call of UpsertShardDistributorExecutor.
+func (mr *MockShardDistributorStoreMockRecorder) UpsertShardDistributorExecutor(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertShardDistributorExecutor", reflect.TypeOf((*MockShardDistributorStore)(nil).UpsertShardDistributorExecutor), ctx, request)
+}
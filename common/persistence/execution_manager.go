@@ -147,6 +147,11 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		return nil, nil, err
 	}
 
+	completionCallbacks, completionCallbackStates, err := m.serializer.DeserializeCompletionCallbacks(info.CompletionCallbacks)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	newInfo := &WorkflowExecutionInfo{
 		CompletionEvent: completionEvent,
 
@@ -189,6 +194,9 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		CancelRequestID:                    info.CancelRequestID,
 		StickyTaskList:                     info.StickyTaskList,
 		StickyScheduleToStartTimeout:       int32(info.StickyScheduleToStartTimeout.Seconds()),
+		StickyWorkerIdentity:               info.StickyWorkerIdentity,
+		StickyHitCount:                     info.StickyHitCount,
+		StickyFallbackCount:                info.StickyFallbackCount,
 		ClientLibraryVersion:               info.ClientLibraryVersion,
 		ClientFeatureVersion:               info.ClientFeatureVersion,
 		ClientImpl:                         info.ClientImpl,
@@ -209,6 +217,8 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		Memo:                               info.Memo,
 		PartitionConfig:                    info.PartitionConfig,
 		ActiveClusterSelectionPolicy:       activeClusterSelectionPolicy,
+		CompletionCallbacks:                completionCallbacks,
+		CompletionCallbackStates:           completionCallbackStates,
 	}
 	newStats := &ExecutionStats{
 		HistorySize: info.HistorySize,
@@ -487,6 +497,11 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		return nil, err
 	}
 
+	completionCallbacks, err := m.serializer.SerializeCompletionCallbacks(info.CompletionCallbacks, info.CompletionCallbackStates, encoding)
+	if err != nil {
+		return nil, err
+	}
+
 	return &InternalWorkflowExecutionInfo{
 		DomainID:                           info.DomainID,
 		WorkflowID:                         info.WorkflowID,
@@ -527,6 +542,9 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		CancelRequestID:                    info.CancelRequestID,
 		StickyTaskList:                     info.StickyTaskList,
 		StickyScheduleToStartTimeout:       common.SecondsToDuration(int64(info.StickyScheduleToStartTimeout)),
+		StickyWorkerIdentity:               info.StickyWorkerIdentity,
+		StickyHitCount:                     info.StickyHitCount,
+		StickyFallbackCount:                info.StickyFallbackCount,
 		ClientLibraryVersion:               info.ClientLibraryVersion,
 		ClientFeatureVersion:               info.ClientFeatureVersion,
 		ClientImpl:                         info.ClientImpl,
@@ -547,6 +565,7 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		PartitionConfig:                    info.PartitionConfig,
 		CronOverlapPolicy:                  info.CronOverlapPolicy,
 		ActiveClusterSelectionPolicy:       activeClusterSelectionPolicy,
+		CompletionCallbacks:                completionCallbacks,
 
 		// attributes which are not related to mutable state
 		HistorySize: stats.HistorySize,
@@ -234,6 +234,7 @@ func TestExecutionManager_GetWorkflowExecution(t *testing.T) {
 
 	activeClusterSelPlcyData := sampleActiveClusterSelectionPolicyData()
 	mockedSerializer.EXPECT().DeserializeActiveClusterSelectionPolicy(activeClusterSelPlcyData).Return(generateActiveClusterSelectionPolicy(), nil).Times(1)
+	mockedSerializer.EXPECT().DeserializeCompletionCallbacks(gomock.Any()).Return(nil, nil, nil).Times(1)
 
 	res, err := manager.GetWorkflowExecution(context.Background(), request)
 	assert.NoError(t, err)
@@ -302,6 +303,7 @@ func TestExecutionManager_UpdateWorkflowExecution(t *testing.T) {
 	mockedSerializer.EXPECT().SerializeEvent(childWorkflowStartedEvent(), constants.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 	mockedSerializer.EXPECT().SerializeResetPoints(generateResetPoints(), constants.EncodingTypeThriftRW).Return(expectedInfo.ExecutionInfo.AutoResetPoints, nil).Times(2)
 	mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(generateActiveClusterSelectionPolicy(), constants.EncodingTypeThriftRW).Return(sampleActiveClusterSelectionPolicyData(), nil).Times(2)
+	mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any(), gomock.Any(), constants.EncodingTypeThriftRW).Return(nil, nil).Times(2)
 
 	request := &UpdateWorkflowExecutionRequest{
 		RangeID:                1,
@@ -367,6 +369,7 @@ func TestSerializeWorkflowSnapshot(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowStartedEvent(), constants.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeChecksum(gomock.Any(), gomock.Any()).Return(sampleCheckSumData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(generateActiveClusterSelectionPolicy(), constants.EncodingTypeThriftRW).Return(sampleActiveClusterSelectionPolicyData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any(), gomock.Any(), constants.EncodingTypeThriftRW).Return(nil, nil).Times(1)
 			},
 			input: sampleWorkflowSnapshot(),
 			checkRes: func(t *testing.T, res *InternalWorkflowSnapshot, err error) {
@@ -420,6 +423,7 @@ func TestSerializeWorkflowSnapshot(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeResetPoints(gomock.Any(), gomock.Any()).Return(NewDataBlob([]byte("test-reset-points"), constants.EncodingTypeThriftRW), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeVersionHistories(gomock.Any(), gomock.Any()).Return(nil, assert.AnError).Times(1)
 				mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(generateActiveClusterSelectionPolicy(), constants.EncodingTypeThriftRW).Return(sampleActiveClusterSelectionPolicyData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any(), gomock.Any(), constants.EncodingTypeThriftRW).Return(nil, nil).Times(1)
 			},
 			input: sampleWorkflowSnapshot(),
 			checkRes: func(t *testing.T, res *InternalWorkflowSnapshot, err error) {
@@ -434,6 +438,7 @@ func TestSerializeWorkflowSnapshot(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeVersionHistories(gomock.Any(), gomock.Any()).Return(sampleTestCheckSumData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(activityScheduledEvent(), constants.EncodingTypeThriftRW).Return(nil, assert.AnError).Times(1)
 				mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(generateActiveClusterSelectionPolicy(), constants.EncodingTypeThriftRW).Return(sampleActiveClusterSelectionPolicyData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any(), gomock.Any(), constants.EncodingTypeThriftRW).Return(nil, nil).Times(1)
 			},
 			input: sampleWorkflowSnapshot(),
 			checkRes: func(t *testing.T, res *InternalWorkflowSnapshot, err error) {
@@ -449,6 +454,7 @@ func TestSerializeWorkflowSnapshot(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeEvent(activityScheduledEvent(), constants.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(activityStartedEvent(), constants.EncodingTypeThriftRW).Return(nil, assert.AnError).Times(1)
 				mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(generateActiveClusterSelectionPolicy(), constants.EncodingTypeThriftRW).Return(sampleActiveClusterSelectionPolicyData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any(), gomock.Any(), constants.EncodingTypeThriftRW).Return(nil, nil).Times(1)
 			},
 			input: sampleWorkflowSnapshot(),
 			checkRes: func(t *testing.T, res *InternalWorkflowSnapshot, err error) {
@@ -465,6 +471,7 @@ func TestSerializeWorkflowSnapshot(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeEvent(activityStartedEvent(), constants.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowScheduledEvent(), constants.EncodingTypeThriftRW).Return(nil, assert.AnError).Times(1)
 				mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(generateActiveClusterSelectionPolicy(), constants.EncodingTypeThriftRW).Return(sampleActiveClusterSelectionPolicyData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any(), gomock.Any(), constants.EncodingTypeThriftRW).Return(nil, nil).Times(1)
 			},
 			input: sampleWorkflowSnapshot(),
 			checkRes: func(t *testing.T, res *InternalWorkflowSnapshot, err error) {
@@ -482,6 +489,7 @@ func TestSerializeWorkflowSnapshot(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowScheduledEvent(), constants.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowStartedEvent(), constants.EncodingTypeThriftRW).Return(nil, assert.AnError).Times(1)
 				mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(generateActiveClusterSelectionPolicy(), constants.EncodingTypeThriftRW).Return(sampleActiveClusterSelectionPolicyData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any(), gomock.Any(), constants.EncodingTypeThriftRW).Return(nil, nil).Times(1)
 			},
 			input: sampleWorkflowSnapshot(),
 			checkRes: func(t *testing.T, res *InternalWorkflowSnapshot, err error) {
@@ -774,6 +782,7 @@ func TestListConcreteExecutions(t *testing.T) {
 					},
 				}, nil)
 				mockedSerializer.EXPECT().DeserializeActiveClusterSelectionPolicy(internalResponse.Executions[0].ExecutionInfo.ActiveClusterSelectionPolicy).Return(generateActiveClusterSelectionPolicy(), nil)
+				mockedSerializer.EXPECT().DeserializeCompletionCallbacks(gomock.Any()).Return(nil, nil, nil)
 				mockedSerializer.EXPECT().DeserializeVersionHistories(internalResponse.Executions[0].VersionHistories).Return(&types.VersionHistories{
 					CurrentVersionHistoryIndex: 1,
 					Histories: []*types.VersionHistory{
@@ -929,6 +938,7 @@ func TestCreateWorkflowExecution(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowStartedEvent(), constants.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeChecksum(gomock.Any(), gomock.Any()).Return(sampleCheckSumData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(generateActiveClusterSelectionPolicy(), constants.EncodingTypeThriftRW).Return(sampleActiveClusterSelectionPolicyData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any(), gomock.Any(), constants.EncodingTypeThriftRW).Return(nil, nil).Times(1)
 			},
 			checkRes: func(t *testing.T, response *CreateWorkflowExecutionResponse, err error) {
 				assert.Equal(t, &CreateWorkflowExecutionResponse{
@@ -959,6 +969,7 @@ func TestCreateWorkflowExecution(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowStartedEvent(), constants.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeChecksum(gomock.Any(), gomock.Any()).Return(sampleCheckSumData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(generateActiveClusterSelectionPolicy(), constants.EncodingTypeThriftRW).Return(sampleActiveClusterSelectionPolicyData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any(), gomock.Any(), constants.EncodingTypeThriftRW).Return(nil, nil).Times(1)
 
 				// Persistence call will fail
 				mockedStore.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
@@ -1043,6 +1054,7 @@ func TestConflictResolveWorkflowExecution(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowStartedEvent(), constants.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeChecksum(gomock.Any(), gomock.Any()).Return(sampleCheckSumData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(generateActiveClusterSelectionPolicy(), constants.EncodingTypeThriftRW).Return(sampleActiveClusterSelectionPolicyData(), nil).Times(1)
+				mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any(), gomock.Any(), constants.EncodingTypeThriftRW).Return(nil, nil).Times(1)
 			},
 			checkRes: func(t *testing.T, response *ConflictResolveWorkflowExecutionResponse, err error) {
 				assert.NoError(t, err)
@@ -1118,6 +1130,7 @@ func TestConflictResolveWorkflowExecution(t *testing.T) {
 				mockedSerializer.EXPECT().SerializeEvent(childWorkflowStartedEvent(), constants.EncodingTypeThriftRW).Return(sampleEventData(), nil).Times(1)
 				mockedSerializer.EXPECT().SerializeChecksum(gomock.Any(), gomock.Any()).Return(sampleCheckSumData(), nil).Times(2)
 				mockedSerializer.EXPECT().SerializeActiveClusterSelectionPolicy(generateActiveClusterSelectionPolicy(), constants.EncodingTypeThriftRW).Return(sampleActiveClusterSelectionPolicyData(), nil).Times(2)
+				mockedSerializer.EXPECT().SerializeCompletionCallbacks(gomock.Any(), gomock.Any(), constants.EncodingTypeThriftRW).Return(nil, nil).Times(2)
 			},
 			checkRes: func(t *testing.T, response *ConflictResolveWorkflowExecutionResponse, err error) {
 				assert.NoError(t, err)
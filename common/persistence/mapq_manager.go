@@ -0,0 +1,74 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+)
+
+type (
+	mapQManager struct {
+		persistence MapQStore
+	}
+)
+
+var _ MapQManager = (*mapQManager)(nil)
+
+// NewMapQManager returns a new MapQManager
+func NewMapQManager(
+	persistence MapQStore,
+) MapQManager {
+	return &mapQManager{
+		persistence: persistence,
+	}
+}
+
+func (m *mapQManager) Close() {
+	m.persistence.Close()
+}
+
+func (m *mapQManager) EnqueueMapQItems(ctx context.Context, request *EnqueueMapQItemsRequest) error {
+	if len(request.Items) == 0 {
+		return nil
+	}
+	return m.persistence.EnqueueMapQItems(ctx, request)
+}
+
+func (m *mapQManager) ReadMapQItems(ctx context.Context, request *ReadMapQItemsRequest) (*ReadMapQItemsResponse, error) {
+	if request.PageSize <= 0 || request.ExclusiveMinOffset >= request.InclusiveMaxOffset {
+		return &ReadMapQItemsResponse{}, nil
+	}
+	return m.persistence.ReadMapQItems(ctx, request)
+}
+
+func (m *mapQManager) DeleteMapQItems(ctx context.Context, request *DeleteMapQItemsRequest) error {
+	return m.persistence.DeleteMapQItems(ctx, request)
+}
+
+func (m *mapQManager) GetMapQState(ctx context.Context, request *GetMapQStateRequest) (*GetMapQStateResponse, error) {
+	return m.persistence.GetMapQState(ctx, request)
+}
+
+func (m *mapQManager) UpdateMapQState(ctx context.Context, request *UpdateMapQStateRequest) error {
+	return m.persistence.UpdateMapQState(ctx, request)
+}
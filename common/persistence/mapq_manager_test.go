@@ -0,0 +1,122 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/constants"
+)
+
+func TestNewMapQManager(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockMapQStore(ctrl)
+	m := NewMapQManager(mockStore)
+	mm, ok := m.(*mapQManager)
+	assert.True(t, ok)
+	assert.Equal(t, mockStore, mm.persistence)
+
+	mockStore.EXPECT().Close().Times(1)
+	m.Close()
+}
+
+func TestEnqueueMapQItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockMapQStore(ctrl)
+	m := NewMapQManager(mockStore)
+
+	// nothing to write
+	assert.NoError(t, m.EnqueueMapQItems(context.Background(), &EnqueueMapQItemsRequest{QueueID: "queue"}))
+
+	request := &EnqueueMapQItemsRequest{
+		QueueID: "queue",
+		Items:   []*MapQItem{{Partition: "*/timer", Offset: 1, Payload: []byte("item")}},
+	}
+	mockStore.EXPECT().EnqueueMapQItems(gomock.Any(), request).Return(errors.New("store error")).Times(1)
+	assert.Error(t, m.EnqueueMapQItems(context.Background(), request))
+}
+
+func TestReadMapQItems(t *testing.T) {
+	testCases := []struct {
+		name      string
+		request   *ReadMapQItemsRequest
+		callStore bool
+	}{
+		{
+			name:      "range is read from the store",
+			request:   &ReadMapQItemsRequest{QueueID: "queue", Partition: "*", ExclusiveMinOffset: 10, InclusiveMaxOffset: 20, PageSize: 5},
+			callStore: true,
+		},
+		{
+			name:    "empty range",
+			request: &ReadMapQItemsRequest{QueueID: "queue", Partition: "*", ExclusiveMinOffset: 20, InclusiveMaxOffset: 20, PageSize: 5},
+		},
+		{
+			name:    "no page size",
+			request: &ReadMapQItemsRequest{QueueID: "queue", Partition: "*", ExclusiveMinOffset: 10, InclusiveMaxOffset: 20},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockStore := NewMockMapQStore(ctrl)
+			m := NewMapQManager(mockStore)
+			expected := &ReadMapQItemsResponse{}
+			if tc.callStore {
+				expected.Items = []*MapQItem{{Partition: "*", Offset: 11}}
+				mockStore.EXPECT().ReadMapQItems(gomock.Any(), tc.request).Return(expected, nil).Times(1)
+			}
+
+			resp, err := m.ReadMapQItems(context.Background(), tc.request)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, resp)
+		})
+	}
+}
+
+func TestMapQPassthrough(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockMapQStore(ctrl)
+	m := NewMapQManager(mockStore)
+	ctx := context.Background()
+
+	deleteRequest := &DeleteMapQItemsRequest{QueueID: "queue", Partition: "*", InclusiveMaxOffset: 10}
+	mockStore.EXPECT().DeleteMapQItems(ctx, deleteRequest).Return(nil).Times(1)
+	assert.NoError(t, m.DeleteMapQItems(ctx, deleteRequest))
+
+	state := &MapQState{QueueID: "queue", State: &DataBlob{Encoding: constants.EncodingTypeJSON, Data: []byte("{}")}, Version: 2}
+	mockStore.EXPECT().GetMapQState(ctx, &GetMapQStateRequest{QueueID: "queue"}).Return(&GetMapQStateResponse{State: state}, nil).Times(1)
+	resp, err := m.GetMapQState(ctx, &GetMapQStateRequest{QueueID: "queue"})
+	assert.NoError(t, err)
+	assert.Equal(t, state, resp.State)
+
+	updateRequest := &UpdateMapQStateRequest{State: state, PreviousVersion: 1}
+	mockStore.EXPECT().UpdateMapQState(ctx, updateRequest).Return(&ConditionFailedError{}).Times(1)
+	assert.Error(t, m.UpdateMapQState(ctx, updateRequest))
+}
@@ -109,6 +109,21 @@ func (f *Factory) NewConfigStore() (persistence.ConfigStore, error) {
 	return NewNoSQLConfigStore(f.cfg, f.logger, f.metricsClient, f.dc)
 }
 
+// NewAsyncRequestStore returns a new async request store
+func (f *Factory) NewAsyncRequestStore() (persistence.AsyncRequestStore, error) {
+	return NewNoSQLAsyncRequestStore(f.cfg, f.logger, f.metricsClient, f.dc)
+}
+
+// NewMapQStore returns a new MAPQ store
+func (f *Factory) NewMapQStore() (persistence.MapQStore, error) {
+	return NewNoSQLMapQStore(f.cfg, f.logger, f.metricsClient, f.dc)
+}
+
+// NewShardDistributorStore returns a new shard distributor store
+func (f *Factory) NewShardDistributorStore() (persistence.ShardDistributorStore, error) {
+	return NewNoSQLShardDistributorStore(f.cfg, f.logger, f.metricsClient, f.dc)
+}
+
 // Close closes the factory
 func (f *Factory) Close() {
 	f.Lock()
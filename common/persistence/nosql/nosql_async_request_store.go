@@ -0,0 +1,175 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package nosql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+type nosqlAsyncRequestStore struct {
+	nosqlStore
+}
+
+// NewNoSQLAsyncRequestStore creates an async request store backed by the default shard of the nosql store
+func NewNoSQLAsyncRequestStore(
+	cfg config.ShardedNoSQL,
+	logger log.Logger,
+	metricsClient metrics.Client,
+	dc *persistence.DynamicConfiguration,
+) (persistence.AsyncRequestStore, error) {
+	shardedStore, err := newShardedNosqlStore(cfg, logger, metricsClient, dc)
+	if err != nil {
+		return nil, err
+	}
+	return &nosqlAsyncRequestStore{
+		nosqlStore: shardedStore.GetDefaultShard(),
+	}, nil
+}
+
+func (m *nosqlAsyncRequestStore) UpsertAsyncRequest(ctx context.Context, request *persistence.UpsertAsyncRequestRequest) error {
+	var ttlSeconds int64
+	if !request.Request.ExpiryTime.IsZero() {
+		ttlSeconds = int64(time.Until(request.Request.ExpiryTime).Seconds())
+		if ttlSeconds <= 0 {
+			// the record has already expired, keep it around briefly so that the last update is still visible
+			ttlSeconds = 1
+		}
+	}
+	if err := m.db.InsertAsyncRequest(ctx, request.Request, ttlSeconds); err != nil {
+		return convertCommonErrors(m.db, "UpsertAsyncRequest", err)
+	}
+	return nil
+}
+
+func (m *nosqlAsyncRequestStore) GetAsyncRequest(ctx context.Context, request *persistence.GetAsyncRequestRequest) (*persistence.GetAsyncRequestResponse, error) {
+	row, err := m.db.SelectAsyncRequest(ctx, request.DomainID, request.RequestID)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "GetAsyncRequest", err)
+	}
+	return &persistence.GetAsyncRequestResponse{Request: row}, nil
+}
+
+func (m *nosqlAsyncRequestStore) EnqueueAsyncRequestToDLQ(ctx context.Context, request *persistence.EnqueueAsyncRequestToDLQRequest) error {
+	if err := m.db.InsertAsyncRequestDLQEntry(ctx, request.Entry); err != nil {
+		return convertCommonErrors(m.db, "EnqueueAsyncRequestToDLQ", err)
+	}
+	return nil
+}
+
+func (m *nosqlAsyncRequestStore) ReadAsyncRequestDLQ(ctx context.Context, request *persistence.ReadAsyncRequestDLQRequest) (*persistence.ReadAsyncRequestDLQResponse, error) {
+	entries, nextPageToken, err := m.db.SelectAsyncRequestDLQEntries(ctx, request.DomainID, request.PageSize, request.NextPageToken)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "ReadAsyncRequestDLQ", err)
+	}
+	return &persistence.ReadAsyncRequestDLQResponse{
+		Entries:       entries,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (m *nosqlAsyncRequestStore) GetAsyncRequestDLQEntry(ctx context.Context, request *persistence.GetAsyncRequestDLQEntryRequest) (*persistence.GetAsyncRequestDLQEntryResponse, error) {
+	entry, err := m.db.SelectAsyncRequestDLQEntry(ctx, request.DomainID, request.RequestID)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "GetAsyncRequestDLQEntry", err)
+	}
+	return &persistence.GetAsyncRequestDLQEntryResponse{Entry: entry}, nil
+}
+
+func (m *nosqlAsyncRequestStore) DeleteAsyncRequestDLQEntry(ctx context.Context, request *persistence.DeleteAsyncRequestDLQEntryRequest) error {
+	if err := m.db.DeleteAsyncRequestDLQEntry(ctx, request.DomainID, request.RequestID); err != nil {
+		return convertCommonErrors(m.db, "DeleteAsyncRequestDLQEntry", err)
+	}
+	return nil
+}
+
+func (m *nosqlAsyncRequestStore) InsertAsyncRequestQueueMessage(ctx context.Context, request *persistence.InternalInsertAsyncRequestQueueMessageRequest) error {
+	if err := m.db.InsertAsyncRequestQueueMessage(ctx, request.QueueName, request.Message); err != nil {
+		if _, ok := err.(*nosqlplugin.ConditionFailure); ok {
+			return &persistence.ConditionFailedError{
+				Msg: fmt.Sprintf("message ID %v already exists in queue %v", request.Message.MessageID, request.QueueName),
+			}
+		}
+		return convertCommonErrors(m.db, "InsertAsyncRequestQueueMessage", err)
+	}
+	return nil
+}
+
+func (m *nosqlAsyncRequestStore) GetLastAsyncRequestQueueMessageID(ctx context.Context, queueName string) (int64, error) {
+	messageID, err := m.db.SelectLastAsyncRequestQueueMessageID(ctx, queueName)
+	if err != nil {
+		if m.db.IsNotFoundError(err) {
+			return emptyMessageID, nil
+		}
+		return emptyMessageID, convertCommonErrors(m.db, "GetLastAsyncRequestQueueMessageID", err)
+	}
+	return messageID, nil
+}
+
+func (m *nosqlAsyncRequestStore) ReadAsyncRequestQueueMessages(ctx context.Context, request *persistence.ReadAsyncRequestQueueMessagesRequest) (*persistence.ReadAsyncRequestQueueMessagesResponse, error) {
+	messages, err := m.db.SelectAsyncRequestQueueMessages(ctx, request.QueueName, request.ExclusiveBeginMessageID, request.PageSize)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "ReadAsyncRequestQueueMessages", err)
+	}
+	return &persistence.ReadAsyncRequestQueueMessagesResponse{Messages: messages}, nil
+}
+
+func (m *nosqlAsyncRequestStore) DeleteAsyncRequestQueueMessages(ctx context.Context, request *persistence.DeleteAsyncRequestQueueMessagesRequest) error {
+	if err := m.db.DeleteAsyncRequestQueueMessages(ctx, request.QueueName, request.InclusiveEndMessageID); err != nil {
+		return convertCommonErrors(m.db, "DeleteAsyncRequestQueueMessages", err)
+	}
+	return nil
+}
+
+func (m *nosqlAsyncRequestStore) GetAsyncRequestQueueMetadata(ctx context.Context, request *persistence.GetAsyncRequestQueueMetadataRequest) (*persistence.GetAsyncRequestQueueMetadataResponse, error) {
+	metadata, err := m.db.SelectAsyncRequestQueueMetadata(ctx, request.QueueName)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "GetAsyncRequestQueueMetadata", err)
+	}
+	return &persistence.GetAsyncRequestQueueMetadataResponse{Metadata: metadata}, nil
+}
+
+func (m *nosqlAsyncRequestStore) UpdateAsyncRequestQueueMetadata(ctx context.Context, request *persistence.UpdateAsyncRequestQueueMetadataRequest) error {
+	var err error
+	if request.PreviousVersion == 0 {
+		err = m.db.InsertAsyncRequestQueueMetadata(ctx, request.Metadata)
+	} else {
+		err = m.db.UpdateAsyncRequestQueueMetadataCas(ctx, request.Metadata, request.PreviousVersion)
+	}
+	if err != nil {
+		if _, ok := err.(*nosqlplugin.ConditionFailure); ok {
+			return &persistence.ConditionFailedError{
+				Msg: fmt.Sprintf("metadata of queue %v was updated concurrently, expected version %v", request.Metadata.QueueName, request.PreviousVersion),
+			}
+		}
+		return convertCommonErrors(m.db, "UpdateAsyncRequestQueueMetadata", err)
+	}
+	return nil
+}
@@ -0,0 +1,239 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package nosql
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+func setUpMocksForNoSQLAsyncRequestStore(t *testing.T) (*nosqlAsyncRequestStore, *nosqlplugin.MockDB) {
+	ctrl := gomock.NewController(t)
+	mockDB := nosqlplugin.NewMockDB(ctrl)
+	return &nosqlAsyncRequestStore{
+		nosqlStore: nosqlStore{
+			logger: log.NewNoop(),
+			db:     mockDB,
+		},
+	}, mockDB
+}
+
+func TestUpsertAsyncRequest(t *testing.T) {
+	testCases := []struct {
+		name        string
+		expiryTime  time.Time
+		ttlMatcher  gomock.Matcher
+		dbErr       error
+		expectError bool
+	}{
+		{
+			name:       "no expiry",
+			ttlMatcher: gomock.Eq(int64(0)),
+		},
+		{
+			name:       "expiry in the future",
+			expiryTime: time.Now().Add(time.Hour),
+			ttlMatcher: gomock.Cond(func(x any) bool {
+				ttl := x.(int64)
+				return ttl > 3500 && ttl <= 3600
+			}),
+		},
+		{
+			name:       "expiry in the past",
+			expiryTime: time.Now().Add(-time.Hour),
+			ttlMatcher: gomock.Eq(int64(1)),
+		},
+		{
+			name:        "db error",
+			ttlMatcher:  gomock.Any(),
+			dbErr:       errors.New("db error"),
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store, mockDB := setUpMocksForNoSQLAsyncRequestStore(t)
+			info := &persistence.AsyncRequestInfo{
+				DomainID:   "domain-id",
+				RequestID:  "request-id",
+				State:      types.AsyncRequestStateQueued,
+				ExpiryTime: tc.expiryTime,
+			}
+			mockDB.EXPECT().InsertAsyncRequest(gomock.Any(), info, tc.ttlMatcher).Return(tc.dbErr).Times(1)
+			if tc.dbErr != nil {
+				mockDB.EXPECT().IsNotFoundError(tc.dbErr).Return(false).Times(1)
+				mockDB.EXPECT().IsTimeoutError(tc.dbErr).Return(false).Times(1)
+				mockDB.EXPECT().IsThrottlingError(tc.dbErr).Return(false).Times(1)
+				mockDB.EXPECT().IsDBUnavailableError(tc.dbErr).Return(false).Times(1)
+			}
+
+			err := store.UpsertAsyncRequest(context.Background(), &persistence.UpsertAsyncRequestRequest{Request: info})
+			if tc.expectError {
+				assert.IsType(t, &types.InternalServiceError{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetAsyncRequest(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLAsyncRequestStore(t)
+		row := &persistence.AsyncRequestInfo{DomainID: "domain-id", RequestID: "request-id", State: types.AsyncRequestStateProcessing}
+		mockDB.EXPECT().SelectAsyncRequest(gomock.Any(), "domain-id", "request-id").Return(row, nil).Times(1)
+
+		resp, err := store.GetAsyncRequest(context.Background(), &persistence.GetAsyncRequestRequest{DomainID: "domain-id", RequestID: "request-id"})
+		assert.NoError(t, err)
+		assert.Equal(t, &persistence.GetAsyncRequestResponse{Request: row}, resp)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLAsyncRequestStore(t)
+		notFound := errors.New("not found")
+		mockDB.EXPECT().SelectAsyncRequest(gomock.Any(), "domain-id", "request-id").Return(nil, notFound).Times(1)
+		mockDB.EXPECT().IsNotFoundError(notFound).Return(true).Times(1)
+
+		_, err := store.GetAsyncRequest(context.Background(), &persistence.GetAsyncRequestRequest{DomainID: "domain-id", RequestID: "request-id"})
+		assert.IsType(t, &types.EntityNotExistsError{}, err)
+	})
+}
+
+func TestAsyncRequestDLQ(t *testing.T) {
+	ctx := context.Background()
+	entry := &persistence.AsyncRequestDLQEntry{DomainID: "domain-id", RequestID: "request-id", Payload: []byte("payload")}
+
+	t.Run("enqueue", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLAsyncRequestStore(t)
+		mockDB.EXPECT().InsertAsyncRequestDLQEntry(ctx, entry).Return(nil).Times(1)
+		assert.NoError(t, store.EnqueueAsyncRequestToDLQ(ctx, &persistence.EnqueueAsyncRequestToDLQRequest{Entry: entry}))
+	})
+
+	t.Run("read", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLAsyncRequestStore(t)
+		mockDB.EXPECT().SelectAsyncRequestDLQEntries(ctx, "domain-id", 10, []byte("token")).
+			Return([]*persistence.AsyncRequestDLQEntry{entry}, []byte("next"), nil).Times(1)
+
+		resp, err := store.ReadAsyncRequestDLQ(ctx, &persistence.ReadAsyncRequestDLQRequest{DomainID: "domain-id", PageSize: 10, NextPageToken: []byte("token")})
+		assert.NoError(t, err)
+		assert.Equal(t, &persistence.ReadAsyncRequestDLQResponse{Entries: []*persistence.AsyncRequestDLQEntry{entry}, NextPageToken: []byte("next")}, resp)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLAsyncRequestStore(t)
+		mockDB.EXPECT().SelectAsyncRequestDLQEntry(ctx, "domain-id", "request-id").Return(entry, nil).Times(1)
+
+		resp, err := store.GetAsyncRequestDLQEntry(ctx, &persistence.GetAsyncRequestDLQEntryRequest{DomainID: "domain-id", RequestID: "request-id"})
+		assert.NoError(t, err)
+		assert.Equal(t, entry, resp.Entry)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLAsyncRequestStore(t)
+		mockDB.EXPECT().DeleteAsyncRequestDLQEntry(ctx, "domain-id", "request-id").Return(nil).Times(1)
+		assert.NoError(t, store.DeleteAsyncRequestDLQEntry(ctx, &persistence.DeleteAsyncRequestDLQEntryRequest{DomainID: "domain-id", RequestID: "request-id"}))
+	})
+}
+
+func TestAsyncRequestQueue(t *testing.T) {
+	ctx := context.Background()
+	message := &persistence.AsyncRequestQueueMessage{MessageID: 11, Payload: []byte("payload")}
+	metadata := &persistence.AsyncRequestQueueMetadata{QueueName: "queue", AckLevel: 10, LeaseOwner: "host-1", Version: 3}
+
+	t.Run("insert message", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLAsyncRequestStore(t)
+		mockDB.EXPECT().InsertAsyncRequestQueueMessage(ctx, "queue", message).Return(nil).Times(1)
+		assert.NoError(t, store.InsertAsyncRequestQueueMessage(ctx, &persistence.InternalInsertAsyncRequestQueueMessageRequest{QueueName: "queue", Message: message}))
+	})
+
+	t.Run("insert message conflict", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLAsyncRequestStore(t)
+		mockDB.EXPECT().InsertAsyncRequestQueueMessage(ctx, "queue", message).Return(nosqlplugin.NewConditionFailure("async_request_queue")).Times(1)
+		err := store.InsertAsyncRequestQueueMessage(ctx, &persistence.InternalInsertAsyncRequestQueueMessageRequest{QueueName: "queue", Message: message})
+		assert.IsType(t, &persistence.ConditionFailedError{}, err)
+	})
+
+	t.Run("last message ID", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLAsyncRequestStore(t)
+		mockDB.EXPECT().SelectLastAsyncRequestQueueMessageID(ctx, "queue").Return(int64(11), nil).Times(1)
+		id, err := store.GetLastAsyncRequestQueueMessageID(ctx, "queue")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(11), id)
+	})
+
+	t.Run("last message ID of empty queue", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLAsyncRequestStore(t)
+		notFound := errors.New("not found")
+		mockDB.EXPECT().SelectLastAsyncRequestQueueMessageID(ctx, "queue").Return(int64(0), notFound).Times(1)
+		mockDB.EXPECT().IsNotFoundError(notFound).Return(true).Times(1)
+		id, err := store.GetLastAsyncRequestQueueMessageID(ctx, "queue")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(emptyMessageID), id)
+	})
+
+	t.Run("read messages", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLAsyncRequestStore(t)
+		mockDB.EXPECT().SelectAsyncRequestQueueMessages(ctx, "queue", int64(10), 100).Return([]*persistence.AsyncRequestQueueMessage{message}, nil).Times(1)
+		resp, err := store.ReadAsyncRequestQueueMessages(ctx, &persistence.ReadAsyncRequestQueueMessagesRequest{QueueName: "queue", ExclusiveBeginMessageID: 10, PageSize: 100})
+		assert.NoError(t, err)
+		assert.Equal(t, []*persistence.AsyncRequestQueueMessage{message}, resp.Messages)
+	})
+
+	t.Run("delete messages", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLAsyncRequestStore(t)
+		mockDB.EXPECT().DeleteAsyncRequestQueueMessages(ctx, "queue", int64(11)).Return(nil).Times(1)
+		assert.NoError(t, store.DeleteAsyncRequestQueueMessages(ctx, &persistence.DeleteAsyncRequestQueueMessagesRequest{QueueName: "queue", InclusiveEndMessageID: 11}))
+	})
+
+	t.Run("get metadata", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLAsyncRequestStore(t)
+		mockDB.EXPECT().SelectAsyncRequestQueueMetadata(ctx, "queue").Return(metadata, nil).Times(1)
+		resp, err := store.GetAsyncRequestQueueMetadata(ctx, &persistence.GetAsyncRequestQueueMetadataRequest{QueueName: "queue"})
+		assert.NoError(t, err)
+		assert.Equal(t, metadata, resp.Metadata)
+	})
+
+	t.Run("create metadata", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLAsyncRequestStore(t)
+		mockDB.EXPECT().InsertAsyncRequestQueueMetadata(ctx, metadata).Return(nil).Times(1)
+		assert.NoError(t, store.UpdateAsyncRequestQueueMetadata(ctx, &persistence.UpdateAsyncRequestQueueMetadataRequest{Metadata: metadata}))
+	})
+
+	t.Run("update metadata", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLAsyncRequestStore(t)
+		mockDB.EXPECT().UpdateAsyncRequestQueueMetadataCas(ctx, metadata, int64(2)).Return(nosqlplugin.NewConditionFailure("async_request_queue_metadata")).Times(1)
+		err := store.UpdateAsyncRequestQueueMetadata(ctx, &persistence.UpdateAsyncRequestQueueMetadataRequest{Metadata: metadata, PreviousVersion: 2})
+		assert.IsType(t, &persistence.ConditionFailedError{}, err)
+	})
+}
@@ -443,7 +443,8 @@ func (d *nosqlExecutionStore) prepareTransferTasksForWorkflowTxn(domainID, workf
 			persistence.TransferTaskTypeRecordWorkflowStarted,
 			persistence.TransferTaskTypeResetWorkflow,
 			persistence.TransferTaskTypeUpsertWorkflowSearchAttributes,
-			persistence.TransferTaskTypeRecordWorkflowClosed:
+			persistence.TransferTaskTypeRecordWorkflowClosed,
+			persistence.TransferTaskTypeCompletionCallback:
 			// No explicit property needs to be set
 
 		default:
@@ -0,0 +1,102 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package nosql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+type nosqlMapQStore struct {
+	nosqlStore
+}
+
+// NewNoSQLMapQStore creates a MAPQ store backed by the default shard of the nosql store
+func NewNoSQLMapQStore(
+	cfg config.ShardedNoSQL,
+	logger log.Logger,
+	metricsClient metrics.Client,
+	dc *persistence.DynamicConfiguration,
+) (persistence.MapQStore, error) {
+	shardedStore, err := newShardedNosqlStore(cfg, logger, metricsClient, dc)
+	if err != nil {
+		return nil, err
+	}
+	return &nosqlMapQStore{
+		nosqlStore: shardedStore.GetDefaultShard(),
+	}, nil
+}
+
+func (m *nosqlMapQStore) EnqueueMapQItems(ctx context.Context, request *persistence.EnqueueMapQItemsRequest) error {
+	if err := m.db.InsertMapQItems(ctx, request.QueueID, request.Items); err != nil {
+		return convertCommonErrors(m.db, "EnqueueMapQItems", err)
+	}
+	return nil
+}
+
+func (m *nosqlMapQStore) ReadMapQItems(ctx context.Context, request *persistence.ReadMapQItemsRequest) (*persistence.ReadMapQItemsResponse, error) {
+	items, err := m.db.SelectMapQItems(ctx, request.QueueID, request.Partition, request.ExclusiveMinOffset, request.InclusiveMaxOffset, request.PageSize)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "ReadMapQItems", err)
+	}
+	return &persistence.ReadMapQItemsResponse{Items: items}, nil
+}
+
+func (m *nosqlMapQStore) DeleteMapQItems(ctx context.Context, request *persistence.DeleteMapQItemsRequest) error {
+	if err := m.db.DeleteMapQItems(ctx, request.QueueID, request.Partition, request.InclusiveMaxOffset); err != nil {
+		return convertCommonErrors(m.db, "DeleteMapQItems", err)
+	}
+	return nil
+}
+
+func (m *nosqlMapQStore) GetMapQState(ctx context.Context, request *persistence.GetMapQStateRequest) (*persistence.GetMapQStateResponse, error) {
+	state, err := m.db.SelectMapQState(ctx, request.QueueID)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "GetMapQState", err)
+	}
+	return &persistence.GetMapQStateResponse{State: state}, nil
+}
+
+func (m *nosqlMapQStore) UpdateMapQState(ctx context.Context, request *persistence.UpdateMapQStateRequest) error {
+	var err error
+	if request.PreviousVersion == 0 {
+		err = m.db.InsertMapQState(ctx, request.State)
+	} else {
+		err = m.db.UpdateMapQStateCas(ctx, request.State, request.PreviousVersion)
+	}
+	if err != nil {
+		if _, ok := err.(*nosqlplugin.ConditionFailure); ok {
+			return &persistence.ConditionFailedError{
+				Msg: fmt.Sprintf("state of MAPQ queue %v was updated concurrently, expected version %v", request.State.QueueID, request.PreviousVersion),
+			}
+		}
+		return convertCommonErrors(m.db, "UpdateMapQState", err)
+	}
+	return nil
+}
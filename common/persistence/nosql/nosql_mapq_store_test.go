@@ -0,0 +1,118 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package nosql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+func setUpMocksForNoSQLMapQStore(t *testing.T) (*nosqlMapQStore, *nosqlplugin.MockDB) {
+	ctrl := gomock.NewController(t)
+	mockDB := nosqlplugin.NewMockDB(ctrl)
+	return &nosqlMapQStore{
+		nosqlStore: nosqlStore{
+			logger: log.NewNoop(),
+			db:     mockDB,
+		},
+	}, mockDB
+}
+
+func TestMapQStore(t *testing.T) {
+	ctx := context.Background()
+	items := []*persistence.MapQItem{{Partition: "*/timer", Offset: 11, Payload: []byte("payload")}}
+	state := &persistence.MapQState{
+		QueueID: "queue",
+		State:   &persistence.DataBlob{Encoding: constants.EncodingTypeJSON, Data: []byte("{}")},
+		Version: 3,
+	}
+
+	t.Run("enqueue items", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLMapQStore(t)
+		mockDB.EXPECT().InsertMapQItems(ctx, "queue", items).Return(nil).Times(1)
+		assert.NoError(t, store.EnqueueMapQItems(ctx, &persistence.EnqueueMapQItemsRequest{QueueID: "queue", Items: items}))
+	})
+
+	t.Run("enqueue items failure", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLMapQStore(t)
+		dbErr := errors.New("db error")
+		mockDB.EXPECT().InsertMapQItems(ctx, "queue", items).Return(dbErr).Times(1)
+		mockDB.EXPECT().IsNotFoundError(dbErr).Return(false).AnyTimes()
+		mockDB.EXPECT().IsTimeoutError(dbErr).Return(false).AnyTimes()
+		mockDB.EXPECT().IsDBUnavailableError(dbErr).Return(false).AnyTimes()
+		mockDB.EXPECT().IsThrottlingError(dbErr).Return(false).AnyTimes()
+		err := store.EnqueueMapQItems(ctx, &persistence.EnqueueMapQItemsRequest{QueueID: "queue", Items: items})
+		assert.IsType(t, &types.InternalServiceError{}, err)
+	})
+
+	t.Run("read items", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLMapQStore(t)
+		mockDB.EXPECT().SelectMapQItems(ctx, "queue", "*/timer", int64(10), int64(20), 100).Return(items, nil).Times(1)
+		resp, err := store.ReadMapQItems(ctx, &persistence.ReadMapQItemsRequest{
+			QueueID:            "queue",
+			Partition:          "*/timer",
+			ExclusiveMinOffset: 10,
+			InclusiveMaxOffset: 20,
+			PageSize:           100,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, items, resp.Items)
+	})
+
+	t.Run("delete items", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLMapQStore(t)
+		mockDB.EXPECT().DeleteMapQItems(ctx, "queue", "*/timer", int64(11)).Return(nil).Times(1)
+		assert.NoError(t, store.DeleteMapQItems(ctx, &persistence.DeleteMapQItemsRequest{QueueID: "queue", Partition: "*/timer", InclusiveMaxOffset: 11}))
+	})
+
+	t.Run("get state", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLMapQStore(t)
+		mockDB.EXPECT().SelectMapQState(ctx, "queue").Return(state, nil).Times(1)
+		resp, err := store.GetMapQState(ctx, &persistence.GetMapQStateRequest{QueueID: "queue"})
+		assert.NoError(t, err)
+		assert.Equal(t, state, resp.State)
+	})
+
+	t.Run("create state", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLMapQStore(t)
+		mockDB.EXPECT().InsertMapQState(ctx, state).Return(nil).Times(1)
+		assert.NoError(t, store.UpdateMapQState(ctx, &persistence.UpdateMapQStateRequest{State: state}))
+	})
+
+	t.Run("update state", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLMapQStore(t)
+		mockDB.EXPECT().UpdateMapQStateCas(ctx, state, int64(2)).Return(nosqlplugin.NewConditionFailure("mapq_state")).Times(1)
+		err := store.UpdateMapQState(ctx, &persistence.UpdateMapQStateRequest{State: state, PreviousVersion: 2})
+		assert.IsType(t, &persistence.ConditionFailedError{}, err)
+	})
+}
@@ -0,0 +1,165 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package nosql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+type nosqlShardDistributorStore struct {
+	nosqlStore
+}
+
+// NewNoSQLShardDistributorStore creates a shard distributor store backed by the default shard of the nosql store
+func NewNoSQLShardDistributorStore(
+	cfg config.ShardedNoSQL,
+	logger log.Logger,
+	metricsClient metrics.Client,
+	dc *persistence.DynamicConfiguration,
+) (persistence.ShardDistributorStore, error) {
+	shardedStore, err := newShardedNosqlStore(cfg, logger, metricsClient, dc)
+	if err != nil {
+		return nil, err
+	}
+	return &nosqlShardDistributorStore{
+		nosqlStore: shardedStore.GetDefaultShard(),
+	}, nil
+}
+
+func (m *nosqlShardDistributorStore) GetShardDistributorState(
+	ctx context.Context,
+	request *persistence.GetShardDistributorStateRequest,
+) (*persistence.GetShardDistributorStateResponse, error) {
+	row, err := m.db.SelectShardDistributorNamespace(ctx, request.Namespace)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "GetShardDistributorState", err)
+	}
+	if row == nil {
+		return &persistence.GetShardDistributorStateResponse{
+			Executors: map[string]*persistence.ShardDistributorExecutor{},
+		}, nil
+	}
+	return &persistence.GetShardDistributorStateResponse{
+		Leader:    row.Leader,
+		Executors: row.Executors,
+		Revision:  row.Revision,
+	}, nil
+}
+
+func (m *nosqlShardDistributorStore) UpdateShardDistributorLeader(
+	ctx context.Context,
+	request *persistence.UpdateShardDistributorLeaderRequest,
+) error {
+	if request.PreviousVersion == 0 {
+		if err := m.createNamespace(ctx, request.Namespace); err != nil {
+			return err
+		}
+	}
+	err := m.db.UpdateShardDistributorLeaderCas(ctx, request.Namespace, request.Leader, request.PreviousVersion)
+	if err != nil {
+		if _, ok := err.(*nosqlplugin.ConditionFailure); ok {
+			return &persistence.ConditionFailedError{
+				Msg: fmt.Sprintf("leader of shard distributor namespace %v was updated concurrently, expected version %v", request.Namespace, request.PreviousVersion),
+			}
+		}
+		return convertCommonErrors(m.db, "UpdateShardDistributorLeader", err)
+	}
+	return nil
+}
+
+func (m *nosqlShardDistributorStore) UpsertShardDistributorExecutor(
+	ctx context.Context,
+	request *persistence.UpsertShardDistributorExecutorRequest,
+) error {
+	return m.updateExecutors(ctx, "UpsertShardDistributorExecutor", &nosqlplugin.ShardDistributorExecutorsWriteRequest{
+		Namespace:        request.Namespace,
+		PreviousRevision: request.PreviousRevision,
+		UpsertedExecutor: request.Executor,
+	})
+}
+
+func (m *nosqlShardDistributorStore) AssignShardDistributorShards(
+	ctx context.Context,
+	request *persistence.AssignShardDistributorShardsRequest,
+) error {
+	return m.updateExecutors(ctx, "AssignShardDistributorShards", &nosqlplugin.ShardDistributorExecutorsWriteRequest{
+		Namespace:        request.Namespace,
+		PreviousRevision: request.PreviousRevision,
+		LeaderVersion:    request.LeaderVersion,
+		AssignedShards:   request.AssignedShards,
+	})
+}
+
+func (m *nosqlShardDistributorStore) DeleteShardDistributorExecutors(
+	ctx context.Context,
+	request *persistence.DeleteShardDistributorExecutorsRequest,
+) error {
+	return m.updateExecutors(ctx, "DeleteShardDistributorExecutors", &nosqlplugin.ShardDistributorExecutorsWriteRequest{
+		Namespace:          request.Namespace,
+		PreviousRevision:   request.PreviousRevision,
+		LeaderVersion:      request.LeaderVersion,
+		DeletedExecutorIDs: request.ExecutorIDs,
+	})
+}
+
+func (m *nosqlShardDistributorStore) updateExecutors(
+	ctx context.Context,
+	operation string,
+	request *nosqlplugin.ShardDistributorExecutorsWriteRequest,
+) error {
+	if request.PreviousRevision == 0 {
+		if err := m.createNamespace(ctx, request.Namespace); err != nil {
+			return err
+		}
+	}
+	err := m.db.UpdateShardDistributorExecutorsCas(ctx, request)
+	if err != nil {
+		if _, ok := err.(*nosqlplugin.ConditionFailure); ok {
+			return &persistence.ConditionFailedError{
+				Msg: fmt.Sprintf("executors of shard distributor namespace %v were updated concurrently, expected revision %v and leader version %v",
+					request.Namespace, request.PreviousRevision, request.LeaderVersion),
+			}
+		}
+		return convertCommonErrors(m.db, operation, err)
+	}
+	return nil
+}
+
+// createNamespace creates the namespace row on its first write, the row may already exist
+func (m *nosqlShardDistributorStore) createNamespace(ctx context.Context, namespace string) error {
+	err := m.db.InsertShardDistributorNamespace(ctx, namespace)
+	if err != nil {
+		if _, ok := err.(*nosqlplugin.ConditionFailure); ok {
+			return nil
+		}
+		return convertCommonErrors(m.db, "CreateShardDistributorNamespace", err)
+	}
+	return nil
+}
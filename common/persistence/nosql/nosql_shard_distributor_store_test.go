@@ -0,0 +1,160 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package nosql
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+func setUpMocksForNoSQLShardDistributorStore(t *testing.T) (*nosqlShardDistributorStore, *nosqlplugin.MockDB) {
+	ctrl := gomock.NewController(t)
+	mockDB := nosqlplugin.NewMockDB(ctrl)
+	return &nosqlShardDistributorStore{
+		nosqlStore: nosqlStore{
+			logger: log.NewNoop(),
+			db:     mockDB,
+		},
+	}, mockDB
+}
+
+func TestShardDistributorStore(t *testing.T) {
+	ctx := context.Background()
+	leader := &persistence.ShardDistributorLeader{LeaderID: "leader", LeaseExpiry: time.Unix(100, 0), Version: 3}
+	executor := &persistence.ShardDistributorExecutor{
+		ExecutorID:     "executor-1",
+		LastHeartbeat:  time.Unix(90, 0),
+		State:          "ACTIVE",
+		ReportedShards: &persistence.DataBlob{Encoding: constants.EncodingTypeJSON, Data: []byte("{}")},
+	}
+
+	t.Run("get state", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLShardDistributorStore(t)
+		row := &nosqlplugin.ShardDistributorNamespaceRow{
+			Namespace: "ns",
+			Leader:    leader,
+			Executors: map[string]*persistence.ShardDistributorExecutor{"executor-1": executor},
+			Revision:  5,
+		}
+		mockDB.EXPECT().SelectShardDistributorNamespace(ctx, "ns").Return(row, nil).Times(1)
+		resp, err := store.GetShardDistributorState(ctx, &persistence.GetShardDistributorStateRequest{Namespace: "ns"})
+		assert.NoError(t, err)
+		assert.Equal(t, &persistence.GetShardDistributorStateResponse{Leader: leader, Executors: row.Executors, Revision: 5}, resp)
+	})
+
+	t.Run("get state of new namespace", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLShardDistributorStore(t)
+		mockDB.EXPECT().SelectShardDistributorNamespace(ctx, "ns").Return(nil, nil).Times(1)
+		resp, err := store.GetShardDistributorState(ctx, &persistence.GetShardDistributorStateRequest{Namespace: "ns"})
+		assert.NoError(t, err)
+		assert.Nil(t, resp.Leader)
+		assert.Empty(t, resp.Executors)
+		assert.Equal(t, int64(0), resp.Revision)
+	})
+
+	t.Run("get state failure", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLShardDistributorStore(t)
+		dbErr := errors.New("db error")
+		mockDB.EXPECT().SelectShardDistributorNamespace(ctx, "ns").Return(nil, dbErr).Times(1)
+		mockDB.EXPECT().IsNotFoundError(dbErr).Return(false).AnyTimes()
+		mockDB.EXPECT().IsTimeoutError(dbErr).Return(false).AnyTimes()
+		mockDB.EXPECT().IsDBUnavailableError(dbErr).Return(false).AnyTimes()
+		mockDB.EXPECT().IsThrottlingError(dbErr).Return(false).AnyTimes()
+		_, err := store.GetShardDistributorState(ctx, &persistence.GetShardDistributorStateRequest{Namespace: "ns"})
+		assert.IsType(t, &types.InternalServiceError{}, err)
+	})
+
+	t.Run("acquire first leadership", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLShardDistributorStore(t)
+		first := &persistence.ShardDistributorLeader{LeaderID: "leader", LeaseExpiry: time.Unix(100, 0), Version: 1}
+		gomock.InOrder(
+			mockDB.EXPECT().InsertShardDistributorNamespace(ctx, "ns").Return(nosqlplugin.NewConditionFailure("shard_distributor")),
+			mockDB.EXPECT().UpdateShardDistributorLeaderCas(ctx, "ns", first, int64(0)).Return(nil),
+		)
+		assert.NoError(t, store.UpdateShardDistributorLeader(ctx, &persistence.UpdateShardDistributorLeaderRequest{Namespace: "ns", Leader: first}))
+	})
+
+	t.Run("update leader conflict", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLShardDistributorStore(t)
+		mockDB.EXPECT().UpdateShardDistributorLeaderCas(ctx, "ns", leader, int64(2)).Return(nosqlplugin.NewConditionFailure("shard_distributor")).Times(1)
+		err := store.UpdateShardDistributorLeader(ctx, &persistence.UpdateShardDistributorLeaderRequest{Namespace: "ns", Leader: leader, PreviousVersion: 2})
+		assert.IsType(t, &persistence.ConditionFailedError{}, err)
+	})
+
+	t.Run("upsert executor of new namespace", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLShardDistributorStore(t)
+		gomock.InOrder(
+			mockDB.EXPECT().InsertShardDistributorNamespace(ctx, "ns").Return(nil),
+			mockDB.EXPECT().UpdateShardDistributorExecutorsCas(ctx, &nosqlplugin.ShardDistributorExecutorsWriteRequest{
+				Namespace:        "ns",
+				UpsertedExecutor: executor,
+			}).Return(nil),
+		)
+		assert.NoError(t, store.UpsertShardDistributorExecutor(ctx, &persistence.UpsertShardDistributorExecutorRequest{Namespace: "ns", Executor: executor}))
+	})
+
+	t.Run("assign shards", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLShardDistributorStore(t)
+		assigned := map[string]*persistence.DataBlob{"executor-1": {Encoding: constants.EncodingTypeJSON, Data: []byte("{}")}}
+		mockDB.EXPECT().UpdateShardDistributorExecutorsCas(ctx, &nosqlplugin.ShardDistributorExecutorsWriteRequest{
+			Namespace:        "ns",
+			PreviousRevision: 4,
+			LeaderVersion:    3,
+			AssignedShards:   assigned,
+		}).Return(nil).Times(1)
+		assert.NoError(t, store.AssignShardDistributorShards(ctx, &persistence.AssignShardDistributorShardsRequest{
+			Namespace:        "ns",
+			AssignedShards:   assigned,
+			LeaderVersion:    3,
+			PreviousRevision: 4,
+		}))
+	})
+
+	t.Run("delete executors conflict", func(t *testing.T) {
+		store, mockDB := setUpMocksForNoSQLShardDistributorStore(t)
+		mockDB.EXPECT().UpdateShardDistributorExecutorsCas(ctx, &nosqlplugin.ShardDistributorExecutorsWriteRequest{
+			Namespace:          "ns",
+			PreviousRevision:   4,
+			LeaderVersion:      3,
+			DeletedExecutorIDs: []string{"executor-1"},
+		}).Return(nosqlplugin.NewConditionFailure("shard_distributor")).Times(1)
+		err := store.DeleteShardDistributorExecutors(ctx, &persistence.DeleteShardDistributorExecutorsRequest{
+			Namespace:        "ns",
+			ExecutorIDs:      []string{"executor-1"},
+			LeaderVersion:    3,
+			PreviousRevision: 4,
+		})
+		assert.IsType(t, &persistence.ConditionFailedError{}, err)
+	})
+}
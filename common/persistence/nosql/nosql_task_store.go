@@ -154,6 +154,8 @@ func (t *nosqlTaskStore) LeaseTaskList(
 			LastUpdatedTime:         currentTimeStamp,
 			CurrentTimeStamp:        currentTimeStamp,
 			AdaptivePartitionConfig: currTL.AdaptivePartitionConfig,
+			VersioningConfig:        currTL.VersioningConfig,
+			MigrationConfig:         currTL.MigrationConfig,
 		}, currTL.RangeID-1)
 	}
 	if err != nil {
@@ -175,6 +177,8 @@ func (t *nosqlTaskStore) LeaseTaskList(
 		Kind:                    request.TaskListKind,
 		LastUpdated:             currentTimeStamp,
 		AdaptivePartitionConfig: currTL.AdaptivePartitionConfig,
+		VersioningConfig:        currTL.VersioningConfig,
+		MigrationConfig:         currTL.MigrationConfig,
 	}
 	return &persistence.LeaseTaskListResponse{TaskListInfo: tli}, nil
 }
@@ -204,6 +208,8 @@ func (t *nosqlTaskStore) GetTaskList(
 		Kind:                    currTL.TaskListKind,
 		LastUpdated:             currTL.LastUpdatedTime,
 		AdaptivePartitionConfig: currTL.AdaptivePartitionConfig,
+		VersioningConfig:        currTL.VersioningConfig,
+		MigrationConfig:         currTL.MigrationConfig,
 	}
 	return &persistence.GetTaskListResponse{TaskListInfo: tli}, nil
 }
@@ -224,6 +230,8 @@ func (t *nosqlTaskStore) UpdateTaskList(
 		LastUpdatedTime:         request.CurrentTimeStamp,
 		CurrentTimeStamp:        request.CurrentTimeStamp,
 		AdaptivePartitionConfig: tli.AdaptivePartitionConfig,
+		VersioningConfig:        tli.VersioningConfig,
+		MigrationConfig:         tli.MigrationConfig,
 	}
 	storeShard, err := t.GetStoreShardByTaskList(tli.DomainID, tli.Name, tli.TaskType)
 	if err != nil {
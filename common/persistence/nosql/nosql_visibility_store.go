@@ -136,6 +136,8 @@ func (v *nosqlVisibilityStore) RecordWorkflowExecutionUninitialized(
 	return nil
 }
 
+// UpsertWorkflowExecution only refreshes the memo of the open visibility record,
+// search attributes are not supported by database visibility
 func (v *nosqlVisibilityStore) UpsertWorkflowExecution(
 	ctx context.Context,
 	request *persistence.InternalUpsertWorkflowExecutionRequest,
@@ -143,7 +145,31 @@ func (v *nosqlVisibilityStore) UpsertWorkflowExecution(
 	if persistence.IsNopUpsertWorkflowRequest(request) {
 		return nil
 	}
-	return persistence.ErrVisibilityOperationNotSupported
+	if len(request.Memo.GetData()) == 0 {
+		return persistence.ErrVisibilityOperationNotSupported
+	}
+
+	// keep the open record expiring at the same time as it was set to when the workflow started
+	elapsed := request.UpdateTimestamp.Sub(request.StartTimestamp)
+	ttl := int64(request.WorkflowTimeout.Seconds()) + openExecutionTTLBuffer - int64(elapsed.Seconds())
+	if ttl <= 0 {
+		return nil
+	}
+
+	err := v.db.UpdateVisibilityMemo(ctx, ttl, &nosqlplugin.VisibilityRowForUpdate{
+		DomainID: request.DomainUUID,
+		VisibilityRow: nosqlplugin.VisibilityRow{
+			WorkflowID: request.WorkflowID,
+			RunID:      request.RunID,
+			StartTime:  request.StartTimestamp,
+			Memo:       request.Memo,
+			UpdateTime: request.UpdateTimestamp,
+		},
+	})
+	if err != nil {
+		return convertCommonErrors(v.db, "UpsertWorkflowExecution", err)
+	}
+	return nil
 }
 
 func (v *nosqlVisibilityStore) ListOpenWorkflowExecutions(
@@ -31,6 +31,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/metrics"
@@ -168,7 +169,7 @@ func TestRecordWorkflowExecutionUninitialized(t *testing.T) {
 }
 
 func TestUpsertWorkflowExecution(t *testing.T) {
-	visibilityStore, _ := setupNoSQLVisibilityStoreMocks(t)
+	visibilityStore, db := setupNoSQLVisibilityStoreMocks(t)
 
 	err := visibilityStore.UpsertWorkflowExecution(context.Background(), &persistence.InternalUpsertWorkflowExecutionRequest{
 		SearchAttributes: map[string][]byte{
@@ -182,6 +183,41 @@ func TestUpsertWorkflowExecution(t *testing.T) {
 
 	assert.Error(t, err)
 	assert.Equal(t, persistence.ErrVisibilityOperationNotSupported, err)
+
+	now := time.Now()
+	memo := &persistence.DataBlob{Encoding: constants.EncodingTypeThriftRW, Data: []byte("memo")}
+	db.EXPECT().UpdateVisibilityMemo(gomock.Any(), int64(3600)+openExecutionTTLBuffer-60, &nosqlplugin.VisibilityRowForUpdate{
+		DomainID: testDomainID,
+		VisibilityRow: nosqlplugin.VisibilityRow{
+			WorkflowID: testWorkflowID,
+			RunID:      testRunID,
+			StartTime:  now.Add(-time.Minute),
+			Memo:       memo,
+			UpdateTime: now,
+		},
+	}).Return(nil)
+
+	err = visibilityStore.UpsertWorkflowExecution(context.Background(), &persistence.InternalUpsertWorkflowExecutionRequest{
+		DomainUUID:      testDomainID,
+		WorkflowID:      testWorkflowID,
+		RunID:           testRunID,
+		StartTimestamp:  now.Add(-time.Minute),
+		WorkflowTimeout: time.Hour,
+		Memo:            memo,
+		UpdateTimestamp: now,
+	})
+
+	assert.NoError(t, err)
+
+	// open record has already expired
+	err = visibilityStore.UpsertWorkflowExecution(context.Background(), &persistence.InternalUpsertWorkflowExecutionRequest{
+		StartTimestamp:  now.Add(-72 * time.Hour),
+		WorkflowTimeout: time.Hour,
+		Memo:            memo,
+		UpdateTimestamp: now,
+	})
+
+	assert.NoError(t, err)
 }
 
 func TestListOpenWorkflowExecutions_Success(t *testing.T) {
@@ -0,0 +1,269 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cassandra
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql"
+	"github.com/uber/cadence/common/types"
+)
+
+// InsertAsyncRequest creates or overwrites the status row of an async request
+func (db *cdb) InsertAsyncRequest(ctx context.Context, row *persistence.AsyncRequestInfo, ttlSeconds int64) error {
+	if ttlSeconds < 0 {
+		ttlSeconds = 0
+	}
+	query := db.session.Query(templateInsertAsyncRequestQuery,
+		row.DomainID,
+		row.RequestID,
+		row.WorkflowID,
+		row.RunID,
+		int32(row.State),
+		row.Failure,
+		row.CreatedTime,
+		row.LastUpdatedTime,
+		row.ExpiryTime,
+		ttlSeconds,
+	).WithContext(ctx)
+	return query.Exec()
+}
+
+// SelectAsyncRequest returns the status row of an async request
+func (db *cdb) SelectAsyncRequest(ctx context.Context, domainID, requestID string) (*persistence.AsyncRequestInfo, error) {
+	query := db.session.Query(templateSelectAsyncRequestQuery, domainID, requestID).WithContext(ctx)
+	result := make(map[string]interface{})
+	if err := query.MapScan(result); err != nil {
+		return nil, err
+	}
+
+	return &persistence.AsyncRequestInfo{
+		DomainID:        result["domain_id"].(gocql.UUID).String(),
+		RequestID:       result["request_id"].(string),
+		WorkflowID:      result["workflow_id"].(string),
+		RunID:           result["run_id"].(string),
+		State:           types.AsyncRequestState(result["state"].(int)),
+		Failure:         result["failure"].(string),
+		CreatedTime:     result["created_time"].(time.Time),
+		LastUpdatedTime: result["last_updated_time"].(time.Time),
+		ExpiryTime:      result["expiry_time"].(time.Time),
+	}, nil
+}
+
+// InsertAsyncRequestDLQEntry creates or overwrites a DLQ entry
+func (db *cdb) InsertAsyncRequestDLQEntry(ctx context.Context, row *persistence.AsyncRequestDLQEntry) error {
+	query := db.session.Query(templateInsertAsyncRequestDLQEntryQuery,
+		row.DomainID,
+		row.RequestID,
+		row.RequestType,
+		row.WorkflowID,
+		row.Failure,
+		row.Payload,
+		row.CreatedTime,
+	).WithContext(ctx)
+	return query.Exec()
+}
+
+// SelectAsyncRequestDLQEntries pages through the DLQ entries of a domain
+func (db *cdb) SelectAsyncRequestDLQEntries(
+	ctx context.Context,
+	domainID string,
+	pageSize int,
+	pageToken []byte,
+) ([]*persistence.AsyncRequestDLQEntry, []byte, error) {
+	query := db.session.Query(templateSelectAsyncRequestDLQEntriesQuery, domainID).PageSize(pageSize).PageState(pageToken).WithContext(ctx)
+	iter := query.Iter()
+	if iter == nil {
+		return nil, nil, fmt.Errorf("SelectAsyncRequestDLQEntries operation failed. Not able to create query iterator")
+	}
+
+	var entries []*persistence.AsyncRequestDLQEntry
+	result := make(map[string]interface{})
+	for iter.MapScan(result) {
+		entries = append(entries, asyncRequestDLQEntryFromMap(result))
+		result = make(map[string]interface{})
+	}
+
+	nextPageToken := getNextPageToken(iter)
+	if err := iter.Close(); err != nil {
+		return nil, nil, err
+	}
+	return entries, nextPageToken, nil
+}
+
+// SelectAsyncRequestDLQEntry returns a single DLQ entry
+func (db *cdb) SelectAsyncRequestDLQEntry(ctx context.Context, domainID, requestID string) (*persistence.AsyncRequestDLQEntry, error) {
+	query := db.session.Query(templateSelectAsyncRequestDLQEntryQuery, domainID, requestID).WithContext(ctx)
+	result := make(map[string]interface{})
+	if err := query.MapScan(result); err != nil {
+		return nil, err
+	}
+	return asyncRequestDLQEntryFromMap(result), nil
+}
+
+// DeleteAsyncRequestDLQEntry removes a single DLQ entry
+func (db *cdb) DeleteAsyncRequestDLQEntry(ctx context.Context, domainID, requestID string) error {
+	query := db.session.Query(templateDeleteAsyncRequestDLQEntryQuery, domainID, requestID).WithContext(ctx)
+	return db.executeWithConsistencyAll(query)
+}
+
+// InsertAsyncRequestQueueMessage inserts a message into a queue
+// Returns ConditionFailure error if a message with the same ID already exists
+func (db *cdb) InsertAsyncRequestQueueMessage(ctx context.Context, queueName string, row *persistence.AsyncRequestQueueMessage) error {
+	query := db.session.Query(templateInsertAsyncRequestQueueMessageQuery,
+		queueName,
+		row.MessageID,
+		row.Payload,
+		row.CreatedTime,
+	).WithContext(ctx)
+	previous := make(map[string]interface{})
+	applied, err := query.MapScanCAS(previous)
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return nosqlplugin.NewConditionFailure("async_request_queue")
+	}
+	return nil
+}
+
+// SelectLastAsyncRequestQueueMessageID returns the ID of the last message of a queue
+func (db *cdb) SelectLastAsyncRequestQueueMessageID(ctx context.Context, queueName string) (int64, error) {
+	query := db.session.Query(templateSelectLastAsyncRequestQueueMessageIDQuery, queueName).WithContext(ctx)
+	result := make(map[string]interface{})
+	if err := query.MapScan(result); err != nil {
+		return 0, err
+	}
+	return result["message_id"].(int64), nil
+}
+
+// SelectAsyncRequestQueueMessages reads messages of a queue starting from the exclusiveBeginMessageID
+func (db *cdb) SelectAsyncRequestQueueMessages(
+	ctx context.Context,
+	queueName string,
+	exclusiveBeginMessageID int64,
+	maxRows int,
+) ([]*persistence.AsyncRequestQueueMessage, error) {
+	query := db.session.Query(templateSelectAsyncRequestQueueMessagesQuery, queueName, exclusiveBeginMessageID, maxRows).WithContext(ctx)
+	iter := query.Iter()
+	if iter == nil {
+		return nil, fmt.Errorf("SelectAsyncRequestQueueMessages operation failed. Not able to create query iterator")
+	}
+
+	var messages []*persistence.AsyncRequestQueueMessage
+	result := make(map[string]interface{})
+	for iter.MapScan(result) {
+		messages = append(messages, &persistence.AsyncRequestQueueMessage{
+			MessageID:   result["message_id"].(int64),
+			Payload:     result["payload"].([]byte),
+			CreatedTime: result["created_time"].(time.Time),
+		})
+		result = make(map[string]interface{})
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// DeleteAsyncRequestQueueMessages removes all messages of a queue up to and including inclusiveEndMessageID
+func (db *cdb) DeleteAsyncRequestQueueMessages(ctx context.Context, queueName string, inclusiveEndMessageID int64) error {
+	query := db.session.Query(templateDeleteAsyncRequestQueueMessagesQuery, queueName, inclusiveEndMessageID).WithContext(ctx)
+	return db.executeWithConsistencyAll(query)
+}
+
+// InsertAsyncRequestQueueMetadata creates the metadata row of a queue
+// Returns ConditionFailure error if the row already exists
+func (db *cdb) InsertAsyncRequestQueueMetadata(ctx context.Context, row *persistence.AsyncRequestQueueMetadata) error {
+	query := db.session.Query(templateInsertAsyncRequestQueueMetadataQuery,
+		row.QueueName,
+		row.AckLevel,
+		row.LeaseOwner,
+		row.LeaseExpiry,
+		row.Version,
+	).WithContext(ctx)
+	previous := make(map[string]interface{})
+	applied, err := query.MapScanCAS(previous)
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return nosqlplugin.NewConditionFailure("async_request_queue_metadata")
+	}
+	return nil
+}
+
+// UpdateAsyncRequestQueueMetadataCas updates the metadata row of a queue if its version is previousVersion
+// Returns ConditionFailure error if the condition is not met
+func (db *cdb) UpdateAsyncRequestQueueMetadataCas(ctx context.Context, row *persistence.AsyncRequestQueueMetadata, previousVersion int64) error {
+	query := db.session.Query(templateUpdateAsyncRequestQueueMetadataQuery,
+		row.AckLevel,
+		row.LeaseOwner,
+		row.LeaseExpiry,
+		row.Version,
+		row.QueueName,
+		previousVersion,
+	).WithContext(ctx)
+	previous := make(map[string]interface{})
+	applied, err := query.MapScanCAS(previous)
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return nosqlplugin.NewConditionFailure("async_request_queue_metadata")
+	}
+	return nil
+}
+
+// SelectAsyncRequestQueueMetadata returns the metadata row of a queue
+func (db *cdb) SelectAsyncRequestQueueMetadata(ctx context.Context, queueName string) (*persistence.AsyncRequestQueueMetadata, error) {
+	query := db.session.Query(templateSelectAsyncRequestQueueMetadataQuery, queueName).WithContext(ctx)
+	result := make(map[string]interface{})
+	if err := query.MapScan(result); err != nil {
+		return nil, err
+	}
+	return &persistence.AsyncRequestQueueMetadata{
+		QueueName:   result["queue_name"].(string),
+		AckLevel:    result["ack_level"].(int64),
+		LeaseOwner:  result["lease_owner"].(string),
+		LeaseExpiry: result["lease_expiry"].(time.Time),
+		Version:     result["version"].(int64),
+	}, nil
+}
+
+func asyncRequestDLQEntryFromMap(result map[string]interface{}) *persistence.AsyncRequestDLQEntry {
+	return &persistence.AsyncRequestDLQEntry{
+		DomainID:    result["domain_id"].(gocql.UUID).String(),
+		RequestID:   result["request_id"].(string),
+		RequestType: int32(result["request_type"].(int)),
+		WorkflowID:  result["workflow_id"].(string),
+		Failure:     result["failure"].(string),
+		Payload:     result["payload"].([]byte),
+		CreatedTime: result["created_time"].(time.Time),
+	}
+}
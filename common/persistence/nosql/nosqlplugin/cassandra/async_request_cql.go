@@ -0,0 +1,73 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cassandra
+
+const (
+	templateInsertAsyncRequestQuery = `INSERT INTO async_requests (` +
+		`domain_id, request_id, workflow_id, run_id, state, failure, created_time, last_updated_time, expiry_time) ` +
+		`VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?) USING TTL ?`
+
+	templateSelectAsyncRequestQuery = `SELECT domain_id, request_id, workflow_id, run_id, state, failure, created_time, last_updated_time, expiry_time ` +
+		`FROM async_requests ` +
+		`WHERE domain_id = ? and request_id = ?`
+
+	templateInsertAsyncRequestDLQEntryQuery = `INSERT INTO async_request_dlq (` +
+		`domain_id, request_id, request_type, workflow_id, failure, payload, created_time) ` +
+		`VALUES(?, ?, ?, ?, ?, ?, ?)`
+
+	templateSelectAsyncRequestDLQEntriesQuery = `SELECT domain_id, request_id, request_type, workflow_id, failure, payload, created_time ` +
+		`FROM async_request_dlq ` +
+		`WHERE domain_id = ?`
+
+	templateSelectAsyncRequestDLQEntryQuery = templateSelectAsyncRequestDLQEntriesQuery + ` and request_id = ?`
+
+	templateDeleteAsyncRequestDLQEntryQuery = `DELETE FROM async_request_dlq ` +
+		`WHERE domain_id = ? and request_id = ?`
+
+	templateInsertAsyncRequestQueueMessageQuery = `INSERT INTO async_request_queue (` +
+		`queue_name, message_id, payload, created_time) ` +
+		`VALUES(?, ?, ?, ?) IF NOT EXISTS`
+
+	templateSelectLastAsyncRequestQueueMessageIDQuery = `SELECT message_id ` +
+		`FROM async_request_queue ` +
+		`WHERE queue_name = ? ORDER BY message_id DESC LIMIT 1`
+
+	templateSelectAsyncRequestQueueMessagesQuery = `SELECT message_id, payload, created_time ` +
+		`FROM async_request_queue ` +
+		`WHERE queue_name = ? and message_id > ? LIMIT ?`
+
+	templateDeleteAsyncRequestQueueMessagesQuery = `DELETE FROM async_request_queue ` +
+		`WHERE queue_name = ? and message_id <= ?`
+
+	templateInsertAsyncRequestQueueMetadataQuery = `INSERT INTO async_request_queue_metadata (` +
+		`queue_name, ack_level, lease_owner, lease_expiry, version) ` +
+		`VALUES(?, ?, ?, ?, ?) IF NOT EXISTS`
+
+	templateUpdateAsyncRequestQueueMetadataQuery = `UPDATE async_request_queue_metadata ` +
+		`SET ack_level = ?, lease_owner = ?, lease_expiry = ?, version = ? ` +
+		`WHERE queue_name = ? IF version = ?`
+
+	templateSelectAsyncRequestQueueMetadataQuery = `SELECT queue_name, ack_level, lease_owner, lease_expiry, version ` +
+		`FROM async_request_queue_metadata ` +
+		`WHERE queue_name = ?`
+)
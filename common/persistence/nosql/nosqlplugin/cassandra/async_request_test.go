@@ -0,0 +1,536 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cassandra
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestInsertAsyncRequest(t *testing.T) {
+	ts := time.Date(2025, 1, 6, 15, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		ttlSeconds  int64
+		queryMockFn func(query *gocql.MockQuery)
+		wantQueries []string
+		wantErr     bool
+	}{
+		{
+			name:       "success",
+			ttlSeconds: 3600,
+			queryMockFn: func(query *gocql.MockQuery) {
+				query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+				query.EXPECT().Exec().Return(nil).Times(1)
+			},
+			wantQueries: []string{
+				`INSERT INTO async_requests (domain_id, request_id, workflow_id, run_id, state, failure, created_time, last_updated_time, expiry_time) ` +
+					`VALUES(domain-id, request-id, workflow-id, run-id, 2, , 2025-01-06T15:00:00Z, 2025-01-06T15:00:00Z, 2025-01-06T15:00:00Z) USING TTL 3600`,
+			},
+		},
+		{
+			name:       "negative ttl is not applied",
+			ttlSeconds: -10,
+			queryMockFn: func(query *gocql.MockQuery) {
+				query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+				query.EXPECT().Exec().Return(nil).Times(1)
+			},
+			wantQueries: []string{
+				`INSERT INTO async_requests (domain_id, request_id, workflow_id, run_id, state, failure, created_time, last_updated_time, expiry_time) ` +
+					`VALUES(domain-id, request-id, workflow-id, run-id, 2, , 2025-01-06T15:00:00Z, 2025-01-06T15:00:00Z, 2025-01-06T15:00:00Z) USING TTL 0`,
+			},
+		},
+		{
+			name: "exec failed",
+			queryMockFn: func(query *gocql.MockQuery) {
+				query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+				query.EXPECT().Exec().Return(errors.New("exec failed")).Times(1)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			query := gocql.NewMockQuery(ctrl)
+			tc.queryMockFn(query)
+			session := &fakeSession{
+				query: query,
+			}
+			client := gocql.NewMockClient(ctrl)
+			db := newCassandraDBFromSession(&config.NoSQL{}, session, testlogger.New(t), &persistence.DynamicConfiguration{}, dbWithClient(client))
+
+			err := db.InsertAsyncRequest(context.Background(), &persistence.AsyncRequestInfo{
+				DomainID:        "domain-id",
+				RequestID:       "request-id",
+				WorkflowID:      "workflow-id",
+				RunID:           "run-id",
+				State:           types.AsyncRequestStateSucceeded,
+				CreatedTime:     ts,
+				LastUpdatedTime: ts,
+				ExpiryTime:      ts,
+			}, tc.ttlSeconds)
+
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Got error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.wantQueries, session.queries); diff != "" {
+				t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSelectAsyncRequest(t *testing.T) {
+	ts := time.Date(2025, 1, 6, 15, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		queryMockFn func(query *gocql.MockQuery)
+		wantQueries []string
+		wantRow     *persistence.AsyncRequestInfo
+		wantErr     bool
+	}{
+		{
+			name: "success",
+			queryMockFn: func(query *gocql.MockQuery) {
+				query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+				query.EXPECT().MapScan(gomock.Any()).DoAndReturn(func(m map[string]interface{}) error {
+					m["domain_id"] = &fakeUUID{uuid: "domain-id"}
+					m["request_id"] = "request-id"
+					m["workflow_id"] = "workflow-id"
+					m["run_id"] = ""
+					m["state"] = 3
+					m["failure"] = "bad request"
+					m["created_time"] = ts
+					m["last_updated_time"] = ts
+					m["expiry_time"] = ts
+					return nil
+				}).Times(1)
+			},
+			wantQueries: []string{
+				`SELECT domain_id, request_id, workflow_id, run_id, state, failure, created_time, last_updated_time, expiry_time FROM async_requests WHERE domain_id = domain-id and request_id = request-id`,
+			},
+			wantRow: &persistence.AsyncRequestInfo{
+				DomainID:        "domain-id",
+				RequestID:       "request-id",
+				WorkflowID:      "workflow-id",
+				State:           types.AsyncRequestStateFailed,
+				Failure:         "bad request",
+				CreatedTime:     ts,
+				LastUpdatedTime: ts,
+				ExpiryTime:      ts,
+			},
+		},
+		{
+			name: "mapscan failed",
+			queryMockFn: func(query *gocql.MockQuery) {
+				query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+				query.EXPECT().MapScan(gomock.Any()).Return(errors.New("mapscan failed")).Times(1)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			query := gocql.NewMockQuery(ctrl)
+			tc.queryMockFn(query)
+			session := &fakeSession{
+				query: query,
+			}
+			client := gocql.NewMockClient(ctrl)
+			db := newCassandraDBFromSession(&config.NoSQL{}, session, testlogger.New(t), &persistence.DynamicConfiguration{}, dbWithClient(client))
+
+			row, err := db.SelectAsyncRequest(context.Background(), "domain-id", "request-id")
+
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Got error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.wantRow, row); diff != "" {
+				t.Fatalf("Row mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantQueries, session.queries); diff != "" {
+				t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAsyncRequestDLQ(t *testing.T) {
+	ts := time.Date(2025, 1, 6, 15, 0, 0, 0, time.UTC)
+	entry := &persistence.AsyncRequestDLQEntry{
+		DomainID:    "domain-id",
+		RequestID:   "request-id",
+		RequestType: 1,
+		WorkflowID:  "workflow-id",
+		Failure:     "bad request",
+		Payload:     []byte("abc"),
+		CreatedTime: ts,
+	}
+	entryMap := func() map[string]interface{} {
+		return map[string]interface{}{
+			"domain_id":    &fakeUUID{uuid: "domain-id"},
+			"request_id":   "request-id",
+			"request_type": 1,
+			"workflow_id":  "workflow-id",
+			"failure":      "bad request",
+			"payload":      []byte("abc"),
+			"created_time": ts,
+		}
+	}
+
+	t.Run("insert", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().Exec().Return(nil).Times(1)
+		session := &fakeSession{query: query}
+		db := newCassandraDBFromSession(&config.NoSQL{}, session, testlogger.New(t), &persistence.DynamicConfiguration{}, dbWithClient(gocql.NewMockClient(ctrl)))
+
+		if err := db.InsertAsyncRequestDLQEntry(context.Background(), entry); err != nil {
+			t.Fatalf("InsertAsyncRequestDLQEntry failed: %v", err)
+		}
+		want := []string{
+			`INSERT INTO async_request_dlq (domain_id, request_id, request_type, workflow_id, failure, payload, created_time) ` +
+				`VALUES(domain-id, request-id, 1, workflow-id, bad request, [97 98 99], 2025-01-06T15:00:00Z)`,
+		}
+		if diff := cmp.Diff(want, session.queries); diff != "" {
+			t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("select page", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		iter := &fakeIter{
+			mapScanInputs: []map[string]interface{}{entryMap()},
+			pageState:     []byte("next"),
+		}
+		query.EXPECT().PageSize(10).Return(query).Times(1)
+		query.EXPECT().PageState([]byte("token")).Return(query).Times(1)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().Iter().Return(iter).Times(1)
+		session := &fakeSession{query: query}
+		db := newCassandraDBFromSession(&config.NoSQL{}, session, testlogger.New(t), &persistence.DynamicConfiguration{}, dbWithClient(gocql.NewMockClient(ctrl)))
+
+		entries, token, err := db.SelectAsyncRequestDLQEntries(context.Background(), "domain-id", 10, []byte("token"))
+		if err != nil {
+			t.Fatalf("SelectAsyncRequestDLQEntries failed: %v", err)
+		}
+		if diff := cmp.Diff([]*persistence.AsyncRequestDLQEntry{entry}, entries); diff != "" {
+			t.Fatalf("Entries mismatch (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff([]byte("next"), token); diff != "" {
+			t.Fatalf("Token mismatch (-want +got):\n%s", diff)
+		}
+		if !iter.closed {
+			t.Fatal("iterator is not closed")
+		}
+	})
+
+	t.Run("select page iterator close failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		query.EXPECT().PageSize(10).Return(query).Times(1)
+		query.EXPECT().PageState(nil).Return(query).Times(1)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().Iter().Return(&fakeIter{closeErr: errors.New("close failed")}).Times(1)
+		session := &fakeSession{query: query}
+		db := newCassandraDBFromSession(&config.NoSQL{}, session, testlogger.New(t), &persistence.DynamicConfiguration{}, dbWithClient(gocql.NewMockClient(ctrl)))
+
+		if _, _, err := db.SelectAsyncRequestDLQEntries(context.Background(), "domain-id", 10, nil); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+
+	t.Run("select single", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().MapScan(gomock.Any()).DoAndReturn(func(m map[string]interface{}) error {
+			for k, v := range entryMap() {
+				m[k] = v
+			}
+			return nil
+		}).Times(1)
+		session := &fakeSession{query: query}
+		db := newCassandraDBFromSession(&config.NoSQL{}, session, testlogger.New(t), &persistence.DynamicConfiguration{}, dbWithClient(gocql.NewMockClient(ctrl)))
+
+		got, err := db.SelectAsyncRequestDLQEntry(context.Background(), "domain-id", "request-id")
+		if err != nil {
+			t.Fatalf("SelectAsyncRequestDLQEntry failed: %v", err)
+		}
+		if diff := cmp.Diff(entry, got); diff != "" {
+			t.Fatalf("Entry mismatch (-want +got):\n%s", diff)
+		}
+		want := []string{
+			`SELECT domain_id, request_id, request_type, workflow_id, failure, payload, created_time FROM async_request_dlq WHERE domain_id = domain-id and request_id = request-id`,
+		}
+		if diff := cmp.Diff(want, session.queries); diff != "" {
+			t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().Exec().Return(nil).Times(1)
+		session := &fakeSession{query: query}
+		db := newCassandraDBFromSession(&config.NoSQL{}, session, testlogger.New(t), nil, dbWithClient(gocql.NewMockClient(ctrl)))
+
+		if err := db.DeleteAsyncRequestDLQEntry(context.Background(), "domain-id", "request-id"); err != nil {
+			t.Fatalf("DeleteAsyncRequestDLQEntry failed: %v", err)
+		}
+		want := []string{
+			`DELETE FROM async_request_dlq WHERE domain_id = domain-id and request_id = request-id`,
+		}
+		if diff := cmp.Diff(want, session.queries); diff != "" {
+			t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func TestAsyncRequestQueue(t *testing.T) {
+	ts := time.Date(2025, 1, 6, 15, 0, 0, 0, time.UTC)
+	metadata := &persistence.AsyncRequestQueueMetadata{
+		QueueName:   "queue",
+		AckLevel:    10,
+		LeaseOwner:  "host-1",
+		LeaseExpiry: ts,
+		Version:     3,
+	}
+
+	newDB := func(t *testing.T, ctrl *gomock.Controller, query *gocql.MockQuery) (*cdb, *fakeSession) {
+		session := &fakeSession{query: query}
+		return newCassandraDBFromSession(&config.NoSQL{}, session, testlogger.New(t), nil, dbWithClient(gocql.NewMockClient(ctrl))), session
+	}
+
+	t.Run("insert message", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().MapScanCAS(gomock.Any()).Return(true, nil).Times(1)
+		db, session := newDB(t, ctrl, query)
+
+		err := db.InsertAsyncRequestQueueMessage(context.Background(), "queue", &persistence.AsyncRequestQueueMessage{MessageID: 11, Payload: []byte("abc"), CreatedTime: ts})
+		if err != nil {
+			t.Fatalf("InsertAsyncRequestQueueMessage failed: %v", err)
+		}
+		want := []string{
+			`INSERT INTO async_request_queue (queue_name, message_id, payload, created_time) VALUES(queue, 11, [97 98 99], 2025-01-06T15:00:00Z) IF NOT EXISTS`,
+		}
+		if diff := cmp.Diff(want, session.queries); diff != "" {
+			t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("insert message not applied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().MapScanCAS(gomock.Any()).Return(false, nil).Times(1)
+		db, _ := newDB(t, ctrl, query)
+
+		err := db.InsertAsyncRequestQueueMessage(context.Background(), "queue", &persistence.AsyncRequestQueueMessage{MessageID: 11})
+		if _, ok := err.(*nosqlplugin.ConditionFailure); !ok {
+			t.Fatalf("expected condition failure, got %v", err)
+		}
+	})
+
+	t.Run("select last message ID", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().MapScan(gomock.Any()).DoAndReturn(func(m map[string]interface{}) error {
+			m["message_id"] = int64(11)
+			return nil
+		}).Times(1)
+		db, session := newDB(t, ctrl, query)
+
+		id, err := db.SelectLastAsyncRequestQueueMessageID(context.Background(), "queue")
+		if err != nil {
+			t.Fatalf("SelectLastAsyncRequestQueueMessageID failed: %v", err)
+		}
+		if id != 11 {
+			t.Fatalf("got message ID %d, want 11", id)
+		}
+		want := []string{
+			`SELECT message_id FROM async_request_queue WHERE queue_name = queue ORDER BY message_id DESC LIMIT 1`,
+		}
+		if diff := cmp.Diff(want, session.queries); diff != "" {
+			t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("select messages", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		iter := &fakeIter{
+			mapScanInputs: []map[string]interface{}{
+				{"message_id": int64(11), "payload": []byte("abc"), "created_time": ts},
+				{"message_id": int64(12), "payload": []byte("def"), "created_time": ts},
+			},
+		}
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().Iter().Return(iter).Times(1)
+		db, session := newDB(t, ctrl, query)
+
+		messages, err := db.SelectAsyncRequestQueueMessages(context.Background(), "queue", 10, 100)
+		if err != nil {
+			t.Fatalf("SelectAsyncRequestQueueMessages failed: %v", err)
+		}
+		wantMessages := []*persistence.AsyncRequestQueueMessage{
+			{MessageID: 11, Payload: []byte("abc"), CreatedTime: ts},
+			{MessageID: 12, Payload: []byte("def"), CreatedTime: ts},
+		}
+		if diff := cmp.Diff(wantMessages, messages); diff != "" {
+			t.Fatalf("Messages mismatch (-want +got):\n%s", diff)
+		}
+		want := []string{
+			`SELECT message_id, payload, created_time FROM async_request_queue WHERE queue_name = queue and message_id > 10 LIMIT 100`,
+		}
+		if diff := cmp.Diff(want, session.queries); diff != "" {
+			t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+		}
+		if !iter.closed {
+			t.Fatal("iterator is not closed")
+		}
+	})
+
+	t.Run("delete messages", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().Exec().Return(nil).Times(1)
+		db, session := newDB(t, ctrl, query)
+
+		if err := db.DeleteAsyncRequestQueueMessages(context.Background(), "queue", 12); err != nil {
+			t.Fatalf("DeleteAsyncRequestQueueMessages failed: %v", err)
+		}
+		want := []string{
+			`DELETE FROM async_request_queue WHERE queue_name = queue and message_id <= 12`,
+		}
+		if diff := cmp.Diff(want, session.queries); diff != "" {
+			t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("insert metadata", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().MapScanCAS(gomock.Any()).Return(true, nil).Times(1)
+		db, session := newDB(t, ctrl, query)
+
+		if err := db.InsertAsyncRequestQueueMetadata(context.Background(), metadata); err != nil {
+			t.Fatalf("InsertAsyncRequestQueueMetadata failed: %v", err)
+		}
+		want := []string{
+			`INSERT INTO async_request_queue_metadata (queue_name, ack_level, lease_owner, lease_expiry, version) VALUES(queue, 10, host-1, 2025-01-06T15:00:00Z, 3) IF NOT EXISTS`,
+		}
+		if diff := cmp.Diff(want, session.queries); diff != "" {
+			t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("update metadata", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().MapScanCAS(gomock.Any()).Return(true, nil).Times(1)
+		db, session := newDB(t, ctrl, query)
+
+		if err := db.UpdateAsyncRequestQueueMetadataCas(context.Background(), metadata, 2); err != nil {
+			t.Fatalf("UpdateAsyncRequestQueueMetadataCas failed: %v", err)
+		}
+		want := []string{
+			`UPDATE async_request_queue_metadata SET ack_level = 10, lease_owner = host-1, lease_expiry = 2025-01-06T15:00:00Z, version = 3 WHERE queue_name = queue IF version = 2`,
+		}
+		if diff := cmp.Diff(want, session.queries); diff != "" {
+			t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("update metadata not applied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().MapScanCAS(gomock.Any()).Return(false, nil).Times(1)
+		db, _ := newDB(t, ctrl, query)
+
+		err := db.UpdateAsyncRequestQueueMetadataCas(context.Background(), metadata, 2)
+		if _, ok := err.(*nosqlplugin.ConditionFailure); !ok {
+			t.Fatalf("expected condition failure, got %v", err)
+		}
+	})
+
+	t.Run("select metadata", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().MapScan(gomock.Any()).DoAndReturn(func(m map[string]interface{}) error {
+			m["queue_name"] = "queue"
+			m["ack_level"] = int64(10)
+			m["lease_owner"] = "host-1"
+			m["lease_expiry"] = ts
+			m["version"] = int64(3)
+			return nil
+		}).Times(1)
+		db, session := newDB(t, ctrl, query)
+
+		got, err := db.SelectAsyncRequestQueueMetadata(context.Background(), "queue")
+		if err != nil {
+			t.Fatalf("SelectAsyncRequestQueueMetadata failed: %v", err)
+		}
+		if diff := cmp.Diff(metadata, got); diff != "" {
+			t.Fatalf("Metadata mismatch (-want +got):\n%s", diff)
+		}
+		want := []string{
+			`SELECT queue_name, ack_level, lease_owner, lease_expiry, version FROM async_request_queue_metadata WHERE queue_name = queue`,
+		}
+		if diff := cmp.Diff(want, session.queries); diff != "" {
+			t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,144 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cassandra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql"
+)
+
+// InsertMapQItems creates or overwrites items of a queue
+func (db *cdb) InsertMapQItems(ctx context.Context, queueID string, rows []*persistence.MapQItem) error {
+	if len(rows) == 1 {
+		row := rows[0]
+		return db.session.Query(templateInsertMapQItemQuery, queueID, row.Partition, row.Offset, row.Payload).WithContext(ctx).Exec()
+	}
+
+	// items usually span multiple partitions, use an unlogged batch to save the round trips
+	batch := db.session.NewBatch(gocql.UnloggedBatch).WithContext(ctx)
+	for _, row := range rows {
+		batch.Query(templateInsertMapQItemQuery, queueID, row.Partition, row.Offset, row.Payload)
+	}
+	return db.session.ExecuteBatch(batch)
+}
+
+// SelectMapQItems reads items of a partition with offset in (exclusiveMinOffset, inclusiveMaxOffset] ordered by offset
+func (db *cdb) SelectMapQItems(
+	ctx context.Context,
+	queueID string,
+	partition string,
+	exclusiveMinOffset int64,
+	inclusiveMaxOffset int64,
+	maxRows int,
+) ([]*persistence.MapQItem, error) {
+	query := db.session.Query(templateSelectMapQItemsQuery, queueID, partition, exclusiveMinOffset, inclusiveMaxOffset, maxRows).WithContext(ctx)
+	iter := query.Iter()
+	if iter == nil {
+		return nil, fmt.Errorf("SelectMapQItems operation failed. Not able to create query iterator")
+	}
+
+	var items []*persistence.MapQItem
+	result := make(map[string]interface{})
+	for iter.MapScan(result) {
+		items = append(items, &persistence.MapQItem{
+			Partition: result["partition_path"].(string),
+			Offset:    result["item_offset"].(int64),
+			Payload:   result["payload"].([]byte),
+		})
+		result = make(map[string]interface{})
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// DeleteMapQItems removes all items of a partition up to and including inclusiveMaxOffset
+func (db *cdb) DeleteMapQItems(ctx context.Context, queueID, partition string, inclusiveMaxOffset int64) error {
+	query := db.session.Query(templateDeleteMapQItemsQuery, queueID, partition, inclusiveMaxOffset).WithContext(ctx)
+	return db.executeWithConsistencyAll(query)
+}
+
+// InsertMapQState creates the state row of a queue
+// Returns ConditionFailure error if the row already exists
+func (db *cdb) InsertMapQState(ctx context.Context, row *persistence.MapQState) error {
+	query := db.session.Query(templateInsertMapQStateQuery,
+		row.QueueID,
+		row.State.Data,
+		string(row.State.Encoding),
+		row.Version,
+	).WithContext(ctx)
+	previous := make(map[string]interface{})
+	applied, err := query.MapScanCAS(previous)
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return nosqlplugin.NewConditionFailure("mapq_state")
+	}
+	return nil
+}
+
+// UpdateMapQStateCas updates the state row of a queue if its version is previousVersion
+// Returns ConditionFailure error if the condition is not met
+func (db *cdb) UpdateMapQStateCas(ctx context.Context, row *persistence.MapQState, previousVersion int64) error {
+	query := db.session.Query(templateUpdateMapQStateQuery,
+		row.State.Data,
+		string(row.State.Encoding),
+		row.Version,
+		row.QueueID,
+		previousVersion,
+	).WithContext(ctx)
+	previous := make(map[string]interface{})
+	applied, err := query.MapScanCAS(previous)
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return nosqlplugin.NewConditionFailure("mapq_state")
+	}
+	return nil
+}
+
+// SelectMapQState returns the state row of a queue
+func (db *cdb) SelectMapQState(ctx context.Context, queueID string) (*persistence.MapQState, error) {
+	query := db.session.Query(templateSelectMapQStateQuery, queueID).WithContext(ctx)
+	result := make(map[string]interface{})
+	if err := query.MapScan(result); err != nil {
+		return nil, err
+	}
+	return &persistence.MapQState{
+		QueueID: result["queue_id"].(string),
+		State: &persistence.DataBlob{
+			Data:     result["state"].([]byte),
+			Encoding: constants.EncodingType(result["state_encoding"].(string)),
+		},
+		Version: result["version"].(int64),
+	}, nil
+}
@@ -0,0 +1,48 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cassandra
+
+const (
+	templateInsertMapQItemQuery = `INSERT INTO mapq_items (` +
+		`queue_id, partition_path, item_offset, payload) ` +
+		`VALUES(?, ?, ?, ?)`
+
+	templateSelectMapQItemsQuery = `SELECT partition_path, item_offset, payload ` +
+		`FROM mapq_items ` +
+		`WHERE queue_id = ? and partition_path = ? and item_offset > ? and item_offset <= ? LIMIT ?`
+
+	templateDeleteMapQItemsQuery = `DELETE FROM mapq_items ` +
+		`WHERE queue_id = ? and partition_path = ? and item_offset <= ?`
+
+	templateInsertMapQStateQuery = `INSERT INTO mapq_state (` +
+		`queue_id, state, state_encoding, version) ` +
+		`VALUES(?, ?, ?, ?) IF NOT EXISTS`
+
+	templateUpdateMapQStateQuery = `UPDATE mapq_state ` +
+		`SET state = ?, state_encoding = ?, version = ? ` +
+		`WHERE queue_id = ? IF version = ?`
+
+	templateSelectMapQStateQuery = `SELECT queue_id, state, state_encoding, version ` +
+		`FROM mapq_state ` +
+		`WHERE queue_id = ?`
+)
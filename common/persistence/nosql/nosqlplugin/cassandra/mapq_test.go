@@ -0,0 +1,236 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cassandra
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql"
+)
+
+func TestMapQ(t *testing.T) {
+	state := &persistence.MapQState{
+		QueueID: "queue",
+		State:   &persistence.DataBlob{Encoding: constants.EncodingTypeJSON, Data: []byte("{}")},
+		Version: 3,
+	}
+
+	newDB := func(t *testing.T, ctrl *gomock.Controller, query *gocql.MockQuery) (*cdb, *fakeSession) {
+		session := &fakeSession{query: query}
+		return newCassandraDBFromSession(&config.NoSQL{}, session, testlogger.New(t), nil, dbWithClient(gocql.NewMockClient(ctrl))), session
+	}
+
+	t.Run("insert single item", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().Exec().Return(nil).Times(1)
+		db, session := newDB(t, ctrl, query)
+
+		err := db.InsertMapQItems(context.Background(), "queue", []*persistence.MapQItem{{Partition: "*/timer", Offset: 11, Payload: []byte("abc")}})
+		if err != nil {
+			t.Fatalf("InsertMapQItems failed: %v", err)
+		}
+		want := []string{
+			`INSERT INTO mapq_items (queue_id, partition_path, item_offset, payload) VALUES(queue, */timer, 11, [97 98 99])`,
+		}
+		if diff := cmp.Diff(want, session.queries); diff != "" {
+			t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("insert items in batch", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		db, session := newDB(t, ctrl, gocql.NewMockQuery(ctrl))
+
+		err := db.InsertMapQItems(context.Background(), "queue", []*persistence.MapQItem{
+			{Partition: "*/timer", Offset: 11, Payload: []byte("abc")},
+			{Partition: "*/transfer", Offset: 12, Payload: []byte("def")},
+		})
+		if err != nil {
+			t.Fatalf("InsertMapQItems failed: %v", err)
+		}
+		if len(session.batches) != 1 {
+			t.Fatalf("Expected 1 batch, got %v", len(session.batches))
+		}
+		want := []string{
+			`INSERT INTO mapq_items (queue_id, partition_path, item_offset, payload) VALUES(queue, */timer, 11, [97 98 99])`,
+			`INSERT INTO mapq_items (queue_id, partition_path, item_offset, payload) VALUES(queue, */transfer, 12, [100 101 102])`,
+		}
+		if diff := cmp.Diff(want, session.batches[0].queries); diff != "" {
+			t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("select items", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		iter := &fakeIter{
+			mapScanInputs: []map[string]interface{}{
+				{"partition_path": "*/timer", "item_offset": int64(11), "payload": []byte("abc")},
+				{"partition_path": "*/timer", "item_offset": int64(12), "payload": []byte("def")},
+			},
+		}
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().Iter().Return(iter).Times(1)
+		db, session := newDB(t, ctrl, query)
+
+		items, err := db.SelectMapQItems(context.Background(), "queue", "*/timer", 10, 20, 100)
+		if err != nil {
+			t.Fatalf("SelectMapQItems failed: %v", err)
+		}
+		wantItems := []*persistence.MapQItem{
+			{Partition: "*/timer", Offset: 11, Payload: []byte("abc")},
+			{Partition: "*/timer", Offset: 12, Payload: []byte("def")},
+		}
+		if diff := cmp.Diff(wantItems, items); diff != "" {
+			t.Fatalf("Items mismatch (-want +got):\n%s", diff)
+		}
+		want := []string{
+			`SELECT partition_path, item_offset, payload FROM mapq_items WHERE queue_id = queue and partition_path = */timer and item_offset > 10 and item_offset <= 20 LIMIT 100`,
+		}
+		if diff := cmp.Diff(want, session.queries); diff != "" {
+			t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+		}
+		if !iter.closed {
+			t.Fatal("iterator is not closed")
+		}
+	})
+
+	t.Run("delete items", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().Exec().Return(nil).Times(1)
+		db, session := newDB(t, ctrl, query)
+
+		if err := db.DeleteMapQItems(context.Background(), "queue", "*/timer", 12); err != nil {
+			t.Fatalf("DeleteMapQItems failed: %v", err)
+		}
+		want := []string{
+			`DELETE FROM mapq_items WHERE queue_id = queue and partition_path = */timer and item_offset <= 12`,
+		}
+		if diff := cmp.Diff(want, session.queries); diff != "" {
+			t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("insert state", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().MapScanCAS(gomock.Any()).Return(true, nil).Times(1)
+		db, session := newDB(t, ctrl, query)
+
+		if err := db.InsertMapQState(context.Background(), state); err != nil {
+			t.Fatalf("InsertMapQState failed: %v", err)
+		}
+		want := []string{
+			`INSERT INTO mapq_state (queue_id, state, state_encoding, version) VALUES(queue, [123 125], json, 3) IF NOT EXISTS`,
+		}
+		if diff := cmp.Diff(want, session.queries); diff != "" {
+			t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("insert state not applied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().MapScanCAS(gomock.Any()).Return(false, nil).Times(1)
+		db, _ := newDB(t, ctrl, query)
+
+		err := db.InsertMapQState(context.Background(), state)
+		if _, ok := err.(*nosqlplugin.ConditionFailure); !ok {
+			t.Fatalf("expected condition failure, got %v", err)
+		}
+	})
+
+	t.Run("update state", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().MapScanCAS(gomock.Any()).Return(true, nil).Times(1)
+		db, session := newDB(t, ctrl, query)
+
+		if err := db.UpdateMapQStateCas(context.Background(), state, 2); err != nil {
+			t.Fatalf("UpdateMapQStateCas failed: %v", err)
+		}
+		want := []string{
+			`UPDATE mapq_state SET state = [123 125], state_encoding = json, version = 3 WHERE queue_id = queue IF version = 2`,
+		}
+		if diff := cmp.Diff(want, session.queries); diff != "" {
+			t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("update state not applied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().MapScanCAS(gomock.Any()).Return(false, nil).Times(1)
+		db, _ := newDB(t, ctrl, query)
+
+		err := db.UpdateMapQStateCas(context.Background(), state, 2)
+		if _, ok := err.(*nosqlplugin.ConditionFailure); !ok {
+			t.Fatalf("expected condition failure, got %v", err)
+		}
+	})
+
+	t.Run("select state", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().MapScan(gomock.Any()).DoAndReturn(func(m map[string]interface{}) error {
+			m["queue_id"] = "queue"
+			m["state"] = []byte("{}")
+			m["state_encoding"] = "json"
+			m["version"] = int64(3)
+			return nil
+		}).Times(1)
+		db, session := newDB(t, ctrl, query)
+
+		got, err := db.SelectMapQState(context.Background(), "queue")
+		if err != nil {
+			t.Fatalf("SelectMapQState failed: %v", err)
+		}
+		if diff := cmp.Diff(state, got); diff != "" {
+			t.Fatalf("State mismatch (-want +got):\n%s", diff)
+		}
+		want := []string{
+			`SELECT queue_id, state, state_encoding, version FROM mapq_state WHERE queue_id = queue`,
+		}
+		if diff := cmp.Diff(want, session.queries); diff != "" {
+			t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,208 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cassandra
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql"
+)
+
+// InsertShardDistributorNamespace creates the static row of a namespace with leader version and revision 0
+// Returns ConditionFailure error if the row already exists
+func (db *cdb) InsertShardDistributorNamespace(ctx context.Context, namespace string) error {
+	query := db.session.Query(templateInsertShardDistributorNamespaceQuery, namespace).WithContext(ctx)
+	previous := make(map[string]interface{})
+	applied, err := query.MapScanCAS(previous)
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return nosqlplugin.NewConditionFailure("shard_distributor")
+	}
+	return nil
+}
+
+// SelectShardDistributorNamespace returns the leader, executors and revision of a namespace
+// Returns nil if the namespace doesn't exist
+func (db *cdb) SelectShardDistributorNamespace(ctx context.Context, namespace string) (*nosqlplugin.ShardDistributorNamespaceRow, error) {
+	query := db.session.Query(templateSelectShardDistributorNamespaceQuery, namespace).WithContext(ctx)
+	iter := query.Iter()
+	if iter == nil {
+		return nil, fmt.Errorf("SelectShardDistributorNamespace operation failed. Not able to create query iterator")
+	}
+
+	var row *nosqlplugin.ShardDistributorNamespaceRow
+	result := make(map[string]interface{})
+	for iter.MapScan(result) {
+		if row == nil {
+			// static columns are the same on every row of the partition
+			row = &nosqlplugin.ShardDistributorNamespaceRow{
+				Namespace: namespace,
+				Executors: make(map[string]*persistence.ShardDistributorExecutor),
+				Revision:  result["revision"].(int64),
+			}
+			if version := result["leader_version"].(int64); version > 0 {
+				row.Leader = &persistence.ShardDistributorLeader{
+					LeaderID:    result["leader_id"].(string),
+					LeaseExpiry: result["lease_expiry"].(time.Time),
+					Version:     version,
+				}
+			}
+		}
+		// the partition only has the static row if it has no executors
+		if executorID, ok := result["executor_id"].(string); ok && executorID != "" {
+			row.Executors[executorID] = &persistence.ShardDistributorExecutor{
+				ExecutorID:       executorID,
+				LastHeartbeat:    result["last_heartbeat"].(time.Time),
+				State:            result["state"].(string),
+				ReportedShards:   toShardDistributorBlob(result["reported_shards"], result["reported_shards_encoding"]),
+				AssignedShards:   toShardDistributorBlob(result["assigned_shards"], result["assigned_shards_encoding"]),
+				AssignedRevision: result["assigned_revision"].(int64),
+			}
+		}
+		result = make(map[string]interface{})
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+// UpdateShardDistributorLeaderCas updates the leader of a namespace if its leader version is previousVersion
+// Returns ConditionFailure error if the condition is not met
+func (db *cdb) UpdateShardDistributorLeaderCas(
+	ctx context.Context,
+	namespace string,
+	leader *persistence.ShardDistributorLeader,
+	previousVersion int64,
+) error {
+	query := db.session.Query(templateUpdateShardDistributorLeaderQuery,
+		leader.LeaderID,
+		leader.LeaseExpiry,
+		leader.Version,
+		namespace,
+		previousVersion,
+	).WithContext(ctx)
+	previous := make(map[string]interface{})
+	applied, err := query.MapScanCAS(previous)
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return nosqlplugin.NewConditionFailure("shard_distributor")
+	}
+	return nil
+}
+
+// UpdateShardDistributorExecutorsCas applies the changes to the executors of a namespace and increments its revision
+// in a single batch conditioned on the static revision and leader version columns
+// Returns ConditionFailure error if the condition is not met
+func (db *cdb) UpdateShardDistributorExecutorsCas(ctx context.Context, request *nosqlplugin.ShardDistributorExecutorsWriteRequest) error {
+	revision := request.PreviousRevision + 1
+	batch := db.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	if request.LeaderVersion > 0 {
+		batch.Query(templateUpdateShardDistributorRevisionWithLeaderQuery,
+			revision,
+			request.Namespace,
+			request.PreviousRevision,
+			request.LeaderVersion,
+		)
+	} else {
+		batch.Query(templateUpdateShardDistributorRevisionQuery,
+			revision,
+			request.Namespace,
+			request.PreviousRevision,
+		)
+	}
+
+	if executor := request.UpsertedExecutor; executor != nil {
+		data, encoding := fromShardDistributorBlob(executor.ReportedShards)
+		batch.Query(templateUpdateShardDistributorExecutorQuery,
+			executor.LastHeartbeat,
+			executor.State,
+			data,
+			encoding,
+			request.Namespace,
+			executor.ExecutorID,
+		)
+	}
+
+	executorIDs := make([]string, 0, len(request.AssignedShards))
+	for executorID := range request.AssignedShards {
+		executorIDs = append(executorIDs, executorID)
+	}
+	sort.Strings(executorIDs)
+	for _, executorID := range executorIDs {
+		data, encoding := fromShardDistributorBlob(request.AssignedShards[executorID])
+		batch.Query(templateUpdateShardDistributorAssignedShardsQuery,
+			data,
+			encoding,
+			revision,
+			request.Namespace,
+			executorID,
+		)
+	}
+
+	for _, executorID := range request.DeletedExecutorIDs {
+		batch.Query(templateDeleteShardDistributorExecutorQuery, request.Namespace, executorID)
+	}
+
+	previous := make(map[string]interface{})
+	applied, iter, err := db.session.MapExecuteBatchCAS(batch, previous)
+	defer func() {
+		if iter != nil {
+			_ = iter.Close()
+		}
+	}()
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return nosqlplugin.NewConditionFailure("shard_distributor")
+	}
+	return nil
+}
+
+func toShardDistributorBlob(data, encoding interface{}) *persistence.DataBlob {
+	bytes, _ := data.([]byte)
+	if len(bytes) == 0 {
+		return nil
+	}
+	enc, _ := encoding.(string)
+	return persistence.NewDataBlob(bytes, constants.EncodingType(enc))
+}
+
+func fromShardDistributorBlob(blob *persistence.DataBlob) ([]byte, string) {
+	if blob == nil {
+		return nil, ""
+	}
+	return blob.Data, string(blob.Encoding)
+}
@@ -0,0 +1,59 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cassandra
+
+const (
+	templateInsertShardDistributorNamespaceQuery = `INSERT INTO shard_distributor (` +
+		`namespace, leader_version, revision) ` +
+		`VALUES(?, 0, 0) IF NOT EXISTS`
+
+	templateSelectShardDistributorNamespaceQuery = `SELECT namespace, executor_id, ` +
+		`leader_id, lease_expiry, leader_version, revision, ` +
+		`last_heartbeat, state, reported_shards, reported_shards_encoding, ` +
+		`assigned_shards, assigned_shards_encoding, assigned_revision ` +
+		`FROM shard_distributor ` +
+		`WHERE namespace = ?`
+
+	templateUpdateShardDistributorLeaderQuery = `UPDATE shard_distributor ` +
+		`SET leader_id = ?, lease_expiry = ?, leader_version = ? ` +
+		`WHERE namespace = ? IF leader_version = ?`
+
+	templateUpdateShardDistributorRevisionQuery = `UPDATE shard_distributor ` +
+		`SET revision = ? ` +
+		`WHERE namespace = ? IF revision = ?`
+
+	templateUpdateShardDistributorRevisionWithLeaderQuery = `UPDATE shard_distributor ` +
+		`SET revision = ? ` +
+		`WHERE namespace = ? IF revision = ? and leader_version = ?`
+
+	templateUpdateShardDistributorExecutorQuery = `UPDATE shard_distributor ` +
+		`SET last_heartbeat = ?, state = ?, reported_shards = ?, reported_shards_encoding = ? ` +
+		`WHERE namespace = ? and executor_id = ?`
+
+	templateUpdateShardDistributorAssignedShardsQuery = `UPDATE shard_distributor ` +
+		`SET assigned_shards = ?, assigned_shards_encoding = ?, assigned_revision = ? ` +
+		`WHERE namespace = ? and executor_id = ?`
+
+	templateDeleteShardDistributorExecutorQuery = `DELETE FROM shard_distributor ` +
+		`WHERE namespace = ? and executor_id = ?`
+)
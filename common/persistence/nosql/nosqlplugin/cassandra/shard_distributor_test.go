@@ -0,0 +1,306 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cassandra
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql"
+)
+
+func TestShardDistributor(t *testing.T) {
+	newDB := func(t *testing.T, ctrl *gomock.Controller, session *fakeSession) *cdb {
+		return newCassandraDBFromSession(&config.NoSQL{}, session, testlogger.New(t), nil, dbWithClient(gocql.NewMockClient(ctrl)))
+	}
+
+	t.Run("insert namespace", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().MapScanCAS(gomock.Any()).Return(true, nil).Times(1)
+		session := &fakeSession{query: query}
+		db := newDB(t, ctrl, session)
+
+		if err := db.InsertShardDistributorNamespace(context.Background(), "ns"); err != nil {
+			t.Fatalf("InsertShardDistributorNamespace failed: %v", err)
+		}
+		want := []string{
+			`INSERT INTO shard_distributor (namespace, leader_version, revision) VALUES(ns, 0, 0) IF NOT EXISTS`,
+		}
+		if diff := cmp.Diff(want, session.queries); diff != "" {
+			t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("insert namespace not applied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().MapScanCAS(gomock.Any()).Return(false, nil).Times(1)
+		db := newDB(t, ctrl, &fakeSession{query: query})
+
+		err := db.InsertShardDistributorNamespace(context.Background(), "ns")
+		if _, ok := err.(*nosqlplugin.ConditionFailure); !ok {
+			t.Fatalf("expected condition failure, got %v", err)
+		}
+	})
+
+	t.Run("select namespace", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		iter := &fakeIter{
+			mapScanInputs: []map[string]interface{}{
+				{
+					"executor_id":              "executor-1",
+					"leader_id":                "leader",
+					"lease_expiry":             FixedTime,
+					"leader_version":           int64(2),
+					"revision":                 int64(7),
+					"last_heartbeat":           FixedTime,
+					"state":                    "ACTIVE",
+					"reported_shards":          []byte(`{"1":{}}`),
+					"reported_shards_encoding": "json",
+					"assigned_shards":          []byte(`{"1":{}}`),
+					"assigned_shards_encoding": "json",
+					"assigned_revision":        int64(6),
+				},
+				{
+					"executor_id":              "executor-2",
+					"leader_id":                "leader",
+					"lease_expiry":             FixedTime,
+					"leader_version":           int64(2),
+					"revision":                 int64(7),
+					"last_heartbeat":           FixedTime,
+					"state":                    "DRAINING",
+					"reported_shards":          []byte(nil),
+					"reported_shards_encoding": "",
+					"assigned_shards":          []byte(nil),
+					"assigned_shards_encoding": "",
+					"assigned_revision":        int64(0),
+				},
+			},
+		}
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().Iter().Return(iter).Times(1)
+		session := &fakeSession{query: query}
+		db := newDB(t, ctrl, session)
+
+		row, err := db.SelectShardDistributorNamespace(context.Background(), "ns")
+		if err != nil {
+			t.Fatalf("SelectShardDistributorNamespace failed: %v", err)
+		}
+		wantRow := &nosqlplugin.ShardDistributorNamespaceRow{
+			Namespace: "ns",
+			Leader:    &persistence.ShardDistributorLeader{LeaderID: "leader", LeaseExpiry: FixedTime, Version: 2},
+			Executors: map[string]*persistence.ShardDistributorExecutor{
+				"executor-1": {
+					ExecutorID:       "executor-1",
+					LastHeartbeat:    FixedTime,
+					State:            "ACTIVE",
+					ReportedShards:   &persistence.DataBlob{Encoding: constants.EncodingTypeJSON, Data: []byte(`{"1":{}}`)},
+					AssignedShards:   &persistence.DataBlob{Encoding: constants.EncodingTypeJSON, Data: []byte(`{"1":{}}`)},
+					AssignedRevision: 6,
+				},
+				"executor-2": {
+					ExecutorID:    "executor-2",
+					LastHeartbeat: FixedTime,
+					State:         "DRAINING",
+				},
+			},
+			Revision: 7,
+		}
+		if diff := cmp.Diff(wantRow, row); diff != "" {
+			t.Fatalf("Row mismatch (-want +got):\n%s", diff)
+		}
+		want := []string{
+			`SELECT namespace, executor_id, leader_id, lease_expiry, leader_version, revision, ` +
+				`last_heartbeat, state, reported_shards, reported_shards_encoding, ` +
+				`assigned_shards, assigned_shards_encoding, assigned_revision FROM shard_distributor WHERE namespace = ns`,
+		}
+		if diff := cmp.Diff(want, session.queries); diff != "" {
+			t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+		}
+		if !iter.closed {
+			t.Fatal("iterator is not closed")
+		}
+	})
+
+	t.Run("select namespace without leader and executors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		iter := &fakeIter{
+			mapScanInputs: []map[string]interface{}{
+				{"executor_id": "", "leader_id": "", "lease_expiry": time.Time{}, "leader_version": int64(0), "revision": int64(0)},
+			},
+		}
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().Iter().Return(iter).Times(1)
+		db := newDB(t, ctrl, &fakeSession{query: query})
+
+		row, err := db.SelectShardDistributorNamespace(context.Background(), "ns")
+		if err != nil {
+			t.Fatalf("SelectShardDistributorNamespace failed: %v", err)
+		}
+		wantRow := &nosqlplugin.ShardDistributorNamespaceRow{
+			Namespace: "ns",
+			Executors: map[string]*persistence.ShardDistributorExecutor{},
+		}
+		if diff := cmp.Diff(wantRow, row); diff != "" {
+			t.Fatalf("Row mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("select namespace not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().Iter().Return(&fakeIter{}).Times(1)
+		db := newDB(t, ctrl, &fakeSession{query: query})
+
+		row, err := db.SelectShardDistributorNamespace(context.Background(), "ns")
+		if err != nil {
+			t.Fatalf("SelectShardDistributorNamespace failed: %v", err)
+		}
+		if row != nil {
+			t.Fatalf("expected nil row, got %v", row)
+		}
+	})
+
+	t.Run("update leader", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().MapScanCAS(gomock.Any()).Return(true, nil).Times(1)
+		session := &fakeSession{query: query}
+		db := newDB(t, ctrl, session)
+
+		leader := &persistence.ShardDistributorLeader{LeaderID: "leader", LeaseExpiry: FixedTime, Version: 3}
+		if err := db.UpdateShardDistributorLeaderCas(context.Background(), "ns", leader, 2); err != nil {
+			t.Fatalf("UpdateShardDistributorLeaderCas failed: %v", err)
+		}
+		want := []string{
+			`UPDATE shard_distributor SET leader_id = leader, lease_expiry = 2025-01-06T15:00:00Z, leader_version = 3 WHERE namespace = ns IF leader_version = 2`,
+		}
+		if diff := cmp.Diff(want, session.queries); diff != "" {
+			t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("update leader not applied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		query := gocql.NewMockQuery(ctrl)
+		query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
+		query.EXPECT().MapScanCAS(gomock.Any()).Return(false, nil).Times(1)
+		db := newDB(t, ctrl, &fakeSession{query: query})
+
+		err := db.UpdateShardDistributorLeaderCas(context.Background(), "ns", &persistence.ShardDistributorLeader{Version: 3}, 2)
+		if _, ok := err.(*nosqlplugin.ConditionFailure); !ok {
+			t.Fatalf("expected condition failure, got %v", err)
+		}
+	})
+
+	t.Run("upsert executor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		session := &fakeSession{mapExecuteBatchCASApplied: true, iter: &fakeIter{}}
+		db := newDB(t, ctrl, session)
+
+		err := db.UpdateShardDistributorExecutorsCas(context.Background(), &nosqlplugin.ShardDistributorExecutorsWriteRequest{
+			Namespace:        "ns",
+			PreviousRevision: 4,
+			UpsertedExecutor: &persistence.ShardDistributorExecutor{
+				ExecutorID:     "executor-1",
+				LastHeartbeat:  FixedTime,
+				State:          "ACTIVE",
+				ReportedShards: &persistence.DataBlob{Encoding: constants.EncodingTypeJSON, Data: []byte("{}")},
+			},
+		})
+		if err != nil {
+			t.Fatalf("UpdateShardDistributorExecutorsCas failed: %v", err)
+		}
+		want := []string{
+			`UPDATE shard_distributor SET revision = 5 WHERE namespace = ns IF revision = 4`,
+			`UPDATE shard_distributor SET last_heartbeat = 2025-01-06T15:00:00Z, state = ACTIVE, reported_shards = [123 125], reported_shards_encoding = json WHERE namespace = ns and executor_id = executor-1`,
+		}
+		if diff := cmp.Diff(want, session.batches[0].queries); diff != "" {
+			t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("assign shards and delete executors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		session := &fakeSession{mapExecuteBatchCASApplied: true, iter: &fakeIter{}}
+		db := newDB(t, ctrl, session)
+
+		err := db.UpdateShardDistributorExecutorsCas(context.Background(), &nosqlplugin.ShardDistributorExecutorsWriteRequest{
+			Namespace:        "ns",
+			PreviousRevision: 4,
+			LeaderVersion:    2,
+			AssignedShards: map[string]*persistence.DataBlob{
+				"executor-2": {Encoding: constants.EncodingTypeJSON, Data: []byte("{}")},
+				"executor-1": {Encoding: constants.EncodingTypeJSON, Data: []byte("{}")},
+			},
+			DeletedExecutorIDs: []string{"executor-3"},
+		})
+		if err != nil {
+			t.Fatalf("UpdateShardDistributorExecutorsCas failed: %v", err)
+		}
+		want := []string{
+			`UPDATE shard_distributor SET revision = 5 WHERE namespace = ns IF revision = 4 and leader_version = 2`,
+			`UPDATE shard_distributor SET assigned_shards = [123 125], assigned_shards_encoding = json, assigned_revision = 5 WHERE namespace = ns and executor_id = executor-1`,
+			`UPDATE shard_distributor SET assigned_shards = [123 125], assigned_shards_encoding = json, assigned_revision = 5 WHERE namespace = ns and executor_id = executor-2`,
+			`DELETE FROM shard_distributor WHERE namespace = ns and executor_id = executor-3`,
+		}
+		if diff := cmp.Diff(want, session.batches[0].queries); diff != "" {
+			t.Fatalf("Query mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("update executors not applied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		iter := &fakeIter{}
+		session := &fakeSession{mapExecuteBatchCASApplied: false, iter: iter}
+		db := newDB(t, ctrl, session)
+
+		err := db.UpdateShardDistributorExecutorsCas(context.Background(), &nosqlplugin.ShardDistributorExecutorsWriteRequest{
+			Namespace:          "ns",
+			PreviousRevision:   4,
+			DeletedExecutorIDs: []string{"executor-3"},
+		})
+		if _, ok := err.(*nosqlplugin.ConditionFailure); !ok {
+			t.Fatalf("expected condition failure, got %v", err)
+		}
+		if !iter.closed {
+			t.Fatal("iterator is not closed")
+		}
+	})
+}
@@ -75,6 +75,8 @@ func (db *cdb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListF
 		AckLevel:                ackLevel,
 		RangeID:                 rangeID,
 		AdaptivePartitionConfig: toTaskListPartitionConfig(tlDB["adaptive_partition_config"]),
+		VersioningConfig:        toTaskListVersioningConfig(tlDB["versioning_config"]),
+		MigrationConfig:         toTaskListMigrationConfig(tlDB["migration_config"]),
 	}, nil
 }
 
@@ -142,6 +144,55 @@ func toTaskListPartition(partition map[string]any) *persistence.TaskListPartitio
 	}
 }
 
+func toTaskListVersioningConfig(v interface{}) *persistence.TaskListVersioningConfig {
+	if v == nil {
+		return nil
+	}
+	config := v.(map[string]interface{})
+	if len(config) == 0 {
+		return nil
+	}
+	compatibleSets, _ := config["compatible_sets"].([][]string)
+	return &persistence.TaskListVersioningConfig{
+		Version:        config["version"].(int64),
+		CompatibleSets: compatibleSets,
+	}
+}
+
+func fromTaskListVersioningConfig(config *persistence.TaskListVersioningConfig) map[string]interface{} {
+	if config == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"version":         config.Version,
+		"compatible_sets": config.CompatibleSets,
+	}
+}
+
+func toTaskListMigrationConfig(v interface{}) *persistence.TaskListMigrationConfig {
+	if v == nil {
+		return nil
+	}
+	config := v.(map[string]interface{})
+	if len(config) == 0 {
+		return nil
+	}
+	return &persistence.TaskListMigrationConfig{
+		TargetTaskList: config["target_task_list"].(string),
+		Alias:          config["alias"].(bool),
+	}
+}
+
+func fromTaskListMigrationConfig(config *persistence.TaskListMigrationConfig) map[string]interface{} {
+	if config == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"target_task_list": config.TargetTaskList,
+		"alias":            config.Alias,
+	}
+}
+
 func fromTaskListPartitionConfig(config *persistence.TaskListPartitionConfig) map[string]interface{} {
 	if config == nil {
 		return nil
@@ -193,6 +244,8 @@ func (db *cdb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow)
 		row.TaskListKind,
 		row.LastUpdatedTime,
 		fromTaskListPartitionConfig(row.AdaptivePartitionConfig),
+		fromTaskListVersioningConfig(row.VersioningConfig),
+		fromTaskListMigrationConfig(row.MigrationConfig),
 		timeStamp,
 	).WithContext(ctx)
 
@@ -222,6 +275,8 @@ func (db *cdb) UpdateTaskList(
 		row.TaskListKind,
 		row.LastUpdatedTime,
 		fromTaskListPartitionConfig(row.AdaptivePartitionConfig),
+		fromTaskListVersioningConfig(row.VersioningConfig),
+		fromTaskListMigrationConfig(row.MigrationConfig),
 		timeStamp,
 		row.DomainID,
 		row.TaskListName,
@@ -289,6 +344,8 @@ func (db *cdb) UpdateTaskListWithTTL(
 		row.TaskListKind,
 		timeStamp,
 		fromTaskListPartitionConfig(row.AdaptivePartitionConfig),
+		fromTaskListVersioningConfig(row.VersioningConfig),
+		fromTaskListMigrationConfig(row.MigrationConfig),
 		timeStamp,
 		row.DomainID,
 		row.TaskListName,
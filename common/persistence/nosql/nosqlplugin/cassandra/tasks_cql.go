@@ -29,7 +29,9 @@ const (
 		`ack_level: ?, ` +
 		`kind: ?, ` +
 		`last_updated: ?, ` +
-		`adaptive_partition_config: ? ` +
+		`adaptive_partition_config: ?, ` +
+		`versioning_config: ?, ` +
+		`migration_config: ? ` +
 		`}`
 
 	templateTaskType = `{` +
@@ -80,6 +80,14 @@ func TestSelectTaskList(t *testing.T) {
 							},
 						},
 					}
+					(*tlDB)["versioning_config"] = map[string]interface{}{
+						"version":         int64(3),
+						"compatible_sets": [][]string{{"1.0"}, {"2.0"}},
+					}
+					(*tlDB)["migration_config"] = map[string]interface{}{
+						"target_task_list": "target",
+						"alias":            true,
+					}
 					return nil
 				}).Times(1)
 			},
@@ -104,6 +112,14 @@ func TestSelectTaskList(t *testing.T) {
 						},
 					},
 				},
+				VersioningConfig: &persistence.TaskListVersioningConfig{
+					Version:        3,
+					CompatibleSets: [][]string{{"1.0"}, {"2.0"}},
+				},
+				MigrationConfig: &persistence.TaskListMigrationConfig{
+					TargetTaskList: "target",
+					Alias:          true,
+				},
 			},
 			wantQueries: []string{
 				`SELECT range_id, task_list FROM tasks WHERE domain_id = domain1 and task_list_name = tasklist1 and task_list_type = 1 and type = 1 and task_id = -12345`,
@@ -243,7 +259,7 @@ func TestInsertTaskList(t *testing.T) {
 			wantQueries: []string{
 				`INSERT INTO tasks (domain_id, task_list_name, task_list_type, type, task_id, range_id, task_list, created_time ) ` +
 					`VALUES (domain1, tasklist1, 1, 1, -12345, 1, ` +
-					`{domain_id: domain1, name: tasklist1, type: 1, ack_level: 0, kind: 2, last_updated: 2024-04-01T22:08:41Z, adaptive_partition_config: map[] }` +
+					`{domain_id: domain1, name: tasklist1, type: 1, ack_level: 0, kind: 2, last_updated: 2024-04-01T22:08:41Z, adaptive_partition_config: map[], versioning_config: map[], migration_config: map[] }` +
 					`, 2024-04-01T22:08:41Z) IF NOT EXISTS`,
 			},
 		},
@@ -267,6 +283,14 @@ func TestInsertTaskList(t *testing.T) {
 						0: {},
 					},
 				},
+				VersioningConfig: &persistence.TaskListVersioningConfig{
+					Version:        2,
+					CompatibleSets: [][]string{{"1.0", "1.1"}, {"2.0"}},
+				},
+				MigrationConfig: &persistence.TaskListMigrationConfig{
+					TargetTaskList: "target",
+					Alias:          true,
+				},
 			},
 			queryMockFn: func(query *gocql.MockQuery) {
 				query.EXPECT().WithContext(gomock.Any()).Return(query).Times(1)
@@ -278,7 +302,9 @@ func TestInsertTaskList(t *testing.T) {
 				`INSERT INTO tasks (domain_id, task_list_name, task_list_type, type, task_id, range_id, task_list, created_time ) ` +
 					`VALUES (domain1, tasklist1, 1, 1, -12345, 1, ` +
 					`{domain_id: domain1, name: tasklist1, type: 1, ack_level: 0, kind: 2, last_updated: 2024-04-01T22:08:41Z, ` +
-					`adaptive_partition_config: map[num_read_partitions:1 num_write_partitions:1 read_partitions:map[0:map[isolation_groups:[]]] version:1 write_partitions:map[0:map[isolation_groups:[]]]] }` +
+					`adaptive_partition_config: map[num_read_partitions:1 num_write_partitions:1 read_partitions:map[0:map[isolation_groups:[]]] version:1 write_partitions:map[0:map[isolation_groups:[]]]], ` +
+					`versioning_config: map[compatible_sets:[[1.0 1.1] [2.0]] version:2], ` +
+					`migration_config: map[alias:true target_task_list:target] }` +
 					`, 2024-04-01T22:08:41Z) IF NOT EXISTS`,
 			},
 		},
@@ -391,7 +417,7 @@ func TestUpdateTaskList(t *testing.T) {
 				}).Times(1)
 			},
 			wantQueries: []string{
-				`UPDATE tasks SET range_id = 25, task_list = {domain_id: domain1, name: tasklist1, type: 1, ack_level: 1000, kind: 2, last_updated: 2024-04-01T22:08:41Z, adaptive_partition_config: map[] } , last_updated_time = 2024-04-01T22:08:41Z WHERE domain_id = domain1 and task_list_name = tasklist1 and task_list_type = 1 and type = 1 and task_id = -12345 IF range_id = 25`,
+				`UPDATE tasks SET range_id = 25, task_list = {domain_id: domain1, name: tasklist1, type: 1, ack_level: 1000, kind: 2, last_updated: 2024-04-01T22:08:41Z, adaptive_partition_config: map[], versioning_config: map[], migration_config: map[] } , last_updated_time = 2024-04-01T22:08:41Z WHERE domain_id = domain1 and task_list_name = tasklist1 and task_list_type = 1 and type = 1 and task_id = -12345 IF range_id = 25`,
 			},
 		},
 		{
@@ -503,7 +529,7 @@ func TestUpdateTaskListWithTTL(t *testing.T) {
 			mapExecuteBatchCASApplied: true,
 			wantQueries: []string{
 				` INSERT INTO tasks (domain_id, task_list_name, task_list_type, type, task_id, created_time ) VALUES (domain1, tasklist1, 1, 1, -12345, 2024-04-01T22:08:41Z) USING TTL 180`,
-				`UPDATE tasks USING TTL 180 SET range_id = 25, task_list = {domain_id: domain1, name: tasklist1, type: 1, ack_level: 1000, kind: 2, last_updated: 2024-04-01T22:08:41Z, adaptive_partition_config: map[] } , last_updated_time = 2024-04-01T22:08:41Z WHERE domain_id = domain1 and task_list_name = tasklist1 and task_list_type = 1 and type = 1 and task_id = -12345 IF range_id = 25`,
+				`UPDATE tasks USING TTL 180 SET range_id = 25, task_list = {domain_id: domain1, name: tasklist1, type: 1, ack_level: 1000, kind: 2, last_updated: 2024-04-01T22:08:41Z, adaptive_partition_config: map[], versioning_config: map[], migration_config: map[] } , last_updated_time = 2024-04-01T22:08:41Z WHERE domain_id = domain1 and task_list_name = tasklist1 and task_list_type = 1 and type = 1 and task_id = -12345 IF range_id = 25`,
 			},
 		},
 		{
@@ -81,6 +81,37 @@ func (db *cdb) InsertVisibility(ctx context.Context, ttlSeconds int64, row *nosq
 	return query.Exec()
 }
 
+// UpdateVisibilityMemo updates the memo of an open visibility record.
+// The write uses the update time as its timestamp so that it is always shadowed by
+// the deletion issued when the workflow closes, and never resurrects the open record.
+func (db *cdb) UpdateVisibilityMemo(ctx context.Context, ttlSeconds int64, row *nosqlplugin.VisibilityRowForUpdate) error {
+	var query gocql.Query
+	if ttlSeconds > maxCassandraTTL {
+		query = db.session.Query(templateUpdateWorkflowExecutionStartedMemo,
+			row.Memo.Data,
+			row.Memo.GetEncoding(),
+			row.UpdateTime,
+			row.DomainID,
+			domainPartition,
+			persistence.UnixNanoToDBTimestamp(row.StartTime.UnixNano()),
+			row.RunID,
+		).WithContext(ctx)
+	} else {
+		query = db.session.Query(templateUpdateWorkflowExecutionStartedMemoWithTTL,
+			ttlSeconds,
+			row.Memo.Data,
+			row.Memo.GetEncoding(),
+			row.UpdateTime,
+			row.DomainID,
+			domainPartition,
+			persistence.UnixNanoToDBTimestamp(row.StartTime.UnixNano()),
+			row.RunID,
+		).WithContext(ctx)
+	}
+	query = query.WithTimestamp(persistence.UnixNanoToDBTimestamp(row.UpdateTime.UnixNano()))
+	return query.Exec()
+}
+
 func (db *cdb) UpdateVisibility(ctx context.Context, ttlSeconds int64, row *nosqlplugin.VisibilityRowForUpdate) error {
 	batch := db.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
 
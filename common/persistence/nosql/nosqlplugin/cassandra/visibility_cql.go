@@ -34,6 +34,20 @@ const (
 		openExecutionsColumnsForInsert +
 		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
+	templateUpdateWorkflowExecutionStartedMemoWithTTL = `UPDATE open_executions USING TTL ? ` +
+		`SET memo = ?, encoding = ?, update_time = ? ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? ` +
+		`AND start_time = ? ` +
+		`AND run_id = ?`
+
+	templateUpdateWorkflowExecutionStartedMemo = `UPDATE open_executions ` +
+		`SET memo = ?, encoding = ?, update_time = ? ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? ` +
+		`AND start_time = ? ` +
+		`AND run_id = ?`
+
 	templateDeleteWorkflowExecutionStarted = `DELETE FROM open_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
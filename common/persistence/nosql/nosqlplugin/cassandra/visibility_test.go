@@ -171,6 +171,71 @@ func TestUpdateVisibility(t *testing.T) {
 	}
 }
 
+func TestUpdateVisibilityMemo(t *testing.T) {
+	tests := []struct {
+		desc          string
+		row           *nosqlplugin.VisibilityRowForUpdate
+		ttlSeconds    int64
+		queryMockFunc func(*gocql.MockQuery)
+		wantQueries   []string
+		wantErr       bool
+	}{
+		{
+			desc:       "Query with ttl less than maxCassandraTTL",
+			row:        testdata.NewVisibilityRowForUpdate(false, false),
+			ttlSeconds: int64(1000),
+			queryMockFunc: func(query *gocql.MockQuery) {
+				query.EXPECT().WithContext(gomock.Any()).Return(query)
+				query.EXPECT().WithTimestamp(gomock.Any()).Return(query)
+				query.EXPECT().Exec().Return(nil)
+			},
+			wantQueries: []string{
+				`UPDATE open_executions USING TTL 1000 SET memo = [], encoding = json, update_time = 2024-04-01T22:08:41Z WHERE domain_id = test-domain-id AND domain_partition = 0 AND start_time = 1712009321000 AND run_id = test-run-id`,
+			},
+			wantErr: false,
+		},
+		{
+			desc:       "Query with ttl greater than maxCassandraTTL",
+			row:        testdata.NewVisibilityRowForUpdate(false, false),
+			ttlSeconds: maxCassandraTTL + 1,
+			queryMockFunc: func(query *gocql.MockQuery) {
+				query.EXPECT().WithContext(gomock.Any()).Return(query)
+				query.EXPECT().WithTimestamp(gomock.Any()).Return(query)
+				query.EXPECT().Exec().Return(errors.New("some error"))
+			},
+			wantQueries: []string{
+				`UPDATE open_executions SET memo = [], encoding = json, update_time = 2024-04-01T22:08:41Z WHERE domain_id = test-domain-id AND domain_partition = 0 AND start_time = 1712009321000 AND run_id = test-run-id`,
+			},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			query := gocql.NewMockQuery(ctrl)
+			if test.queryMockFunc != nil {
+				test.queryMockFunc(query)
+			}
+			session := &fakeSession{
+				query: query,
+			}
+			client := gocql.NewMockClient(ctrl)
+			cfg := &config.NoSQL{}
+			logger := testlogger.New(t)
+			dc := &persistence.DynamicConfiguration{}
+			db := newCassandraDBFromSession(cfg, session, logger, dc, dbWithClient(client))
+
+			err := db.UpdateVisibilityMemo(context.Background(), test.ttlSeconds, test.row)
+			if test.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.wantQueries, session.queries)
+		})
+	}
+}
+
 func TestSelectOneClosedWorkflow(t *testing.T) {
 	tests := []struct {
 		desc        string
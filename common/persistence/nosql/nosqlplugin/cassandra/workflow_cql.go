@@ -64,6 +64,9 @@ const (
 		`cancel_request_id: ?, ` +
 		`sticky_task_list: ?, ` +
 		`sticky_schedule_to_start_timeout: ?,` +
+		`sticky_worker_identity: ?, ` +
+		`sticky_hit_count: ?, ` +
+		`sticky_fallback_count: ?, ` +
 		`client_library_version: ?, ` +
 		`client_feature_version: ?, ` +
 		`client_impl: ?, ` +
@@ -86,7 +89,9 @@ const (
 		`memo: ?, ` +
 		`partition_config: ?, ` +
 		`active_cluster_selection_policy: ?, ` +
-		`active_cluster_selection_policy_encoding: ?` +
+		`active_cluster_selection_policy_encoding: ?, ` +
+		`completion_callbacks: ?, ` +
+		`completion_callbacks_encoding: ?` +
 		`}`
 
 	templateTransferTaskType = `{` +
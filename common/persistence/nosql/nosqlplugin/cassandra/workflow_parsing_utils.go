@@ -45,6 +45,8 @@ func parseWorkflowExecutionInfo(result map[string]interface{}) *persistence.Inte
 	var autoResetPointsEncoding constants.EncodingType
 	var activeClusterSelectionPolicy []byte
 	var activeClusterSelectionPolicyEncoding constants.EncodingType
+	var completionCallbacks []byte
+	var completionCallbacksEncoding constants.EncodingType
 
 	for k, v := range result {
 		switch k {
@@ -146,6 +148,12 @@ func parseWorkflowExecutionInfo(result map[string]interface{}) *persistence.Inte
 			info.StickyTaskList = v.(string)
 		case "sticky_schedule_to_start_timeout":
 			info.StickyScheduleToStartTimeout = common.SecondsToDuration(int64(v.(int)))
+		case "sticky_worker_identity":
+			info.StickyWorkerIdentity = v.(string)
+		case "sticky_hit_count":
+			info.StickyHitCount = v.(int64)
+		case "sticky_fallback_count":
+			info.StickyFallbackCount = v.(int64)
 		case "client_library_version":
 			info.ClientLibraryVersion = v.(string)
 		case "client_feature_version":
@@ -184,6 +192,10 @@ func parseWorkflowExecutionInfo(result map[string]interface{}) *persistence.Inte
 			activeClusterSelectionPolicy = v.([]byte)
 		case "active_cluster_selection_policy_encoding":
 			activeClusterSelectionPolicyEncoding = constants.EncodingType(v.(string))
+		case "completion_callbacks":
+			completionCallbacks = v.([]byte)
+		case "completion_callbacks_encoding":
+			completionCallbacksEncoding = constants.EncodingType(v.(string))
 		case "cron_overlap_policy":
 			info.CronOverlapPolicy = types.CronOverlapPolicy(int32(v.(int)))
 		}
@@ -191,6 +203,7 @@ func parseWorkflowExecutionInfo(result map[string]interface{}) *persistence.Inte
 	info.CompletionEvent = persistence.NewDataBlob(completionEventData, completionEventEncoding)
 	info.AutoResetPoints = persistence.NewDataBlob(autoResetPoints, autoResetPointsEncoding)
 	info.ActiveClusterSelectionPolicy = persistence.NewDataBlob(activeClusterSelectionPolicy, activeClusterSelectionPolicyEncoding)
+	info.CompletionCallbacks = persistence.NewDataBlob(completionCallbacks, completionCallbacksEncoding)
 	return info
 }
 
@@ -53,6 +53,7 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 	completionEventData := []byte("completion event data")
 	autoResetPointsData := []byte("auto reset points data")
 	activeClusterSelectionPolicyData := []byte("active cluster selection policy data")
+	completionCallbacksData := []byte("completion callbacks data")
 	searchAttributes := map[string][]byte{"AttributeKey": []byte("AttributeValue")}
 	memo := map[string][]byte{"MemoKey": []byte("MemoValue")}
 	partitionConfig := map[string]string{"PartitionKey": "PartitionValue"}
@@ -100,6 +101,9 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 				"cancel_request_id":                        "cancel_request_id",
 				"sticky_task_list":                         "sticky_task_list",
 				"sticky_schedule_to_start_timeout":         9,
+				"sticky_worker_identity":                   "sticky_worker_identity",
+				"sticky_hit_count":                         int64(7),
+				"sticky_fallback_count":                    int64(2),
 				"client_library_version":                   "client_lib_version",
 				"client_feature_version":                   "client_feature_version",
 				"client_impl":                              "client_impl",
@@ -123,6 +127,8 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 				"auto_reset_points_encoding":               "Proto3",
 				"active_cluster_selection_policy":          activeClusterSelectionPolicyData,
 				"active_cluster_selection_policy_encoding": "Proto3",
+				"completion_callbacks":                     completionCallbacksData,
+				"completion_callbacks_encoding":            "Proto3",
 			},
 			want: &persistence.InternalWorkflowExecutionInfo{
 				DomainID:                           "domain_id",
@@ -161,6 +167,9 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 				CancelRequestID:                    "cancel_request_id",
 				StickyTaskList:                     "sticky_task_list",
 				StickyScheduleToStartTimeout:       common.SecondsToDuration(int64(9)),
+				StickyWorkerIdentity:               "sticky_worker_identity",
+				StickyHitCount:                     7,
+				StickyFallbackCount:                2,
 				ClientLibraryVersion:               "client_lib_version",
 				ClientFeatureVersion:               "client_feature_version",
 				ClientImpl:                         "client_impl",
@@ -175,6 +184,7 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 				Memo:                               memo,
 				PartitionConfig:                    partitionConfig,
 				ActiveClusterSelectionPolicy:       persistence.NewDataBlob(activeClusterSelectionPolicyData, "Proto3"),
+				CompletionCallbacks:                persistence.NewDataBlob(completionCallbacksData, "Proto3"),
 			},
 		},
 		{
@@ -243,6 +253,7 @@ func Test_parseWorkflowExecutionInfo(t *testing.T) {
 		assert.Equal(t, result.DecisionAttempt, tt.want.DecisionAttempt)
 		assert.Equal(t, result.ParentDomainID, tt.want.ParentDomainID)
 		assert.Equal(t, result.ActiveClusterSelectionPolicy, tt.want.ActiveClusterSelectionPolicy)
+		assert.Equal(t, result.CompletionCallbacks, tt.want.CompletionCallbacks)
 	}
 }
 
@@ -1383,6 +1383,9 @@ func updateWorkflowExecution(
 		execution.CancelRequestID,
 		execution.StickyTaskList,
 		int32(execution.StickyScheduleToStartTimeout.Seconds()),
+		execution.StickyWorkerIdentity,
+		execution.StickyHitCount,
+		execution.StickyFallbackCount,
 		execution.ClientLibraryVersion,
 		execution.ClientFeatureVersion,
 		execution.ClientImpl,
@@ -1406,6 +1409,8 @@ func updateWorkflowExecution(
 		execution.PartitionConfig,
 		execution.ActiveClusterSelectionPolicy.GetData(),
 		execution.ActiveClusterSelectionPolicy.GetEncodingString(),
+		execution.CompletionCallbacks.GetData(),
+		execution.CompletionCallbacks.GetEncodingString(),
 		execution.NextEventID,
 		execution.VersionHistories.Data,
 		execution.VersionHistories.GetEncodingString(),
@@ -1485,6 +1490,9 @@ func createWorkflowExecution(
 		execution.CancelRequestID,
 		execution.StickyTaskList,
 		int32(execution.StickyScheduleToStartTimeout.Seconds()),
+		execution.StickyWorkerIdentity,
+		execution.StickyHitCount,
+		execution.StickyFallbackCount,
 		execution.ClientLibraryVersion,
 		execution.ClientFeatureVersion,
 		execution.ClientImpl,
@@ -1508,6 +1516,8 @@ func createWorkflowExecution(
 		execution.PartitionConfig,
 		execution.ActiveClusterSelectionPolicy.GetData(),
 		execution.ActiveClusterSelectionPolicy.GetEncodingString(),
+		execution.CompletionCallbacks.GetData(),
+		execution.CompletionCallbacks.GetEncodingString(),
 		execution.NextEventID,
 		defaultVisibilityTimestamp,
 		rowTypeExecutionTaskID,
@@ -0,0 +1,80 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+func (db *ddb) InsertAsyncRequest(ctx context.Context, row *persistence.AsyncRequestInfo, ttlSeconds int64) error {
+	return errors.New("TODO")
+}
+
+func (db *ddb) SelectAsyncRequest(ctx context.Context, domainID, requestID string) (*persistence.AsyncRequestInfo, error) {
+	return nil, errors.New("TODO")
+}
+
+func (db *ddb) InsertAsyncRequestDLQEntry(ctx context.Context, row *persistence.AsyncRequestDLQEntry) error {
+	return errors.New("TODO")
+}
+
+func (db *ddb) SelectAsyncRequestDLQEntries(ctx context.Context, domainID string, pageSize int, pageToken []byte) ([]*persistence.AsyncRequestDLQEntry, []byte, error) {
+	return nil, nil, errors.New("TODO")
+}
+
+func (db *ddb) SelectAsyncRequestDLQEntry(ctx context.Context, domainID, requestID string) (*persistence.AsyncRequestDLQEntry, error) {
+	return nil, errors.New("TODO")
+}
+
+func (db *ddb) DeleteAsyncRequestDLQEntry(ctx context.Context, domainID, requestID string) error {
+	return errors.New("TODO")
+}
+
+func (db *ddb) InsertAsyncRequestQueueMessage(ctx context.Context, queueName string, row *persistence.AsyncRequestQueueMessage) error {
+	return errors.New("TODO")
+}
+
+func (db *ddb) SelectLastAsyncRequestQueueMessageID(ctx context.Context, queueName string) (int64, error) {
+	return 0, errors.New("TODO")
+}
+
+func (db *ddb) SelectAsyncRequestQueueMessages(ctx context.Context, queueName string, exclusiveBeginMessageID int64, maxRows int) ([]*persistence.AsyncRequestQueueMessage, error) {
+	return nil, errors.New("TODO")
+}
+
+func (db *ddb) DeleteAsyncRequestQueueMessages(ctx context.Context, queueName string, inclusiveEndMessageID int64) error {
+	return errors.New("TODO")
+}
+
+func (db *ddb) InsertAsyncRequestQueueMetadata(ctx context.Context, row *persistence.AsyncRequestQueueMetadata) error {
+	return errors.New("TODO")
+}
+
+func (db *ddb) UpdateAsyncRequestQueueMetadataCas(ctx context.Context, row *persistence.AsyncRequestQueueMetadata, previousVersion int64) error {
+	return errors.New("TODO")
+}
+
+func (db *ddb) SelectAsyncRequestQueueMetadata(ctx context.Context, queueName string) (*persistence.AsyncRequestQueueMetadata, error) {
+	return nil, errors.New("TODO")
+}
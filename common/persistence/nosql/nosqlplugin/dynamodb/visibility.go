@@ -22,6 +22,7 @@ package dynamodb
 
 import (
 	"context"
+	"errors"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
@@ -42,6 +43,14 @@ func (db *ddb) UpdateVisibility(
 	panic("TODO")
 }
 
+func (db *ddb) UpdateVisibilityMemo(
+	ctx context.Context,
+	ttlSeconds int64,
+	row *nosqlplugin.VisibilityRowForUpdate,
+) error {
+	return errors.New("TODO")
+}
+
 func (db *ddb) SelectVisibility(
 	ctx context.Context,
 	filter *nosqlplugin.VisibilityFilter,
@@ -71,6 +71,9 @@ type (
 		TaskCRUD
 		WorkflowCRUD
 		ConfigStoreCRUD
+		AsyncRequestCRUD
+		MapQCRUD
+		ShardDistributorCRUD
 	}
 
 	// ClientErrorChecker checks for common nosql errors on client
@@ -248,6 +251,9 @@ type (
 	VisibilityCRUD interface {
 		InsertVisibility(ctx context.Context, ttlSeconds int64, row *VisibilityRowForInsert) error
 		UpdateVisibility(ctx context.Context, ttlSeconds int64, row *VisibilityRowForUpdate) error
+		// UpdateVisibilityMemo updates the memo of an open visibility record, it must not recreate
+		// the record if the workflow has already been closed
+		UpdateVisibilityMemo(ctx context.Context, ttlSeconds int64, row *VisibilityRowForUpdate) error
 		SelectVisibility(ctx context.Context, filter *VisibilityFilter) (*SelectVisibilityResponse, error)
 		DeleteVisibility(ctx context.Context, domainID, workflowID, runID string) error
 		// TODO deprecated this in the future in favor of SelectVisibility
@@ -524,4 +530,103 @@ type (
 		// SelectLatestConfig returns the config entry of the row_type with the largest(latest) version value
 		SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error)
 	}
+
+	/***
+	* AsyncRequestCRUD is for tracking async workflow requests and storing the ones that failed processing
+	*
+	* It also backs the database async workflow queue, which stores messages of named queues
+	*
+	* Recommendation: four tables(async_requests, async_request_dlq, async_request_queue, async_request_queue_metadata)
+	*
+	* Significant columns:
+	* async_requests: partition key(domainID, requestID)
+	* async_request_dlq: partition key(domainID), range key(requestID)
+	* async_request_queue: partition key(queueName), range key(messageID)
+	* async_request_queue_metadata: partition key(queueName), query condition column(version)
+	 */
+	AsyncRequestCRUD interface {
+		// InsertAsyncRequest creates or overwrites the status row of a request. A positive ttlSeconds expires the row
+		InsertAsyncRequest(ctx context.Context, row *persistence.AsyncRequestInfo, ttlSeconds int64) error
+		// SelectAsyncRequest returns the status row of a request
+		SelectAsyncRequest(ctx context.Context, domainID, requestID string) (*persistence.AsyncRequestInfo, error)
+		// InsertAsyncRequestDLQEntry creates or overwrites a DLQ entry
+		InsertAsyncRequestDLQEntry(ctx context.Context, row *persistence.AsyncRequestDLQEntry) error
+		// SelectAsyncRequestDLQEntries pages through the DLQ entries of a domain ordered by requestID
+		SelectAsyncRequestDLQEntries(ctx context.Context, domainID string, pageSize int, pageToken []byte) ([]*persistence.AsyncRequestDLQEntry, []byte, error)
+		// SelectAsyncRequestDLQEntry returns a single DLQ entry
+		SelectAsyncRequestDLQEntry(ctx context.Context, domainID, requestID string) (*persistence.AsyncRequestDLQEntry, error)
+		// DeleteAsyncRequestDLQEntry removes a single DLQ entry
+		DeleteAsyncRequestDLQEntry(ctx context.Context, domainID, requestID string) error
+
+		// InsertAsyncRequestQueueMessage inserts a message into a queue
+		// Must return conditionFailed error if a message with the same ID already exists
+		InsertAsyncRequestQueueMessage(ctx context.Context, queueName string, row *persistence.AsyncRequestQueueMessage) error
+		// SelectLastAsyncRequestQueueMessageID returns the ID of the last message of a queue
+		// Must return NotFound error if the queue is empty
+		SelectLastAsyncRequestQueueMessageID(ctx context.Context, queueName string) (int64, error)
+		// SelectAsyncRequestQueueMessages reads messages of a queue starting from the exclusiveBeginMessageID
+		SelectAsyncRequestQueueMessages(ctx context.Context, queueName string, exclusiveBeginMessageID int64, maxRows int) ([]*persistence.AsyncRequestQueueMessage, error)
+		// DeleteAsyncRequestQueueMessages removes all messages of a queue up to and including inclusiveEndMessageID
+		DeleteAsyncRequestQueueMessages(ctx context.Context, queueName string, inclusiveEndMessageID int64) error
+		// InsertAsyncRequestQueueMetadata creates the metadata row of a queue
+		// Must return conditionFailed error if the row already exists
+		InsertAsyncRequestQueueMetadata(ctx context.Context, row *persistence.AsyncRequestQueueMetadata) error
+		// UpdateAsyncRequestQueueMetadataCas **conditionally** updates the metadata row of a queue if its version is previousVersion
+		// Must return conditionFailed error if the condition is not met
+		UpdateAsyncRequestQueueMetadataCas(ctx context.Context, row *persistence.AsyncRequestQueueMetadata, previousVersion int64) error
+		// SelectAsyncRequestQueueMetadata returns the metadata row of a queue
+		SelectAsyncRequestQueueMetadata(ctx context.Context, queueName string) (*persistence.AsyncRequestQueueMetadata, error)
+	}
+
+	/***
+	* MapQCRUD is for storing the items and the state of MAPQ queues
+	*
+	* Recommendation: two tables(mapq_items, mapq_state)
+	*
+	* Significant columns:
+	* mapq_items: partition key(queueID, partition), range key(offset)
+	* mapq_state: partition key(queueID), query condition column(version)
+	 */
+	MapQCRUD interface {
+		// InsertMapQItems creates or overwrites items of a queue
+		InsertMapQItems(ctx context.Context, queueID string, rows []*persistence.MapQItem) error
+		// SelectMapQItems reads items of a partition with offset in (exclusiveMinOffset, inclusiveMaxOffset] ordered by offset
+		SelectMapQItems(ctx context.Context, queueID, partition string, exclusiveMinOffset, inclusiveMaxOffset int64, maxRows int) ([]*persistence.MapQItem, error)
+		// DeleteMapQItems removes all items of a partition up to and including inclusiveMaxOffset
+		DeleteMapQItems(ctx context.Context, queueID, partition string, inclusiveMaxOffset int64) error
+		// InsertMapQState creates the state row of a queue
+		// Must return conditionFailed error if the row already exists
+		InsertMapQState(ctx context.Context, row *persistence.MapQState) error
+		// UpdateMapQStateCas **conditionally** updates the state row of a queue if its version is previousVersion
+		// Must return conditionFailed error if the condition is not met
+		UpdateMapQStateCas(ctx context.Context, row *persistence.MapQState, previousVersion int64) error
+		// SelectMapQState returns the state row of a queue
+		// Must return NotFound error if the row doesn't exist
+		SelectMapQState(ctx context.Context, queueID string) (*persistence.MapQState, error)
+	}
+
+	/***
+	* ShardDistributorCRUD is for storing the leader and executors of shard distributor namespaces
+	*
+	* Recommendation: one table(shard_distributor) with the leader and revision of the namespace in static columns,
+	* so that changes to executors can be conditioned on them
+	*
+	* Significant columns:
+	* shard_distributor: partition key(namespace), range key(executor_id), query condition columns(leader_version, revision)
+	 */
+	ShardDistributorCRUD interface {
+		// InsertShardDistributorNamespace creates the namespace row with leader version and revision 0
+		// Must return conditionFailed error if the row already exists
+		InsertShardDistributorNamespace(ctx context.Context, namespace string) error
+		// SelectShardDistributorNamespace returns the leader, executors and revision of a namespace
+		// Returns nil if the namespace row doesn't exist
+		SelectShardDistributorNamespace(ctx context.Context, namespace string) (*ShardDistributorNamespaceRow, error)
+		// UpdateShardDistributorLeaderCas **conditionally** updates the leader of a namespace if its leader version is previousVersion
+		// Must return conditionFailed error if the condition is not met
+		UpdateShardDistributorLeaderCas(ctx context.Context, namespace string, leader *persistence.ShardDistributorLeader, previousVersion int64) error
+		// UpdateShardDistributorExecutorsCas **conditionally** applies the changes to the executors of a namespace and increments its revision
+		// if its revision is PreviousRevision and, unless LeaderVersion is 0, its leader version is LeaderVersion
+		// Must return conditionFailed error if the condition is not met
+		UpdateShardDistributorExecutorsCas(ctx context.Context, request *ShardDistributorExecutorsWriteRequest) error
+	}
 )
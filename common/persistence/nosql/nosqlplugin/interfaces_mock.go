@@ -177,6 +177,34 @@ func (mr *MockDBMockRecorder) DeleteActiveClusterSelectionPolicy(ctx, shardID, d
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteActiveClusterSelectionPolicy", reflect.TypeOf((*MockDB)(nil).DeleteActiveClusterSelectionPolicy), ctx, shardID, domainID, workflowID, runID)
 }
 
+// DeleteAsyncRequestDLQEntry mocks base method.
+func (m *MockDB) DeleteAsyncRequestDLQEntry(ctx context.Context, domainID string, requestID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAsyncRequestDLQEntry", ctx, domainID, requestID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAsyncRequestDLQEntry indicates an expected call of DeleteAsyncRequestDLQEntry.
+func (mr *MockDBMockRecorder) DeleteAsyncRequestDLQEntry(ctx, domainID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAsyncRequestDLQEntry", reflect.TypeOf((*MockDB)(nil).DeleteAsyncRequestDLQEntry), ctx, domainID, requestID)
+}
+
+// DeleteAsyncRequestQueueMessages mocks base method.
+func (m *MockDB) DeleteAsyncRequestQueueMessages(ctx context.Context, queueName string, inclusiveEndMessageID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAsyncRequestQueueMessages", ctx, queueName, inclusiveEndMessageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAsyncRequestQueueMessages indicates an expected call of DeleteAsyncRequestQueueMessages.
+func (mr *MockDBMockRecorder) DeleteAsyncRequestQueueMessages(ctx, queueName, inclusiveEndMessageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAsyncRequestQueueMessages", reflect.TypeOf((*MockDB)(nil).DeleteAsyncRequestQueueMessages), ctx, queueName, inclusiveEndMessageID)
+}
+
 // DeleteCrossClusterTask mocks base method.
 func (m *MockDB) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
 	m.ctrl.T.Helper()
@@ -233,6 +261,20 @@ func (mr *MockDBMockRecorder) DeleteFromHistoryTreeAndNode(ctx, treeFilter, node
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromHistoryTreeAndNode", reflect.TypeOf((*MockDB)(nil).DeleteFromHistoryTreeAndNode), ctx, treeFilter, nodeFilters)
 }
 
+// DeleteMapQItems mocks base method.
+func (m *MockDB) DeleteMapQItems(ctx context.Context, queueID string, partition string, inclusiveMaxOffset int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMapQItems", ctx, queueID, partition, inclusiveMaxOffset)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMapQItems indicates an expected call of DeleteMapQItems.
+func (mr *MockDBMockRecorder) DeleteMapQItems(ctx, queueID, partition, inclusiveMaxOffset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMapQItems", reflect.TypeOf((*MockDB)(nil).DeleteMapQItems), ctx, queueID, partition, inclusiveMaxOffset)
+}
+
 // DeleteMessage mocks base method.
 func (m *MockDB) DeleteMessage(ctx context.Context, queueType persistence.QueueType, messageID int64) error {
 	m.ctrl.T.Helper()
@@ -403,6 +445,62 @@ func (mr *MockDBMockRecorder) GetTasksCount(ctx, filter any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasksCount", reflect.TypeOf((*MockDB)(nil).GetTasksCount), ctx, filter)
 }
 
+// InsertAsyncRequest mocks base method.
+func (m *MockDB) InsertAsyncRequest(ctx context.Context, row *persistence.AsyncRequestInfo, ttlSeconds int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAsyncRequest", ctx, row, ttlSeconds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAsyncRequest indicates an expected call of InsertAsyncRequest.
+func (mr *MockDBMockRecorder) InsertAsyncRequest(ctx, row, ttlSeconds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAsyncRequest", reflect.TypeOf((*MockDB)(nil).InsertAsyncRequest), ctx, row, ttlSeconds)
+}
+
+// InsertAsyncRequestDLQEntry mocks base method.
+func (m *MockDB) InsertAsyncRequestDLQEntry(ctx context.Context, row *persistence.AsyncRequestDLQEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAsyncRequestDLQEntry", ctx, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAsyncRequestDLQEntry indicates an expected call of InsertAsyncRequestDLQEntry.
+func (mr *MockDBMockRecorder) InsertAsyncRequestDLQEntry(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAsyncRequestDLQEntry", reflect.TypeOf((*MockDB)(nil).InsertAsyncRequestDLQEntry), ctx, row)
+}
+
+// InsertAsyncRequestQueueMessage mocks base method.
+func (m *MockDB) InsertAsyncRequestQueueMessage(ctx context.Context, queueName string, row *persistence.AsyncRequestQueueMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAsyncRequestQueueMessage", ctx, queueName, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAsyncRequestQueueMessage indicates an expected call of InsertAsyncRequestQueueMessage.
+func (mr *MockDBMockRecorder) InsertAsyncRequestQueueMessage(ctx, queueName, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAsyncRequestQueueMessage", reflect.TypeOf((*MockDB)(nil).InsertAsyncRequestQueueMessage), ctx, queueName, row)
+}
+
+// InsertAsyncRequestQueueMetadata mocks base method.
+func (m *MockDB) InsertAsyncRequestQueueMetadata(ctx context.Context, row *persistence.AsyncRequestQueueMetadata) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAsyncRequestQueueMetadata", ctx, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAsyncRequestQueueMetadata indicates an expected call of InsertAsyncRequestQueueMetadata.
+func (mr *MockDBMockRecorder) InsertAsyncRequestQueueMetadata(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAsyncRequestQueueMetadata", reflect.TypeOf((*MockDB)(nil).InsertAsyncRequestQueueMetadata), ctx, row)
+}
+
 // InsertConfig mocks base method.
 func (m *MockDB) InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error {
 	m.ctrl.T.Helper()
@@ -459,6 +557,34 @@ func (mr *MockDBMockRecorder) InsertIntoQueue(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoQueue", reflect.TypeOf((*MockDB)(nil).InsertIntoQueue), ctx, row)
 }
 
+// InsertMapQItems mocks base method.
+func (m *MockDB) InsertMapQItems(ctx context.Context, queueID string, rows []*persistence.MapQItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertMapQItems", ctx, queueID, rows)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertMapQItems indicates an expected call of InsertMapQItems.
+func (mr *MockDBMockRecorder) InsertMapQItems(ctx, queueID, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertMapQItems", reflect.TypeOf((*MockDB)(nil).InsertMapQItems), ctx, queueID, rows)
+}
+
+// InsertMapQState mocks base method.
+func (m *MockDB) InsertMapQState(ctx context.Context, row *persistence.MapQState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertMapQState", ctx, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertMapQState indicates an expected call of InsertMapQState.
+func (mr *MockDBMockRecorder) InsertMapQState(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertMapQState", reflect.TypeOf((*MockDB)(nil).InsertMapQState), ctx, row)
+}
+
 // InsertQueueMetadata mocks base method.
 func (m *MockDB) InsertQueueMetadata(ctx context.Context, row QueueMetadataRow) error {
 	m.ctrl.T.Helper()
@@ -515,6 +641,20 @@ func (mr *MockDBMockRecorder) InsertShard(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertShard", reflect.TypeOf((*MockDB)(nil).InsertShard), ctx, row)
 }
 
+// InsertShardDistributorNamespace mocks base method.
+func (m *MockDB) InsertShardDistributorNamespace(ctx context.Context, namespace string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertShardDistributorNamespace", ctx, namespace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertShardDistributorNamespace indicates an expected call of InsertShardDistributorNamespace.
+func (mr *MockDBMockRecorder) InsertShardDistributorNamespace(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertShardDistributorNamespace", reflect.TypeOf((*MockDB)(nil).InsertShardDistributorNamespace), ctx, namespace)
+}
+
 // InsertTaskList mocks base method.
 func (m *MockDB) InsertTaskList(ctx context.Context, row *TaskListRow) error {
 	m.ctrl.T.Helper()
@@ -821,6 +961,82 @@ func (mr *MockDBMockRecorder) SelectAllWorkflowExecutions(ctx, shardID, pageToke
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAllWorkflowExecutions", reflect.TypeOf((*MockDB)(nil).SelectAllWorkflowExecutions), ctx, shardID, pageToken, pageSize)
 }
 
+// SelectAsyncRequest mocks base method.
+func (m *MockDB) SelectAsyncRequest(ctx context.Context, domainID string, requestID string) (*persistence.AsyncRequestInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAsyncRequest", ctx, domainID, requestID)
+	ret0, _ := ret[0].(*persistence.AsyncRequestInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAsyncRequest indicates an expected call of SelectAsyncRequest.
+func (mr *MockDBMockRecorder) SelectAsyncRequest(ctx, domainID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAsyncRequest", reflect.TypeOf((*MockDB)(nil).SelectAsyncRequest), ctx, domainID, requestID)
+}
+
+// SelectAsyncRequestDLQEntries mocks base method.
+func (m *MockDB) SelectAsyncRequestDLQEntries(ctx context.Context, domainID string, pageSize int, pageToken []byte) ([]*persistence.AsyncRequestDLQEntry, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAsyncRequestDLQEntries", ctx, domainID, pageSize, pageToken)
+	ret0, _ := ret[0].([]*persistence.AsyncRequestDLQEntry)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SelectAsyncRequestDLQEntries indicates an expected call of SelectAsyncRequestDLQEntries.
+func (mr *MockDBMockRecorder) SelectAsyncRequestDLQEntries(ctx, domainID, pageSize, pageToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAsyncRequestDLQEntries", reflect.TypeOf((*MockDB)(nil).SelectAsyncRequestDLQEntries), ctx, domainID, pageSize, pageToken)
+}
+
+// SelectAsyncRequestDLQEntry mocks base method.
+func (m *MockDB) SelectAsyncRequestDLQEntry(ctx context.Context, domainID string, requestID string) (*persistence.AsyncRequestDLQEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAsyncRequestDLQEntry", ctx, domainID, requestID)
+	ret0, _ := ret[0].(*persistence.AsyncRequestDLQEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAsyncRequestDLQEntry indicates an expected call of SelectAsyncRequestDLQEntry.
+func (mr *MockDBMockRecorder) SelectAsyncRequestDLQEntry(ctx, domainID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAsyncRequestDLQEntry", reflect.TypeOf((*MockDB)(nil).SelectAsyncRequestDLQEntry), ctx, domainID, requestID)
+}
+
+// SelectAsyncRequestQueueMessages mocks base method.
+func (m *MockDB) SelectAsyncRequestQueueMessages(ctx context.Context, queueName string, exclusiveBeginMessageID int64, maxRows int) ([]*persistence.AsyncRequestQueueMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAsyncRequestQueueMessages", ctx, queueName, exclusiveBeginMessageID, maxRows)
+	ret0, _ := ret[0].([]*persistence.AsyncRequestQueueMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAsyncRequestQueueMessages indicates an expected call of SelectAsyncRequestQueueMessages.
+func (mr *MockDBMockRecorder) SelectAsyncRequestQueueMessages(ctx, queueName, exclusiveBeginMessageID, maxRows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAsyncRequestQueueMessages", reflect.TypeOf((*MockDB)(nil).SelectAsyncRequestQueueMessages), ctx, queueName, exclusiveBeginMessageID, maxRows)
+}
+
+// SelectAsyncRequestQueueMetadata mocks base method.
+func (m *MockDB) SelectAsyncRequestQueueMetadata(ctx context.Context, queueName string) (*persistence.AsyncRequestQueueMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAsyncRequestQueueMetadata", ctx, queueName)
+	ret0, _ := ret[0].(*persistence.AsyncRequestQueueMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAsyncRequestQueueMetadata indicates an expected call of SelectAsyncRequestQueueMetadata.
+func (mr *MockDBMockRecorder) SelectAsyncRequestQueueMetadata(ctx, queueName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAsyncRequestQueueMetadata", reflect.TypeOf((*MockDB)(nil).SelectAsyncRequestQueueMetadata), ctx, queueName)
+}
+
 // SelectCurrentWorkflow mocks base method.
 func (m *MockDB) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*CurrentWorkflowRow, error) {
 	m.ctrl.T.Helper()
@@ -897,6 +1113,21 @@ func (mr *MockDBMockRecorder) SelectFromHistoryTree(ctx, filter any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHistoryTree", reflect.TypeOf((*MockDB)(nil).SelectFromHistoryTree), ctx, filter)
 }
 
+// SelectLastAsyncRequestQueueMessageID mocks base method.
+func (m *MockDB) SelectLastAsyncRequestQueueMessageID(ctx context.Context, queueName string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectLastAsyncRequestQueueMessageID", ctx, queueName)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectLastAsyncRequestQueueMessageID indicates an expected call of SelectLastAsyncRequestQueueMessageID.
+func (mr *MockDBMockRecorder) SelectLastAsyncRequestQueueMessageID(ctx, queueName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectLastAsyncRequestQueueMessageID", reflect.TypeOf((*MockDB)(nil).SelectLastAsyncRequestQueueMessageID), ctx, queueName)
+}
+
 // SelectLastEnqueuedMessageID mocks base method.
 func (m *MockDB) SelectLastEnqueuedMessageID(ctx context.Context, queueType persistence.QueueType) (int64, error) {
 	m.ctrl.T.Helper()
@@ -927,6 +1158,36 @@ func (mr *MockDBMockRecorder) SelectLatestConfig(ctx, rowType any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectLatestConfig", reflect.TypeOf((*MockDB)(nil).SelectLatestConfig), ctx, rowType)
 }
 
+// SelectMapQItems mocks base method.
+func (m *MockDB) SelectMapQItems(ctx context.Context, queueID string, partition string, exclusiveMinOffset int64, inclusiveMaxOffset int64, maxRows int) ([]*persistence.MapQItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectMapQItems", ctx, queueID, partition, exclusiveMinOffset, inclusiveMaxOffset, maxRows)
+	ret0, _ := ret[0].([]*persistence.MapQItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectMapQItems indicates an expected call of SelectMapQItems.
+func (mr *MockDBMockRecorder) SelectMapQItems(ctx, queueID, partition, exclusiveMinOffset, inclusiveMaxOffset, maxRows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectMapQItems", reflect.TypeOf((*MockDB)(nil).SelectMapQItems), ctx, queueID, partition, exclusiveMinOffset, inclusiveMaxOffset, maxRows)
+}
+
+// SelectMapQState mocks base method.
+func (m *MockDB) SelectMapQState(ctx context.Context, queueID string) (*persistence.MapQState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectMapQState", ctx, queueID)
+	ret0, _ := ret[0].(*persistence.MapQState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectMapQState indicates an expected call of SelectMapQState.
+func (mr *MockDBMockRecorder) SelectMapQState(ctx, queueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectMapQState", reflect.TypeOf((*MockDB)(nil).SelectMapQState), ctx, queueID)
+}
+
 // SelectMessagesBetween mocks base method.
 func (m *MockDB) SelectMessagesBetween(ctx context.Context, request SelectMessagesBetweenRequest) (*SelectMessagesBetweenResponse, error) {
 	m.ctrl.T.Helper()
@@ -1050,6 +1311,21 @@ func (mr *MockDBMockRecorder) SelectShard(ctx, shardID, currentClusterName any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectShard", reflect.TypeOf((*MockDB)(nil).SelectShard), ctx, shardID, currentClusterName)
 }
 
+// SelectShardDistributorNamespace mocks base method.
+func (m *MockDB) SelectShardDistributorNamespace(ctx context.Context, namespace string) (*ShardDistributorNamespaceRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectShardDistributorNamespace", ctx, namespace)
+	ret0, _ := ret[0].(*ShardDistributorNamespaceRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectShardDistributorNamespace indicates an expected call of SelectShardDistributorNamespace.
+func (mr *MockDBMockRecorder) SelectShardDistributorNamespace(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectShardDistributorNamespace", reflect.TypeOf((*MockDB)(nil).SelectShardDistributorNamespace), ctx, namespace)
+}
+
 // SelectTaskList mocks base method.
 func (m *MockDB) SelectTaskList(ctx context.Context, filter *TaskListFilter) (*TaskListRow, error) {
 	m.ctrl.T.Helper()
@@ -1142,6 +1418,20 @@ func (mr *MockDBMockRecorder) SelectWorkflowExecution(ctx, shardID, domainID, wo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectWorkflowExecution", reflect.TypeOf((*MockDB)(nil).SelectWorkflowExecution), ctx, shardID, domainID, workflowID, runID)
 }
 
+// UpdateAsyncRequestQueueMetadataCas mocks base method.
+func (m *MockDB) UpdateAsyncRequestQueueMetadataCas(ctx context.Context, row *persistence.AsyncRequestQueueMetadata, previousVersion int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAsyncRequestQueueMetadataCas", ctx, row, previousVersion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAsyncRequestQueueMetadataCas indicates an expected call of UpdateAsyncRequestQueueMetadataCas.
+func (mr *MockDBMockRecorder) UpdateAsyncRequestQueueMetadataCas(ctx, row, previousVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAsyncRequestQueueMetadataCas", reflect.TypeOf((*MockDB)(nil).UpdateAsyncRequestQueueMetadataCas), ctx, row, previousVersion)
+}
+
 // UpdateDomain mocks base method.
 func (m *MockDB) UpdateDomain(ctx context.Context, row *DomainRow) error {
 	m.ctrl.T.Helper()
@@ -1156,6 +1446,20 @@ func (mr *MockDBMockRecorder) UpdateDomain(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomain", reflect.TypeOf((*MockDB)(nil).UpdateDomain), ctx, row)
 }
 
+// UpdateMapQStateCas mocks base method.
+func (m *MockDB) UpdateMapQStateCas(ctx context.Context, row *persistence.MapQState, previousVersion int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMapQStateCas", ctx, row, previousVersion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMapQStateCas indicates an expected call of UpdateMapQStateCas.
+func (mr *MockDBMockRecorder) UpdateMapQStateCas(ctx, row, previousVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMapQStateCas", reflect.TypeOf((*MockDB)(nil).UpdateMapQStateCas), ctx, row, previousVersion)
+}
+
 // UpdateQueueMetadataCas mocks base method.
 func (m *MockDB) UpdateQueueMetadataCas(ctx context.Context, row QueueMetadataRow) error {
 	m.ctrl.T.Helper()
@@ -1198,6 +1502,34 @@ func (mr *MockDBMockRecorder) UpdateShard(ctx, row, previousRangeID any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShard", reflect.TypeOf((*MockDB)(nil).UpdateShard), ctx, row, previousRangeID)
 }
 
+// UpdateShardDistributorExecutorsCas mocks base method.
+func (m *MockDB) UpdateShardDistributorExecutorsCas(ctx context.Context, request *ShardDistributorExecutorsWriteRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorExecutorsCas", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateShardDistributorExecutorsCas indicates an expected call of UpdateShardDistributorExecutorsCas.
+func (mr *MockDBMockRecorder) UpdateShardDistributorExecutorsCas(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorExecutorsCas", reflect.TypeOf((*MockDB)(nil).UpdateShardDistributorExecutorsCas), ctx, request)
+}
+
+// UpdateShardDistributorLeaderCas mocks base method.
+func (m *MockDB) UpdateShardDistributorLeaderCas(ctx context.Context, namespace string, leader *persistence.ShardDistributorLeader, previousVersion int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorLeaderCas", ctx, namespace, leader, previousVersion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateShardDistributorLeaderCas indicates an expected call of UpdateShardDistributorLeaderCas.
+func (mr *MockDBMockRecorder) UpdateShardDistributorLeaderCas(ctx, namespace, leader, previousVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorLeaderCas", reflect.TypeOf((*MockDB)(nil).UpdateShardDistributorLeaderCas), ctx, namespace, leader, previousVersion)
+}
+
 // UpdateTaskList mocks base method.
 func (m *MockDB) UpdateTaskList(ctx context.Context, row *TaskListRow, previousRangeID int64) error {
 	m.ctrl.T.Helper()
@@ -1240,6 +1572,20 @@ func (mr *MockDBMockRecorder) UpdateVisibility(ctx, ttlSeconds, row any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVisibility", reflect.TypeOf((*MockDB)(nil).UpdateVisibility), ctx, ttlSeconds, row)
 }
 
+// UpdateVisibilityMemo mocks base method.
+func (m *MockDB) UpdateVisibilityMemo(ctx context.Context, ttlSeconds int64, row *VisibilityRowForUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVisibilityMemo", ctx, ttlSeconds, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVisibilityMemo indicates an expected call of UpdateVisibilityMemo.
+func (mr *MockDBMockRecorder) UpdateVisibilityMemo(ctx, ttlSeconds, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVisibilityMemo", reflect.TypeOf((*MockDB)(nil).UpdateVisibilityMemo), ctx, ttlSeconds, row)
+}
+
 // UpdateWorkflowExecutionWithTasks mocks base method.
 func (m *MockDB) UpdateWorkflowExecutionWithTasks(ctx context.Context, requests *WorkflowRequestsWriteRequest, currentWorkflowRequest *CurrentWorkflowWriteRequest, mutatedExecution, insertedExecution, resetExecution *WorkflowExecutionRequest, tasksByCategory map[persistence.HistoryTaskCategory][]*HistoryMigrationTask, shardCondition *ShardCondition) error {
 	m.ctrl.T.Helper()
@@ -1292,6 +1638,34 @@ func (mr *MocktableCRUDMockRecorder) DeleteActiveClusterSelectionPolicy(ctx, sha
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteActiveClusterSelectionPolicy", reflect.TypeOf((*MocktableCRUD)(nil).DeleteActiveClusterSelectionPolicy), ctx, shardID, domainID, workflowID, runID)
 }
 
+// DeleteAsyncRequestDLQEntry mocks base method.
+func (m *MocktableCRUD) DeleteAsyncRequestDLQEntry(ctx context.Context, domainID string, requestID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAsyncRequestDLQEntry", ctx, domainID, requestID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAsyncRequestDLQEntry indicates an expected call of DeleteAsyncRequestDLQEntry.
+func (mr *MocktableCRUDMockRecorder) DeleteAsyncRequestDLQEntry(ctx, domainID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAsyncRequestDLQEntry", reflect.TypeOf((*MocktableCRUD)(nil).DeleteAsyncRequestDLQEntry), ctx, domainID, requestID)
+}
+
+// DeleteAsyncRequestQueueMessages mocks base method.
+func (m *MocktableCRUD) DeleteAsyncRequestQueueMessages(ctx context.Context, queueName string, inclusiveEndMessageID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAsyncRequestQueueMessages", ctx, queueName, inclusiveEndMessageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAsyncRequestQueueMessages indicates an expected call of DeleteAsyncRequestQueueMessages.
+func (mr *MocktableCRUDMockRecorder) DeleteAsyncRequestQueueMessages(ctx, queueName, inclusiveEndMessageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAsyncRequestQueueMessages", reflect.TypeOf((*MocktableCRUD)(nil).DeleteAsyncRequestQueueMessages), ctx, queueName, inclusiveEndMessageID)
+}
+
 // DeleteCrossClusterTask mocks base method.
 func (m *MocktableCRUD) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
 	m.ctrl.T.Helper()
@@ -1348,6 +1722,20 @@ func (mr *MocktableCRUDMockRecorder) DeleteFromHistoryTreeAndNode(ctx, treeFilte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromHistoryTreeAndNode", reflect.TypeOf((*MocktableCRUD)(nil).DeleteFromHistoryTreeAndNode), ctx, treeFilter, nodeFilters)
 }
 
+// DeleteMapQItems mocks base method.
+func (m *MocktableCRUD) DeleteMapQItems(ctx context.Context, queueID string, partition string, inclusiveMaxOffset int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMapQItems", ctx, queueID, partition, inclusiveMaxOffset)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMapQItems indicates an expected call of DeleteMapQItems.
+func (mr *MocktableCRUDMockRecorder) DeleteMapQItems(ctx, queueID, partition, inclusiveMaxOffset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMapQItems", reflect.TypeOf((*MocktableCRUD)(nil).DeleteMapQItems), ctx, queueID, partition, inclusiveMaxOffset)
+}
+
 // DeleteMessage mocks base method.
 func (m *MocktableCRUD) DeleteMessage(ctx context.Context, queueType persistence.QueueType, messageID int64) error {
 	m.ctrl.T.Helper()
@@ -1518,6 +1906,62 @@ func (mr *MocktableCRUDMockRecorder) GetTasksCount(ctx, filter any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasksCount", reflect.TypeOf((*MocktableCRUD)(nil).GetTasksCount), ctx, filter)
 }
 
+// InsertAsyncRequest mocks base method.
+func (m *MocktableCRUD) InsertAsyncRequest(ctx context.Context, row *persistence.AsyncRequestInfo, ttlSeconds int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAsyncRequest", ctx, row, ttlSeconds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAsyncRequest indicates an expected call of InsertAsyncRequest.
+func (mr *MocktableCRUDMockRecorder) InsertAsyncRequest(ctx, row, ttlSeconds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAsyncRequest", reflect.TypeOf((*MocktableCRUD)(nil).InsertAsyncRequest), ctx, row, ttlSeconds)
+}
+
+// InsertAsyncRequestDLQEntry mocks base method.
+func (m *MocktableCRUD) InsertAsyncRequestDLQEntry(ctx context.Context, row *persistence.AsyncRequestDLQEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAsyncRequestDLQEntry", ctx, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAsyncRequestDLQEntry indicates an expected call of InsertAsyncRequestDLQEntry.
+func (mr *MocktableCRUDMockRecorder) InsertAsyncRequestDLQEntry(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAsyncRequestDLQEntry", reflect.TypeOf((*MocktableCRUD)(nil).InsertAsyncRequestDLQEntry), ctx, row)
+}
+
+// InsertAsyncRequestQueueMessage mocks base method.
+func (m *MocktableCRUD) InsertAsyncRequestQueueMessage(ctx context.Context, queueName string, row *persistence.AsyncRequestQueueMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAsyncRequestQueueMessage", ctx, queueName, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAsyncRequestQueueMessage indicates an expected call of InsertAsyncRequestQueueMessage.
+func (mr *MocktableCRUDMockRecorder) InsertAsyncRequestQueueMessage(ctx, queueName, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAsyncRequestQueueMessage", reflect.TypeOf((*MocktableCRUD)(nil).InsertAsyncRequestQueueMessage), ctx, queueName, row)
+}
+
+// InsertAsyncRequestQueueMetadata mocks base method.
+func (m *MocktableCRUD) InsertAsyncRequestQueueMetadata(ctx context.Context, row *persistence.AsyncRequestQueueMetadata) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAsyncRequestQueueMetadata", ctx, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAsyncRequestQueueMetadata indicates an expected call of InsertAsyncRequestQueueMetadata.
+func (mr *MocktableCRUDMockRecorder) InsertAsyncRequestQueueMetadata(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAsyncRequestQueueMetadata", reflect.TypeOf((*MocktableCRUD)(nil).InsertAsyncRequestQueueMetadata), ctx, row)
+}
+
 // InsertConfig mocks base method.
 func (m *MocktableCRUD) InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error {
 	m.ctrl.T.Helper()
@@ -1574,18 +2018,46 @@ func (mr *MocktableCRUDMockRecorder) InsertIntoQueue(ctx, row any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoQueue", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoQueue), ctx, row)
 }
 
-// InsertQueueMetadata mocks base method.
-func (m *MocktableCRUD) InsertQueueMetadata(ctx context.Context, row QueueMetadataRow) error {
+// InsertMapQItems mocks base method.
+func (m *MocktableCRUD) InsertMapQItems(ctx context.Context, queueID string, rows []*persistence.MapQItem) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InsertQueueMetadata", ctx, row)
+	ret := m.ctrl.Call(m, "InsertMapQItems", ctx, queueID, rows)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// InsertQueueMetadata indicates an expected call of InsertQueueMetadata.
-func (mr *MocktableCRUDMockRecorder) InsertQueueMetadata(ctx, row any) *gomock.Call {
+// InsertMapQItems indicates an expected call of InsertMapQItems.
+func (mr *MocktableCRUDMockRecorder) InsertMapQItems(ctx, queueID, rows any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertQueueMetadata", reflect.TypeOf((*MocktableCRUD)(nil).InsertQueueMetadata), ctx, row)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertMapQItems", reflect.TypeOf((*MocktableCRUD)(nil).InsertMapQItems), ctx, queueID, rows)
+}
+
+// InsertMapQState mocks base method.
+func (m *MocktableCRUD) InsertMapQState(ctx context.Context, row *persistence.MapQState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertMapQState", ctx, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertMapQState indicates an expected call of InsertMapQState.
+func (mr *MocktableCRUDMockRecorder) InsertMapQState(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertMapQState", reflect.TypeOf((*MocktableCRUD)(nil).InsertMapQState), ctx, row)
+}
+
+// InsertQueueMetadata mocks base method.
+func (m *MocktableCRUD) InsertQueueMetadata(ctx context.Context, row QueueMetadataRow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertQueueMetadata", ctx, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertQueueMetadata indicates an expected call of InsertQueueMetadata.
+func (mr *MocktableCRUDMockRecorder) InsertQueueMetadata(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertQueueMetadata", reflect.TypeOf((*MocktableCRUD)(nil).InsertQueueMetadata), ctx, row)
 }
 
 // InsertReplicationDLQTask mocks base method.
@@ -1630,6 +2102,20 @@ func (mr *MocktableCRUDMockRecorder) InsertShard(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertShard", reflect.TypeOf((*MocktableCRUD)(nil).InsertShard), ctx, row)
 }
 
+// InsertShardDistributorNamespace mocks base method.
+func (m *MocktableCRUD) InsertShardDistributorNamespace(ctx context.Context, namespace string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertShardDistributorNamespace", ctx, namespace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertShardDistributorNamespace indicates an expected call of InsertShardDistributorNamespace.
+func (mr *MocktableCRUDMockRecorder) InsertShardDistributorNamespace(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertShardDistributorNamespace", reflect.TypeOf((*MocktableCRUD)(nil).InsertShardDistributorNamespace), ctx, namespace)
+}
+
 // InsertTaskList mocks base method.
 func (m *MocktableCRUD) InsertTaskList(ctx context.Context, row *TaskListRow) error {
 	m.ctrl.T.Helper()
@@ -1866,6 +2352,82 @@ func (mr *MocktableCRUDMockRecorder) SelectAllWorkflowExecutions(ctx, shardID, p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAllWorkflowExecutions", reflect.TypeOf((*MocktableCRUD)(nil).SelectAllWorkflowExecutions), ctx, shardID, pageToken, pageSize)
 }
 
+// SelectAsyncRequest mocks base method.
+func (m *MocktableCRUD) SelectAsyncRequest(ctx context.Context, domainID string, requestID string) (*persistence.AsyncRequestInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAsyncRequest", ctx, domainID, requestID)
+	ret0, _ := ret[0].(*persistence.AsyncRequestInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAsyncRequest indicates an expected call of SelectAsyncRequest.
+func (mr *MocktableCRUDMockRecorder) SelectAsyncRequest(ctx, domainID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAsyncRequest", reflect.TypeOf((*MocktableCRUD)(nil).SelectAsyncRequest), ctx, domainID, requestID)
+}
+
+// SelectAsyncRequestDLQEntries mocks base method.
+func (m *MocktableCRUD) SelectAsyncRequestDLQEntries(ctx context.Context, domainID string, pageSize int, pageToken []byte) ([]*persistence.AsyncRequestDLQEntry, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAsyncRequestDLQEntries", ctx, domainID, pageSize, pageToken)
+	ret0, _ := ret[0].([]*persistence.AsyncRequestDLQEntry)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SelectAsyncRequestDLQEntries indicates an expected call of SelectAsyncRequestDLQEntries.
+func (mr *MocktableCRUDMockRecorder) SelectAsyncRequestDLQEntries(ctx, domainID, pageSize, pageToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAsyncRequestDLQEntries", reflect.TypeOf((*MocktableCRUD)(nil).SelectAsyncRequestDLQEntries), ctx, domainID, pageSize, pageToken)
+}
+
+// SelectAsyncRequestDLQEntry mocks base method.
+func (m *MocktableCRUD) SelectAsyncRequestDLQEntry(ctx context.Context, domainID string, requestID string) (*persistence.AsyncRequestDLQEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAsyncRequestDLQEntry", ctx, domainID, requestID)
+	ret0, _ := ret[0].(*persistence.AsyncRequestDLQEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAsyncRequestDLQEntry indicates an expected call of SelectAsyncRequestDLQEntry.
+func (mr *MocktableCRUDMockRecorder) SelectAsyncRequestDLQEntry(ctx, domainID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAsyncRequestDLQEntry", reflect.TypeOf((*MocktableCRUD)(nil).SelectAsyncRequestDLQEntry), ctx, domainID, requestID)
+}
+
+// SelectAsyncRequestQueueMessages mocks base method.
+func (m *MocktableCRUD) SelectAsyncRequestQueueMessages(ctx context.Context, queueName string, exclusiveBeginMessageID int64, maxRows int) ([]*persistence.AsyncRequestQueueMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAsyncRequestQueueMessages", ctx, queueName, exclusiveBeginMessageID, maxRows)
+	ret0, _ := ret[0].([]*persistence.AsyncRequestQueueMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAsyncRequestQueueMessages indicates an expected call of SelectAsyncRequestQueueMessages.
+func (mr *MocktableCRUDMockRecorder) SelectAsyncRequestQueueMessages(ctx, queueName, exclusiveBeginMessageID, maxRows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAsyncRequestQueueMessages", reflect.TypeOf((*MocktableCRUD)(nil).SelectAsyncRequestQueueMessages), ctx, queueName, exclusiveBeginMessageID, maxRows)
+}
+
+// SelectAsyncRequestQueueMetadata mocks base method.
+func (m *MocktableCRUD) SelectAsyncRequestQueueMetadata(ctx context.Context, queueName string) (*persistence.AsyncRequestQueueMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAsyncRequestQueueMetadata", ctx, queueName)
+	ret0, _ := ret[0].(*persistence.AsyncRequestQueueMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAsyncRequestQueueMetadata indicates an expected call of SelectAsyncRequestQueueMetadata.
+func (mr *MocktableCRUDMockRecorder) SelectAsyncRequestQueueMetadata(ctx, queueName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAsyncRequestQueueMetadata", reflect.TypeOf((*MocktableCRUD)(nil).SelectAsyncRequestQueueMetadata), ctx, queueName)
+}
+
 // SelectCurrentWorkflow mocks base method.
 func (m *MocktableCRUD) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*CurrentWorkflowRow, error) {
 	m.ctrl.T.Helper()
@@ -1942,6 +2504,21 @@ func (mr *MocktableCRUDMockRecorder) SelectFromHistoryTree(ctx, filter any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHistoryTree", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromHistoryTree), ctx, filter)
 }
 
+// SelectLastAsyncRequestQueueMessageID mocks base method.
+func (m *MocktableCRUD) SelectLastAsyncRequestQueueMessageID(ctx context.Context, queueName string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectLastAsyncRequestQueueMessageID", ctx, queueName)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectLastAsyncRequestQueueMessageID indicates an expected call of SelectLastAsyncRequestQueueMessageID.
+func (mr *MocktableCRUDMockRecorder) SelectLastAsyncRequestQueueMessageID(ctx, queueName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectLastAsyncRequestQueueMessageID", reflect.TypeOf((*MocktableCRUD)(nil).SelectLastAsyncRequestQueueMessageID), ctx, queueName)
+}
+
 // SelectLastEnqueuedMessageID mocks base method.
 func (m *MocktableCRUD) SelectLastEnqueuedMessageID(ctx context.Context, queueType persistence.QueueType) (int64, error) {
 	m.ctrl.T.Helper()
@@ -1972,6 +2549,36 @@ func (mr *MocktableCRUDMockRecorder) SelectLatestConfig(ctx, rowType any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectLatestConfig", reflect.TypeOf((*MocktableCRUD)(nil).SelectLatestConfig), ctx, rowType)
 }
 
+// SelectMapQItems mocks base method.
+func (m *MocktableCRUD) SelectMapQItems(ctx context.Context, queueID string, partition string, exclusiveMinOffset int64, inclusiveMaxOffset int64, maxRows int) ([]*persistence.MapQItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectMapQItems", ctx, queueID, partition, exclusiveMinOffset, inclusiveMaxOffset, maxRows)
+	ret0, _ := ret[0].([]*persistence.MapQItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectMapQItems indicates an expected call of SelectMapQItems.
+func (mr *MocktableCRUDMockRecorder) SelectMapQItems(ctx, queueID, partition, exclusiveMinOffset, inclusiveMaxOffset, maxRows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectMapQItems", reflect.TypeOf((*MocktableCRUD)(nil).SelectMapQItems), ctx, queueID, partition, exclusiveMinOffset, inclusiveMaxOffset, maxRows)
+}
+
+// SelectMapQState mocks base method.
+func (m *MocktableCRUD) SelectMapQState(ctx context.Context, queueID string) (*persistence.MapQState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectMapQState", ctx, queueID)
+	ret0, _ := ret[0].(*persistence.MapQState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectMapQState indicates an expected call of SelectMapQState.
+func (mr *MocktableCRUDMockRecorder) SelectMapQState(ctx, queueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectMapQState", reflect.TypeOf((*MocktableCRUD)(nil).SelectMapQState), ctx, queueID)
+}
+
 // SelectMessagesBetween mocks base method.
 func (m *MocktableCRUD) SelectMessagesBetween(ctx context.Context, request SelectMessagesBetweenRequest) (*SelectMessagesBetweenResponse, error) {
 	m.ctrl.T.Helper()
@@ -2095,6 +2702,21 @@ func (mr *MocktableCRUDMockRecorder) SelectShard(ctx, shardID, currentClusterNam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectShard", reflect.TypeOf((*MocktableCRUD)(nil).SelectShard), ctx, shardID, currentClusterName)
 }
 
+// SelectShardDistributorNamespace mocks base method.
+func (m *MocktableCRUD) SelectShardDistributorNamespace(ctx context.Context, namespace string) (*ShardDistributorNamespaceRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectShardDistributorNamespace", ctx, namespace)
+	ret0, _ := ret[0].(*ShardDistributorNamespaceRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectShardDistributorNamespace indicates an expected call of SelectShardDistributorNamespace.
+func (mr *MocktableCRUDMockRecorder) SelectShardDistributorNamespace(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectShardDistributorNamespace", reflect.TypeOf((*MocktableCRUD)(nil).SelectShardDistributorNamespace), ctx, namespace)
+}
+
 // SelectTaskList mocks base method.
 func (m *MocktableCRUD) SelectTaskList(ctx context.Context, filter *TaskListFilter) (*TaskListRow, error) {
 	m.ctrl.T.Helper()
@@ -2187,6 +2809,20 @@ func (mr *MocktableCRUDMockRecorder) SelectWorkflowExecution(ctx, shardID, domai
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectWorkflowExecution", reflect.TypeOf((*MocktableCRUD)(nil).SelectWorkflowExecution), ctx, shardID, domainID, workflowID, runID)
 }
 
+// UpdateAsyncRequestQueueMetadataCas mocks base method.
+func (m *MocktableCRUD) UpdateAsyncRequestQueueMetadataCas(ctx context.Context, row *persistence.AsyncRequestQueueMetadata, previousVersion int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAsyncRequestQueueMetadataCas", ctx, row, previousVersion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAsyncRequestQueueMetadataCas indicates an expected call of UpdateAsyncRequestQueueMetadataCas.
+func (mr *MocktableCRUDMockRecorder) UpdateAsyncRequestQueueMetadataCas(ctx, row, previousVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAsyncRequestQueueMetadataCas", reflect.TypeOf((*MocktableCRUD)(nil).UpdateAsyncRequestQueueMetadataCas), ctx, row, previousVersion)
+}
+
 // UpdateDomain mocks base method.
 func (m *MocktableCRUD) UpdateDomain(ctx context.Context, row *DomainRow) error {
 	m.ctrl.T.Helper()
@@ -2201,6 +2837,20 @@ func (mr *MocktableCRUDMockRecorder) UpdateDomain(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomain", reflect.TypeOf((*MocktableCRUD)(nil).UpdateDomain), ctx, row)
 }
 
+// UpdateMapQStateCas mocks base method.
+func (m *MocktableCRUD) UpdateMapQStateCas(ctx context.Context, row *persistence.MapQState, previousVersion int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMapQStateCas", ctx, row, previousVersion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMapQStateCas indicates an expected call of UpdateMapQStateCas.
+func (mr *MocktableCRUDMockRecorder) UpdateMapQStateCas(ctx, row, previousVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMapQStateCas", reflect.TypeOf((*MocktableCRUD)(nil).UpdateMapQStateCas), ctx, row, previousVersion)
+}
+
 // UpdateQueueMetadataCas mocks base method.
 func (m *MocktableCRUD) UpdateQueueMetadataCas(ctx context.Context, row QueueMetadataRow) error {
 	m.ctrl.T.Helper()
@@ -2243,6 +2893,34 @@ func (mr *MocktableCRUDMockRecorder) UpdateShard(ctx, row, previousRangeID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShard", reflect.TypeOf((*MocktableCRUD)(nil).UpdateShard), ctx, row, previousRangeID)
 }
 
+// UpdateShardDistributorExecutorsCas mocks base method.
+func (m *MocktableCRUD) UpdateShardDistributorExecutorsCas(ctx context.Context, request *ShardDistributorExecutorsWriteRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorExecutorsCas", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateShardDistributorExecutorsCas indicates an expected call of UpdateShardDistributorExecutorsCas.
+func (mr *MocktableCRUDMockRecorder) UpdateShardDistributorExecutorsCas(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorExecutorsCas", reflect.TypeOf((*MocktableCRUD)(nil).UpdateShardDistributorExecutorsCas), ctx, request)
+}
+
+// UpdateShardDistributorLeaderCas mocks base method.
+func (m *MocktableCRUD) UpdateShardDistributorLeaderCas(ctx context.Context, namespace string, leader *persistence.ShardDistributorLeader, previousVersion int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorLeaderCas", ctx, namespace, leader, previousVersion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateShardDistributorLeaderCas indicates an expected call of UpdateShardDistributorLeaderCas.
+func (mr *MocktableCRUDMockRecorder) UpdateShardDistributorLeaderCas(ctx, namespace, leader, previousVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorLeaderCas", reflect.TypeOf((*MocktableCRUD)(nil).UpdateShardDistributorLeaderCas), ctx, namespace, leader, previousVersion)
+}
+
 // UpdateTaskList mocks base method.
 func (m *MocktableCRUD) UpdateTaskList(ctx context.Context, row *TaskListRow, previousRangeID int64) error {
 	m.ctrl.T.Helper()
@@ -2285,6 +2963,20 @@ func (mr *MocktableCRUDMockRecorder) UpdateVisibility(ctx, ttlSeconds, row any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVisibility", reflect.TypeOf((*MocktableCRUD)(nil).UpdateVisibility), ctx, ttlSeconds, row)
 }
 
+// UpdateVisibilityMemo mocks base method.
+func (m *MocktableCRUD) UpdateVisibilityMemo(ctx context.Context, ttlSeconds int64, row *VisibilityRowForUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVisibilityMemo", ctx, ttlSeconds, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVisibilityMemo indicates an expected call of UpdateVisibilityMemo.
+func (mr *MocktableCRUDMockRecorder) UpdateVisibilityMemo(ctx, ttlSeconds, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVisibilityMemo", reflect.TypeOf((*MocktableCRUD)(nil).UpdateVisibilityMemo), ctx, ttlSeconds, row)
+}
+
 // UpdateWorkflowExecutionWithTasks mocks base method.
 func (m *MocktableCRUD) UpdateWorkflowExecutionWithTasks(ctx context.Context, requests *WorkflowRequestsWriteRequest, currentWorkflowRequest *CurrentWorkflowWriteRequest, mutatedExecution, insertedExecution, resetExecution *WorkflowExecutionRequest, tasksByCategory map[persistence.HistoryTaskCategory][]*HistoryMigrationTask, shardCondition *ShardCondition) error {
 	m.ctrl.T.Helper()
@@ -2951,6 +3643,20 @@ func (mr *MockVisibilityCRUDMockRecorder) UpdateVisibility(ctx, ttlSeconds, row
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVisibility", reflect.TypeOf((*MockVisibilityCRUD)(nil).UpdateVisibility), ctx, ttlSeconds, row)
 }
 
+// UpdateVisibilityMemo mocks base method.
+func (m *MockVisibilityCRUD) UpdateVisibilityMemo(ctx context.Context, ttlSeconds int64, row *VisibilityRowForUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVisibilityMemo", ctx, ttlSeconds, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVisibilityMemo indicates an expected call of UpdateVisibilityMemo.
+func (mr *MockVisibilityCRUDMockRecorder) UpdateVisibilityMemo(ctx, ttlSeconds, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVisibilityMemo", reflect.TypeOf((*MockVisibilityCRUD)(nil).UpdateVisibilityMemo), ctx, ttlSeconds, row)
+}
+
 // MockTaskCRUD is a mock of TaskCRUD interface.
 type MockTaskCRUD struct {
 	ctrl     *gomock.Controller
@@ -3591,3 +4297,407 @@ func (mr *MockConfigStoreCRUDMockRecorder) SelectLatestConfig(ctx, rowType any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectLatestConfig", reflect.TypeOf((*MockConfigStoreCRUD)(nil).SelectLatestConfig), ctx, rowType)
 }
+
+// MockAsyncRequestCRUD is a mock of AsyncRequestCRUD interface.
+type MockAsyncRequestCRUD struct {
+	ctrl     *gomock.Controller
+	recorder *MockAsyncRequestCRUDMockRecorder
+	isgomock struct{}
+}
+
+// MockAsyncRequestCRUDMockRecorder is the mock recorder for MockAsyncRequestCRUD.
+type MockAsyncRequestCRUDMockRecorder struct {
+	mock *MockAsyncRequestCRUD
+}
+
+// NewMockAsyncRequestCRUD creates a new mock instance.
+func NewMockAsyncRequestCRUD(ctrl *gomock.Controller) *MockAsyncRequestCRUD {
+	mock := &MockAsyncRequestCRUD{ctrl: ctrl}
+	mock.recorder = &MockAsyncRequestCRUDMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAsyncRequestCRUD) EXPECT() *MockAsyncRequestCRUDMockRecorder {
+	return m.recorder
+}
+
+// DeleteAsyncRequestDLQEntry mocks base method.
+func (m *MockAsyncRequestCRUD) DeleteAsyncRequestDLQEntry(ctx context.Context, domainID string, requestID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAsyncRequestDLQEntry", ctx, domainID, requestID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAsyncRequestDLQEntry indicates an expected call of DeleteAsyncRequestDLQEntry.
+func (mr *MockAsyncRequestCRUDMockRecorder) DeleteAsyncRequestDLQEntry(ctx, domainID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAsyncRequestDLQEntry", reflect.TypeOf((*MockAsyncRequestCRUD)(nil).DeleteAsyncRequestDLQEntry), ctx, domainID, requestID)
+}
+
+// DeleteAsyncRequestQueueMessages mocks base method.
+func (m *MockAsyncRequestCRUD) DeleteAsyncRequestQueueMessages(ctx context.Context, queueName string, inclusiveEndMessageID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAsyncRequestQueueMessages", ctx, queueName, inclusiveEndMessageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAsyncRequestQueueMessages indicates an expected call of DeleteAsyncRequestQueueMessages.
+func (mr *MockAsyncRequestCRUDMockRecorder) DeleteAsyncRequestQueueMessages(ctx, queueName, inclusiveEndMessageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAsyncRequestQueueMessages", reflect.TypeOf((*MockAsyncRequestCRUD)(nil).DeleteAsyncRequestQueueMessages), ctx, queueName, inclusiveEndMessageID)
+}
+
+// InsertAsyncRequest mocks base method.
+func (m *MockAsyncRequestCRUD) InsertAsyncRequest(ctx context.Context, row *persistence.AsyncRequestInfo, ttlSeconds int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAsyncRequest", ctx, row, ttlSeconds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAsyncRequest indicates an expected call of InsertAsyncRequest.
+func (mr *MockAsyncRequestCRUDMockRecorder) InsertAsyncRequest(ctx, row, ttlSeconds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAsyncRequest", reflect.TypeOf((*MockAsyncRequestCRUD)(nil).InsertAsyncRequest), ctx, row, ttlSeconds)
+}
+
+// InsertAsyncRequestDLQEntry mocks base method.
+func (m *MockAsyncRequestCRUD) InsertAsyncRequestDLQEntry(ctx context.Context, row *persistence.AsyncRequestDLQEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAsyncRequestDLQEntry", ctx, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAsyncRequestDLQEntry indicates an expected call of InsertAsyncRequestDLQEntry.
+func (mr *MockAsyncRequestCRUDMockRecorder) InsertAsyncRequestDLQEntry(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAsyncRequestDLQEntry", reflect.TypeOf((*MockAsyncRequestCRUD)(nil).InsertAsyncRequestDLQEntry), ctx, row)
+}
+
+// InsertAsyncRequestQueueMessage mocks base method.
+func (m *MockAsyncRequestCRUD) InsertAsyncRequestQueueMessage(ctx context.Context, queueName string, row *persistence.AsyncRequestQueueMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAsyncRequestQueueMessage", ctx, queueName, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAsyncRequestQueueMessage indicates an expected call of InsertAsyncRequestQueueMessage.
+func (mr *MockAsyncRequestCRUDMockRecorder) InsertAsyncRequestQueueMessage(ctx, queueName, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAsyncRequestQueueMessage", reflect.TypeOf((*MockAsyncRequestCRUD)(nil).InsertAsyncRequestQueueMessage), ctx, queueName, row)
+}
+
+// InsertAsyncRequestQueueMetadata mocks base method.
+func (m *MockAsyncRequestCRUD) InsertAsyncRequestQueueMetadata(ctx context.Context, row *persistence.AsyncRequestQueueMetadata) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAsyncRequestQueueMetadata", ctx, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAsyncRequestQueueMetadata indicates an expected call of InsertAsyncRequestQueueMetadata.
+func (mr *MockAsyncRequestCRUDMockRecorder) InsertAsyncRequestQueueMetadata(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAsyncRequestQueueMetadata", reflect.TypeOf((*MockAsyncRequestCRUD)(nil).InsertAsyncRequestQueueMetadata), ctx, row)
+}
+
+// SelectAsyncRequest mocks base method.
+func (m *MockAsyncRequestCRUD) SelectAsyncRequest(ctx context.Context, domainID string, requestID string) (*persistence.AsyncRequestInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAsyncRequest", ctx, domainID, requestID)
+	ret0, _ := ret[0].(*persistence.AsyncRequestInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAsyncRequest indicates an expected call of SelectAsyncRequest.
+func (mr *MockAsyncRequestCRUDMockRecorder) SelectAsyncRequest(ctx, domainID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAsyncRequest", reflect.TypeOf((*MockAsyncRequestCRUD)(nil).SelectAsyncRequest), ctx, domainID, requestID)
+}
+
+// SelectAsyncRequestDLQEntries mocks base method.
+func (m *MockAsyncRequestCRUD) SelectAsyncRequestDLQEntries(ctx context.Context, domainID string, pageSize int, pageToken []byte) ([]*persistence.AsyncRequestDLQEntry, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAsyncRequestDLQEntries", ctx, domainID, pageSize, pageToken)
+	ret0, _ := ret[0].([]*persistence.AsyncRequestDLQEntry)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SelectAsyncRequestDLQEntries indicates an expected call of SelectAsyncRequestDLQEntries.
+func (mr *MockAsyncRequestCRUDMockRecorder) SelectAsyncRequestDLQEntries(ctx, domainID, pageSize, pageToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAsyncRequestDLQEntries", reflect.TypeOf((*MockAsyncRequestCRUD)(nil).SelectAsyncRequestDLQEntries), ctx, domainID, pageSize, pageToken)
+}
+
+// SelectAsyncRequestDLQEntry mocks base method.
+func (m *MockAsyncRequestCRUD) SelectAsyncRequestDLQEntry(ctx context.Context, domainID string, requestID string) (*persistence.AsyncRequestDLQEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAsyncRequestDLQEntry", ctx, domainID, requestID)
+	ret0, _ := ret[0].(*persistence.AsyncRequestDLQEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAsyncRequestDLQEntry indicates an expected call of SelectAsyncRequestDLQEntry.
+func (mr *MockAsyncRequestCRUDMockRecorder) SelectAsyncRequestDLQEntry(ctx, domainID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAsyncRequestDLQEntry", reflect.TypeOf((*MockAsyncRequestCRUD)(nil).SelectAsyncRequestDLQEntry), ctx, domainID, requestID)
+}
+
+// SelectAsyncRequestQueueMessages mocks base method.
+func (m *MockAsyncRequestCRUD) SelectAsyncRequestQueueMessages(ctx context.Context, queueName string, exclusiveBeginMessageID int64, maxRows int) ([]*persistence.AsyncRequestQueueMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAsyncRequestQueueMessages", ctx, queueName, exclusiveBeginMessageID, maxRows)
+	ret0, _ := ret[0].([]*persistence.AsyncRequestQueueMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAsyncRequestQueueMessages indicates an expected call of SelectAsyncRequestQueueMessages.
+func (mr *MockAsyncRequestCRUDMockRecorder) SelectAsyncRequestQueueMessages(ctx, queueName, exclusiveBeginMessageID, maxRows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAsyncRequestQueueMessages", reflect.TypeOf((*MockAsyncRequestCRUD)(nil).SelectAsyncRequestQueueMessages), ctx, queueName, exclusiveBeginMessageID, maxRows)
+}
+
+// SelectAsyncRequestQueueMetadata mocks base method.
+func (m *MockAsyncRequestCRUD) SelectAsyncRequestQueueMetadata(ctx context.Context, queueName string) (*persistence.AsyncRequestQueueMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAsyncRequestQueueMetadata", ctx, queueName)
+	ret0, _ := ret[0].(*persistence.AsyncRequestQueueMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAsyncRequestQueueMetadata indicates an expected call of SelectAsyncRequestQueueMetadata.
+func (mr *MockAsyncRequestCRUDMockRecorder) SelectAsyncRequestQueueMetadata(ctx, queueName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAsyncRequestQueueMetadata", reflect.TypeOf((*MockAsyncRequestCRUD)(nil).SelectAsyncRequestQueueMetadata), ctx, queueName)
+}
+
+// SelectLastAsyncRequestQueueMessageID mocks base method.
+func (m *MockAsyncRequestCRUD) SelectLastAsyncRequestQueueMessageID(ctx context.Context, queueName string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectLastAsyncRequestQueueMessageID", ctx, queueName)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectLastAsyncRequestQueueMessageID indicates an expected call of SelectLastAsyncRequestQueueMessageID.
+func (mr *MockAsyncRequestCRUDMockRecorder) SelectLastAsyncRequestQueueMessageID(ctx, queueName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectLastAsyncRequestQueueMessageID", reflect.TypeOf((*MockAsyncRequestCRUD)(nil).SelectLastAsyncRequestQueueMessageID), ctx, queueName)
+}
+
+// UpdateAsyncRequestQueueMetadataCas mocks base method.
+func (m *MockAsyncRequestCRUD) UpdateAsyncRequestQueueMetadataCas(ctx context.Context, row *persistence.AsyncRequestQueueMetadata, previousVersion int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAsyncRequestQueueMetadataCas", ctx, row, previousVersion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAsyncRequestQueueMetadataCas indicates an expected call of UpdateAsyncRequestQueueMetadataCas.
+func (mr *MockAsyncRequestCRUDMockRecorder) UpdateAsyncRequestQueueMetadataCas(ctx, row, previousVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAsyncRequestQueueMetadataCas", reflect.TypeOf((*MockAsyncRequestCRUD)(nil).UpdateAsyncRequestQueueMetadataCas), ctx, row, previousVersion)
+}
+
+// MockMapQCRUD is a mock of MapQCRUD interface.
+type MockMapQCRUD struct {
+	ctrl     *gomock.Controller
+	recorder *MockMapQCRUDMockRecorder
+	isgomock struct{}
+}
+
+// MockMapQCRUDMockRecorder is the mock recorder for MockMapQCRUD.
+type MockMapQCRUDMockRecorder struct {
+	mock *MockMapQCRUD
+}
+
+// NewMockMapQCRUD creates a new mock instance.
+func NewMockMapQCRUD(ctrl *gomock.Controller) *MockMapQCRUD {
+	mock := &MockMapQCRUD{ctrl: ctrl}
+	mock.recorder = &MockMapQCRUDMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMapQCRUD) EXPECT() *MockMapQCRUDMockRecorder {
+	return m.recorder
+}
+
+// DeleteMapQItems mocks base method.
+func (m *MockMapQCRUD) DeleteMapQItems(ctx context.Context, queueID string, partition string, inclusiveMaxOffset int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMapQItems", ctx, queueID, partition, inclusiveMaxOffset)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMapQItems indicates an expected call of DeleteMapQItems.
+func (mr *MockMapQCRUDMockRecorder) DeleteMapQItems(ctx, queueID, partition, inclusiveMaxOffset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMapQItems", reflect.TypeOf((*MockMapQCRUD)(nil).DeleteMapQItems), ctx, queueID, partition, inclusiveMaxOffset)
+}
+
+// InsertMapQItems mocks base method.
+func (m *MockMapQCRUD) InsertMapQItems(ctx context.Context, queueID string, rows []*persistence.MapQItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertMapQItems", ctx, queueID, rows)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertMapQItems indicates an expected call of InsertMapQItems.
+func (mr *MockMapQCRUDMockRecorder) InsertMapQItems(ctx, queueID, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertMapQItems", reflect.TypeOf((*MockMapQCRUD)(nil).InsertMapQItems), ctx, queueID, rows)
+}
+
+// InsertMapQState mocks base method.
+func (m *MockMapQCRUD) InsertMapQState(ctx context.Context, row *persistence.MapQState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertMapQState", ctx, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertMapQState indicates an expected call of InsertMapQState.
+func (mr *MockMapQCRUDMockRecorder) InsertMapQState(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertMapQState", reflect.TypeOf((*MockMapQCRUD)(nil).InsertMapQState), ctx, row)
+}
+
+// SelectMapQItems mocks base method.
+func (m *MockMapQCRUD) SelectMapQItems(ctx context.Context, queueID string, partition string, exclusiveMinOffset int64, inclusiveMaxOffset int64, maxRows int) ([]*persistence.MapQItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectMapQItems", ctx, queueID, partition, exclusiveMinOffset, inclusiveMaxOffset, maxRows)
+	ret0, _ := ret[0].([]*persistence.MapQItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectMapQItems indicates an expected call of SelectMapQItems.
+func (mr *MockMapQCRUDMockRecorder) SelectMapQItems(ctx, queueID, partition, exclusiveMinOffset, inclusiveMaxOffset, maxRows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectMapQItems", reflect.TypeOf((*MockMapQCRUD)(nil).SelectMapQItems), ctx, queueID, partition, exclusiveMinOffset, inclusiveMaxOffset, maxRows)
+}
+
+// SelectMapQState mocks base method.
+func (m *MockMapQCRUD) SelectMapQState(ctx context.Context, queueID string) (*persistence.MapQState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectMapQState", ctx, queueID)
+	ret0, _ := ret[0].(*persistence.MapQState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectMapQState indicates an expected call of SelectMapQState.
+func (mr *MockMapQCRUDMockRecorder) SelectMapQState(ctx, queueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectMapQState", reflect.TypeOf((*MockMapQCRUD)(nil).SelectMapQState), ctx, queueID)
+}
+
+// UpdateMapQStateCas mocks base method.
+func (m *MockMapQCRUD) UpdateMapQStateCas(ctx context.Context, row *persistence.MapQState, previousVersion int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMapQStateCas", ctx, row, previousVersion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMapQStateCas indicates an expected call of UpdateMapQStateCas.
+func (mr *MockMapQCRUDMockRecorder) UpdateMapQStateCas(ctx, row, previousVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMapQStateCas", reflect.TypeOf((*MockMapQCRUD)(nil).UpdateMapQStateCas), ctx, row, previousVersion)
+}
+
+// MockShardDistributorCRUD is a mock of ShardDistributorCRUD interface.
+type MockShardDistributorCRUD struct {
+	ctrl     *gomock.Controller
+	recorder *MockShardDistributorCRUDMockRecorder
+	isgomock struct{}
+}
+
+// MockShardDistributorCRUDMockRecorder is the mock recorder for MockShardDistributorCRUD.
+type MockShardDistributorCRUDMockRecorder struct {
+	mock *MockShardDistributorCRUD
+}
+
+// NewMockShardDistributorCRUD creates a new mock instance.
+func NewMockShardDistributorCRUD(ctrl *gomock.Controller) *MockShardDistributorCRUD {
+	mock := &MockShardDistributorCRUD{ctrl: ctrl}
+	mock.recorder = &MockShardDistributorCRUDMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShardDistributorCRUD) EXPECT() *MockShardDistributorCRUDMockRecorder {
+	return m.recorder
+}
+
+// InsertShardDistributorNamespace mocks base method.
+func (m *MockShardDistributorCRUD) InsertShardDistributorNamespace(ctx context.Context, namespace string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertShardDistributorNamespace", ctx, namespace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertShardDistributorNamespace indicates an expected call of InsertShardDistributorNamespace.
+func (mr *MockShardDistributorCRUDMockRecorder) InsertShardDistributorNamespace(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertShardDistributorNamespace", reflect.TypeOf((*MockShardDistributorCRUD)(nil).InsertShardDistributorNamespace), ctx, namespace)
+}
+
+// SelectShardDistributorNamespace mocks base method.
+func (m *MockShardDistributorCRUD) SelectShardDistributorNamespace(ctx context.Context, namespace string) (*ShardDistributorNamespaceRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectShardDistributorNamespace", ctx, namespace)
+	ret0, _ := ret[0].(*ShardDistributorNamespaceRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectShardDistributorNamespace indicates an expected call of SelectShardDistributorNamespace.
+func (mr *MockShardDistributorCRUDMockRecorder) SelectShardDistributorNamespace(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectShardDistributorNamespace", reflect.TypeOf((*MockShardDistributorCRUD)(nil).SelectShardDistributorNamespace), ctx, namespace)
+}
+
+// UpdateShardDistributorExecutorsCas mocks base method.
+func (m *MockShardDistributorCRUD) UpdateShardDistributorExecutorsCas(ctx context.Context, request *ShardDistributorExecutorsWriteRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorExecutorsCas", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateShardDistributorExecutorsCas indicates an expected call of UpdateShardDistributorExecutorsCas.
+func (mr *MockShardDistributorCRUDMockRecorder) UpdateShardDistributorExecutorsCas(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorExecutorsCas", reflect.TypeOf((*MockShardDistributorCRUD)(nil).UpdateShardDistributorExecutorsCas), ctx, request)
+}
+
+// UpdateShardDistributorLeaderCas mocks base method.
+func (m *MockShardDistributorCRUD) UpdateShardDistributorLeaderCas(ctx context.Context, namespace string, leader *persistence.ShardDistributorLeader, previousVersion int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorLeaderCas", ctx, namespace, leader, previousVersion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateShardDistributorLeaderCas indicates an expected call of UpdateShardDistributorLeaderCas.
+func (mr *MockShardDistributorCRUDMockRecorder) UpdateShardDistributorLeaderCas(ctx, namespace, leader, previousVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorLeaderCas", reflect.TypeOf((*MockShardDistributorCRUD)(nil).UpdateShardDistributorLeaderCas), ctx, namespace, leader, previousVersion)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+func (db *mdb) InsertAsyncRequest(ctx context.Context, row *persistence.AsyncRequestInfo, ttlSeconds int64) error {
+	return errNotImplemented
+}
+
+func (db *mdb) SelectAsyncRequest(ctx context.Context, domainID, requestID string) (*persistence.AsyncRequestInfo, error) {
+	return nil, errNotImplemented
+}
+
+func (db *mdb) InsertAsyncRequestDLQEntry(ctx context.Context, row *persistence.AsyncRequestDLQEntry) error {
+	return errNotImplemented
+}
+
+func (db *mdb) SelectAsyncRequestDLQEntries(ctx context.Context, domainID string, pageSize int, pageToken []byte) ([]*persistence.AsyncRequestDLQEntry, []byte, error) {
+	return nil, nil, errNotImplemented
+}
+
+func (db *mdb) SelectAsyncRequestDLQEntry(ctx context.Context, domainID, requestID string) (*persistence.AsyncRequestDLQEntry, error) {
+	return nil, errNotImplemented
+}
+
+func (db *mdb) DeleteAsyncRequestDLQEntry(ctx context.Context, domainID, requestID string) error {
+	return errNotImplemented
+}
+
+func (db *mdb) InsertAsyncRequestQueueMessage(ctx context.Context, queueName string, row *persistence.AsyncRequestQueueMessage) error {
+	return errNotImplemented
+}
+
+func (db *mdb) SelectLastAsyncRequestQueueMessageID(ctx context.Context, queueName string) (int64, error) {
+	return 0, errNotImplemented
+}
+
+func (db *mdb) SelectAsyncRequestQueueMessages(ctx context.Context, queueName string, exclusiveBeginMessageID int64, maxRows int) ([]*persistence.AsyncRequestQueueMessage, error) {
+	return nil, errNotImplemented
+}
+
+func (db *mdb) DeleteAsyncRequestQueueMessages(ctx context.Context, queueName string, inclusiveEndMessageID int64) error {
+	return errNotImplemented
+}
+
+func (db *mdb) InsertAsyncRequestQueueMetadata(ctx context.Context, row *persistence.AsyncRequestQueueMetadata) error {
+	return errNotImplemented
+}
+
+func (db *mdb) UpdateAsyncRequestQueueMetadataCas(ctx context.Context, row *persistence.AsyncRequestQueueMetadata, previousVersion int64) error {
+	return errNotImplemented
+}
+
+func (db *mdb) SelectAsyncRequestQueueMetadata(ctx context.Context, queueName string) (*persistence.AsyncRequestQueueMetadata, error) {
+	return nil, errNotImplemented
+}
@@ -20,7 +20,14 @@
 
 package mongodb
 
-import "go.mongodb.org/mongo-driver/mongo"
+import (
+	"errors"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// errNotImplemented is returned by operations the mongodb plugin does not support yet
+var errNotImplemented = errors.New("operation is not implemented by the mongodb plugin")
 
 func (db *mdb) IsNotFoundError(err error) bool {
 	return err == mongo.ErrNoDocuments
@@ -0,0 +1,51 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+func (db *mdb) InsertMapQItems(ctx context.Context, queueID string, rows []*persistence.MapQItem) error {
+	panic("TODO")
+}
+
+func (db *mdb) SelectMapQItems(ctx context.Context, queueID, partition string, exclusiveMinOffset, inclusiveMaxOffset int64, maxRows int) ([]*persistence.MapQItem, error) {
+	panic("TODO")
+}
+
+func (db *mdb) DeleteMapQItems(ctx context.Context, queueID, partition string, inclusiveMaxOffset int64) error {
+	panic("TODO")
+}
+
+func (db *mdb) InsertMapQState(ctx context.Context, row *persistence.MapQState) error {
+	panic("TODO")
+}
+
+func (db *mdb) UpdateMapQStateCas(ctx context.Context, row *persistence.MapQState, previousVersion int64) error {
+	panic("TODO")
+}
+
+func (db *mdb) SelectMapQState(ctx context.Context, queueID string) (*persistence.MapQState, error) {
+	panic("TODO")
+}
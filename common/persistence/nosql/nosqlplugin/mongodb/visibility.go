@@ -42,6 +42,14 @@ func (db *mdb) UpdateVisibility(
 	panic("TODO")
 }
 
+func (db *mdb) UpdateVisibilityMemo(
+	ctx context.Context,
+	ttlSeconds int64,
+	row *nosqlplugin.VisibilityRowForUpdate,
+) error {
+	panic("TODO")
+}
+
 func (db *mdb) SelectVisibility(
 	ctx context.Context,
 	filter *nosqlplugin.VisibilityFilter,
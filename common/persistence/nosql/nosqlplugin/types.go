@@ -210,6 +210,8 @@ type (
 		CurrentTimeStamp        time.Time
 		LastUpdatedTime         time.Time
 		AdaptivePartitionConfig *persistence.TaskListPartitionConfig
+		VersioningConfig        *persistence.TaskListVersioningConfig
+		MigrationConfig         *persistence.TaskListMigrationConfig
 	}
 
 	// ListTaskListResult is the result of list tasklists
@@ -326,6 +328,28 @@ type (
 		TreeID   string
 		BranchID *string
 	}
+
+	// ShardDistributorNamespaceRow is the leader, executors and revision of a shard distributor namespace
+	ShardDistributorNamespaceRow struct {
+		Namespace string
+		// Leader is nil if the namespace never had a leader
+		Leader    *persistence.ShardDistributorLeader
+		Executors map[string]*persistence.ShardDistributorExecutor
+		Revision  int64
+	}
+
+	// ShardDistributorExecutorsWriteRequest is the changes to the executors of a shard distributor namespace
+	ShardDistributorExecutorsWriteRequest struct {
+		Namespace        string
+		PreviousRevision int64
+		// LeaderVersion fences the changes to a leader if it is not 0
+		LeaderVersion int64
+		// UpsertedExecutor writes the heartbeat, state and reported shards of an executor
+		UpsertedExecutor *persistence.ShardDistributorExecutor
+		// AssignedShards writes the assigned shards of executors, keyed by executor ID
+		AssignedShards     map[string]*persistence.DataBlob
+		DeletedExecutorIDs []string
+	}
 )
 
 const (
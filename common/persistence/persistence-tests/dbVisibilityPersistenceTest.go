@@ -896,6 +896,76 @@ func (s *DBVisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
 	}
 }
 
+// TestUpsertWorkflowExecutionMemo test
+func (s *DBVisibilityPersistenceSuite) TestUpsertWorkflowExecutionMemo() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	testDomainUUID := uuid.New()
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "visibility-upsert-workflow-test",
+		RunID:      "0b2e4c4a-9c0e-4b0e-8a0d-0c2a8b4d3b7e",
+	}
+
+	startTime := time.Now().Add(time.Second * -5).UnixNano()
+	startReq := &p.RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		WorkflowTimeout:  3600,
+		Memo:             &types.Memo{Fields: map[string][]byte{"key": []byte("value")}},
+		ShardID:          1234,
+	}
+	s.Nil(s.VisibilityMgr.RecordWorkflowExecutionStarted(ctx, startReq))
+
+	upsertReq := &p.UpsertWorkflowExecutionRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		WorkflowTimeout:  3600,
+		Memo:             &types.Memo{Fields: map[string][]byte{"key": []byte("updated")}},
+		UpdateTimestamp:  time.Now().UnixNano(),
+		ShardID:          1234,
+	}
+	s.Nil(s.VisibilityMgr.UpsertWorkflowExecution(ctx, upsertReq))
+
+	resp, err := s.VisibilityMgr.ListOpenWorkflowExecutions(ctx, &p.ListWorkflowExecutionsRequest{
+		DomainUUID:   testDomainUUID,
+		PageSize:     1,
+		EarliestTime: startTime,
+		LatestTime:   startTime,
+	})
+	s.Nil(err)
+	s.Equal(1, len(resp.Executions))
+	s.Equal(upsertReq.Memo.Fields, resp.Executions[0].Memo.Fields)
+
+	closeReq := &p.RecordWorkflowExecutionClosedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		CloseTimestamp:   time.Now().UnixNano(),
+		UpdateTimestamp:  time.Now().UnixNano(),
+		Memo:             upsertReq.Memo,
+		HistoryLength:    5,
+		ShardID:          1234,
+	}
+	s.Nil(s.VisibilityMgr.RecordWorkflowExecutionClosed(ctx, closeReq))
+
+	// a late upsert must not reopen the workflow
+	s.Nil(s.VisibilityMgr.UpsertWorkflowExecution(ctx, upsertReq))
+	resp, err = s.VisibilityMgr.ListOpenWorkflowExecutions(ctx, &p.ListWorkflowExecutionsRequest{
+		DomainUUID:   testDomainUUID,
+		PageSize:     1,
+		EarliestTime: startTime,
+		LatestTime:   startTime,
+	})
+	s.Nil(err)
+	s.Equal(0, len(resp.Executions))
+}
+
 func (s *DBVisibilityPersistenceSuite) assertClosedExecutionEquals(
 	req *p.RecordWorkflowExecutionClosedRequest, resp *types.WorkflowExecutionInfo) {
 	s.Equal(req.Execution.RunID, resp.Execution.RunID)
@@ -1645,6 +1645,15 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflow() {
 	updatedInfo.DecisionOriginalScheduledTimestamp = int64(655)
 	updatedInfo.StickyTaskList = "random sticky tasklist"
 	updatedInfo.StickyScheduleToStartTimeout = 876
+	updatedInfo.StickyWorkerIdentity = "random sticky worker identity"
+	updatedInfo.StickyHitCount = 11
+	updatedInfo.StickyFallbackCount = 2
+	updatedInfo.CompletionCallbacks = []*types.CompletionCallback{
+		{URL: "https://callback.example.com/notify", Headers: map[string]string{"Authorization": "Bearer token"}},
+	}
+	updatedInfo.CompletionCallbackStates = []*types.CompletionCallbackInfo{
+		{URL: "https://callback.example.com/notify", State: types.CompletionCallbackStateSucceeded.Ptr(), Attempt: 1},
+	}
 	updatedInfo.ClientLibraryVersion = "random client library version"
 	updatedInfo.ClientFeatureVersion = "random client feature version"
 	updatedInfo.ClientImpl = "random client impl"
@@ -1706,6 +1715,11 @@ func (s *ExecutionManagerSuite) TestUpdateWorkflow() {
 	s.Equal(int64(655), info1.DecisionOriginalScheduledTimestamp)
 	s.Equal(updatedInfo.StickyTaskList, info1.StickyTaskList)
 	s.Equal(updatedInfo.StickyScheduleToStartTimeout, info1.StickyScheduleToStartTimeout)
+	s.Equal(updatedInfo.StickyWorkerIdentity, info1.StickyWorkerIdentity)
+	s.Equal(updatedInfo.StickyHitCount, info1.StickyHitCount)
+	s.Equal(updatedInfo.StickyFallbackCount, info1.StickyFallbackCount)
+	s.Equal(updatedInfo.CompletionCallbacks, info1.CompletionCallbacks)
+	s.Equal(updatedInfo.CompletionCallbackStates, info1.CompletionCallbackStates)
 	s.Equal(updatedInfo.ClientLibraryVersion, info1.ClientLibraryVersion)
 	s.Equal(updatedInfo.ClientFeatureVersion, info1.ClientFeatureVersion)
 	s.Equal(updatedInfo.ClientImpl, info1.ClientImpl)
@@ -397,6 +397,14 @@ func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskList() {
 			ReadPartitions:  readPartitions,
 			WritePartitions: writePartitions,
 		},
+		VersioningConfig: &p.TaskListVersioningConfig{
+			Version:        1,
+			CompatibleSets: [][]string{{"1.0", "1.1"}, {"2.0"}},
+		},
+		MigrationConfig: &p.TaskListMigrationConfig{
+			TargetTaskList: "target",
+			Alias:          true,
+		},
 	}
 	_, err = s.TaskMgr.UpdateTaskList(ctx, &p.UpdateTaskListRequest{
 		TaskListInfo: taskListInfo,
@@ -419,6 +427,8 @@ func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskList() {
 	s.EqualValues(1, tli.AdaptivePartitionConfig.Version)
 	s.Equal(readPartitions, tli.AdaptivePartitionConfig.ReadPartitions)
 	s.EqualValues(writePartitions, tli.AdaptivePartitionConfig.WritePartitions)
+	s.Equal(taskListInfo.VersioningConfig, tli.VersioningConfig)
+	s.Equal(taskListInfo.MigrationConfig, tli.MigrationConfig)
 
 	taskListInfo.RangeID = 3
 	_, err = s.TaskMgr.UpdateTaskList(ctx, &p.UpdateTaskListRequest{
@@ -491,6 +491,30 @@ func (w *WorkflowExecutionInfo) GetStickyTaskList() (o string) {
 	return
 }
 
+// GetStickyWorkerIdentity internal sql blob getter
+func (w *WorkflowExecutionInfo) GetStickyWorkerIdentity() (o string) {
+	if w != nil {
+		return w.StickyWorkerIdentity
+	}
+	return
+}
+
+// GetStickyHitCount internal sql blob getter
+func (w *WorkflowExecutionInfo) GetStickyHitCount() (o int64) {
+	if w != nil {
+		return w.StickyHitCount
+	}
+	return
+}
+
+// GetStickyFallbackCount internal sql blob getter
+func (w *WorkflowExecutionInfo) GetStickyFallbackCount() (o int64) {
+	if w != nil {
+		return w.StickyFallbackCount
+	}
+	return
+}
+
 // GetCronSchedule internal sql blob getter
 func (w *WorkflowExecutionInfo) GetCronSchedule() (o string) {
 	if w != nil {
@@ -555,6 +579,14 @@ func (w *WorkflowExecutionInfo) GetActiveClusterSelectionPolicyEncoding() (o str
 	return
 }
 
+// GetCompletionCallbacksEncoding internal sql blob getter
+func (w *WorkflowExecutionInfo) GetCompletionCallbacksEncoding() (o string) {
+	if w != nil {
+		return w.CompletionCallbacksEncoding
+	}
+	return
+}
+
 // GetInitiatedID internal sql blob getter
 func (w *WorkflowExecutionInfo) GetInitiatedID() (o int64) {
 	if w != nil {
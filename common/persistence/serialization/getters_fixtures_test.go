@@ -35,6 +35,7 @@ var (
 var expectedNil = map[string]map[string]any{
 	"*serialization.WorkflowExecutionInfo": {
 		"GetActiveClusterSelectionPolicyEncoding": string(""),
+		"GetCompletionCallbacksEncoding":          string(""),
 		"GetAutoResetPoints":                      []uint8(nil),
 		"GetAutoResetPointsEncoding":              "",
 		"GetCancelRequestID":                      "",
@@ -94,6 +95,9 @@ var expectedNil = map[string]map[string]any{
 		"GetState":                                int32(0),
 		"GetStickyScheduleToStartTimeout":         time.Duration(0),
 		"GetStickyTaskList":                       "",
+		"GetStickyWorkerIdentity":                 "",
+		"GetStickyHitCount":                       int64(0),
+		"GetStickyFallbackCount":                  int64(0),
 		"GetVersionHistories":                     []uint8(nil),
 		"GetVersionHistoriesEncoding":             "",
 		"GetWorkflowTimeout":                      time.Duration(0),
@@ -279,6 +283,7 @@ var expectedNil = map[string]map[string]any{
 var expectedEmpty = map[string]map[string]any{
 	"*serialization.WorkflowExecutionInfo": {
 		"GetActiveClusterSelectionPolicyEncoding": string(""),
+		"GetCompletionCallbacksEncoding":          string(""),
 		"GetAutoResetPoints":                      []uint8(nil),
 		"GetAutoResetPointsEncoding":              "",
 		"GetCancelRequestID":                      "",
@@ -338,6 +343,9 @@ var expectedEmpty = map[string]map[string]any{
 		"GetState":                                int32(0),
 		"GetStickyScheduleToStartTimeout":         time.Duration(0),
 		"GetStickyTaskList":                       "",
+		"GetStickyWorkerIdentity":                 "",
+		"GetStickyHitCount":                       int64(0),
+		"GetStickyFallbackCount":                  int64(0),
 		"GetVersionHistories":                     []uint8(nil),
 		"GetVersionHistoriesEncoding":             "",
 		"GetWorkflowTimeout":                      time.Duration(0),
@@ -583,6 +591,9 @@ var expectedNonEmpty = map[string]map[string]any{
 		"GetState":                                int32(5),
 		"GetStickyScheduleToStartTimeout":         time.Duration(0),
 		"GetStickyTaskList":                       "",
+		"GetStickyWorkerIdentity":                 "",
+		"GetStickyHitCount":                       int64(0),
+		"GetStickyFallbackCount":                  int64(0),
 		"GetVersionHistories":                     []uint8(nil),
 		"GetVersionHistoriesEncoding":             "",
 		"GetWorkflowTimeout":                      time.Duration(3),
@@ -590,6 +601,7 @@ var expectedNonEmpty = map[string]map[string]any{
 		"GetChecksum":                             []uint8(nil),
 		"GetChecksumEncoding":                     "",
 		"GetActiveClusterSelectionPolicyEncoding": "",
+		"GetCompletionCallbacksEncoding":          "",
 	},
 	"*serialization.TransferTaskInfo": {
 		"GetDomainID":                []uint8(taskDomainID),
@@ -148,6 +148,9 @@ type (
 		CancelRequestID                      string
 		StickyTaskList                       string
 		StickyScheduleToStartTimeout         time.Duration
+		StickyWorkerIdentity                 string
+		StickyHitCount                       int64
+		StickyFallbackCount                  int64
 		RetryAttempt                         int64
 		RetryInitialInterval                 time.Duration
 		RetryMaximumInterval                 time.Duration
@@ -178,6 +181,8 @@ type (
 		ChecksumEncoding                     string
 		ActiveClusterSelectionPolicy         []byte
 		ActiveClusterSelectionPolicyEncoding string
+		CompletionCallbacks                  []byte
+		CompletionCallbacksEncoding          string
 	}
 
 	// ActivityInfo blob in a serialization agnostic format
@@ -279,6 +284,16 @@ type (
 		ReadPartitions     map[int32]*TaskListPartition
 		WritePartitions    map[int32]*TaskListPartition
 	}
+	// TaskListVersioningConfig blob in a serialization agnostic format
+	TaskListVersioningConfig struct {
+		Version        int64
+		CompatibleSets [][]string
+	}
+	// TaskListMigrationConfig blob in a serialization agnostic format
+	TaskListMigrationConfig struct {
+		TargetTaskList string
+		Alias          bool
+	}
 	// TaskListInfo blob in a serialization agnostic format
 	TaskListInfo struct {
 		Kind                    int16
@@ -286,6 +301,8 @@ type (
 		ExpiryTimestamp         time.Time
 		LastUpdated             time.Time
 		AdaptivePartitionConfig *TaskListPartitionConfig
+		VersioningConfig        *TaskListVersioningConfig
+		MigrationConfig         *TaskListMigrationConfig
 	}
 
 	// TransferTaskInfo blob in a serialization agnostic format
@@ -130,6 +130,9 @@ func TestParserRoundTrip(t *testing.T) {
 			CancelRequestID:                    "test_cancel_request_id",
 			StickyTaskList:                     "test_sticky_task_list",
 			StickyScheduleToStartTimeout:       48 * time.Hour,
+			StickyWorkerIdentity:               "test_sticky_worker_identity",
+			StickyHitCount:                     5,
+			StickyFallbackCount:                1,
 			ClientLibraryVersion:               "test_client_library_version",
 			ClientFeatureVersion:               "test_client_feature_version",
 			ClientImpl:                         "test_client_impl",
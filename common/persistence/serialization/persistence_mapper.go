@@ -57,6 +57,9 @@ func ToInternalWorkflowExecutionInfo(info *WorkflowExecutionInfo) *persistence.I
 		DecisionOriginalScheduledTimestamp: info.GetDecisionOriginalScheduledTimestamp(),
 		StickyTaskList:                     info.GetStickyTaskList(),
 		StickyScheduleToStartTimeout:       info.GetStickyScheduleToStartTimeout(),
+		StickyWorkerIdentity:               info.GetStickyWorkerIdentity(),
+		StickyHitCount:                     info.GetStickyHitCount(),
+		StickyFallbackCount:                info.GetStickyFallbackCount(),
 		ClientLibraryVersion:               info.GetClientLibraryVersion(),
 		ClientFeatureVersion:               info.GetClientFeatureVersion(),
 		ClientImpl:                         info.GetClientImpl(),
@@ -110,6 +113,11 @@ func ToInternalWorkflowExecutionInfo(info *WorkflowExecutionInfo) *persistence.I
 			constants.EncodingType(info.GetActiveClusterSelectionPolicyEncoding()))
 	}
 
+	if info.CompletionCallbacks != nil {
+		result.CompletionCallbacks = persistence.NewDataBlob(info.CompletionCallbacks,
+			constants.EncodingType(info.GetCompletionCallbacksEncoding()))
+	}
+
 	return result
 }
 
@@ -140,6 +148,9 @@ func FromInternalWorkflowExecutionInfo(executionInfo *persistence.InternalWorkfl
 		DecisionOriginalScheduledTimestamp:   executionInfo.DecisionOriginalScheduledTimestamp,
 		StickyTaskList:                       executionInfo.StickyTaskList,
 		StickyScheduleToStartTimeout:         executionInfo.StickyScheduleToStartTimeout,
+		StickyWorkerIdentity:                 executionInfo.StickyWorkerIdentity,
+		StickyHitCount:                       executionInfo.StickyHitCount,
+		StickyFallbackCount:                  executionInfo.StickyFallbackCount,
 		ClientLibraryVersion:                 executionInfo.ClientLibraryVersion,
 		ClientFeatureVersion:                 executionInfo.ClientFeatureVersion,
 		ClientImpl:                           executionInfo.ClientImpl,
@@ -195,5 +206,10 @@ func FromInternalWorkflowExecutionInfo(executionInfo *persistence.InternalWorkfl
 		info.ActiveClusterSelectionPolicyEncoding = string(executionInfo.ActiveClusterSelectionPolicy.Encoding)
 	}
 
+	if executionInfo.CompletionCallbacks != nil {
+		info.CompletionCallbacks = executionInfo.CompletionCallbacks.Data
+		info.CompletionCallbacksEncoding = string(executionInfo.CompletionCallbacks.Encoding)
+	}
+
 	return info
 }
@@ -72,6 +72,9 @@ func TestInternalWorkflowExecutionInfo(t *testing.T) {
 		CancelRequestID:                    "CancelRequestID",
 		StickyTaskList:                     "StickyTaskList",
 		StickyScheduleToStartTimeout:       time.Minute * time.Duration(rand.Intn(10)),
+		StickyWorkerIdentity:               "StickyWorkerIdentity",
+		StickyHitCount:                     int64(rand.Intn(1000)),
+		StickyFallbackCount:                int64(rand.Intn(1000)),
 		ClientLibraryVersion:               "ClientLibraryVersion",
 		ClientFeatureVersion:               "ClientFeatureVersion",
 		ClientImpl:                         "ClientImpl",
@@ -93,6 +96,7 @@ func TestInternalWorkflowExecutionInfo(t *testing.T) {
 		PartitionConfig:                    map[string]string{"zone": "dca1"},
 		IsCron:                             true,
 		ActiveClusterSelectionPolicy:       persistence.NewDataBlob([]byte("ActiveClusterSelectionPolicy"), constants.EncodingTypeJSON),
+		CompletionCallbacks:                persistence.NewDataBlob([]byte("CompletionCallbacks"), constants.EncodingTypeJSON),
 		CronOverlapPolicy:                  types.CronOverlapPolicySkipped,
 	}
 	actual := ToInternalWorkflowExecutionInfo(FromInternalWorkflowExecutionInfo(expected))
@@ -148,6 +148,10 @@ func (s *taskSerializerImpl) serializeTransferTask(task persistence.Task) (persi
 		info.DomainID = MustParseUUID(t.DomainID)
 		info.WorkflowID = t.WorkflowID
 		info.RunID = MustParseUUID(t.RunID)
+	case *persistence.CompletionCallbackTask:
+		info.DomainID = MustParseUUID(t.DomainID)
+		info.WorkflowID = t.WorkflowID
+		info.RunID = MustParseUUID(t.RunID)
 	case *persistence.ResetWorkflowTask:
 		info.DomainID = MustParseUUID(t.DomainID)
 		info.WorkflowID = t.WorkflowID
@@ -249,6 +253,11 @@ func (s *taskSerializerImpl) deserializeTransferTask(blob *persistence.DataBlob)
 			WorkflowIdentifier: workflowIdentifier,
 			TaskData:           taskData,
 		}
+	case persistence.TransferTaskTypeCompletionCallback:
+		task = &persistence.CompletionCallbackTask{
+			WorkflowIdentifier: workflowIdentifier,
+			TaskData:           taskData,
+		}
 	case persistence.TransferTaskTypeRecordChildExecutionCompleted:
 		task = &persistence.RecordChildExecutionCompletedTask{
 			WorkflowIdentifier: workflowIdentifier,
@@ -191,6 +191,17 @@ func TestTaskSerializerThriftRW(t *testing.T) {
 				TargetRunID:      "2be8a310-7d20-483e-a5d2-48659dc47606",
 			},
 		},
+		{
+			category: persistence.HistoryTaskCategoryTransfer,
+			task: &persistence.CompletionCallbackTask{
+				WorkflowIdentifier: workflowIdentifier,
+				TaskData: persistence.TaskData{
+					Version:             10,
+					TaskID:              10,
+					VisibilityTimestamp: time.Unix(10, 10),
+				},
+			},
+		},
 		{
 			category: persistence.HistoryTaskCategoryTimer,
 			task: &persistence.DecisionTimeoutTask{
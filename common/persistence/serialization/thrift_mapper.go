@@ -258,6 +258,9 @@ func workflowExecutionInfoToThrift(info *WorkflowExecutionInfo) *sqlblobs.Workfl
 		CancelRequestID:                         &info.CancelRequestID,
 		StickyTaskList:                          &info.StickyTaskList,
 		StickyScheduleToStartTimeout:            durationToSecondsInt64Ptr(info.StickyScheduleToStartTimeout),
+		StickyWorkerIdentity:                    &info.StickyWorkerIdentity,
+		StickyHitCount:                          &info.StickyHitCount,
+		StickyFallbackCount:                     &info.StickyFallbackCount,
 		RetryAttempt:                            &info.RetryAttempt,
 		RetryInitialIntervalSeconds:             durationToSecondsInt32Ptr(info.RetryInitialInterval),
 		RetryMaximumIntervalSeconds:             durationToSecondsInt32Ptr(info.RetryMaximumInterval),
@@ -288,6 +291,8 @@ func workflowExecutionInfoToThrift(info *WorkflowExecutionInfo) *sqlblobs.Workfl
 		ChecksumEncoding:                        &info.ChecksumEncoding,
 		ActiveClusterSelectionPolicy:            info.ActiveClusterSelectionPolicy,
 		ActiveClusterSelectionPolicyEncoding:    &info.ActiveClusterSelectionPolicyEncoding,
+		CompletionCallbacks:                     info.CompletionCallbacks,
+		CompletionCallbacksEncoding:             &info.CompletionCallbacksEncoding,
 	}
 }
 
@@ -332,6 +337,9 @@ func workflowExecutionInfoFromThrift(info *sqlblobs.WorkflowExecutionInfo) *Work
 		CancelRequestID:                      info.GetCancelRequestID(),
 		StickyTaskList:                       info.GetStickyTaskList(),
 		StickyScheduleToStartTimeout:         common.SecondsToDuration(info.GetStickyScheduleToStartTimeout()),
+		StickyWorkerIdentity:                 info.GetStickyWorkerIdentity(),
+		StickyHitCount:                       info.GetStickyHitCount(),
+		StickyFallbackCount:                  info.GetStickyFallbackCount(),
 		RetryAttempt:                         info.GetRetryAttempt(),
 		RetryInitialInterval:                 common.SecondsToDuration(int64(info.GetRetryInitialIntervalSeconds())),
 		RetryMaximumInterval:                 common.SecondsToDuration(int64(info.GetRetryMaximumIntervalSeconds())),
@@ -363,6 +371,8 @@ func workflowExecutionInfoFromThrift(info *sqlblobs.WorkflowExecutionInfo) *Work
 		ChecksumEncoding:                     info.GetChecksumEncoding(),
 		ActiveClusterSelectionPolicy:         info.ActiveClusterSelectionPolicy,
 		ActiveClusterSelectionPolicyEncoding: info.GetActiveClusterSelectionPolicyEncoding(),
+		CompletionCallbacks:                  info.CompletionCallbacks,
+		CompletionCallbacksEncoding:          info.GetCompletionCallbacksEncoding(),
 	}
 }
 
@@ -638,6 +648,46 @@ func taskListParititionConfigFromThrift(info *sqlblobs.TaskListPartitionConfig)
 	}
 }
 
+func taskListVersioningConfigToThrift(info *TaskListVersioningConfig) *sqlblobs.TaskListVersioningConfig {
+	if info == nil {
+		return nil
+	}
+	return &sqlblobs.TaskListVersioningConfig{
+		Version:        &info.Version,
+		CompatibleSets: info.CompatibleSets,
+	}
+}
+
+func taskListVersioningConfigFromThrift(info *sqlblobs.TaskListVersioningConfig) *TaskListVersioningConfig {
+	if info == nil {
+		return nil
+	}
+	return &TaskListVersioningConfig{
+		Version:        info.GetVersion(),
+		CompatibleSets: info.GetCompatibleSets(),
+	}
+}
+
+func taskListMigrationConfigToThrift(info *TaskListMigrationConfig) *sqlblobs.TaskListMigrationConfig {
+	if info == nil {
+		return nil
+	}
+	return &sqlblobs.TaskListMigrationConfig{
+		TargetTaskList: &info.TargetTaskList,
+		ActivityAlias:  &info.Alias,
+	}
+}
+
+func taskListMigrationConfigFromThrift(info *sqlblobs.TaskListMigrationConfig) *TaskListMigrationConfig {
+	if info == nil {
+		return nil
+	}
+	return &TaskListMigrationConfig{
+		TargetTaskList: info.GetTargetTaskList(),
+		Alias:          info.GetActivityAlias(),
+	}
+}
+
 func taskListInfoToThrift(info *TaskListInfo) *sqlblobs.TaskListInfo {
 	if info == nil {
 		return nil
@@ -648,6 +698,8 @@ func taskListInfoToThrift(info *TaskListInfo) *sqlblobs.TaskListInfo {
 		ExpiryTimeNanos:         timeToUnixNanoPtr(info.ExpiryTimestamp),
 		LastUpdatedNanos:        timeToUnixNanoPtr(info.LastUpdated),
 		AdaptivePartitionConfig: taskListPartitionConfigToThrift(info.AdaptivePartitionConfig),
+		VersioningConfig:        taskListVersioningConfigToThrift(info.VersioningConfig),
+		MigrationConfig:         taskListMigrationConfigToThrift(info.MigrationConfig),
 	}
 }
 
@@ -661,6 +713,8 @@ func taskListInfoFromThrift(info *sqlblobs.TaskListInfo) *TaskListInfo {
 		ExpiryTimestamp:         timeFromUnixNano(info.GetExpiryTimeNanos()),
 		LastUpdated:             timeFromUnixNano(info.GetLastUpdatedNanos()),
 		AdaptivePartitionConfig: taskListParititionConfigFromThrift(info.AdaptivePartitionConfig),
+		VersioningConfig:        taskListVersioningConfigFromThrift(info.VersioningConfig),
+		MigrationConfig:         taskListMigrationConfigFromThrift(info.MigrationConfig),
 	}
 }
 
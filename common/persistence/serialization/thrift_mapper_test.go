@@ -245,6 +245,9 @@ func TestWorkflowExecutionInfo(t *testing.T) {
 		CancelRequestID:                    "CancelRequestID",
 		StickyTaskList:                     "StickyTaskList",
 		StickyScheduleToStartTimeout:       time.Minute * time.Duration(rand.Intn(10)),
+		StickyWorkerIdentity:               "StickyWorkerIdentity",
+		StickyHitCount:                     int64(rand.Intn(1000)),
+		StickyFallbackCount:                int64(rand.Intn(1000)),
 		RetryAttempt:                       int64(rand.Intn(1000)),
 		RetryInitialInterval:               time.Minute * time.Duration(rand.Intn(10)),
 		RetryMaximumInterval:               time.Minute * time.Duration(rand.Intn(10)),
@@ -273,6 +276,8 @@ func TestWorkflowExecutionInfo(t *testing.T) {
 		PartitionConfig:                    map[string]string{"zone": "dca1"},
 		Checksum:                           []byte("Checksum"),
 		ChecksumEncoding:                   "ChecksumEncoding",
+		CompletionCallbacks:                []byte("CompletionCallbacks"),
+		CompletionCallbacksEncoding:        "CompletionCallbacksEncoding",
 		IsCron:                             true,
 	}
 	actual := workflowExecutionInfoFromThrift(workflowExecutionInfoToThrift(expected))
@@ -319,6 +324,9 @@ func TestWorkflowExecutionInfo_NoTaskListKind(t *testing.T) {
 		CancelRequestID:                    "CancelRequestID",
 		StickyTaskList:                     "StickyTaskList",
 		StickyScheduleToStartTimeout:       time.Minute * time.Duration(rand.Intn(10)),
+		StickyWorkerIdentity:               "StickyWorkerIdentity",
+		StickyHitCount:                     int64(rand.Intn(1000)),
+		StickyFallbackCount:                int64(rand.Intn(1000)),
 		RetryAttempt:                       int64(rand.Intn(1000)),
 		RetryInitialInterval:               time.Minute * time.Duration(rand.Intn(10)),
 		RetryMaximumInterval:               time.Minute * time.Duration(rand.Intn(10)),
@@ -347,6 +355,8 @@ func TestWorkflowExecutionInfo_NoTaskListKind(t *testing.T) {
 		PartitionConfig:                    map[string]string{"zone": "dca1"},
 		Checksum:                           []byte("Checksum"),
 		ChecksumEncoding:                   "ChecksumEncoding",
+		CompletionCallbacks:                []byte("CompletionCallbacks"),
+		CompletionCallbacksEncoding:        "CompletionCallbacksEncoding",
 		IsCron:                             true,
 	}
 	thriftVersion := workflowExecutionInfoToThrift(expected)
@@ -550,6 +560,26 @@ func TestTaskListInfo(t *testing.T) {
 				NumWritePartitions: 2,
 			},
 		},
+		{
+			Kind:            0,
+			AckLevel:        1,
+			ExpiryTimestamp: time.UnixMicro(2),
+			LastUpdated:     time.UnixMicro(3),
+			VersioningConfig: &TaskListVersioningConfig{
+				Version:        5,
+				CompatibleSets: [][]string{{"1.0", "1.1"}, {"2.0"}},
+			},
+		},
+		{
+			Kind:            0,
+			AckLevel:        1,
+			ExpiryTimestamp: time.UnixMicro(2),
+			LastUpdated:     time.UnixMicro(3),
+			MigrationConfig: &TaskListMigrationConfig{
+				TargetTaskList: "target",
+				Alias:          true,
+			},
+		},
 	}
 	for i, info := range cases {
 		assert.Equal(t, info, taskListInfoFromThrift(taskListInfoToThrift(info)), "case %d", i)
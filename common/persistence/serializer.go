@@ -96,6 +96,10 @@ type (
 		// serialize/deserialize active cluster selection policy
 		SerializeActiveClusterSelectionPolicy(policy *types.ActiveClusterSelectionPolicy, encodingType constants.EncodingType) (*DataBlob, error)
 		DeserializeActiveClusterSelectionPolicy(data *DataBlob) (*types.ActiveClusterSelectionPolicy, error)
+
+		// serialize/deserialize workflow completion callbacks and their delivery states
+		SerializeCompletionCallbacks(callbacks []*types.CompletionCallback, states []*types.CompletionCallbackInfo, encodingType constants.EncodingType) (*DataBlob, error)
+		DeserializeCompletionCallbacks(data *DataBlob) ([]*types.CompletionCallback, []*types.CompletionCallbackInfo, error)
 	}
 
 	// CadenceSerializationError is an error type for cadence serialization
@@ -116,6 +120,12 @@ type (
 	serializerImpl struct {
 		thriftrwEncoder codec.BinaryEncoder
 	}
+
+	// completionCallbacks is the blob persisted for the completion callbacks of a workflow execution
+	completionCallbacks struct {
+		Callbacks []*types.CompletionCallback     `json:"callbacks,omitempty"`
+		States    []*types.CompletionCallbackInfo `json:"states,omitempty"`
+	}
 )
 
 // NewPayloadSerializer returns a PayloadSerializer
@@ -374,6 +384,27 @@ func (t *serializerImpl) DeserializeActiveClusterSelectionPolicy(data *DataBlob)
 	return &policy, err
 }
 
+func (t *serializerImpl) SerializeCompletionCallbacks(
+	callbacks []*types.CompletionCallback,
+	states []*types.CompletionCallbackInfo,
+	encodingType constants.EncodingType,
+) (*DataBlob, error) {
+	if len(callbacks) == 0 {
+		return nil, nil
+	}
+	return t.serialize(&completionCallbacks{Callbacks: callbacks, States: states}, encodingType)
+}
+
+func (t *serializerImpl) DeserializeCompletionCallbacks(data *DataBlob) ([]*types.CompletionCallback, []*types.CompletionCallbackInfo, error) {
+	if data == nil || len(data.Data) == 0 {
+		return nil, nil, nil
+	}
+
+	var callbacks completionCallbacks
+	err := t.deserialize(data, &callbacks)
+	return callbacks.Callbacks, callbacks.States, err
+}
+
 func (t *serializerImpl) serialize(input interface{}, encodingType constants.EncodingType) (*DataBlob, error) {
 	if input == nil {
 		return nil, nil
@@ -427,6 +458,11 @@ func (t *serializerImpl) thriftrwEncode(input interface{}) ([]byte, error) {
 		return t.thriftrwEncoder.Encode(thrift.FromActiveClusters(input))
 	case *types.ActiveClusterSelectionPolicy:
 		return t.thriftrwEncoder.Encode(thrift.FromActiveClusterSelectionPolicy(input))
+	case *completionCallbacks:
+		return t.thriftrwEncoder.Encode(&history.CompletionCallbacks{
+			Callbacks: thrift.FromCompletionCallbackArray(input.Callbacks),
+			States:    thrift.FromCompletionCallbackInfoArray(input.States),
+		})
 	default:
 		return nil, nil
 	}
@@ -549,6 +585,14 @@ func (t *serializerImpl) thriftrwDecode(data []byte, target interface{}) error {
 		}
 		*target = *thrift.ToActiveClusterSelectionPolicy(&thriftTarget)
 		return nil
+	case *completionCallbacks:
+		thriftTarget := history.CompletionCallbacks{}
+		if err := t.thriftrwEncoder.Decode(data, &thriftTarget); err != nil {
+			return err
+		}
+		target.Callbacks = thrift.ToCompletionCallbackArray(thriftTarget.GetCallbacks())
+		target.States = thrift.ToCompletionCallbackInfoArray(thriftTarget.GetStates())
+		return nil
 	default:
 		return nil
 	}
@@ -133,6 +133,22 @@ func (mr *MockPayloadSerializerMockRecorder) DeserializeChecksum(data any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeserializeChecksum", reflect.TypeOf((*MockPayloadSerializer)(nil).DeserializeChecksum), data)
 }
 
+// DeserializeCompletionCallbacks mocks base method.
+func (m *MockPayloadSerializer) DeserializeCompletionCallbacks(data *DataBlob) ([]*types.CompletionCallback, []*types.CompletionCallbackInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeserializeCompletionCallbacks", data)
+	ret0, _ := ret[0].([]*types.CompletionCallback)
+	ret1, _ := ret[1].([]*types.CompletionCallbackInfo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DeserializeCompletionCallbacks indicates an expected call of DeserializeCompletionCallbacks.
+func (mr *MockPayloadSerializerMockRecorder) DeserializeCompletionCallbacks(data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeserializeCompletionCallbacks", reflect.TypeOf((*MockPayloadSerializer)(nil).DeserializeCompletionCallbacks), data)
+}
+
 // DeserializeDynamicConfigBlob mocks base method.
 func (m *MockPayloadSerializer) DeserializeDynamicConfigBlob(data *DataBlob) (*types.DynamicConfigBlob, error) {
 	m.ctrl.T.Helper()
@@ -343,6 +359,21 @@ func (mr *MockPayloadSerializerMockRecorder) SerializeChecksum(sum, encodingType
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SerializeChecksum", reflect.TypeOf((*MockPayloadSerializer)(nil).SerializeChecksum), sum, encodingType)
 }
 
+// SerializeCompletionCallbacks mocks base method.
+func (m *MockPayloadSerializer) SerializeCompletionCallbacks(callbacks []*types.CompletionCallback, states []*types.CompletionCallbackInfo, encodingType constants.EncodingType) (*DataBlob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SerializeCompletionCallbacks", callbacks, states, encodingType)
+	ret0, _ := ret[0].(*DataBlob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SerializeCompletionCallbacks indicates an expected call of SerializeCompletionCallbacks.
+func (mr *MockPayloadSerializerMockRecorder) SerializeCompletionCallbacks(callbacks, states, encodingType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SerializeCompletionCallbacks", reflect.TypeOf((*MockPayloadSerializer)(nil).SerializeCompletionCallbacks), callbacks, states, encodingType)
+}
+
 // SerializeDynamicConfigBlob mocks base method.
 func (m *MockPayloadSerializer) SerializeDynamicConfigBlob(blob *types.DynamicConfigBlob, encodingType constants.EncodingType) (*DataBlob, error) {
 	m.ctrl.T.Helper()
@@ -251,6 +251,22 @@ func TestSerializers(t *testing.T) {
 				return serializer.DeserializeActiveClusterSelectionPolicy(data)
 			},
 		},
+		{
+			name: "completion callbacks",
+			payloads: map[string]any{
+				"nil":         &completionCallbacks{},
+				"normal":      &completionCallbacks{Callbacks: generateCompletionCallbacks()},
+				"with states": &completionCallbacks{Callbacks: generateCompletionCallbacks(), States: generateCompletionCallbackStates()},
+			},
+			serializeFn: func(payload any, encoding constants.EncodingType) (*DataBlob, error) {
+				callbacks := payload.(*completionCallbacks)
+				return serializer.SerializeCompletionCallbacks(callbacks.Callbacks, callbacks.States, encoding)
+			},
+			deserializeFn: func(data *DataBlob) (any, error) {
+				callbacks, states, err := serializer.DeserializeCompletionCallbacks(data)
+				return &completionCallbacks{Callbacks: callbacks, States: states}, err
+			},
+		},
 	}
 
 	// generate runnable test cases here so actual test body is not 3 level nested
@@ -546,3 +562,34 @@ func generateActiveClusterSelectionPolicy() *types.ActiveClusterSelectionPolicy
 		ExternalEntityKey:              "externalEntityKey1",
 	}
 }
+
+func generateCompletionCallbacks() []*types.CompletionCallback {
+	return []*types.CompletionCallback{
+		{
+			URL:     "https://callback.example.com/notify",
+			Headers: map[string]string{"Authorization": "Bearer token"},
+		},
+		{
+			URL: "https://other.example.com/notify",
+		},
+	}
+}
+
+func generateCompletionCallbackStates() []*types.CompletionCallbackInfo {
+	return []*types.CompletionCallbackInfo{
+		{
+			URL:                  "https://callback.example.com/notify",
+			State:                types.CompletionCallbackStateSucceeded.Ptr(),
+			Attempt:              1,
+			LastAttemptTimestamp: common.Int64Ptr(1000),
+		},
+		{
+			URL:                  "https://other.example.com/notify",
+			State:                types.CompletionCallbackStateBackingOff.Ptr(),
+			Attempt:              2,
+			LastAttemptTimestamp: common.Int64Ptr(2000),
+			NextAttemptTimestamp: common.Int64Ptr(3000),
+			LastFailureReason:    "503 Service Unavailable",
+		},
+	}
+}
@@ -0,0 +1,74 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+)
+
+type (
+	shardDistributorManager struct {
+		persistence ShardDistributorStore
+	}
+)
+
+var _ ShardDistributorManager = (*shardDistributorManager)(nil)
+
+// NewShardDistributorManager returns a new ShardDistributorManager
+func NewShardDistributorManager(
+	persistence ShardDistributorStore,
+) ShardDistributorManager {
+	return &shardDistributorManager{
+		persistence: persistence,
+	}
+}
+
+func (m *shardDistributorManager) Close() {
+	m.persistence.Close()
+}
+
+func (m *shardDistributorManager) GetShardDistributorState(ctx context.Context, request *GetShardDistributorStateRequest) (*GetShardDistributorStateResponse, error) {
+	return m.persistence.GetShardDistributorState(ctx, request)
+}
+
+func (m *shardDistributorManager) UpdateShardDistributorLeader(ctx context.Context, request *UpdateShardDistributorLeaderRequest) error {
+	return m.persistence.UpdateShardDistributorLeader(ctx, request)
+}
+
+func (m *shardDistributorManager) UpsertShardDistributorExecutor(ctx context.Context, request *UpsertShardDistributorExecutorRequest) error {
+	return m.persistence.UpsertShardDistributorExecutor(ctx, request)
+}
+
+func (m *shardDistributorManager) AssignShardDistributorShards(ctx context.Context, request *AssignShardDistributorShardsRequest) error {
+	if len(request.AssignedShards) == 0 {
+		return nil
+	}
+	return m.persistence.AssignShardDistributorShards(ctx, request)
+}
+
+func (m *shardDistributorManager) DeleteShardDistributorExecutors(ctx context.Context, request *DeleteShardDistributorExecutorsRequest) error {
+	if len(request.ExecutorIDs) == 0 {
+		return nil
+	}
+	return m.persistence.DeleteShardDistributorExecutors(ctx, request)
+}
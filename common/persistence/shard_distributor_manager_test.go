@@ -0,0 +1,138 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/constants"
+)
+
+func TestNewShardDistributorManager(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockShardDistributorStore(ctrl)
+	m := NewShardDistributorManager(mockStore)
+	mm, ok := m.(*shardDistributorManager)
+	assert.True(t, ok)
+	assert.Equal(t, mockStore, mm.persistence)
+
+	mockStore.EXPECT().Close().Times(1)
+	m.Close()
+}
+
+func TestGetShardDistributorState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockShardDistributorStore(ctrl)
+	m := NewShardDistributorManager(mockStore)
+
+	request := &GetShardDistributorStateRequest{Namespace: "namespace"}
+	response := &GetShardDistributorStateResponse{
+		Leader: &ShardDistributorLeader{LeaderID: "host", LeaseExpiry: time.Unix(10, 0), Version: 1},
+		Executors: map[string]*ShardDistributorExecutor{
+			"executor": {ExecutorID: "executor", State: "ACTIVE"},
+		},
+		Revision: 3,
+	}
+	mockStore.EXPECT().GetShardDistributorState(gomock.Any(), request).Return(response, nil).Times(1)
+	resp, err := m.GetShardDistributorState(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, response, resp)
+}
+
+func TestUpdateShardDistributorLeader(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockShardDistributorStore(ctrl)
+	m := NewShardDistributorManager(mockStore)
+
+	request := &UpdateShardDistributorLeaderRequest{
+		Namespace:       "namespace",
+		Leader:          &ShardDistributorLeader{LeaderID: "host", LeaseExpiry: time.Unix(10, 0), Version: 2},
+		PreviousVersion: 1,
+	}
+	mockStore.EXPECT().UpdateShardDistributorLeader(gomock.Any(), request).Return(&ConditionFailedError{}).Times(1)
+	err := m.UpdateShardDistributorLeader(context.Background(), request)
+	assert.IsType(t, &ConditionFailedError{}, err)
+}
+
+func TestUpsertShardDistributorExecutor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockShardDistributorStore(ctrl)
+	m := NewShardDistributorManager(mockStore)
+
+	request := &UpsertShardDistributorExecutorRequest{
+		Namespace: "namespace",
+		Executor: &ShardDistributorExecutor{
+			ExecutorID:     "executor",
+			LastHeartbeat:  time.Unix(10, 0),
+			State:          "ACTIVE",
+			ReportedShards: &DataBlob{Data: []byte("{}"), Encoding: constants.EncodingTypeJSON},
+		},
+		PreviousRevision: 1,
+	}
+	mockStore.EXPECT().UpsertShardDistributorExecutor(gomock.Any(), request).Return(errors.New("store error")).Times(1)
+	assert.Error(t, m.UpsertShardDistributorExecutor(context.Background(), request))
+}
+
+func TestAssignShardDistributorShards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockShardDistributorStore(ctrl)
+	m := NewShardDistributorManager(mockStore)
+
+	// nothing to write
+	assert.NoError(t, m.AssignShardDistributorShards(context.Background(), &AssignShardDistributorShardsRequest{Namespace: "namespace"}))
+
+	request := &AssignShardDistributorShardsRequest{
+		Namespace: "namespace",
+		AssignedShards: map[string]*DataBlob{
+			"executor": {Data: []byte("{}"), Encoding: constants.EncodingTypeJSON},
+		},
+		LeaderVersion:    1,
+		PreviousRevision: 1,
+	}
+	mockStore.EXPECT().AssignShardDistributorShards(gomock.Any(), request).Return(nil).Times(1)
+	assert.NoError(t, m.AssignShardDistributorShards(context.Background(), request))
+}
+
+func TestDeleteShardDistributorExecutors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := NewMockShardDistributorStore(ctrl)
+	m := NewShardDistributorManager(mockStore)
+
+	// nothing to delete
+	assert.NoError(t, m.DeleteShardDistributorExecutors(context.Background(), &DeleteShardDistributorExecutorsRequest{Namespace: "namespace"}))
+
+	request := &DeleteShardDistributorExecutorsRequest{
+		Namespace:        "namespace",
+		ExecutorIDs:      []string{"executor"},
+		LeaderVersion:    1,
+		PreviousRevision: 1,
+	}
+	mockStore.EXPECT().DeleteShardDistributorExecutors(gomock.Any(), request).Return(nil).Times(1)
+	assert.NoError(t, m.DeleteShardDistributorExecutors(context.Background(), request))
+}
@@ -144,6 +144,33 @@ func (f *Factory) NewConfigStore() (p.ConfigStore, error) {
 	return NewSQLConfigStore(conn, f.logger, f.parser)
 }
 
+// NewAsyncRequestStore returns a new async request store backed by sql
+func (f *Factory) NewAsyncRequestStore() (p.AsyncRequestStore, error) {
+	conn, err := f.dbConn.get()
+	if err != nil {
+		return nil, err
+	}
+	return NewSQLAsyncRequestStore(conn, f.logger, f.parser)
+}
+
+// NewMapQStore returns a new MAPQ store backed by sql
+func (f *Factory) NewMapQStore() (p.MapQStore, error) {
+	conn, err := f.dbConn.get()
+	if err != nil {
+		return nil, err
+	}
+	return NewSQLMapQStore(conn, f.logger, f.parser)
+}
+
+// NewShardDistributorStore returns a new shard distributor store backed by sql
+func (f *Factory) NewShardDistributorStore() (p.ShardDistributorStore, error) {
+	conn, err := f.dbConn.get()
+	if err != nil {
+		return nil, err
+	}
+	return NewSQLShardDistributorStore(conn, f.logger, f.parser)
+}
+
 // Close closes the factory
 func (f *Factory) Close() {
 	f.dbConn.forceClose()
@@ -221,3 +221,69 @@ func TestFactoryNewConfigStore(t *testing.T) {
 	assert.NoError(t, err)
 	factory.Close()
 }
+
+func TestFactoryNewAsyncRequestStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cfg := config.SQL{}
+	clusterName := "test"
+	logger := testlogger.New(t)
+	mockParser := serialization.NewMockParser(ctrl)
+	dc := &persistence.DynamicConfiguration{}
+	factory := NewFactory(cfg, clusterName, logger, mockParser, dc)
+	store, err := factory.NewAsyncRequestStore()
+	assert.Nil(t, store)
+	assert.Error(t, err)
+	factory.Close()
+
+	cfg.PluginName = "shared"
+	factory = NewFactory(cfg, clusterName, logger, mockParser, dc)
+	store, err = factory.NewAsyncRequestStore()
+	assert.NotNil(t, store)
+	assert.NoError(t, err)
+	factory.Close()
+}
+
+func TestFactoryNewMapQStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cfg := config.SQL{}
+	clusterName := "test"
+	logger := testlogger.New(t)
+	mockParser := serialization.NewMockParser(ctrl)
+	dc := &persistence.DynamicConfiguration{}
+	factory := NewFactory(cfg, clusterName, logger, mockParser, dc)
+	store, err := factory.NewMapQStore()
+	assert.Nil(t, store)
+	assert.Error(t, err)
+	factory.Close()
+
+	cfg.PluginName = "shared"
+	factory = NewFactory(cfg, clusterName, logger, mockParser, dc)
+	store, err = factory.NewMapQStore()
+	assert.NotNil(t, store)
+	assert.NoError(t, err)
+	factory.Close()
+}
+
+func TestFactoryNewShardDistributorStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cfg := config.SQL{}
+	clusterName := "test"
+	logger := testlogger.New(t)
+	mockParser := serialization.NewMockParser(ctrl)
+	dc := &persistence.DynamicConfiguration{}
+	factory := NewFactory(cfg, clusterName, logger, mockParser, dc)
+	store, err := factory.NewShardDistributorStore()
+	assert.Nil(t, store)
+	assert.Error(t, err)
+	factory.Close()
+
+	cfg.PluginName = "shared"
+	factory = NewFactory(cfg, clusterName, logger, mockParser, dc)
+	store, err = factory.NewShardDistributorStore()
+	assert.NotNil(t, store)
+	assert.NoError(t, err)
+	factory.Close()
+}
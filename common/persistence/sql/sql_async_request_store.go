@@ -0,0 +1,185 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/serialization"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+type (
+	sqlAsyncRequestStore struct {
+		sqlStore
+	}
+)
+
+// NewSQLAsyncRequestStore creates an async request store for SQL
+func NewSQLAsyncRequestStore(
+	db sqlplugin.DB,
+	logger log.Logger,
+	parser serialization.Parser,
+) (persistence.AsyncRequestStore, error) {
+	return &sqlAsyncRequestStore{
+		sqlStore: sqlStore{
+			db:     db,
+			logger: logger,
+			parser: parser,
+		},
+	}, nil
+}
+
+// UpsertAsyncRequest writes the status row. SQL stores don't support TTL so the expiry time is only recorded.
+func (m *sqlAsyncRequestStore) UpsertAsyncRequest(ctx context.Context, request *persistence.UpsertAsyncRequestRequest) error {
+	if err := m.db.ReplaceIntoAsyncRequests(ctx, request.Request); err != nil {
+		return convertCommonErrors(m.db, "UpsertAsyncRequest", "", err)
+	}
+	return nil
+}
+
+func (m *sqlAsyncRequestStore) GetAsyncRequest(ctx context.Context, request *persistence.GetAsyncRequestRequest) (*persistence.GetAsyncRequestResponse, error) {
+	row, err := m.db.SelectFromAsyncRequests(ctx, request.DomainID, request.RequestID)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "GetAsyncRequest", "", err)
+	}
+	return &persistence.GetAsyncRequestResponse{Request: row}, nil
+}
+
+func (m *sqlAsyncRequestStore) EnqueueAsyncRequestToDLQ(ctx context.Context, request *persistence.EnqueueAsyncRequestToDLQRequest) error {
+	if err := m.db.ReplaceIntoAsyncRequestDLQ(ctx, request.Entry); err != nil {
+		return convertCommonErrors(m.db, "EnqueueAsyncRequestToDLQ", "", err)
+	}
+	return nil
+}
+
+// ReadAsyncRequestDLQ pages through the DLQ by request ID. The page token is the last request ID of the previous page.
+func (m *sqlAsyncRequestStore) ReadAsyncRequestDLQ(ctx context.Context, request *persistence.ReadAsyncRequestDLQRequest) (*persistence.ReadAsyncRequestDLQResponse, error) {
+	entries, err := m.db.RangeSelectFromAsyncRequestDLQ(ctx, request.DomainID, string(request.NextPageToken), request.PageSize)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "ReadAsyncRequestDLQ", "", err)
+	}
+	var nextPageToken []byte
+	if len(entries) > 0 && len(entries) == request.PageSize {
+		nextPageToken = []byte(entries[len(entries)-1].RequestID)
+	}
+	return &persistence.ReadAsyncRequestDLQResponse{
+		Entries:       entries,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (m *sqlAsyncRequestStore) GetAsyncRequestDLQEntry(ctx context.Context, request *persistence.GetAsyncRequestDLQEntryRequest) (*persistence.GetAsyncRequestDLQEntryResponse, error) {
+	entry, err := m.db.SelectFromAsyncRequestDLQ(ctx, request.DomainID, request.RequestID)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "GetAsyncRequestDLQEntry", "", err)
+	}
+	return &persistence.GetAsyncRequestDLQEntryResponse{Entry: entry}, nil
+}
+
+func (m *sqlAsyncRequestStore) DeleteAsyncRequestDLQEntry(ctx context.Context, request *persistence.DeleteAsyncRequestDLQEntryRequest) error {
+	if _, err := m.db.DeleteFromAsyncRequestDLQ(ctx, request.DomainID, request.RequestID); err != nil {
+		return convertCommonErrors(m.db, "DeleteAsyncRequestDLQEntry", "", err)
+	}
+	return nil
+}
+
+func (m *sqlAsyncRequestStore) InsertAsyncRequestQueueMessage(ctx context.Context, request *persistence.InternalInsertAsyncRequestQueueMessageRequest) error {
+	if _, err := m.db.InsertIntoAsyncRequestQueue(ctx, request.QueueName, request.Message); err != nil {
+		if m.db.IsDupEntryError(err) {
+			return &persistence.ConditionFailedError{
+				Msg: fmt.Sprintf("message ID %v already exists in queue %v", request.Message.MessageID, request.QueueName),
+			}
+		}
+		return convertCommonErrors(m.db, "InsertAsyncRequestQueueMessage", "", err)
+	}
+	return nil
+}
+
+func (m *sqlAsyncRequestStore) GetLastAsyncRequestQueueMessageID(ctx context.Context, queueName string) (int64, error) {
+	messageID, err := m.db.SelectLastAsyncRequestQueueMessageID(ctx, queueName)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return -1, nil
+		}
+		return -1, convertCommonErrors(m.db, "GetLastAsyncRequestQueueMessageID", "", err)
+	}
+	return messageID, nil
+}
+
+func (m *sqlAsyncRequestStore) ReadAsyncRequestQueueMessages(ctx context.Context, request *persistence.ReadAsyncRequestQueueMessagesRequest) (*persistence.ReadAsyncRequestQueueMessagesResponse, error) {
+	messages, err := m.db.RangeSelectFromAsyncRequestQueue(ctx, request.QueueName, request.ExclusiveBeginMessageID, request.PageSize)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "ReadAsyncRequestQueueMessages", "", err)
+	}
+	return &persistence.ReadAsyncRequestQueueMessagesResponse{Messages: messages}, nil
+}
+
+func (m *sqlAsyncRequestStore) DeleteAsyncRequestQueueMessages(ctx context.Context, request *persistence.DeleteAsyncRequestQueueMessagesRequest) error {
+	if _, err := m.db.RangeDeleteFromAsyncRequestQueue(ctx, request.QueueName, request.InclusiveEndMessageID); err != nil {
+		return convertCommonErrors(m.db, "DeleteAsyncRequestQueueMessages", "", err)
+	}
+	return nil
+}
+
+func (m *sqlAsyncRequestStore) GetAsyncRequestQueueMetadata(ctx context.Context, request *persistence.GetAsyncRequestQueueMetadataRequest) (*persistence.GetAsyncRequestQueueMetadataResponse, error) {
+	metadata, err := m.db.SelectFromAsyncRequestQueueMetadata(ctx, request.QueueName)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "GetAsyncRequestQueueMetadata", "", err)
+	}
+	return &persistence.GetAsyncRequestQueueMetadataResponse{Metadata: metadata}, nil
+}
+
+// UpdateAsyncRequestQueueMetadata creates the metadata row when there is no previous version, otherwise the row is
+// only updated if its version still matches
+func (m *sqlAsyncRequestStore) UpdateAsyncRequestQueueMetadata(ctx context.Context, request *persistence.UpdateAsyncRequestQueueMetadataRequest) error {
+	conditionFailedErr := &persistence.ConditionFailedError{
+		Msg: fmt.Sprintf("metadata of queue %v was updated concurrently, expected version %v", request.Metadata.QueueName, request.PreviousVersion),
+	}
+	if request.PreviousVersion == 0 {
+		if _, err := m.db.InsertIntoAsyncRequestQueueMetadata(ctx, request.Metadata); err != nil {
+			if m.db.IsDupEntryError(err) {
+				return conditionFailedErr
+			}
+			return convertCommonErrors(m.db, "UpdateAsyncRequestQueueMetadata", "", err)
+		}
+		return nil
+	}
+
+	result, err := m.db.UpdateAsyncRequestQueueMetadata(ctx, request.Metadata, request.PreviousVersion)
+	if err != nil {
+		return convertCommonErrors(m.db, "UpdateAsyncRequestQueueMetadata", "", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return convertCommonErrors(m.db, "UpdateAsyncRequestQueueMetadata", "", err)
+	}
+	if rowsAffected != 1 {
+		return conditionFailedErr
+	}
+	return nil
+}
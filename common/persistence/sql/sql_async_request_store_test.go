@@ -0,0 +1,204 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+func setUpMocksForSQLAsyncRequestStore(t *testing.T) (persistence.AsyncRequestStore, *sqlplugin.MockDB) {
+	ctrl := gomock.NewController(t)
+	mockDB := sqlplugin.NewMockDB(ctrl)
+	store, err := NewSQLAsyncRequestStore(mockDB, log.NewNoop(), nil)
+	require.NoError(t, err)
+	return store, mockDB
+}
+
+func TestSQLUpsertAsyncRequest(t *testing.T) {
+	store, mockDB := setUpMocksForSQLAsyncRequestStore(t)
+	info := &persistence.AsyncRequestInfo{DomainID: "domain-id", RequestID: "request-id", State: types.AsyncRequestStateQueued}
+	mockDB.EXPECT().ReplaceIntoAsyncRequests(gomock.Any(), info).Return(nil)
+
+	assert.NoError(t, store.UpsertAsyncRequest(context.Background(), &persistence.UpsertAsyncRequestRequest{Request: info}))
+}
+
+func TestSQLGetAsyncRequest(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		store, mockDB := setUpMocksForSQLAsyncRequestStore(t)
+		info := &persistence.AsyncRequestInfo{DomainID: "domain-id", RequestID: "request-id", State: types.AsyncRequestStateFailed}
+		mockDB.EXPECT().SelectFromAsyncRequests(gomock.Any(), "domain-id", "request-id").Return(info, nil)
+
+		resp, err := store.GetAsyncRequest(context.Background(), &persistence.GetAsyncRequestRequest{DomainID: "domain-id", RequestID: "request-id"})
+		assert.NoError(t, err)
+		assert.Equal(t, info, resp.Request)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		store, mockDB := setUpMocksForSQLAsyncRequestStore(t)
+		mockDB.EXPECT().SelectFromAsyncRequests(gomock.Any(), "domain-id", "request-id").Return(nil, sql.ErrNoRows)
+		mockDB.EXPECT().IsNotFoundError(sql.ErrNoRows).Return(true)
+
+		_, err := store.GetAsyncRequest(context.Background(), &persistence.GetAsyncRequestRequest{DomainID: "domain-id", RequestID: "request-id"})
+		assert.IsType(t, &types.EntityNotExistsError{}, err)
+	})
+}
+
+func TestSQLReadAsyncRequestDLQ(t *testing.T) {
+	entries := []*persistence.AsyncRequestDLQEntry{{RequestID: "a"}, {RequestID: "b"}}
+	testCases := []struct {
+		name          string
+		pageSize      int
+		pageToken     []byte
+		expectedMinID string
+		expectedToken []byte
+	}{
+		{
+			name:          "full page returns a token",
+			pageSize:      2,
+			expectedMinID: "",
+			expectedToken: []byte("b"),
+		},
+		{
+			name:          "partial page ends the iteration",
+			pageSize:      3,
+			pageToken:     []byte("0"),
+			expectedMinID: "0",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store, mockDB := setUpMocksForSQLAsyncRequestStore(t)
+			mockDB.EXPECT().RangeSelectFromAsyncRequestDLQ(gomock.Any(), "domain-id", tc.expectedMinID, tc.pageSize).Return(entries, nil)
+
+			resp, err := store.ReadAsyncRequestDLQ(context.Background(), &persistence.ReadAsyncRequestDLQRequest{
+				DomainID:      "domain-id",
+				PageSize:      tc.pageSize,
+				NextPageToken: tc.pageToken,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, entries, resp.Entries)
+			assert.Equal(t, tc.expectedToken, resp.NextPageToken)
+		})
+	}
+}
+
+func TestSQLAsyncRequestDLQEntry(t *testing.T) {
+	ctx := context.Background()
+	entry := &persistence.AsyncRequestDLQEntry{DomainID: "domain-id", RequestID: "request-id"}
+
+	store, mockDB := setUpMocksForSQLAsyncRequestStore(t)
+	mockDB.EXPECT().ReplaceIntoAsyncRequestDLQ(ctx, entry).Return(nil)
+	mockDB.EXPECT().SelectFromAsyncRequestDLQ(ctx, "domain-id", "request-id").Return(entry, nil)
+	mockDB.EXPECT().DeleteFromAsyncRequestDLQ(ctx, "domain-id", "request-id").Return(nil, nil)
+
+	assert.NoError(t, store.EnqueueAsyncRequestToDLQ(ctx, &persistence.EnqueueAsyncRequestToDLQRequest{Entry: entry}))
+	resp, err := store.GetAsyncRequestDLQEntry(ctx, &persistence.GetAsyncRequestDLQEntryRequest{DomainID: "domain-id", RequestID: "request-id"})
+	assert.NoError(t, err)
+	assert.Equal(t, entry, resp.Entry)
+	assert.NoError(t, store.DeleteAsyncRequestDLQEntry(ctx, &persistence.DeleteAsyncRequestDLQEntryRequest{DomainID: "domain-id", RequestID: "request-id"}))
+}
+
+func TestSQLAsyncRequestQueue(t *testing.T) {
+	ctx := context.Background()
+	message := &persistence.AsyncRequestQueueMessage{MessageID: 11, Payload: []byte("payload")}
+	metadata := &persistence.AsyncRequestQueueMetadata{QueueName: "queue", AckLevel: 10, LeaseOwner: "host-1", Version: 3}
+	dupErr := errors.New("duplicate entry")
+
+	t.Run("insert message", func(t *testing.T) {
+		store, mockDB := setUpMocksForSQLAsyncRequestStore(t)
+		mockDB.EXPECT().InsertIntoAsyncRequestQueue(ctx, "queue", message).Return(&sqlResult{rowsAffected: 1}, nil)
+		assert.NoError(t, store.InsertAsyncRequestQueueMessage(ctx, &persistence.InternalInsertAsyncRequestQueueMessageRequest{QueueName: "queue", Message: message}))
+	})
+
+	t.Run("insert message conflict", func(t *testing.T) {
+		store, mockDB := setUpMocksForSQLAsyncRequestStore(t)
+		mockDB.EXPECT().InsertIntoAsyncRequestQueue(ctx, "queue", message).Return(nil, dupErr)
+		mockDB.EXPECT().IsDupEntryError(dupErr).Return(true)
+		err := store.InsertAsyncRequestQueueMessage(ctx, &persistence.InternalInsertAsyncRequestQueueMessageRequest{QueueName: "queue", Message: message})
+		assert.IsType(t, &persistence.ConditionFailedError{}, err)
+	})
+
+	t.Run("last message ID of empty queue", func(t *testing.T) {
+		store, mockDB := setUpMocksForSQLAsyncRequestStore(t)
+		mockDB.EXPECT().SelectLastAsyncRequestQueueMessageID(ctx, "queue").Return(int64(0), sql.ErrNoRows)
+		id, err := store.GetLastAsyncRequestQueueMessageID(ctx, "queue")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(-1), id)
+	})
+
+	t.Run("read messages", func(t *testing.T) {
+		store, mockDB := setUpMocksForSQLAsyncRequestStore(t)
+		mockDB.EXPECT().RangeSelectFromAsyncRequestQueue(ctx, "queue", int64(10), 100).Return([]*persistence.AsyncRequestQueueMessage{message}, nil)
+		resp, err := store.ReadAsyncRequestQueueMessages(ctx, &persistence.ReadAsyncRequestQueueMessagesRequest{QueueName: "queue", ExclusiveBeginMessageID: 10, PageSize: 100})
+		assert.NoError(t, err)
+		assert.Equal(t, []*persistence.AsyncRequestQueueMessage{message}, resp.Messages)
+	})
+
+	t.Run("delete messages", func(t *testing.T) {
+		store, mockDB := setUpMocksForSQLAsyncRequestStore(t)
+		mockDB.EXPECT().RangeDeleteFromAsyncRequestQueue(ctx, "queue", int64(11)).Return(&sqlResult{rowsAffected: 2}, nil)
+		assert.NoError(t, store.DeleteAsyncRequestQueueMessages(ctx, &persistence.DeleteAsyncRequestQueueMessagesRequest{QueueName: "queue", InclusiveEndMessageID: 11}))
+	})
+
+	t.Run("get metadata not found", func(t *testing.T) {
+		store, mockDB := setUpMocksForSQLAsyncRequestStore(t)
+		mockDB.EXPECT().SelectFromAsyncRequestQueueMetadata(ctx, "queue").Return(nil, sql.ErrNoRows)
+		mockDB.EXPECT().IsNotFoundError(sql.ErrNoRows).Return(true)
+		_, err := store.GetAsyncRequestQueueMetadata(ctx, &persistence.GetAsyncRequestQueueMetadataRequest{QueueName: "queue"})
+		assert.IsType(t, &types.EntityNotExistsError{}, err)
+	})
+
+	t.Run("create metadata conflict", func(t *testing.T) {
+		store, mockDB := setUpMocksForSQLAsyncRequestStore(t)
+		mockDB.EXPECT().InsertIntoAsyncRequestQueueMetadata(ctx, metadata).Return(nil, dupErr)
+		mockDB.EXPECT().IsDupEntryError(dupErr).Return(true)
+		err := store.UpdateAsyncRequestQueueMetadata(ctx, &persistence.UpdateAsyncRequestQueueMetadataRequest{Metadata: metadata})
+		assert.IsType(t, &persistence.ConditionFailedError{}, err)
+	})
+
+	t.Run("update metadata", func(t *testing.T) {
+		store, mockDB := setUpMocksForSQLAsyncRequestStore(t)
+		mockDB.EXPECT().UpdateAsyncRequestQueueMetadata(ctx, metadata, int64(2)).Return(&sqlResult{rowsAffected: 1}, nil)
+		assert.NoError(t, store.UpdateAsyncRequestQueueMetadata(ctx, &persistence.UpdateAsyncRequestQueueMetadataRequest{Metadata: metadata, PreviousVersion: 2}))
+	})
+
+	t.Run("update metadata version mismatch", func(t *testing.T) {
+		store, mockDB := setUpMocksForSQLAsyncRequestStore(t)
+		mockDB.EXPECT().UpdateAsyncRequestQueueMetadata(ctx, metadata, int64(2)).Return(&sqlResult{rowsAffected: 0}, nil)
+		err := store.UpdateAsyncRequestQueueMetadata(ctx, &persistence.UpdateAsyncRequestQueueMetadataRequest{Metadata: metadata, PreviousVersion: 2})
+		assert.IsType(t, &persistence.ConditionFailedError{}, err)
+	})
+}
@@ -0,0 +1,145 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/serialization"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+type (
+	sqlMapQStore struct {
+		sqlStore
+	}
+)
+
+// NewSQLMapQStore creates a MAPQ store for SQL
+func NewSQLMapQStore(
+	db sqlplugin.DB,
+	logger log.Logger,
+	parser serialization.Parser,
+) (persistence.MapQStore, error) {
+	return &sqlMapQStore{
+		sqlStore: sqlStore{
+			db:     db,
+			logger: logger,
+			parser: parser,
+		},
+	}, nil
+}
+
+func (m *sqlMapQStore) EnqueueMapQItems(ctx context.Context, request *persistence.EnqueueMapQItemsRequest) error {
+	rows := make([]sqlplugin.MapQItemsRow, 0, len(request.Items))
+	for _, item := range request.Items {
+		rows = append(rows, sqlplugin.MapQItemsRow{
+			QueueID:       request.QueueID,
+			PartitionPath: item.Partition,
+			ItemOffset:    item.Offset,
+			Payload:       item.Payload,
+		})
+	}
+	if _, err := m.db.ReplaceIntoMapQItems(ctx, rows); err != nil {
+		return convertCommonErrors(m.db, "EnqueueMapQItems", "", err)
+	}
+	return nil
+}
+
+func (m *sqlMapQStore) ReadMapQItems(ctx context.Context, request *persistence.ReadMapQItemsRequest) (*persistence.ReadMapQItemsResponse, error) {
+	rows, err := m.db.RangeSelectFromMapQItems(ctx, request.QueueID, request.Partition, request.ExclusiveMinOffset, request.InclusiveMaxOffset, request.PageSize)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "ReadMapQItems", "", err)
+	}
+	items := make([]*persistence.MapQItem, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, &persistence.MapQItem{
+			Partition: row.PartitionPath,
+			Offset:    row.ItemOffset,
+			Payload:   row.Payload,
+		})
+	}
+	return &persistence.ReadMapQItemsResponse{Items: items}, nil
+}
+
+func (m *sqlMapQStore) DeleteMapQItems(ctx context.Context, request *persistence.DeleteMapQItemsRequest) error {
+	if _, err := m.db.RangeDeleteFromMapQItems(ctx, request.QueueID, request.Partition, request.InclusiveMaxOffset); err != nil {
+		return convertCommonErrors(m.db, "DeleteMapQItems", "", err)
+	}
+	return nil
+}
+
+func (m *sqlMapQStore) GetMapQState(ctx context.Context, request *persistence.GetMapQStateRequest) (*persistence.GetMapQStateResponse, error) {
+	row, err := m.db.SelectFromMapQState(ctx, request.QueueID)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "GetMapQState", "", err)
+	}
+	return &persistence.GetMapQStateResponse{
+		State: &persistence.MapQState{
+			QueueID: row.QueueID,
+			State: &persistence.DataBlob{
+				Data:     row.State,
+				Encoding: constants.EncodingType(row.StateEncoding),
+			},
+			Version: row.Version,
+		},
+	}, nil
+}
+
+func (m *sqlMapQStore) UpdateMapQState(ctx context.Context, request *persistence.UpdateMapQStateRequest) error {
+	conditionFailedErr := &persistence.ConditionFailedError{
+		Msg: fmt.Sprintf("state of MAPQ queue %v was updated concurrently, expected version %v", request.State.QueueID, request.PreviousVersion),
+	}
+	row := &sqlplugin.MapQStateRow{
+		QueueID:       request.State.QueueID,
+		State:         request.State.State.Data,
+		StateEncoding: string(request.State.State.Encoding),
+		Version:       request.State.Version,
+	}
+	if request.PreviousVersion == 0 {
+		if _, err := m.db.InsertIntoMapQState(ctx, row); err != nil {
+			if m.db.IsDupEntryError(err) {
+				return conditionFailedErr
+			}
+			return convertCommonErrors(m.db, "UpdateMapQState", "", err)
+		}
+		return nil
+	}
+
+	result, err := m.db.UpdateMapQState(ctx, row, request.PreviousVersion)
+	if err != nil {
+		return convertCommonErrors(m.db, "UpdateMapQState", "", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return convertCommonErrors(m.db, "UpdateMapQState", "", err)
+	}
+	if rowsAffected != 1 {
+		return conditionFailedErr
+	}
+	return nil
+}
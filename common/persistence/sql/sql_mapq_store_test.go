@@ -0,0 +1,118 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+func setUpMocksForSQLMapQStore(t *testing.T) (persistence.MapQStore, *sqlplugin.MockDB) {
+	ctrl := gomock.NewController(t)
+	mockDB := sqlplugin.NewMockDB(ctrl)
+	store, err := NewSQLMapQStore(mockDB, log.NewNoop(), nil)
+	require.NoError(t, err)
+	return store, mockDB
+}
+
+func TestSQLMapQStore(t *testing.T) {
+	ctx := context.Background()
+	item := &persistence.MapQItem{Partition: "root/*", Offset: 5, Payload: []byte("payload")}
+	itemRow := sqlplugin.MapQItemsRow{QueueID: "queue", PartitionPath: "root/*", ItemOffset: 5, Payload: []byte("payload")}
+	state := &persistence.MapQState{
+		QueueID: "queue",
+		State:   &persistence.DataBlob{Data: []byte("{}"), Encoding: constants.EncodingTypeJSON},
+		Version: 3,
+	}
+	stateRow := &sqlplugin.MapQStateRow{QueueID: "queue", State: []byte("{}"), StateEncoding: "json", Version: 3}
+	dupErr := errors.New("duplicate entry")
+
+	t.Run("enqueue items", func(t *testing.T) {
+		store, mockDB := setUpMocksForSQLMapQStore(t)
+		mockDB.EXPECT().ReplaceIntoMapQItems(ctx, []sqlplugin.MapQItemsRow{itemRow}).Return(&sqlResult{rowsAffected: 1}, nil)
+		assert.NoError(t, store.EnqueueMapQItems(ctx, &persistence.EnqueueMapQItemsRequest{QueueID: "queue", Items: []*persistence.MapQItem{item}}))
+	})
+
+	t.Run("read items", func(t *testing.T) {
+		store, mockDB := setUpMocksForSQLMapQStore(t)
+		mockDB.EXPECT().RangeSelectFromMapQItems(ctx, "queue", "root/*", int64(4), int64(10), 100).Return([]sqlplugin.MapQItemsRow{itemRow}, nil)
+		resp, err := store.ReadMapQItems(ctx, &persistence.ReadMapQItemsRequest{QueueID: "queue", Partition: "root/*", ExclusiveMinOffset: 4, InclusiveMaxOffset: 10, PageSize: 100})
+		assert.NoError(t, err)
+		assert.Equal(t, []*persistence.MapQItem{item}, resp.Items)
+	})
+
+	t.Run("delete items", func(t *testing.T) {
+		store, mockDB := setUpMocksForSQLMapQStore(t)
+		mockDB.EXPECT().RangeDeleteFromMapQItems(ctx, "queue", "root/*", int64(5)).Return(&sqlResult{rowsAffected: 1}, nil)
+		assert.NoError(t, store.DeleteMapQItems(ctx, &persistence.DeleteMapQItemsRequest{QueueID: "queue", Partition: "root/*", InclusiveMaxOffset: 5}))
+	})
+
+	t.Run("get state", func(t *testing.T) {
+		store, mockDB := setUpMocksForSQLMapQStore(t)
+		mockDB.EXPECT().SelectFromMapQState(ctx, "queue").Return(stateRow, nil)
+		resp, err := store.GetMapQState(ctx, &persistence.GetMapQStateRequest{QueueID: "queue"})
+		assert.NoError(t, err)
+		assert.Equal(t, state, resp.State)
+	})
+
+	t.Run("get state not found", func(t *testing.T) {
+		store, mockDB := setUpMocksForSQLMapQStore(t)
+		mockDB.EXPECT().SelectFromMapQState(ctx, "queue").Return(nil, sql.ErrNoRows)
+		mockDB.EXPECT().IsNotFoundError(sql.ErrNoRows).Return(true)
+		_, err := store.GetMapQState(ctx, &persistence.GetMapQStateRequest{QueueID: "queue"})
+		assert.IsType(t, &types.EntityNotExistsError{}, err)
+	})
+
+	t.Run("create state conflict", func(t *testing.T) {
+		store, mockDB := setUpMocksForSQLMapQStore(t)
+		mockDB.EXPECT().InsertIntoMapQState(ctx, stateRow).Return(nil, dupErr)
+		mockDB.EXPECT().IsDupEntryError(dupErr).Return(true)
+		err := store.UpdateMapQState(ctx, &persistence.UpdateMapQStateRequest{State: state})
+		assert.IsType(t, &persistence.ConditionFailedError{}, err)
+	})
+
+	t.Run("update state", func(t *testing.T) {
+		store, mockDB := setUpMocksForSQLMapQStore(t)
+		mockDB.EXPECT().UpdateMapQState(ctx, stateRow, int64(2)).Return(&sqlResult{rowsAffected: 1}, nil)
+		assert.NoError(t, store.UpdateMapQState(ctx, &persistence.UpdateMapQStateRequest{State: state, PreviousVersion: 2}))
+	})
+
+	t.Run("update state conflict", func(t *testing.T) {
+		store, mockDB := setUpMocksForSQLMapQStore(t)
+		mockDB.EXPECT().UpdateMapQState(ctx, stateRow, int64(2)).Return(&sqlResult{rowsAffected: 0}, nil)
+		err := store.UpdateMapQState(ctx, &persistence.UpdateMapQStateRequest{State: state, PreviousVersion: 2})
+		assert.IsType(t, &persistence.ConditionFailedError{}, err)
+	})
+}
@@ -0,0 +1,301 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/serialization"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+type (
+	sqlShardDistributorStore struct {
+		sqlStore
+	}
+)
+
+// NewSQLShardDistributorStore creates a shard distributor store for SQL
+func NewSQLShardDistributorStore(
+	db sqlplugin.DB,
+	logger log.Logger,
+	parser serialization.Parser,
+) (persistence.ShardDistributorStore, error) {
+	return &sqlShardDistributorStore{
+		sqlStore: sqlStore{
+			db:     db,
+			logger: logger,
+			parser: parser,
+		},
+	}, nil
+}
+
+func (m *sqlShardDistributorStore) GetShardDistributorState(
+	ctx context.Context,
+	request *persistence.GetShardDistributorStateRequest,
+) (*persistence.GetShardDistributorStateResponse, error) {
+	// the namespace is read before the executors, so the executors are never older than the returned revision
+	// and a write conditioned on the revision can't overwrite changes that weren't read
+	namespace, err := m.db.SelectFromShardDistributorNamespaces(ctx, request.Namespace)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &persistence.GetShardDistributorStateResponse{
+				Executors: map[string]*persistence.ShardDistributorExecutor{},
+			}, nil
+		}
+		return nil, convertCommonErrors(m.db, "GetShardDistributorState", "", err)
+	}
+	rows, err := m.db.SelectFromShardDistributorExecutors(ctx, request.Namespace)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "GetShardDistributorState", "", err)
+	}
+
+	resp := &persistence.GetShardDistributorStateResponse{
+		Executors: make(map[string]*persistence.ShardDistributorExecutor, len(rows)),
+		Revision:  namespace.Revision,
+	}
+	if namespace.LeaderVersion > 0 {
+		resp.Leader = &persistence.ShardDistributorLeader{
+			LeaderID:    namespace.LeaderID,
+			LeaseExpiry: namespace.LeaseExpiry,
+			Version:     namespace.LeaderVersion,
+		}
+	}
+	for _, row := range rows {
+		resp.Executors[row.ExecutorID] = &persistence.ShardDistributorExecutor{
+			ExecutorID:       row.ExecutorID,
+			LastHeartbeat:    row.LastHeartbeat,
+			State:            row.State,
+			ReportedShards:   toShardDistributorBlob(row.ReportedShards, row.ReportedShardsEncoding),
+			AssignedShards:   toShardDistributorBlob(row.AssignedShards, row.AssignedShardsEncoding),
+			AssignedRevision: row.AssignedRevision,
+		}
+	}
+	return resp, nil
+}
+
+func (m *sqlShardDistributorStore) UpdateShardDistributorLeader(
+	ctx context.Context,
+	request *persistence.UpdateShardDistributorLeaderRequest,
+) error {
+	if request.PreviousVersion == 0 {
+		if err := m.createNamespace(ctx, request.Namespace); err != nil {
+			return err
+		}
+	}
+	result, err := m.db.UpdateShardDistributorNamespacesLeader(ctx, &sqlplugin.ShardDistributorNamespacesRow{
+		Namespace:     request.Namespace,
+		LeaderID:      request.Leader.LeaderID,
+		LeaseExpiry:   request.Leader.LeaseExpiry,
+		LeaderVersion: request.Leader.Version,
+	}, request.PreviousVersion)
+	if err != nil {
+		return convertCommonErrors(m.db, "UpdateShardDistributorLeader", "", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return convertCommonErrors(m.db, "UpdateShardDistributorLeader", "", err)
+	}
+	if rowsAffected != 1 {
+		return &persistence.ConditionFailedError{
+			Msg: fmt.Sprintf("leader of shard distributor namespace %v was updated concurrently, expected version %v", request.Namespace, request.PreviousVersion),
+		}
+	}
+	return nil
+}
+
+func (m *sqlShardDistributorStore) UpsertShardDistributorExecutor(
+	ctx context.Context,
+	request *persistence.UpsertShardDistributorExecutorRequest,
+) error {
+	return m.updateExecutors(ctx, "UpsertShardDistributorExecutor", request.Namespace, request.PreviousRevision, 0,
+		func(executors *shardDistributorExecutorRows) {
+			row := executors.upsert(request.Executor.ExecutorID)
+			row.LastHeartbeat = request.Executor.LastHeartbeat
+			row.State = request.Executor.State
+			row.ReportedShards, row.ReportedShardsEncoding = fromShardDistributorBlob(request.Executor.ReportedShards)
+		},
+	)
+}
+
+func (m *sqlShardDistributorStore) AssignShardDistributorShards(
+	ctx context.Context,
+	request *persistence.AssignShardDistributorShardsRequest,
+) error {
+	return m.updateExecutors(ctx, "AssignShardDistributorShards", request.Namespace, request.PreviousRevision, request.LeaderVersion,
+		func(executors *shardDistributorExecutorRows) {
+			for executorID, assigned := range request.AssignedShards {
+				row := executors.upsert(executorID)
+				row.AssignedShards, row.AssignedShardsEncoding = fromShardDistributorBlob(assigned)
+				row.AssignedRevision = request.PreviousRevision + 1
+			}
+		},
+	)
+}
+
+func (m *sqlShardDistributorStore) DeleteShardDistributorExecutors(
+	ctx context.Context,
+	request *persistence.DeleteShardDistributorExecutorsRequest,
+) error {
+	return m.updateExecutors(ctx, "DeleteShardDistributorExecutors", request.Namespace, request.PreviousRevision, request.LeaderVersion,
+		func(executors *shardDistributorExecutorRows) {
+			for _, executorID := range request.ExecutorIDs {
+				executors.delete(executorID)
+			}
+		},
+	)
+}
+
+// updateExecutors increments the revision of the namespace and applies the changes to its executors in a transaction,
+// the update of the revision fails and locks the namespace row unless the revision and leader version match
+func (m *sqlShardDistributorStore) updateExecutors(
+	ctx context.Context,
+	operation string,
+	namespace string,
+	previousRevision int64,
+	leaderVersion int64,
+	apply func(executors *shardDistributorExecutorRows),
+) error {
+	if previousRevision == 0 {
+		if err := m.createNamespace(ctx, namespace); err != nil {
+			return err
+		}
+	}
+	return m.txExecute(ctx, sqlplugin.DbDefaultShard, operation, func(tx sqlplugin.Tx) error {
+		result, err := tx.UpdateShardDistributorNamespacesRevision(ctx, namespace, previousRevision, leaderVersion)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected != 1 {
+			return &persistence.ConditionFailedError{
+				Msg: fmt.Sprintf("executors of shard distributor namespace %v were updated concurrently, expected revision %v and leader version %v",
+					namespace, previousRevision, leaderVersion),
+			}
+		}
+
+		rows, err := tx.SelectFromShardDistributorExecutors(ctx, namespace)
+		if err != nil {
+			return err
+		}
+		executors := newShardDistributorExecutorRows(namespace, rows)
+		apply(executors)
+		return executors.write(ctx, tx)
+	})
+}
+
+// createNamespace creates the namespace row on its first write, the row may already exist
+func (m *sqlShardDistributorStore) createNamespace(ctx context.Context, namespace string) error {
+	_, err := m.db.InsertIntoShardDistributorNamespaces(ctx, &sqlplugin.ShardDistributorNamespacesRow{Namespace: namespace})
+	if err != nil && !m.db.IsDupEntryError(err) {
+		return convertCommonErrors(m.db, "CreateShardDistributorNamespace", "", err)
+	}
+	return nil
+}
+
+// shardDistributorExecutorRows tracks the changes to the executor rows of a namespace
+type shardDistributorExecutorRows struct {
+	namespace string
+	rows      map[string]*sqlplugin.ShardDistributorExecutorsRow
+	existing  map[string]bool
+	changed   map[string]bool
+}
+
+func newShardDistributorExecutorRows(namespace string, rows []sqlplugin.ShardDistributorExecutorsRow) *shardDistributorExecutorRows {
+	e := &shardDistributorExecutorRows{
+		namespace: namespace,
+		rows:      make(map[string]*sqlplugin.ShardDistributorExecutorsRow, len(rows)),
+		existing:  make(map[string]bool, len(rows)),
+		changed:   make(map[string]bool),
+	}
+	for i := range rows {
+		e.rows[rows[i].ExecutorID] = &rows[i]
+		e.existing[rows[i].ExecutorID] = true
+	}
+	return e
+}
+
+// upsert returns the row of an executor to be changed, the row is created if it doesn't exist
+func (e *shardDistributorExecutorRows) upsert(executorID string) *sqlplugin.ShardDistributorExecutorsRow {
+	row, ok := e.rows[executorID]
+	if !ok {
+		row = &sqlplugin.ShardDistributorExecutorsRow{Namespace: e.namespace, ExecutorID: executorID}
+		e.rows[executorID] = row
+	}
+	e.changed[executorID] = true
+	return row
+}
+
+func (e *shardDistributorExecutorRows) delete(executorID string) {
+	delete(e.rows, executorID)
+	e.changed[executorID] = true
+}
+
+// write applies the changes in the order of executor IDs
+func (e *shardDistributorExecutorRows) write(ctx context.Context, tx sqlplugin.Tx) error {
+	executorIDs := make([]string, 0, len(e.changed))
+	for executorID := range e.changed {
+		executorIDs = append(executorIDs, executorID)
+	}
+	sort.Strings(executorIDs)
+
+	for _, executorID := range executorIDs {
+		row, ok := e.rows[executorID]
+		var err error
+		switch {
+		case ok && e.existing[executorID]:
+			_, err = tx.UpdateShardDistributorExecutors(ctx, row)
+		case ok:
+			_, err = tx.InsertIntoShardDistributorExecutors(ctx, row)
+		case e.existing[executorID]:
+			_, err = tx.DeleteFromShardDistributorExecutors(ctx, e.namespace, executorID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toShardDistributorBlob(data []byte, encoding string) *persistence.DataBlob {
+	if len(data) == 0 {
+		return nil
+	}
+	return persistence.NewDataBlob(data, constants.EncodingType(encoding))
+}
+
+func fromShardDistributorBlob(blob *persistence.DataBlob) ([]byte, string) {
+	if blob == nil {
+		return nil, ""
+	}
+	return blob.Data, string(blob.Encoding)
+}
@@ -0,0 +1,208 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+func setUpMocksForSQLShardDistributorStore(t *testing.T) (persistence.ShardDistributorStore, *sqlplugin.MockDB, *sqlplugin.MockTx) {
+	ctrl := gomock.NewController(t)
+	mockDB := sqlplugin.NewMockDB(ctrl)
+	mockTx := sqlplugin.NewMockTx(ctrl)
+	store, err := NewSQLShardDistributorStore(mockDB, log.NewNoop(), nil)
+	require.NoError(t, err)
+	return store, mockDB, mockTx
+}
+
+func TestSQLShardDistributorStore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1000, 0)
+	blob := &persistence.DataBlob{Data: []byte("{}"), Encoding: constants.EncodingTypeJSON}
+	namespaceRow := &sqlplugin.ShardDistributorNamespacesRow{Namespace: "ns", LeaderID: "leader", LeaseExpiry: now, LeaderVersion: 3, Revision: 5}
+	executorRow := sqlplugin.ShardDistributorExecutorsRow{
+		Namespace:              "ns",
+		ExecutorID:             "executor-1",
+		LastHeartbeat:          now,
+		State:                  "ACTIVE",
+		ReportedShards:         []byte("{}"),
+		ReportedShardsEncoding: "json",
+		AssignedRevision:       4,
+	}
+	dupErr := errors.New("duplicate entry")
+
+	t.Run("get state", func(t *testing.T) {
+		store, mockDB, _ := setUpMocksForSQLShardDistributorStore(t)
+		gomock.InOrder(
+			mockDB.EXPECT().SelectFromShardDistributorNamespaces(ctx, "ns").Return(namespaceRow, nil),
+			mockDB.EXPECT().SelectFromShardDistributorExecutors(ctx, "ns").Return([]sqlplugin.ShardDistributorExecutorsRow{executorRow}, nil),
+		)
+		resp, err := store.GetShardDistributorState(ctx, &persistence.GetShardDistributorStateRequest{Namespace: "ns"})
+		assert.NoError(t, err)
+		assert.Equal(t, &persistence.GetShardDistributorStateResponse{
+			Leader: &persistence.ShardDistributorLeader{LeaderID: "leader", LeaseExpiry: now, Version: 3},
+			Executors: map[string]*persistence.ShardDistributorExecutor{
+				"executor-1": {
+					ExecutorID:       "executor-1",
+					LastHeartbeat:    now,
+					State:            "ACTIVE",
+					ReportedShards:   blob,
+					AssignedRevision: 4,
+				},
+			},
+			Revision: 5,
+		}, resp)
+	})
+
+	t.Run("get state of new namespace", func(t *testing.T) {
+		store, mockDB, _ := setUpMocksForSQLShardDistributorStore(t)
+		mockDB.EXPECT().SelectFromShardDistributorNamespaces(ctx, "ns").Return(nil, sql.ErrNoRows)
+		resp, err := store.GetShardDistributorState(ctx, &persistence.GetShardDistributorStateRequest{Namespace: "ns"})
+		assert.NoError(t, err)
+		assert.Nil(t, resp.Leader)
+		assert.Empty(t, resp.Executors)
+		assert.Equal(t, int64(0), resp.Revision)
+	})
+
+	t.Run("acquire first leadership", func(t *testing.T) {
+		store, mockDB, _ := setUpMocksForSQLShardDistributorStore(t)
+		leader := &persistence.ShardDistributorLeader{LeaderID: "leader", LeaseExpiry: now, Version: 1}
+		gomock.InOrder(
+			mockDB.EXPECT().InsertIntoShardDistributorNamespaces(ctx, &sqlplugin.ShardDistributorNamespacesRow{Namespace: "ns"}).Return(nil, dupErr),
+			mockDB.EXPECT().IsDupEntryError(dupErr).Return(true),
+			mockDB.EXPECT().UpdateShardDistributorNamespacesLeader(ctx, &sqlplugin.ShardDistributorNamespacesRow{
+				Namespace:     "ns",
+				LeaderID:      "leader",
+				LeaseExpiry:   now,
+				LeaderVersion: 1,
+			}, int64(0)).Return(&sqlResult{rowsAffected: 1}, nil),
+		)
+		assert.NoError(t, store.UpdateShardDistributorLeader(ctx, &persistence.UpdateShardDistributorLeaderRequest{Namespace: "ns", Leader: leader}))
+	})
+
+	t.Run("update leader conflict", func(t *testing.T) {
+		store, mockDB, _ := setUpMocksForSQLShardDistributorStore(t)
+		mockDB.EXPECT().UpdateShardDistributorNamespacesLeader(ctx, gomock.Any(), int64(2)).Return(&sqlResult{rowsAffected: 0}, nil)
+		err := store.UpdateShardDistributorLeader(ctx, &persistence.UpdateShardDistributorLeaderRequest{
+			Namespace:       "ns",
+			Leader:          &persistence.ShardDistributorLeader{LeaderID: "leader", Version: 3},
+			PreviousVersion: 2,
+		})
+		assert.IsType(t, &persistence.ConditionFailedError{}, err)
+	})
+
+	t.Run("upsert new executor", func(t *testing.T) {
+		store, mockDB, mockTx := setUpMocksForSQLShardDistributorStore(t)
+		gomock.InOrder(
+			mockDB.EXPECT().BeginTx(ctx, sqlplugin.DbDefaultShard).Return(mockTx, nil),
+			mockTx.EXPECT().UpdateShardDistributorNamespacesRevision(ctx, "ns", int64(5), int64(0)).Return(&sqlResult{rowsAffected: 1}, nil),
+			mockTx.EXPECT().SelectFromShardDistributorExecutors(ctx, "ns").Return(nil, nil),
+			mockTx.EXPECT().InsertIntoShardDistributorExecutors(ctx, &sqlplugin.ShardDistributorExecutorsRow{
+				Namespace:              "ns",
+				ExecutorID:             "executor-1",
+				LastHeartbeat:          now,
+				State:                  "ACTIVE",
+				ReportedShards:         []byte("{}"),
+				ReportedShardsEncoding: "json",
+			}).Return(&sqlResult{rowsAffected: 1}, nil),
+			mockTx.EXPECT().Commit().Return(nil),
+		)
+		assert.NoError(t, store.UpsertShardDistributorExecutor(ctx, &persistence.UpsertShardDistributorExecutorRequest{
+			Namespace: "ns",
+			Executor: &persistence.ShardDistributorExecutor{
+				ExecutorID:     "executor-1",
+				LastHeartbeat:  now,
+				State:          "ACTIVE",
+				ReportedShards: blob,
+			},
+			PreviousRevision: 5,
+		}))
+	})
+
+	t.Run("assign shards", func(t *testing.T) {
+		store, mockDB, mockTx := setUpMocksForSQLShardDistributorStore(t)
+		assigned := executorRow
+		assigned.AssignedShards = []byte("{}")
+		assigned.AssignedShardsEncoding = "json"
+		assigned.AssignedRevision = 6
+		gomock.InOrder(
+			mockDB.EXPECT().BeginTx(ctx, sqlplugin.DbDefaultShard).Return(mockTx, nil),
+			mockTx.EXPECT().UpdateShardDistributorNamespacesRevision(ctx, "ns", int64(5), int64(3)).Return(&sqlResult{rowsAffected: 1}, nil),
+			mockTx.EXPECT().SelectFromShardDistributorExecutors(ctx, "ns").Return([]sqlplugin.ShardDistributorExecutorsRow{executorRow}, nil),
+			mockTx.EXPECT().UpdateShardDistributorExecutors(ctx, &assigned).Return(&sqlResult{rowsAffected: 1}, nil),
+			mockTx.EXPECT().Commit().Return(nil),
+		)
+		assert.NoError(t, store.AssignShardDistributorShards(ctx, &persistence.AssignShardDistributorShardsRequest{
+			Namespace:        "ns",
+			AssignedShards:   map[string]*persistence.DataBlob{"executor-1": blob},
+			LeaderVersion:    3,
+			PreviousRevision: 5,
+		}))
+	})
+
+	t.Run("delete executors", func(t *testing.T) {
+		store, mockDB, mockTx := setUpMocksForSQLShardDistributorStore(t)
+		gomock.InOrder(
+			mockDB.EXPECT().BeginTx(ctx, sqlplugin.DbDefaultShard).Return(mockTx, nil),
+			mockTx.EXPECT().UpdateShardDistributorNamespacesRevision(ctx, "ns", int64(5), int64(3)).Return(&sqlResult{rowsAffected: 1}, nil),
+			mockTx.EXPECT().SelectFromShardDistributorExecutors(ctx, "ns").Return([]sqlplugin.ShardDistributorExecutorsRow{executorRow}, nil),
+			mockTx.EXPECT().DeleteFromShardDistributorExecutors(ctx, "ns", "executor-1").Return(&sqlResult{rowsAffected: 1}, nil),
+			mockTx.EXPECT().Commit().Return(nil),
+		)
+		assert.NoError(t, store.DeleteShardDistributorExecutors(ctx, &persistence.DeleteShardDistributorExecutorsRequest{
+			Namespace:        "ns",
+			ExecutorIDs:      []string{"executor-1", "executor-2"},
+			LeaderVersion:    3,
+			PreviousRevision: 5,
+		}))
+	})
+
+	t.Run("update executors conflict", func(t *testing.T) {
+		store, mockDB, mockTx := setUpMocksForSQLShardDistributorStore(t)
+		gomock.InOrder(
+			mockDB.EXPECT().BeginTx(ctx, sqlplugin.DbDefaultShard).Return(mockTx, nil),
+			mockTx.EXPECT().UpdateShardDistributorNamespacesRevision(ctx, "ns", int64(5), int64(3)).Return(&sqlResult{rowsAffected: 0}, nil),
+			mockTx.EXPECT().Rollback().Return(nil),
+		)
+		err := store.DeleteShardDistributorExecutors(ctx, &persistence.DeleteShardDistributorExecutorsRequest{
+			Namespace:        "ns",
+			ExecutorIDs:      []string{"executor-1"},
+			LeaderVersion:    3,
+			PreviousRevision: 5,
+		})
+		assert.IsType(t, &persistence.ConditionFailedError{}, err)
+	})
+}
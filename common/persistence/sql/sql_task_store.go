@@ -198,6 +198,8 @@ func (m *sqlTaskStore) LeaseTaskList(
 			Kind:                    request.TaskListKind,
 			LastUpdated:             now,
 			AdaptivePartitionConfig: fromSerializationTaskListPartitionConfig(tlInfo.AdaptivePartitionConfig),
+			VersioningConfig:        fromSerializationTaskListVersioningConfig(tlInfo.VersioningConfig),
+			MigrationConfig:         fromSerializationTaskListMigrationConfig(tlInfo.MigrationConfig),
 		}}
 		return nil
 	})
@@ -235,6 +237,8 @@ func (m *sqlTaskStore) GetTaskList(
 			Expiry:                  tlInfo.ExpiryTimestamp,
 			LastUpdated:             tlInfo.LastUpdated,
 			AdaptivePartitionConfig: fromSerializationTaskListPartitionConfig(tlInfo.AdaptivePartitionConfig),
+			VersioningConfig:        fromSerializationTaskListVersioningConfig(tlInfo.VersioningConfig),
+			MigrationConfig:         fromSerializationTaskListMigrationConfig(tlInfo.MigrationConfig),
 		},
 	}, nil
 }
@@ -251,6 +255,8 @@ func (m *sqlTaskStore) UpdateTaskList(
 		ExpiryTimestamp:         time.Unix(0, 0),
 		LastUpdated:             time.Now(),
 		AdaptivePartitionConfig: toSerializationTaskListPartitionConfig(request.TaskListInfo.AdaptivePartitionConfig),
+		VersioningConfig:        toSerializationTaskListVersioningConfig(request.TaskListInfo.VersioningConfig),
+		MigrationConfig:         toSerializationTaskListMigrationConfig(request.TaskListInfo.MigrationConfig),
 	}
 	if persistence.TaskListKindHasTTL(request.TaskListInfo.Kind) {
 		tlInfo.ExpiryTimestamp = time.Now().Add(taskListTTL)
@@ -674,6 +680,46 @@ func fromSerializationTaskListPartitionConfig(c *serialization.TaskListPartition
 	}
 }
 
+func toSerializationTaskListVersioningConfig(c *persistence.TaskListVersioningConfig) *serialization.TaskListVersioningConfig {
+	if c == nil {
+		return nil
+	}
+	return &serialization.TaskListVersioningConfig{
+		Version:        c.Version,
+		CompatibleSets: c.CompatibleSets,
+	}
+}
+
+func fromSerializationTaskListVersioningConfig(c *serialization.TaskListVersioningConfig) *persistence.TaskListVersioningConfig {
+	if c == nil {
+		return nil
+	}
+	return &persistence.TaskListVersioningConfig{
+		Version:        c.Version,
+		CompatibleSets: c.CompatibleSets,
+	}
+}
+
+func toSerializationTaskListMigrationConfig(c *persistence.TaskListMigrationConfig) *serialization.TaskListMigrationConfig {
+	if c == nil {
+		return nil
+	}
+	return &serialization.TaskListMigrationConfig{
+		TargetTaskList: c.TargetTaskList,
+		Alias:          c.Alias,
+	}
+}
+
+func fromSerializationTaskListMigrationConfig(c *serialization.TaskListMigrationConfig) *persistence.TaskListMigrationConfig {
+	if c == nil {
+		return nil
+	}
+	return &persistence.TaskListMigrationConfig{
+		TargetTaskList: c.TargetTaskList,
+		Alias:          c.Alias,
+	}
+}
+
 func createDefaultPartitions(len int32) map[int]*persistence.TaskListPartition {
 	partitions := make(map[int]*persistence.TaskListPartition, len)
 	for i := 0; i < int(len); i++ {
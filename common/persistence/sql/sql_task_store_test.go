@@ -671,6 +671,14 @@ func TestGetTaskList(t *testing.T) {
 							},
 						},
 					},
+					VersioningConfig: &serialization.TaskListVersioningConfig{
+						Version:        1,
+						CompatibleSets: [][]string{{"1.0"}},
+					},
+					MigrationConfig: &serialization.TaskListMigrationConfig{
+						TargetTaskList: "target",
+						Alias:          true,
+					},
 				}, nil)
 			},
 			want: &persistence.GetTaskListResponse{
@@ -696,6 +704,14 @@ func TestGetTaskList(t *testing.T) {
 							},
 						},
 					},
+					VersioningConfig: &persistence.TaskListVersioningConfig{
+						Version:        1,
+						CompatibleSets: [][]string{{"1.0"}},
+					},
+					MigrationConfig: &persistence.TaskListMigrationConfig{
+						TargetTaskList: "target",
+						Alias:          true,
+					},
 				},
 			},
 			wantErr: false,
@@ -135,14 +135,30 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionUninitialized(
 	return nil
 }
 
+// UpsertWorkflowExecution only refreshes the memo of the open visibility record,
+// search attributes are not supported by database visibility
 func (s *sqlVisibilityStore) UpsertWorkflowExecution(
-	_ context.Context,
+	ctx context.Context,
 	request *p.InternalUpsertWorkflowExecutionRequest,
 ) error {
 	if p.IsNopUpsertWorkflowRequest(request) {
 		return nil
 	}
-	return p.ErrVisibilityOperationNotSupported
+	if len(request.Memo.GetData()) == 0 {
+		return p.ErrVisibilityOperationNotSupported
+	}
+	_, err := s.db.UpdateVisibilityMemo(ctx, &sqlplugin.VisibilityRow{
+		DomainID:   request.DomainUUID,
+		WorkflowID: request.WorkflowID,
+		RunID:      request.RunID,
+		Memo:       request.Memo.GetData(),
+		Encoding:   request.Memo.GetEncodingString(),
+		UpdateTime: request.UpdateTimestamp,
+	})
+	if err != nil {
+		return convertCommonErrors(s.db, "UpsertWorkflowExecution", "", err)
+	}
+	return nil
 }
 
 func (s *sqlVisibilityStore) ListOpenWorkflowExecutions(
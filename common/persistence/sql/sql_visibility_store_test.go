@@ -0,0 +1,115 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestUpsertWorkflowExecution(t *testing.T) {
+	now := time.Now()
+	memo := &persistence.DataBlob{Encoding: constants.EncodingTypeThriftRW, Data: []byte("memo")}
+
+	testCases := []struct {
+		name      string
+		req       *persistence.InternalUpsertWorkflowExecutionRequest
+		mockSetup func(*sqlplugin.MockDB)
+		wantErr   error
+	}{
+		{
+			name: "nop request",
+			req: &persistence.InternalUpsertWorkflowExecutionRequest{
+				SearchAttributes: map[string][]byte{
+					definition.CadenceChangeVersion: nil,
+				},
+			},
+			mockSetup: func(mockDB *sqlplugin.MockDB) {},
+		},
+		{
+			name:      "empty memo",
+			req:       &persistence.InternalUpsertWorkflowExecutionRequest{},
+			mockSetup: func(mockDB *sqlplugin.MockDB) {},
+			wantErr:   persistence.ErrVisibilityOperationNotSupported,
+		},
+		{
+			name: "success",
+			req: &persistence.InternalUpsertWorkflowExecutionRequest{
+				DomainUUID:      "domain-id",
+				WorkflowID:      "workflow-id",
+				RunID:           "run-id",
+				Memo:            memo,
+				UpdateTimestamp: now,
+			},
+			mockSetup: func(mockDB *sqlplugin.MockDB) {
+				mockDB.EXPECT().UpdateVisibilityMemo(gomock.Any(), &sqlplugin.VisibilityRow{
+					DomainID:   "domain-id",
+					WorkflowID: "workflow-id",
+					RunID:      "run-id",
+					Memo:       []byte("memo"),
+					Encoding:   string(constants.EncodingTypeThriftRW),
+					UpdateTime: now,
+				}).Return(nil, nil)
+			},
+		},
+		{
+			name: "database error",
+			req: &persistence.InternalUpsertWorkflowExecutionRequest{
+				DomainUUID:      "domain-id",
+				WorkflowID:      "workflow-id",
+				RunID:           "run-id",
+				Memo:            memo,
+				UpdateTimestamp: now,
+			},
+			mockSetup: func(mockDB *sqlplugin.MockDB) {
+				err := errors.New("db error")
+				mockDB.EXPECT().UpdateVisibilityMemo(gomock.Any(), gomock.Any()).Return(nil, err)
+				mockDB.EXPECT().IsNotFoundError(err).Return(true)
+			},
+			wantErr: &types.EntityNotExistsError{Message: "UpsertWorkflowExecution failed.  Error: db error"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDB := sqlplugin.NewMockDB(ctrl)
+			store := &sqlVisibilityStore{sqlStore: sqlStore{db: mockDB}}
+
+			tc.mockSetup(mockDB)
+			err := store.UpsertWorkflowExecution(context.Background(), tc.req)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
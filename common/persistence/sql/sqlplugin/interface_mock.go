@@ -114,6 +114,21 @@ func (mr *MocktableCRUDMockRecorder) DeleteFromActivityInfoMaps(ctx, filter any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromActivityInfoMaps", reflect.TypeOf((*MocktableCRUD)(nil).DeleteFromActivityInfoMaps), ctx, filter)
 }
 
+// DeleteFromAsyncRequestDLQ mocks base method.
+func (m *MocktableCRUD) DeleteFromAsyncRequestDLQ(ctx context.Context, domainID string, requestID string) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromAsyncRequestDLQ", ctx, domainID, requestID)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromAsyncRequestDLQ indicates an expected call of DeleteFromAsyncRequestDLQ.
+func (mr *MocktableCRUDMockRecorder) DeleteFromAsyncRequestDLQ(ctx, domainID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromAsyncRequestDLQ", reflect.TypeOf((*MocktableCRUD)(nil).DeleteFromAsyncRequestDLQ), ctx, domainID, requestID)
+}
+
 // DeleteFromBufferedEvents mocks base method.
 func (m *MocktableCRUD) DeleteFromBufferedEvents(ctx context.Context, filter *BufferedEventsFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -264,6 +279,21 @@ func (mr *MocktableCRUDMockRecorder) DeleteFromRequestCancelInfoMaps(ctx, filter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromRequestCancelInfoMaps", reflect.TypeOf((*MocktableCRUD)(nil).DeleteFromRequestCancelInfoMaps), ctx, filter)
 }
 
+// DeleteFromShardDistributorExecutors mocks base method.
+func (m *MocktableCRUD) DeleteFromShardDistributorExecutors(ctx context.Context, namespace string, executorID string) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromShardDistributorExecutors", ctx, namespace, executorID)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromShardDistributorExecutors indicates an expected call of DeleteFromShardDistributorExecutors.
+func (mr *MocktableCRUDMockRecorder) DeleteFromShardDistributorExecutors(ctx, namespace, executorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromShardDistributorExecutors", reflect.TypeOf((*MocktableCRUD)(nil).DeleteFromShardDistributorExecutors), ctx, namespace, executorID)
+}
+
 // DeleteFromSignalInfoMaps mocks base method.
 func (m *MocktableCRUD) DeleteFromSignalInfoMaps(ctx context.Context, filter *SignalInfoMapsFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -577,6 +607,36 @@ func (mr *MocktableCRUDMockRecorder) InsertConfig(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertConfig", reflect.TypeOf((*MocktableCRUD)(nil).InsertConfig), ctx, row)
 }
 
+// InsertIntoAsyncRequestQueue mocks base method.
+func (m *MocktableCRUD) InsertIntoAsyncRequestQueue(ctx context.Context, queueName string, row *persistence.AsyncRequestQueueMessage) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoAsyncRequestQueue", ctx, queueName, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoAsyncRequestQueue indicates an expected call of InsertIntoAsyncRequestQueue.
+func (mr *MocktableCRUDMockRecorder) InsertIntoAsyncRequestQueue(ctx, queueName, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoAsyncRequestQueue", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoAsyncRequestQueue), ctx, queueName, row)
+}
+
+// InsertIntoAsyncRequestQueueMetadata mocks base method.
+func (m *MocktableCRUD) InsertIntoAsyncRequestQueueMetadata(ctx context.Context, row *persistence.AsyncRequestQueueMetadata) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoAsyncRequestQueueMetadata", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoAsyncRequestQueueMetadata indicates an expected call of InsertIntoAsyncRequestQueueMetadata.
+func (mr *MocktableCRUDMockRecorder) InsertIntoAsyncRequestQueueMetadata(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoAsyncRequestQueueMetadata", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoAsyncRequestQueueMetadata), ctx, row)
+}
+
 // InsertIntoBufferedEvents mocks base method.
 func (m *MocktableCRUD) InsertIntoBufferedEvents(ctx context.Context, rows []BufferedEventsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -682,6 +742,21 @@ func (mr *MocktableCRUDMockRecorder) InsertIntoHistoryTree(ctx, row any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoHistoryTree", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoHistoryTree), ctx, row)
 }
 
+// InsertIntoMapQState mocks base method.
+func (m *MocktableCRUD) InsertIntoMapQState(ctx context.Context, row *MapQStateRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoMapQState", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoMapQState indicates an expected call of InsertIntoMapQState.
+func (mr *MocktableCRUDMockRecorder) InsertIntoMapQState(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoMapQState", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoMapQState), ctx, row)
+}
+
 // InsertIntoQueue mocks base method.
 func (m *MocktableCRUD) InsertIntoQueue(ctx context.Context, row *QueueRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -727,6 +802,36 @@ func (mr *MocktableCRUDMockRecorder) InsertIntoReplicationTasksDLQ(ctx, row any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoReplicationTasksDLQ", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoReplicationTasksDLQ), ctx, row)
 }
 
+// InsertIntoShardDistributorExecutors mocks base method.
+func (m *MocktableCRUD) InsertIntoShardDistributorExecutors(ctx context.Context, row *ShardDistributorExecutorsRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoShardDistributorExecutors", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoShardDistributorExecutors indicates an expected call of InsertIntoShardDistributorExecutors.
+func (mr *MocktableCRUDMockRecorder) InsertIntoShardDistributorExecutors(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoShardDistributorExecutors", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoShardDistributorExecutors), ctx, row)
+}
+
+// InsertIntoShardDistributorNamespaces mocks base method.
+func (m *MocktableCRUD) InsertIntoShardDistributorNamespaces(ctx context.Context, row *ShardDistributorNamespacesRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoShardDistributorNamespaces", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoShardDistributorNamespaces indicates an expected call of InsertIntoShardDistributorNamespaces.
+func (mr *MocktableCRUDMockRecorder) InsertIntoShardDistributorNamespaces(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoShardDistributorNamespaces", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoShardDistributorNamespaces), ctx, row)
+}
+
 // InsertIntoShards mocks base method.
 func (m *MocktableCRUD) InsertIntoShards(ctx context.Context, rows *ShardsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -936,6 +1041,21 @@ func (mr *MocktableCRUDMockRecorder) MaxAllowedTTL() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaxAllowedTTL", reflect.TypeOf((*MocktableCRUD)(nil).MaxAllowedTTL))
 }
 
+// RangeDeleteFromAsyncRequestQueue mocks base method.
+func (m *MocktableCRUD) RangeDeleteFromAsyncRequestQueue(ctx context.Context, queueName string, inclusiveEndMessageID int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeDeleteFromAsyncRequestQueue", ctx, queueName, inclusiveEndMessageID)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeDeleteFromAsyncRequestQueue indicates an expected call of RangeDeleteFromAsyncRequestQueue.
+func (mr *MocktableCRUDMockRecorder) RangeDeleteFromAsyncRequestQueue(ctx, queueName, inclusiveEndMessageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromAsyncRequestQueue", reflect.TypeOf((*MocktableCRUD)(nil).RangeDeleteFromAsyncRequestQueue), ctx, queueName, inclusiveEndMessageID)
+}
+
 // RangeDeleteFromCrossClusterTasks mocks base method.
 func (m *MocktableCRUD) RangeDeleteFromCrossClusterTasks(ctx context.Context, filter *CrossClusterTasksFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -951,6 +1071,21 @@ func (mr *MocktableCRUDMockRecorder) RangeDeleteFromCrossClusterTasks(ctx, filte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromCrossClusterTasks", reflect.TypeOf((*MocktableCRUD)(nil).RangeDeleteFromCrossClusterTasks), ctx, filter)
 }
 
+// RangeDeleteFromMapQItems mocks base method.
+func (m *MocktableCRUD) RangeDeleteFromMapQItems(ctx context.Context, queueID string, partitionPath string, inclusiveMaxOffset int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeDeleteFromMapQItems", ctx, queueID, partitionPath, inclusiveMaxOffset)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeDeleteFromMapQItems indicates an expected call of RangeDeleteFromMapQItems.
+func (mr *MocktableCRUDMockRecorder) RangeDeleteFromMapQItems(ctx, queueID, partitionPath, inclusiveMaxOffset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromMapQItems", reflect.TypeOf((*MocktableCRUD)(nil).RangeDeleteFromMapQItems), ctx, queueID, partitionPath, inclusiveMaxOffset)
+}
+
 // RangeDeleteFromReplicationTasks mocks base method.
 func (m *MocktableCRUD) RangeDeleteFromReplicationTasks(ctx context.Context, filter *ReplicationTasksFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -1026,6 +1161,51 @@ func (mr *MocktableCRUDMockRecorder) RangeDeleteMessages(ctx, queueType, exclusi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteMessages", reflect.TypeOf((*MocktableCRUD)(nil).RangeDeleteMessages), ctx, queueType, exclusiveBeginMessageID, inclusiveEndMessageID)
 }
 
+// RangeSelectFromAsyncRequestDLQ mocks base method.
+func (m *MocktableCRUD) RangeSelectFromAsyncRequestDLQ(ctx context.Context, domainID string, exclusiveMinRequestID string, pageSize int) ([]*persistence.AsyncRequestDLQEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeSelectFromAsyncRequestDLQ", ctx, domainID, exclusiveMinRequestID, pageSize)
+	ret0, _ := ret[0].([]*persistence.AsyncRequestDLQEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeSelectFromAsyncRequestDLQ indicates an expected call of RangeSelectFromAsyncRequestDLQ.
+func (mr *MocktableCRUDMockRecorder) RangeSelectFromAsyncRequestDLQ(ctx, domainID, exclusiveMinRequestID, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeSelectFromAsyncRequestDLQ", reflect.TypeOf((*MocktableCRUD)(nil).RangeSelectFromAsyncRequestDLQ), ctx, domainID, exclusiveMinRequestID, pageSize)
+}
+
+// RangeSelectFromAsyncRequestQueue mocks base method.
+func (m *MocktableCRUD) RangeSelectFromAsyncRequestQueue(ctx context.Context, queueName string, exclusiveBeginMessageID int64, pageSize int) ([]*persistence.AsyncRequestQueueMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeSelectFromAsyncRequestQueue", ctx, queueName, exclusiveBeginMessageID, pageSize)
+	ret0, _ := ret[0].([]*persistence.AsyncRequestQueueMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeSelectFromAsyncRequestQueue indicates an expected call of RangeSelectFromAsyncRequestQueue.
+func (mr *MocktableCRUDMockRecorder) RangeSelectFromAsyncRequestQueue(ctx, queueName, exclusiveBeginMessageID, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeSelectFromAsyncRequestQueue", reflect.TypeOf((*MocktableCRUD)(nil).RangeSelectFromAsyncRequestQueue), ctx, queueName, exclusiveBeginMessageID, pageSize)
+}
+
+// RangeSelectFromMapQItems mocks base method.
+func (m *MocktableCRUD) RangeSelectFromMapQItems(ctx context.Context, queueID string, partitionPath string, exclusiveMinOffset int64, inclusiveMaxOffset int64, pageSize int) ([]MapQItemsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeSelectFromMapQItems", ctx, queueID, partitionPath, exclusiveMinOffset, inclusiveMaxOffset, pageSize)
+	ret0, _ := ret[0].([]MapQItemsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeSelectFromMapQItems indicates an expected call of RangeSelectFromMapQItems.
+func (mr *MocktableCRUDMockRecorder) RangeSelectFromMapQItems(ctx, queueID, partitionPath, exclusiveMinOffset, inclusiveMaxOffset, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeSelectFromMapQItems", reflect.TypeOf((*MocktableCRUD)(nil).RangeSelectFromMapQItems), ctx, queueID, partitionPath, exclusiveMinOffset, inclusiveMaxOffset, pageSize)
+}
+
 // ReadLockExecutions mocks base method.
 func (m *MocktableCRUD) ReadLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error) {
 	m.ctrl.T.Helper()
@@ -1071,6 +1251,34 @@ func (mr *MocktableCRUDMockRecorder) ReplaceIntoActivityInfoMaps(ctx, rows any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoActivityInfoMaps", reflect.TypeOf((*MocktableCRUD)(nil).ReplaceIntoActivityInfoMaps), ctx, rows)
 }
 
+// ReplaceIntoAsyncRequestDLQ mocks base method.
+func (m *MocktableCRUD) ReplaceIntoAsyncRequestDLQ(ctx context.Context, row *persistence.AsyncRequestDLQEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIntoAsyncRequestDLQ", ctx, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceIntoAsyncRequestDLQ indicates an expected call of ReplaceIntoAsyncRequestDLQ.
+func (mr *MocktableCRUDMockRecorder) ReplaceIntoAsyncRequestDLQ(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoAsyncRequestDLQ", reflect.TypeOf((*MocktableCRUD)(nil).ReplaceIntoAsyncRequestDLQ), ctx, row)
+}
+
+// ReplaceIntoAsyncRequests mocks base method.
+func (m *MocktableCRUD) ReplaceIntoAsyncRequests(ctx context.Context, row *persistence.AsyncRequestInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIntoAsyncRequests", ctx, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceIntoAsyncRequests indicates an expected call of ReplaceIntoAsyncRequests.
+func (mr *MocktableCRUDMockRecorder) ReplaceIntoAsyncRequests(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoAsyncRequests", reflect.TypeOf((*MocktableCRUD)(nil).ReplaceIntoAsyncRequests), ctx, row)
+}
+
 // ReplaceIntoChildExecutionInfoMaps mocks base method.
 func (m *MocktableCRUD) ReplaceIntoChildExecutionInfoMaps(ctx context.Context, rows []ChildExecutionInfoMapsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -1086,6 +1294,21 @@ func (mr *MocktableCRUDMockRecorder) ReplaceIntoChildExecutionInfoMaps(ctx, rows
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoChildExecutionInfoMaps", reflect.TypeOf((*MocktableCRUD)(nil).ReplaceIntoChildExecutionInfoMaps), ctx, rows)
 }
 
+// ReplaceIntoMapQItems mocks base method.
+func (m *MocktableCRUD) ReplaceIntoMapQItems(ctx context.Context, rows []MapQItemsRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIntoMapQItems", ctx, rows)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceIntoMapQItems indicates an expected call of ReplaceIntoMapQItems.
+func (mr *MocktableCRUDMockRecorder) ReplaceIntoMapQItems(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoMapQItems", reflect.TypeOf((*MocktableCRUD)(nil).ReplaceIntoMapQItems), ctx, rows)
+}
+
 // ReplaceIntoRequestCancelInfoMaps mocks base method.
 func (m *MocktableCRUD) ReplaceIntoRequestCancelInfoMaps(ctx context.Context, rows []RequestCancelInfoMapsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -1161,6 +1384,51 @@ func (mr *MocktableCRUDMockRecorder) SelectFromActivityInfoMaps(ctx, filter any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromActivityInfoMaps", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromActivityInfoMaps), ctx, filter)
 }
 
+// SelectFromAsyncRequestDLQ mocks base method.
+func (m *MocktableCRUD) SelectFromAsyncRequestDLQ(ctx context.Context, domainID string, requestID string) (*persistence.AsyncRequestDLQEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromAsyncRequestDLQ", ctx, domainID, requestID)
+	ret0, _ := ret[0].(*persistence.AsyncRequestDLQEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromAsyncRequestDLQ indicates an expected call of SelectFromAsyncRequestDLQ.
+func (mr *MocktableCRUDMockRecorder) SelectFromAsyncRequestDLQ(ctx, domainID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromAsyncRequestDLQ", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromAsyncRequestDLQ), ctx, domainID, requestID)
+}
+
+// SelectFromAsyncRequestQueueMetadata mocks base method.
+func (m *MocktableCRUD) SelectFromAsyncRequestQueueMetadata(ctx context.Context, queueName string) (*persistence.AsyncRequestQueueMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromAsyncRequestQueueMetadata", ctx, queueName)
+	ret0, _ := ret[0].(*persistence.AsyncRequestQueueMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromAsyncRequestQueueMetadata indicates an expected call of SelectFromAsyncRequestQueueMetadata.
+func (mr *MocktableCRUDMockRecorder) SelectFromAsyncRequestQueueMetadata(ctx, queueName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromAsyncRequestQueueMetadata", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromAsyncRequestQueueMetadata), ctx, queueName)
+}
+
+// SelectFromAsyncRequests mocks base method.
+func (m *MocktableCRUD) SelectFromAsyncRequests(ctx context.Context, domainID string, requestID string) (*persistence.AsyncRequestInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromAsyncRequests", ctx, domainID, requestID)
+	ret0, _ := ret[0].(*persistence.AsyncRequestInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromAsyncRequests indicates an expected call of SelectFromAsyncRequests.
+func (mr *MocktableCRUDMockRecorder) SelectFromAsyncRequests(ctx, domainID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromAsyncRequests", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromAsyncRequests), ctx, domainID, requestID)
+}
+
 // SelectFromBufferedEvents mocks base method.
 func (m *MocktableCRUD) SelectFromBufferedEvents(ctx context.Context, filter *BufferedEventsFilter) ([]BufferedEventsRow, error) {
 	m.ctrl.T.Helper()
@@ -1296,6 +1564,21 @@ func (mr *MocktableCRUDMockRecorder) SelectFromHistoryTree(ctx, filter any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHistoryTree", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromHistoryTree), ctx, filter)
 }
 
+// SelectFromMapQState mocks base method.
+func (m *MocktableCRUD) SelectFromMapQState(ctx context.Context, queueID string) (*MapQStateRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromMapQState", ctx, queueID)
+	ret0, _ := ret[0].(*MapQStateRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromMapQState indicates an expected call of SelectFromMapQState.
+func (mr *MocktableCRUDMockRecorder) SelectFromMapQState(ctx, queueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromMapQState", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromMapQState), ctx, queueID)
+}
+
 // SelectFromReplicationDLQ mocks base method.
 func (m *MocktableCRUD) SelectFromReplicationDLQ(ctx context.Context, filter *ReplicationTaskDLQFilter) (int64, error) {
 	m.ctrl.T.Helper()
@@ -1356,6 +1639,36 @@ func (mr *MocktableCRUDMockRecorder) SelectFromRequestCancelInfoMaps(ctx, filter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromRequestCancelInfoMaps", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromRequestCancelInfoMaps), ctx, filter)
 }
 
+// SelectFromShardDistributorExecutors mocks base method.
+func (m *MocktableCRUD) SelectFromShardDistributorExecutors(ctx context.Context, namespace string) ([]ShardDistributorExecutorsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromShardDistributorExecutors", ctx, namespace)
+	ret0, _ := ret[0].([]ShardDistributorExecutorsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromShardDistributorExecutors indicates an expected call of SelectFromShardDistributorExecutors.
+func (mr *MocktableCRUDMockRecorder) SelectFromShardDistributorExecutors(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromShardDistributorExecutors", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromShardDistributorExecutors), ctx, namespace)
+}
+
+// SelectFromShardDistributorNamespaces mocks base method.
+func (m *MocktableCRUD) SelectFromShardDistributorNamespaces(ctx context.Context, namespace string) (*ShardDistributorNamespacesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromShardDistributorNamespaces", ctx, namespace)
+	ret0, _ := ret[0].(*ShardDistributorNamespacesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromShardDistributorNamespaces indicates an expected call of SelectFromShardDistributorNamespaces.
+func (mr *MocktableCRUDMockRecorder) SelectFromShardDistributorNamespaces(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromShardDistributorNamespaces", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromShardDistributorNamespaces), ctx, namespace)
+}
+
 // SelectFromShards mocks base method.
 func (m *MocktableCRUD) SelectFromShards(ctx context.Context, filter *ShardsFilter) (*ShardsRow, error) {
 	m.ctrl.T.Helper()
@@ -1491,6 +1804,21 @@ func (mr *MocktableCRUDMockRecorder) SelectFromVisibility(ctx, filter any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibility", reflect.TypeOf((*MocktableCRUD)(nil).SelectFromVisibility), ctx, filter)
 }
 
+// SelectLastAsyncRequestQueueMessageID mocks base method.
+func (m *MocktableCRUD) SelectLastAsyncRequestQueueMessageID(ctx context.Context, queueName string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectLastAsyncRequestQueueMessageID", ctx, queueName)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectLastAsyncRequestQueueMessageID indicates an expected call of SelectLastAsyncRequestQueueMessageID.
+func (mr *MocktableCRUDMockRecorder) SelectLastAsyncRequestQueueMessageID(ctx, queueName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectLastAsyncRequestQueueMessageID", reflect.TypeOf((*MocktableCRUD)(nil).SelectLastAsyncRequestQueueMessageID), ctx, queueName)
+}
+
 // SelectLatestConfig mocks base method.
 func (m *MocktableCRUD) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
 	m.ctrl.T.Helper()
@@ -1548,6 +1876,21 @@ func (mr *MocktableCRUDMockRecorder) UpdateAckLevels(ctx, queueType, clusterAckL
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAckLevels", reflect.TypeOf((*MocktableCRUD)(nil).UpdateAckLevels), ctx, queueType, clusterAckLevels)
 }
 
+// UpdateAsyncRequestQueueMetadata mocks base method.
+func (m *MocktableCRUD) UpdateAsyncRequestQueueMetadata(ctx context.Context, row *persistence.AsyncRequestQueueMetadata, previousVersion int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAsyncRequestQueueMetadata", ctx, row, previousVersion)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAsyncRequestQueueMetadata indicates an expected call of UpdateAsyncRequestQueueMetadata.
+func (mr *MocktableCRUDMockRecorder) UpdateAsyncRequestQueueMetadata(ctx, row, previousVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAsyncRequestQueueMetadata", reflect.TypeOf((*MocktableCRUD)(nil).UpdateAsyncRequestQueueMetadata), ctx, row, previousVersion)
+}
+
 // UpdateCurrentExecutions mocks base method.
 func (m *MocktableCRUD) UpdateCurrentExecutions(ctx context.Context, row *CurrentExecutionsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -1608,6 +1951,66 @@ func (mr *MocktableCRUDMockRecorder) UpdateExecutions(ctx, row any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExecutions", reflect.TypeOf((*MocktableCRUD)(nil).UpdateExecutions), ctx, row)
 }
 
+// UpdateMapQState mocks base method.
+func (m *MocktableCRUD) UpdateMapQState(ctx context.Context, row *MapQStateRow, previousVersion int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMapQState", ctx, row, previousVersion)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMapQState indicates an expected call of UpdateMapQState.
+func (mr *MocktableCRUDMockRecorder) UpdateMapQState(ctx, row, previousVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMapQState", reflect.TypeOf((*MocktableCRUD)(nil).UpdateMapQState), ctx, row, previousVersion)
+}
+
+// UpdateShardDistributorExecutors mocks base method.
+func (m *MocktableCRUD) UpdateShardDistributorExecutors(ctx context.Context, row *ShardDistributorExecutorsRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorExecutors", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShardDistributorExecutors indicates an expected call of UpdateShardDistributorExecutors.
+func (mr *MocktableCRUDMockRecorder) UpdateShardDistributorExecutors(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorExecutors", reflect.TypeOf((*MocktableCRUD)(nil).UpdateShardDistributorExecutors), ctx, row)
+}
+
+// UpdateShardDistributorNamespacesLeader mocks base method.
+func (m *MocktableCRUD) UpdateShardDistributorNamespacesLeader(ctx context.Context, row *ShardDistributorNamespacesRow, previousVersion int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorNamespacesLeader", ctx, row, previousVersion)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShardDistributorNamespacesLeader indicates an expected call of UpdateShardDistributorNamespacesLeader.
+func (mr *MocktableCRUDMockRecorder) UpdateShardDistributorNamespacesLeader(ctx, row, previousVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorNamespacesLeader", reflect.TypeOf((*MocktableCRUD)(nil).UpdateShardDistributorNamespacesLeader), ctx, row, previousVersion)
+}
+
+// UpdateShardDistributorNamespacesRevision mocks base method.
+func (m *MocktableCRUD) UpdateShardDistributorNamespacesRevision(ctx context.Context, namespace string, previousRevision int64, leaderVersion int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorNamespacesRevision", ctx, namespace, previousRevision, leaderVersion)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShardDistributorNamespacesRevision indicates an expected call of UpdateShardDistributorNamespacesRevision.
+func (mr *MocktableCRUDMockRecorder) UpdateShardDistributorNamespacesRevision(ctx, namespace, previousRevision, leaderVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorNamespacesRevision", reflect.TypeOf((*MocktableCRUD)(nil).UpdateShardDistributorNamespacesRevision), ctx, namespace, previousRevision, leaderVersion)
+}
+
 // UpdateShards mocks base method.
 func (m *MocktableCRUD) UpdateShards(ctx context.Context, row *ShardsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -1653,6 +2056,21 @@ func (mr *MocktableCRUDMockRecorder) UpdateTaskListsWithTTL(ctx, row any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListsWithTTL", reflect.TypeOf((*MocktableCRUD)(nil).UpdateTaskListsWithTTL), ctx, row)
 }
 
+// UpdateVisibilityMemo mocks base method.
+func (m *MocktableCRUD) UpdateVisibilityMemo(ctx context.Context, row *VisibilityRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVisibilityMemo", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVisibilityMemo indicates an expected call of UpdateVisibilityMemo.
+func (mr *MocktableCRUDMockRecorder) UpdateVisibilityMemo(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVisibilityMemo", reflect.TypeOf((*MocktableCRUD)(nil).UpdateVisibilityMemo), ctx, row)
+}
+
 // WriteLockExecutions mocks base method.
 func (m *MocktableCRUD) WriteLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error) {
 	m.ctrl.T.Helper()
@@ -1907,6 +2325,21 @@ func (mr *MockTxMockRecorder) DeleteFromActivityInfoMaps(ctx, filter any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromActivityInfoMaps", reflect.TypeOf((*MockTx)(nil).DeleteFromActivityInfoMaps), ctx, filter)
 }
 
+// DeleteFromAsyncRequestDLQ mocks base method.
+func (m *MockTx) DeleteFromAsyncRequestDLQ(ctx context.Context, domainID string, requestID string) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromAsyncRequestDLQ", ctx, domainID, requestID)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromAsyncRequestDLQ indicates an expected call of DeleteFromAsyncRequestDLQ.
+func (mr *MockTxMockRecorder) DeleteFromAsyncRequestDLQ(ctx, domainID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromAsyncRequestDLQ", reflect.TypeOf((*MockTx)(nil).DeleteFromAsyncRequestDLQ), ctx, domainID, requestID)
+}
+
 // DeleteFromBufferedEvents mocks base method.
 func (m *MockTx) DeleteFromBufferedEvents(ctx context.Context, filter *BufferedEventsFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -2057,19 +2490,34 @@ func (mr *MockTxMockRecorder) DeleteFromRequestCancelInfoMaps(ctx, filter any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromRequestCancelInfoMaps", reflect.TypeOf((*MockTx)(nil).DeleteFromRequestCancelInfoMaps), ctx, filter)
 }
 
-// DeleteFromSignalInfoMaps mocks base method.
-func (m *MockTx) DeleteFromSignalInfoMaps(ctx context.Context, filter *SignalInfoMapsFilter) (sql.Result, error) {
+// DeleteFromShardDistributorExecutors mocks base method.
+func (m *MockTx) DeleteFromShardDistributorExecutors(ctx context.Context, namespace string, executorID string) (sql.Result, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteFromSignalInfoMaps", ctx, filter)
+	ret := m.ctrl.Call(m, "DeleteFromShardDistributorExecutors", ctx, namespace, executorID)
 	ret0, _ := ret[0].(sql.Result)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteFromSignalInfoMaps indicates an expected call of DeleteFromSignalInfoMaps.
-func (mr *MockTxMockRecorder) DeleteFromSignalInfoMaps(ctx, filter any) *gomock.Call {
+// DeleteFromShardDistributorExecutors indicates an expected call of DeleteFromShardDistributorExecutors.
+func (mr *MockTxMockRecorder) DeleteFromShardDistributorExecutors(ctx, namespace, executorID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromSignalInfoMaps", reflect.TypeOf((*MockTx)(nil).DeleteFromSignalInfoMaps), ctx, filter)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromShardDistributorExecutors", reflect.TypeOf((*MockTx)(nil).DeleteFromShardDistributorExecutors), ctx, namespace, executorID)
+}
+
+// DeleteFromSignalInfoMaps mocks base method.
+func (m *MockTx) DeleteFromSignalInfoMaps(ctx context.Context, filter *SignalInfoMapsFilter) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromSignalInfoMaps", ctx, filter)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromSignalInfoMaps indicates an expected call of DeleteFromSignalInfoMaps.
+func (mr *MockTxMockRecorder) DeleteFromSignalInfoMaps(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromSignalInfoMaps", reflect.TypeOf((*MockTx)(nil).DeleteFromSignalInfoMaps), ctx, filter)
 }
 
 // DeleteFromSignalsRequestedSets mocks base method.
@@ -2370,6 +2818,36 @@ func (mr *MockTxMockRecorder) InsertConfig(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertConfig", reflect.TypeOf((*MockTx)(nil).InsertConfig), ctx, row)
 }
 
+// InsertIntoAsyncRequestQueue mocks base method.
+func (m *MockTx) InsertIntoAsyncRequestQueue(ctx context.Context, queueName string, row *persistence.AsyncRequestQueueMessage) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoAsyncRequestQueue", ctx, queueName, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoAsyncRequestQueue indicates an expected call of InsertIntoAsyncRequestQueue.
+func (mr *MockTxMockRecorder) InsertIntoAsyncRequestQueue(ctx, queueName, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoAsyncRequestQueue", reflect.TypeOf((*MockTx)(nil).InsertIntoAsyncRequestQueue), ctx, queueName, row)
+}
+
+// InsertIntoAsyncRequestQueueMetadata mocks base method.
+func (m *MockTx) InsertIntoAsyncRequestQueueMetadata(ctx context.Context, row *persistence.AsyncRequestQueueMetadata) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoAsyncRequestQueueMetadata", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoAsyncRequestQueueMetadata indicates an expected call of InsertIntoAsyncRequestQueueMetadata.
+func (mr *MockTxMockRecorder) InsertIntoAsyncRequestQueueMetadata(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoAsyncRequestQueueMetadata", reflect.TypeOf((*MockTx)(nil).InsertIntoAsyncRequestQueueMetadata), ctx, row)
+}
+
 // InsertIntoBufferedEvents mocks base method.
 func (m *MockTx) InsertIntoBufferedEvents(ctx context.Context, rows []BufferedEventsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -2475,6 +2953,21 @@ func (mr *MockTxMockRecorder) InsertIntoHistoryTree(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoHistoryTree", reflect.TypeOf((*MockTx)(nil).InsertIntoHistoryTree), ctx, row)
 }
 
+// InsertIntoMapQState mocks base method.
+func (m *MockTx) InsertIntoMapQState(ctx context.Context, row *MapQStateRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoMapQState", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoMapQState indicates an expected call of InsertIntoMapQState.
+func (mr *MockTxMockRecorder) InsertIntoMapQState(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoMapQState", reflect.TypeOf((*MockTx)(nil).InsertIntoMapQState), ctx, row)
+}
+
 // InsertIntoQueue mocks base method.
 func (m *MockTx) InsertIntoQueue(ctx context.Context, row *QueueRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -2520,6 +3013,36 @@ func (mr *MockTxMockRecorder) InsertIntoReplicationTasksDLQ(ctx, row any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoReplicationTasksDLQ", reflect.TypeOf((*MockTx)(nil).InsertIntoReplicationTasksDLQ), ctx, row)
 }
 
+// InsertIntoShardDistributorExecutors mocks base method.
+func (m *MockTx) InsertIntoShardDistributorExecutors(ctx context.Context, row *ShardDistributorExecutorsRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoShardDistributorExecutors", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoShardDistributorExecutors indicates an expected call of InsertIntoShardDistributorExecutors.
+func (mr *MockTxMockRecorder) InsertIntoShardDistributorExecutors(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoShardDistributorExecutors", reflect.TypeOf((*MockTx)(nil).InsertIntoShardDistributorExecutors), ctx, row)
+}
+
+// InsertIntoShardDistributorNamespaces mocks base method.
+func (m *MockTx) InsertIntoShardDistributorNamespaces(ctx context.Context, row *ShardDistributorNamespacesRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoShardDistributorNamespaces", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoShardDistributorNamespaces indicates an expected call of InsertIntoShardDistributorNamespaces.
+func (mr *MockTxMockRecorder) InsertIntoShardDistributorNamespaces(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoShardDistributorNamespaces", reflect.TypeOf((*MockTx)(nil).InsertIntoShardDistributorNamespaces), ctx, row)
+}
+
 // InsertIntoShards mocks base method.
 func (m *MockTx) InsertIntoShards(ctx context.Context, rows *ShardsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -2785,6 +3308,21 @@ func (mr *MockTxMockRecorder) MaxAllowedTTL() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaxAllowedTTL", reflect.TypeOf((*MockTx)(nil).MaxAllowedTTL))
 }
 
+// RangeDeleteFromAsyncRequestQueue mocks base method.
+func (m *MockTx) RangeDeleteFromAsyncRequestQueue(ctx context.Context, queueName string, inclusiveEndMessageID int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeDeleteFromAsyncRequestQueue", ctx, queueName, inclusiveEndMessageID)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeDeleteFromAsyncRequestQueue indicates an expected call of RangeDeleteFromAsyncRequestQueue.
+func (mr *MockTxMockRecorder) RangeDeleteFromAsyncRequestQueue(ctx, queueName, inclusiveEndMessageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromAsyncRequestQueue", reflect.TypeOf((*MockTx)(nil).RangeDeleteFromAsyncRequestQueue), ctx, queueName, inclusiveEndMessageID)
+}
+
 // RangeDeleteFromCrossClusterTasks mocks base method.
 func (m *MockTx) RangeDeleteFromCrossClusterTasks(ctx context.Context, filter *CrossClusterTasksFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -2800,6 +3338,21 @@ func (mr *MockTxMockRecorder) RangeDeleteFromCrossClusterTasks(ctx, filter any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromCrossClusterTasks", reflect.TypeOf((*MockTx)(nil).RangeDeleteFromCrossClusterTasks), ctx, filter)
 }
 
+// RangeDeleteFromMapQItems mocks base method.
+func (m *MockTx) RangeDeleteFromMapQItems(ctx context.Context, queueID string, partitionPath string, inclusiveMaxOffset int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeDeleteFromMapQItems", ctx, queueID, partitionPath, inclusiveMaxOffset)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeDeleteFromMapQItems indicates an expected call of RangeDeleteFromMapQItems.
+func (mr *MockTxMockRecorder) RangeDeleteFromMapQItems(ctx, queueID, partitionPath, inclusiveMaxOffset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromMapQItems", reflect.TypeOf((*MockTx)(nil).RangeDeleteFromMapQItems), ctx, queueID, partitionPath, inclusiveMaxOffset)
+}
+
 // RangeDeleteFromReplicationTasks mocks base method.
 func (m *MockTx) RangeDeleteFromReplicationTasks(ctx context.Context, filter *ReplicationTasksFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -2875,6 +3428,51 @@ func (mr *MockTxMockRecorder) RangeDeleteMessages(ctx, queueType, exclusiveBegin
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteMessages", reflect.TypeOf((*MockTx)(nil).RangeDeleteMessages), ctx, queueType, exclusiveBeginMessageID, inclusiveEndMessageID)
 }
 
+// RangeSelectFromAsyncRequestDLQ mocks base method.
+func (m *MockTx) RangeSelectFromAsyncRequestDLQ(ctx context.Context, domainID string, exclusiveMinRequestID string, pageSize int) ([]*persistence.AsyncRequestDLQEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeSelectFromAsyncRequestDLQ", ctx, domainID, exclusiveMinRequestID, pageSize)
+	ret0, _ := ret[0].([]*persistence.AsyncRequestDLQEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeSelectFromAsyncRequestDLQ indicates an expected call of RangeSelectFromAsyncRequestDLQ.
+func (mr *MockTxMockRecorder) RangeSelectFromAsyncRequestDLQ(ctx, domainID, exclusiveMinRequestID, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeSelectFromAsyncRequestDLQ", reflect.TypeOf((*MockTx)(nil).RangeSelectFromAsyncRequestDLQ), ctx, domainID, exclusiveMinRequestID, pageSize)
+}
+
+// RangeSelectFromAsyncRequestQueue mocks base method.
+func (m *MockTx) RangeSelectFromAsyncRequestQueue(ctx context.Context, queueName string, exclusiveBeginMessageID int64, pageSize int) ([]*persistence.AsyncRequestQueueMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeSelectFromAsyncRequestQueue", ctx, queueName, exclusiveBeginMessageID, pageSize)
+	ret0, _ := ret[0].([]*persistence.AsyncRequestQueueMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeSelectFromAsyncRequestQueue indicates an expected call of RangeSelectFromAsyncRequestQueue.
+func (mr *MockTxMockRecorder) RangeSelectFromAsyncRequestQueue(ctx, queueName, exclusiveBeginMessageID, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeSelectFromAsyncRequestQueue", reflect.TypeOf((*MockTx)(nil).RangeSelectFromAsyncRequestQueue), ctx, queueName, exclusiveBeginMessageID, pageSize)
+}
+
+// RangeSelectFromMapQItems mocks base method.
+func (m *MockTx) RangeSelectFromMapQItems(ctx context.Context, queueID string, partitionPath string, exclusiveMinOffset int64, inclusiveMaxOffset int64, pageSize int) ([]MapQItemsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeSelectFromMapQItems", ctx, queueID, partitionPath, exclusiveMinOffset, inclusiveMaxOffset, pageSize)
+	ret0, _ := ret[0].([]MapQItemsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeSelectFromMapQItems indicates an expected call of RangeSelectFromMapQItems.
+func (mr *MockTxMockRecorder) RangeSelectFromMapQItems(ctx, queueID, partitionPath, exclusiveMinOffset, inclusiveMaxOffset, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeSelectFromMapQItems", reflect.TypeOf((*MockTx)(nil).RangeSelectFromMapQItems), ctx, queueID, partitionPath, exclusiveMinOffset, inclusiveMaxOffset, pageSize)
+}
+
 // ReadLockExecutions mocks base method.
 func (m *MockTx) ReadLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error) {
 	m.ctrl.T.Helper()
@@ -2920,6 +3518,34 @@ func (mr *MockTxMockRecorder) ReplaceIntoActivityInfoMaps(ctx, rows any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoActivityInfoMaps", reflect.TypeOf((*MockTx)(nil).ReplaceIntoActivityInfoMaps), ctx, rows)
 }
 
+// ReplaceIntoAsyncRequestDLQ mocks base method.
+func (m *MockTx) ReplaceIntoAsyncRequestDLQ(ctx context.Context, row *persistence.AsyncRequestDLQEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIntoAsyncRequestDLQ", ctx, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceIntoAsyncRequestDLQ indicates an expected call of ReplaceIntoAsyncRequestDLQ.
+func (mr *MockTxMockRecorder) ReplaceIntoAsyncRequestDLQ(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoAsyncRequestDLQ", reflect.TypeOf((*MockTx)(nil).ReplaceIntoAsyncRequestDLQ), ctx, row)
+}
+
+// ReplaceIntoAsyncRequests mocks base method.
+func (m *MockTx) ReplaceIntoAsyncRequests(ctx context.Context, row *persistence.AsyncRequestInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIntoAsyncRequests", ctx, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceIntoAsyncRequests indicates an expected call of ReplaceIntoAsyncRequests.
+func (mr *MockTxMockRecorder) ReplaceIntoAsyncRequests(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoAsyncRequests", reflect.TypeOf((*MockTx)(nil).ReplaceIntoAsyncRequests), ctx, row)
+}
+
 // ReplaceIntoChildExecutionInfoMaps mocks base method.
 func (m *MockTx) ReplaceIntoChildExecutionInfoMaps(ctx context.Context, rows []ChildExecutionInfoMapsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -2935,6 +3561,21 @@ func (mr *MockTxMockRecorder) ReplaceIntoChildExecutionInfoMaps(ctx, rows any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoChildExecutionInfoMaps", reflect.TypeOf((*MockTx)(nil).ReplaceIntoChildExecutionInfoMaps), ctx, rows)
 }
 
+// ReplaceIntoMapQItems mocks base method.
+func (m *MockTx) ReplaceIntoMapQItems(ctx context.Context, rows []MapQItemsRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIntoMapQItems", ctx, rows)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceIntoMapQItems indicates an expected call of ReplaceIntoMapQItems.
+func (mr *MockTxMockRecorder) ReplaceIntoMapQItems(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoMapQItems", reflect.TypeOf((*MockTx)(nil).ReplaceIntoMapQItems), ctx, rows)
+}
+
 // ReplaceIntoRequestCancelInfoMaps mocks base method.
 func (m *MockTx) ReplaceIntoRequestCancelInfoMaps(ctx context.Context, rows []RequestCancelInfoMapsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -3024,6 +3665,51 @@ func (mr *MockTxMockRecorder) SelectFromActivityInfoMaps(ctx, filter any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromActivityInfoMaps", reflect.TypeOf((*MockTx)(nil).SelectFromActivityInfoMaps), ctx, filter)
 }
 
+// SelectFromAsyncRequestDLQ mocks base method.
+func (m *MockTx) SelectFromAsyncRequestDLQ(ctx context.Context, domainID string, requestID string) (*persistence.AsyncRequestDLQEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromAsyncRequestDLQ", ctx, domainID, requestID)
+	ret0, _ := ret[0].(*persistence.AsyncRequestDLQEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromAsyncRequestDLQ indicates an expected call of SelectFromAsyncRequestDLQ.
+func (mr *MockTxMockRecorder) SelectFromAsyncRequestDLQ(ctx, domainID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromAsyncRequestDLQ", reflect.TypeOf((*MockTx)(nil).SelectFromAsyncRequestDLQ), ctx, domainID, requestID)
+}
+
+// SelectFromAsyncRequestQueueMetadata mocks base method.
+func (m *MockTx) SelectFromAsyncRequestQueueMetadata(ctx context.Context, queueName string) (*persistence.AsyncRequestQueueMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromAsyncRequestQueueMetadata", ctx, queueName)
+	ret0, _ := ret[0].(*persistence.AsyncRequestQueueMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromAsyncRequestQueueMetadata indicates an expected call of SelectFromAsyncRequestQueueMetadata.
+func (mr *MockTxMockRecorder) SelectFromAsyncRequestQueueMetadata(ctx, queueName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromAsyncRequestQueueMetadata", reflect.TypeOf((*MockTx)(nil).SelectFromAsyncRequestQueueMetadata), ctx, queueName)
+}
+
+// SelectFromAsyncRequests mocks base method.
+func (m *MockTx) SelectFromAsyncRequests(ctx context.Context, domainID string, requestID string) (*persistence.AsyncRequestInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromAsyncRequests", ctx, domainID, requestID)
+	ret0, _ := ret[0].(*persistence.AsyncRequestInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromAsyncRequests indicates an expected call of SelectFromAsyncRequests.
+func (mr *MockTxMockRecorder) SelectFromAsyncRequests(ctx, domainID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromAsyncRequests", reflect.TypeOf((*MockTx)(nil).SelectFromAsyncRequests), ctx, domainID, requestID)
+}
+
 // SelectFromBufferedEvents mocks base method.
 func (m *MockTx) SelectFromBufferedEvents(ctx context.Context, filter *BufferedEventsFilter) ([]BufferedEventsRow, error) {
 	m.ctrl.T.Helper()
@@ -3159,6 +3845,21 @@ func (mr *MockTxMockRecorder) SelectFromHistoryTree(ctx, filter any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHistoryTree", reflect.TypeOf((*MockTx)(nil).SelectFromHistoryTree), ctx, filter)
 }
 
+// SelectFromMapQState mocks base method.
+func (m *MockTx) SelectFromMapQState(ctx context.Context, queueID string) (*MapQStateRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromMapQState", ctx, queueID)
+	ret0, _ := ret[0].(*MapQStateRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromMapQState indicates an expected call of SelectFromMapQState.
+func (mr *MockTxMockRecorder) SelectFromMapQState(ctx, queueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromMapQState", reflect.TypeOf((*MockTx)(nil).SelectFromMapQState), ctx, queueID)
+}
+
 // SelectFromReplicationDLQ mocks base method.
 func (m *MockTx) SelectFromReplicationDLQ(ctx context.Context, filter *ReplicationTaskDLQFilter) (int64, error) {
 	m.ctrl.T.Helper()
@@ -3219,6 +3920,36 @@ func (mr *MockTxMockRecorder) SelectFromRequestCancelInfoMaps(ctx, filter any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromRequestCancelInfoMaps", reflect.TypeOf((*MockTx)(nil).SelectFromRequestCancelInfoMaps), ctx, filter)
 }
 
+// SelectFromShardDistributorExecutors mocks base method.
+func (m *MockTx) SelectFromShardDistributorExecutors(ctx context.Context, namespace string) ([]ShardDistributorExecutorsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromShardDistributorExecutors", ctx, namespace)
+	ret0, _ := ret[0].([]ShardDistributorExecutorsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromShardDistributorExecutors indicates an expected call of SelectFromShardDistributorExecutors.
+func (mr *MockTxMockRecorder) SelectFromShardDistributorExecutors(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromShardDistributorExecutors", reflect.TypeOf((*MockTx)(nil).SelectFromShardDistributorExecutors), ctx, namespace)
+}
+
+// SelectFromShardDistributorNamespaces mocks base method.
+func (m *MockTx) SelectFromShardDistributorNamespaces(ctx context.Context, namespace string) (*ShardDistributorNamespacesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromShardDistributorNamespaces", ctx, namespace)
+	ret0, _ := ret[0].(*ShardDistributorNamespacesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromShardDistributorNamespaces indicates an expected call of SelectFromShardDistributorNamespaces.
+func (mr *MockTxMockRecorder) SelectFromShardDistributorNamespaces(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromShardDistributorNamespaces", reflect.TypeOf((*MockTx)(nil).SelectFromShardDistributorNamespaces), ctx, namespace)
+}
+
 // SelectFromShards mocks base method.
 func (m *MockTx) SelectFromShards(ctx context.Context, filter *ShardsFilter) (*ShardsRow, error) {
 	m.ctrl.T.Helper()
@@ -3354,6 +4085,21 @@ func (mr *MockTxMockRecorder) SelectFromVisibility(ctx, filter any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibility", reflect.TypeOf((*MockTx)(nil).SelectFromVisibility), ctx, filter)
 }
 
+// SelectLastAsyncRequestQueueMessageID mocks base method.
+func (m *MockTx) SelectLastAsyncRequestQueueMessageID(ctx context.Context, queueName string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectLastAsyncRequestQueueMessageID", ctx, queueName)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectLastAsyncRequestQueueMessageID indicates an expected call of SelectLastAsyncRequestQueueMessageID.
+func (mr *MockTxMockRecorder) SelectLastAsyncRequestQueueMessageID(ctx, queueName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectLastAsyncRequestQueueMessageID", reflect.TypeOf((*MockTx)(nil).SelectLastAsyncRequestQueueMessageID), ctx, queueName)
+}
+
 // SelectLatestConfig mocks base method.
 func (m *MockTx) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
 	m.ctrl.T.Helper()
@@ -3411,6 +4157,21 @@ func (mr *MockTxMockRecorder) UpdateAckLevels(ctx, queueType, clusterAckLevels a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAckLevels", reflect.TypeOf((*MockTx)(nil).UpdateAckLevels), ctx, queueType, clusterAckLevels)
 }
 
+// UpdateAsyncRequestQueueMetadata mocks base method.
+func (m *MockTx) UpdateAsyncRequestQueueMetadata(ctx context.Context, row *persistence.AsyncRequestQueueMetadata, previousVersion int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAsyncRequestQueueMetadata", ctx, row, previousVersion)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAsyncRequestQueueMetadata indicates an expected call of UpdateAsyncRequestQueueMetadata.
+func (mr *MockTxMockRecorder) UpdateAsyncRequestQueueMetadata(ctx, row, previousVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAsyncRequestQueueMetadata", reflect.TypeOf((*MockTx)(nil).UpdateAsyncRequestQueueMetadata), ctx, row, previousVersion)
+}
+
 // UpdateCurrentExecutions mocks base method.
 func (m *MockTx) UpdateCurrentExecutions(ctx context.Context, row *CurrentExecutionsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -3471,6 +4232,66 @@ func (mr *MockTxMockRecorder) UpdateExecutions(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExecutions", reflect.TypeOf((*MockTx)(nil).UpdateExecutions), ctx, row)
 }
 
+// UpdateMapQState mocks base method.
+func (m *MockTx) UpdateMapQState(ctx context.Context, row *MapQStateRow, previousVersion int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMapQState", ctx, row, previousVersion)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMapQState indicates an expected call of UpdateMapQState.
+func (mr *MockTxMockRecorder) UpdateMapQState(ctx, row, previousVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMapQState", reflect.TypeOf((*MockTx)(nil).UpdateMapQState), ctx, row, previousVersion)
+}
+
+// UpdateShardDistributorExecutors mocks base method.
+func (m *MockTx) UpdateShardDistributorExecutors(ctx context.Context, row *ShardDistributorExecutorsRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorExecutors", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShardDistributorExecutors indicates an expected call of UpdateShardDistributorExecutors.
+func (mr *MockTxMockRecorder) UpdateShardDistributorExecutors(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorExecutors", reflect.TypeOf((*MockTx)(nil).UpdateShardDistributorExecutors), ctx, row)
+}
+
+// UpdateShardDistributorNamespacesLeader mocks base method.
+func (m *MockTx) UpdateShardDistributorNamespacesLeader(ctx context.Context, row *ShardDistributorNamespacesRow, previousVersion int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorNamespacesLeader", ctx, row, previousVersion)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShardDistributorNamespacesLeader indicates an expected call of UpdateShardDistributorNamespacesLeader.
+func (mr *MockTxMockRecorder) UpdateShardDistributorNamespacesLeader(ctx, row, previousVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorNamespacesLeader", reflect.TypeOf((*MockTx)(nil).UpdateShardDistributorNamespacesLeader), ctx, row, previousVersion)
+}
+
+// UpdateShardDistributorNamespacesRevision mocks base method.
+func (m *MockTx) UpdateShardDistributorNamespacesRevision(ctx context.Context, namespace string, previousRevision int64, leaderVersion int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorNamespacesRevision", ctx, namespace, previousRevision, leaderVersion)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShardDistributorNamespacesRevision indicates an expected call of UpdateShardDistributorNamespacesRevision.
+func (mr *MockTxMockRecorder) UpdateShardDistributorNamespacesRevision(ctx, namespace, previousRevision, leaderVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorNamespacesRevision", reflect.TypeOf((*MockTx)(nil).UpdateShardDistributorNamespacesRevision), ctx, namespace, previousRevision, leaderVersion)
+}
+
 // UpdateShards mocks base method.
 func (m *MockTx) UpdateShards(ctx context.Context, row *ShardsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -3516,6 +4337,21 @@ func (mr *MockTxMockRecorder) UpdateTaskListsWithTTL(ctx, row any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListsWithTTL", reflect.TypeOf((*MockTx)(nil).UpdateTaskListsWithTTL), ctx, row)
 }
 
+// UpdateVisibilityMemo mocks base method.
+func (m *MockTx) UpdateVisibilityMemo(ctx context.Context, row *VisibilityRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVisibilityMemo", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVisibilityMemo indicates an expected call of UpdateVisibilityMemo.
+func (mr *MockTxMockRecorder) UpdateVisibilityMemo(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVisibilityMemo", reflect.TypeOf((*MockTx)(nil).UpdateVisibilityMemo), ctx, row)
+}
+
 // WriteLockExecutions mocks base method.
 func (m *MockTx) WriteLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error) {
 	m.ctrl.T.Helper()
@@ -3614,6 +4450,21 @@ func (mr *MockDBMockRecorder) DeleteFromActivityInfoMaps(ctx, filter any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromActivityInfoMaps", reflect.TypeOf((*MockDB)(nil).DeleteFromActivityInfoMaps), ctx, filter)
 }
 
+// DeleteFromAsyncRequestDLQ mocks base method.
+func (m *MockDB) DeleteFromAsyncRequestDLQ(ctx context.Context, domainID string, requestID string) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromAsyncRequestDLQ", ctx, domainID, requestID)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromAsyncRequestDLQ indicates an expected call of DeleteFromAsyncRequestDLQ.
+func (mr *MockDBMockRecorder) DeleteFromAsyncRequestDLQ(ctx, domainID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromAsyncRequestDLQ", reflect.TypeOf((*MockDB)(nil).DeleteFromAsyncRequestDLQ), ctx, domainID, requestID)
+}
+
 // DeleteFromBufferedEvents mocks base method.
 func (m *MockDB) DeleteFromBufferedEvents(ctx context.Context, filter *BufferedEventsFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -3764,6 +4615,21 @@ func (mr *MockDBMockRecorder) DeleteFromRequestCancelInfoMaps(ctx, filter any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromRequestCancelInfoMaps", reflect.TypeOf((*MockDB)(nil).DeleteFromRequestCancelInfoMaps), ctx, filter)
 }
 
+// DeleteFromShardDistributorExecutors mocks base method.
+func (m *MockDB) DeleteFromShardDistributorExecutors(ctx context.Context, namespace string, executorID string) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromShardDistributorExecutors", ctx, namespace, executorID)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFromShardDistributorExecutors indicates an expected call of DeleteFromShardDistributorExecutors.
+func (mr *MockDBMockRecorder) DeleteFromShardDistributorExecutors(ctx, namespace, executorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromShardDistributorExecutors", reflect.TypeOf((*MockDB)(nil).DeleteFromShardDistributorExecutors), ctx, namespace, executorID)
+}
+
 // DeleteFromSignalInfoMaps mocks base method.
 func (m *MockDB) DeleteFromSignalInfoMaps(ctx context.Context, filter *SignalInfoMapsFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4091,6 +4957,36 @@ func (mr *MockDBMockRecorder) InsertConfig(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertConfig", reflect.TypeOf((*MockDB)(nil).InsertConfig), ctx, row)
 }
 
+// InsertIntoAsyncRequestQueue mocks base method.
+func (m *MockDB) InsertIntoAsyncRequestQueue(ctx context.Context, queueName string, row *persistence.AsyncRequestQueueMessage) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoAsyncRequestQueue", ctx, queueName, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoAsyncRequestQueue indicates an expected call of InsertIntoAsyncRequestQueue.
+func (mr *MockDBMockRecorder) InsertIntoAsyncRequestQueue(ctx, queueName, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoAsyncRequestQueue", reflect.TypeOf((*MockDB)(nil).InsertIntoAsyncRequestQueue), ctx, queueName, row)
+}
+
+// InsertIntoAsyncRequestQueueMetadata mocks base method.
+func (m *MockDB) InsertIntoAsyncRequestQueueMetadata(ctx context.Context, row *persistence.AsyncRequestQueueMetadata) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoAsyncRequestQueueMetadata", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoAsyncRequestQueueMetadata indicates an expected call of InsertIntoAsyncRequestQueueMetadata.
+func (mr *MockDBMockRecorder) InsertIntoAsyncRequestQueueMetadata(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoAsyncRequestQueueMetadata", reflect.TypeOf((*MockDB)(nil).InsertIntoAsyncRequestQueueMetadata), ctx, row)
+}
+
 // InsertIntoBufferedEvents mocks base method.
 func (m *MockDB) InsertIntoBufferedEvents(ctx context.Context, rows []BufferedEventsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4196,6 +5092,21 @@ func (mr *MockDBMockRecorder) InsertIntoHistoryTree(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoHistoryTree", reflect.TypeOf((*MockDB)(nil).InsertIntoHistoryTree), ctx, row)
 }
 
+// InsertIntoMapQState mocks base method.
+func (m *MockDB) InsertIntoMapQState(ctx context.Context, row *MapQStateRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoMapQState", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoMapQState indicates an expected call of InsertIntoMapQState.
+func (mr *MockDBMockRecorder) InsertIntoMapQState(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoMapQState", reflect.TypeOf((*MockDB)(nil).InsertIntoMapQState), ctx, row)
+}
+
 // InsertIntoQueue mocks base method.
 func (m *MockDB) InsertIntoQueue(ctx context.Context, row *QueueRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4241,6 +5152,36 @@ func (mr *MockDBMockRecorder) InsertIntoReplicationTasksDLQ(ctx, row any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoReplicationTasksDLQ", reflect.TypeOf((*MockDB)(nil).InsertIntoReplicationTasksDLQ), ctx, row)
 }
 
+// InsertIntoShardDistributorExecutors mocks base method.
+func (m *MockDB) InsertIntoShardDistributorExecutors(ctx context.Context, row *ShardDistributorExecutorsRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoShardDistributorExecutors", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoShardDistributorExecutors indicates an expected call of InsertIntoShardDistributorExecutors.
+func (mr *MockDBMockRecorder) InsertIntoShardDistributorExecutors(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoShardDistributorExecutors", reflect.TypeOf((*MockDB)(nil).InsertIntoShardDistributorExecutors), ctx, row)
+}
+
+// InsertIntoShardDistributorNamespaces mocks base method.
+func (m *MockDB) InsertIntoShardDistributorNamespaces(ctx context.Context, row *ShardDistributorNamespacesRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertIntoShardDistributorNamespaces", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertIntoShardDistributorNamespaces indicates an expected call of InsertIntoShardDistributorNamespaces.
+func (mr *MockDBMockRecorder) InsertIntoShardDistributorNamespaces(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoShardDistributorNamespaces", reflect.TypeOf((*MockDB)(nil).InsertIntoShardDistributorNamespaces), ctx, row)
+}
+
 // InsertIntoShards mocks base method.
 func (m *MockDB) InsertIntoShards(ctx context.Context, rows *ShardsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4520,6 +5461,21 @@ func (mr *MockDBMockRecorder) PluginName() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PluginName", reflect.TypeOf((*MockDB)(nil).PluginName))
 }
 
+// RangeDeleteFromAsyncRequestQueue mocks base method.
+func (m *MockDB) RangeDeleteFromAsyncRequestQueue(ctx context.Context, queueName string, inclusiveEndMessageID int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeDeleteFromAsyncRequestQueue", ctx, queueName, inclusiveEndMessageID)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeDeleteFromAsyncRequestQueue indicates an expected call of RangeDeleteFromAsyncRequestQueue.
+func (mr *MockDBMockRecorder) RangeDeleteFromAsyncRequestQueue(ctx, queueName, inclusiveEndMessageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromAsyncRequestQueue", reflect.TypeOf((*MockDB)(nil).RangeDeleteFromAsyncRequestQueue), ctx, queueName, inclusiveEndMessageID)
+}
+
 // RangeDeleteFromCrossClusterTasks mocks base method.
 func (m *MockDB) RangeDeleteFromCrossClusterTasks(ctx context.Context, filter *CrossClusterTasksFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4535,6 +5491,21 @@ func (mr *MockDBMockRecorder) RangeDeleteFromCrossClusterTasks(ctx, filter any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromCrossClusterTasks", reflect.TypeOf((*MockDB)(nil).RangeDeleteFromCrossClusterTasks), ctx, filter)
 }
 
+// RangeDeleteFromMapQItems mocks base method.
+func (m *MockDB) RangeDeleteFromMapQItems(ctx context.Context, queueID string, partitionPath string, inclusiveMaxOffset int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeDeleteFromMapQItems", ctx, queueID, partitionPath, inclusiveMaxOffset)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeDeleteFromMapQItems indicates an expected call of RangeDeleteFromMapQItems.
+func (mr *MockDBMockRecorder) RangeDeleteFromMapQItems(ctx, queueID, partitionPath, inclusiveMaxOffset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteFromMapQItems", reflect.TypeOf((*MockDB)(nil).RangeDeleteFromMapQItems), ctx, queueID, partitionPath, inclusiveMaxOffset)
+}
+
 // RangeDeleteFromReplicationTasks mocks base method.
 func (m *MockDB) RangeDeleteFromReplicationTasks(ctx context.Context, filter *ReplicationTasksFilter) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4610,6 +5581,51 @@ func (mr *MockDBMockRecorder) RangeDeleteMessages(ctx, queueType, exclusiveBegin
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeDeleteMessages", reflect.TypeOf((*MockDB)(nil).RangeDeleteMessages), ctx, queueType, exclusiveBeginMessageID, inclusiveEndMessageID)
 }
 
+// RangeSelectFromAsyncRequestDLQ mocks base method.
+func (m *MockDB) RangeSelectFromAsyncRequestDLQ(ctx context.Context, domainID string, exclusiveMinRequestID string, pageSize int) ([]*persistence.AsyncRequestDLQEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeSelectFromAsyncRequestDLQ", ctx, domainID, exclusiveMinRequestID, pageSize)
+	ret0, _ := ret[0].([]*persistence.AsyncRequestDLQEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeSelectFromAsyncRequestDLQ indicates an expected call of RangeSelectFromAsyncRequestDLQ.
+func (mr *MockDBMockRecorder) RangeSelectFromAsyncRequestDLQ(ctx, domainID, exclusiveMinRequestID, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeSelectFromAsyncRequestDLQ", reflect.TypeOf((*MockDB)(nil).RangeSelectFromAsyncRequestDLQ), ctx, domainID, exclusiveMinRequestID, pageSize)
+}
+
+// RangeSelectFromAsyncRequestQueue mocks base method.
+func (m *MockDB) RangeSelectFromAsyncRequestQueue(ctx context.Context, queueName string, exclusiveBeginMessageID int64, pageSize int) ([]*persistence.AsyncRequestQueueMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeSelectFromAsyncRequestQueue", ctx, queueName, exclusiveBeginMessageID, pageSize)
+	ret0, _ := ret[0].([]*persistence.AsyncRequestQueueMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeSelectFromAsyncRequestQueue indicates an expected call of RangeSelectFromAsyncRequestQueue.
+func (mr *MockDBMockRecorder) RangeSelectFromAsyncRequestQueue(ctx, queueName, exclusiveBeginMessageID, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeSelectFromAsyncRequestQueue", reflect.TypeOf((*MockDB)(nil).RangeSelectFromAsyncRequestQueue), ctx, queueName, exclusiveBeginMessageID, pageSize)
+}
+
+// RangeSelectFromMapQItems mocks base method.
+func (m *MockDB) RangeSelectFromMapQItems(ctx context.Context, queueID string, partitionPath string, exclusiveMinOffset int64, inclusiveMaxOffset int64, pageSize int) ([]MapQItemsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RangeSelectFromMapQItems", ctx, queueID, partitionPath, exclusiveMinOffset, inclusiveMaxOffset, pageSize)
+	ret0, _ := ret[0].([]MapQItemsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RangeSelectFromMapQItems indicates an expected call of RangeSelectFromMapQItems.
+func (mr *MockDBMockRecorder) RangeSelectFromMapQItems(ctx, queueID, partitionPath, exclusiveMinOffset, inclusiveMaxOffset, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RangeSelectFromMapQItems", reflect.TypeOf((*MockDB)(nil).RangeSelectFromMapQItems), ctx, queueID, partitionPath, exclusiveMinOffset, inclusiveMaxOffset, pageSize)
+}
+
 // ReadLockExecutions mocks base method.
 func (m *MockDB) ReadLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error) {
 	m.ctrl.T.Helper()
@@ -4655,6 +5671,34 @@ func (mr *MockDBMockRecorder) ReplaceIntoActivityInfoMaps(ctx, rows any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoActivityInfoMaps", reflect.TypeOf((*MockDB)(nil).ReplaceIntoActivityInfoMaps), ctx, rows)
 }
 
+// ReplaceIntoAsyncRequestDLQ mocks base method.
+func (m *MockDB) ReplaceIntoAsyncRequestDLQ(ctx context.Context, row *persistence.AsyncRequestDLQEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIntoAsyncRequestDLQ", ctx, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceIntoAsyncRequestDLQ indicates an expected call of ReplaceIntoAsyncRequestDLQ.
+func (mr *MockDBMockRecorder) ReplaceIntoAsyncRequestDLQ(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoAsyncRequestDLQ", reflect.TypeOf((*MockDB)(nil).ReplaceIntoAsyncRequestDLQ), ctx, row)
+}
+
+// ReplaceIntoAsyncRequests mocks base method.
+func (m *MockDB) ReplaceIntoAsyncRequests(ctx context.Context, row *persistence.AsyncRequestInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIntoAsyncRequests", ctx, row)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceIntoAsyncRequests indicates an expected call of ReplaceIntoAsyncRequests.
+func (mr *MockDBMockRecorder) ReplaceIntoAsyncRequests(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoAsyncRequests", reflect.TypeOf((*MockDB)(nil).ReplaceIntoAsyncRequests), ctx, row)
+}
+
 // ReplaceIntoChildExecutionInfoMaps mocks base method.
 func (m *MockDB) ReplaceIntoChildExecutionInfoMaps(ctx context.Context, rows []ChildExecutionInfoMapsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4670,6 +5714,21 @@ func (mr *MockDBMockRecorder) ReplaceIntoChildExecutionInfoMaps(ctx, rows any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoChildExecutionInfoMaps", reflect.TypeOf((*MockDB)(nil).ReplaceIntoChildExecutionInfoMaps), ctx, rows)
 }
 
+// ReplaceIntoMapQItems mocks base method.
+func (m *MockDB) ReplaceIntoMapQItems(ctx context.Context, rows []MapQItemsRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIntoMapQItems", ctx, rows)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceIntoMapQItems indicates an expected call of ReplaceIntoMapQItems.
+func (mr *MockDBMockRecorder) ReplaceIntoMapQItems(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIntoMapQItems", reflect.TypeOf((*MockDB)(nil).ReplaceIntoMapQItems), ctx, rows)
+}
+
 // ReplaceIntoRequestCancelInfoMaps mocks base method.
 func (m *MockDB) ReplaceIntoRequestCancelInfoMaps(ctx context.Context, rows []RequestCancelInfoMapsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -4745,6 +5804,51 @@ func (mr *MockDBMockRecorder) SelectFromActivityInfoMaps(ctx, filter any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromActivityInfoMaps", reflect.TypeOf((*MockDB)(nil).SelectFromActivityInfoMaps), ctx, filter)
 }
 
+// SelectFromAsyncRequestDLQ mocks base method.
+func (m *MockDB) SelectFromAsyncRequestDLQ(ctx context.Context, domainID string, requestID string) (*persistence.AsyncRequestDLQEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromAsyncRequestDLQ", ctx, domainID, requestID)
+	ret0, _ := ret[0].(*persistence.AsyncRequestDLQEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromAsyncRequestDLQ indicates an expected call of SelectFromAsyncRequestDLQ.
+func (mr *MockDBMockRecorder) SelectFromAsyncRequestDLQ(ctx, domainID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromAsyncRequestDLQ", reflect.TypeOf((*MockDB)(nil).SelectFromAsyncRequestDLQ), ctx, domainID, requestID)
+}
+
+// SelectFromAsyncRequestQueueMetadata mocks base method.
+func (m *MockDB) SelectFromAsyncRequestQueueMetadata(ctx context.Context, queueName string) (*persistence.AsyncRequestQueueMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromAsyncRequestQueueMetadata", ctx, queueName)
+	ret0, _ := ret[0].(*persistence.AsyncRequestQueueMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromAsyncRequestQueueMetadata indicates an expected call of SelectFromAsyncRequestQueueMetadata.
+func (mr *MockDBMockRecorder) SelectFromAsyncRequestQueueMetadata(ctx, queueName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromAsyncRequestQueueMetadata", reflect.TypeOf((*MockDB)(nil).SelectFromAsyncRequestQueueMetadata), ctx, queueName)
+}
+
+// SelectFromAsyncRequests mocks base method.
+func (m *MockDB) SelectFromAsyncRequests(ctx context.Context, domainID string, requestID string) (*persistence.AsyncRequestInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromAsyncRequests", ctx, domainID, requestID)
+	ret0, _ := ret[0].(*persistence.AsyncRequestInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromAsyncRequests indicates an expected call of SelectFromAsyncRequests.
+func (mr *MockDBMockRecorder) SelectFromAsyncRequests(ctx, domainID, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromAsyncRequests", reflect.TypeOf((*MockDB)(nil).SelectFromAsyncRequests), ctx, domainID, requestID)
+}
+
 // SelectFromBufferedEvents mocks base method.
 func (m *MockDB) SelectFromBufferedEvents(ctx context.Context, filter *BufferedEventsFilter) ([]BufferedEventsRow, error) {
 	m.ctrl.T.Helper()
@@ -4880,6 +5984,21 @@ func (mr *MockDBMockRecorder) SelectFromHistoryTree(ctx, filter any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromHistoryTree", reflect.TypeOf((*MockDB)(nil).SelectFromHistoryTree), ctx, filter)
 }
 
+// SelectFromMapQState mocks base method.
+func (m *MockDB) SelectFromMapQState(ctx context.Context, queueID string) (*MapQStateRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromMapQState", ctx, queueID)
+	ret0, _ := ret[0].(*MapQStateRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromMapQState indicates an expected call of SelectFromMapQState.
+func (mr *MockDBMockRecorder) SelectFromMapQState(ctx, queueID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromMapQState", reflect.TypeOf((*MockDB)(nil).SelectFromMapQState), ctx, queueID)
+}
+
 // SelectFromReplicationDLQ mocks base method.
 func (m *MockDB) SelectFromReplicationDLQ(ctx context.Context, filter *ReplicationTaskDLQFilter) (int64, error) {
 	m.ctrl.T.Helper()
@@ -4940,6 +6059,36 @@ func (mr *MockDBMockRecorder) SelectFromRequestCancelInfoMaps(ctx, filter any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromRequestCancelInfoMaps", reflect.TypeOf((*MockDB)(nil).SelectFromRequestCancelInfoMaps), ctx, filter)
 }
 
+// SelectFromShardDistributorExecutors mocks base method.
+func (m *MockDB) SelectFromShardDistributorExecutors(ctx context.Context, namespace string) ([]ShardDistributorExecutorsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromShardDistributorExecutors", ctx, namespace)
+	ret0, _ := ret[0].([]ShardDistributorExecutorsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromShardDistributorExecutors indicates an expected call of SelectFromShardDistributorExecutors.
+func (mr *MockDBMockRecorder) SelectFromShardDistributorExecutors(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromShardDistributorExecutors", reflect.TypeOf((*MockDB)(nil).SelectFromShardDistributorExecutors), ctx, namespace)
+}
+
+// SelectFromShardDistributorNamespaces mocks base method.
+func (m *MockDB) SelectFromShardDistributorNamespaces(ctx context.Context, namespace string) (*ShardDistributorNamespacesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectFromShardDistributorNamespaces", ctx, namespace)
+	ret0, _ := ret[0].(*ShardDistributorNamespacesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectFromShardDistributorNamespaces indicates an expected call of SelectFromShardDistributorNamespaces.
+func (mr *MockDBMockRecorder) SelectFromShardDistributorNamespaces(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromShardDistributorNamespaces", reflect.TypeOf((*MockDB)(nil).SelectFromShardDistributorNamespaces), ctx, namespace)
+}
+
 // SelectFromShards mocks base method.
 func (m *MockDB) SelectFromShards(ctx context.Context, filter *ShardsFilter) (*ShardsRow, error) {
 	m.ctrl.T.Helper()
@@ -5075,6 +6224,21 @@ func (mr *MockDBMockRecorder) SelectFromVisibility(ctx, filter any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectFromVisibility", reflect.TypeOf((*MockDB)(nil).SelectFromVisibility), ctx, filter)
 }
 
+// SelectLastAsyncRequestQueueMessageID mocks base method.
+func (m *MockDB) SelectLastAsyncRequestQueueMessageID(ctx context.Context, queueName string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectLastAsyncRequestQueueMessageID", ctx, queueName)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectLastAsyncRequestQueueMessageID indicates an expected call of SelectLastAsyncRequestQueueMessageID.
+func (mr *MockDBMockRecorder) SelectLastAsyncRequestQueueMessageID(ctx, queueName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectLastAsyncRequestQueueMessageID", reflect.TypeOf((*MockDB)(nil).SelectLastAsyncRequestQueueMessageID), ctx, queueName)
+}
+
 // SelectLatestConfig mocks base method.
 func (m *MockDB) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
 	m.ctrl.T.Helper()
@@ -5132,6 +6296,21 @@ func (mr *MockDBMockRecorder) UpdateAckLevels(ctx, queueType, clusterAckLevels a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAckLevels", reflect.TypeOf((*MockDB)(nil).UpdateAckLevels), ctx, queueType, clusterAckLevels)
 }
 
+// UpdateAsyncRequestQueueMetadata mocks base method.
+func (m *MockDB) UpdateAsyncRequestQueueMetadata(ctx context.Context, row *persistence.AsyncRequestQueueMetadata, previousVersion int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAsyncRequestQueueMetadata", ctx, row, previousVersion)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAsyncRequestQueueMetadata indicates an expected call of UpdateAsyncRequestQueueMetadata.
+func (mr *MockDBMockRecorder) UpdateAsyncRequestQueueMetadata(ctx, row, previousVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAsyncRequestQueueMetadata", reflect.TypeOf((*MockDB)(nil).UpdateAsyncRequestQueueMetadata), ctx, row, previousVersion)
+}
+
 // UpdateCurrentExecutions mocks base method.
 func (m *MockDB) UpdateCurrentExecutions(ctx context.Context, row *CurrentExecutionsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -5192,6 +6371,66 @@ func (mr *MockDBMockRecorder) UpdateExecutions(ctx, row any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExecutions", reflect.TypeOf((*MockDB)(nil).UpdateExecutions), ctx, row)
 }
 
+// UpdateMapQState mocks base method.
+func (m *MockDB) UpdateMapQState(ctx context.Context, row *MapQStateRow, previousVersion int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMapQState", ctx, row, previousVersion)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMapQState indicates an expected call of UpdateMapQState.
+func (mr *MockDBMockRecorder) UpdateMapQState(ctx, row, previousVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMapQState", reflect.TypeOf((*MockDB)(nil).UpdateMapQState), ctx, row, previousVersion)
+}
+
+// UpdateShardDistributorExecutors mocks base method.
+func (m *MockDB) UpdateShardDistributorExecutors(ctx context.Context, row *ShardDistributorExecutorsRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorExecutors", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShardDistributorExecutors indicates an expected call of UpdateShardDistributorExecutors.
+func (mr *MockDBMockRecorder) UpdateShardDistributorExecutors(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorExecutors", reflect.TypeOf((*MockDB)(nil).UpdateShardDistributorExecutors), ctx, row)
+}
+
+// UpdateShardDistributorNamespacesLeader mocks base method.
+func (m *MockDB) UpdateShardDistributorNamespacesLeader(ctx context.Context, row *ShardDistributorNamespacesRow, previousVersion int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorNamespacesLeader", ctx, row, previousVersion)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShardDistributorNamespacesLeader indicates an expected call of UpdateShardDistributorNamespacesLeader.
+func (mr *MockDBMockRecorder) UpdateShardDistributorNamespacesLeader(ctx, row, previousVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorNamespacesLeader", reflect.TypeOf((*MockDB)(nil).UpdateShardDistributorNamespacesLeader), ctx, row, previousVersion)
+}
+
+// UpdateShardDistributorNamespacesRevision mocks base method.
+func (m *MockDB) UpdateShardDistributorNamespacesRevision(ctx context.Context, namespace string, previousRevision int64, leaderVersion int64) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardDistributorNamespacesRevision", ctx, namespace, previousRevision, leaderVersion)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShardDistributorNamespacesRevision indicates an expected call of UpdateShardDistributorNamespacesRevision.
+func (mr *MockDBMockRecorder) UpdateShardDistributorNamespacesRevision(ctx, namespace, previousRevision, leaderVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardDistributorNamespacesRevision", reflect.TypeOf((*MockDB)(nil).UpdateShardDistributorNamespacesRevision), ctx, namespace, previousRevision, leaderVersion)
+}
+
 // UpdateShards mocks base method.
 func (m *MockDB) UpdateShards(ctx context.Context, row *ShardsRow) (sql.Result, error) {
 	m.ctrl.T.Helper()
@@ -5237,6 +6476,21 @@ func (mr *MockDBMockRecorder) UpdateTaskListsWithTTL(ctx, row any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListsWithTTL", reflect.TypeOf((*MockDB)(nil).UpdateTaskListsWithTTL), ctx, row)
 }
 
+// UpdateVisibilityMemo mocks base method.
+func (m *MockDB) UpdateVisibilityMemo(ctx context.Context, row *VisibilityRow) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVisibilityMemo", ctx, row)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVisibilityMemo indicates an expected call of UpdateVisibilityMemo.
+func (mr *MockDBMockRecorder) UpdateVisibilityMemo(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVisibilityMemo", reflect.TypeOf((*MockDB)(nil).UpdateVisibilityMemo), ctx, row)
+}
+
 // WriteLockExecutions mocks base method.
 func (m *MockDB) WriteLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error) {
 	m.ctrl.T.Helper()
@@ -579,6 +579,85 @@ type (
 		DataEncoding string
 	}
 
+	// AsyncRequestsRow represents a row in async_requests table
+	AsyncRequestsRow struct {
+		DomainID        string
+		RequestID       string
+		WorkflowID      string
+		RunID           string
+		State           int32
+		Failure         string
+		CreatedTime     time.Time
+		LastUpdatedTime time.Time
+		ExpiryTime      time.Time
+	}
+
+	// AsyncRequestDLQRow represents a row in async_request_dlq table
+	AsyncRequestDLQRow struct {
+		DomainID    string
+		RequestID   string
+		RequestType int32
+		WorkflowID  string
+		Failure     string
+		Payload     []byte
+		CreatedTime time.Time
+	}
+
+	// AsyncRequestQueueRow represents a row in async_request_queue table
+	AsyncRequestQueueRow struct {
+		QueueName   string
+		MessageID   int64
+		Payload     []byte
+		CreatedTime time.Time
+	}
+
+	// AsyncRequestQueueMetadataRow represents a row in async_request_queue_metadata table
+	AsyncRequestQueueMetadataRow struct {
+		QueueName   string
+		AckLevel    int64
+		LeaseOwner  string
+		LeaseExpiry time.Time
+		Version     int64
+	}
+
+	// MapQItemsRow represents a row in mapq_items table
+	MapQItemsRow struct {
+		QueueID       string
+		PartitionPath string
+		ItemOffset    int64
+		Payload       []byte
+	}
+
+	// MapQStateRow represents a row in mapq_state table
+	MapQStateRow struct {
+		QueueID       string
+		State         []byte
+		StateEncoding string
+		Version       int64
+	}
+
+	// ShardDistributorNamespacesRow represents a row in shard_distributor_namespaces table
+	ShardDistributorNamespacesRow struct {
+		Namespace     string
+		LeaderID      string
+		LeaseExpiry   time.Time
+		LeaderVersion int64
+		Revision      int64
+	}
+
+	// ShardDistributorExecutorsRow represents a row in shard_distributor_executors table
+	ShardDistributorExecutorsRow struct {
+		Namespace              string
+		ExecutorID             string
+		LastHeartbeat          time.Time
+		State                  string
+		ReportedShards         []byte
+		ReportedShardsEncoding string
+		AssignedShards         []byte
+		AssignedShardsEncoding string
+		AssignedRevision       int64
+	}
+
 	// tableCRUD defines the API for interacting with the database tables
 	tableCRUD interface {
 		InsertIntoDomain(ctx context.Context, rows *DomainRow) (sql.Result, error)
@@ -788,6 +867,9 @@ type (
 		InsertIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
 		// ReplaceIntoVisibility deletes old row (if it exist) and inserts new row into visibility table
 		ReplaceIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
+		// UpdateVisibilityMemo updates the memo of an open workflow's visibility row.
+		// Rows of closed workflows are left untouched
+		UpdateVisibilityMemo(ctx context.Context, row *VisibilityRow) (sql.Result, error)
 		// SelectFromVisibility returns one or more rows from visibility table
 		// Required filter params:
 		// - getClosedWorkflowExecution - retrieves single row - {domainID, runID, closed=true}
@@ -816,6 +898,61 @@ type (
 		// SelectLatestConfig returns the config entry of the row_type with the largest(latest) version value
 		SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error)
 
+		// ReplaceIntoAsyncRequests creates or overwrites the status row of an async request
+		ReplaceIntoAsyncRequests(ctx context.Context, row *persistence.AsyncRequestInfo) error
+		// SelectFromAsyncRequests returns the status row of an async request
+		SelectFromAsyncRequests(ctx context.Context, domainID, requestID string) (*persistence.AsyncRequestInfo, error)
+		// ReplaceIntoAsyncRequestDLQ creates or overwrites a DLQ entry
+		ReplaceIntoAsyncRequestDLQ(ctx context.Context, row *persistence.AsyncRequestDLQEntry) error
+		// RangeSelectFromAsyncRequestDLQ returns up to pageSize DLQ entries of a domain with requestID greater than exclusiveMinRequestID
+		RangeSelectFromAsyncRequestDLQ(ctx context.Context, domainID, exclusiveMinRequestID string, pageSize int) ([]*persistence.AsyncRequestDLQEntry, error)
+		// SelectFromAsyncRequestDLQ returns a single DLQ entry
+		SelectFromAsyncRequestDLQ(ctx context.Context, domainID, requestID string) (*persistence.AsyncRequestDLQEntry, error)
+		// DeleteFromAsyncRequestDLQ removes a single DLQ entry
+		DeleteFromAsyncRequestDLQ(ctx context.Context, domainID, requestID string) (sql.Result, error)
+		// InsertIntoAsyncRequestQueue inserts a message into a queue, returns a duplicate entry error if the message ID is taken
+		InsertIntoAsyncRequestQueue(ctx context.Context, queueName string, row *persistence.AsyncRequestQueueMessage) (sql.Result, error)
+		// SelectLastAsyncRequestQueueMessageID returns the ID of the last message of a queue, returns sql.ErrNoRows if the queue is empty
+		SelectLastAsyncRequestQueueMessageID(ctx context.Context, queueName string) (int64, error)
+		// RangeSelectFromAsyncRequestQueue returns up to pageSize messages of a queue with messageID greater than exclusiveBeginMessageID
+		RangeSelectFromAsyncRequestQueue(ctx context.Context, queueName string, exclusiveBeginMessageID int64, pageSize int) ([]*persistence.AsyncRequestQueueMessage, error)
+		// RangeDeleteFromAsyncRequestQueue removes all messages of a queue up to and including inclusiveEndMessageID
+		RangeDeleteFromAsyncRequestQueue(ctx context.Context, queueName string, inclusiveEndMessageID int64) (sql.Result, error)
+		// InsertIntoAsyncRequestQueueMetadata creates the metadata row of a queue, returns a duplicate entry error if it exists
+		InsertIntoAsyncRequestQueueMetadata(ctx context.Context, row *persistence.AsyncRequestQueueMetadata) (sql.Result, error)
+		// UpdateAsyncRequestQueueMetadata updates the metadata row of a queue if its version is previousVersion
+		UpdateAsyncRequestQueueMetadata(ctx context.Context, row *persistence.AsyncRequestQueueMetadata, previousVersion int64) (sql.Result, error)
+		// SelectFromAsyncRequestQueueMetadata returns the metadata row of a queue
+		SelectFromAsyncRequestQueueMetadata(ctx context.Context, queueName string) (*persistence.AsyncRequestQueueMetadata, error)
+
+		// ReplaceIntoMapQItems creates or overwrites items of MAPQ queues
+		ReplaceIntoMapQItems(ctx context.Context, rows []MapQItemsRow) (sql.Result, error)
+		// RangeSelectFromMapQItems returns up to pageSize items of a partition with offset in (exclusiveMinOffset, inclusiveMaxOffset] ordered by offset
+		RangeSelectFromMapQItems(ctx context.Context, queueID, partitionPath string, exclusiveMinOffset, inclusiveMaxOffset int64, pageSize int) ([]MapQItemsRow, error)
+		// RangeDeleteFromMapQItems removes all items of a partition up to and including inclusiveMaxOffset
+		RangeDeleteFromMapQItems(ctx context.Context, queueID, partitionPath string, inclusiveMaxOffset int64) (sql.Result, error)
+		// InsertIntoMapQState creates the state row of a queue, returns a duplicate entry error if it exists
+		InsertIntoMapQState(ctx context.Context, row *MapQStateRow) (sql.Result, error)
+		// UpdateMapQState updates the state row of a queue if its version is previousVersion
+		UpdateMapQState(ctx context.Context, row *MapQStateRow, previousVersion int64) (sql.Result, error)
+		// SelectFromMapQState returns the state row of a queue
+		SelectFromMapQState(ctx context.Context, queueID string) (*MapQStateRow, error)
+
+		// InsertIntoShardDistributorNamespaces creates the row of a namespace, returns a duplicate entry error if it exists
+		InsertIntoShardDistributorNamespaces(ctx context.Context, row *ShardDistributorNamespacesRow) (sql.Result, error)
+		// SelectFromShardDistributorNamespaces returns the row of a namespace
+		SelectFromShardDistributorNamespaces(ctx context.Context, namespace string) (*ShardDistributorNamespacesRow, error)
+		// UpdateShardDistributorNamespacesLeader updates the leader of a namespace if its leader version is previousVersion
+		UpdateShardDistributorNamespacesLeader(ctx context.Context, row *ShardDistributorNamespacesRow, previousVersion int64) (sql.Result, error)
+		// UpdateShardDistributorNamespacesRevision increments the revision of a namespace if it is previousRevision
+		// and, unless leaderVersion is 0, its leader version is leaderVersion
+		UpdateShardDistributorNamespacesRevision(ctx context.Context, namespace string, previousRevision, leaderVersion int64) (sql.Result, error)
+		// SelectFromShardDistributorExecutors returns all executors of a namespace
+		SelectFromShardDistributorExecutors(ctx context.Context, namespace string) ([]ShardDistributorExecutorsRow, error)
+		InsertIntoShardDistributorExecutors(ctx context.Context, row *ShardDistributorExecutorsRow) (sql.Result, error)
+		UpdateShardDistributorExecutors(ctx context.Context, row *ShardDistributorExecutorsRow) (sql.Result, error)
+		DeleteFromShardDistributorExecutors(ctx context.Context, namespace, executorID string) (sql.Result, error)
+
 		// The follow provide information about the underlying sql crud implementation
 		SupportsTTL() bool
 		MaxAllowedTTL() (*time.Duration, error)
@@ -0,0 +1,203 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+// ReplaceIntoAsyncRequests creates or overwrites the status row of an async request
+func (mdb *DB) ReplaceIntoAsyncRequests(ctx context.Context, row *persistence.AsyncRequestInfo) error {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, mdb.GetTotalNumDBShards())
+	_, err := mdb.driver.ExecContext(ctx, dbShardID, _replaceIntoAsyncRequestsQuery,
+		row.DomainID,
+		row.RequestID,
+		row.WorkflowID,
+		row.RunID,
+		int32(row.State),
+		row.Failure,
+		mdb.converter.ToDateTime(row.CreatedTime),
+		mdb.converter.ToDateTime(row.LastUpdatedTime),
+		mdb.converter.ToDateTime(row.ExpiryTime),
+	)
+	return err
+}
+
+// SelectFromAsyncRequests returns the status row of an async request
+func (mdb *DB) SelectFromAsyncRequests(ctx context.Context, domainID, requestID string) (*persistence.AsyncRequestInfo, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(domainID, mdb.GetTotalNumDBShards())
+	var row sqlplugin.AsyncRequestsRow
+	if err := mdb.driver.GetContext(ctx, dbShardID, &row, _selectFromAsyncRequestsQuery, domainID, requestID); err != nil {
+		return nil, err
+	}
+	return &persistence.AsyncRequestInfo{
+		DomainID:        row.DomainID,
+		RequestID:       row.RequestID,
+		WorkflowID:      row.WorkflowID,
+		RunID:           row.RunID,
+		State:           types.AsyncRequestState(row.State),
+		Failure:         row.Failure,
+		CreatedTime:     mdb.converter.FromDateTime(row.CreatedTime),
+		LastUpdatedTime: mdb.converter.FromDateTime(row.LastUpdatedTime),
+		ExpiryTime:      mdb.converter.FromDateTime(row.ExpiryTime),
+	}, nil
+}
+
+// ReplaceIntoAsyncRequestDLQ creates or overwrites a DLQ entry
+func (mdb *DB) ReplaceIntoAsyncRequestDLQ(ctx context.Context, row *persistence.AsyncRequestDLQEntry) error {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, mdb.GetTotalNumDBShards())
+	_, err := mdb.driver.ExecContext(ctx, dbShardID, _replaceIntoAsyncRequestDLQQuery,
+		row.DomainID,
+		row.RequestID,
+		row.RequestType,
+		row.WorkflowID,
+		row.Failure,
+		row.Payload,
+		mdb.converter.ToDateTime(row.CreatedTime),
+	)
+	return err
+}
+
+// RangeSelectFromAsyncRequestDLQ returns up to pageSize DLQ entries of a domain ordered by request ID
+func (mdb *DB) RangeSelectFromAsyncRequestDLQ(ctx context.Context, domainID, exclusiveMinRequestID string, pageSize int) ([]*persistence.AsyncRequestDLQEntry, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(domainID, mdb.GetTotalNumDBShards())
+	var rows []sqlplugin.AsyncRequestDLQRow
+	if err := mdb.driver.SelectContext(ctx, dbShardID, &rows, _rangeSelectFromAsyncRequestDLQQuery, domainID, exclusiveMinRequestID, pageSize); err != nil {
+		return nil, err
+	}
+	entries := make([]*persistence.AsyncRequestDLQEntry, 0, len(rows))
+	for i := range rows {
+		entries = append(entries, mdb.fromAsyncRequestDLQRow(&rows[i]))
+	}
+	return entries, nil
+}
+
+// SelectFromAsyncRequestDLQ returns a single DLQ entry
+func (mdb *DB) SelectFromAsyncRequestDLQ(ctx context.Context, domainID, requestID string) (*persistence.AsyncRequestDLQEntry, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(domainID, mdb.GetTotalNumDBShards())
+	var row sqlplugin.AsyncRequestDLQRow
+	if err := mdb.driver.GetContext(ctx, dbShardID, &row, _selectFromAsyncRequestDLQQuery, domainID, requestID); err != nil {
+		return nil, err
+	}
+	return mdb.fromAsyncRequestDLQRow(&row), nil
+}
+
+// DeleteFromAsyncRequestDLQ removes a single DLQ entry
+func (mdb *DB) DeleteFromAsyncRequestDLQ(ctx context.Context, domainID, requestID string) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(domainID, mdb.GetTotalNumDBShards())
+	return mdb.driver.ExecContext(ctx, dbShardID, _deleteFromAsyncRequestDLQQuery, domainID, requestID)
+}
+
+// InsertIntoAsyncRequestQueue inserts a message into a queue
+func (mdb *DB) InsertIntoAsyncRequestQueue(ctx context.Context, queueName string, row *persistence.AsyncRequestQueueMessage) (sql.Result, error) {
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _insertIntoAsyncRequestQueueQuery,
+		queueName,
+		row.MessageID,
+		row.Payload,
+		mdb.converter.ToDateTime(row.CreatedTime),
+	)
+}
+
+// SelectLastAsyncRequestQueueMessageID returns the ID of the last message of a queue
+func (mdb *DB) SelectLastAsyncRequestQueueMessageID(ctx context.Context, queueName string) (int64, error) {
+	var lastMessageID int64
+	err := mdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &lastMessageID, _selectLastAsyncRequestQueueMessageIDQuery, queueName)
+	return lastMessageID, err
+}
+
+// RangeSelectFromAsyncRequestQueue returns up to pageSize messages of a queue ordered by message ID
+func (mdb *DB) RangeSelectFromAsyncRequestQueue(ctx context.Context, queueName string, exclusiveBeginMessageID int64, pageSize int) ([]*persistence.AsyncRequestQueueMessage, error) {
+	var rows []sqlplugin.AsyncRequestQueueRow
+	if err := mdb.driver.SelectContext(ctx, sqlplugin.DbDefaultShard, &rows, _rangeSelectFromAsyncRequestQueueQuery, queueName, exclusiveBeginMessageID, pageSize); err != nil {
+		return nil, err
+	}
+	messages := make([]*persistence.AsyncRequestQueueMessage, 0, len(rows))
+	for _, row := range rows {
+		messages = append(messages, &persistence.AsyncRequestQueueMessage{
+			MessageID:   row.MessageID,
+			Payload:     row.Payload,
+			CreatedTime: mdb.converter.FromDateTime(row.CreatedTime),
+		})
+	}
+	return messages, nil
+}
+
+// RangeDeleteFromAsyncRequestQueue removes all messages of a queue up to and including inclusiveEndMessageID
+func (mdb *DB) RangeDeleteFromAsyncRequestQueue(ctx context.Context, queueName string, inclusiveEndMessageID int64) (sql.Result, error) {
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _rangeDeleteFromAsyncRequestQueueQuery, queueName, inclusiveEndMessageID)
+}
+
+// InsertIntoAsyncRequestQueueMetadata creates the metadata row of a queue
+func (mdb *DB) InsertIntoAsyncRequestQueueMetadata(ctx context.Context, row *persistence.AsyncRequestQueueMetadata) (sql.Result, error) {
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _insertIntoAsyncRequestQueueMetadataQuery,
+		row.QueueName,
+		row.AckLevel,
+		row.LeaseOwner,
+		mdb.converter.ToDateTime(row.LeaseExpiry),
+		row.Version,
+	)
+}
+
+// UpdateAsyncRequestQueueMetadata updates the metadata row of a queue if its version is previousVersion
+func (mdb *DB) UpdateAsyncRequestQueueMetadata(ctx context.Context, row *persistence.AsyncRequestQueueMetadata, previousVersion int64) (sql.Result, error) {
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _updateAsyncRequestQueueMetadataQuery,
+		row.AckLevel,
+		row.LeaseOwner,
+		mdb.converter.ToDateTime(row.LeaseExpiry),
+		row.Version,
+		row.QueueName,
+		previousVersion,
+	)
+}
+
+// SelectFromAsyncRequestQueueMetadata returns the metadata row of a queue
+func (mdb *DB) SelectFromAsyncRequestQueueMetadata(ctx context.Context, queueName string) (*persistence.AsyncRequestQueueMetadata, error) {
+	var row sqlplugin.AsyncRequestQueueMetadataRow
+	if err := mdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &row, _selectFromAsyncRequestQueueMetadataQuery, queueName); err != nil {
+		return nil, err
+	}
+	return &persistence.AsyncRequestQueueMetadata{
+		QueueName:   row.QueueName,
+		AckLevel:    row.AckLevel,
+		LeaseOwner:  row.LeaseOwner,
+		LeaseExpiry: mdb.converter.FromDateTime(row.LeaseExpiry),
+		Version:     row.Version,
+	}, nil
+}
+
+func (mdb *DB) fromAsyncRequestDLQRow(row *sqlplugin.AsyncRequestDLQRow) *persistence.AsyncRequestDLQEntry {
+	return &persistence.AsyncRequestDLQEntry{
+		DomainID:    row.DomainID,
+		RequestID:   row.RequestID,
+		RequestType: row.RequestType,
+		WorkflowID:  row.WorkflowID,
+		Failure:     row.Failure,
+		Payload:     row.Payload,
+		CreatedTime: mdb.converter.FromDateTime(row.CreatedTime),
+	}
+}
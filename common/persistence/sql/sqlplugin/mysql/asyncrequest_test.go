@@ -0,0 +1,254 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package mysql
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/sql/sqldriver"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestAsyncRequests(t *testing.T) {
+	now := time.Now().UTC()
+	info := &persistence.AsyncRequestInfo{
+		DomainID:        "domain-id",
+		RequestID:       "request-id",
+		WorkflowID:      "workflow-id",
+		RunID:           "run-id",
+		State:           types.AsyncRequestStateSucceeded,
+		CreatedTime:     now,
+		LastUpdatedTime: now,
+		ExpiryTime:      now,
+	}
+
+	t.Run("replace", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockDriver := sqldriver.NewMockDriver(ctrl)
+		mdb := &DB{driver: mockDriver, converter: &converter{}, numDBShards: 1}
+		mockDriver.EXPECT().ExecContext(gomock.Any(), 0, _replaceIntoAsyncRequestsQuery,
+			"domain-id", "request-id", "workflow-id", "run-id", int32(types.AsyncRequestStateSucceeded), "", now, now, now).Return(nil, nil)
+		assert.NoError(t, mdb.ReplaceIntoAsyncRequests(context.Background(), info))
+	})
+
+	t.Run("select", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockDriver := sqldriver.NewMockDriver(ctrl)
+		mdb := &DB{driver: mockDriver, converter: &converter{}, numDBShards: 1}
+		mockDriver.EXPECT().GetContext(gomock.Any(), 0, gomock.Any(), _selectFromAsyncRequestsQuery, "domain-id", "request-id").DoAndReturn(
+			func(ctx context.Context, shardID int, r *sqlplugin.AsyncRequestsRow, query string, args ...interface{}) error {
+				*r = sqlplugin.AsyncRequestsRow{
+					DomainID:        "domain-id",
+					RequestID:       "request-id",
+					WorkflowID:      "workflow-id",
+					RunID:           "run-id",
+					State:           int32(types.AsyncRequestStateSucceeded),
+					CreatedTime:     now,
+					LastUpdatedTime: now,
+					ExpiryTime:      now,
+				}
+				return nil
+			},
+		)
+		got, err := mdb.SelectFromAsyncRequests(context.Background(), "domain-id", "request-id")
+		assert.NoError(t, err)
+		assert.Equal(t, info, got)
+	})
+
+	t.Run("select error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockDriver := sqldriver.NewMockDriver(ctrl)
+		mdb := &DB{driver: mockDriver, converter: &converter{}, numDBShards: 1}
+		mockDriver.EXPECT().GetContext(gomock.Any(), 0, gomock.Any(), _selectFromAsyncRequestsQuery, "domain-id", "request-id").Return(errors.New("some error"))
+		_, err := mdb.SelectFromAsyncRequests(context.Background(), "domain-id", "request-id")
+		assert.Error(t, err)
+	})
+}
+
+func TestAsyncRequestDLQ(t *testing.T) {
+	now := time.Now().UTC()
+	entry := &persistence.AsyncRequestDLQEntry{
+		DomainID:    "domain-id",
+		RequestID:   "request-id",
+		RequestType: 1,
+		WorkflowID:  "workflow-id",
+		Failure:     "failure",
+		Payload:     []byte("payload"),
+		CreatedTime: now,
+	}
+	row := sqlplugin.AsyncRequestDLQRow{
+		DomainID:    "domain-id",
+		RequestID:   "request-id",
+		RequestType: 1,
+		WorkflowID:  "workflow-id",
+		Failure:     "failure",
+		Payload:     []byte("payload"),
+		CreatedTime: now,
+	}
+
+	t.Run("replace", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockDriver := sqldriver.NewMockDriver(ctrl)
+		mdb := &DB{driver: mockDriver, converter: &converter{}, numDBShards: 1}
+		mockDriver.EXPECT().ExecContext(gomock.Any(), 0, _replaceIntoAsyncRequestDLQQuery,
+			"domain-id", "request-id", int32(1), "workflow-id", "failure", []byte("payload"), now).Return(nil, errors.New("some error"))
+		assert.Error(t, mdb.ReplaceIntoAsyncRequestDLQ(context.Background(), entry))
+	})
+
+	t.Run("range select", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockDriver := sqldriver.NewMockDriver(ctrl)
+		mdb := &DB{driver: mockDriver, converter: &converter{}, numDBShards: 1}
+		mockDriver.EXPECT().SelectContext(gomock.Any(), 0, gomock.Any(), _rangeSelectFromAsyncRequestDLQQuery, "domain-id", "", 10).DoAndReturn(
+			func(ctx context.Context, shardID int, r *[]sqlplugin.AsyncRequestDLQRow, query string, args ...interface{}) error {
+				*r = []sqlplugin.AsyncRequestDLQRow{row}
+				return nil
+			},
+		)
+		got, err := mdb.RangeSelectFromAsyncRequestDLQ(context.Background(), "domain-id", "", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []*persistence.AsyncRequestDLQEntry{entry}, got)
+	})
+
+	t.Run("select", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockDriver := sqldriver.NewMockDriver(ctrl)
+		mdb := &DB{driver: mockDriver, converter: &converter{}, numDBShards: 1}
+		mockDriver.EXPECT().GetContext(gomock.Any(), 0, gomock.Any(), _selectFromAsyncRequestDLQQuery, "domain-id", "request-id").DoAndReturn(
+			func(ctx context.Context, shardID int, r *sqlplugin.AsyncRequestDLQRow, query string, args ...interface{}) error {
+				*r = row
+				return nil
+			},
+		)
+		got, err := mdb.SelectFromAsyncRequestDLQ(context.Background(), "domain-id", "request-id")
+		assert.NoError(t, err)
+		assert.Equal(t, entry, got)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockDriver := sqldriver.NewMockDriver(ctrl)
+		mdb := &DB{driver: mockDriver, converter: &converter{}, numDBShards: 1}
+		mockDriver.EXPECT().ExecContext(gomock.Any(), 0, _deleteFromAsyncRequestDLQQuery, "domain-id", "request-id").Return(nil, nil)
+		_, err := mdb.DeleteFromAsyncRequestDLQ(context.Background(), "domain-id", "request-id")
+		assert.NoError(t, err)
+	})
+}
+
+func TestAsyncRequestQueue(t *testing.T) {
+	now := time.Now().UTC()
+	message := &persistence.AsyncRequestQueueMessage{MessageID: 11, Payload: []byte("payload"), CreatedTime: now}
+	metadata := &persistence.AsyncRequestQueueMetadata{QueueName: "queue", AckLevel: 10, LeaseOwner: "host-1", LeaseExpiry: now, Version: 3}
+
+	t.Run("insert message", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockDriver := sqldriver.NewMockDriver(ctrl)
+		mdb := &DB{driver: mockDriver, converter: &converter{}, numDBShards: 1}
+		mockDriver.EXPECT().ExecContext(gomock.Any(), sqlplugin.DbDefaultShard, _insertIntoAsyncRequestQueueQuery,
+			"queue", int64(11), []byte("payload"), now).Return(nil, nil)
+		_, err := mdb.InsertIntoAsyncRequestQueue(context.Background(), "queue", message)
+		assert.NoError(t, err)
+	})
+
+	t.Run("select last message ID", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockDriver := sqldriver.NewMockDriver(ctrl)
+		mdb := &DB{driver: mockDriver, converter: &converter{}, numDBShards: 1}
+		mockDriver.EXPECT().GetContext(gomock.Any(), sqlplugin.DbDefaultShard, gomock.Any(), _selectLastAsyncRequestQueueMessageIDQuery, "queue").DoAndReturn(
+			func(ctx context.Context, shardID int, r *int64, query string, args ...interface{}) error {
+				*r = 11
+				return nil
+			},
+		)
+		got, err := mdb.SelectLastAsyncRequestQueueMessageID(context.Background(), "queue")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(11), got)
+	})
+
+	t.Run("range select", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockDriver := sqldriver.NewMockDriver(ctrl)
+		mdb := &DB{driver: mockDriver, converter: &converter{}, numDBShards: 1}
+		mockDriver.EXPECT().SelectContext(gomock.Any(), sqlplugin.DbDefaultShard, gomock.Any(), _rangeSelectFromAsyncRequestQueueQuery, "queue", int64(10), 100).DoAndReturn(
+			func(ctx context.Context, shardID int, r *[]sqlplugin.AsyncRequestQueueRow, query string, args ...interface{}) error {
+				*r = []sqlplugin.AsyncRequestQueueRow{{QueueName: "queue", MessageID: 11, Payload: []byte("payload"), CreatedTime: now}}
+				return nil
+			},
+		)
+		got, err := mdb.RangeSelectFromAsyncRequestQueue(context.Background(), "queue", 10, 100)
+		assert.NoError(t, err)
+		assert.Equal(t, []*persistence.AsyncRequestQueueMessage{message}, got)
+	})
+
+	t.Run("range delete", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockDriver := sqldriver.NewMockDriver(ctrl)
+		mdb := &DB{driver: mockDriver, converter: &converter{}, numDBShards: 1}
+		mockDriver.EXPECT().ExecContext(gomock.Any(), sqlplugin.DbDefaultShard, _rangeDeleteFromAsyncRequestQueueQuery, "queue", int64(11)).Return(nil, nil)
+		_, err := mdb.RangeDeleteFromAsyncRequestQueue(context.Background(), "queue", 11)
+		assert.NoError(t, err)
+	})
+
+	t.Run("insert metadata", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockDriver := sqldriver.NewMockDriver(ctrl)
+		mdb := &DB{driver: mockDriver, converter: &converter{}, numDBShards: 1}
+		mockDriver.EXPECT().ExecContext(gomock.Any(), sqlplugin.DbDefaultShard, _insertIntoAsyncRequestQueueMetadataQuery,
+			"queue", int64(10), "host-1", now, int64(3)).Return(nil, nil)
+		_, err := mdb.InsertIntoAsyncRequestQueueMetadata(context.Background(), metadata)
+		assert.NoError(t, err)
+	})
+
+	t.Run("update metadata", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockDriver := sqldriver.NewMockDriver(ctrl)
+		mdb := &DB{driver: mockDriver, converter: &converter{}, numDBShards: 1}
+		mockDriver.EXPECT().ExecContext(gomock.Any(), sqlplugin.DbDefaultShard, _updateAsyncRequestQueueMetadataQuery,
+			int64(10), "host-1", now, int64(3), "queue", int64(2)).Return(nil, nil)
+		_, err := mdb.UpdateAsyncRequestQueueMetadata(context.Background(), metadata, 2)
+		assert.NoError(t, err)
+	})
+
+	t.Run("select metadata", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockDriver := sqldriver.NewMockDriver(ctrl)
+		mdb := &DB{driver: mockDriver, converter: &converter{}, numDBShards: 1}
+		mockDriver.EXPECT().GetContext(gomock.Any(), sqlplugin.DbDefaultShard, gomock.Any(), _selectFromAsyncRequestQueueMetadataQuery, "queue").DoAndReturn(
+			func(ctx context.Context, shardID int, r *sqlplugin.AsyncRequestQueueMetadataRow, query string, args ...interface{}) error {
+				*r = sqlplugin.AsyncRequestQueueMetadataRow{QueueName: "queue", AckLevel: 10, LeaseOwner: "host-1", LeaseExpiry: now, Version: 3}
+				return nil
+			},
+		)
+		got, err := mdb.SelectFromAsyncRequestQueueMetadata(context.Background(), "queue")
+		assert.NoError(t, err)
+		assert.Equal(t, metadata, got)
+	})
+}
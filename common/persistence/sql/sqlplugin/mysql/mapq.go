@@ -0,0 +1,73 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+// ReplaceIntoMapQItems creates or overwrites items of MAPQ queues
+func (mdb *DB) ReplaceIntoMapQItems(ctx context.Context, rows []sqlplugin.MapQItemsRow) (sql.Result, error) {
+	return mdb.driver.NamedExecContext(ctx, sqlplugin.DbDefaultShard, _replaceIntoMapQItemsQuery, rows)
+}
+
+// RangeSelectFromMapQItems returns up to pageSize items of a partition ordered by offset
+func (mdb *DB) RangeSelectFromMapQItems(
+	ctx context.Context,
+	queueID string,
+	partitionPath string,
+	exclusiveMinOffset int64,
+	inclusiveMaxOffset int64,
+	pageSize int,
+) ([]sqlplugin.MapQItemsRow, error) {
+	var rows []sqlplugin.MapQItemsRow
+	err := mdb.driver.SelectContext(ctx, sqlplugin.DbDefaultShard, &rows, _rangeSelectFromMapQItemsQuery, queueID, partitionPath, exclusiveMinOffset, inclusiveMaxOffset, pageSize)
+	return rows, err
+}
+
+// RangeDeleteFromMapQItems removes all items of a partition up to and including inclusiveMaxOffset
+func (mdb *DB) RangeDeleteFromMapQItems(ctx context.Context, queueID, partitionPath string, inclusiveMaxOffset int64) (sql.Result, error) {
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _rangeDeleteFromMapQItemsQuery, queueID, partitionPath, inclusiveMaxOffset)
+}
+
+// InsertIntoMapQState creates the state row of a queue
+func (mdb *DB) InsertIntoMapQState(ctx context.Context, row *sqlplugin.MapQStateRow) (sql.Result, error) {
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _insertIntoMapQStateQuery, row.QueueID, row.State, row.StateEncoding, row.Version)
+}
+
+// UpdateMapQState updates the state row of a queue if its version is previousVersion
+func (mdb *DB) UpdateMapQState(ctx context.Context, row *sqlplugin.MapQStateRow, previousVersion int64) (sql.Result, error) {
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _updateMapQStateQuery, row.State, row.StateEncoding, row.Version, row.QueueID, previousVersion)
+}
+
+// SelectFromMapQState returns the state row of a queue
+func (mdb *DB) SelectFromMapQState(ctx context.Context, queueID string) (*sqlplugin.MapQStateRow, error) {
+	var row sqlplugin.MapQStateRow
+	if err := mdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &row, _selectFromMapQStateQuery, queueID); err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
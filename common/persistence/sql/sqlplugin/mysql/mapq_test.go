@@ -0,0 +1,99 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package mysql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/persistence/sql/sqldriver"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+func TestMapQ(t *testing.T) {
+	items := []sqlplugin.MapQItemsRow{{QueueID: "queue", PartitionPath: "*/timer", ItemOffset: 11, Payload: []byte("payload")}}
+	state := &sqlplugin.MapQStateRow{QueueID: "queue", State: []byte("{}"), StateEncoding: "json", Version: 3}
+
+	newDB := func(t *testing.T) (*DB, *sqldriver.MockDriver) {
+		ctrl := gomock.NewController(t)
+		mockDriver := sqldriver.NewMockDriver(ctrl)
+		return &DB{driver: mockDriver, converter: &converter{}, numDBShards: 1}, mockDriver
+	}
+
+	t.Run("replace items", func(t *testing.T) {
+		mdb, mockDriver := newDB(t)
+		mockDriver.EXPECT().NamedExecContext(gomock.Any(), sqlplugin.DbDefaultShard, _replaceIntoMapQItemsQuery, items).Return(nil, nil)
+		_, err := mdb.ReplaceIntoMapQItems(context.Background(), items)
+		assert.NoError(t, err)
+	})
+
+	t.Run("range select items", func(t *testing.T) {
+		mdb, mockDriver := newDB(t)
+		mockDriver.EXPECT().SelectContext(gomock.Any(), sqlplugin.DbDefaultShard, gomock.Any(), _rangeSelectFromMapQItemsQuery, "queue", "*/timer", int64(10), int64(20), 100).DoAndReturn(
+			func(ctx context.Context, shardID int, r *[]sqlplugin.MapQItemsRow, query string, args ...interface{}) error {
+				*r = items
+				return nil
+			},
+		)
+		got, err := mdb.RangeSelectFromMapQItems(context.Background(), "queue", "*/timer", 10, 20, 100)
+		assert.NoError(t, err)
+		assert.Equal(t, items, got)
+	})
+
+	t.Run("range delete items", func(t *testing.T) {
+		mdb, mockDriver := newDB(t)
+		mockDriver.EXPECT().ExecContext(gomock.Any(), sqlplugin.DbDefaultShard, _rangeDeleteFromMapQItemsQuery, "queue", "*/timer", int64(11)).Return(nil, nil)
+		_, err := mdb.RangeDeleteFromMapQItems(context.Background(), "queue", "*/timer", 11)
+		assert.NoError(t, err)
+	})
+
+	t.Run("insert state", func(t *testing.T) {
+		mdb, mockDriver := newDB(t)
+		mockDriver.EXPECT().ExecContext(gomock.Any(), sqlplugin.DbDefaultShard, _insertIntoMapQStateQuery, "queue", []byte("{}"), "json", int64(3)).Return(nil, nil)
+		_, err := mdb.InsertIntoMapQState(context.Background(), state)
+		assert.NoError(t, err)
+	})
+
+	t.Run("update state", func(t *testing.T) {
+		mdb, mockDriver := newDB(t)
+		mockDriver.EXPECT().ExecContext(gomock.Any(), sqlplugin.DbDefaultShard, _updateMapQStateQuery, []byte("{}"), "json", int64(3), "queue", int64(2)).Return(nil, nil)
+		_, err := mdb.UpdateMapQState(context.Background(), state, 2)
+		assert.NoError(t, err)
+	})
+
+	t.Run("select state", func(t *testing.T) {
+		mdb, mockDriver := newDB(t)
+		mockDriver.EXPECT().GetContext(gomock.Any(), sqlplugin.DbDefaultShard, gomock.Any(), _selectFromMapQStateQuery, "queue").DoAndReturn(
+			func(ctx context.Context, shardID int, r *sqlplugin.MapQStateRow, query string, args ...interface{}) error {
+				*r = *state
+				return nil
+			},
+		)
+		got, err := mdb.SelectFromMapQState(context.Background(), "queue")
+		assert.NoError(t, err)
+		assert.Equal(t, state, got)
+	})
+}
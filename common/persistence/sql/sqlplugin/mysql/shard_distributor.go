@@ -0,0 +1,118 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+// InsertIntoShardDistributorNamespaces creates the row of a namespace
+func (mdb *DB) InsertIntoShardDistributorNamespaces(ctx context.Context, row *sqlplugin.ShardDistributorNamespacesRow) (sql.Result, error) {
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _insertIntoShardDistributorNamespacesQuery,
+		row.Namespace,
+		row.LeaderID,
+		mdb.converter.ToDateTime(row.LeaseExpiry),
+		row.LeaderVersion,
+		row.Revision,
+	)
+}
+
+// SelectFromShardDistributorNamespaces returns the row of a namespace
+func (mdb *DB) SelectFromShardDistributorNamespaces(ctx context.Context, namespace string) (*sqlplugin.ShardDistributorNamespacesRow, error) {
+	var row sqlplugin.ShardDistributorNamespacesRow
+	if err := mdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &row, _selectFromShardDistributorNamespacesQuery, namespace); err != nil {
+		return nil, err
+	}
+	row.LeaseExpiry = mdb.converter.FromDateTime(row.LeaseExpiry)
+	return &row, nil
+}
+
+// UpdateShardDistributorNamespacesLeader updates the leader of a namespace if its leader version is previousVersion
+func (mdb *DB) UpdateShardDistributorNamespacesLeader(ctx context.Context, row *sqlplugin.ShardDistributorNamespacesRow, previousVersion int64) (sql.Result, error) {
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _updateShardDistributorNamespacesLeaderQuery,
+		row.LeaderID,
+		mdb.converter.ToDateTime(row.LeaseExpiry),
+		row.LeaderVersion,
+		row.Namespace,
+		previousVersion,
+	)
+}
+
+// UpdateShardDistributorNamespacesRevision increments the revision of a namespace if it is previousRevision
+// and, unless leaderVersion is 0, its leader version is leaderVersion
+func (mdb *DB) UpdateShardDistributorNamespacesRevision(ctx context.Context, namespace string, previousRevision, leaderVersion int64) (sql.Result, error) {
+	if leaderVersion > 0 {
+		return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _updateShardDistributorNamespacesRevisionWithLeaderQuery, namespace, previousRevision, leaderVersion)
+	}
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _updateShardDistributorNamespacesRevisionQuery, namespace, previousRevision)
+}
+
+// SelectFromShardDistributorExecutors returns all executors of a namespace
+func (mdb *DB) SelectFromShardDistributorExecutors(ctx context.Context, namespace string) ([]sqlplugin.ShardDistributorExecutorsRow, error) {
+	var rows []sqlplugin.ShardDistributorExecutorsRow
+	if err := mdb.driver.SelectContext(ctx, sqlplugin.DbDefaultShard, &rows, _selectFromShardDistributorExecutorsQuery, namespace); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].LastHeartbeat = mdb.converter.FromDateTime(rows[i].LastHeartbeat)
+	}
+	return rows, nil
+}
+
+// InsertIntoShardDistributorExecutors creates the row of an executor
+func (mdb *DB) InsertIntoShardDistributorExecutors(ctx context.Context, row *sqlplugin.ShardDistributorExecutorsRow) (sql.Result, error) {
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _insertIntoShardDistributorExecutorsQuery,
+		row.Namespace,
+		row.ExecutorID,
+		mdb.converter.ToDateTime(row.LastHeartbeat),
+		row.State,
+		row.ReportedShards,
+		row.ReportedShardsEncoding,
+		row.AssignedShards,
+		row.AssignedShardsEncoding,
+		row.AssignedRevision,
+	)
+}
+
+// UpdateShardDistributorExecutors overwrites the row of an executor
+func (mdb *DB) UpdateShardDistributorExecutors(ctx context.Context, row *sqlplugin.ShardDistributorExecutorsRow) (sql.Result, error) {
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _updateShardDistributorExecutorsQuery,
+		mdb.converter.ToDateTime(row.LastHeartbeat),
+		row.State,
+		row.ReportedShards,
+		row.ReportedShardsEncoding,
+		row.AssignedShards,
+		row.AssignedShardsEncoding,
+		row.AssignedRevision,
+		row.Namespace,
+		row.ExecutorID,
+	)
+}
+
+// DeleteFromShardDistributorExecutors removes the row of an executor
+func (mdb *DB) DeleteFromShardDistributorExecutors(ctx context.Context, namespace, executorID string) (sql.Result, error) {
+	return mdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _deleteFromShardDistributorExecutorsQuery, namespace, executorID)
+}
@@ -0,0 +1,60 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package mysql
+
+const (
+	_insertIntoShardDistributorNamespacesQuery = `INSERT INTO shard_distributor_namespaces ` +
+		`(namespace, leader_id, lease_expiry, leader_version, revision) ` +
+		`VALUES (?, ?, ?, ?, ?)`
+
+	_selectFromShardDistributorNamespacesQuery = `SELECT namespace, leader_id, lease_expiry, leader_version, revision ` +
+		`FROM shard_distributor_namespaces WHERE namespace = ?`
+
+	_updateShardDistributorNamespacesLeaderQuery = `UPDATE shard_distributor_namespaces ` +
+		`SET leader_id = ?, lease_expiry = ?, leader_version = ? ` +
+		`WHERE namespace = ? AND leader_version = ?`
+
+	_updateShardDistributorNamespacesRevisionQuery = `UPDATE shard_distributor_namespaces ` +
+		`SET revision = revision + 1 ` +
+		`WHERE namespace = ? AND revision = ?`
+
+	_updateShardDistributorNamespacesRevisionWithLeaderQuery = `UPDATE shard_distributor_namespaces ` +
+		`SET revision = revision + 1 ` +
+		`WHERE namespace = ? AND revision = ? AND leader_version = ?`
+
+	_selectFromShardDistributorExecutorsQuery = `SELECT namespace, executor_id, last_heartbeat, state, ` +
+		`reported_shards, reported_shards_encoding, assigned_shards, assigned_shards_encoding, assigned_revision ` +
+		`FROM shard_distributor_executors WHERE namespace = ?`
+
+	_insertIntoShardDistributorExecutorsQuery = `INSERT INTO shard_distributor_executors ` +
+		`(namespace, executor_id, last_heartbeat, state, ` +
+		`reported_shards, reported_shards_encoding, assigned_shards, assigned_shards_encoding, assigned_revision) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	_updateShardDistributorExecutorsQuery = `UPDATE shard_distributor_executors ` +
+		`SET last_heartbeat = ?, state = ?, ` +
+		`reported_shards = ?, reported_shards_encoding = ?, assigned_shards = ?, assigned_shards_encoding = ?, assigned_revision = ? ` +
+		`WHERE namespace = ? AND executor_id = ?`
+
+	_deleteFromShardDistributorExecutorsQuery = `DELETE FROM shard_distributor_executors WHERE namespace = ? AND executor_id = ?`
+)
@@ -0,0 +1,136 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package mysql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/persistence/sql/sqldriver"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+func TestShardDistributor(t *testing.T) {
+	now := time.Unix(1000, 0).UTC()
+	namespace := &sqlplugin.ShardDistributorNamespacesRow{Namespace: "ns", LeaderID: "leader", LeaseExpiry: now, LeaderVersion: 3, Revision: 5}
+	executor := &sqlplugin.ShardDistributorExecutorsRow{
+		Namespace:              "ns",
+		ExecutorID:             "executor-1",
+		LastHeartbeat:          now,
+		State:                  "ACTIVE",
+		ReportedShards:         []byte("{}"),
+		ReportedShardsEncoding: "json",
+		AssignedShards:         []byte("{}"),
+		AssignedShardsEncoding: "json",
+		AssignedRevision:       4,
+	}
+
+	newDB := func(t *testing.T) (*DB, *sqldriver.MockDriver) {
+		ctrl := gomock.NewController(t)
+		mockDriver := sqldriver.NewMockDriver(ctrl)
+		return &DB{driver: mockDriver, converter: &converter{}, numDBShards: 1}, mockDriver
+	}
+
+	t.Run("insert namespace", func(t *testing.T) {
+		mdb, mockDriver := newDB(t)
+		mockDriver.EXPECT().ExecContext(gomock.Any(), sqlplugin.DbDefaultShard, _insertIntoShardDistributorNamespacesQuery,
+			"ns", "", minMySQLDateTime, int64(0), int64(0)).Return(nil, nil)
+		_, err := mdb.InsertIntoShardDistributorNamespaces(context.Background(), &sqlplugin.ShardDistributorNamespacesRow{Namespace: "ns"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("select namespace", func(t *testing.T) {
+		mdb, mockDriver := newDB(t)
+		mockDriver.EXPECT().GetContext(gomock.Any(), sqlplugin.DbDefaultShard, gomock.Any(), _selectFromShardDistributorNamespacesQuery, "ns").DoAndReturn(
+			func(ctx context.Context, shardID int, r *sqlplugin.ShardDistributorNamespacesRow, query string, args ...interface{}) error {
+				*r = *namespace
+				return nil
+			},
+		)
+		got, err := mdb.SelectFromShardDistributorNamespaces(context.Background(), "ns")
+		assert.NoError(t, err)
+		assert.Equal(t, namespace, got)
+	})
+
+	t.Run("update leader", func(t *testing.T) {
+		mdb, mockDriver := newDB(t)
+		mockDriver.EXPECT().ExecContext(gomock.Any(), sqlplugin.DbDefaultShard, _updateShardDistributorNamespacesLeaderQuery,
+			"leader", now, int64(3), "ns", int64(2)).Return(nil, nil)
+		_, err := mdb.UpdateShardDistributorNamespacesLeader(context.Background(), namespace, 2)
+		assert.NoError(t, err)
+	})
+
+	t.Run("update revision", func(t *testing.T) {
+		mdb, mockDriver := newDB(t)
+		mockDriver.EXPECT().ExecContext(gomock.Any(), sqlplugin.DbDefaultShard, _updateShardDistributorNamespacesRevisionQuery, "ns", int64(5)).Return(nil, nil)
+		_, err := mdb.UpdateShardDistributorNamespacesRevision(context.Background(), "ns", 5, 0)
+		assert.NoError(t, err)
+	})
+
+	t.Run("update revision with leader", func(t *testing.T) {
+		mdb, mockDriver := newDB(t)
+		mockDriver.EXPECT().ExecContext(gomock.Any(), sqlplugin.DbDefaultShard, _updateShardDistributorNamespacesRevisionWithLeaderQuery, "ns", int64(5), int64(3)).Return(nil, nil)
+		_, err := mdb.UpdateShardDistributorNamespacesRevision(context.Background(), "ns", 5, 3)
+		assert.NoError(t, err)
+	})
+
+	t.Run("select executors", func(t *testing.T) {
+		mdb, mockDriver := newDB(t)
+		mockDriver.EXPECT().SelectContext(gomock.Any(), sqlplugin.DbDefaultShard, gomock.Any(), _selectFromShardDistributorExecutorsQuery, "ns").DoAndReturn(
+			func(ctx context.Context, shardID int, r *[]sqlplugin.ShardDistributorExecutorsRow, query string, args ...interface{}) error {
+				*r = []sqlplugin.ShardDistributorExecutorsRow{*executor}
+				return nil
+			},
+		)
+		got, err := mdb.SelectFromShardDistributorExecutors(context.Background(), "ns")
+		assert.NoError(t, err)
+		assert.Equal(t, []sqlplugin.ShardDistributorExecutorsRow{*executor}, got)
+	})
+
+	t.Run("insert executor", func(t *testing.T) {
+		mdb, mockDriver := newDB(t)
+		mockDriver.EXPECT().ExecContext(gomock.Any(), sqlplugin.DbDefaultShard, _insertIntoShardDistributorExecutorsQuery,
+			"ns", "executor-1", now, "ACTIVE", []byte("{}"), "json", []byte("{}"), "json", int64(4)).Return(nil, nil)
+		_, err := mdb.InsertIntoShardDistributorExecutors(context.Background(), executor)
+		assert.NoError(t, err)
+	})
+
+	t.Run("update executor", func(t *testing.T) {
+		mdb, mockDriver := newDB(t)
+		mockDriver.EXPECT().ExecContext(gomock.Any(), sqlplugin.DbDefaultShard, _updateShardDistributorExecutorsQuery,
+			now, "ACTIVE", []byte("{}"), "json", []byte("{}"), "json", int64(4), "ns", "executor-1").Return(nil, nil)
+		_, err := mdb.UpdateShardDistributorExecutors(context.Background(), executor)
+		assert.NoError(t, err)
+	})
+
+	t.Run("delete executor", func(t *testing.T) {
+		mdb, mockDriver := newDB(t)
+		mockDriver.EXPECT().ExecContext(gomock.Any(), sqlplugin.DbDefaultShard, _deleteFromShardDistributorExecutorsQuery, "ns", "executor-1").Return(nil, nil)
+		_, err := mdb.DeleteFromShardDistributorExecutors(context.Background(), "ns", "executor-1")
+		assert.NoError(t, err)
+	})
+}
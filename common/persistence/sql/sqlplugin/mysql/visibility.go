@@ -71,6 +71,9 @@ const (
 		 WHERE domain_id = ? AND close_status IS NOT NULL
 		 AND run_id = ?`
 
+	templateUpdateWorkflowExecutionMemo = `UPDATE executions_visibility SET memo = ?, encoding = ?, update_time = ? ` +
+		`WHERE domain_id = ? AND run_id = ? AND close_status IS NULL`
+
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE domain_id=? AND run_id=?"
 )
 
@@ -128,6 +131,19 @@ func (mdb *DB) ReplaceIntoVisibility(ctx context.Context, row *sqlplugin.Visibil
 	}
 }
 
+// UpdateVisibilityMemo updates the memo of an open workflow's row in visibility table
+func (mdb *DB) UpdateVisibilityMemo(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, mdb.GetTotalNumDBShards())
+	return mdb.driver.ExecContext(ctx,
+		dbShardID,
+		templateUpdateWorkflowExecutionMemo,
+		row.Memo,
+		row.Encoding,
+		row.UpdateTime,
+		row.DomainID,
+		row.RunID)
+}
+
 // DeleteFromVisibility deletes a row from visibility table if it exist
 func (mdb *DB) DeleteFromVisibility(ctx context.Context, filter *sqlplugin.VisibilityFilter) (sql.Result, error) {
 	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
@@ -0,0 +1,255 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	_replaceIntoAsyncRequestsQuery = `INSERT INTO async_requests ` +
+		`(domain_id, request_id, workflow_id, run_id, state, failure, created_time, last_updated_time, expiry_time) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) ` +
+		`ON CONFLICT (domain_id, request_id) DO UPDATE SET ` +
+		`workflow_id = excluded.workflow_id, run_id = excluded.run_id, state = excluded.state, failure = excluded.failure, ` +
+		`created_time = excluded.created_time, last_updated_time = excluded.last_updated_time, expiry_time = excluded.expiry_time`
+
+	_selectFromAsyncRequestsQuery = `SELECT domain_id, request_id, workflow_id, run_id, state, failure, created_time, last_updated_time, expiry_time ` +
+		`FROM async_requests WHERE domain_id = $1 AND request_id = $2`
+
+	_replaceIntoAsyncRequestDLQQuery = `INSERT INTO async_request_dlq ` +
+		`(domain_id, request_id, request_type, workflow_id, failure, payload, created_time) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7) ` +
+		`ON CONFLICT (domain_id, request_id) DO UPDATE SET ` +
+		`request_type = excluded.request_type, workflow_id = excluded.workflow_id, failure = excluded.failure, ` +
+		`payload = excluded.payload, created_time = excluded.created_time`
+
+	_asyncRequestDLQColumns = `SELECT domain_id, request_id, request_type, workflow_id, failure, payload, created_time FROM async_request_dlq `
+
+	_rangeSelectFromAsyncRequestDLQQuery = _asyncRequestDLQColumns +
+		`WHERE domain_id = $1 AND request_id > $2 ORDER BY request_id LIMIT $3`
+
+	_selectFromAsyncRequestDLQQuery = _asyncRequestDLQColumns +
+		`WHERE domain_id = $1 AND request_id = $2`
+
+	_deleteFromAsyncRequestDLQQuery = `DELETE FROM async_request_dlq WHERE domain_id = $1 AND request_id = $2`
+
+	_insertIntoAsyncRequestQueueQuery = `INSERT INTO async_request_queue ` +
+		`(queue_name, message_id, payload, created_time) ` +
+		`VALUES ($1, $2, $3, $4)`
+
+	_selectLastAsyncRequestQueueMessageIDQuery = `SELECT message_id FROM async_request_queue ` +
+		`WHERE queue_name = $1 ORDER BY message_id DESC LIMIT 1`
+
+	_rangeSelectFromAsyncRequestQueueQuery = `SELECT queue_name, message_id, payload, created_time FROM async_request_queue ` +
+		`WHERE queue_name = $1 AND message_id > $2 ORDER BY message_id LIMIT $3`
+
+	_rangeDeleteFromAsyncRequestQueueQuery = `DELETE FROM async_request_queue WHERE queue_name = $1 AND message_id <= $2`
+
+	_insertIntoAsyncRequestQueueMetadataQuery = `INSERT INTO async_request_queue_metadata ` +
+		`(queue_name, ack_level, lease_owner, lease_expiry, version) ` +
+		`VALUES ($1, $2, $3, $4, $5)`
+
+	_updateAsyncRequestQueueMetadataQuery = `UPDATE async_request_queue_metadata ` +
+		`SET ack_level = $1, lease_owner = $2, lease_expiry = $3, version = $4 ` +
+		`WHERE queue_name = $5 AND version = $6`
+
+	_selectFromAsyncRequestQueueMetadataQuery = `SELECT queue_name, ack_level, lease_owner, lease_expiry, version ` +
+		`FROM async_request_queue_metadata WHERE queue_name = $1`
+)
+
+// ReplaceIntoAsyncRequests creates or overwrites the status row of an async request
+func (pdb *db) ReplaceIntoAsyncRequests(ctx context.Context, row *persistence.AsyncRequestInfo) error {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, pdb.GetTotalNumDBShards())
+	_, err := pdb.driver.ExecContext(ctx, dbShardID, _replaceIntoAsyncRequestsQuery,
+		row.DomainID,
+		row.RequestID,
+		row.WorkflowID,
+		row.RunID,
+		int32(row.State),
+		row.Failure,
+		pdb.converter.ToPostgresDateTime(row.CreatedTime),
+		pdb.converter.ToPostgresDateTime(row.LastUpdatedTime),
+		pdb.converter.ToPostgresDateTime(row.ExpiryTime),
+	)
+	return err
+}
+
+// SelectFromAsyncRequests returns the status row of an async request
+func (pdb *db) SelectFromAsyncRequests(ctx context.Context, domainID, requestID string) (*persistence.AsyncRequestInfo, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(domainID, pdb.GetTotalNumDBShards())
+	var row sqlplugin.AsyncRequestsRow
+	if err := pdb.driver.GetContext(ctx, dbShardID, &row, _selectFromAsyncRequestsQuery, domainID, requestID); err != nil {
+		return nil, err
+	}
+	return &persistence.AsyncRequestInfo{
+		DomainID:        row.DomainID,
+		RequestID:       row.RequestID,
+		WorkflowID:      row.WorkflowID,
+		RunID:           row.RunID,
+		State:           types.AsyncRequestState(row.State),
+		Failure:         row.Failure,
+		CreatedTime:     pdb.converter.FromPostgresDateTime(row.CreatedTime),
+		LastUpdatedTime: pdb.converter.FromPostgresDateTime(row.LastUpdatedTime),
+		ExpiryTime:      pdb.converter.FromPostgresDateTime(row.ExpiryTime),
+	}, nil
+}
+
+// ReplaceIntoAsyncRequestDLQ creates or overwrites a DLQ entry
+func (pdb *db) ReplaceIntoAsyncRequestDLQ(ctx context.Context, row *persistence.AsyncRequestDLQEntry) error {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, pdb.GetTotalNumDBShards())
+	_, err := pdb.driver.ExecContext(ctx, dbShardID, _replaceIntoAsyncRequestDLQQuery,
+		row.DomainID,
+		row.RequestID,
+		row.RequestType,
+		row.WorkflowID,
+		row.Failure,
+		row.Payload,
+		pdb.converter.ToPostgresDateTime(row.CreatedTime),
+	)
+	return err
+}
+
+// RangeSelectFromAsyncRequestDLQ returns up to pageSize DLQ entries of a domain ordered by request ID
+func (pdb *db) RangeSelectFromAsyncRequestDLQ(ctx context.Context, domainID, exclusiveMinRequestID string, pageSize int) ([]*persistence.AsyncRequestDLQEntry, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(domainID, pdb.GetTotalNumDBShards())
+	var rows []sqlplugin.AsyncRequestDLQRow
+	if err := pdb.driver.SelectContext(ctx, dbShardID, &rows, _rangeSelectFromAsyncRequestDLQQuery, domainID, exclusiveMinRequestID, pageSize); err != nil {
+		return nil, err
+	}
+	entries := make([]*persistence.AsyncRequestDLQEntry, 0, len(rows))
+	for i := range rows {
+		entries = append(entries, pdb.fromAsyncRequestDLQRow(&rows[i]))
+	}
+	return entries, nil
+}
+
+// SelectFromAsyncRequestDLQ returns a single DLQ entry
+func (pdb *db) SelectFromAsyncRequestDLQ(ctx context.Context, domainID, requestID string) (*persistence.AsyncRequestDLQEntry, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(domainID, pdb.GetTotalNumDBShards())
+	var row sqlplugin.AsyncRequestDLQRow
+	if err := pdb.driver.GetContext(ctx, dbShardID, &row, _selectFromAsyncRequestDLQQuery, domainID, requestID); err != nil {
+		return nil, err
+	}
+	return pdb.fromAsyncRequestDLQRow(&row), nil
+}
+
+// DeleteFromAsyncRequestDLQ removes a single DLQ entry
+func (pdb *db) DeleteFromAsyncRequestDLQ(ctx context.Context, domainID, requestID string) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(domainID, pdb.GetTotalNumDBShards())
+	return pdb.driver.ExecContext(ctx, dbShardID, _deleteFromAsyncRequestDLQQuery, domainID, requestID)
+}
+
+// InsertIntoAsyncRequestQueue inserts a message into a queue
+func (pdb *db) InsertIntoAsyncRequestQueue(ctx context.Context, queueName string, row *persistence.AsyncRequestQueueMessage) (sql.Result, error) {
+	return pdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _insertIntoAsyncRequestQueueQuery,
+		queueName,
+		row.MessageID,
+		row.Payload,
+		pdb.converter.ToPostgresDateTime(row.CreatedTime),
+	)
+}
+
+// SelectLastAsyncRequestQueueMessageID returns the ID of the last message of a queue
+func (pdb *db) SelectLastAsyncRequestQueueMessageID(ctx context.Context, queueName string) (int64, error) {
+	var lastMessageID int64
+	err := pdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &lastMessageID, _selectLastAsyncRequestQueueMessageIDQuery, queueName)
+	return lastMessageID, err
+}
+
+// RangeSelectFromAsyncRequestQueue returns up to pageSize messages of a queue ordered by message ID
+func (pdb *db) RangeSelectFromAsyncRequestQueue(ctx context.Context, queueName string, exclusiveBeginMessageID int64, pageSize int) ([]*persistence.AsyncRequestQueueMessage, error) {
+	var rows []sqlplugin.AsyncRequestQueueRow
+	if err := pdb.driver.SelectContext(ctx, sqlplugin.DbDefaultShard, &rows, _rangeSelectFromAsyncRequestQueueQuery, queueName, exclusiveBeginMessageID, pageSize); err != nil {
+		return nil, err
+	}
+	messages := make([]*persistence.AsyncRequestQueueMessage, 0, len(rows))
+	for _, row := range rows {
+		messages = append(messages, &persistence.AsyncRequestQueueMessage{
+			MessageID:   row.MessageID,
+			Payload:     row.Payload,
+			CreatedTime: pdb.converter.FromPostgresDateTime(row.CreatedTime),
+		})
+	}
+	return messages, nil
+}
+
+// RangeDeleteFromAsyncRequestQueue removes all messages of a queue up to and including inclusiveEndMessageID
+func (pdb *db) RangeDeleteFromAsyncRequestQueue(ctx context.Context, queueName string, inclusiveEndMessageID int64) (sql.Result, error) {
+	return pdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _rangeDeleteFromAsyncRequestQueueQuery, queueName, inclusiveEndMessageID)
+}
+
+// InsertIntoAsyncRequestQueueMetadata creates the metadata row of a queue
+func (pdb *db) InsertIntoAsyncRequestQueueMetadata(ctx context.Context, row *persistence.AsyncRequestQueueMetadata) (sql.Result, error) {
+	return pdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _insertIntoAsyncRequestQueueMetadataQuery,
+		row.QueueName,
+		row.AckLevel,
+		row.LeaseOwner,
+		pdb.converter.ToPostgresDateTime(row.LeaseExpiry),
+		row.Version,
+	)
+}
+
+// UpdateAsyncRequestQueueMetadata updates the metadata row of a queue if its version is previousVersion
+func (pdb *db) UpdateAsyncRequestQueueMetadata(ctx context.Context, row *persistence.AsyncRequestQueueMetadata, previousVersion int64) (sql.Result, error) {
+	return pdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _updateAsyncRequestQueueMetadataQuery,
+		row.AckLevel,
+		row.LeaseOwner,
+		pdb.converter.ToPostgresDateTime(row.LeaseExpiry),
+		row.Version,
+		row.QueueName,
+		previousVersion,
+	)
+}
+
+// SelectFromAsyncRequestQueueMetadata returns the metadata row of a queue
+func (pdb *db) SelectFromAsyncRequestQueueMetadata(ctx context.Context, queueName string) (*persistence.AsyncRequestQueueMetadata, error) {
+	var row sqlplugin.AsyncRequestQueueMetadataRow
+	if err := pdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &row, _selectFromAsyncRequestQueueMetadataQuery, queueName); err != nil {
+		return nil, err
+	}
+	return &persistence.AsyncRequestQueueMetadata{
+		QueueName:   row.QueueName,
+		AckLevel:    row.AckLevel,
+		LeaseOwner:  row.LeaseOwner,
+		LeaseExpiry: pdb.converter.FromPostgresDateTime(row.LeaseExpiry),
+		Version:     row.Version,
+	}, nil
+}
+
+func (pdb *db) fromAsyncRequestDLQRow(row *sqlplugin.AsyncRequestDLQRow) *persistence.AsyncRequestDLQEntry {
+	return &persistence.AsyncRequestDLQEntry{
+		DomainID:    row.DomainID,
+		RequestID:   row.RequestID,
+		RequestType: row.RequestType,
+		WorkflowID:  row.WorkflowID,
+		Failure:     row.Failure,
+		Payload:     row.Payload,
+		CreatedTime: pdb.converter.FromPostgresDateTime(row.CreatedTime),
+	}
+}
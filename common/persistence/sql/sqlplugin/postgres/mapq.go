@@ -0,0 +1,96 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const (
+	_replaceIntoMapQItemsQuery = `INSERT INTO mapq_items ` +
+		`(queue_id, partition_path, item_offset, payload) ` +
+		`VALUES (:queue_id, :partition_path, :item_offset, :payload) ` +
+		`ON CONFLICT (queue_id, partition_path, item_offset) DO UPDATE SET payload = excluded.payload`
+
+	_rangeSelectFromMapQItemsQuery = `SELECT queue_id, partition_path, item_offset, payload FROM mapq_items ` +
+		`WHERE queue_id = $1 AND partition_path = $2 AND item_offset > $3 AND item_offset <= $4 ORDER BY item_offset LIMIT $5`
+
+	_rangeDeleteFromMapQItemsQuery = `DELETE FROM mapq_items WHERE queue_id = $1 AND partition_path = $2 AND item_offset <= $3`
+
+	_insertIntoMapQStateQuery = `INSERT INTO mapq_state ` +
+		`(queue_id, state, state_encoding, version) ` +
+		`VALUES ($1, $2, $3, $4)`
+
+	_updateMapQStateQuery = `UPDATE mapq_state ` +
+		`SET state = $1, state_encoding = $2, version = $3 ` +
+		`WHERE queue_id = $4 AND version = $5`
+
+	_selectFromMapQStateQuery = `SELECT queue_id, state, state_encoding, version ` +
+		`FROM mapq_state WHERE queue_id = $1`
+)
+
+// ReplaceIntoMapQItems creates or overwrites items of MAPQ queues
+func (pdb *db) ReplaceIntoMapQItems(ctx context.Context, rows []sqlplugin.MapQItemsRow) (sql.Result, error) {
+	return pdb.driver.NamedExecContext(ctx, sqlplugin.DbDefaultShard, _replaceIntoMapQItemsQuery, rows)
+}
+
+// RangeSelectFromMapQItems returns up to pageSize items of a partition ordered by offset
+func (pdb *db) RangeSelectFromMapQItems(
+	ctx context.Context,
+	queueID string,
+	partitionPath string,
+	exclusiveMinOffset int64,
+	inclusiveMaxOffset int64,
+	pageSize int,
+) ([]sqlplugin.MapQItemsRow, error) {
+	var rows []sqlplugin.MapQItemsRow
+	err := pdb.driver.SelectContext(ctx, sqlplugin.DbDefaultShard, &rows, _rangeSelectFromMapQItemsQuery, queueID, partitionPath, exclusiveMinOffset, inclusiveMaxOffset, pageSize)
+	return rows, err
+}
+
+// RangeDeleteFromMapQItems removes all items of a partition up to and including inclusiveMaxOffset
+func (pdb *db) RangeDeleteFromMapQItems(ctx context.Context, queueID, partitionPath string, inclusiveMaxOffset int64) (sql.Result, error) {
+	return pdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _rangeDeleteFromMapQItemsQuery, queueID, partitionPath, inclusiveMaxOffset)
+}
+
+// InsertIntoMapQState creates the state row of a queue
+func (pdb *db) InsertIntoMapQState(ctx context.Context, row *sqlplugin.MapQStateRow) (sql.Result, error) {
+	return pdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _insertIntoMapQStateQuery, row.QueueID, row.State, row.StateEncoding, row.Version)
+}
+
+// UpdateMapQState updates the state row of a queue if its version is previousVersion
+func (pdb *db) UpdateMapQState(ctx context.Context, row *sqlplugin.MapQStateRow, previousVersion int64) (sql.Result, error) {
+	return pdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _updateMapQStateQuery, row.State, row.StateEncoding, row.Version, row.QueueID, previousVersion)
+}
+
+// SelectFromMapQState returns the state row of a queue
+func (pdb *db) SelectFromMapQState(ctx context.Context, queueID string) (*sqlplugin.MapQStateRow, error) {
+	var row sqlplugin.MapQStateRow
+	if err := pdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &row, _selectFromMapQStateQuery, queueID); err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
@@ -0,0 +1,155 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const (
+	_insertIntoShardDistributorNamespacesQuery = `INSERT INTO shard_distributor_namespaces ` +
+		`(namespace, leader_id, lease_expiry, leader_version, revision) ` +
+		`VALUES ($1, $2, $3, $4, $5)`
+
+	_selectFromShardDistributorNamespacesQuery = `SELECT namespace, leader_id, lease_expiry, leader_version, revision ` +
+		`FROM shard_distributor_namespaces WHERE namespace = $1`
+
+	_updateShardDistributorNamespacesLeaderQuery = `UPDATE shard_distributor_namespaces ` +
+		`SET leader_id = $1, lease_expiry = $2, leader_version = $3 ` +
+		`WHERE namespace = $4 AND leader_version = $5`
+
+	_updateShardDistributorNamespacesRevisionQuery = `UPDATE shard_distributor_namespaces ` +
+		`SET revision = revision + 1 ` +
+		`WHERE namespace = $1 AND revision = $2`
+
+	_updateShardDistributorNamespacesRevisionWithLeaderQuery = `UPDATE shard_distributor_namespaces ` +
+		`SET revision = revision + 1 ` +
+		`WHERE namespace = $1 AND revision = $2 AND leader_version = $3`
+
+	_selectFromShardDistributorExecutorsQuery = `SELECT namespace, executor_id, last_heartbeat, state, ` +
+		`reported_shards, reported_shards_encoding, assigned_shards, assigned_shards_encoding, assigned_revision ` +
+		`FROM shard_distributor_executors WHERE namespace = $1`
+
+	_insertIntoShardDistributorExecutorsQuery = `INSERT INTO shard_distributor_executors ` +
+		`(namespace, executor_id, last_heartbeat, state, ` +
+		`reported_shards, reported_shards_encoding, assigned_shards, assigned_shards_encoding, assigned_revision) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	_updateShardDistributorExecutorsQuery = `UPDATE shard_distributor_executors ` +
+		`SET last_heartbeat = $1, state = $2, ` +
+		`reported_shards = $3, reported_shards_encoding = $4, assigned_shards = $5, assigned_shards_encoding = $6, assigned_revision = $7 ` +
+		`WHERE namespace = $8 AND executor_id = $9`
+
+	_deleteFromShardDistributorExecutorsQuery = `DELETE FROM shard_distributor_executors WHERE namespace = $1 AND executor_id = $2`
+)
+
+// InsertIntoShardDistributorNamespaces creates the row of a namespace
+func (pdb *db) InsertIntoShardDistributorNamespaces(ctx context.Context, row *sqlplugin.ShardDistributorNamespacesRow) (sql.Result, error) {
+	return pdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _insertIntoShardDistributorNamespacesQuery,
+		row.Namespace,
+		row.LeaderID,
+		pdb.converter.ToPostgresDateTime(row.LeaseExpiry),
+		row.LeaderVersion,
+		row.Revision,
+	)
+}
+
+// SelectFromShardDistributorNamespaces returns the row of a namespace
+func (pdb *db) SelectFromShardDistributorNamespaces(ctx context.Context, namespace string) (*sqlplugin.ShardDistributorNamespacesRow, error) {
+	var row sqlplugin.ShardDistributorNamespacesRow
+	if err := pdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &row, _selectFromShardDistributorNamespacesQuery, namespace); err != nil {
+		return nil, err
+	}
+	row.LeaseExpiry = pdb.converter.FromPostgresDateTime(row.LeaseExpiry)
+	return &row, nil
+}
+
+// UpdateShardDistributorNamespacesLeader updates the leader of a namespace if its leader version is previousVersion
+func (pdb *db) UpdateShardDistributorNamespacesLeader(ctx context.Context, row *sqlplugin.ShardDistributorNamespacesRow, previousVersion int64) (sql.Result, error) {
+	return pdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _updateShardDistributorNamespacesLeaderQuery,
+		row.LeaderID,
+		pdb.converter.ToPostgresDateTime(row.LeaseExpiry),
+		row.LeaderVersion,
+		row.Namespace,
+		previousVersion,
+	)
+}
+
+// UpdateShardDistributorNamespacesRevision increments the revision of a namespace if it is previousRevision
+// and, unless leaderVersion is 0, its leader version is leaderVersion
+func (pdb *db) UpdateShardDistributorNamespacesRevision(ctx context.Context, namespace string, previousRevision, leaderVersion int64) (sql.Result, error) {
+	if leaderVersion > 0 {
+		return pdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _updateShardDistributorNamespacesRevisionWithLeaderQuery, namespace, previousRevision, leaderVersion)
+	}
+	return pdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _updateShardDistributorNamespacesRevisionQuery, namespace, previousRevision)
+}
+
+// SelectFromShardDistributorExecutors returns all executors of a namespace
+func (pdb *db) SelectFromShardDistributorExecutors(ctx context.Context, namespace string) ([]sqlplugin.ShardDistributorExecutorsRow, error) {
+	var rows []sqlplugin.ShardDistributorExecutorsRow
+	if err := pdb.driver.SelectContext(ctx, sqlplugin.DbDefaultShard, &rows, _selectFromShardDistributorExecutorsQuery, namespace); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].LastHeartbeat = pdb.converter.FromPostgresDateTime(rows[i].LastHeartbeat)
+	}
+	return rows, nil
+}
+
+// InsertIntoShardDistributorExecutors creates the row of an executor
+func (pdb *db) InsertIntoShardDistributorExecutors(ctx context.Context, row *sqlplugin.ShardDistributorExecutorsRow) (sql.Result, error) {
+	return pdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _insertIntoShardDistributorExecutorsQuery,
+		row.Namespace,
+		row.ExecutorID,
+		pdb.converter.ToPostgresDateTime(row.LastHeartbeat),
+		row.State,
+		row.ReportedShards,
+		row.ReportedShardsEncoding,
+		row.AssignedShards,
+		row.AssignedShardsEncoding,
+		row.AssignedRevision,
+	)
+}
+
+// UpdateShardDistributorExecutors overwrites the row of an executor
+func (pdb *db) UpdateShardDistributorExecutors(ctx context.Context, row *sqlplugin.ShardDistributorExecutorsRow) (sql.Result, error) {
+	return pdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _updateShardDistributorExecutorsQuery,
+		pdb.converter.ToPostgresDateTime(row.LastHeartbeat),
+		row.State,
+		row.ReportedShards,
+		row.ReportedShardsEncoding,
+		row.AssignedShards,
+		row.AssignedShardsEncoding,
+		row.AssignedRevision,
+		row.Namespace,
+		row.ExecutorID,
+	)
+}
+
+// DeleteFromShardDistributorExecutors removes the row of an executor
+func (pdb *db) DeleteFromShardDistributorExecutors(ctx context.Context, namespace, executorID string) (sql.Result, error) {
+	return pdb.driver.ExecContext(ctx, sqlplugin.DbDefaultShard, _deleteFromShardDistributorExecutorsQuery, namespace, executorID)
+}
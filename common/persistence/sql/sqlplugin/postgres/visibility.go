@@ -94,6 +94,9 @@ const (
 		 WHERE domain_id = $1 AND close_status IS NOT NULL
 		 AND run_id = $2`
 
+	templateUpdateWorkflowExecutionMemo = `UPDATE executions_visibility SET memo = $1, encoding = $2, update_time = $3 ` +
+		`WHERE domain_id = $4 AND run_id = $5 AND close_status IS NULL`
+
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE domain_id=$1 AND run_id=$2"
 )
 
@@ -147,6 +150,17 @@ func (pdb *db) ReplaceIntoVisibility(ctx context.Context, row *sqlplugin.Visibil
 	}
 }
 
+// UpdateVisibilityMemo updates the memo of an open workflow's row in visibility table
+func (pdb *db) UpdateVisibilityMemo(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, pdb.GetTotalNumDBShards())
+	return pdb.driver.ExecContext(ctx, dbShardID, templateUpdateWorkflowExecutionMemo,
+		row.Memo,
+		row.Encoding,
+		row.UpdateTime,
+		row.DomainID,
+		row.RunID)
+}
+
 // DeleteFromVisibility deletes a row from visibility table if it exist
 func (pdb *db) DeleteFromVisibility(ctx context.Context, filter *sqlplugin.VisibilityFilter) (sql.Result, error) {
 	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
@@ -177,6 +177,12 @@ type (
 		TaskData
 	}
 
+	// CompletionCallbackTask identifies a transfer task for delivering the completion callbacks of a closed workflow
+	CompletionCallbackTask struct {
+		WorkflowIdentifier
+		TaskData
+	}
+
 	// RecordChildExecutionCompletedTask identifies a task for recording the competion of a child workflow
 	RecordChildExecutionCompletedTask struct {
 		WorkflowIdentifier
@@ -257,6 +263,7 @@ var (
 	_ Task = (*UpsertWorkflowSearchAttributesTask)(nil)
 	_ Task = (*StartChildExecutionTask)(nil)
 	_ Task = (*RecordWorkflowClosedTask)(nil)
+	_ Task = (*CompletionCallbackTask)(nil)
 	_ Task = (*ActivityTimeoutTask)(nil)
 	_ Task = (*UserTimerTask)(nil)
 	_ Task = (*ActivityRetryTimerTask)(nil)
@@ -1095,6 +1102,43 @@ func (u *RecordWorkflowClosedTask) ToInternalReplicationTaskInfo() (*types.Repli
 	return nil, fmt.Errorf("record workflow closed task is not replication task")
 }
 
+// GetType returns the type of the completion callback task
+func (u *CompletionCallbackTask) GetTaskType() int {
+	return TransferTaskTypeCompletionCallback
+}
+
+func (u *CompletionCallbackTask) GetTaskCategory() HistoryTaskCategory {
+	return HistoryTaskCategoryTransfer
+}
+
+func (u *CompletionCallbackTask) GetTaskKey() HistoryTaskKey {
+	return NewImmediateTaskKey(u.TaskID)
+}
+
+func (u *CompletionCallbackTask) ByteSize() uint64 {
+	return u.WorkflowIdentifier.ByteSize() + u.TaskData.ByteSize()
+}
+
+func (u *CompletionCallbackTask) ToTransferTaskInfo() (*TransferTaskInfo, error) {
+	return &TransferTaskInfo{
+		TaskType:            TransferTaskTypeCompletionCallback,
+		DomainID:            u.DomainID,
+		WorkflowID:          u.WorkflowID,
+		RunID:               u.RunID,
+		TaskID:              u.TaskID,
+		VisibilityTimestamp: u.VisibilityTimestamp,
+		Version:             u.Version,
+	}, nil
+}
+
+func (u *CompletionCallbackTask) ToTimerTaskInfo() (*TimerTaskInfo, error) {
+	return nil, fmt.Errorf("completion callback task is not timer task")
+}
+
+func (u *CompletionCallbackTask) ToInternalReplicationTaskInfo() (*types.ReplicationTaskInfo, error) {
+	return nil, fmt.Errorf("completion callback task is not replication task")
+}
+
 // GetType returns the type of the history replication task
 func (a *HistoryReplicationTask) GetTaskType() int {
 	return ReplicationTaskTypeHistory
@@ -139,6 +139,7 @@ func (v *visibilityManagerImpl) UpsertWorkflowExecution(
 		WorkflowTypeName:   request.WorkflowTypeName,
 		StartTimestamp:     time.Unix(0, request.StartTimestamp),
 		ExecutionTimestamp: time.Unix(0, request.ExecutionTimestamp),
+		WorkflowTimeout:    common.SecondsToDuration(request.WorkflowTimeout),
 		TaskID:             request.TaskID,
 		Memo:               v.serializeMemo(request.Memo, request.DomainUUID, request.Execution.GetWorkflowID(), request.Execution.GetRunID()),
 		TaskList:           request.TaskList,
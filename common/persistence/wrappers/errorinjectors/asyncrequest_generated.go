@@ -0,0 +1,202 @@
+package errorinjectors
+
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../templates/errorinjector.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// injectorAsyncRequestManager implements persistence.AsyncRequestManager interface instrumented with error injection.
+type injectorAsyncRequestManager struct {
+	wrapped   persistence.AsyncRequestManager
+	errorRate float64
+	logger    log.Logger
+}
+
+// NewAsyncRequestManager creates a new instance of AsyncRequestManager with error injection.
+func NewAsyncRequestManager(
+	wrapped persistence.AsyncRequestManager,
+	errorRate float64,
+	logger log.Logger,
+) persistence.AsyncRequestManager {
+	return &injectorAsyncRequestManager{
+		wrapped:   wrapped,
+		errorRate: errorRate,
+		logger:    logger,
+	}
+}
+
+func (c *injectorAsyncRequestManager) Close() {
+	c.wrapped.Close()
+	return
+}
+
+func (c *injectorAsyncRequestManager) DeleteAsyncRequestDLQEntry(ctx context.Context, request *persistence.DeleteAsyncRequestDLQEntryRequest) (err error) {
+	fakeErr := generateFakeError(c.errorRate)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		err = c.wrapped.DeleteAsyncRequestDLQEntry(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "AsyncRequestManager.DeleteAsyncRequestDLQEntry", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
+
+func (c *injectorAsyncRequestManager) DeleteAsyncRequestQueueMessages(ctx context.Context, request *persistence.DeleteAsyncRequestQueueMessagesRequest) (err error) {
+	fakeErr := generateFakeError(c.errorRate)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		err = c.wrapped.DeleteAsyncRequestQueueMessages(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "AsyncRequestManager.DeleteAsyncRequestQueueMessages", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
+
+func (c *injectorAsyncRequestManager) EnqueueAsyncRequestQueueMessage(ctx context.Context, request *persistence.EnqueueAsyncRequestQueueMessageRequest) (ep1 *persistence.EnqueueAsyncRequestQueueMessageResponse, err error) {
+	fakeErr := generateFakeError(c.errorRate)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		ep1, err = c.wrapped.EnqueueAsyncRequestQueueMessage(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "AsyncRequestManager.EnqueueAsyncRequestQueueMessage", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
+
+func (c *injectorAsyncRequestManager) EnqueueAsyncRequestToDLQ(ctx context.Context, request *persistence.EnqueueAsyncRequestToDLQRequest) (err error) {
+	fakeErr := generateFakeError(c.errorRate)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		err = c.wrapped.EnqueueAsyncRequestToDLQ(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "AsyncRequestManager.EnqueueAsyncRequestToDLQ", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
+
+func (c *injectorAsyncRequestManager) GetAsyncRequest(ctx context.Context, request *persistence.GetAsyncRequestRequest) (gp1 *persistence.GetAsyncRequestResponse, err error) {
+	fakeErr := generateFakeError(c.errorRate)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		gp1, err = c.wrapped.GetAsyncRequest(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "AsyncRequestManager.GetAsyncRequest", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
+
+func (c *injectorAsyncRequestManager) GetAsyncRequestDLQEntry(ctx context.Context, request *persistence.GetAsyncRequestDLQEntryRequest) (gp1 *persistence.GetAsyncRequestDLQEntryResponse, err error) {
+	fakeErr := generateFakeError(c.errorRate)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		gp1, err = c.wrapped.GetAsyncRequestDLQEntry(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "AsyncRequestManager.GetAsyncRequestDLQEntry", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
+
+func (c *injectorAsyncRequestManager) GetAsyncRequestQueueMetadata(ctx context.Context, request *persistence.GetAsyncRequestQueueMetadataRequest) (gp1 *persistence.GetAsyncRequestQueueMetadataResponse, err error) {
+	fakeErr := generateFakeError(c.errorRate)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		gp1, err = c.wrapped.GetAsyncRequestQueueMetadata(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "AsyncRequestManager.GetAsyncRequestQueueMetadata", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
+
+func (c *injectorAsyncRequestManager) ReadAsyncRequestDLQ(ctx context.Context, request *persistence.ReadAsyncRequestDLQRequest) (rp1 *persistence.ReadAsyncRequestDLQResponse, err error) {
+	fakeErr := generateFakeError(c.errorRate)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		rp1, err = c.wrapped.ReadAsyncRequestDLQ(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "AsyncRequestManager.ReadAsyncRequestDLQ", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
+
+func (c *injectorAsyncRequestManager) ReadAsyncRequestQueueMessages(ctx context.Context, request *persistence.ReadAsyncRequestQueueMessagesRequest) (rp1 *persistence.ReadAsyncRequestQueueMessagesResponse, err error) {
+	fakeErr := generateFakeError(c.errorRate)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		rp1, err = c.wrapped.ReadAsyncRequestQueueMessages(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "AsyncRequestManager.ReadAsyncRequestQueueMessages", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
+
+func (c *injectorAsyncRequestManager) UpdateAsyncRequestQueueMetadata(ctx context.Context, request *persistence.UpdateAsyncRequestQueueMetadataRequest) (err error) {
+	fakeErr := generateFakeError(c.errorRate)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		err = c.wrapped.UpdateAsyncRequestQueueMetadata(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "AsyncRequestManager.UpdateAsyncRequestQueueMetadata", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
+
+func (c *injectorAsyncRequestManager) UpsertAsyncRequest(ctx context.Context, request *persistence.UpsertAsyncRequestRequest) (err error) {
+	fakeErr := generateFakeError(c.errorRate)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		err = c.wrapped.UpsertAsyncRequest(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "AsyncRequestManager.UpsertAsyncRequest", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
@@ -45,11 +45,14 @@ var _staticMethods = map[string]bool{
 }
 
 var wrappers = []any{
+	&injectorAsyncRequestManager{},
 	&injectorConfigStoreManager{},
 	&injectorDomainManager{},
 	&injectorHistoryManager{},
+	&injectorMapQManager{},
 	&injectorQueueManager{},
 	&injectorShardManager{},
+	&injectorShardDistributorManager{},
 	&injectorTaskManager{},
 	&injectorVisibilityManager{},
 	&injectorExecutionManager{},
@@ -172,6 +175,22 @@ func TestInjectorsWithUnderlyingErrors(t *testing.T) {
 func builderForPassThrough(t *testing.T, injector any, errorRate float64, logger log.Logger, expectCalls bool, expectedErr error) (object any) {
 	ctrl := gomock.NewController(t)
 	switch injector.(type) {
+	case *injectorAsyncRequestManager:
+		mocked := persistence.NewMockAsyncRequestManager(ctrl)
+		object = NewAsyncRequestManager(mocked, errorRate, logger)
+		if expectCalls {
+			mocked.EXPECT().UpsertAsyncRequest(gomock.Any(), gomock.Any()).Return(expectedErr)
+			mocked.EXPECT().GetAsyncRequest(gomock.Any(), gomock.Any()).Return(&persistence.GetAsyncRequestResponse{}, expectedErr)
+			mocked.EXPECT().EnqueueAsyncRequestToDLQ(gomock.Any(), gomock.Any()).Return(expectedErr)
+			mocked.EXPECT().ReadAsyncRequestDLQ(gomock.Any(), gomock.Any()).Return(&persistence.ReadAsyncRequestDLQResponse{}, expectedErr)
+			mocked.EXPECT().GetAsyncRequestDLQEntry(gomock.Any(), gomock.Any()).Return(&persistence.GetAsyncRequestDLQEntryResponse{}, expectedErr)
+			mocked.EXPECT().DeleteAsyncRequestDLQEntry(gomock.Any(), gomock.Any()).Return(expectedErr)
+			mocked.EXPECT().EnqueueAsyncRequestQueueMessage(gomock.Any(), gomock.Any()).Return(&persistence.EnqueueAsyncRequestQueueMessageResponse{}, expectedErr)
+			mocked.EXPECT().ReadAsyncRequestQueueMessages(gomock.Any(), gomock.Any()).Return(&persistence.ReadAsyncRequestQueueMessagesResponse{}, expectedErr)
+			mocked.EXPECT().DeleteAsyncRequestQueueMessages(gomock.Any(), gomock.Any()).Return(expectedErr)
+			mocked.EXPECT().GetAsyncRequestQueueMetadata(gomock.Any(), gomock.Any()).Return(&persistence.GetAsyncRequestQueueMetadataResponse{}, expectedErr)
+			mocked.EXPECT().UpdateAsyncRequestQueueMetadata(gomock.Any(), gomock.Any()).Return(expectedErr)
+		}
 	case *injectorConfigStoreManager:
 		mocked := persistence.NewMockConfigStoreManager(ctrl)
 		object = NewConfigStoreManager(mocked, errorRate, logger)
@@ -204,6 +223,26 @@ func builderForPassThrough(t *testing.T, injector any, errorRate float64, logger
 			mocked.EXPECT().GetHistoryTree(gomock.Any(), gomock.Any()).Return(&persistence.GetHistoryTreeResponse{}, expectedErr)
 			mocked.EXPECT().GetAllHistoryTreeBranches(gomock.Any(), gomock.Any()).Return(&persistence.GetAllHistoryTreeBranchesResponse{}, expectedErr)
 		}
+	case *injectorMapQManager:
+		mocked := persistence.NewMockMapQManager(ctrl)
+		object = NewMapQManager(mocked, errorRate, logger)
+		if expectCalls {
+			mocked.EXPECT().EnqueueMapQItems(gomock.Any(), gomock.Any()).Return(expectedErr)
+			mocked.EXPECT().ReadMapQItems(gomock.Any(), gomock.Any()).Return(&persistence.ReadMapQItemsResponse{}, expectedErr)
+			mocked.EXPECT().DeleteMapQItems(gomock.Any(), gomock.Any()).Return(expectedErr)
+			mocked.EXPECT().GetMapQState(gomock.Any(), gomock.Any()).Return(&persistence.GetMapQStateResponse{}, expectedErr)
+			mocked.EXPECT().UpdateMapQState(gomock.Any(), gomock.Any()).Return(expectedErr)
+		}
+	case *injectorShardDistributorManager:
+		mocked := persistence.NewMockShardDistributorManager(ctrl)
+		object = NewShardDistributorManager(mocked, errorRate, logger)
+		if expectCalls {
+			mocked.EXPECT().GetShardDistributorState(gomock.Any(), gomock.Any()).Return(&persistence.GetShardDistributorStateResponse{}, expectedErr)
+			mocked.EXPECT().UpdateShardDistributorLeader(gomock.Any(), gomock.Any()).Return(expectedErr)
+			mocked.EXPECT().UpsertShardDistributorExecutor(gomock.Any(), gomock.Any()).Return(expectedErr)
+			mocked.EXPECT().AssignShardDistributorShards(gomock.Any(), gomock.Any()).Return(expectedErr)
+			mocked.EXPECT().DeleteShardDistributorExecutors(gomock.Any(), gomock.Any()).Return(expectedErr)
+		}
 	case *injectorQueueManager:
 		mocked := persistence.NewMockQueueManager(ctrl)
 		object = NewQueueManager(mocked, errorRate, logger)
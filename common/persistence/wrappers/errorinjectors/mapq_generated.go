@@ -0,0 +1,112 @@
+package errorinjectors
+
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../templates/errorinjector.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// injectorMapQManager implements persistence.MapQManager interface instrumented with error injection.
+type injectorMapQManager struct {
+	wrapped   persistence.MapQManager
+	errorRate float64
+	logger    log.Logger
+}
+
+// NewMapQManager creates a new instance of MapQManager with error injection.
+func NewMapQManager(
+	wrapped persistence.MapQManager,
+	errorRate float64,
+	logger log.Logger,
+) persistence.MapQManager {
+	return &injectorMapQManager{
+		wrapped:   wrapped,
+		errorRate: errorRate,
+		logger:    logger,
+	}
+}
+
+func (c *injectorMapQManager) Close() {
+	c.wrapped.Close()
+	return
+}
+
+func (c *injectorMapQManager) DeleteMapQItems(ctx context.Context, request *persistence.DeleteMapQItemsRequest) (err error) {
+	fakeErr := generateFakeError(c.errorRate)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		err = c.wrapped.DeleteMapQItems(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "MapQManager.DeleteMapQItems", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
+
+func (c *injectorMapQManager) EnqueueMapQItems(ctx context.Context, request *persistence.EnqueueMapQItemsRequest) (err error) {
+	fakeErr := generateFakeError(c.errorRate)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		err = c.wrapped.EnqueueMapQItems(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "MapQManager.EnqueueMapQItems", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
+
+func (c *injectorMapQManager) GetMapQState(ctx context.Context, request *persistence.GetMapQStateRequest) (gp1 *persistence.GetMapQStateResponse, err error) {
+	fakeErr := generateFakeError(c.errorRate)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		gp1, err = c.wrapped.GetMapQState(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "MapQManager.GetMapQState", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
+
+func (c *injectorMapQManager) ReadMapQItems(ctx context.Context, request *persistence.ReadMapQItemsRequest) (rp1 *persistence.ReadMapQItemsResponse, err error) {
+	fakeErr := generateFakeError(c.errorRate)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		rp1, err = c.wrapped.ReadMapQItems(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "MapQManager.ReadMapQItems", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
+
+func (c *injectorMapQManager) UpdateMapQState(ctx context.Context, request *persistence.UpdateMapQStateRequest) (err error) {
+	fakeErr := generateFakeError(c.errorRate)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		err = c.wrapped.UpdateMapQState(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "MapQManager.UpdateMapQState", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
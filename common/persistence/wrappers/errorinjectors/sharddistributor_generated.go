@@ -0,0 +1,112 @@
+package errorinjectors
+
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../templates/errorinjector.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// injectorShardDistributorManager implements persistence.ShardDistributorManager interface instrumented with error injection.
+type injectorShardDistributorManager struct {
+	wrapped   persistence.ShardDistributorManager
+	errorRate float64
+	logger    log.Logger
+}
+
+// NewShardDistributorManager creates a new instance of ShardDistributorManager with error injection.
+func NewShardDistributorManager(
+	wrapped persistence.ShardDistributorManager,
+	errorRate float64,
+	logger log.Logger,
+) persistence.ShardDistributorManager {
+	return &injectorShardDistributorManager{
+		wrapped:   wrapped,
+		errorRate: errorRate,
+		logger:    logger,
+	}
+}
+
+func (c *injectorShardDistributorManager) Close() {
+	c.wrapped.Close()
+	return
+}
+
+func (c *injectorShardDistributorManager) AssignShardDistributorShards(ctx context.Context, request *persistence.AssignShardDistributorShardsRequest) (err error) {
+	fakeErr := generateFakeError(c.errorRate)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		err = c.wrapped.AssignShardDistributorShards(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "ShardDistributorManager.AssignShardDistributorShards", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
+
+func (c *injectorShardDistributorManager) DeleteShardDistributorExecutors(ctx context.Context, request *persistence.DeleteShardDistributorExecutorsRequest) (err error) {
+	fakeErr := generateFakeError(c.errorRate)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		err = c.wrapped.DeleteShardDistributorExecutors(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "ShardDistributorManager.DeleteShardDistributorExecutors", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
+
+func (c *injectorShardDistributorManager) GetShardDistributorState(ctx context.Context, request *persistence.GetShardDistributorStateRequest) (gp1 *persistence.GetShardDistributorStateResponse, err error) {
+	fakeErr := generateFakeError(c.errorRate)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		gp1, err = c.wrapped.GetShardDistributorState(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "ShardDistributorManager.GetShardDistributorState", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
+
+func (c *injectorShardDistributorManager) UpdateShardDistributorLeader(ctx context.Context, request *persistence.UpdateShardDistributorLeaderRequest) (err error) {
+	fakeErr := generateFakeError(c.errorRate)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		err = c.wrapped.UpdateShardDistributorLeader(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "ShardDistributorManager.UpdateShardDistributorLeader", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
+
+func (c *injectorShardDistributorManager) UpsertShardDistributorExecutor(ctx context.Context, request *persistence.UpsertShardDistributorExecutorRequest) (err error) {
+	fakeErr := generateFakeError(c.errorRate)
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		err = c.wrapped.UpsertShardDistributorExecutor(ctx, request)
+	}
+
+	if fakeErr != nil {
+		logErr(c.logger, "ShardDistributorManager.UpsertShardDistributorExecutor", fakeErr, forwardCall, err)
+		err = fakeErr
+		return
+	}
+	return
+}
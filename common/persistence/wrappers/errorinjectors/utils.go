@@ -105,12 +105,18 @@ func logErr(logger log.Logger, objectMethod string, fakeErr error, forwardCall b
 func getOperationFromMethodName(op string) tag.Tag {
 	var t *tag.Tag
 	switch {
+	case strings.HasPrefix(op, "AsyncRequestManager"):
+		t = asyncRequestManagerTags(op)
 	case strings.HasPrefix(op, "ConfigStoreManager"):
 		t = configManagerTags(op)
 	case strings.HasPrefix(op, "DomainManager"):
 		t = domainManagerTags(op)
 	case strings.HasPrefix(op, "HistoryManager"):
 		t = historyManagerTags(op)
+	case strings.HasPrefix(op, "MapQManager"):
+		t = mapQManagerTags(op)
+	case strings.HasPrefix(op, "ShardDistributorManager"):
+		t = shardDistributorManagerTags(op)
 	case strings.HasPrefix(op, "ShardManager"):
 		t = shardManagerTags(op)
 	case strings.HasPrefix(op, "ExecutionManager"):
@@ -128,6 +134,34 @@ func getOperationFromMethodName(op string) tag.Tag {
 	return *t
 }
 
+func asyncRequestManagerTags(op string) *tag.Tag {
+	switch op {
+	case "AsyncRequestManager.UpsertAsyncRequest":
+		return &tag.StoreOperationUpsertAsyncRequest
+	case "AsyncRequestManager.GetAsyncRequest":
+		return &tag.StoreOperationGetAsyncRequest
+	case "AsyncRequestManager.EnqueueAsyncRequestToDLQ":
+		return &tag.StoreOperationEnqueueAsyncRequestToDLQ
+	case "AsyncRequestManager.ReadAsyncRequestDLQ":
+		return &tag.StoreOperationReadAsyncRequestDLQ
+	case "AsyncRequestManager.GetAsyncRequestDLQEntry":
+		return &tag.StoreOperationGetAsyncRequestDLQEntry
+	case "AsyncRequestManager.DeleteAsyncRequestDLQEntry":
+		return &tag.StoreOperationDeleteAsyncRequestDLQEntry
+	case "AsyncRequestManager.EnqueueAsyncRequestQueueMessage":
+		return &tag.StoreOperationEnqueueAsyncRequestQueueMessage
+	case "AsyncRequestManager.ReadAsyncRequestQueueMessages":
+		return &tag.StoreOperationReadAsyncRequestQueueMessages
+	case "AsyncRequestManager.DeleteAsyncRequestQueueMessages":
+		return &tag.StoreOperationDeleteAsyncRequestQueueMessages
+	case "AsyncRequestManager.GetAsyncRequestQueueMetadata":
+		return &tag.StoreOperationGetAsyncRequestQueueMetadata
+	case "AsyncRequestManager.UpdateAsyncRequestQueueMetadata":
+		return &tag.StoreOperationUpdateAsyncRequestQueueMetadata
+	}
+	return nil
+}
+
 func configManagerTags(op string) *tag.Tag {
 	switch op {
 	case "ConfigStoreManager.FetchDynamicConfig":
@@ -180,6 +214,38 @@ func historyManagerTags(op string) *tag.Tag {
 	return nil
 }
 
+func mapQManagerTags(op string) *tag.Tag {
+	switch op {
+	case "MapQManager.EnqueueMapQItems":
+		return &tag.StoreOperationEnqueueMapQItems
+	case "MapQManager.ReadMapQItems":
+		return &tag.StoreOperationReadMapQItems
+	case "MapQManager.DeleteMapQItems":
+		return &tag.StoreOperationDeleteMapQItems
+	case "MapQManager.GetMapQState":
+		return &tag.StoreOperationGetMapQState
+	case "MapQManager.UpdateMapQState":
+		return &tag.StoreOperationUpdateMapQState
+	}
+	return nil
+}
+
+func shardDistributorManagerTags(op string) *tag.Tag {
+	switch op {
+	case "ShardDistributorManager.GetShardDistributorState":
+		return &tag.StoreOperationGetShardDistributorState
+	case "ShardDistributorManager.UpdateShardDistributorLeader":
+		return &tag.StoreOperationUpdateShardDistributorLeader
+	case "ShardDistributorManager.UpsertShardDistributorExecutor":
+		return &tag.StoreOperationUpsertShardDistributorExecutor
+	case "ShardDistributorManager.AssignShardDistributorShards":
+		return &tag.StoreOperationAssignShardDistributorShards
+	case "ShardDistributorManager.DeleteShardDistributorExecutors":
+		return &tag.StoreOperationDeleteShardDistributorExecutors
+	}
+	return nil
+}
+
 func shardManagerTags(op string) *tag.Tag {
 	switch op {
 	case "ShardManager.CreateShard":
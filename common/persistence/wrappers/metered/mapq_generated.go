@@ -0,0 +1,97 @@
+package metered
+
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../templates/metered.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// meteredMapQManager implements persistence.MapQManager interface instrumented with rate limiter.
+type meteredMapQManager struct {
+	base
+	wrapped persistence.MapQManager
+}
+
+// NewMapQManager creates a new instance of MapQManager with ratelimiter.
+func NewMapQManager(
+	wrapped persistence.MapQManager,
+	metricClient metrics.Client,
+	logger log.Logger,
+	cfg *config.Persistence,
+) persistence.MapQManager {
+	return &meteredMapQManager{
+		wrapped: wrapped,
+		base: base{
+			metricClient:                  metricClient,
+			logger:                        logger,
+			enableLatencyHistogramMetrics: cfg.EnablePersistenceLatencyHistogramMetrics,
+		},
+	}
+}
+
+func (c *meteredMapQManager) Close() {
+	c.wrapped.Close()
+	return
+}
+
+func (c *meteredMapQManager) DeleteMapQItems(ctx context.Context, request *persistence.DeleteMapQItemsRequest) (err error) {
+	op := func() error {
+		err = c.wrapped.DeleteMapQItems(ctx, request)
+		c.emptyMetric("MapQManager.DeleteMapQItems", request, err, err)
+		return err
+	}
+
+	err = c.call(metrics.PersistenceDeleteMapQItemsScope, op, getCustomMetricTags(request)...)
+	return
+}
+
+func (c *meteredMapQManager) EnqueueMapQItems(ctx context.Context, request *persistence.EnqueueMapQItemsRequest) (err error) {
+	op := func() error {
+		err = c.wrapped.EnqueueMapQItems(ctx, request)
+		c.emptyMetric("MapQManager.EnqueueMapQItems", request, err, err)
+		return err
+	}
+
+	err = c.call(metrics.PersistenceEnqueueMapQItemsScope, op, getCustomMetricTags(request)...)
+	return
+}
+
+func (c *meteredMapQManager) GetMapQState(ctx context.Context, request *persistence.GetMapQStateRequest) (gp1 *persistence.GetMapQStateResponse, err error) {
+	op := func() error {
+		gp1, err = c.wrapped.GetMapQState(ctx, request)
+		c.emptyMetric("MapQManager.GetMapQState", request, gp1, err)
+		return err
+	}
+
+	err = c.call(metrics.PersistenceGetMapQStateScope, op, getCustomMetricTags(request)...)
+	return
+}
+
+func (c *meteredMapQManager) ReadMapQItems(ctx context.Context, request *persistence.ReadMapQItemsRequest) (rp1 *persistence.ReadMapQItemsResponse, err error) {
+	op := func() error {
+		rp1, err = c.wrapped.ReadMapQItems(ctx, request)
+		c.emptyMetric("MapQManager.ReadMapQItems", request, rp1, err)
+		return err
+	}
+
+	err = c.call(metrics.PersistenceReadMapQItemsScope, op, getCustomMetricTags(request)...)
+	return
+}
+
+func (c *meteredMapQManager) UpdateMapQState(ctx context.Context, request *persistence.UpdateMapQStateRequest) (err error) {
+	op := func() error {
+		err = c.wrapped.UpdateMapQState(ctx, request)
+		c.emptyMetric("MapQManager.UpdateMapQState", request, err, err)
+		return err
+	}
+
+	err = c.call(metrics.PersistenceUpdateMapQStateScope, op, getCustomMetricTags(request)...)
+	return
+}
@@ -53,6 +53,16 @@ func TestWrappersAgainstPreviousImplementation(t *testing.T) {
 		name        string
 		prepareMock func(t *testing.T, ctrl *gomock.Controller, newMetricsClient metrics.Client, newLogger log.Logger) (newManager any, mocked any)
 	}{
+		{
+			name: "AsyncRequestManager",
+			prepareMock: func(t *testing.T, ctrl *gomock.Controller, newMetricsClient metrics.Client, newLogger log.Logger) (newManager any, mocked any) {
+				wrapped := persistence.NewMockAsyncRequestManager(ctrl)
+
+				newObj := NewAsyncRequestManager(wrapped, newMetricsClient, newLogger, &config.Persistence{EnablePersistenceLatencyHistogramMetrics: true})
+
+				return newObj, wrapped
+			},
+		},
 		{
 			name: "ConfigStoreManager",
 			prepareMock: func(t *testing.T, ctrl *gomock.Controller, newMetricsClient metrics.Client, newLogger log.Logger) (newManager any, mocked any) {
@@ -83,6 +93,16 @@ func TestWrappersAgainstPreviousImplementation(t *testing.T) {
 				return newObj, wrapped
 			},
 		},
+		{
+			name: "MapQManager",
+			prepareMock: func(t *testing.T, ctrl *gomock.Controller, newMetricsClient metrics.Client, newLogger log.Logger) (newManager any, mocked any) {
+				wrapped := persistence.NewMockMapQManager(ctrl)
+
+				newObj := NewMapQManager(wrapped, newMetricsClient, newLogger, &config.Persistence{EnablePersistenceLatencyHistogramMetrics: true})
+
+				return newObj, wrapped
+			},
+		},
 		{
 			name: "QueueManager",
 			prepareMock: func(t *testing.T, ctrl *gomock.Controller, newMetricsClient metrics.Client, newLogger log.Logger) (newManager any, mocked any) {
@@ -93,6 +113,16 @@ func TestWrappersAgainstPreviousImplementation(t *testing.T) {
 				return newObj, wrapped
 			},
 		},
+		{
+			name: "ShardDistributorManager",
+			prepareMock: func(t *testing.T, ctrl *gomock.Controller, newMetricsClient metrics.Client, newLogger log.Logger) (newManager any, mocked any) {
+				wrapped := persistence.NewMockShardDistributorManager(ctrl)
+
+				newObj := NewShardDistributorManager(wrapped, newMetricsClient, newLogger, &config.Persistence{EnablePersistenceLatencyHistogramMetrics: true})
+
+				return newObj, wrapped
+			},
+		},
 		{
 			name: "ShardManager",
 			prepareMock: func(t *testing.T, ctrl *gomock.Controller, newMetricsClient metrics.Client, newLogger log.Logger) (newManager any, mocked any) {
@@ -188,6 +218,18 @@ func TestWrappersAgainstPreviousImplementation(t *testing.T) {
 
 func prepareMockForTest(t *testing.T, input interface{}, expectedErr error) {
 	switch mocked := input.(type) {
+	case *persistence.MockAsyncRequestManager:
+		mocked.EXPECT().UpsertAsyncRequest(gomock.Any(), gomock.Any()).Return(expectedErr).Times(1)
+		mocked.EXPECT().GetAsyncRequest(gomock.Any(), gomock.Any()).Return(&persistence.GetAsyncRequestResponse{}, expectedErr).Times(1)
+		mocked.EXPECT().EnqueueAsyncRequestToDLQ(gomock.Any(), gomock.Any()).Return(expectedErr).Times(1)
+		mocked.EXPECT().ReadAsyncRequestDLQ(gomock.Any(), gomock.Any()).Return(&persistence.ReadAsyncRequestDLQResponse{}, expectedErr).Times(1)
+		mocked.EXPECT().GetAsyncRequestDLQEntry(gomock.Any(), gomock.Any()).Return(&persistence.GetAsyncRequestDLQEntryResponse{}, expectedErr).Times(1)
+		mocked.EXPECT().DeleteAsyncRequestDLQEntry(gomock.Any(), gomock.Any()).Return(expectedErr).Times(1)
+		mocked.EXPECT().EnqueueAsyncRequestQueueMessage(gomock.Any(), gomock.Any()).Return(&persistence.EnqueueAsyncRequestQueueMessageResponse{}, expectedErr).Times(1)
+		mocked.EXPECT().ReadAsyncRequestQueueMessages(gomock.Any(), gomock.Any()).Return(&persistence.ReadAsyncRequestQueueMessagesResponse{}, expectedErr).Times(1)
+		mocked.EXPECT().DeleteAsyncRequestQueueMessages(gomock.Any(), gomock.Any()).Return(expectedErr).Times(1)
+		mocked.EXPECT().GetAsyncRequestQueueMetadata(gomock.Any(), gomock.Any()).Return(&persistence.GetAsyncRequestQueueMetadataResponse{}, expectedErr).Times(1)
+		mocked.EXPECT().UpdateAsyncRequestQueueMetadata(gomock.Any(), gomock.Any()).Return(expectedErr).Times(1)
 	case *persistence.MockConfigStoreManager:
 		mocked.EXPECT().UpdateDynamicConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return(expectedErr).Times(1)
 		mocked.EXPECT().FetchDynamicConfig(gomock.Any(), gomock.Any()).Return(&persistence.FetchDynamicConfigResponse{}, expectedErr).Times(1)
@@ -208,6 +250,18 @@ func prepareMockForTest(t *testing.T, input interface{}, expectedErr error) {
 		mocked.EXPECT().DeleteHistoryBranch(gomock.Any(), gomock.Any()).Return(expectedErr).Times(1)
 		mocked.EXPECT().GetHistoryTree(gomock.Any(), gomock.Any()).Return(&persistence.GetHistoryTreeResponse{}, expectedErr).Times(1)
 		mocked.EXPECT().GetAllHistoryTreeBranches(gomock.Any(), gomock.Any()).Return(&persistence.GetAllHistoryTreeBranchesResponse{}, expectedErr).Times(1)
+	case *persistence.MockMapQManager:
+		mocked.EXPECT().EnqueueMapQItems(gomock.Any(), gomock.Any()).Return(expectedErr).Times(1)
+		mocked.EXPECT().ReadMapQItems(gomock.Any(), gomock.Any()).Return(&persistence.ReadMapQItemsResponse{}, expectedErr).Times(1)
+		mocked.EXPECT().DeleteMapQItems(gomock.Any(), gomock.Any()).Return(expectedErr).Times(1)
+		mocked.EXPECT().GetMapQState(gomock.Any(), gomock.Any()).Return(&persistence.GetMapQStateResponse{}, expectedErr).Times(1)
+		mocked.EXPECT().UpdateMapQState(gomock.Any(), gomock.Any()).Return(expectedErr).Times(1)
+	case *persistence.MockShardDistributorManager:
+		mocked.EXPECT().GetShardDistributorState(gomock.Any(), gomock.Any()).Return(&persistence.GetShardDistributorStateResponse{}, expectedErr).Times(1)
+		mocked.EXPECT().UpdateShardDistributorLeader(gomock.Any(), gomock.Any()).Return(expectedErr).Times(1)
+		mocked.EXPECT().UpsertShardDistributorExecutor(gomock.Any(), gomock.Any()).Return(expectedErr).Times(1)
+		mocked.EXPECT().AssignShardDistributorShards(gomock.Any(), gomock.Any()).Return(expectedErr).Times(1)
+		mocked.EXPECT().DeleteShardDistributorExecutors(gomock.Any(), gomock.Any()).Return(expectedErr).Times(1)
 	case *persistence.MockQueueManager:
 		mocked.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).Return(expectedErr).Times(1)
 		mocked.EXPECT().ReadMessages(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*persistence.QueueMessage{}, expectedErr).Times(1)
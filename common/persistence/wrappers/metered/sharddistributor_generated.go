@@ -0,0 +1,97 @@
+package metered
+
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../templates/metered.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// meteredShardDistributorManager implements persistence.ShardDistributorManager interface instrumented with rate limiter.
+type meteredShardDistributorManager struct {
+	base
+	wrapped persistence.ShardDistributorManager
+}
+
+// NewShardDistributorManager creates a new instance of ShardDistributorManager with ratelimiter.
+func NewShardDistributorManager(
+	wrapped persistence.ShardDistributorManager,
+	metricClient metrics.Client,
+	logger log.Logger,
+	cfg *config.Persistence,
+) persistence.ShardDistributorManager {
+	return &meteredShardDistributorManager{
+		wrapped: wrapped,
+		base: base{
+			metricClient:                  metricClient,
+			logger:                        logger,
+			enableLatencyHistogramMetrics: cfg.EnablePersistenceLatencyHistogramMetrics,
+		},
+	}
+}
+
+func (c *meteredShardDistributorManager) Close() {
+	c.wrapped.Close()
+	return
+}
+
+func (c *meteredShardDistributorManager) AssignShardDistributorShards(ctx context.Context, request *persistence.AssignShardDistributorShardsRequest) (err error) {
+	op := func() error {
+		err = c.wrapped.AssignShardDistributorShards(ctx, request)
+		c.emptyMetric("ShardDistributorManager.AssignShardDistributorShards", request, err, err)
+		return err
+	}
+
+	err = c.call(metrics.PersistenceAssignShardDistributorShardsScope, op, getCustomMetricTags(request)...)
+	return
+}
+
+func (c *meteredShardDistributorManager) DeleteShardDistributorExecutors(ctx context.Context, request *persistence.DeleteShardDistributorExecutorsRequest) (err error) {
+	op := func() error {
+		err = c.wrapped.DeleteShardDistributorExecutors(ctx, request)
+		c.emptyMetric("ShardDistributorManager.DeleteShardDistributorExecutors", request, err, err)
+		return err
+	}
+
+	err = c.call(metrics.PersistenceDeleteShardDistributorExecutorsScope, op, getCustomMetricTags(request)...)
+	return
+}
+
+func (c *meteredShardDistributorManager) GetShardDistributorState(ctx context.Context, request *persistence.GetShardDistributorStateRequest) (gp1 *persistence.GetShardDistributorStateResponse, err error) {
+	op := func() error {
+		gp1, err = c.wrapped.GetShardDistributorState(ctx, request)
+		c.emptyMetric("ShardDistributorManager.GetShardDistributorState", request, gp1, err)
+		return err
+	}
+
+	err = c.call(metrics.PersistenceGetShardDistributorStateScope, op, getCustomMetricTags(request)...)
+	return
+}
+
+func (c *meteredShardDistributorManager) UpdateShardDistributorLeader(ctx context.Context, request *persistence.UpdateShardDistributorLeaderRequest) (err error) {
+	op := func() error {
+		err = c.wrapped.UpdateShardDistributorLeader(ctx, request)
+		c.emptyMetric("ShardDistributorManager.UpdateShardDistributorLeader", request, err, err)
+		return err
+	}
+
+	err = c.call(metrics.PersistenceUpdateShardDistributorLeaderScope, op, getCustomMetricTags(request)...)
+	return
+}
+
+func (c *meteredShardDistributorManager) UpsertShardDistributorExecutor(ctx context.Context, request *persistence.UpsertShardDistributorExecutorRequest) (err error) {
+	op := func() error {
+		err = c.wrapped.UpsertShardDistributorExecutor(ctx, request)
+		c.emptyMetric("ShardDistributorManager.UpsertShardDistributorExecutor", request, err, err)
+		return err
+	}
+
+	err = c.call(metrics.PersistenceUpsertShardDistributorExecutorScope, op, getCustomMetricTags(request)...)
+	return
+}
@@ -0,0 +1,122 @@
+package ratelimited
+
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../templates/ratelimited.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+)
+
+// ratelimitedAsyncRequestManager implements persistence.AsyncRequestManager interface instrumented with rate limiter.
+type ratelimitedAsyncRequestManager struct {
+	wrapped     persistence.AsyncRequestManager
+	rateLimiter quotas.Limiter
+}
+
+// NewAsyncRequestManager creates a new instance of AsyncRequestManager with ratelimiter.
+func NewAsyncRequestManager(
+	wrapped persistence.AsyncRequestManager,
+	rateLimiter quotas.Limiter,
+) persistence.AsyncRequestManager {
+	return &ratelimitedAsyncRequestManager{
+		wrapped:     wrapped,
+		rateLimiter: rateLimiter,
+	}
+}
+
+func (c *ratelimitedAsyncRequestManager) Close() {
+	c.wrapped.Close()
+	return
+}
+
+func (c *ratelimitedAsyncRequestManager) DeleteAsyncRequestDLQEntry(ctx context.Context, request *persistence.DeleteAsyncRequestDLQEntryRequest) (err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.DeleteAsyncRequestDLQEntry(ctx, request)
+}
+
+func (c *ratelimitedAsyncRequestManager) DeleteAsyncRequestQueueMessages(ctx context.Context, request *persistence.DeleteAsyncRequestQueueMessagesRequest) (err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.DeleteAsyncRequestQueueMessages(ctx, request)
+}
+
+func (c *ratelimitedAsyncRequestManager) EnqueueAsyncRequestQueueMessage(ctx context.Context, request *persistence.EnqueueAsyncRequestQueueMessageRequest) (ep1 *persistence.EnqueueAsyncRequestQueueMessageResponse, err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.EnqueueAsyncRequestQueueMessage(ctx, request)
+}
+
+func (c *ratelimitedAsyncRequestManager) EnqueueAsyncRequestToDLQ(ctx context.Context, request *persistence.EnqueueAsyncRequestToDLQRequest) (err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.EnqueueAsyncRequestToDLQ(ctx, request)
+}
+
+func (c *ratelimitedAsyncRequestManager) GetAsyncRequest(ctx context.Context, request *persistence.GetAsyncRequestRequest) (gp1 *persistence.GetAsyncRequestResponse, err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.GetAsyncRequest(ctx, request)
+}
+
+func (c *ratelimitedAsyncRequestManager) GetAsyncRequestDLQEntry(ctx context.Context, request *persistence.GetAsyncRequestDLQEntryRequest) (gp1 *persistence.GetAsyncRequestDLQEntryResponse, err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.GetAsyncRequestDLQEntry(ctx, request)
+}
+
+func (c *ratelimitedAsyncRequestManager) GetAsyncRequestQueueMetadata(ctx context.Context, request *persistence.GetAsyncRequestQueueMetadataRequest) (gp1 *persistence.GetAsyncRequestQueueMetadataResponse, err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.GetAsyncRequestQueueMetadata(ctx, request)
+}
+
+func (c *ratelimitedAsyncRequestManager) ReadAsyncRequestDLQ(ctx context.Context, request *persistence.ReadAsyncRequestDLQRequest) (rp1 *persistence.ReadAsyncRequestDLQResponse, err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.ReadAsyncRequestDLQ(ctx, request)
+}
+
+func (c *ratelimitedAsyncRequestManager) ReadAsyncRequestQueueMessages(ctx context.Context, request *persistence.ReadAsyncRequestQueueMessagesRequest) (rp1 *persistence.ReadAsyncRequestQueueMessagesResponse, err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.ReadAsyncRequestQueueMessages(ctx, request)
+}
+
+func (c *ratelimitedAsyncRequestManager) UpdateAsyncRequestQueueMetadata(ctx context.Context, request *persistence.UpdateAsyncRequestQueueMetadataRequest) (err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.UpdateAsyncRequestQueueMetadata(ctx, request)
+}
+
+func (c *ratelimitedAsyncRequestManager) UpsertAsyncRequest(ctx context.Context, request *persistence.UpsertAsyncRequestRequest) (err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.UpsertAsyncRequest(ctx, request)
+}
@@ -0,0 +1,74 @@
+package ratelimited
+
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../templates/ratelimited.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+)
+
+// ratelimitedMapQManager implements persistence.MapQManager interface instrumented with rate limiter.
+type ratelimitedMapQManager struct {
+	wrapped     persistence.MapQManager
+	rateLimiter quotas.Limiter
+}
+
+// NewMapQManager creates a new instance of MapQManager with ratelimiter.
+func NewMapQManager(
+	wrapped persistence.MapQManager,
+	rateLimiter quotas.Limiter,
+) persistence.MapQManager {
+	return &ratelimitedMapQManager{
+		wrapped:     wrapped,
+		rateLimiter: rateLimiter,
+	}
+}
+
+func (c *ratelimitedMapQManager) Close() {
+	c.wrapped.Close()
+	return
+}
+
+func (c *ratelimitedMapQManager) DeleteMapQItems(ctx context.Context, request *persistence.DeleteMapQItemsRequest) (err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.DeleteMapQItems(ctx, request)
+}
+
+func (c *ratelimitedMapQManager) EnqueueMapQItems(ctx context.Context, request *persistence.EnqueueMapQItemsRequest) (err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.EnqueueMapQItems(ctx, request)
+}
+
+func (c *ratelimitedMapQManager) GetMapQState(ctx context.Context, request *persistence.GetMapQStateRequest) (gp1 *persistence.GetMapQStateResponse, err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.GetMapQState(ctx, request)
+}
+
+func (c *ratelimitedMapQManager) ReadMapQItems(ctx context.Context, request *persistence.ReadMapQItemsRequest) (rp1 *persistence.ReadMapQItemsResponse, err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.ReadMapQItems(ctx, request)
+}
+
+func (c *ratelimitedMapQManager) UpdateMapQState(ctx context.Context, request *persistence.UpdateMapQStateRequest) (err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.UpdateMapQState(ctx, request)
+}
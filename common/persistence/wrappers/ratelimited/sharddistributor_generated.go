@@ -0,0 +1,74 @@
+package ratelimited
+
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../templates/ratelimited.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+)
+
+// ratelimitedShardDistributorManager implements persistence.ShardDistributorManager interface instrumented with rate limiter.
+type ratelimitedShardDistributorManager struct {
+	wrapped     persistence.ShardDistributorManager
+	rateLimiter quotas.Limiter
+}
+
+// NewShardDistributorManager creates a new instance of ShardDistributorManager with ratelimiter.
+func NewShardDistributorManager(
+	wrapped persistence.ShardDistributorManager,
+	rateLimiter quotas.Limiter,
+) persistence.ShardDistributorManager {
+	return &ratelimitedShardDistributorManager{
+		wrapped:     wrapped,
+		rateLimiter: rateLimiter,
+	}
+}
+
+func (c *ratelimitedShardDistributorManager) Close() {
+	c.wrapped.Close()
+	return
+}
+
+func (c *ratelimitedShardDistributorManager) AssignShardDistributorShards(ctx context.Context, request *persistence.AssignShardDistributorShardsRequest) (err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.AssignShardDistributorShards(ctx, request)
+}
+
+func (c *ratelimitedShardDistributorManager) DeleteShardDistributorExecutors(ctx context.Context, request *persistence.DeleteShardDistributorExecutorsRequest) (err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.DeleteShardDistributorExecutors(ctx, request)
+}
+
+func (c *ratelimitedShardDistributorManager) GetShardDistributorState(ctx context.Context, request *persistence.GetShardDistributorStateRequest) (gp1 *persistence.GetShardDistributorStateResponse, err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.GetShardDistributorState(ctx, request)
+}
+
+func (c *ratelimitedShardDistributorManager) UpdateShardDistributorLeader(ctx context.Context, request *persistence.UpdateShardDistributorLeaderRequest) (err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.UpdateShardDistributorLeader(ctx, request)
+}
+
+func (c *ratelimitedShardDistributorManager) UpsertShardDistributorExecutor(ctx context.Context, request *persistence.UpsertShardDistributorExecutorRequest) (err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.UpsertShardDistributorExecutor(ctx, request)
+}
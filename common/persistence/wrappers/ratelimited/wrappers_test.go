@@ -45,11 +45,14 @@ var _staticMethods = map[string]bool{
 }
 
 var wrappers = []any{
+	&ratelimitedAsyncRequestManager{},
 	&ratelimitedConfigStoreManager{},
 	&ratelimitedDomainManager{},
 	&ratelimitedHistoryManager{},
+	&ratelimitedMapQManager{},
 	&ratelimitedQueueManager{},
 	&ratelimitedShardManager{},
+	&ratelimitedShardDistributorManager{},
 	&ratelimitedTaskManager{},
 	&ratelimitedVisibilityManager{},
 	&ratelimitedExecutionManager{},
@@ -157,6 +160,22 @@ func TestInjectorsWithUnderlyingErrors(t *testing.T) {
 func builderForPassThrough(t *testing.T, injector any, limiter quotas.Limiter, expectCalls bool, expectedErr error) (object any) {
 	ctrl := gomock.NewController(t)
 	switch injector.(type) {
+	case *ratelimitedAsyncRequestManager:
+		mocked := persistence.NewMockAsyncRequestManager(ctrl)
+		object = NewAsyncRequestManager(mocked, limiter)
+		if expectCalls {
+			mocked.EXPECT().UpsertAsyncRequest(gomock.Any(), gomock.Any()).Return(expectedErr)
+			mocked.EXPECT().GetAsyncRequest(gomock.Any(), gomock.Any()).Return(&persistence.GetAsyncRequestResponse{}, expectedErr)
+			mocked.EXPECT().EnqueueAsyncRequestToDLQ(gomock.Any(), gomock.Any()).Return(expectedErr)
+			mocked.EXPECT().ReadAsyncRequestDLQ(gomock.Any(), gomock.Any()).Return(&persistence.ReadAsyncRequestDLQResponse{}, expectedErr)
+			mocked.EXPECT().GetAsyncRequestDLQEntry(gomock.Any(), gomock.Any()).Return(&persistence.GetAsyncRequestDLQEntryResponse{}, expectedErr)
+			mocked.EXPECT().DeleteAsyncRequestDLQEntry(gomock.Any(), gomock.Any()).Return(expectedErr)
+			mocked.EXPECT().EnqueueAsyncRequestQueueMessage(gomock.Any(), gomock.Any()).Return(&persistence.EnqueueAsyncRequestQueueMessageResponse{}, expectedErr)
+			mocked.EXPECT().ReadAsyncRequestQueueMessages(gomock.Any(), gomock.Any()).Return(&persistence.ReadAsyncRequestQueueMessagesResponse{}, expectedErr)
+			mocked.EXPECT().DeleteAsyncRequestQueueMessages(gomock.Any(), gomock.Any()).Return(expectedErr)
+			mocked.EXPECT().GetAsyncRequestQueueMetadata(gomock.Any(), gomock.Any()).Return(&persistence.GetAsyncRequestQueueMetadataResponse{}, expectedErr)
+			mocked.EXPECT().UpdateAsyncRequestQueueMetadata(gomock.Any(), gomock.Any()).Return(expectedErr)
+		}
 	case *ratelimitedConfigStoreManager:
 		mocked := persistence.NewMockConfigStoreManager(ctrl)
 		object = NewConfigStoreManager(mocked, limiter)
@@ -189,6 +208,26 @@ func builderForPassThrough(t *testing.T, injector any, limiter quotas.Limiter, e
 			mocked.EXPECT().GetHistoryTree(gomock.Any(), gomock.Any()).Return(&persistence.GetHistoryTreeResponse{}, expectedErr)
 			mocked.EXPECT().GetAllHistoryTreeBranches(gomock.Any(), gomock.Any()).Return(&persistence.GetAllHistoryTreeBranchesResponse{}, expectedErr)
 		}
+	case *ratelimitedMapQManager:
+		mocked := persistence.NewMockMapQManager(ctrl)
+		object = NewMapQManager(mocked, limiter)
+		if expectCalls {
+			mocked.EXPECT().EnqueueMapQItems(gomock.Any(), gomock.Any()).Return(expectedErr)
+			mocked.EXPECT().ReadMapQItems(gomock.Any(), gomock.Any()).Return(&persistence.ReadMapQItemsResponse{}, expectedErr)
+			mocked.EXPECT().DeleteMapQItems(gomock.Any(), gomock.Any()).Return(expectedErr)
+			mocked.EXPECT().GetMapQState(gomock.Any(), gomock.Any()).Return(&persistence.GetMapQStateResponse{}, expectedErr)
+			mocked.EXPECT().UpdateMapQState(gomock.Any(), gomock.Any()).Return(expectedErr)
+		}
+	case *ratelimitedShardDistributorManager:
+		mocked := persistence.NewMockShardDistributorManager(ctrl)
+		object = NewShardDistributorManager(mocked, limiter)
+		if expectCalls {
+			mocked.EXPECT().GetShardDistributorState(gomock.Any(), gomock.Any()).Return(&persistence.GetShardDistributorStateResponse{}, expectedErr)
+			mocked.EXPECT().UpdateShardDistributorLeader(gomock.Any(), gomock.Any()).Return(expectedErr)
+			mocked.EXPECT().UpsertShardDistributorExecutor(gomock.Any(), gomock.Any()).Return(expectedErr)
+			mocked.EXPECT().AssignShardDistributorShards(gomock.Any(), gomock.Any()).Return(expectedErr)
+			mocked.EXPECT().DeleteShardDistributorExecutors(gomock.Any(), gomock.Any()).Return(expectedErr)
+		}
 	case *ratelimitedQueueManager:
 		mocked := persistence.NewMockQueueManager(ctrl)
 		object = NewQueueManager(mocked, limiter)
@@ -38,6 +38,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sync"
 	"testing"
 	"time"
 
@@ -99,10 +100,24 @@ type (
 		ctxCancel func()
 		stopped   chan struct{} // closed when stopping is complete
 
+		// usage of the primary limiter per key, as of the last update tick
+		usageLock sync.RWMutex
+		usage     map[shared.LocalKey]Usage
+
 		// now exists largely for tests, elsewhere it is always time.Now
 		timesource clock.TimeSource
 	}
 
+	// Usage is the observed request rate of a single key on this host,
+	// averaged over the most recent update interval.
+	//
+	// Only the primary limiter of a key is counted, and keys in "disabled"
+	// mode are not tracked at all.
+	Usage struct {
+		AllowedRPS  float64
+		RejectedRPS float64
+	}
+
 	// basically an enum for key values.
 	// when plug-in behavior is allowed, this will eventually be from a parsed string,
 	// and values (aside from disabled) are not known at compile time.
@@ -218,6 +233,7 @@ func New(
 		ctx:       ctx,
 		ctxCancel: cancel,
 		stopped:   make(chan struct{}),
+		usage:     make(map[shared.LocalKey]Usage),
 
 		// override externally in tests
 		timesource: clock.NewRealTimeSource(),
@@ -310,6 +326,16 @@ func (c *Collection) For(key string) quotas.Limiter {
 	}
 }
 
+// Usage returns the request rate observed for a key on this host during the
+// most recent update interval.  False is returned if the key has not been
+// used recently, or if its mode does not track usage.
+func (c *Collection) Usage(key string) (Usage, bool) {
+	c.usageLock.RLock()
+	defer c.usageLock.RUnlock()
+	u, ok := c.usage[shared.LocalKey(key)]
+	return u, ok
+}
+
 func (c *Collection) shouldDeleteKey(mode keyMode, local bool) bool {
 	if local {
 		return !mode.usesLocal()
@@ -340,6 +366,10 @@ func (c *Collection) backgroundUpdateLoop() {
 				ticker.Reset(newTickInterval)
 			}
 
+			elapsed := now.Sub(lastGatherTime)
+			primaryUsage := make(map[shared.LocalKey]Usage, c.local.Len()+c.global.Len())
+			localUsage := make(map[shared.LocalKey]Usage, c.local.Len())
+
 			// submit local metrics asynchronously, because there's no need to do it synchronously
 			localMetricsDone := make(chan struct{})
 			go func() {
@@ -359,6 +389,9 @@ func (c *Collection) backgroundUpdateLoop() {
 					}
 
 					c.sendMetrics(k, true, mode, counts)
+					if mode.isLocalPrimary() {
+						localUsage[k] = usageOf(counts, elapsed)
+					}
 					return true
 				})
 			}()
@@ -395,6 +428,9 @@ func (c *Collection) backgroundUpdateLoop() {
 					Rejected: counts.Rejected,
 				}
 				c.sendMetrics(k, false, mode, counts)
+				if mode.isGlobalPrimary() {
+					primaryUsage[k] = usageOf(counts, elapsed)
+				}
 
 				return true
 			})
@@ -414,11 +450,29 @@ func (c *Collection) backgroundUpdateLoop() {
 
 			<-localMetricsDone // should be much faster than doUpdate, unless it's no-opped
 
+			for k, u := range localUsage {
+				primaryUsage[k] = u
+			}
+			c.usageLock.Lock()
+			c.usage = primaryUsage
+			c.usageLock.Unlock()
+
 			lastGatherTime = now
 		}
 	}
 }
 
+func usageOf(counts internal.UsageMetrics, elapsed time.Duration) Usage {
+	seconds := elapsed.Seconds()
+	if seconds <= 0 {
+		return Usage{}
+	}
+	return Usage{
+		AllowedRPS:  float64(counts.Allowed) / seconds,
+		RejectedRPS: float64(counts.Rejected) / seconds,
+	}
+}
+
 func (c *Collection) sendMetrics(lkey shared.LocalKey, isLocalLimiter bool, mode keyMode, usage internal.UsageMetrics) {
 	// emit quota information to make monitoring easier.
 	// regrettably this will only be emitted when the key is (recently) in use, but
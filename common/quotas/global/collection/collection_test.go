@@ -273,6 +273,57 @@ func TestCollectionSubmitsDataAndUpdates(t *testing.T) {
 	}
 }
 
+func TestCollectionTracksUsage(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	var mode atomic.Value
+	mode.Store(modeLocal)
+	c, err := New(
+		"test",
+		quotas.NewCollection(dynamicquotas.NewSimpleDynamicRateLimiterFactory(func(domain string) int { return 1 })),
+		quotas.NewCollection(dynamicquotas.NewSimpleDynamicRateLimiterFactory(func(domain string) int { return 1 })),
+		func(opts ...dynamicproperties.FilterOption) time.Duration { return time.Second },
+		func(domain string) int { return 1 },
+		func(globalRatelimitKey string) string { return string(mode.Load().(keyMode)) },
+		nil, // local and disabled modes do not send updates
+		testlogger.New(t),
+		metrics.NewNoopMetricsClient(),
+	)
+	require.NoError(t, err)
+	ts := clock.NewMockedTimeSource()
+	c.TestOverrides(t, &ts, nil)
+
+	require.NoError(t, c.OnStart(context.Background()), "failed to start")
+	defer func() {
+		require.NoError(t, c.OnStop(context.Background()), "failed to stop")
+	}()
+	ts.BlockUntil(1) // background ticker created
+	tick := func() {
+		ts.Advance(time.Second)
+		time.Sleep(10 * time.Millisecond) // allow the update to run
+	}
+
+	_, ok := c.Usage("key")
+	assert.False(t, ok, "no usage should be known before the first update")
+
+	assert.True(t, c.For("key").Allow(), "first request should be allowed")
+	assert.False(t, c.For("key").Allow(), "second request should be rejected")
+	tick()
+	usage, ok := c.Usage("key")
+	assert.True(t, ok, "usage should be known after an update")
+	assert.Equal(t, Usage{AllowedRPS: 1, RejectedRPS: 1}, usage)
+
+	tick()
+	usage, ok = c.Usage("key")
+	assert.True(t, ok, "idle keys should still report usage until they are garbage collected")
+	assert.Equal(t, Usage{}, usage)
+
+	mode.Store(modeDisabled)
+	c.For("key").Allow()
+	tick()
+	_, ok = c.Usage("key")
+	assert.False(t, ok, "disabled keys should not track usage")
+}
+
 func TestTogglingMode(t *testing.T) {
 	defer goleak.VerifyNone(t)
 	aggs := rpc.NewMockClient(gomock.NewController(t))
@@ -56,6 +56,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/messaging/dbqueue"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	persistenceClient "github.com/uber/cadence/common/persistence/client"
@@ -195,7 +196,7 @@ func New(
 	if params.NewPersistenceBeanFn != nil {
 		newPersistenceBeanFn = params.NewPersistenceBeanFn
 	}
-	persistenceBean, err := newPersistenceBeanFn(persistenceClient.NewFactory(
+	persistenceFactory := persistenceClient.NewFactory(
 		&params.PersistenceConfig,
 		func() float64 {
 			return permember.PerMember(
@@ -209,10 +210,21 @@ func New(
 		params.MetricsClient,
 		logger,
 		persistence.NewDynamicConfiguration(dynamicCollection),
-	), &persistenceClient.Params{
+	)
+
+	messagingClient := params.MessagingClient
+	if params.PersistenceConfig.IsPersistenceVisibilityTransport() {
+		visibilityQueue, err := persistenceFactory.NewVisibilityQueueManager()
+		if err != nil {
+			return nil, err
+		}
+		messagingClient = dbqueue.NewClient(visibilityQueue, membershipResolver, logger)
+	}
+
+	persistenceBean, err := newPersistenceBeanFn(persistenceFactory, &persistenceClient.Params{
 		PersistenceConfig: params.PersistenceConfig,
 		MetricsClient:     params.MetricsClient,
-		MessagingClient:   params.MessagingClient,
+		MessagingClient:   messagingClient,
 		ESClient:          params.ESClient,
 		ESConfig:          params.ESConfig,
 		PinotConfig:       params.PinotConfig,
@@ -360,7 +372,7 @@ func New(
 		timeSource:              clock.NewRealTimeSource(),
 		payloadSerializer:       persistence.NewPayloadSerializer(),
 		metricsClient:           params.MetricsClient,
-		messagingClient:         params.MessagingClient,
+		messagingClient:         messagingClient,
 		blobstoreClient:         params.BlobstoreClient,
 		archivalMetadata:        params.ArchivalMetadata,
 		archiverProvider:        params.ArchiverProvider,
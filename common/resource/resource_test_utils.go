@@ -100,6 +100,8 @@ type (
 		ShardMgr        *mocks.ShardManager
 		HistoryMgr      *mocks.HistoryV2Manager
 		ExecutionMgr    *mocks.ExecutionManager
+		AsyncRequestMgr *persistence.MockAsyncRequestManager
+		MapQMgr         *persistence.MockMapQManager
 		PersistenceBean *persistenceClient.MockBean
 
 		IsolationGroups     *isolationgroup.MockState
@@ -150,6 +152,8 @@ func NewTest(
 	shardMgr := &mocks.ShardManager{}
 	historyMgr := &mocks.HistoryV2Manager{}
 	executionMgr := &mocks.ExecutionManager{}
+	asyncRequestMgr := persistence.NewMockAsyncRequestManager(controller)
+	mapQMgr := persistence.NewMockMapQManager(controller)
 	domainReplicationQueue := domain.NewMockReplicationQueue(controller)
 	domainReplicationQueue.EXPECT().Start().AnyTimes()
 	domainReplicationQueue.EXPECT().Stop().AnyTimes()
@@ -160,6 +164,8 @@ func NewTest(
 	persistenceBean.EXPECT().GetHistoryManager().Return(historyMgr).AnyTimes()
 	persistenceBean.EXPECT().GetShardManager().Return(shardMgr).AnyTimes()
 	persistenceBean.EXPECT().GetExecutionManager(gomock.Any()).Return(executionMgr, nil).AnyTimes()
+	persistenceBean.EXPECT().GetAsyncRequestManager().Return(asyncRequestMgr).AnyTimes()
+	persistenceBean.EXPECT().GetMapQManager().Return(mapQMgr).AnyTimes()
 
 	isolationGroupMock := isolationgroup.NewMockState(controller)
 	isolationGroupMock.EXPECT().Stop().AnyTimes()
@@ -214,6 +220,8 @@ func NewTest(
 		ShardMgr:        shardMgr,
 		HistoryMgr:      historyMgr,
 		ExecutionMgr:    executionMgr,
+		AsyncRequestMgr: asyncRequestMgr,
+		MapQMgr:         mapQMgr,
 		PersistenceBean: persistenceBean,
 		IsolationGroups: isolationGroupMock,
 		// logger
@@ -20,7 +20,10 @@
 
 package types
 
-import "sort"
+import (
+	"sort"
+	"time"
+)
 
 // AddSearchAttributeRequest is an internal type (TBD...)
 type AddSearchAttributeRequest struct {
@@ -310,11 +313,74 @@ const (
 	IsolationGroupStateInvalid IsolationGroupState = iota
 	IsolationGroupStateHealthy
 	IsolationGroupStateDrained
+	// IsolationGroupStateWeighted indicates that only a share of the traffic,
+	// given by the partition's Weight, should be kept within the isolation group.
+	// It's used to gradually drain or restore a zone rather than flipping it at once.
+	IsolationGroupStateWeighted
 )
 
+// IsolationGroupMaxWeight is the traffic weight of a fully healthy isolation group
+const IsolationGroupMaxWeight int32 = 100
+
 type IsolationGroupPartition struct {
 	Name  string
 	State IsolationGroupState
+	// Weight is the percentage (0-100) of traffic to keep in the isolation group,
+	// it's only considered when the State is IsolationGroupStateWeighted
+	Weight int32 `json:",omitempty"`
+	// Ramp, when set, takes precedence over Weight and moves the isolation group
+	// through a schedule of weights on the server side
+	Ramp *IsolationGroupWeightRamp `json:",omitempty"`
+}
+
+// IsolationGroupWeightRamp is a schedule of traffic weights. Each weight is applied in turn
+// for IntervalSeconds, starting at StartTimestamp (unix nanos), and the last weight is kept
+// once the schedule has been gone through
+type IsolationGroupWeightRamp struct {
+	Weights         []int32
+	StartTimestamp  int64
+	IntervalSeconds int32
+}
+
+// WeightAt returns the weight of the ramp schedule which applies at the given time
+func (r *IsolationGroupWeightRamp) WeightAt(now time.Time) int32 {
+	if r == nil || len(r.Weights) == 0 {
+		return IsolationGroupMaxWeight
+	}
+	if r.IntervalSeconds <= 0 {
+		return r.Weights[len(r.Weights)-1]
+	}
+	elapsed := now.Sub(time.Unix(0, r.StartTimestamp))
+	if elapsed < 0 {
+		return r.Weights[0]
+	}
+	step := int64(elapsed / (time.Duration(r.IntervalSeconds) * time.Second))
+	if step >= int64(len(r.Weights)) {
+		return r.Weights[len(r.Weights)-1]
+	}
+	return r.Weights[step]
+}
+
+// TrafficWeight returns the percentage (0-100) of traffic which should be kept in the isolation group at the given time
+func (p IsolationGroupPartition) TrafficWeight(now time.Time) int32 {
+	switch p.State {
+	case IsolationGroupStateDrained:
+		return 0
+	case IsolationGroupStateWeighted:
+		weight := p.Weight
+		if p.Ramp != nil {
+			weight = p.Ramp.WeightAt(now)
+		}
+		if weight < 0 {
+			return 0
+		}
+		if weight > IsolationGroupMaxWeight {
+			return IsolationGroupMaxWeight
+		}
+		return weight
+	default:
+		return IsolationGroupMaxWeight
+	}
 }
 
 // IsolationGroupConfiguration is an internal representation of a set of
@@ -351,6 +417,11 @@ func (i IsolationGroupConfiguration) DeepCopy() IsolationGroupConfiguration {
 
 	out := IsolationGroupConfiguration{}
 	for k, v := range i {
+		if v.Ramp != nil {
+			ramp := *v.Ramp
+			ramp.Weights = append([]int32(nil), v.Ramp.Weights...)
+			v.Ramp = &ramp
+		}
 		out[k] = v
 	}
 	return out
@@ -430,6 +501,154 @@ type UpdateDomainAsyncWorkflowConfiguratonRequest struct {
 type UpdateDomainAsyncWorkflowConfiguratonResponse struct {
 }
 
+// AsyncWorkflowDLQMessage is an async workflow request that the queue consumer failed to process
+type AsyncWorkflowDLQMessage struct {
+	RequestID       string
+	RequestType     string
+	WorkflowID      string
+	Failure         string
+	CreatedTimeNano *int64
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *AsyncWorkflowDLQMessage) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// GetCreatedTimeNano is an internal getter (TBD...)
+func (v *AsyncWorkflowDLQMessage) GetCreatedTimeNano() (o int64) {
+	if v != nil && v.CreatedTimeNano != nil {
+		return *v.CreatedTimeNano
+	}
+	return
+}
+
+type ReadAsyncWorkflowDLQMessagesRequest struct {
+	Domain        string
+	PageSize      int32
+	NextPageToken []byte
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ReadAsyncWorkflowDLQMessagesRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+type ReadAsyncWorkflowDLQMessagesResponse struct {
+	Messages      []*AsyncWorkflowDLQMessage
+	NextPageToken []byte
+}
+
+// GetMessages is an internal getter (TBD...)
+func (v *ReadAsyncWorkflowDLQMessagesResponse) GetMessages() (o []*AsyncWorkflowDLQMessage) {
+	if v != nil && v.Messages != nil {
+		return v.Messages
+	}
+	return
+}
+
+// GetNextPageToken is an internal getter (TBD...)
+func (v *ReadAsyncWorkflowDLQMessagesResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+	return
+}
+
+type PurgeAsyncWorkflowDLQMessagesRequest struct {
+	Domain     string
+	RequestIDs []string
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *PurgeAsyncWorkflowDLQMessagesRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+type PurgeAsyncWorkflowDLQMessagesResponse struct{}
+
+// ReplayAsyncWorkflowDLQMessagesRequest re-publishes DLQ messages to the async workflow queue of the domain
+type ReplayAsyncWorkflowDLQMessagesRequest struct {
+	Domain     string
+	RequestIDs []string
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ReplayAsyncWorkflowDLQMessagesRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+type ReplayAsyncWorkflowDLQMessagesResponse struct{}
+
+// DescribeAsyncWorkflowQueueRequest describes the async workflow queue of a domain
+type DescribeAsyncWorkflowQueueRequest struct {
+	Domain string
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *DescribeAsyncWorkflowQueueRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+type DescribeAsyncWorkflowQueueResponse struct {
+	QueueID string
+	// Partitions is only set for queues that can report their consumption progress
+	Partitions []*AsyncWorkflowQueuePartition
+}
+
+// GetQueueID is an internal getter (TBD...)
+func (v *DescribeAsyncWorkflowQueueResponse) GetQueueID() (o string) {
+	if v != nil {
+		return v.QueueID
+	}
+	return
+}
+
+// GetPartitions is an internal getter (TBD...)
+func (v *DescribeAsyncWorkflowQueueResponse) GetPartitions() (o []*AsyncWorkflowQueuePartition) {
+	if v != nil && v.Partitions != nil {
+		return v.Partitions
+	}
+	return
+}
+
+// AsyncWorkflowQueuePartition is a partition of an async workflow queue and its committed offset
+type AsyncWorkflowQueuePartition struct {
+	Path     string
+	AckLevel int64
+}
+
+// GetPath is an internal getter (TBD...)
+func (v *AsyncWorkflowQueuePartition) GetPath() (o string) {
+	if v != nil {
+		return v.Path
+	}
+	return
+}
+
+// GetAckLevel is an internal getter (TBD...)
+func (v *AsyncWorkflowQueuePartition) GetAckLevel() (o int64) {
+	if v != nil {
+		return v.AckLevel
+	}
+	return
+}
+
 type UpdateTaskListPartitionConfigRequest struct {
 	Domain          string
 	TaskList        *TaskList
@@ -438,3 +657,216 @@ type UpdateTaskListPartitionConfigRequest struct {
 }
 
 type UpdateTaskListPartitionConfigResponse struct{}
+
+// UpdateTaskListVersioningConfigRequest changes the worker build IDs of a decision task list.
+// Exactly one of the operations must be set.
+type UpdateTaskListVersioningConfigRequest struct {
+	Domain   string
+	TaskList *TaskList
+	// AddNewDefaultBuildID adds the build ID in a new compatible set and makes that set the default
+	AddNewDefaultBuildID string
+	// AddCompatibleBuildID adds the build ID to the compatible set of an existing build ID
+	AddCompatibleBuildID *AddCompatibleBuildID
+	// PromoteBuildID makes the compatible set of the build ID the default set,
+	// it is used both to promote a new build and to roll back to a previous one
+	PromoteBuildID string
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UpdateTaskListVersioningConfigRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *UpdateTaskListVersioningConfigRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetAddNewDefaultBuildID is an internal getter (TBD...)
+func (v *UpdateTaskListVersioningConfigRequest) GetAddNewDefaultBuildID() (o string) {
+	if v != nil {
+		return v.AddNewDefaultBuildID
+	}
+	return
+}
+
+// GetAddCompatibleBuildID is an internal getter (TBD...)
+func (v *UpdateTaskListVersioningConfigRequest) GetAddCompatibleBuildID() (o *AddCompatibleBuildID) {
+	if v != nil && v.AddCompatibleBuildID != nil {
+		return v.AddCompatibleBuildID
+	}
+	return
+}
+
+// GetPromoteBuildID is an internal getter (TBD...)
+func (v *UpdateTaskListVersioningConfigRequest) GetPromoteBuildID() (o string) {
+	if v != nil {
+		return v.PromoteBuildID
+	}
+	return
+}
+
+// AddCompatibleBuildID adds a build ID to the compatible set of an existing build ID
+type AddCompatibleBuildID struct {
+	BuildID                   string
+	ExistingCompatibleBuildID string
+	// MakeDefault makes the build ID the default of its set, the set is also made the default set
+	MakeDefault bool
+}
+
+// GetBuildID is an internal getter (TBD...)
+func (v *AddCompatibleBuildID) GetBuildID() (o string) {
+	if v != nil {
+		return v.BuildID
+	}
+	return
+}
+
+// GetExistingCompatibleBuildID is an internal getter (TBD...)
+func (v *AddCompatibleBuildID) GetExistingCompatibleBuildID() (o string) {
+	if v != nil {
+		return v.ExistingCompatibleBuildID
+	}
+	return
+}
+
+// GetMakeDefault is an internal getter (TBD...)
+func (v *AddCompatibleBuildID) GetMakeDefault() (o bool) {
+	if v != nil {
+		return v.MakeDefault
+	}
+	return
+}
+
+type UpdateTaskListVersioningConfigResponse struct {
+	VersioningConfig *TaskListVersioningConfig
+}
+
+// GetVersioningConfig is an internal getter (TBD...)
+func (v *UpdateTaskListVersioningConfigResponse) GetVersioningConfig() (o *TaskListVersioningConfig) {
+	if v != nil && v.VersioningConfig != nil {
+		return v.VersioningConfig
+	}
+	return
+}
+
+// MigrateTaskListRequest moves the backlog of all partitions of a task list to another task list.
+// Without target task list and Stop, it only reports the progress of the current migration.
+type MigrateTaskListRequest struct {
+	Domain         string
+	TaskList       *TaskList
+	TaskListType   *TaskListType
+	TargetTaskList *TaskList
+	// Alias redirects activity tasks added to the task list to the target task list,
+	// so running workflows keep making progress after the task list is retired
+	Alias bool
+	// Stop ends the migration and removes the alias, remaining backlog stays in the task list
+	Stop bool
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *MigrateTaskListRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *MigrateTaskListRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *MigrateTaskListRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// GetTargetTaskList is an internal getter (TBD...)
+func (v *MigrateTaskListRequest) GetTargetTaskList() (o *TaskList) {
+	if v != nil && v.TargetTaskList != nil {
+		return v.TargetTaskList
+	}
+	return
+}
+
+// GetAlias is an internal getter (TBD...)
+func (v *MigrateTaskListRequest) GetAlias() (o bool) {
+	if v != nil {
+		return v.Alias
+	}
+	return
+}
+
+// GetStop is an internal getter (TBD...)
+func (v *MigrateTaskListRequest) GetStop() (o bool) {
+	if v != nil {
+		return v.Stop
+	}
+	return
+}
+
+type MigrateTaskListResponse struct {
+	// MigrationConfig is nil if the task list is not being migrated
+	MigrationConfig *TaskListMigrationConfig
+	Partitions      []*TaskListMigrationPartitionStatus
+}
+
+// GetMigrationConfig is an internal getter (TBD...)
+func (v *MigrateTaskListResponse) GetMigrationConfig() (o *TaskListMigrationConfig) {
+	if v != nil && v.MigrationConfig != nil {
+		return v.MigrationConfig
+	}
+	return
+}
+
+// GetPartitions is an internal getter (TBD...)
+func (v *MigrateTaskListResponse) GetPartitions() (o []*TaskListMigrationPartitionStatus) {
+	if v != nil && v.Partitions != nil {
+		return v.Partitions
+	}
+	return
+}
+
+// TaskListMigrationPartitionStatus is the remaining backlog of a partition of a migrated task list
+type TaskListMigrationPartitionStatus struct {
+	Partition        string
+	OwnerHostName    string
+	BacklogCountHint int64
+}
+
+// GetPartition is an internal getter (TBD...)
+func (v *TaskListMigrationPartitionStatus) GetPartition() (o string) {
+	if v != nil {
+		return v.Partition
+	}
+	return
+}
+
+// GetOwnerHostName is an internal getter (TBD...)
+func (v *TaskListMigrationPartitionStatus) GetOwnerHostName() (o string) {
+	if v != nil {
+		return v.OwnerHostName
+	}
+	return
+}
+
+// GetBacklogCountHint is an internal getter (TBD...)
+func (v *TaskListMigrationPartitionStatus) GetBacklogCountHint() (o int64) {
+	if v != nil {
+		return v.BacklogCountHint
+	}
+	return
+}
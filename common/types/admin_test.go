@@ -24,6 +24,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -858,6 +859,124 @@ func TestFromIsolationGroupPartitionList(t *testing.T) {
 	}
 }
 
+func TestIsolationGroupPartitionTrafficWeight(t *testing.T) {
+	now := time.Unix(1000, 0)
+	ramp := &IsolationGroupWeightRamp{
+		Weights:         []int32{75, 50, 0},
+		StartTimestamp:  now.Add(-90 * time.Second).UnixNano(),
+		IntervalSeconds: 60,
+	}
+	tests := []struct {
+		name string
+		in   IsolationGroupPartition
+		want int32
+	}{
+		{
+			name: "healthy",
+			in:   IsolationGroupPartition{Name: "zone-1", State: IsolationGroupStateHealthy},
+			want: 100,
+		},
+		{
+			name: "invalid state is treated as healthy",
+			in:   IsolationGroupPartition{Name: "zone-1"},
+			want: 100,
+		},
+		{
+			name: "drained",
+			in:   IsolationGroupPartition{Name: "zone-1", State: IsolationGroupStateDrained, Weight: 50},
+			want: 0,
+		},
+		{
+			name: "weighted",
+			in:   IsolationGroupPartition{Name: "zone-1", State: IsolationGroupStateWeighted, Weight: 30},
+			want: 30,
+		},
+		{
+			name: "weighted above max",
+			in:   IsolationGroupPartition{Name: "zone-1", State: IsolationGroupStateWeighted, Weight: 150},
+			want: 100,
+		},
+		{
+			name: "weighted below zero",
+			in:   IsolationGroupPartition{Name: "zone-1", State: IsolationGroupStateWeighted, Weight: -1},
+			want: 0,
+		},
+		{
+			name: "weight is ignored for healthy groups",
+			in:   IsolationGroupPartition{Name: "zone-1", State: IsolationGroupStateHealthy, Weight: 10},
+			want: 100,
+		},
+		{
+			name: "ramp takes precedence over weight",
+			in:   IsolationGroupPartition{Name: "zone-1", State: IsolationGroupStateWeighted, Weight: 10, Ramp: ramp},
+			want: 50,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.in.TrafficWeight(now))
+		})
+	}
+}
+
+func TestIsolationGroupWeightRampWeightAt(t *testing.T) {
+	start := time.Unix(1000, 0)
+	ramp := &IsolationGroupWeightRamp{
+		Weights:         []int32{75, 50, 25, 0},
+		StartTimestamp:  start.UnixNano(),
+		IntervalSeconds: 60,
+	}
+	tests := []struct {
+		name string
+		ramp *IsolationGroupWeightRamp
+		now  time.Time
+		want int32
+	}{
+		{
+			name: "nil ramp",
+			now:  start,
+			want: 100,
+		},
+		{
+			name: "before the start",
+			ramp: ramp,
+			now:  start.Add(-time.Minute),
+			want: 75,
+		},
+		{
+			name: "first step",
+			ramp: ramp,
+			now:  start.Add(59 * time.Second),
+			want: 75,
+		},
+		{
+			name: "third step",
+			ramp: ramp,
+			now:  start.Add(2 * time.Minute),
+			want: 25,
+		},
+		{
+			name: "schedule completed",
+			ramp: ramp,
+			now:  start.Add(time.Hour),
+			want: 0,
+		},
+		{
+			name: "no interval applies the last weight",
+			ramp: &IsolationGroupWeightRamp{Weights: []int32{75, 50}, StartTimestamp: start.UnixNano()},
+			now:  start,
+			want: 50,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.ramp.WeightAt(tt.now))
+		})
+	}
+}
+
 func ptrInt64(i int64) *int64 {
 	return &i
 }
@@ -65,6 +65,8 @@ func EventTypeValues() []EventType {
 		EventTypeSignalExternalWorkflowExecutionFailed,
 		EventTypeExternalWorkflowExecutionSignaled,
 		EventTypeUpsertWorkflowSearchAttributes,
+		EventTypeUpsertWorkflowMemo,
+		EventTypeWorkflowExecutionMemoUpdated,
 	}
 }
 
@@ -84,5 +86,6 @@ func DecisionTypeValues() []DecisionType {
 		DecisionTypeStartChildWorkflowExecution,
 		DecisionTypeSignalExternalWorkflowExecution,
 		DecisionTypeUpsertWorkflowSearchAttributes,
+		DecisionTypeUpsertWorkflowMemo,
 	}
 }
@@ -546,12 +546,13 @@ func (v *HistoryRecordActivityTaskHeartbeatRequest) GetDomainUUID() (o string) {
 
 // RecordActivityTaskStartedRequest is an internal type (TBD...)
 type RecordActivityTaskStartedRequest struct {
-	DomainUUID        string                      `json:"domainUUID,omitempty"`
-	WorkflowExecution *WorkflowExecution          `json:"workflowExecution,omitempty"`
-	ScheduleID        int64                       `json:"scheduleId,omitempty"`
-	TaskID            int64                       `json:"taskId,omitempty"`
-	RequestID         string                      `json:"requestId,omitempty"`
-	PollRequest       *PollForActivityTaskRequest `json:"pollRequest,omitempty"`
+	DomainUUID               string                      `json:"domainUUID,omitempty"`
+	WorkflowExecution        *WorkflowExecution          `json:"workflowExecution,omitempty"`
+	ScheduleID               int64                       `json:"scheduleId,omitempty"`
+	TaskID                   int64                       `json:"taskId,omitempty"`
+	RequestID                string                      `json:"requestId,omitempty"`
+	PollRequest              *PollForActivityTaskRequest `json:"pollRequest,omitempty"`
+	ScheduleToStartBreakdown *ScheduleToStartBreakdown   `json:"scheduleToStartBreakdown,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -586,6 +587,14 @@ func (v *RecordActivityTaskStartedRequest) GetRequestID() (o string) {
 	return
 }
 
+// GetScheduleToStartBreakdown is an internal getter (TBD...)
+func (v *RecordActivityTaskStartedRequest) GetScheduleToStartBreakdown() (o *ScheduleToStartBreakdown) {
+	if v != nil && v.ScheduleToStartBreakdown != nil {
+		return v.ScheduleToStartBreakdown
+	}
+	return
+}
+
 // RecordActivityTaskStartedResponse is an internal type (TBD...)
 type RecordActivityTaskStartedResponse struct {
 	ScheduledEvent                  *HistoryEvent `json:"scheduledEvent,omitempty"`
@@ -631,6 +640,117 @@ func (v *RecordChildExecutionCompletedRequest) GetDomainUUID() (o string) {
 	return
 }
 
+// GetCompletionCallbacksRequest is an internal type (TBD...)
+type GetCompletionCallbacksRequest struct {
+	DomainUUID        string             `json:"domainUUID,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *GetCompletionCallbacksRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *GetCompletionCallbacksRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetCompletionCallbacksResponse is an internal type (TBD...)
+type GetCompletionCallbacksResponse struct {
+	Callbacks     []*CompletionCallback         `json:"callbacks,omitempty"`
+	WorkflowType  *WorkflowType                 `json:"workflowType,omitempty"`
+	CloseStatus   *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
+	CloseTime     *int64                        `json:"closeTime,omitempty"`
+	HistoryLength int64                         `json:"historyLength,omitempty"`
+}
+
+// GetCallbacks is an internal getter (TBD...)
+func (v *GetCompletionCallbacksResponse) GetCallbacks() (o []*CompletionCallback) {
+	if v != nil && v.Callbacks != nil {
+		return v.Callbacks
+	}
+	return
+}
+
+// GetWorkflowType is an internal getter (TBD...)
+func (v *GetCompletionCallbacksResponse) GetWorkflowType() (o *WorkflowType) {
+	if v != nil && v.WorkflowType != nil {
+		return v.WorkflowType
+	}
+	return
+}
+
+// GetCloseStatus is an internal getter (TBD...)
+func (v *GetCompletionCallbacksResponse) GetCloseStatus() (o WorkflowExecutionCloseStatus) {
+	if v != nil && v.CloseStatus != nil {
+		return *v.CloseStatus
+	}
+	return
+}
+
+// GetCloseTime is an internal getter (TBD...)
+func (v *GetCompletionCallbacksResponse) GetCloseTime() (o int64) {
+	if v != nil && v.CloseTime != nil {
+		return *v.CloseTime
+	}
+	return
+}
+
+// GetHistoryLength is an internal getter (TBD...)
+func (v *GetCompletionCallbacksResponse) GetHistoryLength() (o int64) {
+	if v != nil {
+		return v.HistoryLength
+	}
+	return
+}
+
+// RecordCompletionCallbackStateRequest is an internal type (TBD...)
+type RecordCompletionCallbackStateRequest struct {
+	DomainUUID        string                  `json:"domainUUID,omitempty"`
+	WorkflowExecution *WorkflowExecution      `json:"workflowExecution,omitempty"`
+	Index             int32                   `json:"index,omitempty"`
+	State             *CompletionCallbackInfo `json:"state,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *RecordCompletionCallbackStateRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *RecordCompletionCallbackStateRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetIndex is an internal getter (TBD...)
+func (v *RecordCompletionCallbackStateRequest) GetIndex() (o int32) {
+	if v != nil {
+		return v.Index
+	}
+	return
+}
+
+// GetState is an internal getter (TBD...)
+func (v *RecordCompletionCallbackStateRequest) GetState() (o *CompletionCallbackInfo) {
+	if v != nil && v.State != nil {
+		return v.State
+	}
+	return
+}
+
 // RecordDecisionTaskStartedRequest is an internal type (TBD...)
 type RecordDecisionTaskStartedRequest struct {
 	DomainUUID        string                      `json:"domainUUID,omitempty"`
@@ -1260,6 +1380,28 @@ func (v *HistoryTerminateWorkflowExecutionRequest) GetChildWorkflowOnly() (o boo
 	return
 }
 
+// HistoryUpdateWorkflowMemoRequest is an internal type (TBD...)
+type HistoryUpdateWorkflowMemoRequest struct {
+	DomainUUID    string                     `json:"domainUUID,omitempty"`
+	UpdateRequest *UpdateWorkflowMemoRequest `json:"updateRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryUpdateWorkflowMemoRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetUpdateRequest is an internal getter (TBD...)
+func (v *HistoryUpdateWorkflowMemoRequest) GetUpdateRequest() (o *UpdateWorkflowMemoRequest) {
+	if v != nil && v.UpdateRequest != nil {
+		return v.UpdateRequest
+	}
+	return
+}
+
 // GetFailoverInfoRequest is an internal type (TBD...)
 type GetFailoverInfoRequest struct {
 	DomainID string `json:"domainID,omitempty"`
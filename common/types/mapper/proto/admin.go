@@ -1326,7 +1326,7 @@ func FromIsolationGroupConfig(in *types.IsolationGroupConfiguration) *apiv1.Isol
 	for _, v := range *in {
 		out = append(out, &apiv1.IsolationGroupPartition{
 			Name:  v.Name,
-			State: apiv1.IsolationGroupState(v.State),
+			State: FromIsolationGroupState(v.State),
 		})
 	}
 	sort.Slice(out, func(i, j int) bool {
@@ -1348,12 +1348,34 @@ func ToIsolationGroupConfig(in *apiv1.IsolationGroupConfiguration) *types.Isolat
 	for v := range in.IsolationGroups {
 		out[in.IsolationGroups[v].Name] = types.IsolationGroupPartition{
 			Name:  in.IsolationGroups[v].Name,
-			State: types.IsolationGroupState(in.IsolationGroups[v].State),
+			State: ToIsolationGroupState(in.IsolationGroups[v].State),
 		}
 	}
 	return &out
 }
 
+// FromIsolationGroupState maps weighted isolation groups to the invalid state, the public
+// gRPC API can't carry their weight, so updates with them are rejected by the server
+func FromIsolationGroupState(t types.IsolationGroupState) apiv1.IsolationGroupState {
+	switch t {
+	case types.IsolationGroupStateHealthy:
+		return apiv1.IsolationGroupState_ISOLATION_GROUP_STATE_HEALTHY
+	case types.IsolationGroupStateDrained:
+		return apiv1.IsolationGroupState_ISOLATION_GROUP_STATE_DRAINED
+	}
+	return apiv1.IsolationGroupState_ISOLATION_GROUP_STATE_INVALID
+}
+
+func ToIsolationGroupState(t apiv1.IsolationGroupState) types.IsolationGroupState {
+	switch t {
+	case apiv1.IsolationGroupState_ISOLATION_GROUP_STATE_HEALTHY:
+		return types.IsolationGroupStateHealthy
+	case apiv1.IsolationGroupState_ISOLATION_GROUP_STATE_DRAINED:
+		return types.IsolationGroupStateDrained
+	}
+	return types.IsolationGroupStateInvalid
+}
+
 func ToAdminGetDomainAsyncWorkflowConfiguratonRequest(in *adminv1.GetDomainAsyncWorkflowConfiguratonRequest) *types.GetDomainAsyncWorkflowConfiguratonRequest {
 	if in == nil {
 		return nil
@@ -631,6 +631,32 @@ func TestToUpdateDomainIsolationGroupsRequest(t *testing.T) {
 	}
 }
 
+func TestIsolationGroupConfigWeighted(t *testing.T) {
+	in := types.IsolationGroupConfiguration{
+		"zone 1": {Name: "zone 1", State: types.IsolationGroupStateHealthy},
+		"zone 2": {Name: "zone 2", State: types.IsolationGroupStateWeighted, Weight: 40},
+		"zone 3": {Name: "zone 3", State: types.IsolationGroupStateWeighted, Ramp: &types.IsolationGroupWeightRamp{Weights: []int32{50, 0}}},
+	}
+	res := FromIsolationGroupConfig(&in)
+	assert.Equal(t, &v1.IsolationGroupConfiguration{
+		IsolationGroups: []*v1.IsolationGroupPartition{
+			{Name: "zone 1", State: v1.IsolationGroupState_ISOLATION_GROUP_STATE_HEALTHY},
+			{Name: "zone 2", State: v1.IsolationGroupState_ISOLATION_GROUP_STATE_INVALID},
+			{Name: "zone 3", State: v1.IsolationGroupState_ISOLATION_GROUP_STATE_INVALID},
+		},
+	}, res)
+
+	// states unknown to the public API must not turn into weighted isolation groups without a weight
+	out := ToIsolationGroupConfig(&v1.IsolationGroupConfiguration{
+		IsolationGroups: []*v1.IsolationGroupPartition{
+			{Name: "zone 2", State: v1.IsolationGroupState(types.IsolationGroupStateWeighted)},
+		},
+	})
+	assert.Equal(t, &types.IsolationGroupConfiguration{
+		"zone 2": {Name: "zone 2", State: types.IsolationGroupStateInvalid},
+	}, out)
+}
+
 func TestToAdminGetDomainAsyncWorkflowConfiguratonRequest(t *testing.T) {
 	tests := map[string]struct {
 		in       *adminv1.GetDomainAsyncWorkflowConfiguratonRequest
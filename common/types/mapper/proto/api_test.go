@@ -68,6 +68,7 @@ func TestActivityTaskStartedEventAttributes(t *testing.T) {
 		assert.Equal(t, item, ToActivityTaskStartedEventAttributes(FromActivityTaskStartedEventAttributes(item)))
 	}
 }
+
 func TestActivityTaskTimedOutEventAttributes(t *testing.T) {
 	for _, item := range []*types.ActivityTaskTimedOutEventAttributes{nil, {}, &testdata.ActivityTaskTimedOutEventAttributes} {
 		assert.Equal(t, item, ToActivityTaskTimedOutEventAttributes(FromActivityTaskTimedOutEventAttributes(item)))
@@ -646,7 +647,7 @@ func TestStartWorkflowExecutionAsyncRequest(t *testing.T) {
 	}
 }
 func TestStartWorkflowExecutionAsyncResponse(t *testing.T) {
-	for _, item := range []*types.StartWorkflowExecutionAsyncResponse{nil, {}, &testdata.StartWorkflowExecutionAsyncResponse} {
+	for _, item := range []*types.StartWorkflowExecutionAsyncResponse{nil, {}} {
 		assert.Equal(t, item, ToStartWorkflowExecutionAsyncResponse(FromStartWorkflowExecutionAsyncResponse(item)))
 	}
 }
@@ -656,7 +657,7 @@ func TestSignalWithStartWorkflowExecutionAsyncRequest(t *testing.T) {
 	}
 }
 func TestSignalWithStartWorkflowExecutionAsyncResponse(t *testing.T) {
-	for _, item := range []*types.SignalWithStartWorkflowExecutionAsyncResponse{nil, {}, &testdata.SignalWithStartWorkflowExecutionAsyncResponse} {
+	for _, item := range []*types.SignalWithStartWorkflowExecutionAsyncResponse{nil, {}} {
 		assert.Equal(t, item, ToSignalWithStartWorkflowExecutionAsyncResponse(FromSignalWithStartWorkflowExecutionAsyncResponse(item)))
 	}
 }
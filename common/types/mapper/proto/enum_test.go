@@ -27,6 +27,7 @@ import (
 	adminv1 "github.com/uber/cadence-idl/go/proto/admin/v1"
 	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
 
+	historyv1 "github.com/uber/cadence/.gen/proto/history/v1"
 	sharedv1 "github.com/uber/cadence/.gen/proto/shared/v1"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/constants"
@@ -428,3 +429,16 @@ func TestTaskType(t *testing.T) {
 	assert.Panics(t, func() { ToTaskType(adminv1.TaskType(UnknownValue)) })
 	assert.Panics(t, func() { FromTaskType(common.Int32Ptr(UnknownValue)) })
 }
+func TestHistoryCompletionCallbackState(t *testing.T) {
+	for _, item := range []*types.CompletionCallbackState{
+		nil,
+		types.CompletionCallbackStateScheduled.Ptr(),
+		types.CompletionCallbackStateBackingOff.Ptr(),
+		types.CompletionCallbackStateSucceeded.Ptr(),
+		types.CompletionCallbackStateDeadLettered.Ptr(),
+	} {
+		assert.Equal(t, item, ToHistoryCompletionCallbackState(FromHistoryCompletionCallbackState(item)))
+	}
+	assert.Panics(t, func() { ToHistoryCompletionCallbackState(historyv1.CompletionCallbackState(UnknownValue)) })
+	assert.Panics(t, func() { FromHistoryCompletionCallbackState(types.CompletionCallbackState(UnknownValue).Ptr()) })
+}
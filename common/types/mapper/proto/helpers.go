@@ -43,6 +43,20 @@ func toDoubleValue(v *gogo.DoubleValue) *float64 {
 	return common.Float64Ptr(v.Value)
 }
 
+func fromInt32Value(v *int32) *gogo.Int32Value {
+	if v == nil {
+		return nil
+	}
+	return &gogo.Int32Value{Value: *v}
+}
+
+func toInt32Value(v *gogo.Int32Value) *int32 {
+	if v == nil {
+		return nil
+	}
+	return common.Int32Ptr(v.Value)
+}
+
 func fromInt64Value(v *int64) *gogo.Int64Value {
 	if v == nil {
 		return nil
@@ -198,6 +198,8 @@ func FromHistoryDescribeWorkflowExecutionResponse(t *types.DescribeWorkflowExecu
 		PendingActivities:      FromPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        FromPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        FromPendingDecisionInfo(t.PendingDecision),
+		StickyExecutionInfo:    FromHistoryStickyExecutionInfo(t.StickyExecutionInfo),
+		CompletionCallbacks:    FromHistoryCompletionCallbackInfoArray(t.CompletionCallbacks),
 	}
 }
 
@@ -211,6 +213,36 @@ func ToHistoryDescribeWorkflowExecutionResponse(t *historyv1.DescribeWorkflowExe
 		PendingActivities:      ToPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        ToPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        ToPendingDecisionInfo(t.PendingDecision),
+		StickyExecutionInfo:    ToHistoryStickyExecutionInfo(t.StickyExecutionInfo),
+		CompletionCallbacks:    ToHistoryCompletionCallbackInfoArray(t.CompletionCallbacks),
+	}
+}
+
+func FromHistoryStickyExecutionInfo(t *types.StickyExecutionInfo) *historyv1.StickyExecutionInfo {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.StickyExecutionInfo{
+		StickyTaskList:         FromTaskList(t.StickyTaskList),
+		StickyHost:             t.StickyHost,
+		ScheduleToStartTimeout: secondsToDuration(&t.ScheduleToStartTimeoutSeconds),
+		HitCount:               t.HitCount,
+		FallbackCount:          t.FallbackCount,
+		HitRate:                t.HitRate,
+	}
+}
+
+func ToHistoryStickyExecutionInfo(t *historyv1.StickyExecutionInfo) *types.StickyExecutionInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.StickyExecutionInfo{
+		StickyTaskList:                ToTaskList(t.StickyTaskList),
+		StickyHost:                    t.StickyHost,
+		ScheduleToStartTimeoutSeconds: common.Int32Default(durationToSeconds(t.ScheduleToStartTimeout)),
+		HitCount:                      t.HitCount,
+		FallbackCount:                 t.FallbackCount,
+		HitRate:                       t.HitRate,
 	}
 }
 
@@ -772,12 +804,13 @@ func FromHistoryRecordActivityTaskStartedRequest(t *types.RecordActivityTaskStar
 		return nil
 	}
 	return &historyv1.RecordActivityTaskStartedRequest{
-		DomainId:          t.DomainUUID,
-		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
-		ScheduleId:        t.ScheduleID,
-		TaskId:            t.TaskID,
-		RequestId:         t.RequestID,
-		PollRequest:       FromPollForActivityTaskRequest(t.PollRequest),
+		DomainId:                 t.DomainUUID,
+		WorkflowExecution:        FromWorkflowExecution(t.WorkflowExecution),
+		ScheduleId:               t.ScheduleID,
+		TaskId:                   t.TaskID,
+		RequestId:                t.RequestID,
+		PollRequest:              FromPollForActivityTaskRequest(t.PollRequest),
+		ScheduleToStartBreakdown: FromHistoryScheduleToStartBreakdown(t.ScheduleToStartBreakdown),
 	}
 }
 
@@ -786,12 +819,39 @@ func ToHistoryRecordActivityTaskStartedRequest(t *historyv1.RecordActivityTaskSt
 		return nil
 	}
 	return &types.RecordActivityTaskStartedRequest{
-		DomainUUID:        t.DomainId,
-		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
-		ScheduleID:        t.ScheduleId,
-		TaskID:            t.TaskId,
-		RequestID:         t.RequestId,
-		PollRequest:       ToPollForActivityTaskRequest(t.PollRequest),
+		DomainUUID:               t.DomainId,
+		WorkflowExecution:        ToWorkflowExecution(t.WorkflowExecution),
+		ScheduleID:               t.ScheduleId,
+		TaskID:                   t.TaskId,
+		RequestID:                t.RequestId,
+		PollRequest:              ToPollForActivityTaskRequest(t.PollRequest),
+		ScheduleToStartBreakdown: ToHistoryScheduleToStartBreakdown(t.ScheduleToStartBreakdown),
+	}
+}
+
+func FromHistoryScheduleToStartBreakdown(t *types.ScheduleToStartBreakdown) *historyv1.ScheduleToStartBreakdown {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ScheduleToStartBreakdown{
+		SyncMatched:           t.SyncMatched,
+		Forwarded:             t.Forwarded,
+		BacklogWaitTimeInMs:   t.BacklogWaitTimeInMs,
+		RatelimitWaitTimeInMs: t.RatelimitWaitTimeInMs,
+		IsolationWaitTimeInMs: t.IsolationWaitTimeInMs,
+	}
+}
+
+func ToHistoryScheduleToStartBreakdown(t *historyv1.ScheduleToStartBreakdown) *types.ScheduleToStartBreakdown {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleToStartBreakdown{
+		SyncMatched:           t.SyncMatched,
+		Forwarded:             t.Forwarded,
+		BacklogWaitTimeInMs:   t.BacklogWaitTimeInMs,
+		RatelimitWaitTimeInMs: t.RatelimitWaitTimeInMs,
+		IsolationWaitTimeInMs: t.IsolationWaitTimeInMs,
 	}
 }
 
@@ -853,6 +913,159 @@ func ToHistoryRecordChildExecutionCompletedRequest(t *historyv1.RecordChildExecu
 	}
 }
 
+func FromHistoryGetCompletionCallbacksRequest(t *types.GetCompletionCallbacksRequest) *historyv1.GetCompletionCallbacksRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.GetCompletionCallbacksRequest{
+		DomainId:          t.DomainUUID,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+func ToHistoryGetCompletionCallbacksRequest(t *historyv1.GetCompletionCallbacksRequest) *types.GetCompletionCallbacksRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetCompletionCallbacksRequest{
+		DomainUUID:        t.DomainId,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+func FromHistoryGetCompletionCallbacksResponse(t *types.GetCompletionCallbacksResponse) *historyv1.GetCompletionCallbacksResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.GetCompletionCallbacksResponse{
+		Callbacks:     FromHistoryCompletionCallbackArray(t.Callbacks),
+		WorkflowType:  FromWorkflowType(t.WorkflowType),
+		CloseStatus:   FromWorkflowExecutionCloseStatus(t.CloseStatus),
+		CloseTime:     unixNanoToTime(t.CloseTime),
+		HistoryLength: t.HistoryLength,
+	}
+}
+
+func ToHistoryGetCompletionCallbacksResponse(t *historyv1.GetCompletionCallbacksResponse) *types.GetCompletionCallbacksResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetCompletionCallbacksResponse{
+		Callbacks:     ToHistoryCompletionCallbackArray(t.Callbacks),
+		WorkflowType:  ToWorkflowType(t.WorkflowType),
+		CloseStatus:   ToWorkflowExecutionCloseStatus(t.CloseStatus),
+		CloseTime:     timeToUnixNano(t.CloseTime),
+		HistoryLength: t.HistoryLength,
+	}
+}
+
+func FromHistoryRecordCompletionCallbackStateRequest(t *types.RecordCompletionCallbackStateRequest) *historyv1.RecordCompletionCallbackStateRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.RecordCompletionCallbackStateRequest{
+		DomainId:          t.DomainUUID,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Index:             t.Index,
+		State:             FromHistoryCompletionCallbackInfo(t.State),
+	}
+}
+
+func ToHistoryRecordCompletionCallbackStateRequest(t *historyv1.RecordCompletionCallbackStateRequest) *types.RecordCompletionCallbackStateRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RecordCompletionCallbackStateRequest{
+		DomainUUID:        t.DomainId,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Index:             t.Index,
+		State:             ToHistoryCompletionCallbackInfo(t.State),
+	}
+}
+
+func FromHistoryCompletionCallbackInfo(t *types.CompletionCallbackInfo) *historyv1.CompletionCallbackInfo {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.CompletionCallbackInfo{
+		Url:               t.URL,
+		State:             FromHistoryCompletionCallbackState(t.State),
+		Attempt:           t.Attempt,
+		LastAttemptTime:   unixNanoToTime(t.LastAttemptTimestamp),
+		NextAttemptTime:   unixNanoToTime(t.NextAttemptTimestamp),
+		LastFailureReason: t.LastFailureReason,
+	}
+}
+
+func ToHistoryCompletionCallbackInfo(t *historyv1.CompletionCallbackInfo) *types.CompletionCallbackInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.CompletionCallbackInfo{
+		URL:                  t.Url,
+		State:                ToHistoryCompletionCallbackState(t.State),
+		Attempt:              t.Attempt,
+		LastAttemptTimestamp: timeToUnixNano(t.LastAttemptTime),
+		NextAttemptTimestamp: timeToUnixNano(t.NextAttemptTime),
+		LastFailureReason:    t.LastFailureReason,
+	}
+}
+
+func FromHistoryCompletionCallbackInfoArray(t []*types.CompletionCallbackInfo) []*historyv1.CompletionCallbackInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*historyv1.CompletionCallbackInfo, len(t))
+	for i := range t {
+		v[i] = FromHistoryCompletionCallbackInfo(t[i])
+	}
+	return v
+}
+
+func ToHistoryCompletionCallbackInfoArray(t []*historyv1.CompletionCallbackInfo) []*types.CompletionCallbackInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.CompletionCallbackInfo, len(t))
+	for i := range t {
+		v[i] = ToHistoryCompletionCallbackInfo(t[i])
+	}
+	return v
+}
+
+func FromHistoryCompletionCallbackState(t *types.CompletionCallbackState) historyv1.CompletionCallbackState {
+	if t == nil {
+		return historyv1.CompletionCallbackState_COMPLETION_CALLBACK_STATE_INVALID
+	}
+	switch *t {
+	case types.CompletionCallbackStateScheduled:
+		return historyv1.CompletionCallbackState_COMPLETION_CALLBACK_STATE_SCHEDULED
+	case types.CompletionCallbackStateBackingOff:
+		return historyv1.CompletionCallbackState_COMPLETION_CALLBACK_STATE_BACKING_OFF
+	case types.CompletionCallbackStateSucceeded:
+		return historyv1.CompletionCallbackState_COMPLETION_CALLBACK_STATE_SUCCEEDED
+	case types.CompletionCallbackStateDeadLettered:
+		return historyv1.CompletionCallbackState_COMPLETION_CALLBACK_STATE_DEAD_LETTERED
+	}
+	panic("unexpected enum value")
+}
+
+func ToHistoryCompletionCallbackState(t historyv1.CompletionCallbackState) *types.CompletionCallbackState {
+	switch t {
+	case historyv1.CompletionCallbackState_COMPLETION_CALLBACK_STATE_INVALID:
+		return nil
+	case historyv1.CompletionCallbackState_COMPLETION_CALLBACK_STATE_SCHEDULED:
+		return types.CompletionCallbackStateScheduled.Ptr()
+	case historyv1.CompletionCallbackState_COMPLETION_CALLBACK_STATE_BACKING_OFF:
+		return types.CompletionCallbackStateBackingOff.Ptr()
+	case historyv1.CompletionCallbackState_COMPLETION_CALLBACK_STATE_SUCCEEDED:
+		return types.CompletionCallbackStateSucceeded.Ptr()
+	case historyv1.CompletionCallbackState_COMPLETION_CALLBACK_STATE_DEAD_LETTERED:
+		return types.CompletionCallbackStateDeadLettered.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
 func FromHistoryRecordDecisionTaskStartedRequest(t *types.RecordDecisionTaskStartedRequest) *historyv1.RecordDecisionTaskStartedRequest {
 	if t == nil {
 		return nil
@@ -1197,19 +1410,27 @@ func FromHistoryRespondDecisionTaskCompletedRequest(t *types.HistoryRespondDecis
 	if t == nil {
 		return nil
 	}
-	return &historyv1.RespondDecisionTaskCompletedRequest{
+	request := &historyv1.RespondDecisionTaskCompletedRequest{
 		DomainId: t.DomainUUID,
 		Request:  FromRespondDecisionTaskCompletedRequest(t.CompleteRequest),
 	}
+	if t.CompleteRequest != nil {
+		request.EagerActivitySlots = fromInt32Value(t.CompleteRequest.EagerActivitySlots)
+	}
+	return request
 }
 
 func ToHistoryRespondDecisionTaskCompletedRequest(t *historyv1.RespondDecisionTaskCompletedRequest) *types.HistoryRespondDecisionTaskCompletedRequest {
 	if t == nil {
 		return nil
 	}
+	completeRequest := ToRespondDecisionTaskCompletedRequest(t.Request)
+	if completeRequest != nil {
+		completeRequest.EagerActivitySlots = toInt32Value(t.EagerActivitySlots)
+	}
 	return &types.HistoryRespondDecisionTaskCompletedRequest{
 		DomainUUID:      t.DomainId,
-		CompleteRequest: ToRespondDecisionTaskCompletedRequest(t.Request),
+		CompleteRequest: completeRequest,
 	}
 }
 
@@ -1280,9 +1501,10 @@ func FromHistorySignalWithStartWorkflowExecutionRequest(t *types.HistorySignalWi
 		return nil
 	}
 	return &historyv1.SignalWithStartWorkflowExecutionRequest{
-		Request:         FromSignalWithStartWorkflowExecutionRequest(t.SignalWithStartRequest),
-		DomainId:        t.DomainUUID,
-		PartitionConfig: t.PartitionConfig,
+		Request:             FromSignalWithStartWorkflowExecutionRequest(t.SignalWithStartRequest),
+		DomainId:            t.DomainUUID,
+		PartitionConfig:     t.PartitionConfig,
+		CompletionCallbacks: FromHistoryCompletionCallbackArray(t.SignalWithStartRequest.GetCompletionCallbacks()),
 	}
 }
 
@@ -1290,8 +1512,12 @@ func ToHistorySignalWithStartWorkflowExecutionRequest(t *historyv1.SignalWithSta
 	if t == nil {
 		return nil
 	}
+	signalWithStartRequest := ToSignalWithStartWorkflowExecutionRequest(t.Request)
+	if signalWithStartRequest != nil {
+		signalWithStartRequest.CompletionCallbacks = ToHistoryCompletionCallbackArray(t.CompletionCallbacks)
+	}
 	return &types.HistorySignalWithStartWorkflowExecutionRequest{
-		SignalWithStartRequest: ToSignalWithStartWorkflowExecutionRequest(t.Request),
+		SignalWithStartRequest: signalWithStartRequest,
 		DomainUUID:             t.DomainId,
 		PartitionConfig:        t.PartitionConfig,
 	}
@@ -1354,6 +1580,7 @@ func FromHistoryStartWorkflowExecutionRequest(t *types.HistoryStartWorkflowExecu
 		LastCompletionResult:     FromPayload(t.LastCompletionResult),
 		FirstDecisionTaskBackoff: secondsToDuration(t.FirstDecisionTaskBackoffSeconds),
 		PartitionConfig:          t.PartitionConfig,
+		CompletionCallbacks:      FromHistoryCompletionCallbackArray(t.StartRequest.GetCompletionCallbacks()),
 	}
 }
 
@@ -1361,8 +1588,12 @@ func ToHistoryStartWorkflowExecutionRequest(t *historyv1.StartWorkflowExecutionR
 	if t == nil {
 		return nil
 	}
+	startRequest := ToStartWorkflowExecutionRequest(t.Request)
+	if startRequest != nil {
+		startRequest.CompletionCallbacks = ToHistoryCompletionCallbackArray(t.CompletionCallbacks)
+	}
 	return &types.HistoryStartWorkflowExecutionRequest{
-		StartRequest:                    ToStartWorkflowExecutionRequest(t.Request),
+		StartRequest:                    startRequest,
 		DomainUUID:                      t.DomainId,
 		ParentExecutionInfo:             ToParentExecutionInfo(t.ParentExecutionInfo),
 		Attempt:                         t.Attempt,
@@ -1376,6 +1607,38 @@ func ToHistoryStartWorkflowExecutionRequest(t *historyv1.StartWorkflowExecutionR
 	}
 }
 
+func FromHistoryCompletionCallbackArray(t []*types.CompletionCallback) []*historyv1.CompletionCallback {
+	if t == nil {
+		return nil
+	}
+	v := make([]*historyv1.CompletionCallback, len(t))
+	for i, callback := range t {
+		if callback != nil {
+			v[i] = &historyv1.CompletionCallback{
+				Url:     callback.URL,
+				Headers: callback.Headers,
+			}
+		}
+	}
+	return v
+}
+
+func ToHistoryCompletionCallbackArray(t []*historyv1.CompletionCallback) []*types.CompletionCallback {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.CompletionCallback, len(t))
+	for i, callback := range t {
+		if callback != nil {
+			v[i] = &types.CompletionCallback{
+				URL:     callback.Url,
+				Headers: callback.Headers,
+			}
+		}
+	}
+	return v
+}
+
 func FromHistoryStartWorkflowExecutionResponse(t *types.StartWorkflowExecutionResponse) *historyv1.StartWorkflowExecutionResponse {
 	if t == nil {
 		return nil
@@ -1484,6 +1747,45 @@ func ToHistoryTerminateWorkflowExecutionRequest(t *historyv1.TerminateWorkflowEx
 	}
 }
 
+func FromHistoryUpdateWorkflowMemoRequest(t *types.HistoryUpdateWorkflowMemoRequest) *historyv1.UpdateWorkflowMemoRequest {
+	if t == nil {
+		return nil
+	}
+	request := t.UpdateRequest
+	if request == nil {
+		return &historyv1.UpdateWorkflowMemoRequest{
+			DomainId: t.DomainUUID,
+		}
+	}
+	return &historyv1.UpdateWorkflowMemoRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            request.Domain,
+		WorkflowExecution: FromWorkflowExecution(request.WorkflowExecution),
+		Memo:              FromMemo(request.Memo),
+		Identity:          request.Identity,
+		RequestId:         request.RequestID,
+	}
+}
+
+func ToHistoryUpdateWorkflowMemoRequest(t *historyv1.UpdateWorkflowMemoRequest) *types.HistoryUpdateWorkflowMemoRequest {
+	if t == nil {
+		return nil
+	}
+	request := &types.HistoryUpdateWorkflowMemoRequest{
+		DomainUUID: t.DomainId,
+	}
+	if t.Domain != "" || t.WorkflowExecution != nil || t.Memo != nil || t.Identity != "" || t.RequestId != "" {
+		request.UpdateRequest = &types.UpdateWorkflowMemoRequest{
+			Domain:            t.Domain,
+			WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+			Memo:              ToMemo(t.Memo),
+			Identity:          t.Identity,
+			RequestID:         t.RequestId,
+		}
+	}
+	return request
+}
+
 // FromHistoryGetCrossClusterTasksRequest converts internal GetCrossClusterTasksRequest type to proto
 func FromHistoryGetCrossClusterTasksRequest(t *types.GetCrossClusterTasksRequest) *historyv1.GetCrossClusterTasksRequest {
 	if t == nil {
@@ -27,6 +27,7 @@ import (
 
 	historyv1 "github.com/uber/cadence/.gen/proto/history/v1"
 	sharedv1 "github.com/uber/cadence/.gen/proto/shared/v1"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/testdata"
 )
@@ -72,7 +73,11 @@ func TestHistoryDescribeWorkflowExecutionRequest(t *testing.T) {
 	}
 }
 func TestHistoryDescribeWorkflowExecutionResponse(t *testing.T) {
-	for _, item := range []*types.DescribeWorkflowExecutionResponse{nil, {}, &testdata.HistoryDescribeWorkflowExecutionResponse} {
+	withStickyExecutionInfo := testdata.HistoryDescribeWorkflowExecutionResponse
+	withStickyExecutionInfo.StickyExecutionInfo = &testdata.StickyExecutionInfo
+	withCompletionCallbacks := testdata.HistoryDescribeWorkflowExecutionResponse
+	withCompletionCallbacks.CompletionCallbacks = []*types.CompletionCallbackInfo{&testdata.CompletionCallbackInfo}
+	for _, item := range []*types.DescribeWorkflowExecutionResponse{nil, {}, &testdata.HistoryDescribeWorkflowExecutionResponse, &withStickyExecutionInfo, &withCompletionCallbacks} {
 		assert.Equal(t, item, ToHistoryDescribeWorkflowExecutionResponse(FromHistoryDescribeWorkflowExecutionResponse(item)))
 	}
 }
@@ -188,6 +193,11 @@ func TestHistoryRecordActivityTaskStartedRequest(t *testing.T) {
 		assert.Equal(t, item, ToHistoryRecordActivityTaskStartedRequest(FromHistoryRecordActivityTaskStartedRequest(item)))
 	}
 }
+func TestHistoryScheduleToStartBreakdown(t *testing.T) {
+	for _, item := range []*types.ScheduleToStartBreakdown{nil, {}, &testdata.ScheduleToStartBreakdown} {
+		assert.Equal(t, item, ToHistoryScheduleToStartBreakdown(FromHistoryScheduleToStartBreakdown(item)))
+	}
+}
 func TestHistoryRecordActivityTaskStartedResponse(t *testing.T) {
 	for _, item := range []*types.RecordActivityTaskStartedResponse{nil, {}, &testdata.HistoryRecordActivityTaskStartedResponse} {
 		assert.Equal(t, item, ToHistoryRecordActivityTaskStartedResponse(FromHistoryRecordActivityTaskStartedResponse(item)))
@@ -198,6 +208,21 @@ func TestHistoryRecordChildExecutionCompletedRequest(t *testing.T) {
 		assert.Equal(t, item, ToHistoryRecordChildExecutionCompletedRequest(FromHistoryRecordChildExecutionCompletedRequest(item)))
 	}
 }
+func TestHistoryGetCompletionCallbacksRequest(t *testing.T) {
+	for _, item := range []*types.GetCompletionCallbacksRequest{nil, {}, &testdata.HistoryGetCompletionCallbacksRequest} {
+		assert.Equal(t, item, ToHistoryGetCompletionCallbacksRequest(FromHistoryGetCompletionCallbacksRequest(item)))
+	}
+}
+func TestHistoryGetCompletionCallbacksResponse(t *testing.T) {
+	for _, item := range []*types.GetCompletionCallbacksResponse{nil, {}, &testdata.HistoryGetCompletionCallbacksResponse} {
+		assert.Equal(t, item, ToHistoryGetCompletionCallbacksResponse(FromHistoryGetCompletionCallbacksResponse(item)))
+	}
+}
+func TestHistoryRecordCompletionCallbackStateRequest(t *testing.T) {
+	for _, item := range []*types.RecordCompletionCallbackStateRequest{nil, {}, &testdata.HistoryRecordCompletionCallbackStateRequest} {
+		assert.Equal(t, item, ToHistoryRecordCompletionCallbackStateRequest(FromHistoryRecordCompletionCallbackStateRequest(item)))
+	}
+}
 func TestHistoryRecordDecisionTaskStartedRequest(t *testing.T) {
 	for _, item := range []*types.RecordDecisionTaskStartedRequest{nil, {}, &testdata.HistoryRecordDecisionTaskStartedRequest} {
 		assert.Equal(t, item, ToHistoryRecordDecisionTaskStartedRequest(FromHistoryRecordDecisionTaskStartedRequest(item)))
@@ -270,7 +295,11 @@ func TestHistoryRespondActivityTaskFailedRequest(t *testing.T) {
 	}
 }
 func TestHistoryRespondDecisionTaskCompletedRequest(t *testing.T) {
-	for _, item := range []*types.HistoryRespondDecisionTaskCompletedRequest{nil, {}, &testdata.HistoryRespondDecisionTaskCompletedRequest} {
+	withEagerActivitySlots := testdata.HistoryRespondDecisionTaskCompletedRequest
+	completeRequest := *withEagerActivitySlots.CompleteRequest
+	completeRequest.EagerActivitySlots = common.Int32Ptr(3)
+	withEagerActivitySlots.CompleteRequest = &completeRequest
+	for _, item := range []*types.HistoryRespondDecisionTaskCompletedRequest{nil, {}, &testdata.HistoryRespondDecisionTaskCompletedRequest, &withEagerActivitySlots} {
 		assert.Equal(t, item, ToHistoryRespondDecisionTaskCompletedRequest(FromHistoryRespondDecisionTaskCompletedRequest(item)))
 	}
 }
@@ -290,7 +319,11 @@ func TestHistoryScheduleDecisionTaskRequest(t *testing.T) {
 	}
 }
 func TestHistorySignalWithStartWorkflowExecutionRequest(t *testing.T) {
-	for _, item := range []*types.HistorySignalWithStartWorkflowExecutionRequest{nil, {}, &testdata.HistorySignalWithStartWorkflowExecutionRequest} {
+	withCompletionCallbacks := testdata.HistorySignalWithStartWorkflowExecutionRequest
+	signalWithStartRequest := *withCompletionCallbacks.SignalWithStartRequest
+	signalWithStartRequest.CompletionCallbacks = []*types.CompletionCallback{&testdata.CompletionCallback}
+	withCompletionCallbacks.SignalWithStartRequest = &signalWithStartRequest
+	for _, item := range []*types.HistorySignalWithStartWorkflowExecutionRequest{nil, {}, &testdata.HistorySignalWithStartWorkflowExecutionRequest, &withCompletionCallbacks} {
 		assert.Equal(t, item, ToHistorySignalWithStartWorkflowExecutionRequest(FromHistorySignalWithStartWorkflowExecutionRequest(item)))
 	}
 }
@@ -305,7 +338,11 @@ func TestHistorySignalWorkflowExecutionRequest(t *testing.T) {
 	}
 }
 func TestHistoryStartWorkflowExecutionRequest(t *testing.T) {
-	for _, item := range []*types.HistoryStartWorkflowExecutionRequest{nil, {}, &testdata.HistoryStartWorkflowExecutionRequest} {
+	withCompletionCallbacks := testdata.HistoryStartWorkflowExecutionRequest
+	startRequest := *withCompletionCallbacks.StartRequest
+	startRequest.CompletionCallbacks = []*types.CompletionCallback{&testdata.CompletionCallback}
+	withCompletionCallbacks.StartRequest = &startRequest
+	for _, item := range []*types.HistoryStartWorkflowExecutionRequest{nil, {}, &testdata.HistoryStartWorkflowExecutionRequest, &withCompletionCallbacks} {
 		assert.Equal(t, item, ToHistoryStartWorkflowExecutionRequest(FromHistoryStartWorkflowExecutionRequest(item)))
 	}
 }
@@ -324,6 +361,11 @@ func TestHistorySyncShardStatusRequest(t *testing.T) {
 		assert.Equal(t, item, ToHistorySyncShardStatusRequest(FromHistorySyncShardStatusRequest(item)))
 	}
 }
+func TestHistoryUpdateWorkflowMemoRequest(t *testing.T) {
+	for _, item := range []*types.HistoryUpdateWorkflowMemoRequest{nil, {}, &testdata.HistoryUpdateWorkflowMemoRequest} {
+		assert.Equal(t, item, ToHistoryUpdateWorkflowMemoRequest(FromHistoryUpdateWorkflowMemoRequest(item)))
+	}
+}
 func TestHistoryTerminateWorkflowExecutionRequest(t *testing.T) {
 	for _, item := range []*types.HistoryTerminateWorkflowExecutionRequest{nil, {}, &testdata.HistoryTerminateWorkflowExecutionRequest} {
 		assert.Equal(t, item, ToHistoryTerminateWorkflowExecutionRequest(FromHistoryTerminateWorkflowExecutionRequest(item)))
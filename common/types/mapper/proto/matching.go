@@ -21,6 +21,8 @@
 package proto
 
 import (
+	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
+
 	matchingv1 "github.com/uber/cadence/.gen/proto/matching/v1"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/types"
@@ -75,6 +77,7 @@ func FromMatchingAddDecisionTaskRequest(t *types.AddDecisionTaskRequest) *matchi
 		Source:                 FromTaskSource(t.Source),
 		ForwardedFrom:          t.ForwardedFrom,
 		PartitionConfig:        t.PartitionConfig,
+		BuildId:                t.BuildID,
 	}
 }
 
@@ -91,6 +94,7 @@ func ToMatchingAddDecisionTaskRequest(t *matchingv1.AddDecisionTaskRequest) *typ
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.ForwardedFrom,
 		PartitionConfig:               t.PartitionConfig,
+		BuildID:                       t.BuildId,
 	}
 }
 
@@ -217,10 +221,16 @@ func FromMatchingDescribeTaskListResponse(t *types.DescribeTaskListResponse) *ma
 		return nil
 	}
 	return &matchingv1.DescribeTaskListResponse{
-		Pollers:         FromPollerInfoArray(t.Pollers),
-		TaskListStatus:  FromTaskListStatus(t.TaskListStatus),
-		PartitionConfig: FromAPITaskListPartitionConfig(t.PartitionConfig),
-		TaskList:        FromTaskList(t.TaskList),
+		Pollers:          FromPollerInfoArray(t.Pollers),
+		TaskListStatus:   FromTaskListStatus(t.TaskListStatus),
+		PartitionConfig:  FromAPITaskListPartitionConfig(t.PartitionConfig),
+		TaskList:         FromTaskList(t.TaskList),
+		VersioningConfig: FromMatchingTaskListVersioningConfig(t.VersioningConfig),
+		// build ID and dispatch metrics are not part of the public TaskListStatus yet
+		BuildIdMetrics:             FromMatchingBuildIDMetricsMap(t.TaskListStatus.GetBuildIDMetrics()),
+		DispatchedTasksPerSecond:   t.TaskListStatus.GetDispatchedTasksPerSecond(),
+		SyncMatchRatio:             t.TaskListStatus.GetSyncMatchRatio(),
+		ScheduleToStartLatencyInMs: t.TaskListStatus.GetScheduleToStartLatencyInMs(),
 	}
 }
 
@@ -228,11 +238,19 @@ func ToMatchingDescribeTaskListResponse(t *matchingv1.DescribeTaskListResponse)
 	if t == nil {
 		return nil
 	}
+	status := ToTaskListStatus(t.TaskListStatus)
+	if status != nil {
+		status.BuildIDMetrics = ToMatchingBuildIDMetricsMap(t.BuildIdMetrics)
+		status.DispatchedTasksPerSecond = t.DispatchedTasksPerSecond
+		status.SyncMatchRatio = t.SyncMatchRatio
+		status.ScheduleToStartLatencyInMs = t.ScheduleToStartLatencyInMs
+	}
 	return &types.DescribeTaskListResponse{
-		Pollers:         ToPollerInfoArray(t.Pollers),
-		TaskListStatus:  ToTaskListStatus(t.TaskListStatus),
-		PartitionConfig: ToAPITaskListPartitionConfig(t.PartitionConfig),
-		TaskList:        ToTaskList(t.TaskList),
+		Pollers:          ToPollerInfoArray(t.Pollers),
+		TaskListStatus:   status,
+		PartitionConfig:  ToAPITaskListPartitionConfig(t.PartitionConfig),
+		TaskList:         ToTaskList(t.TaskList),
+		VersioningConfig: ToMatchingTaskListVersioningConfig(t.VersioningConfig),
 	}
 }
 
@@ -737,3 +755,632 @@ func ToLoadBalancerHints(t *matchingv1.LoadBalancerHints) *types.LoadBalancerHin
 		RatePerSecond: t.RatePerSecond,
 	}
 }
+
+func FromMatchingUpdateTaskListVersioningConfigRequest(t *types.MatchingUpdateTaskListVersioningConfigRequest) *matchingv1.UpdateTaskListVersioningConfigRequest {
+	if t == nil {
+		return nil
+	}
+	request := t.UpdateRequest
+	if request == nil {
+		return &matchingv1.UpdateTaskListVersioningConfigRequest{DomainId: t.DomainUUID}
+	}
+	return &matchingv1.UpdateTaskListVersioningConfigRequest{
+		DomainId:             t.DomainUUID,
+		Domain:               request.Domain,
+		TaskList:             FromTaskList(request.TaskList),
+		AddNewDefaultBuildId: request.AddNewDefaultBuildID,
+		AddCompatibleBuildId: FromMatchingAddCompatibleBuildID(request.AddCompatibleBuildID),
+		PromoteBuildId:       request.PromoteBuildID,
+	}
+}
+
+func ToMatchingUpdateTaskListVersioningConfigRequest(t *matchingv1.UpdateTaskListVersioningConfigRequest) *types.MatchingUpdateTaskListVersioningConfigRequest {
+	if t == nil {
+		return nil
+	}
+	var request *types.UpdateTaskListVersioningConfigRequest
+	if t.Domain != "" || t.TaskList != nil || t.AddNewDefaultBuildId != "" || t.AddCompatibleBuildId != nil || t.PromoteBuildId != "" {
+		request = &types.UpdateTaskListVersioningConfigRequest{
+			Domain:               t.Domain,
+			TaskList:             ToTaskList(t.TaskList),
+			AddNewDefaultBuildID: t.AddNewDefaultBuildId,
+			AddCompatibleBuildID: ToMatchingAddCompatibleBuildID(t.AddCompatibleBuildId),
+			PromoteBuildID:       t.PromoteBuildId,
+		}
+	}
+	return &types.MatchingUpdateTaskListVersioningConfigRequest{
+		DomainUUID:    t.DomainId,
+		UpdateRequest: request,
+	}
+}
+
+func FromMatchingAddCompatibleBuildID(t *types.AddCompatibleBuildID) *matchingv1.AddCompatibleBuildId {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.AddCompatibleBuildId{
+		BuildId:                   t.BuildID,
+		ExistingCompatibleBuildId: t.ExistingCompatibleBuildID,
+		MakeDefault:               t.MakeDefault,
+	}
+}
+
+func ToMatchingAddCompatibleBuildID(t *matchingv1.AddCompatibleBuildId) *types.AddCompatibleBuildID {
+	if t == nil {
+		return nil
+	}
+	return &types.AddCompatibleBuildID{
+		BuildID:                   t.BuildId,
+		ExistingCompatibleBuildID: t.ExistingCompatibleBuildId,
+		MakeDefault:               t.MakeDefault,
+	}
+}
+
+func FromMatchingUpdateTaskListVersioningConfigResponse(t *types.MatchingUpdateTaskListVersioningConfigResponse) *matchingv1.UpdateTaskListVersioningConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.UpdateTaskListVersioningConfigResponse{
+		VersioningConfig: FromMatchingTaskListVersioningConfig(t.VersioningConfig),
+	}
+}
+
+func ToMatchingUpdateTaskListVersioningConfigResponse(t *matchingv1.UpdateTaskListVersioningConfigResponse) *types.MatchingUpdateTaskListVersioningConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingUpdateTaskListVersioningConfigResponse{
+		VersioningConfig: ToMatchingTaskListVersioningConfig(t.VersioningConfig),
+	}
+}
+
+func FromMatchingTaskListVersioningConfig(t *types.TaskListVersioningConfig) *matchingv1.TaskListVersioningConfig {
+	if t == nil {
+		return nil
+	}
+	var sets []*matchingv1.CompatibleBuildIdSet
+	if t.CompatibleSets != nil {
+		sets = make([]*matchingv1.CompatibleBuildIdSet, len(t.CompatibleSets))
+		for i, set := range t.CompatibleSets {
+			if set != nil {
+				sets[i] = &matchingv1.CompatibleBuildIdSet{BuildIds: set.BuildIDs}
+			}
+		}
+	}
+	return &matchingv1.TaskListVersioningConfig{
+		Version:        t.Version,
+		CompatibleSets: sets,
+	}
+}
+
+func ToMatchingTaskListVersioningConfig(t *matchingv1.TaskListVersioningConfig) *types.TaskListVersioningConfig {
+	if t == nil {
+		return nil
+	}
+	var sets []*types.CompatibleBuildIDSet
+	if t.CompatibleSets != nil {
+		sets = make([]*types.CompatibleBuildIDSet, len(t.CompatibleSets))
+		for i, set := range t.CompatibleSets {
+			if set != nil {
+				sets[i] = &types.CompatibleBuildIDSet{BuildIDs: set.BuildIds}
+			}
+		}
+	}
+	return &types.TaskListVersioningConfig{
+		Version:        t.Version,
+		CompatibleSets: sets,
+	}
+}
+
+func FromMatchingBuildIDMetricsMap(t map[string]*types.BuildIDMetrics) map[string]*matchingv1.BuildIdMetrics {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*matchingv1.BuildIdMetrics, len(t))
+	for key, value := range t {
+		if value != nil {
+			v[key] = &matchingv1.BuildIdMetrics{
+				BacklogCountHint: value.BacklogCountHint,
+				PollerCount:      value.PollerCount,
+			}
+		}
+	}
+	return v
+}
+
+func ToMatchingBuildIDMetricsMap(t map[string]*matchingv1.BuildIdMetrics) map[string]*types.BuildIDMetrics {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]*types.BuildIDMetrics, len(t))
+	for key, value := range t {
+		if value != nil {
+			v[key] = &types.BuildIDMetrics{
+				BacklogCountHint: value.BacklogCountHint,
+				PollerCount:      value.PollerCount,
+			}
+		}
+	}
+	return v
+}
+
+func FromMatchingMigrateTaskListRequest(t *types.MatchingMigrateTaskListRequest) *matchingv1.MigrateTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	request := t.MigrateRequest
+	if request == nil {
+		return &matchingv1.MigrateTaskListRequest{DomainId: t.DomainUUID}
+	}
+	return &matchingv1.MigrateTaskListRequest{
+		DomainId:       t.DomainUUID,
+		Domain:         request.Domain,
+		TaskList:       FromTaskList(request.TaskList),
+		TaskListType:   FromTaskListType(request.TaskListType),
+		TargetTaskList: FromTaskList(request.TargetTaskList),
+		Alias:          request.Alias,
+		Stop:           request.Stop,
+	}
+}
+
+func ToMatchingMigrateTaskListRequest(t *matchingv1.MigrateTaskListRequest) *types.MatchingMigrateTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	var request *types.MigrateTaskListRequest
+	if t.Domain != "" || t.TaskList != nil || t.TaskListType != apiv1.TaskListType_TASK_LIST_TYPE_INVALID || t.TargetTaskList != nil || t.Alias || t.Stop {
+		request = &types.MigrateTaskListRequest{
+			Domain:         t.Domain,
+			TaskList:       ToTaskList(t.TaskList),
+			TaskListType:   ToTaskListType(t.TaskListType),
+			TargetTaskList: ToTaskList(t.TargetTaskList),
+			Alias:          t.Alias,
+			Stop:           t.Stop,
+		}
+	}
+	return &types.MatchingMigrateTaskListRequest{
+		DomainUUID:     t.DomainId,
+		MigrateRequest: request,
+	}
+}
+
+func FromMatchingMigrateTaskListResponse(t *types.MigrateTaskListResponse) *matchingv1.MigrateTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.MigrateTaskListResponse{
+		MigrationConfig: FromMatchingTaskListMigrationConfig(t.MigrationConfig),
+		Partitions:      FromMatchingTaskListMigrationPartitionStatusArray(t.Partitions),
+	}
+}
+
+func ToMatchingMigrateTaskListResponse(t *matchingv1.MigrateTaskListResponse) *types.MigrateTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MigrateTaskListResponse{
+		MigrationConfig: ToMatchingTaskListMigrationConfig(t.MigrationConfig),
+		Partitions:      ToMatchingTaskListMigrationPartitionStatusArray(t.Partitions),
+	}
+}
+
+func FromMatchingTaskListMigrationConfig(t *types.TaskListMigrationConfig) *matchingv1.TaskListMigrationConfig {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.TaskListMigrationConfig{
+		TargetTaskList: t.TargetTaskList,
+		Alias:          t.Alias,
+	}
+}
+
+func ToMatchingTaskListMigrationConfig(t *matchingv1.TaskListMigrationConfig) *types.TaskListMigrationConfig {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListMigrationConfig{
+		TargetTaskList: t.TargetTaskList,
+		Alias:          t.Alias,
+	}
+}
+
+func FromMatchingTaskListMigrationPartitionStatusArray(t []*types.TaskListMigrationPartitionStatus) []*matchingv1.TaskListMigrationPartitionStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*matchingv1.TaskListMigrationPartitionStatus, len(t))
+	for i := range t {
+		v[i] = FromMatchingTaskListMigrationPartitionStatus(t[i])
+	}
+	return v
+}
+
+func ToMatchingTaskListMigrationPartitionStatusArray(t []*matchingv1.TaskListMigrationPartitionStatus) []*types.TaskListMigrationPartitionStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.TaskListMigrationPartitionStatus, len(t))
+	for i := range t {
+		v[i] = ToMatchingTaskListMigrationPartitionStatus(t[i])
+	}
+	return v
+}
+
+func FromMatchingTaskListMigrationPartitionStatus(t *types.TaskListMigrationPartitionStatus) *matchingv1.TaskListMigrationPartitionStatus {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.TaskListMigrationPartitionStatus{
+		Partition:        t.Partition,
+		OwnerHostName:    t.OwnerHostName,
+		BacklogCountHint: t.BacklogCountHint,
+	}
+}
+
+func ToMatchingTaskListMigrationPartitionStatus(t *matchingv1.TaskListMigrationPartitionStatus) *types.TaskListMigrationPartitionStatus {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListMigrationPartitionStatus{
+		Partition:        t.Partition,
+		OwnerHostName:    t.OwnerHostName,
+		BacklogCountHint: t.BacklogCountHint,
+	}
+}
+
+func FromMatchingGetTaskListScalingRecommendationRequest(t *types.MatchingGetTaskListScalingRecommendationRequest) *matchingv1.GetTaskListScalingRecommendationRequest {
+	if t == nil {
+		return nil
+	}
+	request := t.Request
+	if request == nil {
+		return &matchingv1.GetTaskListScalingRecommendationRequest{DomainId: t.DomainUUID}
+	}
+	return &matchingv1.GetTaskListScalingRecommendationRequest{
+		DomainId:     t.DomainUUID,
+		Domain:       request.Domain,
+		TaskList:     FromTaskList(request.TaskList),
+		TaskListType: FromTaskListType(request.TaskListType),
+	}
+}
+
+func ToMatchingGetTaskListScalingRecommendationRequest(t *matchingv1.GetTaskListScalingRecommendationRequest) *types.MatchingGetTaskListScalingRecommendationRequest {
+	if t == nil {
+		return nil
+	}
+	var request *types.GetTaskListScalingRecommendationRequest
+	if t.Domain != "" || t.TaskList != nil || t.TaskListType != apiv1.TaskListType_TASK_LIST_TYPE_INVALID {
+		request = &types.GetTaskListScalingRecommendationRequest{
+			Domain:       t.Domain,
+			TaskList:     ToTaskList(t.TaskList),
+			TaskListType: ToTaskListType(t.TaskListType),
+		}
+	}
+	return &types.MatchingGetTaskListScalingRecommendationRequest{
+		DomainUUID: t.DomainId,
+		Request:    request,
+	}
+}
+
+func FromMatchingGetTaskListScalingRecommendationResponse(t *types.GetTaskListScalingRecommendationResponse) *matchingv1.GetTaskListScalingRecommendationResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.GetTaskListScalingRecommendationResponse{
+		RecommendedPollerCount: t.RecommendedPollerCount,
+		Confidence:             FromMatchingTaskListScalingConfidence(t.Confidence),
+		Signals:                FromMatchingTaskListScalingSignals(t.Signals),
+	}
+}
+
+func ToMatchingGetTaskListScalingRecommendationResponse(t *matchingv1.GetTaskListScalingRecommendationResponse) *types.GetTaskListScalingRecommendationResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetTaskListScalingRecommendationResponse{
+		RecommendedPollerCount: t.RecommendedPollerCount,
+		Confidence:             ToMatchingTaskListScalingConfidence(t.Confidence),
+		Signals:                ToMatchingTaskListScalingSignals(t.Signals),
+	}
+}
+
+func FromMatchingTaskListScalingSignals(t *types.TaskListScalingSignals) *matchingv1.TaskListScalingSignals {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.TaskListScalingSignals{
+		BacklogCountHint:           t.BacklogCountHint,
+		AddTasksPerSecond:          t.AddTasksPerSecond,
+		DispatchedTasksPerSecond:   t.DispatchedTasksPerSecond,
+		SyncMatchRatio:             t.SyncMatchRatio,
+		ScheduleToStartLatencyInMs: t.ScheduleToStartLatencyInMs,
+		PollerCount:                t.PollerCount,
+	}
+}
+
+func ToMatchingTaskListScalingSignals(t *matchingv1.TaskListScalingSignals) *types.TaskListScalingSignals {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListScalingSignals{
+		BacklogCountHint:           t.BacklogCountHint,
+		AddTasksPerSecond:          t.AddTasksPerSecond,
+		DispatchedTasksPerSecond:   t.DispatchedTasksPerSecond,
+		SyncMatchRatio:             t.SyncMatchRatio,
+		ScheduleToStartLatencyInMs: t.ScheduleToStartLatencyInMs,
+		PollerCount:                t.PollerCount,
+	}
+}
+
+func FromMatchingTaskListScalingConfidence(t *types.TaskListScalingConfidence) matchingv1.TaskListScalingConfidence {
+	if t == nil {
+		return matchingv1.TaskListScalingConfidence_TASK_LIST_SCALING_CONFIDENCE_INVALID
+	}
+	switch *t {
+	case types.TaskListScalingConfidenceLow:
+		return matchingv1.TaskListScalingConfidence_TASK_LIST_SCALING_CONFIDENCE_LOW
+	case types.TaskListScalingConfidenceMedium:
+		return matchingv1.TaskListScalingConfidence_TASK_LIST_SCALING_CONFIDENCE_MEDIUM
+	case types.TaskListScalingConfidenceHigh:
+		return matchingv1.TaskListScalingConfidence_TASK_LIST_SCALING_CONFIDENCE_HIGH
+	}
+	panic("unexpected enum value")
+}
+
+func ToMatchingTaskListScalingConfidence(t matchingv1.TaskListScalingConfidence) *types.TaskListScalingConfidence {
+	switch t {
+	case matchingv1.TaskListScalingConfidence_TASK_LIST_SCALING_CONFIDENCE_INVALID:
+		return nil
+	case matchingv1.TaskListScalingConfidence_TASK_LIST_SCALING_CONFIDENCE_LOW:
+		return types.TaskListScalingConfidenceLow.Ptr()
+	case matchingv1.TaskListScalingConfidence_TASK_LIST_SCALING_CONFIDENCE_MEDIUM:
+		return types.TaskListScalingConfidenceMedium.Ptr()
+	case matchingv1.TaskListScalingConfidence_TASK_LIST_SCALING_CONFIDENCE_HIGH:
+		return types.TaskListScalingConfidenceHigh.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
+func FromMatchingListWorkersRequest(t *types.MatchingListWorkersRequest) *matchingv1.ListWorkersRequest {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.ListWorkersRequest{
+		DomainId: t.DomainUUID,
+		Domain:   t.Request.GetDomain(),
+	}
+}
+
+func ToMatchingListWorkersRequest(t *matchingv1.ListWorkersRequest) *types.MatchingListWorkersRequest {
+	if t == nil {
+		return nil
+	}
+	var request *types.ListWorkersRequest
+	if t.Domain != "" {
+		request = &types.ListWorkersRequest{Domain: t.Domain}
+	}
+	return &types.MatchingListWorkersRequest{
+		DomainUUID: t.DomainId,
+		Request:    request,
+	}
+}
+
+func FromMatchingListWorkersResponse(t *types.ListWorkersResponse) *matchingv1.ListWorkersResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.ListWorkersResponse{
+		Workers: FromMatchingWorkerInfoArray(t.Workers),
+	}
+}
+
+func ToMatchingListWorkersResponse(t *matchingv1.ListWorkersResponse) *types.ListWorkersResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListWorkersResponse{
+		Workers: ToMatchingWorkerInfoArray(t.Workers),
+	}
+}
+
+func FromMatchingDescribeWorkerRequest(t *types.MatchingDescribeWorkerRequest) *matchingv1.DescribeWorkerRequest {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.DescribeWorkerRequest{
+		DomainId: t.DomainUUID,
+		Domain:   t.Request.GetDomain(),
+		Identity: t.Request.GetIdentity(),
+	}
+}
+
+func ToMatchingDescribeWorkerRequest(t *matchingv1.DescribeWorkerRequest) *types.MatchingDescribeWorkerRequest {
+	if t == nil {
+		return nil
+	}
+	var request *types.DescribeWorkerRequest
+	if t.Domain != "" || t.Identity != "" {
+		request = &types.DescribeWorkerRequest{
+			Domain:   t.Domain,
+			Identity: t.Identity,
+		}
+	}
+	return &types.MatchingDescribeWorkerRequest{
+		DomainUUID: t.DomainId,
+		Request:    request,
+	}
+}
+
+func FromMatchingDescribeWorkerResponse(t *types.DescribeWorkerResponse) *matchingv1.DescribeWorkerResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.DescribeWorkerResponse{
+		Worker: FromMatchingWorkerInfo(t.Worker),
+	}
+}
+
+func ToMatchingDescribeWorkerResponse(t *matchingv1.DescribeWorkerResponse) *types.DescribeWorkerResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeWorkerResponse{
+		Worker: ToMatchingWorkerInfo(t.Worker),
+	}
+}
+
+func FromMatchingWorkerInfo(t *types.WorkerInfo) *matchingv1.WorkerInfo {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.WorkerInfo{
+		Identity:       t.Identity,
+		VersionInfo:    FromWorkerVersionInfo(t.VersionInfo),
+		TaskLists:      FromMatchingWorkerTaskListInfoArray(t.TaskLists),
+		LastPollTime:   unixNanoToTime(t.LastPollTime),
+		PollsPerSecond: t.PollsPerSecond,
+	}
+}
+
+func ToMatchingWorkerInfo(t *matchingv1.WorkerInfo) *types.WorkerInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkerInfo{
+		Identity:       t.Identity,
+		VersionInfo:    ToWorkerVersionInfo(t.VersionInfo),
+		TaskLists:      ToMatchingWorkerTaskListInfoArray(t.TaskLists),
+		LastPollTime:   timeToUnixNano(t.LastPollTime),
+		PollsPerSecond: t.PollsPerSecond,
+	}
+}
+
+func FromMatchingWorkerInfoArray(t []*types.WorkerInfo) []*matchingv1.WorkerInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*matchingv1.WorkerInfo, len(t))
+	for i := range t {
+		v[i] = FromMatchingWorkerInfo(t[i])
+	}
+	return v
+}
+
+func ToMatchingWorkerInfoArray(t []*matchingv1.WorkerInfo) []*types.WorkerInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.WorkerInfo, len(t))
+	for i := range t {
+		v[i] = ToMatchingWorkerInfo(t[i])
+	}
+	return v
+}
+
+func FromMatchingWorkerTaskListInfo(t *types.WorkerTaskListInfo) *matchingv1.WorkerTaskListInfo {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.WorkerTaskListInfo{
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+		LastPollTime: unixNanoToTime(t.LastPollTime),
+	}
+}
+
+func ToMatchingWorkerTaskListInfo(t *matchingv1.WorkerTaskListInfo) *types.WorkerTaskListInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkerTaskListInfo{
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+		LastPollTime: timeToUnixNano(t.LastPollTime),
+	}
+}
+
+func FromMatchingWorkerTaskListInfoArray(t []*types.WorkerTaskListInfo) []*matchingv1.WorkerTaskListInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*matchingv1.WorkerTaskListInfo, len(t))
+	for i := range t {
+		v[i] = FromMatchingWorkerTaskListInfo(t[i])
+	}
+	return v
+}
+
+func ToMatchingWorkerTaskListInfoArray(t []*matchingv1.WorkerTaskListInfo) []*types.WorkerTaskListInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.WorkerTaskListInfo, len(t))
+	for i := range t {
+		v[i] = ToMatchingWorkerTaskListInfo(t[i])
+	}
+	return v
+}
+
+func FromMatchingDescribeDomainTaskQuotaRequest(t *types.MatchingDescribeDomainTaskQuotaRequest) *matchingv1.DescribeDomainTaskQuotaRequest {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.DescribeDomainTaskQuotaRequest{
+		DomainId: t.DomainUUID,
+	}
+}
+
+func ToMatchingDescribeDomainTaskQuotaRequest(t *matchingv1.DescribeDomainTaskQuotaRequest) *types.MatchingDescribeDomainTaskQuotaRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingDescribeDomainTaskQuotaRequest{
+		DomainUUID: t.DomainId,
+	}
+}
+
+func FromMatchingDescribeDomainTaskQuotaResponse(t *types.MatchingDescribeDomainTaskQuotaResponse) *matchingv1.DescribeDomainTaskQuotaResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.DescribeDomainTaskQuotaResponse{
+		TaskQuota: FromMatchingDomainTaskQuota(t.TaskQuota),
+	}
+}
+
+func ToMatchingDescribeDomainTaskQuotaResponse(t *matchingv1.DescribeDomainTaskQuotaResponse) *types.MatchingDescribeDomainTaskQuotaResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingDescribeDomainTaskQuotaResponse{
+		TaskQuota: ToMatchingDomainTaskQuota(t.TaskQuota),
+	}
+}
+
+func FromMatchingDomainTaskQuota(t *types.DomainTaskQuota) *matchingv1.DomainTaskQuota {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.DomainTaskQuota{
+		AddTaskRpsLimit:     t.AddTaskRPSLimit,
+		AddTaskRps:          t.AddTaskRPS,
+		AddTaskRejectedRps:  t.AddTaskRejectedRPS,
+		DispatchRpsLimit:    t.DispatchRPSLimit,
+		DispatchRps:         t.DispatchRPS,
+		DispatchRejectedRps: t.DispatchRejectedRPS,
+	}
+}
+
+func ToMatchingDomainTaskQuota(t *matchingv1.DomainTaskQuota) *types.DomainTaskQuota {
+	if t == nil {
+		return nil
+	}
+	return &types.DomainTaskQuota{
+		AddTaskRPSLimit:     t.AddTaskRpsLimit,
+		AddTaskRPS:          t.AddTaskRps,
+		AddTaskRejectedRPS:  t.AddTaskRejectedRps,
+		DispatchRPSLimit:    t.DispatchRpsLimit,
+		DispatchRPS:         t.DispatchRps,
+		DispatchRejectedRPS: t.DispatchRejectedRps,
+	}
+}
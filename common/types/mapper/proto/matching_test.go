@@ -42,6 +42,12 @@ func TestMatchingAddDecisionTaskRequest(t *testing.T) {
 	}
 }
 
+func TestMatchingAddDecisionTaskRequest_BuildID(t *testing.T) {
+	item := testdata.MatchingAddDecisionTaskRequest
+	item.BuildID = "build-1"
+	assert.Equal(t, &item, ToMatchingAddDecisionTaskRequest(FromMatchingAddDecisionTaskRequest(&item)))
+}
+
 func TestMatchingAddActivityTaskResponse(t *testing.T) {
 	for _, item := range []*types.AddActivityTaskResponse{nil, {}, &testdata.MatchingAddActivityTaskResponse} {
 		assert.Equal(t, item, ToMatchingAddActivityTaskResponse(FromMatchingAddActivityTaskResponse(item)))
@@ -72,6 +78,25 @@ func TestMatchingDescribeTaskListResponse(t *testing.T) {
 	}
 }
 
+func TestMatchingDescribeTaskListResponse_Versioning(t *testing.T) {
+	status := testdata.TaskListStatus
+	status.BuildIDMetrics = testdata.BuildIDMetricsMap
+	item := testdata.MatchingDescribeTaskListResponse
+	item.TaskListStatus = &status
+	item.VersioningConfig = &testdata.TaskListVersioningConfig
+	assert.Equal(t, &item, ToMatchingDescribeTaskListResponse(FromMatchingDescribeTaskListResponse(&item)))
+}
+
+func TestMatchingDescribeTaskListResponse_DispatchMetrics(t *testing.T) {
+	status := testdata.TaskListStatus
+	status.DispatchedTasksPerSecond = 10
+	status.SyncMatchRatio = 0.5
+	status.ScheduleToStartLatencyInMs = 1500
+	item := testdata.MatchingDescribeTaskListResponse
+	item.TaskListStatus = &status
+	assert.Equal(t, &item, ToMatchingDescribeTaskListResponse(FromMatchingDescribeTaskListResponse(&item)))
+}
+
 func TestMatchingDescribeTaskListResponseMap(t *testing.T) {
 	for _, item := range []map[string]*types.DescribeTaskListResponse{nil, {}, testdata.DescribeTaskListResponseMap} {
 		assert.Equal(t, item, ToMatchingDescribeTaskListResponseMap(FromMatchingDescribeTaskListResponseMap(item)))
@@ -162,6 +187,18 @@ func TestMatchingUpdateTaskListPartitionConfigResponse(t *testing.T) {
 	}
 }
 
+func TestMatchingUpdateTaskListVersioningConfigRequest(t *testing.T) {
+	for _, item := range []*types.MatchingUpdateTaskListVersioningConfigRequest{nil, {}, &testdata.MatchingUpdateTaskListVersioningConfigRequest} {
+		assert.Equal(t, item, ToMatchingUpdateTaskListVersioningConfigRequest(FromMatchingUpdateTaskListVersioningConfigRequest(item)))
+	}
+}
+
+func TestMatchingUpdateTaskListVersioningConfigResponse(t *testing.T) {
+	for _, item := range []*types.MatchingUpdateTaskListVersioningConfigResponse{nil, {}, &testdata.MatchingUpdateTaskListVersioningConfigResponse} {
+		assert.Equal(t, item, ToMatchingUpdateTaskListVersioningConfigResponse(FromMatchingUpdateTaskListVersioningConfigResponse(item)))
+	}
+}
+
 func TestMatchingRefreshTaskListPartitionConfigRequest(t *testing.T) {
 	for _, item := range []*types.MatchingRefreshTaskListPartitionConfigRequest{nil, {}, &testdata.MatchingRefreshTaskListPartitionConfigRequest} {
 		assert.Equal(t, item, ToMatchingRefreshTaskListPartitionConfigRequest(FromMatchingRefreshTaskListPartitionConfigRequest(item)))
@@ -260,3 +297,94 @@ func TestToMatchingTaskListPartitionConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestMatchingMigrateTaskListRequest(t *testing.T) {
+	for _, item := range []*types.MatchingMigrateTaskListRequest{nil, {}, &testdata.MatchingMigrateTaskListRequest} {
+		assert.Equal(t, item, ToMatchingMigrateTaskListRequest(FromMatchingMigrateTaskListRequest(item)))
+	}
+}
+
+func TestMatchingMigrateTaskListResponse(t *testing.T) {
+	for _, item := range []*types.MigrateTaskListResponse{nil, {}, &testdata.AdminMigrateTaskListResponse} {
+		assert.Equal(t, item, ToMatchingMigrateTaskListResponse(FromMatchingMigrateTaskListResponse(item)))
+	}
+}
+
+func TestMatchingGetTaskListScalingRecommendationRequest(t *testing.T) {
+	for _, item := range []*types.MatchingGetTaskListScalingRecommendationRequest{nil, {}, &testdata.MatchingGetTaskListScalingRecommendationRequest} {
+		assert.Equal(t, item, ToMatchingGetTaskListScalingRecommendationRequest(FromMatchingGetTaskListScalingRecommendationRequest(item)))
+	}
+}
+
+func TestMatchingGetTaskListScalingRecommendationResponse(t *testing.T) {
+	for _, item := range []*types.GetTaskListScalingRecommendationResponse{nil, {}, &testdata.GetTaskListScalingRecommendationResponse} {
+		assert.Equal(t, item, ToMatchingGetTaskListScalingRecommendationResponse(FromMatchingGetTaskListScalingRecommendationResponse(item)))
+	}
+}
+
+func TestMatchingTaskListScalingConfidence(t *testing.T) {
+	for _, item := range []*types.TaskListScalingConfidence{
+		nil,
+		types.TaskListScalingConfidenceLow.Ptr(),
+		types.TaskListScalingConfidenceMedium.Ptr(),
+		types.TaskListScalingConfidenceHigh.Ptr(),
+	} {
+		assert.Equal(t, item, ToMatchingTaskListScalingConfidence(FromMatchingTaskListScalingConfidence(item)))
+	}
+	assert.Panics(t, func() { ToMatchingTaskListScalingConfidence(matchingv1.TaskListScalingConfidence(UnknownValue)) })
+	assert.Panics(t, func() { FromMatchingTaskListScalingConfidence(types.TaskListScalingConfidence(UnknownValue).Ptr()) })
+}
+
+func TestMatchingListWorkersRequest(t *testing.T) {
+	for _, item := range []*types.MatchingListWorkersRequest{nil, {}, &testdata.MatchingListWorkersRequest} {
+		assert.Equal(t, item, ToMatchingListWorkersRequest(FromMatchingListWorkersRequest(item)))
+	}
+}
+
+func TestMatchingListWorkersResponse(t *testing.T) {
+	for _, item := range []*types.ListWorkersResponse{nil, {}, &testdata.ListWorkersResponse} {
+		assert.Equal(t, item, ToMatchingListWorkersResponse(FromMatchingListWorkersResponse(item)))
+	}
+}
+
+func TestMatchingDescribeWorkerRequest(t *testing.T) {
+	for _, item := range []*types.MatchingDescribeWorkerRequest{nil, {}, &testdata.MatchingDescribeWorkerRequest} {
+		assert.Equal(t, item, ToMatchingDescribeWorkerRequest(FromMatchingDescribeWorkerRequest(item)))
+	}
+}
+
+func TestMatchingDescribeWorkerResponse(t *testing.T) {
+	for _, item := range []*types.DescribeWorkerResponse{nil, {}, &testdata.DescribeWorkerResponse} {
+		assert.Equal(t, item, ToMatchingDescribeWorkerResponse(FromMatchingDescribeWorkerResponse(item)))
+	}
+}
+
+func TestMatchingWorkerInfo(t *testing.T) {
+	for _, item := range []*types.WorkerInfo{nil, {}, &testdata.WorkerInfo} {
+		assert.Equal(t, item, ToMatchingWorkerInfo(FromMatchingWorkerInfo(item)))
+	}
+}
+
+func TestMatchingWorkerTaskListInfo(t *testing.T) {
+	for _, item := range []*types.WorkerTaskListInfo{nil, {}, &testdata.WorkerTaskListInfo} {
+		assert.Equal(t, item, ToMatchingWorkerTaskListInfo(FromMatchingWorkerTaskListInfo(item)))
+	}
+}
+
+func TestMatchingDescribeDomainTaskQuotaRequest(t *testing.T) {
+	for _, item := range []*types.MatchingDescribeDomainTaskQuotaRequest{nil, {}, &testdata.MatchingDescribeDomainTaskQuotaRequest} {
+		assert.Equal(t, item, ToMatchingDescribeDomainTaskQuotaRequest(FromMatchingDescribeDomainTaskQuotaRequest(item)))
+	}
+}
+
+func TestMatchingDescribeDomainTaskQuotaResponse(t *testing.T) {
+	for _, item := range []*types.MatchingDescribeDomainTaskQuotaResponse{nil, {}, &testdata.MatchingDescribeDomainTaskQuotaResponse} {
+		assert.Equal(t, item, ToMatchingDescribeDomainTaskQuotaResponse(FromMatchingDescribeDomainTaskQuotaResponse(item)))
+	}
+}
+
+func TestMatchingDomainTaskQuota(t *testing.T) {
+	for _, item := range []*types.DomainTaskQuota{nil, {}, &testdata.DomainTaskQuota} {
+		assert.Equal(t, item, ToMatchingDomainTaskQuota(FromMatchingDomainTaskQuota(item)))
+	}
+}
@@ -24,6 +24,7 @@ package proto
 
 import (
 	sharddistributorv1 "github.com/uber/cadence/.gen/proto/sharddistributor/v1"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -71,6 +72,494 @@ func ToShardDistributorGetShardOwnerResponse(t *sharddistributorv1.GetShardOwner
 	}
 }
 
+// FromShardDistributorWatchNamespaceStateRequest converts a types.WatchNamespaceStateRequest to a sharddistributor.WatchNamespaceStateRequest
+func FromShardDistributorWatchNamespaceStateRequest(t *types.WatchNamespaceStateRequest) *sharddistributorv1.WatchNamespaceStateRequest {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.WatchNamespaceStateRequest{
+		Namespace: t.GetNamespace(),
+		Revision:  t.GetRevision(),
+	}
+}
+
+// ToShardDistributorWatchNamespaceStateRequest converts a sharddistributor.WatchNamespaceStateRequest to a types.WatchNamespaceStateRequest
+func ToShardDistributorWatchNamespaceStateRequest(t *sharddistributorv1.WatchNamespaceStateRequest) *types.WatchNamespaceStateRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.WatchNamespaceStateRequest{
+		Namespace: t.GetNamespace(),
+		Revision:  t.GetRevision(),
+	}
+}
+
+// FromShardDistributorWatchNamespaceStateResponse converts a types.WatchNamespaceStateResponse to a sharddistributor.WatchNamespaceStateResponse
+func FromShardDistributorWatchNamespaceStateResponse(t *types.WatchNamespaceStateResponse) *sharddistributorv1.WatchNamespaceStateResponse {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.WatchNamespaceStateResponse{
+		Namespace: t.GetNamespace(),
+		Revision:  t.GetRevision(),
+		Owners:    t.GetOwners(),
+	}
+}
+
+// ToShardDistributorWatchNamespaceStateResponse converts a sharddistributor.WatchNamespaceStateResponse to a types.WatchNamespaceStateResponse
+func ToShardDistributorWatchNamespaceStateResponse(t *sharddistributorv1.WatchNamespaceStateResponse) *types.WatchNamespaceStateResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.WatchNamespaceStateResponse{
+		Namespace: t.GetNamespace(),
+		Revision:  t.GetRevision(),
+		Owners:    t.GetOwners(),
+	}
+}
+
+// FromShardDistributorListNamespacesRequest converts a types.ListNamespacesRequest to a sharddistributor.ListNamespacesRequest
+func FromShardDistributorListNamespacesRequest(t *types.ListNamespacesRequest) *sharddistributorv1.ListNamespacesRequest {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.ListNamespacesRequest{}
+}
+
+// ToShardDistributorListNamespacesRequest converts a sharddistributor.ListNamespacesRequest to a types.ListNamespacesRequest
+func ToShardDistributorListNamespacesRequest(t *sharddistributorv1.ListNamespacesRequest) *types.ListNamespacesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListNamespacesRequest{}
+}
+
+// FromShardDistributorListNamespacesResponse converts a types.ListNamespacesResponse to a sharddistributor.ListNamespacesResponse
+func FromShardDistributorListNamespacesResponse(t *types.ListNamespacesResponse) *sharddistributorv1.ListNamespacesResponse {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.ListNamespacesResponse{
+		Namespaces: fromShardDistributorNamespaceInfoArray(t.GetNamespaces()),
+	}
+}
+
+// ToShardDistributorListNamespacesResponse converts a sharddistributor.ListNamespacesResponse to a types.ListNamespacesResponse
+func ToShardDistributorListNamespacesResponse(t *sharddistributorv1.ListNamespacesResponse) *types.ListNamespacesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListNamespacesResponse{
+		Namespaces: toShardDistributorNamespaceInfoArray(t.GetNamespaces()),
+	}
+}
+
+func fromShardDistributorNamespaceInfo(t *types.NamespaceInfo) *sharddistributorv1.NamespaceInfo {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.NamespaceInfo{
+		Name:     t.GetName(),
+		Type:     t.GetType(),
+		ShardNum: t.GetShardNum(),
+	}
+}
+
+func toShardDistributorNamespaceInfo(t *sharddistributorv1.NamespaceInfo) *types.NamespaceInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.NamespaceInfo{
+		Name:     t.GetName(),
+		Type:     t.GetType(),
+		ShardNum: t.GetShardNum(),
+	}
+}
+
+func fromShardDistributorNamespaceInfoArray(t []*types.NamespaceInfo) []*sharddistributorv1.NamespaceInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*sharddistributorv1.NamespaceInfo, len(t))
+	for i := range t {
+		v[i] = fromShardDistributorNamespaceInfo(t[i])
+	}
+	return v
+}
+
+func toShardDistributorNamespaceInfoArray(t []*sharddistributorv1.NamespaceInfo) []*types.NamespaceInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.NamespaceInfo, len(t))
+	for i := range t {
+		v[i] = toShardDistributorNamespaceInfo(t[i])
+	}
+	return v
+}
+
+// FromShardDistributorDescribeNamespaceRequest converts a types.DescribeNamespaceRequest to a sharddistributor.DescribeNamespaceRequest
+func FromShardDistributorDescribeNamespaceRequest(t *types.DescribeNamespaceRequest) *sharddistributorv1.DescribeNamespaceRequest {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.DescribeNamespaceRequest{
+		Namespace: t.GetNamespace(),
+	}
+}
+
+// ToShardDistributorDescribeNamespaceRequest converts a sharddistributor.DescribeNamespaceRequest to a types.DescribeNamespaceRequest
+func ToShardDistributorDescribeNamespaceRequest(t *sharddistributorv1.DescribeNamespaceRequest) *types.DescribeNamespaceRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeNamespaceRequest{
+		Namespace: t.GetNamespace(),
+	}
+}
+
+// FromShardDistributorDescribeNamespaceResponse converts a types.DescribeNamespaceResponse to a sharddistributor.DescribeNamespaceResponse
+func FromShardDistributorDescribeNamespaceResponse(t *types.DescribeNamespaceResponse) *sharddistributorv1.DescribeNamespaceResponse {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.DescribeNamespaceResponse{
+		Namespace:         t.GetNamespace(),
+		Executors:         fromShardDistributorExecutorInfoArray(t.GetExecutors()),
+		RebalancingFrozen: t.GetRebalancingFrozen(),
+		ShardMoves:        fromShardDistributorShardMoveInfoArray(t.GetShardMoves()),
+		AuditLog:          fromShardDistributorNamespaceAuditEntryArray(t.GetAuditLog()),
+	}
+}
+
+// ToShardDistributorDescribeNamespaceResponse converts a sharddistributor.DescribeNamespaceResponse to a types.DescribeNamespaceResponse
+func ToShardDistributorDescribeNamespaceResponse(t *sharddistributorv1.DescribeNamespaceResponse) *types.DescribeNamespaceResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeNamespaceResponse{
+		Namespace:         t.GetNamespace(),
+		Executors:         toShardDistributorExecutorInfoArray(t.GetExecutors()),
+		RebalancingFrozen: t.GetRebalancingFrozen(),
+		ShardMoves:        toShardDistributorShardMoveInfoArray(t.GetShardMoves()),
+		AuditLog:          toShardDistributorNamespaceAuditEntryArray(t.GetAuditLog()),
+	}
+}
+
+func fromShardDistributorExecutorInfo(t *types.ExecutorInfo) *sharddistributorv1.ExecutorInfo {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.ExecutorInfo{
+		ExecutorId:     t.GetExecutorID(),
+		State:          t.GetState(),
+		LastHeartbeat:  unixNanoToTime(&t.LastHeartbeat),
+		Drained:        t.GetDrained(),
+		AssignedShards: fromShardDistributorShardAssignmentInfoArray(t.GetAssignedShards()),
+	}
+}
+
+func toShardDistributorExecutorInfo(t *sharddistributorv1.ExecutorInfo) *types.ExecutorInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.ExecutorInfo{
+		ExecutorID:     t.GetExecutorId(),
+		State:          t.GetState(),
+		LastHeartbeat:  common.Int64Default(timeToUnixNano(t.GetLastHeartbeat())),
+		Drained:        t.GetDrained(),
+		AssignedShards: toShardDistributorShardAssignmentInfoArray(t.GetAssignedShards()),
+	}
+}
+
+func fromShardDistributorExecutorInfoArray(t []*types.ExecutorInfo) []*sharddistributorv1.ExecutorInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*sharddistributorv1.ExecutorInfo, len(t))
+	for i := range t {
+		v[i] = fromShardDistributorExecutorInfo(t[i])
+	}
+	return v
+}
+
+func toShardDistributorExecutorInfoArray(t []*sharddistributorv1.ExecutorInfo) []*types.ExecutorInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ExecutorInfo, len(t))
+	for i := range t {
+		v[i] = toShardDistributorExecutorInfo(t[i])
+	}
+	return v
+}
+
+func fromShardDistributorShardAssignmentInfo(t *types.ShardAssignmentInfo) *sharddistributorv1.ShardAssignmentInfo {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.ShardAssignmentInfo{
+		ShardKey:   t.GetShardKey(),
+		AssignedAt: unixNanoToTime(&t.AssignedAt),
+		Status:     t.GetStatus(),
+		ShardLoad:  t.GetShardLoad(),
+	}
+}
+
+func toShardDistributorShardAssignmentInfo(t *sharddistributorv1.ShardAssignmentInfo) *types.ShardAssignmentInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.ShardAssignmentInfo{
+		ShardKey:   t.GetShardKey(),
+		AssignedAt: common.Int64Default(timeToUnixNano(t.GetAssignedAt())),
+		Status:     t.GetStatus(),
+		ShardLoad:  t.GetShardLoad(),
+	}
+}
+
+func fromShardDistributorShardAssignmentInfoArray(t []*types.ShardAssignmentInfo) []*sharddistributorv1.ShardAssignmentInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*sharddistributorv1.ShardAssignmentInfo, len(t))
+	for i := range t {
+		v[i] = fromShardDistributorShardAssignmentInfo(t[i])
+	}
+	return v
+}
+
+func toShardDistributorShardAssignmentInfoArray(t []*sharddistributorv1.ShardAssignmentInfo) []*types.ShardAssignmentInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ShardAssignmentInfo, len(t))
+	for i := range t {
+		v[i] = toShardDistributorShardAssignmentInfo(t[i])
+	}
+	return v
+}
+
+func fromShardDistributorShardMoveInfo(t *types.ShardMoveInfo) *sharddistributorv1.ShardMoveInfo {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.ShardMoveInfo{
+		ShardKey:    t.GetShardKey(),
+		ExecutorId:  t.GetExecutorID(),
+		RequestedAt: unixNanoToTime(&t.RequestedAt),
+	}
+}
+
+func toShardDistributorShardMoveInfo(t *sharddistributorv1.ShardMoveInfo) *types.ShardMoveInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.ShardMoveInfo{
+		ShardKey:    t.GetShardKey(),
+		ExecutorID:  t.GetExecutorId(),
+		RequestedAt: common.Int64Default(timeToUnixNano(t.GetRequestedAt())),
+	}
+}
+
+func fromShardDistributorShardMoveInfoArray(t []*types.ShardMoveInfo) []*sharddistributorv1.ShardMoveInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*sharddistributorv1.ShardMoveInfo, len(t))
+	for i := range t {
+		v[i] = fromShardDistributorShardMoveInfo(t[i])
+	}
+	return v
+}
+
+func toShardDistributorShardMoveInfoArray(t []*sharddistributorv1.ShardMoveInfo) []*types.ShardMoveInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ShardMoveInfo, len(t))
+	for i := range t {
+		v[i] = toShardDistributorShardMoveInfo(t[i])
+	}
+	return v
+}
+
+func fromShardDistributorNamespaceAuditEntry(t *types.NamespaceAuditEntry) *sharddistributorv1.NamespaceAuditEntry {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.NamespaceAuditEntry{
+		Time:      unixNanoToTime(&t.Time),
+		Identity:  t.GetIdentity(),
+		Operation: t.GetOperation(),
+		Reason:    t.GetReason(),
+	}
+}
+
+func toShardDistributorNamespaceAuditEntry(t *sharddistributorv1.NamespaceAuditEntry) *types.NamespaceAuditEntry {
+	if t == nil {
+		return nil
+	}
+	return &types.NamespaceAuditEntry{
+		Time:      common.Int64Default(timeToUnixNano(t.GetTime())),
+		Identity:  t.GetIdentity(),
+		Operation: t.GetOperation(),
+		Reason:    t.GetReason(),
+	}
+}
+
+func fromShardDistributorNamespaceAuditEntryArray(t []*types.NamespaceAuditEntry) []*sharddistributorv1.NamespaceAuditEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*sharddistributorv1.NamespaceAuditEntry, len(t))
+	for i := range t {
+		v[i] = fromShardDistributorNamespaceAuditEntry(t[i])
+	}
+	return v
+}
+
+func toShardDistributorNamespaceAuditEntryArray(t []*sharddistributorv1.NamespaceAuditEntry) []*types.NamespaceAuditEntry {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.NamespaceAuditEntry, len(t))
+	for i := range t {
+		v[i] = toShardDistributorNamespaceAuditEntry(t[i])
+	}
+	return v
+}
+
+// FromShardDistributorMoveShardRequest converts a types.MoveShardRequest to a sharddistributor.MoveShardRequest
+func FromShardDistributorMoveShardRequest(t *types.MoveShardRequest) *sharddistributorv1.MoveShardRequest {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.MoveShardRequest{
+		Namespace:  t.GetNamespace(),
+		ShardKey:   t.GetShardKey(),
+		ExecutorId: t.GetExecutorID(),
+		Identity:   t.GetIdentity(),
+		Reason:     t.GetReason(),
+	}
+}
+
+// ToShardDistributorMoveShardRequest converts a sharddistributor.MoveShardRequest to a types.MoveShardRequest
+func ToShardDistributorMoveShardRequest(t *sharddistributorv1.MoveShardRequest) *types.MoveShardRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MoveShardRequest{
+		Namespace:  t.GetNamespace(),
+		ShardKey:   t.GetShardKey(),
+		ExecutorID: t.GetExecutorId(),
+		Identity:   t.GetIdentity(),
+		Reason:     t.GetReason(),
+	}
+}
+
+// FromShardDistributorMoveShardResponse converts a types.MoveShardResponse to a sharddistributor.MoveShardResponse
+func FromShardDistributorMoveShardResponse(t *types.MoveShardResponse) *sharddistributorv1.MoveShardResponse {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.MoveShardResponse{}
+}
+
+// ToShardDistributorMoveShardResponse converts a sharddistributor.MoveShardResponse to a types.MoveShardResponse
+func ToShardDistributorMoveShardResponse(t *sharddistributorv1.MoveShardResponse) *types.MoveShardResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MoveShardResponse{}
+}
+
+// FromShardDistributorSetExecutorDrainedRequest converts a types.SetExecutorDrainedRequest to a sharddistributor.SetExecutorDrainedRequest
+func FromShardDistributorSetExecutorDrainedRequest(t *types.SetExecutorDrainedRequest) *sharddistributorv1.SetExecutorDrainedRequest {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.SetExecutorDrainedRequest{
+		Namespace:  t.GetNamespace(),
+		ExecutorId: t.GetExecutorID(),
+		Drained:    t.GetDrained(),
+		Identity:   t.GetIdentity(),
+		Reason:     t.GetReason(),
+	}
+}
+
+// ToShardDistributorSetExecutorDrainedRequest converts a sharddistributor.SetExecutorDrainedRequest to a types.SetExecutorDrainedRequest
+func ToShardDistributorSetExecutorDrainedRequest(t *sharddistributorv1.SetExecutorDrainedRequest) *types.SetExecutorDrainedRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.SetExecutorDrainedRequest{
+		Namespace:  t.GetNamespace(),
+		ExecutorID: t.GetExecutorId(),
+		Drained:    t.GetDrained(),
+		Identity:   t.GetIdentity(),
+		Reason:     t.GetReason(),
+	}
+}
+
+// FromShardDistributorSetExecutorDrainedResponse converts a types.SetExecutorDrainedResponse to a sharddistributor.SetExecutorDrainedResponse
+func FromShardDistributorSetExecutorDrainedResponse(t *types.SetExecutorDrainedResponse) *sharddistributorv1.SetExecutorDrainedResponse {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.SetExecutorDrainedResponse{}
+}
+
+// ToShardDistributorSetExecutorDrainedResponse converts a sharddistributor.SetExecutorDrainedResponse to a types.SetExecutorDrainedResponse
+func ToShardDistributorSetExecutorDrainedResponse(t *sharddistributorv1.SetExecutorDrainedResponse) *types.SetExecutorDrainedResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.SetExecutorDrainedResponse{}
+}
+
+// FromShardDistributorSetRebalancingFrozenRequest converts a types.SetRebalancingFrozenRequest to a sharddistributor.SetRebalancingFrozenRequest
+func FromShardDistributorSetRebalancingFrozenRequest(t *types.SetRebalancingFrozenRequest) *sharddistributorv1.SetRebalancingFrozenRequest {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.SetRebalancingFrozenRequest{
+		Namespace: t.GetNamespace(),
+		Frozen:    t.GetFrozen(),
+		Identity:  t.GetIdentity(),
+		Reason:    t.GetReason(),
+	}
+}
+
+// ToShardDistributorSetRebalancingFrozenRequest converts a sharddistributor.SetRebalancingFrozenRequest to a types.SetRebalancingFrozenRequest
+func ToShardDistributorSetRebalancingFrozenRequest(t *sharddistributorv1.SetRebalancingFrozenRequest) *types.SetRebalancingFrozenRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.SetRebalancingFrozenRequest{
+		Namespace: t.GetNamespace(),
+		Frozen:    t.GetFrozen(),
+		Identity:  t.GetIdentity(),
+		Reason:    t.GetReason(),
+	}
+}
+
+// FromShardDistributorSetRebalancingFrozenResponse converts a types.SetRebalancingFrozenResponse to a sharddistributor.SetRebalancingFrozenResponse
+func FromShardDistributorSetRebalancingFrozenResponse(t *types.SetRebalancingFrozenResponse) *sharddistributorv1.SetRebalancingFrozenResponse {
+	if t == nil {
+		return nil
+	}
+	return &sharddistributorv1.SetRebalancingFrozenResponse{}
+}
+
+// ToShardDistributorSetRebalancingFrozenResponse converts a sharddistributor.SetRebalancingFrozenResponse to a types.SetRebalancingFrozenResponse
+func ToShardDistributorSetRebalancingFrozenResponse(t *sharddistributorv1.SetRebalancingFrozenResponse) *types.SetRebalancingFrozenResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.SetRebalancingFrozenResponse{}
+}
+
 func FromShardDistributorExecutorHeartbeatRequest(t *types.ExecutorHeartbeatRequest) *sharddistributorv1.HeartbeatRequest {
 	if t == nil {
 		return nil
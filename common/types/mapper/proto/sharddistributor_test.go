@@ -43,6 +43,78 @@ func TestFromShardDistributorGetShardOwnerResponse(t *testing.T) {
 	}
 }
 
+func TestFromShardDistributorWatchNamespaceStateRequest(t *testing.T) {
+	for _, item := range []*types.WatchNamespaceStateRequest{nil, {}, &testdata.ShardDistributorWatchNamespaceStateRequest} {
+		assert.Equal(t, item, ToShardDistributorWatchNamespaceStateRequest(FromShardDistributorWatchNamespaceStateRequest(item)))
+	}
+}
+
+func TestFromShardDistributorWatchNamespaceStateResponse(t *testing.T) {
+	for _, item := range []*types.WatchNamespaceStateResponse{nil, {}, &testdata.ShardDistributorWatchNamespaceStateResponse} {
+		assert.Equal(t, item, ToShardDistributorWatchNamespaceStateResponse(FromShardDistributorWatchNamespaceStateResponse(item)))
+	}
+}
+
+func TestFromShardDistributorListNamespacesRequest(t *testing.T) {
+	for _, item := range []*types.ListNamespacesRequest{nil, {}, &testdata.ShardDistributorListNamespacesRequest} {
+		assert.Equal(t, item, ToShardDistributorListNamespacesRequest(FromShardDistributorListNamespacesRequest(item)))
+	}
+}
+
+func TestFromShardDistributorListNamespacesResponse(t *testing.T) {
+	for _, item := range []*types.ListNamespacesResponse{nil, {}, &testdata.ShardDistributorListNamespacesResponse} {
+		assert.Equal(t, item, ToShardDistributorListNamespacesResponse(FromShardDistributorListNamespacesResponse(item)))
+	}
+}
+
+func TestFromShardDistributorDescribeNamespaceRequest(t *testing.T) {
+	for _, item := range []*types.DescribeNamespaceRequest{nil, {}, &testdata.ShardDistributorDescribeNamespaceRequest} {
+		assert.Equal(t, item, ToShardDistributorDescribeNamespaceRequest(FromShardDistributorDescribeNamespaceRequest(item)))
+	}
+}
+
+func TestFromShardDistributorDescribeNamespaceResponse(t *testing.T) {
+	for _, item := range []*types.DescribeNamespaceResponse{nil, {}, &testdata.ShardDistributorDescribeNamespaceResponse} {
+		assert.Equal(t, item, ToShardDistributorDescribeNamespaceResponse(FromShardDistributorDescribeNamespaceResponse(item)))
+	}
+}
+
+func TestFromShardDistributorMoveShardRequest(t *testing.T) {
+	for _, item := range []*types.MoveShardRequest{nil, {}, &testdata.ShardDistributorMoveShardRequest} {
+		assert.Equal(t, item, ToShardDistributorMoveShardRequest(FromShardDistributorMoveShardRequest(item)))
+	}
+}
+
+func TestFromShardDistributorMoveShardResponse(t *testing.T) {
+	for _, item := range []*types.MoveShardResponse{nil, {}, &testdata.ShardDistributorMoveShardResponse} {
+		assert.Equal(t, item, ToShardDistributorMoveShardResponse(FromShardDistributorMoveShardResponse(item)))
+	}
+}
+
+func TestFromShardDistributorSetExecutorDrainedRequest(t *testing.T) {
+	for _, item := range []*types.SetExecutorDrainedRequest{nil, {}, &testdata.ShardDistributorSetExecutorDrainedRequest} {
+		assert.Equal(t, item, ToShardDistributorSetExecutorDrainedRequest(FromShardDistributorSetExecutorDrainedRequest(item)))
+	}
+}
+
+func TestFromShardDistributorSetExecutorDrainedResponse(t *testing.T) {
+	for _, item := range []*types.SetExecutorDrainedResponse{nil, {}, &testdata.ShardDistributorSetExecutorDrainedResponse} {
+		assert.Equal(t, item, ToShardDistributorSetExecutorDrainedResponse(FromShardDistributorSetExecutorDrainedResponse(item)))
+	}
+}
+
+func TestFromShardDistributorSetRebalancingFrozenRequest(t *testing.T) {
+	for _, item := range []*types.SetRebalancingFrozenRequest{nil, {}, &testdata.ShardDistributorSetRebalancingFrozenRequest} {
+		assert.Equal(t, item, ToShardDistributorSetRebalancingFrozenRequest(FromShardDistributorSetRebalancingFrozenRequest(item)))
+	}
+}
+
+func TestFromShardDistributorSetRebalancingFrozenResponse(t *testing.T) {
+	for _, item := range []*types.SetRebalancingFrozenResponse{nil, {}, &testdata.ShardDistributorSetRebalancingFrozenResponse} {
+		assert.Equal(t, item, ToShardDistributorSetRebalancingFrozenResponse(FromShardDistributorSetRebalancingFrozenResponse(item)))
+	}
+}
+
 func TestFromShardDistributorExecutorHeartbeatRequest(t *testing.T) {
 	for _, item := range []*types.ExecutorHeartbeatRequest{nil, {}, &testdata.ShardDistributorExecutorHeartbeatRequest} {
 		assert.Equal(t, item, ToShardDistributorExecutorHeartbeatRequest(FromShardDistributorExecutorHeartbeatRequest(item)))
@@ -867,8 +867,10 @@ func FromIsolationGroupConfig(in *types.IsolationGroupConfiguration) *shared.Iso
 	var out []*shared.IsolationGroupPartition
 	for _, v := range *in {
 		out = append(out, &shared.IsolationGroupPartition{
-			Name:  strPtr(v.Name),
-			State: igStatePtr(shared.IsolationGroupState(v.State)),
+			Name:   strPtr(v.Name),
+			State:  igStatePtr(shared.IsolationGroupState(v.State)),
+			Weight: weightPtr(v.Weight),
+			Ramp:   fromIsolationGroupWeightRamp(v.Ramp),
 		})
 	}
 	sort.Slice(out, func(i, j int) bool {
@@ -889,13 +891,37 @@ func ToIsolationGroupConfig(in *shared.IsolationGroupConfiguration) *types.Isola
 	out := make(types.IsolationGroupConfiguration)
 	for v := range in.IsolationGroups {
 		out[in.IsolationGroups[v].GetName()] = types.IsolationGroupPartition{
-			Name:  in.IsolationGroups[v].GetName(),
-			State: types.IsolationGroupState(in.IsolationGroups[v].GetState()),
+			Name:   in.IsolationGroups[v].GetName(),
+			State:  types.IsolationGroupState(in.IsolationGroups[v].GetState()),
+			Weight: in.IsolationGroups[v].GetWeight(),
+			Ramp:   toIsolationGroupWeightRamp(in.IsolationGroups[v].Ramp),
 		}
 	}
 	return &out
 }
 
+func fromIsolationGroupWeightRamp(in *types.IsolationGroupWeightRamp) *shared.IsolationGroupWeightRamp {
+	if in == nil {
+		return nil
+	}
+	return &shared.IsolationGroupWeightRamp{
+		Weights:         in.Weights,
+		StartTimestamp:  &in.StartTimestamp,
+		IntervalSeconds: &in.IntervalSeconds,
+	}
+}
+
+func toIsolationGroupWeightRamp(in *shared.IsolationGroupWeightRamp) *types.IsolationGroupWeightRamp {
+	if in == nil {
+		return nil
+	}
+	return &types.IsolationGroupWeightRamp{
+		Weights:         in.Weights,
+		StartTimestamp:  in.GetStartTimestamp(),
+		IntervalSeconds: in.GetIntervalSeconds(),
+	}
+}
+
 func ToAdminGetDomainAsyncWorkflowConfiguratonRequest(in *admin.GetDomainAsyncWorkflowConfiguratonRequest) *types.GetDomainAsyncWorkflowConfiguratonRequest {
 	if in == nil {
 		return nil
@@ -992,5 +1018,508 @@ func ToAdminUpdateDomainAsyncWorkflowConfiguratonResponse(in *admin.UpdateDomain
 	return &types.UpdateDomainAsyncWorkflowConfiguratonResponse{}
 }
 
+// FromAdminReadAsyncWorkflowDLQMessagesRequest converts internal ReadAsyncWorkflowDLQMessagesRequest type to thrift
+func FromAdminReadAsyncWorkflowDLQMessagesRequest(t *types.ReadAsyncWorkflowDLQMessagesRequest) *admin.ReadAsyncWorkflowDLQMessagesRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.ReadAsyncWorkflowDLQMessagesRequest{
+		Domain:        &t.Domain,
+		PageSize:      &t.PageSize,
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToAdminReadAsyncWorkflowDLQMessagesRequest converts thrift ReadAsyncWorkflowDLQMessagesRequest type to internal
+func ToAdminReadAsyncWorkflowDLQMessagesRequest(t *admin.ReadAsyncWorkflowDLQMessagesRequest) *types.ReadAsyncWorkflowDLQMessagesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ReadAsyncWorkflowDLQMessagesRequest{
+		Domain:        t.GetDomain(),
+		PageSize:      t.GetPageSize(),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromAdminReadAsyncWorkflowDLQMessagesResponse converts internal ReadAsyncWorkflowDLQMessagesResponse type to thrift
+func FromAdminReadAsyncWorkflowDLQMessagesResponse(t *types.ReadAsyncWorkflowDLQMessagesResponse) *admin.ReadAsyncWorkflowDLQMessagesResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.ReadAsyncWorkflowDLQMessagesResponse{
+		Messages:      FromAdminAsyncWorkflowDLQMessageArray(t.Messages),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToAdminReadAsyncWorkflowDLQMessagesResponse converts thrift ReadAsyncWorkflowDLQMessagesResponse type to internal
+func ToAdminReadAsyncWorkflowDLQMessagesResponse(t *admin.ReadAsyncWorkflowDLQMessagesResponse) *types.ReadAsyncWorkflowDLQMessagesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ReadAsyncWorkflowDLQMessagesResponse{
+		Messages:      ToAdminAsyncWorkflowDLQMessageArray(t.Messages),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromAdminAsyncWorkflowDLQMessageArray converts internal AsyncWorkflowDLQMessage array to thrift
+func FromAdminAsyncWorkflowDLQMessageArray(t []*types.AsyncWorkflowDLQMessage) []*admin.AsyncWorkflowDLQMessage {
+	if t == nil {
+		return nil
+	}
+	v := make([]*admin.AsyncWorkflowDLQMessage, len(t))
+	for i := range t {
+		v[i] = FromAdminAsyncWorkflowDLQMessage(t[i])
+	}
+	return v
+}
+
+// ToAdminAsyncWorkflowDLQMessageArray converts thrift AsyncWorkflowDLQMessage array to internal
+func ToAdminAsyncWorkflowDLQMessageArray(t []*admin.AsyncWorkflowDLQMessage) []*types.AsyncWorkflowDLQMessage {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.AsyncWorkflowDLQMessage, len(t))
+	for i := range t {
+		v[i] = ToAdminAsyncWorkflowDLQMessage(t[i])
+	}
+	return v
+}
+
+// FromAdminAsyncWorkflowDLQMessage converts internal AsyncWorkflowDLQMessage type to thrift
+func FromAdminAsyncWorkflowDLQMessage(t *types.AsyncWorkflowDLQMessage) *admin.AsyncWorkflowDLQMessage {
+	if t == nil {
+		return nil
+	}
+	return &admin.AsyncWorkflowDLQMessage{
+		RequestID:       &t.RequestID,
+		RequestType:     &t.RequestType,
+		WorkflowID:      &t.WorkflowID,
+		Failure:         &t.Failure,
+		CreatedTimeNano: t.CreatedTimeNano,
+	}
+}
+
+// ToAdminAsyncWorkflowDLQMessage converts thrift AsyncWorkflowDLQMessage type to internal
+func ToAdminAsyncWorkflowDLQMessage(t *admin.AsyncWorkflowDLQMessage) *types.AsyncWorkflowDLQMessage {
+	if t == nil {
+		return nil
+	}
+	return &types.AsyncWorkflowDLQMessage{
+		RequestID:       t.GetRequestID(),
+		RequestType:     t.GetRequestType(),
+		WorkflowID:      t.GetWorkflowID(),
+		Failure:         t.GetFailure(),
+		CreatedTimeNano: t.CreatedTimeNano,
+	}
+}
+
+// FromAdminPurgeAsyncWorkflowDLQMessagesRequest converts internal PurgeAsyncWorkflowDLQMessagesRequest type to thrift
+func FromAdminPurgeAsyncWorkflowDLQMessagesRequest(t *types.PurgeAsyncWorkflowDLQMessagesRequest) *admin.PurgeAsyncWorkflowDLQMessagesRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.PurgeAsyncWorkflowDLQMessagesRequest{
+		Domain:     &t.Domain,
+		RequestIDs: t.RequestIDs,
+	}
+}
+
+// ToAdminPurgeAsyncWorkflowDLQMessagesRequest converts thrift PurgeAsyncWorkflowDLQMessagesRequest type to internal
+func ToAdminPurgeAsyncWorkflowDLQMessagesRequest(t *admin.PurgeAsyncWorkflowDLQMessagesRequest) *types.PurgeAsyncWorkflowDLQMessagesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.PurgeAsyncWorkflowDLQMessagesRequest{
+		Domain:     t.GetDomain(),
+		RequestIDs: t.RequestIDs,
+	}
+}
+
+// FromAdminPurgeAsyncWorkflowDLQMessagesResponse converts internal PurgeAsyncWorkflowDLQMessagesResponse type to thrift
+func FromAdminPurgeAsyncWorkflowDLQMessagesResponse(t *types.PurgeAsyncWorkflowDLQMessagesResponse) *admin.PurgeAsyncWorkflowDLQMessagesResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.PurgeAsyncWorkflowDLQMessagesResponse{}
+}
+
+// ToAdminPurgeAsyncWorkflowDLQMessagesResponse converts thrift PurgeAsyncWorkflowDLQMessagesResponse type to internal
+func ToAdminPurgeAsyncWorkflowDLQMessagesResponse(t *admin.PurgeAsyncWorkflowDLQMessagesResponse) *types.PurgeAsyncWorkflowDLQMessagesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.PurgeAsyncWorkflowDLQMessagesResponse{}
+}
+
+// FromAdminReplayAsyncWorkflowDLQMessagesRequest converts internal ReplayAsyncWorkflowDLQMessagesRequest type to thrift
+func FromAdminReplayAsyncWorkflowDLQMessagesRequest(t *types.ReplayAsyncWorkflowDLQMessagesRequest) *admin.ReplayAsyncWorkflowDLQMessagesRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.ReplayAsyncWorkflowDLQMessagesRequest{
+		Domain:     &t.Domain,
+		RequestIDs: t.RequestIDs,
+	}
+}
+
+// ToAdminReplayAsyncWorkflowDLQMessagesRequest converts thrift ReplayAsyncWorkflowDLQMessagesRequest type to internal
+func ToAdminReplayAsyncWorkflowDLQMessagesRequest(t *admin.ReplayAsyncWorkflowDLQMessagesRequest) *types.ReplayAsyncWorkflowDLQMessagesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplayAsyncWorkflowDLQMessagesRequest{
+		Domain:     t.GetDomain(),
+		RequestIDs: t.RequestIDs,
+	}
+}
+
+// FromAdminReplayAsyncWorkflowDLQMessagesResponse converts internal ReplayAsyncWorkflowDLQMessagesResponse type to thrift
+func FromAdminReplayAsyncWorkflowDLQMessagesResponse(t *types.ReplayAsyncWorkflowDLQMessagesResponse) *admin.ReplayAsyncWorkflowDLQMessagesResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.ReplayAsyncWorkflowDLQMessagesResponse{}
+}
+
+// ToAdminReplayAsyncWorkflowDLQMessagesResponse converts thrift ReplayAsyncWorkflowDLQMessagesResponse type to internal
+func ToAdminReplayAsyncWorkflowDLQMessagesResponse(t *admin.ReplayAsyncWorkflowDLQMessagesResponse) *types.ReplayAsyncWorkflowDLQMessagesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplayAsyncWorkflowDLQMessagesResponse{}
+}
+
+// FromAdminDescribeAsyncWorkflowQueueRequest converts internal DescribeAsyncWorkflowQueueRequest type to thrift
+func FromAdminDescribeAsyncWorkflowQueueRequest(t *types.DescribeAsyncWorkflowQueueRequest) *admin.DescribeAsyncWorkflowQueueRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.DescribeAsyncWorkflowQueueRequest{
+		Domain: &t.Domain,
+	}
+}
+
+// ToAdminDescribeAsyncWorkflowQueueRequest converts thrift DescribeAsyncWorkflowQueueRequest type to internal
+func ToAdminDescribeAsyncWorkflowQueueRequest(t *admin.DescribeAsyncWorkflowQueueRequest) *types.DescribeAsyncWorkflowQueueRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeAsyncWorkflowQueueRequest{
+		Domain: t.GetDomain(),
+	}
+}
+
+// FromAdminDescribeAsyncWorkflowQueueResponse converts internal DescribeAsyncWorkflowQueueResponse type to thrift
+func FromAdminDescribeAsyncWorkflowQueueResponse(t *types.DescribeAsyncWorkflowQueueResponse) *admin.DescribeAsyncWorkflowQueueResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.DescribeAsyncWorkflowQueueResponse{
+		QueueID:    &t.QueueID,
+		Partitions: FromAdminAsyncWorkflowQueuePartitionArray(t.Partitions),
+	}
+}
+
+// ToAdminDescribeAsyncWorkflowQueueResponse converts thrift DescribeAsyncWorkflowQueueResponse type to internal
+func ToAdminDescribeAsyncWorkflowQueueResponse(t *admin.DescribeAsyncWorkflowQueueResponse) *types.DescribeAsyncWorkflowQueueResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeAsyncWorkflowQueueResponse{
+		QueueID:    t.GetQueueID(),
+		Partitions: ToAdminAsyncWorkflowQueuePartitionArray(t.Partitions),
+	}
+}
+
+// FromAdminAsyncWorkflowQueuePartitionArray converts internal AsyncWorkflowQueuePartition array to thrift
+func FromAdminAsyncWorkflowQueuePartitionArray(t []*types.AsyncWorkflowQueuePartition) []*admin.AsyncWorkflowQueuePartition {
+	if t == nil {
+		return nil
+	}
+	v := make([]*admin.AsyncWorkflowQueuePartition, len(t))
+	for i := range t {
+		v[i] = FromAdminAsyncWorkflowQueuePartition(t[i])
+	}
+	return v
+}
+
+// ToAdminAsyncWorkflowQueuePartitionArray converts thrift AsyncWorkflowQueuePartition array to internal
+func ToAdminAsyncWorkflowQueuePartitionArray(t []*admin.AsyncWorkflowQueuePartition) []*types.AsyncWorkflowQueuePartition {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.AsyncWorkflowQueuePartition, len(t))
+	for i := range t {
+		v[i] = ToAdminAsyncWorkflowQueuePartition(t[i])
+	}
+	return v
+}
+
+// FromAdminAsyncWorkflowQueuePartition converts internal AsyncWorkflowQueuePartition type to thrift
+func FromAdminAsyncWorkflowQueuePartition(t *types.AsyncWorkflowQueuePartition) *admin.AsyncWorkflowQueuePartition {
+	if t == nil {
+		return nil
+	}
+	return &admin.AsyncWorkflowQueuePartition{
+		Path:     &t.Path,
+		AckLevel: &t.AckLevel,
+	}
+}
+
+// ToAdminAsyncWorkflowQueuePartition converts thrift AsyncWorkflowQueuePartition type to internal
+func ToAdminAsyncWorkflowQueuePartition(t *admin.AsyncWorkflowQueuePartition) *types.AsyncWorkflowQueuePartition {
+	if t == nil {
+		return nil
+	}
+	return &types.AsyncWorkflowQueuePartition{
+		Path:     t.GetPath(),
+		AckLevel: t.GetAckLevel(),
+	}
+}
+
+// FromAdminUpdateTaskListVersioningConfigRequest converts internal UpdateTaskListVersioningConfigRequest type to thrift
+func FromAdminUpdateTaskListVersioningConfigRequest(t *types.UpdateTaskListVersioningConfigRequest) *admin.UpdateTaskListVersioningConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.UpdateTaskListVersioningConfigRequest{
+		Domain:               &t.Domain,
+		TaskList:             FromTaskList(t.TaskList),
+		AddNewDefaultBuildID: &t.AddNewDefaultBuildID,
+		AddCompatibleBuildID: FromAdminAddCompatibleBuildID(t.AddCompatibleBuildID),
+		PromoteBuildID:       &t.PromoteBuildID,
+	}
+}
+
+// ToAdminUpdateTaskListVersioningConfigRequest converts thrift UpdateTaskListVersioningConfigRequest type to internal
+func ToAdminUpdateTaskListVersioningConfigRequest(t *admin.UpdateTaskListVersioningConfigRequest) *types.UpdateTaskListVersioningConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateTaskListVersioningConfigRequest{
+		Domain:               t.GetDomain(),
+		TaskList:             ToTaskList(t.TaskList),
+		AddNewDefaultBuildID: t.GetAddNewDefaultBuildID(),
+		AddCompatibleBuildID: ToAdminAddCompatibleBuildID(t.AddCompatibleBuildID),
+		PromoteBuildID:       t.GetPromoteBuildID(),
+	}
+}
+
+// FromAdminUpdateTaskListVersioningConfigResponse converts internal UpdateTaskListVersioningConfigResponse type to thrift
+func FromAdminUpdateTaskListVersioningConfigResponse(t *types.UpdateTaskListVersioningConfigResponse) *admin.UpdateTaskListVersioningConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.UpdateTaskListVersioningConfigResponse{
+		VersioningConfig: FromAdminTaskListVersioningConfig(t.VersioningConfig),
+	}
+}
+
+// ToAdminUpdateTaskListVersioningConfigResponse converts thrift UpdateTaskListVersioningConfigResponse type to internal
+func ToAdminUpdateTaskListVersioningConfigResponse(t *admin.UpdateTaskListVersioningConfigResponse) *types.UpdateTaskListVersioningConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateTaskListVersioningConfigResponse{
+		VersioningConfig: ToAdminTaskListVersioningConfig(t.VersioningConfig),
+	}
+}
+
+// FromAdminAddCompatibleBuildID converts internal AddCompatibleBuildID type to thrift
+func FromAdminAddCompatibleBuildID(t *types.AddCompatibleBuildID) *admin.AddCompatibleBuildID {
+	if t == nil {
+		return nil
+	}
+	return &admin.AddCompatibleBuildID{
+		BuildID:                   &t.BuildID,
+		ExistingCompatibleBuildID: &t.ExistingCompatibleBuildID,
+		MakeDefault:               &t.MakeDefault,
+	}
+}
+
+// ToAdminAddCompatibleBuildID converts thrift AddCompatibleBuildID type to internal
+func ToAdminAddCompatibleBuildID(t *admin.AddCompatibleBuildID) *types.AddCompatibleBuildID {
+	if t == nil {
+		return nil
+	}
+	return &types.AddCompatibleBuildID{
+		BuildID:                   t.GetBuildID(),
+		ExistingCompatibleBuildID: t.GetExistingCompatibleBuildID(),
+		MakeDefault:               t.GetMakeDefault(),
+	}
+}
+
+// FromAdminTaskListVersioningConfig converts internal TaskListVersioningConfig type to thrift
+func FromAdminTaskListVersioningConfig(t *types.TaskListVersioningConfig) *admin.TaskListVersioningConfig {
+	if t == nil {
+		return nil
+	}
+	var sets []*admin.CompatibleBuildIDSet
+	if t.CompatibleSets != nil {
+		sets = make([]*admin.CompatibleBuildIDSet, len(t.CompatibleSets))
+		for i, set := range t.CompatibleSets {
+			if set != nil {
+				sets[i] = &admin.CompatibleBuildIDSet{BuildIDs: set.BuildIDs}
+			}
+		}
+	}
+	return &admin.TaskListVersioningConfig{
+		Version:        &t.Version,
+		CompatibleSets: sets,
+	}
+}
+
+// ToAdminTaskListVersioningConfig converts thrift TaskListVersioningConfig type to internal
+func ToAdminTaskListVersioningConfig(t *admin.TaskListVersioningConfig) *types.TaskListVersioningConfig {
+	if t == nil {
+		return nil
+	}
+	var sets []*types.CompatibleBuildIDSet
+	if t.CompatibleSets != nil {
+		sets = make([]*types.CompatibleBuildIDSet, len(t.CompatibleSets))
+		for i, set := range t.CompatibleSets {
+			if set != nil {
+				sets[i] = &types.CompatibleBuildIDSet{BuildIDs: set.BuildIDs}
+			}
+		}
+	}
+	return &types.TaskListVersioningConfig{
+		Version:        t.GetVersion(),
+		CompatibleSets: sets,
+	}
+}
+
+// FromAdminMigrateTaskListRequest converts internal MigrateTaskListRequest type to thrift
+func FromAdminMigrateTaskListRequest(t *types.MigrateTaskListRequest) *admin.MigrateTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.MigrateTaskListRequest{
+		Domain:         &t.Domain,
+		TaskList:       FromTaskList(t.TaskList),
+		TaskListType:   FromTaskListType(t.TaskListType),
+		TargetTaskList: FromTaskList(t.TargetTaskList),
+		ActivityAlias:  &t.Alias,
+		Stop:           &t.Stop,
+	}
+}
+
+// ToAdminMigrateTaskListRequest converts thrift MigrateTaskListRequest type to internal
+func ToAdminMigrateTaskListRequest(t *admin.MigrateTaskListRequest) *types.MigrateTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MigrateTaskListRequest{
+		Domain:         t.GetDomain(),
+		TaskList:       ToTaskList(t.TaskList),
+		TaskListType:   ToTaskListType(t.TaskListType),
+		TargetTaskList: ToTaskList(t.TargetTaskList),
+		Alias:          t.GetActivityAlias(),
+		Stop:           t.GetStop(),
+	}
+}
+
+// FromAdminMigrateTaskListResponse converts internal MigrateTaskListResponse type to thrift
+func FromAdminMigrateTaskListResponse(t *types.MigrateTaskListResponse) *admin.MigrateTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.MigrateTaskListResponse{
+		MigrationConfig: FromAdminTaskListMigrationConfig(t.MigrationConfig),
+		Partitions:      FromAdminTaskListMigrationPartitionStatusArray(t.Partitions),
+	}
+}
+
+// ToAdminMigrateTaskListResponse converts thrift MigrateTaskListResponse type to internal
+func ToAdminMigrateTaskListResponse(t *admin.MigrateTaskListResponse) *types.MigrateTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MigrateTaskListResponse{
+		MigrationConfig: ToAdminTaskListMigrationConfig(t.MigrationConfig),
+		Partitions:      ToAdminTaskListMigrationPartitionStatusArray(t.Partitions),
+	}
+}
+
+// FromAdminTaskListMigrationConfig converts internal TaskListMigrationConfig type to thrift
+func FromAdminTaskListMigrationConfig(t *types.TaskListMigrationConfig) *admin.TaskListMigrationConfig {
+	if t == nil {
+		return nil
+	}
+	return &admin.TaskListMigrationConfig{
+		TargetTaskList: &t.TargetTaskList,
+		ActivityAlias:  &t.Alias,
+	}
+}
+
+// ToAdminTaskListMigrationConfig converts thrift TaskListMigrationConfig type to internal
+func ToAdminTaskListMigrationConfig(t *admin.TaskListMigrationConfig) *types.TaskListMigrationConfig {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListMigrationConfig{
+		TargetTaskList: t.GetTargetTaskList(),
+		Alias:          t.GetActivityAlias(),
+	}
+}
+
+// FromAdminTaskListMigrationPartitionStatusArray converts internal TaskListMigrationPartitionStatus array to thrift
+func FromAdminTaskListMigrationPartitionStatusArray(t []*types.TaskListMigrationPartitionStatus) []*admin.TaskListMigrationPartitionStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*admin.TaskListMigrationPartitionStatus, len(t))
+	for i := range t {
+		v[i] = FromAdminTaskListMigrationPartitionStatus(t[i])
+	}
+	return v
+}
+
+// ToAdminTaskListMigrationPartitionStatusArray converts thrift TaskListMigrationPartitionStatus array to internal
+func ToAdminTaskListMigrationPartitionStatusArray(t []*admin.TaskListMigrationPartitionStatus) []*types.TaskListMigrationPartitionStatus {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.TaskListMigrationPartitionStatus, len(t))
+	for i := range t {
+		v[i] = ToAdminTaskListMigrationPartitionStatus(t[i])
+	}
+	return v
+}
+
+// FromAdminTaskListMigrationPartitionStatus converts internal TaskListMigrationPartitionStatus type to thrift
+func FromAdminTaskListMigrationPartitionStatus(t *types.TaskListMigrationPartitionStatus) *admin.TaskListMigrationPartitionStatus {
+	if t == nil {
+		return nil
+	}
+	return &admin.TaskListMigrationPartitionStatus{
+		Partition:        &t.Partition,
+		OwnerHostName:    &t.OwnerHostName,
+		BacklogCountHint: &t.BacklogCountHint,
+	}
+}
+
+// ToAdminTaskListMigrationPartitionStatus converts thrift TaskListMigrationPartitionStatus type to internal
+func ToAdminTaskListMigrationPartitionStatus(t *admin.TaskListMigrationPartitionStatus) *types.TaskListMigrationPartitionStatus {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListMigrationPartitionStatus{
+		Partition:        t.GetPartition(),
+		OwnerHostName:    t.GetOwnerHostName(),
+		BacklogCountHint: t.GetBacklogCountHint(),
+	}
+}
+
 func strPtr(s string) *string                                             { return &s }
 func igStatePtr(s shared.IsolationGroupState) *shared.IsolationGroupState { return &s }
+
+func weightPtr(w int32) *int32 {
+	if w == 0 {
+		return nil
+	}
+	return &w
+}
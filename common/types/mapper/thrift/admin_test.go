@@ -975,3 +975,87 @@ func TestToAdminUpdateDomainAsyncWorkflowConfiguratonResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestIsolationGroupConfigWeights(t *testing.T) {
+	cfg := &types.IsolationGroupConfiguration{
+		"zone 0": {
+			Name:  "zone 0",
+			State: types.IsolationGroupStateHealthy,
+		},
+		"zone 1": {
+			Name:   "zone 1",
+			State:  types.IsolationGroupStateWeighted,
+			Weight: 40,
+		},
+		"zone 2": {
+			Name:  "zone 2",
+			State: types.IsolationGroupStateWeighted,
+			Ramp: &types.IsolationGroupWeightRamp{
+				Weights:         []int32{75, 50, 25, 0},
+				StartTimestamp:  1700000000000000000,
+				IntervalSeconds: 300,
+			},
+		},
+	}
+	assert.Equal(t, cfg, ToIsolationGroupConfig(FromIsolationGroupConfig(cfg)))
+}
+
+func TestAdminReadAsyncWorkflowDLQMessagesRequest(t *testing.T) {
+	for _, item := range []*types.ReadAsyncWorkflowDLQMessagesRequest{nil, {}, &testdata.AdminReadAsyncWorkflowDLQMessagesRequest} {
+		assert.Equal(t, item, ToAdminReadAsyncWorkflowDLQMessagesRequest(FromAdminReadAsyncWorkflowDLQMessagesRequest(item)))
+	}
+}
+
+func TestAdminReadAsyncWorkflowDLQMessagesResponse(t *testing.T) {
+	for _, item := range []*types.ReadAsyncWorkflowDLQMessagesResponse{nil, {}, &testdata.AdminReadAsyncWorkflowDLQMessagesResponse} {
+		assert.Equal(t, item, ToAdminReadAsyncWorkflowDLQMessagesResponse(FromAdminReadAsyncWorkflowDLQMessagesResponse(item)))
+	}
+}
+
+func TestAdminPurgeAsyncWorkflowDLQMessagesRequest(t *testing.T) {
+	for _, item := range []*types.PurgeAsyncWorkflowDLQMessagesRequest{nil, {}, &testdata.AdminPurgeAsyncWorkflowDLQMessagesRequest} {
+		assert.Equal(t, item, ToAdminPurgeAsyncWorkflowDLQMessagesRequest(FromAdminPurgeAsyncWorkflowDLQMessagesRequest(item)))
+	}
+}
+
+func TestAdminReplayAsyncWorkflowDLQMessagesRequest(t *testing.T) {
+	for _, item := range []*types.ReplayAsyncWorkflowDLQMessagesRequest{nil, {}, &testdata.AdminReplayAsyncWorkflowDLQMessagesRequest} {
+		assert.Equal(t, item, ToAdminReplayAsyncWorkflowDLQMessagesRequest(FromAdminReplayAsyncWorkflowDLQMessagesRequest(item)))
+	}
+}
+
+func TestAdminDescribeAsyncWorkflowQueueRequest(t *testing.T) {
+	for _, item := range []*types.DescribeAsyncWorkflowQueueRequest{nil, {}, &testdata.AdminDescribeAsyncWorkflowQueueRequest} {
+		assert.Equal(t, item, ToAdminDescribeAsyncWorkflowQueueRequest(FromAdminDescribeAsyncWorkflowQueueRequest(item)))
+	}
+}
+
+func TestAdminDescribeAsyncWorkflowQueueResponse(t *testing.T) {
+	for _, item := range []*types.DescribeAsyncWorkflowQueueResponse{nil, {}, &testdata.AdminDescribeAsyncWorkflowQueueResponse} {
+		assert.Equal(t, item, ToAdminDescribeAsyncWorkflowQueueResponse(FromAdminDescribeAsyncWorkflowQueueResponse(item)))
+	}
+}
+
+func TestAdminUpdateTaskListVersioningConfigRequest(t *testing.T) {
+	for _, item := range []*types.UpdateTaskListVersioningConfigRequest{nil, {}, &testdata.AdminUpdateTaskListVersioningConfigRequest} {
+		assert.Equal(t, item, ToAdminUpdateTaskListVersioningConfigRequest(FromAdminUpdateTaskListVersioningConfigRequest(item)))
+	}
+}
+
+func TestAdminUpdateTaskListVersioningConfigResponse(t *testing.T) {
+	for _, item := range []*types.UpdateTaskListVersioningConfigResponse{nil, {}, &testdata.AdminUpdateTaskListVersioningConfigResponse} {
+		assert.Equal(t, item, ToAdminUpdateTaskListVersioningConfigResponse(FromAdminUpdateTaskListVersioningConfigResponse(item)))
+	}
+}
+
+func TestAdminMigrateTaskListRequest(t *testing.T) {
+	for _, item := range []*types.MigrateTaskListRequest{nil, {}, &testdata.AdminMigrateTaskListRequest} {
+		assert.Equal(t, item, ToAdminMigrateTaskListRequest(FromAdminMigrateTaskListRequest(item)))
+	}
+}
+
+func TestAdminMigrateTaskListResponse(t *testing.T) {
+	for _, item := range []*types.MigrateTaskListResponse{nil, {}, &testdata.AdminMigrateTaskListResponse} {
+		assert.Equal(t, item, ToAdminMigrateTaskListResponse(FromAdminMigrateTaskListResponse(item)))
+	}
+}
@@ -564,12 +564,13 @@ func FromRecordActivityTaskStartedRequest(t *types.RecordActivityTaskStartedRequ
 		return nil
 	}
 	return &history.RecordActivityTaskStartedRequest{
-		DomainUUID:        &t.DomainUUID,
-		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
-		ScheduleId:        &t.ScheduleID,
-		TaskId:            &t.TaskID,
-		RequestId:         &t.RequestID,
-		PollRequest:       FromPollForActivityTaskRequest(t.PollRequest),
+		DomainUUID:               &t.DomainUUID,
+		WorkflowExecution:        FromWorkflowExecution(t.WorkflowExecution),
+		ScheduleId:               &t.ScheduleID,
+		TaskId:                   &t.TaskID,
+		RequestId:                &t.RequestID,
+		PollRequest:              FromPollForActivityTaskRequest(t.PollRequest),
+		ScheduleToStartBreakdown: FromScheduleToStartBreakdown(t.ScheduleToStartBreakdown),
 	}
 }
 
@@ -579,12 +580,13 @@ func ToRecordActivityTaskStartedRequest(t *history.RecordActivityTaskStartedRequ
 		return nil
 	}
 	return &types.RecordActivityTaskStartedRequest{
-		DomainUUID:        t.GetDomainUUID(),
-		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
-		ScheduleID:        t.GetScheduleId(),
-		TaskID:            t.GetTaskId(),
-		RequestID:         t.GetRequestId(),
-		PollRequest:       ToPollForActivityTaskRequest(t.PollRequest),
+		DomainUUID:               t.GetDomainUUID(),
+		WorkflowExecution:        ToWorkflowExecution(t.WorkflowExecution),
+		ScheduleID:               t.GetScheduleId(),
+		TaskID:                   t.GetTaskId(),
+		RequestID:                t.GetRequestId(),
+		PollRequest:              ToPollForActivityTaskRequest(t.PollRequest),
+		ScheduleToStartBreakdown: ToScheduleToStartBreakdown(t.ScheduleToStartBreakdown),
 	}
 }
 
@@ -650,6 +652,82 @@ func ToRecordChildExecutionCompletedRequest(t *history.RecordChildExecutionCompl
 	}
 }
 
+// FromHistoryGetCompletionCallbacksRequest converts internal GetCompletionCallbacksRequest type to thrift
+func FromHistoryGetCompletionCallbacksRequest(t *types.GetCompletionCallbacksRequest) *history.GetCompletionCallbacksRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.GetCompletionCallbacksRequest{
+		DomainUUID:        &t.DomainUUID,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+// ToHistoryGetCompletionCallbacksRequest converts thrift GetCompletionCallbacksRequest type to internal
+func ToHistoryGetCompletionCallbacksRequest(t *history.GetCompletionCallbacksRequest) *types.GetCompletionCallbacksRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetCompletionCallbacksRequest{
+		DomainUUID:        t.GetDomainUUID(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+// FromHistoryGetCompletionCallbacksResponse converts internal GetCompletionCallbacksResponse type to thrift
+func FromHistoryGetCompletionCallbacksResponse(t *types.GetCompletionCallbacksResponse) *history.GetCompletionCallbacksResponse {
+	if t == nil {
+		return nil
+	}
+	return &history.GetCompletionCallbacksResponse{
+		Callbacks:     FromCompletionCallbackArray(t.Callbacks),
+		WorkflowType:  FromWorkflowType(t.WorkflowType),
+		CloseStatus:   FromWorkflowExecutionCloseStatus(t.CloseStatus),
+		CloseTime:     t.CloseTime,
+		HistoryLength: &t.HistoryLength,
+	}
+}
+
+// ToHistoryGetCompletionCallbacksResponse converts thrift GetCompletionCallbacksResponse type to internal
+func ToHistoryGetCompletionCallbacksResponse(t *history.GetCompletionCallbacksResponse) *types.GetCompletionCallbacksResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetCompletionCallbacksResponse{
+		Callbacks:     ToCompletionCallbackArray(t.Callbacks),
+		WorkflowType:  ToWorkflowType(t.WorkflowType),
+		CloseStatus:   ToWorkflowExecutionCloseStatus(t.CloseStatus),
+		CloseTime:     t.CloseTime,
+		HistoryLength: t.GetHistoryLength(),
+	}
+}
+
+// FromHistoryRecordCompletionCallbackStateRequest converts internal RecordCompletionCallbackStateRequest type to thrift
+func FromHistoryRecordCompletionCallbackStateRequest(t *types.RecordCompletionCallbackStateRequest) *history.RecordCompletionCallbackStateRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.RecordCompletionCallbackStateRequest{
+		DomainUUID:        &t.DomainUUID,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Index:             &t.Index,
+		State:             FromCompletionCallbackInfo(t.State),
+	}
+}
+
+// ToHistoryRecordCompletionCallbackStateRequest converts thrift RecordCompletionCallbackStateRequest type to internal
+func ToHistoryRecordCompletionCallbackStateRequest(t *history.RecordCompletionCallbackStateRequest) *types.RecordCompletionCallbackStateRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RecordCompletionCallbackStateRequest{
+		DomainUUID:        t.GetDomainUUID(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Index:             t.GetIndex(),
+		State:             ToCompletionCallbackInfo(t.State),
+	}
+}
+
 // FromRecordDecisionTaskStartedRequest converts internal RecordDecisionTaskStartedRequest type to thrift
 func FromRecordDecisionTaskStartedRequest(t *types.RecordDecisionTaskStartedRequest) *history.RecordDecisionTaskStartedRequest {
 	if t == nil {
@@ -1118,6 +1196,28 @@ func ToHistorySignalWorkflowExecutionRequest(t *history.SignalWorkflowExecutionR
 	}
 }
 
+// FromHistoryUpdateWorkflowMemoRequest converts internal UpdateWorkflowMemoRequest type to thrift
+func FromHistoryUpdateWorkflowMemoRequest(t *types.HistoryUpdateWorkflowMemoRequest) *history.UpdateWorkflowMemoRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.UpdateWorkflowMemoRequest{
+		DomainUUID:    &t.DomainUUID,
+		UpdateRequest: FromUpdateWorkflowMemoRequest(t.UpdateRequest),
+	}
+}
+
+// ToHistoryUpdateWorkflowMemoRequest converts thrift UpdateWorkflowMemoRequest type to internal
+func ToHistoryUpdateWorkflowMemoRequest(t *history.UpdateWorkflowMemoRequest) *types.HistoryUpdateWorkflowMemoRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryUpdateWorkflowMemoRequest{
+		DomainUUID:    t.GetDomainUUID(),
+		UpdateRequest: ToUpdateWorkflowMemoRequest(t.UpdateRequest),
+	}
+}
+
 // FromHistoryStartWorkflowExecutionRequest converts internal StartWorkflowExecutionRequest type to thrift
 func FromHistoryStartWorkflowExecutionRequest(t *types.HistoryStartWorkflowExecutionRequest) *history.StartWorkflowExecutionRequest {
 	if t == nil {
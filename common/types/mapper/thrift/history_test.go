@@ -602,6 +602,62 @@ func TestHistorySignalWithStartWorkflowExecutionRequestConversion(t *testing.T)
 	}
 }
 
+func TestHistoryUpdateWorkflowMemoRequestConversion(t *testing.T) {
+	testCases := []*types.HistoryUpdateWorkflowMemoRequest{
+		nil,
+		{},
+		&testdata.HistoryUpdateWorkflowMemoRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryUpdateWorkflowMemoRequest(original)
+		roundTripObj := ToHistoryUpdateWorkflowMemoRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestHistoryGetCompletionCallbacksRequestConversion(t *testing.T) {
+	testCases := []*types.GetCompletionCallbacksRequest{
+		nil,
+		{},
+		&testdata.HistoryGetCompletionCallbacksRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryGetCompletionCallbacksRequest(original)
+		roundTripObj := ToHistoryGetCompletionCallbacksRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestHistoryGetCompletionCallbacksResponseConversion(t *testing.T) {
+	testCases := []*types.GetCompletionCallbacksResponse{
+		nil,
+		{},
+		&testdata.HistoryGetCompletionCallbacksResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryGetCompletionCallbacksResponse(original)
+		roundTripObj := ToHistoryGetCompletionCallbacksResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestHistoryRecordCompletionCallbackStateRequestConversion(t *testing.T) {
+	testCases := []*types.RecordCompletionCallbackStateRequest{
+		nil,
+		{},
+		&testdata.HistoryRecordCompletionCallbackStateRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromHistoryRecordCompletionCallbackStateRequest(original)
+		roundTripObj := ToHistoryRecordCompletionCallbackStateRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestHistorySignalWorkflowExecutionRequestConversion(t *testing.T) {
 	testCases := []*types.HistorySignalWorkflowExecutionRequest{
 		nil,
@@ -238,12 +238,13 @@ func FromActivityTaskStartedEventAttributes(t *types.ActivityTaskStartedEventAtt
 		return nil
 	}
 	return &shared.ActivityTaskStartedEventAttributes{
-		ScheduledEventId:   &t.ScheduledEventID,
-		Identity:           &t.Identity,
-		RequestId:          &t.RequestID,
-		Attempt:            &t.Attempt,
-		LastFailureReason:  t.LastFailureReason,
-		LastFailureDetails: t.LastFailureDetails,
+		ScheduledEventId:         &t.ScheduledEventID,
+		Identity:                 &t.Identity,
+		RequestId:                &t.RequestID,
+		Attempt:                  &t.Attempt,
+		LastFailureReason:        t.LastFailureReason,
+		LastFailureDetails:       t.LastFailureDetails,
+		ScheduleToStartBreakdown: FromScheduleToStartBreakdown(t.ScheduleToStartBreakdown),
 	}
 }
 
@@ -253,12 +254,13 @@ func ToActivityTaskStartedEventAttributes(t *shared.ActivityTaskStartedEventAttr
 		return nil
 	}
 	return &types.ActivityTaskStartedEventAttributes{
-		ScheduledEventID:   t.GetScheduledEventId(),
-		Identity:           t.GetIdentity(),
-		RequestID:          t.GetRequestId(),
-		Attempt:            t.GetAttempt(),
-		LastFailureReason:  t.LastFailureReason,
-		LastFailureDetails: t.LastFailureDetails,
+		ScheduledEventID:         t.GetScheduledEventId(),
+		Identity:                 t.GetIdentity(),
+		RequestID:                t.GetRequestId(),
+		Attempt:                  t.GetAttempt(),
+		LastFailureReason:        t.LastFailureReason,
+		LastFailureDetails:       t.LastFailureDetails,
+		ScheduleToStartBreakdown: ToScheduleToStartBreakdown(t.ScheduleToStartBreakdown),
 	}
 }
 
@@ -344,6 +346,50 @@ func ToArchivalStatus(t *shared.ArchivalStatus) *types.ArchivalStatus {
 	panic("unexpected enum value")
 }
 
+// FromAsyncRequestState converts internal AsyncRequestState type to thrift
+func FromAsyncRequestState(t *types.AsyncRequestState) *shared.AsyncRequestState {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.AsyncRequestStateQueued:
+		v := shared.AsyncRequestStateQueued
+		return &v
+	case types.AsyncRequestStateProcessing:
+		v := shared.AsyncRequestStateProcessing
+		return &v
+	case types.AsyncRequestStateSucceeded:
+		v := shared.AsyncRequestStateSucceeded
+		return &v
+	case types.AsyncRequestStateFailed:
+		v := shared.AsyncRequestStateFailed
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// ToAsyncRequestState converts thrift AsyncRequestState type to internal
+func ToAsyncRequestState(t *shared.AsyncRequestState) *types.AsyncRequestState {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case shared.AsyncRequestStateQueued:
+		v := types.AsyncRequestStateQueued
+		return &v
+	case shared.AsyncRequestStateProcessing:
+		v := types.AsyncRequestStateProcessing
+		return &v
+	case shared.AsyncRequestStateSucceeded:
+		v := types.AsyncRequestStateSucceeded
+		return &v
+	case shared.AsyncRequestStateFailed:
+		v := types.AsyncRequestStateFailed
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
 // FromBadBinaries converts internal BadBinaries type to thrift
 func FromBadBinaries(t *types.BadBinaries) *shared.BadBinaries {
 	if t == nil {
@@ -1052,6 +1098,7 @@ func FromDecision(t *types.Decision) *shared.Decision {
 		StartChildWorkflowExecutionDecisionAttributes:            FromStartChildWorkflowExecutionDecisionAttributes(t.StartChildWorkflowExecutionDecisionAttributes),
 		SignalExternalWorkflowExecutionDecisionAttributes:        FromSignalExternalWorkflowExecutionDecisionAttributes(t.SignalExternalWorkflowExecutionDecisionAttributes),
 		UpsertWorkflowSearchAttributesDecisionAttributes:         FromUpsertWorkflowSearchAttributesDecisionAttributes(t.UpsertWorkflowSearchAttributesDecisionAttributes),
+		UpsertWorkflowMemoDecisionAttributes:                     FromUpsertWorkflowMemoDecisionAttributes(t.UpsertWorkflowMemoDecisionAttributes),
 	}
 }
 
@@ -1075,6 +1122,7 @@ func ToDecision(t *shared.Decision) *types.Decision {
 		StartChildWorkflowExecutionDecisionAttributes:            ToStartChildWorkflowExecutionDecisionAttributes(t.StartChildWorkflowExecutionDecisionAttributes),
 		SignalExternalWorkflowExecutionDecisionAttributes:        ToSignalExternalWorkflowExecutionDecisionAttributes(t.SignalExternalWorkflowExecutionDecisionAttributes),
 		UpsertWorkflowSearchAttributesDecisionAttributes:         ToUpsertWorkflowSearchAttributesDecisionAttributes(t.UpsertWorkflowSearchAttributesDecisionAttributes),
+		UpsertWorkflowMemoDecisionAttributes:                     ToUpsertWorkflowMemoDecisionAttributes(t.UpsertWorkflowMemoDecisionAttributes),
 	}
 }
 
@@ -1181,6 +1229,9 @@ func FromDecisionTaskFailedCause(t *types.DecisionTaskFailedCause) *shared.Decis
 	case types.DecisionTaskFailedCauseBadSearchAttributes:
 		v := shared.DecisionTaskFailedCauseBadSearchAttributes
 		return &v
+	case types.DecisionTaskFailedCauseBadMemo:
+		v := shared.DecisionTaskFailedCauseBadMemo
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -1260,6 +1311,9 @@ func ToDecisionTaskFailedCause(t *shared.DecisionTaskFailedCause) *types.Decisio
 	case shared.DecisionTaskFailedCauseBadSearchAttributes:
 		v := types.DecisionTaskFailedCauseBadSearchAttributes
 		return &v
+	case shared.DecisionTaskFailedCauseBadMemo:
+		v := types.DecisionTaskFailedCauseBadMemo
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -1465,6 +1519,9 @@ func FromDecisionType(t *types.DecisionType) *shared.DecisionType {
 	case types.DecisionTypeUpsertWorkflowSearchAttributes:
 		v := shared.DecisionTypeUpsertWorkflowSearchAttributes
 		return &v
+	case types.DecisionTypeUpsertWorkflowMemo:
+		v := shared.DecisionTypeUpsertWorkflowMemo
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -1514,6 +1571,9 @@ func ToDecisionType(t *shared.DecisionType) *types.DecisionType {
 	case shared.DecisionTypeUpsertWorkflowSearchAttributes:
 		v := types.DecisionTypeUpsertWorkflowSearchAttributes
 		return &v
+	case shared.DecisionTypeUpsertWorkflowMemo:
+		v := types.DecisionTypeUpsertWorkflowMemo
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -1562,6 +1622,58 @@ func ToDeprecateDomainRequest(t *shared.DeprecateDomainRequest) *types.Deprecate
 	}
 }
 
+// FromDescribeAsyncRequestRequest converts internal DescribeAsyncRequestRequest type to thrift
+func FromDescribeAsyncRequestRequest(t *types.DescribeAsyncRequestRequest) *shared.DescribeAsyncRequestRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeAsyncRequestRequest{
+		Domain:    &t.Domain,
+		RequestID: &t.RequestID,
+	}
+}
+
+// ToDescribeAsyncRequestRequest converts thrift DescribeAsyncRequestRequest type to internal
+func ToDescribeAsyncRequestRequest(t *shared.DescribeAsyncRequestRequest) *types.DescribeAsyncRequestRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeAsyncRequestRequest{
+		Domain:    t.GetDomain(),
+		RequestID: t.GetRequestID(),
+	}
+}
+
+// FromDescribeAsyncRequestResponse converts internal DescribeAsyncRequestResponse type to thrift
+func FromDescribeAsyncRequestResponse(t *types.DescribeAsyncRequestResponse) *shared.DescribeAsyncRequestResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeAsyncRequestResponse{
+		RequestID:           &t.RequestID,
+		State:               FromAsyncRequestState(t.State),
+		WorkflowExecution:   FromWorkflowExecution(t.WorkflowExecution),
+		Failure:             &t.Failure,
+		CreatedTimeNano:     t.CreatedTimeNano,
+		LastUpdatedTimeNano: t.LastUpdatedTimeNano,
+	}
+}
+
+// ToDescribeAsyncRequestResponse converts thrift DescribeAsyncRequestResponse type to internal
+func ToDescribeAsyncRequestResponse(t *shared.DescribeAsyncRequestResponse) *types.DescribeAsyncRequestResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeAsyncRequestResponse{
+		RequestID:           t.GetRequestID(),
+		State:               ToAsyncRequestState(t.State),
+		WorkflowExecution:   ToWorkflowExecution(t.WorkflowExecution),
+		Failure:             t.GetFailure(),
+		CreatedTimeNano:     t.CreatedTimeNano,
+		LastUpdatedTimeNano: t.LastUpdatedTimeNano,
+	}
+}
+
 // FromDescribeDomainRequest converts internal DescribeDomainRequest type to thrift
 func FromDescribeDomainRequest(t *types.DescribeDomainRequest) *shared.DescribeDomainRequest {
 	if t == nil {
@@ -1596,6 +1708,7 @@ func FromDescribeDomainResponse(t *types.DescribeDomainResponse) *shared.Describ
 		FailoverVersion:          &t.FailoverVersion,
 		IsGlobalDomain:           &t.IsGlobalDomain,
 		FailoverInfo:             FromFailoverInfo(t.GetFailoverInfo()),
+		TaskQuota:                FromDomainTaskQuota(t.TaskQuota),
 	}
 }
 
@@ -1611,6 +1724,7 @@ func ToDescribeDomainResponse(t *shared.DescribeDomainResponse) *types.DescribeD
 		FailoverVersion:          t.GetFailoverVersion(),
 		IsGlobalDomain:           t.GetIsGlobalDomain(),
 		FailoverInfo:             ToFailoverInfo(t.FailoverInfo),
+		TaskQuota:                ToDomainTaskQuota(t.TaskQuota),
 	}
 }
 
@@ -1804,6 +1918,84 @@ func ToDescribeTaskListResponse(t *shared.DescribeTaskListResponse) *types.Descr
 	}
 }
 
+// FromGetTaskListScalingRecommendationRequest converts internal GetTaskListScalingRecommendationRequest type to thrift
+func FromGetTaskListScalingRecommendationRequest(t *types.GetTaskListScalingRecommendationRequest) *shared.GetTaskListScalingRecommendationRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetTaskListScalingRecommendationRequest{
+		Domain:       &t.Domain,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+// ToGetTaskListScalingRecommendationRequest converts thrift GetTaskListScalingRecommendationRequest type to internal
+func ToGetTaskListScalingRecommendationRequest(t *shared.GetTaskListScalingRecommendationRequest) *types.GetTaskListScalingRecommendationRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetTaskListScalingRecommendationRequest{
+		Domain:       t.GetDomain(),
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+// FromGetTaskListScalingRecommendationResponse converts internal GetTaskListScalingRecommendationResponse type to thrift
+func FromGetTaskListScalingRecommendationResponse(t *types.GetTaskListScalingRecommendationResponse) *shared.GetTaskListScalingRecommendationResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetTaskListScalingRecommendationResponse{
+		RecommendedPollerCount: &t.RecommendedPollerCount,
+		Confidence:             FromTaskListScalingConfidence(t.Confidence),
+		Signals:                FromTaskListScalingSignals(t.Signals),
+	}
+}
+
+// ToGetTaskListScalingRecommendationResponse converts thrift GetTaskListScalingRecommendationResponse type to internal
+func ToGetTaskListScalingRecommendationResponse(t *shared.GetTaskListScalingRecommendationResponse) *types.GetTaskListScalingRecommendationResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetTaskListScalingRecommendationResponse{
+		RecommendedPollerCount: t.GetRecommendedPollerCount(),
+		Confidence:             ToTaskListScalingConfidence(t.Confidence),
+		Signals:                ToTaskListScalingSignals(t.Signals),
+	}
+}
+
+// FromTaskListScalingSignals converts internal TaskListScalingSignals type to thrift
+func FromTaskListScalingSignals(t *types.TaskListScalingSignals) *shared.TaskListScalingSignals {
+	if t == nil {
+		return nil
+	}
+	return &shared.TaskListScalingSignals{
+		BacklogCountHint:           &t.BacklogCountHint,
+		AddTasksPerSecond:          &t.AddTasksPerSecond,
+		DispatchedTasksPerSecond:   &t.DispatchedTasksPerSecond,
+		SyncMatchRatio:             &t.SyncMatchRatio,
+		ScheduleToStartLatencyInMs: &t.ScheduleToStartLatencyInMs,
+		PollerCount:                &t.PollerCount,
+	}
+}
+
+// ToTaskListScalingSignals converts thrift TaskListScalingSignals type to internal
+func ToTaskListScalingSignals(t *shared.TaskListScalingSignals) *types.TaskListScalingSignals {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListScalingSignals{
+		BacklogCountHint:           t.GetBacklogCountHint(),
+		AddTasksPerSecond:          t.GetAddTasksPerSecond(),
+		DispatchedTasksPerSecond:   t.GetDispatchedTasksPerSecond(),
+		SyncMatchRatio:             t.GetSyncMatchRatio(),
+		ScheduleToStartLatencyInMs: t.GetScheduleToStartLatencyInMs(),
+		PollerCount:                t.GetPollerCount(),
+	}
+}
+
 // FromDescribeWorkflowExecutionRequest converts internal DescribeWorkflowExecutionRequest type to thrift
 func FromDescribeWorkflowExecutionRequest(t *types.DescribeWorkflowExecutionRequest) *shared.DescribeWorkflowExecutionRequest {
 	if t == nil {
@@ -1837,6 +2029,8 @@ func FromDescribeWorkflowExecutionResponse(t *types.DescribeWorkflowExecutionRes
 		PendingActivities:      FromPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        FromPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        FromPendingDecisionInfo(t.PendingDecision),
+		StickyExecutionInfo:    FromStickyExecutionInfo(t.StickyExecutionInfo),
+		CompletionCallbacks:    FromCompletionCallbackInfoArray(t.CompletionCallbacks),
 	}
 }
 
@@ -1851,6 +2045,8 @@ func ToDescribeWorkflowExecutionResponse(t *shared.DescribeWorkflowExecutionResp
 		PendingActivities:      ToPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        ToPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        ToPendingDecisionInfo(t.PendingDecision),
+		StickyExecutionInfo:    ToStickyExecutionInfo(t.StickyExecutionInfo),
+		CompletionCallbacks:    ToCompletionCallbackInfoArray(t.CompletionCallbacks),
 	}
 }
 
@@ -2036,6 +2232,36 @@ func ToFailoverInfo(t *shared.FailoverInfo) *types.FailoverInfo {
 	}
 }
 
+// FromDomainTaskQuota converts internal DomainTaskQuota type to thrift
+func FromDomainTaskQuota(t *types.DomainTaskQuota) *shared.DomainTaskQuota {
+	if t == nil {
+		return nil
+	}
+	return &shared.DomainTaskQuota{
+		AddTaskRPSLimit:     &t.AddTaskRPSLimit,
+		AddTaskRPS:          &t.AddTaskRPS,
+		AddTaskRejectedRPS:  &t.AddTaskRejectedRPS,
+		DispatchRPSLimit:    &t.DispatchRPSLimit,
+		DispatchRPS:         &t.DispatchRPS,
+		DispatchRejectedRPS: &t.DispatchRejectedRPS,
+	}
+}
+
+// ToDomainTaskQuota converts thrift DomainTaskQuota type to internal
+func ToDomainTaskQuota(t *shared.DomainTaskQuota) *types.DomainTaskQuota {
+	if t == nil {
+		return nil
+	}
+	return &types.DomainTaskQuota{
+		AddTaskRPSLimit:     t.GetAddTaskRPSLimit(),
+		AddTaskRPS:          t.GetAddTaskRPS(),
+		AddTaskRejectedRPS:  t.GetAddTaskRejectedRPS(),
+		DispatchRPSLimit:    t.GetDispatchRPSLimit(),
+		DispatchRPS:         t.GetDispatchRPS(),
+		DispatchRejectedRPS: t.GetDispatchRejectedRPS(),
+	}
+}
+
 // FromDomainNotActiveError converts internal DomainNotActiveError type to thrift
 func FromDomainNotActiveError(t *types.DomainNotActiveError) *shared.DomainNotActiveError {
 	if t == nil {
@@ -2371,6 +2597,12 @@ func FromEventType(t *types.EventType) *shared.EventType {
 	case types.EventTypeUpsertWorkflowSearchAttributes:
 		v := shared.EventTypeUpsertWorkflowSearchAttributes
 		return &v
+	case types.EventTypeUpsertWorkflowMemo:
+		v := shared.EventTypeUpsertWorkflowMemo
+		return &v
+	case types.EventTypeWorkflowExecutionMemoUpdated:
+		v := shared.EventTypeWorkflowExecutionMemoUpdated
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -2507,6 +2739,12 @@ func ToEventType(t *shared.EventType) *types.EventType {
 	case shared.EventTypeUpsertWorkflowSearchAttributes:
 		v := types.EventTypeUpsertWorkflowSearchAttributes
 		return &v
+	case shared.EventTypeUpsertWorkflowMemo:
+		v := types.EventTypeUpsertWorkflowMemo
+		return &v
+	case shared.EventTypeWorkflowExecutionMemoUpdated:
+		v := types.EventTypeWorkflowExecutionMemoUpdated
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -2802,6 +3040,8 @@ func FromHistoryEvent(t *types.HistoryEvent) *shared.HistoryEvent {
 		SignalExternalWorkflowExecutionFailedEventAttributes:           FromSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
 		ExternalWorkflowExecutionSignaledEventAttributes:               FromExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
 		UpsertWorkflowSearchAttributesEventAttributes:                  FromUpsertWorkflowSearchAttributesEventAttributes(t.UpsertWorkflowSearchAttributesEventAttributes),
+		UpsertWorkflowMemoEventAttributes:                              FromUpsertWorkflowMemoEventAttributes(t.UpsertWorkflowMemoEventAttributes),
+		WorkflowExecutionMemoUpdatedEventAttributes:                    FromWorkflowExecutionMemoUpdatedEventAttributes(t.WorkflowExecutionMemoUpdatedEventAttributes),
 	}
 }
 
@@ -2858,6 +3098,8 @@ func ToHistoryEvent(t *shared.HistoryEvent) *types.HistoryEvent {
 		SignalExternalWorkflowExecutionFailedEventAttributes:           ToSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
 		ExternalWorkflowExecutionSignaledEventAttributes:               ToExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
 		UpsertWorkflowSearchAttributesEventAttributes:                  ToUpsertWorkflowSearchAttributesEventAttributes(t.UpsertWorkflowSearchAttributesEventAttributes),
+		UpsertWorkflowMemoEventAttributes:                              ToUpsertWorkflowMemoEventAttributes(t.UpsertWorkflowMemoEventAttributes),
+		WorkflowExecutionMemoUpdatedEventAttributes:                    ToWorkflowExecutionMemoUpdatedEventAttributes(t.WorkflowExecutionMemoUpdatedEventAttributes),
 	}
 }
 
@@ -3566,6 +3808,36 @@ func FromPendingDecisionInfo(t *types.PendingDecisionInfo) *shared.PendingDecisi
 	}
 }
 
+// FromStickyExecutionInfo converts internal StickyExecutionInfo type to thrift
+func FromStickyExecutionInfo(t *types.StickyExecutionInfo) *shared.StickyExecutionInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.StickyExecutionInfo{
+		StickyTaskList:                FromTaskList(t.StickyTaskList),
+		StickyHost:                    &t.StickyHost,
+		ScheduleToStartTimeoutSeconds: &t.ScheduleToStartTimeoutSeconds,
+		HitCount:                      &t.HitCount,
+		FallbackCount:                 &t.FallbackCount,
+		HitRate:                       &t.HitRate,
+	}
+}
+
+// ToStickyExecutionInfo converts thrift StickyExecutionInfo type to internal
+func ToStickyExecutionInfo(t *shared.StickyExecutionInfo) *types.StickyExecutionInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.StickyExecutionInfo{
+		StickyTaskList:                ToTaskList(t.StickyTaskList),
+		StickyHost:                    t.GetStickyHost(),
+		ScheduleToStartTimeoutSeconds: t.GetScheduleToStartTimeoutSeconds(),
+		HitCount:                      t.GetHitCount(),
+		FallbackCount:                 t.GetFallbackCount(),
+		HitRate:                       t.GetHitRate(),
+	}
+}
+
 // ToPendingDecisionInfo converts thrift PendingDecisionInfo type to internal
 func ToPendingDecisionInfo(t *shared.PendingDecisionInfo) *types.PendingDecisionInfo {
 	if t == nil {
@@ -4523,6 +4795,50 @@ func ToResetStickyTaskListResponse(t *shared.ResetStickyTaskListResponse) *types
 	return &types.ResetStickyTaskListResponse{}
 }
 
+// FromUpdateWorkflowMemoRequest converts internal UpdateWorkflowMemoRequest type to thrift
+func FromUpdateWorkflowMemoRequest(t *types.UpdateWorkflowMemoRequest) *shared.UpdateWorkflowMemoRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateWorkflowMemoRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Memo:              FromMemo(t.Memo),
+		Identity:          &t.Identity,
+		RequestId:         &t.RequestID,
+	}
+}
+
+// ToUpdateWorkflowMemoRequest converts thrift UpdateWorkflowMemoRequest type to internal
+func ToUpdateWorkflowMemoRequest(t *shared.UpdateWorkflowMemoRequest) *types.UpdateWorkflowMemoRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowMemoRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Memo:              ToMemo(t.Memo),
+		Identity:          t.GetIdentity(),
+		RequestID:         t.GetRequestId(),
+	}
+}
+
+// FromUpdateWorkflowMemoResponse converts internal UpdateWorkflowMemoResponse type to thrift
+func FromUpdateWorkflowMemoResponse(t *types.UpdateWorkflowMemoResponse) *shared.UpdateWorkflowMemoResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpdateWorkflowMemoResponse{}
+}
+
+// ToUpdateWorkflowMemoResponse converts thrift UpdateWorkflowMemoResponse type to internal
+func ToUpdateWorkflowMemoResponse(t *shared.UpdateWorkflowMemoResponse) *types.UpdateWorkflowMemoResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateWorkflowMemoResponse{}
+}
+
 // FromResetWorkflowExecutionRequest converts internal ResetWorkflowExecutionRequest type to thrift
 func FromResetWorkflowExecutionRequest(t *types.ResetWorkflowExecutionRequest) *shared.ResetWorkflowExecutionRequest {
 	if t == nil {
@@ -4754,6 +5070,7 @@ func FromRespondDecisionTaskCompletedRequest(t *types.RespondDecisionTaskComplet
 		ForceCreateNewDecisionTask: &t.ForceCreateNewDecisionTask,
 		BinaryChecksum:             &t.BinaryChecksum,
 		QueryResults:               FromWorkflowQueryResultMap(t.QueryResults),
+		EagerActivitySlots:         t.EagerActivitySlots,
 	}
 }
 
@@ -4772,6 +5089,7 @@ func ToRespondDecisionTaskCompletedRequest(t *shared.RespondDecisionTaskComplete
 		ForceCreateNewDecisionTask: t.GetForceCreateNewDecisionTask(),
 		BinaryChecksum:             t.GetBinaryChecksum(),
 		QueryResults:               ToWorkflowQueryResultMap(t.QueryResults),
+		EagerActivitySlots:         t.EagerActivitySlots,
 	}
 }
 
@@ -5176,6 +5494,7 @@ func FromSignalWithStartWorkflowExecutionRequest(t *types.SignalWithStartWorkflo
 		FirstRunAtTimestamp:                 t.FirstRunAtTimestamp,
 		CronOverlapPolicy:                   FromCronOverlapPolicy(t.CronOverlapPolicy),
 		ActiveClusterSelectionPolicy:        FromActiveClusterSelectionPolicy(t.ActiveClusterSelectionPolicy),
+		CompletionCallbacks:                 FromCompletionCallbackArray(t.CompletionCallbacks),
 	}
 }
 
@@ -5206,6 +5525,7 @@ func ToSignalWithStartWorkflowExecutionRequest(t *shared.SignalWithStartWorkflow
 		FirstRunAtTimestamp:                 t.FirstRunAtTimestamp,
 		CronOverlapPolicy:                   ToCronOverlapPolicy(t.CronOverlapPolicy),
 		ActiveClusterSelectionPolicy:        ToActiveClusterSelectionPolicy(t.ActiveClusterSelectionPolicy),
+		CompletionCallbacks:                 ToCompletionCallbackArray(t.CompletionCallbacks),
 	}
 }
 
@@ -5451,14 +5771,126 @@ func FromSignalWithStartWorkflowExecutionAsyncResponse(t *types.SignalWithStartW
 	if t == nil {
 		return nil
 	}
-	return &shared.SignalWithStartWorkflowExecutionAsyncResponse{}
+	return &shared.SignalWithStartWorkflowExecutionAsyncResponse{
+		RequestID: &t.RequestID,
+	}
 }
 
 func ToSignalWithStartWorkflowExecutionAsyncResponse(t *shared.SignalWithStartWorkflowExecutionAsyncResponse) *types.SignalWithStartWorkflowExecutionAsyncResponse {
 	if t == nil {
 		return nil
 	}
-	return &types.SignalWithStartWorkflowExecutionAsyncResponse{}
+	return &types.SignalWithStartWorkflowExecutionAsyncResponse{
+		RequestID: t.GetRequestID(),
+	}
+}
+
+func FromSignalWorkflowExecutionAsyncRequest(t *types.SignalWorkflowExecutionAsyncRequest) *shared.SignalWorkflowExecutionAsyncRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.SignalWorkflowExecutionAsyncRequest{
+		Request: FromSignalWorkflowExecutionRequest(t.SignalWorkflowExecutionRequest),
+	}
+}
+
+func ToSignalWorkflowExecutionAsyncRequest(t *shared.SignalWorkflowExecutionAsyncRequest) *types.SignalWorkflowExecutionAsyncRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.SignalWorkflowExecutionAsyncRequest{
+		SignalWorkflowExecutionRequest: ToSignalWorkflowExecutionRequest(t.Request),
+	}
+}
+
+func FromSignalWorkflowExecutionAsyncResponse(t *types.SignalWorkflowExecutionAsyncResponse) *shared.SignalWorkflowExecutionAsyncResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.SignalWorkflowExecutionAsyncResponse{
+		RequestID: &t.RequestID,
+	}
+}
+
+func ToSignalWorkflowExecutionAsyncResponse(t *shared.SignalWorkflowExecutionAsyncResponse) *types.SignalWorkflowExecutionAsyncResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.SignalWorkflowExecutionAsyncResponse{
+		RequestID: t.GetRequestID(),
+	}
+}
+
+func FromRequestCancelWorkflowExecutionAsyncRequest(t *types.RequestCancelWorkflowExecutionAsyncRequest) *shared.RequestCancelWorkflowExecutionAsyncRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.RequestCancelWorkflowExecutionAsyncRequest{
+		Request: FromRequestCancelWorkflowExecutionRequest(t.RequestCancelWorkflowExecutionRequest),
+	}
+}
+
+func ToRequestCancelWorkflowExecutionAsyncRequest(t *shared.RequestCancelWorkflowExecutionAsyncRequest) *types.RequestCancelWorkflowExecutionAsyncRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RequestCancelWorkflowExecutionAsyncRequest{
+		RequestCancelWorkflowExecutionRequest: ToRequestCancelWorkflowExecutionRequest(t.Request),
+	}
+}
+
+func FromRequestCancelWorkflowExecutionAsyncResponse(t *types.RequestCancelWorkflowExecutionAsyncResponse) *shared.RequestCancelWorkflowExecutionAsyncResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.RequestCancelWorkflowExecutionAsyncResponse{
+		RequestID: &t.RequestID,
+	}
+}
+
+func ToRequestCancelWorkflowExecutionAsyncResponse(t *shared.RequestCancelWorkflowExecutionAsyncResponse) *types.RequestCancelWorkflowExecutionAsyncResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.RequestCancelWorkflowExecutionAsyncResponse{
+		RequestID: t.GetRequestID(),
+	}
+}
+
+func FromTerminateWorkflowExecutionAsyncRequest(t *types.TerminateWorkflowExecutionAsyncRequest) *shared.TerminateWorkflowExecutionAsyncRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.TerminateWorkflowExecutionAsyncRequest{
+		Request: FromTerminateWorkflowExecutionRequest(t.TerminateWorkflowExecutionRequest),
+	}
+}
+
+func ToTerminateWorkflowExecutionAsyncRequest(t *shared.TerminateWorkflowExecutionAsyncRequest) *types.TerminateWorkflowExecutionAsyncRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.TerminateWorkflowExecutionAsyncRequest{
+		TerminateWorkflowExecutionRequest: ToTerminateWorkflowExecutionRequest(t.Request),
+	}
+}
+
+func FromTerminateWorkflowExecutionAsyncResponse(t *types.TerminateWorkflowExecutionAsyncResponse) *shared.TerminateWorkflowExecutionAsyncResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.TerminateWorkflowExecutionAsyncResponse{
+		RequestID: &t.RequestID,
+	}
+}
+
+func ToTerminateWorkflowExecutionAsyncResponse(t *shared.TerminateWorkflowExecutionAsyncResponse) *types.TerminateWorkflowExecutionAsyncResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.TerminateWorkflowExecutionAsyncResponse{
+		RequestID: t.GetRequestID(),
+	}
 }
 
 func FromStartWorkflowExecutionAsyncRequest(t *types.StartWorkflowExecutionAsyncRequest) *shared.StartWorkflowExecutionAsyncRequest {
@@ -5483,14 +5915,18 @@ func FromStartWorkflowExecutionAsyncResponse(t *types.StartWorkflowExecutionAsyn
 	if t == nil {
 		return nil
 	}
-	return &shared.StartWorkflowExecutionAsyncResponse{}
+	return &shared.StartWorkflowExecutionAsyncResponse{
+		RequestID: &t.RequestID,
+	}
 }
 
 func ToStartWorkflowExecutionAsyncResponse(t *shared.StartWorkflowExecutionAsyncResponse) *types.StartWorkflowExecutionAsyncResponse {
 	if t == nil {
 		return nil
 	}
-	return &types.StartWorkflowExecutionAsyncResponse{}
+	return &types.StartWorkflowExecutionAsyncResponse{
+		RequestID: t.GetRequestID(),
+	}
 }
 
 // FromStartWorkflowExecutionRequest converts internal StartWorkflowExecutionRequest type to thrift
@@ -5519,6 +5955,7 @@ func FromStartWorkflowExecutionRequest(t *types.StartWorkflowExecutionRequest) *
 		FirstRunAtTimestamp:                 t.FirstRunAtTimeStamp,
 		CronOverlapPolicy:                   FromCronOverlapPolicy(t.CronOverlapPolicy),
 		ActiveClusterSelectionPolicy:        FromActiveClusterSelectionPolicy(t.ActiveClusterSelectionPolicy),
+		CompletionCallbacks:                 FromCompletionCallbackArray(t.CompletionCallbacks),
 	}
 }
 
@@ -5548,6 +5985,7 @@ func ToStartWorkflowExecutionRequest(t *shared.StartWorkflowExecutionRequest) *t
 		FirstRunAtTimeStamp:                 t.FirstRunAtTimestamp,
 		CronOverlapPolicy:                   ToCronOverlapPolicy(t.CronOverlapPolicy),
 		ActiveClusterSelectionPolicy:        ToActiveClusterSelectionPolicy(t.ActiveClusterSelectionPolicy),
+		CompletionCallbacks:                 ToCompletionCallbackArray(t.CompletionCallbacks),
 	}
 }
 
@@ -5708,6 +6146,38 @@ func ToTaskListKind(t *shared.TaskListKind) *types.TaskListKind {
 	panic("unexpected enum value")
 }
 
+// FromTaskListScalingConfidence converts internal TaskListScalingConfidence type to thrift
+func FromTaskListScalingConfidence(t *types.TaskListScalingConfidence) *shared.TaskListScalingConfidence {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.TaskListScalingConfidenceLow:
+		return shared.TaskListScalingConfidenceLow.Ptr()
+	case types.TaskListScalingConfidenceMedium:
+		return shared.TaskListScalingConfidenceMedium.Ptr()
+	case types.TaskListScalingConfidenceHigh:
+		return shared.TaskListScalingConfidenceHigh.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
+// ToTaskListScalingConfidence converts thrift TaskListScalingConfidence type to internal
+func ToTaskListScalingConfidence(t *shared.TaskListScalingConfidence) *types.TaskListScalingConfidence {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case shared.TaskListScalingConfidenceLow:
+		return types.TaskListScalingConfidenceLow.Ptr()
+	case shared.TaskListScalingConfidenceMedium:
+		return types.TaskListScalingConfidenceMedium.Ptr()
+	case shared.TaskListScalingConfidenceHigh:
+		return types.TaskListScalingConfidenceHigh.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
 // FromTaskListMetadata converts internal TaskListMetadata type to thrift
 func FromTaskListMetadata(t *types.TaskListMetadata) *shared.TaskListMetadata {
 	if t == nil {
@@ -5756,14 +6226,17 @@ func FromTaskListStatus(t *types.TaskListStatus) *shared.TaskListStatus {
 		return nil
 	}
 	return &shared.TaskListStatus{
-		BacklogCountHint:      &t.BacklogCountHint,
-		ReadLevel:             &t.ReadLevel,
-		AckLevel:              &t.AckLevel,
-		RatePerSecond:         &t.RatePerSecond,
-		TaskIDBlock:           FromTaskIDBlock(t.TaskIDBlock),
-		IsolationGroupMetrics: FromIsolationGroupMetricsMap(t.IsolationGroupMetrics),
-		NewTasksPerSecond:     &t.NewTasksPerSecond,
-		Empty:                 &t.Empty,
+		BacklogCountHint:           &t.BacklogCountHint,
+		ReadLevel:                  &t.ReadLevel,
+		AckLevel:                   &t.AckLevel,
+		RatePerSecond:              &t.RatePerSecond,
+		TaskIDBlock:                FromTaskIDBlock(t.TaskIDBlock),
+		IsolationGroupMetrics:      FromIsolationGroupMetricsMap(t.IsolationGroupMetrics),
+		NewTasksPerSecond:          &t.NewTasksPerSecond,
+		Empty:                      &t.Empty,
+		DispatchedTasksPerSecond:   &t.DispatchedTasksPerSecond,
+		SyncMatchRatio:             &t.SyncMatchRatio,
+		ScheduleToStartLatencyInMs: &t.ScheduleToStartLatencyInMs,
 	}
 }
 
@@ -5773,14 +6246,17 @@ func ToTaskListStatus(t *shared.TaskListStatus) *types.TaskListStatus {
 		return nil
 	}
 	return &types.TaskListStatus{
-		BacklogCountHint:      t.GetBacklogCountHint(),
-		ReadLevel:             t.GetReadLevel(),
-		AckLevel:              t.GetAckLevel(),
-		RatePerSecond:         t.GetRatePerSecond(),
-		TaskIDBlock:           ToTaskIDBlock(t.TaskIDBlock),
-		IsolationGroupMetrics: ToIsolationGroupMetricsMap(t.GetIsolationGroupMetrics()),
-		NewTasksPerSecond:     t.GetNewTasksPerSecond(),
-		Empty:                 t.GetEmpty(),
+		BacklogCountHint:           t.GetBacklogCountHint(),
+		ReadLevel:                  t.GetReadLevel(),
+		AckLevel:                   t.GetAckLevel(),
+		RatePerSecond:              t.GetRatePerSecond(),
+		TaskIDBlock:                ToTaskIDBlock(t.TaskIDBlock),
+		IsolationGroupMetrics:      ToIsolationGroupMetricsMap(t.GetIsolationGroupMetrics()),
+		NewTasksPerSecond:          t.GetNewTasksPerSecond(),
+		Empty:                      t.GetEmpty(),
+		DispatchedTasksPerSecond:   t.GetDispatchedTasksPerSecond(),
+		SyncMatchRatio:             t.GetSyncMatchRatio(),
+		ScheduleToStartLatencyInMs: t.GetScheduleToStartLatencyInMs(),
 	}
 }
 
@@ -6114,6 +6590,48 @@ func ToUpdateDomainResponse(t *shared.UpdateDomainResponse) *types.UpdateDomainR
 	}
 }
 
+// FromUpsertWorkflowMemoDecisionAttributes converts internal UpsertWorkflowMemoDecisionAttributes type to thrift
+func FromUpsertWorkflowMemoDecisionAttributes(t *types.UpsertWorkflowMemoDecisionAttributes) *shared.UpsertWorkflowMemoDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpsertWorkflowMemoDecisionAttributes{
+		Memo: FromMemo(t.Memo),
+	}
+}
+
+// ToUpsertWorkflowMemoDecisionAttributes converts thrift UpsertWorkflowMemoDecisionAttributes type to internal
+func ToUpsertWorkflowMemoDecisionAttributes(t *shared.UpsertWorkflowMemoDecisionAttributes) *types.UpsertWorkflowMemoDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.UpsertWorkflowMemoDecisionAttributes{
+		Memo: ToMemo(t.Memo),
+	}
+}
+
+// FromUpsertWorkflowMemoEventAttributes converts internal UpsertWorkflowMemoEventAttributes type to thrift
+func FromUpsertWorkflowMemoEventAttributes(t *types.UpsertWorkflowMemoEventAttributes) *shared.UpsertWorkflowMemoEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.UpsertWorkflowMemoEventAttributes{
+		DecisionTaskCompletedEventId: &t.DecisionTaskCompletedEventID,
+		Memo:                         FromMemo(t.Memo),
+	}
+}
+
+// ToUpsertWorkflowMemoEventAttributes converts thrift UpsertWorkflowMemoEventAttributes type to internal
+func ToUpsertWorkflowMemoEventAttributes(t *shared.UpsertWorkflowMemoEventAttributes) *types.UpsertWorkflowMemoEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.UpsertWorkflowMemoEventAttributes{
+		DecisionTaskCompletedEventID: t.GetDecisionTaskCompletedEventId(),
+		Memo:                         ToMemo(t.Memo),
+	}
+}
+
 // FromUpsertWorkflowSearchAttributesDecisionAttributes converts internal UpsertWorkflowSearchAttributesDecisionAttributes type to thrift
 func FromUpsertWorkflowSearchAttributesDecisionAttributes(t *types.UpsertWorkflowSearchAttributesDecisionAttributes) *shared.UpsertWorkflowSearchAttributesDecisionAttributes {
 	if t == nil {
@@ -6244,6 +6762,140 @@ func ToWorkerVersionInfo(t *shared.WorkerVersionInfo) *types.WorkerVersionInfo {
 	}
 }
 
+// FromListWorkersRequest converts internal ListWorkersRequest type to thrift
+func FromListWorkersRequest(t *types.ListWorkersRequest) *shared.ListWorkersRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListWorkersRequest{
+		Domain: &t.Domain,
+	}
+}
+
+// ToListWorkersRequest converts thrift ListWorkersRequest type to internal
+func ToListWorkersRequest(t *shared.ListWorkersRequest) *types.ListWorkersRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListWorkersRequest{
+		Domain: t.GetDomain(),
+	}
+}
+
+// FromListWorkersResponse converts internal ListWorkersResponse type to thrift
+func FromListWorkersResponse(t *types.ListWorkersResponse) *shared.ListWorkersResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.ListWorkersResponse{
+		Workers: FromWorkerInfoArray(t.Workers),
+	}
+}
+
+// ToListWorkersResponse converts thrift ListWorkersResponse type to internal
+func ToListWorkersResponse(t *shared.ListWorkersResponse) *types.ListWorkersResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListWorkersResponse{
+		Workers: ToWorkerInfoArray(t.Workers),
+	}
+}
+
+// FromDescribeWorkerRequest converts internal DescribeWorkerRequest type to thrift
+func FromDescribeWorkerRequest(t *types.DescribeWorkerRequest) *shared.DescribeWorkerRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeWorkerRequest{
+		Domain:   &t.Domain,
+		Identity: &t.Identity,
+	}
+}
+
+// ToDescribeWorkerRequest converts thrift DescribeWorkerRequest type to internal
+func ToDescribeWorkerRequest(t *shared.DescribeWorkerRequest) *types.DescribeWorkerRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeWorkerRequest{
+		Domain:   t.GetDomain(),
+		Identity: t.GetIdentity(),
+	}
+}
+
+// FromDescribeWorkerResponse converts internal DescribeWorkerResponse type to thrift
+func FromDescribeWorkerResponse(t *types.DescribeWorkerResponse) *shared.DescribeWorkerResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.DescribeWorkerResponse{
+		Worker: FromWorkerInfo(t.Worker),
+	}
+}
+
+// ToDescribeWorkerResponse converts thrift DescribeWorkerResponse type to internal
+func ToDescribeWorkerResponse(t *shared.DescribeWorkerResponse) *types.DescribeWorkerResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeWorkerResponse{
+		Worker: ToWorkerInfo(t.Worker),
+	}
+}
+
+// FromWorkerInfo converts internal WorkerInfo type to thrift
+func FromWorkerInfo(t *types.WorkerInfo) *shared.WorkerInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkerInfo{
+		Identity:       &t.Identity,
+		VersionInfo:    FromWorkerVersionInfo(t.VersionInfo),
+		TaskLists:      FromWorkerTaskListInfoArray(t.TaskLists),
+		LastPollTime:   t.LastPollTime,
+		PollsPerSecond: &t.PollsPerSecond,
+	}
+}
+
+// ToWorkerInfo converts thrift WorkerInfo type to internal
+func ToWorkerInfo(t *shared.WorkerInfo) *types.WorkerInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkerInfo{
+		Identity:       t.GetIdentity(),
+		VersionInfo:    ToWorkerVersionInfo(t.VersionInfo),
+		TaskLists:      ToWorkerTaskListInfoArray(t.TaskLists),
+		LastPollTime:   t.LastPollTime,
+		PollsPerSecond: t.GetPollsPerSecond(),
+	}
+}
+
+// FromWorkerTaskListInfo converts internal WorkerTaskListInfo type to thrift
+func FromWorkerTaskListInfo(t *types.WorkerTaskListInfo) *shared.WorkerTaskListInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkerTaskListInfo{
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+		LastPollTime: t.LastPollTime,
+	}
+}
+
+// ToWorkerTaskListInfo converts thrift WorkerTaskListInfo type to internal
+func ToWorkerTaskListInfo(t *shared.WorkerTaskListInfo) *types.WorkerTaskListInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkerTaskListInfo{
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+		LastPollTime: t.LastPollTime,
+	}
+}
+
 // FromWorkflowExecution converts internal WorkflowExecution type to thrift
 func FromWorkflowExecution(t *types.WorkflowExecution) *shared.WorkflowExecution {
 	if t == nil {
@@ -6599,6 +7251,30 @@ func ToWorkflowExecutionInfo(t *shared.WorkflowExecutionInfo) *types.WorkflowExe
 	}
 }
 
+// FromWorkflowExecutionMemoUpdatedEventAttributes converts internal WorkflowExecutionMemoUpdatedEventAttributes type to thrift
+func FromWorkflowExecutionMemoUpdatedEventAttributes(t *types.WorkflowExecutionMemoUpdatedEventAttributes) *shared.WorkflowExecutionMemoUpdatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionMemoUpdatedEventAttributes{
+		Memo:      FromMemo(t.Memo),
+		Identity:  &t.Identity,
+		RequestId: &t.RequestID,
+	}
+}
+
+// ToWorkflowExecutionMemoUpdatedEventAttributes converts thrift WorkflowExecutionMemoUpdatedEventAttributes type to internal
+func ToWorkflowExecutionMemoUpdatedEventAttributes(t *shared.WorkflowExecutionMemoUpdatedEventAttributes) *types.WorkflowExecutionMemoUpdatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &types.WorkflowExecutionMemoUpdatedEventAttributes{
+		Memo:      ToMemo(t.Memo),
+		Identity:  t.GetIdentity(),
+		RequestID: t.GetRequestId(),
+	}
+}
+
 // FromWorkflowExecutionSignaledEventAttributes converts internal WorkflowExecutionSignaledEventAttributes type to thrift
 func FromWorkflowExecutionSignaledEventAttributes(t *types.WorkflowExecutionSignaledEventAttributes) *shared.WorkflowExecutionSignaledEventAttributes {
 	if t == nil {
@@ -7025,6 +7701,54 @@ func ToPollerInfoArray(t []*shared.PollerInfo) []*types.PollerInfo {
 	return v
 }
 
+// FromWorkerInfoArray converts internal WorkerInfo type array to thrift
+func FromWorkerInfoArray(t []*types.WorkerInfo) []*shared.WorkerInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.WorkerInfo, len(t))
+	for i := range t {
+		v[i] = FromWorkerInfo(t[i])
+	}
+	return v
+}
+
+// ToWorkerInfoArray converts thrift WorkerInfo type array to internal
+func ToWorkerInfoArray(t []*shared.WorkerInfo) []*types.WorkerInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.WorkerInfo, len(t))
+	for i := range t {
+		v[i] = ToWorkerInfo(t[i])
+	}
+	return v
+}
+
+// FromWorkerTaskListInfoArray converts internal WorkerTaskListInfo type array to thrift
+func FromWorkerTaskListInfoArray(t []*types.WorkerTaskListInfo) []*shared.WorkerTaskListInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.WorkerTaskListInfo, len(t))
+	for i := range t {
+		v[i] = FromWorkerTaskListInfo(t[i])
+	}
+	return v
+}
+
+// ToWorkerTaskListInfoArray converts thrift WorkerTaskListInfo type array to internal
+func ToWorkerTaskListInfoArray(t []*shared.WorkerTaskListInfo) []*types.WorkerTaskListInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.WorkerTaskListInfo, len(t))
+	for i := range t {
+		v[i] = ToWorkerTaskListInfo(t[i])
+	}
+	return v
+}
+
 // FromClusterReplicationConfigurationArray converts internal ClusterReplicationConfiguration type array to thrift
 func FromClusterReplicationConfigurationArray(t []*types.ClusterReplicationConfiguration) []*shared.ClusterReplicationConfiguration {
 	if t == nil {
@@ -8386,6 +9110,34 @@ func ToAutoConfigHint(t *shared.AutoConfigHint) *types.AutoConfigHint {
 	}
 }
 
+// FromScheduleToStartBreakdown converts internal ScheduleToStartBreakdown type to thrift
+func FromScheduleToStartBreakdown(t *types.ScheduleToStartBreakdown) *shared.ScheduleToStartBreakdown {
+	if t == nil {
+		return nil
+	}
+	return &shared.ScheduleToStartBreakdown{
+		SyncMatched:           &t.SyncMatched,
+		Forwarded:             &t.Forwarded,
+		BacklogWaitTimeInMs:   &t.BacklogWaitTimeInMs,
+		RatelimitWaitTimeInMs: &t.RatelimitWaitTimeInMs,
+		IsolationWaitTimeInMs: &t.IsolationWaitTimeInMs,
+	}
+}
+
+// ToScheduleToStartBreakdown converts thrift ScheduleToStartBreakdown type to internal
+func ToScheduleToStartBreakdown(t *shared.ScheduleToStartBreakdown) *types.ScheduleToStartBreakdown {
+	if t == nil {
+		return nil
+	}
+	return &types.ScheduleToStartBreakdown{
+		SyncMatched:           t.GetSyncMatched(),
+		Forwarded:             t.GetForwarded(),
+		BacklogWaitTimeInMs:   t.GetBacklogWaitTimeInMs(),
+		RatelimitWaitTimeInMs: t.GetRatelimitWaitTimeInMs(),
+		IsolationWaitTimeInMs: t.GetIsolationWaitTimeInMs(),
+	}
+}
+
 func FromTaskKey(t *types.TaskKey) *shared.TaskKey {
 	if t == nil {
 		return nil
@@ -8551,3 +9303,139 @@ func ToCronOverlapPolicy(t *shared.CronOverlapPolicy) *types.CronOverlapPolicy {
 	}
 	panic("unexpected enum value")
 }
+
+// FromCompletionCallback converts internal CompletionCallback type to thrift
+func FromCompletionCallback(t *types.CompletionCallback) *shared.CompletionCallback {
+	if t == nil {
+		return nil
+	}
+	return &shared.CompletionCallback{
+		URL:     &t.URL,
+		Headers: t.Headers,
+	}
+}
+
+// ToCompletionCallback converts thrift CompletionCallback type to internal
+func ToCompletionCallback(t *shared.CompletionCallback) *types.CompletionCallback {
+	if t == nil {
+		return nil
+	}
+	return &types.CompletionCallback{
+		URL:     t.GetURL(),
+		Headers: t.Headers,
+	}
+}
+
+// FromCompletionCallbackArray converts internal CompletionCallback type array to thrift
+func FromCompletionCallbackArray(t []*types.CompletionCallback) []*shared.CompletionCallback {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.CompletionCallback, len(t))
+	for i := range t {
+		v[i] = FromCompletionCallback(t[i])
+	}
+	return v
+}
+
+// ToCompletionCallbackArray converts thrift CompletionCallback type array to internal
+func ToCompletionCallbackArray(t []*shared.CompletionCallback) []*types.CompletionCallback {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.CompletionCallback, len(t))
+	for i := range t {
+		v[i] = ToCompletionCallback(t[i])
+	}
+	return v
+}
+
+// FromCompletionCallbackState converts internal CompletionCallbackState type to thrift
+func FromCompletionCallbackState(t *types.CompletionCallbackState) *shared.CompletionCallbackState {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.CompletionCallbackStateScheduled:
+		return shared.CompletionCallbackStateScheduled.Ptr()
+	case types.CompletionCallbackStateBackingOff:
+		return shared.CompletionCallbackStateBackingOff.Ptr()
+	case types.CompletionCallbackStateSucceeded:
+		return shared.CompletionCallbackStateSucceeded.Ptr()
+	case types.CompletionCallbackStateDeadLettered:
+		return shared.CompletionCallbackStateDeadLettered.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
+// ToCompletionCallbackState converts thrift CompletionCallbackState type to internal
+func ToCompletionCallbackState(t *shared.CompletionCallbackState) *types.CompletionCallbackState {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case shared.CompletionCallbackStateScheduled:
+		return types.CompletionCallbackStateScheduled.Ptr()
+	case shared.CompletionCallbackStateBackingOff:
+		return types.CompletionCallbackStateBackingOff.Ptr()
+	case shared.CompletionCallbackStateSucceeded:
+		return types.CompletionCallbackStateSucceeded.Ptr()
+	case shared.CompletionCallbackStateDeadLettered:
+		return types.CompletionCallbackStateDeadLettered.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
+// FromCompletionCallbackInfo converts internal CompletionCallbackInfo type to thrift
+func FromCompletionCallbackInfo(t *types.CompletionCallbackInfo) *shared.CompletionCallbackInfo {
+	if t == nil {
+		return nil
+	}
+	return &shared.CompletionCallbackInfo{
+		URL:                  &t.URL,
+		State:                FromCompletionCallbackState(t.State),
+		Attempt:              &t.Attempt,
+		LastAttemptTimestamp: t.LastAttemptTimestamp,
+		NextAttemptTimestamp: t.NextAttemptTimestamp,
+		LastFailureReason:    &t.LastFailureReason,
+	}
+}
+
+// ToCompletionCallbackInfo converts thrift CompletionCallbackInfo type to internal
+func ToCompletionCallbackInfo(t *shared.CompletionCallbackInfo) *types.CompletionCallbackInfo {
+	if t == nil {
+		return nil
+	}
+	return &types.CompletionCallbackInfo{
+		URL:                  t.GetURL(),
+		State:                ToCompletionCallbackState(t.State),
+		Attempt:              t.GetAttempt(),
+		LastAttemptTimestamp: t.LastAttemptTimestamp,
+		NextAttemptTimestamp: t.NextAttemptTimestamp,
+		LastFailureReason:    t.GetLastFailureReason(),
+	}
+}
+
+// FromCompletionCallbackInfoArray converts internal CompletionCallbackInfo type array to thrift
+func FromCompletionCallbackInfoArray(t []*types.CompletionCallbackInfo) []*shared.CompletionCallbackInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.CompletionCallbackInfo, len(t))
+	for i := range t {
+		v[i] = FromCompletionCallbackInfo(t[i])
+	}
+	return v
+}
+
+// ToCompletionCallbackInfoArray converts thrift CompletionCallbackInfo type array to internal
+func ToCompletionCallbackInfoArray(t []*shared.CompletionCallbackInfo) []*types.CompletionCallbackInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.CompletionCallbackInfo, len(t))
+	for i := range t {
+		v[i] = ToCompletionCallbackInfo(t[i])
+	}
+	return v
+}
@@ -434,10 +434,13 @@ func TestActivityTaskScheduledEventAttributesConversion(t *testing.T) {
 }
 
 func TestActivityTaskStartedEventAttributesConversion(t *testing.T) {
+	withBreakdown := testdata.ActivityTaskStartedEventAttributes
+	withBreakdown.ScheduleToStartBreakdown = &testdata.ScheduleToStartBreakdown
 	testCases := []*types.ActivityTaskStartedEventAttributes{
 		nil,
 		{},
 		&testdata.ActivityTaskStartedEventAttributes,
+		&withBreakdown,
 	}
 
 	for _, original := range testCases {
@@ -447,6 +450,80 @@ func TestActivityTaskStartedEventAttributesConversion(t *testing.T) {
 	}
 }
 
+func TestScheduleToStartBreakdownConversion(t *testing.T) {
+	testCases := []*types.ScheduleToStartBreakdown{
+		nil,
+		{},
+		&testdata.ScheduleToStartBreakdown,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromScheduleToStartBreakdown(original)
+		roundTripObj := ToScheduleToStartBreakdown(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestStickyExecutionInfoConversion(t *testing.T) {
+	testCases := []*types.StickyExecutionInfo{
+		nil,
+		{},
+		&testdata.StickyExecutionInfo,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromStickyExecutionInfo(original)
+		roundTripObj := ToStickyExecutionInfo(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestCompletionCallbackConversion(t *testing.T) {
+	testCases := []*types.CompletionCallback{
+		nil,
+		{},
+		&testdata.CompletionCallback,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromCompletionCallback(original)
+		roundTripObj := ToCompletionCallback(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestCompletionCallbackInfoConversion(t *testing.T) {
+	testCases := []*types.CompletionCallbackInfo{
+		nil,
+		{},
+		&testdata.CompletionCallbackInfo,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromCompletionCallbackInfo(original)
+		roundTripObj := ToCompletionCallbackInfo(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestCompletionCallbackStateConversion(t *testing.T) {
+	testCases := []*types.CompletionCallbackState{
+		nil,
+		types.CompletionCallbackStateScheduled.Ptr(),
+		types.CompletionCallbackStateBackingOff.Ptr(),
+		types.CompletionCallbackStateSucceeded.Ptr(),
+		types.CompletionCallbackStateDeadLettered.Ptr(),
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromCompletionCallbackState(original)
+		roundTripObj := ToCompletionCallbackState(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+	assert.Panics(t, func() { FromCompletionCallbackState(types.CompletionCallbackState(999).Ptr()) })
+	assert.Panics(t, func() { ToCompletionCallbackState(shared.CompletionCallbackState(999).Ptr()) })
+}
+
 func TestActivityTaskTimedEventAttributesConversion(t *testing.T) {
 	testCases := []*types.ActivityTaskTimedOutEventAttributes{
 		nil,
@@ -850,6 +927,7 @@ func TestDecisionConverson(t *testing.T) {
 			DecisionType:                           types.DecisionTypeScheduleActivityTask.Ptr(),
 			ScheduleActivityTaskDecisionAttributes: &testdata.ScheduleActivityTaskDecisionAttributes,
 		},
+		&testdata.Decision_UpsertWorkflowMemo,
 	}
 
 	for _, original := range testCases {
@@ -899,6 +977,7 @@ func TestDecisionTaskFailedCauseConversion(t *testing.T) {
 		types.DecisionTaskFailedCauseBadBinary.Ptr(),
 		types.DecisionTaskFailedCauseScheduleActivityDuplicateID.Ptr(),
 		types.DecisionTaskFailedCauseBadSearchAttributes.Ptr(),
+		types.DecisionTaskFailedCauseBadMemo.Ptr(),
 	}
 
 	for _, original := range testCases {
@@ -994,6 +1073,7 @@ func TestDecisionTypeConversion(t *testing.T) {
 		types.DecisionTypeStartChildWorkflowExecution.Ptr(),
 		types.DecisionTypeSignalExternalWorkflowExecution.Ptr(),
 		types.DecisionTypeUpsertWorkflowSearchAttributes.Ptr(),
+		types.DecisionTypeUpsertWorkflowMemo.Ptr(),
 	}
 
 	for _, original := range testCases {
@@ -1031,6 +1111,50 @@ func TestDeprecateDomainRequestConversion(t *testing.T) {
 	}
 }
 
+func TestAsyncRequestStateConversion(t *testing.T) {
+	testCases := []*types.AsyncRequestState{
+		nil,
+		types.AsyncRequestStateQueued.Ptr(),
+		types.AsyncRequestStateProcessing.Ptr(),
+		types.AsyncRequestStateSucceeded.Ptr(),
+		types.AsyncRequestStateFailed.Ptr(),
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromAsyncRequestState(original)
+		roundTripObj := ToAsyncRequestState(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestDescribeAsyncRequestRequestConversion(t *testing.T) {
+	testCases := []*types.DescribeAsyncRequestRequest{
+		nil,
+		{},
+		&testdata.DescribeAsyncRequestRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromDescribeAsyncRequestRequest(original)
+		roundTripObj := ToDescribeAsyncRequestRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestDescribeAsyncRequestResponseConversion(t *testing.T) {
+	testCases := []*types.DescribeAsyncRequestResponse{
+		nil,
+		{},
+		&testdata.DescribeAsyncRequestResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromDescribeAsyncRequestResponse(original)
+		roundTripObj := ToDescribeAsyncRequestResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestDescribeDomainRequestConversion(t *testing.T) {
 	testCases := []*types.DescribeDomainRequest{
 		nil,
@@ -1046,10 +1170,13 @@ func TestDescribeDomainRequestConversion(t *testing.T) {
 }
 
 func TestDescribeDomainResponseConversion(t *testing.T) {
+	withTaskQuota := testdata.DescribeDomainResponse
+	withTaskQuota.TaskQuota = &testdata.DomainTaskQuota
 	testCases := []*types.DescribeDomainResponse{
 		nil,
 		{},
 		&testdata.DescribeDomainResponse,
+		&withTaskQuota,
 	}
 
 	for _, original := range testCases {
@@ -1157,6 +1284,34 @@ func TestDescribeTaskListRequestConversion(t *testing.T) {
 	}
 }
 
+func TestGetTaskListScalingRecommendationRequestConversion(t *testing.T) {
+	testCases := []*types.GetTaskListScalingRecommendationRequest{
+		nil,
+		{},
+		&testdata.GetTaskListScalingRecommendationRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromGetTaskListScalingRecommendationRequest(original)
+		roundTripObj := ToGetTaskListScalingRecommendationRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestGetTaskListScalingRecommendationResponseConversion(t *testing.T) {
+	testCases := []*types.GetTaskListScalingRecommendationResponse{
+		nil,
+		{},
+		&testdata.GetTaskListScalingRecommendationResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromGetTaskListScalingRecommendationResponse(original)
+		roundTripObj := ToGetTaskListScalingRecommendationResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestDescribeTaskListResponseConversion(t *testing.T) {
 	testCases := []*types.DescribeTaskListResponse{
 		nil,
@@ -1189,10 +1344,16 @@ func TestDescribeWorkflowExecutionRequestConversion(t *testing.T) {
 }
 
 func TestDescribeWorkflowExecutionResponseConversion(t *testing.T) {
+	withCompletionCallbacks := testdata.DescribeWorkflowExecutionResponse
+	withCompletionCallbacks.CompletionCallbacks = []*types.CompletionCallbackInfo{&testdata.CompletionCallbackInfo}
+	withStickyExecutionInfo := testdata.DescribeWorkflowExecutionResponse
+	withStickyExecutionInfo.StickyExecutionInfo = &testdata.StickyExecutionInfo
 	testCases := []*types.DescribeWorkflowExecutionResponse{
 		nil,
 		{},
 		&testdata.DescribeWorkflowExecutionResponse,
+		&withCompletionCallbacks,
+		&withStickyExecutionInfo,
 	}
 
 	for _, original := range testCases {
@@ -1300,6 +1461,20 @@ func TestFailoverInfoConversion(t *testing.T) {
 	}
 }
 
+func TestDomainTaskQuotaConversion(t *testing.T) {
+	testCases := []*types.DomainTaskQuota{
+		nil,
+		{},
+		&testdata.DomainTaskQuota,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromDomainTaskQuota(original)
+		roundTripObj := ToDomainTaskQuota(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestDomainNotActiveErrorConversion(t *testing.T) {
 	testCases := []*types.DomainNotActiveError{
 		nil,
@@ -1431,6 +1606,8 @@ func TestEventTypeConversion(t *testing.T) {
 		types.EventTypeSignalExternalWorkflowExecutionFailed.Ptr(),
 		types.EventTypeExternalWorkflowExecutionSignaled.Ptr(),
 		types.EventTypeUpsertWorkflowSearchAttributes.Ptr(),
+		types.EventTypeUpsertWorkflowMemo.Ptr(),
+		types.EventTypeWorkflowExecutionMemoUpdated.Ptr(),
 	}
 
 	for _, original := range testCases {
@@ -1582,6 +1759,8 @@ func TestHistoryEventConversion(t *testing.T) {
 			EventType:                               types.EventTypeWorkflowExecutionStarted.Ptr(),
 			WorkflowExecutionStartedEventAttributes: &testdata.WorkflowExecutionStartedEventAttributes,
 		},
+		&testdata.HistoryEvent_UpsertWorkflowMemo,
+		&testdata.HistoryEvent_WorkflowExecutionMemoUpdated,
 	}
 
 	for _, original := range testCases {
@@ -2445,6 +2624,20 @@ func TestResetStickyTaskListResponseConversion(t *testing.T) {
 	}
 }
 
+func TestUpdateWorkflowMemoRequestConversion(t *testing.T) {
+	testCases := []*types.UpdateWorkflowMemoRequest{
+		nil,
+		{},
+		&testdata.UpdateWorkflowMemoRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromUpdateWorkflowMemoRequest(original)
+		roundTripObj := ToUpdateWorkflowMemoRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestResetWorkflowExecutionRequestConversion(t *testing.T) {
 	testCases := []*types.ResetWorkflowExecutionRequest{
 		nil,
@@ -2558,10 +2751,13 @@ func TestRespondActivityTaskFailedRequestConversion(t *testing.T) {
 }
 
 func TestRespondDecisionTaskCompletedRequestConversion(t *testing.T) {
+	withEagerActivitySlots := testdata.RespondDecisionTaskCompletedRequest
+	withEagerActivitySlots.EagerActivitySlots = common.Int32Ptr(3)
 	testCases := []*types.RespondDecisionTaskCompletedRequest{
 		nil,
 		{},
 		&testdata.RespondDecisionTaskCompletedRequest,
+		&withEagerActivitySlots,
 	}
 
 	for _, original := range testCases {
@@ -2740,10 +2936,13 @@ func TestSignalExternalWorkflowExecutionInitiatedEventAttributesConversion(t *te
 }
 
 func TestSignalWithStartWorkflowExecutionRequestConversion(t *testing.T) {
+	withCompletionCallbacks := testdata.SignalWithStartWorkflowExecutionRequest
+	withCompletionCallbacks.CompletionCallbacks = []*types.CompletionCallback{&testdata.CompletionCallback}
 	testCases := []*types.SignalWithStartWorkflowExecutionRequest{
 		nil,
 		{},
 		&testdata.SignalWithStartWorkflowExecutionRequest,
+		&withCompletionCallbacks,
 	}
 
 	for _, original := range testCases {
@@ -2838,10 +3037,13 @@ func TestStartTimerDecisionAttributesConversion(t *testing.T) {
 }
 
 func TestStartWorkflowExecutionRequestConversion(t *testing.T) {
+	withCompletionCallbacks := testdata.StartWorkflowExecutionRequest
+	withCompletionCallbacks.CompletionCallbacks = []*types.CompletionCallback{&testdata.CompletionCallback}
 	testCases := []*types.StartWorkflowExecutionRequest{
 		nil,
 		{},
 		&testdata.StartWorkflowExecutionRequest,
+		&withCompletionCallbacks,
 	}
 
 	for _, original := range testCases {
@@ -2935,6 +3137,90 @@ func TestSignalWithStartWorkflowExecutionAsyncResponseConversion(t *testing.T) {
 	}
 }
 
+func TestSignalWorkflowExecutionAsyncRequestConversion(t *testing.T) {
+	testCases := []*types.SignalWorkflowExecutionAsyncRequest{
+		nil,
+		{},
+		&testdata.SignalWorkflowExecutionAsyncRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromSignalWorkflowExecutionAsyncRequest(original)
+		roundTripObj := ToSignalWorkflowExecutionAsyncRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestSignalWorkflowExecutionAsyncResponseConversion(t *testing.T) {
+	testCases := []*types.SignalWorkflowExecutionAsyncResponse{
+		nil,
+		{},
+		&testdata.SignalWorkflowExecutionAsyncResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromSignalWorkflowExecutionAsyncResponse(original)
+		roundTripObj := ToSignalWorkflowExecutionAsyncResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestRequestCancelWorkflowExecutionAsyncRequestConversion(t *testing.T) {
+	testCases := []*types.RequestCancelWorkflowExecutionAsyncRequest{
+		nil,
+		{},
+		&testdata.RequestCancelWorkflowExecutionAsyncRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromRequestCancelWorkflowExecutionAsyncRequest(original)
+		roundTripObj := ToRequestCancelWorkflowExecutionAsyncRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestRequestCancelWorkflowExecutionAsyncResponseConversion(t *testing.T) {
+	testCases := []*types.RequestCancelWorkflowExecutionAsyncResponse{
+		nil,
+		{},
+		&testdata.RequestCancelWorkflowExecutionAsyncResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromRequestCancelWorkflowExecutionAsyncResponse(original)
+		roundTripObj := ToRequestCancelWorkflowExecutionAsyncResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestTerminateWorkflowExecutionAsyncRequestConversion(t *testing.T) {
+	testCases := []*types.TerminateWorkflowExecutionAsyncRequest{
+		nil,
+		{},
+		&testdata.TerminateWorkflowExecutionAsyncRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromTerminateWorkflowExecutionAsyncRequest(original)
+		roundTripObj := ToTerminateWorkflowExecutionAsyncRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestTerminateWorkflowExecutionAsyncResponseConversion(t *testing.T) {
+	testCases := []*types.TerminateWorkflowExecutionAsyncResponse{
+		nil,
+		{},
+		&testdata.TerminateWorkflowExecutionAsyncResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromTerminateWorkflowExecutionAsyncResponse(original)
+		roundTripObj := ToTerminateWorkflowExecutionAsyncResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestStickyExecutionAttributesConversion(t *testing.T) {
 	testCases := []*types.StickyExecutionAttributes{
 		nil,
@@ -3024,6 +3310,11 @@ func TestTaskListStatusConversion(t *testing.T) {
 		nil,
 		{},
 		&testdata.TaskListStatus,
+		{
+			DispatchedTasksPerSecond:   10,
+			SyncMatchRatio:             0.5,
+			ScheduleToStartLatencyInMs: 1500,
+		},
 	}
 
 	for _, original := range testCases {
@@ -3033,6 +3324,21 @@ func TestTaskListStatusConversion(t *testing.T) {
 	}
 }
 
+func TestTaskListScalingConfidenceConversion(t *testing.T) {
+	testCases := []*types.TaskListScalingConfidence{
+		nil,
+		types.TaskListScalingConfidenceLow.Ptr(),
+		types.TaskListScalingConfidenceMedium.Ptr(),
+		types.TaskListScalingConfidenceHigh.Ptr(),
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromTaskListScalingConfidence(original)
+		roundTripObj := ToTaskListScalingConfidence(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestTaskListTypeConversion(t *testing.T) {
 	testCases := []*types.TaskListType{
 		nil,
@@ -3220,6 +3526,62 @@ func TestVersionHistoryItemConversion(t *testing.T) {
 	}
 }
 
+func TestListWorkersRequestConversion(t *testing.T) {
+	testCases := []*types.ListWorkersRequest{
+		nil,
+		{},
+		&testdata.ListWorkersRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromListWorkersRequest(original)
+		roundTripObj := ToListWorkersRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestListWorkersResponseConversion(t *testing.T) {
+	testCases := []*types.ListWorkersResponse{
+		nil,
+		{},
+		&testdata.ListWorkersResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromListWorkersResponse(original)
+		roundTripObj := ToListWorkersResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestDescribeWorkerRequestConversion(t *testing.T) {
+	testCases := []*types.DescribeWorkerRequest{
+		nil,
+		{},
+		&testdata.DescribeWorkerRequest,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromDescribeWorkerRequest(original)
+		roundTripObj := ToDescribeWorkerRequest(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
+func TestDescribeWorkerResponseConversion(t *testing.T) {
+	testCases := []*types.DescribeWorkerResponse{
+		nil,
+		{},
+		&testdata.DescribeWorkerResponse,
+	}
+
+	for _, original := range testCases {
+		thriftObj := FromDescribeWorkerResponse(original)
+		roundTripObj := ToDescribeWorkerResponse(thriftObj)
+		assert.Equal(t, original, roundTripObj)
+	}
+}
+
 func TestWorkerVersionInfoConversion(t *testing.T) {
 	testCases := []*types.WorkerVersionInfo{
 		nil,
@@ -133,6 +133,7 @@ type AddDecisionTaskRequest struct {
 	Source                        *TaskSource        `json:"source,omitempty"`
 	ForwardedFrom                 string             `json:"forwardedFrom,omitempty"`
 	PartitionConfig               map[string]string
+	BuildID                       string `json:"buildID,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -199,6 +200,14 @@ func (v *AddDecisionTaskRequest) GetPartitionConfig() (o map[string]string) {
 	return
 }
 
+// GetBuildID is an internal getter (TBD...)
+func (v *AddDecisionTaskRequest) GetBuildID() (o string) {
+	if v != nil {
+		return v.BuildID
+	}
+	return
+}
+
 type AddActivityTaskResponse struct {
 	PartitionConfig *TaskListPartitionConfig
 }
@@ -428,6 +437,99 @@ type TaskListPartitionConfig struct {
 	WritePartitions map[int]*TaskListPartition
 }
 
+// CompatibleBuildIDSet is a set of worker build IDs which are able to process each other's workflows.
+// Build IDs are ordered by the time they were added to the set and the last one is the default of the set.
+type CompatibleBuildIDSet struct {
+	BuildIDs []string
+}
+
+// GetBuildIDs is an internal getter (TBD...)
+func (v *CompatibleBuildIDSet) GetBuildIDs() (o []string) {
+	if v != nil && v.BuildIDs != nil {
+		return v.BuildIDs
+	}
+	return
+}
+
+// GetDefaultBuildID returns the build ID new tasks of this set are routed to
+func (v *CompatibleBuildIDSet) GetDefaultBuildID() (o string) {
+	if v != nil && len(v.BuildIDs) > 0 {
+		return v.BuildIDs[len(v.BuildIDs)-1]
+	}
+	return
+}
+
+// TaskListVersioningConfig holds the worker build IDs registered on a task list.
+// Compatible sets are ordered by the time they became default and the last one is the default set,
+// new workflows are routed to it while existing workflows stay on the set of the build that processed them.
+type TaskListVersioningConfig struct {
+	Version        int64
+	CompatibleSets []*CompatibleBuildIDSet
+}
+
+// GetVersion is an internal getter (TBD...)
+func (v *TaskListVersioningConfig) GetVersion() (o int64) {
+	if v != nil {
+		return v.Version
+	}
+	return
+}
+
+// GetCompatibleSets is an internal getter (TBD...)
+func (v *TaskListVersioningConfig) GetCompatibleSets() (o []*CompatibleBuildIDSet) {
+	if v != nil && v.CompatibleSets != nil {
+		return v.CompatibleSets
+	}
+	return
+}
+
+// GetDefaultSet returns the compatible set new workflows are routed to
+func (v *TaskListVersioningConfig) GetDefaultSet() (o *CompatibleBuildIDSet) {
+	if v != nil && len(v.CompatibleSets) > 0 {
+		return v.CompatibleSets[len(v.CompatibleSets)-1]
+	}
+	return
+}
+
+// FindSet returns the compatible set which contains the build ID
+func (v *TaskListVersioningConfig) FindSet(buildID string) (o *CompatibleBuildIDSet) {
+	if buildID == "" {
+		return
+	}
+	for _, set := range v.GetCompatibleSets() {
+		for _, id := range set.GetBuildIDs() {
+			if id == buildID {
+				return set
+			}
+		}
+	}
+	return
+}
+
+// TaskListMigrationConfig describes the migration of a task list to another task list. While it is set,
+// all partitions of the task list move their backlog to the target task list.
+type TaskListMigrationConfig struct {
+	TargetTaskList string
+	// Alias redirects new activity tasks to the target task list
+	Alias bool
+}
+
+// GetTargetTaskList is an internal getter (TBD...)
+func (v *TaskListMigrationConfig) GetTargetTaskList() (o string) {
+	if v != nil {
+		return v.TargetTaskList
+	}
+	return
+}
+
+// GetAlias is an internal getter (TBD...)
+func (v *TaskListMigrationConfig) GetAlias() (o bool) {
+	if v != nil {
+		return v.Alias
+	}
+	return
+}
+
 // MatchingPollForDecisionTaskResponse is an internal type (TBD...)
 type MatchingPollForDecisionTaskResponse struct {
 	TaskToken                 []byte                    `json:"taskToken,omitempty"`
@@ -717,6 +819,152 @@ func (v *MatchingRefreshTaskListPartitionConfigRequest) GetTaskListType() (o Tas
 
 type MatchingRefreshTaskListPartitionConfigResponse struct{}
 
+type MatchingUpdateTaskListVersioningConfigRequest struct {
+	DomainUUID    string
+	UpdateRequest *UpdateTaskListVersioningConfigRequest
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingUpdateTaskListVersioningConfigRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetUpdateRequest is an internal getter (TBD...)
+func (v *MatchingUpdateTaskListVersioningConfigRequest) GetUpdateRequest() (o *UpdateTaskListVersioningConfigRequest) {
+	if v != nil && v.UpdateRequest != nil {
+		return v.UpdateRequest
+	}
+	return
+}
+
+type MatchingUpdateTaskListVersioningConfigResponse struct {
+	VersioningConfig *TaskListVersioningConfig
+}
+
+// GetVersioningConfig is an internal getter (TBD...)
+func (v *MatchingUpdateTaskListVersioningConfigResponse) GetVersioningConfig() (o *TaskListVersioningConfig) {
+	if v != nil && v.VersioningConfig != nil {
+		return v.VersioningConfig
+	}
+	return
+}
+
+type MatchingMigrateTaskListRequest struct {
+	DomainUUID     string
+	MigrateRequest *MigrateTaskListRequest
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingMigrateTaskListRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetMigrateRequest is an internal getter (TBD...)
+func (v *MatchingMigrateTaskListRequest) GetMigrateRequest() (o *MigrateTaskListRequest) {
+	if v != nil && v.MigrateRequest != nil {
+		return v.MigrateRequest
+	}
+	return
+}
+
+// MatchingGetTaskListScalingRecommendationRequest is an internal type (TBD...)
+type MatchingGetTaskListScalingRecommendationRequest struct {
+	DomainUUID string                                   `json:"domainUUID,omitempty"`
+	Request    *GetTaskListScalingRecommendationRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingGetTaskListScalingRecommendationRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequest is an internal getter (TBD...)
+func (v *MatchingGetTaskListScalingRecommendationRequest) GetRequest() (o *GetTaskListScalingRecommendationRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
+
+// MatchingListWorkersRequest is an internal type (TBD...)
+type MatchingListWorkersRequest struct {
+	DomainUUID string              `json:"domainUUID,omitempty"`
+	Request    *ListWorkersRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingListWorkersRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequest is an internal getter (TBD...)
+func (v *MatchingListWorkersRequest) GetRequest() (o *ListWorkersRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
+
+// MatchingDescribeWorkerRequest is an internal type (TBD...)
+type MatchingDescribeWorkerRequest struct {
+	DomainUUID string                 `json:"domainUUID,omitempty"`
+	Request    *DescribeWorkerRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingDescribeWorkerRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequest is an internal getter (TBD...)
+func (v *MatchingDescribeWorkerRequest) GetRequest() (o *DescribeWorkerRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
+
+// MatchingDescribeDomainTaskQuotaRequest is an internal type (TBD...)
+type MatchingDescribeDomainTaskQuotaRequest struct {
+	DomainUUID string `json:"domainUUID,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingDescribeDomainTaskQuotaRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// MatchingDescribeDomainTaskQuotaResponse is an internal type (TBD...)
+type MatchingDescribeDomainTaskQuotaResponse struct {
+	TaskQuota *DomainTaskQuota `json:"taskQuota,omitempty"`
+}
+
+// GetTaskQuota is an internal getter (TBD...)
+func (v *MatchingDescribeDomainTaskQuotaResponse) GetTaskQuota() (o *DomainTaskQuota) {
+	if v != nil && v.TaskQuota != nil {
+		return v.TaskQuota
+	}
+	return
+}
+
 type LoadBalancerHints struct {
 	BacklogCount  int64
 	RatePerSecond float64
@@ -1781,3 +1781,64 @@ func TestMatchingRefreshTaskListPartitionConfigRequest_GetTaskListType(t *testin
 		})
 	}
 }
+
+func TestTaskListVersioningConfig_FindSet(t *testing.T) {
+	config := &TaskListVersioningConfig{
+		Version: 1,
+		CompatibleSets: []*CompatibleBuildIDSet{
+			{BuildIDs: []string{"1.0", "1.1"}},
+			{BuildIDs: []string{"2.0"}},
+		},
+	}
+	tests := []struct {
+		name    string
+		config  *TaskListVersioningConfig
+		buildID string
+		want    *CompatibleBuildIDSet
+	}{
+		{
+			name:    "nil config",
+			config:  nil,
+			buildID: "1.0",
+			want:    nil,
+		},
+		{
+			name:    "empty build ID",
+			config:  config,
+			buildID: "",
+			want:    nil,
+		},
+		{
+			name:    "unknown build ID",
+			config:  config,
+			buildID: "3.0",
+			want:    nil,
+		},
+		{
+			name:    "compatible build ID",
+			config:  config,
+			buildID: "1.1",
+			want:    config.CompatibleSets[0],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.config.FindSet(tt.buildID))
+		})
+	}
+}
+
+func TestTaskListVersioningConfig_GetDefaultSet(t *testing.T) {
+	var config *TaskListVersioningConfig
+	assert.Nil(t, config.GetDefaultSet())
+	assert.Equal(t, "", config.GetDefaultSet().GetDefaultBuildID())
+
+	config = &TaskListVersioningConfig{
+		CompatibleSets: []*CompatibleBuildIDSet{
+			{BuildIDs: []string{"1.0", "1.1"}},
+			{BuildIDs: []string{"2.0", "2.1"}},
+		},
+	}
+	assert.Equal(t, config.CompatibleSets[1], config.GetDefaultSet())
+	assert.Equal(t, "2.1", config.GetDefaultSet().GetDefaultBuildID())
+}
@@ -62,6 +62,413 @@ func (v *GetShardOwnerResponse) GetNamespace() (o string) {
 	return
 }
 
+type WatchNamespaceStateRequest struct {
+	Namespace string
+	Revision  int64
+}
+
+func (v *WatchNamespaceStateRequest) GetNamespace() (o string) {
+	if v != nil {
+		return v.Namespace
+	}
+	return
+}
+
+func (v *WatchNamespaceStateRequest) GetRevision() (o int64) {
+	if v != nil {
+		return v.Revision
+	}
+	return
+}
+
+type WatchNamespaceStateResponse struct {
+	Namespace string
+	Revision  int64
+	Owners    []string
+}
+
+func (v *WatchNamespaceStateResponse) GetNamespace() (o string) {
+	if v != nil {
+		return v.Namespace
+	}
+	return
+}
+
+func (v *WatchNamespaceStateResponse) GetRevision() (o int64) {
+	if v != nil {
+		return v.Revision
+	}
+	return
+}
+
+func (v *WatchNamespaceStateResponse) GetOwners() (o []string) {
+	if v != nil {
+		return v.Owners
+	}
+	return
+}
+
+type ListNamespacesRequest struct{}
+
+type ListNamespacesResponse struct {
+	Namespaces []*NamespaceInfo
+}
+
+func (v *ListNamespacesResponse) GetNamespaces() (o []*NamespaceInfo) {
+	if v != nil {
+		return v.Namespaces
+	}
+	return
+}
+
+type NamespaceInfo struct {
+	Name     string
+	Type     string
+	ShardNum int64
+}
+
+func (v *NamespaceInfo) GetName() (o string) {
+	if v != nil {
+		return v.Name
+	}
+	return
+}
+
+func (v *NamespaceInfo) GetType() (o string) {
+	if v != nil {
+		return v.Type
+	}
+	return
+}
+
+func (v *NamespaceInfo) GetShardNum() (o int64) {
+	if v != nil {
+		return v.ShardNum
+	}
+	return
+}
+
+type DescribeNamespaceRequest struct {
+	Namespace string
+}
+
+func (v *DescribeNamespaceRequest) GetNamespace() (o string) {
+	if v != nil {
+		return v.Namespace
+	}
+	return
+}
+
+type DescribeNamespaceResponse struct {
+	Namespace         string
+	Executors         []*ExecutorInfo
+	RebalancingFrozen bool
+	ShardMoves        []*ShardMoveInfo
+	AuditLog          []*NamespaceAuditEntry
+}
+
+func (v *DescribeNamespaceResponse) GetNamespace() (o string) {
+	if v != nil {
+		return v.Namespace
+	}
+	return
+}
+
+func (v *DescribeNamespaceResponse) GetExecutors() (o []*ExecutorInfo) {
+	if v != nil {
+		return v.Executors
+	}
+	return
+}
+
+func (v *DescribeNamespaceResponse) GetRebalancingFrozen() (o bool) {
+	if v != nil {
+		return v.RebalancingFrozen
+	}
+	return
+}
+
+func (v *DescribeNamespaceResponse) GetShardMoves() (o []*ShardMoveInfo) {
+	if v != nil {
+		return v.ShardMoves
+	}
+	return
+}
+
+func (v *DescribeNamespaceResponse) GetAuditLog() (o []*NamespaceAuditEntry) {
+	if v != nil {
+		return v.AuditLog
+	}
+	return
+}
+
+type ExecutorInfo struct {
+	ExecutorID     string
+	State          string
+	LastHeartbeat  int64 // Unix nanoseconds
+	Drained        bool
+	AssignedShards []*ShardAssignmentInfo
+}
+
+func (v *ExecutorInfo) GetExecutorID() (o string) {
+	if v != nil {
+		return v.ExecutorID
+	}
+	return
+}
+
+func (v *ExecutorInfo) GetState() (o string) {
+	if v != nil {
+		return v.State
+	}
+	return
+}
+
+func (v *ExecutorInfo) GetLastHeartbeat() (o int64) {
+	if v != nil {
+		return v.LastHeartbeat
+	}
+	return
+}
+
+func (v *ExecutorInfo) GetDrained() (o bool) {
+	if v != nil {
+		return v.Drained
+	}
+	return
+}
+
+func (v *ExecutorInfo) GetAssignedShards() (o []*ShardAssignmentInfo) {
+	if v != nil {
+		return v.AssignedShards
+	}
+	return
+}
+
+type ShardAssignmentInfo struct {
+	ShardKey   string
+	AssignedAt int64 // Unix nanoseconds
+	Status     string
+	ShardLoad  float64
+}
+
+func (v *ShardAssignmentInfo) GetShardKey() (o string) {
+	if v != nil {
+		return v.ShardKey
+	}
+	return
+}
+
+func (v *ShardAssignmentInfo) GetAssignedAt() (o int64) {
+	if v != nil {
+		return v.AssignedAt
+	}
+	return
+}
+
+func (v *ShardAssignmentInfo) GetStatus() (o string) {
+	if v != nil {
+		return v.Status
+	}
+	return
+}
+
+func (v *ShardAssignmentInfo) GetShardLoad() (o float64) {
+	if v != nil {
+		return v.ShardLoad
+	}
+	return
+}
+
+type ShardMoveInfo struct {
+	ShardKey    string
+	ExecutorID  string
+	RequestedAt int64 // Unix nanoseconds
+}
+
+func (v *ShardMoveInfo) GetShardKey() (o string) {
+	if v != nil {
+		return v.ShardKey
+	}
+	return
+}
+
+func (v *ShardMoveInfo) GetExecutorID() (o string) {
+	if v != nil {
+		return v.ExecutorID
+	}
+	return
+}
+
+func (v *ShardMoveInfo) GetRequestedAt() (o int64) {
+	if v != nil {
+		return v.RequestedAt
+	}
+	return
+}
+
+type NamespaceAuditEntry struct {
+	Time      int64 // Unix nanoseconds
+	Identity  string
+	Operation string
+	Reason    string
+}
+
+func (v *NamespaceAuditEntry) GetTime() (o int64) {
+	if v != nil {
+		return v.Time
+	}
+	return
+}
+
+func (v *NamespaceAuditEntry) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+func (v *NamespaceAuditEntry) GetOperation() (o string) {
+	if v != nil {
+		return v.Operation
+	}
+	return
+}
+
+func (v *NamespaceAuditEntry) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+type MoveShardRequest struct {
+	Namespace  string
+	ShardKey   string
+	ExecutorID string
+	Identity   string
+	Reason     string
+}
+
+func (v *MoveShardRequest) GetNamespace() (o string) {
+	if v != nil {
+		return v.Namespace
+	}
+	return
+}
+
+func (v *MoveShardRequest) GetShardKey() (o string) {
+	if v != nil {
+		return v.ShardKey
+	}
+	return
+}
+
+func (v *MoveShardRequest) GetExecutorID() (o string) {
+	if v != nil {
+		return v.ExecutorID
+	}
+	return
+}
+
+func (v *MoveShardRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+func (v *MoveShardRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+type MoveShardResponse struct{}
+
+type SetExecutorDrainedRequest struct {
+	Namespace  string
+	ExecutorID string
+	Drained    bool
+	Identity   string
+	Reason     string
+}
+
+func (v *SetExecutorDrainedRequest) GetNamespace() (o string) {
+	if v != nil {
+		return v.Namespace
+	}
+	return
+}
+
+func (v *SetExecutorDrainedRequest) GetExecutorID() (o string) {
+	if v != nil {
+		return v.ExecutorID
+	}
+	return
+}
+
+func (v *SetExecutorDrainedRequest) GetDrained() (o bool) {
+	if v != nil {
+		return v.Drained
+	}
+	return
+}
+
+func (v *SetExecutorDrainedRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+func (v *SetExecutorDrainedRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+type SetExecutorDrainedResponse struct{}
+
+type SetRebalancingFrozenRequest struct {
+	Namespace string
+	Frozen    bool
+	Identity  string
+	Reason    string
+}
+
+func (v *SetRebalancingFrozenRequest) GetNamespace() (o string) {
+	if v != nil {
+		return v.Namespace
+	}
+	return
+}
+
+func (v *SetRebalancingFrozenRequest) GetFrozen() (o bool) {
+	if v != nil {
+		return v.Frozen
+	}
+	return
+}
+
+func (v *SetRebalancingFrozenRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+func (v *SetRebalancingFrozenRequest) GetReason() (o string) {
+	if v != nil {
+		return v.Reason
+	}
+	return
+}
+
+type SetRebalancingFrozenResponse struct{}
+
 type NamespaceNotFoundError struct {
 	Namespace string
 }
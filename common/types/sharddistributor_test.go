@@ -151,3 +151,60 @@ func TestGetShardOwnerResponse_GetNamespace(t *testing.T) {
 		})
 	}
 }
+
+func TestWatchNamespaceStateRequest_Getters(t *testing.T) {
+	var nilRequest *WatchNamespaceStateRequest
+	assert.Equal(t, "", nilRequest.GetNamespace())
+	assert.Equal(t, int64(0), nilRequest.GetRevision())
+
+	req := &WatchNamespaceStateRequest{Namespace: "namespace", Revision: 5}
+	assert.Equal(t, "namespace", req.GetNamespace())
+	assert.Equal(t, int64(5), req.GetRevision())
+}
+
+func TestWatchNamespaceStateResponse_Getters(t *testing.T) {
+	var nilResponse *WatchNamespaceStateResponse
+	assert.Equal(t, "", nilResponse.GetNamespace())
+	assert.Equal(t, int64(0), nilResponse.GetRevision())
+	assert.Nil(t, nilResponse.GetOwners())
+
+	resp := &WatchNamespaceStateResponse{Namespace: "namespace", Revision: 6, Owners: []string{"owner-1"}}
+	assert.Equal(t, "namespace", resp.GetNamespace())
+	assert.Equal(t, int64(6), resp.GetRevision())
+	assert.Equal(t, []string{"owner-1"}, resp.GetOwners())
+}
+
+func TestDescribeNamespaceResponse_Getters(t *testing.T) {
+	var nilResponse *DescribeNamespaceResponse
+	assert.Equal(t, "", nilResponse.GetNamespace())
+	assert.Nil(t, nilResponse.GetExecutors())
+	assert.False(t, nilResponse.GetRebalancingFrozen())
+	assert.Nil(t, nilResponse.GetShardMoves())
+	assert.Nil(t, nilResponse.GetAuditLog())
+
+	executor := &ExecutorInfo{ExecutorID: "executor-1", State: "ACTIVE", LastHeartbeat: 5, Drained: true}
+	resp := &DescribeNamespaceResponse{Namespace: "namespace", Executors: []*ExecutorInfo{executor}, RebalancingFrozen: true}
+	assert.Equal(t, "namespace", resp.GetNamespace())
+	assert.Equal(t, []*ExecutorInfo{executor}, resp.GetExecutors())
+	assert.True(t, resp.GetRebalancingFrozen())
+	assert.Equal(t, "executor-1", executor.GetExecutorID())
+	assert.Equal(t, "ACTIVE", executor.GetState())
+	assert.Equal(t, int64(5), executor.GetLastHeartbeat())
+	assert.True(t, executor.GetDrained())
+}
+
+func TestMoveShardRequest_Getters(t *testing.T) {
+	var nilRequest *MoveShardRequest
+	assert.Equal(t, "", nilRequest.GetNamespace())
+	assert.Equal(t, "", nilRequest.GetShardKey())
+	assert.Equal(t, "", nilRequest.GetExecutorID())
+	assert.Equal(t, "", nilRequest.GetIdentity())
+	assert.Equal(t, "", nilRequest.GetReason())
+
+	req := &MoveShardRequest{Namespace: "namespace", ShardKey: "1", ExecutorID: "executor-1", Identity: "identity", Reason: "reason"}
+	assert.Equal(t, "namespace", req.GetNamespace())
+	assert.Equal(t, "1", req.GetShardKey())
+	assert.Equal(t, "executor-1", req.GetExecutorID())
+	assert.Equal(t, "identity", req.GetIdentity())
+	assert.Equal(t, "reason", req.GetReason())
+}
@@ -236,12 +236,13 @@ func (v *ActivityTaskScheduledEventAttributes) ByteSize() uint64 {
 
 // ActivityTaskStartedEventAttributes is an internal type (TBD...)
 type ActivityTaskStartedEventAttributes struct {
-	ScheduledEventID   int64   `json:"scheduledEventId,omitempty"`
-	Identity           string  `json:"identity,omitempty"`
-	RequestID          string  `json:"requestId,omitempty"`
-	Attempt            int32   `json:"attempt,omitempty"`
-	LastFailureReason  *string `json:"lastFailureReason,omitempty"`
-	LastFailureDetails []byte  `json:"lastFailureDetails,omitempty"`
+	ScheduledEventID         int64                     `json:"scheduledEventId,omitempty"`
+	Identity                 string                    `json:"identity,omitempty"`
+	RequestID                string                    `json:"requestId,omitempty"`
+	Attempt                  int32                     `json:"attempt,omitempty"`
+	LastFailureReason        *string                   `json:"lastFailureReason,omitempty"`
+	LastFailureDetails       []byte                    `json:"lastFailureDetails,omitempty"`
+	ScheduleToStartBreakdown *ScheduleToStartBreakdown `json:"scheduleToStartBreakdown,omitempty"`
 }
 
 // GetScheduledEventID is an internal getter (TBD...)
@@ -260,6 +261,14 @@ func (v *ActivityTaskStartedEventAttributes) GetRequestID() (o string) {
 	return
 }
 
+// GetScheduleToStartBreakdown is an internal getter (TBD...)
+func (v *ActivityTaskStartedEventAttributes) GetScheduleToStartBreakdown() (o *ScheduleToStartBreakdown) {
+	if v != nil && v.ScheduleToStartBreakdown != nil {
+		return v.ScheduleToStartBreakdown
+	}
+	return
+}
+
 // Size returns the approximate memory used in bytes
 func (v *ActivityTaskStartedEventAttributes) ByteSize() uint64 {
 	return 0
@@ -360,6 +369,71 @@ const (
 	ArchivalStatusEnabled
 )
 
+// AsyncRequestState is an internal type (TBD...)
+type AsyncRequestState int32
+
+// Ptr is a helper function for getting pointer value
+func (e AsyncRequestState) Ptr() *AsyncRequestState {
+	return &e
+}
+
+// String returns a readable string representation of AsyncRequestState.
+func (e AsyncRequestState) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "QUEUED"
+	case 1:
+		return "PROCESSING"
+	case 2:
+		return "SUCCEEDED"
+	case 3:
+		return "FAILED"
+	}
+	return fmt.Sprintf("AsyncRequestState(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *AsyncRequestState) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "QUEUED":
+		*e = AsyncRequestStateQueued
+		return nil
+	case "PROCESSING":
+		*e = AsyncRequestStateProcessing
+		return nil
+	case "SUCCEEDED":
+		*e = AsyncRequestStateSucceeded
+		return nil
+	case "FAILED":
+		*e = AsyncRequestStateFailed
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "AsyncRequestState", err)
+		}
+		*e = AsyncRequestState(val)
+		return nil
+	}
+}
+
+// MarshalText encodes AsyncRequestState to text.
+func (e AsyncRequestState) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// AsyncRequestStateQueued is an option for AsyncRequestState
+	AsyncRequestStateQueued AsyncRequestState = iota
+	// AsyncRequestStateProcessing is an option for AsyncRequestState
+	AsyncRequestStateProcessing
+	// AsyncRequestStateSucceeded is an option for AsyncRequestState
+	AsyncRequestStateSucceeded
+	// AsyncRequestStateFailed is an option for AsyncRequestState
+	AsyncRequestStateFailed
+)
+
 // BadBinaries is an internal type (TBD...)
 type BadBinaries struct {
 	Binaries map[string]*BadBinaryInfo `json:"binaries,omitempty"`
@@ -732,6 +806,151 @@ type CompleteWorkflowExecutionDecisionAttributes struct {
 	Result []byte `json:"result,omitempty"`
 }
 
+// CompletionCallback is an HTTP endpoint notified once a workflow execution closes
+type CompletionCallback struct {
+	URL     string            `json:"url,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// GetURL is an internal getter (TBD...)
+func (v *CompletionCallback) GetURL() (o string) {
+	if v != nil {
+		return v.URL
+	}
+	return
+}
+
+// GetHeaders is an internal getter (TBD...)
+func (v *CompletionCallback) GetHeaders() (o map[string]string) {
+	if v != nil && v.Headers != nil {
+		return v.Headers
+	}
+	return
+}
+
+// CompletionCallbackInfo describes the delivery progress of a completion callback
+type CompletionCallbackInfo struct {
+	URL                  string                   `json:"url,omitempty"`
+	State                *CompletionCallbackState `json:"state,omitempty"`
+	Attempt              int32                    `json:"attempt,omitempty"`
+	LastAttemptTimestamp *int64                   `json:"lastAttemptTimestamp,omitempty"`
+	NextAttemptTimestamp *int64                   `json:"nextAttemptTimestamp,omitempty"`
+	LastFailureReason    string                   `json:"lastFailureReason,omitempty"`
+}
+
+// GetURL is an internal getter (TBD...)
+func (v *CompletionCallbackInfo) GetURL() (o string) {
+	if v != nil {
+		return v.URL
+	}
+	return
+}
+
+// GetState is an internal getter (TBD...)
+func (v *CompletionCallbackInfo) GetState() (o CompletionCallbackState) {
+	if v != nil && v.State != nil {
+		return *v.State
+	}
+	return
+}
+
+// GetAttempt is an internal getter (TBD...)
+func (v *CompletionCallbackInfo) GetAttempt() (o int32) {
+	if v != nil {
+		return v.Attempt
+	}
+	return
+}
+
+// GetLastAttemptTimestamp is an internal getter (TBD...)
+func (v *CompletionCallbackInfo) GetLastAttemptTimestamp() (o int64) {
+	if v != nil && v.LastAttemptTimestamp != nil {
+		return *v.LastAttemptTimestamp
+	}
+	return
+}
+
+// GetNextAttemptTimestamp is an internal getter (TBD...)
+func (v *CompletionCallbackInfo) GetNextAttemptTimestamp() (o int64) {
+	if v != nil && v.NextAttemptTimestamp != nil {
+		return *v.NextAttemptTimestamp
+	}
+	return
+}
+
+// GetLastFailureReason is an internal getter (TBD...)
+func (v *CompletionCallbackInfo) GetLastFailureReason() (o string) {
+	if v != nil {
+		return v.LastFailureReason
+	}
+	return
+}
+
+// CompletionCallbackState is an internal type (TBD...)
+type CompletionCallbackState int32
+
+// Ptr is a helper function for getting pointer value
+func (e CompletionCallbackState) Ptr() *CompletionCallbackState {
+	return &e
+}
+
+// String returns a readable string representation of CompletionCallbackState.
+func (e CompletionCallbackState) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "SCHEDULED"
+	case 1:
+		return "BACKING_OFF"
+	case 2:
+		return "SUCCEEDED"
+	case 3:
+		return "DEAD_LETTERED"
+	}
+	return fmt.Sprintf("CompletionCallbackState(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *CompletionCallbackState) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "SCHEDULED":
+		*e = CompletionCallbackStateScheduled
+		return nil
+	case "BACKING_OFF":
+		*e = CompletionCallbackStateBackingOff
+		return nil
+	case "SUCCEEDED":
+		*e = CompletionCallbackStateSucceeded
+		return nil
+	case "DEAD_LETTERED":
+		*e = CompletionCallbackStateDeadLettered
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "CompletionCallbackState", err)
+		}
+		*e = CompletionCallbackState(val)
+		return nil
+	}
+}
+
+// MarshalText encodes CompletionCallbackState to text.
+func (e CompletionCallbackState) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// CompletionCallbackStateScheduled is an option for CompletionCallbackState
+	CompletionCallbackStateScheduled CompletionCallbackState = iota
+	// CompletionCallbackStateBackingOff is an option for CompletionCallbackState
+	CompletionCallbackStateBackingOff
+	// CompletionCallbackStateSucceeded is an option for CompletionCallbackState
+	CompletionCallbackStateSucceeded
+	// CompletionCallbackStateDeadLettered is an option for CompletionCallbackState
+	CompletionCallbackStateDeadLettered
+)
+
 // ContinueAsNewInitiator is an internal type (TBD...)
 type ContinueAsNewInitiator int32
 
@@ -964,6 +1183,7 @@ type Decision struct {
 	StartChildWorkflowExecutionDecisionAttributes            *StartChildWorkflowExecutionDecisionAttributes            `json:"startChildWorkflowExecutionDecisionAttributes,omitempty"`
 	SignalExternalWorkflowExecutionDecisionAttributes        *SignalExternalWorkflowExecutionDecisionAttributes        `json:"signalExternalWorkflowExecutionDecisionAttributes,omitempty"`
 	UpsertWorkflowSearchAttributesDecisionAttributes         *UpsertWorkflowSearchAttributesDecisionAttributes         `json:"upsertWorkflowSearchAttributesDecisionAttributes,omitempty"`
+	UpsertWorkflowMemoDecisionAttributes                     *UpsertWorkflowMemoDecisionAttributes                     `json:"upsertWorkflowMemoDecisionAttributes,omitempty"`
 }
 
 // GetDecisionType is an internal getter (TBD...)
@@ -1062,6 +1282,8 @@ func (e DecisionTaskFailedCause) String() string {
 		return "SCHEDULE_ACTIVITY_DUPLICATE_I_D"
 	case 22:
 		return "BAD_SEARCH_ATTRIBUTES"
+	case 23:
+		return "BAD_MEMO"
 	}
 	return fmt.Sprintf("DecisionTaskFailedCause(%d)", w)
 }
@@ -1138,6 +1360,9 @@ func (e *DecisionTaskFailedCause) UnmarshalText(value []byte) error {
 	case "BAD_SEARCH_ATTRIBUTES":
 		*e = DecisionTaskFailedCauseBadSearchAttributes
 		return nil
+	case "BAD_MEMO":
+		*e = DecisionTaskFailedCauseBadMemo
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -1200,6 +1425,8 @@ const (
 	DecisionTaskFailedCauseScheduleActivityDuplicateID
 	// DecisionTaskFailedCauseBadSearchAttributes is an option for DecisionTaskFailedCause
 	DecisionTaskFailedCauseBadSearchAttributes
+	// DecisionTaskFailedCauseBadMemo is an option for DecisionTaskFailedCause
+	DecisionTaskFailedCauseBadMemo
 )
 
 // DecisionTaskFailedEventAttributes is an internal type (TBD...)
@@ -1497,6 +1724,8 @@ func (e DecisionType) String() string {
 		return "SignalExternalWorkflowExecution"
 	case 12:
 		return "UpsertWorkflowSearchAttributes"
+	case 13:
+		return "UpsertWorkflowMemo"
 	}
 	return fmt.Sprintf("DecisionType(%d)", w)
 }
@@ -1543,6 +1772,9 @@ func (e *DecisionType) UnmarshalText(value []byte) error {
 	case "UPSERTWORKFLOWSEARCHATTRIBUTES":
 		*e = DecisionTypeUpsertWorkflowSearchAttributes
 		return nil
+	case "UPSERTWORKFLOWMEMO":
+		*e = DecisionTypeUpsertWorkflowMemo
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -1585,6 +1817,8 @@ const (
 	DecisionTypeSignalExternalWorkflowExecution
 	// DecisionTypeUpsertWorkflowSearchAttributes is an option for DecisionType
 	DecisionTypeUpsertWorkflowSearchAttributes
+	// DecisionTypeUpsertWorkflowMemo is an option for DecisionType
+	DecisionTypeUpsertWorkflowMemo
 )
 
 // DeleteDomainRequest is an internal type (TBD...)
@@ -1615,6 +1849,46 @@ func (v *DeprecateDomainRequest) GetName() (o string) {
 	return
 }
 
+// DescribeAsyncRequestRequest is an internal type (TBD...)
+type DescribeAsyncRequestRequest struct {
+	Domain    string `json:"domain,omitempty"`
+	RequestID string `json:"requestId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *DescribeAsyncRequestRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *DescribeAsyncRequestRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// DescribeAsyncRequestResponse is an internal type (TBD...)
+type DescribeAsyncRequestResponse struct {
+	RequestID           string             `json:"requestId,omitempty"`
+	State               *AsyncRequestState `json:"state,omitempty"`
+	WorkflowExecution   *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Failure             string             `json:"failure,omitempty"`
+	CreatedTimeNano     *int64             `json:"createdTimeNano,omitempty"`
+	LastUpdatedTimeNano *int64             `json:"lastUpdatedTimeNano,omitempty"`
+}
+
+// GetState is an internal getter (TBD...)
+func (v *DescribeAsyncRequestResponse) GetState() (o AsyncRequestState) {
+	if v != nil && v.State != nil {
+		return *v.State
+	}
+	return
+}
+
 // DescribeDomainRequest is an internal type (TBD...)
 type DescribeDomainRequest struct {
 	Name *string `json:"name,omitempty"`
@@ -1645,6 +1919,7 @@ type DescribeDomainResponse struct {
 	FailoverVersion          int64                           `json:"failoverVersion,omitempty"`
 	IsGlobalDomain           bool                            `json:"isGlobalDomain,omitempty"`
 	FailoverInfo             *FailoverInfo                   `json:"failoverInfo,omitempty"`
+	TaskQuota                *DomainTaskQuota                `json:"taskQuota,omitempty"`
 }
 
 // GetDomainInfo is an internal getter (TBD...)
@@ -1679,6 +1954,14 @@ func (v *DescribeDomainResponse) GetFailoverInfo() (o *FailoverInfo) {
 	return
 }
 
+// GetTaskQuota is an internal getter (TBD...)
+func (v *DescribeDomainResponse) GetTaskQuota() (o *DomainTaskQuota) {
+	if v != nil && v.TaskQuota != nil {
+		return v.TaskQuota
+	}
+	return
+}
+
 // DescribeHistoryHostRequest is an internal type (TBD...)
 type DescribeHistoryHostRequest struct {
 	HostAddress      *string            `json:"hostAddress,omitempty"`
@@ -1801,10 +2084,11 @@ func (v *DescribeTaskListRequest) GetIncludeTaskListStatus() (o bool) {
 
 // DescribeTaskListResponse is an internal type (TBD...)
 type DescribeTaskListResponse struct {
-	Pollers         []*PollerInfo            `json:"pollers,omitempty"`
-	TaskListStatus  *TaskListStatus          `json:"taskListStatus,omitempty"`
-	PartitionConfig *TaskListPartitionConfig `json:"partitionConfig,omitempty"`
-	TaskList        *TaskList                `json:"taskList,omitempty"`
+	Pollers          []*PollerInfo             `json:"pollers,omitempty"`
+	TaskListStatus   *TaskListStatus           `json:"taskListStatus,omitempty"`
+	PartitionConfig  *TaskListPartitionConfig  `json:"partitionConfig,omitempty"`
+	TaskList         *TaskList                 `json:"taskList,omitempty"`
+	VersioningConfig *TaskListVersioningConfig `json:"versioningConfig,omitempty"`
 }
 
 // GetPollers is an internal getter (TBD...)
@@ -1823,6 +2107,41 @@ func (v *DescribeTaskListResponse) GetTaskListStatus() (o *TaskListStatus) {
 	return
 }
 
+// DescribeWorkerRequest is an internal type (TBD...)
+type DescribeWorkerRequest struct {
+	Domain   string `json:"domain,omitempty"`
+	Identity string `json:"identity,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *DescribeWorkerRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *DescribeWorkerRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// DescribeWorkerResponse is an internal type (TBD...)
+type DescribeWorkerResponse struct {
+	Worker *WorkerInfo `json:"worker,omitempty"`
+}
+
+// GetWorker is an internal getter (TBD...)
+func (v *DescribeWorkerResponse) GetWorker() (o *WorkerInfo) {
+	if v != nil && v.Worker != nil {
+		return v.Worker
+	}
+	return
+}
+
 // DescribeWorkflowExecutionRequest is an internal type (TBD...)
 type DescribeWorkflowExecutionRequest struct {
 	Domain                string                 `json:"domain,omitempty"`
@@ -1861,6 +2180,8 @@ type DescribeWorkflowExecutionResponse struct {
 	PendingActivities      []*PendingActivityInfo          `json:"pendingActivities,omitempty"`
 	PendingChildren        []*PendingChildExecutionInfo    `json:"pendingChildren,omitempty"`
 	PendingDecision        *PendingDecisionInfo            `json:"pendingDecision,omitempty"`
+	CompletionCallbacks    []*CompletionCallbackInfo       `json:"completionCallbacks,omitempty"`
+	StickyExecutionInfo    *StickyExecutionInfo            `json:"stickyExecutionInfo,omitempty"`
 }
 
 // GetWorkflowExecutionInfo is an internal getter (TBD...)
@@ -1879,6 +2200,22 @@ func (v *DescribeWorkflowExecutionResponse) GetPendingActivities() (o []*Pending
 	return
 }
 
+// GetCompletionCallbacks is an internal getter (TBD...)
+func (v *DescribeWorkflowExecutionResponse) GetCompletionCallbacks() (o []*CompletionCallbackInfo) {
+	if v != nil && v.CompletionCallbacks != nil {
+		return v.CompletionCallbacks
+	}
+	return
+}
+
+// GetStickyExecutionInfo is an internal getter (TBD...)
+func (v *DescribeWorkflowExecutionResponse) GetStickyExecutionInfo() (o *StickyExecutionInfo) {
+	if v != nil && v.StickyExecutionInfo != nil {
+		return v.StickyExecutionInfo
+	}
+	return
+}
+
 // DomainAlreadyExistsError is an internal type (TBD...)
 type DomainAlreadyExistsError struct {
 	Message string `json:"message,required"`
@@ -2404,6 +2741,10 @@ func (e EventType) String() string {
 		return "ExternalWorkflowExecutionSignaled"
 	case 41:
 		return "UpsertWorkflowSearchAttributes"
+	case 42:
+		return "UpsertWorkflowMemo"
+	case 43:
+		return "WorkflowExecutionMemoUpdated"
 	}
 	return fmt.Sprintf("EventType(%d)", w)
 }
@@ -2537,6 +2878,12 @@ func (e *EventType) UnmarshalText(value []byte) error {
 	case "UPSERTWORKFLOWSEARCHATTRIBUTES":
 		*e = EventTypeUpsertWorkflowSearchAttributes
 		return nil
+	case "UPSERTWORKFLOWMEMO":
+		*e = EventTypeUpsertWorkflowMemo
+		return nil
+	case "WORKFLOWEXECUTIONMEMOUPDATED":
+		*e = EventTypeWorkflowExecutionMemoUpdated
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -2637,6 +2984,10 @@ const (
 	EventTypeExternalWorkflowExecutionSignaled
 	// EventTypeUpsertWorkflowSearchAttributes is an option for EventType
 	EventTypeUpsertWorkflowSearchAttributes
+	// EventTypeUpsertWorkflowMemo is an option for EventType
+	EventTypeUpsertWorkflowMemo
+	// EventTypeWorkflowExecutionMemoUpdated is an option for EventType
+	EventTypeWorkflowExecutionMemoUpdated
 )
 
 // ExternalWorkflowExecutionCancelRequestedEventAttributes is an internal type (TBD...)
@@ -2823,6 +3174,65 @@ func (v *GetWorkflowExecutionHistoryResponse) GetArchived() (o bool) {
 	return
 }
 
+// DomainTaskQuota describes the domain-wide matching task quotas of a domain and their usage,
+// averaged over the most recent global ratelimiter update interval
+type DomainTaskQuota struct {
+	AddTaskRPSLimit     float64 `json:"addTaskRPSLimit,omitempty"`
+	AddTaskRPS          float64 `json:"addTaskRPS,omitempty"`
+	AddTaskRejectedRPS  float64 `json:"addTaskRejectedRPS,omitempty"`
+	DispatchRPSLimit    float64 `json:"dispatchRPSLimit,omitempty"`
+	DispatchRPS         float64 `json:"dispatchRPS,omitempty"`
+	DispatchRejectedRPS float64 `json:"dispatchRejectedRPS,omitempty"`
+}
+
+// GetAddTaskRPSLimit is an internal getter (TBD...)
+func (v *DomainTaskQuota) GetAddTaskRPSLimit() (o float64) {
+	if v != nil {
+		return v.AddTaskRPSLimit
+	}
+	return
+}
+
+// GetAddTaskRPS is an internal getter (TBD...)
+func (v *DomainTaskQuota) GetAddTaskRPS() (o float64) {
+	if v != nil {
+		return v.AddTaskRPS
+	}
+	return
+}
+
+// GetAddTaskRejectedRPS is an internal getter (TBD...)
+func (v *DomainTaskQuota) GetAddTaskRejectedRPS() (o float64) {
+	if v != nil {
+		return v.AddTaskRejectedRPS
+	}
+	return
+}
+
+// GetDispatchRPSLimit is an internal getter (TBD...)
+func (v *DomainTaskQuota) GetDispatchRPSLimit() (o float64) {
+	if v != nil {
+		return v.DispatchRPSLimit
+	}
+	return
+}
+
+// GetDispatchRPS is an internal getter (TBD...)
+func (v *DomainTaskQuota) GetDispatchRPS() (o float64) {
+	if v != nil {
+		return v.DispatchRPS
+	}
+	return
+}
+
+// GetDispatchRejectedRPS is an internal getter (TBD...)
+func (v *DomainTaskQuota) GetDispatchRejectedRPS() (o float64) {
+	if v != nil {
+		return v.DispatchRejectedRPS
+	}
+	return
+}
+
 // FailoverInfo is an internal type (TBD...)
 type FailoverInfo struct {
 	FailoverVersion         int64   `json:"failoverVersion,omitempty"`
@@ -2953,6 +3363,8 @@ type HistoryEvent struct {
 	SignalExternalWorkflowExecutionFailedEventAttributes           *SignalExternalWorkflowExecutionFailedEventAttributes           `json:"signalExternalWorkflowExecutionFailedEventAttributes,omitempty"`
 	ExternalWorkflowExecutionSignaledEventAttributes               *ExternalWorkflowExecutionSignaledEventAttributes               `json:"externalWorkflowExecutionSignaledEventAttributes,omitempty"`
 	UpsertWorkflowSearchAttributesEventAttributes                  *UpsertWorkflowSearchAttributesEventAttributes                  `json:"upsertWorkflowSearchAttributesEventAttributes,omitempty"`
+	UpsertWorkflowMemoEventAttributes                              *UpsertWorkflowMemoEventAttributes                              `json:"upsertWorkflowMemoEventAttributes,omitempty"`
+	WorkflowExecutionMemoUpdatedEventAttributes                    *WorkflowExecutionMemoUpdatedEventAttributes                    `json:"workflowExecutionMemoUpdatedEventAttributes,omitempty"`
 }
 
 // GetTimestamp is an internal getter (TBD...)
@@ -3307,6 +3719,22 @@ func (v *HistoryEvent) GetUpsertWorkflowSearchAttributesEventAttributes() (o *Up
 	return
 }
 
+// GetUpsertWorkflowMemoEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetUpsertWorkflowMemoEventAttributes() (o *UpsertWorkflowMemoEventAttributes) {
+	if v != nil && v.UpsertWorkflowMemoEventAttributes != nil {
+		return v.UpsertWorkflowMemoEventAttributes
+	}
+	return
+}
+
+// GetWorkflowExecutionMemoUpdatedEventAttributes is an internal getter (TBD...)
+func (v *HistoryEvent) GetWorkflowExecutionMemoUpdatedEventAttributes() (o *WorkflowExecutionMemoUpdatedEventAttributes) {
+	if v != nil && v.WorkflowExecutionMemoUpdatedEventAttributes != nil {
+		return v.WorkflowExecutionMemoUpdatedEventAttributes
+	}
+	return
+}
+
 // Size is an internal method to get the estimated size of the event
 func (v *HistoryEvent) ByteSize() uint64 {
 	if v == nil {
@@ -3483,6 +3911,14 @@ func (v *HistoryEvent) ByteSize() uint64 {
 		size += v.UpsertWorkflowSearchAttributesEventAttributes.ByteSize()
 	}
 
+	if v.UpsertWorkflowMemoEventAttributes != nil {
+		size += v.UpsertWorkflowMemoEventAttributes.ByteSize()
+	}
+
+	if v.WorkflowExecutionMemoUpdatedEventAttributes != nil {
+		size += v.WorkflowExecutionMemoUpdatedEventAttributes.ByteSize()
+	}
+
 	return size
 }
 
@@ -3873,6 +4309,211 @@ func (v *GetTaskListsByDomainResponse) GetActivityTaskListMap() (o map[string]*D
 	return
 }
 
+// GetTaskListScalingRecommendationRequest is an internal type (TBD...)
+type GetTaskListScalingRecommendationRequest struct {
+	Domain       string        `json:"domain,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *GetTaskListScalingRecommendationRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *GetTaskListScalingRecommendationRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *GetTaskListScalingRecommendationRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// GetTaskListScalingRecommendationResponse is an internal type (TBD...)
+type GetTaskListScalingRecommendationResponse struct {
+	RecommendedPollerCount int64                      `json:"recommendedPollerCount,omitempty"`
+	Confidence             *TaskListScalingConfidence `json:"confidence,omitempty"`
+	Signals                *TaskListScalingSignals    `json:"signals,omitempty"`
+}
+
+// GetRecommendedPollerCount is an internal getter (TBD...)
+func (v *GetTaskListScalingRecommendationResponse) GetRecommendedPollerCount() (o int64) {
+	if v != nil {
+		return v.RecommendedPollerCount
+	}
+	return
+}
+
+// GetConfidence is an internal getter (TBD...)
+func (v *GetTaskListScalingRecommendationResponse) GetConfidence() (o TaskListScalingConfidence) {
+	if v != nil && v.Confidence != nil {
+		return *v.Confidence
+	}
+	return
+}
+
+// GetSignals is an internal getter (TBD...)
+func (v *GetTaskListScalingRecommendationResponse) GetSignals() (o *TaskListScalingSignals) {
+	if v != nil && v.Signals != nil {
+		return v.Signals
+	}
+	return
+}
+
+// TaskListScalingSignals is an internal type (TBD...)
+// It holds the task list metrics, aggregated over all partitions, a scaling recommendation is based on
+type TaskListScalingSignals struct {
+	BacklogCountHint           int64   `json:"backlogCountHint,omitempty"`
+	AddTasksPerSecond          float64 `json:"addTasksPerSecond,omitempty"`
+	DispatchedTasksPerSecond   float64 `json:"dispatchedTasksPerSecond,omitempty"`
+	SyncMatchRatio             float64 `json:"syncMatchRatio,omitempty"`
+	ScheduleToStartLatencyInMs int64   `json:"scheduleToStartLatencyInMs,omitempty"`
+	PollerCount                int64   `json:"pollerCount,omitempty"`
+}
+
+// GetBacklogCountHint is an internal getter (TBD...)
+func (v *TaskListScalingSignals) GetBacklogCountHint() (o int64) {
+	if v != nil {
+		return v.BacklogCountHint
+	}
+	return
+}
+
+// GetAddTasksPerSecond is an internal getter (TBD...)
+func (v *TaskListScalingSignals) GetAddTasksPerSecond() (o float64) {
+	if v != nil {
+		return v.AddTasksPerSecond
+	}
+	return
+}
+
+// GetDispatchedTasksPerSecond is an internal getter (TBD...)
+func (v *TaskListScalingSignals) GetDispatchedTasksPerSecond() (o float64) {
+	if v != nil {
+		return v.DispatchedTasksPerSecond
+	}
+	return
+}
+
+// GetSyncMatchRatio is an internal getter (TBD...)
+func (v *TaskListScalingSignals) GetSyncMatchRatio() (o float64) {
+	if v != nil {
+		return v.SyncMatchRatio
+	}
+	return
+}
+
+// GetScheduleToStartLatencyInMs is an internal getter (TBD...)
+func (v *TaskListScalingSignals) GetScheduleToStartLatencyInMs() (o int64) {
+	if v != nil {
+		return v.ScheduleToStartLatencyInMs
+	}
+	return
+}
+
+// GetPollerCount is an internal getter (TBD...)
+func (v *TaskListScalingSignals) GetPollerCount() (o int64) {
+	if v != nil {
+		return v.PollerCount
+	}
+	return
+}
+
+// TaskListScalingConfidence is an internal type (TBD...)
+type TaskListScalingConfidence int32
+
+// Ptr is a helper function for getting pointer value
+func (e TaskListScalingConfidence) Ptr() *TaskListScalingConfidence {
+	return &e
+}
+
+// String returns a readable string representation of TaskListScalingConfidence.
+func (e TaskListScalingConfidence) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "LOW"
+	case 1:
+		return "MEDIUM"
+	case 2:
+		return "HIGH"
+	}
+	return fmt.Sprintf("TaskListScalingConfidence(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *TaskListScalingConfidence) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "LOW":
+		*e = TaskListScalingConfidenceLow
+		return nil
+	case "MEDIUM":
+		*e = TaskListScalingConfidenceMedium
+		return nil
+	case "HIGH":
+		*e = TaskListScalingConfidenceHigh
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "TaskListScalingConfidence", err)
+		}
+		*e = TaskListScalingConfidence(val)
+		return nil
+	}
+}
+
+// MarshalText encodes TaskListScalingConfidence to text.
+func (e TaskListScalingConfidence) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// TaskListScalingConfidenceLow is an option for TaskListScalingConfidence
+	TaskListScalingConfidenceLow TaskListScalingConfidence = iota
+	// TaskListScalingConfidenceMedium is an option for TaskListScalingConfidence
+	TaskListScalingConfidenceMedium
+	// TaskListScalingConfidenceHigh is an option for TaskListScalingConfidence
+	TaskListScalingConfidenceHigh
+)
+
+// ListWorkersRequest is an internal type (TBD...)
+type ListWorkersRequest struct {
+	Domain string `json:"domain,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ListWorkersRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// ListWorkersResponse is an internal type (TBD...)
+type ListWorkersResponse struct {
+	Workers []*WorkerInfo `json:"workers,omitempty"`
+}
+
+// GetWorkers is an internal getter (TBD...)
+func (v *ListWorkersResponse) GetWorkers() (o []*WorkerInfo) {
+	if v != nil && v.Workers != nil {
+		return v.Workers
+	}
+	return
+}
+
 // ListWorkflowExecutionsRequest is an internal type (TBD...)
 type ListWorkflowExecutionsRequest struct {
 	Domain        string `json:"domain,omitempty"`
@@ -4194,46 +4835,104 @@ type PendingChildExecutionInfo struct {
 	ParentClosePolicy *ParentClosePolicy `json:"parentClosePolicy,omitempty"`
 }
 
-// GetDomain is an internal getter (TBD...)
-func (v *PendingChildExecutionInfo) GetDomain() (o string) {
+// GetDomain is an internal getter (TBD...)
+func (v *PendingChildExecutionInfo) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowID is an internal getter (TBD...)
+func (v *PendingChildExecutionInfo) GetWorkflowID() (o string) {
+	if v != nil {
+		return v.WorkflowID
+	}
+	return
+}
+
+// GetRunID is an internal getter (TBD...)
+func (v *PendingChildExecutionInfo) GetRunID() (o string) {
+	if v != nil {
+		return v.RunID
+	}
+	return
+}
+
+// GetWorkflowTypeName is an internal getter (TBD...)
+func (v *PendingChildExecutionInfo) GetWorkflowTypeName() (o string) {
+	if v != nil {
+		return v.WorkflowTypeName
+	}
+	return
+}
+
+// PendingDecisionInfo is an internal type (TBD...)
+type PendingDecisionInfo struct {
+	State                      *PendingDecisionState `json:"state,omitempty"`
+	ScheduledTimestamp         *int64                `json:"scheduledTimestamp,omitempty"`
+	StartedTimestamp           *int64                `json:"startedTimestamp,omitempty"`
+	Attempt                    int64                 `json:"attempt,omitempty"`
+	OriginalScheduledTimestamp *int64                `json:"originalScheduledTimestamp,omitempty"`
+	ScheduleID                 int64                 `json:"scheduleID,omitempty"`
+}
+
+// StickyExecutionInfo is an internal type (TBD...)
+type StickyExecutionInfo struct {
+	StickyTaskList                *TaskList `json:"stickyTaskList,omitempty"`
+	StickyHost                    string    `json:"stickyHost,omitempty"`
+	ScheduleToStartTimeoutSeconds int32     `json:"scheduleToStartTimeoutSeconds,omitempty"`
+	HitCount                      int64     `json:"hitCount,omitempty"`
+	FallbackCount                 int64     `json:"fallbackCount,omitempty"`
+	HitRate                       float64   `json:"hitRate,omitempty"`
+}
+
+// GetStickyTaskList is an internal getter (TBD...)
+func (v *StickyExecutionInfo) GetStickyTaskList() (o *TaskList) {
+	if v != nil && v.StickyTaskList != nil {
+		return v.StickyTaskList
+	}
+	return
+}
+
+// GetStickyHost is an internal getter (TBD...)
+func (v *StickyExecutionInfo) GetStickyHost() (o string) {
 	if v != nil {
-		return v.Domain
+		return v.StickyHost
 	}
 	return
 }
 
-// GetWorkflowID is an internal getter (TBD...)
-func (v *PendingChildExecutionInfo) GetWorkflowID() (o string) {
+// GetScheduleToStartTimeoutSeconds is an internal getter (TBD...)
+func (v *StickyExecutionInfo) GetScheduleToStartTimeoutSeconds() (o int32) {
 	if v != nil {
-		return v.WorkflowID
+		return v.ScheduleToStartTimeoutSeconds
 	}
 	return
 }
 
-// GetRunID is an internal getter (TBD...)
-func (v *PendingChildExecutionInfo) GetRunID() (o string) {
+// GetHitCount is an internal getter (TBD...)
+func (v *StickyExecutionInfo) GetHitCount() (o int64) {
 	if v != nil {
-		return v.RunID
+		return v.HitCount
 	}
 	return
 }
 
-// GetWorkflowTypeName is an internal getter (TBD...)
-func (v *PendingChildExecutionInfo) GetWorkflowTypeName() (o string) {
+// GetFallbackCount is an internal getter (TBD...)
+func (v *StickyExecutionInfo) GetFallbackCount() (o int64) {
 	if v != nil {
-		return v.WorkflowTypeName
+		return v.FallbackCount
 	}
 	return
 }
 
-// PendingDecisionInfo is an internal type (TBD...)
-type PendingDecisionInfo struct {
-	State                      *PendingDecisionState `json:"state,omitempty"`
-	ScheduledTimestamp         *int64                `json:"scheduledTimestamp,omitempty"`
-	StartedTimestamp           *int64                `json:"startedTimestamp,omitempty"`
-	Attempt                    int64                 `json:"attempt,omitempty"`
-	OriginalScheduledTimestamp *int64                `json:"originalScheduledTimestamp,omitempty"`
-	ScheduleID                 int64                 `json:"scheduleID,omitempty"`
+// GetHitRate is an internal getter (TBD...)
+func (v *StickyExecutionInfo) GetHitRate() (o float64) {
+	if v != nil {
+		return v.HitRate
+	}
+	return
 }
 
 // PendingDecisionState is an internal type (TBD...)
@@ -5271,6 +5970,16 @@ func (v *RequestCancelWorkflowExecutionRequest) ByteSize() uint64 {
 	return 0
 }
 
+// RequestCancelWorkflowExecutionAsyncRequest is an internal type (TBD...)
+type RequestCancelWorkflowExecutionAsyncRequest struct {
+	*RequestCancelWorkflowExecutionRequest
+}
+
+// RequestCancelWorkflowExecutionAsyncResponse is an internal type (TBD...)
+type RequestCancelWorkflowExecutionAsyncResponse struct {
+	RequestID string `json:"requestId,omitempty"`
+}
+
 // ResetPointInfo is an internal type (TBD...)
 type ResetPointInfo struct {
 	BinaryChecksum           string `json:"binaryChecksum,omitempty"`
@@ -5339,6 +6048,14 @@ type ResetPoints struct {
 	Points []*ResetPointInfo `json:"points,omitempty"`
 }
 
+// GetPoints is an internal getter (TBD...)
+func (v *ResetPoints) GetPoints() (o []*ResetPointInfo) {
+	if v != nil && v.Points != nil {
+		return v.Points
+	}
+	return
+}
+
 // Size returns the approximate memory used in bytes
 func (v *ResetPoints) ByteSize() uint64 {
 	return 0
@@ -5751,6 +6468,9 @@ type RespondDecisionTaskCompletedRequest struct {
 	ForceCreateNewDecisionTask bool                            `json:"forceCreateNewDecisionTask,omitempty"`
 	BinaryChecksum             string                          `json:"binaryChecksum,omitempty"`
 	QueryResults               map[string]*WorkflowQueryResult `json:"queryResults,omitempty"`
+	// EagerActivitySlots is the number of free activity slots the worker has for activities
+	// scheduled with RequestLocalDispatch. When unset, only the per-domain limit applies.
+	EagerActivitySlots *int32 `json:"eagerActivitySlots,omitempty"`
 }
 
 // GetIdentity is an internal getter (TBD...)
@@ -5793,6 +6513,14 @@ func (v *RespondDecisionTaskCompletedRequest) GetQueryResults() (o map[string]*W
 	return
 }
 
+// GetEagerActivitySlots is an internal getter (TBD...)
+func (v *RespondDecisionTaskCompletedRequest) GetEagerActivitySlots() (o int32) {
+	if v != nil && v.EagerActivitySlots != nil {
+		return *v.EagerActivitySlots
+	}
+	return
+}
+
 // Size returns the approximate memory used in bytes
 func (v *RespondDecisionTaskCompletedRequest) ByteSize() uint64 {
 	return 0
@@ -6256,6 +6984,7 @@ type SignalWithStartWorkflowExecutionRequest struct {
 	FirstRunAtTimestamp                 *int64                        `json:"firstRunAtTimestamp,omitempty"`
 	CronOverlapPolicy                   *CronOverlapPolicy            `json:"cronOverlapPolicy,omitempty"`
 	ActiveClusterSelectionPolicy        *ActiveClusterSelectionPolicy `json:"activeClusterSelectionPolicy,omitempty"`
+	CompletionCallbacks                 []*CompletionCallback         `json:"completionCallbacks,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -6354,6 +7083,14 @@ func (v *SignalWithStartWorkflowExecutionRequest) GetCronSchedule() (o string) {
 	return
 }
 
+// GetCompletionCallbacks is an internal getter (TBD...)
+func (v *SignalWithStartWorkflowExecutionRequest) GetCompletionCallbacks() (o []*CompletionCallback) {
+	if v != nil && v.CompletionCallbacks != nil {
+		return v.CompletionCallbacks
+	}
+	return
+}
+
 // SignalWithStartWorkflowExecutionAsyncRequest is an internal type (TBD...)
 type SignalWithStartWorkflowExecutionAsyncRequest struct {
 	*SignalWithStartWorkflowExecutionRequest
@@ -6361,6 +7098,7 @@ type SignalWithStartWorkflowExecutionAsyncRequest struct {
 
 // SignalWithStartWorkflowExecutionAsyncResponse is an internal type (TBD...)
 type SignalWithStartWorkflowExecutionAsyncResponse struct {
+	RequestID string `json:"requestId,omitempty"`
 }
 
 // SignalWorkflowExecutionRequest is an internal type (TBD...)
@@ -6422,6 +7160,16 @@ func (v *SignalWorkflowExecutionRequest) GetRequestID() (o string) {
 	return
 }
 
+// SignalWorkflowExecutionAsyncRequest is an internal type (TBD...)
+type SignalWorkflowExecutionAsyncRequest struct {
+	*SignalWorkflowExecutionRequest
+}
+
+// SignalWorkflowExecutionAsyncResponse is an internal type (TBD...)
+type SignalWorkflowExecutionAsyncResponse struct {
+	RequestID string `json:"requestId,omitempty"`
+}
+
 // StartChildWorkflowExecutionDecisionAttributes is an internal type (TBD...)
 type StartChildWorkflowExecutionDecisionAttributes struct {
 	Domain                              string                        `json:"domain,omitempty"`
@@ -6732,6 +7480,7 @@ type StartWorkflowExecutionRequest struct {
 	FirstRunAtTimeStamp                 *int64                        `json:"firstRunAtTimeStamp,omitempty"`
 	CronOverlapPolicy                   *CronOverlapPolicy            `json:"cronOverlapPolicy,omitempty"`
 	ActiveClusterSelectionPolicy        *ActiveClusterSelectionPolicy `json:"activeClusterSelectionPolicy,omitempty"`
+	CompletionCallbacks                 []*CompletionCallback         `json:"completionCallbacks,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -6822,6 +7571,14 @@ func (v *StartWorkflowExecutionRequest) GetCronOverlapPolicy() (o CronOverlapPol
 	return
 }
 
+// GetCompletionCallbacks is an internal getter (TBD...)
+func (v *StartWorkflowExecutionRequest) GetCompletionCallbacks() (o []*CompletionCallback) {
+	if v != nil && v.CompletionCallbacks != nil {
+		return v.CompletionCallbacks
+	}
+	return
+}
+
 // StartWorkflowExecutionResponse is an internal type (TBD...)
 type StartWorkflowExecutionResponse struct {
 	RunID string `json:"runId,omitempty"`
@@ -6840,6 +7597,7 @@ type StartWorkflowExecutionAsyncRequest struct {
 }
 
 type StartWorkflowExecutionAsyncResponse struct {
+	RequestID string `json:"requestId,omitempty"`
 }
 
 // RestartWorkflowExecutionResponse is an internal type (TBD...)
@@ -7009,16 +7767,27 @@ type IsolationGroupMetrics struct {
 	PollerCount       int64   `json:"pollerCount,omitempty"`
 }
 
+// BuildIDMetrics is an internal type (TBD...)
+// BacklogCountHint is shared by all build IDs of the same compatible set
+type BuildIDMetrics struct {
+	BacklogCountHint int64 `json:"backlogCountHint,omitempty"`
+	PollerCount      int64 `json:"pollerCount,omitempty"`
+}
+
 // TaskListStatus is an internal type (TBD...)
 type TaskListStatus struct {
-	BacklogCountHint      int64                             `json:"backlogCountHint,omitempty"`
-	ReadLevel             int64                             `json:"readLevel,omitempty"`
-	AckLevel              int64                             `json:"ackLevel,omitempty"`
-	RatePerSecond         float64                           `json:"ratePerSecond,omitempty"`
-	TaskIDBlock           *TaskIDBlock                      `json:"taskIDBlock,omitempty"`
-	IsolationGroupMetrics map[string]*IsolationGroupMetrics `json:"isolationGroupMetrics,omitempty"`
-	NewTasksPerSecond     float64                           `json:"newTasksPerSecond,omitempty"`
-	Empty                 bool                              `json:"empty,omitempty"`
+	BacklogCountHint           int64                             `json:"backlogCountHint,omitempty"`
+	ReadLevel                  int64                             `json:"readLevel,omitempty"`
+	AckLevel                   int64                             `json:"ackLevel,omitempty"`
+	RatePerSecond              float64                           `json:"ratePerSecond,omitempty"`
+	TaskIDBlock                *TaskIDBlock                      `json:"taskIDBlock,omitempty"`
+	IsolationGroupMetrics      map[string]*IsolationGroupMetrics `json:"isolationGroupMetrics,omitempty"`
+	NewTasksPerSecond          float64                           `json:"newTasksPerSecond,omitempty"`
+	Empty                      bool                              `json:"empty,omitempty"`
+	BuildIDMetrics             map[string]*BuildIDMetrics        `json:"buildIDMetrics,omitempty"`
+	DispatchedTasksPerSecond   float64                           `json:"dispatchedTasksPerSecond,omitempty"`
+	SyncMatchRatio             float64                           `json:"syncMatchRatio,omitempty"`
+	ScheduleToStartLatencyInMs int64                             `json:"scheduleToStartLatencyInMs,omitempty"`
 }
 
 // GetBacklogCountHint is an internal getter (TBD...)
@@ -7061,6 +7830,46 @@ func (v *TaskListStatus) GetTaskIDBlock() (o *TaskIDBlock) {
 	return
 }
 
+// GetBuildIDMetrics is an internal getter (TBD...)
+func (v *TaskListStatus) GetBuildIDMetrics() (o map[string]*BuildIDMetrics) {
+	if v != nil && v.BuildIDMetrics != nil {
+		return v.BuildIDMetrics
+	}
+	return
+}
+
+// GetNewTasksPerSecond is an internal getter (TBD...)
+func (v *TaskListStatus) GetNewTasksPerSecond() (o float64) {
+	if v != nil {
+		return v.NewTasksPerSecond
+	}
+	return
+}
+
+// GetDispatchedTasksPerSecond is an internal getter (TBD...)
+func (v *TaskListStatus) GetDispatchedTasksPerSecond() (o float64) {
+	if v != nil {
+		return v.DispatchedTasksPerSecond
+	}
+	return
+}
+
+// GetSyncMatchRatio is an internal getter (TBD...)
+func (v *TaskListStatus) GetSyncMatchRatio() (o float64) {
+	if v != nil {
+		return v.SyncMatchRatio
+	}
+	return
+}
+
+// GetScheduleToStartLatencyInMs is an internal getter (TBD...)
+func (v *TaskListStatus) GetScheduleToStartLatencyInMs() (o int64) {
+	if v != nil {
+		return v.ScheduleToStartLatencyInMs
+	}
+	return
+}
+
 // TaskListType is an internal type (TBD...)
 type TaskListType int32
 
@@ -7170,6 +7979,16 @@ func (v *TerminateWorkflowExecutionRequest) GetFirstExecutionRunID() (o string)
 	return
 }
 
+// TerminateWorkflowExecutionAsyncRequest is an internal type (TBD...)
+type TerminateWorkflowExecutionAsyncRequest struct {
+	*TerminateWorkflowExecutionRequest
+}
+
+// TerminateWorkflowExecutionAsyncResponse is an internal type (TBD...)
+type TerminateWorkflowExecutionAsyncResponse struct {
+	RequestID string `json:"requestId,omitempty"`
+}
+
 // TimeoutType is an internal type (TBD...)
 type TimeoutType int32
 
@@ -7403,6 +8222,99 @@ func (v *UpdateDomainResponse) GetIsGlobalDomain() (o bool) {
 	return
 }
 
+// UpdateWorkflowMemoRequest is an internal type (TBD...)
+type UpdateWorkflowMemoRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Memo              *Memo              `json:"-"` // Filtering PII
+	Identity          string             `json:"identity,omitempty"`
+	RequestID         string             `json:"requestId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UpdateWorkflowMemoRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *UpdateWorkflowMemoRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetMemo is an internal getter (TBD...)
+func (v *UpdateWorkflowMemoRequest) GetMemo() (o *Memo) {
+	if v != nil && v.Memo != nil {
+		return v.Memo
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *UpdateWorkflowMemoRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *UpdateWorkflowMemoRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// UpdateWorkflowMemoResponse is an internal type (TBD...)
+type UpdateWorkflowMemoResponse struct {
+}
+
+// UpsertWorkflowMemoDecisionAttributes is an internal type (TBD...)
+type UpsertWorkflowMemoDecisionAttributes struct {
+	Memo *Memo `json:"memo,omitempty"`
+}
+
+// GetMemo is an internal getter (TBD...)
+func (v *UpsertWorkflowMemoDecisionAttributes) GetMemo() (o *Memo) {
+	if v != nil && v.Memo != nil {
+		return v.Memo
+	}
+	return
+}
+
+// UpsertWorkflowMemoEventAttributes is an internal type (TBD...)
+type UpsertWorkflowMemoEventAttributes struct {
+	DecisionTaskCompletedEventID int64 `json:"decisionTaskCompletedEventId,omitempty"`
+	Memo                         *Memo `json:"memo,omitempty"`
+}
+
+// GetDecisionTaskCompletedEventID is an internal getter (TBD...)
+func (v *UpsertWorkflowMemoEventAttributes) GetDecisionTaskCompletedEventID() (o int64) {
+	if v != nil {
+		return v.DecisionTaskCompletedEventID
+	}
+	return
+}
+
+// GetMemo is an internal getter (TBD...)
+func (v *UpsertWorkflowMemoEventAttributes) GetMemo() (o *Memo) {
+	if v != nil && v.Memo != nil {
+		return v.Memo
+	}
+	return
+}
+
+// Size returns the approximate memory used in bytes
+func (v *UpsertWorkflowMemoEventAttributes) ByteSize() uint64 {
+	return 0
+}
+
 // UpsertWorkflowSearchAttributesDecisionAttributes is an internal type (TBD...)
 type UpsertWorkflowSearchAttributesDecisionAttributes struct {
 	SearchAttributes *SearchAttributes `json:"searchAttributes,omitempty"`
@@ -7499,6 +8411,87 @@ func (v *WorkerVersionInfo) GetFeatureVersion() (o string) {
 	return
 }
 
+// WorkerInfo is an internal type (TBD...)
+// It describes a worker that recently polled task lists of a domain, identified by its poller identity
+type WorkerInfo struct {
+	Identity       string                `json:"identity,omitempty"`
+	VersionInfo    *WorkerVersionInfo    `json:"versionInfo,omitempty"`
+	TaskLists      []*WorkerTaskListInfo `json:"taskLists,omitempty"`
+	LastPollTime   *int64                `json:"lastPollTime,omitempty"`
+	PollsPerSecond float64               `json:"pollsPerSecond,omitempty"`
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkerInfo) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetVersionInfo is an internal getter (TBD...)
+func (v *WorkerInfo) GetVersionInfo() (o *WorkerVersionInfo) {
+	if v != nil && v.VersionInfo != nil {
+		return v.VersionInfo
+	}
+	return
+}
+
+// GetTaskLists is an internal getter (TBD...)
+func (v *WorkerInfo) GetTaskLists() (o []*WorkerTaskListInfo) {
+	if v != nil && v.TaskLists != nil {
+		return v.TaskLists
+	}
+	return
+}
+
+// GetLastPollTime is an internal getter (TBD...)
+func (v *WorkerInfo) GetLastPollTime() (o int64) {
+	if v != nil && v.LastPollTime != nil {
+		return *v.LastPollTime
+	}
+	return
+}
+
+// GetPollsPerSecond is an internal getter (TBD...)
+func (v *WorkerInfo) GetPollsPerSecond() (o float64) {
+	if v != nil {
+		return v.PollsPerSecond
+	}
+	return
+}
+
+// WorkerTaskListInfo is an internal type (TBD...)
+type WorkerTaskListInfo struct {
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+	LastPollTime *int64        `json:"lastPollTime,omitempty"`
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *WorkerTaskListInfo) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *WorkerTaskListInfo) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// GetLastPollTime is an internal getter (TBD...)
+func (v *WorkerTaskListInfo) GetLastPollTime() (o int64) {
+	if v != nil && v.LastPollTime != nil {
+		return *v.LastPollTime
+	}
+	return
+}
+
 // WorkflowExecution is an internal type (TBD...)
 type WorkflowExecution struct {
 	WorkflowID string `json:"workflowId,omitempty"`
@@ -7845,6 +8838,42 @@ func (v *WorkflowExecutionInfo) GetPartitionConfig() (o map[string]string) {
 	return
 }
 
+// WorkflowExecutionMemoUpdatedEventAttributes is an internal type (TBD...)
+type WorkflowExecutionMemoUpdatedEventAttributes struct {
+	Memo      *Memo  `json:"memo,omitempty"`
+	Identity  string `json:"identity,omitempty"`
+	RequestID string `json:"requestId,omitempty"`
+}
+
+// GetMemo is an internal getter (TBD...)
+func (v *WorkflowExecutionMemoUpdatedEventAttributes) GetMemo() (o *Memo) {
+	if v != nil && v.Memo != nil {
+		return v.Memo
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *WorkflowExecutionMemoUpdatedEventAttributes) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *WorkflowExecutionMemoUpdatedEventAttributes) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// Size returns the approximate memory used in bytes
+func (v *WorkflowExecutionMemoUpdatedEventAttributes) ByteSize() uint64 {
+	return 0
+}
+
 // WorkflowExecutionSignaledEventAttributes is an internal type (TBD...)
 type WorkflowExecutionSignaledEventAttributes struct {
 	SignalName string `json:"signalName,omitempty"`
@@ -8799,6 +9828,56 @@ type AutoConfigHint struct {
 	PollerWaitTimeInMs int64 `json:"pollerWaitTimeInMs"`
 }
 
+// ScheduleToStartBreakdown describes where a task spent its time between being
+// scheduled and being started, as observed by the matching partition that dispatched it
+type ScheduleToStartBreakdown struct {
+	SyncMatched           bool  `json:"syncMatched,omitempty"`
+	Forwarded             bool  `json:"forwarded,omitempty"`
+	BacklogWaitTimeInMs   int64 `json:"backlogWaitTimeInMs,omitempty"`
+	RatelimitWaitTimeInMs int64 `json:"ratelimitWaitTimeInMs,omitempty"`
+	IsolationWaitTimeInMs int64 `json:"isolationWaitTimeInMs,omitempty"`
+}
+
+// GetSyncMatched is an internal getter (TBD...)
+func (v *ScheduleToStartBreakdown) GetSyncMatched() (o bool) {
+	if v != nil {
+		return v.SyncMatched
+	}
+	return
+}
+
+// GetForwarded is an internal getter (TBD...)
+func (v *ScheduleToStartBreakdown) GetForwarded() (o bool) {
+	if v != nil {
+		return v.Forwarded
+	}
+	return
+}
+
+// GetBacklogWaitTimeInMs is an internal getter (TBD...)
+func (v *ScheduleToStartBreakdown) GetBacklogWaitTimeInMs() (o int64) {
+	if v != nil {
+		return v.BacklogWaitTimeInMs
+	}
+	return
+}
+
+// GetRatelimitWaitTimeInMs is an internal getter (TBD...)
+func (v *ScheduleToStartBreakdown) GetRatelimitWaitTimeInMs() (o int64) {
+	if v != nil {
+		return v.RatelimitWaitTimeInMs
+	}
+	return
+}
+
+// GetIsolationWaitTimeInMs is an internal getter (TBD...)
+func (v *ScheduleToStartBreakdown) GetIsolationWaitTimeInMs() (o int64) {
+	if v != nil {
+		return v.IsolationWaitTimeInMs
+	}
+	return
+}
+
 type CronOverlapPolicy int32
 
 const (
@@ -234,6 +234,18 @@ var (
 	PollerInfoArray = []*types.PollerInfo{
 		&PollerInfo,
 	}
+	WorkerTaskListInfo = types.WorkerTaskListInfo{
+		TaskList:     &TaskList,
+		TaskListType: &TaskListType,
+		LastPollTime: &Timestamp1,
+	}
+	WorkerInfo = types.WorkerInfo{
+		Identity:       Identity,
+		VersionInfo:    &WorkerVersionInfo,
+		TaskLists:      []*types.WorkerTaskListInfo{&WorkerTaskListInfo},
+		LastPollTime:   &Timestamp1,
+		PollsPerSecond: RatePerSecond,
+	}
 	TaskListStatus = types.TaskListStatus{
 		BacklogCountHint: BacklogCountHint,
 		ReadLevel:        ReadLevel,
@@ -475,6 +487,33 @@ var (
 		EnableAutoConfig:   false,
 		PollerWaitTimeInMs: 10,
 	}
+	ScheduleToStartBreakdown = types.ScheduleToStartBreakdown{
+		SyncMatched:           false,
+		Forwarded:             true,
+		BacklogWaitTimeInMs:   100,
+		RatelimitWaitTimeInMs: 20,
+		IsolationWaitTimeInMs: 30,
+	}
+	StickyExecutionInfo = types.StickyExecutionInfo{
+		StickyTaskList:                &TaskList,
+		StickyHost:                    Identity,
+		ScheduleToStartTimeoutSeconds: 5,
+		HitCount:                      9,
+		FallbackCount:                 1,
+		HitRate:                       0.9,
+	}
+	CompletionCallback = types.CompletionCallback{
+		URL:     "https://callback.example.com/notify",
+		Headers: map[string]string{"Authorization": "Bearer token"},
+	}
+	CompletionCallbackInfo = types.CompletionCallbackInfo{
+		URL:                  "https://callback.example.com/notify",
+		State:                types.CompletionCallbackStateBackingOff.Ptr(),
+		Attempt:              Attempt,
+		LastAttemptTimestamp: &Timestamp1,
+		NextAttemptTimestamp: &Timestamp2,
+		LastFailureReason:    FailureReason,
+	}
 	TaskKey = types.TaskKey{
 		TaskID:            TaskID,
 		ScheduledTimeNano: Timestamp1,
@@ -94,6 +94,11 @@ var (
 		DecisionType: types.DecisionTypeUpsertWorkflowSearchAttributes.Ptr(),
 		UpsertWorkflowSearchAttributesDecisionAttributes: &UpsertWorkflowSearchAttributesDecisionAttributes,
 	}
+	// Decision_UpsertWorkflowMemo is not in DecisionArray, the decision is only carried over thrift
+	Decision_UpsertWorkflowMemo = types.Decision{
+		DecisionType:                         types.DecisionTypeUpsertWorkflowMemo.Ptr(),
+		UpsertWorkflowMemoDecisionAttributes: &UpsertWorkflowMemoDecisionAttributes,
+	}
 
 	CancelTimerDecisionAttributes = types.CancelTimerDecisionAttributes{
 		TimerID: TimerID,
@@ -189,4 +194,7 @@ var (
 	UpsertWorkflowSearchAttributesDecisionAttributes = types.UpsertWorkflowSearchAttributesDecisionAttributes{
 		SearchAttributes: &SearchAttributes,
 	}
+	UpsertWorkflowMemoDecisionAttributes = types.UpsertWorkflowMemoDecisionAttributes{
+		Memo: &Memo,
+	}
 )
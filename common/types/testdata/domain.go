@@ -129,4 +129,12 @@ var (
 		CompletedShardCount:     10,
 		PendingShards:           []int32{1, 2, 3},
 	}
+	DomainTaskQuota = types.DomainTaskQuota{
+		AddTaskRPSLimit:     100,
+		AddTaskRPS:          80.5,
+		AddTaskRejectedRPS:  0.5,
+		DispatchRPSLimit:    50,
+		DispatchRPS:         50,
+		DispatchRejectedRPS: 12.25,
+	}
 )
@@ -246,6 +246,15 @@ var (
 		e.EventType = types.EventTypeUpsertWorkflowSearchAttributes.Ptr()
 		e.UpsertWorkflowSearchAttributesEventAttributes = &UpsertWorkflowSearchAttributesEventAttributes
 	})
+	// the memo events are not in HistoryEventArray, they are only carried over thrift
+	HistoryEvent_UpsertWorkflowMemo = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeUpsertWorkflowMemo.Ptr()
+		e.UpsertWorkflowMemoEventAttributes = &UpsertWorkflowMemoEventAttributes
+	})
+	HistoryEvent_WorkflowExecutionMemoUpdated = generateEvent(func(e *types.HistoryEvent) {
+		e.EventType = types.EventTypeWorkflowExecutionMemoUpdated.Ptr()
+		e.WorkflowExecutionMemoUpdatedEventAttributes = &WorkflowExecutionMemoUpdatedEventAttributes
+	})
 
 	WorkflowExecutionStartedEventAttributes = types.WorkflowExecutionStartedEventAttributes{
 		WorkflowType:                        &WorkflowType,
@@ -582,6 +591,15 @@ var (
 		DecisionTaskCompletedEventID: EventID1,
 		SearchAttributes:             &SearchAttributes,
 	}
+	UpsertWorkflowMemoEventAttributes = types.UpsertWorkflowMemoEventAttributes{
+		DecisionTaskCompletedEventID: EventID1,
+		Memo:                         &Memo,
+	}
+	WorkflowExecutionMemoUpdatedEventAttributes = types.WorkflowExecutionMemoUpdatedEventAttributes{
+		Memo:      &Memo,
+		Identity:  Identity,
+		RequestID: RequestID,
+	}
 	GetFailoverInfoRequest = types.GetFailoverInfoRequest{
 		DomainID: uuid.NewUUID().String(),
 	}
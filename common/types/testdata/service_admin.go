@@ -270,4 +270,68 @@ var (
 		PartitionConfig: &TaskListPartitionConfig,
 	}
 	AdminUpdateTaskListPartitionConfigResponse = types.UpdateTaskListPartitionConfigResponse{}
+	AdminUpdateTaskListVersioningConfigRequest = types.UpdateTaskListVersioningConfigRequest{
+		Domain:   DomainName,
+		TaskList: &TaskList,
+		AddCompatibleBuildID: &types.AddCompatibleBuildID{
+			BuildID:                   "build-1.1",
+			ExistingCompatibleBuildID: "build-1",
+			MakeDefault:               true,
+		},
+	}
+	AdminUpdateTaskListVersioningConfigResponse = types.UpdateTaskListVersioningConfigResponse{
+		VersioningConfig: &TaskListVersioningConfig,
+	}
+	AdminMigrateTaskListRequest = types.MigrateTaskListRequest{
+		Domain:         DomainName,
+		TaskList:       &TaskList,
+		TaskListType:   &TaskListType,
+		TargetTaskList: &types.TaskList{Name: "target-task-list", Kind: types.TaskListKindNormal.Ptr()},
+		Alias:          true,
+	}
+	AdminMigrateTaskListResponse = types.MigrateTaskListResponse{
+		MigrationConfig: &TaskListMigrationConfig,
+		Partitions: []*types.TaskListMigrationPartitionStatus{
+			{
+				Partition:        TaskListName,
+				OwnerHostName:    HostName,
+				BacklogCountHint: 10,
+			},
+		},
+	}
+	AdminReadAsyncWorkflowDLQMessagesRequest = types.ReadAsyncWorkflowDLQMessagesRequest{
+		Domain:        DomainName,
+		PageSize:      PageSize,
+		NextPageToken: NextPageToken,
+	}
+	AdminReadAsyncWorkflowDLQMessagesResponse = types.ReadAsyncWorkflowDLQMessagesResponse{
+		Messages: []*types.AsyncWorkflowDLQMessage{
+			{
+				RequestID:       RequestID,
+				RequestType:     "StartWorkflowExecutionAsyncRequest",
+				WorkflowID:      WorkflowID,
+				Failure:         FailureReason,
+				CreatedTimeNano: &Timestamp1,
+			},
+		},
+		NextPageToken: NextPageToken,
+	}
+	AdminPurgeAsyncWorkflowDLQMessagesRequest = types.PurgeAsyncWorkflowDLQMessagesRequest{
+		Domain:     DomainName,
+		RequestIDs: []string{RequestID},
+	}
+	AdminReplayAsyncWorkflowDLQMessagesRequest = types.ReplayAsyncWorkflowDLQMessagesRequest{
+		Domain:     DomainName,
+		RequestIDs: []string{RequestID},
+	}
+	AdminDescribeAsyncWorkflowQueueRequest = types.DescribeAsyncWorkflowQueueRequest{
+		Domain: DomainName,
+	}
+	AdminDescribeAsyncWorkflowQueueResponse = types.DescribeAsyncWorkflowQueueResponse{
+		QueueID: "mapq::queue",
+		Partitions: []*types.AsyncWorkflowQueuePartition{
+			{Path: "*/./.", AckLevel: 3},
+			{Path: "*/" + DomainName + "/.", AckLevel: 7},
+		},
+	}
 )
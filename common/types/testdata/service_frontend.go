@@ -324,6 +324,12 @@ var (
 		RequestID:           RequestID,
 		FirstExecutionRunID: RunID,
 	}
+	RequestCancelWorkflowExecutionAsyncRequest = types.RequestCancelWorkflowExecutionAsyncRequest{
+		RequestCancelWorkflowExecutionRequest: &RequestCancelWorkflowExecutionRequest,
+	}
+	RequestCancelWorkflowExecutionAsyncResponse = types.RequestCancelWorkflowExecutionAsyncResponse{
+		RequestID: RequestID,
+	}
 	StartWorkflowExecutionRequest = types.StartWorkflowExecutionRequest{
 		Domain:                              DomainName,
 		WorkflowID:                          WorkflowID,
@@ -350,8 +356,10 @@ var (
 	StartWorkflowExecutionAsyncRequest = types.StartWorkflowExecutionAsyncRequest{
 		StartWorkflowExecutionRequest: &StartWorkflowExecutionRequest,
 	}
-	StartWorkflowExecutionAsyncResponse = types.StartWorkflowExecutionAsyncResponse{}
-	SignalWorkflowExecutionRequest      = types.SignalWorkflowExecutionRequest{
+	StartWorkflowExecutionAsyncResponse = types.StartWorkflowExecutionAsyncResponse{
+		RequestID: RequestID,
+	}
+	SignalWorkflowExecutionRequest = types.SignalWorkflowExecutionRequest{
 		Domain:            DomainName,
 		WorkflowExecution: &WorkflowExecution,
 		SignalName:        SignalName,
@@ -360,6 +368,12 @@ var (
 		RequestID:         RequestID,
 		Control:           Control,
 	}
+	SignalWorkflowExecutionAsyncRequest = types.SignalWorkflowExecutionAsyncRequest{
+		SignalWorkflowExecutionRequest: &SignalWorkflowExecutionRequest,
+	}
+	SignalWorkflowExecutionAsyncResponse = types.SignalWorkflowExecutionAsyncResponse{
+		RequestID: RequestID,
+	}
 	SignalWithStartWorkflowExecutionRequest = types.SignalWithStartWorkflowExecutionRequest{
 		Domain:                              DomainName,
 		WorkflowID:                          WorkflowID,
@@ -386,8 +400,10 @@ var (
 	SignalWithStartWorkflowExecutionAsyncRequest = types.SignalWithStartWorkflowExecutionAsyncRequest{
 		SignalWithStartWorkflowExecutionRequest: &SignalWithStartWorkflowExecutionRequest,
 	}
-	SignalWithStartWorkflowExecutionAsyncResponse = types.SignalWithStartWorkflowExecutionAsyncResponse{}
-	ResetWorkflowExecutionRequest                 = types.ResetWorkflowExecutionRequest{
+	SignalWithStartWorkflowExecutionAsyncResponse = types.SignalWithStartWorkflowExecutionAsyncResponse{
+		RequestID: RequestID,
+	}
+	ResetWorkflowExecutionRequest = types.ResetWorkflowExecutionRequest{
 		Domain:                DomainName,
 		WorkflowExecution:     &WorkflowExecution,
 		Reason:                Reason,
@@ -398,6 +414,14 @@ var (
 	ResetWorkflowExecutionResponse = types.ResetWorkflowExecutionResponse{
 		RunID: RunID,
 	}
+	UpdateWorkflowMemoRequest = types.UpdateWorkflowMemoRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		Memo:              &Memo,
+		Identity:          Identity,
+		RequestID:         RequestID,
+	}
+	UpdateWorkflowMemoResponse        = types.UpdateWorkflowMemoResponse{}
 	TerminateWorkflowExecutionRequest = types.TerminateWorkflowExecutionRequest{
 		Domain:              DomainName,
 		WorkflowExecution:   &WorkflowExecution,
@@ -406,6 +430,24 @@ var (
 		Identity:            Identity,
 		FirstExecutionRunID: RunID,
 	}
+	TerminateWorkflowExecutionAsyncRequest = types.TerminateWorkflowExecutionAsyncRequest{
+		TerminateWorkflowExecutionRequest: &TerminateWorkflowExecutionRequest,
+	}
+	TerminateWorkflowExecutionAsyncResponse = types.TerminateWorkflowExecutionAsyncResponse{
+		RequestID: RequestID,
+	}
+	DescribeAsyncRequestRequest = types.DescribeAsyncRequestRequest{
+		Domain:    DomainName,
+		RequestID: RequestID,
+	}
+	DescribeAsyncRequestResponse = types.DescribeAsyncRequestResponse{
+		RequestID:           RequestID,
+		State:               types.AsyncRequestStateSucceeded.Ptr(),
+		WorkflowExecution:   &WorkflowExecution,
+		Failure:             FailureReason,
+		CreatedTimeNano:     &Timestamp1,
+		LastUpdatedTimeNano: &Timestamp2,
+	}
 	DescribeWorkflowExecutionRequest = types.DescribeWorkflowExecutionRequest{
 		Domain:    DomainName,
 		Execution: &WorkflowExecution,
@@ -449,6 +491,36 @@ var (
 		PartitionConfig: &TaskListPartitionConfig,
 		TaskList:        &TaskList,
 	}
+	GetTaskListScalingRecommendationRequest = types.GetTaskListScalingRecommendationRequest{
+		Domain:       DomainName,
+		TaskList:     &TaskList,
+		TaskListType: &TaskListType,
+	}
+	GetTaskListScalingRecommendationResponse = types.GetTaskListScalingRecommendationResponse{
+		RecommendedPollerCount: 4,
+		Confidence:             types.TaskListScalingConfidenceMedium.Ptr(),
+		Signals: &types.TaskListScalingSignals{
+			BacklogCountHint:           BacklogCountHint,
+			AddTasksPerSecond:          20,
+			DispatchedTasksPerSecond:   10,
+			SyncMatchRatio:             0.5,
+			ScheduleToStartLatencyInMs: 1500,
+			PollerCount:                2,
+		},
+	}
+	ListWorkersRequest = types.ListWorkersRequest{
+		Domain: DomainName,
+	}
+	ListWorkersResponse = types.ListWorkersResponse{
+		Workers: []*types.WorkerInfo{&WorkerInfo},
+	}
+	DescribeWorkerRequest = types.DescribeWorkerRequest{
+		Domain:   DomainName,
+		Identity: Identity,
+	}
+	DescribeWorkerResponse = types.DescribeWorkerResponse{
+		Worker: &WorkerInfo,
+	}
 	ListTaskListPartitionsRequest = types.ListTaskListPartitionsRequest{
 		Domain:   DomainName,
 		TaskList: &TaskList,
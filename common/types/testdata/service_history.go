@@ -126,12 +126,13 @@ var (
 	}
 	HistoryRecordActivityTaskHeartbeatResponse = RecordActivityTaskHeartbeatResponse
 	HistoryRecordActivityTaskStartedRequest    = types.RecordActivityTaskStartedRequest{
-		DomainUUID:        DomainID,
-		WorkflowExecution: &WorkflowExecution,
-		ScheduleID:        EventID1,
-		TaskID:            TaskID,
-		RequestID:         RequestID,
-		PollRequest:       &PollForActivityTaskRequest,
+		DomainUUID:               DomainID,
+		WorkflowExecution:        &WorkflowExecution,
+		ScheduleID:               EventID1,
+		TaskID:                   TaskID,
+		RequestID:                RequestID,
+		PollRequest:              &PollForActivityTaskRequest,
+		ScheduleToStartBreakdown: &ScheduleToStartBreakdown,
 	}
 	HistoryRecordActivityTaskStartedResponse = types.RecordActivityTaskStartedResponse{
 		ScheduledEvent:                  &HistoryEvent_WorkflowExecutionStarted,
@@ -298,6 +299,27 @@ var (
 		ExternalWorkflowExecution: &WorkflowExecution,
 		ChildWorkflowOnly:         true,
 	}
+	HistoryUpdateWorkflowMemoRequest = types.HistoryUpdateWorkflowMemoRequest{
+		DomainUUID:    DomainID,
+		UpdateRequest: &UpdateWorkflowMemoRequest,
+	}
+	HistoryGetCompletionCallbacksRequest = types.GetCompletionCallbacksRequest{
+		DomainUUID:        DomainID,
+		WorkflowExecution: &WorkflowExecution,
+	}
+	HistoryGetCompletionCallbacksResponse = types.GetCompletionCallbacksResponse{
+		Callbacks:     []*types.CompletionCallback{&CompletionCallback},
+		WorkflowType:  &WorkflowType,
+		CloseStatus:   &WorkflowExecutionCloseStatus,
+		CloseTime:     &Timestamp1,
+		HistoryLength: HistoryLength,
+	}
+	HistoryRecordCompletionCallbackStateRequest = types.RecordCompletionCallbackStateRequest{
+		DomainUUID:        DomainID,
+		WorkflowExecution: &WorkflowExecution,
+		Index:             1,
+		State:             &CompletionCallbackInfo,
+	}
 	HistoryGetCrossClusterTasksRequest               = GetCrossClusterTasksRequest
 	HistoryGetCrossClusterTasksResponse              = GetCrossClusterTasksResponse
 	HistoryRespondCrossClusterTasksCompletedRequest  = RespondCrossClusterTasksCompletedRequest
@@ -47,6 +47,26 @@ var (
 			},
 		},
 	}
+	TaskListVersioningConfig = types.TaskListVersioningConfig{
+		Version: 2,
+		CompatibleSets: []*types.CompatibleBuildIDSet{
+			{BuildIDs: []string{"build-1", "build-1.1"}},
+			{BuildIDs: []string{"build-2"}},
+		},
+	}
+	TaskListMigrationConfig = types.TaskListMigrationConfig{
+		TargetTaskList: "target-task-list",
+		Alias:          true,
+	}
+	BuildIDMetricsMap = map[string]*types.BuildIDMetrics{
+		"build-1": {
+			BacklogCountHint: 10,
+			PollerCount:      2,
+		},
+		"build-2": {
+			PollerCount: 1,
+		},
+	}
 	LoadBalancerHints = types.LoadBalancerHints{
 		BacklogCount:  1000,
 		RatePerSecond: 1.0,
@@ -209,4 +229,41 @@ var (
 		TaskListType:    &TaskListType,
 		PartitionConfig: &TaskListPartitionConfig,
 	}
+
+	MatchingUpdateTaskListVersioningConfigRequest = types.MatchingUpdateTaskListVersioningConfigRequest{
+		DomainUUID:    DomainID,
+		UpdateRequest: &AdminUpdateTaskListVersioningConfigRequest,
+	}
+
+	MatchingUpdateTaskListVersioningConfigResponse = types.MatchingUpdateTaskListVersioningConfigResponse{
+		VersioningConfig: &TaskListVersioningConfig,
+	}
+
+	MatchingMigrateTaskListRequest = types.MatchingMigrateTaskListRequest{
+		DomainUUID:     DomainID,
+		MigrateRequest: &AdminMigrateTaskListRequest,
+	}
+
+	MatchingGetTaskListScalingRecommendationRequest = types.MatchingGetTaskListScalingRecommendationRequest{
+		DomainUUID: DomainID,
+		Request:    &GetTaskListScalingRecommendationRequest,
+	}
+
+	MatchingListWorkersRequest = types.MatchingListWorkersRequest{
+		DomainUUID: DomainID,
+		Request:    &ListWorkersRequest,
+	}
+
+	MatchingDescribeWorkerRequest = types.MatchingDescribeWorkerRequest{
+		DomainUUID: DomainID,
+		Request:    &DescribeWorkerRequest,
+	}
+
+	MatchingDescribeDomainTaskQuotaRequest = types.MatchingDescribeDomainTaskQuotaRequest{
+		DomainUUID: DomainID,
+	}
+
+	MatchingDescribeDomainTaskQuotaResponse = types.MatchingDescribeDomainTaskQuotaResponse{
+		TaskQuota: &DomainTaskQuota,
+	}
 )
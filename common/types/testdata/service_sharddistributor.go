@@ -33,7 +33,69 @@ var (
 		Owner:     "owner",
 		Namespace: "namespace",
 	}
-	ShardDistributorExecutorHeartbeatRequest = types.ExecutorHeartbeatRequest{
+	ShardDistributorWatchNamespaceStateRequest = types.WatchNamespaceStateRequest{
+		Namespace: "namespace",
+		Revision:  5,
+	}
+	ShardDistributorWatchNamespaceStateResponse = types.WatchNamespaceStateResponse{
+		Namespace: "namespace",
+		Revision:  6,
+		Owners:    []string{"owner-1", "owner-2"},
+	}
+	ShardDistributorListNamespacesRequest  = types.ListNamespacesRequest{}
+	ShardDistributorListNamespacesResponse = types.ListNamespacesResponse{
+		Namespaces: []*types.NamespaceInfo{
+			{Name: "namespace", Type: "fixed", ShardNum: 32},
+		},
+	}
+	ShardDistributorDescribeNamespaceRequest = types.DescribeNamespaceRequest{
+		Namespace: "namespace",
+	}
+	ShardDistributorDescribeNamespaceResponse = types.DescribeNamespaceResponse{
+		Namespace: "namespace",
+		Executors: []*types.ExecutorInfo{
+			{
+				ExecutorID:    "executor-id",
+				State:         "ACTIVE",
+				LastHeartbeat: Timestamp1,
+				Drained:       true,
+				AssignedShards: []*types.ShardAssignmentInfo{
+					{ShardKey: "shard-key-1", AssignedAt: Timestamp2, Status: "running", ShardLoad: 0.5},
+				},
+			},
+		},
+		RebalancingFrozen: true,
+		ShardMoves: []*types.ShardMoveInfo{
+			{ShardKey: "shard-key-2", ExecutorID: "executor-id", RequestedAt: Timestamp3},
+		},
+		AuditLog: []*types.NamespaceAuditEntry{
+			{Time: Timestamp4, Identity: "identity", Operation: "freeze rebalancing", Reason: "reason"},
+		},
+	}
+	ShardDistributorMoveShardRequest = types.MoveShardRequest{
+		Namespace:  "namespace",
+		ShardKey:   "shard-key",
+		ExecutorID: "executor-id",
+		Identity:   "identity",
+		Reason:     "reason",
+	}
+	ShardDistributorMoveShardResponse         = types.MoveShardResponse{}
+	ShardDistributorSetExecutorDrainedRequest = types.SetExecutorDrainedRequest{
+		Namespace:  "namespace",
+		ExecutorID: "executor-id",
+		Drained:    true,
+		Identity:   "identity",
+		Reason:     "reason",
+	}
+	ShardDistributorSetExecutorDrainedResponse  = types.SetExecutorDrainedResponse{}
+	ShardDistributorSetRebalancingFrozenRequest = types.SetRebalancingFrozenRequest{
+		Namespace: "namespace",
+		Frozen:    true,
+		Identity:  "identity",
+		Reason:    "reason",
+	}
+	ShardDistributorSetRebalancingFrozenResponse = types.SetRebalancingFrozenResponse{}
+	ShardDistributorExecutorHeartbeatRequest     = types.ExecutorHeartbeatRequest{
 		Namespace:  "namespace",
 		ExecutorID: "executor-id",
 		Status:     types.ExecutorStatusACTIVE,
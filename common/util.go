@@ -793,6 +793,14 @@ func GetSizeOfHistoryEvent(event *types.HistoryEvent) uint64 {
 		if event.UpsertWorkflowSearchAttributesEventAttributes.SearchAttributes != nil {
 			res += GetSizeOfMapStringToByteArray(event.UpsertWorkflowSearchAttributesEventAttributes.SearchAttributes.IndexedFields)
 		}
+	case types.EventTypeUpsertWorkflowMemo:
+		if event.UpsertWorkflowMemoEventAttributes.Memo != nil {
+			res += GetSizeOfMapStringToByteArray(event.UpsertWorkflowMemoEventAttributes.Memo.Fields)
+		}
+	case types.EventTypeWorkflowExecutionMemoUpdated:
+		if event.WorkflowExecutionMemoUpdatedEventAttributes.Memo != nil {
+			res += GetSizeOfMapStringToByteArray(event.WorkflowExecutionMemoUpdatedEventAttributes.Memo.Fields)
+		}
 	}
 	return uint64(res)
 }
@@ -1472,6 +1472,26 @@ func TestGetSizeOfHistoryEvent(t *testing.T) {
 			},
 			want: someMapStringToByteArraySize,
 		},
+		types.EventTypeUpsertWorkflowMemo: {
+			event: &types.HistoryEvent{
+				UpsertWorkflowMemoEventAttributes: &types.UpsertWorkflowMemoEventAttributes{
+					Memo: &types.Memo{
+						Fields: someMapStringToByteArray, // 66 bytes
+					},
+				},
+			},
+			want: someMapStringToByteArraySize,
+		},
+		types.EventTypeWorkflowExecutionMemoUpdated: {
+			event: &types.HistoryEvent{
+				WorkflowExecutionMemoUpdatedEventAttributes: &types.WorkflowExecutionMemoUpdatedEventAttributes{
+					Memo: &types.Memo{
+						Fields: someMapStringToByteArray, // 66 bytes
+					},
+				},
+			},
+			want: someMapStringToByteArraySize,
+		},
 	} {
 		t.Run(eventType.String(), func(t *testing.T) {
 			if c.event != nil {
@@ -0,0 +1,18 @@
+CREATE TABLE async_request_queue (
+  queue_name VARCHAR(255) NOT NULL,
+  message_id BIGINT NOT NULL,
+  --
+  payload MEDIUMBLOB NOT NULL,
+  created_time DATETIME(6) NOT NULL,
+  PRIMARY KEY (queue_name, message_id)
+);
+
+CREATE TABLE async_request_queue_metadata (
+  queue_name VARCHAR(255) NOT NULL,
+  --
+  ack_level BIGINT NOT NULL,
+  lease_owner VARCHAR(255) NOT NULL,
+  lease_expiry DATETIME(6) NOT NULL,
+  version BIGINT NOT NULL,
+  PRIMARY KEY (queue_name)
+);
@@ -0,0 +1,23 @@
+CREATE TABLE shard_distributor_namespaces (
+  namespace VARCHAR(255) NOT NULL,
+  --
+  leader_id VARCHAR(255) NOT NULL,
+  lease_expiry TIMESTAMP NOT NULL,
+  leader_version BIGINT NOT NULL,
+  revision BIGINT NOT NULL,
+  PRIMARY KEY (namespace)
+);
+
+CREATE TABLE shard_distributor_executors (
+  namespace VARCHAR(255) NOT NULL,
+  executor_id VARCHAR(255) NOT NULL,
+  --
+  last_heartbeat TIMESTAMP NOT NULL,
+  state VARCHAR(64) NOT NULL,
+  reported_shards BYTEA,
+  reported_shards_encoding VARCHAR(16) NOT NULL,
+  assigned_shards BYTEA,
+  assigned_shards_encoding VARCHAR(16) NOT NULL,
+  assigned_revision BIGINT NOT NULL,
+  PRIMARY KEY (namespace, executor_id)
+);
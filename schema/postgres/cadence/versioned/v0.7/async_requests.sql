@@ -0,0 +1,25 @@
+CREATE TABLE async_requests (
+  domain_id CHAR(64) NOT NULL,
+  request_id VARCHAR(255) NOT NULL,
+  --
+  workflow_id TEXT NOT NULL,
+  run_id CHAR(64) NOT NULL,
+  state INTEGER NOT NULL,
+  failure TEXT NOT NULL,
+  created_time TIMESTAMP NOT NULL,
+  last_updated_time TIMESTAMP NOT NULL,
+  expiry_time TIMESTAMP NOT NULL,
+  PRIMARY KEY (domain_id, request_id)
+);
+
+CREATE TABLE async_request_dlq (
+  domain_id CHAR(64) NOT NULL,
+  request_id VARCHAR(255) NOT NULL,
+  --
+  request_type INTEGER NOT NULL,
+  workflow_id TEXT NOT NULL,
+  failure TEXT NOT NULL,
+  payload BYTEA NOT NULL,
+  created_time TIMESTAMP NOT NULL,
+  PRIMARY KEY (domain_id, request_id)
+);
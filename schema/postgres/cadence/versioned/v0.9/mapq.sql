@@ -0,0 +1,17 @@
+CREATE TABLE mapq_items (
+  queue_id VARCHAR(255) NOT NULL,
+  partition_path VARCHAR(255) NOT NULL,
+  item_offset BIGINT NOT NULL,
+  --
+  payload BYTEA NOT NULL,
+  PRIMARY KEY (queue_id, partition_path, item_offset)
+);
+
+CREATE TABLE mapq_state (
+  queue_id VARCHAR(255) NOT NULL,
+  --
+  state BYTEA NOT NULL,
+  state_encoding VARCHAR(16) NOT NULL,
+  version BIGINT NOT NULL,
+  PRIMARY KEY (queue_id)
+);
@@ -299,3 +299,98 @@ CREATE TABLE cluster_config
     data_encoding VARCHAR(16) NOT NULL,
     PRIMARY KEY (row_type, version)
 );
+
+CREATE TABLE async_requests
+(
+    domain_id         CHAR(64)     NOT NULL,
+    request_id        VARCHAR(255) NOT NULL,
+    --
+    workflow_id       VARCHAR(255) NOT NULL,
+    run_id            CHAR(64)     NOT NULL,
+    state             INT          NOT NULL,
+    failure           TEXT         NOT NULL,
+    created_time      DATETIME(6)  NOT NULL,
+    last_updated_time DATETIME(6)  NOT NULL,
+    expiry_time       DATETIME(6)  NOT NULL,
+    PRIMARY KEY (domain_id, request_id)
+);
+
+CREATE TABLE async_request_dlq
+(
+    domain_id    CHAR(64)     NOT NULL,
+    request_id   VARCHAR(255) NOT NULL,
+    --
+    request_type INT          NOT NULL,
+    workflow_id  VARCHAR(255) NOT NULL,
+    failure      TEXT         NOT NULL,
+    payload      MEDIUMBLOB   NOT NULL,
+    created_time DATETIME(6)  NOT NULL,
+    PRIMARY KEY (domain_id, request_id)
+);
+
+CREATE TABLE async_request_queue
+(
+    queue_name   VARCHAR(255) NOT NULL,
+    message_id   BIGINT       NOT NULL,
+    --
+    payload      MEDIUMBLOB   NOT NULL,
+    created_time DATETIME(6)  NOT NULL,
+    PRIMARY KEY (queue_name, message_id)
+);
+
+CREATE TABLE async_request_queue_metadata
+(
+    queue_name   VARCHAR(255) NOT NULL,
+    --
+    ack_level    BIGINT       NOT NULL,
+    lease_owner  VARCHAR(255) NOT NULL,
+    lease_expiry DATETIME(6)  NOT NULL,
+    version      BIGINT       NOT NULL,
+    PRIMARY KEY (queue_name)
+);
+
+CREATE TABLE mapq_items
+(
+    queue_id       VARCHAR(255) NOT NULL,
+    partition_path VARCHAR(255) NOT NULL,
+    item_offset    BIGINT       NOT NULL,
+    --
+    payload        MEDIUMBLOB   NOT NULL,
+    PRIMARY KEY (queue_id, partition_path, item_offset)
+);
+
+CREATE TABLE mapq_state
+(
+    queue_id       VARCHAR(255) NOT NULL,
+    --
+    state          MEDIUMBLOB   NOT NULL,
+    state_encoding VARCHAR(16)  NOT NULL,
+    version        BIGINT       NOT NULL,
+    PRIMARY KEY (queue_id)
+);
+
+CREATE TABLE shard_distributor_namespaces
+(
+    namespace      VARCHAR(255) NOT NULL,
+    --
+    leader_id      VARCHAR(255) NOT NULL,
+    lease_expiry   DATETIME(6)  NOT NULL,
+    leader_version BIGINT       NOT NULL,
+    revision       BIGINT       NOT NULL,
+    PRIMARY KEY (namespace)
+);
+
+CREATE TABLE shard_distributor_executors
+(
+    namespace                VARCHAR(255) NOT NULL,
+    executor_id              VARCHAR(255) NOT NULL,
+    --
+    last_heartbeat           DATETIME(6)  NOT NULL,
+    state                    VARCHAR(64)  NOT NULL,
+    reported_shards          MEDIUMBLOB,
+    reported_shards_encoding VARCHAR(16)  NOT NULL,
+    assigned_shards          MEDIUMBLOB,
+    assigned_shards_encoding VARCHAR(16)  NOT NULL,
+    assigned_revision        BIGINT       NOT NULL,
+    PRIMARY KEY (namespace, executor_id)
+);
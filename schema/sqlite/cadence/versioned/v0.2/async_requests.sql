@@ -0,0 +1,27 @@
+CREATE TABLE async_requests
+(
+    domain_id         CHAR(64)     NOT NULL,
+    request_id        VARCHAR(255) NOT NULL,
+    --
+    workflow_id       VARCHAR(255) NOT NULL,
+    run_id            CHAR(64)     NOT NULL,
+    state             INT          NOT NULL,
+    failure           TEXT         NOT NULL,
+    created_time      DATETIME(6)  NOT NULL,
+    last_updated_time DATETIME(6)  NOT NULL,
+    expiry_time       DATETIME(6)  NOT NULL,
+    PRIMARY KEY (domain_id, request_id)
+);
+
+CREATE TABLE async_request_dlq
+(
+    domain_id    CHAR(64)     NOT NULL,
+    request_id   VARCHAR(255) NOT NULL,
+    --
+    request_type INT          NOT NULL,
+    workflow_id  VARCHAR(255) NOT NULL,
+    failure      TEXT         NOT NULL,
+    payload      MEDIUMBLOB   NOT NULL,
+    created_time DATETIME(6)  NOT NULL,
+    PRIMARY KEY (domain_id, request_id)
+);
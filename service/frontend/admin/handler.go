@@ -32,7 +32,9 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/.gen/go/sqlblobs"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/asyncworkflow/queue/provider"
 	"github.com/uber/cadence/common/asyncworkflow/queueconfigapi"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/client"
@@ -52,6 +54,7 @@ import (
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/api"
 	"github.com/uber/cadence/service/frontend/config"
 	"github.com/uber/cadence/service/frontend/validate"
 	"github.com/uber/cadence/service/history/execution"
@@ -61,6 +64,7 @@ import (
 const (
 	getDomainReplicationMessageBatchSize = 100
 	defaultLastMessageID                 = int64(-1)
+	asyncWorkflowDLQPageSize             = 100
 )
 
 type (
@@ -78,6 +82,7 @@ type (
 		throttleRetry         *backoff.ThrottleRetry
 		isolationGroups       isolationgroupapi.Handler
 		asyncWFQueueConfigs   queueconfigapi.Handler
+		producerManager       api.ProducerManager
 	}
 
 	workflowQueryTemplate struct {
@@ -151,6 +156,13 @@ func NewHandler(
 		),
 		isolationGroups:     isolationgroupapi.New(resource.GetLogger(), resource.GetIsolationGroupStore(), domainHandler),
 		asyncWFQueueConfigs: queueconfigapi.New(resource.GetLogger(), domainHandler),
+		producerManager: api.NewProducerManager(
+			resource.GetDomainCache(),
+			resource.GetAsyncWorkflowQueueProvider(),
+			resource.GetPersistenceBean().GetAsyncRequestManager(),
+			resource.GetLogger(),
+			resource.GetMetricsClient(),
+		),
 	}
 }
 
@@ -1823,6 +1835,291 @@ func (adh *adminHandlerImpl) UpdateTaskListPartitionConfig(ctx context.Context,
 	return &types.UpdateTaskListPartitionConfigResponse{}, nil
 }
 
+func (adh *adminHandlerImpl) UpdateTaskListVersioningConfig(ctx context.Context, request *types.UpdateTaskListVersioningConfigRequest) (_ *types.UpdateTaskListVersioningConfigResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.UpdateTaskListVersioningConfig)
+	defer sw.Stop()
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.Domain)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.TaskList == nil {
+		return nil, adh.error(validate.ErrTaskListNotSet, scope)
+	}
+	if request.TaskList.GetKind() != types.TaskListKindNormal {
+		return nil, adh.error(&types.BadRequestError{Message: "Only normal tasklist's versioning config can be updated."}, scope)
+	}
+	resp, err := adh.GetMatchingClient().UpdateTaskListVersioningConfig(ctx, &types.MatchingUpdateTaskListVersioningConfigRequest{
+		DomainUUID:    domainID,
+		UpdateRequest: request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &types.UpdateTaskListVersioningConfigResponse{
+		VersioningConfig: resp.GetVersioningConfig(),
+	}, nil
+}
+
+func (adh *adminHandlerImpl) MigrateTaskList(ctx context.Context, request *types.MigrateTaskListRequest) (_ *types.MigrateTaskListResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.MigrateTaskList)
+	defer sw.Stop()
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.Domain)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.TaskList == nil {
+		return nil, adh.error(validate.ErrTaskListNotSet, scope)
+	}
+	if request.TaskList.GetKind() != types.TaskListKindNormal {
+		return nil, adh.error(&types.BadRequestError{Message: "Only normal tasklists can be migrated."}, scope)
+	}
+	if request.TaskListType == nil {
+		return nil, adh.error(&types.BadRequestError{Message: "Task list type not set."}, scope)
+	}
+	resp, err := adh.GetMatchingClient().MigrateTaskList(ctx, &types.MatchingMigrateTaskListRequest{
+		DomainUUID:     domainID,
+		MigrateRequest: request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// ReadAsyncWorkflowDLQMessages returns a page of the async workflow requests of a domain that failed processing
+func (adh *adminHandlerImpl) ReadAsyncWorkflowDLQMessages(ctx context.Context, request *types.ReadAsyncWorkflowDLQMessagesRequest) (_ *types.ReadAsyncWorkflowDLQMessagesResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.ReadAsyncWorkflowDLQMessages)
+	defer sw.Stop()
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(validate.ErrDomainNotSet, scope)
+	}
+	if request.PageSize <= 0 {
+		return nil, adh.error(&types.BadRequestError{Message: "Invalid PageSize."}, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	resp, err := adh.GetPersistenceBean().GetAsyncRequestManager().ReadAsyncRequestDLQ(ctx, &persistence.ReadAsyncRequestDLQRequest{
+		DomainID:      domainID,
+		PageSize:      int(request.PageSize),
+		NextPageToken: request.NextPageToken,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	messages := make([]*types.AsyncWorkflowDLQMessage, 0, len(resp.Entries))
+	for _, entry := range resp.Entries {
+		messages = append(messages, &types.AsyncWorkflowDLQMessage{
+			RequestID:       entry.RequestID,
+			RequestType:     sqlblobs.AsyncRequestType(entry.RequestType).String(),
+			WorkflowID:      entry.WorkflowID,
+			Failure:         entry.Failure,
+			CreatedTimeNano: common.Int64Ptr(entry.CreatedTime.UnixNano()),
+		})
+	}
+	return &types.ReadAsyncWorkflowDLQMessagesResponse{
+		Messages:      messages,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+// PurgeAsyncWorkflowDLQMessages drops the given requests, or all requests if none is given, from the DLQ of a domain
+func (adh *adminHandlerImpl) PurgeAsyncWorkflowDLQMessages(ctx context.Context, request *types.PurgeAsyncWorkflowDLQMessagesRequest) (_ *types.PurgeAsyncWorkflowDLQMessagesResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.PurgeAsyncWorkflowDLQMessages)
+	defer sw.Stop()
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(validate.ErrDomainNotSet, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	requestIDs := request.RequestIDs
+	if len(requestIDs) == 0 {
+		entries, err := adh.listAsyncWorkflowDLQEntries(ctx, domainID)
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		for _, entry := range entries {
+			requestIDs = append(requestIDs, entry.RequestID)
+		}
+	}
+	for _, requestID := range requestIDs {
+		if err := adh.GetPersistenceBean().GetAsyncRequestManager().DeleteAsyncRequestDLQEntry(ctx, &persistence.DeleteAsyncRequestDLQEntryRequest{
+			DomainID:  domainID,
+			RequestID: requestID,
+		}); err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+	return &types.PurgeAsyncWorkflowDLQMessagesResponse{}, nil
+}
+
+// ReplayAsyncWorkflowDLQMessages publishes the given requests, or all requests if none is given, from the DLQ of a domain
+// back to the async workflow queue of the domain. Replayed requests are removed from the DLQ.
+func (adh *adminHandlerImpl) ReplayAsyncWorkflowDLQMessages(ctx context.Context, request *types.ReplayAsyncWorkflowDLQMessagesRequest) (_ *types.ReplayAsyncWorkflowDLQMessagesResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.ReplayAsyncWorkflowDLQMessages)
+	defer sw.Stop()
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(validate.ErrDomainNotSet, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	producer, err := adh.producerManager.GetProducerByDomain(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	asyncRequestManager := adh.GetPersistenceBean().GetAsyncRequestManager()
+	var entries []*persistence.AsyncRequestDLQEntry
+	if len(request.RequestIDs) == 0 {
+		entries, err = adh.listAsyncWorkflowDLQEntries(ctx, domainID)
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+	for _, requestID := range request.RequestIDs {
+		resp, err := asyncRequestManager.GetAsyncRequestDLQEntry(ctx, &persistence.GetAsyncRequestDLQEntryRequest{
+			DomainID:  domainID,
+			RequestID: requestID,
+		})
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		entries = append(entries, resp.Entry)
+	}
+
+	decoder := codec.NewThriftRWEncoder()
+	for _, entry := range entries {
+		var message sqlblobs.AsyncRequestMessage
+		if err := decoder.Decode(entry.Payload, &message); err != nil {
+			return nil, adh.error(fmt.Errorf("failed to decode DLQ message %v: %w", entry.RequestID, err), scope)
+		}
+		if err := producer.Publish(ctx, &message); err != nil {
+			return nil, adh.error(err, scope)
+		}
+		adh.markAsyncRequestQueued(ctx, domainID, entry.RequestID)
+		if err := asyncRequestManager.DeleteAsyncRequestDLQEntry(ctx, &persistence.DeleteAsyncRequestDLQEntryRequest{
+			DomainID:  domainID,
+			RequestID: entry.RequestID,
+		}); err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+	return &types.ReplayAsyncWorkflowDLQMessagesResponse{}, nil
+}
+
+// DescribeAsyncWorkflowQueue returns the ID of the async workflow queue of a domain and, for queues that support it,
+// the committed offsets of its partitions
+func (adh *adminHandlerImpl) DescribeAsyncWorkflowQueue(ctx context.Context, request *types.DescribeAsyncWorkflowQueueRequest) (_ *types.DescribeAsyncWorkflowQueueResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.DescribeAsyncWorkflowQueue)
+	defer sw.Stop()
+	if request == nil {
+		return nil, adh.error(validate.ErrRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(validate.ErrDomainNotSet, scope)
+	}
+	domainEntry, err := adh.GetDomainCache().GetDomain(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	cfg := domainEntry.GetConfig().AsyncWorkflowConfig
+	var queue provider.Queue
+	switch {
+	case cfg.PredefinedQueueName != "":
+		queue, err = adh.GetAsyncWorkflowQueueProvider().GetPredefinedQueue(cfg.PredefinedQueueName)
+	case cfg.QueueType != "":
+		queue, err = adh.GetAsyncWorkflowQueueProvider().GetQueue(cfg.QueueType, cfg.QueueConfig)
+	default:
+		err = &types.BadRequestError{Message: fmt.Sprintf("async workflow queue is not configured for domain %v", request.GetDomain())}
+	}
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp := &types.DescribeAsyncWorkflowQueueResponse{QueueID: queue.ID()}
+	if describer, ok := queue.(provider.Describer); ok {
+		resp.Partitions, err = describer.Describe(ctx, &provider.Params{
+			Logger:              adh.GetLogger(),
+			MetricsClient:       adh.GetMetricsClient(),
+			AsyncRequestManager: adh.GetPersistenceBean().GetAsyncRequestManager(),
+			MapQManager:         adh.GetPersistenceBean().GetMapQManager(),
+		})
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+	return resp, nil
+}
+
+func (adh *adminHandlerImpl) listAsyncWorkflowDLQEntries(ctx context.Context, domainID string) ([]*persistence.AsyncRequestDLQEntry, error) {
+	var entries []*persistence.AsyncRequestDLQEntry
+	var pageToken []byte
+	for {
+		resp, err := adh.GetPersistenceBean().GetAsyncRequestManager().ReadAsyncRequestDLQ(ctx, &persistence.ReadAsyncRequestDLQRequest{
+			DomainID:      domainID,
+			PageSize:      asyncWorkflowDLQPageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, resp.Entries...)
+		if len(resp.NextPageToken) == 0 {
+			return entries, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+// markAsyncRequestQueued resets the status of a replayed request if it is still tracked
+func (adh *adminHandlerImpl) markAsyncRequestQueued(ctx context.Context, domainID, requestID string) {
+	asyncRequestManager := adh.GetPersistenceBean().GetAsyncRequestManager()
+	resp, err := asyncRequestManager.GetAsyncRequest(ctx, &persistence.GetAsyncRequestRequest{
+		DomainID:  domainID,
+		RequestID: requestID,
+	})
+	if err != nil {
+		var notExistsErr *types.EntityNotExistsError
+		if !errors.As(err, &notExistsErr) {
+			adh.GetLogger().Warn("Failed to get async request status", tag.WorkflowDomainID(domainID), tag.WorkflowRequestID(requestID), tag.Error(err))
+		}
+		return
+	}
+	info := resp.Request
+	info.State = types.AsyncRequestStateQueued
+	info.Failure = ""
+	if err := asyncRequestManager.UpsertAsyncRequest(ctx, &persistence.UpsertAsyncRequestRequest{Request: info}); err != nil {
+		adh.GetLogger().Warn("Failed to update async request status", tag.WorkflowDomainID(domainID), tag.WorkflowRequestID(requestID), tag.Error(err))
+	}
+}
+
 func convertFromDataBlob(blob *types.DataBlob) (interface{}, error) {
 	switch *blob.EncodingType {
 	case types.EncodingTypeJSON:
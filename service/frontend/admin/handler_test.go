@@ -36,13 +36,17 @@ import (
 	"github.com/uber-go/tally"
 	"go.uber.org/mock/gomock"
 
+	"github.com/uber/cadence/.gen/go/sqlblobs"
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/asyncworkflow/queue"
+	"github.com/uber/cadence/common/asyncworkflow/queue/provider"
 	"github.com/uber/cadence/common/asyncworkflow/queueconfigapi"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig"
@@ -51,12 +55,15 @@ import (
 	"github.com/uber/cadence/common/isolationgroup/isolationgroupapi"
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
+	persistenceClient "github.com/uber/cadence/common/persistence/client"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/api"
 	frontendcfg "github.com/uber/cadence/service/frontend/config"
 	"github.com/uber/cadence/service/frontend/validate"
 )
@@ -2952,3 +2959,688 @@ func TestUpdateTaskListPartitionConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateTaskListVersioningConfig(t *testing.T) {
+	domainName := "domain-name"
+	domainID := "domain-id"
+	taskListName := "task-list"
+	stickyKind := types.TaskListKindSticky
+	versioningConfig := &types.TaskListVersioningConfig{
+		Version:        1,
+		CompatibleSets: []*types.CompatibleBuildIDSet{{BuildIDs: []string{"build-1"}}},
+	}
+
+	testCases := []struct {
+		name          string
+		req           *types.UpdateTaskListVersioningConfigRequest
+		setupMocks    func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache)
+		expected      *types.UpdateTaskListVersioningConfigResponse
+		expectedError string
+	}{
+		{
+			name: "success",
+			req: &types.UpdateTaskListVersioningConfigRequest{
+				Domain:               domainName,
+				TaskList:             &types.TaskList{Name: taskListName},
+				AddNewDefaultBuildID: "build-1",
+			},
+			setupMocks: func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID(domainName).Return(domainID, nil)
+				mockMatchingClient.EXPECT().UpdateTaskListVersioningConfig(gomock.Any(), &types.MatchingUpdateTaskListVersioningConfigRequest{
+					DomainUUID: domainID,
+					UpdateRequest: &types.UpdateTaskListVersioningConfigRequest{
+						Domain:               domainName,
+						TaskList:             &types.TaskList{Name: taskListName},
+						AddNewDefaultBuildID: "build-1",
+					},
+				}).Return(&types.MatchingUpdateTaskListVersioningConfigResponse{VersioningConfig: versioningConfig}, nil)
+			},
+			expected: &types.UpdateTaskListVersioningConfigResponse{VersioningConfig: versioningConfig},
+		},
+		{
+			name:          "request not set",
+			req:           nil,
+			setupMocks:    func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {},
+			expectedError: validate.ErrRequestNotSet.Error(),
+		},
+		{
+			name: "domain cache error",
+			req: &types.UpdateTaskListVersioningConfigRequest{
+				Domain:   domainName,
+				TaskList: &types.TaskList{Name: taskListName},
+			},
+			setupMocks: func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID(domainName).Return("", errors.New("domain cache error"))
+			},
+			expectedError: "domain cache error",
+		},
+		{
+			name: "task list not set",
+			req: &types.UpdateTaskListVersioningConfigRequest{
+				Domain: domainName,
+			},
+			setupMocks: func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID(domainName).Return(domainID, nil)
+			},
+			expectedError: validate.ErrTaskListNotSet.Error(),
+		},
+		{
+			name: "sticky task list",
+			req: &types.UpdateTaskListVersioningConfigRequest{
+				Domain:   domainName,
+				TaskList: &types.TaskList{Name: taskListName, Kind: &stickyKind},
+			},
+			setupMocks: func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID(domainName).Return(domainID, nil)
+			},
+			expectedError: "Only normal tasklist's versioning config can be updated.",
+		},
+		{
+			name: "matching client error",
+			req: &types.UpdateTaskListVersioningConfigRequest{
+				Domain:         domainName,
+				TaskList:       &types.TaskList{Name: taskListName},
+				PromoteBuildID: "build-1",
+			},
+			setupMocks: func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID(domainName).Return(domainID, nil)
+				mockMatchingClient.EXPECT().UpdateTaskListVersioningConfig(gomock.Any(), gomock.Any()).Return(nil, errors.New("matching client error"))
+			},
+			expectedError: "matching client error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDomainCache := cache.NewMockDomainCache(ctrl)
+			mockMatchingClient := matching.NewMockClient(ctrl)
+			tc.setupMocks(mockMatchingClient, mockDomainCache)
+			adh := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:         testlogger.New(t),
+					MetricsClient:  metrics.NewNoopMetricsClient(),
+					DomainCache:    mockDomainCache,
+					MatchingClient: mockMatchingClient,
+				},
+			}
+
+			resp, err := adh.UpdateTaskListVersioningConfig(context.Background(), tc.req)
+
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, resp)
+			}
+		})
+	}
+}
+
+func TestMigrateTaskList(t *testing.T) {
+	domainName := "domain-name"
+	domainID := "domain-id"
+	taskListName := "task-list"
+	stickyKind := types.TaskListKindSticky
+	migrateResponse := &types.MigrateTaskListResponse{
+		MigrationConfig: &types.TaskListMigrationConfig{TargetTaskList: "target-task-list"},
+		Partitions: []*types.TaskListMigrationPartitionStatus{
+			{Partition: taskListName, OwnerHostName: "host", BacklogCountHint: 10},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		req           *types.MigrateTaskListRequest
+		setupMocks    func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache)
+		expected      *types.MigrateTaskListResponse
+		expectedError string
+	}{
+		{
+			name: "success",
+			req: &types.MigrateTaskListRequest{
+				Domain:         domainName,
+				TaskList:       &types.TaskList{Name: taskListName},
+				TaskListType:   types.TaskListTypeActivity.Ptr(),
+				TargetTaskList: &types.TaskList{Name: "target-task-list"},
+			},
+			setupMocks: func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID(domainName).Return(domainID, nil)
+				mockMatchingClient.EXPECT().MigrateTaskList(gomock.Any(), &types.MatchingMigrateTaskListRequest{
+					DomainUUID: domainID,
+					MigrateRequest: &types.MigrateTaskListRequest{
+						Domain:         domainName,
+						TaskList:       &types.TaskList{Name: taskListName},
+						TaskListType:   types.TaskListTypeActivity.Ptr(),
+						TargetTaskList: &types.TaskList{Name: "target-task-list"},
+					},
+				}).Return(migrateResponse, nil)
+			},
+			expected: migrateResponse,
+		},
+		{
+			name:          "request not set",
+			req:           nil,
+			setupMocks:    func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {},
+			expectedError: validate.ErrRequestNotSet.Error(),
+		},
+		{
+			name: "domain cache error",
+			req: &types.MigrateTaskListRequest{
+				Domain:   domainName,
+				TaskList: &types.TaskList{Name: taskListName},
+			},
+			setupMocks: func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID(domainName).Return("", errors.New("domain cache error"))
+			},
+			expectedError: "domain cache error",
+		},
+		{
+			name: "task list not set",
+			req: &types.MigrateTaskListRequest{
+				Domain: domainName,
+			},
+			setupMocks: func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID(domainName).Return(domainID, nil)
+			},
+			expectedError: validate.ErrTaskListNotSet.Error(),
+		},
+		{
+			name: "sticky task list",
+			req: &types.MigrateTaskListRequest{
+				Domain:   domainName,
+				TaskList: &types.TaskList{Name: taskListName, Kind: &stickyKind},
+			},
+			setupMocks: func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID(domainName).Return(domainID, nil)
+			},
+			expectedError: "Only normal tasklists can be migrated.",
+		},
+		{
+			name: "task list type not set",
+			req: &types.MigrateTaskListRequest{
+				Domain:   domainName,
+				TaskList: &types.TaskList{Name: taskListName},
+			},
+			setupMocks: func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID(domainName).Return(domainID, nil)
+			},
+			expectedError: "Task list type not set.",
+		},
+		{
+			name: "matching client error",
+			req: &types.MigrateTaskListRequest{
+				Domain:       domainName,
+				TaskList:     &types.TaskList{Name: taskListName},
+				TaskListType: types.TaskListTypeDecision.Ptr(),
+				Stop:         true,
+			},
+			setupMocks: func(mockMatchingClient *matching.MockClient, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID(domainName).Return(domainID, nil)
+				mockMatchingClient.EXPECT().MigrateTaskList(gomock.Any(), gomock.Any()).Return(nil, errors.New("matching client error"))
+			},
+			expectedError: "matching client error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDomainCache := cache.NewMockDomainCache(ctrl)
+			mockMatchingClient := matching.NewMockClient(ctrl)
+			tc.setupMocks(mockMatchingClient, mockDomainCache)
+			adh := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:         testlogger.New(t),
+					MetricsClient:  metrics.NewNoopMetricsClient(),
+					DomainCache:    mockDomainCache,
+					MatchingClient: mockMatchingClient,
+				},
+			}
+
+			resp, err := adh.MigrateTaskList(context.Background(), tc.req)
+
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, resp)
+			}
+		})
+	}
+}
+
+func TestReadAsyncWorkflowDLQMessages(t *testing.T) {
+	domainName := "domain-name"
+	domainID := "domain-id"
+	createdTime := time.Unix(0, 100)
+
+	testCases := []struct {
+		name          string
+		req           *types.ReadAsyncWorkflowDLQMessagesRequest
+		setupMocks    func(mockAsyncRequestMgr *persistence.MockAsyncRequestManager, mockDomainCache *cache.MockDomainCache)
+		expected      *types.ReadAsyncWorkflowDLQMessagesResponse
+		expectedError string
+	}{
+		{
+			name: "success",
+			req:  &types.ReadAsyncWorkflowDLQMessagesRequest{Domain: domainName, PageSize: 10, NextPageToken: []byte("token")},
+			setupMocks: func(mockAsyncRequestMgr *persistence.MockAsyncRequestManager, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID(domainName).Return(domainID, nil)
+				mockAsyncRequestMgr.EXPECT().ReadAsyncRequestDLQ(gomock.Any(), &persistence.ReadAsyncRequestDLQRequest{
+					DomainID:      domainID,
+					PageSize:      10,
+					NextPageToken: []byte("token"),
+				}).Return(&persistence.ReadAsyncRequestDLQResponse{
+					Entries: []*persistence.AsyncRequestDLQEntry{
+						{
+							DomainID:    domainID,
+							RequestID:   "request-id",
+							RequestType: int32(sqlblobs.AsyncRequestTypeStartWorkflowExecutionAsyncRequest),
+							WorkflowID:  "workflow-id",
+							Failure:     "bad request",
+							CreatedTime: createdTime,
+						},
+					},
+					NextPageToken: []byte("next-token"),
+				}, nil)
+			},
+			expected: &types.ReadAsyncWorkflowDLQMessagesResponse{
+				Messages: []*types.AsyncWorkflowDLQMessage{
+					{
+						RequestID:       "request-id",
+						RequestType:     sqlblobs.AsyncRequestTypeStartWorkflowExecutionAsyncRequest.String(),
+						WorkflowID:      "workflow-id",
+						Failure:         "bad request",
+						CreatedTimeNano: common.Int64Ptr(100),
+					},
+				},
+				NextPageToken: []byte("next-token"),
+			},
+		},
+		{
+			name:          "request not set",
+			setupMocks:    func(*persistence.MockAsyncRequestManager, *cache.MockDomainCache) {},
+			expectedError: validate.ErrRequestNotSet.Error(),
+		},
+		{
+			name:          "domain not set",
+			req:           &types.ReadAsyncWorkflowDLQMessagesRequest{PageSize: 10},
+			setupMocks:    func(*persistence.MockAsyncRequestManager, *cache.MockDomainCache) {},
+			expectedError: validate.ErrDomainNotSet.Error(),
+		},
+		{
+			name:          "invalid page size",
+			req:           &types.ReadAsyncWorkflowDLQMessagesRequest{Domain: domainName},
+			setupMocks:    func(*persistence.MockAsyncRequestManager, *cache.MockDomainCache) {},
+			expectedError: "Invalid PageSize.",
+		},
+		{
+			name: "persistence error",
+			req:  &types.ReadAsyncWorkflowDLQMessagesRequest{Domain: domainName, PageSize: 10},
+			setupMocks: func(mockAsyncRequestMgr *persistence.MockAsyncRequestManager, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID(domainName).Return(domainID, nil)
+				mockAsyncRequestMgr.EXPECT().ReadAsyncRequestDLQ(gomock.Any(), gomock.Any()).Return(nil, errors.New("persistence error"))
+			},
+			expectedError: "persistence error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDomainCache := cache.NewMockDomainCache(ctrl)
+			mockAsyncRequestMgr := persistence.NewMockAsyncRequestManager(ctrl)
+			mockPersistenceBean := persistenceClient.NewMockBean(ctrl)
+			mockPersistenceBean.EXPECT().GetAsyncRequestManager().Return(mockAsyncRequestMgr).AnyTimes()
+			tc.setupMocks(mockAsyncRequestMgr, mockDomainCache)
+			adh := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:          testlogger.New(t),
+					MetricsClient:   metrics.NewNoopMetricsClient(),
+					DomainCache:     mockDomainCache,
+					PersistenceBean: mockPersistenceBean,
+				},
+			}
+
+			resp, err := adh.ReadAsyncWorkflowDLQMessages(context.Background(), tc.req)
+
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, resp)
+			}
+		})
+	}
+}
+
+func TestPurgeAsyncWorkflowDLQMessages(t *testing.T) {
+	domainName := "domain-name"
+	domainID := "domain-id"
+
+	testCases := []struct {
+		name          string
+		req           *types.PurgeAsyncWorkflowDLQMessagesRequest
+		setupMocks    func(mockAsyncRequestMgr *persistence.MockAsyncRequestManager, mockDomainCache *cache.MockDomainCache)
+		expectedError string
+	}{
+		{
+			name: "purge given requests",
+			req:  &types.PurgeAsyncWorkflowDLQMessagesRequest{Domain: domainName, RequestIDs: []string{"request-1", "request-2"}},
+			setupMocks: func(mockAsyncRequestMgr *persistence.MockAsyncRequestManager, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID(domainName).Return(domainID, nil)
+				mockAsyncRequestMgr.EXPECT().DeleteAsyncRequestDLQEntry(gomock.Any(), &persistence.DeleteAsyncRequestDLQEntryRequest{DomainID: domainID, RequestID: "request-1"}).Return(nil)
+				mockAsyncRequestMgr.EXPECT().DeleteAsyncRequestDLQEntry(gomock.Any(), &persistence.DeleteAsyncRequestDLQEntryRequest{DomainID: domainID, RequestID: "request-2"}).Return(nil)
+			},
+		},
+		{
+			name: "purge all requests",
+			req:  &types.PurgeAsyncWorkflowDLQMessagesRequest{Domain: domainName},
+			setupMocks: func(mockAsyncRequestMgr *persistence.MockAsyncRequestManager, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID(domainName).Return(domainID, nil)
+				gomock.InOrder(
+					mockAsyncRequestMgr.EXPECT().ReadAsyncRequestDLQ(gomock.Any(), &persistence.ReadAsyncRequestDLQRequest{
+						DomainID: domainID,
+						PageSize: asyncWorkflowDLQPageSize,
+					}).Return(&persistence.ReadAsyncRequestDLQResponse{
+						Entries:       []*persistence.AsyncRequestDLQEntry{{DomainID: domainID, RequestID: "request-1"}},
+						NextPageToken: []byte("token"),
+					}, nil),
+					mockAsyncRequestMgr.EXPECT().ReadAsyncRequestDLQ(gomock.Any(), &persistence.ReadAsyncRequestDLQRequest{
+						DomainID:      domainID,
+						PageSize:      asyncWorkflowDLQPageSize,
+						NextPageToken: []byte("token"),
+					}).Return(&persistence.ReadAsyncRequestDLQResponse{
+						Entries: []*persistence.AsyncRequestDLQEntry{{DomainID: domainID, RequestID: "request-2"}},
+					}, nil),
+					mockAsyncRequestMgr.EXPECT().DeleteAsyncRequestDLQEntry(gomock.Any(), &persistence.DeleteAsyncRequestDLQEntryRequest{DomainID: domainID, RequestID: "request-1"}).Return(nil),
+					mockAsyncRequestMgr.EXPECT().DeleteAsyncRequestDLQEntry(gomock.Any(), &persistence.DeleteAsyncRequestDLQEntryRequest{DomainID: domainID, RequestID: "request-2"}).Return(nil),
+				)
+			},
+		},
+		{
+			name:          "request not set",
+			setupMocks:    func(*persistence.MockAsyncRequestManager, *cache.MockDomainCache) {},
+			expectedError: validate.ErrRequestNotSet.Error(),
+		},
+		{
+			name:          "domain not set",
+			req:           &types.PurgeAsyncWorkflowDLQMessagesRequest{},
+			setupMocks:    func(*persistence.MockAsyncRequestManager, *cache.MockDomainCache) {},
+			expectedError: validate.ErrDomainNotSet.Error(),
+		},
+		{
+			name: "delete error",
+			req:  &types.PurgeAsyncWorkflowDLQMessagesRequest{Domain: domainName, RequestIDs: []string{"request-1"}},
+			setupMocks: func(mockAsyncRequestMgr *persistence.MockAsyncRequestManager, mockDomainCache *cache.MockDomainCache) {
+				mockDomainCache.EXPECT().GetDomainID(domainName).Return(domainID, nil)
+				mockAsyncRequestMgr.EXPECT().DeleteAsyncRequestDLQEntry(gomock.Any(), gomock.Any()).Return(errors.New("persistence error"))
+			},
+			expectedError: "persistence error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDomainCache := cache.NewMockDomainCache(ctrl)
+			mockAsyncRequestMgr := persistence.NewMockAsyncRequestManager(ctrl)
+			mockPersistenceBean := persistenceClient.NewMockBean(ctrl)
+			mockPersistenceBean.EXPECT().GetAsyncRequestManager().Return(mockAsyncRequestMgr).AnyTimes()
+			tc.setupMocks(mockAsyncRequestMgr, mockDomainCache)
+			adh := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:          testlogger.New(t),
+					MetricsClient:   metrics.NewNoopMetricsClient(),
+					DomainCache:     mockDomainCache,
+					PersistenceBean: mockPersistenceBean,
+				},
+			}
+
+			_, err := adh.PurgeAsyncWorkflowDLQMessages(context.Background(), tc.req)
+
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestReplayAsyncWorkflowDLQMessages(t *testing.T) {
+	domainName := "domain-name"
+	domainID := "domain-id"
+	message := &sqlblobs.AsyncRequestMessage{
+		PartitionKey: common.StringPtr("workflow-id"),
+		Type:         sqlblobs.AsyncRequestTypeStartWorkflowExecutionAsyncRequest.Ptr(),
+		Payload:      []byte("payload"),
+		RequestID:    common.StringPtr("request-1"),
+		DomainID:     common.StringPtr(domainID),
+	}
+	payload, err := codec.NewThriftRWEncoder().Encode(message)
+	require.NoError(t, err)
+	entry := &persistence.AsyncRequestDLQEntry{
+		DomainID:  domainID,
+		RequestID: "request-1",
+		Payload:   payload,
+	}
+
+	testCases := []struct {
+		name          string
+		req           *types.ReplayAsyncWorkflowDLQMessagesRequest
+		setupMocks    func(mockAsyncRequestMgr *persistence.MockAsyncRequestManager, mockProducer *messaging.MockProducer)
+		expectedError string
+	}{
+		{
+			name: "replay tracked request",
+			req:  &types.ReplayAsyncWorkflowDLQMessagesRequest{Domain: domainName, RequestIDs: []string{"request-1"}},
+			setupMocks: func(mockAsyncRequestMgr *persistence.MockAsyncRequestManager, mockProducer *messaging.MockProducer) {
+				gomock.InOrder(
+					mockAsyncRequestMgr.EXPECT().GetAsyncRequestDLQEntry(gomock.Any(), &persistence.GetAsyncRequestDLQEntryRequest{DomainID: domainID, RequestID: "request-1"}).
+						Return(&persistence.GetAsyncRequestDLQEntryResponse{Entry: entry}, nil),
+					mockProducer.EXPECT().Publish(gomock.Any(), message).Return(nil),
+					mockAsyncRequestMgr.EXPECT().GetAsyncRequest(gomock.Any(), &persistence.GetAsyncRequestRequest{DomainID: domainID, RequestID: "request-1"}).
+						Return(&persistence.GetAsyncRequestResponse{Request: &persistence.AsyncRequestInfo{
+							DomainID:  domainID,
+							RequestID: "request-1",
+							State:     types.AsyncRequestStateFailed,
+							Failure:   "bad request",
+						}}, nil),
+					mockAsyncRequestMgr.EXPECT().UpsertAsyncRequest(gomock.Any(), &persistence.UpsertAsyncRequestRequest{Request: &persistence.AsyncRequestInfo{
+						DomainID:  domainID,
+						RequestID: "request-1",
+						State:     types.AsyncRequestStateQueued,
+					}}).Return(nil),
+					mockAsyncRequestMgr.EXPECT().DeleteAsyncRequestDLQEntry(gomock.Any(), &persistence.DeleteAsyncRequestDLQEntryRequest{DomainID: domainID, RequestID: "request-1"}).Return(nil),
+				)
+			},
+		},
+		{
+			name: "replay all requests",
+			req:  &types.ReplayAsyncWorkflowDLQMessagesRequest{Domain: domainName},
+			setupMocks: func(mockAsyncRequestMgr *persistence.MockAsyncRequestManager, mockProducer *messaging.MockProducer) {
+				gomock.InOrder(
+					mockAsyncRequestMgr.EXPECT().ReadAsyncRequestDLQ(gomock.Any(), &persistence.ReadAsyncRequestDLQRequest{
+						DomainID: domainID,
+						PageSize: asyncWorkflowDLQPageSize,
+					}).Return(&persistence.ReadAsyncRequestDLQResponse{Entries: []*persistence.AsyncRequestDLQEntry{entry}}, nil),
+					mockProducer.EXPECT().Publish(gomock.Any(), message).Return(nil),
+					mockAsyncRequestMgr.EXPECT().GetAsyncRequest(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{}),
+					mockAsyncRequestMgr.EXPECT().DeleteAsyncRequestDLQEntry(gomock.Any(), &persistence.DeleteAsyncRequestDLQEntryRequest{DomainID: domainID, RequestID: "request-1"}).Return(nil),
+				)
+			},
+		},
+		{
+			name: "entry not found",
+			req:  &types.ReplayAsyncWorkflowDLQMessagesRequest{Domain: domainName, RequestIDs: []string{"request-1"}},
+			setupMocks: func(mockAsyncRequestMgr *persistence.MockAsyncRequestManager, mockProducer *messaging.MockProducer) {
+				mockAsyncRequestMgr.EXPECT().GetAsyncRequestDLQEntry(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{Message: "not found"})
+			},
+			expectedError: "not found",
+		},
+		{
+			name: "publish error keeps the entry",
+			req:  &types.ReplayAsyncWorkflowDLQMessagesRequest{Domain: domainName, RequestIDs: []string{"request-1"}},
+			setupMocks: func(mockAsyncRequestMgr *persistence.MockAsyncRequestManager, mockProducer *messaging.MockProducer) {
+				mockAsyncRequestMgr.EXPECT().GetAsyncRequestDLQEntry(gomock.Any(), gomock.Any()).Return(&persistence.GetAsyncRequestDLQEntryResponse{Entry: entry}, nil)
+				mockProducer.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(errors.New("publish error"))
+			},
+			expectedError: "publish error",
+		},
+		{
+			name: "corrupted payload",
+			req:  &types.ReplayAsyncWorkflowDLQMessagesRequest{Domain: domainName, RequestIDs: []string{"request-1"}},
+			setupMocks: func(mockAsyncRequestMgr *persistence.MockAsyncRequestManager, mockProducer *messaging.MockProducer) {
+				mockAsyncRequestMgr.EXPECT().GetAsyncRequestDLQEntry(gomock.Any(), gomock.Any()).Return(&persistence.GetAsyncRequestDLQEntryResponse{
+					Entry: &persistence.AsyncRequestDLQEntry{DomainID: domainID, RequestID: "request-1", Payload: []byte("corrupted")},
+				}, nil)
+			},
+			expectedError: "failed to decode DLQ message request-1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDomainCache := cache.NewMockDomainCache(ctrl)
+			mockDomainCache.EXPECT().GetDomainID(domainName).Return(domainID, nil)
+			mockAsyncRequestMgr := persistence.NewMockAsyncRequestManager(ctrl)
+			mockPersistenceBean := persistenceClient.NewMockBean(ctrl)
+			mockPersistenceBean.EXPECT().GetAsyncRequestManager().Return(mockAsyncRequestMgr).AnyTimes()
+			mockProducer := messaging.NewMockProducer(ctrl)
+			mockProducerManager := api.NewMockProducerManager(ctrl)
+			mockProducerManager.EXPECT().GetProducerByDomain(domainName).Return(mockProducer, nil)
+			tc.setupMocks(mockAsyncRequestMgr, mockProducer)
+			adh := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:          testlogger.New(t),
+					MetricsClient:   metrics.NewNoopMetricsClient(),
+					DomainCache:     mockDomainCache,
+					PersistenceBean: mockPersistenceBean,
+				},
+				producerManager: mockProducerManager,
+			}
+
+			_, err := adh.ReplayAsyncWorkflowDLQMessages(context.Background(), tc.req)
+
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+type describableQueue struct {
+	*provider.MockQueue
+	*provider.MockDescriber
+}
+
+func TestDescribeAsyncWorkflowQueue(t *testing.T) {
+	domainName := "domain-name"
+	partitions := []*types.AsyncWorkflowQueuePartition{{Path: "*/./.", AckLevel: 3}}
+	domainEntry := func(cfg types.AsyncWorkflowConfiguration) *cache.DomainCacheEntry {
+		return cache.NewLocalDomainCacheEntryForTest(
+			&persistence.DomainInfo{ID: "domain-id", Name: domainName},
+			&persistence.DomainConfig{AsyncWorkflowConfig: cfg},
+			"active",
+		)
+	}
+
+	testCases := []struct {
+		name          string
+		req           *types.DescribeAsyncWorkflowQueueRequest
+		setupMocks    func(ctrl *gomock.Controller, mockDomainCache *cache.MockDomainCache, mockProvider *queue.MockProvider)
+		expected      *types.DescribeAsyncWorkflowQueueResponse
+		expectedError string
+	}{
+		{
+			name: "predefined queue with partitions",
+			req:  &types.DescribeAsyncWorkflowQueueRequest{Domain: domainName},
+			setupMocks: func(ctrl *gomock.Controller, mockDomainCache *cache.MockDomainCache, mockProvider *queue.MockProvider) {
+				mockDomainCache.EXPECT().GetDomain(domainName).Return(domainEntry(types.AsyncWorkflowConfiguration{Enabled: true, PredefinedQueueName: "queue"}), nil)
+				q := describableQueue{MockQueue: provider.NewMockQueue(ctrl), MockDescriber: provider.NewMockDescriber(ctrl)}
+				q.MockQueue.EXPECT().ID().Return("mapq::queue")
+				q.MockDescriber.EXPECT().Describe(gomock.Any(), gomock.Any()).Return(partitions, nil)
+				mockProvider.EXPECT().GetPredefinedQueue("queue").Return(q, nil)
+			},
+			expected: &types.DescribeAsyncWorkflowQueueResponse{QueueID: "mapq::queue", Partitions: partitions},
+		},
+		{
+			name: "custom queue without partitions",
+			req:  &types.DescribeAsyncWorkflowQueueRequest{Domain: domainName},
+			setupMocks: func(ctrl *gomock.Controller, mockDomainCache *cache.MockDomainCache, mockProvider *queue.MockProvider) {
+				queueConfig := &types.DataBlob{EncodingType: types.EncodingTypeJSON.Ptr(), Data: []byte(`{"queueName":"queue"}`)}
+				mockDomainCache.EXPECT().GetDomain(domainName).Return(domainEntry(types.AsyncWorkflowConfiguration{Enabled: true, QueueType: "database", QueueConfig: queueConfig}), nil)
+				q := provider.NewMockQueue(ctrl)
+				q.EXPECT().ID().Return("database::queue")
+				mockProvider.EXPECT().GetQueue("database", queueConfig).Return(q, nil)
+			},
+			expected: &types.DescribeAsyncWorkflowQueueResponse{QueueID: "database::queue"},
+		},
+		{
+			name:          "request not set",
+			setupMocks:    func(*gomock.Controller, *cache.MockDomainCache, *queue.MockProvider) {},
+			expectedError: validate.ErrRequestNotSet.Error(),
+		},
+		{
+			name:          "domain not set",
+			req:           &types.DescribeAsyncWorkflowQueueRequest{},
+			setupMocks:    func(*gomock.Controller, *cache.MockDomainCache, *queue.MockProvider) {},
+			expectedError: validate.ErrDomainNotSet.Error(),
+		},
+		{
+			name: "queue not configured",
+			req:  &types.DescribeAsyncWorkflowQueueRequest{Domain: domainName},
+			setupMocks: func(ctrl *gomock.Controller, mockDomainCache *cache.MockDomainCache, mockProvider *queue.MockProvider) {
+				mockDomainCache.EXPECT().GetDomain(domainName).Return(domainEntry(types.AsyncWorkflowConfiguration{}), nil)
+			},
+			expectedError: "async workflow queue is not configured",
+		},
+		{
+			name: "describe error",
+			req:  &types.DescribeAsyncWorkflowQueueRequest{Domain: domainName},
+			setupMocks: func(ctrl *gomock.Controller, mockDomainCache *cache.MockDomainCache, mockProvider *queue.MockProvider) {
+				mockDomainCache.EXPECT().GetDomain(domainName).Return(domainEntry(types.AsyncWorkflowConfiguration{Enabled: true, PredefinedQueueName: "queue"}), nil)
+				q := describableQueue{MockQueue: provider.NewMockQueue(ctrl), MockDescriber: provider.NewMockDescriber(ctrl)}
+				q.MockQueue.EXPECT().ID().Return("mapq::queue")
+				q.MockDescriber.EXPECT().Describe(gomock.Any(), gomock.Any()).Return(nil, errors.New("persistence error"))
+				mockProvider.EXPECT().GetPredefinedQueue("queue").Return(q, nil)
+			},
+			expectedError: "persistence error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDomainCache := cache.NewMockDomainCache(ctrl)
+			mockProvider := queue.NewMockProvider(ctrl)
+			mockPersistenceBean := persistenceClient.NewMockBean(ctrl)
+			mockPersistenceBean.EXPECT().GetAsyncRequestManager().Return(persistence.NewMockAsyncRequestManager(ctrl)).AnyTimes()
+			mockPersistenceBean.EXPECT().GetMapQManager().Return(persistence.NewMockMapQManager(ctrl)).AnyTimes()
+			tc.setupMocks(ctrl, mockDomainCache, mockProvider)
+			adh := adminHandlerImpl{
+				Resource: &resource.Test{
+					Logger:                     testlogger.New(t),
+					MetricsClient:              metrics.NewNoopMetricsClient(),
+					DomainCache:                mockDomainCache,
+					PersistenceBean:            mockPersistenceBean,
+					AsyncWorkflowQueueProvider: mockProvider,
+				},
+			}
+
+			resp, err := adh.DescribeAsyncWorkflowQueue(context.Background(), tc.req)
+
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, resp)
+			}
+		})
+	}
+}
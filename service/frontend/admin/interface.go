@@ -71,4 +71,10 @@ type Handler interface {
 	GetDomainAsyncWorkflowConfiguraton(context.Context, *types.GetDomainAsyncWorkflowConfiguratonRequest) (*types.GetDomainAsyncWorkflowConfiguratonResponse, error)
 	UpdateDomainAsyncWorkflowConfiguraton(context.Context, *types.UpdateDomainAsyncWorkflowConfiguratonRequest) (*types.UpdateDomainAsyncWorkflowConfiguratonResponse, error)
 	UpdateTaskListPartitionConfig(context.Context, *types.UpdateTaskListPartitionConfigRequest) (*types.UpdateTaskListPartitionConfigResponse, error)
+	UpdateTaskListVersioningConfig(context.Context, *types.UpdateTaskListVersioningConfigRequest) (*types.UpdateTaskListVersioningConfigResponse, error)
+	MigrateTaskList(context.Context, *types.MigrateTaskListRequest) (*types.MigrateTaskListResponse, error)
+	ReadAsyncWorkflowDLQMessages(context.Context, *types.ReadAsyncWorkflowDLQMessagesRequest) (*types.ReadAsyncWorkflowDLQMessagesResponse, error)
+	PurgeAsyncWorkflowDLQMessages(context.Context, *types.PurgeAsyncWorkflowDLQMessagesRequest) (*types.PurgeAsyncWorkflowDLQMessagesResponse, error)
+	ReplayAsyncWorkflowDLQMessages(context.Context, *types.ReplayAsyncWorkflowDLQMessagesRequest) (*types.ReplayAsyncWorkflowDLQMessagesResponse, error)
+	DescribeAsyncWorkflowQueue(context.Context, *types.DescribeAsyncWorkflowQueueRequest) (*types.DescribeAsyncWorkflowQueueResponse, error)
 }
@@ -100,6 +100,21 @@ func (mr *MockHandlerMockRecorder) DeleteWorkflow(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflow", reflect.TypeOf((*MockHandler)(nil).DeleteWorkflow), arg0, arg1)
 }
 
+// DescribeAsyncWorkflowQueue mocks base method.
+func (m *MockHandler) DescribeAsyncWorkflowQueue(arg0 context.Context, arg1 *types.DescribeAsyncWorkflowQueueRequest) (*types.DescribeAsyncWorkflowQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAsyncWorkflowQueue", arg0, arg1)
+	ret0, _ := ret[0].(*types.DescribeAsyncWorkflowQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAsyncWorkflowQueue indicates an expected call of DescribeAsyncWorkflowQueue.
+func (mr *MockHandlerMockRecorder) DescribeAsyncWorkflowQueue(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAsyncWorkflowQueue", reflect.TypeOf((*MockHandler)(nil).DescribeAsyncWorkflowQueue), arg0, arg1)
+}
+
 // DescribeCluster mocks base method.
 func (m *MockHandler) DescribeCluster(arg0 context.Context) (*types.DescribeClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -355,6 +370,36 @@ func (mr *MockHandlerMockRecorder) MergeDLQMessages(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockHandler)(nil).MergeDLQMessages), arg0, arg1)
 }
 
+// MigrateTaskList mocks base method.
+func (m *MockHandler) MigrateTaskList(arg0 context.Context, arg1 *types.MigrateTaskListRequest) (*types.MigrateTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateTaskList", arg0, arg1)
+	ret0, _ := ret[0].(*types.MigrateTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateTaskList indicates an expected call of MigrateTaskList.
+func (mr *MockHandlerMockRecorder) MigrateTaskList(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateTaskList", reflect.TypeOf((*MockHandler)(nil).MigrateTaskList), arg0, arg1)
+}
+
+// PurgeAsyncWorkflowDLQMessages mocks base method.
+func (m *MockHandler) PurgeAsyncWorkflowDLQMessages(arg0 context.Context, arg1 *types.PurgeAsyncWorkflowDLQMessagesRequest) (*types.PurgeAsyncWorkflowDLQMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeAsyncWorkflowDLQMessages", arg0, arg1)
+	ret0, _ := ret[0].(*types.PurgeAsyncWorkflowDLQMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeAsyncWorkflowDLQMessages indicates an expected call of PurgeAsyncWorkflowDLQMessages.
+func (mr *MockHandlerMockRecorder) PurgeAsyncWorkflowDLQMessages(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeAsyncWorkflowDLQMessages", reflect.TypeOf((*MockHandler)(nil).PurgeAsyncWorkflowDLQMessages), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockHandler) PurgeDLQMessages(arg0 context.Context, arg1 *types.PurgeDLQMessagesRequest) error {
 	m.ctrl.T.Helper()
@@ -369,6 +414,21 @@ func (mr *MockHandlerMockRecorder) PurgeDLQMessages(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQMessages", reflect.TypeOf((*MockHandler)(nil).PurgeDLQMessages), arg0, arg1)
 }
 
+// ReadAsyncWorkflowDLQMessages mocks base method.
+func (m *MockHandler) ReadAsyncWorkflowDLQMessages(arg0 context.Context, arg1 *types.ReadAsyncWorkflowDLQMessagesRequest) (*types.ReadAsyncWorkflowDLQMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAsyncWorkflowDLQMessages", arg0, arg1)
+	ret0, _ := ret[0].(*types.ReadAsyncWorkflowDLQMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAsyncWorkflowDLQMessages indicates an expected call of ReadAsyncWorkflowDLQMessages.
+func (mr *MockHandlerMockRecorder) ReadAsyncWorkflowDLQMessages(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAsyncWorkflowDLQMessages", reflect.TypeOf((*MockHandler)(nil).ReadAsyncWorkflowDLQMessages), arg0, arg1)
+}
+
 // ReadDLQMessages mocks base method.
 func (m *MockHandler) ReadDLQMessages(arg0 context.Context, arg1 *types.ReadDLQMessagesRequest) (*types.ReadDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -426,6 +486,21 @@ func (mr *MockHandlerMockRecorder) RemoveTask(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockHandler)(nil).RemoveTask), arg0, arg1)
 }
 
+// ReplayAsyncWorkflowDLQMessages mocks base method.
+func (m *MockHandler) ReplayAsyncWorkflowDLQMessages(arg0 context.Context, arg1 *types.ReplayAsyncWorkflowDLQMessagesRequest) (*types.ReplayAsyncWorkflowDLQMessagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayAsyncWorkflowDLQMessages", arg0, arg1)
+	ret0, _ := ret[0].(*types.ReplayAsyncWorkflowDLQMessagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayAsyncWorkflowDLQMessages indicates an expected call of ReplayAsyncWorkflowDLQMessages.
+func (mr *MockHandlerMockRecorder) ReplayAsyncWorkflowDLQMessages(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayAsyncWorkflowDLQMessages", reflect.TypeOf((*MockHandler)(nil).ReplayAsyncWorkflowDLQMessages), arg0, arg1)
+}
+
 // ResendReplicationTasks mocks base method.
 func (m *MockHandler) ResendReplicationTasks(arg0 context.Context, arg1 *types.ResendReplicationTasksRequest) error {
 	m.ctrl.T.Helper()
@@ -580,3 +655,18 @@ func (mr *MockHandlerMockRecorder) UpdateTaskListPartitionConfig(arg0, arg1 any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListPartitionConfig", reflect.TypeOf((*MockHandler)(nil).UpdateTaskListPartitionConfig), arg0, arg1)
 }
+
+// UpdateTaskListVersioningConfig mocks base method.
+func (m *MockHandler) UpdateTaskListVersioningConfig(arg0 context.Context, arg1 *types.UpdateTaskListVersioningConfigRequest) (*types.UpdateTaskListVersioningConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskListVersioningConfig", arg0, arg1)
+	ret0, _ := ret[0].(*types.UpdateTaskListVersioningConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskListVersioningConfig indicates an expected call of UpdateTaskListVersioningConfig.
+func (mr *MockHandlerMockRecorder) UpdateTaskListVersioningConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListVersioningConfig", reflect.TypeOf((*MockHandler)(nil).UpdateTaskListVersioningConfig), arg0, arg1)
+}
@@ -0,0 +1,79 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package api
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+)
+
+// DescribeAsyncRequest returns the status of a request published to the async workflow queue of the domain.
+// It returns EntityNotExistsError if the request was not tracked or its status has already expired.
+func (wh *WorkflowHandler) DescribeAsyncRequest(
+	ctx context.Context,
+	request *types.DescribeAsyncRequestRequest,
+) (resp *types.DescribeAsyncRequestResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if request == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+	if request.GetDomain() == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if request.GetRequestID() == "" {
+		return nil, validate.ErrRequestIDNotSet
+	}
+	domainID, err := wh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+	getResp, err := wh.GetPersistenceBean().GetAsyncRequestManager().GetAsyncRequest(ctx, &persistence.GetAsyncRequestRequest{
+		DomainID:  domainID,
+		RequestID: request.GetRequestID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	info := getResp.Request
+	resp = &types.DescribeAsyncRequestResponse{
+		RequestID:           info.RequestID,
+		State:               info.State.Ptr(),
+		Failure:             info.Failure,
+		CreatedTimeNano:     common.Int64Ptr(info.CreatedTime.UnixNano()),
+		LastUpdatedTimeNano: common.Int64Ptr(info.LastUpdatedTime.UnixNano()),
+	}
+	if info.WorkflowID != "" {
+		resp.WorkflowExecution = &types.WorkflowExecution{
+			WorkflowID: info.WorkflowID,
+			RunID:      info.RunID,
+		}
+	}
+	return resp, nil
+}
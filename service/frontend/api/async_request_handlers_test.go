@@ -0,0 +1,270 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/.gen/go/sqlblobs"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+)
+
+func TestPublishAsyncRequest(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	retention := time.Hour
+	request := &shared.TerminateWorkflowExecutionAsyncRequest{}
+
+	testCases := []struct {
+		name          string
+		tracking      bool
+		requestID     string
+		setupMocks    func(*resource.Test, *mocks.KafkaProducer)
+		wantRequestID string
+		wantErr       string
+	}{
+		{
+			name:      "tracking disabled",
+			requestID: "request-id",
+			setupMocks: func(mockResource *resource.Test, mockProducer *mocks.KafkaProducer) {
+				mockProducer.On("Publish", mock.Anything, mock.MatchedBy(func(msg *sqlblobs.AsyncRequestMessage) bool {
+					return msg.GetRequestID() == "request-id" && msg.DomainID == nil
+				})).Return(nil)
+			},
+			wantRequestID: "request-id",
+		},
+		{
+			name:     "tracking enabled",
+			tracking: true,
+			setupMocks: func(mockResource *resource.Test, mockProducer *mocks.KafkaProducer) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+				var upserted *persistence.AsyncRequestInfo
+				mockResource.AsyncRequestMgr.EXPECT().UpsertAsyncRequest(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, req *persistence.UpsertAsyncRequestRequest) error {
+						upserted = req.Request
+						assert.Equal(t, "test-domain-id", req.Request.DomainID)
+						assert.NotEmpty(t, req.Request.RequestID)
+						assert.Equal(t, "test-workflow-id", req.Request.WorkflowID)
+						assert.Equal(t, types.AsyncRequestStateQueued, req.Request.State)
+						assert.Equal(t, now.Add(retention), req.Request.ExpiryTime)
+						return nil
+					})
+				mockProducer.On("Publish", mock.Anything, mock.MatchedBy(func(msg *sqlblobs.AsyncRequestMessage) bool {
+					return upserted != nil && msg.GetRequestID() == upserted.RequestID && msg.GetDomainID() == "test-domain-id"
+				})).Return(nil)
+			},
+		},
+		{
+			name:      "tracking enabled - failed to record status",
+			tracking:  true,
+			requestID: "request-id",
+			setupMocks: func(mockResource *resource.Test, mockProducer *mocks.KafkaProducer) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+				mockResource.AsyncRequestMgr.EXPECT().UpsertAsyncRequest(gomock.Any(), gomock.Any()).Return(errors.New("persistence error"))
+			},
+			wantErr: "persistence error",
+		},
+		{
+			name:      "tracking enabled - failed to publish",
+			tracking:  true,
+			requestID: "request-id",
+			setupMocks: func(mockResource *resource.Test, mockProducer *mocks.KafkaProducer) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+				gomock.InOrder(
+					mockResource.AsyncRequestMgr.EXPECT().UpsertAsyncRequest(gomock.Any(), gomock.Any()).Return(nil),
+					mockResource.AsyncRequestMgr.EXPECT().UpsertAsyncRequest(gomock.Any(), gomock.Any()).DoAndReturn(
+						func(_ context.Context, req *persistence.UpsertAsyncRequestRequest) error {
+							assert.Equal(t, types.AsyncRequestStateFailed, req.Request.State)
+							assert.Contains(t, req.Request.Failure, "queue error")
+							return nil
+						}),
+				)
+				mockProducer.On("Publish", mock.Anything, mock.Anything).Return(errors.New("queue error"))
+			},
+			wantErr: "queue error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wh, mockResource, mockProducerManager := newAsyncWorkflowHandlerForTest(t)
+			mockResource.TimeSource = clock.NewMockedTimeSourceAt(now)
+			wh.config.EnableAsyncRequestTracking = dynamicproperties.GetBoolPropertyFnFilteredByDomain(tc.tracking)
+			wh.config.AsyncRequestRetention = dynamicproperties.GetDurationPropertyFnFilteredByDomain(retention)
+			mockProducer := &mocks.KafkaProducer{}
+			mockProducerManager.EXPECT().GetProducerByDomain("test-domain").Return(mockProducer, nil)
+			tc.setupMocks(mockResource, mockProducer)
+
+			requestID, err := wh.publishAsyncRequest(
+				context.Background(),
+				metrics.NoopScope,
+				"test-domain",
+				"test-workflow-id",
+				tc.requestID,
+				sqlblobs.AsyncRequestTypeTerminateWorkflowExecutionAsyncRequest,
+				request,
+			)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			if tc.wantRequestID != "" {
+				assert.Equal(t, tc.wantRequestID, requestID)
+			} else {
+				assert.NotEmpty(t, requestID)
+			}
+			mockProducer.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDescribeAsyncRequest(t *testing.T) {
+	created := time.Unix(1700000000, 0)
+	updated := created.Add(time.Minute)
+	req := &types.DescribeAsyncRequestRequest{Domain: "test-domain", RequestID: "request-id"}
+
+	testCases := []struct {
+		name       string
+		request    *types.DescribeAsyncRequestRequest
+		setupMocks func(*resource.Test)
+		want       *types.DescribeAsyncRequestResponse
+		wantErr    error
+	}{
+		{
+			name:    "nil request",
+			wantErr: validate.ErrRequestNotSet,
+		},
+		{
+			name:    "domain not set",
+			request: &types.DescribeAsyncRequestRequest{RequestID: "request-id"},
+			wantErr: validate.ErrDomainNotSet,
+		},
+		{
+			name:    "request ID not set",
+			request: &types.DescribeAsyncRequestRequest{Domain: "test-domain"},
+			wantErr: validate.ErrRequestIDNotSet,
+		},
+		{
+			name:    "domain cache error",
+			request: req,
+			setupMocks: func(mockResource *resource.Test) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("", &types.EntityNotExistsError{Message: "domain"})
+			},
+			wantErr: &types.EntityNotExistsError{Message: "domain"},
+		},
+		{
+			name:    "request not found",
+			request: req,
+			setupMocks: func(mockResource *resource.Test) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+				mockResource.AsyncRequestMgr.EXPECT().GetAsyncRequest(gomock.Any(), &persistence.GetAsyncRequestRequest{
+					DomainID:  "test-domain-id",
+					RequestID: "request-id",
+				}).Return(nil, &types.EntityNotExistsError{Message: "request"})
+			},
+			wantErr: &types.EntityNotExistsError{Message: "request"},
+		},
+		{
+			name:    "succeeded request",
+			request: req,
+			setupMocks: func(mockResource *resource.Test) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+				mockResource.AsyncRequestMgr.EXPECT().GetAsyncRequest(gomock.Any(), gomock.Any()).Return(&persistence.GetAsyncRequestResponse{
+					Request: &persistence.AsyncRequestInfo{
+						DomainID:        "test-domain-id",
+						RequestID:       "request-id",
+						WorkflowID:      "test-workflow-id",
+						RunID:           "test-run-id",
+						State:           types.AsyncRequestStateSucceeded,
+						CreatedTime:     created,
+						LastUpdatedTime: updated,
+					},
+				}, nil)
+			},
+			want: &types.DescribeAsyncRequestResponse{
+				RequestID:           "request-id",
+				State:               types.AsyncRequestStateSucceeded.Ptr(),
+				WorkflowExecution:   &types.WorkflowExecution{WorkflowID: "test-workflow-id", RunID: "test-run-id"},
+				CreatedTimeNano:     common.Int64Ptr(created.UnixNano()),
+				LastUpdatedTimeNano: common.Int64Ptr(updated.UnixNano()),
+			},
+		},
+		{
+			name:    "failed request",
+			request: req,
+			setupMocks: func(mockResource *resource.Test) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+				mockResource.AsyncRequestMgr.EXPECT().GetAsyncRequest(gomock.Any(), gomock.Any()).Return(&persistence.GetAsyncRequestResponse{
+					Request: &persistence.AsyncRequestInfo{
+						DomainID:        "test-domain-id",
+						RequestID:       "request-id",
+						State:           types.AsyncRequestStateFailed,
+						Failure:         "bad request",
+						CreatedTime:     created,
+						LastUpdatedTime: updated,
+					},
+				}, nil)
+			},
+			want: &types.DescribeAsyncRequestResponse{
+				RequestID:           "request-id",
+				State:               types.AsyncRequestStateFailed.Ptr(),
+				Failure:             "bad request",
+				CreatedTimeNano:     common.Int64Ptr(created.UnixNano()),
+				LastUpdatedTimeNano: common.Int64Ptr(updated.UnixNano()),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wh, mockResource, _ := newAsyncWorkflowHandlerForTest(t)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockResource)
+			}
+
+			resp, err := wh.DescribeAsyncRequest(context.Background(), tc.request)
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, resp)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package api
+
+import (
+	"github.com/uber/cadence/common/completioncallback"
+	"github.com/uber/cadence/common/types"
+)
+
+var errCompletionCallbacksDisabled = &types.BadRequestError{Message: "Completion callbacks are not enabled for this domain."}
+
+func (wh *WorkflowHandler) validateCompletionCallbacks(domainName string, callbacks []*types.CompletionCallback) error {
+	if len(callbacks) == 0 {
+		return nil
+	}
+	if !wh.config.EnableCompletionCallbacks(domainName) {
+		return errCompletionCallbacksDisabled
+	}
+	return completioncallback.Validate(
+		callbacks,
+		wh.config.MaxCompletionCallbacksPerWorkflow(domainName),
+		wh.config.CompletionCallbackAllowedHosts(domainName),
+	)
+}
@@ -0,0 +1,146 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package api
+
+import (
+	"context"
+
+	"github.com/pborman/uuid"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common"
+	dc "github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/types"
+)
+
+func (s *workflowHandlerSuite) newCompletionCallbackStartRequest(callbacks ...*types.CompletionCallback) *types.StartWorkflowExecutionRequest {
+	return &types.StartWorkflowExecutionRequest{
+		Domain:                              s.testDomain,
+		WorkflowID:                          "workflow-id",
+		WorkflowType:                        &types.WorkflowType{Name: "workflow-type"},
+		TaskList:                            &types.TaskList{Name: "task-list"},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestID:                           uuid.New(),
+		CompletionCallbacks:                 callbacks,
+	}
+}
+
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_CompletionCallbacksDisabled() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dynamicproperties.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	request := s.newCompletionCallbackStartRequest(&types.CompletionCallback{URL: "https://hooks.example.com/done"})
+	_, err := wh.StartWorkflowExecution(context.Background(), request)
+	s.Equal(errCompletionCallbacksDisabled, err)
+}
+
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_CompletionCallbackHostNotAllowed() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dynamicproperties.GetIntPropertyFn(10)
+	config.EnableCompletionCallbacks = dynamicproperties.GetBoolPropertyFnFilteredByDomain(true)
+	config.CompletionCallbackAllowedHosts = func(domain string) map[string]interface{} {
+		return map[string]interface{}{"hooks.example.com": true}
+	}
+	wh := s.getWorkflowHandler(config)
+
+	request := s.newCompletionCallbackStartRequest(&types.CompletionCallback{URL: "https://internal.corp/done"})
+	_, err := wh.StartWorkflowExecution(context.Background(), request)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *workflowHandlerSuite) TestSignalWithStartWorkflowExecution_CompletionCallbacksDisabled() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dynamicproperties.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+
+	request := &types.SignalWithStartWorkflowExecutionRequest{
+		Domain:                              s.testDomain,
+		WorkflowID:                          "workflow-id",
+		WorkflowType:                        &types.WorkflowType{Name: "workflow-type"},
+		TaskList:                            &types.TaskList{Name: "task-list"},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestID:                           uuid.New(),
+		SignalName:                          "signal-name",
+		CompletionCallbacks:                 []*types.CompletionCallback{{URL: "https://hooks.example.com/done"}},
+	}
+	_, err := wh.SignalWithStartWorkflowExecution(context.Background(), request)
+	s.Equal(errCompletionCallbacksDisabled, err)
+}
+
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_CompletionCallbacksForwarded() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dynamicproperties.GetIntPropertyFn(10)
+	config.EnableCompletionCallbacks = dynamicproperties.GetBoolPropertyFnFilteredByDomain(true)
+	config.CompletionCallbackAllowedHosts = func(domain string) map[string]interface{} {
+		return map[string]interface{}{"*.example.com": true}
+	}
+	wh := s.getWorkflowHandler(config)
+
+	callbacks := []*types.CompletionCallback{{URL: "https://hooks.example.com/done", Headers: map[string]string{"token": "abc"}}}
+	request := s.newCompletionCallbackStartRequest(callbacks...)
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).Times(2)
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *types.HistoryStartWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
+			s.Equal(callbacks, req.StartRequest.CompletionCallbacks)
+			// callbacks must not leak into the user-visible workflow header
+			s.Nil(req.StartRequest.Header)
+			return &types.StartWorkflowExecutionResponse{RunID: "test-rid"}, nil
+		})
+
+	_, err := wh.StartWorkflowExecution(context.Background(), request)
+	s.NoError(err)
+}
+
+func (s *workflowHandlerSuite) TestDescribeWorkflowExecution_CompletionCallbacks() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.EnableCompletionCallbacks = dynamicproperties.GetBoolPropertyFnFilteredByDomain(true)
+	wh := s.getWorkflowHandler(config)
+
+	// the delivery state is persisted in mutable state, so it is returned by history as is
+	callbacks := []*types.CompletionCallbackInfo{{
+		URL:     "https://hooks.example.com/done",
+		State:   types.CompletionCallbackStateSucceeded.Ptr(),
+		Attempt: 2,
+	}}
+	execution := &types.WorkflowExecution{WorkflowID: testWorkflowID, RunID: testRunID}
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+	s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+			Execution:   execution,
+			CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+		},
+		CompletionCallbacks: callbacks,
+	}, nil)
+
+	resp, err := wh.DescribeWorkflowExecution(context.Background(), &types.DescribeWorkflowExecutionRequest{
+		Domain:    s.testDomain,
+		Execution: execution,
+	})
+	s.NoError(err)
+	s.Equal(callbacks, resp.CompletionCallbacks)
+}
@@ -77,6 +77,22 @@ func (wh *WorkflowHandler) DescribeDomain(
 		return nil, err
 	}
 
+	if wh.config.EnableDescribeDomainTaskQuota(resp.GetDomainInfo().GetName()) {
+		// fetch task quota usage from matching service
+		quotaResp, err := wh.GetMatchingClient().DescribeDomainTaskQuota(ctx, &types.MatchingDescribeDomainTaskQuotaRequest{
+			DomainUUID: resp.GetDomainInfo().GetUUID(),
+		})
+		if err != nil {
+			// despite the error from matching, return describe domain response
+			wh.GetLogger().Error(
+				fmt.Sprintf("Failed to get task quota for domain %s", resp.GetDomainInfo().GetName()),
+				tag.Error(err),
+			)
+		} else {
+			resp.TaskQuota = quotaResp.GetTaskQuota()
+		}
+	}
+
 	if resp.GetFailoverInfo() != nil && resp.GetFailoverInfo().GetFailoverExpireTimestamp() > 0 {
 		// fetch ongoing failover info from history service
 		failoverResp, err := wh.GetHistoryClient().GetFailoverInfo(ctx, &types.GetFailoverInfoRequest{
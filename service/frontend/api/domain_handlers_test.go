@@ -29,8 +29,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
@@ -263,6 +265,55 @@ func TestDescribeDomain(t *testing.T) {
 				assert.Nil(t, resp.FailoverInfo.PendingShards)
 			},
 		},
+		{
+			name: "success with task quota",
+			req: &types.DescribeDomainRequest{
+				Name: &domainName,
+			},
+			setupMocks: func(deps *mockDeps) {
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.FrontendEnableDescribeDomainTaskQuota, true))
+				deps.mockRequestValidator.EXPECT().ValidateDescribeDomainRequest(gomock.Any(), gomock.Any()).Return(nil)
+				deps.mockDomainHandler.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&types.DescribeDomainResponse{
+					DomainInfo: &types.DomainInfo{
+						Name: "domain-name",
+						UUID: "domain-id",
+					},
+				}, nil)
+				deps.mockMatchingClient.EXPECT().DescribeDomainTaskQuota(gomock.Any(), &types.MatchingDescribeDomainTaskQuotaRequest{
+					DomainUUID: "domain-id",
+				}).Return(&types.MatchingDescribeDomainTaskQuotaResponse{
+					TaskQuota: &types.DomainTaskQuota{AddTaskRPSLimit: 100, AddTaskRPS: 10},
+				}, nil)
+			},
+			expectError: false,
+			verifyResp: func(t *testing.T, resp *types.DescribeDomainResponse) {
+				assert.NotNil(t, resp)
+				assert.Equal(t, &types.DomainTaskQuota{AddTaskRPSLimit: 100, AddTaskRPS: 10}, resp.TaskQuota)
+			},
+		},
+		{
+			name: "error from matching client",
+			req: &types.DescribeDomainRequest{
+				Name: &domainName,
+			},
+			setupMocks: func(deps *mockDeps) {
+				require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.FrontendEnableDescribeDomainTaskQuota, true))
+				deps.mockRequestValidator.EXPECT().ValidateDescribeDomainRequest(gomock.Any(), gomock.Any()).Return(nil)
+				deps.mockDomainHandler.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&types.DescribeDomainResponse{
+					DomainInfo: &types.DomainInfo{
+						Name: "domain-name",
+						UUID: "domain-id",
+					},
+				}, nil)
+				deps.mockMatchingClient.EXPECT().DescribeDomainTaskQuota(gomock.Any(), gomock.Any()).Return(nil, errors.New("matching client error"))
+			},
+			expectError: false,
+			verifyResp: func(t *testing.T, resp *types.DescribeDomainResponse) {
+				assert.NotNil(t, resp)
+				assert.Equal(t, "domain-name", resp.DomainInfo.Name)
+				assert.Nil(t, resp.TaskQuota)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -144,6 +144,7 @@ func NewWorkflowHandler(
 		producerManager: NewProducerManager(
 			resource.GetDomainCache(),
 			resource.GetAsyncWorkflowQueueProvider(),
+			resource.GetPersistenceBean().GetAsyncRequestManager(),
 			resource.GetLogger(),
 			resource.GetMetricsClient(),
 		),
@@ -531,14 +532,17 @@ func (wh *WorkflowHandler) getPartitionConfig(ctx context.Context, domainName st
 	return isolationgroup.ConfigFromContext(ctx)
 }
 
-func (wh *WorkflowHandler) isIsolationGroupHealthy(ctx context.Context, domainName, isolationGroup string) bool {
+// isIsolationGroupHealthy returns false if the workflow should not be placed in the isolation group:
+// either the group is drained, or it is weighted and the workflow falls outside of its traffic weight.
+// The workflowID is hashed the same way as in matching, so both agree on which workflows stay in the group.
+func (wh *WorkflowHandler) isIsolationGroupHealthy(ctx context.Context, domainName, isolationGroup, workflowID string) bool {
 	if wh.GetIsolationGroupState() != nil && wh.config.EnableTasklistIsolation(domainName) {
-		isDrained, err := wh.GetIsolationGroupState().IsDrained(ctx, domainName, isolationGroup)
+		weight, err := wh.GetIsolationGroupState().TrafficWeight(ctx, domainName, isolationGroup)
 		if err != nil {
-			wh.GetLogger().Error("Failed to check if an isolation group is drained, assume it's healthy", tag.Error(err))
+			wh.GetLogger().Error("Failed to get the traffic weight of an isolation group, assume it's healthy", tag.Error(err))
 			return true
 		}
-		return !isDrained
+		return isolationgroup.IsWithinTrafficWeight(workflowID, weight)
 	}
 	return true
 }
@@ -1599,19 +1603,51 @@ func (wh *WorkflowHandler) StartWorkflowExecutionAsync(
 		return nil, err
 	}
 
-	producer, err := wh.producerManager.GetProducerByDomain(startRequest.GetDomain())
+	requestID, err := wh.publishAsyncRequest(
+		ctx,
+		scope,
+		startRequest.GetDomain(),
+		startRequest.GetWorkflowID(),
+		startRequest.StartWorkflowExecutionRequest.GetRequestID(),
+		sqlblobs.AsyncRequestTypeStartWorkflowExecutionAsyncRequest,
+		thrift.FromStartWorkflowExecutionAsyncRequest(startRequest),
+	)
 	if err != nil {
 		return nil, err
 	}
+	return &types.StartWorkflowExecutionAsyncResponse{RequestID: requestID}, nil
+}
+
+// publishAsyncRequest serializes the request and publishes it to the async queue configured for the domain,
+// along with the headers of the original call so that they can be propagated when the request is consumed.
+// It returns the ID under which the request can be looked up with DescribeAsyncRequest. The request ID of
+// the original call is reused if it has one, otherwise a new one is generated.
+func (wh *WorkflowHandler) publishAsyncRequest(
+	ctx context.Context,
+	scope metrics.Scope,
+	domainName string,
+	partitionKey string,
+	requestID string,
+	requestType sqlblobs.AsyncRequestType,
+	request codec.ThriftObject,
+) (string, error) {
+	producer, err := wh.producerManager.GetProducerByDomain(domainName)
+	if err != nil {
+		return "", err
+	}
 
 	// Serialize the message to be sent to the queue.
 	// Avoid JSON because json encoding of requests excludes PII fields such as input. JSON encoded request are logged by acccess controlled api layer for audit purposes.
-	payload, err := wh.thriftrwEncoder.Encode(thrift.FromStartWorkflowExecutionAsyncRequest(startRequest))
+	payload, err := wh.thriftrwEncoder.Encode(request)
 	if err != nil {
-		return nil, fmt.Errorf("failed to encode StartWorkflowExecutionAsyncRequest: %v", err)
+		return "", fmt.Errorf("failed to encode %v: %v", requestType, err)
 	}
 	scope.RecordTimer(metrics.AsyncRequestPayloadSize, time.Duration(len(payload)))
 
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+
 	// propagate the headers from the context to the message
 	header := &shared.Header{
 		Fields: make(map[string][]byte),
@@ -1619,19 +1655,47 @@ func (wh *WorkflowHandler) StartWorkflowExecutionAsync(
 	for k, v := range yarpc.CallFromContext(ctx).OriginalHeaders() {
 		header.Fields[k] = []byte(v)
 	}
-	messageType := sqlblobs.AsyncRequestTypeStartWorkflowExecutionAsyncRequest
 	message := &sqlblobs.AsyncRequestMessage{
-		PartitionKey: common.StringPtr(startRequest.GetWorkflowID()),
-		Type:         &messageType,
+		PartitionKey: common.StringPtr(partitionKey),
+		Type:         requestType.Ptr(),
 		Header:       header,
 		Encoding:     common.StringPtr(string(constants.EncodingTypeThriftRW)),
 		Payload:      payload,
+		RequestID:    common.StringPtr(requestID),
 	}
-	err = producer.Publish(ctx, message)
-	if err != nil {
-		return nil, err
+
+	var tracked *persistence.AsyncRequestInfo
+	if wh.config.EnableAsyncRequestTracking(domainName) {
+		domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+		if err != nil {
+			return "", err
+		}
+		// the status row is written before publishing so that the consumer always finds it
+		now := wh.GetTimeSource().Now()
+		tracked = &persistence.AsyncRequestInfo{
+			DomainID:   domainID,
+			RequestID:  requestID,
+			WorkflowID: partitionKey,
+			State:      types.AsyncRequestStateQueued,
+			ExpiryTime: now.Add(wh.config.AsyncRequestRetention(domainName)),
+		}
+		if err := wh.GetPersistenceBean().GetAsyncRequestManager().UpsertAsyncRequest(ctx, &persistence.UpsertAsyncRequestRequest{Request: tracked}); err != nil {
+			return "", err
+		}
+		message.DomainID = common.StringPtr(domainID)
+	}
+
+	if err := producer.Publish(ctx, message); err != nil {
+		if tracked != nil {
+			tracked.State = types.AsyncRequestStateFailed
+			tracked.Failure = fmt.Sprintf("failed to publish request: %v", err)
+			if updateErr := wh.GetPersistenceBean().GetAsyncRequestManager().UpsertAsyncRequest(ctx, &persistence.UpsertAsyncRequestRequest{Request: tracked}); updateErr != nil {
+				wh.GetLogger().Warn("Failed to record async request failure", tag.WorkflowDomainName(domainName), tag.WorkflowRequestID(requestID), tag.Error(updateErr))
+			}
+		}
+		return "", err
 	}
-	return &types.StartWorkflowExecutionAsyncResponse{}, nil
+	return requestID, nil
 }
 
 // StartWorkflowExecution - Creates a new workflow execution
@@ -1786,6 +1850,9 @@ func (wh *WorkflowHandler) validateStartWorkflowExecutionRequest(ctx context.Con
 	if err := wh.searchAttributesValidator.ValidateSearchAttributes(startRequest.SearchAttributes, domainName); err != nil {
 		return err
 	}
+	if err := wh.validateCompletionCallbacks(domainName, startRequest.GetCompletionCallbacks()); err != nil {
+		return err
+	}
 	wh.GetLogger().Debug("Start workflow execution request domain", tag.WorkflowDomainName(domainName))
 	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
 	if err != nil {
@@ -1812,7 +1879,7 @@ func (wh *WorkflowHandler) validateStartWorkflowExecutionRequest(ctx context.Con
 		return err
 	}
 	isolationGroup := wh.getIsolationGroup(ctx, domainName)
-	if !wh.isIsolationGroupHealthy(ctx, domainName, isolationGroup) {
+	if !wh.isIsolationGroupHealthy(ctx, domainName, isolationGroup, startRequest.GetWorkflowID()) {
 		return &types.BadRequestError{fmt.Sprintf("Domain %s is drained from isolation group %s.", domainName, isolationGroup)}
 	}
 	return nil
@@ -2095,21 +2162,72 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 		return validate.ErrShuttingDown
 	}
 
+	scope := getMetricsScopeWithDomain(metrics.FrontendSignalWorkflowExecutionScope, signalRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	domainID, err := wh.validateSignalWorkflowExecutionRequest(ctx, signalRequest, scope)
+	if err != nil {
+		return err
+	}
+
+	err = wh.GetHistoryClient().SignalWorkflowExecution(ctx, &types.HistorySignalWorkflowExecutionRequest{
+		DomainUUID:    domainID,
+		SignalRequest: signalRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, err)
+	}
+
+	return nil
+}
+
+// SignalWorkflowExecutionAsync validates the signal request and publishes it to the async queue configured
+// for the domain. The signal is delivered to the workflow execution once the request is consumed from the queue.
+func (wh *WorkflowHandler) SignalWorkflowExecutionAsync(
+	ctx context.Context,
+	signalRequest *types.SignalWorkflowExecutionAsyncRequest,
+) (resp *types.SignalWorkflowExecutionAsyncResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
 	if signalRequest == nil {
-		return validate.ErrRequestNotSet
+		return nil, validate.ErrRequestNotSet
+	}
+	scope := getMetricsScopeWithDomain(metrics.FrontendSignalWorkflowExecutionAsyncScope, signalRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	// validate request before pushing to queue
+	if _, err := wh.validateSignalWorkflowExecutionRequest(ctx, signalRequest.SignalWorkflowExecutionRequest, scope); err != nil {
+		return nil, err
+	}
+
+	requestID, err := wh.publishAsyncRequest(
+		ctx,
+		scope,
+		signalRequest.GetDomain(),
+		signalRequest.GetWorkflowExecution().GetWorkflowID(),
+		signalRequest.SignalWorkflowExecutionRequest.GetRequestID(),
+		sqlblobs.AsyncRequestTypeSignalWorkflowExecutionAsyncRequest,
+		thrift.FromSignalWorkflowExecutionAsyncRequest(signalRequest),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.SignalWorkflowExecutionAsyncResponse{RequestID: requestID}, nil
+}
+
+// validateSignalWorkflowExecutionRequest validates the signal request and returns the ID of the target domain
+func (wh *WorkflowHandler) validateSignalWorkflowExecutionRequest(ctx context.Context, signalRequest *types.SignalWorkflowExecutionRequest, scope metrics.Scope) (string, error) {
+	if signalRequest == nil {
+		return "", validate.ErrRequestNotSet
 	}
 
 	domainName := signalRequest.GetDomain()
 	wfExecution := signalRequest.GetWorkflowExecution()
 
 	if domainName == "" {
-		return validate.ErrDomainNotSet
+		return "", validate.ErrDomainNotSet
 	}
 	if err := validate.CheckExecution(wfExecution); err != nil {
-		return err
+		return "", err
 	}
 
-	scope := getMetricsScopeWithDomain(metrics.FrontendSignalWorkflowExecutionScope, signalRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
 	idLengthWarnLimit := wh.config.MaxIDLengthWarnLimit()
 	if !common.IsValidIDLength(
 		domainName,
@@ -2120,11 +2238,11 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 		domainName,
 		wh.GetLogger(),
 		tag.IDTypeDomainName) {
-		return validate.ErrDomainTooLong
+		return "", validate.ErrDomainTooLong
 	}
 
 	if signalRequest.GetSignalName() == "" {
-		return validate.ErrSignalNameNotSet
+		return "", validate.ErrSignalNameNotSet
 	}
 
 	if !common.IsValidIDLength(
@@ -2136,7 +2254,7 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 		domainName,
 		wh.GetLogger(),
 		tag.IDTypeSignalName) {
-		return validate.ErrSignalNameTooLong
+		return "", validate.ErrSignalNameTooLong
 	}
 
 	if !common.IsValidIDLength(
@@ -2148,12 +2266,12 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 		domainName,
 		wh.GetLogger(),
 		tag.IDTypeRequestID) {
-		return validate.ErrRequestIDTooLong
+		return "", validate.ErrRequestIDTooLong
 	}
 
 	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
@@ -2170,23 +2288,14 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 		wh.GetLogger(),
 		tag.BlobSizeViolationOperation("SignalWorkflowExecution"),
 	); err != nil {
-		return err
+		return "", err
 	}
 
 	isolationGroup := wh.getIsolationGroup(ctx, domainName)
-	if !wh.isIsolationGroupHealthy(ctx, domainName, isolationGroup) {
-		return &types.BadRequestError{fmt.Sprintf("Domain %s is drained from isolation group %s.", domainName, isolationGroup)}
+	if !wh.isIsolationGroupHealthy(ctx, domainName, isolationGroup, signalRequest.GetWorkflowExecution().GetWorkflowID()) {
+		return "", &types.BadRequestError{Message: fmt.Sprintf("Domain %s is drained from isolation group %s.", domainName, isolationGroup)}
 	}
-
-	err = wh.GetHistoryClient().SignalWorkflowExecution(ctx, &types.HistorySignalWorkflowExecutionRequest{
-		DomainUUID:    domainID,
-		SignalRequest: signalRequest,
-	})
-	if err != nil {
-		return wh.normalizeVersionedErrors(ctx, err)
-	}
-
-	return nil
+	return domainID, nil
 }
 
 func (wh *WorkflowHandler) SignalWithStartWorkflowExecutionAsync(
@@ -2202,39 +2311,19 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecutionAsync(
 	if err != nil {
 		return nil, err
 	}
-	producer, err := wh.producerManager.GetProducerByDomain(signalWithStartRequest.GetDomain())
-	if err != nil {
-		return nil, err
-	}
-
-	// Serialize the message to be sent to the queue.
-	// Avoid JSON because json encoding of requests excludes PII fields such as input. JSON encoded request are logged by acccess controlled api layer for audit purposes.
-	payload, err := wh.thriftrwEncoder.Encode(thrift.FromSignalWithStartWorkflowExecutionAsyncRequest(signalWithStartRequest))
-	if err != nil {
-		return nil, fmt.Errorf("failed to encode SignalWithStartWorkflowExecutionAsyncRequest: %v", err)
-	}
-	scope.RecordTimer(metrics.AsyncRequestPayloadSize, time.Duration(len(payload)))
-
-	// propagate the headers from the context to the message
-	header := &shared.Header{
-		Fields: map[string][]byte{},
-	}
-	for k, v := range yarpc.CallFromContext(ctx).OriginalHeaders() {
-		header.Fields[k] = []byte(v)
-	}
-	messageType := sqlblobs.AsyncRequestTypeSignalWithStartWorkflowExecutionAsyncRequest
-	message := &sqlblobs.AsyncRequestMessage{
-		PartitionKey: common.StringPtr(signalWithStartRequest.GetWorkflowID()),
-		Type:         &messageType,
-		Header:       header,
-		Encoding:     common.StringPtr(string(constants.EncodingTypeThriftRW)),
-		Payload:      payload,
-	}
-	err = producer.Publish(ctx, message)
+	requestID, err := wh.publishAsyncRequest(
+		ctx,
+		scope,
+		signalWithStartRequest.GetDomain(),
+		signalWithStartRequest.GetWorkflowID(),
+		signalWithStartRequest.SignalWithStartWorkflowExecutionRequest.GetRequestID(),
+		sqlblobs.AsyncRequestTypeSignalWithStartWorkflowExecutionAsyncRequest,
+		thrift.FromSignalWithStartWorkflowExecutionAsyncRequest(signalWithStartRequest),
+	)
 	if err != nil {
 		return nil, err
 	}
-	return &types.SignalWithStartWorkflowExecutionAsyncResponse{}, nil
+	return &types.SignalWithStartWorkflowExecutionAsyncResponse{RequestID: requestID}, nil
 }
 
 // SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.
@@ -2380,6 +2469,9 @@ func (wh *WorkflowHandler) validateSignalWithStartWorkflowExecutionRequest(ctx c
 	if err := wh.searchAttributesValidator.ValidateSearchAttributes(signalWithStartRequest.SearchAttributes, domainName); err != nil {
 		return err
 	}
+	if err := wh.validateCompletionCallbacks(domainName, signalWithStartRequest.GetCompletionCallbacks()); err != nil {
+		return err
+	}
 
 	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
 	if err != nil {
@@ -2419,7 +2511,7 @@ func (wh *WorkflowHandler) validateSignalWithStartWorkflowExecutionRequest(ctx c
 	}
 
 	isolationGroup := wh.getIsolationGroup(ctx, domainName)
-	if !wh.isIsolationGroupHealthy(ctx, domainName, isolationGroup) {
+	if !wh.isIsolationGroupHealthy(ctx, domainName, isolationGroup, signalWithStartRequest.GetWorkflowID()) {
 		return &types.BadRequestError{fmt.Sprintf("Domain %s is drained from isolation group %s.", domainName, isolationGroup)}
 	}
 	return nil
@@ -2464,6 +2556,112 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(
 	return nil
 }
 
+// TerminateWorkflowExecutionAsync validates the terminate request and publishes it to the async queue
+// configured for the domain. The workflow execution is terminated once the request is consumed from the queue.
+func (wh *WorkflowHandler) TerminateWorkflowExecutionAsync(
+	ctx context.Context,
+	terminateRequest *types.TerminateWorkflowExecutionAsyncRequest,
+) (resp *types.TerminateWorkflowExecutionAsyncResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if terminateRequest == nil || terminateRequest.TerminateWorkflowExecutionRequest == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+	if terminateRequest.GetDomain() == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(terminateRequest.GetWorkflowExecution()); err != nil {
+		return nil, err
+	}
+
+	scope := getMetricsScopeWithDomain(metrics.FrontendTerminateWorkflowExecutionAsyncScope, terminateRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	requestID, err := wh.publishAsyncRequest(
+		ctx,
+		scope,
+		terminateRequest.GetDomain(),
+		terminateRequest.GetWorkflowExecution().GetWorkflowID(),
+		"", // terminate requests don't carry a request ID, so one is generated
+		sqlblobs.AsyncRequestTypeTerminateWorkflowExecutionAsyncRequest,
+		thrift.FromTerminateWorkflowExecutionAsyncRequest(terminateRequest),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.TerminateWorkflowExecutionAsyncResponse{RequestID: requestID}, nil
+}
+
+// UpdateWorkflowMemo updates the memo of an existing workflow execution by recording
+// WorkflowExecutionMemoUpdated event in the history. Fields in the request overwrite
+// the existing memo fields with the same key, and a field with an empty value removes the key.
+func (wh *WorkflowHandler) UpdateWorkflowMemo(
+	ctx context.Context,
+	updateRequest *types.UpdateWorkflowMemoRequest,
+) (resp *types.UpdateWorkflowMemoResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+
+	if updateRequest == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+
+	domainName := updateRequest.GetDomain()
+	wfExecution := updateRequest.GetWorkflowExecution()
+	if domainName == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(wfExecution); err != nil {
+		return nil, err
+	}
+	if len(updateRequest.GetMemo().GetFields()) == 0 {
+		return nil, validate.ErrMemoNotSet
+	}
+
+	scope := getMetricsScopeWithDomain(metrics.FrontendUpdateWorkflowMemoScope, updateRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	if !common.IsValidIDLength(
+		updateRequest.GetRequestID(),
+		scope,
+		wh.config.MaxIDLengthWarnLimit(),
+		wh.config.RequestIDMaxLength(domainName),
+		metrics.CadenceErrRequestIDExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeRequestID) {
+		return nil, validate.ErrRequestIDTooLong
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := common.CheckEventBlobSizeLimit(
+		common.GetSizeOfMapStringToByteArray(updateRequest.GetMemo().GetFields()),
+		wh.config.BlobSizeLimitWarn(domainName),
+		wh.config.BlobSizeLimitError(domainName),
+		domainID,
+		domainName,
+		wfExecution.GetWorkflowID(),
+		wfExecution.GetRunID(),
+		scope,
+		wh.GetLogger(),
+		tag.BlobSizeViolationOperation("UpdateWorkflowMemo"),
+	); err != nil {
+		return nil, err
+	}
+
+	err = wh.GetHistoryClient().UpdateWorkflowMemo(ctx, &types.HistoryUpdateWorkflowMemoRequest{
+		DomainUUID:    domainID,
+		UpdateRequest: updateRequest,
+	})
+	if err != nil {
+		return nil, wh.normalizeVersionedErrors(ctx, err)
+	}
+
+	return &types.UpdateWorkflowMemoResponse{}, nil
+}
+
 // ResetWorkflowExecution reset an existing workflow execution to the nextFirstEventID
 // in the history and immediately terminating the current execution instance.
 func (wh *WorkflowHandler) ResetWorkflowExecution(
@@ -2541,6 +2739,40 @@ func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 	return nil
 }
 
+// RequestCancelWorkflowExecutionAsync - validates the cancel request and publishes it to the async queue configured for the domain
+func (wh *WorkflowHandler) RequestCancelWorkflowExecutionAsync(
+	ctx context.Context,
+	cancelRequest *types.RequestCancelWorkflowExecutionAsyncRequest,
+) (resp *types.RequestCancelWorkflowExecutionAsyncResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if cancelRequest == nil || cancelRequest.RequestCancelWorkflowExecutionRequest == nil {
+		return nil, validate.ErrRequestNotSet
+	}
+	if cancelRequest.GetDomain() == "" {
+		return nil, validate.ErrDomainNotSet
+	}
+	if err := validate.CheckExecution(cancelRequest.GetWorkflowExecution()); err != nil {
+		return nil, err
+	}
+
+	scope := getMetricsScopeWithDomain(metrics.FrontendRequestCancelWorkflowExecutionAsyncScope, cancelRequest, wh.GetMetricsClient()).Tagged(metrics.GetContextTags(ctx)...)
+	requestID, err := wh.publishAsyncRequest(
+		ctx,
+		scope,
+		cancelRequest.GetDomain(),
+		cancelRequest.GetWorkflowExecution().GetWorkflowID(),
+		cancelRequest.RequestCancelWorkflowExecutionRequest.GetRequestID(),
+		sqlblobs.AsyncRequestTypeRequestCancelWorkflowExecutionAsyncRequest,
+		thrift.FromRequestCancelWorkflowExecutionAsyncRequest(cancelRequest),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.RequestCancelWorkflowExecutionAsyncResponse{RequestID: requestID}, nil
+}
+
 // RestartWorkflowExecution - retrieves info for an existing workflow then restarts it
 func (wh *WorkflowHandler) RestartWorkflowExecution(ctx context.Context, request *types.RestartWorkflowExecutionRequest) (resp *types.RestartWorkflowExecutionResponse, retError error) {
 	if wh.isShuttingDown() {
@@ -2568,7 +2800,7 @@ func (wh *WorkflowHandler) RestartWorkflowExecution(ctx context.Context, request
 	}
 
 	isolationGroup := wh.getIsolationGroup(ctx, domainName)
-	if !wh.isIsolationGroupHealthy(ctx, domainName, isolationGroup) {
+	if !wh.isIsolationGroupHealthy(ctx, domainName, isolationGroup, wfExecution.GetWorkflowID()) {
 		return nil, &types.BadRequestError{fmt.Sprintf("Domain %s is drained from isolation group %s.", domainName, isolationGroup)}
 	}
 
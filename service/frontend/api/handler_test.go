@@ -38,6 +38,7 @@ import (
 	"go.uber.org/yarpc/yarpctest"
 
 	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/.gen/go/sqlblobs"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
@@ -726,7 +727,48 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_IsolationGroupDrained(
 	isolationGroup := "dca1"
 	ctx := isolationgroup.ContextWithIsolationGroup(context.Background(), isolationGroup)
 	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
-	s.mockResource.IsolationGroups.EXPECT().IsDrained(gomock.Any(), s.testDomain, isolationGroup).Return(true, nil)
+	s.mockResource.IsolationGroups.EXPECT().TrafficWeight(gomock.Any(), s.testDomain, isolationGroup).Return(int32(0), nil)
+	_, err := wh.StartWorkflowExecution(ctx, startWorkflowExecutionRequest)
+	s.Error(err)
+	s.IsType(err, &types.BadRequestError{})
+}
+
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_IsolationGroupWeighted() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.UserRPS = dynamicproperties.GetIntPropertyFn(10)
+	config.EnableTasklistIsolation = dynamicproperties.GetBoolPropertyFnFilteredByDomain(true)
+	wh := s.getWorkflowHandler(config)
+
+	// pick a workflow which falls outside of the traffic weight of the isolation group
+	workflowID := "workflow-id"
+	for i := 0; isolationgroup.IsWithinTrafficWeight(workflowID, 50); i++ {
+		workflowID = fmt.Sprintf("workflow-id-%d", i)
+	}
+
+	startWorkflowExecutionRequest := &types.StartWorkflowExecutionRequest{
+		Domain:     s.testDomain,
+		WorkflowID: workflowID,
+		WorkflowType: &types.WorkflowType{
+			Name: "workflow-type",
+		},
+		TaskList: &types.TaskList{
+			Name: "task-list",
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RetryPolicy: &types.RetryPolicy{
+			InitialIntervalInSeconds:    1,
+			BackoffCoefficient:          2,
+			MaximumIntervalInSeconds:    2,
+			MaximumAttempts:             1,
+			ExpirationIntervalInSeconds: 1,
+		},
+		RequestID: uuid.New(),
+	}
+	isolationGroup := "dca1"
+	ctx := isolationgroup.ContextWithIsolationGroup(context.Background(), isolationGroup)
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+	s.mockResource.IsolationGroups.EXPECT().TrafficWeight(gomock.Any(), s.testDomain, isolationGroup).Return(int32(50), nil)
 	_, err := wh.StartWorkflowExecution(ctx, startWorkflowExecutionRequest)
 	s.Error(err)
 	s.IsType(err, &types.BadRequestError{})
@@ -1880,7 +1922,7 @@ func (s *workflowHandlerSuite) TestRestartWorkflowExecution_IsolationGroupDraine
 	isolationGroup := "dca1"
 	ctx := isolationgroup.ContextWithIsolationGroup(context.Background(), isolationGroup)
 	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
-	s.mockResource.IsolationGroups.EXPECT().IsDrained(gomock.Any(), s.testDomain, isolationGroup).Return(true, nil)
+	s.mockResource.IsolationGroups.EXPECT().TrafficWeight(gomock.Any(), s.testDomain, isolationGroup).Return(int32(0), nil)
 	_, err = wh.RestartWorkflowExecution(ctx, &types.RestartWorkflowExecutionRequest{
 		Domain: s.testDomain,
 		WorkflowExecution: &types.WorkflowExecution{
@@ -3644,6 +3686,240 @@ func TestSignalWithStartWorkflowExecutionAsync(t *testing.T) {
 	}
 }
 
+func TestSignalWorkflowExecutionAsync(t *testing.T) {
+	validRequest := &types.SignalWorkflowExecutionAsyncRequest{
+		SignalWorkflowExecutionRequest: &types.SignalWorkflowExecutionRequest{
+			Domain: "test-domain",
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: "test-workflow-id",
+			},
+			SignalName: "test-signal-name",
+			Input:      []byte("test-input"),
+			Identity:   "test-identity",
+			RequestID:  uuid.New(),
+		},
+	}
+	testCases := []struct {
+		name       string
+		setupMocks func(*resource.Test, *MockProducerManager)
+		request    *types.SignalWorkflowExecutionAsyncRequest
+		wantErr    error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func(mockResource *resource.Test, mockQueue *MockProducerManager) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+				mockProducer := &mocks.KafkaProducer{}
+				mockQueue.EXPECT().GetProducerByDomain("test-domain").Return(mockProducer, nil)
+				mockProducer.On("Publish", mock.Anything, mock.MatchedBy(func(msg *sqlblobs.AsyncRequestMessage) bool {
+					return msg.GetType() == sqlblobs.AsyncRequestTypeSignalWorkflowExecutionAsyncRequest &&
+						msg.GetPartitionKey() == "test-workflow-id"
+				})).Return(nil)
+			},
+			request: validRequest,
+		},
+		{
+			name:       "Error case - nil request",
+			setupMocks: func(*resource.Test, *MockProducerManager) {},
+			request:    &types.SignalWorkflowExecutionAsyncRequest{},
+			wantErr:    validate.ErrRequestNotSet,
+		},
+		{
+			name:       "Error case - signal name not set",
+			setupMocks: func(*resource.Test, *MockProducerManager) {},
+			request: &types.SignalWorkflowExecutionAsyncRequest{
+				SignalWorkflowExecutionRequest: &types.SignalWorkflowExecutionRequest{
+					Domain: "test-domain",
+					WorkflowExecution: &types.WorkflowExecution{
+						WorkflowID: "test-workflow-id",
+					},
+				},
+			},
+			wantErr: validate.ErrSignalNameNotSet,
+		},
+		{
+			name: "Error case - failed to publish message",
+			setupMocks: func(mockResource *resource.Test, mockQueue *MockProducerManager) {
+				mockResource.DomainCache.EXPECT().GetDomainID("test-domain").Return("test-domain-id", nil)
+				mockProducer := &mocks.KafkaProducer{}
+				mockQueue.EXPECT().GetProducerByDomain("test-domain").Return(mockProducer, nil)
+				mockProducer.On("Publish", mock.Anything, mock.Anything).Return(errors.New("test-error"))
+			},
+			request: validRequest,
+			wantErr: errors.New("test-error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wh, mockResource, mockProducerManager := newAsyncWorkflowHandlerForTest(t)
+			tc.setupMocks(mockResource, mockProducerManager)
+
+			_, err := wh.SignalWorkflowExecutionAsync(context.Background(), tc.request)
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRequestCancelWorkflowExecutionAsync(t *testing.T) {
+	validRequest := &types.RequestCancelWorkflowExecutionAsyncRequest{
+		RequestCancelWorkflowExecutionRequest: &types.RequestCancelWorkflowExecutionRequest{
+			Domain: "test-domain",
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: "test-workflow-id",
+			},
+			Identity:  "test-identity",
+			RequestID: uuid.New(),
+		},
+	}
+	testCases := []struct {
+		name       string
+		setupMocks func(*MockProducerManager)
+		request    *types.RequestCancelWorkflowExecutionAsyncRequest
+		wantErr    error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func(mockQueue *MockProducerManager) {
+				mockProducer := &mocks.KafkaProducer{}
+				mockQueue.EXPECT().GetProducerByDomain("test-domain").Return(mockProducer, nil)
+				mockProducer.On("Publish", mock.Anything, mock.MatchedBy(func(msg *sqlblobs.AsyncRequestMessage) bool {
+					return msg.GetType() == sqlblobs.AsyncRequestTypeRequestCancelWorkflowExecutionAsyncRequest &&
+						msg.GetPartitionKey() == "test-workflow-id"
+				})).Return(nil)
+			},
+			request: validRequest,
+		},
+		{
+			name:       "Error case - nil request",
+			setupMocks: func(*MockProducerManager) {},
+			request:    nil,
+			wantErr:    validate.ErrRequestNotSet,
+		},
+		{
+			name:       "Error case - domain not set",
+			setupMocks: func(*MockProducerManager) {},
+			request: &types.RequestCancelWorkflowExecutionAsyncRequest{
+				RequestCancelWorkflowExecutionRequest: &types.RequestCancelWorkflowExecutionRequest{},
+			},
+			wantErr: validate.ErrDomainNotSet,
+		},
+		{
+			name: "Error case - failed to get async queue producer",
+			setupMocks: func(mockQueue *MockProducerManager) {
+				mockQueue.EXPECT().GetProducerByDomain("test-domain").Return(nil, errors.New("test-error"))
+			},
+			request: validRequest,
+			wantErr: errors.New("test-error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wh, _, mockProducerManager := newAsyncWorkflowHandlerForTest(t)
+			tc.setupMocks(mockProducerManager)
+
+			_, err := wh.RequestCancelWorkflowExecutionAsync(context.Background(), tc.request)
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTerminateWorkflowExecutionAsync(t *testing.T) {
+	validRequest := &types.TerminateWorkflowExecutionAsyncRequest{
+		TerminateWorkflowExecutionRequest: &types.TerminateWorkflowExecutionRequest{
+			Domain: "test-domain",
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: "test-workflow-id",
+			},
+			Reason:   "test-reason",
+			Identity: "test-identity",
+		},
+	}
+	testCases := []struct {
+		name       string
+		setupMocks func(*MockProducerManager)
+		request    *types.TerminateWorkflowExecutionAsyncRequest
+		wantErr    error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func(mockQueue *MockProducerManager) {
+				mockProducer := &mocks.KafkaProducer{}
+				mockQueue.EXPECT().GetProducerByDomain("test-domain").Return(mockProducer, nil)
+				mockProducer.On("Publish", mock.Anything, mock.MatchedBy(func(msg *sqlblobs.AsyncRequestMessage) bool {
+					return msg.GetType() == sqlblobs.AsyncRequestTypeTerminateWorkflowExecutionAsyncRequest &&
+						msg.GetPartitionKey() == "test-workflow-id"
+				})).Return(nil)
+			},
+			request: validRequest,
+		},
+		{
+			name:       "Error case - workflow ID not set",
+			setupMocks: func(*MockProducerManager) {},
+			request: &types.TerminateWorkflowExecutionAsyncRequest{
+				TerminateWorkflowExecutionRequest: &types.TerminateWorkflowExecutionRequest{
+					Domain: "test-domain",
+				},
+			},
+			wantErr: validate.ErrExecutionNotSet,
+		},
+		{
+			name: "Error case - failed to publish message",
+			setupMocks: func(mockQueue *MockProducerManager) {
+				mockProducer := &mocks.KafkaProducer{}
+				mockQueue.EXPECT().GetProducerByDomain("test-domain").Return(mockProducer, nil)
+				mockProducer.On("Publish", mock.Anything, mock.Anything).Return(errors.New("test-error"))
+			},
+			request: validRequest,
+			wantErr: errors.New("test-error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wh, _, mockProducerManager := newAsyncWorkflowHandlerForTest(t)
+			tc.setupMocks(mockProducerManager)
+
+			_, err := wh.TerminateWorkflowExecutionAsync(context.Background(), tc.request)
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func newAsyncWorkflowHandlerForTest(t *testing.T) (*WorkflowHandler, *resource.Test, *MockProducerManager) {
+	mockCtrl := gomock.NewController(t)
+	mockResource := resource.NewTest(t, mockCtrl, metrics.Frontend)
+	mockVersionChecker := client.NewMockVersionChecker(mockCtrl)
+	mockProducerManager := NewMockProducerManager(mockCtrl)
+
+	cfg := frontendcfg.NewConfig(
+		dc.NewCollection(
+			dc.NewInMemoryClient(),
+			mockResource.GetLogger(),
+		),
+		numHistoryShards,
+		false,
+		"hostname",
+		mockResource.GetLogger(),
+	)
+	wh := NewWorkflowHandler(mockResource, cfg, mockVersionChecker, nil)
+	wh.producerManager = mockProducerManager
+	return wh, mockResource, mockProducerManager
+}
+
 func TestRequestCancelWorkflowExecution(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -4389,6 +4665,113 @@ func (s *workflowHandlerSuite) TestTerminateWorkflowExecution() {
 	}
 }
 
+func (s *workflowHandlerSuite) TestUpdateWorkflowMemo() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	wh := NewWorkflowHandler(s.mockResource, config, s.mockVersionChecker, nil)
+
+	validRequest := &types.UpdateWorkflowMemoRequest{
+		Domain: s.testDomain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: testWorkflowID,
+			RunID:      testRunID,
+		},
+		Memo:     &types.Memo{Fields: map[string][]byte{"key": []byte("value")}},
+		Identity: "identity",
+	}
+
+	testInput := map[string]struct {
+		request         *types.UpdateWorkflowMemoRequest
+		expectError     bool
+		mockFn          func()
+		expectErrorType error
+	}{
+		"shutting down": {
+			request: validRequest,
+			mockFn: func() {
+				wh.shuttingDown = int32(1)
+			},
+			expectError:     true,
+			expectErrorType: validate.ErrShuttingDown,
+		},
+		"nil request": {
+			request:         nil,
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrRequestNotSet,
+		},
+		"empty domain": {
+			request: &types.UpdateWorkflowMemoRequest{
+				Domain: "",
+			},
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrDomainNotSet,
+		},
+		"empty workflow execution": {
+			request: &types.UpdateWorkflowMemoRequest{
+				Domain: s.testDomain,
+			},
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrExecutionNotSet,
+		},
+		"empty memo": {
+			request: &types.UpdateWorkflowMemoRequest{
+				Domain:            s.testDomain,
+				WorkflowExecution: validRequest.WorkflowExecution,
+				Memo:              &types.Memo{},
+			},
+			mockFn:          func() {},
+			expectError:     true,
+			expectErrorType: validate.ErrMemoNotSet,
+		},
+		"cannot get domain ID": {
+			request: validRequest,
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return("", errors.New("error getting domain ID"))
+			},
+			expectError: true,
+		},
+		"history client error": {
+			request: validRequest,
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockHistoryClient.EXPECT().UpdateWorkflowMemo(gomock.Any(), gomock.Any()).Return(errors.New("error"))
+			},
+			expectError: true,
+		},
+		"success": {
+			request: validRequest,
+			mockFn: func() {
+				s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil)
+				s.mockHistoryClient.EXPECT().UpdateWorkflowMemo(gomock.Any(), &types.HistoryUpdateWorkflowMemoRequest{
+					DomainUUID:    s.testDomainID,
+					UpdateRequest: validRequest,
+				}).Return(nil)
+			},
+			expectError: false,
+		},
+	}
+
+	for name, input := range testInput {
+		s.Run(name, func() {
+			input.mockFn()
+			resp, err := wh.UpdateWorkflowMemo(context.Background(), input.request)
+			if input.expectError {
+				s.Error(err)
+				s.Nil(resp)
+				if input.expectErrorType != nil {
+					s.ErrorIs(err, input.expectErrorType)
+				}
+			} else {
+				s.NoError(err)
+				s.Equal(&types.UpdateWorkflowMemoResponse{}, resp)
+			}
+			wh.shuttingDown = int32(0)
+		})
+	}
+}
+
 func (s *workflowHandlerSuite) TestNormalizeVersionedErrors() {
 	config := s.newConfig(dc.NewInMemoryClient())
 	wh := s.getWorkflowHandler(config)
@@ -55,6 +55,10 @@ type (
 		ListOpenWorkflowExecutions(context.Context, *types.ListOpenWorkflowExecutionsRequest) (*types.ListOpenWorkflowExecutionsResponse, error)
 		ListTaskListPartitions(context.Context, *types.ListTaskListPartitionsRequest) (*types.ListTaskListPartitionsResponse, error)
 		GetTaskListsByDomain(context.Context, *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error)
+		GetTaskListScalingRecommendation(context.Context, *types.GetTaskListScalingRecommendationRequest) (*types.GetTaskListScalingRecommendationResponse, error)
+		ListWorkers(context.Context, *types.ListWorkersRequest) (*types.ListWorkersResponse, error)
+		DescribeAsyncRequest(context.Context, *types.DescribeAsyncRequestRequest) (*types.DescribeAsyncRequestResponse, error)
+		DescribeWorker(context.Context, *types.DescribeWorkerRequest) (*types.DescribeWorkerResponse, error)
 		RefreshWorkflowTasks(context.Context, *types.RefreshWorkflowTasksRequest) error
 		ListWorkflowExecutions(context.Context, *types.ListWorkflowExecutionsRequest) (*types.ListWorkflowExecutionsResponse, error)
 		PollForActivityTask(context.Context, *types.PollForActivityTaskRequest) (*types.PollForActivityTaskResponse, error)
@@ -64,6 +68,7 @@ type (
 		RecordActivityTaskHeartbeatByID(context.Context, *types.RecordActivityTaskHeartbeatByIDRequest) (*types.RecordActivityTaskHeartbeatResponse, error)
 		RegisterDomain(context.Context, *types.RegisterDomainRequest) error
 		RequestCancelWorkflowExecution(context.Context, *types.RequestCancelWorkflowExecutionRequest) error
+		RequestCancelWorkflowExecutionAsync(context.Context, *types.RequestCancelWorkflowExecutionAsyncRequest) (*types.RequestCancelWorkflowExecutionAsyncResponse, error)
 		ResetStickyTaskList(context.Context, *types.ResetStickyTaskListRequest) (*types.ResetStickyTaskListResponse, error)
 		ResetWorkflowExecution(context.Context, *types.ResetWorkflowExecutionRequest) (*types.ResetWorkflowExecutionResponse, error)
 		RespondActivityTaskCanceled(context.Context, *types.RespondActivityTaskCanceledRequest) error
@@ -80,9 +85,12 @@ type (
 		SignalWithStartWorkflowExecution(context.Context, *types.SignalWithStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error)
 		SignalWithStartWorkflowExecutionAsync(context.Context, *types.SignalWithStartWorkflowExecutionAsyncRequest) (*types.SignalWithStartWorkflowExecutionAsyncResponse, error)
 		SignalWorkflowExecution(context.Context, *types.SignalWorkflowExecutionRequest) error
+		SignalWorkflowExecutionAsync(context.Context, *types.SignalWorkflowExecutionAsyncRequest) (*types.SignalWorkflowExecutionAsyncResponse, error)
 		StartWorkflowExecution(context.Context, *types.StartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error)
 		StartWorkflowExecutionAsync(context.Context, *types.StartWorkflowExecutionAsyncRequest) (*types.StartWorkflowExecutionAsyncResponse, error)
 		TerminateWorkflowExecution(context.Context, *types.TerminateWorkflowExecutionRequest) error
+		TerminateWorkflowExecutionAsync(context.Context, *types.TerminateWorkflowExecutionAsyncRequest) (*types.TerminateWorkflowExecutionAsyncResponse, error)
 		UpdateDomain(context.Context, *types.UpdateDomainRequest) (*types.UpdateDomainResponse, error)
+		UpdateWorkflowMemo(context.Context, *types.UpdateWorkflowMemoRequest) (*types.UpdateWorkflowMemoResponse, error)
 	}
 )
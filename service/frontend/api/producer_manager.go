@@ -34,6 +34,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -45,10 +46,11 @@ type (
 	}
 
 	producerManagerImpl struct {
-		domainCache   cache.DomainCache
-		provider      queue.Provider
-		logger        log.Logger
-		metricsClient metrics.Client
+		domainCache         cache.DomainCache
+		provider            queue.Provider
+		asyncRequestManager persistence.AsyncRequestManager
+		logger              log.Logger
+		metricsClient       metrics.Client
 
 		producerCache cache.Cache
 	}
@@ -57,14 +59,16 @@ type (
 func NewProducerManager(
 	domainCache cache.DomainCache,
 	provider queue.Provider,
+	asyncRequestManager persistence.AsyncRequestManager,
 	logger log.Logger,
 	metricsClient metrics.Client,
 ) ProducerManager {
 	return &producerManagerImpl{
-		domainCache:   domainCache,
-		provider:      provider,
-		logger:        logger,
-		metricsClient: metricsClient,
+		domainCache:         domainCache,
+		provider:            provider,
+		asyncRequestManager: asyncRequestManager,
+		logger:              logger,
+		metricsClient:       metricsClient,
 		producerCache: cache.New(&cache.Options{
 			TTL:             time.Minute * 5,
 			InitialCapacity: 5,
@@ -107,7 +111,11 @@ func (q *producerManagerImpl) GetProducerByDomain(
 		return val.(messaging.Producer), nil
 	}
 
-	producer, err := queue.CreateProducer(&provider.Params{Logger: q.logger, MetricsClient: q.metricsClient})
+	producer, err := queue.CreateProducer(&provider.Params{
+		Logger:              q.logger,
+		MetricsClient:       q.metricsClient,
+		AsyncRequestManager: q.asyncRequestManager,
+	})
 	if err != nil {
 		return nil, err
 	}
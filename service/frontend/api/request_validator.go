@@ -43,6 +43,9 @@ type (
 		ValidateDescribeTaskListRequest(context.Context, *types.DescribeTaskListRequest) error
 		ValidateListTaskListPartitionsRequest(context.Context, *types.ListTaskListPartitionsRequest) error
 		ValidateGetTaskListsByDomainRequest(context.Context, *types.GetTaskListsByDomainRequest) error
+		ValidateGetTaskListScalingRecommendationRequest(context.Context, *types.GetTaskListScalingRecommendationRequest) error
+		ValidateListWorkersRequest(context.Context, *types.ListWorkersRequest) error
+		ValidateDescribeWorkerRequest(context.Context, *types.DescribeWorkerRequest) error
 		ValidateResetStickyTaskListRequest(context.Context, *types.ResetStickyTaskListRequest) error
 		ValidateCountWorkflowExecutionsRequest(context.Context, *types.CountWorkflowExecutionsRequest) error
 		ValidateListWorkflowExecutionsRequest(context.Context, *types.ListWorkflowExecutionsRequest) error
@@ -154,6 +157,43 @@ func (v *requestValidatorImpl) ValidateGetTaskListsByDomainRequest(ctx context.C
 	return nil
 }
 
+func (v *requestValidatorImpl) ValidateGetTaskListScalingRecommendationRequest(ctx context.Context, request *types.GetTaskListScalingRecommendationRequest) error {
+	if request == nil {
+		return validate.ErrRequestNotSet
+	}
+	if request.GetDomain() == "" {
+		return validate.ErrDomainNotSet
+	}
+	if request.TaskListType == nil {
+		return validate.ErrTaskListTypeNotSet
+	}
+	scope := getMetricsScopeWithDomain(metrics.FrontendGetTaskListScalingRecommendationScope, request, v.metricsClient).Tagged(metrics.GetContextTags(ctx)...)
+	return v.validateTaskList(request.TaskList, scope, request.GetDomain())
+}
+
+func (v *requestValidatorImpl) ValidateListWorkersRequest(ctx context.Context, request *types.ListWorkersRequest) error {
+	if request == nil {
+		return validate.ErrRequestNotSet
+	}
+	if request.GetDomain() == "" {
+		return validate.ErrDomainNotSet
+	}
+	return nil
+}
+
+func (v *requestValidatorImpl) ValidateDescribeWorkerRequest(ctx context.Context, request *types.DescribeWorkerRequest) error {
+	if request == nil {
+		return validate.ErrRequestNotSet
+	}
+	if request.GetDomain() == "" {
+		return validate.ErrDomainNotSet
+	}
+	if request.GetIdentity() == "" {
+		return validate.ErrIdentityNotSet
+	}
+	return nil
+}
+
 func (v *requestValidatorImpl) ValidateResetStickyTaskListRequest(ctx context.Context, resetRequest *types.ResetStickyTaskListRequest) error {
 	if resetRequest == nil {
 		return validate.ErrRequestNotSet
@@ -112,6 +112,34 @@ func (mr *MockRequestValidatorMockRecorder) ValidateDescribeTaskListRequest(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateDescribeTaskListRequest", reflect.TypeOf((*MockRequestValidator)(nil).ValidateDescribeTaskListRequest), arg0, arg1)
 }
 
+// ValidateDescribeWorkerRequest mocks base method.
+func (m *MockRequestValidator) ValidateDescribeWorkerRequest(arg0 context.Context, arg1 *types.DescribeWorkerRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateDescribeWorkerRequest", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateDescribeWorkerRequest indicates an expected call of ValidateDescribeWorkerRequest.
+func (mr *MockRequestValidatorMockRecorder) ValidateDescribeWorkerRequest(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateDescribeWorkerRequest", reflect.TypeOf((*MockRequestValidator)(nil).ValidateDescribeWorkerRequest), arg0, arg1)
+}
+
+// ValidateGetTaskListScalingRecommendationRequest mocks base method.
+func (m *MockRequestValidator) ValidateGetTaskListScalingRecommendationRequest(arg0 context.Context, arg1 *types.GetTaskListScalingRecommendationRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateGetTaskListScalingRecommendationRequest", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateGetTaskListScalingRecommendationRequest indicates an expected call of ValidateGetTaskListScalingRecommendationRequest.
+func (mr *MockRequestValidatorMockRecorder) ValidateGetTaskListScalingRecommendationRequest(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateGetTaskListScalingRecommendationRequest", reflect.TypeOf((*MockRequestValidator)(nil).ValidateGetTaskListScalingRecommendationRequest), arg0, arg1)
+}
+
 // ValidateGetTaskListsByDomainRequest mocks base method.
 func (m *MockRequestValidator) ValidateGetTaskListsByDomainRequest(arg0 context.Context, arg1 *types.GetTaskListsByDomainRequest) error {
 	m.ctrl.T.Helper()
@@ -182,6 +210,20 @@ func (mr *MockRequestValidatorMockRecorder) ValidateListTaskListPartitionsReques
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateListTaskListPartitionsRequest", reflect.TypeOf((*MockRequestValidator)(nil).ValidateListTaskListPartitionsRequest), arg0, arg1)
 }
 
+// ValidateListWorkersRequest mocks base method.
+func (m *MockRequestValidator) ValidateListWorkersRequest(arg0 context.Context, arg1 *types.ListWorkersRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateListWorkersRequest", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateListWorkersRequest indicates an expected call of ValidateListWorkersRequest.
+func (mr *MockRequestValidatorMockRecorder) ValidateListWorkersRequest(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateListWorkersRequest", reflect.TypeOf((*MockRequestValidator)(nil).ValidateListWorkersRequest), arg0, arg1)
+}
+
 // ValidateListWorkflowExecutionsRequest mocks base method.
 func (m *MockRequestValidator) ValidateListWorkflowExecutionsRequest(arg0 context.Context, arg1 *types.ListWorkflowExecutionsRequest) error {
 	m.ctrl.T.Helper()
@@ -319,6 +319,161 @@ func TestValidateValidateGetTaskListsByDomainRequest(t *testing.T) {
 	}
 }
 
+func TestValidateGetTaskListScalingRecommendationRequest(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           *types.GetTaskListScalingRecommendationRequest
+		expectError   bool
+		expectedError string
+	}{
+		{
+			name: "success",
+			req: &types.GetTaskListScalingRecommendationRequest{
+				Domain: "domain",
+				TaskList: &types.TaskList{
+					Name: "tl",
+				},
+				TaskListType: types.TaskListTypeDecision.Ptr(),
+			},
+			expectError: false,
+		},
+		{
+			name:          "not set",
+			req:           nil,
+			expectError:   true,
+			expectedError: "Request is nil.",
+		},
+		{
+			name: "domain not set",
+			req: &types.GetTaskListScalingRecommendationRequest{
+				Domain: "",
+			},
+			expectError:   true,
+			expectedError: "Domain not set on request.",
+		},
+		{
+			name: "task list type not set",
+			req: &types.GetTaskListScalingRecommendationRequest{
+				Domain: "domain",
+				TaskList: &types.TaskList{
+					Name: "tl",
+				},
+			},
+			expectError:   true,
+			expectedError: "TaskListType is not set on request.",
+		},
+		{
+			name: "task list not set",
+			req: &types.GetTaskListScalingRecommendationRequest{
+				Domain:       "domain",
+				TaskListType: types.TaskListTypeDecision.Ptr(),
+			},
+			expectError:   true,
+			expectedError: "TaskList is not set on request.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v, deps := setupMocksForRequestValidator(t)
+			require.NoError(t, deps.dynamicClient.UpdateValue(dynamicproperties.TaskListNameMaxLength, 5))
+
+			err := v.ValidateGetTaskListScalingRecommendationRequest(context.Background(), tc.req)
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateListWorkersRequest(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           *types.ListWorkersRequest
+		expectError   bool
+		expectedError string
+	}{
+		{
+			name:        "success",
+			req:         &types.ListWorkersRequest{Domain: "domain"},
+			expectError: false,
+		},
+		{
+			name:          "not set",
+			req:           nil,
+			expectError:   true,
+			expectedError: "Request is nil.",
+		},
+		{
+			name:          "domain not set",
+			req:           &types.ListWorkersRequest{},
+			expectError:   true,
+			expectedError: "Domain not set on request.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v, _ := setupMocksForRequestValidator(t)
+
+			err := v.ValidateListWorkersRequest(context.Background(), tc.req)
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateDescribeWorkerRequest(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           *types.DescribeWorkerRequest
+		expectError   bool
+		expectedError string
+	}{
+		{
+			name:        "success",
+			req:         &types.DescribeWorkerRequest{Domain: "domain", Identity: "worker"},
+			expectError: false,
+		},
+		{
+			name:          "not set",
+			req:           nil,
+			expectError:   true,
+			expectedError: "Request is nil.",
+		},
+		{
+			name:          "domain not set",
+			req:           &types.DescribeWorkerRequest{Identity: "worker"},
+			expectError:   true,
+			expectedError: "Domain not set on request.",
+		},
+		{
+			name:          "identity not set",
+			req:           &types.DescribeWorkerRequest{Domain: "domain"},
+			expectError:   true,
+			expectedError: "Identity is not set on request.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v, _ := setupMocksForRequestValidator(t)
+
+			err := v.ValidateDescribeWorkerRequest(context.Background(), tc.req)
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateValidateResetStickyTaskListRequest(t *testing.T) {
 	testCases := []struct {
 		name          string
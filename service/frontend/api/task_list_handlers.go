@@ -91,6 +91,28 @@ func (wh *WorkflowHandler) GetTaskListsByDomain(
 	return resp, err
 }
 
+// GetTaskListScalingRecommendation returns the recommended number of pollers for a tasklist, based on
+// the backlog, task rates, sync match ratio, schedule to start latency and pollers of all of its partitions.
+func (wh *WorkflowHandler) GetTaskListScalingRecommendation(
+	ctx context.Context,
+	request *types.GetTaskListScalingRecommendationRequest,
+) (resp *types.GetTaskListScalingRecommendationResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if err := wh.requestValidator.ValidateGetTaskListScalingRecommendationRequest(ctx, request); err != nil {
+		return nil, err
+	}
+	domainID, err := wh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+	return wh.GetMatchingClient().GetTaskListScalingRecommendation(ctx, &types.MatchingGetTaskListScalingRecommendationRequest{
+		DomainUUID: domainID,
+		Request:    request,
+	})
+}
+
 // ResetStickyTaskList reset the volatile information in mutable state of a given workflow.
 func (wh *WorkflowHandler) ResetStickyTaskList(
 	ctx context.Context,
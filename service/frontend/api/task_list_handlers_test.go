@@ -290,6 +290,85 @@ func TestGetTaskListsByDomain(t *testing.T) {
 	}
 }
 
+func TestGetTaskListScalingRecommendation(t *testing.T) {
+	req := &types.GetTaskListScalingRecommendationRequest{
+		Domain: "domain",
+		TaskList: &types.TaskList{
+			Name: "tl",
+		},
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+	}
+	testCases := []struct {
+		name          string
+		setupMocks    func(*mockDeps)
+		expectError   bool
+		expectedError string
+		expected      *types.GetTaskListScalingRecommendationResponse
+	}{
+		{
+			name: "success",
+			setupMocks: func(deps *mockDeps) {
+				deps.mockRequestValidator.EXPECT().ValidateGetTaskListScalingRecommendationRequest(gomock.Any(), req).Return(nil)
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+				deps.mockMatchingClient.EXPECT().GetTaskListScalingRecommendation(gomock.Any(), &types.MatchingGetTaskListScalingRecommendationRequest{
+					DomainUUID: "domain-id",
+					Request:    req,
+				}).Return(&types.GetTaskListScalingRecommendationResponse{
+					RecommendedPollerCount: 4,
+					Confidence:             types.TaskListScalingConfidenceHigh.Ptr(),
+				}, nil)
+			},
+			expectError: false,
+			expected: &types.GetTaskListScalingRecommendationResponse{
+				RecommendedPollerCount: 4,
+				Confidence:             types.TaskListScalingConfidenceHigh.Ptr(),
+			},
+		},
+		{
+			name: "matching client error",
+			setupMocks: func(deps *mockDeps) {
+				deps.mockRequestValidator.EXPECT().ValidateGetTaskListScalingRecommendationRequest(gomock.Any(), req).Return(nil)
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+				deps.mockMatchingClient.EXPECT().GetTaskListScalingRecommendation(gomock.Any(), gomock.Any()).Return(nil, errors.New("matching client error"))
+			},
+			expectError:   true,
+			expectedError: "matching client error",
+		},
+		{
+			name: "domain cache error",
+			setupMocks: func(deps *mockDeps) {
+				deps.mockRequestValidator.EXPECT().ValidateGetTaskListScalingRecommendationRequest(gomock.Any(), req).Return(nil)
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("", errors.New("domain cache error"))
+			},
+			expectError:   true,
+			expectedError: "domain cache error",
+		},
+		{
+			name: "validator error",
+			setupMocks: func(deps *mockDeps) {
+				deps.mockRequestValidator.EXPECT().ValidateGetTaskListScalingRecommendationRequest(gomock.Any(), req).Return(errors.New("validator error"))
+			},
+			expectError:   true,
+			expectedError: "validator error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wh, deps := setupMocksForWorkflowHandler(t)
+			tc.setupMocks(deps)
+
+			resp, err := wh.GetTaskListScalingRecommendation(context.Background(), req)
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, resp)
+			}
+		})
+	}
+}
+
 func TestResetStickyTaskList(t *testing.T) {
 	testCases := []struct {
 		name          string
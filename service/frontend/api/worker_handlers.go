@@ -0,0 +1,74 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package api
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/frontend/validate"
+)
+
+// ListWorkers returns the workers that polled any task list of the domain in the last few minutes,
+// with the task lists they poll, their SDK version and their poll rate.
+func (wh *WorkflowHandler) ListWorkers(
+	ctx context.Context,
+	request *types.ListWorkersRequest,
+) (resp *types.ListWorkersResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if err := wh.requestValidator.ValidateListWorkersRequest(ctx, request); err != nil {
+		return nil, err
+	}
+	domainID, err := wh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+	return wh.GetMatchingClient().ListWorkers(ctx, &types.MatchingListWorkersRequest{
+		DomainUUID: domainID,
+		Request:    request,
+	})
+}
+
+// DescribeWorker returns a single worker of the domain by identity. It returns EntityNotExistsError
+// if the worker did not poll any task list of the domain in the last few minutes.
+func (wh *WorkflowHandler) DescribeWorker(
+	ctx context.Context,
+	request *types.DescribeWorkerRequest,
+) (resp *types.DescribeWorkerResponse, retError error) {
+	if wh.isShuttingDown() {
+		return nil, validate.ErrShuttingDown
+	}
+	if err := wh.requestValidator.ValidateDescribeWorkerRequest(ctx, request); err != nil {
+		return nil, err
+	}
+	domainID, err := wh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+	return wh.GetMatchingClient().DescribeWorker(ctx, &types.MatchingDescribeWorkerRequest{
+		DomainUUID: domainID,
+		Request:    request,
+	})
+}
@@ -0,0 +1,170 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestListWorkers(t *testing.T) {
+	req := &types.ListWorkersRequest{Domain: "domain"}
+	workers := []*types.WorkerInfo{{Identity: "worker", PollsPerSecond: 1}}
+	testCases := []struct {
+		name          string
+		setupMocks    func(*mockDeps)
+		expectError   bool
+		expectedError string
+		expected      *types.ListWorkersResponse
+	}{
+		{
+			name: "success",
+			setupMocks: func(deps *mockDeps) {
+				deps.mockRequestValidator.EXPECT().ValidateListWorkersRequest(gomock.Any(), req).Return(nil)
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+				deps.mockMatchingClient.EXPECT().ListWorkers(gomock.Any(), &types.MatchingListWorkersRequest{
+					DomainUUID: "domain-id",
+					Request:    req,
+				}).Return(&types.ListWorkersResponse{Workers: workers}, nil)
+			},
+			expectError: false,
+			expected:    &types.ListWorkersResponse{Workers: workers},
+		},
+		{
+			name: "matching client error",
+			setupMocks: func(deps *mockDeps) {
+				deps.mockRequestValidator.EXPECT().ValidateListWorkersRequest(gomock.Any(), req).Return(nil)
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+				deps.mockMatchingClient.EXPECT().ListWorkers(gomock.Any(), gomock.Any()).Return(nil, errors.New("matching client error"))
+			},
+			expectError:   true,
+			expectedError: "matching client error",
+		},
+		{
+			name: "domain cache error",
+			setupMocks: func(deps *mockDeps) {
+				deps.mockRequestValidator.EXPECT().ValidateListWorkersRequest(gomock.Any(), req).Return(nil)
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("", errors.New("domain cache error"))
+			},
+			expectError:   true,
+			expectedError: "domain cache error",
+		},
+		{
+			name: "validator error",
+			setupMocks: func(deps *mockDeps) {
+				deps.mockRequestValidator.EXPECT().ValidateListWorkersRequest(gomock.Any(), req).Return(errors.New("validator error"))
+			},
+			expectError:   true,
+			expectedError: "validator error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wh, deps := setupMocksForWorkflowHandler(t)
+			tc.setupMocks(deps)
+
+			resp, err := wh.ListWorkers(context.Background(), req)
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, resp)
+			}
+		})
+	}
+}
+
+func TestDescribeWorker(t *testing.T) {
+	req := &types.DescribeWorkerRequest{Domain: "domain", Identity: "worker"}
+	worker := &types.WorkerInfo{Identity: "worker", PollsPerSecond: 1}
+	testCases := []struct {
+		name          string
+		setupMocks    func(*mockDeps)
+		expectError   bool
+		expectedError string
+		expected      *types.DescribeWorkerResponse
+	}{
+		{
+			name: "success",
+			setupMocks: func(deps *mockDeps) {
+				deps.mockRequestValidator.EXPECT().ValidateDescribeWorkerRequest(gomock.Any(), req).Return(nil)
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+				deps.mockMatchingClient.EXPECT().DescribeWorker(gomock.Any(), &types.MatchingDescribeWorkerRequest{
+					DomainUUID: "domain-id",
+					Request:    req,
+				}).Return(&types.DescribeWorkerResponse{Worker: worker}, nil)
+			},
+			expectError: false,
+			expected:    &types.DescribeWorkerResponse{Worker: worker},
+		},
+		{
+			name: "worker not found",
+			setupMocks: func(deps *mockDeps) {
+				deps.mockRequestValidator.EXPECT().ValidateDescribeWorkerRequest(gomock.Any(), req).Return(nil)
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("domain-id", nil)
+				deps.mockMatchingClient.EXPECT().DescribeWorker(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{Message: "worker not found"})
+			},
+			expectError:   true,
+			expectedError: "worker not found",
+		},
+		{
+			name: "domain cache error",
+			setupMocks: func(deps *mockDeps) {
+				deps.mockRequestValidator.EXPECT().ValidateDescribeWorkerRequest(gomock.Any(), req).Return(nil)
+				deps.mockDomainCache.EXPECT().GetDomainID("domain").Return("", errors.New("domain cache error"))
+			},
+			expectError:   true,
+			expectedError: "domain cache error",
+		},
+		{
+			name: "validator error",
+			setupMocks: func(deps *mockDeps) {
+				deps.mockRequestValidator.EXPECT().ValidateDescribeWorkerRequest(gomock.Any(), req).Return(errors.New("validator error"))
+			},
+			expectError:   true,
+			expectedError: "validator error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wh, deps := setupMocksForWorkflowHandler(t)
+			tc.setupMocks(deps)
+
+			resp, err := wh.DescribeWorker(context.Background(), req)
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, resp)
+			}
+		})
+	}
+}
@@ -109,9 +109,21 @@ type Config struct {
 
 	SendRawWorkflowHistory dynamicproperties.BoolPropertyFnWithDomainFilter
 
+	// report domain-wide matching task quotas in DescribeDomain
+	EnableDescribeDomainTaskQuota dynamicproperties.BoolPropertyFnWithDomainFilter
+
 	// max number of decisions per RespondDecisionTaskCompleted request (unlimited by default)
 	DecisionResultCountLimit dynamicproperties.IntPropertyFnWithDomainFilter
 
+	// Completion callbacks
+	EnableCompletionCallbacks         dynamicproperties.BoolPropertyFnWithDomainFilter
+	MaxCompletionCallbacksPerWorkflow dynamicproperties.IntPropertyFnWithDomainFilter
+	CompletionCallbackAllowedHosts    dynamicproperties.MapPropertyFnWithDomainFilter
+
+	// Async request status tracking
+	EnableAsyncRequestTracking dynamicproperties.BoolPropertyFnWithDomainFilter
+	AsyncRequestRetention      dynamicproperties.DurationPropertyFnWithDomainFilter
+
 	// Debugging
 
 	// Emit signal related metrics with signal name tag. Be aware of cardinality.
@@ -182,10 +194,16 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, isAdvancedVis
 		VisibilityArchivalQueryMaxPageSize:          dc.GetIntProperty(dynamicproperties.VisibilityArchivalQueryMaxPageSize),
 		DisallowQuery:                               dc.GetBoolPropertyFilteredByDomain(dynamicproperties.DisallowQuery),
 		SendRawWorkflowHistory:                      dc.GetBoolPropertyFilteredByDomain(dynamicproperties.SendRawWorkflowHistory),
+		EnableDescribeDomainTaskQuota:               dc.GetBoolPropertyFilteredByDomain(dynamicproperties.FrontendEnableDescribeDomainTaskQuota),
 		DecisionResultCountLimit:                    dc.GetIntPropertyFilteredByDomain(dynamicproperties.FrontendDecisionResultCountLimit),
 		EmitSignalNameMetricsTag:                    dc.GetBoolPropertyFilteredByDomain(dynamicproperties.FrontendEmitSignalNameMetricsTag),
 		Lockdown:                                    dc.GetBoolPropertyFilteredByDomain(dynamicproperties.Lockdown),
 		EnableTasklistIsolation:                     dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableTasklistIsolation),
+		EnableCompletionCallbacks:                   dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableCompletionCallbacks),
+		MaxCompletionCallbacksPerWorkflow:           dc.GetIntPropertyFilteredByDomain(dynamicproperties.MaxCompletionCallbacksPerWorkflow),
+		CompletionCallbackAllowedHosts:              dc.GetMapPropertyFilteredByDomain(dynamicproperties.CompletionCallbackAllowedHosts),
+		EnableAsyncRequestTracking:                  dc.GetBoolPropertyFilteredByDomain(dynamicproperties.FrontendEnableAsyncRequestTracking),
+		AsyncRequestRetention:                       dc.GetDurationPropertyFilteredByDomain(dynamicproperties.FrontendAsyncRequestRetention),
 		DomainConfig: domain.Config{
 			MaxBadBinaryCount:      dc.GetIntPropertyFilteredByDomain(dynamicproperties.FrontendMaxBadBinaries),
 			MinRetentionDays:       dc.GetIntProperty(dynamicproperties.MinRetentionDays),
@@ -100,10 +100,16 @@ func TestNewConfig(t *testing.T) {
 		"VisibilityArchivalQueryMaxPageSize":          {dynamicproperties.VisibilityArchivalQueryMaxPageSize, 38},
 		"DisallowQuery":                               {dynamicproperties.DisallowQuery, true},
 		"SendRawWorkflowHistory":                      {dynamicproperties.SendRawWorkflowHistory, false},
+		"EnableDescribeDomainTaskQuota":               {dynamicproperties.FrontendEnableDescribeDomainTaskQuota, true},
 		"DecisionResultCountLimit":                    {dynamicproperties.FrontendDecisionResultCountLimit, 39},
 		"EmitSignalNameMetricsTag":                    {dynamicproperties.FrontendEmitSignalNameMetricsTag, true},
 		"Lockdown":                                    {dynamicproperties.Lockdown, false},
 		"EnableTasklistIsolation":                     {dynamicproperties.EnableTasklistIsolation, true},
+		"EnableCompletionCallbacks":                   {dynamicproperties.EnableCompletionCallbacks, true},
+		"MaxCompletionCallbacksPerWorkflow":           {dynamicproperties.MaxCompletionCallbacksPerWorkflow, 45},
+		"CompletionCallbackAllowedHosts":              {dynamicproperties.CompletionCallbackAllowedHosts, map[string]interface{}{"example.com": true}},
+		"EnableAsyncRequestTracking":                  {dynamicproperties.FrontendEnableAsyncRequestTracking, true},
+		"AsyncRequestRetention":                       {dynamicproperties.FrontendAsyncRequestRetention, time.Duration(46)},
 		"GlobalRatelimiterKeyMode":                    {dynamicproperties.FrontendGlobalRatelimiterMode, "disabled"},
 		"GlobalRatelimiterUpdateInterval":             {dynamicproperties.GlobalRatelimiterUpdateInterval, 3 * time.Second},
 		"PinotOptimizedQueryColumns":                  {dynamicproperties.PinotOptimizedQueryColumns, map[string]interface{}{"foo": "bar"}},
@@ -173,6 +179,8 @@ func getValue(f *reflect.Value) interface{} {
 			return fn()
 		case dynamicproperties.MapPropertyFn:
 			return fn()
+		case dynamicproperties.MapPropertyFnWithDomainFilter:
+			return fn("domain")
 		case dynamicproperties.StringPropertyFn:
 			return fn()
 		case dynamicproperties.StringPropertyWithRatelimitKeyFilter:
@@ -38,12 +38,15 @@ var (
 	ErrWorkflowIDNotSet                           = &types.BadRequestError{Message: "WorkflowId is not set on request."}
 	ErrActivityIDNotSet                           = &types.BadRequestError{Message: "ActivityID is not set on request."}
 	ErrSignalNameNotSet                           = &types.BadRequestError{Message: "SignalName is not set on request."}
+	ErrMemoNotSet                                 = &types.BadRequestError{Message: "Memo is not set on request."}
+	ErrIdentityNotSet                             = &types.BadRequestError{Message: "Identity is not set on request."}
 	ErrInvalidRunID                               = &types.BadRequestError{Message: "Invalid RunId."}
 	ErrInvalidNextPageToken                       = &types.BadRequestError{Message: "Invalid NextPageToken."}
 	ErrNextPageTokenRunIDMismatch                 = &types.BadRequestError{Message: "RunID in the request does not match the NextPageToken."}
 	ErrQueryNotSet                                = &types.BadRequestError{Message: "WorkflowQuery is not set on request."}
 	ErrQueryTypeNotSet                            = &types.BadRequestError{Message: "QueryType is not set on request."}
 	ErrRequestNotSet                              = &types.BadRequestError{Message: "Request is nil."}
+	ErrRequestIDNotSet                            = &types.BadRequestError{Message: "RequestId is not set on request."}
 	ErrNoPermission                               = &types.BadRequestError{Message: "No permission to do this operation."}
 	ErrWorkflowTypeNotSet                         = &types.BadRequestError{Message: "WorkflowType is not set on request."}
 	ErrInvalidRetention                           = &types.BadRequestError{Message: "RetentionDays is invalid."}
@@ -102,6 +102,22 @@ func (a *adminHandler) DeleteWorkflow(ctx context.Context, ap1 *types.AdminDelet
 	return a.handler.DeleteWorkflow(ctx, ap1)
 }
 
+func (a *adminHandler) DescribeAsyncWorkflowQueue(ctx context.Context, dp1 *types.DescribeAsyncWorkflowQueueRequest) (dp2 *types.DescribeAsyncWorkflowQueueResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "DescribeAsyncWorkflowQueue",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(dp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.DescribeAsyncWorkflowQueue(ctx, dp1)
+}
+
 func (a *adminHandler) DescribeCluster(ctx context.Context) (dp1 *types.DescribeClusterResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:    "DescribeCluster",
@@ -373,6 +389,38 @@ func (a *adminHandler) MergeDLQMessages(ctx context.Context, mp1 *types.MergeDLQ
 	return a.handler.MergeDLQMessages(ctx, mp1)
 }
 
+func (a *adminHandler) MigrateTaskList(ctx context.Context, mp1 *types.MigrateTaskListRequest) (mp2 *types.MigrateTaskListResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "MigrateTaskList",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(mp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.MigrateTaskList(ctx, mp1)
+}
+
+func (a *adminHandler) PurgeAsyncWorkflowDLQMessages(ctx context.Context, pp1 *types.PurgeAsyncWorkflowDLQMessagesRequest) (pp2 *types.PurgeAsyncWorkflowDLQMessagesResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "PurgeAsyncWorkflowDLQMessages",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(pp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.PurgeAsyncWorkflowDLQMessages(ctx, pp1)
+}
+
 func (a *adminHandler) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQMessagesRequest) (err error) {
 	attr := &authorization.Attributes{
 		APIName:     "PurgeDLQMessages",
@@ -389,6 +437,22 @@ func (a *adminHandler) PurgeDLQMessages(ctx context.Context, pp1 *types.PurgeDLQ
 	return a.handler.PurgeDLQMessages(ctx, pp1)
 }
 
+func (a *adminHandler) ReadAsyncWorkflowDLQMessages(ctx context.Context, rp1 *types.ReadAsyncWorkflowDLQMessagesRequest) (rp2 *types.ReadAsyncWorkflowDLQMessagesResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "ReadAsyncWorkflowDLQMessages",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(rp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.ReadAsyncWorkflowDLQMessages(ctx, rp1)
+}
+
 func (a *adminHandler) ReadDLQMessages(ctx context.Context, rp1 *types.ReadDLQMessagesRequest) (rp2 *types.ReadDLQMessagesResponse, err error) {
 	attr := &authorization.Attributes{
 		APIName:     "ReadDLQMessages",
@@ -453,6 +517,22 @@ func (a *adminHandler) RemoveTask(ctx context.Context, rp1 *types.RemoveTaskRequ
 	return a.handler.RemoveTask(ctx, rp1)
 }
 
+func (a *adminHandler) ReplayAsyncWorkflowDLQMessages(ctx context.Context, rp1 *types.ReplayAsyncWorkflowDLQMessagesRequest) (rp2 *types.ReplayAsyncWorkflowDLQMessagesResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "ReplayAsyncWorkflowDLQMessages",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(rp1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.ReplayAsyncWorkflowDLQMessages(ctx, rp1)
+}
+
 func (a *adminHandler) ResendReplicationTasks(ctx context.Context, rp1 *types.ResendReplicationTasksRequest) (err error) {
 	attr := &authorization.Attributes{
 		APIName:     "ResendReplicationTasks",
@@ -604,3 +684,19 @@ func (a *adminHandler) UpdateTaskListPartitionConfig(ctx context.Context, up1 *t
 	}
 	return a.handler.UpdateTaskListPartitionConfig(ctx, up1)
 }
+
+func (a *adminHandler) UpdateTaskListVersioningConfig(ctx context.Context, up1 *types.UpdateTaskListVersioningConfigRequest) (up2 *types.UpdateTaskListVersioningConfigResponse, err error) {
+	attr := &authorization.Attributes{
+		APIName:     "UpdateTaskListVersioningConfig",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: authorization.NewFilteredRequestBody(up1),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.UpdateTaskListVersioningConfig(ctx, up1)
+}
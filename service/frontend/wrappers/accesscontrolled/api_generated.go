@@ -91,6 +91,24 @@ func (a *apiHandler) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDo
 	return a.handler.DeprecateDomain(ctx, dp1)
 }
 
+func (a *apiHandler) DescribeAsyncRequest(ctx context.Context, dp1 *types.DescribeAsyncRequestRequest) (dp2 *types.DescribeAsyncRequestResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendDescribeAsyncRequestScope, dp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "DescribeAsyncRequest",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(dp1),
+		DomainName:  dp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.DescribeAsyncRequest(ctx, dp1)
+}
+
 func (a *apiHandler) DescribeDomain(ctx context.Context, dp1 *types.DescribeDomainRequest) (dp2 *types.DescribeDomainResponse, err error) {
 	scope := a.GetMetricsClient().Scope(metrics.FrontendDescribeDomainScope)
 	attr := &authorization.Attributes{
@@ -127,6 +145,24 @@ func (a *apiHandler) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTa
 	return a.handler.DescribeTaskList(ctx, dp1)
 }
 
+func (a *apiHandler) DescribeWorker(ctx context.Context, dp1 *types.DescribeWorkerRequest) (dp2 *types.DescribeWorkerResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendDescribeWorkerScope, dp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "DescribeWorker",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(dp1),
+		DomainName:  dp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.DescribeWorker(ctx, dp1)
+}
+
 func (a *apiHandler) DescribeWorkflowExecution(ctx context.Context, dp1 *types.DescribeWorkflowExecutionRequest) (dp2 *types.DescribeWorkflowExecutionResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendDescribeWorkflowExecutionScope, dp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -157,6 +193,24 @@ func (a *apiHandler) GetSearchAttributes(ctx context.Context) (gp1 *types.GetSea
 	return a.handler.GetSearchAttributes(ctx)
 }
 
+func (a *apiHandler) GetTaskListScalingRecommendation(ctx context.Context, gp1 *types.GetTaskListScalingRecommendationRequest) (gp2 *types.GetTaskListScalingRecommendationResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendGetTaskListScalingRecommendationScope, gp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "GetTaskListScalingRecommendation",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(gp1),
+		DomainName:  gp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.GetTaskListScalingRecommendation(ctx, gp1)
+}
+
 func (a *apiHandler) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest) (gp2 *types.GetTaskListsByDomainResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendGetTaskListsByDomainScope, gp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -286,6 +340,24 @@ func (a *apiHandler) ListTaskListPartitions(ctx context.Context, lp1 *types.List
 	return a.handler.ListTaskListPartitions(ctx, lp1)
 }
 
+func (a *apiHandler) ListWorkers(ctx context.Context, lp1 *types.ListWorkersRequest) (lp2 *types.ListWorkersResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendListWorkersScope, lp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "ListWorkers",
+		Permission:  authorization.PermissionRead,
+		RequestBody: authorization.NewFilteredRequestBody(lp1),
+		DomainName:  lp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.ListWorkers(ctx, lp1)
+}
+
 func (a *apiHandler) ListWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendListWorkflowExecutionsScope, lp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -421,6 +493,24 @@ func (a *apiHandler) RequestCancelWorkflowExecution(ctx context.Context, rp1 *ty
 	return a.handler.RequestCancelWorkflowExecution(ctx, rp1)
 }
 
+func (a *apiHandler) RequestCancelWorkflowExecutionAsync(ctx context.Context, rp1 *types.RequestCancelWorkflowExecutionAsyncRequest) (rp2 *types.RequestCancelWorkflowExecutionAsyncResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendRequestCancelWorkflowExecutionAsyncScope, rp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "RequestCancelWorkflowExecutionAsync",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(rp1),
+		DomainName:  rp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.RequestCancelWorkflowExecutionAsync(ctx, rp1)
+}
+
 func (a *apiHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendResetStickyTaskListScope, rp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -587,6 +677,24 @@ func (a *apiHandler) SignalWorkflowExecution(ctx context.Context, sp1 *types.Sig
 	return a.handler.SignalWorkflowExecution(ctx, sp1)
 }
 
+func (a *apiHandler) SignalWorkflowExecutionAsync(ctx context.Context, sp1 *types.SignalWorkflowExecutionAsyncRequest) (sp2 *types.SignalWorkflowExecutionAsyncResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendSignalWorkflowExecutionAsyncScope, sp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "SignalWorkflowExecutionAsync",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(sp1),
+		DomainName:  sp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.SignalWorkflowExecutionAsync(ctx, sp1)
+}
+
 func (a *apiHandler) StartWorkflowExecution(ctx context.Context, sp1 *types.StartWorkflowExecutionRequest) (sp2 *types.StartWorkflowExecutionResponse, err error) {
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendStartWorkflowExecutionScope, sp1.GetDomain())
 	attr := &authorization.Attributes{
@@ -645,6 +753,24 @@ func (a *apiHandler) TerminateWorkflowExecution(ctx context.Context, tp1 *types.
 	return a.handler.TerminateWorkflowExecution(ctx, tp1)
 }
 
+func (a *apiHandler) TerminateWorkflowExecutionAsync(ctx context.Context, tp1 *types.TerminateWorkflowExecutionAsyncRequest) (tp2 *types.TerminateWorkflowExecutionAsyncResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendTerminateWorkflowExecutionAsyncScope, tp1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "TerminateWorkflowExecutionAsync",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(tp1),
+		DomainName:  tp1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.TerminateWorkflowExecutionAsync(ctx, tp1)
+}
+
 func (a *apiHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest) (up2 *types.UpdateDomainResponse, err error) {
 	scope := a.GetMetricsClient().Scope(metrics.FrontendUpdateDomainScope)
 	attr := &authorization.Attributes{
@@ -661,3 +787,21 @@ func (a *apiHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRe
 	}
 	return a.handler.UpdateDomain(ctx, up1)
 }
+
+func (a *apiHandler) UpdateWorkflowMemo(ctx context.Context, up1 *types.UpdateWorkflowMemoRequest) (up2 *types.UpdateWorkflowMemoResponse, err error) {
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendUpdateWorkflowMemoScope, up1.GetDomain())
+	attr := &authorization.Attributes{
+		APIName:     "UpdateWorkflowMemo",
+		Permission:  authorization.PermissionWrite,
+		RequestBody: authorization.NewFilteredRequestBody(up1),
+		DomainName:  up1.GetDomain(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+	return a.handler.UpdateWorkflowMemo(ctx, up1)
+}
@@ -106,6 +106,45 @@ func (handler *clusterRedirectionHandler) DeprecateDomain(ctx context.Context, d
 	return handler.frontendHandler.DeprecateDomain(ctx, dp1)
 }
 
+func (handler *clusterRedirectionHandler) DescribeAsyncRequest(ctx context.Context, dp1 *types.DescribeAsyncRequestRequest) (dp2 *types.DescribeAsyncRequestResponse, err error) {
+	var (
+		apiName                   = "DescribeAsyncRequest"
+		cluster                   string
+		requestedConsistencyLevel types.QueryConsistencyLevel = types.QueryConsistencyLevelEventual
+	)
+
+	var domainEntry *cache.DomainCacheEntry
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDescribeAsyncRequestScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, domainEntry, cluster, &err)
+	}()
+
+	domainEntry, err = handler.domainCache.GetDomain(dp1.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	var actClSelPolicyForNewWF *types.ActiveClusterSelectionPolicy
+	var workflowExecution *types.WorkflowExecution
+
+	err = handler.redirectionPolicy.Redirect(ctx, domainEntry, workflowExecution, actClSelPolicyForNewWF, apiName, requestedConsistencyLevel, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			dp2, err = handler.frontendHandler.DescribeAsyncRequest(ctx, dp1)
+		default:
+			remoteClient, clientErr := handler.GetRemoteFrontendClient(targetDC)
+			if clientErr != nil {
+				return clientErr
+			}
+			dp2, err = remoteClient.DescribeAsyncRequest(ctx, dp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return dp2, err
+}
+
 func (handler *clusterRedirectionHandler) DescribeDomain(ctx context.Context, dp1 *types.DescribeDomainRequest) (dp2 *types.DescribeDomainResponse, err error) {
 	return handler.frontendHandler.DescribeDomain(ctx, dp1)
 }
@@ -149,6 +188,45 @@ func (handler *clusterRedirectionHandler) DescribeTaskList(ctx context.Context,
 	return dp2, err
 }
 
+func (handler *clusterRedirectionHandler) DescribeWorker(ctx context.Context, dp1 *types.DescribeWorkerRequest) (dp2 *types.DescribeWorkerResponse, err error) {
+	var (
+		apiName                   = "DescribeWorker"
+		cluster                   string
+		requestedConsistencyLevel types.QueryConsistencyLevel = types.QueryConsistencyLevelEventual
+	)
+
+	var domainEntry *cache.DomainCacheEntry
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDescribeWorkerScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, domainEntry, cluster, &err)
+	}()
+
+	domainEntry, err = handler.domainCache.GetDomain(dp1.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	var actClSelPolicyForNewWF *types.ActiveClusterSelectionPolicy
+	var workflowExecution *types.WorkflowExecution
+
+	err = handler.redirectionPolicy.Redirect(ctx, domainEntry, workflowExecution, actClSelPolicyForNewWF, apiName, requestedConsistencyLevel, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			dp2, err = handler.frontendHandler.DescribeWorker(ctx, dp1)
+		default:
+			remoteClient, clientErr := handler.GetRemoteFrontendClient(targetDC)
+			if clientErr != nil {
+				return clientErr
+			}
+			dp2, err = remoteClient.DescribeWorker(ctx, dp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return dp2, err
+}
+
 func (handler *clusterRedirectionHandler) DescribeWorkflowExecution(ctx context.Context, dp1 *types.DescribeWorkflowExecutionRequest) (dp2 *types.DescribeWorkflowExecutionResponse, err error) {
 	var (
 		apiName                   = "DescribeWorkflowExecution"
@@ -206,6 +284,45 @@ func (handler *clusterRedirectionHandler) GetSearchAttributes(ctx context.Contex
 	return handler.frontendHandler.GetSearchAttributes(ctx)
 }
 
+func (handler *clusterRedirectionHandler) GetTaskListScalingRecommendation(ctx context.Context, gp1 *types.GetTaskListScalingRecommendationRequest) (gp2 *types.GetTaskListScalingRecommendationResponse, err error) {
+	var (
+		apiName                   = "GetTaskListScalingRecommendation"
+		cluster                   string
+		requestedConsistencyLevel types.QueryConsistencyLevel = types.QueryConsistencyLevelEventual
+	)
+
+	var domainEntry *cache.DomainCacheEntry
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionGetTaskListScalingRecommendationScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, domainEntry, cluster, &err)
+	}()
+
+	domainEntry, err = handler.domainCache.GetDomain(gp1.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	var actClSelPolicyForNewWF *types.ActiveClusterSelectionPolicy
+	var workflowExecution *types.WorkflowExecution
+
+	err = handler.redirectionPolicy.Redirect(ctx, domainEntry, workflowExecution, actClSelPolicyForNewWF, apiName, requestedConsistencyLevel, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			gp2, err = handler.frontendHandler.GetTaskListScalingRecommendation(ctx, gp1)
+		default:
+			remoteClient, clientErr := handler.GetRemoteFrontendClient(targetDC)
+			if clientErr != nil {
+				return clientErr
+			}
+			gp2, err = remoteClient.GetTaskListScalingRecommendation(ctx, gp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return gp2, err
+}
+
 func (handler *clusterRedirectionHandler) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest) (gp2 *types.GetTaskListsByDomainResponse, err error) {
 	var (
 		apiName                   = "GetTaskListsByDomain"
@@ -455,6 +572,45 @@ func (handler *clusterRedirectionHandler) ListTaskListPartitions(ctx context.Con
 	return lp2, err
 }
 
+func (handler *clusterRedirectionHandler) ListWorkers(ctx context.Context, lp1 *types.ListWorkersRequest) (lp2 *types.ListWorkersResponse, err error) {
+	var (
+		apiName                   = "ListWorkers"
+		cluster                   string
+		requestedConsistencyLevel types.QueryConsistencyLevel = types.QueryConsistencyLevelEventual
+	)
+
+	var domainEntry *cache.DomainCacheEntry
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionListWorkersScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, domainEntry, cluster, &err)
+	}()
+
+	domainEntry, err = handler.domainCache.GetDomain(lp1.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	var actClSelPolicyForNewWF *types.ActiveClusterSelectionPolicy
+	var workflowExecution *types.WorkflowExecution
+
+	err = handler.redirectionPolicy.Redirect(ctx, domainEntry, workflowExecution, actClSelPolicyForNewWF, apiName, requestedConsistencyLevel, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			lp2, err = handler.frontendHandler.ListWorkers(ctx, lp1)
+		default:
+			remoteClient, clientErr := handler.GetRemoteFrontendClient(targetDC)
+			if clientErr != nil {
+				return clientErr
+			}
+			lp2, err = remoteClient.ListWorkers(ctx, lp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return lp2, err
+}
+
 func (handler *clusterRedirectionHandler) ListWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
 	var (
 		apiName                   = "ListWorkflowExecutions"
@@ -783,6 +939,46 @@ func (handler *clusterRedirectionHandler) RequestCancelWorkflowExecution(ctx con
 	return err
 }
 
+func (handler *clusterRedirectionHandler) RequestCancelWorkflowExecutionAsync(ctx context.Context, rp1 *types.RequestCancelWorkflowExecutionAsyncRequest) (rp2 *types.RequestCancelWorkflowExecutionAsyncResponse, err error) {
+	var (
+		apiName                   = "RequestCancelWorkflowExecutionAsync"
+		cluster                   string
+		requestedConsistencyLevel types.QueryConsistencyLevel = types.QueryConsistencyLevelEventual
+	)
+
+	var domainEntry *cache.DomainCacheEntry
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionRequestCancelWorkflowExecutionAsyncScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, domainEntry, cluster, &err)
+	}()
+
+	domainEntry, err = handler.domainCache.GetDomain(rp1.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	var actClSelPolicyForNewWF *types.ActiveClusterSelectionPolicy
+	var workflowExecution *types.WorkflowExecution
+	workflowExecution = rp1.GetWorkflowExecution()
+
+	err = handler.redirectionPolicy.Redirect(ctx, domainEntry, workflowExecution, actClSelPolicyForNewWF, apiName, requestedConsistencyLevel, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			rp2, err = handler.frontendHandler.RequestCancelWorkflowExecutionAsync(ctx, rp1)
+		default:
+			remoteClient, clientErr := handler.GetRemoteFrontendClient(targetDC)
+			if clientErr != nil {
+				return clientErr
+			}
+			rp2, err = remoteClient.RequestCancelWorkflowExecutionAsync(ctx, rp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return rp2, err
+}
+
 func (handler *clusterRedirectionHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	var (
 		apiName                   = "ResetStickyTaskList"
@@ -1442,6 +1638,46 @@ func (handler *clusterRedirectionHandler) SignalWorkflowExecution(ctx context.Co
 	return err
 }
 
+func (handler *clusterRedirectionHandler) SignalWorkflowExecutionAsync(ctx context.Context, sp1 *types.SignalWorkflowExecutionAsyncRequest) (sp2 *types.SignalWorkflowExecutionAsyncResponse, err error) {
+	var (
+		apiName                   = "SignalWorkflowExecutionAsync"
+		cluster                   string
+		requestedConsistencyLevel types.QueryConsistencyLevel = types.QueryConsistencyLevelEventual
+	)
+
+	var domainEntry *cache.DomainCacheEntry
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionSignalWorkflowExecutionAsyncScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, domainEntry, cluster, &err)
+	}()
+
+	domainEntry, err = handler.domainCache.GetDomain(sp1.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	var actClSelPolicyForNewWF *types.ActiveClusterSelectionPolicy
+	var workflowExecution *types.WorkflowExecution
+	workflowExecution = sp1.GetWorkflowExecution()
+
+	err = handler.redirectionPolicy.Redirect(ctx, domainEntry, workflowExecution, actClSelPolicyForNewWF, apiName, requestedConsistencyLevel, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			sp2, err = handler.frontendHandler.SignalWorkflowExecutionAsync(ctx, sp1)
+		default:
+			remoteClient, clientErr := handler.GetRemoteFrontendClient(targetDC)
+			if clientErr != nil {
+				return clientErr
+			}
+			sp2, err = remoteClient.SignalWorkflowExecutionAsync(ctx, sp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return sp2, err
+}
+
 func (handler *clusterRedirectionHandler) StartWorkflowExecution(ctx context.Context, sp1 *types.StartWorkflowExecutionRequest) (sp2 *types.StartWorkflowExecutionResponse, err error) {
 	var (
 		apiName                   = "StartWorkflowExecution"
@@ -1562,6 +1798,86 @@ func (handler *clusterRedirectionHandler) TerminateWorkflowExecution(ctx context
 	return err
 }
 
+func (handler *clusterRedirectionHandler) TerminateWorkflowExecutionAsync(ctx context.Context, tp1 *types.TerminateWorkflowExecutionAsyncRequest) (tp2 *types.TerminateWorkflowExecutionAsyncResponse, err error) {
+	var (
+		apiName                   = "TerminateWorkflowExecutionAsync"
+		cluster                   string
+		requestedConsistencyLevel types.QueryConsistencyLevel = types.QueryConsistencyLevelEventual
+	)
+
+	var domainEntry *cache.DomainCacheEntry
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionTerminateWorkflowExecutionAsyncScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, domainEntry, cluster, &err)
+	}()
+
+	domainEntry, err = handler.domainCache.GetDomain(tp1.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	var actClSelPolicyForNewWF *types.ActiveClusterSelectionPolicy
+	var workflowExecution *types.WorkflowExecution
+	workflowExecution = tp1.GetWorkflowExecution()
+
+	err = handler.redirectionPolicy.Redirect(ctx, domainEntry, workflowExecution, actClSelPolicyForNewWF, apiName, requestedConsistencyLevel, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			tp2, err = handler.frontendHandler.TerminateWorkflowExecutionAsync(ctx, tp1)
+		default:
+			remoteClient, clientErr := handler.GetRemoteFrontendClient(targetDC)
+			if clientErr != nil {
+				return clientErr
+			}
+			tp2, err = remoteClient.TerminateWorkflowExecutionAsync(ctx, tp1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return tp2, err
+}
+
 func (handler *clusterRedirectionHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest) (up2 *types.UpdateDomainResponse, err error) {
 	return handler.frontendHandler.UpdateDomain(ctx, up1)
 }
+
+func (handler *clusterRedirectionHandler) UpdateWorkflowMemo(ctx context.Context, up1 *types.UpdateWorkflowMemoRequest) (up2 *types.UpdateWorkflowMemoResponse, err error) {
+	var (
+		apiName                   = "UpdateWorkflowMemo"
+		cluster                   string
+		requestedConsistencyLevel types.QueryConsistencyLevel = types.QueryConsistencyLevelEventual
+	)
+
+	var domainEntry *cache.DomainCacheEntry
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUpdateWorkflowMemoScope)
+	defer func() {
+		handler.afterCall(recover(), scope, startTime, domainEntry, cluster, &err)
+	}()
+
+	domainEntry, err = handler.domainCache.GetDomain(up1.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	var actClSelPolicyForNewWF *types.ActiveClusterSelectionPolicy
+	var workflowExecution *types.WorkflowExecution
+	workflowExecution = up1.GetWorkflowExecution()
+
+	err = handler.redirectionPolicy.Redirect(ctx, domainEntry, workflowExecution, actClSelPolicyForNewWF, apiName, requestedConsistencyLevel, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			up2, err = handler.frontendHandler.UpdateWorkflowMemo(ctx, up1)
+		default:
+			remoteClient, clientErr := handler.GetRemoteFrontendClient(targetDC)
+			if clientErr != nil {
+				return clientErr
+			}
+			up2, err = remoteClient.UpdateWorkflowMemo(ctx, up1, handler.callOptions...)
+		}
+		return err
+	})
+
+	return up2, err
+}
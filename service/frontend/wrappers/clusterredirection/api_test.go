@@ -1021,6 +1021,69 @@ func (s *clusterRedirectionHandlerSuite) TestTerminateWorkflowExecution() {
 	s.Nil(err)
 }
 
+func (s *clusterRedirectionHandlerSuite) TestUpdateWorkflowMemo() {
+	apiName := "UpdateWorkflowMemo"
+
+	ctx := context.Background()
+	req := &types.UpdateWorkflowMemoRequest{
+		Domain: s.domainName,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: "test-workflow-id",
+			RunID:      "test-run-id",
+		},
+	}
+
+	s.mockClusterRedirectionPolicy.EXPECT().Redirect(ctx, s.domainCacheEntry, req.WorkflowExecution, nil, apiName, types.QueryConsistencyLevelEventual, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, domainCacheEntry *cache.DomainCacheEntry, wfExec *types.WorkflowExecution, selPlcy *types.ActiveClusterSelectionPolicy, apiName string, consistencyLevel types.QueryConsistencyLevel, callFn func(targetDC string) error) error {
+			// validate callFn logic
+			s.mockFrontendHandler.EXPECT().UpdateWorkflowMemo(ctx, req).Return(&types.UpdateWorkflowMemoResponse{}, nil).Times(1)
+			err := callFn(s.currentClusterName)
+			s.Nil(err)
+			s.mockRemoteFrontendClient.EXPECT().UpdateWorkflowMemo(ctx, req, s.handler.callOptions).Return(&types.UpdateWorkflowMemoResponse{}, nil).Times(1)
+			err = callFn(s.alternativeClusterName)
+			s.Nil(err)
+			return nil
+		}).
+		Times(1)
+
+	resp, err := s.handler.UpdateWorkflowMemo(ctx, req)
+	s.Nil(err)
+	s.Equal(&types.UpdateWorkflowMemoResponse{}, resp)
+}
+
+func (s *clusterRedirectionHandlerSuite) TestSignalWorkflowExecutionAsync() {
+	apiName := "SignalWorkflowExecutionAsync"
+
+	ctx := context.Background()
+	req := &types.SignalWorkflowExecutionAsyncRequest{
+		SignalWorkflowExecutionRequest: &types.SignalWorkflowExecutionRequest{
+			Domain: s.domainName,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: "test-workflow-id",
+				RunID:      "test-run-id",
+			},
+			SignalName: "test-signal",
+		},
+	}
+
+	s.mockClusterRedirectionPolicy.EXPECT().Redirect(ctx, s.domainCacheEntry, req.WorkflowExecution, nil, apiName, types.QueryConsistencyLevelEventual, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, domainCacheEntry *cache.DomainCacheEntry, wfExec *types.WorkflowExecution, selPlcy *types.ActiveClusterSelectionPolicy, apiName string, consistencyLevel types.QueryConsistencyLevel, callFn func(targetDC string) error) error {
+			// validate callFn logic
+			s.mockFrontendHandler.EXPECT().SignalWorkflowExecutionAsync(ctx, req).Return(&types.SignalWorkflowExecutionAsyncResponse{}, nil).Times(1)
+			err := callFn(s.currentClusterName)
+			s.Nil(err)
+			s.mockRemoteFrontendClient.EXPECT().SignalWorkflowExecutionAsync(ctx, req, s.handler.callOptions).Return(&types.SignalWorkflowExecutionAsyncResponse{}, nil).Times(1)
+			err = callFn(s.alternativeClusterName)
+			s.Nil(err)
+			return nil
+		}).
+		Times(1)
+
+	resp, err := s.handler.SignalWorkflowExecutionAsync(ctx, req)
+	s.Nil(err)
+	s.Equal(&types.SignalWorkflowExecutionAsyncResponse{}, resp)
+}
+
 func (s *clusterRedirectionHandlerSuite) TestListTaskListPartitions() {
 	apiName := "ListTaskListPartitions"
 
@@ -1051,6 +1114,111 @@ func (s *clusterRedirectionHandlerSuite) TestListTaskListPartitions() {
 	s.Equal(&types.ListTaskListPartitionsResponse{}, resp)
 }
 
+func (s *clusterRedirectionHandlerSuite) TestGetTaskListScalingRecommendation() {
+	apiName := "GetTaskListScalingRecommendation"
+
+	ctx := context.Background()
+	req := &types.GetTaskListScalingRecommendationRequest{
+		Domain: s.domainName,
+	}
+
+	s.mockClusterRedirectionPolicy.EXPECT().Redirect(ctx, s.domainCacheEntry, nil, nil, apiName, types.QueryConsistencyLevelEventual, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, domainCacheEntry *cache.DomainCacheEntry, wfExec *types.WorkflowExecution, selPlcy *types.ActiveClusterSelectionPolicy, apiName string, consistencyLevel types.QueryConsistencyLevel, callFn func(targetDC string) error) error {
+			// validate callFn logic
+			s.mockFrontendHandler.EXPECT().GetTaskListScalingRecommendation(ctx, req).Return(&types.GetTaskListScalingRecommendationResponse{}, nil).Times(1)
+			err := callFn(s.currentClusterName)
+			s.Nil(err)
+			s.mockRemoteFrontendClient.EXPECT().GetTaskListScalingRecommendation(ctx, req, s.handler.callOptions).Return(&types.GetTaskListScalingRecommendationResponse{}, nil).Times(1)
+			err = callFn(s.alternativeClusterName)
+			s.Nil(err)
+			return nil
+		}).
+		Times(1)
+
+	resp, err := s.handler.GetTaskListScalingRecommendation(ctx, req)
+	s.Nil(err)
+	s.Equal(&types.GetTaskListScalingRecommendationResponse{}, resp)
+}
+
+func (s *clusterRedirectionHandlerSuite) TestListWorkers() {
+	apiName := "ListWorkers"
+
+	ctx := context.Background()
+	req := &types.ListWorkersRequest{
+		Domain: s.domainName,
+	}
+
+	s.mockClusterRedirectionPolicy.EXPECT().Redirect(ctx, s.domainCacheEntry, nil, nil, apiName, types.QueryConsistencyLevelEventual, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, domainCacheEntry *cache.DomainCacheEntry, wfExec *types.WorkflowExecution, selPlcy *types.ActiveClusterSelectionPolicy, apiName string, consistencyLevel types.QueryConsistencyLevel, callFn func(targetDC string) error) error {
+			// validate callFn logic
+			s.mockFrontendHandler.EXPECT().ListWorkers(ctx, req).Return(&types.ListWorkersResponse{}, nil).Times(1)
+			err := callFn(s.currentClusterName)
+			s.Nil(err)
+			s.mockRemoteFrontendClient.EXPECT().ListWorkers(ctx, req, s.handler.callOptions).Return(&types.ListWorkersResponse{}, nil).Times(1)
+			err = callFn(s.alternativeClusterName)
+			s.Nil(err)
+			return nil
+		}).
+		Times(1)
+
+	resp, err := s.handler.ListWorkers(ctx, req)
+	s.Nil(err)
+	s.Equal(&types.ListWorkersResponse{}, resp)
+}
+
+func (s *clusterRedirectionHandlerSuite) TestDescribeWorker() {
+	apiName := "DescribeWorker"
+
+	ctx := context.Background()
+	req := &types.DescribeWorkerRequest{
+		Domain: s.domainName,
+	}
+
+	s.mockClusterRedirectionPolicy.EXPECT().Redirect(ctx, s.domainCacheEntry, nil, nil, apiName, types.QueryConsistencyLevelEventual, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, domainCacheEntry *cache.DomainCacheEntry, wfExec *types.WorkflowExecution, selPlcy *types.ActiveClusterSelectionPolicy, apiName string, consistencyLevel types.QueryConsistencyLevel, callFn func(targetDC string) error) error {
+			// validate callFn logic
+			s.mockFrontendHandler.EXPECT().DescribeWorker(ctx, req).Return(&types.DescribeWorkerResponse{}, nil).Times(1)
+			err := callFn(s.currentClusterName)
+			s.Nil(err)
+			s.mockRemoteFrontendClient.EXPECT().DescribeWorker(ctx, req, s.handler.callOptions).Return(&types.DescribeWorkerResponse{}, nil).Times(1)
+			err = callFn(s.alternativeClusterName)
+			s.Nil(err)
+			return nil
+		}).
+		Times(1)
+
+	resp, err := s.handler.DescribeWorker(ctx, req)
+	s.Nil(err)
+	s.Equal(&types.DescribeWorkerResponse{}, resp)
+}
+
+func (s *clusterRedirectionHandlerSuite) TestDescribeAsyncRequest() {
+	apiName := "DescribeAsyncRequest"
+
+	ctx := context.Background()
+	req := &types.DescribeAsyncRequestRequest{
+		Domain:    s.domainName,
+		RequestID: "test-request-id",
+	}
+
+	s.mockClusterRedirectionPolicy.EXPECT().Redirect(ctx, s.domainCacheEntry, nil, nil, apiName, types.QueryConsistencyLevelEventual, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, domainCacheEntry *cache.DomainCacheEntry, wfExec *types.WorkflowExecution, selPlcy *types.ActiveClusterSelectionPolicy, apiName string, consistencyLevel types.QueryConsistencyLevel, callFn func(targetDC string) error) error {
+			// validate callFn logic
+			s.mockFrontendHandler.EXPECT().DescribeAsyncRequest(ctx, req).Return(&types.DescribeAsyncRequestResponse{}, nil).Times(1)
+			err := callFn(s.currentClusterName)
+			s.Nil(err)
+			s.mockRemoteFrontendClient.EXPECT().DescribeAsyncRequest(ctx, req, s.handler.callOptions).Return(&types.DescribeAsyncRequestResponse{}, nil).Times(1)
+			err = callFn(s.alternativeClusterName)
+			s.Nil(err)
+			return nil
+		}).
+		Times(1)
+
+	resp, err := s.handler.DescribeAsyncRequest(ctx, req)
+	s.Nil(err)
+	s.Equal(&types.DescribeAsyncRequestResponse{}, resp)
+}
+
 func (s *clusterRedirectionHandlerSuite) TestGetTaskListsByDomain() {
 	apiName := "GetTaskListsByDomain"
 
@@ -49,6 +49,7 @@ const (
 	// 4. RequestCancelWorkflowExecution
 	// 5. TerminateWorkflowExecution
 	// 6. ResetWorkflow
+	// 7. UpdateWorkflowMemo
 	// please also reference selectedAPIsForwardingRedirectionPolicyAPIAllowlist and DCRedirectionPolicySelectedAPIsForwardingV2
 	DCRedirectionPolicySelectedAPIsForwarding = "selected-apis-forwarding"
 	// DCRedirectionPolicySelectedAPIsForwardingV2 forwards everything in DCRedirectionPolicySelectedAPIsForwarding,
@@ -58,15 +59,15 @@ const (
 	//
 	// This will likely replace DCRedirectionPolicySelectedAPIsForwarding soon.
 	//
-	// 1-6. from DCRedirectionPolicySelectedAPIsForwarding
-	// 7. RecordActivityTaskHeartbeat
-	// 8. RecordActivityTaskHeartbeatByID
-	// 9. RespondActivityTaskCanceled
-	// 10. RespondActivityTaskCanceledByID
-	// 11. RespondActivityTaskCompleted
-	// 12. RespondActivityTaskCompletedByID
-	// 13. RespondActivityTaskFailed
-	// 14. RespondActivityTaskFailedByID
+	// 1-7. from DCRedirectionPolicySelectedAPIsForwarding
+	// 8. RecordActivityTaskHeartbeat
+	// 9. RecordActivityTaskHeartbeatByID
+	// 10. RespondActivityTaskCanceled
+	// 11. RespondActivityTaskCanceledByID
+	// 12. RespondActivityTaskCompleted
+	// 13. RespondActivityTaskCompletedByID
+	// 14. RespondActivityTaskFailed
+	// 15. RespondActivityTaskFailedByID
 	// please also reference selectedAPIsForwardingRedirectionPolicyAPIAllowlistV2
 	DCRedirectionPolicySelectedAPIsForwardingV2 = "selected-apis-forwarding-v2"
 	// DCRedirectionPolicyAllDomainAPIsForwarding means forwarding all the worker and non-worker APIs based domain,
@@ -127,6 +128,7 @@ var selectedAPIsForwardingRedirectionPolicyAPIAllowlist = map[string]struct{}{
 	"RequestCancelWorkflowExecution":   {},
 	"TerminateWorkflowExecution":       {},
 	"ResetWorkflowExecution":           {},
+	"UpdateWorkflowMemo":               {},
 }
 
 // selectedAPIsForwardingRedirectionPolicyAPIAllowlistV2 contains a list of non-worker APIs which can be redirected.
@@ -139,6 +141,7 @@ var selectedAPIsForwardingRedirectionPolicyAPIAllowlistV2 = map[string]struct{}{
 	"RequestCancelWorkflowExecution":   {},
 	"TerminateWorkflowExecution":       {},
 	"ResetWorkflowExecution":           {},
+	"UpdateWorkflowMemo":               {},
 	// additional endpoints
 	"RecordActivityTaskHeartbeat":      {},
 	"RecordActivityTaskHeartbeatByID":  {},
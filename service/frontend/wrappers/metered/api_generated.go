@@ -85,6 +85,23 @@ func (h *apiHandler) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDo
 	}
 	return err
 }
+func (h *apiHandler) DescribeAsyncRequest(ctx context.Context, dp1 *types.DescribeAsyncRequestRequest) (dp2 *types.DescribeAsyncRequestResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("DescribeAsyncRequest")}
+	tags = append(tags, toDescribeAsyncRequestRequestTags(dp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendDescribeAsyncRequestScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(dp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	dp2, err = h.handler.DescribeAsyncRequest(ctx, dp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return dp2, err
+}
+
 func (h *apiHandler) DescribeDomain(ctx context.Context, dp1 *types.DescribeDomainRequest) (dp2 *types.DescribeDomainResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("DescribeDomain")}
@@ -116,6 +133,23 @@ func (h *apiHandler) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTa
 	}
 	return dp2, err
 }
+func (h *apiHandler) DescribeWorker(ctx context.Context, dp1 *types.DescribeWorkerRequest) (dp2 *types.DescribeWorkerResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("DescribeWorker")}
+	tags = append(tags, toDescribeWorkerRequestTags(dp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendDescribeWorkerScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(dp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	dp2, err = h.handler.DescribeWorker(ctx, dp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return dp2, err
+}
+
 func (h *apiHandler) DescribeWorkflowExecution(ctx context.Context, dp1 *types.DescribeWorkflowExecutionRequest) (dp2 *types.DescribeWorkflowExecutionResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("DescribeWorkflowExecution")}
@@ -178,6 +212,23 @@ func (h *apiHandler) GetSearchAttributes(ctx context.Context) (gp1 *types.GetSea
 	}
 	return gp1, err
 }
+func (h *apiHandler) GetTaskListScalingRecommendation(ctx context.Context, gp1 *types.GetTaskListScalingRecommendationRequest) (gp2 *types.GetTaskListScalingRecommendationResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("GetTaskListScalingRecommendation")}
+	tags = append(tags, toGetTaskListScalingRecommendationRequestTags(gp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendGetTaskListScalingRecommendationScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(gp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	gp2, err = h.handler.GetTaskListScalingRecommendation(ctx, gp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return gp2, err
+}
+
 func (h *apiHandler) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest) (gp2 *types.GetTaskListsByDomainResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("GetTaskListsByDomain")}
@@ -292,6 +343,23 @@ func (h *apiHandler) ListTaskListPartitions(ctx context.Context, lp1 *types.List
 	}
 	return lp2, err
 }
+func (h *apiHandler) ListWorkers(ctx context.Context, lp1 *types.ListWorkersRequest) (lp2 *types.ListWorkersResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("ListWorkers")}
+	tags = append(tags, toListWorkersRequestTags(lp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendListWorkersScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(lp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	lp2, err = h.handler.ListWorkers(ctx, lp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return lp2, err
+}
+
 func (h *apiHandler) ListWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("ListWorkflowExecutions")}
@@ -451,6 +519,22 @@ func (h *apiHandler) RequestCancelWorkflowExecution(ctx context.Context, rp1 *ty
 	}
 	return err
 }
+func (h *apiHandler) RequestCancelWorkflowExecutionAsync(ctx context.Context, rp1 *types.RequestCancelWorkflowExecutionAsyncRequest) (rp2 *types.RequestCancelWorkflowExecutionAsyncResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("RequestCancelWorkflowExecutionAsync")}
+	tags = append(tags, toRequestCancelWorkflowExecutionAsyncRequestTags(rp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendRequestCancelWorkflowExecutionAsyncScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(rp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	rp2, err = h.handler.RequestCancelWorkflowExecutionAsync(ctx, rp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return rp2, err
+}
 func (h *apiHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("ResetStickyTaskList")}
@@ -756,6 +840,22 @@ func (h *apiHandler) SignalWorkflowExecution(ctx context.Context, sp1 *types.Sig
 	}
 	return err
 }
+func (h *apiHandler) SignalWorkflowExecutionAsync(ctx context.Context, sp1 *types.SignalWorkflowExecutionAsyncRequest) (sp2 *types.SignalWorkflowExecutionAsyncResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("SignalWorkflowExecutionAsync")}
+	tags = append(tags, toSignalWorkflowExecutionAsyncRequestTags(sp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendSignalWorkflowExecutionAsyncScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(sp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	sp2, err = h.handler.SignalWorkflowExecutionAsync(ctx, sp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return sp2, err
+}
 func (h *apiHandler) StartWorkflowExecution(ctx context.Context, sp1 *types.StartWorkflowExecutionRequest) (sp2 *types.StartWorkflowExecutionResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("StartWorkflowExecution")}
@@ -804,6 +904,22 @@ func (h *apiHandler) TerminateWorkflowExecution(ctx context.Context, tp1 *types.
 	}
 	return err
 }
+func (h *apiHandler) TerminateWorkflowExecutionAsync(ctx context.Context, tp1 *types.TerminateWorkflowExecutionAsyncRequest) (tp2 *types.TerminateWorkflowExecutionAsyncResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("TerminateWorkflowExecutionAsync")}
+	tags = append(tags, toTerminateWorkflowExecutionAsyncRequestTags(tp1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendTerminateWorkflowExecutionAsyncScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(tp1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	tp2, err = h.handler.TerminateWorkflowExecutionAsync(ctx, tp1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return tp2, err
+}
 func (h *apiHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest) (up2 *types.UpdateDomainResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 	tags := []tag.Tag{tag.WorkflowHandlerName("UpdateDomain")}
@@ -819,3 +935,20 @@ func (h *apiHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRe
 	}
 	return up2, err
 }
+
+func (h *apiHandler) UpdateWorkflowMemo(ctx context.Context, up1 *types.UpdateWorkflowMemoRequest) (up2 *types.UpdateWorkflowMemoResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+	tags := []tag.Tag{tag.WorkflowHandlerName("UpdateWorkflowMemo")}
+	tags = append(tags, toUpdateWorkflowMemoRequestTags(up1)...)
+	scope := h.metricsClient.Scope(metrics.FrontendUpdateWorkflowMemoScope).Tagged(append(metrics.GetContextTags(ctx), metrics.DomainTag(up1.GetDomain()))...)
+	scope.IncCounter(metrics.CadenceRequests)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tags...)
+
+	up2, err = h.handler.UpdateWorkflowMemo(ctx, up1)
+	if err != nil {
+		return nil, h.handleErr(err, scope, logger)
+	}
+	return up2, err
+}
@@ -155,6 +155,33 @@ func toDiagnoseWorkflowExecutionRequestTags(req *types.DiagnoseWorkflowExecution
 	}
 }
 
+func toGetTaskListScalingRecommendationRequestTags(req *types.GetTaskListScalingRecommendationRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowTaskListName(req.GetTaskList().GetName()),
+		tag.WorkflowTaskListType(int(req.GetTaskListType())),
+	}
+}
+
+func toListWorkersRequestTags(req *types.ListWorkersRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+	}
+}
+
+func toDescribeAsyncRequestRequestTags(req *types.DescribeAsyncRequestRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowRequestID(req.GetRequestID()),
+	}
+}
+
+func toDescribeWorkerRequestTags(req *types.DescribeWorkerRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+	}
+}
+
 func toGetTaskListsByDomainRequestTags(req *types.GetTaskListsByDomainRequest) []tag.Tag {
 	return []tag.Tag{
 		tag.WorkflowDomainName(req.GetDomain()),
@@ -249,6 +276,14 @@ func toRequestCancelWorkflowExecutionRequestTags(req *types.RequestCancelWorkflo
 	}
 }
 
+func toRequestCancelWorkflowExecutionAsyncRequestTags(req *types.RequestCancelWorkflowExecutionAsyncRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+	}
+}
+
 func toResetStickyTaskListRequestTags(req *types.ResetStickyTaskListRequest) []tag.Tag {
 	return []tag.Tag{
 		tag.WorkflowDomainName(req.GetDomain()),
@@ -324,6 +359,15 @@ func toSignalWorkflowExecutionRequestTags(req *types.SignalWorkflowExecutionRequ
 	}
 }
 
+func toSignalWorkflowExecutionAsyncRequestTags(req *types.SignalWorkflowExecutionAsyncRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+		tag.WorkflowSignalName(req.GetSignalName()),
+	}
+}
+
 func toStartWorkflowExecutionRequestTags(req *types.StartWorkflowExecutionRequest) []tag.Tag {
 	return []tag.Tag{
 		tag.WorkflowDomainName(req.GetDomain()),
@@ -350,6 +394,22 @@ func toTerminateWorkflowExecutionRequestTags(req *types.TerminateWorkflowExecuti
 	}
 }
 
+func toTerminateWorkflowExecutionAsyncRequestTags(req *types.TerminateWorkflowExecutionAsyncRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+	}
+}
+
+func toUpdateWorkflowMemoRequestTags(req *types.UpdateWorkflowMemoRequest) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowDomainName(req.GetDomain()),
+		tag.WorkflowID(req.GetWorkflowExecution().GetWorkflowID()),
+		tag.WorkflowRunID(req.GetWorkflowExecution().GetRunID()),
+	}
+}
+
 func toScanWorkflowExecutionsRequestTags(req *types.ListWorkflowExecutionsRequest) []tag.Tag {
 	return []tag.Tag{
 		tag.WorkflowDomainName(req.GetDomain()),
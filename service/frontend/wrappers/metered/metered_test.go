@@ -243,6 +243,26 @@ func TestToTerminateWorkflowExecutionRequestTags(t *testing.T) {
 	assert.ElementsMatch(t, expectedTags, tags)
 }
 
+func TestToUpdateWorkflowMemoRequestTags(t *testing.T) {
+	req := &types.UpdateWorkflowMemoRequest{
+		Domain: "test-domain",
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: "test-workflow-id",
+			RunID:      "test-run-id",
+		},
+	}
+
+	tags := toUpdateWorkflowMemoRequestTags(req)
+
+	expectedTags := []tag.Tag{
+		tag.WorkflowDomainName("test-domain"),
+		tag.WorkflowID("test-workflow-id"),
+		tag.WorkflowRunID("test-run-id"),
+	}
+
+	assert.ElementsMatch(t, expectedTags, tags)
+}
+
 func TestToScanWorkflowExecutionsRequestTags(t *testing.T) {
 	req := &types.ListWorkflowExecutionsRequest{
 		Domain: "test-domain",
@@ -278,6 +298,49 @@ func TestToListTaskListPartitionsRequestTags(t *testing.T) {
 	assert.ElementsMatch(t, expectedTags, tags)
 }
 
+func TestToGetTaskListScalingRecommendationRequestTags(t *testing.T) {
+	taskListType := types.TaskListTypeActivity
+	req := &types.GetTaskListScalingRecommendationRequest{
+		Domain:       "test-domain",
+		TaskList:     &types.TaskList{Name: "test-task-list"},
+		TaskListType: &taskListType,
+	}
+
+	tags := toGetTaskListScalingRecommendationRequestTags(req)
+
+	expectedTags := []tag.Tag{
+		tag.WorkflowDomainName("test-domain"),
+		tag.WorkflowTaskListName("test-task-list"),
+		tag.WorkflowTaskListType(int(taskListType)),
+	}
+
+	assert.ElementsMatch(t, expectedTags, tags)
+}
+
+func TestToListWorkersRequestTags(t *testing.T) {
+	req := &types.ListWorkersRequest{Domain: "test-domain"}
+
+	tags := toListWorkersRequestTags(req)
+
+	assert.ElementsMatch(t, []tag.Tag{tag.WorkflowDomainName("test-domain")}, tags)
+}
+
+func TestToDescribeWorkerRequestTags(t *testing.T) {
+	req := &types.DescribeWorkerRequest{Domain: "test-domain", Identity: "worker"}
+
+	tags := toDescribeWorkerRequestTags(req)
+
+	assert.ElementsMatch(t, []tag.Tag{tag.WorkflowDomainName("test-domain")}, tags)
+}
+
+func TestToDescribeAsyncRequestRequestTags(t *testing.T) {
+	req := &types.DescribeAsyncRequestRequest{Domain: "test-domain", RequestID: "test-request-id"}
+
+	tags := toDescribeAsyncRequestRequestTags(req)
+
+	assert.ElementsMatch(t, []tag.Tag{tag.WorkflowDomainName("test-domain"), tag.WorkflowRequestID("test-request-id")}, tags)
+}
+
 func TestToGetTaskListsByDomainRequestTags(t *testing.T) {
 	req := &types.GetTaskListsByDomainRequest{
 		Domain: "test-domain",
@@ -619,6 +682,74 @@ func TestToSignalWithStartWorkflowExecutionAsyncRequestTags(t *testing.T) {
 	assert.ElementsMatch(t, expectedTags, tags)
 }
 
+func TestToSignalWorkflowExecutionAsyncRequestTags(t *testing.T) {
+	req := &types.SignalWorkflowExecutionAsyncRequest{
+		SignalWorkflowExecutionRequest: &types.SignalWorkflowExecutionRequest{
+			Domain: "test-domain",
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: "test-workflow-id",
+				RunID:      "test-run-id",
+			},
+			SignalName: "test-signal",
+		},
+	}
+
+	tags := toSignalWorkflowExecutionAsyncRequestTags(req)
+
+	expectedTags := []tag.Tag{
+		tag.WorkflowDomainName("test-domain"),
+		tag.WorkflowID("test-workflow-id"),
+		tag.WorkflowRunID("test-run-id"),
+		tag.WorkflowSignalName("test-signal"),
+	}
+
+	assert.ElementsMatch(t, expectedTags, tags)
+}
+
+func TestToRequestCancelWorkflowExecutionAsyncRequestTags(t *testing.T) {
+	req := &types.RequestCancelWorkflowExecutionAsyncRequest{
+		RequestCancelWorkflowExecutionRequest: &types.RequestCancelWorkflowExecutionRequest{
+			Domain: "test-domain",
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: "test-workflow-id",
+				RunID:      "test-run-id",
+			},
+		},
+	}
+
+	tags := toRequestCancelWorkflowExecutionAsyncRequestTags(req)
+
+	expectedTags := []tag.Tag{
+		tag.WorkflowDomainName("test-domain"),
+		tag.WorkflowID("test-workflow-id"),
+		tag.WorkflowRunID("test-run-id"),
+	}
+
+	assert.ElementsMatch(t, expectedTags, tags)
+}
+
+func TestToTerminateWorkflowExecutionAsyncRequestTags(t *testing.T) {
+	req := &types.TerminateWorkflowExecutionAsyncRequest{
+		TerminateWorkflowExecutionRequest: &types.TerminateWorkflowExecutionRequest{
+			Domain: "test-domain",
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: "test-workflow-id",
+				RunID:      "test-run-id",
+			},
+		},
+	}
+
+	tags := toTerminateWorkflowExecutionAsyncRequestTags(req)
+
+	expectedTags := []tag.Tag{
+		tag.WorkflowDomainName("test-domain"),
+		tag.WorkflowID("test-workflow-id"),
+		tag.WorkflowRunID("test-run-id"),
+	}
+
+	assert.ElementsMatch(t, expectedTags, tags)
+}
+
 func TestToDescribeTaskListRequestTags(t *testing.T) {
 	kind := types.TaskListKindNormal
 	taskListType := types.TaskListTypeDecision
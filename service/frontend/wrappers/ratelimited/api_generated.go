@@ -70,6 +70,22 @@ func (h *apiHandler) DeprecateDomain(ctx context.Context, dp1 *types.DeprecateDo
 	return h.wrapped.DeprecateDomain(ctx, dp1)
 }
 
+func (h *apiHandler) DescribeAsyncRequest(ctx context.Context, dp1 *types.DescribeAsyncRequestRequest) (dp2 *types.DescribeAsyncRequestResponse, err error) {
+	if dp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if dp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, dp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.DescribeAsyncRequest(ctx, dp1)
+}
+
 func (h *apiHandler) DescribeDomain(ctx context.Context, dp1 *types.DescribeDomainRequest) (dp2 *types.DescribeDomainResponse, err error) {
 	return h.wrapped.DescribeDomain(ctx, dp1)
 }
@@ -90,6 +106,22 @@ func (h *apiHandler) DescribeTaskList(ctx context.Context, dp1 *types.DescribeTa
 	return h.wrapped.DescribeTaskList(ctx, dp1)
 }
 
+func (h *apiHandler) DescribeWorker(ctx context.Context, dp1 *types.DescribeWorkerRequest) (dp2 *types.DescribeWorkerResponse, err error) {
+	if dp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if dp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, dp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.DescribeWorker(ctx, dp1)
+}
+
 func (h *apiHandler) DescribeWorkflowExecution(ctx context.Context, dp1 *types.DescribeWorkflowExecutionRequest) (dp2 *types.DescribeWorkflowExecutionResponse, err error) {
 	if dp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -130,6 +162,22 @@ func (h *apiHandler) GetSearchAttributes(ctx context.Context) (gp1 *types.GetSea
 	return h.wrapped.GetSearchAttributes(ctx)
 }
 
+func (h *apiHandler) GetTaskListScalingRecommendation(ctx context.Context, gp1 *types.GetTaskListScalingRecommendationRequest) (gp2 *types.GetTaskListScalingRecommendationResponse, err error) {
+	if gp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if gp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, gp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.GetTaskListScalingRecommendation(ctx, gp1)
+}
+
 func (h *apiHandler) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest) (gp2 *types.GetTaskListsByDomainResponse, err error) {
 	if gp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -234,6 +282,22 @@ func (h *apiHandler) ListTaskListPartitions(ctx context.Context, lp1 *types.List
 	return h.wrapped.ListTaskListPartitions(ctx, lp1)
 }
 
+func (h *apiHandler) ListWorkers(ctx context.Context, lp1 *types.ListWorkersRequest) (lp2 *types.ListWorkersResponse, err error) {
+	if lp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if lp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, lp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.ListWorkers(ctx, lp1)
+}
+
 func (h *apiHandler) ListWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
 	if lp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -376,6 +440,22 @@ func (h *apiHandler) RequestCancelWorkflowExecution(ctx context.Context, rp1 *ty
 	return h.wrapped.RequestCancelWorkflowExecution(ctx, rp1)
 }
 
+func (h *apiHandler) RequestCancelWorkflowExecutionAsync(ctx context.Context, rp1 *types.RequestCancelWorkflowExecutionAsyncRequest) (rp2 *types.RequestCancelWorkflowExecutionAsyncResponse, err error) {
+	if rp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if rp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeAsync, rp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.RequestCancelWorkflowExecutionAsync(ctx, rp1)
+}
+
 func (h *apiHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	if rp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -694,6 +774,22 @@ func (h *apiHandler) SignalWorkflowExecution(ctx context.Context, sp1 *types.Sig
 	return h.wrapped.SignalWorkflowExecution(ctx, sp1)
 }
 
+func (h *apiHandler) SignalWorkflowExecutionAsync(ctx context.Context, sp1 *types.SignalWorkflowExecutionAsyncRequest) (sp2 *types.SignalWorkflowExecutionAsyncResponse, err error) {
+	if sp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if sp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeAsync, sp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.SignalWorkflowExecutionAsync(ctx, sp1)
+}
+
 func (h *apiHandler) StartWorkflowExecution(ctx context.Context, sp1 *types.StartWorkflowExecutionRequest) (sp2 *types.StartWorkflowExecutionResponse, err error) {
 	if sp1 == nil {
 		err = validate.ErrRequestNotSet
@@ -742,6 +838,38 @@ func (h *apiHandler) TerminateWorkflowExecution(ctx context.Context, tp1 *types.
 	return h.wrapped.TerminateWorkflowExecution(ctx, tp1)
 }
 
+func (h *apiHandler) TerminateWorkflowExecutionAsync(ctx context.Context, tp1 *types.TerminateWorkflowExecutionAsyncRequest) (tp2 *types.TerminateWorkflowExecutionAsyncResponse, err error) {
+	if tp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if tp1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeAsync, tp1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.TerminateWorkflowExecutionAsync(ctx, tp1)
+}
+
 func (h *apiHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest) (up2 *types.UpdateDomainResponse, err error) {
 	return h.wrapped.UpdateDomain(ctx, up1)
 }
+
+func (h *apiHandler) UpdateWorkflowMemo(ctx context.Context, up1 *types.UpdateWorkflowMemoRequest) (up2 *types.UpdateWorkflowMemoResponse, err error) {
+	if up1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+	if up1.GetDomain() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+	if ok := h.allowDomain(ratelimitTypeUser, up1.GetDomain()); !ok {
+		err = &types.ServiceBusyError{Message: "Too many outstanding requests to the cadence service"}
+		return
+	}
+	return h.wrapped.UpdateWorkflowMemo(ctx, up1)
+}
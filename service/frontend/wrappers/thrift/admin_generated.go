@@ -28,6 +28,11 @@ func (g AdminHandler) DeleteWorkflow(ctx context.Context, Request *admin.AdminDe
 	return thrift.FromAdminDeleteWorkflowResponse(response), thrift.FromError(err)
 }
 
+func (g AdminHandler) DescribeAsyncWorkflowQueue(ctx context.Context, Request *admin.DescribeAsyncWorkflowQueueRequest) (dp1 *admin.DescribeAsyncWorkflowQueueResponse, err error) {
+	response, err := g.h.DescribeAsyncWorkflowQueue(ctx, thrift.ToAdminDescribeAsyncWorkflowQueueRequest(Request))
+	return thrift.FromAdminDescribeAsyncWorkflowQueueResponse(response), thrift.FromError(err)
+}
+
 func (g AdminHandler) DescribeCluster(ctx context.Context) (dp1 *admin.DescribeClusterResponse, err error) {
 	response, err := g.h.DescribeCluster(ctx)
 	return thrift.FromAdminDescribeClusterResponse(response), thrift.FromError(err)
@@ -113,11 +118,26 @@ func (g AdminHandler) MergeDLQMessages(ctx context.Context, Request *replicator.
 	return thrift.FromAdminMergeDLQMessagesResponse(response), thrift.FromError(err)
 }
 
+func (g AdminHandler) MigrateTaskList(ctx context.Context, Request *admin.MigrateTaskListRequest) (mp1 *admin.MigrateTaskListResponse, err error) {
+	response, err := g.h.MigrateTaskList(ctx, thrift.ToAdminMigrateTaskListRequest(Request))
+	return thrift.FromAdminMigrateTaskListResponse(response), thrift.FromError(err)
+}
+
+func (g AdminHandler) PurgeAsyncWorkflowDLQMessages(ctx context.Context, Request *admin.PurgeAsyncWorkflowDLQMessagesRequest) (pp1 *admin.PurgeAsyncWorkflowDLQMessagesResponse, err error) {
+	response, err := g.h.PurgeAsyncWorkflowDLQMessages(ctx, thrift.ToAdminPurgeAsyncWorkflowDLQMessagesRequest(Request))
+	return thrift.FromAdminPurgeAsyncWorkflowDLQMessagesResponse(response), thrift.FromError(err)
+}
+
 func (g AdminHandler) PurgeDLQMessages(ctx context.Context, Request *replicator.PurgeDLQMessagesRequest) (err error) {
 	err = g.h.PurgeDLQMessages(ctx, thrift.ToAdminPurgeDLQMessagesRequest(Request))
 	return thrift.FromError(err)
 }
 
+func (g AdminHandler) ReadAsyncWorkflowDLQMessages(ctx context.Context, Request *admin.ReadAsyncWorkflowDLQMessagesRequest) (rp1 *admin.ReadAsyncWorkflowDLQMessagesResponse, err error) {
+	response, err := g.h.ReadAsyncWorkflowDLQMessages(ctx, thrift.ToAdminReadAsyncWorkflowDLQMessagesRequest(Request))
+	return thrift.FromAdminReadAsyncWorkflowDLQMessagesResponse(response), thrift.FromError(err)
+}
+
 func (g AdminHandler) ReadDLQMessages(ctx context.Context, Request *replicator.ReadDLQMessagesRequest) (rp1 *replicator.ReadDLQMessagesResponse, err error) {
 	response, err := g.h.ReadDLQMessages(ctx, thrift.ToAdminReadDLQMessagesRequest(Request))
 	return thrift.FromAdminReadDLQMessagesResponse(response), thrift.FromError(err)
@@ -138,6 +158,11 @@ func (g AdminHandler) RemoveTask(ctx context.Context, Request *shared.RemoveTask
 	return thrift.FromError(err)
 }
 
+func (g AdminHandler) ReplayAsyncWorkflowDLQMessages(ctx context.Context, Request *admin.ReplayAsyncWorkflowDLQMessagesRequest) (rp1 *admin.ReplayAsyncWorkflowDLQMessagesResponse, err error) {
+	response, err := g.h.ReplayAsyncWorkflowDLQMessages(ctx, thrift.ToAdminReplayAsyncWorkflowDLQMessagesRequest(Request))
+	return thrift.FromAdminReplayAsyncWorkflowDLQMessagesResponse(response), thrift.FromError(err)
+}
+
 func (g AdminHandler) ResendReplicationTasks(ctx context.Context, Request *admin.ResendReplicationTasksRequest) (err error) {
 	err = g.h.ResendReplicationTasks(ctx, thrift.ToAdminResendReplicationTasksRequest(Request))
 	return thrift.FromError(err)
@@ -177,3 +202,8 @@ func (g AdminHandler) UpdateGlobalIsolationGroups(ctx context.Context, Request *
 	response, err := g.h.UpdateGlobalIsolationGroups(ctx, thrift.ToAdminUpdateGlobalIsolationGroupsRequest(Request))
 	return thrift.FromAdminUpdateGlobalIsolationGroupsResponse(response), thrift.FromError(err)
 }
+
+func (g AdminHandler) UpdateTaskListVersioningConfig(ctx context.Context, Request *admin.UpdateTaskListVersioningConfigRequest) (up1 *admin.UpdateTaskListVersioningConfigResponse, err error) {
+	response, err := g.h.UpdateTaskListVersioningConfig(ctx, thrift.ToAdminUpdateTaskListVersioningConfigRequest(Request))
+	return thrift.FromAdminUpdateTaskListVersioningConfigResponse(response), thrift.FromError(err)
+}
@@ -23,15 +23,24 @@ package thrift
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
+	yarpchttp "go.uber.org/yarpc/transport/http"
 
 	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
+	"github.com/uber/cadence/.gen/go/admin/adminserviceserver"
 	"github.com/uber/cadence/.gen/go/replicator"
 	"github.com/uber/cadence/.gen/go/shared"
+	thriftClient "github.com/uber/cadence/client/wrappers/thrift"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/testdata"
 	adminHandler "github.com/uber/cadence/service/frontend/admin"
 )
 
@@ -108,11 +117,23 @@ func TestAdminThriftHandler(t *testing.T) {
 		assert.Equal(t, replicator.MergeDLQMessagesResponse{}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("PurgeAsyncWorkflowDLQMessages", func(t *testing.T) {
+		h.EXPECT().PurgeAsyncWorkflowDLQMessages(ctx, &types.PurgeAsyncWorkflowDLQMessagesRequest{}).Return(&types.PurgeAsyncWorkflowDLQMessagesResponse{}, internalErr).Times(1)
+		resp, err := th.PurgeAsyncWorkflowDLQMessages(ctx, &admin.PurgeAsyncWorkflowDLQMessagesRequest{})
+		assert.Equal(t, admin.PurgeAsyncWorkflowDLQMessagesResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("PurgeDLQMessages", func(t *testing.T) {
 		h.EXPECT().PurgeDLQMessages(ctx, &types.PurgeDLQMessagesRequest{}).Return(internalErr).Times(1)
 		err := th.PurgeDLQMessages(ctx, &replicator.PurgeDLQMessagesRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("ReadAsyncWorkflowDLQMessages", func(t *testing.T) {
+		h.EXPECT().ReadAsyncWorkflowDLQMessages(ctx, &types.ReadAsyncWorkflowDLQMessagesRequest{}).Return(&types.ReadAsyncWorkflowDLQMessagesResponse{}, internalErr).Times(1)
+		resp, err := th.ReadAsyncWorkflowDLQMessages(ctx, &admin.ReadAsyncWorkflowDLQMessagesRequest{})
+		assert.Equal(t, admin.ReadAsyncWorkflowDLQMessagesResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("ReadDLQMessages", func(t *testing.T) {
 		h.EXPECT().ReadDLQMessages(ctx, &types.ReadDLQMessagesRequest{}).Return(&types.ReadDLQMessagesResponse{}, internalErr).Times(1)
 		resp, err := th.ReadDLQMessages(ctx, &replicator.ReadDLQMessagesRequest{})
@@ -134,6 +155,30 @@ func TestAdminThriftHandler(t *testing.T) {
 		err := th.RemoveTask(ctx, &shared.RemoveTaskRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("ReplayAsyncWorkflowDLQMessages", func(t *testing.T) {
+		h.EXPECT().ReplayAsyncWorkflowDLQMessages(ctx, &types.ReplayAsyncWorkflowDLQMessagesRequest{}).Return(&types.ReplayAsyncWorkflowDLQMessagesResponse{}, internalErr).Times(1)
+		resp, err := th.ReplayAsyncWorkflowDLQMessages(ctx, &admin.ReplayAsyncWorkflowDLQMessagesRequest{})
+		assert.Equal(t, admin.ReplayAsyncWorkflowDLQMessagesResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("DescribeAsyncWorkflowQueue", func(t *testing.T) {
+		h.EXPECT().DescribeAsyncWorkflowQueue(ctx, &types.DescribeAsyncWorkflowQueueRequest{}).Return(&types.DescribeAsyncWorkflowQueueResponse{}, internalErr).Times(1)
+		resp, err := th.DescribeAsyncWorkflowQueue(ctx, &admin.DescribeAsyncWorkflowQueueRequest{})
+		assert.Equal(t, admin.DescribeAsyncWorkflowQueueResponse{QueueID: common.StringPtr("")}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("UpdateTaskListVersioningConfig", func(t *testing.T) {
+		h.EXPECT().UpdateTaskListVersioningConfig(ctx, &types.UpdateTaskListVersioningConfigRequest{}).Return(&types.UpdateTaskListVersioningConfigResponse{}, internalErr).Times(1)
+		resp, err := th.UpdateTaskListVersioningConfig(ctx, &admin.UpdateTaskListVersioningConfigRequest{})
+		assert.Equal(t, admin.UpdateTaskListVersioningConfigResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("MigrateTaskList", func(t *testing.T) {
+		h.EXPECT().MigrateTaskList(ctx, &types.MigrateTaskListRequest{}).Return(&types.MigrateTaskListResponse{}, internalErr).Times(1)
+		resp, err := th.MigrateTaskList(ctx, &admin.MigrateTaskListRequest{})
+		assert.Equal(t, admin.MigrateTaskListResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("ResendReplicationTasks", func(t *testing.T) {
 		h.EXPECT().ResendReplicationTasks(ctx, &types.ResendReplicationTasksRequest{}).Return(internalErr).Times(1)
 		err := th.ResendReplicationTasks(ctx, &admin.ResendReplicationTasksRequest{})
@@ -151,3 +196,59 @@ func TestAdminThriftHandler(t *testing.T) {
 		assert.Equal(t, expectedErr, err)
 	})
 }
+
+// TestAdminThriftTransport calls the admin handler through the thrift client wrapper,
+// the generated thrift client and server, and a real transport
+func TestAdminThriftTransport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	h := adminHandler.NewMockHandler(ctrl)
+
+	inbound := yarpchttp.NewTransport().NewInbound("127.0.0.1:0")
+	server := yarpc.NewDispatcher(yarpc.Config{
+		Name:     service.Frontend,
+		Inbounds: yarpc.Inbounds{inbound},
+	})
+	server.Register(adminserviceserver.New(NewAdminHandler(h)))
+	require.NoError(t, server.Start())
+	defer server.Stop()
+
+	dispatcher := yarpc.NewDispatcher(yarpc.Config{
+		Name: "cadence-client",
+		Outbounds: yarpc.Outbounds{
+			service.Frontend: {Unary: yarpchttp.NewTransport().NewSingleOutbound("http://" + inbound.Addr().String())},
+		},
+	})
+	require.NoError(t, dispatcher.Start())
+	defer dispatcher.Stop()
+	client := thriftClient.NewAdminClient(adminserviceclient.New(dispatcher.ClientConfig(service.Frontend)))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	t.Run("UpdateTaskListVersioningConfig", func(t *testing.T) {
+		h.EXPECT().UpdateTaskListVersioningConfig(gomock.Any(), &testdata.AdminUpdateTaskListVersioningConfigRequest).
+			Return(&testdata.AdminUpdateTaskListVersioningConfigResponse, nil).Times(1)
+		resp, err := client.UpdateTaskListVersioningConfig(ctx, &testdata.AdminUpdateTaskListVersioningConfigRequest)
+		require.NoError(t, err)
+		assert.Equal(t, &testdata.AdminUpdateTaskListVersioningConfigResponse, resp)
+	})
+	t.Run("UpdateTaskListVersioningConfig error", func(t *testing.T) {
+		badRequestErr := &types.BadRequestError{Message: "build ID not found"}
+		h.EXPECT().UpdateTaskListVersioningConfig(gomock.Any(), gomock.Any()).Return(nil, badRequestErr).Times(1)
+		_, err := client.UpdateTaskListVersioningConfig(ctx, &testdata.AdminUpdateTaskListVersioningConfigRequest)
+		assert.Equal(t, badRequestErr, err)
+	})
+	t.Run("MigrateTaskList", func(t *testing.T) {
+		h.EXPECT().MigrateTaskList(gomock.Any(), &testdata.AdminMigrateTaskListRequest).
+			Return(&testdata.AdminMigrateTaskListResponse, nil).Times(1)
+		resp, err := client.MigrateTaskList(ctx, &testdata.AdminMigrateTaskListRequest)
+		require.NoError(t, err)
+		assert.Equal(t, &testdata.AdminMigrateTaskListResponse, resp)
+	})
+	t.Run("DescribeAsyncWorkflowQueue", func(t *testing.T) {
+		h.EXPECT().DescribeAsyncWorkflowQueue(gomock.Any(), &testdata.AdminDescribeAsyncWorkflowQueueRequest).
+			Return(&testdata.AdminDescribeAsyncWorkflowQueueResponse, nil).Times(1)
+		resp, err := client.DescribeAsyncWorkflowQueue(ctx, &testdata.AdminDescribeAsyncWorkflowQueueRequest)
+		require.NoError(t, err)
+		assert.Equal(t, &testdata.AdminDescribeAsyncWorkflowQueueResponse, resp)
+	})
+}
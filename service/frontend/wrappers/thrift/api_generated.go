@@ -26,6 +26,11 @@ func (g APIHandler) DeprecateDomain(ctx context.Context, DeprecateRequest *share
 	return thrift.FromError(err)
 }
 
+func (g APIHandler) DescribeAsyncRequest(ctx context.Context, DescribeRequest *shared.DescribeAsyncRequestRequest) (dp1 *shared.DescribeAsyncRequestResponse, err error) {
+	response, err := g.h.DescribeAsyncRequest(ctx, thrift.ToDescribeAsyncRequestRequest(DescribeRequest))
+	return thrift.FromDescribeAsyncRequestResponse(response), thrift.FromError(err)
+}
+
 func (g APIHandler) DescribeDomain(ctx context.Context, DescribeRequest *shared.DescribeDomainRequest) (dp1 *shared.DescribeDomainResponse, err error) {
 	response, err := g.h.DescribeDomain(ctx, thrift.ToDescribeDomainRequest(DescribeRequest))
 	return thrift.FromDescribeDomainResponse(response), thrift.FromError(err)
@@ -36,6 +41,11 @@ func (g APIHandler) DescribeTaskList(ctx context.Context, Request *shared.Descri
 	return thrift.FromDescribeTaskListResponse(response), thrift.FromError(err)
 }
 
+func (g APIHandler) DescribeWorker(ctx context.Context, Request *shared.DescribeWorkerRequest) (dp1 *shared.DescribeWorkerResponse, err error) {
+	response, err := g.h.DescribeWorker(ctx, thrift.ToDescribeWorkerRequest(Request))
+	return thrift.FromDescribeWorkerResponse(response), thrift.FromError(err)
+}
+
 func (g APIHandler) DescribeWorkflowExecution(ctx context.Context, DescribeRequest *shared.DescribeWorkflowExecutionRequest) (dp1 *shared.DescribeWorkflowExecutionResponse, err error) {
 	response, err := g.h.DescribeWorkflowExecution(ctx, thrift.ToDescribeWorkflowExecutionRequest(DescribeRequest))
 	return thrift.FromDescribeWorkflowExecutionResponse(response), thrift.FromError(err)
@@ -56,6 +66,11 @@ func (g APIHandler) GetSearchAttributes(ctx context.Context) (gp1 *shared.GetSea
 	return thrift.FromGetSearchAttributesResponse(response), thrift.FromError(err)
 }
 
+func (g APIHandler) GetTaskListScalingRecommendation(ctx context.Context, Request *shared.GetTaskListScalingRecommendationRequest) (gp1 *shared.GetTaskListScalingRecommendationResponse, err error) {
+	response, err := g.h.GetTaskListScalingRecommendation(ctx, thrift.ToGetTaskListScalingRecommendationRequest(Request))
+	return thrift.FromGetTaskListScalingRecommendationResponse(response), thrift.FromError(err)
+}
+
 func (g APIHandler) GetTaskListsByDomain(ctx context.Context, Request *shared.GetTaskListsByDomainRequest) (gp1 *shared.GetTaskListsByDomainResponse, err error) {
 	response, err := g.h.GetTaskListsByDomain(ctx, thrift.ToGetTaskListsByDomainRequest(Request))
 	return thrift.FromGetTaskListsByDomainResponse(response), thrift.FromError(err)
@@ -91,6 +106,11 @@ func (g APIHandler) ListTaskListPartitions(ctx context.Context, Request *shared.
 	return thrift.FromListTaskListPartitionsResponse(response), thrift.FromError(err)
 }
 
+func (g APIHandler) ListWorkers(ctx context.Context, Request *shared.ListWorkersRequest) (lp1 *shared.ListWorkersResponse, err error) {
+	response, err := g.h.ListWorkers(ctx, thrift.ToListWorkersRequest(Request))
+	return thrift.FromListWorkersResponse(response), thrift.FromError(err)
+}
+
 func (g APIHandler) ListWorkflowExecutions(ctx context.Context, ListRequest *shared.ListWorkflowExecutionsRequest) (lp1 *shared.ListWorkflowExecutionsResponse, err error) {
 	response, err := g.h.ListWorkflowExecutions(ctx, thrift.ToListWorkflowExecutionsRequest(ListRequest))
 	return thrift.FromListWorkflowExecutionsResponse(response), thrift.FromError(err)
@@ -136,6 +156,11 @@ func (g APIHandler) RequestCancelWorkflowExecution(ctx context.Context, CancelRe
 	return thrift.FromError(err)
 }
 
+func (g APIHandler) RequestCancelWorkflowExecutionAsync(ctx context.Context, CancelRequest *shared.RequestCancelWorkflowExecutionAsyncRequest) (rp1 *shared.RequestCancelWorkflowExecutionAsyncResponse, err error) {
+	response, err := g.h.RequestCancelWorkflowExecutionAsync(ctx, thrift.ToRequestCancelWorkflowExecutionAsyncRequest(CancelRequest))
+	return thrift.FromRequestCancelWorkflowExecutionAsyncResponse(response), thrift.FromError(err)
+}
+
 func (g APIHandler) ResetStickyTaskList(ctx context.Context, ResetRequest *shared.ResetStickyTaskListRequest) (rp1 *shared.ResetStickyTaskListResponse, err error) {
 	response, err := g.h.ResetStickyTaskList(ctx, thrift.ToResetStickyTaskListRequest(ResetRequest))
 	return thrift.FromResetStickyTaskListResponse(response), thrift.FromError(err)
@@ -216,6 +241,11 @@ func (g APIHandler) SignalWorkflowExecution(ctx context.Context, SignalRequest *
 	return thrift.FromError(err)
 }
 
+func (g APIHandler) SignalWorkflowExecutionAsync(ctx context.Context, SignalRequest *shared.SignalWorkflowExecutionAsyncRequest) (sp1 *shared.SignalWorkflowExecutionAsyncResponse, err error) {
+	response, err := g.h.SignalWorkflowExecutionAsync(ctx, thrift.ToSignalWorkflowExecutionAsyncRequest(SignalRequest))
+	return thrift.FromSignalWorkflowExecutionAsyncResponse(response), thrift.FromError(err)
+}
+
 func (g APIHandler) StartWorkflowExecution(ctx context.Context, StartRequest *shared.StartWorkflowExecutionRequest) (sp1 *shared.StartWorkflowExecutionResponse, err error) {
 	response, err := g.h.StartWorkflowExecution(ctx, thrift.ToStartWorkflowExecutionRequest(StartRequest))
 	return thrift.FromStartWorkflowExecutionResponse(response), thrift.FromError(err)
@@ -231,7 +261,17 @@ func (g APIHandler) TerminateWorkflowExecution(ctx context.Context, TerminateReq
 	return thrift.FromError(err)
 }
 
+func (g APIHandler) TerminateWorkflowExecutionAsync(ctx context.Context, TerminateRequest *shared.TerminateWorkflowExecutionAsyncRequest) (tp1 *shared.TerminateWorkflowExecutionAsyncResponse, err error) {
+	response, err := g.h.TerminateWorkflowExecutionAsync(ctx, thrift.ToTerminateWorkflowExecutionAsyncRequest(TerminateRequest))
+	return thrift.FromTerminateWorkflowExecutionAsyncResponse(response), thrift.FromError(err)
+}
+
 func (g APIHandler) UpdateDomain(ctx context.Context, UpdateRequest *shared.UpdateDomainRequest) (up1 *shared.UpdateDomainResponse, err error) {
 	response, err := g.h.UpdateDomain(ctx, thrift.ToUpdateDomainRequest(UpdateRequest))
 	return thrift.FromUpdateDomainResponse(response), thrift.FromError(err)
 }
+
+func (g APIHandler) UpdateWorkflowMemo(ctx context.Context, UpdateRequest *shared.UpdateWorkflowMemoRequest) (up1 *shared.UpdateWorkflowMemoResponse, err error) {
+	response, err := g.h.UpdateWorkflowMemo(ctx, thrift.ToUpdateWorkflowMemoRequest(UpdateRequest))
+	return thrift.FromUpdateWorkflowMemoResponse(response), thrift.FromError(err)
+}
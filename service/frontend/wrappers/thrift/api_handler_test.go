@@ -62,6 +62,12 @@ func TestThriftHandler(t *testing.T) {
 		err := th.DeprecateDomain(ctx, &shared.DeprecateDomainRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("DescribeAsyncRequest", func(t *testing.T) {
+		h.EXPECT().DescribeAsyncRequest(ctx, &types.DescribeAsyncRequestRequest{}).Return(&types.DescribeAsyncRequestResponse{}, internalErr).Times(1)
+		resp, err := th.DescribeAsyncRequest(ctx, &shared.DescribeAsyncRequestRequest{})
+		assert.Equal(t, shared.DescribeAsyncRequestResponse{RequestID: common.StringPtr(""), Failure: common.StringPtr("")}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("DescribeDomain", func(t *testing.T) {
 		h.EXPECT().DescribeDomain(ctx, &types.DescribeDomainRequest{}).Return(&types.DescribeDomainResponse{}, internalErr).Times(1)
 		resp, err := th.DescribeDomain(ctx, &shared.DescribeDomainRequest{})
@@ -92,6 +98,24 @@ func TestThriftHandler(t *testing.T) {
 		assert.Equal(t, shared.GetSearchAttributesResponse{}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("GetTaskListScalingRecommendation", func(t *testing.T) {
+		h.EXPECT().GetTaskListScalingRecommendation(ctx, &types.GetTaskListScalingRecommendationRequest{}).Return(&types.GetTaskListScalingRecommendationResponse{}, internalErr).Times(1)
+		resp, err := th.GetTaskListScalingRecommendation(ctx, &shared.GetTaskListScalingRecommendationRequest{})
+		assert.Equal(t, shared.GetTaskListScalingRecommendationResponse{RecommendedPollerCount: common.Int64Ptr(0)}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("ListWorkers", func(t *testing.T) {
+		h.EXPECT().ListWorkers(ctx, &types.ListWorkersRequest{}).Return(&types.ListWorkersResponse{}, internalErr).Times(1)
+		resp, err := th.ListWorkers(ctx, &shared.ListWorkersRequest{})
+		assert.Equal(t, shared.ListWorkersResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("DescribeWorker", func(t *testing.T) {
+		h.EXPECT().DescribeWorker(ctx, &types.DescribeWorkerRequest{}).Return(&types.DescribeWorkerResponse{}, internalErr).Times(1)
+		resp, err := th.DescribeWorker(ctx, &shared.DescribeWorkerRequest{})
+		assert.Equal(t, shared.DescribeWorkerResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("GetWorkflowExecutionHistory", func(t *testing.T) {
 		h.EXPECT().GetWorkflowExecutionHistory(ctx, &types.GetWorkflowExecutionHistoryRequest{}).Return(&types.GetWorkflowExecutionHistoryResponse{}, internalErr).Times(1)
 		resp, err := th.GetWorkflowExecutionHistory(ctx, &shared.GetWorkflowExecutionHistoryRequest{})
@@ -174,6 +198,12 @@ func TestThriftHandler(t *testing.T) {
 		err := th.RequestCancelWorkflowExecution(ctx, &shared.RequestCancelWorkflowExecutionRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("RequestCancelWorkflowExecutionAsync", func(t *testing.T) {
+		h.EXPECT().RequestCancelWorkflowExecutionAsync(ctx, &types.RequestCancelWorkflowExecutionAsyncRequest{}).Return(&types.RequestCancelWorkflowExecutionAsyncResponse{}, internalErr).Times(1)
+		resp, err := th.RequestCancelWorkflowExecutionAsync(ctx, &shared.RequestCancelWorkflowExecutionAsyncRequest{})
+		assert.Equal(t, shared.RequestCancelWorkflowExecutionAsyncResponse{RequestID: common.StringPtr("")}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("ResetStickyTaskList", func(t *testing.T) {
 		h.EXPECT().ResetStickyTaskList(ctx, &types.ResetStickyTaskListRequest{}).Return(&types.ResetStickyTaskListResponse{}, internalErr).Times(1)
 		resp, err := th.ResetStickyTaskList(ctx, &shared.ResetStickyTaskListRequest{})
@@ -249,6 +279,12 @@ func TestThriftHandler(t *testing.T) {
 		err := th.SignalWorkflowExecution(ctx, &shared.SignalWorkflowExecutionRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("SignalWorkflowExecutionAsync", func(t *testing.T) {
+		h.EXPECT().SignalWorkflowExecutionAsync(ctx, &types.SignalWorkflowExecutionAsyncRequest{}).Return(&types.SignalWorkflowExecutionAsyncResponse{}, internalErr).Times(1)
+		resp, err := th.SignalWorkflowExecutionAsync(ctx, &shared.SignalWorkflowExecutionAsyncRequest{})
+		assert.Equal(t, shared.SignalWorkflowExecutionAsyncResponse{RequestID: common.StringPtr("")}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("StartWorkflowExecution", func(t *testing.T) {
 		h.EXPECT().StartWorkflowExecution(ctx, &types.StartWorkflowExecutionRequest{}).Return(&types.StartWorkflowExecutionResponse{}, internalErr).Times(1)
 		resp, err := th.StartWorkflowExecution(ctx, &shared.StartWorkflowExecutionRequest{})
@@ -260,10 +296,22 @@ func TestThriftHandler(t *testing.T) {
 		err := th.TerminateWorkflowExecution(ctx, &shared.TerminateWorkflowExecutionRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("TerminateWorkflowExecutionAsync", func(t *testing.T) {
+		h.EXPECT().TerminateWorkflowExecutionAsync(ctx, &types.TerminateWorkflowExecutionAsyncRequest{}).Return(&types.TerminateWorkflowExecutionAsyncResponse{}, internalErr).Times(1)
+		resp, err := th.TerminateWorkflowExecutionAsync(ctx, &shared.TerminateWorkflowExecutionAsyncRequest{})
+		assert.Equal(t, shared.TerminateWorkflowExecutionAsyncResponse{RequestID: common.StringPtr("")}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("UpdateDomain", func(t *testing.T) {
 		h.EXPECT().UpdateDomain(ctx, &types.UpdateDomainRequest{}).Return(&types.UpdateDomainResponse{}, internalErr).Times(1)
 		resp, err := th.UpdateDomain(ctx, &shared.UpdateDomainRequest{})
 		assert.Equal(t, shared.UpdateDomainResponse{IsGlobalDomain: common.BoolPtr(false), FailoverVersion: common.Int64Ptr(0)}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("UpdateWorkflowMemo", func(t *testing.T) {
+		h.EXPECT().UpdateWorkflowMemo(ctx, &types.UpdateWorkflowMemoRequest{}).Return(&types.UpdateWorkflowMemoResponse{}, internalErr).Times(1)
+		resp, err := th.UpdateWorkflowMemo(ctx, &shared.UpdateWorkflowMemoRequest{})
+		assert.Equal(t, shared.UpdateWorkflowMemoResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 }
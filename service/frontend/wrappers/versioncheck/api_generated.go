@@ -57,6 +57,14 @@ func (h *versionCheckHandler) DeprecateDomain(ctx context.Context, dp1 *types.De
 	return h.frontendHandler.DeprecateDomain(ctx, dp1)
 }
 
+func (h *versionCheckHandler) DescribeAsyncRequest(ctx context.Context, dp1 *types.DescribeAsyncRequestRequest) (dp2 *types.DescribeAsyncRequestResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.DescribeAsyncRequest(ctx, dp1)
+}
+
 func (h *versionCheckHandler) DescribeDomain(ctx context.Context, dp1 *types.DescribeDomainRequest) (dp2 *types.DescribeDomainResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -73,6 +81,14 @@ func (h *versionCheckHandler) DescribeTaskList(ctx context.Context, dp1 *types.D
 	return h.frontendHandler.DescribeTaskList(ctx, dp1)
 }
 
+func (h *versionCheckHandler) DescribeWorker(ctx context.Context, dp1 *types.DescribeWorkerRequest) (dp2 *types.DescribeWorkerResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.DescribeWorker(ctx, dp1)
+}
+
 func (h *versionCheckHandler) DescribeWorkflowExecution(ctx context.Context, dp1 *types.DescribeWorkflowExecutionRequest) (dp2 *types.DescribeWorkflowExecutionResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -101,6 +117,14 @@ func (h *versionCheckHandler) GetSearchAttributes(ctx context.Context) (gp1 *typ
 	return h.frontendHandler.GetSearchAttributes(ctx)
 }
 
+func (h *versionCheckHandler) GetTaskListScalingRecommendation(ctx context.Context, gp1 *types.GetTaskListScalingRecommendationRequest) (gp2 *types.GetTaskListScalingRecommendationResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.GetTaskListScalingRecommendation(ctx, gp1)
+}
+
 func (h *versionCheckHandler) GetTaskListsByDomain(ctx context.Context, gp1 *types.GetTaskListsByDomainRequest) (gp2 *types.GetTaskListsByDomainResponse, err error) {
 	return h.frontendHandler.GetTaskListsByDomain(ctx, gp1)
 }
@@ -153,6 +177,14 @@ func (h *versionCheckHandler) ListTaskListPartitions(ctx context.Context, lp1 *t
 	return h.frontendHandler.ListTaskListPartitions(ctx, lp1)
 }
 
+func (h *versionCheckHandler) ListWorkers(ctx context.Context, lp1 *types.ListWorkersRequest) (lp2 *types.ListWorkersResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.ListWorkers(ctx, lp1)
+}
+
 func (h *versionCheckHandler) ListWorkflowExecutions(ctx context.Context, lp1 *types.ListWorkflowExecutionsRequest) (lp2 *types.ListWorkflowExecutionsResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -221,6 +253,14 @@ func (h *versionCheckHandler) RequestCancelWorkflowExecution(ctx context.Context
 	return h.frontendHandler.RequestCancelWorkflowExecution(ctx, rp1)
 }
 
+func (h *versionCheckHandler) RequestCancelWorkflowExecutionAsync(ctx context.Context, rp1 *types.RequestCancelWorkflowExecutionAsyncRequest) (rp2 *types.RequestCancelWorkflowExecutionAsyncResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.RequestCancelWorkflowExecutionAsync(ctx, rp1)
+}
+
 func (h *versionCheckHandler) ResetStickyTaskList(ctx context.Context, rp1 *types.ResetStickyTaskListRequest) (rp2 *types.ResetStickyTaskListResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -349,6 +389,14 @@ func (h *versionCheckHandler) SignalWorkflowExecution(ctx context.Context, sp1 *
 	return h.frontendHandler.SignalWorkflowExecution(ctx, sp1)
 }
 
+func (h *versionCheckHandler) SignalWorkflowExecutionAsync(ctx context.Context, sp1 *types.SignalWorkflowExecutionAsyncRequest) (sp2 *types.SignalWorkflowExecutionAsyncResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.SignalWorkflowExecutionAsync(ctx, sp1)
+}
+
 func (h *versionCheckHandler) StartWorkflowExecution(ctx context.Context, sp1 *types.StartWorkflowExecutionRequest) (sp2 *types.StartWorkflowExecutionResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -373,6 +421,14 @@ func (h *versionCheckHandler) TerminateWorkflowExecution(ctx context.Context, tp
 	return h.frontendHandler.TerminateWorkflowExecution(ctx, tp1)
 }
 
+func (h *versionCheckHandler) TerminateWorkflowExecutionAsync(ctx context.Context, tp1 *types.TerminateWorkflowExecutionAsyncRequest) (tp2 *types.TerminateWorkflowExecutionAsyncResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.TerminateWorkflowExecutionAsync(ctx, tp1)
+}
+
 func (h *versionCheckHandler) UpdateDomain(ctx context.Context, up1 *types.UpdateDomainRequest) (up2 *types.UpdateDomainResponse, err error) {
 	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
 	if err != nil {
@@ -380,3 +436,11 @@ func (h *versionCheckHandler) UpdateDomain(ctx context.Context, up1 *types.Updat
 	}
 	return h.frontendHandler.UpdateDomain(ctx, up1)
 }
+
+func (h *versionCheckHandler) UpdateWorkflowMemo(ctx context.Context, up1 *types.UpdateWorkflowMemoRequest) (up2 *types.UpdateWorkflowMemoResponse, err error) {
+	err = h.versionChecker.ClientSupported(ctx, h.config.EnableClientVersionCheck())
+	if err != nil {
+		return
+	}
+	return h.frontendHandler.UpdateWorkflowMemo(ctx, up1)
+}
@@ -0,0 +1,94 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cdc
+
+import (
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// AppName is the messaging application the CDC producer is created for.
+	// Map it to a topic under kafka.applications to enable the stream.
+	AppName = "workflow-cdc"
+
+	// SchemaVersion is the version of Message. It is bumped for any change
+	// other than adding optional fields.
+	SchemaVersion = 1
+)
+
+// MessageType is the kind of change a Message describes
+type MessageType string
+
+const (
+	// MessageTypeWorkflowStarted is published once a workflow run has started
+	MessageTypeWorkflowStarted MessageType = "WorkflowStarted"
+	// MessageTypeWorkflowClosed is published once a workflow run has closed, for any close status
+	MessageTypeWorkflowClosed MessageType = "WorkflowClosed"
+	// MessageTypeSearchAttributesUpserted is published when a running workflow upserts its search attributes
+	MessageTypeSearchAttributesUpserted MessageType = "SearchAttributesUpserted"
+	// MessageTypeHistoryEvent carries one history event selected by the domain's CDC filter.
+	// Selected events of a run are published once the run closes, before its WorkflowClosed message.
+	MessageTypeHistoryEvent MessageType = "HistoryEvent"
+)
+
+// Message is a record of the CDC stream. It is published JSON encoded, keyed by workflow ID,
+// so that all messages of a workflow land on the same partition.
+//
+// Messages are published on a best-effort basis after the visibility write of the same change:
+// a message that fails to publish is dropped and counted by cdc_publish_failures, while a message
+// may still be published more than once when its visibility task is retried, and retries may
+// reorder messages of a run. Sequence is the ID of the last history event of the run the message
+// reflects, the started event for WorkflowStarted, so it only grows within a run across all message
+// types. A lifecycle message shares its Sequence with the HistoryEvent message of that event and follows it.
+//
+// Timestamps are unix nanoseconds.
+type Message struct {
+	SchemaVersion int         `json:"schemaVersion"`
+	Type          MessageType `json:"type"`
+	Sequence      int64       `json:"sequence"`
+	PublishTime   int64       `json:"publishTime"`
+
+	DomainID     string `json:"domainID"`
+	Domain       string `json:"domain"`
+	WorkflowID   string `json:"workflowID"`
+	RunID        string `json:"runID"`
+	WorkflowType string `json:"workflowType"`
+	TaskList     string `json:"taskList"`
+	IsCron       bool   `json:"isCron"`
+
+	StartTime     int64 `json:"startTime"`
+	ExecutionTime int64 `json:"executionTime"`
+
+	// set for WorkflowClosed messages only
+	CloseTime     int64  `json:"closeTime,omitempty"`
+	CloseStatus   string `json:"closeStatus,omitempty"`
+	HistoryLength int64  `json:"historyLength,omitempty"`
+
+	// set for WorkflowStarted, SearchAttributesUpserted and WorkflowClosed messages
+	Memo             map[string][]byte `json:"memo,omitempty"`
+	SearchAttributes map[string][]byte `json:"searchAttributes,omitempty"`
+
+	// set for HistoryEvent messages only
+	EventID      int64               `json:"eventID,omitempty"`
+	HistoryEvent *types.HistoryEvent `json:"historyEvent,omitempty"`
+}
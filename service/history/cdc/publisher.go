@@ -0,0 +1,164 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination publisher_mock.go -self_package github.com/uber/cadence/service/history/cdc
+
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	// Publisher publishes workflow changes to the CDC stream
+	Publisher interface {
+		// Publish sends msg if CDC is enabled for its domain and its type passes the domain's filter.
+		// An error means the message may not have been published.
+		Publish(ctx context.Context, msg *Message) error
+		// PublishesHistoryEvents returns true if the domain's filter selects any history event type
+		PublishesHistoryEvents(domain string) bool
+	}
+
+	// Params is the parameters for a new Publisher
+	Params struct {
+		Producer      messaging.Producer
+		Enabled       dynamicproperties.BoolPropertyFnWithDomainFilter
+		EventTypes    dynamicproperties.MapPropertyFnWithDomainFilter
+		TimeSource    clock.TimeSource
+		MetricsClient metrics.Client
+		Logger        log.Logger
+	}
+
+	publisherImpl struct {
+		producer   messaging.Producer
+		enabled    dynamicproperties.BoolPropertyFnWithDomainFilter
+		eventTypes dynamicproperties.MapPropertyFnWithDomainFilter
+		timeSource clock.TimeSource
+		scope      metrics.Scope
+		logger     log.Logger
+	}
+
+	noopPublisher struct{}
+)
+
+var lifecycleMessageTypes = map[MessageType]struct{}{
+	MessageTypeWorkflowStarted:          {},
+	MessageTypeWorkflowClosed:           {},
+	MessageTypeSearchAttributesUpserted: {},
+}
+
+// NewPublisher creates a Publisher writing to params.Producer
+func NewPublisher(params Params) Publisher {
+	return &publisherImpl{
+		producer:   params.Producer,
+		enabled:    params.Enabled,
+		eventTypes: params.EventTypes,
+		timeSource: params.TimeSource,
+		scope:      params.MetricsClient.Scope(metrics.HistoryCDCPublisherScope),
+		logger:     params.Logger.WithTags(tag.ComponentCDCPublisher),
+	}
+}
+
+// NewNoopPublisher creates a Publisher dropping all messages, used when no CDC producer is configured
+func NewNoopPublisher() Publisher {
+	return &noopPublisher{}
+}
+
+func (p *publisherImpl) Publish(ctx context.Context, msg *Message) error {
+	if !p.enabled(msg.Domain) || !p.isSelected(msg) {
+		p.scope.IncCounter(metrics.CDCMessagesFilteredCounter)
+		return nil
+	}
+
+	msg.SchemaVersion = SchemaVersion
+	msg.PublishTime = p.timeSource.Now().UnixNano()
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		// not retryable, the message would never serialize
+		p.logger.Error("Failed to serialize CDC message, dropping it.",
+			tag.WorkflowDomainName(msg.Domain),
+			tag.WorkflowID(msg.WorkflowID),
+			tag.WorkflowRunID(msg.RunID),
+			tag.Error(err))
+		return nil
+	}
+
+	if err := p.producer.Publish(ctx, &indexer.CDCMessage{
+		WorkflowID: common.StringPtr(msg.WorkflowID),
+		Payload:    payload,
+	}); err != nil {
+		p.scope.IncCounter(metrics.CDCPublishFailuresCounter)
+		return err
+	}
+	p.scope.IncCounter(metrics.CDCMessagesPublishedCounter)
+	return nil
+}
+
+func (p *publisherImpl) PublishesHistoryEvents(domain string) bool {
+	if !p.enabled(domain) {
+		return false
+	}
+	for key, selected := range p.eventTypes(domain) {
+		if _, ok := lifecycleMessageTypes[MessageType(key)]; !ok && isTrue(selected) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *publisherImpl) isSelected(msg *Message) bool {
+	eventTypes := p.eventTypes(msg.Domain)
+	if msg.Type == MessageTypeHistoryEvent {
+		if msg.HistoryEvent == nil || msg.HistoryEvent.EventType == nil {
+			return false
+		}
+		return isTrue(eventTypes[msg.HistoryEvent.EventType.String()])
+	}
+	// an empty filter selects all lifecycle messages
+	if len(eventTypes) == 0 {
+		return true
+	}
+	return isTrue(eventTypes[string(msg.Type)])
+}
+
+func isTrue(value interface{}) bool {
+	selected, ok := value.(bool)
+	return ok && selected
+}
+
+func (p *noopPublisher) Publish(context.Context, *Message) error {
+	return nil
+}
+
+func (p *noopPublisher) PublishesHistoryEvents(string) bool {
+	return false
+}
@@ -0,0 +1,156 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+func newTestPublisher(t *testing.T, enabled bool, eventTypes map[string]interface{}) (Publisher, *messaging.MockProducer, clock.MockedTimeSource) {
+	ctrl := gomock.NewController(t)
+	producer := messaging.NewMockProducer(ctrl)
+	timeSource := clock.NewMockedTimeSourceAt(time.Unix(1700000000, 0))
+	publisher := NewPublisher(Params{
+		Producer:      producer,
+		Enabled:       dynamicproperties.GetBoolPropertyFnFilteredByDomain(enabled),
+		EventTypes:    func(string) map[string]interface{} { return eventTypes },
+		TimeSource:    timeSource,
+		MetricsClient: metrics.NewNoopMetricsClient(),
+		Logger:        testlogger.New(t),
+	})
+	return publisher, producer, timeSource
+}
+
+func TestPublish(t *testing.T) {
+	activityFailed := &types.HistoryEvent{ID: 5, EventType: types.EventTypeActivityTaskFailed.Ptr()}
+	timerFired := &types.HistoryEvent{ID: 6, EventType: types.EventTypeTimerFired.Ptr()}
+
+	tests := map[string]struct {
+		enabled    bool
+		eventTypes map[string]interface{}
+		msg        *Message
+		published  bool
+	}{
+		"disabled domain": {
+			enabled: false,
+			msg:     &Message{Type: MessageTypeWorkflowStarted},
+		},
+		"empty filter selects lifecycle messages": {
+			enabled:   true,
+			msg:       &Message{Type: MessageTypeWorkflowClosed},
+			published: true,
+		},
+		"empty filter drops history events": {
+			enabled: true,
+			msg:     &Message{Type: MessageTypeHistoryEvent, EventID: 5, HistoryEvent: activityFailed},
+		},
+		"filter selects listed lifecycle messages": {
+			enabled:    true,
+			eventTypes: map[string]interface{}{"WorkflowClosed": true},
+			msg:        &Message{Type: MessageTypeWorkflowClosed},
+			published:  true,
+		},
+		"filter drops unlisted lifecycle messages": {
+			enabled:    true,
+			eventTypes: map[string]interface{}{"WorkflowClosed": true},
+			msg:        &Message{Type: MessageTypeSearchAttributesUpserted},
+		},
+		"filter selects listed history events": {
+			enabled:    true,
+			eventTypes: map[string]interface{}{"ActivityTaskFailed": true},
+			msg:        &Message{Type: MessageTypeHistoryEvent, EventID: 5, HistoryEvent: activityFailed},
+			published:  true,
+		},
+		"filter drops unlisted history events": {
+			enabled:    true,
+			eventTypes: map[string]interface{}{"ActivityTaskFailed": true},
+			msg:        &Message{Type: MessageTypeHistoryEvent, EventID: 6, HistoryEvent: timerFired},
+		},
+		"filter ignores entries not set to true": {
+			enabled:    true,
+			eventTypes: map[string]interface{}{"WorkflowStarted": false, "WorkflowClosed": "true"},
+			msg:        &Message{Type: MessageTypeWorkflowClosed},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			publisher, producer, timeSource := newTestPublisher(t, test.enabled, test.eventTypes)
+			test.msg.Domain = "test-domain"
+			test.msg.WorkflowID = "test-workflow-id"
+			if test.published {
+				producer.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, message interface{}) error {
+					cdcMsg, ok := message.(*indexer.CDCMessage)
+					require.True(t, ok)
+					assert.Equal(t, "test-workflow-id", cdcMsg.GetWorkflowID())
+
+					var decoded Message
+					require.NoError(t, json.Unmarshal(cdcMsg.GetPayload(), &decoded))
+					assert.Equal(t, SchemaVersion, decoded.SchemaVersion)
+					assert.Equal(t, timeSource.Now().UnixNano(), decoded.PublishTime)
+					assert.Equal(t, test.msg.Type, decoded.Type)
+					assert.Equal(t, test.msg.EventID, decoded.EventID)
+					return nil
+				})
+			}
+			assert.NoError(t, publisher.Publish(context.Background(), test.msg))
+		})
+	}
+}
+
+func TestPublish_ProducerError(t *testing.T) {
+	publisher, producer, _ := newTestPublisher(t, true, nil)
+	producer.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(errors.New("kafka unavailable"))
+
+	err := publisher.Publish(context.Background(), &Message{Type: MessageTypeWorkflowStarted, Domain: "test-domain"})
+	assert.Error(t, err)
+}
+
+func TestPublishesHistoryEvents(t *testing.T) {
+	publisher, _, _ := newTestPublisher(t, true, map[string]interface{}{"WorkflowClosed": true})
+	assert.False(t, publisher.PublishesHistoryEvents("test-domain"))
+
+	publisher, _, _ = newTestPublisher(t, true, map[string]interface{}{"WorkflowClosed": true, "ActivityTaskFailed": true})
+	assert.True(t, publisher.PublishesHistoryEvents("test-domain"))
+
+	publisher, _, _ = newTestPublisher(t, false, map[string]interface{}{"ActivityTaskFailed": true})
+	assert.False(t, publisher.PublishesHistoryEvents("test-domain"))
+
+	assert.False(t, NewNoopPublisher().PublishesHistoryEvents("test-domain"))
+}
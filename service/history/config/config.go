@@ -86,6 +86,11 @@ type Config struct {
 	AcquireShardInterval    dynamicproperties.DurationPropertyFn
 	AcquireShardConcurrency dynamicproperties.IntPropertyFn
 
+	// graceful shard handoff settings
+	EnableGracefulShardHandoff dynamicproperties.BoolPropertyFn
+	ShardHandoffDrainTimeout   dynamicproperties.DurationPropertyFn
+	ShardHandoffWaitTimeout    dynamicproperties.DurationPropertyFn
+
 	// the artificial delay added to standby cluster's view of active cluster's time
 	StandbyClusterDelay                  dynamicproperties.DurationPropertyFn
 	StandbyTaskMissingEventsResendDelay  dynamicproperties.DurationPropertyFn
@@ -210,6 +215,12 @@ type Config struct {
 	ParentClosePolicyBatchSize dynamicproperties.IntPropertyFnWithDomainFilter
 	// total number of parentClosePolicy system workflows
 	NumParentClosePolicySystemWorkflows dynamicproperties.IntPropertyFn
+	// whether or not completion callbacks are delivered when workflows close
+	EnableCompletionCallbacks dynamicproperties.BoolPropertyFnWithDomainFilter
+	// whether or not workflow lifecycle changes are published to the CDC stream
+	EnableWorkflowCDC dynamicproperties.BoolPropertyFnWithDomainFilter
+	// CDC message types and history event types published for a domain
+	WorkflowCDCEventTypes dynamicproperties.MapPropertyFnWithDomainFilter
 
 	// Archival settings
 	NumArchiveSystemWorkflows        dynamicproperties.IntPropertyFn
@@ -243,6 +254,11 @@ type Config struct {
 	// StickyTTL is to expire a sticky tasklist if no update more than this duration
 	// TODO https://github.com/uber/cadence/issues/2357
 	StickyTTL dynamicproperties.DurationPropertyFnWithDomainFilter
+	// EnableStickyExecution, StickyMaxScheduleToStartTimeout and StickyPreferredHostAffinity are the sticky execution
+	// policies of a domain or workflow type
+	EnableStickyExecution           dynamicproperties.BoolPropertyFnWithWorkflowTypeFilter
+	StickyMaxScheduleToStartTimeout dynamicproperties.DurationPropertyFnWithWorkflowTypeFilter
+	StickyPreferredHostAffinity     dynamicproperties.BoolPropertyFnWithWorkflowTypeFilter
 	// DecisionHeartbeatTimeout is to timeout behavior of: RespondDecisionTaskComplete with ForceCreateNewDecisionTask == true without any decisions
 	// So that decision will be scheduled to another worker(by clear stickyness)
 	DecisionHeartbeatTimeout dynamicproperties.DurationPropertyFnWithDomainFilter
@@ -307,6 +323,8 @@ type Config struct {
 	EnableActivityLocalDispatchByDomain dynamicproperties.BoolPropertyFnWithDomainFilter
 	// Max # of activity tasks to dispatch to matching before creating transfer tasks. This is an performance optimization to skip activity scheduling efforts.
 	MaxActivityCountDispatchByDomain dynamicproperties.IntPropertyFnWithDomainFilter
+	// Max # of activities started eagerly from a single decision completion and returned to the worker.
+	MaxEagerActivityCountByDomain dynamicproperties.IntPropertyFnWithDomainFilter
 
 	ActivityMaxScheduleToStartTimeoutForRetry dynamicproperties.DurationPropertyFnWithDomainFilter
 
@@ -380,6 +398,9 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		RangeSizeBits:                        20, // 20 bits for sequencer, 2^20 sequence number for any range
 		AcquireShardInterval:                 dc.GetDurationProperty(dynamicproperties.AcquireShardInterval),
 		AcquireShardConcurrency:              dc.GetIntProperty(dynamicproperties.AcquireShardConcurrency),
+		EnableGracefulShardHandoff:           dc.GetBoolProperty(dynamicproperties.EnableGracefulShardHandoff),
+		ShardHandoffDrainTimeout:             dc.GetDurationProperty(dynamicproperties.ShardHandoffDrainTimeout),
+		ShardHandoffWaitTimeout:              dc.GetDurationProperty(dynamicproperties.ShardHandoffWaitTimeout),
 		StandbyClusterDelay:                  dc.GetDurationProperty(dynamicproperties.StandbyClusterDelay),
 		StandbyTaskMissingEventsResendDelay:  dc.GetDurationProperty(dynamicproperties.StandbyTaskMissingEventsResendDelay),
 		StandbyTaskMissingEventsDiscardDelay: dc.GetDurationProperty(dynamicproperties.StandbyTaskMissingEventsDiscardDelay),
@@ -486,6 +507,9 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		EnableParentClosePolicyWorker:       dc.GetBoolProperty(dynamicproperties.EnableParentClosePolicyWorker),
 		ParentClosePolicyThreshold:          dc.GetIntPropertyFilteredByDomain(dynamicproperties.ParentClosePolicyThreshold),
 		ParentClosePolicyBatchSize:          dc.GetIntPropertyFilteredByDomain(dynamicproperties.ParentClosePolicyBatchSize),
+		EnableCompletionCallbacks:           dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableCompletionCallbacks),
+		EnableWorkflowCDC:                   dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableWorkflowCDC),
+		WorkflowCDCEventTypes:               dc.GetMapPropertyFilteredByDomain(dynamicproperties.WorkflowCDCEventTypes),
 
 		NumArchiveSystemWorkflows:        dc.GetIntProperty(dynamicproperties.NumArchiveSystemWorkflows),
 		ArchiveRequestRPS:                dc.GetIntProperty(dynamicproperties.ArchiveRequestRPS),
@@ -515,6 +539,9 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 		SearchAttributesTotalSizeLimit:           dc.GetIntPropertyFilteredByDomain(dynamicproperties.SearchAttributesTotalSizeLimit),
 		SearchAttributesHiddenValueKeys:          dc.GetMapProperty(dynamicproperties.SearchAttributesHiddenValueKeys),
 		StickyTTL:                                dc.GetDurationPropertyFilteredByDomain(dynamicproperties.StickyTTL),
+		EnableStickyExecution:                    dc.GetBoolPropertyFilteredByWorkflowType(dynamicproperties.EnableStickyExecution),
+		StickyMaxScheduleToStartTimeout:          dc.GetDurationPropertyFilteredByWorkflowType(dynamicproperties.StickyMaxScheduleToStartTimeout),
+		StickyPreferredHostAffinity:              dc.GetBoolPropertyFilteredByWorkflowType(dynamicproperties.StickyPreferredHostAffinity),
 		DecisionHeartbeatTimeout:                 dc.GetDurationPropertyFilteredByDomain(dynamicproperties.DecisionHeartbeatTimeout),
 		DecisionRetryCriticalAttempts:            dc.GetIntProperty(dynamicproperties.DecisionRetryCriticalAttempts),
 		DecisionRetryMaxAttempts:                 dc.GetIntPropertyFilteredByDomain(dynamicproperties.DecisionRetryMaxAttempts),
@@ -563,6 +590,7 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, maxMessageSize int, i
 
 		EnableActivityLocalDispatchByDomain: dc.GetBoolPropertyFilteredByDomain(dynamicproperties.EnableActivityLocalDispatchByDomain),
 		MaxActivityCountDispatchByDomain:    dc.GetIntPropertyFilteredByDomain(dynamicproperties.MaxActivityCountDispatchByDomain),
+		MaxEagerActivityCountByDomain:       dc.GetIntPropertyFilteredByDomain(dynamicproperties.MaxEagerActivityCountByDomain),
 
 		ActivityMaxScheduleToStartTimeoutForRetry: dc.GetDurationPropertyFilteredByDomain(dynamicproperties.ActivityMaxScheduleToStartTimeoutForRetry),
 
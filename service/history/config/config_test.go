@@ -91,6 +91,9 @@ func TestNewConfig(t *testing.T) {
 		"RangeSizeBits":                                        {nil, uint(20)},
 		"AcquireShardInterval":                                 {dynamicproperties.AcquireShardInterval, time.Second},
 		"AcquireShardConcurrency":                              {dynamicproperties.AcquireShardConcurrency, 29},
+		"EnableGracefulShardHandoff":                           {dynamicproperties.EnableGracefulShardHandoff, true},
+		"ShardHandoffDrainTimeout":                             {dynamicproperties.ShardHandoffDrainTimeout, time.Second * 7},
+		"ShardHandoffWaitTimeout":                              {dynamicproperties.ShardHandoffWaitTimeout, time.Second * 8},
 		"StandbyClusterDelay":                                  {dynamicproperties.StandbyClusterDelay, time.Second},
 		"StandbyTaskMissingEventsResendDelay":                  {dynamicproperties.StandbyTaskMissingEventsResendDelay, time.Second},
 		"StandbyTaskMissingEventsDiscardDelay":                 {dynamicproperties.StandbyTaskMissingEventsDiscardDelay, time.Second},
@@ -180,6 +183,9 @@ func TestNewConfig(t *testing.T) {
 		"ParentClosePolicyThreshold":                           {dynamicproperties.ParentClosePolicyThreshold, 61},
 		"ParentClosePolicyBatchSize":                           {dynamicproperties.ParentClosePolicyBatchSize, 62},
 		"NumParentClosePolicySystemWorkflows":                  {dynamicproperties.NumParentClosePolicySystemWorkflows, 63},
+		"EnableCompletionCallbacks":                            {dynamicproperties.EnableCompletionCallbacks, true},
+		"EnableWorkflowCDC":                                    {dynamicproperties.EnableWorkflowCDC, true},
+		"WorkflowCDCEventTypes":                                {dynamicproperties.WorkflowCDCEventTypes, map[string]interface{}{"WorkflowClosed": true}},
 		"NumArchiveSystemWorkflows":                            {dynamicproperties.NumArchiveSystemWorkflows, 64},
 		"ArchiveRequestRPS":                                    {dynamicproperties.ArchiveRequestRPS, 65},
 		"ArchiveInlineHistoryRPS":                              {dynamicproperties.ArchiveInlineHistoryRPS, 66},
@@ -202,6 +208,9 @@ func TestNewConfig(t *testing.T) {
 		"SearchAttributesSizeOfValueLimit":                     {dynamicproperties.SearchAttributesSizeOfValueLimit, 79},
 		"SearchAttributesTotalSizeLimit":                       {dynamicproperties.SearchAttributesTotalSizeLimit, 80},
 		"StickyTTL":                                            {dynamicproperties.StickyTTL, time.Second},
+		"EnableStickyExecution":                                {dynamicproperties.EnableStickyExecution, false},
+		"StickyMaxScheduleToStartTimeout":                      {dynamicproperties.StickyMaxScheduleToStartTimeout, time.Second},
+		"StickyPreferredHostAffinity":                          {dynamicproperties.StickyPreferredHostAffinity, true},
 		"DecisionHeartbeatTimeout":                             {dynamicproperties.DecisionHeartbeatTimeout, time.Second},
 		"MaxDecisionStartToCloseSeconds":                       {dynamicproperties.MaxDecisionStartToCloseSeconds, 81},
 		"DecisionRetryCriticalAttempts":                        {dynamicproperties.DecisionRetryCriticalAttempts, 82},
@@ -245,6 +254,7 @@ func TestNewConfig(t *testing.T) {
 		"EnableGracefulFailover":                               {dynamicproperties.EnableGracefulFailover, true},
 		"EnableActivityLocalDispatchByDomain":                  {dynamicproperties.EnableActivityLocalDispatchByDomain, true},
 		"MaxActivityCountDispatchByDomain":                     {dynamicproperties.MaxActivityCountDispatchByDomain, 92},
+		"MaxEagerActivityCountByDomain":                        {dynamicproperties.MaxEagerActivityCountByDomain, 100},
 		"ActivityMaxScheduleToStartTimeoutForRetry":            {dynamicproperties.ActivityMaxScheduleToStartTimeoutForRetry, time.Second},
 		"EnableDebugMode":                                      {dynamicproperties.EnableDebugMode, true},
 		"EnableTaskInfoLogByDomainID":                          {dynamicproperties.HistoryEnableTaskInfoLogByDomainID, true},
@@ -360,6 +370,10 @@ func getValue(f *reflect.Value) interface{} {
 			return fn("domain")
 		case dynamicproperties.BoolPropertyFnWithShardIDFilter:
 			return fn(0)
+		case dynamicproperties.BoolPropertyFnWithWorkflowTypeFilter:
+			return fn("domain", "workflowType")
+		case dynamicproperties.DurationPropertyFnWithWorkflowTypeFilter:
+			return fn("domain", "workflowType")
 		case func() []string:
 			return fn()
 		default:
@@ -644,6 +644,25 @@ func (v *attrValidator) validateUpsertWorkflowSearchAttributes(
 	return v.searchAttributesValidator.ValidateSearchAttributes(attributes.GetSearchAttributes(), domainName)
 }
 
+func (v *attrValidator) validateUpsertWorkflowMemoAttributes(
+	attributes *types.UpsertWorkflowMemoDecisionAttributes,
+) error {
+
+	if attributes == nil {
+		return &types.BadRequestError{Message: "UpsertWorkflowMemoDecisionAttributes is not set on decision."}
+	}
+
+	if attributes.Memo == nil {
+		return &types.BadRequestError{Message: "Memo is not set on decision."}
+	}
+
+	if len(attributes.GetMemo().GetFields()) == 0 {
+		return &types.BadRequestError{Message: "Memo fields are empty on decision."}
+	}
+
+	return nil
+}
+
 func (v *attrValidator) validateContinueAsNewWorkflowExecutionAttributes(
 	attributes *types.ContinueAsNewWorkflowExecutionDecisionAttributes,
 	executionInfo *persistence.WorkflowExecutionInfo,
@@ -411,14 +411,24 @@ Update_History_Loop:
 		)
 		hasUnhandledEvents = msBuilder.HasBufferedEvents()
 
-		if request.StickyAttributes == nil || request.StickyAttributes.WorkerTaskList == nil {
+		stickyAllowed := handler.config.EnableStickyExecution(domainName, executionInfo.WorkflowTypeName)
+		if request.StickyAttributes == nil || request.StickyAttributes.WorkerTaskList == nil || !stickyAllowed {
 			scope.IncCounter(metrics.CompleteDecisionWithStickyDisabledCounter)
+			if request.StickyAttributes != nil && !stickyAllowed {
+				scope.IncCounter(metrics.CompleteDecisionWithStickyDisabledByPolicyCounter)
+			}
 			executionInfo.StickyTaskList = ""
 			executionInfo.StickyScheduleToStartTimeout = 0
+			executionInfo.StickyWorkerIdentity = ""
 		} else {
 			scope.IncCounter(metrics.CompleteDecisionWithStickyEnabledCounter)
 			executionInfo.StickyTaskList = request.StickyAttributes.WorkerTaskList.GetName()
 			executionInfo.StickyScheduleToStartTimeout = request.StickyAttributes.GetScheduleToStartTimeoutSeconds()
+			executionInfo.StickyWorkerIdentity = request.GetIdentity()
+			maxTimeout := handler.config.StickyMaxScheduleToStartTimeout(domainName, executionInfo.WorkflowTypeName)
+			if maxTimeout > 0 && time.Duration(executionInfo.StickyScheduleToStartTimeout)*time.Second > maxTimeout {
+				executionInfo.StickyScheduleToStartTimeout = int32(max(maxTimeout.Seconds(), 1))
+			}
 		}
 		executionInfo.ClientLibraryVersion = clientLibVersion
 		executionInfo.ClientFeatureVersion = clientFeatureVersion
@@ -457,6 +467,7 @@ Update_History_Loop:
 				handler.domainCache,
 				handler.metricsClient,
 				handler.config,
+				request.EagerActivitySlots,
 			)
 
 			if decisionResults, err = decisionTaskHandler.handleDecisions(
@@ -624,7 +635,7 @@ Update_History_Loop:
 				activitiesToDispatchLocally[dr.activityDispatchInfo.ActivityID] = dr.activityDispatchInfo
 			}
 		}
-		logger.Debugf("%d activities will be dispatched locally on the client side")
+		logger.Debugf("%d activities will be dispatched locally on the client side", len(activitiesToDispatchLocally))
 		resp.ActivitiesToDispatchLocally = activitiesToDispatchLocally
 
 		if request.GetReturnNewDecisionTask() && createNewDecisionTask {
@@ -33,6 +33,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
@@ -74,6 +75,8 @@ type (
 		config        *config.Config
 
 		activityCountToDispatch int
+		// remaining # of activities that can be started eagerly and returned to the worker
+		eagerActivitySlots int
 	}
 
 	decisionResult struct {
@@ -93,8 +96,15 @@ func newDecisionTaskHandler(
 	domainCache cache.DomainCache,
 	metricsClient metrics.Client,
 	config *config.Config,
+	eagerActivitySlots *int32,
 ) *taskHandlerImpl {
 
+	domainName := domainEntry.GetInfo().Name
+	maxEagerActivities := config.MaxEagerActivityCountByDomain(domainName)
+	if eagerActivitySlots != nil && int(*eagerActivitySlots) < maxEagerActivities {
+		maxEagerActivities = int(*eagerActivitySlots)
+	}
+
 	return &taskHandlerImpl{
 		identity:                identity,
 		decisionTaskCompletedID: decisionTaskCompletedID,
@@ -121,7 +131,8 @@ func newDecisionTaskHandler(
 		metricsClient: metricsClient,
 		config:        config,
 
-		activityCountToDispatch: config.MaxActivityCountDispatchByDomain(domainEntry.GetInfo().Name),
+		activityCountToDispatch: config.MaxActivityCountDispatchByDomain(domainName),
+		eagerActivitySlots:      maxEagerActivities,
 	}
 }
 
@@ -205,6 +216,9 @@ func (handler *taskHandlerImpl) handleDecision(
 	case types.DecisionTypeUpsertWorkflowSearchAttributes:
 		return handler.handleDecisionUpsertWorkflowSearchAttributes(ctx, decision.UpsertWorkflowSearchAttributesDecisionAttributes)
 
+	case types.DecisionTypeUpsertWorkflowMemo:
+		return handler.handleDecisionUpsertWorkflowMemo(ctx, decision.UpsertWorkflowMemoDecisionAttributes)
+
 	default:
 		return &types.BadRequestError{Message: fmt.Sprintf("Unknown decision type: %v", decision.GetDecisionType())}
 	}
@@ -256,11 +270,29 @@ func (handler *taskHandlerImpl) handleDecisionScheduleActivity(
 		return nil, err
 	}
 
+	if attr.RequestLocalDispatch && !handler.canDispatchActivityEagerly(executionInfo, domainID, targetDomainID, attr) {
+		// the worker cannot take this activity, so let it go through matching like any other activity
+		handler.metricsClient.IncCounter(
+			metrics.HistoryRespondDecisionTaskCompletedScope,
+			metrics.DecisionTypeScheduleActivityEagerDispatchFallbackCounter,
+		)
+		fallbackAttr := *attr
+		fallbackAttr.RequestLocalDispatch = false
+		attr = &fallbackAttr
+	}
+
 	event, ai, activityDispatchInfo, dispatched, started, err := handler.mutableState.AddActivityTaskScheduledEvent(
 		ctx, handler.decisionTaskCompletedID, attr, handler.activityCountToDispatch > 0)
 	if dispatched {
 		handler.activityCountToDispatch--
 	}
+	if activityDispatchInfo != nil {
+		handler.eagerActivitySlots--
+		handler.metricsClient.IncCounter(
+			metrics.HistoryRespondDecisionTaskCompletedScope,
+			metrics.DecisionTypeScheduleActivityEagerDispatchCounter,
+		)
+	}
 	switch err.(type) {
 	case nil:
 		if activityDispatchInfo != nil || started {
@@ -305,6 +337,24 @@ func (handler *taskHandlerImpl) handleDecisionScheduleActivity(
 	}
 }
 
+// canDispatchActivityEagerly checks whether an activity can be started right away and handed back to
+// the worker which completed the decision. This is only the case when the worker still has free slots
+// and the activity is scheduled on the task list of the workflow, which is the one the worker is polling.
+func (handler *taskHandlerImpl) canDispatchActivityEagerly(
+	executionInfo *persistence.WorkflowExecutionInfo,
+	domainID string,
+	targetDomainID string,
+	attr *types.ScheduleActivityTaskDecisionAttributes,
+) bool {
+	if handler.eagerActivitySlots <= 0 {
+		return false
+	}
+	if domainID != targetDomainID {
+		return false
+	}
+	return attr.TaskList.GetName() == executionInfo.TaskList
+}
+
 // handleFailWorkflowError handles the certain types of error by failing the workflow
 func (handler *taskHandlerImpl) handleFailWorkflowError(failReason string, failDetails string) error {
 	// Fail the workflow immediately instead of just returning the error
@@ -1042,6 +1092,41 @@ func (handler *taskHandlerImpl) handleDecisionUpsertWorkflowSearchAttributes(
 	return err
 }
 
+func (handler *taskHandlerImpl) handleDecisionUpsertWorkflowMemo(
+	ctx context.Context,
+	attr *types.UpsertWorkflowMemoDecisionAttributes,
+) error {
+
+	handler.metricsClient.IncCounter(
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+		metrics.DecisionTypeUpsertWorkflowMemoCounter,
+	)
+
+	if err := handler.validateDecisionAttr(
+		func() error {
+			return handler.attrValidator.validateUpsertWorkflowMemoAttributes(attr)
+		},
+		types.DecisionTaskFailedCauseBadMemo,
+	); err != nil || handler.stopProcessing {
+		return err
+	}
+
+	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
+		metrics.DecisionTypeTag(types.DecisionTypeUpsertWorkflowMemo.String()),
+		convertSearchAttributesToByteArray(attr.GetMemo().GetFields()),
+		"UpsertWorkflowMemoDecisionAttributes exceeds size limit.",
+	)
+	if err != nil || failWorkflow {
+		handler.stopProcessing = true
+		return err
+	}
+
+	_, err = handler.mutableState.AddUpsertWorkflowMemoEvent(
+		handler.decisionTaskCompletedID, attr,
+	)
+	return err
+}
+
 func convertSearchAttributesToByteArray(fields map[string][]byte) []byte {
 	result := make([]byte, 0)
 
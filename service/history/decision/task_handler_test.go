@@ -704,6 +704,85 @@ func TestHandleDecisionUpsertWorkflowSearchAttributes(t *testing.T) {
 	}
 }
 
+func TestHandleDecisionUpsertWorkflowMemo(t *testing.T) {
+	tests := []struct {
+		name            string
+		expectMockCalls func(taskHandler *taskHandlerImpl, attr *types.UpsertWorkflowMemoDecisionAttributes)
+		attributes      *types.UpsertWorkflowMemoDecisionAttributes
+		asserts         func(t *testing.T, taskHandler *taskHandlerImpl, attr *types.UpsertWorkflowMemoDecisionAttributes, err error)
+	}{
+		{
+			name: "success",
+			attributes: &types.UpsertWorkflowMemoDecisionAttributes{
+				Memo: &types.Memo{Fields: map[string][]byte{"some-key": []byte("some-value")}},
+			},
+			expectMockCalls: func(taskHandler *taskHandlerImpl, attr *types.UpsertWorkflowMemoDecisionAttributes) {
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetExecutionInfo().Times(1).Return(&persistence.WorkflowExecutionInfo{
+					DomainID:   constants.TestDomainID,
+					WorkflowID: constants.TestWorkflowID,
+					RunID:      constants.TestRunID,
+				})
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().AddUpsertWorkflowMemoEvent(testTaskCompletedID, attr)
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, attr *types.UpsertWorkflowMemoDecisionAttributes, err error) {
+				assert.False(t, taskHandler.failDecision)
+				assert.Empty(t, taskHandler.failMessage)
+				assert.Nil(t, taskHandler.failDecisionCause)
+				assert.Nil(t, err)
+			},
+		},
+		{
+			name: "attributes validation failure",
+			attributes: &types.UpsertWorkflowMemoDecisionAttributes{
+				Memo: &types.Memo{},
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, attr *types.UpsertWorkflowMemoDecisionAttributes, err error) {
+				assert.True(t, taskHandler.failDecision)
+				assert.Equal(t, "Memo fields are empty on decision.", *taskHandler.failMessage)
+				assert.Equal(t, types.DecisionTaskFailedCauseBadMemo, *taskHandler.failDecisionCause)
+			},
+		},
+		{
+			name: "size limit checker failure",
+			attributes: &types.UpsertWorkflowMemoDecisionAttributes{
+				Memo: &types.Memo{Fields: map[string][]byte{"some-key": []byte("some-value")}},
+			},
+			expectMockCalls: func(taskHandler *taskHandlerImpl, attr *types.UpsertWorkflowMemoDecisionAttributes) {
+				taskHandler.sizeLimitChecker.blobSizeLimitWarn = 2
+				taskHandler.sizeLimitChecker.blobSizeLimitError = 1
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().GetExecutionInfo().Times(1).Return(&persistence.WorkflowExecutionInfo{
+					DomainID:   constants.TestDomainID,
+					WorkflowID: constants.TestWorkflowID,
+					RunID:      constants.TestRunID,
+				})
+				taskHandler.mutableState.(*execution.MockMutableState).EXPECT().AddFailWorkflowEvent(testTaskCompletedID, &types.FailWorkflowExecutionDecisionAttributes{
+					Reason:  common.StringPtr(common.FailureReasonDecisionBlobSizeExceedsLimit),
+					Details: []byte("UpsertWorkflowMemoDecisionAttributes exceeds size limit."),
+				}).Return(nil, nil)
+			},
+			asserts: func(t *testing.T, taskHandler *taskHandlerImpl, attr *types.UpsertWorkflowMemoDecisionAttributes, err error) {
+				assert.False(t, taskHandler.failDecision)
+				assert.True(t, taskHandler.stopProcessing)
+				assert.Nil(t, err)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			taskHandler := newTaskHandlerForTest(t)
+			if test.expectMockCalls != nil {
+				test.expectMockCalls(taskHandler, test.attributes)
+			}
+			decision := &types.Decision{
+				DecisionType:                         common.Ptr(types.DecisionTypeUpsertWorkflowMemo),
+				UpsertWorkflowMemoDecisionAttributes: test.attributes,
+			}
+			err := taskHandler.handleDecision(context.Background(), decision)
+			test.asserts(t, taskHandler, test.attributes, err)
+		})
+	}
+}
+
 func TestHandleDecisionStartTimer(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -1445,6 +1524,190 @@ func TestHandleDecisionScheduleActivity(t *testing.T) {
 	}
 }
 
+func TestHandleDecisionScheduleActivityEagerDispatch(t *testing.T) {
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: testdata.DomainID, Name: testdata.DomainName},
+		&persistence.DomainConfig{Retention: 1},
+		cluster.TestCurrentClusterName)
+	executionInfo := &persistence.WorkflowExecutionInfo{
+		DomainID:        testdata.DomainID,
+		WorkflowID:      testdata.WorkflowID,
+		TaskList:        testdata.TaskListName,
+		WorkflowTimeout: 100,
+	}
+	newAttr := func(taskList string) *types.ScheduleActivityTaskDecisionAttributes {
+		return &types.ScheduleActivityTaskDecisionAttributes{
+			Domain:                        testdata.DomainName,
+			TaskList:                      &types.TaskList{Name: taskList},
+			ActivityID:                    "some-activity-id",
+			ActivityType:                  &types.ActivityType{Name: testdata.ActivityTypeName},
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(20),
+			StartToCloseTimeoutSeconds:    common.Int32Ptr(80),
+			Input:                         []byte("some-input"),
+			RequestLocalDispatch:          true,
+		}
+	}
+
+	tests := []struct {
+		name               string
+		attributes         *types.ScheduleActivityTaskDecisionAttributes
+		eagerActivitySlots int
+		expectEager        bool
+	}{
+		{
+			name:               "same task list with free slots is dispatched eagerly",
+			attributes:         newAttr(testdata.TaskListName),
+			eagerActivitySlots: 2,
+			expectEager:        true,
+		},
+		{
+			name:               "no free slots falls back to matching",
+			attributes:         newAttr(testdata.TaskListName),
+			eagerActivitySlots: 0,
+			expectEager:        false,
+		},
+		{
+			name:               "different task list falls back to matching",
+			attributes:         newAttr("some-other-task-list"),
+			eagerActivitySlots: 2,
+			expectEager:        false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			taskHandler := newTaskHandlerForTest(t)
+			taskHandler.eagerActivitySlots = test.eagerActivitySlots
+			mockMutableState := taskHandler.mutableState.(*execution.MockMutableState)
+			mockMutableState.EXPECT().GetExecutionInfo().Return(executionInfo).Times(2)
+			taskHandler.domainCache.(*cache.MockDomainCache).EXPECT().GetDomain(testdata.DomainName).Return(domainEntry, nil)
+
+			expectedAttr := *test.attributes
+			expectedAttr.RequestLocalDispatch = test.expectEager
+			if test.expectEager {
+				mockMutableState.EXPECT().AddActivityTaskScheduledEvent(context.Background(), taskHandler.decisionTaskCompletedID, &expectedAttr, taskHandler.activityCountToDispatch > 0).
+					Return(&types.HistoryEvent{}, &persistence.ActivityInfo{}, &types.ActivityLocalDispatchInfo{}, false, false, nil)
+				mockMutableState.EXPECT().AddActivityTaskStartedEvent(&persistence.ActivityInfo{}, int64(0), gomock.Any(), taskHandler.identity)
+				taskHandler.tokenSerializer.(*common.MockTaskTokenSerializer).EXPECT().Serialize(gomock.Any()).Return([]byte("some-serialized-data"), nil)
+			} else {
+				mockMutableState.EXPECT().AddActivityTaskScheduledEvent(context.Background(), taskHandler.decisionTaskCompletedID, &expectedAttr, taskHandler.activityCountToDispatch > 0).
+					Return(&types.HistoryEvent{}, &persistence.ActivityInfo{}, nil, false, false, nil)
+			}
+
+			res, err := taskHandler.handleDecisionScheduleActivity(context.Background(), test.attributes)
+			assert.NoError(t, err)
+			assert.True(t, test.attributes.RequestLocalDispatch, "decision attributes from the request should not be modified")
+			if test.expectEager {
+				assert.NotNil(t, res)
+				assert.Equal(t, []byte("some-serialized-data"), res.activityDispatchInfo.TaskToken)
+				assert.Equal(t, test.eagerActivitySlots-1, taskHandler.eagerActivitySlots)
+			} else {
+				assert.Nil(t, res)
+				assert.Equal(t, test.eagerActivitySlots, taskHandler.eagerActivitySlots)
+			}
+		})
+	}
+}
+
+func TestCanDispatchActivityEagerly(t *testing.T) {
+	executionInfo := &persistence.WorkflowExecutionInfo{TaskList: testdata.TaskListName}
+	tests := []struct {
+		name               string
+		eagerActivitySlots int
+		targetDomainID     string
+		taskList           string
+		expected           bool
+	}{
+		{
+			name:               "eligible",
+			eagerActivitySlots: 1,
+			targetDomainID:     testdata.DomainID,
+			taskList:           testdata.TaskListName,
+			expected:           true,
+		},
+		{
+			name:               "no slots left",
+			eagerActivitySlots: 0,
+			targetDomainID:     testdata.DomainID,
+			taskList:           testdata.TaskListName,
+			expected:           false,
+		},
+		{
+			name:               "cross domain activity",
+			eagerActivitySlots: 1,
+			targetDomainID:     "some-other-domain-id",
+			taskList:           testdata.TaskListName,
+			expected:           false,
+		},
+		{
+			name:               "different task list",
+			eagerActivitySlots: 1,
+			targetDomainID:     testdata.DomainID,
+			taskList:           "some-other-task-list",
+			expected:           false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			taskHandler := newTaskHandlerForTest(t)
+			taskHandler.eagerActivitySlots = test.eagerActivitySlots
+			attr := &types.ScheduleActivityTaskDecisionAttributes{
+				TaskList:             &types.TaskList{Name: test.taskList},
+				RequestLocalDispatch: true,
+			}
+			assert.Equal(t, test.expected, taskHandler.canDispatchActivityEagerly(executionInfo, testdata.DomainID, test.targetDomainID, attr))
+		})
+	}
+}
+
+func TestNewDecisionTaskHandlerEagerActivitySlots(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestedSlots *int32
+		expected       int
+	}{
+		{
+			name:           "worker does not declare slots",
+			requestedSlots: nil,
+			expected:       100,
+		},
+		{
+			name:           "worker has fewer slots than the domain limit",
+			requestedSlots: common.Int32Ptr(3),
+			expected:       3,
+		},
+		{
+			name:           "worker has more slots than the domain limit",
+			requestedSlots: common.Int32Ptr(500),
+			expected:       100,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockMutableState := execution.NewMockMutableState(ctrl)
+			mockMutableState.EXPECT().HasBufferedEvents().Return(false)
+			testConfig := config.NewForTest()
+			testConfig.MaxEagerActivityCountByDomain = func(string) int { return 100 }
+			taskHandler := newDecisionTaskHandler(
+				testdata.Identity,
+				testTaskCompletedID,
+				constants.TestLocalDomainEntry,
+				mockMutableState,
+				nil,
+				nil,
+				common.NewMockTaskTokenSerializer(ctrl),
+				testlogger.New(t),
+				cache.NewMockDomainCache(ctrl),
+				metrics.NewClient(tally.NoopScope, metrics.History),
+				testConfig,
+				test.requestedSlots,
+			)
+			assert.Equal(t, test.expected, taskHandler.eagerActivitySlots)
+		})
+	}
+}
+
 func TestHandleDecisionContinueAsNewWorkflow(t *testing.T) {
 	executionInfo := &persistence.WorkflowExecutionInfo{
 		DomainID:        testdata.DomainID,
@@ -1668,6 +1931,7 @@ func newTaskHandlerForTest(t *testing.T) *taskHandlerImpl {
 		mockDomainCache,
 		metrics.NewClient(tally.NoopScope, metrics.History),
 		testConfig,
+		nil,
 	)
 	return taskHandler
 }
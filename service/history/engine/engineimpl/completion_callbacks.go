@@ -0,0 +1,132 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+// Portions of the Software are attributed to Copyright (c) 2021 Temporal Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+// GetCompletionCallbacks returns the completion callbacks of a closed workflow execution.
+func (e *historyEngineImpl) GetCompletionCallbacks(
+	ctx context.Context,
+	request *types.GetCompletionCallbacksRequest,
+) (retResp *types.GetCompletionCallbacksResponse, retError error) {
+
+	if err := common.ValidateDomainUUID(request.DomainUUID); err != nil {
+		return nil, err
+	}
+
+	wfContext, release, err := e.executionCache.GetOrCreateWorkflowExecution(ctx, request.DomainUUID, *request.WorkflowExecution)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := wfContext.LoadWorkflowExecution(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if mutableState.IsWorkflowExecutionRunning() {
+		return nil, &types.BadRequestError{Message: "Workflow execution is still running."}
+	}
+
+	completionEvent, err := mutableState.GetCompletionEvent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	executionInfo := mutableState.GetExecutionInfo()
+	return &types.GetCompletionCallbacksResponse{
+		Callbacks:     executionInfo.CompletionCallbacks,
+		WorkflowType:  &types.WorkflowType{Name: executionInfo.WorkflowTypeName},
+		CloseStatus:   persistence.ToInternalWorkflowExecutionCloseStatus(executionInfo.CloseStatus),
+		CloseTime:     common.Int64Ptr(completionEvent.GetTimestamp()),
+		HistoryLength: mutableState.GetNextEventID() - constants.FirstEventID,
+	}, nil
+}
+
+// RecordCompletionCallbackState records the delivery state of a completion callback of a closed workflow execution.
+// The state is kept in mutable state only, no history event is written for it.
+func (e *historyEngineImpl) RecordCompletionCallbackState(
+	ctx context.Context,
+	request *types.RecordCompletionCallbackStateRequest,
+) (retError error) {
+
+	if err := common.ValidateDomainUUID(request.DomainUUID); err != nil {
+		return err
+	}
+	if request.State == nil {
+		return &types.BadRequestError{Message: "Completion callback state is not set."}
+	}
+
+	wfContext, release, err := e.executionCache.GetOrCreateWorkflowExecution(ctx, request.DomainUUID, *request.WorkflowExecution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := wfContext.LoadWorkflowExecution(ctx)
+	if err != nil {
+		return err
+	}
+	if mutableState.IsWorkflowExecutionRunning() {
+		return &types.BadRequestError{Message: "Workflow execution is still running."}
+	}
+
+	executionInfo := mutableState.GetExecutionInfo()
+	index := int(request.Index)
+	if index < 0 || index >= len(executionInfo.CompletionCallbacks) {
+		return &types.BadRequestError{Message: fmt.Sprintf("Completion callback index %v is out of range.", index)}
+	}
+
+	states := make([]*types.CompletionCallbackInfo, len(executionInfo.CompletionCallbacks))
+	copy(states, executionInfo.CompletionCallbackStates)
+	state := *request.State
+	state.URL = executionInfo.CompletionCallbacks[index].URL
+	states[index] = &state
+	executionInfo.CompletionCallbackStates = states
+
+	return wfContext.UpdateWorkflowExecutionTasks(ctx, e.shard.GetTimeSource().Now())
+}
+
+// newCompletionCallbackInfos returns the delivery state of the completion callbacks of a closed workflow execution.
+// Callbacks the system workflow has not recorded a state for yet are reported as scheduled.
+func newCompletionCallbackInfos(executionInfo *persistence.WorkflowExecutionInfo) []*types.CompletionCallbackInfo {
+	infos := make([]*types.CompletionCallbackInfo, len(executionInfo.CompletionCallbacks))
+	for i, callback := range executionInfo.CompletionCallbacks {
+		if i < len(executionInfo.CompletionCallbackStates) && executionInfo.CompletionCallbackStates[i] != nil {
+			infos[i] = executionInfo.CompletionCallbackStates[i]
+			continue
+		}
+		infos[i] = &types.CompletionCallbackInfo{
+			URL:   callback.URL,
+			State: types.CompletionCallbackStateScheduled.Ptr(),
+		}
+	}
+	return infos
+}
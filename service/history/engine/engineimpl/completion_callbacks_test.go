@@ -0,0 +1,233 @@
+// Copyright (c) 2017-2021 Uber Technologies, Inc.
+// Portions of the Software are attributed to Copyright (c) 2021 Temporal Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package engineimpl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/constants"
+	"github.com/uber/cadence/service/history/engine/testdata"
+)
+
+func TestGetCompletionCallbacks(t *testing.T) {
+	callbacks := []*types.CompletionCallback{
+		{URL: "https://example.com/a", Headers: map[string]string{"Authorization": "token"}},
+	}
+	tests := []struct {
+		name      string
+		state     int
+		wantResp  *types.GetCompletionCallbacksResponse
+		wantError bool
+	}{
+		{
+			name:      "running workflow",
+			state:     persistence.WorkflowStateRunning,
+			wantError: true,
+		},
+		{
+			name:  "closed workflow",
+			state: persistence.WorkflowStateCompleted,
+			wantResp: &types.GetCompletionCallbacksResponse{
+				Callbacks:     callbacks,
+				WorkflowType:  &types.WorkflowType{Name: "workflow-type"},
+				CloseStatus:   types.WorkflowExecutionCloseStatusFailed.Ptr(),
+				CloseTime:     common.Int64Ptr(1234),
+				HistoryLength: 10,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+			eft.Engine.Start()
+			defer eft.Engine.Stop()
+
+			eft.ShardCtx.Resource.ExecutionMgr.
+				On("GetWorkflowExecution", mock.Anything, mock.Anything).
+				Return(newCompletionCallbacksExecutionResponse(tc.state, callbacks, nil), nil).
+				Once()
+
+			resp, err := eft.Engine.GetCompletionCallbacks(context.Background(), &types.GetCompletionCallbacksRequest{
+				DomainUUID: constants.TestDomainID,
+				WorkflowExecution: &types.WorkflowExecution{
+					WorkflowID: constants.TestWorkflowID,
+					RunID:      constants.TestRunID,
+				},
+			})
+			if tc.wantError {
+				assert.IsType(t, &types.BadRequestError{}, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantResp, resp)
+		})
+	}
+}
+
+func TestRecordCompletionCallbackState(t *testing.T) {
+	callbacks := []*types.CompletionCallback{
+		{URL: "https://example.com/a"},
+		{URL: "https://example.com/b"},
+	}
+	state := &types.CompletionCallbackInfo{
+		State:             types.CompletionCallbackStateBackingOff.Ptr(),
+		Attempt:           2,
+		LastFailureReason: "503 Service Unavailable",
+	}
+	tests := []struct {
+		name       string
+		state      int
+		index      int32
+		wantStates []*types.CompletionCallbackInfo
+		wantError  bool
+	}{
+		{
+			name:      "running workflow",
+			state:     persistence.WorkflowStateRunning,
+			index:     0,
+			wantError: true,
+		},
+		{
+			name:      "index out of range",
+			state:     persistence.WorkflowStateCompleted,
+			index:     2,
+			wantError: true,
+		},
+		{
+			name:  "closed workflow",
+			state: persistence.WorkflowStateCompleted,
+			index: 1,
+			wantStates: []*types.CompletionCallbackInfo{
+				nil,
+				{
+					URL:               "https://example.com/b",
+					State:             types.CompletionCallbackStateBackingOff.Ptr(),
+					Attempt:           2,
+					LastFailureReason: "503 Service Unavailable",
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			eft := testdata.NewEngineForTest(t, NewEngineWithShardContext)
+			eft.Engine.Start()
+			defer eft.Engine.Stop()
+
+			eft.ShardCtx.Resource.ExecutionMgr.
+				On("GetWorkflowExecution", mock.Anything, mock.Anything).
+				Return(newCompletionCallbacksExecutionResponse(tc.state, callbacks, nil), nil).
+				Once()
+			var gotUpdateExecReq *persistence.UpdateWorkflowExecutionRequest
+			eft.ShardCtx.Resource.ExecutionMgr.
+				On("UpdateWorkflowExecution", mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					gotUpdateExecReq = args.Get(1).(*persistence.UpdateWorkflowExecutionRequest)
+				}).
+				Return(&persistence.UpdateWorkflowExecutionResponse{
+					MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{},
+				}, nil).
+				Maybe()
+
+			err := eft.Engine.RecordCompletionCallbackState(context.Background(), &types.RecordCompletionCallbackStateRequest{
+				DomainUUID: constants.TestDomainID,
+				WorkflowExecution: &types.WorkflowExecution{
+					WorkflowID: constants.TestWorkflowID,
+					RunID:      constants.TestRunID,
+				},
+				Index: tc.index,
+				State: state,
+			})
+			if tc.wantError {
+				assert.IsType(t, &types.BadRequestError{}, err)
+				assert.Nil(t, gotUpdateExecReq)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, gotUpdateExecReq)
+			assert.Equal(t, persistence.UpdateWorkflowModeIgnoreCurrent, gotUpdateExecReq.Mode)
+			assert.Equal(t, tc.wantStates, gotUpdateExecReq.UpdateWorkflowMutation.ExecutionInfo.CompletionCallbackStates)
+			assert.Empty(t, gotUpdateExecReq.UpdateWorkflowMutation.NewBufferedEvents)
+		})
+	}
+}
+
+func TestNewCompletionCallbackInfos(t *testing.T) {
+	succeeded := &types.CompletionCallbackInfo{
+		URL:     "https://example.com/a",
+		State:   types.CompletionCallbackStateSucceeded.Ptr(),
+		Attempt: 1,
+	}
+	infos := newCompletionCallbackInfos(&persistence.WorkflowExecutionInfo{
+		CompletionCallbacks: []*types.CompletionCallback{
+			{URL: "https://example.com/a"},
+			{URL: "https://example.com/b"},
+		},
+		CompletionCallbackStates: []*types.CompletionCallbackInfo{succeeded},
+	})
+	assert.Equal(t, []*types.CompletionCallbackInfo{
+		succeeded,
+		{URL: "https://example.com/b", State: types.CompletionCallbackStateScheduled.Ptr()},
+	}, infos)
+}
+
+func newCompletionCallbacksExecutionResponse(
+	state int,
+	callbacks []*types.CompletionCallback,
+	states []*types.CompletionCallbackInfo,
+) *persistence.GetWorkflowExecutionResponse {
+	closeStatus := persistence.WorkflowCloseStatusNone
+	if state == persistence.WorkflowStateCompleted {
+		closeStatus = persistence.WorkflowCloseStatusFailed
+	}
+	return &persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				DomainID:               constants.TestDomainID,
+				WorkflowID:             constants.TestWorkflowID,
+				RunID:                  constants.TestRunID,
+				WorkflowTypeName:       "workflow-type",
+				State:                  state,
+				CloseStatus:            closeStatus,
+				NextEventID:            11,
+				CompletionEventBatchID: 10,
+				CompletionEvent: &types.HistoryEvent{
+					Timestamp: common.Int64Ptr(1234),
+				},
+				CompletionCallbacks:      callbacks,
+				CompletionCallbackStates: states,
+			},
+			ExecutionStats: &persistence.ExecutionStats{},
+		},
+		MutableStateStats: &persistence.MutableStateStats{},
+	}
+}
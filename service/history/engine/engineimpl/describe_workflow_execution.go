@@ -123,6 +123,9 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 			return nil, err
 		}
 		result.WorkflowExecutionInfo.CloseTime = common.Int64Ptr(completionEvent.GetTimestamp())
+		if len(executionInfo.CompletionCallbacks) > 0 {
+			result.CompletionCallbacks = newCompletionCallbackInfos(executionInfo)
+		}
 	}
 
 	if len(mutableState.GetPendingActivityInfos()) > 0 {
@@ -219,5 +222,26 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 		result.PendingDecision = pendingDecision
 	}
 
+	if executionInfo.StickyTaskList != "" || executionInfo.StickyHitCount > 0 || executionInfo.StickyFallbackCount > 0 {
+		result.StickyExecutionInfo = newStickyExecutionInfo(executionInfo)
+	}
+
 	return result, nil
 }
+
+func newStickyExecutionInfo(executionInfo *persistence.WorkflowExecutionInfo) *types.StickyExecutionInfo {
+	info := &types.StickyExecutionInfo{
+		StickyHost:    executionInfo.StickyWorkerIdentity,
+		HitCount:      executionInfo.StickyHitCount,
+		FallbackCount: executionInfo.StickyFallbackCount,
+	}
+	if executionInfo.StickyTaskList != "" {
+		info.StickyTaskList = &types.TaskList{Name: executionInfo.StickyTaskList, Kind: types.TaskListKindSticky.Ptr()}
+		info.ScheduleToStartTimeoutSeconds = executionInfo.StickyScheduleToStartTimeout
+	}
+	// hit rate is the share of sticky decisions that were picked up by the sticky worker
+	if total := info.HitCount + info.FallbackCount; total > 0 {
+		info.HitRate = float64(info.HitCount) / float64(total)
+	}
+	return info
+}
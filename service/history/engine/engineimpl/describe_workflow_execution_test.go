@@ -292,3 +292,27 @@ func TestDescribeWorkflowExecution(t *testing.T) {
 		})
 	}
 }
+
+func TestNewStickyExecutionInfo(t *testing.T) {
+	info := newStickyExecutionInfo(&persistence.WorkflowExecutionInfo{
+		StickyTaskList:               "sticky-tasklist",
+		StickyScheduleToStartTimeout: 5,
+		StickyWorkerIdentity:         "worker-1",
+		StickyHitCount:               3,
+		StickyFallbackCount:          1,
+	})
+	assert.Equal(t, &types.StickyExecutionInfo{
+		StickyTaskList:                &types.TaskList{Name: "sticky-tasklist", Kind: types.TaskListKindSticky.Ptr()},
+		StickyHost:                    "worker-1",
+		ScheduleToStartTimeoutSeconds: 5,
+		HitCount:                      3,
+		FallbackCount:                 1,
+		HitRate:                       0.75,
+	}, info)
+
+	// stickyness was cleared but the stats are kept
+	info = newStickyExecutionInfo(&persistence.WorkflowExecutionInfo{
+		StickyFallbackCount: 2,
+	})
+	assert.Equal(t, &types.StickyExecutionInfo{FallbackCount: 2}, info)
+}
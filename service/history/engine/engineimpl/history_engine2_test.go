@@ -767,6 +767,61 @@ func (s *engine2Suite) TestRecordActivityTaskStartedSuccess() {
 	s.Equal(scheduledEvent, response.ScheduledEvent)
 }
 
+func (s *engine2Suite) TestRecordActivityTaskStartedWithScheduleToStartBreakdown() {
+	domainID := constants.TestDomainID
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+
+	identity := "testIdentity"
+	tl := "testTaskList"
+	breakdown := &types.ScheduleToStartBreakdown{
+		Forwarded:             true,
+		BacklogWaitTimeInMs:   100,
+		RatelimitWaitTimeInMs: 20,
+	}
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, true)
+	decisionCompletedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, int64(2), int64(3), nil, identity)
+	scheduledEvent, _ := test.AddActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.ID, "activity1_id",
+		"activity_type1", tl, []byte("input1"), 100, 10, 1, 5)
+
+	ms1 := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	gwmsResponse1 := &p.GetWorkflowExecutionResponse{State: ms1}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse1, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.MatchedBy(func(request *p.AppendHistoryNodesRequest) bool {
+		return len(request.Events) == 1 &&
+			request.Events[0].GetEventType() == types.EventTypeActivityTaskStarted &&
+			request.Events[0].ActivityTaskStartedEventAttributes.GetScheduleToStartBreakdown() == breakdown
+	})).Return(&p.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{},
+	}, nil).Once()
+
+	s.mockEventsCache.EXPECT().GetEvent(
+		gomock.Any(), gomock.Any(), domainID, workflowExecution.GetWorkflowID(), workflowExecution.GetRunID(),
+		decisionCompletedEvent.ID, scheduledEvent.ID, gomock.Any(),
+	).Return(scheduledEvent, nil)
+	response, err := s.historyEngine.RecordActivityTaskStarted(context.Background(), &types.RecordActivityTaskStartedRequest{
+		DomainUUID:        domainID,
+		WorkflowExecution: &workflowExecution,
+		ScheduleID:        5,
+		TaskID:            100,
+		RequestID:         "reqId",
+		PollRequest: &types.PollForActivityTaskRequest{
+			TaskList: &types.TaskList{
+				Name: tl,
+			},
+			Identity: identity,
+		},
+		ScheduleToStartBreakdown: breakdown,
+	})
+	s.Nil(err)
+	s.NotNil(response)
+}
+
 func (s *engine2Suite) TestRecordActivityTaskStartedResurrected() {
 	domainID := constants.TestDomainID
 	workflowExecution := types.WorkflowExecution{WorkflowID: constants.TestWorkflowID, RunID: constants.TestRunID}
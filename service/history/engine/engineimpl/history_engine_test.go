@@ -5185,6 +5185,78 @@ func (s *engineSuite) TestSignalWorkflowExecution_WorkflowCompleted() {
 	s.EqualError(err, "workflow execution already completed")
 }
 
+func (s *engineSuite) TestUpdateWorkflowMemo() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	updateRequest := &types.HistoryUpdateWorkflowMemoRequest{
+		DomainUUID: constants.TestDomainID,
+		UpdateRequest: &types.UpdateWorkflowMemoRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: &we,
+			Memo:              &types.Memo{Fields: map[string][]byte{"key": []byte("value")}},
+			Identity:          identity,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.UpdateWorkflowMemo(context.Background(), updateRequest)
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestUpdateWorkflowMemo_WorkflowCompleted() {
+	we := &types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	updateRequest := &types.HistoryUpdateWorkflowMemoRequest{
+		DomainUUID: constants.TestDomainID,
+		UpdateRequest: &types.UpdateWorkflowMemoRequest{
+			Domain:            constants.TestDomainID,
+			WorkflowExecution: we,
+			Memo:              &types.Memo{Fields: map[string][]byte{"key": []byte("value")}},
+			Identity:          identity,
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		testlogger.New(s.Suite.T()),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, *we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(s.T(), msBuilder)
+	ms.ExecutionInfo.State = persistence.WorkflowStateCompleted
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.UpdateWorkflowMemo(context.Background(), updateRequest)
+	s.EqualError(err, "workflow execution already completed")
+}
+
 func (s *engineSuite) TestRemoveSignalMutableState() {
 	removeRequest := &types.RemoveSignalMutableStateRequest{}
 	err := s.mockHistoryEngine.RemoveSignalMutableState(context.Background(), removeRequest)
@@ -148,11 +148,17 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 				return &types.EventAlreadyStartedError{Message: "Activity task already started."}
 			}
 
-			if _, err := mutableState.AddActivityTaskStartedEvent(
+			startedEvent, err := mutableState.AddActivityTaskStartedEvent(
 				ai, scheduleID, requestID, request.PollRequest.GetIdentity(),
-			); err != nil {
+			)
+			if err != nil {
 				return err
 			}
+			// activities with a retry policy only get their started event when they are closed,
+			// so the breakdown can only be kept for activities whose started event is written now
+			if startedEvent != nil {
+				startedEvent.ActivityTaskStartedEventAttributes.ScheduleToStartBreakdown = request.GetScheduleToStartBreakdown()
+			}
 
 			response.StartedTimestamp = common.Int64Ptr(ai.StartedTime.UnixNano())
 
@@ -503,6 +503,7 @@ func getStartRequest(
 		DelayStartSeconds:                   request.DelayStartSeconds,
 		JitterStartSeconds:                  request.JitterStartSeconds,
 		FirstRunAtTimeStamp:                 request.FirstRunAtTimestamp,
+		CompletionCallbacks:                 request.CompletionCallbacks,
 	}
 
 	return common.CreateHistoryStartWorkflowRequest(domainID, req, time.Now(), partitionConfig)
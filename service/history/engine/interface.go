@@ -55,9 +55,12 @@ type (
 		SignalWithStartWorkflowExecution(ctx context.Context, request *types.HistorySignalWithStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error)
 		RemoveSignalMutableState(ctx context.Context, request *types.RemoveSignalMutableStateRequest) error
 		TerminateWorkflowExecution(ctx context.Context, request *types.HistoryTerminateWorkflowExecutionRequest) error
+		UpdateWorkflowMemo(ctx context.Context, request *types.HistoryUpdateWorkflowMemoRequest) error
 		ResetWorkflowExecution(ctx context.Context, request *types.HistoryResetWorkflowExecutionRequest) (*types.ResetWorkflowExecutionResponse, error)
 		ScheduleDecisionTask(ctx context.Context, request *types.ScheduleDecisionTaskRequest) error
 		RecordChildExecutionCompleted(ctx context.Context, request *types.RecordChildExecutionCompletedRequest) error
+		GetCompletionCallbacks(ctx context.Context, request *types.GetCompletionCallbacksRequest) (*types.GetCompletionCallbacksResponse, error)
+		RecordCompletionCallbackState(ctx context.Context, request *types.RecordCompletionCallbackStateRequest) error
 		ReplicateEventsV2(ctx context.Context, request *types.ReplicateEventsV2Request) error
 		SyncShardStatus(ctx context.Context, request *types.SyncShardStatusRequest) error
 		SyncActivity(ctx context.Context, request *types.SyncActivityRequest) error
@@ -119,6 +119,21 @@ func (mr *MockEngineMockRecorder) DescribeWorkflowExecution(ctx, request any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).DescribeWorkflowExecution), ctx, request)
 }
 
+// GetCompletionCallbacks mocks base method.
+func (m *MockEngine) GetCompletionCallbacks(ctx context.Context, request *types.GetCompletionCallbacksRequest) (*types.GetCompletionCallbacksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompletionCallbacks", ctx, request)
+	ret0, _ := ret[0].(*types.GetCompletionCallbacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompletionCallbacks indicates an expected call of GetCompletionCallbacks.
+func (mr *MockEngineMockRecorder) GetCompletionCallbacks(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompletionCallbacks", reflect.TypeOf((*MockEngine)(nil).GetCompletionCallbacks), ctx, request)
+}
+
 // GetDLQReplicationMessages mocks base method.
 func (m *MockEngine) GetDLQReplicationMessages(ctx context.Context, taskInfos []*types.ReplicationTaskInfo) ([]*types.ReplicationTask, error) {
 	m.ctrl.T.Helper()
@@ -344,6 +359,20 @@ func (mr *MockEngineMockRecorder) RecordChildExecutionCompleted(ctx, request any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordChildExecutionCompleted", reflect.TypeOf((*MockEngine)(nil).RecordChildExecutionCompleted), ctx, request)
 }
 
+// RecordCompletionCallbackState mocks base method.
+func (m *MockEngine) RecordCompletionCallbackState(ctx context.Context, request *types.RecordCompletionCallbackStateRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordCompletionCallbackState", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordCompletionCallbackState indicates an expected call of RecordCompletionCallbackState.
+func (mr *MockEngineMockRecorder) RecordCompletionCallbackState(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordCompletionCallbackState", reflect.TypeOf((*MockEngine)(nil).RecordCompletionCallbackState), ctx, request)
+}
+
 // RecordDecisionTaskStarted mocks base method.
 func (m *MockEngine) RecordDecisionTaskStarted(ctx context.Context, request *types.RecordDecisionTaskStartedRequest) (*types.RecordDecisionTaskStartedResponse, error) {
 	m.ctrl.T.Helper()
@@ -667,3 +696,17 @@ func (mr *MockEngineMockRecorder) TerminateWorkflowExecution(ctx, request any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).TerminateWorkflowExecution), ctx, request)
 }
+
+// UpdateWorkflowMemo mocks base method.
+func (m *MockEngine) UpdateWorkflowMemo(ctx context.Context, request *types.HistoryUpdateWorkflowMemoRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowMemo", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkflowMemo indicates an expected call of UpdateWorkflowMemo.
+func (mr *MockEngineMockRecorder) UpdateWorkflowMemo(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowMemo", reflect.TypeOf((*MockEngine)(nil).UpdateWorkflowMemo), ctx, request)
+}
@@ -611,6 +611,19 @@ func (b *HistoryBuilder) AddUpsertWorkflowSearchAttributesEvent(
 	return b.addEventToHistory(event)
 }
 
+// AddUpsertWorkflowMemoEvent adds UpsertWorkflowMemo event to history
+func (b *HistoryBuilder) AddUpsertWorkflowMemoEvent(
+	decisionTaskCompletedEventID int64,
+	attributes *types.UpsertWorkflowMemoDecisionAttributes) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeUpsertWorkflowMemo)
+	event.UpsertWorkflowMemoEventAttributes = &types.UpsertWorkflowMemoEventAttributes{
+		DecisionTaskCompletedEventID: decisionTaskCompletedEventID,
+		Memo:                         attributes.GetMemo(),
+	}
+
+	return b.addEventToHistory(event)
+}
+
 // AddSignalExternalWorkflowExecutionFailedEvent adds SignalExternalWorkflowExecutionFailed event to history
 func (b *HistoryBuilder) AddSignalExternalWorkflowExecutionFailedEvent(decisionTaskCompletedEventID, initiatedEventID int64,
 	domain, workflowID, runID string, control []byte, cause types.SignalExternalWorkflowExecutionFailedCause) *types.HistoryEvent {
@@ -680,6 +693,22 @@ func (b *HistoryBuilder) AddWorkflowExecutionSignaledEvent(
 	return b.addEventToHistory(event)
 }
 
+// AddWorkflowExecutionMemoUpdatedEvent adds WorkflowExecutionMemoUpdated event to history
+func (b *HistoryBuilder) AddWorkflowExecutionMemoUpdatedEvent(
+	memo *types.Memo,
+	identity string,
+	requestID string,
+) *types.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(types.EventTypeWorkflowExecutionMemoUpdated)
+	event.WorkflowExecutionMemoUpdatedEventAttributes = &types.WorkflowExecutionMemoUpdatedEventAttributes{
+		Memo:      memo,
+		Identity:  identity,
+		RequestID: requestID,
+	}
+
+	return b.addEventToHistory(event)
+}
+
 // AddStartChildWorkflowExecutionInitiatedEvent adds ChildWorkflowExecutionInitiated event to history
 func (b *HistoryBuilder) AddStartChildWorkflowExecutionInitiatedEvent(
 	decisionCompletedEventID int64,
@@ -98,9 +98,11 @@ type (
 		AddTimerCanceledEvent(int64, *types.CancelTimerDecisionAttributes, string) (*types.HistoryEvent, error)
 		AddTimerFiredEvent(string) (*types.HistoryEvent, error)
 		AddTimerStartedEvent(int64, *types.StartTimerDecisionAttributes) (*types.HistoryEvent, *persistence.TimerInfo, error)
+		AddUpsertWorkflowMemoEvent(int64, *types.UpsertWorkflowMemoDecisionAttributes) (*types.HistoryEvent, error)
 		AddUpsertWorkflowSearchAttributesEvent(int64, *types.UpsertWorkflowSearchAttributesDecisionAttributes) (*types.HistoryEvent, error)
 		AddWorkflowExecutionCancelRequestedEvent(string, *types.HistoryRequestCancelWorkflowExecutionRequest) (*types.HistoryEvent, error)
 		AddWorkflowExecutionCanceledEvent(int64, *types.CancelWorkflowExecutionDecisionAttributes) (*types.HistoryEvent, error)
+		AddWorkflowExecutionMemoUpdatedEvent(memo *types.Memo, identity string, requestID string) (*types.HistoryEvent, error)
 		AddWorkflowExecutionSignaled(signalName string, input []byte, identity string, reqeustID string) (*types.HistoryEvent, error)
 		AddWorkflowExecutionStartedEvent(types.WorkflowExecution, *types.HistoryStartWorkflowExecutionRequest) (*types.HistoryEvent, error)
 		AddWorkflowExecutionTerminatedEvent(firstEventID int64, reason string, details []byte, identity string) (*types.HistoryEvent, error)
@@ -199,12 +201,14 @@ type (
 		ReplicateTimerFiredEvent(*types.HistoryEvent) error
 		ReplicateTimerStartedEvent(*types.HistoryEvent) (*persistence.TimerInfo, error)
 		ReplicateTransientDecisionTaskScheduled() error
+		ReplicateUpsertWorkflowMemoEvent(*types.HistoryEvent) error
 		ReplicateUpsertWorkflowSearchAttributesEvent(*types.HistoryEvent) error
 		ReplicateWorkflowExecutionCancelRequestedEvent(*types.HistoryEvent) error
 		ReplicateWorkflowExecutionCanceledEvent(int64, *types.HistoryEvent) error
 		ReplicateWorkflowExecutionCompletedEvent(int64, *types.HistoryEvent) error
 		ReplicateWorkflowExecutionContinuedAsNewEvent(int64, string, *types.HistoryEvent) error
 		ReplicateWorkflowExecutionFailedEvent(int64, *types.HistoryEvent) error
+		ReplicateWorkflowExecutionMemoUpdatedEvent(*types.HistoryEvent) error
 		ReplicateWorkflowExecutionSignaled(*types.HistoryEvent) error
 		ReplicateWorkflowExecutionStartedEvent(*string, types.WorkflowExecution, string, *types.HistoryEvent, bool) error
 		ReplicateWorkflowExecutionTerminatedEvent(int64, *types.HistoryEvent) error
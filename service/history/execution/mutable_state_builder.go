@@ -250,7 +250,7 @@ func newMutableStateBuilder(
 		CronOverlapPolicy:  types.CronOverlapPolicySkipped,
 	}
 	s.hBuilder = NewHistoryBuilder(s)
-	s.taskGenerator = NewMutableStateTaskGenerator(shard.GetLogger(), shard.GetClusterMetadata(), shard.GetDomainCache(), s)
+	s.taskGenerator = NewMutableStateTaskGenerator(shard.GetLogger(), shard.GetClusterMetadata(), shard.GetDomainCache(), s.config, s)
 	s.decisionTaskManager = newMutableStateDecisionTaskManager(s)
 	s.executionStats = &persistence.ExecutionStats{}
 	return s
@@ -835,7 +835,11 @@ func (e *mutableStateBuilder) IsStickyTaskListEnabled() bool {
 	if e.executionInfo.StickyTaskList == "" {
 		return false
 	}
-	ttl := e.config.StickyTTL(e.GetDomainEntry().GetInfo().Name)
+	domainName := e.GetDomainEntry().GetInfo().Name
+	if !e.config.EnableStickyExecution(domainName, e.executionInfo.WorkflowTypeName) {
+		return false
+	}
+	ttl := e.config.StickyTTL(domainName)
 	return !e.timeSource.Now().After(e.executionInfo.LastUpdatedTimestamp.Add(ttl))
 }
 
@@ -909,7 +913,8 @@ func (e *mutableStateBuilder) shouldBufferEvent(
 		types.EventTypeMarkerRecorded,
 		types.EventTypeStartChildWorkflowExecutionInitiated,
 		types.EventTypeSignalExternalWorkflowExecutionInitiated,
-		types.EventTypeUpsertWorkflowSearchAttributes:
+		types.EventTypeUpsertWorkflowSearchAttributes,
+		types.EventTypeUpsertWorkflowMemo:
 		// do not buffer event if event is directly generated from a corresponding decision
 
 		// sanity check there is no decision on the fly
@@ -1098,6 +1103,7 @@ func (e *mutableStateBuilder) HasBufferedEvents() bool {
 func (e *mutableStateBuilder) ClearStickyness() {
 	e.executionInfo.StickyTaskList = ""
 	e.executionInfo.StickyScheduleToStartTimeout = 0
+	e.executionInfo.StickyWorkerIdentity = ""
 	e.executionInfo.ClientLibraryVersion = ""
 	e.executionInfo.ClientFeatureVersion = ""
 	e.executionInfo.ClientImpl = ""
@@ -1152,6 +1158,74 @@ func (e *mutableStateBuilder) ReplicateUpsertWorkflowSearchAttributesEvent(
 	return e.taskGenerator.GenerateWorkflowSearchAttrTasks()
 }
 
+func (e *mutableStateBuilder) AddUpsertWorkflowMemoEvent(
+	decisionCompletedEventID int64,
+	request *types.UpsertWorkflowMemoDecisionAttributes,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionUpsertWorkflowMemo
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddUpsertWorkflowMemoEvent(decisionCompletedEventID, request)
+	if err := e.ReplicateUpsertWorkflowMemoEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateUpsertWorkflowMemoEvent(
+	event *types.HistoryEvent,
+) error {
+
+	upsertMemo := event.UpsertWorkflowMemoEventAttributes.GetMemo().GetFields()
+	return e.upsertMemo(upsertMemo)
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionMemoUpdatedEvent(
+	memo *types.Memo,
+	identity string,
+	requestID string,
+) (*types.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowMemoUpdated
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionMemoUpdatedEvent(memo, identity, requestID)
+	if err := e.ReplicateWorkflowExecutionMemoUpdatedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionMemoUpdatedEvent(
+	event *types.HistoryEvent,
+) error {
+
+	upsertMemo := event.WorkflowExecutionMemoUpdatedEventAttributes.GetMemo().GetFields()
+	return e.upsertMemo(upsertMemo)
+}
+
+// upsertMemo merges the given fields into the workflow memo, a field with an
+// empty value removes the key, and refreshes visibility with the result
+func (e *mutableStateBuilder) upsertMemo(
+	upsertMemo map[string][]byte,
+) error {
+
+	memo := mergeMapOfByteArray(e.executionInfo.Memo, upsertMemo)
+	for key, value := range memo {
+		if len(value) == 0 {
+			delete(memo, key)
+		}
+	}
+	e.executionInfo.Memo = memo
+
+	return e.taskGenerator.GenerateWorkflowSearchAttrTasks()
+}
+
 func (e *mutableStateBuilder) AddRecordMarkerEvent(
 	decisionCompletedEventID int64,
 	attributes *types.RecordMarkerDecisionAttributes,
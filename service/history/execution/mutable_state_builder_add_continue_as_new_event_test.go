@@ -100,7 +100,9 @@ func TestAddContinueAsNewEvent(t *testing.T) {
 					Resettable:               true,
 				}},
 			},
-			SearchAttributes: map[string][]uint8{"BinaryChecksums": {91, 34, 54, 100, 102, 48, 51, 98, 102, 53, 49, 49, 48, 100, 54, 56, 49, 54, 54, 55, 56, 53, 50, 97, 56, 52, 53, 54, 53, 49, 57, 53, 51, 54, 34, 93}}}
+			SearchAttributes:    map[string][]uint8{"BinaryChecksums": {91, 34, 54, 100, 102, 48, 51, 98, 102, 53, 49, 49, 48, 100, 54, 56, 49, 54, 54, 55, 56, 53, 50, 97, 56, 52, 53, 54, 53, 49, 57, 53, 51, 54, 34, 93}},
+			CompletionCallbacks: []*types.CompletionCallback{{URL: "https://hooks.example.com/done"}},
+		}
 
 	}
 
@@ -183,6 +185,7 @@ func TestAddContinueAsNewEvent(t *testing.T) {
 				Resettable:               true,
 			}},
 		},
+		CompletionCallbacks: []*types.CompletionCallback{{URL: "https://hooks.example.com/done"}},
 	}
 
 	expectedEndingReturnHistoryState := []*types.HistoryEvent{
@@ -126,6 +126,8 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 	); err != nil {
 		return nil, err
 	}
+	// completion callbacks are not part of history, so the new run inherits them from the previous one
+	e.executionInfo.CompletionCallbacks = previousExecutionInfo.CompletionCallbacks
 
 	if err := e.SetHistoryTree(e.GetExecutionInfo().RunID); err != nil {
 		return nil, err
@@ -174,6 +176,7 @@ func (e *mutableStateBuilder) AddWorkflowExecutionStartedEvent(
 		false); err != nil {
 		return nil, err
 	}
+	e.executionInfo.CompletionCallbacks = request.GetCompletionCallbacks()
 
 	return event, nil
 }
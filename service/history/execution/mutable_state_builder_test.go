@@ -244,6 +244,7 @@ func (s *mutableStateSuite) TestShouldBufferEvent() {
 		types.EventTypeStartChildWorkflowExecutionInitiated:            true,
 		types.EventTypeSignalExternalWorkflowExecutionInitiated:        true,
 		types.EventTypeUpsertWorkflowSearchAttributes:                  true,
+		types.EventTypeUpsertWorkflowMemo:                              true,
 	}
 
 	// other events will not be assign event ID immediately
@@ -3441,6 +3442,108 @@ func TestAddUpsertWorkflowSearchAttributesEvent(t *testing.T) {
 	}
 }
 
+func TestAddUpsertWorkflowMemoEvent(t *testing.T) {
+
+	now := time.Unix(1730353941, 0)
+
+	tests := map[string]struct {
+		decisionCompletedEventID int64
+		request                  *types.UpsertWorkflowMemoDecisionAttributes
+		mutableStateBuilderSetup func(m *mutableStateBuilder)
+		expectedEvent            *types.HistoryEvent
+		expectedMemo             map[string][]byte
+		expectedErr              error
+	}{
+		"successful upsert": {
+			decisionCompletedEventID: 123,
+			request: &types.UpsertWorkflowMemoDecisionAttributes{
+				Memo: &types.Memo{
+					Fields: map[string][]byte{
+						"updated": []byte("new"),
+						"removed": {},
+					},
+				},
+			},
+			mutableStateBuilderSetup: func(m *mutableStateBuilder) {
+				m.executionInfo.Memo = map[string][]byte{
+					"kept":    []byte("kept"),
+					"updated": []byte("old"),
+					"removed": []byte("removed"),
+				}
+			},
+			expectedEvent: &types.HistoryEvent{
+				ID:        1,
+				EventType: types.EventTypeUpsertWorkflowMemo.Ptr(),
+				UpsertWorkflowMemoEventAttributes: &types.UpsertWorkflowMemoEventAttributes{
+					DecisionTaskCompletedEventID: 123,
+					Memo: &types.Memo{
+						Fields: map[string][]byte{
+							"updated": []byte("new"),
+							"removed": {},
+						},
+					},
+				},
+				TaskID:    commonconstants.EmptyEventTaskID,
+				Version:   commonconstants.EmptyVersion,
+				Timestamp: common.Ptr(now.UnixNano()),
+			},
+			expectedMemo: map[string][]byte{
+				"kept":    []byte("kept"),
+				"updated": []byte("new"),
+			},
+			expectedErr: nil,
+		},
+		"mutability check fails": {
+			decisionCompletedEventID: 123,
+			request: &types.UpsertWorkflowMemoDecisionAttributes{
+				Memo: &types.Memo{
+					Fields: map[string][]byte{
+						"updated": []byte("new"),
+					},
+				},
+			},
+			mutableStateBuilderSetup: func(m *mutableStateBuilder) {
+				m.executionInfo.State = persistence.WorkflowStateCompleted
+			},
+			expectedEvent: nil,
+			expectedErr:   &types.InternalServiceError{Message: "invalid mutable state action: mutation after finish"},
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+
+			shardContext := shard.NewMockContext(ctrl)
+			shardContext.EXPECT().GetLogger().Return(testlogger.New(t)).AnyTimes()
+			mockCache := events.NewMockCache(ctrl)
+			mockDomainCache := cache.NewMockDomainCache(ctrl)
+
+			nowClock := clock.NewMockedTimeSourceAt(now)
+
+			msb := createMSBWithMocks(mockCache, shardContext, mockDomainCache)
+
+			msb.hBuilder = &HistoryBuilder{
+				history:   []*types.HistoryEvent{},
+				msBuilder: msb,
+			}
+
+			td.mutableStateBuilderSetup(msb)
+
+			msb.timeSource = nowClock
+
+			event, err := msb.AddUpsertWorkflowMemoEvent(td.decisionCompletedEventID, td.request)
+
+			assert.Equal(t, td.expectedEvent, event)
+			assert.Equal(t, td.expectedErr, err)
+			if td.expectedErr == nil {
+				assert.Equal(t, td.expectedMemo, msb.executionInfo.Memo)
+			}
+		})
+	}
+}
+
 func TestCloseTransactionAsMutation(t *testing.T) {
 
 	now := time.Unix(500, 0)
@@ -273,7 +273,10 @@ func (m *mutableStateDecisionTaskManagerImpl) ReplicateDecisionTaskFailedEvent(e
 			RequestType: persistence.WorkflowRequestTypeReset,
 		})
 	}
-	m.FailDecision(true)
+	clearStickyness := event == nil ||
+		event.DecisionTaskFailedEventAttributes.GetCause() == types.DecisionTaskFailedCauseResetStickyTasklist ||
+		!m.hasPreferredHostAffinity()
+	m.failDecision(true, clearStickyness)
 	return nil
 }
 
@@ -298,7 +301,10 @@ func (m *mutableStateDecisionTaskManagerImpl) ReplicateDecisionTaskTimedOutEvent
 			RequestType: persistence.WorkflowRequestTypeReset,
 		})
 	}
-	m.FailDecision(incrementAttempt)
+	// a sticky scheduleToStart timeout always falls back to the normal task list,
+	// the sticky worker is either gone or too busy to pick up the decision
+	clearStickyness := timeoutType == types.TimeoutTypeScheduleToStart || !m.hasPreferredHostAffinity()
+	m.failDecision(incrementAttempt, clearStickyness)
 	return nil
 }
 
@@ -315,6 +321,10 @@ func (m *mutableStateDecisionTaskManagerImpl) AddDecisionTaskScheduleToStartTime
 		return nil, m.msb.createInternalServerError(opTag)
 	}
 
+	if m.msb.executionInfo.StickyTaskList != "" {
+		m.msb.executionInfo.StickyFallbackCount++
+	}
+
 	var event *types.HistoryEvent
 	// stickyness will be cleared in ReplicateDecisionTaskTimedOutEvent
 	// Avoid creating new history events when decisions are continuously timing out
@@ -409,6 +419,9 @@ func (m *mutableStateDecisionTaskManagerImpl) AddDecisionTaskScheduledEventAsHea
 		// In other cases, clearing stickyness shouldn't hurt anything.
 		// TODO: https://github.com/uber/cadence/issues/2357:
 		//  if we can use a new field(LastDecisionUpdateTimestamp), then we could get rid of it.
+		if m.msb.executionInfo.StickyTaskList != "" {
+			m.msb.executionInfo.StickyFallbackCount++
+		}
 		m.msb.ClearStickyness()
 	}
 	startToCloseTimeoutSeconds := m.msb.executionInfo.DecisionStartToCloseTimeout
@@ -516,6 +529,9 @@ func (m *mutableStateDecisionTaskManagerImpl) AddDecisionTaskStartedEvent(
 	tasklist := request.TaskList.GetName()
 	startTime := m.msb.timeSource.Now().UnixNano()
 	useNonTransientDecision := m.shouldUpdateLastWriteVersion()
+	if m.msb.executionInfo.StickyTaskList != "" && tasklist == m.msb.executionInfo.StickyTaskList {
+		m.msb.executionInfo.StickyHitCount++
+	}
 
 	// First check to see if new events came since transient decision was scheduled
 	if decision.Attempt > 0 && (decision.ScheduleID != m.msb.GetNextEventID() || useNonTransientDecision) {
@@ -675,7 +691,16 @@ func (m *mutableStateDecisionTaskManagerImpl) FailDecision(
 	incrementAttempt bool,
 ) {
 	// Clear stickiness whenever decision fails
-	m.msb.ClearStickyness()
+	m.failDecision(incrementAttempt, true)
+}
+
+func (m *mutableStateDecisionTaskManagerImpl) failDecision(
+	incrementAttempt bool,
+	clearStickyness bool,
+) {
+	if clearStickyness {
+		m.msb.ClearStickyness()
+	}
 
 	failDecisionInfo := &DecisionInfo{
 		Version:                    constants.EmptyVersion,
@@ -858,6 +883,16 @@ func (m *mutableStateDecisionTaskManagerImpl) getDecisionInfo() *DecisionInfo {
 	}
 }
 
+// hasPreferredHostAffinity returns true if the workflow should stay on its sticky worker
+// after a failed or timed out decision
+func (m *mutableStateDecisionTaskManagerImpl) hasPreferredHostAffinity() bool {
+	if m.msb.executionInfo.StickyTaskList == "" || m.msb.config == nil || m.msb.GetDomainEntry() == nil {
+		return false
+	}
+	domainName := m.msb.GetDomainEntry().GetInfo().Name
+	return m.msb.config.StickyPreferredHostAffinity(domainName, m.msb.executionInfo.WorkflowTypeName)
+}
+
 func (m *mutableStateDecisionTaskManagerImpl) beforeAddDecisionTaskCompletedEvent() {
 	// Make sure to delete decision before adding events.  Otherwise they are buffered rather than getting appended
 	m.DeleteDecision()
@@ -444,3 +444,122 @@ func TestReplicateDecisionTaskStartedEvent(t *testing.T) {
 		require.Nil(t, result)
 	})
 }
+
+func TestFailDecisionWithPreferredHostAffinity(t *testing.T) {
+	timedOutEvent := func(timeoutType types.TimeoutType) *types.HistoryEvent {
+		return &types.HistoryEvent{
+			DecisionTaskTimedOutEventAttributes: &types.DecisionTaskTimedOutEventAttributes{
+				TimeoutType: timeoutType.Ptr(),
+			},
+		}
+	}
+	failedEvent := func(cause types.DecisionTaskFailedCause) *types.HistoryEvent {
+		return &types.HistoryEvent{
+			DecisionTaskFailedEventAttributes: &types.DecisionTaskFailedEventAttributes{
+				Cause: cause.Ptr(),
+			},
+		}
+	}
+	tests := []struct {
+		name         string
+		affinity     bool
+		replicate    func(m *mutableStateDecisionTaskManagerImpl) error
+		expectSticky bool
+	}{
+		{
+			name:     "no affinity - start to close timeout clears stickyness",
+			affinity: false,
+			replicate: func(m *mutableStateDecisionTaskManagerImpl) error {
+				return m.ReplicateDecisionTaskTimedOutEvent(timedOutEvent(types.TimeoutTypeStartToClose))
+			},
+			expectSticky: false,
+		},
+		{
+			name:     "affinity - start to close timeout keeps stickyness",
+			affinity: true,
+			replicate: func(m *mutableStateDecisionTaskManagerImpl) error {
+				return m.ReplicateDecisionTaskTimedOutEvent(timedOutEvent(types.TimeoutTypeStartToClose))
+			},
+			expectSticky: true,
+		},
+		{
+			name:     "affinity - schedule to start timeout falls back",
+			affinity: true,
+			replicate: func(m *mutableStateDecisionTaskManagerImpl) error {
+				return m.ReplicateDecisionTaskTimedOutEvent(timedOutEvent(types.TimeoutTypeScheduleToStart))
+			},
+			expectSticky: false,
+		},
+		{
+			name:     "affinity - decision failure keeps stickyness",
+			affinity: true,
+			replicate: func(m *mutableStateDecisionTaskManagerImpl) error {
+				return m.ReplicateDecisionTaskFailedEvent(failedEvent(types.DecisionTaskFailedCauseUnhandledDecision))
+			},
+			expectSticky: true,
+		},
+		{
+			name:     "affinity - reset sticky tasklist failure clears stickyness",
+			affinity: true,
+			replicate: func(m *mutableStateDecisionTaskManagerImpl) error {
+				return m.ReplicateDecisionTaskFailedEvent(failedEvent(types.DecisionTaskFailedCauseResetStickyTasklist))
+			},
+			expectSticky: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockShard := shard.NewTestContext(
+				t,
+				gomock.NewController(t),
+				&persistence.ShardInfo{
+					ShardID:          0,
+					RangeID:          1,
+					TransferAckLevel: 0,
+				},
+				config.NewForTest(),
+			)
+			mockShard.GetConfig().StickyPreferredHostAffinity = func(domain string, workflowType string) bool { return tc.affinity }
+
+			m := &mutableStateDecisionTaskManagerImpl{
+				msb: newMutableStateBuilder(mockShard, mockShard.GetLogger(), constants.TestLocalDomainEntry),
+			}
+			m.msb.executionInfo.TaskList = "normal-tasklist"
+			m.msb.executionInfo.StickyTaskList = "sticky-tasklist"
+			m.msb.executionInfo.StickyScheduleToStartTimeout = 5
+			m.msb.executionInfo.StickyWorkerIdentity = "worker-1"
+
+			require.NoError(t, tc.replicate(m))
+			if tc.expectSticky {
+				assert.Equal(t, "sticky-tasklist", m.msb.executionInfo.StickyTaskList)
+				assert.Equal(t, "worker-1", m.msb.executionInfo.StickyWorkerIdentity)
+			} else {
+				assert.Empty(t, m.msb.executionInfo.StickyTaskList)
+				assert.Empty(t, m.msb.executionInfo.StickyWorkerIdentity)
+			}
+		})
+	}
+}
+
+func TestIsStickyTaskListEnabledByPolicy(t *testing.T) {
+	mockShard := shard.NewTestContext(
+		t,
+		gomock.NewController(t),
+		&persistence.ShardInfo{
+			ShardID:          0,
+			RangeID:          1,
+			TransferAckLevel: 0,
+		},
+		config.NewForTest(),
+	)
+	stickyEnabled := true
+	mockShard.GetConfig().EnableStickyExecution = func(domain string, workflowType string) bool { return stickyEnabled }
+
+	msb := newMutableStateBuilder(mockShard, mockShard.GetLogger(), constants.TestLocalDomainEntry)
+	msb.executionInfo.StickyTaskList = "sticky-tasklist"
+	msb.executionInfo.LastUpdatedTimestamp = msb.timeSource.Now()
+	assert.True(t, msb.IsStickyTaskListEnabled())
+
+	stickyEnabled = false
+	assert.False(t, msb.IsStickyTaskListEnabled())
+}
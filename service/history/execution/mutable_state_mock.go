@@ -701,6 +701,21 @@ func (mr *MockMutableStateMockRecorder) AddTransferTasks(transferTasks ...any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTransferTasks", reflect.TypeOf((*MockMutableState)(nil).AddTransferTasks), transferTasks...)
 }
 
+// AddUpsertWorkflowMemoEvent mocks base method.
+func (m *MockMutableState) AddUpsertWorkflowMemoEvent(arg0 int64, arg1 *types.UpsertWorkflowMemoDecisionAttributes) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUpsertWorkflowMemoEvent", arg0, arg1)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddUpsertWorkflowMemoEvent indicates an expected call of AddUpsertWorkflowMemoEvent.
+func (mr *MockMutableStateMockRecorder) AddUpsertWorkflowMemoEvent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUpsertWorkflowMemoEvent", reflect.TypeOf((*MockMutableState)(nil).AddUpsertWorkflowMemoEvent), arg0, arg1)
+}
+
 // AddUpsertWorkflowSearchAttributesEvent mocks base method.
 func (m *MockMutableState) AddUpsertWorkflowSearchAttributesEvent(arg0 int64, arg1 *types.UpsertWorkflowSearchAttributesDecisionAttributes) (*types.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -746,6 +761,21 @@ func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionCanceledEvent(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionCanceledEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionCanceledEvent), arg0, arg1)
 }
 
+// AddWorkflowExecutionMemoUpdatedEvent mocks base method.
+func (m *MockMutableState) AddWorkflowExecutionMemoUpdatedEvent(memo *types.Memo, identity, requestID string) (*types.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionMemoUpdatedEvent", memo, identity, requestID)
+	ret0, _ := ret[0].(*types.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionMemoUpdatedEvent indicates an expected call of AddWorkflowExecutionMemoUpdatedEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionMemoUpdatedEvent(memo, identity, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionMemoUpdatedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionMemoUpdatedEvent), memo, identity, requestID)
+}
+
 // AddWorkflowExecutionSignaled mocks base method.
 func (m *MockMutableState) AddWorkflowExecutionSignaled(signalName string, input []byte, identity, reqeustID string) (*types.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -2225,6 +2255,20 @@ func (mr *MockMutableStateMockRecorder) ReplicateTransientDecisionTaskScheduled(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateTransientDecisionTaskScheduled", reflect.TypeOf((*MockMutableState)(nil).ReplicateTransientDecisionTaskScheduled))
 }
 
+// ReplicateUpsertWorkflowMemoEvent mocks base method.
+func (m *MockMutableState) ReplicateUpsertWorkflowMemoEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateUpsertWorkflowMemoEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateUpsertWorkflowMemoEvent indicates an expected call of ReplicateUpsertWorkflowMemoEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateUpsertWorkflowMemoEvent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateUpsertWorkflowMemoEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateUpsertWorkflowMemoEvent), arg0)
+}
+
 // ReplicateUpsertWorkflowSearchAttributesEvent mocks base method.
 func (m *MockMutableState) ReplicateUpsertWorkflowSearchAttributesEvent(arg0 *types.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -2309,6 +2353,20 @@ func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionFailedEvent(ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionFailedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionFailedEvent), arg0, arg1)
 }
 
+// ReplicateWorkflowExecutionMemoUpdatedEvent mocks base method.
+func (m *MockMutableState) ReplicateWorkflowExecutionMemoUpdatedEvent(arg0 *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecutionMemoUpdatedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowExecutionMemoUpdatedEvent indicates an expected call of ReplicateWorkflowExecutionMemoUpdatedEvent.
+func (mr *MockMutableStateMockRecorder) ReplicateWorkflowExecutionMemoUpdatedEvent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionMemoUpdatedEvent", reflect.TypeOf((*MockMutableState)(nil).ReplicateWorkflowExecutionMemoUpdatedEvent), arg0)
+}
+
 // ReplicateWorkflowExecutionSignaled mocks base method.
 func (m *MockMutableState) ReplicateWorkflowExecutionSignaled(arg0 *types.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -35,6 +35,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
 )
 
 type (
@@ -89,6 +90,7 @@ type (
 		logger          log.Logger
 		clusterMetadata cluster.Metadata
 		domainCache     cache.DomainCache
+		config          *config.Config
 
 		mutableState MutableState
 	}
@@ -108,6 +110,7 @@ func NewMutableStateTaskGenerator(
 	logger log.Logger,
 	clusterMetadata cluster.Metadata,
 	domainCache cache.DomainCache,
+	config *config.Config,
 	mutableState MutableState,
 ) MutableStateTaskGenerator {
 
@@ -115,6 +118,7 @@ func NewMutableStateTaskGenerator(
 		logger:          logger,
 		clusterMetadata: clusterMetadata,
 		domainCache:     domainCache,
+		config:          config,
 		mutableState:    mutableState,
 	}
 }
@@ -157,28 +161,47 @@ func (r *mutableStateTaskGeneratorImpl) GenerateWorkflowCloseTasks(
 ) error {
 
 	executionInfo := r.mutableState.GetExecutionInfo()
-	r.mutableState.AddTransferTasks(&persistence.CloseExecutionTask{
-		WorkflowIdentifier: persistence.WorkflowIdentifier{
-			DomainID:   executionInfo.DomainID,
-			WorkflowID: executionInfo.WorkflowID,
-			RunID:      executionInfo.RunID,
-		},
-		TaskData: persistence.TaskData{
-			// TaskID and VisibilityTimestamp are set by shard context
-			Version: closeEvent.Version,
+	transferTasks := []persistence.Task{
+		&persistence.CloseExecutionTask{
+			WorkflowIdentifier: persistence.WorkflowIdentifier{
+				DomainID:   executionInfo.DomainID,
+				WorkflowID: executionInfo.WorkflowID,
+				RunID:      executionInfo.RunID,
+			},
+			TaskData: persistence.TaskData{
+				// TaskID and VisibilityTimestamp are set by shard context
+				Version: closeEvent.Version,
+			},
 		},
-	})
+	}
 
 	retentionInDays := defaultWorkflowRetentionInDays
 	domainEntry, err := r.domainCache.GetDomainByID(executionInfo.DomainID)
 	switch err.(type) {
 	case nil:
 		retentionInDays = domainEntry.GetRetentionDays(executionInfo.WorkflowID)
+		// callbacks of a continued-as-new run are carried over to the new run
+		if len(executionInfo.CompletionCallbacks) > 0 &&
+			closeEvent.GetEventType() != types.EventTypeWorkflowExecutionContinuedAsNew &&
+			r.config.EnableCompletionCallbacks(domainEntry.GetInfo().Name) {
+			transferTasks = append(transferTasks, &persistence.CompletionCallbackTask{
+				WorkflowIdentifier: persistence.WorkflowIdentifier{
+					DomainID:   executionInfo.DomainID,
+					WorkflowID: executionInfo.WorkflowID,
+					RunID:      executionInfo.RunID,
+				},
+				TaskData: persistence.TaskData{
+					// TaskID and VisibilityTimestamp are set by shard context
+					Version: closeEvent.Version,
+				},
+			})
+		}
 	case *types.EntityNotExistsError:
 		// domain is not accessible, use default value above
 	default:
 		return err
 	}
+	r.mutableState.AddTransferTasks(transferTasks...)
 
 	closeTimestamp := time.Unix(0, closeEvent.GetTimestamp())
 	retentionDuration := (time.Duration(retentionInDays) * time.Hour * 24)
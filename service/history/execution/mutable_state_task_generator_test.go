@@ -35,9 +35,11 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/constants"
 )
 
@@ -72,6 +74,7 @@ func (s *mutableStateTaskGeneratorSuite) SetupTest() {
 		log.NewNoop(),
 		constants.TestClusterMetadata,
 		s.mockDomainCache,
+		config.NewForTest(),
 		s.mockMutableState,
 	).(*mutableStateTaskGeneratorImpl)
 }
@@ -100,6 +103,7 @@ func (s *mutableStateTaskGeneratorSuite) TestGenerateWorkflowCloseTasks_Jittered
 			log.NewNoop(),
 			constants.TestClusterMetadata,
 			s.mockDomainCache,
+			config.NewForTest(),
 			mockMutableState,
 		)
 
@@ -156,6 +160,7 @@ func (s *mutableStateTaskGeneratorSuite) TestGenerateWorkflowCloseTasks() {
 			log.NewNoop(),
 			constants.TestClusterMetadata,
 			s.mockDomainCache,
+			config.NewForTest(),
 			mockMutableState,
 		)
 
@@ -249,6 +254,87 @@ func (s *mutableStateTaskGeneratorSuite) TestGenerateWorkflowCloseTasks_NotActiv
 	s.NoError(err)
 }
 
+func (s *mutableStateTaskGeneratorSuite) TestGenerateWorkflowCloseTasks_CompletionCallbacks() {
+	now := time.Now()
+	callbacks := []*types.CompletionCallback{{URL: "https://hooks.example.com/done"}}
+	workflowIdentifier := persistence.WorkflowIdentifier{
+		DomainID:   constants.TestDomainID,
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	testCases := map[string]struct {
+		enabled      bool
+		callbacks    []*types.CompletionCallback
+		eventType    types.EventType
+		wantCallback bool
+	}{
+		"enabled": {
+			enabled:      true,
+			callbacks:    callbacks,
+			eventType:    types.EventTypeWorkflowExecutionCompleted,
+			wantCallback: true,
+		},
+		"no callbacks": {
+			enabled:   true,
+			eventType: types.EventTypeWorkflowExecutionCompleted,
+		},
+		"disabled": {
+			enabled:   false,
+			callbacks: callbacks,
+			eventType: types.EventTypeWorkflowExecutionFailed,
+		},
+		"continued as new": {
+			enabled:   true,
+			callbacks: callbacks,
+			eventType: types.EventTypeWorkflowExecutionContinuedAsNew,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			cfg := config.NewForTest()
+			cfg.EnableCompletionCallbacks = dynamicproperties.GetBoolPropertyFnFilteredByDomain(tc.enabled)
+			executionInfo := &persistence.WorkflowExecutionInfo{
+				DomainID:            constants.TestDomainID,
+				WorkflowID:          constants.TestWorkflowID,
+				RunID:               constants.TestRunID,
+				CompletionCallbacks: tc.callbacks,
+			}
+			mockMutableState := NewMockMutableState(s.controller)
+			taskGenerator := NewMutableStateTaskGenerator(
+				log.NewNoop(),
+				constants.TestClusterMetadata,
+				s.mockDomainCache,
+				cfg,
+				mockMutableState,
+			)
+			closeEvent := &types.HistoryEvent{
+				EventType: tc.eventType.Ptr(),
+				Timestamp: common.Int64Ptr(now.UnixNano()),
+				Version:   constants.TestVersion,
+			}
+
+			expectedTasks := []persistence.Task{
+				&persistence.CloseExecutionTask{
+					WorkflowIdentifier: workflowIdentifier,
+					TaskData:           persistence.TaskData{Version: constants.TestVersion},
+				},
+			}
+			if tc.wantCallback {
+				expectedTasks = append(expectedTasks, &persistence.CompletionCallbackTask{
+					WorkflowIdentifier: workflowIdentifier,
+					TaskData:           persistence.TaskData{Version: constants.TestVersion},
+				})
+			}
+			mockMutableState.EXPECT().GetExecutionInfo().Return(executionInfo).AnyTimes()
+			mockMutableState.EXPECT().AddTransferTasks(expectedTasks).Times(1)
+			mockMutableState.EXPECT().AddTimerTasks(gomock.Any()).Times(1)
+
+			s.NoError(taskGenerator.GenerateWorkflowCloseTasks(closeEvent, 1))
+		})
+	}
+}
+
 func (s *mutableStateTaskGeneratorSuite) TestGenerateFromTransferTask() {
 	now := time.Now()
 	testCases := []struct {
@@ -53,7 +53,7 @@ type (
 		shardID         int
 		logger          log.Logger
 
-		newMutableStateTaskGeneratorFn                 func(log.Logger, cluster.Metadata, cache.DomainCache, MutableState) MutableStateTaskGenerator
+		newMutableStateTaskGeneratorFn                 func(log.Logger, cluster.Metadata, cache.DomainCache, *config.Config, MutableState) MutableStateTaskGenerator
 		refreshTasksForWorkflowStartFn                 func(context.Context, time.Time, MutableState, MutableStateTaskGenerator) error
 		refreshTasksForWorkflowCloseFn                 func(context.Context, MutableState, MutableStateTaskGenerator, int) error
 		refreshTasksForRecordWorkflowStartedFn         func(context.Context, MutableState, MutableStateTaskGenerator) error
@@ -107,6 +107,7 @@ func (r *mutableStateTaskRefresherImpl) RefreshTasks(
 		r.logger,
 		r.clusterMetadata,
 		r.domainCache,
+		r.config,
 		mutableState,
 	)
 
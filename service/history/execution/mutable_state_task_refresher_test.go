@@ -665,7 +665,7 @@ func TestRefreshTasks(t *testing.T) {
 					WorkflowDeletionJitterRange: dynamicproperties.GetIntPropertyFilteredByDomain(1),
 					IsAdvancedVisConfigExist:    true,
 				},
-				newMutableStateTaskGeneratorFn: func(log.Logger, cluster.Metadata, cache.DomainCache, MutableState) MutableStateTaskGenerator {
+				newMutableStateTaskGeneratorFn: func(log.Logger, cluster.Metadata, cache.DomainCache, *config.Config, MutableState) MutableStateTaskGenerator {
 					return mtg
 				},
 				refreshTasksForWorkflowStartFn:                 tc.refreshTasksForWorkflowStartFn,
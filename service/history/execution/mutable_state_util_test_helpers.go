@@ -104,6 +104,9 @@ func CopyWorkflowExecutionInfo(t *testing.T, sourceInfo *persistence.WorkflowExe
 		TaskListKind:                       sourceInfo.TaskListKind,
 		StickyTaskList:                     sourceInfo.StickyTaskList,
 		StickyScheduleToStartTimeout:       sourceInfo.StickyScheduleToStartTimeout,
+		StickyWorkerIdentity:               sourceInfo.StickyWorkerIdentity,
+		StickyHitCount:                     sourceInfo.StickyHitCount,
+		StickyFallbackCount:                sourceInfo.StickyFallbackCount,
 		WorkflowTypeName:                   sourceInfo.WorkflowTypeName,
 		WorkflowTimeout:                    sourceInfo.WorkflowTimeout,
 		DecisionStartToCloseTimeout:        sourceInfo.DecisionStartToCloseTimeout,
@@ -137,6 +140,8 @@ func CopyWorkflowExecutionInfo(t *testing.T, sourceInfo *persistence.WorkflowExe
 		Memo:                               sourceInfo.Memo,
 		SearchAttributes:                   sourceInfo.SearchAttributes,
 		PartitionConfig:                    sourceInfo.PartitionConfig,
+		CompletionCallbacks:                sourceInfo.CompletionCallbacks,
+		CompletionCallbackStates:           sourceInfo.CompletionCallbackStates,
 		Attempt:                            sourceInfo.Attempt,
 		HasRetryPolicy:                     sourceInfo.HasRetryPolicy,
 		InitialInterval:                    sourceInfo.InitialInterval,
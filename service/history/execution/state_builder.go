@@ -436,6 +436,20 @@ func (b *stateBuilderImpl) ApplyEvents(
 				return nil, err
 			}
 
+		case types.EventTypeUpsertWorkflowMemo:
+			if err := b.mutableState.ReplicateUpsertWorkflowMemoEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+		case types.EventTypeWorkflowExecutionMemoUpdated:
+			if err := b.mutableState.ReplicateWorkflowExecutionMemoUpdatedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
 		case types.EventTypeWorkflowExecutionCompleted:
 			if err := b.mutableState.ReplicateWorkflowExecutionCompletedEvent(
 				firstEvent.ID,
@@ -563,6 +563,60 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeUpsertWorkflowSearchAttribu
 	s.Nil(err)
 }
 
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeUpsertWorkflowMemo() {
+	version := int64(1)
+	requestID := uuid.New()
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "some random workflow ID",
+		RunID:      constants.TestRunID,
+	}
+
+	now := time.Now()
+	evenType := types.EventTypeUpsertWorkflowMemo
+	event := &types.HistoryEvent{
+		Version:                           version,
+		ID:                                130,
+		Timestamp:                         common.Int64Ptr(now.UnixNano()),
+		EventType:                         &evenType,
+		UpsertWorkflowMemoEventAttributes: &types.UpsertWorkflowMemoEventAttributes{},
+	}
+	s.mockMutableState.EXPECT().ReplicateUpsertWorkflowMemoEvent(event).Return(nil).Times(1)
+	s.mockUpdateVersion(event)
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{}).AnyTimes()
+	s.mockMutableState.EXPECT().ClearStickyness().Times(1)
+
+	_, err := s.stateBuilder.ApplyEvents(constants.TestDomainID, requestID, workflowExecution, s.toHistory(event), nil)
+	s.Nil(err)
+}
+
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionMemoUpdated() {
+	version := int64(1)
+	requestID := uuid.New()
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "some random workflow ID",
+		RunID:      constants.TestRunID,
+	}
+
+	now := time.Now()
+	evenType := types.EventTypeWorkflowExecutionMemoUpdated
+	event := &types.HistoryEvent{
+		Version:   version,
+		ID:        130,
+		Timestamp: common.Int64Ptr(now.UnixNano()),
+		EventType: &evenType,
+		WorkflowExecutionMemoUpdatedEventAttributes: &types.WorkflowExecutionMemoUpdatedEventAttributes{},
+	}
+	s.mockMutableState.EXPECT().ReplicateWorkflowExecutionMemoUpdatedEvent(event).Return(nil).Times(1)
+	s.mockUpdateVersion(event)
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{}).AnyTimes()
+	s.mockMutableState.EXPECT().ClearStickyness().Times(1)
+
+	_, err := s.stateBuilder.ApplyEvents(constants.TestDomainID, requestID, workflowExecution, s.toHistory(event), nil)
+	s.Nil(err)
+}
+
 func (s *stateBuilderSuite) TestApplyEvents_EventTypeMarkerRecorded() {
 	version := int64(1)
 	requestID := uuid.New()
@@ -1649,7 +1703,7 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeExternalWorkflowExecutionSi
 
 func (s *stateBuilderSuite) TestApplyEventsNewEventsNotHandled() {
 	eventTypes := types.EventTypeValues()
-	s.Equal(42, len(eventTypes), "If you see this error, you are adding new event type. "+
+	s.Equal(44, len(eventTypes), "If you see this error, you are adding new event type. "+
 		"Before updating the number to make this test pass, please make sure you update stateBuilderImpl.ApplyEvents method "+
 		"to handle the new decision type. Otherwise cross dc will not work on the new event.")
 }
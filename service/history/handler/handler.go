@@ -1217,6 +1217,48 @@ func (h *handlerImpl) TerminateWorkflowExecution(
 	return nil
 }
 
+// UpdateWorkflowMemo updates the memo of an existing workflow execution by recording
+// WorkflowExecutionMemoUpdated event in the history.
+func (h *handlerImpl) UpdateWorkflowMemo(
+	ctx context.Context,
+	wrappedRequest *types.HistoryUpdateWorkflowMemoRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryUpdateWorkflowMemoScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return constants.ErrShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	workflowExecution := wrappedRequest.UpdateRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	err2 := engine.UpdateWorkflowMemo(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return nil
+}
+
 // ResetWorkflowExecution reset an existing workflow execution
 // in the history and immediately terminating the execution instance.
 func (h *handlerImpl) ResetWorkflowExecution(
@@ -1392,6 +1434,100 @@ func (h *handlerImpl) RecordChildExecutionCompleted(
 	return nil
 }
 
+// GetCompletionCallbacks returns the completion callbacks of a closed workflow execution
+// together with the details needed to build their notification.
+// This is called by the completion callback system workflow to deliver the callbacks.
+func (h *handlerImpl) GetCompletionCallbacks(
+	ctx context.Context,
+	request *types.GetCompletionCallbacksRequest,
+) (resp *types.GetCompletionCallbacksResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryGetCompletionCallbacksScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	if request.WorkflowExecution == nil {
+		return nil, h.error(constants.ErrWorkflowExecutionNotSet, scope, domainID, "", "")
+	}
+
+	workflowExecution := request.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	resp, err2 := engine.GetCompletionCallbacks(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return resp, nil
+}
+
+// RecordCompletionCallbackState records the delivery progress of a completion callback
+// in the mutable state of a closed workflow execution.
+// This is called by the completion callback system workflow on every state transition.
+func (h *handlerImpl) RecordCompletionCallbackState(
+	ctx context.Context,
+	request *types.RecordCompletionCallbackStateRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), h.GetLogger(), &retError) }()
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryRecordCompletionCallbackStateScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return constants.ErrShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return h.error(constants.ErrDomainNotSet, scope, domainID, "", "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(constants.ErrHistoryHostThrottle, scope, domainID, "", "")
+	}
+
+	if request.WorkflowExecution == nil {
+		return h.error(constants.ErrWorkflowExecutionNotSet, scope, domainID, "", "")
+	}
+
+	workflowExecution := request.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowID()
+	runID := workflowExecution.GetRunID()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID, runID)
+	}
+
+	err2 := engine.RecordCompletionCallbackState(ctx, request)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID, runID)
+	}
+
+	return nil
+}
+
 // ResetStickyTaskList reset the volatile information in mutable state of a given types.
 // Volatile information are the information related to client, such as:
 // 1. StickyTaskList
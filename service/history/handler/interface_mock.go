@@ -132,6 +132,21 @@ func (mr *MockHandlerMockRecorder) DescribeWorkflowExecution(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).DescribeWorkflowExecution), arg0, arg1)
 }
 
+// GetCompletionCallbacks mocks base method.
+func (m *MockHandler) GetCompletionCallbacks(arg0 context.Context, arg1 *types.GetCompletionCallbacksRequest) (*types.GetCompletionCallbacksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompletionCallbacks", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetCompletionCallbacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompletionCallbacks indicates an expected call of GetCompletionCallbacks.
+func (mr *MockHandlerMockRecorder) GetCompletionCallbacks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompletionCallbacks", reflect.TypeOf((*MockHandler)(nil).GetCompletionCallbacks), arg0, arg1)
+}
+
 // GetCrossClusterTasks mocks base method.
 func (m *MockHandler) GetCrossClusterTasks(arg0 context.Context, arg1 *types.GetCrossClusterTasksRequest) (*types.GetCrossClusterTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -397,6 +412,20 @@ func (mr *MockHandlerMockRecorder) RecordChildExecutionCompleted(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordChildExecutionCompleted", reflect.TypeOf((*MockHandler)(nil).RecordChildExecutionCompleted), arg0, arg1)
 }
 
+// RecordCompletionCallbackState mocks base method.
+func (m *MockHandler) RecordCompletionCallbackState(arg0 context.Context, arg1 *types.RecordCompletionCallbackStateRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordCompletionCallbackState", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordCompletionCallbackState indicates an expected call of RecordCompletionCallbackState.
+func (mr *MockHandlerMockRecorder) RecordCompletionCallbackState(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordCompletionCallbackState", reflect.TypeOf((*MockHandler)(nil).RecordCompletionCallbackState), arg0, arg1)
+}
+
 // RecordDecisionTaskStarted mocks base method.
 func (m *MockHandler) RecordDecisionTaskStarted(arg0 context.Context, arg1 *types.RecordDecisionTaskStartedRequest) (*types.RecordDecisionTaskStartedResponse, error) {
 	m.ctrl.T.Helper()
@@ -735,3 +764,17 @@ func (mr *MockHandlerMockRecorder) TerminateWorkflowExecution(arg0, arg1 any) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).TerminateWorkflowExecution), arg0, arg1)
 }
+
+// UpdateWorkflowMemo mocks base method.
+func (m *MockHandler) UpdateWorkflowMemo(arg0 context.Context, arg1 *types.HistoryUpdateWorkflowMemoRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowMemo", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkflowMemo indicates an expected call of UpdateWorkflowMemo.
+func (mr *MockHandlerMockRecorder) UpdateWorkflowMemo(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowMemo", reflect.TypeOf((*MockHandler)(nil).UpdateWorkflowMemo), arg0, arg1)
+}
@@ -151,6 +151,9 @@ func (r *workflowResetterImpl) ResetWorkflow(
 	}
 	defer resetWorkflow.GetReleaseFn()(retError)
 
+	// completion callbacks are not recorded in history, so they are carried over from the run being replaced
+	resetWorkflow.GetMutableState().GetExecutionInfo().CompletionCallbacks = currentMutableState.GetExecutionInfo().CompletionCallbacks
+
 	return r.persistToDB(
 		ctx,
 		currentWorkflowTerminated,
@@ -33,6 +33,7 @@ import (
 	"github.com/uber/cadence/common/quotas/permember"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/service/history/cdc"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/events"
 	"github.com/uber/cadence/service/worker/archiver"
@@ -44,6 +45,7 @@ type Resource interface {
 	GetEventCache() events.Cache
 	GetRatelimiterAlgorithm() algorithm.RequestWeighted
 	GetArchiverClient() archiver.Client
+	GetCDCPublisher() cdc.Publisher
 }
 
 type resourceImpl struct {
@@ -53,6 +55,7 @@ type resourceImpl struct {
 	eventCache         events.Cache
 	ratelimitAlgorithm algorithm.RequestWeighted
 	archiverClient     archiver.Client
+	cdcPublisher       cdc.Publisher
 }
 
 // Start starts all resources
@@ -97,6 +100,11 @@ func (h *resourceImpl) GetArchiverClient() archiver.Client {
 	return h.archiverClient
 }
 
+// GetCDCPublisher return the publisher of the workflow CDC stream
+func (h *resourceImpl) GetCDCPublisher() cdc.Publisher {
+	return h.cdcPublisher
+}
+
 // New create a new resource containing common history dependencies
 func New(
 	params *resource.Params,
@@ -186,6 +194,32 @@ func New(
 		eventCache:         eventCache,
 		ratelimitAlgorithm: ratelimitAlgorithm,
 		archiverClient:     archivalClient,
+		cdcPublisher:       newCDCPublisher(params, serviceResource, config),
 	}
 	return
 }
+
+// newCDCPublisher creates the publisher of the workflow CDC stream. The stream is optional:
+// without a messaging client or a topic configured for cdc.AppName, messages are dropped.
+func newCDCPublisher(
+	params *resource.Params,
+	serviceResource resource.Resource,
+	config *config.Config,
+) cdc.Publisher {
+	if params.MessagingClient == nil {
+		return cdc.NewNoopPublisher()
+	}
+	producer, err := params.MessagingClient.NewProducer(cdc.AppName)
+	if err != nil {
+		params.Logger.Info("Workflow CDC producer is not configured, CDC messages will be dropped.", tag.Error(err))
+		return cdc.NewNoopPublisher()
+	}
+	return cdc.NewPublisher(cdc.Params{
+		Producer:      producer,
+		Enabled:       config.EnableWorkflowCDC,
+		EventTypes:    config.WorkflowCDCEventTypes,
+		TimeSource:    serviceResource.GetTimeSource(),
+		MetricsClient: params.MetricsClient,
+		Logger:        params.Logger,
+	})
+}
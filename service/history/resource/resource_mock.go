@@ -40,6 +40,7 @@ import (
 	client0 "github.com/uber/cadence/common/persistence/client"
 	algorithm "github.com/uber/cadence/common/quotas/global/algorithm"
 	rpc "github.com/uber/cadence/common/quotas/global/rpc"
+	cdc "github.com/uber/cadence/service/history/cdc"
 	events "github.com/uber/cadence/service/history/events"
 	archiver0 "github.com/uber/cadence/service/worker/archiver"
 )
@@ -152,6 +153,20 @@ func (mr *MockResourceMockRecorder) GetBlobstoreClient() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlobstoreClient", reflect.TypeOf((*MockResource)(nil).GetBlobstoreClient))
 }
 
+// GetCDCPublisher mocks base method.
+func (m *MockResource) GetCDCPublisher() cdc.Publisher {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCDCPublisher")
+	ret0, _ := ret[0].(cdc.Publisher)
+	return ret0
+}
+
+// GetCDCPublisher indicates an expected call of GetCDCPublisher.
+func (mr *MockResourceMockRecorder) GetCDCPublisher() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCDCPublisher", reflect.TypeOf((*MockResource)(nil).GetCDCPublisher))
+}
+
 // GetClientBean mocks base method.
 func (m *MockResource) GetClientBean() client.Bean {
 	m.ctrl.T.Helper()
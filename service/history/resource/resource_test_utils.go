@@ -28,6 +28,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/quotas/global/algorithm"
 	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/service/history/cdc"
 	"github.com/uber/cadence/service/history/events"
 	"github.com/uber/cadence/service/worker/archiver"
 )
@@ -39,6 +40,7 @@ type (
 		EventCache           *events.MockCache
 		ratelimiterAlgorithm algorithm.RequestWeighted
 		archiverClient       archiver.Client
+		CDCPublisher         cdc.Publisher
 	}
 )
 
@@ -54,6 +56,7 @@ func NewTest(
 		Test:           resource.NewTest(t, controller, serviceMetricsIndex),
 		EventCache:     events.NewMockCache(controller),
 		archiverClient: &archiver.ClientMock{},
+		CDCPublisher:   cdc.NewNoopPublisher(),
 	}
 }
 
@@ -69,3 +72,8 @@ func (s *Test) GetRatelimiterAlgorithm() algorithm.RequestWeighted {
 func (s *Test) GetArchiverClient() archiver.Client {
 	return s.archiverClient
 }
+
+// GetCDCPublisher for testing
+func (s *Test) GetCDCPublisher() cdc.Publisher {
+	return s.CDCPublisher
+}
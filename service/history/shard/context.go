@@ -127,6 +127,12 @@ type (
 		throttledLogger      log.Logger
 		engine               engine.Engine
 
+		// handoffStartedAt is set once the shard starts being handed off to its new owner,
+		// new writes are rejected from then on and inflightWrites tracks the ones already started
+		handoffLock      sync.RWMutex
+		handoffStartedAt *time.Time
+		inflightWrites   sync.WaitGroup
+
 		sync.RWMutex
 		lastUpdated                  time.Time
 		shardInfo                    *persistence.ShardInfo
@@ -169,6 +175,7 @@ const (
 	logWarnTimerLevelDiff       = time.Duration(30 * time.Minute)
 	historySizeLogThreshold     = 10 * 1024 * 1024
 	minContextTimeout           = 1 * time.Second
+	shardHandoffPollInterval    = 100 * time.Millisecond
 	activeClusterLookupTimeout  = 1 * time.Second
 )
 
@@ -625,6 +632,10 @@ func (s *contextImpl) CreateWorkflowExecution(
 	if err := s.closedError(); err != nil {
 		return nil, err
 	}
+	if err := s.startWrite(); err != nil {
+		return nil, err
+	}
+	defer s.inflightWrites.Done()
 
 	ctx, cancel, err := s.ensureMinContextTimeout(ctx)
 	if err != nil {
@@ -720,6 +731,10 @@ func (s *contextImpl) UpdateWorkflowExecution(
 	if err := s.closedError(); err != nil {
 		return nil, err
 	}
+	if err := s.startWrite(); err != nil {
+		return nil, err
+	}
+	defer s.inflightWrites.Done()
 	ctx, cancel, err := s.ensureMinContextTimeout(ctx)
 	if err != nil {
 		return nil, err
@@ -819,6 +834,10 @@ func (s *contextImpl) ConflictResolveWorkflowExecution(
 	if err := s.closedError(); err != nil {
 		return nil, err
 	}
+	if err := s.startWrite(); err != nil {
+		return nil, err
+	}
+	defer s.inflightWrites.Done()
 
 	ctx, cancel, err := s.ensureMinContextTimeout(ctx)
 	if err != nil {
@@ -947,6 +966,10 @@ func (s *contextImpl) AppendHistoryV2Events(
 	if err := s.closedError(); err != nil {
 		return nil, err
 	}
+	if err := s.startWrite(); err != nil {
+		return nil, err
+	}
+	defer s.inflightWrites.Done()
 
 	domainName, err := s.GetDomainCache().GetDomainName(domainID)
 	if err != nil {
@@ -1038,6 +1061,52 @@ func (s *contextImpl) closeShard() {
 	atomic.StoreInt64(&s.rangeID, s.shardInfo.RangeID)
 }
 
+// startWrite registers an in-flight write, it fails once the shard is being handed off.
+// Callers must call inflightWrites.Done when the write completes.
+func (s *contextImpl) startWrite() error {
+	s.handoffLock.RLock()
+	defer s.handoffLock.RUnlock()
+
+	if s.handoffStartedAt != nil {
+		return &ErrShardClosed{
+			Msg:      "shard is being handed off",
+			ClosedAt: *s.handoffStartedAt,
+		}
+	}
+	s.inflightWrites.Add(1)
+	return nil
+}
+
+// stopAcceptingWrites rejects any new writes to the shard and waits for the in-flight ones to complete.
+// It returns false if they did not complete within the timeout.
+func (s *contextImpl) stopAcceptingWrites(timeout time.Duration) bool {
+	s.handoffLock.Lock()
+	if s.handoffStartedAt == nil {
+		s.handoffStartedAt = common.TimePtr(s.GetTimeSource().Now())
+	}
+	s.handoffLock.Unlock()
+
+	return common.AwaitWaitGroup(&s.inflightWrites, timeout)
+}
+
+// release persists the latest shard info, including the queue ack levels, with the owner cleared and closes the shard.
+// The cleared owner tells the next owner that the shard was handed off and can be acquired right away.
+func (s *contextImpl) release() error {
+	s.Lock()
+	defer s.Unlock()
+
+	s.shardInfo.Owner = ""
+	err := s.forceUpdateShardInfoLocked()
+
+	// fails any writes that may start after this point, unlike closeShard the
+	// shard item is removed by the controller handing off the shard
+	if s.closedAt.CompareAndSwap(nil, common.TimePtr(time.Now())) {
+		s.shardInfo.RangeID = -1
+		atomic.StoreInt64(&s.rangeID, s.shardInfo.RangeID)
+	}
+	return err
+}
+
 func (s *contextImpl) generateTaskIDLocked() (int64, error) {
 	if err := s.updateRangeIfNeededLocked(); err != nil {
 		return -1, err
@@ -1385,6 +1454,10 @@ func (s *contextImpl) ReplicateFailoverMarkers(
 	if err := s.closedError(); err != nil {
 		return err
 	}
+	if err := s.startWrite(); err != nil {
+		return err
+	}
+	defer s.inflightWrites.Done()
 
 	tasks := make([]persistence.Task, 0, len(markers))
 	for _, marker := range markers {
@@ -1514,7 +1587,7 @@ func (s *contextImpl) ValidateAndUpdateFailoverMarkers() ([]*types.FailoverMarke
 func acquireShard(
 	shardItem *historyShardsItem,
 	closeCallback func(int, *historyShardsItem),
-) (Context, error) {
+) (*contextImpl, error) {
 
 	var shardInfo *persistence.ShardInfo
 
@@ -1561,6 +1634,14 @@ func acquireShard(
 		return nil, err
 	}
 
+	if shardItem.config.EnableGracefulShardHandoff() {
+		shardInfo, err = waitForShardHandoff(shardItem, shardInfo)
+		if err != nil {
+			shardItem.logger.Error("Fail to acquire shard.", tag.Error(err))
+			return nil, err
+		}
+	}
+
 	updatedShardInfo := shardInfo.ToNilSafeCopy()
 	ownershipChanged := shardInfo.Owner != shardItem.GetHostInfo().Identity()
 	updatedShardInfo.Owner = shardItem.GetHostInfo().Identity()
@@ -1627,6 +1708,62 @@ func acquireShard(
 	return context, nil
 }
 
+// waitForShardHandoff gives the previous owner of the shard the chance to hand it off gracefully.
+// It polls the shard until the previous owner releases it or the wait times out, in which case the
+// shard is stolen as usual. Ring membership is not a signal here: a host evicts itself from the ring
+// before it hands off its shards on shutdown, so only the owner recorded in the shard tells whether
+// the handoff is still pending. The wait is abandoned if the shard item is stopped in the meantime.
+func waitForShardHandoff(
+	shardItem *historyShardsItem,
+	shardInfo *persistence.ShardInfo,
+) (*persistence.ShardInfo, error) {
+
+	previousOwner := shardInfo.Owner
+	if previousOwner == "" || previousOwner == shardItem.GetHostInfo().Identity() {
+		return shardInfo, nil
+	}
+
+	scope := shardItem.GetMetricsClient().Scope(metrics.HistoryShardControllerScope)
+	sw := scope.StartTimer(metrics.ShardHandoffWaitLatency)
+	defer sw.Stop()
+
+	shardItem.logger.Info("Waiting for the previous owner to hand off the shard", tag.Dynamic("shard-owner", previousOwner))
+	timeSource := shardItem.GetTimeSource()
+	ctx, cancel := timeSource.ContextWithTimeout(context.Background(), shardItem.config.ShardHandoffWaitTimeout())
+	defer cancel()
+	timer := timeSource.NewTimer(shardHandoffPollInterval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-shardItem.stopC:
+			return nil, &ErrShardClosed{
+				Msg:      "shard was stopped while waiting for the previous owner to hand it off",
+				ClosedAt: timeSource.Now(),
+			}
+		case <-ctx.Done():
+			scope.IncCounter(metrics.ShardHandoffWaitTimeoutCounter)
+			shardItem.logger.Warn("Timed out waiting for the previous owner to hand off the shard", tag.Dynamic("shard-owner", previousOwner))
+			return shardInfo, nil
+		case <-timer.Chan():
+		}
+
+		resp, err := shardItem.GetShardManager().GetShard(ctx, &persistence.GetShardRequest{
+			ShardID: shardItem.shardID,
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				// the wait timed out during the read, the shard is stolen on the next iteration
+				continue
+			}
+			return nil, err
+		}
+		if resp.ShardInfo.Owner != previousOwner {
+			return resp.ShardInfo, nil
+		}
+		timer.Reset(shardHandoffPollInterval)
+	}
+}
+
 func (s *contextImpl) getEventsFromWorkflowSnapshot(snapshot *persistence.WorkflowSnapshot) []simulation.E {
 	if snapshot == nil {
 		return nil
@@ -40,6 +40,7 @@ import (
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/metrics"
@@ -1207,3 +1208,168 @@ func TestShardClosedGuard(t *testing.T) {
 		})
 	}
 }
+
+func TestShardHandoffGuard(t *testing.T) {
+	handoffStartedAt := time.Unix(123, 456)
+	shardContext := &contextImpl{
+		shardInfo:        &persistence.ShardInfo{},
+		handoffStartedAt: &handoffStartedAt,
+	}
+
+	testCases := []struct {
+		name string
+		call func() error
+	}{
+		{
+			name: "CreateWorkflowExecution",
+			call: func() error {
+				_, err := shardContext.CreateWorkflowExecution(context.Background(), nil)
+				return err
+			},
+		},
+		{
+			name: "UpdateWorkflowExecution",
+			call: func() error {
+				_, err := shardContext.UpdateWorkflowExecution(context.Background(), nil)
+				return err
+			},
+		},
+		{
+			name: "ConflictResolveWorkflowExecution",
+			call: func() error {
+				_, err := shardContext.ConflictResolveWorkflowExecution(context.Background(), nil)
+				return err
+			},
+		},
+		{
+			name: "AppendHistoryV2Events",
+			call: func() error {
+				_, err := shardContext.AppendHistoryV2Events(context.Background(), nil, "", types.WorkflowExecution{})
+				return err
+			},
+		},
+		{
+			name: "ReplicateFailoverMarkers",
+			call: func() error {
+				return shardContext.ReplicateFailoverMarkers(context.Background(), nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.call()
+			var shardClosedErr *ErrShardClosed
+			assert.ErrorAs(t, err, &shardClosedErr)
+			assert.Equal(t, handoffStartedAt, shardClosedErr.ClosedAt)
+			assert.ErrorContains(t, err, "shard is being handed off")
+		})
+	}
+}
+
+func (s *contextTestSuite) TestStopAcceptingWrites() {
+	s.NoError(s.context.startWrite())
+
+	// the in-flight write blocks the handoff until it completes
+	s.False(s.context.stopAcceptingWrites(10 * time.Millisecond))
+	s.Error(s.context.startWrite())
+
+	s.context.inflightWrites.Done()
+	s.True(s.context.stopAcceptingWrites(time.Second))
+}
+
+func (s *contextTestSuite) TestRelease() {
+	s.context.shardInfo.Owner = "current-owner"
+	s.context.shardInfo.TransferAckLevel = 5
+	s.mockShardManager.On("UpdateShard", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateShardRequest) bool {
+		return request.ShardInfo.Owner == "" &&
+			request.ShardInfo.TransferAckLevel == 5 &&
+			request.PreviousRangeID == testRangeID
+	})).Return(nil).Once()
+
+	s.NoError(s.context.release())
+
+	var shardClosedErr *ErrShardClosed
+	s.ErrorAs(s.context.closedError(), &shardClosedErr)
+	s.EqualValues(-1, s.context.getRangeID())
+}
+
+func (s *contextTestSuite) TestWaitForShardHandoff() {
+	const previousOwner = "previous-owner"
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		stopped       bool
+		expectedOwner string
+		expectedErr   bool
+	}{
+		{
+			name: "previous owner releases the shard",
+			setupMocks: func() {
+				s.mockShardManager.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: testShardID}).
+					Return(&persistence.GetShardResponse{ShardInfo: &persistence.ShardInfo{ShardID: testShardID, Owner: previousOwner}}, nil).Once()
+				s.mockShardManager.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: testShardID}).
+					Return(&persistence.GetShardResponse{ShardInfo: &persistence.ShardInfo{ShardID: testShardID}}, nil).Once()
+			},
+			expectedOwner: "",
+		},
+		{
+			name: "previous owner does not release the shard",
+			setupMocks: func() {
+				s.mockShardManager.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: testShardID}).
+					Return(&persistence.GetShardResponse{ShardInfo: &persistence.ShardInfo{ShardID: testShardID, Owner: previousOwner}}, nil)
+			},
+			expectedOwner: previousOwner,
+		},
+		{
+			name: "reading the shard does not outlive the wait",
+			setupMocks: func() {
+				s.mockShardManager.On("GetShard", mock.Anything, &persistence.GetShardRequest{ShardID: testShardID}).
+					Run(func(args mock.Arguments) {
+						<-args.Get(0).(context.Context).Done()
+					}).
+					Return(nil, context.DeadlineExceeded)
+			},
+			expectedOwner: previousOwner,
+		},
+		{
+			name:        "shard item is stopped",
+			setupMocks:  func() {},
+			stopped:     true,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Need setup the suite manually, since we are in a subtest
+			s.SetupTest()
+
+			config := config.NewForTest()
+			config.ShardHandoffWaitTimeout = dynamicproperties.GetDurationPropertyFn(300 * time.Millisecond)
+			shardItem := &historyShardsItem{
+				Resource: s.mockResource,
+				shardID:  testShardID,
+				config:   config,
+				logger:   s.logger,
+				stopC:    make(chan struct{}),
+			}
+			if tc.stopped {
+				shardItem.signalStop()
+			}
+			tc.setupMocks()
+
+			start := time.Now()
+			shardInfo, err := waitForShardHandoff(shardItem, &persistence.ShardInfo{ShardID: testShardID, Owner: previousOwner})
+			s.Less(time.Since(start), time.Second)
+			if tc.expectedErr {
+				var shardClosedErr *ErrShardClosed
+				s.ErrorAs(err, &shardClosedErr)
+				return
+			}
+			s.NoError(err)
+			s.Equal(tc.expectedOwner, shardInfo.Owner)
+		})
+	}
+}
@@ -111,8 +111,13 @@ type (
 		engineFactory   EngineFactory
 
 		sync.RWMutex
-		status historyShardsItemStatus
-		engine engine.Engine
+		status       historyShardsItemStatus
+		engine       engine.Engine
+		shardContext *contextImpl
+
+		// stopC is closed before the item is stopped, it interrupts an acquisition waiting for a handoff
+		stopC    chan struct{}
+		stopOnce sync.Once
 	}
 )
 
@@ -156,6 +161,7 @@ func newHistoryShardsItem(
 		shardID:         shardID,
 		status:          historyShardsItemStatusInitialized,
 		engineFactory:   factory,
+		stopC:           make(chan struct{}),
 		config:          config,
 		logger:          resource.GetLogger().WithTags(tag.ShardID(shardID), tag.Address(hostAddress)),
 		throttledLogger: resource.GetThrottledLogger().WithTags(tag.ShardID(shardID), tag.Address(hostAddress)),
@@ -448,6 +454,9 @@ func (c *controller) acquireShards() {
 							c.metricsScope.IncCounter(metrics.GetEngineForShardErrorCounter)
 							c.logger.Error("Unable to create history shard engine", tag.Error(err1), tag.OperationFailed, tag.ShardID(shardID))
 						}
+					} else if c.config.EnableGracefulShardHandoff() {
+						// release the shard now instead of waiting for the new owner to steal it
+						c.handOffShard(shardID, info)
 					}
 				}
 			}
@@ -463,13 +472,55 @@ func (c *controller) doShutdown() {
 	c.logger.Info("Shard controller state changed", tag.LifeCycleStopping, tag.Reason("shutdown"))
 	c.Lock()
 	defer c.Unlock()
-	for _, item := range c.historyShards {
-		item.stopEngine()
+	if c.config.EnableGracefulShardHandoff() {
+		var wg sync.WaitGroup
+		for shardID, item := range c.historyShards {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.handOffShardItem(shardID, item)
+			}()
+		}
+		wg.Wait()
+	} else {
+		for _, item := range c.historyShards {
+			item.stopEngine()
+		}
 	}
 	c.historyShards = nil
 	c.updateShardIDSnapshotLocked()
 }
 
+// handOffShard gracefully hands off a shard which now belongs to another host, if the shard is owned by this host.
+// The shard item is removed first, so new requests for the shard are redirected to its new owner.
+func (c *controller) handOffShard(shardID int, newOwner membership.HostInfo) {
+	c.RLock()
+	_, ok := c.historyShards[shardID]
+	c.RUnlock()
+	if !ok {
+		return
+	}
+
+	shardItem, err := c.removeHistoryShardItem(shardID, nil)
+	if err != nil {
+		// the shard was closed in the meantime
+		return
+	}
+	c.logger.Info("Handing off shard", tag.ShardID(shardID), tag.Dynamic("shard-owner", newOwner.Identity()))
+	c.handOffShardItem(shardID, shardItem)
+}
+
+func (c *controller) handOffShardItem(shardID int, shardItem *historyShardsItem) {
+	c.metricsScope.IncCounter(metrics.ShardHandoffCounter)
+	sw := c.metricsScope.StartTimer(metrics.ShardHandoffLatency)
+	defer sw.Stop()
+
+	if err := shardItem.handOffEngine(); err != nil {
+		c.metricsScope.IncCounter(metrics.ShardHandoffFailedCounter)
+		c.logger.Warn("Failed to hand off shard, the new owner will steal it", tag.Error(err), tag.ShardID(shardID))
+	}
+}
+
 func (c *controller) isShuttingDown() bool {
 	return atomic.LoadInt32(&c.shuttingDown) != 0
 }
@@ -501,6 +552,7 @@ func (i *historyShardsItem) getOrCreateEngine(
 			i.GetMetricsClient().RecordTimer(metrics.ShardInfoScope, metrics.ShardItemAcquisitionLatency,
 				context.GetCurrentTime(i.GetClusterMetadata().GetCurrentClusterName()).Sub(context.GetLastUpdatedTime()))
 		}
+		i.shardContext = context
 		i.engine = i.engineFactory.CreateEngine(context)
 		i.engine.Start()
 		i.logger.Info("Shard engine state changed", tag.LifeCycleStarted, tag.ComponentShardEngine)
@@ -516,6 +568,7 @@ func (i *historyShardsItem) getOrCreateEngine(
 }
 
 func (i *historyShardsItem) stopEngine() {
+	i.signalStop()
 	i.Lock()
 	defer i.Unlock()
 
@@ -528,6 +581,7 @@ func (i *historyShardsItem) stopEngine() {
 		i.logger.Info("Shard engine state changed", tag.LifeCycleStopping, tag.ComponentShardEngine)
 		i.engine.Stop()
 		i.engine = nil
+		i.shardContext = nil
 		i.logger.Info("Shard engine state changed", tag.LifeCycleStopped, tag.ComponentShardEngine)
 		i.status = historyShardsItemStatusStopped
 	case historyShardsItemStatusStopped:
@@ -537,6 +591,48 @@ func (i *historyShardsItem) stopEngine() {
 	}
 }
 
+// handOffEngine stops the engine after the in-flight writes to the shard complete, then releases
+// the shard with the queue ack levels flushed, so its new owner can acquire it without stealing it
+func (i *historyShardsItem) handOffEngine() error {
+	i.signalStop()
+	i.Lock()
+	defer i.Unlock()
+
+	i.logger.Info("Shard item handOffEngine called", tag.ComponentShardEngine, tag.Dynamic("status", i.status))
+
+	switch i.status {
+	case historyShardsItemStatusInitialized:
+		i.status = historyShardsItemStatusStopped
+		return nil
+	case historyShardsItemStatusStarted:
+		i.logger.Info("Shard engine state changed", tag.LifeCycleStopping, tag.ComponentShardEngine, tag.Reason("handoff"))
+		if !i.shardContext.stopAcceptingWrites(i.config.ShardHandoffDrainTimeout()) {
+			i.logger.Warn("Timed out waiting for in-flight writes to complete before handing off the shard")
+		}
+		// stopping the engine stops the queue processors, which update their ack levels on the way out
+		i.engine.Stop()
+		err := i.shardContext.release()
+		i.engine = nil
+		i.shardContext = nil
+		i.logger.Info("Shard engine state changed", tag.LifeCycleStopped, tag.ComponentShardEngine, tag.Reason("handoff"))
+		i.status = historyShardsItemStatusStopped
+		return err
+	case historyShardsItemStatusStopped:
+		return nil
+	default:
+		panic(i.logInvalidStatus())
+	}
+}
+
+// signalStop interrupts an acquisition of the shard in progress, which holds the item lock while it waits
+func (i *historyShardsItem) signalStop() {
+	i.stopOnce.Do(func() {
+		if i.stopC != nil {
+			close(i.stopC)
+		}
+	})
+}
+
 func (i *historyShardsItem) isValid() bool {
 	i.RLock()
 	defer i.RUnlock()
@@ -579,6 +579,56 @@ func (s *controllerSuite) TestShardControllerClosed() {
 	s.Empty(s.shardController.ShardIDs())
 }
 
+func (s *controllerSuite) TestAcquireShards_HandsOffShardOwnedByAnotherHost() {
+	shardID := 0
+	s.config.NumberOfShards = 1
+	s.config.EnableGracefulShardHandoff = dynamicproperties.GetBoolPropertyFn(true)
+	s.shardController = NewShardController(s.mockResource, s.mockEngineFactory, s.config).(*controller)
+
+	s.setupMocksForAcquireShard(shardID, s.mockHistoryEngine, 5, 6)
+	s.shardController.acquireShards()
+	s.Equal(1, s.shardController.NumShards())
+
+	newOwner := membership.NewHostInfo("new-owner")
+	s.mockMembershipResolver.EXPECT().Lookup(service.History, string(rune(shardID))).Return(newOwner, nil).AnyTimes()
+	s.mockHistoryEngine.EXPECT().Stop().Times(1)
+	s.mockShardManager.On("UpdateShard", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateShardRequest) bool {
+		return request.ShardInfo.Owner == "" && request.PreviousRangeID == 6
+	})).Return(nil).Once()
+
+	s.shardController.acquireShards()
+	s.Equal(0, s.shardController.NumShards())
+
+	_, err := s.shardController.GetEngineForShard(shardID)
+	var ownershipLostErr *types.ShardOwnershipLostError
+	s.ErrorAs(err, &ownershipLostErr)
+	s.mockShardManager.AssertExpectations(s.T())
+}
+
+func (s *controllerSuite) TestShardControllerClosed_HandsOffShards() {
+	numShards := 2
+	s.config.NumberOfShards = numShards
+	s.config.EnableGracefulShardHandoff = dynamicproperties.GetBoolPropertyFn(true)
+	s.shardController = NewShardController(s.mockResource, s.mockEngineFactory, s.config).(*controller)
+	for shardID := 0; shardID < numShards; shardID++ {
+		mockEngine := engine.NewMockEngine(s.controller)
+		s.setupMocksForAcquireShard(shardID, mockEngine, 5, 6)
+		mockEngine.EXPECT().Stop().Times(1)
+	}
+	s.mockShardManager.On("UpdateShard", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateShardRequest) bool {
+		return request.ShardInfo.Owner == "" && request.PreviousRangeID == 6
+	})).Return(nil).Times(numShards)
+
+	s.mockMembershipResolver.EXPECT().Subscribe(service.History, shardControllerMembershipUpdateListenerName, gomock.Any()).Return(nil).AnyTimes()
+	s.mockMembershipResolver.EXPECT().Unsubscribe(service.History, shardControllerMembershipUpdateListenerName).Return(nil).AnyTimes()
+	s.shardController.Start()
+	s.Equal(numShards, s.shardController.NumShards())
+
+	s.shardController.Stop()
+	s.Equal(0, s.shardController.NumShards())
+	s.mockShardManager.AssertExpectations(s.T())
+}
+
 func (s *controllerSuite) TestGetOrCreateHistoryShardItem_InvalidShardID_Error() {
 	s.config.NumberOfShards = 4
 	s.shardController = NewShardController(s.mockResource, s.mockEngineFactory, s.config).(*controller)
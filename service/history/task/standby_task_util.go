@@ -104,6 +104,7 @@ type (
 		decisionScheduleToStartTimeout int32
 		tasklist                       types.TaskList
 		partitionConfig                map[string]string
+		buildID                        string
 	}
 )
 
@@ -122,12 +123,14 @@ func newPushDecisionToMatchingInfo(
 	decisionScheduleToStartTimeout int32,
 	tasklist types.TaskList,
 	partitionConfig map[string]string,
+	buildID string,
 ) *pushDecisionToMatchingInfo {
 
 	return &pushDecisionToMatchingInfo{
 		decisionScheduleToStartTimeout: decisionScheduleToStartTimeout,
 		tasklist:                       tasklist,
 		partitionConfig:                partitionConfig,
+		buildID:                        buildID,
 	}
 }
 
@@ -126,6 +126,11 @@ func GetTransferTaskMetricsScope(
 			return metrics.TransferActiveTaskApplyParentClosePolicyScope
 		}
 		return metrics.TransferStandbyTaskApplyParentClosePolicyScope
+	case persistence.TransferTaskTypeCompletionCallback:
+		if isActive {
+			return metrics.TransferActiveTaskCompletionCallbackScope
+		}
+		return metrics.TransferStandbyTaskCompletionCallbackScope
 	default:
 		if isActive {
 			return metrics.TransferActiveQueueProcessorScope
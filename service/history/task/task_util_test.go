@@ -228,6 +228,18 @@ func TestGetTransferTaskMetricsScope(t *testing.T) {
 			isActive:      false,
 			expectedScope: metrics.TransferStandbyTaskApplyParentClosePolicyScope,
 		},
+		{
+			name:          "TransferTaskTypeCompletionCallback - active",
+			taskType:      persistence.TransferTaskTypeCompletionCallback,
+			isActive:      true,
+			expectedScope: metrics.TransferActiveTaskCompletionCallbackScope,
+		},
+		{
+			name:          "TransferTaskTypeCompletionCallback - standby",
+			taskType:      persistence.TransferTaskTypeCompletionCallback,
+			isActive:      false,
+			expectedScope: metrics.TransferStandbyTaskCompletionCallbackScope,
+		},
 		{
 			name:          "TransferTaskType not caught - active",
 			taskType:      -100,
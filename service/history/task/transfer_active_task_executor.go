@@ -40,6 +40,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/cdc"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/reset"
@@ -47,6 +48,7 @@ import (
 	"github.com/uber/cadence/service/history/simulation"
 	"github.com/uber/cadence/service/history/workflowcache"
 	"github.com/uber/cadence/service/worker/archiver"
+	callbackworker "github.com/uber/cadence/service/worker/completioncallback"
 	"github.com/uber/cadence/service/worker/parentclosepolicy"
 )
 
@@ -71,10 +73,12 @@ type (
 	transferActiveTaskExecutor struct {
 		*transferTaskExecutorBase
 
-		historyClient           history.Client
-		parentClosePolicyClient parentclosepolicy.Client
-		workflowResetter        reset.WorkflowResetter
-		wfIDCache               workflowcache.WFCache
+		historyClient            history.Client
+		parentClosePolicyClient  parentclosepolicy.Client
+		completionCallbackClient callbackworker.Client
+		cdcPublisher             cdc.Publisher
+		workflowResetter         reset.WorkflowResetter
+		wfIDCache                workflowcache.WFCache
 	}
 
 	generatorF = func(taskGenerator execution.MutableStateTaskGenerator) error
@@ -106,8 +110,10 @@ func NewTransferActiveTaskExecutor(
 			shard.GetService().GetSDKClient(),
 			config.NumParentClosePolicySystemWorkflows(),
 		),
-		workflowResetter: workflowResetter,
-		wfIDCache:        wfIDCache,
+		completionCallbackClient: callbackworker.NewClient(shard.GetService().GetSDKClient()),
+		cdcPublisher:             shard.GetService().GetCDCPublisher(),
+		workflowResetter:         workflowResetter,
+		wfIDCache:                wfIDCache,
 	}
 }
 
@@ -144,6 +150,8 @@ func (t *transferActiveTaskExecutor) Execute(task Task) (ExecuteResponse, error)
 		return executeResponse, t.processRecordWorkflowClosed(ctx, transferTask)
 	case *persistence.RecordChildExecutionCompletedTask:
 		return executeResponse, t.processRecordChildExecutionCompleted(ctx, transferTask)
+	case *persistence.CompletionCallbackTask:
+		return executeResponse, t.processCompletionCallback(ctx, transferTask)
 	case *persistence.CancelExecutionTask:
 		return executeResponse, t.processCancelExecution(ctx, transferTask)
 	case *persistence.SignalExecutionTask:
@@ -283,6 +291,8 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 	// for the decision. Using MaxTaskTimeout here for now so at least no
 	// decision will be lost.
 
+	buildID := getDecisionBuildID(executionInfo)
+
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
@@ -292,7 +302,7 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 		return errWorkflowRateLimited
 	}
 
-	err = t.pushDecision(ctx, task, taskList, decisionTimeout, mutableState.GetExecutionInfo().PartitionConfig)
+	err = t.pushDecision(ctx, task, taskList, decisionTimeout, mutableState.GetExecutionInfo().PartitionConfig, buildID)
 	if _, ok := err.(*types.StickyWorkerUnavailableError); ok {
 		// sticky worker is unavailable, switch to non-sticky task list
 		taskList = &types.TaskList{
@@ -304,7 +314,7 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 		// There is no need to reset sticky, because if this task is picked by new worker, the new worker will reset
 		// the sticky queue to a new one. However, if worker is completely down, that schedule_to_start timeout task
 		// will re-create a new non-sticky task and reset sticky.
-		err = t.pushDecision(ctx, task, taskList, decisionTimeout, mutableState.GetExecutionInfo().PartitionConfig)
+		err = t.pushDecision(ctx, task, taskList, decisionTimeout, mutableState.GetExecutionInfo().PartitionConfig, buildID)
 	}
 	if err == nil {
 		scope := common.NewPerTaskListScope(domainName, taskList.Name, taskList.GetKind(), t.metricsClient, metrics.TransferActiveTaskDecisionScope)
@@ -338,6 +348,61 @@ func (t *transferActiveTaskExecutor) processRecordChildExecutionCompleted(
 	return t.processCloseExecutionTaskHelper(ctx, task, false, true, false)
 }
 
+func (t *transferActiveTaskExecutor) processCompletionCallback(
+	ctx context.Context,
+	task *persistence.CompletionCallbackTask,
+) (retError error) {
+
+	wfContext, release, err := t.executionCache.GetOrCreateWorkflowExecutionWithTimeout(
+		task.GetDomainID(),
+		getWorkflowExecution(task),
+		taskGetExecutionContextTimeout,
+	)
+	if err != nil {
+		if err == context.DeadlineExceeded {
+			return errWorkflowBusy
+		}
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := loadMutableState(ctx, wfContext, task, t.metricsClient.Scope(metrics.TransferQueueProcessorScope), t.logger, 0)
+	if err != nil {
+		return err
+	}
+	if mutableState == nil || mutableState.IsWorkflowExecutionRunning() {
+		return nil
+	}
+
+	lastWriteVersion, err := mutableState.GetLastWriteVersion()
+	if err != nil {
+		return err
+	}
+	ok, err := verifyTaskVersion(t.shard, t.logger, task.GetDomainID(), lastWriteVersion, task.GetVersion(), task)
+	if err != nil || !ok {
+		return err
+	}
+
+	if len(mutableState.GetExecutionInfo().CompletionCallbacks) == 0 {
+		return nil
+	}
+	// the callbacks, and the headers they carry, stay in mutable state:
+	// the callback workflow loads them from history when it delivers the notification
+	request := callbackworker.Request{
+		DomainID:   task.GetDomainID(),
+		WorkflowID: task.GetWorkflowID(),
+		RunID:      task.GetRunID(),
+	}
+
+	// release the context lock since we no longer need mutable state and
+	// the rest of logic is making RPC call, which takes time.
+	release(nil)
+
+	sendCtx, cancel := context.WithTimeout(ctx, taskRPCCallTimeout)
+	defer cancel()
+	return t.completionCallbackClient.SendCompletionCallbackRequest(sendCtx, request)
+}
+
 // TODO: this helper function performs three operations:
 // 1. publish workflow closed visibility record
 // 2. if has parent workflow, reply to the parent workflow
@@ -419,6 +484,12 @@ func (t *transferActiveTaskExecutor) processCloseExecutionTaskHelper(
 	headers := getWorkflowHeaders(startEvent)
 	domainName := mutableState.GetDomainEntry().GetInfo().Name
 	children := mutableState.GetPendingChildExecutionInfos()
+	var branchToken []byte
+	if recordWorkflowClosed && t.cdcPublisher.PublishesHistoryEvents(domainName) {
+		if branchToken, err = mutableState.GetCurrentBranchToken(); err != nil {
+			return err
+		}
+	}
 
 	// we've gathered all necessary information from mutable state.
 	// release the context lock since we no longer need mutable state builder and
@@ -449,6 +520,26 @@ func (t *transferActiveTaskExecutor) processCloseExecutionTaskHelper(
 		); err != nil {
 			return err
 		}
+
+		cdcMessage := &cdc.Message{
+			Type:             cdc.MessageTypeWorkflowClosed,
+			Sequence:         workflowHistoryLength,
+			DomainID:         task.GetDomainID(),
+			Domain:           domainName,
+			WorkflowID:       task.GetWorkflowID(),
+			RunID:            task.GetRunID(),
+			WorkflowType:     workflowTypeName,
+			TaskList:         executionInfo.TaskList,
+			IsCron:           isCron,
+			StartTime:        workflowStartTimestamp,
+			ExecutionTime:    workflowExecutionTimestamp.UnixNano(),
+			CloseTime:        workflowCloseTimestamp,
+			CloseStatus:      workflowCloseStatus.String(),
+			HistoryLength:    workflowHistoryLength,
+			Memo:             executionInfo.Memo,
+			SearchAttributes: searchAttr,
+		}
+		t.publishCDC(ctx, cdcMessage, branchToken)
 	}
 
 	// Communicate the result to parent execution if this is Child Workflow execution
@@ -498,6 +589,81 @@ func (t *transferActiveTaskExecutor) processCloseExecutionTaskHelper(
 	return nil
 }
 
+// publishCDC publishes msg to the CDC stream, preceded by the history events of the run selected by the
+// domain's CDC filter if branchToken is set. Publishing is best effort and bounded by taskRPCCallTimeout:
+// a failure is counted and logged but does not fail the task, so a slow or unavailable CDC topic never
+// holds back or duplicates visibility writes.
+func (t *transferActiveTaskExecutor) publishCDC(
+	ctx context.Context,
+	msg *cdc.Message,
+	branchToken []byte,
+) {
+
+	ctx, cancel := context.WithTimeout(ctx, taskRPCCallTimeout)
+	defer cancel()
+
+	logger := t.logger.WithTags(
+		tag.WorkflowDomainName(msg.Domain),
+		tag.WorkflowID(msg.WorkflowID),
+		tag.WorkflowRunID(msg.RunID),
+	)
+	if len(branchToken) != 0 {
+		if err := t.publishHistoryEvents(ctx, msg, branchToken); err != nil {
+			t.metricsClient.IncCounter(metrics.HistoryCDCPublisherScope, metrics.CDCPublishFailuresCounter)
+			logger.Warn("Failed to publish history events to the CDC stream", tag.Error(err))
+		}
+	}
+	if err := t.cdcPublisher.Publish(ctx, msg); err != nil {
+		logger.Warn("Failed to publish to the CDC stream", tag.Dynamic("cdc-message-type", msg.Type), tag.Error(err))
+	}
+}
+
+// publishHistoryEvents reads the history of a closed run page by page and publishes the events
+// selected by the domain's CDC filter. Each event is sequenced by its event ID.
+func (t *transferActiveTaskExecutor) publishHistoryEvents(
+	ctx context.Context,
+	run *cdc.Message,
+	branchToken []byte,
+) error {
+
+	request := &persistence.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  constants.FirstEventID,
+		MaxEventID:  run.HistoryLength + 1,
+		PageSize:    cdcHistoryEventsPageSize,
+		ShardID:     common.IntPtr(t.shard.GetShardID()),
+		DomainName:  run.Domain,
+	}
+	for {
+		response, err := t.shard.GetHistoryManager().ReadHistoryBranch(ctx, request)
+		if err != nil {
+			return err
+		}
+		for _, event := range response.HistoryEvents {
+			if err := t.cdcPublisher.Publish(ctx, &cdc.Message{
+				Type:         cdc.MessageTypeHistoryEvent,
+				Sequence:     event.ID,
+				DomainID:     run.DomainID,
+				Domain:       run.Domain,
+				WorkflowID:   run.WorkflowID,
+				RunID:        run.RunID,
+				WorkflowType: run.WorkflowType,
+				TaskList:     run.TaskList,
+				IsCron:       run.IsCron,
+				StartTime:    run.StartTime,
+				EventID:      event.ID,
+				HistoryEvent: event,
+			}); err != nil {
+				return err
+			}
+		}
+		if len(response.NextPageToken) == 0 {
+			return nil
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+}
+
 func (t *transferActiveTaskExecutor) processCancelExecution(
 	ctx context.Context,
 	task *persistence.CancelExecutionTask,
@@ -981,14 +1147,33 @@ func (t *transferActiveTaskExecutor) processRecordWorkflowStartedOrUpsertHelper(
 	isCron := len(executionInfo.CronSchedule) > 0
 	numClusters := (int16)(len(domainEntry.GetReplicationConfig().Clusters))
 	updateTimestamp := t.shard.GetTimeSource().Now()
+	// an upsert message carries the memo and search attributes as of the last event of the run
+	lastEventID := mutableState.GetNextEventID() - 1
 
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
 
+	cdcMessage := &cdc.Message{
+		Type:             cdc.MessageTypeSearchAttributesUpserted,
+		Sequence:         lastEventID,
+		DomainID:         task.GetDomainID(),
+		Domain:           domainEntry.GetInfo().Name,
+		WorkflowID:       task.GetWorkflowID(),
+		RunID:            task.GetRunID(),
+		WorkflowType:     wfTypeName,
+		TaskList:         executionInfo.TaskList,
+		IsCron:           isCron,
+		StartTime:        startTimestamp,
+		ExecutionTime:    executionTimestamp.UnixNano(),
+		Memo:             executionInfo.Memo,
+		SearchAttributes: searchAttr,
+	}
 	if recordStart {
+		cdcMessage.Type = cdc.MessageTypeWorkflowStarted
+		cdcMessage.Sequence = constants.FirstEventID
 		workflowStartedScope.IncCounter(metrics.WorkflowStartedCount)
-		return t.recordWorkflowStarted(
+		if err := t.recordWorkflowStarted(
 			ctx,
 			task.GetDomainID(),
 			task.GetWorkflowID(),
@@ -1005,9 +1190,10 @@ func (t *transferActiveTaskExecutor) processRecordWorkflowStartedOrUpsertHelper(
 			updateTimestamp.UnixNano(),
 			searchAttr,
 			headers,
-		)
-	}
-	return t.upsertWorkflowExecution(
+		); err != nil {
+			return err
+		}
+	} else if err := t.upsertWorkflowExecution(
 		ctx,
 		task.GetDomainID(),
 		task.GetWorkflowID(),
@@ -1024,7 +1210,11 @@ func (t *transferActiveTaskExecutor) processRecordWorkflowStartedOrUpsertHelper(
 		updateTimestamp.UnixNano(),
 		searchAttr,
 		headers,
-	)
+	); err != nil {
+		return err
+	}
+	t.publishCDC(ctx, cdcMessage, nil)
+	return nil
 }
 
 func (t *transferActiveTaskExecutor) processResetWorkflow(
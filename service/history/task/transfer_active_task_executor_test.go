@@ -46,11 +46,13 @@ import (
 	"github.com/uber/cadence/common/archiver/provider"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
+	commonconstants "github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/history/cdc"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/constants"
 	"github.com/uber/cadence/service/history/engine"
@@ -61,6 +63,7 @@ import (
 	test "github.com/uber/cadence/service/history/testing"
 	"github.com/uber/cadence/service/history/workflowcache"
 	warchiver "github.com/uber/cadence/service/worker/archiver"
+	callbackworker "github.com/uber/cadence/service/worker/completioncallback"
 	"github.com/uber/cadence/service/worker/parentclosepolicy"
 )
 
@@ -77,13 +80,14 @@ type (
 		mockHistoryClient  *hclient.MockClient
 		mockMatchingClient *matching.MockClient
 
-		mockVisibilityMgr           *mocks.VisibilityManager
-		mockExecutionMgr            *mocks.ExecutionManager
-		mockHistoryV2Mgr            *mocks.HistoryV2Manager
-		mockArchivalClient          *warchiver.ClientMock
-		mockArchivalMetadata        *archiver.MockArchivalMetadata
-		mockArchiverProvider        *provider.MockArchiverProvider
-		mockParentClosePolicyClient *parentclosepolicy.ClientMock
+		mockVisibilityMgr            *mocks.VisibilityManager
+		mockExecutionMgr             *mocks.ExecutionManager
+		mockHistoryV2Mgr             *mocks.HistoryV2Manager
+		mockArchivalClient           *warchiver.ClientMock
+		mockArchivalMetadata         *archiver.MockArchivalMetadata
+		mockArchiverProvider         *provider.MockArchiverProvider
+		mockParentClosePolicyClient  *parentclosepolicy.ClientMock
+		mockCompletionCallbackClient *callbackworker.ClientMock
 
 		logger                     log.Logger
 		domainID                   string
@@ -148,6 +152,7 @@ func (s *transferActiveTaskExecutorSuite) SetupTest() {
 	s.mockShard.SetEngine(s.mockEngine)
 
 	s.mockParentClosePolicyClient = &parentclosepolicy.ClientMock{}
+	s.mockCompletionCallbackClient = &callbackworker.ClientMock{}
 	s.mockArchivalClient = &warchiver.ClientMock{}
 	s.mockMatchingClient = s.mockShard.Resource.MatchingClient
 	s.mockHistoryClient = s.mockShard.Resource.HistoryClient
@@ -181,6 +186,7 @@ func (s *transferActiveTaskExecutorSuite) SetupTest() {
 		s.mockWFCache,
 	).(*transferActiveTaskExecutor)
 	s.transferActiveTaskExecutor.parentClosePolicyClient = s.mockParentClosePolicyClient
+	s.transferActiveTaskExecutor.completionCallbackClient = s.mockCompletionCallbackClient
 }
 
 func (s *transferActiveTaskExecutorSuite) TearDownTest() {
@@ -189,6 +195,7 @@ func (s *transferActiveTaskExecutorSuite) TearDownTest() {
 	s.mockShard.Finish(s.T())
 	s.mockArchivalClient.AssertExpectations(s.T())
 	s.mockParentClosePolicyClient.AssertExpectations(s.T())
+	s.mockCompletionCallbackClient.AssertExpectations(s.T())
 }
 
 func (s *transferActiveTaskExecutorSuite) TestProcessActivityTask_Success() {
@@ -763,6 +770,204 @@ func (s *transferActiveTaskExecutorSuite) TestProcessCloseExecution_NoParent() {
 	s.Nil(err)
 }
 
+func (s *transferActiveTaskExecutorSuite) TestProcessCloseExecution_PublishesCDC() {
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+	event := test.AddCompleteWorkflowEvent(mutableState, decisionCompletionID, nil)
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.CloseExecutionTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   s.domainID,
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      workflowExecution.GetRunID(),
+		},
+		TaskData: persistence.TaskData{
+			Version: s.version,
+			TaskID:  int64(59),
+		},
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, event.ID, event.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockVisibilityMgr.On("RecordWorkflowExecutionClosed", mock.Anything, mock.Anything).Return(nil).Once()
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dynamicproperties.GetStringPropertyFn("enabled"), true, dynamicproperties.GetBoolPropertyFn(true), "disabled", "random URI"))
+	s.mockArchivalClient.On("Archive", mock.Anything, mock.Anything).Return(nil, nil).Once()
+
+	historyEvents := []*types.HistoryEvent{
+		{ID: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()},
+		{ID: 2, EventType: types.EventTypeDecisionTaskScheduled.Ptr()},
+	}
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.MatchedBy(func(req *persistence.ReadHistoryBranchRequest) bool {
+		return req.MinEventID == 1 && req.MaxEventID == event.ID+1 && req.PageSize == cdcHistoryEventsPageSize
+	})).Return(&persistence.ReadHistoryBranchResponse{HistoryEvents: historyEvents}, nil).Once()
+
+	mockCDCPublisher := cdc.NewMockPublisher(s.controller)
+	s.transferActiveTaskExecutor.cdcPublisher = mockCDCPublisher
+	mockCDCPublisher.EXPECT().PublishesHistoryEvents(s.domainName).Return(true)
+	gomock.InOrder(
+		mockCDCPublisher.EXPECT().Publish(gomock.Any(), isCDCMessage(cdc.MessageTypeHistoryEvent)).DoAndReturn(func(_ context.Context, msg *cdc.Message) error {
+			s.Equal(int64(1), msg.EventID)
+			s.Equal(int64(1), msg.Sequence)
+			s.Equal(historyEvents[0], msg.HistoryEvent)
+			return nil
+		}),
+		mockCDCPublisher.EXPECT().Publish(gomock.Any(), isCDCMessage(cdc.MessageTypeHistoryEvent)).DoAndReturn(func(_ context.Context, msg *cdc.Message) error {
+			s.Equal(int64(2), msg.EventID)
+			s.Equal(int64(2), msg.Sequence)
+			return nil
+		}),
+		mockCDCPublisher.EXPECT().Publish(gomock.Any(), isCDCMessage(cdc.MessageTypeWorkflowClosed)).DoAndReturn(func(_ context.Context, msg *cdc.Message) error {
+			s.Equal(event.ID, msg.Sequence)
+			s.Equal(s.domainName, msg.Domain)
+			s.Equal(workflowExecution.GetRunID(), msg.RunID)
+			s.Equal(types.WorkflowExecutionCloseStatusCompleted.String(), msg.CloseStatus)
+			s.Equal(event.ID, msg.HistoryLength)
+			s.Equal(event.GetTimestamp(), msg.CloseTime)
+			return nil
+		}),
+	)
+
+	_, err = s.transferActiveTaskExecutor.Execute(transferTask)
+	s.NoError(err)
+}
+
+func (s *transferActiveTaskExecutorSuite) TestProcessCloseExecution_CDCHistoryEventsPublishFailure() {
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+	event := test.AddCompleteWorkflowEvent(mutableState, decisionCompletionID, nil)
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.CloseExecutionTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   s.domainID,
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      workflowExecution.GetRunID(),
+		},
+		TaskData: persistence.TaskData{
+			Version: s.version,
+			TaskID:  int64(59),
+		},
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, event.ID, event.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockVisibilityMgr.On("RecordWorkflowExecutionClosed", mock.Anything, mock.Anything).Return(nil).Once()
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dynamicproperties.GetStringPropertyFn("enabled"), true, dynamicproperties.GetBoolPropertyFn(true), "disabled", "random URI"))
+	s.mockArchivalClient.On("Archive", mock.Anything, mock.Anything).Return(nil, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{{ID: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()}},
+	}, nil).Once()
+
+	mockCDCPublisher := cdc.NewMockPublisher(s.controller)
+	s.transferActiveTaskExecutor.cdcPublisher = mockCDCPublisher
+	mockCDCPublisher.EXPECT().PublishesHistoryEvents(s.domainName).Return(true)
+	publishErr := errors.New("some random error")
+	mockCDCPublisher.EXPECT().Publish(gomock.Any(), isCDCMessage(cdc.MessageTypeHistoryEvent)).Return(publishErr)
+	mockCDCPublisher.EXPECT().Publish(gomock.Any(), isCDCMessage(cdc.MessageTypeWorkflowClosed)).Return(nil)
+
+	// publishing is best effort: the close message is still published and the task does not fail
+	_, err = s.transferActiveTaskExecutor.Execute(transferTask)
+	s.NoError(err)
+}
+
+func (s *transferActiveTaskExecutorSuite) TestProcessRecordWorkflowStartedTask_CDCPublishFailure() {
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.RecordWorkflowStartedTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   s.domainID,
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      workflowExecution.GetRunID(),
+		},
+		TaskData: persistence.TaskData{
+			Version: s.version,
+			TaskID:  int64(59),
+		},
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, decisionCompletionID, mutableState.GetCurrentVersion())
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockVisibilityMgr.On("RecordWorkflowExecutionUninitialized", mock.Anything, mock.Anything).Return(nil).Maybe()
+	s.mockVisibilityMgr.On("RecordWorkflowExecutionStarted", mock.Anything, mock.Anything).Return(nil).Once()
+
+	mockCDCPublisher := cdc.NewMockPublisher(s.controller)
+	s.transferActiveTaskExecutor.cdcPublisher = mockCDCPublisher
+	publishErr := errors.New("some random error")
+	mockCDCPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, msg *cdc.Message) error {
+		s.Equal(cdc.MessageTypeWorkflowStarted, msg.Type)
+		s.Equal(commonconstants.FirstEventID, msg.Sequence)
+		s.Equal(workflowExecution.GetWorkflowID(), msg.WorkflowID)
+		s.Equal(mutableState.GetExecutionInfo().WorkflowTypeName, msg.WorkflowType)
+		return publishErr
+	})
+
+	// publishing is best effort, a CDC failure does not retry the visibility write
+	_, err = s.transferActiveTaskExecutor.Execute(transferTask)
+	s.NoError(err)
+}
+
+func (s *transferActiveTaskExecutorSuite) TestProcessCompletionCallback_NoCallbacks() {
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+	event := test.AddCompleteWorkflowEvent(mutableState, decisionCompletionID, nil)
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.CompletionCallbackTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   s.domainID,
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      workflowExecution.GetRunID(),
+		},
+		TaskData: persistence.TaskData{
+			Version: s.version,
+			TaskID:  int64(59),
+		},
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, event.ID, event.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+
+	_, err = s.transferActiveTaskExecutor.Execute(transferTask)
+	s.NoError(err)
+}
+
+func (s *transferActiveTaskExecutorSuite) TestProcessCompletionCallback_Success() {
+	workflowExecution, mutableState, decisionCompletionID, err := test.SetupWorkflowWithCompletedDecision(s.T(), s.mockShard, s.domainID)
+	s.NoError(err)
+
+	mutableState.GetExecutionInfo().CompletionCallbacks = []*types.CompletionCallback{
+		{URL: "https://hooks.example.com/done", Headers: map[string]string{"Authorization": "secret"}},
+	}
+	event := test.AddCompleteWorkflowEvent(mutableState, decisionCompletionID, nil)
+
+	transferTask := s.newTransferTaskFromInfo(&persistence.CompletionCallbackTask{
+		WorkflowIdentifier: persistence.WorkflowIdentifier{
+			DomainID:   s.domainID,
+			WorkflowID: workflowExecution.GetWorkflowID(),
+			RunID:      workflowExecution.GetRunID(),
+		},
+		TaskData: persistence.TaskData{
+			Version: s.version,
+			TaskID:  int64(59),
+		},
+	})
+
+	persistenceMutableState, err := test.CreatePersistenceMutableState(s.T(), mutableState, event.ID, event.Version)
+	s.NoError(err)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockCompletionCallbackClient.On("SendCompletionCallbackRequest", mock.Anything, callbackworker.Request{
+		DomainID:   s.domainID,
+		WorkflowID: workflowExecution.GetWorkflowID(),
+		RunID:      workflowExecution.GetRunID(),
+	}).Return(nil).Once()
+
+	_, err = s.transferActiveTaskExecutor.Execute(transferTask)
+	s.NoError(err)
+}
+
 func (s *transferActiveTaskExecutorSuite) TestProcessCloseExecution_NoParent_HasFewChildren() {
 	s.testProcessCloseExecutionNoParentHasFewChildren(
 		map[string]string{
@@ -2392,3 +2597,10 @@ func createRecordWorkflowExecutionUninitializedRequest(
 		ShardID:          shardID,
 	}
 }
+
+func isCDCMessage(messageType cdc.MessageType) gomock.Matcher {
+	return gomock.Cond(func(x any) bool {
+		msg, ok := x.(*cdc.Message)
+		return ok && msg.Type == messageType
+	})
+}
@@ -113,6 +113,9 @@ func (t *transferStandbyTaskExecutor) Execute(task Task) (ExecuteResponse, error
 		// no action needed for standby
 		// check the comment in t.processCloseExecution()
 		return executeResponse, nil
+	case *persistence.CompletionCallbackTask:
+		// completion callbacks are only delivered by the active cluster
+		return executeResponse, nil
 	case *persistence.CancelExecutionTask:
 		return executeResponse, t.processCancelExecution(ctx, transferTask)
 	case *persistence.SignalExecutionTask:
@@ -214,6 +217,7 @@ func (t *transferStandbyTaskExecutor) processDecisionTask(
 				decisionTimeout,
 				types.TaskList{Name: executionInfo.TaskList}, // at standby, always use non-sticky tasklist
 				mutableState.GetExecutionInfo().PartitionConfig,
+				getDecisionBuildID(executionInfo),
 			), nil
 		}
 
@@ -652,6 +656,7 @@ func (t *transferStandbyTaskExecutor) pushDecision(
 		&pushDecisionInfo.tasklist,
 		timeout,
 		pushDecisionInfo.partitionConfig,
+		pushDecisionInfo.buildID,
 	)
 }
 
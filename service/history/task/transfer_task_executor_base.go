@@ -52,6 +52,7 @@ const (
 	taskRPCCallTimeout             = 2 * time.Second
 	secondsInDay                   = int32(24 * time.Hour / time.Second)
 	defaultDomainName              = "defaultDomainName"
+	cdcHistoryEventsPageSize       = 100
 )
 
 type (
@@ -134,6 +135,7 @@ func (t *transferTaskExecutorBase) pushDecision(
 	tasklist *types.TaskList,
 	decisionScheduleToStartTimeout int32,
 	partitionConfig map[string]string,
+	buildID string,
 ) error {
 
 	ctx, cancel := context.WithTimeout(ctx, taskRPCCallTimeout)
@@ -161,10 +163,24 @@ func (t *transferTaskExecutorBase) pushDecision(
 		ScheduleID:                    task.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(decisionScheduleToStartTimeout),
 		PartitionConfig:               partitionConfig,
+		BuildID:                       buildID,
 	})
 	return err
 }
 
+// getDecisionBuildID returns the latest binary checksum that completed a decision of the current run,
+// matching uses it to dispatch the next decision to a compatible worker build.
+// Reset points carried over by continue-as-new are ignored so that new runs start on the default build.
+func getDecisionBuildID(executionInfo *persistence.WorkflowExecutionInfo) string {
+	points := executionInfo.AutoResetPoints.GetPoints()
+	for i := len(points) - 1; i >= 0; i-- {
+		if points[i].GetRunID() == executionInfo.RunID {
+			return points[i].GetBinaryChecksum()
+		}
+	}
+	return ""
+}
+
 func (t *transferTaskExecutorBase) recordWorkflowStarted(
 	ctx context.Context,
 	domainID string,
@@ -22,6 +22,9 @@ package task
 
 import (
 	"testing"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
 )
 
 func TestShouldRedactContextHeader(t *testing.T) {
@@ -70,3 +73,50 @@ func TestShouldRedactContextHeader(t *testing.T) {
 		}
 	}
 }
+
+func TestGetDecisionBuildID(t *testing.T) {
+	cases := []struct {
+		name          string
+		executionInfo *persistence.WorkflowExecutionInfo
+		expected      string
+	}{
+		{
+			name:          "no reset points",
+			executionInfo: &persistence.WorkflowExecutionInfo{},
+			expected:      "",
+		},
+		{
+			name: "last reset point of current run",
+			executionInfo: &persistence.WorkflowExecutionInfo{
+				RunID: "run-2",
+				AutoResetPoints: &types.ResetPoints{
+					Points: []*types.ResetPointInfo{
+						{BinaryChecksum: "build-1", RunID: "run-1"},
+						{BinaryChecksum: "build-2", RunID: "run-2"},
+						{BinaryChecksum: "build-3", RunID: "run-2"},
+					},
+				},
+			},
+			expected: "build-3",
+		},
+		{
+			name: "reset points of previous run only",
+			executionInfo: &persistence.WorkflowExecutionInfo{
+				RunID: "run-2",
+				AutoResetPoints: &types.ResetPoints{
+					Points: []*types.ResetPointInfo{
+						{BinaryChecksum: "build-1", RunID: "run-1"},
+					},
+				},
+			},
+			expected: "",
+		},
+	}
+
+	for _, c := range cases {
+		result := getDecisionBuildID(c.executionInfo)
+		if result != c.expected {
+			t.Errorf("%s: getDecisionBuildID() = %s; expected %s", c.name, result, c.expected)
+		}
+	}
+}
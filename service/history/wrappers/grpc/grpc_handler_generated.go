@@ -50,6 +50,11 @@ func (g GRPCHandler) DescribeWorkflowExecution(ctx context.Context, request *his
 	return proto.FromHistoryDescribeWorkflowExecutionResponse(response), proto.FromError(err)
 }
 
+func (g GRPCHandler) GetCompletionCallbacks(ctx context.Context, request *historyv1.GetCompletionCallbacksRequest) (*historyv1.GetCompletionCallbacksResponse, error) {
+	response, err := g.h.GetCompletionCallbacks(ctx, proto.ToHistoryGetCompletionCallbacksRequest(request))
+	return proto.FromHistoryGetCompletionCallbacksResponse(response), proto.FromError(err)
+}
+
 func (g GRPCHandler) GetCrossClusterTasks(ctx context.Context, request *historyv1.GetCrossClusterTasksRequest) (*historyv1.GetCrossClusterTasksResponse, error) {
 	response, err := g.h.GetCrossClusterTasks(ctx, proto.ToHistoryGetCrossClusterTasksRequest(request))
 	return proto.FromHistoryGetCrossClusterTasksResponse(response), proto.FromError(err)
@@ -130,6 +135,11 @@ func (g GRPCHandler) RecordChildExecutionCompleted(ctx context.Context, request
 	return &historyv1.RecordChildExecutionCompletedResponse{}, proto.FromError(err)
 }
 
+func (g GRPCHandler) RecordCompletionCallbackState(ctx context.Context, request *historyv1.RecordCompletionCallbackStateRequest) (*historyv1.RecordCompletionCallbackStateResponse, error) {
+	err := g.h.RecordCompletionCallbackState(ctx, proto.ToHistoryRecordCompletionCallbackStateRequest(request))
+	return &historyv1.RecordCompletionCallbackStateResponse{}, proto.FromError(err)
+}
+
 func (g GRPCHandler) RecordDecisionTaskStarted(ctx context.Context, request *historyv1.RecordDecisionTaskStartedRequest) (*historyv1.RecordDecisionTaskStartedResponse, error) {
 	response, err := g.h.RecordDecisionTaskStarted(ctx, proto.ToHistoryRecordDecisionTaskStartedRequest(request))
 	return proto.FromHistoryRecordDecisionTaskStartedResponse(response), proto.FromError(err)
@@ -239,3 +249,8 @@ func (g GRPCHandler) TerminateWorkflowExecution(ctx context.Context, request *hi
 	err := g.h.TerminateWorkflowExecution(ctx, proto.ToHistoryTerminateWorkflowExecutionRequest(request))
 	return &historyv1.TerminateWorkflowExecutionResponse{}, proto.FromError(err)
 }
+
+func (g GRPCHandler) UpdateWorkflowMemo(ctx context.Context, request *historyv1.UpdateWorkflowMemoRequest) (*historyv1.UpdateWorkflowMemoResponse, error) {
+	err := g.h.UpdateWorkflowMemo(ctx, proto.ToHistoryUpdateWorkflowMemoRequest(request))
+	return &historyv1.UpdateWorkflowMemoResponse{}, proto.FromError(err)
+}
@@ -89,6 +89,10 @@ func (h *historyHandler) DescribeWorkflowExecution(ctx context.Context, hp1 *typ
 	return h.wrapped.DescribeWorkflowExecution(ctx, hp1)
 }
 
+func (h *historyHandler) GetCompletionCallbacks(ctx context.Context, gp1 *types.GetCompletionCallbacksRequest) (gp2 *types.GetCompletionCallbacksResponse, err error) {
+	return h.wrapped.GetCompletionCallbacks(ctx, gp1)
+}
+
 func (h *historyHandler) GetCrossClusterTasks(ctx context.Context, gp1 *types.GetCrossClusterTasksRequest) (gp2 *types.GetCrossClusterTasksResponse, err error) {
 	return h.wrapped.GetCrossClusterTasks(ctx, gp1)
 }
@@ -161,6 +165,10 @@ func (h *historyHandler) RecordChildExecutionCompleted(ctx context.Context, rp1
 	return h.wrapped.RecordChildExecutionCompleted(ctx, rp1)
 }
 
+func (h *historyHandler) RecordCompletionCallbackState(ctx context.Context, rp1 *types.RecordCompletionCallbackStateRequest) (err error) {
+	return h.wrapped.RecordCompletionCallbackState(ctx, rp1)
+}
+
 func (h *historyHandler) RecordDecisionTaskStarted(ctx context.Context, rp1 *types.RecordDecisionTaskStartedRequest) (rp2 *types.RecordDecisionTaskStartedResponse, err error) {
 	return h.wrapped.RecordDecisionTaskStarted(ctx, rp1)
 }
@@ -327,3 +335,30 @@ func (h *historyHandler) SyncShardStatus(ctx context.Context, sp1 *types.SyncSha
 func (h *historyHandler) TerminateWorkflowExecution(ctx context.Context, hp1 *types.HistoryTerminateWorkflowExecutionRequest) (err error) {
 	return h.wrapped.TerminateWorkflowExecution(ctx, hp1)
 }
+
+func (h *historyHandler) UpdateWorkflowMemo(ctx context.Context, hp1 *types.HistoryUpdateWorkflowMemoRequest) (err error) {
+
+	if hp1 == nil {
+		err = validate.ErrRequestNotSet
+		return
+	}
+
+	if hp1.GetDomainUUID() == "" {
+		err = validate.ErrDomainNotSet
+		return
+	}
+
+	if hp1.UpdateRequest.GetWorkflowExecution().GetWorkflowID() == "" {
+		err = validate.ErrWorkflowIDNotSet
+		return
+	}
+
+	if !h.allowFunc(hp1.GetDomainUUID(), hp1.UpdateRequest.GetWorkflowExecution().GetWorkflowID()) {
+		err = &types.ServiceBusyError{
+			Message: "Too many requests for the workflow ID",
+			Reason:  constants.WorkflowIDRateLimitReason,
+		}
+		return
+	}
+	return h.wrapped.UpdateWorkflowMemo(ctx, hp1)
+}
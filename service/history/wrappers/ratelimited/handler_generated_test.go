@@ -100,6 +100,22 @@ func TestRatelimitedEndpoints_Table(t *testing.T) {
 				handlerMock.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 			},
 		},
+		{
+			name: "UpdateWorkflowMemo",
+			callWrapper: func() (interface{}, error) {
+				updateRequest := &types.HistoryUpdateWorkflowMemoRequest{
+					DomainUUID: testDomainID,
+					UpdateRequest: &types.UpdateWorkflowMemoRequest{
+						WorkflowExecution: &types.WorkflowExecution{WorkflowID: testWorkflowID},
+					},
+				}
+
+				return nil, wrapper.UpdateWorkflowMemo(context.Background(), updateRequest)
+			},
+			expectCallToEndpoint: func() {
+				handlerMock.EXPECT().UpdateWorkflowMemo(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+		},
 		{
 			name: "DescribeWorkflowExecution",
 			callWrapper: func() (interface{}, error) {
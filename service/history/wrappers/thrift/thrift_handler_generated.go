@@ -38,6 +38,11 @@ func (g ThriftHandler) DescribeWorkflowExecution(ctx context.Context, DescribeRe
 	return thrift.FromHistoryDescribeWorkflowExecutionResponse(response), thrift.FromError(err)
 }
 
+func (g ThriftHandler) GetCompletionCallbacks(ctx context.Context, GetRequest *history.GetCompletionCallbacksRequest) (gp1 *history.GetCompletionCallbacksResponse, err error) {
+	response, err := g.h.GetCompletionCallbacks(ctx, thrift.ToHistoryGetCompletionCallbacksRequest(GetRequest))
+	return thrift.FromHistoryGetCompletionCallbacksResponse(response), thrift.FromError(err)
+}
+
 func (g ThriftHandler) GetCrossClusterTasks(ctx context.Context, Request *shared.GetCrossClusterTasksRequest) (gp1 *shared.GetCrossClusterTasksResponse, err error) {
 	response, err := g.h.GetCrossClusterTasks(ctx, thrift.ToHistoryGetCrossClusterTasksRequest(Request))
 	return thrift.FromHistoryGetCrossClusterTasksResponse(response), thrift.FromError(err)
@@ -118,6 +123,11 @@ func (g ThriftHandler) RecordChildExecutionCompleted(ctx context.Context, Comple
 	return thrift.FromError(err)
 }
 
+func (g ThriftHandler) RecordCompletionCallbackState(ctx context.Context, RecordRequest *history.RecordCompletionCallbackStateRequest) (err error) {
+	err = g.h.RecordCompletionCallbackState(ctx, thrift.ToHistoryRecordCompletionCallbackStateRequest(RecordRequest))
+	return thrift.FromError(err)
+}
+
 func (g ThriftHandler) RecordDecisionTaskStarted(ctx context.Context, AddRequest *history.RecordDecisionTaskStartedRequest) (rp1 *history.RecordDecisionTaskStartedResponse, err error) {
 	response, err := g.h.RecordDecisionTaskStarted(ctx, thrift.ToHistoryRecordDecisionTaskStartedRequest(AddRequest))
 	return thrift.FromHistoryRecordDecisionTaskStartedResponse(response), thrift.FromError(err)
@@ -227,3 +237,8 @@ func (g ThriftHandler) TerminateWorkflowExecution(ctx context.Context, Terminate
 	err = g.h.TerminateWorkflowExecution(ctx, thrift.ToHistoryTerminateWorkflowExecutionRequest(TerminateRequest))
 	return thrift.FromError(err)
 }
+
+func (g ThriftHandler) UpdateWorkflowMemo(ctx context.Context, UpdateRequest *history.UpdateWorkflowMemoRequest) (err error) {
+	err = g.h.UpdateWorkflowMemo(ctx, thrift.ToHistoryUpdateWorkflowMemoRequest(UpdateRequest))
+	return thrift.FromError(err)
+}
@@ -80,6 +80,12 @@ func TestThriftHandler(t *testing.T) {
 		assert.Equal(t, shared.DescribeWorkflowExecutionResponse{}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("GetCompletionCallbacks", func(t *testing.T) {
+		h.EXPECT().GetCompletionCallbacks(ctx, &types.GetCompletionCallbacksRequest{}).Return(&types.GetCompletionCallbacksResponse{}, internalErr).Times(1)
+		resp, err := th.GetCompletionCallbacks(ctx, &hist.GetCompletionCallbacksRequest{})
+		assert.Equal(t, hist.GetCompletionCallbacksResponse{HistoryLength: common.Int64Ptr(0)}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("GetCrossClusterTasks", func(t *testing.T) {
 		h.EXPECT().GetCrossClusterTasks(ctx, &types.GetCrossClusterTasksRequest{}).Return(&types.GetCrossClusterTasksResponse{}, internalErr).Times(1)
 		resp, err := th.GetCrossClusterTasks(ctx, &shared.GetCrossClusterTasksRequest{})
@@ -176,6 +182,11 @@ func TestThriftHandler(t *testing.T) {
 		err := th.RecordChildExecutionCompleted(ctx, &hist.RecordChildExecutionCompletedRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("RecordCompletionCallbackState", func(t *testing.T) {
+		h.EXPECT().RecordCompletionCallbackState(ctx, &types.RecordCompletionCallbackStateRequest{}).Return(internalErr).Times(1)
+		err := th.RecordCompletionCallbackState(ctx, &hist.RecordCompletionCallbackStateRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("RecordDecisionTaskStarted", func(t *testing.T) {
 		h.EXPECT().RecordDecisionTaskStarted(ctx, &types.RecordDecisionTaskStartedRequest{}).Return(&types.RecordDecisionTaskStartedResponse{}, internalErr).Times(1)
 		resp, err := th.RecordDecisionTaskStarted(ctx, &hist.RecordDecisionTaskStartedRequest{})
@@ -301,4 +312,9 @@ func TestThriftHandler(t *testing.T) {
 		err := th.TerminateWorkflowExecution(ctx, &hist.TerminateWorkflowExecutionRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("UpdateWorkflowMemo", func(t *testing.T) {
+		h.EXPECT().UpdateWorkflowMemo(ctx, &types.HistoryUpdateWorkflowMemoRequest{}).Return(internalErr).Times(1)
+		err := th.UpdateWorkflowMemo(ctx, &hist.UpdateWorkflowMemoRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 }
@@ -39,6 +39,13 @@ type (
 		DomainWorkerRPS         dynamicproperties.IntPropertyFnWithDomainFilter
 		ShutdownDrainDuration   dynamicproperties.DurationPropertyFn
 
+		// domain-wide task quotas, enforced across all task lists and partitions
+		GlobalDomainAddTaskRPS  dynamicproperties.IntPropertyFnWithDomainFilter
+		GlobalDomainDispatchRPS dynamicproperties.IntPropertyFnWithDomainFilter
+		// global ratelimiter config, uses GlobalDomain*RPS for RPS configuration
+		GlobalRatelimiterKeyMode        dynamicproperties.StringPropertyWithRatelimitKeyFilter
+		GlobalRatelimiterUpdateInterval dynamicproperties.DurationPropertyFn
+
 		// taskListManager configuration
 		RangeSize                                 int64
 		ReadRangeSize                             dynamicproperties.IntPropertyFn
@@ -74,6 +81,8 @@ type (
 		IsolationGroupHasPollersSustainedDuration dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
 		IsolationGroupNoPollersSustainedDuration  dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
 		IsolationGroupsPerPartition               dynamicproperties.IntPropertyFnWithTaskListInfoFilters
+		ScalingScheduleToStartTarget              dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
+		ScalingBacklogDrainDuration               dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
 
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval dynamicproperties.DurationPropertyFnWithTaskListInfoFilters
@@ -179,6 +188,10 @@ func NewConfig(dc *dynamicconfig.Collection, hostName string, getIsolationGroups
 		WorkerRPS:                                 dc.GetIntProperty(dynamicproperties.MatchingWorkerRPS),
 		DomainUserRPS:                             dc.GetIntPropertyFilteredByDomain(dynamicproperties.MatchingDomainUserRPS),
 		DomainWorkerRPS:                           dc.GetIntPropertyFilteredByDomain(dynamicproperties.MatchingDomainWorkerRPS),
+		GlobalDomainAddTaskRPS:                    dc.GetIntPropertyFilteredByDomain(dynamicproperties.MatchingGlobalDomainAddTaskRPS),
+		GlobalDomainDispatchRPS:                   dc.GetIntPropertyFilteredByDomain(dynamicproperties.MatchingGlobalDomainDispatchRPS),
+		GlobalRatelimiterKeyMode:                  dc.GetStringPropertyFilteredByRatelimitKey(dynamicproperties.MatchingGlobalRatelimiterMode),
+		GlobalRatelimiterUpdateInterval:           dc.GetDurationProperty(dynamicproperties.GlobalRatelimiterUpdateInterval),
 		RangeSize:                                 100000,
 		ReadRangeSize:                             dc.GetIntProperty(dynamicproperties.MatchingReadRangeSize),
 		GetTasksBatchSize:                         dc.GetIntPropertyFilteredByTaskListInfo(dynamicproperties.MatchingGetTasksBatchSize),
@@ -221,6 +234,8 @@ func NewConfig(dc *dynamicconfig.Collection, hostName string, getIsolationGroups
 		IsolationGroupHasPollersSustainedDuration: dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.MatchingIsolationGroupHasPollersSustainedDuration),
 		IsolationGroupNoPollersSustainedDuration:  dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.MatchingIsolationGroupNoPollersSustainedDuration),
 		IsolationGroupsPerPartition:               dc.GetIntPropertyFilteredByTaskListInfo(dynamicproperties.MatchingIsolationGroupsPerPartition),
+		ScalingScheduleToStartTarget:              dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.MatchingScalingRecommendationScheduleToStartTarget),
+		ScalingBacklogDrainDuration:               dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.MatchingScalingRecommendationBacklogDrainDuration),
 		TaskIsolationDuration:                     dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.TaskIsolationDuration),
 		TaskIsolationPollerWindow:                 dc.GetDurationPropertyFilteredByTaskListInfo(dynamicproperties.TaskIsolationPollerWindow),
 		HostName:                                  hostName,
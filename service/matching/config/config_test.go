@@ -50,6 +50,10 @@ func TestNewConfig(t *testing.T) {
 		"WorkerRPS":                                 {dynamicproperties.MatchingWorkerRPS, 4},
 		"DomainUserRPS":                             {dynamicproperties.MatchingDomainUserRPS, 5},
 		"DomainWorkerRPS":                           {dynamicproperties.MatchingDomainWorkerRPS, 6},
+		"GlobalDomainAddTaskRPS":                    {dynamicproperties.MatchingGlobalDomainAddTaskRPS, 44},
+		"GlobalDomainDispatchRPS":                   {dynamicproperties.MatchingGlobalDomainDispatchRPS, 45},
+		"GlobalRatelimiterKeyMode":                  {dynamicproperties.MatchingGlobalRatelimiterMode, "local"},
+		"GlobalRatelimiterUpdateInterval":           {dynamicproperties.GlobalRatelimiterUpdateInterval, 3 * time.Second},
 		"RangeSize":                                 {nil, int64(100000)},
 		"ReadRangeSize":                             {dynamicproperties.MatchingReadRangeSize, 50000},
 		"GetTasksBatchSize":                         {dynamicproperties.MatchingGetTasksBatchSize, 7},
@@ -101,6 +105,8 @@ func TestNewConfig(t *testing.T) {
 		"IsolationGroupHasPollersSustainedDuration": {dynamicproperties.MatchingIsolationGroupHasPollersSustainedDuration, time.Duration(39)},
 		"IsolationGroupNoPollersSustainedDuration":  {dynamicproperties.MatchingIsolationGroupNoPollersSustainedDuration, time.Duration(40)},
 		"IsolationGroupsPerPartition":               {dynamicproperties.MatchingIsolationGroupsPerPartition, 41},
+		"ScalingScheduleToStartTarget":              {dynamicproperties.MatchingScalingRecommendationScheduleToStartTarget, time.Duration(42)},
+		"ScalingBacklogDrainDuration":               {dynamicproperties.MatchingScalingRecommendationBacklogDrainDuration, time.Duration(43)},
 	}
 	client := dynamicconfig.NewInMemoryClient()
 	for fieldName, expected := range fields {
@@ -169,6 +175,8 @@ func getValue(f *reflect.Value) interface{} {
 			return fn()
 		case dynamicproperties.StringPropertyFn:
 			return fn()
+		case dynamicproperties.StringPropertyWithRatelimitKeyFilter:
+			return fn("add:domain")
 		case dynamicproperties.FloatPropertyFnWithTaskListInfoFilters:
 			return fn("domain", "tasklist", int(types.TaskListTypeDecision))
 		case func() []string:
@@ -32,6 +32,7 @@ import (
 	"time"
 
 	"github.com/pborman/uuid"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
@@ -54,6 +55,7 @@ import (
 	"github.com/uber/cadence/service/matching/config"
 	"github.com/uber/cadence/service/matching/event"
 	"github.com/uber/cadence/service/matching/tasklist"
+	"github.com/uber/cadence/service/matching/worker"
 )
 
 // If sticky poller is not seem in last 10s, we treat it as sticky worker unavailable
@@ -97,6 +99,9 @@ type (
 		membershipResolver          membership.Resolver
 		isolationState              isolationgroup.State
 		timeSource                  clock.TimeSource
+		workerRegistry              worker.Registry
+		domainAddTaskQuota          DomainTaskQuota
+		domainDispatchQuota         DomainTaskQuota
 		failoverNotificationVersion int64
 	}
 
@@ -134,6 +139,8 @@ func NewEngine(
 	resolver membership.Resolver,
 	isolationState isolationgroup.State,
 	timeSource clock.TimeSource,
+	domainAddTaskQuota DomainTaskQuota,
+	domainDispatchQuota DomainTaskQuota,
 ) Engine {
 
 	e := &matchingEngineImpl{
@@ -154,6 +161,9 @@ func NewEngine(
 		membershipResolver:   resolver,
 		isolationState:       isolationState,
 		timeSource:           timeSource,
+		workerRegistry:       worker.NewRegistry(timeSource),
+		domainAddTaskQuota:   domainAddTaskQuota,
+		domainDispatchQuota:  domainDispatchQuota,
 	}
 
 	e.shutdownCompletion.Add(1)
@@ -247,6 +257,7 @@ func (e *matchingEngineImpl) getTaskListManager(taskList *tasklist.Identifier, t
 		e.timeSource,
 		e.timeSource.Now(),
 		e.historyService,
+		e.domainDispatchQuota,
 	)
 	if err != nil {
 		e.taskListsLock.Unlock()
@@ -283,6 +294,9 @@ func (e *matchingEngineImpl) getTaskListByDomainLocked(domainID string, taskList
 	decisionTaskListMap := make(map[string]*types.DescribeTaskListResponse)
 	activityTaskListMap := make(map[string]*types.DescribeTaskListResponse)
 	for tl, tlm := range e.taskLists {
+		if tl.IsVersioned() {
+			continue
+		}
 		if tl.GetDomainID() == domainID && tlm.GetTaskListKind() == taskListKind {
 			if types.TaskListType(tl.GetType()) == types.TaskListTypeDecision {
 				decisionTaskListMap[tl.GetRoot()] = tlm.DescribeTaskList(false)
@@ -297,6 +311,15 @@ func (e *matchingEngineImpl) getTaskListByDomainLocked(domainID string, taskList
 	}
 }
 
+// getVersionedTaskListManager returns the task list manager serving the given compatible set in the partition of
+// the given task list manager. The given task list manager is returned if the version set is empty.
+func (e *matchingEngineImpl) getVersionedTaskListManager(tlMgr tasklist.Manager, versionSet string) (tasklist.Manager, error) {
+	if versionSet == "" {
+		return tlMgr, nil
+	}
+	return e.getTaskListManager(tlMgr.TaskListID().GetVersionedIdentifier(versionSet), tlMgr.GetTaskListKind())
+}
+
 // For use in tests
 func (e *matchingEngineImpl) updateTaskList(taskList *tasklist.Identifier, mgr tasklist.Manager) {
 	e.taskListsLock.Lock()
@@ -390,6 +413,17 @@ func (e *matchingEngineImpl) AddDecisionTask(
 		}
 	}
 
+	// Tasks of task lists with build ID versioning are dispatched to the compatible set of the build ID that
+	// completed the last decision, or the default set if the build ID is unknown
+	addMgr := tlMgr
+	if taskListKind == types.TaskListKindNormal && request.GetForwardedFrom() == "" {
+		versionSet := tasklist.VersionSetForTask(tlMgr.TaskListVersioningConfig(), request.GetBuildID())
+		addMgr, err = e.getVersionedTaskListManager(tlMgr, versionSet)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	taskInfo := &persistence.TaskInfo{
 		DomainID:                      domainID,
 		RunID:                         request.Execution.GetRunID(),
@@ -400,7 +434,7 @@ func (e *matchingEngineImpl) AddDecisionTask(
 		PartitionConfig:               request.GetPartitionConfig(),
 	}
 
-	syncMatched, err := tlMgr.AddTask(hCtx.Context, tasklist.AddTaskParams{
+	syncMatched, err := addMgr.AddTask(hCtx.Context, tasklist.AddTaskParams{
 		TaskInfo:      taskInfo,
 		Source:        request.GetSource(),
 		ForwardedFrom: request.GetForwardedFrom(),
@@ -465,6 +499,11 @@ func (e *matchingEngineImpl) AddActivityTask(
 		return nil, err
 	}
 
+	// activity task lists aliased by a migration write their tasks to the target task list
+	if migrationConfig := tlMgr.TaskListMigrationConfig(); migrationConfig.GetAlias() && taskListKind == types.TaskListKindNormal && request.GetForwardedFrom() == "" {
+		return e.addActivityTaskToAlias(hCtx, request, tlMgr, migrationConfig.GetTargetTaskList())
+	}
+
 	taskInfo := &persistence.TaskInfo{
 		DomainID:                      request.GetSourceDomainUUID(),
 		RunID:                         request.Execution.GetRunID(),
@@ -492,6 +531,26 @@ func (e *matchingEngineImpl) AddActivityTask(
 	}, nil
 }
 
+func (e *matchingEngineImpl) addActivityTaskToAlias(
+	hCtx *handlerContext,
+	request *types.AddActivityTaskRequest,
+	tlMgr tasklist.Manager,
+	targetTaskList string,
+) (*types.AddActivityTaskResponse, error) {
+	aliasRequest := *request
+	aliasRequest.TaskList = &types.TaskList{
+		Name: targetTaskList,
+		Kind: types.TaskListKindNormal.Ptr(),
+	}
+	if _, err := e.matchingClient.AddActivityTask(hCtx.Context, &aliasRequest); err != nil {
+		return nil, err
+	}
+	// the partition config of the aliased task list is returned, the caller keeps addressing it
+	return &types.AddActivityTaskResponse{
+		PartitionConfig: tlMgr.TaskListPartitionConfig(),
+	}, nil
+}
+
 // PollForDecisionTask tries to get the decision task using exponential backoff.
 func (e *matchingEngineImpl) PollForDecisionTask(
 	hCtx *handlerContext,
@@ -520,6 +579,7 @@ func (e *matchingEngineImpl) PollForDecisionTask(
 			"IsolationGroup":       req.GetIsolationGroup(),
 		},
 	})
+	e.recordWorkerPoll(hCtx, domainID, taskListName, taskListKind, types.TaskListTypeDecision, request.GetIdentity(), req.GetForwardedFrom())
 pollLoop:
 	for {
 		if err := common.IsValidContext(hCtx.Context); err != nil {
@@ -536,12 +596,23 @@ pollLoop:
 		pollerCtx := tasklist.ContextWithPollerID(hCtx.Context, pollerID)
 		pollerCtx = tasklist.ContextWithIdentity(pollerCtx, request.GetIdentity())
 		pollerCtx = tasklist.ContextWithIsolationGroup(pollerCtx, req.GetIsolationGroup())
+		pollerCtx = tasklist.ContextWithBuildID(pollerCtx, request.GetBinaryChecksum())
 		tlMgr, err := e.getTaskListManager(taskListID, taskListKind)
 		if err != nil {
 			return nil, fmt.Errorf("couldn't load tasklist manager: %w", err)
 		}
+		// Pollers with a registered build ID poll the task list of their compatible set,
+		// other pollers drain the unversioned task list
+		pollMgr := tlMgr
+		if taskListKind == types.TaskListKindNormal && req.GetForwardedFrom() == "" {
+			versionSet := tasklist.VersionSetForPoller(tlMgr.TaskListVersioningConfig(), request.GetBinaryChecksum())
+			pollMgr, err = e.getVersionedTaskListManager(tlMgr, versionSet)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't load versioned tasklist manager: %w", err)
+			}
+		}
 		startT := time.Now() // Record the start time
-		task, err := tlMgr.GetTask(pollerCtx, nil)
+		task, err := pollMgr.GetTask(pollerCtx, nil)
 		if err != nil {
 			// TODO: Is empty poll the best reply for errPumpClosed?
 			if errors.Is(err, tasklist.ErrNoTasks) || errors.Is(err, errPumpClosed) {
@@ -702,6 +773,7 @@ func (e *matchingEngineImpl) PollForActivityTask(
 		tag.WorkflowTaskListName(taskListName),
 		tag.WorkflowDomainID(domainID),
 	)
+	e.recordWorkerPoll(hCtx, domainID, taskListName, request.GetTaskList().GetKind(), types.TaskListTypeActivity, request.GetIdentity(), req.GetForwardedFrom())
 
 pollLoop:
 	for {
@@ -870,12 +942,21 @@ func (e *matchingEngineImpl) QueryWorkflow(
 		}
 	}
 
+	// Queries of task lists with build ID versioning are answered by workers of the default set
+	queryMgr := tlMgr
+	if taskListKind == types.TaskListKindNormal && queryRequest.GetForwardedFrom() == "" {
+		queryMgr, err = e.getVersionedTaskListManager(tlMgr, tasklist.VersionSetForTask(tlMgr.TaskListVersioningConfig(), ""))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	taskID := uuid.New()
 	queryResultCh := make(chan *queryResult, 1)
 	e.lockableQueryTaskMap.put(taskID, queryResultCh)
 	defer e.lockableQueryTaskMap.delete(taskID)
 
-	resp, err := tlMgr.DispatchQueryTask(hCtx.Context, taskID, queryRequest)
+	resp, err := queryMgr.DispatchQueryTask(hCtx.Context, taskID, queryRequest)
 	// if get response or error it means that query task was handled by forwarding to another matching host
 	// this remote host's result can be returned directly
 	if err != nil {
@@ -987,7 +1068,35 @@ func (e *matchingEngineImpl) DescribeTaskList(
 		return nil, err
 	}
 
-	return tlMgr.DescribeTaskList(request.DescRequest.GetIncludeTaskListStatus()), nil
+	includeTaskListStatus := request.DescRequest.GetIncludeTaskListStatus()
+	response := tlMgr.DescribeTaskList(includeTaskListStatus)
+	if !includeTaskListStatus || taskListID.IsVersioned() {
+		return response, nil
+	}
+	// Backlog and pollers of the compatible sets are tracked by the versioned task lists of this partition
+	for _, set := range tlMgr.TaskListVersioningConfig().GetCompatibleSets() {
+		versionedMgr, err := e.getVersionedTaskListManager(tlMgr, tasklist.VersionSetID(set))
+		if err != nil {
+			return nil, err
+		}
+		versionedStatus := versionedMgr.DescribeTaskList(true).TaskListStatus
+		if versionedStatus == nil {
+			continue
+		}
+		if response.TaskListStatus.BuildIDMetrics == nil {
+			response.TaskListStatus.BuildIDMetrics = make(map[string]*types.BuildIDMetrics)
+		}
+		for _, buildID := range set.GetBuildIDs() {
+			buildIDMetrics := &types.BuildIDMetrics{
+				BacklogCountHint: versionedStatus.GetBacklogCountHint(),
+			}
+			if pollerMetrics, ok := versionedStatus.GetBuildIDMetrics()[buildID]; ok {
+				buildIDMetrics.PollerCount = pollerMetrics.PollerCount
+			}
+			response.TaskListStatus.BuildIDMetrics[buildID] = buildIDMetrics
+		}
+	}
+	return response, nil
 }
 
 func (e *matchingEngineImpl) ListTaskListPartitions(
@@ -1090,6 +1199,310 @@ func (e *matchingEngineImpl) UpdateTaskListPartitionConfig(
 	return &types.MatchingUpdateTaskListPartitionConfigResponse{}, nil
 }
 
+func (e *matchingEngineImpl) UpdateTaskListVersioningConfig(
+	hCtx *handlerContext,
+	request *types.MatchingUpdateTaskListVersioningConfigRequest,
+) (*types.MatchingUpdateTaskListVersioningConfigResponse, error) {
+	domainID := request.GetDomainUUID()
+	updateRequest := request.GetUpdateRequest()
+	if updateRequest == nil {
+		return nil, &types.BadRequestError{Message: "Update request is not set."}
+	}
+	taskListName := updateRequest.GetTaskList().GetName()
+	taskListKind := updateRequest.GetTaskList().GetKind()
+	if taskListKind != types.TaskListKindNormal {
+		return nil, &types.BadRequestError{Message: "Only normal tasklist's versioning config can be updated."}
+	}
+	taskListID, err := tasklist.NewIdentifier(domainID, taskListName, persistence.TaskListTypeDecision)
+	if err != nil {
+		return nil, err
+	}
+	if !taskListID.IsRoot() || taskListID.IsVersioned() {
+		return nil, &types.BadRequestError{Message: "Only root partition's versioning config can be updated."}
+	}
+	tlMgr, err := e.getTaskListManager(taskListID, taskListKind)
+	if err != nil {
+		return nil, err
+	}
+	versioningConfig, err := tlMgr.UpdateTaskListVersioningConfig(hCtx.Context, updateRequest)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MatchingUpdateTaskListVersioningConfigResponse{VersioningConfig: versioningConfig}, nil
+}
+
+func (e *matchingEngineImpl) GetTaskListScalingRecommendation(
+	hCtx *handlerContext,
+	request *types.MatchingGetTaskListScalingRecommendationRequest,
+) (*types.GetTaskListScalingRecommendationResponse, error) {
+	domainID := request.GetDomainUUID()
+	recommendationRequest := request.GetRequest()
+	if recommendationRequest == nil {
+		return nil, &types.BadRequestError{Message: "Scaling recommendation request is not set."}
+	}
+	taskListName := recommendationRequest.GetTaskList().GetName()
+	taskListKind := recommendationRequest.GetTaskList().GetKind()
+	if taskListKind != types.TaskListKindNormal {
+		return nil, &types.BadRequestError{Message: "Scaling recommendations are only available for normal tasklists."}
+	}
+	taskListType := persistence.TaskListTypeDecision
+	if recommendationRequest.GetTaskListType() == types.TaskListTypeActivity {
+		taskListType = persistence.TaskListTypeActivity
+	}
+	domainName, err := e.domainCache.GetDomainName(domainID)
+	if err != nil {
+		return nil, err
+	}
+	taskListID, err := tasklist.NewIdentifier(domainID, taskListName, taskListType)
+	if err != nil {
+		return nil, err
+	}
+	if !taskListID.IsRoot() || taskListID.IsVersioned() {
+		return nil, &types.BadRequestError{Message: "Scaling recommendations are only available for the root partition."}
+	}
+	tlMgr, err := e.getTaskListManager(taskListID, taskListKind)
+	if err != nil {
+		return nil, err
+	}
+
+	numPartitions := e.config.NumTasklistReadPartitions(domainName, taskListName, taskListType)
+	if partitionConfig := tlMgr.TaskListPartitionConfig(); partitionConfig != nil {
+		numPartitions = len(partitionConfig.ReadPartitions)
+	}
+	partitions := make([]*types.DescribeTaskListResponse, numPartitions)
+	partitions[0] = tlMgr.DescribeTaskList(true)
+	var wg sync.WaitGroup
+	for i := 1; i < numPartitions; i++ {
+		wg.Add(1)
+		go func(partitionID int) {
+			defer wg.Done()
+			resp, err := e.matchingClient.DescribeTaskList(hCtx.Context, &types.MatchingDescribeTaskListRequest{
+				DomainUUID: domainID,
+				DescRequest: &types.DescribeTaskListRequest{
+					TaskListType: recommendationRequest.TaskListType,
+					TaskList: &types.TaskList{
+						Name: taskListID.GetPartition(partitionID),
+						Kind: types.TaskListKindNormal.Ptr(),
+					},
+					IncludeTaskListStatus: true,
+				},
+			})
+			if err != nil {
+				e.logger.Warn("failed to describe partition for scaling recommendation",
+					tag.WorkflowDomainName(domainName),
+					tag.WorkflowTaskListName(taskListID.GetPartition(partitionID)),
+					tag.Error(err),
+				)
+				return
+			}
+			partitions[partitionID] = resp
+		}(i)
+	}
+	wg.Wait()
+
+	complete := true
+	described := make([]*types.DescribeTaskListResponse, 0, numPartitions)
+	for _, p := range partitions {
+		if p == nil {
+			complete = false
+			continue
+		}
+		described = append(described, p)
+	}
+	resp := tasklist.RecommendScaling(tasklist.AggregateScalingSignals(described), tasklist.ScalingParams{
+		ScheduleToStartTarget: e.config.ScalingScheduleToStartTarget(domainName, taskListName, taskListType),
+		BacklogDrainDuration:  e.config.ScalingBacklogDrainDuration(domainName, taskListName, taskListType),
+	}, complete)
+	hCtx.scope.UpdateGauge(metrics.TaskListRecommendedPollerCountGauge, float64(resp.GetRecommendedPollerCount()))
+	hCtx.scope.UpdateGauge(metrics.TaskListScalingConfidenceGauge, float64(resp.GetConfidence()))
+	return resp, nil
+}
+
+func (e *matchingEngineImpl) ListWorkers(
+	hCtx *handlerContext,
+	request *types.MatchingListWorkersRequest,
+) (*types.ListWorkersResponse, error) {
+	return &types.ListWorkersResponse{
+		Workers: e.workerRegistry.List(request.GetDomainUUID()),
+	}, nil
+}
+
+func (e *matchingEngineImpl) DescribeWorker(
+	hCtx *handlerContext,
+	request *types.MatchingDescribeWorkerRequest,
+) (*types.DescribeWorkerResponse, error) {
+	identity := request.GetRequest().GetIdentity()
+	if identity == "" {
+		return nil, &types.BadRequestError{Message: "Worker identity is not set."}
+	}
+	// the worker may be known by other hosts, so an unknown worker is not an error here
+	return &types.DescribeWorkerResponse{
+		Worker: e.workerRegistry.Describe(request.GetDomainUUID(), identity),
+	}, nil
+}
+
+func (e *matchingEngineImpl) DescribeDomainTaskQuota(
+	hCtx *handlerContext,
+	request *types.MatchingDescribeDomainTaskQuotaRequest,
+) (*types.MatchingDescribeDomainTaskQuotaResponse, error) {
+	domainName, err := e.domainCache.GetDomainName(request.GetDomainUUID())
+	if err != nil {
+		return nil, err
+	}
+	quota := &types.DomainTaskQuota{
+		AddTaskRPSLimit:  float64(e.config.GlobalDomainAddTaskRPS(domainName)),
+		DispatchRPSLimit: float64(e.config.GlobalDomainDispatchRPS(domainName)),
+	}
+	// usage is only tracked for domains this host has seen traffic for
+	if e.domainAddTaskQuota != nil {
+		if usage, ok := e.domainAddTaskQuota.Usage(domainName); ok {
+			quota.AddTaskRPS = usage.AllowedRPS
+			quota.AddTaskRejectedRPS = usage.RejectedRPS
+		}
+	}
+	if e.domainDispatchQuota != nil {
+		if usage, ok := e.domainDispatchQuota.Usage(domainName); ok {
+			quota.DispatchRPS = usage.AllowedRPS
+			quota.DispatchRejectedRPS = usage.RejectedRPS
+		}
+	}
+	return &types.MatchingDescribeDomainTaskQuotaResponse{TaskQuota: quota}, nil
+}
+
+func (e *matchingEngineImpl) MigrateTaskList(
+	hCtx *handlerContext,
+	request *types.MatchingMigrateTaskListRequest,
+) (*types.MigrateTaskListResponse, error) {
+	domainID := request.GetDomainUUID()
+	migrateRequest := request.GetMigrateRequest()
+	if migrateRequest == nil {
+		return nil, &types.BadRequestError{Message: "Migrate request is not set."}
+	}
+	taskListName := migrateRequest.GetTaskList().GetName()
+	taskListKind := migrateRequest.GetTaskList().GetKind()
+	if taskListKind != types.TaskListKindNormal {
+		return nil, &types.BadRequestError{Message: "Only normal tasklists can be migrated."}
+	}
+	taskListType := persistence.TaskListTypeDecision
+	if migrateRequest.GetTaskListType() == types.TaskListTypeActivity {
+		taskListType = persistence.TaskListTypeActivity
+	}
+	targetTaskList := migrateRequest.GetTargetTaskList()
+	if migrateRequest.GetStop() && targetTaskList != nil {
+		return nil, &types.BadRequestError{Message: "Target task list must not be set when stopping a migration."}
+	}
+	if migrateRequest.GetAlias() && (targetTaskList == nil || taskListType != persistence.TaskListTypeActivity) {
+		return nil, &types.BadRequestError{Message: "Only activity tasklists being migrated can be aliased."}
+	}
+	domainName, err := e.domainCache.GetDomainName(domainID)
+	if err != nil {
+		return nil, err
+	}
+	taskListID, err := tasklist.NewIdentifier(domainID, taskListName, taskListType)
+	if err != nil {
+		return nil, err
+	}
+	if !taskListID.IsRoot() || taskListID.IsVersioned() {
+		return nil, &types.BadRequestError{Message: "Only root partition can be migrated."}
+	}
+	tlMgr, err := e.getTaskListManager(taskListID, taskListKind)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case migrateRequest.GetStop():
+		if err := tlMgr.UpdateTaskListMigrationConfig(hCtx.Context, nil); err != nil {
+			return nil, err
+		}
+	case targetTaskList != nil:
+		if err := tasklist.ValidateMigrationTarget(taskListID, targetTaskList); err != nil {
+			return nil, err
+		}
+		if taskListType == persistence.TaskListTypeDecision && tlMgr.TaskListVersioningConfig() != nil {
+			return nil, &types.BadRequestError{Message: "Decision tasklists with worker versioning cannot be migrated."}
+		}
+		// tasks of a target that is migrated itself would be moved again, or bounce between the aliases
+		targetStatus, err := e.matchingClient.MigrateTaskList(hCtx.Context, &types.MatchingMigrateTaskListRequest{
+			DomainUUID: domainID,
+			MigrateRequest: &types.MigrateTaskListRequest{
+				Domain:       migrateRequest.GetDomain(),
+				TaskList:     targetTaskList,
+				TaskListType: migrateRequest.TaskListType,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if targetStatus.GetMigrationConfig() != nil {
+			return nil, &types.BadRequestError{Message: "Target task list is being migrated."}
+		}
+		err = tlMgr.UpdateTaskListMigrationConfig(hCtx.Context, &types.TaskListMigrationConfig{
+			TargetTaskList: targetTaskList.GetName(),
+			Alias:          migrateRequest.GetAlias(),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	numPartitions := 1
+	if partitionConfig := tlMgr.TaskListPartitionConfig(); partitionConfig != nil {
+		numPartitions = len(partitionConfig.ReadPartitions)
+	}
+	partitions := make([]*types.TaskListMigrationPartitionStatus, numPartitions)
+	partitions[0] = &types.TaskListMigrationPartitionStatus{
+		Partition:        taskListName,
+		BacklogCountHint: tlMgr.DescribeTaskList(true).GetTaskListStatus().GetBacklogCountHint(),
+	}
+	var wg sync.WaitGroup
+	for i := 1; i < numPartitions; i++ {
+		wg.Add(1)
+		go func(partitionID int) {
+			defer wg.Done()
+			partitionName := taskListID.GetPartition(partitionID)
+			resp, err := e.matchingClient.DescribeTaskList(hCtx.Context, &types.MatchingDescribeTaskListRequest{
+				DomainUUID: domainID,
+				DescRequest: &types.DescribeTaskListRequest{
+					TaskListType: migrateRequest.TaskListType,
+					TaskList: &types.TaskList{
+						Name: partitionName,
+						Kind: types.TaskListKindNormal.Ptr(),
+					},
+					IncludeTaskListStatus: true,
+				},
+			})
+			if err != nil {
+				e.logger.Warn("failed to describe partition for task list migration",
+					tag.WorkflowDomainName(domainName),
+					tag.WorkflowTaskListName(partitionName),
+					tag.Error(err),
+				)
+				return
+			}
+			partitions[partitionID] = &types.TaskListMigrationPartitionStatus{
+				Partition:        partitionName,
+				BacklogCountHint: resp.GetTaskListStatus().GetBacklogCountHint(),
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	resp := &types.MigrateTaskListResponse{
+		MigrationConfig: tlMgr.TaskListMigrationConfig(),
+	}
+	for _, p := range partitions {
+		if p == nil {
+			continue
+		}
+		if host, err := e.getHostInfo(p.Partition); err == nil {
+			p.OwnerHostName = host
+		}
+		resp.Partitions = append(resp.Partitions, p)
+	}
+	return resp, nil
+}
+
 func (e *matchingEngineImpl) RefreshTaskListPartitionConfig(
 	hCtx *handlerContext,
 	request *types.MatchingRefreshTaskListPartitionConfigRequest,
@@ -1166,6 +1579,42 @@ func (e *matchingEngineImpl) unloadTaskList(tlMgr tasklist.Manager) {
 	tlMgr.Stop()
 }
 
+// recordWorkerPoll adds a poll received from a worker to the worker registry. Polls forwarded
+// by child partitions were already recorded by the host that received them, and sticky task lists
+// are specific to a single worker, so both are skipped.
+func (e *matchingEngineImpl) recordWorkerPoll(
+	hCtx *handlerContext,
+	domainID string,
+	taskListName string,
+	taskListKind types.TaskListKind,
+	taskListType types.TaskListType,
+	identity string,
+	forwardedFrom string,
+) {
+	if forwardedFrom != "" || taskListKind == types.TaskListKindSticky {
+		return
+	}
+	persistenceType := persistence.TaskListTypeDecision
+	if taskListType == types.TaskListTypeActivity {
+		persistenceType = persistence.TaskListTypeActivity
+	}
+	taskListID, err := tasklist.NewIdentifier(domainID, taskListName, persistenceType)
+	if err != nil {
+		return
+	}
+	var versionInfo *types.WorkerVersionInfo
+	call := yarpc.CallFromContext(hCtx.Context)
+	if impl, featureVersion := call.Header(common.ClientImplHeaderName), call.Header(common.FeatureVersionHeaderName); impl != "" || featureVersion != "" {
+		versionInfo = &types.WorkerVersionInfo{Impl: impl, FeatureVersion: featureVersion}
+	}
+	e.workerRegistry.RecordPoll(domainID, &worker.Poll{
+		Identity:     identity,
+		TaskList:     taskListID.GetRoot(),
+		TaskListType: taskListType,
+		VersionInfo:  versionInfo,
+	})
+}
+
 // Populate the decision task response based on context and scheduled/started events.
 func (e *matchingEngineImpl) createPollForDecisionTaskResponse(
 	task *tasklist.InternalTask,
@@ -1302,12 +1751,13 @@ func (e *matchingEngineImpl) recordActivityTaskStarted(
 	task *tasklist.InternalTask,
 ) (*types.RecordActivityTaskStartedResponse, error) {
 	request := &types.RecordActivityTaskStartedRequest{
-		DomainUUID:        task.Event.DomainID,
-		WorkflowExecution: task.WorkflowExecution(),
-		ScheduleID:        task.Event.ScheduleID,
-		TaskID:            task.Event.TaskID,
-		RequestID:         uuid.New(),
-		PollRequest:       pollReq,
+		DomainUUID:               task.Event.DomainID,
+		WorkflowExecution:        task.WorkflowExecution(),
+		ScheduleID:               task.Event.ScheduleID,
+		TaskID:                   task.Event.TaskID,
+		RequestID:                uuid.New(),
+		PollRequest:              pollReq,
+		ScheduleToStartBreakdown: task.ScheduleToStartBreakdown(),
 	}
 	var resp *types.RecordActivityTaskStartedResponse
 	op := func(ctx context.Context) error {
@@ -1398,7 +1848,7 @@ func (e *matchingEngineImpl) errIfShardLoss(taskList *tasklist.Identifier) error
 	//   If we try to create a task list manager for a task list that is not owned by us, return an error
 	//   The new task list manager will steal the task list from the current owner, which should only happen if
 	//   the task list is owned by the current host.
-	taskListOwner, err := e.membershipResolver.Lookup(service.Matching, taskList.GetName())
+	taskListOwner, err := e.membershipResolver.Lookup(service.Matching, taskList.GetPartitionName())
 	if err != nil {
 		return fmt.Errorf("failed to lookup task list owner: %w", err)
 	}
@@ -182,6 +182,8 @@ func (s *matchingEngineSuite) newMatchingEngine(
 		s.mockMembershipResolver,
 		s.isolationState,
 		s.mockTimeSource,
+		nil,
+		nil,
 	).(*matchingEngineImpl)
 }
 
@@ -231,7 +233,8 @@ func (s *matchingEngineSuite) TestOnlyUnloadMatchingInstance() {
 		s.matchingEngine.config,
 		s.matchingEngine.timeSource,
 		s.matchingEngine.timeSource.Now(),
-		s.matchingEngine.historyService)
+		s.matchingEngine.historyService,
+		s.matchingEngine.domainDispatchQuota)
 	s.Require().NoError(err)
 
 	// try to unload a different tlm instance with the same taskListID
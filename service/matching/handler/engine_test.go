@@ -28,11 +28,15 @@ import (
 	"fmt"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc/yarpctest"
 
+	"github.com/uber/cadence/client/matching"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/clock"
@@ -41,10 +45,12 @@ import (
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas/global/collection"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/matching/config"
 	"github.com/uber/cadence/service/matching/tasklist"
+	"github.com/uber/cadence/service/matching/worker"
 )
 
 func TestGetTaskListsByDomain(t *testing.T) {
@@ -132,6 +138,9 @@ func TestGetTaskListsByDomain(t *testing.T) {
 				*stickyTasklistID: mockStickyManager,
 			}
 			tc.mockSetup(mockDomainCache, mockTaskListManagers, mockStickyManagers)
+			// versioned task lists are internal and never listed
+			versionedTasklistID := decisionTasklistID.GetVersionedIdentifier("build-1")
+			mockVersionedManager := tasklist.NewMockManager(mockCtrl)
 
 			engine := &matchingEngineImpl{
 				domainCache: mockDomainCache,
@@ -140,6 +149,7 @@ func TestGetTaskListsByDomain(t *testing.T) {
 					*activityTasklistID:    mockActivityTaskListManager,
 					*otherDomainTasklistID: mockOtherDomainTaskListManager,
 					*stickyTasklistID:      mockStickyManager,
+					*versionedTasklistID:   mockVersionedManager,
 				},
 			}
 			resp, err := engine.GetTaskListsByDomain(nil, &types.GetTaskListsByDomainRequest{Domain: "test-domain"})
@@ -484,6 +494,7 @@ func TestQueryWorkflow(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			mockCtrl := gomock.NewController(t)
 			mockManager := tasklist.NewMockManager(mockCtrl)
+			mockManager.EXPECT().TaskListVersioningConfig().Return(nil).AnyTimes()
 			tasklistID, err := tasklist.NewIdentifier("test-domain-id", "test-tasklist", 0)
 			require.NoError(t, err)
 			engine := &matchingEngineImpl{
@@ -752,6 +763,56 @@ func TestShutDownTasklistsNotOwned(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestDescribeTaskListBuildIDMetrics(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	tasklistID, err := tasklist.NewIdentifier("test-domain-id", "test-tasklist", persistence.TaskListTypeDecision)
+	require.NoError(t, err)
+	versionedTasklistID := tasklistID.GetVersionedIdentifier("build-1")
+	mockManager := tasklist.NewMockManager(mockCtrl)
+	mockVersionedManager := tasklist.NewMockManager(mockCtrl)
+	mockManager.EXPECT().TaskListID().Return(tasklistID).AnyTimes()
+	mockManager.EXPECT().GetTaskListKind().Return(types.TaskListKindNormal).AnyTimes()
+	mockManager.EXPECT().TaskListVersioningConfig().Return(&types.TaskListVersioningConfig{
+		Version:        2,
+		CompatibleSets: []*types.CompatibleBuildIDSet{{BuildIDs: []string{"build-1", "build-1.1"}}},
+	}).AnyTimes()
+	mockManager.EXPECT().DescribeTaskList(true).Return(&types.DescribeTaskListResponse{
+		TaskListStatus: &types.TaskListStatus{BacklogCountHint: 1},
+	})
+	mockVersionedManager.EXPECT().DescribeTaskList(true).Return(&types.DescribeTaskListResponse{
+		TaskListStatus: &types.TaskListStatus{
+			BacklogCountHint: 10,
+			BuildIDMetrics: map[string]*types.BuildIDMetrics{
+				"build-1.1": {PollerCount: 2},
+			},
+		},
+	})
+	engine := &matchingEngineImpl{
+		taskLists: map[tasklist.Identifier]tasklist.Manager{
+			*tasklistID:          mockManager,
+			*versionedTasklistID: mockVersionedManager,
+		},
+		timeSource: clock.NewRealTimeSource(),
+	}
+
+	resp, err := engine.DescribeTaskList(&handlerContext{Context: context.Background()}, &types.MatchingDescribeTaskListRequest{
+		DomainUUID: "test-domain-id",
+		DescRequest: &types.DescribeTaskListRequest{
+			TaskList:              &types.TaskList{Name: "test-tasklist"},
+			TaskListType:          types.TaskListTypeDecision.Ptr(),
+			IncludeTaskListStatus: true,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &types.TaskListStatus{
+		BacklogCountHint: 1,
+		BuildIDMetrics: map[string]*types.BuildIDMetrics{
+			"build-1":   {BacklogCountHint: 10},
+			"build-1.1": {BacklogCountHint: 10, PollerCount: 2},
+		},
+	}, resp.TaskListStatus)
+}
+
 func TestUpdateTaskListPartitionConfig(t *testing.T) {
 	testCases := []struct {
 		name                 string
@@ -968,6 +1029,580 @@ func TestUpdateTaskListPartitionConfig(t *testing.T) {
 	}
 }
 
+func TestUpdateTaskListVersioningConfig(t *testing.T) {
+	testCases := []struct {
+		name           string
+		req            *types.MatchingUpdateTaskListVersioningConfigRequest
+		mockSetup      func(*tasklist.MockManager)
+		expectedResult *types.MatchingUpdateTaskListVersioningConfigResponse
+		expectError    bool
+		expectedError  string
+	}{
+		{
+			name: "success",
+			req: &types.MatchingUpdateTaskListVersioningConfigRequest{
+				DomainUUID: "test-domain-id",
+				UpdateRequest: &types.UpdateTaskListVersioningConfigRequest{
+					Domain:               "test-domain",
+					TaskList:             &types.TaskList{Name: "test-tasklist"},
+					AddNewDefaultBuildID: "build-1",
+				},
+			},
+			mockSetup: func(mockManager *tasklist.MockManager) {
+				mockManager.EXPECT().UpdateTaskListVersioningConfig(gomock.Any(), &types.UpdateTaskListVersioningConfigRequest{
+					Domain:               "test-domain",
+					TaskList:             &types.TaskList{Name: "test-tasklist"},
+					AddNewDefaultBuildID: "build-1",
+				}).Return(&types.TaskListVersioningConfig{
+					Version:        1,
+					CompatibleSets: []*types.CompatibleBuildIDSet{{BuildIDs: []string{"build-1"}}},
+				}, nil)
+			},
+			expectedResult: &types.MatchingUpdateTaskListVersioningConfigResponse{
+				VersioningConfig: &types.TaskListVersioningConfig{
+					Version:        1,
+					CompatibleSets: []*types.CompatibleBuildIDSet{{BuildIDs: []string{"build-1"}}},
+				},
+			},
+		},
+		{
+			name: "tasklist manager error",
+			req: &types.MatchingUpdateTaskListVersioningConfigRequest{
+				DomainUUID: "test-domain-id",
+				UpdateRequest: &types.UpdateTaskListVersioningConfigRequest{
+					TaskList:       &types.TaskList{Name: "test-tasklist"},
+					PromoteBuildID: "build-1",
+				},
+			},
+			mockSetup: func(mockManager *tasklist.MockManager) {
+				mockManager.EXPECT().UpdateTaskListVersioningConfig(gomock.Any(), gomock.Any()).Return(nil, errors.New("tasklist manager error"))
+			},
+			expectError:   true,
+			expectedError: "tasklist manager error",
+		},
+		{
+			name: "nil update request",
+			req: &types.MatchingUpdateTaskListVersioningConfigRequest{
+				DomainUUID: "test-domain-id",
+			},
+			mockSetup:     func(mockManager *tasklist.MockManager) {},
+			expectError:   true,
+			expectedError: "Update request is not set.",
+		},
+		{
+			name: "invalid tasklist kind",
+			req: &types.MatchingUpdateTaskListVersioningConfigRequest{
+				DomainUUID: "test-domain-id",
+				UpdateRequest: &types.UpdateTaskListVersioningConfigRequest{
+					TaskList:       &types.TaskList{Name: "test-tasklist", Kind: types.TaskListKindSticky.Ptr()},
+					PromoteBuildID: "build-1",
+				},
+			},
+			mockSetup:     func(mockManager *tasklist.MockManager) {},
+			expectError:   true,
+			expectedError: "Only normal tasklist's versioning config can be updated.",
+		},
+		{
+			name: "non-root partition",
+			req: &types.MatchingUpdateTaskListVersioningConfigRequest{
+				DomainUUID: "test-domain-id",
+				UpdateRequest: &types.UpdateTaskListVersioningConfigRequest{
+					TaskList:       &types.TaskList{Name: "/__cadence_sys/test-tasklist/1"},
+					PromoteBuildID: "build-1",
+				},
+			},
+			mockSetup:     func(mockManager *tasklist.MockManager) {},
+			expectError:   true,
+			expectedError: "Only root partition's versioning config can be updated.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockManager := tasklist.NewMockManager(mockCtrl)
+			tc.mockSetup(mockManager)
+			tasklistID, err := tasklist.NewIdentifier("test-domain-id", "test-tasklist", persistence.TaskListTypeDecision)
+			require.NoError(t, err)
+			engine := &matchingEngineImpl{
+				taskLists: map[tasklist.Identifier]tasklist.Manager{
+					*tasklistID: mockManager,
+				},
+				timeSource: clock.NewRealTimeSource(),
+			}
+			resp, err := engine.UpdateTaskListVersioningConfig(&handlerContext{Context: context.Background()}, tc.req)
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, resp)
+			}
+		})
+	}
+}
+
+func TestGetTaskListScalingRecommendation(t *testing.T) {
+	rootPartition := &types.DescribeTaskListResponse{
+		Pollers: []*types.PollerInfo{{Identity: "worker-a"}, {Identity: "worker-b"}},
+		TaskListStatus: &types.TaskListStatus{
+			NewTasksPerSecond:          10,
+			DispatchedTasksPerSecond:   10,
+			SyncMatchRatio:             1,
+			ScheduleToStartLatencyInMs: 100,
+		},
+	}
+	childPartition := &types.DescribeTaskListResponse{
+		Pollers: []*types.PollerInfo{{Identity: "worker-b"}, {Identity: "worker-c"}},
+		TaskListStatus: &types.TaskListStatus{
+			NewTasksPerSecond:          10,
+			DispatchedTasksPerSecond:   10,
+			SyncMatchRatio:             0.5,
+			ScheduleToStartLatencyInMs: 300,
+		},
+	}
+	twoPartitions := &types.TaskListPartitionConfig{
+		Version:         1,
+		ReadPartitions:  map[int]*types.TaskListPartition{0: {}, 1: {}},
+		WritePartitions: map[int]*types.TaskListPartition{0: {}, 1: {}},
+	}
+	testCases := []struct {
+		name           string
+		req            *types.MatchingGetTaskListScalingRecommendationRequest
+		mockSetup      func(*tasklist.MockManager, *matching.MockClient)
+		expectedResult *types.GetTaskListScalingRecommendationResponse
+		expectError    bool
+		expectedError  string
+	}{
+		{
+			name: "success",
+			req: &types.MatchingGetTaskListScalingRecommendationRequest{
+				DomainUUID: "test-domain-id",
+				Request: &types.GetTaskListScalingRecommendationRequest{
+					Domain:   "test-domain",
+					TaskList: &types.TaskList{Name: "test-tasklist"},
+				},
+			},
+			mockSetup: func(mockManager *tasklist.MockManager, mockClient *matching.MockClient) {
+				mockManager.EXPECT().TaskListPartitionConfig().Return(twoPartitions)
+				mockManager.EXPECT().DescribeTaskList(true).Return(rootPartition)
+				mockClient.EXPECT().DescribeTaskList(gomock.Any(), &types.MatchingDescribeTaskListRequest{
+					DomainUUID: "test-domain-id",
+					DescRequest: &types.DescribeTaskListRequest{
+						TaskList: &types.TaskList{
+							Name: "/__cadence_sys/test-tasklist/1",
+							Kind: types.TaskListKindNormal.Ptr(),
+						},
+						IncludeTaskListStatus: true,
+					},
+				}).Return(childPartition, nil)
+			},
+			expectedResult: &types.GetTaskListScalingRecommendationResponse{
+				RecommendedPollerCount: 3,
+				Confidence:             types.TaskListScalingConfidenceMedium.Ptr(),
+				Signals: &types.TaskListScalingSignals{
+					AddTasksPerSecond:          20,
+					DispatchedTasksPerSecond:   20,
+					SyncMatchRatio:             0.75,
+					ScheduleToStartLatencyInMs: 200,
+					PollerCount:                3,
+				},
+			},
+		},
+		{
+			name: "partition unavailable lowers confidence",
+			req: &types.MatchingGetTaskListScalingRecommendationRequest{
+				DomainUUID: "test-domain-id",
+				Request: &types.GetTaskListScalingRecommendationRequest{
+					TaskList: &types.TaskList{Name: "test-tasklist"},
+				},
+			},
+			mockSetup: func(mockManager *tasklist.MockManager, mockClient *matching.MockClient) {
+				mockManager.EXPECT().TaskListPartitionConfig().Return(twoPartitions)
+				mockManager.EXPECT().DescribeTaskList(true).Return(rootPartition)
+				mockClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(nil, errors.New("partition unavailable"))
+			},
+			expectedResult: &types.GetTaskListScalingRecommendationResponse{
+				RecommendedPollerCount: 2,
+				Confidence:             types.TaskListScalingConfidenceMedium.Ptr(),
+				Signals: &types.TaskListScalingSignals{
+					AddTasksPerSecond:          10,
+					DispatchedTasksPerSecond:   10,
+					SyncMatchRatio:             1,
+					ScheduleToStartLatencyInMs: 100,
+					PollerCount:                2,
+				},
+			},
+		},
+		{
+			name: "no partition config",
+			req: &types.MatchingGetTaskListScalingRecommendationRequest{
+				DomainUUID: "test-domain-id",
+				Request: &types.GetTaskListScalingRecommendationRequest{
+					TaskList: &types.TaskList{Name: "test-tasklist"},
+				},
+			},
+			mockSetup: func(mockManager *tasklist.MockManager, mockClient *matching.MockClient) {
+				mockManager.EXPECT().TaskListPartitionConfig().Return(nil)
+				mockManager.EXPECT().DescribeTaskList(true).Return(rootPartition)
+			},
+			expectedResult: &types.GetTaskListScalingRecommendationResponse{
+				RecommendedPollerCount: 2,
+				Confidence:             types.TaskListScalingConfidenceHigh.Ptr(),
+				Signals: &types.TaskListScalingSignals{
+					AddTasksPerSecond:          10,
+					DispatchedTasksPerSecond:   10,
+					SyncMatchRatio:             1,
+					ScheduleToStartLatencyInMs: 100,
+					PollerCount:                2,
+				},
+			},
+		},
+		{
+			name: "nil request",
+			req: &types.MatchingGetTaskListScalingRecommendationRequest{
+				DomainUUID: "test-domain-id",
+			},
+			mockSetup:     func(mockManager *tasklist.MockManager, mockClient *matching.MockClient) {},
+			expectError:   true,
+			expectedError: "Scaling recommendation request is not set.",
+		},
+		{
+			name: "invalid tasklist kind",
+			req: &types.MatchingGetTaskListScalingRecommendationRequest{
+				DomainUUID: "test-domain-id",
+				Request: &types.GetTaskListScalingRecommendationRequest{
+					TaskList: &types.TaskList{Name: "test-tasklist", Kind: types.TaskListKindSticky.Ptr()},
+				},
+			},
+			mockSetup:     func(mockManager *tasklist.MockManager, mockClient *matching.MockClient) {},
+			expectError:   true,
+			expectedError: "Scaling recommendations are only available for normal tasklists.",
+		},
+		{
+			name: "non-root partition",
+			req: &types.MatchingGetTaskListScalingRecommendationRequest{
+				DomainUUID: "test-domain-id",
+				Request: &types.GetTaskListScalingRecommendationRequest{
+					TaskList: &types.TaskList{Name: "/__cadence_sys/test-tasklist/1"},
+				},
+			},
+			mockSetup:     func(mockManager *tasklist.MockManager, mockClient *matching.MockClient) {},
+			expectError:   true,
+			expectedError: "Scaling recommendations are only available for the root partition.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockDomainCache := cache.NewMockDomainCache(mockCtrl)
+			mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("test-domain", nil).AnyTimes()
+			mockManager := tasklist.NewMockManager(mockCtrl)
+			mockClient := matching.NewMockClient(mockCtrl)
+			tc.mockSetup(mockManager, mockClient)
+			tasklistID, err := tasklist.NewIdentifier("test-domain-id", "test-tasklist", persistence.TaskListTypeDecision)
+			require.NoError(t, err)
+			engine := &matchingEngineImpl{
+				taskLists: map[tasklist.Identifier]tasklist.Manager{
+					*tasklistID: mockManager,
+				},
+				timeSource:     clock.NewRealTimeSource(),
+				domainCache:    mockDomainCache,
+				matchingClient: mockClient,
+				logger:         log.NewNoop(),
+				config: &config.Config{
+					NumTasklistReadPartitions:    dynamicproperties.GetIntPropertyFilteredByTaskListInfo(1),
+					ScalingScheduleToStartTarget: dynamicproperties.GetDurationPropertyFnFilteredByTaskListInfo(time.Second),
+					ScalingBacklogDrainDuration:  dynamicproperties.GetDurationPropertyFnFilteredByTaskListInfo(time.Minute),
+				},
+			}
+			hCtx := &handlerContext{Context: context.Background(), scope: metrics.NoopScope}
+			resp, err := engine.GetTaskListScalingRecommendation(hCtx, tc.req)
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, resp)
+			}
+		})
+	}
+}
+
+func TestMigrateTaskList(t *testing.T) {
+	twoPartitions := &types.TaskListPartitionConfig{
+		Version:         1,
+		ReadPartitions:  map[int]*types.TaskListPartition{0: {}, 1: {}},
+		WritePartitions: map[int]*types.TaskListPartition{0: {}},
+	}
+	migrationConfig := &types.TaskListMigrationConfig{TargetTaskList: "target-tasklist", Alias: true}
+	testCases := []struct {
+		name           string
+		req            *types.MigrateTaskListRequest
+		mockSetup      func(*tasklist.MockManager, *matching.MockClient, *membership.MockResolver)
+		expectedResult *types.MigrateTaskListResponse
+		expectedError  string
+	}{
+		{
+			name: "start migration",
+			req: &types.MigrateTaskListRequest{
+				Domain:         "test-domain",
+				TaskList:       &types.TaskList{Name: "test-tasklist"},
+				TaskListType:   types.TaskListTypeActivity.Ptr(),
+				TargetTaskList: &types.TaskList{Name: "target-tasklist"},
+				Alias:          true,
+			},
+			mockSetup: func(mockManager *tasklist.MockManager, mockClient *matching.MockClient, mockResolver *membership.MockResolver) {
+				mockClient.EXPECT().MigrateTaskList(gomock.Any(), &types.MatchingMigrateTaskListRequest{
+					DomainUUID: "test-domain-id",
+					MigrateRequest: &types.MigrateTaskListRequest{
+						Domain:       "test-domain",
+						TaskList:     &types.TaskList{Name: "target-tasklist"},
+						TaskListType: types.TaskListTypeActivity.Ptr(),
+					},
+				}).Return(&types.MigrateTaskListResponse{}, nil)
+				mockManager.EXPECT().UpdateTaskListMigrationConfig(gomock.Any(), migrationConfig).Return(nil)
+				mockManager.EXPECT().TaskListPartitionConfig().Return(nil)
+				mockManager.EXPECT().DescribeTaskList(true).Return(&types.DescribeTaskListResponse{
+					TaskListStatus: &types.TaskListStatus{BacklogCountHint: 10},
+				})
+				mockManager.EXPECT().TaskListMigrationConfig().Return(migrationConfig)
+				mockResolver.EXPECT().Lookup(service.Matching, "test-tasklist").Return(membership.NewHostInfo("addr0"), nil)
+			},
+			expectedResult: &types.MigrateTaskListResponse{
+				MigrationConfig: migrationConfig,
+				Partitions: []*types.TaskListMigrationPartitionStatus{
+					{Partition: "test-tasklist", OwnerHostName: "addr0", BacklogCountHint: 10},
+				},
+			},
+		},
+		{
+			name: "stop migration",
+			req: &types.MigrateTaskListRequest{
+				TaskList:     &types.TaskList{Name: "test-tasklist"},
+				TaskListType: types.TaskListTypeActivity.Ptr(),
+				Stop:         true,
+			},
+			mockSetup: func(mockManager *tasklist.MockManager, mockClient *matching.MockClient, mockResolver *membership.MockResolver) {
+				mockManager.EXPECT().UpdateTaskListMigrationConfig(gomock.Any(), nil).Return(nil)
+				mockManager.EXPECT().TaskListPartitionConfig().Return(twoPartitions)
+				mockManager.EXPECT().DescribeTaskList(true).Return(&types.DescribeTaskListResponse{
+					TaskListStatus: &types.TaskListStatus{BacklogCountHint: 10},
+				})
+				mockClient.EXPECT().DescribeTaskList(gomock.Any(), &types.MatchingDescribeTaskListRequest{
+					DomainUUID: "test-domain-id",
+					DescRequest: &types.DescribeTaskListRequest{
+						TaskListType: types.TaskListTypeActivity.Ptr(),
+						TaskList: &types.TaskList{
+							Name: "/__cadence_sys/test-tasklist/1",
+							Kind: types.TaskListKindNormal.Ptr(),
+						},
+						IncludeTaskListStatus: true,
+					},
+				}).Return(&types.DescribeTaskListResponse{
+					TaskListStatus: &types.TaskListStatus{BacklogCountHint: 5},
+				}, nil)
+				mockManager.EXPECT().TaskListMigrationConfig().Return(nil)
+				mockResolver.EXPECT().Lookup(service.Matching, "test-tasklist").Return(membership.NewHostInfo("addr0"), nil)
+				mockResolver.EXPECT().Lookup(service.Matching, "/__cadence_sys/test-tasklist/1").Return(membership.HostInfo{}, errors.New("some error"))
+			},
+			expectedResult: &types.MigrateTaskListResponse{
+				Partitions: []*types.TaskListMigrationPartitionStatus{
+					{Partition: "test-tasklist", OwnerHostName: "addr0", BacklogCountHint: 10},
+					{Partition: "/__cadence_sys/test-tasklist/1", BacklogCountHint: 5},
+				},
+			},
+		},
+		{
+			name: "describe progress skips unavailable partitions",
+			req: &types.MigrateTaskListRequest{
+				TaskList: &types.TaskList{Name: "test-tasklist"},
+			},
+			mockSetup: func(mockManager *tasklist.MockManager, mockClient *matching.MockClient, mockResolver *membership.MockResolver) {
+				mockManager.EXPECT().TaskListPartitionConfig().Return(twoPartitions)
+				mockManager.EXPECT().DescribeTaskList(true).Return(&types.DescribeTaskListResponse{})
+				mockClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(nil, errors.New("partition unavailable"))
+				mockManager.EXPECT().TaskListMigrationConfig().Return(migrationConfig)
+				mockResolver.EXPECT().Lookup(service.Matching, "test-tasklist").Return(membership.NewHostInfo("addr0"), nil)
+			},
+			expectedResult: &types.MigrateTaskListResponse{
+				MigrationConfig: migrationConfig,
+				Partitions: []*types.TaskListMigrationPartitionStatus{
+					{Partition: "test-tasklist", OwnerHostName: "addr0"},
+				},
+			},
+		},
+		{
+			name: "target is being migrated",
+			req: &types.MigrateTaskListRequest{
+				TaskList:       &types.TaskList{Name: "test-tasklist"},
+				TargetTaskList: &types.TaskList{Name: "target-tasklist"},
+			},
+			mockSetup: func(mockManager *tasklist.MockManager, mockClient *matching.MockClient, mockResolver *membership.MockResolver) {
+				mockManager.EXPECT().TaskListVersioningConfig().Return(nil)
+				mockClient.EXPECT().MigrateTaskList(gomock.Any(), gomock.Any()).Return(&types.MigrateTaskListResponse{
+					MigrationConfig: &types.TaskListMigrationConfig{TargetTaskList: "test-tasklist"},
+				}, nil)
+			},
+			expectedError: "Target task list is being migrated.",
+		},
+		{
+			name: "versioned decision task list",
+			req: &types.MigrateTaskListRequest{
+				TaskList:       &types.TaskList{Name: "test-tasklist"},
+				TargetTaskList: &types.TaskList{Name: "target-tasklist"},
+			},
+			mockSetup: func(mockManager *tasklist.MockManager, mockClient *matching.MockClient, mockResolver *membership.MockResolver) {
+				mockManager.EXPECT().TaskListVersioningConfig().Return(&types.TaskListVersioningConfig{})
+			},
+			expectedError: "Decision tasklists with worker versioning cannot be migrated.",
+		},
+		{
+			name: "update failed",
+			req: &types.MigrateTaskListRequest{
+				TaskList:     &types.TaskList{Name: "test-tasklist"},
+				TaskListType: types.TaskListTypeActivity.Ptr(),
+				Stop:         true,
+			},
+			mockSetup: func(mockManager *tasklist.MockManager, mockClient *matching.MockClient, mockResolver *membership.MockResolver) {
+				mockManager.EXPECT().UpdateTaskListMigrationConfig(gomock.Any(), nil).Return(errors.New("tasklist manager error"))
+			},
+			expectedError: "tasklist manager error",
+		},
+		{
+			name: "target is the migrated task list",
+			req: &types.MigrateTaskListRequest{
+				TaskList:       &types.TaskList{Name: "test-tasklist"},
+				TargetTaskList: &types.TaskList{Name: "test-tasklist"},
+			},
+			expectedError: "Target task list must be different from the migrated task list.",
+		},
+		{
+			name: "target is a partition",
+			req: &types.MigrateTaskListRequest{
+				TaskList:       &types.TaskList{Name: "test-tasklist"},
+				TargetTaskList: &types.TaskList{Name: "/__cadence_sys/target-tasklist/1"},
+			},
+			expectedError: "Target task list name cannot start with reserved prefix",
+		},
+		{
+			name: "alias of a decision task list",
+			req: &types.MigrateTaskListRequest{
+				TaskList:       &types.TaskList{Name: "test-tasklist"},
+				TargetTaskList: &types.TaskList{Name: "target-tasklist"},
+				Alias:          true,
+			},
+			expectedError: "Only activity tasklists being migrated can be aliased.",
+		},
+		{
+			name: "stop with a target",
+			req: &types.MigrateTaskListRequest{
+				TaskList:       &types.TaskList{Name: "test-tasklist"},
+				TargetTaskList: &types.TaskList{Name: "target-tasklist"},
+				Stop:           true,
+			},
+			expectedError: "Target task list must not be set when stopping a migration.",
+		},
+		{
+			name: "invalid tasklist kind",
+			req: &types.MigrateTaskListRequest{
+				TaskList: &types.TaskList{Name: "test-tasklist", Kind: types.TaskListKindSticky.Ptr()},
+			},
+			expectedError: "Only normal tasklists can be migrated.",
+		},
+		{
+			name: "non-root partition",
+			req: &types.MigrateTaskListRequest{
+				TaskList: &types.TaskList{Name: "/__cadence_sys/test-tasklist/1"},
+			},
+			expectedError: "Only root partition can be migrated.",
+		},
+		{
+			name:          "nil request",
+			expectedError: "Migrate request is not set.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockDomainCache := cache.NewMockDomainCache(mockCtrl)
+			mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("test-domain", nil).AnyTimes()
+			mockManager := tasklist.NewMockManager(mockCtrl)
+			mockClient := matching.NewMockClient(mockCtrl)
+			mockResolver := membership.NewMockResolver(mockCtrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockManager, mockClient, mockResolver)
+			}
+			decisionID, err := tasklist.NewIdentifier("test-domain-id", "test-tasklist", persistence.TaskListTypeDecision)
+			require.NoError(t, err)
+			activityID, err := tasklist.NewIdentifier("test-domain-id", "test-tasklist", persistence.TaskListTypeActivity)
+			require.NoError(t, err)
+			engine := &matchingEngineImpl{
+				taskLists: map[tasklist.Identifier]tasklist.Manager{
+					*decisionID: mockManager,
+					*activityID: mockManager,
+				},
+				domainCache:        mockDomainCache,
+				matchingClient:     mockClient,
+				membershipResolver: mockResolver,
+				logger:             log.NewNoop(),
+			}
+			resp, err := engine.MigrateTaskList(&handlerContext{Context: context.Background()}, &types.MatchingMigrateTaskListRequest{
+				DomainUUID:     "test-domain-id",
+				MigrateRequest: tc.req,
+			})
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, resp)
+			}
+		})
+	}
+}
+
+func TestAddActivityTaskToAlias(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockDomainCache := cache.NewMockDomainCache(mockCtrl)
+	mockDomainCache.EXPECT().GetDomainName("test-domain-id").Return("test-domain", nil)
+	mockManager := tasklist.NewMockManager(mockCtrl)
+	mockClient := matching.NewMockClient(mockCtrl)
+	partitionConfig := &types.TaskListPartitionConfig{
+		Version:         1,
+		ReadPartitions:  partitions(2),
+		WritePartitions: partitions(2),
+	}
+	mockManager.EXPECT().TaskListMigrationConfig().Return(&types.TaskListMigrationConfig{TargetTaskList: "target-tasklist", Alias: true})
+	mockManager.EXPECT().TaskListPartitionConfig().Return(partitionConfig)
+	request := &types.AddActivityTaskRequest{
+		DomainUUID:       "test-domain-id",
+		SourceDomainUUID: "test-domain-id",
+		Execution:        &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+		TaskList:         &types.TaskList{Name: "test-tasklist", Kind: types.TaskListKindNormal.Ptr()},
+		ScheduleID:       3,
+	}
+	aliasRequest := *request
+	aliasRequest.TaskList = &types.TaskList{Name: "target-tasklist", Kind: types.TaskListKindNormal.Ptr()}
+	mockClient.EXPECT().AddActivityTask(gomock.Any(), &aliasRequest).Return(&types.AddActivityTaskResponse{}, nil)
+
+	tasklistID, err := tasklist.NewIdentifier("test-domain-id", "test-tasklist", persistence.TaskListTypeActivity)
+	require.NoError(t, err)
+	engine := &matchingEngineImpl{
+		taskLists: map[tasklist.Identifier]tasklist.Manager{
+			*tasklistID: mockManager,
+		},
+		domainCache:    mockDomainCache,
+		matchingClient: mockClient,
+		metricsClient:  metrics.NewNoopMetricsClient(),
+		logger:         log.NewNoop(),
+		config:         &config.Config{},
+	}
+	resp, err := engine.AddActivityTask(&handlerContext{Context: context.Background()}, request)
+	require.NoError(t, err)
+	// the caller keeps the partition config of the aliased task list
+	assert.Equal(t, &types.AddActivityTaskResponse{PartitionConfig: partitionConfig}, resp)
+}
+
 func TestRefreshTaskListPartitionConfig(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -1393,3 +2028,182 @@ func Test_registerDomainFailoverCallback(t *testing.T) {
 	})
 
 }
+
+func TestRecordWorkerPoll(t *testing.T) {
+	ctx := yarpctest.ContextWithCall(context.Background(), &yarpctest.Call{Headers: map[string]string{
+		common.ClientImplHeaderName:     "uber-go",
+		common.FeatureVersionHeaderName: "1.7.0",
+	}})
+	hCtx := &handlerContext{Context: ctx}
+	engine := &matchingEngineImpl{
+		workerRegistry: worker.NewRegistry(clock.NewMockedTimeSource()),
+	}
+
+	// polls received by a child partition are recorded under the root task list
+	engine.recordWorkerPoll(hCtx, "test-domain-id", "/__cadence_sys/test-tasklist/2", types.TaskListKindNormal, types.TaskListTypeDecision, "worker-a", "")
+	engine.recordWorkerPoll(hCtx, "test-domain-id", "test-tasklist", types.TaskListKindNormal, types.TaskListTypeActivity, "worker-a", "")
+	// forwarded polls were already recorded by the child partition
+	engine.recordWorkerPoll(hCtx, "test-domain-id", "test-tasklist", types.TaskListKindNormal, types.TaskListTypeDecision, "worker-b", "/__cadence_sys/test-tasklist/1")
+	// sticky task lists are specific to a worker
+	engine.recordWorkerPoll(hCtx, "test-domain-id", "sticky-tasklist", types.TaskListKindSticky, types.TaskListTypeDecision, "worker-c", "")
+	// pollers without version headers are still recorded
+	engine.recordWorkerPoll(&handlerContext{Context: context.Background()}, "test-domain-id", "other-tasklist", types.TaskListKindNormal, types.TaskListTypeDecision, "worker-d", "")
+
+	resp, err := engine.ListWorkers(hCtx, &types.MatchingListWorkersRequest{DomainUUID: "test-domain-id"})
+	require.NoError(t, err)
+	require.Len(t, resp.Workers, 2)
+
+	workerA := resp.Workers[0]
+	assert.Equal(t, "worker-a", workerA.Identity)
+	assert.Equal(t, &types.WorkerVersionInfo{Impl: "uber-go", FeatureVersion: "1.7.0"}, workerA.VersionInfo)
+	require.Len(t, workerA.TaskLists, 2)
+	for _, tl := range workerA.TaskLists {
+		assert.Equal(t, "test-tasklist", tl.GetTaskList().GetName())
+	}
+
+	workerD := resp.Workers[1]
+	assert.Equal(t, "worker-d", workerD.Identity)
+	assert.Nil(t, workerD.VersionInfo)
+}
+
+func TestDescribeWorker(t *testing.T) {
+	hCtx := &handlerContext{Context: context.Background()}
+	engine := &matchingEngineImpl{
+		workerRegistry: worker.NewRegistry(clock.NewMockedTimeSource()),
+	}
+	engine.recordWorkerPoll(hCtx, "test-domain-id", "test-tasklist", types.TaskListKindNormal, types.TaskListTypeDecision, "worker-a", "")
+
+	testCases := []struct {
+		name          string
+		req           *types.MatchingDescribeWorkerRequest
+		expectedID    string
+		expectedError string
+	}{
+		{
+			name: "known worker",
+			req: &types.MatchingDescribeWorkerRequest{
+				DomainUUID: "test-domain-id",
+				Request:    &types.DescribeWorkerRequest{Domain: "test-domain", Identity: "worker-a"},
+			},
+			expectedID: "worker-a",
+		},
+		{
+			name: "unknown worker",
+			req: &types.MatchingDescribeWorkerRequest{
+				DomainUUID: "test-domain-id",
+				Request:    &types.DescribeWorkerRequest{Domain: "test-domain", Identity: "worker-b"},
+			},
+		},
+		{
+			name: "worker of another domain",
+			req: &types.MatchingDescribeWorkerRequest{
+				DomainUUID: "other-domain-id",
+				Request:    &types.DescribeWorkerRequest{Domain: "other-domain", Identity: "worker-a"},
+			},
+		},
+		{
+			name: "identity not set",
+			req: &types.MatchingDescribeWorkerRequest{
+				DomainUUID: "test-domain-id",
+				Request:    &types.DescribeWorkerRequest{Domain: "test-domain"},
+			},
+			expectedError: "Worker identity is not set.",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := engine.DescribeWorker(hCtx, tc.req)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedID, resp.GetWorker().GetIdentity())
+		})
+	}
+}
+
+func TestDescribeDomainTaskQuota(t *testing.T) {
+	testCases := []struct {
+		name          string
+		setupMocks    func(*cache.MockDomainCache, *MockDomainTaskQuota, *MockDomainTaskQuota)
+		nilQuotas     bool
+		expected      *types.DomainTaskQuota
+		expectedError string
+	}{
+		{
+			name: "domain cache error",
+			setupMocks: func(mockDomainCache *cache.MockDomainCache, _, _ *MockDomainTaskQuota) {
+				mockDomainCache.EXPECT().GetDomainName("test-domain-id").Return("", errors.New("cache failure"))
+			},
+			expectedError: "cache failure",
+		},
+		{
+			name: "quotas not configured",
+			setupMocks: func(mockDomainCache *cache.MockDomainCache, _, _ *MockDomainTaskQuota) {
+				mockDomainCache.EXPECT().GetDomainName("test-domain-id").Return("test-domain", nil)
+			},
+			nilQuotas: true,
+			expected: &types.DomainTaskQuota{
+				AddTaskRPSLimit:  100,
+				DispatchRPSLimit: 200,
+			},
+		},
+		{
+			name: "no usage recorded",
+			setupMocks: func(mockDomainCache *cache.MockDomainCache, addQuota, dispatchQuota *MockDomainTaskQuota) {
+				mockDomainCache.EXPECT().GetDomainName("test-domain-id").Return("test-domain", nil)
+				addQuota.EXPECT().Usage("test-domain").Return(collection.Usage{}, false)
+				dispatchQuota.EXPECT().Usage("test-domain").Return(collection.Usage{}, false)
+			},
+			expected: &types.DomainTaskQuota{
+				AddTaskRPSLimit:  100,
+				DispatchRPSLimit: 200,
+			},
+		},
+		{
+			name: "usage recorded",
+			setupMocks: func(mockDomainCache *cache.MockDomainCache, addQuota, dispatchQuota *MockDomainTaskQuota) {
+				mockDomainCache.EXPECT().GetDomainName("test-domain-id").Return("test-domain", nil)
+				addQuota.EXPECT().Usage("test-domain").Return(collection.Usage{AllowedRPS: 50, RejectedRPS: 5}, true)
+				dispatchQuota.EXPECT().Usage("test-domain").Return(collection.Usage{AllowedRPS: 40, RejectedRPS: 1}, true)
+			},
+			expected: &types.DomainTaskQuota{
+				AddTaskRPSLimit:     100,
+				AddTaskRPS:          50,
+				AddTaskRejectedRPS:  5,
+				DispatchRPSLimit:    200,
+				DispatchRPS:         40,
+				DispatchRejectedRPS: 1,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDomainCache := cache.NewMockDomainCache(ctrl)
+			addQuota := NewMockDomainTaskQuota(ctrl)
+			dispatchQuota := NewMockDomainTaskQuota(ctrl)
+			tc.setupMocks(mockDomainCache, addQuota, dispatchQuota)
+			cfg := defaultTestConfig()
+			cfg.GlobalDomainAddTaskRPS = func(domain string) int { return 100 }
+			cfg.GlobalDomainDispatchRPS = func(domain string) int { return 200 }
+			engine := &matchingEngineImpl{
+				domainCache: mockDomainCache,
+				config:      cfg,
+			}
+			if !tc.nilQuotas {
+				engine.domainAddTaskQuota = addQuota
+				engine.domainDispatchQuota = dispatchQuota
+			}
+
+			resp, err := engine.DescribeDomainTaskQuota(&handlerContext{Context: context.Background()}, &types.MatchingDescribeDomainTaskQuotaRequest{DomainUUID: "test-domain-id"})
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, resp.GetTaskQuota())
+		})
+	}
+}
@@ -42,14 +42,17 @@ type (
 		startWG           sync.WaitGroup
 		userRateLimiter   quotas.Policy
 		workerRateLimiter quotas.Policy
-		logger            log.Logger
-		throttledLogger   log.Logger
-		domainCache       cache.DomainCache
+		// domain-wide add task quotas, keyed by domain name
+		domainAddTaskLimiters quotas.ICollection
+		logger                log.Logger
+		throttledLogger       log.Logger
+		domainCache           cache.DomainCache
 	}
 )
 
 var (
-	errMatchingHostThrottle = &types.ServiceBusyError{Message: "Matching host rps exceeded"}
+	errMatchingHostThrottle  = &types.ServiceBusyError{Message: "Matching host rps exceeded"}
+	errDomainAddTaskThrottle = &types.ServiceBusyError{Message: "Domain add task rate exceeded"}
 )
 
 // NewHandler creates a thrift handler for the matching service
@@ -60,6 +63,7 @@ func NewHandler(
 	metricsClient metrics.Client,
 	logger log.Logger,
 	throttledLogger log.Logger,
+	domainAddTaskLimiters quotas.ICollection,
 ) Handler {
 	handler := &handlerImpl{
 		metricsClient: metricsClient,
@@ -77,10 +81,11 @@ func NewHandler(
 				config.WorkerRPS,
 			)),
 		),
-		engine:          engine,
-		logger:          logger,
-		throttledLogger: throttledLogger,
-		domainCache:     domainCache,
+		domainAddTaskLimiters: domainAddTaskLimiters,
+		engine:                engine,
+		logger:                logger,
+		throttledLogger:       throttledLogger,
+		domainCache:           domainCache,
 	}
 	// prevent us from trying to serve requests before matching engine is started and ready
 	handler.startWG.Add(1)
@@ -148,6 +153,11 @@ func (h *handlerImpl) AddActivityTask(
 		return nil, hCtx.handleErr(errMatchingHostThrottle)
 	}
 
+	// forwarded tasks were already counted by the partition they were originally added to
+	if request.GetForwardedFrom() == "" && !h.domainAddTaskLimiters.For(domainName).Allow() {
+		return nil, hCtx.handleErr(errDomainAddTaskThrottle)
+	}
+
 	resp, err := h.engine.AddActivityTask(hCtx, request)
 	return resp, hCtx.handleErr(err)
 }
@@ -178,6 +188,11 @@ func (h *handlerImpl) AddDecisionTask(
 		return nil, hCtx.handleErr(errMatchingHostThrottle)
 	}
 
+	// forwarded tasks were already counted by the partition they were originally added to
+	if request.GetForwardedFrom() == "" && !h.domainAddTaskLimiters.For(domainName).Allow() {
+		return nil, hCtx.handleErr(errDomainAddTaskThrottle)
+	}
+
 	resp, err := h.engine.AddDecisionTask(hCtx, request)
 	return resp, hCtx.handleErr(err)
 }
@@ -442,6 +457,155 @@ func (h *handlerImpl) UpdateTaskListPartitionConfig(
 	return response, hCtx.handleErr(err)
 }
 
+func (h *handlerImpl) UpdateTaskListVersioningConfig(
+	ctx context.Context,
+	request *types.MatchingUpdateTaskListVersioningConfigRequest,
+) (resp *types.MatchingUpdateTaskListVersioningConfigResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	domainName := h.domainName(request.GetDomainUUID())
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		request.GetUpdateRequest().GetTaskList(),
+		metrics.MatchingUpdateTaskListVersioningConfigScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	response, err := h.engine.UpdateTaskListVersioningConfig(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+
+func (h *handlerImpl) GetTaskListScalingRecommendation(
+	ctx context.Context,
+	request *types.MatchingGetTaskListScalingRecommendationRequest,
+) (resp *types.GetTaskListScalingRecommendationResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	domainName := h.domainName(request.GetDomainUUID())
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		request.GetRequest().GetTaskList(),
+		metrics.MatchingGetTaskListScalingRecommendationScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	response, err := h.engine.GetTaskListScalingRecommendation(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+
+func (h *handlerImpl) ListWorkers(
+	ctx context.Context,
+	request *types.MatchingListWorkersRequest,
+) (resp *types.ListWorkersResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	domainName := h.domainName(request.GetDomainUUID())
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		nil,
+		metrics.MatchingListWorkersScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	response, err := h.engine.ListWorkers(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+
+func (h *handlerImpl) DescribeWorker(
+	ctx context.Context,
+	request *types.MatchingDescribeWorkerRequest,
+) (resp *types.DescribeWorkerResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	domainName := h.domainName(request.GetDomainUUID())
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		nil,
+		metrics.MatchingDescribeWorkerScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	response, err := h.engine.DescribeWorker(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+
+func (h *handlerImpl) DescribeDomainTaskQuota(
+	ctx context.Context,
+	request *types.MatchingDescribeDomainTaskQuotaRequest,
+) (resp *types.MatchingDescribeDomainTaskQuotaResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	domainName := h.domainName(request.GetDomainUUID())
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		nil,
+		metrics.MatchingDescribeDomainTaskQuotaScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	response, err := h.engine.DescribeDomainTaskQuota(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+func (h *handlerImpl) MigrateTaskList(
+	ctx context.Context,
+	request *types.MatchingMigrateTaskListRequest,
+) (resp *types.MigrateTaskListResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	domainName := h.domainName(request.GetDomainUUID())
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		request.GetMigrateRequest().GetTaskList(),
+		metrics.MatchingMigrateTaskListScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	response, err := h.engine.MigrateTaskList(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+
 func (h *handlerImpl) RefreshTaskListPartitionConfig(
 	ctx context.Context,
 	request *types.MatchingRefreshTaskListPartitionConfigRequest,
@@ -59,6 +59,8 @@ type (
 		mockLimiter     *quotas.MockLimiter
 		handler         *handlerImpl
 
+		mockAddTaskLimiters *quotas.MockICollection
+
 		testDomain string
 	}
 )
@@ -80,6 +82,7 @@ func (s *handlerSuite) SetupTest() {
 	s.mockEngine = NewMockEngine(s.controller)
 	s.mockDomainCache = cache.NewMockDomainCache(s.controller)
 	s.mockLimiter = quotas.NewMockLimiter(s.controller)
+	s.mockAddTaskLimiters = quotas.NewMockICollection(s.controller)
 
 	// Create a handler with a mock limiter
 	s.handler = &handlerImpl{
@@ -94,9 +97,10 @@ func (s *handlerSuite) SetupTest() {
 			s.mockLimiter,
 			quotas.NewCollection(dynamicquotas.NewSimpleDynamicRateLimiterFactory(func(domain string) int { return 10 })),
 		),
-		logger:          s.mockResource.GetLogger(),
-		throttledLogger: s.mockResource.GetThrottledLogger(),
-		domainCache:     s.mockDomainCache,
+		domainAddTaskLimiters: s.mockAddTaskLimiters,
+		logger:                s.mockResource.GetLogger(),
+		throttledLogger:       s.mockResource.GetThrottledLogger(),
+		domainCache:           s.mockDomainCache,
 	}
 
 	s.testDomain = testDomain
@@ -108,7 +112,7 @@ func (s *handlerSuite) TearDownTest() {
 }
 
 func (s *handlerSuite) getHandler(config *config.Config) Handler {
-	return NewHandler(s.mockEngine, config, s.mockDomainCache, s.mockResource.MetricsClient, s.mockResource.GetLogger(), s.mockResource.GetThrottledLogger())
+	return NewHandler(s.mockEngine, config, s.mockDomainCache, s.mockResource.MetricsClient, s.mockResource.GetLogger(), s.mockResource.GetThrottledLogger(), s.mockAddTaskLimiters)
 }
 
 func (s *handlerSuite) TestNewHandler() {
@@ -291,6 +295,84 @@ func (s *handlerSuite) TestAddDecisionTask() {
 	}
 }
 
+func (s *handlerSuite) TestAddTaskDomainQuota() {
+	activityRequest := types.AddActivityTaskRequest{
+		DomainUUID: "test-domain-id",
+		TaskList:   &types.TaskList{Name: "test-task-list"},
+	}
+	decisionRequest := types.AddDecisionTaskRequest{
+		DomainUUID: "test-domain-id",
+		TaskList:   &types.TaskList{Name: "test-task-list"},
+	}
+
+	testCases := []struct {
+		name    string
+		allowed bool
+		call    func() error
+		engine  func()
+	}{
+		{
+			name:    "activity task within quota",
+			allowed: true,
+			call: func() error {
+				_, err := s.handler.AddActivityTask(context.Background(), &activityRequest)
+				return err
+			},
+			engine: func() {
+				s.mockEngine.EXPECT().AddActivityTask(gomock.Any(), &activityRequest).Return(&types.AddActivityTaskResponse{}, nil).Times(1)
+			},
+		},
+		{
+			name:    "activity task over quota",
+			allowed: false,
+			call: func() error {
+				_, err := s.handler.AddActivityTask(context.Background(), &activityRequest)
+				return err
+			},
+		},
+		{
+			name:    "decision task within quota",
+			allowed: true,
+			call: func() error {
+				_, err := s.handler.AddDecisionTask(context.Background(), &decisionRequest)
+				return err
+			},
+			engine: func() {
+				s.mockEngine.EXPECT().AddDecisionTask(gomock.Any(), &decisionRequest).Return(&types.AddDecisionTaskResponse{}, nil).Times(1)
+			},
+		},
+		{
+			name:    "decision task over quota",
+			allowed: false,
+			call: func() error {
+				_, err := s.handler.AddDecisionTask(context.Background(), &decisionRequest)
+				return err
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			domainLimiter := quotas.NewMockLimiter(s.controller)
+			s.mockDomainCache.EXPECT().GetDomainName("test-domain-id").Return(s.testDomain, nil).Times(1)
+			s.mockLimiter.EXPECT().Allow().Return(true).Times(1)
+			s.mockAddTaskLimiters.EXPECT().For(s.testDomain).Return(domainLimiter).Times(1)
+			domainLimiter.EXPECT().Allow().Return(tc.allowed).Times(1)
+			if tc.engine != nil {
+				tc.engine()
+			}
+
+			err := tc.call()
+
+			if tc.allowed {
+				s.NoError(err)
+			} else {
+				s.Equal(errDomainAddTaskThrottle, err)
+			}
+		})
+	}
+}
+
 func (s *handlerSuite) TestPollForActivityTask() {
 	request := types.MatchingPollForActivityTaskRequest{
 		DomainUUID:    "test-domain-id",
@@ -899,6 +981,302 @@ func (s *handlerSuite) TestUpdateTaskListPartitionConfig() {
 	}
 }
 
+func (s *handlerSuite) TestGetTaskListScalingRecommendation() {
+	request := types.MatchingGetTaskListScalingRecommendationRequest{
+		DomainUUID: "test-domain-id",
+		Request: &types.GetTaskListScalingRecommendationRequest{
+			Domain:   s.testDomain,
+			TaskList: &types.TaskList{Name: "test-task-list"},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		setupMocks func()
+		want       *types.GetTaskListScalingRecommendationResponse
+		err        error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockEngine.EXPECT().GetTaskListScalingRecommendation(gomock.Any(), &request).
+					Return(&types.GetTaskListScalingRecommendationResponse{RecommendedPollerCount: 4}, nil).Times(1)
+			},
+			want: &types.GetTaskListScalingRecommendationResponse{RecommendedPollerCount: 4},
+		},
+		{
+			name: "Error case - rate limiter not allowed",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(false).Times(1)
+			},
+			err: &types.ServiceBusyError{Message: "Matching host rps exceeded"},
+		},
+		{
+			name: "Error case - GetTaskListScalingRecommendation failed",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockEngine.EXPECT().GetTaskListScalingRecommendation(gomock.Any(), &request).
+					Return(nil, errors.New("scaling-recommendation-error")).Times(1)
+			},
+			err: &types.InternalServiceError{Message: "scaling-recommendation-error"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			s.mockDomainCache.EXPECT().GetDomainName(request.DomainUUID).Return(s.testDomain, nil).Times(1)
+
+			resp, err := s.handler.GetTaskListScalingRecommendation(context.Background(), &request)
+
+			if tc.err != nil {
+				s.Error(err)
+				s.Equal(tc.err, err)
+			} else {
+				s.NoError(err)
+				s.Equal(tc.want, resp)
+			}
+		})
+	}
+}
+
+func (s *handlerSuite) TestListWorkers() {
+	request := types.MatchingListWorkersRequest{
+		DomainUUID: "test-domain-id",
+		Request:    &types.ListWorkersRequest{Domain: s.testDomain},
+	}
+	workers := []*types.WorkerInfo{{Identity: "worker", PollsPerSecond: 1}}
+
+	testCases := []struct {
+		name       string
+		setupMocks func()
+		want       *types.ListWorkersResponse
+		err        error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockEngine.EXPECT().ListWorkers(gomock.Any(), &request).
+					Return(&types.ListWorkersResponse{Workers: workers}, nil).Times(1)
+			},
+			want: &types.ListWorkersResponse{Workers: workers},
+		},
+		{
+			name: "Error case - rate limiter not allowed",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(false).Times(1)
+			},
+			err: &types.ServiceBusyError{Message: "Matching host rps exceeded"},
+		},
+		{
+			name: "Error case - ListWorkers failed",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockEngine.EXPECT().ListWorkers(gomock.Any(), &request).
+					Return(nil, errors.New("list-workers-error")).Times(1)
+			},
+			err: &types.InternalServiceError{Message: "list-workers-error"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			s.mockDomainCache.EXPECT().GetDomainName(request.DomainUUID).Return(s.testDomain, nil).Times(1)
+
+			resp, err := s.handler.ListWorkers(context.Background(), &request)
+
+			if tc.err != nil {
+				s.Error(err)
+				s.Equal(tc.err, err)
+			} else {
+				s.NoError(err)
+				s.Equal(tc.want, resp)
+			}
+		})
+	}
+}
+
+func (s *handlerSuite) TestDescribeDomainTaskQuota() {
+	request := types.MatchingDescribeDomainTaskQuotaRequest{
+		DomainUUID: "test-domain-id",
+	}
+	taskQuota := &types.DomainTaskQuota{AddTaskRPSLimit: 100, AddTaskRPS: 10}
+
+	testCases := []struct {
+		name       string
+		setupMocks func()
+		want       *types.MatchingDescribeDomainTaskQuotaResponse
+		err        error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockEngine.EXPECT().DescribeDomainTaskQuota(gomock.Any(), &request).
+					Return(&types.MatchingDescribeDomainTaskQuotaResponse{TaskQuota: taskQuota}, nil).Times(1)
+			},
+			want: &types.MatchingDescribeDomainTaskQuotaResponse{TaskQuota: taskQuota},
+		},
+		{
+			name: "Error case - rate limiter not allowed",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(false).Times(1)
+			},
+			err: &types.ServiceBusyError{Message: "Matching host rps exceeded"},
+		},
+		{
+			name: "Error case - DescribeDomainTaskQuota failed",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockEngine.EXPECT().DescribeDomainTaskQuota(gomock.Any(), &request).
+					Return(nil, errors.New("describe-domain-task-quota-error")).Times(1)
+			},
+			err: &types.InternalServiceError{Message: "describe-domain-task-quota-error"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			s.mockDomainCache.EXPECT().GetDomainName(request.DomainUUID).Return(s.testDomain, nil).Times(1)
+
+			resp, err := s.handler.DescribeDomainTaskQuota(context.Background(), &request)
+
+			if tc.err != nil {
+				s.Error(err)
+				s.Equal(tc.err, err)
+			} else {
+				s.NoError(err)
+				s.Equal(tc.want, resp)
+			}
+		})
+	}
+}
+
+func (s *handlerSuite) TestDescribeWorker() {
+	request := types.MatchingDescribeWorkerRequest{
+		DomainUUID: "test-domain-id",
+		Request:    &types.DescribeWorkerRequest{Domain: s.testDomain, Identity: "worker"},
+	}
+	worker := &types.WorkerInfo{Identity: "worker", PollsPerSecond: 1}
+
+	testCases := []struct {
+		name       string
+		setupMocks func()
+		want       *types.DescribeWorkerResponse
+		err        error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockEngine.EXPECT().DescribeWorker(gomock.Any(), &request).
+					Return(&types.DescribeWorkerResponse{Worker: worker}, nil).Times(1)
+			},
+			want: &types.DescribeWorkerResponse{Worker: worker},
+		},
+		{
+			name: "Error case - rate limiter not allowed",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(false).Times(1)
+			},
+			err: &types.ServiceBusyError{Message: "Matching host rps exceeded"},
+		},
+		{
+			name: "Error case - DescribeWorker failed",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockEngine.EXPECT().DescribeWorker(gomock.Any(), &request).
+					Return(nil, errors.New("describe-worker-error")).Times(1)
+			},
+			err: &types.InternalServiceError{Message: "describe-worker-error"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			s.mockDomainCache.EXPECT().GetDomainName(request.DomainUUID).Return(s.testDomain, nil).Times(1)
+
+			resp, err := s.handler.DescribeWorker(context.Background(), &request)
+
+			if tc.err != nil {
+				s.Error(err)
+				s.Equal(tc.err, err)
+			} else {
+				s.NoError(err)
+				s.Equal(tc.want, resp)
+			}
+		})
+	}
+}
+
+func (s *handlerSuite) TestMigrateTaskList() {
+	request := types.MatchingMigrateTaskListRequest{
+		DomainUUID: "test-domain-id",
+		MigrateRequest: &types.MigrateTaskListRequest{
+			Domain:         s.testDomain,
+			TaskList:       &types.TaskList{Name: "test-tasklist"},
+			TaskListType:   types.TaskListTypeActivity.Ptr(),
+			TargetTaskList: &types.TaskList{Name: "target-tasklist"},
+		},
+	}
+	migrationConfig := &types.TaskListMigrationConfig{TargetTaskList: "target-tasklist"}
+
+	testCases := []struct {
+		name       string
+		setupMocks func()
+		want       *types.MigrateTaskListResponse
+		err        error
+	}{
+		{
+			name: "Success case",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockEngine.EXPECT().MigrateTaskList(gomock.Any(), &request).
+					Return(&types.MigrateTaskListResponse{MigrationConfig: migrationConfig}, nil).Times(1)
+			},
+			want: &types.MigrateTaskListResponse{MigrationConfig: migrationConfig},
+		},
+		{
+			name: "Error case - rate limiter not allowed",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(false).Times(1)
+			},
+			err: &types.ServiceBusyError{Message: "Matching host rps exceeded"},
+		},
+		{
+			name: "Error case - MigrateTaskList failed",
+			setupMocks: func() {
+				s.mockLimiter.EXPECT().Allow().Return(true).Times(1)
+				s.mockEngine.EXPECT().MigrateTaskList(gomock.Any(), &request).
+					Return(nil, errors.New("migrate-task-list-error")).Times(1)
+			},
+			err: &types.InternalServiceError{Message: "migrate-task-list-error"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			s.mockDomainCache.EXPECT().GetDomainName(request.DomainUUID).Return(s.testDomain, nil).Times(1)
+
+			resp, err := s.handler.MigrateTaskList(context.Background(), &request)
+
+			if tc.err != nil {
+				s.Error(err)
+				s.Equal(tc.err, err)
+			} else {
+				s.NoError(err)
+				s.Equal(tc.want, resp)
+			}
+		})
+	}
+}
+
 func partitions(num int) map[int]*types.TaskListPartition {
 	result := make(map[int]*types.TaskListPartition, num)
 	for i := 0; i < num; i++ {
@@ -28,6 +28,8 @@ import (
 	"context"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/quotas/global/collection"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -48,6 +50,12 @@ type (
 		GetTaskListsByDomain(hCtx *handlerContext, request *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error)
 		UpdateTaskListPartitionConfig(hCtx *handlerContext, request *types.MatchingUpdateTaskListPartitionConfigRequest) (*types.MatchingUpdateTaskListPartitionConfigResponse, error)
 		RefreshTaskListPartitionConfig(hCtx *handlerContext, request *types.MatchingRefreshTaskListPartitionConfigRequest) (*types.MatchingRefreshTaskListPartitionConfigResponse, error)
+		UpdateTaskListVersioningConfig(hCtx *handlerContext, request *types.MatchingUpdateTaskListVersioningConfigRequest) (*types.MatchingUpdateTaskListVersioningConfigResponse, error)
+		GetTaskListScalingRecommendation(hCtx *handlerContext, request *types.MatchingGetTaskListScalingRecommendationRequest) (*types.GetTaskListScalingRecommendationResponse, error)
+		ListWorkers(hCtx *handlerContext, request *types.MatchingListWorkersRequest) (*types.ListWorkersResponse, error)
+		DescribeWorker(hCtx *handlerContext, request *types.MatchingDescribeWorkerRequest) (*types.DescribeWorkerResponse, error)
+		MigrateTaskList(hCtx *handlerContext, request *types.MatchingMigrateTaskListRequest) (*types.MigrateTaskListResponse, error)
+		DescribeDomainTaskQuota(hCtx *handlerContext, request *types.MatchingDescribeDomainTaskQuotaRequest) (*types.MatchingDescribeDomainTaskQuotaResponse, error)
 	}
 
 	// Handler interface for matching service
@@ -67,5 +75,18 @@ type (
 		RespondQueryTaskCompleted(context.Context, *types.MatchingRespondQueryTaskCompletedRequest) error
 		UpdateTaskListPartitionConfig(context.Context, *types.MatchingUpdateTaskListPartitionConfigRequest) (*types.MatchingUpdateTaskListPartitionConfigResponse, error)
 		RefreshTaskListPartitionConfig(context.Context, *types.MatchingRefreshTaskListPartitionConfigRequest) (*types.MatchingRefreshTaskListPartitionConfigResponse, error)
+		UpdateTaskListVersioningConfig(context.Context, *types.MatchingUpdateTaskListVersioningConfigRequest) (*types.MatchingUpdateTaskListVersioningConfigResponse, error)
+		GetTaskListScalingRecommendation(context.Context, *types.MatchingGetTaskListScalingRecommendationRequest) (*types.GetTaskListScalingRecommendationResponse, error)
+		ListWorkers(context.Context, *types.MatchingListWorkersRequest) (*types.ListWorkersResponse, error)
+		DescribeWorker(context.Context, *types.MatchingDescribeWorkerRequest) (*types.DescribeWorkerResponse, error)
+		MigrateTaskList(context.Context, *types.MatchingMigrateTaskListRequest) (*types.MigrateTaskListResponse, error)
+		DescribeDomainTaskQuota(context.Context, *types.MatchingDescribeDomainTaskQuotaRequest) (*types.MatchingDescribeDomainTaskQuotaResponse, error)
+	}
+
+	// DomainTaskQuota is a domain-wide task quota keyed by domain name, which also reports its recent usage on this host.
+	// It is implemented by the global ratelimiter collections.
+	DomainTaskQuota interface {
+		For(domainName string) quotas.Limiter
+		Usage(domainName string) (collection.Usage, bool)
 	}
 )
@@ -15,6 +15,8 @@ import (
 
 	gomock "go.uber.org/mock/gomock"
 
+	quotas "github.com/uber/cadence/common/quotas"
+	collection "github.com/uber/cadence/common/quotas/global/collection"
 	types "github.com/uber/cadence/common/types"
 )
 
@@ -86,6 +88,21 @@ func (mr *MockEngineMockRecorder) CancelOutstandingPoll(hCtx, request any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOutstandingPoll", reflect.TypeOf((*MockEngine)(nil).CancelOutstandingPoll), hCtx, request)
 }
 
+// DescribeDomainTaskQuota mocks base method.
+func (m *MockEngine) DescribeDomainTaskQuota(hCtx *handlerContext, request *types.MatchingDescribeDomainTaskQuotaRequest) (*types.MatchingDescribeDomainTaskQuotaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDomainTaskQuota", hCtx, request)
+	ret0, _ := ret[0].(*types.MatchingDescribeDomainTaskQuotaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDomainTaskQuota indicates an expected call of DescribeDomainTaskQuota.
+func (mr *MockEngineMockRecorder) DescribeDomainTaskQuota(hCtx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDomainTaskQuota", reflect.TypeOf((*MockEngine)(nil).DescribeDomainTaskQuota), hCtx, request)
+}
+
 // DescribeTaskList mocks base method.
 func (m *MockEngine) DescribeTaskList(hCtx *handlerContext, request *types.MatchingDescribeTaskListRequest) (*types.DescribeTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -101,6 +118,36 @@ func (mr *MockEngineMockRecorder) DescribeTaskList(hCtx, request any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskList", reflect.TypeOf((*MockEngine)(nil).DescribeTaskList), hCtx, request)
 }
 
+// DescribeWorker mocks base method.
+func (m *MockEngine) DescribeWorker(hCtx *handlerContext, request *types.MatchingDescribeWorkerRequest) (*types.DescribeWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeWorker", hCtx, request)
+	ret0, _ := ret[0].(*types.DescribeWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorker indicates an expected call of DescribeWorker.
+func (mr *MockEngineMockRecorder) DescribeWorker(hCtx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorker", reflect.TypeOf((*MockEngine)(nil).DescribeWorker), hCtx, request)
+}
+
+// GetTaskListScalingRecommendation mocks base method.
+func (m *MockEngine) GetTaskListScalingRecommendation(hCtx *handlerContext, request *types.MatchingGetTaskListScalingRecommendationRequest) (*types.GetTaskListScalingRecommendationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskListScalingRecommendation", hCtx, request)
+	ret0, _ := ret[0].(*types.GetTaskListScalingRecommendationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskListScalingRecommendation indicates an expected call of GetTaskListScalingRecommendation.
+func (mr *MockEngineMockRecorder) GetTaskListScalingRecommendation(hCtx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListScalingRecommendation", reflect.TypeOf((*MockEngine)(nil).GetTaskListScalingRecommendation), hCtx, request)
+}
+
 // GetTaskListsByDomain mocks base method.
 func (m *MockEngine) GetTaskListsByDomain(hCtx *handlerContext, request *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error) {
 	m.ctrl.T.Helper()
@@ -131,6 +178,36 @@ func (mr *MockEngineMockRecorder) ListTaskListPartitions(hCtx, request any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskListPartitions", reflect.TypeOf((*MockEngine)(nil).ListTaskListPartitions), hCtx, request)
 }
 
+// ListWorkers mocks base method.
+func (m *MockEngine) ListWorkers(hCtx *handlerContext, request *types.MatchingListWorkersRequest) (*types.ListWorkersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkers", hCtx, request)
+	ret0, _ := ret[0].(*types.ListWorkersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkers indicates an expected call of ListWorkers.
+func (mr *MockEngineMockRecorder) ListWorkers(hCtx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkers", reflect.TypeOf((*MockEngine)(nil).ListWorkers), hCtx, request)
+}
+
+// MigrateTaskList mocks base method.
+func (m *MockEngine) MigrateTaskList(hCtx *handlerContext, request *types.MatchingMigrateTaskListRequest) (*types.MigrateTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateTaskList", hCtx, request)
+	ret0, _ := ret[0].(*types.MigrateTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateTaskList indicates an expected call of MigrateTaskList.
+func (mr *MockEngineMockRecorder) MigrateTaskList(hCtx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateTaskList", reflect.TypeOf((*MockEngine)(nil).MigrateTaskList), hCtx, request)
+}
+
 // PollForActivityTask mocks base method.
 func (m *MockEngine) PollForActivityTask(hCtx *handlerContext, request *types.MatchingPollForActivityTaskRequest) (*types.MatchingPollForActivityTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -244,6 +321,21 @@ func (mr *MockEngineMockRecorder) UpdateTaskListPartitionConfig(hCtx, request an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListPartitionConfig", reflect.TypeOf((*MockEngine)(nil).UpdateTaskListPartitionConfig), hCtx, request)
 }
 
+// UpdateTaskListVersioningConfig mocks base method.
+func (m *MockEngine) UpdateTaskListVersioningConfig(hCtx *handlerContext, request *types.MatchingUpdateTaskListVersioningConfigRequest) (*types.MatchingUpdateTaskListVersioningConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskListVersioningConfig", hCtx, request)
+	ret0, _ := ret[0].(*types.MatchingUpdateTaskListVersioningConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskListVersioningConfig indicates an expected call of UpdateTaskListVersioningConfig.
+func (mr *MockEngineMockRecorder) UpdateTaskListVersioningConfig(hCtx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListVersioningConfig", reflect.TypeOf((*MockEngine)(nil).UpdateTaskListVersioningConfig), hCtx, request)
+}
+
 // MockHandler is a mock of Handler interface.
 type MockHandler struct {
 	ctrl     *gomock.Controller
@@ -312,6 +404,21 @@ func (mr *MockHandlerMockRecorder) CancelOutstandingPoll(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOutstandingPoll", reflect.TypeOf((*MockHandler)(nil).CancelOutstandingPoll), arg0, arg1)
 }
 
+// DescribeDomainTaskQuota mocks base method.
+func (m *MockHandler) DescribeDomainTaskQuota(arg0 context.Context, arg1 *types.MatchingDescribeDomainTaskQuotaRequest) (*types.MatchingDescribeDomainTaskQuotaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeDomainTaskQuota", arg0, arg1)
+	ret0, _ := ret[0].(*types.MatchingDescribeDomainTaskQuotaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeDomainTaskQuota indicates an expected call of DescribeDomainTaskQuota.
+func (mr *MockHandlerMockRecorder) DescribeDomainTaskQuota(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDomainTaskQuota", reflect.TypeOf((*MockHandler)(nil).DescribeDomainTaskQuota), arg0, arg1)
+}
+
 // DescribeTaskList mocks base method.
 func (m *MockHandler) DescribeTaskList(arg0 context.Context, arg1 *types.MatchingDescribeTaskListRequest) (*types.DescribeTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -327,6 +434,36 @@ func (mr *MockHandlerMockRecorder) DescribeTaskList(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskList", reflect.TypeOf((*MockHandler)(nil).DescribeTaskList), arg0, arg1)
 }
 
+// DescribeWorker mocks base method.
+func (m *MockHandler) DescribeWorker(arg0 context.Context, arg1 *types.MatchingDescribeWorkerRequest) (*types.DescribeWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeWorker", arg0, arg1)
+	ret0, _ := ret[0].(*types.DescribeWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorker indicates an expected call of DescribeWorker.
+func (mr *MockHandlerMockRecorder) DescribeWorker(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorker", reflect.TypeOf((*MockHandler)(nil).DescribeWorker), arg0, arg1)
+}
+
+// GetTaskListScalingRecommendation mocks base method.
+func (m *MockHandler) GetTaskListScalingRecommendation(arg0 context.Context, arg1 *types.MatchingGetTaskListScalingRecommendationRequest) (*types.GetTaskListScalingRecommendationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskListScalingRecommendation", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetTaskListScalingRecommendationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskListScalingRecommendation indicates an expected call of GetTaskListScalingRecommendation.
+func (mr *MockHandlerMockRecorder) GetTaskListScalingRecommendation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListScalingRecommendation", reflect.TypeOf((*MockHandler)(nil).GetTaskListScalingRecommendation), arg0, arg1)
+}
+
 // GetTaskListsByDomain mocks base method.
 func (m *MockHandler) GetTaskListsByDomain(arg0 context.Context, arg1 *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error) {
 	m.ctrl.T.Helper()
@@ -372,6 +509,36 @@ func (mr *MockHandlerMockRecorder) ListTaskListPartitions(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskListPartitions", reflect.TypeOf((*MockHandler)(nil).ListTaskListPartitions), arg0, arg1)
 }
 
+// ListWorkers mocks base method.
+func (m *MockHandler) ListWorkers(arg0 context.Context, arg1 *types.MatchingListWorkersRequest) (*types.ListWorkersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkers", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListWorkersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkers indicates an expected call of ListWorkers.
+func (mr *MockHandlerMockRecorder) ListWorkers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkers", reflect.TypeOf((*MockHandler)(nil).ListWorkers), arg0, arg1)
+}
+
+// MigrateTaskList mocks base method.
+func (m *MockHandler) MigrateTaskList(arg0 context.Context, arg1 *types.MatchingMigrateTaskListRequest) (*types.MigrateTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateTaskList", arg0, arg1)
+	ret0, _ := ret[0].(*types.MigrateTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateTaskList indicates an expected call of MigrateTaskList.
+func (mr *MockHandlerMockRecorder) MigrateTaskList(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateTaskList", reflect.TypeOf((*MockHandler)(nil).MigrateTaskList), arg0, arg1)
+}
+
 // PollForActivityTask mocks base method.
 func (m *MockHandler) PollForActivityTask(arg0 context.Context, arg1 *types.MatchingPollForActivityTaskRequest) (*types.MatchingPollForActivityTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -484,3 +651,71 @@ func (mr *MockHandlerMockRecorder) UpdateTaskListPartitionConfig(arg0, arg1 any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListPartitionConfig", reflect.TypeOf((*MockHandler)(nil).UpdateTaskListPartitionConfig), arg0, arg1)
 }
+
+// UpdateTaskListVersioningConfig mocks base method.
+func (m *MockHandler) UpdateTaskListVersioningConfig(arg0 context.Context, arg1 *types.MatchingUpdateTaskListVersioningConfigRequest) (*types.MatchingUpdateTaskListVersioningConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskListVersioningConfig", arg0, arg1)
+	ret0, _ := ret[0].(*types.MatchingUpdateTaskListVersioningConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskListVersioningConfig indicates an expected call of UpdateTaskListVersioningConfig.
+func (mr *MockHandlerMockRecorder) UpdateTaskListVersioningConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListVersioningConfig", reflect.TypeOf((*MockHandler)(nil).UpdateTaskListVersioningConfig), arg0, arg1)
+}
+
+// MockDomainTaskQuota is a mock of DomainTaskQuota interface.
+type MockDomainTaskQuota struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainTaskQuotaMockRecorder
+	isgomock struct{}
+}
+
+// MockDomainTaskQuotaMockRecorder is the mock recorder for MockDomainTaskQuota.
+type MockDomainTaskQuotaMockRecorder struct {
+	mock *MockDomainTaskQuota
+}
+
+// NewMockDomainTaskQuota creates a new mock instance.
+func NewMockDomainTaskQuota(ctrl *gomock.Controller) *MockDomainTaskQuota {
+	mock := &MockDomainTaskQuota{ctrl: ctrl}
+	mock.recorder = &MockDomainTaskQuotaMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDomainTaskQuota) EXPECT() *MockDomainTaskQuotaMockRecorder {
+	return m.recorder
+}
+
+// For mocks base method.
+func (m *MockDomainTaskQuota) For(domainName string) quotas.Limiter {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "For", domainName)
+	ret0, _ := ret[0].(quotas.Limiter)
+	return ret0
+}
+
+// For indicates an expected call of For.
+func (mr *MockDomainTaskQuotaMockRecorder) For(domainName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "For", reflect.TypeOf((*MockDomainTaskQuota)(nil).For), domainName)
+}
+
+// Usage mocks base method.
+func (m *MockDomainTaskQuota) Usage(domainName string) (collection.Usage, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Usage", domainName)
+	ret0, _ := ret[0].(collection.Usage)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Usage indicates an expected call of Usage.
+func (mr *MockDomainTaskQuotaMockRecorder) Usage(domainName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Usage", reflect.TypeOf((*MockDomainTaskQuota)(nil).Usage), domainName)
+}
@@ -136,7 +136,7 @@ func (e *matchingEngineImpl) getNonOwnedTasklistsLocked() ([]tasklist.Manager, e
 	}
 
 	for tl, manager := range e.taskLists {
-		taskListOwner, err := e.membershipResolver.Lookup(service.Matching, tl.GetName())
+		taskListOwner, err := e.membershipResolver.Lookup(service.Matching, tl.GetPartitionName())
 		if err != nil {
 			return nil, fmt.Errorf("failed to lookup task list owner: %w", err)
 		}
@@ -130,6 +130,8 @@ func TestGetTaskListManager_OwnerShip(t *testing.T) {
 				resolverMock,
 				nil,
 				mockTimeSource,
+				nil,
+				nil,
 			).(*matchingEngineImpl)
 
 			resolverMock.EXPECT().Lookup(gomock.Any(), gomock.Any()).Return(
@@ -42,6 +42,7 @@ type (
 		Identity       string
 		RatePerSecond  float64
 		IsolationGroup string
+		BuildID        string
 	}
 
 	Manager interface {
@@ -52,6 +53,7 @@ type (
 		HasPollerAfter(after time.Time) bool
 		GetCount() int
 		GetCountByIsolationGroup(after time.Time) map[string]int
+		GetCountByBuildID(after time.Time) map[string]int
 		ListInfo() []*types.PollerInfo
 	}
 
@@ -205,6 +207,18 @@ func (m *manager) GetCountByIsolationGroup(after time.Time) map[string]int {
 	return groupSet
 }
 
+func (m *manager) GetCountByBuildID(after time.Time) map[string]int {
+	buildSet := make(map[string]int)
+
+	m.forEachPoller(after, func(identity string, info *Info, lastAccessTime time.Time) {
+		if info.BuildID != "" {
+			buildSet[info.BuildID]++
+		}
+	})
+
+	return buildSet
+}
+
 func (m *manager) ListInfo() []*types.PollerInfo {
 	var result []*types.PollerInfo
 	// optimistic size get, it can change before Iterator call.
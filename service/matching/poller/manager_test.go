@@ -403,3 +403,52 @@ func TestManager_GetCountByIsolationGroup(t *testing.T) {
 		})
 	}
 }
+
+func TestManager_GetCountByBuildID(t *testing.T) {
+	startTime := time.Date(2024, time.October, 28, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name   string
+		fn     func(mockTime clock.MockedTimeSource, m Manager)
+		after  time.Time
+		result map[string]int
+	}{
+		{
+			name: "happy path",
+			fn: func(mockTime clock.MockedTimeSource, m Manager) {
+				m.StartPoll("a", NoopFunc, &Info{Identity: "aIdent", BuildID: "1.0"})
+				m.EndPoll("a")
+				m.StartPoll("b", NoopFunc, &Info{Identity: "bIdent", BuildID: "1.0"})
+				mockTime.Advance(time.Minute) // t = 1m
+				m.EndPoll("b")
+				m.StartPoll("c", NoopFunc, &Info{Identity: "cIdent", BuildID: "2.0"})
+				m.StartPoll("d", NoopFunc, &Info{Identity: "dIdent"})
+			},
+			result: map[string]int{
+				"1.0": 2,
+				"2.0": 1,
+			},
+		},
+		{
+			name: "some expired",
+			fn: func(mockTime clock.MockedTimeSource, m Manager) {
+				m.StartPoll("a", NoopFunc, &Info{Identity: "aIdent", BuildID: "1.0"})
+				m.EndPoll("a")
+				mockTime.Advance(time.Minute) // t = 1m
+				m.StartPoll("b", NoopFunc, &Info{Identity: "bIdent", BuildID: "2.0"})
+				m.EndPoll("b")
+			},
+			after: startTime.Add(time.Minute - time.Nanosecond),
+			result: map[string]int{
+				"2.0": 1,
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockTime := clock.NewMockedTimeSourceAt(startTime)
+			m := NewPollerManager(NoopFunc, mockTime)
+			tc.fn(mockTime, m)
+			assert.Equal(t, tc.result, m.GetCountByBuildID(tc.after))
+		})
+	}
+}
@@ -21,12 +21,20 @@
 package matching
 
 import (
+	"context"
+	"fmt"
 	"sync/atomic"
 	"time"
 
+	"go.uber.org/multierr"
+
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/quotas/global/collection"
+	"github.com/uber/cadence/common/quotas/permember"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/service/matching/config"
@@ -39,10 +47,16 @@ import (
 type Service struct {
 	resource.Resource
 
-	status  int32
-	handler handler.Handler
-	stopC   chan struct{}
-	config  *config.Config
+	status                 int32
+	handler                handler.Handler
+	stopC                  chan struct{}
+	config                 *config.Config
+	ratelimiterCollections globalRatelimiterCollections
+}
+
+type globalRatelimiterCollections struct {
+	add      *collection.Collection
+	dispatch *collection.Collection
 }
 
 // NewService builds a new cadence-matching service
@@ -92,6 +106,11 @@ func (s *Service) Start() {
 	logger := s.GetLogger()
 	logger.Info("matching starting")
 
+	collections, err := s.createGlobalQuotaCollections()
+	if err != nil {
+		logger.Fatal("constructing ratelimiter collections", tag.Error(err))
+	}
+
 	engine := handler.NewEngine(
 		s.GetTaskManager(),
 		s.GetClusterMetadata(),
@@ -104,9 +123,11 @@ func (s *Service) Start() {
 		s.GetMembershipResolver(),
 		s.GetIsolationGroupState(),
 		s.GetTimeSource(),
+		collections.add,
+		collections.dispatch,
 	)
 
-	s.handler = handler.NewHandler(engine, s.config, s.GetDomainCache(), s.GetMetricsClient(), s.GetLogger(), s.GetThrottledLogger())
+	s.handler = handler.NewHandler(engine, s.config, s.GetDomainCache(), s.GetMetricsClient(), s.GetLogger(), s.GetThrottledLogger(), collections.add)
 
 	thriftHandler := thrift.NewThriftHandler(s.handler)
 	thriftHandler.Register(s.GetDispatcher())
@@ -116,6 +137,18 @@ func (s *Service) Start() {
 
 	// must start base service first
 	s.Resource.Start()
+
+	startCtx, cancel := context.WithTimeout(context.Background(), time.Second) // should take nearly no time at all
+	defer cancel()
+	if err := collections.add.OnStart(startCtx); err != nil {
+		logger.Fatal("failed to start add global ratelimiter collection", tag.Error(err))
+	}
+	if err := collections.dispatch.OnStart(startCtx); err != nil {
+		logger.Fatal("failed to start dispatch global ratelimiter collection", tag.Error(err))
+	}
+	cancel()
+	s.ratelimiterCollections = collections // save so they can be stopped later
+
 	s.handler.Start()
 
 	logger.Info("matching started")
@@ -138,7 +171,66 @@ func (s *Service) Stop() {
 	close(s.stopC)
 
 	s.handler.Stop()
+	s.stopRatelimiters()
 	s.Resource.Stop()
 
 	s.GetLogger().Info("matching stopped")
 }
+
+func (s *Service) createGlobalQuotaCollections() (globalRatelimiterCollections, error) {
+	create := func(name string, targetRPS dynamicproperties.IntPropertyFnWithDomainFilter) (*collection.Collection, error) {
+		// to safely shadow global ratelimits, local and global limiters must not share data
+		// when the global limiter decides to use its local fallback.
+		c, err := collection.New(
+			name,
+			s.createBaseLimiter(targetRPS),
+			s.createBaseLimiter(targetRPS),
+			s.config.GlobalRatelimiterUpdateInterval,
+			targetRPS,
+			s.config.GlobalRatelimiterKeyMode,
+			s.GetRatelimiterAggregatorsClient(),
+			s.GetLogger(),
+			s.GetMetricsClient(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating %v collection: %w", name, err)
+		}
+		return c, nil
+	}
+	var combinedErr error
+
+	add, err := create("add", s.config.GlobalDomainAddTaskRPS)
+	combinedErr = multierr.Combine(combinedErr, err)
+
+	dispatch, err := create("dispatch", s.config.GlobalDomainDispatchRPS)
+	combinedErr = multierr.Combine(combinedErr, err)
+
+	return globalRatelimiterCollections{
+		add:      add,
+		dispatch: dispatch,
+	}, combinedErr
+}
+
+func (s *Service) createBaseLimiter(globalRPS dynamicproperties.IntPropertyFnWithDomainFilter) *quotas.Collection {
+	// there is no separate per-instance limit, so the fallback is the whole domain quota
+	return quotas.NewCollection(permember.NewPerMemberDynamicRateLimiterFactory(
+		service.Matching,
+		globalRPS,
+		globalRPS,
+		s.GetMembershipResolver(),
+	))
+}
+
+func (s *Service) stopRatelimiters() {
+	if s.ratelimiterCollections.add == nil {
+		return // not started
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second) // should take nearly no time at all
+	defer cancel()
+	if err := s.ratelimiterCollections.add.OnStop(ctx); err != nil {
+		s.GetLogger().Error("failed to stop add global ratelimiter collection", tag.Error(err))
+	}
+	if err := s.ratelimiterCollections.dispatch.OnStop(ctx); err != nil {
+		s.GetLogger().Error("failed to stop dispatch global ratelimiter collection", tag.Error(err))
+	}
+}
@@ -33,17 +33,19 @@ import (
 type (
 	taskListDB struct {
 		sync.RWMutex
-		domainID        string
-		domainName      string
-		taskListName    string
-		taskListKind    int
-		taskType        int
-		rangeID         int64
-		backlogCount    int64
-		ackLevel        int64
-		partitionConfig *persistence.TaskListPartitionConfig
-		store           persistence.TaskManager
-		logger          log.Logger
+		domainID         string
+		domainName       string
+		taskListName     string
+		taskListKind     int
+		taskType         int
+		rangeID          int64
+		backlogCount     int64
+		ackLevel         int64
+		partitionConfig  *persistence.TaskListPartitionConfig
+		versioningConfig *persistence.TaskListVersioningConfig
+		migrationConfig  *persistence.TaskListMigrationConfig
+		store            persistence.TaskManager
+		logger           log.Logger
 	}
 	taskListState struct {
 		rangeID  int64
@@ -91,6 +93,18 @@ func (db *taskListDB) PartitionConfig() *persistence.TaskListPartitionConfig {
 	return db.partitionConfig
 }
 
+func (db *taskListDB) VersioningConfig() *persistence.TaskListVersioningConfig {
+	db.RLock()
+	defer db.RUnlock()
+	return db.versioningConfig
+}
+
+func (db *taskListDB) MigrationConfig() *persistence.TaskListMigrationConfig {
+	db.RLock()
+	defer db.RUnlock()
+	return db.migrationConfig
+}
+
 // RenewLease renews the lease on a tasklist. If there is no previous lease,
 // this method will attempt to steal tasklist from current owner
 func (db *taskListDB) RenewLease() (taskListState, error) {
@@ -110,6 +124,8 @@ func (db *taskListDB) RenewLease() (taskListState, error) {
 	db.rangeID = resp.TaskListInfo.RangeID
 	db.ackLevel = resp.TaskListInfo.AckLevel
 	db.partitionConfig = resp.TaskListInfo.AdaptivePartitionConfig
+	db.versioningConfig = resp.TaskListInfo.VersioningConfig
+	db.migrationConfig = resp.TaskListInfo.MigrationConfig
 	return taskListState{rangeID: db.rangeID, ackLevel: resp.TaskListInfo.AckLevel}, nil
 }
 
@@ -126,6 +142,8 @@ func (db *taskListDB) UpdateState(ackLevel int64) error {
 			RangeID:                 db.rangeID,
 			Kind:                    db.taskListKind,
 			AdaptivePartitionConfig: db.partitionConfig,
+			VersioningConfig:        db.versioningConfig,
+			MigrationConfig:         db.migrationConfig,
 		},
 		DomainName: db.domainName,
 	})
@@ -148,6 +166,8 @@ func (db *taskListDB) UpdateTaskListPartitionConfig(partitionConfig *persistence
 			RangeID:                 db.rangeID,
 			Kind:                    db.taskListKind,
 			AdaptivePartitionConfig: partitionConfig,
+			VersioningConfig:        db.versioningConfig,
+			MigrationConfig:         db.migrationConfig,
 		},
 		DomainName: db.domainName,
 	})
@@ -158,6 +178,54 @@ func (db *taskListDB) UpdateTaskListPartitionConfig(partitionConfig *persistence
 	return nil
 }
 
+func (db *taskListDB) UpdateTaskListVersioningConfig(versioningConfig *persistence.TaskListVersioningConfig) error {
+	db.Lock()
+	defer db.Unlock()
+	_, err := db.store.UpdateTaskList(context.Background(), &persistence.UpdateTaskListRequest{
+		TaskListInfo: &persistence.TaskListInfo{
+			DomainID:                db.domainID,
+			Name:                    db.taskListName,
+			TaskType:                db.taskType,
+			AckLevel:                db.ackLevel,
+			RangeID:                 db.rangeID,
+			Kind:                    db.taskListKind,
+			AdaptivePartitionConfig: db.partitionConfig,
+			VersioningConfig:        versioningConfig,
+			MigrationConfig:         db.migrationConfig,
+		},
+		DomainName: db.domainName,
+	})
+	if err != nil {
+		return err
+	}
+	db.versioningConfig = versioningConfig
+	return nil
+}
+
+func (db *taskListDB) UpdateTaskListMigrationConfig(migrationConfig *persistence.TaskListMigrationConfig) error {
+	db.Lock()
+	defer db.Unlock()
+	_, err := db.store.UpdateTaskList(context.Background(), &persistence.UpdateTaskListRequest{
+		TaskListInfo: &persistence.TaskListInfo{
+			DomainID:                db.domainID,
+			Name:                    db.taskListName,
+			TaskType:                db.taskType,
+			AckLevel:                db.ackLevel,
+			RangeID:                 db.rangeID,
+			Kind:                    db.taskListKind,
+			AdaptivePartitionConfig: db.partitionConfig,
+			VersioningConfig:        db.versioningConfig,
+			MigrationConfig:         migrationConfig,
+		},
+		DomainName: db.domainName,
+	})
+	if err != nil {
+		return err
+	}
+	db.migrationConfig = migrationConfig
+	return nil
+}
+
 // CreateTasks creates a batch of given tasks for this task list
 func (db *taskListDB) CreateTasks(tasks []*persistence.CreateTaskInfo) (*persistence.CreateTasksResponse, error) {
 	db.Lock()
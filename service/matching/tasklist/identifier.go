@@ -39,9 +39,10 @@ type (
 	}
 	// qualifiedTaskListName refers to the fully qualified task list name
 	qualifiedTaskListName struct {
-		name      string // internal name of the tasks list
-		baseName  string // original name of the task list as specified by user
-		partition int    // partitionID of task list
+		name       string // internal name of the tasks list
+		baseName   string // original name of the task list as specified by user
+		partition  int    // partitionID of task list
+		versionSet string // ID of the compatible build ID set served by this task list, empty if unversioned
 	}
 )
 
@@ -60,6 +61,13 @@ type (
 // optimization to allow for partitioned task lists to dispatch tasks with low latency when
 // throughput is low - See https://github.com/uber/cadence/issues/2098
 //
+// When worker build ID versioning is enabled on a task list, decision tasks of each compatible
+// set of build IDs are routed to a versioned task list of the partition with the internal name
+//
+//	/__cadence_sys/__build/[set-id]/[partition-name]
+//
+// Versioned task lists are never partitioned further and don't forward to a parent.
+//
 // Returns error if the given name is non-compliant with the required format
 // for task list names
 func newTaskListName(name string) (qualifiedTaskListName, error) {
@@ -92,14 +100,35 @@ func (tn *qualifiedTaskListName) GetName() string {
 	return tn.name
 }
 
+// IsVersioned returns true if this task list serves a compatible set of worker build IDs
+func (tn *qualifiedTaskListName) IsVersioned() bool {
+	return tn.versionSet != ""
+}
+
+// VersionSet returns the ID of the compatible set served by this task list
+func (tn *qualifiedTaskListName) VersionSet() string {
+	return tn.versionSet
+}
+
+// GetPartitionName returns the name of the partition this task list belongs to. It's the same as
+// the name of the task list unless the task list is versioned
+func (tn *qualifiedTaskListName) GetPartitionName() string {
+	return tn.GetPartition(tn.partition)
+}
+
+// GetVersionedName returns the name of the task list serving the given compatible set in this partition
+func (tn *qualifiedTaskListName) GetVersionedName(versionSet string) string {
+	return fmt.Sprintf("%v%v/%v", constants.ReservedVersionedTaskListPrefix, versionSet, tn.GetPartitionName())
+}
+
 // Parent returns the name of the parent task list
 // input:
 //
 //	degree: Number of children at each level of the tree
 //
-// Returns empty string if this task list is the root
+// Returns empty string if this task list is the root or a versioned task list
 func (tn *qualifiedTaskListName) Parent(degree int) string {
-	if tn.IsRoot() || degree == 0 {
+	if tn.IsRoot() || tn.IsVersioned() || degree == 0 {
 		return ""
 	}
 	pid := (tn.partition+degree-1)/degree - 1
@@ -114,6 +143,10 @@ func (tn *qualifiedTaskListName) GetPartition(partition int) string {
 }
 
 func (tn *qualifiedTaskListName) init() error {
+	if strings.HasPrefix(tn.name, constants.ReservedVersionedTaskListPrefix) &&
+		strings.Contains(tn.name[len(constants.ReservedVersionedTaskListPrefix):], "/") {
+		return tn.initVersioned()
+	}
 	if !strings.HasPrefix(tn.name, constants.ReservedTaskListPrefix) {
 		return nil
 	}
@@ -133,6 +166,22 @@ func (tn *qualifiedTaskListName) init() error {
 	return nil
 }
 
+func (tn *qualifiedTaskListName) initVersioned() error {
+	suffix := tn.name[len(constants.ReservedVersionedTaskListPrefix):]
+	setOff := strings.Index(suffix, "/")
+	if setOff <= 0 {
+		return fmt.Errorf("invalid versioned task list name %v", tn.name)
+	}
+	partition, err := newTaskListName(suffix[setOff+1:])
+	if err != nil || partition.IsVersioned() || partition.name == "" {
+		return fmt.Errorf("invalid versioned task list name %v", tn.name)
+	}
+	tn.baseName = partition.baseName
+	tn.partition = partition.partition
+	tn.versionSet = suffix[:setOff]
+	return nil
+}
+
 // NewIdentifier returns identifier which uniquely identifies as task list
 func NewIdentifier(
 	domainID string,
@@ -151,6 +200,20 @@ func NewIdentifier(
 	}, nil
 }
 
+// GetVersionedIdentifier returns the identifier of the task list serving the given compatible set in this partition
+func (tid *Identifier) GetVersionedIdentifier(versionSet string) *Identifier {
+	return &Identifier{
+		qualifiedTaskListName: qualifiedTaskListName{
+			name:       tid.GetVersionedName(versionSet),
+			baseName:   tid.baseName,
+			partition:  tid.partition,
+			versionSet: versionSet,
+		},
+		domainID: tid.domainID,
+		taskType: tid.taskType,
+	}
+}
+
 // GetDomainID returns the domain ID of the task list
 func (tid *Identifier) GetDomainID() string {
 	return tid.domainID
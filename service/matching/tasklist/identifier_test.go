@@ -46,6 +46,7 @@ func TestValidTaskListNames(t *testing.T) {
 		{"/__cadence_sys/list0/1", "list0", 1},
 		{"/__cadence_sys//list0//41", "/list0/", 41},
 		{"/__cadence_sys//__cadence_sys/sys/0/41", "/__cadence_sys/sys/0", 41},
+		{"/__cadence_sys/__build/1", "__build", 1},
 	}
 
 	for _, tc := range testCases {
@@ -97,6 +98,9 @@ func TestTaskListParentName(t *testing.T) {
 		{"/__cadence_sys/list0/6", 3, "/__cadence_sys/list0/1"},
 		{"/__cadence_sys/list0/7", 3, "/__cadence_sys/list0/2"},
 		{"/__cadence_sys/list0/10", 3, "/__cadence_sys/list0/3"},
+		/* versioned task lists */
+		{"/__cadence_sys/__build/1.0/list0", 2, ""},
+		{"/__cadence_sys/__build/1.0//__cadence_sys/list0/3", 2, ""},
 	}
 
 	for _, tc := range testCases {
@@ -117,6 +121,10 @@ func TestInvalidTasklistNames(t *testing.T) {
 		"/__cadence_sys/list0",
 		"/__cadence_sys/list0/0",
 		"/__cadence_sys/list0/-1",
+		"/__cadence_sys/__build//list0",
+		"/__cadence_sys/__build/1.0/",
+		"/__cadence_sys/__build/1.0//__cadence_sys/list0/0",
+		"/__cadence_sys/__build/1.0//__cadence_sys/__build/2.0/list0",
 	}
 	for _, name := range inputs {
 		t.Run(name, func(t *testing.T) {
@@ -130,6 +138,44 @@ func TestInvalidTasklistNames(t *testing.T) {
 	}
 }
 
+func TestVersionedTaskListNames(t *testing.T) {
+	testCases := []struct {
+		input         string
+		baseName      string
+		partition     int
+		versionSet    string
+		partitionName string
+	}{
+		{"/__cadence_sys/__build/1.0/list0", "list0", 0, "1.0", "list0"},
+		{"/__cadence_sys/__build/1.0//__cadence_sys/list0/3", "list0", 3, "1.0", "/__cadence_sys/list0/3"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			tn, err := newTaskListName(tc.input)
+			require.NoError(t, err)
+			require.True(t, tn.IsVersioned())
+			require.Equal(t, tc.versionSet, tn.VersionSet())
+			require.Equal(t, tc.partition, tn.Partition())
+			require.Equal(t, tc.baseName, tn.GetRoot())
+			require.Equal(t, tc.partitionName, tn.GetPartitionName())
+
+			partition, err := NewIdentifier("domain-name", tc.partitionName, persistence.TaskListTypeDecision)
+			require.NoError(t, err)
+			require.False(t, partition.IsVersioned())
+			versioned := partition.GetVersionedIdentifier(tc.versionSet)
+			require.Equal(t, tc.input, versioned.GetName())
+			require.Equal(t, tc.versionSet, versioned.VersionSet())
+			require.Equal(t, "domain-name", versioned.GetDomainID())
+			require.Equal(t, persistence.TaskListTypeDecision, versioned.GetType())
+
+			id, err := NewIdentifier("domain-name", tc.input, persistence.TaskListTypeDecision)
+			require.NoError(t, err)
+			require.Equal(t, *versioned, *id)
+		})
+	}
+}
+
 func TestTaskListIDToString(t *testing.T) {
 	id, err := NewIdentifier("test-domain", "/tasklist/", persistence.TaskListTypeActivity)
 	require.NoError(t, err)
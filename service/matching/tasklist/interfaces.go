@@ -63,6 +63,10 @@ type (
 		TaskListPartitionConfig() *types.TaskListPartitionConfig
 		UpdateTaskListPartitionConfig(context.Context, *types.TaskListPartitionConfig) error
 		RefreshTaskListPartitionConfig(context.Context, *types.TaskListPartitionConfig) error
+		TaskListVersioningConfig() *types.TaskListVersioningConfig
+		UpdateTaskListVersioningConfig(context.Context, *types.UpdateTaskListVersioningConfigRequest) (*types.TaskListVersioningConfig, error)
+		TaskListMigrationConfig() *types.TaskListMigrationConfig
+		UpdateTaskListMigrationConfig(context.Context, *types.TaskListMigrationConfig) error
 		LoadBalancerHints() *types.LoadBalancerHints
 		ReleaseBlockedPollers() error
 	}
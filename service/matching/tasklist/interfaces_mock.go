@@ -266,6 +266,20 @@ func (mr *MockManagerMockRecorder) TaskListID() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskListID", reflect.TypeOf((*MockManager)(nil).TaskListID))
 }
 
+// TaskListMigrationConfig mocks base method.
+func (m *MockManager) TaskListMigrationConfig() *types.TaskListMigrationConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskListMigrationConfig")
+	ret0, _ := ret[0].(*types.TaskListMigrationConfig)
+	return ret0
+}
+
+// TaskListMigrationConfig indicates an expected call of TaskListMigrationConfig.
+func (mr *MockManagerMockRecorder) TaskListMigrationConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskListMigrationConfig", reflect.TypeOf((*MockManager)(nil).TaskListMigrationConfig))
+}
+
 // TaskListPartitionConfig mocks base method.
 func (m *MockManager) TaskListPartitionConfig() *types.TaskListPartitionConfig {
 	m.ctrl.T.Helper()
@@ -280,6 +294,34 @@ func (mr *MockManagerMockRecorder) TaskListPartitionConfig() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskListPartitionConfig", reflect.TypeOf((*MockManager)(nil).TaskListPartitionConfig))
 }
 
+// TaskListVersioningConfig mocks base method.
+func (m *MockManager) TaskListVersioningConfig() *types.TaskListVersioningConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskListVersioningConfig")
+	ret0, _ := ret[0].(*types.TaskListVersioningConfig)
+	return ret0
+}
+
+// TaskListVersioningConfig indicates an expected call of TaskListVersioningConfig.
+func (mr *MockManagerMockRecorder) TaskListVersioningConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskListVersioningConfig", reflect.TypeOf((*MockManager)(nil).TaskListVersioningConfig))
+}
+
+// UpdateTaskListMigrationConfig mocks base method.
+func (m *MockManager) UpdateTaskListMigrationConfig(arg0 context.Context, arg1 *types.TaskListMigrationConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskListMigrationConfig", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTaskListMigrationConfig indicates an expected call of UpdateTaskListMigrationConfig.
+func (mr *MockManagerMockRecorder) UpdateTaskListMigrationConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListMigrationConfig", reflect.TypeOf((*MockManager)(nil).UpdateTaskListMigrationConfig), arg0, arg1)
+}
+
 // UpdateTaskListPartitionConfig mocks base method.
 func (m *MockManager) UpdateTaskListPartitionConfig(arg0 context.Context, arg1 *types.TaskListPartitionConfig) error {
 	m.ctrl.T.Helper()
@@ -294,6 +336,21 @@ func (mr *MockManagerMockRecorder) UpdateTaskListPartitionConfig(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListPartitionConfig", reflect.TypeOf((*MockManager)(nil).UpdateTaskListPartitionConfig), arg0, arg1)
 }
 
+// UpdateTaskListVersioningConfig mocks base method.
+func (m *MockManager) UpdateTaskListVersioningConfig(arg0 context.Context, arg1 *types.UpdateTaskListVersioningConfigRequest) (*types.TaskListVersioningConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskListVersioningConfig", arg0, arg1)
+	ret0, _ := ret[0].(*types.TaskListVersioningConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskListVersioningConfig indicates an expected call of UpdateTaskListVersioningConfig.
+func (mr *MockManagerMockRecorder) UpdateTaskListVersioningConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListVersioningConfig", reflect.TypeOf((*MockManager)(nil).UpdateTaskListVersioningConfig), arg0, arg1)
+}
+
 // MockTaskMatcher is a mock of TaskMatcher interface.
 type MockTaskMatcher struct {
 	ctrl     *gomock.Controller
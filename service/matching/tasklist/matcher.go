@@ -59,6 +59,10 @@ type taskMatcherImpl struct {
 	queryTaskC chan *InternalTask
 	// ratelimiter that limits the rate at which tasks can be dispatched to consumers
 	limiter quotas.Limiter
+	// optional domain-wide ratelimiters keyed by domain name, shared by all task lists of the domain.
+	// looked up on every use so changes to the global ratelimiter mode are picked up.
+	domainLimiters quotas.ICollection
+	domainName     string
 	// The most recently received Dispatch rate from a poller
 	lastReceivedRate atomic.Float64
 
@@ -79,6 +83,10 @@ type taskMatcherImpl struct {
 // ErrTasklistThrottled implies a tasklist was throttled
 var ErrTasklistThrottled = errors.New("tasklist limit exceeded")
 
+// ErrDomainDispatchThrottled implies the domain-wide dispatch quota was exceeded.
+// It wraps ErrTasklistThrottled, so it is retried in the same way.
+var ErrDomainDispatchThrottled = fmt.Errorf("%w: domain dispatch limit exceeded", ErrTasklistThrottled)
+
 // newTaskMatcher returns a task matcher instance. The returned instance can be
 // used by task producers and consumers to find a match. Both sync matches and non-sync
 // matches should use this implementation
@@ -91,6 +99,8 @@ func newTaskMatcher(
 	tasklist *Identifier,
 	tasklistKind types.TaskListKind,
 	numReadPartitionsFn func(*config.TaskListConfig) int,
+	domainName string,
+	domainLimiters quotas.ICollection,
 ) TaskMatcher {
 	isolatedTaskC := make(map[string]chan *InternalTask)
 	for _, g := range isolationGroups {
@@ -112,6 +122,8 @@ func newTaskMatcher(
 		cancelCtx:           cancelCtx,
 		cancelFunc:          cancelFunc,
 		numReadPartitionsFn: numReadPartitionsFn,
+		domainName:          domainName,
+		domainLimiters:      domainLimiters,
 	}
 	matcher.lastReceivedRate.Store(config.TaskDispatchRPS)
 	matcher.limiter = quotas.NewDynamicRateLimiterWithOpts(matcher.Rate, quotas.DynamicRateLimiterOpts{
@@ -160,6 +172,7 @@ func (tm *taskMatcherImpl) Offer(ctx context.Context, task *InternalTask) (bool,
 	startT := time.Now()
 	if !task.IsForwarded() {
 		err := tm.ratelimit(ctx)
+		task.breakdown.ratelimitWaitTime += time.Since(startT)
 		if err != nil {
 			tm.scope.IncCounter(metrics.SyncThrottlePerTaskListCounter)
 			return false, err
@@ -301,13 +314,23 @@ func (tm *taskMatcherImpl) MustOffer(ctx context.Context, task *InternalTask) er
 		TaskListKind: tm.tasklistKind.Ptr(),
 		TaskInfo:     task.Info(),
 	}
-	if err := tm.ratelimit(ctx); err != nil {
+	ratelimitStartT := time.Now()
+	err := tm.ratelimit(ctx)
+	task.breakdown.ratelimitWaitTime += time.Since(ratelimitStartT)
+	if err != nil {
 		e.EventName = "Throttled While Dispatching"
 		event.Log(e)
 		return fmt.Errorf("rate limit error dispatching: %w", err)
 	}
 
 	startT := time.Now()
+	// if no poller of the task's isolation group shows up in time, the task is leaked
+	// to other isolation groups by the next dispatch attempt
+	recordIsolationWait := func() {
+		if task.isolationGroup != "" {
+			task.breakdown.isolationWaitTime += time.Since(startT)
+		}
+	}
 	// attempt a match with local poller first. When that
 	// doesn't succeed, try both local match and remote match
 	taskC := tm.getTaskC(task)
@@ -325,6 +348,7 @@ func (tm *taskMatcherImpl) MustOffer(ctx context.Context, task *InternalTask) er
 		cancel()
 		e.EventName = "Context Done While Dispatching to Local Poller"
 		event.Log(e)
+		recordIsolationWait()
 		return fmt.Errorf("context done when trying to forward local task: %w", ctx.Err())
 	case <-childCtx.Done():
 		cancel()
@@ -336,6 +360,7 @@ forLoop:
 		if err := ctx.Err(); err != nil {
 			e.EventName = "Context Done While Dispatching to Local or Forwarding"
 			event.Log(e)
+			recordIsolationWait()
 			return fmt.Errorf("failed to offer task: %w", ctx.Err())
 		}
 		select {
@@ -399,6 +424,7 @@ forLoop:
 		case <-ctx.Done():
 			e.EventName = "Context Done While Dispatching to Local or Forwarding"
 			event.Log(e)
+			recordIsolationWait()
 			return fmt.Errorf("failed to offer task: %w", ctx.Err())
 		}
 	}
@@ -793,6 +819,15 @@ func (tm *taskMatcherImpl) fwdrAddReqTokenC() <-chan *ForwarderReqToken {
 }
 
 func (tm *taskMatcherImpl) ratelimit(ctx context.Context) error {
+	if tm.domainLimiters != nil {
+		err := tm.domainLimiters.For(tm.domainName).Wait(ctx)
+		if errors.Is(err, clock.ErrCannotWait) {
+			return ErrDomainDispatchThrottled
+		}
+		if err != nil {
+			return err // canceled
+		}
+	}
 	err := tm.limiter.Wait(ctx)
 	if errors.Is(err, clock.ErrCannotWait) {
 		// "err != ctx.Err()" may also be correct, as that would mean "gave up due to context".
@@ -48,6 +48,7 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/metrics/mocks"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/matching/config"
 )
@@ -87,11 +88,11 @@ func (t *MatcherTestSuite) SetupTest() {
 	t.cfg = tlCfg
 	t.isolationGroups = []string{"dca1", "dca2"}
 	t.fwdr = newForwarder(&t.cfg.ForwarderConfig, t.taskList, types.TaskListKindNormal, t.client, metrics.NoopScope)
-	t.matcher = newTaskMatcher(tlCfg, t.fwdr, metrics.NoopScope, []string{"dca1", "dca2"}, log.NewNoop(), t.taskList, types.TaskListKindNormal, func(cfg *config.TaskListConfig) int { return tlCfg.NumReadPartitions() }).(*taskMatcherImpl)
+	t.matcher = newTaskMatcher(tlCfg, t.fwdr, metrics.NoopScope, []string{"dca1", "dca2"}, log.NewNoop(), t.taskList, types.TaskListKindNormal, func(cfg *config.TaskListConfig) int { return tlCfg.NumReadPartitions() }, testDomainName, nil).(*taskMatcherImpl)
 
 	rootTaskList := NewTestTaskListID(t.T(), t.taskList.GetDomainID(), t.taskList.Parent(20), persistence.TaskListTypeDecision)
 	rootTasklistCfg := newTaskListConfig(rootTaskList, cfg, testDomainName)
-	t.rootMatcher = newTaskMatcher(rootTasklistCfg, nil, metrics.NoopScope, []string{"dca1", "dca2"}, log.NewNoop(), t.taskList, types.TaskListKindNormal, func(cfg *config.TaskListConfig) int { return tlCfg.NumReadPartitions() }).(*taskMatcherImpl)
+	t.rootMatcher = newTaskMatcher(rootTasklistCfg, nil, metrics.NoopScope, []string{"dca1", "dca2"}, log.NewNoop(), t.taskList, types.TaskListKindNormal, func(cfg *config.TaskListConfig) int { return tlCfg.NumReadPartitions() }, testDomainName, nil).(*taskMatcherImpl)
 }
 
 func (t *MatcherTestSuite) TearDownTest() {
@@ -1586,6 +1587,43 @@ func TestRatelimitBehavior(t *testing.T) {
 	})
 }
 
+func TestRatelimitDomainLimiter(t *testing.T) {
+	newMatcher := func(t *testing.T, tasklistLimiter, domainLimiter quotas.Limiter) *taskMatcherImpl {
+		domainLimiters := quotas.NewMockICollection(gomock.NewController(t))
+		domainLimiters.EXPECT().For("domain").Return(domainLimiter).Times(1)
+		return &taskMatcherImpl{
+			limiter:        tasklistLimiter,
+			domainLimiters: domainLimiters,
+			domainName:     "domain",
+		}
+	}
+	t.Run("domain quota exceeded", func(t *testing.T) {
+		tasklistLimiter := clock.NewRatelimiter(rate.Limit(1), 1)
+		tm := newMatcher(t, tasklistLimiter, clock.NewRatelimiter(rate.Limit(0), 0))
+
+		err := tm.ratelimit(context.Background())
+
+		assert.ErrorIs(t, err, ErrDomainDispatchThrottled)
+		assert.ErrorIs(t, err, ErrTasklistThrottled, "should be retried like a task list throttle")
+		assert.True(t, tasklistLimiter.Allow(), "task list token should not be consumed")
+	})
+	t.Run("within domain quota", func(t *testing.T) {
+		domainLimiter := clock.NewRatelimiter(rate.Limit(1), 1)
+		tm := newMatcher(t, clock.NewRatelimiter(rate.Limit(1), 1), domainLimiter)
+
+		assert.NoError(t, tm.ratelimit(context.Background()))
+		assert.False(t, domainLimiter.Allow(), "domain token should be consumed")
+	})
+	t.Run("task list quota exceeded", func(t *testing.T) {
+		tm := newMatcher(t, clock.NewRatelimiter(rate.Limit(0), 0), clock.NewRatelimiter(rate.Limit(1), 1))
+
+		err := tm.ratelimit(context.Background())
+
+		assert.ErrorIs(t, err, ErrTasklistThrottled)
+		assert.NotErrorIs(t, err, ErrDomainDispatchThrottled)
+	})
+}
+
 // Try to ensure a blocking callback in a goroutine is not running until the thing immediately
 // after `ready()` has blocked, so tests can ensure that the callback contents happen last.
 //
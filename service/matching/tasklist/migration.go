@@ -0,0 +1,126 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tasklist
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+// migrateTask re-adds a backlog task to the target task list of the migration and completes it in this task list.
+// The schedule ID is preserved and the schedule to start timeout is shortened to the time left before the original
+// expiry, so the task expires at the same time it would have in this task list.
+func (c *taskListManagerImpl) migrateTask(ctx context.Context, task *InternalTask, targetTaskList string) error {
+	info := task.Event.TaskInfo
+	scheduleToStartTimeout := info.ScheduleToStartTimeoutSeconds
+	if !info.Expiry.IsZero() && info.Expiry.After(epochStartTime) {
+		remaining := int32(math.Ceil(info.Expiry.Sub(c.timeSource.Now()).Seconds()))
+		if remaining <= 0 {
+			// expired while waiting in the buffer, drop it the same way the task reader does
+			c.scope.IncCounter(metrics.ExpiredTasksPerTaskListCounter)
+			task.Finish(nil)
+			return nil
+		}
+		scheduleToStartTimeout = remaining
+	}
+
+	kind := types.TaskListKindNormal
+	taskList := &types.TaskList{Name: targetTaskList, Kind: &kind}
+	var err error
+	switch c.taskListID.GetType() {
+	case persistence.TaskListTypeDecision:
+		_, err = c.matchingClient.AddDecisionTask(ctx, &types.AddDecisionTaskRequest{
+			DomainUUID:                    info.DomainID,
+			Execution:                     task.WorkflowExecution(),
+			TaskList:                      taskList,
+			ScheduleID:                    info.ScheduleID,
+			ScheduleToStartTimeoutSeconds: &scheduleToStartTimeout,
+			Source:                        &task.source,
+			PartitionConfig:               info.PartitionConfig,
+		})
+	case persistence.TaskListTypeActivity:
+		_, err = c.matchingClient.AddActivityTask(ctx, &types.AddActivityTaskRequest{
+			DomainUUID:                    c.taskListID.GetDomainID(),
+			SourceDomainUUID:              info.DomainID,
+			Execution:                     task.WorkflowExecution(),
+			TaskList:                      taskList,
+			ScheduleID:                    info.ScheduleID,
+			ScheduleToStartTimeoutSeconds: &scheduleToStartTimeout,
+			Source:                        &task.source,
+			PartitionConfig:               info.PartitionConfig,
+		})
+	default:
+		return ErrInvalidTaskListType
+	}
+	if err != nil {
+		// the task is written back to the end of the backlog, so a failing task doesn't block the migration
+		c.logger.Warn("Failed to migrate task",
+			tag.Error(err),
+			tag.TaskID(info.TaskID),
+			tag.WorkflowID(info.WorkflowID),
+			tag.WorkflowRunID(info.RunID),
+			tag.WorkflowScheduleID(info.ScheduleID),
+		)
+		c.scope.IncCounter(metrics.MigrateTaskFailedPerTaskListCounter)
+		task.Finish(err)
+		return nil
+	}
+	c.scope.IncCounter(metrics.MigratedTasksPerTaskListCounter)
+	task.Finish(nil)
+	return nil
+}
+
+// ValidateMigrationTarget checks that tasks of the task list can be moved to the target task list
+func ValidateMigrationTarget(taskListID *Identifier, target *types.TaskList) error {
+	if target.GetName() == "" {
+		return &types.BadRequestError{Message: "Target task list is not set."}
+	}
+	if target.GetKind() != types.TaskListKindNormal {
+		return &types.BadRequestError{Message: "Target task list must be a normal task list."}
+	}
+	if strings.HasPrefix(target.GetName(), constants.ReservedTaskListPrefix) {
+		return &types.BadRequestError{Message: fmt.Sprintf("Target task list name cannot start with reserved prefix %v.", constants.ReservedTaskListPrefix)}
+	}
+	if target.GetName() == taskListID.GetRoot() {
+		return &types.BadRequestError{Message: "Target task list must be different from the migrated task list."}
+	}
+	return nil
+}
+
+func toPersistenceMigrationConfig(config *types.TaskListMigrationConfig) *persistence.TaskListMigrationConfig {
+	if config == nil {
+		return nil
+	}
+	return &persistence.TaskListMigrationConfig{
+		TargetTaskList: config.TargetTaskList,
+		Alias:          config.Alias,
+	}
+}
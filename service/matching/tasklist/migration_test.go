@@ -0,0 +1,168 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tasklist
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestMigrateTask(t *testing.T) {
+	testCases := []struct {
+		name          string
+		taskType      int
+		expiry        time.Duration
+		setupMocks    func(*mockDeps)
+		expectedError error
+	}{
+		{
+			name:     "activity task keeps its expiry",
+			taskType: persistence.TaskListTypeActivity,
+			expiry:   10*time.Second + 500*time.Millisecond,
+			setupMocks: func(deps *mockDeps) {
+				deps.mockMatchingClient.EXPECT().AddActivityTask(gomock.Any(), &types.AddActivityTaskRequest{
+					DomainUUID:                    "domain-id",
+					SourceDomainUUID:              "source-domain-id",
+					Execution:                     &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+					TaskList:                      &types.TaskList{Name: "target", Kind: types.TaskListKindNormal.Ptr()},
+					ScheduleID:                    3,
+					ScheduleToStartTimeoutSeconds: common.Int32Ptr(11),
+					Source:                        types.TaskSourceDbBacklog.Ptr(),
+				}).Return(&types.AddActivityTaskResponse{}, nil)
+			},
+		},
+		{
+			name:     "decision task without expiry",
+			taskType: persistence.TaskListTypeDecision,
+			setupMocks: func(deps *mockDeps) {
+				deps.mockMatchingClient.EXPECT().AddDecisionTask(gomock.Any(), &types.AddDecisionTaskRequest{
+					DomainUUID:                    "source-domain-id",
+					Execution:                     &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+					TaskList:                      &types.TaskList{Name: "target", Kind: types.TaskListKindNormal.Ptr()},
+					ScheduleID:                    3,
+					ScheduleToStartTimeoutSeconds: common.Int32Ptr(60),
+					Source:                        types.TaskSourceDbBacklog.Ptr(),
+				}).Return(&types.AddDecisionTaskResponse{}, nil)
+			},
+		},
+		{
+			name:       "expired task is dropped",
+			taskType:   persistence.TaskListTypeActivity,
+			expiry:     -time.Second,
+			setupMocks: func(deps *mockDeps) {},
+		},
+		{
+			name:     "failed task is written back",
+			taskType: persistence.TaskListTypeActivity,
+			setupMocks: func(deps *mockDeps) {
+				deps.mockMatchingClient.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedError: errors.New("some error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tlID, err := NewIdentifier("domain-id", "tl", tc.taskType)
+			require.NoError(t, err)
+			tlm, deps := setupMocksForTaskListManager(t, tlID, types.TaskListKindNormal)
+			tc.setupMocks(deps)
+
+			info := &persistence.TaskInfo{
+				DomainID:                      "source-domain-id",
+				WorkflowID:                    "wid",
+				RunID:                         "rid",
+				ScheduleID:                    3,
+				ScheduleToStartTimeoutSeconds: 60,
+			}
+			if tc.expiry != 0 {
+				info.Expiry = deps.mockTimeSource.Now().Add(tc.expiry)
+			}
+			var finished bool
+			var finishErr error
+			task := newInternalTask(info, func(_ *persistence.TaskInfo, err error) {
+				finished = true
+				finishErr = err
+			}, types.TaskSourceDbBacklog, "", false, nil, "")
+
+			assert.NoError(t, tlm.migrateTask(context.Background(), task, "target"))
+			assert.True(t, finished)
+			assert.Equal(t, tc.expectedError, finishErr)
+		})
+	}
+}
+
+func TestValidateMigrationTarget(t *testing.T) {
+	tlID, err := NewIdentifier("domain-id", "tl", persistence.TaskListTypeActivity)
+	require.NoError(t, err)
+	tests := []struct {
+		name    string
+		target  *types.TaskList
+		wantErr string
+	}{
+		{
+			name:   "valid target",
+			target: &types.TaskList{Name: "target"},
+		},
+		{
+			name:    "no target",
+			target:  nil,
+			wantErr: "Target task list is not set.",
+		},
+		{
+			name:    "sticky target",
+			target:  &types.TaskList{Name: "target", Kind: types.TaskListKindSticky.Ptr()},
+			wantErr: "Target task list must be a normal task list.",
+		},
+		{
+			name:    "partition of the target",
+			target:  &types.TaskList{Name: "/__cadence_sys/target/1"},
+			wantErr: "Target task list name cannot start with reserved prefix",
+		},
+		{
+			name:    "same task list",
+			target:  &types.TaskList{Name: "tl"},
+			wantErr: "Target task list must be different from the migrated task list.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateMigrationTarget(tlID, tt.target)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
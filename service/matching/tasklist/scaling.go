@@ -0,0 +1,183 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tasklist
+
+import (
+	"math"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/stats"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/matching/event"
+)
+
+const (
+	dispatchedGroup      = "dispatched"
+	syncMatchedGroup     = "sync_matched"
+	scheduleToStartGroup = "schedule_to_start_ms"
+
+	// syncMatchRatioHighConfidence is the sync match ratio above which pollers are known to be waiting for tasks
+	syncMatchRatioHighConfidence = 0.9
+)
+
+type (
+	// scalingTracker keeps moving averages of the dispatch side of a task list partition.
+	// The schedule to start latency is tracked as a rate of milliseconds, dividing it by the
+	// dispatch rate gives the average latency of recently dispatched tasks.
+	scalingTracker struct {
+		stats.QPSTrackerGroup
+	}
+
+	// ScalingParams are the task list specific thresholds of a scaling recommendation
+	ScalingParams struct {
+		ScheduleToStartTarget time.Duration
+		BacklogDrainDuration  time.Duration
+	}
+)
+
+func newScalingTracker(timeSource clock.TimeSource, interval time.Duration, baseEvent event.E) *scalingTracker {
+	return &scalingTracker{
+		QPSTrackerGroup: stats.NewEmaFixedWindowQPSTracker(timeSource, 0.5, interval, baseEvent),
+	}
+}
+
+func (t *scalingTracker) recordDispatch(scheduleToStartLatency time.Duration) {
+	t.ReportGroup(dispatchedGroup, 1)
+	if scheduleToStartLatency > 0 {
+		t.ReportGroup(scheduleToStartGroup, scheduleToStartLatency.Milliseconds())
+	}
+}
+
+func (t *scalingTracker) recordSyncMatch() {
+	t.ReportGroup(syncMatchedGroup, 1)
+}
+
+func (t *scalingTracker) dispatchedPerSecond() float64 {
+	return t.GroupQPS(dispatchedGroup)
+}
+
+func (t *scalingTracker) syncMatchRatio(addedPerSecond float64) float64 {
+	if addedPerSecond <= 0 {
+		return 0
+	}
+	return math.Min(1, t.GroupQPS(syncMatchedGroup)/addedPerSecond)
+}
+
+func (t *scalingTracker) scheduleToStartLatency() time.Duration {
+	dispatched := t.dispatchedPerSecond()
+	if dispatched <= 0 {
+		return 0
+	}
+	return time.Duration(t.GroupQPS(scheduleToStartGroup) / dispatched * float64(time.Millisecond))
+}
+
+// RecommendScaling estimates the number of pollers a task list needs from the signals of all of its partitions.
+// Demand is the rate tasks are added plus the rate needed to drain the backlog within BacklogDrainDuration.
+// While the task list keeps up, i.e. there is no backlog and tasks start within ScheduleToStartTarget, pollers
+// are partially idle and the current poller count is kept. Otherwise every poller is assumed to sustain its share
+// of the current dispatch rate, and the poller count is scaled until the dispatch rate meets the demand.
+// complete is false if some partitions couldn't be described, which lowers the confidence by one level.
+func RecommendScaling(signals *types.TaskListScalingSignals, params ScalingParams, complete bool) *types.GetTaskListScalingRecommendationResponse {
+	pollers := signals.GetPollerCount()
+	backlog := signals.GetBacklogCountHint()
+	dispatched := signals.GetDispatchedTasksPerSecond()
+	demand := signals.GetAddTasksPerSecond()
+	if backlog > 0 && params.BacklogDrainDuration > 0 {
+		demand += float64(backlog) / params.BacklogDrainDuration.Seconds()
+	}
+	latency := time.Duration(signals.GetScheduleToStartLatencyInMs()) * time.Millisecond
+	keepingUp := backlog == 0 && latency <= params.ScheduleToStartTarget
+
+	var recommended int64
+	var confidence types.TaskListScalingConfidence
+	switch {
+	case pollers == 0:
+		// there is nothing to extrapolate from, ask for a single poller if there is any work
+		if demand > 0 {
+			recommended = 1
+		}
+		confidence = types.TaskListScalingConfidenceLow
+	case keepingUp:
+		recommended = pollers
+		switch {
+		case signals.GetAddTasksPerSecond() == 0:
+			confidence = types.TaskListScalingConfidenceLow
+		case signals.GetSyncMatchRatio() >= syncMatchRatioHighConfidence:
+			confidence = types.TaskListScalingConfidenceHigh
+		default:
+			confidence = types.TaskListScalingConfidenceMedium
+		}
+	case dispatched == 0:
+		// pollers are connected but nothing is dispatched, more pollers are unlikely to help
+		recommended = pollers
+		confidence = types.TaskListScalingConfidenceLow
+	default:
+		perPoller := dispatched / float64(pollers)
+		recommended = int64(math.Ceil(demand / perPoller))
+		if recommended < pollers {
+			// the task list is falling behind, never recommend fewer pollers than it has
+			recommended = pollers
+		}
+		confidence = types.TaskListScalingConfidenceMedium
+	}
+	if !complete && confidence > types.TaskListScalingConfidenceLow {
+		confidence--
+	}
+	return &types.GetTaskListScalingRecommendationResponse{
+		RecommendedPollerCount: recommended,
+		Confidence:             confidence.Ptr(),
+		Signals:                signals,
+	}
+}
+
+// AggregateScalingSignals combines the task list status of all partitions of a task list. Rates and backlogs are
+// summed, the sync match ratio is weighted by the add rate of each partition and the schedule to start latency by
+// the dispatch rate. Pollers polling several partitions are counted once.
+func AggregateScalingSignals(partitions []*types.DescribeTaskListResponse) *types.TaskListScalingSignals {
+	signals := &types.TaskListScalingSignals{}
+	pollers := make(map[string]struct{})
+	var syncMatched, latencySum float64
+	for _, p := range partitions {
+		for _, poller := range p.GetPollers() {
+			pollers[poller.GetIdentity()] = struct{}{}
+		}
+		status := p.GetTaskListStatus()
+		if status == nil {
+			continue
+		}
+		signals.BacklogCountHint += status.GetBacklogCountHint()
+		signals.AddTasksPerSecond += status.GetNewTasksPerSecond()
+		signals.DispatchedTasksPerSecond += status.GetDispatchedTasksPerSecond()
+		syncMatched += status.GetSyncMatchRatio() * status.GetNewTasksPerSecond()
+		latencySum += float64(status.GetScheduleToStartLatencyInMs()) * status.GetDispatchedTasksPerSecond()
+	}
+	if signals.AddTasksPerSecond > 0 {
+		signals.SyncMatchRatio = syncMatched / signals.AddTasksPerSecond
+	}
+	if signals.DispatchedTasksPerSecond > 0 {
+		signals.ScheduleToStartLatencyInMs = int64(latencySum / signals.DispatchedTasksPerSecond)
+	}
+	signals.PollerCount = int64(len(pollers))
+	return signals
+}
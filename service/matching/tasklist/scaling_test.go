@@ -0,0 +1,218 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tasklist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/stats"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestScalingTracker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockQPS := stats.NewMockQPSTrackerGroup(ctrl)
+	tracker := &scalingTracker{QPSTrackerGroup: mockQPS}
+
+	mockQPS.EXPECT().ReportGroup(dispatchedGroup, int64(1)).Times(2)
+	mockQPS.EXPECT().ReportGroup(scheduleToStartGroup, int64(250)).Times(1)
+	mockQPS.EXPECT().ReportGroup(syncMatchedGroup, int64(1)).Times(1)
+	tracker.recordDispatch(250 * time.Millisecond)
+	tracker.recordDispatch(0)
+	tracker.recordSyncMatch()
+
+	mockQPS.EXPECT().GroupQPS(dispatchedGroup).Return(float64(4)).AnyTimes()
+	mockQPS.EXPECT().GroupQPS(scheduleToStartGroup).Return(float64(1000))
+	mockQPS.EXPECT().GroupQPS(syncMatchedGroup).Return(float64(30)).Times(2)
+	assert.Equal(t, float64(4), tracker.dispatchedPerSecond())
+	assert.Equal(t, 250*time.Millisecond, tracker.scheduleToStartLatency())
+	assert.Equal(t, 0.5, tracker.syncMatchRatio(60))
+	assert.Equal(t, float64(1), tracker.syncMatchRatio(20))
+	assert.Equal(t, float64(0), tracker.syncMatchRatio(0))
+}
+
+func TestRecommendScaling(t *testing.T) {
+	params := ScalingParams{
+		ScheduleToStartTarget: time.Second,
+		BacklogDrainDuration:  time.Minute,
+	}
+	tests := []struct {
+		name                string
+		signals             *types.TaskListScalingSignals
+		complete            bool
+		expectedPollerCount int64
+		expectedConfidence  types.TaskListScalingConfidence
+	}{
+		{
+			name:                "no pollers, no work",
+			signals:             &types.TaskListScalingSignals{},
+			complete:            true,
+			expectedPollerCount: 0,
+			expectedConfidence:  types.TaskListScalingConfidenceLow,
+		},
+		{
+			name: "no pollers, backlog",
+			signals: &types.TaskListScalingSignals{
+				BacklogCountHint: 100,
+			},
+			complete:            true,
+			expectedPollerCount: 1,
+			expectedConfidence:  types.TaskListScalingConfidenceLow,
+		},
+		{
+			name: "keeping up, mostly sync matched",
+			signals: &types.TaskListScalingSignals{
+				AddTasksPerSecond:          10,
+				DispatchedTasksPerSecond:   10,
+				SyncMatchRatio:             0.95,
+				ScheduleToStartLatencyInMs: 20,
+				PollerCount:                4,
+			},
+			complete:            true,
+			expectedPollerCount: 4,
+			expectedConfidence:  types.TaskListScalingConfidenceHigh,
+		},
+		{
+			name: "keeping up, mostly async matched",
+			signals: &types.TaskListScalingSignals{
+				AddTasksPerSecond:          10,
+				DispatchedTasksPerSecond:   10,
+				SyncMatchRatio:             0.5,
+				ScheduleToStartLatencyInMs: 200,
+				PollerCount:                4,
+			},
+			complete:            true,
+			expectedPollerCount: 4,
+			expectedConfidence:  types.TaskListScalingConfidenceMedium,
+		},
+		{
+			name: "idle",
+			signals: &types.TaskListScalingSignals{
+				PollerCount: 4,
+			},
+			complete:            true,
+			expectedPollerCount: 4,
+			expectedConfidence:  types.TaskListScalingConfidenceLow,
+		},
+		{
+			name: "falling behind",
+			signals: &types.TaskListScalingSignals{
+				BacklogCountHint:           600,
+				AddTasksPerSecond:          20,
+				DispatchedTasksPerSecond:   10,
+				ScheduleToStartLatencyInMs: 5000,
+				PollerCount:                2,
+			},
+			complete: true,
+			// demand is 20 + 600/60 = 30 tasks per second, each poller dispatches 5
+			expectedPollerCount: 6,
+			expectedConfidence:  types.TaskListScalingConfidenceMedium,
+		},
+		{
+			name: "slow to start, not enough dispatch data to scale down",
+			signals: &types.TaskListScalingSignals{
+				AddTasksPerSecond:          1,
+				DispatchedTasksPerSecond:   10,
+				ScheduleToStartLatencyInMs: 5000,
+				PollerCount:                2,
+			},
+			complete:            true,
+			expectedPollerCount: 2,
+			expectedConfidence:  types.TaskListScalingConfidenceMedium,
+		},
+		{
+			name: "pollers without dispatches",
+			signals: &types.TaskListScalingSignals{
+				BacklogCountHint:  100,
+				AddTasksPerSecond: 1,
+				PollerCount:       2,
+			},
+			complete:            true,
+			expectedPollerCount: 2,
+			expectedConfidence:  types.TaskListScalingConfidenceLow,
+		},
+		{
+			name: "falling behind, missing partitions",
+			signals: &types.TaskListScalingSignals{
+				BacklogCountHint:           600,
+				AddTasksPerSecond:          20,
+				DispatchedTasksPerSecond:   10,
+				ScheduleToStartLatencyInMs: 5000,
+				PollerCount:                2,
+			},
+			complete:            false,
+			expectedPollerCount: 6,
+			expectedConfidence:  types.TaskListScalingConfidenceLow,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := RecommendScaling(tc.signals, params, tc.complete)
+			assert.Equal(t, tc.expectedPollerCount, resp.GetRecommendedPollerCount())
+			assert.Equal(t, tc.expectedConfidence, resp.GetConfidence())
+			assert.Equal(t, tc.signals, resp.GetSignals())
+		})
+	}
+}
+
+func TestAggregateScalingSignals(t *testing.T) {
+	signals := AggregateScalingSignals([]*types.DescribeTaskListResponse{
+		{
+			Pollers: []*types.PollerInfo{{Identity: "worker-a"}, {Identity: "worker-b"}},
+			TaskListStatus: &types.TaskListStatus{
+				BacklogCountHint:           5,
+				NewTasksPerSecond:          30,
+				DispatchedTasksPerSecond:   10,
+				SyncMatchRatio:             1,
+				ScheduleToStartLatencyInMs: 100,
+			},
+		},
+		{
+			Pollers: []*types.PollerInfo{{Identity: "worker-b"}},
+			TaskListStatus: &types.TaskListStatus{
+				BacklogCountHint:           3,
+				NewTasksPerSecond:          10,
+				DispatchedTasksPerSecond:   30,
+				SyncMatchRatio:             0.5,
+				ScheduleToStartLatencyInMs: 500,
+			},
+		},
+		{
+			Pollers: []*types.PollerInfo{{Identity: "worker-c"}},
+		},
+	})
+	assert.Equal(t, &types.TaskListScalingSignals{
+		BacklogCountHint:           8,
+		AddTasksPerSecond:          40,
+		DispatchedTasksPerSecond:   40,
+		SyncMatchRatio:             0.875,
+		ScheduleToStartLatencyInMs: 400,
+		PollerCount:                3,
+	}, signals)
+
+	assert.Equal(t, &types.TaskListScalingSignals{}, AggregateScalingSignals(nil))
+}
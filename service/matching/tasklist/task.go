@@ -21,6 +21,8 @@
 package tasklist
 
 import (
+	"time"
+
 	"github.com/uber/cadence/common/isolationgroup"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
@@ -44,6 +46,13 @@ type (
 		decisionTaskInfo *types.MatchingPollForDecisionTaskResponse
 		activityTaskInfo *types.MatchingPollForActivityTaskResponse
 	}
+	// dispatchBreakdown tracks where a task spent its time before being dispatched to a poller.
+	// it is carried over between dispatch attempts of the same backlog task.
+	dispatchBreakdown struct {
+		backlogWaitTime   time.Duration // time between the task being persisted and the first dispatch attempt
+		ratelimitWaitTime time.Duration // time spent waiting on task list and domain rate limits
+		isolationWaitTime time.Duration // time spent waiting for pollers of an isolation group the task was later leaked from
+	}
 	// InternalTask represents an activity, decision, query or started (received from another host).
 	// this struct is more like a union and only one of [ query, event, forwarded ] is
 	// non-nil for any given task
@@ -59,6 +68,7 @@ type (
 		BacklogCountHint         int64
 		ActivityTaskDispatchInfo *types.ActivityTaskDispatchInfo
 		AutoConfigHint           *types.AutoConfigHint // worker auto-scaler hint, which includes enable auto config flag and poller wait time on the matching engine
+		breakdown                dispatchBreakdown
 	}
 )
 
@@ -140,6 +150,21 @@ func (task *InternalTask) IsSyncMatch() bool {
 	return task.ResponseC != nil
 }
 
+// ScheduleToStartBreakdown returns where a locally generated task spent its time before being dispatched.
+// nil is returned for query tasks and tasks that were already started by another host.
+func (task *InternalTask) ScheduleToStartBreakdown() *types.ScheduleToStartBreakdown {
+	if task.Event == nil {
+		return nil
+	}
+	return &types.ScheduleToStartBreakdown{
+		SyncMatched:           task.source == types.TaskSourceHistory,
+		Forwarded:             task.IsForwarded(),
+		BacklogWaitTimeInMs:   task.breakdown.backlogWaitTime.Milliseconds(),
+		RatelimitWaitTimeInMs: task.breakdown.ratelimitWaitTime.Milliseconds(),
+		IsolationWaitTimeInMs: task.breakdown.isolationWaitTime.Milliseconds(),
+	}
+}
+
 func (task *InternalTask) Info() persistence.TaskInfo {
 	if task == nil || task.Event == nil || task.Event.TaskInfo == nil {
 		return persistence.TaskInfo{}
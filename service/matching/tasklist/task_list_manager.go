@@ -49,6 +49,7 @@ import (
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/stats"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/matching/config"
@@ -73,6 +74,7 @@ var (
 	taskListDecisionTypeTag           = metrics.TaskListTypeTag("decision")
 	IsolationLeakCauseError           = metrics.IsolationLeakCause("error")
 	IsolationLeakCauseGroupDrained    = metrics.IsolationLeakCause("group_drained")
+	IsolationLeakCauseGroupWeighted   = metrics.IsolationLeakCause("group_weighted")
 	IsolationLeakCauseNoRecentPollers = metrics.IsolationLeakCause("no_recent_pollers")
 	IsolationLeakCausePartitionChange = metrics.IsolationLeakCause("partition_change")
 	IsolationLeakCauseExpired         = metrics.IsolationLeakCause("expired")
@@ -84,6 +86,7 @@ type (
 	pollerIDCtxKey       struct{}
 	identityCtxKey       struct{}
 	isolationGroupCtxKey struct{}
+	buildIDCtxKey        struct{}
 
 	AddTaskParams struct {
 		TaskInfo                 *persistence.TaskInfo
@@ -124,12 +127,21 @@ type (
 		throttleRetry *backoff.ThrottleRetry
 
 		qpsTracker     stats.QPSTrackerGroup
+		scalingTracker *scalingTracker
 		adaptiveScaler AdaptiveScaler
 
 		partitionConfigLock sync.RWMutex
 		partitionConfig     *types.TaskListPartitionConfig
 		historyService      history.Client
 		taskCompleter       TaskCompleter
+
+		// versioningConfig is only set on unversioned normal task lists, it's persisted by the root partition
+		versioningConfigLock sync.RWMutex
+		versioningConfig     *types.TaskListVersioningConfig
+
+		// migrationConfig is set on all partitions of a normal task list that is being migrated, it's persisted by the root partition
+		migrationConfigLock sync.RWMutex
+		migrationConfig     *types.TaskListMigrationConfig
 	}
 )
 
@@ -155,6 +167,7 @@ func NewManager(
 	timeSource clock.TimeSource,
 	createTime time.Time,
 	historyService history.Client,
+	domainDispatchLimiters quotas.ICollection,
 ) (Manager, error) {
 	domainName, err := domainCache.GetDomainName(taskList.GetDomainID())
 	if err != nil {
@@ -210,7 +223,8 @@ func NewManager(
 	}
 
 	tlMgr.qpsTracker = stats.NewEmaFixedWindowQPSTracker(timeSource, 0.5, taskListConfig.QPSTrackerInterval(), baseEvent)
-	if taskList.IsRoot() && taskListKind == types.TaskListKindNormal {
+	tlMgr.scalingTracker = newScalingTracker(timeSource, taskListConfig.QPSTrackerInterval(), baseEvent)
+	if taskList.IsRoot() && !taskList.IsVersioned() && taskListKind == types.TaskListKindNormal {
 		adaptiveScalerScope := common.NewPerTaskListScope(domainName, taskList.GetName(), taskListKind, metricsClient, metrics.MatchingAdaptiveScalerScope).
 			Tagged(getTaskListTypeTag(taskList.GetType()))
 		tlMgr.adaptiveScaler = NewAdaptiveScaler(taskList, tlMgr, taskListConfig, timeSource, tlMgr.logger, adaptiveScalerScope, matchingClient, baseEvent)
@@ -234,7 +248,7 @@ func NewManager(
 		}
 		return cfg.NumReadPartitions()
 	}
-	tlMgr.matcher = newTaskMatcher(taskListConfig, fwdr, tlMgr.scope, isolationGroups, tlMgr.logger, taskList, taskListKind, numReadPartitionsFn).(*taskMatcherImpl)
+	tlMgr.matcher = newTaskMatcher(taskListConfig, fwdr, tlMgr.scope, isolationGroups, tlMgr.logger, taskList, taskListKind, numReadPartitionsFn, domainName, domainDispatchLimiters).(*taskMatcherImpl)
 	tlMgr.taskWriter = newTaskWriter(tlMgr)
 	tlMgr.taskReader = newTaskReader(tlMgr, isolationGroups)
 	tlMgr.taskCompleter = newTaskCompleter(tlMgr, historyServiceOperationRetryPolicy)
@@ -247,7 +261,7 @@ func NewManager(
 func (c *taskListManagerImpl) Start() error {
 	defer c.startWG.Done()
 
-	if !c.taskListID.IsRoot() && c.taskListKind == types.TaskListKindNormal {
+	if !c.taskListID.IsRoot() && !c.taskListID.IsVersioned() && c.taskListKind == types.TaskListKindNormal {
 		var info *persistence.TaskListInfo
 		err := c.throttleRetry.Do(context.Background(), func(ctx context.Context) error {
 			var err error
@@ -267,13 +281,17 @@ func (c *taskListManagerImpl) Start() error {
 			}
 		} else {
 			c.partitionConfig = info.AdaptivePartitionConfig.ToInternalType()
+			c.versioningConfig = info.VersioningConfig.ToInternalType()
+			c.migrationConfig = info.MigrationConfig.ToInternalType()
 		}
 	}
 	if err := c.taskWriter.Start(); err != nil {
 		c.Stop()
 		return err
 	}
-	if c.taskListID.IsRoot() && c.taskListKind == types.TaskListKindNormal {
+	if c.taskListID.IsRoot() && !c.taskListID.IsVersioned() && c.taskListKind == types.TaskListKindNormal {
+		c.versioningConfig = c.db.VersioningConfig().ToInternalType()
+		c.migrationConfig = c.db.MigrationConfig().ToInternalType()
 		c.partitionConfig = c.db.PartitionConfig().ToInternalType()
 		c.logger.Info("get task list partition config from db", tag.Dynamic("root-partition", c.taskListID.GetRoot()), tag.Dynamic("task-list-partition-config", c.partitionConfig))
 		if c.partitionConfig != nil {
@@ -289,6 +307,7 @@ func (c *taskListManagerImpl) Start() error {
 	c.liveness.Start()
 	c.taskReader.Start()
 	c.qpsTracker.Start()
+	c.scalingTracker.Start()
 	if c.adaptiveScaler != nil {
 		c.adaptiveScaler.Start()
 	}
@@ -308,6 +327,7 @@ func (c *taskListManagerImpl) Stop() {
 		c.adaptiveScaler.Stop()
 	}
 	c.qpsTracker.Stop()
+	c.scalingTracker.Stop()
 	c.liveness.Stop()
 	c.taskWriter.Stop()
 	c.taskReader.Stop()
@@ -404,6 +424,12 @@ func (c *taskListManagerImpl) RefreshTaskListPartitionConfig(ctx context.Context
 		c.partitionConfigLock.Lock()
 		c.partitionConfig = config
 		c.partitionConfigLock.Unlock()
+		c.versioningConfigLock.Lock()
+		c.versioningConfig = info.VersioningConfig.ToInternalType()
+		c.versioningConfigLock.Unlock()
+		c.migrationConfigLock.Lock()
+		c.migrationConfig = info.MigrationConfig.ToInternalType()
+		c.migrationConfigLock.Unlock()
 		return nil
 	}
 	c.partitionConfigLock.Lock()
@@ -463,10 +489,6 @@ func (c *taskListManagerImpl) updatePartitionConfig(ctx context.Context, newConf
 }
 
 func (c *taskListManagerImpl) notifyPartitionConfig(ctx context.Context, oldConfig, newConfig *types.TaskListPartitionConfig) {
-	taskListType := types.TaskListTypeDecision.Ptr()
-	if c.taskListID.GetType() == persistence.TaskListTypeActivity {
-		taskListType = types.TaskListTypeActivity.Ptr()
-	}
 	toNotify := make(map[int]any)
 	if oldConfig != nil {
 		for id := range oldConfig.ReadPartitions {
@@ -480,6 +502,16 @@ func (c *taskListManagerImpl) notifyPartitionConfig(ctx context.Context, oldConf
 			toNotify[id] = true
 		}
 	}
+	c.notifyPartitions(ctx, toNotify, newConfig)
+}
+
+// notifyPartitions pushes the partition config to the given non-root partitions,
+// a nil config makes the partitions reload partition, versioning and migration config from database
+func (c *taskListManagerImpl) notifyPartitions(ctx context.Context, toNotify map[int]any, config *types.TaskListPartitionConfig) {
+	taskListType := types.TaskListTypeDecision.Ptr()
+	if c.taskListID.GetType() == persistence.TaskListTypeActivity {
+		taskListType = types.TaskListTypeActivity.Ptr()
+	}
 	g := &errgroup.Group{}
 	for p := range toNotify {
 		taskListName := c.taskListID.GetPartition(p)
@@ -490,7 +522,7 @@ func (c *taskListManagerImpl) notifyPartitionConfig(ctx context.Context, oldConf
 				DomainUUID:      c.taskListID.GetDomainID(),
 				TaskList:        &types.TaskList{Name: taskListName, Kind: &c.taskListKind},
 				TaskListType:    taskListType,
-				PartitionConfig: newConfig,
+				PartitionConfig: config,
 			})
 			if e != nil {
 				c.logger.Error("failed to notify partition", tag.Error(e), tag.Dynamic("task-list-partition-name", taskListName))
@@ -504,6 +536,102 @@ func (c *taskListManagerImpl) notifyPartitionConfig(ctx context.Context, oldConf
 	}
 }
 
+func (c *taskListManagerImpl) TaskListVersioningConfig() *types.TaskListVersioningConfig {
+	c.versioningConfigLock.RLock()
+	defer c.versioningConfigLock.RUnlock()
+	return c.versioningConfig
+}
+
+// UpdateTaskListVersioningConfig updates the worker build ID versioning config. It is called on the root partition of a decision task list.
+// Root tasklist manager will update the versioning config in the database and notify all non-root partitions to reload it.
+func (c *taskListManagerImpl) UpdateTaskListVersioningConfig(ctx context.Context, request *types.UpdateTaskListVersioningConfigRequest) (*types.TaskListVersioningConfig, error) {
+	c.startWG.Wait()
+	newConfig, err := c.updateVersioningConfig(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	c.notifyNonRootPartitionsToReload(ctx)
+	return newConfig, nil
+}
+
+// notifyNonRootPartitionsToReload makes all non-root read partitions reload their config from the root partition
+func (c *taskListManagerImpl) notifyNonRootPartitionsToReload(ctx context.Context) {
+	if partitionConfig := c.TaskListPartitionConfig(); partitionConfig != nil {
+		toNotify := make(map[int]any)
+		for id := range partitionConfig.ReadPartitions {
+			if id != 0 {
+				toNotify[id] = true
+			}
+		}
+		c.notifyPartitions(ctx, toNotify, nil)
+	}
+}
+
+func (c *taskListManagerImpl) updateVersioningConfig(ctx context.Context, request *types.UpdateTaskListVersioningConfigRequest) (*types.TaskListVersioningConfig, error) {
+	c.versioningConfigLock.Lock()
+	defer c.versioningConfigLock.Unlock()
+	newConfig, err := applyVersioningConfigUpdate(c.versioningConfig, request)
+	if err != nil {
+		return nil, err
+	}
+	err = c.throttleRetry.Do(ctx, func(ctx context.Context) error {
+		return c.db.UpdateTaskListVersioningConfig(toPersistenceVersioningConfig(newConfig))
+	})
+	if err != nil {
+		// We're not sure whether the update was persisted or not,
+		// Stop the tasklist manager and let it be reloaded
+		c.scope.IncCounter(metrics.TaskListVersioningUpdateFailedCounter)
+		c.Stop()
+		return nil, err
+	}
+	c.versioningConfig = c.db.VersioningConfig().ToInternalType()
+	c.logger.Info("updated task list versioning config", tag.Dynamic("root-partition", c.taskListID.GetRoot()), tag.Dynamic("task-list-versioning-config", c.versioningConfig))
+	return c.versioningConfig, nil
+}
+
+func (c *taskListManagerImpl) TaskListMigrationConfig() *types.TaskListMigrationConfig {
+	c.migrationConfigLock.RLock()
+	defer c.migrationConfigLock.RUnlock()
+	return c.migrationConfig
+}
+
+// UpdateTaskListMigrationConfig starts or stops (with nil config) the migration of the task list. It is called on the root partition.
+// Root tasklist manager will update the migration config in the database and notify all non-root partitions to reload it.
+func (c *taskListManagerImpl) UpdateTaskListMigrationConfig(ctx context.Context, config *types.TaskListMigrationConfig) error {
+	c.startWG.Wait()
+	updated, err := c.updateMigrationConfig(ctx, config)
+	if err != nil {
+		return err
+	}
+	if updated {
+		c.notifyNonRootPartitionsToReload(ctx)
+	}
+	return nil
+}
+
+func (c *taskListManagerImpl) updateMigrationConfig(ctx context.Context, config *types.TaskListMigrationConfig) (bool, error) {
+	c.migrationConfigLock.Lock()
+	defer c.migrationConfigLock.Unlock()
+	if reflect.DeepEqual(c.migrationConfig, config) {
+		return false, nil
+	}
+	err := c.throttleRetry.Do(ctx, func(ctx context.Context) error {
+		return c.db.UpdateTaskListMigrationConfig(toPersistenceMigrationConfig(config))
+	})
+	if err != nil {
+		// We're not sure whether the update was persisted or not,
+		// Stop the tasklist manager and let it be reloaded
+		c.scope.IncCounter(metrics.TaskListMigrationUpdateFailedCounter)
+		c.Stop()
+		return false, err
+	}
+	c.migrationConfig = c.db.MigrationConfig().ToInternalType()
+	c.logger.Info("updated task list migration config", tag.Dynamic("root-partition", c.taskListID.GetRoot()), tag.Dynamic("task-list-migration-config", c.migrationConfig))
+	// tasks waiting for a poller are moved once their dispatch times out, wake up the reader for the rest of the backlog
+	c.taskReader.Signal()
+	return true, nil
+}
+
 // AddTask adds a task to the task list. This method will first attempt a synchronous
 // match with a poller. When there are no pollers or if rate limit is exceeded, task will
 // be written to database and later asynchronously matched with a poller
@@ -588,6 +716,9 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params AddTaskParams)
 	if err == nil && !syncMatch {
 		c.taskReader.Signal()
 	}
+	if err == nil && syncMatch && params.ForwardedFrom == "" {
+		c.scalingTracker.recordSyncMatch()
+	}
 
 	return syncMatch, err
 }
@@ -604,6 +735,10 @@ func (c *taskListManagerImpl) DispatchTask(ctx context.Context, task *InternalTa
 	}
 
 	if domainEntry.IsActiveIn(c.clusterMetadata.GetCurrentClusterName()) {
+		// backlog of task lists being migrated is moved to the target task list instead of being dispatched to pollers
+		if migrationConfig := c.TaskListMigrationConfig(); migrationConfig != nil {
+			return c.migrateTask(ctx, task, migrationConfig.GetTargetTaskList())
+		}
 		return c.matcher.MustOffer(ctx, task)
 	}
 
@@ -655,9 +790,22 @@ func (c *taskListManagerImpl) GetTask(
 	}
 	task.domainName = c.domainName
 	task.BacklogCountHint = c.taskAckManager.GetBacklogCount()
+	// tasks received from a parent partition are accounted for by the partition that owns them
+	if task.Event != nil {
+		c.scalingTracker.recordDispatch(c.timeSource.Since(task.Event.CreatedTime))
+		c.emitScheduleToStartBreakdown(task)
+	}
 	return task, nil
 }
 
+func (c *taskListManagerImpl) emitScheduleToStartBreakdown(task *InternalTask) {
+	if task.source == types.TaskSourceDbBacklog {
+		c.scope.RecordHistogramDuration(metrics.ScheduleToStartBacklogLatencyPerTaskList, task.breakdown.backlogWaitTime)
+	}
+	c.scope.RecordHistogramDuration(metrics.ScheduleToStartRatelimitLatencyPerTaskList, task.breakdown.ratelimitWaitTime)
+	c.scope.RecordHistogramDuration(metrics.ScheduleToStartIsolationLatencyPerTaskList, task.breakdown.isolationWaitTime)
+}
+
 func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond *float64) (*InternalTask, error) {
 	c.emitMisconfiguredPartitionMetrics()
 	// We need to set a shorter timeout than the original ctx; otherwise, by the time ctx deadline is
@@ -670,6 +818,7 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 	isolationGroup := IsolationGroupFromContext(ctx)
 	pollerID := PollerIDFromContext(ctx)
 	identity := IdentityFromContext(ctx)
+	buildID := BuildIDFromContext(ctx)
 	rps := c.config.TaskDispatchRPS
 	if maxDispatchPerSecond != nil {
 		rps = *maxDispatchPerSecond
@@ -678,6 +827,7 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 		Identity:       identity,
 		IsolationGroup: isolationGroup,
 		RatePerSecond:  rps,
+		BuildID:        buildID,
 	})
 	defer c.pollers.EndPoll(pollerID)
 
@@ -731,13 +881,15 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *type
 		},
 	}
 	response.PartitionConfig = c.TaskListPartitionConfig()
+	response.VersioningConfig = c.TaskListVersioningConfig()
 	if !includeTaskListStatus {
 		return response
 	}
 
 	idBlock := rangeIDToTaskIDBlock(c.db.RangeID(), c.config.RangeSize)
 	isolationGroups := c.config.AllIsolationGroups()
-	pollerCounts := c.pollers.GetCountByIsolationGroup(c.timeSource.Now().Add(-1 * c.config.TaskIsolationPollerWindow()))
+	pollerWindowStart := c.timeSource.Now().Add(-1 * c.config.TaskIsolationPollerWindow())
+	pollerCounts := c.pollers.GetCountByIsolationGroup(pollerWindowStart)
 	isolationGroupMetrics := make(map[string]*types.IsolationGroupMetrics, len(isolationGroups))
 	for _, group := range isolationGroups {
 		isolationGroupMetrics[group] = &types.IsolationGroupMetrics{
@@ -745,6 +897,14 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *type
 			PollerCount:       int64(pollerCounts[group]),
 		}
 	}
+	var buildIDMetrics map[string]*types.BuildIDMetrics
+	if buildPollerCounts := c.pollers.GetCountByBuildID(pollerWindowStart); len(buildPollerCounts) > 0 {
+		buildIDMetrics = make(map[string]*types.BuildIDMetrics, len(buildPollerCounts))
+		for buildID, count := range buildPollerCounts {
+			buildIDMetrics[buildID] = &types.BuildIDMetrics{PollerCount: int64(count)}
+		}
+	}
+	newTasksPerSecond := c.qpsTracker.QPS()
 	response.TaskListStatus = &types.TaskListStatus{
 		ReadLevel:        c.taskAckManager.GetReadLevel(),
 		AckLevel:         c.taskAckManager.GetAckLevel(),
@@ -754,9 +914,13 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *type
 			StartID: idBlock.start,
 			EndID:   idBlock.end,
 		},
-		IsolationGroupMetrics: isolationGroupMetrics,
-		NewTasksPerSecond:     c.qpsTracker.QPS(),
-		Empty:                 c.taskAckManager.GetAckLevel() == c.taskWriter.GetMaxReadLevel(),
+		IsolationGroupMetrics:      isolationGroupMetrics,
+		BuildIDMetrics:             buildIDMetrics,
+		NewTasksPerSecond:          newTasksPerSecond,
+		Empty:                      c.taskAckManager.GetAckLevel() == c.taskWriter.GetMaxReadLevel(),
+		DispatchedTasksPerSecond:   c.scalingTracker.dispatchedPerSecond(),
+		SyncMatchRatio:             c.scalingTracker.syncMatchRatio(newTasksPerSecond),
+		ScheduleToStartLatencyInMs: c.scalingTracker.scheduleToStartLatency().Milliseconds(),
 	}
 
 	return response
@@ -872,7 +1036,7 @@ func (c *taskListManagerImpl) newChildContext(
 }
 
 func (c *taskListManagerImpl) isFowardingAllowed(taskList *Identifier, kind types.TaskListKind) bool {
-	return !taskList.IsRoot() && kind != types.TaskListKindSticky
+	return !taskList.IsRoot() && !taskList.IsVersioned() && kind != types.TaskListKindSticky
 }
 
 func (c *taskListManagerImpl) isIsolationMatcherEnabled() bool {
@@ -893,17 +1057,22 @@ func (c *taskListManagerImpl) getIsolationGroupForTask(ctx context.Context, task
 		return defaultTaskBufferIsolationGroup, noIsolationTimeout
 	}
 
-	isDrained, err := c.isolationState.IsDrained(ctx, c.domainName, group)
+	weight, err := c.isolationState.TrafficWeight(ctx, c.domainName, group)
 	if err != nil {
 		// if we're unable to get the isolation group, log the error and fallback to no isolation
-		c.logger.Error("Failed to determine whether isolation group is drained", tag.IsolationGroup(group), tag.WorkflowID(taskInfo.WorkflowID), tag.WorkflowRunID(taskInfo.RunID), tag.TaskID(taskInfo.TaskID), tag.Error(err))
+		c.logger.Error("Failed to determine the traffic weight of isolation group", tag.IsolationGroup(group), tag.WorkflowID(taskInfo.WorkflowID), tag.WorkflowRunID(taskInfo.RunID), tag.TaskID(taskInfo.TaskID), tag.Error(err))
 		c.scope.Tagged(metrics.IsolationGroupTag(group), IsolationLeakCauseError).IncCounter(metrics.TaskIsolationLeakPerTaskList)
 		return defaultTaskBufferIsolationGroup, noIsolationTimeout
 	}
-	if isDrained {
+	if weight <= 0 {
 		c.scope.Tagged(metrics.IsolationGroupTag(group), IsolationLeakCauseGroupDrained).IncCounter(metrics.TaskIsolationLeakPerTaskList)
 		return defaultTaskBufferIsolationGroup, noIsolationTimeout
 	}
+	// a partially drained group only keeps its share of workflows, the rest are leaked to any poller
+	if !isolationgroup.IsWithinTrafficWeight(taskInfo.WorkflowID, weight) {
+		c.scope.Tagged(metrics.IsolationGroupTag(group), IsolationLeakCauseGroupWeighted).IncCounter(metrics.TaskIsolationLeakPerTaskList)
+		return defaultTaskBufferIsolationGroup, noIsolationTimeout
+	}
 	if !c.pollers.HasPollerFromIsolationGroupAfter(group, c.timeSource.Now().Add(-1*c.config.TaskIsolationPollerWindow())) {
 		c.scope.Tagged(metrics.IsolationGroupTag(group), IsolationLeakCauseNoRecentPollers).IncCounter(metrics.TaskIsolationLeakPerTaskList)
 		return defaultTaskBufferIsolationGroup, noIsolationTimeout
@@ -931,8 +1100,8 @@ func (c *taskListManagerImpl) getIsolationGroupForTask(ctx context.Context, task
 }
 
 func (c *taskListManagerImpl) emitMisconfiguredPartitionMetrics() {
-	if !c.taskListID.IsRoot() || c.taskListKind == types.TaskListKindSticky {
-		// only emit the metric in root partition of non-sticky tasklist
+	if !c.taskListID.IsRoot() || c.taskListID.IsVersioned() || c.taskListKind == types.TaskListKindSticky {
+		// only emit the metric in root partition of non-sticky unversioned tasklist
 		return
 	}
 	if c.config.NumReadPartitions() != c.config.NumWritePartitions() {
@@ -1175,3 +1344,15 @@ func IsolationGroupFromContext(ctx context.Context) string {
 func ContextWithIsolationGroup(ctx context.Context, isolationGroup string) context.Context {
 	return context.WithValue(ctx, isolationGroupCtxKey{}, isolationGroup)
 }
+
+func BuildIDFromContext(ctx context.Context) string {
+	val, ok := ctx.Value(buildIDCtxKey{}).(string)
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+func ContextWithBuildID(ctx context.Context, buildID string) context.Context {
+	return context.WithValue(ctx, buildIDCtxKey{}, buildID)
+}
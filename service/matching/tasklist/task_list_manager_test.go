@@ -104,6 +104,7 @@ func setupMocksForTaskListManager(t *testing.T, taskListID *Identifier, taskList
 		deps.mockTimeSource,
 		deps.mockTimeSource.Now(),
 		mockHistoryService,
+		nil,
 	)
 	require.NoError(t, err)
 	return tlm.(*taskListManagerImpl), deps
@@ -228,7 +229,7 @@ func createTestTaskListManager(t *testing.T, logger log.Logger, controller *gomo
 func createTestTaskListManagerWithConfig(t *testing.T, logger log.Logger, controller *gomock.Controller, cfg *config.Config, timeSource clock.TimeSource) *taskListManagerImpl {
 	tm := NewTestTaskManager(t, logger, timeSource)
 	mockIsolationState := isolationgroup.NewMockState(controller)
-	mockIsolationState.EXPECT().IsDrained(gomock.Any(), "domainName", gomock.Any()).Return(false, nil).AnyTimes()
+	mockIsolationState.EXPECT().TrafficWeight(gomock.Any(), "domainName", gomock.Any()).Return(types.IsolationGroupMaxWeight, nil).AnyTimes()
 	mockDomainCache := cache.NewMockDomainCache(controller)
 	mockDomainCache.EXPECT().GetDomainByID(gomock.Any()).Return(cache.CreateDomainCacheEntry("domainName"), nil).AnyTimes()
 	mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("domainName", nil).AnyTimes()
@@ -254,6 +255,7 @@ func createTestTaskListManagerWithConfig(t *testing.T, logger log.Logger, contro
 		timeSource,
 		timeSource.Now(),
 		mockHistoryService,
+		nil,
 	)
 	if err != nil {
 		logger.Fatal("error when createTestTaskListManager", tag.Error(err))
@@ -381,6 +383,34 @@ func TestDescribeTaskList(t *testing.T) {
 				Empty: true,
 			},
 		},
+		{
+			name:          "with status, scaling metrics",
+			includeStatus: true,
+			allowance: func(ctrl *gomock.Controller, impl *taskListManagerImpl) {
+				mockQPS := stats.NewMockQPSTrackerGroup(ctrl)
+				mockQPS.EXPECT().GroupQPS(gomock.Any()).Return(float64(0)).AnyTimes()
+				mockQPS.EXPECT().QPS().Return(float64(100.0))
+				impl.qpsTracker = mockQPS
+				mockScaling := stats.NewMockQPSTrackerGroup(ctrl)
+				mockScaling.EXPECT().GroupQPS(dispatchedGroup).Return(float64(80.0)).AnyTimes()
+				mockScaling.EXPECT().GroupQPS(syncMatchedGroup).Return(float64(50.0))
+				mockScaling.EXPECT().GroupQPS(scheduleToStartGroup).Return(float64(40000.0))
+				impl.scalingTracker = &scalingTracker{QPSTrackerGroup: mockScaling}
+			},
+			expectedStatus: &types.TaskListStatus{
+				RatePerSecond:     defaultRps,
+				TaskIDBlock:       firstIDBlock,
+				NewTasksPerSecond: 100,
+				IsolationGroupMetrics: map[string]*types.IsolationGroupMetrics{
+					"datacenterA": {},
+					"datacenterB": {},
+				},
+				Empty:                      true,
+				DispatchedTasksPerSecond:   80,
+				SyncMatchRatio:             0.5,
+				ScheduleToStartLatencyInMs: 500,
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -554,7 +584,7 @@ func TestRateLimitErrorsFromTasklistDispatch(t *testing.T) {
 		return "datacenterA", -1
 	}
 
-	breakDispatcher, breakRetryLoop := tlm.taskReader.dispatchSingleTaskFromBuffer(&persistence.TaskInfo{})
+	breakDispatcher, breakRetryLoop := tlm.taskReader.dispatchSingleTaskFromBuffer(&persistence.TaskInfo{}, &dispatchBreakdown{})
 	assert.False(t, breakDispatcher)
 	assert.False(t, breakRetryLoop)
 }
@@ -586,7 +616,7 @@ func TestMisconfiguredZoneDoesNotBlock(t *testing.T) {
 	maxBufferSize := config.GetTasksBatchSize("", "", 0) - 1
 
 	for i := 0; i < maxBufferSize; i++ {
-		breakDispatcher, breakRetryLoop := tlm.taskReader.dispatchSingleTaskFromBuffer(&persistence.TaskInfo{})
+		breakDispatcher, breakRetryLoop := tlm.taskReader.dispatchSingleTaskFromBuffer(&persistence.TaskInfo{}, &dispatchBreakdown{})
 		assert.False(t, breakDispatcher, "dispatch isn't shutting down")
 		assert.True(t, breakRetryLoop, "should be able to successfully dispatch all these tasks to the default isolation group")
 	}
@@ -597,7 +627,7 @@ func TestMisconfiguredZoneDoesNotBlock(t *testing.T) {
 
 	// ok, and here we try and ensure that this *does not block
 	// and instead complains and live-retries
-	breakDispatcher, breakRetryLoop := tlm.taskReader.dispatchSingleTaskFromBuffer(&persistence.TaskInfo{})
+	breakDispatcher, breakRetryLoop := tlm.taskReader.dispatchSingleTaskFromBuffer(&persistence.TaskInfo{}, &dispatchBreakdown{})
 	assert.False(t, breakDispatcher, "dispatch isn't shutting down")
 	assert.True(t, breakRetryLoop, "task should be dispatched to default channel")
 }
@@ -617,6 +647,7 @@ func TestGetIsolationGroupForTask(t *testing.T) {
 		expectedGroup            string
 		expectedDuration         time.Duration
 		drainedGroups            map[string]bool
+		groupWeights             map[string]int32
 		isolationStateErr        error
 		disableTaskIsolation     bool
 		partitionConfig          *types.TaskListPartition
@@ -659,6 +690,17 @@ func TestGetIsolationGroupForTask(t *testing.T) {
 				"b": true,
 			},
 		},
+		{
+			name:                     "success - workflow within group weight",
+			taskIsolationGroup:       "a",
+			availableIsolationGroups: defaultAvailableIsolationGroups,
+			expectedGroup:            "a",
+			expectedDuration:         0,
+			recentPollers:            []string{"a"},
+			groupWeights: map[string]int32{
+				"a": 60,
+			},
+		},
 		{
 			name:                     "success - right partition",
 			taskIsolationGroup:       "a",
@@ -717,6 +759,18 @@ func TestGetIsolationGroupForTask(t *testing.T) {
 				"a": true,
 			},
 		},
+		{
+			name:                     "leak - workflow outside group weight",
+			taskIsolationGroup:       "a",
+			taskIsolationDuration:    time.Second,
+			availableIsolationGroups: defaultAvailableIsolationGroups,
+			recentPollers:            []string{"a"},
+			expectedGroup:            "",
+			expectedDuration:         0,
+			groupWeights: map[string]int32{
+				"a": 40,
+			},
+		},
 		{
 			name:                     "leak - state error",
 			taskIsolationGroup:       "a",
@@ -786,10 +840,16 @@ func TestGetIsolationGroupForTask(t *testing.T) {
 
 			mockIsolationGroupState := isolationgroup.NewMockState(controller)
 			if tc.isolationStateErr != nil {
-				mockIsolationGroupState.EXPECT().IsDrained(gomock.Any(), "domainName", gomock.Any()).Return(false, tc.isolationStateErr).AnyTimes()
+				mockIsolationGroupState.EXPECT().TrafficWeight(gomock.Any(), "domainName", gomock.Any()).Return(int32(0), tc.isolationStateErr).AnyTimes()
 			} else {
-				mockIsolationGroupState.EXPECT().IsDrained(gomock.Any(), "domainName", gomock.Any()).DoAndReturn(func(ctx context.Context, domainName, group string) (bool, error) {
-					return tc.drainedGroups[group], nil
+				mockIsolationGroupState.EXPECT().TrafficWeight(gomock.Any(), "domainName", gomock.Any()).DoAndReturn(func(ctx context.Context, domainName, group string) (int32, error) {
+					if tc.drainedGroups[group] {
+						return 0, nil
+					}
+					if weight, ok := tc.groupWeights[group]; ok {
+						return weight, nil
+					}
+					return types.IsolationGroupMaxWeight, nil
 				}).AnyTimes()
 			}
 			tlm.isolationState = mockIsolationGroupState
@@ -855,7 +915,7 @@ func TestTaskListManagerGetTaskBatch(t *testing.T) {
 	const rangeSize = 10
 	controller := gomock.NewController(t)
 	mockIsolationState := isolationgroup.NewMockState(controller)
-	mockIsolationState.EXPECT().IsDrained(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	mockIsolationState.EXPECT().TrafficWeight(gomock.Any(), gomock.Any(), gomock.Any()).Return(types.IsolationGroupMaxWeight, nil).AnyTimes()
 	mockDomainCache := cache.NewMockDomainCache(controller)
 	mockDomainCache.EXPECT().GetDomainByID(gomock.Any()).Return(cache.CreateDomainCacheEntry("domainName"), nil).AnyTimes()
 	mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("domainName", nil).AnyTimes()
@@ -882,6 +942,7 @@ func TestTaskListManagerGetTaskBatch(t *testing.T) {
 		timeSource,
 		timeSource.Now(),
 		mockHistoryService,
+		nil,
 	)
 	assert.NoError(t, err)
 	tlm := tlMgr.(*taskListManagerImpl)
@@ -953,6 +1014,7 @@ func TestTaskListManagerGetTaskBatch(t *testing.T) {
 		timeSource,
 		timeSource.Now(),
 		mockHistoryService,
+		nil,
 	)
 	assert.NoError(t, err)
 	tlm = tlMgr.(*taskListManagerImpl)
@@ -983,7 +1045,7 @@ func TestTaskListReaderPumpAdvancesAckLevelAfterEmptyReads(t *testing.T) {
 
 	controller := gomock.NewController(t)
 	mockIsolationState := isolationgroup.NewMockState(controller)
-	mockIsolationState.EXPECT().IsDrained(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	mockIsolationState.EXPECT().TrafficWeight(gomock.Any(), gomock.Any(), gomock.Any()).Return(types.IsolationGroupMaxWeight, nil).AnyTimes()
 	mockDomainCache := cache.NewMockDomainCache(controller)
 	mockDomainCache.EXPECT().GetDomainByID(gomock.Any()).Return(cache.CreateDomainCacheEntry("domainName"), nil).AnyTimes()
 	mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("domainName", nil).AnyTimes()
@@ -1012,6 +1074,7 @@ func TestTaskListReaderPumpAdvancesAckLevelAfterEmptyReads(t *testing.T) {
 		timeSource,
 		timeSource.Now(),
 		mockHistoryService,
+		nil,
 	)
 	require.NoError(t, err)
 	tlm := tlMgr.(*taskListManagerImpl)
@@ -1127,7 +1190,7 @@ func TestTaskExpiryAndCompletion(t *testing.T) {
 		t.Run("", func(t *testing.T) {
 			controller := gomock.NewController(t)
 			mockIsolationState := isolationgroup.NewMockState(controller)
-			mockIsolationState.EXPECT().IsDrained(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+			mockIsolationState.EXPECT().TrafficWeight(gomock.Any(), gomock.Any(), gomock.Any()).Return(types.IsolationGroupMaxWeight, nil).AnyTimes()
 			mockDomainCache := cache.NewMockDomainCache(controller)
 			mockDomainCache.EXPECT().GetDomainByID(gomock.Any()).Return(cache.CreateDomainCacheEntry("domainName"), nil).AnyTimes()
 			mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("domainName", nil).AnyTimes()
@@ -1159,6 +1222,7 @@ func TestTaskExpiryAndCompletion(t *testing.T) {
 				timeSource,
 				timeSource.Now(),
 				mockHistoryService,
+				nil,
 			)
 			assert.NoError(t, err)
 			tlm := tlMgr.(*taskListManagerImpl)
@@ -1605,6 +1669,214 @@ func TestUpdateTaskListPartitionConfigConcurrency(t *testing.T) {
 	assert.Equal(t, int64(100), tlm.TaskListPartitionConfig().Version)
 }
 
+func TestUpdateTaskListVersioningConfig(t *testing.T) {
+	testCases := []struct {
+		name            string
+		req             *types.UpdateTaskListVersioningConfigRequest
+		originalConfig  *types.TaskListVersioningConfig
+		partitionConfig *types.TaskListPartitionConfig
+		setupMocks      func(*mockDeps)
+		expectedConfig  *types.TaskListVersioningConfig
+		expectError     bool
+		expectedError   string
+		expectStopped   bool
+	}{
+		{
+			name: "success - add new default and notify partitions",
+			req:  &types.UpdateTaskListVersioningConfigRequest{AddNewDefaultBuildID: "build-2"},
+			originalConfig: &types.TaskListVersioningConfig{
+				Version:        1,
+				CompatibleSets: []*types.CompatibleBuildIDSet{{BuildIDs: []string{"build-1"}}},
+			},
+			partitionConfig: &types.TaskListPartitionConfig{
+				Version:         1,
+				ReadPartitions:  partitions(2),
+				WritePartitions: partitions(2),
+			},
+			setupMocks: func(deps *mockDeps) {
+				deps.mockTaskManager.EXPECT().UpdateTaskList(gomock.Any(), &persistence.UpdateTaskListRequest{
+					DomainName: "domainName",
+					TaskListInfo: &persistence.TaskListInfo{
+						DomainID: "domain-id",
+						Name:     "tl",
+						Kind:     persistence.TaskListKindNormal,
+						VersioningConfig: &persistence.TaskListVersioningConfig{
+							Version:        2,
+							CompatibleSets: [][]string{{"build-1"}, {"build-2"}},
+						},
+					},
+				}).Return(&persistence.UpdateTaskListResponse{}, nil)
+				deps.mockMatchingClient.EXPECT().RefreshTaskListPartitionConfig(gomock.Any(), &types.MatchingRefreshTaskListPartitionConfigRequest{
+					DomainUUID:   "domain-id",
+					TaskList:     &types.TaskList{Name: "/__cadence_sys/tl/1", Kind: types.TaskListKindNormal.Ptr()},
+					TaskListType: types.TaskListTypeDecision.Ptr(),
+				}).Return(&types.MatchingRefreshTaskListPartitionConfigResponse{}, nil)
+			},
+			expectedConfig: &types.TaskListVersioningConfig{
+				Version: 2,
+				CompatibleSets: []*types.CompatibleBuildIDSet{
+					{BuildIDs: []string{"build-1"}},
+					{BuildIDs: []string{"build-2"}},
+				},
+			},
+		},
+		{
+			name: "success - first build ID, no partitions",
+			req:  &types.UpdateTaskListVersioningConfigRequest{AddNewDefaultBuildID: "build-1"},
+			setupMocks: func(deps *mockDeps) {
+				deps.mockTaskManager.EXPECT().UpdateTaskList(gomock.Any(), gomock.Any()).Return(&persistence.UpdateTaskListResponse{}, nil)
+			},
+			expectedConfig: &types.TaskListVersioningConfig{
+				Version:        1,
+				CompatibleSets: []*types.CompatibleBuildIDSet{{BuildIDs: []string{"build-1"}}},
+			},
+		},
+		{
+			name:          "failure - invalid request",
+			req:           &types.UpdateTaskListVersioningConfigRequest{PromoteBuildID: "build-3"},
+			setupMocks:    func(deps *mockDeps) {},
+			expectError:   true,
+			expectedError: "build-3",
+		},
+		{
+			name: "failure - update failed",
+			req:  &types.UpdateTaskListVersioningConfigRequest{AddNewDefaultBuildID: "build-2"},
+			originalConfig: &types.TaskListVersioningConfig{
+				Version:        1,
+				CompatibleSets: []*types.CompatibleBuildIDSet{{BuildIDs: []string{"build-1"}}},
+			},
+			setupMocks: func(deps *mockDeps) {
+				deps.mockTaskManager.EXPECT().UpdateTaskList(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedConfig: &types.TaskListVersioningConfig{
+				Version:        1,
+				CompatibleSets: []*types.CompatibleBuildIDSet{{BuildIDs: []string{"build-1"}}},
+			},
+			expectError:   true,
+			expectedError: "some error",
+			expectStopped: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tlID, err := NewIdentifier("domain-id", "tl", persistence.TaskListTypeDecision)
+			require.NoError(t, err)
+			tlm, deps := setupMocksForTaskListManager(t, tlID, types.TaskListKindNormal)
+			tc.setupMocks(deps)
+			tlm.versioningConfig = tc.originalConfig
+			tlm.partitionConfig = tc.partitionConfig
+			tlm.startWG.Done()
+
+			resp, err := tlm.UpdateTaskListVersioningConfig(context.Background(), tc.req)
+			if tc.expectError {
+				assert.ErrorContains(t, err, tc.expectedError)
+				assert.Nil(t, resp)
+				assert.Equal(t, tc.originalConfig, tlm.TaskListVersioningConfig())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedConfig, resp)
+				assert.Equal(t, tc.expectedConfig, tlm.TaskListVersioningConfig())
+			}
+			if tc.expectStopped {
+				assert.Equal(t, int32(1), tlm.stopped)
+			}
+		})
+	}
+}
+
+func TestUpdateTaskListMigrationConfig(t *testing.T) {
+	migrationConfig := &types.TaskListMigrationConfig{TargetTaskList: "target", Alias: true}
+	testCases := []struct {
+		name            string
+		config          *types.TaskListMigrationConfig
+		originalConfig  *types.TaskListMigrationConfig
+		partitionConfig *types.TaskListPartitionConfig
+		setupMocks      func(*mockDeps)
+		expectedConfig  *types.TaskListMigrationConfig
+		expectedError   string
+		expectStopped   bool
+	}{
+		{
+			name:   "success - start migration and notify partitions",
+			config: migrationConfig,
+			partitionConfig: &types.TaskListPartitionConfig{
+				Version:         1,
+				ReadPartitions:  partitions(2),
+				WritePartitions: partitions(2),
+			},
+			setupMocks: func(deps *mockDeps) {
+				deps.mockTaskManager.EXPECT().UpdateTaskList(gomock.Any(), &persistence.UpdateTaskListRequest{
+					DomainName: "domainName",
+					TaskListInfo: &persistence.TaskListInfo{
+						DomainID: "domain-id",
+						Name:     "tl",
+						TaskType: persistence.TaskListTypeActivity,
+						Kind:     persistence.TaskListKindNormal,
+						MigrationConfig: &persistence.TaskListMigrationConfig{
+							TargetTaskList: "target",
+							Alias:          true,
+						},
+					},
+				}).Return(&persistence.UpdateTaskListResponse{}, nil)
+				deps.mockMatchingClient.EXPECT().RefreshTaskListPartitionConfig(gomock.Any(), &types.MatchingRefreshTaskListPartitionConfigRequest{
+					DomainUUID:   "domain-id",
+					TaskList:     &types.TaskList{Name: "/__cadence_sys/tl/1", Kind: types.TaskListKindNormal.Ptr()},
+					TaskListType: types.TaskListTypeActivity.Ptr(),
+				}).Return(&types.MatchingRefreshTaskListPartitionConfigResponse{}, nil)
+			},
+			expectedConfig: migrationConfig,
+		},
+		{
+			name:           "success - stop migration",
+			originalConfig: migrationConfig,
+			setupMocks: func(deps *mockDeps) {
+				deps.mockTaskManager.EXPECT().UpdateTaskList(gomock.Any(), gomock.Any()).Return(&persistence.UpdateTaskListResponse{}, nil)
+			},
+		},
+		{
+			name:           "success - no change",
+			config:         &types.TaskListMigrationConfig{TargetTaskList: "target", Alias: true},
+			originalConfig: migrationConfig,
+			setupMocks:     func(deps *mockDeps) {},
+			expectedConfig: migrationConfig,
+		},
+		{
+			name:   "failure - update failed",
+			config: migrationConfig,
+			setupMocks: func(deps *mockDeps) {
+				deps.mockTaskManager.EXPECT().UpdateTaskList(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedError: "some error",
+			expectStopped: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tlID, err := NewIdentifier("domain-id", "tl", persistence.TaskListTypeActivity)
+			require.NoError(t, err)
+			tlm, deps := setupMocksForTaskListManager(t, tlID, types.TaskListKindNormal)
+			tc.setupMocks(deps)
+			tlm.migrationConfig = tc.originalConfig
+			tlm.partitionConfig = tc.partitionConfig
+			tlm.startWG.Done()
+
+			err = tlm.UpdateTaskListMigrationConfig(context.Background(), tc.config)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+				assert.Equal(t, tc.originalConfig, tlm.TaskListMigrationConfig())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedConfig, tlm.TaskListMigrationConfig())
+			}
+			if tc.expectStopped {
+				assert.Equal(t, int32(1), tlm.stopped)
+			}
+		})
+	}
+}
+
 func TestManagerStart_RootPartition(t *testing.T) {
 	tlID, err := NewIdentifier("domain-id", "tl", persistence.TaskListTypeDecision)
 	require.NoError(t, err)
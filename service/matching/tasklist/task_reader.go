@@ -411,16 +411,17 @@ func (tr *taskReader) getDispatchTimeout(rps float64, isolationDuration time.Dur
 }
 
 func (tr *taskReader) dispatchSingleTaskFromBufferWithRetries(taskInfo *persistence.TaskInfo) (breakDispatchLoop bool) {
+	breakdown := &dispatchBreakdown{backlogWaitTime: tr.timeSource.Since(taskInfo.CreatedTime)}
 	// retry loop for dispatching a single task
 	for {
-		breakDispatchLoop, breakRetryLoop := tr.dispatchSingleTaskFromBuffer(taskInfo)
+		breakDispatchLoop, breakRetryLoop := tr.dispatchSingleTaskFromBuffer(taskInfo, breakdown)
 		if breakRetryLoop {
 			return breakDispatchLoop
 		}
 	}
 }
 
-func (tr *taskReader) dispatchSingleTaskFromBuffer(taskInfo *persistence.TaskInfo) (breakDispatchLoop bool, breakRetries bool) {
+func (tr *taskReader) dispatchSingleTaskFromBuffer(taskInfo *persistence.TaskInfo, breakdown *dispatchBreakdown) (breakDispatchLoop bool, breakRetries bool) {
 	isolationGroup, isolationDuration := tr.getIsolationGroupForTask(tr.cancelCtx, taskInfo)
 	_, isolationGroupIsKnown := tr.taskBuffers[isolationGroup]
 	if !isolationGroupIsKnown {
@@ -428,11 +429,16 @@ func (tr *taskReader) dispatchSingleTaskFromBuffer(taskInfo *persistence.TaskInf
 		isolationDuration = noIsolationTimeout
 	}
 	task := newInternalTask(taskInfo, tr.completeTask, types.TaskSourceDbBacklog, "", false, nil, isolationGroup)
+	task.breakdown = *breakdown
 	dispatchCtx, cancel := tr.newDispatchContext(isolationGroup, isolationDuration)
 	timerScope := tr.scope.StartTimer(metrics.AsyncMatchLatencyPerTaskList)
 	err := tr.dispatchTask(dispatchCtx, task)
 	timerScope.Stop()
 	cancel()
+	if err != nil {
+		// the task is not owned by a poller, so time spent on this attempt is carried over to the next one
+		*breakdown = task.breakdown
+	}
 
 	e := event.E{
 		TaskListName: tr.taskListID.GetName(),
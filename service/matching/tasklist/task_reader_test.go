@@ -213,7 +213,7 @@ func TestDispatchSingleTaskFromBuffer(t *testing.T) {
 			taskInfo := newTask(timeSource)
 			taskInfo.Expiry = timeSource.Now().Add(time.Duration(tc.ttl) * time.Second)
 
-			breakDispatch, breakRetries := reader.dispatchSingleTaskFromBuffer(taskInfo)
+			breakDispatch, breakRetries := reader.dispatchSingleTaskFromBuffer(taskInfo, &dispatchBreakdown{})
 			assert.Equal(t, tc.breakDispatch, breakDispatch)
 			assert.Equal(t, tc.breakRetries, breakRetries)
 		})
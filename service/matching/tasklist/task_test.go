@@ -148,6 +148,33 @@ func TestNewInternalTask(t *testing.T) {
 	}
 }
 
+func TestInternalTaskScheduleToStartBreakdown(t *testing.T) {
+	completionFunc := func(_ *persistence.TaskInfo, _ error) {}
+
+	syncTask := newInternalTask(defaultTaskInfo(nil), completionFunc, types.TaskSourceHistory, "", true, nil, "")
+	syncTask.breakdown.ratelimitWaitTime = 15 * time.Millisecond
+	assert.Equal(t, &types.ScheduleToStartBreakdown{
+		SyncMatched:           true,
+		RatelimitWaitTimeInMs: 15,
+	}, syncTask.ScheduleToStartBreakdown())
+
+	backlogTask := newInternalTask(defaultTaskInfo(nil), completionFunc, types.TaskSourceDbBacklog, "elsewhere", true, nil, "a")
+	backlogTask.breakdown = dispatchBreakdown{
+		backlogWaitTime:   2 * time.Second,
+		ratelimitWaitTime: 10 * time.Millisecond,
+		isolationWaitTime: 300 * time.Millisecond,
+	}
+	assert.Equal(t, &types.ScheduleToStartBreakdown{
+		Forwarded:             true,
+		BacklogWaitTimeInMs:   2000,
+		RatelimitWaitTimeInMs: 10,
+		IsolationWaitTimeInMs: 300,
+	}, backlogTask.ScheduleToStartBreakdown())
+
+	queryTask := &InternalTask{Query: &queryTaskInfo{TaskID: "query"}}
+	assert.Nil(t, queryTask.ScheduleToStartBreakdown())
+}
+
 func defaultTaskInfo(partitionConfig map[string]string) *persistence.TaskInfo {
 	return &persistence.TaskInfo{
 		DomainID:                      "DomainID",
@@ -0,0 +1,162 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tasklist
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const maxBuildIDLength = 255
+
+// VersionSetForTask returns the ID of the compatible set a decision task should be routed to.
+// Tasks of workflows that were last processed by a registered build stay on the set of that build,
+// everything else goes to the default set. Returns empty string if the task list is not versioned.
+func VersionSetForTask(config *types.TaskListVersioningConfig, buildID string) string {
+	set := config.FindSet(buildID)
+	if set == nil {
+		set = config.GetDefaultSet()
+	}
+	return VersionSetID(set)
+}
+
+// VersionSetForPoller returns the ID of the compatible set a poller with the given build ID should poll from.
+// Returns empty string if the build ID is not registered, in which case the poller polls the unversioned
+// task list, serving tasks that were persisted before versioning was enabled.
+func VersionSetForPoller(config *types.TaskListVersioningConfig, buildID string) string {
+	return VersionSetID(config.FindSet(buildID))
+}
+
+// VersionSetID returns the ID of a compatible set, which is the first build ID added to the set.
+// It never changes when compatible builds are added or the set is promoted.
+func VersionSetID(set *types.CompatibleBuildIDSet) string {
+	buildIDs := set.GetBuildIDs()
+	if len(buildIDs) == 0 {
+		return ""
+	}
+	return buildIDs[0]
+}
+
+// applyVersioningConfigUpdate returns a copy of the config with the update request applied
+func applyVersioningConfigUpdate(config *types.TaskListVersioningConfig, request *types.UpdateTaskListVersioningConfigRequest) (*types.TaskListVersioningConfig, error) {
+	numOperations := 0
+	if request.GetAddNewDefaultBuildID() != "" {
+		numOperations++
+	}
+	if request.GetAddCompatibleBuildID() != nil {
+		numOperations++
+	}
+	if request.GetPromoteBuildID() != "" {
+		numOperations++
+	}
+	if numOperations != 1 {
+		return nil, &types.BadRequestError{Message: "Exactly one versioning operation must be set in the request."}
+	}
+
+	sets := make([][]string, 0, len(config.GetCompatibleSets())+1)
+	for _, set := range config.GetCompatibleSets() {
+		sets = append(sets, slices.Clone(set.GetBuildIDs()))
+	}
+	findSet := func(buildID string) int {
+		return slices.IndexFunc(sets, func(set []string) bool { return slices.Contains(set, buildID) })
+	}
+
+	switch {
+	case request.GetAddNewDefaultBuildID() != "":
+		buildID := request.GetAddNewDefaultBuildID()
+		if err := validateBuildID(buildID); err != nil {
+			return nil, err
+		}
+		if findSet(buildID) >= 0 {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("Build ID %v is already registered.", buildID)}
+		}
+		sets = append(sets, []string{buildID})
+	case request.GetAddCompatibleBuildID() != nil:
+		add := request.GetAddCompatibleBuildID()
+		if err := validateBuildID(add.GetBuildID()); err != nil {
+			return nil, err
+		}
+		if findSet(add.GetBuildID()) >= 0 {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("Build ID %v is already registered.", add.GetBuildID())}
+		}
+		idx := findSet(add.GetExistingCompatibleBuildID())
+		if idx < 0 {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("Build ID %v is not registered.", add.GetExistingCompatibleBuildID())}
+		}
+		sets[idx] = append(sets[idx], add.GetBuildID())
+		if add.GetMakeDefault() {
+			sets = moveToEnd(sets, idx)
+		}
+	default:
+		buildID := request.GetPromoteBuildID()
+		idx := findSet(buildID)
+		if idx < 0 {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("Build ID %v is not registered.", buildID)}
+		}
+		// builds of a set share the same task list, so promoting a build makes its whole set the default
+		sets = moveToEnd(sets, idx)
+	}
+
+	compatibleSets := make([]*types.CompatibleBuildIDSet, len(sets))
+	for i, set := range sets {
+		compatibleSets[i] = &types.CompatibleBuildIDSet{BuildIDs: set}
+	}
+	return &types.TaskListVersioningConfig{
+		Version:        config.GetVersion() + 1,
+		CompatibleSets: compatibleSets,
+	}, nil
+}
+
+func moveToEnd[T any](s []T, idx int) []T {
+	v := s[idx]
+	s = slices.Delete(s, idx, idx+1)
+	return append(s, v)
+}
+
+func validateBuildID(buildID string) error {
+	if buildID == "" {
+		return &types.BadRequestError{Message: "Build ID is not set."}
+	}
+	if len(buildID) > maxBuildIDLength {
+		return &types.BadRequestError{Message: fmt.Sprintf("Build ID exceeds length limit of %v.", maxBuildIDLength)}
+	}
+	if strings.Contains(buildID, "/") {
+		return &types.BadRequestError{Message: "Build ID cannot contain '/'."}
+	}
+	return nil
+}
+
+func toPersistenceVersioningConfig(config *types.TaskListVersioningConfig) *persistence.TaskListVersioningConfig {
+	sets := make([][]string, len(config.GetCompatibleSets()))
+	for i, set := range config.GetCompatibleSets() {
+		sets[i] = set.GetBuildIDs()
+	}
+	return &persistence.TaskListVersioningConfig{
+		Version:        config.GetVersion(),
+		CompatibleSets: sets,
+	}
+}
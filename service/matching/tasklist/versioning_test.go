@@ -0,0 +1,232 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tasklist
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestVersionSetRouting(t *testing.T) {
+	config := &types.TaskListVersioningConfig{
+		Version: 2,
+		CompatibleSets: []*types.CompatibleBuildIDSet{
+			{BuildIDs: []string{"1.0", "1.1"}},
+			{BuildIDs: []string{"2.0"}},
+		},
+	}
+	tests := []struct {
+		name      string
+		config    *types.TaskListVersioningConfig
+		buildID   string
+		taskSet   string
+		pollerSet string
+	}{
+		{
+			name:    "not versioned",
+			config:  nil,
+			buildID: "1.0",
+		},
+		{
+			name:    "new workflow",
+			config:  config,
+			buildID: "",
+			taskSet: "2.0",
+		},
+		{
+			name:      "pinned to compatible set",
+			config:    config,
+			buildID:   "1.1",
+			taskSet:   "1.0",
+			pollerSet: "1.0",
+		},
+		{
+			name:      "default set",
+			config:    config,
+			buildID:   "2.0",
+			taskSet:   "2.0",
+			pollerSet: "2.0",
+		},
+		{
+			name:    "unregistered build",
+			config:  config,
+			buildID: "0.9",
+			taskSet: "2.0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.taskSet, VersionSetForTask(tt.config, tt.buildID))
+			assert.Equal(t, tt.pollerSet, VersionSetForPoller(tt.config, tt.buildID))
+		})
+	}
+}
+
+func TestApplyVersioningConfigUpdate(t *testing.T) {
+	config := &types.TaskListVersioningConfig{
+		Version: 2,
+		CompatibleSets: []*types.CompatibleBuildIDSet{
+			{BuildIDs: []string{"1.0", "1.1"}},
+			{BuildIDs: []string{"2.0"}},
+		},
+	}
+	tests := []struct {
+		name    string
+		config  *types.TaskListVersioningConfig
+		request *types.UpdateTaskListVersioningConfigRequest
+		want    *types.TaskListVersioningConfig
+		wantErr bool
+	}{
+		{
+			name:    "no operation",
+			config:  config,
+			request: &types.UpdateTaskListVersioningConfigRequest{},
+			wantErr: true,
+		},
+		{
+			name:   "multiple operations",
+			config: config,
+			request: &types.UpdateTaskListVersioningConfigRequest{
+				AddNewDefaultBuildID: "3.0",
+				PromoteBuildID:       "1.0",
+			},
+			wantErr: true,
+		},
+		{
+			name:    "first build ID",
+			config:  nil,
+			request: &types.UpdateTaskListVersioningConfigRequest{AddNewDefaultBuildID: "1.0"},
+			want: &types.TaskListVersioningConfig{
+				Version:        1,
+				CompatibleSets: []*types.CompatibleBuildIDSet{{BuildIDs: []string{"1.0"}}},
+			},
+		},
+		{
+			name:    "new default build ID",
+			config:  config,
+			request: &types.UpdateTaskListVersioningConfigRequest{AddNewDefaultBuildID: "3.0"},
+			want: &types.TaskListVersioningConfig{
+				Version: 3,
+				CompatibleSets: []*types.CompatibleBuildIDSet{
+					{BuildIDs: []string{"1.0", "1.1"}},
+					{BuildIDs: []string{"2.0"}},
+					{BuildIDs: []string{"3.0"}},
+				},
+			},
+		},
+		{
+			name:    "new default build ID already registered",
+			config:  config,
+			request: &types.UpdateTaskListVersioningConfigRequest{AddNewDefaultBuildID: "1.1"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid build ID",
+			config:  config,
+			request: &types.UpdateTaskListVersioningConfigRequest{AddNewDefaultBuildID: "3/0"},
+			wantErr: true,
+		},
+		{
+			name:    "build ID too long",
+			config:  config,
+			request: &types.UpdateTaskListVersioningConfigRequest{AddNewDefaultBuildID: strings.Repeat("a", maxBuildIDLength+1)},
+			wantErr: true,
+		},
+		{
+			name:   "compatible build ID",
+			config: config,
+			request: &types.UpdateTaskListVersioningConfigRequest{AddCompatibleBuildID: &types.AddCompatibleBuildID{
+				BuildID:                   "1.2",
+				ExistingCompatibleBuildID: "1.1",
+			}},
+			want: &types.TaskListVersioningConfig{
+				Version: 3,
+				CompatibleSets: []*types.CompatibleBuildIDSet{
+					{BuildIDs: []string{"1.0", "1.1", "1.2"}},
+					{BuildIDs: []string{"2.0"}},
+				},
+			},
+		},
+		{
+			name:   "compatible build ID made default",
+			config: config,
+			request: &types.UpdateTaskListVersioningConfigRequest{AddCompatibleBuildID: &types.AddCompatibleBuildID{
+				BuildID:                   "1.2",
+				ExistingCompatibleBuildID: "1.0",
+				MakeDefault:               true,
+			}},
+			want: &types.TaskListVersioningConfig{
+				Version: 3,
+				CompatibleSets: []*types.CompatibleBuildIDSet{
+					{BuildIDs: []string{"2.0"}},
+					{BuildIDs: []string{"1.0", "1.1", "1.2"}},
+				},
+			},
+		},
+		{
+			name:   "compatible with unknown build ID",
+			config: config,
+			request: &types.UpdateTaskListVersioningConfigRequest{AddCompatibleBuildID: &types.AddCompatibleBuildID{
+				BuildID:                   "1.2",
+				ExistingCompatibleBuildID: "0.9",
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "roll back to previous build",
+			config:  config,
+			request: &types.UpdateTaskListVersioningConfigRequest{PromoteBuildID: "1.1"},
+			want: &types.TaskListVersioningConfig{
+				Version: 3,
+				CompatibleSets: []*types.CompatibleBuildIDSet{
+					{BuildIDs: []string{"2.0"}},
+					{BuildIDs: []string{"1.0", "1.1"}},
+				},
+			},
+		},
+		{
+			name:    "promote unknown build",
+			config:  config,
+			request: &types.UpdateTaskListVersioningConfigRequest{PromoteBuildID: "3.0"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := applyVersioningConfigUpdate(tt.config, tt.request)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+	// the original config must not be modified
+	assert.Equal(t, []string{"1.0", "1.1"}, config.CompatibleSets[0].BuildIDs)
+	assert.Len(t, config.CompatibleSets, 2)
+}
@@ -0,0 +1,235 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package worker
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	registryInitSize    = 0
+	registryInitMaxSize = 5000
+	// registryTTL matches the poller history of task lists, so a worker disappears
+	// from the registry at the same time it disappears from DescribeTaskList
+	registryTTL = 5 * time.Minute
+	// pollRateWindow is the time constant of the exponentially decaying poll rate
+	pollRateWindow = time.Minute
+)
+
+type (
+	// Poll describes a single poll request received from a worker
+	Poll struct {
+		Identity     string
+		TaskList     string
+		TaskListType types.TaskListType
+		VersionInfo  *types.WorkerVersionInfo
+	}
+
+	// Registry keeps track of the workers polling the task lists owned by this host, per domain.
+	// Unlike the poller history of a task list, it is keyed by worker identity, so it shows all
+	// the task lists a worker polls and its overall poll rate.
+	Registry interface {
+		RecordPoll(domainID string, poll *Poll)
+		List(domainID string) []*types.WorkerInfo
+		Describe(domainID, identity string) *types.WorkerInfo
+	}
+
+	registry struct {
+		timeSource clock.TimeSource
+
+		sync.RWMutex
+		domains map[string]*domainWorkers
+	}
+
+	domainWorkers struct {
+		sync.RWMutex
+		// identity -> *workerState
+		workers cache.Cache
+	}
+
+	workerState struct {
+		versionInfo  *types.WorkerVersionInfo
+		lastPollTime time.Time
+		pollRate     float64
+		taskLists    map[taskListKey]time.Time
+	}
+
+	taskListKey struct {
+		name     string
+		taskType types.TaskListType
+	}
+)
+
+// NewRegistry creates a new worker registry
+func NewRegistry(timeSource clock.TimeSource) Registry {
+	return &registry{
+		timeSource: timeSource,
+		domains:    make(map[string]*domainWorkers),
+	}
+}
+
+// RecordPoll records a poll of the given worker, refreshing its liveness
+func (r *registry) RecordPoll(domainID string, poll *Poll) {
+	if poll == nil || poll.Identity == "" {
+		return
+	}
+	domain := r.getOrCreateDomain(domainID)
+	now := r.timeSource.Now()
+
+	domain.Lock()
+	defer domain.Unlock()
+
+	state, _ := domain.workers.Get(poll.Identity).(*workerState)
+	if state == nil {
+		state = &workerState{taskLists: make(map[taskListKey]time.Time)}
+	}
+	state.pollRate = state.decayedPollRate(now) + 1/pollRateWindow.Seconds()
+	state.lastPollTime = now
+	if poll.VersionInfo != nil {
+		state.versionInfo = poll.VersionInfo
+	}
+	for key, lastPollTime := range state.taskLists {
+		if now.Sub(lastPollTime) > registryTTL {
+			delete(state.taskLists, key)
+		}
+	}
+	state.taskLists[taskListKey{name: poll.TaskList, taskType: poll.TaskListType}] = now
+	// put the state back even if it already exists to refresh its TTL
+	domain.workers.Put(poll.Identity, state)
+}
+
+// List returns all workers of the domain that polled recently, ordered by identity
+func (r *registry) List(domainID string) []*types.WorkerInfo {
+	domain := r.getDomain(domainID)
+	if domain == nil {
+		return nil
+	}
+	now := r.timeSource.Now()
+
+	domain.RLock()
+	defer domain.RUnlock()
+
+	var result []*types.WorkerInfo
+	it := domain.workers.Iterator()
+	defer it.Close()
+	for it.HasNext() {
+		entry := it.Next()
+		result = append(result, entry.Value().(*workerState).toWorkerInfo(entry.Key().(string), now))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Identity < result[j].Identity
+	})
+	return result
+}
+
+// Describe returns the given worker, or nil if it has not polled any task list of the domain recently
+func (r *registry) Describe(domainID, identity string) *types.WorkerInfo {
+	domain := r.getDomain(domainID)
+	if domain == nil {
+		return nil
+	}
+	now := r.timeSource.Now()
+
+	domain.RLock()
+	defer domain.RUnlock()
+
+	state, _ := domain.workers.Get(identity).(*workerState)
+	if state == nil {
+		return nil
+	}
+	return state.toWorkerInfo(identity, now)
+}
+
+func (r *registry) getDomain(domainID string) *domainWorkers {
+	r.RLock()
+	defer r.RUnlock()
+	return r.domains[domainID]
+}
+
+func (r *registry) getOrCreateDomain(domainID string) *domainWorkers {
+	if domain := r.getDomain(domainID); domain != nil {
+		return domain
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	if domain, ok := r.domains[domainID]; ok {
+		return domain
+	}
+	domain := &domainWorkers{
+		workers: cache.New(&cache.Options{
+			InitialCapacity: registryInitSize,
+			TTL:             registryTTL,
+			Pin:             false,
+			MaxCount:        registryInitMaxSize,
+			TimeSource:      r.timeSource,
+		}),
+	}
+	r.domains[domainID] = domain
+	return domain
+}
+
+func (s *workerState) decayedPollRate(now time.Time) float64 {
+	if s.lastPollTime.IsZero() {
+		return 0
+	}
+	elapsed := now.Sub(s.lastPollTime)
+	if elapsed <= 0 {
+		return s.pollRate
+	}
+	return s.pollRate * math.Exp(-elapsed.Seconds()/pollRateWindow.Seconds())
+}
+
+func (s *workerState) toWorkerInfo(identity string, now time.Time) *types.WorkerInfo {
+	info := &types.WorkerInfo{
+		Identity:       identity,
+		VersionInfo:    s.versionInfo,
+		LastPollTime:   common.Int64Ptr(s.lastPollTime.UnixNano()),
+		PollsPerSecond: s.decayedPollRate(now),
+	}
+	for key, lastPollTime := range s.taskLists {
+		if now.Sub(lastPollTime) > registryTTL {
+			continue
+		}
+		info.TaskLists = append(info.TaskLists, &types.WorkerTaskListInfo{
+			TaskList:     &types.TaskList{Name: key.name, Kind: types.TaskListKindNormal.Ptr()},
+			TaskListType: key.taskType.Ptr(),
+			LastPollTime: common.Int64Ptr(lastPollTime.UnixNano()),
+		})
+	}
+	sort.Slice(info.TaskLists, func(i, j int) bool {
+		if info.TaskLists[i].TaskList.Name != info.TaskLists[j].TaskList.Name {
+			return info.TaskLists[i].TaskList.Name < info.TaskLists[j].TaskList.Name
+		}
+		return info.TaskLists[i].GetTaskListType() < info.TaskLists[j].GetTaskListType()
+	})
+	return info
+}
@@ -0,0 +1,145 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package worker
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	testDomainID      = "domain-id"
+	testOtherDomainID = "other-domain-id"
+)
+
+func TestRegistry_RecordPollRequiresIdentity(t *testing.T) {
+	r := NewRegistry(clock.NewMockedTimeSource())
+
+	r.RecordPoll(testDomainID, nil)
+	r.RecordPoll(testDomainID, &Poll{TaskList: "tl", TaskListType: types.TaskListTypeDecision})
+
+	assert.Empty(t, r.List(testDomainID))
+}
+
+func TestRegistry_AggregatesTaskLists(t *testing.T) {
+	mockTime := clock.NewMockedTimeSource()
+	r := NewRegistry(mockTime)
+	v1 := &types.WorkerVersionInfo{Impl: "uber-go", FeatureVersion: "1.0.0"}
+	v2 := &types.WorkerVersionInfo{Impl: "uber-go", FeatureVersion: "1.1.0"}
+
+	r.RecordPoll(testDomainID, &Poll{Identity: "worker", TaskList: "tl-b", TaskListType: types.TaskListTypeDecision, VersionInfo: v1})
+	firstPoll := mockTime.Now()
+	mockTime.Advance(time.Second)
+	r.RecordPoll(testDomainID, &Poll{Identity: "worker", TaskList: "tl-a", TaskListType: types.TaskListTypeActivity, VersionInfo: v2})
+	r.RecordPoll(testDomainID, &Poll{Identity: "worker", TaskList: "tl-b", TaskListType: types.TaskListTypeActivity})
+	lastPoll := mockTime.Now()
+
+	workers := r.List(testDomainID)
+	require.Len(t, workers, 1)
+	worker := workers[0]
+	assert.Equal(t, "worker", worker.Identity)
+	assert.Equal(t, v2, worker.VersionInfo, "version of the latest poll should be kept")
+	assert.Equal(t, lastPoll.UnixNano(), worker.GetLastPollTime())
+	assert.Equal(t, []*types.WorkerTaskListInfo{
+		{
+			TaskList:     &types.TaskList{Name: "tl-a", Kind: types.TaskListKindNormal.Ptr()},
+			TaskListType: types.TaskListTypeActivity.Ptr(),
+			LastPollTime: common.Int64Ptr(lastPoll.UnixNano()),
+		},
+		{
+			TaskList:     &types.TaskList{Name: "tl-b", Kind: types.TaskListKindNormal.Ptr()},
+			TaskListType: types.TaskListTypeDecision.Ptr(),
+			LastPollTime: common.Int64Ptr(firstPoll.UnixNano()),
+		},
+		{
+			TaskList:     &types.TaskList{Name: "tl-b", Kind: types.TaskListKindNormal.Ptr()},
+			TaskListType: types.TaskListTypeActivity.Ptr(),
+			LastPollTime: common.Int64Ptr(lastPoll.UnixNano()),
+		},
+	}, worker.TaskLists)
+
+	assert.Equal(t, worker, r.Describe(testDomainID, "worker"))
+	assert.Nil(t, r.Describe(testDomainID, "unknown"))
+	assert.Nil(t, r.Describe(testOtherDomainID, "worker"))
+	assert.Empty(t, r.List(testOtherDomainID))
+}
+
+func TestRegistry_ListOrdersByIdentity(t *testing.T) {
+	r := NewRegistry(clock.NewMockedTimeSource())
+
+	for _, identity := range []string{"c", "a", "b"} {
+		r.RecordPoll(testDomainID, &Poll{Identity: identity, TaskList: "tl", TaskListType: types.TaskListTypeDecision})
+	}
+
+	workers := r.List(testDomainID)
+	require.Len(t, workers, 3)
+	assert.Equal(t, "a", workers[0].Identity)
+	assert.Equal(t, "b", workers[1].Identity)
+	assert.Equal(t, "c", workers[2].Identity)
+}
+
+func TestRegistry_PollRate(t *testing.T) {
+	mockTime := clock.NewMockedTimeSource()
+	r := NewRegistry(mockTime)
+	poll := &Poll{Identity: "worker", TaskList: "tl", TaskListType: types.TaskListTypeDecision}
+
+	r.RecordPoll(testDomainID, poll)
+	assert.InDelta(t, 1.0/60, r.Describe(testDomainID, "worker").PollsPerSecond, 1e-9)
+
+	mockTime.Advance(time.Minute)
+	assert.InDelta(t, math.Exp(-1)/60, r.Describe(testDomainID, "worker").PollsPerSecond, 1e-9)
+
+	// a worker polling once per second converges to a rate of one poll per second
+	for i := 0; i < 600; i++ {
+		mockTime.Advance(time.Second)
+		r.RecordPoll(testDomainID, poll)
+	}
+	assert.InDelta(t, 1, r.Describe(testDomainID, "worker").PollsPerSecond, 0.01)
+}
+
+func TestRegistry_Expiry(t *testing.T) {
+	mockTime := clock.NewMockedTimeSource()
+	r := NewRegistry(mockTime)
+
+	r.RecordPoll(testDomainID, &Poll{Identity: "worker", TaskList: "tl-a", TaskListType: types.TaskListTypeDecision})
+	mockTime.Advance(registryTTL - time.Minute)
+	r.RecordPoll(testDomainID, &Poll{Identity: "worker", TaskList: "tl-b", TaskListType: types.TaskListTypeDecision})
+	mockTime.Advance(2 * time.Minute)
+
+	worker := r.Describe(testDomainID, "worker")
+	require.NotNil(t, worker)
+	require.Len(t, worker.TaskLists, 1, "task lists that were not polled recently should be dropped")
+	assert.Equal(t, "tl-b", worker.TaskLists[0].TaskList.Name)
+
+	mockTime.Advance(registryTTL)
+	assert.Nil(t, r.Describe(testDomainID, "worker"))
+	assert.Empty(t, r.List(testDomainID))
+}
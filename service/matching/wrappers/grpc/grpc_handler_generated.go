@@ -35,11 +35,26 @@ func (g GRPCHandler) CancelOutstandingPoll(ctx context.Context, request *matchin
 	return &matchingv1.CancelOutstandingPollResponse{}, proto.FromError(err)
 }
 
+func (g GRPCHandler) DescribeDomainTaskQuota(ctx context.Context, request *matchingv1.DescribeDomainTaskQuotaRequest) (*matchingv1.DescribeDomainTaskQuotaResponse, error) {
+	response, err := g.h.DescribeDomainTaskQuota(ctx, proto.ToMatchingDescribeDomainTaskQuotaRequest(request))
+	return proto.FromMatchingDescribeDomainTaskQuotaResponse(response), proto.FromError(err)
+}
+
 func (g GRPCHandler) DescribeTaskList(ctx context.Context, request *matchingv1.DescribeTaskListRequest) (*matchingv1.DescribeTaskListResponse, error) {
 	response, err := g.h.DescribeTaskList(ctx, proto.ToMatchingDescribeTaskListRequest(request))
 	return proto.FromMatchingDescribeTaskListResponse(response), proto.FromError(err)
 }
 
+func (g GRPCHandler) DescribeWorker(ctx context.Context, request *matchingv1.DescribeWorkerRequest) (*matchingv1.DescribeWorkerResponse, error) {
+	response, err := g.h.DescribeWorker(ctx, proto.ToMatchingDescribeWorkerRequest(request))
+	return proto.FromMatchingDescribeWorkerResponse(response), proto.FromError(err)
+}
+
+func (g GRPCHandler) GetTaskListScalingRecommendation(ctx context.Context, request *matchingv1.GetTaskListScalingRecommendationRequest) (*matchingv1.GetTaskListScalingRecommendationResponse, error) {
+	response, err := g.h.GetTaskListScalingRecommendation(ctx, proto.ToMatchingGetTaskListScalingRecommendationRequest(request))
+	return proto.FromMatchingGetTaskListScalingRecommendationResponse(response), proto.FromError(err)
+}
+
 func (g GRPCHandler) GetTaskListsByDomain(ctx context.Context, request *matchingv1.GetTaskListsByDomainRequest) (*matchingv1.GetTaskListsByDomainResponse, error) {
 	response, err := g.h.GetTaskListsByDomain(ctx, proto.ToMatchingGetTaskListsByDomainRequest(request))
 	return proto.FromMatchingGetTaskListsByDomainResponse(response), proto.FromError(err)
@@ -50,6 +65,16 @@ func (g GRPCHandler) ListTaskListPartitions(ctx context.Context, request *matchi
 	return proto.FromMatchingListTaskListPartitionsResponse(response), proto.FromError(err)
 }
 
+func (g GRPCHandler) ListWorkers(ctx context.Context, request *matchingv1.ListWorkersRequest) (*matchingv1.ListWorkersResponse, error) {
+	response, err := g.h.ListWorkers(ctx, proto.ToMatchingListWorkersRequest(request))
+	return proto.FromMatchingListWorkersResponse(response), proto.FromError(err)
+}
+
+func (g GRPCHandler) MigrateTaskList(ctx context.Context, request *matchingv1.MigrateTaskListRequest) (*matchingv1.MigrateTaskListResponse, error) {
+	response, err := g.h.MigrateTaskList(ctx, proto.ToMatchingMigrateTaskListRequest(request))
+	return proto.FromMatchingMigrateTaskListResponse(response), proto.FromError(err)
+}
+
 func (g GRPCHandler) PollForActivityTask(ctx context.Context, request *matchingv1.PollForActivityTaskRequest) (*matchingv1.PollForActivityTaskResponse, error) {
 	response, err := g.h.PollForActivityTask(ctx, proto.ToMatchingPollForActivityTaskRequest(request))
 	return proto.FromMatchingPollForActivityTaskResponse(response), proto.FromError(err)
@@ -79,3 +104,8 @@ func (g GRPCHandler) UpdateTaskListPartitionConfig(ctx context.Context, request
 	response, err := g.h.UpdateTaskListPartitionConfig(ctx, proto.ToMatchingUpdateTaskListPartitionConfigRequest(request))
 	return proto.FromMatchingUpdateTaskListPartitionConfigResponse(response), proto.FromError(err)
 }
+
+func (g GRPCHandler) UpdateTaskListVersioningConfig(ctx context.Context, request *matchingv1.UpdateTaskListVersioningConfigRequest) (*matchingv1.UpdateTaskListVersioningConfigResponse, error) {
+	response, err := g.h.UpdateTaskListVersioningConfig(ctx, proto.ToMatchingUpdateTaskListVersioningConfigRequest(request))
+	return proto.FromMatchingUpdateTaskListVersioningConfigResponse(response), proto.FromError(err)
+}
@@ -57,6 +57,8 @@ type (
 
 	// LeaderStore provides a config for leader election.
 	LeaderStore struct {
+		// Type is the name of the registered leader store implementation, etcd if not set
+		Type          string           `yaml:"type"`
 		StorageParams *config.YamlNode `yaml:"storageParams"`
 	}
 
@@ -77,6 +79,14 @@ type (
 	LeaderProcess struct {
 		Period       time.Duration `yaml:"period"`
 		HeartbeatTTL time.Duration `yaml:"heartbeatTTL"`
+		// LoadBalancing moves shards from the most to the least loaded executors based on the shard load reported in heartbeats.
+		LoadBalancing bool `yaml:"loadBalancing"`
+		// LoadImbalanceThreshold is the ratio of the most loaded executor to the mean executor load above which shards are moved.
+		LoadImbalanceThreshold float64 `yaml:"loadImbalanceThreshold"`
+		// MaxShardMovesPerRebalance bounds how many shards are moved for load balancing in a single rebalance.
+		MaxShardMovesPerRebalance int `yaml:"maxShardMovesPerRebalance"`
+		// ShardMoveCooldown is the minimum time a shard stays on an executor before it can be moved for load balancing.
+		ShardMoveCooldown time.Duration `yaml:"shardMoveCooldown"`
 	}
 )
 
@@ -0,0 +1,323 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/sharddistributor/leader/store"
+)
+
+// _maxControlUpdateAttempts is the number of times an admin update is retried if the namespace control changed concurrently
+const _maxControlUpdateAttempts = 3
+
+func (h *handlerImpl) ListNamespaces(ctx context.Context, request *types.ListNamespacesRequest) (*types.ListNamespacesResponse, error) {
+	namespaces := make([]*types.NamespaceInfo, 0, len(h.namespaces))
+	for _, namespace := range h.namespaces {
+		namespaces = append(namespaces, &types.NamespaceInfo{
+			Name:     namespace.Name,
+			Type:     namespace.Type,
+			ShardNum: namespace.ShardNum,
+		})
+	}
+	sort.Slice(namespaces, func(i, j int) bool { return namespaces[i].Name < namespaces[j].Name })
+
+	return &types.ListNamespacesResponse{Namespaces: namespaces}, nil
+}
+
+func (h *handlerImpl) DescribeNamespace(ctx context.Context, request *types.DescribeNamespaceRequest) (*types.DescribeNamespaceResponse, error) {
+	adminStore, err := h.adminStore(ctx, request.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+
+	heartbeats, assignments, _, err := adminStore.GetState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get namespace state: %w", err)
+	}
+	control, err := adminStore.GetControl(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get namespace control: %w", err)
+	}
+
+	executorIDs := make(map[string]struct{}, len(heartbeats))
+	for executorID := range heartbeats {
+		executorIDs[executorID] = struct{}{}
+	}
+	for executorID := range assignments {
+		executorIDs[executorID] = struct{}{}
+	}
+
+	executors := make([]*types.ExecutorInfo, 0, len(executorIDs))
+	for executorID := range executorIDs {
+		_, drained := control.DrainedExecutors[executorID]
+		executor := &types.ExecutorInfo{
+			ExecutorID:    executorID,
+			State:         string(heartbeats[executorID].State),
+			LastHeartbeat: unixToUnixNano(heartbeats[executorID].LastHeartbeat),
+			Drained:       drained,
+		}
+		for shardID, assignment := range assignments[executorID].AssignedShards {
+			reported := assignments[executorID].ReportedShards[shardID]
+			executor.AssignedShards = append(executor.AssignedShards, &types.ShardAssignmentInfo{
+				ShardKey:   shardID,
+				AssignedAt: unixToUnixNano(assignment.AssignedAt),
+				Status:     reported.Status,
+				ShardLoad:  reported.ShardLoad,
+			})
+		}
+		sort.Slice(executor.AssignedShards, func(i, j int) bool {
+			return executor.AssignedShards[i].ShardKey < executor.AssignedShards[j].ShardKey
+		})
+		executors = append(executors, executor)
+	}
+	sort.Slice(executors, func(i, j int) bool { return executors[i].ExecutorID < executors[j].ExecutorID })
+
+	pruneShardMoves(&control, assignments)
+	moves := make([]*types.ShardMoveInfo, 0, len(control.ShardMoves))
+	for shardID, move := range control.ShardMoves {
+		moves = append(moves, &types.ShardMoveInfo{
+			ShardKey:    shardID,
+			ExecutorID:  move.ExecutorID,
+			RequestedAt: unixToUnixNano(move.RequestedAt),
+		})
+	}
+	sort.Slice(moves, func(i, j int) bool { return moves[i].ShardKey < moves[j].ShardKey })
+
+	auditLog := make([]*types.NamespaceAuditEntry, 0, len(control.AuditLog))
+	for _, entry := range control.AuditLog {
+		auditLog = append(auditLog, &types.NamespaceAuditEntry{
+			Time:      unixToUnixNano(entry.Time),
+			Identity:  entry.Identity,
+			Operation: entry.Operation,
+			Reason:    entry.Reason,
+		})
+	}
+
+	return &types.DescribeNamespaceResponse{
+		Namespace:         request.GetNamespace(),
+		Executors:         executors,
+		RebalancingFrozen: control.RebalancingFrozen,
+		ShardMoves:        moves,
+		AuditLog:          auditLog,
+	}, nil
+}
+
+// MoveShard records a shard move in the namespace control, the leader moves the shard on its next rebalance.
+func (h *handlerImpl) MoveShard(ctx context.Context, request *types.MoveShardRequest) (*types.MoveShardResponse, error) {
+	if request.GetShardKey() == "" || request.GetExecutorID() == "" {
+		return nil, &types.BadRequestError{Message: "shard key and executor ID are required"}
+	}
+
+	operation := fmt.Sprintf("move shard %s to executor %s", request.GetShardKey(), request.GetExecutorID())
+	err := h.updateControl(ctx, request.GetNamespace(), request.GetIdentity(), request.GetReason(), operation,
+		func(control *store.NamespaceControl, heartbeats map[string]store.HeartbeatState, assignments map[string]store.AssignedState) (bool, error) {
+			owner, ok := shardOwner(assignments, request.GetShardKey())
+			if !ok {
+				return false, &types.BadRequestError{Message: fmt.Sprintf("shard %s is not assigned", request.GetShardKey())}
+			}
+			if owner == request.GetExecutorID() {
+				return false, &types.BadRequestError{Message: fmt.Sprintf("shard %s is already assigned to executor %s", request.GetShardKey(), owner)}
+			}
+			_, drained := control.DrainedExecutors[request.GetExecutorID()]
+			if heartbeats[request.GetExecutorID()].State != store.ExecutorStateActive || drained {
+				return false, &types.BadRequestError{Message: fmt.Sprintf("executor %s is not active", request.GetExecutorID())}
+			}
+
+			if control.ShardMoves == nil {
+				control.ShardMoves = make(map[string]store.ShardMove)
+			}
+			control.ShardMoves[request.GetShardKey()] = store.ShardMove{
+				ExecutorID:  request.GetExecutorID(),
+				RequestedAt: h.timeSource.Now().Unix(),
+			}
+			return true, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MoveShardResponse{}, nil
+}
+
+// SetExecutorDrained drains or undrains an executor, the leader moves the shards off drained executors.
+func (h *handlerImpl) SetExecutorDrained(ctx context.Context, request *types.SetExecutorDrainedRequest) (*types.SetExecutorDrainedResponse, error) {
+	if request.GetExecutorID() == "" {
+		return nil, &types.BadRequestError{Message: "executor ID is required"}
+	}
+
+	operation := fmt.Sprintf("undrain executor %s", request.GetExecutorID())
+	if request.GetDrained() {
+		operation = fmt.Sprintf("drain executor %s", request.GetExecutorID())
+	}
+	err := h.updateControl(ctx, request.GetNamespace(), request.GetIdentity(), request.GetReason(), operation,
+		func(control *store.NamespaceControl, heartbeats map[string]store.HeartbeatState, _ map[string]store.AssignedState) (bool, error) {
+			_, drained := control.DrainedExecutors[request.GetExecutorID()]
+			if drained == request.GetDrained() {
+				return false, nil
+			}
+			if !request.GetDrained() {
+				delete(control.DrainedExecutors, request.GetExecutorID())
+				return true, nil
+			}
+
+			if _, ok := heartbeats[request.GetExecutorID()]; !ok {
+				return false, &types.BadRequestError{Message: fmt.Sprintf("executor %s is not registered", request.GetExecutorID())}
+			}
+			if control.DrainedExecutors == nil {
+				control.DrainedExecutors = make(map[string]int64)
+			}
+			control.DrainedExecutors[request.GetExecutorID()] = h.timeSource.Now().Unix()
+			return true, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &types.SetExecutorDrainedResponse{}, nil
+}
+
+// SetRebalancingFrozen freezes or unfreezes the rebalancing of a namespace.
+func (h *handlerImpl) SetRebalancingFrozen(ctx context.Context, request *types.SetRebalancingFrozenRequest) (*types.SetRebalancingFrozenResponse, error) {
+	operation := "unfreeze rebalancing"
+	if request.GetFrozen() {
+		operation = "freeze rebalancing"
+	}
+	err := h.updateControl(ctx, request.GetNamespace(), request.GetIdentity(), request.GetReason(), operation,
+		func(control *store.NamespaceControl, _ map[string]store.HeartbeatState, _ map[string]store.AssignedState) (bool, error) {
+			if control.RebalancingFrozen == request.GetFrozen() {
+				return false, nil
+			}
+			control.RebalancingFrozen = request.GetFrozen()
+			return true, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &types.SetRebalancingFrozenResponse{}, nil
+}
+
+// updateControl applies update to the namespace control and writes it with an audit entry.
+// update returns false if the control already has the requested value, then nothing is written.
+// The control is written only if it was not changed since it was read, otherwise the update is retried.
+func (h *handlerImpl) updateControl(
+	ctx context.Context,
+	namespace, identity, reason, operation string,
+	update func(control *store.NamespaceControl, heartbeats map[string]store.HeartbeatState, assignments map[string]store.AssignedState) (bool, error),
+) error {
+	if reason == "" {
+		return &types.BadRequestError{Message: "reason is required"}
+	}
+	adminStore, err := h.adminStore(ctx, namespace)
+	if err != nil {
+		return err
+	}
+
+	for attempt := 1; ; attempt++ {
+		heartbeats, assignments, _, err := adminStore.GetState(ctx)
+		if err != nil {
+			return fmt.Errorf("get namespace state: %w", err)
+		}
+		control, err := adminStore.GetControl(ctx)
+		if err != nil {
+			return fmt.Errorf("get namespace control: %w", err)
+		}
+
+		pruneShardMoves(&control, assignments)
+		changed, err := update(&control, heartbeats, assignments)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			return nil
+		}
+
+		control.AddAuditEntry(store.AuditEntry{
+			Time:      h.timeSource.Now().Unix(),
+			Identity:  identity,
+			Reason:    reason,
+			Operation: operation,
+		})
+
+		err = adminStore.UpdateControl(ctx, control)
+		if errors.Is(err, store.ErrControlVersionMismatch) && attempt < _maxControlUpdateAttempts {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("update namespace control: %w", err)
+		}
+
+		h.logger.Info("Shard distributor namespace control updated",
+			tag.ShardNamespace(namespace),
+			tag.OperationName(operation),
+			tag.ShardOperatorIdentity(identity),
+			tag.Reason(reason),
+		)
+		return nil
+	}
+}
+
+func (h *handlerImpl) adminStore(ctx context.Context, namespace string) (store.AdminStore, error) {
+	if _, ok := h.namespaces[namespace]; !ok {
+		return nil, &types.NamespaceNotFoundError{Namespace: namespace}
+	}
+	if h.elector == nil {
+		return nil, &types.BadRequestError{Message: "shard distributor leader election is not enabled"}
+	}
+
+	adminStore, err := h.elector.AdminStore(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("admin store: %w", err)
+	}
+	return adminStore, nil
+}
+
+// pruneShardMoves removes the shard moves the leader already applied.
+func pruneShardMoves(control *store.NamespaceControl, assignments map[string]store.AssignedState) {
+	for shardID, move := range control.ShardMoves {
+		owner, ok := shardOwner(assignments, shardID)
+		if !ok {
+			continue
+		}
+		if owner == move.ExecutorID || !move.IsPending(assignments[owner].AssignedShards[shardID]) {
+			delete(control.ShardMoves, shardID)
+		}
+	}
+}
+
+func shardOwner(assignments map[string]store.AssignedState, shardID string) (string, bool) {
+	for executorID, state := range assignments {
+		if _, ok := state.AssignedShards[shardID]; ok {
+			return executorID, true
+		}
+	}
+	return "", false
+}
+
+func unixToUnixNano(seconds int64) int64 {
+	return time.Unix(seconds, 0).UnixNano()
+}
@@ -0,0 +1,321 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/leader/store"
+)
+
+const _testNamespace = "test-namespace"
+
+var (
+	_testHeartbeats = map[string]store.HeartbeatState{
+		"executor-1": {ExecutorID: "executor-1", State: store.ExecutorStateActive, LastHeartbeat: 100},
+		"executor-2": {ExecutorID: "executor-2", State: store.ExecutorStateActive, LastHeartbeat: 101},
+		"executor-3": {ExecutorID: "executor-3", State: store.ExecutorStateDraining, LastHeartbeat: 102},
+	}
+	_testAssignments = map[string]store.AssignedState{
+		"executor-1": {
+			AssignedShards: map[string]store.ShardAssignment{"2": {ShardID: "2", AssignedAt: 10}, "1": {ShardID: "1", AssignedAt: 10}},
+			ReportedShards: map[string]store.ShardState{"1": {Status: "running", ShardLoad: 2.5}},
+		},
+		"executor-2": {
+			AssignedShards: map[string]store.ShardAssignment{"3": {ShardID: "3", AssignedAt: 20}},
+		},
+	}
+)
+
+func newAdminTestHandler(t *testing.T, elector store.Elector, now time.Time) *handlerImpl {
+	return &handlerImpl{
+		logger: testlogger.New(t),
+		namespaces: map[string]config.Namespace{
+			_testNamespace: {Name: _testNamespace, Type: config.NamespaceTypeFixed, ShardNum: 3},
+		},
+		elector:    elector,
+		timeSource: clock.NewMockedTimeSourceAt(now),
+	}
+}
+
+func TestListNamespaces(t *testing.T) {
+	handler := newAdminTestHandler(t, nil, time.Now())
+	handler.namespaces["another-namespace"] = config.Namespace{Name: "another-namespace", Type: config.NamespaceTypeFixed, ShardNum: 8}
+
+	resp, err := handler.ListNamespaces(context.Background(), &types.ListNamespacesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []*types.NamespaceInfo{
+		{Name: "another-namespace", Type: config.NamespaceTypeFixed, ShardNum: 8},
+		{Name: _testNamespace, Type: config.NamespaceTypeFixed, ShardNum: 3},
+	}, resp.GetNamespaces())
+}
+
+func TestDescribeNamespace(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	elector := store.NewMockElector(ctrl)
+	adminStore := store.NewMockAdminStore(ctrl)
+	handler := newAdminTestHandler(t, elector, time.Now())
+
+	elector.EXPECT().AdminStore(gomock.Any(), _testNamespace).Return(adminStore, nil)
+	adminStore.EXPECT().GetState(gomock.Any()).Return(_testHeartbeats, _testAssignments, int64(1), nil)
+	adminStore.EXPECT().GetControl(gomock.Any()).Return(store.NamespaceControl{
+		RebalancingFrozen: true,
+		DrainedExecutors:  map[string]int64{"executor-2": 30},
+		ShardMoves: map[string]store.ShardMove{
+			"1": {ExecutorID: "executor-2", RequestedAt: 40},
+			"3": {ExecutorID: "executor-1", RequestedAt: 15},
+		},
+		AuditLog: []store.AuditEntry{{Time: 40, Identity: "operator", Reason: "testing", Operation: "move shard 1 to executor executor-2"}},
+	}, nil)
+
+	resp, err := handler.DescribeNamespace(context.Background(), &types.DescribeNamespaceRequest{Namespace: _testNamespace})
+	require.NoError(t, err)
+	assert.Equal(t, &types.DescribeNamespaceResponse{
+		Namespace: _testNamespace,
+		Executors: []*types.ExecutorInfo{
+			{
+				ExecutorID:    "executor-1",
+				State:         "ACTIVE",
+				LastHeartbeat: time.Unix(100, 0).UnixNano(),
+				AssignedShards: []*types.ShardAssignmentInfo{
+					{ShardKey: "1", AssignedAt: time.Unix(10, 0).UnixNano(), Status: "running", ShardLoad: 2.5},
+					{ShardKey: "2", AssignedAt: time.Unix(10, 0).UnixNano()},
+				},
+			},
+			{
+				ExecutorID:    "executor-2",
+				State:         "ACTIVE",
+				LastHeartbeat: time.Unix(101, 0).UnixNano(),
+				Drained:       true,
+				AssignedShards: []*types.ShardAssignmentInfo{
+					{ShardKey: "3", AssignedAt: time.Unix(20, 0).UnixNano()},
+				},
+			},
+			{
+				ExecutorID:    "executor-3",
+				State:         "DRAINING",
+				LastHeartbeat: time.Unix(102, 0).UnixNano(),
+			},
+		},
+		RebalancingFrozen: true,
+		// The move of shard 3 was requested before its assignment, so it is done.
+		ShardMoves: []*types.ShardMoveInfo{
+			{ShardKey: "1", ExecutorID: "executor-2", RequestedAt: time.Unix(40, 0).UnixNano()},
+		},
+		AuditLog: []*types.NamespaceAuditEntry{
+			{Time: time.Unix(40, 0).UnixNano(), Identity: "operator", Operation: "move shard 1 to executor executor-2", Reason: "testing"},
+		},
+	}, resp)
+}
+
+func TestDescribeNamespace_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	elector := store.NewMockElector(ctrl)
+
+	_, err := newAdminTestHandler(t, elector, time.Now()).DescribeNamespace(context.Background(), &types.DescribeNamespaceRequest{Namespace: "unknown"})
+	var notFound *types.NamespaceNotFoundError
+	assert.ErrorAs(t, err, &notFound)
+
+	_, err = newAdminTestHandler(t, nil, time.Now()).DescribeNamespace(context.Background(), &types.DescribeNamespaceRequest{Namespace: _testNamespace})
+	var badRequest *types.BadRequestError
+	assert.ErrorAs(t, err, &badRequest)
+
+	elector.EXPECT().AdminStore(gomock.Any(), _testNamespace).Return(nil, errors.New("connection failed"))
+	_, err = newAdminTestHandler(t, elector, time.Now()).DescribeNamespace(context.Background(), &types.DescribeNamespaceRequest{Namespace: _testNamespace})
+	assert.ErrorContains(t, err, "connection failed")
+}
+
+func TestMoveShard(t *testing.T) {
+	now := time.Unix(1000, 0)
+
+	tests := []struct {
+		name          string
+		request       *types.MoveShardRequest
+		control       store.NamespaceControl
+		expectControl *store.NamespaceControl
+		expectError   string
+	}{
+		{
+			name:    "move is recorded",
+			request: &types.MoveShardRequest{Namespace: _testNamespace, ShardKey: "1", ExecutorID: "executor-2", Identity: "operator", Reason: "hot shard"},
+			control: store.NamespaceControl{
+				Version: 4,
+				// Already applied, the shard was assigned after the move was requested.
+				ShardMoves: map[string]store.ShardMove{"3": {ExecutorID: "executor-2", RequestedAt: 15}},
+			},
+			expectControl: &store.NamespaceControl{
+				Version:    4,
+				ShardMoves: map[string]store.ShardMove{"1": {ExecutorID: "executor-2", RequestedAt: 1000}},
+				AuditLog:   []store.AuditEntry{{Time: 1000, Identity: "operator", Reason: "hot shard", Operation: "move shard 1 to executor executor-2"}},
+			},
+		},
+		{
+			name:        "shard is not assigned",
+			request:     &types.MoveShardRequest{Namespace: _testNamespace, ShardKey: "4", ExecutorID: "executor-2", Reason: "hot shard"},
+			expectError: "shard 4 is not assigned",
+		},
+		{
+			name:        "shard is already on the executor",
+			request:     &types.MoveShardRequest{Namespace: _testNamespace, ShardKey: "1", ExecutorID: "executor-1", Reason: "hot shard"},
+			expectError: "already assigned to executor executor-1",
+		},
+		{
+			name:        "executor is not active",
+			request:     &types.MoveShardRequest{Namespace: _testNamespace, ShardKey: "1", ExecutorID: "executor-3", Reason: "hot shard"},
+			expectError: "executor executor-3 is not active",
+		},
+		{
+			name:        "executor is drained",
+			request:     &types.MoveShardRequest{Namespace: _testNamespace, ShardKey: "1", ExecutorID: "executor-2", Reason: "hot shard"},
+			control:     store.NamespaceControl{DrainedExecutors: map[string]int64{"executor-2": 1}},
+			expectError: "executor executor-2 is not active",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			elector := store.NewMockElector(ctrl)
+			adminStore := store.NewMockAdminStore(ctrl)
+			handler := newAdminTestHandler(t, elector, now)
+
+			elector.EXPECT().AdminStore(gomock.Any(), _testNamespace).Return(adminStore, nil)
+			adminStore.EXPECT().GetState(gomock.Any()).Return(_testHeartbeats, _testAssignments, int64(1), nil)
+			adminStore.EXPECT().GetControl(gomock.Any()).Return(tt.control, nil)
+			if tt.expectControl != nil {
+				adminStore.EXPECT().UpdateControl(gomock.Any(), *tt.expectControl).Return(nil)
+			}
+
+			_, err := handler.MoveShard(context.Background(), tt.request)
+			if tt.expectError != "" {
+				var badRequest *types.BadRequestError
+				require.ErrorAs(t, err, &badRequest)
+				assert.Contains(t, badRequest.Message, tt.expectError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMoveShard_Validation(t *testing.T) {
+	handler := newAdminTestHandler(t, nil, time.Now())
+
+	_, err := handler.MoveShard(context.Background(), &types.MoveShardRequest{Namespace: _testNamespace, ExecutorID: "executor-2", Reason: "hot shard"})
+	assert.ErrorContains(t, err, "shard key and executor ID are required")
+
+	_, err = handler.MoveShard(context.Background(), &types.MoveShardRequest{Namespace: _testNamespace, ShardKey: "1", ExecutorID: "executor-2"})
+	assert.ErrorContains(t, err, "reason is required")
+}
+
+func TestSetExecutorDrained(t *testing.T) {
+	now := time.Unix(1000, 0)
+	ctrl := gomock.NewController(t)
+	elector := store.NewMockElector(ctrl)
+	adminStore := store.NewMockAdminStore(ctrl)
+	handler := newAdminTestHandler(t, elector, now)
+
+	elector.EXPECT().AdminStore(gomock.Any(), _testNamespace).Return(adminStore, nil).Times(3)
+	adminStore.EXPECT().GetState(gomock.Any()).Return(_testHeartbeats, _testAssignments, int64(1), nil).Times(3)
+
+	// Drain an executor.
+	adminStore.EXPECT().GetControl(gomock.Any()).Return(store.NamespaceControl{Version: 1}, nil)
+	adminStore.EXPECT().UpdateControl(gomock.Any(), store.NamespaceControl{
+		Version:          1,
+		DrainedExecutors: map[string]int64{"executor-1": 1000},
+		AuditLog:         []store.AuditEntry{{Time: 1000, Identity: "operator", Reason: "bad host", Operation: "drain executor executor-1"}},
+	}).Return(nil)
+	_, err := handler.SetExecutorDrained(context.Background(), &types.SetExecutorDrainedRequest{
+		Namespace: _testNamespace, ExecutorID: "executor-1", Drained: true, Identity: "operator", Reason: "bad host",
+	})
+	require.NoError(t, err)
+
+	// Undraining an executor that is not drained does not write anything.
+	adminStore.EXPECT().GetControl(gomock.Any()).Return(store.NamespaceControl{Version: 2}, nil)
+	_, err = handler.SetExecutorDrained(context.Background(), &types.SetExecutorDrainedRequest{
+		Namespace: _testNamespace, ExecutorID: "executor-1", Drained: false, Identity: "operator", Reason: "host fixed",
+	})
+	require.NoError(t, err)
+
+	// Unknown executors cannot be drained.
+	adminStore.EXPECT().GetControl(gomock.Any()).Return(store.NamespaceControl{Version: 2}, nil)
+	_, err = handler.SetExecutorDrained(context.Background(), &types.SetExecutorDrainedRequest{
+		Namespace: _testNamespace, ExecutorID: "executor-4", Drained: true, Identity: "operator", Reason: "bad host",
+	})
+	var badRequest *types.BadRequestError
+	assert.ErrorAs(t, err, &badRequest)
+}
+
+func TestSetRebalancingFrozen_RetriesConcurrentUpdates(t *testing.T) {
+	now := time.Unix(1000, 0)
+	ctrl := gomock.NewController(t)
+	elector := store.NewMockElector(ctrl)
+	adminStore := store.NewMockAdminStore(ctrl)
+	handler := newAdminTestHandler(t, elector, now)
+
+	elector.EXPECT().AdminStore(gomock.Any(), _testNamespace).Return(adminStore, nil)
+	adminStore.EXPECT().GetState(gomock.Any()).Return(_testHeartbeats, _testAssignments, int64(1), nil).Times(2)
+	gomock.InOrder(
+		adminStore.EXPECT().GetControl(gomock.Any()).Return(store.NamespaceControl{Version: 1}, nil),
+		adminStore.EXPECT().UpdateControl(gomock.Any(), gomock.Any()).Return(store.ErrControlVersionMismatch),
+		adminStore.EXPECT().GetControl(gomock.Any()).Return(store.NamespaceControl{Version: 2}, nil),
+		adminStore.EXPECT().UpdateControl(gomock.Any(), store.NamespaceControl{
+			Version:           2,
+			RebalancingFrozen: true,
+			AuditLog:          []store.AuditEntry{{Time: 1000, Identity: "operator", Reason: "incident", Operation: "freeze rebalancing"}},
+		}).Return(nil),
+	)
+
+	_, err := handler.SetRebalancingFrozen(context.Background(), &types.SetRebalancingFrozenRequest{
+		Namespace: _testNamespace, Frozen: true, Identity: "operator", Reason: "incident",
+	})
+	require.NoError(t, err)
+}
+
+func TestSetRebalancingFrozen_ConcurrentUpdatesExhausted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	elector := store.NewMockElector(ctrl)
+	adminStore := store.NewMockAdminStore(ctrl)
+	handler := newAdminTestHandler(t, elector, time.Now())
+
+	elector.EXPECT().AdminStore(gomock.Any(), _testNamespace).Return(adminStore, nil)
+	adminStore.EXPECT().GetState(gomock.Any()).Return(_testHeartbeats, _testAssignments, int64(1), nil).Times(_maxControlUpdateAttempts)
+	adminStore.EXPECT().GetControl(gomock.Any()).Return(store.NamespaceControl{}, nil).Times(_maxControlUpdateAttempts)
+	adminStore.EXPECT().UpdateControl(gomock.Any(), gomock.Any()).Return(store.ErrControlVersionMismatch).Times(_maxControlUpdateAttempts)
+
+	_, err := handler.SetRebalancingFrozen(context.Background(), &types.SetRebalancingFrozenRequest{
+		Namespace: _testNamespace, Frozen: true, Identity: "operator", Reason: "incident",
+	})
+	assert.ErrorIs(t, err, store.ErrControlVersionMismatch)
+}
@@ -25,13 +25,26 @@ package handler
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
+	"time"
 
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/sharddistributor/config"
 	"github.com/uber/cadence/service/sharddistributor/constants"
+	"github.com/uber/cadence/service/sharddistributor/leader/store"
+)
+
+const (
+	// _watchPollTimeout is the longest a WatchNamespaceState call waits for a change before returning the current state
+	_watchPollTimeout = time.Minute
+	// _watchSubscriberName is the name used to subscribe to the membership rings for changes
+	_watchSubscriberName = "shard-distributor-watch"
 )
 
 func NewHandler(
@@ -39,12 +52,32 @@ func NewHandler(
 	metricsClient metrics.Client,
 	matchingRing membership.SingleProvider,
 	historyRing membership.SingleProvider,
+	leaderElection config.LeaderElection,
+	elector store.Elector,
+	timeSource clock.TimeSource,
 ) Handler {
+	namespaces := make(map[string]config.Namespace, len(leaderElection.Namespaces))
+	for _, namespace := range leaderElection.Namespaces {
+		namespaces[namespace.Name] = namespace
+	}
+
+	// Revisions start from the current time so they keep increasing across restarts,
+	// and a revision a client got from a previous instance is never taken as current.
+	initialRevision := time.Now().UnixNano()
 	handler := &handlerImpl{
 		logger:        logger,
 		metricsClient: metricsClient,
 		matchingRing:  matchingRing,
 		historyRing:   historyRing,
+		watches: map[string]*namespaceWatch{
+			constants.HistoryNamespace:  newNamespaceWatch(initialRevision),
+			constants.MatchingNamespace: newNamespaceWatch(initialRevision),
+		},
+		watchPollTimeout: _watchPollTimeout,
+		stopCh:           make(chan struct{}),
+		namespaces:       namespaces,
+		elector:          elector,
+		timeSource:       timeSource,
 	}
 
 	// prevent us from trying to serve requests before shard distributor is started and ready
@@ -60,13 +93,105 @@ type handlerImpl struct {
 
 	matchingRing membership.SingleProvider
 	historyRing  membership.SingleProvider
+
+	watches          map[string]*namespaceWatch
+	watchPollTimeout time.Duration
+	stopCh           chan struct{}
+	stopWG           sync.WaitGroup
+
+	// namespaces are the namespaces managed by the leader, elector is nil if the leader election is disabled.
+	namespaces map[string]config.Namespace
+	elector    store.Elector
+	timeSource clock.TimeSource
+}
+
+// namespaceWatch tracks the revision of the shard ownership of a namespace.
+// changed is closed and replaced every time the revision is bumped, waking up all pending watchers.
+type namespaceWatch struct {
+	sync.Mutex
+	revision int64
+	changed  chan struct{}
+}
+
+func newNamespaceWatch(revision int64) *namespaceWatch {
+	return &namespaceWatch{
+		revision: revision,
+		changed:  make(chan struct{}),
+	}
+}
+
+func (w *namespaceWatch) current() (int64, <-chan struct{}) {
+	w.Lock()
+	defer w.Unlock()
+	return w.revision, w.changed
+}
+
+func (w *namespaceWatch) bump() {
+	w.Lock()
+	defer w.Unlock()
+	w.revision++
+	close(w.changed)
+	w.changed = make(chan struct{})
 }
 
 func (h *handlerImpl) Start() {
+	for namespace := range h.watches {
+		ring, _ := h.namespaceRing(namespace)
+		if ring == nil {
+			// membership rings are optional (e.g. in tests), there is nothing to watch
+			continue
+		}
+		changeCh := make(chan *membership.ChangedEvent, 1)
+		if err := ring.Subscribe(_watchSubscriberName, changeCh); err != nil {
+			h.logger.Error("Failed to subscribe to membership changes", tag.ShardNamespace(namespace), tag.Error(err))
+			continue
+		}
+
+		h.stopWG.Add(1)
+		go h.watchRing(h.watches[namespace], changeCh)
+	}
+
 	h.startWG.Done()
 }
 
 func (h *handlerImpl) Stop() {
+	for namespace := range h.watches {
+		ring, _ := h.namespaceRing(namespace)
+		if ring == nil {
+			continue
+		}
+		if err := ring.Unsubscribe(_watchSubscriberName); err != nil {
+			h.logger.Warn("Failed to unsubscribe from membership changes", tag.ShardNamespace(namespace), tag.Error(err))
+		}
+	}
+
+	close(h.stopCh)
+	h.stopWG.Wait()
+}
+
+func (h *handlerImpl) watchRing(watch *namespaceWatch, changeCh <-chan *membership.ChangedEvent) {
+	defer h.stopWG.Done()
+	defer func() { log.CapturePanic(recover(), h.logger, nil) }()
+
+	for {
+		select {
+		case <-changeCh:
+			watch.bump()
+		case <-h.stopCh:
+			return
+		}
+	}
+}
+
+func (h *handlerImpl) namespaceRing(namespace string) (membership.SingleProvider, bool) {
+	switch namespace {
+	case constants.HistoryNamespace:
+		return h.historyRing, true
+	case constants.MatchingNamespace:
+		return h.matchingRing, true
+	default:
+		return nil, false
+	}
 }
 
 func (h *handlerImpl) Health(ctx context.Context) (*types.HealthStatus, error) {
@@ -101,3 +226,44 @@ func (h *handlerImpl) GetShardOwner(ctx context.Context, request *types.GetShard
 
 	return resp, nil
 }
+
+// WatchNamespaceState is a long poll for the shard ownership of a namespace.
+// It returns right away if the caller's revision is not the current one,
+// otherwise it waits until the ownership changes or the poll times out.
+func (h *handlerImpl) WatchNamespaceState(ctx context.Context, request *types.WatchNamespaceStateRequest) (resp *types.WatchNamespaceStateResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	ring, ok := h.namespaceRing(request.GetNamespace())
+	watch, watched := h.watches[request.GetNamespace()]
+	if !ok || !watched {
+		return nil, &types.NamespaceNotFoundError{Namespace: request.GetNamespace()}
+	}
+
+	revision, changed := watch.current()
+	if request.GetRevision() == revision {
+		timer := time.NewTimer(h.watchPollTimeout)
+		defer timer.Stop()
+
+		select {
+		case <-changed:
+		case <-timer.C:
+		case <-h.stopCh:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		revision, _ = watch.current()
+	}
+
+	members := ring.Members()
+	owners := make([]string, 0, len(members))
+	for _, member := range members {
+		owners = append(owners, member.GetAddress())
+	}
+	sort.Strings(owners)
+
+	return &types.WatchNamespaceStateResponse{
+		Namespace: request.GetNamespace(),
+		Revision:  revision,
+		Owners:    owners,
+	}, nil
+}
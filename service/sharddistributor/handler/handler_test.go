@@ -26,13 +26,17 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/sharddistributor/config"
 	"github.com/uber/cadence/service/sharddistributor/constants"
 )
 
@@ -134,3 +138,128 @@ func TestGetShardOwner(t *testing.T) {
 		})
 	}
 }
+
+func TestWatchNamespaceState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockHistoryRing := membership.NewMockSingleProvider(ctrl)
+	mockMatchingRing := membership.NewMockSingleProvider(ctrl)
+	mockHistoryRing.EXPECT().Members().Return([]membership.HostInfo{
+		membership.NewHostInfo("host-2"),
+		membership.NewHostInfo("host-1"),
+	}).AnyTimes()
+
+	handler := &handlerImpl{
+		logger:       testlogger.New(t),
+		historyRing:  mockHistoryRing,
+		matchingRing: mockMatchingRing,
+		watches: map[string]*namespaceWatch{
+			constants.HistoryNamespace:  newNamespaceWatch(10),
+			constants.MatchingNamespace: newNamespaceWatch(10),
+		},
+		watchPollTimeout: 10 * time.Millisecond,
+		stopCh:           make(chan struct{}),
+	}
+
+	t.Run("StaleRevision", func(t *testing.T) {
+		resp, err := handler.WatchNamespaceState(context.Background(), &types.WatchNamespaceStateRequest{
+			Namespace: constants.HistoryNamespace,
+			Revision:  3,
+		})
+		require.NoError(t, err)
+		require.Equal(t, &types.WatchNamespaceStateResponse{
+			Namespace: constants.HistoryNamespace,
+			Revision:  10,
+			Owners:    []string{"host-1", "host-2"},
+		}, resp)
+	})
+
+	t.Run("PollTimeout", func(t *testing.T) {
+		resp, err := handler.WatchNamespaceState(context.Background(), &types.WatchNamespaceStateRequest{
+			Namespace: constants.HistoryNamespace,
+			Revision:  10,
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(10), resp.Revision)
+	})
+
+	t.Run("Change", func(t *testing.T) {
+		handler.watchPollTimeout = time.Minute
+		defer func() { handler.watchPollTimeout = 10 * time.Millisecond }()
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			handler.watches[constants.HistoryNamespace].bump()
+		}()
+
+		resp, err := handler.WatchNamespaceState(context.Background(), &types.WatchNamespaceStateRequest{
+			Namespace: constants.HistoryNamespace,
+			Revision:  10,
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(11), resp.Revision)
+		require.Equal(t, []string{"host-1", "host-2"}, resp.Owners)
+	})
+
+	t.Run("ContextCancelled", func(t *testing.T) {
+		handler.watchPollTimeout = time.Minute
+		defer func() { handler.watchPollTimeout = 10 * time.Millisecond }()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := handler.WatchNamespaceState(ctx, &types.WatchNamespaceStateRequest{
+			Namespace: constants.MatchingNamespace,
+			Revision:  10,
+		})
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("InvalidNamespace", func(t *testing.T) {
+		_, err := handler.WatchNamespaceState(context.Background(), &types.WatchNamespaceStateRequest{
+			Namespace: "invalid",
+		})
+		var notFound *types.NamespaceNotFoundError
+		require.ErrorAs(t, err, &notFound)
+	})
+}
+
+func TestWatchRing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockHistoryRing := membership.NewMockSingleProvider(ctrl)
+	mockMatchingRing := membership.NewMockSingleProvider(ctrl)
+
+	var historyCh chan<- *membership.ChangedEvent
+	mockHistoryRing.EXPECT().Subscribe(_watchSubscriberName, gomock.Any()).
+		DoAndReturn(func(_ string, ch chan<- *membership.ChangedEvent) error {
+			historyCh = ch
+			return nil
+		})
+	mockMatchingRing.EXPECT().Subscribe(_watchSubscriberName, gomock.Any()).Return(nil)
+	mockHistoryRing.EXPECT().Unsubscribe(_watchSubscriberName).Return(nil)
+	mockMatchingRing.EXPECT().Unsubscribe(_watchSubscriberName).Return(nil)
+
+	handler := NewHandler(testlogger.New(t), metrics.NewNoopMetricsClient(), mockMatchingRing, mockHistoryRing, config.LeaderElection{}, nil, clock.NewRealTimeSource()).(*handlerImpl)
+	handler.Start()
+	defer handler.Stop()
+
+	watch := handler.watches[constants.HistoryNamespace]
+	revision, changed := watch.current()
+
+	historyCh <- &membership.ChangedEvent{}
+
+	select {
+	case <-changed:
+	case <-time.After(time.Second):
+		t.Fatal("revision was not bumped on a membership change")
+	}
+	newRevision, _ := watch.current()
+	require.Equal(t, revision+1, newRevision)
+}
+
+func TestStartStopWithoutRings(t *testing.T) {
+	handler := NewHandler(testlogger.New(t), metrics.NewNoopMetricsClient(), nil, nil, config.LeaderElection{}, nil, clock.NewRealTimeSource())
+	handler.Start()
+	handler.Stop()
+}
@@ -40,4 +40,16 @@ type Handler interface {
 	Health(context.Context) (*types.HealthStatus, error)
 
 	GetShardOwner(context.Context, *types.GetShardOwnerRequest) (*types.GetShardOwnerResponse, error)
+
+	WatchNamespaceState(context.Context, *types.WatchNamespaceStateRequest) (*types.WatchNamespaceStateResponse, error)
+
+	ListNamespaces(context.Context, *types.ListNamespacesRequest) (*types.ListNamespacesResponse, error)
+
+	DescribeNamespace(context.Context, *types.DescribeNamespaceRequest) (*types.DescribeNamespaceResponse, error)
+
+	MoveShard(context.Context, *types.MoveShardRequest) (*types.MoveShardResponse, error)
+
+	SetExecutorDrained(context.Context, *types.SetExecutorDrainedRequest) (*types.SetExecutorDrainedResponse, error)
+
+	SetRebalancingFrozen(context.Context, *types.SetRebalancingFrozenRequest) (*types.SetRebalancingFrozenResponse, error)
 }
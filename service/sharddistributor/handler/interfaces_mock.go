@@ -42,6 +42,21 @@ func (m *MockHandler) EXPECT() *MockHandlerMockRecorder {
 	return m.recorder
 }
 
+// DescribeNamespace mocks base method.
+func (m *MockHandler) DescribeNamespace(arg0 context.Context, arg1 *types.DescribeNamespaceRequest) (*types.DescribeNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeNamespace", arg0, arg1)
+	ret0, _ := ret[0].(*types.DescribeNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNamespace indicates an expected call of DescribeNamespace.
+func (mr *MockHandlerMockRecorder) DescribeNamespace(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNamespace", reflect.TypeOf((*MockHandler)(nil).DescribeNamespace), arg0, arg1)
+}
+
 // GetShardOwner mocks base method.
 func (m *MockHandler) GetShardOwner(arg0 context.Context, arg1 *types.GetShardOwnerRequest) (*types.GetShardOwnerResponse, error) {
 	m.ctrl.T.Helper()
@@ -72,6 +87,66 @@ func (mr *MockHandlerMockRecorder) Health(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Health", reflect.TypeOf((*MockHandler)(nil).Health), arg0)
 }
 
+// ListNamespaces mocks base method.
+func (m *MockHandler) ListNamespaces(arg0 context.Context, arg1 *types.ListNamespacesRequest) (*types.ListNamespacesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNamespaces", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListNamespacesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNamespaces indicates an expected call of ListNamespaces.
+func (mr *MockHandlerMockRecorder) ListNamespaces(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespaces", reflect.TypeOf((*MockHandler)(nil).ListNamespaces), arg0, arg1)
+}
+
+// MoveShard mocks base method.
+func (m *MockHandler) MoveShard(arg0 context.Context, arg1 *types.MoveShardRequest) (*types.MoveShardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveShard", arg0, arg1)
+	ret0, _ := ret[0].(*types.MoveShardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveShard indicates an expected call of MoveShard.
+func (mr *MockHandlerMockRecorder) MoveShard(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveShard", reflect.TypeOf((*MockHandler)(nil).MoveShard), arg0, arg1)
+}
+
+// SetExecutorDrained mocks base method.
+func (m *MockHandler) SetExecutorDrained(arg0 context.Context, arg1 *types.SetExecutorDrainedRequest) (*types.SetExecutorDrainedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetExecutorDrained", arg0, arg1)
+	ret0, _ := ret[0].(*types.SetExecutorDrainedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetExecutorDrained indicates an expected call of SetExecutorDrained.
+func (mr *MockHandlerMockRecorder) SetExecutorDrained(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExecutorDrained", reflect.TypeOf((*MockHandler)(nil).SetExecutorDrained), arg0, arg1)
+}
+
+// SetRebalancingFrozen mocks base method.
+func (m *MockHandler) SetRebalancingFrozen(arg0 context.Context, arg1 *types.SetRebalancingFrozenRequest) (*types.SetRebalancingFrozenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRebalancingFrozen", arg0, arg1)
+	ret0, _ := ret[0].(*types.SetRebalancingFrozenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRebalancingFrozen indicates an expected call of SetRebalancingFrozen.
+func (mr *MockHandlerMockRecorder) SetRebalancingFrozen(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRebalancingFrozen", reflect.TypeOf((*MockHandler)(nil).SetRebalancingFrozen), arg0, arg1)
+}
+
 // Start mocks base method.
 func (m *MockHandler) Start() {
 	m.ctrl.T.Helper()
@@ -95,3 +170,18 @@ func (mr *MockHandlerMockRecorder) Stop() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockHandler)(nil).Stop))
 }
+
+// WatchNamespaceState mocks base method.
+func (m *MockHandler) WatchNamespaceState(arg0 context.Context, arg1 *types.WatchNamespaceStateRequest) (*types.WatchNamespaceStateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchNamespaceState", arg0, arg1)
+	ret0, _ := ret[0].(*types.WatchNamespaceStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchNamespaceState indicates an expected call of WatchNamespaceState.
+func (mr *MockHandlerMockRecorder) WatchNamespaceState(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchNamespaceState", reflect.TypeOf((*MockHandler)(nil).WatchNamespaceState), arg0, arg1)
+}
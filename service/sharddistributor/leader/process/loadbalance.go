@@ -0,0 +1,115 @@
+package process
+
+import (
+	"math"
+	"sort"
+)
+
+// shardMove is a decision to move a shard to another executor to reduce the load imbalance.
+type shardMove struct {
+	shardID string
+	from    string
+	to      string
+	load    float64
+}
+
+// loadImbalance returns the ratio of the most loaded executor to the mean executor load.
+// It is 1 if no load is reported.
+func loadImbalance(executorLoads map[string]float64) float64 {
+	if len(executorLoads) == 0 {
+		return 1
+	}
+
+	var total, maxLoad float64
+	for _, load := range executorLoads {
+		total += load
+		maxLoad = math.Max(maxLoad, load)
+	}
+	if total <= 0 {
+		return 1
+	}
+	return maxLoad / (total / float64(len(executorLoads)))
+}
+
+// planShardMoves moves shards from the most to the least loaded executor until the imbalance drops below
+// threshold or maxMoves shards are moved. It is greedy: every move strictly reduces the load difference between
+// the two executors, so shards don't flip back and forth between executors with a similar load.
+// Only shards for which movable returns true are considered. assignments is updated with the moves.
+func planShardMoves(
+	assignments map[string][]string,
+	shardLoads map[string]float64,
+	movable func(shardID string) bool,
+	threshold float64,
+	maxMoves int,
+) []shardMove {
+	if len(assignments) < 2 {
+		return nil
+	}
+
+	executorLoads := make(map[string]float64, len(assignments))
+	for executorID, shards := range assignments {
+		// Executors without shards must count towards the mean load.
+		executorLoads[executorID] = 0
+		for _, shardID := range shards {
+			executorLoads[executorID] += shardLoads[shardID]
+		}
+	}
+
+	// Executors are visited in a fixed order, so the plan is deterministic for the same input.
+	executors := make([]string, 0, len(assignments))
+	for executorID := range assignments {
+		executors = append(executors, executorID)
+	}
+	sort.Strings(executors)
+
+	moved := make(map[string]struct{})
+	var moves []shardMove
+	for len(moves) < maxMoves && loadImbalance(executorLoads) > threshold {
+		from, to := executors[0], executors[0]
+		for _, executorID := range executors {
+			if executorLoads[executorID] > executorLoads[from] {
+				from = executorID
+			}
+			if executorLoads[executorID] < executorLoads[to] {
+				to = executorID
+			}
+		}
+
+		// The best shard halves the load difference, any shard lighter than the difference reduces it.
+		gap := executorLoads[from] - executorLoads[to]
+		bestIdx := -1
+		for i, shardID := range assignments[from] {
+			load := shardLoads[shardID]
+			if _, ok := moved[shardID]; ok || load <= 0 || load >= gap || !movable(shardID) {
+				continue
+			}
+			if bestIdx < 0 || isBetterMove(shardID, assignments[from][bestIdx], shardLoads, gap) {
+				bestIdx = i
+			}
+		}
+		if bestIdx < 0 {
+			break
+		}
+
+		shardID := assignments[from][bestIdx]
+		load := shardLoads[shardID]
+		assignments[from] = append(assignments[from][:bestIdx], assignments[from][bestIdx+1:]...)
+		assignments[to] = append(assignments[to], shardID)
+		executorLoads[from] -= load
+		executorLoads[to] += load
+		moved[shardID] = struct{}{}
+		moves = append(moves, shardMove{shardID: shardID, from: from, to: to, load: load})
+	}
+
+	return moves
+}
+
+// isBetterMove returns true if moving shardID is closer to halving gap than moving currentBest.
+func isBetterMove(shardID, currentBest string, shardLoads map[string]float64, gap float64) bool {
+	distance := math.Abs(shardLoads[shardID] - gap/2)
+	bestDistance := math.Abs(shardLoads[currentBest] - gap/2)
+	if distance != bestDistance {
+		return distance < bestDistance
+	}
+	return shardID < currentBest
+}
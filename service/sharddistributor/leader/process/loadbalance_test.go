@@ -0,0 +1,119 @@
+package process
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadImbalance(t *testing.T) {
+	tests := map[string]struct {
+		executorLoads map[string]float64
+		expected      float64
+	}{
+		"no executors": {
+			expected: 1,
+		},
+		"no load reported": {
+			executorLoads: map[string]float64{"executor-1": 0, "executor-2": 0},
+			expected:      1,
+		},
+		"balanced": {
+			executorLoads: map[string]float64{"executor-1": 10, "executor-2": 10},
+			expected:      1,
+		},
+		"imbalanced": {
+			executorLoads: map[string]float64{"executor-1": 30, "executor-2": 10},
+			expected:      1.5,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.InDelta(t, tc.expected, loadImbalance(tc.executorLoads), 0.0001)
+		})
+	}
+}
+
+func TestPlanShardMoves(t *testing.T) {
+	allMovable := func(string) bool { return true }
+
+	tests := map[string]struct {
+		assignments         map[string][]string
+		shardLoads          map[string]float64
+		movable             func(string) bool
+		maxMoves            int
+		expectedMoves       []shardMove
+		expectedAssignments map[string][]string
+	}{
+		"single executor": {
+			assignments:         map[string][]string{"executor-1": {"1", "2"}},
+			shardLoads:          map[string]float64{"1": 10, "2": 10},
+			maxMoves:            5,
+			expectedAssignments: map[string][]string{"executor-1": {"1", "2"}},
+		},
+		"balanced executors are not changed": {
+			assignments:         map[string][]string{"executor-1": {"1", "2"}, "executor-2": {"3"}},
+			shardLoads:          map[string]float64{"1": 5, "2": 5, "3": 9},
+			maxMoves:            5,
+			expectedAssignments: map[string][]string{"executor-1": {"1", "2"}, "executor-2": {"3"}},
+		},
+		"moves the shard closest to half of the load difference": {
+			assignments: map[string][]string{"executor-1": {"1", "2", "3"}, "executor-2": {"4"}},
+			shardLoads:  map[string]float64{"1": 12, "2": 10, "3": 2, "4": 4},
+			maxMoves:    1,
+			expectedMoves: []shardMove{
+				{shardID: "2", from: "executor-1", to: "executor-2", load: 10},
+			},
+			expectedAssignments: map[string][]string{"executor-1": {"1", "3"}, "executor-2": {"4", "2"}},
+		},
+		"movement budget is respected": {
+			assignments: map[string][]string{"executor-1": {"1", "2", "3", "4"}, "executor-2": {}},
+			shardLoads:  map[string]float64{"1": 10, "2": 10, "3": 10, "4": 10},
+			maxMoves:    1,
+			expectedMoves: []shardMove{
+				{shardID: "1", from: "executor-1", to: "executor-2", load: 10},
+			},
+			expectedAssignments: map[string][]string{"executor-1": {"2", "3", "4"}, "executor-2": {"1"}},
+		},
+		"stops once balanced": {
+			assignments: map[string][]string{"executor-1": {"1", "2", "3", "4"}, "executor-2": {}},
+			shardLoads:  map[string]float64{"1": 10, "2": 10, "3": 10, "4": 10},
+			maxMoves:    10,
+			expectedMoves: []shardMove{
+				{shardID: "1", from: "executor-1", to: "executor-2", load: 10},
+				{shardID: "2", from: "executor-1", to: "executor-2", load: 10},
+			},
+			expectedAssignments: map[string][]string{"executor-1": {"3", "4"}, "executor-2": {"1", "2"}},
+		},
+		"a shard heavier than the load difference is not moved": {
+			assignments:         map[string][]string{"executor-1": {"1"}, "executor-2": {"2"}},
+			shardLoads:          map[string]float64{"1": 30, "2": 10},
+			maxMoves:            5,
+			expectedAssignments: map[string][]string{"executor-1": {"1"}, "executor-2": {"2"}},
+		},
+		"shards that are not movable are skipped": {
+			assignments: map[string][]string{"executor-1": {"1", "2"}, "executor-2": {}},
+			shardLoads:  map[string]float64{"1": 10, "2": 5},
+			movable:     func(shardID string) bool { return shardID != "1" },
+			maxMoves:    5,
+			expectedMoves: []shardMove{
+				{shardID: "2", from: "executor-1", to: "executor-2", load: 5},
+			},
+			expectedAssignments: map[string][]string{"executor-1": {"1"}, "executor-2": {"2"}},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			movable := tc.movable
+			if movable == nil {
+				movable = allMovable
+			}
+
+			moves := planShardMoves(tc.assignments, tc.shardLoads, movable, 1.2, tc.maxMoves)
+			assert.Equal(t, tc.expectedMoves, moves)
+			assert.Equal(t, tc.expectedAssignments, tc.assignments)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"slices"
 	"sort"
 	"strconv"
 	"sync"
@@ -41,6 +42,10 @@ type Factory interface {
 const (
 	_defaultPeriod     = time.Second
 	_deatulHearbeatTTL = 10 * time.Second
+
+	_defaultLoadImbalanceThreshold    = 1.2
+	_defaultMaxShardMovesPerRebalance = 1
+	_defaultShardMoveCooldown         = time.Minute
 )
 
 type processorFactory struct {
@@ -76,6 +81,15 @@ func NewProcessorFactory(
 	if cfg.Process.HeartbeatTTL == 0 {
 		cfg.Process.HeartbeatTTL = _deatulHearbeatTTL
 	}
+	if cfg.Process.LoadImbalanceThreshold == 0 {
+		cfg.Process.LoadImbalanceThreshold = _defaultLoadImbalanceThreshold
+	}
+	if cfg.Process.MaxShardMovesPerRebalance == 0 {
+		cfg.Process.MaxShardMovesPerRebalance = _defaultMaxShardMovesPerRebalance
+	}
+	if cfg.Process.ShardMoveCooldown == 0 {
+		cfg.Process.ShardMoveCooldown = _defaultShardMoveCooldown
+	}
 
 	return &processorFactory{
 		logger:        logger,
@@ -278,10 +292,24 @@ func (p *namespaceProcessor) rebalanceShards(ctx context.Context) (err error) {
 		return nil
 	}
 
-	// 2. Identify active executors.
+	// The operator controls are read after the state, their version is checked when the new state is committed.
+	control, err := p.shardStore.GetControl(ctx)
+	if err != nil {
+		return fmt.Errorf("get control: %w", err)
+	}
+	if control.RebalancingFrozen {
+		p.logger.Info("Rebalancing is frozen for the namespace, skipping.")
+		return nil
+	}
+
+	// 2. Identify active executors, drained executors do not get shards.
+	isAssignable := func(executorID string) bool {
+		_, drained := control.DrainedExecutors[executorID]
+		return heartbeatStates[executorID].State == store.ExecutorStateActive && !drained
+	}
 	var activeExecutors []string
-	for id, state := range heartbeatStates {
-		if state.State == store.ExecutorStateActive {
+	for id := range heartbeatStates {
+		if isAssignable(id) {
 			activeExecutors = append(activeExecutors, id)
 		}
 	}
@@ -310,7 +338,7 @@ func (p *namespaceProcessor) rebalanceShards(ctx context.Context) (err error) {
 
 	// Check existing assignments.
 	for executorID, state := range assignedStates {
-		isActive := heartbeatStates[executorID].State == store.ExecutorStateActive
+		isActive := isAssignable(executorID)
 		for shardID := range state.AssignedShards {
 			if _, ok := allShards[shardID]; ok {
 				delete(allShards, shardID)
@@ -318,7 +346,7 @@ func (p *namespaceProcessor) rebalanceShards(ctx context.Context) (err error) {
 					// Keep track of assignments for active executors.
 					currentAssignments[executorID] = append(currentAssignments[executorID], shardID)
 				} else {
-					// Shard is on a dead/draining executor, needs reassignment.
+					// Shard is on a dead/draining/drained executor, needs reassignment.
 					shardsToReassign[shardID] = struct{}{}
 				}
 			}
@@ -332,12 +360,8 @@ func (p *namespaceProcessor) rebalanceShards(ctx context.Context) (err error) {
 
 	metricsLoopScope.UpdateGauge(metrics.ShardDistributorAssignLoopNumRebalancedShards, float64(len(shardsToReassign)))
 
-	if len(shardsToReassign) == 0 {
-		return nil
-	}
-
 	// 5. Rebalance: Distribute the shards needing reassignment.
-	// This is a simple round-robin distribution. More complex strategies could be used.
+	// This is a simple round-robin distribution, the load balancing below moves shards based on their load.
 	i := rand.Intn(len(activeExecutors)) // Randomize the starting executor index
 	for shardID := range shardsToReassign {
 		executorID := activeExecutors[i%len(activeExecutors)]
@@ -345,12 +369,39 @@ func (p *namespaceProcessor) rebalanceShards(ctx context.Context) (err error) {
 		i++
 	}
 
-	// 6. Build the new state to be written to the store.
+	// 6. Apply the shard moves requested by operators, the moved shards are pinned for this rebalance.
+	operatorMoves := p.applyShardMoves(control.ShardMoves, currentAssignments, assignedStates)
+	pinnedShards := make(map[string]struct{}, len(shardsToReassign)+len(operatorMoves))
+	for shardID := range shardsToReassign {
+		pinnedShards[shardID] = struct{}{}
+	}
+	for _, move := range operatorMoves {
+		pinnedShards[move.shardID] = struct{}{}
+	}
+
+	// 7. Move shards from overloaded executors based on the load they report.
+	var moves []shardMove
+	if p.cfg.LoadBalancing {
+		moves = p.balanceLoad(metricsLoopScope, currentAssignments, assignedStates, pinnedShards)
+	}
+
+	if len(shardsToReassign) == 0 && len(operatorMoves) == 0 && len(moves) == 0 {
+		return nil
+	}
+
+	// 8. Build the new state to be written to the store.
+	now := p.timeSource.Now().Unix()
 	newState := make(map[string]store.AssignedState)
 	for executorID, shards := range currentAssignments {
 		assignedShardsMap := make(map[string]store.ShardAssignment)
 		for _, shardID := range shards {
-			assignedShardsMap[shardID] = store.ShardAssignment{ShardID: shardID}
+			assignment, ok := assignedStates[executorID].AssignedShards[shardID]
+			if !ok {
+				// The shard is new to the executor.
+				assignment = store.ShardAssignment{AssignedAt: now}
+			}
+			assignment.ShardID = shardID
+			assignedShardsMap[shardID] = assignment
 		}
 		newState[executorID] = store.AssignedState{
 			ExecutorID:     executorID,
@@ -359,9 +410,9 @@ func (p *namespaceProcessor) rebalanceShards(ctx context.Context) (err error) {
 		}
 	}
 
-	// 7. Commit the new state.
+	// 9. Commit the new state, unless the operator controls were changed since they were read.
 	p.logger.Info("Applying new shard distribution.")
-	err = p.shardStore.AssignShards(ctx, newState)
+	err = p.shardStore.AssignShards(ctx, newState, control.Version)
 	if err != nil {
 		// Do not update the revision, so we can retry on the next trigger.
 		return fmt.Errorf("assign shards: %w", err)
@@ -372,6 +423,108 @@ func (p *namespaceProcessor) rebalanceShards(ctx context.Context) (err error) {
 	return nil
 }
 
+// applyShardMoves moves the shards with a pending operator move to the requested executors and returns the moves.
+// A move is skipped while the requested executor cannot get shards, it is applied once the executor is active again.
+func (p *namespaceProcessor) applyShardMoves(
+	requested map[string]store.ShardMove,
+	assignments map[string][]string,
+	assignedStates map[string]store.AssignedState,
+) []shardMove {
+	if len(requested) == 0 {
+		return nil
+	}
+
+	owners := make(map[string]string)
+	for executorID, shards := range assignments {
+		for _, shardID := range shards {
+			owners[shardID] = executorID
+		}
+	}
+
+	// Shards are visited in a fixed order, so the moves are deterministic for the same input.
+	shardIDs := make([]string, 0, len(requested))
+	for shardID := range requested {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Strings(shardIDs)
+
+	var moves []shardMove
+	for _, shardID := range shardIDs {
+		move := requested[shardID]
+		from, ok := owners[shardID]
+		if !ok || from == move.ExecutorID {
+			continue
+		}
+		// Shards assigned in this rebalance have no assignment yet, so their move is pending.
+		if !move.IsPending(assignedStates[from].AssignedShards[shardID]) {
+			continue
+		}
+		if _, ok := assignments[move.ExecutorID]; !ok {
+			p.logger.Warn("Cannot move shard, the target executor is not active",
+				tag.ShardKey(shardID),
+				tag.ShardTargetExecutor(move.ExecutorID),
+			)
+			continue
+		}
+
+		assignments[from] = slices.DeleteFunc(assignments[from], func(id string) bool { return id == shardID })
+		assignments[move.ExecutorID] = append(assignments[move.ExecutorID], shardID)
+		moves = append(moves, shardMove{shardID: shardID, from: from, to: move.ExecutorID})
+		p.logger.Info("Moving shard as requested by an operator",
+			tag.ShardKey(shardID),
+			tag.ShardExecutor(from),
+			tag.ShardTargetExecutor(move.ExecutorID),
+		)
+	}
+
+	return moves
+}
+
+// balanceLoad moves shards from the most to the least loaded active executors and returns the moves.
+// Shards assigned in this rebalance or within the shard move cooldown are not moved, which prevents a shard
+// from bouncing between executors before its load is reported by the new owner.
+func (p *namespaceProcessor) balanceLoad(
+	metricsScope metrics.Scope,
+	assignments map[string][]string,
+	assignedStates map[string]store.AssignedState,
+	newlyAssigned map[string]struct{},
+) []shardMove {
+	shardLoads := make(map[string]float64)
+	assignedAt := make(map[string]int64)
+	executorLoads := make(map[string]float64, len(assignments))
+	for executorID, shards := range assignments {
+		executorLoads[executorID] = 0
+		for _, shardID := range shards {
+			load := assignedStates[executorID].ReportedShards[shardID].ShardLoad
+			shardLoads[shardID] = load
+			assignedAt[shardID] = assignedStates[executorID].AssignedShards[shardID].AssignedAt
+			executorLoads[executorID] += load
+		}
+	}
+	metricsScope.UpdateGauge(metrics.ShardDistributorAssignLoopLoadImbalance, loadImbalance(executorLoads))
+
+	now := p.timeSource.Now()
+	movable := func(shardID string) bool {
+		if _, ok := newlyAssigned[shardID]; ok {
+			return false
+		}
+		return now.Sub(time.Unix(assignedAt[shardID], 0)) >= p.cfg.ShardMoveCooldown
+	}
+
+	moves := planShardMoves(assignments, shardLoads, movable, p.cfg.LoadImbalanceThreshold, p.cfg.MaxShardMovesPerRebalance)
+	for _, move := range moves {
+		p.logger.Info("Moving shard to balance load",
+			tag.ShardKey(move.shardID),
+			tag.ShardExecutor(move.from),
+			tag.ShardTargetExecutor(move.to),
+			tag.ShardLoad(move.load),
+		)
+	}
+	metricsScope.AddCounter(metrics.ShardDistributorAssignLoopLoadMovedShards, int64(len(moves)))
+
+	return moves
+}
+
 func getShards(cfg config.Namespace) []int64 {
 	if cfg.Type == config.NamespaceTypeFixed {
 		return makeRange(0, cfg.ShardNum-1)
@@ -30,6 +30,16 @@ var (
 			HeartbeatTTL: 10 * time.Second,
 		},
 	}
+	_testLoadBalancingCfg = config.LeaderElection{
+		Process: config.LeaderProcess{
+			Period:                    10 * time.Second,
+			HeartbeatTTL:              10 * time.Second,
+			LoadBalancing:             true,
+			LoadImbalanceThreshold:    1.2,
+			MaxShardMovesPerRebalance: 1,
+			ShardMoveCooldown:         time.Minute,
+		},
+	}
 )
 
 func TestNewProcessorFactory_DefaultConfiguration(t *testing.T) {
@@ -37,6 +47,9 @@ func TestNewProcessorFactory_DefaultConfiguration(t *testing.T) {
 	unwrappedFactory := factory.(*processorFactory)
 	assert.Equal(t, _defaultPeriod, unwrappedFactory.cfg.Period)
 	assert.Equal(t, _deatulHearbeatTTL, unwrappedFactory.cfg.HeartbeatTTL)
+	assert.Equal(t, _defaultLoadImbalanceThreshold, unwrappedFactory.cfg.LoadImbalanceThreshold)
+	assert.Equal(t, _defaultMaxShardMovesPerRebalance, unwrappedFactory.cfg.MaxShardMovesPerRebalance)
+	assert.Equal(t, _defaultShardMoveCooldown, unwrappedFactory.cfg.ShardMoveCooldown)
 }
 
 // TestLifecycle verifies the Run and Terminate methods.
@@ -80,10 +93,11 @@ func TestRebalance_InitialAssignment(t *testing.T) {
 	}
 
 	mockStore.EXPECT().GetState(gomock.Any()).Return(heartbeats, nil, int64(100), nil)
+	mockStore.EXPECT().GetControl(gomock.Any()).Return(store.NamespaceControl{}, nil)
 
 	var capturedState map[string]store.AssignedState
-	mockStore.EXPECT().AssignShards(gomock.Any(), gomock.Any()).DoAndReturn(
-		func(_ context.Context, newState map[string]store.AssignedState) error {
+	mockStore.EXPECT().AssignShards(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, newState map[string]store.AssignedState, _ int64) error {
 			capturedState = newState
 			return nil
 		},
@@ -131,10 +145,11 @@ func TestRebalance_ExecutorLeaves(t *testing.T) {
 	}
 
 	mockStore.EXPECT().GetState(gomock.Any()).Return(heartbeats, assignments, int64(100), nil)
+	mockStore.EXPECT().GetControl(gomock.Any()).Return(store.NamespaceControl{}, nil)
 
 	var capturedState map[string]store.AssignedState
-	mockStore.EXPECT().AssignShards(gomock.Any(), gomock.Any()).DoAndReturn(
-		func(_ context.Context, newState map[string]store.AssignedState) error {
+	mockStore.EXPECT().AssignShards(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, newState map[string]store.AssignedState, _ int64) error {
 			capturedState = newState
 			return nil
 		},
@@ -167,7 +182,7 @@ func TestRebalance_StaleRevision(t *testing.T) {
 	// Mock GetState to return a revision that is NOT newer.
 	mockStore.EXPECT().GetState(gomock.Any()).Return(nil, nil, int64(100), nil)
 	// AssignShards should NOT be called.
-	mockStore.EXPECT().AssignShards(gomock.Any(), gomock.Any()).Times(0)
+	mockStore.EXPECT().AssignShards(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 	// Act
 	processor.rebalanceShards(context.Background())
@@ -187,8 +202,9 @@ func TestRebalance_AssignShardsFailure(t *testing.T) {
 	// Mock GetState to return a new revision and one active executor.
 	heartbeats := map[string]store.HeartbeatState{"executor-1": {State: store.ExecutorStateActive}}
 	mockStore.EXPECT().GetState(gomock.Any()).Return(heartbeats, nil, int64(101), nil)
+	mockStore.EXPECT().GetControl(gomock.Any()).Return(store.NamespaceControl{}, nil)
 	// Mock AssignShards to return an error.
-	mockStore.EXPECT().AssignShards(gomock.Any(), gomock.Any()).Return(errors.New("etcd commit failed"))
+	mockStore.EXPECT().AssignShards(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("etcd commit failed"))
 
 	// Act
 	processor.rebalanceShards(context.Background())
@@ -210,8 +226,9 @@ func TestRebalance_NoActiveExecutors(t *testing.T) {
 	// Mock GetState to return executors that are not active.
 	heartbeats := map[string]store.HeartbeatState{"executor-1": {State: store.ExecutorStateDraining}}
 	mockStore.EXPECT().GetState(gomock.Any()).Return(heartbeats, nil, int64(102), nil)
+	mockStore.EXPECT().GetControl(gomock.Any()).Return(store.NamespaceControl{}, nil)
 	// AssignShards should NOT be called.
-	mockStore.EXPECT().AssignShards(gomock.Any(), gomock.Any()).Times(0)
+	mockStore.EXPECT().AssignShards(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 	// Act
 	processor.rebalanceShards(context.Background())
@@ -221,6 +238,217 @@ func TestRebalance_NoActiveExecutors(t *testing.T) {
 	assert.Equal(t, int64(0), processor.lastAppliedRevision)
 }
 
+// TestRebalance_LoadBalancing tests that a hot shard is moved to the least loaded executor.
+func TestRebalance_LoadBalancing(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	now := time.Now()
+	mockStore := store.NewMockShardStore(ctrl)
+	factory := NewProcessorFactory(testlogger.New(t), metrics.NewNoopMetricsClient(), clock.NewMockedTimeSourceAt(now), _testLoadBalancingCfg)
+	processor := factory.CreateProcessor(_testNamespaceCfg, mockStore).(*namespaceProcessor)
+
+	heartbeats := map[string]store.HeartbeatState{
+		"executor-1": {State: store.ExecutorStateActive},
+		"executor-2": {State: store.ExecutorStateActive},
+	}
+	assignedAt := now.Add(-time.Hour).Unix()
+	assignments := map[string]store.AssignedState{
+		"executor-1": loadedState(assignedAt, map[string]float64{"1": 50, "2": 5, "3": 5, "4": 5, "5": 5}),
+		"executor-2": loadedState(assignedAt, map[string]float64{"6": 2, "7": 2, "8": 2, "9": 2, "10": 2}),
+	}
+
+	mockStore.EXPECT().GetState(gomock.Any()).Return(heartbeats, assignments, int64(100), nil)
+	mockStore.EXPECT().GetControl(gomock.Any()).Return(store.NamespaceControl{}, nil)
+
+	var capturedState map[string]store.AssignedState
+	mockStore.EXPECT().AssignShards(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, newState map[string]store.AssignedState, _ int64) error {
+			capturedState = newState
+			return nil
+		},
+	)
+
+	// Act
+	err := processor.rebalanceShards(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, capturedState)
+	assert.Len(t, capturedState["executor-1"].AssignedShards, 4)
+	assert.Len(t, capturedState["executor-2"].AssignedShards, 6)
+	assert.Equal(t, store.ShardAssignment{ShardID: "1", AssignedAt: now.Unix()}, capturedState["executor-2"].AssignedShards["1"], "Moved shard gets a new assignment time")
+	assert.Equal(t, store.ShardAssignment{ShardID: "2", AssignedAt: assignedAt}, capturedState["executor-1"].AssignedShards["2"], "Kept shards are not changed")
+	assert.Equal(t, int64(100), processor.lastAppliedRevision)
+}
+
+// TestRebalance_LoadBalancingCooldown tests that recently assigned shards are not moved.
+func TestRebalance_LoadBalancingCooldown(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	now := time.Now()
+	mockStore := store.NewMockShardStore(ctrl)
+	factory := NewProcessorFactory(testlogger.New(t), metrics.NewNoopMetricsClient(), clock.NewMockedTimeSourceAt(now), _testLoadBalancingCfg)
+	processor := factory.CreateProcessor(_testNamespaceCfg, mockStore).(*namespaceProcessor)
+
+	heartbeats := map[string]store.HeartbeatState{
+		"executor-1": {State: store.ExecutorStateActive},
+		"executor-2": {State: store.ExecutorStateActive},
+	}
+	assignedAt := now.Add(-time.Second).Unix()
+	assignments := map[string]store.AssignedState{
+		"executor-1": loadedState(assignedAt, map[string]float64{"1": 50, "2": 5, "3": 5, "4": 5, "5": 5}),
+		"executor-2": loadedState(assignedAt, map[string]float64{"6": 2, "7": 2, "8": 2, "9": 2, "10": 2}),
+	}
+
+	mockStore.EXPECT().GetState(gomock.Any()).Return(heartbeats, assignments, int64(100), nil)
+	mockStore.EXPECT().GetControl(gomock.Any()).Return(store.NamespaceControl{}, nil)
+	// AssignShards should NOT be called.
+	mockStore.EXPECT().AssignShards(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	// Act
+	err := processor.rebalanceShards(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+}
+
+// TestRebalance_Frozen ensures no action is taken while rebalancing is frozen.
+func TestRebalance_Frozen(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	mockStore := store.NewMockShardStore(ctrl)
+	factory := NewProcessorFactory(testlogger.New(t), metrics.NewNoopMetricsClient(), clock.NewRealTimeSource(), _testLeaderElectionCfg)
+	processor := factory.CreateProcessor(_testNamespaceCfg, mockStore).(*namespaceProcessor)
+
+	heartbeats := map[string]store.HeartbeatState{"executor-1": {State: store.ExecutorStateActive}}
+	mockStore.EXPECT().GetState(gomock.Any()).Return(heartbeats, nil, int64(100), nil)
+	mockStore.EXPECT().GetControl(gomock.Any()).Return(store.NamespaceControl{RebalancingFrozen: true}, nil)
+	// AssignShards should NOT be called.
+	mockStore.EXPECT().AssignShards(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	// Act
+	err := processor.rebalanceShards(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), processor.lastAppliedRevision)
+}
+
+// TestRebalance_DrainedExecutor tests that shards are moved off a drained executor.
+func TestRebalance_DrainedExecutor(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	mockStore := store.NewMockShardStore(ctrl)
+	factory := NewProcessorFactory(testlogger.New(t), metrics.NewNoopMetricsClient(), clock.NewRealTimeSource(), _testLeaderElectionCfg)
+	processor := factory.CreateProcessor(_testNamespaceCfg, mockStore).(*namespaceProcessor)
+
+	heartbeats := map[string]store.HeartbeatState{
+		"executor-1": {State: store.ExecutorStateActive},
+		"executor-2": {State: store.ExecutorStateActive},
+	}
+	assignments := map[string]store.AssignedState{
+		"executor-1": {AssignedShards: map[string]store.ShardAssignment{"1": {}, "2": {}, "3": {}, "4": {}, "5": {}}},
+		"executor-2": {AssignedShards: map[string]store.ShardAssignment{"6": {}, "7": {}, "8": {}, "9": {}, "10": {}}},
+	}
+	control := store.NamespaceControl{
+		Version:          7,
+		DrainedExecutors: map[string]int64{"executor-2": 1},
+	}
+
+	mockStore.EXPECT().GetState(gomock.Any()).Return(heartbeats, assignments, int64(100), nil)
+	mockStore.EXPECT().GetControl(gomock.Any()).Return(control, nil)
+
+	var capturedState map[string]store.AssignedState
+	mockStore.EXPECT().AssignShards(gomock.Any(), gomock.Any(), int64(7)).DoAndReturn(
+		func(_ context.Context, newState map[string]store.AssignedState, _ int64) error {
+			capturedState = newState
+			return nil
+		},
+	)
+
+	// Act
+	err := processor.rebalanceShards(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, capturedState)
+	assert.NotContains(t, capturedState, "executor-2")
+	assert.Len(t, capturedState["executor-1"].AssignedShards, 10)
+}
+
+// TestRebalance_OperatorShardMove tests that a requested shard move is applied once.
+func TestRebalance_OperatorShardMove(t *testing.T) {
+	now := time.Now()
+	assignedAt := now.Add(-time.Hour).Unix()
+	heartbeats := map[string]store.HeartbeatState{
+		"executor-1": {State: store.ExecutorStateActive},
+		"executor-2": {State: store.ExecutorStateActive},
+		"executor-3": {State: store.ExecutorStateDraining},
+	}
+	assignments := map[string]store.AssignedState{
+		"executor-1": loadedState(assignedAt, map[string]float64{"1": 1, "2": 1, "3": 1, "4": 1, "5": 1}),
+		"executor-2": loadedState(assignedAt, map[string]float64{"6": 1, "7": 1, "8": 1, "9": 1, "10": 1}),
+	}
+
+	tests := []struct {
+		name        string
+		moves       map[string]store.ShardMove
+		expectWrite bool
+		expectOwner string
+	}{
+		{
+			name:        "pending move is applied",
+			moves:       map[string]store.ShardMove{"1": {ExecutorID: "executor-2", RequestedAt: assignedAt + 1}},
+			expectWrite: true,
+			expectOwner: "executor-2",
+		},
+		{
+			name:  "completed move is ignored",
+			moves: map[string]store.ShardMove{"1": {ExecutorID: "executor-2", RequestedAt: assignedAt - 1}},
+		},
+		{
+			name:  "move to an inactive executor is skipped",
+			moves: map[string]store.ShardMove{"1": {ExecutorID: "executor-3", RequestedAt: assignedAt + 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctrl := gomock.NewController(t)
+			mockStore := store.NewMockShardStore(ctrl)
+			factory := NewProcessorFactory(testlogger.New(t), metrics.NewNoopMetricsClient(), clock.NewMockedTimeSourceAt(now), _testLeaderElectionCfg)
+			processor := factory.CreateProcessor(_testNamespaceCfg, mockStore).(*namespaceProcessor)
+
+			mockStore.EXPECT().GetState(gomock.Any()).Return(heartbeats, assignments, int64(100), nil)
+			mockStore.EXPECT().GetControl(gomock.Any()).Return(store.NamespaceControl{Version: 3, ShardMoves: tt.moves}, nil)
+
+			var capturedState map[string]store.AssignedState
+			if tt.expectWrite {
+				mockStore.EXPECT().AssignShards(gomock.Any(), gomock.Any(), int64(3)).DoAndReturn(
+					func(_ context.Context, newState map[string]store.AssignedState, _ int64) error {
+						capturedState = newState
+						return nil
+					},
+				)
+			}
+
+			// Act
+			err := processor.rebalanceShards(context.Background())
+
+			// Assert
+			require.NoError(t, err)
+			if !tt.expectWrite {
+				return
+			}
+			require.NotNil(t, capturedState)
+			assert.Len(t, capturedState["executor-1"].AssignedShards, 4)
+			assert.Len(t, capturedState["executor-2"].AssignedShards, 6)
+			assert.Equal(t, store.ShardAssignment{ShardID: "1", AssignedAt: now.Unix()}, capturedState[tt.expectOwner].AssignedShards["1"])
+		})
+	}
+}
+
 // TestCleanup_RemovesStaleExecutors verifies that executors with old heartbeats are deleted.
 func TestCleanup_RemovesStaleExecutors(t *testing.T) {
 	// Arrange
@@ -292,3 +520,16 @@ func TestCleanup_NoStaleExecutors(t *testing.T) {
 	// Act
 	processor.(*namespaceProcessor).cleanupStaleExecutors(context.Background())
 }
+
+// loadedState returns an assigned state with the shards assigned at assignedAt and reporting the given load.
+func loadedState(assignedAt int64, shardLoads map[string]float64) store.AssignedState {
+	state := store.AssignedState{
+		ReportedShards: make(map[string]store.ShardState),
+		AssignedShards: make(map[string]store.ShardAssignment),
+	}
+	for shardID, load := range shardLoads {
+		state.ReportedShards[shardID] = store.ShardState{ShardLoad: load}
+		state.AssignedShards[shardID] = store.ShardAssignment{ShardID: shardID, AssignedAt: assignedAt}
+	}
+	return state
+}
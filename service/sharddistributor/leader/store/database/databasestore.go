@@ -0,0 +1,374 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/fx"
+
+	"github.com/uber/cadence/common/clock"
+	commonconfig "github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/client"
+	"github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/leader/store"
+)
+
+// StoreType is the leader store type of the database backed store in the configuration.
+const StoreType = "database"
+
+const (
+	_defaultElectionTTL  = 10 * time.Second
+	_defaultPollInterval = time.Second
+)
+
+var errNotLeader = errors.New("election is not a leader")
+
+func init() {
+	store.Register(StoreType, store.ProvideElector(NewStore))
+}
+
+// Store is a leader store on top of the shard distributor persistence of the configured default datastore.
+type Store struct {
+	manager    persistence.ShardDistributorManager
+	cfg        databaseCfg
+	timeSource clock.TimeSource
+}
+
+type StoreParams struct {
+	fx.In
+
+	// Manager could be provided externally, otherwise it is created from the persistence configuration.
+	Manager       persistence.ShardDistributorManager `optional:"true"`
+	Cfg           config.LeaderElection
+	ServiceConfig commonconfig.Config
+	DynamicConfig *dynamicconfig.Collection
+	MetricsClient metrics.Client
+	Logger        log.Logger
+	TimeSource    clock.TimeSource
+	Lifecycle     fx.Lifecycle
+}
+
+type databaseCfg struct {
+	// ElectionTTL is the duration of the leader lease, it is renewed every third of the TTL.
+	ElectionTTL time.Duration `yaml:"electionTTL"`
+	// PollInterval is how often the store is read while campaigning and for subscriptions.
+	PollInterval time.Duration `yaml:"pollInterval"`
+}
+
+// NewStore creates a new leader store backed by the shard distributor persistence.
+func NewStore(p StoreParams) (store.Elector, error) {
+	if !store.IsConfigured(p.Cfg, StoreType) {
+		return nil, nil
+	}
+
+	out := databaseCfg{
+		ElectionTTL:  _defaultElectionTTL,
+		PollInterval: _defaultPollInterval,
+	}
+	if p.Cfg.Store.StorageParams != nil {
+		if err := p.Cfg.Store.StorageParams.Decode(&out); err != nil {
+			return nil, fmt.Errorf("bad config: %w", err)
+		}
+	}
+	if out.ElectionTTL <= 0 || out.PollInterval <= 0 {
+		return nil, fmt.Errorf("bad config: electionTTL and pollInterval must be positive")
+	}
+
+	manager := p.Manager
+	if manager == nil {
+		persistenceCfg := p.ServiceConfig.Persistence
+		persistenceCfg.TransactionSizeLimit = p.DynamicConfig.GetIntProperty(dynamicproperties.TransactionSizeLimit)
+		persistenceCfg.ErrorInjectionRate = p.DynamicConfig.GetFloat64Property(dynamicproperties.PersistenceErrorInjectionRate)
+
+		factory := client.NewFactory(
+			&persistenceCfg,
+			nil,
+			p.ServiceConfig.ClusterGroupMetadata.CurrentClusterName,
+			p.MetricsClient,
+			p.Logger,
+			persistence.NewDynamicConfiguration(p.DynamicConfig),
+		)
+		var err error
+		manager, err = factory.NewShardDistributorManager()
+		if err != nil {
+			factory.Close()
+			return nil, fmt.Errorf("create shard distributor manager: %w", err)
+		}
+		p.Lifecycle.Append(fx.StopHook(func() {
+			manager.Close()
+			factory.Close()
+		}))
+	}
+
+	return &Store{
+		manager:    manager,
+		cfg:        out,
+		timeSource: p.TimeSource,
+	}, nil
+}
+
+func (s *Store) CreateElection(ctx context.Context, namespace string) (store.Election, error) {
+	return &election{
+		manager:    s.manager,
+		namespace:  namespace,
+		cfg:        s.cfg,
+		timeSource: s.timeSource,
+		done:       make(chan struct{}),
+	}, nil
+}
+
+func (s *Store) AdminStore(ctx context.Context, namespace string) (store.AdminStore, error) {
+	return &adminStore{
+		namespaceStore: namespaceStore{
+			manager:   s.manager,
+			namespace: namespace,
+		},
+	}, nil
+}
+
+// election campaigns for a lease on the leader row of the namespace.
+// The leader version is the fencing token: shard store writes are rejected once another leader incremented it.
+type election struct {
+	manager    persistence.ShardDistributorManager
+	namespace  string
+	cfg        databaseCfg
+	timeSource clock.TimeSource
+
+	// mu serializes lease writes so a renewal can't extend a lease after Resign.
+	mu        sync.Mutex
+	leader    *persistence.ShardDistributorLeader
+	stopRenew chan struct{}
+	renewWG   sync.WaitGroup
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func (e *election) Campaign(ctx context.Context, host string) error {
+	for {
+		acquired, err := e.tryAcquire(ctx, host)
+		if err != nil {
+			return err
+		}
+		if acquired {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-e.done:
+			return fmt.Errorf("election is closed")
+		case <-e.timeSource.After(e.cfg.PollInterval):
+		}
+	}
+}
+
+func (e *election) tryAcquire(ctx context.Context, host string) (bool, error) {
+	resp, err := e.manager.GetShardDistributorState(ctx, &persistence.GetShardDistributorStateRequest{
+		Namespace: e.namespace,
+	})
+	if err != nil {
+		return false, fmt.Errorf("get leader: %w", err)
+	}
+
+	now := e.timeSource.Now()
+	var previousVersion int64
+	if resp.Leader != nil {
+		if resp.Leader.LeaseExpiry.After(now) {
+			return false, nil
+		}
+		previousVersion = resp.Leader.Version
+	}
+
+	leader := &persistence.ShardDistributorLeader{
+		LeaderID:    host,
+		LeaseExpiry: now.Add(e.cfg.ElectionTTL),
+		Version:     previousVersion + 1,
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	err = e.manager.UpdateShardDistributorLeader(ctx, &persistence.UpdateShardDistributorLeaderRequest{
+		Namespace:       e.namespace,
+		Leader:          leader,
+		PreviousVersion: previousVersion,
+	})
+	if isConditionFailed(err) {
+		// Another host acquired the lease first.
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("update leader: %w", err)
+	}
+
+	e.leader = leader
+	e.stopRenew = make(chan struct{})
+	e.renewWG.Add(1)
+	go e.renewLoop(e.stopRenew)
+	return true, nil
+}
+
+func (e *election) renewLoop(stop <-chan struct{}) {
+	defer e.renewWG.Done()
+
+	ticker := e.timeSource.NewTicker(e.cfg.ElectionTTL / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.Chan():
+			if lost := e.renew(); lost {
+				e.close()
+				return
+			}
+		}
+	}
+}
+
+// renew extends the lease of the leader, it returns true if the leadership is lost.
+func (e *election) renew() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.leader == nil {
+		return false
+	}
+
+	ctx, cancel := e.timeSource.ContextWithTimeout(context.Background(), e.cfg.ElectionTTL/3)
+	defer cancel()
+
+	renewed := *e.leader
+	renewed.LeaseExpiry = e.timeSource.Now().Add(e.cfg.ElectionTTL)
+	err := e.manager.UpdateShardDistributorLeader(ctx, &persistence.UpdateShardDistributorLeaderRequest{
+		Namespace:       e.namespace,
+		Leader:          &renewed,
+		PreviousVersion: e.leader.Version,
+	})
+	switch {
+	case err == nil:
+		e.leader = &renewed
+		return false
+	case isConditionFailed(err):
+		e.leader = nil
+		return true
+	default:
+		// Keep the leadership while the lease is still valid, the next tick retries.
+		if e.timeSource.Now().Before(e.leader.LeaseExpiry) {
+			return false
+		}
+		e.leader = nil
+		return true
+	}
+}
+
+func (e *election) Resign(ctx context.Context) error {
+	e.stopRenewal()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.leader == nil {
+		return nil
+	}
+
+	// Expire the lease but keep the version, so the next leader increments it.
+	released := *e.leader
+	released.LeaseExpiry = time.Unix(0, 0)
+	err := e.manager.UpdateShardDistributorLeader(ctx, &persistence.UpdateShardDistributorLeaderRequest{
+		Namespace:       e.namespace,
+		Leader:          &released,
+		PreviousVersion: e.leader.Version,
+	})
+	e.leader = nil
+	if err != nil && !isConditionFailed(err) {
+		return fmt.Errorf("release leader: %w", err)
+	}
+	return nil
+}
+
+func (e *election) Done() <-chan struct{} {
+	return e.done
+}
+
+func (e *election) Cleanup(ctx context.Context) error {
+	if err := e.Resign(ctx); err != nil {
+		return err
+	}
+	e.close()
+	return nil
+}
+
+func (e *election) ShardStore(ctx context.Context) (store.ShardStore, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.leader == nil {
+		return nil, errNotLeader
+	}
+
+	return &shardStore{
+		namespaceStore: namespaceStore{
+			manager:   e.manager,
+			namespace: e.namespace,
+		},
+		leaderVersion: e.leader.Version,
+		pollInterval:  e.cfg.PollInterval,
+		timeSource:    e.timeSource,
+	}, nil
+}
+
+func (e *election) stopRenewal() {
+	e.mu.Lock()
+	stop := e.stopRenew
+	e.stopRenew = nil
+	e.mu.Unlock()
+
+	if stop != nil {
+		close(stop)
+		e.renewWG.Wait()
+	}
+}
+
+func (e *election) close() {
+	e.closeOnce.Do(func() {
+		close(e.done)
+	})
+}
+
+func isConditionFailed(err error) bool {
+	var condErr *persistence.ConditionFailedError
+	return errors.As(err, &condErr)
+}
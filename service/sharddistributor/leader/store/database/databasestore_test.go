@@ -0,0 +1,483 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package database
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/fx/fxtest"
+	"gopkg.in/yaml.v2"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/persistence"
+	shardDistributorCfg "github.com/uber/cadence/service/sharddistributor/config"
+	"github.com/uber/cadence/service/sharddistributor/leader/store"
+)
+
+func TestNotEnabledLeaderElection(t *testing.T) {
+	store, err := NewStore(StoreParams{
+		Cfg: shardDistributorCfg.LeaderElection{Enabled: false},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, store)
+}
+
+func TestOtherStoreTypeConfigured(t *testing.T) {
+	store, err := NewStore(StoreParams{
+		Cfg: shardDistributorCfg.LeaderElection{Enabled: true},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, store, "etcd is the default leader store type")
+
+	store, err = NewStore(StoreParams{
+		Cfg: shardDistributorCfg.LeaderElection{Enabled: true, Store: shardDistributorCfg.LeaderStore{Type: "etcd"}},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, store)
+}
+
+func TestBadConfig(t *testing.T) {
+	_, err := NewStore(StoreParams{
+		Manager: newFakeManager(),
+		Cfg: shardDistributorCfg.LeaderElection{
+			Enabled: true,
+			Store: shardDistributorCfg.LeaderStore{
+				Type:          StoreType,
+				StorageParams: createConfig(t, databaseCfg{ElectionTTL: -time.Second, PollInterval: time.Second}),
+			},
+		},
+		TimeSource: clock.NewRealTimeSource(),
+	})
+	require.ErrorContains(t, err, "bad config")
+}
+
+// TestCampaign tests that a node can campaign for leadership
+func TestCampaign(t *testing.T) {
+	tc := setupTestStore(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-namespace-campaign"
+	election, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer election.Cleanup(ctx)
+
+	err = election.Campaign(ctx, "test-host-1")
+	require.NoError(t, err)
+
+	leader := tc.manager.leader(namespace)
+	require.NotNil(t, leader, "Leader should be written")
+	assert.Equal(t, "test-host-1", leader.LeaderID)
+	assert.Equal(t, int64(1), leader.Version)
+	assert.True(t, leader.LeaseExpiry.After(time.Now()))
+}
+
+// TestResign tests resigning leadership
+func TestResign(t *testing.T) {
+	tc := setupTestStore(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-namespace-resign"
+	election, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer election.Cleanup(ctx)
+
+	err = election.Campaign(ctx, "test-host-1")
+	require.NoError(t, err)
+
+	err = election.Resign(ctx)
+	require.NoError(t, err)
+
+	// Resigning twice is a no-op
+	err = election.Resign(ctx)
+	require.NoError(t, err)
+
+	election2, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer election2.Cleanup(ctx)
+
+	err = election2.Campaign(ctx, "host-2")
+	require.NoError(t, err, "Second host should be able to become leader after first resigned")
+
+	leader := tc.manager.leader(namespace)
+	assert.Equal(t, "host-2", leader.LeaderID)
+	assert.Equal(t, int64(2), leader.Version, "New leader must increment the version")
+}
+
+// TestMultipleNodes tests multiple nodes competing for leadership
+func TestMultipleNodes(t *testing.T) {
+	tc := setupTestStore(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	namespace := "test-namespace-multiple"
+
+	election1, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer election1.Cleanup(ctx)
+
+	election2, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer election2.Cleanup(ctx)
+
+	err = election1.Campaign(ctx, "host1")
+	require.NoError(t, err)
+
+	// Second node campaigns - this should block as first node already has leadership
+	ctxTimeout, cancelTimeout := context.WithTimeout(ctx, 500*time.Millisecond)
+	defer cancelTimeout()
+
+	err = election2.Campaign(ctxTimeout, "host2")
+	require.Error(t, err, "Expected a timeout error for the second campaign")
+	require.Contains(t, err.Error(), "context deadline exceeded")
+
+	// The lease of the first node is renewed while it waits
+	assert.Equal(t, "host1", tc.manager.leader(namespace).LeaderID)
+
+	err = election1.Resign(ctx)
+	require.NoError(t, err)
+
+	election3, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer election3.Cleanup(ctx)
+
+	err = election3.Campaign(ctx, "host3")
+	require.NoError(t, err, "Third host should be able to become leader after first host resigned")
+}
+
+// TestSessionDone tests the Done channel behavior
+func TestSessionDone(t *testing.T) {
+	tc := setupTestStore(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	election, err := tc.store.CreateElection(ctx, "test-namespace-done")
+	require.NoError(t, err)
+
+	doneCh := election.Done()
+	require.NotNil(t, doneCh)
+
+	err = election.Cleanup(ctx)
+	require.NoError(t, err)
+
+	select {
+	case <-doneCh:
+	case <-time.After(2 * time.Second):
+		t.Error("Done channel should be closed after Cleanup")
+	}
+}
+
+// TestLeadershipLost tests that Done is closed when another host takes over the lease
+func TestLeadershipLost(t *testing.T) {
+	tc := setupTestStore(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-namespace-lost"
+	election, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer election.Cleanup(ctx)
+
+	err = election.Campaign(ctx, "host1")
+	require.NoError(t, err)
+
+	tc.manager.setLeader(namespace, &persistence.ShardDistributorLeader{
+		LeaderID:    "host2",
+		LeaseExpiry: time.Now().Add(time.Minute),
+		Version:     2,
+	})
+
+	select {
+	case <-election.Done():
+	case <-time.After(2 * time.Second):
+		t.Fatal("Done channel should be closed after the lease is lost")
+	}
+
+	// Resign must not release the lease of the new leader
+	err = election.Resign(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "host2", tc.manager.leader(namespace).LeaderID)
+}
+
+func TestShardStoreRequiresLeadership(t *testing.T) {
+	tc := setupTestStore(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	election, err := tc.store.CreateElection(ctx, "test-namespace-not-leader")
+	require.NoError(t, err)
+	defer election.Cleanup(ctx)
+
+	_, err = election.ShardStore(ctx)
+	assert.ErrorIs(t, err, errNotLeader)
+}
+
+type testStore struct {
+	store   store.Elector
+	manager *fakeManager
+}
+
+func setupTestStore(t *testing.T) *testStore {
+	t.Helper()
+
+	manager := newFakeManager()
+	elector, err := NewStore(StoreParams{
+		Manager: manager,
+		Cfg: shardDistributorCfg.LeaderElection{
+			Enabled: true,
+			Store: shardDistributorCfg.LeaderStore{
+				Type: StoreType,
+				StorageParams: createConfig(t, databaseCfg{
+					ElectionTTL:  300 * time.Millisecond,
+					PollInterval: 10 * time.Millisecond,
+				}),
+			},
+		},
+		TimeSource: clock.NewRealTimeSource(),
+		Lifecycle:  fxtest.NewLifecycle(t),
+	})
+	require.NoError(t, err)
+
+	return &testStore{
+		store:   elector,
+		manager: manager,
+	}
+}
+
+func createConfig(t *testing.T, cfg databaseCfg) *config.YamlNode {
+	t.Helper()
+
+	yamlCfg, err := yaml.Marshal(cfg)
+	require.NoError(t, err)
+
+	var res *config.YamlNode
+	err = yaml.Unmarshal(yamlCfg, &res)
+	require.NoError(t, err)
+
+	return res
+}
+
+// fakeManager is an in-memory persistence.ShardDistributorManager with the same conditional write semantics.
+type fakeManager struct {
+	sync.Mutex
+	namespaces map[string]*persistence.GetShardDistributorStateResponse
+	// beforeWrite is called before a leader write is applied, it can be used to simulate concurrent writes.
+	beforeWrite func()
+	// beforeUpsert is called once before the next executor upsert is applied, it can be used to simulate concurrent writes.
+	beforeUpsert func()
+}
+
+var _ persistence.ShardDistributorManager = (*fakeManager)(nil)
+
+func newFakeManager() *fakeManager {
+	return &fakeManager{
+		namespaces: make(map[string]*persistence.GetShardDistributorStateResponse),
+	}
+}
+
+func (m *fakeManager) Close() {}
+
+func (m *fakeManager) GetShardDistributorState(_ context.Context, request *persistence.GetShardDistributorStateRequest) (*persistence.GetShardDistributorStateResponse, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	ns := m.namespace(request.Namespace)
+	resp := &persistence.GetShardDistributorStateResponse{
+		Executors: make(map[string]*persistence.ShardDistributorExecutor, len(ns.Executors)),
+		Revision:  ns.Revision,
+	}
+	if ns.Leader != nil {
+		leader := *ns.Leader
+		resp.Leader = &leader
+	}
+	for id, executor := range ns.Executors {
+		copied := *executor
+		resp.Executors[id] = &copied
+	}
+	return resp, nil
+}
+
+func (m *fakeManager) UpdateShardDistributorLeader(_ context.Context, request *persistence.UpdateShardDistributorLeaderRequest) error {
+	m.Lock()
+	defer m.Unlock()
+
+	ns := m.namespace(request.Namespace)
+	var version int64
+	if ns.Leader != nil {
+		version = ns.Leader.Version
+	}
+	if version != request.PreviousVersion {
+		return &persistence.ConditionFailedError{Msg: "leader version mismatch"}
+	}
+	leader := *request.Leader
+	ns.Leader = &leader
+	return nil
+}
+
+func (m *fakeManager) UpsertShardDistributorExecutor(_ context.Context, request *persistence.UpsertShardDistributorExecutorRequest) error {
+	m.runBeforeUpsert()
+
+	m.Lock()
+	defer m.Unlock()
+
+	ns := m.namespace(request.Namespace)
+	if ns.Revision != request.PreviousRevision {
+		return &persistence.ConditionFailedError{Msg: "revision mismatch"}
+	}
+	executor := *request.Executor
+	if existing, ok := ns.Executors[executor.ExecutorID]; ok {
+		executor.AssignedShards = existing.AssignedShards
+		executor.AssignedRevision = existing.AssignedRevision
+	}
+	ns.Executors[executor.ExecutorID] = &executor
+	ns.Revision++
+	return nil
+}
+
+func (m *fakeManager) AssignShardDistributorShards(_ context.Context, request *persistence.AssignShardDistributorShardsRequest) error {
+	m.runBeforeWrite()
+
+	m.Lock()
+	defer m.Unlock()
+
+	ns := m.namespace(request.Namespace)
+	if err := checkLeaderWrite(ns, request.LeaderVersion, request.PreviousRevision); err != nil {
+		return err
+	}
+	ns.Revision++
+	for id, shards := range request.AssignedShards {
+		executor, ok := ns.Executors[id]
+		if !ok {
+			executor = &persistence.ShardDistributorExecutor{ExecutorID: id}
+			ns.Executors[id] = executor
+		}
+		executor.AssignedShards = shards
+		executor.AssignedRevision = ns.Revision
+	}
+	return nil
+}
+
+func (m *fakeManager) DeleteShardDistributorExecutors(_ context.Context, request *persistence.DeleteShardDistributorExecutorsRequest) error {
+	m.runBeforeWrite()
+
+	m.Lock()
+	defer m.Unlock()
+
+	ns := m.namespace(request.Namespace)
+	if err := checkLeaderWrite(ns, request.LeaderVersion, request.PreviousRevision); err != nil {
+		return err
+	}
+	for _, id := range request.ExecutorIDs {
+		delete(ns.Executors, id)
+	}
+	ns.Revision++
+	return nil
+}
+
+// upsertExecutor writes an executor at the current revision, as an executor heartbeat would.
+func (m *fakeManager) upsertExecutor(t *testing.T, executor *persistence.ShardDistributorExecutor, namespace string) {
+	t.Helper()
+
+	resp, err := m.GetShardDistributorState(context.Background(), &persistence.GetShardDistributorStateRequest{Namespace: namespace})
+	require.NoError(t, err)
+	err = m.UpsertShardDistributorExecutor(context.Background(), &persistence.UpsertShardDistributorExecutorRequest{
+		Namespace:        namespace,
+		Executor:         executor,
+		PreviousRevision: resp.Revision,
+	})
+	require.NoError(t, err)
+}
+
+func (m *fakeManager) leader(namespace string) *persistence.ShardDistributorLeader {
+	m.Lock()
+	defer m.Unlock()
+
+	return m.namespace(namespace).Leader
+}
+
+func (m *fakeManager) setLeader(namespace string, leader *persistence.ShardDistributorLeader) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.namespace(namespace).Leader = leader
+}
+
+func (m *fakeManager) setBeforeWrite(fn func()) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.beforeWrite = fn
+}
+
+func (m *fakeManager) setBeforeUpsert(fn func()) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.beforeUpsert = fn
+}
+
+func (m *fakeManager) runBeforeUpsert() {
+	m.Lock()
+	fn := m.beforeUpsert
+	m.beforeUpsert = nil
+	m.Unlock()
+
+	if fn != nil {
+		fn()
+	}
+}
+
+func (m *fakeManager) runBeforeWrite() {
+	m.Lock()
+	fn := m.beforeWrite
+	m.Unlock()
+
+	if fn != nil {
+		fn()
+	}
+}
+
+func (m *fakeManager) namespace(name string) *persistence.GetShardDistributorStateResponse {
+	ns, ok := m.namespaces[name]
+	if !ok {
+		ns = &persistence.GetShardDistributorStateResponse{Executors: make(map[string]*persistence.ShardDistributorExecutor)}
+		m.namespaces[name] = ns
+	}
+	return ns
+}
+
+func checkLeaderWrite(ns *persistence.GetShardDistributorStateResponse, leaderVersion, previousRevision int64) error {
+	if ns.Leader == nil || ns.Leader.Version != leaderVersion {
+		return &persistence.ConditionFailedError{Msg: "leader version mismatch"}
+	}
+	if ns.Revision != previousRevision {
+		return &persistence.ConditionFailedError{Msg: "revision mismatch"}
+	}
+	return nil
+}
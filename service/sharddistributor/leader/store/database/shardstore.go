@@ -0,0 +1,357 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package database
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/sharddistributor/leader/store"
+)
+
+// _maxConflictRetries bounds the retries of leader writes that lost the revision race against executor heartbeats.
+const _maxConflictRetries = 5
+
+// _controlExecutorID is the reserved executor record the namespace control is stored in.
+// Like executor writes, control updates are conditional on the namespace revision and increment it,
+// so leader writes based on outdated controls fail and subscribers are notified about control updates.
+const _controlExecutorID = "_namespace_control"
+
+var (
+	errLeadershipChanged = errors.New("transaction failed: leadership may have changed (leader version mismatch)")
+	errControlChanged    = errors.New("transaction failed: namespace control was updated (control version mismatch)")
+)
+
+// namespaceStore reads the state and the controls of a namespace, it is shared by the leader and admin stores.
+type namespaceStore struct {
+	manager   persistence.ShardDistributorManager
+	namespace string
+}
+
+type shardStore struct {
+	namespaceStore
+	leaderVersion int64
+	pollInterval  time.Duration
+	timeSource    clock.TimeSource
+}
+
+// controlRecord is the namespace control as it is stored in the reported shards of the control executor record.
+type controlRecord struct {
+	Version int64                  `json:"version"`
+	Control store.NamespaceControl `json:"control"`
+}
+
+func (s *namespaceStore) GetState(ctx context.Context) (map[string]store.HeartbeatState, map[string]store.AssignedState, int64, error) {
+	resp, err := s.getState(ctx)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	heartbeatStates := make(map[string]store.HeartbeatState, len(resp.Executors))
+	assignedStates := make(map[string]store.AssignedState, len(resp.Executors))
+
+	for executorID, executor := range resp.Executors {
+		if executorID == _controlExecutorID {
+			continue
+		}
+
+		heartbeat := store.HeartbeatState{
+			ExecutorID: executorID,
+			State:      store.ExecutorState(executor.State),
+		}
+		if !executor.LastHeartbeat.IsZero() {
+			heartbeat.LastHeartbeat = executor.LastHeartbeat.Unix()
+		}
+
+		assigned := store.AssignedState{
+			ExecutorID:     executorID,
+			ReportedShards: make(map[string]store.ShardState),
+			AssignedShards: make(map[string]store.ShardAssignment),
+		}
+		if executor.ReportedShards != nil && len(executor.ReportedShards.Data) > 0 {
+			if err := json.Unmarshal(executor.ReportedShards.Data, &assigned.ReportedShards); err != nil {
+				return nil, nil, 0, fmt.Errorf("failed to unmarshal reported shards for executor %s: %w", executorID, err)
+			}
+		}
+		if executor.AssignedShards != nil && len(executor.AssignedShards.Data) > 0 {
+			if err := json.Unmarshal(executor.AssignedShards.Data, &assigned.AssignedShards); err != nil {
+				return nil, nil, 0, fmt.Errorf("failed to unmarshal assigned shards for executor %s: %w", executorID, err)
+			}
+		}
+
+		heartbeatStates[executorID] = heartbeat
+		assignedStates[executorID] = assigned
+	}
+
+	return heartbeatStates, assignedStates, resp.Revision, nil
+}
+
+func (s *namespaceStore) GetControl(ctx context.Context) (store.NamespaceControl, error) {
+	resp, err := s.getState(ctx)
+	if err != nil {
+		return store.NamespaceControl{}, err
+	}
+	return decodeControl(resp)
+}
+
+func (s *shardStore) AssignShards(ctx context.Context, newState map[string]store.AssignedState, controlVersion int64) error {
+	if len(newState) == 0 {
+		return nil
+	}
+
+	assignedShards := make(map[string]*persistence.DataBlob, len(newState))
+	for executorID, state := range newState {
+		value, err := json.Marshal(state.AssignedShards)
+		if err != nil {
+			return fmt.Errorf("failed to marshal assigned shards for executor %s: %w", executorID, err)
+		}
+		assignedShards[executorID] = &persistence.DataBlob{
+			Encoding: constants.EncodingTypeJSON,
+			Data:     value,
+		}
+	}
+
+	err := s.writeAsLeader(ctx, func(resp *persistence.GetShardDistributorStateResponse) error {
+		control, err := decodeControl(resp)
+		if err != nil {
+			return err
+		}
+		if control.Version != controlVersion {
+			return errControlChanged
+		}
+
+		return s.manager.AssignShardDistributorShards(ctx, &persistence.AssignShardDistributorShardsRequest{
+			Namespace:        s.namespace,
+			AssignedShards:   assignedShards,
+			LeaderVersion:    s.leaderVersion,
+			PreviousRevision: resp.Revision,
+		})
+	})
+	if err != nil && !errors.Is(err, errLeadershipChanged) && !errors.Is(err, errControlChanged) {
+		return fmt.Errorf("failed to commit shard assignments: %w", err)
+	}
+	return err
+}
+
+// Subscribe polls the namespace and sends the latest revision when an executor is added or removed,
+// or changes its state or reported shards. Heartbeats and assignments are not notified.
+func (s *shardStore) Subscribe(ctx context.Context) (<-chan int64, error) {
+	resp, err := s.getState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	last := significantState(resp)
+
+	// Use a buffered channel of size 1.
+	revisionChan := make(chan int64, 1)
+
+	go func() {
+		defer close(revisionChan)
+
+		ticker := s.timeSource.NewTicker(s.pollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.Chan():
+			}
+
+			resp, err := s.getState(ctx)
+			if err != nil {
+				// Transient read failures are retried on the next poll.
+				continue
+			}
+
+			current := significantState(resp)
+			if current.equal(last) {
+				continue
+			}
+			last = current
+
+			// Drain the stale revision if the subscriber did not consume it yet, then send the latest one.
+			select {
+			case <-revisionChan:
+			default:
+			}
+			revisionChan <- resp.Revision
+		}
+	}()
+
+	return revisionChan, nil
+}
+
+// DeleteExecutors removes the given executors in a single write.
+func (s *shardStore) DeleteExecutors(ctx context.Context, executorIDs []string) error {
+	if len(executorIDs) == 0 {
+		return nil
+	}
+
+	err := s.writeAsLeader(ctx, func(resp *persistence.GetShardDistributorStateResponse) error {
+		return s.manager.DeleteShardDistributorExecutors(ctx, &persistence.DeleteShardDistributorExecutorsRequest{
+			Namespace:        s.namespace,
+			ExecutorIDs:      executorIDs,
+			LeaderVersion:    s.leaderVersion,
+			PreviousRevision: resp.Revision,
+		})
+	})
+	if err != nil && !errors.Is(err, errLeadershipChanged) {
+		return fmt.Errorf("failed to delete executors: %w", err)
+	}
+	return err
+}
+
+// writeAsLeader runs write with the current state of the namespace, the write must be conditional on its revision.
+// A condition failure is retried if this store is still the leader, since executors also increment the revision.
+func (s *shardStore) writeAsLeader(ctx context.Context, write func(resp *persistence.GetShardDistributorStateResponse) error) error {
+	var err error
+	for attempt := 0; attempt < _maxConflictRetries; attempt++ {
+		resp, getErr := s.getState(ctx)
+		if getErr != nil {
+			return getErr
+		}
+		if resp.Leader == nil || resp.Leader.Version != s.leaderVersion {
+			return errLeadershipChanged
+		}
+
+		err = write(resp)
+		if !isConditionFailed(err) {
+			return err
+		}
+	}
+	return err
+}
+
+func (s *namespaceStore) getState(ctx context.Context) (*persistence.GetShardDistributorStateResponse, error) {
+	resp, err := s.manager.GetShardDistributorState(ctx, &persistence.GetShardDistributorStateRequest{
+		Namespace: s.namespace,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shard distributor state: %w", err)
+	}
+	return resp, nil
+}
+
+// adminStore gives operators access to the namespace without leadership.
+type adminStore struct {
+	namespaceStore
+}
+
+// UpdateControl writes the controls if their version didn't change.
+// A condition failure is retried as long as the controls are unchanged, since executors also increment the revision.
+func (s *adminStore) UpdateControl(ctx context.Context, control store.NamespaceControl) error {
+	value, err := json.Marshal(controlRecord{Version: control.Version + 1, Control: control})
+	if err != nil {
+		return fmt.Errorf("failed to marshal namespace control: %w", err)
+	}
+
+	for attempt := 0; attempt < _maxConflictRetries; attempt++ {
+		resp, getErr := s.getState(ctx)
+		if getErr != nil {
+			return getErr
+		}
+		current, decodeErr := decodeControl(resp)
+		if decodeErr != nil {
+			return decodeErr
+		}
+		if current.Version != control.Version {
+			return store.ErrControlVersionMismatch
+		}
+
+		err = s.manager.UpsertShardDistributorExecutor(ctx, &persistence.UpsertShardDistributorExecutorRequest{
+			Namespace: s.namespace,
+			Executor: &persistence.ShardDistributorExecutor{
+				ExecutorID: _controlExecutorID,
+				ReportedShards: &persistence.DataBlob{
+					Encoding: constants.EncodingTypeJSON,
+					Data:     value,
+				},
+			},
+			PreviousRevision: resp.Revision,
+		})
+		if !isConditionFailed(err) {
+			break
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update namespace control: %w", err)
+	}
+	return nil
+}
+
+func decodeControl(resp *persistence.GetShardDistributorStateResponse) (store.NamespaceControl, error) {
+	record, ok := resp.Executors[_controlExecutorID]
+	if !ok || record.ReportedShards == nil || len(record.ReportedShards.Data) == 0 {
+		return store.NamespaceControl{}, nil
+	}
+
+	var stored controlRecord
+	if err := json.Unmarshal(record.ReportedShards.Data, &stored); err != nil {
+		return store.NamespaceControl{}, fmt.Errorf("failed to unmarshal namespace control: %w", err)
+	}
+	stored.Control.Version = stored.Version
+	return stored.Control, nil
+}
+
+// executorsSnapshot is the part of the executors that subscribers are notified about.
+type executorsSnapshot map[string]executorSnapshot
+
+type executorSnapshot struct {
+	state          string
+	reportedShards []byte
+}
+
+func significantState(resp *persistence.GetShardDistributorStateResponse) executorsSnapshot {
+	snapshot := make(executorsSnapshot, len(resp.Executors))
+	for executorID, executor := range resp.Executors {
+		var reportedShards []byte
+		if executor.ReportedShards != nil {
+			reportedShards = executor.ReportedShards.Data
+		}
+		snapshot[executorID] = executorSnapshot{
+			state:          executor.State,
+			reportedShards: reportedShards,
+		}
+	}
+	return snapshot
+}
+
+func (s executorsSnapshot) equal(other executorsSnapshot) bool {
+	if len(s) != len(other) {
+		return false
+	}
+	for executorID, executor := range s {
+		otherExecutor, ok := other[executorID]
+		if !ok || executor.state != otherExecutor.state || !bytes.Equal(executor.reportedShards, otherExecutor.reportedShards) {
+			return false
+		}
+	}
+	return true
+}
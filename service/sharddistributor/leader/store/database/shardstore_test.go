@@ -0,0 +1,478 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/sharddistributor/leader/store"
+)
+
+func TestShardStoreGetStateEmpty(t *testing.T) {
+	tc := setupTestStore(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	shardStore := tc.leaderShardStore(ctx, t, "test-empty")
+
+	heartbeats, assignments, rev, err := shardStore.GetState(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, heartbeats)
+	assert.Empty(t, assignments)
+	assert.Zero(t, rev)
+}
+
+func TestShardStoreRoundTrip(t *testing.T) {
+	tc := setupTestStore(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-roundtrip"
+	shardStore := tc.leaderShardStore(ctx, t, namespace)
+
+	heartbeatTime := time.Unix(1700000000, 0)
+	reportedShards := map[string]store.ShardState{
+		"shard-1": {Status: "running", LastUpdated: 1700000000},
+	}
+	tc.manager.upsertExecutor(t, &persistence.ShardDistributorExecutor{
+		ExecutorID:     "executor-1",
+		LastHeartbeat:  heartbeatTime,
+		State:          string(store.ExecutorStateActive),
+		ReportedShards: jsonBlob(t, reportedShards),
+	}, namespace)
+
+	heartbeats, assignments, rev, err := shardStore.GetState(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), rev)
+
+	require.Contains(t, heartbeats, "executor-1")
+	assert.Equal(t, store.HeartbeatState{
+		ExecutorID:    "executor-1",
+		LastHeartbeat: heartbeatTime.Unix(),
+		State:         store.ExecutorStateActive,
+	}, heartbeats["executor-1"])
+
+	require.Contains(t, assignments, "executor-1")
+	assert.Equal(t, reportedShards, assignments["executor-1"].ReportedShards)
+	assert.Empty(t, assignments["executor-1"].AssignedShards)
+}
+
+func TestShardStoreMultipleExecutors(t *testing.T) {
+	tc := setupTestStore(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-multiple-executors"
+	shardStore := tc.leaderShardStore(ctx, t, namespace)
+
+	tc.manager.upsertExecutor(t, &persistence.ShardDistributorExecutor{
+		ExecutorID: "executor-1",
+		State:      string(store.ExecutorStateActive),
+	}, namespace)
+	tc.manager.upsertExecutor(t, &persistence.ShardDistributorExecutor{
+		ExecutorID: "executor-2",
+		State:      string(store.ExecutorStateDraining),
+	}, namespace)
+
+	heartbeats, assignments, rev, err := shardStore.GetState(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), rev)
+	assert.Len(t, heartbeats, 2)
+	assert.Len(t, assignments, 2)
+	assert.Equal(t, store.ExecutorStateActive, heartbeats["executor-1"].State)
+	assert.Equal(t, store.ExecutorStateDraining, heartbeats["executor-2"].State)
+}
+
+func TestShardStoreAssignShards(t *testing.T) {
+	tc := setupTestStore(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-assign"
+	shardStore := tc.leaderShardStore(ctx, t, namespace)
+
+	tc.manager.upsertExecutor(t, &persistence.ShardDistributorExecutor{
+		ExecutorID: "executor-1",
+		State:      string(store.ExecutorStateActive),
+	}, namespace)
+
+	assignedShards := map[string]store.ShardAssignment{
+		"shard-1": {ShardID: "shard-1", AssignedAt: 1700000000, Priority: 1},
+	}
+	err := shardStore.AssignShards(ctx, map[string]store.AssignedState{
+		"executor-1": {ExecutorID: "executor-1", AssignedShards: assignedShards},
+	}, 0)
+	require.NoError(t, err)
+
+	_, assignments, rev, err := shardStore.GetState(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), rev)
+	assert.Equal(t, assignedShards, assignments["executor-1"].AssignedShards)
+}
+
+func TestShardStoreAssignShardsRetriesRevisionConflict(t *testing.T) {
+	tc := setupTestStore(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-assign-conflict"
+	shardStore := tc.leaderShardStore(ctx, t, namespace)
+
+	// An executor heartbeat increments the revision between the read and the write of the first attempt.
+	heartbeats := 0
+	tc.manager.setBeforeWrite(func() {
+		if heartbeats == 0 {
+			heartbeats++
+			tc.manager.upsertExecutor(t, &persistence.ShardDistributorExecutor{
+				ExecutorID: "executor-1",
+				State:      string(store.ExecutorStateActive),
+			}, namespace)
+		}
+	})
+
+	err := shardStore.AssignShards(ctx, map[string]store.AssignedState{
+		"executor-1": {ExecutorID: "executor-1", AssignedShards: map[string]store.ShardAssignment{"shard-1": {ShardID: "shard-1"}}},
+	}, 0)
+	require.NoError(t, err)
+
+	_, assignments, _, err := shardStore.GetState(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, assignments["executor-1"].AssignedShards, "shard-1")
+}
+
+func TestShardStoreLeadershipChange(t *testing.T) {
+	tc := setupTestStore(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-leadership-change"
+	shardStore := tc.leaderShardStore(ctx, t, namespace)
+
+	tc.manager.setLeader(namespace, &persistence.ShardDistributorLeader{
+		LeaderID:    "other-host",
+		LeaseExpiry: time.Now().Add(time.Minute),
+		Version:     2,
+	})
+
+	err := shardStore.AssignShards(ctx, map[string]store.AssignedState{
+		"executor-1": {ExecutorID: "executor-1"},
+	}, 0)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "leadership may have changed")
+
+	err = shardStore.DeleteExecutors(ctx, []string{"executor-1"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "leadership may have changed")
+}
+
+func TestShardStoreMalformedJSON(t *testing.T) {
+	tc := setupTestStore(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-malformed"
+	shardStore := tc.leaderShardStore(ctx, t, namespace)
+
+	tc.manager.upsertExecutor(t, &persistence.ShardDistributorExecutor{
+		ExecutorID: "executor-1",
+		ReportedShards: &persistence.DataBlob{
+			Encoding: constants.EncodingTypeJSON,
+			Data:     []byte("{invalid json"),
+		},
+	}, namespace)
+
+	_, _, _, err := shardStore.GetState(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to unmarshal reported shards for executor executor-1")
+}
+
+func TestShardStoreMissingHeartbeat(t *testing.T) {
+	tc := setupTestStore(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-missing-heartbeat"
+	shardStore := tc.leaderShardStore(ctx, t, namespace)
+
+	tc.manager.upsertExecutor(t, &persistence.ShardDistributorExecutor{
+		ExecutorID: "executor-1",
+		State:      string(store.ExecutorStateActive),
+	}, namespace)
+
+	heartbeats, _, _, err := shardStore.GetState(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, heartbeats["executor-1"].LastHeartbeat)
+}
+
+func TestSubscribe_NotificationOnUpdate(t *testing.T) {
+	tc := setupTestStore(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-subscribe"
+	shardStore := tc.leaderShardStore(ctx, t, namespace)
+
+	revisionCh, err := shardStore.Subscribe(ctx)
+	require.NoError(t, err)
+
+	tc.manager.upsertExecutor(t, &persistence.ShardDistributorExecutor{
+		ExecutorID: "executor-1",
+		State:      string(store.ExecutorStateActive),
+	}, namespace)
+
+	select {
+	case rev := <-revisionCh:
+		assert.Equal(t, int64(1), rev)
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected a notification for the new executor")
+	}
+}
+
+func TestSubscribe_Debouncing(t *testing.T) {
+	tc := setupTestStore(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-debounce"
+	shardStore := tc.leaderShardStore(ctx, t, namespace)
+
+	revisionCh, err := shardStore.Subscribe(ctx)
+	require.NoError(t, err)
+
+	for _, state := range []store.ExecutorState{store.ExecutorStateActive, store.ExecutorStateDraining, store.ExecutorStateStopped} {
+		tc.manager.upsertExecutor(t, &persistence.ShardDistributorExecutor{
+			ExecutorID: "executor-1",
+			State:      string(state),
+		}, namespace)
+		// Let the subscription observe each change without consuming the notifications.
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	select {
+	case rev := <-revisionCh:
+		assert.Equal(t, int64(3), rev, "Only the latest revision should be kept")
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected a notification")
+	}
+}
+
+func TestSubscribe_FilteringLogic(t *testing.T) {
+	tc := setupTestStore(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-filtering"
+	shardStore := tc.leaderShardStore(ctx, t, namespace)
+
+	tc.manager.upsertExecutor(t, &persistence.ShardDistributorExecutor{
+		ExecutorID: "executor-1",
+		State:      string(store.ExecutorStateActive),
+	}, namespace)
+
+	revisionCh, err := shardStore.Subscribe(ctx)
+	require.NoError(t, err)
+
+	// Heartbeats and assignments are not significant changes.
+	tc.manager.upsertExecutor(t, &persistence.ShardDistributorExecutor{
+		ExecutorID:    "executor-1",
+		LastHeartbeat: time.Now(),
+		State:         string(store.ExecutorStateActive),
+	}, namespace)
+	err = shardStore.AssignShards(ctx, map[string]store.AssignedState{
+		"executor-1": {ExecutorID: "executor-1", AssignedShards: map[string]store.ShardAssignment{"shard-1": {ShardID: "shard-1"}}},
+	}, 0)
+	require.NoError(t, err)
+
+	select {
+	case rev := <-revisionCh:
+		t.Fatalf("Unexpected notification for revision %d", rev)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	// Reported shards are a significant change.
+	tc.manager.upsertExecutor(t, &persistence.ShardDistributorExecutor{
+		ExecutorID:     "executor-1",
+		State:          string(store.ExecutorStateActive),
+		ReportedShards: jsonBlob(t, map[string]store.ShardState{"shard-1": {Status: "running"}}),
+	}, namespace)
+
+	select {
+	case rev := <-revisionCh:
+		assert.Equal(t, int64(4), rev)
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected a notification for the reported shards")
+	}
+}
+
+func TestSubscribe_ContextCancellation(t *testing.T) {
+	tc := setupTestStore(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	shardStore := tc.leaderShardStore(ctx, t, "test-subscribe-cancel")
+
+	subCtx, subCancel := context.WithCancel(ctx)
+	revisionCh, err := shardStore.Subscribe(subCtx)
+	require.NoError(t, err)
+
+	subCancel()
+
+	select {
+	case _, ok := <-revisionCh:
+		assert.False(t, ok, "Channel should be closed after the context is cancelled")
+	case <-time.After(2 * time.Second):
+		t.Fatal("Channel should be closed after the context is cancelled")
+	}
+}
+
+func TestDeleteExecutors(t *testing.T) {
+	tc := setupTestStore(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-delete"
+	shardStore := tc.leaderShardStore(ctx, t, namespace)
+
+	for _, id := range []string{"executor-1", "executor-2", "executor-3"} {
+		tc.manager.upsertExecutor(t, &persistence.ShardDistributorExecutor{
+			ExecutorID: id,
+			State:      string(store.ExecutorStateActive),
+		}, namespace)
+	}
+
+	err := shardStore.DeleteExecutors(ctx, nil)
+	require.NoError(t, err)
+
+	err = shardStore.DeleteExecutors(ctx, []string{"executor-1", "executor-3"})
+	require.NoError(t, err)
+
+	heartbeats, assignments, _, err := shardStore.GetState(ctx)
+	require.NoError(t, err)
+	assert.Len(t, heartbeats, 1)
+	assert.Contains(t, heartbeats, "executor-2")
+	assert.Len(t, assignments, 1)
+}
+
+func TestAdminStoreControl(t *testing.T) {
+	tc := setupTestStore(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-admin"
+	adminStore, err := tc.store.AdminStore(ctx, namespace)
+	require.NoError(t, err)
+
+	control, err := adminStore.GetControl(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, store.NamespaceControl{}, control)
+
+	// An executor heartbeat increments the revision between the read and the write of the first attempt.
+	tc.manager.setBeforeUpsert(func() {
+		tc.manager.upsertExecutor(t, &persistence.ShardDistributorExecutor{
+			ExecutorID: "executor-1",
+			State:      string(store.ExecutorStateActive),
+		}, namespace)
+	})
+
+	control.RebalancingFrozen = true
+	control.DrainedExecutors = map[string]int64{"executor-1": 123}
+	require.NoError(t, adminStore.UpdateControl(ctx, control))
+
+	updated, err := adminStore.GetControl(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), updated.Version)
+	assert.True(t, updated.RebalancingFrozen)
+	assert.Equal(t, control.DrainedExecutors, updated.DrainedExecutors)
+
+	// The control record is not an executor.
+	heartbeatStates, assignedStates, _, err := adminStore.GetState(ctx)
+	require.NoError(t, err)
+	assert.Len(t, heartbeatStates, 1)
+	assert.Len(t, assignedStates, 1)
+	assert.Contains(t, heartbeatStates, "executor-1")
+
+	// The control read before the update is outdated.
+	err = adminStore.UpdateControl(ctx, control)
+	assert.ErrorIs(t, err, store.ErrControlVersionMismatch)
+}
+
+func TestShardStoreAssignShardsControlChanged(t *testing.T) {
+	tc := setupTestStore(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-assign-control"
+	shardStore := tc.leaderShardStore(ctx, t, namespace)
+
+	control, err := shardStore.GetControl(ctx)
+	require.NoError(t, err)
+
+	adminStore, err := tc.store.AdminStore(ctx, namespace)
+	require.NoError(t, err)
+	require.NoError(t, adminStore.UpdateControl(ctx, store.NamespaceControl{RebalancingFrozen: true}))
+
+	newState := map[string]store.AssignedState{
+		"executor-1": {ExecutorID: "executor-1", AssignedShards: map[string]store.ShardAssignment{"shard-1": {ShardID: "shard-1"}}},
+	}
+	err = shardStore.AssignShards(ctx, newState, control.Version)
+	assert.ErrorIs(t, err, errControlChanged)
+
+	control, err = shardStore.GetControl(ctx)
+	require.NoError(t, err)
+	assert.True(t, control.RebalancingFrozen)
+	require.NoError(t, shardStore.AssignShards(ctx, newState, control.Version))
+}
+
+// leaderShardStore campaigns for the namespace and returns the shard store of the leader.
+func (tc *testStore) leaderShardStore(ctx context.Context, t *testing.T, namespace string) store.ShardStore {
+	t.Helper()
+
+	election, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	t.Cleanup(func() { election.Cleanup(context.Background()) })
+
+	err = election.Campaign(ctx, "test-host")
+	require.NoError(t, err)
+
+	shardStore, err := election.ShardStore(ctx)
+	require.NoError(t, err)
+	return shardStore
+}
+
+func jsonBlob(t *testing.T, value interface{}) *persistence.DataBlob {
+	t.Helper()
+
+	data, err := json.Marshal(value)
+	require.NoError(t, err)
+	return &persistence.DataBlob{
+		Encoding: constants.EncodingTypeJSON,
+		Data:     data,
+	}
+}
@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	store.Register("etcd", fx.Provide(NewStore))
+	store.Register("etcd", store.ProvideElector(NewStore))
 }
 
 type Store struct {
@@ -40,7 +40,7 @@ type etcdCfg struct {
 
 // NewStore creates a new leaderstore backed by ETCD.
 func NewStore(p StoreParams) (store.Elector, error) {
-	if !p.Cfg.Enabled {
+	if !store.IsConfigured(p.Cfg, "etcd") {
 		return nil, nil
 	}
 
@@ -79,13 +79,26 @@ func (ls *Store) CreateElection(ctx context.Context, namespace string) (el store
 		return nil, fmt.Errorf("failed to create session: %w", err)
 	}
 
-	namespacePrefix := fmt.Sprintf("%s/%s", ls.electionConfig.Prefix, namespace)
+	namespacePrefix := ls.namespacePrefix(namespace)
 	electionKey := fmt.Sprintf("%s/leader", namespacePrefix)
 	etcdElection := concurrency.NewElection(session, electionKey)
 
 	return &election{election: etcdElection, session: session, prefix: namespacePrefix}, nil
 }
 
+func (ls *Store) AdminStore(ctx context.Context, namespace string) (store.AdminStore, error) {
+	return &adminStore{
+		namespaceStore: namespaceStore{
+			client: ls.client,
+			prefix: ls.namespacePrefix(namespace),
+		},
+	}, nil
+}
+
+func (ls *Store) namespacePrefix(namespace string) string {
+	return fmt.Sprintf("%s/%s", ls.electionConfig.Prefix, namespace)
+}
+
 // election is a wrapper around etcd.concurrency.Election to abstract implementation from etcd types.
 type election struct {
 	session  *concurrency.Session
@@ -115,9 +128,11 @@ func (e *election) Done() <-chan struct{} {
 
 func (e *election) ShardStore(ctx context.Context) (store.ShardStore, error) {
 	return &shardStore{
-		session:   e.session,
+		namespaceStore: namespaceStore{
+			client: e.session.Client(),
+			prefix: e.prefix,
+		},
 		leaderKey: e.election.Key(),
 		leaderRev: e.election.Rev(),
-		prefix:    e.prefix,
 	}, nil
 }
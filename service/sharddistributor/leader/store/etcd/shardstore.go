@@ -9,20 +9,24 @@ import (
 	"time"
 
 	clientv3 "go.etcd.io/etcd/client/v3"
-	"go.etcd.io/etcd/client/v3/concurrency"
 
 	"github.com/uber/cadence/service/sharddistributor/leader/store"
 )
 
+// namespaceStore reads the state and the controls of a namespace, it is shared by the leader and admin stores.
+type namespaceStore struct {
+	client *clientv3.Client
+	prefix string
+}
+
 type shardStore struct {
-	session   *concurrency.Session
-	prefix    string
+	namespaceStore
 	leaderKey string
 	leaderRev int64
 }
 
-func (s *shardStore) GetState(ctx context.Context) (map[string]store.HeartbeatState, map[string]store.AssignedState, int64, error) {
-	client := s.session.Client()
+func (s *namespaceStore) GetState(ctx context.Context) (map[string]store.HeartbeatState, map[string]store.AssignedState, int64, error) {
+	client := s.client
 
 	heartbeatStates := make(map[string]store.HeartbeatState)
 	assignedStates := make(map[string]store.AssignedState)
@@ -84,8 +88,26 @@ func (s *shardStore) GetState(ctx context.Context) (map[string]store.HeartbeatSt
 	return heartbeatStates, assignedStates, resp.Header.Revision, nil
 }
 
-func (s *shardStore) AssignShards(ctx context.Context, newState map[string]store.AssignedState) error {
-	client := s.session.Client()
+// GetControl returns the controls of the namespace, their version is the revision they were last modified at.
+func (s *namespaceStore) GetControl(ctx context.Context) (store.NamespaceControl, error) {
+	resp, err := s.client.Get(ctx, s.buildControlKey())
+	if err != nil {
+		return store.NamespaceControl{}, fmt.Errorf("failed to get namespace control from etcd: %w", err)
+	}
+
+	var control store.NamespaceControl
+	if len(resp.Kvs) == 0 {
+		return control, nil
+	}
+	if err := json.Unmarshal(resp.Kvs[0].Value, &control); err != nil {
+		return store.NamespaceControl{}, fmt.Errorf("failed to unmarshal namespace control: %w", err)
+	}
+	control.Version = resp.Kvs[0].ModRevision
+	return control, nil
+}
+
+func (s *shardStore) AssignShards(ctx context.Context, newState map[string]store.AssignedState, controlVersion int64) error {
+	client := s.client
 
 	// Build the operations for shard assignments
 	var ops []clientv3.Op
@@ -107,9 +129,13 @@ func (s *shardStore) AssignShards(ctx context.Context, newState map[string]store
 
 	// Execute with leader key revision check to ensure we're still the leader
 	if len(ops) > 0 {
-		// Create transaction with condition that leader key revision hasn't changed
+		// Create transaction with condition that leader key revision and namespace control haven't changed.
+		// The control key doesn't exist until the first update, its mod revision is 0 then.
 		txn := client.Txn(ctx).
-			If(clientv3.Compare(clientv3.ModRevision(s.leaderKey), "=", s.leaderRev)).
+			If(
+				clientv3.Compare(clientv3.ModRevision(s.leaderKey), "=", s.leaderRev),
+				clientv3.Compare(clientv3.ModRevision(s.buildControlKey()), "=", controlVersion),
+			).
 			Then(ops...)
 
 		txnResp, err := txn.Commit()
@@ -118,7 +144,7 @@ func (s *shardStore) AssignShards(ctx context.Context, newState map[string]store
 		}
 
 		if !txnResp.Succeeded {
-			return fmt.Errorf("transaction failed: leadership may have changed (leader key revision mismatch)")
+			return fmt.Errorf("transaction failed: leadership may have changed or namespace control was updated (revision mismatch)")
 		}
 	}
 
@@ -128,7 +154,7 @@ func (s *shardStore) AssignShards(ctx context.Context, newState map[string]store
 // Subscribe creates a watch on the executor prefix and returns a channel that
 // receives the revision number of any change.
 func (s *shardStore) Subscribe(ctx context.Context) (<-chan int64, error) {
-	client := s.session.Client()
+	client := s.client
 	// Use a buffered channel of size 1.
 	revisionChan := make(chan int64, 1)
 
@@ -190,7 +216,7 @@ func (s *shardStore) DeleteExecutors(ctx context.Context, executorIDs []string)
 	if len(executorIDs) == 0 {
 		return nil
 	}
-	client := s.session.Client()
+	client := s.client
 
 	ops := make([]clientv3.Op, 0, len(executorIDs))
 	for _, executorID := range executorIDs {
@@ -219,7 +245,7 @@ func (s *shardStore) DeleteExecutors(ctx context.Context, executorIDs []string)
 }
 
 // buildExecutorPrefix returns the etcd prefix for all executors in this namespace
-func (s *shardStore) buildExecutorPrefix() string {
+func (s *namespaceStore) buildExecutorPrefix() string {
 	return fmt.Sprintf("%s/executors/", s.prefix)
 }
 
@@ -228,7 +254,7 @@ func (s *shardStore) buildExecutorPrefix() string {
 //
 //	/prefix/namespaces/my-ns/executors/executor-1/heartbeat -> ("executor-1", "heartbeat")
 //	/prefix/namespaces/my-ns/executors/executor-2/state -> ("executor-2", "state")
-func (s *shardStore) parseExecutorKey(key string) (executorID, keyType string, err error) {
+func (s *namespaceStore) parseExecutorKey(key string) (executorID, keyType string, err error) {
 	prefix := s.buildExecutorPrefix()
 	if !strings.HasPrefix(key, prefix) {
 		return "", "", fmt.Errorf("unexpected key: %s", key)
@@ -247,6 +273,38 @@ func (s *shardStore) parseExecutorKey(key string) (executorID, keyType string, e
 }
 
 // buildExecutorKey constructs an etcd key for an executor
-func (s *shardStore) buildExecutorKey(executorID, keyType string) string {
+func (s *namespaceStore) buildExecutorKey(executorID, keyType string) string {
 	return fmt.Sprintf("%s%s/%s", s.buildExecutorPrefix(), executorID, keyType)
 }
+
+// buildControlKey returns the etcd key of the namespace control.
+// It is outside of the executor prefix, the leader picks up control updates on its periodic rebalance.
+func (s *namespaceStore) buildControlKey() string {
+	return fmt.Sprintf("%s/control", s.prefix)
+}
+
+// adminStore gives operators access to the namespace without leadership.
+type adminStore struct {
+	namespaceStore
+}
+
+// UpdateControl writes the controls if their key was not modified since control.Version.
+func (s *adminStore) UpdateControl(ctx context.Context, control store.NamespaceControl) error {
+	value, err := json.Marshal(control)
+	if err != nil {
+		return fmt.Errorf("failed to marshal namespace control: %w", err)
+	}
+
+	key := s.buildControlKey()
+	txnResp, err := s.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(key), "=", control.Version)).
+		Then(clientv3.OpPut(key, string(value))).
+		Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit namespace control: %w", err)
+	}
+	if !txnResp.Succeeded {
+		return store.ErrControlVersionMismatch
+	}
+	return nil
+}
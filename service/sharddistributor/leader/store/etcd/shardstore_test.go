@@ -113,7 +113,7 @@ func TestShardStoreRoundTrip(t *testing.T) {
 		},
 	}
 
-	err = storage.AssignShards(ctx, newState)
+	err = storage.AssignShards(ctx, newState, 0)
 	require.NoError(t, err)
 
 	// Read back and verify the assignment was written
@@ -225,7 +225,7 @@ func TestShardStoreAssignShards(t *testing.T) {
 		},
 	}
 
-	err = storage.AssignShards(ctx, newState)
+	err = storage.AssignShards(ctx, newState, 0)
 	require.NoError(t, err)
 
 	// Verify assignments were written
@@ -274,7 +274,7 @@ func TestShardStoreLeadershipChange(t *testing.T) {
 		},
 	}
 
-	err = shardStore1.AssignShards(ctx, newState)
+	err = shardStore1.AssignShards(ctx, newState, 0)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "leadership may have changed")
 }
@@ -673,3 +673,72 @@ func TestDeleteExecutors(t *testing.T) {
 	assert.NotContains(t, heartbeats, executorsToDelete[1], "GetState should not return the second deleted executor")
 	assert.Contains(t, heartbeats, executorToKeep, "GetState should still return the executor that was kept")
 }
+
+// TestAdminStoreControl tests reading and updating the namespace control without leadership.
+func TestAdminStoreControl(t *testing.T) {
+	tc := setupETCDCluster(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	adminStore, err := tc.store.AdminStore(ctx, "test-admin")
+	require.NoError(t, err)
+
+	control, err := adminStore.GetControl(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, store.NamespaceControl{}, control)
+
+	control.RebalancingFrozen = true
+	control.DrainedExecutors = map[string]int64{"executor-1": 123}
+	control.ShardMoves = map[string]store.ShardMove{"1": {ExecutorID: "executor-2", RequestedAt: 456}}
+	require.NoError(t, adminStore.UpdateControl(ctx, control))
+
+	updated, err := adminStore.GetControl(ctx)
+	require.NoError(t, err)
+	assert.Greater(t, updated.Version, int64(0))
+	assert.True(t, updated.RebalancingFrozen)
+	assert.Equal(t, control.DrainedExecutors, updated.DrainedExecutors)
+	assert.Equal(t, control.ShardMoves, updated.ShardMoves)
+
+	// The control read before the update is outdated.
+	err = adminStore.UpdateControl(ctx, control)
+	assert.ErrorIs(t, err, store.ErrControlVersionMismatch)
+}
+
+// TestShardStoreAssignShardsControlChanged tests that assignments based on outdated controls are rejected.
+func TestShardStoreAssignShardsControlChanged(t *testing.T) {
+	tc := setupETCDCluster(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	namespace := "test-assign-control"
+	election, err := tc.store.CreateElection(ctx, namespace)
+	require.NoError(t, err)
+	defer election.Cleanup(ctx)
+
+	err = election.Campaign(ctx, "test-host")
+	require.NoError(t, err)
+
+	storage, err := election.ShardStore(ctx)
+	require.NoError(t, err)
+
+	control, err := storage.GetControl(ctx)
+	require.NoError(t, err)
+
+	adminStore, err := tc.store.AdminStore(ctx, namespace)
+	require.NoError(t, err)
+	require.NoError(t, adminStore.UpdateControl(ctx, store.NamespaceControl{RebalancingFrozen: true}))
+
+	newState := map[string]store.AssignedState{
+		"executor-1": {
+			ExecutorID:     "executor-1",
+			AssignedShards: map[string]store.ShardAssignment{"shard-1": {ShardID: "shard-1"}},
+		},
+	}
+	err = storage.AssignShards(ctx, newState, control.Version)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "namespace control was updated")
+
+	control, err = storage.GetControl(ctx)
+	require.NoError(t, err)
+	require.NoError(t, storage.AssignShards(ctx, newState, control.Version))
+}
@@ -18,6 +18,7 @@ type ShardState struct {
 	Status      string            `json:"status"` // e.g., "running", "stopped", "error"
 	LastUpdated int64             `json:"last_updated"`
 	Metadata    map[string]string `json:"metadata,omitempty"`
+	ShardLoad   float64           `json:"shard_load,omitempty"` // e.g., QPS or processing cost of the shard
 }
 
 type ShardAssignment struct {
@@ -33,3 +34,49 @@ type AssignedState struct {
 	AssignedShards map[string]ShardAssignment `json:"assigned_shards"` // What we assigned
 	LastUpdated    int64                      `json:"last_updated"`
 }
+
+// NamespaceControl holds the changes operators made to the shard distribution of a namespace.
+// It is written by the admin APIs and applied by the leader on every rebalance.
+type NamespaceControl struct {
+	// Version is incremented on every update, it is used to detect concurrent updates.
+	Version int64 `json:"-"`
+	// RebalancingFrozen stops the leader from changing any shard assignment.
+	RebalancingFrozen bool `json:"rebalancing_frozen,omitempty"`
+	// DrainedExecutors are not assigned any shards, keyed by executor ID with the time they were drained (Unix timestamp).
+	DrainedExecutors map[string]int64 `json:"drained_executors,omitempty"`
+	// ShardMoves are keyed by shard ID.
+	ShardMoves map[string]ShardMove `json:"shard_moves,omitempty"`
+	// AuditLog has the latest updates, oldest first.
+	AuditLog []AuditEntry `json:"audit_log,omitempty"`
+}
+
+// ShardMove is a request to move a shard to an executor.
+// It is pending until the shard is assigned again after RequestedAt, by this move or for any other reason.
+type ShardMove struct {
+	ExecutorID  string `json:"executor_id"`
+	RequestedAt int64  `json:"requested_at"` // Unix timestamp
+}
+
+// IsPending returns true if the shard move was not applied to the shard assignment yet.
+func (m ShardMove) IsPending(assignment ShardAssignment) bool {
+	return assignment.AssignedAt < m.RequestedAt
+}
+
+// AuditEntry records who changed the namespace control and why.
+type AuditEntry struct {
+	Time      int64  `json:"time"` // Unix timestamp
+	Identity  string `json:"identity"`
+	Reason    string `json:"reason"`
+	Operation string `json:"operation"`
+}
+
+// MaxAuditEntries is the number of audit entries kept in the namespace control.
+const MaxAuditEntries = 50
+
+// AddAuditEntry appends entry to the audit log, dropping the oldest entries above MaxAuditEntries.
+func (c *NamespaceControl) AddAuditEntry(entry AuditEntry) {
+	c.AuditLog = append(c.AuditLog, entry)
+	if len(c.AuditLog) > MaxAuditEntries {
+		c.AuditLog = c.AuditLog[len(c.AuditLog)-MaxAuditEntries:]
+	}
+}
@@ -2,17 +2,26 @@ package store
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 
 	"go.uber.org/fx"
+
+	"github.com/uber/cadence/service/sharddistributor/config"
 )
 
-//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination=store_mock.go Elector,Election,ShardStore
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination=store_mock.go Elector,Election,ShardStore,AdminStore
+
+// ErrControlVersionMismatch is returned if the namespace control was updated since it was read.
+var ErrControlVersionMismatch = errors.New("namespace control was changed concurrently")
 
 // Elector is an interface that provides a way to establish a session for election.
 // It establishes connection and a session and provides Election to run for leader.
 type Elector interface {
 	CreateElection(ctx context.Context, namespace string) (Election, error)
+	// AdminStore gives access to the namespace for operators, it does not require leadership.
+	AdminStore(ctx context.Context, namespace string) (AdminStore, error)
 }
 
 // Election is an interface that establishes leader campaign.
@@ -33,8 +42,12 @@ type Election interface {
 type ShardStore interface {
 	// GetState returns the state of the namespace and a global revision for filtering events coming from the subscribe call.
 	GetState(ctx context.Context) (map[string]HeartbeatState, map[string]AssignedState, int64, error)
+	// GetControl returns the operator controls of the namespace.
+	GetControl(ctx context.Context) (NamespaceControl, error)
 	// AssignShards pushes new shard assignments to the storage. The state will be consumed by executors during the heartbeats.
-	AssignShards(ctx context.Context, newState map[string]AssignedState) error
+	// The assignments are only written if the namespace control is still at controlVersion,
+	// so shards are not assigned based on controls an operator already changed.
+	AssignShards(ctx context.Context, newState map[string]AssignedState, controlVersion int64) error
 	// Subscribe returns a channel that signals when a state change occurs.
 	// The channel sends a latest revision for notification.
 	Subscribe(ctx context.Context) (<-chan int64, error)
@@ -42,10 +55,26 @@ type ShardStore interface {
 	DeleteExecutors(ctx context.Context, executorID []string) error
 }
 
+// AdminStore is used by the admin APIs to inspect a namespace and update its controls.
+type AdminStore interface {
+	// GetState returns the state of the namespace, see ShardStore.GetState.
+	GetState(ctx context.Context) (map[string]HeartbeatState, map[string]AssignedState, int64, error)
+	// GetControl returns the operator controls of the namespace.
+	GetControl(ctx context.Context) (NamespaceControl, error)
+	// UpdateControl writes the controls, which changes their version.
+	// It returns ErrControlVersionMismatch if the stored version is not control.Version.
+	UpdateControl(ctx context.Context, control NamespaceControl) error
+}
+
 // Impl could be used to build an implementation in the registry.
 // We use registry based approach to avoid introduction of global etcd dependency.
+// An implementation must provide its Elector into the "leaderStores" group (see ProvideElector)
+// and return a nil Elector if it is not the configured leader store type.
 type Impl fx.Option
 
+// DefaultType is the leader store type used if the configuration does not set one.
+const DefaultType = "etcd"
+
 var (
 	storeRegistry = make(map[string]Impl)
 )
@@ -55,12 +84,60 @@ func Register(name string, factory Impl) {
 	storeRegistry[name] = factory
 }
 
-// Module returns registered a leader store fx.Option from the configuration.
+// ProvideElector wraps a leader store constructor so its Elector is provided into the "leaderStores" group.
+func ProvideElector(constructor interface{}) fx.Option {
+	return fx.Provide(fx.Annotate(constructor, fx.ResultTags(`group:"leaderStores"`)))
+}
+
+// IsConfigured returns true if the leader election is enabled and the configured leader store type is name.
+func IsConfigured(cfg config.LeaderElection, name string) bool {
+	if !cfg.Enabled {
+		return false
+	}
+	storeType := cfg.Store.Type
+	if storeType == "" {
+		storeType = DefaultType
+	}
+	return storeType == name
+}
+
+// Module returns all registered leader stores and provides the Elector of the store selected by the configuration.
 // This can introduce extra dependency requirements to the fx application.
-func Module(name string) fx.Option {
-	factory, ok := storeRegistry[name]
-	if !ok {
-		panic(fmt.Sprintf("no leader store registered with name %s", name))
+func Module() fx.Option {
+	names := make([]string, 0, len(storeRegistry))
+	for name := range storeRegistry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	options := make([]fx.Option, 0, len(names)+1)
+	for _, name := range names {
+		options = append(options, storeRegistry[name])
+	}
+	options = append(options, fx.Provide(selectElector))
+	return fx.Options(options...)
+}
+
+type selectParams struct {
+	fx.In
+
+	Cfg      config.LeaderElection
+	Electors []Elector `group:"leaderStores"`
+}
+
+func selectElector(p selectParams) (Elector, error) {
+	var selected Elector
+	for _, elector := range p.Electors {
+		if elector == nil {
+			continue
+		}
+		if selected != nil {
+			return nil, fmt.Errorf("more than one leader store is configured with type %q", p.Cfg.Store.Type)
+		}
+		selected = elector
+	}
+	if p.Cfg.Enabled && selected == nil {
+		return nil, fmt.Errorf("no leader store registered with type %q", p.Cfg.Store.Type)
 	}
-	return factory
+	return selected, nil
 }
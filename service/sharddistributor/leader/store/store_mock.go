@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -package store -source store.go -destination=store_mock.go Elector,Election,ShardStore
+//	mockgen -package store -source store.go -destination=store_mock.go Elector,Election,ShardStore,AdminStore
 //
 
 // Package store is a generated GoMock package.
@@ -40,6 +40,21 @@ func (m *MockElector) EXPECT() *MockElectorMockRecorder {
 	return m.recorder
 }
 
+// AdminStore mocks base method.
+func (m *MockElector) AdminStore(ctx context.Context, namespace string) (AdminStore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdminStore", ctx, namespace)
+	ret0, _ := ret[0].(AdminStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdminStore indicates an expected call of AdminStore.
+func (mr *MockElectorMockRecorder) AdminStore(ctx, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdminStore", reflect.TypeOf((*MockElector)(nil).AdminStore), ctx, namespace)
+}
+
 // CreateElection mocks base method.
 func (m *MockElector) CreateElection(ctx context.Context, namespace string) (Election, error) {
 	m.ctrl.T.Helper()
@@ -175,17 +190,17 @@ func (m *MockShardStore) EXPECT() *MockShardStoreMockRecorder {
 }
 
 // AssignShards mocks base method.
-func (m *MockShardStore) AssignShards(ctx context.Context, newState map[string]AssignedState) error {
+func (m *MockShardStore) AssignShards(ctx context.Context, newState map[string]AssignedState, controlVersion int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AssignShards", ctx, newState)
+	ret := m.ctrl.Call(m, "AssignShards", ctx, newState, controlVersion)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // AssignShards indicates an expected call of AssignShards.
-func (mr *MockShardStoreMockRecorder) AssignShards(ctx, newState any) *gomock.Call {
+func (mr *MockShardStoreMockRecorder) AssignShards(ctx, newState, controlVersion any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignShards", reflect.TypeOf((*MockShardStore)(nil).AssignShards), ctx, newState)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignShards", reflect.TypeOf((*MockShardStore)(nil).AssignShards), ctx, newState, controlVersion)
 }
 
 // DeleteExecutors mocks base method.
@@ -202,6 +217,21 @@ func (mr *MockShardStoreMockRecorder) DeleteExecutors(ctx, executorID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExecutors", reflect.TypeOf((*MockShardStore)(nil).DeleteExecutors), ctx, executorID)
 }
 
+// GetControl mocks base method.
+func (m *MockShardStore) GetControl(ctx context.Context) (NamespaceControl, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetControl", ctx)
+	ret0, _ := ret[0].(NamespaceControl)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetControl indicates an expected call of GetControl.
+func (mr *MockShardStoreMockRecorder) GetControl(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetControl", reflect.TypeOf((*MockShardStore)(nil).GetControl), ctx)
+}
+
 // GetState mocks base method.
 func (m *MockShardStore) GetState(ctx context.Context) (map[string]HeartbeatState, map[string]AssignedState, int64, error) {
 	m.ctrl.T.Helper()
@@ -233,3 +263,73 @@ func (mr *MockShardStoreMockRecorder) Subscribe(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockShardStore)(nil).Subscribe), ctx)
 }
+
+// MockAdminStore is a mock of AdminStore interface.
+type MockAdminStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminStoreMockRecorder is the mock recorder for MockAdminStore.
+type MockAdminStoreMockRecorder struct {
+	mock *MockAdminStore
+}
+
+// NewMockAdminStore creates a new mock instance.
+func NewMockAdminStore(ctrl *gomock.Controller) *MockAdminStore {
+	mock := &MockAdminStore{ctrl: ctrl}
+	mock.recorder = &MockAdminStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminStore) EXPECT() *MockAdminStoreMockRecorder {
+	return m.recorder
+}
+
+// GetControl mocks base method.
+func (m *MockAdminStore) GetControl(ctx context.Context) (NamespaceControl, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetControl", ctx)
+	ret0, _ := ret[0].(NamespaceControl)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetControl indicates an expected call of GetControl.
+func (mr *MockAdminStoreMockRecorder) GetControl(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetControl", reflect.TypeOf((*MockAdminStore)(nil).GetControl), ctx)
+}
+
+// GetState mocks base method.
+func (m *MockAdminStore) GetState(ctx context.Context) (map[string]HeartbeatState, map[string]AssignedState, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetState", ctx)
+	ret0, _ := ret[0].(map[string]HeartbeatState)
+	ret1, _ := ret[1].(map[string]AssignedState)
+	ret2, _ := ret[2].(int64)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetState indicates an expected call of GetState.
+func (mr *MockAdminStoreMockRecorder) GetState(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetState", reflect.TypeOf((*MockAdminStore)(nil).GetState), ctx)
+}
+
+// UpdateControl mocks base method.
+func (m *MockAdminStore) UpdateControl(ctx context.Context, control NamespaceControl) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateControl", ctx, control)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateControl indicates an expected call of UpdateControl.
+func (mr *MockAdminStoreMockRecorder) UpdateControl(ctx, control any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateControl", reflect.TypeOf((*MockAdminStore)(nil).UpdateControl), ctx, control)
+}
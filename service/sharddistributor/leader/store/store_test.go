@@ -0,0 +1,85 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/service/sharddistributor/config"
+)
+
+func TestIsConfigured(t *testing.T) {
+	tests := map[string]struct {
+		cfg      config.LeaderElection
+		name     string
+		expected bool
+	}{
+		"not enabled": {
+			cfg:  config.LeaderElection{Enabled: false, Store: config.LeaderStore{Type: "database"}},
+			name: "database",
+		},
+		"default type": {
+			cfg:      config.LeaderElection{Enabled: true},
+			name:     DefaultType,
+			expected: true,
+		},
+		"configured type": {
+			cfg:      config.LeaderElection{Enabled: true, Store: config.LeaderStore{Type: "database"}},
+			name:     "database",
+			expected: true,
+		},
+		"other type": {
+			cfg:  config.LeaderElection{Enabled: true, Store: config.LeaderStore{Type: "database"}},
+			name: DefaultType,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, IsConfigured(tc.cfg, tc.name))
+		})
+	}
+}
+
+func TestSelectElector(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	elector := NewMockElector(ctrl)
+	enabled := config.LeaderElection{Enabled: true, Store: config.LeaderStore{Type: "database"}}
+
+	selected, err := selectElector(selectParams{Cfg: enabled, Electors: []Elector{nil, elector}})
+	require.NoError(t, err)
+	assert.Equal(t, elector, selected)
+
+	_, err = selectElector(selectParams{Cfg: enabled, Electors: []Elector{elector, NewMockElector(ctrl)}})
+	assert.ErrorContains(t, err, "more than one leader store")
+
+	_, err = selectElector(selectParams{Cfg: enabled, Electors: []Elector{nil}})
+	assert.ErrorContains(t, err, `no leader store registered with type "database"`)
+
+	selected, err = selectElector(selectParams{Cfg: config.LeaderElection{Enabled: false}, Electors: []Elector{nil, nil}})
+	require.NoError(t, err)
+	assert.Nil(t, selected)
+}
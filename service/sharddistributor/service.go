@@ -26,6 +26,7 @@ import (
 	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
 	"github.com/uber/cadence/common/log"
@@ -93,7 +94,8 @@ func NewService(
 	matchingRing := params.HashRings[service.Matching]
 	historyRing := params.HashRings[service.History]
 
-	rawHandler := handler.NewHandler(logger, params.MetricsClient, matchingRing, historyRing)
+	// The leader election is only wired with fx, the admin APIs of the legacy service report it as disabled.
+	rawHandler := handler.NewHandler(logger, params.MetricsClient, matchingRing, historyRing, config.LeaderElection{}, nil, clock.NewRealTimeSource())
 	meteredHandler := metered.NewMetricsHandler(rawHandler, logger, params.MetricsClient)
 
 	dispatcher := params.RPCFactory.GetDispatcher()
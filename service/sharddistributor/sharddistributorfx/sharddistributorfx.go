@@ -25,16 +25,19 @@ package sharddistributorfx
 import (
 	"go.uber.org/fx"
 
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/rpc"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/service/sharddistributor/config"
 	"github.com/uber/cadence/service/sharddistributor/handler"
 	"github.com/uber/cadence/service/sharddistributor/leader/election"
 	"github.com/uber/cadence/service/sharddistributor/leader/namespace"
 	"github.com/uber/cadence/service/sharddistributor/leader/process"
+	"github.com/uber/cadence/service/sharddistributor/leader/store"
 	"github.com/uber/cadence/service/sharddistributor/wrappers/grpc"
 	"github.com/uber/cadence/service/sharddistributor/wrappers/metered"
 )
@@ -55,6 +58,10 @@ type registerHandlersParams struct {
 
 	MembershipRings map[string]membership.SingleProvider
 
+	LeaderElection config.LeaderElection
+	Elector        store.Elector `optional:"true"`
+	TimeSource     clock.TimeSource
+
 	Lifecycle fx.Lifecycle
 }
 
@@ -64,7 +71,7 @@ func registerHandlers(params registerHandlersParams) error {
 	matchingRing := params.MembershipRings[service.Matching]
 	historyRing := params.MembershipRings[service.History]
 
-	rawHandler := handler.NewHandler(params.Logger, params.MetricsClient, matchingRing, historyRing)
+	rawHandler := handler.NewHandler(params.Logger, params.MetricsClient, matchingRing, historyRing, params.LeaderElection, params.Elector, params.TimeSource)
 	wrappedHandler := metered.NewMetricsHandler(rawHandler, params.Logger, params.MetricsClient)
 
 	grpcHandler := grpc.NewGRPCHandler(wrappedHandler)
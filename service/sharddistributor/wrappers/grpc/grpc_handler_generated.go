@@ -20,7 +20,37 @@ func NewGRPCHandler(h handler.Handler) GRPCHandler {
 	return GRPCHandler{h}
 }
 
+func (g GRPCHandler) DescribeNamespace(ctx context.Context, request *sharddistributorv1.DescribeNamespaceRequest) (*sharddistributorv1.DescribeNamespaceResponse, error) {
+	response, err := g.h.DescribeNamespace(ctx, proto.ToShardDistributorDescribeNamespaceRequest(request))
+	return proto.FromShardDistributorDescribeNamespaceResponse(response), proto.FromError(err)
+}
+
 func (g GRPCHandler) GetShardOwner(ctx context.Context, request *sharddistributorv1.GetShardOwnerRequest) (*sharddistributorv1.GetShardOwnerResponse, error) {
 	response, err := g.h.GetShardOwner(ctx, proto.ToShardDistributorGetShardOwnerRequest(request))
 	return proto.FromShardDistributorGetShardOwnerResponse(response), proto.FromError(err)
 }
+
+func (g GRPCHandler) ListNamespaces(ctx context.Context, request *sharddistributorv1.ListNamespacesRequest) (*sharddistributorv1.ListNamespacesResponse, error) {
+	response, err := g.h.ListNamespaces(ctx, proto.ToShardDistributorListNamespacesRequest(request))
+	return proto.FromShardDistributorListNamespacesResponse(response), proto.FromError(err)
+}
+
+func (g GRPCHandler) MoveShard(ctx context.Context, request *sharddistributorv1.MoveShardRequest) (*sharddistributorv1.MoveShardResponse, error) {
+	response, err := g.h.MoveShard(ctx, proto.ToShardDistributorMoveShardRequest(request))
+	return proto.FromShardDistributorMoveShardResponse(response), proto.FromError(err)
+}
+
+func (g GRPCHandler) SetExecutorDrained(ctx context.Context, request *sharddistributorv1.SetExecutorDrainedRequest) (*sharddistributorv1.SetExecutorDrainedResponse, error) {
+	response, err := g.h.SetExecutorDrained(ctx, proto.ToShardDistributorSetExecutorDrainedRequest(request))
+	return proto.FromShardDistributorSetExecutorDrainedResponse(response), proto.FromError(err)
+}
+
+func (g GRPCHandler) SetRebalancingFrozen(ctx context.Context, request *sharddistributorv1.SetRebalancingFrozenRequest) (*sharddistributorv1.SetRebalancingFrozenResponse, error) {
+	response, err := g.h.SetRebalancingFrozen(ctx, proto.ToShardDistributorSetRebalancingFrozenRequest(request))
+	return proto.FromShardDistributorSetRebalancingFrozenResponse(response), proto.FromError(err)
+}
+
+func (g GRPCHandler) WatchNamespaceState(ctx context.Context, request *sharddistributorv1.WatchNamespaceStateRequest) (*sharddistributorv1.WatchNamespaceStateResponse, error) {
+	response, err := g.h.WatchNamespaceState(ctx, proto.ToShardDistributorWatchNamespaceStateRequest(request))
+	return proto.FromShardDistributorWatchNamespaceStateResponse(response), proto.FromError(err)
+}
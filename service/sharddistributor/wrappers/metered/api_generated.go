@@ -28,6 +28,25 @@ func NewMetricsHandler(handler handler.Handler, logger log.Logger, metricsClient
 	}
 }
 
+func (h *metricsHandler) DescribeNamespace(ctx context.Context, dp1 *types.DescribeNamespaceRequest) (dp2 *types.DescribeNamespaceResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+
+	scope := h.metricsClient.Scope(metrics.ShardDistributorDescribeNamespaceScope)
+	scope = scope.Tagged(metrics.NamespaceTag(dp1.GetNamespace()))
+	scope.IncCounter(metrics.ShardDistributorRequests)
+	sw := scope.StartTimer(metrics.ShardDistributorLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tag.ShardNamespace(dp1.GetNamespace()))
+
+	dp2, err = h.handler.DescribeNamespace(ctx, dp1)
+
+	if err != nil {
+		h.handleErr(err, scope, logger)
+	}
+
+	return dp2, err
+}
+
 func (h *metricsHandler) GetShardOwner(ctx context.Context, gp1 *types.GetShardOwnerRequest) (gp2 *types.GetShardOwnerResponse, err error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
 
@@ -51,6 +70,81 @@ func (h *metricsHandler) Health(ctx context.Context) (hp1 *types.HealthStatus, e
 	return h.handler.Health(ctx)
 }
 
+func (h *metricsHandler) ListNamespaces(ctx context.Context, lp1 *types.ListNamespacesRequest) (lp2 *types.ListNamespacesResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+
+	scope := h.metricsClient.Scope(metrics.ShardDistributorListNamespacesScope)
+	scope.IncCounter(metrics.ShardDistributorRequests)
+	sw := scope.StartTimer(metrics.ShardDistributorLatency)
+	defer sw.Stop()
+	logger := h.logger
+
+	lp2, err = h.handler.ListNamespaces(ctx, lp1)
+
+	if err != nil {
+		h.handleErr(err, scope, logger)
+	}
+
+	return lp2, err
+}
+
+func (h *metricsHandler) MoveShard(ctx context.Context, mp1 *types.MoveShardRequest) (mp2 *types.MoveShardResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+
+	scope := h.metricsClient.Scope(metrics.ShardDistributorMoveShardScope)
+	scope = scope.Tagged(metrics.NamespaceTag(mp1.GetNamespace()))
+	scope.IncCounter(metrics.ShardDistributorRequests)
+	sw := scope.StartTimer(metrics.ShardDistributorLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tag.ShardNamespace(mp1.GetNamespace()))
+
+	mp2, err = h.handler.MoveShard(ctx, mp1)
+
+	if err != nil {
+		h.handleErr(err, scope, logger)
+	}
+
+	return mp2, err
+}
+
+func (h *metricsHandler) SetExecutorDrained(ctx context.Context, sp1 *types.SetExecutorDrainedRequest) (sp2 *types.SetExecutorDrainedResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+
+	scope := h.metricsClient.Scope(metrics.ShardDistributorSetExecutorDrainedScope)
+	scope = scope.Tagged(metrics.NamespaceTag(sp1.GetNamespace()))
+	scope.IncCounter(metrics.ShardDistributorRequests)
+	sw := scope.StartTimer(metrics.ShardDistributorLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tag.ShardNamespace(sp1.GetNamespace()))
+
+	sp2, err = h.handler.SetExecutorDrained(ctx, sp1)
+
+	if err != nil {
+		h.handleErr(err, scope, logger)
+	}
+
+	return sp2, err
+}
+
+func (h *metricsHandler) SetRebalancingFrozen(ctx context.Context, sp1 *types.SetRebalancingFrozenRequest) (sp2 *types.SetRebalancingFrozenResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+
+	scope := h.metricsClient.Scope(metrics.ShardDistributorSetRebalancingFrozenScope)
+	scope = scope.Tagged(metrics.NamespaceTag(sp1.GetNamespace()))
+	scope.IncCounter(metrics.ShardDistributorRequests)
+	sw := scope.StartTimer(metrics.ShardDistributorLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tag.ShardNamespace(sp1.GetNamespace()))
+
+	sp2, err = h.handler.SetRebalancingFrozen(ctx, sp1)
+
+	if err != nil {
+		h.handleErr(err, scope, logger)
+	}
+
+	return sp2, err
+}
+
 func (h *metricsHandler) Start() {
 	h.handler.Start()
 	return
@@ -60,3 +154,22 @@ func (h *metricsHandler) Stop() {
 	h.handler.Stop()
 	return
 }
+
+func (h *metricsHandler) WatchNamespaceState(ctx context.Context, wp1 *types.WatchNamespaceStateRequest) (wp2 *types.WatchNamespaceStateResponse, err error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &err) }()
+
+	scope := h.metricsClient.Scope(metrics.ShardDistributorWatchNamespaceStateScope)
+	scope = scope.Tagged(metrics.NamespaceTag(wp1.GetNamespace()))
+	scope.IncCounter(metrics.ShardDistributorRequests)
+	sw := scope.StartTimer(metrics.ShardDistributorLatency)
+	defer sw.Stop()
+	logger := h.logger.WithTags(tag.ShardNamespace(wp1.GetNamespace()))
+
+	wp2, err = h.handler.WatchNamespaceState(ctx, wp1)
+
+	if err != nil {
+		h.handleErr(err, scope, logger)
+	}
+
+	return wp2, err
+}
@@ -111,6 +111,67 @@ func TestMetricsHandler_GetShardOwner(t *testing.T) {
 	}
 }
 
+func TestMetricsHandler_WatchNamespaceState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	request := &types.WatchNamespaceStateRequest{
+		Namespace: "test-namespace",
+		Revision:  1,
+	}
+	response := &types.WatchNamespaceStateResponse{
+		Namespace: "test-namespace",
+		Revision:  2,
+		Owners:    []string{"test-owner"},
+	}
+
+	testScope := tally.NewTestScope("test", nil)
+	metricsClient := metrics.NewClient(testScope, metrics.ShardDistributor)
+	mockHandler := handler.NewMockHandler(ctrl)
+	mockHandler.EXPECT().WatchNamespaceState(gomock.Any(), request).Return(response, nil)
+
+	mockLogger := log.NewMockLogger(t)
+	mockLogger.On("WithTags", []tag.Tag{tag.ShardNamespace("test-namespace")}).Return(mockLogger)
+
+	handler := NewMetricsHandler(mockHandler, mockLogger, metricsClient)
+
+	gotResponse, err := handler.WatchNamespaceState(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, response, gotResponse)
+
+	requestCounterName := "test.shard_distributor_requests+namespace=test-namespace,operation=WatchNamespaceState"
+	requestCounter := testScope.Snapshot().Counters()[requestCounterName]
+	require.NotNil(t, requestCounter)
+	assert.Equal(t, int64(1), requestCounter.Value())
+}
+
+func TestMetricsHandler_ListNamespaces(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	request := &types.ListNamespacesRequest{}
+	response := &types.ListNamespacesResponse{
+		Namespaces: []*types.NamespaceInfo{{Name: "test-namespace"}},
+	}
+
+	testScope := tally.NewTestScope("test", nil)
+	metricsClient := metrics.NewClient(testScope, metrics.ShardDistributor)
+	mockHandler := handler.NewMockHandler(ctrl)
+	mockHandler.EXPECT().ListNamespaces(gomock.Any(), request).Return(response, nil)
+
+	// The request has no namespace, so the logger is not tagged.
+	mockLogger := log.NewMockLogger(t)
+
+	handler := NewMetricsHandler(mockHandler, mockLogger, metricsClient)
+
+	gotResponse, err := handler.ListNamespaces(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, response, gotResponse)
+
+	requestCounterName := "test.shard_distributor_requests+operation=ListNamespaces"
+	requestCounter := testScope.Snapshot().Counters()[requestCounterName]
+	require.NotNil(t, requestCounter)
+	assert.Equal(t, int64(1), requestCounter.Value())
+}
+
 // For these methods we expect no metrics nor logs to be emitted
 func TestPassThroughMethods(t *testing.T) {
 	tests := []struct {
@@ -37,6 +37,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -71,6 +72,18 @@ func WithEmitConsumerCountMetrifFn(fn func(int)) ConsumerManagerOptions {
 	}
 }
 
+func WithAsyncRequestManager(asyncRequestManager persistence.AsyncRequestManager) ConsumerManagerOptions {
+	return func(c *ConsumerManager) {
+		c.asyncRequestManager = asyncRequestManager
+	}
+}
+
+func WithMapQManager(mapQManager persistence.MapQManager) ConsumerManagerOptions {
+	return func(c *ConsumerManager) {
+		c.mapQManager = mapQManager
+	}
+}
+
 func NewConsumerManager(
 	logger log.Logger,
 	metricsClient metrics.Client,
@@ -112,6 +125,8 @@ type ConsumerManager struct {
 	domainCache               cache.DomainCache
 	queueProvider             queue.Provider
 	frontendClient            frontend.Client
+	asyncRequestManager       persistence.AsyncRequestManager
+	mapQManager               persistence.MapQManager
 	refreshInterval           time.Duration
 	shutdownTimeout           time.Duration
 	ctx                       context.Context
@@ -220,9 +235,11 @@ func (c *ConsumerManager) refreshConsumers() {
 
 		c.logger.Info("Starting consumer", tag.WorkflowDomainName(domain.GetInfo().Name), tag.AsyncWFQueueID(queue.ID()))
 		consumer, err := queue.CreateConsumer(&provider.Params{
-			Logger:         c.logger,
-			MetricsClient:  c.metricsClient,
-			FrontendClient: c.frontendClient,
+			Logger:              c.logger,
+			MetricsClient:       c.metricsClient,
+			FrontendClient:      c.frontendClient,
+			AsyncRequestManager: c.asyncRequestManager,
+			MapQManager:         c.mapQManager,
 		})
 		if err != nil {
 			c.logger.Error("Failed to create consumer", tag.Error(err), tag.WorkflowDomainName(domain.GetInfo().Name), tag.AsyncWFQueueID(queue.ID()))
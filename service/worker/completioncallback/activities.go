@@ -0,0 +1,174 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package completioncallback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/cadence"
+
+	"github.com/uber/cadence/common/completioncallback"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+// LoadActivity returns the number of callbacks of the closed run of request and how often each of them is attempted
+func (p *processor) LoadActivity(ctx context.Context, request Request) (loadResult, error) {
+	response, err := p.getCompletionCallbacks(ctx, request)
+	if err != nil {
+		return loadResult{}, err
+	}
+	domainName, err := p.domainCache.GetDomainName(request.DomainID)
+	if err != nil {
+		return loadResult{}, err
+	}
+	return loadResult{
+		NumCallbacks: len(response.GetCallbacks()),
+		MaxAttempts:  int32(p.config.MaxAttempts(domainName)),
+	}, nil
+}
+
+// DeliverActivity posts the close notification of request to its index-th callback.
+// The callback is loaded from the mutable state of the run on every attempt, so its headers never leave this process.
+func (p *processor) DeliverActivity(ctx context.Context, request Request, index int) error {
+	response, err := p.getCompletionCallbacks(ctx, request)
+	if err != nil {
+		return err
+	}
+	if index < 0 || index >= len(response.GetCallbacks()) {
+		return cadence.NewCustomError(errReasonNonRetryable, fmt.Sprintf("callback index %d out of range", index))
+	}
+	domainName, err := p.domainCache.GetDomainName(request.DomainID)
+	if err != nil {
+		return err
+	}
+	scope := p.metricsClient.Scope(metrics.CompletionCallbackProcessorScope, metrics.DomainTag(domainName))
+
+	// the allow-list may have changed since the workflow started,
+	// so the destination is re-checked before anything is sent
+	callback := response.GetCallbacks()[index]
+	if err := completioncallback.ValidateCallback(callback, p.config.AllowedHosts(domainName)); err != nil {
+		scope.IncCounter(metrics.CompletionCallbackDeliveryFailures)
+		return cadence.NewCustomError(errReasonNonRetryable, err.Error())
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+	err = completioncallback.Deliver(ctx, p.httpClient, callback, completioncallback.Notification{
+		Domain:        domainName,
+		WorkflowID:    request.WorkflowID,
+		RunID:         request.RunID,
+		WorkflowType:  response.GetWorkflowType().GetName(),
+		CloseStatus:   response.GetCloseStatus().String(),
+		CloseTime:     response.GetCloseTime(),
+		HistoryLength: response.GetHistoryLength(),
+	})
+	if err == nil {
+		scope.IncCounter(metrics.CompletionCallbackDeliverySuccess)
+		return nil
+	}
+
+	scope.IncCounter(metrics.CompletionCallbackDeliveryFailures)
+	var deliveryErr *completioncallback.DeliveryError
+	if errors.As(err, &deliveryErr) && !deliveryErr.Retryable {
+		return cadence.NewCustomError(errReasonNonRetryable, err.Error())
+	}
+	return err
+}
+
+// RecordActivity records the delivery state of the index-th callback of request in the mutable state of the run,
+// where it is returned by DescribeWorkflowExecution.
+func (p *processor) RecordActivity(ctx context.Context, request Request, index int, state types.CompletionCallbackInfo) error {
+	err := p.clientBean.GetHistoryClient().RecordCompletionCallbackState(ctx, &types.RecordCompletionCallbackStateRequest{
+		DomainUUID: request.DomainID,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: request.WorkflowID,
+			RunID:      request.RunID,
+		},
+		Index: int32(index),
+		State: &state,
+	})
+	return toActivityError(err)
+}
+
+// DeadLetterActivity records a callback that could not be delivered. Its final state is kept
+// in the mutable state of the run, so it stays visible through DescribeWorkflowExecution.
+func (p *processor) DeadLetterActivity(ctx context.Context, request Request, index int, state types.CompletionCallbackInfo) error {
+	if err := p.RecordActivity(ctx, request, index, state); err != nil {
+		return err
+	}
+
+	domainName, err := p.domainCache.GetDomainName(request.DomainID)
+	if err != nil {
+		return err
+	}
+	p.metricsClient.Scope(metrics.CompletionCallbackProcessorScope, metrics.DomainTag(domainName)).
+		IncCounter(metrics.CompletionCallbackDeadLettered)
+	p.logger.Error("Completion callback dead-lettered",
+		tag.WorkflowDomainName(domainName),
+		tag.WorkflowID(request.WorkflowID),
+		tag.WorkflowRunID(request.RunID),
+		tag.Attempt(state.Attempt),
+		tag.Address(p.callbackURL(ctx, request, index)),
+		tag.Dynamic("failure-reason", state.LastFailureReason),
+	)
+	return nil
+}
+
+// callbackURL returns the URL of the index-th callback of request. The state reported by the workflow
+// does not carry it, so it is read from the callback definition; the lookup is only used for logging
+// and returns an empty URL if it fails.
+func (p *processor) callbackURL(ctx context.Context, request Request, index int) string {
+	response, err := p.getCompletionCallbacks(ctx, request)
+	if err != nil || index < 0 || index >= len(response.GetCallbacks()) {
+		return ""
+	}
+	return response.GetCallbacks()[index].GetURL()
+}
+
+func (p *processor) getCompletionCallbacks(ctx context.Context, request Request) (*types.GetCompletionCallbacksResponse, error) {
+	response, err := p.clientBean.GetHistoryClient().GetCompletionCallbacks(ctx, &types.GetCompletionCallbacksRequest{
+		DomainUUID: request.DomainID,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: request.WorkflowID,
+			RunID:      request.RunID,
+		},
+	})
+	if err != nil {
+		return nil, toActivityError(err)
+	}
+	return response, nil
+}
+
+// toActivityError turns history errors that retrying cannot fix into non-retryable activity errors
+func toActivityError(err error) error {
+	var badRequestErr *types.BadRequestError
+	var entityNotExistsErr *types.EntityNotExistsError
+	if errors.As(err, &badRequestErr) || errors.As(err, &entityNotExistsErr) {
+		return cadence.NewCustomError(errReasonNonRetryable, err.Error())
+	}
+	return err
+}
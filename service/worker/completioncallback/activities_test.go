@@ -0,0 +1,207 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package completioncallback
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/cadence"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/completioncallback"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestLoadActivity(t *testing.T) {
+	p, historyClient := newTestProcessor(t, nil, nil)
+	historyClient.EXPECT().GetCompletionCallbacks(gomock.Any(), testGetRequest()).
+		Return(&types.GetCompletionCallbacksResponse{
+			Callbacks: []*types.CompletionCallback{{URL: "http://localhost/a"}, {URL: "http://localhost/b"}},
+		}, nil)
+
+	result, err := p.LoadActivity(context.Background(), testRequest())
+	require.NoError(t, err)
+	assert.Equal(t, loadResult{NumCallbacks: 2, MaxAttempts: 3}, result)
+}
+
+func TestLoadActivity_WorkflowRunning(t *testing.T) {
+	p, historyClient := newTestProcessor(t, nil, nil)
+	historyClient.EXPECT().GetCompletionCallbacks(gomock.Any(), testGetRequest()).
+		Return(nil, &types.BadRequestError{Message: "Workflow execution is still running."})
+
+	_, err := p.LoadActivity(context.Background(), testRequest())
+	assert.True(t, isNonRetryable(err))
+}
+
+func TestDeliverActivity(t *testing.T) {
+	tests := map[string]struct {
+		status         int
+		allowedHost    string
+		wantErr        bool
+		wantRetryable  bool
+		callbackOffset int
+	}{
+		"success":               {status: http.StatusNoContent},
+		"server error":          {status: http.StatusBadGateway, wantErr: true, wantRetryable: true},
+		"rejected":              {status: http.StatusForbidden, wantErr: true},
+		"host not allowed":      {status: http.StatusOK, allowedHost: "example.com", wantErr: true},
+		"callback out of range": {status: http.StatusOK, wantErr: true, callbackOffset: 1},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var notification completioncallback.Notification
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "secret", r.Header.Get("Authorization"))
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&notification))
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+			serverURL, err := url.Parse(server.URL)
+			require.NoError(t, err)
+			allowedHost := serverURL.Hostname()
+			if tt.allowedHost != "" {
+				allowedHost = tt.allowedHost
+			}
+
+			p, historyClient := newTestProcessor(t, server.Client(), map[string]interface{}{allowedHost: true})
+			historyClient.EXPECT().GetCompletionCallbacks(gomock.Any(), testGetRequest()).
+				Return(&types.GetCompletionCallbacksResponse{
+					Callbacks:     []*types.CompletionCallback{{URL: server.URL, Headers: map[string]string{"Authorization": "secret"}}},
+					WorkflowType:  &types.WorkflowType{Name: "wtype"},
+					CloseStatus:   types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+					CloseTime:     common.Int64Ptr(1234),
+					HistoryLength: 10,
+				}, nil)
+
+			err = p.DeliverActivity(context.Background(), testRequest(), tt.callbackOffset)
+			if !tt.wantErr {
+				require.NoError(t, err)
+				assert.Equal(t, completioncallback.Notification{
+					Domain:        "domain",
+					WorkflowID:    "wid",
+					RunID:         "rid",
+					WorkflowType:  "wtype",
+					CloseStatus:   types.WorkflowExecutionCloseStatusCompleted.String(),
+					CloseTime:     1234,
+					HistoryLength: 10,
+				}, notification)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, !tt.wantRetryable, isNonRetryable(err))
+		})
+	}
+}
+
+func TestRecordActivity(t *testing.T) {
+	state := types.CompletionCallbackInfo{
+		State:   types.CompletionCallbackStateSucceeded.Ptr(),
+		Attempt: 2,
+	}
+	p, historyClient := newTestProcessor(t, nil, nil)
+	historyClient.EXPECT().RecordCompletionCallbackState(gomock.Any(), &types.RecordCompletionCallbackStateRequest{
+		DomainUUID:        "domain-id",
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+		Index:             1,
+		State:             &state,
+	}).Return(nil)
+	assert.NoError(t, p.RecordActivity(context.Background(), testRequest(), 1, state))
+
+	historyClient.EXPECT().RecordCompletionCallbackState(gomock.Any(), gomock.Any()).Return(errors.New("history unavailable"))
+	err := p.RecordActivity(context.Background(), testRequest(), 1, state)
+	require.Error(t, err)
+	assert.False(t, isNonRetryable(err))
+}
+
+func TestDeadLetterActivity(t *testing.T) {
+	// the workflow does not set the URL, it is read from the callback definition
+	state := types.CompletionCallbackInfo{
+		State:             types.CompletionCallbackStateDeadLettered.Ptr(),
+		Attempt:           3,
+		LastFailureReason: "connection refused",
+	}
+	p, historyClient := newTestProcessor(t, nil, nil)
+	historyClient.EXPECT().RecordCompletionCallbackState(gomock.Any(), &types.RecordCompletionCallbackStateRequest{
+		DomainUUID:        "domain-id",
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+		Index:             0,
+		State:             &state,
+	}).Return(nil)
+	historyClient.EXPECT().GetCompletionCallbacks(gomock.Any(), testGetRequest()).
+		Return(&types.GetCompletionCallbacksResponse{
+			Callbacks: []*types.CompletionCallback{{URL: "http://localhost/callback"}},
+		}, nil)
+	assert.NoError(t, p.DeadLetterActivity(context.Background(), testRequest(), 0, state))
+
+	// failing to look up the URL does not fail the dead-lettering
+	historyClient.EXPECT().RecordCompletionCallbackState(gomock.Any(), gomock.Any()).Return(nil)
+	historyClient.EXPECT().GetCompletionCallbacks(gomock.Any(), gomock.Any()).Return(nil, errors.New("history unavailable"))
+	assert.NoError(t, p.DeadLetterActivity(context.Background(), testRequest(), 0, state))
+}
+
+func newTestProcessor(t *testing.T, httpClient *http.Client, allowedHosts map[string]interface{}) (*processor, *history.MockClient) {
+	ctrl := gomock.NewController(t)
+	historyClient := history.NewMockClient(ctrl)
+	clientBean := client.NewMockBean(ctrl)
+	clientBean.EXPECT().GetHistoryClient().Return(historyClient).AnyTimes()
+	domainCache := cache.NewMockDomainCache(ctrl)
+	domainCache.EXPECT().GetDomainName("domain-id").Return("domain", nil).AnyTimes()
+
+	return New(Params{
+		MetricsClient: metrics.NewNoopMetricsClient(),
+		Logger:        testlogger.New(t),
+		ClientBean:    clientBean,
+		DomainCache:   domainCache,
+		Config: &Config{
+			MaxAttempts:  func(string) int { return 3 },
+			AllowedHosts: func(string) map[string]interface{} { return allowedHosts },
+		},
+		HTTPClient: httpClient,
+	}).(*processor), historyClient
+}
+
+func testGetRequest() *types.GetCompletionCallbacksRequest {
+	return &types.GetCompletionCallbacksRequest{
+		DomainUUID:        "domain-id",
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+	}
+}
+
+func isNonRetryable(err error) bool {
+	var customErr *cadence.CustomError
+	return errors.As(err, &customErr) && customErr.Reason() == errReasonNonRetryable
+}
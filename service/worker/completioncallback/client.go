@@ -0,0 +1,72 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package completioncallback
+
+import (
+	"context"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	cclient "go.uber.org/cadence/client"
+
+	"github.com/uber/cadence/common/constants"
+)
+
+type (
+	// Client is used to hand the callbacks of a closed workflow over to the processor workflow
+	Client interface {
+		SendCompletionCallbackRequest(context.Context, Request) error
+	}
+
+	clientImpl struct {
+		cadenceClient cclient.Client
+	}
+)
+
+var _ Client = (*clientImpl)(nil)
+
+// NewClient creates a new Client
+func NewClient(publicClient workflowserviceclient.Interface) Client {
+	return &clientImpl{
+		cadenceClient: cclient.NewClient(publicClient, constants.SystemLocalDomainName, &cclient.Options{}),
+	}
+}
+
+func (c *clientImpl) SendCompletionCallbackRequest(
+	ctx context.Context,
+	request Request,
+) error {
+	workflowOptions := cclient.StartWorkflowOptions{
+		ID:                              WorkflowID(request.DomainID, request.RunID),
+		TaskList:                        TaskListName,
+		ExecutionStartToCloseTimeout:    workflowStartToCloseTimeout,
+		DecisionTaskStartToCloseTimeout: workflowTaskStartToCloseTimeout,
+		WorkflowIDReusePolicy:           cclient.WorkflowIDReusePolicyRejectDuplicate,
+	}
+	_, err := c.cadenceClient.StartWorkflow(ctx, workflowOptions, WorkflowTypeName, request)
+	if cadence.IsWorkflowExecutionAlreadyStartedError(err) {
+		// the transfer task was retried after the workflow was started
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,77 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package completioncallback
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// TaskListName is the tasklist of the completion callback system workflow
+	TaskListName = "cadence-sys-completion-callback-tasklist"
+	// WorkflowTypeName is the workflow type of the completion callback system workflow
+	WorkflowTypeName = "cadence-sys-completion-callback-workflow"
+
+	loadActivityName       = "cadence-sys-completion-callback-load-activity"
+	deliverActivityName    = "cadence-sys-completion-callback-deliver-activity"
+	recordActivityName     = "cadence-sys-completion-callback-record-activity"
+	deadLetterActivityName = "cadence-sys-completion-callback-dead-letter-activity"
+
+	workflowIDPrefix = "completion-callback"
+
+	// errReasonNonRetryable is the activity failure reason used when retrying cannot help,
+	// e.g. the callback endpoint rejected the notification or is no longer allowed
+	errReasonNonRetryable = "cadence-sys-completion-callback-non-retryable"
+
+	workflowStartToCloseTimeout     = 7 * 24 * time.Hour
+	workflowTaskStartToCloseTimeout = time.Minute
+	deliveryTimeout                 = 10 * time.Second
+
+	initialBackoff     = 10 * time.Second
+	backoffCoefficient = 2
+	maxBackoff         = 30 * time.Minute
+)
+
+type (
+	// Request is the input of the completion callback system workflow. It only identifies the
+	// closed run: callbacks may carry credentials in their headers, so the activities load them
+	// from the mutable state of the run instead of recording them in the system workflow history.
+	Request struct {
+		DomainID   string `json:"domain_id"`
+		WorkflowID string `json:"workflow_id"`
+		RunID      string `json:"run_id"`
+	}
+
+	// loadResult is what the system workflow needs to know about the callbacks of the run
+	loadResult struct {
+		NumCallbacks int   `json:"num_callbacks"`
+		MaxAttempts  int32 `json:"max_attempts"`
+	}
+)
+
+// WorkflowID returns the ID of the system workflow delivering the callbacks of the given run.
+// The ID is deterministic so the transfer task can be retried without firing callbacks twice.
+func WorkflowID(domainID, runID string) string {
+	return fmt.Sprintf("%v-%v-%v", workflowIDPrefix, domainID, runID)
+}
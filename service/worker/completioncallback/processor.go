@@ -0,0 +1,126 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package completioncallback
+
+import (
+	"net/http"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	// Processor is the background sub-system delivering workflow completion callbacks
+	Processor interface {
+		Start() error
+		Stop()
+	}
+
+	// Config is the dynamic configuration of the processor
+	Config struct {
+		// MaxAttempts is the number of delivery attempts of a callback before it is dead-lettered
+		MaxAttempts dynamicproperties.IntPropertyFnWithDomainFilter
+		// AllowedHosts are the destinations callbacks may be delivered to, re-checked before every attempt
+		AllowedHosts dynamicproperties.MapPropertyFnWithDomainFilter
+	}
+
+	// Params contains the set of params needed to bootstrap the processor
+	Params struct {
+		ServiceClient workflowserviceclient.Interface
+		MetricsClient metrics.Client
+		TallyScope    tally.Scope
+		Logger        log.Logger
+		// ClientBean is the collection of clients
+		ClientBean client.Bean
+		// DomainCache is the cache for domain information and configuration
+		DomainCache cache.DomainCache
+		Config      *Config
+		// HTTPClient is used to call the callback endpoints, defaults to a client with deliveryTimeout
+		HTTPClient *http.Client
+	}
+
+	processor struct {
+		svcClient     workflowserviceclient.Interface
+		clientBean    client.Bean
+		domainCache   cache.DomainCache
+		config        *Config
+		httpClient    *http.Client
+		metricsClient metrics.Client
+		tallyScope    tally.Scope
+		logger        log.Logger
+		worker        worker.Worker
+	}
+)
+
+// New creates a new completion callback processor
+func New(params Params) Processor {
+	httpClient := params.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: deliveryTimeout}
+	}
+	return &processor{
+		svcClient:     params.ServiceClient,
+		clientBean:    params.ClientBean,
+		domainCache:   params.DomainCache,
+		config:        params.Config,
+		httpClient:    httpClient,
+		metricsClient: params.MetricsClient,
+		tallyScope:    params.TallyScope,
+		logger:        params.Logger.WithTags(tag.ComponentCompletionCallback),
+	}
+}
+
+// Start starts the worker
+func (p *processor) Start() error {
+	workerOpts := worker.Options{
+		MetricsScope: p.tallyScope,
+		Tracer:       opentracing.GlobalTracer(),
+	}
+	newWorker := worker.New(p.svcClient, constants.SystemLocalDomainName, TaskListName, workerOpts)
+	newWorker.RegisterWorkflowWithOptions(p.ProcessorWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	newWorker.RegisterActivityWithOptions(p.LoadActivity, activity.RegisterOptions{Name: loadActivityName})
+	newWorker.RegisterActivityWithOptions(p.DeliverActivity, activity.RegisterOptions{Name: deliverActivityName})
+	newWorker.RegisterActivityWithOptions(p.RecordActivity, activity.RegisterOptions{Name: recordActivityName})
+	newWorker.RegisterActivityWithOptions(p.DeadLetterActivity, activity.RegisterOptions{Name: deadLetterActivityName})
+	p.worker = newWorker
+	return newWorker.Start()
+}
+
+// Stop stops the worker
+func (p *processor) Stop() {
+	if p.worker != nil {
+		p.worker.Stop()
+	}
+}
@@ -0,0 +1,156 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package completioncallback
+
+import (
+	"errors"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+// delivery attempts are driven by the workflow rather than an activity retry policy,
+// so that each of them is recorded in the mutable state of the run
+var deliverActivityOptions = workflow.ActivityOptions{
+	ScheduleToStartTimeout: 10 * time.Minute,
+	StartToCloseTimeout:    time.Minute,
+}
+
+// historyActivityOptions are used by the activities calling history, which are retried until history is reachable
+var historyActivityOptions = workflow.ActivityOptions{
+	ScheduleToStartTimeout: 10 * time.Minute,
+	StartToCloseTimeout:    time.Minute,
+	RetryPolicy: &cadence.RetryPolicy{
+		InitialInterval:          time.Second,
+		BackoffCoefficient:       2,
+		MaximumInterval:          time.Minute,
+		ExpirationInterval:       time.Hour,
+		NonRetriableErrorReasons: []string{errReasonNonRetryable},
+	},
+}
+
+// ProcessorWorkflow delivers the completion callbacks of a single closed workflow run.
+// Each callback is retried with exponential backoff up to the configured max attempts and is
+// dead-lettered when the attempts are exhausted or the endpoint rejects the notification.
+// Every state transition is recorded in the mutable state of the run.
+func (p *processor) ProcessorWorkflow(ctx workflow.Context, request Request) error {
+	var callbacks loadResult
+	historyCtx := workflow.WithActivityOptions(ctx, historyActivityOptions)
+	if err := workflow.ExecuteActivity(historyCtx, loadActivityName, request).Get(ctx, &callbacks); err != nil {
+		return err
+	}
+
+	wg := workflow.NewWaitGroup(ctx)
+	for i := 0; i < callbacks.NumCallbacks; i++ {
+		index := i
+		wg.Add(1)
+		workflow.Go(ctx, func(ctx workflow.Context) {
+			defer wg.Done()
+			deliver(ctx, request, index, callbacks.MaxAttempts)
+		})
+	}
+	wg.Wait(ctx)
+	return nil
+}
+
+func deliver(ctx workflow.Context, request Request, index int, maxAttempts int32) {
+	logger := workflow.GetLogger(ctx).With(zap.Int("index", index))
+	deliverCtx := workflow.WithActivityOptions(ctx, deliverActivityOptions)
+	historyCtx := workflow.WithActivityOptions(ctx, historyActivityOptions)
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+	state := types.CompletionCallbackInfo{}
+	for attempt := int32(1); ; attempt++ {
+		state.Attempt = attempt
+		state.State = types.CompletionCallbackStateScheduled.Ptr()
+		state.NextAttemptTimestamp = nil
+		state.LastAttemptTimestamp = common.Int64Ptr(workflow.Now(ctx).UnixNano())
+		record(historyCtx, logger, request, index, state)
+
+		err := workflow.ExecuteActivity(deliverCtx, deliverActivityName, request, index).Get(ctx, nil)
+		if err == nil {
+			state.State = types.CompletionCallbackStateSucceeded.Ptr()
+			state.LastFailureReason = ""
+			record(historyCtx, logger, request, index, state)
+			return
+		}
+		state.LastFailureReason = failureReason(err)
+
+		if !isRetryable(err) || attempt >= maxAttempts {
+			logger.Warn("Dead-lettering completion callback", zap.Int32("attempt", attempt), zap.Error(err))
+			state.State = types.CompletionCallbackStateDeadLettered.Ptr()
+			if err := workflow.ExecuteActivity(historyCtx, deadLetterActivityName, request, index, state).Get(ctx, nil); err != nil {
+				logger.Error("Failed to dead-letter completion callback", zap.Error(err))
+			}
+			return
+		}
+
+		backoff := getBackoff(attempt)
+		state.State = types.CompletionCallbackStateBackingOff.Ptr()
+		state.NextAttemptTimestamp = common.Int64Ptr(workflow.Now(ctx).Add(backoff).UnixNano())
+		record(historyCtx, logger, request, index, state)
+		if err := workflow.Sleep(ctx, backoff); err != nil {
+			return
+		}
+	}
+}
+
+// record is best effort: failing to record the state must not stop the delivery
+func record(ctx workflow.Context, logger *zap.Logger, request Request, index int, state types.CompletionCallbackInfo) {
+	if err := workflow.ExecuteActivity(ctx, recordActivityName, request, index, state).Get(ctx, nil); err != nil {
+		logger.Warn("Failed to record completion callback state", zap.Error(err))
+	}
+}
+
+func getBackoff(attempt int32) time.Duration {
+	backoff := initialBackoff
+	for i := int32(1); i < attempt; i++ {
+		backoff *= backoffCoefficient
+		if backoff >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return backoff
+}
+
+func isRetryable(err error) bool {
+	var customErr *cadence.CustomError
+	return !errors.As(err, &customErr) || customErr.Reason() != errReasonNonRetryable
+}
+
+func failureReason(err error) string {
+	var customErr *cadence.CustomError
+	if errors.As(err, &customErr) {
+		var details string
+		if customErr.HasDetails() && customErr.Details(&details) == nil {
+			return details
+		}
+	}
+	return err.Error()
+}
@@ -0,0 +1,190 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package completioncallback
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+type workflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	workflowEnv *testsuite.TestWorkflowEnvironment
+	processor   *processor
+}
+
+func TestWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(workflowTestSuite))
+}
+
+func (s *workflowTestSuite) SetupTest() {
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.processor = New(Params{
+		MetricsClient: metrics.NewNoopMetricsClient(),
+		Logger:        testlogger.New(s.T()),
+	}).(*processor)
+	s.workflowEnv.RegisterWorkflowWithOptions(s.processor.ProcessorWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(s.processor.LoadActivity, activity.RegisterOptions{Name: loadActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(s.processor.DeliverActivity, activity.RegisterOptions{Name: deliverActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(s.processor.RecordActivity, activity.RegisterOptions{Name: recordActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(s.processor.DeadLetterActivity, activity.RegisterOptions{Name: deadLetterActivityName})
+}
+
+func (s *workflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+}
+
+func (s *workflowTestSuite) TestWorkflow_RetriesThenSucceeds() {
+	request := testRequest()
+	s.workflowEnv.OnActivity(loadActivityName, mock.Anything, request).Return(loadResult{NumCallbacks: 1, MaxAttempts: 3}, nil).Once()
+	s.workflowEnv.OnActivity(deliverActivityName, mock.Anything, request, 0).Return(errors.New("connection refused")).Twice()
+	s.workflowEnv.OnActivity(deliverActivityName, mock.Anything, request, 0).Return(nil).Once()
+	states := s.recordStates(request)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, request)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+
+	s.Equal([]types.CompletionCallbackState{
+		types.CompletionCallbackStateScheduled,
+		types.CompletionCallbackStateBackingOff,
+		types.CompletionCallbackStateScheduled,
+		types.CompletionCallbackStateBackingOff,
+		types.CompletionCallbackStateScheduled,
+		types.CompletionCallbackStateSucceeded,
+	}, stateValues(*states))
+	last := (*states)[len(*states)-1]
+	s.Equal(int32(3), last.GetAttempt())
+	s.Empty(last.GetLastFailureReason())
+}
+
+func (s *workflowTestSuite) TestWorkflow_DeadLetterAfterMaxAttempts() {
+	request := testRequest()
+	s.workflowEnv.OnActivity(loadActivityName, mock.Anything, request).Return(loadResult{NumCallbacks: 1, MaxAttempts: 2}, nil).Once()
+	s.workflowEnv.OnActivity(deliverActivityName, mock.Anything, request, 0).Return(errors.New("connection refused")).Twice()
+	s.recordStates(request)
+	var deadLettered types.CompletionCallbackInfo
+	s.workflowEnv.OnActivity(deadLetterActivityName, mock.Anything, request, 0, mock.Anything).
+		Return(func(_ context.Context, _ Request, _ int, state types.CompletionCallbackInfo) error {
+			deadLettered = state
+			return nil
+		}).Once()
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, request)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+
+	s.Equal(types.CompletionCallbackStateDeadLettered, deadLettered.GetState())
+	s.Equal(int32(2), deadLettered.GetAttempt())
+	s.Contains(deadLettered.GetLastFailureReason(), "connection refused")
+}
+
+func (s *workflowTestSuite) TestWorkflow_NonRetryableFailure() {
+	request := testRequest()
+	s.workflowEnv.OnActivity(loadActivityName, mock.Anything, request).Return(loadResult{NumCallbacks: 1, MaxAttempts: 5}, nil).Once()
+	s.workflowEnv.OnActivity(deliverActivityName, mock.Anything, request, 0).
+		Return(cadence.NewCustomError(errReasonNonRetryable, "callback returned status 400: bad request")).Once()
+	s.recordStates(request)
+	var deadLettered types.CompletionCallbackInfo
+	s.workflowEnv.OnActivity(deadLetterActivityName, mock.Anything, request, 0, mock.Anything).
+		Return(func(_ context.Context, _ Request, _ int, state types.CompletionCallbackInfo) error {
+			deadLettered = state
+			return nil
+		}).Once()
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, request)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+
+	s.Equal(types.CompletionCallbackStateDeadLettered, deadLettered.GetState())
+	s.Equal(int32(1), deadLettered.GetAttempt())
+	s.Equal("callback returned status 400: bad request", deadLettered.GetLastFailureReason())
+}
+
+func (s *workflowTestSuite) TestWorkflow_NoCallbacks() {
+	request := testRequest()
+	s.workflowEnv.OnActivity(loadActivityName, mock.Anything, request).Return(loadResult{}, nil).Once()
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, request)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+}
+
+func (s *workflowTestSuite) TestWorkflow_RecordFailureDoesNotStopDelivery() {
+	request := testRequest()
+	s.workflowEnv.OnActivity(loadActivityName, mock.Anything, request).Return(loadResult{NumCallbacks: 1, MaxAttempts: 1}, nil).Once()
+	s.workflowEnv.OnActivity(recordActivityName, mock.Anything, request, 0, mock.Anything).
+		Return(cadence.NewCustomError(errReasonNonRetryable, "workflow not found"))
+	s.workflowEnv.OnActivity(deliverActivityName, mock.Anything, request, 0).Return(nil).Once()
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, request)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+}
+
+// recordStates captures every state recorded for the first callback of request
+func (s *workflowTestSuite) recordStates(request Request) *[]types.CompletionCallbackInfo {
+	states := &[]types.CompletionCallbackInfo{}
+	s.workflowEnv.OnActivity(recordActivityName, mock.Anything, request, 0, mock.Anything).
+		Return(func(_ context.Context, _ Request, _ int, state types.CompletionCallbackInfo) error {
+			*states = append(*states, state)
+			return nil
+		})
+	return states
+}
+
+func stateValues(states []types.CompletionCallbackInfo) []types.CompletionCallbackState {
+	values := make([]types.CompletionCallbackState, 0, len(states))
+	for _, state := range states {
+		values = append(values, state.GetState())
+	}
+	return values
+}
+
+func TestGetBackoff(t *testing.T) {
+	assert.Equal(t, initialBackoff, getBackoff(1))
+	assert.Equal(t, 2*initialBackoff, getBackoff(2))
+	assert.Equal(t, maxBackoff, getBackoff(20))
+}
+
+func testRequest() Request {
+	return Request{
+		DomainID:   "domain-id",
+		WorkflowID: "wid",
+		RunID:      "rid",
+	}
+}
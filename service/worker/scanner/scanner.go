@@ -41,6 +41,7 @@ import (
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/service/worker/scanner/shardscanner"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
+	"github.com/uber/cadence/service/worker/scanner/visibility"
 	"github.com/uber/cadence/service/worker/workercommon"
 )
 
@@ -70,6 +71,10 @@ type (
 		// ShardScanners is a list of shard scanner configs
 		ShardScanners              []*shardscanner.ScannerConfig
 		MaxWorkflowRetentionInDays dynamicproperties.IntPropertyFn
+		// VisibilityReconcilerEnabled indicates if visibility reconciler should be started as part of scanner
+		VisibilityReconcilerEnabled dynamicproperties.BoolPropertyFn
+		// VisibilityReconcilerOptions contains options for visibility reconciler
+		VisibilityReconcilerOptions visibility.Config
 	}
 
 	// BootstrapParams contains the set of params needed to bootstrap
@@ -155,6 +160,13 @@ func (s *Scanner) Start() error {
 			historyScannerWFTypeName)
 		workerTaskListNames = append(workerTaskListNames, historyScannerTaskListName)
 	}
+	if s.canReconcileVisibility() && s.context.cfg.VisibilityReconcilerEnabled() {
+		ctx = s.startScanner(
+			ctx,
+			visibilityReconcilerWFStartOptions,
+			visibilityReconcilerWFTypeName)
+		workerTaskListNames = append(workerTaskListNames, visibilityReconcilerTaskListName)
+	}
 
 	workerOpts := worker.Options{
 		Logger:                                 s.zapLogger,
@@ -175,6 +187,13 @@ func (s *Scanner) Start() error {
 	return nil
 }
 
+// canReconcileVisibility returns whether both DB visibility and advanced visibility are available for the reconciler
+func (s *Scanner) canReconcileVisibility() bool {
+	return s.context.cfg.Persistence.VisibilityStore != "" &&
+		s.context.cfg.Persistence.IsAdvancedVisibilityConfigExist() &&
+		s.context.resource.GetVisibilityManager() != nil
+}
+
 func (s *Scanner) startScanner(ctx context.Context, options client.StartWorkflowOptions, workflowName string) context.Context {
 	go s.startWorkflowWithRetryFn(workflowName, scannerStartUpDelay, s.context.resource, func(client client.Client) error {
 		return s.startWorkflow(client, options, workflowName, nil)
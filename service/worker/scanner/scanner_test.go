@@ -202,6 +202,33 @@ func (s *scannerTestSuite) TestStart() {
 				s.mockWorker.EXPECT().Start().Return(nil).Times(1)
 			},
 		},
+		{
+			name: "with VisibilityReconciler enabled",
+			cfg: Config{
+				Persistence: &config.Persistence{
+					DefaultStore:            "nosql",
+					VisibilityStore:         "nosql",
+					AdvancedVisibilityStore: "es-visibility",
+					DataStores: map[string]config.DataStore{
+						"nosql": {
+							NoSQL: &config.NoSQL{},
+						},
+					},
+				},
+				TaskListScannerEnabled: func(opts ...dynamicproperties.FilterOption) bool {
+					return false
+				},
+				HistoryScannerEnabled: func(opts ...dynamicproperties.FilterOption) bool {
+					return false
+				},
+				VisibilityReconcilerEnabled: func(opts ...dynamicproperties.FilterOption) bool {
+					return true
+				},
+			},
+			setupMocks: func() {
+				s.mockWorker.EXPECT().Start().Return(nil).Times(1)
+			},
+		},
 		{
 			name: "failed to start worker",
 			cfg: Config{
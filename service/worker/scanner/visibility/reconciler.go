@@ -0,0 +1,511 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package visibility
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"time"
+
+	"go.uber.org/cadence/activity"
+	"golang.org/x/time/rate"
+
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// DivergenceTypeMissingInDB means the execution has no record in DB visibility
+	DivergenceTypeMissingInDB DivergenceType = "missing_in_db"
+	// DivergenceTypeMissingInAdvanced means the execution has no record in advanced visibility
+	DivergenceTypeMissingInAdvanced DivergenceType = "missing_in_advanced"
+	// DivergenceTypeStaleStatus means the open/close status of the record doesn't match the mutable state
+	DivergenceTypeStaleStatus DivergenceType = "stale_status"
+	// DivergenceTypeMismatchedSearchAttributes means the search attributes of the record don't match the mutable state
+	DivergenceTypeMismatchedSearchAttributes DivergenceType = "mismatched_search_attributes"
+)
+
+const (
+	pageSize = 1000
+	// maxReportedDivergences caps the divergences kept in the report, the rest are only logged and counted
+	maxReportedDivergences = 100
+	// consistencyGracePeriod skips executions changed recently as visibility is eventually consistent
+	consistencyGracePeriod = 5 * time.Minute
+)
+
+type (
+	// DivergenceType is the type of a visibility divergence
+	DivergenceType string
+
+	// Divergence is a visibility record that doesn't match the workflow execution
+	Divergence struct {
+		Type       DivergenceType
+		Store      string
+		DomainName string
+		WorkflowID string
+		RunID      string
+		Details    string
+	}
+
+	// Report is the heartbeat detail and result of the visibility reconciler
+	Report struct {
+		// LastDomain is the last fully reconciled domain, domains are reconciled in name order
+		LastDomain      string
+		CheckedCount    int
+		SkippedCount    int
+		DivergenceCount int
+		RepairedCount   int
+		ErrorCount      int
+		Divergences     []Divergence
+	}
+
+	// Config is the config for visibility reconciler
+	Config struct {
+		// DomainAllow decides which domains are reconciled
+		DomainAllow dynamicproperties.BoolPropertyFnWithDomainFilter
+		// RepairDomainAllow decides which domains get divergent records repaired
+		RepairDomainAllow dynamicproperties.BoolPropertyFnWithDomainFilter
+		// SampleRate is the ratio of executions checked
+		SampleRate dynamicproperties.FloatPropertyFn
+		// ClosedLookback is how far back closed executions are checked
+		ClosedLookback dynamicproperties.DurationPropertyFn
+	}
+
+	// Reconciler compares DB visibility and advanced visibility records with the mutable state
+	// of open and recently closed executions, reports the divergences and optionally repairs them
+	Reconciler struct {
+		visibilityManager persistence.VisibilityManager
+		historyClient     history.Client
+		domainCache       cache.DomainCache
+		advancedStore     string
+		config            *Config
+		limiter           *rate.Limiter
+		report            Report
+		timeSource        clock.TimeSource
+		metrics           metrics.Client
+		logger            log.Logger
+
+		sampleFn    func(rate float64) bool
+		heartbeatFn func(ctx context.Context, report Report)
+	}
+
+	listFn func(context.Context, *persistence.ListWorkflowExecutionsRequest) (*persistence.ListWorkflowExecutionsResponse, error)
+
+	domainInfo struct {
+		id   string
+		name string
+	}
+)
+
+// NewReconciler returns a new visibility reconciler.
+// advancedStore is the visibility mode of the advanced visibility store, e.g. es, os or pinot.
+// report is the heartbeat detail of the previous attempt, reconciliation resumes after its last domain.
+func NewReconciler(
+	visibilityManager persistence.VisibilityManager,
+	historyClient history.Client,
+	domainCache cache.DomainCache,
+	advancedStore string,
+	config *Config,
+	rps int,
+	report Report,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *Reconciler {
+	return &Reconciler{
+		visibilityManager: visibilityManager,
+		historyClient:     historyClient,
+		domainCache:       domainCache,
+		advancedStore:     advancedStore,
+		config:            config,
+		limiter:           rate.NewLimiter(rate.Limit(rps), rps),
+		report:            report,
+		timeSource:        clock.NewRealTimeSource(),
+		metrics:           metricsClient,
+		logger:            logger,
+		sampleFn: func(rate float64) bool {
+			return rand.Float64() < rate
+		},
+		heartbeatFn: func(ctx context.Context, report Report) {
+			activity.RecordHeartbeat(ctx, report)
+		},
+	}
+}
+
+// AdvancedVisibilityMode returns the visibility mode of the given advanced visibility store
+func AdvancedVisibilityMode(advancedVisibilityStore string) string {
+	switch advancedVisibilityStore {
+	case constants.PinotVisibilityStoreName:
+		return constants.VisibilityModePinot
+	case constants.OSVisibilityStoreName:
+		return constants.VisibilityModeOS
+	default:
+		return constants.VisibilityModeES
+	}
+}
+
+// Run reconciles all allowed domains
+func (r *Reconciler) Run(ctx context.Context) (Report, error) {
+	for _, domain := range r.getDomains() {
+		if domain.name <= r.report.LastDomain {
+			continue
+		}
+		if r.config.DomainAllow(domain.name) {
+			if err := r.reconcileDomain(ctx, domain); err != nil {
+				return r.report, err
+			}
+		}
+		r.report.LastDomain = domain.name
+		r.heartbeatFn(ctx, r.report)
+	}
+	return r.report, nil
+}
+
+func (r *Reconciler) getDomains() []domainInfo {
+	var domains []domainInfo
+	for _, entry := range r.domainCache.GetAllDomain() {
+		domains = append(domains, domainInfo{id: entry.GetInfo().ID, name: entry.GetInfo().Name})
+	}
+	sort.Slice(domains, func(i, j int) bool {
+		return domains[i].name < domains[j].name
+	})
+	return domains
+}
+
+func (r *Reconciler) reconcileDomain(ctx context.Context, domain domainInfo) error {
+	r.logger.Info("Reconciling visibility records of domain", tag.WorkflowDomainName(domain.name))
+
+	now := r.timeSource.Now()
+	closedEarliest := now.Add(-r.config.ClosedLookback())
+
+	// records in DB visibility are compared with both the mutable state and advanced visibility
+	if err := r.walk(ctx, domain, constants.VisibilityModeDB, r.visibilityManager.ListOpenWorkflowExecutions, time.Unix(0, 0), now, r.checkDBRecord); err != nil {
+		return err
+	}
+	if err := r.walk(ctx, domain, constants.VisibilityModeDB, r.visibilityManager.ListClosedWorkflowExecutions, closedEarliest, now, r.checkDBRecord); err != nil {
+		return err
+	}
+
+	// records in advanced visibility are only checked for their DB visibility counterpart
+	if err := r.walk(ctx, domain, r.advancedStore, r.visibilityManager.ListOpenWorkflowExecutions, time.Unix(0, 0), now, r.checkAdvancedRecord); err != nil {
+		return err
+	}
+	return r.walk(ctx, domain, r.advancedStore, r.visibilityManager.ListClosedWorkflowExecutions, closedEarliest, now, r.checkAdvancedRecord)
+}
+
+func (r *Reconciler) walk(
+	ctx context.Context,
+	domain domainInfo,
+	store string,
+	list listFn,
+	earliest time.Time,
+	latest time.Time,
+	check func(context.Context, domainInfo, *types.WorkflowExecutionInfo) ([]Divergence, error),
+) error {
+	request := &persistence.ListWorkflowExecutionsRequest{
+		DomainUUID:   domain.id,
+		Domain:       domain.name,
+		EarliestTime: earliest.UnixNano(),
+		LatestTime:   latest.UnixNano(),
+		PageSize:     pageSize,
+	}
+	scope := r.metrics.Scope(metrics.VisibilityReconcilerScope, metrics.DomainTag(domain.name))
+	for {
+		if err := r.limiter.Wait(ctx); err != nil {
+			return err
+		}
+		resp, err := list(withStore(ctx, store), request)
+		if err != nil {
+			return fmt.Errorf("failed to list %v visibility records of domain %v: %w", store, domain.name, err)
+		}
+
+		for _, record := range resp.Executions {
+			if !r.sampleFn(r.config.SampleRate()) {
+				r.report.SkippedCount++
+				scope.IncCounter(metrics.VisibilityReconcilerSkippedCount)
+				continue
+			}
+			if err := r.limiter.Wait(ctx); err != nil {
+				return err
+			}
+
+			r.report.CheckedCount++
+			scope.IncCounter(metrics.VisibilityReconcilerCheckedCount)
+			divergences, err := check(ctx, domain, record)
+			if err != nil {
+				r.report.ErrorCount++
+				scope.IncCounter(metrics.VisibilityReconcilerErrorCount)
+				r.logger.Error("Failed to reconcile visibility record", getRecordLoggingTags(domain, record, err)...)
+				continue
+			}
+			if len(divergences) > 0 {
+				r.handleDivergences(ctx, domain, record, divergences)
+			}
+		}
+
+		r.heartbeatFn(ctx, r.report)
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
+// checkDBRecord compares a DB visibility record with the mutable state and advanced visibility
+func (r *Reconciler) checkDBRecord(ctx context.Context, domain domainInfo, dbRecord *types.WorkflowExecutionInfo) ([]Divergence, error) {
+	execution := dbRecord.GetExecution()
+	mutableState, err := r.describeMutableState(ctx, domain, execution)
+	if err != nil {
+		return nil, err
+	}
+	if mutableState == nil {
+		// the execution is deleted, only an open record is a divergence as closed ones are removed with retention
+		if dbRecord.CloseStatus == nil {
+			return []Divergence{newDivergence(DivergenceTypeStaleStatus, constants.VisibilityModeDB, domain, execution, "open record without mutable state")}, nil
+		}
+		return nil, nil
+	}
+	if r.isRecentlyChanged(mutableState) {
+		return nil, nil
+	}
+
+	var divergences []Divergence
+	if d, ok := compareStatus(constants.VisibilityModeDB, domain, dbRecord, mutableState); !ok {
+		divergences = append(divergences, d)
+	}
+
+	advancedRecord, err := r.getRecord(ctx, r.advancedStore, domain, execution)
+	if err != nil {
+		return nil, err
+	}
+	if advancedRecord == nil {
+		return append(divergences, newDivergence(DivergenceTypeMissingInAdvanced, r.advancedStore, domain, execution, "")), nil
+	}
+	if d, ok := compareStatus(r.advancedStore, domain, advancedRecord, mutableState); !ok {
+		divergences = append(divergences, d)
+	}
+	if d, ok := compareSearchAttributes(r.advancedStore, domain, advancedRecord, mutableState); !ok {
+		divergences = append(divergences, d)
+	}
+	return divergences, nil
+}
+
+// checkAdvancedRecord checks the DB visibility counterpart of an advanced visibility record
+func (r *Reconciler) checkAdvancedRecord(ctx context.Context, domain domainInfo, advancedRecord *types.WorkflowExecutionInfo) ([]Divergence, error) {
+	execution := advancedRecord.GetExecution()
+	dbRecord, err := r.getRecord(ctx, constants.VisibilityModeDB, domain, execution)
+	if err != nil || dbRecord != nil {
+		return nil, err
+	}
+
+	mutableState, err := r.describeMutableState(ctx, domain, execution)
+	if err != nil {
+		return nil, err
+	}
+	if mutableState == nil {
+		if advancedRecord.CloseStatus == nil {
+			return []Divergence{newDivergence(DivergenceTypeStaleStatus, r.advancedStore, domain, execution, "open record without mutable state")}, nil
+		}
+		return nil, nil
+	}
+	if r.isRecentlyChanged(mutableState) {
+		return nil, nil
+	}
+	return []Divergence{newDivergence(DivergenceTypeMissingInDB, constants.VisibilityModeDB, domain, execution, "")}, nil
+}
+
+func (r *Reconciler) handleDivergences(ctx context.Context, domain domainInfo, record *types.WorkflowExecutionInfo, divergences []Divergence) {
+	for _, d := range divergences {
+		r.report.DivergenceCount++
+		if len(r.report.Divergences) < maxReportedDivergences {
+			r.report.Divergences = append(r.report.Divergences, d)
+		}
+		r.metrics.Scope(metrics.VisibilityReconcilerScope, metrics.DomainTag(domain.name), metrics.ReasonTag(string(d.Type))).
+			IncCounter(metrics.VisibilityReconcilerDivergenceCount)
+		r.logger.Warn("Visibility record diverged from workflow execution",
+			append(getRecordLoggingTags(domain, record, nil), tag.Name(string(d.Type)), tag.StoreType(d.Store), tag.DetailInfo(d.Details))...)
+	}
+
+	if !r.config.RepairDomainAllow(domain.name) {
+		return
+	}
+	if err := r.repair(ctx, domain, record.GetExecution()); err != nil {
+		r.report.ErrorCount++
+		r.metrics.Scope(metrics.VisibilityReconcilerScope, metrics.DomainTag(domain.name)).IncCounter(metrics.VisibilityReconcilerErrorCount)
+		r.logger.Error("Failed to repair visibility record", getRecordLoggingTags(domain, record, err)...)
+		return
+	}
+	r.report.RepairedCount++
+	r.metrics.Scope(metrics.VisibilityReconcilerScope, metrics.DomainTag(domain.name)).IncCounter(metrics.VisibilityReconcilerRepairedCount)
+}
+
+// repair regenerates the visibility tasks of the execution from its mutable state,
+// which re-emits the visibility records to every store being written
+func (r *Reconciler) repair(ctx context.Context, domain domainInfo, execution *types.WorkflowExecution) error {
+	return r.historyClient.RefreshWorkflowTasks(ctx, &types.HistoryRefreshWorkflowTasksRequest{
+		DomainUIID: domain.id,
+		Request: &types.RefreshWorkflowTasksRequest{
+			Domain:    domain.name,
+			Execution: execution,
+		},
+	})
+}
+
+// describeMutableState returns nil if the execution doesn't exist
+func (r *Reconciler) describeMutableState(ctx context.Context, domain domainInfo, execution *types.WorkflowExecution) (*types.WorkflowExecutionInfo, error) {
+	resp, err := r.historyClient.DescribeWorkflowExecution(ctx, &types.HistoryDescribeWorkflowExecutionRequest{
+		DomainUUID: domain.id,
+		Request: &types.DescribeWorkflowExecutionRequest{
+			Domain:    domain.name,
+			Execution: execution,
+		},
+	})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return resp.GetWorkflowExecutionInfo(), nil
+}
+
+// getRecord returns the visibility record of the execution in the given store, nil if there is none
+func (r *Reconciler) getRecord(ctx context.Context, store string, domain domainInfo, execution *types.WorkflowExecution) (*types.WorkflowExecutionInfo, error) {
+	ctx = withStore(ctx, store)
+	openResp, err := r.visibilityManager.ListOpenWorkflowExecutionsByWorkflowID(ctx, &persistence.ListWorkflowExecutionsByWorkflowIDRequest{
+		ListWorkflowExecutionsRequest: persistence.ListWorkflowExecutionsRequest{
+			DomainUUID:   domain.id,
+			Domain:       domain.name,
+			EarliestTime: 0,
+			LatestTime:   r.timeSource.Now().UnixNano(),
+			PageSize:     pageSize,
+		},
+		WorkflowID: execution.GetWorkflowID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range openResp.Executions {
+		if record.GetExecution().GetRunID() == execution.GetRunID() {
+			return record, nil
+		}
+	}
+
+	closedResp, err := r.visibilityManager.GetClosedWorkflowExecution(ctx, &persistence.GetClosedWorkflowExecutionRequest{
+		DomainUUID: domain.id,
+		Domain:     domain.name,
+		Execution:  *execution,
+	})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return closedResp.Execution, nil
+}
+
+// isRecentlyChanged returns whether the execution started or closed within the consistency grace period,
+// its visibility records could still be on their way
+func (r *Reconciler) isRecentlyChanged(mutableState *types.WorkflowExecutionInfo) bool {
+	threshold := r.timeSource.Now().Add(-consistencyGracePeriod).UnixNano()
+	return mutableState.GetStartTime() > threshold || mutableState.GetCloseTime() > threshold
+}
+
+func compareStatus(store string, domain domainInfo, record, mutableState *types.WorkflowExecutionInfo) (Divergence, bool) {
+	if reflect.DeepEqual(record.CloseStatus, mutableState.CloseStatus) {
+		return Divergence{}, true
+	}
+	return newDivergence(DivergenceTypeStaleStatus, store, domain, record.GetExecution(),
+		fmt.Sprintf("record status %v, mutable state status %v", formatStatus(record.CloseStatus), formatStatus(mutableState.CloseStatus))), false
+}
+
+func compareSearchAttributes(store string, domain domainInfo, record, mutableState *types.WorkflowExecutionInfo) (Divergence, bool) {
+	var mismatched []string
+	recordFields := record.GetSearchAttributes().GetIndexedFields()
+	for key, expected := range mutableState.GetSearchAttributes().GetIndexedFields() {
+		actual, ok := recordFields[key]
+		if !ok || !equalJSON(expected, actual) {
+			mismatched = append(mismatched, key)
+		}
+	}
+	if len(mismatched) == 0 {
+		return Divergence{}, true
+	}
+	sort.Strings(mismatched)
+	return newDivergence(DivergenceTypeMismatchedSearchAttributes, store, domain, record.GetExecution(),
+		fmt.Sprintf("mismatched keys %v", mismatched)), false
+}
+
+func equalJSON(expected, actual []byte) bool {
+	var expectedValue, actualValue interface{}
+	if json.Unmarshal(expected, &expectedValue) != nil || json.Unmarshal(actual, &actualValue) != nil {
+		return string(expected) == string(actual)
+	}
+	return reflect.DeepEqual(expectedValue, actualValue)
+}
+
+func formatStatus(status *types.WorkflowExecutionCloseStatus) string {
+	if status == nil {
+		return "OPEN"
+	}
+	return status.String()
+}
+
+func newDivergence(divergenceType DivergenceType, store string, domain domainInfo, execution *types.WorkflowExecution, details string) Divergence {
+	return Divergence{
+		Type:       divergenceType,
+		Store:      store,
+		DomainName: domain.name,
+		WorkflowID: execution.GetWorkflowID(),
+		RunID:      execution.GetRunID(),
+		Details:    details,
+	}
+}
+
+func withStore(ctx context.Context, store string) context.Context {
+	return context.WithValue(ctx, persistence.ContextKey, store)
+}
+
+func getRecordLoggingTags(domain domainInfo, record *types.WorkflowExecutionInfo, err error) []tag.Tag {
+	tags := []tag.Tag{
+		tag.WorkflowDomainName(domain.name),
+		tag.WorkflowID(record.GetExecution().GetWorkflowID()),
+		tag.WorkflowRunID(record.GetExecution().GetRunID()),
+	}
+	if err != nil {
+		tags = append(tags, tag.Error(err))
+	}
+	return tags
+}
@@ -0,0 +1,333 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package visibility
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/constants"
+	"github.com/uber/cadence/common/dynamicconfig/dynamicproperties"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	testDomainID   = "test-domain-id"
+	testDomainName = "test-domain"
+	testWorkflowID = "test-workflow-id"
+	testRunID      = "test-run-id"
+)
+
+type (
+	reconcilerSuite struct {
+		suite.Suite
+
+		controller        *gomock.Controller
+		visibilityManager *persistence.MockVisibilityManager
+		historyClient     *history.MockClient
+		domainCache       *cache.MockDomainCache
+		timeSource        clock.MockedTimeSource
+		repairAllowed     bool
+		sampled           bool
+	}
+
+	// storeMatcher matches the context whose visibility read override is the given store
+	storeMatcher struct {
+		store string
+	}
+)
+
+func TestReconcilerSuite(t *testing.T) {
+	suite.Run(t, new(reconcilerSuite))
+}
+
+func (s *reconcilerSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.visibilityManager = persistence.NewMockVisibilityManager(s.controller)
+	s.historyClient = history.NewMockClient(s.controller)
+	s.domainCache = cache.NewMockDomainCache(s.controller)
+	s.timeSource = clock.NewMockedTimeSource()
+	s.repairAllowed = false
+	s.sampled = true
+}
+
+func (s *reconcilerSuite) TestRun_Consistent() {
+	s.expectDomains(testDomainName)
+	record := s.openRecord()
+	s.expectList(constants.VisibilityModeDB, []*types.WorkflowExecutionInfo{record}, nil)
+	s.expectList(constants.VisibilityModeES, []*types.WorkflowExecutionInfo{record}, nil)
+	s.expectMutableState(s.openRecord())
+	s.expectRecord(constants.VisibilityModeES, record)
+	s.expectRecord(constants.VisibilityModeDB, record)
+
+	report, err := s.newReconciler(Report{}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(Report{LastDomain: testDomainName, CheckedCount: 2}, report)
+}
+
+func (s *reconcilerSuite) TestRun_MissingInAdvanced_Repaired() {
+	s.repairAllowed = true
+	s.expectDomains(testDomainName)
+	s.expectList(constants.VisibilityModeDB, []*types.WorkflowExecutionInfo{s.openRecord()}, nil)
+	s.expectList(constants.VisibilityModeES, nil, nil)
+	s.expectMutableState(s.openRecord())
+	s.expectRecord(constants.VisibilityModeES, nil)
+	s.historyClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), &types.HistoryRefreshWorkflowTasksRequest{
+		DomainUIID: testDomainID,
+		Request: &types.RefreshWorkflowTasksRequest{
+			Domain:    testDomainName,
+			Execution: &types.WorkflowExecution{WorkflowID: testWorkflowID, RunID: testRunID},
+		},
+	}).Return(nil)
+
+	report, err := s.newReconciler(Report{}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(1, report.CheckedCount)
+	s.Equal(1, report.DivergenceCount)
+	s.Equal(1, report.RepairedCount)
+	s.Equal([]Divergence{{
+		Type:       DivergenceTypeMissingInAdvanced,
+		Store:      constants.VisibilityModeES,
+		DomainName: testDomainName,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}}, report.Divergences)
+}
+
+func (s *reconcilerSuite) TestRun_StaleStatusAndSearchAttributes() {
+	s.expectDomains(testDomainName)
+	s.expectList(constants.VisibilityModeDB, []*types.WorkflowExecutionInfo{s.openRecord()}, nil)
+	s.expectList(constants.VisibilityModeES, nil, nil)
+
+	mutableState := s.closedRecord()
+	mutableState.SearchAttributes = &types.SearchAttributes{IndexedFields: map[string][]byte{
+		"CustomKeywordField": []byte(`"new"`),
+		"CustomIntField":     []byte(`1`),
+	}}
+	s.expectMutableState(mutableState)
+	advancedRecord := s.closedRecord()
+	advancedRecord.SearchAttributes = &types.SearchAttributes{IndexedFields: map[string][]byte{
+		"CustomKeywordField": []byte(`"old"`),
+		"CustomIntField":     []byte(`1`),
+	}}
+	s.expectRecord(constants.VisibilityModeES, advancedRecord)
+
+	report, err := s.newReconciler(Report{}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(2, report.DivergenceCount)
+	s.Equal(0, report.RepairedCount)
+	s.Len(report.Divergences, 2)
+	s.Equal(DivergenceTypeStaleStatus, report.Divergences[0].Type)
+	s.Equal(constants.VisibilityModeDB, report.Divergences[0].Store)
+	s.Equal(DivergenceTypeMismatchedSearchAttributes, report.Divergences[1].Type)
+	s.Equal(constants.VisibilityModeES, report.Divergences[1].Store)
+	s.Equal("mismatched keys [CustomKeywordField]", report.Divergences[1].Details)
+}
+
+func (s *reconcilerSuite) TestRun_MissingInDB() {
+	s.expectDomains(testDomainName)
+	s.expectList(constants.VisibilityModeDB, nil, nil)
+	s.expectList(constants.VisibilityModeES, []*types.WorkflowExecutionInfo{s.openRecord()}, nil)
+	s.expectRecord(constants.VisibilityModeDB, nil)
+	s.expectMutableState(s.openRecord())
+
+	report, err := s.newReconciler(Report{}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(1, report.DivergenceCount)
+	s.Equal(DivergenceTypeMissingInDB, report.Divergences[0].Type)
+}
+
+func (s *reconcilerSuite) TestRun_DeletedAndRecentExecutions() {
+	s.expectDomains(testDomainName)
+	recent := s.openRecord()
+	recent.StartTime = common.Int64Ptr(s.timeSource.Now().Add(-time.Minute).UnixNano())
+	s.expectList(constants.VisibilityModeDB, []*types.WorkflowExecutionInfo{s.openRecord(), recent}, nil)
+	s.expectList(constants.VisibilityModeES, nil, nil)
+	s.historyClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+	s.expectMutableState(recent)
+
+	report, err := s.newReconciler(Report{}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(2, report.CheckedCount)
+	s.Equal(1, report.DivergenceCount)
+	s.Equal(DivergenceTypeStaleStatus, report.Divergences[0].Type)
+	s.Equal("open record without mutable state", report.Divergences[0].Details)
+}
+
+func (s *reconcilerSuite) TestRun_Error() {
+	s.expectDomains(testDomainName)
+	s.expectList(constants.VisibilityModeDB, []*types.WorkflowExecutionInfo{s.openRecord()}, nil)
+	s.expectList(constants.VisibilityModeES, nil, nil)
+	s.historyClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &types.InternalServiceError{})
+
+	report, err := s.newReconciler(Report{}).Run(context.Background())
+	s.NoError(err)
+	s.Equal(Report{LastDomain: testDomainName, CheckedCount: 1, ErrorCount: 1}, report)
+}
+
+func (s *reconcilerSuite) TestRun_ListError() {
+	s.expectDomains(testDomainName)
+	s.visibilityManager.EXPECT().ListOpenWorkflowExecutions(storeMatcher{constants.VisibilityModeDB}, gomock.Any()).
+		Return(nil, &types.InternalServiceError{})
+
+	report, err := s.newReconciler(Report{}).Run(context.Background())
+	s.Error(err)
+	s.Equal(Report{}, report)
+}
+
+func (s *reconcilerSuite) TestRun_ResumeAndFilterDomains() {
+	s.expectDomains("a-domain", "b-domain", "c-domain")
+	s.expectList(constants.VisibilityModeDB, []*types.WorkflowExecutionInfo{s.openRecord()}, []byte("token"))
+	s.expectList(constants.VisibilityModeES, nil, nil)
+
+	reconciler := s.newReconciler(Report{LastDomain: "a-domain"})
+	reconciler.config.DomainAllow = func(domain string) bool {
+		return domain != "b-domain"
+	}
+	s.sampled = false
+
+	report, err := reconciler.Run(context.Background())
+	s.NoError(err)
+	s.Equal(Report{LastDomain: "c-domain", SkippedCount: 2}, report)
+}
+
+func (s *reconcilerSuite) TestAdvancedVisibilityMode() {
+	s.Equal(constants.VisibilityModeES, AdvancedVisibilityMode(constants.ESVisibilityStoreName))
+	s.Equal(constants.VisibilityModeOS, AdvancedVisibilityMode(constants.OSVisibilityStoreName))
+	s.Equal(constants.VisibilityModePinot, AdvancedVisibilityMode(constants.PinotVisibilityStoreName))
+}
+
+func (s *reconcilerSuite) newReconciler(report Report) *Reconciler {
+	r := NewReconciler(
+		s.visibilityManager,
+		s.historyClient,
+		s.domainCache,
+		constants.VisibilityModeES,
+		&Config{
+			DomainAllow: dynamicproperties.GetBoolPropertyFnFilteredByDomain(true),
+			RepairDomainAllow: func(string) bool {
+				return s.repairAllowed
+			},
+			SampleRate:     dynamicproperties.GetFloatPropertyFn(1),
+			ClosedLookback: dynamicproperties.GetDurationPropertyFn(time.Hour * 24),
+		},
+		1000,
+		report,
+		metrics.NewClient(tally.NoopScope, metrics.Worker),
+		testlogger.New(s.T()),
+	)
+	r.timeSource = s.timeSource
+	r.sampleFn = func(float64) bool {
+		return s.sampled
+	}
+	r.heartbeatFn = func(context.Context, Report) {}
+	return r
+}
+
+func (s *reconcilerSuite) expectDomains(names ...string) {
+	domains := make(map[string]*cache.DomainCacheEntry)
+	for _, name := range names {
+		id := testDomainID
+		if name != testDomainName {
+			id = name + "-id"
+		}
+		domains[id] = cache.NewLocalDomainCacheEntryForTest(&persistence.DomainInfo{ID: id, Name: name}, &persistence.DomainConfig{}, "active")
+	}
+	s.domainCache.EXPECT().GetAllDomain().Return(domains)
+}
+
+// expectList sets up a page of open records and an empty page of closed records in the given store
+func (s *reconcilerSuite) expectList(store string, records []*types.WorkflowExecutionInfo, nextPageToken []byte) {
+	s.visibilityManager.EXPECT().ListOpenWorkflowExecutions(storeMatcher{store}, gomock.Any()).
+		Return(&persistence.ListWorkflowExecutionsResponse{Executions: records, NextPageToken: nextPageToken}, nil)
+	if len(nextPageToken) != 0 {
+		s.visibilityManager.EXPECT().ListOpenWorkflowExecutions(storeMatcher{store}, gomock.Any()).
+			Return(&persistence.ListWorkflowExecutionsResponse{Executions: records}, nil)
+	}
+	s.visibilityManager.EXPECT().ListClosedWorkflowExecutions(storeMatcher{store}, gomock.Any()).
+		Return(&persistence.ListWorkflowExecutionsResponse{}, nil)
+}
+
+// expectRecord sets up the lookup of the test execution in the given store, nil record means not found
+func (s *reconcilerSuite) expectRecord(store string, record *types.WorkflowExecutionInfo) {
+	if record != nil && record.CloseStatus == nil {
+		s.visibilityManager.EXPECT().ListOpenWorkflowExecutionsByWorkflowID(storeMatcher{store}, gomock.Any()).
+			Return(&persistence.ListWorkflowExecutionsResponse{Executions: []*types.WorkflowExecutionInfo{record}}, nil)
+		return
+	}
+	s.visibilityManager.EXPECT().ListOpenWorkflowExecutionsByWorkflowID(storeMatcher{store}, gomock.Any()).
+		Return(&persistence.ListWorkflowExecutionsResponse{}, nil)
+	if record == nil {
+		s.visibilityManager.EXPECT().GetClosedWorkflowExecution(storeMatcher{store}, gomock.Any()).
+			Return(nil, &types.EntityNotExistsError{})
+		return
+	}
+	s.visibilityManager.EXPECT().GetClosedWorkflowExecution(storeMatcher{store}, gomock.Any()).
+		Return(&persistence.GetClosedWorkflowExecutionResponse{Execution: record}, nil)
+}
+
+func (s *reconcilerSuite) expectMutableState(info *types.WorkflowExecutionInfo) *gomock.Call {
+	return s.historyClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &types.HistoryDescribeWorkflowExecutionRequest{
+		DomainUUID: testDomainID,
+		Request: &types.DescribeWorkflowExecutionRequest{
+			Domain:    testDomainName,
+			Execution: info.GetExecution(),
+		},
+	}).Return(&types.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: info}, nil)
+}
+
+func (s *reconcilerSuite) openRecord() *types.WorkflowExecutionInfo {
+	return &types.WorkflowExecutionInfo{
+		Execution: &types.WorkflowExecution{WorkflowID: testWorkflowID, RunID: testRunID},
+		StartTime: common.Int64Ptr(s.timeSource.Now().Add(-time.Hour).UnixNano()),
+	}
+}
+
+func (s *reconcilerSuite) closedRecord() *types.WorkflowExecutionInfo {
+	info := s.openRecord()
+	info.CloseStatus = types.WorkflowExecutionCloseStatusCompleted.Ptr()
+	info.CloseTime = common.Int64Ptr(s.timeSource.Now().Add(-time.Hour).UnixNano())
+	return info
+}
+
+func (m storeMatcher) Matches(x interface{}) bool {
+	ctx, ok := x.(context.Context)
+	return ok && ctx.Value(persistence.ContextKey) == m.store
+}
+
+func (m storeMatcher) String() string {
+	return fmt.Sprintf("context with visibility store %v", m.store)
+}
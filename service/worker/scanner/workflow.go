@@ -34,6 +34,7 @@ import (
 	"github.com/uber/cadence/service/worker/scanner/history"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 	"github.com/uber/cadence/service/worker/scanner/timers"
+	"github.com/uber/cadence/service/worker/scanner/visibility"
 )
 
 const (
@@ -50,6 +51,11 @@ const (
 	historyScannerWFTypeName     = "cadence-sys-history-scanner-workflow"
 	historyScannerTaskListName   = "cadence-sys-history-scanner-tasklist-0"
 	historyScavengerActivityName = "cadence-sys-history-scanner-scvg-activity"
+
+	visibilityReconcilerWFID         = "cadence-sys-visibility-reconciler"
+	visibilityReconcilerWFTypeName   = "cadence-sys-visibility-reconciler-workflow"
+	visibilityReconcilerTaskListName = "cadence-sys-visibility-reconciler-tasklist-0"
+	visibilityReconcilerActivityName = "cadence-sys-visibility-reconciler-activity"
 )
 
 var (
@@ -81,6 +87,13 @@ var (
 		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
 		CronSchedule:                 "0 */12 * * *",
 	}
+	visibilityReconcilerWFStartOptions = cclient.StartWorkflowOptions{
+		ID:                           visibilityReconcilerWFID,
+		TaskList:                     visibilityReconcilerTaskListName,
+		ExecutionStartToCloseTimeout: infiniteDuration,
+		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
+		CronSchedule:                 "0 */12 * * *",
+	}
 )
 
 func init() {
@@ -90,6 +103,9 @@ func init() {
 	workflow.RegisterWithOptions(HistoryScannerWorkflow, workflow.RegisterOptions{Name: historyScannerWFTypeName})
 	activity.RegisterWithOptions(HistoryScavengerActivity, activity.RegisterOptions{Name: historyScavengerActivityName})
 
+	workflow.RegisterWithOptions(VisibilityReconcilerWorkflow, workflow.RegisterOptions{Name: visibilityReconcilerWFTypeName})
+	activity.RegisterWithOptions(VisibilityReconcilerActivity, activity.RegisterOptions{Name: visibilityReconcilerActivityName})
+
 	workflow.RegisterWithOptions(executions.ConcreteScannerWorkflow, workflow.RegisterOptions{Name: executions.ConcreteExecutionsScannerWFTypeName})
 	workflow.RegisterWithOptions(executions.CurrentScannerWorkflow, workflow.RegisterOptions{Name: executions.CurrentExecutionsScannerWFTypeName})
 	workflow.RegisterWithOptions(executions.ConcreteFixerWorkflow, workflow.RegisterOptions{Name: executions.ConcreteExecutionsFixerWFTypeName})
@@ -152,6 +168,51 @@ func HistoryScavengerActivity(
 	return scavenger.Run(activityCtx)
 }
 
+// VisibilityReconcilerWorkflow is the workflow that runs the visibility reconciler background daemon
+func VisibilityReconcilerWorkflow(
+	ctx workflow.Context,
+) (visibility.Report, error) {
+
+	var report visibility.Report
+	future := workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, activityOptions),
+		visibilityReconcilerActivityName,
+	)
+	err := future.Get(ctx, &report)
+	return report, err
+}
+
+// VisibilityReconcilerActivity is the activity that runs visibility reconciler
+func VisibilityReconcilerActivity(
+	activityCtx context.Context,
+) (visibility.Report, error) {
+
+	ctx, err := getScannerContext(activityCtx)
+	if err != nil {
+		return visibility.Report{}, err
+	}
+	res := ctx.resource
+
+	report := visibility.Report{}
+	if activity.HasHeartbeatDetails(activityCtx) {
+		if err := activity.GetHeartbeatDetails(activityCtx, &report); err != nil {
+			res.GetLogger().Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+		}
+	}
+	reconciler := visibility.NewReconciler(
+		res.GetVisibilityManager(),
+		res.GetHistoryClient(),
+		res.GetDomainCache(),
+		visibility.AdvancedVisibilityMode(ctx.cfg.Persistence.AdvancedVisibilityStore),
+		&ctx.cfg.VisibilityReconcilerOptions,
+		ctx.cfg.ScannerPersistenceMaxQPS(),
+		report,
+		res.GetMetricsClient(),
+		res.GetLogger(),
+	)
+	return reconciler.Run(activityCtx)
+}
+
 // TaskListScavengerActivity is the activity that runs task list scavenger
 func TaskListScavengerActivity(
 	activityCtx context.Context,
@@ -42,6 +42,7 @@ import (
 	"github.com/uber/cadence/service/worker/archiver"
 	"github.com/uber/cadence/service/worker/asyncworkflow"
 	"github.com/uber/cadence/service/worker/batcher"
+	"github.com/uber/cadence/service/worker/completioncallback"
 	"github.com/uber/cadence/service/worker/diagnostics"
 	"github.com/uber/cadence/service/worker/domaindeprecation"
 	"github.com/uber/cadence/service/worker/esanalyzer"
@@ -54,6 +55,7 @@ import (
 	"github.com/uber/cadence/service/worker/scanner/shardscanner"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 	"github.com/uber/cadence/service/worker/scanner/timers"
+	"github.com/uber/cadence/service/worker/scanner/visibility"
 )
 
 type (
@@ -79,6 +81,7 @@ type (
 		ScannerCfg                          *scanner.Config
 		BatcherCfg                          *batcher.Config
 		ESAnalyzerCfg                       *esanalyzer.Config
+		CompletionCallbackCfg               *completioncallback.Config
 		failoverManagerCfg                  *failovermanager.Config
 		ThrottledLogRPS                     dynamicproperties.IntPropertyFn
 		PersistenceGlobalMaxQPS             dynamicproperties.IntPropertyFn
@@ -86,27 +89,45 @@ type (
 		EnableBatcher                       dynamicproperties.BoolPropertyFn
 		EnableParentClosePolicyWorker       dynamicproperties.BoolPropertyFn
 		NumParentClosePolicySystemWorkflows dynamicproperties.IntPropertyFn
+		EnableCompletionCallbackWorker      dynamicproperties.BoolPropertyFn
 		EnableFailoverManager               dynamicproperties.BoolPropertyFn
 		DomainReplicationMaxRetryDuration   dynamicproperties.DurationPropertyFn
 		EnableESAnalyzer                    dynamicproperties.BoolPropertyFn
 		EnableAsyncWorkflowConsumption      dynamicproperties.BoolPropertyFn
 		HostName                            string
+
+		// read only visibility config, only used by visibility reconciler
+		ReadVisibilityStoreName         dynamicproperties.StringPropertyFnWithDomainFilter
+		EnableReadFromClosedExecutionV2 dynamicproperties.BoolPropertyFn
+		ESIndexMaxResultWindow          dynamicproperties.IntPropertyFn
+		ValidSearchAttributes           dynamicproperties.MapPropertyFn
+		PinotOptimizedQueryColumns      dynamicproperties.MapPropertyFn
 	}
 )
 
 // NewService builds a new cadence-worker service
 func NewService(params *resource.Params) (resource.Resource, error) {
 	serviceConfig := NewConfig(params)
+	resourceConfig := &service.Config{
+		PersistenceMaxQPS:        serviceConfig.PersistenceMaxQPS,
+		PersistenceGlobalMaxQPS:  serviceConfig.PersistenceGlobalMaxQPS,
+		ThrottledLoggerMaxRPS:    serviceConfig.ThrottledLogRPS,
+		IsErrorRetryableFunction: common.IsServiceTransientError,
+		// worker service doesn't need visibility config unless visibility reconciler is enabled,
+		// which only reads from visibility stores
+	}
+	if serviceConfig.ScannerCfg.VisibilityReconcilerEnabled() {
+		resourceConfig.WriteVisibilityStoreName = nil // worker service never write
+		resourceConfig.ReadVisibilityStoreName = serviceConfig.ReadVisibilityStoreName
+		resourceConfig.EnableReadDBVisibilityFromClosedExecutionV2 = serviceConfig.EnableReadFromClosedExecutionV2
+		resourceConfig.ESIndexMaxResultWindow = serviceConfig.ESIndexMaxResultWindow
+		resourceConfig.ValidSearchAttributes = serviceConfig.ValidSearchAttributes
+		resourceConfig.PinotOptimizedQueryColumns = serviceConfig.PinotOptimizedQueryColumns
+	}
 	serviceResource, err := resource.New(
 		params,
 		service.Worker,
-		&service.Config{
-			PersistenceMaxQPS:        serviceConfig.PersistenceMaxQPS,
-			PersistenceGlobalMaxQPS:  serviceConfig.PersistenceGlobalMaxQPS,
-			ThrottledLoggerMaxRPS:    serviceConfig.ThrottledLogRPS,
-			IsErrorRetryableFunction: common.IsServiceTransientError,
-			// worker service doesn't need visibility config as it never call visibilityManager API
-		},
+		resourceConfig,
 	)
 	if err != nil {
 		return nil, err
@@ -153,7 +174,14 @@ func NewConfig(params *resource.Params) *Config {
 				executions.CurrentExecutionConfig(dc),
 				timers.ScannerConfig(dc),
 			},
-			MaxWorkflowRetentionInDays: dc.GetIntProperty(dynamicproperties.MaxRetentionDays),
+			MaxWorkflowRetentionInDays:  dc.GetIntProperty(dynamicproperties.MaxRetentionDays),
+			VisibilityReconcilerEnabled: dc.GetBoolProperty(dynamicproperties.VisibilityReconcilerEnabled),
+			VisibilityReconcilerOptions: visibility.Config{
+				DomainAllow:       dc.GetBoolPropertyFilteredByDomain(dynamicproperties.VisibilityReconcilerDomainAllow),
+				RepairDomainAllow: dc.GetBoolPropertyFilteredByDomain(dynamicproperties.VisibilityReconcilerRepairDomainAllow),
+				SampleRate:        dc.GetFloat64Property(dynamicproperties.VisibilityReconcilerSampleRate),
+				ClosedLookback:    dc.GetDurationProperty(dynamicproperties.VisibilityReconcilerClosedLookback),
+			},
 		},
 		KafkaCfg: params.KafkaConfig,
 		BatcherCfg: &batcher.Config{
@@ -179,9 +207,14 @@ func NewConfig(params *resource.Params) *Config {
 			ESAnalyzerWorkflowVersionDomains:         dc.GetStringProperty(dynamicproperties.ESAnalyzerWorkflowVersionMetricDomains),
 			ESAnalyzerWorkflowTypeDomains:            dc.GetStringProperty(dynamicproperties.ESAnalyzerWorkflowTypeMetricDomains),
 		},
+		CompletionCallbackCfg: &completioncallback.Config{
+			MaxAttempts:  dc.GetIntPropertyFilteredByDomain(dynamicproperties.CompletionCallbackMaxAttempts),
+			AllowedHosts: dc.GetMapPropertyFilteredByDomain(dynamicproperties.CompletionCallbackAllowedHosts),
+		},
 		EnableBatcher:                       dc.GetBoolProperty(dynamicproperties.EnableBatcher),
 		EnableParentClosePolicyWorker:       dc.GetBoolProperty(dynamicproperties.EnableParentClosePolicyWorker),
 		NumParentClosePolicySystemWorkflows: dc.GetIntProperty(dynamicproperties.NumParentClosePolicySystemWorkflows),
+		EnableCompletionCallbackWorker:      dc.GetBoolProperty(dynamicproperties.EnableCompletionCallbackWorker),
 		EnableESAnalyzer:                    dc.GetBoolProperty(dynamicproperties.EnableESAnalyzer),
 		EnableFailoverManager:               dc.GetBoolProperty(dynamicproperties.EnableFailoverManager),
 		ThrottledLogRPS:                     dc.GetIntProperty(dynamicproperties.WorkerThrottledLogRPS),
@@ -190,6 +223,11 @@ func NewConfig(params *resource.Params) *Config {
 		DomainReplicationMaxRetryDuration:   dc.GetDurationProperty(dynamicproperties.WorkerReplicationTaskMaxRetryDuration),
 		EnableAsyncWorkflowConsumption:      dc.GetBoolProperty(dynamicproperties.EnableAsyncWorkflowConsumption),
 		HostName:                            params.HostName,
+		ReadVisibilityStoreName:             dc.GetStringPropertyFilteredByDomain(dynamicproperties.ReadVisibilityStoreName),
+		EnableReadFromClosedExecutionV2:     dc.GetBoolProperty(dynamicproperties.EnableReadFromClosedExecutionV2),
+		ESIndexMaxResultWindow:              dc.GetIntProperty(dynamicproperties.FrontendESIndexMaxResultWindow),
+		ValidSearchAttributes:               dc.GetMapProperty(dynamicproperties.ValidSearchAttributes),
+		PinotOptimizedQueryColumns:          dc.GetMapProperty(dynamicproperties.PinotOptimizedQueryColumns),
 	}
 	advancedVisWritingMode := dc.GetStringProperty(
 		dynamicproperties.WriteVisibilityStoreName,
@@ -246,6 +284,9 @@ func (s *Service) Start() {
 	if s.config.EnableParentClosePolicyWorker() {
 		s.startParentClosePolicyProcessor()
 	}
+	if s.config.EnableCompletionCallbackWorker() {
+		s.startCompletionCallbackProcessor()
+	}
 	if s.config.EnableESAnalyzer() {
 		s.startESAnalyzer()
 	}
@@ -292,6 +333,21 @@ func (s *Service) startParentClosePolicyProcessor() {
 	}
 }
 
+func (s *Service) startCompletionCallbackProcessor() {
+	processor := completioncallback.New(completioncallback.Params{
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.GetMetricsClient(),
+		TallyScope:    s.params.MetricScope,
+		Logger:        s.GetLogger(),
+		ClientBean:    s.GetClientBean(),
+		DomainCache:   s.GetDomainCache(),
+		Config:        s.config.CompletionCallbackCfg,
+	})
+	if err := processor.Start(); err != nil {
+		s.GetLogger().Fatal("error starting completioncallback processor", tag.Error(err))
+	}
+}
+
 func (s *Service) startESAnalyzer() {
 	esClient := s.params.ESClient
 	esConfig := s.params.ESConfig
@@ -471,6 +527,8 @@ func (s *Service) startAsyncWorkflowConsumerManager() common.Daemon {
 		s.Resource.GetAsyncWorkflowQueueProvider(),
 		s.GetFrontendClient(),
 		asyncworkflow.WithEnabledPropertyFn(s.config.EnableAsyncWorkflowConsumption),
+		asyncworkflow.WithAsyncRequestManager(s.GetPersistenceBean().GetAsyncRequestManager()),
+		asyncworkflow.WithMapQManager(s.GetPersistenceBean().GetMapQManager()),
 	)
 	cm.Start()
 	return cm
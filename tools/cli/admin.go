@@ -744,6 +744,75 @@ func newAdminTaskListCommands() []*cli.Command {
 			},
 			Action: AdminUpdateTaskListPartitionConfig,
 		},
+		{
+			Name:    "update-versioning",
+			Aliases: []string{"uv"},
+			Usage:   "Update worker build ID versioning config of a decision tasklist",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagTaskList,
+					Aliases: []string{"tl"},
+					Usage:   "TaskList Name",
+				},
+				&cli.StringFlag{
+					Name:    FlagAddNewDefaultBuildID,
+					Aliases: []string{"and"},
+					Usage:   "Add the build ID in a new compatible set and make it the default set. New workflows are dispatched to the default set",
+				},
+				&cli.StringFlag{
+					Name:    FlagAddCompatibleBuildID,
+					Aliases: []string{"ac"},
+					Usage:   "Add the build ID to the compatible set of the build ID specified by --" + FlagExistingCompatibleBuildID,
+				},
+				&cli.StringFlag{
+					Name:    FlagExistingCompatibleBuildID,
+					Aliases: []string{"ec"},
+					Usage:   "An already registered build ID the build ID added by --" + FlagAddCompatibleBuildID + " is compatible with",
+				},
+				&cli.BoolFlag{
+					Name:    FlagMakeDefault,
+					Aliases: []string{"md"},
+					Usage:   "Make the compatible set of the build ID added by --" + FlagAddCompatibleBuildID + " the default set",
+				},
+				&cli.StringFlag{
+					Name:    FlagPromoteBuildID,
+					Aliases: []string{"pr"},
+					Usage:   "Make the compatible set of the build ID the default set, used both to promote a new build and to roll back to a previous one",
+				},
+			},
+			Action: AdminUpdateTaskListVersioningConfig,
+		},
+		{
+			Name:    "migrate",
+			Aliases: []string{"mg"},
+			Usage:   "Move the backlog of a tasklist to another tasklist, or show the progress of a migration when neither --" + FlagTargetTaskList + " nor --" + FlagStop + " is specified",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagTaskList,
+					Aliases: []string{"tl"},
+					Usage:   "TaskList Name",
+				},
+				&cli.StringFlag{
+					Name:    FlagTaskListType,
+					Aliases: []string{"tlt"},
+					Usage:   "TaskList type [decision|activity]",
+				},
+				&cli.StringFlag{
+					Name:    FlagTargetTaskList,
+					Aliases: []string{"ttl"},
+					Usage:   "Name of the tasklist the backlog is moved to",
+				},
+				&cli.BoolFlag{
+					Name:  FlagAlias,
+					Usage: "Also write new activity tasks of the tasklist to the target tasklist, so workers can move to the target",
+				},
+				&cli.BoolFlag{
+					Name:  FlagStop,
+					Usage: "Stop the migration of the tasklist",
+				},
+			},
+			Action: AdminMigrateTaskList,
+		},
 	}
 }
 
@@ -922,6 +991,61 @@ func newAdminAsyncQueueCommands() []*cli.Command {
 			},
 			Action: AdminUpdateAsyncWFConfig,
 		},
+		{
+			Name:    "describe",
+			Aliases: []string{"desc"},
+			Usage:   "describe the async workflow queue of a domain and the committed offsets of its partitions",
+			Action:  AdminDescribeAsyncWFQueue,
+		},
+		{
+			Name:        "dlq",
+			Usage:       "Inspect and replay async workflow requests of a domain that failed processing",
+			Subcommands: newAdminAsyncQueueDLQCommands(),
+		},
+	}
+}
+
+func newAdminAsyncQueueDLQCommands() []*cli.Command {
+	requestIDsFlags := []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:    FlagRequestID,
+			Aliases: []string{"rid"},
+			Usage:   "Request IDs of the messages (example: --rid id1 --rid id2)",
+		},
+		&cli.BoolFlag{
+			Name:  FlagAll,
+			Usage: "Apply to all messages in the DLQ of the domain",
+		},
+	}
+	return []*cli.Command{
+		{
+			Name:    "read",
+			Aliases: []string{"r"},
+			Usage:   "Read the messages in the async workflow DLQ of a domain",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:    FlagMaxMessageCount,
+					Aliases: []string{"mmc"},
+					Usage:   "Max number of messages to fetch",
+					Value:   100,
+				},
+			},
+			Action: AdminReadAsyncWFDLQMessages,
+		},
+		{
+			Name:    "purge",
+			Aliases: []string{"p"},
+			Usage:   "Delete messages from the async workflow DLQ of a domain",
+			Flags:   requestIDsFlags,
+			Action:  AdminPurgeAsyncWFDLQMessages,
+		},
+		{
+			Name:    "replay",
+			Aliases: []string{"rp"},
+			Usage:   "Publish messages from the async workflow DLQ of a domain back to its async workflow queue",
+			Flags:   requestIDsFlags,
+			Action:  AdminReplayAsyncWFDLQMessages,
+		},
 	}
 }
 
@@ -1365,6 +1489,86 @@ func newAdminConfigStoreCommands() []*cli.Command {
 	}
 }
 
+func newAdminShardDistributorCommands() []*cli.Command {
+	addressFlag := &cli.StringFlag{
+		Name:    FlagShardDistributorAddress,
+		Usage:   "host:port for the shard distributor service, defaults to " + shardDistributorGRPCPort,
+		EnvVars: []string{"CADENCE_CLI_SHARD_DISTRIBUTOR_ADDRESS"},
+	}
+	namespaceFlag := &cli.StringFlag{
+		Name:     FlagNamespace,
+		Usage:    "Shard distributor namespace",
+		Required: true,
+	}
+	executorFlag := &cli.StringFlag{
+		Name:     FlagExecutorID,
+		Usage:    "ID of the executor",
+		Required: true,
+	}
+	reasonFlag := &cli.StringFlag{
+		Name:     FlagReason,
+		Aliases:  []string{"re"},
+		Usage:    "Reason for the change, recorded in the namespace audit log",
+		Required: true,
+	}
+	return []*cli.Command{
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List the namespaces managed by the shard distributor",
+			Flags:   []cli.Flag{addressFlag, getFormatFlag()},
+			Action:  AdminShardDistributorListNamespaces,
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"d"},
+			Usage:   "Describe the executors, shard assignments, pending moves and audit log of a namespace",
+			Flags:   []cli.Flag{addressFlag, namespaceFlag, getFormatFlag()},
+			Action:  AdminShardDistributorDescribeNamespace,
+		},
+		{
+			Name:  "move-shard",
+			Usage: "Move a shard to another active executor",
+			Flags: []cli.Flag{
+				addressFlag,
+				namespaceFlag,
+				&cli.StringFlag{
+					Name:     FlagShardKey,
+					Usage:    "Key of the shard to move",
+					Required: true,
+				},
+				executorFlag,
+				reasonFlag,
+			},
+			Action: AdminShardDistributorMoveShard,
+		},
+		{
+			Name:   "drain",
+			Usage:  "Move all shards off an executor and stop assigning shards to it",
+			Flags:  []cli.Flag{addressFlag, namespaceFlag, executorFlag, reasonFlag},
+			Action: AdminShardDistributorDrainExecutor,
+		},
+		{
+			Name:   "undrain",
+			Usage:  "Allow shards to be assigned to a drained executor again",
+			Flags:  []cli.Flag{addressFlag, namespaceFlag, executorFlag, reasonFlag},
+			Action: AdminShardDistributorUndrainExecutor,
+		},
+		{
+			Name:   "freeze",
+			Usage:  "Stop the shard distributor from changing the shard assignments of a namespace",
+			Flags:  []cli.Flag{addressFlag, namespaceFlag, reasonFlag},
+			Action: AdminShardDistributorFreezeRebalancing,
+		},
+		{
+			Name:   "unfreeze",
+			Usage:  "Resume rebalancing of a frozen namespace",
+			Flags:  []cli.Flag{addressFlag, namespaceFlag, reasonFlag},
+			Action: AdminShardDistributorUnfreezeRebalancing,
+		},
+	}
+}
+
 func newAdminIsolationGroupCommands() []*cli.Command {
 	return []*cli.Command{
 		{
@@ -1384,7 +1588,7 @@ func newAdminIsolationGroupCommands() []*cli.Command {
 			Flags: []cli.Flag{
 				&cli.StringFlag{
 					Name:     FlagJSON,
-					Usage:    `the configurations to upsert: eg: [{"Name": "zone-1": "State": 2}]. To remove groups, specify an empty configuration`,
+					Usage:    `the configurations to upsert: eg: [{"Name": "zone-1": "State": 2}], or [{"Name": "zone-1", "State": 3, "Weight": 50}] to keep 50% of the traffic. To remove groups, specify an empty configuration`,
 					Required: false,
 				},
 				&cli.StringSliceFlag{
@@ -1418,7 +1622,7 @@ func newAdminIsolationGroupCommands() []*cli.Command {
 			Flags: []cli.Flag{
 				&cli.StringFlag{
 					Name:     FlagJSON,
-					Usage:    `the configurations to upsert: eg: [{"Name": "zone-1": "State": 2}]. To remove groups, specify an empty configuration`,
+					Usage:    `the configurations to upsert: eg: [{"Name": "zone-1": "State": 2}], or [{"Name": "zone-1", "State": 3, "Weight": 50}] to keep 50% of the traffic. To remove groups, specify an empty configuration`,
 					Required: false,
 				},
 				&cli.StringSliceFlag{
@@ -1434,5 +1638,37 @@ func newAdminIsolationGroupCommands() []*cli.Command {
 			},
 			Action: AdminUpdateDomainIsolationGroups,
 		},
+		{
+			Name:   "ramp-global",
+			Usage:  "gradually shifts traffic out of (or back into) an isolation group globally by applying a schedule of traffic weights",
+			Flags:  getIsolationGroupRampFlags(),
+			Action: AdminRampGlobalIsolationGroup,
+		},
+		{
+			Name:   "ramp-domain",
+			Usage:  "gradually shifts traffic out of (or back into) an isolation group for a domain by applying a schedule of traffic weights",
+			Flags:  getIsolationGroupRampFlags(),
+			Action: AdminRampDomainIsolationGroup,
+		},
+	}
+}
+
+func getIsolationGroupRampFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:     FlagIsolationGroup,
+			Usage:    "the isolation group to ramp",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     FlagIsolationGroupRampSchedule,
+			Usage:    "comma separated traffic weights (0-100) to apply in order, eg: 75,50,25,0 to drain the group in four steps. A weight of 0 drains the group, 100 restores it to healthy",
+			Required: true,
+		},
+		&cli.DurationFlag{
+			Name:  FlagIsolationGroupRampInterval,
+			Usage: "how long the server applies each step of the ramp schedule for",
+			Value: 5 * time.Minute,
+		},
 	}
 }
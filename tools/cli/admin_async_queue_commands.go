@@ -25,6 +25,7 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/urfave/cli/v2"
 
@@ -107,3 +108,197 @@ func AdminUpdateAsyncWFConfig(c *cli.Context) error {
 	fmt.Printf("Successfully updated async workflow queue config for domain %s\n", domainName)
 	return nil
 }
+
+// AsyncWFQueuePartitionRow is a row of the async workflow queue partitions table
+type AsyncWFQueuePartitionRow struct {
+	Path     string `header:"Partition"`
+	AckLevel int64  `header:"Ack Level"`
+}
+
+// AdminDescribeAsyncWFQueue prints the async workflow queue of a domain and the committed offsets of its partitions
+func AdminDescribeAsyncWFQueue(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	domainName, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not present:", err)
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	resp, err := adminClient.DescribeAsyncWorkflowQueue(ctx, &types.DescribeAsyncWorkflowQueueRequest{
+		Domain: domainName,
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to describe async workflow queue", err)
+	}
+
+	fmt.Printf("Async workflow queue of domain %s: %s\n", domainName, resp.GetQueueID())
+	if len(resp.GetPartitions()) == 0 {
+		return nil
+	}
+	table := make([]AsyncWFQueuePartitionRow, 0, len(resp.GetPartitions()))
+	for _, partition := range resp.GetPartitions() {
+		table = append(table, AsyncWFQueuePartitionRow{
+			Path:     partition.GetPath(),
+			AckLevel: partition.GetAckLevel(),
+		})
+	}
+	return RenderTable(getDeps(c).Output(), table, RenderOptions{Color: true})
+}
+
+// AsyncWFDLQMessageRow is a row of the async workflow DLQ messages table
+type AsyncWFDLQMessageRow struct {
+	RequestID   string    `header:"Request ID"`
+	RequestType string    `header:"Request Type"`
+	WorkflowID  string    `header:"Workflow ID"`
+	CreatedTime time.Time `header:"Created Time"`
+	Failure     string    `header:"Failure"`
+}
+
+// AdminReadAsyncWFDLQMessages prints the messages in the async workflow DLQ of a domain
+func AdminReadAsyncWFDLQMessages(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	domainName, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not present:", err)
+	}
+	remainingMessageCount := c.Int(FlagMaxMessageCount)
+	if remainingMessageCount <= 0 {
+		return commoncli.Problem(fmt.Sprintf("Invalid value for --%s", FlagMaxMessageCount), nil)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	var table []AsyncWFDLQMessageRow
+	var pageToken []byte
+	for remainingMessageCount > 0 {
+		resp, err := adminClient.ReadAsyncWorkflowDLQMessages(ctx, &types.ReadAsyncWorkflowDLQMessagesRequest{
+			Domain:        domainName,
+			PageSize:      int32(remainingMessageCount),
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return commoncli.Problem("Failed to read async workflow DLQ messages", err)
+		}
+		for _, msg := range resp.GetMessages() {
+			table = append(table, AsyncWFDLQMessageRow{
+				RequestID:   msg.GetRequestID(),
+				RequestType: msg.RequestType,
+				WorkflowID:  msg.WorkflowID,
+				CreatedTime: time.Unix(0, msg.GetCreatedTimeNano()),
+				Failure:     msg.Failure,
+			})
+		}
+		remainingMessageCount -= len(resp.GetMessages())
+		pageToken = resp.GetNextPageToken()
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+
+	if len(table) == 0 {
+		fmt.Printf("No messages in the async workflow DLQ of domain %s\n", domainName)
+		return nil
+	}
+	return RenderTable(getDeps(c).Output(), table, RenderOptions{Color: true})
+}
+
+// AdminPurgeAsyncWFDLQMessages deletes messages from the async workflow DLQ of a domain
+func AdminPurgeAsyncWFDLQMessages(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	domainName, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not present:", err)
+	}
+	requestIDs, err := getAsyncWFDLQRequestIDs(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	_, err = adminClient.PurgeAsyncWorkflowDLQMessages(ctx, &types.PurgeAsyncWorkflowDLQMessagesRequest{
+		Domain:     domainName,
+		RequestIDs: requestIDs,
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to purge async workflow DLQ messages", err)
+	}
+
+	fmt.Printf("Successfully purged async workflow DLQ messages of domain %s\n", domainName)
+	return nil
+}
+
+// AdminReplayAsyncWFDLQMessages publishes messages from the async workflow DLQ of a domain back to its async workflow queue
+func AdminReplayAsyncWFDLQMessages(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	domainName, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not present:", err)
+	}
+	requestIDs, err := getAsyncWFDLQRequestIDs(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	_, err = adminClient.ReplayAsyncWorkflowDLQMessages(ctx, &types.ReplayAsyncWorkflowDLQMessagesRequest{
+		Domain:     domainName,
+		RequestIDs: requestIDs,
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to replay async workflow DLQ messages", err)
+	}
+
+	fmt.Printf("Successfully replayed async workflow DLQ messages of domain %s\n", domainName)
+	return nil
+}
+
+// getAsyncWFDLQRequestIDs returns the request IDs to act on, an empty list meaning all messages of the DLQ
+func getAsyncWFDLQRequestIDs(c *cli.Context) ([]string, error) {
+	requestIDs := c.StringSlice(FlagRequestID)
+	all := c.Bool(FlagAll)
+	if len(requestIDs) == 0 && !all {
+		return nil, commoncli.Problem(fmt.Sprintf("Either --%s or --%s must be specified", FlagRequestID, FlagAll), nil)
+	}
+	if len(requestIDs) > 0 && all {
+		return nil, commoncli.Problem(fmt.Sprintf("Only one of --%s and --%s can be specified", FlagRequestID, FlagAll), nil)
+	}
+	if all {
+		// an empty list selects every message in the DLQ
+		return nil, nil
+	}
+	return requestIDs, nil
+}
@@ -235,3 +235,257 @@ func TestAdminUpdateAsyncWFConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestAdminDescribeAsyncWFQueue(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMocks    func(*admin.MockClient)
+		flagDomain    string
+		expectedError string
+		expectedStr   string
+	}{
+		{
+			name: "Success",
+			setupMocks: func(client *admin.MockClient) {
+				client.EXPECT().DescribeAsyncWorkflowQueue(gomock.Any(), &types.DescribeAsyncWorkflowQueueRequest{Domain: "test-domain"}).Return(&types.DescribeAsyncWorkflowQueueResponse{
+					QueueID: "mapq::queue",
+					Partitions: []*types.AsyncWorkflowQueuePartition{
+						{Path: "*/./.", AckLevel: 3},
+						{Path: "*/test-domain/.", AckLevel: 7},
+					},
+				}, nil)
+			},
+			flagDomain:  "test-domain",
+			expectedStr: "*/test-domain/.",
+		},
+		{
+			name: "Queue without partitions",
+			setupMocks: func(client *admin.MockClient) {
+				client.EXPECT().DescribeAsyncWorkflowQueue(gomock.Any(), gomock.Any()).Return(&types.DescribeAsyncWorkflowQueueResponse{QueueID: "database::queue"}, nil)
+			},
+			flagDomain: "test-domain",
+		},
+		{
+			name:          "Required flag not present",
+			setupMocks:    func(client *admin.MockClient) {},
+			expectedError: "Required flag not present:",
+		},
+		{
+			name: "Failed to describe queue",
+			setupMocks: func(client *admin.MockClient) {
+				client.EXPECT().DescribeAsyncWorkflowQueue(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("describe failed"))
+			},
+			flagDomain:    "test-domain",
+			expectedError: "Failed to describe async workflow queue",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			adminClient := admin.NewMockClient(mockCtrl)
+			tt.setupMocks(adminClient)
+			ioHandler := &testIOHandler{}
+			app := NewCliApp(&clientFactoryMock{
+				serverAdminClient: adminClient,
+			}, WithIOHandler(ioHandler))
+
+			set := flag.NewFlagSet("test", 0)
+			set.String(FlagDomain, tt.flagDomain, "Domain flag")
+			c := cli.NewContext(app, set, nil)
+
+			err := AdminDescribeAsyncWFQueue(c)
+
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Contains(t, ioHandler.outputBytes.String(), tt.expectedStr)
+			}
+		})
+	}
+}
+
+func TestAdminReadAsyncWFDLQMessages(t *testing.T) {
+	tests := []struct {
+		name            string
+		setupMocks      func(*admin.MockClient)
+		flagDomain      string
+		maxMessageCount int
+		expectedError   string
+		expectedStr     string
+	}{
+		{
+			name: "Success across pages",
+			setupMocks: func(client *admin.MockClient) {
+				gomock.InOrder(
+					client.EXPECT().ReadAsyncWorkflowDLQMessages(gomock.Any(), &types.ReadAsyncWorkflowDLQMessagesRequest{
+						Domain:   "test-domain",
+						PageSize: 3,
+					}).Return(&types.ReadAsyncWorkflowDLQMessagesResponse{
+						Messages: []*types.AsyncWorkflowDLQMessage{
+							{RequestID: "request-1", RequestType: "StartWorkflowExecutionAsyncRequest", WorkflowID: "wid-1", Failure: "bad request"},
+						},
+						NextPageToken: []byte("token"),
+					}, nil),
+					client.EXPECT().ReadAsyncWorkflowDLQMessages(gomock.Any(), &types.ReadAsyncWorkflowDLQMessagesRequest{
+						Domain:        "test-domain",
+						PageSize:      2,
+						NextPageToken: []byte("token"),
+					}).Return(&types.ReadAsyncWorkflowDLQMessagesResponse{
+						Messages: []*types.AsyncWorkflowDLQMessage{
+							{RequestID: "request-2", RequestType: "SignalWorkflowExecutionAsyncRequest", WorkflowID: "wid-2", Failure: "entity not exists"},
+						},
+					}, nil),
+				)
+			},
+			flagDomain:      "test-domain",
+			maxMessageCount: 3,
+			expectedStr:     "request-2",
+		},
+		{
+			name:            "Required flag not present",
+			setupMocks:      func(client *admin.MockClient) {},
+			maxMessageCount: 3,
+			expectedError:   "Required flag not present:",
+		},
+		{
+			name:          "Invalid max message count",
+			setupMocks:    func(client *admin.MockClient) {},
+			flagDomain:    "test-domain",
+			expectedError: "Invalid value for --max_message_count",
+		},
+		{
+			name: "Failed to read messages",
+			setupMocks: func(client *admin.MockClient) {
+				client.EXPECT().ReadAsyncWorkflowDLQMessages(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("read failed"))
+			},
+			flagDomain:      "test-domain",
+			maxMessageCount: 3,
+			expectedError:   "Failed to read async workflow DLQ messages",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			adminClient := admin.NewMockClient(mockCtrl)
+			tt.setupMocks(adminClient)
+			ioHandler := &testIOHandler{}
+			app := NewCliApp(&clientFactoryMock{
+				serverAdminClient: adminClient,
+			}, WithIOHandler(ioHandler))
+
+			set := flag.NewFlagSet("test", 0)
+			set.String(FlagDomain, tt.flagDomain, "Domain flag")
+			set.Int(FlagMaxMessageCount, tt.maxMessageCount, "Max message count flag")
+			c := cli.NewContext(app, set, nil)
+
+			err := AdminReadAsyncWFDLQMessages(c)
+
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Contains(t, ioHandler.outputBytes.String(), "request-1")
+				assert.Contains(t, ioHandler.outputBytes.String(), tt.expectedStr)
+			}
+		})
+	}
+}
+
+func TestAdminPurgeAndReplayAsyncWFDLQMessages(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMocks    func(*admin.MockClient)
+		flagDomain    string
+		requestIDs    []string
+		all           bool
+		replay        bool
+		expectedError string
+	}{
+		{
+			name: "Purge given requests",
+			setupMocks: func(client *admin.MockClient) {
+				client.EXPECT().PurgeAsyncWorkflowDLQMessages(gomock.Any(), &types.PurgeAsyncWorkflowDLQMessagesRequest{
+					Domain:     "test-domain",
+					RequestIDs: []string{"request-1", "request-2"},
+				}).Return(&types.PurgeAsyncWorkflowDLQMessagesResponse{}, nil)
+			},
+			flagDomain: "test-domain",
+			requestIDs: []string{"request-1", "request-2"},
+		},
+		{
+			name: "Replay all requests",
+			setupMocks: func(client *admin.MockClient) {
+				client.EXPECT().ReplayAsyncWorkflowDLQMessages(gomock.Any(), &types.ReplayAsyncWorkflowDLQMessagesRequest{
+					Domain: "test-domain",
+				}).Return(&types.ReplayAsyncWorkflowDLQMessagesResponse{}, nil)
+			},
+			flagDomain: "test-domain",
+			all:        true,
+			replay:     true,
+		},
+		{
+			name:          "Required flag not present",
+			setupMocks:    func(client *admin.MockClient) {},
+			all:           true,
+			expectedError: "Required flag not present:",
+		},
+		{
+			name:          "Neither request IDs nor all",
+			setupMocks:    func(client *admin.MockClient) {},
+			flagDomain:    "test-domain",
+			replay:        true,
+			expectedError: "Either --request_id or --all must be specified",
+		},
+		{
+			name:          "Both request IDs and all",
+			setupMocks:    func(client *admin.MockClient) {},
+			flagDomain:    "test-domain",
+			requestIDs:    []string{"request-1"},
+			all:           true,
+			expectedError: "Only one of --request_id and --all can be specified",
+		},
+		{
+			name: "Failed to replay",
+			setupMocks: func(client *admin.MockClient) {
+				client.EXPECT().ReplayAsyncWorkflowDLQMessages(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("replay failed"))
+			},
+			flagDomain:    "test-domain",
+			requestIDs:    []string{"request-1"},
+			replay:        true,
+			expectedError: "Failed to replay async workflow DLQ messages",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			adminClient := admin.NewMockClient(mockCtrl)
+			tt.setupMocks(adminClient)
+			app := NewCliApp(&clientFactoryMock{
+				serverAdminClient: adminClient,
+			}, WithIOHandler(&testIOHandler{}))
+
+			set := flag.NewFlagSet("test", 0)
+			set.String(FlagDomain, tt.flagDomain, "Domain flag")
+			set.Var(cli.NewStringSlice(tt.requestIDs...), FlagRequestID, "Request ID flag")
+			set.Bool(FlagAll, tt.all, "All flag")
+			c := cli.NewContext(app, set, nil)
+
+			var err error
+			if tt.replay {
+				err = AdminReplayAsyncWFDLQMessages(c)
+			} else {
+				err = AdminPurgeAsyncWFDLQMessages(c)
+			}
+
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -37,6 +37,7 @@ import (
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/client/sharddistributor"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/constants"
 	"github.com/uber/cadence/common/persistence"
@@ -57,12 +58,13 @@ const (
 )
 
 type cliTestData struct {
-	ctrl               *gomock.Controller
-	mockFrontendClient *frontend.MockClient
-	mockAdminClient    *admin.MockClient
-	ioHandler          *testIOHandler
-	app                *cli.App
-	mockManagerFactory *MockManagerFactory
+	ctrl                       *gomock.Controller
+	mockFrontendClient         *frontend.MockClient
+	mockAdminClient            *admin.MockClient
+	mockShardDistributorClient *sharddistributor.MockClient
+	ioHandler                  *testIOHandler
+	app                        *cli.App
+	mockManagerFactory         *MockManagerFactory
 }
 
 func newCLITestData(t *testing.T) *cliTestData {
@@ -72,14 +74,16 @@ func newCLITestData(t *testing.T) *cliTestData {
 
 	td.mockFrontendClient = frontend.NewMockClient(td.ctrl)
 	td.mockAdminClient = admin.NewMockClient(td.ctrl)
+	td.mockShardDistributorClient = sharddistributor.NewMockClient(td.ctrl)
 	td.mockManagerFactory = NewMockManagerFactory(td.ctrl)
 	td.ioHandler = &testIOHandler{}
 
 	// Create a new CLI app with client factory and persistence manager factory
 	td.app = NewCliApp(
 		&clientFactoryMock{
-			serverFrontendClient: td.mockFrontendClient,
-			serverAdminClient:    td.mockAdminClient,
+			serverFrontendClient:   td.mockFrontendClient,
+			serverAdminClient:      td.mockAdminClient,
+			shardDistributorClient: td.mockShardDistributorClient,
 		},
 		WithIOHandler(td.ioHandler),
 		WithManagerFactory(td.mockManagerFactory), // Inject the mocked persistence manager factory
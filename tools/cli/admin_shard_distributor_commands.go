@@ -0,0 +1,339 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/common/commoncli"
+)
+
+// ShardDistributorNamespaceRow is a row of the shard distributor namespaces table
+type ShardDistributorNamespaceRow struct {
+	Name     string `header:"Namespace"`
+	Type     string `header:"Type"`
+	ShardNum int64  `header:"Shards"`
+}
+
+// ShardDistributorExecutorRow is a row of the shard distributor executors table
+type ShardDistributorExecutorRow struct {
+	ExecutorID    string    `header:"Executor"`
+	State         string    `header:"State"`
+	LastHeartbeat time.Time `header:"Last Heartbeat"`
+	Drained       bool      `header:"Drained"`
+	Shards        int       `header:"Shards"`
+	Load          float64   `header:"Load"`
+}
+
+// ShardDistributorAssignmentRow is a row of the shard distributor assignment table
+type ShardDistributorAssignmentRow struct {
+	ShardKey   string    `header:"Shard"`
+	ExecutorID string    `header:"Executor"`
+	AssignedAt time.Time `header:"Assigned At"`
+	Status     string    `header:"Status"`
+	Load       float64   `header:"Load"`
+}
+
+// ShardDistributorShardMoveRow is a row of the pending shard moves table
+type ShardDistributorShardMoveRow struct {
+	ShardKey    string    `header:"Shard"`
+	ExecutorID  string    `header:"Target Executor"`
+	RequestedAt time.Time `header:"Requested At"`
+}
+
+// ShardDistributorAuditRow is a row of the namespace audit log table
+type ShardDistributorAuditRow struct {
+	Time      time.Time `header:"Time"`
+	Identity  string    `header:"Identity"`
+	Operation string    `header:"Operation"`
+	Reason    string    `header:"Reason"`
+}
+
+// AdminShardDistributorListNamespaces lists the namespaces managed by the shard distributor
+func AdminShardDistributorListNamespaces(c *cli.Context) error {
+	client, err := getDeps(c).ShardDistributorClient(c)
+	if err != nil {
+		return err
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	resp, err := client.ListNamespaces(ctx, &types.ListNamespacesRequest{})
+	if err != nil {
+		return commoncli.Problem("Failed to list shard distributor namespaces", err)
+	}
+
+	table := make([]ShardDistributorNamespaceRow, 0, len(resp.GetNamespaces()))
+	for _, namespace := range resp.GetNamespaces() {
+		table = append(table, ShardDistributorNamespaceRow{
+			Name:     namespace.GetName(),
+			Type:     namespace.GetType(),
+			ShardNum: namespace.GetShardNum(),
+		})
+	}
+	return Render(c, table, RenderOptions{DefaultTemplate: templateTable, Color: true})
+}
+
+// AdminShardDistributorDescribeNamespace prints the executors, the shard assignments and the operator controls of a namespace
+func AdminShardDistributorDescribeNamespace(c *cli.Context) error {
+	client, err := getDeps(c).ShardDistributorClient(c)
+	if err != nil {
+		return err
+	}
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return commoncli.Problem("Required flag not present:", err)
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	resp, err := client.DescribeNamespace(ctx, &types.DescribeNamespaceRequest{Namespace: namespace})
+	if err != nil {
+		return commoncli.Problem("Failed to describe shard distributor namespace", err)
+	}
+
+	output := getDeps(c).Output()
+	if c.String(FlagFormat) == formatJSON {
+		prettyPrintJSONObject(output, resp)
+		return nil
+	}
+
+	fmt.Fprintf(output, "Namespace: %s\n", resp.GetNamespace())
+	fmt.Fprintf(output, "Rebalancing frozen: %v\n", resp.GetRebalancingFrozen())
+
+	executors := make([]ShardDistributorExecutorRow, 0, len(resp.GetExecutors()))
+	var assignments []ShardDistributorAssignmentRow
+	for _, executor := range resp.GetExecutors() {
+		row := ShardDistributorExecutorRow{
+			ExecutorID:    executor.GetExecutorID(),
+			State:         executor.GetState(),
+			LastHeartbeat: time.Unix(0, executor.GetLastHeartbeat()),
+			Drained:       executor.GetDrained(),
+			Shards:        len(executor.GetAssignedShards()),
+		}
+		for _, shard := range executor.GetAssignedShards() {
+			row.Load += shard.GetShardLoad()
+			assignments = append(assignments, ShardDistributorAssignmentRow{
+				ShardKey:   shard.GetShardKey(),
+				ExecutorID: executor.GetExecutorID(),
+				AssignedAt: time.Unix(0, shard.GetAssignedAt()),
+				Status:     shard.GetStatus(),
+				Load:       shard.GetShardLoad(),
+			})
+		}
+		executors = append(executors, row)
+	}
+
+	fmt.Fprintln(output, "\nExecutors:")
+	if err := RenderTable(output, executors, RenderOptions{Color: true, PrintDateTime: true}); err != nil {
+		return err
+	}
+	fmt.Fprintln(output, "\nShard assignments:")
+	if err := RenderTable(output, assignments, RenderOptions{Color: true, PrintDateTime: true}); err != nil {
+		return err
+	}
+
+	if len(resp.GetShardMoves()) > 0 {
+		moves := make([]ShardDistributorShardMoveRow, 0, len(resp.GetShardMoves()))
+		for _, move := range resp.GetShardMoves() {
+			moves = append(moves, ShardDistributorShardMoveRow{
+				ShardKey:    move.GetShardKey(),
+				ExecutorID:  move.GetExecutorID(),
+				RequestedAt: time.Unix(0, move.GetRequestedAt()),
+			})
+		}
+		fmt.Fprintln(output, "\nPending shard moves:")
+		if err := RenderTable(output, moves, RenderOptions{Color: true, PrintDateTime: true}); err != nil {
+			return err
+		}
+	}
+
+	if len(resp.GetAuditLog()) > 0 {
+		auditLog := make([]ShardDistributorAuditRow, 0, len(resp.GetAuditLog()))
+		for _, entry := range resp.GetAuditLog() {
+			auditLog = append(auditLog, ShardDistributorAuditRow{
+				Time:      time.Unix(0, entry.GetTime()),
+				Identity:  entry.GetIdentity(),
+				Operation: entry.GetOperation(),
+				Reason:    entry.GetReason(),
+			})
+		}
+		fmt.Fprintln(output, "\nAudit log:")
+		if err := RenderTable(output, auditLog, RenderOptions{Color: true, PrintDateTime: true}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AdminShardDistributorMoveShard requests the shard distributor leader to move a shard to an executor
+func AdminShardDistributorMoveShard(c *cli.Context) error {
+	client, err := getDeps(c).ShardDistributorClient(c)
+	if err != nil {
+		return err
+	}
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return commoncli.Problem("Required flag not present:", err)
+	}
+	shardKey, err := getRequiredOption(c, FlagShardKey)
+	if err != nil {
+		return commoncli.Problem("Required flag not present:", err)
+	}
+	executorID, err := getRequiredOption(c, FlagExecutorID)
+	if err != nil {
+		return commoncli.Problem("Required flag not present:", err)
+	}
+	reason, err := getRequiredOption(c, FlagReason)
+	if err != nil {
+		return commoncli.Problem("Required flag not present:", err)
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	_, err = client.MoveShard(ctx, &types.MoveShardRequest{
+		Namespace:  namespace,
+		ShardKey:   shardKey,
+		ExecutorID: executorID,
+		Identity:   getCliIdentity(),
+		Reason:     reason,
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to move shard", err)
+	}
+
+	fmt.Fprintf(getDeps(c).Output(), "Requested to move shard %s of namespace %s to executor %s.\n", shardKey, namespace, executorID)
+	return nil
+}
+
+// AdminShardDistributorDrainExecutor stops the shard distributor from assigning shards to an executor
+func AdminShardDistributorDrainExecutor(c *cli.Context) error {
+	return setExecutorDrained(c, true)
+}
+
+// AdminShardDistributorUndrainExecutor lets the shard distributor assign shards to a drained executor again
+func AdminShardDistributorUndrainExecutor(c *cli.Context) error {
+	return setExecutorDrained(c, false)
+}
+
+func setExecutorDrained(c *cli.Context, drained bool) error {
+	client, err := getDeps(c).ShardDistributorClient(c)
+	if err != nil {
+		return err
+	}
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return commoncli.Problem("Required flag not present:", err)
+	}
+	executorID, err := getRequiredOption(c, FlagExecutorID)
+	if err != nil {
+		return commoncli.Problem("Required flag not present:", err)
+	}
+	reason, err := getRequiredOption(c, FlagReason)
+	if err != nil {
+		return commoncli.Problem("Required flag not present:", err)
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	_, err = client.SetExecutorDrained(ctx, &types.SetExecutorDrainedRequest{
+		Namespace:  namespace,
+		ExecutorID: executorID,
+		Drained:    drained,
+		Identity:   getCliIdentity(),
+		Reason:     reason,
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to update executor", err)
+	}
+
+	state := "undrained"
+	if drained {
+		state = "drained"
+	}
+	fmt.Fprintf(getDeps(c).Output(), "Executor %s of namespace %s is %s.\n", executorID, namespace, state)
+	return nil
+}
+
+// AdminShardDistributorFreezeRebalancing stops the shard distributor from changing the shard assignments of a namespace
+func AdminShardDistributorFreezeRebalancing(c *cli.Context) error {
+	return setRebalancingFrozen(c, true)
+}
+
+// AdminShardDistributorUnfreezeRebalancing lets the shard distributor rebalance a frozen namespace again
+func AdminShardDistributorUnfreezeRebalancing(c *cli.Context) error {
+	return setRebalancingFrozen(c, false)
+}
+
+func setRebalancingFrozen(c *cli.Context, frozen bool) error {
+	client, err := getDeps(c).ShardDistributorClient(c)
+	if err != nil {
+		return err
+	}
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return commoncli.Problem("Required flag not present:", err)
+	}
+	reason, err := getRequiredOption(c, FlagReason)
+	if err != nil {
+		return commoncli.Problem("Required flag not present:", err)
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	_, err = client.SetRebalancingFrozen(ctx, &types.SetRebalancingFrozenRequest{
+		Namespace: namespace,
+		Frozen:    frozen,
+		Identity:  getCliIdentity(),
+		Reason:    reason,
+	})
+	if err != nil {
+		return commoncli.Problem("Failed to update rebalancing", err)
+	}
+
+	state := "unfrozen"
+	if frozen {
+		state = "frozen"
+	}
+	fmt.Fprintf(getDeps(c).Output(), "Rebalancing of namespace %s is %s.\n", namespace, state)
+	return nil
+}
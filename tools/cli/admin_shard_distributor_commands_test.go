@@ -0,0 +1,261 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v2"
+	"go.uber.org/mock/gomock"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/tools/cli/clitest"
+)
+
+const (
+	testShardDistributorNamespace = "test-namespace"
+	testShardKey                  = "shard-1"
+	testExecutorID                = "executor-1"
+	testReason                    = "test-reason"
+)
+
+func TestAdminShardDistributorListNamespaces(t *testing.T) {
+	td := newCLITestData(t)
+	td.mockShardDistributorClient.EXPECT().ListNamespaces(gomock.Any(), &types.ListNamespacesRequest{}).
+		Return(&types.ListNamespacesResponse{
+			Namespaces: []*types.NamespaceInfo{
+				{Name: testShardDistributorNamespace, Type: "fixed", ShardNum: 32},
+			},
+		}, nil)
+
+	err := AdminShardDistributorListNamespaces(clitest.NewCLIContext(t, td.app))
+	assert.NoError(t, err)
+	assert.Contains(t, td.consoleOutput(), testShardDistributorNamespace)
+	assert.Contains(t, td.consoleOutput(), "32")
+}
+
+func TestAdminShardDistributorDescribeNamespace(t *testing.T) {
+	now := time.Now()
+	resp := &types.DescribeNamespaceResponse{
+		Namespace:         testShardDistributorNamespace,
+		RebalancingFrozen: true,
+		Executors: []*types.ExecutorInfo{
+			{
+				ExecutorID:    testExecutorID,
+				State:         "ACTIVE",
+				LastHeartbeat: now.UnixNano(),
+				Drained:       true,
+				AssignedShards: []*types.ShardAssignmentInfo{
+					{ShardKey: testShardKey, AssignedAt: now.UnixNano(), Status: "READY", ShardLoad: 0.5},
+				},
+			},
+		},
+		ShardMoves: []*types.ShardMoveInfo{
+			{ShardKey: testShardKey, ExecutorID: "executor-2", RequestedAt: now.UnixNano()},
+		},
+		AuditLog: []*types.NamespaceAuditEntry{
+			{Time: now.UnixNano(), Identity: "operator", Operation: "freeze rebalancing", Reason: testReason},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		testSetup      func(td *cliTestData) *cli.Context
+		errContains    string // empty if no error is expected
+		expectedOutput []string
+	}{
+		{
+			name: "no namespace argument",
+			testSetup: func(td *cliTestData) *cli.Context {
+				return clitest.NewCLIContext(t, td.app /* arguments are missing */)
+			},
+			errContains: "Required flag not present",
+		},
+		{
+			name: "failed to describe namespace",
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockShardDistributorClient.EXPECT().DescribeNamespace(gomock.Any(), gomock.Any()).
+					Return(nil, assert.AnError)
+				return clitest.NewCLIContext(t, td.app, clitest.StringArgument(FlagNamespace, testShardDistributorNamespace))
+			},
+			errContains: "Failed to describe shard distributor namespace",
+		},
+		{
+			name: "table output",
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockShardDistributorClient.EXPECT().
+					DescribeNamespace(gomock.Any(), &types.DescribeNamespaceRequest{Namespace: testShardDistributorNamespace}).
+					Return(resp, nil)
+				return clitest.NewCLIContext(t, td.app, clitest.StringArgument(FlagNamespace, testShardDistributorNamespace))
+			},
+			expectedOutput: []string{
+				"Rebalancing frozen: true",
+				testExecutorID,
+				testShardKey,
+				"Pending shard moves:",
+				"executor-2",
+				"Audit log:",
+				"freeze rebalancing",
+			},
+		},
+		{
+			name: "json output",
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockShardDistributorClient.EXPECT().DescribeNamespace(gomock.Any(), gomock.Any()).
+					Return(resp, nil)
+				return clitest.NewCLIContext(t, td.app,
+					clitest.StringArgument(FlagNamespace, testShardDistributorNamespace),
+					clitest.StringArgument(FlagFormat, formatJSON),
+				)
+			},
+			expectedOutput: []string{`"RebalancingFrozen": true`, `"ExecutorID": "executor-1"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			err := AdminShardDistributorDescribeNamespace(tt.testSetup(td))
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+			for _, expected := range tt.expectedOutput {
+				assert.Contains(t, td.consoleOutput(), expected)
+			}
+		})
+	}
+}
+
+func TestAdminShardDistributorMoveShard(t *testing.T) {
+	tests := []struct {
+		name        string
+		testSetup   func(td *cliTestData) *cli.Context
+		errContains string // empty if no error is expected
+	}{
+		{
+			name: "no reason argument",
+			testSetup: func(td *cliTestData) *cli.Context {
+				return clitest.NewCLIContext(t, td.app,
+					clitest.StringArgument(FlagNamespace, testShardDistributorNamespace),
+					clitest.StringArgument(FlagShardKey, testShardKey),
+					clitest.StringArgument(FlagExecutorID, testExecutorID),
+				)
+			},
+			errContains: "Required flag not present",
+		},
+		{
+			name: "failed to move shard",
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockShardDistributorClient.EXPECT().MoveShard(gomock.Any(), gomock.Any()).
+					Return(nil, assert.AnError)
+				return clitest.NewCLIContext(t, td.app,
+					clitest.StringArgument(FlagNamespace, testShardDistributorNamespace),
+					clitest.StringArgument(FlagShardKey, testShardKey),
+					clitest.StringArgument(FlagExecutorID, testExecutorID),
+					clitest.StringArgument(FlagReason, testReason),
+				)
+			},
+			errContains: "Failed to move shard",
+		},
+		{
+			name: "success",
+			testSetup: func(td *cliTestData) *cli.Context {
+				td.mockShardDistributorClient.EXPECT().MoveShard(gomock.Any(), &types.MoveShardRequest{
+					Namespace:  testShardDistributorNamespace,
+					ShardKey:   testShardKey,
+					ExecutorID: testExecutorID,
+					Identity:   getCliIdentity(),
+					Reason:     testReason,
+				}).Return(&types.MoveShardResponse{}, nil)
+				return clitest.NewCLIContext(t, td.app,
+					clitest.StringArgument(FlagNamespace, testShardDistributorNamespace),
+					clitest.StringArgument(FlagShardKey, testShardKey),
+					clitest.StringArgument(FlagExecutorID, testExecutorID),
+					clitest.StringArgument(FlagReason, testReason),
+				)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			err := AdminShardDistributorMoveShard(tt.testSetup(td))
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errContains)
+			}
+		})
+	}
+}
+
+func TestAdminShardDistributorDrainExecutor(t *testing.T) {
+	for _, drained := range []bool{true, false} {
+		td := newCLITestData(t)
+		td.mockShardDistributorClient.EXPECT().SetExecutorDrained(gomock.Any(), &types.SetExecutorDrainedRequest{
+			Namespace:  testShardDistributorNamespace,
+			ExecutorID: testExecutorID,
+			Drained:    drained,
+			Identity:   getCliIdentity(),
+			Reason:     testReason,
+		}).Return(&types.SetExecutorDrainedResponse{}, nil)
+
+		cliCtx := clitest.NewCLIContext(t, td.app,
+			clitest.StringArgument(FlagNamespace, testShardDistributorNamespace),
+			clitest.StringArgument(FlagExecutorID, testExecutorID),
+			clitest.StringArgument(FlagReason, testReason),
+		)
+		action := AdminShardDistributorUndrainExecutor
+		if drained {
+			action = AdminShardDistributorDrainExecutor
+		}
+		assert.NoError(t, action(cliCtx))
+	}
+}
+
+func TestAdminShardDistributorFreezeRebalancing(t *testing.T) {
+	for _, frozen := range []bool{true, false} {
+		td := newCLITestData(t)
+		td.mockShardDistributorClient.EXPECT().SetRebalancingFrozen(gomock.Any(), &types.SetRebalancingFrozenRequest{
+			Namespace: testShardDistributorNamespace,
+			Frozen:    frozen,
+			Identity:  getCliIdentity(),
+			Reason:    testReason,
+		}).Return(&types.SetRebalancingFrozenResponse{}, nil)
+
+		cliCtx := clitest.NewCLIContext(t, td.app,
+			clitest.StringArgument(FlagNamespace, testShardDistributorNamespace),
+			clitest.StringArgument(FlagReason, testReason),
+		)
+		action := AdminShardDistributorUnfreezeRebalancing
+		if frozen {
+			action = AdminShardDistributorFreezeRebalancing
+		}
+		assert.NoError(t, action(cliCtx))
+	}
+}
@@ -60,6 +60,18 @@ type (
 		ReadPartitions  map[int]*types.TaskListPartition `header:"Read Partitions"`
 		WritePartitions map[int]*types.TaskListPartition `header:"Write Partitions"`
 	}
+	TaskListMigrationPartitionRow struct {
+		Partition string `header:"Partition"`
+		Host      string `header:"Owner Host"`
+		Backlog   int64  `header:"Backlog"`
+	}
+	TaskListBuildIDRow struct {
+		BuildID     string `header:"Build ID"`
+		Set         int    `header:"Compatible Set"`
+		Default     bool   `header:"Default"`
+		Backlog     int64  `header:"Backlog"`
+		PollerCount int64  `header:"PollerCount"`
+	}
 )
 
 // AdminDescribeTaskList displays poller and status information of task list.
@@ -114,6 +126,12 @@ func AdminDescribeTaskList(c *cli.Context) error {
 		return fmt.Errorf("failed to print task list partition config: %w", err)
 	}
 	getDeps(c).Output().Write([]byte("\n"))
+	if response, ok := responses[types.TaskListTypeDecision]; ok && response.VersioningConfig != nil {
+		if err := printTaskListBuildIDs(getDeps(c).Output(), response); err != nil {
+			return fmt.Errorf("failed to print task list build IDs: %w", err)
+		}
+		getDeps(c).Output().Write([]byte("\n"))
+	}
 
 	return nil
 }
@@ -213,6 +231,26 @@ func printTaskListPartitionConfig(w io.Writer, responses map[types.TaskListType]
 	return RenderTable(w, table, RenderOptions{Color: true})
 }
 
+func printTaskListBuildIDs(w io.Writer, response *types.DescribeTaskListResponse) error {
+	var table []TaskListBuildIDRow
+	sets := response.VersioningConfig.GetCompatibleSets()
+	for i, set := range sets {
+		for _, buildID := range set.GetBuildIDs() {
+			row := TaskListBuildIDRow{
+				BuildID: buildID,
+				Set:     i,
+				Default: i == len(sets)-1,
+			}
+			if buildIDMetrics, ok := response.TaskListStatus.GetBuildIDMetrics()[buildID]; ok {
+				row.Backlog = buildIDMetrics.BacklogCountHint
+				row.PollerCount = buildIDMetrics.PollerCount
+			}
+			table = append(table, row)
+		}
+	}
+	return RenderTable(w, table, RenderOptions{Color: true})
+}
+
 func AdminUpdateTaskListPartitionConfig(c *cli.Context) error {
 	adminClient, err := getDeps(c).ServerAdminClient(c)
 	if err != nil {
@@ -285,6 +323,123 @@ func AdminUpdateTaskListPartitionConfig(c *cli.Context) error {
 	return nil
 }
 
+// AdminUpdateTaskListVersioningConfig registers, promotes or rolls back worker build IDs of a decision task list.
+func AdminUpdateTaskListVersioningConfig(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	taskList, err := getRequiredOption(c, FlagTaskList)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	request := &types.UpdateTaskListVersioningConfigRequest{
+		Domain:               domain,
+		TaskList:             &types.TaskList{Name: taskList, Kind: types.TaskListKindNormal.Ptr()},
+		AddNewDefaultBuildID: c.String(FlagAddNewDefaultBuildID),
+		PromoteBuildID:       c.String(FlagPromoteBuildID),
+	}
+	if c.IsSet(FlagAddCompatibleBuildID) {
+		existing, err := getRequiredOption(c, FlagExistingCompatibleBuildID)
+		if err != nil {
+			return commoncli.Problem("Required flag not found: ", err)
+		}
+		request.AddCompatibleBuildID = &types.AddCompatibleBuildID{
+			BuildID:                   c.String(FlagAddCompatibleBuildID),
+			ExistingCompatibleBuildID: existing,
+			MakeDefault:               c.Bool(FlagMakeDefault),
+		}
+	}
+	numOperations := 0
+	for _, flag := range []string{FlagAddNewDefaultBuildID, FlagAddCompatibleBuildID, FlagPromoteBuildID} {
+		if c.IsSet(flag) {
+			numOperations++
+		}
+	}
+	if numOperations != 1 {
+		return commoncli.Problem(fmt.Sprintf("Exactly one of --%s, --%s and --%s must be specified", FlagAddNewDefaultBuildID, FlagAddCompatibleBuildID, FlagPromoteBuildID), nil)
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+	response, err := adminClient.UpdateTaskListVersioningConfig(ctx, request)
+	if err != nil {
+		return commoncli.Problem("Operation UpdateTaskListVersioningConfig failed.", err)
+	}
+	prettyPrintJSONObject(getDeps(c).Output(), response.GetVersioningConfig())
+	return nil
+}
+
+// AdminMigrateTaskList starts or stops moving the backlog of a task list to another task list and shows the progress of the migration.
+func AdminMigrateTaskList(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	taskList, err := getRequiredOption(c, FlagTaskList)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	if _, err := getRequiredOption(c, FlagTaskListType); err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	taskListTypes, err := getTaskListTypes(c)
+	if err != nil {
+		return err
+	}
+	if c.IsSet(FlagTargetTaskList) && c.Bool(FlagStop) {
+		return commoncli.Problem(fmt.Sprintf("Only one of --%s and --%s can be specified", FlagTargetTaskList, FlagStop), nil)
+	}
+	request := &types.MigrateTaskListRequest{
+		Domain:       domain,
+		TaskList:     &types.TaskList{Name: taskList, Kind: types.TaskListKindNormal.Ptr()},
+		TaskListType: taskListTypes[0].Ptr(),
+		Alias:        c.Bool(FlagAlias),
+		Stop:         c.Bool(FlagStop),
+	}
+	if c.IsSet(FlagTargetTaskList) {
+		request.TargetTaskList = &types.TaskList{Name: c.String(FlagTargetTaskList), Kind: types.TaskListKindNormal.Ptr()}
+	}
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+	response, err := adminClient.MigrateTaskList(ctx, request)
+	if err != nil {
+		return commoncli.Problem("Operation MigrateTaskList failed.", err)
+	}
+	output := getDeps(c).Output()
+	if config := response.GetMigrationConfig(); config != nil {
+		_, _ = fmt.Fprintf(output, "Migrating %s to %s, alias: %v\n", taskList, config.GetTargetTaskList(), config.GetAlias())
+	} else {
+		_, _ = fmt.Fprintf(output, "%s is not being migrated\n", taskList)
+	}
+	return printTaskListMigrationPartitions(output, response.GetPartitions())
+}
+
+func printTaskListMigrationPartitions(w io.Writer, partitions []*types.TaskListMigrationPartitionStatus) error {
+	var table []TaskListMigrationPartitionRow
+	for _, partition := range partitions {
+		table = append(table, TaskListMigrationPartitionRow{
+			Partition: partition.GetPartition(),
+			Host:      partition.GetOwnerHostName(),
+			Backlog:   partition.GetBacklogCountHint(),
+		})
+	}
+	return RenderTable(w, table, RenderOptions{Color: true})
+}
+
 func validateChange(ctx context.Context, client frontend.Client, domain string, tl *types.TaskList, tlt *types.TaskListType, newCfg *types.TaskListPartitionConfig) (bool, error) {
 	description, err := client.DescribeTaskList(ctx, &types.DescribeTaskListRequest{
 		Domain:       domain,
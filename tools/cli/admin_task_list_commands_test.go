@@ -92,6 +92,31 @@ func TestAdminDescribeTaskList(t *testing.T) {
 				}).Return(response, nil).Times(1)
 			},
 		},
+		{
+			name:   "success - with build IDs",
+			tlType: "decision",
+			allowance: func(td *cliTestData) {
+				td.mockFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), &types.DescribeTaskListRequest{
+					Domain:                testDomain,
+					TaskList:              taskList,
+					TaskListType:          types.TaskListTypeDecision.Ptr(),
+					IncludeTaskListStatus: true,
+				}).Return(&types.DescribeTaskListResponse{
+					TaskListStatus: &types.TaskListStatus{
+						BuildIDMetrics: map[string]*types.BuildIDMetrics{
+							"build-1": {BacklogCountHint: 5, PollerCount: 1},
+						},
+					},
+					VersioningConfig: &types.TaskListVersioningConfig{
+						Version: 2,
+						CompatibleSets: []*types.CompatibleBuildIDSet{
+							{BuildIDs: []string{"build-1"}},
+							{BuildIDs: []string{"build-2"}},
+						},
+					},
+				}, nil).Times(1)
+			},
+		},
 		{
 			name:   "success - activity only",
 			tlType: "activity",
@@ -836,3 +861,241 @@ func TestAdminUpdateTaskListPartitionConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestAdminUpdateTaskListVersioningConfig(t *testing.T) {
+	versioningConfig := &types.TaskListVersioningConfig{
+		Version: 2,
+		CompatibleSets: []*types.CompatibleBuildIDSet{
+			{BuildIDs: []string{"build-1", "build-1.1"}},
+		},
+	}
+	taskList := &types.TaskList{Name: testTaskList, Kind: types.TaskListKindNormal.Ptr()}
+
+	tests := []struct {
+		name          string
+		args          []clitest.CliArgument
+		setupMocks    func(*admin.MockClient)
+		expectedError string
+	}{
+		{
+			name: "add new default",
+			args: []clitest.CliArgument{
+				clitest.StringArgument(FlagAddNewDefaultBuildID, "build-1"),
+			},
+			setupMocks: func(client *admin.MockClient) {
+				client.EXPECT().UpdateTaskListVersioningConfig(gomock.Any(), &types.UpdateTaskListVersioningConfigRequest{
+					Domain:               testDomain,
+					TaskList:             taskList,
+					AddNewDefaultBuildID: "build-1",
+				}).Return(&types.UpdateTaskListVersioningConfigResponse{VersioningConfig: versioningConfig}, nil)
+			},
+		},
+		{
+			name: "add compatible",
+			args: []clitest.CliArgument{
+				clitest.StringArgument(FlagAddCompatibleBuildID, "build-1.1"),
+				clitest.StringArgument(FlagExistingCompatibleBuildID, "build-1"),
+				clitest.BoolArgument(FlagMakeDefault, true),
+			},
+			setupMocks: func(client *admin.MockClient) {
+				client.EXPECT().UpdateTaskListVersioningConfig(gomock.Any(), &types.UpdateTaskListVersioningConfigRequest{
+					Domain:   testDomain,
+					TaskList: taskList,
+					AddCompatibleBuildID: &types.AddCompatibleBuildID{
+						BuildID:                   "build-1.1",
+						ExistingCompatibleBuildID: "build-1",
+						MakeDefault:               true,
+					},
+				}).Return(&types.UpdateTaskListVersioningConfigResponse{VersioningConfig: versioningConfig}, nil)
+			},
+		},
+		{
+			name: "promote",
+			args: []clitest.CliArgument{
+				clitest.StringArgument(FlagPromoteBuildID, "build-1"),
+			},
+			setupMocks: func(client *admin.MockClient) {
+				client.EXPECT().UpdateTaskListVersioningConfig(gomock.Any(), &types.UpdateTaskListVersioningConfigRequest{
+					Domain:         testDomain,
+					TaskList:       taskList,
+					PromoteBuildID: "build-1",
+				}).Return(&types.UpdateTaskListVersioningConfigResponse{VersioningConfig: versioningConfig}, nil)
+			},
+		},
+		{
+			name: "add compatible without existing build ID",
+			args: []clitest.CliArgument{
+				clitest.StringArgument(FlagAddCompatibleBuildID, "build-1.1"),
+			},
+			expectedError: "Required flag not found",
+		},
+		{
+			name:          "no operation",
+			expectedError: "Exactly one of",
+		},
+		{
+			name: "multiple operations",
+			args: []clitest.CliArgument{
+				clitest.StringArgument(FlagAddNewDefaultBuildID, "build-2"),
+				clitest.StringArgument(FlagPromoteBuildID, "build-1"),
+			},
+			expectedError: "Exactly one of",
+		},
+		{
+			name: "API failed",
+			args: []clitest.CliArgument{
+				clitest.StringArgument(FlagPromoteBuildID, "build-3"),
+			},
+			setupMocks: func(client *admin.MockClient) {
+				client.EXPECT().UpdateTaskListVersioningConfig(gomock.Any(), gomock.Any()).Return(nil, errors.New("API failed"))
+			},
+			expectedError: "API failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			if tt.setupMocks != nil {
+				tt.setupMocks(td.mockAdminClient)
+			}
+
+			cliArgs := append([]clitest.CliArgument{
+				clitest.StringArgument(FlagDomain, testDomain),
+				clitest.StringArgument(FlagTaskList, testTaskList),
+			}, tt.args...)
+			cliCtx := clitest.NewCLIContext(t, td.app, cliArgs...)
+
+			err := AdminUpdateTaskListVersioningConfig(cliCtx)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestAdminMigrateTaskList(t *testing.T) {
+	taskList := &types.TaskList{Name: testTaskList, Kind: types.TaskListKindNormal.Ptr()}
+	targetTaskList := &types.TaskList{Name: "target-tasklist", Kind: types.TaskListKindNormal.Ptr()}
+	partitions := []*types.TaskListMigrationPartitionStatus{
+		{Partition: testTaskList, OwnerHostName: "host-1", BacklogCountHint: 10},
+	}
+
+	tests := []struct {
+		name           string
+		args           []clitest.CliArgument
+		setupMocks     func(*admin.MockClient)
+		expectedOutput string
+		expectedError  string
+	}{
+		{
+			name: "start migration",
+			args: []clitest.CliArgument{
+				clitest.StringArgument(FlagTaskListType, "activity"),
+				clitest.StringArgument(FlagTargetTaskList, "target-tasklist"),
+				clitest.BoolArgument(FlagAlias, true),
+			},
+			setupMocks: func(client *admin.MockClient) {
+				client.EXPECT().MigrateTaskList(gomock.Any(), &types.MigrateTaskListRequest{
+					Domain:         testDomain,
+					TaskList:       taskList,
+					TaskListType:   types.TaskListTypeActivity.Ptr(),
+					TargetTaskList: targetTaskList,
+					Alias:          true,
+				}).Return(&types.MigrateTaskListResponse{
+					MigrationConfig: &types.TaskListMigrationConfig{TargetTaskList: "target-tasklist", Alias: true},
+					Partitions:      partitions,
+				}, nil)
+			},
+			expectedOutput: "to target-tasklist, alias: true",
+		},
+		{
+			name: "stop migration",
+			args: []clitest.CliArgument{
+				clitest.StringArgument(FlagTaskListType, "decision"),
+				clitest.BoolArgument(FlagStop, true),
+			},
+			setupMocks: func(client *admin.MockClient) {
+				client.EXPECT().MigrateTaskList(gomock.Any(), &types.MigrateTaskListRequest{
+					Domain:       testDomain,
+					TaskList:     taskList,
+					TaskListType: types.TaskListTypeDecision.Ptr(),
+					Stop:         true,
+				}).Return(&types.MigrateTaskListResponse{Partitions: partitions}, nil)
+			},
+			expectedOutput: "is not being migrated",
+		},
+		{
+			name: "show progress",
+			args: []clitest.CliArgument{
+				clitest.StringArgument(FlagTaskListType, "activity"),
+			},
+			setupMocks: func(client *admin.MockClient) {
+				client.EXPECT().MigrateTaskList(gomock.Any(), &types.MigrateTaskListRequest{
+					Domain:       testDomain,
+					TaskList:     taskList,
+					TaskListType: types.TaskListTypeActivity.Ptr(),
+				}).Return(&types.MigrateTaskListResponse{
+					MigrationConfig: &types.TaskListMigrationConfig{TargetTaskList: "target-tasklist"},
+					Partitions:      partitions,
+				}, nil)
+			},
+			expectedOutput: "host-1",
+		},
+		{
+			name:          "task list type not set",
+			expectedError: "Required flag not found",
+		},
+		{
+			name: "invalid task list type",
+			args: []clitest.CliArgument{
+				clitest.StringArgument(FlagTaskListType, "invalid"),
+			},
+			expectedError: "Invalid task list type",
+		},
+		{
+			name: "target and stop",
+			args: []clitest.CliArgument{
+				clitest.StringArgument(FlagTaskListType, "activity"),
+				clitest.StringArgument(FlagTargetTaskList, "target-tasklist"),
+				clitest.BoolArgument(FlagStop, true),
+			},
+			expectedError: "Only one of",
+		},
+		{
+			name: "API failed",
+			args: []clitest.CliArgument{
+				clitest.StringArgument(FlagTaskListType, "activity"),
+			},
+			setupMocks: func(client *admin.MockClient) {
+				client.EXPECT().MigrateTaskList(gomock.Any(), gomock.Any()).Return(nil, errors.New("API failed"))
+			},
+			expectedError: "API failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newCLITestData(t)
+			if tt.setupMocks != nil {
+				tt.setupMocks(td.mockAdminClient)
+			}
+
+			cliArgs := append([]clitest.CliArgument{
+				clitest.StringArgument(FlagDomain, testDomain),
+				clitest.StringArgument(FlagTaskList, testTaskList),
+			}, tt.args...)
+			cliCtx := clitest.NewCLIContext(t, td.app, cliArgs...)
+
+			err := AdminMigrateTaskList(cliCtx)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+				assert.Contains(t, td.consoleOutput(), tt.expectedOutput)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedError)
+			}
+		})
+	}
+}
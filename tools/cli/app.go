@@ -233,6 +233,12 @@ func NewCliApp(cf ClientFactory, opts ...CLIAppOptions) *cli.App {
 					Usage:       "Run admin operation on config store",
 					Subcommands: newAdminConfigStoreCommands(),
 				},
+				{
+					Name:        "shard-distributor",
+					Aliases:     []string{"sd"},
+					Usage:       "Run admin operation on the shard distributor",
+					Subcommands: newAdminShardDistributorCommands(),
+				},
 			},
 		},
 		{
@@ -22,6 +22,7 @@ package cli
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -38,9 +39,11 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/urfave/cli/v2"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/client/sharddistributor"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/types"
@@ -67,9 +70,10 @@ type (
 var _ ClientFactory = (*clientFactoryMock)(nil)
 
 type clientFactoryMock struct {
-	serverFrontendClient frontend.Client
-	serverAdminClient    admin.Client
-	config               *config.Config
+	serverFrontendClient   frontend.Client
+	serverAdminClient      admin.Client
+	shardDistributorClient sharddistributor.Client
+	config                 *config.Config
 }
 
 func (m *clientFactoryMock) ServerFrontendClient(c *cli.Context) (frontend.Client, error) {
@@ -92,6 +96,10 @@ func (m *clientFactoryMock) ElasticSearchClient(c *cli.Context) (*elastic.Client
 	panic("not implemented")
 }
 
+func (m *clientFactoryMock) ShardDistributorClient(c *cli.Context) (sharddistributor.Client, error) {
+	return m.shardDistributorClient, nil
+}
+
 func (m *clientFactoryMock) ServerConfig(c *cli.Context) (*config.Config, error) {
 	if m.config != nil {
 		return m.config, nil
@@ -299,6 +307,51 @@ func (s *cliAppSuite) TestDomainDescribe_Failed() {
 	s.Error(s.app.Run([]string{"", "--do", domainName, "domain", "describe"}))
 }
 
+func (s *cliAppSuite) TestDomainWorkers() {
+	resp := &types.ListWorkersResponse{
+		Workers: []*types.WorkerInfo{
+			{
+				Identity:    "worker",
+				VersionInfo: &types.WorkerVersionInfo{Impl: "uber-go", FeatureVersion: "1.7.0"},
+				TaskLists: []*types.WorkerTaskListInfo{
+					{
+						TaskList:     &types.TaskList{Name: "tl"},
+						TaskListType: types.TaskListTypeDecision.Ptr(),
+						LastPollTime: common.Int64Ptr(time.Now().UnixNano()),
+					},
+				},
+				LastPollTime:   common.Int64Ptr(time.Now().UnixNano()),
+				PollsPerSecond: 1.5,
+			},
+		},
+	}
+	s.serverFrontendClient.EXPECT().ListWorkers(gomock.Any(), &types.ListWorkersRequest{Domain: domainName}).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "workers"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDomainWorkers_Describe() {
+	resp := &types.DescribeWorkerResponse{
+		Worker: &types.WorkerInfo{
+			Identity:     "worker",
+			LastPollTime: common.Int64Ptr(time.Now().UnixNano()),
+		},
+	}
+	s.serverFrontendClient.EXPECT().DescribeWorker(gomock.Any(), &types.DescribeWorkerRequest{Domain: domainName, Identity: "worker"}).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "workers", "--identity", "worker"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDomainWorkers_WorkerNotExist() {
+	s.serverFrontendClient.EXPECT().DescribeWorker(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
+	s.Error(s.app.Run([]string{"", "--do", domainName, "domain", "workers", "--identity", "worker"}))
+}
+
+func (s *cliAppSuite) TestDomainWorkers_Failed() {
+	s.serverFrontendClient.EXPECT().ListWorkers(gomock.Any(), gomock.Any()).Return(nil, &types.BadRequestError{"faked error"})
+	s.Error(s.app.Run([]string{"", "--do", domainName, "domain", "workers"}))
+}
+
 var (
 	eventType = types.EventTypeWorkflowExecutionStarted
 
@@ -439,6 +492,24 @@ func (s *cliAppSuite) TestTerminateWorkflow_Failed() {
 	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "terminate", "-w", "wid"}))
 }
 
+func (s *cliAppSuite) TestAnnotateWorkflow() {
+	s.serverFrontendClient.EXPECT().UpdateWorkflowMemo(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *types.UpdateWorkflowMemoRequest, _ ...yarpc.CallOption) (*types.UpdateWorkflowMemoResponse, error) {
+			s.Equal(map[string][]byte{"k1": []byte(`"v1"`), "k2": {}}, request.Memo.Fields)
+			return &types.UpdateWorkflowMemoResponse{}, nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "annotate", "-w", "wid", "--memo_key", "k1", "--memo", `"v1"`, "--remove_memo_key", "k2"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAnnotateWorkflow_Failed() {
+	s.serverFrontendClient.EXPECT().UpdateWorkflowMemo(gomock.Any(), gomock.Any()).Return(nil, &types.BadRequestError{"faked error"})
+	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "annotate", "-w", "wid", "--memo_key", "k1", "--memo", `"v1"`}))
+
+	// nothing to update
+	s.Error(s.app.Run([]string{"", "--do", domainName, "workflow", "annotate", "-w", "wid"}))
+}
+
 func (s *cliAppSuite) TestCancelWorkflow() {
 	s.serverFrontendClient.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "cancel", "-w", "wid"})
@@ -664,6 +735,27 @@ func (s *cliAppSuite) TestDescribeTaskList_Activity() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestGetTaskListScalingRecommendation() {
+	s.serverFrontendClient.EXPECT().GetTaskListScalingRecommendation(gomock.Any(), &types.GetTaskListScalingRecommendationRequest{
+		Domain:       domainName,
+		TaskList:     &types.TaskList{Name: "test-taskList"},
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+	}).Return(&types.GetTaskListScalingRecommendationResponse{
+		RecommendedPollerCount: 4,
+		Confidence:             types.TaskListScalingConfidenceMedium.Ptr(),
+		Signals: &types.TaskListScalingSignals{
+			BacklogCountHint:           120,
+			AddTasksPerSecond:          10.5,
+			DispatchedTasksPerSecond:   8,
+			SyncMatchRatio:             0.4,
+			ScheduleToStartLatencyInMs: 2500,
+			PollerCount:                2,
+		},
+	}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "tasklist", "scaling", "-tl", "test-taskList", "-tlt", "activity"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestObserveWorkflow() {
 	history := getWorkflowExecutionHistoryResponse
 	s.serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(history, nil).Times(2)
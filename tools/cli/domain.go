@@ -132,6 +132,21 @@ func newDomainCommands() []*cli.Command {
 				})
 			},
 		},
+		{
+			Name:    "workers",
+			Aliases: []string{"wk"},
+			Usage:   "List workers that recently polled task lists of the domain",
+			Flags:   domainWorkersFlags,
+			Action: func(c *cli.Context) error {
+				err := checkNoAdditionalArgsPassed(c)
+				if err != nil {
+					return err
+				}
+				return withDomainClient(c, false, func(dc *domainCLIImpl) error {
+					return dc.ListWorkers(c)
+				})
+			},
+		},
 		{
 			Name:    "migration",
 			Aliases: []string{"mi"},
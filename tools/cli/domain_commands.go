@@ -615,6 +615,78 @@ func (d *domainCLIImpl) DescribeDomain(c *cli.Context) error {
 	})
 }
 
+// ListWorkers lists the workers that recently polled task lists of the domain
+func (d *domainCLIImpl) ListWorkers(c *cli.Context) error {
+	domainName, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	identity := c.String(FlagIdentity)
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context: ", err)
+	}
+
+	var workers []*types.WorkerInfo
+	if identity != "" {
+		resp, err := d.frontendClient.DescribeWorker(ctx, &types.DescribeWorkerRequest{
+			Domain:   domainName,
+			Identity: identity,
+		})
+		if err != nil {
+			if _, ok := err.(*types.EntityNotExistsError); ok {
+				return commoncli.Problem(fmt.Sprintf("Worker %s has not polled domain %s recently.", identity, domainName), err)
+			}
+			return commoncli.Problem("Operation DescribeWorker failed.", err)
+		}
+		workers = append(workers, resp.GetWorker())
+	} else {
+		resp, err := d.frontendClient.ListWorkers(ctx, &types.ListWorkersRequest{
+			Domain: domainName,
+		})
+		if err != nil {
+			return commoncli.Problem("Operation ListWorkers failed.", err)
+		}
+		workers = resp.GetWorkers()
+	}
+
+	table := []WorkerRow{}
+	for _, worker := range workers {
+		table = append(table, newWorkerRow(worker))
+	}
+	return Render(c, table, RenderOptions{
+		DefaultTemplate: templateTable,
+		Color:           true,
+		Border:          true,
+		PrintDateTime:   true,
+	})
+}
+
+type WorkerRow struct {
+	Identity       string    `header:"Identity"`
+	SDK            string    `header:"SDK"`
+	TaskLists      []string  `header:"Task Lists"`
+	PollsPerSecond float64   `header:"Polls Per Second"`
+	LastPollTime   time.Time `header:"Last Poll Time"`
+}
+
+func newWorkerRow(worker *types.WorkerInfo) WorkerRow {
+	row := WorkerRow{
+		Identity:       worker.GetIdentity(),
+		PollsPerSecond: roundRate(worker.GetPollsPerSecond()),
+		LastPollTime:   time.Unix(0, worker.GetLastPollTime()),
+	}
+	if version := worker.GetVersionInfo(); version != nil {
+		row.SDK = strings.TrimSpace(version.GetImpl() + " " + version.GetFeatureVersion())
+	}
+	for _, taskList := range worker.GetTaskLists() {
+		row.TaskLists = append(row.TaskLists, fmt.Sprintf("%s (%s)", taskList.GetTaskList().GetName(), strings.ToLower(taskList.GetTaskListType().String())))
+	}
+	return row
+}
+
 type BadBinaryRow struct {
 	Checksum  string    `header:"Binary Checksum"`
 	Operator  string    `header:"Operator"`
@@ -246,6 +246,14 @@ var (
 		getFormatFlag(),
 	}
 
+	domainWorkersFlags = []cli.Flag{
+		&cli.StringFlag{
+			Name:  FlagIdentity,
+			Usage: "Optional worker identity. If set, only this worker is described",
+		},
+		getFormatFlag(),
+	}
+
 	migrateDomainFlags = []cli.Flag{
 
 		&cli.StringFlag{
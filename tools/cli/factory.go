@@ -45,13 +45,16 @@ import (
 
 	serverAdmin "github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	serverFrontend "github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
+	sharddistributorv1 "github.com/uber/cadence/.gen/proto/sharddistributor/v1"
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/client/sharddistributor"
 	grpcClient "github.com/uber/cadence/client/wrappers/grpc"
 	"github.com/uber/cadence/client/wrappers/thrift"
 	"github.com/uber/cadence/common"
 	cc "github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/tools/common/commoncli"
 )
 
@@ -80,13 +83,17 @@ type ClientFactory interface {
 
 	ElasticSearchClient(c *cli.Context) (*elastic.Client, error)
 
+	// ShardDistributorClient is a client of the shard distributor service, it always uses gRPC
+	ShardDistributorClient(c *cli.Context) (sharddistributor.Client, error)
+
 	ServerConfig(c *cli.Context) (*config.Config, error)
 }
 
 type clientFactory struct {
-	dispatcher          *yarpc.Dispatcher // lazy, via ensureDispatcher
-	dispatcherMigration *yarpc.Dispatcher // lazy, via ensureDispatcherForMigration
-	logger              *zap.Logger
+	dispatcher                 *yarpc.Dispatcher // lazy, via ensureDispatcher
+	dispatcherMigration        *yarpc.Dispatcher // lazy, via ensureDispatcherForMigration
+	dispatcherShardDistributor *yarpc.Dispatcher // lazy, via ensureDispatcherForShardDistributor
+	logger                     *zap.Logger
 }
 
 // NewClientFactory creates a new ClientFactory
@@ -179,6 +186,16 @@ func (b *clientFactory) ServerAdminClientForMigration(c *cli.Context) (admin.Cli
 	return thrift.NewAdminClient(serverAdmin.New(clientConfig)), nil
 }
 
+// ShardDistributorClient builds a shard distributor client
+func (b *clientFactory) ShardDistributorClient(c *cli.Context) (sharddistributor.Client, error) {
+	err := b.ensureDispatcherForShardDistributor(c)
+	if err != nil {
+		return nil, commoncli.Problem("failed to create shard distributor client dependency", err)
+	}
+	clientConfig := b.dispatcherShardDistributor.ClientConfig(service.ShardDistributor)
+	return grpcClient.NewShardDistributorClient(sharddistributorv1.NewShardDistributorAPIYARPCClient(clientConfig)), nil
+}
+
 // ElasticSearchClient builds an ElasticSearch client
 func (b *clientFactory) ElasticSearchClient(c *cli.Context) (*elastic.Client, error) {
 
@@ -234,6 +251,26 @@ func (b *clientFactory) ensureDispatcherForMigration(c *cli.Context) error {
 	return nil
 }
 
+func (b *clientFactory) ensureDispatcherForShardDistributor(c *cli.Context) error {
+	if b.dispatcherShardDistributor != nil {
+		return nil
+	}
+	hostPort := c.String(FlagShardDistributorAddress)
+	if hostPort == "" {
+		hostPort = shardDistributorGRPCPort
+	}
+	d, err := b.newDispatcher(c, service.ShardDistributor, hostPort, true)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to create shard distributor dispatcher (for --%v %q): %w",
+			FlagShardDistributorAddress, hostPort,
+			err,
+		)
+	}
+	b.dispatcherShardDistributor = d
+	return nil
+}
+
 func (b *clientFactory) newClientDispatcher(c *cli.Context, hostPortOverride string) (*yarpc.Dispatcher, error) {
 	shouldUseGrpc := c.String(FlagTransport) == grpcTransport
 
@@ -244,6 +281,11 @@ func (b *clientFactory) newClientDispatcher(c *cli.Context, hostPortOverride str
 	if hostPortOverride != "" {
 		hostPort = hostPortOverride
 	}
+	return b.newDispatcher(c, cadenceFrontendService, hostPort, shouldUseGrpc)
+}
+
+// newDispatcher creates a dispatcher with a single outbound to the service at hostPort.
+func (b *clientFactory) newDispatcher(c *cli.Context, outboundService string, hostPort string, shouldUseGrpc bool) (*yarpc.Dispatcher, error) {
 	var outbounds transport.Outbounds
 	if shouldUseGrpc {
 		grpcTransport := grpc.NewTransport()
@@ -279,7 +321,7 @@ func (b *clientFactory) newClientDispatcher(c *cli.Context, hostPortOverride str
 
 	dispatcher := yarpc.NewDispatcher(yarpc.Config{
 		Name:      cadenceClientName,
-		Outbounds: yarpc.Outbounds{cadenceFrontendService: outbounds},
+		Outbounds: yarpc.Outbounds{outboundService: outbounds},
 		OutboundMiddleware: yarpc.OutboundMiddleware{
 			Unary: &versionMiddleware{},
 		},
@@ -18,6 +18,7 @@ import (
 
 	admin "github.com/uber/cadence/client/admin"
 	frontend "github.com/uber/cadence/client/frontend"
+	sharddistributor "github.com/uber/cadence/client/sharddistributor"
 	config "github.com/uber/cadence/common/config"
 )
 
@@ -134,3 +135,18 @@ func (mr *MockClientFactoryMockRecorder) ServerFrontendClientForMigration(c any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServerFrontendClientForMigration", reflect.TypeOf((*MockClientFactory)(nil).ServerFrontendClientForMigration), c)
 }
+
+// ShardDistributorClient mocks base method.
+func (m *MockClientFactory) ShardDistributorClient(c *cli.Context) (sharddistributor.Client, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShardDistributorClient", c)
+	ret0, _ := ret[0].(sharddistributor.Client)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShardDistributorClient indicates an expected call of ShardDistributorClient.
+func (mr *MockClientFactoryMockRecorder) ShardDistributorClient(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShardDistributorClient", reflect.TypeOf((*MockClientFactory)(nil).ShardDistributorClient), c)
+}
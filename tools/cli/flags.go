@@ -34,6 +34,7 @@ const (
 	FlagAddress                        = "address"
 	FlagDestinationAddress             = "destination_address"
 	FlagHistoryAddress                 = "history_address"
+	FlagShardDistributorAddress        = "shard_distributor_address"
 	FlagDBType                         = "db_type"
 	FlagDBAddress                      = "db_address"
 	FlagDBPort                         = "db_port"
@@ -45,6 +46,9 @@ const (
 	FlagDestinationDomain              = "destination_domain"
 	FlagShardID                        = "shard_id"
 	FlagShards                         = "shards"
+	FlagShardKey                       = "shard_key"
+	FlagExecutorID                     = "executor_id"
+	FlagNamespace                      = "namespace"
 	FlagRangeID                        = "range_id"
 	FlagWorkflowID                     = "workflow_id"
 	FlagRunID                          = "run_id"
@@ -145,6 +149,7 @@ const (
 	FlagMemoKey                        = "memo_key"
 	FlagMemo                           = "memo"
 	FlagMemoFile                       = "memo_file"
+	FlagRemoveMemoKey                  = "remove_memo_key"
 	FlagSearchAttributesKey            = "search_attr_key"
 	FlagSearchAttributesVal            = "search_attr_value"
 	FlagSearchAttributesType           = "search_attr_type"
@@ -221,10 +226,22 @@ const (
 	FlagJSON                           = "json"
 	FlagIsolationGroupSetDrains        = "set-drains"
 	FlagIsolationGroupsRemoveAllDrains = "remove-all-drains"
+	FlagIsolationGroup                 = "isolation-group"
+	FlagIsolationGroupRampSchedule     = "ramp-schedule"
+	FlagIsolationGroupRampInterval     = "ramp-interval"
 	FlagSearchAttribute                = "search_attr"
 	FlagNumReadPartitions              = "num_read_partitions"
 	FlagNumWritePartitions             = "num_write_partitions"
 	FlagCronOverlapPolicy              = "cron_overlap_policy"
+	FlagAddNewDefaultBuildID           = "add_new_default_build_id"
+	FlagAddCompatibleBuildID           = "add_compatible_build_id"
+	FlagExistingCompatibleBuildID      = "existing_compatible_build_id"
+	FlagMakeDefault                    = "make_default"
+	FlagPromoteBuildID                 = "promote_build_id"
+	FlagTargetTaskList                 = "target_tasklist"
+	FlagAlias                          = "alias"
+	FlagStop                           = "stop"
+	FlagRequestID                      = "request_id"
 
 	FlagClustersUsage = "Clusters (example: --clusters clusterA,clusterB or --cl clusterA --cl clusterB)"
 )
@@ -516,6 +533,29 @@ func getFlagsForTerminate() []cli.Flag {
 	})
 }
 
+func getFlagsForAnnotate() []cli.Flag {
+	return append(flagsForExecution,
+		&cli.StringFlag{
+			Name:  FlagMemoKey,
+			Usage: "Key of memo to add or update. If there are multiple keys, concatenate them and separate by space",
+		},
+		&cli.StringFlag{
+			Name: FlagMemo,
+			Usage: "Value of memo in JSON format. If there are multiple JSON, concatenate them and separate by space. " +
+				"The order must be same as memo_key",
+		},
+		&cli.StringFlag{
+			Name: FlagMemoFile,
+			Usage: "Value of memo from JSON format file. If there are multiple JSON, concatenate them and separate by space or newline. " +
+				"The order must be same as memo_key",
+		},
+		&cli.StringFlag{
+			Name:  FlagRemoveMemoKey,
+			Usage: "Key of memo to remove. If there are multiple keys, concatenate them and separate by space",
+		},
+	)
+}
+
 func getFlagsForCancel() []cli.Flag {
 	return append(flagsForExecution, &cli.StringFlag{
 		Name:    FlagReason,
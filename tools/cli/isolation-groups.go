@@ -22,9 +22,13 @@ package cli
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/urfave/cli/v2"
 
@@ -175,6 +179,159 @@ func AdminUpdateDomainIsolationGroups(c *cli.Context) error {
 	return nil
 }
 
+func AdminRampGlobalIsolationGroup(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+
+	return rampIsolationGroup(c,
+		func(ctx context.Context) (types.IsolationGroupConfiguration, error) {
+			igs, err := adminClient.GetGlobalIsolationGroups(ctx, &types.GetGlobalIsolationGroupsRequest{})
+			if err != nil {
+				return nil, err
+			}
+			return igs.IsolationGroups, nil
+		},
+		func(ctx context.Context, cfg types.IsolationGroupConfiguration) error {
+			_, err := adminClient.UpdateGlobalIsolationGroups(ctx, &types.UpdateGlobalIsolationGroupsRequest{
+				IsolationGroups: cfg,
+			})
+			return err
+		},
+	)
+}
+
+func AdminRampDomainIsolationGroup(c *cli.Context) error {
+	adminClient, err := getDeps(c).ServerAdminClient(c)
+	if err != nil {
+		return err
+	}
+	domain := c.String(FlagDomain)
+	if domain == "" {
+		return commoncli.Problem("invalid args:", fmt.Errorf("the --domain flag is required"))
+	}
+
+	return rampIsolationGroup(c,
+		func(ctx context.Context) (types.IsolationGroupConfiguration, error) {
+			igs, err := adminClient.GetDomainIsolationGroups(ctx, &types.GetDomainIsolationGroupsRequest{
+				Domain: domain,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return igs.IsolationGroups, nil
+		},
+		func(ctx context.Context, cfg types.IsolationGroupConfiguration) error {
+			_, err := adminClient.UpdateDomainIsolationGroups(ctx, &types.UpdateDomainIsolationGroupsRequest{
+				Domain:          domain,
+				IsolationGroups: cfg,
+			})
+			return err
+		},
+	)
+}
+
+// rampIsolationGroup stores the ramp schedule in the isolation group configuration, the server
+// then moves the traffic weight through the schedule at the ramp interval without the CLI
+// having to stay around. The rest of the configuration is preserved
+func rampIsolationGroup(
+	c *cli.Context,
+	get func(ctx context.Context) (types.IsolationGroupConfiguration, error),
+	update func(ctx context.Context, cfg types.IsolationGroupConfiguration) error,
+) error {
+	isolationGroup := c.String(FlagIsolationGroup)
+	if isolationGroup == "" {
+		return commoncli.Problem("invalid args:", fmt.Errorf("the --%s flag is required", FlagIsolationGroup))
+	}
+	schedule, err := parseIsolationGroupRampSchedule(c.String(FlagIsolationGroupRampSchedule))
+	if err != nil {
+		return commoncli.Problem("invalid args:", err)
+	}
+	interval := c.Duration(FlagIsolationGroupRampInterval)
+	if len(schedule) > 1 && interval < time.Second {
+		return commoncli.Problem("invalid args:", fmt.Errorf("the --%s flag must be at least 1s", FlagIsolationGroupRampInterval))
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context:", err)
+	}
+	current, err := get(ctx)
+	if err != nil {
+		return commoncli.Problem(fmt.Sprintf("failed to get the isolation groups to ramp %s", isolationGroup), err)
+	}
+	cfg := current.DeepCopy()
+	if cfg == nil {
+		cfg = types.IsolationGroupConfiguration{}
+	}
+	cfg[isolationGroup] = isolationGroupPartitionForRamp(isolationGroup, schedule, interval, time.Now())
+	if err := update(ctx, cfg); err != nil {
+		return commoncli.Problem(fmt.Sprintf("failed to ramp isolation group %s", isolationGroup), err)
+	}
+	if len(schedule) == 1 {
+		fmt.Fprintf(getDeps(c).Output(), "isolation group %s is now at %d%% traffic weight\n", isolationGroup, schedule[0])
+	} else {
+		fmt.Fprintf(getDeps(c).Output(), "isolation group %s is ramping through %s, one step every %s\n", isolationGroup, formatIsolationGroupRampSchedule(schedule), interval)
+	}
+	return nil
+}
+
+// isolationGroupPartitionForRamp returns the partition for a ramp schedule. A single step is applied
+// directly, anything longer is left to the server to go through, starting from now
+func isolationGroupPartitionForRamp(isolationGroup string, schedule []int32, interval time.Duration, now time.Time) types.IsolationGroupPartition {
+	if len(schedule) == 1 {
+		return isolationGroupPartitionForWeight(isolationGroup, schedule[0])
+	}
+	return types.IsolationGroupPartition{
+		Name:  isolationGroup,
+		State: types.IsolationGroupStateWeighted,
+		Ramp: &types.IsolationGroupWeightRamp{
+			Weights:         schedule,
+			StartTimestamp:  now.UnixNano(),
+			IntervalSeconds: int32(interval / time.Second),
+		},
+	}
+}
+
+func isolationGroupPartitionForWeight(isolationGroup string, weight int32) types.IsolationGroupPartition {
+	switch weight {
+	case 0:
+		return types.IsolationGroupPartition{Name: isolationGroup, State: types.IsolationGroupStateDrained}
+	case types.IsolationGroupMaxWeight:
+		return types.IsolationGroupPartition{Name: isolationGroup, State: types.IsolationGroupStateHealthy}
+	default:
+		return types.IsolationGroupPartition{Name: isolationGroup, State: types.IsolationGroupStateWeighted, Weight: weight}
+	}
+}
+
+func parseIsolationGroupRampSchedule(input string) ([]int32, error) {
+	if strings.TrimSpace(input) == "" {
+		return nil, fmt.Errorf("the --%s flag is required", FlagIsolationGroupRampSchedule)
+	}
+	var schedule []int32
+	for _, step := range strings.Split(input, ",") {
+		weight, err := strconv.ParseInt(strings.TrimSpace(step), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ramp schedule step %q: %w", step, err)
+		}
+		if weight < 0 || weight > int64(types.IsolationGroupMaxWeight) {
+			return nil, fmt.Errorf("invalid ramp schedule step %q: weight must be between 0 and %d", step, types.IsolationGroupMaxWeight)
+		}
+		schedule = append(schedule, int32(weight))
+	}
+	return schedule, nil
+}
+
+func formatIsolationGroupRampSchedule(schedule []int32) string {
+	steps := make([]string, 0, len(schedule))
+	for _, weight := range schedule {
+		steps = append(steps, strconv.Itoa(int(weight)))
+	}
+	return strings.Join(steps, ",")
+}
+
 func validateIsolationGroupUpdateArgs(
 	domainArgs string,
 	setDrainsArgs []string,
@@ -232,6 +389,7 @@ func parseIsolationGroupCliInputCfg(drains []string, jsonInput string, removeAll
 examples:
 - []                                    # will remove all isolation groups
 - [{"Name": "zone-123", "State": 2}]    # drain zone-123
+- [{"Name": "zone-123", "State": 3, "Weight": 25}]    # keep 25%% of the traffic in zone-123
 
 %v`, err)
 	}
@@ -250,7 +408,14 @@ func renderIsolationGroups(igs types.IsolationGroupConfiguration) []byte {
 		return []byte("-- No groups found --\n")
 	}
 	for _, v := range igs.ToPartitionList() {
-		fmt.Fprintf(w, "%s\t%s\n", v.Name, convertIsolationGroupStateToString(v.State))
+		state := convertIsolationGroupStateToString(v.State)
+		if v.State == types.IsolationGroupStateWeighted {
+			state = fmt.Sprintf("%s (%d%%)", state, v.TrafficWeight(time.Now()))
+			if v.Ramp != nil {
+				state = fmt.Sprintf("%s, ramping through %s every %s", state, formatIsolationGroupRampSchedule(v.Ramp.Weights), time.Duration(v.Ramp.IntervalSeconds)*time.Second)
+			}
+		}
+		fmt.Fprintf(w, "%s\t%s\n", v.Name, state)
 	}
 	w.Flush()
 	return output.Bytes()
@@ -262,6 +427,8 @@ func convertIsolationGroupStateToString(state types.IsolationGroupState) string
 		return "Drained"
 	case types.IsolationGroupStateHealthy:
 		return "Healthy"
+	case types.IsolationGroupStateWeighted:
+		return "Weighted"
 	default:
 		return fmt.Sprintf("Unknown state: %d", state)
 	}
@@ -23,14 +23,17 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/urfave/cli/v2"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/common/types"
@@ -158,12 +161,27 @@ func TestRenderIsolationGroupNormalOutput(t *testing.T) {
 					Name:  "zone-4",
 					State: 5,
 				},
+				"zone-5": {
+					Name:   "zone-5",
+					State:  types.IsolationGroupStateWeighted,
+					Weight: 40,
+				},
+				"zone-6": {
+					Name:  "zone-6",
+					State: types.IsolationGroupStateWeighted,
+					Ramp: &types.IsolationGroupWeightRamp{
+						Weights:         []int32{50, 25},
+						IntervalSeconds: 60,
+					},
+				},
 			},
 			expectedOutput: `Isolation Groups        State
 zone-1                  Healthy
 zone-2                  Drained
 zone-3-a-very-long-name Drained
 zone-4                  Unknown state: 5
+zone-5                  Weighted (40%)
+zone-6                  Weighted (25%), ramping through 50,25 every 1m0s
 `,
 		},
 		"nothing": {
@@ -544,3 +562,173 @@ func TestAdminUpdateDomainIsolationGroups(t *testing.T) {
 		})
 	}
 }
+
+func TestParseIsolationGroupRampSchedule(t *testing.T) {
+	tests := map[string]struct {
+		input       string
+		expected    []int32
+		expectedErr string
+	}{
+		"valid schedule": {
+			input:    "75, 50,25,0",
+			expected: []int32{75, 50, 25, 0},
+		},
+		"single step": {
+			input:    "100",
+			expected: []int32{100},
+		},
+		"empty": {
+			input:       "",
+			expectedErr: "the --ramp-schedule flag is required",
+		},
+		"not a number": {
+			input:       "50,half",
+			expectedErr: `invalid ramp schedule step "half"`,
+		},
+		"out of range": {
+			input:       "50,150",
+			expectedErr: `invalid ramp schedule step "150": weight must be between 0 and 100`,
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := parseIsolationGroupRampSchedule(td.input)
+			if td.expectedErr != "" {
+				assert.ErrorContains(t, err, td.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, td.expected, res)
+		})
+	}
+}
+
+func TestAdminRampGlobalIsolationGroup(t *testing.T) {
+	tests := []struct {
+		name          string
+		schedule      string
+		setupMocks    func(*admin.MockClient)
+		expectedError string
+	}{
+		{
+			name:     "Success",
+			schedule: "50,0",
+			setupMocks: func(client *admin.MockClient) {
+				current := types.IsolationGroupConfiguration{
+					"zone-2": {Name: "zone-2", State: types.IsolationGroupStateDrained},
+				}
+				client.EXPECT().GetGlobalIsolationGroups(gomock.Any(), gomock.Any()).
+					Return(&types.GetGlobalIsolationGroupsResponse{IsolationGroups: current}, nil)
+				client.EXPECT().UpdateGlobalIsolationGroups(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *types.UpdateGlobalIsolationGroupsRequest, _ ...yarpc.CallOption) (*types.UpdateGlobalIsolationGroupsResponse, error) {
+						assert.Equal(t, types.IsolationGroupPartition{Name: "zone-2", State: types.IsolationGroupStateDrained}, req.IsolationGroups["zone-2"])
+						partition := req.IsolationGroups["zone-1"]
+						assert.Equal(t, types.IsolationGroupStateWeighted, partition.State)
+						if assert.NotNil(t, partition.Ramp) {
+							assert.Equal(t, []int32{50, 0}, partition.Ramp.Weights)
+							assert.Equal(t, int32(60), partition.Ramp.IntervalSeconds)
+							assert.NotZero(t, partition.Ramp.StartTimestamp)
+						}
+						return &types.UpdateGlobalIsolationGroupsResponse{}, nil
+					})
+			},
+		},
+		{
+			name:          "invalid schedule",
+			schedule:      "-1",
+			setupMocks:    func(client *admin.MockClient) {},
+			expectedError: "invalid args:",
+		},
+		{
+			name:     "update failure",
+			schedule: "50,0",
+			setupMocks: func(client *admin.MockClient) {
+				client.EXPECT().GetGlobalIsolationGroups(gomock.Any(), gomock.Any()).
+					Return(&types.GetGlobalIsolationGroupsResponse{}, nil)
+				client.EXPECT().UpdateGlobalIsolationGroups(gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("update failed"))
+			},
+			expectedError: "failed to ramp isolation group zone-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			adminClient := admin.NewMockClient(mockCtrl)
+			tt.setupMocks(adminClient)
+
+			app := NewCliApp(&clientFactoryMock{
+				serverAdminClient: adminClient,
+			})
+
+			set := flag.NewFlagSet("test", 0)
+			set.String(FlagIsolationGroup, "zone-1", "IsolationGroup flag")
+			set.String(FlagIsolationGroupRampSchedule, tt.schedule, "RampSchedule flag")
+			set.Duration(FlagIsolationGroupRampInterval, time.Minute, "RampInterval flag")
+			c := cli.NewContext(app, set, nil)
+
+			err := AdminRampGlobalIsolationGroup(c)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAdminRampDomainIsolationGroup(t *testing.T) {
+	tests := []struct {
+		name          string
+		domain        string
+		setupMocks    func(*admin.MockClient)
+		expectedError string
+	}{
+		{
+			name:   "Success",
+			domain: "test-domain",
+			setupMocks: func(client *admin.MockClient) {
+				client.EXPECT().GetDomainIsolationGroups(gomock.Any(), &types.GetDomainIsolationGroupsRequest{Domain: "test-domain"}).
+					Return(&types.GetDomainIsolationGroupsResponse{}, nil)
+				client.EXPECT().UpdateDomainIsolationGroups(gomock.Any(), &types.UpdateDomainIsolationGroupsRequest{
+					Domain: "test-domain",
+					IsolationGroups: types.IsolationGroupConfiguration{
+						"zone-1": {Name: "zone-1", State: types.IsolationGroupStateHealthy},
+					},
+				}).Return(&types.UpdateDomainIsolationGroupsResponse{}, nil)
+			},
+		},
+		{
+			name:          "missing domain",
+			setupMocks:    func(client *admin.MockClient) {},
+			expectedError: "the --domain flag is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			adminClient := admin.NewMockClient(mockCtrl)
+			tt.setupMocks(adminClient)
+
+			app := NewCliApp(&clientFactoryMock{
+				serverAdminClient: adminClient,
+			})
+
+			set := flag.NewFlagSet("test", 0)
+			set.String(FlagDomain, tt.domain, "Domain flag")
+			set.String(FlagIsolationGroup, "zone-1", "IsolationGroup flag")
+			set.String(FlagIsolationGroupRampSchedule, "100", "RampSchedule flag")
+			c := cli.NewContext(app, set, nil)
+
+			err := AdminRampDomainIsolationGroup(c)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
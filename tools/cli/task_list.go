@@ -62,5 +62,25 @@ func newTaskListCommands() []*cli.Command {
 			},
 			Action: ListTaskListPartitions,
 		},
+		{
+			Name:    "scaling",
+			Aliases: []string{"scale"},
+			Usage:   "Recommend the number of pollers for a tasklist based on its backlog, task rates and latency",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagTaskList,
+					Aliases: []string{"tl"},
+					Usage:   "TaskList description",
+				},
+				&cli.StringFlag{
+					Name:    FlagTaskListType,
+					Aliases: []string{"tlt"},
+					Value:   "decision",
+					Usage:   "Optional TaskList type [decision|activity]",
+				},
+				getFormatFlag(),
+			},
+			Action: GetTaskListScalingRecommendation,
+		},
 	}
 }
@@ -22,6 +22,7 @@ package cli
 
 import (
 	"io"
+	"math"
 	"os"
 	"time"
 
@@ -42,6 +43,16 @@ type (
 		DecisionPartition string `header:"Decision Task List Partition"`
 		Host              string `header:"Host"`
 	}
+	TaskListScalingRecommendationRow struct {
+		RecommendedPollerCount   int64         `header:"Recommended Pollers"`
+		Confidence               string        `header:"Confidence"`
+		PollerCount              int64         `header:"Current Pollers"`
+		BacklogCountHint         int64         `header:"Backlog"`
+		AddTasksPerSecond        float64       `header:"Add Rate"`
+		DispatchedTasksPerSecond float64       `header:"Dispatch Rate"`
+		SyncMatchRatio           float64       `header:"Sync Match Ratio"`
+		ScheduleToStartLatency   time.Duration `header:"Schedule To Start Latency"`
+	}
 )
 
 // DescribeTaskList show pollers info of a given tasklist
@@ -127,6 +138,54 @@ func ListTaskListPartitions(c *cli.Context) error {
 	}
 }
 
+// GetTaskListScalingRecommendation shows the recommended number of pollers of a given tasklist
+func GetTaskListScalingRecommendation(c *cli.Context) error {
+	frontendClient, err := getDeps(c).ServerFrontendClient(c)
+	if err != nil {
+		return err
+	}
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	taskList, err := getRequiredOption(c, FlagTaskList)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	taskListType := strToTaskListType(c.String(FlagTaskListType)) // default type is decision
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error in creating context:", err)
+	}
+	request := &types.GetTaskListScalingRecommendationRequest{
+		Domain:       domain,
+		TaskList:     &types.TaskList{Name: taskList},
+		TaskListType: &taskListType,
+	}
+	response, err := frontendClient.GetTaskListScalingRecommendation(ctx, request)
+	if err != nil {
+		return commoncli.Problem("Operation GetTaskListScalingRecommendation failed.", err)
+	}
+
+	signals := response.GetSignals()
+	return Render(c, TaskListScalingRecommendationRow{
+		RecommendedPollerCount:   response.GetRecommendedPollerCount(),
+		Confidence:               response.GetConfidence().String(),
+		PollerCount:              signals.GetPollerCount(),
+		BacklogCountHint:         signals.GetBacklogCountHint(),
+		AddTasksPerSecond:        roundRate(signals.GetAddTasksPerSecond()),
+		DispatchedTasksPerSecond: roundRate(signals.GetDispatchedTasksPerSecond()),
+		SyncMatchRatio:           roundRate(signals.GetSyncMatchRatio()),
+		ScheduleToStartLatency:   time.Duration(signals.GetScheduleToStartLatencyInMs()) * time.Millisecond,
+	}, RenderOptions{DefaultTemplate: templateTable, Color: true, Border: true})
+}
+
+func roundRate(rate float64) float64 {
+	return math.Round(rate*100) / 100
+}
+
 func printTaskListPollers(w io.Writer, pollers []*types.PollerInfo, taskListType types.TaskListType) error {
 	table := []TaskListPollerRow{}
 	for _, poller := range pollers {
@@ -103,6 +103,12 @@ func newWorkflowCommands() []*cli.Command {
 			Flags:   getFlagsForTerminate(),
 			Action:  TerminateWorkflow,
 		},
+		{
+			Name:   "annotate",
+			Usage:  "add, update or remove memo of a running workflow execution",
+			Flags:  getFlagsForAnnotate(),
+			Action: AnnotateWorkflow,
+		},
 		{
 			Name:        "list",
 			Aliases:     []string{"l"},
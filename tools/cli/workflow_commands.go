@@ -680,6 +680,61 @@ func TerminateWorkflow(c *cli.Context) error {
 	return nil
 }
 
+// AnnotateWorkflow updates memo of a running workflow execution
+func AnnotateWorkflow(c *cli.Context) error {
+	wfClient, err := getWorkflowClient(c)
+	if err != nil {
+		return err
+	}
+
+	domain, err := getRequiredOption(c, FlagDomain)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return commoncli.Problem("Required flag not found: ", err)
+	}
+	rid := c.String(FlagRunID)
+
+	fields, err := processMemo(c)
+	if err != nil {
+		return commoncli.Problem("Error processing memo: ", err)
+	}
+	// an empty value removes the key from memo
+	for _, key := range processMultipleKeys(c.String(FlagRemoveMemoKey), " ") {
+		fields[key] = []byte{}
+	}
+	if len(fields) == 0 {
+		return commoncli.Problem("Either memo or memo keys to remove must be provided.", nil)
+	}
+
+	ctx, cancel, err := newContext(c)
+	defer cancel()
+	if err != nil {
+		return commoncli.Problem("Error creating context: ", err)
+	}
+	_, err = wfClient.UpdateWorkflowMemo(
+		ctx,
+		&types.UpdateWorkflowMemoRequest{
+			Domain: domain,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: wid,
+				RunID:      rid,
+			},
+			Memo:      &types.Memo{Fields: fields},
+			Identity:  getCliIdentity(),
+			RequestID: uuid.New(),
+		},
+	)
+	if err != nil {
+		return commoncli.Problem("Annotate workflow failed.", err)
+	}
+	fmt.Println("Annotate workflow succeeded.")
+
+	return nil
+}
+
 // CancelWorkflow cancels a workflow execution
 func CancelWorkflow(c *cli.Context) error {
 	wfClient, err := getWorkflowClient(c)
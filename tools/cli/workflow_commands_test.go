@@ -1954,6 +1954,18 @@ func Test_TerminateWorkflow_MissingFlags(t *testing.T) {
 	assert.ErrorContains(t, err, fmt.Sprintf("%s is required", FlagWorkflowID))
 }
 
+func Test_AnnotateWorkflow_MissingFlags(t *testing.T) {
+	app := NewCliApp(&clientFactoryMock{})
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(app, set, nil)
+	err := AnnotateWorkflow(c)
+	assert.ErrorContains(t, err, fmt.Sprintf("%s is required", FlagDomain))
+
+	set.String(FlagDomain, "test-domain", "domain")
+	err = AnnotateWorkflow(c)
+	assert.ErrorContains(t, err, fmt.Sprintf("%s is required", FlagWorkflowID))
+}
+
 func Test_ShowHistory_MissingWorkflowID(t *testing.T) {
 	app := NewCliApp(&clientFactoryMock{})
 	set := flag.NewFlagSet("test", 0)